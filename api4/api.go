@@ -35,6 +35,8 @@ type Routes struct {
 	TeamMembers        *mux.Router // 'api/v4/teams/{team_id:[A-Za-z0-9]+}/members'
 	TeamMember         *mux.Router // 'api/v4/teams/{team_id:[A-Za-z0-9]+}/members/{user_id:[A-Za-z0-9]+}'
 	TeamMembersForUser *mux.Router // 'api/v4/users/{user_id:[A-Za-z0-9]+}/teams/members'
+	TeamInviteLinks    *mux.Router // 'api/v4/teams/{team_id:[A-Za-z0-9]+}/invite_links'
+	TeamInviteLink     *mux.Router // 'api/v4/teams/{team_id:[A-Za-z0-9]+}/invite_links/{invite_link_id:[A-Za-z0-9]+}'
 
 	Channels                 *mux.Router // 'api/v4/channels'
 	Channel                  *mux.Router // 'api/v4/channels/{channel_id:[A-Za-z0-9]+}'
@@ -47,6 +49,12 @@ type Routes struct {
 	ChannelMembersForUser    *mux.Router // 'api/v4/users/{user_id:[A-Za-z0-9]+}/teams/{team_id:[A-Za-z0-9]+}/channels/members'
 	ChannelModerations       *mux.Router // 'api/v4/channels/{channel_id:[A-Za-z0-9]+}/moderations'
 	ChannelCategories        *mux.Router // 'api/v4/users/{user_id:[A-Za-z0-9]+}/teams/{team_id:[A-Za-z0-9]+}/channels/categories'
+	ChannelBookmarks         *mux.Router // 'api/v4/channels/{channel_id:[A-Za-z0-9]+}/bookmarks'
+	ChannelBookmark          *mux.Router // 'api/v4/channels/{channel_id:[A-Za-z0-9]+}/bookmarks/{bookmark_id:[A-Za-z0-9]+}'
+	ChannelGuestLinks        *mux.Router // 'api/v4/channels/{channel_id:[A-Za-z0-9]+}/guest_links'
+	ChannelGuestLink         *mux.Router // 'api/v4/channels/{channel_id:[A-Za-z0-9]+}/guest_links/{guest_link_id:[A-Za-z0-9]+}'
+	ChannelIntegrations      *mux.Router // 'api/v4/channels/{channel_id:[A-Za-z0-9]+}/integrations'
+	ChannelIntegration       *mux.Router // 'api/v4/channels/{channel_id:[A-Za-z0-9]+}/integrations/{integration_id:[A-Za-z0-9]+}'
 
 	Posts           *mux.Router // 'api/v4/posts'
 	Post            *mux.Router // 'api/v4/posts/{post_id:[A-Za-z0-9]+}'
@@ -70,6 +78,8 @@ type Routes struct {
 	IncomingHook  *mux.Router // 'api/v4/hooks/incoming/{hook_id:[A-Za-z0-9]+}'
 	OutgoingHooks *mux.Router // 'api/v4/hooks/outgoing'
 	OutgoingHook  *mux.Router // 'api/v4/hooks/outgoing/{hook_id:[A-Za-z0-9]+}'
+	PresenceHooks *mux.Router // 'api/v4/hooks/presence'
+	PresenceHook  *mux.Router // 'api/v4/hooks/presence/{hook_id:[A-Za-z0-9]+}'
 
 	OAuth     *mux.Router // 'api/v4/oauth'
 	OAuthApps *mux.Router // 'api/v4/oauth/apps'
@@ -79,6 +89,7 @@ type Routes struct {
 
 	SAML       *mux.Router // 'api/v4/saml'
 	Compliance *mux.Router // 'api/v4/compliance'
+	Embed      *mux.Router // 'api/v4/embed'
 	Cluster    *mux.Router // 'api/v4/cluster'
 
 	Image *mux.Router // 'api/v4/image'
@@ -89,7 +100,9 @@ type Routes struct {
 
 	Bleve *mux.Router // 'api/v4/bleve'
 
-	DataRetention *mux.Router // 'api/v4/data_retention'
+	DataRetention         *mux.Router // 'api/v4/data_retention'
+	DataRetentionPolicies *mux.Router // 'api/v4/data_retention/policies'
+	DataRetentionPolicy   *mux.Router // 'api/v4/data_retention/policies/{policy_id:[A-Za-z0-9]+}'
 
 	Brand *mux.Router // 'api/v4/brand'
 
@@ -114,8 +127,10 @@ type Routes struct {
 
 	ReactionByNameForPostForUser *mux.Router // 'api/v4/users/{user_id:[A-Za-z0-9]+}/posts/{post_id:[A-Za-z0-9]+}/reactions/{emoji_name:[A-Za-z0-9\\_\\-\\+]+}'
 
-	TermsOfService *mux.Router // 'api/v4/terms_of_service'
-	Groups         *mux.Router // 'api/v4/groups'
+	TermsOfService         *mux.Router // 'api/v4/terms_of_service'
+	TermsOfServicePolicies *mux.Router // 'api/v4/terms_of_service/policies'
+	TermsOfServicePolicy   *mux.Router // 'api/v4/terms_of_service/policies/{policy_id:[A-Za-z0-9]+}'
+	Groups                 *mux.Router // 'api/v4/groups'
 }
 
 type API struct {
@@ -150,6 +165,8 @@ func Init(configservice configservice.ConfigService, globalOptionsFunc app.AppOp
 	api.BaseRoutes.TeamMembers = api.BaseRoutes.Team.PathPrefix("/members").Subrouter()
 	api.BaseRoutes.TeamMember = api.BaseRoutes.TeamMembers.PathPrefix("/{user_id:[A-Za-z0-9]+}").Subrouter()
 	api.BaseRoutes.TeamMembersForUser = api.BaseRoutes.User.PathPrefix("/teams/members").Subrouter()
+	api.BaseRoutes.TeamInviteLinks = api.BaseRoutes.Team.PathPrefix("/invite_links").Subrouter()
+	api.BaseRoutes.TeamInviteLink = api.BaseRoutes.TeamInviteLinks.PathPrefix("/{invite_link_id:[A-Za-z0-9]+}").Subrouter()
 
 	api.BaseRoutes.Channels = api.BaseRoutes.ApiRoot.PathPrefix("/channels").Subrouter()
 	api.BaseRoutes.Channel = api.BaseRoutes.Channels.PathPrefix("/{channel_id:[A-Za-z0-9]+}").Subrouter()
@@ -162,6 +179,12 @@ func Init(configservice configservice.ConfigService, globalOptionsFunc app.AppOp
 	api.BaseRoutes.ChannelMembersForUser = api.BaseRoutes.User.PathPrefix("/teams/{team_id:[A-Za-z0-9]+}/channels/members").Subrouter()
 	api.BaseRoutes.ChannelModerations = api.BaseRoutes.Channel.PathPrefix("/moderations").Subrouter()
 	api.BaseRoutes.ChannelCategories = api.BaseRoutes.User.PathPrefix("/teams/{team_id:[A-Za-z0-9]+}/channels/categories").Subrouter()
+	api.BaseRoutes.ChannelBookmarks = api.BaseRoutes.Channel.PathPrefix("/bookmarks").Subrouter()
+	api.BaseRoutes.ChannelBookmark = api.BaseRoutes.ChannelBookmarks.PathPrefix("/{bookmark_id:[A-Za-z0-9]+}").Subrouter()
+	api.BaseRoutes.ChannelGuestLinks = api.BaseRoutes.Channel.PathPrefix("/guest_links").Subrouter()
+	api.BaseRoutes.ChannelGuestLink = api.BaseRoutes.ChannelGuestLinks.PathPrefix("/{guest_link_id:[A-Za-z0-9]+}").Subrouter()
+	api.BaseRoutes.ChannelIntegrations = api.BaseRoutes.Channel.PathPrefix("/integrations").Subrouter()
+	api.BaseRoutes.ChannelIntegration = api.BaseRoutes.ChannelIntegrations.PathPrefix("/{integration_id:[A-Za-z0-9]+}").Subrouter()
 
 	api.BaseRoutes.Posts = api.BaseRoutes.ApiRoot.PathPrefix("/posts").Subrouter()
 	api.BaseRoutes.Post = api.BaseRoutes.Posts.PathPrefix("/{post_id:[A-Za-z0-9]+}").Subrouter()
@@ -184,6 +207,8 @@ func Init(configservice configservice.ConfigService, globalOptionsFunc app.AppOp
 	api.BaseRoutes.IncomingHook = api.BaseRoutes.IncomingHooks.PathPrefix("/{hook_id:[A-Za-z0-9]+}").Subrouter()
 	api.BaseRoutes.OutgoingHooks = api.BaseRoutes.Hooks.PathPrefix("/outgoing").Subrouter()
 	api.BaseRoutes.OutgoingHook = api.BaseRoutes.OutgoingHooks.PathPrefix("/{hook_id:[A-Za-z0-9]+}").Subrouter()
+	api.BaseRoutes.PresenceHooks = api.BaseRoutes.Hooks.PathPrefix("/presence").Subrouter()
+	api.BaseRoutes.PresenceHook = api.BaseRoutes.PresenceHooks.PathPrefix("/{hook_id:[A-Za-z0-9]+}").Subrouter()
 
 	api.BaseRoutes.SAML = api.BaseRoutes.ApiRoot.PathPrefix("/saml").Subrouter()
 
@@ -192,6 +217,7 @@ func Init(configservice configservice.ConfigService, globalOptionsFunc app.AppOp
 	api.BaseRoutes.OAuthApp = api.BaseRoutes.OAuthApps.PathPrefix("/{app_id:[A-Za-z0-9]+}").Subrouter()
 
 	api.BaseRoutes.Compliance = api.BaseRoutes.ApiRoot.PathPrefix("/compliance").Subrouter()
+	api.BaseRoutes.Embed = api.BaseRoutes.ApiRoot.PathPrefix("/embed").Subrouter()
 	api.BaseRoutes.Cluster = api.BaseRoutes.ApiRoot.PathPrefix("/cluster").Subrouter()
 	api.BaseRoutes.LDAP = api.BaseRoutes.ApiRoot.PathPrefix("/ldap").Subrouter()
 	api.BaseRoutes.Brand = api.BaseRoutes.ApiRoot.PathPrefix("/brand").Subrouter()
@@ -204,6 +230,8 @@ func Init(configservice configservice.ConfigService, globalOptionsFunc app.AppOp
 	api.BaseRoutes.Elasticsearch = api.BaseRoutes.ApiRoot.PathPrefix("/elasticsearch").Subrouter()
 	api.BaseRoutes.Bleve = api.BaseRoutes.ApiRoot.PathPrefix("/bleve").Subrouter()
 	api.BaseRoutes.DataRetention = api.BaseRoutes.ApiRoot.PathPrefix("/data_retention").Subrouter()
+	api.BaseRoutes.DataRetentionPolicies = api.BaseRoutes.DataRetention.PathPrefix("/policies").Subrouter()
+	api.BaseRoutes.DataRetentionPolicy = api.BaseRoutes.DataRetentionPolicies.PathPrefix("/{policy_id:[A-Za-z0-9]+}").Subrouter()
 
 	api.BaseRoutes.Emojis = api.BaseRoutes.ApiRoot.PathPrefix("/emoji").Subrouter()
 	api.BaseRoutes.Emoji = api.BaseRoutes.ApiRoot.PathPrefix("/emoji/{emoji_id:[A-Za-z0-9]+}").Subrouter()
@@ -219,13 +247,29 @@ func Init(configservice configservice.ConfigService, globalOptionsFunc app.AppOp
 	api.BaseRoutes.Image = api.BaseRoutes.ApiRoot.PathPrefix("/image").Subrouter()
 
 	api.BaseRoutes.TermsOfService = api.BaseRoutes.ApiRoot.PathPrefix("/terms_of_service").Subrouter()
+	api.BaseRoutes.TermsOfServicePolicies = api.BaseRoutes.TermsOfService.PathPrefix("/policies").Subrouter()
+	api.BaseRoutes.TermsOfServicePolicy = api.BaseRoutes.TermsOfServicePolicies.PathPrefix("/{policy_id:[A-Za-z0-9]+}").Subrouter()
 	api.BaseRoutes.Groups = api.BaseRoutes.ApiRoot.PathPrefix("/groups").Subrouter()
 
 	api.InitUser()
+	api.InitUserProperty()
+	api.InitUserRelationship()
+	api.InitOrgChart()
+	api.InitLoginHistory()
+	api.InitEmailVerification()
+	api.InitServiceAccount()
+	api.InitPermissionCheck()
 	api.InitBot()
 	api.InitTeam()
+	api.InitTeamDirectory()
+	api.InitTeamInviteLink()
 	api.InitChannel()
+	api.InitChannelBookmark()
+	api.InitChannelGuestLink()
+	api.InitChannelIntegration()
 	api.InitPost()
+	api.InitPendingPin()
+	api.InitPostAcknowledgement()
 	api.InitFile()
 	api.InitSystem()
 	api.InitLicense()
@@ -246,6 +290,7 @@ func Init(configservice configservice.ConfigService, globalOptionsFunc app.AppOp
 	api.InitWebSocket()
 	api.InitEmoji()
 	api.InitOAuth()
+	api.InitEmbed()
 	api.InitReaction()
 	api.InitOpenGraph()
 	api.InitPlugin()
@@ -4,6 +4,7 @@
 package api4
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"math/rand"
@@ -945,9 +946,9 @@ func (me *TestHelper) MakeUserChannelAdmin(user *model.User, channel *model.Chan
 func (me *TestHelper) UpdateUserToTeamAdmin(user *model.User, team *model.Team) {
 	utils.DisableDebugLogForTest()
 
-	if tm, err := me.App.Srv().Store.Team().GetMember(team.Id, user.Id); err == nil {
+	if tm, err := me.App.Srv().Store.Team().GetMember(context.Background(), team.Id, user.Id, false); err == nil {
 		tm.SchemeAdmin = true
-		if _, err = me.App.Srv().Store.Team().UpdateMember(tm); err != nil {
+		if _, err = me.App.Srv().Store.Team().UpdateMember(context.Background(), tm); err != nil {
 			utils.EnableDebugLogForTest()
 			panic(err)
 		}
@@ -965,9 +966,9 @@ func (me *TestHelper) UpdateUserToTeamAdmin(user *model.User, team *model.Team)
 func (me *TestHelper) UpdateUserToNonTeamAdmin(user *model.User, team *model.Team) {
 	utils.DisableDebugLogForTest()
 
-	if tm, err := me.App.Srv().Store.Team().GetMember(team.Id, user.Id); err == nil {
+	if tm, err := me.App.Srv().Store.Team().GetMember(context.Background(), team.Id, user.Id, false); err == nil {
 		tm.SchemeAdmin = false
-		if _, err = me.App.Srv().Store.Team().UpdateMember(tm); err != nil {
+		if _, err = me.App.Srv().Store.Team().UpdateMember(context.Background(), tm); err != nil {
 			utils.EnableDebugLogForTest()
 			panic(err)
 		}
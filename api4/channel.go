@@ -53,10 +53,12 @@ func (api *API) InitChannel() {
 	api.BaseRoutes.Channel.Handle("", api.ApiSessionRequired(deleteChannel)).Methods("DELETE")
 	api.BaseRoutes.Channel.Handle("/stats", api.ApiSessionRequired(getChannelStats)).Methods("GET")
 	api.BaseRoutes.Channel.Handle("/pinned", api.ApiSessionRequired(getPinnedPosts)).Methods("GET")
+	api.BaseRoutes.Channel.Handle("/changes", api.ApiSessionRequired(getChannelChanges)).Methods("GET")
 	api.BaseRoutes.Channel.Handle("/timezones", api.ApiSessionRequired(getChannelMembersTimezones)).Methods("GET")
 	api.BaseRoutes.Channel.Handle("/members_minus_group_members", api.ApiSessionRequired(channelMembersMinusGroupMembers)).Methods("GET")
 	api.BaseRoutes.Channel.Handle("/move", api.ApiSessionRequired(moveChannel)).Methods("POST")
 	api.BaseRoutes.Channel.Handle("/member_counts_by_group", api.ApiSessionRequired(channelMemberCountsByGroup)).Methods("GET")
+	api.BaseRoutes.Channel.Handle("/posting_restrictions", api.ApiSessionRequired(updateChannelPostingRestrictions)).Methods("PUT")
 
 	api.BaseRoutes.ChannelForUser.Handle("/unread", api.ApiSessionRequired(getChannelUnread)).Methods("GET")
 
@@ -83,6 +85,8 @@ func createChannel(c *Context, w http.ResponseWriter, r *http.Request) {
 		c.SetInvalidParam("channel")
 		return
 	}
+	// Channel props hold server managed settings such as posting restrictions.
+	channel.Props = nil
 
 	auditRec := c.MakeAuditRecord("createChannel", audit.Fail)
 	defer c.LogAuditRec(auditRec)
@@ -376,6 +380,13 @@ func patchChannel(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Only the channel admins approve pins and manage the allowed integrations, so only they may
+	// decide whether pins need their approval or integrations have to be allowed.
+	if (patch.PinApprovalRequired != nil || patch.IntegrationsRestricted != nil) && !c.App.SessionHasPermissionToChannel(*c.App.Session(), c.Params.ChannelId, model.PERMISSION_MANAGE_CHANNEL_ROLES) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_CHANNEL_ROLES)
+		return
+	}
+
 	rchannel, err := c.App.PatchChannel(oldChannel, patch, c.App.Session().UserId)
 	if err != nil {
 		c.Err = err
@@ -684,11 +695,47 @@ func getPinnedPosts(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 
 	clientPostList := c.App.PreparePostListForClient(posts)
+	clientPostList = c.App.SanitizePostListMetadataForUser(clientPostList, c.App.Session().UserId)
 
 	w.Header().Set(model.HEADER_ETAG_SERVER, clientPostList.Etag())
 	w.Write([]byte(clientPostList.ToJson()))
 }
 
+func getChannelChanges(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	var sinceSeq int64
+	if sinceSeqString := r.URL.Query().Get("since_seq"); len(sinceSeqString) > 0 {
+		var parseError error
+		sinceSeq, parseError = strconv.ParseInt(sinceSeqString, 10, 64)
+		if parseError != nil || sinceSeq < 0 {
+			c.SetInvalidParam("since_seq")
+			return
+		}
+	}
+
+	if !c.App.SessionHasPermissionToChannel(*c.App.Session(), c.Params.ChannelId, model.PERMISSION_READ_CHANNEL) {
+		c.SetPermissionError(model.PERMISSION_READ_CHANNEL)
+		return
+	}
+
+	changes, err := c.App.GetChannelChanges(c.Params.ChannelId, sinceSeq)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	userId := c.App.Session().UserId
+	for id, post := range changes.Posts {
+		changes.Posts[id] = c.App.SanitizePostMetadataForUser(c.App.PreparePostForClient(post, false, false), userId)
+	}
+
+	w.Write([]byte(changes.ToJson()))
+}
+
 func getAllChannels(c *Context, w http.ResponseWriter, r *http.Request) {
 	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
 		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
@@ -1077,7 +1124,7 @@ func getChannelByName(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 
 	includeDeleted, _ := strconv.ParseBool(r.URL.Query().Get("include_deleted"))
-	channel, appErr := c.App.GetChannelByName(c.Params.ChannelName, c.Params.TeamId, includeDeleted)
+	channel, appErr := c.App.ResolveChannelByName(c.Params.ChannelName, c.Params.TeamId, includeDeleted)
 	if appErr != nil {
 		c.Err = appErr
 		return
@@ -1111,7 +1158,7 @@ func getChannelByNameForTeamName(c *Context, w http.ResponseWriter, r *http.Requ
 	}
 
 	includeDeleted, _ := strconv.ParseBool(r.URL.Query().Get("include_deleted"))
-	channel, appErr := c.App.GetChannelByNameForTeamName(c.Params.ChannelName, c.Params.TeamName, includeDeleted)
+	channel, appErr := c.App.ResolveChannelByNameForTeamName(c.Params.ChannelName, c.Params.TeamName, includeDeleted)
 	if appErr != nil {
 		c.Err = appErr
 		return
@@ -1233,6 +1280,24 @@ func getChannelMembersForUser(c *Context, w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	// Sorting switches to keyset pagination, so that users with many memberships can fetch the
+	// most relevant ones first.
+	if sortBy := r.URL.Query().Get("sort"); sortBy != "" {
+		if !model.IsValidChannelMembersSort(sortBy) {
+			c.SetInvalidParam("sort")
+			return
+		}
+
+		page, err := c.App.GetChannelMembersForUserPage(c.Params.TeamId, c.Params.UserId, sortBy, r.URL.Query().Get("cursor"), c.Params.PerPage)
+		if err != nil {
+			c.Err = err
+			return
+		}
+
+		w.Write([]byte(page.ToJson()))
+		return
+	}
+
 	members, err := c.App.GetChannelMembersForUser(c.Params.TeamId, c.Params.UserId)
 	if err != nil {
 		c.Err = err
@@ -1302,17 +1367,24 @@ func updateChannelMemberRoles(c *Context, w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	expiresAt, ok := rolesExpiresAtFromProps(props)
+	if !ok {
+		c.SetInvalidParam("expires_at")
+		return
+	}
+
 	auditRec := c.MakeAuditRecord("updateChannelMemberRoles", audit.Fail)
 	defer c.LogAuditRec(auditRec)
 	auditRec.AddMeta("channel_id", c.Params.ChannelId)
 	auditRec.AddMeta("roles", newRoles)
+	auditRec.AddMeta("expires_at", expiresAt)
 
 	if !c.App.SessionHasPermissionToChannel(*c.App.Session(), c.Params.ChannelId, model.PERMISSION_MANAGE_CHANNEL_ROLES) {
 		c.SetPermissionError(model.PERMISSION_MANAGE_CHANNEL_ROLES)
 		return
 	}
 
-	if _, err := c.App.UpdateChannelMemberRoles(c.Params.ChannelId, c.Params.UserId, newRoles); err != nil {
+	if _, err := c.App.UpdateChannelMemberRolesWithExpiry(c.Params.ChannelId, c.Params.UserId, newRoles, expiresAt); err != nil {
 		c.Err = err
 		return
 	}
@@ -1568,6 +1640,51 @@ func removeChannelMember(c *Context, w http.ResponseWriter, r *http.Request) {
 	ReturnStatusOK(w)
 }
 
+func updateChannelPostingRestrictions(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	restrictions := model.PostingRestrictionsFromJson(r.Body)
+	if restrictions == nil {
+		c.SetInvalidParam("posting_restrictions")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("updateChannelPostingRestrictions", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("restrictions", restrictions)
+
+	channel, err := c.App.GetChannel(c.Params.ChannelId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+	auditRec.AddMeta("channel", channel)
+
+	if channel.IsGroupOrDirect() {
+		if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+			c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+			return
+		}
+	} else if !c.App.SessionHasPermissionToTeam(*c.App.Session(), channel.TeamId, model.PERMISSION_MANAGE_TEAM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_TEAM)
+		return
+	}
+
+	updatedChannel, err := c.App.UpdateChannelPostingRestrictions(channel, restrictions)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	c.LogAudit("name=" + updatedChannel.Name)
+
+	w.Write([]byte(updatedChannel.ToJson()))
+}
+
 func updateChannelScheme(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireChannelId()
 	if c.Err != nil {
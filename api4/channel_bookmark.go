@@ -0,0 +1,219 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/audit"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func (api *API) InitChannelBookmark() {
+	api.BaseRoutes.ChannelBookmarks.Handle("", api.ApiSessionRequired(getChannelBookmarks)).Methods("GET")
+	api.BaseRoutes.ChannelBookmarks.Handle("", api.ApiSessionRequired(createChannelBookmark)).Methods("POST")
+	api.BaseRoutes.ChannelBookmark.Handle("", api.ApiSessionRequired(getChannelBookmark)).Methods("GET")
+	api.BaseRoutes.ChannelBookmark.Handle("", api.ApiSessionRequired(patchChannelBookmark)).Methods("PUT")
+	api.BaseRoutes.ChannelBookmark.Handle("", api.ApiSessionRequired(deleteChannelBookmark)).Methods("DELETE")
+}
+
+func getChannelBookmarks(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToChannel(*c.App.Session(), c.Params.ChannelId, model.PERMISSION_READ_CHANNEL) {
+		c.SetPermissionError(model.PERMISSION_READ_CHANNEL)
+		return
+	}
+
+	bookmarks, err := c.App.GetChannelBookmarks(c.Params.ChannelId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(model.ChannelBookmarkListToJson(bookmarks)))
+}
+
+func getChannelBookmark(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId().RequireBookmarkId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToChannel(*c.App.Session(), c.Params.ChannelId, model.PERMISSION_READ_CHANNEL) {
+		c.SetPermissionError(model.PERMISSION_READ_CHANNEL)
+		return
+	}
+
+	bookmark, err := getChannelBookmarkForChannel(c)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(bookmark.ToJson()))
+}
+
+func createChannelBookmark(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	bookmark := model.ChannelBookmarkFromJson(r.Body)
+	if bookmark == nil {
+		c.SetInvalidParam("bookmark")
+		return
+	}
+	bookmark.ChannelId = c.Params.ChannelId
+
+	auditRec := c.MakeAuditRecord("createChannelBookmark", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("channel_id", c.Params.ChannelId)
+
+	if !checkManageChannelBookmarksPermission(c) {
+		return
+	}
+
+	createdBookmark, err := c.App.CreateChannelBookmark(bookmark, c.App.Session().UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("bookmark_id", createdBookmark.Id)
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte(createdBookmark.ToJson()))
+}
+
+func patchChannelBookmark(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId().RequireBookmarkId()
+	if c.Err != nil {
+		return
+	}
+
+	patch := model.ChannelBookmarkPatchFromJson(r.Body)
+	if patch == nil {
+		c.SetInvalidParam("bookmark")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("patchChannelBookmark", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("channel_id", c.Params.ChannelId)
+	auditRec.AddMeta("bookmark_id", c.Params.BookmarkId)
+
+	if !checkManageChannelBookmarksPermission(c) {
+		return
+	}
+
+	bookmark, err := getChannelBookmarkForChannel(c)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	updatedBookmark, err := c.App.PatchChannelBookmark(bookmark, patch, c.App.Session().UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	w.Write([]byte(updatedBookmark.ToJson()))
+}
+
+func deleteChannelBookmark(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId().RequireBookmarkId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteChannelBookmark", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("channel_id", c.Params.ChannelId)
+	auditRec.AddMeta("bookmark_id", c.Params.BookmarkId)
+
+	if !checkManageChannelBookmarksPermission(c) {
+		return
+	}
+
+	bookmark, err := getChannelBookmarkForChannel(c)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	deletedBookmark, err := c.App.DeleteChannelBookmark(bookmark)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	w.Write([]byte(deletedBookmark.ToJson()))
+}
+
+// getChannelBookmarkForChannel fetches the requested bookmark, making sure it belongs to the channel in the URL.
+func getChannelBookmarkForChannel(c *Context) (*model.ChannelBookmark, *model.AppError) {
+	bookmark, err := c.App.GetChannelBookmark(c.Params.BookmarkId, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if bookmark.ChannelId != c.Params.ChannelId {
+		return nil, model.NewAppError("getChannelBookmarkForChannel", "app.channel_bookmark.get.not_found.app_error", nil, "", http.StatusNotFound)
+	}
+
+	return bookmark, nil
+}
+
+// checkManageChannelBookmarksPermission verifies the session may change the bookmarks of the channel,
+// following the same rules as editing the channel header. It sets c.Err and returns false otherwise.
+func checkManageChannelBookmarksPermission(c *Context) bool {
+	channel, err := c.App.GetChannel(c.Params.ChannelId)
+	if err != nil {
+		c.Err = err
+		return false
+	}
+
+	if channel.DeleteAt != 0 {
+		c.Err = model.NewAppError("checkManageChannelBookmarksPermission", "api.channel_bookmark.channel_archived.app_error", nil, "", http.StatusBadRequest)
+		return false
+	}
+
+	switch channel.Type {
+	case model.CHANNEL_OPEN:
+		if !c.App.SessionHasPermissionToChannel(*c.App.Session(), channel.Id, model.PERMISSION_MANAGE_PUBLIC_CHANNEL_PROPERTIES) {
+			c.SetPermissionError(model.PERMISSION_MANAGE_PUBLIC_CHANNEL_PROPERTIES)
+			return false
+		}
+
+	case model.CHANNEL_PRIVATE:
+		if !c.App.SessionHasPermissionToChannel(*c.App.Session(), channel.Id, model.PERMISSION_MANAGE_PRIVATE_CHANNEL_PROPERTIES) {
+			c.SetPermissionError(model.PERMISSION_MANAGE_PRIVATE_CHANNEL_PROPERTIES)
+			return false
+		}
+
+	case model.CHANNEL_GROUP, model.CHANNEL_DIRECT:
+		// Bookmarks in group/dm channels are not linked to any specific permission, so just check for membership.
+		if _, err = c.App.GetChannelMember(channel.Id, c.App.Session().UserId); err != nil {
+			c.Err = model.NewAppError("checkManageChannelBookmarksPermission", "api.channel_bookmark.forbidden.app_error", nil, "", http.StatusForbidden)
+			return false
+		}
+
+	default:
+		c.Err = model.NewAppError("checkManageChannelBookmarksPermission", "api.channel_bookmark.forbidden.app_error", nil, "", http.StatusForbidden)
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func newLinkBookmark(channelId string) *model.ChannelBookmark {
+	return &model.ChannelBookmark{
+		ChannelId:   channelId,
+		DisplayName: "Handbook",
+		LinkUrl:     "https://handbook.mattermost.com",
+		Emoji:       "book",
+		Type:        model.CHANNEL_BOOKMARK_LINK,
+	}
+}
+
+func TestCreateChannelBookmark(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	Client := th.Client
+
+	t.Run("should create a link bookmark", func(t *testing.T) {
+		bookmark, resp := Client.CreateChannelBookmark(newLinkBookmark(th.BasicChannel.Id))
+		CheckNoError(t, resp)
+		CheckCreatedStatus(t, resp)
+		require.NotEmpty(t, bookmark.Id)
+		require.Equal(t, th.BasicUser.Id, bookmark.OwnerId)
+		require.Equal(t, th.BasicChannel.Id, bookmark.ChannelId)
+	})
+
+	t.Run("should fail with an invalid bookmark", func(t *testing.T) {
+		bookmark := newLinkBookmark(th.BasicChannel.Id)
+		bookmark.LinkUrl = "junk"
+		_, resp := Client.CreateChannelBookmark(bookmark)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("should fail without permission to manage the channel", func(t *testing.T) {
+		defaultRolePermissions := th.SaveDefaultRolePermissions()
+		defer th.RestoreDefaultRolePermissions(defaultRolePermissions)
+		th.RemovePermissionFromRole(model.PERMISSION_MANAGE_PUBLIC_CHANNEL_PROPERTIES.Id, model.CHANNEL_USER_ROLE_ID)
+
+		_, resp := Client.CreateChannelBookmark(newLinkBookmark(th.BasicChannel.Id))
+		CheckForbiddenStatus(t, resp)
+
+		th.MakeUserChannelAdmin(th.BasicUser, th.BasicChannel)
+		th.AddPermissionToRole(model.PERMISSION_MANAGE_PUBLIC_CHANNEL_PROPERTIES.Id, model.CHANNEL_ADMIN_ROLE_ID)
+
+		_, resp = Client.CreateChannelBookmark(newLinkBookmark(th.BasicChannel.Id))
+		CheckNoError(t, resp)
+	})
+
+	t.Run("should fail for non members of a private channel", func(t *testing.T) {
+		th.LoginBasic2()
+		channel := th.CreatePrivateChannel()
+		th.LoginBasic()
+
+		_, resp := Client.CreateChannelBookmark(newLinkBookmark(channel.Id))
+		CheckForbiddenStatus(t, resp)
+	})
+}
+
+func TestGetChannelBookmarks(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	Client := th.Client
+
+	second := newLinkBookmark(th.BasicChannel.Id)
+	second.SortOrder = 2
+	second, resp := Client.CreateChannelBookmark(second)
+	CheckNoError(t, resp)
+
+	first := newLinkBookmark(th.BasicChannel.Id)
+	first.SortOrder = 1
+	first, resp = Client.CreateChannelBookmark(first)
+	CheckNoError(t, resp)
+
+	bookmarks, resp := Client.GetChannelBookmarks(th.BasicChannel.Id)
+	CheckNoError(t, resp)
+	require.Len(t, bookmarks, 2)
+	require.Equal(t, first.Id, bookmarks[0].Id)
+	require.Equal(t, second.Id, bookmarks[1].Id)
+
+	bookmark, resp := Client.GetChannelBookmark(th.BasicChannel.Id, first.Id)
+	CheckNoError(t, resp)
+	require.Equal(t, first.Id, bookmark.Id)
+
+	_, resp = Client.GetChannelBookmark(th.BasicChannel2.Id, first.Id)
+	CheckNotFoundStatus(t, resp)
+
+	user := th.CreateUser()
+	Client.Login(user.Email, user.Password)
+	_, resp = Client.GetChannelBookmarks(th.BasicChannel.Id)
+	CheckForbiddenStatus(t, resp)
+}
+
+func TestPatchChannelBookmark(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	Client := th.Client
+
+	bookmark, resp := Client.CreateChannelBookmark(newLinkBookmark(th.BasicChannel.Id))
+	CheckNoError(t, resp)
+
+	patch := &model.ChannelBookmarkPatch{
+		DisplayName: model.NewString("Wiki"),
+		SortOrder:   model.NewInt64(5),
+	}
+
+	patched, resp := Client.PatchChannelBookmark(th.BasicChannel.Id, bookmark.Id, patch)
+	CheckNoError(t, resp)
+	require.Equal(t, "Wiki", patched.DisplayName)
+	require.Equal(t, int64(5), patched.SortOrder)
+	require.Equal(t, bookmark.LinkUrl, patched.LinkUrl)
+
+	_, resp = Client.PatchChannelBookmark(th.BasicChannel.Id, model.NewId(), patch)
+	CheckNotFoundStatus(t, resp)
+
+	_, resp = Client.PatchChannelBookmark(th.BasicChannel.Id, bookmark.Id, &model.ChannelBookmarkPatch{LinkUrl: model.NewString("junk")})
+	CheckBadRequestStatus(t, resp)
+}
+
+func TestDeleteChannelBookmark(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	Client := th.Client
+
+	bookmark, resp := Client.CreateChannelBookmark(newLinkBookmark(th.BasicChannel.Id))
+	CheckNoError(t, resp)
+
+	deleted, resp := Client.DeleteChannelBookmark(th.BasicChannel.Id, bookmark.Id)
+	CheckNoError(t, resp)
+	require.NotZero(t, deleted.DeleteAt)
+
+	_, resp = Client.GetChannelBookmark(th.BasicChannel.Id, bookmark.Id)
+	CheckNotFoundStatus(t, resp)
+
+	_, resp = Client.DeleteChannelBookmark(th.BasicChannel.Id, bookmark.Id)
+	CheckNotFoundStatus(t, resp)
+}
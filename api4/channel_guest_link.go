@@ -0,0 +1,172 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/audit"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func (api *API) InitChannelGuestLink() {
+	api.BaseRoutes.ChannelGuestLinks.Handle("", api.ApiSessionRequired(getChannelGuestLinks)).Methods("GET")
+	api.BaseRoutes.ChannelGuestLinks.Handle("", api.ApiSessionRequired(createChannelGuestLink)).Methods("POST")
+	api.BaseRoutes.ChannelGuestLink.Handle("", api.ApiSessionRequired(getChannelGuestLink)).Methods("GET")
+	api.BaseRoutes.ChannelGuestLink.Handle("", api.ApiSessionRequired(revokeChannelGuestLink)).Methods("DELETE")
+}
+
+func getChannelGuestLinks(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	if !checkChannelGuestLinkPermissions(c) {
+		return
+	}
+
+	links, err := c.App.GetChannelGuestLinks(c.Params.ChannelId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(model.ChannelGuestLinkListToJson(links)))
+}
+
+func getChannelGuestLink(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId().RequireGuestLinkId()
+	if c.Err != nil {
+		return
+	}
+
+	if !checkChannelGuestLinkPermissions(c) {
+		return
+	}
+
+	link, err := getChannelGuestLinkForChannel(c)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(link.ToJson()))
+}
+
+func createChannelGuestLink(c *Context, w http.ResponseWriter, r *http.Request) {
+	if c.App.Srv().License() == nil {
+		c.Err = model.NewAppError("createChannelGuestLink", "api.team.invate_guests_to_channels.license.error", nil, "", http.StatusNotImplemented)
+		return
+	}
+
+	if !*c.App.Config().GuestAccountsSettings.Enable {
+		c.Err = model.NewAppError("createChannelGuestLink", "api.team.invate_guests_to_channels.disabled.error", nil, "", http.StatusNotImplemented)
+		return
+	}
+
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	link := model.ChannelGuestLinkFromJson(r.Body)
+	if link == nil {
+		c.SetInvalidParam("guest_link")
+		return
+	}
+	link.ChannelId = c.Params.ChannelId
+
+	auditRec := c.MakeAuditRecord("createChannelGuestLink", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("channel_id", c.Params.ChannelId)
+
+	if !checkChannelGuestLinkPermissions(c) {
+		return
+	}
+
+	createdLink, err := c.App.CreateChannelGuestLink(link, c.App.Session().UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("guest_link_id", createdLink.Id)
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte(createdLink.ToJson()))
+}
+
+func revokeChannelGuestLink(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId().RequireGuestLinkId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("revokeChannelGuestLink", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("channel_id", c.Params.ChannelId)
+	auditRec.AddMeta("guest_link_id", c.Params.GuestLinkId)
+
+	if !checkChannelGuestLinkPermissions(c) {
+		return
+	}
+
+	if _, err := getChannelGuestLinkForChannel(c); err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := c.App.RevokeChannelGuestLink(c.Params.GuestLinkId); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	ReturnStatusOK(w)
+}
+
+// checkChannelGuestLinkPermissions checks that the session can both invite guests to the team of
+// the channel of the request and manage the members of that channel, setting the error otherwise.
+func checkChannelGuestLinkPermissions(c *Context) bool {
+	channel, err := c.App.GetChannel(c.Params.ChannelId)
+	if err != nil {
+		c.Err = err
+		return false
+	}
+
+	if !c.App.SessionHasPermissionToTeam(*c.App.Session(), channel.TeamId, model.PERMISSION_INVITE_GUEST) {
+		c.SetPermissionError(model.PERMISSION_INVITE_GUEST)
+		return false
+	}
+
+	permission := model.PERMISSION_MANAGE_PUBLIC_CHANNEL_MEMBERS
+	if channel.Type == model.CHANNEL_PRIVATE {
+		permission = model.PERMISSION_MANAGE_PRIVATE_CHANNEL_MEMBERS
+	}
+
+	if !c.App.SessionHasPermissionToChannel(*c.App.Session(), channel.Id, permission) {
+		c.SetPermissionError(permission)
+		return false
+	}
+
+	return true
+}
+
+// getChannelGuestLinkForChannel returns the guest link of the request, making sure it belongs to
+// the channel of the request.
+func getChannelGuestLinkForChannel(c *Context) (*model.ChannelGuestLink, *model.AppError) {
+	link, err := c.App.GetChannelGuestLink(c.Params.GuestLinkId)
+	if err != nil {
+		return nil, err
+	}
+
+	if link.ChannelId != c.Params.ChannelId {
+		return nil, model.NewAppError("getChannelGuestLinkForChannel", "app.channel_guest_link.get.not_found.app_error", nil, "", http.StatusNotFound)
+	}
+
+	return link, nil
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func newGuestLinkUser(domain string) *model.User {
+	id := model.NewId()
+	return &model.User{
+		Email:    "success+" + id + "@" + domain,
+		Username: "un_" + id,
+		Password: "Password1",
+	}
+}
+
+func TestCreateChannelGuestLink(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("should fail without guest accounts", func(t *testing.T) {
+		_, resp := th.SystemAdminClient.CreateChannelGuestLink(&model.ChannelGuestLink{ChannelId: th.BasicChannel.Id})
+		CheckNotImplementedStatus(t, resp)
+	})
+
+	enableGuestAccounts := *th.App.Config().GuestAccountsSettings.Enable
+	defer func() {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.GuestAccountsSettings.Enable = enableGuestAccounts })
+		th.App.Srv().RemoveLicense()
+	}()
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.GuestAccountsSettings.Enable = true })
+	th.App.Srv().SetLicense(model.NewTestLicense())
+
+	t.Run("should create a link", func(t *testing.T) {
+		created, resp := th.SystemAdminClient.CreateChannelGuestLink(&model.ChannelGuestLink{ChannelId: th.BasicChannel.Id, MaxUses: 5})
+		CheckNoError(t, resp)
+		CheckCreatedStatus(t, resp)
+		require.NotEmpty(t, created.Id)
+		require.Len(t, created.Token, model.CHANNEL_GUEST_LINK_TOKEN_SIZE)
+		require.Equal(t, th.BasicTeam.Id, created.TeamId)
+		require.Equal(t, th.SystemAdminUser.Id, created.CreatorId)
+
+		links, resp := th.SystemAdminClient.GetChannelGuestLinks(th.BasicChannel.Id)
+		CheckNoError(t, resp)
+		require.Len(t, links, 1)
+	})
+
+	t.Run("should fail for a direct channel", func(t *testing.T) {
+		dm := th.CreateDmChannel(th.BasicUser2)
+		_, resp := th.SystemAdminClient.CreateChannelGuestLink(&model.ChannelGuestLink{ChannelId: dm.Id})
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("should fail without permission to invite guests", func(t *testing.T) {
+		_, resp := th.Client.CreateChannelGuestLink(&model.ChannelGuestLink{ChannelId: th.BasicChannel.Id})
+		CheckForbiddenStatus(t, resp)
+
+		_, resp = th.Client.GetChannelGuestLinks(th.BasicChannel.Id)
+		CheckForbiddenStatus(t, resp)
+	})
+}
+
+func TestCreateGuestWithChannelGuestLink(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	enableGuestAccounts := *th.App.Config().GuestAccountsSettings.Enable
+	defer func() {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.GuestAccountsSettings.Enable = enableGuestAccounts })
+		th.App.Srv().RemoveLicense()
+	}()
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.GuestAccountsSettings.Enable = true })
+	th.App.Srv().SetLicense(model.NewTestLicense())
+
+	link, resp := th.SystemAdminClient.CreateChannelGuestLink(&model.ChannelGuestLink{
+		ChannelId:      th.BasicChannel.Id,
+		MaxUses:        1,
+		AllowedDomains: "simulator.amazonses.com",
+	})
+	CheckNoError(t, resp)
+
+	t.Run("should fail with an invalid token", func(t *testing.T) {
+		_, resp := th.Client.CreateGuestWithChannelGuestLink(newGuestLinkUser("simulator.amazonses.com"), model.NewRandomString(model.CHANNEL_GUEST_LINK_TOKEN_SIZE))
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("should fail with an email of another domain", func(t *testing.T) {
+		_, resp := th.Client.CreateGuestWithChannelGuestLink(newGuestLinkUser("example.com"), link.Token)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("should create a guest in the channel", func(t *testing.T) {
+		guest, resp := th.Client.CreateGuestWithChannelGuestLink(newGuestLinkUser("simulator.amazonses.com"), link.Token)
+		CheckNoError(t, resp)
+		CheckCreatedStatus(t, resp)
+		require.True(t, guest.IsGuest())
+
+		_, appErr := th.App.GetChannelMember(th.BasicChannel.Id, guest.Id)
+		require.Nil(t, appErr)
+
+		member, appErr := th.App.GetTeamMember(th.BasicTeam.Id, guest.Id)
+		require.Nil(t, appErr)
+		require.True(t, member.SchemeGuest)
+
+		fetched, resp := th.SystemAdminClient.GetChannelGuestLink(th.BasicChannel.Id, link.Id)
+		CheckNoError(t, resp)
+		require.Equal(t, int64(1), fetched.UseCount)
+	})
+
+	t.Run("should fail once used up", func(t *testing.T) {
+		_, resp := th.Client.CreateGuestWithChannelGuestLink(newGuestLinkUser("simulator.amazonses.com"), link.Token)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("should fail once revoked", func(t *testing.T) {
+		revocable, resp := th.SystemAdminClient.CreateChannelGuestLink(&model.ChannelGuestLink{ChannelId: th.BasicChannel.Id})
+		CheckNoError(t, resp)
+
+		_, resp = th.SystemAdminClient.RevokeChannelGuestLink(th.BasicChannel.Id, revocable.Id)
+		CheckNoError(t, resp)
+
+		_, resp = th.Client.CreateGuestWithChannelGuestLink(newGuestLinkUser("simulator.amazonses.com"), revocable.Token)
+		CheckBadRequestStatus(t, resp)
+	})
+}
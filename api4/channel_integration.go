@@ -0,0 +1,104 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/audit"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func (api *API) InitChannelIntegration() {
+	api.BaseRoutes.ChannelIntegrations.Handle("", api.ApiSessionRequired(getChannelIntegrations)).Methods("GET")
+	api.BaseRoutes.ChannelIntegrations.Handle("", api.ApiSessionRequired(addChannelIntegration)).Methods("POST")
+	api.BaseRoutes.ChannelIntegration.Handle("", api.ApiSessionRequired(removeChannelIntegration)).Methods("DELETE")
+}
+
+func getChannelIntegrations(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToChannel(*c.App.Session(), c.Params.ChannelId, model.PERMISSION_MANAGE_CHANNEL_ROLES) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_CHANNEL_ROLES)
+		return
+	}
+
+	integrations, err := c.App.GetChannelIntegrations(c.Params.ChannelId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(model.ChannelIntegrationListToJson(integrations)))
+}
+
+func addChannelIntegration(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	integration := model.ChannelIntegrationFromJson(r.Body)
+	if integration == nil {
+		c.SetInvalidParam("integration")
+		return
+	}
+	integration.CreatorId = c.App.Session().UserId
+
+	auditRec := c.MakeAuditRecord("addChannelIntegration", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("channel_id", c.Params.ChannelId)
+	auditRec.AddMeta("integration", integration)
+
+	if !c.App.SessionHasPermissionToChannel(*c.App.Session(), c.Params.ChannelId, model.PERMISSION_MANAGE_CHANNEL_ROLES) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_CHANNEL_ROLES)
+		return
+	}
+
+	channel, err := c.App.GetChannel(c.Params.ChannelId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	savedIntegration, err := c.App.AddChannelIntegration(channel, integration)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte(savedIntegration.ToJson()))
+}
+
+func removeChannelIntegration(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId().RequireIntegrationId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("removeChannelIntegration", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("channel_id", c.Params.ChannelId)
+	auditRec.AddMeta("integration_id", c.Params.IntegrationId)
+
+	if !c.App.SessionHasPermissionToChannel(*c.App.Session(), c.Params.ChannelId, model.PERMISSION_MANAGE_CHANNEL_ROLES) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_CHANNEL_ROLES)
+		return
+	}
+
+	if err := c.App.RemoveChannelIntegration(c.Params.ChannelId, c.Params.IntegrationId); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	ReturnStatusOK(w)
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestChannelIntegrations(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	Client := th.Client
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableIncomingWebhooks = true })
+
+	hook, appErr := th.App.CreateIncomingWebhookForChannel(th.BasicUser.Id, th.BasicChannel, &model.IncomingWebhook{ChannelId: th.BasicChannel.Id})
+	require.Nil(t, appErr)
+
+	_, resp := Client.PatchChannel(th.BasicChannel.Id, &model.ChannelPatch{IntegrationsRestricted: model.NewBool(true)})
+	CheckForbiddenStatus(t, resp)
+
+	channel, resp := th.SystemAdminClient.PatchChannel(th.BasicChannel.Id, &model.ChannelPatch{IntegrationsRestricted: model.NewBool(true)})
+	CheckNoError(t, resp)
+	require.True(t, channel.IsIntegrationsRestricted())
+
+	t.Run("webhooks should not post until allowed", func(t *testing.T) {
+		appErr := th.App.HandleIncomingWebhook(hook.Id, &model.IncomingWebhookRequest{Text: "text"})
+		require.NotNil(t, appErr)
+		require.Equal(t, http.StatusForbidden, appErr.StatusCode)
+
+		_, resp := Client.AddChannelIntegration(&model.ChannelIntegration{
+			ChannelId:     th.BasicChannel.Id,
+			IntegrationId: hook.Id,
+			Type:          model.CHANNEL_INTEGRATION_TYPE_INCOMING_WEBHOOK,
+		})
+		CheckForbiddenStatus(t, resp)
+
+		integration, resp := th.SystemAdminClient.AddChannelIntegration(&model.ChannelIntegration{
+			ChannelId:     th.BasicChannel.Id,
+			IntegrationId: hook.Id,
+			Type:          model.CHANNEL_INTEGRATION_TYPE_INCOMING_WEBHOOK,
+		})
+		CheckNoError(t, resp)
+		CheckCreatedStatus(t, resp)
+		require.Equal(t, th.SystemAdminUser.Id, integration.CreatorId)
+
+		_, resp = th.SystemAdminClient.AddChannelIntegration(integration)
+		CheckBadRequestStatus(t, resp)
+
+		appErr = th.App.HandleIncomingWebhook(hook.Id, &model.IncomingWebhookRequest{Text: "text"})
+		require.Nil(t, appErr)
+	})
+
+	t.Run("channel admins should list the allowed integrations", func(t *testing.T) {
+		_, resp := Client.GetChannelIntegrations(th.BasicChannel.Id)
+		CheckForbiddenStatus(t, resp)
+
+		integrations, resp := th.SystemAdminClient.GetChannelIntegrations(th.BasicChannel.Id)
+		CheckNoError(t, resp)
+		require.Len(t, integrations, 1)
+		require.Equal(t, hook.Id, integrations[0].IntegrationId)
+	})
+
+	t.Run("integrations of other teams should not be allowed", func(t *testing.T) {
+		otherChannel := th.CreateChannelWithClientAndTeam(th.SystemAdminClient, model.CHANNEL_OPEN, th.CreateTeamWithClient(th.SystemAdminClient).Id)
+		otherHook, appErr := th.App.CreateIncomingWebhookForChannel(th.SystemAdminUser.Id, otherChannel, &model.IncomingWebhook{ChannelId: otherChannel.Id})
+		require.Nil(t, appErr)
+
+		_, resp := th.SystemAdminClient.AddChannelIntegration(&model.ChannelIntegration{
+			ChannelId:     th.BasicChannel.Id,
+			IntegrationId: otherHook.Id,
+			Type:          model.CHANNEL_INTEGRATION_TYPE_INCOMING_WEBHOOK,
+		})
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("removed integrations should not post anymore", func(t *testing.T) {
+		_, resp := Client.RemoveChannelIntegration(th.BasicChannel.Id, hook.Id)
+		CheckForbiddenStatus(t, resp)
+
+		pass, resp := th.SystemAdminClient.RemoveChannelIntegration(th.BasicChannel.Id, hook.Id)
+		CheckNoError(t, resp)
+		require.True(t, pass)
+
+		_, resp = th.SystemAdminClient.RemoveChannelIntegration(th.BasicChannel.Id, hook.Id)
+		CheckNotFoundStatus(t, resp)
+
+		appErr := th.App.HandleIncomingWebhook(hook.Id, &model.IncomingWebhookRequest{Text: "text"})
+		require.NotNil(t, appErr)
+		require.Equal(t, http.StatusForbidden, appErr.StatusCode)
+	})
+
+	t.Run("bots should not post until allowed", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableBotAccountCreation = true })
+		bot := th.CreateBotWithSystemAdminClient()
+		botUser, appErr := th.App.GetUser(bot.UserId)
+		require.Nil(t, appErr)
+		th.LinkUserToTeam(botUser, th.BasicTeam)
+		th.AddUserToChannel(botUser, th.BasicChannel)
+
+		post := &model.Post{UserId: bot.UserId, ChannelId: th.BasicChannel.Id, Message: "message"}
+		_, appErr = th.App.CreatePost(post.Clone(), th.BasicChannel, false, true)
+		require.NotNil(t, appErr)
+		require.Equal(t, http.StatusForbidden, appErr.StatusCode)
+
+		_, resp := th.SystemAdminClient.AddChannelIntegration(&model.ChannelIntegration{
+			ChannelId:     th.BasicChannel.Id,
+			IntegrationId: bot.UserId,
+			Type:          model.CHANNEL_INTEGRATION_TYPE_BOT,
+		})
+		CheckNoError(t, resp)
+
+		_, appErr = th.App.CreatePost(post.Clone(), th.BasicChannel, false, true)
+		require.Nil(t, appErr)
+	})
+}
@@ -1810,6 +1810,39 @@ func TestGetChannelByNameForTeamName(t *testing.T) {
 	CheckNotFoundStatus(t, resp)
 }
 
+func TestGetChannelByNameAfterRename(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	Client := th.Client
+
+	oldChannelName := th.BasicChannel.Name
+	newChannelName := GenerateTestChannelName()
+	_, resp := Client.PatchChannel(th.BasicChannel.Id, &model.ChannelPatch{Name: &newChannelName})
+	CheckNoError(t, resp)
+
+	oldTeamName := th.BasicTeam.Name
+	_, err := th.App.RenameTeam(th.BasicTeam, GenerateTestTeamName(), "")
+	require.Nil(t, err)
+
+	channel, resp := Client.GetChannelByName(oldChannelName, th.BasicTeam.Id, "")
+	CheckNoError(t, resp)
+	require.Equal(t, th.BasicChannel.Id, channel.Id)
+	require.Equal(t, newChannelName, channel.Name)
+
+	channel, resp = Client.GetChannelByNameForTeamName(oldChannelName, oldTeamName, "")
+	CheckNoError(t, resp)
+	require.Equal(t, th.BasicChannel.Id, channel.Id)
+
+	team, resp := Client.GetTeamByName(oldTeamName, "")
+	CheckNoError(t, resp)
+	require.Equal(t, th.BasicTeam.Id, team.Id)
+
+	user := th.CreateUser()
+	Client.Login(user.Email, user.Password)
+	_, resp = Client.GetChannelByNameForTeamName(oldChannelName, oldTeamName, "")
+	CheckNotFoundStatus(t, resp)
+}
+
 func TestGetChannelMembers(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -1973,6 +2006,63 @@ func TestGetChannelMembersForUser(t *testing.T) {
 	CheckNoError(t, resp)
 }
 
+func TestGetChannelMembersForUserPage(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	Client := th.Client
+
+	var channelIds []string
+	cursor := ""
+	for {
+		page, resp := Client.GetChannelMembersForUserPage(th.BasicUser.Id, th.BasicTeam.Id, model.CHANNEL_MEMBERS_SORT_UNREAD, cursor, 4)
+		CheckNoError(t, resp)
+		require.LessOrEqual(t, len(page.Members), 4)
+		for _, member := range page.Members {
+			channelIds = append(channelIds, member.ChannelId)
+		}
+		if !page.HasMore {
+			require.Empty(t, page.NextToken)
+			break
+		}
+		require.NotEmpty(t, page.NextToken)
+		cursor = page.NextToken
+	}
+
+	members, resp := Client.GetChannelMembersForUser(th.BasicUser.Id, th.BasicTeam.Id, "")
+	CheckNoError(t, resp)
+	require.Len(t, channelIds, len(*members))
+	for _, member := range *members {
+		require.Contains(t, channelIds, member.ChannelId)
+	}
+
+	t.Run("invalid sort", func(t *testing.T) {
+		_, resp := Client.GetChannelMembersForUserPage(th.BasicUser.Id, th.BasicTeam.Id, "junk", "", 4)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		_, resp := Client.GetChannelMembersForUserPage(th.BasicUser.Id, th.BasicTeam.Id, model.CHANNEL_MEMBERS_SORT_UNREAD, "junk", 4)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("cursor of another sort", func(t *testing.T) {
+		page, resp := Client.GetChannelMembersForUserPage(th.BasicUser.Id, th.BasicTeam.Id, model.CHANNEL_MEMBERS_SORT_LAST_VIEWED_AT, "", 1)
+		CheckNoError(t, resp)
+		require.True(t, page.HasMore)
+
+		_, resp = Client.GetChannelMembersForUserPage(th.BasicUser.Id, th.BasicTeam.Id, model.CHANNEL_MEMBERS_SORT_UNREAD, page.NextToken, 1)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("other user", func(t *testing.T) {
+		_, resp := Client.GetChannelMembersForUserPage(th.BasicUser2.Id, th.BasicTeam.Id, model.CHANNEL_MEMBERS_SORT_UNREAD, "", 4)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp = th.SystemAdminClient.GetChannelMembersForUserPage(th.BasicUser.Id, th.BasicTeam.Id, model.CHANNEL_MEMBERS_SORT_UNREAD, "", 4)
+		CheckNoError(t, resp)
+	})
+}
+
 func TestViewChannel(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -2121,6 +2211,53 @@ func TestGetChannelStats(t *testing.T) {
 	CheckNoError(t, resp)
 }
 
+func TestGetChannelChanges(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	Client := th.Client
+
+	changes, resp := Client.GetChannelChanges(th.BasicChannel.Id, 0)
+	CheckNoError(t, resp)
+	sinceSeq := changes.LastSeq
+	require.NotZero(t, sinceSeq)
+
+	newPost := th.CreatePost()
+	assert.Equal(t, sinceSeq+1, newPost.ChangeSeq)
+
+	editedPost := th.BasicPost.Clone()
+	editedPost.Message = "edited message"
+	editedPost, resp = Client.UpdatePost(editedPost.Id, editedPost)
+	CheckNoError(t, resp)
+	assert.Equal(t, sinceSeq+2, editedPost.ChangeSeq)
+
+	_, resp = Client.DeletePost(newPost.Id)
+	CheckNoError(t, resp)
+
+	changes, resp = Client.GetChannelChanges(th.BasicChannel.Id, sinceSeq)
+	CheckNoError(t, resp)
+	require.Len(t, changes.Changes, 2)
+	assert.Equal(t, editedPost.Id, changes.Changes[0].PostId)
+	assert.Equal(t, model.CHANNEL_CHANGE_POST_EDITED, changes.Changes[0].Type)
+	assert.Equal(t, newPost.Id, changes.Changes[1].PostId)
+	assert.Equal(t, model.CHANNEL_CHANGE_POST_DELETED, changes.Changes[1].Type)
+	assert.Equal(t, sinceSeq+3, changes.LastSeq)
+	assert.False(t, changes.HasMore)
+	require.Len(t, changes.Posts, 1)
+	assert.Equal(t, "edited message", changes.Posts[editedPost.Id].Message)
+
+	changes, resp = Client.GetChannelChanges(th.BasicChannel.Id, changes.LastSeq)
+	CheckNoError(t, resp)
+	assert.Empty(t, changes.Changes)
+	assert.Equal(t, sinceSeq+3, changes.LastSeq)
+
+	_, resp = Client.GetChannelChanges(th.BasicChannel.Id, -1)
+	CheckBadRequestStatus(t, resp)
+
+	privateChannel := th.CreateChannelWithClient(th.SystemAdminClient, model.CHANNEL_PRIVATE)
+	_, resp = Client.GetChannelChanges(privateChannel.Id, 0)
+	CheckForbiddenStatus(t, resp)
+}
+
 func TestGetPinnedPosts(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -3179,6 +3316,32 @@ func TestAutocompleteChannelsForSearchGuestUsers(t *testing.T) {
 	}
 }
 
+func TestUpdateChannelPostingRestrictions(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	restrictions := &model.PostingRestrictions{RestrictFileUploads: model.NewBool(true)}
+
+	_, resp := th.Client.UpdateChannelPostingRestrictions(th.BasicChannel.Id, restrictions)
+	CheckForbiddenStatus(t, resp)
+
+	channel, resp := th.SystemAdminClient.UpdateChannelPostingRestrictions(th.BasicChannel.Id, restrictions)
+	CheckNoError(t, resp)
+	require.True(t, channel.IsFileUploadRestricted())
+	require.False(t, channel.IsCustomEmojiRestricted())
+
+	channel, resp = th.Client.GetChannel(th.BasicChannel.Id, "")
+	CheckNoError(t, resp)
+	require.True(t, channel.IsFileUploadRestricted())
+
+	_, resp = th.Client.UploadFile([]byte("data"), th.BasicChannel.Id, "test.txt")
+	CheckForbiddenStatus(t, resp)
+	CheckErrorMessage(t, resp, "app.posting_restrictions.file_uploads.channel.app_error")
+
+	_, resp = th.SystemAdminClient.UpdateChannelPostingRestrictions(model.NewId(), restrictions)
+	CheckNotFoundStatus(t, resp)
+}
+
 func TestUpdateChannelScheme(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
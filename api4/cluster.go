@@ -6,11 +6,14 @@ package api4
 import (
 	"net/http"
 
+	"github.com/mattermost/mattermost-server/v5/audit"
 	"github.com/mattermost/mattermost-server/v5/model"
 )
 
 func (api *API) InitCluster() {
 	api.BaseRoutes.Cluster.Handle("/status", api.ApiSessionRequired(getClusterStatus)).Methods("GET")
+	api.BaseRoutes.Cluster.Handle("/websocket_connections", api.ApiSessionRequired(getWebSocketConnections)).Methods("GET")
+	api.BaseRoutes.Cluster.Handle("/websocket_connections/{connection_id:[A-Za-z0-9]+}", api.ApiSessionRequired(disconnectWebSocketConnection)).Methods("DELETE")
 }
 
 func getClusterStatus(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -27,3 +30,49 @@ func getClusterStatus(c *Context, w http.ResponseWriter, r *http.Request) {
 	infos := c.App.GetClusterStatus()
 	w.Write([]byte(model.ClusterInfosToJson(infos)))
 }
+
+func getWebSocketConnections(c *Context, w http.ResponseWriter, r *http.Request) {
+	userId := r.URL.Query().Get("user_id")
+	if userId != "" && !model.IsValidId(userId) {
+		c.SetInvalidParam("user_id")
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	infos, err := c.App.GetClusterWebConnections(userId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(model.WebConnInfosToJson(infos)))
+}
+
+func disconnectWebSocketConnection(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireConnectionId()
+	if c.Err != nil {
+		return
+	}
+	connectionId := c.Params.ConnectionId
+
+	auditRec := c.MakeAuditRecord("disconnectWebSocketConnection", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("connection_id", connectionId)
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	if err := c.App.DisconnectWebConnection(connectionId); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	ReturnStatusOK(w)
+}
@@ -33,3 +33,29 @@ func TestGetClusterStatus(t *testing.T) {
 		CheckForbiddenStatus(t, resp)
 	})
 }
+
+func TestWebSocketConnections(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("as system user", func(t *testing.T) {
+		_, resp := th.Client.GetWebSocketConnections(th.BasicUser.Id)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp = th.Client.DisconnectWebSocketConnection(model.NewId())
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("as system admin", func(t *testing.T) {
+		infos, resp := th.SystemAdminClient.GetWebSocketConnections(th.BasicUser.Id)
+		CheckNoError(t, resp)
+		require.NotNil(t, infos)
+
+		_, resp = th.SystemAdminClient.GetWebSocketConnections("junk")
+		CheckBadRequestStatus(t, resp)
+
+		ok, resp := th.SystemAdminClient.DisconnectWebSocketConnection(model.NewId())
+		CheckNotFoundStatus(t, resp)
+		require.False(t, ok)
+	})
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestVerifyChannelIntegrity(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	_, resp := th.SystemAdminClient.VerifyChannelIntegrity(th.BasicChannel.Id)
+	CheckNotImplementedStatus(t, resp)
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ComplianceSettings.EnablePostIntegrity = true })
+
+	th.CreatePost()
+
+	report, resp := th.SystemAdminClient.VerifyChannelIntegrity(th.BasicChannel.Id)
+	CheckNoError(t, resp)
+	require.NotNil(t, report)
+	assert.Equal(t, th.BasicChannel.Id, report.ChannelId)
+	assert.True(t, report.Valid)
+	assert.Equal(t, int64(1), report.EntriesChecked)
+
+	_, resp = th.Client.VerifyChannelIntegrity(th.BasicChannel.Id)
+	CheckForbiddenStatus(t, resp)
+
+	_, resp = th.SystemAdminClient.VerifyChannelIntegrity(model.NewId())
+	CheckNotFoundStatus(t, resp)
+}
@@ -5,10 +5,21 @@ package api4
 
 import (
 	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/audit"
+	"github.com/mattermost/mattermost-server/v5/model"
 )
 
 func (api *API) InitDataRetention() {
 	api.BaseRoutes.DataRetention.Handle("/policy", api.ApiSessionRequired(getPolicy)).Methods("GET")
+	api.BaseRoutes.DataRetentionPolicies.Handle("", api.ApiSessionRequired(getRetentionPolicies)).Methods("GET")
+	api.BaseRoutes.DataRetentionPolicies.Handle("", api.ApiSessionRequired(createRetentionPolicy)).Methods("POST")
+	api.BaseRoutes.DataRetentionPolicy.Handle("", api.ApiSessionRequired(getRetentionPolicy)).Methods("GET")
+	api.BaseRoutes.DataRetentionPolicy.Handle("", api.ApiSessionRequired(updateRetentionPolicy)).Methods("PUT")
+	api.BaseRoutes.DataRetentionPolicy.Handle("", api.ApiSessionRequired(deleteRetentionPolicy)).Methods("DELETE")
+	api.BaseRoutes.DataRetentionPolicy.Handle("/teams", api.ApiSessionRequired(getTeamsForRetentionPolicy)).Methods("GET")
+	api.BaseRoutes.Team.Handle("/data_retention_policy", api.ApiSessionRequired(getRetentionPolicyForTeam)).Methods("GET")
+	api.BaseRoutes.Team.Handle("/data_retention_policy", api.ApiSessionRequired(setRetentionPolicyForTeam)).Methods("PUT")
 }
 
 func getPolicy(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -22,3 +33,200 @@ func getPolicy(c *Context, w http.ResponseWriter, r *http.Request) {
 
 	w.Write([]byte(policy.ToJson()))
 }
+
+func getRetentionPolicies(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	policies, err := c.App.GetRetentionPolicies(c.Params.Page, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(model.RetentionPolicyListToJson(policies)))
+}
+
+func getRetentionPolicy(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePolicyId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	policy, err := c.App.GetRetentionPolicy(c.Params.PolicyId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(policy.ToJson()))
+}
+
+func createRetentionPolicy(c *Context, w http.ResponseWriter, r *http.Request) {
+	policy := model.RetentionPolicyFromJson(r.Body)
+	if policy == nil {
+		c.SetInvalidParam("policy")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("createRetentionPolicy", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("policy", policy)
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	createdPolicy, err := c.App.CreateRetentionPolicy(policy)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("policy_id", createdPolicy.Id)
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte(createdPolicy.ToJson()))
+}
+
+func updateRetentionPolicy(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePolicyId()
+	if c.Err != nil {
+		return
+	}
+
+	policy := model.RetentionPolicyFromJson(r.Body)
+	if policy == nil {
+		c.SetInvalidParam("policy")
+		return
+	}
+
+	// The policy_id in the URL overrides any one in the body.
+	policy.Id = c.Params.PolicyId
+
+	auditRec := c.MakeAuditRecord("updateRetentionPolicy", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("policy", policy)
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	updatedPolicy, err := c.App.UpdateRetentionPolicy(policy)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	w.Write([]byte(updatedPolicy.ToJson()))
+}
+
+func deleteRetentionPolicy(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePolicyId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteRetentionPolicy", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("policy_id", c.Params.PolicyId)
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	if err := c.App.DeleteRetentionPolicy(c.Params.PolicyId); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	ReturnStatusOK(w)
+}
+
+func getTeamsForRetentionPolicy(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePolicyId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	teams, err := c.App.GetTeamsForRetentionPolicy(c.Params.PolicyId, c.Params.Page, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(model.TeamListToJson(teams)))
+}
+
+func getRetentionPolicyForTeam(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	policy, err := c.App.GetRetentionPolicyForTeam(c.Params.TeamId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(policy.ToJson()))
+}
+
+func setRetentionPolicyForTeam(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	props := model.MapFromJson(r.Body)
+	policyId := props["policy_id"]
+	if policyId != "" && !model.IsValidId(policyId) {
+		c.SetInvalidParam("policy_id")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("setRetentionPolicyForTeam", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("team_id", c.Params.TeamId)
+	auditRec.AddMeta("policy_id", policyId)
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	if err := c.App.SetRetentionPolicyForTeam(c.Params.TeamId, policyId); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	ReturnStatusOK(w)
+}
@@ -5,6 +5,10 @@ package api4
 
 import (
 	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
 )
 
 func TestDataRetentionGetPolicy(t *testing.T) {
@@ -14,3 +18,148 @@ func TestDataRetentionGetPolicy(t *testing.T) {
 	_, resp := th.Client.GetDataRetentionPolicy()
 	CheckNotImplementedStatus(t, resp)
 }
+
+func TestRetentionPolicies(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	newPolicy := func() *model.RetentionPolicy {
+		return &model.RetentionPolicy{
+			DisplayName:  "legal",
+			PostDuration: 30,
+			FileDuration: model.RETENTION_POLICY_KEEP_FOREVER,
+			TeamIds:      []string{th.BasicTeam.Id},
+			ChannelIds:   []string{th.BasicChannel.Id},
+		}
+	}
+
+	t.Run("should require a license", func(t *testing.T) {
+		_, resp := th.SystemAdminClient.CreateRetentionPolicy(newPolicy())
+		CheckNotImplementedStatus(t, resp)
+	})
+
+	th.App.Srv().SetLicense(model.NewTestLicense("data_retention"))
+
+	t.Run("should require permission to manage the system", func(t *testing.T) {
+		_, resp := th.Client.CreateRetentionPolicy(newPolicy())
+		CheckForbiddenStatus(t, resp)
+
+		_, resp = th.Client.GetRetentionPolicies(0, 10)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	policy, resp := th.SystemAdminClient.CreateRetentionPolicy(newPolicy())
+	CheckNoError(t, resp)
+	CheckCreatedStatus(t, resp)
+	require.NotEmpty(t, policy.Id)
+	require.Equal(t, []string{th.BasicTeam.Id}, policy.TeamIds)
+
+	t.Run("should get policies", func(t *testing.T) {
+		fetched, resp := th.SystemAdminClient.GetRetentionPolicy(policy.Id)
+		CheckNoError(t, resp)
+		require.Equal(t, policy, fetched)
+
+		policies, resp := th.SystemAdminClient.GetRetentionPolicies(0, 10)
+		CheckNoError(t, resp)
+		require.Len(t, policies, 1)
+
+		_, resp = th.SystemAdminClient.GetRetentionPolicy(model.NewId())
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("should reject a channel that belongs to another policy", func(t *testing.T) {
+		other := newPolicy()
+		other.TeamIds = nil
+		_, resp := th.SystemAdminClient.CreateRetentionPolicy(other)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("should reject unknown channels", func(t *testing.T) {
+		other := newPolicy()
+		other.TeamIds = nil
+		other.ChannelIds = []string{model.NewId()}
+		_, resp := th.SystemAdminClient.CreateRetentionPolicy(other)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("should update policy", func(t *testing.T) {
+		policy.PostDuration = 10
+		policy.ChannelIds = []string{th.BasicChannel2.Id}
+
+		updated, resp := th.SystemAdminClient.UpdateRetentionPolicy(policy)
+		CheckNoError(t, resp)
+		require.Equal(t, int64(10), updated.PostDuration)
+		require.Equal(t, []string{th.BasicChannel2.Id}, updated.ChannelIds)
+		require.Equal(t, policy.CreateAt, updated.CreateAt)
+	})
+
+	t.Run("should delete policy", func(t *testing.T) {
+		ok, resp := th.SystemAdminClient.DeleteRetentionPolicy(policy.Id)
+		CheckNoError(t, resp)
+		require.True(t, ok)
+
+		_, resp = th.SystemAdminClient.GetRetentionPolicy(policy.Id)
+		CheckNotFoundStatus(t, resp)
+	})
+}
+
+func TestRetentionPolicyForTeam(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.Srv().SetLicense(model.NewTestLicense("data_retention"))
+
+	policy, resp := th.SystemAdminClient.CreateRetentionPolicy(&model.RetentionPolicy{
+		DisplayName:  "legal",
+		PostDuration: 30,
+		FileDuration: model.RETENTION_POLICY_KEEP_FOREVER,
+	})
+	CheckNoError(t, resp)
+
+	t.Run("should require permission to manage the system", func(t *testing.T) {
+		_, resp := th.Client.SetRetentionPolicyForTeam(th.BasicTeam.Id, policy.Id)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp = th.Client.GetRetentionPolicyForTeam(th.BasicTeam.Id)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp = th.Client.GetTeamsForRetentionPolicy(policy.Id, 0, 10)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("should set the policy of the team", func(t *testing.T) {
+		_, resp := th.SystemAdminClient.GetRetentionPolicyForTeam(th.BasicTeam.Id)
+		CheckNotFoundStatus(t, resp)
+
+		ok, resp := th.SystemAdminClient.SetRetentionPolicyForTeam(th.BasicTeam.Id, policy.Id)
+		CheckNoError(t, resp)
+		require.True(t, ok)
+
+		teamPolicy, resp := th.SystemAdminClient.GetRetentionPolicyForTeam(th.BasicTeam.Id)
+		CheckNoError(t, resp)
+		require.Equal(t, policy.Id, teamPolicy.Id)
+		require.Equal(t, []string{th.BasicTeam.Id}, teamPolicy.TeamIds)
+
+		teams, resp := th.SystemAdminClient.GetTeamsForRetentionPolicy(policy.Id, 0, 10)
+		CheckNoError(t, resp)
+		require.Len(t, teams, 1)
+		require.Equal(t, th.BasicTeam.Id, teams[0].Id)
+	})
+
+	t.Run("should fail for a missing policy", func(t *testing.T) {
+		_, resp := th.SystemAdminClient.SetRetentionPolicyForTeam(th.BasicTeam.Id, model.NewId())
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("should remove the policy of the team", func(t *testing.T) {
+		_, resp := th.SystemAdminClient.SetRetentionPolicyForTeam(th.BasicTeam.Id, "")
+		CheckNoError(t, resp)
+
+		_, resp = th.SystemAdminClient.GetRetentionPolicyForTeam(th.BasicTeam.Id)
+		CheckNotFoundStatus(t, resp)
+
+		teams, resp := th.SystemAdminClient.GetTeamsForRetentionPolicy(policy.Id, 0, 10)
+		CheckNoError(t, resp)
+		require.Empty(t, teams)
+	})
+}
@@ -0,0 +1,29 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func (api *API) InitEmailVerification() {
+	api.BaseRoutes.Users.Handle("/unverified_emails", api.ApiSessionRequired(getUnverifiedUsers)).Methods("GET")
+}
+
+func getUnverifiedUsers(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_SYSCONSOLE_READ_USER_MANAGEMENT) {
+		c.SetPermissionError(model.PERMISSION_SYSCONSOLE_READ_USER_MANAGEMENT)
+		return
+	}
+
+	users, err := c.App.GetUnverifiedUsers(c.Params.Page, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(model.UnverifiedUserListToJson(users)))
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestEmailVerificationGracePeriod(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.TeamSettings.EnableOpenServer = true
+		*cfg.EmailSettings.RequireEmailVerification = true
+		*cfg.EmailSettings.EmailVerificationGracePeriodDays = 0
+	})
+
+	user, resp := th.Client.CreateUser(&model.User{Email: th.GenerateTestEmail(), Username: GenerateTestUsername(), Password: "Pa$$word11"})
+	CheckNoError(t, resp)
+	require.False(t, user.EmailVerified)
+
+	client := th.CreateClient()
+
+	t.Run("should not log in without a grace period", func(t *testing.T) {
+		_, resp := client.Login(user.Email, "Pa$$word11")
+		CheckUnauthorizedStatus(t, resp)
+		CheckErrorMessage(t, resp, "api.user.login.not_verified.app_error")
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.EmailSettings.EmailVerificationGracePeriodDays = 7 })
+
+	t.Run("should log in within the grace period", func(t *testing.T) {
+		_, resp := client.Login(user.Email, "Pa$$word11")
+		CheckNoError(t, resp)
+
+		verification, err := th.App.Srv().Store.EmailVerification().Get(user.Id)
+		require.Nil(t, err)
+		assert.InDelta(t, model.GetMillis()+7*24*60*60*1000, verification.Deadline, 60*1000)
+	})
+
+	t.Run("should report the unverified users", func(t *testing.T) {
+		_, resp := th.Client.GetUnverifiedUsers(0, 200)
+		CheckForbiddenStatus(t, resp)
+
+		users, resp := th.SystemAdminClient.GetUnverifiedUsers(0, 200)
+		CheckNoError(t, resp)
+
+		var found *model.UnverifiedUser
+		for _, unverifiedUser := range users {
+			if unverifiedUser.UserId == user.Id {
+				found = unverifiedUser
+			}
+		}
+		require.NotNil(t, found)
+		assert.NotZero(t, found.Deadline)
+		assert.Zero(t, found.LockedAt)
+	})
+
+	t.Run("should lock the account once the deadline passed", func(t *testing.T) {
+		verification, err := th.App.Srv().Store.EmailVerification().Get(user.Id)
+		require.Nil(t, err)
+		verification.Deadline = verification.CreateAt
+		_, err = th.App.Srv().Store.EmailVerification().Update(verification)
+		require.Nil(t, err)
+
+		require.Nil(t, th.App.EnforceEmailVerificationGracePeriod())
+
+		verification, err = th.App.Srv().Store.EmailVerification().Get(user.Id)
+		require.Nil(t, err)
+		assert.NotZero(t, verification.LockedAt)
+
+		_, resp := client.GetMe("")
+		CheckUnauthorizedStatus(t, resp)
+
+		_, resp = client.Login(user.Email, "Pa$$word11")
+		CheckUnauthorizedStatus(t, resp)
+		CheckErrorMessage(t, resp, "api.user.login.email_verification_overdue.app_error")
+	})
+
+	t.Run("should unlock the account once the email is verified", func(t *testing.T) {
+		require.Nil(t, th.App.VerifyUserEmail(user.Id, user.Email))
+
+		_, err := th.App.Srv().Store.EmailVerification().Get(user.Id)
+		require.NotNil(t, err)
+
+		_, resp := client.Login(user.Email, "Pa$$word11")
+		CheckNoError(t, resp)
+	})
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/audit"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func (api *API) InitEmbed() {
+	api.BaseRoutes.Embed.Handle("/apps/{app_id:[A-Za-z0-9]+}/config", api.ApiHandler(getEmbedConfig)).Methods("GET")
+	api.BaseRoutes.Embed.Handle("/tokens", api.ApiSessionRequired(createEmbedToken)).Methods("POST")
+	api.BaseRoutes.Embed.Handle("/tokens/exchange", api.ApiHandler(exchangeEmbedToken)).Methods("POST")
+}
+
+func getEmbedConfig(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireAppId()
+	if c.Err != nil {
+		return
+	}
+
+	embedConfig, err := c.App.GetEmbedConfig(c.Params.AppId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(embedConfig.ToJson()))
+}
+
+func createEmbedToken(c *Context, w http.ResponseWriter, r *http.Request) {
+	props := model.MapFromJson(r.Body)
+	channelId := props["channel_id"]
+	if !model.IsValidId(channelId) {
+		c.SetInvalidParam("channel_id")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("createEmbedToken", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("channel_id", channelId)
+
+	if !c.App.SessionHasPermissionToChannel(*c.App.Session(), channelId, model.PERMISSION_READ_CHANNEL) {
+		c.SetPermissionError(model.PERMISSION_READ_CHANNEL)
+		return
+	}
+
+	embedToken, err := c.App.CreateEmbedToken(c.App.Session(), channelId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("app_id", embedToken.AppId)
+	auditRec.AddMeta("expires_at", embedToken.ExpiresAt)
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte(embedToken.ToJson()))
+}
+
+func exchangeEmbedToken(c *Context, w http.ResponseWriter, r *http.Request) {
+	props := model.MapFromJson(r.Body)
+	token := props["token"]
+	if len(token) != model.TOKEN_SIZE {
+		c.SetInvalidParam("token")
+		return
+	}
+
+	// The origin of the parent application is the one the browser vouches for, which the holder
+	// of the token can't choose.
+	origin := r.Header.Get("Origin")
+	if !model.IsValidEmbedOrigin(origin) {
+		c.SetInvalidParam("origin")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("exchangeEmbedToken", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("origin", origin)
+
+	session, err := c.App.ExchangeEmbedToken(token, origin)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("session_id", session.Id)
+	auditRec.AddMeta("channel_id", session.EmbedChannelId())
+	auditRec.AddMeta("expires_at", session.ExpiresAt)
+	c.LogAuditWithUserId(session.UserId, "embed token exchanged - session_id="+session.Id)
+
+	w.Header().Set(model.HEADER_TOKEN, session.Token)
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte(session.ToJson()))
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestEmbedTokens(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableOAuthServiceProvider = true
+		*cfg.ServiceSettings.EnableEmbedding = true
+	})
+
+	parentOrigin := "https://parent.example.com"
+	oapp, resp := th.SystemAdminClient.CreateOAuthApp(&model.OAuthApp{
+		Name:                GenerateTestAppName(),
+		Homepage:            "https://nowhere.com",
+		CallbackUrls:        []string{"https://nowhere.com"},
+		EmbedFrameAncestors: []string{parentOrigin},
+	})
+	CheckNoError(t, resp)
+
+	oauthSession, appErr := th.App.GetOAuthAccessTokenForImplicitFlow(th.BasicUser.Id, &model.AuthorizeRequest{
+		ResponseType: model.IMPLICIT_RESPONSE_TYPE,
+		ClientId:     oapp.Id,
+		RedirectUri:  oapp.CallbackUrls[0],
+		State:        "123",
+	})
+	require.Nil(t, appErr)
+
+	oauthClient := th.CreateClient()
+	oauthClient.AuthToken = oauthSession.Token
+	oauthClient.AuthType = model.HEADER_BEARER
+
+	t.Run("config", func(t *testing.T) {
+		embedConfig, resp := th.CreateClient().GetEmbedConfig(oapp.Id)
+		CheckNoError(t, resp)
+		require.Equal(t, []string{parentOrigin}, embedConfig.FrameAncestors)
+	})
+
+	t.Run("frame ancestors set by a system admin only", func(t *testing.T) {
+		userApp, resp := th.Client.CreateOAuthApp(&model.OAuthApp{
+			Name:                GenerateTestAppName(),
+			Homepage:            "https://nowhere.com",
+			CallbackUrls:        []string{"https://nowhere.com"},
+			EmbedFrameAncestors: []string{parentOrigin},
+		})
+		CheckNoError(t, resp)
+		require.Empty(t, userApp.EmbedFrameAncestors)
+
+		_, resp = th.CreateClient().GetEmbedConfig(userApp.Id)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("not an oauth session", func(t *testing.T) {
+		_, resp := th.Client.CreateEmbedToken(th.BasicChannel.Id)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("channel without access", func(t *testing.T) {
+		channel := th.CreateChannelWithClient(th.SystemAdminClient, model.CHANNEL_PRIVATE)
+
+		_, resp := oauthClient.CreateEmbedToken(channel.Id)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("origin not allowed", func(t *testing.T) {
+		embedToken, resp := oauthClient.CreateEmbedToken(th.BasicChannel.Id)
+		CheckNoError(t, resp)
+
+		_, resp = th.CreateClient().ExchangeEmbedToken(embedToken.Token, "https://other.example.com")
+		CheckForbiddenStatus(t, resp)
+
+		// The token is consumed by the failed attempt.
+		_, resp = th.CreateClient().ExchangeEmbedToken(embedToken.Token, parentOrigin)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("origin only taken from the header", func(t *testing.T) {
+		embedToken, resp := oauthClient.CreateEmbedToken(th.BasicChannel.Id)
+		CheckNoError(t, resp)
+
+		client := th.CreateClient()
+		r, err := client.DoApiPost(client.GetEmbedRoute()+"/tokens/exchange", model.MapToJson(map[string]string{"token": embedToken.Token, "origin": parentOrigin}))
+		require.NotNil(t, err)
+		require.Equal(t, http.StatusBadRequest, r.StatusCode)
+	})
+
+	t.Run("session restricted to the channel", func(t *testing.T) {
+		embedToken, resp := oauthClient.CreateEmbedToken(th.BasicChannel.Id)
+		CheckNoError(t, resp)
+		CheckCreatedStatus(t, resp)
+		require.Equal(t, oapp.Id, embedToken.AppId)
+		require.Equal(t, th.BasicChannel.Id, embedToken.ChannelId)
+
+		session, resp := th.CreateClient().ExchangeEmbedToken(embedToken.Token, parentOrigin)
+		CheckNoError(t, resp)
+		CheckCreatedStatus(t, resp)
+		require.Equal(t, th.BasicUser.Id, session.UserId)
+		require.Equal(t, th.BasicChannel.Id, session.EmbedChannelId())
+
+		_, resp = th.CreateClient().ExchangeEmbedToken(embedToken.Token, parentOrigin)
+		CheckBadRequestStatus(t, resp)
+
+		client := th.CreateClient()
+		client.AuthToken = session.Token
+		client.AuthType = model.HEADER_BEARER
+
+		_, resp = client.GetChannel(th.BasicChannel.Id, "")
+		CheckNoError(t, resp)
+
+		_, resp = client.CreatePost(&model.Post{ChannelId: th.BasicChannel.Id, Message: "message"})
+		CheckNoError(t, resp)
+
+		_, resp = client.CreatePost(&model.Post{ChannelId: th.BasicChannel2.Id, Message: "message"})
+		CheckForbiddenStatus(t, resp)
+
+		_, resp = client.GetChannel(th.BasicChannel2.Id, "")
+		CheckForbiddenStatus(t, resp)
+
+		_, resp = client.GetTeam(th.BasicTeam.Id, "")
+		CheckForbiddenStatus(t, resp)
+
+		_, resp = client.CreateEmbedToken(th.BasicChannel.Id)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableEmbedding = false })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableEmbedding = true })
+
+		_, resp := oauthClient.CreateEmbedToken(th.BasicChannel.Id)
+		CheckNotImplementedStatus(t, resp)
+
+		_, resp = th.CreateClient().GetEmbedConfig(oapp.Id)
+		CheckNotImplementedStatus(t, resp)
+	})
+}
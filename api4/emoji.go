@@ -51,28 +51,6 @@ func createEmoji(c *Context, w http.ResponseWriter, r *http.Request) {
 	auditRec := c.MakeAuditRecord("createEmoji", audit.Fail)
 	defer c.LogAuditRec(auditRec)
 
-	// Allow any user with CREATE_EMOJIS permission at Team level to create emojis at system level
-	memberships, err := c.App.GetTeamMembersForUser(c.App.Session().UserId)
-
-	if err != nil {
-		c.Err = err
-		return
-	}
-
-	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_CREATE_EMOJIS) {
-		hasPermission := false
-		for _, membership := range memberships {
-			if c.App.SessionHasPermissionToTeam(*c.App.Session(), membership.TeamId, model.PERMISSION_CREATE_EMOJIS) {
-				hasPermission = true
-				break
-			}
-		}
-		if !hasPermission {
-			c.SetPermissionError(model.PERMISSION_CREATE_EMOJIS)
-			return
-		}
-	}
-
 	m := r.MultipartForm
 	props := m.Value
 
@@ -89,6 +67,14 @@ func createEmoji(c *Context, w http.ResponseWriter, r *http.Request) {
 
 	auditRec.AddMeta("emoji", emoji)
 
+	if hasPermission, err := hasEmojiPermission(c, emoji.TeamId, model.PERMISSION_CREATE_EMOJIS); err != nil {
+		c.Err = err
+		return
+	} else if !hasPermission {
+		c.SetPermissionError(model.PERMISSION_CREATE_EMOJIS)
+		return
+	}
+
 	newEmoji, err := c.App.CreateEmoji(c.App.Session().UserId, emoji, m)
 	if err != nil {
 		c.Err = err
@@ -111,7 +97,7 @@ func getEmojiList(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	listEmoji, err := c.App.GetEmojiList(c.Params.Page, c.Params.PerPage, sort)
+	listEmoji, err := c.App.GetEmojiListForUser(c.App.Session().UserId, c.Params.Page, c.Params.PerPage, sort)
 	if err != nil {
 		c.Err = err
 		return
@@ -137,42 +123,21 @@ func deleteEmoji(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 	auditRec.AddMeta("emoji", emoji)
 
-	// Allow any user with DELETE_EMOJIS permission at Team level to delete emojis at system level
-	memberships, err := c.App.GetTeamMembersForUser(c.App.Session().UserId)
-
-	if err != nil {
-		c.Err = err
+	if hasPermission, appErr := hasEmojiPermission(c, emoji.TeamId, model.PERMISSION_DELETE_EMOJIS); appErr != nil {
+		c.Err = appErr
+		return
+	} else if !hasPermission {
+		c.SetPermissionError(model.PERMISSION_DELETE_EMOJIS)
 		return
-	}
-
-	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_DELETE_EMOJIS) {
-		hasPermission := false
-		for _, membership := range memberships {
-			if c.App.SessionHasPermissionToTeam(*c.App.Session(), membership.TeamId, model.PERMISSION_DELETE_EMOJIS) {
-				hasPermission = true
-				break
-			}
-		}
-		if !hasPermission {
-			c.SetPermissionError(model.PERMISSION_DELETE_EMOJIS)
-			return
-		}
 	}
 
 	if c.App.Session().UserId != emoji.CreatorId {
-		if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_DELETE_OTHERS_EMOJIS) {
-			hasPermission := false
-			for _, membership := range memberships {
-				if c.App.SessionHasPermissionToTeam(*c.App.Session(), membership.TeamId, model.PERMISSION_DELETE_OTHERS_EMOJIS) {
-					hasPermission = true
-					break
-				}
-			}
-
-			if !hasPermission {
-				c.SetPermissionError(model.PERMISSION_DELETE_OTHERS_EMOJIS)
-				return
-			}
+		if hasPermission, appErr := hasEmojiPermission(c, emoji.TeamId, model.PERMISSION_DELETE_OTHERS_EMOJIS); appErr != nil {
+			c.Err = appErr
+			return
+		} else if !hasPermission {
+			c.SetPermissionError(model.PERMISSION_DELETE_OTHERS_EMOJIS)
+			return
 		}
 	}
 
@@ -204,6 +169,11 @@ func getEmoji(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if !canSeeEmoji(c, emoji) {
+		c.Err = model.NewAppError("getEmoji", "app.emoji.get.no_result", nil, "id="+emoji.Id, http.StatusNotFound)
+		return
+	}
+
 	w.Write([]byte(emoji.ToJson()))
 }
 
@@ -219,6 +189,11 @@ func getEmojiByName(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if !canSeeEmoji(c, emoji) {
+		c.Err = model.NewAppError("getEmojiByName", "app.emoji.get_by_name.no_result", nil, "name="+emoji.Name, http.StatusNotFound)
+		return
+	}
+
 	w.Write([]byte(emoji.ToJson()))
 }
 
@@ -256,7 +231,7 @@ func searchEmojis(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	emojis, err := c.App.SearchEmoji(emojiSearch.Term, emojiSearch.PrefixOnly, web.PER_PAGE_MAXIMUM)
+	emojis, err := c.App.SearchEmoji(c.App.Session().UserId, emojiSearch.Term, emojiSearch.PrefixOnly, web.PER_PAGE_MAXIMUM)
 	if err != nil {
 		c.Err = err
 		return
@@ -273,7 +248,7 @@ func autocompleteEmojis(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	emojis, err := c.App.SearchEmoji(name, true, EMOJI_MAX_AUTOCOMPLETE_ITEMS)
+	emojis, err := c.App.SearchEmoji(c.App.Session().UserId, name, true, EMOJI_MAX_AUTOCOMPLETE_ITEMS)
 	if err != nil {
 		c.Err = err
 		return
@@ -281,3 +256,34 @@ func autocompleteEmojis(c *Context, w http.ResponseWriter, r *http.Request) {
 
 	w.Write([]byte(model.EmojiListToJson(emojis)))
 }
+
+// hasEmojiPermission returns whether the session has the emoji permission for the emoji of the team, or for the
+// emoji available to everyone when teamId is empty. The emoji available to everyone may be managed by any user
+// holding the permission in one of their teams.
+func hasEmojiPermission(c *Context, teamId string, permission *model.Permission) (bool, *model.AppError) {
+	if teamId != "" {
+		return c.App.SessionHasPermissionToTeam(*c.App.Session(), teamId, permission), nil
+	}
+
+	if c.App.SessionHasPermissionTo(*c.App.Session(), permission) {
+		return true, nil
+	}
+
+	memberships, err := c.App.GetTeamMembersForUser(c.App.Session().UserId)
+	if err != nil {
+		return false, err
+	}
+
+	for _, membership := range memberships {
+		if c.App.SessionHasPermissionToTeam(*c.App.Session(), membership.TeamId, permission) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// canSeeEmoji returns whether the emoji is available to everyone or to the members of a team the session can view.
+func canSeeEmoji(c *Context, emoji *model.Emoji) bool {
+	return emoji.TeamId == "" || c.App.SessionHasPermissionToTeam(*c.App.Session(), emoji.TeamId, model.PERMISSION_VIEW_TEAM)
+}
@@ -450,6 +450,97 @@ func TestGetEmojiByName(t *testing.T) {
 	CheckUnauthorizedStatus(t, resp)
 }
 
+func TestTeamEmoji(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	Client := th.Client
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableCustomEmoji = true })
+
+	otherTeam := th.CreateTeamWithClient(th.SystemAdminClient)
+
+	teamEmoji, resp := Client.CreateEmoji(&model.Emoji{
+		CreatorId: th.BasicUser.Id,
+		Name:      model.NewId(),
+		TeamId:    th.BasicTeam.Id,
+	}, utils.CreateTestGif(t, 10, 10), "image.gif")
+	CheckNoError(t, resp)
+	assert.Equal(t, th.BasicTeam.Id, teamEmoji.TeamId)
+
+	t.Run("create an emoji for a team the user is not a member of", func(t *testing.T) {
+		_, resp := Client.CreateEmoji(&model.Emoji{
+			CreatorId: th.BasicUser.Id,
+			Name:      model.NewId(),
+			TeamId:    otherTeam.Id,
+		}, utils.CreateTestGif(t, 10, 10), "image.gif")
+		CheckForbiddenStatus(t, resp)
+	})
+
+	otherTeamEmoji, resp := th.SystemAdminClient.CreateEmoji(&model.Emoji{
+		CreatorId: th.SystemAdminUser.Id,
+		Name:      model.NewId(),
+		TeamId:    otherTeam.Id,
+	}, utils.CreateTestGif(t, 10, 10), "image.gif")
+	CheckNoError(t, resp)
+
+	globalEmoji, resp := Client.CreateEmoji(&model.Emoji{
+		CreatorId: th.BasicUser.Id,
+		Name:      model.NewId(),
+	}, utils.CreateTestGif(t, 10, 10), "image.gif")
+	CheckNoError(t, resp)
+
+	t.Run("get the emoji of another team", func(t *testing.T) {
+		_, resp := Client.GetEmoji(otherTeamEmoji.Id)
+		CheckNotFoundStatus(t, resp)
+
+		_, resp = Client.GetEmojiByName(otherTeamEmoji.Name)
+		CheckNotFoundStatus(t, resp)
+
+		emoji, resp := th.SystemAdminClient.GetEmoji(otherTeamEmoji.Id)
+		CheckNoError(t, resp)
+		assert.Equal(t, otherTeam.Id, emoji.TeamId)
+	})
+
+	t.Run("list and search the emoji", func(t *testing.T) {
+		getIds := func(emojis []*model.Emoji, resp *model.Response) []string {
+			CheckNoError(t, resp)
+			ids := []string{}
+			for _, emoji := range emojis {
+				ids = append(ids, emoji.Id)
+			}
+			return ids
+		}
+
+		ids := getIds(Client.GetEmojiList(0, 200))
+		assert.Contains(t, ids, teamEmoji.Id)
+		assert.Contains(t, ids, globalEmoji.Id)
+		assert.NotContains(t, ids, otherTeamEmoji.Id)
+
+		ids = getIds(Client.SearchEmoji(&model.EmojiSearch{Term: otherTeamEmoji.Name}))
+		assert.Empty(t, ids)
+
+		ids = getIds(Client.SearchEmoji(&model.EmojiSearch{Term: teamEmoji.Name}))
+		assert.Equal(t, []string{teamEmoji.Id}, ids)
+	})
+
+	t.Run("react with the emoji of a team", func(t *testing.T) {
+		_, resp := Client.SaveReaction(&model.Reaction{UserId: th.BasicUser.Id, PostId: th.BasicPost.Id, EmojiName: teamEmoji.Name})
+		CheckNoError(t, resp)
+
+		_, resp = Client.SaveReaction(&model.Reaction{UserId: th.BasicUser.Id, PostId: th.BasicPost.Id, EmojiName: otherTeamEmoji.Name})
+		CheckForbiddenStatus(t, resp)
+		CheckErrorMessage(t, resp, "app.emoji.other_team.app_error")
+	})
+
+	t.Run("delete the emoji of another team", func(t *testing.T) {
+		_, resp := Client.DeleteEmoji(otherTeamEmoji.Id)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp = th.SystemAdminClient.DeleteEmoji(otherTeamEmoji.Id)
+		CheckNoError(t, resp)
+	})
+}
+
 func TestGetEmojiImage(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
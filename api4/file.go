@@ -61,6 +61,7 @@ func (api *API) InitFile() {
 	api.BaseRoutes.File.Handle("/link", api.ApiSessionRequired(getFileLink)).Methods("GET")
 	api.BaseRoutes.File.Handle("/preview", api.ApiSessionRequiredTrustRequester(getFilePreview)).Methods("GET")
 	api.BaseRoutes.File.Handle("/info", api.ApiSessionRequired(getFileInfo)).Methods("GET")
+	api.BaseRoutes.File.Handle("/sensitive", api.ApiSessionRequired(updateFileSensitive)).Methods("PUT")
 
 	api.BaseRoutes.PublicFile.Handle("", api.ApiHandler(getPublicFile)).Methods("GET")
 
@@ -477,8 +478,7 @@ func getFile(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 	auditRec.AddMeta("file", info)
 
-	if info.CreatorId != c.App.Session().UserId && !c.App.SessionHasPermissionToChannelByPost(*c.App.Session(), info.PostId, model.PERMISSION_READ_CHANNEL) {
-		c.SetPermissionError(model.PERMISSION_READ_CHANNEL)
+	if !checkFileDownloadPermission(c, info) {
 		return
 	}
 
@@ -490,9 +490,16 @@ func getFile(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 	defer fileReader.Close()
 
+	content, size, err := c.App.RunFileWillBeDownloadedHooks(info, c.App.Session().UserId, fileReader)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
 	auditRec.Success()
+	logSensitiveFileDownload(c, info, "file")
 
-	err = writeFileResponse(info.Name, info.MimeType, info.Size, time.Unix(0, info.UpdateAt*int64(1000*1000)), *c.App.Config().ServiceSettings.WebserverMode, fileReader, forceDownload, w, r)
+	err = writeFileResponse(info.Name, info.MimeType, size, time.Unix(0, info.UpdateAt*int64(1000*1000)), *c.App.Config().ServiceSettings.WebserverMode, content, forceDownload, w, r)
 	if err != nil {
 		c.Err = err
 		return
@@ -512,8 +519,7 @@ func getFileThumbnail(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if info.CreatorId != c.App.Session().UserId && !c.App.SessionHasPermissionToChannelByPost(*c.App.Session(), info.PostId, model.PERMISSION_READ_CHANNEL) {
-		c.SetPermissionError(model.PERMISSION_READ_CHANNEL)
+	if !checkFileDownloadPermission(c, info) {
 		return
 	}
 
@@ -530,6 +536,8 @@ func getFileThumbnail(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 	defer fileReader.Close()
 
+	logSensitiveFileDownload(c, info, "thumbnail")
+
 	err = writeFileResponse(info.Name, THUMBNAIL_IMAGE_TYPE, 0, time.Unix(0, info.UpdateAt*int64(1000*1000)), *c.App.Config().ServiceSettings.WebserverMode, fileReader, forceDownload, w, r)
 	if err != nil {
 		c.Err = err
@@ -558,8 +566,7 @@ func getFileLink(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 	auditRec.AddMeta("file", info)
 
-	if info.CreatorId != c.App.Session().UserId && !c.App.SessionHasPermissionToChannelByPost(*c.App.Session(), info.PostId, model.PERMISSION_READ_CHANNEL) {
-		c.SetPermissionError(model.PERMISSION_READ_CHANNEL)
+	if !checkFileDownloadPermission(c, info) {
 		return
 	}
 
@@ -591,8 +598,7 @@ func getFilePreview(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if info.CreatorId != c.App.Session().UserId && !c.App.SessionHasPermissionToChannelByPost(*c.App.Session(), info.PostId, model.PERMISSION_READ_CHANNEL) {
-		c.SetPermissionError(model.PERMISSION_READ_CHANNEL)
+	if !checkFileDownloadPermission(c, info) {
 		return
 	}
 
@@ -609,6 +615,8 @@ func getFilePreview(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 	defer fileReader.Close()
 
+	logSensitiveFileDownload(c, info, "preview")
+
 	err = writeFileResponse(info.Name, PREVIEW_IMAGE_TYPE, 0, time.Unix(0, info.UpdateAt*int64(1000*1000)), *c.App.Config().ServiceSettings.WebserverMode, fileReader, forceDownload, w, r)
 	if err != nil {
 		c.Err = err
@@ -672,16 +680,96 @@ func getPublicFile(c *Context, w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		c.Err = err
 		c.Err.StatusCode = http.StatusNotFound
+		return
 	}
 	defer fileReader.Close()
 
-	err = writeFileResponse(info.Name, info.MimeType, info.Size, time.Unix(0, info.UpdateAt*int64(1000*1000)), *c.App.Config().ServiceSettings.WebserverMode, fileReader, false, w, r)
+	content, size, err := c.App.RunFileWillBeDownloadedHooks(info, "", fileReader)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	logSensitiveFileDownload(c, info, "public")
+
+	err = writeFileResponse(info.Name, info.MimeType, size, time.Unix(0, info.UpdateAt*int64(1000*1000)), *c.App.Config().ServiceSettings.WebserverMode, content, false, w, r)
 	if err != nil {
 		c.Err = err
 		return
 	}
 }
 
+func updateFileSensitive(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireFileId()
+	if c.Err != nil {
+		return
+	}
+
+	props := model.StringInterfaceFromJson(r.Body)
+	sensitive, ok := props["sensitive"].(bool)
+	if !ok {
+		c.SetInvalidParam("sensitive")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("updateFileSensitive", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("sensitive", sensitive)
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	info, err := c.App.GetFileInfo(c.Params.FileId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+	auditRec.AddMeta("file", info)
+
+	info, err = c.App.SetFileInfoSensitive(info, sensitive)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	w.Write([]byte(info.ToJson()))
+}
+
+// checkFileDownloadPermission verifies the session may download the content of the file, including its thumbnail
+// and preview. Besides reading the channel, this requires the download_file permission, which admins may restrict
+// to specific channel roles. It sets c.Err and returns false otherwise.
+func checkFileDownloadPermission(c *Context, info *model.FileInfo) bool {
+	if info.CreatorId == c.App.Session().UserId {
+		return true
+	}
+
+	if !c.App.SessionHasPermissionToChannelByPost(*c.App.Session(), info.PostId, model.PERMISSION_READ_CHANNEL) {
+		c.SetPermissionError(model.PERMISSION_READ_CHANNEL)
+		return false
+	}
+
+	if !c.App.SessionHasPermissionToChannelByPost(*c.App.Session(), info.PostId, model.PERMISSION_DOWNLOAD_FILE) {
+		c.SetPermissionError(model.PERMISSION_DOWNLOAD_FILE)
+		return false
+	}
+
+	return true
+}
+
+// logSensitiveFileDownload keeps a persistent audit trail of every download of a file marked as sensitive,
+// regardless of how the audit log is configured.
+func logSensitiveFileDownload(c *Context, info *model.FileInfo, variant string) {
+	if !info.Sensitive {
+		return
+	}
+
+	c.LogAudit("sensitive_file_download file_id=" + info.Id + " post_id=" + info.PostId + " variant=" + variant)
+}
+
 func writeFileResponse(filename string, contentType string, contentSize int64, lastModification time.Time, webserverMode string, fileReader io.ReadSeeker, forceDownload bool, w http.ResponseWriter, r *http.Request) *model.AppError {
 	w.Header().Set("Cache-Control", "private, no-cache")
 	w.Header().Set("X-Content-Type-Options", "nosniff")
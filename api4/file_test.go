@@ -677,6 +677,76 @@ func TestGetFile(t *testing.T) {
 	CheckNoError(t, resp)
 }
 
+func TestGetFileDownloadPermission(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	Client := th.Client
+
+	if *th.App.Config().FileSettings.DriverName == "" {
+		t.Skip("skipping because no file driver is enabled")
+	}
+
+	fileResp, resp := Client.UploadFile([]byte("data"), th.BasicChannel.Id, "test.txt")
+	CheckNoError(t, resp)
+	fileId := fileResp.FileInfos[0].Id
+
+	_, resp = Client.CreatePost(&model.Post{ChannelId: th.BasicChannel.Id, Message: "with file", FileIds: model.StringArray{fileId}})
+	CheckNoError(t, resp)
+
+	defaultRolePermissions := th.SaveDefaultRolePermissions()
+	defer th.RestoreDefaultRolePermissions(defaultRolePermissions)
+	th.RemovePermissionFromRole(model.PERMISSION_DOWNLOAD_FILE.Id, model.CHANNEL_USER_ROLE_ID)
+
+	th.LoginBasic2()
+	_, resp = Client.GetFile(fileId)
+	CheckForbiddenStatus(t, resp)
+
+	_, resp = Client.GetFileInfo(fileId)
+	CheckNoError(t, resp)
+
+	th.AddPermissionToRole(model.PERMISSION_DOWNLOAD_FILE.Id, model.CHANNEL_ADMIN_ROLE_ID)
+	th.MakeUserChannelAdmin(th.BasicUser2, th.BasicChannel)
+
+	_, resp = Client.GetFile(fileId)
+	CheckNoError(t, resp)
+
+	// The uploader can always download their own files.
+	th.LoginBasic()
+	_, resp = Client.GetFile(fileId)
+	CheckNoError(t, resp)
+}
+
+func TestSetFileSensitive(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	Client := th.Client
+
+	if *th.App.Config().FileSettings.DriverName == "" {
+		t.Skip("skipping because no file driver is enabled")
+	}
+
+	fileResp, resp := Client.UploadFile([]byte("data"), th.BasicChannel.Id, "test.txt")
+	CheckNoError(t, resp)
+	fileId := fileResp.FileInfos[0].Id
+
+	_, resp = Client.SetFileSensitive(fileId, true)
+	CheckForbiddenStatus(t, resp)
+
+	info, resp := th.SystemAdminClient.SetFileSensitive(fileId, true)
+	CheckNoError(t, resp)
+	require.True(t, info.Sensitive)
+
+	_, resp = Client.GetFile(fileId)
+	CheckNoError(t, resp)
+
+	info, resp = th.SystemAdminClient.SetFileSensitive(fileId, false)
+	CheckNoError(t, resp)
+	require.False(t, info.Sensitive)
+
+	_, resp = th.SystemAdminClient.SetFileSensitive(model.NewId(), true)
+	CheckNotFoundStatus(t, resp)
+}
+
 func TestGetFileHeaders(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
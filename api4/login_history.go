@@ -0,0 +1,98 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func (api *API) InitLoginHistory() {
+	api.BaseRoutes.Users.Handle("/login_history", api.ApiSessionRequired(getLoginHistory)).Methods("GET")
+	api.BaseRoutes.User.Handle("/login_history", api.ApiSessionRequired(getUserLoginHistory)).Methods("GET")
+}
+
+func loginHistoryGetOptionsFromRequest(c *Context, r *http.Request) *model.LoginHistoryGetOptions {
+	options := &model.LoginHistoryGetOptions{
+		Page:    c.Params.Page,
+		PerPage: c.Params.PerPage,
+	}
+	options.OnlyFailures, _ = strconv.ParseBool(r.URL.Query().Get("only_failures"))
+
+	if sinceString := r.URL.Query().Get("since"); len(sinceString) > 0 {
+		since, parseError := strconv.ParseInt(sinceString, 10, 64)
+		if parseError != nil {
+			c.SetInvalidParam("since")
+			return nil
+		}
+		options.Since = since
+	}
+
+	if untilString := r.URL.Query().Get("until"); len(untilString) > 0 {
+		until, parseError := strconv.ParseInt(untilString, 10, 64)
+		if parseError != nil {
+			c.SetInvalidParam("until")
+			return nil
+		}
+		options.Until = until
+	}
+
+	return options
+}
+
+func getLoginHistory(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_SYSCONSOLE_READ_USER_MANAGEMENT) {
+		c.SetPermissionError(model.PERMISSION_SYSCONSOLE_READ_USER_MANAGEMENT)
+		return
+	}
+
+	options := loginHistoryGetOptionsFromRequest(c, r)
+	if c.Err != nil {
+		return
+	}
+
+	if userId := r.URL.Query().Get("user_id"); len(userId) > 0 {
+		if !model.IsValidId(userId) {
+			c.SetInvalidParam("user_id")
+			return
+		}
+		options.UserId = userId
+	}
+
+	entries, err := c.App.GetLoginHistory(options)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(model.LoginHistoryListToJson(entries)))
+}
+
+func getUserLoginHistory(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if c.App.Session().UserId != c.Params.UserId && !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_SYSCONSOLE_READ_USER_MANAGEMENT) {
+		c.SetPermissionError(model.PERMISSION_SYSCONSOLE_READ_USER_MANAGEMENT)
+		return
+	}
+
+	options := loginHistoryGetOptionsFromRequest(c, r)
+	if c.Err != nil {
+		return
+	}
+	options.UserId = c.Params.UserId
+
+	entries, err := c.App.GetLoginHistory(options)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(model.LoginHistoryListToJson(entries)))
+}
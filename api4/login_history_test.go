@@ -0,0 +1,61 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestGetLoginHistory(t *testing.T) {
+	// The basic users are shared between tests, so only the attempts made by this one are looked at.
+	start := model.GetMillis()
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	client := th.CreateClient()
+	_, resp := client.Login(th.BasicUser.Email, "wrongpassword")
+	CheckUnauthorizedStatus(t, resp)
+
+	// Attempts are recorded in the background.
+	var entries []*model.LoginHistory
+	require.Eventually(t, func() bool {
+		entries, resp = th.Client.GetUserLoginHistory(th.BasicUser.Id, &model.LoginHistoryGetOptions{Since: start, PerPage: 60})
+		return resp.Error == nil && len(entries) >= 2
+	}, 5*time.Second, 100*time.Millisecond)
+
+	require.False(t, entries[0].Success)
+	require.NotEmpty(t, entries[0].FailureReason)
+	require.True(t, entries[1].Success)
+	require.Equal(t, model.USER_AUTH_SERVICE_EMAIL, entries[1].AuthService)
+
+	t.Run("should filter failures", func(t *testing.T) {
+		entries, resp := th.Client.GetUserLoginHistory(th.BasicUser.Id, &model.LoginHistoryGetOptions{OnlyFailures: true, Since: start, PerPage: 60})
+		CheckNoError(t, resp)
+		require.Len(t, entries, 1)
+		require.False(t, entries[0].Success)
+	})
+
+	t.Run("should not allow users to see the history of others", func(t *testing.T) {
+		_, resp := th.Client.GetUserLoginHistory(th.BasicUser2.Id, &model.LoginHistoryGetOptions{Since: start, PerPage: 60})
+		CheckForbiddenStatus(t, resp)
+
+		_, resp = th.Client.GetLoginHistory(&model.LoginHistoryGetOptions{Since: start, PerPage: 60})
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("should allow admins to query the history", func(t *testing.T) {
+		entries, resp := th.SystemAdminClient.GetUserLoginHistory(th.BasicUser.Id, &model.LoginHistoryGetOptions{Since: start, PerPage: 60})
+		CheckNoError(t, resp)
+		require.Len(t, entries, 2)
+
+		entries, resp = th.SystemAdminClient.GetLoginHistory(&model.LoginHistoryGetOptions{UserId: th.BasicUser.Id, OnlyFailures: true, Since: start, PerPage: 60})
+		CheckNoError(t, resp)
+		require.Len(t, entries, 1)
+	})
+}
@@ -40,6 +40,7 @@ func createOAuthApp(c *Context, w http.ResponseWriter, r *http.Request) {
 
 	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
 		oauthApp.IsTrusted = false
+		oauthApp.EmbedFrameAncestors = nil
 	}
 
 	oauthApp.CreatorId = c.App.Session().UserId
@@ -100,6 +101,7 @@ func updateOAuthApp(c *Context, w http.ResponseWriter, r *http.Request) {
 
 	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
 		oauthApp.IsTrusted = oldOauthApp.IsTrusted
+		oauthApp.EmbedFrameAncestors = oldOauthApp.EmbedFrameAncestors
 	}
 
 	updatedOauthApp, err := c.App.UpdateOauthApp(oldOauthApp, oauthApp)
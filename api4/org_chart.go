@@ -0,0 +1,103 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/audit"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func (api *API) InitOrgChart() {
+	api.BaseRoutes.User.Handle("/manager", api.ApiSessionRequired(setUserManager)).Methods("PUT")
+	api.BaseRoutes.User.Handle("/direct_reports", api.ApiSessionRequired(getDirectReports)).Methods("GET")
+	api.BaseRoutes.User.Handle("/org_chart", api.ApiSessionRequired(getOrgChart)).Methods("GET")
+}
+
+func setUserManager(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	props := model.MapFromJson(r.Body)
+	managerId, ok := props["manager_id"]
+	if !ok || (managerId != "" && !model.IsValidId(managerId)) {
+		c.SetInvalidParam("manager_id")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("setUserManager", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("user_id", c.Params.UserId)
+	auditRec.AddMeta("manager_id", managerId)
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	user, err := c.App.SetUserManager(c.Params.UserId, managerId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	c.App.SanitizeProfile(user, c.IsSystemAdmin())
+	w.Write([]byte(user.ToJson()))
+}
+
+func getDirectReports(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	canSee, err := c.App.UserCanSeeOtherUser(c.App.Session().UserId, c.Params.UserId)
+	if err != nil || !canSee {
+		c.SetPermissionError(model.PERMISSION_VIEW_MEMBERS)
+		return
+	}
+
+	users, err := c.App.GetDirectReports(c.Params.UserId, c.Params.Page, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	for _, user := range users {
+		c.App.SanitizeProfile(user, c.IsSystemAdmin())
+	}
+
+	w.Write([]byte(model.UserListToJson(users)))
+}
+
+func getOrgChart(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	canSee, err := c.App.UserCanSeeOtherUser(c.App.Session().UserId, c.Params.UserId)
+	if err != nil || !canSee {
+		c.SetPermissionError(model.PERMISSION_VIEW_MEMBERS)
+		return
+	}
+
+	orgChart, err := c.App.GetOrgChart(c.Params.UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	c.App.SanitizeProfile(orgChart.User, c.IsSystemAdmin())
+	for _, user := range append(orgChart.Managers, orgChart.DirectReports...) {
+		c.App.SanitizeProfile(user, c.IsSystemAdmin())
+	}
+
+	w.Write([]byte(orgChart.ToJson()))
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrgChart(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("should require permission to manage the system", func(t *testing.T) {
+		_, resp := th.Client.SetUserManager(th.BasicUser.Id, th.BasicUser2.Id)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	user, resp := th.SystemAdminClient.SetUserManager(th.BasicUser.Id, th.BasicUser2.Id)
+	CheckNoError(t, resp)
+	require.Equal(t, th.BasicUser2.Id, user.ManagerId)
+
+	t.Run("should reject reporting lines that loop", func(t *testing.T) {
+		_, resp := th.SystemAdminClient.SetUserManager(th.BasicUser2.Id, th.BasicUser.Id)
+		CheckErrorMessage(t, resp, "app.user.set_manager.cycle.app_error")
+
+		_, resp = th.SystemAdminClient.SetUserManager(th.BasicUser.Id, th.BasicUser.Id)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("users can't change their manager by updating themselves", func(t *testing.T) {
+		me, resp := th.Client.GetMe("")
+		CheckNoError(t, resp)
+
+		me.ManagerId = ""
+		_, resp = th.Client.UpdateUser(me)
+		CheckNoError(t, resp)
+
+		me, resp = th.Client.GetMe("")
+		CheckNoError(t, resp)
+		require.Equal(t, th.BasicUser2.Id, me.ManagerId)
+	})
+
+	t.Run("should return the direct reports", func(t *testing.T) {
+		users, resp := th.Client.GetDirectReports(th.BasicUser2.Id, 0, 60)
+		CheckNoError(t, resp)
+		require.Len(t, users, 1)
+		require.Equal(t, th.BasicUser.Id, users[0].Id)
+	})
+
+	t.Run("should return the org chart", func(t *testing.T) {
+		orgChart, resp := th.Client.GetOrgChart(th.BasicUser.Id)
+		CheckNoError(t, resp)
+		require.Equal(t, th.BasicUser.Id, orgChart.User.Id)
+		require.Len(t, orgChart.Managers, 1)
+		require.Equal(t, th.BasicUser2.Id, orgChart.Managers[0].Id)
+		require.Empty(t, orgChart.DirectReports)
+	})
+
+	t.Run("should remove the manager", func(t *testing.T) {
+		user, resp := th.SystemAdminClient.SetUserManager(th.BasicUser.Id, "")
+		CheckNoError(t, resp)
+		require.Empty(t, user.ManagerId)
+
+		users, resp := th.Client.GetDirectReports(th.BasicUser2.Id, 0, 60)
+		CheckNoError(t, resp)
+		require.Empty(t, users)
+	})
+}
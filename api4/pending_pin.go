@@ -0,0 +1,112 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/audit"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func (api *API) InitPendingPin() {
+	api.BaseRoutes.Channel.Handle("/pending_pins", api.ApiSessionRequired(getPendingPinsForChannel)).Methods("GET")
+	api.BaseRoutes.Post.Handle("/pin/approve", api.ApiSessionRequired(approvePendingPin)).Methods("POST")
+	api.BaseRoutes.Post.Handle("/pin/reject", api.ApiSessionRequired(rejectPendingPin)).Methods("POST")
+}
+
+func getPendingPinsForChannel(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToChannel(*c.App.Session(), c.Params.ChannelId, model.PERMISSION_MANAGE_CHANNEL_ROLES) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_CHANNEL_ROLES)
+		return
+	}
+
+	pendingPins, err := c.App.GetPendingPinsForChannel(c.Params.ChannelId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(model.PendingPinListToJson(pendingPins)))
+}
+
+func approvePendingPin(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePostId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("approvePendingPin", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("post_id", c.Params.PostId)
+
+	pendingPin := getPendingPinForChannelAdmin(c)
+	if c.Err != nil {
+		return
+	}
+	auditRec.AddMeta("pending_pin", pendingPin)
+
+	post, err := c.App.ApprovePendingPin(pendingPin)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	w.Write([]byte(post.ToJson()))
+}
+
+func rejectPendingPin(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePostId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("rejectPendingPin", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("post_id", c.Params.PostId)
+
+	pendingPin := getPendingPinForChannelAdmin(c)
+	if c.Err != nil {
+		return
+	}
+	auditRec.AddMeta("pending_pin", pendingPin)
+
+	if err := c.App.RejectPendingPin(pendingPin); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	ReturnStatusOK(w)
+}
+
+// getPendingPinForChannelAdmin fetches the request to pin the post in the URL, making sure the
+// session may approve the pins of its channel. It sets c.Err otherwise.
+func getPendingPinForChannelAdmin(c *Context) *model.PendingPin {
+	pendingPin, err := c.App.GetPendingPin(c.Params.PostId)
+	if err != nil {
+		// Don't leak which posts have pending pins to users that can't read their channel.
+		if err.StatusCode == http.StatusNotFound && !c.App.SessionHasPermissionToChannelByPost(*c.App.Session(), c.Params.PostId, model.PERMISSION_READ_CHANNEL) {
+			c.SetPermissionError(model.PERMISSION_READ_CHANNEL)
+			return nil
+		}
+		c.Err = err
+		return nil
+	}
+
+	if !c.App.SessionHasPermissionToChannel(*c.App.Session(), pendingPin.ChannelId, model.PERMISSION_MANAGE_CHANNEL_ROLES) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_CHANNEL_ROLES)
+		return nil
+	}
+
+	return pendingPin
+}
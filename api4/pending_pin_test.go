@@ -0,0 +1,97 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestPendingPins(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	Client := th.Client
+
+	_, resp := Client.PatchChannel(th.BasicChannel.Id, &model.ChannelPatch{PinApprovalRequired: model.NewBool(true)})
+	CheckForbiddenStatus(t, resp)
+
+	channel, resp := th.SystemAdminClient.PatchChannel(th.BasicChannel.Id, &model.ChannelPatch{PinApprovalRequired: model.NewBool(true)})
+	CheckNoError(t, resp)
+	require.True(t, channel.IsPinApprovalRequired())
+
+	t.Run("pinning should only request the pin", func(t *testing.T) {
+		post := th.CreatePost()
+
+		pass, resp := Client.PinPost(post.Id)
+		CheckNoError(t, resp)
+		require.True(t, pass)
+		require.Equal(t, http.StatusAccepted, resp.StatusCode)
+
+		rpost, err := th.App.GetSinglePost(post.Id)
+		require.Nil(t, err)
+		require.False(t, rpost.IsPinned)
+
+		_, resp = Client.PinPost(post.Id)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp = Client.GetPendingPinsForChannel(th.BasicChannel.Id)
+		CheckForbiddenStatus(t, resp)
+
+		pendingPins, resp := th.SystemAdminClient.GetPendingPinsForChannel(th.BasicChannel.Id)
+		CheckNoError(t, resp)
+		require.Len(t, pendingPins, 1)
+		require.Equal(t, post.Id, pendingPins[0].PostId)
+		require.Equal(t, th.BasicUser.Id, pendingPins[0].UserId)
+	})
+
+	t.Run("channel admins should approve pins", func(t *testing.T) {
+		post := th.CreatePost()
+		_, resp := Client.PinPost(post.Id)
+		CheckNoError(t, resp)
+
+		_, resp = Client.ApprovePendingPin(post.Id)
+		CheckForbiddenStatus(t, resp)
+
+		pinnedPost, resp := th.SystemAdminClient.ApprovePendingPin(post.Id)
+		CheckNoError(t, resp)
+		require.True(t, pinnedPost.IsPinned)
+
+		_, resp = th.SystemAdminClient.ApprovePendingPin(post.Id)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("channel admins should reject pins", func(t *testing.T) {
+		post := th.CreatePost()
+		_, resp := Client.PinPost(post.Id)
+		CheckNoError(t, resp)
+
+		_, resp = Client.RejectPendingPin(post.Id)
+		CheckForbiddenStatus(t, resp)
+
+		pass, resp := th.SystemAdminClient.RejectPendingPin(post.Id)
+		CheckNoError(t, resp)
+		require.True(t, pass)
+
+		rpost, err := th.App.GetSinglePost(post.Id)
+		require.Nil(t, err)
+		require.False(t, rpost.IsPinned)
+	})
+
+	t.Run("channel admins should pin directly", func(t *testing.T) {
+		post := th.CreatePost()
+		th.MakeUserChannelAdmin(th.BasicUser, th.BasicChannel)
+
+		_, resp := Client.PinPost(post.Id)
+		CheckNoError(t, resp)
+		CheckOKStatus(t, resp)
+
+		rpost, err := th.App.GetSinglePost(post.Id)
+		require.Nil(t, err)
+		require.True(t, rpost.IsPinned)
+	})
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func (api *API) InitPermissionCheck() {
+	api.BaseRoutes.User.Handle("/permissions/batch", api.ApiSessionRequired(checkPermissions)).Methods("POST")
+}
+
+func checkPermissions(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	// The checks are answered for the session, so they can only be made for the session's own user.
+	if c.Params.UserId != c.App.Session().UserId {
+		c.SetPermissionError(model.PERMISSION_EDIT_OTHER_USERS)
+		return
+	}
+
+	checks := model.PermissionChecksFromJson(r.Body)
+	if checks == nil {
+		c.SetInvalidParam("permission_checks")
+		return
+	}
+
+	results, err := c.App.SessionCheckPermissions(*c.App.Session(), checks)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(model.PermissionCheckResultsToJson(results)))
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestCheckPermissions(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("should answer the checks in order", func(t *testing.T) {
+		checks := []*model.PermissionCheck{
+			{Permission: model.PERMISSION_MANAGE_SYSTEM.Id},
+			{Permission: model.PERMISSION_CREATE_TEAM.Id},
+			{Permission: model.PERMISSION_VIEW_TEAM.Id, TeamId: th.BasicTeam.Id},
+			{Permission: model.PERMISSION_CREATE_POST.Id, ChannelId: th.BasicChannel.Id},
+			{Permission: model.PERMISSION_CREATE_POST.Id, ChannelId: model.NewId()},
+		}
+
+		results, resp := th.Client.CheckPermissions(checks)
+		CheckNoError(t, resp)
+		require.Len(t, results, len(checks))
+
+		for i, result := range results {
+			assert.Equal(t, *checks[i], result.PermissionCheck)
+		}
+		assert.False(t, results[0].Allowed)
+		assert.True(t, results[1].Allowed)
+		assert.True(t, results[2].Allowed)
+		assert.True(t, results[3].Allowed)
+		assert.False(t, results[4].Allowed)
+	})
+
+	t.Run("should allow system admins", func(t *testing.T) {
+		results, resp := th.SystemAdminClient.CheckPermissions([]*model.PermissionCheck{{Permission: model.PERMISSION_MANAGE_SYSTEM.Id}})
+		CheckNoError(t, resp)
+		require.Len(t, results, 1)
+		assert.True(t, results[0].Allowed)
+	})
+
+	t.Run("should reject unknown permissions", func(t *testing.T) {
+		_, resp := th.Client.CheckPermissions([]*model.PermissionCheck{{Permission: "not_a_permission"}})
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("should reject too many checks", func(t *testing.T) {
+		checks := make([]*model.PermissionCheck, model.PERMISSION_CHECKS_MAX_PER_BATCH+1)
+		for i := range checks {
+			checks[i] = &model.PermissionCheck{Permission: model.PERMISSION_CREATE_TEAM.Id}
+		}
+
+		_, resp := th.Client.CheckPermissions(checks)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("should not check for other users", func(t *testing.T) {
+		r, err := th.Client.DoApiPost(th.Client.GetUserRoute(th.BasicUser2.Id)+"/permissions/batch", "[]")
+		require.NotNil(t, err)
+		assert.Equal(t, 403, r.StatusCode)
+	})
+}
@@ -23,6 +23,7 @@ func (api *API) InitPost() {
 	api.BaseRoutes.Post.Handle("/thread", api.ApiSessionRequired(getPostThread)).Methods("GET")
 	api.BaseRoutes.Post.Handle("/files/info", api.ApiSessionRequired(getFileInfosForPost)).Methods("GET")
 	api.BaseRoutes.PostsForChannel.Handle("", api.ApiSessionRequired(getPostsForChannel)).Methods("GET")
+	api.BaseRoutes.PostsForChannel.Handle("/delta", api.ApiSessionRequired(getPostsDeltaForChannel)).Methods("GET")
 	api.BaseRoutes.PostsForUser.Handle("/flagged", api.ApiSessionRequired(getFlaggedPostsForUser)).Methods("GET")
 
 	api.BaseRoutes.ChannelForUser.Handle("/posts/unread", api.ApiSessionRequired(getPostsForChannelAroundLastUnread)).Methods("GET")
@@ -96,7 +97,7 @@ func createPost(c *Context, w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusCreated)
 
 	// Note that rp has already had PreparePostForClient called on it by App.CreatePost
-	w.Write([]byte(rp.ToJson()))
+	w.Write([]byte(c.App.SanitizePostMetadataForUser(rp, c.App.Session().UserId).ToJson()))
 }
 
 func createEphemeralPost(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -129,6 +130,48 @@ func createEphemeralPost(c *Context, w http.ResponseWriter, r *http.Request) {
 	w.Write([]byte(rp.ToJson()))
 }
 
+func getPostsDeltaForChannel(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	token := r.URL.Query().Get("token")
+	var since int64
+	if sinceString := r.URL.Query().Get("since"); len(sinceString) > 0 {
+		var parseError error
+		since, parseError = strconv.ParseInt(sinceString, 10, 64)
+		if parseError != nil || since < 0 {
+			c.SetInvalidParam("since")
+			return
+		}
+	} else if token == "" {
+		c.SetInvalidParam("since")
+		return
+	}
+
+	if !c.App.SessionHasPermissionToChannel(*c.App.Session(), c.Params.ChannelId, model.PERMISSION_READ_CHANNEL) {
+		c.SetPermissionError(model.PERMISSION_READ_CHANNEL)
+		return
+	}
+
+	delta, err := c.App.GetPostsDelta(c.Params.ChannelId, since, token)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	userId := c.App.Session().UserId
+	for i, post := range delta.New {
+		delta.New[i] = c.App.SanitizePostMetadataForUser(c.App.PreparePostForClient(post, false, false), userId)
+	}
+	for i, post := range delta.Edited {
+		delta.Edited[i] = c.App.SanitizePostMetadataForUser(c.App.PreparePostForClient(post, false, false), userId)
+	}
+
+	w.Write([]byte(delta.ToJson()))
+}
+
 func getPostsForChannel(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireChannelId()
 	if c.Err != nil {
@@ -211,6 +254,7 @@ func getPostsForChannel(c *Context, w http.ResponseWriter, r *http.Request) {
 
 	c.App.AddCursorIdsForPostList(list, afterPost, beforePost, since, page, perPage)
 	clientPostList := c.App.PreparePostListForClient(list)
+	clientPostList = c.App.SanitizePostListMetadataForUser(clientPostList, c.App.Session().UserId)
 
 	w.Write([]byte(clientPostList.ToJson()))
 }
@@ -259,6 +303,7 @@ func getPostsForChannelAroundLastUnread(c *Context, w http.ResponseWriter, r *ht
 	postList.PrevPostId = c.App.GetPrevPostIdFromPostList(postList)
 
 	clientPostList := c.App.PreparePostListForClient(postList)
+	clientPostList = c.App.SanitizePostListMetadataForUser(clientPostList, c.App.Session().UserId)
 
 	if len(etag) > 0 {
 		w.Header().Set(model.HEADER_ETAG_SERVER, etag)
@@ -322,7 +367,10 @@ func getFlaggedPostsForUser(c *Context, w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	w.Write([]byte(c.App.PreparePostListForClient(pl).ToJson()))
+	clientPostList := c.App.PreparePostListForClient(pl)
+	clientPostList = c.App.SanitizePostListMetadataForUser(clientPostList, c.App.Session().UserId)
+
+	w.Write([]byte(clientPostList.ToJson()))
 }
 
 func getPost(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -356,6 +404,7 @@ func getPost(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 
 	post = c.App.PreparePostForClient(post, false, false)
+	post = c.App.SanitizePostMetadataForUser(post, c.App.Session().UserId)
 
 	if c.HandleEtag(post.Etag(), "Get Post", w, r) {
 		return
@@ -444,6 +493,7 @@ func getPostThread(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 
 	clientPostList := c.App.PreparePostListForClient(list)
+	clientPostList = c.App.SanitizePostListMetadataForUser(clientPostList, c.App.Session().UserId)
 
 	w.Header().Set(model.HEADER_ETAG_SERVER, clientPostList.Etag())
 
@@ -511,6 +561,7 @@ func searchPosts(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 
 	clientPostList := c.App.PreparePostListForClient(results.PostList)
+	clientPostList = c.App.SanitizePostListMetadataForUser(clientPostList, c.App.Session().UserId)
 
 	results = model.MakePostSearchResults(clientPostList, results.Matches)
 
@@ -573,7 +624,7 @@ func updatePost(c *Context, w http.ResponseWriter, r *http.Request) {
 	auditRec.Success()
 	auditRec.AddMeta("update", rpost)
 
-	w.Write([]byte(rpost.ToJson()))
+	w.Write([]byte(c.App.SanitizePostMetadataForUser(rpost, c.App.Session().UserId).ToJson()))
 }
 
 func patchPost(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -623,7 +674,7 @@ func patchPost(c *Context, w http.ResponseWriter, r *http.Request) {
 	auditRec.Success()
 	auditRec.AddMeta("patch", patchedPost)
 
-	w.Write([]byte(patchedPost.ToJson()))
+	w.Write([]byte(c.App.SanitizePostMetadataForUser(patchedPost, c.App.Session().UserId).ToJson()))
 }
 
 func setPostUnread(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -690,6 +741,21 @@ func saveIsPinnedPost(c *Context, w http.ResponseWriter, r *http.Request, isPinn
 		return
 	}
 
+	// Pinning in channels requiring approval only requests the pin, unless done by a channel admin.
+	if isPinned && channel.IsPinApprovalRequired() && !c.App.SessionHasPermissionToChannel(*c.App.Session(), channel.Id, model.PERMISSION_MANAGE_CHANNEL_ROLES) {
+		pendingPin, err := c.App.RequestPinPost(post, c.App.Session().UserId)
+		if err != nil {
+			c.Err = err
+			return
+		}
+		auditRec.AddMeta("pending_pin", pendingPin)
+
+		auditRec.Success()
+		w.WriteHeader(http.StatusAccepted)
+		ReturnStatusOK(w)
+		return
+	}
+
 	patch := &model.PostPatch{}
 	patch.IsPinned = model.NewBool(isPinned)
 
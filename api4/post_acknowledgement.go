@@ -0,0 +1,106 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/audit"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func (api *API) InitPostAcknowledgement() {
+	api.BaseRoutes.Post.Handle("/acks", api.ApiSessionRequired(getPostAcknowledgements)).Methods("GET")
+	api.BaseRoutes.Post.Handle("/ack", api.ApiSessionRequired(acknowledgePost)).Methods("POST")
+	api.BaseRoutes.Post.Handle("/ack", api.ApiSessionRequired(unacknowledgePost)).Methods("DELETE")
+}
+
+func getPostAcknowledgements(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePostId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToChannelByPost(*c.App.Session(), c.Params.PostId, model.PERMISSION_READ_CHANNEL) {
+		c.SetPermissionError(model.PERMISSION_READ_CHANNEL)
+		return
+	}
+
+	acknowledgements, err := c.App.GetPostAcknowledgements(c.Params.PostId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(model.PostAcknowledgementListToJson(acknowledgements)))
+}
+
+func acknowledgePost(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePostId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("acknowledgePost", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("post_id", c.Params.PostId)
+
+	post := getPostToAcknowledge(c)
+	if c.Err != nil {
+		return
+	}
+
+	acknowledgement, err := c.App.AcknowledgePost(post, c.App.Session().UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte(acknowledgement.ToJson()))
+}
+
+func unacknowledgePost(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePostId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("unacknowledgePost", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("post_id", c.Params.PostId)
+
+	post := getPostToAcknowledge(c)
+	if c.Err != nil {
+		return
+	}
+
+	if err := c.App.UnacknowledgePost(post, c.App.Session().UserId); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	ReturnStatusOK(w)
+}
+
+// getPostToAcknowledge fetches the post in the URL, making sure the session can read its channel.
+// It sets c.Err otherwise.
+func getPostToAcknowledge(c *Context) *model.Post {
+	if !c.App.SessionHasPermissionToChannelByPost(*c.App.Session(), c.Params.PostId, model.PERMISSION_READ_CHANNEL) {
+		c.SetPermissionError(model.PERMISSION_READ_CHANNEL)
+		return nil
+	}
+
+	post, err := c.App.GetSinglePost(c.Params.PostId)
+	if err != nil {
+		c.Err = err
+		return nil
+	}
+
+	return post
+}
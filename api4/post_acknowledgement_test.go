@@ -0,0 +1,80 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestPostAcknowledgements(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	Client := th.Client
+
+	post := &model.Post{ChannelId: th.BasicChannel.Id, Message: "please read"}
+	post.AddProp(model.POST_PROPS_PRIORITY, model.POST_PRIORITY_URGENT)
+	post.AddProp(model.POST_PROPS_REQUESTED_ACK, true)
+	post, resp := Client.CreatePost(post)
+	CheckNoError(t, resp)
+	require.True(t, post.IsUrgent())
+	require.True(t, post.IsAckRequested())
+
+	t.Run("should acknowledge the post", func(t *testing.T) {
+		acknowledgement, resp := Client.AcknowledgePost(post.Id)
+		CheckNoError(t, resp)
+		CheckCreatedStatus(t, resp)
+		require.Equal(t, th.BasicUser.Id, acknowledgement.UserId)
+
+		_, resp = Client.AcknowledgePost(post.Id)
+		CheckBadRequestStatus(t, resp)
+
+		acknowledgements, resp := Client.GetPostAcknowledgements(post.Id)
+		CheckNoError(t, resp)
+		require.Len(t, acknowledgements, 1)
+		require.Equal(t, th.BasicUser.Id, acknowledgements[0].UserId)
+	})
+
+	t.Run("should remove the acknowledgement", func(t *testing.T) {
+		pass, resp := Client.UnacknowledgePost(post.Id)
+		CheckNoError(t, resp)
+		require.True(t, pass)
+
+		_, resp = Client.UnacknowledgePost(post.Id)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("should not acknowledge posts not requesting it", func(t *testing.T) {
+		_, resp := Client.AcknowledgePost(th.BasicPost.Id)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("should not acknowledge posts of unreadable channels", func(t *testing.T) {
+		privatePost := th.CreatePostWithClient(th.SystemAdminClient, th.CreateChannelWithClient(th.SystemAdminClient, model.CHANNEL_PRIVATE))
+
+		_, resp := Client.AcknowledgePost(privatePost.Id)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp = Client.GetPostAcknowledgements(privatePost.Id)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("should require the permission to send urgent posts", func(t *testing.T) {
+		th.RemovePermissionFromRole(model.PERMISSION_CREATE_URGENT_POST.Id, model.CHANNEL_USER_ROLE_ID)
+		defer th.AddPermissionToRole(model.PERMISSION_CREATE_URGENT_POST.Id, model.CHANNEL_USER_ROLE_ID)
+
+		urgentPost := &model.Post{ChannelId: th.BasicChannel.Id, Message: "urgent"}
+		urgentPost.AddProp(model.POST_PROPS_PRIORITY, model.POST_PRIORITY_URGENT)
+		_, resp := Client.CreatePost(urgentPost)
+		CheckForbiddenStatus(t, resp)
+
+		importantPost := &model.Post{ChannelId: th.BasicChannel.Id, Message: "important"}
+		importantPost.AddProp(model.POST_PROPS_PRIORITY, model.POST_PRIORITY_IMPORTANT)
+		_, resp = Client.CreatePost(importantPost)
+		CheckNoError(t, resp)
+	})
+}
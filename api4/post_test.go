@@ -1185,6 +1185,45 @@ func TestGetPostsForChannel(t *testing.T) {
 	})
 }
 
+func TestGetPostsDeltaForChannel(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	Client := th.Client
+
+	since := model.GetMillis()
+	time.Sleep(2 * time.Millisecond)
+
+	newPost := th.CreatePost()
+	editedPost := th.BasicPost
+	editedPost.Message = "edited message"
+	_, resp := Client.UpdatePost(editedPost.Id, editedPost)
+	CheckNoError(t, resp)
+
+	delta, resp := Client.GetPostsDelta(th.BasicChannel.Id, since, "")
+	CheckNoError(t, resp)
+	require.Len(t, delta.New, 1)
+	assert.Equal(t, newPost.Id, delta.New[0].Id)
+	require.Len(t, delta.Edited, 1)
+	assert.Equal(t, "edited message", delta.Edited[0].Message)
+	assert.False(t, delta.HasMore)
+	require.NotEmpty(t, delta.NextToken)
+
+	delta, resp = Client.GetPostsDelta(th.BasicChannel.Id, 0, delta.NextToken)
+	CheckNoError(t, resp)
+	assert.Empty(t, delta.New)
+	assert.Empty(t, delta.Edited)
+
+	_, resp = Client.GetPostsDelta(th.BasicChannel2.Id, 0, delta.NextToken)
+	CheckBadRequestStatus(t, resp)
+
+	_, resp = Client.GetPostsDelta(th.BasicChannel.Id, 0, "junk")
+	CheckBadRequestStatus(t, resp)
+
+	privateChannel := th.CreateChannelWithClient(th.SystemAdminClient, model.CHANNEL_PRIVATE)
+	_, resp = Client.GetPostsDelta(privateChannel.Id, since, "")
+	CheckForbiddenStatus(t, resp)
+}
+
 func TestGetFlaggedPostsForUser(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
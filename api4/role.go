@@ -5,6 +5,7 @@ package api4
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/mattermost/mattermost-server/v5/audit"
 	"github.com/mattermost/mattermost-server/v5/model"
@@ -147,3 +148,14 @@ func patchRole(c *Context, w http.ResponseWriter, r *http.Request) {
 
 	w.Write([]byte(role.ToJson()))
 }
+
+// rolesExpiresAtFromProps reads the optional expiry of the roles granted by a roles update. Roles
+// without an expiry are granted permanently.
+func rolesExpiresAtFromProps(props map[string]string) (int64, bool) {
+	if props["expires_at"] == "" {
+		return 0, true
+	}
+
+	expiresAt, err := strconv.ParseInt(props["expires_at"], 10, 64)
+	return expiresAt, err == nil
+}
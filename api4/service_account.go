@@ -0,0 +1,68 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/mattermost/mattermost-server/v5/audit"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func (api *API) InitServiceAccount() {
+	api.BaseRoutes.Users.Handle("/service_accounts", api.ApiSessionRequired(createServiceAccount)).Methods("POST")
+	api.BaseRoutes.Users.Handle("/service_accounts", api.ApiSessionRequired(getServiceAccounts)).Methods("GET")
+}
+
+func createServiceAccount(c *Context, w http.ResponseWriter, r *http.Request) {
+	account := model.ServiceAccountFromJson(r.Body)
+	if account == nil {
+		c.SetInvalidParam("service_account")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("createServiceAccount", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("username", account.Username)
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_SYSCONSOLE_WRITE_USER_MANAGEMENT) {
+		c.SetPermissionError(model.PERMISSION_SYSCONSOLE_WRITE_USER_MANAGEMENT)
+		return
+	}
+
+	user, err := c.App.CreateServiceAccount(account)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("user", user)
+
+	c.App.SanitizeProfile(user, c.IsSystemAdmin())
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte(user.ToJson()))
+}
+
+func getServiceAccounts(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_SYSCONSOLE_READ_USER_MANAGEMENT) {
+		c.SetPermissionError(model.PERMISSION_SYSCONSOLE_READ_USER_MANAGEMENT)
+		return
+	}
+
+	includeDeleted, _ := strconv.ParseBool(r.URL.Query().Get("include_deleted"))
+
+	users, err := c.App.GetServiceAccounts(c.Params.Page, c.Params.PerPage, includeDeleted)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	for _, user := range users {
+		c.App.SanitizeProfile(user, c.IsSystemAdmin())
+	}
+
+	w.Write([]byte(model.UserListToJson(users)))
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestServiceAccount(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	account := &model.ServiceAccount{Username: GenerateTestUsername(), DisplayName: "Build Pipeline"}
+
+	t.Run("should require permission to create a service account", func(t *testing.T) {
+		_, resp := th.Client.CreateServiceAccount(account)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	user, resp := th.SystemAdminClient.CreateServiceAccount(account)
+	CheckNoError(t, resp)
+	CheckCreatedStatus(t, resp)
+	require.True(t, user.IsServiceAccount)
+	assert.Equal(t, account.Username, user.Username)
+
+	t.Run("should list the service accounts", func(t *testing.T) {
+		_, resp := th.Client.GetServiceAccounts(0, 200, false)
+		CheckForbiddenStatus(t, resp)
+
+		users, resp := th.SystemAdminClient.GetServiceAccounts(0, 200, false)
+		CheckNoError(t, resp)
+		require.Len(t, users, 1)
+		assert.Equal(t, user.Id, users[0].Id)
+	})
+
+	t.Run("should not log in with a password", func(t *testing.T) {
+		err := th.App.UpdatePassword(user, "Pa$$word11")
+		require.Nil(t, err)
+
+		_, resp := th.CreateClient().Login(account.Username, "Pa$$word11")
+		CheckUnauthorizedStatus(t, resp)
+		CheckErrorMessage(t, resp, "api.user.login.service_account_login_forbidden.app_error")
+	})
+
+	t.Run("should authenticate with a user access token", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableUserAccessTokens = false })
+
+		token, resp := th.SystemAdminClient.CreateUserAccessToken(user.Id, "ci")
+		CheckNoError(t, resp)
+
+		client := th.CreateClient()
+		client.AuthToken = token.Token
+		client.AuthType = model.HEADER_BEARER
+
+		me, resp := client.GetMe("")
+		CheckNoError(t, resp)
+		assert.Equal(t, user.Id, me.Id)
+	})
+}
@@ -197,3 +197,106 @@ func TestUpdateUserStatus(t *testing.T) {
 		CheckUnauthorizedStatus(t, resp)
 	})
 }
+
+func TestUserStatusAutomation(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	Client := th.Client
+
+	request := &model.StatusAutomationRequest{Source: "calendar", EndAt: model.GetMillis() + 60*60*1000}
+
+	t.Run("start and end automation", func(t *testing.T) {
+		_, resp := Client.UpdateUserStatus(th.BasicUser.Id, &model.Status{Status: "away", UserId: th.BasicUser.Id})
+		CheckNoError(t, resp)
+
+		automation, resp := Client.StartUserStatusAutomation(th.BasicUser.Id, request)
+		CheckNoError(t, resp)
+		assert.Equal(t, "away", automation.PreviousStatus)
+		assert.True(t, automation.PreviousManual)
+
+		status, resp := Client.GetUserStatus(th.BasicUser.Id, "")
+		CheckNoError(t, resp)
+		assert.Equal(t, "dnd", status.Status)
+
+		fetched, resp := Client.GetUserStatusAutomation(th.BasicUser.Id)
+		CheckNoError(t, resp)
+		assert.Equal(t, automation, fetched)
+
+		ok, resp := Client.EndUserStatusAutomation(th.BasicUser.Id)
+		CheckNoError(t, resp)
+		assert.True(t, ok)
+
+		status, resp = Client.GetUserStatus(th.BasicUser.Id, "")
+		CheckNoError(t, resp)
+		assert.Equal(t, "away", status.Status)
+
+		_, resp = Client.GetUserStatusAutomation(th.BasicUser.Id)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("extend automation", func(t *testing.T) {
+		_, resp := Client.UpdateUserStatus(th.BasicUser.Id, &model.Status{Status: "online", UserId: th.BasicUser.Id})
+		CheckNoError(t, resp)
+
+		_, resp = Client.StartUserStatusAutomation(th.BasicUser.Id, request)
+		CheckNoError(t, resp)
+
+		extended, resp := Client.StartUserStatusAutomation(th.BasicUser.Id, &model.StatusAutomationRequest{Source: "calendar", EndAt: request.EndAt + 1000})
+		CheckNoError(t, resp)
+		assert.Equal(t, "online", extended.PreviousStatus)
+		assert.Equal(t, request.EndAt+1000, extended.EndAt)
+
+		_, resp = Client.EndUserStatusAutomation(th.BasicUser.Id)
+		CheckNoError(t, resp)
+
+		status, resp := Client.GetUserStatus(th.BasicUser.Id, "")
+		CheckNoError(t, resp)
+		assert.Equal(t, "online", status.Status)
+	})
+
+	t.Run("manual change overrides automation", func(t *testing.T) {
+		_, resp := Client.UpdateUserStatus(th.BasicUser.Id, &model.Status{Status: "online", UserId: th.BasicUser.Id})
+		CheckNoError(t, resp)
+
+		_, resp = Client.StartUserStatusAutomation(th.BasicUser.Id, request)
+		CheckNoError(t, resp)
+
+		_, resp = Client.UpdateUserStatus(th.BasicUser.Id, &model.Status{Status: "away", UserId: th.BasicUser.Id})
+		CheckNoError(t, resp)
+
+		_, resp = Client.GetUserStatusAutomation(th.BasicUser.Id)
+		CheckNotFoundStatus(t, resp)
+
+		_, resp = Client.EndUserStatusAutomation(th.BasicUser.Id)
+		CheckNotFoundStatus(t, resp)
+
+		status, resp := Client.GetUserStatus(th.BasicUser.Id, "")
+		CheckNoError(t, resp)
+		assert.Equal(t, "away", status.Status)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		_, resp := Client.StartUserStatusAutomation(th.BasicUser.Id, &model.StatusAutomationRequest{Source: "calendar", EndAt: model.GetMillis() - 1000})
+		CheckBadRequestStatus(t, resp)
+
+		_, resp = Client.StartUserStatusAutomation(th.BasicUser.Id, &model.StatusAutomationRequest{EndAt: request.EndAt})
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("other user", func(t *testing.T) {
+		_, resp := Client.StartUserStatusAutomation(th.BasicUser2.Id, request)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp = Client.GetUserStatusAutomation(th.BasicUser2.Id)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp = Client.EndUserStatusAutomation(th.BasicUser2.Id)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp = th.SystemAdminClient.StartUserStatusAutomation(th.BasicUser2.Id, request)
+		CheckNoError(t, resp)
+
+		_, resp = th.SystemAdminClient.EndUserStatusAutomation(th.BasicUser2.Id)
+		CheckNoError(t, resp)
+	})
+}
@@ -4,6 +4,7 @@
 package api4
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -34,18 +35,33 @@ func (api *API) InitSystem() {
 	api.BaseRoutes.System.Handle("/ping", api.ApiHandler(getSystemPing)).Methods("GET")
 
 	api.BaseRoutes.System.Handle("/timezones", api.ApiSessionRequired(getSupportedTimezones)).Methods("GET")
+	api.BaseRoutes.System.Handle("/diagnostics/export", api.ApiSessionRequired(exportDiagnostics)).Methods("GET")
+	api.BaseRoutes.System.Handle("/support_packet", api.ApiSessionRequired(generateSupportPacket)).Methods("GET")
+	api.BaseRoutes.System.Handle("/errors", api.ApiSessionRequired(getErrorCatalog)).Methods("GET")
+
+	api.BaseRoutes.System.Handle("/debug_capture", api.ApiSessionRequired(getDebugCaptures)).Methods("GET")
+	api.BaseRoutes.System.Handle("/debug_capture/rules", api.ApiSessionRequired(getDebugCaptureRules)).Methods("GET")
+	api.BaseRoutes.System.Handle("/debug_capture/rules", api.ApiSessionRequired(createDebugCaptureRule)).Methods("POST")
+	api.BaseRoutes.System.Handle("/debug_capture/rules/{rule_id:[A-Za-z0-9]+}", api.ApiSessionRequired(deleteDebugCaptureRule)).Methods("DELETE")
+
+	api.BaseRoutes.System.Handle("/profiles", api.ApiSessionRequired(getProfiles)).Methods("GET")
+	api.BaseRoutes.System.Handle("/profiles", api.ApiSessionRequired(captureProfile)).Methods("POST")
+	api.BaseRoutes.System.Handle("/profiles/{job_id:[A-Za-z0-9]+}/download", api.ApiSessionRequired(downloadProfile)).Methods("GET")
 
 	api.BaseRoutes.ApiRoot.Handle("/audits", api.ApiSessionRequired(getAudits)).Methods("GET")
 	api.BaseRoutes.ApiRoot.Handle("/email/test", api.ApiSessionRequired(testEmail)).Methods("POST")
 	api.BaseRoutes.ApiRoot.Handle("/site_url/test", api.ApiSessionRequired(testSiteURL)).Methods("POST")
 	api.BaseRoutes.ApiRoot.Handle("/file/s3_test", api.ApiSessionRequired(testS3)).Methods("POST")
 	api.BaseRoutes.ApiRoot.Handle("/database/recycle", api.ApiSessionRequired(databaseRecycle)).Methods("POST")
+	api.BaseRoutes.ApiRoot.Handle("/database/migration", api.ApiSessionRequired(getSchemaMigrationStatus)).Methods("GET")
 	api.BaseRoutes.ApiRoot.Handle("/caches/invalidate", api.ApiSessionRequired(invalidateCaches)).Methods("POST")
 
 	api.BaseRoutes.ApiRoot.Handle("/logs", api.ApiSessionRequired(getLogs)).Methods("GET")
 	api.BaseRoutes.ApiRoot.Handle("/logs", api.ApiHandler(postLog)).Methods("POST")
 
 	api.BaseRoutes.ApiRoot.Handle("/analytics/old", api.ApiSessionRequired(getAnalytics)).Methods("GET")
+	api.BaseRoutes.ApiRoot.Handle("/analytics/seat_usage", api.ApiSessionRequired(getSeatUsageForecast)).Methods("GET")
+	api.BaseRoutes.ApiRoot.Handle("/analytics/integrations", api.ApiSessionRequired(getIntegrationsUsage)).Methods("GET")
 
 	api.BaseRoutes.ApiRoot.Handle("/redirect_location", api.ApiSessionRequiredTrustRequester(getRedirectLocation)).Methods("GET")
 
@@ -67,6 +83,7 @@ func getSystemPing(c *Context, w http.ResponseWriter, r *http.Request) {
 	s["DesktopMinVersion"] = reqs.DesktopMinVersion
 	s["IosLatestVersion"] = reqs.IosLatestVersion
 	s["IosMinVersion"] = reqs.IosMinVersion
+	s["ReadOnlyMode"] = strconv.FormatBool(c.App.Srv().ReadOnly.IsReadOnly())
 
 	actualGoroutines := runtime.NumGoroutine()
 	if *c.App.Config().ServiceSettings.GoroutineHealthThreshold > 0 && actualGoroutines >= *c.App.Config().ServiceSettings.GoroutineHealthThreshold {
@@ -226,6 +243,21 @@ func databaseRecycle(c *Context, w http.ResponseWriter, r *http.Request) {
 	ReturnStatusOK(w)
 }
 
+func getSchemaMigrationStatus(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	status, err := c.App.GetSchemaMigrationStatus()
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(status.ToJson()))
+}
+
 func invalidateCaches(c *Context, w http.ResponseWriter, r *http.Request) {
 	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
 		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
@@ -273,6 +305,47 @@ func getLogs(c *Context, w http.ResponseWriter, r *http.Request) {
 	w.Write([]byte(model.ArrayToJson(lines)))
 }
 
+func exportDiagnostics(c *Context, w http.ResponseWriter, r *http.Request) {
+	auditRec := c.MakeAuditRecord("exportDiagnostics", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	export := c.App.Srv().ExportDiagnostics()
+
+	auditRec.Success()
+	auditRec.AddMeta("events", len(export.Events))
+
+	w.Write([]byte(export.ToJson()))
+}
+
+func generateSupportPacket(c *Context, w http.ResponseWriter, r *http.Request) {
+	auditRec := c.MakeAuditRecord("generateSupportPacket", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	// The packet is generated in memory, so that an error is reported before anything is sent.
+	var buf bytes.Buffer
+	if err := c.App.GenerateSupportPacket(&buf); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment;filename=\"%s\"", model.SupportPacketFileName(time.Now())))
+	w.Header().Set("Cache-Control", "private, no-cache")
+	w.Write(buf.Bytes())
+}
+
 func postLog(c *Context, w http.ResponseWriter, r *http.Request) {
 	forceToDebug := false
 
@@ -314,6 +387,7 @@ func postLog(c *Context, w http.ResponseWriter, r *http.Request) {
 func getAnalytics(c *Context, w http.ResponseWriter, r *http.Request) {
 	name := r.URL.Query().Get("name")
 	teamId := r.URL.Query().Get("team_id")
+	exact := r.URL.Query().Get("exact") == "true"
 
 	if name == "" {
 		name = "standard"
@@ -324,7 +398,7 @@ func getAnalytics(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	rows, err := c.App.GetAnalytics(name, teamId)
+	rows, err := c.App.GetAnalytics(name, teamId, exact)
 	if err != nil {
 		c.Err = err
 		return
@@ -338,6 +412,56 @@ func getAnalytics(c *Context, w http.ResponseWriter, r *http.Request) {
 	w.Write([]byte(rows.ToJson()))
 }
 
+func getSeatUsageForecast(c *Context, w http.ResponseWriter, r *http.Request) {
+	days := model.SEAT_USAGE_DEFAULT_DAYS
+	if daysStr := r.URL.Query().Get("days"); daysStr != "" {
+		var err error
+		days, err = strconv.Atoi(daysStr)
+		if err != nil || days <= 0 || days > model.SEAT_USAGE_MAX_DAYS {
+			c.SetInvalidParam("days")
+			return
+		}
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	forecast, err := c.App.GetSeatUsageForecast(days)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(forecast.ToJson()))
+}
+
+func getIntegrationsUsage(c *Context, w http.ResponseWriter, r *http.Request) {
+	days := model.INTEGRATION_USAGE_DEFAULT_DAYS
+	if daysStr := r.URL.Query().Get("days"); daysStr != "" {
+		var err error
+		days, err = strconv.Atoi(daysStr)
+		if err != nil || days <= 0 || days > model.INTEGRATION_USAGE_MAX_DAYS {
+			c.SetInvalidParam("days")
+			return
+		}
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	report, err := c.App.GetIntegrationsUsage(days)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(report.ToJson()))
+}
+
 func getSupportedTimezones(c *Context, w http.ResponseWriter, r *http.Request) {
 	supportedTimezones := c.App.Timezones().GetSupported()
 	if supportedTimezones == nil {
@@ -353,6 +477,10 @@ func getSupportedTimezones(c *Context, w http.ResponseWriter, r *http.Request) {
 	w.Write(b)
 }
 
+func getErrorCatalog(c *Context, w http.ResponseWriter, r *http.Request) {
+	w.Write([]byte(model.ErrorCatalogEntryListToJson(c.App.GetErrorCatalog())))
+}
+
 func testS3(c *Context, w http.ResponseWriter, r *http.Request) {
 	cfg := model.ConfigFromJson(r.Body)
 	if cfg == nil {
@@ -544,3 +672,180 @@ func getServerBusyExpires(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 	w.Write([]byte(c.App.Srv().Busy.ToJson()))
 }
+
+func createDebugCaptureRule(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	rule := model.DebugCaptureRuleFromJson(r.Body)
+	if rule == nil {
+		c.SetInvalidParam("rule")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("createDebugCaptureRule", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("endpoint", rule.Endpoint)
+	auditRec.AddMeta("user_id", rule.UserId)
+	auditRec.AddMeta("expires_at", rule.ExpiresAt)
+
+	rule.CreatorId = c.App.Session().UserId
+
+	rule, err := c.App.CreateDebugCaptureRule(rule)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("rule_id", rule.Id)
+	mlog.Info("Debug capture enabled", mlog.String("rule_id", rule.Id), mlog.String("endpoint", rule.Endpoint), mlog.String("user_id", rule.UserId), mlog.Int64("expires_at", rule.ExpiresAt))
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte(rule.ToJson()))
+}
+
+func getDebugCaptureRules(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	w.Write([]byte(model.DebugCaptureRuleListToJson(c.App.GetDebugCaptureRules())))
+}
+
+func deleteDebugCaptureRule(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireDebugCaptureRuleId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteDebugCaptureRule", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("rule_id", c.Params.DebugCaptureRuleId)
+
+	if err := c.App.DeleteDebugCaptureRule(c.Params.DebugCaptureRuleId); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	ReturnStatusOK(w)
+}
+
+func getDebugCaptures(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	ruleId := r.URL.Query().Get("rule_id")
+	if ruleId != "" && !model.IsValidId(ruleId) {
+		c.SetInvalidUrlParam("rule_id")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("getDebugCaptures", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("rule_id", ruleId)
+
+	captures := c.App.GetDebugCaptures(ruleId)
+
+	auditRec.Success()
+	w.Write([]byte(model.DebugCaptureListToJson(captures)))
+}
+
+func captureProfile(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	request := model.ProfileCaptureRequestFromJson(r.Body)
+	if request == nil {
+		c.SetInvalidParam("profile")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("captureProfile", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("type", request.Type)
+	auditRec.AddMeta("seconds", request.Seconds)
+
+	job, err := c.App.CaptureProfile(request.Type, request.Seconds, model.PROFILE_CAPTURE_REASON_MANUAL)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("job_id", job.Id)
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte(job.ToJson()))
+}
+
+func getProfiles(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	jobs, err := c.App.GetJobsByTypePage(model.JOB_TYPE_PROFILE_CAPTURE, c.Params.Page, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(model.JobsToJson(jobs)))
+}
+
+func downloadProfile(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireJobId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	job, err := c.App.GetJob(c.Params.JobId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if job.Type != model.JOB_TYPE_PROFILE_CAPTURE {
+		c.Err = model.NewAppError("downloadProfile", "api.system.download_profile.not_found.app_error", nil, "job_id="+job.Id, http.StatusNotFound)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("downloadProfile", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("job_id", job.Id)
+
+	artifact, err := c.App.GetJobArtifact(job)
+	if err != nil {
+		c.Err = err
+		return
+	}
+	defer artifact.Close()
+
+	size, _ := strconv.ParseInt(job.Data[model.JOB_DATA_ARTIFACT_SIZE], 10, 64)
+	err = writeFileResponse(job.Data[model.JOB_DATA_ARTIFACT_NAME], "application/octet-stream", size, time.Unix(0, job.LastActivityAt*int64(time.Millisecond)), *c.App.Config().ServiceSettings.WebserverMode, artifact, true, w, r)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+}
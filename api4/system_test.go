@@ -4,6 +4,8 @@
 package api4
 
 import (
+	"archive/zip"
+	"bytes"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -13,6 +15,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/mattermost/mattermost-server/v5/app"
 	"github.com/mattermost/mattermost-server/v5/mlog"
 	"github.com/mattermost/mattermost-server/v5/model"
 	"github.com/stretchr/testify/assert"
@@ -211,6 +214,24 @@ func TestDatabaseRecycle(t *testing.T) {
 	})
 }
 
+func TestGetSchemaMigrationStatus(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("as system user", func(t *testing.T) {
+		_, resp := th.Client.GetSchemaMigrationStatus()
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("as system admin", func(t *testing.T) {
+		status, resp := th.SystemAdminClient.GetSchemaMigrationStatus()
+		CheckNoError(t, resp)
+		require.NotNil(t, status)
+		assert.NotEqual(t, model.SCHEMA_MIGRATION_STATUS_RUNNING, status.Status, "the server only starts once the migration has finished")
+		assert.NotEmpty(t, status.CurrentVersion)
+	})
+}
+
 func TestInvalidateCaches(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -237,6 +258,49 @@ func TestInvalidateCaches(t *testing.T) {
 	})
 }
 
+func TestExportDiagnostics(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	_, resp := th.Client.ExportDiagnostics()
+	CheckForbiddenStatus(t, resp)
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		cfg.LogSettings.DiagnosticsCategories = []string{model.DIAGNOSTICS_CATEGORY_SERVER}
+	})
+
+	export, resp := th.SystemAdminClient.ExportDiagnostics()
+	CheckNoError(t, resp)
+	assert.Equal(t, th.App.DiagnosticId(), export.DiagnosticId)
+	assert.Equal(t, []string{model.DIAGNOSTICS_CATEGORY_SERVER}, export.Categories)
+	require.Len(t, export.Events, 1)
+	assert.Equal(t, app.TRACK_SERVER, export.Events[0].Event)
+	assert.Equal(t, model.CurrentVersion, export.Events[0].Properties["version"])
+}
+
+func TestGetErrorCatalog(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	entries, resp := th.Client.GetErrorCatalog()
+	CheckNoError(t, resp)
+	require.NotEmpty(t, entries)
+
+	var found *model.ErrorCatalogEntry
+	for _, entry := range entries {
+		if entry.Id == "api.context.404.app_error" {
+			found = entry
+		}
+	}
+	require.NotNil(t, found)
+	assert.Equal(t, []int{http.StatusNotFound}, found.StatusCodes)
+	assert.NotEmpty(t, found.Message)
+
+	th.Client.Logout()
+	_, resp = th.Client.GetErrorCatalog()
+	CheckUnauthorizedStatus(t, resp)
+}
+
 func TestGetLogs(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -346,6 +410,14 @@ func TestGetAnalyticsOld(t *testing.T) {
 	_, resp = th.SystemAdminClient.GetAnalyticsOld("extra_counts", "")
 	CheckNoError(t, resp)
 
+	r, err := th.SystemAdminClient.DoApiGet(th.SystemAdminClient.GetAnalyticsRoute()+"/old?name=standard&exact=true", "")
+	require.Nil(t, err)
+	exactRows := model.AnalyticsRowsFromJson(r.Body)
+	r.Body.Close()
+	require.NotEmpty(t, exactRows)
+	assert.Equal(t, "post_count", exactRows[2].Name)
+	assert.True(t, exactRows[2].Value >= 0)
+
 	rows, resp = th.SystemAdminClient.GetAnalyticsOld("", th.BasicTeam.Id)
 	CheckNoError(t, resp)
 
@@ -380,6 +452,75 @@ func TestGetAnalyticsOld(t *testing.T) {
 	CheckUnauthorizedStatus(t, resp)
 }
 
+func TestGetSeatUsageForecast(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	forecast, resp := th.Client.GetSeatUsageForecast(7)
+	CheckForbiddenStatus(t, resp)
+	require.Nil(t, forecast)
+
+	_, resp = th.SystemAdminClient.GetSeatUsageForecast(0)
+	CheckBadRequestStatus(t, resp)
+
+	_, resp = th.SystemAdminClient.GetSeatUsageForecast(model.SEAT_USAGE_MAX_DAYS + 1)
+	CheckBadRequestStatus(t, resp)
+
+	forecast, resp = th.SystemAdminClient.GetSeatUsageForecast(7)
+	CheckNoError(t, resp)
+	require.Len(t, forecast.History, 7)
+	assert.True(t, forecast.CurrentSeats >= 3, "the basic users should take seats")
+	assert.Equal(t, int64(0), forecast.LicensedSeats)
+	assert.False(t, forecast.Exceeded)
+
+	license := model.NewTestLicense()
+	license.Features.Users = model.NewInt(1)
+	th.App.Srv().SetLicense(license)
+	defer th.App.Srv().SetLicense(nil)
+
+	forecast, resp = th.SystemAdminClient.GetSeatUsageForecast(7)
+	CheckNoError(t, resp)
+	assert.Equal(t, int64(1), forecast.LicensedSeats)
+	assert.True(t, forecast.Exceeded)
+	assert.Equal(t, forecast.History[6].Day, forecast.ProjectedAt)
+}
+
+func TestGetIntegrationsUsage(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableIncomingWebhooks = true })
+
+	report, resp := th.Client.GetIntegrationsUsage(7)
+	CheckForbiddenStatus(t, resp)
+	require.Nil(t, report)
+
+	_, resp = th.SystemAdminClient.GetIntegrationsUsage(0)
+	CheckBadRequestStatus(t, resp)
+
+	_, resp = th.SystemAdminClient.GetIntegrationsUsage(model.INTEGRATION_USAGE_MAX_DAYS + 1)
+	CheckBadRequestStatus(t, resp)
+
+	hook, err := th.App.CreateIncomingWebhookForChannel(th.BasicUser.Id, th.BasicChannel, &model.IncomingWebhook{ChannelId: th.BasicChannel.Id})
+	require.Nil(t, err)
+	require.Nil(t, th.App.HandleIncomingWebhook(hook.Id, &model.IncomingWebhookRequest{Text: "hello"}))
+
+	report, resp = th.SystemAdminClient.GetIntegrationsUsage(7)
+	CheckNoError(t, resp)
+	assert.Equal(t, model.IntegrationUsageDay(model.GetMillis())-6*model.INTEGRATION_USAGE_DAY_MILLIS, report.StartDay)
+
+	var summary *model.IntegrationUsageSummary
+	for _, integration := range report.Integrations {
+		if integration.IntegrationId == hook.Id {
+			summary = integration
+		}
+	}
+	require.NotNil(t, summary)
+	assert.Equal(t, model.INTEGRATION_USAGE_TYPE_INCOMING_WEBHOOK, summary.Type)
+	assert.Equal(t, int64(1), summary.Posts)
+	assert.False(t, summary.Dormant)
+}
+
 func TestS3TestConnection(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -666,3 +807,164 @@ func TestPushNotificationAck(t *testing.T) {
 		assert.NotNil(t, resp.Body)
 	})
 }
+
+func TestDebugCapture(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("requires manage system permission", func(t *testing.T) {
+		_, resp := th.Client.CreateDebugCaptureRule(&model.DebugCaptureRule{UserId: th.BasicUser.Id, ExpiresAt: model.GetMillis() + 60000})
+		CheckForbiddenStatus(t, resp)
+
+		_, resp = th.Client.GetDebugCaptureRules()
+		CheckForbiddenStatus(t, resp)
+
+		_, resp = th.Client.GetDebugCaptures("")
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("rejects rules expiring too late", func(t *testing.T) {
+		rule := &model.DebugCaptureRule{UserId: th.BasicUser.Id, ExpiresAt: model.GetMillis() + 2*model.DEBUG_CAPTURE_MAX_DURATION}
+		_, resp := th.SystemAdminClient.CreateDebugCaptureRule(rule)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("rejects unknown log levels", func(t *testing.T) {
+		rule := &model.DebugCaptureRule{Endpoint: "getUser", LogLevel: "verbose", ExpiresAt: model.GetMillis() + 60000}
+		_, resp := th.SystemAdminClient.CreateDebugCaptureRule(rule)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("redacts the credentials of the configuration", func(t *testing.T) {
+		rule, resp := th.SystemAdminClient.CreateDebugCaptureRule(&model.DebugCaptureRule{
+			Endpoint:  "patchConfig",
+			LogLevel:  mlog.LevelDebug,
+			ExpiresAt: model.GetMillis() + 60000,
+		})
+		CheckNoError(t, resp)
+		require.Equal(t, mlog.LevelDebug, rule.LogLevel)
+		defer th.SystemAdminClient.DeleteDebugCaptureRule(rule.Id)
+
+		_, resp = th.SystemAdminClient.PatchConfig(&model.Config{
+			EmailSettings:  model.EmailSettings{SMTPPassword: model.NewString("smtp-password")},
+			GitLabSettings: model.SSOSettings{Secret: model.NewString("gitlab-secret")},
+		})
+		CheckNoError(t, resp)
+
+		captures, resp := th.SystemAdminClient.GetDebugCaptures(rule.Id)
+		CheckNoError(t, resp)
+		require.Len(t, captures, 1)
+		assert.NotContains(t, captures[0].RequestBody, "smtp-password")
+		assert.NotContains(t, captures[0].RequestBody, "gitlab-secret")
+		assert.Contains(t, captures[0].RequestBody, `"SMTPPassword":"********"`)
+	})
+
+	t.Run("captures the requests of the user to the endpoint", func(t *testing.T) {
+		rule, resp := th.SystemAdminClient.CreateDebugCaptureRule(&model.DebugCaptureRule{
+			Endpoint:  "getUser",
+			UserId:    th.BasicUser.Id,
+			ExpiresAt: model.GetMillis() + 60000,
+		})
+		CheckNoError(t, resp)
+		CheckCreatedStatus(t, resp)
+		require.NotEmpty(t, rule.Id)
+		require.Equal(t, th.SystemAdminUser.Id, rule.CreatorId)
+
+		rules, resp := th.SystemAdminClient.GetDebugCaptureRules()
+		CheckNoError(t, resp)
+		require.Len(t, rules, 1)
+
+		_, resp = th.Client.GetUser(th.BasicUser2.Id, "")
+		CheckNoError(t, resp)
+		_, resp = th.Client.GetMe("")
+		CheckNoError(t, resp)
+		_, resp = th.SystemAdminClient.GetUser(th.BasicUser2.Id, "")
+		CheckNoError(t, resp)
+
+		captures, resp := th.SystemAdminClient.GetDebugCaptures(rule.Id)
+		CheckNoError(t, resp)
+		require.Len(t, captures, 1)
+		assert.Equal(t, th.BasicUser.Id, captures[0].UserId)
+		assert.Equal(t, "getUser", captures[0].Endpoint)
+		assert.Equal(t, http.StatusOK, captures[0].StatusCode)
+		assert.Equal(t, "********", captures[0].RequestHeaders[model.HEADER_AUTH])
+		assert.Contains(t, captures[0].ResponseBody, th.BasicUser2.Id)
+
+		ok, resp := th.SystemAdminClient.DeleteDebugCaptureRule(rule.Id)
+		CheckNoError(t, resp)
+		require.True(t, ok)
+
+		_, resp = th.Client.GetUser(th.BasicUser2.Id, "")
+		CheckNoError(t, resp)
+
+		captures, resp = th.SystemAdminClient.GetDebugCaptures(rule.Id)
+		CheckNoError(t, resp)
+		require.Len(t, captures, 1)
+
+		_, resp = th.SystemAdminClient.DeleteDebugCaptureRule(rule.Id)
+		CheckNotFoundStatus(t, resp)
+	})
+}
+
+func TestCaptureProfile(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("requires manage system permission", func(t *testing.T) {
+		_, resp := th.Client.CaptureProfile(&model.ProfileCaptureRequest{Type: model.PROFILE_TYPE_HEAP})
+		CheckForbiddenStatus(t, resp)
+
+		_, resp = th.Client.GetProfiles(0, 10)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("rejects unknown profile types", func(t *testing.T) {
+		_, resp := th.SystemAdminClient.CaptureProfile(&model.ProfileCaptureRequest{Type: "block"})
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("captures a downloadable heap profile", func(t *testing.T) {
+		job, resp := th.SystemAdminClient.CaptureProfile(&model.ProfileCaptureRequest{Type: model.PROFILE_TYPE_HEAP})
+		CheckNoError(t, resp)
+		CheckCreatedStatus(t, resp)
+		require.Equal(t, model.JOB_TYPE_PROFILE_CAPTURE, job.Type)
+		require.Equal(t, model.PROFILE_CAPTURE_REASON_MANUAL, job.Data["reason"])
+
+		require.Eventually(t, func() bool {
+			job, resp = th.SystemAdminClient.GetJob(job.Id)
+			return resp.Error == nil && job.Status == model.JOB_STATUS_SUCCESS
+		}, 5*time.Second, 100*time.Millisecond)
+
+		jobs, resp := th.SystemAdminClient.GetProfiles(0, 10)
+		CheckNoError(t, resp)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, job.Id, jobs[0].Id)
+
+		data, resp := th.SystemAdminClient.DownloadProfile(job.Id)
+		CheckNoError(t, resp)
+		assert.NotEmpty(t, data)
+		assert.Equal(t, job.Data[model.JOB_DATA_ARTIFACT_SIZE], strconv.Itoa(len(data)))
+
+		_, resp = th.Client.DownloadProfile(job.Id)
+		CheckForbiddenStatus(t, resp)
+	})
+}
+
+func TestGenerateSupportPacket(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("requires manage system permission", func(t *testing.T) {
+		_, resp := th.Client.GenerateSupportPacket()
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("returns a zip archive", func(t *testing.T) {
+		data, resp := th.SystemAdminClient.GenerateSupportPacket()
+		CheckNoError(t, resp)
+
+		archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		require.NoError(t, err)
+		require.NotEmpty(t, archive.File)
+	})
+}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/mattermost/mattermost-server/v5/audit"
 	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/web"
 )
 
 const (
@@ -36,6 +37,7 @@ func (api *API) InitTeam() {
 	api.BaseRoutes.Teams.Handle("", api.ApiSessionRequired(getAllTeams)).Methods("GET")
 	api.BaseRoutes.Teams.Handle("/{team_id:[A-Za-z0-9]+}/scheme", api.ApiSessionRequired(updateTeamScheme)).Methods("PUT")
 	api.BaseRoutes.Teams.Handle("/search", api.ApiSessionRequiredDisableWhenBusy(searchTeams)).Methods("POST")
+	api.BaseRoutes.Teams.Handle("/active_member_counts", api.ApiSessionRequired(getTeamsActiveMemberCounts)).Methods("POST")
 	api.BaseRoutes.TeamsForUser.Handle("", api.ApiSessionRequired(getTeamsForUser)).Methods("GET")
 	api.BaseRoutes.TeamsForUser.Handle("/unread", api.ApiSessionRequired(getTeamsUnreadForUser)).Methods("GET")
 
@@ -44,9 +46,13 @@ func (api *API) InitTeam() {
 	api.BaseRoutes.Team.Handle("", api.ApiSessionRequired(deleteTeam)).Methods("DELETE")
 	api.BaseRoutes.Team.Handle("/patch", api.ApiSessionRequired(patchTeam)).Methods("PUT")
 	api.BaseRoutes.Team.Handle("/restore", api.ApiSessionRequired(restoreTeam)).Methods("POST")
+	api.BaseRoutes.Team.Handle("/purge/cancel", api.ApiSessionRequired(cancelTeamPurge)).Methods("POST")
 	api.BaseRoutes.Team.Handle("/privacy", api.ApiSessionRequired(updateTeamPrivacy)).Methods("PUT")
 	api.BaseRoutes.Team.Handle("/stats", api.ApiSessionRequired(getTeamStats)).Methods("GET")
 	api.BaseRoutes.Team.Handle("/regenerate_invite_id", api.ApiSessionRequired(regenerateTeamInviteId)).Methods("POST")
+	api.BaseRoutes.Team.Handle("/posting_restrictions", api.ApiSessionRequired(updateTeamPostingRestrictions)).Methods("PUT")
+	api.BaseRoutes.Team.Handle("/notify_defaults", api.ApiSessionRequired(getTeamNotifyDefaults)).Methods("GET")
+	api.BaseRoutes.Team.Handle("/notify_defaults", api.ApiSessionRequired(updateTeamNotifyDefaults)).Methods("PUT")
 
 	api.BaseRoutes.Team.Handle("/image", api.ApiSessionRequiredTrustRequester(getTeamIcon)).Methods("GET")
 	api.BaseRoutes.Team.Handle("/image", api.ApiSessionRequired(setTeamIcon)).Methods("POST")
@@ -83,6 +89,8 @@ func createTeam(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	team.Email = strings.ToLower(team.Email)
+	// Team props hold server managed settings such as posting restrictions.
+	team.Props = nil
 
 	auditRec := c.MakeAuditRecord("createTeam", audit.Fail)
 	defer c.LogAuditRec(auditRec)
@@ -135,7 +143,7 @@ func getTeamByName(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	team, err := c.App.GetTeamByName(c.Params.TeamName)
+	team, err := c.App.ResolveTeamByName(c.Params.TeamName)
 	if err != nil {
 		c.Err = err
 		return
@@ -267,6 +275,33 @@ func restoreTeam(c *Context, w http.ResponseWriter, r *http.Request) {
 	w.Write([]byte(team.ToJson()))
 }
 
+func cancelTeamPurge(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("cancelTeamPurge", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("team_id", c.Params.TeamId)
+
+	if !c.App.SessionHasPermissionToTeam(*c.App.Session(), c.Params.TeamId, model.PERMISSION_MANAGE_TEAM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_TEAM)
+		return
+	}
+
+	team, err := c.App.CancelTeamPurge(c.Params.TeamId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.AddMeta("team", team)
+	auditRec.Success()
+
+	w.Write([]byte(team.ToJson()))
+}
+
 func updateTeamPrivacy(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireTeamId()
 	if c.Err != nil {
@@ -330,10 +365,21 @@ func regenerateTeamInviteId(c *Context, w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	var inviteExpiresAt int64
+	if expiresAt := r.URL.Query().Get("expires_at"); expiresAt != "" {
+		var parseErr error
+		inviteExpiresAt, parseErr = strconv.ParseInt(expiresAt, 10, 64)
+		if parseErr != nil || inviteExpiresAt <= model.GetMillis() {
+			c.SetInvalidUrlParam("expires_at")
+			return
+		}
+	}
+
 	auditRec := c.MakeAuditRecord("regenerateTeamInviteId", audit.Fail)
 	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("invite_expires_at", inviteExpiresAt)
 
-	patchedTeam, err := c.App.RegenerateTeamInviteId(c.Params.TeamId)
+	patchedTeam, err := c.App.RegenerateTeamInviteId(c.Params.TeamId, inviteExpiresAt)
 	if err != nil {
 		c.Err = err
 		return
@@ -397,7 +443,24 @@ func getTeamsForUser(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	teams, err := c.App.GetTeamsForUser(c.Params.UserId)
+	sort := r.URL.Query().Get("sort")
+	if sort != "" && sort != model.TEAMS_FOR_USER_SORT_DISPLAY_NAME && sort != model.TEAMS_FOR_USER_SORT_NAME && sort != model.TEAMS_FOR_USER_SORT_CREATE_AT {
+		c.SetInvalidParam("sort")
+		return
+	}
+
+	opts := &model.TeamsForUserGetOptions{
+		IncludeDeleted: c.Params.IncludeDeleted,
+		Sort:           sort,
+	}
+
+	// Teams are only paged when asked to, as clients have long relied on getting all of them.
+	if r.URL.Query().Get("page") != "" || r.URL.Query().Get("per_page") != "" {
+		opts.Page = c.Params.Page
+		opts.PerPage = c.Params.PerPage
+	}
+
+	teams, err := c.App.GetTeamsForUserWithOptions(c.Params.UserId, opts)
 	if err != nil {
 		c.Err = err
 		return
@@ -421,6 +484,15 @@ func getTeamsUnreadForUser(c *Context, w http.ResponseWriter, r *http.Request) {
 	// optional team id to be excluded from the result
 	teamId := r.URL.Query().Get("exclude_team")
 
+	// Replies always count towards the unreads of their channel, since the read state of threads
+	// isn't tracked apart from it, so the totals are the same whether collapsed threads are included.
+	if includeCollapsedThreads := r.URL.Query().Get("include_collapsed_threads"); includeCollapsedThreads != "" {
+		if _, err := strconv.ParseBool(includeCollapsedThreads); err != nil {
+			c.SetInvalidParam("include_collapsed_threads")
+			return
+		}
+	}
+
 	unreadTeamsList, err := c.App.GetTeamsUnreadForUser(teamId, c.Params.UserId)
 	if err != nil {
 		c.Err = err
@@ -471,6 +543,17 @@ func getTeamMembers(c *Context, w http.ResponseWriter, r *http.Request) {
 	excludeDeletedUsers := r.URL.Query().Get("exclude_deleted_users")
 	excludeDeletedUsersBool, _ := strconv.ParseBool(excludeDeletedUsers)
 
+	roles := []string{}
+	if rolesString := r.URL.Query().Get("roles"); rolesString != "" {
+		for _, role := range strings.Split(rolesString, ",") {
+			if role != model.TEAM_ADMIN_ROLE_ID && role != model.TEAM_USER_ROLE_ID && role != model.TEAM_GUEST_ROLE_ID {
+				c.SetInvalidParam("roles")
+				return
+			}
+			roles = append(roles, role)
+		}
+	}
+
 	if !c.App.SessionHasPermissionToTeam(*c.App.Session(), c.Params.TeamId, model.PERMISSION_VIEW_TEAM) {
 		c.SetPermissionError(model.PERMISSION_VIEW_TEAM)
 		return
@@ -486,6 +569,7 @@ func getTeamMembers(c *Context, w http.ResponseWriter, r *http.Request) {
 		Sort:                sort,
 		ExcludeDeletedUsers: excludeDeletedUsersBool,
 		ViewRestrictions:    restrictions,
+		Roles:               roles,
 	}
 
 	members, err := c.App.GetTeamMembers(c.Params.TeamId, c.Params.Page*c.Params.PerPage, c.Params.PerPage, teamMembersGetOptions)
@@ -519,7 +603,38 @@ func getTeamMembersForUser(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	members, err := c.App.GetTeamMembersForUser(c.Params.UserId)
+	query := r.URL.Query()
+
+	// Team members are only paged when asked to, as clients have long relied on getting all of them.
+	if query.Get("page") == "" && query.Get("per_page") == "" && query.Get("sort") == "" && query.Get("exclude_deleted") == "" {
+		members, err := c.App.GetTeamMembersForUser(c.Params.UserId)
+		if err != nil {
+			c.Err = err
+			return
+		}
+
+		w.Write([]byte(model.TeamMembersToJson(members)))
+		return
+	}
+
+	opts := &model.TeamMembersForUserGetOptions{
+		Sort: query.Get("sort"),
+	}
+
+	if opts.Sort != "" && opts.Sort != model.TEAMS_FOR_USER_SORT_DISPLAY_NAME {
+		c.SetInvalidParam("sort")
+		return
+	}
+
+	if excludeDeleted := query.Get("exclude_deleted"); excludeDeleted != "" {
+		var parseErr error
+		if opts.ExcludeDeleted, parseErr = strconv.ParseBool(excludeDeleted); parseErr != nil {
+			c.SetInvalidParam("exclude_deleted")
+			return
+		}
+	}
+
+	members, err := c.App.GetTeamMembersForUserWithPagination(c.Params.UserId, c.Params.Page, c.Params.PerPage, opts)
 	if err != nil {
 		c.Err = err
 		return
@@ -645,6 +760,7 @@ func addTeamMember(c *Context, w http.ResponseWriter, r *http.Request) {
 func addUserToTeamFromInvite(c *Context, w http.ResponseWriter, r *http.Request) {
 	tokenId := r.URL.Query().Get("token")
 	inviteId := r.URL.Query().Get("invite_id")
+	inviteLink := r.URL.Query().Get("invite_link")
 
 	var member *model.TeamMember
 	var err *model.AppError
@@ -652,6 +768,7 @@ func addUserToTeamFromInvite(c *Context, w http.ResponseWriter, r *http.Request)
 	auditRec := c.MakeAuditRecord("addUserToTeamFromInvite", audit.Fail)
 	defer c.LogAuditRec(auditRec)
 	auditRec.AddMeta("invite_id", inviteId)
+	auditRec.AddMeta("invite_link", inviteLink)
 
 	if len(tokenId) > 0 {
 		member, err = c.App.AddTeamMemberByToken(c.App.Session().UserId, tokenId)
@@ -662,6 +779,13 @@ func addUserToTeamFromInvite(c *Context, w http.ResponseWriter, r *http.Request)
 		}
 
 		member, err = c.App.AddTeamMemberByInviteId(inviteId, c.App.Session().UserId)
+	} else if len(inviteLink) > 0 {
+		if c.App.Session().Props[model.SESSION_PROP_IS_GUEST] == "true" {
+			c.Err = model.NewAppError("addUserToTeamFromInvite", "api.team.add_user_to_team_from_invite.guest.app_error", nil, "", http.StatusForbidden)
+			return
+		}
+
+		member, err = c.App.AddTeamMemberByInviteLink(inviteLink, c.App.Session().UserId)
 	} else {
 		err = model.NewAppError("addTeamMember", "api.team.add_user_to_team.missing_parameter.app_error", nil, "", http.StatusBadRequest)
 	}
@@ -878,6 +1002,35 @@ func getTeamStats(c *Context, w http.ResponseWriter, r *http.Request) {
 	w.Write([]byte(stats.ToJson()))
 }
 
+func getTeamsActiveMemberCounts(c *Context, w http.ResponseWriter, r *http.Request) {
+	teamIds := model.ArrayFromJson(r.Body)
+	if len(teamIds) == 0 || len(teamIds) > web.PER_PAGE_MAXIMUM {
+		c.SetInvalidParam("team_ids")
+		return
+	}
+
+	for _, teamId := range teamIds {
+		if !model.IsValidId(teamId) {
+			c.SetInvalidParam("team_ids")
+			return
+		}
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	counts, err := c.App.GetTeamsActiveMemberCounts(teamIds)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	b, _ := json.Marshal(counts)
+	w.Write(b)
+}
+
 func updateTeamMemberRoles(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireTeamId().RequireUserId()
 	if c.Err != nil {
@@ -892,16 +1045,23 @@ func updateTeamMemberRoles(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	expiresAt, ok := rolesExpiresAtFromProps(props)
+	if !ok {
+		c.SetInvalidParam("expires_at")
+		return
+	}
+
 	auditRec := c.MakeAuditRecord("updateTeamMemberRoles", audit.Fail)
 	defer c.LogAuditRec(auditRec)
 	auditRec.AddMeta("roles", newRoles)
+	auditRec.AddMeta("expires_at", expiresAt)
 
 	if !c.App.SessionHasPermissionToTeam(*c.App.Session(), c.Params.TeamId, model.PERMISSION_MANAGE_TEAM_ROLES) {
 		c.SetPermissionError(model.PERMISSION_MANAGE_TEAM_ROLES)
 		return
 	}
 
-	teamMember, err := c.App.UpdateTeamMemberRoles(c.Params.TeamId, c.Params.UserId, newRoles)
+	teamMember, err := c.App.UpdateTeamMemberRolesWithExpiry(c.Params.TeamId, c.Params.UserId, newRoles, expiresAt)
 	if err != nil {
 		c.Err = err
 		return
@@ -947,6 +1107,11 @@ func updateTeamMemberSchemeRoles(c *Context, w http.ResponseWriter, r *http.Requ
 }
 
 func getAllTeams(c *Context, w http.ResponseWriter, r *http.Request) {
+	if onlyDeleted, _ := strconv.ParseBool(r.URL.Query().Get("only_deleted")); onlyDeleted {
+		getDeletedTeams(c, w, r)
+		return
+	}
+
 	teams := []*model.Team{}
 	var err *model.AppError
 	var teamsWithCount *model.TeamsWithCount
@@ -993,6 +1158,23 @@ func getAllTeams(c *Context, w http.ResponseWriter, r *http.Request) {
 	w.Write(resBody)
 }
 
+func getDeletedTeams(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	teams, err := c.App.GetAllDeletedTeamsPage(c.Params.Page*c.Params.PerPage, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	c.App.SanitizeTeams(*c.App.Session(), teams)
+
+	w.Write([]byte(model.TeamListToJson(teams)))
+}
+
 func searchTeams(c *Context, w http.ResponseWriter, r *http.Request) {
 	props := model.TeamSearchFromJson(r.Body)
 	if props == nil {
@@ -1005,6 +1187,11 @@ func searchTeams(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := props.TeamSearchOpts.IsValid(); err != nil {
+		c.Err = err
+		return
+	}
+
 	var teams []*model.Team
 	var totalCount int64
 	var err *model.AppError
@@ -1016,13 +1203,13 @@ func searchTeams(c *Context, w http.ResponseWriter, r *http.Request) {
 			c.Err = model.NewAppError("searchTeams", "api.team.search_teams.pagination_not_implemented.private_team_search", nil, "", http.StatusNotImplemented)
 			return
 		}
-		teams, err = c.App.SearchPrivateTeams(props.Term)
+		teams, err = c.App.SearchPrivateTeams(props)
 	} else if c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_LIST_PUBLIC_TEAMS) {
 		if props.Page != nil || props.PerPage != nil {
 			c.Err = model.NewAppError("searchTeams", "api.team.search_teams.pagination_not_implemented.public_team_search", nil, "", http.StatusNotImplemented)
 			return
 		}
-		teams, err = c.App.SearchPublicTeams(props.Term)
+		teams, err = c.App.SearchPublicTeams(props)
 	} else {
 		teams = []*model.Team{}
 	}
@@ -1505,6 +1692,93 @@ func updateTeamScheme(c *Context, w http.ResponseWriter, r *http.Request) {
 	ReturnStatusOK(w)
 }
 
+func updateTeamPostingRestrictions(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	restrictions := model.PostingRestrictionsFromJson(r.Body)
+	if restrictions == nil {
+		c.SetInvalidParam("posting_restrictions")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("updateTeamPostingRestrictions", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("team_id", c.Params.TeamId)
+	auditRec.AddMeta("restrictions", restrictions)
+
+	if !c.App.SessionHasPermissionToTeam(*c.App.Session(), c.Params.TeamId, model.PERMISSION_MANAGE_TEAM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_TEAM)
+		return
+	}
+
+	team, err := c.App.UpdateTeamPostingRestrictions(c.Params.TeamId, restrictions)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	c.App.SanitizeTeam(*c.App.Session(), team)
+	w.Write([]byte(team.ToJson()))
+}
+
+func getTeamNotifyDefaults(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToTeam(*c.App.Session(), c.Params.TeamId, model.PERMISSION_VIEW_TEAM) {
+		c.SetPermissionError(model.PERMISSION_VIEW_TEAM)
+		return
+	}
+
+	defaults, err := c.App.GetTeamNotifyDefaults(c.Params.TeamId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(model.MapToJson(defaults)))
+}
+
+func updateTeamNotifyDefaults(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	var defaults model.StringMap
+	if jsonErr := json.NewDecoder(r.Body).Decode(&defaults); jsonErr != nil || defaults == nil {
+		c.SetInvalidParam("notify_defaults")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("updateTeamNotifyDefaults", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("team_id", c.Params.TeamId)
+	auditRec.AddMeta("notify_defaults", defaults)
+
+	if !c.App.SessionHasPermissionToTeam(*c.App.Session(), c.Params.TeamId, model.PERMISSION_MANAGE_TEAM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_TEAM)
+		return
+	}
+
+	team, err := c.App.SetTeamNotifyDefaults(c.Params.TeamId, defaults)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	w.Write([]byte(model.MapToJson(team.GetNotifyDefaults())))
+}
+
 func teamMembersMinusGroupMembers(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireTeamId()
 	if c.Err != nil {
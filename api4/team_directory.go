@@ -0,0 +1,62 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/audit"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func (api *API) InitTeamDirectory() {
+	api.BaseRoutes.Teams.Handle("/directory/search", api.ApiSessionRequiredDisableWhenBusy(searchTeamDirectory)).Methods("GET")
+	api.BaseRoutes.Team.Handle("/directory_categories", api.ApiSessionRequired(setTeamDirectoryCategories)).Methods("PUT")
+}
+
+func searchTeamDirectory(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_LIST_PUBLIC_TEAMS) {
+		c.SetPermissionError(model.PERMISSION_LIST_PUBLIC_TEAMS)
+		return
+	}
+
+	query := r.URL.Query()
+	directory, err := c.App.GetTeamDirectory(query.Get("term"), query.Get("category"), c.Params.Page, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(directory.ToJson()))
+}
+
+func setTeamDirectoryCategories(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	categories := model.ArrayFromJson(r.Body)
+
+	auditRec := c.MakeAuditRecord("setTeamDirectoryCategories", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("team_id", c.Params.TeamId)
+	auditRec.AddMeta("categories", categories)
+
+	if !c.App.SessionHasPermissionToTeam(*c.App.Session(), c.Params.TeamId, model.PERMISSION_MANAGE_TEAM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_TEAM)
+		return
+	}
+
+	team, err := c.App.SetTeamDirectoryCategories(c.Params.TeamId, categories)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	c.App.SanitizeTeam(*c.App.Session(), team)
+	w.Write([]byte(team.ToJson()))
+}
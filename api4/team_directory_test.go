@@ -0,0 +1,75 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestTeamDirectory(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	createTeam := func(displayName string, allowOpenInvite bool) *model.Team {
+		team, err := th.App.CreateTeam(&model.Team{
+			DisplayName:     displayName,
+			Name:            GenerateTestTeamName(),
+			Email:           th.GenerateTestEmail(),
+			Type:            model.TEAM_OPEN,
+			AllowOpenInvite: allowOpenInvite,
+		})
+		require.Nil(t, err)
+		return team
+	}
+
+	bigTeam := createTeam("Directory big", true)
+	smallTeam := createTeam("Directory small", true)
+	inviteOnlyTeam := createTeam("Directory invite only", false)
+
+	th.LinkUserToTeam(th.BasicUser, bigTeam)
+	th.LinkUserToTeam(th.BasicUser2, bigTeam)
+	th.LinkUserToTeam(th.BasicUser, smallTeam)
+
+	t.Run("should require permission to manage the team to set categories", func(t *testing.T) {
+		_, resp := th.Client.SetTeamDirectoryCategories(bigTeam.Id, []string{"engineering"})
+		CheckForbiddenStatus(t, resp)
+	})
+
+	team, resp := th.SystemAdminClient.SetTeamDirectoryCategories(bigTeam.Id, []string{" Engineering", "design"})
+	CheckNoError(t, resp)
+	require.Equal(t, []string{"engineering", "design"}, team.GetDirectoryCategories())
+
+	t.Run("should rank open teams by size", func(t *testing.T) {
+		directory, resp := th.Client.SearchTeamDirectory("directory", "", 0, 60)
+		CheckNoError(t, resp)
+		require.Equal(t, int64(2), directory.TotalCount)
+		require.Equal(t, bigTeam.Id, directory.Entries[0].Team.Id)
+		require.Equal(t, int64(2), directory.Entries[0].MemberCount)
+		require.Equal(t, smallTeam.Id, directory.Entries[1].Team.Id)
+		require.Empty(t, directory.Entries[0].Team.Email)
+
+		for _, entry := range directory.Entries {
+			require.NotEqual(t, inviteOnlyTeam.Id, entry.Team.Id)
+		}
+	})
+
+	t.Run("should filter by category", func(t *testing.T) {
+		directory, resp := th.Client.SearchTeamDirectory("", "engineering", 0, 60)
+		CheckNoError(t, resp)
+		require.Len(t, directory.Entries, 1)
+		require.Equal(t, bigTeam.Id, directory.Entries[0].Team.Id)
+	})
+
+	t.Run("should paginate", func(t *testing.T) {
+		directory, resp := th.Client.SearchTeamDirectory("directory", "", 1, 1)
+		CheckNoError(t, resp)
+		require.Equal(t, int64(2), directory.TotalCount)
+		require.Len(t, directory.Entries, 1)
+		require.Equal(t, smallTeam.Id, directory.Entries[0].Team.Id)
+	})
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/audit"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func (api *API) InitTeamInviteLink() {
+	api.BaseRoutes.TeamInviteLinks.Handle("", api.ApiSessionRequired(getTeamInviteLinks)).Methods("GET")
+	api.BaseRoutes.TeamInviteLinks.Handle("", api.ApiSessionRequired(createTeamInviteLink)).Methods("POST")
+	api.BaseRoutes.TeamInviteLink.Handle("", api.ApiSessionRequired(getTeamInviteLink)).Methods("GET")
+	api.BaseRoutes.TeamInviteLink.Handle("", api.ApiSessionRequired(revokeTeamInviteLink)).Methods("DELETE")
+}
+
+func getTeamInviteLinks(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToTeam(*c.App.Session(), c.Params.TeamId, model.PERMISSION_MANAGE_TEAM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_TEAM)
+		return
+	}
+
+	links, err := c.App.GetTeamInviteLinks(c.Params.TeamId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(model.TeamInviteLinkListToJson(links)))
+}
+
+func getTeamInviteLink(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId().RequireInviteLinkId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToTeam(*c.App.Session(), c.Params.TeamId, model.PERMISSION_MANAGE_TEAM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_TEAM)
+		return
+	}
+
+	link, err := getTeamInviteLinkForTeam(c)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(link.ToJson()))
+}
+
+func createTeamInviteLink(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	link := model.TeamInviteLinkFromJson(r.Body)
+	if link == nil {
+		c.SetInvalidParam("invite_link")
+		return
+	}
+	link.TeamId = c.Params.TeamId
+
+	auditRec := c.MakeAuditRecord("createTeamInviteLink", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("team_id", c.Params.TeamId)
+
+	if !c.App.SessionHasPermissionToTeam(*c.App.Session(), c.Params.TeamId, model.PERMISSION_MANAGE_TEAM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_TEAM)
+		return
+	}
+
+	createdLink, err := c.App.CreateTeamInviteLink(link, c.App.Session().UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("invite_link", createdLink)
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte(createdLink.ToJson()))
+}
+
+func revokeTeamInviteLink(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId().RequireInviteLinkId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("revokeTeamInviteLink", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("team_id", c.Params.TeamId)
+	auditRec.AddMeta("invite_link_id", c.Params.InviteLinkId)
+
+	if !c.App.SessionHasPermissionToTeam(*c.App.Session(), c.Params.TeamId, model.PERMISSION_MANAGE_TEAM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_TEAM)
+		return
+	}
+
+	if _, err := getTeamInviteLinkForTeam(c); err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := c.App.RevokeTeamInviteLink(c.Params.InviteLinkId); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	ReturnStatusOK(w)
+}
+
+// getTeamInviteLinkForTeam returns the invite link of the request, making sure it belongs to the
+// team of the request.
+func getTeamInviteLinkForTeam(c *Context) (*model.TeamInviteLink, *model.AppError) {
+	link, err := c.App.GetTeamInviteLink(c.Params.InviteLinkId)
+	if err != nil {
+		return nil, err
+	}
+
+	if link.TeamId != c.Params.TeamId {
+		return nil, model.NewAppError("getTeamInviteLinkForTeam", "app.team_invite_link.get.not_found.app_error", nil, "", http.StatusNotFound)
+	}
+
+	return link, nil
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func newTeamInviteLink(teamId string) *model.TeamInviteLink {
+	return &model.TeamInviteLink{
+		TeamId:      teamId,
+		Name:        "link-" + model.NewId(),
+		DisplayName: "Onboarding",
+	}
+}
+
+func TestCreateTeamInviteLink(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("should create a link", func(t *testing.T) {
+		link := newTeamInviteLink(th.BasicTeam.Id)
+		link.ChannelIds = []string{th.BasicChannel.Id}
+		created, resp := th.SystemAdminClient.CreateTeamInviteLink(link)
+		CheckNoError(t, resp)
+		CheckCreatedStatus(t, resp)
+		require.NotEmpty(t, created.Id)
+		require.Equal(t, th.SystemAdminUser.Id, created.CreatorId)
+		require.Equal(t, model.TEAM_USER_ROLE_ID, created.Role)
+	})
+
+	t.Run("should fail with a taken name", func(t *testing.T) {
+		link := newTeamInviteLink(th.BasicTeam.Id)
+		_, resp := th.SystemAdminClient.CreateTeamInviteLink(link)
+		CheckNoError(t, resp)
+
+		_, resp = th.SystemAdminClient.CreateTeamInviteLink(link)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("should fail with a channel of another team", func(t *testing.T) {
+		otherTeam := th.CreateTeam()
+		link := newTeamInviteLink(otherTeam.Id)
+		link.ChannelIds = []string{th.BasicChannel.Id}
+		_, resp := th.SystemAdminClient.CreateTeamInviteLink(link)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("should fail without permission to manage the team", func(t *testing.T) {
+		_, resp := th.Client.CreateTeamInviteLink(newTeamInviteLink(th.BasicTeam.Id))
+		CheckForbiddenStatus(t, resp)
+
+		_, resp = th.Client.GetTeamInviteLinks(th.BasicTeam.Id)
+		CheckForbiddenStatus(t, resp)
+	})
+}
+
+func TestRevokeTeamInviteLink(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	created, resp := th.SystemAdminClient.CreateTeamInviteLink(newTeamInviteLink(th.BasicTeam.Id))
+	CheckNoError(t, resp)
+
+	links, resp := th.SystemAdminClient.GetTeamInviteLinks(th.BasicTeam.Id)
+	CheckNoError(t, resp)
+	require.Len(t, links, 1)
+
+	otherTeam := th.CreateTeam()
+	_, resp = th.SystemAdminClient.GetTeamInviteLink(otherTeam.Id, created.Id)
+	CheckNotFoundStatus(t, resp)
+
+	_, resp = th.Client.RevokeTeamInviteLink(th.BasicTeam.Id, created.Id)
+	CheckForbiddenStatus(t, resp)
+
+	ok, resp := th.SystemAdminClient.RevokeTeamInviteLink(th.BasicTeam.Id, created.Id)
+	CheckNoError(t, resp)
+	require.True(t, ok)
+
+	links, resp = th.SystemAdminClient.GetTeamInviteLinks(th.BasicTeam.Id)
+	CheckNoError(t, resp)
+	require.Empty(t, links)
+
+	_, resp = th.SystemAdminClient.RevokeTeamInviteLink(th.BasicTeam.Id, created.Id)
+	CheckNotFoundStatus(t, resp)
+
+	user := th.CreateUser()
+	th.Client.Login(user.Email, user.Password)
+	_, resp = th.Client.AddTeamMemberFromInviteLink(created.Name)
+	CheckBadRequestStatus(t, resp)
+}
+
+func TestAddTeamMemberFromInviteLink(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	Client := th.Client
+
+	link := newTeamInviteLink(th.BasicTeam.Id)
+	link.MaxUses = 1
+	link.Role = model.TEAM_ADMIN_ROLE_ID
+	link.ChannelIds = []string{th.BasicChannel.Id}
+	created, resp := th.SystemAdminClient.CreateTeamInviteLink(link)
+	CheckNoError(t, resp)
+
+	user := th.CreateUser()
+	Client.Login(user.Email, user.Password)
+
+	member, resp := Client.AddTeamMemberFromInviteLink(created.Name)
+	CheckNoError(t, resp)
+	CheckCreatedStatus(t, resp)
+	require.Equal(t, th.BasicTeam.Id, member.TeamId)
+	require.True(t, member.SchemeAdmin)
+
+	_, resp = Client.GetChannelMember(th.BasicChannel.Id, user.Id, "")
+	CheckNoError(t, resp)
+
+	// Joining again doesn't use up the link.
+	_, resp = Client.AddTeamMemberFromInviteLink(created.Name)
+	CheckNoError(t, resp)
+
+	fetched, resp := th.SystemAdminClient.GetTeamInviteLink(th.BasicTeam.Id, created.Id)
+	CheckNoError(t, resp)
+	require.Equal(t, int64(1), fetched.UseCount)
+
+	otherUser := th.CreateUser()
+	Client.Login(otherUser.Email, otherUser.Password)
+	_, resp = Client.AddTeamMemberFromInviteLink(created.Name)
+	CheckBadRequestStatus(t, resp)
+
+	_, resp = Client.AddTeamMemberFromInviteLink("unknown-" + model.NewId())
+	CheckBadRequestStatus(t, resp)
+}
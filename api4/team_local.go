@@ -87,7 +87,7 @@ func localInviteUsersToTeam(c *Context, w http.ResponseWriter, r *http.Request)
 	auditRec.AddMeta("count", len(emailList))
 	auditRec.AddMeta("emails", emailList)
 
-	team, err := c.App.Srv().Store.Team().Get(c.Params.TeamId)
+	team, err := c.App.GetTeam(c.Params.TeamId)
 	if err != nil {
 		c.Err = err
 		return
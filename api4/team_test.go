@@ -11,6 +11,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/mattermost/mattermost-server/v5/app"
 	"github.com/mattermost/mattermost-server/v5/model"
@@ -42,7 +43,7 @@ func TestCreateTeam(t *testing.T) {
 
 	rteam.Id = ""
 	_, resp = Client.CreateTeam(rteam)
-	CheckErrorMessage(t, resp, "store.sql_team.save.domain_exists.app_error")
+	CheckErrorMessage(t, resp, "app.team.save.domain_exists.app_error")
 	CheckBadRequestStatus(t, resp)
 
 	rteam.Name = ""
@@ -538,6 +539,131 @@ func TestRestoreTeam(t *testing.T) {
 	})
 }
 
+func TestCancelTeamPurge(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	Client := th.Client
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.TeamDeletionGracePeriodInDays = 30 })
+
+	team := th.CreateTeam()
+
+	webSocketClient, err := th.CreateWebSocketClient()
+	require.Nil(t, err)
+	webSocketClient.Listen()
+	defer webSocketClient.Close()
+
+	_, resp := Client.SoftDeleteTeam(team.Id)
+	CheckOKStatus(t, resp)
+
+	deletedTeam, err := th.App.GetTeam(team.Id)
+	require.Nil(t, err)
+	require.NotZero(t, deletedTeam.DeleteAt)
+	require.Equal(t, deletedTeam.DeleteAt+30*24*60*60*1000, deletedTeam.GetPurgeAt())
+
+	t.Run("members are notified", func(t *testing.T) {
+		timeout := time.After(5 * time.Second)
+		for {
+			select {
+			case event := <-webSocketClient.EventChannel:
+				if event.EventType() == model.WEBSOCKET_EVENT_TEAM_PURGE_SCHEDULED {
+					require.Equal(t, team.Id, event.GetData()["team_id"])
+					require.EqualValues(t, deletedTeam.GetPurgeAt(), event.GetData()["purge_at"])
+					return
+				}
+			case <-timeout:
+				require.Fail(t, "did not receive the team_purge_scheduled event")
+				return
+			}
+		}
+	})
+
+	t.Run("no permission to manage team", func(t *testing.T) {
+		th.LoginBasic2()
+		defer th.LoginBasic()
+
+		_, resp := Client.CancelTeamPurge(team.Id)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("cancel scheduled purge", func(t *testing.T) {
+		canceledTeam, resp := Client.CancelTeamPurge(team.Id)
+		CheckOKStatus(t, resp)
+		require.NotZero(t, canceledTeam.DeleteAt)
+		require.Zero(t, canceledTeam.GetPurgeAt())
+	})
+
+	t.Run("no purge scheduled", func(t *testing.T) {
+		_, resp := Client.CancelTeamPurge(team.Id)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp = Client.CancelTeamPurge(th.BasicTeam.Id)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("restore clears the scheduled purge", func(t *testing.T) {
+		otherTeam := th.CreateTeam()
+		_, resp := Client.SoftDeleteTeam(otherTeam.Id)
+		CheckOKStatus(t, resp)
+
+		restoredTeam, resp := Client.RestoreTeam(otherTeam.Id)
+		CheckOKStatus(t, resp)
+		require.Zero(t, restoredTeam.DeleteAt)
+		require.Zero(t, restoredTeam.GetPurgeAt())
+	})
+
+	t.Run("no purge scheduled without a grace period", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.TeamDeletionGracePeriodInDays = 0 })
+
+		otherTeam := th.CreateTeam()
+		_, resp := Client.SoftDeleteTeam(otherTeam.Id)
+		CheckOKStatus(t, resp)
+
+		deletedTeam, err := th.App.GetTeam(otherTeam.Id)
+		require.Nil(t, err)
+		require.Zero(t, deletedTeam.GetPurgeAt())
+	})
+}
+
+func TestGetDeletedTeams(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	team := th.CreateTeam()
+	_, resp := th.SystemAdminClient.SoftDeleteTeam(team.Id)
+	CheckOKStatus(t, resp)
+
+	t.Run("should require permission", func(t *testing.T) {
+		_, resp := th.Client.GetDeletedTeams(0, 100)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("should only return the archived teams", func(t *testing.T) {
+		teams, resp := th.SystemAdminClient.GetDeletedTeams(0, 100)
+		CheckNoError(t, resp)
+
+		var found bool
+		for _, deletedTeam := range teams {
+			assert.NotZero(t, deletedTeam.DeleteAt)
+			if deletedTeam.Id == team.Id {
+				found = true
+			}
+		}
+		assert.True(t, found)
+	})
+
+	t.Run("should not return a restored team", func(t *testing.T) {
+		_, resp := th.SystemAdminClient.RestoreTeam(team.Id)
+		CheckNoError(t, resp)
+
+		teams, resp := th.SystemAdminClient.GetDeletedTeams(0, 100)
+		CheckNoError(t, resp)
+		for _, deletedTeam := range teams {
+			assert.NotEqual(t, team.Id, deletedTeam.Id)
+		}
+	})
+}
+
 func TestPatchTeamSanitization(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -730,6 +856,29 @@ func TestRegenerateTeamInviteId(t *testing.T) {
 
 	assert.NotEqual(t, team.InviteId, rteam.InviteId)
 	assert.NotEqual(t, team.InviteId, "")
+	assert.Equal(t, int64(0), rteam.InviteExpiresAt)
+
+	t.Run("with an expiry", func(t *testing.T) {
+		expiresAt := model.GetMillis() + 60*1000
+		rteam, resp := Client.RegenerateTeamInviteIdWithExpiry(team.Id, expiresAt)
+		CheckNoError(t, resp)
+		assert.Equal(t, expiresAt, rteam.InviteExpiresAt)
+
+		_, resp = Client.RegenerateTeamInviteIdWithExpiry(team.Id, model.GetMillis()-1000)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("invalidates the previous invite id", func(t *testing.T) {
+		team, appErr := th.App.GetTeam(team.Id)
+		require.Nil(t, appErr)
+
+		_, resp := Client.RegenerateTeamInviteId(team.Id)
+		CheckNoError(t, resp)
+
+		_, appErr = th.App.GetTeamByInviteId(team.InviteId)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusNotFound, appErr.StatusCode)
+	})
 }
 
 func TestSoftDeleteTeam(t *testing.T) {
@@ -1209,6 +1358,59 @@ func TestSearchAllTeams(t *testing.T) {
 	})
 }
 
+func TestSearchAllTeamsWithOpts(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	term := "opts" + model.NewId()
+	oTeam := th.CreateTeamWithClient(th.SystemAdminClient)
+	oTeam.DisplayName = term + "open"
+	oTeam.AllowOpenInvite = true
+	oTeam, err := th.App.UpdateTeam(oTeam)
+	require.Nil(t, err)
+
+	pTeam, resp := th.SystemAdminClient.CreateTeam(&model.Team{DisplayName: term + "private", Name: GenerateTestTeamName(), Email: th.GenerateTestEmail(), Type: model.TEAM_INVITE})
+	CheckNoError(t, resp)
+
+	aTeam, resp := th.SystemAdminClient.CreateTeam(&model.Team{DisplayName: term + "archived", Name: GenerateTestTeamName(), Email: th.GenerateTestEmail(), Type: model.TEAM_OPEN})
+	CheckNoError(t, resp)
+	_, resp = th.SystemAdminClient.SoftDeleteTeam(aTeam.Id)
+	CheckNoError(t, resp)
+
+	th.TestForSystemAdminAndLocal(t, func(t *testing.T, client *model.Client4) {
+		rteams, resp := client.SearchTeams(&model.TeamSearch{Term: term})
+		CheckNoError(t, resp)
+		require.Len(t, rteams, 3, "should have returned the archived team")
+
+		rteams, resp = client.SearchTeams(&model.TeamSearch{Term: term, TeamSearchOpts: model.TeamSearchOpts{ExcludeDeleted: true}})
+		CheckNoError(t, resp)
+		require.Len(t, rteams, 2, "should not have returned the archived team")
+
+		rteams, resp = client.SearchTeams(&model.TeamSearch{Term: term, TeamSearchOpts: model.TeamSearchOpts{TeamType: model.TEAM_INVITE}})
+		CheckNoError(t, resp)
+		require.Len(t, rteams, 1)
+		require.Equal(t, pTeam.Id, rteams[0].Id)
+
+		rteams, resp = client.SearchTeams(&model.TeamSearch{Term: term, TeamSearchOpts: model.TeamSearchOpts{AllowOpenInvite: model.NewBool(true)}})
+		CheckNoError(t, resp)
+		require.Len(t, rteams, 1)
+		require.Equal(t, oTeam.Id, rteams[0].Id)
+
+		_, resp = client.SearchTeams(&model.TeamSearch{Term: term, TeamSearchOpts: model.TeamSearchOpts{TeamType: "X"}})
+		CheckBadRequestStatus(t, resp)
+
+		rteams, _, resp = client.SearchTeamsPaged(&model.TeamSearch{Term: term, Page: model.NewInt(0), PerPage: model.NewInt(10), TeamSearchOpts: model.TeamSearchOpts{Sort: model.TEAM_SEARCH_SORT_DISPLAY_NAME, SortDescending: true}})
+		CheckNoError(t, resp)
+		require.Len(t, rteams, 3)
+		require.Equal(t, pTeam.Id, rteams[0].Id)
+		require.Equal(t, oTeam.Id, rteams[1].Id)
+		require.Equal(t, aTeam.Id, rteams[2].Id)
+
+		_, _, resp = client.SearchTeamsPaged(&model.TeamSearch{Term: term, Page: model.NewInt(0), PerPage: model.NewInt(10), TeamSearchOpts: model.TeamSearchOpts{Sort: "Name"}})
+		CheckBadRequestStatus(t, resp)
+	})
+}
+
 func TestSearchAllTeamsPaged(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -1447,6 +1649,37 @@ func TestGetTeamsForUser(t *testing.T) {
 	CheckNoError(t, resp)
 }
 
+func TestGetTeamsForUserWithOptions(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	Client := th.Client
+
+	team2, resp := Client.CreateTeam(&model.Team{DisplayName: "A Team", Name: GenerateTestTeamName(), Email: th.GenerateTestEmail(), Type: model.TEAM_INVITE})
+	CheckNoError(t, resp)
+
+	team3, resp := Client.CreateTeam(&model.Team{DisplayName: "B Team", Name: GenerateTestTeamName(), Email: th.GenerateTestEmail(), Type: model.TEAM_INVITE})
+	CheckNoError(t, resp)
+	_, resp = Client.SoftDeleteTeam(team3.Id)
+	CheckNoError(t, resp)
+
+	teams, resp := Client.GetTeamsForUserWithOptions(th.BasicUser.Id, &model.TeamsForUserGetOptions{}, "")
+	CheckNoError(t, resp)
+	require.Len(t, teams, 2)
+	require.Equal(t, team2.Id, teams[0].Id)
+
+	teams, resp = Client.GetTeamsForUserWithOptions(th.BasicUser.Id, &model.TeamsForUserGetOptions{IncludeDeleted: true}, "")
+	CheckNoError(t, resp)
+	require.Len(t, teams, 3)
+	require.Equal(t, team3.Id, teams[1].Id)
+
+	teams, resp = Client.GetTeamsForUserWithOptions(th.BasicUser.Id, &model.TeamsForUserGetOptions{IncludeDeleted: true, Page: 1, PerPage: 2}, "")
+	CheckNoError(t, resp)
+	require.Len(t, teams, 1)
+
+	_, resp = Client.GetTeamsForUserWithOptions(th.BasicUser.Id, &model.TeamsForUserGetOptions{Sort: "junk"}, "")
+	CheckBadRequestStatus(t, resp)
+}
+
 func TestGetTeamsForUserSanitization(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -1635,6 +1868,36 @@ func TestGetTeamMembers(t *testing.T) {
 	CheckNoError(t, resp)
 }
 
+func TestGetTeamMembersWithRoles(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	_, err := th.App.UpdateTeamMemberSchemeRoles(th.BasicTeam.Id, th.BasicUser2.Id, false, true, true)
+	require.Nil(t, err)
+
+	members, resp := th.Client.GetTeamMembersWithRoles(th.BasicTeam.Id, 0, 100, []string{model.TEAM_ADMIN_ROLE_ID}, "")
+	CheckNoError(t, resp)
+	require.NotEmpty(t, members)
+
+	var found bool
+	for _, member := range members {
+		assert.True(t, member.SchemeAdmin)
+		if member.UserId == th.BasicUser2.Id {
+			found = true
+		}
+	}
+	assert.True(t, found)
+
+	members, resp = th.Client.GetTeamMembersWithRoles(th.BasicTeam.Id, 0, 100, []string{model.TEAM_USER_ROLE_ID}, "")
+	CheckNoError(t, resp)
+	for _, member := range members {
+		assert.False(t, member.SchemeAdmin)
+	}
+
+	_, resp = th.Client.GetTeamMembersWithRoles(th.BasicTeam.Id, 0, 100, []string{model.SYSTEM_ADMIN_ROLE_ID}, "")
+	CheckBadRequestStatus(t, resp)
+}
+
 func TestGetTeamMembersForUser(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -1671,6 +1934,52 @@ func TestGetTeamMembersForUser(t *testing.T) {
 	CheckNoError(t, resp)
 }
 
+func TestGetTeamMembersForUserWithOptions(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	Client := th.Client
+
+	team := th.CreateTeamWithClient(th.SystemAdminClient)
+	th.LinkUserToTeam(th.BasicUser, team)
+	deletedTeam := th.CreateTeamWithClient(th.SystemAdminClient)
+	th.LinkUserToTeam(th.BasicUser, deletedTeam)
+	require.Nil(t, th.App.SoftDeleteTeam(deletedTeam.Id))
+
+	t.Run("sorted by display name", func(t *testing.T) {
+		opts := &model.TeamMembersForUserGetOptions{Sort: model.TEAMS_FOR_USER_SORT_DISPLAY_NAME}
+		members, resp := Client.GetTeamMembersForUserWithOptions(th.BasicUser.Id, 0, 100, opts, "")
+		CheckNoError(t, resp)
+		require.Len(t, members, 3)
+
+		previous := ""
+		for _, member := range members {
+			memberTeam, appErr := th.App.GetTeam(member.TeamId)
+			require.Nil(t, appErr)
+			require.LessOrEqual(t, previous, memberTeam.DisplayName)
+			previous = memberTeam.DisplayName
+		}
+
+		members, resp = Client.GetTeamMembersForUserWithOptions(th.BasicUser.Id, 0, 1, opts, "")
+		CheckNoError(t, resp)
+		require.Len(t, members, 1)
+	})
+
+	t.Run("excluding deleted", func(t *testing.T) {
+		opts := &model.TeamMembersForUserGetOptions{ExcludeDeleted: true}
+		members, resp := Client.GetTeamMembersForUserWithOptions(th.BasicUser.Id, 0, 100, opts, "")
+		CheckNoError(t, resp)
+		require.Len(t, members, 2)
+		for _, member := range members {
+			require.NotEqual(t, deletedTeam.Id, member.TeamId)
+		}
+	})
+
+	t.Run("invalid sort", func(t *testing.T) {
+		_, resp := Client.GetTeamMembersForUserWithOptions(th.BasicUser.Id, 0, 100, &model.TeamMembersForUserGetOptions{Sort: "junk"}, "")
+		CheckBadRequestStatus(t, resp)
+	})
+}
+
 func TestGetTeamMembersByIds(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -2272,6 +2581,35 @@ func TestRemoveTeamMember(t *testing.T) {
 	CheckNoError(t, resp)
 }
 
+func TestGetTeamsActiveMemberCounts(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	otherTeam := th.CreateTeam()
+	emptyTeamId := model.NewId()
+
+	_, resp := th.Client.GetTeamsActiveMemberCounts([]string{th.BasicTeam.Id})
+	CheckForbiddenStatus(t, resp)
+
+	counts, resp := th.SystemAdminClient.GetTeamsActiveMemberCounts([]string{th.BasicTeam.Id, otherTeam.Id, emptyTeamId})
+	CheckNoError(t, resp)
+	require.Equal(t, int64(3), counts[th.BasicTeam.Id])
+	require.Equal(t, int64(1), counts[otherTeam.Id])
+	require.Equal(t, int64(0), counts[emptyTeamId])
+
+	th.UpdateActiveUser(th.BasicUser2, false)
+
+	counts, resp = th.SystemAdminClient.GetTeamsActiveMemberCounts([]string{th.BasicTeam.Id})
+	CheckNoError(t, resp)
+	require.Equal(t, int64(2), counts[th.BasicTeam.Id])
+
+	_, resp = th.SystemAdminClient.GetTeamsActiveMemberCounts([]string{})
+	CheckBadRequestStatus(t, resp)
+
+	_, resp = th.SystemAdminClient.GetTeamsActiveMemberCounts([]string{"junk"})
+	CheckBadRequestStatus(t, resp)
+}
+
 func TestGetTeamStats(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -2391,6 +2729,29 @@ func TestUpdateTeamMemberRoles(t *testing.T) {
 	CheckNoError(t, resp)
 }
 
+func TestUpdateTeamMemberRolesWithExpiry(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	expiresAt := model.GetMillis() + 60*60*1000
+	ok, resp := th.SystemAdminClient.UpdateTeamMemberRolesWithExpiry(th.BasicTeam.Id, th.BasicUser.Id, "team_user team_post_all", expiresAt)
+	CheckNoError(t, resp)
+	require.True(t, ok)
+
+	member, resp := th.SystemAdminClient.GetTeamMember(th.BasicTeam.Id, th.BasicUser.Id, "")
+	CheckNoError(t, resp)
+	assert.Equal(t, "team_post_all", member.ExplicitRoles)
+	assert.Equal(t, expiresAt, member.ExplicitRolesExpiresAt)
+
+	_, resp = th.SystemAdminClient.UpdateTeamMemberRolesWithExpiry(th.BasicTeam.Id, th.BasicUser.Id, "team_user team_post_all", model.GetMillis()-1000)
+	CheckBadRequestStatus(t, resp)
+
+	requestBody := map[string]string{"roles": "team_user team_post_all", "expires_at": "soon"}
+	_, err := th.SystemAdminClient.DoApiPut(th.SystemAdminClient.GetTeamMemberRoute(th.BasicTeam.Id, th.BasicUser.Id)+"/roles", model.MapToJson(requestBody))
+	require.NotNil(t, err)
+	assert.Equal(t, http.StatusBadRequest, err.StatusCode)
+}
+
 func TestUpdateTeamMemberSchemeRoles(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -2518,6 +2879,36 @@ func TestGetMyTeamsUnread(t *testing.T) {
 	_, resp = Client.GetTeamsUnreadForUser(model.NewId(), "")
 	CheckForbiddenStatus(t, resp)
 
+	t.Run("sidebar categories", func(t *testing.T) {
+		categories, err := th.App.GetSidebarCategories(user.Id, th.BasicTeam.Id)
+		require.Nil(t, err)
+
+		teams, resp := Client.GetTeamsUnreadForUser(user.Id, "")
+		CheckNoError(t, resp)
+
+		var basicTeam *model.TeamUnread
+		for _, team := range teams {
+			if team.TeamId == th.BasicTeam.Id {
+				basicTeam = team
+			}
+		}
+		require.NotNil(t, basicTeam)
+		require.NotEmpty(t, basicTeam.Categories)
+		for _, category := range basicTeam.Categories {
+			assert.Contains(t, categories.Order, category.CategoryId)
+		}
+	})
+
+	t.Run("include collapsed threads", func(t *testing.T) {
+		r, err := Client.DoApiGet(Client.GetUserRoute(user.Id)+"/teams/unread?include_collapsed_threads=true", "")
+		require.Nil(t, err)
+		closeBody(r)
+
+		_, err = Client.DoApiGet(Client.GetUserRoute(user.Id)+"/teams/unread?include_collapsed_threads=junk", "")
+		require.NotNil(t, err)
+		assert.Equal(t, http.StatusBadRequest, err.StatusCode)
+	})
+
 	Client.Logout()
 	_, resp = Client.GetTeamsUnreadForUser(user.Id, "")
 	CheckUnauthorizedStatus(t, resp)
@@ -3043,6 +3434,57 @@ func TestRemoveTeamIcon(t *testing.T) {
 	CheckForbiddenStatus(t, resp)
 }
 
+func TestUpdateTeamPostingRestrictions(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	restrictions := &model.PostingRestrictions{RestrictCustomEmoji: model.NewBool(true)}
+
+	_, resp := th.Client.UpdateTeamPostingRestrictions(th.BasicTeam.Id, restrictions)
+	CheckForbiddenStatus(t, resp)
+
+	team, resp := th.SystemAdminClient.UpdateTeamPostingRestrictions(th.BasicTeam.Id, restrictions)
+	CheckNoError(t, resp)
+	require.True(t, team.IsCustomEmojiRestricted())
+	require.False(t, team.IsFileUploadRestricted())
+
+	team, resp = th.SystemAdminClient.UpdateTeamPostingRestrictions(th.BasicTeam.Id, &model.PostingRestrictions{RestrictCustomEmoji: model.NewBool(false)})
+	CheckNoError(t, resp)
+	require.False(t, team.IsCustomEmojiRestricted())
+}
+
+func TestTeamNotifyDefaults(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	defaults := map[string]string{model.EMAIL_NOTIFY_PROP: "false", model.PUSH_NOTIFY_PROP: model.USER_NOTIFY_ALL}
+
+	_, resp := th.Client.UpdateTeamNotifyDefaults(th.BasicTeam.Id, defaults)
+	CheckForbiddenStatus(t, resp)
+
+	updated, resp := th.SystemAdminClient.UpdateTeamNotifyDefaults(th.BasicTeam.Id, defaults)
+	CheckNoError(t, resp)
+	require.Equal(t, defaults, updated)
+
+	fetched, resp := th.Client.GetTeamNotifyDefaults(th.BasicTeam.Id)
+	CheckNoError(t, resp)
+	require.Equal(t, defaults, fetched)
+
+	_, resp = th.SystemAdminClient.UpdateTeamNotifyDefaults(th.BasicTeam.Id, map[string]string{model.PUSH_NOTIFY_PROP: "sometimes"})
+	CheckBadRequestStatus(t, resp)
+
+	_, resp = th.SystemAdminClient.UpdateTeamNotifyDefaults(th.BasicTeam.Id, map[string]string{model.MENTION_KEYS_NOTIFY_PROP: "word"})
+	CheckBadRequestStatus(t, resp)
+
+	updated, resp = th.SystemAdminClient.UpdateTeamNotifyDefaults(th.BasicTeam.Id, map[string]string{})
+	CheckNoError(t, resp)
+	require.Empty(t, updated)
+
+	otherTeam := th.CreateTeamWithClient(th.SystemAdminClient)
+	_, resp = th.Client.GetTeamNotifyDefaults(otherTeam.Id)
+	CheckForbiddenStatus(t, resp)
+}
+
 func TestUpdateTeamScheme(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
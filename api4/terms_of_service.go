@@ -14,6 +14,18 @@ import (
 func (api *API) InitTermsOfService() {
 	api.BaseRoutes.TermsOfService.Handle("", api.ApiSessionRequired(getLatestTermsOfService)).Methods("GET")
 	api.BaseRoutes.TermsOfService.Handle("", api.ApiSessionRequired(createTermsOfService)).Methods("POST")
+	api.BaseRoutes.TermsOfService.Handle("/pending", api.ApiSessionRequired(getPendingTermsOfServicePolicyVersions)).Methods("GET")
+
+	api.BaseRoutes.TermsOfServicePolicies.Handle("", api.ApiSessionRequired(getTermsOfServicePolicies)).Methods("GET")
+	api.BaseRoutes.TermsOfServicePolicies.Handle("", api.ApiSessionRequired(createTermsOfServicePolicy)).Methods("POST")
+	api.BaseRoutes.TermsOfServicePolicy.Handle("", api.ApiSessionRequired(getTermsOfServicePolicy)).Methods("GET")
+	api.BaseRoutes.TermsOfServicePolicy.Handle("", api.ApiSessionRequired(updateTermsOfServicePolicy)).Methods("PUT")
+	api.BaseRoutes.TermsOfServicePolicy.Handle("", api.ApiSessionRequired(deleteTermsOfServicePolicy)).Methods("DELETE")
+	api.BaseRoutes.TermsOfServicePolicy.Handle("/versions", api.ApiSessionRequired(publishTermsOfServicePolicyVersion)).Methods("POST")
+	api.BaseRoutes.TermsOfServicePolicy.Handle("/versions/latest", api.ApiSessionRequired(getLatestTermsOfServicePolicyVersion)).Methods("GET")
+	api.BaseRoutes.TermsOfServicePolicy.Handle("/accept", api.ApiSessionRequired(acceptTermsOfServicePolicyVersion)).Methods("POST")
+	api.BaseRoutes.TermsOfServicePolicy.Handle("/report", api.ApiSessionRequired(getTermsOfServicePolicyReport)).Methods("GET")
+	api.BaseRoutes.TermsOfServicePolicy.Handle("/report/outstanding", api.ApiSessionRequired(getTermsOfServicePolicyOutstandingUsers)).Methods("GET")
 }
 
 func getLatestTermsOfService(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -68,3 +80,271 @@ func createTermsOfService(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 	auditRec.Success()
 }
+
+func getPendingTermsOfServicePolicyVersions(c *Context, w http.ResponseWriter, r *http.Request) {
+	versions, err := c.App.GetPendingTermsOfServicePolicyVersions(c.App.Session().UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(model.TermsOfServicePolicyVersionListToJson(versions)))
+}
+
+func getTermsOfServicePolicies(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	policies, err := c.App.GetTermsOfServicePolicies(c.Params.Page, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(model.TermsOfServicePolicyListToJson(policies)))
+}
+
+func getTermsOfServicePolicy(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePolicyId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	policy, err := c.App.GetTermsOfServicePolicy(c.Params.PolicyId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(policy.ToJson()))
+}
+
+func createTermsOfServicePolicy(c *Context, w http.ResponseWriter, r *http.Request) {
+	policy := model.TermsOfServicePolicyFromJson(r.Body)
+	if policy == nil {
+		c.SetInvalidParam("policy")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("createTermsOfServicePolicy", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("policy", policy)
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	createdPolicy, err := c.App.CreateTermsOfServicePolicy(policy)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("policy_id", createdPolicy.Id)
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte(createdPolicy.ToJson()))
+}
+
+func updateTermsOfServicePolicy(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePolicyId()
+	if c.Err != nil {
+		return
+	}
+
+	policy := model.TermsOfServicePolicyFromJson(r.Body)
+	if policy == nil {
+		c.SetInvalidParam("policy")
+		return
+	}
+
+	// The policy_id in the URL overrides any one in the body.
+	policy.Id = c.Params.PolicyId
+
+	auditRec := c.MakeAuditRecord("updateTermsOfServicePolicy", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("policy", policy)
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	updatedPolicy, err := c.App.UpdateTermsOfServicePolicy(policy)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	w.Write([]byte(updatedPolicy.ToJson()))
+}
+
+func deleteTermsOfServicePolicy(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePolicyId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteTermsOfServicePolicy", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("policy_id", c.Params.PolicyId)
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	if err := c.App.DeleteTermsOfServicePolicy(c.Params.PolicyId); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	ReturnStatusOK(w)
+}
+
+func publishTermsOfServicePolicyVersion(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePolicyId()
+	if c.Err != nil {
+		return
+	}
+
+	props := model.MapFromJson(r.Body)
+	text := props["text"]
+	if text == "" {
+		c.SetInvalidParam("text")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("publishTermsOfServicePolicyVersion", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("policy_id", c.Params.PolicyId)
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	version, err := c.App.PublishTermsOfServicePolicyVersion(c.Params.PolicyId, text, c.App.Session().UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("version_id", version.Id)
+	auditRec.AddMeta("version", version.Version)
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte(version.ToJson()))
+}
+
+func getLatestTermsOfServicePolicyVersion(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePolicyId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	version, err := c.App.GetLatestTermsOfServicePolicyVersion(c.Params.PolicyId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(version.ToJson()))
+}
+
+func acceptTermsOfServicePolicyVersion(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePolicyId()
+	if c.Err != nil {
+		return
+	}
+
+	props := model.MapFromJson(r.Body)
+	versionId := props["version_id"]
+	if !model.IsValidId(versionId) {
+		c.SetInvalidParam("version_id")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("acceptTermsOfServicePolicyVersion", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("policy_id", c.Params.PolicyId)
+	auditRec.AddMeta("version_id", versionId)
+
+	// The acceptance must come from the user themselves.
+	if c.App.Session().IsImpersonated() {
+		c.Err = model.NewAppError("acceptTermsOfServicePolicyVersion", "api.terms_of_service_policy.accept.impersonated.app_error", nil, "", http.StatusForbidden)
+		return
+	}
+
+	if err := c.App.AcceptTermsOfServicePolicyVersion(c.App.Session().UserId, c.Params.PolicyId, versionId); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	ReturnStatusOK(w)
+}
+
+func getTermsOfServicePolicyReport(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePolicyId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	report, err := c.App.GetTermsOfServicePolicyReport(c.Params.PolicyId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(report.ToJson()))
+}
+
+func getTermsOfServicePolicyOutstandingUsers(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePolicyId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	users, err := c.App.GetTermsOfServicePolicyOutstandingUsers(c.Params.PolicyId, c.Params.Page, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	for _, user := range users {
+		c.App.SanitizeProfile(user, true)
+	}
+
+	w.Write([]byte(model.UserListToJson(users)))
+}
@@ -54,3 +54,117 @@ func TestCreateTermsOfServiceAdminUser(t *testing.T) {
 	assert.Equal(t, "terms of service new_2", termsOfService.Text)
 	assert.Equal(t, th.SystemAdminUser.Id, termsOfService.UserId)
 }
+
+func TestTermsOfServicePolicies(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	newPolicy := func() *model.TermsOfServicePolicy {
+		return &model.TermsOfServicePolicy{
+			DisplayName: "contractors",
+			TeamIds:     []string{th.BasicTeam.Id},
+		}
+	}
+
+	t.Run("should require a license", func(t *testing.T) {
+		_, resp := th.SystemAdminClient.CreateTermsOfServicePolicy(newPolicy())
+		CheckNotImplementedStatus(t, resp)
+	})
+
+	th.App.Srv().SetLicense(model.NewTestLicense("custom_terms_of_service"))
+
+	t.Run("should require permission to manage the system", func(t *testing.T) {
+		_, resp := th.Client.CreateTermsOfServicePolicy(newPolicy())
+		CheckForbiddenStatus(t, resp)
+
+		_, resp = th.Client.GetTermsOfServicePolicies(0, 10)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	policy, resp := th.SystemAdminClient.CreateTermsOfServicePolicy(newPolicy())
+	CheckNoError(t, resp)
+	CheckCreatedStatus(t, resp)
+	require.Equal(t, []string{th.BasicTeam.Id}, policy.TeamIds)
+
+	_, resp = th.SystemAdminClient.GetTermsOfServicePolicyReport(policy.Id)
+	CheckNotFoundStatus(t, resp)
+
+	_, resp = th.Client.PublishTermsOfServicePolicyVersion(policy.Id, "terms")
+	CheckForbiddenStatus(t, resp)
+
+	version, resp := th.SystemAdminClient.PublishTermsOfServicePolicyVersion(policy.Id, "terms")
+	CheckNoError(t, resp)
+	CheckCreatedStatus(t, resp)
+	require.Equal(t, 1, version.Version)
+
+	t.Run("should not block users while enforcement is disabled", func(t *testing.T) {
+		_, resp := th.Client.GetChannel(th.BasicChannel.Id, "")
+		CheckNoError(t, resp)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.SupportSettings.EnforceTermsOfServicePolicies = true })
+
+	t.Run("should block users until they accept the latest version", func(t *testing.T) {
+		_, resp := th.Client.GetChannel(th.BasicChannel.Id, "")
+		CheckErrorMessage(t, resp, "api.context.terms_of_service_policy_required.app_error")
+		CheckForbiddenStatus(t, resp)
+
+		_, resp = th.Client.GetMe("")
+		CheckNoError(t, resp)
+
+		pending, resp := th.Client.GetPendingTermsOfServicePolicyVersions()
+		CheckNoError(t, resp)
+		require.Len(t, pending, 1)
+		require.Equal(t, version.Id, pending[0].Id)
+
+		_, resp = th.Client.AcceptTermsOfServicePolicyVersion(policy.Id, model.NewId())
+		CheckBadRequestStatus(t, resp)
+
+		_, resp = th.Client.AcceptTermsOfServicePolicyVersion(policy.Id, version.Id)
+		CheckNoError(t, resp)
+
+		_, resp = th.Client.GetChannel(th.BasicChannel.Id, "")
+		CheckNoError(t, resp)
+	})
+
+	t.Run("should report outstanding acceptances", func(t *testing.T) {
+		report, resp := th.SystemAdminClient.GetTermsOfServicePolicyReport(policy.Id)
+		CheckNoError(t, resp)
+		require.Equal(t, version.Id, report.VersionId)
+		require.Equal(t, int64(1), report.AcceptedUsers)
+		require.Equal(t, report.TargetedUsers-report.AcceptedUsers, report.OutstandingUsers)
+
+		users, resp := th.SystemAdminClient.GetTermsOfServicePolicyOutstandingUsers(policy.Id, 0, 100)
+		CheckNoError(t, resp)
+		require.Len(t, users, int(report.OutstandingUsers))
+		for _, user := range users {
+			require.NotEqual(t, th.BasicUser.Id, user.Id)
+		}
+	})
+
+	t.Run("should require accepting new versions again", func(t *testing.T) {
+		newVersion, resp := th.SystemAdminClient.PublishTermsOfServicePolicyVersion(policy.Id, "new terms")
+		CheckNoError(t, resp)
+		require.Equal(t, 2, newVersion.Version)
+
+		_, resp = th.Client.GetChannel(th.BasicChannel.Id, "")
+		CheckForbiddenStatus(t, resp)
+
+		_, resp = th.Client.AcceptTermsOfServicePolicyVersion(policy.Id, newVersion.Id)
+		CheckNoError(t, resp)
+	})
+
+	t.Run("should stop enforcing deleted policies", func(t *testing.T) {
+		_, resp := th.SystemAdminClient.PublishTermsOfServicePolicyVersion(policy.Id, "newer terms")
+		CheckNoError(t, resp)
+
+		_, resp = th.SystemAdminClient.DeleteTermsOfServicePolicy(policy.Id)
+		CheckNoError(t, resp)
+
+		_, resp = th.Client.GetChannel(th.BasicChannel.Id, "")
+		CheckNoError(t, resp)
+
+		_, resp = th.SystemAdminClient.GetTermsOfServicePolicy(policy.Id)
+		CheckNotFoundStatus(t, resp)
+	})
+}
@@ -11,14 +11,12 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/mattermost/mattermost-server/v5/app"
 	"github.com/mattermost/mattermost-server/v5/audit"
 	"github.com/mattermost/mattermost-server/v5/mlog"
 	"github.com/mattermost/mattermost-server/v5/model"
 	"github.com/mattermost/mattermost-server/v5/store"
-	"github.com/mattermost/mattermost-server/v5/utils"
 )
 
 func (api *API) InitUser() {
@@ -76,6 +74,7 @@ func (api *API) InitUser() {
 	api.BaseRoutes.User.Handle("/audits", api.ApiSessionRequired(getUserAudits)).Methods("GET")
 
 	api.BaseRoutes.User.Handle("/tokens", api.ApiSessionRequired(createUserAccessToken)).Methods("POST")
+	api.BaseRoutes.User.Handle("/impersonate", api.ApiSessionRequired(impersonateUser)).Methods("POST")
 	api.BaseRoutes.User.Handle("/tokens", api.ApiSessionRequired(getUserAccessTokensForUser)).Methods("GET")
 	api.BaseRoutes.Users.Handle("/tokens", api.ApiSessionRequired(getUserAccessTokens)).Methods("GET")
 	api.BaseRoutes.Users.Handle("/tokens/search", api.ApiSessionRequired(searchUserAccessTokens)).Methods("POST")
@@ -98,6 +97,7 @@ func createUser(c *Context, w http.ResponseWriter, r *http.Request) {
 
 	tokenId := r.URL.Query().Get("t")
 	inviteId := r.URL.Query().Get("iid")
+	guestLinkToken := r.URL.Query().Get("guest_link")
 
 	auditRec := c.MakeAuditRecord("createUser", audit.Fail)
 	defer c.LogAuditRec(auditRec)
@@ -136,6 +136,17 @@ func createUser(c *Context, w http.ResponseWriter, r *http.Request) {
 		ruser, err = c.App.CreateUserWithToken(user, token)
 	} else if len(inviteId) > 0 {
 		ruser, err = c.App.CreateUserWithInviteId(user, inviteId)
+	} else if len(guestLinkToken) > 0 {
+		if c.App.Srv().License() == nil {
+			c.Err = model.NewAppError("CreateGuestWithChannelGuestLink", "api.user.create_user.guest_accounts.license.app_error", nil, "", http.StatusBadRequest)
+			return
+		}
+		if !*c.App.Config().GuestAccountsSettings.Enable {
+			c.Err = model.NewAppError("CreateGuestWithChannelGuestLink", "api.user.create_user.guest_accounts.disabled.app_error", nil, "", http.StatusBadRequest)
+			return
+		}
+		auditRec.AddMeta("guest_link", true)
+		ruser, err = c.App.CreateGuestWithChannelGuestLink(user, guestLinkToken)
 	} else if c.IsSystemAdmin() {
 		ruser, err = c.App.CreateUserAsAdmin(user)
 		auditRec.AddMeta("admin", true)
@@ -562,8 +573,8 @@ func getFilteredUsersStats(c *Context, w http.ResponseWriter, r *http.Request) {
 		TeamRoles:          teamRoles,
 	}
 
-	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
-		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_SYSCONSOLE_READ_USER_MANAGEMENT) {
+		c.SetPermissionError(model.PERMISSION_SYSCONSOLE_READ_USER_MANAGEMENT)
 		return
 	}
 
@@ -609,6 +620,7 @@ func getUsers(c *Context, w http.ResponseWriter, r *http.Request) {
 	rolesString := r.URL.Query().Get("roles")
 	channelRolesString := r.URL.Query().Get("channel_roles")
 	teamRolesString := r.URL.Query().Get("team_roles")
+	lastLoginBeforeString := r.URL.Query().Get("last_login_before")
 
 	if len(notInChannelId) > 0 && len(inTeamId) == 0 {
 		c.SetInvalidUrlParam("team_id")
@@ -640,6 +652,31 @@ func getUsers(c *Context, w http.ResponseWriter, r *http.Request) {
 		c.SetInvalidUrlParam("inactive")
 	}
 
+	accountFilters := model.UserAccountFilters{
+		AuthService: r.URL.Query().Get("auth_service"),
+	}
+	accountFilters.NeverLoggedIn, _ = strconv.ParseBool(r.URL.Query().Get("never_logged_in"))
+	accountFilters.MfaActive, _ = strconv.ParseBool(r.URL.Query().Get("mfa_active"))
+	accountFilters.MfaInactive, _ = strconv.ParseBool(r.URL.Query().Get("mfa_inactive"))
+	if lastLoginBeforeString != "" {
+		lastLoginBefore, parseErr := strconv.ParseInt(lastLoginBeforeString, 10, 64)
+		if parseErr != nil {
+			c.SetInvalidUrlParam("last_login_before")
+			return
+		}
+		accountFilters.LastLoginBefore = lastLoginBefore
+	}
+	if err := accountFilters.IsValid(); err != nil {
+		c.Err = err
+		return
+	}
+
+	// How and when users log in is only for the eyes of user managers.
+	if !accountFilters.IsEmpty() && !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_SYSCONSOLE_READ_USER_MANAGEMENT) {
+		c.SetPermissionError(model.PERMISSION_SYSCONSOLE_READ_USER_MANAGEMENT)
+		return
+	}
+
 	roles := []string{}
 	var rolesValid bool
 	if rolesString != "" {
@@ -673,23 +710,24 @@ func getUsers(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 
 	userGetOptions := &model.UserGetOptions{
-		InTeamId:         inTeamId,
-		InChannelId:      inChannelId,
-		NotInTeamId:      notInTeamId,
-		NotInChannelId:   notInChannelId,
-		InGroupId:        inGroupId,
-		GroupConstrained: groupConstrainedBool,
-		WithoutTeam:      withoutTeamBool,
-		Inactive:         inactiveBool,
-		Active:           activeBool,
-		Role:             role,
-		Roles:            roles,
-		ChannelRoles:     channelRoles,
-		TeamRoles:        teamRoles,
-		Sort:             sort,
-		Page:             c.Params.Page,
-		PerPage:          c.Params.PerPage,
-		ViewRestrictions: restrictions,
+		InTeamId:           inTeamId,
+		InChannelId:        inChannelId,
+		NotInTeamId:        notInTeamId,
+		NotInChannelId:     notInChannelId,
+		InGroupId:          inGroupId,
+		GroupConstrained:   groupConstrainedBool,
+		WithoutTeam:        withoutTeamBool,
+		Inactive:           inactiveBool,
+		Active:             activeBool,
+		Role:               role,
+		Roles:              roles,
+		ChannelRoles:       channelRoles,
+		TeamRoles:          teamRoles,
+		UserAccountFilters: accountFilters,
+		Sort:               sort,
+		Page:               c.Params.Page,
+		PerPage:            c.Params.PerPage,
+		ViewRestrictions:   restrictions,
 	}
 
 	var profiles []*model.User
@@ -914,6 +952,27 @@ func searchUsers(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if len(props.PropertyFilters) > model.USER_PROPERTY_FIELDS_MAX {
+		c.SetInvalidParam("property_filters")
+		return
+	}
+	for fieldId := range props.PropertyFilters {
+		if !model.IsValidId(fieldId) {
+			c.SetInvalidParam("property_filters")
+			return
+		}
+	}
+
+	if err := props.UserAccountFilters.IsValid(); err != nil {
+		c.Err = err
+		return
+	}
+
+	if !props.UserAccountFilters.IsEmpty() && !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_SYSCONSOLE_READ_USER_MANAGEMENT) {
+		c.SetPermissionError(model.PERMISSION_SYSCONSOLE_READ_USER_MANAGEMENT)
+		return
+	}
+
 	options := &model.UserSearchOptions{
 		IsAdmin:          c.IsSystemAdmin(),
 		AllowInactive:    props.AllowInactive,
@@ -924,6 +983,8 @@ func searchUsers(c *Context, w http.ResponseWriter, r *http.Request) {
 		ChannelRoles:     props.ChannelRoles,
 		TeamRoles:        props.TeamRoles,
 	}
+	options.PropertyFilters = props.PropertyFilters
+	options.UserAccountFilters = props.UserAccountFilters
 
 	if c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
 		options.AllowEmails = true
@@ -1215,16 +1276,23 @@ func updateUserRoles(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	expiresAt, ok := rolesExpiresAtFromProps(props)
+	if !ok {
+		c.SetInvalidParam("expires_at")
+		return
+	}
+
 	auditRec := c.MakeAuditRecord("updateUserRoles", audit.Fail)
 	defer c.LogAuditRec(auditRec)
 	auditRec.AddMeta("roles", newRoles)
+	auditRec.AddMeta("expires_at", expiresAt)
 
 	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_ROLES) {
 		c.SetPermissionError(model.PERMISSION_MANAGE_ROLES)
 		return
 	}
 
-	user, err := c.App.UpdateUserRoles(c.Params.UserId, newRoles, true)
+	user, err := c.App.UpdateUserRolesWithExpiry(c.Params.UserId, newRoles, expiresAt, true)
 	if err != nil {
 		c.Err = err
 		return
@@ -1234,6 +1302,10 @@ func updateUserRoles(c *Context, w http.ResponseWriter, r *http.Request) {
 	auditRec.AddMeta("user", user)
 	c.LogAudit(fmt.Sprintf("user=%s roles=%s", c.Params.UserId, newRoles))
 
+	if c.Params.UserId == c.App.Session().UserId {
+		c.App.AttachCSRFCookie(w, r)
+	}
+
 	ReturnStatusOK(w)
 }
 
@@ -1258,7 +1330,7 @@ func updateUserActive(c *Context, w http.ResponseWriter, r *http.Request) {
 	// true when you're trying to de-activate yourself
 	isSelfDeactive := !active && c.Params.UserId == c.App.Session().UserId
 
-	if !isSelfDeactive && !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+	if !isSelfDeactive && !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_SYSCONSOLE_WRITE_USER_MANAGEMENT) {
 		c.Err = model.NewAppError("updateUserActive", "api.user.update_active.permissions.app_error", nil, "userId="+c.Params.UserId, http.StatusForbidden)
 		return
 	}
@@ -1276,6 +1348,12 @@ func updateUserActive(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 	auditRec.AddMeta("user", user)
 
+	// Only system admins can act on other system admins.
+	if user.IsSystemAdmin() && !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
 	if active && user.IsGuest() && !*c.App.Config().GuestAccountsSettings.Enable {
 		c.Err = model.NewAppError("updateUserActive", "api.user.update_active.cannot_enable_guest_when_guest_feature_is_disabled.app_error", nil, "userId="+c.Params.UserId, http.StatusUnauthorized)
 		return
@@ -1420,6 +1498,10 @@ func updateUserMfa(c *Context, w http.ResponseWriter, r *http.Request) {
 	auditRec.AddMeta("activate", activate)
 	c.LogAudit("success - mfa updated")
 
+	if c.Params.UserId == c.App.Session().UserId {
+		c.App.AttachCSRFCookie(w, r)
+	}
+
 	ReturnStatusOK(w)
 }
 
@@ -1493,6 +1575,10 @@ func updatePassword(c *Context, w http.ResponseWriter, r *http.Request) {
 	auditRec.Success()
 	c.LogAudit("completed")
 
+	if c.Params.UserId == c.App.Session().UserId {
+		c.App.AttachCSRFCookie(w, r)
+	}
+
 	ReturnStatusOK(w)
 }
 
@@ -1857,24 +1943,8 @@ func attachDeviceId(c *Context, w http.ResponseWriter, r *http.Request) {
 
 	maxAge := *c.App.Config().ServiceSettings.SessionLengthMobileInDays * 60 * 60 * 24
 
-	secure := false
-	if app.GetProtocol(r) == "https" {
-		secure = true
-	}
-
-	subpath, _ := utils.GetSubpathFromConfig(c.App.Config())
-
-	expiresAt := time.Unix(model.GetMillis()/1000+int64(maxAge), 0)
-	sessionCookie := &http.Cookie{
-		Name:     model.SESSION_COOKIE_TOKEN,
-		Value:    c.App.Session().Token,
-		Path:     subpath,
-		MaxAge:   maxAge,
-		Expires:  expiresAt,
-		HttpOnly: true,
-		Domain:   c.App.GetCookieDomain(),
-		Secure:   secure,
-	}
+	sessionCookie := c.App.NewSessionCookie(r, model.SESSION_COOKIE_TOKEN, c.App.Session().Token, maxAge)
+	sessionCookie.HttpOnly = true
 
 	http.SetCookie(w, sessionCookie)
 
@@ -2079,6 +2149,49 @@ func createUserAccessToken(c *Context, w http.ResponseWriter, r *http.Request) {
 	w.Write([]byte(accessToken.ToJson()))
 }
 
+func impersonateUser(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	props := model.StringInterfaceFromJson(r.Body)
+	writeAccess, _ := props["write_access"].(bool)
+
+	auditRec := c.MakeAuditRecord("impersonateUser", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("write_access", writeAccess)
+
+	if user, err := c.App.GetUser(c.Params.UserId); err == nil {
+		auditRec.AddMeta("user", user)
+	}
+
+	if c.App.Session().IsOAuth {
+		c.SetPermissionError(model.PERMISSION_IMPERSONATE_USER)
+		c.Err.DetailedError += ", attempted access by oauth app"
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_IMPERSONATE_USER) {
+		c.SetPermissionError(model.PERMISSION_IMPERSONATE_USER)
+		return
+	}
+
+	session, err := c.App.ImpersonateUser(c.App.Session(), c.Params.UserId, writeAccess)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("session_id", session.Id)
+	auditRec.AddMeta("expires_at", session.ExpiresAt)
+	c.LogAuditWithUserId(c.Params.UserId, "impersonated - session_id="+session.Id)
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte(session.ToJson()))
+}
+
 func searchUserAccessTokens(c *Context, w http.ResponseWriter, r *http.Request) {
 	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
 		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
@@ -2471,7 +2584,13 @@ func verifyUserEmailWithoutToken(c *Context, w http.ResponseWriter, r *http.Requ
 	defer c.LogAuditRec(auditRec)
 	auditRec.AddMeta("user_id", user.Id)
 
-	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_SYSCONSOLE_WRITE_USER_MANAGEMENT) {
+		c.SetPermissionError(model.PERMISSION_SYSCONSOLE_WRITE_USER_MANAGEMENT)
+		return
+	}
+
+	// Only system admins can act on other system admins.
+	if user.IsSystemAdmin() && !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
 		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
 		return
 	}
@@ -0,0 +1,173 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/audit"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func (api *API) InitUserProperty() {
+	api.BaseRoutes.Users.Handle("/properties/fields", api.ApiSessionRequired(getUserPropertyFields)).Methods("GET")
+	api.BaseRoutes.Users.Handle("/properties/fields", api.ApiSessionRequired(createUserPropertyField)).Methods("POST")
+	api.BaseRoutes.Users.Handle("/properties/fields/{field_id:[A-Za-z0-9]+}", api.ApiSessionRequired(updateUserPropertyField)).Methods("PUT")
+	api.BaseRoutes.Users.Handle("/properties/fields/{field_id:[A-Za-z0-9]+}", api.ApiSessionRequired(deleteUserPropertyField)).Methods("DELETE")
+
+	api.BaseRoutes.User.Handle("/properties", api.ApiSessionRequired(getUserPropertyValues)).Methods("GET")
+	api.BaseRoutes.User.Handle("/properties/patch", api.ApiSessionRequired(patchUserPropertyValues)).Methods("PUT")
+}
+
+func getUserPropertyFields(c *Context, w http.ResponseWriter, r *http.Request) {
+	fields, err := c.App.GetUserPropertyFields()
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(model.UserPropertyFieldListToJson(fields)))
+}
+
+func createUserPropertyField(c *Context, w http.ResponseWriter, r *http.Request) {
+	field := model.UserPropertyFieldFromJson(r.Body)
+	if field == nil {
+		c.SetInvalidParam("field")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("createUserPropertyField", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("field", field)
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	createdField, err := c.App.CreateUserPropertyField(field)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("field_id", createdField.Id)
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte(createdField.ToJson()))
+}
+
+func updateUserPropertyField(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireFieldId()
+	if c.Err != nil {
+		return
+	}
+
+	field := model.UserPropertyFieldFromJson(r.Body)
+	if field == nil {
+		c.SetInvalidParam("field")
+		return
+	}
+
+	// The field_id in the URL overrides any one in the body.
+	field.Id = c.Params.FieldId
+
+	auditRec := c.MakeAuditRecord("updateUserPropertyField", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("field", field)
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	updatedField, err := c.App.UpdateUserPropertyField(field)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	w.Write([]byte(updatedField.ToJson()))
+}
+
+func deleteUserPropertyField(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireFieldId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteUserPropertyField", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("field_id", c.Params.FieldId)
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_MANAGE_SYSTEM) {
+		c.SetPermissionError(model.PERMISSION_MANAGE_SYSTEM)
+		return
+	}
+
+	if err := c.App.DeleteUserPropertyField(c.Params.FieldId); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	ReturnStatusOK(w)
+}
+
+func getUserPropertyValues(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	canSee, err := c.App.UserCanSeeOtherUser(c.App.Session().UserId, c.Params.UserId)
+	if err != nil || !canSee {
+		c.SetPermissionError(model.PERMISSION_VIEW_MEMBERS)
+		return
+	}
+
+	values, err := c.App.GetUserPropertyValues(c.Params.UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(model.MapToJson(values)))
+}
+
+func patchUserPropertyValues(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	patch := model.MapFromJson(r.Body)
+	if len(patch) == 0 {
+		c.SetInvalidParam("properties")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("patchUserPropertyValues", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("user_id", c.Params.UserId)
+
+	if !c.App.SessionHasPermissionToUser(*c.App.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PERMISSION_EDIT_OTHER_USERS)
+		return
+	}
+
+	values, err := c.App.PatchUserPropertyValues(c.Params.UserId, patch, false)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	w.Write([]byte(model.MapToJson(values)))
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestUserProperties(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	newField := func(fieldType string, options ...string) *model.UserPropertyField {
+		return &model.UserPropertyField{
+			Name:        "field_" + model.NewId(),
+			DisplayName: "Field",
+			Type:        fieldType,
+			Options:     options,
+		}
+	}
+
+	t.Run("should require permission to manage the fields", func(t *testing.T) {
+		_, resp := th.Client.CreateUserPropertyField(newField(model.USER_PROPERTY_FIELD_TYPE_TEXT))
+		CheckForbiddenStatus(t, resp)
+	})
+
+	office, resp := th.SystemAdminClient.CreateUserPropertyField(newField(model.USER_PROPERTY_FIELD_TYPE_SELECT, "Berlin", "Toronto"))
+	CheckNoError(t, resp)
+	CheckCreatedStatus(t, resp)
+
+	department := newField(model.USER_PROPERTY_FIELD_TYPE_TEXT)
+	department.LdapAttribute = "departmentNumber"
+	department, resp = th.SystemAdminClient.CreateUserPropertyField(department)
+	CheckNoError(t, resp)
+
+	t.Run("should reject a duplicated name", func(t *testing.T) {
+		duplicate := newField(model.USER_PROPERTY_FIELD_TYPE_TEXT)
+		duplicate.Name = office.Name
+		_, resp := th.SystemAdminClient.CreateUserPropertyField(duplicate)
+		CheckErrorMessage(t, resp, "app.user_property.save_field.conflict.app_error")
+	})
+
+	t.Run("any user can list the fields", func(t *testing.T) {
+		fields, resp := th.Client.GetUserPropertyFields()
+		CheckNoError(t, resp)
+		require.Len(t, fields, 2)
+	})
+
+	t.Run("users can set their own values", func(t *testing.T) {
+		values, resp := th.Client.PatchUserPropertyValues(th.BasicUser.Id, map[string]string{office.Id: "Berlin"})
+		CheckNoError(t, resp)
+		require.Equal(t, map[string]string{office.Id: "Berlin"}, values)
+
+		_, resp = th.Client.PatchUserPropertyValues(th.BasicUser.Id, map[string]string{office.Id: "Paris"})
+		CheckBadRequestStatus(t, resp)
+
+		_, resp = th.Client.PatchUserPropertyValues(th.BasicUser.Id, map[string]string{department.Id: "R&D"})
+		CheckErrorMessage(t, resp, "app.user_property.patch_values.synced_field.app_error")
+
+		_, resp = th.Client.PatchUserPropertyValues(th.BasicUser2.Id, map[string]string{office.Id: "Berlin"})
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("other users can read the values", func(t *testing.T) {
+		values, resp := th.SystemAdminClient.GetUserPropertyValues(th.BasicUser.Id)
+		CheckNoError(t, resp)
+		require.Equal(t, "Berlin", values[office.Id])
+	})
+
+	t.Run("search can filter on the values", func(t *testing.T) {
+		users, resp := th.SystemAdminClient.SearchUsers(&model.UserSearch{
+			Term:            th.BasicUser.Username,
+			PropertyFilters: map[string]string{office.Id: "Berlin"},
+		})
+		CheckNoError(t, resp)
+		require.Len(t, users, 1)
+
+		users, resp = th.SystemAdminClient.SearchUsers(&model.UserSearch{
+			Term:            th.BasicUser.Username,
+			PropertyFilters: map[string]string{office.Id: "Toronto"},
+		})
+		CheckNoError(t, resp)
+		require.Empty(t, users)
+
+		_, resp = th.SystemAdminClient.SearchUsers(&model.UserSearch{
+			Term:            th.BasicUser.Username,
+			PropertyFilters: map[string]string{"junk": "Berlin"},
+		})
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("deleting a field removes its values", func(t *testing.T) {
+		ok, resp := th.SystemAdminClient.DeleteUserPropertyField(office.Id)
+		CheckNoError(t, resp)
+		require.True(t, ok)
+
+		values, resp := th.Client.GetUserPropertyValues(th.BasicUser.Id)
+		CheckNoError(t, resp)
+		require.Empty(t, values)
+	})
+}
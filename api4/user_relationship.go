@@ -0,0 +1,110 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/audit"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func (api *API) InitUserRelationship() {
+	api.BaseRoutes.User.Handle("/relationships", api.ApiSessionRequired(getUserRelationships)).Methods("GET")
+	api.BaseRoutes.User.Handle("/relationships", api.ApiSessionRequired(addUserRelationship)).Methods("POST")
+	api.BaseRoutes.User.Handle("/relationships/{other_user_id:[A-Za-z0-9]+}", api.ApiSessionRequired(removeUserRelationship)).Methods("DELETE")
+}
+
+func getUserRelationships(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	relationshipType := r.URL.Query().Get("type")
+	if relationshipType != "" && !model.IsValidUserRelationshipType(relationshipType) {
+		c.SetInvalidUrlParam("type")
+		return
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.App.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PERMISSION_EDIT_OTHER_USERS)
+		return
+	}
+
+	relationships, err := c.App.GetUserRelationships(c.Params.UserId, relationshipType)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(model.UserRelationshipListToJson(relationships)))
+}
+
+func addUserRelationship(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	relationship := model.UserRelationshipFromJson(r.Body)
+	if relationship == nil {
+		c.SetInvalidParam("relationship")
+		return
+	}
+	relationship.UserId = c.Params.UserId
+
+	auditRec := c.MakeAuditRecord("addUserRelationship", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("relationship", relationship)
+
+	if !c.App.SessionHasPermissionToUser(*c.App.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PERMISSION_EDIT_OTHER_USERS)
+		return
+	}
+
+	savedRelationship, err := c.App.AddUserRelationship(relationship)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte(savedRelationship.ToJson()))
+}
+
+func removeUserRelationship(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId().RequireOtherUserId()
+	if c.Err != nil {
+		return
+	}
+
+	relationshipType := r.URL.Query().Get("type")
+	if !model.IsValidUserRelationshipType(relationshipType) {
+		c.SetInvalidUrlParam("type")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("removeUserRelationship", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("user_id", c.Params.UserId)
+	auditRec.AddMeta("other_user_id", c.Params.OtherUserId)
+	auditRec.AddMeta("type", relationshipType)
+
+	if !c.App.SessionHasPermissionToUser(*c.App.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PERMISSION_EDIT_OTHER_USERS)
+		return
+	}
+
+	if err := c.App.RemoveUserRelationship(c.Params.UserId, c.Params.OtherUserId, relationshipType); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	ReturnStatusOK(w)
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestUserRelationships(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	Client := th.Client
+
+	dm, resp := Client.CreateDirectChannel(th.BasicUser.Id, th.BasicUser2.Id)
+	CheckNoError(t, resp)
+
+	t.Run("users should mute other users", func(t *testing.T) {
+		relationship, resp := Client.AddUserRelationship(&model.UserRelationship{
+			UserId:      th.BasicUser.Id,
+			OtherUserId: th.BasicUser2.Id,
+			Type:        model.USER_RELATIONSHIP_MUTE,
+		})
+		CheckNoError(t, resp)
+		CheckCreatedStatus(t, resp)
+		require.Equal(t, th.BasicUser.Id, relationship.UserId)
+
+		_, resp = Client.AddUserRelationship(relationship)
+		CheckBadRequestStatus(t, resp)
+
+		relationships, resp := Client.GetUserRelationships(th.BasicUser.Id, model.USER_RELATIONSHIP_MUTE)
+		CheckNoError(t, resp)
+		require.Len(t, relationships, 1)
+		require.Equal(t, th.BasicUser2.Id, relationships[0].OtherUserId)
+
+		relationships, resp = Client.GetUserRelationships(th.BasicUser.Id, model.USER_RELATIONSHIP_BLOCK)
+		CheckNoError(t, resp)
+		require.Empty(t, relationships)
+	})
+
+	t.Run("users should not manage the relationships of others", func(t *testing.T) {
+		_, resp := Client.GetUserRelationships(th.BasicUser2.Id, "")
+		CheckForbiddenStatus(t, resp)
+
+		_, resp = Client.AddUserRelationship(&model.UserRelationship{
+			UserId:      th.BasicUser2.Id,
+			OtherUserId: th.BasicUser.Id,
+			Type:        model.USER_RELATIONSHIP_BLOCK,
+		})
+		CheckForbiddenStatus(t, resp)
+
+		_, resp = Client.RemoveUserRelationship(th.BasicUser2.Id, th.BasicUser.Id, model.USER_RELATIONSHIP_BLOCK)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("invalid relationships should be rejected", func(t *testing.T) {
+		_, resp := Client.AddUserRelationship(&model.UserRelationship{
+			UserId:      th.BasicUser.Id,
+			OtherUserId: th.BasicUser2.Id,
+			Type:        "follow",
+		})
+		CheckBadRequestStatus(t, resp)
+
+		_, resp = Client.AddUserRelationship(&model.UserRelationship{
+			UserId:      th.BasicUser.Id,
+			OtherUserId: model.NewId(),
+			Type:        model.USER_RELATIONSHIP_BLOCK,
+		})
+		CheckNotFoundStatus(t, resp)
+
+		_, resp = Client.GetUserRelationships(th.BasicUser.Id, "follow")
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("blocked users should not send direct messages", func(t *testing.T) {
+		_, resp := th.SystemAdminClient.AddUserRelationship(&model.UserRelationship{
+			UserId:      th.BasicUser2.Id,
+			OtherUserId: th.BasicUser.Id,
+			Type:        model.USER_RELATIONSHIP_BLOCK,
+		})
+		CheckNoError(t, resp)
+
+		_, resp = Client.CreatePost(&model.Post{ChannelId: dm.Id, Message: "hello"})
+		CheckForbiddenStatus(t, resp)
+
+		pass, resp := th.SystemAdminClient.RemoveUserRelationship(th.BasicUser2.Id, th.BasicUser.Id, model.USER_RELATIONSHIP_BLOCK)
+		CheckNoError(t, resp)
+		require.True(t, pass)
+
+		_, resp = th.SystemAdminClient.RemoveUserRelationship(th.BasicUser2.Id, th.BasicUser.Id, model.USER_RELATIONSHIP_BLOCK)
+		CheckNotFoundStatus(t, resp)
+
+		_, resp = Client.CreatePost(&model.Post{ChannelId: dm.Id, Message: "hello"})
+		CheckNoError(t, resp)
+	})
+}
@@ -486,7 +486,7 @@ func TestCreateUserWithInviteId(t *testing.T) {
 
 		_, resp := th.Client.CreateUserWithInviteId(&user, inviteId)
 		CheckNotFoundStatus(t, resp)
-		CheckErrorMessage(t, resp, "store.sql_team.get_by_invite_id.finding.app_error")
+		CheckErrorMessage(t, resp, "app.team.get_by_invite_id.finding.app_error")
 	})
 
 	t.Run("NoInviteId", func(t *testing.T) {
@@ -507,7 +507,7 @@ func TestCreateUserWithInviteId(t *testing.T) {
 
 		_, resp = th.Client.CreateUserWithInviteId(&user, inviteId)
 		CheckNotFoundStatus(t, resp)
-		CheckErrorMessage(t, resp, "store.sql_team.get_by_invite_id.finding.app_error")
+		CheckErrorMessage(t, resp, "app.team.get_by_invite_id.finding.app_error")
 	})
 
 	t.Run("EnableUserCreationDisable", func(t *testing.T) {
@@ -1933,7 +1933,7 @@ func TestPermanentDeleteAllUsers(t *testing.T) {
 		require.Nil(t, err)
 		require.Greater(t, len(users), 0)
 
-		postCount, err := th.App.Srv().Store.Post().AnalyticsPostCount("", false, false)
+		postCount, err := th.App.Srv().Store.Post().AnalyticsPostCount("", false, false, false)
 		require.Nil(t, err)
 		require.Greater(t, postCount, int64(0))
 
@@ -1946,7 +1946,7 @@ func TestPermanentDeleteAllUsers(t *testing.T) {
 		require.Nil(t, err)
 		require.Len(t, users, 0)
 
-		postCount, err = th.App.Srv().Store.Post().AnalyticsPostCount("", false, false)
+		postCount, err = th.App.Srv().Store.Post().AnalyticsPostCount("", false, false, false)
 		require.Nil(t, err)
 		require.Equal(t, postCount, int64(0))
 
@@ -1986,6 +1986,66 @@ func TestUpdateUserRoles(t *testing.T) {
 	})
 }
 
+func TestWriteRequestAfterCSRFRotation(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.Client.HttpHeader[model.HEADER_REQUESTED_WITH] = model.HEADER_REQUESTED_WITH_XML
+	_, resp := th.Client.Login(th.BasicUser.Email, th.BasicUser.Password)
+	CheckNoError(t, resp)
+
+	session, err := th.App.GetSession(th.Client.AuthToken)
+	require.Nil(t, err)
+	previousCSRF := session.GetCSRF()
+	require.NotEmpty(t, previousCSRF)
+
+	// An admin changing the roles of the user rotates the CSRF token of their sessions.
+	_, resp = th.SystemAdminClient.UpdateUserRoles(th.BasicUser.Id, model.SYSTEM_USER_ROLE_ID+" "+model.SYSTEM_POST_ALL_ROLE_ID)
+	CheckNoError(t, resp)
+
+	session, err = th.App.GetSession(th.Client.AuthToken)
+	require.Nil(t, err)
+	require.NotEqual(t, previousCSRF, session.GetCSRF())
+
+	patchWithCSRF := func(csrfToken string) *http.Response {
+		t.Helper()
+		request, err := http.NewRequest(http.MethodPut, th.Client.ApiUrl+th.Client.GetUserRoute(th.BasicUser.Id)+"/patch", strings.NewReader(`{"nickname":"`+model.NewId()+`"}`))
+		require.NoError(t, err)
+		request.AddCookie(&http.Cookie{Name: model.SESSION_COOKIE_TOKEN, Value: th.Client.AuthToken})
+		request.AddCookie(&http.Cookie{Name: model.SESSION_COOKIE_CSRF, Value: csrfToken})
+		request.Header.Set(model.HEADER_CSRF_TOKEN, csrfToken)
+		request.Header.Set(model.HEADER_REQUESTED_WITH, model.HEADER_REQUESTED_WITH_XML)
+
+		response, err := th.Client.HttpClient.Do(request)
+		require.NoError(t, err)
+		response.Body.Close()
+		return response
+	}
+
+	t.Run("the previous token is accepted and the new one is attached", func(t *testing.T) {
+		response := patchWithCSRF(previousCSRF)
+		require.Equal(t, http.StatusOK, response.StatusCode)
+
+		csrfCookie := ""
+		for _, cookie := range response.Cookies() {
+			if cookie.Name == model.SESSION_COOKIE_CSRF {
+				csrfCookie = cookie.Value
+			}
+		}
+		require.Equal(t, session.GetCSRF(), csrfCookie)
+	})
+
+	t.Run("the new token is accepted", func(t *testing.T) {
+		response := patchWithCSRF(session.GetCSRF())
+		require.Equal(t, http.StatusOK, response.StatusCode)
+	})
+
+	t.Run("an unknown token is rejected", func(t *testing.T) {
+		response := patchWithCSRF(model.NewId())
+		require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+	})
+}
+
 func assertExpectedWebsocketEvent(t *testing.T, client *model.WebSocketClient, event string, test func(*model.WebSocketEvent)) {
 	for {
 		select {
@@ -2166,6 +2226,34 @@ func TestUpdateUserActive(t *testing.T) {
 	})
 }
 
+func TestUpdateUserActiveAsUserManager(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateUserRoles(th.BasicUser.Id, model.SYSTEM_USER_ROLE_ID+" "+model.SYSTEM_USER_MANAGER_ROLE_ID, false)
+	th.LoginBasic()
+
+	t.Run("should deactivate and reactivate a user", func(t *testing.T) {
+		pass, resp := th.Client.UpdateUserActive(th.BasicUser2.Id, false)
+		CheckNoError(t, resp)
+		require.True(t, pass)
+
+		pass, resp = th.Client.UpdateUserActive(th.BasicUser2.Id, true)
+		CheckNoError(t, resp)
+		require.True(t, pass)
+	})
+
+	t.Run("should not deactivate a system admin", func(t *testing.T) {
+		_, resp := th.Client.UpdateUserActive(th.SystemAdminUser.Id, false)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("should not read the compliance reports", func(t *testing.T) {
+		_, resp := th.Client.GetComplianceReports(0, 10)
+		CheckForbiddenStatus(t, resp)
+	})
+}
+
 func TestGetUsers(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -2318,6 +2406,68 @@ func TestGetUsersWithoutTeam(t *testing.T) {
 	require.True(t, found2, "should return user that has no teams")
 }
 
+func TestGetUsersWithAccountFilters(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	newUser := th.CreateUser()
+
+	t.Run("should prevent non-admin users from filtering on accounts", func(t *testing.T) {
+		_, resp := th.Client.GetUsersWithAccountFilters(&model.UserAccountFilters{NeverLoggedIn: true}, 0, 100)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("should return the users who never logged in", func(t *testing.T) {
+		users, resp := th.SystemAdminClient.GetUsersWithAccountFilters(&model.UserAccountFilters{NeverLoggedIn: true}, 0, model.USER_SEARCH_MAX_LIMIT)
+		CheckNoError(t, resp)
+
+		ids := []string{}
+		for _, user := range users {
+			ids = append(ids, user.Id)
+		}
+		require.Contains(t, ids, newUser.Id)
+		require.NotContains(t, ids, th.BasicUser.Id)
+	})
+
+	t.Run("should show the last login to admins only", func(t *testing.T) {
+		user, resp := th.SystemAdminClient.GetUser(th.BasicUser.Id, "")
+		CheckNoError(t, resp)
+		require.NotZero(t, user.LastLogin)
+
+		user, resp = th.Client.GetUser(th.SystemAdminUser.Id, "")
+		CheckNoError(t, resp)
+		require.Zero(t, user.LastLogin)
+	})
+
+	t.Run("should reject invalid filters", func(t *testing.T) {
+		_, resp := th.SystemAdminClient.GetUsersWithAccountFilters(&model.UserAccountFilters{MfaActive: true, MfaInactive: true}, 0, 100)
+		CheckBadRequestStatus(t, resp)
+		CheckErrorMessage(t, resp, "model.user_account_filters.is_valid.mfa.app_error")
+
+		_, resp = th.SystemAdminClient.GetUsersWithAccountFilters(&model.UserAccountFilters{AuthService: "unknown"}, 0, 100)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("should filter searches", func(t *testing.T) {
+		search := &model.UserSearch{Term: newUser.Username}
+		search.NeverLoggedIn = true
+
+		_, resp := th.Client.SearchUsers(search)
+		CheckForbiddenStatus(t, resp)
+
+		users, resp := th.SystemAdminClient.SearchUsers(search)
+		CheckNoError(t, resp)
+		require.Len(t, users, 1)
+		require.Equal(t, newUser.Id, users[0].Id)
+
+		search = &model.UserSearch{Term: th.BasicUser.Username}
+		search.NeverLoggedIn = true
+		users, resp = th.SystemAdminClient.SearchUsers(search)
+		CheckNoError(t, resp)
+		require.Empty(t, users)
+	})
+}
+
 func TestGetUsersInTeam(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -5101,3 +5251,94 @@ func TestConvertUserToBot(t *testing.T) {
 		require.NotNil(t, bot)
 	})
 }
+
+func TestImpersonateUser(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableImpersonation = false })
+
+		_, resp := th.SystemAdminClient.ImpersonateUser(th.BasicUser.Id, false)
+		CheckNotImplementedStatus(t, resp)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableImpersonation = true
+		*cfg.ServiceSettings.EnableImpersonationWriteAccess = false
+	})
+
+	t.Run("without permission", func(t *testing.T) {
+		_, resp := th.Client.ImpersonateUser(th.BasicUser2.Id, false)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("invalid targets", func(t *testing.T) {
+		_, resp := th.SystemAdminClient.ImpersonateUser(th.SystemAdminUser.Id, false)
+		CheckBadRequestStatus(t, resp)
+
+		admin := th.CreateUser()
+		th.App.UpdateUserRoles(admin.Id, model.SYSTEM_USER_ROLE_ID+" "+model.SYSTEM_ADMIN_ROLE_ID, false)
+		_, resp = th.SystemAdminClient.ImpersonateUser(admin.Id, false)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp = th.SystemAdminClient.ImpersonateUser(model.NewId(), false)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("write access disabled", func(t *testing.T) {
+		_, resp := th.SystemAdminClient.ImpersonateUser(th.BasicUser.Id, true)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("read-only session", func(t *testing.T) {
+		session, resp := th.SystemAdminClient.ImpersonateUser(th.BasicUser.Id, false)
+		CheckNoError(t, resp)
+		CheckCreatedStatus(t, resp)
+		require.Equal(t, th.BasicUser.Id, session.UserId)
+		require.Equal(t, th.SystemAdminUser.Id, session.ImpersonatorId())
+		require.True(t, session.IsImpersonationReadOnly())
+		require.InDelta(t, model.GetMillis()+30*60*1000, session.ExpiresAt, 60*1000)
+
+		client := th.CreateClient()
+		client.AuthToken = session.Token
+		client.AuthType = model.HEADER_BEARER
+
+		me, resp := client.GetMe("")
+		CheckNoError(t, resp)
+		require.Equal(t, th.BasicUser.Id, me.Id)
+
+		_, resp = client.CreatePost(&model.Post{ChannelId: th.BasicChannel.Id, Message: "message"})
+		CheckForbiddenStatus(t, resp)
+
+		_, resp = client.ImpersonateUser(th.BasicUser2.Id, false)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp = client.Logout()
+		CheckNoError(t, resp)
+
+		_, resp = client.GetMe("")
+		CheckUnauthorizedStatus(t, resp)
+	})
+
+	t.Run("write access", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableImpersonationWriteAccess = true })
+
+		session, resp := th.SystemAdminClient.ImpersonateUser(th.BasicUser.Id, true)
+		CheckNoError(t, resp)
+		require.False(t, session.IsImpersonationReadOnly())
+
+		client := th.CreateClient()
+		client.AuthToken = session.Token
+		client.AuthType = model.HEADER_BEARER
+
+		post, resp := client.CreatePost(&model.Post{ChannelId: th.BasicChannel.Id, Message: "message"})
+		CheckNoError(t, resp)
+		require.Equal(t, th.BasicUser.Id, post.UserId)
+
+		// Disabling impersonation ends the sessions already started.
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableImpersonation = false })
+		_, resp = client.GetMe("")
+		CheckUnauthorizedStatus(t, resp)
+	})
+}
@@ -23,6 +23,12 @@ func (api *API) InitWebhook() {
 	api.BaseRoutes.OutgoingHook.Handle("", api.ApiSessionRequired(updateOutgoingHook)).Methods("PUT")
 	api.BaseRoutes.OutgoingHook.Handle("", api.ApiSessionRequired(deleteOutgoingHook)).Methods("DELETE")
 	api.BaseRoutes.OutgoingHook.Handle("/regen_token", api.ApiSessionRequired(regenOutgoingHookToken)).Methods("POST")
+
+	api.BaseRoutes.PresenceHooks.Handle("", api.ApiSessionRequired(createPresenceHook)).Methods("POST")
+	api.BaseRoutes.PresenceHooks.Handle("", api.ApiSessionRequired(getPresenceHooks)).Methods("GET")
+	api.BaseRoutes.PresenceHook.Handle("", api.ApiSessionRequired(getPresenceHook)).Methods("GET")
+	api.BaseRoutes.PresenceHook.Handle("", api.ApiSessionRequired(updatePresenceHook)).Methods("PUT")
+	api.BaseRoutes.PresenceHook.Handle("", api.ApiSessionRequired(deletePresenceHook)).Methods("DELETE")
 }
 
 func createIncomingHook(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -574,3 +580,145 @@ func deleteOutgoingHook(c *Context, w http.ResponseWriter, r *http.Request) {
 
 	ReturnStatusOK(w)
 }
+
+func createPresenceHook(c *Context, w http.ResponseWriter, r *http.Request) {
+	hook := model.PresenceWebhookFromJson(r.Body)
+	if hook == nil {
+		c.SetInvalidParam("presence_webhook")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("createPresenceHook", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	c.LogAudit("attempt")
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_SYSCONSOLE_WRITE_INTEGRATIONS) {
+		c.SetPermissionError(model.PERMISSION_SYSCONSOLE_WRITE_INTEGRATIONS)
+		return
+	}
+
+	presenceHook, err := c.App.CreatePresenceWebhook(hook, c.App.Session().UserId)
+	if err != nil {
+		c.LogAudit("fail")
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("hook", presenceHook)
+	c.LogAudit("success")
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte(presenceHook.ToJson()))
+}
+
+func getPresenceHooks(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_SYSCONSOLE_READ_INTEGRATIONS) {
+		c.SetPermissionError(model.PERMISSION_SYSCONSOLE_READ_INTEGRATIONS)
+		return
+	}
+
+	hooks, err := c.App.GetPresenceWebhooksPage(c.Params.Page, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(model.PresenceWebhookListToJson(hooks)))
+}
+
+func getPresenceHook(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireHookId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_SYSCONSOLE_READ_INTEGRATIONS) {
+		c.SetPermissionError(model.PERMISSION_SYSCONSOLE_READ_INTEGRATIONS)
+		return
+	}
+
+	hook, err := c.App.GetPresenceWebhook(c.Params.HookId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(hook.ToJson()))
+}
+
+func updatePresenceHook(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireHookId()
+	if c.Err != nil {
+		return
+	}
+
+	updatedHook := model.PresenceWebhookFromJson(r.Body)
+	if updatedHook == nil {
+		c.SetInvalidParam("presence_webhook")
+		return
+	}
+
+	// The hook being updated in the payload must be the same one as indicated in the URL.
+	if updatedHook.Id != c.Params.HookId {
+		c.SetInvalidParam("hook_id")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("updatePresenceHook", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("hook_id", c.Params.HookId)
+	c.LogAudit("attempt")
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_SYSCONSOLE_WRITE_INTEGRATIONS) {
+		c.SetPermissionError(model.PERMISSION_SYSCONSOLE_WRITE_INTEGRATIONS)
+		return
+	}
+
+	oldHook, err := c.App.GetPresenceWebhook(c.Params.HookId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	presenceHook, err := c.App.UpdatePresenceWebhook(oldHook, updatedHook)
+	if err != nil {
+		c.LogAudit("fail")
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("hook", presenceHook)
+	c.LogAudit("success")
+
+	w.Write([]byte(presenceHook.ToJson()))
+}
+
+func deletePresenceHook(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireHookId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deletePresenceHook", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("hook_id", c.Params.HookId)
+	c.LogAudit("attempt")
+
+	if !c.App.SessionHasPermissionTo(*c.App.Session(), model.PERMISSION_SYSCONSOLE_WRITE_INTEGRATIONS) {
+		c.SetPermissionError(model.PERMISSION_SYSCONSOLE_WRITE_INTEGRATIONS)
+		return
+	}
+
+	if err := c.App.DeletePresenceWebhook(c.Params.HookId); err != nil {
+		c.LogAudit("fail")
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	c.LogAudit("success")
+
+	ReturnStatusOK(w)
+}
@@ -1107,3 +1107,92 @@ func TestDeleteOutgoingHook(t *testing.T) {
 		CheckForbiddenStatus(t, resp)
 	})
 }
+
+func TestPresenceWebhooks(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	hook := &model.PresenceWebhook{
+		DisplayName:     "on-call",
+		CallbackURL:     "http://nowhere.com",
+		UserIds:         []string{th.BasicUser.Id, th.BasicUser2.Id},
+		Statuses:        []string{model.STATUS_ONLINE, model.STATUS_OFFLINE},
+		DebounceSeconds: 30,
+	}
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnablePresenceWebhooks = false })
+
+	_, resp := th.SystemAdminClient.CreatePresenceWebhook(hook)
+	CheckNotImplementedStatus(t, resp)
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnablePresenceWebhooks = true })
+
+	_, resp = th.Client.CreatePresenceWebhook(hook)
+	CheckForbiddenStatus(t, resp)
+
+	_, resp = th.Client.GetPresenceWebhooks(0, 100, "")
+	CheckForbiddenStatus(t, resp)
+
+	created, resp := th.SystemAdminClient.CreatePresenceWebhook(hook)
+	CheckNoError(t, resp)
+	CheckCreatedStatus(t, resp)
+	assert.Equal(t, th.SystemAdminUser.Id, created.CreatorId)
+	assert.ElementsMatch(t, hook.UserIds, created.UserIds)
+
+	t.Run("unknown user", func(t *testing.T) {
+		invalid := *hook
+		invalid.UserIds = []string{model.NewId()}
+		_, resp := th.SystemAdminClient.CreatePresenceWebhook(&invalid)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		fetched, resp := th.SystemAdminClient.GetPresenceWebhook(created.Id)
+		CheckNoError(t, resp)
+		assert.Equal(t, created.Id, fetched.Id)
+
+		_, resp = th.Client.GetPresenceWebhook(created.Id)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp = th.SystemAdminClient.GetPresenceWebhook(model.NewId())
+		CheckNotFoundStatus(t, resp)
+
+		hooks, resp := th.SystemAdminClient.GetPresenceWebhooks(0, 100, "")
+		CheckNoError(t, resp)
+		found := false
+		for _, h := range hooks {
+			if h.Id == created.Id {
+				found = true
+			}
+		}
+		assert.True(t, found)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		update := *created
+		update.UserIds = []string{th.BasicUser.Id}
+		update.Statuses = nil
+		update.CreatorId = model.NewId()
+
+		updated, resp := th.SystemAdminClient.UpdatePresenceWebhook(&update)
+		CheckNoError(t, resp)
+		assert.Equal(t, []string{th.BasicUser.Id}, []string(updated.UserIds))
+		assert.Empty(t, updated.Statuses)
+		assert.Equal(t, created.CreatorId, updated.CreatorId)
+
+		_, resp = th.Client.UpdatePresenceWebhook(&update)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		_, resp := th.Client.DeletePresenceWebhook(created.Id)
+		CheckForbiddenStatus(t, resp)
+
+		ok, resp := th.SystemAdminClient.DeletePresenceWebhook(created.Id)
+		CheckNoError(t, resp)
+		assert.True(t, ok)
+
+		_, resp = th.SystemAdminClient.GetPresenceWebhook(created.Id)
+		CheckNotFoundStatus(t, resp)
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"strings"
 	"time"
 
 	"runtime/debug"
@@ -160,6 +161,11 @@ func (s *Server) InvalidateAllCachesSkipSend() {
 	mlog.Info("Purging all caches")
 	s.sessionCache.Purge()
 	s.statusCache.Purge()
+	s.termsOfServiceCache.Purge()
+	s.teamDirectoryCache.Purge()
+	s.commandDynamicListCache.Purge()
+	s.profilesCache.Purge()
+	s.unknownStatusCache.Purge()
 	s.Store.Team().ClearCaches()
 	s.Store.Channel().ClearCaches()
 	s.Store.User().ClearCaches()
@@ -180,6 +186,30 @@ func (a *App) RecycleDatabaseConnection() {
 	mlog.Info("Finished recycling database connections.")
 }
 
+// GetSchemaMigrationStatus reports the progress of the schema migration run by whichever node of
+// the cluster holds the migration lock, or the outcome of the last one.
+func (a *App) GetSchemaMigrationStatus() (*model.SchemaMigrationStatus, *model.AppError) {
+	props, err := a.Srv().Store.System().Get()
+	if err != nil {
+		return nil, err
+	}
+
+	value, ok := props[model.SYSTEM_SCHEMA_MIGRATION_STATUS]
+	if !ok {
+		return &model.SchemaMigrationStatus{
+			Status:         model.SCHEMA_MIGRATION_STATUS_NONE,
+			CurrentVersion: props["Version"],
+		}, nil
+	}
+
+	status := model.SchemaMigrationStatusFromJson(strings.NewReader(value))
+	if status == nil {
+		return nil, model.NewAppError("GetSchemaMigrationStatus", "app.admin.schema_migration_status.app_error", nil, "", http.StatusInternalServerError)
+	}
+
+	return status, nil
+}
+
 func (a *App) TestSiteURL(siteURL string) *model.AppError {
 	url := fmt.Sprintf("%s/api/v4/system/ping", siteURL)
 	res, err := http.Get(url)
@@ -16,10 +16,13 @@ const (
 	MONTH_MILLISECONDS = 31 * DAY_MILLISECONDS
 )
 
-func (a *App) GetAnalytics(name string, teamId string) (model.AnalyticsRows, *model.AppError) {
+// GetAnalytics returns the named set of analytics rows. The system wide post, user and session counts
+// are estimated from the table statistics unless exact is set, since counting them exactly takes
+// seconds on large installations.
+func (a *App) GetAnalytics(name string, teamId string, exact bool) (model.AnalyticsRows, *model.AppError) {
 	skipIntensiveQueries := false
 	var systemUserCount int64
-	systemUserCount, err := a.Srv().Store.User().Count(model.UserCountOptions{})
+	systemUserCount, err := a.Srv().Store.User().Count(model.UserCountOptions{Estimate: !exact})
 	if err != nil {
 		return nil, err
 	}
@@ -75,10 +78,12 @@ func (a *App) GetAnalytics(name string, teamId string) (model.AnalyticsRows, *mo
 		}
 
 		var postChan chan store.StoreResult
-		if !skipIntensiveQueries {
+		// Estimating the system wide post count is cheap regardless of the size of the table.
+		estimatePostCount := !exact && teamId == ""
+		if !skipIntensiveQueries || estimatePostCount {
 			postChan = make(chan store.StoreResult, 1)
 			go func() {
-				count, err2 := a.Srv().Store.Post().AnalyticsPostCount(teamId, false, false)
+				count, err2 := a.Srv().Store.Post().AnalyticsPostCount(teamId, false, false, estimatePostCount)
 				postChan <- store.StoreResult{Data: count, Err: err2}
 				close(postChan)
 			}()
@@ -86,7 +91,7 @@ func (a *App) GetAnalytics(name string, teamId string) (model.AnalyticsRows, *mo
 
 		teamCountChan := make(chan store.StoreResult, 1)
 		go func() {
-			teamCount, err2 := a.Srv().Store.Team().AnalyticsTeamCount(false)
+			teamCount, err2 := a.Srv().Store.Team().AnalyticsTeamCount(a.Context(), false)
 			teamCountChan <- store.StoreResult{Data: teamCount, Err: err2}
 			close(teamCountChan)
 		}()
@@ -252,7 +257,7 @@ func (a *App) GetAnalytics(name string, teamId string) (model.AnalyticsRows, *mo
 
 		sessionChan := make(chan store.StoreResult, 1)
 		go func() {
-			count, err2 := a.Srv().Store.Session().AnalyticsSessionCount()
+			count, err2 := a.Srv().Store.Session().AnalyticsSessionCount(!exact)
 			sessionChan <- store.StoreResult{Data: count, NErr: err2}
 			close(sessionChan)
 		}()
@@ -263,14 +268,14 @@ func (a *App) GetAnalytics(name string, teamId string) (model.AnalyticsRows, *mo
 		if !skipIntensiveQueries {
 			fileChan = make(chan store.StoreResult, 1)
 			go func() {
-				count, err2 := a.Srv().Store.Post().AnalyticsPostCount(teamId, true, false)
+				count, err2 := a.Srv().Store.Post().AnalyticsPostCount(teamId, true, false, false)
 				fileChan <- store.StoreResult{Data: count, Err: err2}
 				close(fileChan)
 			}()
 
 			hashtagChan = make(chan store.StoreResult, 1)
 			go func() {
-				count, err2 := a.Srv().Store.Post().AnalyticsPostCount(teamId, false, true)
+				count, err2 := a.Srv().Store.Post().AnalyticsPostCount(teamId, false, true, false)
 				hashtagChan <- store.StoreResult{Data: count, Err: err2}
 				close(hashtagChan)
 			}()
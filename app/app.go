@@ -108,6 +108,9 @@ func (a *App) InitServer() {
 			a.Srv().Go(func() {
 				runLicenseExpirationCheckJob(a)
 			})
+			a.Srv().Go(func() {
+				runStatusAutomationEndJob(a)
+			})
 		}
 		a.srv.RunJobs()
 	})
@@ -135,6 +138,27 @@ func (a *App) initJobs() {
 	if jobsExpiryNotifyInterface != nil {
 		a.srv.Jobs.ExpiryNotify = jobsExpiryNotifyInterface(a)
 	}
+	if jobsSeatUsageNotifyInterface != nil {
+		a.srv.Jobs.SeatUsageNotify = jobsSeatUsageNotifyInterface(a)
+	}
+	if jobsEmailVerificationInterface != nil {
+		a.srv.Jobs.EmailVerification = jobsEmailVerificationInterface(a)
+	}
+	if jobsBackupInterface != nil {
+		a.srv.Jobs.Backup = jobsBackupInterface(a)
+	}
+	if jobsRoleExpiryInterface != nil {
+		a.srv.Jobs.RoleExpiry = jobsRoleExpiryInterface(a)
+	}
+	if jobsFileEnrichmentInterface != nil {
+		a.srv.Jobs.FileEnrichment = jobsFileEnrichmentInterface(a)
+	}
+	if jobsPostIntegrityInterface != nil {
+		a.srv.Jobs.PostIntegrity = jobsPostIntegrityInterface(a)
+	}
+	if jobsTeamPurgeInterface != nil {
+		a.srv.Jobs.TeamPurge = jobsTeamPurgeInterface(a)
+	}
 
 	a.srv.Jobs.Workers = a.srv.Jobs.InitWorkers()
 	a.srv.Jobs.Schedulers = a.srv.Jobs.InitSchedulers()
@@ -261,7 +285,13 @@ func (a *App) ImageProxy() *imageproxy.ImageProxy {
 func (a *App) Timezones() *timezones.Timezones {
 	return a.timezones
 }
+
+// Context returns the context of the request the app is serving, cancelled once the request is
+// aborted, or the background context outside of a request.
 func (a *App) Context() context.Context {
+	if a.context == nil {
+		return context.Background()
+	}
 	return a.context
 }
 
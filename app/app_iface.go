@@ -26,6 +26,7 @@ import (
 	"github.com/mattermost/mattermost-server/v5/mlog"
 	"github.com/mattermost/mattermost-server/v5/model"
 	"github.com/mattermost/mattermost-server/v5/plugin"
+	"github.com/mattermost/mattermost-server/v5/services/backup"
 	"github.com/mattermost/mattermost-server/v5/services/filesstore"
 	"github.com/mattermost/mattermost-server/v5/services/httpservice"
 	"github.com/mattermost/mattermost-server/v5/services/imageproxy"
@@ -43,16 +44,43 @@ type AppIface interface {
 	ListAutocompleteCommands(teamId string, T goi18n.TranslateFunc) ([]*model.Command, *model.AppError)
 	// @openTracingParams teamId, skipSlackParsing
 	CreateCommandPost(post *model.Post, teamId string, response *model.CommandResponse, skipSlackParsing bool) (*model.Post, *model.AppError)
+	// AcceptTermsOfServicePolicyVersion records that the user accepted the given version of a policy. Only
+	// the latest version can be accepted, so that a client can't skip a version published in the meantime.
+	AcceptTermsOfServicePolicyVersion(userId, policyId, versionId string) *model.AppError
+	// AcknowledgePost records that the user acknowledged the post, which must have requested it, and
+	// lets the channel know about it.
+	AcknowledgePost(post *model.Post, userId string) (*model.PostAcknowledgement, *model.AppError)
+	// AddChannelIntegration allows a bot, an incoming webhook or a slash command in the channel. Webhooks
+	// and commands have to belong to the team of the channel.
+	AddChannelIntegration(channel *model.Channel, integration *model.ChannelIntegration) (*model.ChannelIntegration, *model.AppError)
 	// AddCursorIdsForPostList adds NextPostId and PrevPostId as cursor to the PostList.
 	// The conditional blocks ensure that it sets those cursor IDs immediately as afterPost, beforePost or empty,
 	// and only query to database whenever necessary.
 	AddCursorIdsForPostList(originalList *model.PostList, afterPost, beforePost string, since int64, page, perPage int)
 	// AddPublicKey will add plugin public key to the config. Overwrites the previous file
 	AddPublicKey(name string, key io.Reader) *model.AppError
+	// AddTeamMemberByInviteLink joins the user to the team of the invite link with the given name,
+	// giving them the role of the link and adding them to its channels. Users already in the team don't
+	// use up the link.
+	AddTeamMemberByInviteLink(name, userId string) (*model.TeamMember, *model.AppError)
+	// AddUserRelationship mutes or blocks the other user on behalf of the user.
+	AddUserRelationship(relationship *model.UserRelationship) (*model.UserRelationship, *model.AppError)
+	// ApprovePendingPin pins the post of the request and removes the request.
+	ApprovePendingPin(pendingPin *model.PendingPin) (*model.Post, *model.AppError)
+	// AttachCSRFCookie sets the CSRF cookie to the current token of the session, so that clients pick
+	// up the token after it was rotated.
+	AttachCSRFCookie(w http.ResponseWriter, r *http.Request)
 	// Basic test team and user so you always know one
 	CreateBasicUser(client *model.Client4) *model.AppError
 	// Caller must close the first return value
 	FileReader(path string) (filesstore.ReadCloseSeeker, *model.AppError)
+	// CancelTeamPurge keeps a soft deleted team from being permanently deleted at the end of its grace
+	// period. The team stays archived until it's restored.
+	CancelTeamPurge(teamId string) (*model.Team, *model.AppError)
+	// CaptureProfile starts capturing a profile of this node, recorded by a profile capture job. The
+	// profile is saved as the artifact of the job once captured, the job being returned right away.
+	// seconds is the duration of a CPU profile, zero using ProfilingSettings.CPUProfileSeconds.
+	CaptureProfile(profileType string, seconds int, reason string) (*model.Job, *model.AppError)
 	// ChannelMembersMinusGroupMembers returns the set of users in the given channel minus the set of users in the given
 	// groups.
 	//
@@ -61,23 +89,42 @@ type AppIface interface {
 	ChannelMembersMinusGroupMembers(channelID string, groupIDs []string, page, perPage int) ([]*model.UserWithGroups, int64, *model.AppError)
 	// ClientConfigWithComputed gets the configuration in a format suitable for sending to the client.
 	ClientConfigWithComputed() map[string]string
+	// Context returns the context of the request the app is serving, cancelled once the request is
+	// aborted, or the background context outside of a request.
+	Context() context.Context
 	// ConvertBotToUser converts a bot to user.
 	ConvertBotToUser(bot *model.Bot, userPatch *model.UserPatch, sysadmin bool) (*model.User, *model.AppError)
 	// ConvertUserToBot converts a user to bot.
 	ConvertUserToBot(user *model.User) (*model.Bot, *model.AppError)
+	// CreateBackup writes a backup of the database, as a bulk export, to w. Deleted records are left
+	// out, so restoring incremental backups doesn't replay deletions.
+	CreateBackup(w io.Writer, opts BackupOptions) (*backup.Manifest, *model.AppError)
 	// CreateBot creates the given bot and corresponding user.
 	CreateBot(bot *model.Bot) (*model.Bot, *model.AppError)
 	// CreateChannelScheme creates a new Scheme of scope channel and assigns it to the channel.
 	CreateChannelScheme(channel *model.Channel) (*model.Scheme, *model.AppError)
+	// CreateDebugCaptureRule starts capturing the requests matching the rule on this node until the
+	// rule expires.
+	CreateDebugCaptureRule(rule *model.DebugCaptureRule) (*model.DebugCaptureRule, *model.AppError)
 	// CreateDefaultChannels creates channels in the given team for each channel returned by (*App).DefaultChannelNames.
 	//
 	CreateDefaultChannels(teamID string) ([]*model.Channel, *model.AppError)
 	// CreateDefaultMemberships adds users to teams and channels based on their group memberships and how those groups
 	// are configured to sync with teams and channels for group members on or after the given timestamp.
 	CreateDefaultMemberships(since int64) error
+	// CreateEmbedToken mints a short-lived token for the OAuth app of the session to embed the view
+	// of the channel on behalf of the user of the session.
+	CreateEmbedToken(session *model.Session, channelId string) (*model.EmbedToken, *model.AppError)
 	// CreateGuest creates a guest and sets several fields of the returned User struct to
 	// their zero values.
 	CreateGuest(user *model.User) (*model.User, *model.AppError)
+	// CreateGuestWithChannelGuestLink creates a guest account through the channel guest link with the
+	// given token, and adds it to the channel of the link and to its team. Guests only see the channels
+	// they are members of, so the account is limited to that channel until given access to others.
+	CreateGuestWithChannelGuestLink(user *model.User, token string) (*model.User, *model.AppError)
+	// CreateServiceAccount creates the user backing a service account. The account can't log in until
+	// it's given a user access token or a client side certificate.
+	CreateServiceAccount(account *model.ServiceAccount) (*model.User, *model.AppError)
 	// CreateUser creates a user and sets several fields of the returned User struct to
 	// their zero values.
 	CreateUser(user *model.User) (*model.User, *model.AppError)
@@ -87,6 +134,10 @@ type AppIface interface {
 	// This is to avoid having to change all the code in cmd/mattermost/commands/* for now
 	// shutdown should be called directly on the server
 	Shutdown()
+	// DebugCaptureRuleFor returns the id of an active rule capturing the requests of the user to the
+	// endpoint, or an empty string if the request isn't to be captured, and the minimum level the
+	// request is to log at, or an empty string to keep the level of the logger.
+	DebugCaptureRuleFor(endpoint, userId string) (string, string)
 	// DefaultChannelNames returns the list of system-wide default channel names.
 	//
 	// By default the list will be (not necessarily in this order):
@@ -100,23 +151,48 @@ type AppIface interface {
 	DeleteBotIconImage(botUserId string) *model.AppError
 	// DeleteChannelScheme deletes a channels scheme and sets its SchemeId to nil.
 	DeleteChannelScheme(channel *model.Channel) (*model.Channel, *model.AppError)
+	// DeleteDebugCaptureRule stops capturing the requests matching the rule. The requests it already
+	// captured are kept until overwritten.
+	DeleteDebugCaptureRule(ruleId string) *model.AppError
 	// DeleteGroupConstrainedMemberships deletes team and channel memberships of users who aren't members of the allowed
 	// groups of all group-constrained teams and channels.
 	DeleteGroupConstrainedMemberships() error
 	// DeletePublicKey will delete plugin public key from the config.
 	DeletePublicKey(name string) *model.AppError
+	// DeleteTermsOfServicePolicy stops enforcing a policy. Its versions and their acceptances are kept.
+	DeleteTermsOfServicePolicy(policyId string) *model.AppError
 	// DemoteUserToGuest Convert user's roles and all his mermbership's roles from
 	// regular user roles to guest roles.
 	DemoteUserToGuest(user *model.User) *model.AppError
 	// DisablePlugin will set the config for an installed plugin to disabled, triggering deactivation if active.
 	// Notifies cluster peers through config change.
 	DisablePlugin(id string) *model.AppError
+	// DisconnectWebConnection closes the websocket connection with the given id. The other nodes of the
+	// cluster are asked to close it when it isn't open on this one. Clients usually reconnect right away,
+	// under a new connection id.
+	DisconnectWebConnection(connectionId string) *model.AppError
 	// DoPermissionsMigrations execute all the permissions migrations need by the current version.
 	DoPermissionsMigrations() error
 	// EnablePlugin will set the config for an installed plugin to enabled, triggering asynchronous
 	// activation if inactive anywhere in the cluster.
 	// Notifies cluster peers through config change.
 	EnablePlugin(id string) *model.AppError
+	// EndStatusAutomation ends the automation of the status of the user, restoring the status they
+	// had before it started.
+	EndStatusAutomation(userId string) *model.AppError
+	// EnforceEmailVerificationGracePeriod locks the accounts of the users who missed the deadline to
+	// verify their email address, and reminds the ones whose deadline is coming up.
+	EnforceEmailVerificationGracePeriod() *model.AppError
+	// EnqueueFileEnrichment creates a file enrichment job for the given file, unless enrichment is disabled.
+	EnqueueFileEnrichment(info *model.FileInfo)
+	// EnrichFileInfo runs the file enrichers and the FileWillBeEnriched plugin hooks over the stored content of a file,
+	// and saves what they extract onto its FileInfo. An enricher that fails is logged and skipped.
+	EnrichFileInfo(fileId string) (*model.FileInfo, *model.AppError)
+	// ExchangeEmbedToken consumes an embed token and creates a session restricted to the channel it
+	// was minted for. origin is the Origin header of the exchange request, that is the origin of the
+	// parent application exchanging the token, and has to be one of the frame ancestors of the OAuth
+	// app. The session is never extended and only carries the base role of the user.
+	ExchangeEmbedToken(tokenString, origin string) (*model.Session, *model.AppError)
 	// Expand announcements in incoming webhooks from Slack. Those announcements
 	// can be found in the text attribute, or in the pretext, text, title and value
 	// attributes of the attachment structure. The Slack attachment structure is
@@ -137,32 +213,69 @@ type AppIface interface {
 	// FilterNonGroupTeamMembers returns the subset of the given user IDs of the users who are not members of groups
 	// associated to the team excluding bots.
 	FilterNonGroupTeamMembers(userIds []string, team *model.Team) ([]string, error)
+	// GenerateSupportPacket writes to w a zip archive describing the server to help diagnose it: the
+	// sanitized configuration, the recent logs, the jobs, the database, the plugins and the metrics.
+	// The secrets of the configuration are redacted from every file of the archive.
+	GenerateSupportPacket(w io.Writer) *model.AppError
 	// GetAllLdapGroupsPage retrieves all LDAP groups under the configured base DN using the default or configured group
 	// filter.
 	GetAllLdapGroupsPage(page int, perPage int, opts model.LdapGroupSearchOpts) ([]*model.Group, int, *model.AppError)
+	// GetAnalytics returns the named set of analytics rows. The system wide post, user and session counts
+	// are estimated from the table statistics unless exact is set, since counting them exactly takes
+	// seconds on large installations.
+	GetAnalytics(name string, teamId string, exact bool) (model.AnalyticsRows, *model.AppError)
 	// GetBot returns the given bot.
 	GetBot(botUserId string, includeDeleted bool) (*model.Bot, *model.AppError)
 	// GetBotIconImage retrieves LHS icon for a bot.
 	GetBotIconImage(botUserId string) ([]byte, *model.AppError)
 	// GetBots returns the requested page of bots.
 	GetBots(options *model.BotGetOptions) (model.BotList, *model.AppError)
+	// GetChannelChanges returns the changes to the channel past sinceSeq, along with the current state of
+	// the posts they concern.
+	GetChannelChanges(channelId string, sinceSeq int64) (*model.ChannelChanges, *model.AppError)
 	// GetChannelGroupUsers returns the users who are associated to the channel via GroupChannels and GroupMembers.
 	GetChannelGroupUsers(channelID string) ([]*model.User, *model.AppError)
+	// GetChannelMembersForUserPage returns a page of the channel memberships of the user in the team in
+	// the given order, either from the start or past the token handed out by a previous call.
+	GetChannelMembersForUserPage(teamId, userId, sortBy, token string, perPage int) (*model.ChannelMembersPage, *model.AppError)
 	// GetChannelModerationsForChannel Gets a channels ChannelModerations from either the higherScoped roles or from the channel scheme roles.
 	GetChannelModerationsForChannel(channel *model.Channel) ([]*model.ChannelModeration, *model.AppError)
 	// GetClusterPluginStatuses returns the status for plugins installed anywhere in the cluster.
 	GetClusterPluginStatuses() (model.PluginStatuses, *model.AppError)
+	// GetClusterWebConnections describes the websocket connections open anywhere in the cluster, or only
+	// those of the given user.
+	GetClusterWebConnections(userId string) ([]*model.WebConnInfo, *model.AppError)
 	// GetConfigFile proxies access to the given configuration file to the underlying config store.
 	GetConfigFile(name string) ([]byte, error)
+	// GetDebugCaptureRules returns the debug capture rules of this node that haven't expired yet.
+	GetDebugCaptureRules() []*model.DebugCaptureRule
+	// GetDebugCaptures returns the requests captured on this node by the rule, or by any rule if ruleId
+	// is empty, oldest first.
+	GetDebugCaptures(ruleId string) []*model.DebugCapture
+	// GetEmbedConfig returns what a frame embedding a channel view on behalf of the OAuth app needs
+	// for the handshake with its parent application.
+	GetEmbedConfig(appId string) (*model.EmbedConfig, *model.AppError)
+	// GetEmojiListForUser returns the emoji available to the user, which are the ones available to everyone
+	// and those of the teams the user is a member of.
+	GetEmojiListForUser(userId string, page, perPage int, sort string) ([]*model.Emoji, *model.AppError)
 	// GetEmojiStaticUrl returns a relative static URL for system default emojis,
 	// and the API route for custom ones. Errors if not found or if custom and deleted.
 	GetEmojiStaticUrl(emojiName string) (string, *model.AppError)
 	// GetEnvironmentConfig returns a map of configuration keys whose values have been overridden by an environment variable.
 	GetEnvironmentConfig() map[string]interface{}
+	// GetErrorCatalog returns the errors the server may return, with their messages translated in the
+	// locale of the session. Errors without a translation are listed without a message.
+	GetErrorCatalog() []*model.ErrorCatalogEntry
 	// GetFilteredUsersStats is used to get a count of users based on the set of filters supported by UserCountOptions.
 	GetFilteredUsersStats(options *model.UserCountOptions) (*model.UsersStats, *model.AppError)
 	// GetGroupsByTeam returns the paged list and the total count of group associated to the given team.
 	GetGroupsByTeam(teamId string, opts model.GroupSearchOpts) ([]*model.GroupWithSchemeAdmin, int, *model.AppError)
+	// GetIntegrationsUsage returns the daily activity of the integrations over the given number of
+	// days, today included, along with the activity of every existing integration over that period.
+	// The integrations that existed for the whole period without being used are flagged as dormant.
+	GetIntegrationsUsage(days int) (*model.IntegrationsUsageReport, *model.AppError)
+	// GetJobArtifact opens the file produced by the job. The caller must close it.
+	GetJobArtifact(job *model.Job) (filesstore.ReadCloseSeeker, *model.AppError)
 	// GetKnownUsers returns the list of user ids of users with any direct
 	// relationship with a user. That means any user sharing any channel, including
 	// direct and group channels.
@@ -172,6 +285,10 @@ type AppIface interface {
 	// GetMarketplacePlugins returns a list of plugins from the marketplace-server,
 	// and plugins that are installed locally.
 	GetMarketplacePlugins(filter *model.MarketplacePluginFilter) ([]*model.MarketplacePlugin, *model.AppError)
+	// GetOrgChart returns the reporting chain and the direct reports of the user.
+	GetOrgChart(userId string) (*model.OrgChart, *model.AppError)
+	// GetPendingTermsOfServicePolicyVersions returns the policy versions the user still has to accept.
+	GetPendingTermsOfServicePolicyVersions(userId string) ([]*model.TermsOfServicePolicyVersion, *model.AppError)
 	// GetPluginPublicKeyFiles returns all public keys listed in the config.
 	GetPluginPublicKeyFiles() ([]string, *model.AppError)
 	// GetPluginStatus returns the status for a plugin installed on this server.
@@ -184,29 +301,82 @@ type AppIface interface {
 	// To get the plugins environment when the plugins are disabled, manually acquire the plugins
 	// lock instead.
 	GetPluginsEnvironment() *plugin.Environment
+	// GetPostsDelta returns the changes to the posts of the channel, either since the given time or past
+	// the token handed out by a previous call. The delta holds the token of the next call.
+	GetPostsDelta(channelId string, since int64, token string) (*model.PostsDelta, *model.AppError)
 	// GetPublicKey will return the actual public key saved in the `name` file.
 	GetPublicKey(name string) ([]byte, *model.AppError)
+	// GetRetentionPolicyForTeam returns the policy governing the team, failing with a 404 when the team
+	// follows the global data retention settings.
+	GetRetentionPolicyForTeam(teamId string) (*model.RetentionPolicy, *model.AppError)
 	// GetSanitizedConfig gets the configuration for a system admin without any secrets.
 	GetSanitizedConfig() *model.Config
+	// GetSchemaMigrationStatus reports the progress of the schema migration run by whichever node of
+	// the cluster holds the migration lock, or the outcome of the last one.
+	GetSchemaMigrationStatus() (*model.SchemaMigrationStatus, *model.AppError)
 	// GetSchemeRolesForChannel Checks if a channel or its team has an override scheme for channel roles and returns the scheme roles or default channel roles.
 	GetSchemeRolesForChannel(channelId string) (guestRoleName string, userRoleName string, adminRoleName string, err *model.AppError)
+	// GetSeatUsageForecast returns the licensed seats used over the given number of days, today
+	// included, and the day the seat count of the license is projected to be exceeded.
+	GetSeatUsageForecast(days int) (*model.SeatUsageForecast, *model.AppError)
 	// GetSessionLengthInMillis returns the session length, in milliseconds,
 	// based on the type of session (Mobile, SSO, Web/LDAP).
 	GetSessionLengthInMillis(session *model.Session) int64
 	// GetSuggestions returns suggestions for user input.
 	GetSuggestions(commandArgs *model.CommandArgs, commands []*model.Command, roleID string) []model.AutocompleteSuggestion
+	// GetTeamDirectory returns a page of the open teams matching the term and category, ranked by
+	// activity and size.
+	GetTeamDirectory(term, category string, page, perPage int) (*model.TeamDirectory, *model.AppError)
 	// GetTeamGroupUsers returns the users who are associated to the team via GroupTeams and GroupMembers.
 	GetTeamGroupUsers(teamID string) ([]*model.User, *model.AppError)
+	// GetTeamNotifyDefaults returns the notification preferences given to the members of the team who
+	// kept them to the default.
+	GetTeamNotifyDefaults(teamId string) (model.StringMap, *model.AppError)
 	// GetTeamSchemeChannelRoles Checks if a team has an override scheme and returns the scheme channel role names or default channel role names.
 	GetTeamSchemeChannelRoles(teamId string) (guestRoleName string, userRoleName string, adminRoleName string, err *model.AppError)
+	// GetTeamsActiveMemberCounts returns the number of active members of each of the given teams.
+	GetTeamsActiveMemberCounts(teamIds []string) (map[string]int64, *model.AppError)
+	// GetTeamsForRetentionPolicy returns a page of the teams governed by the policy.
+	GetTeamsForRetentionPolicy(policyId string, page, perPage int) ([]*model.Team, *model.AppError)
+	// GetTeamsUnreadForUser returns the unread totals of the user for each of their teams, along with
+	// the totals of their sidebar categories on each team.
+	GetTeamsUnreadForUser(excludeTeamId string, userId string) ([]*model.TeamUnread, *model.AppError)
+	// GetTermsOfServicePolicyOutstandingUsers returns the targeted users who haven't accepted the latest
+	// version of a policy yet.
+	GetTermsOfServicePolicyOutstandingUsers(policyId string, page, perPage int) ([]*model.User, *model.AppError)
+	// GetTermsOfServicePolicyReport summarizes how many of the users targeted by a policy have accepted
+	// its latest version.
+	GetTermsOfServicePolicyReport(policyId string) (*model.TermsOfServicePolicyReport, *model.AppError)
 	// GetTotalUsersStats is used for the DM list total
 	GetTotalUsersStats(viewRestrictions *model.ViewUsersRestrictions) (*model.UsersStats, *model.AppError)
+	// GetUnverifiedUsers returns a page of the users signed up by email who haven't verified their
+	// email address, along with their deadline to do so.
+	GetUnverifiedUsers(page, perPage int) ([]*model.UnverifiedUser, *model.AppError)
+	// GetUserPropertyValues returns the values of the custom profile fields of the user, keyed by
+	// field id.
+	GetUserPropertyValues(userId string) (map[string]string, *model.AppError)
+	// GetUserRelationships returns the users the user muted or blocked, as the given relationship type
+	// asks, or both when it is empty.
+	GetUserRelationships(userId string, relationshipType string) ([]*model.UserRelationship, *model.AppError)
+	// HasPendingTermsOfServicePolicies reports whether the user has to accept a policy version before
+	// using the API. Users without pending versions are cached, so that enforcement doesn't query the
+	// database on every request.
+	HasPendingTermsOfServicePolicies(userId string) (bool, *model.AppError)
 	// HubRegister registers a connection to a hub.
 	HubRegister(webConn *WebConn)
 	// HubStart starts all the hubs.
 	HubStart()
 	// HubUnregister unregisters a connection from a hub.
 	HubUnregister(webConn *WebConn)
+	// ImpersonateUser creates a session acting as the given user on behalf of the user of the
+	// impersonator session. The session is read-only unless writeAccess is set, expires after
+	// ServiceSettings.ImpersonationSessionLengthInMinutes and is never extended. The impersonated user
+	// is notified by email.
+	ImpersonateUser(impersonator *model.Session, userId string, writeAccess bool) (*model.Session, *model.AppError)
+	// ImportConvertedChatExport runs the bulk import of the data converted into outputDir. When a
+	// previous import failed, it resumes from the line that failed. A failing import records the line
+	// it failed at, so that it can be resumed once the cause is fixed.
+	ImportConvertedChatExport(outputDir string, dryRun bool, workers int) (*model.AppError, int)
 	// InstallMarketplacePlugin installs a plugin listed in the marketplace server. It will get the plugin bundle
 	// from the prepackaged folder, if available, or remotely if EnableRemoteMarketplace is true.
 	InstallMarketplacePlugin(request *model.InstallMarketplacePluginRequest) (*model.Manifest, *model.AppError)
@@ -222,6 +392,8 @@ type AppIface interface {
 	LogAuditRec(rec *audit.Record, err error)
 	// LogAuditRecWithLevel logs an audit record using specified Level.
 	LogAuditRecWithLevel(rec *audit.Record, level audit.Level, err error)
+	// LogStoreAuditRec logs an audit record of a store operation using StoreLevel.
+	LogStoreAuditRec(rec *audit.Record, err error)
 	// MakeAuditRecord creates a audit record pre-populated with defaults.
 	MakeAuditRecord(event string, initialStatus string) *audit.Record
 	// MarkChanelAsUnreadFromPost will take a post and set the channel as unread from that one.
@@ -229,10 +401,17 @@ type AppIface interface {
 	// MoveChannel method is prone to data races if someone joins to channel during the move process. However this
 	// function is only exposed to sysadmins and the possibility of this edge case is realtively small.
 	MoveChannel(team *model.Team, channel *model.Channel, user *model.User) *model.AppError
+	// NewSessionCookie returns a cookie expiring after maxAge seconds, with the domain, path, Secure
+	// and SameSite attributes configured for the session cookies.
+	NewSessionCookie(r *http.Request, name, value string, maxAge int) *http.Cookie
 	// NewWebConn returns a new WebConn instance.
 	NewWebConn(ws *websocket.Conn, session model.Session, t goi18n.TranslateFunc, locale string) *WebConn
 	// NewWebHub creates a new Hub.
 	NewWebHub() *Hub
+	// NotifySeatUsageForecast emails the system admins when the seat count of the license is exceeded,
+	// or projected to be within ServiceSettings.SeatUsageNotificationDays. Admins are notified at most
+	// once a week.
+	NotifySeatUsageForecast() *model.AppError
 	// NotifySessionsExpired is called periodically from the job server to notify any mobile sessions that have expired.
 	NotifySessionsExpired() *model.AppError
 	// OverrideIconURLIfEmoji changes the post icon override URL prop, if it has an emoji icon,
@@ -242,6 +421,9 @@ type AppIface interface {
 	PatchBot(botUserId string, botPatch *model.BotPatch) (*model.Bot, *model.AppError)
 	// PatchChannelModerationsForChannel Updates a channels scheme roles based on a given ChannelModerationPatch, if the permissions match the higher scoped role the scheme is deleted.
 	PatchChannelModerationsForChannel(channel *model.Channel, channelModerationsPatch []*model.ChannelModerationPatch) ([]*model.ChannelModeration, *model.AppError)
+	// PatchUserPropertyValues sets the given values, keyed by field id, for the user. Empty values
+	// clear the field. Fields synced from the directory can only be set by the sync itself.
+	PatchUserPropertyValues(userId string, patch map[string]string, fromSync bool) (map[string]string, *model.AppError)
 	// Perform an HTTP POST request to an integration's action endpoint.
 	// Caller must consume and close returned http.Response as necessary.
 	// For internal requests, requests are routed directly to a plugin ServerHTTP hook
@@ -251,24 +433,95 @@ type AppIface interface {
 	// PromoteGuestToUser Convert user's roles and all his mermbership's roles from
 	// guest roles to regular user roles.
 	PromoteGuestToUser(user *model.User, requestorId string) *model.AppError
+	// PublishTermsOfServicePolicyVersion publishes a new version of a policy, which every targeted user
+	// then has to accept before they can keep using the API.
+	PublishTermsOfServicePolicyVersion(policyId, text, userId string) (*model.TermsOfServicePolicyVersion, *model.AppError)
+	// PurgeScheduledTeams permanently deletes the soft deleted teams whose grace period has ended. It
+	// does nothing while the grace period is disabled.
+	PurgeScheduledTeams() *model.AppError
+	// RecordDebugCapture adds a captured request to the buffer.
+	RecordDebugCapture(capture *model.DebugCapture)
+	// RegenerateTeamInviteId invalidates the invite links of the team by replacing its InviteId with a
+	// new one, which expires at inviteExpiresAt unless 0.
+	RegenerateTeamInviteId(teamId string, inviteExpiresAt int64) (*model.Team, *model.AppError)
+	// RejectPendingPin removes the request without pinning the post.
+	RejectPendingPin(pendingPin *model.PendingPin) *model.AppError
+	// RemoveExpiredRoleGrants takes away the system, team and channel roles whose grants have expired, and
+	// lets each grantee know by email. Expired roles already stop granting permissions when they are
+	// resolved; this cleans them out of the stored roles, the sessions and the caches.
+	RemoveExpiredRoleGrants() *model.AppError
 	// RenameChannel is used to rename the channel Name and the DisplayName fields
 	RenameChannel(channel *model.Channel, newChannelName string, newDisplayName string) (*model.Channel, *model.AppError)
 	// RenameTeam is used to rename the team Name and the DisplayName fields
 	RenameTeam(team *model.Team, newTeamName string, newDisplayName string) (*model.Team, *model.AppError)
+	// RequestPinPost records the request of the user to pin the post, to be approved or rejected by
+	// the channel admins, and lets the channel know about it.
+	RequestPinPost(post *model.Post, userId string) (*model.PendingPin, *model.AppError)
+	// ResolveChannelByName returns the channel of the team currently named channelName or, failing that,
+	// the channel of the team that was named channelName before being renamed.
+	ResolveChannelByName(channelName, teamId string, includeDeleted bool) (*model.Channel, *model.AppError)
+	// ResolveChannelByNameForTeamName is ResolveChannelByName for a team given by its current or previous
+	// name.
+	ResolveChannelByNameForTeamName(channelName, teamName string, includeDeleted bool) (*model.Channel, *model.AppError)
+	// ResolveTeamByName returns the team currently named name or, failing that, the team that was named
+	// name before being renamed.
+	ResolveTeamByName(name string) (*model.Team, *model.AppError)
+	// ResolveUserNotifyProps returns the notification preferences applying to the user in the team,
+	// the preferences the user kept to the default following the defaults of the team, then the system
+	// defaults. An empty teamId resolves against the system defaults only.
+	ResolveUserNotifyProps(user *model.User, teamId string) (model.StringMap, *model.AppError)
+	// RestoreBackup verifies the backup at path and imports it. It only validates the data, as a dry
+	// run of the bulk import, unless apply is set. Once applied, it checks that every team, channel and
+	// user of the backup exists. It returns the line of the data the import failed on, if any.
+	RestoreBackup(path string, passphrase string, apply bool, workers int) (*backup.Manifest, *model.AppError, int)
+	// RestoreChannelSnapshot verifies the channel snapshot at path and restores it into a new channel
+	// of the team with the given name. Members that no longer belong to the team are skipped. Posts and
+	// files are restored with new ids, keeping their authors and creation times.
+	RestoreChannelSnapshot(path string, passphrase string, teamId string, name string) (*model.Channel, *model.AppError)
+	// ResumeBulkImport runs a bulk import skipping the data lines before startLine, which a previous
+	// import that failed at startLine already imported.
+	ResumeBulkImport(fileReader io.Reader, dryRun bool, workers int, startLine int) (*model.AppError, int)
 	// RevokeSessionsFromAllUsers will go through all the sessions active
 	// in the server and revoke them
 	RevokeSessionsFromAllUsers() *model.AppError
+	// RotateSessionsCSRF replaces the CSRF token of every session of the user, so that a token leaked
+	// before a change to the privileges of the user can't be used afterwards. Clients pick up the new
+	// token from the CSRF cookie, which is attached again on their next request. The previous token is
+	// still accepted for a short grace period so that requests already in flight don't fail.
+	RotateSessionsCSRF(userId string)
+	// RunFileWillBeDownloadedHooks gives plugins a chance to reject the download of a file by the given user, or to
+	// replace the content that is served, for instance with a watermarked copy. It returns the content to serve and its size.
+	RunFileWillBeDownloadedHooks(info *model.FileInfo, userId string, fileReader io.ReadSeeker) (io.ReadSeeker, int64, *model.AppError)
+	// RunScheduledBackup takes the backup of a backup job into BackupSettings.Directory. It is
+	// incremental from the previous successful backup unless a full backup is due. It returns the job
+	// data to record.
+	RunScheduledBackup(lastSuccessfulJob *model.Job) (map[string]string, *model.AppError)
+	// SanitizePostListMetadataForUser applies SanitizePostMetadataForUser to every post in the list.
+	SanitizePostListMetadataForUser(originalList *model.PostList, userId string) *model.PostList
+	// SanitizePostMetadataForUser removes the content of any permalink previews embedded in the given post that the
+	// user doesn't have permission to read. The given post is not modified.
+	SanitizePostMetadataForUser(post *model.Post, userId string) *model.Post
 	// SaveConfig replaces the active configuration, optionally notifying cluster peers.
 	SaveConfig(newCfg *model.Config, sendConfigChangeClusterMessage bool) *model.AppError
+	// SaveJobArtifact writes the file produced by the in progress job to the file store and records it
+	// in the job data, so that it can be downloaded once the job is done.
+	SaveJobArtifact(job *model.Job, name string, data io.Reader) *model.AppError
 	// SearchAllChannels returns a list of channels, the total count of the results of the search (if the paginate search option is true), and an error.
 	SearchAllChannels(term string, opts model.ChannelSearchOpts) (*model.ChannelListWithTeamData, int64, *model.AppError)
 	// SearchAllTeams returns a team list and the total count of the results
 	SearchAllTeams(searchOpts *model.TeamSearch) ([]*model.Team, int64, *model.AppError)
+	// SearchEmoji searches the emoji available to the user.
+	SearchEmoji(userId string, name string, prefixOnly bool, limit int) ([]*model.Emoji, *model.AppError)
+	// SearchPrivateTeamsForUser searches the private teams the user is a member of.
+	SearchPrivateTeamsForUser(userId string, searchOpts *model.TeamSearch) ([]*model.Team, *model.AppError)
 	// ServePluginPublicRequest serves public plugin files
 	// at the URL http(s)://$SITE_URL/plugins/$PLUGIN_ID/public/{anything}
 	ServePluginPublicRequest(w http.ResponseWriter, r *http.Request)
 	// ServerBusyStateChanged is called when a CLUSTER_EVENT_BUSY_STATE_CHANGED is received.
 	ServerBusyStateChanged(sbs *model.ServerBusyState)
+	// SessionCheckPermissions answers a batch of permission checks for the session, giving clients the
+	// server's view of their permissions instead of having them parse roles themselves.
+	SessionCheckPermissions(session model.Session, checks []*model.PermissionCheck) ([]*model.PermissionCheckResult, *model.AppError)
 	// SessionHasPermissionToManageBot returns nil if the session has access to manage the given bot.
 	// This function deviates from other authorization checks in returning an error instead of just
 	// a boolean, allowing the permission failure to be exposed with more granularity.
@@ -279,10 +532,32 @@ type AppIface interface {
 	SetBotIconImage(botUserId string, file io.ReadSeeker) *model.AppError
 	// SetBotIconImageFromMultiPartFile sets LHS icon for a bot.
 	SetBotIconImageFromMultiPartFile(botUserId string, imageData *multipart.FileHeader) *model.AppError
+	// SetFileInfoSensitive marks or unmarks a file as sensitive. Every download of a sensitive file is audited.
+	SetFileInfoSensitive(info *model.FileInfo, sensitive bool) (*model.FileInfo, *model.AppError)
+	// SetRetentionPolicyForTeam makes the policy govern the team, in place of the policy that governed
+	// it before. An empty policyId leaves the team to the global data retention settings.
+	SetRetentionPolicyForTeam(teamId, policyId string) *model.AppError
 	// SetStatusLastActivityAt sets the last activity at for a user on the local app server and updates
 	// status to away if needed. Used by the WS to set status to away if an 'online' device disconnects
 	// while an 'away' device is still connected
 	SetStatusLastActivityAt(userId string, activityAt int64)
+	// SetTeamDirectoryCategories replaces the categories the team is listed under in the team directory.
+	SetTeamDirectoryCategories(teamId string, categories []string) (*model.Team, *model.AppError)
+	// SetTeamNotifyDefaults replaces the notification preferences given to the members of the team who
+	// kept them to the default. An empty map makes them follow the system defaults again.
+	SetTeamNotifyDefaults(teamId string, defaults model.StringMap) (*model.Team, *model.AppError)
+	// SetUserManager changes the manager of the user, or removes it when managerId is empty. A user
+	// can't end up reporting to themselves through the chain of managers.
+	SetUserManager(userId, managerId string) (*model.User, *model.AppError)
+	// SnapshotChannel writes the current state of a channel, its members and undeleted posts along with
+	// the files attached to them, to w as a backup archive that RestoreChannelSnapshot can restore into a
+	// new channel.
+	SnapshotChannel(channelId string, w io.Writer, opts ChannelSnapshotOptions) (*backup.Manifest, *model.AppError)
+	// StartStatusAutomation sets the status of the user to do not disturb until endAt on behalf of
+	// source, typically a calendar connector reporting a meeting. The status of the user before the
+	// automation is restored once it ends, unless the user changed their status manually meanwhile.
+	// Starting an automation for a user whose status is already automated extends it.
+	StartStatusAutomation(userId, source string, endAt int64) (*model.StatusAutomation, *model.AppError)
 	// SyncPlugins synchronizes the plugins installed locally
 	// with the plugin bundles available in the file store.
 	SyncPlugins() *model.AppError
@@ -293,6 +568,12 @@ type AppIface interface {
 	// the member's group memberships and the configuration of those groups to the syncable. This method should only
 	// be invoked on group-synced (aka group-constrained) syncables.
 	SyncSyncableRoles(syncableID string, syncableType model.GroupSyncableType) *model.AppError
+	// SyncUserManagerFromLdap sets the manager of the user to the one named by the configured LDAP
+	// attribute. The attribute holds the value of the IdAttribute of the manager.
+	SyncUserManagerFromLdap(user *model.User) *model.AppError
+	// SyncUserPropertiesFromLdap copies the directory attributes mapped to custom profile fields into
+	// the profile of the user. Values that don't fit their field are left out.
+	SyncUserPropertiesFromLdap(user *model.User) *model.AppError
 	// TeamMembersMinusGroupMembers returns the set of users on the given team minus the set of users in the given
 	// groups.
 	//
@@ -303,14 +584,31 @@ type AppIface interface {
 	DoAdvancedPermissionsMigration()
 	// This to be used for places we check the users password when they are already logged in
 	DoubleCheckPassword(user *model.User, password string) *model.AppError
+	// UnacknowledgePost removes the acknowledgement of the post by the user.
+	UnacknowledgePost(post *model.Post, userId string) *model.AppError
 	// UpdateBotActive marks a bot as active or inactive, along with its corresponding user.
 	UpdateBotActive(botUserId string, active bool) (*model.Bot, *model.AppError)
 	// UpdateBotOwner changes a bot's owner to the given value.
 	UpdateBotOwner(botUserId, newOwnerId string) (*model.Bot, *model.AppError)
 	// UpdateChannel updates a given channel by its Id. It also publishes the CHANNEL_UPDATED event.
 	UpdateChannel(channel *model.Channel) (*model.Channel, *model.AppError)
+	// UpdateChannelMemberRolesWithExpiry updates the roles of the channel member, granting the explicit
+	// roles until expiresAt, or permanently when expiresAt is 0.
+	UpdateChannelMemberRolesWithExpiry(channelId string, userId string, newRoles string, expiresAt int64) (*model.ChannelMember, *model.AppError)
 	// UpdateChannelScheme saves the new SchemeId of the channel passed.
 	UpdateChannelScheme(channel *model.Channel) (*model.Channel, *model.AppError)
+	// UpdateTeamMemberRolesWithExpiry updates the roles of the team member, granting the explicit roles
+	// until expiresAt, or permanently when expiresAt is 0.
+	UpdateTeamMemberRolesWithExpiry(teamId string, userId string, newRoles string, expiresAt int64) (*model.TeamMember, *model.AppError)
+	// UpdateTermsOfServicePolicy changes the name and targets of a policy. Users who become targeted
+	// have to accept the latest version of the policy, if it has one.
+	UpdateTermsOfServicePolicy(policy *model.TermsOfServicePolicy) (*model.TermsOfServicePolicy, *model.AppError)
+	// UpdateUserPropertyField changes the definition of a field. The type of a field can't change since
+	// the values users already have wouldn't match it anymore.
+	UpdateUserPropertyField(field *model.UserPropertyField) (*model.UserPropertyField, *model.AppError)
+	// UpdateUserRolesWithExpiry updates the system roles of the user. The roles other than system_user
+	// and system_guest are granted until expiresAt, or permanently when expiresAt is 0.
+	UpdateUserRolesWithExpiry(userId string, newRoles string, expiresAt int64, sendWebSocketEvent bool) (*model.User, *model.AppError)
 	// UpdateWebConnUserActivity sets the LastUserActivityAt of the hub for the given session.
 	UpdateWebConnUserActivity(session model.Session, activityAt int64)
 	// UploadFile uploads a single file in form of a completely constructed byte array for a channel.
@@ -325,9 +623,15 @@ type AppIface interface {
 	// the same length. clientIds should either not be provided or have the same length as files and filenames.
 	// The provided files should be closed by the caller so that they are not leaked.
 	UploadFiles(teamId string, channelId string, userId string, files []io.ReadCloser, filenames []string, clientIds []string, now time.Time) (*model.FileUploadResponse, *model.AppError)
+	// UserBelongsToTeam returns whether the user is a member of the team, without loading the member.
+	UserBelongsToTeam(userId, teamId string) (bool, *model.AppError)
 	// UserIsInAdminRoleGroup returns true at least one of the user's groups are configured to set the members as
 	// admins in the given syncable.
 	UserIsInAdminRoleGroup(userID, syncableID string, syncableType model.GroupSyncableType) (bool, *model.AppError)
+	// VerifyChannelIntegrity walks the hash chain of the channel, checking that each entry is
+	// correctly chained to the previous one, and that the latest content recorded for each post
+	// still matches the post stored in the database.
+	VerifyChannelIntegrity(channelId string) (*model.PostIntegrityReport, *model.AppError)
 	// VerifyPlugin checks that the given signature corresponds to the given plugin and matches a trusted certificate.
 	VerifyPlugin(plugin, signature io.ReadSeeker) *model.AppError
 	//GetUserStatusesByIds used by apiV4
@@ -396,9 +700,10 @@ type AppIface interface {
 	CompleteSwitchWithOAuth(service string, userData io.Reader, email string) (*model.User, *model.AppError)
 	Compliance() einterfaces.ComplianceInterface
 	Config() *model.Config
-	Context() context.Context
 	CopyFileInfos(userId string, fileIds []string) ([]string, *model.AppError)
 	CreateChannel(channel *model.Channel, addMember bool) (*model.Channel, *model.AppError)
+	CreateChannelBookmark(bookmark *model.ChannelBookmark, userId string) (*model.ChannelBookmark, *model.AppError)
+	CreateChannelGuestLink(link *model.ChannelGuestLink, creatorId string) (*model.ChannelGuestLink, *model.AppError)
 	CreateChannelWithUser(channel *model.Channel, userId string) (*model.Channel, *model.AppError)
 	CreateCommand(cmd *model.Command) (*model.Command, *model.AppError)
 	CreateCommandWebhook(commandId string, args *model.CommandArgs) (*model.CommandWebhook, *model.AppError)
@@ -415,16 +720,21 @@ type AppIface interface {
 	CreatePost(post *model.Post, channel *model.Channel, triggerWebhooks, setOnline bool) (savedPost *model.Post, err *model.AppError)
 	CreatePostAsUser(post *model.Post, currentSessionId string, setOnline bool) (*model.Post, *model.AppError)
 	CreatePostMissingChannel(post *model.Post, triggerWebhooks bool) (*model.Post, *model.AppError)
+	CreatePresenceWebhook(hook *model.PresenceWebhook, creatorId string) (*model.PresenceWebhook, *model.AppError)
+	CreateRetentionPolicy(policy *model.RetentionPolicy) (*model.RetentionPolicy, *model.AppError)
 	CreateRole(role *model.Role) (*model.Role, *model.AppError)
 	CreateScheme(scheme *model.Scheme) (*model.Scheme, *model.AppError)
 	CreateSession(session *model.Session) (*model.Session, *model.AppError)
 	CreateSidebarCategory(userId, teamId string, newCategory *model.SidebarCategoryWithChannels) (*model.SidebarCategoryWithChannels, *model.AppError)
 	CreateTeam(team *model.Team) (*model.Team, *model.AppError)
+	CreateTeamInviteLink(link *model.TeamInviteLink, creatorId string) (*model.TeamInviteLink, *model.AppError)
 	CreateTeamWithUser(team *model.Team, userId string) (*model.Team, *model.AppError)
 	CreateTermsOfService(text, userId string) (*model.TermsOfService, *model.AppError)
+	CreateTermsOfServicePolicy(policy *model.TermsOfServicePolicy) (*model.TermsOfServicePolicy, *model.AppError)
 	CreateUserAccessToken(token *model.UserAccessToken) (*model.UserAccessToken, *model.AppError)
 	CreateUserAsAdmin(user *model.User) (*model.User, *model.AppError)
 	CreateUserFromSignup(user *model.User) (*model.User, *model.AppError)
+	CreateUserPropertyField(field *model.UserPropertyField) (*model.UserPropertyField, *model.AppError)
 	CreateUserWithInviteId(user *model.User, inviteId string) (*model.User, *model.AppError)
 	CreateUserWithToken(user *model.User, token *model.Token) (*model.User, *model.AppError)
 	CreateWebhookPost(userId string, channel *model.Channel, text, overrideUsername, overrideIconUrl, overrideIconEmoji string, props model.StringInterface, postType string, postRootId string) (*model.Post, *model.AppError)
@@ -436,6 +746,7 @@ type AppIface interface {
 	DeleteAllKeysForPlugin(pluginId string) *model.AppError
 	DeleteBrandImage() *model.AppError
 	DeleteChannel(channel *model.Channel, userId string) *model.AppError
+	DeleteChannelBookmark(bookmark *model.ChannelBookmark) (*model.ChannelBookmark, *model.AppError)
 	DeleteCommand(commandId string) *model.AppError
 	DeleteEmoji(emoji *model.Emoji) *model.AppError
 	DeleteEphemeralPost(userId, postId string)
@@ -450,10 +761,13 @@ type AppIface interface {
 	DeletePost(postId, deleteByID string) (*model.Post, *model.AppError)
 	DeletePostFiles(post *model.Post)
 	DeletePreferences(userId string, preferences model.Preferences) *model.AppError
+	DeletePresenceWebhook(hookId string) *model.AppError
 	DeleteReactionForPost(reaction *model.Reaction) *model.AppError
+	DeleteRetentionPolicy(policyId string) *model.AppError
 	DeleteScheme(schemeId string) (*model.Scheme, *model.AppError)
 	DeleteSidebarCategory(userId, teamId, categoryId string) *model.AppError
 	DeleteToken(token *model.Token) *model.AppError
+	DeleteUserPropertyField(fieldId string) *model.AppError
 	DiagnosticId() string
 	DisableAutoResponder(userId string, asAdmin bool) *model.AppError
 	DisableUserAccessToken(token *model.UserAccessToken) *model.AppError
@@ -464,6 +778,7 @@ type AppIface interface {
 	DoLogin(w http.ResponseWriter, r *http.Request, user *model.User, deviceId string, isMobile, isOAuth, isSaml bool) *model.AppError
 	DoPostAction(postId, actionId, userId, selectedOption string) (string, *model.AppError)
 	DoPostActionWithCookie(postId, actionId, userId, selectedOption string, cookie *model.PostActionCookie) (string, *model.AppError)
+	DoSystemConsoleRolesCreationMigration()
 	DoUploadFile(now time.Time, rawTeamId string, rawChannelId string, rawUserId string, rawFilename string, data []byte) (*model.FileInfo, *model.AppError)
 	DoUploadFileExpectModification(now time.Time, rawTeamId string, rawChannelId string, rawUserId string, rawFilename string, data []byte) (*model.FileInfo, []byte, *model.AppError)
 	DownloadFromURL(downloadURL string) ([]byte, error)
@@ -482,6 +797,7 @@ type AppIface interface {
 	GetActivePluginManifests() ([]*model.Manifest, *model.AppError)
 	GetAllChannels(page, perPage int, opts model.ChannelSearchOpts) (*model.ChannelListWithTeamData, *model.AppError)
 	GetAllChannelsCount(opts model.ChannelSearchOpts) (int64, *model.AppError)
+	GetAllDeletedTeamsPage(offset int, limit int) ([]*model.Team, *model.AppError)
 	GetAllPrivateTeams() ([]*model.Team, *model.AppError)
 	GetAllPrivateTeamsPage(offset int, limit int) ([]*model.Team, *model.AppError)
 	GetAllPrivateTeamsPageWithCount(offset int, limit int) (*model.TeamsWithCount, *model.AppError)
@@ -493,7 +809,6 @@ type AppIface interface {
 	GetAllTeams() ([]*model.Team, *model.AppError)
 	GetAllTeamsPage(offset int, limit int) ([]*model.Team, *model.AppError)
 	GetAllTeamsPageWithCount(offset int, limit int) (*model.TeamsWithCount, *model.AppError)
-	GetAnalytics(name string, teamId string) (model.AnalyticsRows, *model.AppError)
 	GetAudits(userId string, limit int) (model.Audits, *model.AppError)
 	GetAuditsPage(userId string, page int, perPage int) (model.Audits, *model.AppError)
 	GetAuthorizationCode(w http.ResponseWriter, r *http.Request, service string, props map[string]string, loginHint string) (string, *model.AppError)
@@ -501,10 +816,15 @@ type AppIface interface {
 	GetBrandImage() ([]byte, *model.AppError)
 	GetBulkReactionsForPosts(postIds []string) (map[string][]*model.Reaction, *model.AppError)
 	GetChannel(channelId string) (*model.Channel, *model.AppError)
+	GetChannelBookmark(bookmarkId string, includeDeleted bool) (*model.ChannelBookmark, *model.AppError)
+	GetChannelBookmarks(channelId string) ([]*model.ChannelBookmark, *model.AppError)
 	GetChannelByName(channelName, teamId string, includeDeleted bool) (*model.Channel, *model.AppError)
 	GetChannelByNameForTeamName(channelName, teamName string, includeDeleted bool) (*model.Channel, *model.AppError)
 	GetChannelCounts(teamId string, userId string) (*model.ChannelCounts, *model.AppError)
 	GetChannelGuestCount(channelId string) (int64, *model.AppError)
+	GetChannelGuestLink(linkId string) (*model.ChannelGuestLink, *model.AppError)
+	GetChannelGuestLinks(channelId string) ([]*model.ChannelGuestLink, *model.AppError)
+	GetChannelIntegrations(channelId string) ([]*model.ChannelIntegration, *model.AppError)
 	GetChannelMember(channelId string, userId string) (*model.ChannelMember, *model.AppError)
 	GetChannelMemberCount(channelId string) (int64, *model.AppError)
 	GetChannelMembersByIds(channelId string, userIds []string) (*model.ChannelMembers, *model.AppError)
@@ -529,6 +849,7 @@ type AppIface interface {
 	GetDataRetentionPolicy() (*model.DataRetentionPolicy, *model.AppError)
 	GetDefaultProfileImage(user *model.User) ([]byte, *model.AppError)
 	GetDeletedChannels(teamId string, offset int, limit int, userId string) (*model.ChannelList, *model.AppError)
+	GetDirectReports(managerId string, page, perPage int) ([]*model.User, *model.AppError)
 	GetEmoji(emojiId string) (*model.Emoji, *model.AppError)
 	GetEmojiByName(emojiName string) (*model.Emoji, *model.AppError)
 	GetEmojiImage(emojiId string) ([]byte, string, *model.AppError)
@@ -568,6 +889,8 @@ type AppIface interface {
 	GetJobsByTypePage(jobType string, page int, perPage int) ([]*model.Job, *model.AppError)
 	GetJobsPage(page int, perPage int) ([]*model.Job, *model.AppError)
 	GetLatestTermsOfService() (*model.TermsOfService, *model.AppError)
+	GetLatestTermsOfServicePolicyVersion(policyId string) (*model.TermsOfServicePolicyVersion, *model.AppError)
+	GetLoginHistory(options *model.LoginHistoryGetOptions) ([]*model.LoginHistory, *model.AppError)
 	GetLogs(page, perPage int) ([]string, *model.AppError)
 	GetLogsSkipSend(page, perPage int) ([]string, *model.AppError)
 	GetMessageForNotification(post *model.Post, translateFunc i18n.TranslateFunc) string
@@ -595,10 +918,13 @@ type AppIface interface {
 	GetOutgoingWebhooksPage(page, perPage int) ([]*model.OutgoingWebhook, *model.AppError)
 	GetOutgoingWebhooksPageByUser(userId string, page, perPage int) ([]*model.OutgoingWebhook, *model.AppError)
 	GetPasswordRecoveryToken(token string) (*model.Token, *model.AppError)
+	GetPendingPin(postId string) (*model.PendingPin, *model.AppError)
+	GetPendingPinsForChannel(channelId string) ([]*model.PendingPin, *model.AppError)
 	GetPermalinkPost(postId string, userId string) (*model.PostList, *model.AppError)
 	GetPinnedPosts(channelId string) (*model.PostList, *model.AppError)
 	GetPluginKey(pluginId string, key string) ([]byte, *model.AppError)
 	GetPlugins() (*model.PluginsResponse, *model.AppError)
+	GetPostAcknowledgements(postId string) ([]*model.PostAcknowledgement, *model.AppError)
 	GetPostAfterTime(channelId string, time int64) (*model.Post, *model.AppError)
 	GetPostIdAfterTime(channelId string, time int64) (string, *model.AppError)
 	GetPostIdBeforeTime(channelId string, time int64) (string, *model.AppError)
@@ -614,6 +940,8 @@ type AppIface interface {
 	GetPreferenceByCategoryAndNameForUser(userId string, category string, preferenceName string) (*model.Preference, *model.AppError)
 	GetPreferenceByCategoryForUser(userId string, category string) (model.Preferences, *model.AppError)
 	GetPreferencesForUser(userId string) (model.Preferences, *model.AppError)
+	GetPresenceWebhook(hookId string) (*model.PresenceWebhook, *model.AppError)
+	GetPresenceWebhooksPage(page, perPage int) ([]*model.PresenceWebhook, *model.AppError)
 	GetPrevPostIdFromPostList(postList *model.PostList) string
 	GetPrivateChannelsForTeam(teamId string, offset int, limit int) (*model.ChannelList, *model.AppError)
 	GetProfileImage(user *model.User) ([]byte, bool, *model.AppError)
@@ -622,6 +950,8 @@ type AppIface interface {
 	GetReactionsForPost(postId string) ([]*model.Reaction, *model.AppError)
 	GetRecentlyActiveUsersForTeam(teamId string) (map[string]*model.User, *model.AppError)
 	GetRecentlyActiveUsersForTeamPage(teamId string, page, perPage int, asAdmin bool, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, *model.AppError)
+	GetRetentionPolicies(page, perPage int) ([]*model.RetentionPolicy, *model.AppError)
+	GetRetentionPolicy(policyId string) (*model.RetentionPolicy, *model.AppError)
 	GetRole(id string) (*model.Role, *model.AppError)
 	GetRoleByName(name string) (*model.Role, *model.AppError)
 	GetRolesByNames(names []string) ([]*model.Role, *model.AppError)
@@ -634,6 +964,7 @@ type AppIface interface {
 	GetSchemeRolesForTeam(teamId string) (string, string, string, *model.AppError)
 	GetSchemes(scope string, offset int, limit int) ([]*model.Scheme, *model.AppError)
 	GetSchemesPage(scope string, page int, perPage int) ([]*model.Scheme, *model.AppError)
+	GetServiceAccounts(page, perPage int, includeDeleted bool) ([]*model.User, *model.AppError)
 	GetSession(token string) (*model.Session, *model.AppError)
 	GetSessionById(sessionId string) (*model.Session, *model.AppError)
 	GetSessions(userId string) ([]*model.Session, *model.AppError)
@@ -643,6 +974,7 @@ type AppIface interface {
 	GetSinglePost(postId string) (*model.Post, *model.AppError)
 	GetSiteURL() string
 	GetStatus(userId string) (*model.Status, *model.AppError)
+	GetStatusAutomation(userId string) (*model.StatusAutomation, *model.AppError)
 	GetStatusFromCache(userId string) *model.Status
 	GetStatusesByIds(userIds []string) (map[string]interface{}, *model.AppError)
 	GetT() goi18n.TranslateFunc
@@ -651,18 +983,22 @@ type AppIface interface {
 	GetTeamByName(name string) (*model.Team, *model.AppError)
 	GetTeamIcon(team *model.Team) ([]byte, *model.AppError)
 	GetTeamIdFromQuery(query url.Values) (string, *model.AppError)
+	GetTeamInviteLink(linkId string) (*model.TeamInviteLink, *model.AppError)
+	GetTeamInviteLinks(teamId string) ([]*model.TeamInviteLink, *model.AppError)
 	GetTeamMember(teamId, userId string) (*model.TeamMember, *model.AppError)
 	GetTeamMembers(teamId string, offset int, limit int, teamMembersGetOptions *model.TeamMembersGetOptions) ([]*model.TeamMember, *model.AppError)
 	GetTeamMembersByIds(teamId string, userIds []string, restrictions *model.ViewUsersRestrictions) ([]*model.TeamMember, *model.AppError)
 	GetTeamMembersForUser(userId string) ([]*model.TeamMember, *model.AppError)
-	GetTeamMembersForUserWithPagination(userId string, page, perPage int) ([]*model.TeamMember, *model.AppError)
+	GetTeamMembersForUserWithPagination(userId string, page, perPage int, opts *model.TeamMembersForUserGetOptions) ([]*model.TeamMember, *model.AppError)
 	GetTeamStats(teamId string, restrictions *model.ViewUsersRestrictions) (*model.TeamStats, *model.AppError)
 	GetTeamUnread(teamId, userId string) (*model.TeamUnread, *model.AppError)
 	GetTeamsForScheme(scheme *model.Scheme, offset int, limit int) ([]*model.Team, *model.AppError)
 	GetTeamsForSchemePage(scheme *model.Scheme, page int, perPage int) ([]*model.Team, *model.AppError)
 	GetTeamsForUser(userId string) ([]*model.Team, *model.AppError)
-	GetTeamsUnreadForUser(excludeTeamId string, userId string) ([]*model.TeamUnread, *model.AppError)
+	GetTeamsForUserWithOptions(userId string, opts *model.TeamsForUserGetOptions) ([]*model.Team, *model.AppError)
 	GetTermsOfService(id string) (*model.TermsOfService, *model.AppError)
+	GetTermsOfServicePolicies(page, perPage int) ([]*model.TermsOfServicePolicy, *model.AppError)
+	GetTermsOfServicePolicy(policyId string) (*model.TermsOfServicePolicy, *model.AppError)
 	GetUser(userId string) (*model.User, *model.AppError)
 	GetUserAccessToken(tokenId string, sanitize bool) (*model.UserAccessToken, *model.AppError)
 	GetUserAccessTokens(page, perPage int) ([]*model.UserAccessToken, *model.AppError)
@@ -671,6 +1007,8 @@ type AppIface interface {
 	GetUserByEmail(email string) (*model.User, *model.AppError)
 	GetUserByUsername(username string) (*model.User, *model.AppError)
 	GetUserForLogin(id, loginId string) (*model.User, *model.AppError)
+	GetUserPropertyField(fieldId string) (*model.UserPropertyField, *model.AppError)
+	GetUserPropertyFields() ([]*model.UserPropertyField, *model.AppError)
 	GetUserTermsOfService(userId string) (*model.UserTermsOfService, *model.AppError)
 	GetUsers(options *model.UserGetOptions) ([]*model.User, *model.AppError)
 	GetUsersByGroupChannelIds(channelIds []string, asAdmin bool) (map[string][]*model.User, *model.AppError)
@@ -760,6 +1098,7 @@ type AppIface interface {
 	OpenInteractiveDialog(request model.OpenDialogRequest) *model.AppError
 	OriginChecker() func(*http.Request) bool
 	PatchChannel(channel *model.Channel, patch *model.ChannelPatch, userId string) (*model.Channel, *model.AppError)
+	PatchChannelBookmark(bookmark *model.ChannelBookmark, patch *model.ChannelBookmarkPatch, userId string) (*model.ChannelBookmark, *model.AppError)
 	PatchPost(postId string, patch *model.PostPatch) (*model.Post, *model.AppError)
 	PatchRole(role *model.Role, patch *model.RolePatch) (*model.Role, *model.AppError)
 	PatchScheme(scheme *model.Scheme, patch *model.SchemePatch) (*model.Scheme, *model.AppError)
@@ -794,10 +1133,10 @@ type AppIface interface {
 	RegenCommandToken(cmd *model.Command) (*model.Command, *model.AppError)
 	RegenOutgoingWebhookToken(hook *model.OutgoingWebhook) (*model.OutgoingWebhook, *model.AppError)
 	RegenerateOAuthAppSecret(app *model.OAuthApp) (*model.OAuthApp, *model.AppError)
-	RegenerateTeamInviteId(teamId string) (*model.Team, *model.AppError)
 	RegisterPluginCommand(pluginId string, command *model.Command) error
 	ReloadConfig() error
 	RemoveAllDeactivatedMembersFromChannel(channel *model.Channel) *model.AppError
+	RemoveChannelIntegration(channelId string, integrationId string) *model.AppError
 	RemoveConfigListener(id string)
 	RemoveFile(path string) *model.AppError
 	RemovePlugin(id string) *model.AppError
@@ -809,6 +1148,7 @@ type AppIface interface {
 	RemoveTeamMemberFromTeam(teamMember *model.TeamMember, requestorId string) *model.AppError
 	RemoveUserFromChannel(userIdToRemove string, removerUserId string, channel *model.Channel) *model.AppError
 	RemoveUserFromTeam(teamId string, userId string, requestorId string) *model.AppError
+	RemoveUserRelationship(userId string, otherUserId string, relationshipType string) *model.AppError
 	RemoveUsersFromChannelNotMemberOfTeam(remover *model.User, channel *model.Channel, team *model.Team) *model.AppError
 	RequestId() string
 	ResetPasswordFromToken(userSuppliedTokenString, newPassword string) *model.AppError
@@ -819,9 +1159,11 @@ type AppIface interface {
 	RestrictUsersSearchByPermissions(userId string, options *model.UserSearchOptions) (*model.UserSearchOptions, *model.AppError)
 	RevokeAccessToken(token string) *model.AppError
 	RevokeAllSessions(userId string) *model.AppError
+	RevokeChannelGuestLink(linkId string) *model.AppError
 	RevokeSession(session *model.Session) *model.AppError
 	RevokeSessionById(sessionId string) *model.AppError
 	RevokeSessionsForDeviceId(userId string, deviceId string, currentSessionId string) *model.AppError
+	RevokeTeamInviteLink(linkId string) *model.AppError
 	RevokeUserAccessToken(token *model.UserAccessToken) *model.AppError
 	RolesGrantPermission(roleNames []string, permissionId string) bool
 	Saml() einterfaces.SamlInterface
@@ -838,13 +1180,12 @@ type AppIface interface {
 	SearchChannels(teamId string, term string) (*model.ChannelList, *model.AppError)
 	SearchChannelsForUser(userId, teamId, term string) (*model.ChannelList, *model.AppError)
 	SearchChannelsUserNotIn(teamId string, userId string, term string) (*model.ChannelList, *model.AppError)
-	SearchEmoji(name string, prefixOnly bool, limit int) ([]*model.Emoji, *model.AppError)
 	SearchEngine() *searchengine.Broker
 	SearchGroupChannels(userId, term string) (*model.ChannelList, *model.AppError)
 	SearchPostsInTeam(teamId string, paramsList []*model.SearchParams) (*model.PostList, *model.AppError)
 	SearchPostsInTeamForUser(terms string, userId string, teamId string, isOrSearch bool, includeDeletedChannels bool, timeZoneOffset int, page, perPage int) (*model.PostSearchResults, *model.AppError)
-	SearchPrivateTeams(term string) ([]*model.Team, *model.AppError)
-	SearchPublicTeams(term string) ([]*model.Team, *model.AppError)
+	SearchPrivateTeams(searchOpts *model.TeamSearch) ([]*model.Team, *model.AppError)
+	SearchPublicTeams(searchOpts *model.TeamSearch) ([]*model.Team, *model.AppError)
 	SearchUserAccessTokens(term string) ([]*model.UserAccessToken, *model.AppError)
 	SearchUsers(props *model.UserSearch, options *model.UserSearchOptions) ([]*model.User, *model.AppError)
 	SearchUsersInChannel(channelId string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError)
@@ -936,6 +1277,7 @@ type AppIface interface {
 	UpdateChannelMemberNotifyProps(data map[string]string, channelId string, userId string) (*model.ChannelMember, *model.AppError)
 	UpdateChannelMemberRoles(channelId string, userId string, newRoles string) (*model.ChannelMember, *model.AppError)
 	UpdateChannelMemberSchemeRoles(channelId string, userId string, isSchemeGuest bool, isSchemeUser bool, isSchemeAdmin bool) (*model.ChannelMember, *model.AppError)
+	UpdateChannelPostingRestrictions(channel *model.Channel, restrictions *model.PostingRestrictions) (*model.Channel, *model.AppError)
 	UpdateChannelPrivacy(oldChannel *model.Channel, user *model.User) (*model.Channel, *model.AppError)
 	UpdateCommand(oldCmd, updatedCmd *model.Command) (*model.Command, *model.AppError)
 	UpdateConfig(f func(*model.Config))
@@ -955,6 +1297,8 @@ type AppIface interface {
 	UpdatePasswordSendEmail(user *model.User, newPassword, method string) *model.AppError
 	UpdatePost(post *model.Post, safeUpdate bool) (*model.Post, *model.AppError)
 	UpdatePreferences(userId string, preferences model.Preferences) *model.AppError
+	UpdatePresenceWebhook(oldHook, updatedHook *model.PresenceWebhook) (*model.PresenceWebhook, *model.AppError)
+	UpdateRetentionPolicy(policy *model.RetentionPolicy) (*model.RetentionPolicy, *model.AppError)
 	UpdateRole(role *model.Role) (*model.Role, *model.AppError)
 	UpdateScheme(scheme *model.Scheme) (*model.Scheme, *model.AppError)
 	UpdateSessionsIsGuest(userId string, isGuest bool)
@@ -963,6 +1307,7 @@ type AppIface interface {
 	UpdateTeam(team *model.Team) (*model.Team, *model.AppError)
 	UpdateTeamMemberRoles(teamId string, userId string, newRoles string) (*model.TeamMember, *model.AppError)
 	UpdateTeamMemberSchemeRoles(teamId string, userId string, isSchemeGuest bool, isSchemeUser bool, isSchemeAdmin bool) (*model.TeamMember, *model.AppError)
+	UpdateTeamPostingRestrictions(teamId string, restrictions *model.PostingRestrictions) (*model.Team, *model.AppError)
 	UpdateTeamPrivacy(teamId string, teamType string, allowOpenInvite bool) *model.AppError
 	UpdateTeamScheme(team *model.Team) (*model.Team, *model.AppError)
 	UpdateUser(user *model.User, sendNotifications bool) (*model.User, *model.AppError)
@@ -98,6 +98,8 @@ func TestDoAdvancedPermissionsMigration(t *testing.T) {
 			model.PERMISSION_MANAGE_PUBLIC_CHANNEL_MEMBERS.Id,
 			model.PERMISSION_UPLOAD_FILE.Id,
 			model.PERMISSION_GET_PUBLIC_LINK.Id,
+			model.PERMISSION_DOWNLOAD_FILE.Id,
+			model.PERMISSION_CREATE_URGENT_POST.Id,
 			model.PERMISSION_CREATE_POST.Id,
 			model.PERMISSION_USE_CHANNEL_MENTIONS.Id,
 			model.PERMISSION_USE_SLASH_COMMANDS.Id,
@@ -189,6 +191,7 @@ func TestDoAdvancedPermissionsMigration(t *testing.T) {
 			model.PERMISSION_INVITE_GUEST.Id,
 			model.PERMISSION_PROMOTE_GUEST.Id,
 			model.PERMISSION_DEMOTE_TO_GUEST.Id,
+			model.PERMISSION_IMPERSONATE_USER.Id,
 			model.PERMISSION_DELETE_POST.Id,
 			model.PERMISSION_DELETE_OTHERS_POSTS.Id,
 			model.PERMISSION_CREATE_TEAM.Id,
@@ -218,6 +221,8 @@ func TestDoAdvancedPermissionsMigration(t *testing.T) {
 			model.PERMISSION_REMOVE_REACTION.Id,
 			model.PERMISSION_UPLOAD_FILE.Id,
 			model.PERMISSION_GET_PUBLIC_LINK.Id,
+			model.PERMISSION_DOWNLOAD_FILE.Id,
+			model.PERMISSION_CREATE_URGENT_POST.Id,
 			model.PERMISSION_CREATE_POST.Id,
 			model.PERMISSION_USE_CHANNEL_MENTIONS.Id,
 			model.PERMISSION_USE_SLASH_COMMANDS.Id,
@@ -290,6 +295,8 @@ func TestDoAdvancedPermissionsMigration(t *testing.T) {
 			model.PERMISSION_MANAGE_PUBLIC_CHANNEL_MEMBERS.Id,
 			model.PERMISSION_UPLOAD_FILE.Id,
 			model.PERMISSION_GET_PUBLIC_LINK.Id,
+			model.PERMISSION_DOWNLOAD_FILE.Id,
+			model.PERMISSION_CREATE_URGENT_POST.Id,
 			model.PERMISSION_CREATE_POST.Id,
 			model.PERMISSION_USE_CHANNEL_MENTIONS.Id,
 			model.PERMISSION_USE_SLASH_COMMANDS.Id,
@@ -381,6 +388,7 @@ func TestDoAdvancedPermissionsMigration(t *testing.T) {
 			model.PERMISSION_INVITE_GUEST.Id,
 			model.PERMISSION_PROMOTE_GUEST.Id,
 			model.PERMISSION_DEMOTE_TO_GUEST.Id,
+			model.PERMISSION_IMPERSONATE_USER.Id,
 			model.PERMISSION_DELETE_POST.Id,
 			model.PERMISSION_DELETE_OTHERS_POSTS.Id,
 			model.PERMISSION_CREATE_TEAM.Id,
@@ -410,6 +418,8 @@ func TestDoAdvancedPermissionsMigration(t *testing.T) {
 			model.PERMISSION_REMOVE_REACTION.Id,
 			model.PERMISSION_UPLOAD_FILE.Id,
 			model.PERMISSION_GET_PUBLIC_LINK.Id,
+			model.PERMISSION_DOWNLOAD_FILE.Id,
+			model.PERMISSION_CREATE_URGENT_POST.Id,
 			model.PERMISSION_CREATE_POST.Id,
 			model.PERMISSION_USE_CHANNEL_MENTIONS.Id,
 			model.PERMISSION_USE_SLASH_COMMANDS.Id,
@@ -530,6 +540,7 @@ func TestDoEmojisPermissionsMigration(t *testing.T) {
 		model.PERMISSION_INVITE_GUEST.Id,
 		model.PERMISSION_PROMOTE_GUEST.Id,
 		model.PERMISSION_DEMOTE_TO_GUEST.Id,
+		model.PERMISSION_IMPERSONATE_USER.Id,
 		model.PERMISSION_DELETE_POST.Id,
 		model.PERMISSION_DELETE_OTHERS_POSTS.Id,
 		model.PERMISSION_CREATE_TEAM.Id,
@@ -558,6 +569,8 @@ func TestDoEmojisPermissionsMigration(t *testing.T) {
 		model.PERMISSION_REMOVE_REACTION.Id,
 		model.PERMISSION_UPLOAD_FILE.Id,
 		model.PERMISSION_GET_PUBLIC_LINK.Id,
+		model.PERMISSION_DOWNLOAD_FILE.Id,
+		model.PERMISSION_CREATE_URGENT_POST.Id,
 		model.PERMISSION_CREATE_POST.Id,
 		model.PERMISSION_USE_SLASH_COMMANDS.Id,
 		model.PERMISSION_REMOVE_USER_FROM_TEAM.Id,
@@ -20,6 +20,7 @@ const (
 	RestContentLevelID = 241
 	RestPermsLevelID   = 242
 	CLILevelID         = 243
+	StoreLevelID       = 244
 )
 
 var (
@@ -27,6 +28,7 @@ var (
 	RestContentLevel = audit.Level{ID: RestContentLevelID, Name: "audit-rest-content", Stacktrace: false}
 	RestPermsLevel   = audit.Level{ID: RestPermsLevelID, Name: "audit-rest-perms", Stacktrace: false}
 	CLILevel         = audit.Level{ID: CLILevelID, Name: "audit-cli", Stacktrace: false}
+	StoreLevel       = audit.Level{ID: StoreLevelID, Name: "audit-store", Stacktrace: false}
 )
 
 func (a *App) GetAudits(userId string, limit int) (model.Audits, *model.AppError) {
@@ -62,6 +64,11 @@ func (a *App) LogAuditRec(rec *audit.Record, err error) {
 	a.LogAuditRecWithLevel(rec, CLILevel, err)
 }
 
+// LogStoreAuditRec logs an audit record of a store operation using StoreLevel.
+func (a *App) LogStoreAuditRec(rec *audit.Record, err error) {
+	a.LogAuditRecWithLevel(rec, StoreLevel, err)
+}
+
 // LogAuditRecWithLevel logs an audit record using specified Level.
 func (a *App) LogAuditRecWithLevel(rec *audit.Record, level audit.Level, err error) {
 	if rec == nil {
@@ -130,7 +137,7 @@ func (s *Server) configureAudit(adt *audit.Audit) {
 			Insecure: *s.Config().ExperimentalAuditSettings.SysLogInsecure,
 		}
 
-		filter := adt.MakeFilter(RestLevel, RestContentLevel, RestPermsLevel, CLILevel)
+		filter := adt.MakeFilter(RestLevel, RestContentLevel, RestPermsLevel, CLILevel, StoreLevel)
 		formatter := adt.MakeJSONFormatter()
 		target, err := mlog.NewSyslogTarget(filter, formatter, params, maxQSize)
 		if err != nil {
@@ -156,7 +163,7 @@ func (s *Server) configureAudit(adt *audit.Audit) {
 			maxQueueSize = audit.DefMaxQueueSize
 		}
 
-		filter := adt.MakeFilter(RestLevel, RestContentLevel, RestPermsLevel, CLILevel)
+		filter := adt.MakeFilter(RestLevel, RestContentLevel, RestPermsLevel, CLILevel, StoreLevel)
 		formatter := adt.MakeJSONFormatter()
 		formatter.DisableTimestamp = false
 		target, err := audit.NewFileTarget(filter, formatter, opts, maxQueueSize)
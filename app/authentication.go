@@ -165,6 +165,10 @@ func (a *App) CheckUserPreflightAuthenticationCriteria(user *model.User, mfaToke
 		return err
 	}
 
+	if err := checkUserNotServiceAccount(user); err != nil {
+		return err
+	}
+
 	if err := checkUserLoginAttempts(user, *a.Config().ServiceSettings.MaximumLoginAttempts); err != nil {
 		return err
 	}
@@ -174,6 +178,9 @@ func (a *App) CheckUserPreflightAuthenticationCriteria(user *model.User, mfaToke
 
 func (a *App) CheckUserPostflightAuthenticationCriteria(user *model.User) *model.AppError {
 	if !user.EmailVerified && *a.Config().EmailSettings.RequireEmailVerification {
+		if a.Config().EmailSettings.IsEmailVerificationGracePeriodEnabled() {
+			return a.checkEmailVerificationGracePeriod(user)
+		}
 		return model.NewAppError("Login", "api.user.login.not_verified.app_error", nil, "user_id="+user.Id, http.StatusUnauthorized)
 	}
 
@@ -220,6 +227,15 @@ func checkUserNotBot(user *model.User) *model.AppError {
 	return nil
 }
 
+// checkUserNotServiceAccount refuses interactive logins of service accounts, which only
+// authenticate with user access tokens or client side certificates.
+func checkUserNotServiceAccount(user *model.User) *model.AppError {
+	if user.IsServiceAccount {
+		return model.NewAppError("Login", "api.user.login.service_account_login_forbidden.app_error", nil, "user_id="+user.Id, http.StatusUnauthorized)
+	}
+	return nil
+}
+
 func (a *App) authenticateUser(user *model.User, password, mfaToken string) (*model.User, *model.AppError) {
 	license := a.Srv().License()
 	ldapAvailable := *a.Config().LdapSettings.Enable && a.Ldap() != nil && license != nil && *license.Features.LDAP
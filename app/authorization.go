@@ -48,6 +48,11 @@ func (a *App) SessionHasPermissionToChannel(session model.Session, channelId str
 		return true
 	}
 
+	// Embedded sessions are restricted to the channel their token was minted for.
+	if session.IsEmbedded() && session.EmbedChannelId() != channelId {
+		return false
+	}
+
 	ids, err := a.Srv().Store.Channel().GetAllChannelMembersForUser(session.UserId, true, true)
 
 	var channelRoles []string
@@ -73,6 +78,12 @@ func (a *App) SessionHasPermissionToChannel(session model.Session, channelId str
 }
 
 func (a *App) SessionHasPermissionToChannelByPost(session model.Session, postId string, permission *model.Permission) bool {
+	if session.IsEmbedded() {
+		if channel, err := a.Srv().Store.Channel().GetForPost(postId); err != nil || channel.Id != session.EmbedChannelId() {
+			return false
+		}
+	}
+
 	if channelMember, err := a.Srv().Store.Channel().GetMemberForPost(postId, session.UserId); err == nil {
 
 		if a.RolesGrantPermission(channelMember.GetRoles(), permission.Id) {
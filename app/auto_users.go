@@ -4,6 +4,8 @@
 package app
 
 import (
+	"net/http"
+
 	"github.com/mattermost/mattermost-server/v5/model"
 	"github.com/mattermost/mattermost-server/v5/utils"
 )
@@ -53,8 +55,8 @@ func (a *App) CreateBasicUser(client *model.Client4) *model.AppError {
 	if err != nil {
 		return err
 	}
-	if _, err = a.Srv().Store.Team().SaveMember(&model.TeamMember{TeamId: basicteam.Id, UserId: ruser.Id}, *a.Config().TeamSettings.MaxUsersPerTeam); err != nil {
-		return err
+	if _, nErr := a.Srv().Store.Team().SaveMember(a.Context(), &model.TeamMember{TeamId: basicteam.Id, UserId: ruser.Id}, *a.Config().TeamSettings.MaxUsersPerTeam); nErr != nil {
+		return model.NewAppError("CreateBasicUser", "app.team.join_user_to_team.save_member.app_error", nil, nErr.Error(), http.StatusInternalServerError)
 	}
 
 	return nil
@@ -0,0 +1,418 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/services/backup"
+)
+
+// BackupOptions configures CreateBackup.
+type BackupOptions struct {
+	// Since makes the backup incremental: it then only holds what changed after this time, usually
+	// the Until of the previous backup. Zero takes a full backup.
+	Since int64
+	// IncludeFiles copies the attachments, profile pictures and custom emoji referenced by the
+	// backup into it. Without it the manifest only lists them.
+	IncludeFiles bool
+	// Passphrase encrypts the backup when set.
+	Passphrase string
+}
+
+// CreateBackup writes a backup of the database, as a bulk export, to w. Deleted records are left
+// out, so restoring incremental backups doesn't replay deletions.
+func (a *App) CreateBackup(w io.Writer, opts BackupOptions) (*backup.Manifest, *model.AppError) {
+	manifest := &backup.Manifest{
+		ServerVersion: model.CurrentVersion,
+		SchemaVersion: a.Srv().Store.GetCurrentSchemaVersion(),
+		CreateAt:      model.GetMillis(),
+		Since:         opts.Since,
+		IncludesFiles: opts.IncludeFiles,
+	}
+	// Anything changed while the backup runs may be exported twice, which restoring tolerates.
+	manifest.Until = manifest.CreateAt
+
+	bw, err := backup.NewWriter(w, opts.Passphrase)
+	if err != nil {
+		return nil, model.NewAppError("CreateBackup", "app.backup.create.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	data, err := bw.Create(backup.DATA_ENTRY)
+	if err != nil {
+		return nil, model.NewAppError("CreateBackup", "app.backup.create.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	exportOpts := &exportOptions{Since: opts.Since, Files: newExportFiles()}
+	if appErr := a.exportBackupData(data, exportOpts); appErr != nil {
+		return nil, appErr
+	}
+
+	files, appErr := a.backupFiles(bw, exportOpts.Files.paths, opts.IncludeFiles)
+	if appErr != nil {
+		return nil, appErr
+	}
+	manifest.Files = files
+
+	if err := bw.Close(manifest); err != nil {
+		return nil, model.NewAppError("CreateBackup", "app.backup.create.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return manifest, nil
+}
+
+func (a *App) exportBackupData(writer io.Writer, opts *exportOptions) *model.AppError {
+	steps := []struct {
+		name   string
+		export func(io.Writer, *exportOptions) *model.AppError
+	}{
+		{"teams", a.exportAllTeams},
+		{"channels", a.exportAllChannels},
+		{"users", a.exportAllUsers},
+		{"user relationships", a.exportAllUserRelationships},
+		{"channel bookmarks", a.exportAllChannelBookmarks},
+		{"posts", a.exportAllPosts},
+		{"emoji", a.exportBackupEmoji},
+		{"direct channels", a.exportAllDirectChannels},
+		{"direct posts", a.exportAllDirectPosts},
+	}
+
+	if err := a.exportVersion(writer); err != nil {
+		return err
+	}
+
+	for _, step := range steps {
+		mlog.Info("Backup: exporting " + step.name)
+		if err := step.export(writer, opts); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// exportBackupEmoji references custom emoji images by their file store path, unlike the bulk export
+// which copies them next to the export file.
+func (a *App) exportBackupEmoji(writer io.Writer, opts *exportOptions) *model.AppError {
+	for page := 0; ; page++ {
+		emojis, err := a.GetEmojiList(page, 100, model.EMOJI_SORT_BY_NAME)
+		if err != nil {
+			return err
+		}
+
+		if len(emojis) == 0 {
+			return nil
+		}
+
+		for _, emoji := range emojis {
+			if !opts.changed(emoji.UpdateAt) {
+				continue
+			}
+
+			path := opts.Files.add(getEmojiImagePath(emoji.Id))
+			if err := a.exportWriteLine(writer, ImportLineFromEmoji(emoji, *path)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// backupFiles lists the referenced files in the manifest, copying them into the backup when
+// include is set. Files missing from the file store are left out, and restoring then skips the
+// references to them.
+func (a *App) backupFiles(bw *backup.Writer, paths []string, include bool) ([]backup.File, *model.AppError) {
+	files := []backup.File{}
+	if !include {
+		for _, path := range paths {
+			files = append(files, backup.File{Path: path})
+		}
+		return files, nil
+	}
+
+	backend, appErr := a.FileBackend()
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	mlog.Info("Backup: copying files", mlog.Int("count", len(paths)))
+	for _, path := range paths {
+		reader, appErr := backend.Reader(path)
+		if appErr != nil {
+			mlog.Warn("Backup: skipping a file missing from the file store", mlog.String("path", path), mlog.Err(appErr))
+			continue
+		}
+
+		w, err := bw.Create(backup.FILES_PREFIX + path)
+		if err != nil {
+			reader.Close()
+			return nil, model.NewAppError("CreateBackup", "app.backup.create.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+
+		size, err := io.Copy(w, reader)
+		reader.Close()
+		if err != nil {
+			return nil, model.NewAppError("CreateBackup", "app.backup.copy_file.app_error", map[string]interface{}{"Path": path}, err.Error(), http.StatusInternalServerError)
+		}
+
+		files = append(files, backup.File{Path: path, Size: size})
+	}
+
+	return files, nil
+}
+
+// RestoreBackup verifies the backup at path and imports it. It only validates the data, as a dry
+// run of the bulk import, unless apply is set. Once applied, it checks that every team, channel and
+// user of the backup exists. It returns the line of the data the import failed on, if any.
+func (a *App) RestoreBackup(path string, passphrase string, apply bool, workers int) (*backup.Manifest, *model.AppError, int) {
+	archive, err := backup.Open(path, passphrase)
+	if err != nil {
+		return nil, model.NewAppError("RestoreBackup", "app.backup.open.app_error", nil, err.Error(), http.StatusBadRequest), 0
+	}
+	defer archive.Close()
+
+	if archive.Manifest.ChannelId != "" {
+		return archive.Manifest, model.NewAppError("RestoreBackup", "app.backup.channel_snapshot.app_error", nil, "", http.StatusBadRequest), 0
+	}
+
+	if err = archive.Verify(); err != nil {
+		return archive.Manifest, model.NewAppError("RestoreBackup", "app.backup.verify.app_error", nil, err.Error(), http.StatusBadRequest), 0
+	}
+
+	dir, err := ioutil.TempDir("", "mattermost-restore")
+	if err != nil {
+		return archive.Manifest, model.NewAppError("RestoreBackup", "app.backup.extract.app_error", nil, err.Error(), http.StatusInternalServerError), 0
+	}
+	defer os.RemoveAll(dir)
+
+	if err = archive.Extract(dir); err != nil {
+		return archive.Manifest, model.NewAppError("RestoreBackup", "app.backup.extract.app_error", nil, err.Error(), http.StatusInternalServerError), 0
+	}
+
+	dataPath := filepath.Join(dir, "restore.jsonl")
+	if err = rebaseBackupData(filepath.Join(dir, backup.DATA_ENTRY), dataPath, filepath.Join(dir, backup.FILES_PREFIX)); err != nil {
+		return archive.Manifest, model.NewAppError("RestoreBackup", "app.backup.extract.app_error", nil, err.Error(), http.StatusInternalServerError), 0
+	}
+
+	if appErr, line := a.importBackupData(dataPath, true, workers); appErr != nil || !apply {
+		return archive.Manifest, appErr, line
+	}
+
+	if appErr, line := a.importBackupData(dataPath, false, workers); appErr != nil {
+		return archive.Manifest, appErr, line
+	}
+
+	return archive.Manifest, a.verifyRestoredData(dataPath), 0
+}
+
+func (a *App) importBackupData(path string, dryRun bool, workers int) (*model.AppError, int) {
+	f, err := os.Open(path)
+	if err != nil {
+		return model.NewAppError("RestoreBackup", "app.backup.extract.app_error", nil, err.Error(), http.StatusInternalServerError), 0
+	}
+	defer f.Close()
+
+	return a.BulkImport(f, dryRun, workers)
+}
+
+// rebaseBackupData rewrites the file store paths referenced by the data at src into paths of the
+// extracted files below filesDir. References to files the backup doesn't hold are dropped.
+func rebaseBackupData(src, dst, filesDir string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	rebase := func(path *string) *string {
+		if path == nil {
+			return nil
+		}
+
+		extracted := filepath.Join(filesDir, filepath.FromSlash(*path))
+		if _, err := os.Stat(extracted); err != nil {
+			return nil
+		}
+		return &extracted
+	}
+
+	rebaseAttachments := func(attachments *[]AttachmentImportData) *[]AttachmentImportData {
+		if attachments == nil {
+			return nil
+		}
+
+		rebased := []AttachmentImportData{}
+		for _, attachment := range *attachments {
+			if path := rebase(attachment.Path); path != nil {
+				rebased = append(rebased, AttachmentImportData{Path: path})
+			}
+		}
+		return &rebased
+	}
+
+	rebaseReplies := func(replies *[]ReplyImportData) {
+		if replies == nil {
+			return
+		}
+		for i := range *replies {
+			(*replies)[i].Attachments = rebaseAttachments((*replies)[i].Attachments)
+		}
+	}
+
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxScanTokenSize)
+	for scanner.Scan() {
+		var line LineImportData
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			return err
+		}
+
+		switch {
+		case line.User != nil:
+			line.User.ProfileImage = rebase(line.User.ProfileImage)
+		case line.Post != nil:
+			line.Post.Attachments = rebaseAttachments(line.Post.Attachments)
+			rebaseReplies(line.Post.Replies)
+		case line.DirectPost != nil:
+			line.DirectPost.Attachments = rebaseAttachments(line.DirectPost.Attachments)
+			rebaseReplies(line.DirectPost.Replies)
+		case line.Emoji != nil:
+			// An emoji can't be imported without its image.
+			if line.Emoji.Image = rebase(line.Emoji.Image); line.Emoji.Image == nil {
+				continue
+			}
+		}
+
+		b, err := json.Marshal(line)
+		if err != nil {
+			return err
+		}
+
+		if _, err := out.Write(append(b, '\n')); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
+
+// verifyRestoredData checks that the teams, channels and users of a restored backup exist.
+func (a *App) verifyRestoredData(path string) *model.AppError {
+	f, err := os.Open(path)
+	if err != nil {
+		return model.NewAppError("RestoreBackup", "app.backup.extract.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	defer f.Close()
+
+	missing := []string{}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxScanTokenSize)
+	for scanner.Scan() {
+		var line LineImportData
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			return model.NewAppError("RestoreBackup", "app.backup.extract.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+
+		switch {
+		case line.Team != nil && line.Team.Name != nil:
+			if _, err := a.GetTeamByName(*line.Team.Name); err != nil {
+				missing = append(missing, "team "+*line.Team.Name)
+			}
+		case line.Channel != nil && line.Channel.Team != nil && line.Channel.Name != nil:
+			team, err := a.GetTeamByName(*line.Channel.Team)
+			if err != nil {
+				missing = append(missing, "channel "+*line.Channel.Team+"/"+*line.Channel.Name)
+				continue
+			}
+			if _, err := a.Srv().Store.Channel().GetByName(team.Id, *line.Channel.Name, false); err != nil {
+				missing = append(missing, "channel "+*line.Channel.Team+"/"+*line.Channel.Name)
+			}
+		case line.User != nil && line.User.Username != nil:
+			if _, err := a.Srv().Store.User().GetByUsername(*line.User.Username); err != nil {
+				missing = append(missing, "user "+*line.User.Username)
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return model.NewAppError("RestoreBackup", "app.backup.extract.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	if len(missing) > 0 {
+		return model.NewAppError("RestoreBackup", "app.backup.verify_restore.app_error", map[string]interface{}{"Count": len(missing)}, strings.Join(missing, ", "), http.StatusInternalServerError)
+	}
+
+	return nil
+}
+
+// RunScheduledBackup takes the backup of a backup job into BackupSettings.Directory. It is
+// incremental from the previous successful backup unless a full backup is due. It returns the job
+// data to record.
+func (a *App) RunScheduledBackup(lastSuccessfulJob *model.Job) (map[string]string, *model.AppError) {
+	settings := a.Config().BackupSettings
+
+	since := int64(0)
+	incrementals := 0
+	if *settings.EnableIncremental && lastSuccessfulJob != nil {
+		lastIncrementals, _ := strconv.Atoi(lastSuccessfulJob.Data["incrementals"])
+		if lastIncrementals < *settings.MaxIncrementalBackups {
+			since, _ = strconv.ParseInt(lastSuccessfulJob.Data["until"], 10, 64)
+			if since > 0 {
+				incrementals = lastIncrementals + 1
+			}
+		}
+	}
+
+	if err := os.MkdirAll(*settings.Directory, 0700); err != nil {
+		return nil, model.NewAppError("RunScheduledBackup", "app.backup.directory.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	kind := "full"
+	if since > 0 {
+		kind = "incremental"
+	}
+	path := filepath.Join(*settings.Directory, fmt.Sprintf("mattermost-%s-%s.mmbackup", time.Now().UTC().Format("20060102-150405"), kind))
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, model.NewAppError("RunScheduledBackup", "app.backup.directory.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	manifest, appErr := a.CreateBackup(f, BackupOptions{
+		Since:        since,
+		IncludeFiles: *settings.IncludeFiles,
+		Passphrase:   *settings.EncryptionPassphrase,
+	})
+	if closeErr := f.Close(); appErr == nil && closeErr != nil {
+		appErr = model.NewAppError("RunScheduledBackup", "app.backup.create.app_error", nil, closeErr.Error(), http.StatusInternalServerError)
+	}
+	if appErr != nil {
+		os.Remove(path)
+		return nil, appErr
+	}
+
+	return map[string]string{
+		"file":         path,
+		"since":        strconv.FormatInt(manifest.Since, 10),
+		"until":        strconv.FormatInt(manifest.Until, 10),
+		"incrementals": strconv.Itoa(incrementals),
+	}, nil
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestBackupRoundTrip(t *testing.T) {
+	th1 := Setup(t).InitBasic()
+	defer th1.TearDown()
+
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "mattermost.mmbackup")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+
+	manifest, appErr := th1.App.CreateBackup(f, BackupOptions{IncludeFiles: true, Passphrase: "secret"})
+	require.Nil(t, appErr)
+	require.NoError(t, f.Close())
+	assert.False(t, manifest.Incremental())
+	assert.NotZero(t, manifest.Until)
+
+	th2 := Setup(t)
+	defer th2.TearDown()
+
+	t.Run("wrong passphrase", func(t *testing.T) {
+		_, appErr, _ := th2.App.RestoreBackup(path, "wrong", true, 2)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.backup.open.app_error", appErr.Id)
+	})
+
+	t.Run("validation only", func(t *testing.T) {
+		_, appErr, line := th2.App.RestoreBackup(path, "secret", false, 2)
+		require.Nil(t, appErr)
+		assert.Equal(t, 0, line)
+
+		_, appErr = th2.App.GetTeamByName(th1.BasicTeam.Name)
+		require.NotNil(t, appErr)
+	})
+
+	t.Run("apply", func(t *testing.T) {
+		_, appErr, line := th2.App.RestoreBackup(path, "secret", true, 2)
+		require.Nil(t, appErr)
+		assert.Equal(t, 0, line)
+
+		team, appErr := th2.App.GetTeamByName(th1.BasicTeam.Name)
+		require.Nil(t, appErr)
+
+		_, appErr = th2.App.GetChannelByName(th1.BasicChannel.Name, team.Id, false)
+		require.Nil(t, appErr)
+
+		_, appErr = th2.App.GetUserByUsername(th1.BasicUser.Username)
+		require.Nil(t, appErr)
+	})
+}
+
+func TestRunScheduledBackup(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.BackupSettings.Directory = dir
+		*cfg.BackupSettings.MaxIncrementalBackups = 1
+	})
+
+	data, appErr := th.App.RunScheduledBackup(nil)
+	require.Nil(t, appErr)
+	assert.Equal(t, "0", data["since"])
+	assert.Equal(t, "0", data["incrementals"])
+	assert.True(t, strings.HasSuffix(data["file"], "-full.mmbackup"))
+	require.FileExists(t, data["file"])
+
+	data, appErr = th.App.RunScheduledBackup(&model.Job{Data: data})
+	require.Nil(t, appErr)
+	assert.NotEqual(t, "0", data["since"])
+	assert.Equal(t, "1", data["incrementals"])
+	assert.True(t, strings.HasSuffix(data["file"], "-incremental.mmbackup"))
+
+	// Once MaxIncrementalBackups is reached the next backup is a full one again.
+	data, appErr = th.App.RunScheduledBackup(&model.Job{Data: data})
+	require.Nil(t, appErr)
+	assert.Equal(t, "0", data["since"])
+	assert.Equal(t, "0", data["incrementals"])
+}
+
+func TestRebaseBackupData(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	filesDir := filepath.Join(dir, "files")
+	require.NoError(t, os.MkdirAll(filepath.Join(filesDir, "users", "user1"), 0700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(filesDir, "users", "user1", "profile.png"), []byte("png"), 0600))
+
+	src := filepath.Join(dir, "data.jsonl")
+	dst := filepath.Join(dir, "restore.jsonl")
+	lines := []string{
+		`{"type":"version","version":1}`,
+		`{"type":"user","user":{"username":"user1","profile_image":"users/user1/profile.png"}}`,
+		`{"type":"user","user":{"username":"user2","profile_image":"users/user2/profile.png"}}`,
+		`{"type":"emoji","emoji":{"name":"emoji1","image":"emoji/emoji1/image"}}`,
+	}
+	require.NoError(t, ioutil.WriteFile(src, []byte(strings.Join(lines, "\n")+"\n"), 0600))
+
+	require.NoError(t, rebaseBackupData(src, dst, filesDir))
+
+	b, err := ioutil.ReadFile(dst)
+	require.NoError(t, err)
+	rebased := strings.Split(strings.TrimSpace(string(b)), "\n")
+
+	// The emoji is dropped since the backup doesn't hold its image.
+	require.Len(t, rebased, 3)
+	assert.Contains(t, rebased[1], strconv.Quote(filepath.Join(filesDir, "users", "user1", "profile.png")))
+	assert.NotContains(t, rebased[2], "profile_image")
+}
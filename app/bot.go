@@ -196,6 +196,10 @@ func (a *App) PermanentDeleteBot(botUserId string) *model.AppError {
 		}
 	}
 
+	if err := a.Srv().Store.ChannelIntegration().PermanentDeleteByIntegration(botUserId); err != nil {
+		return model.NewAppError("PermanentDeleteBot", "app.channel_integration.permanent_delete_by_integration.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
 	if err := a.Srv().Store.User().PermanentDelete(botUserId); err != nil {
 		return err
 	}
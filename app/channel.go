@@ -239,6 +239,8 @@ func (a *App) CreateChannel(channel *model.Channel, addMember bool) (*model.Chan
 		}
 	}
 
+	a.releaseSlug(model.SLUG_HISTORY_KIND_CHANNEL, sc.TeamId, sc.Name)
+
 	if addMember {
 		user, err := a.Srv().Store.User().Get(channel.CreatorId)
 		if err != nil {
@@ -533,6 +535,14 @@ func (a *App) GetGroupChannel(userIds []string) (*model.Channel, *model.AppError
 
 // UpdateChannel updates a given channel by its Id. It also publishes the CHANNEL_UPDATED event.
 func (a *App) UpdateChannel(channel *model.Channel) (*model.Channel, *model.AppError) {
+	var oldName string
+	if channel.TeamId != "" {
+		oldChannel, err := a.Srv().Store.Channel().Get(channel.Id, false)
+		if err == nil {
+			oldName = oldChannel.Name
+		}
+	}
+
 	_, err := a.Srv().Store.Channel().Update(channel)
 	if err != nil {
 		var appErr *model.AppError
@@ -547,6 +557,10 @@ func (a *App) UpdateChannel(channel *model.Channel) (*model.Channel, *model.AppE
 		}
 	}
 
+	if oldName != "" {
+		a.recordSlugChange(model.SLUG_HISTORY_KIND_CHANNEL, channel.TeamId, channel.Id, oldName, channel.Name)
+	}
+
 	a.invalidateCacheForChannel(channel)
 
 	messageWs := model.NewWebSocketEvent(model.WEBSOCKET_EVENT_CHANNEL_UPDATED, "", channel.Id, "", nil)
@@ -983,6 +997,16 @@ func buildChannelModerations(channelType string, memberRole *model.Role, guestRo
 }
 
 func (a *App) UpdateChannelMemberRoles(channelId string, userId string, newRoles string) (*model.ChannelMember, *model.AppError) {
+	return a.UpdateChannelMemberRolesWithExpiry(channelId, userId, newRoles, 0)
+}
+
+// UpdateChannelMemberRolesWithExpiry updates the roles of the channel member, granting the explicit
+// roles until expiresAt, or permanently when expiresAt is 0.
+func (a *App) UpdateChannelMemberRolesWithExpiry(channelId string, userId string, newRoles string, expiresAt int64) (*model.ChannelMember, *model.AppError) {
+	if err := checkRoleGrantExpiry("UpdateChannelMemberRoles", expiresAt); err != nil {
+		return nil, err
+	}
+
 	var member *model.ChannelMember
 	var err *model.AppError
 	if member, err = a.GetChannelMember(channelId, userId); err != nil {
@@ -1037,6 +1061,10 @@ func (a *App) UpdateChannelMemberRoles(channelId string, userId string, newRoles
 	}
 
 	member.ExplicitRoles = strings.Join(newExplicitRoles, " ")
+	member.ExplicitRolesExpiresAt = 0
+	if len(newExplicitRoles) > 0 {
+		member.ExplicitRolesExpiresAt = expiresAt
+	}
 
 	member, err = a.Srv().Store.Channel().UpdateMember(member)
 	if err != nil {
@@ -1277,7 +1305,7 @@ func (a *App) addUserToChannel(user *model.User, channel *model.Channel, teamMem
 }
 
 func (a *App) AddUserToChannel(user *model.User, channel *model.Channel) (*model.ChannelMember, *model.AppError) {
-	teamMember, err := a.Srv().Store.Team().GetMember(channel.TeamId, user.Id)
+	teamMember, err := a.GetTeamMember(channel.TeamId, user.Id)
 
 	if err != nil {
 		return nil, err
@@ -1337,6 +1365,8 @@ func (a *App) AddChannelMember(userId string, channel *model.Channel, userReques
 		})
 	}
 
+	a.publishChannelMemberEventStreamEvent(model.EVENT_STREAM_CHANNEL_MEMBER_ADDED, channel, cm)
+
 	if userRequestorId == "" || userId == userRequestorId {
 		a.postJoinChannelMessage(user, channel)
 	} else {
@@ -1390,18 +1420,8 @@ func (a *App) PostUpdateChannelHeaderMessage(userId string, channel *model.Chann
 		return model.NewAppError("PostUpdateChannelHeaderMessage", "api.channel.post_update_channel_header_message_and_forget.retrieve_user.error", nil, err.Error(), http.StatusBadRequest)
 	}
 
-	var message string
-	if oldChannelHeader == "" {
-		message = fmt.Sprintf(utils.T("api.channel.post_update_channel_header_message_and_forget.updated_to"), user.Username, newChannelHeader)
-	} else if newChannelHeader == "" {
-		message = fmt.Sprintf(utils.T("api.channel.post_update_channel_header_message_and_forget.removed"), user.Username, oldChannelHeader)
-	} else {
-		message = fmt.Sprintf(utils.T("api.channel.post_update_channel_header_message_and_forget.updated_from"), user.Username, oldChannelHeader, newChannelHeader)
-	}
-
 	post := &model.Post{
 		ChannelId: channel.Id,
-		Message:   message,
 		Type:      model.POST_HEADER_CHANGE,
 		UserId:    userId,
 		Props: model.StringInterface{
@@ -1411,6 +1431,14 @@ func (a *App) PostUpdateChannelHeaderMessage(userId string, channel *model.Chann
 		},
 	}
 
+	if oldChannelHeader == "" {
+		setSystemMessage(post, "api.channel.post_update_channel_header_message_and_forget.updated_to", user.Username, newChannelHeader)
+	} else if newChannelHeader == "" {
+		setSystemMessage(post, "api.channel.post_update_channel_header_message_and_forget.removed", user.Username, oldChannelHeader)
+	} else {
+		setSystemMessage(post, "api.channel.post_update_channel_header_message_and_forget.updated_from", user.Username, oldChannelHeader, newChannelHeader)
+	}
+
 	if _, err := a.CreatePost(post, channel, false, true); err != nil {
 		return model.NewAppError("", "api.channel.post_update_channel_header_message_and_forget.post.error", nil, err.Error(), http.StatusInternalServerError)
 	}
@@ -1424,18 +1452,8 @@ func (a *App) PostUpdateChannelPurposeMessage(userId string, channel *model.Chan
 		return model.NewAppError("PostUpdateChannelPurposeMessage", "app.channel.post_update_channel_purpose_message.retrieve_user.error", nil, err.Error(), http.StatusBadRequest)
 	}
 
-	var message string
-	if oldChannelPurpose == "" {
-		message = fmt.Sprintf(utils.T("app.channel.post_update_channel_purpose_message.updated_to"), user.Username, newChannelPurpose)
-	} else if newChannelPurpose == "" {
-		message = fmt.Sprintf(utils.T("app.channel.post_update_channel_purpose_message.removed"), user.Username, oldChannelPurpose)
-	} else {
-		message = fmt.Sprintf(utils.T("app.channel.post_update_channel_purpose_message.updated_from"), user.Username, oldChannelPurpose, newChannelPurpose)
-	}
-
 	post := &model.Post{
 		ChannelId: channel.Id,
-		Message:   message,
 		Type:      model.POST_PURPOSE_CHANGE,
 		UserId:    userId,
 		Props: model.StringInterface{
@@ -1444,6 +1462,15 @@ func (a *App) PostUpdateChannelPurposeMessage(userId string, channel *model.Chan
 			"new_purpose": newChannelPurpose,
 		},
 	}
+
+	if oldChannelPurpose == "" {
+		setSystemMessage(post, "app.channel.post_update_channel_purpose_message.updated_to", user.Username, newChannelPurpose)
+	} else if newChannelPurpose == "" {
+		setSystemMessage(post, "app.channel.post_update_channel_purpose_message.removed", user.Username, oldChannelPurpose)
+	} else {
+		setSystemMessage(post, "app.channel.post_update_channel_purpose_message.updated_from", user.Username, oldChannelPurpose, newChannelPurpose)
+	}
+
 	if _, err := a.CreatePost(post, channel, false, true); err != nil {
 		return model.NewAppError("", "app.channel.post_update_channel_purpose_message.post.error", nil, err.Error(), http.StatusInternalServerError)
 	}
@@ -1457,11 +1484,8 @@ func (a *App) PostUpdateChannelDisplayNameMessage(userId string, channel *model.
 		return model.NewAppError("PostUpdateChannelDisplayNameMessage", "api.channel.post_update_channel_displayname_message_and_forget.retrieve_user.error", nil, err.Error(), http.StatusBadRequest)
 	}
 
-	message := fmt.Sprintf(utils.T("api.channel.post_update_channel_displayname_message_and_forget.updated_from"), user.Username, oldChannelDisplayName, newChannelDisplayName)
-
 	post := &model.Post{
 		ChannelId: channel.Id,
-		Message:   message,
 		Type:      model.POST_DISPLAYNAME_CHANGE,
 		UserId:    userId,
 		Props: model.StringInterface{
@@ -1470,6 +1494,7 @@ func (a *App) PostUpdateChannelDisplayNameMessage(userId string, channel *model.
 			"new_displayname": newChannelDisplayName,
 		},
 	}
+	setSystemMessage(post, "api.channel.post_update_channel_displayname_message_and_forget.updated_from", user.Username, oldChannelDisplayName, newChannelDisplayName)
 
 	if _, err := a.CreatePost(post, channel, false, true); err != nil {
 		return model.NewAppError("PostUpdateChannelDisplayNameMessage", "api.channel.post_update_channel_displayname_message_and_forget.create_post.error", nil, err.Error(), http.StatusInternalServerError)
@@ -1526,7 +1551,7 @@ func (a *App) GetChannelsByNames(channelNames []string, teamId string) ([]*model
 func (a *App) GetChannelByNameForTeamName(channelName, teamName string, includeDeleted bool) (*model.Channel, *model.AppError) {
 	var team *model.Team
 
-	team, err := a.Srv().Store.Team().GetByName(teamName)
+	team, err := a.GetTeamByName(teamName)
 	if err != nil {
 		err.StatusCode = http.StatusNotFound
 		return nil, err
@@ -1672,6 +1697,29 @@ func (a *App) GetChannelMembersForUser(teamId string, userId string) (*model.Cha
 	return a.Srv().Store.Channel().GetMembersForUser(teamId, userId)
 }
 
+// GetChannelMembersForUserPage returns a page of the channel memberships of the user in the team in
+// the given order, either from the start or past the token handed out by a previous call.
+func (a *App) GetChannelMembersForUserPage(teamId, userId, sortBy, token string, perPage int) (*model.ChannelMembersPage, *model.AppError) {
+	cursor := model.ChannelMembersCursor{SortBy: sortBy}
+	if token != "" {
+		decoded := model.ChannelMembersCursorFromToken(token)
+		if decoded == nil || decoded.SortBy != sortBy {
+			return nil, model.NewAppError("GetChannelMembersForUserPage", "app.channel.get_members_page.invalid_token.app_error", nil, "", http.StatusBadRequest)
+		}
+		cursor = *decoded
+	}
+
+	page, next, err := a.Srv().Store.Channel().GetMembersForUserWithCursor(teamId, userId, cursor, perPage)
+	if err != nil {
+		return nil, err
+	}
+
+	if page.HasMore {
+		page.NextToken = next.ToToken()
+	}
+	return page, nil
+}
+
 func (a *App) GetChannelMembersForUserWithPagination(teamId, userId string, page, perPage int) ([]*model.ChannelMember, *model.AppError) {
 	m, err := a.Srv().Store.Channel().GetMembersForUserWithPagination(teamId, userId, page, perPage)
 	if err != nil {
@@ -1763,6 +1811,8 @@ func (a *App) JoinChannel(channel *model.Channel, userId string) *model.AppError
 		})
 	}
 
+	a.publishChannelMemberEventStreamEvent(model.EVENT_STREAM_CHANNEL_MEMBER_ADDED, channel, cm)
+
 	if err := a.postJoinChannelMessage(user, channel); err != nil {
 		return err
 	}
@@ -1771,23 +1821,23 @@ func (a *App) JoinChannel(channel *model.Channel, userId string) *model.AppError
 }
 
 func (a *App) postJoinChannelMessage(user *model.User, channel *model.Channel) *model.AppError {
-	message := fmt.Sprintf(utils.T("api.channel.join_channel.post_and_forget"), user.Username)
+	translationId := "api.channel.join_channel.post_and_forget"
 	postType := model.POST_JOIN_CHANNEL
 
 	if user.IsGuest() {
-		message = fmt.Sprintf(utils.T("api.channel.guest_join_channel.post_and_forget"), user.Username)
+		translationId = "api.channel.guest_join_channel.post_and_forget"
 		postType = model.POST_GUEST_JOIN_CHANNEL
 	}
 
 	post := &model.Post{
 		ChannelId: channel.Id,
-		Message:   message,
 		Type:      postType,
 		UserId:    user.Id,
 		Props: model.StringInterface{
 			"username": user.Username,
 		},
 	}
+	setSystemMessage(post, translationId, user.Username)
 
 	if _, err := a.CreatePost(post, channel, false, true); err != nil {
 		return model.NewAppError("postJoinChannelMessage", "api.channel.post_user_add_remove_message_and_forget.error", nil, err.Error(), http.StatusInternalServerError)
@@ -1799,13 +1849,13 @@ func (a *App) postJoinChannelMessage(user *model.User, channel *model.Channel) *
 func (a *App) postJoinTeamMessage(user *model.User, channel *model.Channel) *model.AppError {
 	post := &model.Post{
 		ChannelId: channel.Id,
-		Message:   fmt.Sprintf(utils.T("api.team.join_team.post_and_forget"), user.Username),
 		Type:      model.POST_JOIN_TEAM,
 		UserId:    user.Id,
 		Props: model.StringInterface{
 			"username": user.Username,
 		},
 	}
+	setSystemMessage(post, "api.team.join_team.post_and_forget", user.Username)
 
 	if _, err := a.CreatePost(post, channel, false, true); err != nil {
 		return model.NewAppError("postJoinTeamMessage", "api.channel.post_user_add_remove_message_and_forget.error", nil, err.Error(), http.StatusInternalServerError)
@@ -1887,16 +1937,16 @@ func (a *App) LeaveChannel(channelId string, userId string) *model.AppError {
 func (a *App) postLeaveChannelMessage(user *model.User, channel *model.Channel) *model.AppError {
 	post := &model.Post{
 		ChannelId: channel.Id,
-		// Message here embeds `@username`, not just `username`, to ensure that mentions
-		// treat this as a username mention even though the user has now left the channel.
-		// The client renders its own system message, ignoring this value altogether.
-		Message: fmt.Sprintf(utils.T("api.channel.leave.left"), fmt.Sprintf("@%s", user.Username)),
-		Type:    model.POST_LEAVE_CHANNEL,
-		UserId:  user.Id,
+		Type:      model.POST_LEAVE_CHANNEL,
+		UserId:    user.Id,
 		Props: model.StringInterface{
 			"username": user.Username,
 		},
 	}
+	// Message here embeds `@username`, not just `username`, to ensure that mentions
+	// treat this as a username mention even though the user has now left the channel.
+	// The client renders its own system message, ignoring this value altogether.
+	setSystemMessage(post, "api.channel.leave.left", fmt.Sprintf("@%s", user.Username))
 
 	if _, err := a.CreatePost(post, channel, false, true); err != nil {
 		return model.NewAppError("postLeaveChannelMessage", "api.channel.post_user_add_remove_message_and_forget.error", nil, err.Error(), http.StatusInternalServerError)
@@ -1906,17 +1956,16 @@ func (a *App) postLeaveChannelMessage(user *model.User, channel *model.Channel)
 }
 
 func (a *App) PostAddToChannelMessage(user *model.User, addedUser *model.User, channel *model.Channel, postRootId string) *model.AppError {
-	message := fmt.Sprintf(utils.T("api.channel.add_member.added"), addedUser.Username, user.Username)
+	translationId := "api.channel.add_member.added"
 	postType := model.POST_ADD_TO_CHANNEL
 
 	if addedUser.IsGuest() {
-		message = fmt.Sprintf(utils.T("api.channel.add_guest.added"), addedUser.Username, user.Username)
+		translationId = "api.channel.add_guest.added"
 		postType = model.POST_ADD_GUEST_TO_CHANNEL
 	}
 
 	post := &model.Post{
 		ChannelId: channel.Id,
-		Message:   message,
 		Type:      postType,
 		UserId:    user.Id,
 		RootId:    postRootId,
@@ -1927,6 +1976,7 @@ func (a *App) PostAddToChannelMessage(user *model.User, addedUser *model.User, c
 			"addedUsername":                addedUser.Username,
 		},
 	}
+	setSystemMessage(post, translationId, addedUser.Username, user.Username)
 
 	if _, err := a.CreatePost(post, channel, false, true); err != nil {
 		return model.NewAppError("postAddToChannelMessage", "api.channel.post_user_add_remove_message_and_forget.error", nil, err.Error(), http.StatusInternalServerError)
@@ -1938,7 +1988,6 @@ func (a *App) PostAddToChannelMessage(user *model.User, addedUser *model.User, c
 func (a *App) postAddToTeamMessage(user *model.User, addedUser *model.User, channel *model.Channel, postRootId string) *model.AppError {
 	post := &model.Post{
 		ChannelId: channel.Id,
-		Message:   fmt.Sprintf(utils.T("api.team.add_user_to_team.added"), addedUser.Username, user.Username),
 		Type:      model.POST_ADD_TO_TEAM,
 		UserId:    user.Id,
 		RootId:    postRootId,
@@ -1949,6 +1998,7 @@ func (a *App) postAddToTeamMessage(user *model.User, addedUser *model.User, chan
 			"addedUsername":                addedUser.Username,
 		},
 	}
+	setSystemMessage(post, "api.team.add_user_to_team.added", addedUser.Username, user.Username)
 
 	if _, err := a.CreatePost(post, channel, false, true); err != nil {
 		return model.NewAppError("postAddToTeamMessage", "api.channel.post_user_add_remove_message_and_forget.error", nil, err.Error(), http.StatusInternalServerError)
@@ -1960,17 +2010,17 @@ func (a *App) postAddToTeamMessage(user *model.User, addedUser *model.User, chan
 func (a *App) postRemoveFromChannelMessage(removerUserId string, removedUser *model.User, channel *model.Channel) *model.AppError {
 	post := &model.Post{
 		ChannelId: channel.Id,
-		// Message here embeds `@username`, not just `username`, to ensure that mentions
-		// treat this as a username mention even though the user has now left the channel.
-		// The client renders its own system message, ignoring this value altogether.
-		Message: fmt.Sprintf(utils.T("api.channel.remove_member.removed"), fmt.Sprintf("@%s", removedUser.Username)),
-		Type:    model.POST_REMOVE_FROM_CHANNEL,
-		UserId:  removerUserId,
+		Type:      model.POST_REMOVE_FROM_CHANNEL,
+		UserId:    removerUserId,
 		Props: model.StringInterface{
 			"removedUserId":   removedUser.Id,
 			"removedUsername": removedUser.Username,
 		},
 	}
+	// Message here embeds `@username`, not just `username`, to ensure that mentions
+	// treat this as a username mention even though the user has now left the channel.
+	// The client renders its own system message, ignoring this value altogether.
+	setSystemMessage(post, "api.channel.remove_member.removed", fmt.Sprintf("@%s", removedUser.Username))
 
 	if _, err := a.CreatePost(post, channel, false, true); err != nil {
 		return model.NewAppError("postRemoveFromChannelMessage", "api.channel.post_user_add_remove_message_and_forget.error", nil, err.Error(), http.StatusInternalServerError)
@@ -2049,6 +2099,8 @@ func (a *App) removeUserFromChannel(userIdToRemove string, removerUserId string,
 		})
 	}
 
+	a.publishChannelMemberEventStreamEvent(model.EVENT_STREAM_CHANNEL_MEMBER_REMOVED, channel, cm)
+
 	message := model.NewWebSocketEvent(model.WEBSOCKET_EVENT_USER_REMOVED, "", channel.Id, "", nil)
 	message.Add("user_id", userIdToRemove)
 	message.Add("remover_id", removerUserId)
@@ -2341,10 +2393,38 @@ func (a *App) PermanentDeleteChannel(channel *model.Channel) *model.AppError {
 		return err
 	}
 
+	if nErr := a.Srv().Store.ChannelBookmark().PermanentDeleteByChannel(channel.Id); nErr != nil {
+		return model.NewAppError("PermanentDeleteChannel", "app.channel_bookmark.permanent_delete_by_channel.app_error", nil, nErr.Error(), http.StatusInternalServerError)
+	}
+
+	if nErr := a.Srv().Store.ChannelGuestLink().PermanentDeleteByChannel(channel.Id); nErr != nil {
+		return model.NewAppError("PermanentDeleteChannel", "app.channel_guest_link.permanent_delete_by_channel.app_error", nil, nErr.Error(), http.StatusInternalServerError)
+	}
+
+	if nErr := a.Srv().Store.ChannelChange().PermanentDeleteByChannel(channel.Id); nErr != nil {
+		return model.NewAppError("PermanentDeleteChannel", "app.channel_change.permanent_delete_by_channel.app_error", nil, nErr.Error(), http.StatusInternalServerError)
+	}
+
+	if nErr := a.Srv().Store.PendingPin().PermanentDeleteByChannel(channel.Id); nErr != nil {
+		return model.NewAppError("PermanentDeleteChannel", "app.pending_pin.permanent_delete_by_channel.app_error", nil, nErr.Error(), http.StatusInternalServerError)
+	}
+
+	if nErr := a.Srv().Store.PostAcknowledgement().PermanentDeleteByChannel(channel.Id); nErr != nil {
+		return model.NewAppError("PermanentDeleteChannel", "app.post_acknowledgement.permanent_delete_by_channel.app_error", nil, nErr.Error(), http.StatusInternalServerError)
+	}
+
+	if nErr := a.Srv().Store.ChannelIntegration().PermanentDeleteByChannel(channel.Id); nErr != nil {
+		return model.NewAppError("PermanentDeleteChannel", "app.channel_integration.permanent_delete_by_channel.app_error", nil, nErr.Error(), http.StatusInternalServerError)
+	}
+
 	if nErr := a.Srv().Store.Channel().PermanentDelete(channel.Id); nErr != nil {
 		return model.NewAppError("PermanentDeleteChannel", "app.channel.permanent_delete.app_error", nil, nErr.Error(), http.StatusInternalServerError)
 	}
 
+	if nErr := a.Srv().Store.SlugHistory().PermanentDeleteByTarget(channel.Id); nErr != nil {
+		return model.NewAppError("PermanentDeleteChannel", "app.channel.permanent_delete.app_error", nil, nErr.Error(), http.StatusInternalServerError)
+	}
+
 	return nil
 }
 
@@ -2387,7 +2467,7 @@ func (a *App) MoveChannel(team *model.Team, channel *model.Channel, user *model.
 	}
 
 	// keep instance of the previous team
-	previousTeam, err := a.Srv().Store.Team().Get(channel.TeamId)
+	previousTeam, err := a.GetTeam(channel.TeamId)
 	if err != nil {
 		return err
 	}
@@ -2451,13 +2531,13 @@ func (a *App) postChannelMoveMessage(user *model.User, channel *model.Channel, p
 
 	post := &model.Post{
 		ChannelId: channel.Id,
-		Message:   fmt.Sprintf(utils.T("api.team.move_channel.success"), previousTeam.Name),
 		Type:      model.POST_MOVE_CHANNEL,
 		UserId:    user.Id,
 		Props: model.StringInterface{
 			"username": user.Username,
 		},
 	}
+	setSystemMessage(post, "api.team.move_channel.success", previousTeam.Name)
 
 	if _, err := a.CreatePost(post, channel, false, true); err != nil {
 		return model.NewAppError("postChannelMoveMessage", "api.team.move_channel.post.error", nil, err.Error(), http.StatusInternalServerError)
@@ -2573,9 +2653,9 @@ func (a *App) FillInChannelsProps(channelList *model.ChannelList) *model.AppErro
 				}
 
 				if len(channelMentionsProp) > 0 {
-					channel.AddProp("channel_mentions", channelMentionsProp)
+					channel.AddProp(model.CHANNEL_PROPS_CHANNEL_MENTIONS, channelMentionsProp)
 				} else if channel.Props != nil {
-					delete(channel.Props, "channel_mentions")
+					delete(channel.Props, model.CHANNEL_PROPS_CHANNEL_MENTIONS)
 				}
 			}
 		}
@@ -0,0 +1,153 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+func (a *App) GetChannelBookmarks(channelId string) ([]*model.ChannelBookmark, *model.AppError) {
+	bookmarks, err := a.Srv().Store.ChannelBookmark().GetBookmarksForChannel(channelId, false)
+	if err != nil {
+		return nil, model.NewAppError("GetChannelBookmarks", "app.channel_bookmark.get_for_channel.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return bookmarks, nil
+}
+
+func (a *App) GetChannelBookmark(bookmarkId string, includeDeleted bool) (*model.ChannelBookmark, *model.AppError) {
+	bookmark, err := a.Srv().Store.ChannelBookmark().Get(bookmarkId, includeDeleted)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetChannelBookmark", "app.channel_bookmark.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetChannelBookmark", "app.channel_bookmark.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return bookmark, nil
+}
+
+func (a *App) CreateChannelBookmark(bookmark *model.ChannelBookmark, userId string) (*model.ChannelBookmark, *model.AppError) {
+	count, err := a.Srv().Store.ChannelBookmark().CountForChannel(bookmark.ChannelId)
+	if err != nil {
+		return nil, model.NewAppError("CreateChannelBookmark", "app.channel_bookmark.count.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	if count >= model.CHANNEL_BOOKMARKS_PER_CHANNEL_MAX {
+		return nil, model.NewAppError("CreateChannelBookmark", "app.channel_bookmark.create.limit_reached.app_error", map[string]interface{}{"Max": model.CHANNEL_BOOKMARKS_PER_CHANNEL_MAX}, "", http.StatusBadRequest)
+	}
+
+	bookmark.Id = ""
+	bookmark.OwnerId = userId
+	if bookmark.Type == model.CHANNEL_BOOKMARK_FILE {
+		if appErr := a.validateChannelBookmarkFile(bookmark, userId); appErr != nil {
+			return nil, appErr
+		}
+	}
+
+	savedBookmark, err := a.Srv().Store.ChannelBookmark().Save(bookmark)
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("CreateChannelBookmark", "app.channel_bookmark.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	a.sendChannelBookmarkEvent(model.WEBSOCKET_EVENT_CHANNEL_BOOKMARK_CREATED, savedBookmark)
+
+	return savedBookmark, nil
+}
+
+func (a *App) PatchChannelBookmark(bookmark *model.ChannelBookmark, patch *model.ChannelBookmarkPatch, userId string) (*model.ChannelBookmark, *model.AppError) {
+	bookmark = bookmark.Clone()
+	oldFileId := bookmark.FileId
+	bookmark.Patch(patch)
+
+	if bookmark.Type == model.CHANNEL_BOOKMARK_FILE && bookmark.FileId != oldFileId {
+		if appErr := a.validateChannelBookmarkFile(bookmark, userId); appErr != nil {
+			return nil, appErr
+		}
+	}
+
+	updatedBookmark, err := a.Srv().Store.ChannelBookmark().Update(bookmark)
+	if err != nil {
+		var appErr *model.AppError
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("PatchChannelBookmark", "app.channel_bookmark.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("PatchChannelBookmark", "app.channel_bookmark.update.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	a.sendChannelBookmarkEvent(model.WEBSOCKET_EVENT_CHANNEL_BOOKMARK_UPDATED, updatedBookmark)
+
+	return updatedBookmark, nil
+}
+
+func (a *App) DeleteChannelBookmark(bookmark *model.ChannelBookmark) (*model.ChannelBookmark, *model.AppError) {
+	deleteAt := model.GetMillis()
+	if err := a.Srv().Store.ChannelBookmark().Delete(bookmark.Id, deleteAt); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("DeleteChannelBookmark", "app.channel_bookmark.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("DeleteChannelBookmark", "app.channel_bookmark.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	deletedBookmark := bookmark.Clone()
+	deletedBookmark.DeleteAt = deleteAt
+	deletedBookmark.UpdateAt = deleteAt
+
+	a.sendChannelBookmarkEvent(model.WEBSOCKET_EVENT_CHANNEL_BOOKMARK_DELETED, deletedBookmark)
+
+	return deletedBookmark, nil
+}
+
+// validateChannelBookmarkFile makes sure a file bookmark only points at a file the user could
+// already see in the bookmarked channel, either because they uploaded it or because it was posted there.
+func (a *App) validateChannelBookmarkFile(bookmark *model.ChannelBookmark, userId string) *model.AppError {
+	fileInfo, appErr := a.GetFileInfo(bookmark.FileId)
+	if appErr != nil {
+		return appErr
+	}
+
+	if fileInfo.PostId == "" {
+		if fileInfo.CreatorId != userId {
+			return model.NewAppError("validateChannelBookmarkFile", "app.channel_bookmark.file.invalid.app_error", nil, "file_id="+fileInfo.Id, http.StatusBadRequest)
+		}
+		return nil
+	}
+
+	post, appErr := a.GetSinglePost(fileInfo.PostId)
+	if appErr != nil {
+		return appErr
+	}
+
+	if post.ChannelId != bookmark.ChannelId {
+		return model.NewAppError("validateChannelBookmarkFile", "app.channel_bookmark.file.invalid.app_error", nil, "file_id="+fileInfo.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (a *App) sendChannelBookmarkEvent(event string, bookmark *model.ChannelBookmark) {
+	message := model.NewWebSocketEvent(event, "", bookmark.ChannelId, "", nil)
+	message.Add("bookmark", bookmark.ToJson())
+	a.Publish(message)
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+func (a *App) CreateChannelGuestLink(link *model.ChannelGuestLink, creatorId string) (*model.ChannelGuestLink, *model.AppError) {
+	channel, appErr := a.GetChannel(link.ChannelId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if channel.DeleteAt != 0 || (channel.Type != model.CHANNEL_OPEN && channel.Type != model.CHANNEL_PRIVATE) {
+		return nil, model.NewAppError("CreateChannelGuestLink", "app.channel_guest_link.invalid_channel.app_error", nil, "channel_id="+channel.Id, http.StatusBadRequest)
+	}
+
+	if channel.IsGroupConstrained() {
+		return nil, model.NewAppError("CreateChannelGuestLink", "app.channel_guest_link.group_constrained.app_error", nil, "channel_id="+channel.Id, http.StatusBadRequest)
+	}
+
+	link.Id = ""
+	link.Token = ""
+	link.TeamId = channel.TeamId
+	link.CreatorId = creatorId
+	link.DeleteAt = 0
+
+	count, err := a.Srv().Store.ChannelGuestLink().CountForChannel(link.ChannelId)
+	if err != nil {
+		return nil, model.NewAppError("CreateChannelGuestLink", "app.channel_guest_link.get_for_channel.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	if count >= model.CHANNEL_GUEST_LINKS_PER_CHANNEL_MAX {
+		return nil, model.NewAppError("CreateChannelGuestLink", "app.channel_guest_link.create.limit.app_error", map[string]interface{}{"Max": model.CHANNEL_GUEST_LINKS_PER_CHANNEL_MAX}, "", http.StatusBadRequest)
+	}
+
+	savedLink, err := a.Srv().Store.ChannelGuestLink().Save(link)
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("CreateChannelGuestLink", "app.channel_guest_link.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return savedLink, nil
+}
+
+func (a *App) GetChannelGuestLink(linkId string) (*model.ChannelGuestLink, *model.AppError) {
+	link, err := a.Srv().Store.ChannelGuestLink().Get(linkId)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetChannelGuestLink", "app.channel_guest_link.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetChannelGuestLink", "app.channel_guest_link.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return link, nil
+}
+
+func (a *App) GetChannelGuestLinks(channelId string) ([]*model.ChannelGuestLink, *model.AppError) {
+	links, err := a.Srv().Store.ChannelGuestLink().GetForChannel(channelId)
+	if err != nil {
+		return nil, model.NewAppError("GetChannelGuestLinks", "app.channel_guest_link.get_for_channel.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return links, nil
+}
+
+func (a *App) RevokeChannelGuestLink(linkId string) *model.AppError {
+	if err := a.Srv().Store.ChannelGuestLink().Revoke(linkId, model.GetMillis()); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("RevokeChannelGuestLink", "app.channel_guest_link.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return model.NewAppError("RevokeChannelGuestLink", "app.channel_guest_link.revoke.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return nil
+}
+
+// CreateGuestWithChannelGuestLink creates a guest account through the channel guest link with the
+// given token, and adds it to the channel of the link and to its team. Guests only see the channels
+// they are members of, so the account is limited to that channel until given access to others.
+func (a *App) CreateGuestWithChannelGuestLink(user *model.User, token string) (*model.User, *model.AppError) {
+	if err := a.IsUserSignUpAllowed(); err != nil {
+		return nil, err
+	}
+
+	link, err := a.Srv().Store.ChannelGuestLink().GetByToken(token)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("CreateGuestWithChannelGuestLink", "app.channel_guest_link.invalid.app_error", nil, nfErr.Error(), http.StatusBadRequest)
+		default:
+			return nil, model.NewAppError("CreateGuestWithChannelGuestLink", "app.channel_guest_link.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	if link.IsExpired(model.GetMillis()) {
+		return nil, model.NewAppError("CreateGuestWithChannelGuestLink", "app.channel_guest_link.expired.app_error", nil, "id="+link.Id, http.StatusBadRequest)
+	}
+
+	if link.IsExhausted() {
+		return nil, model.NewAppError("CreateGuestWithChannelGuestLink", "app.channel_guest_link.exhausted.app_error", nil, "id="+link.Id, http.StatusBadRequest)
+	}
+
+	if !CheckUserDomain(user, link.AllowedDomains) {
+		return nil, model.NewAppError("CreateGuestWithChannelGuestLink", "app.channel_guest_link.invalid_email.app_error", map[string]interface{}{"Addresses": link.AllowedDomains}, "id="+link.Id, http.StatusForbidden)
+	}
+
+	channel, appErr := a.GetChannel(link.ChannelId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	team, appErr := a.GetTeam(channel.TeamId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if channel.DeleteAt != 0 || team.DeleteAt != 0 {
+		return nil, model.NewAppError("CreateGuestWithChannelGuestLink", "app.channel_guest_link.invalid.app_error", nil, "channel_id="+channel.Id, http.StatusBadRequest)
+	}
+
+	user.EmailVerified = false
+
+	ruser, appErr := a.CreateGuest(user)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	// The use is only recorded once the guest was created, so that failing sign ups don't use up the
+	// link. Guests created past the limit by concurrent sign ups are deleted right away.
+	if err := a.Srv().Store.ChannelGuestLink().IncrementUseCount(link.Id, model.GetMillis()); err != nil {
+		if appErr := a.PermanentDeleteUser(ruser); appErr != nil {
+			mlog.Error("Failed to delete a guest created past the limits of a channel guest link.", mlog.String("user_id", ruser.Id), mlog.Err(appErr))
+		}
+
+		var ltErr *store.ErrLimitExceeded
+		switch {
+		case errors.As(err, &ltErr):
+			return nil, model.NewAppError("CreateGuestWithChannelGuestLink", "app.channel_guest_link.exhausted.app_error", nil, ltErr.Error(), http.StatusBadRequest)
+		default:
+			return nil, model.NewAppError("CreateGuestWithChannelGuestLink", "app.channel_guest_link.increment_use_count.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	if appErr := a.JoinUserToTeam(team, ruser, ""); appErr != nil {
+		return nil, appErr
+	}
+
+	if _, appErr := a.AddChannelMember(ruser.Id, channel, "", ""); appErr != nil {
+		return nil, appErr
+	}
+
+	return ruser, nil
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+// AddChannelIntegration allows a bot, an incoming webhook or a slash command in the channel. Webhooks
+// and commands have to belong to the team of the channel.
+func (a *App) AddChannelIntegration(channel *model.Channel, integration *model.ChannelIntegration) (*model.ChannelIntegration, *model.AppError) {
+	integration.ChannelId = channel.Id
+
+	var teamId string
+	switch integration.Type {
+	case model.CHANNEL_INTEGRATION_TYPE_BOT:
+		if _, err := a.GetBot(integration.IntegrationId, false); err != nil {
+			return nil, err
+		}
+	case model.CHANNEL_INTEGRATION_TYPE_INCOMING_WEBHOOK:
+		hook, err := a.GetIncomingWebhook(integration.IntegrationId)
+		if err != nil {
+			return nil, err
+		}
+		teamId = hook.TeamId
+	case model.CHANNEL_INTEGRATION_TYPE_COMMAND:
+		cmd, err := a.GetCommand(integration.IntegrationId)
+		if err != nil {
+			return nil, err
+		}
+		teamId = cmd.TeamId
+	}
+
+	if teamId != "" && teamId != channel.TeamId {
+		return nil, model.NewAppError("AddChannelIntegration", "app.channel_integration.add.wrong_team.app_error", nil, "integration_id="+integration.IntegrationId, http.StatusBadRequest)
+	}
+
+	savedIntegration, err := a.Srv().Store.ChannelIntegration().Save(integration)
+	if err != nil {
+		var appErr *model.AppError
+		var cErr *store.ErrConflict
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &cErr):
+			return nil, model.NewAppError("AddChannelIntegration", "app.channel_integration.add.exists.app_error", nil, cErr.Error(), http.StatusBadRequest)
+		default:
+			return nil, model.NewAppError("AddChannelIntegration", "app.channel_integration.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return savedIntegration, nil
+}
+
+func (a *App) GetChannelIntegrations(channelId string) ([]*model.ChannelIntegration, *model.AppError) {
+	integrations, err := a.Srv().Store.ChannelIntegration().GetForChannel(channelId)
+	if err != nil {
+		return nil, model.NewAppError("GetChannelIntegrations", "app.channel_integration.get_for_channel.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return integrations, nil
+}
+
+func (a *App) RemoveChannelIntegration(channelId string, integrationId string) *model.AppError {
+	if err := a.Srv().Store.ChannelIntegration().Delete(channelId, integrationId); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("RemoveChannelIntegration", "app.channel_integration.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return model.NewAppError("RemoveChannelIntegration", "app.channel_integration.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return nil
+}
+
+// checkIntegrationAllowedInChannel returns an error if the channel restricts its integrations and
+// the given bot, incoming webhook or slash command isn't allowed there.
+func (a *App) checkIntegrationAllowedInChannel(channel *model.Channel, integrationId string) *model.AppError {
+	if !channel.IsIntegrationsRestricted() {
+		return nil
+	}
+
+	if _, err := a.Srv().Store.ChannelIntegration().Get(channel.Id, integrationId); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("checkIntegrationAllowedInChannel", "app.channel_integration.not_allowed.app_error", nil, "channel_id="+channel.Id+", integration_id="+integrationId, http.StatusForbidden)
+		default:
+			return model.NewAppError("checkIntegrationAllowedInChannel", "app.channel_integration.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,440 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/services/backup"
+)
+
+const (
+	CHANNEL_SNAPSHOT_BATCH_SIZE = 1000
+
+	channelSnapshotFile      = "file"
+	channelSnapshotThumbnail = "thumbnail"
+	channelSnapshotPreview   = "preview"
+)
+
+// ChannelSnapshotOptions configures SnapshotChannel.
+type ChannelSnapshotOptions struct {
+	// IncludeFiles copies the files attached to the posts into the snapshot. Without it the posts
+	// are restored without their attachments.
+	IncludeFiles bool
+	// Passphrase encrypts the snapshot when set.
+	Passphrase string
+}
+
+// channelSnapshotEntry is the name of the archive entry holding a file, or its thumbnail or preview,
+// in a channel snapshot. Files are stored by id since their file store paths aren't exported.
+func channelSnapshotEntry(fileId, kind string) string {
+	return backup.FILES_PREFIX + fileId + "/" + kind
+}
+
+// SnapshotChannel writes the current state of a channel, its members and undeleted posts along with
+// the files attached to them, to w as a backup archive that RestoreChannelSnapshot can restore into a
+// new channel.
+func (a *App) SnapshotChannel(channelId string, w io.Writer, opts ChannelSnapshotOptions) (*backup.Manifest, *model.AppError) {
+	channel, appErr := a.GetChannel(channelId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	manifest := &backup.Manifest{
+		ServerVersion: model.CurrentVersion,
+		SchemaVersion: a.Srv().Store.GetCurrentSchemaVersion(),
+		CreateAt:      model.GetMillis(),
+		IncludesFiles: opts.IncludeFiles,
+		ChannelId:     channel.Id,
+	}
+	manifest.Until = manifest.CreateAt
+
+	bw, err := backup.NewWriter(w, opts.Passphrase)
+	if err != nil {
+		return nil, model.NewAppError("SnapshotChannel", "app.channel_snapshot.create.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	data, err := bw.Create(backup.DATA_ENTRY)
+	if err != nil {
+		return nil, model.NewAppError("SnapshotChannel", "app.channel_snapshot.create.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	files, appErr := a.exportChannelSnapshotData(data, channel)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if opts.IncludeFiles {
+		manifest.Files, appErr = a.copyChannelSnapshotFiles(bw, files)
+		if appErr != nil {
+			return nil, appErr
+		}
+	}
+
+	if err := bw.Close(manifest); err != nil {
+		return nil, model.NewAppError("SnapshotChannel", "app.channel_snapshot.create.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return manifest, nil
+}
+
+// exportChannelSnapshotData streams the data of the snapshot to w and returns the files the posts
+// reference.
+func (a *App) exportChannelSnapshotData(w io.Writer, channel *model.Channel) ([]*model.FileInfo, *model.AppError) {
+	writeLine := func(line *model.ChannelSnapshotLine) *model.AppError {
+		if _, err := io.WriteString(w, line.ToJson()+"\n"); err != nil {
+			return model.NewAppError("SnapshotChannel", "app.channel_snapshot.create.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		return nil
+	}
+
+	if appErr := writeLine(&model.ChannelSnapshotLine{Type: model.CHANNEL_SNAPSHOT_LINE_TYPE_CHANNEL, Channel: channel}); appErr != nil {
+		return nil, appErr
+	}
+
+	for offset := 0; ; offset += CHANNEL_SNAPSHOT_BATCH_SIZE {
+		members, appErr := a.Srv().Store.Channel().GetMembers(channel.Id, offset, CHANNEL_SNAPSHOT_BATCH_SIZE)
+		if appErr != nil {
+			return nil, appErr
+		}
+
+		for i := range *members {
+			if appErr := writeLine(&model.ChannelSnapshotLine{Type: model.CHANNEL_SNAPSHOT_LINE_TYPE_MEMBER, Member: &(*members)[i]}); appErr != nil {
+				return nil, appErr
+			}
+		}
+
+		if len(*members) < CHANNEL_SNAPSHOT_BATCH_SIZE {
+			break
+		}
+	}
+
+	files := []*model.FileInfo{}
+	cursor := model.ChannelSnapshotCursor{}
+	for {
+		posts, appErr := a.Srv().Store.Post().GetPostsForChannelSnapshot(channel.Id, cursor, CHANNEL_SNAPSHOT_BATCH_SIZE)
+		if appErr != nil {
+			return nil, appErr
+		}
+
+		for _, post := range posts {
+			if appErr := writeLine(&model.ChannelSnapshotLine{Type: model.CHANNEL_SNAPSHOT_LINE_TYPE_POST, Post: post}); appErr != nil {
+				return nil, appErr
+			}
+
+			if len(post.FileIds) == 0 {
+				continue
+			}
+
+			infos, appErr := a.Srv().Store.FileInfo().GetForPost(post.Id, false, false, false)
+			if appErr != nil {
+				return nil, appErr
+			}
+
+			for _, info := range infos {
+				if appErr := writeLine(&model.ChannelSnapshotLine{Type: model.CHANNEL_SNAPSHOT_LINE_TYPE_FILE, File: info}); appErr != nil {
+					return nil, appErr
+				}
+				files = append(files, info)
+			}
+		}
+
+		if len(posts) < CHANNEL_SNAPSHOT_BATCH_SIZE {
+			return files, nil
+		}
+
+		last := posts[len(posts)-1]
+		cursor = model.ChannelSnapshotCursor{CreateAt: last.CreateAt, Id: last.Id}
+	}
+}
+
+// copyChannelSnapshotFiles copies the files, along with their thumbnails and previews, into the
+// snapshot. Files missing from the file store are left out, and restoring then skips them.
+func (a *App) copyChannelSnapshotFiles(bw *backup.Writer, infos []*model.FileInfo) ([]backup.File, *model.AppError) {
+	backend, appErr := a.FileBackend()
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	files := []backup.File{}
+	for _, info := range infos {
+		for kind, path := range map[string]string{
+			channelSnapshotFile:      info.Path,
+			channelSnapshotThumbnail: info.ThumbnailPath,
+			channelSnapshotPreview:   info.PreviewPath,
+		} {
+			if path == "" {
+				continue
+			}
+
+			reader, appErr := backend.Reader(path)
+			if appErr != nil {
+				mlog.Warn("Channel snapshot: skipping a file missing from the file store", mlog.String("path", path), mlog.Err(appErr))
+				continue
+			}
+
+			w, err := bw.Create(channelSnapshotEntry(info.Id, kind))
+			if err != nil {
+				reader.Close()
+				return nil, model.NewAppError("SnapshotChannel", "app.channel_snapshot.create.app_error", nil, err.Error(), http.StatusInternalServerError)
+			}
+
+			size, err := io.Copy(w, reader)
+			reader.Close()
+			if err != nil {
+				return nil, model.NewAppError("SnapshotChannel", "app.backup.copy_file.app_error", map[string]interface{}{"Path": path}, err.Error(), http.StatusInternalServerError)
+			}
+
+			files = append(files, backup.File{Path: path, Size: size})
+		}
+	}
+
+	return files, nil
+}
+
+// RestoreChannelSnapshot verifies the channel snapshot at path and restores it into a new channel
+// of the team with the given name. Members that no longer belong to the team are skipped. Posts and
+// files are restored with new ids, keeping their authors and creation times.
+func (a *App) RestoreChannelSnapshot(path string, passphrase string, teamId string, name string) (*model.Channel, *model.AppError) {
+	archive, err := backup.Open(path, passphrase)
+	if err != nil {
+		return nil, model.NewAppError("RestoreChannelSnapshot", "app.backup.open.app_error", nil, err.Error(), http.StatusBadRequest)
+	}
+	defer archive.Close()
+
+	if archive.Manifest.ChannelId == "" {
+		return nil, model.NewAppError("RestoreChannelSnapshot", "app.channel_snapshot.not_a_snapshot.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if err = archive.Verify(); err != nil {
+		return nil, model.NewAppError("RestoreChannelSnapshot", "app.backup.verify.app_error", nil, err.Error(), http.StatusBadRequest)
+	}
+
+	data, err := archive.OpenEntry(backup.DATA_ENTRY)
+	if err != nil {
+		return nil, model.NewAppError("RestoreChannelSnapshot", "app.backup.open.app_error", nil, err.Error(), http.StatusBadRequest)
+	}
+	defer data.Close()
+
+	restorer := &channelSnapshotRestorer{
+		app:     a,
+		archive: archive,
+		teamId:  teamId,
+		name:    name,
+		postIds: map[string]string{},
+	}
+
+	scanner := bufio.NewScanner(data)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxScanTokenSize)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		var line model.ChannelSnapshotLine
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil || !line.IsValid() {
+			return restorer.channel, model.NewAppError("RestoreChannelSnapshot", "app.channel_snapshot.invalid_line.app_error", map[string]interface{}{"Line": lineNumber}, "", http.StatusBadRequest)
+		}
+
+		if appErr := restorer.restore(&line); appErr != nil {
+			return restorer.channel, appErr
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return restorer.channel, model.NewAppError("RestoreChannelSnapshot", "app.backup.open.app_error", nil, err.Error(), http.StatusBadRequest)
+	}
+
+	if appErr := restorer.flushPost(); appErr != nil {
+		return restorer.channel, appErr
+	}
+
+	if restorer.channel == nil {
+		return nil, model.NewAppError("RestoreChannelSnapshot", "app.channel_snapshot.invalid_line.app_error", map[string]interface{}{"Line": 1}, "", http.StatusBadRequest)
+	}
+
+	return restorer.channel, nil
+}
+
+// channelSnapshotRestorer restores the lines of a channel snapshot in order. A post is only saved
+// once the files following it have been restored, so that it can reference their new ids.
+type channelSnapshotRestorer struct {
+	app     *App
+	archive *backup.Archive
+	teamId  string
+	name    string
+
+	channel *model.Channel
+	// postIds maps the ids of the posts of the snapshot to the ids of the restored posts.
+	postIds map[string]string
+	post    *model.Post
+	files   []*model.FileInfo
+}
+
+func (r *channelSnapshotRestorer) restore(line *model.ChannelSnapshotLine) *model.AppError {
+	if r.channel == nil && line.Type != model.CHANNEL_SNAPSHOT_LINE_TYPE_CHANNEL {
+		return model.NewAppError("RestoreChannelSnapshot", "app.channel_snapshot.invalid_line.app_error", map[string]interface{}{"Line": 1}, "", http.StatusBadRequest)
+	}
+
+	switch line.Type {
+	case model.CHANNEL_SNAPSHOT_LINE_TYPE_CHANNEL:
+		return r.restoreChannel(line.Channel)
+	case model.CHANNEL_SNAPSHOT_LINE_TYPE_MEMBER:
+		return r.restoreMember(line.Member)
+	case model.CHANNEL_SNAPSHOT_LINE_TYPE_POST:
+		if appErr := r.flushPost(); appErr != nil {
+			return appErr
+		}
+		r.post = line.Post
+		return nil
+	case model.CHANNEL_SNAPSHOT_LINE_TYPE_FILE:
+		return r.restoreFile(line.File)
+	}
+
+	return nil
+}
+
+func (r *channelSnapshotRestorer) restoreChannel(snapshot *model.Channel) *model.AppError {
+	if r.channel != nil {
+		return model.NewAppError("RestoreChannelSnapshot", "app.channel_snapshot.duplicate_channel.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if snapshot.Type != model.CHANNEL_OPEN && snapshot.Type != model.CHANNEL_PRIVATE {
+		return model.NewAppError("RestoreChannelSnapshot", "app.channel_snapshot.channel_type.app_error", nil, "type="+snapshot.Type, http.StatusBadRequest)
+	}
+
+	channel, appErr := r.app.CreateChannel(&model.Channel{
+		TeamId:      r.teamId,
+		Name:        r.name,
+		DisplayName: snapshot.DisplayName,
+		Type:        snapshot.Type,
+		Header:      snapshot.Header,
+		Purpose:     snapshot.Purpose,
+	}, false)
+	if appErr != nil {
+		return appErr
+	}
+
+	r.channel = channel
+	return nil
+}
+
+func (r *channelSnapshotRestorer) restoreMember(snapshot *model.ChannelMember) *model.AppError {
+	if _, appErr := r.app.Srv().Store.Team().GetMember(r.app.Context(), r.teamId, snapshot.UserId, false); appErr != nil {
+		mlog.Warn("Channel snapshot: skipping a member who doesn't belong to the team", mlog.String("user_id", snapshot.UserId))
+		return nil
+	}
+
+	member := &model.ChannelMember{
+		ChannelId:     r.channel.Id,
+		UserId:        snapshot.UserId,
+		NotifyProps:   snapshot.NotifyProps,
+		SchemeGuest:   snapshot.SchemeGuest,
+		SchemeUser:    snapshot.SchemeUser,
+		SchemeAdmin:   snapshot.SchemeAdmin,
+		ExplicitRoles: snapshot.ExplicitRoles,
+	}
+	if member.NotifyProps == nil {
+		member.NotifyProps = model.GetDefaultChannelNotifyProps()
+	}
+
+	if _, appErr := r.app.Srv().Store.Channel().SaveMember(member); appErr != nil {
+		return appErr
+	}
+
+	r.app.InvalidateCacheForUser(member.UserId)
+	return nil
+}
+
+// restoreFile copies a file of the pending post, with its thumbnail and preview, under a new id.
+func (r *channelSnapshotRestorer) restoreFile(snapshot *model.FileInfo) *model.AppError {
+	if r.post == nil || snapshot.PostId != r.post.Id {
+		mlog.Warn("Channel snapshot: skipping a file that doesn't follow its post", mlog.String("file_id", snapshot.Id))
+		return nil
+	}
+
+	info := *snapshot
+	info.Id = model.NewId()
+	info.PostId = ""
+	info.Path, info.ThumbnailPath, info.PreviewPath = "", "", ""
+
+	pathPrefix := time.Now().Format("20060102") + "/teams/" + r.teamId + "/channels/" + r.channel.Id + "/users/" + info.CreatorId + "/" + info.Id + "/"
+	nameWithoutExtension := strings.TrimSuffix(info.Name, filepath.Ext(info.Name))
+	for _, file := range []struct {
+		kind   string
+		path   string
+		target *string
+	}{
+		{channelSnapshotFile, pathPrefix + info.Name, &info.Path},
+		{channelSnapshotThumbnail, pathPrefix + nameWithoutExtension + "_thumb.jpg", &info.ThumbnailPath},
+		{channelSnapshotPreview, pathPrefix + nameWithoutExtension + "_preview.jpg", &info.PreviewPath},
+	} {
+		reader, err := r.archive.OpenEntry(channelSnapshotEntry(snapshot.Id, file.kind))
+		if err != nil {
+			continue
+		}
+
+		_, appErr := r.app.WriteFile(reader, file.path)
+		reader.Close()
+		if appErr != nil {
+			return appErr
+		}
+
+		*file.target = file.path
+	}
+
+	if info.Path == "" {
+		mlog.Warn("Channel snapshot: skipping a file missing from the snapshot", mlog.String("file_id", snapshot.Id))
+		return nil
+	}
+
+	r.files = append(r.files, &info)
+	return nil
+}
+
+// flushPost saves the pending post along with the files restored for it.
+func (r *channelSnapshotRestorer) flushPost() *model.AppError {
+	if r.post == nil {
+		return nil
+	}
+
+	snapshotId := r.post.Id
+	post := r.post.Clone()
+	files := r.files
+	r.post, r.files = nil, nil
+
+	post.Id = ""
+	post.ChannelId = r.channel.Id
+	post.OriginalId = ""
+	post.ReplyCount = 0
+	post.FileIds = model.StringArray{}
+	for _, info := range files {
+		post.FileIds = append(post.FileIds, info.Id)
+	}
+
+	// A reply whose root wasn't part of the snapshot is restored as a root post.
+	post.RootId, post.ParentId = r.postIds[post.RootId], r.postIds[post.ParentId]
+	if post.RootId == "" {
+		post.ParentId = ""
+	}
+
+	saved, appErr := r.app.Srv().Store.Post().Save(post)
+	if appErr != nil {
+		return appErr
+	}
+	r.postIds[snapshotId] = saved.Id
+
+	for _, info := range files {
+		info.PostId = saved.Id
+		if _, appErr := r.app.Srv().Store.FileInfo().Save(info); appErr != nil {
+			return appErr
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestChannelSnapshot(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	dir, err := ioutil.TempDir("", "snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	info, appErr := th.App.UploadFile([]byte("contents"), th.BasicChannel.Id, "file.txt")
+	require.Nil(t, appErr)
+
+	root, appErr := th.App.CreatePost(&model.Post{UserId: th.BasicUser.Id, ChannelId: th.BasicChannel.Id, Message: "root", FileIds: []string{info.Id}}, th.BasicChannel, false, false)
+	require.Nil(t, appErr)
+	reply, appErr := th.App.CreatePost(&model.Post{UserId: th.BasicUser2.Id, ChannelId: th.BasicChannel.Id, RootId: root.Id, ParentId: root.Id, Message: "reply"}, th.BasicChannel, false, false)
+	require.Nil(t, appErr)
+	deleted := th.CreatePost(th.BasicChannel)
+	_, appErr = th.App.DeletePost(deleted.Id, th.BasicUser.Id)
+	require.Nil(t, appErr)
+
+	path := filepath.Join(dir, "channel.mmbackup")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	manifest, appErr := th.App.SnapshotChannel(th.BasicChannel.Id, f, ChannelSnapshotOptions{IncludeFiles: true, Passphrase: "secret"})
+	require.Nil(t, appErr)
+	require.NoError(t, f.Close())
+	assert.Equal(t, th.BasicChannel.Id, manifest.ChannelId)
+	assert.Len(t, manifest.Files, 1)
+
+	t.Run("restore into a new channel", func(t *testing.T) {
+		channel, appErr := th.App.RestoreChannelSnapshot(path, "secret", th.BasicTeam.Id, "restored-"+model.NewId()[:8])
+		require.Nil(t, appErr)
+		assert.NotEqual(t, th.BasicChannel.Id, channel.Id)
+		assert.Equal(t, th.BasicChannel.DisplayName, channel.DisplayName)
+
+		_, appErr = th.App.GetChannelMember(channel.Id, th.BasicUser.Id)
+		require.Nil(t, appErr)
+
+		posts, appErr := th.App.GetPostsPage(model.GetPostsOptions{ChannelId: channel.Id, PerPage: 60})
+		require.Nil(t, appErr)
+
+		var restoredRoot, restoredReply *model.Post
+		for _, post := range posts.Posts {
+			assert.NotEqual(t, deleted.Message, post.Message)
+			switch post.Message {
+			case root.Message:
+				restoredRoot = post
+			case reply.Message:
+				restoredReply = post
+			}
+		}
+		require.NotNil(t, restoredRoot)
+		require.NotNil(t, restoredReply)
+		assert.Equal(t, root.CreateAt, restoredRoot.CreateAt)
+		assert.Equal(t, restoredRoot.Id, restoredReply.RootId)
+		assert.Equal(t, reply.UserId, restoredReply.UserId)
+
+		infos, appErr := th.App.GetFileInfosForPost(restoredRoot.Id, true)
+		require.Nil(t, appErr)
+		require.Len(t, infos, 1)
+		assert.NotEqual(t, info.Id, infos[0].Id)
+
+		contents, appErr := th.App.ReadFile(infos[0].Path)
+		require.Nil(t, appErr)
+		assert.Equal(t, "contents", string(contents))
+	})
+
+	t.Run("existing channel name", func(t *testing.T) {
+		_, appErr := th.App.RestoreChannelSnapshot(path, "secret", th.BasicTeam.Id, th.BasicChannel.Name)
+		require.NotNil(t, appErr)
+	})
+
+	t.Run("not a snapshot", func(t *testing.T) {
+		backupPath := filepath.Join(dir, "server.mmbackup")
+		f, err := os.Create(backupPath)
+		require.NoError(t, err)
+		_, appErr := th.App.CreateBackup(f, BackupOptions{})
+		require.Nil(t, appErr)
+		require.NoError(t, f.Close())
+
+		_, appErr = th.App.RestoreChannelSnapshot(backupPath, "", th.BasicTeam.Id, "restored-"+model.NewId()[:8])
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.channel_snapshot.not_a_snapshot.app_error", appErr.Id)
+
+		_, appErr, _ = th.App.RestoreBackup(path, "secret", false, 2)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.backup.channel_snapshot.app_error", appErr.Id)
+	})
+}
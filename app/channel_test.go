@@ -4,6 +4,7 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"sort"
@@ -1966,7 +1967,7 @@ func TestGetSidebarCategories(t *testing.T) {
 		// Manually add the user to the team without going through the app layer to simulate a pre-existing user/team
 		// relationship that hasn't been migrated yet
 		team := th.CreateTeam()
-		_, err := th.App.Srv().Store.Team().SaveMember(&model.TeamMember{
+		_, err := th.App.Srv().Store.Team().SaveMember(context.Background(), &model.TeamMember{
 			TeamId:     team.Id,
 			UserId:     th.BasicUser.Id,
 			SchemeUser: true,
@@ -0,0 +1,557 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+const (
+	// ChatImportDataFile is the bulk import file written by the converters of the exports of other
+	// chat platforms.
+	ChatImportDataFile = "import.jsonl"
+	// ChatImportReportFile is the report mapping the entities of the export to the imported ones.
+	ChatImportReportFile = "mapping_report.json"
+	// ChatImportProgressFile records the line a failed import of the converted data resumes from.
+	ChatImportProgressFile = "import_progress.json"
+
+	chatImportAttachmentsDir = "attachments"
+)
+
+var invalidChatImportChannelNameChars = regexp.MustCompile(`[^a-z0-9\-_]+`)
+
+// ChatImportReport maps the users and channels of an export from another chat platform to the
+// usernames and channel names they are imported as, and lists what was left out or altered.
+type ChatImportReport struct {
+	Platform       string            `json:"platform"`
+	Team           string            `json:"team"`
+	Users          map[string]string `json:"users"`
+	Channels       map[string]string `json:"channels"`
+	DirectChannels int               `json:"direct_channels"`
+	Posts          int               `json:"posts"`
+	Replies        int               `json:"replies"`
+	DirectPosts    int               `json:"direct_posts"`
+	Attachments    int               `json:"attachments"`
+	Notes          []ChatImportNote  `json:"notes"`
+}
+
+// ChatImportNote records an entity of the export that was skipped or altered while converted.
+type ChatImportNote struct {
+	Kind     string `json:"kind"`
+	SourceId string `json:"source_id"`
+	Message  string `json:"message"`
+}
+
+type chatImportProgress struct {
+	Line int `json:"line"`
+}
+
+// chatImportConverter collects the entities converted from an export, and writes them to a bulk
+// import file in the order the bulk import expects them.
+type chatImportConverter struct {
+	team      string
+	outputDir string
+	report    *ChatImportReport
+
+	usernames    map[string]bool
+	channelNames map[string]bool
+
+	users          []*UserImportData
+	usersBySource  map[string]*UserImportData
+	channels       []*ChannelImportData
+	posts          []*PostImportData
+	directChannels []*DirectChannelImportData
+	directPosts    []*DirectPostImportData
+}
+
+func newChatImportConverter(platform, team, outputDir string) (*chatImportConverter, error) {
+	if err := os.MkdirAll(filepath.Join(outputDir, chatImportAttachmentsDir), 0700); err != nil {
+		return nil, errors.Wrap(err, "failed to create the output directory")
+	}
+
+	absOutputDir, err := filepath.Abs(outputDir)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve the output directory")
+	}
+
+	return &chatImportConverter{
+		team:      team,
+		outputDir: absOutputDir,
+		report: &ChatImportReport{
+			Platform: platform,
+			Team:     team,
+			Users:    map[string]string{},
+			Channels: map[string]string{},
+			Notes:    []ChatImportNote{},
+		},
+		usernames:     map[string]bool{},
+		channelNames:  map[string]bool{},
+		usersBySource: map[string]*UserImportData{},
+	}, nil
+}
+
+func (c *chatImportConverter) note(kind, sourceId, message string) {
+	c.report.Notes = append(c.report.Notes, ChatImportNote{Kind: kind, SourceId: sourceId, Message: message})
+}
+
+// addUser adds a user of the export to the team, returning the username it is imported as.
+func (c *chatImportConverter) addUser(sourceId, username, email, firstName, lastName string, teamAdmin, deactivated bool) string {
+	name := model.CleanUsername(username)
+	for i := 2; c.usernames[name]; i++ {
+		name = fmt.Sprintf("%s-%d", truncateRunes(model.CleanUsername(username), model.USER_NAME_MAX_LENGTH-4), i)
+	}
+	c.usernames[name] = true
+
+	if name != username {
+		c.note("user", sourceId, fmt.Sprintf("username %q imported as %q", username, name))
+	}
+
+	if email == "" {
+		email = name + "@localhost"
+		c.note("user", sourceId, "no email address, imported as "+email)
+	}
+
+	teamRoles := model.TEAM_USER_ROLE_ID
+	if teamAdmin {
+		teamRoles += " " + model.TEAM_ADMIN_ROLE_ID
+	}
+
+	user := &UserImportData{
+		Username:  model.NewString(name),
+		Email:     model.NewString(strings.ToLower(email)),
+		FirstName: model.NewString(truncateRunes(firstName, model.USER_FIRST_NAME_MAX_RUNES)),
+		LastName:  model.NewString(truncateRunes(lastName, model.USER_LAST_NAME_MAX_RUNES)),
+		Teams: &[]UserTeamImportData{{
+			Name:     model.NewString(c.team),
+			Roles:    model.NewString(teamRoles),
+			Channels: &[]UserChannelImportData{},
+		}},
+	}
+	if deactivated {
+		user.DeleteAt = model.NewInt64(model.GetMillis())
+	}
+
+	c.users = append(c.users, user)
+	c.usersBySource[sourceId] = user
+	c.report.Users[sourceId] = name
+
+	return name
+}
+
+// username returns the username the user of the export is imported as, or "" if it isn't.
+func (c *chatImportConverter) username(sourceId string) string {
+	if user, ok := c.usersBySource[sourceId]; ok {
+		return *user.Username
+	}
+	return ""
+}
+
+// addChannel adds a channel of the export to the team, returning the name it is imported as.
+func (c *chatImportConverter) addChannel(sourceId, name, displayName, channelType, header, purpose string) string {
+	channelName := strings.Trim(invalidChatImportChannelNameChars.ReplaceAllString(strings.ToLower(name), "-"), "-_")
+	channelName = truncateRunes(channelName, model.CHANNEL_NAME_MAX_LENGTH-4)
+	if !model.IsValidChannelIdentifier(channelName) {
+		channelName = "channel-" + strings.ToLower(model.NewId())
+	}
+
+	uniqueName := channelName
+	for i := 2; c.channelNames[uniqueName]; i++ {
+		uniqueName = fmt.Sprintf("%s-%d", channelName, i)
+	}
+	c.channelNames[uniqueName] = true
+
+	if uniqueName != name {
+		c.note("channel", sourceId, fmt.Sprintf("channel %q imported as %q", name, uniqueName))
+	}
+
+	if displayName == "" {
+		displayName = name
+	}
+
+	c.channels = append(c.channels, &ChannelImportData{
+		Team:        model.NewString(c.team),
+		Name:        model.NewString(uniqueName),
+		DisplayName: model.NewString(truncateRunes(displayName, model.CHANNEL_DISPLAY_NAME_MAX_RUNES)),
+		Type:        model.NewString(channelType),
+		Header:      model.NewString(truncateRunes(header, model.CHANNEL_HEADER_MAX_RUNES)),
+		Purpose:     model.NewString(truncateRunes(purpose, model.CHANNEL_PURPOSE_MAX_RUNES)),
+	})
+	c.report.Channels[sourceId] = uniqueName
+
+	return uniqueName
+}
+
+// joinChannel makes the user of the export a member of the imported channel.
+func (c *chatImportConverter) joinChannel(userSourceId, channelName string) {
+	user, ok := c.usersBySource[userSourceId]
+	if !ok {
+		return
+	}
+
+	team := &(*user.Teams)[0]
+	for _, channel := range *team.Channels {
+		if *channel.Name == channelName {
+			return
+		}
+	}
+
+	*team.Channels = append(*team.Channels, UserChannelImportData{
+		Name:  model.NewString(channelName),
+		Roles: model.NewString(model.CHANNEL_USER_ROLE_ID),
+	})
+}
+
+// message truncates the message of a post to the size the bulk import accepts.
+func (c *chatImportConverter) message(sourceId, message string) string {
+	if len([]rune(message)) > model.POST_MESSAGE_MAX_RUNES_V1 {
+		c.note("message", sourceId, "message truncated")
+		return truncateRunes(message, model.POST_MESSAGE_MAX_RUNES_V1)
+	}
+	return message
+}
+
+// addAttachment copies a file of the export to the output directory, returning the attachment
+// referencing it, or nil if the file can't be copied.
+func (c *chatImportConverter) addAttachment(sourceId, path, fileName string) *AttachmentImportData {
+	in, err := os.Open(path)
+	if err != nil {
+		c.note("attachment", sourceId, "file not found in the export")
+		return nil
+	}
+	defer in.Close()
+
+	fileName = filepath.Base(fileName)
+	if fileName == "." || fileName == string(filepath.Separator) {
+		fileName = "file"
+	}
+
+	dst := filepath.Join(c.outputDir, chatImportAttachmentsDir, strings.ToLower(model.NewId())+"_"+fileName)
+	out, err := os.Create(dst)
+	if err != nil {
+		c.note("attachment", sourceId, "unable to copy the file: "+err.Error())
+		return nil
+	}
+	defer out.Close()
+
+	if _, err := io.Copy(out, in); err != nil {
+		c.note("attachment", sourceId, "unable to copy the file: "+err.Error())
+		return nil
+	}
+
+	c.report.Attachments++
+	return &AttachmentImportData{Path: model.NewString(dst)}
+}
+
+func (c *chatImportConverter) addPost(post *PostImportData) {
+	c.posts = append(c.posts, post)
+	c.report.Posts++
+	if post.Replies != nil {
+		c.report.Replies += len(*post.Replies)
+	}
+}
+
+// addDirectChannel adds a direct or group channel between the given users, returning their
+// usernames, or nil if the channel can't be imported.
+func (c *chatImportConverter) addDirectChannel(sourceId string, memberSourceIds []string) []string {
+	members := []string{}
+	for _, memberSourceId := range memberSourceIds {
+		if username := c.username(memberSourceId); username != "" {
+			members = append(members, username)
+		}
+	}
+	members = model.RemoveDuplicateStrings(members)
+	sort.Strings(members)
+
+	if len(members) < 2 || len(members) > model.CHANNEL_GROUP_MAX_USERS {
+		c.note("direct_channel", sourceId, fmt.Sprintf("direct channels must have between 2 and %d members, skipped", model.CHANNEL_GROUP_MAX_USERS))
+		return nil
+	}
+
+	c.directChannels = append(c.directChannels, &DirectChannelImportData{Members: &members})
+	c.report.DirectChannels++
+
+	return members
+}
+
+func (c *chatImportConverter) addDirectPost(post *DirectPostImportData) {
+	c.directPosts = append(c.directPosts, post)
+	c.report.DirectPosts++
+	if post.Replies != nil {
+		c.report.Replies += len(*post.Replies)
+	}
+}
+
+// chatImportMessage is a message of the export converted for the bulk import, along with its
+// replies when it starts a thread.
+type chatImportMessage struct {
+	sourceId    string
+	user        string
+	message     string
+	createAt    int64
+	reactions   []ReactionImportData
+	attachments []AttachmentImportData
+	replies     []*chatImportMessage
+}
+
+func (m *chatImportMessage) isEmpty() bool {
+	return m.message == "" && len(m.attachments) == 0
+}
+
+func sortChatImportMessages(messages []*chatImportMessage) {
+	sort.SliceStable(messages, func(i, j int) bool {
+		return messages[i].createAt < messages[j].createAt
+	})
+}
+
+func (c *chatImportConverter) convertReplies(thread *chatImportMessage) *[]ReplyImportData {
+	sortChatImportMessages(thread.replies)
+
+	replies := []ReplyImportData{}
+	for _, reply := range thread.replies {
+		if reply.isEmpty() {
+			continue
+		}
+
+		createAt := reply.createAt
+		if createAt < thread.createAt {
+			createAt = thread.createAt
+		}
+
+		data := ReplyImportData{
+			User:     model.NewString(reply.user),
+			Message:  model.NewString(c.message(reply.sourceId, reply.message)),
+			CreateAt: model.NewInt64(createAt),
+		}
+		if len(reply.reactions) > 0 {
+			reactions := chatImportReactionsAt(reply.reactions, createAt)
+			data.Reactions = &reactions
+		}
+		if len(reply.attachments) > 0 {
+			attachments := reply.attachments
+			data.Attachments = &attachments
+		}
+		replies = append(replies, data)
+	}
+
+	if len(replies) == 0 {
+		return nil
+	}
+	return &replies
+}
+
+func chatImportReactionsAt(reactions []ReactionImportData, createAt int64) []ReactionImportData {
+	for i := range reactions {
+		reactions[i].CreateAt = model.NewInt64(createAt)
+	}
+	return reactions
+}
+
+// addThreads adds the threads of a channel, or of a direct channel between the given members.
+func (c *chatImportConverter) addThreads(channelName string, members []string, threads []*chatImportMessage) {
+	sortChatImportMessages(threads)
+
+	for _, thread := range threads {
+		if thread.isEmpty() {
+			continue
+		}
+
+		message := model.NewString(c.message(thread.sourceId, thread.message))
+		createAt := model.NewInt64(thread.createAt)
+		replies := c.convertReplies(thread)
+
+		var reactions *[]ReactionImportData
+		if len(thread.reactions) > 0 {
+			r := chatImportReactionsAt(thread.reactions, thread.createAt)
+			reactions = &r
+		}
+
+		var attachments *[]AttachmentImportData
+		if len(thread.attachments) > 0 {
+			attachments = &thread.attachments
+		}
+
+		if members != nil {
+			c.addDirectPost(&DirectPostImportData{
+				ChannelMembers: &members,
+				User:           model.NewString(thread.user),
+				Message:        message,
+				CreateAt:       createAt,
+				Reactions:      reactions,
+				Replies:        replies,
+				Attachments:    attachments,
+			})
+			continue
+		}
+
+		c.addPost(&PostImportData{
+			Team:        model.NewString(c.team),
+			Channel:     model.NewString(channelName),
+			User:        model.NewString(thread.user),
+			Message:     message,
+			CreateAt:    createAt,
+			Reactions:   reactions,
+			Replies:     replies,
+			Attachments: attachments,
+		})
+	}
+}
+
+// write writes the bulk import file and the mapping report to the output directory.
+func (c *chatImportConverter) write() error {
+	f, err := os.Create(filepath.Join(c.outputDir, ChatImportDataFile))
+	if err != nil {
+		return errors.Wrap(err, "failed to create the import file")
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	encoder := json.NewEncoder(w)
+
+	lines := []LineImportData{{Type: "version", Version: model.NewInt(1)}}
+	for _, channel := range c.channels {
+		lines = append(lines, LineImportData{Type: "channel", Channel: channel})
+	}
+	for _, user := range c.users {
+		lines = append(lines, LineImportData{Type: "user", User: user})
+	}
+	for _, post := range c.posts {
+		lines = append(lines, LineImportData{Type: "post", Post: post})
+	}
+	for _, directChannel := range c.directChannels {
+		lines = append(lines, LineImportData{Type: "direct_channel", DirectChannel: directChannel})
+	}
+	for _, directPost := range c.directPosts {
+		lines = append(lines, LineImportData{Type: "direct_post", DirectPost: directPost})
+	}
+
+	for _, line := range lines {
+		if err := encoder.Encode(line); err != nil {
+			return errors.Wrap(err, "failed to write the import file")
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		return errors.Wrap(err, "failed to write the import file")
+	}
+
+	report, err := json.MarshalIndent(c.report, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to encode the mapping report")
+	}
+
+	return errors.Wrap(ioutil.WriteFile(filepath.Join(c.outputDir, ChatImportReportFile), report, 0600), "failed to write the mapping report")
+}
+
+// readChatImportJson reads a file of an export holding either a JSON array of documents or one
+// document per line, as written by mongoexport.
+func readChatImportJson(path string, each func(data json.RawMessage) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	for {
+		b, err := r.Peek(1)
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if b[0] != ' ' && b[0] != '\t' && b[0] != '\r' && b[0] != '\n' {
+			break
+		}
+		r.ReadByte()
+	}
+
+	decoder := json.NewDecoder(r)
+	if b, _ := r.Peek(1); len(b) == 1 && b[0] == '[' {
+		var documents []json.RawMessage
+		if err := decoder.Decode(&documents); err != nil {
+			return errors.Wrapf(err, "failed to decode %s", filepath.Base(path))
+		}
+		for _, document := range documents {
+			if err := each(document); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for {
+		var document json.RawMessage
+		if err := decoder.Decode(&document); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return errors.Wrapf(err, "failed to decode %s", filepath.Base(path))
+		}
+		if err := each(document); err != nil {
+			return err
+		}
+	}
+}
+
+// HasChatImportProgress reports whether a failed import of the converted data in outputDir can be
+// resumed.
+func HasChatImportProgress(outputDir string) bool {
+	_, err := os.Stat(filepath.Join(outputDir, ChatImportProgressFile))
+	return err == nil
+}
+
+// ImportConvertedChatExport runs the bulk import of the data converted into outputDir. When a
+// previous import failed, it resumes from the line that failed. A failing import records the line
+// it failed at, so that it can be resumed once the cause is fixed.
+func (a *App) ImportConvertedChatExport(outputDir string, dryRun bool, workers int) (*model.AppError, int) {
+	progressPath := filepath.Join(outputDir, ChatImportProgressFile)
+
+	var progress chatImportProgress
+	if data, err := ioutil.ReadFile(progressPath); err == nil {
+		if err := json.Unmarshal(data, &progress); err != nil {
+			return model.NewAppError("ImportConvertedChatExport", "app.chat_import.progress.app_error", nil, err.Error(), http.StatusInternalServerError), 0
+		}
+	}
+
+	f, err := os.Open(filepath.Join(outputDir, ChatImportDataFile))
+	if err != nil {
+		return model.NewAppError("ImportConvertedChatExport", "app.chat_import.open.app_error", nil, err.Error(), http.StatusInternalServerError), 0
+	}
+	defer f.Close()
+
+	appErr, lineNumber := a.ResumeBulkImport(f, dryRun, workers, progress.Line)
+	if dryRun {
+		return appErr, lineNumber
+	}
+
+	if appErr != nil {
+		if lineNumber > 0 {
+			data, _ := json.Marshal(chatImportProgress{Line: lineNumber})
+			if err := ioutil.WriteFile(progressPath, data, 0600); err != nil {
+				return model.NewAppError("ImportConvertedChatExport", "app.chat_import.progress.app_error", nil, err.Error(), http.StatusInternalServerError), lineNumber
+			}
+		}
+		return appErr, lineNumber
+	}
+
+	if err := os.Remove(progressPath); err != nil && !os.IsNotExist(err) {
+		return model.NewAppError("ImportConvertedChatExport", "app.chat_import.progress.app_error", nil, err.Error(), http.StatusInternalServerError), 0
+	}
+
+	return nil, 0
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeChatImportFiles(t *testing.T, dir string, files map[string]string) {
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+	}
+}
+
+func readChatImportLines(t *testing.T, outputDir string) map[string][]LineImportData {
+	f, err := os.Open(filepath.Join(outputDir, ChatImportDataFile))
+	require.NoError(t, err)
+	defer f.Close()
+
+	lines := map[string][]LineImportData{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var line LineImportData
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		lines[line.Type] = append(lines[line.Type], line)
+	}
+	require.NoError(t, scanner.Err())
+
+	return lines
+}
+
+func TestConvertRocketChatExport(t *testing.T) {
+	exportDir, err := ioutil.TempDir("", "rocketchat")
+	require.NoError(t, err)
+	defer os.RemoveAll(exportDir)
+
+	writeChatImportFiles(t, exportDir, map[string]string{
+		"users.json": `{"_id":"u1","username":"alice","name":"Alice Smith","emails":[{"address":"alice@example.com"}],"roles":["admin","user"],"active":true}
+{"_id":"u2","username":"Bob.Jones","name":"Bob","emails":[{"address":"bob@example.com"}],"roles":["user"],"active":false}
+{"_id":"u3","name":"No Username"}`,
+		"rocketchat_room.json": `[
+{"_id":"r1","t":"c","name":"general","fname":"General","topic":"Everything"},
+{"_id":"r2","t":"d","uids":["u1","u2"]},
+{"_id":"r3","t":"l","name":"livechat"}]`,
+		"rocketchat_subscription.json": `{"rid":"r1","u":{"_id":"u1"}}`,
+		"rocketchat_message.json": `{"_id":"m1","rid":"r1","msg":"hello @Bob.Jones","ts":{"$date":"2020-01-01T10:00:00.000Z"},"u":{"_id":"u1","username":"alice"},"reactions":{":smile:":{"usernames":["Bob.Jones"]}}}
+{"_id":"m2","rid":"r1","msg":"reply","ts":{"$date":{"$numberLong":"1577872900000"}},"u":{"_id":"u2","username":"Bob.Jones"},"tmid":"m1"}
+{"_id":"m3","rid":"r1","msg":"","ts":{"$date":1577872950000},"u":{"_id":"u1","username":"alice"},"file":{"_id":"f1","name":"notes.txt"}}
+{"_id":"m4","rid":"r1","msg":"Bob.Jones joined","t":"uj","ts":{"$date":1577872960000},"u":{"_id":"u2","username":"Bob.Jones"}}
+{"_id":"m5","rid":"r2","msg":"private","ts":"2020-01-01T10:05:00Z","u":{"_id":"u2","username":"Bob.Jones"}}`,
+		"uploads/f1": "file contents",
+	})
+
+	outputDir := filepath.Join(exportDir, "output")
+	report, err := ConvertRocketChatExport(exportDir, "team", outputDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"u1": "alice", "u2": "bob.jones"}, report.Users)
+	assert.Equal(t, map[string]string{"r1": "general"}, report.Channels)
+	assert.Equal(t, 1, report.DirectChannels)
+	assert.Equal(t, 2, report.Posts)
+	assert.Equal(t, 1, report.Replies)
+	assert.Equal(t, 1, report.DirectPosts)
+	assert.Equal(t, 1, report.Attachments)
+
+	lines := readChatImportLines(t, outputDir)
+	require.Len(t, lines["version"], 1)
+	require.Len(t, lines["channel"], 1)
+	assert.Equal(t, "Everything", *lines["channel"][0].Channel.Header)
+
+	require.Len(t, lines["user"], 2)
+	alice := lines["user"][0].User
+	assert.Equal(t, "alice@example.com", *alice.Email)
+	assert.Equal(t, "Smith", *alice.LastName)
+	assert.Equal(t, "team_user team_admin", *(*alice.Teams)[0].Roles)
+	assert.Equal(t, "general", *(*(*alice.Teams)[0].Channels)[0].Name)
+	assert.NotNil(t, lines["user"][1].User.DeleteAt)
+
+	require.Len(t, lines["post"], 2)
+	post := lines["post"][0].Post
+	assert.Equal(t, "hello @bob.jones", *post.Message)
+	require.Len(t, *post.Replies, 1)
+	assert.Equal(t, "reply", *(*post.Replies)[0].Message)
+	require.Len(t, *post.Reactions, 1)
+	assert.Equal(t, "smile", *(*post.Reactions)[0].EmojiName)
+
+	require.Len(t, *lines["post"][1].Post.Attachments, 1)
+	contents, err := ioutil.ReadFile(*(*lines["post"][1].Post.Attachments)[0].Path)
+	require.NoError(t, err)
+	assert.Equal(t, "file contents", string(contents))
+
+	require.Len(t, lines["direct_post"], 1)
+	assert.Equal(t, "private", *lines["direct_post"][0].DirectPost.Message)
+
+	_, err = os.Stat(filepath.Join(outputDir, ChatImportReportFile))
+	assert.NoError(t, err)
+}
+
+func TestConvertZulipExport(t *testing.T) {
+	exportDir, err := ioutil.TempDir("", "zulip")
+	require.NoError(t, err)
+	defer os.RemoveAll(exportDir)
+
+	writeChatImportFiles(t, exportDir, map[string]string{
+		"realm.json": `{
+"zerver_userprofile":[
+{"id":1,"email":"user1@example.com","delivery_email":"iago@example.com","full_name":"Iago Admin","is_active":true,"role":200},
+{"id":2,"email":"user2@example.com","delivery_email":"hamlet@example.com","full_name":"King Hamlet","is_active":true,"role":400},
+{"id":3,"email":"user3@example.com","delivery_email":"othello@example.com","full_name":"Othello","is_active":true,"role":400}],
+"zerver_stream":[{"id":10,"name":"Denmark","description":"A stream","invite_only":true}],
+"zerver_recipient":[{"id":100,"type":2,"type_id":10},{"id":101,"type":1,"type_id":2},{"id":102,"type":3,"type_id":1}],
+"zerver_subscription":[{"user_profile":1,"recipient":100,"active":true},{"user_profile":1,"recipient":102},{"user_profile":2,"recipient":102},{"user_profile":3,"recipient":102}],
+"zerver_reaction":[{"message":1000,"user_profile":2,"emoji_name":"thumbs_up"}],
+"zerver_attachment":[{"id":7,"file_name":"plan.txt","path_id":"2/ab/plan.txt","messages":[1002]}]
+}`,
+		"messages-000001.json": `{"zerver_message":[
+{"id":1000,"sender":1,"recipient":100,"subject":"castle","content":"hi @**King Hamlet**, see #**Denmark>castle**","date_sent":1577872800.0},
+{"id":1001,"sender":2,"recipient":100,"subject":"castle","content":"on it","date_sent":1577872860.0},
+{"id":1002,"sender":2,"recipient":100,"subject":"ghost","content":"[plan.txt](/user_uploads/2/ab/plan.txt)","date_sent":1577872900.0}]}`,
+		"messages-000002.json": `{"zerver_message":[
+{"id":1003,"sender":1,"recipient":101,"subject":"","content":"direct","date_sent":1577872950.0},
+{"id":1004,"sender":3,"recipient":102,"subject":"","content":"group","date_sent":1577872960.0}]}`,
+		"uploads/2/ab/plan.txt": "plan",
+	})
+
+	outputDir := filepath.Join(exportDir, "output")
+	report, err := ConvertZulipExport(exportDir, "team", outputDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"1": "iago", "2": "hamlet", "3": "othello"}, report.Users)
+	assert.Equal(t, map[string]string{"10": "denmark"}, report.Channels)
+	assert.Equal(t, 2, report.DirectChannels)
+	assert.Equal(t, 2, report.Posts)
+	assert.Equal(t, 1, report.Replies)
+	assert.Equal(t, 2, report.DirectPosts)
+	assert.Equal(t, 1, report.Attachments)
+
+	lines := readChatImportLines(t, outputDir)
+	require.Len(t, lines["channel"], 1)
+	assert.Equal(t, "P", *lines["channel"][0].Channel.Type)
+
+	require.Len(t, lines["user"], 3)
+	assert.Equal(t, "team_user team_admin", *(*lines["user"][0].User.Teams)[0].Roles)
+	assert.Equal(t, "team_user", *(*lines["user"][1].User.Teams)[0].Roles)
+
+	require.Len(t, lines["post"], 2)
+	castle := lines["post"][0].Post
+	assert.Equal(t, "**castle**\nhi @hamlet, see ~denmark", *castle.Message)
+	require.Len(t, *castle.Replies, 1)
+	assert.Equal(t, "on it", *(*castle.Replies)[0].Message)
+	require.Len(t, *castle.Reactions, 1)
+
+	ghost := lines["post"][1].Post
+	assert.True(t, strings.HasPrefix(*ghost.Message, "**ghost**\n"))
+	require.Len(t, *ghost.Attachments, 1)
+
+	require.Len(t, lines["direct_channel"], 2)
+	assert.ElementsMatch(t, []string{"iago", "hamlet"}, *lines["direct_channel"][0].DirectChannel.Members)
+	assert.ElementsMatch(t, []string{"iago", "hamlet", "othello"}, *lines["direct_channel"][1].DirectChannel.Members)
+}
+
+func TestConvertZulipMentions(t *testing.T) {
+	usernamesByFullName := map[string]string{"King Hamlet": "hamlet"}
+	usernamesById := map[string]string{"2": "hamlet", "3": "othello"}
+	channelNames := map[string]string{"Denmark": "denmark"}
+
+	for input, output := range map[string]string{
+		"@**King Hamlet**":         "@hamlet",
+		"@**Othello, the Moor|3**": "@othello",
+		"@_**King Hamlet**":        "@hamlet",
+		"@**all**":                 "@channel",
+		"@**Unknown**":             "@**Unknown**",
+		"#**Denmark**":             "~denmark",
+		"#**Denmark>castle**":      "~denmark",
+		"#**Elsinore**":            "#**Elsinore**",
+	} {
+		assert.Equal(t, output, convertZulipMentions(input, usernamesByFullName, usernamesById, channelNames), input)
+	}
+}
@@ -27,6 +27,9 @@ func (a *App) registerAllClusterMessageHandlers() {
 	a.Cluster().RegisterClusterMessageHandler(model.CLUSTER_EVENT_INSTALL_PLUGIN, a.clusterInstallPluginHandler)
 	a.Cluster().RegisterClusterMessageHandler(model.CLUSTER_EVENT_REMOVE_PLUGIN, a.clusterRemovePluginHandler)
 	a.Cluster().RegisterClusterMessageHandler(model.CLUSTER_EVENT_BUSY_STATE_CHANGED, a.clusterBusyStateChgHandler)
+	a.Cluster().RegisterClusterMessageHandler(model.CLUSTER_EVENT_INVALIDATE_CACHE_FOR_PERMALINK_PREVIEW, a.clusterInvalidateCacheForPermalinkPreviewHandler)
+	a.Cluster().RegisterClusterMessageHandler(model.CLUSTER_EVENT_DISCONNECT_WEB_CONN, a.clusterDisconnectWebConnHandler)
+	a.Cluster().RegisterClusterMessageHandler(model.CLUSTER_EVENT_INVALIDATE_CACHE_FOR_PRESENCE_WEBHOOKS, a.clusterInvalidateCacheForPresenceWebhooksHandler)
 }
 
 func (a *App) clusterPublishHandler(msg *model.ClusterMessage) {
@@ -70,6 +73,14 @@ func (a *App) clusterClearSessionCacheForAllUsersHandler(msg *model.ClusterMessa
 	a.ClearSessionCacheForAllUsersSkipClusterSend()
 }
 
+func (a *App) clusterInvalidateCacheForPermalinkPreviewHandler(msg *model.ClusterMessage) {
+	a.invalidateCacheForPermalinkPreviewSkipClusterSend(msg.Data)
+}
+
+func (a *App) clusterInvalidateCacheForPresenceWebhooksHandler(msg *model.ClusterMessage) {
+	a.invalidateCacheForPresenceWebhooksSkipClusterSend()
+}
+
 func (a *App) clusterInstallPluginHandler(msg *model.ClusterMessage) {
 	a.InstallPluginFromData(model.PluginEventDataFromJson(strings.NewReader(msg.Data)))
 }
@@ -81,3 +92,7 @@ func (a *App) clusterRemovePluginHandler(msg *model.ClusterMessage) {
 func (a *App) clusterBusyStateChgHandler(msg *model.ClusterMessage) {
 	a.ServerBusyStateChanged(model.ServerBusyStateFromJson(strings.NewReader(msg.Data)))
 }
+
+func (a *App) clusterDisconnectWebConnHandler(msg *model.ClusterMessage) {
+	a.Srv().DisconnectWebConnection(msg.Data)
+}
@@ -252,8 +252,7 @@ func (a *App) mentionsToTeamMembers(message, teamId string) model.UserMentionMap
 						continue
 					}
 
-					_, err = a.GetTeamMember(teamId, userFromTrimmed.Id)
-					if err != nil {
+					if belongs, _ := a.UserBelongsToTeam(userFromTrimmed.Id, teamId); !belongs {
 						// The user is not in the team, so we should ignore it
 						return
 					}
@@ -265,8 +264,7 @@ func (a *App) mentionsToTeamMembers(message, teamId string) model.UserMentionMap
 				return
 			}
 
-			_, err = a.GetTeamMember(teamId, user.Id)
-			if err != nil {
+			if belongs, _ := a.UserBelongsToTeam(user.Id, teamId); !belongs {
 				// The user is not in the team, so we should ignore it
 				return
 			}
@@ -359,7 +357,7 @@ func (a *App) tryExecuteCustomCommand(args *model.CommandArgs, trigger string, m
 
 	teamChan := make(chan store.StoreResult, 1)
 	go func() {
-		team, err := a.Srv().Store.Team().Get(args.TeamId)
+		team, err := a.GetTeam(args.TeamId)
 		teamChan <- store.StoreResult{Data: team, Err: err}
 		close(teamChan)
 	}()
@@ -412,6 +410,10 @@ func (a *App) tryExecuteCustomCommand(args *model.CommandArgs, trigger string, m
 		return nil, nil, nil
 	}
 
+	if appErr := a.checkIntegrationAllowedInChannel(channel, cmd.Id); appErr != nil {
+		return nil, nil, appErr
+	}
+
 	mlog.Debug("Executing command", mlog.String("command", trigger), mlog.String("user_id", args.UserId))
 
 	p := url.Values{}
@@ -447,7 +449,9 @@ func (a *App) tryExecuteCustomCommand(args *model.CommandArgs, trigger string, m
 	}
 	p.Set("response_url", args.SiteURL+"/hooks/commands/"+hook.Id)
 
-	return a.doCommandRequest(cmd, p)
+	_, response, appErr := a.doCommandRequest(cmd, p)
+	a.Srv().recordIntegrationUsage(cmd.Id, model.INTEGRATION_USAGE_TYPE_COMMAND, appErr == nil)
+	return cmd, response, appErr
 }
 
 func (a *App) doCommandRequest(cmd *model.Command, p url.Values) (*model.Command, *model.CommandResponse, *model.AppError) {
@@ -502,6 +506,8 @@ func (a *App) doCommandRequest(cmd *model.Command, p url.Values) (*model.Command
 		return cmd, nil, model.NewAppError("command", "api.command.execute_command.failed_empty.app_error", map[string]interface{}{"Trigger": cmd.Trigger}, "", http.StatusInternalServerError)
 	}
 
+	a.checkCommandAutocompleteDataVersion(cmd, resp.Header.Get(model.COMMAND_AUTOCOMPLETE_VERSION_HEADER))
+
 	return cmd, response, nil
 }
 
@@ -623,6 +629,14 @@ func (a *App) CreateCommand(cmd *model.Command) (*model.Command, *model.AppError
 		}
 	}
 
+	// The token authenticates the server when fetching the autocomplete data.
+	if cmd.Token == "" {
+		cmd.Token = model.NewId()
+	}
+	if appErr := a.prepareCommandAutocompleteData(cmd); appErr != nil {
+		return nil, appErr
+	}
+
 	command, nErr := a.Srv().Store.Command().Save(cmd)
 	if nErr != nil {
 		var appErr *model.AppError
@@ -669,6 +683,15 @@ func (a *App) UpdateCommand(oldCmd, updatedCmd *model.Command) (*model.Command,
 	updatedCmd.CreatorId = oldCmd.CreatorId
 	updatedCmd.TeamId = oldCmd.TeamId
 
+	// Keep the fetched autocomplete data as long as it's fetched from the same url.
+	if updatedCmd.AutocompleteData == nil && updatedCmd.AutocompleteDataURL != "" && updatedCmd.AutocompleteDataURL == oldCmd.AutocompleteDataURL {
+		updatedCmd.AutocompleteData = oldCmd.AutocompleteData
+		updatedCmd.AutocompleteDataVersion = oldCmd.AutocompleteDataVersion
+	}
+	if appErr := a.prepareCommandAutocompleteData(updatedCmd); appErr != nil {
+		return nil, appErr
+	}
+
 	command, err := a.Srv().Store.Command().Update(updatedCmd)
 	if err != nil {
 		var nfErr *store.ErrNotFound
@@ -740,5 +763,9 @@ func (a *App) DeleteCommand(commandId string) *model.AppError {
 		return model.NewAppError("DeleteCommand", "app.command.deletecommand.internal_error", nil, err.Error(), http.StatusInternalServerError)
 	}
 
+	if err := a.Srv().Store.ChannelIntegration().PermanentDeleteByIntegration(commandId); err != nil {
+		return model.NewAppError("DeleteCommand", "app.channel_integration.permanent_delete_by_integration.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
 	return nil
 }
@@ -18,16 +18,15 @@ func (a *App) GetSuggestions(commandArgs *model.CommandArgs, commands []*model.C
 		return strings.Compare(strings.ToLower(commands[i].Trigger), strings.ToLower(commands[j].Trigger)) < 0
 	})
 
-	autocompleteData := []*model.AutocompleteData{}
+	userInput := commandArgs.Command
+	suggestions := []model.AutocompleteSuggestion{}
 	for _, command := range commands {
 		if command.AutocompleteData == nil {
 			command.AutocompleteData = model.NewAutocompleteData(command.Trigger, command.AutoCompleteHint, command.AutoCompleteDesc)
 		}
-		autocompleteData = append(autocompleteData, command.AutocompleteData)
+		suggestions = append(suggestions, a.getCommandSuggestions(commandArgs, command, []*model.AutocompleteData{command.AutocompleteData}, "", userInput, roleID)...)
 	}
 
-	userInput := commandArgs.Command
-	suggestions := a.getSuggestions(commandArgs, autocompleteData, "", userInput, roleID)
 	for i, suggestion := range suggestions {
 		for _, command := range commands {
 			if strings.HasPrefix(suggestion.Complete, command.Trigger) {
@@ -41,6 +40,12 @@ func (a *App) GetSuggestions(commandArgs *model.CommandArgs, commands []*model.C
 }
 
 func (a *App) getSuggestions(commandArgs *model.CommandArgs, commands []*model.AutocompleteData, inputParsed, inputToBeParsed, roleID string) []model.AutocompleteSuggestion {
+	return a.getCommandSuggestions(commandArgs, nil, commands, inputParsed, inputToBeParsed, roleID)
+}
+
+// getCommandSuggestions returns the suggestions of the autocomplete data of slashCommand, which
+// tells where its dynamic list arguments are fetched from.
+func (a *App) getCommandSuggestions(commandArgs *model.CommandArgs, slashCommand *model.Command, commands []*model.AutocompleteData, inputParsed, inputToBeParsed, roleID string) []model.AutocompleteSuggestion {
 	suggestions := []model.AutocompleteSuggestion{}
 	index := strings.Index(inputToBeParsed, " ")
 
@@ -71,12 +76,12 @@ func (a *App) getSuggestions(commandArgs *model.CommandArgs, commands []*model.A
 
 		if len(command.Arguments) == 0 {
 			// Seek recursively in subcommands
-			subSuggestions := a.getSuggestions(commandArgs, command.SubCommands, parsed, toBeParsed, roleID)
+			subSuggestions := a.getCommandSuggestions(commandArgs, slashCommand, command.SubCommands, parsed, toBeParsed, roleID)
 			suggestions = append(suggestions, subSuggestions...)
 			continue
 		}
 
-		found, _, _, suggestion := a.parseArguments(commandArgs, command.Arguments, parsed, toBeParsed)
+		found, _, _, suggestion := a.parseArguments(commandArgs, slashCommand, command.Arguments, parsed, toBeParsed)
 		if found {
 			suggestions = append(suggestions, suggestion...)
 		}
@@ -85,27 +90,27 @@ func (a *App) getSuggestions(commandArgs *model.CommandArgs, commands []*model.A
 	return suggestions
 }
 
-func (a *App) parseArguments(commandArgs *model.CommandArgs, args []*model.AutocompleteArg, parsed, toBeParsed string) (found bool, alreadyParsed string, yetToBeParsed string, suggestions []model.AutocompleteSuggestion) {
+func (a *App) parseArguments(commandArgs *model.CommandArgs, command *model.Command, args []*model.AutocompleteArg, parsed, toBeParsed string) (found bool, alreadyParsed string, yetToBeParsed string, suggestions []model.AutocompleteSuggestion) {
 	if len(args) == 0 {
 		return false, parsed, toBeParsed, suggestions
 	}
 
 	if args[0].Required {
-		found, changedParsed, changedToBeParsed, suggestion := a.parseArgument(commandArgs, args[0], parsed, toBeParsed)
+		found, changedParsed, changedToBeParsed, suggestion := a.parseArgument(commandArgs, command, args[0], parsed, toBeParsed)
 		if found {
 			suggestions = append(suggestions, suggestion...)
 			return true, changedParsed, changedToBeParsed, suggestions
 		}
-		return a.parseArguments(commandArgs, args[1:], changedParsed, changedToBeParsed)
+		return a.parseArguments(commandArgs, command, args[1:], changedParsed, changedToBeParsed)
 	}
 
 	// Handling optional arguments. Optional argument can be inputted or not,
 	// so we have to pase both cases recursively and output combined suggestions.
-	foundWithOptional, changedParsedWithOptional, changedToBeParsedWithOptional, suggestionsWithOptional := a.parseArgument(commandArgs, args[0], parsed, toBeParsed)
+	foundWithOptional, changedParsedWithOptional, changedToBeParsedWithOptional, suggestionsWithOptional := a.parseArgument(commandArgs, command, args[0], parsed, toBeParsed)
 	if foundWithOptional {
 		suggestions = append(suggestions, suggestionsWithOptional...)
 	} else {
-		foundWithOptionalRest, changedParsedWithOptionalRest, changedToBeParsedWithOptionalRest, suggestionsWithOptionalRest := a.parseArguments(commandArgs, args[1:], changedParsedWithOptional, changedToBeParsedWithOptional)
+		foundWithOptionalRest, changedParsedWithOptionalRest, changedToBeParsedWithOptionalRest, suggestionsWithOptionalRest := a.parseArguments(commandArgs, command, args[1:], changedParsedWithOptional, changedToBeParsedWithOptional)
 		if foundWithOptionalRest {
 			suggestions = append(suggestions, suggestionsWithOptionalRest...)
 		}
@@ -114,7 +119,7 @@ func (a *App) parseArguments(commandArgs *model.CommandArgs, args []*model.Autoc
 		changedToBeParsedWithOptional = changedToBeParsedWithOptionalRest
 	}
 
-	foundWithoutOptional, changedParsedWithoutOptional, changedToBeParsedWithoutOptional, suggestionsWithoutOptional := a.parseArguments(commandArgs, args[1:], parsed, toBeParsed)
+	foundWithoutOptional, changedParsedWithoutOptional, changedToBeParsedWithoutOptional, suggestionsWithoutOptional := a.parseArguments(commandArgs, command, args[1:], parsed, toBeParsed)
 	if foundWithoutOptional {
 		suggestions = append(suggestions, suggestionsWithoutOptional...)
 	}
@@ -133,7 +138,7 @@ func (a *App) parseArguments(commandArgs *model.CommandArgs, args []*model.Autoc
 	return foundWithoutOptional, changedParsedWithoutOptional, changedToBeParsedWithoutOptional, suggestions
 }
 
-func (a *App) parseArgument(commandArgs *model.CommandArgs, arg *model.AutocompleteArg, parsed, toBeParsed string) (found bool, alreadyParsed string, yetToBeParsed string, suggestions []model.AutocompleteSuggestion) {
+func (a *App) parseArgument(commandArgs *model.CommandArgs, command *model.Command, arg *model.AutocompleteArg, parsed, toBeParsed string) (found bool, alreadyParsed string, yetToBeParsed string, suggestions []model.AutocompleteSuggestion) {
 	if arg.Name != "" { //Parse the --name first
 		found, changedParsed, changedToBeParsed, suggestion := parseNamedArgument(arg, parsed, toBeParsed)
 		if found {
@@ -167,7 +172,7 @@ func (a *App) parseArgument(commandArgs *model.CommandArgs, arg *model.Autocompl
 		parsed = changedParsed
 		toBeParsed = changedToBeParsed
 	} else if arg.Type == model.AutocompleteArgTypeDynamicList {
-		found, changedParsed, changedToBeParsed, dynamicListSuggestions := a.getDynamicListArgument(commandArgs, arg, parsed, toBeParsed)
+		found, changedParsed, changedToBeParsed, dynamicListSuggestions := a.getDynamicListArgument(commandArgs, command, arg, parsed, toBeParsed)
 		if found {
 			suggestions = append(suggestions, dynamicListSuggestions...)
 			return true, changedParsed, changedToBeParsed, suggestions
@@ -230,7 +235,7 @@ func parseStaticListArgument(arg *model.AutocompleteArg, parsed, toBeParsed stri
 	return parseListItems(a.PossibleArguments, parsed, toBeParsed)
 }
 
-func (a *App) getDynamicListArgument(commandArgs *model.CommandArgs, arg *model.AutocompleteArg, parsed, toBeParsed string) (found bool, alreadyParsed string, yetToBeParsed string, suggestions []model.AutocompleteSuggestion) {
+func (a *App) getDynamicListArgument(commandArgs *model.CommandArgs, command *model.Command, arg *model.AutocompleteArg, parsed, toBeParsed string) (found bool, alreadyParsed string, yetToBeParsed string, suggestions []model.AutocompleteSuggestion) {
 	dynamicArg := arg.Data.(*model.AutocompleteDynamicListArg)
 
 	params := url.Values{}
@@ -254,6 +259,16 @@ func (a *App) getDynamicListArgument(commandArgs *model.CommandArgs, arg *model.
 	params.Add("user_id", commandArgs.UserId)
 	params.Add("site_url", commandArgs.SiteURL)
 
+	// Custom slash commands are served by their integration rather than by a plugin.
+	if command != nil && command.Id != "" {
+		listItems, err := a.getCommandDynamicListItems(command, dynamicArg.FetchURL, params)
+		if err != nil {
+			a.Log().Error("Can't fetch dynamic list arguments for", mlog.String("url", dynamicArg.FetchURL), mlog.Err(err))
+			return false, parsed, toBeParsed, []model.AutocompleteSuggestion{}
+		}
+		return parseListItems(listItems, parsed, toBeParsed)
+	}
+
 	resp, err := a.doPluginRequest("GET", dynamicArg.FetchURL, params, nil)
 
 	if err != nil {
@@ -0,0 +1,205 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+const (
+	COMMAND_DYNAMIC_LIST_CACHE_SIZE = 10000
+	// COMMAND_DYNAMIC_LIST_CACHE_EXPIRY bounds how stale the values of a dynamic list argument can
+	// get, sparing the integration a request on every keystroke.
+	COMMAND_DYNAMIC_LIST_CACHE_EXPIRY = 30 * time.Second
+)
+
+// getCommandDynamicListItems returns the values of a dynamic list argument of a custom slash
+// command, as served by its integration at fetchURL. The values are cached per user, channel and
+// input parsed so far, for the current version of the autocomplete data of the command.
+func (a *App) getCommandDynamicListItems(command *model.Command, fetchURL string, params url.Values) ([]model.AutocompleteListItem, error) {
+	key := strings.Join([]string{
+		command.Id,
+		command.AutocompleteDataVersion,
+		params.Get("user_id"),
+		params.Get("channel_id"),
+		fetchURL,
+		params.Get("parsed"),
+	}, "\n")
+
+	var items []model.AutocompleteListItem
+	if err := a.Srv().commandDynamicListCache.Get(key, &items); err == nil {
+		return items, nil
+	}
+
+	// The listed commands are sanitized, so fetch the token of the command.
+	cmd, err := a.Srv().Store.Command().Get(command.Id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get command_id=%s", command.Id)
+	}
+
+	resp, err := a.doCommandAutocompleteRequest(cmd, fetchURL, params)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	items = model.AutocompleteStaticListItemsFromJSON(io.LimitReader(resp.Body, MaxIntegrationResponseSize))
+	a.Srv().commandDynamicListCache.SetWithDefaultExpiry(key, items)
+
+	a.checkCommandAutocompleteDataVersion(cmd, resp.Header.Get(model.COMMAND_AUTOCOMPLETE_VERSION_HEADER))
+
+	return items, nil
+}
+
+// doCommandAutocompleteRequest sends an autocomplete request of the command to its integration,
+// failing unless the integration responds successfully.
+func (a *App) doCommandAutocompleteRequest(cmd *model.Command, rawURL string, params url.Values) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(params) > 0 {
+		if req.URL.RawQuery != "" {
+			req.URL.RawQuery += "&"
+		}
+		req.URL.RawQuery += params.Encode()
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Token "+cmd.Token)
+
+	resp, err := a.HTTPService().MakeClient(false).Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		io.Copy(ioutil.Discard, io.LimitReader(resp.Body, MaxIntegrationResponseSize))
+		return nil, errors.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return resp, nil
+}
+
+// fetchCommandAutocompleteDefinition gets the autocomplete data of the command from its
+// AutocompleteDataURL, with the relative urls of the dynamic list arguments resolved against the
+// url of the command.
+func (a *App) fetchCommandAutocompleteDefinition(cmd *model.Command) (*model.CommandAutocompleteDefinition, *model.AppError) {
+	resp, err := a.doCommandAutocompleteRequest(cmd, cmd.AutocompleteDataURL, nil)
+	if err != nil {
+		return nil, model.NewAppError("fetchCommandAutocompleteDefinition", "app.command.autocomplete_data.fetch.app_error", map[string]interface{}{"Trigger": cmd.Trigger}, err.Error(), http.StatusBadRequest)
+	}
+	defer resp.Body.Close()
+
+	definition, err := model.CommandAutocompleteDefinitionFromJson(io.LimitReader(resp.Body, MaxIntegrationResponseSize))
+	if err != nil {
+		return nil, model.NewAppError("fetchCommandAutocompleteDefinition", "app.command.autocomplete_data.fetch.app_error", map[string]interface{}{"Trigger": cmd.Trigger}, err.Error(), http.StatusBadRequest)
+	}
+
+	if appErr := resolveCommandAutocompleteURLs(cmd.URL, definition.AutocompleteData); appErr != nil {
+		return nil, appErr
+	}
+
+	return definition, nil
+}
+
+// resolveCommandAutocompleteURLs resolves the relative urls of the dynamic list arguments against
+// the url of the command.
+func resolveCommandAutocompleteURLs(commandURL string, data *model.AutocompleteData) *model.AppError {
+	if data == nil {
+		return nil
+	}
+
+	baseURL, err := url.Parse(commandURL)
+	if err == nil {
+		err = data.UpdateRelativeURLsForPluginCommands(baseURL)
+	}
+	if err != nil {
+		return model.NewAppError("resolveCommandAutocompleteURLs", "model.command.is_valid.autocomplete_data.app_error", nil, err.Error(), http.StatusBadRequest)
+	}
+	return nil
+}
+
+// prepareCommandAutocompleteData fetches the autocomplete data of a command being saved when it
+// only has an AutocompleteDataURL, and resolves the relative urls of the dynamic list arguments.
+func (a *App) prepareCommandAutocompleteData(cmd *model.Command) *model.AppError {
+	if cmd.AutocompleteData == nil && cmd.AutocompleteDataURL != "" {
+		definition, appErr := a.fetchCommandAutocompleteDefinition(cmd)
+		if appErr != nil {
+			return appErr
+		}
+		cmd.AutocompleteData = definition.AutocompleteData
+		cmd.AutocompleteDataVersion = definition.Version
+		return nil
+	}
+
+	return resolveCommandAutocompleteURLs(cmd.URL, cmd.AutocompleteData)
+}
+
+// checkCommandAutocompleteDataVersion refreshes the autocomplete data of the command in the
+// background when its integration announces another version than the stored one.
+func (a *App) checkCommandAutocompleteDataVersion(cmd *model.Command, version string) {
+	if version == "" || version == cmd.AutocompleteDataVersion || cmd.AutocompleteDataURL == "" {
+		return
+	}
+
+	if _, refreshing := a.Srv().commandAutocompleteRefreshes.LoadOrStore(cmd.Id, true); refreshing {
+		return
+	}
+
+	a.Srv().Go(func() {
+		defer a.Srv().commandAutocompleteRefreshes.Delete(cmd.Id)
+
+		if _, appErr := a.refreshCommandAutocompleteData(cmd.Id); appErr != nil {
+			mlog.Warn("Unable to refresh the autocomplete data of a command.", mlog.String("command_id", cmd.Id), mlog.Err(appErr))
+		}
+	})
+}
+
+// refreshCommandAutocompleteData fetches the autocomplete data of the command from its
+// AutocompleteDataURL and saves it along with its version.
+func (a *App) refreshCommandAutocompleteData(commandId string) (*model.Command, *model.AppError) {
+	cmd, appErr := a.GetCommand(commandId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if cmd.AutocompleteDataURL == "" {
+		return nil, model.NewAppError("refreshCommandAutocompleteData", "app.command.autocomplete_data.no_url.app_error", nil, "command_id="+commandId, http.StatusBadRequest)
+	}
+
+	definition, appErr := a.fetchCommandAutocompleteDefinition(cmd)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	cmd.AutocompleteData = definition.AutocompleteData
+	cmd.AutocompleteDataVersion = definition.Version
+	cmd.UpdateAt = model.GetMillis()
+
+	updated, err := a.Srv().Store.Command().Update(cmd)
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("refreshCommandAutocompleteData", "app.command.updatecommand.internal_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return updated, nil
+}
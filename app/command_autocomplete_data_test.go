@@ -0,0 +1,120 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestCommandAutocompleteData(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		cfg.ServiceSettings.AllowedUntrustedInternalConnections = model.NewString("127.0.0.1")
+		cfg.ServiceSettings.EnableCommands = model.NewBool(true)
+	})
+
+	var version atomic.Value
+	version.Store("v1")
+	var listCalls int32
+
+	var token string
+	integration := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Token "+token, r.Header.Get("Authorization"))
+
+		switch r.URL.Path {
+		case "/cmd/autocomplete":
+			data := model.NewAutocompleteData("deploy", "[environment]", "Deploy the app")
+			data.AddDynamicListArgument("The environment", "environments", true)
+			b, _ := json.Marshal(&model.CommandAutocompleteDefinition{Version: version.Load().(string), AutocompleteData: data})
+			w.Write(b)
+		case "/cmd/environments":
+			atomic.AddInt32(&listCalls, 1)
+			assert.Equal(t, th.BasicUser.Id, r.URL.Query().Get("user_id"))
+			w.Header().Set(model.COMMAND_AUTOCOMPLETE_VERSION_HEADER, version.Load().(string))
+			w.Write([]byte(`[{"Item": "production"}, {"Item": "staging"}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer integration.Close()
+
+	token = model.NewId()
+	command, appErr := th.App.CreateCommand(&model.Command{
+		CreatorId:           th.BasicUser.Id,
+		TeamId:              th.BasicTeam.Id,
+		Token:               token,
+		URL:                 integration.URL + "/cmd",
+		Method:              model.COMMAND_METHOD_POST,
+		Trigger:             "deploy",
+		AutoComplete:        true,
+		AutocompleteDataURL: integration.URL + "/cmd/autocomplete",
+	})
+	require.Nil(t, appErr)
+	require.NotNil(t, command.AutocompleteData, "the autocomplete data should be fetched on registration")
+	assert.Equal(t, "v1", command.AutocompleteDataVersion)
+	assert.Equal(t, integration.URL+"/cmd/environments", command.AutocompleteData.Arguments[0].Data.(*model.AutocompleteDynamicListArg).FetchURL)
+
+	getSuggestions := func(input string) []model.AutocompleteSuggestion {
+		commands, appErr := th.App.ListAutocompleteCommands(th.BasicTeam.Id, th.App.T)
+		require.Nil(t, appErr)
+
+		args := &model.CommandArgs{
+			ChannelId: th.BasicChannel.Id,
+			TeamId:    th.BasicTeam.Id,
+			UserId:    th.BasicUser.Id,
+			Command:   input,
+		}
+		return th.App.GetSuggestions(args, commands, model.SYSTEM_USER_ROLE_ID)
+	}
+
+	t.Run("dynamic list values are fetched from the integration and cached", func(t *testing.T) {
+		suggestions := getSuggestions("deploy ")
+		require.Len(t, suggestions, 2)
+		assert.Equal(t, "deploy production", suggestions[0].Complete)
+
+		suggestions = getSuggestions("deploy st")
+		require.Len(t, suggestions, 1)
+		assert.Equal(t, "deploy staging", suggestions[0].Complete)
+
+		assert.Equal(t, int32(1), atomic.LoadInt32(&listCalls))
+	})
+
+	t.Run("a new version announced by the integration refreshes the autocomplete data", func(t *testing.T) {
+		version.Store("v2")
+		th.App.Srv().commandDynamicListCache.Purge()
+
+		require.Len(t, getSuggestions("deploy "), 2)
+
+		assert.Eventually(t, func() bool {
+			refreshed, appErr := th.App.GetCommand(command.Id)
+			require.Nil(t, appErr)
+			return refreshed.AutocompleteDataVersion == "v2"
+		}, 5*time.Second, 20*time.Millisecond)
+	})
+
+	t.Run("updating the command keeps the fetched autocomplete data", func(t *testing.T) {
+		current, appErr := th.App.GetCommand(command.Id)
+		require.Nil(t, appErr)
+
+		updated := *current
+		updated.AutocompleteData = nil
+		updated.DisplayName = "Deploy"
+		result, appErr := th.App.UpdateCommand(current, &updated)
+		require.Nil(t, appErr)
+		require.NotNil(t, result.AutocompleteData)
+		assert.Equal(t, "v2", result.AutocompleteDataVersion)
+	})
+}
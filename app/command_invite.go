@@ -143,7 +143,7 @@ func (me *InviteProvider) DoCommand(a *App, args *model.CommandArgs, message str
 		var text string
 		if err.Id == "api.channel.add_members.user_denied" {
 			text = args.T("api.command_invite.group_constrained_user_denied")
-		} else if err.Id == "store.sql_team.get_member.missing.app_error" ||
+		} else if err.Id == "app.team.get_member.missing.app_error" ||
 			err.Id == "api.channel.add_user.to.channel.failed.deleted.app_error" {
 			text = args.T("api.command_invite.user_not_in_team.app_error", map[string]interface{}{
 				"Username": userProfile.Username,
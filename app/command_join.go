@@ -42,12 +42,13 @@ func (me *JoinProvider) DoCommand(a *App, args *model.CommandArgs, message strin
 		channelName = message[1:]
 	}
 
-	channel, err := a.Srv().Store.Channel().GetByName(args.TeamId, channelName, true)
+	channel, err := a.ResolveChannelByName(channelName, args.TeamId, false)
 	if err != nil {
 		return &model.CommandResponse{Text: args.T("api.command_join.list.app_error"), ResponseType: model.COMMAND_RESPONSE_TYPE_EPHEMERAL}
 	}
 
-	if channel.Name != channelName {
+	// A channel renamed since is joined through its old name, but the name must match exactly otherwise.
+	if channel.Name != channelName && strings.EqualFold(channel.Name, channelName) {
 		return &model.CommandResponse{ResponseType: model.COMMAND_RESPONSE_TYPE_EPHEMERAL, Text: args.T("api.command_join.missing.app_error")}
 	}
 
@@ -52,8 +52,13 @@ func (me *LeaveProvider) DoCommand(a *App, args *model.CommandArgs, message stri
 		return &model.CommandResponse{Text: args.T("api.command_leave.fail.app_error"), ResponseType: model.COMMAND_RESPONSE_TYPE_EPHEMERAL}
 	}
 
-	member, err := a.GetTeamMember(team.Id, args.UserId)
-	if err != nil || member.DeleteAt != 0 {
+	// Leaving the last channel removes a guest from the team. A concurrent lookup that read the membership
+	// before the removal can put it back into the cache right after the removal invalidated it.
+	belongs, err := a.Srv().Store.Team().UserBelongsToTeam(a.Context(), args.UserId, team.Id, false)
+	if err != nil {
+		return &model.CommandResponse{Text: args.T("api.command_leave.fail.app_error"), ResponseType: model.COMMAND_RESPONSE_TYPE_EPHEMERAL}
+	}
+	if !belongs {
 		return &model.CommandResponse{GotoLocation: args.SiteURL + "/"}
 	}
 
@@ -0,0 +1,196 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+const (
+	DEBUG_CAPTURE_BUFFER_SIZE = 500
+	DEBUG_CAPTURE_MAX_RULES   = 20
+)
+
+// debugCaptureRecorder keeps the requests matching the active debug capture rules in a ring buffer,
+// overwriting the oldest captures once full. Both the rules and the captures are local to the node.
+type debugCaptureRecorder struct {
+	mutex    sync.RWMutex
+	rules    map[string]*model.DebugCaptureRule
+	captures []*model.DebugCapture
+	next     int
+	full     bool
+}
+
+func newDebugCaptureRecorder(size int) *debugCaptureRecorder {
+	return &debugCaptureRecorder{
+		rules:    make(map[string]*model.DebugCaptureRule),
+		captures: make([]*model.DebugCapture, size),
+	}
+}
+
+// pruneExpiredRules must be called with the write lock held.
+func (r *debugCaptureRecorder) pruneExpiredRules(now int64) {
+	for id, rule := range r.rules {
+		if rule.ExpiresAt <= now {
+			delete(r.rules, id)
+		}
+	}
+}
+
+func (r *debugCaptureRecorder) addRule(rule *model.DebugCaptureRule) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.pruneExpiredRules(rule.CreateAt)
+	if len(r.rules) >= DEBUG_CAPTURE_MAX_RULES {
+		return false
+	}
+
+	r.rules[rule.Id] = rule
+	return true
+}
+
+func (r *debugCaptureRecorder) removeRule(ruleId string) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, ok := r.rules[ruleId]; !ok {
+		return false
+	}
+
+	delete(r.rules, ruleId)
+	return true
+}
+
+func (r *debugCaptureRecorder) activeRules(now int64) []*model.DebugCaptureRule {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.pruneExpiredRules(now)
+
+	rules := make([]*model.DebugCaptureRule, 0, len(r.rules))
+	for _, rule := range r.rules {
+		ruleCopy := *rule
+		rules = append(rules, &ruleCopy)
+	}
+
+	return rules
+}
+
+// debugCaptureLogLevels ranks the log levels of the rules, the most verbose first.
+var debugCaptureLogLevels = map[string]int{
+	mlog.LevelDebug: 1,
+	mlog.LevelInfo:  2,
+	mlog.LevelWarn:  3,
+	mlog.LevelError: 4,
+}
+
+// match returns the id of a rule capturing the request of the user to the endpoint, or an empty
+// string, along with the most verbose log level set by the matching rules. It is called on every
+// request, so it avoids the write lock.
+func (r *debugCaptureRecorder) match(endpoint, userId string, now int64) (ruleId string, logLevel string) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	for _, rule := range r.rules {
+		if !rule.Matches(endpoint, userId, now) {
+			continue
+		}
+
+		if ruleId == "" {
+			ruleId = rule.Id
+		}
+		if rule.LogLevel != "" && (logLevel == "" || debugCaptureLogLevels[rule.LogLevel] < debugCaptureLogLevels[logLevel]) {
+			logLevel = rule.LogLevel
+		}
+	}
+
+	return ruleId, logLevel
+}
+
+func (r *debugCaptureRecorder) record(capture *model.DebugCapture) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.captures[r.next] = capture
+	r.next = (r.next + 1) % len(r.captures)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// list returns the captures of the rule, or all of them if ruleId is empty, oldest first.
+func (r *debugCaptureRecorder) list(ruleId string) []*model.DebugCapture {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	ordered := r.captures[:r.next]
+	if r.full {
+		ordered = append(append([]*model.DebugCapture{}, r.captures[r.next:]...), r.captures[:r.next]...)
+	}
+
+	captures := []*model.DebugCapture{}
+	for _, capture := range ordered {
+		if ruleId == "" || capture.RuleId == ruleId {
+			captures = append(captures, capture)
+		}
+	}
+
+	return captures
+}
+
+// CreateDebugCaptureRule starts capturing the requests matching the rule on this node until the
+// rule expires.
+func (a *App) CreateDebugCaptureRule(rule *model.DebugCaptureRule) (*model.DebugCaptureRule, *model.AppError) {
+	rule.Id = model.NewId()
+	rule.CreateAt = model.GetMillis()
+
+	if err := rule.IsValid(rule.CreateAt); err != nil {
+		return nil, err
+	}
+
+	if !a.Srv().debugCaptures.addRule(rule) {
+		return nil, model.NewAppError("CreateDebugCaptureRule", "app.debug_capture.create_rule.too_many.app_error", map[string]interface{}{"Max": DEBUG_CAPTURE_MAX_RULES}, "", http.StatusBadRequest)
+	}
+
+	ruleCopy := *rule
+	return &ruleCopy, nil
+}
+
+// GetDebugCaptureRules returns the debug capture rules of this node that haven't expired yet.
+func (a *App) GetDebugCaptureRules() []*model.DebugCaptureRule {
+	return a.Srv().debugCaptures.activeRules(model.GetMillis())
+}
+
+// DeleteDebugCaptureRule stops capturing the requests matching the rule. The requests it already
+// captured are kept until overwritten.
+func (a *App) DeleteDebugCaptureRule(ruleId string) *model.AppError {
+	if !a.Srv().debugCaptures.removeRule(ruleId) {
+		return model.NewAppError("DeleteDebugCaptureRule", "app.debug_capture.rule_not_found.app_error", nil, "rule_id="+ruleId, http.StatusNotFound)
+	}
+
+	return nil
+}
+
+// GetDebugCaptures returns the requests captured on this node by the rule, or by any rule if ruleId
+// is empty, oldest first.
+func (a *App) GetDebugCaptures(ruleId string) []*model.DebugCapture {
+	return a.Srv().debugCaptures.list(ruleId)
+}
+
+// DebugCaptureRuleFor returns the id of an active rule capturing the requests of the user to the
+// endpoint, or an empty string if the request isn't to be captured, and the minimum level the
+// request is to log at, or an empty string to keep the level of the logger.
+func (a *App) DebugCaptureRuleFor(endpoint, userId string) (string, string) {
+	return a.Srv().debugCaptures.match(endpoint, userId, model.GetMillis())
+}
+
+// RecordDebugCapture adds a captured request to the buffer.
+func (a *App) RecordDebugCapture(capture *model.DebugCapture) {
+	a.Srv().debugCaptures.record(capture)
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDebugCaptureRecorder(t *testing.T) {
+	t.Run("overwrites the oldest captures", func(t *testing.T) {
+		recorder := newDebugCaptureRecorder(3)
+		require.Empty(t, recorder.list(""))
+
+		for _, requestId := range []string{"a", "b", "c", "d", "e"} {
+			recorder.record(&model.DebugCapture{RuleId: "rule", RequestId: requestId})
+		}
+
+		captures := recorder.list("")
+		require.Len(t, captures, 3)
+		assert.Equal(t, "c", captures[0].RequestId)
+		assert.Equal(t, "d", captures[1].RequestId)
+		assert.Equal(t, "e", captures[2].RequestId)
+	})
+
+	t.Run("filters the captures by rule", func(t *testing.T) {
+		recorder := newDebugCaptureRecorder(10)
+		recorder.record(&model.DebugCapture{RuleId: "rule1", RequestId: "a"})
+		recorder.record(&model.DebugCapture{RuleId: "rule2", RequestId: "b"})
+
+		captures := recorder.list("rule2")
+		require.Len(t, captures, 1)
+		assert.Equal(t, "b", captures[0].RequestId)
+	})
+
+	t.Run("returns the most verbose log level of the matching rules", func(t *testing.T) {
+		recorder := newDebugCaptureRecorder(10)
+		now := model.GetMillis()
+
+		require.True(t, recorder.addRule(&model.DebugCaptureRule{Id: "endpoint", Endpoint: "getUser", LogLevel: "info", CreateAt: now, ExpiresAt: now + 1000}))
+		require.True(t, recorder.addRule(&model.DebugCaptureRule{Id: "user", UserId: "user1", LogLevel: "debug", CreateAt: now, ExpiresAt: now + 1000}))
+		require.True(t, recorder.addRule(&model.DebugCaptureRule{Id: "capture", Endpoint: "getChannel", CreateAt: now, ExpiresAt: now + 1000}))
+
+		_, logLevel := recorder.match("getUser", "user1", now)
+		assert.Equal(t, "debug", logLevel)
+		_, logLevel = recorder.match("getUser", "user2", now)
+		assert.Equal(t, "info", logLevel)
+		ruleId, logLevel := recorder.match("getChannel", "user2", now)
+		assert.Equal(t, "capture", ruleId)
+		assert.Equal(t, "", logLevel)
+	})
+
+	t.Run("matches and prunes the active rules", func(t *testing.T) {
+		recorder := newDebugCaptureRecorder(10)
+		now := model.GetMillis()
+
+		require.True(t, recorder.addRule(&model.DebugCaptureRule{Id: "expiring", Endpoint: "getUser", CreateAt: now, ExpiresAt: now + 10}))
+		require.True(t, recorder.addRule(&model.DebugCaptureRule{Id: "user", UserId: "user1", CreateAt: now, ExpiresAt: now + 1000}))
+
+		ruleId, _ := recorder.match("getUser", "user2", now)
+		assert.Equal(t, "expiring", ruleId)
+		ruleId, _ = recorder.match("getChannel", "user1", now)
+		assert.Equal(t, "user", ruleId)
+		ruleId, _ = recorder.match("getChannel", "user2", now)
+		assert.Equal(t, "", ruleId)
+		ruleId, _ = recorder.match("getUser", "user2", now+10)
+		assert.Equal(t, "", ruleId)
+
+		rules := recorder.activeRules(now + 10)
+		require.Len(t, rules, 1)
+		assert.Equal(t, "user", rules[0].Id)
+
+		assert.True(t, recorder.removeRule("user"))
+		assert.False(t, recorder.removeRule("user"))
+		assert.Empty(t, recorder.activeRules(now))
+	})
+
+	t.Run("limits the number of rules", func(t *testing.T) {
+		recorder := newDebugCaptureRecorder(10)
+		now := model.GetMillis()
+
+		for i := 0; i < DEBUG_CAPTURE_MAX_RULES; i++ {
+			require.True(t, recorder.addRule(&model.DebugCaptureRule{Id: model.NewId(), UserId: "user1", CreateAt: now, ExpiresAt: now + 1000}))
+		}
+		assert.False(t, recorder.addRule(&model.DebugCaptureRule{Id: model.NewId(), UserId: "user1", CreateAt: now, ExpiresAt: now + 1000}))
+	})
+}
@@ -4,6 +4,7 @@
 package app
 
 import (
+	"context"
 	"path/filepath"
 	"runtime"
 	"strings"
@@ -11,7 +12,7 @@ import (
 	"github.com/mattermost/mattermost-server/v5/mlog"
 	"github.com/mattermost/mattermost-server/v5/model"
 	"github.com/mattermost/mattermost-server/v5/store"
-	rudder "github.com/rudderlabs/analytics-go"
+	"github.com/mattermost/mattermost-server/v5/utils"
 )
 
 const (
@@ -46,6 +47,10 @@ const (
 	TRACK_CONFIG_ELASTICSEARCH      = "config_elasticsearch"
 	TRACK_CONFIG_PLUGIN             = "config_plugin"
 	TRACK_CONFIG_DATA_RETENTION     = "config_data_retention"
+	TRACK_CONFIG_ARCHIVE            = "config_archive"
+	TRACK_CONFIG_BACKUP             = "config_backup"
+	TRACK_CONFIG_EVENT_STREAM       = "config_event_stream"
+	TRACK_CONFIG_PROFILING          = "config_profiling"
 	TRACK_CONFIG_MESSAGE_EXPORT     = "config_message_export"
 	TRACK_CONFIG_DISPLAY            = "config_display"
 	TRACK_CONFIG_GUEST_ACCOUNTS     = "config_guest_accounts"
@@ -67,32 +72,68 @@ const (
 // declaring this as var to allow overriding in tests
 var SENTRY_DSN = "placeholder_sentry_dsn"
 
+var diagnosticsCollectors = []struct {
+	category string
+	collect  func(*Server, DiagnosticsSink)
+}{
+	{model.DIAGNOSTICS_CATEGORY_ACTIVITY, (*Server).trackActivity},
+	{model.DIAGNOSTICS_CATEGORY_CONFIG, (*Server).trackConfig},
+	{model.DIAGNOSTICS_CATEGORY_LICENSE, (*Server).trackLicense},
+	{model.DIAGNOSTICS_CATEGORY_PLUGINS, (*Server).trackPlugins},
+	{model.DIAGNOSTICS_CATEGORY_SERVER, (*Server).trackServer},
+	{model.DIAGNOSTICS_CATEGORY_PERMISSIONS, (*Server).trackPermissions},
+	{model.DIAGNOSTICS_CATEGORY_SEARCH, (*Server).trackElasticsearch},
+	{model.DIAGNOSTICS_CATEGORY_GROUPS, (*Server).trackGroups},
+	{model.DIAGNOSTICS_CATEGORY_CHANNEL_MODERATION, (*Server).trackChannelModeration},
+}
+
 func (s *Server) SendDailyDiagnostics() {
 	s.sendDailyDiagnostics(false)
 }
 
 func (s *Server) sendDailyDiagnostics(override bool) {
-	if *s.Config().LogSettings.EnableDiagnostics && s.IsLeader() && ((!strings.Contains(RUDDER_KEY, "placeholder") && !strings.Contains(RUDDER_DATAPLANE_URL, "placeholder")) || override) {
+	hasRudderKeys := !strings.Contains(RUDDER_KEY, "placeholder") && !strings.Contains(RUDDER_DATAPLANE_URL, "placeholder")
+	hasSinkURL := *s.Config().LogSettings.DiagnosticsSinkURL != ""
+
+	if *s.Config().LogSettings.EnableDiagnostics && s.IsLeader() && (hasRudderKeys || hasSinkURL || override) {
 		s.initDiagnostics(RUDDER_DATAPLANE_URL)
-		s.trackActivity()
-		s.trackConfig()
-		s.trackLicense()
-		s.trackPlugins()
-		s.trackServer()
-		s.trackPermissions()
-		s.trackElasticsearch()
-		s.trackGroups()
-		s.trackChannelModeration()
+		if s.diagnosticsSink == nil {
+			return
+		}
+
+		s.collectDiagnostics(s.diagnosticsSink)
+		if err := s.diagnosticsSink.Flush(); err != nil {
+			mlog.Warn("Failed to send the diagnostics.", mlog.Err(err))
+		}
+	}
+}
+
+// collectDiagnostics tracks the diagnostics of the categories the admin opted in to.
+func (s *Server) collectDiagnostics(sink DiagnosticsSink) {
+	categories := s.Config().LogSettings.DiagnosticsCategories
+	for _, collector := range diagnosticsCollectors {
+		if utils.StringInSlice(collector.category, categories) {
+			collector.collect(s, sink)
+		}
+	}
+}
+
+// ExportDiagnostics collects the diagnostics the server would send, without sending them.
+func (s *Server) ExportDiagnostics() *model.DiagnosticsExport {
+	sink := &memoryDiagnosticsSink{}
+	s.collectDiagnostics(sink)
+
+	return &model.DiagnosticsExport{
+		DiagnosticId: s.diagnosticId,
+		CreateAt:     model.GetMillis(),
+		Categories:   s.Config().LogSettings.DiagnosticsCategories,
+		Events:       sink.events,
 	}
 }
 
 func (s *Server) SendDiagnostic(event string, properties map[string]interface{}) {
-	if s.rudderClient != nil {
-		s.rudderClient.Enqueue(rudder.Track{
-			Event:      event,
-			UserId:     s.diagnosticId,
-			Properties: properties,
-		})
+	if s.diagnosticsSink != nil {
+		s.diagnosticsSink.Track(event, properties)
 	}
 }
 
@@ -128,7 +169,7 @@ func pluginVersion(pluginsAvailable []*model.BundleInfo, pluginId string) string
 	return ""
 }
 
-func (s *Server) trackActivity() {
+func (s *Server) trackActivity(sink DiagnosticsSink) {
 	var userCount int64
 	var guestAccountsCount int64
 	var botAccountsCount int64
@@ -175,7 +216,7 @@ func (s *Server) trackActivity() {
 		inactiveUserCount = iucr
 	}
 
-	teamCount, err := s.Store.Team().AnalyticsTeamCount(false)
+	teamCount, err := s.Store.Team().AnalyticsTeamCount(context.Background(), false)
 	if err != nil {
 		mlog.Error(err.Error())
 	}
@@ -200,7 +241,7 @@ func (s *Server) trackActivity() {
 		deletedPrivateChannelCount = dpccr
 	}
 
-	postsCount, _ = s.Store.Post().AnalyticsPostCount("", false, false)
+	postsCount, _ = s.Store.Post().AnalyticsPostCount("", false, false, true)
 
 	postCountsOptions := &model.AnalyticsPostCountsOptions{TeamId: "", BotsOnly: false, YesterdayOnly: true}
 	postCountsYesterday, _ := s.Store.Post().AnalyticsPostCountsByDay(postCountsOptions)
@@ -234,7 +275,7 @@ func (s *Server) trackActivity() {
 		activeUsersMonthlyCount = r.Data.(int64)
 	}
 
-	s.SendDiagnostic(TRACK_ACTIVITY, map[string]interface{}{
+	sink.Track(TRACK_ACTIVITY, map[string]interface{}{
 		"registered_users":             userCount,
 		"bot_accounts":                 botAccountsCount,
 		"guest_accounts":               guestAccountsCount,
@@ -256,19 +297,30 @@ func (s *Server) trackActivity() {
 	})
 }
 
-func (s *Server) trackConfig() {
+func (s *Server) trackConfig(sink DiagnosticsSink) {
 	cfg := s.Config()
-	s.SendDiagnostic(TRACK_CONFIG_SERVICE, map[string]interface{}{
+	sink.Track(TRACK_CONFIG_SERVICE, map[string]interface{}{
 		"web_server_mode":                                         *cfg.ServiceSettings.WebserverMode,
 		"enable_security_fix_alert":                               *cfg.ServiceSettings.EnableSecurityFixAlert,
+		"enable_seat_usage_notifications":                         *cfg.ServiceSettings.EnableSeatUsageNotifications,
+		"seat_usage_notification_days":                            *cfg.ServiceSettings.SeatUsageNotificationDays,
+		"exclude_service_accounts_from_seat_count":                *cfg.ServiceSettings.ExcludeServiceAccountsFromSeatCount,
 		"enable_insecure_outgoing_connections":                    *cfg.ServiceSettings.EnableInsecureOutgoingConnections,
 		"enable_incoming_webhooks":                                cfg.ServiceSettings.EnableIncomingWebhooks,
 		"enable_outgoing_webhooks":                                cfg.ServiceSettings.EnableOutgoingWebhooks,
+		"enable_presence_webhooks":                                *cfg.ServiceSettings.EnablePresenceWebhooks,
 		"enable_commands":                                         *cfg.ServiceSettings.EnableCommands,
 		"enable_only_admin_integrations":                          *cfg.ServiceSettings.DEPRECATED_DO_NOT_USE_EnableOnlyAdminIntegrations,
 		"enable_post_username_override":                           cfg.ServiceSettings.EnablePostUsernameOverride,
 		"enable_post_icon_override":                               cfg.ServiceSettings.EnablePostIconOverride,
 		"enable_user_access_tokens":                               *cfg.ServiceSettings.EnableUserAccessTokens,
+		"enable_impersonation":                                    *cfg.ServiceSettings.EnableImpersonation,
+		"enable_impersonation_write_access":                       *cfg.ServiceSettings.EnableImpersonationWriteAccess,
+		"impersonation_session_length_in_minutes":                 *cfg.ServiceSettings.ImpersonationSessionLengthInMinutes,
+		"enable_embedding":                                        *cfg.ServiceSettings.EnableEmbedding,
+		"embed_token_expiry_in_seconds":                           *cfg.ServiceSettings.EmbedTokenExpiryInSeconds,
+		"embed_session_length_in_minutes":                         *cfg.ServiceSettings.EmbedSessionLengthInMinutes,
+		"login_history_retention_days":                            *cfg.ServiceSettings.LoginHistoryRetentionDays,
 		"enable_custom_emoji":                                     *cfg.ServiceSettings.EnableCustomEmoji,
 		"enable_emoji_picker":                                     *cfg.ServiceSettings.EnableEmojiPicker,
 		"enable_gif_picker":                                       *cfg.ServiceSettings.EnableGifPicker,
@@ -322,9 +374,13 @@ func (s *Server) trackConfig() {
 		"websocket_url":                                           isDefault(*cfg.ServiceSettings.WebsocketURL, ""),
 		"allow_cookies_for_subdomains":                            *cfg.ServiceSettings.AllowCookiesForSubdomains,
 		"enable_api_team_deletion":                                *cfg.ServiceSettings.EnableAPITeamDeletion,
+		"team_deletion_grace_period_in_days":                      *cfg.ServiceSettings.TeamDeletionGracePeriodInDays,
 		"experimental_enable_hardened_mode":                       *cfg.ServiceSettings.ExperimentalEnableHardenedMode,
 		"disable_legacy_mfa":                                      *cfg.ServiceSettings.DisableLegacyMFA,
 		"experimental_strict_csrf_enforcement":                    *cfg.ServiceSettings.ExperimentalStrictCSRFEnforcement,
+		"csrf_enforcement_mode":                                   *cfg.ServiceSettings.CSRFEnforcementMode,
+		"session_cookie_same_site":                                *cfg.ServiceSettings.SessionCookieSameSite,
+		"session_cookie_secure":                                   *cfg.ServiceSettings.SessionCookieSecure,
 		"enable_email_invitations":                                *cfg.ServiceSettings.EnableEmailInvitations,
 		"experimental_channel_organization":                       *cfg.ServiceSettings.ExperimentalChannelOrganization,
 		"experimental_channel_sidebar_organization":               *cfg.ServiceSettings.ExperimentalChannelSidebarOrganization,
@@ -335,9 +391,10 @@ func (s *Server) trackConfig() {
 		"enable_opentracing":                                      *cfg.ServiceSettings.EnableOpenTracing,
 		"experimental_data_prefetch":                              *cfg.ServiceSettings.ExperimentalDataPrefetch,
 		"enable_local_mode":                                       *cfg.ServiceSettings.EnableLocalMode,
+		"enable_permalink_previews":                               *cfg.ServiceSettings.EnablePermalinkPreviews,
 	})
 
-	s.SendDiagnostic(TRACK_CONFIG_TEAM, map[string]interface{}{
+	sink.Track(TRACK_CONFIG_TEAM, map[string]interface{}{
 		"enable_user_creation":                      cfg.TeamSettings.EnableUserCreation,
 		"enable_team_creation":                      *cfg.TeamSettings.DEPRECATED_DO_NOT_USE_EnableTeamCreation,
 		"restrict_team_invite":                      *cfg.TeamSettings.DEPRECATED_DO_NOT_USE_RestrictTeamInvite,
@@ -371,7 +428,7 @@ func (s *Server) trackConfig() {
 		"experimental_default_channels":             len(cfg.TeamSettings.ExperimentalDefaultChannels),
 	})
 
-	s.SendDiagnostic(TRACK_CONFIG_CLIENT_REQ, map[string]interface{}{
+	sink.Track(TRACK_CONFIG_CLIENT_REQ, map[string]interface{}{
 		"android_latest_version": cfg.ClientRequirements.AndroidLatestVersion,
 		"android_min_version":    cfg.ClientRequirements.AndroidMinVersion,
 		"desktop_latest_version": cfg.ClientRequirements.DesktopLatestVersion,
@@ -380,19 +437,23 @@ func (s *Server) trackConfig() {
 		"ios_min_version":        cfg.ClientRequirements.IosMinVersion,
 	})
 
-	s.SendDiagnostic(TRACK_CONFIG_SQL, map[string]interface{}{
-		"driver_name":                    *cfg.SqlSettings.DriverName,
-		"trace":                          cfg.SqlSettings.Trace,
-		"max_idle_conns":                 *cfg.SqlSettings.MaxIdleConns,
-		"conn_max_lifetime_milliseconds": *cfg.SqlSettings.ConnMaxLifetimeMilliseconds,
-		"max_open_conns":                 *cfg.SqlSettings.MaxOpenConns,
-		"data_source_replicas":           len(cfg.SqlSettings.DataSourceReplicas),
-		"data_source_search_replicas":    len(cfg.SqlSettings.DataSourceSearchReplicas),
-		"query_timeout":                  *cfg.SqlSettings.QueryTimeout,
-		"disable_database_search":        *cfg.SqlSettings.DisableDatabaseSearch,
+	sink.Track(TRACK_CONFIG_SQL, map[string]interface{}{
+		"driver_name":                              *cfg.SqlSettings.DriverName,
+		"trace":                                    cfg.SqlSettings.Trace,
+		"max_idle_conns":                           *cfg.SqlSettings.MaxIdleConns,
+		"conn_max_lifetime_milliseconds":           *cfg.SqlSettings.ConnMaxLifetimeMilliseconds,
+		"max_open_conns":                           *cfg.SqlSettings.MaxOpenConns,
+		"data_source_replicas":                     len(cfg.SqlSettings.DataSourceReplicas),
+		"data_source_search_replicas":              len(cfg.SqlSettings.DataSourceSearchReplicas),
+		"query_timeout":                            *cfg.SqlSettings.QueryTimeout,
+		"disable_database_search":                  *cfg.SqlSettings.DisableDatabaseSearch,
+		"enable_posts_partitioning":                *cfg.SqlSettings.EnablePostsPartitioning,
+		"enable_replica_fallback":                  *cfg.SqlSettings.EnableReplicaFallback,
+		"replica_fallback_max_master_read_percent": *cfg.SqlSettings.ReplicaFallbackMaxMasterReadPercent,
+		"migration_lock_timeout_seconds":           *cfg.SqlSettings.MigrationLockTimeoutSeconds,
 	})
 
-	s.SendDiagnostic(TRACK_CONFIG_LOG, map[string]interface{}{
+	sink.Track(TRACK_CONFIG_LOG, map[string]interface{}{
 		"enable_console":           cfg.LogSettings.EnableConsole,
 		"console_level":            cfg.LogSettings.ConsoleLevel,
 		"console_json":             *cfg.LogSettings.ConsoleJson,
@@ -402,9 +463,10 @@ func (s *Server) trackConfig() {
 		"enable_webhook_debugging": cfg.LogSettings.EnableWebhookDebugging,
 		"isdefault_file_location":  isDefault(cfg.LogSettings.FileLocation, ""),
 		"advanced_logging_config":  *cfg.LogSettings.AdvancedLoggingConfig != "",
+		"diagnostics_categories":   strings.Join(cfg.LogSettings.DiagnosticsCategories, ","),
 	})
 
-	s.SendDiagnostic(TRACK_CONFIG_AUDIT, map[string]interface{}{
+	sink.Track(TRACK_CONFIG_AUDIT, map[string]interface{}{
 		"syslog_enabled":        *cfg.ExperimentalAuditSettings.SysLogEnabled,
 		"syslog_insecure":       *cfg.ExperimentalAuditSettings.SysLogInsecure,
 		"syslog_max_queue_size": *cfg.ExperimentalAuditSettings.SysLogMaxQueueSize,
@@ -416,7 +478,7 @@ func (s *Server) trackConfig() {
 		"file_max_queue_size":   *cfg.ExperimentalAuditSettings.FileMaxQueueSize,
 	})
 
-	s.SendDiagnostic(TRACK_CONFIG_NOTIFICATION_LOG, map[string]interface{}{
+	sink.Track(TRACK_CONFIG_NOTIFICATION_LOG, map[string]interface{}{
 		"enable_console":          *cfg.NotificationLogSettings.EnableConsole,
 		"console_level":           *cfg.NotificationLogSettings.ConsoleLevel,
 		"console_json":            *cfg.NotificationLogSettings.ConsoleJson,
@@ -426,7 +488,7 @@ func (s *Server) trackConfig() {
 		"isdefault_file_location": isDefault(*cfg.NotificationLogSettings.FileLocation, ""),
 	})
 
-	s.SendDiagnostic(TRACK_CONFIG_PASSWORD, map[string]interface{}{
+	sink.Track(TRACK_CONFIG_PASSWORD, map[string]interface{}{
 		"minimum_length": *cfg.PasswordSettings.MinimumLength,
 		"lowercase":      *cfg.PasswordSettings.Lowercase,
 		"number":         *cfg.PasswordSettings.Number,
@@ -434,7 +496,7 @@ func (s *Server) trackConfig() {
 		"symbol":         *cfg.PasswordSettings.Symbol,
 	})
 
-	s.SendDiagnostic(TRACK_CONFIG_FILE, map[string]interface{}{
+	sink.Track(TRACK_CONFIG_FILE, map[string]interface{}{
 		"enable_public_links":     cfg.FileSettings.EnablePublicLink,
 		"driver_name":             *cfg.FileSettings.DriverName,
 		"isdefault_directory":     isDefault(*cfg.FileSettings.Directory, model.FILE_SETTINGS_DEFAULT_DIRECTORY),
@@ -447,13 +509,16 @@ func (s *Server) trackConfig() {
 		"enable_file_attachments": *cfg.FileSettings.EnableFileAttachments,
 		"enable_mobile_upload":    *cfg.FileSettings.EnableMobileUpload,
 		"enable_mobile_download":  *cfg.FileSettings.EnableMobileDownload,
+		"enable_file_enrichment":  *cfg.FileSettings.EnableFileEnrichment,
 	})
 
-	s.SendDiagnostic(TRACK_CONFIG_EMAIL, map[string]interface{}{
+	sink.Track(TRACK_CONFIG_EMAIL, map[string]interface{}{
 		"enable_sign_up_with_email":            cfg.EmailSettings.EnableSignUpWithEmail,
 		"enable_sign_in_with_email":            *cfg.EmailSettings.EnableSignInWithEmail,
 		"enable_sign_in_with_username":         *cfg.EmailSettings.EnableSignInWithUsername,
 		"require_email_verification":           cfg.EmailSettings.RequireEmailVerification,
+		"email_verification_grace_period_days": *cfg.EmailSettings.EmailVerificationGracePeriodDays,
+		"email_verification_reminder_days":     *cfg.EmailSettings.EmailVerificationReminderDays,
 		"send_email_notifications":             cfg.EmailSettings.SendEmailNotifications,
 		"use_channel_in_email_notifications":   *cfg.EmailSettings.UseChannelInEmailNotifications,
 		"email_notification_contents_type":     *cfg.EmailSettings.EmailNotificationContentsType,
@@ -476,7 +541,7 @@ func (s *Server) trackConfig() {
 		"smtp_server_timeout":                  *cfg.EmailSettings.SMTPServerTimeout,
 	})
 
-	s.SendDiagnostic(TRACK_CONFIG_RATE, map[string]interface{}{
+	sink.Track(TRACK_CONFIG_RATE, map[string]interface{}{
 		"enable_rate_limiter":      *cfg.RateLimitSettings.Enable,
 		"vary_by_remote_address":   *cfg.RateLimitSettings.VaryByRemoteAddr,
 		"vary_by_user":             *cfg.RateLimitSettings.VaryByUser,
@@ -486,25 +551,25 @@ func (s *Server) trackConfig() {
 		"isdefault_vary_by_header": isDefault(cfg.RateLimitSettings.VaryByHeader, ""),
 	})
 
-	s.SendDiagnostic(TRACK_CONFIG_PRIVACY, map[string]interface{}{
+	sink.Track(TRACK_CONFIG_PRIVACY, map[string]interface{}{
 		"show_email_address": cfg.PrivacySettings.ShowEmailAddress,
 		"show_full_name":     cfg.PrivacySettings.ShowFullName,
 	})
 
-	s.SendDiagnostic(TRACK_CONFIG_THEME, map[string]interface{}{
+	sink.Track(TRACK_CONFIG_THEME, map[string]interface{}{
 		"enable_theme_selection":  *cfg.ThemeSettings.EnableThemeSelection,
 		"isdefault_default_theme": isDefault(*cfg.ThemeSettings.DefaultTheme, model.TEAM_SETTINGS_DEFAULT_TEAM_TEXT),
 		"allow_custom_themes":     *cfg.ThemeSettings.AllowCustomThemes,
 		"allowed_themes":          len(cfg.ThemeSettings.AllowedThemes),
 	})
 
-	s.SendDiagnostic(TRACK_CONFIG_OAUTH, map[string]interface{}{
+	sink.Track(TRACK_CONFIG_OAUTH, map[string]interface{}{
 		"enable_gitlab":    cfg.GitLabSettings.Enable,
 		"enable_google":    cfg.GoogleSettings.Enable,
 		"enable_office365": cfg.Office365Settings.Enable,
 	})
 
-	s.SendDiagnostic(TRACK_CONFIG_SUPPORT, map[string]interface{}{
+	sink.Track(TRACK_CONFIG_SUPPORT, map[string]interface{}{
 		"isdefault_terms_of_service_link":              isDefault(*cfg.SupportSettings.TermsOfServiceLink, model.SUPPORT_SETTINGS_DEFAULT_TERMS_OF_SERVICE_LINK),
 		"isdefault_privacy_policy_link":                isDefault(*cfg.SupportSettings.PrivacyPolicyLink, model.SUPPORT_SETTINGS_DEFAULT_PRIVACY_POLICY_LINK),
 		"isdefault_about_link":                         isDefault(*cfg.SupportSettings.AboutLink, model.SUPPORT_SETTINGS_DEFAULT_ABOUT_LINK),
@@ -513,10 +578,11 @@ func (s *Server) trackConfig() {
 		"isdefault_support_email":                      isDefault(*cfg.SupportSettings.SupportEmail, model.SUPPORT_SETTINGS_DEFAULT_SUPPORT_EMAIL),
 		"custom_terms_of_service_enabled":              *cfg.SupportSettings.CustomTermsOfServiceEnabled,
 		"custom_terms_of_service_re_acceptance_period": *cfg.SupportSettings.CustomTermsOfServiceReAcceptancePeriod,
+		"enforce_terms_of_service_policies":            *cfg.SupportSettings.EnforceTermsOfServicePolicies,
 		"enable_ask_community_link":                    *cfg.SupportSettings.EnableAskCommunityLink,
 	})
 
-	s.SendDiagnostic(TRACK_CONFIG_LDAP, map[string]interface{}{
+	sink.Track(TRACK_CONFIG_LDAP, map[string]interface{}{
 		"enable":                                 *cfg.LdapSettings.Enable,
 		"enable_sync":                            *cfg.LdapSettings.EnableSync,
 		"enable_admin_filter":                    *cfg.LdapSettings.EnableAdminFilter,
@@ -532,6 +598,7 @@ func (s *Server) trackConfig() {
 		"isdefault_nickname_attribute":           isDefault(*cfg.LdapSettings.NicknameAttribute, model.LDAP_SETTINGS_DEFAULT_NICKNAME_ATTRIBUTE),
 		"isdefault_id_attribute":                 isDefault(*cfg.LdapSettings.IdAttribute, model.LDAP_SETTINGS_DEFAULT_ID_ATTRIBUTE),
 		"isdefault_position_attribute":           isDefault(*cfg.LdapSettings.PositionAttribute, model.LDAP_SETTINGS_DEFAULT_POSITION_ATTRIBUTE),
+		"isdefault_manager_attribute":            isDefault(*cfg.LdapSettings.ManagerAttribute, model.LDAP_SETTINGS_DEFAULT_MANAGER_ATTRIBUTE),
 		"isdefault_login_id_attribute":           isDefault(*cfg.LdapSettings.LoginIdAttribute, ""),
 		"isdefault_login_field_name":             isDefault(*cfg.LdapSettings.LoginFieldName, model.LDAP_SETTINGS_DEFAULT_LOGIN_FIELD_NAME),
 		"isdefault_login_button_color":           isDefault(*cfg.LdapSettings.LoginButtonColor, ""),
@@ -545,18 +612,19 @@ func (s *Server) trackConfig() {
 		"isnotempty_picture_attribute":           !isDefault(*cfg.LdapSettings.PictureAttribute, ""),
 	})
 
-	s.SendDiagnostic(TRACK_CONFIG_COMPLIANCE, map[string]interface{}{
-		"enable":       *cfg.ComplianceSettings.Enable,
-		"enable_daily": *cfg.ComplianceSettings.EnableDaily,
+	sink.Track(TRACK_CONFIG_COMPLIANCE, map[string]interface{}{
+		"enable":                *cfg.ComplianceSettings.Enable,
+		"enable_daily":          *cfg.ComplianceSettings.EnableDaily,
+		"enable_post_integrity": *cfg.ComplianceSettings.EnablePostIntegrity,
 	})
 
-	s.SendDiagnostic(TRACK_CONFIG_LOCALIZATION, map[string]interface{}{
+	sink.Track(TRACK_CONFIG_LOCALIZATION, map[string]interface{}{
 		"default_server_locale": *cfg.LocalizationSettings.DefaultServerLocale,
 		"default_client_locale": *cfg.LocalizationSettings.DefaultClientLocale,
 		"available_locales":     *cfg.LocalizationSettings.AvailableLocales,
 	})
 
-	s.SendDiagnostic(TRACK_CONFIG_SAML, map[string]interface{}{
+	sink.Track(TRACK_CONFIG_SAML, map[string]interface{}{
 		"enable":                              *cfg.SamlSettings.Enable,
 		"enable_sync_with_ldap":               *cfg.SamlSettings.EnableSyncWithLdap,
 		"enable_sync_with_ldap_include_auth":  *cfg.SamlSettings.EnableSyncWithLdapIncludeAuth,
@@ -584,7 +652,7 @@ func (s *Server) trackConfig() {
 		"isdefault_login_button_text_color":   isDefault(*cfg.SamlSettings.LoginButtonTextColor, ""),
 	})
 
-	s.SendDiagnostic(TRACK_CONFIG_CLUSTER, map[string]interface{}{
+	sink.Track(TRACK_CONFIG_CLUSTER, map[string]interface{}{
 		"enable":                  *cfg.ClusterSettings.Enable,
 		"network_interface":       isDefault(*cfg.ClusterSettings.NetworkInterface, ""),
 		"bind_address":            isDefault(*cfg.ClusterSettings.BindAddress, ""),
@@ -594,38 +662,39 @@ func (s *Server) trackConfig() {
 		"read_only_config":        *cfg.ClusterSettings.ReadOnlyConfig,
 	})
 
-	s.SendDiagnostic(TRACK_CONFIG_METRICS, map[string]interface{}{
+	sink.Track(TRACK_CONFIG_METRICS, map[string]interface{}{
 		"enable":             *cfg.MetricsSettings.Enable,
 		"block_profile_rate": *cfg.MetricsSettings.BlockProfileRate,
 	})
 
-	s.SendDiagnostic(TRACK_CONFIG_NATIVEAPP, map[string]interface{}{
+	sink.Track(TRACK_CONFIG_NATIVEAPP, map[string]interface{}{
 		"isdefault_app_download_link":         isDefault(*cfg.NativeAppSettings.AppDownloadLink, model.NATIVEAPP_SETTINGS_DEFAULT_APP_DOWNLOAD_LINK),
 		"isdefault_android_app_download_link": isDefault(*cfg.NativeAppSettings.AndroidAppDownloadLink, model.NATIVEAPP_SETTINGS_DEFAULT_ANDROID_APP_DOWNLOAD_LINK),
 		"isdefault_iosapp_download_link":      isDefault(*cfg.NativeAppSettings.IosAppDownloadLink, model.NATIVEAPP_SETTINGS_DEFAULT_IOS_APP_DOWNLOAD_LINK),
 	})
 
-	s.SendDiagnostic(TRACK_CONFIG_EXPERIMENTAL, map[string]interface{}{
+	sink.Track(TRACK_CONFIG_EXPERIMENTAL, map[string]interface{}{
 		"client_side_cert_enable":            *cfg.ExperimentalSettings.ClientSideCertEnable,
 		"isdefault_client_side_cert_check":   isDefault(*cfg.ExperimentalSettings.ClientSideCertCheck, model.CLIENT_SIDE_CERT_CHECK_PRIMARY_AUTH),
 		"link_metadata_timeout_milliseconds": *cfg.ExperimentalSettings.LinkMetadataTimeoutMilliseconds,
 		"enable_click_to_reply":              *cfg.ExperimentalSettings.EnableClickToReply,
 		"restrict_system_admin":              *cfg.ExperimentalSettings.RestrictSystemAdmin,
 		"use_new_saml_library":               *cfg.ExperimentalSettings.UseNewSAMLLibrary,
+		"enable_store_fault_injection":       *cfg.ExperimentalSettings.EnableStoreFaultInjection,
 	})
 
-	s.SendDiagnostic(TRACK_CONFIG_ANALYTICS, map[string]interface{}{
+	sink.Track(TRACK_CONFIG_ANALYTICS, map[string]interface{}{
 		"isdefault_max_users_for_statistics": isDefault(*cfg.AnalyticsSettings.MaxUsersForStatistics, model.ANALYTICS_SETTINGS_DEFAULT_MAX_USERS_FOR_STATISTICS),
 	})
 
-	s.SendDiagnostic(TRACK_CONFIG_ANNOUNCEMENT, map[string]interface{}{
+	sink.Track(TRACK_CONFIG_ANNOUNCEMENT, map[string]interface{}{
 		"enable_banner":               *cfg.AnnouncementSettings.EnableBanner,
 		"isdefault_banner_color":      isDefault(*cfg.AnnouncementSettings.BannerColor, model.ANNOUNCEMENT_SETTINGS_DEFAULT_BANNER_COLOR),
 		"isdefault_banner_text_color": isDefault(*cfg.AnnouncementSettings.BannerTextColor, model.ANNOUNCEMENT_SETTINGS_DEFAULT_BANNER_TEXT_COLOR),
 		"allow_banner_dismissal":      *cfg.AnnouncementSettings.AllowBannerDismissal,
 	})
 
-	s.SendDiagnostic(TRACK_CONFIG_ELASTICSEARCH, map[string]interface{}{
+	sink.Track(TRACK_CONFIG_ELASTICSEARCH, map[string]interface{}{
 		"isdefault_connection_url":          isDefault(*cfg.ElasticsearchSettings.ConnectionUrl, model.ELASTICSEARCH_SETTINGS_DEFAULT_CONNECTION_URL),
 		"isdefault_username":                isDefault(*cfg.ElasticsearchSettings.Username, model.ELASTICSEARCH_SETTINGS_DEFAULT_USERNAME),
 		"isdefault_password":                isDefault(*cfg.ElasticsearchSettings.Password, model.ELASTICSEARCH_SETTINGS_DEFAULT_PASSWORD),
@@ -698,17 +767,51 @@ func (s *Server) trackConfig() {
 		}
 	}
 
-	s.SendDiagnostic(TRACK_CONFIG_PLUGIN, pluginConfigData)
+	sink.Track(TRACK_CONFIG_PLUGIN, pluginConfigData)
 
-	s.SendDiagnostic(TRACK_CONFIG_DATA_RETENTION, map[string]interface{}{
+	sink.Track(TRACK_CONFIG_DATA_RETENTION, map[string]interface{}{
 		"enable_message_deletion": *cfg.DataRetentionSettings.EnableMessageDeletion,
 		"enable_file_deletion":    *cfg.DataRetentionSettings.EnableFileDeletion,
 		"message_retention_days":  *cfg.DataRetentionSettings.MessageRetentionDays,
 		"file_retention_days":     *cfg.DataRetentionSettings.FileRetentionDays,
 		"deletion_job_start_time": *cfg.DataRetentionSettings.DeletionJobStartTime,
+		"batch_size":              *cfg.DataRetentionSettings.BatchSize,
+		"time_between_batches":    *cfg.DataRetentionSettings.TimeBetweenBatchesMilliseconds,
+	})
+
+	sink.Track(TRACK_CONFIG_ARCHIVE, map[string]interface{}{
+		"enable_post_archiving":  *cfg.ArchiveSettings.EnablePostArchiving,
+		"archive_after_months":   *cfg.ArchiveSettings.ArchiveAfterMonths,
+		"archive_job_start_time": *cfg.ArchiveSettings.ArchiveJobStartTime,
+		"batch_size":             *cfg.ArchiveSettings.BatchSize,
+	})
+
+	sink.Track(TRACK_CONFIG_BACKUP, map[string]interface{}{
+		"enable_scheduled_backups": *cfg.BackupSettings.EnableScheduledBackups,
+		"isdefault_directory":      isDefault(*cfg.BackupSettings.Directory, model.BACKUP_SETTINGS_DEFAULT_DIRECTORY),
+		"interval_hours":           *cfg.BackupSettings.IntervalHours,
+		"enable_incremental":       *cfg.BackupSettings.EnableIncremental,
+		"max_incremental_backups":  *cfg.BackupSettings.MaxIncrementalBackups,
+		"include_files":            *cfg.BackupSettings.IncludeFiles,
+		"encrypted":                *cfg.BackupSettings.EncryptionPassphrase != "",
+	})
+
+	sink.Track(TRACK_CONFIG_EVENT_STREAM, map[string]interface{}{
+		"enable":      *cfg.EventStreamSettings.Enable,
+		"sink":        *cfg.EventStreamSettings.Sink,
+		"event_types": *cfg.EventStreamSettings.EventTypes,
+		"batch_size":  *cfg.EventStreamSettings.BatchSize,
+	})
+
+	sink.Track(TRACK_CONFIG_PROFILING, map[string]interface{}{
+		"enable_automatic_capture":         *cfg.ProfilingSettings.EnableAutomaticCapture,
+		"latency_threshold_milliseconds":   *cfg.ProfilingSettings.LatencyThresholdMilliseconds,
+		"memory_threshold_mb":              *cfg.ProfilingSettings.MemoryThresholdMB,
+		"cpu_profile_seconds":              *cfg.ProfilingSettings.CPUProfileSeconds,
+		"minimum_capture_interval_minutes": *cfg.ProfilingSettings.MinimumCaptureIntervalMinutes,
 	})
 
-	s.SendDiagnostic(TRACK_CONFIG_MESSAGE_EXPORT, map[string]interface{}{
+	sink.Track(TRACK_CONFIG_MESSAGE_EXPORT, map[string]interface{}{
 		"enable_message_export":                 *cfg.MessageExportSettings.EnableExport,
 		"export_format":                         *cfg.MessageExportSettings.ExportFormat,
 		"daily_run_time":                        *cfg.MessageExportSettings.DailyRunTime,
@@ -721,26 +824,26 @@ func (s *Server) trackConfig() {
 		"global_relay_smtp_server_timeout":      *cfg.EmailSettings.SMTPServerTimeout,
 	})
 
-	s.SendDiagnostic(TRACK_CONFIG_DISPLAY, map[string]interface{}{
+	sink.Track(TRACK_CONFIG_DISPLAY, map[string]interface{}{
 		"experimental_timezone":        *cfg.DisplaySettings.ExperimentalTimezone,
 		"isdefault_custom_url_schemes": len(cfg.DisplaySettings.CustomUrlSchemes) != 0,
 	})
 
-	s.SendDiagnostic(TRACK_CONFIG_GUEST_ACCOUNTS, map[string]interface{}{
+	sink.Track(TRACK_CONFIG_GUEST_ACCOUNTS, map[string]interface{}{
 		"enable":                                 *cfg.GuestAccountsSettings.Enable,
 		"allow_email_accounts":                   *cfg.GuestAccountsSettings.AllowEmailAccounts,
 		"enforce_multifactor_authentication":     *cfg.GuestAccountsSettings.EnforceMultifactorAuthentication,
 		"isdefault_restrict_creation_to_domains": isDefault(*cfg.GuestAccountsSettings.RestrictCreationToDomains, ""),
 	})
 
-	s.SendDiagnostic(TRACK_CONFIG_IMAGE_PROXY, map[string]interface{}{
+	sink.Track(TRACK_CONFIG_IMAGE_PROXY, map[string]interface{}{
 		"enable":                               *cfg.ImageProxySettings.Enable,
 		"image_proxy_type":                     *cfg.ImageProxySettings.ImageProxyType,
 		"isdefault_remote_image_proxy_url":     isDefault(*cfg.ImageProxySettings.RemoteImageProxyURL, ""),
 		"isdefault_remote_image_proxy_options": isDefault(*cfg.ImageProxySettings.RemoteImageProxyOptions, ""),
 	})
 
-	s.SendDiagnostic(TRACK_CONFIG_BLEVE, map[string]interface{}{
+	sink.Track(TRACK_CONFIG_BLEVE, map[string]interface{}{
 		"enable_indexing":                   *cfg.BleveSettings.EnableIndexing,
 		"enable_searching":                  *cfg.BleveSettings.EnableSearching,
 		"enable_autocomplete":               *cfg.BleveSettings.EnableAutocomplete,
@@ -748,7 +851,7 @@ func (s *Server) trackConfig() {
 	})
 }
 
-func (s *Server) trackLicense() {
+func (s *Server) trackLicense(sink DiagnosticsSink) {
 	if license := s.License(); license != nil {
 		data := map[string]interface{}{
 			"customer_id": license.Customer.Id,
@@ -765,11 +868,11 @@ func (s *Server) trackLicense() {
 			data["feature_"+featureName] = featureValue
 		}
 
-		s.SendDiagnostic(TRACK_LICENSE, data)
+		sink.Track(TRACK_LICENSE, data)
 	}
 }
 
-func (s *Server) trackPlugins() {
+func (s *Server) trackPlugins(sink DiagnosticsSink) {
 	pluginsEnvironment := s.GetPluginsEnvironment()
 	if pluginsEnvironment == nil {
 		return
@@ -820,7 +923,7 @@ func (s *Server) trackPlugins() {
 		totalDisabledCount = -1 // -1 to indicate disabled or error
 	}
 
-	s.SendDiagnostic(TRACK_PLUGINS, map[string]interface{}{
+	sink.Track(TRACK_PLUGINS, map[string]interface{}{
 		"enabled_plugins":               totalEnabledCount,
 		"enabled_webapp_plugins":        webappEnabledCount,
 		"enabled_backend_plugins":       backendEnabledCount,
@@ -832,7 +935,7 @@ func (s *Server) trackPlugins() {
 	})
 }
 
-func (s *Server) trackServer() {
+func (s *Server) trackServer(sink DiagnosticsSink) {
 	data := map[string]interface{}{
 		"edition":          model.BuildEnterpriseReady,
 		"version":          model.CurrentVersion,
@@ -848,10 +951,10 @@ func (s *Server) trackServer() {
 		data["database_version"] = scr
 	}
 
-	s.SendDiagnostic(TRACK_SERVER, data)
+	sink.Track(TRACK_SERVER, data)
 }
 
-func (s *Server) trackPermissions() {
+func (s *Server) trackPermissions(sink DiagnosticsSink) {
 	phase1Complete := false
 	if _, err := s.Store.System().GetByName(ADVANCED_PERMISSIONS_MIGRATION_KEY); err == nil {
 		phase1Complete = true
@@ -862,7 +965,7 @@ func (s *Server) trackPermissions() {
 		phase2Complete = true
 	}
 
-	s.SendDiagnostic(TRACK_PERMISSIONS_GENERAL, map[string]interface{}{
+	sink.Track(TRACK_PERMISSIONS_GENERAL, map[string]interface{}{
 		"phase_1_migration_complete": phase1Complete,
 		"phase_2_migration_complete": phase2Complete,
 	})
@@ -907,7 +1010,7 @@ func (s *Server) trackPermissions() {
 		channelGuestPermissions = strings.Join(role.Permissions, " ")
 	}
 
-	s.SendDiagnostic(TRACK_PERMISSIONS_SYSTEM_SCHEME, map[string]interface{}{
+	sink.Track(TRACK_PERMISSIONS_SYSTEM_SCHEME, map[string]interface{}{
 		"system_admin_permissions":  systemAdminPermissions,
 		"system_user_permissions":   systemUserPermissions,
 		"team_admin_permissions":    teamAdminPermissions,
@@ -950,9 +1053,9 @@ func (s *Server) trackPermissions() {
 				channelGuestPermissions = strings.Join(role.Permissions, " ")
 			}
 
-			count, _ := s.Store.Team().AnalyticsGetTeamCountForScheme(scheme.Id)
+			count, _ := s.Store.Team().AnalyticsGetTeamCountForScheme(context.Background(), scheme.Id)
 
-			s.SendDiagnostic(TRACK_PERMISSIONS_TEAM_SCHEMES, map[string]interface{}{
+			sink.Track(TRACK_PERMISSIONS_TEAM_SCHEMES, map[string]interface{}{
 				"scheme_id":                 scheme.Id,
 				"team_admin_permissions":    teamAdminPermissions,
 				"team_user_permissions":     teamUserPermissions,
@@ -966,7 +1069,7 @@ func (s *Server) trackPermissions() {
 	}
 }
 
-func (s *Server) trackElasticsearch() {
+func (s *Server) trackElasticsearch(sink DiagnosticsSink) {
 	data := map[string]interface{}{}
 
 	for _, engine := range s.SearchEngine.GetActiveEngines() {
@@ -975,10 +1078,10 @@ func (s *Server) trackElasticsearch() {
 		}
 	}
 
-	s.SendDiagnostic(TRACK_ELASTICSEARCH, data)
+	sink.Track(TRACK_ELASTICSEARCH, data)
 }
 
-func (s *Server) trackGroups() {
+func (s *Server) trackGroups(sink DiagnosticsSink) {
 	groupCount, err := s.Store.Group().GroupCount()
 	if err != nil {
 		mlog.Error(err.Error())
@@ -994,7 +1097,7 @@ func (s *Server) trackGroups() {
 		mlog.Error(err.Error())
 	}
 
-	groupSyncedTeamCount, err := s.Store.Team().GroupSyncedTeamCount()
+	groupSyncedTeamCount, err := s.Store.Team().GroupSyncedTeamCount(context.Background())
 	if err != nil {
 		mlog.Error(err.Error())
 	}
@@ -1019,7 +1122,7 @@ func (s *Server) trackGroups() {
 		mlog.Error(err.Error())
 	}
 
-	s.SendDiagnostic(TRACK_GROUPS, map[string]interface{}{
+	sink.Track(TRACK_GROUPS, map[string]interface{}{
 		"group_count":                      groupCount,
 		"group_team_count":                 groupTeamCount,
 		"group_channel_count":              groupChannelCount,
@@ -1031,7 +1134,7 @@ func (s *Server) trackGroups() {
 	})
 }
 
-func (s *Server) trackChannelModeration() {
+func (s *Server) trackChannelModeration(sink DiagnosticsSink) {
 	channelSchemeCount, err := s.Store.Scheme().CountByScope(model.SCHEME_SCOPE_CHANNEL)
 	if err != nil {
 		mlog.Error(err.Error())
@@ -1074,7 +1177,7 @@ func (s *Server) trackChannelModeration() {
 		mlog.Error(err.Error())
 	}
 
-	s.SendDiagnostic(TRACK_CHANNEL_MODERATION, map[string]interface{}{
+	sink.Track(TRACK_CHANNEL_MODERATION, map[string]interface{}{
 		"channel_scheme_count": channelSchemeCount,
 
 		"create_post_user_disabled_count":  createPostUser,
@@ -0,0 +1,125 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+
+	rudder "github.com/rudderlabs/analytics-go"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+// DiagnosticsSink receives the diagnostics events collected by the server.
+type DiagnosticsSink interface {
+	Track(event string, properties map[string]interface{})
+	// Flush is called once all the events of a daily collection have been tracked.
+	Flush() error
+	Close() error
+}
+
+// rudderDiagnosticsSink sends the diagnostics to Rudder, which batches the events on its own.
+type rudderDiagnosticsSink struct {
+	client       rudder.Client
+	diagnosticId string
+}
+
+func (r *rudderDiagnosticsSink) Track(event string, properties map[string]interface{}) {
+	r.client.Enqueue(rudder.Track{
+		Event:      event,
+		UserId:     r.diagnosticId,
+		Properties: properties,
+	})
+}
+
+func (r *rudderDiagnosticsSink) Flush() error {
+	return nil
+}
+
+func (r *rudderDiagnosticsSink) Close() error {
+	return r.client.Close()
+}
+
+// httpDiagnosticsSink posts the events of each collection as a single DiagnosticsExport to the
+// endpoint the admin routes the diagnostics to instead of Rudder.
+type httpDiagnosticsSink struct {
+	url          string
+	diagnosticId string
+	categories   func() []string
+	client       func() *http.Client
+
+	mutex  sync.Mutex
+	events []*model.DiagnosticsEvent
+}
+
+func (h *httpDiagnosticsSink) Track(event string, properties map[string]interface{}) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.events = append(h.events, &model.DiagnosticsEvent{Event: event, Properties: properties})
+}
+
+func (h *httpDiagnosticsSink) Flush() error {
+	h.mutex.Lock()
+	events := h.events
+	h.events = nil
+	h.mutex.Unlock()
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	export := &model.DiagnosticsExport{
+		DiagnosticId: h.diagnosticId,
+		CreateAt:     model.GetMillis(),
+		Categories:   h.categories(),
+		Events:       events,
+	}
+
+	req, err := http.NewRequest("POST", h.url, bytes.NewReader([]byte(export.ToJson())))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := h.client().Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, MaxIntegrationResponseSize))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (h *httpDiagnosticsSink) Close() error {
+	return h.Flush()
+}
+
+// memoryDiagnosticsSink keeps the events tracked, to export them instead of sending them.
+type memoryDiagnosticsSink struct {
+	events []*model.DiagnosticsEvent
+}
+
+func (m *memoryDiagnosticsSink) Track(event string, properties map[string]interface{}) {
+	m.events = append(m.events, &model.DiagnosticsEvent{Event: event, Properties: properties})
+}
+
+func (m *memoryDiagnosticsSink) Flush() error {
+	return nil
+}
+
+func (m *memoryDiagnosticsSink) Close() error {
+	return nil
+}
@@ -269,3 +269,85 @@ func TestRudderDiagnostics(t *testing.T) {
 		}
 	})
 }
+
+func TestCollectDiagnosticsCategories(t *testing.T) {
+	th := SetupWithCustomConfig(t, func(config *model.Config) {
+		*config.PluginSettings.Enable = false
+	})
+	defer th.TearDown()
+
+	collect := func() []string {
+		sink := &memoryDiagnosticsSink{}
+		th.Server.collectDiagnostics(sink)
+
+		var events []string
+		for _, event := range sink.events {
+			events = append(events, event.Event)
+		}
+		return events
+	}
+
+	events := collect()
+	for _, item := range []string{TRACK_ACTIVITY, TRACK_CONFIG_SERVICE, TRACK_SERVER, TRACK_PERMISSIONS_GENERAL} {
+		assert.Contains(t, events, item)
+	}
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		cfg.LogSettings.DiagnosticsCategories = []string{model.DIAGNOSTICS_CATEGORY_SERVER}
+	})
+	assert.Equal(t, []string{TRACK_SERVER}, collect())
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		cfg.LogSettings.DiagnosticsCategories = []string{}
+	})
+	assert.Empty(t, collect())
+
+	export := th.Server.ExportDiagnostics()
+	assert.Equal(t, th.App.DiagnosticId(), export.DiagnosticId)
+	assert.Empty(t, export.Categories)
+	assert.Empty(t, export.Events)
+}
+
+func TestDiagnosticsSinkURL(t *testing.T) {
+	th := SetupWithCustomConfig(t, func(config *model.Config) {
+		*config.PluginSettings.Enable = false
+	})
+	defer th.TearDown()
+
+	data := make(chan *model.DiagnosticsExport, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data <- model.DiagnosticsExportFromJson(r.Body)
+	}))
+	defer server.Close()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.LogSettings.EnableDiagnostics = true
+		*cfg.LogSettings.DiagnosticsSinkURL = server.URL
+		cfg.LogSettings.DiagnosticsCategories = []string{model.DIAGNOSTICS_CATEGORY_SERVER, model.DIAGNOSTICS_CATEGORY_ACTIVITY}
+	})
+
+	// The sink URL is enough to send the diagnostics, even without the Rudder keys.
+	th.App.Srv().SendDailyDiagnostics()
+
+	select {
+	case export := <-data:
+		require.NotNil(t, export)
+		assert.Equal(t, th.App.DiagnosticId(), export.DiagnosticId)
+		assert.Equal(t, []string{model.DIAGNOSTICS_CATEGORY_SERVER, model.DIAGNOSTICS_CATEGORY_ACTIVITY}, export.Categories)
+		require.Len(t, export.Events, 2)
+		assert.Equal(t, TRACK_ACTIVITY, export.Events[0].Event)
+		assert.Equal(t, TRACK_SERVER, export.Events[1].Event)
+	case <-time.After(time.Second * 5):
+		require.Fail(t, "Did not receive diagnostics")
+	}
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.LogSettings.EnableDiagnostics = false })
+	th.App.Srv().SendDailyDiagnostics()
+
+	select {
+	case <-data:
+		require.Fail(t, "Should not send diagnostics when they are disabled")
+	case <-time.After(time.Second * 1):
+		// Did not receive diagnostics
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"net/http"
 
@@ -165,6 +166,32 @@ func (es *EmailService) sendVerifyEmail(userEmail, locale, siteURL, token string
 	return nil
 }
 
+// sendVerifyEmailReminderEmail reminds the user that their account will get locked in the given
+// number of days unless they verify their email address.
+func (es *EmailService) sendVerifyEmailReminderEmail(userEmail, locale, siteURL, token string, days int) *model.AppError {
+	T := utils.GetUserTranslations(locale)
+
+	link := fmt.Sprintf("%s/do_verify_email?token=%s&email=%s", siteURL, token, url.QueryEscape(userEmail))
+
+	serverURL := condenseSiteURL(siteURL)
+
+	subject := T("api.templates.verify_reminder_subject",
+		map[string]interface{}{"SiteName": es.srv.Config().TeamSettings.SiteName})
+
+	bodyPage := es.newEmailTemplate("verify_body", locale)
+	bodyPage.Props["SiteURL"] = siteURL
+	bodyPage.Props["Title"] = T("api.templates.verify_reminder_body.title", map[string]interface{}{"ServerURL": serverURL})
+	bodyPage.Props["Info"] = T("api.templates.verify_reminder_body.info", days)
+	bodyPage.Props["VerifyUrl"] = link
+	bodyPage.Props["Button"] = T("api.templates.verify_body.button")
+
+	if err := es.sendMail(userEmail, subject, bodyPage.Render()); err != nil {
+		return model.NewAppError("SendVerifyEmailReminderEmail", "api.user.send_verify_email_and_forget.failed.error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return nil
+}
+
 func (es *EmailService) SendSignInChangeEmail(email, method, locale, siteURL string) *model.AppError {
 	T := utils.GetUserTranslations(locale)
 
@@ -269,6 +296,60 @@ func (es *EmailService) sendUserAccessTokenAddedEmail(email, locale, siteURL str
 	return nil
 }
 
+func (es *EmailService) sendImpersonationStartedEmail(email, impersonatorName string, writeAccess bool, expiresAt int64, locale, siteURL string) *model.AppError {
+	T := utils.GetUserTranslations(locale)
+
+	subject := T("api.templates.impersonation_subject",
+		map[string]interface{}{"SiteName": es.srv.Config().TeamSettings.SiteName})
+
+	access := T("api.templates.impersonation_body.read_only")
+	if writeAccess {
+		access = T("api.templates.impersonation_body.write_access")
+	}
+
+	bodyPage := es.newEmailTemplate("password_change_body", locale)
+	bodyPage.Props["SiteURL"] = siteURL
+	bodyPage.Props["Title"] = T("api.templates.impersonation_body.title")
+	bodyPage.Props["Info"] = T("api.templates.impersonation_body.info",
+		map[string]interface{}{
+			"Impersonator": impersonatorName,
+			"Access":       access,
+			"ExpiresAt":    time.Unix(0, expiresAt*int64(time.Millisecond)).UTC().Format(time.RFC1123),
+			"SiteURL":      siteURL,
+		})
+	bodyPage.Props["Warning"] = T("api.templates.email_warning")
+
+	if err := es.sendMail(email, subject, bodyPage.Render()); err != nil {
+		return model.NewAppError("sendImpersonationStartedEmail", "api.user.send_impersonation_started_email.error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return nil
+}
+
+func (es *EmailService) sendTeamPurgeScheduledEmail(email, teamDisplayName string, purgeAt int64, locale, siteURL string) *model.AppError {
+	T := utils.GetUserTranslations(locale)
+
+	subject := T("api.templates.team_purge_scheduled_subject",
+		map[string]interface{}{"SiteName": es.srv.Config().TeamSettings.SiteName, "TeamDisplayName": teamDisplayName})
+
+	bodyPage := es.newEmailTemplate("password_change_body", locale)
+	bodyPage.Props["SiteURL"] = siteURL
+	bodyPage.Props["Title"] = T("api.templates.team_purge_scheduled_body.title")
+	bodyPage.Props["Info"] = T("api.templates.team_purge_scheduled_body.info",
+		map[string]interface{}{
+			"TeamDisplayName": teamDisplayName,
+			"PurgeAt":         time.Unix(0, purgeAt*int64(time.Millisecond)).UTC().Format(time.RFC1123),
+			"SiteURL":         siteURL,
+		})
+	bodyPage.Props["Warning"] = T("api.templates.email_warning")
+
+	if err := es.sendMail(email, subject, bodyPage.Render()); err != nil {
+		return model.NewAppError("sendTeamPurgeScheduledEmail", "api.team.send_purge_scheduled_email.error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return nil
+}
+
 func (es *EmailService) SendPasswordResetEmail(email string, token *model.Token, locale, siteURL string) (bool, *model.AppError) {
 	T := utils.GetUserTranslations(locale)
 
@@ -536,6 +617,66 @@ func (es *EmailService) SendRemoveExpiredLicenseEmail(email string, locale, site
 	return nil
 }
 
+func (es *EmailService) SendSeatUsageForecastEmail(email string, locale, siteURL string, forecast *model.SeatUsageForecast) *model.AppError {
+	T := utils.GetUserTranslations(locale)
+	subject := T("api.templates.seat_usage_forecast.subject",
+		map[string]interface{}{"SiteName": es.srv.Config().TeamSettings.SiteName})
+
+	props := map[string]interface{}{
+		"CurrentSeats":  forecast.CurrentSeats,
+		"LicensedSeats": forecast.LicensedSeats,
+		"Date":          time.Unix(0, forecast.ProjectedAt*int64(time.Millisecond)).UTC().Format("January 2, 2006"),
+	}
+
+	bodyPage := es.newEmailTemplate("seat_usage_forecast", locale)
+	bodyPage.Props["SiteURL"] = siteURL
+	if forecast.Exceeded {
+		bodyPage.Props["Title"] = T("api.templates.seat_usage_forecast.body.exceeded_title")
+		bodyPage.Props["Info"] = T("api.templates.seat_usage_forecast.body.exceeded_info", props)
+	} else {
+		bodyPage.Props["Title"] = T("api.templates.seat_usage_forecast.body.title")
+		bodyPage.Props["Info"] = T("api.templates.seat_usage_forecast.body.info", props)
+	}
+	bodyPage.Props["Link"] = siteURL + "/admin_console/about/license"
+	bodyPage.Props["LinkButton"] = T("api.templates.seat_usage_forecast.body.license_button")
+
+	if err := es.sendMail(email, subject, bodyPage.Render()); err != nil {
+		return model.NewAppError("SendSeatUsageForecastEmail", "api.license.seat_usage_forecast.failed.error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return nil
+}
+
+func (es *EmailService) SendRoleGrantExpiredEmail(email string, locale, siteURL string, roles []string, scope model.RoleScope, scopeName string, expiredAt int64) *model.AppError {
+	T := utils.GetUserTranslations(locale)
+	subject := T("api.templates.role_grant_expired.subject",
+		map[string]interface{}{"SiteName": es.srv.Config().TeamSettings.SiteName})
+
+	props := map[string]interface{}{
+		"Roles":     strings.Join(roles, ", "),
+		"ScopeName": scopeName,
+		"Date":      time.Unix(0, expiredAt*int64(time.Millisecond)).UTC().Format("January 2, 2006 15:04 MST"),
+	}
+
+	bodyPage := es.newEmailTemplate("role_grant_expired", locale)
+	bodyPage.Props["SiteURL"] = siteURL
+	bodyPage.Props["Title"] = T("api.templates.role_grant_expired.body.title")
+	switch scope {
+	case model.RoleScopeTeam:
+		bodyPage.Props["Info"] = T("api.templates.role_grant_expired.body.team_info", props)
+	case model.RoleScopeChannel:
+		bodyPage.Props["Info"] = T("api.templates.role_grant_expired.body.channel_info", props)
+	default:
+		bodyPage.Props["Info"] = T("api.templates.role_grant_expired.body.system_info", props)
+	}
+
+	if err := es.sendNotificationMail(email, subject, bodyPage.Render()); err != nil {
+		return model.NewAppError("SendRoleGrantExpiredEmail", "api.templates.role_grant_expired.failed.error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return nil
+}
+
 func (es *EmailService) sendNotificationMail(to, subject, htmlBody string) *model.AppError {
 	if !*es.srv.Config().EmailSettings.SendEmailNotifications {
 		return nil
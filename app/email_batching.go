@@ -4,6 +4,7 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"html/template"
 	"strconv"
@@ -140,7 +141,7 @@ func (job *EmailBatchingJob) checkPendingNotifications(now time.Time, handler fu
 				continue
 			}
 
-			team, err := job.server.Store.Team().GetByName(notifications[0].teamName)
+			team, err := job.server.Store.Team().GetByName(context.Background(), notifications[0].teamName)
 			if err != nil {
 				mlog.Error("Unable to find Team id for notification", mlog.Err(err))
 				continue
@@ -0,0 +1,181 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+const (
+	EMAIL_VERIFICATION_ENFORCEMENT_BATCH_SIZE = 100
+)
+
+// checkEmailVerificationGracePeriod returns an error if the unverified user has missed the
+// deadline to verify their email address. The deadline is set the first time the user logs in.
+func (a *App) checkEmailVerificationGracePeriod(user *model.User) *model.AppError {
+	verification, err := a.Srv().Store.EmailVerification().Get(user.Id)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if !errors.As(err, &nfErr) {
+			return model.NewAppError("checkEmailVerificationGracePeriod", "app.email_verification.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+
+		now := model.GetMillis()
+		verification, err = a.Srv().Store.EmailVerification().Save(&model.EmailVerification{
+			UserId:   user.Id,
+			CreateAt: now,
+			Deadline: now + int64(*a.Config().EmailSettings.EmailVerificationGracePeriodDays)*DAY_MILLISECONDS,
+		})
+		if err != nil {
+			var cErr *store.ErrConflict
+			if !errors.As(err, &cErr) {
+				return model.NewAppError("checkEmailVerificationGracePeriod", "app.email_verification.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+			}
+
+			// Another login of the user set the deadline in the meantime.
+			verification, err = a.Srv().Store.EmailVerification().Get(user.Id)
+			if err != nil {
+				return model.NewAppError("checkEmailVerificationGracePeriod", "app.email_verification.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+			}
+		}
+	}
+
+	if verification.IsOverdue(model.GetMillis()) {
+		return model.NewAppError("Login", "api.user.login.email_verification_overdue.app_error", nil, "user_id="+user.Id, http.StatusUnauthorized)
+	}
+
+	return nil
+}
+
+// clearEmailVerificationDeadline forgets the deadline of the user once they don't need one anymore.
+func (a *App) clearEmailVerificationDeadline(userId string) {
+	if err := a.Srv().Store.EmailVerification().Delete(userId); err != nil {
+		mlog.Warn("Failed to clear the email verification deadline of the user", mlog.String("user_id", userId), mlog.Err(err))
+	}
+}
+
+// getUserPendingEmailVerification returns the user the entry belongs to, or nil if the user
+// verified their email address or got deactivated since, in which case the entry is cleared.
+func (a *App) getUserPendingEmailVerification(verification *model.EmailVerification) (*model.User, *model.AppError) {
+	user, appErr := a.GetUser(verification.UserId)
+	if appErr != nil && appErr.StatusCode != http.StatusNotFound {
+		return nil, appErr
+	}
+
+	if user == nil || user.EmailVerified || user.DeleteAt != 0 {
+		a.clearEmailVerificationDeadline(verification.UserId)
+		return nil, nil
+	}
+
+	return user, nil
+}
+
+// EnforceEmailVerificationGracePeriod locks the accounts of the users who missed the deadline to
+// verify their email address, and reminds the ones whose deadline is coming up.
+func (a *App) EnforceEmailVerificationGracePeriod() *model.AppError {
+	if err := a.lockOverdueEmailVerifications(); err != nil {
+		return err
+	}
+
+	return a.remindPendingEmailVerifications()
+}
+
+func (a *App) lockOverdueEmailVerifications() *model.AppError {
+	for {
+		now := model.GetMillis()
+		verifications, err := a.Srv().Store.EmailVerification().GetOverdue(now, EMAIL_VERIFICATION_ENFORCEMENT_BATCH_SIZE)
+		if err != nil {
+			return model.NewAppError("lockOverdueEmailVerifications", "app.email_verification.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+
+		for _, verification := range verifications {
+			user, appErr := a.getUserPendingEmailVerification(verification)
+			if appErr != nil {
+				return appErr
+			}
+			if user == nil {
+				continue
+			}
+
+			if appErr := a.RevokeAllSessions(user.Id); appErr != nil {
+				return appErr
+			}
+
+			verification.LockedAt = now
+			if _, err := a.Srv().Store.EmailVerification().Update(verification); err != nil {
+				return model.NewAppError("lockOverdueEmailVerifications", "app.email_verification.update.app_error", nil, err.Error(), http.StatusInternalServerError)
+			}
+		}
+
+		if len(verifications) < EMAIL_VERIFICATION_ENFORCEMENT_BATCH_SIZE {
+			return nil
+		}
+	}
+}
+
+func (a *App) remindPendingEmailVerifications() *model.AppError {
+	reminderDays := int64(*a.Config().EmailSettings.EmailVerificationReminderDays)
+	if reminderDays == 0 {
+		return nil
+	}
+
+	for {
+		now := model.GetMillis()
+		verifications, err := a.Srv().Store.EmailVerification().GetForReminder(now+reminderDays*DAY_MILLISECONDS, EMAIL_VERIFICATION_ENFORCEMENT_BATCH_SIZE)
+		if err != nil {
+			return model.NewAppError("remindPendingEmailVerifications", "app.email_verification.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+
+		for _, verification := range verifications {
+			user, appErr := a.getUserPendingEmailVerification(verification)
+			if appErr != nil {
+				return appErr
+			}
+			if user == nil {
+				continue
+			}
+
+			days := int((verification.Deadline - now + DAY_MILLISECONDS - 1) / DAY_MILLISECONDS)
+			if appErr := a.sendEmailVerificationReminder(user, days); appErr != nil {
+				// The user is only ever reminded once, so as not to retry on every run for an
+				// address that can't receive email.
+				mlog.Warn("Failed to send the email verification reminder", mlog.String("user_id", user.Id), mlog.Err(appErr))
+			}
+
+			verification.RemindedAt = now
+			if _, err := a.Srv().Store.EmailVerification().Update(verification); err != nil {
+				return model.NewAppError("remindPendingEmailVerifications", "app.email_verification.update.app_error", nil, err.Error(), http.StatusInternalServerError)
+			}
+		}
+
+		if len(verifications) < EMAIL_VERIFICATION_ENFORCEMENT_BATCH_SIZE {
+			return nil
+		}
+	}
+}
+
+func (a *App) sendEmailVerificationReminder(user *model.User, days int) *model.AppError {
+	token, appErr := a.Srv().EmailService.CreateVerifyEmailToken(user.Id, user.Email)
+	if appErr != nil {
+		return appErr
+	}
+
+	return a.Srv().EmailService.sendVerifyEmailReminderEmail(user.Email, user.Locale, a.GetSiteURL(), token.Token, days)
+}
+
+// GetUnverifiedUsers returns a page of the users signed up by email who haven't verified their
+// email address, along with their deadline to do so.
+func (a *App) GetUnverifiedUsers(page, perPage int) ([]*model.UnverifiedUser, *model.AppError) {
+	users, err := a.Srv().Store.EmailVerification().GetUnverifiedUsers(page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetUnverifiedUsers", "app.email_verification.get_unverified_users.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return users, nil
+}
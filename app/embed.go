@@ -0,0 +1,183 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+// GetEmbedConfig returns what a frame embedding a channel view on behalf of the OAuth app needs
+// for the handshake with its parent application.
+func (a *App) GetEmbedConfig(appId string) (*model.EmbedConfig, *model.AppError) {
+	oauthApp, appErr := a.getEmbedOAuthApp(appId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	return &model.EmbedConfig{
+		AppId:          oauthApp.Id,
+		FrameAncestors: oauthApp.EmbedFrameAncestors,
+	}, nil
+}
+
+// CreateEmbedToken mints a short-lived token for the OAuth app of the session to embed the view
+// of the channel on behalf of the user of the session.
+func (a *App) CreateEmbedToken(session *model.Session, channelId string) (*model.EmbedToken, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableEmbedding {
+		return nil, model.NewAppError("CreateEmbedToken", "app.embed.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	if !session.IsOAuth {
+		return nil, model.NewAppError("CreateEmbedToken", "app.embed.oauth_required.app_error", nil, "", http.StatusForbidden)
+	}
+
+	accessData, err := a.Srv().Store.OAuth().GetAccessData(session.Token)
+	if err != nil {
+		return nil, model.NewAppError("CreateEmbedToken", "app.embed.oauth_required.app_error", nil, err.Error(), http.StatusForbidden)
+	}
+
+	oauthApp, appErr := a.getEmbedOAuthApp(accessData.ClientId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	extra := &model.EmbedTokenExtra{
+		AppId:     oauthApp.Id,
+		UserId:    session.UserId,
+		ChannelId: channelId,
+	}
+	token := model.NewToken(model.TOKEN_TYPE_EMBED, extra.ToJson())
+	if err := a.Srv().Store.Token().Save(token); err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("CreateEmbedToken", "app.recover.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return &model.EmbedToken{
+		Token:     token.Token,
+		AppId:     oauthApp.Id,
+		ChannelId: channelId,
+		ExpiresAt: token.CreateAt + int64(*a.Config().ServiceSettings.EmbedTokenExpiryInSeconds)*1000,
+	}, nil
+}
+
+// ExchangeEmbedToken consumes an embed token and creates a session restricted to the channel it
+// was minted for. origin is the Origin header of the exchange request, that is the origin of the
+// parent application exchanging the token, and has to be one of the frame ancestors of the OAuth
+// app. The session is never extended and only carries the base role of the user.
+func (a *App) ExchangeEmbedToken(tokenString, origin string) (*model.Session, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableEmbedding {
+		return nil, model.NewAppError("ExchangeEmbedToken", "app.embed.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	token, err := a.Srv().Store.Token().GetByToken(tokenString)
+	if err != nil || token.Type != model.TOKEN_TYPE_EMBED {
+		return nil, model.NewAppError("ExchangeEmbedToken", "app.embed.invalid_token.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	// The token is single use, whether the exchange succeeds or not.
+	if err = a.Srv().Store.Token().Delete(token.Token); err != nil {
+		return nil, model.NewAppError("ExchangeEmbedToken", "app.embed.invalid_token.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	if model.GetMillis()-token.CreateAt > int64(*a.Config().ServiceSettings.EmbedTokenExpiryInSeconds)*1000 {
+		return nil, model.NewAppError("ExchangeEmbedToken", "app.embed.token_expired.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	extra := model.EmbedTokenExtraFromJson(strings.NewReader(token.Extra))
+	if extra == nil {
+		return nil, model.NewAppError("ExchangeEmbedToken", "app.embed.invalid_token.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	oauthApp, appErr := a.getEmbedOAuthApp(extra.AppId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if !oauthApp.AllowsEmbedOrigin(origin) {
+		return nil, model.NewAppError("ExchangeEmbedToken", "app.embed.invalid_origin.app_error", nil, "origin="+origin, http.StatusForbidden)
+	}
+
+	user, appErr := a.GetUser(extra.UserId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if user.DeleteAt != 0 {
+		return nil, model.NewAppError("ExchangeEmbedToken", "app.embed.invalid_token.app_error", nil, "user_id="+user.Id, http.StatusBadRequest)
+	}
+
+	if !a.HasPermissionToChannel(user.Id, extra.ChannelId, model.PERMISSION_READ_CHANNEL) {
+		return nil, model.NewAppError("ExchangeEmbedToken", "app.embed.channel_access.app_error", nil, "channel_id="+extra.ChannelId, http.StatusForbidden)
+	}
+
+	// The embedding application is trusted with the channel only, so the session never carries
+	// the system roles of the user beyond the base one.
+	roles := model.SYSTEM_USER_ROLE_ID
+	if user.IsGuest() {
+		roles = model.SYSTEM_GUEST_ROLE_ID
+	}
+
+	session := &model.Session{
+		UserId:  user.Id,
+		Roles:   roles,
+		IsOAuth: false,
+	}
+	session.AddProp(model.SESSION_PROP_TYPE, model.SESSION_TYPE_EMBED)
+	session.AddProp(model.SESSION_PROP_EMBED_APP_ID, oauthApp.Id)
+	session.AddProp(model.SESSION_PROP_EMBED_CHANNEL_ID, extra.ChannelId)
+	session.AddProp(model.SESSION_PROP_IS_GUEST, strconv.FormatBool(user.IsGuest()))
+	session.ExpiresAt = model.GetMillis() + int64(*a.Config().ServiceSettings.EmbedSessionLengthInMinutes)*60*1000
+
+	session, err = a.Srv().Store.Session().Save(session)
+	if err != nil {
+		var invErr *store.ErrInvalidInput
+		switch {
+		case errors.As(err, &invErr):
+			return nil, model.NewAppError("ExchangeEmbedToken", "app.session.save.existing.app_error", nil, invErr.Error(), http.StatusBadRequest)
+		default:
+			return nil, model.NewAppError("ExchangeEmbedToken", "app.session.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	a.AddSessionToCache(session)
+
+	mlog.Info("Exchanged an embed token.",
+		mlog.String("app_id", oauthApp.Id),
+		mlog.String("user_id", user.Id),
+		mlog.String("channel_id", extra.ChannelId),
+		mlog.Int64("expires_at", session.ExpiresAt),
+	)
+
+	return session, nil
+}
+
+// getEmbedOAuthApp returns the OAuth app if embedding is enabled for it.
+func (a *App) getEmbedOAuthApp(appId string) (*model.OAuthApp, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableEmbedding {
+		return nil, model.NewAppError("getEmbedOAuthApp", "app.embed.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	oauthApp, appErr := a.GetOAuthApp(appId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if len(oauthApp.EmbedFrameAncestors) == 0 {
+		return nil, model.NewAppError("getEmbedOAuthApp", "app.embed.app_not_allowed.app_error", nil, "app_id="+appId, http.StatusForbidden)
+	}
+
+	return oauthApp, nil
+}
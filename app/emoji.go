@@ -75,14 +75,15 @@ func (a *App) CreateEmoji(sessionUserId string, emoji *model.Emoji, multiPartIma
 		return nil, model.NewAppError("CreateEmoji", "app.emoji.create.internal_error", nil, err.Error(), http.StatusInternalServerError)
 	}
 
-	message := model.NewWebSocketEvent(model.WEBSOCKET_EVENT_EMOJI_ADDED, "", "", "", nil)
+	// Only the members of the team are told about the emoji of a team.
+	message := model.NewWebSocketEvent(model.WEBSOCKET_EVENT_EMOJI_ADDED, emoji.TeamId, "", "", nil)
 	message.Add("emoji", emoji.ToJson())
 	a.Publish(message)
 	return emoji, nil
 }
 
 func (a *App) GetEmojiList(page, perPage int, sort string) ([]*model.Emoji, *model.AppError) {
-	list, err := a.Srv().Store.Emoji().GetList(page*perPage, perPage, sort)
+	list, err := a.Srv().Store.Emoji().GetList(page*perPage, perPage, sort, nil)
 	if err != nil {
 		return nil, model.NewAppError("GetEmojiList", "app.emoji.get_list.internal_error", nil, err.Error(), http.StatusInternalServerError)
 	}
@@ -90,6 +91,37 @@ func (a *App) GetEmojiList(page, perPage int, sort string) ([]*model.Emoji, *mod
 	return list, nil
 }
 
+// GetEmojiListForUser returns the emoji available to the user, which are the ones available to everyone
+// and those of the teams the user is a member of.
+func (a *App) GetEmojiListForUser(userId string, page, perPage int, sort string) ([]*model.Emoji, *model.AppError) {
+	teamIds, appErr := a.getEmojiTeamIdsForUser(userId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	list, err := a.Srv().Store.Emoji().GetList(page*perPage, perPage, sort, teamIds)
+	if err != nil {
+		return nil, model.NewAppError("GetEmojiListForUser", "app.emoji.get_list.internal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return list, nil
+}
+
+// getEmojiTeamIdsForUser returns the teams whose emoji are available to the user.
+func (a *App) getEmojiTeamIdsForUser(userId string) ([]string, *model.AppError) {
+	teamIds, err := a.Srv().Store.Team().GetUserTeamIds(a.Context(), userId, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// A nil list would make the emoji of every team available.
+	if teamIds == nil {
+		teamIds = []string{}
+	}
+
+	return teamIds, nil
+}
+
 func (a *App) UploadEmojiImage(id string, imageData *multipart.FileHeader) *model.AppError {
 	if !*a.Config().ServiceSettings.EnableCustomEmoji {
 		return model.NewAppError("UploadEmojiImage", "api.emoji.disabled.app_error", nil, "", http.StatusNotImplemented)
@@ -263,12 +295,18 @@ func (a *App) GetEmojiImage(emojiId string) ([]byte, string, *model.AppError) {
 	return img, imageType, nil
 }
 
-func (a *App) SearchEmoji(name string, prefixOnly bool, limit int) ([]*model.Emoji, *model.AppError) {
+// SearchEmoji searches the emoji available to the user.
+func (a *App) SearchEmoji(userId string, name string, prefixOnly bool, limit int) ([]*model.Emoji, *model.AppError) {
 	if !*a.Config().ServiceSettings.EnableCustomEmoji {
 		return nil, model.NewAppError("SearchEmoji", "api.emoji.disabled.app_error", nil, "", http.StatusNotImplemented)
 	}
 
-	list, err := a.Srv().Store.Emoji().Search(name, prefixOnly, limit)
+	teamIds, appErr := a.getEmojiTeamIdsForUser(userId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	list, err := a.Srv().Store.Emoji().Search(name, prefixOnly, limit, teamIds)
 	if err != nil {
 		return nil, model.NewAppError("SearchEmoji", "app.emoji.get_by_name.app_error", nil, "name="+name+", "+err.Error(), http.StatusInternalServerError)
 	}
@@ -276,6 +314,35 @@ func (a *App) SearchEmoji(name string, prefixOnly bool, limit int) ([]*model.Emo
 	return list, nil
 }
 
+// checkTeamEmojiAllowed returns an error if the emoji is the custom emoji of a team other than the one of the
+// channel, since the emoji of a team may only be used in its channels.
+func (a *App) checkTeamEmojiAllowed(channel *model.Channel, emojiName string) *model.AppError {
+	if !*a.Config().ServiceSettings.EnableCustomEmoji {
+		return nil
+	}
+
+	if _, ok := model.GetSystemEmojiId(emojiName); ok {
+		return nil
+	}
+
+	emoji, err := a.Srv().Store.Emoji().GetByName(emojiName, true)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil
+		default:
+			return model.NewAppError("checkTeamEmojiAllowed", "app.emoji.get_by_name.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	if emoji.TeamId != "" && emoji.TeamId != channel.TeamId {
+		return model.NewAppError("checkTeamEmojiAllowed", "app.emoji.other_team.app_error", map[string]interface{}{"Name": emoji.Name}, "channel_id="+channel.Id, http.StatusForbidden)
+	}
+
+	return nil
+}
+
 // GetEmojiStaticUrl returns a relative static URL for system default emojis,
 // and the API route for custom ones. Errors if not found or if custom and deleted.
 func (a *App) GetEmojiStaticUrl(emojiName string) (string, *model.AppError) {
@@ -42,6 +42,12 @@ func RegisterElasticsearchInterface(f func(*Server) searchengine.SearchEngineInt
 	elasticsearchInterface = f
 }
 
+var eventStreamInterface func(*Server) einterfaces.EventStreamInterface
+
+func RegisterEventStreamInterface(f func(*Server) einterfaces.EventStreamInterface) {
+	eventStreamInterface = f
+}
+
 var jobsDataRetentionJobInterface func(*Server) ejobs.DataRetentionJobInterface
 
 func RegisterJobsDataRetentionJobInterface(f func(*Server) ejobs.DataRetentionJobInterface) {
@@ -96,6 +102,66 @@ func RegisterJobsExpiryNotifyJobInterface(f func(*App) tjobs.ExpiryNotifyJobInte
 	jobsExpiryNotifyInterface = f
 }
 
+var jobsPostArchiveInterface func(*Server) tjobs.PostArchiveJobInterface
+
+func RegisterJobsPostArchiveJobInterface(f func(*Server) tjobs.PostArchiveJobInterface) {
+	jobsPostArchiveInterface = f
+}
+
+var jobsPostsPartitioningInterface func(*Server) tjobs.PostsPartitioningJobInterface
+
+func RegisterJobsPostsPartitioningJobInterface(f func(*Server) tjobs.PostsPartitioningJobInterface) {
+	jobsPostsPartitioningInterface = f
+}
+
+var jobsPreferencesMigrationInterface func(*Server) tjobs.PreferencesMigrationJobInterface
+
+func RegisterJobsPreferencesMigrationJobInterface(f func(*Server) tjobs.PreferencesMigrationJobInterface) {
+	jobsPreferencesMigrationInterface = f
+}
+
+var jobsSeatUsageNotifyInterface func(*App) tjobs.SeatUsageNotifyJobInterface
+
+func RegisterJobsSeatUsageNotifyJobInterface(f func(*App) tjobs.SeatUsageNotifyJobInterface) {
+	jobsSeatUsageNotifyInterface = f
+}
+
+var jobsEmailVerificationInterface func(*App) tjobs.EmailVerificationJobInterface
+
+func RegisterJobsEmailVerificationJobInterface(f func(*App) tjobs.EmailVerificationJobInterface) {
+	jobsEmailVerificationInterface = f
+}
+
+var jobsBackupInterface func(*App) tjobs.BackupJobInterface
+
+func RegisterJobsBackupJobInterface(f func(*App) tjobs.BackupJobInterface) {
+	jobsBackupInterface = f
+}
+
+var jobsRoleExpiryInterface func(*App) tjobs.RoleExpiryJobInterface
+
+func RegisterJobsRoleExpiryJobInterface(f func(*App) tjobs.RoleExpiryJobInterface) {
+	jobsRoleExpiryInterface = f
+}
+
+var jobsFileEnrichmentInterface func(*App) tjobs.FileEnrichmentJobInterface
+
+func RegisterJobsFileEnrichmentJobInterface(f func(*App) tjobs.FileEnrichmentJobInterface) {
+	jobsFileEnrichmentInterface = f
+}
+
+var jobsPostIntegrityInterface func(*App) tjobs.PostIntegrityJobInterface
+
+func RegisterJobsPostIntegrityJobInterface(f func(*App) tjobs.PostIntegrityJobInterface) {
+	jobsPostIntegrityInterface = f
+}
+
+var jobsTeamPurgeInterface func(*App) tjobs.TeamPurgeJobInterface
+
+func RegisterJobsTeamPurgeJobInterface(f func(*App) tjobs.TeamPurgeJobInterface) {
+	jobsTeamPurgeInterface = f
+}
+
 var ldapInterface func(*App) einterfaces.LdapInterface
 
 func RegisterLdapInterface(f func(*App) einterfaces.LdapInterface) {
@@ -148,6 +214,9 @@ func (s *Server) initEnterprise() {
 	if clusterInterface != nil {
 		s.Cluster = clusterInterface(s)
 	}
+	if eventStreamInterface != nil {
+		s.EventStream = eventStreamInterface(s)
+	}
 	if elasticsearchInterface != nil {
 		s.SearchEngine.RegisterElasticsearchEngine(elasticsearchInterface(s))
 	}
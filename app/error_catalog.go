@@ -0,0 +1,21 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+// GetErrorCatalog returns the errors the server may return, with their messages translated in the
+// locale of the session. Errors without a translation are listed without a message.
+func (a *App) GetErrorCatalog() []*model.ErrorCatalogEntry {
+	entries := model.GetErrorCatalog()
+	for _, entry := range entries {
+		if message := a.T(entry.Id); message != entry.Id {
+			entry.Message = message
+		}
+	}
+
+	return entries
+}
@@ -0,0 +1,287 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+const (
+	EVENT_STREAM_POLL_INTERVAL     = 5 * time.Second
+	EVENT_STREAM_RETRY_BACKOFF     = 5 * time.Second
+	EVENT_STREAM_MAX_RETRY_BACKOFF = 10 * time.Minute
+	// EVENT_STREAM_MAX_BATCHES_PER_ROUND bounds the batches delivered before checking whether the
+	// dispatcher was stopped or the node lost the cluster leadership.
+	EVENT_STREAM_MAX_BATCHES_PER_ROUND = 20
+)
+
+// eventStreamSink delivers a batch of events to the system consuming the event stream.
+type eventStreamSink interface {
+	Publish(events []*model.EventStreamEvent) error
+}
+
+// eventStreamWebhookSink posts the batches of events to the HTTP firehose as a JSON array, signed
+// with the webhook secret.
+type eventStreamWebhookSink struct {
+	client *http.Client
+	url    string
+	secret string
+}
+
+func (s *eventStreamWebhookSink) Publish(events []*model.EventStreamEvent) error {
+	body := []byte(model.EventStreamEventListToJson(events))
+
+	req, err := http.NewRequest("POST", s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(model.EVENT_STREAM_SIGNATURE_HEADER, model.SignEventStreamPayload(s.secret, body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, MaxIntegrationResponseSize))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// eventStreamDispatcher delivers the events of the outbox to the sink of the event stream. Only the
+// cluster leader delivers them, so that the events aren't sent by several nodes at once. An event
+// is removed from the outbox once its delivery succeeded, and retried with an exponential backoff
+// otherwise, so that it is delivered at least once.
+type eventStreamDispatcher struct {
+	server *Server
+	wake   chan struct{}
+	stop   chan struct{}
+	done   chan struct{}
+}
+
+func newEventStreamDispatcher(s *Server) *eventStreamDispatcher {
+	return &eventStreamDispatcher{
+		server: s,
+		wake:   make(chan struct{}, 1),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+}
+
+func (s *Server) startEventStreamDispatcher() {
+	s.eventStreamDispatcher = newEventStreamDispatcher(s)
+	go s.eventStreamDispatcher.run()
+}
+
+func (s *Server) stopEventStreamDispatcher() {
+	if s.eventStreamDispatcher != nil {
+		s.eventStreamDispatcher.stopAndWait()
+	}
+}
+
+func (d *eventStreamDispatcher) run() {
+	defer close(d.done)
+
+	ticker := time.NewTicker(EVENT_STREAM_POLL_INTERVAL)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-d.stop:
+			return
+		case <-ticker.C:
+		case <-d.wake:
+		}
+
+		d.dispatch()
+	}
+}
+
+// wakeUp has the events delivered without waiting for the next poll.
+func (d *eventStreamDispatcher) wakeUp() {
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (d *eventStreamDispatcher) stopAndWait() {
+	close(d.stop)
+	<-d.done
+}
+
+func (d *eventStreamDispatcher) stopped() bool {
+	select {
+	case <-d.stop:
+		return true
+	default:
+		return false
+	}
+}
+
+func (d *eventStreamDispatcher) sink(settings *model.EventStreamSettings) (eventStreamSink, error) {
+	switch *settings.Sink {
+	case model.EVENT_STREAM_SINK_WEBHOOK:
+		return &eventStreamWebhookSink{
+			client: d.server.HTTPService.MakeClient(false),
+			url:    *settings.WebhookURL,
+			secret: *settings.WebhookSecret,
+		}, nil
+	case model.EVENT_STREAM_SINK_KAFKA:
+		if d.server.EventStream == nil {
+			return nil, errors.New("publishing to Kafka is not available on this server")
+		}
+		return d.server.EventStream, nil
+	default:
+		return nil, fmt.Errorf("unknown sink %q", *settings.Sink)
+	}
+}
+
+func (d *eventStreamDispatcher) dispatch() {
+	settings := d.server.Config().EventStreamSettings
+	if !*settings.Enable || !d.server.IsLeader() {
+		return
+	}
+
+	sink, err := d.sink(&settings)
+	if err != nil {
+		mlog.Error("Unable to deliver the events of the event stream.", mlog.Err(err))
+		return
+	}
+
+	for i := 0; i < EVENT_STREAM_MAX_BATCHES_PER_ROUND && !d.stopped(); i++ {
+		now := model.GetMillis()
+
+		events, err := d.server.Store.EventStream().GetPending(now, *settings.BatchSize)
+		if err != nil {
+			mlog.Error("Unable to get the pending events of the event stream.", mlog.Err(err))
+			return
+		}
+		if len(events) == 0 {
+			return
+		}
+
+		ids := make([]string, len(events))
+		for j, event := range events {
+			ids[j] = event.Id
+		}
+
+		if err := sink.Publish(events); err != nil {
+			backoff := eventStreamRetryBackoff(events[0].Attempts + 1)
+			mlog.Warn("Delivery of the events of the event stream failed, retrying.", mlog.String("sink", *settings.Sink), mlog.Int("events", len(events)), mlog.Duration("backoff", backoff), mlog.Err(err))
+
+			if err := d.server.Store.EventStream().RecordFailedAttempt(ids, now+int64(backoff/time.Millisecond)); err != nil {
+				mlog.Error("Unable to record the failed delivery of the events of the event stream.", mlog.Err(err))
+			}
+			return
+		}
+
+		// Events failing to be removed are delivered again, which the consumers deduplicate.
+		if err := d.server.Store.EventStream().Delete(ids); err != nil {
+			mlog.Error("Unable to remove the delivered events of the event stream.", mlog.Err(err))
+			return
+		}
+
+		if len(events) < *settings.BatchSize {
+			return
+		}
+	}
+
+	// More events are pending.
+	d.wakeUp()
+}
+
+// eventStreamRetryBackoff returns how long to wait before the given attempt to deliver events.
+func eventStreamRetryBackoff(attempts int) time.Duration {
+	backoff := EVENT_STREAM_RETRY_BACKOFF
+	for i := 1; i < attempts && backoff < EVENT_STREAM_MAX_RETRY_BACKOFF; i++ {
+		backoff *= 2
+	}
+
+	if backoff > EVENT_STREAM_MAX_RETRY_BACKOFF {
+		return EVENT_STREAM_MAX_RETRY_BACKOFF
+	}
+	return backoff
+}
+
+// publishEventStreamEvent records the change of an entity in the outbox of the event stream, when
+// enabled for the type of the event. Failing to record it is logged rather than failing the change.
+func (s *Server) publishEventStreamEvent(eventType, teamId, channelId, userId string, data interface{}) {
+	settings := s.Config().EventStreamSettings
+	if !*settings.Enable || !settings.PublishesEventType(eventType) {
+		return
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		mlog.Error("Unable to encode an event of the event stream.", mlog.String("type", eventType), mlog.Err(err))
+		return
+	}
+
+	event := &model.EventStreamEvent{
+		Type:      eventType,
+		TeamId:    teamId,
+		ChannelId: channelId,
+		UserId:    userId,
+		Data:      string(b),
+	}
+
+	if _, err := s.Store.EventStream().Save(event); err != nil {
+		mlog.Error("Unable to save an event of the event stream.", mlog.String("type", eventType), mlog.Err(err))
+		return
+	}
+
+	if s.eventStreamDispatcher != nil {
+		s.eventStreamDispatcher.wakeUp()
+	}
+}
+
+func (a *App) publishPostEventStreamEvent(eventType string, post *model.Post) {
+	if !*a.Config().EventStreamSettings.Enable {
+		return
+	}
+
+	teamId := ""
+	if channel, err := a.GetChannel(post.ChannelId); err == nil {
+		teamId = channel.TeamId
+	}
+
+	a.Srv().publishEventStreamEvent(eventType, teamId, post.ChannelId, post.UserId, post)
+}
+
+func (a *App) publishChannelMemberEventStreamEvent(eventType string, channel *model.Channel, member *model.ChannelMember) {
+	a.Srv().publishEventStreamEvent(eventType, channel.TeamId, channel.Id, member.UserId, member)
+}
+
+func (a *App) publishTeamMemberEventStreamEvent(eventType string, member *model.TeamMember) {
+	a.Srv().publishEventStreamEvent(eventType, member.TeamId, "", member.UserId, member)
+}
+
+func (a *App) publishUserEventStreamEvent(eventType string, user *model.User) {
+	if !*a.Config().EventStreamSettings.Enable {
+		return
+	}
+
+	sanitized := *user
+	sanitized.Sanitize(map[string]bool{})
+
+	a.Srv().publishEventStreamEvent(eventType, "", "", user.Id, &sanitized)
+}
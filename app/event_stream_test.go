@@ -0,0 +1,61 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestEventStreamWebhookSink(t *testing.T) {
+	events := []*model.EventStreamEvent{
+		{Id: model.NewId(), Type: model.EVENT_STREAM_POST_CREATED, CreateAt: 1, Data: `{"id":"post"}`},
+	}
+
+	t.Run("signed delivery", func(t *testing.T) {
+		var signature string
+		var body []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			signature = r.Header.Get(model.EVENT_STREAM_SIGNATURE_HEADER)
+			body, _ = ioutil.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		sink := &eventStreamWebhookSink{client: http.DefaultClient, url: server.URL, secret: "0123456789abcdef"}
+		require.NoError(t, sink.Publish(events))
+
+		assert.Equal(t, model.SignEventStreamPayload("0123456789abcdef", body), signature)
+		received := model.EventStreamEventListFromJson(bytes.NewReader(body))
+		require.Len(t, received, 1)
+		assert.Equal(t, events[0].Id, received[0].Id)
+	})
+
+	t.Run("error status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		sink := &eventStreamWebhookSink{client: http.DefaultClient, url: server.URL, secret: "0123456789abcdef"}
+		require.Error(t, sink.Publish(events))
+	})
+}
+
+func TestEventStreamRetryBackoff(t *testing.T) {
+	assert.Equal(t, EVENT_STREAM_RETRY_BACKOFF, eventStreamRetryBackoff(1))
+	assert.Equal(t, 2*EVENT_STREAM_RETRY_BACKOFF, eventStreamRetryBackoff(2))
+	assert.Equal(t, 4*EVENT_STREAM_RETRY_BACKOFF, eventStreamRetryBackoff(3))
+	assert.Equal(t, EVENT_STREAM_MAX_RETRY_BACKOFF, eventStreamRetryBackoff(100))
+	assert.True(t, eventStreamRetryBackoff(10) <= 10*time.Minute)
+}
@@ -53,29 +53,73 @@ var exportablePreferences = map[ComparablePreference]string{{
 }: "EmailInterval",
 }
 
+// exportOptions narrows a bulk export the way backups need it. The zero value exports everything
+// and references no files.
+type exportOptions struct {
+	// Since skips records last updated at or before this time, in milliseconds.
+	Since int64
+	// Files, when set, collects the file store paths of attachments and profile pictures, which the
+	// exported lines then reference by those paths.
+	Files *exportFiles
+}
+
+func (opts *exportOptions) changed(updateAt int64) bool {
+	return updateAt > opts.Since
+}
+
+// exportFiles is the ordered set of file store paths an export references.
+type exportFiles struct {
+	paths []string
+	seen  map[string]bool
+}
+
+func newExportFiles() *exportFiles {
+	return &exportFiles{seen: map[string]bool{}}
+}
+
+func (ef *exportFiles) add(path string) *string {
+	if !ef.seen[path] {
+		ef.seen[path] = true
+		ef.paths = append(ef.paths, path)
+	}
+	return &path
+}
+
 func (a *App) BulkExport(writer io.Writer, file string, pathToEmojiDir string, dirNameToExportEmoji string) *model.AppError {
+	opts := &exportOptions{}
+
 	mlog.Info("Bulk export: exporting version")
 	if err := a.exportVersion(writer); err != nil {
 		return err
 	}
 
 	mlog.Info("Bulk export: exporting teams")
-	if err := a.exportAllTeams(writer); err != nil {
+	if err := a.exportAllTeams(writer, opts); err != nil {
 		return err
 	}
 
 	mlog.Info("Bulk export: exporting channels")
-	if err := a.exportAllChannels(writer); err != nil {
+	if err := a.exportAllChannels(writer, opts); err != nil {
 		return err
 	}
 
 	mlog.Info("Bulk export: exporting users")
-	if err := a.exportAllUsers(writer); err != nil {
+	if err := a.exportAllUsers(writer, opts); err != nil {
+		return err
+	}
+
+	mlog.Info("Bulk export: exporting user relationships")
+	if err := a.exportAllUserRelationships(writer, opts); err != nil {
+		return err
+	}
+
+	mlog.Info("Bulk export: exporting channel bookmarks")
+	if err := a.exportAllChannelBookmarks(writer, opts); err != nil {
 		return err
 	}
 
 	mlog.Info("Bulk export: exporting posts")
-	if err := a.exportAllPosts(writer); err != nil {
+	if err := a.exportAllPosts(writer, opts); err != nil {
 		return err
 	}
 
@@ -85,12 +129,12 @@ func (a *App) BulkExport(writer io.Writer, file string, pathToEmojiDir string, d
 	}
 
 	mlog.Info("Bulk export: exporting direct channels")
-	if err := a.exportAllDirectChannels(writer); err != nil {
+	if err := a.exportAllDirectChannels(writer, opts); err != nil {
 		return err
 	}
 
 	mlog.Info("Bulk export: exporting direct posts")
-	if err := a.exportAllDirectPosts(writer); err != nil {
+	if err := a.exportAllDirectPosts(writer, opts); err != nil {
 		return err
 	}
 
@@ -120,10 +164,10 @@ func (a *App) exportVersion(writer io.Writer) *model.AppError {
 	return a.exportWriteLine(writer, versionLine)
 }
 
-func (a *App) exportAllTeams(writer io.Writer) *model.AppError {
+func (a *App) exportAllTeams(writer io.Writer, opts *exportOptions) *model.AppError {
 	afterId := strings.Repeat("0", 26)
 	for {
-		teams, err := a.Srv().Store.Team().GetAllForExportAfter(1000, afterId)
+		teams, err := a.Srv().Store.Team().GetAllForExportAfter(a.Context(), 1000, afterId)
 
 		if err != nil {
 			return err
@@ -137,7 +181,7 @@ func (a *App) exportAllTeams(writer io.Writer) *model.AppError {
 			afterId = team.Id
 
 			// Skip deleted.
-			if team.DeleteAt != 0 {
+			if team.DeleteAt != 0 || !opts.changed(team.UpdateAt) {
 				continue
 			}
 
@@ -151,7 +195,7 @@ func (a *App) exportAllTeams(writer io.Writer) *model.AppError {
 	return nil
 }
 
-func (a *App) exportAllChannels(writer io.Writer) *model.AppError {
+func (a *App) exportAllChannels(writer io.Writer, opts *exportOptions) *model.AppError {
 	afterId := strings.Repeat("0", 26)
 	for {
 		channels, err := a.Srv().Store.Channel().GetAllChannelsForExportAfter(1000, afterId)
@@ -168,7 +212,7 @@ func (a *App) exportAllChannels(writer io.Writer) *model.AppError {
 			afterId = channel.Id
 
 			// Skip deleted.
-			if channel.DeleteAt != 0 {
+			if channel.DeleteAt != 0 || !opts.changed(channel.UpdateAt) {
 				continue
 			}
 
@@ -182,7 +226,109 @@ func (a *App) exportAllChannels(writer io.Writer) *model.AppError {
 	return nil
 }
 
-func (a *App) exportAllUsers(writer io.Writer) *model.AppError {
+func (a *App) exportAllChannelBookmarks(writer io.Writer, opts *exportOptions) *model.AppError {
+	usernames := map[string]string{}
+	afterId := strings.Repeat("0", 26)
+	for {
+		channels, err := a.Srv().Store.Channel().GetAllChannelsForExportAfter(1000, afterId)
+
+		if err != nil {
+			return err
+		}
+
+		if len(channels) == 0 {
+			break
+		}
+
+		for _, channel := range channels {
+			afterId = channel.Id
+
+			// Skip deleted.
+			if channel.DeleteAt != 0 {
+				continue
+			}
+
+			bookmarks, nErr := a.Srv().Store.ChannelBookmark().GetBookmarksForChannel(channel.Id, false)
+			if nErr != nil {
+				return model.NewAppError("exportAllChannelBookmarks", "app.channel_bookmark.get_for_channel.app_error", nil, nErr.Error(), http.StatusInternalServerError)
+			}
+
+			for _, bookmark := range bookmarks {
+				// File bookmarks reference uploads that are not part of the export.
+				if bookmark.Type != model.CHANNEL_BOOKMARK_LINK || !opts.changed(bookmark.UpdateAt) {
+					continue
+				}
+
+				username, ok := usernames[bookmark.OwnerId]
+				if !ok {
+					user, err := a.Srv().Store.User().Get(bookmark.OwnerId)
+					if err != nil {
+						return err
+					}
+					username = user.Username
+					usernames[bookmark.OwnerId] = username
+				}
+
+				bookmarkLine := ImportLineFromChannelBookmark(channel, bookmark, username)
+				if err := a.exportWriteLine(writer, bookmarkLine); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (a *App) exportAllUserRelationships(writer io.Writer, opts *exportOptions) *model.AppError {
+	usernames := map[string]string{}
+	afterId := strings.Repeat("0", 26)
+	for {
+		users, err := a.Srv().Store.User().GetAllAfter(1000, afterId)
+
+		if err != nil {
+			return err
+		}
+
+		if len(users) == 0 {
+			break
+		}
+
+		for _, user := range users {
+			afterId = user.Id
+
+			relationships, nErr := a.Srv().Store.UserRelationship().GetForUser(user.Id)
+			if nErr != nil {
+				return model.NewAppError("exportAllUserRelationships", "app.user_relationship.get_for_user.app_error", nil, nErr.Error(), http.StatusInternalServerError)
+			}
+
+			for _, relationship := range relationships {
+				if !opts.changed(relationship.CreateAt) {
+					continue
+				}
+
+				otherUsername, ok := usernames[relationship.OtherUserId]
+				if !ok {
+					otherUser, err := a.Srv().Store.User().Get(relationship.OtherUserId)
+					if err != nil {
+						return err
+					}
+					otherUsername = otherUser.Username
+					usernames[relationship.OtherUserId] = otherUsername
+				}
+
+				relationshipLine := ImportLineFromUserRelationship(relationship, user.Username, otherUsername)
+				if err := a.exportWriteLine(writer, relationshipLine); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (a *App) exportAllUsers(writer io.Writer, opts *exportOptions) *model.AppError {
 	afterId := strings.Repeat("0", 26)
 	for {
 		users, err := a.Srv().Store.User().GetAllAfter(1000, afterId)
@@ -198,6 +344,10 @@ func (a *App) exportAllUsers(writer io.Writer) *model.AppError {
 		for _, user := range users {
 			afterId = user.Id
 
+			if !opts.changed(user.UpdateAt) {
+				continue
+			}
+
 			// Gathering here the exportable preferences to pass them on to ImportLineFromUser
 			exportedPrefs := make(map[string]*string)
 			allPrefs, err := a.GetPreferencesForUser(user.Id)
@@ -248,6 +398,10 @@ func (a *App) exportAllUsers(writer io.Writer) *model.AppError {
 
 			userLine.User.Teams = members
 
+			if opts.Files != nil && user.LastPictureUpdate > 0 {
+				userLine.User.ProfileImage = opts.Files.add("users/" + user.Id + "/profile.png")
+			}
+
 			if err := a.exportWriteLine(writer, userLine); err != nil {
 				return err
 			}
@@ -260,7 +414,7 @@ func (a *App) exportAllUsers(writer io.Writer) *model.AppError {
 func (a *App) buildUserTeamAndChannelMemberships(userId string) (*[]UserTeamImportData, *model.AppError) {
 	var memberships []UserTeamImportData
 
-	members, err := a.Srv().Store.Team().GetTeamMembersForExport(userId)
+	members, err := a.Srv().Store.Team().GetTeamMembersForExport(a.Context(), userId)
 
 	if err != nil {
 		return nil, err
@@ -335,7 +489,7 @@ func (a *App) buildUserNotifyProps(notifyProps model.StringMap) *UserNotifyProps
 	}
 }
 
-func (a *App) exportAllPosts(writer io.Writer) *model.AppError {
+func (a *App) exportAllPosts(writer io.Writer, opts *exportOptions) *model.AppError {
 	afterId := strings.Repeat("0", 26)
 
 	for {
@@ -351,14 +505,20 @@ func (a *App) exportAllPosts(writer io.Writer) *model.AppError {
 		for _, post := range posts {
 			afterId = post.Id
 
-			// Skip deleted.
-			if post.DeleteAt != 0 {
+			// Skip deleted. Replies update the UpdateAt of their root post, so unchanged threads are
+			// skipped too.
+			if post.DeleteAt != 0 || !opts.changed(post.UpdateAt) {
 				continue
 			}
 
 			postLine := ImportLineForPost(post)
 
-			postLine.Post.Replies, err = a.buildPostReplies(post.Id)
+			postLine.Post.Replies, err = a.buildPostReplies(post.Id, opts)
+			if err != nil {
+				return err
+			}
+
+			postLine.Post.Attachments, err = a.buildPostAttachments(&post.Post, opts)
 			if err != nil {
 				return err
 			}
@@ -378,7 +538,7 @@ func (a *App) exportAllPosts(writer io.Writer) *model.AppError {
 	}
 }
 
-func (a *App) buildPostReplies(postId string) (*[]ReplyImportData, *model.AppError) {
+func (a *App) buildPostReplies(postId string, opts *exportOptions) (*[]ReplyImportData, *model.AppError) {
 	var replies []ReplyImportData
 
 	replyPosts, err := a.Srv().Store.Post().GetRepliesForExport(postId)
@@ -394,12 +554,35 @@ func (a *App) buildPostReplies(postId string) (*[]ReplyImportData, *model.AppErr
 				return nil, err
 			}
 		}
+		replyImportObject.Attachments, err = a.buildPostAttachments(&reply.Post, opts)
+		if err != nil {
+			return nil, err
+		}
 		replies = append(replies, *replyImportObject)
 	}
 
 	return &replies, nil
 }
 
+// buildPostAttachments references the files attached to post when the export collects files.
+func (a *App) buildPostAttachments(post *model.Post, opts *exportOptions) (*[]AttachmentImportData, *model.AppError) {
+	if opts.Files == nil || len(post.FileIds) == 0 {
+		return nil, nil
+	}
+
+	infos, err := a.Srv().Store.FileInfo().GetForPost(post.Id, false, false, false)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments := make([]AttachmentImportData, 0, len(infos))
+	for _, info := range infos {
+		attachments = append(attachments, AttachmentImportData{Path: opts.Files.add(info.Path)})
+	}
+
+	return &attachments, nil
+}
+
 func (a *App) BuildPostReactions(postId string) (*[]ReactionImportData, *model.AppError) {
 	var reactionsOfPost []ReactionImportData
 
@@ -511,7 +694,7 @@ func (a *App) copyEmojiImages(emojiId string, emojiImagePath string, pathToDir s
 	return nil
 }
 
-func (a *App) exportAllDirectChannels(writer io.Writer) *model.AppError {
+func (a *App) exportAllDirectChannels(writer io.Writer, opts *exportOptions) *model.AppError {
 	afterId := strings.Repeat("0", 26)
 	for {
 		channels, err := a.Srv().Store.Channel().GetAllDirectChannelsForExportAfter(1000, afterId)
@@ -527,7 +710,7 @@ func (a *App) exportAllDirectChannels(writer io.Writer) *model.AppError {
 			afterId = channel.Id
 
 			// Skip deleted.
-			if channel.DeleteAt != 0 {
+			if channel.DeleteAt != 0 || !opts.changed(channel.UpdateAt) {
 				continue
 			}
 
@@ -541,7 +724,7 @@ func (a *App) exportAllDirectChannels(writer io.Writer) *model.AppError {
 	return nil
 }
 
-func (a *App) exportAllDirectPosts(writer io.Writer) *model.AppError {
+func (a *App) exportAllDirectPosts(writer io.Writer, opts *exportOptions) *model.AppError {
 	afterId := strings.Repeat("0", 26)
 	for {
 		posts, err := a.Srv().Store.Post().GetDirectPostParentsForExportAfter(1000, afterId)
@@ -557,18 +740,23 @@ func (a *App) exportAllDirectPosts(writer io.Writer) *model.AppError {
 			afterId = post.Id
 
 			// Skip deleted.
-			if post.DeleteAt != 0 {
+			if post.DeleteAt != 0 || !opts.changed(post.UpdateAt) {
 				continue
 			}
 
 			// Do the Replies.
-			replies, err := a.buildPostReplies(post.Id)
+			replies, err := a.buildPostReplies(post.Id, opts)
 			if err != nil {
 				return err
 			}
 
 			postLine := ImportLineForDirectPost(post)
 			postLine.DirectPost.Replies = replies
+
+			postLine.DirectPost.Attachments, err = a.buildPostAttachments(&post.Post, opts)
+			if err != nil {
+				return err
+			}
 			if err := a.exportWriteLine(writer, postLine); err != nil {
 				return err
 			}
@@ -38,6 +38,33 @@ func ImportLineFromChannel(channel *model.ChannelForExport) *LineImportData {
 	}
 }
 
+func ImportLineFromChannelBookmark(channel *model.ChannelForExport, bookmark *model.ChannelBookmark, ownerUsername string) *LineImportData {
+	return &LineImportData{
+		Type: "channel_bookmark",
+		ChannelBookmark: &ChannelBookmarkImportData{
+			Team:        &channel.TeamName,
+			Channel:     &channel.Name,
+			Owner:       &ownerUsername,
+			DisplayName: &bookmark.DisplayName,
+			LinkUrl:     &bookmark.LinkUrl,
+			ImageUrl:    &bookmark.ImageUrl,
+			Emoji:       &bookmark.Emoji,
+			SortOrder:   &bookmark.SortOrder,
+		},
+	}
+}
+
+func ImportLineFromUserRelationship(relationship *model.UserRelationship, username, otherUsername string) *LineImportData {
+	return &LineImportData{
+		Type: "user_relationship",
+		UserRelationship: &UserRelationshipImportData{
+			User:      &username,
+			OtherUser: &otherUsername,
+			Type:      &relationship.Type,
+		},
+	}
+}
+
 func ImportLineFromDirectChannel(channel *model.DirectChannelForExport) *LineImportData {
 	channelMembers := *channel.Members
 	if len(channelMembers) == 1 {
@@ -185,7 +185,7 @@ func (a *App) findTeamIdForFilename(post *model.Post, id, filename string) strin
 	name, _ := url.QueryUnescape(filename)
 
 	// This post is in a direct channel so we need to figure out what team the files are stored under.
-	teams, err := a.Srv().Store.Team().GetTeamsByUserId(post.UserId)
+	teams, err := a.Srv().Store.Team().GetTeamsByUserId(a.Context(), post.UserId, nil)
 	if err != nil {
 		mlog.Error("Unable to get teams when migrating post to use FileInfo", mlog.Err(err), mlog.String("post_id", post.Id))
 		return ""
@@ -395,6 +395,10 @@ func (a *App) UploadFiles(teamId string, channelId string, userId string, files
 		return nil, model.NewAppError("UploadFiles", "api.file.upload_file.incorrect_number_of_files.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if err := a.checkFileUploadsAllowedForChannelId(channelId); err != nil {
+		return nil, err
+	}
+
 	resStruct := &model.FileUploadResponse{
 		FileInfos: []*model.FileInfo{},
 		ClientIds: []string{},
@@ -434,12 +438,18 @@ func (a *App) UploadFiles(teamId string, channelId string, userId string, files
 
 // UploadFile uploads a single file in form of a completely constructed byte array for a channel.
 func (a *App) UploadFile(data []byte, channelId string, filename string) (*model.FileInfo, *model.AppError) {
-	_, err := a.GetChannel(channelId)
+	channel, err := a.GetChannel(channelId)
 	if err != nil && channelId != "" {
 		return nil, model.NewAppError("UploadFile", "api.file.upload_file.incorrect_channelId.app_error",
 			map[string]interface{}{"channelId": channelId}, "", http.StatusBadRequest)
 	}
 
+	if channel != nil {
+		if err = a.checkFileUploadsAllowed(channel); err != nil {
+			return nil, err
+		}
+	}
+
 	info, _, appError := a.DoUploadFileExpectModification(time.Now(), "noteam", channelId, "nouser", filename, data)
 	if appError != nil {
 		return nil, appError
@@ -600,6 +610,9 @@ func (a *App) UploadFileX(channelId, name string, input io.Reader,
 		return nil, t.newAppError("api.file.upload_file.storage.app_error",
 			"", http.StatusNotImplemented)
 	}
+	if aerr := a.checkFileUploadsAllowedForChannelId(t.ChannelId); aerr != nil {
+		return nil, aerr
+	}
 	if t.ContentLength > t.maxFileSize {
 		return nil, t.newAppError("api.file.upload_file.too_large_detailed.app_error",
 			"", http.StatusRequestEntityTooLarge, "Length", t.ContentLength, "Limit", t.maxFileSize)
@@ -645,6 +658,8 @@ func (a *App) UploadFileX(channelId, name string, input io.Reader,
 
 	wg.Wait()
 
+	a.EnqueueFileEnrichment(t.fileinfo)
+
 	return t.fileinfo, nil
 }
 
@@ -708,16 +723,8 @@ func (t *UploadFileTask) runPlugins() *model.AppError {
 }
 
 func (t *UploadFileTask) preprocessImage() *model.AppError {
-	// If SVG, attempt to extract dimensions and then return
+	// SVGs have no preview; their dimensions are extracted by the file enrichment job.
 	if t.fileinfo.MimeType == "image/svg+xml" {
-		svgInfo, err := parseSVG(t.newReader())
-		if err != nil {
-			mlog.Error("Failed to parse SVG", mlog.Err(err))
-		}
-		if svgInfo.Width > 0 && svgInfo.Height > 0 {
-			t.fileinfo.Width = svgInfo.Width
-			t.fileinfo.Height = svgInfo.Height
-		}
 		t.fileinfo.HasPreviewImage = false
 		return nil
 	}
@@ -964,6 +971,8 @@ func (a *App) DoUploadFileExpectModification(now time.Time, rawTeamId string, ra
 		return nil, data, err
 	}
 
+	a.EnqueueFileEnrichment(info)
+
 	return info, data, nil
 }
 
@@ -1107,6 +1116,65 @@ func (a *App) GetFileInfo(fileId string) (*model.FileInfo, *model.AppError) {
 	return a.Srv().Store.FileInfo().Get(fileId)
 }
 
+// SetFileInfoSensitive marks or unmarks a file as sensitive. Every download of a sensitive file is audited.
+func (a *App) SetFileInfoSensitive(info *model.FileInfo, sensitive bool) (*model.FileInfo, *model.AppError) {
+	if err := a.Srv().Store.FileInfo().SetSensitive(info.Id, sensitive); err != nil {
+		return nil, err
+	}
+
+	if info.PostId != "" {
+		a.Srv().Store.FileInfo().InvalidateFileInfosForPostCache(info.PostId, false)
+	}
+
+	return a.GetFileInfo(info.Id)
+}
+
+// RunFileWillBeDownloadedHooks gives plugins a chance to reject the download of a file by the given user, or to
+// replace the content that is served, for instance with a watermarked copy. It returns the content to serve and its size.
+func (a *App) RunFileWillBeDownloadedHooks(info *model.FileInfo, userId string, fileReader io.ReadSeeker) (io.ReadSeeker, int64, *model.AppError) {
+	pluginsEnvironment := a.GetPluginsEnvironment()
+	if pluginsEnvironment == nil {
+		return fileReader, info.Size, nil
+	}
+
+	content := fileReader
+	size := info.Size
+	var appErr *model.AppError
+
+	pluginContext := a.PluginContext()
+	pluginsEnvironment.RunMultiPluginHook(func(hooks plugin.Hooks) bool {
+		if _, err := content.Seek(0, io.SeekStart); err != nil {
+			appErr = model.NewAppError("RunFileWillBeDownloadedHooks", "app.file.download.seek.app_error", nil, "file_id="+info.Id+", err="+err.Error(), http.StatusInternalServerError)
+			return false
+		}
+
+		buf := &bytes.Buffer{}
+		rejectionReason := hooks.FileWillBeDownloaded(pluginContext, info, userId, content, buf)
+		if rejectionReason != "" {
+			appErr = model.NewAppError("RunFileWillBeDownloadedHooks", "app.file.download.rejected_by_plugin.app_error",
+				map[string]interface{}{"Filename": info.Name, "Reason": rejectionReason}, "file_id="+info.Id, http.StatusForbidden)
+			return false
+		}
+
+		if buf.Len() != 0 {
+			content = bytes.NewReader(buf.Bytes())
+			size = int64(buf.Len())
+		}
+
+		return true
+	}, plugin.FileWillBeDownloadedId)
+
+	if appErr != nil {
+		return nil, 0, appErr
+	}
+
+	if _, err := content.Seek(0, io.SeekStart); err != nil {
+		return nil, 0, model.NewAppError("RunFileWillBeDownloadedHooks", "app.file.download.seek.app_error", nil, "file_id="+info.Id+", err="+err.Error(), http.StatusInternalServerError)
+	}
+
+	return content, size, nil
+}
+
 func (a *App) GetFileInfos(page, perPage int, opt *model.GetFileInfosOptions) ([]*model.FileInfo, *model.AppError) {
 	return a.Srv().Store.FileInfo().GetWithOptions(page, perPage, opt)
 }
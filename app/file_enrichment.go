@@ -0,0 +1,247 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"image"
+	"image/jpeg"
+	"io/ioutil"
+	"regexp"
+
+	"github.com/pkg/errors"
+	"github.com/rwcarlsen/goexif/exif"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin"
+)
+
+// fileEnricher extracts metadata from the content of a file onto its FileInfo. When it rewrites the content, it
+// returns the replacement, which is stored in place of the original and passed on to the next enrichers.
+type fileEnricher struct {
+	name   string
+	enrich func(info *model.FileInfo, data []byte) ([]byte, error)
+}
+
+// fileEnrichers run in order: EXIF stripping comes first, so that the dimensions and the hash describe the content
+// that is actually stored.
+var fileEnrichers = []fileEnricher{
+	{"strip_exif", stripJpegExif},
+	{"dimensions", extractDimensions},
+	{"page_count", countPages},
+	{"hash", computeHash},
+}
+
+var pdfPageRegexp = regexp.MustCompile(`/Type\s*/Page[^s]`)
+
+// EnqueueFileEnrichment creates a file enrichment job for the given file, unless enrichment is disabled.
+func (a *App) EnqueueFileEnrichment(info *model.FileInfo) {
+	if !*a.Config().FileSettings.EnableFileEnrichment || a.Srv().Jobs == nil {
+		return
+	}
+
+	if _, err := a.Srv().Jobs.CreateJob(model.JOB_TYPE_FILE_ENRICHMENT, map[string]string{"file_id": info.Id}); err != nil {
+		mlog.Warn("Failed to create the file enrichment job", mlog.String("file_id", info.Id), mlog.Err(err))
+	}
+}
+
+// EnrichFileInfo runs the file enrichers and the FileWillBeEnriched plugin hooks over the stored content of a file,
+// and saves what they extract onto its FileInfo. An enricher that fails is logged and skipped.
+func (a *App) EnrichFileInfo(fileId string) (*model.FileInfo, *model.AppError) {
+	info, appErr := a.Srv().Store.FileInfo().Get(fileId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	data, appErr := a.ReadFile(info.Path)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	for _, enricher := range fileEnrichers {
+		replacement, err := enricher.enrich(info, data)
+		if err != nil {
+			mlog.Warn("Failed to enrich file", mlog.String("file_id", info.Id), mlog.String("enricher", enricher.name), mlog.Err(err))
+			continue
+		}
+
+		if replacement != nil {
+			if _, appErr = a.WriteFile(bytes.NewReader(replacement), info.Path); appErr != nil {
+				return nil, appErr
+			}
+			data = replacement
+			info.Size = int64(len(data))
+		}
+	}
+
+	a.runFileWillBeEnrichedHooks(info, data)
+
+	info.EnrichedAt = model.GetMillis()
+	if appErr = a.Srv().Store.FileInfo().UpdateEnrichment(info); appErr != nil {
+		return nil, appErr
+	}
+
+	if info.PostId != "" {
+		a.Srv().Store.FileInfo().InvalidateFileInfosForPostCache(info.PostId, false)
+	}
+
+	return info, nil
+}
+
+func (a *App) runFileWillBeEnrichedHooks(info *model.FileInfo, data []byte) {
+	pluginsEnvironment := a.GetPluginsEnvironment()
+	if pluginsEnvironment == nil {
+		return
+	}
+
+	pluginContext := a.PluginContext()
+	pluginsEnvironment.RunMultiPluginHook(func(hooks plugin.Hooks) bool {
+		metadata := hooks.FileWillBeEnriched(pluginContext, info, bytes.NewReader(data))
+		if len(metadata) == 0 {
+			return true
+		}
+
+		if info.Metadata == nil {
+			info.Metadata = model.StringMap{}
+		}
+		for key, value := range metadata {
+			info.Metadata[key] = value
+		}
+
+		return true
+	}, plugin.FileWillBeEnrichedId)
+}
+
+// stripJpegExif removes the EXIF data, which may include the location where a photo was taken, from JPEG images. The
+// image is turned upright first, since its orientation is lost along with the rest of the EXIF data.
+func stripJpegExif(info *model.FileInfo, data []byte) ([]byte, error) {
+	if info.MimeType != "image/jpeg" {
+		return nil, nil
+	}
+
+	if _, err := exif.Decode(bytes.NewReader(data)); err != nil {
+		// There is no EXIF data to strip.
+		return nil, nil
+	}
+
+	orientation, _ := getImageOrientation(bytes.NewReader(data))
+
+	img, err := jpeg.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode the image")
+	}
+
+	buf := &bytes.Buffer{}
+	if err := jpeg.Encode(buf, makeImageUpright(img, orientation), &jpeg.Options{Quality: 90}); err != nil {
+		return nil, errors.Wrap(err, "failed to encode the image")
+	}
+
+	return buf.Bytes(), nil
+}
+
+// extractDimensions sets the width and height of images, as displayed once their orientation is applied.
+func extractDimensions(info *model.FileInfo, data []byte) ([]byte, error) {
+	if !info.IsImage() {
+		return nil, nil
+	}
+
+	if info.MimeType == "image/svg+xml" {
+		svgInfo, err := parseSVG(bytes.NewReader(data))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse the SVG")
+		}
+		if svgInfo.Width > 0 && svgInfo.Height > 0 {
+			info.Width = svgInfo.Width
+			info.Height = svgInfo.Height
+		}
+		return nil, nil
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode the image config")
+	}
+
+	info.Width = config.Width
+	info.Height = config.Height
+	if orientation, err := getImageOrientation(bytes.NewReader(data)); err == nil &&
+		(orientation == RotatedCWMirrored ||
+			orientation == RotatedCCW ||
+			orientation == RotatedCCWMirrored ||
+			orientation == RotatedCW) {
+		info.Width, info.Height = info.Height, info.Width
+	}
+
+	return nil, nil
+}
+
+// countPages sets the number of pages of PDF documents, and of the pages or slides of Word and PowerPoint documents.
+// PDF documents which keep their page objects in compressed streams are left without a count.
+func countPages(info *model.FileInfo, data []byte) ([]byte, error) {
+	switch info.Extension {
+	case "pdf":
+		info.PageCount = len(pdfPageRegexp.FindAll(data, -1))
+	case "docx", "pptx":
+		count, err := countOfficePages(data)
+		if err != nil {
+			return nil, err
+		}
+		info.PageCount = count
+	}
+
+	return nil, nil
+}
+
+// countOfficePages reads the page or slide count that Office Open XML documents record in their extended properties.
+func countOfficePages(data []byte) (int, error) {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to open the document")
+	}
+
+	for _, file := range reader.File {
+		if file.Name != "docProps/app.xml" {
+			continue
+		}
+
+		rc, err := file.Open()
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to open the document properties")
+		}
+		defer rc.Close()
+
+		content, err := ioutil.ReadAll(rc)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to read the document properties")
+		}
+
+		var properties struct {
+			Pages  int `xml:"Pages"`
+			Slides int `xml:"Slides"`
+		}
+		if err := xml.Unmarshal(content, &properties); err != nil {
+			return 0, errors.Wrap(err, "failed to parse the document properties")
+		}
+
+		if properties.Slides > 0 {
+			return properties.Slides, nil
+		}
+		return properties.Pages, nil
+	}
+
+	return 0, nil
+}
+
+// computeHash sets the SHA-256 hash of the content of the file.
+func computeHash(info *model.FileInfo, data []byte) ([]byte, error) {
+	sum := sha256.Sum256(data)
+	info.Hash = hex.EncodeToString(sum[:])
+
+	return nil, nil
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"image"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rwcarlsen/goexif/exif"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/utils/fileutils"
+)
+
+func readTestFile(t *testing.T, name string) []byte {
+	path, _ := fileutils.FindDir("tests")
+	data, err := ioutil.ReadFile(filepath.Join(path, name))
+	require.Nil(t, err)
+	return data
+}
+
+func TestStripJpegExif(t *testing.T) {
+	t.Run("rotated jpeg", func(t *testing.T) {
+		data := readTestFile(t, "orientation_test_6.jpeg")
+		original, _, err := image.DecodeConfig(bytes.NewReader(data))
+		require.Nil(t, err)
+
+		info := model.NewInfo("orientation_test_6.jpeg")
+		stripped, err := stripJpegExif(info, data)
+		require.Nil(t, err)
+		require.NotNil(t, stripped)
+
+		_, err = exif.Decode(bytes.NewReader(stripped))
+		assert.NotNil(t, err, "the EXIF data should have been removed")
+
+		config, _, err := image.DecodeConfig(bytes.NewReader(stripped))
+		require.Nil(t, err)
+		assert.Equal(t, original.Width, config.Height, "the image should have been turned upright")
+		assert.Equal(t, original.Height, config.Width, "the image should have been turned upright")
+	})
+
+	t.Run("jpeg without exif", func(t *testing.T) {
+		data := readTestFile(t, "orientation_test_6.jpeg")
+		stripped, err := stripJpegExif(model.NewInfo("orientation_test_6.jpeg"), data)
+		require.Nil(t, err)
+
+		unchanged, err := stripJpegExif(model.NewInfo("orientation_test_6.jpeg"), stripped)
+		require.Nil(t, err)
+		assert.Nil(t, unchanged)
+	})
+
+	t.Run("png", func(t *testing.T) {
+		stripped, err := stripJpegExif(model.NewInfo("test.png"), readTestFile(t, "test.png"))
+		require.Nil(t, err)
+		assert.Nil(t, stripped)
+	})
+}
+
+func TestExtractDimensions(t *testing.T) {
+	t.Run("rotated jpeg", func(t *testing.T) {
+		data := readTestFile(t, "orientation_test_6.jpeg")
+		config, _, err := image.DecodeConfig(bytes.NewReader(data))
+		require.Nil(t, err)
+
+		info := model.NewInfo("orientation_test_6.jpeg")
+		_, err = extractDimensions(info, data)
+		require.Nil(t, err)
+		assert.Equal(t, config.Height, info.Width)
+		assert.Equal(t, config.Width, info.Height)
+	})
+
+	t.Run("svg", func(t *testing.T) {
+		info := model.NewInfo("test.svg")
+		_, err := extractDimensions(info, readTestFile(t, "test.svg"))
+		require.Nil(t, err)
+		assert.NotZero(t, info.Width)
+		assert.NotZero(t, info.Height)
+	})
+
+	t.Run("not an image", func(t *testing.T) {
+		info := model.NewInfo("test.txt")
+		_, err := extractDimensions(info, []byte("some text"))
+		require.Nil(t, err)
+		assert.Zero(t, info.Width)
+		assert.Zero(t, info.Height)
+	})
+}
+
+func TestCountPages(t *testing.T) {
+	t.Run("pdf", func(t *testing.T) {
+		data := []byte("%PDF-1.4\n" +
+			"1 0 obj << /Type /Catalog /Pages 2 0 R >> endobj\n" +
+			"2 0 obj << /Type /Pages /Kids [3 0 R 4 0 R] /Count 2 >> endobj\n" +
+			"3 0 obj << /Type /Page /Parent 2 0 R >> endobj\n" +
+			"4 0 obj << /Type/Page /Parent 2 0 R >> endobj\n" +
+			"%%EOF\n")
+
+		info := model.NewInfo("document.pdf")
+		_, err := countPages(info, data)
+		require.Nil(t, err)
+		assert.Equal(t, 2, info.PageCount)
+	})
+
+	makeOfficeDocument := func(t *testing.T, properties string) []byte {
+		buf := &bytes.Buffer{}
+		w := zip.NewWriter(buf)
+		f, err := w.Create("docProps/app.xml")
+		require.Nil(t, err)
+		_, err = f.Write([]byte(properties))
+		require.Nil(t, err)
+		require.Nil(t, w.Close())
+		return buf.Bytes()
+	}
+
+	t.Run("docx", func(t *testing.T) {
+		data := makeOfficeDocument(t, `<?xml version="1.0" encoding="UTF-8"?><Properties><Pages>7</Pages><Words>1200</Words></Properties>`)
+
+		info := model.NewInfo("document.docx")
+		_, err := countPages(info, data)
+		require.Nil(t, err)
+		assert.Equal(t, 7, info.PageCount)
+	})
+
+	t.Run("pptx", func(t *testing.T) {
+		data := makeOfficeDocument(t, `<?xml version="1.0" encoding="UTF-8"?><Properties><Slides>12</Slides></Properties>`)
+
+		info := model.NewInfo("slides.pptx")
+		_, err := countPages(info, data)
+		require.Nil(t, err)
+		assert.Equal(t, 12, info.PageCount)
+	})
+
+	t.Run("invalid docx", func(t *testing.T) {
+		info := model.NewInfo("document.docx")
+		_, err := countPages(info, []byte("not a zip"))
+		assert.NotNil(t, err)
+		assert.Zero(t, info.PageCount)
+	})
+}
+
+func TestEnrichFileInfo(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	data := readTestFile(t, "orientation_test_6.jpeg")
+	info, appErr := th.App.DoUploadFile(time.Now(), th.BasicTeam.Id, th.BasicChannel.Id, th.BasicUser.Id, "orientation_test_6.jpeg", data)
+	require.Nil(t, appErr)
+	defer th.App.Srv().Store.FileInfo().PermanentDelete(info.Id)
+
+	enriched, appErr := th.App.EnrichFileInfo(info.Id)
+	require.Nil(t, appErr)
+
+	stored, appErr := th.App.ReadFile(info.Path)
+	require.Nil(t, appErr)
+	_, err := exif.Decode(bytes.NewReader(stored))
+	assert.NotNil(t, err, "the EXIF data should have been removed from the stored file")
+
+	sum := sha256.Sum256(stored)
+	assert.Equal(t, hex.EncodeToString(sum[:]), enriched.Hash)
+	assert.Equal(t, int64(len(stored)), enriched.Size)
+	assert.NotZero(t, enriched.EnrichedAt)
+
+	fetched, appErr := th.App.GetFileInfo(info.Id)
+	require.Nil(t, appErr)
+	assert.Equal(t, enriched.Hash, fetched.Hash)
+	assert.Equal(t, enriched.Size, fetched.Size)
+	assert.Equal(t, info.Width, fetched.Width)
+	assert.Equal(t, info.Height, fetched.Height)
+}
@@ -108,7 +108,7 @@ func (a *App) UpsertGroupSyncable(groupSyncable *model.GroupSyncable) (*model.Gr
 		}
 
 		var team *model.Team
-		team, err = a.Srv().Store.Team().Get(channel.TeamId)
+		team, err = a.GetTeam(channel.TeamId)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,95 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+// ImpersonateUser creates a session acting as the given user on behalf of the user of the
+// impersonator session. The session is read-only unless writeAccess is set, expires after
+// ServiceSettings.ImpersonationSessionLengthInMinutes and is never extended. The impersonated user
+// is notified by email.
+func (a *App) ImpersonateUser(impersonator *model.Session, userId string, writeAccess bool) (*model.Session, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableImpersonation {
+		return nil, model.NewAppError("ImpersonateUser", "app.impersonation.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	if writeAccess && !*a.Config().ServiceSettings.EnableImpersonationWriteAccess {
+		return nil, model.NewAppError("ImpersonateUser", "app.impersonation.write_access_disabled.app_error", nil, "", http.StatusForbidden)
+	}
+
+	if impersonator.IsImpersonated() {
+		return nil, model.NewAppError("ImpersonateUser", "app.impersonation.nested.app_error", nil, "", http.StatusForbidden)
+	}
+
+	if impersonator.UserId == userId {
+		return nil, model.NewAppError("ImpersonateUser", "app.impersonation.self.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	user, appErr := a.GetUser(userId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if user.DeleteAt != 0 || user.IsBot {
+		return nil, model.NewAppError("ImpersonateUser", "app.impersonation.invalid_user.app_error", nil, "user_id="+user.Id, http.StatusBadRequest)
+	}
+
+	// Impersonating a system admin would let a delegated support role escalate its privileges.
+	if user.IsSystemAdmin() {
+		return nil, model.NewAppError("ImpersonateUser", "app.impersonation.system_admin.app_error", nil, "user_id="+user.Id, http.StatusForbidden)
+	}
+
+	impersonatorUser, appErr := a.GetUser(impersonator.UserId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	session := &model.Session{
+		UserId:  user.Id,
+		Roles:   user.GetRawRoles(),
+		IsOAuth: false,
+	}
+	session.AddProp(model.SESSION_PROP_TYPE, model.SESSION_TYPE_IMPERSONATION)
+	session.AddProp(model.SESSION_PROP_IMPERSONATOR_ID, impersonatorUser.Id)
+	session.AddProp(model.SESSION_PROP_IMPERSONATION_WRITE, strconv.FormatBool(writeAccess))
+	session.AddProp(model.SESSION_PROP_IS_GUEST, strconv.FormatBool(user.IsGuest()))
+	session.SetRolesExpiresAt(user.RolesExpiresAt)
+	session.ExpiresAt = model.GetMillis() + int64(*a.Config().ServiceSettings.ImpersonationSessionLengthInMinutes)*60*1000
+
+	session, err := a.Srv().Store.Session().Save(session)
+	if err != nil {
+		var invErr *store.ErrInvalidInput
+		switch {
+		case errors.As(err, &invErr):
+			return nil, model.NewAppError("ImpersonateUser", "app.session.save.existing.app_error", nil, invErr.Error(), http.StatusBadRequest)
+		default:
+			return nil, model.NewAppError("ImpersonateUser", "app.session.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	a.AddSessionToCache(session)
+
+	mlog.Info("Started impersonating a user.",
+		mlog.String("impersonator_id", impersonatorUser.Id),
+		mlog.String("user_id", user.Id),
+		mlog.Bool("write_access", writeAccess),
+		mlog.Int64("expires_at", session.ExpiresAt),
+	)
+
+	a.Srv().Go(func() {
+		if err := a.Srv().EmailService.sendImpersonationStartedEmail(user.Email, impersonatorUser.Username, writeAccess, session.ExpiresAt, user.Locale, a.GetSiteURL()); err != nil {
+			mlog.Error("Unable to send impersonation started email", mlog.Err(err), mlog.String("user_id", user.Id))
+		}
+	})
+
+	return session, nil
+}
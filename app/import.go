@@ -18,6 +18,7 @@ import (
 
 const (
 	importMultiplePostsThreshold = 1000
+	importMultipleTeamsThreshold = 100
 	maxScanTokenSize             = 16 * 1024 * 1024 // Need to set a higher limit than default because some customers cross the limit. See MM-22314
 )
 
@@ -32,8 +33,21 @@ func stopOnError(err LineImportWorkerError) bool {
 func (a *App) bulkImportWorker(dryRun bool, wg *sync.WaitGroup, lines <-chan LineImportWorkerData, errors chan<- LineImportWorkerError) {
 	postLines := []LineImportWorkerData{}
 	directPostLines := []LineImportWorkerData{}
+	teamLines := []LineImportWorkerData{}
 	for line := range lines {
 		switch {
+		case line.LineImportData.Type == "team":
+			if line.Team == nil {
+				errors <- LineImportWorkerError{model.NewAppError("BulkImport", "app.import.import_line.null_team.error", nil, "", http.StatusBadRequest), line.LineNumber}
+				continue
+			}
+			teamLines = append(teamLines, line)
+			if len(teamLines) >= importMultipleTeamsThreshold {
+				if errLine, err := a.importMultipleTeamLines(teamLines, dryRun); err != nil {
+					errors <- LineImportWorkerError{err, errLine}
+				}
+				teamLines = []LineImportWorkerData{}
+			}
 		case line.LineImportData.Type == "post":
 			postLines = append(postLines, line)
 			if line.Post == nil {
@@ -63,6 +77,11 @@ func (a *App) bulkImportWorker(dryRun bool, wg *sync.WaitGroup, lines <-chan Lin
 		}
 	}
 
+	if len(teamLines) > 0 {
+		if errLine, err := a.importMultipleTeamLines(teamLines, dryRun); err != nil {
+			errors <- LineImportWorkerError{err, errLine}
+		}
+	}
 	if len(postLines) > 0 {
 		if errLine, err := a.importMultiplePostLines(postLines, dryRun); err != nil {
 			errors <- LineImportWorkerError{err, errLine}
@@ -77,6 +96,12 @@ func (a *App) bulkImportWorker(dryRun bool, wg *sync.WaitGroup, lines <-chan Lin
 }
 
 func (a *App) BulkImport(fileReader io.Reader, dryRun bool, workers int) (*model.AppError, int) {
+	return a.ResumeBulkImport(fileReader, dryRun, workers, 0)
+}
+
+// ResumeBulkImport runs a bulk import skipping the data lines before startLine, which a previous
+// import that failed at startLine already imported.
+func (a *App) ResumeBulkImport(fileReader io.Reader, dryRun bool, workers int, startLine int) (*model.AppError, int) {
 	scanner := bufio.NewScanner(fileReader)
 	buf := make([]byte, 0, 64*1024)
 	scanner.Buffer(buf, maxScanTokenSize)
@@ -113,9 +138,13 @@ func (a *App) BulkImport(fileReader io.Reader, dryRun bool, workers int) (*model
 			continue
 		}
 
+		if lineNumber < startLine {
+			continue
+		}
+
 		if line.Type != lastLineType {
 			// Only clear the worker queue if is not the first data entry
-			if lineNumber != 2 {
+			if linesChan != nil {
 				// Changing type. Clear out the worker queue before continuing.
 				close(linesChan)
 				wg.Wait()
@@ -200,6 +229,11 @@ func (a *App) importLine(line LineImportData, dryRun bool) *model.AppError {
 			return model.NewAppError("BulkImport", "app.import.import_line.null_user.error", nil, "", http.StatusBadRequest)
 		}
 		return a.importUser(line.User, dryRun)
+	case line.Type == "user_relationship":
+		if line.UserRelationship == nil {
+			return model.NewAppError("BulkImport", "app.import.import_line.null_user_relationship.error", nil, "", http.StatusBadRequest)
+		}
+		return a.importUserRelationship(line.UserRelationship, dryRun)
 	case line.Type == "direct_channel":
 		if line.DirectChannel == nil {
 			return model.NewAppError("BulkImport", "app.import.import_line.null_direct_channel.error", nil, "", http.StatusBadRequest)
@@ -210,6 +244,11 @@ func (a *App) importLine(line LineImportData, dryRun bool) *model.AppError {
 			return model.NewAppError("BulkImport", "app.import.import_line.null_emoji.error", nil, "", http.StatusBadRequest)
 		}
 		return a.importEmoji(line.Emoji, dryRun)
+	case line.Type == "channel_bookmark":
+		if line.ChannelBookmark == nil {
+			return model.NewAppError("BulkImport", "app.import.import_line.null_channel_bookmark.error", nil, "", http.StatusBadRequest)
+		}
+		return a.importChannelBookmark(line.ChannelBookmark, dryRun)
 	default:
 		return model.NewAppError("BulkImport", "app.import.import_line.unknown_line_type.error", map[string]interface{}{"Type": line.Type}, "", http.StatusBadRequest)
 	}
@@ -165,12 +165,30 @@ func (a *App) importTeam(data *TeamImportData, dryRun bool) *model.AppError {
 	}
 
 	var team *model.Team
-	team, err := a.Srv().Store.Team().GetByName(*data.Name)
+	team, err := a.GetTeamByName(*data.Name)
 
 	if err != nil {
 		team = &model.Team{}
 	}
 
+	if err := a.applyTeamImportData(team, data); err != nil {
+		return err
+	}
+
+	if team.Id == "" {
+		if _, err := a.CreateTeam(team); err != nil {
+			return err
+		}
+	} else {
+		if _, err := a.updateTeamUnsanitized(team); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *App) applyTeamImportData(team *model.Team, data *TeamImportData) *model.AppError {
 	team.Name = *data.Name
 	team.DisplayName = *data.DisplayName
 	team.Type = *data.Type
@@ -200,17 +218,75 @@ func (a *App) importTeam(data *TeamImportData, dryRun bool) *model.AppError {
 		team.SchemeId = &scheme.Id
 	}
 
-	if team.Id == "" {
-		if _, err := a.CreateTeam(team); err != nil {
-			return err
+	return nil
+}
+
+// importMultipleTeamLines creates the new teams of the lines with a single insert. The lines
+// naming an existing team are imported one by one, updating the team. It will return an error
+// and the line that caused it whenever possible.
+func (a *App) importMultipleTeamLines(lines []LineImportWorkerData, dryRun bool) (int, *model.AppError) {
+	if len(lines) == 0 {
+		return 0, nil
+	}
+
+	for _, line := range lines {
+		if err := validateTeamImportData(line.Team); err != nil {
+			return line.LineNumber, err
 		}
-	} else {
-		if _, err := a.updateTeamUnsanitized(team); err != nil {
-			return err
+	}
+
+	// If this is a Dry Run, do not continue any further.
+	if dryRun {
+		return 0, nil
+	}
+
+	teams := make([]*model.Team, len(lines))
+	for i, line := range lines {
+		teams[i] = &model.Team{}
+		if err := a.applyTeamImportData(teams[i], line.Team); err != nil {
+			return line.LineNumber, err
 		}
 	}
 
-	return nil
+	saved, rowErrs, err := a.Srv().Store.Team().SaveMultiple(a.Context(), teams)
+	var cErr *store.ErrConflict
+	if err != nil && errors.As(err, &cErr) {
+		// Another worker created one of the teams in the meantime.
+		for _, line := range lines {
+			if err := a.importTeam(line.Team, dryRun); err != nil {
+				return line.LineNumber, err
+			}
+		}
+		return 0, nil
+	} else if err != nil {
+		return 0, model.NewAppError("importMultipleTeamLines", "app.team.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	for i, line := range lines {
+		if rowErr := rowErrs[i]; rowErr != nil {
+			if !errors.As(rowErr, &cErr) {
+				var appErr *model.AppError
+				if errors.As(rowErr, &appErr) {
+					return line.LineNumber, appErr
+				}
+				return line.LineNumber, model.NewAppError("importMultipleTeamLines", "app.team.save.app_error", nil, rowErr.Error(), http.StatusBadRequest)
+			}
+
+			// The team already exists, or is repeated in this batch: import it as an update.
+			if err := a.importTeam(line.Team, dryRun); err != nil {
+				return line.LineNumber, err
+			}
+			continue
+		}
+
+		a.releaseSlug(model.SLUG_HISTORY_KIND_TEAM, "", saved[i].Name)
+
+		if _, err := a.CreateDefaultChannels(saved[i].Id); err != nil {
+			return line.LineNumber, err
+		}
+	}
+
+	return 0, nil
 }
 
 func (a *App) importChannel(data *ChannelImportData, dryRun bool) *model.AppError {
@@ -223,7 +299,7 @@ func (a *App) importChannel(data *ChannelImportData, dryRun bool) *model.AppErro
 		return nil
 	}
 
-	team, err := a.Srv().Store.Team().GetByName(*data.Team)
+	team, err := a.GetTeamByName(*data.Team)
 	if err != nil {
 		return model.NewAppError("BulkImport", "app.import.import_channel.team_not_found.error", map[string]interface{}{"TeamName": *data.Team}, err.Error(), http.StatusBadRequest)
 	}
@@ -278,6 +354,116 @@ func (a *App) importChannel(data *ChannelImportData, dryRun bool) *model.AppErro
 	return nil
 }
 
+func (a *App) importChannelBookmark(data *ChannelBookmarkImportData, dryRun bool) *model.AppError {
+	if err := validateChannelBookmarkImportData(data); err != nil {
+		return err
+	}
+
+	// If this is a Dry Run, do not continue any further.
+	if dryRun {
+		return nil
+	}
+
+	team, err := a.GetTeamByName(*data.Team)
+	if err != nil {
+		return model.NewAppError("BulkImport", "app.import.import_channel_bookmark.team_not_found.error", map[string]interface{}{"TeamName": *data.Team}, err.Error(), http.StatusBadRequest)
+	}
+
+	channel, nErr := a.Srv().Store.Channel().GetByName(team.Id, *data.Channel, false)
+	if nErr != nil {
+		return model.NewAppError("BulkImport", "app.import.import_channel_bookmark.channel_not_found.error", map[string]interface{}{"ChannelName": *data.Channel}, nErr.Error(), http.StatusBadRequest)
+	}
+
+	owner, err := a.Srv().Store.User().GetByUsername(*data.Owner)
+	if err != nil {
+		return model.NewAppError("BulkImport", "app.import.import_channel_bookmark.owner_not_found.error", map[string]interface{}{"Username": *data.Owner}, err.Error(), http.StatusBadRequest)
+	}
+
+	bookmarks, nErr := a.Srv().Store.ChannelBookmark().GetBookmarksForChannel(channel.Id, false)
+	if nErr != nil {
+		return model.NewAppError("BulkImport", "app.channel_bookmark.get_for_channel.app_error", nil, nErr.Error(), http.StatusInternalServerError)
+	}
+
+	var bookmark *model.ChannelBookmark
+	for _, existing := range bookmarks {
+		if existing.Type == model.CHANNEL_BOOKMARK_LINK && existing.LinkUrl == *data.LinkUrl && existing.DisplayName == *data.DisplayName {
+			bookmark = existing
+			break
+		}
+	}
+
+	if bookmark == nil {
+		bookmark = &model.ChannelBookmark{
+			ChannelId:   channel.Id,
+			DisplayName: *data.DisplayName,
+			LinkUrl:     *data.LinkUrl,
+			Type:        model.CHANNEL_BOOKMARK_LINK,
+		}
+	}
+
+	bookmark.OwnerId = owner.Id
+	if data.ImageUrl != nil {
+		bookmark.ImageUrl = *data.ImageUrl
+	}
+	if data.Emoji != nil {
+		bookmark.Emoji = *data.Emoji
+	}
+	if data.SortOrder != nil {
+		bookmark.SortOrder = *data.SortOrder
+	}
+
+	if bookmark.Id == "" {
+		if _, nErr = a.Srv().Store.ChannelBookmark().Save(bookmark); nErr != nil {
+			return model.NewAppError("BulkImport", "app.channel_bookmark.save.app_error", nil, nErr.Error(), http.StatusInternalServerError)
+		}
+	} else {
+		if _, nErr = a.Srv().Store.ChannelBookmark().Update(bookmark); nErr != nil {
+			return model.NewAppError("BulkImport", "app.channel_bookmark.update.app_error", nil, nErr.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return nil
+}
+
+func (a *App) importUserRelationship(data *UserRelationshipImportData, dryRun bool) *model.AppError {
+	if err := validateUserRelationshipImportData(data); err != nil {
+		return err
+	}
+
+	// If this is a Dry Run, do not continue any further.
+	if dryRun {
+		return nil
+	}
+
+	user, err := a.Srv().Store.User().GetByUsername(*data.User)
+	if err != nil {
+		return model.NewAppError("BulkImport", "app.import.import_user_relationship.user_not_found.error", map[string]interface{}{"Username": *data.User}, err.Error(), http.StatusBadRequest)
+	}
+
+	otherUser, err := a.Srv().Store.User().GetByUsername(*data.OtherUser)
+	if err != nil {
+		return model.NewAppError("BulkImport", "app.import.import_user_relationship.user_not_found.error", map[string]interface{}{"Username": *data.OtherUser}, err.Error(), http.StatusBadRequest)
+	}
+
+	if _, nErr := a.Srv().Store.UserRelationship().Get(user.Id, otherUser.Id, *data.Type); nErr == nil {
+		return nil
+	}
+
+	relationship := &model.UserRelationship{
+		UserId:      user.Id,
+		OtherUserId: otherUser.Id,
+		Type:        *data.Type,
+	}
+	if _, nErr := a.Srv().Store.UserRelationship().Save(relationship); nErr != nil {
+		var cErr *store.ErrConflict
+		if !errors.As(nErr, &cErr) {
+			return model.NewAppError("BulkImport", "app.user_relationship.save.app_error", nil, nErr.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return nil
+}
+
 func (a *App) importUser(data *UserImportData, dryRun bool) *model.AppError {
 	if err := validateUserImportData(data); err != nil {
 		return err
@@ -670,7 +856,7 @@ func (a *App) importUserTeams(user *model.User, data *[]UserTeamImportData) *mod
 	isGuestByTeamId := map[string]bool{}
 	isUserByTeamId := map[string]bool{}
 	isAdminByTeamId := map[string]bool{}
-	existingMemberships, err := a.Srv().Store.Team().GetTeamsForUser(user.Id)
+	existingMemberships, err := a.Srv().Store.Team().GetTeamsForUser(a.Context(), user.Id)
 	if err != nil {
 		return err
 	}
@@ -747,16 +933,29 @@ func (a *App) importUserTeams(user *model.User, data *[]UserTeamImportData) *mod
 		}
 	}
 
-	oldMembers, err := a.Srv().Store.Team().UpdateMultipleMembers(oldTeamMembers)
+	oldMembers, err := a.Srv().Store.Team().UpdateMultipleMembers(a.Context(), oldTeamMembers)
 	if err != nil {
 		return err
 	}
 
 	newMembers := []*model.TeamMember{}
 	if len(newTeamMembers) > 0 {
-		newMembers, err = a.Srv().Store.Team().SaveMultipleMembers(newTeamMembers, *a.Config().TeamSettings.MaxUsersPerTeam)
-		if err != nil {
-			return err
+		var nErr error
+		newMembers, nErr = a.Srv().Store.Team().SaveMultipleMembers(a.Context(), newTeamMembers, *a.Config().TeamSettings.MaxUsersPerTeam)
+		if nErr != nil {
+			var appErr *model.AppError
+			var conflictErr *store.ErrConflict
+			var limitExceededErr *store.ErrLimitExceeded
+			switch {
+			case errors.As(nErr, &appErr): // in case we haven't converted to plain error.
+				return appErr
+			case errors.As(nErr, &conflictErr):
+				return model.NewAppError("importUserTeams", "app.team.join_user_to_team.save_member.conflict.app_error", nil, nErr.Error(), http.StatusBadRequest)
+			case errors.As(nErr, &limitExceededErr):
+				return model.NewAppError("importUserTeams", "app.team.join_user_to_team.max_accounts.app_error", nil, nErr.Error(), http.StatusBadRequest)
+			default: // last fallback in case it doesn't map to an existing app error.
+				return model.NewAppError("importUserTeams", "app.team.join_user_to_team.save_member.app_error", nil, nErr.Error(), http.StatusInternalServerError)
+			}
 		}
 	}
 
@@ -1126,7 +1325,7 @@ func (a *App) getUsersByUsernames(usernames []string) (map[string]*model.User, *
 }
 
 func (a *App) getTeamsByNames(names []string) (map[string]*model.Team, *model.AppError) {
-	allTeams, err := a.Srv().Store.Team().GetByNames(names)
+	allTeams, err := a.Srv().Store.Team().GetByNames(a.Context(), names)
 	if err != nil {
 		return nil, model.NewAppError("BulkImport", "app.import.get_teams_by_names.some_teams_not_found.error", nil, err.Error(), http.StatusBadRequest)
 	}
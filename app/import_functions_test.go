@@ -4,6 +4,7 @@
 package app
 
 import (
+	"context"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -504,7 +505,7 @@ func TestImportImportTeam(t *testing.T) {
 	scheme2 := th.SetupTeamScheme()
 
 	// Check how many teams are in the database.
-	teamsCount, err := th.App.Srv().Store.Team().AnalyticsTeamCount(false)
+	teamsCount, err := th.App.Srv().Store.Team().AnalyticsTeamCount(context.Background(), false)
 	require.Nil(t, err, "Failed to get team count.")
 
 	data := TeamImportData{
@@ -579,6 +580,50 @@ func TestImportImportTeam(t *testing.T) {
 	assert.Equal(t, scheme2.Id, *team.SchemeId)
 }
 
+func TestImportImportMultipleTeamLines(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	existing := th.CreateTeam()
+
+	newName := model.NewId()
+	lines := []LineImportWorkerData{
+		{LineImportData{Type: "team", Team: &TeamImportData{Name: ptrStr(newName), DisplayName: ptrStr("New Team"), Type: ptrStr("O")}}, 2},
+		{LineImportData{Type: "team", Team: &TeamImportData{Name: ptrStr(existing.Name), DisplayName: ptrStr("Updated Team"), Type: ptrStr("I")}}, 3},
+	}
+
+	teamsCount, err := th.App.Srv().Store.Team().AnalyticsTeamCount(context.Background(), false)
+	require.Nil(t, err)
+
+	errLine, err := th.App.importMultipleTeamLines(lines, true)
+	require.Nil(t, err)
+	assert.Zero(t, errLine)
+	th.CheckTeamCount(t, teamsCount)
+
+	errLine, err = th.App.importMultipleTeamLines(lines, false)
+	require.Nil(t, err)
+	assert.Zero(t, errLine)
+	th.CheckTeamCount(t, teamsCount+1)
+
+	team, err := th.App.GetTeamByName(newName)
+	require.Nil(t, err)
+	assert.Equal(t, "New Team", team.DisplayName)
+
+	channels, err := th.App.GetPublicChannelsForTeam(team.Id, 0, 100)
+	require.Nil(t, err)
+	assert.NotEmpty(t, *channels)
+
+	team, err = th.App.GetTeam(existing.Id)
+	require.Nil(t, err)
+	assert.Equal(t, "Updated Team", team.DisplayName)
+	assert.Equal(t, "I", team.Type)
+
+	lines[0].Team.Type = ptrStr("XYZ")
+	errLine, err = th.App.importMultipleTeamLines(lines, false)
+	require.NotNil(t, err)
+	assert.Equal(t, 2, errLine)
+}
+
 func TestImportImportChannel(t *testing.T) {
 	th := Setup(t)
 	defer th.TearDown()
@@ -1711,7 +1756,7 @@ func TestImportUserTeams(t *testing.T) {
 				} else {
 					require.Nil(t, err)
 				}
-				teamMembers, err := th.App.Srv().Store.Team().GetTeamsForUser(user.Id)
+				teamMembers, err := th.App.Srv().Store.Team().GetTeamsForUser(context.Background(), user.Id)
 				require.Nil(t, err)
 				require.Len(t, teamMembers, tc.expectedUserTeams)
 				if tc.expectedUserTeams == 1 {
@@ -1913,7 +1958,7 @@ func TestImportUserDefaultNotifyProps(t *testing.T) {
 	comparisonUser.SetDefaultNotifications()
 
 	for key, expectedValue := range comparisonUser.NotifyProps {
-		if key == model.EMAIL_NOTIFY_PROP {
+		if key == model.EMAIL_NOTIFY_PROP || key == model.INHERITED_NOTIFY_PROP {
 			continue
 		}
 
@@ -1958,7 +2003,7 @@ func TestImportimportMultiplePostLines(t *testing.T) {
 	require.Nil(t, err, "Failed to get user from database.")
 
 	// Count the number of posts in the testing team.
-	initialPostCount, err := th.App.Srv().Store.Post().AnalyticsPostCount(team.Id, false, false)
+	initialPostCount, err := th.App.Srv().Store.Post().AnalyticsPostCount(team.Id, false, false, false)
 	require.Nil(t, err)
 
 	// Try adding an invalid post in dry run mode.
@@ -2447,7 +2492,7 @@ func TestImportImportPost(t *testing.T) {
 	require.Nil(t, appErr, "Failed to get user from database.")
 
 	// Count the number of posts in the testing team.
-	initialPostCount, appErr := th.App.Srv().Store.Post().AnalyticsPostCount(team.Id, false, false)
+	initialPostCount, appErr := th.App.Srv().Store.Post().AnalyticsPostCount(team.Id, false, false, false)
 	require.Nil(t, appErr)
 
 	time := model.GetMillis()
@@ -3087,7 +3132,7 @@ func TestImportImportDirectPost(t *testing.T) {
 	directChannel = channel
 
 	// Get the number of posts in the system.
-	result, appErr := th.App.Srv().Store.Post().AnalyticsPostCount("", false, false)
+	result, appErr := th.App.Srv().Store.Post().AnalyticsPostCount("", false, false, false)
 	require.Nil(t, appErr)
 	initialPostCount := result
 	initialDate := model.GetMillis()
@@ -3349,7 +3394,7 @@ func TestImportImportDirectPost(t *testing.T) {
 	groupChannel = channel
 
 	// Get the number of posts in the system.
-	result, appErr = th.App.Srv().Store.Post().AnalyticsPostCount("", false, false)
+	result, appErr = th.App.Srv().Store.Post().AnalyticsPostCount("", false, false, false)
 	require.Nil(t, appErr)
 	initialPostCount = result
 
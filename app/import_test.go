@@ -61,7 +61,7 @@ func checkNoError(t *testing.T, err *model.AppError) {
 }
 
 func AssertAllPostsCount(t *testing.T, a *App, initialCount int64, change int64, teamName string) {
-	result, err := a.Srv().Store.Post().AnalyticsPostCount(teamName, false, false)
+	result, err := a.Srv().Store.Post().AnalyticsPostCount(teamName, false, false, false)
 	require.Nil(t, err)
 	require.Equal(t, initialCount+change, result, "Did not find the expected number of posts.")
 }
@@ -224,6 +224,20 @@ func TestImportBulkImport(t *testing.T) {
 		require.Nil(t, err, "BulkImport should have succeeded")
 		require.Equal(t, 0, line, "BulkImport line should be 0")
 	})
+
+	t.Run("Resume from a line", func(t *testing.T) {
+		data := `{"type": "version", "version": 1}
+{"type": "user", "user": {"username": "invalid username", "email": "invalid@example.com"}}
+{"type": "team", "team": {"type": "O", "display_name": "lskmw2d7a5ao7ppwqh5ljchvr4", "name": "` + teamName + `"}}`
+
+		err, line := th.App.ResumeBulkImport(strings.NewReader(data), false, 2, 0)
+		require.NotNil(t, err, "Should have failed due to the invalid user on line 2.")
+		require.Equal(t, 2, line, "Should have failed due to the invalid user on line 2.")
+
+		err, line = th.App.ResumeBulkImport(strings.NewReader(data), false, 2, 3)
+		require.Nil(t, err, "BulkImport should have succeeded")
+		require.Equal(t, 0, line, "BulkImport line should be 0")
+	})
 }
 
 func TestImportProcessImportDataFileVersionLine(t *testing.T) {
@@ -8,16 +8,18 @@ import "github.com/mattermost/mattermost-server/v5/model"
 // Import Data Models
 
 type LineImportData struct {
-	Type          string                   `json:"type"`
-	Scheme        *SchemeImportData        `json:"scheme,omitempty"`
-	Team          *TeamImportData          `json:"team,omitempty"`
-	Channel       *ChannelImportData       `json:"channel,omitempty"`
-	User          *UserImportData          `json:"user,omitempty"`
-	Post          *PostImportData          `json:"post,omitempty"`
-	DirectChannel *DirectChannelImportData `json:"direct_channel,omitempty"`
-	DirectPost    *DirectPostImportData    `json:"direct_post,omitempty"`
-	Emoji         *EmojiImportData         `json:"emoji,omitempty"`
-	Version       *int                     `json:"version,omitempty"`
+	Type             string                      `json:"type"`
+	Scheme           *SchemeImportData           `json:"scheme,omitempty"`
+	Team             *TeamImportData             `json:"team,omitempty"`
+	Channel          *ChannelImportData          `json:"channel,omitempty"`
+	User             *UserImportData             `json:"user,omitempty"`
+	UserRelationship *UserRelationshipImportData `json:"user_relationship,omitempty"`
+	Post             *PostImportData             `json:"post,omitempty"`
+	DirectChannel    *DirectChannelImportData    `json:"direct_channel,omitempty"`
+	DirectPost       *DirectPostImportData       `json:"direct_post,omitempty"`
+	Emoji            *EmojiImportData            `json:"emoji,omitempty"`
+	ChannelBookmark  *ChannelBookmarkImportData  `json:"channel_bookmark,omitempty"`
+	Version          *int                        `json:"version,omitempty"`
 }
 
 type TeamImportData struct {
@@ -39,6 +41,17 @@ type ChannelImportData struct {
 	Scheme      *string `json:"scheme,omitempty"`
 }
 
+type ChannelBookmarkImportData struct {
+	Team        *string `json:"team"`
+	Channel     *string `json:"channel"`
+	Owner       *string `json:"owner"`
+	DisplayName *string `json:"display_name"`
+	LinkUrl     *string `json:"link_url"`
+	ImageUrl    *string `json:"image_url,omitempty"`
+	Emoji       *string `json:"emoji,omitempty"`
+	SortOrder   *int64  `json:"sort_order,omitempty"`
+}
+
 type UserImportData struct {
 	ProfileImage       *string `json:"profile_image,omitempty"`
 	Username           *string `json:"username"`
@@ -70,6 +83,12 @@ type UserImportData struct {
 	NotifyProps *UserNotifyPropsImportData `json:"notify_props,omitempty"`
 }
 
+type UserRelationshipImportData struct {
+	User      *string `json:"user"`
+	OtherUser *string `json:"other_user"`
+	Type      *string `json:"type"`
+}
+
 type UserNotifyPropsImportData struct {
 	Desktop      *string `json:"desktop"`
 	DesktopSound *string `json:"desktop_sound"`
@@ -192,6 +192,60 @@ func validateChannelImportData(data *ChannelImportData) *model.AppError {
 	return nil
 }
 
+func validateChannelBookmarkImportData(data *ChannelBookmarkImportData) *model.AppError {
+	if data.Team == nil {
+		return model.NewAppError("BulkImport", "app.import.validate_channel_bookmark_import_data.team_missing.error", nil, "", http.StatusBadRequest)
+	}
+
+	if data.Channel == nil {
+		return model.NewAppError("BulkImport", "app.import.validate_channel_bookmark_import_data.channel_missing.error", nil, "", http.StatusBadRequest)
+	}
+
+	if data.Owner == nil {
+		return model.NewAppError("BulkImport", "app.import.validate_channel_bookmark_import_data.owner_missing.error", nil, "", http.StatusBadRequest)
+	}
+
+	if data.DisplayName == nil {
+		return model.NewAppError("BulkImport", "app.import.validate_channel_bookmark_import_data.display_name_missing.error", nil, "", http.StatusBadRequest)
+	} else if utf8.RuneCountInString(*data.DisplayName) == 0 || utf8.RuneCountInString(*data.DisplayName) > model.CHANNEL_BOOKMARK_DISPLAY_NAME_MAX_RUNES {
+		return model.NewAppError("BulkImport", "app.import.validate_channel_bookmark_import_data.display_name_length.error", nil, "", http.StatusBadRequest)
+	}
+
+	if data.LinkUrl == nil {
+		return model.NewAppError("BulkImport", "app.import.validate_channel_bookmark_import_data.link_url_missing.error", nil, "", http.StatusBadRequest)
+	} else if len(*data.LinkUrl) > model.CHANNEL_BOOKMARK_URL_MAX_LENGTH || !model.IsValidHttpUrl(*data.LinkUrl) {
+		return model.NewAppError("BulkImport", "app.import.validate_channel_bookmark_import_data.link_url_invalid.error", nil, "", http.StatusBadRequest)
+	}
+
+	if data.ImageUrl != nil && *data.ImageUrl != "" && (len(*data.ImageUrl) > model.CHANNEL_BOOKMARK_URL_MAX_LENGTH || !model.IsValidHttpUrl(*data.ImageUrl)) {
+		return model.NewAppError("BulkImport", "app.import.validate_channel_bookmark_import_data.image_url_invalid.error", nil, "", http.StatusBadRequest)
+	}
+
+	if data.Emoji != nil && len(*data.Emoji) > model.CHANNEL_BOOKMARK_EMOJI_MAX_LENGTH {
+		return model.NewAppError("BulkImport", "app.import.validate_channel_bookmark_import_data.emoji_length.error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func validateUserRelationshipImportData(data *UserRelationshipImportData) *model.AppError {
+	if data.User == nil {
+		return model.NewAppError("BulkImport", "app.import.validate_user_relationship_import_data.user_missing.error", nil, "", http.StatusBadRequest)
+	}
+
+	if data.OtherUser == nil {
+		return model.NewAppError("BulkImport", "app.import.validate_user_relationship_import_data.other_user_missing.error", nil, "", http.StatusBadRequest)
+	} else if *data.OtherUser == *data.User {
+		return model.NewAppError("BulkImport", "app.import.validate_user_relationship_import_data.same_user.error", nil, "", http.StatusBadRequest)
+	}
+
+	if data.Type == nil || !model.IsValidUserRelationshipType(*data.Type) {
+		return model.NewAppError("BulkImport", "app.import.validate_user_relationship_import_data.type_invalid.error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
 func validateUserImportData(data *UserImportData) *model.AppError {
 	if data.ProfileImage != nil {
 		if _, err := os.Stat(*data.ProfileImage); os.IsNotExist(err) {
@@ -1371,3 +1371,77 @@ func TestImportValidateEmojiImportData(t *testing.T) {
 	err = validateEmojiImportData(&data)
 	assert.NotNil(t, err)
 }
+
+func TestImportValidateUserRelationshipImportData(t *testing.T) {
+	data := UserRelationshipImportData{
+		User:      ptrStr("username"),
+		OtherUser: ptrStr("otherusername"),
+		Type:      ptrStr(model.USER_RELATIONSHIP_MUTE),
+	}
+
+	err := validateUserRelationshipImportData(&data)
+	assert.Nil(t, err, "Validation should succeed")
+
+	data.User = nil
+	err = validateUserRelationshipImportData(&data)
+	assert.NotNil(t, err)
+	data.User = ptrStr("username")
+
+	data.OtherUser = nil
+	err = validateUserRelationshipImportData(&data)
+	assert.NotNil(t, err)
+
+	data.OtherUser = ptrStr("username")
+	err = validateUserRelationshipImportData(&data)
+	assert.NotNil(t, err)
+	data.OtherUser = ptrStr("otherusername")
+
+	*data.Type = "follow"
+	err = validateUserRelationshipImportData(&data)
+	assert.NotNil(t, err)
+
+	*data.Type = model.USER_RELATIONSHIP_BLOCK
+	err = validateUserRelationshipImportData(&data)
+	assert.Nil(t, err)
+}
+
+func TestImportValidateChannelBookmarkImportData(t *testing.T) {
+	data := ChannelBookmarkImportData{
+		Team:        ptrStr("teamname"),
+		Channel:     ptrStr("channelname"),
+		Owner:       ptrStr("username"),
+		DisplayName: ptrStr("Handbook"),
+		LinkUrl:     ptrStr("https://handbook.mattermost.com"),
+	}
+
+	err := validateChannelBookmarkImportData(&data)
+	assert.Nil(t, err, "Validation should succeed")
+
+	data.Team = nil
+	err = validateChannelBookmarkImportData(&data)
+	assert.NotNil(t, err)
+	data.Team = ptrStr("teamname")
+
+	data.Owner = nil
+	err = validateChannelBookmarkImportData(&data)
+	assert.NotNil(t, err)
+	data.Owner = ptrStr("username")
+
+	*data.DisplayName = ""
+	err = validateChannelBookmarkImportData(&data)
+	assert.NotNil(t, err)
+	*data.DisplayName = "Handbook"
+
+	*data.LinkUrl = "junk"
+	err = validateChannelBookmarkImportData(&data)
+	assert.NotNil(t, err)
+	*data.LinkUrl = "https://handbook.mattermost.com"
+
+	data.ImageUrl = ptrStr("junk")
+	err = validateChannelBookmarkImportData(&data)
+	assert.NotNil(t, err)
+
+	*data.ImageUrl = ""
+	err = validateChannelBookmarkImportData(&data)
+	assert.Nil(t, err)
+}
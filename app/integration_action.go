@@ -175,7 +175,7 @@ func (a *App) DoPostActionWithCookie(postId, actionId, userId, selectedOption st
 			return
 		}
 
-		team, err := a.Srv().Store.Team().Get(upstreamRequest.TeamId)
+		team, err := a.GetTeam(upstreamRequest.TeamId)
 		teamChan <- store.StoreResult{Data: team, Err: err}
 	}()
 
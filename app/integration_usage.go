@@ -0,0 +1,259 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+const (
+	INTEGRATION_USAGE_FLUSH_INTERVAL = time.Minute
+	// INTEGRATION_USAGE_RETENTION_DAYS is how long the daily usage is kept, long enough to cover the
+	// longest report.
+	INTEGRATION_USAGE_RETENTION_DAYS    = model.INTEGRATION_USAGE_MAX_DAYS
+	INTEGRATION_USAGE_DELETE_BATCH_SIZE = 1000
+	INTEGRATION_USAGE_LIST_PAGE_SIZE    = 200
+)
+
+type integrationUsageKey struct {
+	integrationId   string
+	integrationType string
+	day             int64
+}
+
+// integrationUsageRecorder counts the activity of the integrations in memory and periodically adds
+// it to the daily usage of the store, so that busy integrations don't write to the database on
+// every post, execution or delivery.
+type integrationUsageRecorder struct {
+	server *Server
+	stop   chan struct{}
+	done   chan struct{}
+
+	mutex   sync.Mutex
+	pending map[integrationUsageKey]*model.IntegrationUsage
+}
+
+func newIntegrationUsageRecorder(s *Server) *integrationUsageRecorder {
+	return &integrationUsageRecorder{
+		server:  s,
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+		pending: make(map[integrationUsageKey]*model.IntegrationUsage),
+	}
+}
+
+func (s *Server) startIntegrationUsageRecorder() {
+	s.integrationUsageRecorder = newIntegrationUsageRecorder(s)
+	go s.integrationUsageRecorder.run()
+}
+
+// stopIntegrationUsageRecorder stops the recorder once it flushed the usage counted so far.
+func (s *Server) stopIntegrationUsageRecorder() {
+	if s.integrationUsageRecorder != nil {
+		close(s.integrationUsageRecorder.stop)
+		<-s.integrationUsageRecorder.done
+	}
+}
+
+func (r *integrationUsageRecorder) run() {
+	defer close(r.done)
+
+	ticker := time.NewTicker(INTEGRATION_USAGE_FLUSH_INTERVAL)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.stop:
+			r.flush()
+			return
+		case <-ticker.C:
+			r.flush()
+			if r.server.IsLeader() {
+				r.deleteExpired(model.GetMillis())
+			}
+		}
+	}
+}
+
+func (r *integrationUsageRecorder) record(usage *model.IntegrationUsage) {
+	key := integrationUsageKey{usage.IntegrationId, usage.Type, usage.Day}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if pending, ok := r.pending[key]; ok {
+		pending.Add(usage)
+		return
+	}
+	r.pending[key] = usage
+}
+
+// flush adds the usage counted since the previous flush to the store. The usage that fails to be
+// saved is dropped rather than retried, the counters being statistics.
+func (r *integrationUsageRecorder) flush() {
+	r.mutex.Lock()
+	pending := r.pending
+	r.pending = make(map[integrationUsageKey]*model.IntegrationUsage)
+	r.mutex.Unlock()
+
+	for _, usage := range pending {
+		if err := r.server.Store.IntegrationUsage().Increment(usage); err != nil {
+			mlog.Warn("Unable to record the usage of an integration.", mlog.String("integration_id", usage.IntegrationId), mlog.Err(err))
+		}
+	}
+}
+
+func (r *integrationUsageRecorder) deleteExpired(now int64) {
+	endDay := model.IntegrationUsageDay(now) - int64(INTEGRATION_USAGE_RETENTION_DAYS)*model.INTEGRATION_USAGE_DAY_MILLIS
+	if _, err := r.server.Store.IntegrationUsage().PermanentDeleteBatch(endDay, INTEGRATION_USAGE_DELETE_BATCH_SIZE); err != nil {
+		mlog.Error("Unable to remove the expired usage of the integrations.", mlog.Err(err))
+	}
+}
+
+// recordIntegrationUsage counts a post, execution or delivery of the integration, depending on its
+// type, or a failure when it didn't succeed.
+func (s *Server) recordIntegrationUsage(integrationId, integrationType string, succeeded bool) {
+	if s.integrationUsageRecorder == nil {
+		return
+	}
+	s.integrationUsageRecorder.record(model.NewIntegrationUsage(integrationId, integrationType, succeeded, model.GetMillis()))
+}
+
+// GetIntegrationsUsage returns the daily activity of the integrations over the given number of
+// days, today included, along with the activity of every existing integration over that period.
+// The integrations that existed for the whole period without being used are flagged as dormant.
+func (a *App) GetIntegrationsUsage(days int) (*model.IntegrationsUsageReport, *model.AppError) {
+	// Include the usage counted since the last flush.
+	if a.Srv().integrationUsageRecorder != nil {
+		a.Srv().integrationUsageRecorder.flush()
+	}
+
+	today := model.IntegrationUsageDay(model.GetMillis())
+	startDay := today - int64(days-1)*model.INTEGRATION_USAGE_DAY_MILLIS
+
+	daily, err := a.Srv().Store.IntegrationUsage().GetForPeriod(startDay, today)
+	if err != nil {
+		return nil, model.NewAppError("GetIntegrationsUsage", "app.integration_usage.get_for_period.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	integrations, appErr := a.getIntegrationUsageSummaries()
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	return model.NewIntegrationsUsageReport(startDay, daily, integrations), nil
+}
+
+// getIntegrationUsageSummaries lists the existing bots, webhooks and slash commands as the
+// summaries of a usage report, without any activity.
+func (a *App) getIntegrationUsageSummaries() ([]*model.IntegrationUsageSummary, *model.AppError) {
+	var summaries []*model.IntegrationUsageSummary
+
+	for page := 0; ; page++ {
+		bots, err := a.Srv().Store.Bot().GetAll(&model.BotGetOptions{Page: page, PerPage: INTEGRATION_USAGE_LIST_PAGE_SIZE})
+		if err != nil {
+			return nil, model.NewAppError("getIntegrationUsageSummaries", "app.bot.getbots.internal_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		for _, bot := range bots {
+			summaries = append(summaries, &model.IntegrationUsageSummary{
+				IntegrationId: bot.UserId,
+				Type:          model.INTEGRATION_USAGE_TYPE_BOT,
+				DisplayName:   bot.Username,
+				CreatorId:     bot.OwnerId,
+				CreateAt:      bot.CreateAt,
+			})
+		}
+		if len(bots) < INTEGRATION_USAGE_LIST_PAGE_SIZE {
+			break
+		}
+	}
+
+	for offset := 0; ; offset += INTEGRATION_USAGE_LIST_PAGE_SIZE {
+		hooks, err := a.Srv().Store.Webhook().GetIncomingList(offset, INTEGRATION_USAGE_LIST_PAGE_SIZE)
+		if err != nil {
+			return nil, err
+		}
+		for _, hook := range hooks {
+			summaries = append(summaries, &model.IntegrationUsageSummary{
+				IntegrationId: hook.Id,
+				Type:          model.INTEGRATION_USAGE_TYPE_INCOMING_WEBHOOK,
+				DisplayName:   hook.DisplayName,
+				CreatorId:     hook.UserId,
+				CreateAt:      hook.CreateAt,
+			})
+		}
+		if len(hooks) < INTEGRATION_USAGE_LIST_PAGE_SIZE {
+			break
+		}
+	}
+
+	for offset := 0; ; offset += INTEGRATION_USAGE_LIST_PAGE_SIZE {
+		hooks, err := a.Srv().Store.Webhook().GetOutgoingList(offset, INTEGRATION_USAGE_LIST_PAGE_SIZE)
+		if err != nil {
+			return nil, err
+		}
+		for _, hook := range hooks {
+			summaries = append(summaries, &model.IntegrationUsageSummary{
+				IntegrationId: hook.Id,
+				Type:          model.INTEGRATION_USAGE_TYPE_OUTGOING_WEBHOOK,
+				DisplayName:   hook.DisplayName,
+				CreatorId:     hook.CreatorId,
+				CreateAt:      hook.CreateAt,
+			})
+		}
+		if len(hooks) < INTEGRATION_USAGE_LIST_PAGE_SIZE {
+			break
+		}
+	}
+
+	for offset := 0; ; offset += INTEGRATION_USAGE_LIST_PAGE_SIZE {
+		hooks, err := a.Srv().Store.PresenceWebhook().GetAll(offset, INTEGRATION_USAGE_LIST_PAGE_SIZE)
+		if err != nil {
+			return nil, model.NewAppError("getIntegrationUsageSummaries", "app.presence_webhook.get_all.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		for _, hook := range hooks {
+			summaries = append(summaries, &model.IntegrationUsageSummary{
+				IntegrationId: hook.Id,
+				Type:          model.INTEGRATION_USAGE_TYPE_PRESENCE_WEBHOOK,
+				DisplayName:   hook.DisplayName,
+				CreatorId:     hook.CreatorId,
+				CreateAt:      hook.CreateAt,
+			})
+		}
+		if len(hooks) < INTEGRATION_USAGE_LIST_PAGE_SIZE {
+			break
+		}
+	}
+
+	teams, appErr := a.Srv().Store.Team().GetAll(a.Context())
+	if appErr != nil {
+		return nil, appErr
+	}
+	for _, team := range teams {
+		commands, err := a.Srv().Store.Command().GetByTeam(team.Id)
+		if err != nil {
+			return nil, model.NewAppError("getIntegrationUsageSummaries", "app.command.listteamcommands.internal_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		for _, command := range commands {
+			displayName := command.DisplayName
+			if displayName == "" {
+				displayName = "/" + command.Trigger
+			}
+			summaries = append(summaries, &model.IntegrationUsageSummary{
+				IntegrationId: command.Id,
+				Type:          model.INTEGRATION_USAGE_TYPE_COMMAND,
+				DisplayName:   displayName,
+				CreatorId:     command.CreatorId,
+				CreateAt:      command.CreateAt,
+			})
+		}
+	}
+
+	return summaries, nil
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestGetIntegrationsUsage(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableOutgoingWebhooks = true })
+
+	hook, err := th.App.CreateOutgoingWebhook(&model.OutgoingWebhook{
+		ChannelId:    th.BasicChannel.Id,
+		TeamId:       th.BasicTeam.Id,
+		CreatorId:    th.BasicUser.Id,
+		DisplayName:  "usage",
+		CallbackURLs: []string{"http://nowhere.com"},
+	})
+	require.Nil(t, err)
+
+	th.App.Srv().recordIntegrationUsage(hook.Id, model.INTEGRATION_USAGE_TYPE_OUTGOING_WEBHOOK, true)
+	th.App.Srv().recordIntegrationUsage(hook.Id, model.INTEGRATION_USAGE_TYPE_OUTGOING_WEBHOOK, true)
+	th.App.Srv().recordIntegrationUsage(hook.Id, model.INTEGRATION_USAGE_TYPE_OUTGOING_WEBHOOK, true)
+	th.App.Srv().recordIntegrationUsage(hook.Id, model.INTEGRATION_USAGE_TYPE_OUTGOING_WEBHOOK, false)
+
+	report, err := th.App.GetIntegrationsUsage(7)
+	require.Nil(t, err)
+
+	var daily *model.IntegrationUsage
+	for _, usage := range report.Daily {
+		if usage.IntegrationId == hook.Id {
+			daily = usage
+		}
+	}
+	require.NotNil(t, daily, "usage not flushed before the report")
+	assert.Equal(t, model.IntegrationUsageDay(model.GetMillis()), daily.Day)
+
+	var summary *model.IntegrationUsageSummary
+	for _, integration := range report.Integrations {
+		if integration.IntegrationId == hook.Id {
+			summary = integration
+		}
+	}
+	require.NotNil(t, summary)
+	assert.Equal(t, "usage", summary.DisplayName)
+	assert.Equal(t, th.BasicUser.Id, summary.CreatorId)
+	assert.Equal(t, int64(3), summary.Deliveries)
+	assert.Equal(t, int64(1), summary.Failures)
+	assert.Equal(t, 0.25, summary.ErrorRate)
+	assert.Equal(t, daily.Day, summary.LastActiveDay)
+	assert.False(t, summary.Dormant)
+}
@@ -4,9 +4,18 @@
 package app
 
 import (
+	"io"
+	"net/http"
+	"path/filepath"
+	"strconv"
+
 	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/services/filesstore"
 )
 
+// JOB_ARTIFACTS_DIRECTORY is the directory of the file store keeping the files produced by the jobs.
+const JOB_ARTIFACTS_DIRECTORY = "jobs"
+
 func (a *App) GetJob(id string) (*model.Job, *model.AppError) {
 	return a.Srv().Store.Job().Get(id)
 }
@@ -34,3 +43,33 @@ func (a *App) CreateJob(job *model.Job) (*model.Job, *model.AppError) {
 func (a *App) CancelJob(jobId string) *model.AppError {
 	return a.Srv().Jobs.RequestCancellation(jobId)
 }
+
+// SaveJobArtifact writes the file produced by the in progress job to the file store and records it
+// in the job data, so that it can be downloaded once the job is done.
+func (a *App) SaveJobArtifact(job *model.Job, name string, data io.Reader) *model.AppError {
+	path := filepath.Join(JOB_ARTIFACTS_DIRECTORY, job.Id, name)
+
+	size, err := a.WriteFile(data, path)
+	if err != nil {
+		return err
+	}
+
+	if job.Data == nil {
+		job.Data = make(map[string]string)
+	}
+	job.Data[model.JOB_DATA_ARTIFACT_NAME] = name
+	job.Data[model.JOB_DATA_ARTIFACT_PATH] = path
+	job.Data[model.JOB_DATA_ARTIFACT_SIZE] = strconv.FormatInt(size, 10)
+
+	return a.Srv().Jobs.UpdateInProgressJobData(job)
+}
+
+// GetJobArtifact opens the file produced by the job. The caller must close it.
+func (a *App) GetJobArtifact(job *model.Job) (filesstore.ReadCloseSeeker, *model.AppError) {
+	path := job.Data[model.JOB_DATA_ARTIFACT_PATH]
+	if path == "" {
+		return nil, model.NewAppError("GetJobArtifact", "app.job.get_artifact.not_found.app_error", nil, "job_id="+job.Id, http.StatusNotFound)
+	}
+
+	return a.FileReader(path)
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"sync"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+)
+
+const (
+	LAST_ACTIVITY_FLUSH_INTERVAL = 10 * time.Second
+	// LAST_ACTIVITY_FLUSH_BATCH_SIZE bounds the number of users updated by a single statement.
+	LAST_ACTIVITY_FLUSH_BATCH_SIZE = 500
+)
+
+// lastActivityBuffer holds the latest LastActivityAt of the users in memory and periodically writes
+// them to the store in batches, so that the statuses of active users aren't updated on every action.
+type lastActivityBuffer struct {
+	server *Server
+	stop   chan struct{}
+	done   chan struct{}
+
+	mutex   sync.Mutex
+	pending map[string]int64
+}
+
+func newLastActivityBuffer(s *Server) *lastActivityBuffer {
+	return &lastActivityBuffer{
+		server:  s,
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+		pending: make(map[string]int64),
+	}
+}
+
+func (s *Server) startLastActivityBuffer() {
+	s.lastActivityBuffer = newLastActivityBuffer(s)
+	go s.lastActivityBuffer.run()
+}
+
+// stopLastActivityBuffer stops the buffer once it flushed the pending updates.
+func (s *Server) stopLastActivityBuffer() {
+	if s.lastActivityBuffer != nil {
+		close(s.lastActivityBuffer.stop)
+		<-s.lastActivityBuffer.done
+	}
+}
+
+func (b *lastActivityBuffer) run() {
+	defer close(b.done)
+
+	ticker := time.NewTicker(LAST_ACTIVITY_FLUSH_INTERVAL)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-b.stop:
+			b.flush()
+			return
+		case <-ticker.C:
+			b.flush()
+		}
+	}
+}
+
+func (b *lastActivityBuffer) record(userId string, lastActivityAt int64) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if lastActivityAt > b.pending[userId] {
+		b.pending[userId] = lastActivityAt
+	}
+}
+
+// flush writes the pending updates to the store. The updates that fail to be written are dropped
+// rather than retried, the next action of the users updating them again.
+func (b *lastActivityBuffer) flush() {
+	b.mutex.Lock()
+	pending := b.pending
+	b.pending = make(map[string]int64)
+	b.mutex.Unlock()
+
+	batch := make(map[string]int64, LAST_ACTIVITY_FLUSH_BATCH_SIZE)
+	for userId, lastActivityAt := range pending {
+		batch[userId] = lastActivityAt
+		if len(batch) == LAST_ACTIVITY_FLUSH_BATCH_SIZE {
+			b.write(batch)
+			batch = make(map[string]int64, LAST_ACTIVITY_FLUSH_BATCH_SIZE)
+		}
+	}
+	b.write(batch)
+}
+
+func (b *lastActivityBuffer) write(batch map[string]int64) {
+	if len(batch) == 0 {
+		return
+	}
+
+	if err := b.server.Store.Status().UpdateLastActivityAts(batch); err != nil {
+		mlog.Error("Failed to save the last activity of the users", mlog.Int("count", len(batch)), mlog.Err(err))
+	}
+}
+
+// updateLastActivityAt buffers the update of the LastActivityAt of the user's status, which is
+// written to the store with the updates of the other users.
+func (s *Server) updateLastActivityAt(userId string, lastActivityAt int64) {
+	if s.lastActivityBuffer == nil {
+		if err := s.Store.Status().UpdateLastActivityAt(userId, lastActivityAt); err != nil {
+			mlog.Error("Failed to save status", mlog.String("user_id", userId), mlog.Err(err))
+		}
+		return
+	}
+
+	s.lastActivityBuffer.record(userId, lastActivityAt)
+}
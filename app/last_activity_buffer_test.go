@@ -0,0 +1,61 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestLastActivityBuffer(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	for _, user := range []*model.User{th.BasicUser, th.BasicUser2} {
+		require.Nil(t, th.App.Srv().Store.Status().SaveOrUpdate(&model.Status{UserId: user.Id, Status: model.STATUS_ONLINE, LastActivityAt: 100}))
+	}
+
+	buffer := newLastActivityBuffer(th.App.Srv())
+	buffer.record(th.BasicUser.Id, 200)
+	buffer.record(th.BasicUser.Id, 300)
+	buffer.record(th.BasicUser.Id, 250)
+	buffer.record(th.BasicUser2.Id, 400)
+
+	assert.Equal(t, map[string]int64{th.BasicUser.Id: 300, th.BasicUser2.Id: 400}, buffer.pending)
+
+	// Nothing is written until the buffer is flushed.
+	status, err := th.App.Srv().Store.Status().Get(th.BasicUser.Id)
+	require.Nil(t, err)
+	assert.Equal(t, int64(100), status.LastActivityAt)
+
+	buffer.flush()
+	assert.Empty(t, buffer.pending)
+
+	status, err = th.App.Srv().Store.Status().Get(th.BasicUser.Id)
+	require.Nil(t, err)
+	assert.Equal(t, int64(300), status.LastActivityAt)
+
+	status, err = th.App.Srv().Store.Status().Get(th.BasicUser2.Id)
+	require.Nil(t, err)
+	assert.Equal(t, int64(400), status.LastActivityAt)
+}
+
+func TestLastActivityBufferFlushesOnStop(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	require.Nil(t, th.App.Srv().Store.Status().SaveOrUpdate(&model.Status{UserId: th.BasicUser.Id, Status: model.STATUS_ONLINE, LastActivityAt: 100}))
+
+	th.App.Srv().updateLastActivityAt(th.BasicUser.Id, 500)
+	th.App.Srv().stopLastActivityBuffer()
+	th.App.Srv().lastActivityBuffer = nil
+
+	status, err := th.App.Srv().Store.Status().Get(th.BasicUser.Id)
+	require.Nil(t, err)
+	assert.Equal(t, int64(500), status.LastActivityAt)
+}
@@ -67,7 +67,9 @@ func (s *Server) SaveLicense(licenseBytes []byte) (*model.License, *model.AppErr
 	}
 	license := model.LicenseFromJson(strings.NewReader(licenseStr))
 
-	uniqueUserCount, err := s.Store.User().Count(model.UserCountOptions{})
+	uniqueUserCount, err := s.Store.User().Count(model.UserCountOptions{
+		ExcludeServiceAccounts: *s.Config().ServiceSettings.ExcludeServiceAccountsFromSeatCount,
+	})
 	if err != nil {
 		return nil, model.NewAppError("addLicense", "api.license.add_license.invalid_count.app_error", nil, err.Error(), http.StatusBadRequest)
 	}
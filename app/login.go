@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/avct/uasurfer"
+	"github.com/mattermost/mattermost-server/v5/mlog"
 	"github.com/mattermost/mattermost-server/v5/model"
 	"github.com/mattermost/mattermost-server/v5/plugin"
 	"github.com/mattermost/mattermost-server/v5/store"
@@ -55,9 +56,18 @@ func (a *App) AuthenticateUserForLogin(id, loginId, password, mfaToken string, l
 		return nil, err
 	}
 
+	// LDAP users who have never logged in aren't saved yet, so there is no one to record the attempt for.
+	if loginUser := user; loginUser.Id != "" {
+		defer func() {
+			if err != nil {
+				a.recordLoginAttempt(loginUser, false, err.Id)
+			}
+		}()
+	}
+
 	// If client side cert is enable and it's checking as a primary source
 	// then trust the proxy and cert that the correct user is supplied and allow
-	// them access
+	// them access. This is also how service accounts log in without a token.
 	if *a.Config().ExperimentalSettings.ClientSideCertEnable && *a.Config().ExperimentalSettings.ClientSideCertCheck == model.CLIENT_SIDE_CERT_CHECK_PRIMARY_AUTH {
 		// Unless the user is a bot.
 		if err = checkUserNotBot(user); err != nil {
@@ -129,6 +139,7 @@ func (a *App) DoLogin(w http.ResponseWriter, r *http.Request, user *model.User,
 		model.USER_AUTH_SERVICE_IS_MOBILE: strconv.FormatBool(isMobile),
 		model.USER_AUTH_SERVICE_IS_SAML:   strconv.FormatBool(isSaml),
 	}}
+	session.SetRolesExpiresAt(user.RolesExpiresAt)
 	session.GenerateCSRF()
 
 	if len(deviceId) > 0 {
@@ -173,12 +184,32 @@ func (a *App) DoLogin(w http.ResponseWriter, r *http.Request, user *model.User,
 
 	a.SetSession(session)
 
+	a.recordLoginAttempt(user, true, "")
+
+	if err := a.Srv().Store.User().UpdateLastLogin(user.Id, session.CreateAt); err != nil {
+		mlog.Warn("Failed to record the last login of the user", mlog.String("user_id", user.Id), mlog.Err(err))
+	} else {
+		a.InvalidateCacheForUser(user.Id)
+	}
+
 	if user.AuthService == model.USER_AUTH_SERVICE_LDAP && a.Ldap() != nil {
 		a.Srv().Go(func() {
 			a.Ldap().UpdateProfilePictureIfNecessary(user, session)
 		})
 	}
 
+	if user.AuthData != nil && a.Ldap() != nil && (user.AuthService == model.USER_AUTH_SERVICE_LDAP ||
+		(user.AuthService == model.USER_AUTH_SERVICE_SAML && *a.Config().SamlSettings.EnableSyncWithLdap)) {
+		a.Srv().Go(func() {
+			if err := a.SyncUserPropertiesFromLdap(user); err != nil {
+				mlog.Warn("Failed to sync the custom profile fields of the user", mlog.String("user_id", user.Id), mlog.Err(err))
+			}
+			if err := a.SyncUserManagerFromLdap(user); err != nil {
+				mlog.Warn("Failed to sync the manager of the user", mlog.String("user_id", user.Id), mlog.Err(err))
+			}
+		})
+	}
+
 	if pluginsEnvironment := a.GetPluginsEnvironment(); pluginsEnvironment != nil {
 		a.Srv().Go(func() {
 			pluginContext := a.PluginContext()
@@ -193,50 +224,58 @@ func (a *App) DoLogin(w http.ResponseWriter, r *http.Request, user *model.User,
 }
 
 func (a *App) AttachSessionCookies(w http.ResponseWriter, r *http.Request) {
-	secure := false
-	if GetProtocol(r) == "https" {
-		secure = true
+	maxAge := *a.Config().ServiceSettings.SessionLengthWebInDays * 60 * 60 * 24
+
+	sessionCookie := a.NewSessionCookie(r, model.SESSION_COOKIE_TOKEN, a.Session().Token, maxAge)
+	sessionCookie.HttpOnly = true
+
+	userCookie := a.NewSessionCookie(r, model.SESSION_COOKIE_USER, a.Session().UserId, maxAge)
+	csrfCookie := a.NewSessionCookie(r, model.SESSION_COOKIE_CSRF, a.Session().GetCSRF(), maxAge)
+
+	http.SetCookie(w, sessionCookie)
+	http.SetCookie(w, userCookie)
+	http.SetCookie(w, csrfCookie)
+}
+
+// AttachCSRFCookie sets the CSRF cookie to the current token of the session, so that clients pick
+// up the token after it was rotated.
+func (a *App) AttachCSRFCookie(w http.ResponseWriter, r *http.Request) {
+	if a.Session().GetCSRF() == "" {
+		return
 	}
 
 	maxAge := *a.Config().ServiceSettings.SessionLengthWebInDays * 60 * 60 * 24
-	domain := a.GetCookieDomain()
+
+	http.SetCookie(w, a.NewSessionCookie(r, model.SESSION_COOKIE_CSRF, a.Session().GetCSRF(), maxAge))
+}
+
+// NewSessionCookie returns a cookie expiring after maxAge seconds, with the domain, path, Secure
+// and SameSite attributes configured for the session cookies.
+func (a *App) NewSessionCookie(r *http.Request, name, value string, maxAge int) *http.Cookie {
 	subpath, _ := utils.GetSubpathFromConfig(a.Config())
 
-	expiresAt := time.Unix(model.GetMillis()/1000+int64(maxAge), 0)
-	sessionCookie := &http.Cookie{
-		Name:     model.SESSION_COOKIE_TOKEN,
-		Value:    a.Session().Token,
-		Path:     subpath,
-		MaxAge:   maxAge,
-		Expires:  expiresAt,
-		HttpOnly: true,
-		Domain:   domain,
-		Secure:   secure,
-	}
-
-	userCookie := &http.Cookie{
-		Name:    model.SESSION_COOKIE_USER,
-		Value:   a.Session().UserId,
+	cookie := &http.Cookie{
+		Name:    name,
+		Value:   value,
 		Path:    subpath,
 		MaxAge:  maxAge,
-		Expires: expiresAt,
-		Domain:  domain,
-		Secure:  secure,
+		Expires: time.Unix(model.GetMillis()/1000+int64(maxAge), 0),
+		Domain:  a.GetCookieDomain(),
+		Secure:  GetProtocol(r) == "https" || *a.Config().ServiceSettings.SessionCookieSecure,
 	}
 
-	csrfCookie := &http.Cookie{
-		Name:    model.SESSION_COOKIE_CSRF,
-		Value:   a.Session().GetCSRF(),
-		Path:    subpath,
-		MaxAge:  maxAge,
-		Expires: expiresAt,
-		Domain:  domain,
-		Secure:  secure,
+	switch *a.Config().ServiceSettings.SessionCookieSameSite {
+	case model.SESSION_COOKIE_SAME_SITE_LAX:
+		cookie.SameSite = http.SameSiteLaxMode
+	case model.SESSION_COOKIE_SAME_SITE_STRICT:
+		cookie.SameSite = http.SameSiteStrictMode
+	case model.SESSION_COOKIE_SAME_SITE_NONE:
+		// Browsers drop cookies with SameSite=None unless they are secure.
+		cookie.SameSite = http.SameSiteNoneMode
+		cookie.Secure = true
 	}
 
-	http.SetCookie(w, sessionCookie)
-	http.SetCookie(w, userCookie)
-	http.SetCookie(w, csrfCookie)
+	return cookie
 }
 
 func GetProtocol(r *http.Request) string {
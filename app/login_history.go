@@ -0,0 +1,60 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+const (
+	LOGIN_HISTORY_CLEANUP_BATCH_SIZE = 1000
+)
+
+// recordLoginAttempt adds the attempt of the user to log in to their login history. Attempts are
+// recorded in the background so that logging in isn't slowed down by it.
+func (a *App) recordLoginAttempt(user *model.User, success bool, failureReason string) {
+	entry := &model.LoginHistory{
+		UserId:        user.Id,
+		CreateAt:      model.GetMillis(),
+		IpAddress:     a.IpAddress(),
+		UserAgent:     a.UserAgent(),
+		AuthService:   user.AuthService,
+		Success:       success,
+		FailureReason: failureReason,
+	}
+
+	a.Srv().Go(func() {
+		if _, err := a.Srv().Store.LoginHistory().Save(entry); err != nil {
+			mlog.Warn("Failed to record the login attempt of the user", mlog.String("user_id", entry.UserId), mlog.Err(err))
+		}
+	})
+}
+
+func (a *App) GetLoginHistory(options *model.LoginHistoryGetOptions) ([]*model.LoginHistory, *model.AppError) {
+	entries, err := a.Srv().Store.LoginHistory().Get(options)
+	if err != nil {
+		return nil, model.NewAppError("GetLoginHistory", "app.login_history.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return entries, nil
+}
+
+func (s *Server) doLoginHistoryCleanup() {
+	endTime := model.GetMillis() - int64(*s.Config().ServiceSettings.LoginHistoryRetentionDays)*int64(24*time.Hour/time.Millisecond)
+
+	for {
+		deleted, err := s.Store.LoginHistory().PermanentDeleteBatch(endTime, LOGIN_HISTORY_CLEANUP_BATCH_SIZE)
+		if err != nil {
+			mlog.Error("Unable to clean up the login history.", mlog.Err(err))
+			return
+		}
+		if deleted < LOGIN_HISTORY_CLEANUP_BATCH_SIZE {
+			return
+		}
+	}
+}
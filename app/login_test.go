@@ -7,7 +7,10 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
 )
 
 func TestCheckForClientSideCert(t *testing.T) {
@@ -35,3 +38,52 @@ func TestCheckForClientSideCert(t *testing.T) {
 		require.Equal(t, actualEmail, tt.expectedEmail, "CheckForClientSideCert(%v): expected %v, actual %v", tt.subject, tt.expectedEmail, actualEmail)
 	}
 }
+
+func TestNewSessionCookie(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	r := &http.Request{Header: http.Header{}}
+
+	t.Run("default", func(t *testing.T) {
+		cookie := th.App.NewSessionCookie(r, model.SESSION_COOKIE_CSRF, "token", 60)
+		assert.Equal(t, model.SESSION_COOKIE_CSRF, cookie.Name)
+		assert.Equal(t, "token", cookie.Value)
+		assert.Equal(t, 60, cookie.MaxAge)
+		assert.False(t, cookie.Secure)
+		assert.Equal(t, http.SameSite(0), cookie.SameSite)
+	})
+
+	t.Run("secure over https", func(t *testing.T) {
+		r := &http.Request{Header: http.Header{}}
+		r.Header.Set(model.HEADER_FORWARDED_PROTO, "https")
+
+		cookie := th.App.NewSessionCookie(r, model.SESSION_COOKIE_CSRF, "token", 60)
+		assert.True(t, cookie.Secure)
+	})
+
+	t.Run("forced secure", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.SessionCookieSecure = true })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.SessionCookieSecure = false })
+
+		cookie := th.App.NewSessionCookie(r, model.SESSION_COOKIE_CSRF, "token", 60)
+		assert.True(t, cookie.Secure)
+	})
+
+	for sameSite, expected := range map[string]http.SameSite{
+		model.SESSION_COOKIE_SAME_SITE_LAX:    http.SameSiteLaxMode,
+		model.SESSION_COOKIE_SAME_SITE_STRICT: http.SameSiteStrictMode,
+		model.SESSION_COOKIE_SAME_SITE_NONE:   http.SameSiteNoneMode,
+	} {
+		t.Run("same site "+sameSite, func(t *testing.T) {
+			th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.SessionCookieSameSite = sameSite })
+			defer th.App.UpdateConfig(func(cfg *model.Config) {
+				*cfg.ServiceSettings.SessionCookieSameSite = model.SESSION_COOKIE_SAME_SITE_DEFAULT
+			})
+
+			cookie := th.App.NewSessionCookie(r, model.SESSION_COOKIE_CSRF, "token", 60)
+			assert.Equal(t, expected, cookie.SameSite)
+			assert.Equal(t, sameSite == model.SESSION_COOKIE_SAME_SITE_NONE, cookie.Secure, "SameSite=None requires secure cookies")
+		})
+	}
+}
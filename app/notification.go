@@ -4,6 +4,7 @@
 package app
 
 import (
+	"net/http"
 	"sort"
 	"strings"
 	"unicode"
@@ -56,11 +57,22 @@ func (a *App) SendNotifications(post *model.Post, team *model.Team, channel *mod
 		}()
 	}
 
+	rchan := make(chan store.StoreResult, 1)
+	go func() {
+		relatedUserIds, err := a.Srv().Store.UserRelationship().GetUserIdsRelatedTo(post.UserId)
+		if err != nil {
+			rchan <- store.StoreResult{Err: model.NewAppError("SendNotifications", "app.user_relationship.get_related_to.app_error", nil, err.Error(), http.StatusInternalServerError)}
+		} else {
+			rchan <- store.StoreResult{Data: relatedUserIds}
+		}
+		close(rchan)
+	}()
+
 	result := <-pchan
 	if result.Err != nil {
 		return nil, result.Err
 	}
-	profileMap := result.Data.(map[string]*model.User)
+	profileMap := resolveProfilesNotifyProps(result.Data.(map[string]*model.User), channel, team)
 
 	result = <-cmnchan
 	if result.Err != nil {
@@ -95,7 +107,7 @@ func (a *App) SendNotifications(post *model.Post, team *model.Team, channel *mod
 		allowChannelMentions := a.allowChannelMentions(post, len(profileMap))
 		keywords := a.getMentionKeywordsInChannel(profileMap, allowChannelMentions, channelMemberNotifyPropsMap)
 
-		mentions = getExplicitMentions(post, keywords, groups)
+		mentions = getExplicitMentions(post, keywords, groups, *a.Config().LocalizationSettings.EnableCJKTokenization)
 
 		// Add an implicit mention when a user is added to a channel
 		// even if the user has set 'username mentions' to false in account settings.
@@ -156,6 +168,13 @@ func (a *App) SendNotifications(post *model.Post, team *model.Team, channel *mod
 		}
 	}
 
+	// Users who muted or blocked the author of the post aren't notified of it
+	result = <-rchan
+	if result.Err != nil {
+		return nil, result.Err
+	}
+	allActivityPushUserIds = removeRelatedRecipients(result.Data.([]string), mentions, allActivityPushUserIds)
+
 	mentionedUsersList := make([]string, 0, len(mentions.Mentions))
 	updateMentionChans := []chan *model.AppError{}
 
@@ -164,7 +183,7 @@ func (a *App) SendNotifications(post *model.Post, team *model.Team, channel *mod
 
 		umc := make(chan *model.AppError, 1)
 		go func(userId string) {
-			umc <- a.Srv().Store.Channel().IncrementMentionCount(post.ChannelId, userId)
+			umc <- a.Srv().Store.Channel().IncrementMentionCount(post.ChannelId, userId, post.IsUrgent())
 			close(umc)
 		}(id)
 		updateMentionChans = append(updateMentionChans, umc)
@@ -270,7 +289,7 @@ func (a *App) SendNotifications(post *model.Post, team *model.Team, channel *mod
 				status = &model.Status{UserId: id, Status: model.STATUS_OFFLINE, Manual: false, LastActivityAt: 0, ActiveChannel: ""}
 			}
 
-			if ShouldSendPushNotification(profileMap[id], channelMemberNotifyPropsMap[id], true, status, post) {
+			if a.shouldSendPushNotification(profileMap[id], channelMemberNotifyPropsMap[id], true, status, post) {
 				mentionType := mentions.Mentions[id]
 
 				replyToThreadType := ""
@@ -311,7 +330,7 @@ func (a *App) SendNotifications(post *model.Post, team *model.Team, channel *mod
 					status = &model.Status{UserId: id, Status: model.STATUS_OFFLINE, Manual: false, LastActivityAt: 0, ActiveChannel: ""}
 				}
 
-				if ShouldSendPushNotification(profileMap[id], channelMemberNotifyPropsMap[id], false, status, post) {
+				if a.shouldSendPushNotification(profileMap[id], channelMemberNotifyPropsMap[id], false, status, post) {
 					a.sendPushNotification(
 						notification,
 						profileMap[id],
@@ -336,7 +355,7 @@ func (a *App) SendNotifications(post *model.Post, team *model.Team, channel *mod
 	message := model.NewWebSocketEvent(model.WEBSOCKET_EVENT_POSTED, "", post.ChannelId, "", nil)
 
 	// Note that PreparePostForClient should've already been called by this point
-	message.Add("post", post.ToJson())
+	message.Add("post", removePermalinkPreviewContent(post).ToJson())
 
 	message.Add("channel_type", channel.Type)
 	message.Add("channel_display_name", notification.GetChannelName(model.SHOW_USERNAME, ""))
@@ -678,7 +697,8 @@ func (m *ExplicitMentions) removeMention(userId string) {
 
 // Given a message and a map mapping mention keywords to the users who use them, returns a map of mentioned
 // users and a slice of potential mention users not in the channel and whether or not @here was mentioned.
-func getExplicitMentions(post *model.Post, keywords map[string][]string, groups map[string]*model.Group) *ExplicitMentions {
+// When tokenizeCJK is set, mentions written next to CJK text without a space between them are found as well.
+func getExplicitMentions(post *model.Post, keywords map[string][]string, groups map[string]*model.Group, tokenizeCJK bool) *ExplicitMentions {
 	ret := &ExplicitMentions{}
 
 	buf := ""
@@ -687,7 +707,7 @@ func getExplicitMentions(post *model.Post, keywords map[string][]string, groups
 		markdown.Inspect(message, func(node interface{}) bool {
 			text, ok := node.(*markdown.Text)
 			if !ok {
-				ret.processText(buf, keywords, groups)
+				ret.processText(buf, keywords, groups, tokenizeCJK)
 				buf = ""
 				return true
 			}
@@ -695,7 +715,7 @@ func getExplicitMentions(post *model.Post, keywords map[string][]string, groups
 			return false
 		})
 	}
-	ret.processText(buf, keywords, groups)
+	ret.processText(buf, keywords, groups, tokenizeCJK)
 
 	return ret
 }
@@ -990,9 +1010,13 @@ func isKeywordMultibyte(keywords map[string][]string, word string) ([]string, bo
 }
 
 // Processes text to filter mentioned users and other potential mentions
-func (m *ExplicitMentions) processText(text string, keywords map[string][]string, groups map[string]*model.Group) {
+func (m *ExplicitMentions) processText(text string, keywords map[string][]string, groups map[string]*model.Group, tokenizeCJK bool) {
 	systemMentions := map[string]bool{"@here": true, "@channel": true, "@all": true}
 
+	if tokenizeCJK {
+		text = model.SpaceCJKBoundaries(text)
+	}
+
 	for _, word := range strings.FieldsFunc(text, func(c rune) bool {
 		// Split on any whitespace or punctuation that can't be part of an at mention or emoji pattern
 		return !(c == ':' || c == '.' || c == '-' || c == '_' || c == '@' || unicode.IsLetter(c) || unicode.IsNumber(c))
@@ -4,6 +4,7 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"html"
 	"html/template"
@@ -23,7 +24,7 @@ func (a *App) sendNotificationEmail(notification *PostNotification, user *model.
 	post := notification.Post
 
 	if channel.IsGroupOrDirect() {
-		teams, err := a.Srv().Store.Team().GetTeamsByUserId(user.Id)
+		teams, err := a.Srv().Store.Team().GetTeamsByUserId(context.Background(), user.Id, nil)
 		if err != nil {
 			return err
 		}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/mattermost/mattermost-server/v5/model"
@@ -86,7 +87,7 @@ func TestGetNotificationEmailBodyFullNotificationPublicChannel(t *testing.T) {
 
 	storeMock := th.App.Srv().Store.(*mocks.Store)
 	teamStoreMock := mocks.TeamStore{}
-	teamStoreMock.On("GetByName", "testteam").Return(&model.Team{Name: "testteam"}, nil)
+	teamStoreMock.On("GetByName", mock.Anything, "testteam").Return(&model.Team{Name: "testteam"}, nil)
 	storeMock.On("Team").Return(&teamStoreMock)
 
 	body := th.App.getNotificationEmailBody(recipient, post, channel, channelName, senderName, teamName, teamURL, emailNotificationContentsType, true, translateFunc)
@@ -118,7 +119,7 @@ func TestGetNotificationEmailBodyFullNotificationGroupChannel(t *testing.T) {
 
 	storeMock := th.App.Srv().Store.(*mocks.Store)
 	teamStoreMock := mocks.TeamStore{}
-	teamStoreMock.On("GetByName", "testteam").Return(&model.Team{Name: "testteam"}, nil)
+	teamStoreMock.On("GetByName", mock.Anything, "testteam").Return(&model.Team{Name: "testteam"}, nil)
 	storeMock.On("Team").Return(&teamStoreMock)
 
 	body := th.App.getNotificationEmailBody(recipient, post, channel, channelName, senderName, teamName, teamURL, emailNotificationContentsType, true, translateFunc)
@@ -150,7 +151,7 @@ func TestGetNotificationEmailBodyFullNotificationPrivateChannel(t *testing.T) {
 
 	storeMock := th.App.Srv().Store.(*mocks.Store)
 	teamStoreMock := mocks.TeamStore{}
-	teamStoreMock.On("GetByName", "testteam").Return(&model.Team{Name: "testteam"}, nil)
+	teamStoreMock.On("GetByName", mock.Anything, "testteam").Return(&model.Team{Name: "testteam"}, nil)
 	storeMock.On("Team").Return(&teamStoreMock)
 
 	body := th.App.getNotificationEmailBody(recipient, post, channel, channelName, senderName, teamName, teamURL, emailNotificationContentsType, true, translateFunc)
@@ -182,7 +183,7 @@ func TestGetNotificationEmailBodyFullNotificationDirectChannel(t *testing.T) {
 
 	storeMock := th.App.Srv().Store.(*mocks.Store)
 	teamStoreMock := mocks.TeamStore{}
-	teamStoreMock.On("GetByName", "testteam").Return(&model.Team{Name: "testteam"}, nil)
+	teamStoreMock.On("GetByName", mock.Anything, "testteam").Return(&model.Team{Name: "testteam"}, nil)
 	storeMock.On("Team").Return(&teamStoreMock)
 
 	body := th.App.getNotificationEmailBody(recipient, post, channel, channelName, senderName, teamName, teamURL, emailNotificationContentsType, true, translateFunc)
@@ -217,7 +218,7 @@ func TestGetNotificationEmailBodyFullNotificationLocaleTimeWithTimezone(t *testi
 
 	storeMock := th.App.Srv().Store.(*mocks.Store)
 	teamStoreMock := mocks.TeamStore{}
-	teamStoreMock.On("GetByName", "testteam").Return(&model.Team{Name: "testteam"}, nil)
+	teamStoreMock.On("GetByName", mock.Anything, "testteam").Return(&model.Team{Name: "testteam"}, nil)
 	storeMock.On("Team").Return(&teamStoreMock)
 
 	body := th.App.getNotificationEmailBody(recipient, post, channel, channelName, senderName, teamName, teamURL, emailNotificationContentsType, false, translateFunc)
@@ -250,7 +251,7 @@ func TestGetNotificationEmailBodyFullNotificationLocaleTimeNoTimezone(t *testing
 
 	storeMock := th.App.Srv().Store.(*mocks.Store)
 	teamStoreMock := mocks.TeamStore{}
-	teamStoreMock.On("GetByName", "testteam").Return(&model.Team{Name: "testteam"}, nil)
+	teamStoreMock.On("GetByName", mock.Anything, "testteam").Return(&model.Team{Name: "testteam"}, nil)
 	storeMock.On("Team").Return(&teamStoreMock)
 
 	tm := time.Unix(post.CreateAt/1000, 0)
@@ -296,7 +297,7 @@ func TestGetNotificationEmailBodyFullNotificationLocaleTime12Hour(t *testing.T)
 
 	storeMock := th.App.Srv().Store.(*mocks.Store)
 	teamStoreMock := mocks.TeamStore{}
-	teamStoreMock.On("GetByName", "testteam").Return(&model.Team{Name: "testteam"}, nil)
+	teamStoreMock.On("GetByName", mock.Anything, "testteam").Return(&model.Team{Name: "testteam"}, nil)
 	storeMock.On("Team").Return(&teamStoreMock)
 
 	body := th.App.getNotificationEmailBody(recipient, post, channel, channelName, senderName, teamName, teamURL, emailNotificationContentsType, false, translateFunc)
@@ -329,7 +330,7 @@ func TestGetNotificationEmailBodyFullNotificationLocaleTime24Hour(t *testing.T)
 
 	storeMock := th.App.Srv().Store.(*mocks.Store)
 	teamStoreMock := mocks.TeamStore{}
-	teamStoreMock.On("GetByName", "testteam").Return(&model.Team{Name: "testteam"}, nil)
+	teamStoreMock.On("GetByName", mock.Anything, "testteam").Return(&model.Team{Name: "testteam"}, nil)
 	storeMock.On("Team").Return(&teamStoreMock)
 
 	body := th.App.getNotificationEmailBody(recipient, post, channel, channelName, senderName, teamName, teamURL, emailNotificationContentsType, true, translateFunc)
@@ -359,7 +360,7 @@ func TestGetNotificationEmailBodyGenericNotificationPublicChannel(t *testing.T)
 
 	storeMock := th.App.Srv().Store.(*mocks.Store)
 	teamStoreMock := mocks.TeamStore{}
-	teamStoreMock.On("GetByName", "testteam").Return(&model.Team{Name: "testteam"}, nil)
+	teamStoreMock.On("GetByName", mock.Anything, "testteam").Return(&model.Team{Name: "testteam"}, nil)
 	storeMock.On("Team").Return(&teamStoreMock)
 
 	body := th.App.getNotificationEmailBody(recipient, post, channel, channelName, senderName, teamName, teamURL, emailNotificationContentsType, true, translateFunc)
@@ -390,7 +391,7 @@ func TestGetNotificationEmailBodyGenericNotificationGroupChannel(t *testing.T) {
 
 	storeMock := th.App.Srv().Store.(*mocks.Store)
 	teamStoreMock := mocks.TeamStore{}
-	teamStoreMock.On("GetByName", "testteam").Return(&model.Team{Name: "testteam"}, nil)
+	teamStoreMock.On("GetByName", mock.Anything, "testteam").Return(&model.Team{Name: "testteam"}, nil)
 	storeMock.On("Team").Return(&teamStoreMock)
 
 	body := th.App.getNotificationEmailBody(recipient, post, channel, channelName, senderName, teamName, teamURL, emailNotificationContentsType, true, translateFunc)
@@ -421,7 +422,7 @@ func TestGetNotificationEmailBodyGenericNotificationPrivateChannel(t *testing.T)
 
 	storeMock := th.App.Srv().Store.(*mocks.Store)
 	teamStoreMock := mocks.TeamStore{}
-	teamStoreMock.On("GetByName", "testteam").Return(&model.Team{Name: "testteam"}, nil)
+	teamStoreMock.On("GetByName", mock.Anything, "testteam").Return(&model.Team{Name: "testteam"}, nil)
 	storeMock.On("Team").Return(&teamStoreMock)
 
 	body := th.App.getNotificationEmailBody(recipient, post, channel, channelName, senderName, teamName, teamURL, emailNotificationContentsType, true, translateFunc)
@@ -452,7 +453,7 @@ func TestGetNotificationEmailBodyGenericNotificationDirectChannel(t *testing.T)
 
 	storeMock := th.App.Srv().Store.(*mocks.Store)
 	teamStoreMock := mocks.TeamStore{}
-	teamStoreMock.On("GetByName", "testteam").Return(&model.Team{Name: "testteam"}, nil)
+	teamStoreMock.On("GetByName", mock.Anything, "testteam").Return(&model.Team{Name: "testteam"}, nil)
 	storeMock.On("Team").Return(&teamStoreMock)
 
 	body := th.App.getNotificationEmailBody(recipient, post, channel, channelName, senderName, teamName, teamURL, emailNotificationContentsType, true, translateFunc)
@@ -485,7 +486,7 @@ func TestGetNotificationEmailEscapingChars(t *testing.T) {
 
 	storeMock := th.App.Srv().Store.(*mocks.Store)
 	teamStoreMock := mocks.TeamStore{}
-	teamStoreMock.On("GetByName", "testteam").Return(&model.Team{Name: "testteam"}, nil)
+	teamStoreMock.On("GetByName", mock.Anything, "testteam").Return(&model.Team{Name: "testteam"}, nil)
 	storeMock.On("Team").Return(&teamStoreMock)
 
 	body := th.App.getNotificationEmailBody(recipient, post, ch,
@@ -524,7 +525,7 @@ func TestGetNotificationEmailBodyPublicChannelMention(t *testing.T) {
 
 	storeMock := th.App.Srv().Store.(*mocks.Store)
 	teamStoreMock := mocks.TeamStore{}
-	teamStoreMock.On("GetByName", "testteam").Return(&model.Team{Id: "test", Name: "testteam"}, nil)
+	teamStoreMock.On("GetByName", mock.Anything, "testteam").Return(&model.Team{Id: "test", Name: "testteam"}, nil)
 	storeMock.On("Team").Return(&teamStoreMock)
 
 	channelStoreMock := mocks.ChannelStore{}
@@ -589,7 +590,7 @@ func TestGetNotificationEmailBodyMultiPublicChannelMention(t *testing.T) {
 
 	storeMock := th.App.Srv().Store.(*mocks.Store)
 	teamStoreMock := mocks.TeamStore{}
-	teamStoreMock.On("GetByName", "testteam").Return(&model.Team{Id: "test", Name: "testteam"}, nil)
+	teamStoreMock.On("GetByName", mock.Anything, "testteam").Return(&model.Team{Id: "test", Name: "testteam"}, nil)
 	storeMock.On("Team").Return(&teamStoreMock)
 
 	channelStoreMock := mocks.ChannelStore{}
@@ -637,7 +638,7 @@ func TestGetNotificationEmailBodyPrivateChannelMention(t *testing.T) {
 
 	storeMock := th.App.Srv().Store.(*mocks.Store)
 	teamStoreMock := mocks.TeamStore{}
-	teamStoreMock.On("GetByName", "testteam").Return(&model.Team{Id: "test", Name: "testteam"}, nil)
+	teamStoreMock.On("GetByName", mock.Anything, "testteam").Return(&model.Team{Id: "test", Name: "testteam"}, nil)
 	storeMock.On("Team").Return(&teamStoreMock)
 
 	channelStoreMock := mocks.ChannelStore{}
@@ -669,7 +670,7 @@ func TestGenerateHyperlinkForChannelsPublic(t *testing.T) {
 
 	storeMock := th.App.Srv().Store.(*mocks.Store)
 	teamStoreMock := mocks.TeamStore{}
-	teamStoreMock.On("GetByName", "testteam").Return(&model.Team{Id: "test", Name: "testteam"}, nil)
+	teamStoreMock.On("GetByName", mock.Anything, "testteam").Return(&model.Team{Id: "test", Name: "testteam"}, nil)
 	storeMock.On("Team").Return(&teamStoreMock)
 
 	channelStoreMock := mocks.ChannelStore{}
@@ -718,7 +719,7 @@ func TestGenerateHyperlinkForChannelsMultiPublic(t *testing.T) {
 
 	storeMock := th.App.Srv().Store.(*mocks.Store)
 	teamStoreMock := mocks.TeamStore{}
-	teamStoreMock.On("GetByName", "testteam").Return(&model.Team{Id: "test", Name: "testteam"}, nil)
+	teamStoreMock.On("GetByName", mock.Anything, "testteam").Return(&model.Team{Id: "test", Name: "testteam"}, nil)
 	storeMock.On("Team").Return(&teamStoreMock)
 
 	channelStoreMock := mocks.ChannelStore{}
@@ -751,7 +752,7 @@ func TestGenerateHyperlinkForChannelsPrivate(t *testing.T) {
 
 	storeMock := th.App.Srv().Store.(*mocks.Store)
 	teamStoreMock := mocks.TeamStore{}
-	teamStoreMock.On("GetByName", "testteam").Return(&model.Team{Id: "test", Name: "testteam"}, nil)
+	teamStoreMock.On("GetByName", mock.Anything, "testteam").Return(&model.Team{Id: "test", Name: "testteam"}, nil)
 	storeMock.On("Team").Return(&teamStoreMock)
 
 	channelStoreMock := mocks.ChannelStore{}
@@ -783,7 +784,7 @@ func TestLandingLink(t *testing.T) {
 
 	storeMock := th.App.Srv().Store.(*mocks.Store)
 	teamStoreMock := mocks.TeamStore{}
-	teamStoreMock.On("GetByName", "testteam").Return(&model.Team{Name: "testteam"}, nil)
+	teamStoreMock.On("GetByName", mock.Anything, "testteam").Return(&model.Team{Name: "testteam"}, nil)
 	storeMock.On("Team").Return(&teamStoreMock)
 
 	body := th.App.getNotificationEmailBody(recipient, post, channel, channelName, senderName, teamName, teamURL, emailNotificationContentsType, true, translateFunc)
@@ -812,7 +813,7 @@ func TestLandingLinkPermalink(t *testing.T) {
 
 	storeMock := th.App.Srv().Store.(*mocks.Store)
 	teamStoreMock := mocks.TeamStore{}
-	teamStoreMock.On("GetByName", "testteam").Return(&model.Team{Name: "testteam"}, nil)
+	teamStoreMock.On("GetByName", mock.Anything, "testteam").Return(&model.Team{Name: "testteam"}, nil)
 	storeMock.On("Team").Return(&teamStoreMock)
 
 	body := th.App.getNotificationEmailBody(recipient, post, channel, channelName, senderName, teamName, teamURL, emailNotificationContentsType, true, translateFunc)
@@ -0,0 +1,107 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+// resolveNotifyProps returns the notification preferences applying to a user: the preferences the
+// user set, then the defaults of the team, then the system defaults. teamDefaults is nil outside of
+// a team.
+func resolveNotifyProps(userProps, teamDefaults model.StringMap) model.StringMap {
+	resolved := model.StringMap{}
+	for key, value := range userProps {
+		resolved[key] = value
+	}
+
+	for key, value := range model.DefaultUserNotifyProps() {
+		if key == model.INHERITED_NOTIFY_PROP || !model.IsInheritedNotifyProp(userProps, key) {
+			continue
+		}
+
+		if teamValue, ok := teamDefaults[key]; ok {
+			resolved[key] = teamValue
+		} else if userValue, ok := userProps[key]; !ok || userValue == model.USER_NOTIFY_DEFAULT {
+			resolved[key] = value
+		}
+	}
+
+	return resolved
+}
+
+// resolveProfilesNotifyProps returns the profiles of the members of the channel with their
+// notification preferences resolved against the defaults of the team of the channel. The profiles
+// are copied, so that the users shared with the cache aren't modified.
+func resolveProfilesNotifyProps(profileMap map[string]*model.User, channel *model.Channel, team *model.Team) map[string]*model.User {
+	var teamDefaults model.StringMap
+	if channel.TeamId != "" && team != nil && team.Id == channel.TeamId {
+		teamDefaults = team.GetNotifyDefaults()
+	}
+
+	resolvedMap := make(map[string]*model.User, len(profileMap))
+	for id, profile := range profileMap {
+		if profile == nil {
+			resolvedMap[id] = nil
+			continue
+		}
+
+		resolved := *profile
+		resolved.NotifyProps = resolveNotifyProps(profile.NotifyProps, teamDefaults)
+		resolvedMap[id] = &resolved
+	}
+
+	return resolvedMap
+}
+
+// ResolveUserNotifyProps returns the notification preferences applying to the user in the team,
+// the preferences the user kept to the default following the defaults of the team, then the system
+// defaults. An empty teamId resolves against the system defaults only.
+func (a *App) ResolveUserNotifyProps(user *model.User, teamId string) (model.StringMap, *model.AppError) {
+	if teamId == "" {
+		return resolveNotifyProps(user.NotifyProps, nil), nil
+	}
+
+	teamDefaults, err := a.GetTeamNotifyDefaults(teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolveNotifyProps(user.NotifyProps, teamDefaults), nil
+}
+
+// GetTeamNotifyDefaults returns the notification preferences given to the members of the team who
+// kept them to the default.
+func (a *App) GetTeamNotifyDefaults(teamId string) (model.StringMap, *model.AppError) {
+	team, err := a.GetTeam(teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	return team.GetNotifyDefaults(), nil
+}
+
+// SetTeamNotifyDefaults replaces the notification preferences given to the members of the team who
+// kept them to the default. An empty map makes them follow the system defaults again.
+func (a *App) SetTeamNotifyDefaults(teamId string, defaults model.StringMap) (*model.Team, *model.AppError) {
+	if err := model.IsValidTeamNotifyDefaults(defaults); err != nil {
+		return nil, err
+	}
+
+	team, err := a.GetTeam(teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	team.SetNotifyDefaults(defaults)
+
+	team, err = a.updateTeamUnsanitized(team)
+	if err != nil {
+		return nil, err
+	}
+
+	a.sendTeamEvent(team, model.WEBSOCKET_EVENT_UPDATE_TEAM)
+
+	return team, nil
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestResolveNotifyProps(t *testing.T) {
+	teamDefaults := model.StringMap{
+		model.EMAIL_NOTIFY_PROP: "false",
+		model.PUSH_NOTIFY_PROP:  model.USER_NOTIFY_ALL,
+	}
+
+	t.Run("user setting wins", func(t *testing.T) {
+		resolved := resolveNotifyProps(model.StringMap{model.EMAIL_NOTIFY_PROP: "true"}, teamDefaults)
+		assert.Equal(t, "true", resolved[model.EMAIL_NOTIFY_PROP])
+		assert.Equal(t, model.USER_NOTIFY_ALL, resolved[model.PUSH_NOTIFY_PROP])
+	})
+
+	t.Run("default follows the team", func(t *testing.T) {
+		resolved := resolveNotifyProps(model.StringMap{model.EMAIL_NOTIFY_PROP: model.USER_NOTIFY_DEFAULT}, teamDefaults)
+		assert.Equal(t, "false", resolved[model.EMAIL_NOTIFY_PROP])
+	})
+
+	t.Run("system default without a team default", func(t *testing.T) {
+		expected := model.DefaultUserNotifyProps()
+		delete(expected, model.INHERITED_NOTIFY_PROP)
+		resolved := resolveNotifyProps(model.StringMap{}, nil)
+		assert.Equal(t, expected, resolved)
+
+		resolved = resolveNotifyProps(model.StringMap{model.DESKTOP_NOTIFY_PROP: model.USER_NOTIFY_DEFAULT}, teamDefaults)
+		assert.Equal(t, model.USER_NOTIFY_MENTION, resolved[model.DESKTOP_NOTIFY_PROP])
+	})
+
+	t.Run("inherited props follow the team", func(t *testing.T) {
+		resolved := resolveNotifyProps(model.DefaultUserNotifyProps(), teamDefaults)
+		assert.Equal(t, "false", resolved[model.EMAIL_NOTIFY_PROP])
+		assert.Equal(t, model.USER_NOTIFY_ALL, resolved[model.PUSH_NOTIFY_PROP])
+		assert.Equal(t, model.USER_NOTIFY_MENTION, resolved[model.DESKTOP_NOTIFY_PROP])
+	})
+
+	t.Run("empty values are kept", func(t *testing.T) {
+		resolved := resolveNotifyProps(model.StringMap{model.MENTION_KEYS_NOTIFY_PROP: ""}, teamDefaults)
+		assert.Equal(t, "", resolved[model.MENTION_KEYS_NOTIFY_PROP])
+	})
+
+	t.Run("other props are kept", func(t *testing.T) {
+		resolved := resolveNotifyProps(model.StringMap{model.AUTO_RESPONDER_ACTIVE_NOTIFY_PROP: "true"}, teamDefaults)
+		assert.Equal(t, "true", resolved[model.AUTO_RESPONDER_ACTIVE_NOTIFY_PROP])
+	})
+}
+
+func TestResolveProfilesNotifyProps(t *testing.T) {
+	team := &model.Team{Id: model.NewId()}
+	team.SetNotifyDefaults(model.StringMap{model.PUSH_NOTIFY_PROP: model.USER_NOTIFY_NONE})
+
+	user := &model.User{Id: model.NewId(), NotifyProps: model.StringMap{model.PUSH_NOTIFY_PROP: model.USER_NOTIFY_DEFAULT}}
+	profileMap := map[string]*model.User{user.Id: user}
+
+	resolved := resolveProfilesNotifyProps(profileMap, &model.Channel{TeamId: team.Id}, team)
+	assert.Equal(t, model.USER_NOTIFY_NONE, resolved[user.Id].NotifyProps[model.PUSH_NOTIFY_PROP])
+	assert.Equal(t, model.USER_NOTIFY_DEFAULT, user.NotifyProps[model.PUSH_NOTIFY_PROP], "the profile should not be modified")
+
+	resolved = resolveProfilesNotifyProps(profileMap, &model.Channel{}, team)
+	assert.Equal(t, model.USER_NOTIFY_MENTION, resolved[user.Id].NotifyProps[model.PUSH_NOTIFY_PROP], "direct channels should not follow the team")
+}
+
+func TestTeamNotifyDefaults(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	_, err := th.App.SetTeamNotifyDefaults(th.BasicTeam.Id, model.StringMap{model.COMMENTS_NOTIFY_PROP: model.COMMENTS_NOTIFY_ANY})
+	require.Nil(t, err)
+
+	defaults, err := th.App.GetTeamNotifyDefaults(th.BasicTeam.Id)
+	require.Nil(t, err)
+	assert.Equal(t, model.StringMap{model.COMMENTS_NOTIFY_PROP: model.COMMENTS_NOTIFY_ANY}, defaults)
+
+	user := &model.User{NotifyProps: model.StringMap{model.COMMENTS_NOTIFY_PROP: model.USER_NOTIFY_DEFAULT}}
+	resolved, err := th.App.ResolveUserNotifyProps(user, th.BasicTeam.Id)
+	require.Nil(t, err)
+	assert.Equal(t, model.COMMENTS_NOTIFY_ANY, resolved[model.COMMENTS_NOTIFY_PROP])
+
+	resolved, err = th.App.ResolveUserNotifyProps(user, "")
+	require.Nil(t, err)
+	assert.Equal(t, model.COMMENTS_NOTIFY_NEVER, resolved[model.COMMENTS_NOTIFY_PROP])
+
+	_, err = th.App.SetTeamNotifyDefaults(th.BasicTeam.Id, model.StringMap{model.COMMENTS_NOTIFY_PROP: "sometimes"})
+	require.NotNil(t, err)
+}
+
+func TestNewUserFollowsTeamNotifyDefaults(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	_, err := th.App.SetTeamNotifyDefaults(th.BasicTeam.Id, model.StringMap{
+		model.EMAIL_NOTIFY_PROP: "false",
+		model.PUSH_NOTIFY_PROP:  model.USER_NOTIFY_NONE,
+	})
+	require.Nil(t, err)
+
+	user := th.CreateUser()
+	th.LinkUserToTeam(user, th.BasicTeam)
+
+	user, err = th.App.GetUser(user.Id)
+	require.Nil(t, err)
+
+	resolved, err := th.App.ResolveUserNotifyProps(user, th.BasicTeam.Id)
+	require.Nil(t, err)
+	assert.Equal(t, "false", resolved[model.EMAIL_NOTIFY_PROP])
+	assert.Equal(t, model.USER_NOTIFY_NONE, resolved[model.PUSH_NOTIFY_PROP])
+	assert.Equal(t, model.USER_NOTIFY_MENTION, resolved[model.DESKTOP_NOTIFY_PROP])
+
+	t.Run("changed preferences stop following the team", func(t *testing.T) {
+		props := model.CopyStringMap(user.NotifyProps)
+		props[model.PUSH_NOTIFY_PROP] = model.USER_NOTIFY_ALL
+
+		updated, err := th.App.UpdateUserNotifyProps(user.Id, props)
+		require.Nil(t, err)
+
+		resolved, err := th.App.ResolveUserNotifyProps(updated, th.BasicTeam.Id)
+		require.Nil(t, err)
+		assert.Equal(t, model.USER_NOTIFY_ALL, resolved[model.PUSH_NOTIFY_PROP])
+		assert.Equal(t, "false", resolved[model.EMAIL_NOTIFY_PROP])
+	})
+}
@@ -399,6 +399,16 @@ func ShouldSendPushNotification(user *model.User, channelNotifyProps model.Strin
 		DoesStatusAllowPushNotification(user.NotifyProps, status, post.ChannelId)
 }
 
+// shouldSendPushNotification lets urgent posts through to the users in Do Not Disturb when the
+// server is configured to, and otherwise follows ShouldSendPushNotification.
+func (a *App) shouldSendPushNotification(user *model.User, channelNotifyProps model.StringMap, wasMentioned bool, status *model.Status, post *model.Post) bool {
+	if post.IsUrgent() && status.Status == model.STATUS_DND && *a.Config().ServiceSettings.UrgentPostsBypassDoNotDisturb {
+		return DoesNotifyPropsAllowPushNotification(user, channelNotifyProps, post, wasMentioned)
+	}
+
+	return ShouldSendPushNotification(user, channelNotifyProps, wasMentioned, status, post)
+}
+
 func DoesNotifyPropsAllowPushNotification(user *model.User, channelNotifyProps model.StringMap, post *model.Post, wasMentioned bool) bool {
 	userNotifyProps := user.NotifyProps
 	userNotify := userNotifyProps[model.PUSH_NOTIFY_PROP]
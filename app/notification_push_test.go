@@ -544,6 +544,39 @@ func TestDoesStatusAllowPushNotification(t *testing.T) {
 	}
 }
 
+func TestShouldSendPushNotificationForUrgentPost(t *testing.T) {
+	th := SetupWithStoreMock(t)
+	defer th.TearDown()
+
+	user := &model.User{Id: model.NewId(), NotifyProps: model.StringMap{model.PUSH_NOTIFY_PROP: model.USER_NOTIFY_ALL}}
+	dnd := &model.Status{UserId: user.Id, Status: model.STATUS_DND, Manual: true, LastActivityAt: model.GetMillis(), ActiveChannel: ""}
+
+	post := &model.Post{UserId: model.NewId(), ChannelId: model.NewId()}
+	urgentPost := &model.Post{UserId: post.UserId, ChannelId: post.ChannelId}
+	urgentPost.AddProp(model.POST_PROPS_PRIORITY, model.POST_PRIORITY_URGENT)
+
+	t.Run("should not bypass Do Not Disturb by default", func(t *testing.T) {
+		assert.False(t, th.App.shouldSendPushNotification(user, model.StringMap{}, true, dnd, urgentPost))
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.UrgentPostsBypassDoNotDisturb = true
+	})
+
+	t.Run("should bypass Do Not Disturb for urgent posts", func(t *testing.T) {
+		assert.True(t, th.App.shouldSendPushNotification(user, model.StringMap{}, true, dnd, urgentPost))
+	})
+
+	t.Run("should not bypass Do Not Disturb for other posts", func(t *testing.T) {
+		assert.False(t, th.App.shouldSendPushNotification(user, model.StringMap{}, true, dnd, post))
+	})
+
+	t.Run("should still follow the notify props", func(t *testing.T) {
+		channelNotifyProps := model.StringMap{model.PUSH_NOTIFY_PROP: model.CHANNEL_NOTIFY_NONE}
+		assert.False(t, th.App.shouldSendPushNotification(user, channelNotifyProps, true, dnd, urgentPost))
+	})
+}
+
 func TestGetPushNotificationMessage(t *testing.T) {
 	th := SetupWithStoreMock(t)
 	defer th.TearDown()
@@ -878,7 +878,7 @@ func TestGetExplicitMentions(t *testing.T) {
 				},
 			}
 
-			m := getExplicitMentions(post, tc.Keywords, tc.Groups)
+			m := getExplicitMentions(post, tc.Keywords, tc.Groups, false)
 
 			assert.EqualValues(t, tc.Expected, m)
 		})
@@ -931,7 +931,7 @@ func TestGetExplicitMentionsAtHere(t *testing.T) {
 		}
 		for message, shouldMention := range cases {
 			post := &model.Post{Message: message}
-			m := getExplicitMentions(post, nil, nil)
+			m := getExplicitMentions(post, nil, nil, false)
 			require.False(t, m.HereMentioned && !shouldMention, "shouldn't have mentioned @here with \"%v\"")
 			require.False(t, !m.HereMentioned && shouldMention, "should've mentioned @here with \"%v\"")
 		}
@@ -939,7 +939,7 @@ func TestGetExplicitMentionsAtHere(t *testing.T) {
 
 	t.Run("Mention @here and someone", func(t *testing.T) {
 		id := model.NewId()
-		m := getExplicitMentions(&model.Post{Message: "@here @user @potential"}, map[string][]string{"@user": {id}}, nil)
+		m := getExplicitMentions(&model.Post{Message: "@here @user @potential"}, map[string][]string{"@user": {id}}, nil, false)
 		require.True(t, m.HereMentioned, "should've mentioned @here with \"@here @user\"")
 		require.Len(t, m.Mentions, 1)
 		require.Equal(t, KeywordMention, m.Mentions[id], "should've mentioned @user with \"@here @user\"")
@@ -949,10 +949,22 @@ func TestGetExplicitMentionsAtHere(t *testing.T) {
 
 	t.Run("Username ending with period", func(t *testing.T) {
 		id := model.NewId()
-		m := getExplicitMentions(&model.Post{Message: "@potential. test"}, map[string][]string{"@user": {id}}, nil)
+		m := getExplicitMentions(&model.Post{Message: "@potential. test"}, map[string][]string{"@user": {id}}, nil, false)
 		require.Equal(t, len(m.OtherPotentialMentions), 1, "should've potential mentions for @potential")
 		assert.Equal(t, "potential", m.OtherPotentialMentions[0])
 	})
+
+	t.Run("Mention followed by CJK text", func(t *testing.T) {
+		id := model.NewId()
+		post := &model.Post{Message: "@userさん、@hereの確認をお願いします"}
+
+		m := getExplicitMentions(post, map[string][]string{"@user": {id}}, nil, false)
+		require.Empty(t, m.Mentions, "shouldn't have mentioned @user without CJK tokenization")
+
+		m = getExplicitMentions(post, map[string][]string{"@user": {id}}, nil, true)
+		require.True(t, m.HereMentioned, "should've mentioned @here")
+		require.Equal(t, KeywordMention, m.Mentions[id], "should've mentioned @user")
+	})
 }
 
 func TestAllowChannelMentions(t *testing.T) {
@@ -1872,7 +1884,7 @@ func TestIsKeywordMultibyte(t *testing.T) {
 				},
 			}
 
-			m := getExplicitMentions(post, tc.Keywords, tc.Groups)
+			m := getExplicitMentions(post, tc.Keywords, tc.Groups, false)
 			assert.EqualValues(t, tc.Expected, m)
 		})
 	}
@@ -2189,7 +2201,7 @@ func TestProcessText(t *testing.T) {
 	} {
 		t.Run(name, func(t *testing.T) {
 			e := &ExplicitMentions{}
-			e.processText(tc.Text, tc.Keywords, tc.Groups)
+			e.processText(tc.Text, tc.Keywords, tc.Groups, false)
 
 			assert.EqualValues(t, tc.Expected, e)
 		})
@@ -0,0 +1,115 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+// SetUserManager changes the manager of the user, or removes it when managerId is empty. A user
+// can't end up reporting to themselves through the chain of managers.
+func (a *App) SetUserManager(userId, managerId string) (*model.User, *model.AppError) {
+	user, appErr := a.GetUser(userId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if managerId != "" {
+		manager, appErr := a.GetUser(managerId)
+		if appErr != nil {
+			appErr.StatusCode = http.StatusBadRequest
+			return nil, appErr
+		}
+
+		if manager.DeleteAt != 0 || manager.IsBot {
+			return nil, model.NewAppError("SetUserManager", "app.user.set_manager.invalid_manager.app_error", nil, "manager_id="+managerId, http.StatusBadRequest)
+		}
+
+		chain, appErr := a.Srv().Store.User().GetReportingChain(managerId)
+		if appErr != nil {
+			return nil, appErr
+		}
+		for _, chainManager := range chain {
+			if chainManager.Id == userId {
+				return nil, model.NewAppError("SetUserManager", "app.user.set_manager.cycle.app_error", nil, "manager_id="+managerId, http.StatusBadRequest)
+			}
+		}
+	}
+
+	if user.ManagerId == managerId {
+		return user, nil
+	}
+
+	user.ManagerId = managerId
+	userUpdate, appErr := a.Srv().Store.User().Update(user, true)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	a.InvalidateCacheForUser(userId)
+	a.sendUpdatedUserEvent(*userUpdate.New)
+
+	return userUpdate.New, nil
+}
+
+func (a *App) GetDirectReports(managerId string, page, perPage int) ([]*model.User, *model.AppError) {
+	return a.Srv().Store.User().GetDirectReports(managerId, page*perPage, perPage)
+}
+
+// GetOrgChart returns the reporting chain and the direct reports of the user.
+func (a *App) GetOrgChart(userId string) (*model.OrgChart, *model.AppError) {
+	user, appErr := a.GetUser(userId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	managers, appErr := a.Srv().Store.User().GetReportingChain(userId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	directReports, appErr := a.GetDirectReports(userId, 0, model.USER_SEARCH_MAX_LIMIT)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	return &model.OrgChart{
+		User:          user,
+		Managers:      managers,
+		DirectReports: directReports,
+	}, nil
+}
+
+// SyncUserManagerFromLdap sets the manager of the user to the one named by the configured LDAP
+// attribute. The attribute holds the value of the IdAttribute of the manager.
+func (a *App) SyncUserManagerFromLdap(user *model.User) *model.AppError {
+	attribute := *a.Config().LdapSettings.ManagerAttribute
+	if a.Ldap() == nil || user.AuthData == nil || attribute == "" {
+		return nil
+	}
+
+	attributes, appErr := a.Ldap().GetUserAttributes(*user.AuthData, []string{attribute})
+	if appErr != nil {
+		return appErr
+	}
+
+	managerId := ""
+	if managerAuthData := attributes[attribute]; managerAuthData != "" {
+		manager, appErr := a.Srv().Store.User().GetByAuth(&managerAuthData, user.AuthService)
+		if appErr != nil {
+			// The manager may not have logged in yet, in which case the user is left unchanged.
+			if appErr.Id == store.MISSING_AUTH_ACCOUNT_ERROR {
+				return nil
+			}
+			return appErr
+		}
+		managerId = manager.Id
+	}
+
+	_, appErr = a.SetUserManager(user.Id, managerId)
+	return appErr
+}
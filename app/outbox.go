@@ -0,0 +1,153 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+const (
+	OUTBOX_RELAY_INTERVAL = 10 * time.Second
+	// OUTBOX_RELAY_GRACE_PERIOD is how long the server recording an event has to publish it before
+	// the relay does.
+	OUTBOX_RELAY_GRACE_PERIOD          = 30 * time.Second
+	OUTBOX_RELAY_BATCH_SIZE            = 100
+	OUTBOX_RELAY_MAX_BATCHES_PER_ROUND = 20
+	// OUTBOX_RETENTION is how long the delivered events are kept before being removed.
+	OUTBOX_RETENTION         = time.Hour
+	OUTBOX_DELETE_BATCH_SIZE = 1000
+)
+
+// outboxRelay publishes the events of the outbox left undelivered, typically because the server
+// recording them stopped before publishing them, so that the clients eventually converge. Only the
+// cluster leader relays them, publishing them to the whole cluster.
+type outboxRelay struct {
+	server *Server
+	stop   chan struct{}
+	done   chan struct{}
+}
+
+func newOutboxRelay(s *Server) *outboxRelay {
+	return &outboxRelay{
+		server: s,
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+}
+
+func (s *Server) startOutboxRelay() {
+	s.outboxRelay = newOutboxRelay(s)
+	go s.outboxRelay.run()
+}
+
+func (s *Server) stopOutboxRelay() {
+	if s.outboxRelay != nil {
+		close(s.outboxRelay.stop)
+		<-s.outboxRelay.done
+	}
+}
+
+func (r *outboxRelay) run() {
+	defer close(r.done)
+
+	ticker := time.NewTicker(OUTBOX_RELAY_INTERVAL)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+			if r.server.IsLeader() {
+				r.relay(model.GetMillis())
+				r.deleteDelivered(model.GetMillis())
+			}
+		}
+	}
+}
+
+func (r *outboxRelay) stopped() bool {
+	select {
+	case <-r.stop:
+		return true
+	default:
+		return false
+	}
+}
+
+// relay publishes the events recorded before the grace period preceding now and left undelivered.
+func (r *outboxRelay) relay(now int64) {
+	createdBefore := now - int64(OUTBOX_RELAY_GRACE_PERIOD/time.Millisecond)
+
+	for i := 0; i < OUTBOX_RELAY_MAX_BATCHES_PER_ROUND && !r.stopped(); i++ {
+		events, err := r.server.Store.Outbox().GetUndelivered(createdBefore, OUTBOX_RELAY_BATCH_SIZE)
+		if err != nil {
+			mlog.Error("Unable to get the undelivered events of the outbox.", mlog.Err(err))
+			return
+		}
+		if len(events) == 0 {
+			return
+		}
+
+		ids := make([]string, len(events))
+		for j, event := range events {
+			ids[j] = event.Id
+
+			// Events that can't be decoded are marked delivered all the same, rather than retried forever.
+			message := event.WebSocketEvent()
+			if message == nil {
+				mlog.Warn("Unable to decode an event of the outbox.", mlog.String("outbox_event_id", event.Id))
+				continue
+			}
+			r.server.Publish(message)
+		}
+
+		mlog.Info("Relayed undelivered events of the outbox.", mlog.Int("events", len(events)))
+
+		if err := r.server.Store.Outbox().MarkDelivered(ids, model.GetMillis()); err != nil {
+			mlog.Error("Unable to mark the relayed events of the outbox as delivered.", mlog.Err(err))
+			return
+		}
+
+		if len(events) < OUTBOX_RELAY_BATCH_SIZE {
+			return
+		}
+	}
+}
+
+func (r *outboxRelay) deleteDelivered(now int64) {
+	endTime := now - int64(OUTBOX_RETENTION/time.Millisecond)
+	if _, err := r.server.Store.Outbox().PermanentDeleteBatch(endTime, OUTBOX_DELETE_BATCH_SIZE); err != nil {
+		mlog.Error("Unable to remove the delivered events of the outbox.", mlog.Err(err))
+	}
+}
+
+// markOutboxEventDelivered records that the event was published along with the change it
+// announces, so that the relay doesn't publish it again.
+func (a *App) markOutboxEventDelivered(event *model.OutboxEvent) {
+	if event == nil {
+		return
+	}
+
+	if err := a.Srv().Store.Outbox().MarkDelivered([]string{event.Id}, model.GetMillis()); err != nil {
+		mlog.Warn("Unable to mark an event of the outbox as delivered.", mlog.String("outbox_event_id", event.Id), mlog.Err(err))
+	}
+}
+
+// newPostedOutboxMessage returns the posted event recorded in the outbox along with a new post, to
+// which the store adds the post. Unlike the event published when sending the notifications, it
+// doesn't list the mentioned users.
+func newPostedOutboxMessage(channel *model.Channel, sender *model.User, setOnline bool) *model.WebSocketEvent {
+	message := model.NewWebSocketEvent(model.WEBSOCKET_EVENT_POSTED, "", channel.Id, "", nil)
+	message.Add("channel_type", channel.Type)
+	message.Add("channel_display_name", channel.DisplayName)
+	message.Add("channel_name", channel.Name)
+	message.Add("sender_name", sender.Username)
+	message.Add("team_id", channel.TeamId)
+	message.Add("set_online", setOnline)
+	return message
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store/storetest/mocks"
+)
+
+func TestOutboxRelay(t *testing.T) {
+	th := SetupWithStoreMock(t)
+	defer th.TearDown()
+
+	message := model.NewWebSocketEvent(model.WEBSOCKET_EVENT_POST_EDITED, "", model.NewId(), "", nil)
+	message.Add("post", "{}")
+	event := model.NewOutboxEvent(message)
+	event.PreSave()
+	invalidEvent := &model.OutboxEvent{Id: model.NewId(), CreateAt: event.CreateAt, Event: "{"}
+
+	mockOutboxStore := mocks.OutboxStore{}
+	mockOutboxStore.On("GetUndelivered", int64(1000)-int64(OUTBOX_RELAY_GRACE_PERIOD.Milliseconds()), OUTBOX_RELAY_BATCH_SIZE).Return([]*model.OutboxEvent{event, invalidEvent}, nil)
+	mockOutboxStore.On("MarkDelivered", []string{event.Id, invalidEvent.Id}, mock.AnythingOfType("int64")).Return(nil)
+	mockOutboxStore.On("PermanentDeleteBatch", int64(1000)-int64(OUTBOX_RETENTION.Milliseconds()), int64(OUTBOX_DELETE_BATCH_SIZE)).Return(int64(0), nil)
+
+	mockStore := th.App.Srv().Store.(*mocks.Store)
+	mockStore.On("Outbox").Return(&mockOutboxStore)
+
+	relay := newOutboxRelay(th.App.Srv())
+	relay.relay(1000)
+	relay.deleteDelivered(1000)
+
+	mockOutboxStore.AssertExpectations(t)
+}
+
+func TestCreatePostOutboxEvent(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	post, err := th.App.CreatePostAsUser(&model.Post{
+		UserId:    th.BasicUser.Id,
+		ChannelId: th.BasicChannel.Id,
+		Message:   "message",
+	}, "", true)
+	require.Nil(t, err)
+
+	// The event was published along with the post, so that there's nothing left to relay.
+	events, storeErr := th.App.Srv().Store.Outbox().GetUndelivered(model.GetMillis()+1, 1000)
+	require.Nil(t, storeErr)
+	for _, event := range events {
+		message := event.WebSocketEvent()
+		require.NotNil(t, message)
+		assert.NotContains(t, message.GetData()["post"], post.Id)
+	}
+}
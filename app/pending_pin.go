@@ -0,0 +1,109 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+// RequestPinPost records the request of the user to pin the post, to be approved or rejected by
+// the channel admins, and lets the channel know about it.
+func (a *App) RequestPinPost(post *model.Post, userId string) (*model.PendingPin, *model.AppError) {
+	if post.IsPinned {
+		return nil, model.NewAppError("RequestPinPost", "app.pending_pin.request.already_pinned.app_error", nil, "post_id="+post.Id, http.StatusBadRequest)
+	}
+
+	pendingPin, err := a.Srv().Store.PendingPin().Save(&model.PendingPin{
+		PostId:    post.Id,
+		ChannelId: post.ChannelId,
+		UserId:    userId,
+	})
+	if err != nil {
+		var appErr *model.AppError
+		var cErr *store.ErrConflict
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &cErr):
+			return nil, model.NewAppError("RequestPinPost", "app.pending_pin.request.already_requested.app_error", nil, cErr.Error(), http.StatusBadRequest)
+		default:
+			return nil, model.NewAppError("RequestPinPost", "app.pending_pin.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	message := model.NewWebSocketEvent(model.WEBSOCKET_EVENT_PIN_REQUESTED, "", pendingPin.ChannelId, "", nil)
+	message.Add("pending_pin", pendingPin.ToJson())
+	a.Publish(message)
+
+	return pendingPin, nil
+}
+
+func (a *App) GetPendingPin(postId string) (*model.PendingPin, *model.AppError) {
+	pendingPin, err := a.Srv().Store.PendingPin().Get(postId)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetPendingPin", "app.pending_pin.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetPendingPin", "app.pending_pin.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return pendingPin, nil
+}
+
+func (a *App) GetPendingPinsForChannel(channelId string) ([]*model.PendingPin, *model.AppError) {
+	pendingPins, err := a.Srv().Store.PendingPin().GetForChannel(channelId)
+	if err != nil {
+		return nil, model.NewAppError("GetPendingPinsForChannel", "app.pending_pin.get_for_channel.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return pendingPins, nil
+}
+
+// ApprovePendingPin pins the post of the request and removes the request.
+func (a *App) ApprovePendingPin(pendingPin *model.PendingPin) (*model.Post, *model.AppError) {
+	patch := &model.PostPatch{}
+	patch.IsPinned = model.NewBool(true)
+
+	post, appErr := a.PatchPost(pendingPin.PostId, patch)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if appErr := a.deletePendingPin(pendingPin, true); appErr != nil {
+		return nil, appErr
+	}
+
+	return post, nil
+}
+
+// RejectPendingPin removes the request without pinning the post.
+func (a *App) RejectPendingPin(pendingPin *model.PendingPin) *model.AppError {
+	return a.deletePendingPin(pendingPin, false)
+}
+
+func (a *App) deletePendingPin(pendingPin *model.PendingPin, approved bool) *model.AppError {
+	if err := a.Srv().Store.PendingPin().Delete(pendingPin.PostId); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("deletePendingPin", "app.pending_pin.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return model.NewAppError("deletePendingPin", "app.pending_pin.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	message := model.NewWebSocketEvent(model.WEBSOCKET_EVENT_PIN_REQUEST_RESOLVED, "", pendingPin.ChannelId, "", nil)
+	message.Add("pending_pin", pendingPin.ToJson())
+	message.Add("approved", approved)
+	a.Publish(message)
+
+	return nil
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+// sessionRolesResolver resolves the roles a session holds in teams and channels. The resolved roles
+// are remembered, so a batch of permission checks only looks up each team and channel once.
+type sessionRolesResolver struct {
+	app            *App
+	session        model.Session
+	channelMembers map[string]string
+	teamRoles      map[string][]string
+	channelRoles   map[string][]string
+}
+
+func newSessionRolesResolver(a *App, session model.Session) *sessionRolesResolver {
+	return &sessionRolesResolver{
+		app:          a,
+		session:      session,
+		teamRoles:    make(map[string][]string),
+		channelRoles: make(map[string][]string),
+	}
+}
+
+func (r *sessionRolesResolver) rolesForSystem() []string {
+	return r.session.GetUserRoles()
+}
+
+func (r *sessionRolesResolver) rolesForTeam(teamId string) []string {
+	if roles, ok := r.teamRoles[teamId]; ok {
+		return roles
+	}
+
+	var roles []string
+	if teamMember := r.session.GetTeamByTeamId(teamId); teamMember != nil {
+		roles = append(roles, teamMember.GetRoles()...)
+	}
+	roles = append(roles, r.rolesForSystem()...)
+
+	r.teamRoles[teamId] = roles
+	return roles
+}
+
+// rolesForChannel mirrors SessionHasPermissionToChannel: the channel member roles, then the roles in
+// the channel's team, or the system roles for channels outside of a team.
+func (r *sessionRolesResolver) rolesForChannel(channelId string) []string {
+	if roles, ok := r.channelRoles[channelId]; ok {
+		return roles
+	}
+
+	if r.channelMembers == nil {
+		channelMembers, err := r.app.Srv().Store.Channel().GetAllChannelMembersForUser(r.session.UserId, true, true)
+		if err != nil {
+			mlog.Warn("Failed to get the channel members to check permissions.", mlog.String("user_id", r.session.UserId), mlog.Err(err))
+			channelMembers = map[string]string{}
+		}
+		r.channelMembers = channelMembers
+	}
+
+	var roles []string
+	if memberRoles, ok := r.channelMembers[channelId]; ok {
+		roles = append(roles, strings.Fields(memberRoles)...)
+	}
+
+	channel, err := r.app.GetChannel(channelId)
+	if err == nil && channel.TeamId != "" {
+		roles = append(roles, r.rolesForTeam(channel.TeamId)...)
+	} else if err == nil || err.StatusCode != http.StatusNotFound {
+		roles = append(roles, r.rolesForSystem()...)
+	}
+
+	r.channelRoles[channelId] = roles
+	return roles
+}
+
+// SessionCheckPermissions answers a batch of permission checks for the session, giving clients the
+// server's view of their permissions instead of having them parse roles themselves.
+func (a *App) SessionCheckPermissions(session model.Session, checks []*model.PermissionCheck) ([]*model.PermissionCheckResult, *model.AppError) {
+	if len(checks) > model.PERMISSION_CHECKS_MAX_PER_BATCH {
+		return nil, model.NewAppError("SessionCheckPermissions", "app.permission_check.too_many.app_error", map[string]interface{}{"Max": model.PERMISSION_CHECKS_MAX_PER_BATCH}, "checks="+strconv.Itoa(len(checks)), http.StatusBadRequest)
+	}
+
+	knownPermissions := make(map[string]bool, len(model.ALL_PERMISSIONS))
+	for _, permission := range model.ALL_PERMISSIONS {
+		knownPermissions[permission.Id] = true
+	}
+
+	for _, check := range checks {
+		if err := check.IsValid(); err != nil {
+			return nil, err
+		}
+		if !knownPermissions[check.Permission] {
+			return nil, model.NewAppError("SessionCheckPermissions", "app.permission_check.unknown_permission.app_error", map[string]interface{}{"Permission": check.Permission}, "", http.StatusBadRequest)
+		}
+	}
+
+	resolver := newSessionRolesResolver(a, session)
+	results := make([]*model.PermissionCheckResult, 0, len(checks))
+	for _, check := range checks {
+		result := &model.PermissionCheckResult{PermissionCheck: *check}
+
+		switch {
+		case session.IsUnrestricted():
+			result.Allowed = true
+		case check.ChannelId != "":
+			result.Allowed = a.RolesGrantPermission(resolver.rolesForChannel(check.ChannelId), check.Permission)
+		case check.TeamId != "":
+			result.Allowed = a.RolesGrantPermission(resolver.rolesForTeam(check.TeamId), check.Permission)
+		default:
+			result.Allowed = a.RolesGrantPermission(resolver.rolesForSystem(), check.Permission)
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
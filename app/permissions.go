@@ -19,7 +19,7 @@ const systemSchemeName = "00000000-0000-0000-0000-000000000000" // Prevents coll
 
 func (a *App) ResetPermissionsSystem() *model.AppError {
 	// Reset all Teams to not have a scheme.
-	if err := a.Srv().Store.Team().ResetAllTeamSchemes(); err != nil {
+	if err := a.Srv().Store.Team().ResetAllTeamSchemes(a.Context()); err != nil {
 		return err
 	}
 
@@ -34,7 +34,7 @@ func (a *App) ResetPermissionsSystem() *model.AppError {
 	}
 
 	// Reset all Custom Role assignments to TeamMembers.
-	if err := a.Srv().Store.Team().ClearAllCustomRoleAssignments(); err != nil {
+	if err := a.Srv().Store.Team().ClearAllCustomRoleAssignments(a.Context()); err != nil {
 		return err
 	}
 
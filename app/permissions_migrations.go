@@ -48,14 +48,23 @@ const (
 	PERMISSION_INVITE_GUEST                      = "invite_guest"
 	PERMISSION_PROMOTE_GUEST                     = "promote_guest"
 	PERMISSION_DEMOTE_TO_GUEST                   = "demote_to_guest"
+	PERMISSION_IMPERSONATE_USER                  = "impersonate_user"
 	PERMISSION_USE_CHANNEL_MENTIONS              = "use_channel_mentions"
 	PERMISSION_CREATE_POST                       = "create_post"
 	PERMISSION_CREATE_POST_PUBLIC                = "create_post_public"
 	PERMISSION_USE_GROUP_MENTIONS                = "use_group_mentions"
+	PERMISSION_DOWNLOAD_FILE                     = "download_file"
+	PERMISSION_CREATE_URGENT_POST                = "create_urgent_post"
+	PERMISSION_READ_CHANNEL                      = "read_channel"
 	PERMISSION_ADD_REACTION                      = "add_reaction"
 	PERMISSION_REMOVE_REACTION                   = "remove_reaction"
 	PERMISSION_MANAGE_PUBLIC_CHANNEL_MEMBERS     = "manage_public_channel_members"
 	PERMISSION_MANAGE_PRIVATE_CHANNEL_MEMBERS    = "manage_private_channel_members"
+	PERMISSION_SYSCONSOLE_READ_USER_MANAGEMENT   = "sysconsole_read_user_management"
+	PERMISSION_SYSCONSOLE_WRITE_USER_MANAGEMENT  = "sysconsole_write_user_management"
+	PERMISSION_SYSCONSOLE_READ_COMPLIANCE        = "sysconsole_read_compliance"
+	PERMISSION_SYSCONSOLE_READ_INTEGRATIONS      = "sysconsole_read_integrations"
+	PERMISSION_SYSCONSOLE_WRITE_INTEGRATIONS     = "sysconsole_write_integrations"
 )
 
 func isRole(roleName string) func(*model.Role, map[string]map[string]bool) bool {
@@ -425,6 +434,54 @@ func (a *App) getAddUseGroupMentionsPermissionMigration() (permissionsMap, error
 	}, nil
 }
 
+func (a *App) getAddDownloadFilePermissionMigration() (permissionsMap, error) {
+	return permissionsMap{
+		permissionTransformation{
+			On:  permissionExists(PERMISSION_READ_CHANNEL),
+			Add: []string{PERMISSION_DOWNLOAD_FILE},
+		},
+	}, nil
+}
+
+func (a *App) getAddImpersonateUserPermissionMigration() (permissionsMap, error) {
+	return permissionsMap{
+		permissionTransformation{
+			On:  isRole(model.SYSTEM_ADMIN_ROLE_ID),
+			Add: []string{PERMISSION_IMPERSONATE_USER},
+		},
+	}, nil
+}
+
+// getAddSystemConsolePermissionsMigration gives the existing system admins every system console
+// permission, so that they keep their access once the endpoints check them.
+func (a *App) getAddSystemConsolePermissionsMigration() (permissionsMap, error) {
+	return permissionsMap{
+		permissionTransformation{
+			On: isRole(model.SYSTEM_ADMIN_ROLE_ID),
+			Add: []string{
+				PERMISSION_SYSCONSOLE_READ_USER_MANAGEMENT,
+				PERMISSION_SYSCONSOLE_WRITE_USER_MANAGEMENT,
+				PERMISSION_SYSCONSOLE_READ_COMPLIANCE,
+				PERMISSION_SYSCONSOLE_READ_INTEGRATIONS,
+				PERMISSION_SYSCONSOLE_WRITE_INTEGRATIONS,
+			},
+		},
+	}, nil
+}
+
+func (a *App) getAddCreateUrgentPostPermissionMigration() (permissionsMap, error) {
+	return permissionsMap{
+		permissionTransformation{
+			On: permissionAnd(
+				isNotRole(model.CHANNEL_GUEST_ROLE_ID),
+				isNotSchemeRole("Channel Guest Role for Scheme"),
+				permissionOr(permissionExists(PERMISSION_CREATE_POST), permissionExists(PERMISSION_CREATE_POST_PUBLIC)),
+			),
+			Add: []string{PERMISSION_CREATE_URGENT_POST},
+		},
+	}, nil
+}
+
 // DoPermissionsMigrations execute all the permissions migrations need by the current version.
 func (a *App) DoPermissionsMigrations() error {
 	PermissionsMigrations := []struct {
@@ -442,6 +499,10 @@ func (a *App) DoPermissionsMigrations() error {
 		{Key: model.MIGRATION_KEY_ADD_MANAGE_GUESTS_PERMISSIONS, Migration: a.getAddManageGuestsPermissionsMigration},
 		{Key: model.MIGRATION_KEY_CHANNEL_MODERATIONS_PERMISSIONS, Migration: a.channelModerationPermissionsMigration},
 		{Key: model.MIGRATION_KEY_ADD_USE_GROUP_MENTIONS_PERMISSION, Migration: a.getAddUseGroupMentionsPermissionMigration},
+		{Key: model.MIGRATION_KEY_ADD_DOWNLOAD_FILE_PERMISSION, Migration: a.getAddDownloadFilePermissionMigration},
+		{Key: model.MIGRATION_KEY_ADD_IMPERSONATE_USER_PERMISSION, Migration: a.getAddImpersonateUserPermissionMigration},
+		{Key: model.MIGRATION_KEY_ADD_SYSTEM_CONSOLE_PERMISSIONS, Migration: a.getAddSystemConsolePermissionsMigration},
+		{Key: model.MIGRATION_KEY_ADD_CREATE_URGENT_POST_PERMISSION, Migration: a.getAddCreateUrgentPostPermissionMigration},
 	}
 
 	for _, migration := range PermissionsMigrations {
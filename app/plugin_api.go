@@ -213,7 +213,7 @@ func (api *PluginAPI) GetTeamMember(teamId, userId string) (*model.TeamMember, *
 }
 
 func (api *PluginAPI) GetTeamMembersForUser(userId string, page int, perPage int) ([]*model.TeamMember, *model.AppError) {
-	return api.app.GetTeamMembersForUserWithPagination(userId, page, perPage)
+	return api.app.GetTeamMembersForUserWithPagination(userId, page, perPage, nil)
 }
 
 func (api *PluginAPI) UpdateTeamMemberRoles(teamId, userId, newRoles string) (*model.TeamMember, *model.AppError) {
@@ -307,6 +307,14 @@ func (api *PluginAPI) UpdateUserStatus(userId, status string) (*model.Status, *m
 	return api.app.GetStatus(userId)
 }
 
+func (api *PluginAPI) StartUserStatusAutomation(userId, source string, endAt int64) (*model.StatusAutomation, *model.AppError) {
+	return api.app.StartStatusAutomation(userId, source, endAt)
+}
+
+func (api *PluginAPI) EndUserStatusAutomation(userId string) *model.AppError {
+	return api.app.EndStatusAutomation(userId)
+}
+
 func (api *PluginAPI) GetUsersInChannel(channelId, sortBy string, page, perPage int) ([]*model.User, *model.AppError) {
 	switch sortBy {
 	case model.CHANNEL_SORT_BY_USERNAME:
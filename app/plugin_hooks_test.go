@@ -664,6 +664,144 @@ func TestHookFileWillBeUploaded(t *testing.T) {
 	})
 }
 
+func TestHookFileWillBeDownloaded(t *testing.T) {
+	info := &model.FileInfo{Id: model.NewId(), Name: "testhook.txt", Size: int64(len("inputfile"))}
+
+	t.Run("rejected", func(t *testing.T) {
+		th := Setup(t).InitBasic()
+		defer th.TearDown()
+
+		var mockAPI plugintest.API
+		mockAPI.On("LoadPluginConfiguration", mock.Anything).Return(nil)
+		tearDown, _, _ := SetAppEnvironmentWithPlugins(t, []string{
+			`
+			package main
+
+			import (
+				"io"
+				"github.com/mattermost/mattermost-server/v5/plugin"
+				"github.com/mattermost/mattermost-server/v5/model"
+			)
+
+			type MyPlugin struct {
+				plugin.MattermostPlugin
+			}
+
+			func (p *MyPlugin) FileWillBeDownloaded(c *plugin.Context, info *model.FileInfo, userId string, file io.Reader, output io.Writer) string {
+				return "rejected"
+			}
+
+			func main() {
+				plugin.ClientMain(&MyPlugin{})
+			}
+			`,
+		}, th.App, func(*model.Manifest) plugin.API { return &mockAPI })
+		defer tearDown()
+
+		_, _, err := th.App.RunFileWillBeDownloadedHooks(info, th.BasicUser.Id, bytes.NewReader([]byte("inputfile")))
+		require.NotNil(t, err)
+		assert.Equal(t, "app.file.download.rejected_by_plugin.app_error", err.Id)
+	})
+
+	t.Run("watermarked", func(t *testing.T) {
+		th := Setup(t).InitBasic()
+		defer th.TearDown()
+
+		var mockAPI plugintest.API
+		mockAPI.On("LoadPluginConfiguration", mock.Anything).Return(nil)
+		tearDown, _, _ := SetAppEnvironmentWithPlugins(t, []string{
+			`
+			package main
+
+			import (
+				"io"
+				"io/ioutil"
+				"github.com/mattermost/mattermost-server/v5/plugin"
+				"github.com/mattermost/mattermost-server/v5/model"
+			)
+
+			type MyPlugin struct {
+				plugin.MattermostPlugin
+			}
+
+			func (p *MyPlugin) FileWillBeDownloaded(c *plugin.Context, info *model.FileInfo, userId string, file io.Reader, output io.Writer) string {
+				data, err := ioutil.ReadAll(file)
+				if err != nil {
+					return err.Error()
+				}
+				output.Write(append(data, []byte(" for "+userId)...))
+				return ""
+			}
+
+			func main() {
+				plugin.ClientMain(&MyPlugin{})
+			}
+			`,
+		}, th.App, func(*model.Manifest) plugin.API { return &mockAPI })
+		defer tearDown()
+
+		content, size, err := th.App.RunFileWillBeDownloadedHooks(info, th.BasicUser.Id, bytes.NewReader([]byte("inputfile")))
+		require.Nil(t, err)
+
+		data, readErr := ioutil.ReadAll(content)
+		require.Nil(t, readErr)
+		expected := "inputfile for " + th.BasicUser.Id
+		assert.Equal(t, expected, string(data))
+		assert.Equal(t, int64(len(expected)), size)
+	})
+}
+
+func TestHookFileWillBeEnriched(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	var mockAPI plugintest.API
+	mockAPI.On("LoadPluginConfiguration", mock.Anything).Return(nil)
+	tearDown, _, _ := SetAppEnvironmentWithPlugins(t, []string{
+		`
+		package main
+
+		import (
+			"io"
+			"io/ioutil"
+			"strconv"
+			"github.com/mattermost/mattermost-server/v5/plugin"
+			"github.com/mattermost/mattermost-server/v5/model"
+		)
+
+		type MyPlugin struct {
+			plugin.MattermostPlugin
+		}
+
+		func (p *MyPlugin) FileWillBeEnriched(c *plugin.Context, info *model.FileInfo, file io.Reader) map[string]string {
+			data, err := ioutil.ReadAll(file)
+			if err != nil {
+				return nil
+			}
+			return map[string]string{"length": strconv.Itoa(len(data)), "hash": info.Hash}
+		}
+
+		func main() {
+			plugin.ClientMain(&MyPlugin{})
+		}
+		`,
+	}, th.App, func(*model.Manifest) plugin.API { return &mockAPI })
+	defer tearDown()
+
+	info, err := th.App.DoUploadFile(time.Now(), th.BasicTeam.Id, th.BasicChannel.Id, th.BasicUser.Id, "testhook.txt", []byte("inputfile"))
+	require.Nil(t, err)
+	defer th.App.Srv().Store.FileInfo().PermanentDelete(info.Id)
+
+	enriched, err := th.App.EnrichFileInfo(info.Id)
+	require.Nil(t, err)
+	require.NotEmpty(t, enriched.Hash)
+	assert.Equal(t, model.StringMap{"length": "9", "hash": enriched.Hash}, enriched.Metadata)
+
+	fetched, err := th.App.GetFileInfo(info.Id)
+	require.Nil(t, err)
+	assert.Equal(t, enriched.Metadata, fetched.Metadata)
+}
+
 func TestUserWillLogIn_Blocked(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
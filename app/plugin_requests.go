@@ -172,6 +172,16 @@ func (a *App) servePluginRequest(w http.ResponseWriter, r *http.Request, handler
 					csrfCheckPassed = true
 				}
 			}
+
+			if !csrfCheckPassed && *a.Config().ServiceSettings.CSRFEnforcementMode == model.CSRF_ENFORCEMENT_MODE_REPORT {
+				a.Log().Warn("CSRF check failed for plugin request, allowed in report mode",
+					mlog.String("path", r.URL.Path),
+					mlog.String("ip", r.RemoteAddr),
+					mlog.String("session_id", session.Id),
+					mlog.String("user_id", session.UserId),
+				)
+				csrfCheckPassed = true
+			}
 		} else {
 			csrfCheckPassed = true
 		}
@@ -194,6 +194,13 @@ func (a *App) CreatePost(post *model.Post, channel *model.Channel, triggerWebhoo
 		post.AddProp("from_bot", "true")
 	}
 
+	// Webhooks posting as a bot are allowed in the channel on their own.
+	if user.IsBot && !post.IsSystemMessage() && post.GetProp("from_webhook") == nil {
+		if err = a.checkIntegrationAllowedInChannel(channel, user.Id); err != nil {
+			return nil, err
+		}
+	}
+
 	if a.Srv().License() != nil && *a.Config().TeamSettings.ExperimentalTownSquareIsReadOnly &&
 		!post.IsSystemMessage() &&
 		channel.Name == model.DEFAULT_CHANNEL &&
@@ -201,6 +208,18 @@ func (a *App) CreatePost(post *model.Post, channel *model.Channel, triggerWebhoo
 		return nil, model.NewAppError("createPost", "api.post.create_post.town_square_read_only", nil, "", http.StatusForbidden)
 	}
 
+	if err = a.checkPostAllowedByRestrictions(post, channel); err != nil {
+		return nil, err
+	}
+
+	if err = a.checkPostAllowedByBlocks(post, channel); err != nil {
+		return nil, err
+	}
+
+	if post.IsUrgent() && !a.HasPermissionToChannel(user.Id, channel.Id, model.PERMISSION_CREATE_URGENT_POST) {
+		return nil, model.NewAppError("createPost", "app.post_priority.urgent.permissions.app_error", nil, "", http.StatusForbidden)
+	}
+
 	var ephemeralPost *model.Post
 	if post.Type == "" && !a.HasPermissionToChannel(user.Id, channel.Id, model.PERMISSION_USE_CHANNEL_MENTIONS) {
 		mention := post.DisableMentionHighlights()
@@ -290,15 +309,21 @@ func (a *App) CreatePost(post *model.Post, channel *model.Channel, triggerWebhoo
 		}
 	}
 
-	rpost, err := a.Srv().Store.Post().Save(post)
+	rpost, outboxEvent, err := a.Srv().Store.Post().SaveWithOutboxEvent(post, newPostedOutboxMessage(channel, user, setOnline))
 	if err != nil {
 		return nil, err
 	}
 
+	a.recordPostIntegrity(rpost)
+
 	// Update the mapping from pending post id to the actual post id, for any clients that
 	// might be duplicating requests.
 	a.Srv().seenPendingPostIdsCache.SetWithExpiry(post.PendingPostId, rpost.Id, PENDING_POST_IDS_CACHE_TTL)
 
+	if user.IsBot && !rpost.IsSystemMessage() && rpost.GetProp("from_webhook") == nil {
+		a.Srv().recordIntegrationUsage(user.Id, model.INTEGRATION_USAGE_TYPE_BOT, true)
+	}
+
 	if pluginsEnvironment := a.GetPluginsEnvironment(); pluginsEnvironment != nil {
 		a.Srv().Go(func() {
 			pluginContext := a.PluginContext()
@@ -309,6 +334,8 @@ func (a *App) CreatePost(post *model.Post, channel *model.Channel, triggerWebhoo
 		})
 	}
 
+	a.Srv().publishEventStreamEvent(model.EVENT_STREAM_POST_CREATED, channel.TeamId, channel.Id, rpost.UserId, rpost)
+
 	if a.Metrics() != nil {
 		a.Metrics().IncrementPostCreate()
 	}
@@ -329,6 +356,8 @@ func (a *App) CreatePost(post *model.Post, channel *model.Channel, triggerWebhoo
 
 	if err := a.handlePostEvents(rpost, user, channel, triggerWebhooks, parentPostList, setOnline); err != nil {
 		mlog.Error("Failed to handle post events", mlog.Err(err))
+	} else {
+		a.markOutboxEventDelivered(outboxEvent)
 	}
 
 	// Send any ephemeral posts after the post is created to ensure it shows up after the latest post created
@@ -387,7 +416,7 @@ func (a *App) FillInPostProps(post *model.Post, channel *model.Channel) *model.A
 
 		for _, mentioned := range mentionedChannels {
 			if mentioned.Type == model.CHANNEL_OPEN {
-				team, err := a.Srv().Store.Team().Get(mentioned.TeamId)
+				team, err := a.GetTeam(mentioned.TeamId)
 				if err != nil {
 					mlog.Error("Failed to get team of the channel mention", mlog.String("team_id", channel.TeamId), mlog.String("channel_id", channel.Id), mlog.Err(err))
 				}
@@ -416,7 +445,7 @@ func (a *App) FillInPostProps(post *model.Post, channel *model.Channel) *model.A
 func (a *App) handlePostEvents(post *model.Post, user *model.User, channel *model.Channel, triggerWebhooks bool, parentPostList *model.PostList, setOnline bool) error {
 	var team *model.Team
 	if len(channel.TeamId) > 0 {
-		t, err := a.Srv().Store.Team().Get(channel.TeamId)
+		t, err := a.GetTeam(channel.TeamId)
 		if err != nil {
 			return err
 		}
@@ -549,6 +578,12 @@ func (a *App) UpdatePost(post *model.Post, safeUpdate bool) (*model.Post, *model
 		return nil, model.NewAppError("UpdatePost", "api.post.update_post.can_not_update_post_in_deleted.error", nil, "", http.StatusBadRequest)
 	}
 
+	if post.Message != oldPost.Message {
+		if err = a.checkCustomEmojiAllowed(channel, getEmojiNamesForString(post.Message)); err != nil {
+			return nil, err
+		}
+	}
+
 	newPost := &model.Post{}
 	newPost = oldPost.Clone()
 
@@ -563,6 +598,7 @@ func (a *App) UpdatePost(post *model.Post, safeUpdate bool) (*model.Post, *model
 		newPost.HasReactions = post.HasReactions
 		newPost.FileIds = post.FileIds
 		newPost.SetProps(post.GetProps())
+		keepPostPriority(newPost, oldPost)
 	}
 
 	// Avoid deep-equal checks if EditAt was already modified through message change
@@ -586,11 +622,14 @@ func (a *App) UpdatePost(post *model.Post, safeUpdate bool) (*model.Post, *model
 		}
 	}
 
-	rpost, err := a.Srv().Store.Post().Update(newPost, oldPost)
+	outboxMessage := model.NewWebSocketEvent(model.WEBSOCKET_EVENT_POST_EDITED, "", newPost.ChannelId, "", nil)
+	rpost, outboxEvent, err := a.Srv().Store.Post().UpdateWithOutboxEvent(newPost, oldPost, outboxMessage)
 	if err != nil {
 		return nil, err
 	}
 
+	a.recordPostIntegrity(rpost)
+
 	if pluginsEnvironment := a.GetPluginsEnvironment(); pluginsEnvironment != nil {
 		a.Srv().Go(func() {
 			pluginContext := a.PluginContext()
@@ -601,13 +640,17 @@ func (a *App) UpdatePost(post *model.Post, safeUpdate bool) (*model.Post, *model
 		})
 	}
 
+	a.publishPostEventStreamEvent(model.EVENT_STREAM_POST_EDITED, rpost)
+
 	rpost = a.PreparePostForClient(rpost, false, true)
 
 	message := model.NewWebSocketEvent(model.WEBSOCKET_EVENT_POST_EDITED, "", rpost.ChannelId, "", nil)
-	message.Add("post", rpost.ToJson())
+	message.Add("post", removePermalinkPreviewContent(rpost).ToJson())
 	a.Publish(message)
+	a.markOutboxEventDelivered(outboxEvent)
 
 	a.invalidateCacheForChannelPosts(rpost.ChannelId)
+	a.invalidateCacheForPermalinkPreview(rpost.Id)
 
 	return rpost, nil
 }
@@ -658,12 +701,82 @@ func (a *App) GetPostsSince(options model.GetPostsSinceOptions) (*model.PostList
 	return a.Srv().Store.Post().GetPostsSince(options, true)
 }
 
+// GetPostsDelta returns the changes to the posts of the channel, either since the given time or past
+// the token handed out by a previous call. The delta holds the token of the next call.
+func (a *App) GetPostsDelta(channelId string, since int64, token string) (*model.PostsDelta, *model.AppError) {
+	cursor := model.NewPostsDeltaCursor(channelId, since)
+	if token != "" {
+		decoded := model.PostsDeltaCursorFromToken(token)
+		if decoded == nil || decoded.ChannelId != channelId {
+			return nil, model.NewAppError("GetPostsDelta", "app.post.get_posts_delta.invalid_token.app_error", nil, "", http.StatusBadRequest)
+		}
+		cursor = *decoded
+	}
+
+	delta, next, err := a.Srv().Store.Post().GetPostsDelta(cursor, model.POSTS_DELTA_MAX)
+	if err != nil {
+		return nil, err
+	}
+
+	delta.NextToken = next.ToToken()
+	return delta, nil
+}
+
+// GetChannelChanges returns the changes to the channel past sinceSeq, along with the current state of
+// the posts they concern.
+func (a *App) GetChannelChanges(channelId string, sinceSeq int64) (*model.ChannelChanges, *model.AppError) {
+	changes, err := a.Srv().Store.ChannelChange().GetSince(channelId, sinceSeq, model.CHANNEL_CHANGES_MAX+1)
+	if err != nil {
+		return nil, model.NewAppError("GetChannelChanges", "app.channel_change.get_since.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	result := model.NewChannelChanges(sinceSeq)
+	if len(changes) > model.CHANNEL_CHANGES_MAX {
+		changes = changes[:model.CHANNEL_CHANGES_MAX]
+		result.HasMore = true
+	}
+	result.AddChanges(changes)
+
+	if postIds := result.PostIds(); len(postIds) > 0 {
+		posts, appErr := a.Srv().Store.Post().GetPostsByIds(postIds)
+		if appErr != nil {
+			return nil, appErr
+		}
+
+		for _, post := range posts {
+			result.Posts[post.Id] = post
+		}
+	}
+
+	return result, nil
+}
+
 func (a *App) GetSinglePost(postId string) (*model.Post, *model.AppError) {
-	return a.Srv().Store.Post().GetSingle(postId)
+	post, err := a.Srv().Store.Post().GetSingle(postId)
+	if err != nil && err.StatusCode == http.StatusNotFound && a.isPostArchivingEnabled() {
+		return a.getArchivedPost(postId, err)
+	}
+
+	return post, err
 }
 
 func (a *App) GetPostThread(postId string, skipFetchThreads bool) (*model.PostList, *model.AppError) {
-	return a.Srv().Store.Post().Get(postId, skipFetchThreads)
+	list, err := a.Srv().Store.Post().Get(postId, skipFetchThreads)
+	if err != nil && err.StatusCode == http.StatusNotFound && a.isPostArchivingEnabled() {
+		if skipFetchThreads {
+			post, appErr := a.getArchivedPost(postId, err)
+			if appErr != nil {
+				return nil, appErr
+			}
+			list = model.NewPostList()
+			list.AddPost(post)
+			list.AddOrder(post.Id)
+			return list, nil
+		}
+		return a.getArchivedPostThread(postId, err)
+	}
+
+	return list, err
 }
 
 func (a *App) GetFlaggedPosts(userId string, offset int, limit int) (*model.PostList, *model.AppError) {
@@ -679,7 +792,7 @@ func (a *App) GetFlaggedPostsForChannel(userId, channelId string, offset int, li
 }
 
 func (a *App) GetPermalinkPost(postId string, userId string) (*model.PostList, *model.AppError) {
-	list, err := a.Srv().Store.Post().Get(postId, false)
+	list, err := a.GetPostThread(postId, false)
 	if err != nil {
 		return nil, err
 	}
@@ -858,13 +971,22 @@ func (a *App) DeletePost(postId, deleteByID string) (*model.Post, *model.AppErro
 		return nil, err
 	}
 
-	if err := a.Srv().Store.Post().Delete(postId, model.GetMillis(), deleteByID); err != nil {
+	deleteAt := model.GetMillis()
+	outboxMessage := model.NewWebSocketEvent(model.WEBSOCKET_EVENT_POST_DELETED, "", post.ChannelId, "", nil)
+	outboxEvent, err := a.Srv().Store.Post().DeleteWithOutboxEvent(postId, deleteAt, deleteByID, outboxMessage)
+	if err != nil {
 		return nil, err
 	}
 
+	deletedPost := post.Clone()
+	deletedPost.DeleteAt = deleteAt
+	deletedPost.UpdateAt = deleteAt
+	a.Srv().publishEventStreamEvent(model.EVENT_STREAM_POST_DELETED, channel.TeamId, channel.Id, post.UserId, deletedPost)
+
 	message := model.NewWebSocketEvent(model.WEBSOCKET_EVENT_POST_DELETED, "", post.ChannelId, "", nil)
-	message.Add("post", a.PreparePostForClient(post, false, false).ToJson())
+	message.Add("post", removePermalinkPreviewContent(a.PreparePostForClient(post, false, false)).ToJson())
 	a.Publish(message)
+	a.markOutboxEventDelivered(outboxEvent)
 
 	a.Srv().Go(func() {
 		a.DeletePostFiles(post)
@@ -874,6 +996,7 @@ func (a *App) DeletePost(postId, deleteByID string) (*model.Post, *model.AppErro
 	})
 
 	a.invalidateCacheForChannelPosts(post.ChannelId)
+	a.invalidateCacheForPermalinkPreview(post.Id)
 
 	return post, nil
 }
@@ -1039,6 +1162,15 @@ func (a *App) SearchPostsInTeamForUser(terms string, userId string, teamId strin
 		return nil, err
 	}
 
+	// Archived posts are only searched once the live results fit on the first page.
+	if a.isPostArchivingEnabled() && page == 0 && len(postSearchResults.Order) < perPage {
+		archived, err := a.searchArchivedPosts(userId, teamId, finalParamsList, includeDeleted, perPage-len(postSearchResults.Order))
+		if err != nil {
+			return nil, err
+		}
+		postSearchResults.Extend(archived)
+	}
+
 	return postSearchResults, nil
 }
 
@@ -1168,6 +1300,7 @@ func (a *App) countMentionsFromPost(user *model.User, post *model.Post) (int, *m
 
 	// A mapping of thread root IDs to whether or not a post in that thread mentions the user
 	mentionedByThread := make(map[string]bool)
+	tokenizeCJK := *a.Config().LocalizationSettings.EnableCJKTokenization
 
 	thread, err := a.GetPostThread(post.Id, false)
 	if err != nil {
@@ -1176,7 +1309,7 @@ func (a *App) countMentionsFromPost(user *model.User, post *model.Post) (int, *m
 
 	count := 0
 
-	if isPostMention(user, post, keywords, thread.Posts, mentionedByThread, checkForCommentMentions) {
+	if isPostMention(user, post, keywords, thread.Posts, mentionedByThread, checkForCommentMentions, tokenizeCJK) {
 		count += 1
 	}
 
@@ -1194,7 +1327,7 @@ func (a *App) countMentionsFromPost(user *model.User, post *model.Post) (int, *m
 		}
 
 		for _, postId := range postList.Order {
-			if isPostMention(user, postList.Posts[postId], keywords, postList.Posts, mentionedByThread, checkForCommentMentions) {
+			if isPostMention(user, postList.Posts[postId], keywords, postList.Posts, mentionedByThread, checkForCommentMentions, tokenizeCJK) {
 				count += 1
 			}
 		}
@@ -1246,14 +1379,14 @@ func isCommentMention(user *model.User, post *model.Post, otherPosts map[string]
 	return mentioned
 }
 
-func isPostMention(user *model.User, post *model.Post, keywords map[string][]string, otherPosts map[string]*model.Post, mentionedByThread map[string]bool, checkForCommentMentions bool) bool {
+func isPostMention(user *model.User, post *model.Post, keywords map[string][]string, otherPosts map[string]*model.Post, mentionedByThread map[string]bool, checkForCommentMentions bool, tokenizeCJK bool) bool {
 	// Prevent the user from mentioning themselves
 	if post.UserId == user.Id && post.GetProp("from_webhook") != "true" {
 		return false
 	}
 
 	// Check for keyword mentions
-	mentions := getExplicitMentions(post, keywords, make(map[string]*model.Group))
+	mentions := getExplicitMentions(post, keywords, make(map[string]*model.Group), tokenizeCJK)
 	if _, ok := mentions.Mentions[user.Id]; ok {
 		return true
 	}
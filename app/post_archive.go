@@ -0,0 +1,125 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+	"github.com/mattermost/mattermost-server/v5/utils"
+)
+
+func (a *App) isPostArchivingEnabled() bool {
+	return *a.Config().ArchiveSettings.EnablePostArchiving
+}
+
+// getArchivedPost reads a post back from the archive when it can no longer be found in the Posts table.
+// The original not found error is returned when the post isn't archived either.
+func (a *App) getArchivedPost(postId string, notFoundErr *model.AppError) (*model.Post, *model.AppError) {
+	post, err := a.Srv().Store.PostArchive().Get(postId)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, notFoundErr
+		default:
+			return nil, model.NewAppError("getArchivedPost", "app.post_archive.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return post, nil
+}
+
+func (a *App) getArchivedPostThread(postId string, notFoundErr *model.AppError) (*model.PostList, *model.AppError) {
+	list, err := a.Srv().Store.PostArchive().GetThread(postId)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, notFoundErr
+		default:
+			return nil, model.NewAppError("getArchivedPostThread", "app.post_archive.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return list, nil
+}
+
+// searchArchivedPosts returns up to limit archived posts matching the given search params in the
+// channels of the team that the user belongs to. Only plain terms and channel filters are supported,
+// so params relying on any other filter are skipped.
+func (a *App) searchArchivedPosts(userId, teamId string, paramsList []*model.SearchParams, includeDeletedChannels bool, limit int) (*model.PostList, *model.AppError) {
+	list := model.NewPostList()
+	if limit <= 0 {
+		return list, nil
+	}
+
+	channels, err := a.Srv().Store.Channel().GetChannels(teamId, userId, includeDeletedChannels)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return list, nil
+		default:
+			return nil, model.NewAppError("searchArchivedPosts", "app.channel.get_channels.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	for _, params := range paramsList {
+		if !isArchiveSearchable(params) {
+			continue
+		}
+
+		var channelIds []string
+		for _, channel := range *channels {
+			if len(params.InChannels) > 0 && !utils.StringInSlice(channel.Id, params.InChannels) {
+				continue
+			}
+			if utils.StringInSlice(channel.Id, params.ExcludedChannels) {
+				continue
+			}
+			channelIds = append(channelIds, channel.Id)
+		}
+
+		var terms []string
+		for _, term := range strings.Fields(params.Terms) {
+			if term = strings.Trim(term, "\"*"); term != "" {
+				terms = append(terms, term)
+			}
+		}
+
+		posts, err := a.Srv().Store.PostArchive().Search(channelIds, terms, limit)
+		if err != nil {
+			return nil, model.NewAppError("searchArchivedPosts", "app.post_archive.search.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+
+		for _, post := range posts {
+			if _, ok := list.Posts[post.Id]; ok || len(list.Order) >= limit {
+				continue
+			}
+			list.AddPost(post)
+			list.AddOrder(post.Id)
+		}
+	}
+
+	return list, nil
+}
+
+func isArchiveSearchable(params *model.SearchParams) bool {
+	return params.Terms != "" &&
+		params.ExcludedTerms == "" &&
+		!params.IsHashtag &&
+		!params.OrTerms &&
+		len(params.FromUsers) == 0 &&
+		len(params.ExcludedUsers) == 0 &&
+		params.AfterDate == "" &&
+		params.BeforeDate == "" &&
+		params.OnDate == "" &&
+		params.ExcludedAfterDate == "" &&
+		params.ExcludedBeforeDate == "" &&
+		params.ExcludedDate == ""
+}
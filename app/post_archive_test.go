@@ -0,0 +1,83 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestArchivedPostReadThrough(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	root, appErr := th.App.CreatePost(&model.Post{
+		UserId:    th.BasicUser.Id,
+		ChannelId: th.BasicChannel.Id,
+		Message:   "archived budget thread",
+		CreateAt:  1000,
+	}, th.BasicChannel, false, true)
+	require.Nil(t, appErr)
+
+	reply, appErr := th.App.CreatePost(&model.Post{
+		UserId:    th.BasicUser.Id,
+		ChannelId: th.BasicChannel.Id,
+		RootId:    root.Id,
+		Message:   "archived reply",
+		CreateAt:  1001,
+	}, th.BasicChannel, false, true)
+	require.Nil(t, appErr)
+
+	for {
+		archived, err := th.App.Srv().Store.PostArchive().ArchiveBatch(2000, 100)
+		require.Nil(t, err)
+		if archived == 0 {
+			break
+		}
+	}
+
+	t.Run("archived posts are hidden while archiving is disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ArchiveSettings.EnablePostArchiving = false })
+
+		_, appErr := th.App.GetSinglePost(root.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusNotFound, appErr.StatusCode)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ArchiveSettings.EnablePostArchiving = true })
+
+	t.Run("single post", func(t *testing.T) {
+		post, appErr := th.App.GetSinglePost(root.Id)
+		require.Nil(t, appErr)
+		assert.True(t, post.IsArchived)
+		assert.Equal(t, root.Message, post.Message)
+	})
+
+	t.Run("permalink", func(t *testing.T) {
+		list, appErr := th.App.GetPermalinkPost(reply.Id, th.BasicUser.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, reply.Id, list.Order[0])
+		assert.Len(t, list.Posts, 2)
+		assert.True(t, list.Posts[root.Id].IsArchived)
+	})
+
+	t.Run("missing post", func(t *testing.T) {
+		_, appErr := th.App.GetSinglePost(model.NewId())
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusNotFound, appErr.StatusCode)
+	})
+
+	t.Run("search", func(t *testing.T) {
+		results, appErr := th.App.SearchPostsInTeamForUser("budget", th.BasicUser.Id, th.BasicTeam.Id, false, false, 0, 0, 20)
+		require.Nil(t, appErr)
+		require.Len(t, results.Order, 1)
+		assert.Equal(t, root.Id, results.Order[0])
+		assert.True(t, results.Posts[root.Id].IsArchived)
+	})
+}
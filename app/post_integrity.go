@@ -0,0 +1,110 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+const POST_INTEGRITY_VERIFY_BATCH_SIZE = 1000
+
+// recordPostIntegrity appends the current content of the post to the hash chain of its channel
+// when post integrity is enabled. Failing to do so doesn't fail the post, but is logged, and the
+// post shows up as modified when the channel is verified.
+func (a *App) recordPostIntegrity(post *model.Post) {
+	if !*a.Config().ComplianceSettings.EnablePostIntegrity {
+		return
+	}
+
+	if _, err := a.Srv().Store.PostIntegrity().Append(post); err != nil {
+		mlog.Error("Failed to record post integrity", mlog.String("post_id", post.Id), mlog.String("channel_id", post.ChannelId), mlog.Err(err))
+	}
+}
+
+// VerifyChannelIntegrity walks the hash chain of the channel, checking that each entry is
+// correctly chained to the previous one, and that the latest content recorded for each post
+// still matches the post stored in the database.
+func (a *App) VerifyChannelIntegrity(channelId string) (*model.PostIntegrityReport, *model.AppError) {
+	report := &model.PostIntegrityReport{
+		ChannelId:       channelId,
+		BrokenLinks:     []int64{},
+		ModifiedPostIds: []string{},
+		MissingPostIds:  []string{},
+	}
+
+	// The latest entry of a post supersedes the earlier ones, which were recorded before edits.
+	contentHashes := map[string]string{}
+	var postIds []string
+
+	var previous *model.PostIntegrityEntry
+	for {
+		afterSeq := int64(0)
+		if previous != nil {
+			afterSeq = previous.Seq
+		}
+
+		entries, err := a.Srv().Store.PostIntegrity().GetForChannel(channelId, afterSeq, POST_INTEGRITY_VERIFY_BATCH_SIZE)
+		if err != nil {
+			return nil, model.NewAppError("VerifyChannelIntegrity", "app.post_integrity.get_for_channel.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+
+		for _, entry := range entries {
+			previousHash := ""
+			expectedSeq := int64(1)
+			if previous != nil {
+				previousHash = previous.Hash
+				expectedSeq = previous.Seq + 1
+			}
+
+			if entry.Seq != expectedSeq || entry.Hash != entry.ComputeHash(previousHash) {
+				report.BrokenLinks = append(report.BrokenLinks, entry.Seq)
+			}
+
+			if _, ok := contentHashes[entry.PostId]; !ok {
+				postIds = append(postIds, entry.PostId)
+			}
+			contentHashes[entry.PostId] = entry.ContentHash
+			report.EntriesChecked++
+			previous = entry
+		}
+
+		if len(entries) < POST_INTEGRITY_VERIFY_BATCH_SIZE {
+			break
+		}
+	}
+
+	for start := 0; start < len(postIds); start += POST_INTEGRITY_VERIFY_BATCH_SIZE {
+		end := start + POST_INTEGRITY_VERIFY_BATCH_SIZE
+		if end > len(postIds) {
+			end = len(postIds)
+		}
+
+		posts, err := a.Srv().Store.Post().GetPostsByIds(postIds[start:end])
+		if err != nil {
+			return nil, err
+		}
+
+		found := map[string]bool{}
+		for _, post := range posts {
+			found[post.Id] = true
+			report.PostsChecked++
+			if post.ChannelId != channelId || model.PostContentHash(post) != contentHashes[post.Id] {
+				report.ModifiedPostIds = append(report.ModifiedPostIds, post.Id)
+			}
+		}
+
+		for _, postId := range postIds[start:end] {
+			if !found[postId] {
+				report.MissingPostIds = append(report.MissingPostIds, postId)
+			}
+		}
+	}
+
+	report.Valid = len(report.BrokenLinks) == 0 && len(report.ModifiedPostIds) == 0 && len(report.MissingPostIds) == 0
+
+	return report, nil
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestVerifyChannelIntegrity(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ComplianceSettings.EnablePostIntegrity = true })
+
+	channel := th.CreateChannel(th.BasicTeam)
+	post1 := th.CreatePost(channel)
+	post2 := th.CreatePost(channel)
+
+	post2.Message = "edited"
+	_, err := th.App.UpdatePost(post2, false)
+	require.Nil(t, err)
+
+	t.Run("should verify an untouched channel", func(t *testing.T) {
+		report, err := th.App.VerifyChannelIntegrity(channel.Id)
+		require.Nil(t, err)
+		assert.True(t, report.Valid)
+		assert.Equal(t, int64(3), report.EntriesChecked)
+		assert.Equal(t, int64(2), report.PostsChecked)
+	})
+
+	t.Run("should detect a post modified in the database", func(t *testing.T) {
+		tampered := post1.Clone()
+		tampered.Message = "tampered"
+		_, err := th.App.Srv().Store.Post().Overwrite(tampered)
+		require.Nil(t, err)
+
+		report, err := th.App.VerifyChannelIntegrity(channel.Id)
+		require.Nil(t, err)
+		assert.False(t, report.Valid)
+		assert.Equal(t, []string{post1.Id}, report.ModifiedPostIds)
+		assert.Empty(t, report.BrokenLinks)
+	})
+
+	t.Run("should not record posts when disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ComplianceSettings.EnablePostIntegrity = false })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ComplianceSettings.EnablePostIntegrity = true })
+
+		otherChannel := th.CreateChannel(th.BasicTeam)
+		th.CreatePost(otherChannel)
+
+		report, err := th.App.VerifyChannelIntegrity(otherChannel.Id)
+		require.Nil(t, err)
+		assert.True(t, report.Valid)
+		assert.Zero(t, report.EntriesChecked)
+	})
+}
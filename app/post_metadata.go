@@ -35,6 +35,15 @@ var linkCache = cache.NewLRU(&cache.LRUOptions{
 	Size: LINK_CACHE_SIZE,
 })
 
+const PERMALINK_PREVIEW_CACHE_SIZE = 10000
+const PERMALINK_PREVIEW_CACHE_DURATION = 1 * time.Hour
+
+// permalinkPreviewCache holds the previews of posts referenced by permalinks, keyed by the id of the referenced
+// post. Entries are invalidated when the referenced post is edited or deleted.
+var permalinkPreviewCache = cache.NewLRU(&cache.LRUOptions{
+	Size: PERMALINK_PREVIEW_CACHE_SIZE,
+})
+
 func (a *App) InitPostMetadata() {
 	// Dump any cached links if the proxy settings have changed so image URLs can be updated
 	a.AddConfigListener(func(before, after *model.Config) {
@@ -100,6 +109,12 @@ func (a *App) PreparePostForClient(originalPost *model.Post, isNewPost bool, isE
 		return post
 	}
 
+	// New and edited posts are broadcast to every member of the channel, so their system
+	// messages are left in the default locale of the server.
+	if !isNewPost && !isEditPost {
+		a.localizeSystemMessage(post)
+	}
+
 	// Emojis and reaction counts
 	if emojis, reactions, err := a.getEmojisAndReactionsForPost(post); err != nil {
 		mlog.Warn("Failed to get emojis and reactions for a post", mlog.String("post_id", post.Id), mlog.Err(err))
@@ -164,6 +179,23 @@ func (a *App) getEmbedForPost(post *model.Post, firstLink string, isNewPost bool
 		}, nil
 	}
 
+	if firstLink != "" && *a.Config().ServiceSettings.EnablePermalinkPreviews {
+		if postId := getPermalinkPostId(firstLink, a.GetSiteURL()); postId != "" && postId != post.Id {
+			previewPost, err := a.getPermalinkPreview(postId)
+			if err != nil {
+				return nil, err
+			}
+
+			if previewPost != nil {
+				return &model.PostEmbed{
+					Type: model.POST_EMBED_PERMALINK,
+					URL:  firstLink,
+					Data: previewPost,
+				}, nil
+			}
+		}
+	}
+
 	if firstLink == "" || !*a.Config().ServiceSettings.EnableLinkPreviews {
 		return nil, nil
 	}
@@ -242,6 +274,189 @@ func (a *App) getImagesForPost(post *model.Post, imageURLs []string, isNewPost b
 	return images
 }
 
+// getPermalinkPostId returns the id of the post referenced by the given link if it is a permalink to a post on
+// this server, or an empty string otherwise.
+func getPermalinkPostId(link string, siteURL string) string {
+	if siteURL == "" {
+		return ""
+	}
+
+	resolved, err := url.Parse(resolveMetadataURL(link, siteURL))
+	if err != nil {
+		return ""
+	}
+
+	site, err := url.Parse(siteURL)
+	if err != nil {
+		return ""
+	}
+
+	if resolved.Scheme != site.Scheme || resolved.Host != site.Host {
+		return ""
+	}
+
+	sitePath := strings.TrimSuffix(site.Path, "/")
+	if !strings.HasPrefix(resolved.Path, sitePath+"/") {
+		return ""
+	}
+
+	return model.ParsePermalinkPostId(strings.TrimPrefix(resolved.Path, sitePath))
+}
+
+// getPermalinkPreview returns a preview of the post with the given id for embedding in posts that link to it. No
+// permission checks are done here since the preview is shared by everyone who can see the linking post, so callers
+// must use SanitizePostMetadataForUser before returning the preview to a user.
+func (a *App) getPermalinkPreview(postId string) (*model.PreviewPost, *model.AppError) {
+	var cached model.PreviewPost
+	if err := permalinkPreviewCache.Get(postId, &cached); err == nil {
+		return &cached, nil
+	}
+
+	referencedPost, err := a.Srv().Store.Post().GetSingle(postId)
+	if err != nil {
+		if err.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	channel, err := a.GetChannel(referencedPost.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	var team *model.Team
+	if channel.TeamId != "" {
+		team, err = a.GetTeam(channel.TeamId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Only the message itself is embedded to avoid recursively embedding previews of previews
+	previewedPost := referencedPost.Clone()
+	previewedPost.Metadata = nil
+	previewedPost.StripActionIntegrations()
+
+	previewPost := model.NewPreviewPost(previewedPost, team, channel)
+
+	permalinkPreviewCache.SetWithExpiry(postId, previewPost, PERMALINK_PREVIEW_CACHE_DURATION)
+
+	return previewPost, nil
+}
+
+func (a *App) invalidateCacheForPermalinkPreview(postId string) {
+	a.invalidateCacheForPermalinkPreviewSkipClusterSend(postId)
+
+	if a.Cluster() != nil {
+		msg := &model.ClusterMessage{
+			Event:    model.CLUSTER_EVENT_INVALIDATE_CACHE_FOR_PERMALINK_PREVIEW,
+			SendType: model.CLUSTER_SEND_BEST_EFFORT,
+			Data:     postId,
+		}
+		a.Cluster().SendClusterMessage(msg)
+	}
+}
+
+func (a *App) invalidateCacheForPermalinkPreviewSkipClusterSend(postId string) {
+	permalinkPreviewCache.Remove(postId)
+}
+
+// SanitizePostMetadataForUser removes the content of any permalink previews embedded in the given post that the
+// user doesn't have permission to read. The given post is not modified.
+func (a *App) SanitizePostMetadataForUser(post *model.Post, userId string) *model.Post {
+	return filterPermalinkPreviews(post, func(previewPost *model.PreviewPost) bool {
+		return a.canReadPermalinkPreview(userId, previewPost)
+	})
+}
+
+// SanitizePostListMetadataForUser applies SanitizePostMetadataForUser to every post in the list.
+func (a *App) SanitizePostListMetadataForUser(originalList *model.PostList, userId string) *model.PostList {
+	if originalList == nil {
+		return nil
+	}
+
+	list := &model.PostList{
+		Posts:      make(map[string]*model.Post, len(originalList.Posts)),
+		Order:      originalList.Order,
+		NextPostId: originalList.NextPostId,
+		PrevPostId: originalList.PrevPostId,
+	}
+
+	for id, post := range originalList.Posts {
+		list.Posts[id] = a.SanitizePostMetadataForUser(post, userId)
+	}
+
+	return list
+}
+
+func (a *App) canReadPermalinkPreview(userId string, previewPost *model.PreviewPost) bool {
+	if a.HasPermissionToChannel(userId, previewPost.ChannelId, model.PERMISSION_READ_CHANNEL) {
+		return true
+	}
+
+	if previewPost.ChannelType != model.CHANNEL_OPEN {
+		return false
+	}
+
+	channel, err := a.GetChannel(previewPost.ChannelId)
+	if err != nil {
+		return false
+	}
+
+	return a.HasPermissionToTeam(userId, channel.TeamId, model.PERMISSION_READ_PUBLIC_CHANNEL)
+}
+
+// removePermalinkPreviewContent strips the content of any embedded permalink previews from a post before it is
+// broadcast over the websocket, since every member of the channel receives the same event. Clients can fetch the
+// preview through the API, which checks the permissions of the requesting user.
+func removePermalinkPreviewContent(post *model.Post) *model.Post {
+	return filterPermalinkPreviews(post, func(*model.PreviewPost) bool {
+		return false
+	})
+}
+
+// filterPermalinkPreviews returns a copy of the post where the content of every permalink preview for which keep
+// returns false has been removed. The original post is returned if nothing needs to be removed.
+func filterPermalinkPreviews(post *model.Post, keep func(*model.PreviewPost) bool) *model.Post {
+	if post == nil || post.Metadata == nil {
+		return post
+	}
+
+	modified := false
+	embeds := make([]*model.PostEmbed, len(post.Metadata.Embeds))
+	for i, embed := range post.Metadata.Embeds {
+		embeds[i] = embed
+
+		if embed.Type != model.POST_EMBED_PERMALINK {
+			continue
+		}
+
+		previewPost, ok := embed.Data.(*model.PreviewPost)
+		if !ok || previewPost == nil || previewPost.Post == nil || keep(previewPost) {
+			continue
+		}
+
+		embeds[i] = &model.PostEmbed{
+			Type: embed.Type,
+			URL:  embed.URL,
+			Data: &model.PreviewPost{PostID: previewPost.PostID},
+		}
+		modified = true
+	}
+
+	if !modified {
+		return post
+	}
+
+	post = post.Clone()
+	metadata := *post.Metadata
+	metadata.Embeds = embeds
+	post.Metadata = &metadata
+
+	return post
+}
+
 func getEmojiNamesForString(s string) []string {
 	names := model.EMOJI_PATTERN.FindAllString(s, -1)
 
@@ -22,6 +22,7 @@ import (
 	"github.com/mattermost/mattermost-server/v5/model"
 	"github.com/mattermost/mattermost-server/v5/services/httpservice"
 	"github.com/mattermost/mattermost-server/v5/services/imageproxy"
+	"github.com/mattermost/mattermost-server/v5/utils"
 	"github.com/mattermost/mattermost-server/v5/utils/testutils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -513,6 +514,37 @@ func TestPreparePostForClient(t *testing.T) {
 		assert.Nil(t, clientPost.Metadata.Reactions, "should not have populated Reactions")
 		assert.Nil(t, clientPost.Metadata.Files, "should not have populated Files")
 	})
+
+	t.Run("system message in the locale of the request", func(t *testing.T) {
+		th := setup(t)
+		defer th.TearDown()
+
+		channel := th.CreateChannel(th.BasicTeam)
+		err := th.App.postJoinChannelMessage(th.BasicUser2, channel)
+		require.Nil(t, err)
+
+		posts, err := th.App.GetPostsPage(model.GetPostsOptions{ChannelId: channel.Id, Page: 0, PerPage: 10})
+		require.Nil(t, err)
+
+		var post *model.Post
+		for _, p := range posts.Posts {
+			if p.Type == model.POST_JOIN_CHANNEL && p.UserId == th.BasicUser2.Id {
+				post = p
+			}
+		}
+		require.NotNil(t, post)
+		assert.Equal(t, th.BasicUser2.Username+" joined the channel.", post.Message)
+
+		th.App.SetT(utils.GetUserTranslations("es"))
+		defer th.App.SetT(nil)
+
+		clientPost := th.App.PreparePostForClient(post, false, false)
+		assert.Equal(t, th.BasicUser2.Username+" se unió al canal.", clientPost.Message)
+		assert.Equal(t, th.BasicUser2.Username+" joined the channel.", post.Message, "should not have changed the original post")
+
+		clientPost = th.App.PreparePostForClient(post, true, false)
+		assert.Equal(t, th.BasicUser2.Username+" joined the channel.", clientPost.Message, "should not have localized a new post")
+	})
 }
 
 func TestPreparePostForClientWithImageProxy(t *testing.T) {
@@ -2075,6 +2107,153 @@ func TestResolveMetadataURL(t *testing.T) {
 	}
 }
 
+func TestGetPermalinkPostId(t *testing.T) {
+	postId := model.NewId()
+
+	for _, test := range []struct {
+		Name     string
+		Link     string
+		SiteURL  string
+		Expected string
+	}{
+		{
+			Name:     "permalink",
+			Link:     "https://mattermost.example.com/myteam/pl/" + postId,
+			SiteURL:  "https://mattermost.example.com",
+			Expected: postId,
+		},
+		{
+			Name:     "permalink with subpath",
+			Link:     "https://mattermost.example.com/subpath/myteam/pl/" + postId,
+			SiteURL:  "https://mattermost.example.com/subpath/",
+			Expected: postId,
+		},
+		{
+			Name:     "permalink missing subpath",
+			Link:     "https://mattermost.example.com/myteam/pl/" + postId,
+			SiteURL:  "https://mattermost.example.com/subpath",
+			Expected: "",
+		},
+		{
+			Name:     "permalink to another server",
+			Link:     "https://other.example.com/myteam/pl/" + postId,
+			SiteURL:  "https://mattermost.example.com",
+			Expected: "",
+		},
+		{
+			Name:     "channel link",
+			Link:     "https://mattermost.example.com/myteam/channels/town-square",
+			SiteURL:  "https://mattermost.example.com",
+			Expected: "",
+		},
+		{
+			Name:     "no site URL",
+			Link:     "https://mattermost.example.com/myteam/pl/" + postId,
+			SiteURL:  "",
+			Expected: "",
+		},
+	} {
+		t.Run(test.Name, func(t *testing.T) {
+			assert.Equal(t, test.Expected, getPermalinkPostId(test.Link, test.SiteURL))
+		})
+	}
+}
+
+func TestPermalinkPreviews(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	siteURL := "http://mattermost.example.com"
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.SiteURL = siteURL
+		*cfg.ServiceSettings.EnablePermalinkPreviews = true
+	})
+
+	privateChannel := th.CreatePrivateChannel(th.BasicTeam)
+	referencedPost := th.CreatePost(privateChannel)
+
+	permalink := siteURL + "/" + th.BasicTeam.Name + "/pl/" + referencedPost.Id
+	post := th.CreatePost(th.BasicChannel)
+	post.Message = permalink
+
+	getPreview := func(t *testing.T, post *model.Post) *model.PreviewPost {
+		t.Helper()
+
+		require.NotNil(t, post.Metadata)
+		require.Len(t, post.Metadata.Embeds, 1)
+		require.Equal(t, model.POST_EMBED_PERMALINK, post.Metadata.Embeds[0].Type)
+
+		previewPost, ok := post.Metadata.Embeds[0].Data.(*model.PreviewPost)
+		require.True(t, ok)
+
+		return previewPost
+	}
+
+	t.Run("should embed the referenced post", func(t *testing.T) {
+		clientPost := th.App.PreparePostForClient(post, false, false)
+
+		previewPost := getPreview(t, clientPost)
+		assert.Equal(t, referencedPost.Id, previewPost.PostID)
+		require.NotNil(t, previewPost.Post)
+		assert.Equal(t, referencedPost.Message, previewPost.Post.Message)
+		assert.Equal(t, th.BasicTeam.Name, previewPost.TeamName)
+		assert.Equal(t, privateChannel.DisplayName, previewPost.ChannelDisplayName)
+	})
+
+	t.Run("should keep the preview for users who can read the referenced post", func(t *testing.T) {
+		clientPost := th.App.SanitizePostMetadataForUser(th.App.PreparePostForClient(post, false, false), th.BasicUser.Id)
+
+		assert.NotNil(t, getPreview(t, clientPost).Post)
+	})
+
+	t.Run("should remove the preview for users who can't read the referenced post", func(t *testing.T) {
+		clientPost := th.App.PreparePostForClient(post, false, false)
+		sanitizedPost := th.App.SanitizePostMetadataForUser(clientPost, th.BasicUser2.Id)
+
+		previewPost := getPreview(t, sanitizedPost)
+		assert.Equal(t, referencedPost.Id, previewPost.PostID)
+		assert.Nil(t, previewPost.Post)
+
+		assert.NotNil(t, getPreview(t, clientPost).Post, "shouldn't have mutated the original post")
+	})
+
+	t.Run("should update the preview when the referenced post is edited", func(t *testing.T) {
+		th.App.PreparePostForClient(post, false, false)
+
+		edited := referencedPost.Clone()
+		edited.Message = "edited message"
+		_, err := th.App.UpdatePost(edited, false)
+		require.Nil(t, err)
+
+		clientPost := th.App.PreparePostForClient(post, false, false)
+		assert.Equal(t, "edited message", getPreview(t, clientPost).Post.Message)
+	})
+
+	t.Run("should not embed the referenced post once it is deleted", func(t *testing.T) {
+		_, err := th.App.DeletePost(referencedPost.Id, th.BasicUser.Id)
+		require.Nil(t, err)
+
+		clientPost := th.App.PreparePostForClient(post, false, false)
+		for _, embed := range clientPost.Metadata.Embeds {
+			assert.NotEqual(t, model.POST_EMBED_PERMALINK, embed.Type)
+		}
+	})
+
+	t.Run("should not embed anything when disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.ServiceSettings.EnablePermalinkPreviews = false
+		})
+
+		otherPost := th.CreatePost(th.BasicChannel)
+		otherPost.Message = siteURL + "/" + th.BasicTeam.Name + "/pl/" + th.CreatePost(th.BasicChannel).Id
+
+		clientPost := th.App.PreparePostForClient(otherPost, false, false)
+		for _, embed := range clientPost.Metadata.Embeds {
+			assert.NotEqual(t, model.POST_EMBED_PERMALINK, embed.Type)
+		}
+	})
+}
+
 func TestParseLinkMetadata(t *testing.T) {
 	th := Setup(t)
 	defer th.TearDown()
@@ -0,0 +1,84 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+// keepPostPriority restores the priority of the old post on its updated version, the priority
+// of a post being set once when it is created.
+func keepPostPriority(newPost, oldPost *model.Post) {
+	for _, key := range []string{model.POST_PROPS_PRIORITY, model.POST_PROPS_REQUESTED_ACK} {
+		if value := oldPost.GetProp(key); value != nil {
+			newPost.AddProp(key, value)
+		} else if newPost.GetProp(key) != nil {
+			newPost.DelProp(key)
+		}
+	}
+}
+
+// AcknowledgePost records that the user acknowledged the post, which must have requested it, and
+// lets the channel know about it.
+func (a *App) AcknowledgePost(post *model.Post, userId string) (*model.PostAcknowledgement, *model.AppError) {
+	if !post.IsAckRequested() {
+		return nil, model.NewAppError("AcknowledgePost", "app.post_acknowledgement.not_requested.app_error", nil, "post_id="+post.Id, http.StatusBadRequest)
+	}
+
+	acknowledgement, err := a.Srv().Store.PostAcknowledgement().Save(&model.PostAcknowledgement{
+		PostId:    post.Id,
+		UserId:    userId,
+		ChannelId: post.ChannelId,
+	})
+	if err != nil {
+		var appErr *model.AppError
+		var cErr *store.ErrConflict
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &cErr):
+			return nil, model.NewAppError("AcknowledgePost", "app.post_acknowledgement.save.exists.app_error", nil, cErr.Error(), http.StatusBadRequest)
+		default:
+			return nil, model.NewAppError("AcknowledgePost", "app.post_acknowledgement.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	message := model.NewWebSocketEvent(model.WEBSOCKET_EVENT_POST_ACKNOWLEDGEMENT_ADDED, "", post.ChannelId, "", nil)
+	message.Add("acknowledgement", acknowledgement.ToJson())
+	a.Publish(message)
+
+	return acknowledgement, nil
+}
+
+// UnacknowledgePost removes the acknowledgement of the post by the user.
+func (a *App) UnacknowledgePost(post *model.Post, userId string) *model.AppError {
+	if err := a.Srv().Store.PostAcknowledgement().Delete(post.Id, userId); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("UnacknowledgePost", "app.post_acknowledgement.delete.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return model.NewAppError("UnacknowledgePost", "app.post_acknowledgement.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	message := model.NewWebSocketEvent(model.WEBSOCKET_EVENT_POST_ACKNOWLEDGEMENT_REMOVED, "", post.ChannelId, "", nil)
+	message.Add("acknowledgement", (&model.PostAcknowledgement{PostId: post.Id, UserId: userId, ChannelId: post.ChannelId}).ToJson())
+	a.Publish(message)
+
+	return nil
+}
+
+func (a *App) GetPostAcknowledgements(postId string) ([]*model.PostAcknowledgement, *model.AppError) {
+	acknowledgements, err := a.Srv().Store.PostAcknowledgement().GetForPost(postId)
+	if err != nil {
+		return nil, model.NewAppError("GetPostAcknowledgements", "app.post_acknowledgement.get_for_post.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return acknowledgements, nil
+}
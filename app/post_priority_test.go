@@ -0,0 +1,110 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestKeepPostPriority(t *testing.T) {
+	oldPost := &model.Post{}
+	oldPost.AddProp(model.POST_PROPS_PRIORITY, model.POST_PRIORITY_IMPORTANT)
+
+	newPost := &model.Post{}
+	newPost.AddProp(model.POST_PROPS_PRIORITY, model.POST_PRIORITY_URGENT)
+	newPost.AddProp(model.POST_PROPS_REQUESTED_ACK, true)
+	newPost.AddProp("other", "value")
+
+	keepPostPriority(newPost, oldPost)
+	assert.Equal(t, model.POST_PRIORITY_IMPORTANT, newPost.GetPriority())
+	assert.False(t, newPost.IsAckRequested())
+	assert.Equal(t, "value", newPost.GetProp("other"))
+}
+
+func TestPostAcknowledgements(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	post := &model.Post{
+		UserId:    th.BasicUser.Id,
+		ChannelId: th.BasicChannel.Id,
+		Message:   "message",
+	}
+	post.AddProp(model.POST_PROPS_PRIORITY, model.POST_PRIORITY_IMPORTANT)
+	post.AddProp(model.POST_PROPS_REQUESTED_ACK, true)
+	post, err := th.App.CreatePostAsUser(post, "", true)
+	require.Nil(t, err)
+
+	t.Run("should acknowledge the post once", func(t *testing.T) {
+		acknowledgement, err := th.App.AcknowledgePost(post, th.BasicUser2.Id)
+		require.Nil(t, err)
+		assert.Equal(t, th.BasicChannel.Id, acknowledgement.ChannelId)
+
+		_, err = th.App.AcknowledgePost(post, th.BasicUser2.Id)
+		require.NotNil(t, err)
+		assert.Equal(t, "app.post_acknowledgement.save.exists.app_error", err.Id)
+
+		acknowledgements, err := th.App.GetPostAcknowledgements(post.Id)
+		require.Nil(t, err)
+		require.Len(t, acknowledgements, 1)
+		assert.Equal(t, th.BasicUser2.Id, acknowledgements[0].UserId)
+	})
+
+	t.Run("should remove the acknowledgement", func(t *testing.T) {
+		err := th.App.UnacknowledgePost(post, th.BasicUser2.Id)
+		require.Nil(t, err)
+
+		err = th.App.UnacknowledgePost(post, th.BasicUser2.Id)
+		require.NotNil(t, err)
+		assert.Equal(t, http.StatusNotFound, err.StatusCode)
+	})
+
+	t.Run("should not acknowledge posts not requesting it", func(t *testing.T) {
+		_, err := th.App.AcknowledgePost(th.CreatePost(th.BasicChannel), th.BasicUser2.Id)
+		require.NotNil(t, err)
+		assert.Equal(t, "app.post_acknowledgement.not_requested.app_error", err.Id)
+	})
+
+	t.Run("should keep the priority when updating the post", func(t *testing.T) {
+		update := post.Clone()
+		update.Message = "edited"
+		update.SetProps(model.StringInterface{})
+
+		updated, err := th.App.UpdatePost(update, false)
+		require.Nil(t, err)
+		assert.Equal(t, model.POST_PRIORITY_IMPORTANT, updated.GetPriority())
+		assert.True(t, updated.IsAckRequested())
+	})
+}
+
+func TestCreateUrgentPostPermission(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	newUrgentPost := func() *model.Post {
+		post := &model.Post{
+			UserId:    th.BasicUser.Id,
+			ChannelId: th.BasicChannel.Id,
+			Message:   "message",
+		}
+		post.AddProp(model.POST_PROPS_PRIORITY, model.POST_PRIORITY_URGENT)
+		return post
+	}
+
+	_, err := th.App.CreatePostAsUser(newUrgentPost(), "", true)
+	require.Nil(t, err)
+
+	th.RemovePermissionFromRole(model.PERMISSION_CREATE_URGENT_POST.Id, model.CHANNEL_USER_ROLE_ID)
+	defer th.AddPermissionToRole(model.PERMISSION_CREATE_URGENT_POST.Id, model.CHANNEL_USER_ROLE_ID)
+
+	_, err = th.App.CreatePostAsUser(newUrgentPost(), "", true)
+	require.NotNil(t, err)
+	assert.Equal(t, http.StatusForbidden, err.StatusCode)
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"fmt"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/utils"
+)
+
+// setSystemMessage renders the message of the system post in the default locale of the server,
+// and records its translation on the post so that it can be rendered again in the locale of each
+// viewer when the post is fetched.
+func setSystemMessage(post *model.Post, translationId string, params ...string) {
+	args := make([]interface{}, len(params))
+	for i, param := range params {
+		args[i] = param
+	}
+
+	post.Message = fmt.Sprintf(utils.T(translationId), args...)
+	post.SetSystemMessageTranslation(translationId, params)
+}
+
+// localizeSystemMessage renders the message of the system post in the locale of the current
+// request, leaving posts without a recorded translation untouched.
+func (a *App) localizeSystemMessage(post *model.Post) {
+	if a.t == nil {
+		return
+	}
+
+	translationId, params := post.GetSystemMessageTranslation()
+	if translationId == "" {
+		return
+	}
+
+	post.Message = fmt.Sprintf(a.T(translationId), params...)
+}
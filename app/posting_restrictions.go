@@ -0,0 +1,132 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func (a *App) UpdateChannelPostingRestrictions(channel *model.Channel, restrictions *model.PostingRestrictions) (*model.Channel, *model.AppError) {
+	channel = channel.DeepCopy()
+	channel.SetPostingRestrictions(restrictions)
+
+	return a.UpdateChannel(channel)
+}
+
+func (a *App) UpdateTeamPostingRestrictions(teamId string, restrictions *model.PostingRestrictions) (*model.Team, *model.AppError) {
+	team, err := a.GetTeam(teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	team.SetPostingRestrictions(restrictions)
+
+	team, err = a.updateTeamUnsanitized(team)
+	if err != nil {
+		return nil, err
+	}
+
+	a.sendTeamEvent(team, model.WEBSOCKET_EVENT_UPDATE_TEAM)
+
+	return team, nil
+}
+
+// getPostingRestrictionsTeam returns the team the channel belongs to, or nil for direct and group messages.
+func (a *App) getPostingRestrictionsTeam(channel *model.Channel) (*model.Team, *model.AppError) {
+	if channel.TeamId == "" {
+		return nil, nil
+	}
+
+	return a.GetTeam(channel.TeamId)
+}
+
+// checkFileUploadsAllowed returns an error if file uploads are restricted in the channel or in its team.
+func (a *App) checkFileUploadsAllowed(channel *model.Channel) *model.AppError {
+	if channel.IsFileUploadRestricted() {
+		return model.NewAppError("checkFileUploadsAllowed", "app.posting_restrictions.file_uploads.channel.app_error", nil, "channel_id="+channel.Id, http.StatusForbidden)
+	}
+
+	team, err := a.getPostingRestrictionsTeam(channel)
+	if err != nil {
+		return err
+	}
+
+	if team != nil && team.IsFileUploadRestricted() {
+		return model.NewAppError("checkFileUploadsAllowed", "app.posting_restrictions.file_uploads.team.app_error", nil, "team_id="+team.Id, http.StatusForbidden)
+	}
+
+	return nil
+}
+
+// checkFileUploadsAllowedForChannelId is checkFileUploadsAllowed for callers that only know the channel id.
+func (a *App) checkFileUploadsAllowedForChannelId(channelId string) *model.AppError {
+	channel, err := a.GetChannel(channelId)
+	if err != nil {
+		return err
+	}
+
+	return a.checkFileUploadsAllowed(channel)
+}
+
+// checkCustomEmojiAllowed returns an error if any of the given emoji names refers to a custom emoji
+// while custom emoji are restricted in the channel or in its team.
+func (a *App) checkCustomEmojiAllowed(channel *model.Channel, emojiNames []string) *model.AppError {
+	if !*a.Config().ServiceSettings.EnableCustomEmoji || len(emojiNames) == 0 {
+		return nil
+	}
+
+	team, err := a.getPostingRestrictionsTeam(channel)
+	if err != nil {
+		return err
+	}
+
+	channelRestricted := channel.IsCustomEmojiRestricted()
+	if !channelRestricted && (team == nil || !team.IsCustomEmojiRestricted()) {
+		return nil
+	}
+
+	names := []string{}
+	for _, name := range model.RemoveDuplicateStrings(emojiNames) {
+		if _, ok := model.GetSystemEmojiId(name); !ok {
+			names = append(names, name)
+		}
+	}
+
+	if len(names) == 0 {
+		return nil
+	}
+
+	emojis, err := a.GetMultipleEmojiByName(names)
+	if err != nil {
+		return err
+	}
+
+	if len(emojis) == 0 {
+		return nil
+	}
+
+	params := map[string]interface{}{"Name": emojis[0].Name}
+	if channelRestricted {
+		return model.NewAppError("checkCustomEmojiAllowed", "app.posting_restrictions.custom_emoji.channel.app_error", params, "channel_id="+channel.Id, http.StatusForbidden)
+	}
+
+	return model.NewAppError("checkCustomEmojiAllowed", "app.posting_restrictions.custom_emoji.team.app_error", params, "team_id="+team.Id, http.StatusForbidden)
+}
+
+// checkPostAllowedByRestrictions applies the channel and team posting restrictions to a user post.
+func (a *App) checkPostAllowedByRestrictions(post *model.Post, channel *model.Channel) *model.AppError {
+	if post.IsSystemMessage() {
+		return nil
+	}
+
+	if len(post.FileIds) > 0 {
+		if err := a.checkFileUploadsAllowed(channel); err != nil {
+			return err
+		}
+	}
+
+	return a.checkCustomEmojiAllowed(channel, getEmojiNamesForPost(post, nil))
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestPostingRestrictionsFileUploads(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	fileInfo, err := th.App.Srv().Store.FileInfo().Save(&model.FileInfo{
+		CreatorId: th.BasicUser.Id,
+		Path:      "file.txt",
+	})
+	require.Nil(t, err)
+
+	post := &model.Post{
+		UserId:    th.BasicUser.Id,
+		ChannelId: th.BasicChannel.Id,
+		Message:   "file attached",
+		FileIds:   []string{fileInfo.Id},
+	}
+
+	t.Run("channel restriction", func(t *testing.T) {
+		channel, appErr := th.App.UpdateChannelPostingRestrictions(th.BasicChannel, &model.PostingRestrictions{RestrictFileUploads: model.NewBool(true)})
+		require.Nil(t, appErr)
+		defer th.App.UpdateChannelPostingRestrictions(channel, &model.PostingRestrictions{RestrictFileUploads: model.NewBool(false)})
+
+		_, appErr = th.App.CreatePost(post.Clone(), channel, false, true)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.posting_restrictions.file_uploads.channel.app_error", appErr.Id)
+
+		_, appErr = th.App.UploadFile([]byte("data"), channel.Id, "file.txt")
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.posting_restrictions.file_uploads.channel.app_error", appErr.Id)
+	})
+
+	t.Run("team restriction", func(t *testing.T) {
+		_, appErr := th.App.UpdateTeamPostingRestrictions(th.BasicTeam.Id, &model.PostingRestrictions{RestrictFileUploads: model.NewBool(true)})
+		require.Nil(t, appErr)
+		defer th.App.UpdateTeamPostingRestrictions(th.BasicTeam.Id, &model.PostingRestrictions{RestrictFileUploads: model.NewBool(false)})
+
+		_, appErr = th.App.CreatePost(post.Clone(), th.BasicChannel, false, true)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.posting_restrictions.file_uploads.team.app_error", appErr.Id)
+	})
+
+	t.Run("posts without files are allowed", func(t *testing.T) {
+		channel, appErr := th.App.UpdateChannelPostingRestrictions(th.BasicChannel, &model.PostingRestrictions{RestrictFileUploads: model.NewBool(true)})
+		require.Nil(t, appErr)
+		defer th.App.UpdateChannelPostingRestrictions(channel, &model.PostingRestrictions{RestrictFileUploads: model.NewBool(false)})
+
+		_, appErr = th.App.CreatePost(&model.Post{UserId: th.BasicUser.Id, ChannelId: channel.Id, Message: "no files"}, channel, false, true)
+		require.Nil(t, appErr)
+	})
+}
+
+func TestPostingRestrictionsCustomEmoji(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableCustomEmoji = true })
+
+	emoji := th.CreateEmoji()
+	post := th.CreatePost(th.BasicChannel)
+
+	channel, appErr := th.App.UpdateChannelPostingRestrictions(th.BasicChannel, &model.PostingRestrictions{RestrictCustomEmoji: model.NewBool(true)})
+	require.Nil(t, appErr)
+
+	t.Run("should reject custom emoji in posts", func(t *testing.T) {
+		_, appErr := th.App.CreatePost(&model.Post{UserId: th.BasicUser.Id, ChannelId: channel.Id, Message: "hello :" + emoji.Name + ":"}, channel, false, true)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.posting_restrictions.custom_emoji.channel.app_error", appErr.Id)
+	})
+
+	t.Run("should reject custom emoji in edits", func(t *testing.T) {
+		edited := post.Clone()
+		edited.Message = ":" + emoji.Name + ":"
+		_, appErr := th.App.UpdatePost(edited, false)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.posting_restrictions.custom_emoji.channel.app_error", appErr.Id)
+	})
+
+	t.Run("should reject custom emoji reactions", func(t *testing.T) {
+		_, appErr := th.App.SaveReactionForPost(&model.Reaction{UserId: th.BasicUser.Id, PostId: post.Id, EmojiName: emoji.Name})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.posting_restrictions.custom_emoji.channel.app_error", appErr.Id)
+	})
+
+	t.Run("should allow system emoji", func(t *testing.T) {
+		_, appErr := th.App.CreatePost(&model.Post{UserId: th.BasicUser.Id, ChannelId: channel.Id, Message: "hello :smile:"}, channel, false, true)
+		require.Nil(t, appErr)
+
+		_, appErr = th.App.SaveReactionForPost(&model.Reaction{UserId: th.BasicUser.Id, PostId: post.Id, EmojiName: "smile"})
+		require.Nil(t, appErr)
+	})
+}
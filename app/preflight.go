@@ -0,0 +1,41 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/utils"
+)
+
+// PREFLIGHT_MIN_FREE_DISK_SPACE is the free space the local file store needs before the server
+// agrees to start.
+const PREFLIGHT_MIN_FREE_DISK_SPACE = 100 * 1024 * 1024
+
+// checkFileStoreDiskSpace refuses to start the server when the local file store is nearly full,
+// rather than letting uploads and exports fail part way through.
+func (s *Server) checkFileStoreDiskSpace() error {
+	settings := s.Config().FileSettings
+	if *settings.DriverName != model.IMAGE_DRIVER_LOCAL {
+		return nil
+	}
+
+	return checkFreeDiskSpace(*settings.Directory, PREFLIGHT_MIN_FREE_DISK_SPACE)
+}
+
+func checkFreeDiskSpace(directory string, minFree uint64) error {
+	free, err := utils.FreeDiskSpace(directory)
+	if err != nil {
+		mlog.Warn("Unable to check free disk space for the file store.", mlog.String("directory", directory), mlog.Err(err))
+		return nil
+	}
+
+	if free < minFree {
+		return errors.Errorf("only %d MB are free in the file store directory %s, at least %d MB are needed: free up space or point FileSettings.Directory at a larger volume", free/1024/1024, directory, minFree/1024/1024)
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"io/ioutil"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckFreeDiskSpace(t *testing.T) {
+	dir, err := ioutil.TempDir("", "preflight")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	assert.NoError(t, checkFreeDiskSpace(dir, 1))
+
+	err = checkFreeDiskSpace(dir, math.MaxUint64)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "FileSettings.Directory")
+
+	// A directory that can't be inspected is logged rather than treated as full.
+	assert.NoError(t, checkFreeDiskSpace(dir+"/missing", math.MaxUint64))
+}
@@ -0,0 +1,341 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+const PRESENCE_WEBHOOKS_LOAD_PAGE_SIZE = 100
+
+// presenceWebhookDispatcher holds the presence webhooks of the node along with the state needed to
+// debounce the status transitions of the watched users.
+type presenceWebhookDispatcher struct {
+	mutex  sync.Mutex
+	hooks  []*model.PresenceWebhook
+	loaded bool
+
+	// pending and timers are keyed by webhook and user, and hold the latest status of the
+	// transitions being debounced.
+	pending map[string]*model.Status
+	timers  map[string]*time.Timer
+	// settled is keyed by webhook and user, and holds the last status the user settled on.
+	settled map[string]string
+}
+
+type presenceWebhookFireFunc func(hook *model.PresenceWebhook, status *model.Status, previousStatus string)
+
+func newPresenceWebhookDispatcher() *presenceWebhookDispatcher {
+	return &presenceWebhookDispatcher{
+		pending: make(map[string]*model.Status),
+		timers:  make(map[string]*time.Timer),
+		settled: make(map[string]string),
+	}
+}
+
+func (d *presenceWebhookDispatcher) getHooks(ss store.Store) ([]*model.PresenceWebhook, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if d.loaded {
+		return d.hooks, nil
+	}
+
+	hooks := []*model.PresenceWebhook{}
+	for offset := 0; ; offset += PRESENCE_WEBHOOKS_LOAD_PAGE_SIZE {
+		page, err := ss.PresenceWebhook().GetAll(offset, PRESENCE_WEBHOOKS_LOAD_PAGE_SIZE)
+		if err != nil {
+			return nil, err
+		}
+		hooks = append(hooks, page...)
+		if len(page) < PRESENCE_WEBHOOKS_LOAD_PAGE_SIZE {
+			break
+		}
+	}
+
+	d.hooks = hooks
+	d.loaded = true
+
+	return d.hooks, nil
+}
+
+// invalidate forgets the loaded webhooks, along with the transitions being debounced for them.
+func (d *presenceWebhookDispatcher) invalidate() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.hooks = nil
+	d.loaded = false
+	d.stopTimersLocked()
+}
+
+func (d *presenceWebhookDispatcher) stop() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.stopTimersLocked()
+}
+
+func (d *presenceWebhookDispatcher) stopTimersLocked() {
+	for key, timer := range d.timers {
+		timer.Stop()
+		delete(d.timers, key)
+		delete(d.pending, key)
+	}
+}
+
+// statusChanged records a status transition of a user watched by the webhook. The webhook fires once
+// the transition has settled, unless the user is back to the status that was last delivered.
+func (d *presenceWebhookDispatcher) statusChanged(hook *model.PresenceWebhook, status *model.Status, fire presenceWebhookFireFunc) {
+	key := hook.Id + ":" + status.UserId
+
+	d.mutex.Lock()
+
+	if hook.DebounceSeconds <= 0 {
+		previousStatus, changed := d.settleLocked(key, status.Status)
+		d.mutex.Unlock()
+		if changed {
+			fire(hook, status, previousStatus)
+		}
+		return
+	}
+
+	if _, ok := d.pending[key]; !ok {
+		d.timers[key] = time.AfterFunc(time.Duration(hook.DebounceSeconds)*time.Second, func() {
+			d.flush(key, hook, fire)
+		})
+	}
+	d.pending[key] = status
+
+	d.mutex.Unlock()
+}
+
+func (d *presenceWebhookDispatcher) flush(key string, hook *model.PresenceWebhook, fire presenceWebhookFireFunc) {
+	d.mutex.Lock()
+
+	status, ok := d.pending[key]
+	if !ok {
+		d.mutex.Unlock()
+		return
+	}
+	delete(d.pending, key)
+	delete(d.timers, key)
+
+	previousStatus, changed := d.settleLocked(key, status.Status)
+	d.mutex.Unlock()
+
+	if changed {
+		fire(hook, status, previousStatus)
+	}
+}
+
+func (d *presenceWebhookDispatcher) settleLocked(key, status string) (string, bool) {
+	previousStatus := d.settled[key]
+	if previousStatus == status {
+		return previousStatus, false
+	}
+
+	d.settled[key] = status
+	return previousStatus, true
+}
+
+// triggerPresenceWebhooks hands the status transition of a user to the presence webhooks watching
+// them.
+func (a *App) triggerPresenceWebhooks(status *model.Status) {
+	if !*a.Config().ServiceSettings.EnablePresenceWebhooks {
+		return
+	}
+
+	hooks, err := a.Srv().presenceWebhooks.getHooks(a.Srv().Store)
+	if err != nil {
+		mlog.Error("Failed to load presence webhooks", mlog.Err(err))
+		return
+	}
+
+	statusCopy := *status
+	for _, hook := range hooks {
+		if hook.WatchesUser(status.UserId) {
+			a.Srv().presenceWebhooks.statusChanged(hook, &statusCopy, a.sendPresenceWebhook)
+		}
+	}
+}
+
+func (a *App) sendPresenceWebhook(hook *model.PresenceWebhook, status *model.Status, previousStatus string) {
+	if !hook.MatchesStatus(status.Status) {
+		return
+	}
+
+	payload := &model.PresenceWebhookPayload{
+		WebhookId:      hook.Id,
+		UserId:         status.UserId,
+		Status:         status.Status,
+		PreviousStatus: previousStatus,
+		Manual:         status.Manual,
+		Timestamp:      model.GetMillis(),
+	}
+	if user, err := a.GetUser(status.UserId); err == nil {
+		payload.Username = user.Username
+	}
+
+	a.Srv().webhookDeliveryQueue.EnqueueWithCallback(hook.CallbackURL, "application/json", []byte(payload.ToJson()), func(delivered bool) {
+		a.Srv().recordIntegrationUsage(hook.Id, model.INTEGRATION_USAGE_TYPE_PRESENCE_WEBHOOK, delivered)
+	})
+}
+
+func (a *App) invalidateCacheForPresenceWebhooks() {
+	a.invalidateCacheForPresenceWebhooksSkipClusterSend()
+
+	if a.Cluster() != nil {
+		msg := &model.ClusterMessage{
+			Event:    model.CLUSTER_EVENT_INVALIDATE_CACHE_FOR_PRESENCE_WEBHOOKS,
+			SendType: model.CLUSTER_SEND_RELIABLE,
+		}
+		a.Cluster().SendClusterMessage(msg)
+	}
+}
+
+func (a *App) invalidateCacheForPresenceWebhooksSkipClusterSend() {
+	a.Srv().presenceWebhooks.invalidate()
+}
+
+func (a *App) validatePresenceWebhookUsers(where string, hook *model.PresenceWebhook) *model.AppError {
+	if len(hook.UserIds) == 0 || len(hook.UserIds) > model.PRESENCE_WEBHOOK_USER_IDS_MAX {
+		// Rejected when validating the webhook itself.
+		return nil
+	}
+
+	users, err := a.Srv().Store.User().GetProfileByIds(hook.UserIds, &store.UserGetByIdsOpts{}, true)
+	if err != nil {
+		return err
+	}
+
+	if len(users) != len(hook.UserIds) {
+		return model.NewAppError(where, "app.presence_webhook.invalid_users.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (a *App) CreatePresenceWebhook(hook *model.PresenceWebhook, creatorId string) (*model.PresenceWebhook, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnablePresenceWebhooks {
+		return nil, model.NewAppError("CreatePresenceWebhook", "api.presence_webhook.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	hook.Id = ""
+	hook.CreatorId = creatorId
+	hook.DeleteAt = 0
+	hook.UserIds = model.RemoveDuplicateStrings(hook.UserIds)
+
+	if appErr := a.validatePresenceWebhookUsers("CreatePresenceWebhook", hook); appErr != nil {
+		return nil, appErr
+	}
+
+	savedHook, err := a.Srv().Store.PresenceWebhook().Save(hook)
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("CreatePresenceWebhook", "app.presence_webhook.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	a.invalidateCacheForPresenceWebhooks()
+
+	return savedHook, nil
+}
+
+func (a *App) UpdatePresenceWebhook(oldHook, updatedHook *model.PresenceWebhook) (*model.PresenceWebhook, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnablePresenceWebhooks {
+		return nil, model.NewAppError("UpdatePresenceWebhook", "api.presence_webhook.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	updatedHook.Id = oldHook.Id
+	updatedHook.CreatorId = oldHook.CreatorId
+	updatedHook.CreateAt = oldHook.CreateAt
+	updatedHook.DeleteAt = oldHook.DeleteAt
+	updatedHook.UserIds = model.RemoveDuplicateStrings(updatedHook.UserIds)
+
+	if appErr := a.validatePresenceWebhookUsers("UpdatePresenceWebhook", updatedHook); appErr != nil {
+		return nil, appErr
+	}
+
+	savedHook, err := a.Srv().Store.PresenceWebhook().Update(updatedHook)
+	if err != nil {
+		var appErr *model.AppError
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("UpdatePresenceWebhook", "app.presence_webhook.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("UpdatePresenceWebhook", "app.presence_webhook.update.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	a.invalidateCacheForPresenceWebhooks()
+
+	return savedHook, nil
+}
+
+func (a *App) GetPresenceWebhook(hookId string) (*model.PresenceWebhook, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnablePresenceWebhooks {
+		return nil, model.NewAppError("GetPresenceWebhook", "api.presence_webhook.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	hook, err := a.Srv().Store.PresenceWebhook().Get(hookId)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetPresenceWebhook", "app.presence_webhook.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetPresenceWebhook", "app.presence_webhook.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return hook, nil
+}
+
+func (a *App) GetPresenceWebhooksPage(page, perPage int) ([]*model.PresenceWebhook, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnablePresenceWebhooks {
+		return nil, model.NewAppError("GetPresenceWebhooksPage", "api.presence_webhook.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	hooks, err := a.Srv().Store.PresenceWebhook().GetAll(page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetPresenceWebhooksPage", "app.presence_webhook.get_all.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return hooks, nil
+}
+
+func (a *App) DeletePresenceWebhook(hookId string) *model.AppError {
+	if !*a.Config().ServiceSettings.EnablePresenceWebhooks {
+		return model.NewAppError("DeletePresenceWebhook", "api.presence_webhook.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	if err := a.Srv().Store.PresenceWebhook().Delete(hookId, model.GetMillis()); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("DeletePresenceWebhook", "app.presence_webhook.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return model.NewAppError("DeletePresenceWebhook", "app.presence_webhook.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	a.invalidateCacheForPresenceWebhooks()
+
+	return nil
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+type firedPresenceWebhook struct {
+	status         string
+	previousStatus string
+}
+
+type presenceWebhookRecorder struct {
+	mutex sync.Mutex
+	fired []firedPresenceWebhook
+}
+
+func (r *presenceWebhookRecorder) fire(hook *model.PresenceWebhook, status *model.Status, previousStatus string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.fired = append(r.fired, firedPresenceWebhook{status.Status, previousStatus})
+}
+
+func (r *presenceWebhookRecorder) get() []firedPresenceWebhook {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return append([]firedPresenceWebhook{}, r.fired...)
+}
+
+func TestPresenceWebhookDispatcher(t *testing.T) {
+	userId := model.NewId()
+	status := func(s string) *model.Status {
+		return &model.Status{UserId: userId, Status: s}
+	}
+
+	t.Run("without debounce", func(t *testing.T) {
+		d := newPresenceWebhookDispatcher()
+		defer d.stop()
+		r := &presenceWebhookRecorder{}
+		hook := &model.PresenceWebhook{Id: model.NewId(), UserIds: []string{userId}}
+
+		d.statusChanged(hook, status(model.STATUS_ONLINE), r.fire)
+		d.statusChanged(hook, status(model.STATUS_ONLINE), r.fire)
+		d.statusChanged(hook, status(model.STATUS_AWAY), r.fire)
+
+		assert.Equal(t, []firedPresenceWebhook{
+			{model.STATUS_ONLINE, ""},
+			{model.STATUS_AWAY, model.STATUS_ONLINE},
+		}, r.get())
+	})
+
+	t.Run("with debounce", func(t *testing.T) {
+		d := newPresenceWebhookDispatcher()
+		defer d.stop()
+		r := &presenceWebhookRecorder{}
+		hook := &model.PresenceWebhook{Id: model.NewId(), UserIds: []string{userId}, DebounceSeconds: 1}
+
+		d.statusChanged(hook, status(model.STATUS_ONLINE), r.fire)
+		d.statusChanged(hook, status(model.STATUS_AWAY), r.fire)
+		d.statusChanged(hook, status(model.STATUS_OFFLINE), r.fire)
+		assert.Empty(t, r.get())
+
+		require.Eventually(t, func() bool { return len(r.get()) == 1 }, 5*time.Second, 10*time.Millisecond)
+		assert.Equal(t, firedPresenceWebhook{model.STATUS_OFFLINE, ""}, r.get()[0])
+
+		// Flapping back to the delivered status within the window fires nothing.
+		d.statusChanged(hook, status(model.STATUS_ONLINE), r.fire)
+		d.statusChanged(hook, status(model.STATUS_OFFLINE), r.fire)
+		time.Sleep(1500 * time.Millisecond)
+		assert.Len(t, r.get(), 1)
+	})
+
+	t.Run("invalidate drops pending transitions", func(t *testing.T) {
+		d := newPresenceWebhookDispatcher()
+		defer d.stop()
+		r := &presenceWebhookRecorder{}
+		hook := &model.PresenceWebhook{Id: model.NewId(), UserIds: []string{userId}, DebounceSeconds: 1}
+
+		d.statusChanged(hook, status(model.STATUS_ONLINE), r.fire)
+		d.invalidate()
+		time.Sleep(1500 * time.Millisecond)
+		assert.Empty(t, r.get())
+	})
+}
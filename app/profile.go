@@ -0,0 +1,267 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+const (
+	PROFILE_MONITOR_INTERVAL = time.Minute
+	// PROFILE_MONITOR_MIN_REQUESTS is the number of requests needed since the last check for their
+	// latency to be considered, so that a few slow requests of an idle server don't trigger a capture.
+	PROFILE_MONITOR_MIN_REQUESTS = 20
+)
+
+// profiler captures the profiles of this node, either on demand or when the monitor finds the
+// server to be slow or to use too much memory. Only one profile is captured at a time.
+type profiler struct {
+	server *Server
+	stop   chan struct{}
+	done   chan struct{}
+
+	capturing int32
+	// lastAutomaticCapture is only accessed by the monitor.
+	lastAutomaticCapture time.Time
+
+	mutex           sync.Mutex
+	requestCount    int64
+	requestDuration time.Duration
+}
+
+func newProfiler(s *Server) *profiler {
+	return &profiler{
+		server: s,
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+}
+
+func (s *Server) startProfiler() {
+	s.profiler = newProfiler(s)
+	go s.profiler.run()
+}
+
+// stopProfiler stops the monitor and interrupts the CPU profile being captured, if any.
+func (s *Server) stopProfiler() {
+	if s.profiler != nil {
+		close(s.profiler.stop)
+		<-s.profiler.done
+	}
+}
+
+func (p *profiler) run() {
+	defer close(p.done)
+
+	ticker := time.NewTicker(PROFILE_MONITOR_INTERVAL)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+			p.check()
+		}
+	}
+}
+
+func (p *profiler) recordRequest(elapsed time.Duration) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.requestCount++
+	p.requestDuration += elapsed
+}
+
+// takeRequestLatency returns the number of requests and their average duration since the last
+// call.
+func (p *profiler) takeRequestLatency() (int64, time.Duration) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	count, duration := p.requestCount, p.requestDuration
+	p.requestCount = 0
+	p.requestDuration = 0
+
+	if count == 0 {
+		return 0, 0
+	}
+	return count, duration / time.Duration(count)
+}
+
+// thresholdBreached returns the type of the profile to capture and the reason for it, or empty
+// strings if the server is within the thresholds.
+func thresholdBreached(settings model.ProfilingSettings, heapAlloc uint64, requestCount int64, averageLatency time.Duration) (string, string) {
+	if heapAlloc > uint64(*settings.MemoryThresholdMB)*1024*1024 {
+		return model.PROFILE_TYPE_HEAP, model.PROFILE_CAPTURE_REASON_MEMORY
+	}
+
+	if requestCount >= PROFILE_MONITOR_MIN_REQUESTS && averageLatency > time.Duration(*settings.LatencyThresholdMilliseconds)*time.Millisecond {
+		return model.PROFILE_TYPE_CPU, model.PROFILE_CAPTURE_REASON_LATENCY
+	}
+
+	return "", ""
+}
+
+func (p *profiler) check() {
+	requestCount, averageLatency := p.takeRequestLatency()
+
+	settings := p.server.Config().ProfilingSettings
+	if !*settings.EnableAutomaticCapture {
+		return
+	}
+
+	if time.Since(p.lastAutomaticCapture) < time.Duration(*settings.MinimumCaptureIntervalMinutes)*time.Minute {
+		return
+	}
+
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+
+	profileType, reason := thresholdBreached(settings, memStats.HeapAlloc, requestCount, averageLatency)
+	if profileType == "" {
+		return
+	}
+
+	mlog.Warn("Profiling threshold exceeded, capturing a profile",
+		mlog.String("profile_type", profileType),
+		mlog.String("reason", reason),
+		mlog.Int64("heap_alloc", int64(memStats.HeapAlloc)),
+		mlog.Int64("request_count", requestCount),
+		mlog.Duration("average_latency", averageLatency))
+
+	job, err := New(ServerConnector(p.server)).CaptureProfile(profileType, 0, reason)
+	if err != nil {
+		mlog.Error("Failed to capture a profile", mlog.String("profile_type", profileType), mlog.Err(err))
+		return
+	}
+
+	p.lastAutomaticCapture = time.Now()
+	mlog.Info("Capturing a profile", mlog.String("job_id", job.Id), mlog.String("profile_type", profileType))
+}
+
+// RecordRequestDuration accounts for the duration of an API request in the latency monitored by
+// the profiler.
+func (s *Server) RecordRequestDuration(elapsed time.Duration) {
+	if s.profiler != nil {
+		s.profiler.recordRequest(elapsed)
+	}
+}
+
+// writeProfile writes the profile to w. A CPU profile samples the server for the duration, or
+// until stop is closed.
+func writeProfile(w io.Writer, profileType string, duration time.Duration, stop <-chan struct{}) error {
+	if profileType != model.PROFILE_TYPE_CPU {
+		return pprof.Lookup(profileType).WriteTo(w, 0)
+	}
+
+	if err := pprof.StartCPUProfile(w); err != nil {
+		return err
+	}
+
+	timer := time.NewTimer(duration)
+	select {
+	case <-timer.C:
+	case <-stop:
+		timer.Stop()
+	}
+
+	pprof.StopCPUProfile()
+	return nil
+}
+
+// CaptureProfile starts capturing a profile of this node, recorded by a profile capture job. The
+// profile is saved as the artifact of the job once captured, the job being returned right away.
+// seconds is the duration of a CPU profile, zero using ProfilingSettings.CPUProfileSeconds.
+func (a *App) CaptureProfile(profileType string, seconds int, reason string) (*model.Job, *model.AppError) {
+	request := &model.ProfileCaptureRequest{Type: profileType, Seconds: seconds}
+	if err := request.IsValid(); err != nil {
+		return nil, err
+	}
+
+	if seconds == 0 {
+		seconds = *a.Config().ProfilingSettings.CPUProfileSeconds
+	}
+
+	p := a.Srv().profiler
+	if p == nil || !atomic.CompareAndSwapInt32(&p.capturing, 0, 1) {
+		return nil, model.NewAppError("CaptureProfile", "app.profile.capture.in_progress.app_error", nil, "", http.StatusConflict)
+	}
+
+	data := map[string]string{
+		"profile_type": profileType,
+		"reason":       reason,
+	}
+	if profileType == model.PROFILE_TYPE_CPU {
+		data["seconds"] = strconv.Itoa(seconds)
+	}
+	if hostname, err := os.Hostname(); err == nil {
+		data["hostname"] = hostname
+	}
+
+	job, err := a.Srv().Jobs.CreateJob(model.JOB_TYPE_PROFILE_CAPTURE, data)
+	if err != nil {
+		atomic.StoreInt32(&p.capturing, 0)
+		return nil, err
+	}
+
+	// The profile is of this node, so the job is run here rather than by a job worker.
+	if _, err = a.Srv().Jobs.ClaimJob(job); err != nil {
+		atomic.StoreInt32(&p.capturing, 0)
+		return nil, err
+	}
+	job.Status = model.JOB_STATUS_IN_PROGRESS
+
+	// The capture records the artifact in the data of its own copy of the job.
+	jobCopy := *job
+	jobCopy.Data = make(map[string]string, len(job.Data))
+	for key, value := range job.Data {
+		jobCopy.Data[key] = value
+	}
+	a.Srv().Go(func() {
+		defer atomic.StoreInt32(&p.capturing, 0)
+		a.runProfileCapture(&jobCopy, profileType, time.Duration(seconds)*time.Second)
+	})
+
+	return job, nil
+}
+
+func (a *App) runProfileCapture(job *model.Job, profileType string, duration time.Duration) {
+	var buf bytes.Buffer
+	if err := writeProfile(&buf, profileType, duration, a.Srv().profiler.stop); err != nil {
+		a.setProfileCaptureError(job, model.NewAppError("runProfileCapture", "app.profile.capture.app_error", nil, err.Error(), http.StatusInternalServerError))
+		return
+	}
+
+	name := fmt.Sprintf("%s-%s.pprof", profileType, time.Now().UTC().Format("20060102-150405"))
+	if err := a.SaveJobArtifact(job, name, &buf); err != nil {
+		a.setProfileCaptureError(job, err)
+		return
+	}
+
+	if err := a.Srv().Jobs.SetJobSuccess(job); err != nil {
+		mlog.Error("Failed to set success for the profile capture job", mlog.String("job_id", job.Id), mlog.Err(err))
+	}
+}
+
+func (a *App) setProfileCaptureError(job *model.Job, appErr *model.AppError) {
+	mlog.Error("Failed to capture a profile", mlog.String("job_id", job.Id), mlog.Err(appErr))
+	if err := a.Srv().Jobs.SetJobError(job, appErr); err != nil {
+		mlog.Error("Failed to set the error of the profile capture job", mlog.String("job_id", job.Id), mlog.Err(err))
+	}
+}
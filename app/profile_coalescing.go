@@ -0,0 +1,131 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+const (
+	PROFILES_CACHE_SIZE = 20000
+	// PROFILES_CACHE_EXPIRY keeps the profiles just long enough to absorb the bursts of requests for
+	// the same users, as when many clients react to the same websocket event.
+	PROFILES_CACHE_EXPIRY = 5 * time.Second
+
+	UNKNOWN_STATUS_CACHE_SIZE   = 20000
+	UNKNOWN_STATUS_CACHE_EXPIRY = 30 * time.Second
+)
+
+// getProfilesByIdsCoalesced returns the profiles of the given users, reading the recently fetched
+// ones from a short-lived cache and sharing the store read of the others with the concurrent
+// requests for the same users. The returned profiles are copies the caller may modify.
+func (a *App) getProfilesByIdsCoalesced(userIds []string) ([]*model.User, *model.AppError) {
+	metrics := a.Metrics()
+
+	users := make([]*model.User, 0, len(userIds))
+	missingUserIds := []string{}
+	for _, userId := range userIds {
+		var user *model.User
+		if err := a.Srv().profilesCache.Get(userId, &user); err == nil {
+			users = append(users, user)
+		} else {
+			missingUserIds = append(missingUserIds, userId)
+		}
+	}
+
+	if metrics != nil {
+		metrics.AddMemCacheHitCounter("Profiles", float64(len(users)))
+		metrics.AddMemCacheMissCounter("Profiles", float64(len(missingUserIds)))
+	}
+
+	if len(missingUserIds) == 0 {
+		return users, nil
+	}
+
+	value, err, _ := a.Srv().profileRequests.Do(coalescingKey("profiles", missingUserIds), func() (interface{}, *model.AppError) {
+		generation := atomic.LoadUint32(&a.Srv().profilesCacheGeneration)
+
+		fetched, err := a.Srv().Store.User().GetProfileByIds(missingUserIds, &store.UserGetByIdsOpts{}, true)
+		if err != nil {
+			return nil, err
+		}
+
+		// Don't cache profiles that may have been updated while being fetched.
+		if atomic.LoadUint32(&a.Srv().profilesCacheGeneration) == generation {
+			for _, user := range fetched {
+				a.Srv().profilesCache.SetWithDefaultExpiry(user.Id, user)
+			}
+		}
+
+		return fetched, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range value.([]*model.User) {
+		users = append(users, user.DeepCopy())
+	}
+
+	return users, nil
+}
+
+// invalidateProfileCacheForUser drops the cached profile of the user.
+func (s *Server) invalidateProfileCacheForUser(userId string) {
+	atomic.AddUint32(&s.profilesCacheGeneration, 1)
+	s.profilesCache.Remove(userId)
+	s.unknownStatusCache.Remove(userId)
+}
+
+// getStatusesByIdsCoalesced reads the statuses of the given users from the store, sharing the read
+// with the concurrent requests for the same users. The users recently found without a status are
+// skipped, as they are reported offline anyway until they set one.
+func (a *App) getStatusesByIdsCoalesced(userIds []string) ([]*model.Status, *model.AppError) {
+	missingUserIds := []string{}
+	for _, userId := range userIds {
+		var unknown bool
+		if err := a.Srv().unknownStatusCache.Get(userId, &unknown); err != nil {
+			missingUserIds = append(missingUserIds, userId)
+		}
+	}
+
+	if len(missingUserIds) == 0 {
+		return []*model.Status{}, nil
+	}
+
+	value, err, _ := a.Srv().statusRequests.Do(coalescingKey("statuses", missingUserIds), func() (interface{}, *model.AppError) {
+		statuses, err := a.Srv().Store.Status().GetByIds(missingUserIds)
+		if err != nil {
+			return nil, err
+		}
+
+		found := make(map[string]bool, len(statuses))
+		for _, status := range statuses {
+			found[status.UserId] = true
+		}
+		for _, userId := range missingUserIds {
+			if !found[userId] {
+				a.Srv().unknownStatusCache.SetWithDefaultExpiry(userId, true)
+			}
+		}
+
+		return statuses, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	shared := value.([]*model.Status)
+	statuses := make([]*model.Status, 0, len(shared))
+	for _, status := range shared {
+		statusCopy := *status
+		statuses = append(statuses, &statusCopy)
+	}
+
+	return statuses, nil
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestProfilerRequestLatency(t *testing.T) {
+	p := newProfiler(nil)
+
+	count, average := p.takeRequestLatency()
+	assert.Equal(t, int64(0), count)
+	assert.Equal(t, time.Duration(0), average)
+
+	p.recordRequest(100 * time.Millisecond)
+	p.recordRequest(300 * time.Millisecond)
+
+	count, average = p.takeRequestLatency()
+	assert.Equal(t, int64(2), count)
+	assert.Equal(t, 200*time.Millisecond, average)
+
+	count, _ = p.takeRequestLatency()
+	assert.Equal(t, int64(0), count, "the latency is reset once taken")
+}
+
+func TestThresholdBreached(t *testing.T) {
+	settings := model.ProfilingSettings{}
+	settings.SetDefaults()
+	*settings.MemoryThresholdMB = 100
+	*settings.LatencyThresholdMilliseconds = 500
+
+	profileType, reason := thresholdBreached(settings, 50*1024*1024, PROFILE_MONITOR_MIN_REQUESTS, 100*time.Millisecond)
+	assert.Empty(t, profileType)
+	assert.Empty(t, reason)
+
+	profileType, reason = thresholdBreached(settings, 200*1024*1024, PROFILE_MONITOR_MIN_REQUESTS, time.Second)
+	assert.Equal(t, model.PROFILE_TYPE_HEAP, profileType)
+	assert.Equal(t, model.PROFILE_CAPTURE_REASON_MEMORY, reason)
+
+	profileType, reason = thresholdBreached(settings, 50*1024*1024, PROFILE_MONITOR_MIN_REQUESTS, time.Second)
+	assert.Equal(t, model.PROFILE_TYPE_CPU, profileType)
+	assert.Equal(t, model.PROFILE_CAPTURE_REASON_LATENCY, reason)
+
+	profileType, _ = thresholdBreached(settings, 50*1024*1024, PROFILE_MONITOR_MIN_REQUESTS-1, time.Second)
+	assert.Empty(t, profileType, "too few requests to consider their latency")
+}
+
+func TestWriteProfile(t *testing.T) {
+	for _, profileType := range []string{model.PROFILE_TYPE_HEAP, model.PROFILE_TYPE_GOROUTINE} {
+		var buf bytes.Buffer
+		require.NoError(t, writeProfile(&buf, profileType, 0, nil))
+		assert.NotZero(t, buf.Len(), profileType)
+	}
+
+	t.Run("cpu profile stops early", func(t *testing.T) {
+		stop := make(chan struct{})
+		close(stop)
+
+		var buf bytes.Buffer
+		require.NoError(t, writeProfile(&buf, model.PROFILE_TYPE_CPU, time.Hour, stop))
+		assert.NotZero(t, buf.Len())
+	})
+}
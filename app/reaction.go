@@ -37,6 +37,14 @@ func (a *App) SaveReactionForPost(reaction *model.Reaction) (*model.Reaction, *m
 		}
 	}
 
+	if err = a.checkCustomEmojiAllowed(channel, []string{reaction.EmojiName}); err != nil {
+		return nil, err
+	}
+
+	if err = a.checkTeamEmojiAllowed(channel, reaction.EmojiName); err != nil {
+		return nil, err
+	}
+
 	reaction, nErr := a.Srv().Store.Reaction().Save(reaction)
 	if nErr != nil {
 		var appErr *model.AppError
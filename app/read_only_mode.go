@@ -0,0 +1,118 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+const (
+	READ_ONLY_MODE_PROBE_INTERVAL = 5 * time.Second
+)
+
+// ReadOnlyMode is the state of a server whose database refuses writes, as it briefly does during
+// a failover. The server enters it as soon as the store reports a write refused because the
+// database is read-only, rejects the writes of the clients while in it, and probes the database
+// until it accepts writes again.
+type ReadOnlyMode struct {
+	readOnly int32 // protected via atomic for fast IsReadOnly calls
+
+	server *Server
+
+	mutex   sync.Mutex
+	stop    chan struct{}
+	stopped chan struct{}
+}
+
+func NewReadOnlyMode(server *Server) *ReadOnlyMode {
+	return &ReadOnlyMode{server: server}
+}
+
+// IsReadOnly returns true if the server is in read-only mode.
+func (r *ReadOnlyMode) IsReadOnly() bool {
+	if r == nil {
+		return false
+	}
+	return atomic.LoadInt32(&r.readOnly) != 0
+}
+
+// Enter puts the server in read-only mode because of err, and starts probing the database.
+func (r *ReadOnlyMode) Enter(err error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if !atomic.CompareAndSwapInt32(&r.readOnly, 0, 1) {
+		return
+	}
+
+	mlog.Warn("The database is read-only, the server is entering read-only mode.", mlog.Err(err))
+	r.publish(true)
+
+	r.stop = make(chan struct{})
+	r.stopped = make(chan struct{})
+	go r.probe(r.stop, r.stopped)
+}
+
+func (r *ReadOnlyMode) probe(stop, stopped chan struct{}) {
+	defer close(stopped)
+
+	ticker := time.NewTicker(READ_ONLY_MODE_PROBE_INTERVAL)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			readOnly, err := r.server.Store.System().IsDatabaseReadOnly()
+			if err != nil {
+				mlog.Warn("Unable to check if the database is still read-only.", mlog.Err(err))
+				continue
+			}
+			if !readOnly {
+				r.exit()
+				return
+			}
+		}
+	}
+}
+
+func (r *ReadOnlyMode) exit() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if !atomic.CompareAndSwapInt32(&r.readOnly, 1, 0) {
+		return
+	}
+
+	mlog.Info("The database accepts writes again, the server is leaving read-only mode.")
+	r.publish(false)
+	r.stop = nil
+}
+
+func (r *ReadOnlyMode) publish(readOnly bool) {
+	message := model.NewWebSocketEvent(model.WEBSOCKET_EVENT_READ_ONLY_MODE_CHANGED, "", "", "", nil)
+	message.Add("read_only", readOnly)
+	r.server.Go(func() {
+		r.server.Publish(message)
+	})
+}
+
+// Close stops probing the database.
+func (r *ReadOnlyMode) Close() {
+	r.mutex.Lock()
+	stop, stopped := r.stop, r.stopped
+	r.stop = nil
+	r.mutex.Unlock()
+
+	if stop != nil {
+		close(stop)
+		<-stopped
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadOnlyMode(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	readOnly := th.Server.ReadOnly
+	require.False(t, readOnly.IsReadOnly())
+
+	readOnly.Enter(errors.New("cannot execute INSERT in a read-only transaction"))
+	assert.True(t, readOnly.IsReadOnly())
+
+	// Entering again while in read-only mode is a no-op.
+	readOnly.Enter(errors.New("cannot execute INSERT in a read-only transaction"))
+	assert.True(t, readOnly.IsReadOnly())
+
+	// The test database accepts writes, so the first probe takes the server out of read-only mode.
+	require.Eventually(t, func() bool { return !readOnly.IsReadOnly() }, 3*READ_ONLY_MODE_PROBE_INTERVAL, 100*time.Millisecond)
+
+	var nilReadOnly *ReadOnlyMode
+	assert.False(t, nilReadOnly.IsReadOnly())
+}
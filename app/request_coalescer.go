@@ -0,0 +1,68 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+type coalescedRequest struct {
+	wg    sync.WaitGroup
+	value interface{}
+	err   *model.AppError
+}
+
+// requestCoalescer makes the concurrent callers of a request with the same key share a single
+// execution of it, so that bursts of identical reads hit the store once.
+type requestCoalescer struct {
+	mutex    sync.Mutex
+	requests map[string]*coalescedRequest
+}
+
+func newRequestCoalescer() *requestCoalescer {
+	return &requestCoalescer{
+		requests: make(map[string]*coalescedRequest),
+	}
+}
+
+// Do executes fn, unless a request with the same key is already in flight, in which case it waits
+// for that request and returns its result instead. The returned value is shared by the callers, who
+// must not modify it. shared reports whether the result came from another caller's request.
+func (c *requestCoalescer) Do(key string, fn func() (interface{}, *model.AppError)) (value interface{}, err *model.AppError, shared bool) {
+	c.mutex.Lock()
+	if request, ok := c.requests[key]; ok {
+		c.mutex.Unlock()
+		request.wg.Wait()
+		return request.value, request.err, true
+	}
+
+	request := &coalescedRequest{}
+	request.wg.Add(1)
+	c.requests[key] = request
+	c.mutex.Unlock()
+
+	defer func() {
+		c.mutex.Lock()
+		delete(c.requests, key)
+		c.mutex.Unlock()
+		request.wg.Done()
+	}()
+
+	request.value, request.err = fn()
+
+	return request.value, request.err, false
+}
+
+// coalescingKey builds a request key from ids, independently of their order.
+func coalescingKey(prefix string, ids []string) string {
+	sorted := make([]string, len(ids))
+	copy(sorted, ids)
+	sort.Strings(sorted)
+
+	return prefix + ":" + strings.Join(sorted, ",")
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestRequestCoalescer(t *testing.T) {
+	t.Run("concurrent requests with the same key share one execution", func(t *testing.T) {
+		coalescer := newRequestCoalescer()
+
+		var executions int32
+		var startOnce sync.Once
+		release := make(chan struct{})
+		started := make(chan struct{})
+
+		var wg sync.WaitGroup
+		results := make([]interface{}, 5)
+		for i := range results {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				value, err, _ := coalescer.Do("key", func() (interface{}, *model.AppError) {
+					atomic.AddInt32(&executions, 1)
+					startOnce.Do(func() { close(started) })
+					<-release
+					return "value", nil
+				})
+				require.Nil(t, err)
+				results[i] = value
+			}(i)
+
+			if i == 0 {
+				<-started
+			}
+		}
+
+		// Give the other callers time to join the request in flight.
+		time.Sleep(100 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		assert.Equal(t, int32(1), atomic.LoadInt32(&executions))
+		for _, result := range results {
+			assert.Equal(t, "value", result)
+		}
+		assert.Empty(t, coalescer.requests)
+	})
+
+	t.Run("requests are executed again once completed", func(t *testing.T) {
+		coalescer := newRequestCoalescer()
+
+		var executions int
+		for i := 0; i < 2; i++ {
+			_, err, shared := coalescer.Do("key", func() (interface{}, *model.AppError) {
+				executions++
+				return nil, model.NewAppError("test", "test", nil, "", http.StatusInternalServerError)
+			})
+			require.NotNil(t, err)
+			assert.False(t, shared)
+		}
+		assert.Equal(t, 2, executions)
+	})
+}
+
+func TestCoalescingKey(t *testing.T) {
+	assert.Equal(t, coalescingKey("profiles", []string{"b", "a"}), coalescingKey("profiles", []string{"a", "b"}))
+	assert.NotEqual(t, coalescingKey("profiles", []string{"a"}), coalescingKey("statuses", []string{"a"}))
+
+	ids := []string{"b", "a"}
+	coalescingKey("profiles", ids)
+	assert.Equal(t, []string{"b", "a"}, ids)
+}
+
+func TestGetProfilesByIdsCoalesced(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	users, err := th.App.getProfilesByIdsCoalesced([]string{th.BasicUser.Id, th.BasicUser2.Id})
+	require.Nil(t, err)
+	require.Len(t, users, 2)
+
+	// The returned profiles are copies.
+	users[0].Nickname = "changed"
+	users, err = th.App.getProfilesByIdsCoalesced([]string{th.BasicUser.Id, th.BasicUser2.Id})
+	require.Nil(t, err)
+	for _, user := range users {
+		assert.NotEqual(t, "changed", user.Nickname)
+	}
+
+	// Updating a user invalidates the cached profile.
+	user := th.BasicUser.DeepCopy()
+	user.Nickname = model.NewId()
+	_, err = th.App.UpdateUser(user, false)
+	require.Nil(t, err)
+
+	users, err = th.App.getProfilesByIdsCoalesced([]string{th.BasicUser.Id})
+	require.Nil(t, err)
+	require.Len(t, users, 1)
+	assert.Equal(t, user.Nickname, users[0].Nickname)
+}
+
+func TestGetStatusesByIdsCoalesced(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	userId := model.NewId()
+	statuses, err := th.App.getStatusesByIdsCoalesced([]string{userId})
+	require.Nil(t, err)
+	assert.Empty(t, statuses)
+
+	var unknown bool
+	require.NoError(t, th.App.Srv().unknownStatusCache.Get(userId, &unknown))
+
+	th.App.SetStatusOnline(th.BasicUser.Id, true)
+	statusMap, err := th.App.GetStatusesByIds([]string{th.BasicUser.Id, userId})
+	require.Nil(t, err)
+	assert.Equal(t, model.STATUS_ONLINE, statusMap[th.BasicUser.Id])
+	assert.Equal(t, model.STATUS_OFFLINE, statusMap[userId])
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+func (a *App) checkRetentionPolicyLicense(where string) *model.AppError {
+	license := a.Srv().License()
+	if license == nil || !*license.Features.DataRetention {
+		return model.NewAppError(where, "ent.data_retention.generic.license.error", nil, "", http.StatusNotImplemented)
+	}
+
+	return nil
+}
+
+func (a *App) GetRetentionPolicies(page, perPage int) ([]*model.RetentionPolicy, *model.AppError) {
+	if appErr := a.checkRetentionPolicyLicense("GetRetentionPolicies"); appErr != nil {
+		return nil, appErr
+	}
+
+	policies, err := a.Srv().Store.RetentionPolicy().GetAll(page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetRetentionPolicies", "app.retention_policy.get_all.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return policies, nil
+}
+
+func (a *App) GetRetentionPolicy(policyId string) (*model.RetentionPolicy, *model.AppError) {
+	if appErr := a.checkRetentionPolicyLicense("GetRetentionPolicy"); appErr != nil {
+		return nil, appErr
+	}
+
+	policy, err := a.Srv().Store.RetentionPolicy().Get(policyId)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetRetentionPolicy", "app.retention_policy.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetRetentionPolicy", "app.retention_policy.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return policy, nil
+}
+
+func (a *App) CreateRetentionPolicy(policy *model.RetentionPolicy) (*model.RetentionPolicy, *model.AppError) {
+	if appErr := a.checkRetentionPolicyLicense("CreateRetentionPolicy"); appErr != nil {
+		return nil, appErr
+	}
+
+	if appErr := a.validateRetentionPolicyTargets(policy); appErr != nil {
+		return nil, appErr
+	}
+
+	policy.Id = ""
+	savedPolicy, err := a.Srv().Store.RetentionPolicy().Save(policy)
+	if err != nil {
+		return nil, retentionPolicySaveError("CreateRetentionPolicy", err)
+	}
+
+	return savedPolicy, nil
+}
+
+func (a *App) UpdateRetentionPolicy(policy *model.RetentionPolicy) (*model.RetentionPolicy, *model.AppError) {
+	oldPolicy, appErr := a.GetRetentionPolicy(policy.Id)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if appErr = a.validateRetentionPolicyTargets(policy); appErr != nil {
+		return nil, appErr
+	}
+
+	policy.CreateAt = oldPolicy.CreateAt
+	updatedPolicy, err := a.Srv().Store.RetentionPolicy().Update(policy)
+	if err != nil {
+		return nil, retentionPolicySaveError("UpdateRetentionPolicy", err)
+	}
+
+	return updatedPolicy, nil
+}
+
+func (a *App) DeleteRetentionPolicy(policyId string) *model.AppError {
+	if appErr := a.checkRetentionPolicyLicense("DeleteRetentionPolicy"); appErr != nil {
+		return appErr
+	}
+
+	if err := a.Srv().Store.RetentionPolicy().Delete(policyId); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("DeleteRetentionPolicy", "app.retention_policy.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return model.NewAppError("DeleteRetentionPolicy", "app.retention_policy.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return nil
+}
+
+// GetTeamsForRetentionPolicy returns a page of the teams governed by the policy.
+func (a *App) GetTeamsForRetentionPolicy(policyId string, page, perPage int) ([]*model.Team, *model.AppError) {
+	if _, appErr := a.GetRetentionPolicy(policyId); appErr != nil {
+		return nil, appErr
+	}
+
+	teams, err := a.Srv().Store.Team().GetTeamsForPolicyPage(a.Context(), policyId, page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetTeamsForRetentionPolicy", "app.retention_policy.get_teams.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return teams, nil
+}
+
+// GetRetentionPolicyForTeam returns the policy governing the team, failing with a 404 when the team
+// follows the global data retention settings.
+func (a *App) GetRetentionPolicyForTeam(teamId string) (*model.RetentionPolicy, *model.AppError) {
+	if appErr := a.checkRetentionPolicyLicense("GetRetentionPolicyForTeam"); appErr != nil {
+		return nil, appErr
+	}
+
+	policy, err := a.Srv().Store.Team().GetPolicyForTeam(a.Context(), teamId)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetRetentionPolicyForTeam", "app.retention_policy.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetRetentionPolicyForTeam", "app.retention_policy.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return policy, nil
+}
+
+// SetRetentionPolicyForTeam makes the policy govern the team, in place of the policy that governed
+// it before. An empty policyId leaves the team to the global data retention settings.
+func (a *App) SetRetentionPolicyForTeam(teamId, policyId string) *model.AppError {
+	if appErr := a.checkRetentionPolicyLicense("SetRetentionPolicyForTeam"); appErr != nil {
+		return appErr
+	}
+
+	if _, appErr := a.GetTeam(teamId); appErr != nil {
+		return appErr
+	}
+
+	if err := a.Srv().Store.Team().SetPolicy(a.Context(), teamId, policyId); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("SetRetentionPolicyForTeam", "app.retention_policy.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return model.NewAppError("SetRetentionPolicyForTeam", "app.retention_policy.set_for_team.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return nil
+}
+
+func retentionPolicySaveError(where string, err error) *model.AppError {
+	var appErr *model.AppError
+	var nfErr *store.ErrNotFound
+	var conflictErr *store.ErrConflict
+	switch {
+	case errors.As(err, &appErr):
+		return appErr
+	case errors.As(err, &nfErr):
+		return model.NewAppError(where, "app.retention_policy.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+	case errors.As(err, &conflictErr):
+		return model.NewAppError(where, "app.retention_policy.save.conflict.app_error", nil, conflictErr.Error(), http.StatusBadRequest)
+	default:
+		return model.NewAppError(where, "app.retention_policy.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// validateRetentionPolicyTargets makes sure every team and channel listed in the policy exists.
+func (a *App) validateRetentionPolicyTargets(policy *model.RetentionPolicy) *model.AppError {
+	for _, teamId := range model.RemoveDuplicateStrings(policy.TeamIds) {
+		if _, appErr := a.GetTeam(teamId); appErr != nil {
+			return model.NewAppError("validateRetentionPolicyTargets", "app.retention_policy.invalid_team.app_error", nil, "team_id="+teamId, http.StatusBadRequest)
+		}
+	}
+
+	channelIds := model.RemoveDuplicateStrings(policy.ChannelIds)
+	if len(channelIds) == 0 {
+		return nil
+	}
+
+	channels, appErr := a.Srv().Store.Channel().GetChannelsByIds(channelIds, true)
+	if appErr != nil {
+		return appErr
+	}
+	if len(channels) != len(channelIds) {
+		return model.NewAppError("validateRetentionPolicyTargets", "app.retention_policy.invalid_channel.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
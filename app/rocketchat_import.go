@@ -0,0 +1,350 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/utils"
+)
+
+// The files of a Rocket.Chat export, as written by mongoexport for each collection of the database.
+// The uploaded files are read from the uploads directory, named after the id of their upload.
+const (
+	rocketChatUsersFile         = "users.json"
+	rocketChatRoomsFile         = "rocketchat_room.json"
+	rocketChatSubscriptionsFile = "rocketchat_subscription.json"
+	rocketChatMessagesFile      = "rocketchat_message.json"
+	rocketChatUploadsDir        = "uploads"
+)
+
+var rocketChatMentionRegexp = regexp.MustCompile(`@([A-Za-z0-9\.\-_]+)`)
+
+// rocketChatTime is a time of a Rocket.Chat export in milliseconds. mongoexport writes dates either
+// as {"$date": ...} holding an ISO 8601 string, milliseconds or {"$numberLong": ...}, or directly
+// as an ISO 8601 string.
+type rocketChatTime int64
+
+func (t *rocketChatTime) UnmarshalJSON(data []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	if wrapper, ok := value.(map[string]interface{}); ok {
+		if date, ok := wrapper["$date"]; ok {
+			value = date
+		}
+		if wrapper, ok := value.(map[string]interface{}); ok {
+			value = wrapper["$numberLong"]
+		}
+	}
+
+	switch v := value.(type) {
+	case float64:
+		*t = rocketChatTime(v)
+	case string:
+		if millis, err := strconv.ParseInt(v, 10, 64); err == nil {
+			*t = rocketChatTime(millis)
+			return nil
+		}
+		parsed, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return err
+		}
+		*t = rocketChatTime(parsed.UnixNano() / int64(time.Millisecond))
+	case nil:
+		*t = 0
+	default:
+		return fmt.Errorf("unsupported date %s", string(data))
+	}
+
+	return nil
+}
+
+type rocketChatUser struct {
+	Id       string `json:"_id"`
+	Username string `json:"username"`
+	Name     string `json:"name"`
+	Emails   []struct {
+		Address string `json:"address"`
+	} `json:"emails"`
+	Roles  []string `json:"roles"`
+	Active *bool    `json:"active"`
+	Type   string   `json:"type"`
+}
+
+type rocketChatRoom struct {
+	Id          string   `json:"_id"`
+	Type        string   `json:"t"`
+	Name        string   `json:"name"`
+	FName       string   `json:"fname"`
+	Topic       string   `json:"topic"`
+	Description string   `json:"description"`
+	UserIds     []string `json:"uids"`
+	Usernames   []string `json:"usernames"`
+}
+
+type rocketChatSubscription struct {
+	RoomId string `json:"rid"`
+	User   struct {
+		Id string `json:"_id"`
+	} `json:"u"`
+}
+
+type rocketChatFile struct {
+	Id   string `json:"_id"`
+	Name string `json:"name"`
+}
+
+type rocketChatMessage struct {
+	Id     string         `json:"_id"`
+	RoomId string         `json:"rid"`
+	Msg    string         `json:"msg"`
+	Ts     rocketChatTime `json:"ts"`
+	User   struct {
+		Id       string `json:"_id"`
+		Username string `json:"username"`
+	} `json:"u"`
+	Type      string           `json:"t"`
+	ThreadId  string           `json:"tmid"`
+	File      *rocketChatFile  `json:"file"`
+	Files     []rocketChatFile `json:"files"`
+	Reactions map[string]struct {
+		Usernames []string `json:"usernames"`
+	} `json:"reactions"`
+}
+
+// ConvertRocketChatExport converts the Rocket.Chat export in exportDir into a bulk import file for
+// the given team, written to outputDir along with the attached files and the mapping report.
+// Public and private rooms become channels, direct rooms direct channels, and threads replies.
+func ConvertRocketChatExport(exportDir, team, outputDir string) (*ChatImportReport, error) {
+	c, err := newChatImportConverter("rocketchat", team, outputDir)
+	if err != nil {
+		return nil, err
+	}
+
+	// Usernames are kept by Rocket.Chat messages, to mention and react. They are mapped to the ones
+	// the users are imported as.
+	usernames := map[string]string{}
+	userIdsByUsername := map[string]string{}
+
+	err = readChatImportJson(filepath.Join(exportDir, rocketChatUsersFile), func(data json.RawMessage) error {
+		var user rocketChatUser
+		if err := json.Unmarshal(data, &user); err != nil {
+			return errors.Wrap(err, "failed to decode a user")
+		}
+
+		if user.Username == "" {
+			c.note("user", user.Id, "no username, skipped")
+			return nil
+		}
+
+		email := ""
+		if len(user.Emails) > 0 {
+			email = user.Emails[0].Address
+		}
+
+		firstName, lastName := user.Name, ""
+		if i := strings.Index(user.Name, " "); i != -1 {
+			firstName, lastName = user.Name[:i], user.Name[i+1:]
+		}
+
+		if user.Type == "bot" {
+			c.note("user", user.Id, "bot imported as a user")
+		}
+
+		deactivated := user.Active != nil && !*user.Active
+		usernames[user.Username] = c.addUser(user.Id, user.Username, email, firstName, lastName, utils.StringInSlice("admin", user.Roles), deactivated)
+		userIdsByUsername[user.Username] = user.Id
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read the users")
+	}
+
+	channelNames := map[string]string{}
+	directMembers := map[string][]string{}
+
+	err = readChatImportJson(filepath.Join(exportDir, rocketChatRoomsFile), func(data json.RawMessage) error {
+		var room rocketChatRoom
+		if err := json.Unmarshal(data, &room); err != nil {
+			return errors.Wrap(err, "failed to decode a room")
+		}
+
+		switch room.Type {
+		case "c", "p":
+			channelType := model.CHANNEL_OPEN
+			if room.Type == "p" {
+				channelType = model.CHANNEL_PRIVATE
+			}
+			channelNames[room.Id] = c.addChannel(room.Id, room.Name, room.FName, channelType, room.Topic, room.Description)
+		case "d":
+			memberIds := room.UserIds
+			if len(memberIds) == 0 {
+				for _, username := range room.Usernames {
+					memberIds = append(memberIds, userIdsByUsername[username])
+				}
+			}
+			if members := c.addDirectChannel(room.Id, memberIds); members != nil {
+				directMembers[room.Id] = members
+			}
+		default:
+			c.note("room", room.Id, fmt.Sprintf("rooms of type %q are not supported, skipped", room.Type))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read the rooms")
+	}
+
+	err = readChatImportJson(filepath.Join(exportDir, rocketChatSubscriptionsFile), func(data json.RawMessage) error {
+		var subscription rocketChatSubscription
+		if err := json.Unmarshal(data, &subscription); err != nil {
+			return errors.Wrap(err, "failed to decode a subscription")
+		}
+
+		if channelName, ok := channelNames[subscription.RoomId]; ok {
+			c.joinChannel(subscription.User.Id, channelName)
+		}
+		return nil
+	})
+	if err != nil && !os.IsNotExist(errors.Cause(err)) {
+		return nil, errors.Wrap(err, "failed to read the subscriptions")
+	}
+
+	threads := map[string][]*chatImportMessage{}
+	messagesById := map[string]*chatImportMessage{}
+	threadIds := map[string]string{}
+	roomIds := map[string]string{}
+	systemMessages := 0
+
+	err = readChatImportJson(filepath.Join(exportDir, rocketChatMessagesFile), func(data json.RawMessage) error {
+		var message rocketChatMessage
+		if err := json.Unmarshal(data, &message); err != nil {
+			return errors.Wrap(err, "failed to decode a message")
+		}
+
+		if message.Type != "" {
+			systemMessages++
+			return nil
+		}
+
+		_, isChannel := channelNames[message.RoomId]
+		_, isDirect := directMembers[message.RoomId]
+		if !isChannel && !isDirect {
+			c.note("message", message.Id, "room not imported, skipped")
+			return nil
+		}
+
+		user := c.username(message.User.Id)
+		if user == "" {
+			c.note("message", message.Id, "author not imported, skipped")
+			return nil
+		}
+
+		if message.Ts <= 0 {
+			c.note("message", message.Id, "no time, skipped")
+			return nil
+		}
+
+		if isChannel {
+			c.joinChannel(message.User.Id, channelNames[message.RoomId])
+		}
+
+		converted := &chatImportMessage{
+			sourceId: message.Id,
+			user:     user,
+			message:  convertRocketChatMentions(message.Msg, usernames),
+			createAt: int64(message.Ts),
+		}
+
+		for emoji, reaction := range message.Reactions {
+			for _, username := range reaction.Usernames {
+				if reactor, ok := usernames[username]; ok {
+					converted.reactions = append(converted.reactions, ReactionImportData{
+						User:      model.NewString(reactor),
+						EmojiName: model.NewString(strings.Trim(emoji, ":")),
+					})
+				}
+			}
+		}
+
+		files := message.Files
+		if len(files) == 0 && message.File != nil {
+			files = []rocketChatFile{*message.File}
+		}
+		for _, file := range files {
+			if attachment := c.addAttachment(file.Id, filepath.Join(exportDir, rocketChatUploadsDir, file.Id), file.Name); attachment != nil {
+				converted.attachments = append(converted.attachments, *attachment)
+			}
+		}
+
+		messagesById[message.Id] = converted
+		if message.ThreadId != "" {
+			threadIds[message.Id] = message.ThreadId
+		} else {
+			threads[message.RoomId] = append(threads[message.RoomId], converted)
+		}
+
+		roomIds[message.Id] = message.RoomId
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read the messages")
+	}
+
+	for messageId, threadId := range threadIds {
+		reply := messagesById[messageId]
+		if root, ok := messagesById[threadId]; ok {
+			root.replies = append(root.replies, reply)
+			continue
+		}
+
+		c.note("message", messageId, "thread not found, imported as a message")
+		threads[roomIds[messageId]] = append(threads[roomIds[messageId]], reply)
+	}
+
+	if systemMessages > 0 {
+		c.note("message", "", fmt.Sprintf("%d system messages skipped", systemMessages))
+	}
+
+	roomIdsWithThreads := make([]string, 0, len(threads))
+	for roomId := range threads {
+		roomIdsWithThreads = append(roomIdsWithThreads, roomId)
+	}
+	sort.Strings(roomIdsWithThreads)
+
+	for _, roomId := range roomIdsWithThreads {
+		c.addThreads(channelNames[roomId], directMembers[roomId], threads[roomId])
+	}
+
+	if err := c.write(); err != nil {
+		return nil, err
+	}
+
+	return c.report, nil
+}
+
+// convertRocketChatMentions rewrites the mentions of a message to the usernames the mentioned
+// users are imported as.
+func convertRocketChatMentions(message string, usernames map[string]string) string {
+	return rocketChatMentionRegexp.ReplaceAllStringFunc(message, func(mention string) string {
+		if username, ok := usernames[mention[1:]]; ok {
+			return "@" + username
+		}
+		return mention
+	})
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+const (
+	expiredRoleGrantsBatchSize = 100
+)
+
+func checkRoleGrantExpiry(where string, expiresAt int64) *model.AppError {
+	if expiresAt < 0 || (expiresAt > 0 && expiresAt <= model.GetMillis()) {
+		return model.NewAppError(where, "app.role.expires_at.app_error", nil, "", http.StatusBadRequest)
+	}
+	return nil
+}
+
+// RemoveExpiredRoleGrants takes away the system, team and channel roles whose grants have expired, and
+// lets each grantee know by email. Expired roles already stop granting permissions when they are
+// resolved; this cleans them out of the stored roles, the sessions and the caches.
+func (a *App) RemoveExpiredRoleGrants() *model.AppError {
+	now := model.GetMillis()
+
+	if err := a.removeExpiredSystemRoles(now); err != nil {
+		return err
+	}
+
+	if err := a.removeExpiredTeamRoles(now); err != nil {
+		return err
+	}
+
+	return a.removeExpiredChannelRoles(now)
+}
+
+func (a *App) removeExpiredSystemRoles(now int64) *model.AppError {
+	for {
+		users, err := a.Srv().Store.User().GetUsersWithExpiredRoles(now, expiredRoleGrantsBatchSize)
+		if err != nil {
+			return err
+		}
+
+		removed := 0
+		for _, user := range users {
+			newRoles := user.GetRawRoles()
+			if _, err := a.UpdateUserRolesWithExpiry(user.Id, newRoles, 0, true); err != nil {
+				mlog.Error("Failed to remove the expired system roles of the user.", mlog.String("user_id", user.Id), mlog.Err(err))
+				continue
+			}
+			removed++
+
+			expiredRoles := model.RemoveRoles(strings.Fields(user.Roles), strings.Fields(newRoles))
+			a.sendRoleGrantExpiredEmail(user.Id, expiredRoles, model.RoleScopeSystem, "", user.RolesExpiresAt)
+		}
+
+		if len(users) < expiredRoleGrantsBatchSize || removed == 0 {
+			return nil
+		}
+	}
+}
+
+func (a *App) removeExpiredTeamRoles(now int64) *model.AppError {
+	for {
+		members, err := a.Srv().Store.Team().GetMembersWithExpiredRoles(a.Context(), now, expiredRoleGrantsBatchSize)
+		if err != nil {
+			return model.NewAppError("removeExpiredTeamRoles", "app.team.get_members_with_expired_roles.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+
+		removed := 0
+		for _, member := range members {
+			if _, err := a.UpdateTeamMemberRolesWithExpiry(member.TeamId, member.UserId, strings.Join(member.GetRoles(), " "), 0); err != nil {
+				mlog.Error("Failed to remove the expired roles of the team member.", mlog.String("team_id", member.TeamId), mlog.String("user_id", member.UserId), mlog.Err(err))
+				continue
+			}
+			removed++
+
+			teamName := member.TeamId
+			if team, err := a.GetTeam(member.TeamId); err == nil {
+				teamName = team.DisplayName
+			}
+			a.sendRoleGrantExpiredEmail(member.UserId, strings.Fields(member.ExplicitRoles), model.RoleScopeTeam, teamName, member.ExplicitRolesExpiresAt)
+		}
+
+		if len(members) < expiredRoleGrantsBatchSize || removed == 0 {
+			return nil
+		}
+	}
+}
+
+func (a *App) removeExpiredChannelRoles(now int64) *model.AppError {
+	for {
+		members, err := a.Srv().Store.Channel().GetMembersWithExpiredRoles(now, expiredRoleGrantsBatchSize)
+		if err != nil {
+			return err
+		}
+
+		removed := 0
+		for _, member := range members {
+			if _, err := a.UpdateChannelMemberRolesWithExpiry(member.ChannelId, member.UserId, strings.Join(member.GetRoles(), " "), 0); err != nil {
+				mlog.Error("Failed to remove the expired roles of the channel member.", mlog.String("channel_id", member.ChannelId), mlog.String("user_id", member.UserId), mlog.Err(err))
+				continue
+			}
+			removed++
+
+			channelName := member.ChannelId
+			if channel, err := a.GetChannel(member.ChannelId); err == nil {
+				channelName = channel.DisplayName
+			}
+			a.sendRoleGrantExpiredEmail(member.UserId, strings.Fields(member.ExplicitRoles), model.RoleScopeChannel, channelName, member.ExplicitRolesExpiresAt)
+		}
+
+		if len(members) < expiredRoleGrantsBatchSize || removed == 0 {
+			return nil
+		}
+	}
+}
+
+func (a *App) sendRoleGrantExpiredEmail(userId string, roles []string, scope model.RoleScope, scopeName string, expiredAt int64) {
+	if len(roles) == 0 {
+		return
+	}
+
+	user, err := a.GetUser(userId)
+	if err != nil {
+		mlog.Warn("Failed to get the user to notify of their expired roles.", mlog.String("user_id", userId), mlog.Err(err))
+		return
+	}
+
+	if user.Email == "" || user.IsBot {
+		return
+	}
+
+	a.Srv().Go(func() {
+		if err := a.Srv().EmailService.SendRoleGrantExpiredEmail(user.Email, user.Locale, a.GetSiteURL(), roles, scope, scopeName, expiredAt); err != nil {
+			mlog.Error("Failed to send the expired roles email.", mlog.String("user_id", userId), mlog.Err(err))
+		}
+	})
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestUpdateRolesWithExpiry(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	expiresAt := model.GetMillis() + 60*60*1000
+
+	t.Run("team member", func(t *testing.T) {
+		member, err := th.App.UpdateTeamMemberRolesWithExpiry(th.BasicTeam.Id, th.BasicUser.Id, "team_user team_post_all", expiresAt)
+		require.Nil(t, err)
+		assert.Equal(t, "team_post_all", member.ExplicitRoles)
+		assert.Equal(t, expiresAt, member.ExplicitRolesExpiresAt)
+
+		member, err = th.App.UpdateTeamMemberRoles(th.BasicTeam.Id, th.BasicUser.Id, "team_user team_post_all")
+		require.Nil(t, err)
+		assert.Equal(t, int64(0), member.ExplicitRolesExpiresAt)
+
+		_, err = th.App.UpdateTeamMemberRolesWithExpiry(th.BasicTeam.Id, th.BasicUser.Id, "team_user team_post_all", model.GetMillis()-1000)
+		require.NotNil(t, err)
+		assert.Equal(t, http.StatusBadRequest, err.StatusCode)
+	})
+
+	t.Run("no expiry without explicit roles", func(t *testing.T) {
+		member, err := th.App.UpdateChannelMemberRolesWithExpiry(th.BasicChannel.Id, th.BasicUser.Id, "channel_user", expiresAt)
+		require.Nil(t, err)
+		assert.Equal(t, "", member.ExplicitRoles)
+		assert.Equal(t, int64(0), member.ExplicitRolesExpiresAt)
+	})
+
+	t.Run("system roles", func(t *testing.T) {
+		user, err := th.App.UpdateUserRolesWithExpiry(th.BasicUser2.Id, "system_user system_user_manager", expiresAt, false)
+		require.Nil(t, err)
+		assert.Equal(t, expiresAt, user.RolesExpiresAt)
+
+		user, err = th.App.UpdateUserRolesWithExpiry(th.BasicUser2.Id, "system_user", expiresAt, false)
+		require.Nil(t, err)
+		assert.Equal(t, int64(0), user.RolesExpiresAt)
+	})
+}
+
+func TestExpiredSystemRoleGrantInSession(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	session, err := th.App.CreateSession(&model.Session{UserId: th.BasicUser2.Id, Roles: th.BasicUser2.GetRawRoles()})
+	require.Nil(t, err)
+	assert.False(t, th.App.SessionHasPermissionTo(*session, model.PERMISSION_MANAGE_SYSTEM))
+
+	expiresAt := model.GetMillis() + 1000
+	_, err = th.App.UpdateUserRolesWithExpiry(th.BasicUser2.Id, "system_user system_admin", expiresAt, false)
+	require.Nil(t, err)
+
+	session, err = th.App.GetSession(session.Token)
+	require.Nil(t, err)
+	assert.Equal(t, expiresAt, session.GetRolesExpiresAt())
+	assert.True(t, th.App.SessionHasPermissionTo(*session, model.PERMISSION_MANAGE_SYSTEM))
+
+	// The grant is denied as soon as it expires, without waiting for the role expiry job.
+	time.Sleep(time.Until(time.Unix(0, expiresAt*int64(time.Millisecond))) + 100*time.Millisecond)
+
+	session, err = th.App.GetSession(session.Token)
+	require.Nil(t, err)
+	assert.Contains(t, session.Roles, model.SYSTEM_ADMIN_ROLE_ID)
+	assert.False(t, th.App.SessionHasPermissionTo(*session, model.PERMISSION_MANAGE_SYSTEM))
+	assert.Equal(t, []string{model.SYSTEM_USER_ROLE_ID}, session.GetUserRoles())
+}
+
+func TestRemoveExpiredRoleGrants(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	expiredAt := model.GetMillis() - 1000
+
+	user := th.CreateUser()
+	user.Roles = "system_user system_user_manager"
+	user.RolesExpiresAt = expiredAt
+	_, err := th.App.Srv().Store.User().Update(user, true)
+	require.Nil(t, err)
+	th.App.InvalidateCacheForUser(user.Id)
+
+	teamMember, err := th.App.GetTeamMember(th.BasicTeam.Id, th.BasicUser.Id)
+	require.Nil(t, err)
+	teamMember.ExplicitRoles = "team_post_all"
+	teamMember.ExplicitRolesExpiresAt = expiredAt
+	_, err = th.App.Srv().Store.Team().UpdateMember(context.Background(), teamMember)
+	require.Nil(t, err)
+
+	channelMember, err := th.App.GetChannelMember(th.BasicChannel.Id, th.BasicUser.Id)
+	require.Nil(t, err)
+	channelMember.ExplicitRoles = "custom_role"
+	channelMember.ExplicitRolesExpiresAt = expiredAt
+	_, err = th.App.Srv().Store.Channel().UpdateMember(channelMember)
+	require.Nil(t, err)
+
+	require.Nil(t, th.App.RemoveExpiredRoleGrants())
+
+	user, err = th.App.GetUser(user.Id)
+	require.Nil(t, err)
+	assert.Equal(t, "system_user", user.Roles)
+	assert.Equal(t, int64(0), user.RolesExpiresAt)
+
+	teamMember, err = th.App.GetTeamMember(th.BasicTeam.Id, th.BasicUser.Id)
+	require.Nil(t, err)
+	assert.Equal(t, "", teamMember.ExplicitRoles)
+	assert.Equal(t, int64(0), teamMember.ExplicitRolesExpiresAt)
+	assert.True(t, teamMember.SchemeUser)
+
+	channelMember, err = th.App.GetChannelMember(th.BasicChannel.Id, th.BasicUser.Id)
+	require.Nil(t, err)
+	assert.Equal(t, "", channelMember.ExplicitRoles)
+	assert.Equal(t, int64(0), channelMember.ExplicitRolesExpiresAt)
+	assert.True(t, channelMember.SchemeUser)
+}
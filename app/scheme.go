@@ -169,7 +169,7 @@ func (a *App) GetTeamsForScheme(scheme *model.Scheme, offset int, limit int) ([]
 		return nil, err
 	}
 
-	teams, err := a.Srv().Store.Team().GetTeamsByScheme(scheme.Id, offset, limit)
+	teams, err := a.Srv().Store.Team().GetTeamsByScheme(a.Context(), scheme.Id, offset, limit)
 	if err != nil {
 		return nil, err
 	}
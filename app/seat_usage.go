@@ -0,0 +1,75 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"strconv"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+const (
+	SEAT_USAGE_NOTIFICATION_INTERVAL = 7 * model.SEAT_USAGE_DAY_MILLIS
+)
+
+// GetSeatUsageForecast returns the licensed seats used over the given number of days, today
+// included, and the day the seat count of the license is projected to be exceeded.
+func (a *App) GetSeatUsageForecast(days int) (*model.SeatUsageForecast, *model.AppError) {
+	now := model.GetMillis()
+	history, err := a.Srv().Store.User().AnalyticsSeatCountsByDay(now-int64(days-1)*model.SEAT_USAGE_DAY_MILLIS, now, *a.Config().ServiceSettings.ExcludeServiceAccountsFromSeatCount)
+	if err != nil {
+		return nil, err
+	}
+
+	var licensedSeats int64
+	if license := a.Srv().License(); license != nil && license.Features.Users != nil {
+		licensedSeats = int64(*license.Features.Users)
+	}
+
+	return model.NewSeatUsageForecast(history, licensedSeats), nil
+}
+
+// NotifySeatUsageForecast emails the system admins when the seat count of the license is exceeded,
+// or projected to be within ServiceSettings.SeatUsageNotificationDays. Admins are notified at most
+// once a week.
+func (a *App) NotifySeatUsageForecast() *model.AppError {
+	if a.Srv().License() == nil {
+		return nil
+	}
+
+	forecast, err := a.GetSeatUsageForecast(model.SEAT_USAGE_DEFAULT_DAYS)
+	if err != nil {
+		return err
+	}
+
+	now := model.GetMillis()
+	horizon := now + int64(*a.Config().ServiceSettings.SeatUsageNotificationDays)*model.SEAT_USAGE_DAY_MILLIS
+	if !forecast.IsProjectedWithin(horizon) {
+		return nil
+	}
+
+	if system, appErr := a.Srv().Store.System().GetByName(model.SYSTEM_LAST_SEAT_USAGE_NOTIFICATION); appErr == nil {
+		if lastNotified, _ := strconv.ParseInt(system.Value, 10, 64); now-lastNotified < SEAT_USAGE_NOTIFICATION_INTERVAL {
+			return nil
+		}
+	}
+
+	users, err := a.Srv().Store.User().GetSystemAdminProfiles()
+	if err != nil {
+		return err
+	}
+
+	for _, user := range users {
+		if user.Email == "" {
+			continue
+		}
+
+		if err := a.Srv().EmailService.SendSeatUsageForecastEmail(user.Email, user.Locale, a.GetSiteURL(), forecast); err != nil {
+			mlog.Error("Failed to send the seat usage forecast email.", mlog.String("user_id", user.Id), mlog.Err(err))
+		}
+	}
+
+	return a.Srv().Store.System().SaveOrUpdate(&model.System{Name: model.SYSTEM_LAST_SEAT_USAGE_NOTIFICATION, Value: strconv.FormatInt(now, 10)})
+}
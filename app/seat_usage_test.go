@@ -0,0 +1,61 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestNotifySeatUsageForecast(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.Srv().Store.System().PermanentDeleteByName(model.SYSTEM_LAST_SEAT_USAGE_NOTIFICATION)
+
+	t.Run("no license", func(t *testing.T) {
+		require.Nil(t, th.App.NotifySeatUsageForecast())
+
+		_, err := th.App.Srv().Store.System().GetByName(model.SYSTEM_LAST_SEAT_USAGE_NOTIFICATION)
+		require.NotNil(t, err)
+	})
+
+	t.Run("seats available", func(t *testing.T) {
+		license := model.NewTestLicense()
+		license.Features.Users = model.NewInt(100000)
+		th.App.Srv().SetLicense(license)
+		defer th.App.Srv().SetLicense(nil)
+
+		require.Nil(t, th.App.NotifySeatUsageForecast())
+
+		_, err := th.App.Srv().Store.System().GetByName(model.SYSTEM_LAST_SEAT_USAGE_NOTIFICATION)
+		require.NotNil(t, err)
+	})
+
+	t.Run("seats exceeded", func(t *testing.T) {
+		license := model.NewTestLicense()
+		license.Features.Users = model.NewInt(1)
+		th.App.Srv().SetLicense(license)
+		defer th.App.Srv().SetLicense(nil)
+
+		require.Nil(t, th.App.NotifySeatUsageForecast())
+
+		system, err := th.App.Srv().Store.System().GetByName(model.SYSTEM_LAST_SEAT_USAGE_NOTIFICATION)
+		require.Nil(t, err)
+		notifiedAt, _ := strconv.ParseInt(system.Value, 10, 64)
+		assert.True(t, notifiedAt > 0)
+
+		// Admins are not notified again within the week.
+		require.Nil(t, th.App.NotifySeatUsageForecast())
+
+		system, err = th.App.Srv().Store.System().GetByName(model.SYSTEM_LAST_SEAT_USAGE_NOTIFICATION)
+		require.Nil(t, err)
+		assert.Equal(t, strconv.FormatInt(notifiedAt, 10), system.Value)
+	})
+}
@@ -4,6 +4,7 @@
 package app
 
 import (
+	"context"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -76,7 +77,7 @@ func (s *Server) DoSecurityUpdateCheck() {
 			v.Set(PROP_SECURITY_ACTIVE_USER_COUNT, strconv.FormatInt(ucr, 10))
 		}
 
-		if teamCount, err := s.Store.Team().AnalyticsTeamCount(false); err == nil {
+		if teamCount, err := s.Store.Team().AnalyticsTeamCount(context.Background(), false); err == nil {
 			v.Set(PROP_SECURITY_TEAM_COUNT, strconv.FormatInt(teamCount, 10))
 		}
 
@@ -45,6 +45,7 @@ import (
 	"github.com/mattermost/mattermost-server/v5/services/timezones"
 	"github.com/mattermost/mattermost-server/v5/services/tracing"
 	"github.com/mattermost/mattermost-server/v5/store"
+	"github.com/mattermost/mattermost-server/v5/store/auditlayer"
 	"github.com/mattermost/mattermost-server/v5/store/localcachelayer"
 	"github.com/mattermost/mattermost-server/v5/store/searchlayer"
 	"github.com/mattermost/mattermost-server/v5/store/sqlstore"
@@ -74,6 +75,7 @@ type Server struct {
 	ListenAddr  *net.TCPAddr
 	RateLimiter *RateLimiter
 	Busy        *Busy
+	ReadOnly    *ReadOnlyMode
 
 	localModeServer *http.Server
 
@@ -107,31 +109,60 @@ type Server struct {
 
 	newStore func() store.Store
 
-	htmlTemplateWatcher     *utils.HTMLTemplateWatcher
-	sessionCache            cache.Cache
-	seenPendingPostIdsCache cache.Cache
-	statusCache             cache.Cache
-	configListenerId        string
-	licenseListenerId       string
-	logListenerId           string
-	clusterLeaderListenerId string
-	searchConfigListenerId  string
-	searchLicenseListenerId string
-	configStore             config.Store
-	asymmetricSigningKey    *ecdsa.PrivateKey
-	postActionCookieSecret  []byte
+	htmlTemplateWatcher      *utils.HTMLTemplateWatcher
+	sessionCache             cache.Cache
+	seenPendingPostIdsCache  cache.Cache
+	statusCache              cache.Cache
+	termsOfServiceCache      cache.Cache
+	teamDirectoryCache       cache.Cache
+	commandDynamicListCache  cache.Cache
+	profilesCache            cache.Cache
+	unknownStatusCache       cache.Cache
+	presenceWebhooks         *presenceWebhookDispatcher
+	webhookDeliveryQueue     *WebhookDeliveryQueue
+	eventStreamDispatcher    *eventStreamDispatcher
+	outboxRelay              *outboxRelay
+	integrationUsageRecorder *integrationUsageRecorder
+	lastActivityBuffer       *lastActivityBuffer
+	configListenerId         string
+	licenseListenerId        string
+	logListenerId            string
+	clusterLeaderListenerId  string
+	searchConfigListenerId   string
+	searchLicenseListenerId  string
+	configStore              config.Store
+	asymmetricSigningKey     *ecdsa.PrivateKey
+	postActionCookieSecret   []byte
 
 	advancedLogListenerCleanup func()
 
 	pluginCommands     []*PluginCommand
 	pluginCommandsLock sync.RWMutex
 
+	// commandAutocompleteRefreshes holds the ids of the commands whose autocomplete data is being
+	// refreshed.
+	commandAutocompleteRefreshes sync.Map
+
+	// profileRequests and statusRequests coalesce the concurrent store reads of the same profiles
+	// and statuses.
+	profileRequests *requestCoalescer
+	statusRequests  *requestCoalescer
+	// profilesCacheGeneration is incremented whenever a cached profile is invalidated.
+	profilesCacheGeneration uint32
+
+	// debugCaptures holds the debug capture rules of this node and the requests they captured.
+	debugCaptures *debugCaptureRecorder
+
+	// profiler captures the profiles of this node and monitors the thresholds triggering them.
+	profiler *profiler
+
 	clientConfig        atomic.Value
 	clientConfigHash    atomic.Value
 	limitedClientConfig atomic.Value
 
-	diagnosticId string
-	rudderClient rudder.Client
+	diagnosticId       string
+	diagnosticsSink    DiagnosticsSink
+	diagnosticsSinkURL string
 
 	phase2PermissionsMigrationComplete bool
 
@@ -153,6 +184,7 @@ type Server struct {
 	Cluster          einterfaces.ClusterInterface
 	Compliance       einterfaces.ComplianceInterface
 	DataRetention    einterfaces.DataRetentionInterface
+	EventStream      einterfaces.EventStreamInterface
 	Ldap             einterfaces.LdapInterface
 	MessageExport    einterfaces.MessageExportInterface
 	Metrics          einterfaces.MetricsInterface
@@ -257,15 +289,43 @@ func NewServer(options ...Option) (*Server, error) {
 	s.statusCache = s.CacheProvider.NewCache(&cache.CacheOptions{
 		Size: model.STATUS_CACHE_SIZE,
 	})
+	s.termsOfServiceCache = s.CacheProvider.NewCache(&cache.CacheOptions{
+		Size:          TERMS_OF_SERVICE_POLICY_CACHE_SIZE,
+		DefaultExpiry: TERMS_OF_SERVICE_POLICY_CACHE_EXPIRY,
+	})
+	s.teamDirectoryCache = s.CacheProvider.NewCache(&cache.CacheOptions{
+		Size:          TEAM_DIRECTORY_CACHE_SIZE,
+		DefaultExpiry: TEAM_DIRECTORY_CACHE_EXPIRY,
+	})
+	s.commandDynamicListCache = s.CacheProvider.NewCache(&cache.CacheOptions{
+		Size:          COMMAND_DYNAMIC_LIST_CACHE_SIZE,
+		DefaultExpiry: COMMAND_DYNAMIC_LIST_CACHE_EXPIRY,
+	})
+	s.profilesCache = s.CacheProvider.NewCache(&cache.CacheOptions{
+		Size:          PROFILES_CACHE_SIZE,
+		DefaultExpiry: PROFILES_CACHE_EXPIRY,
+	})
+	s.unknownStatusCache = s.CacheProvider.NewCache(&cache.CacheOptions{
+		Size:          UNKNOWN_STATUS_CACHE_SIZE,
+		DefaultExpiry: UNKNOWN_STATUS_CACHE_EXPIRY,
+	})
+	s.profileRequests = newRequestCoalescer()
+	s.statusRequests = newRequestCoalescer()
+	s.debugCaptures = newDebugCaptureRecorder(DEBUG_CAPTURE_BUFFER_SIZE)
 
 	s.createPushNotificationsHub()
+	s.createWebhookDeliveryQueue()
+	s.presenceWebhooks = newPresenceWebhookDispatcher()
 
 	if err := utils.InitTranslations(s.Config().LocalizationSettings); err != nil {
 		return nil, errors.Wrapf(err, "unable to load Mattermost translation files")
 	}
 
+	s.setEntityIdGenerator()
+
 	s.configListenerId = s.AddConfigListener(func(_, _ *model.Config) {
 		s.configOrLicenseListener()
+		s.setEntityIdGenerator()
 
 		message := model.NewWebSocketEvent(model.WEBSOCKET_EVENT_CONFIG_CHANGED, "", "", "", nil)
 
@@ -287,12 +347,23 @@ func NewServer(options ...Option) (*Server, error) {
 
 	s.initEnterprise()
 
+	s.ReadOnly = NewReadOnlyMode(s)
+
 	if s.newStore == nil {
 		s.newStore = func() store.Store {
 			s.sqlStore = sqlstore.NewSqlSupplier(s.Config().SqlSettings, s.Metrics)
+
+			var baseStore store.Store = s.sqlStore
+			if *s.Config().ExperimentalSettings.EnableStoreFaultInjection {
+				mlog.Warn("Store fault injection is enabled. Calls to the database will be delayed or fail on purpose.")
+				baseStore = store.NewChaosLayer(s.sqlStore, s.storeChaosSettings)
+			}
+			baseStore = store.NewReadOnlyLayer(baseStore, s.ReadOnly.Enter)
+			baseStore = auditlayer.NewAuditLayer(baseStore, New(ServerConnector(s)))
+
 			searchStore := searchlayer.NewSearchLayer(
 				localcachelayer.NewLocalCacheLayer(
-					s.sqlStore,
+					baseStore,
 					s.Metrics,
 					s.Cluster,
 					s.CacheProvider,
@@ -336,6 +407,11 @@ func NewServer(options ...Option) (*Server, error) {
 	}
 
 	s.initJobs()
+	s.startEventStreamDispatcher()
+	s.startOutboxRelay()
+	s.startIntegrationUsageRecorder()
+	s.startLastActivityBuffer()
+	s.startProfiler()
 
 	if s.joinCluster && s.Cluster != nil {
 		s.Cluster.StartInterNodeCommunication()
@@ -408,6 +484,10 @@ func NewServer(options ...Option) (*Server, error) {
 		mlog.Error("Problem with file storage settings", mlog.Err(appErr))
 	}
 
+	if err = s.checkFileStoreDiskSpace(); err != nil {
+		return nil, errors.Wrap(err, "file store pre-flight check failed")
+	}
+
 	model.AppErrorInit(utils.T)
 
 	s.timezones = timezones.New()
@@ -514,6 +594,9 @@ func (s *Server) RunJobs() {
 		s.Go(func() {
 			runCommandWebhookCleanupJob(s)
 		})
+		s.Go(func() {
+			runLoginHistoryCleanupJob(s)
+		})
 
 		if complianceI := s.Compliance; complianceI != nil {
 			complianceI.StartComplianceDailyJob()
@@ -638,6 +721,13 @@ func (s *Server) Shutdown() error {
 
 	s.HubStop()
 	s.StopPushNotificationsHubWorkers()
+	s.presenceWebhooks.stop()
+	s.stopWebhookDeliveryQueue()
+	s.stopEventStreamDispatcher()
+	s.stopOutboxRelay()
+	s.stopIntegrationUsageRecorder()
+	s.stopLastActivityBuffer()
+	s.stopProfiler()
 	s.ShutDownPlugins()
 	s.RemoveLicenseListener(s.licenseListenerId)
 	s.RemoveClusterLeaderChangedListener(s.clusterLeaderListenerId)
@@ -655,6 +745,7 @@ func (s *Server) Shutdown() error {
 
 	s.StopHTTPServer()
 	s.stopLocalModeServer()
+	s.ReadOnly.Close()
 
 	s.WaitForGoroutines()
 
@@ -1079,6 +1170,13 @@ func runSessionCleanupJob(s *Server) {
 	}, time.Hour*24)
 }
 
+func runLoginHistoryCleanupJob(s *Server) {
+	s.doLoginHistoryCleanup()
+	model.CreateRecurringTask("Login History Cleanup", func() {
+		s.doLoginHistoryCleanup()
+	}, time.Hour*24)
+}
+
 func runLicenseExpirationCheckJob(a *App) {
 	doLicenseExpirationCheck(a)
 	model.CreateRecurringTask("License Expiration Check", func() {
@@ -1231,34 +1329,61 @@ func (s *Server) stopSearchEngine() {
 	}
 }
 
-// initDiagnostics initialises the Rudder client for the diagnostics system.
+// initDiagnostics initialises the sink of the diagnostics system, which is the Rudder client
+// unless the admin routes the diagnostics to their own endpoint.
 func (s *Server) initDiagnostics(endpoint string) {
-	if s.rudderClient == nil {
-		config := rudder.Config{}
-		config.Logger = rudder.StdLogger(s.Log.StdLog(mlog.String("source", "rudder")))
-		config.Endpoint = endpoint
-		// For testing
-		if endpoint != RUDDER_DATAPLANE_URL {
-			config.Verbose = true
-			config.BatchSize = 1
+	sinkURL := *s.Config().LogSettings.DiagnosticsSinkURL
+	if s.diagnosticsSink != nil && s.diagnosticsSinkURL == sinkURL {
+		return
+	}
+
+	if s.diagnosticsSink != nil {
+		if err := s.diagnosticsSink.Close(); err != nil {
+			mlog.Warn("Failed to close the diagnostics sink", mlog.Err(err))
 		}
-		client, err := rudder.NewWithConfig(RUDDER_KEY, endpoint, config)
-		if err != nil {
-			mlog.Error("Failed to create Rudder instance", mlog.Err(err))
-			return
+		s.diagnosticsSink = nil
+	}
+
+	if sinkURL != "" {
+		s.diagnosticsSink = &httpDiagnosticsSink{
+			url:          sinkURL,
+			diagnosticId: s.diagnosticId,
+			categories: func() []string {
+				return s.Config().LogSettings.DiagnosticsCategories
+			},
+			client: func() *http.Client {
+				return s.HTTPService.MakeClient(true)
+			},
 		}
-		client.Enqueue(rudder.Identify{
-			UserId: s.diagnosticId,
-		})
+		s.diagnosticsSinkURL = sinkURL
+		return
+	}
 
-		s.rudderClient = client
+	config := rudder.Config{}
+	config.Logger = rudder.StdLogger(s.Log.StdLog(mlog.String("source", "rudder")))
+	config.Endpoint = endpoint
+	// For testing
+	if endpoint != RUDDER_DATAPLANE_URL {
+		config.Verbose = true
+		config.BatchSize = 1
 	}
+	client, err := rudder.NewWithConfig(RUDDER_KEY, endpoint, config)
+	if err != nil {
+		mlog.Error("Failed to create Rudder instance", mlog.Err(err))
+		return
+	}
+	client.Enqueue(rudder.Identify{
+		UserId: s.diagnosticId,
+	})
+
+	s.diagnosticsSink = &rudderDiagnosticsSink{client: client, diagnosticId: s.diagnosticId}
+	s.diagnosticsSinkURL = ""
 }
 
-// shutdownDiagnostics closes the diagnostics system Rudder client.
+// shutdownDiagnostics closes the sink of the diagnostics system.
 func (s *Server) shutdownDiagnostics() error {
-	if s.rudderClient != nil {
-		return s.rudderClient.Close()
+	if s.diagnosticsSink != nil {
+		return s.diagnosticsSink.Close()
 	}
 
 	return nil
@@ -1303,6 +1428,15 @@ func (s *Server) ensureDiagnosticId() {
 	s.diagnosticId = id
 }
 
+// setEntityIdGenerator makes the new posts and jobs get sortable ids when configured to.
+func (s *Server) setEntityIdGenerator() {
+	if *s.Config().ServiceSettings.ExperimentalSortableIds {
+		model.SetEntityIdGenerator(model.NewSortableId)
+	} else {
+		model.SetEntityIdGenerator(nil)
+	}
+}
+
 func (s *Server) configOrLicenseListener() {
 	s.regenerateClientConfig()
 }
@@ -1331,4 +1465,26 @@ func (s *Server) initJobs() {
 	if jobsMigrationsInterface != nil {
 		s.Jobs.Migrations = jobsMigrationsInterface(s)
 	}
+	if jobsPostArchiveInterface != nil {
+		s.Jobs.PostArchive = jobsPostArchiveInterface(s)
+	}
+	if jobsPostsPartitioningInterface != nil {
+		s.Jobs.PostsPartitioning = jobsPostsPartitioningInterface(s)
+	}
+	if jobsPreferencesMigrationInterface != nil {
+		s.Jobs.PreferencesMigration = jobsPreferencesMigrationInterface(s)
+	}
+}
+
+// storeChaosSettings maps the store fault injection settings of the current config, so they can
+// be tuned without restarting the server once fault injection is enabled.
+func (s *Server) storeChaosSettings() store.ChaosSettings {
+	settings := s.Config().ExperimentalSettings
+	return store.ChaosSettings{
+		Enable:       *settings.EnableStoreFaultInjection,
+		Latency:      time.Duration(*settings.StoreFaultInjectionLatencyMilliseconds) * time.Millisecond,
+		ErrorPercent: *settings.StoreFaultInjectionErrorPercent,
+		ReplicaLag:   time.Duration(*settings.StoreFaultInjectionReplicaLagMilliseconds) * time.Millisecond,
+		Methods:      settings.StoreFaultInjectionMethods,
+	}
 }
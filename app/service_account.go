@@ -0,0 +1,29 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+// CreateServiceAccount creates the user backing a service account. The account can't log in until
+// it's given a user access token or a client side certificate.
+func (a *App) CreateServiceAccount(account *model.ServiceAccount) (*model.User, *model.AppError) {
+	if appErr := account.IsValid(); appErr != nil {
+		return nil, appErr
+	}
+
+	user, appErr := a.Srv().Store.User().Save(account.ToUser())
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	a.InvalidateCacheForUser(user.Id)
+
+	return user, nil
+}
+
+func (a *App) GetServiceAccounts(page, perPage int, includeDeleted bool) ([]*model.User, *model.AppError) {
+	return a.Srv().Store.User().GetServiceAccounts(page*perPage, perPage, includeDeleted)
+}
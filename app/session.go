@@ -129,6 +129,64 @@ func (a *App) UpdateSessionsIsGuest(userId string, isGuest bool) {
 	}
 }
 
+// updateSessionsRolesExpiresAt records in every session of the user when their system roles
+// expire, so that permission checks stop granting the roles as soon as the grant lapses rather
+// than once the role expiry job removes it.
+func (a *App) updateSessionsRolesExpiresAt(userId string, expiresAt int64) {
+	sessions, err := a.Srv().Store.Session().GetSessions(userId)
+	if err != nil {
+		mlog.Error("Unable to get user sessions", mlog.String("user_id", userId), mlog.Err(err))
+		return
+	}
+
+	for _, session := range sessions {
+		if session.GetRolesExpiresAt() == expiresAt {
+			continue
+		}
+
+		session.SetRolesExpiresAt(expiresAt)
+		if err := a.Srv().Store.Session().UpdateProps(session); err != nil {
+			mlog.Error("Unable to update the roles expiry of the session", mlog.String("session_id", session.Id), mlog.Err(err))
+		}
+	}
+
+	a.ClearSessionCacheForUser(userId)
+}
+
+// RotateSessionsCSRF replaces the CSRF token of every session of the user, so that a token leaked
+// before a change to the privileges of the user can't be used afterwards. Clients pick up the new
+// token from the CSRF cookie, which is attached again on their next request. The previous token is
+// still accepted for a short grace period so that requests already in flight don't fail.
+func (a *App) RotateSessionsCSRF(userId string) {
+	sessions, err := a.Srv().Store.Session().GetSessions(userId)
+	if err != nil {
+		mlog.Error("Unable to get user sessions", mlog.String("user_id", userId), mlog.Err(err))
+		return
+	}
+
+	for _, session := range sessions {
+		if session.GetCSRF() == "" {
+			continue
+		}
+
+		session.RotateCSRF()
+		if err := a.Srv().Store.Session().UpdateProps(session); err != nil {
+			mlog.Error("Unable to rotate the CSRF token of the session", mlog.String("session_id", session.Id), mlog.Err(err))
+			continue
+		}
+
+		// Keep the session of the request up to date, so that the new token can be attached to
+		// the response.
+		if session.Id == a.Session().Id {
+			a.Session().AddProp("csrf", session.GetCSRF())
+			a.Session().AddProp(model.SESSION_PROP_CSRF_PREVIOUS, session.Props[model.SESSION_PROP_CSRF_PREVIOUS])
+			a.Session().AddProp(model.SESSION_PROP_CSRF_ROTATED_AT, session.Props[model.SESSION_PROP_CSRF_ROTATED_AT])
+		}
+	}
+
+	a.ClearSessionCacheForUser(userId)
+}
+
 func (a *App) RevokeAllSessions(userId string) *model.AppError {
 	sessions, err := a.Srv().Store.Session().GetSessions(userId)
 	if err != nil {
@@ -307,7 +365,8 @@ func (a *App) UpdateLastActivityAtIfNeeded(session model.Session) {
 // A new ExpiresAt is only written if enough time has elapsed since last update.
 // Returns true only if the session was extended.
 func (a *App) ExtendSessionExpiryIfNeeded(session *model.Session) bool {
-	if session == nil || session.IsExpired() {
+	// Impersonation and embedded sessions are time-boxed and never extended.
+	if session == nil || session.IsExpired() || session.IsImpersonated() || session.IsEmbedded() {
 		return false
 	}
 
@@ -376,7 +435,7 @@ func (a *App) CreateUserAccessToken(token *model.UserAccessToken) (*model.UserAc
 		return nil, err
 	}
 
-	if !*a.Config().ServiceSettings.EnableUserAccessTokens && !user.IsBot {
+	if !*a.Config().ServiceSettings.EnableUserAccessTokens && !user.IsBot && !user.IsServiceAccount {
 		return nil, model.NewAppError("CreateUserAccessToken", "app.user_access_token.disabled", nil, "", http.StatusNotImplemented)
 	}
 
@@ -387,8 +446,8 @@ func (a *App) CreateUserAccessToken(token *model.UserAccessToken) (*model.UserAc
 		return nil, err
 	}
 
-	// Don't send emails to bot users.
-	if !user.IsBot {
+	// Don't send emails to bot users or service accounts, their addresses are placeholders.
+	if !user.IsBot && !user.IsServiceAccount {
 		if err := a.Srv().EmailService.sendUserAccessTokenAddedEmail(user.Email, user.Locale, a.GetSiteURL()); err != nil {
 			a.Log().Error("Unable to send user access token added email", mlog.Err(err), mlog.String("user_id", user.Id))
 		}
@@ -413,7 +472,7 @@ func (a *App) createSessionForUserAccessToken(tokenString string) (*model.Sessio
 		return nil, err
 	}
 
-	if !*a.Config().ServiceSettings.EnableUserAccessTokens && !user.IsBot {
+	if !*a.Config().ServiceSettings.EnableUserAccessTokens && !user.IsBot && !user.IsServiceAccount {
 		return nil, model.NewAppError("createSessionForUserAccessToken", "app.user_access_token.invalid_or_missing", nil, "EnableUserAccessTokens=false", http.StatusUnauthorized)
 	}
 
@@ -430,6 +489,7 @@ func (a *App) createSessionForUserAccessToken(tokenString string) (*model.Sessio
 
 	session.AddProp(model.SESSION_PROP_USER_ACCESS_TOKEN_ID, token.Id)
 	session.AddProp(model.SESSION_PROP_TYPE, model.SESSION_TYPE_USER_ACCESS_TOKEN)
+	session.SetRolesExpiresAt(user.RolesExpiresAt)
 	if user.IsBot {
 		session.AddProp(model.SESSION_PROP_IS_BOT, model.SESSION_PROP_IS_BOT_VALUE)
 	}
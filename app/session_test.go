@@ -183,6 +183,47 @@ func TestUpdateSessionOnPromoteDemote(t *testing.T) {
 	})
 }
 
+func TestRotateSessionsCSRF(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	session := &model.Session{UserId: th.BasicUser.Id}
+	session.GenerateCSRF()
+	session, err := th.App.CreateSession(session)
+	require.Nil(t, err)
+	token := session.GetCSRF()
+
+	tokenSession, err := th.App.CreateSession(&model.Session{UserId: th.BasicUser.Id})
+	require.Nil(t, err)
+
+	t.Run("should rotate the token on a change of roles", func(t *testing.T) {
+		_, err = th.App.UpdateUserRoles(th.BasicUser.Id, model.SYSTEM_USER_ROLE_ID+" "+model.SYSTEM_ADMIN_ROLE_ID, false)
+		require.Nil(t, err)
+
+		rsession, err := th.App.GetSession(session.Token)
+		require.Nil(t, err)
+		assert.NotEmpty(t, rsession.GetCSRF())
+		assert.NotEqual(t, token, rsession.GetCSRF())
+		assert.True(t, rsession.IsValidCSRF(token, model.GetMillis()), "previous token accepted within the grace period")
+		token = rsession.GetCSRF()
+	})
+
+	t.Run("should rotate the token on a change of password", func(t *testing.T) {
+		err = th.App.UpdatePassword(th.BasicUser, "Password2!")
+		require.Nil(t, err)
+
+		rsession, err := th.App.GetSession(session.Token)
+		require.Nil(t, err)
+		assert.NotEqual(t, token, rsession.GetCSRF())
+	})
+
+	t.Run("should not add a token to sessions without one", func(t *testing.T) {
+		rsession, err := th.App.GetSession(tokenSession.Token)
+		require.Nil(t, err)
+		assert.Empty(t, rsession.GetCSRF())
+	})
+}
+
 const hourMillis int64 = 60 * 60 * 1000
 const dayMillis int64 = 24 * hourMillis
 
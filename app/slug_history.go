@@ -0,0 +1,117 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+// recordSlugChange remembers the name given up by a renamed team or channel, and forgets any previous
+// owner of the name it took, so that the live name always wins. The history only serves redirects, so
+// failing to update it does not fail the rename.
+func (a *App) recordSlugChange(kind, scopeId, targetId, oldName, newName string) {
+	if oldName == newName {
+		return
+	}
+
+	if oldName != "" {
+		entry := &model.SlugHistory{Kind: kind, ScopeId: scopeId, Name: oldName, TargetId: targetId}
+		if _, err := a.Srv().Store.SlugHistory().Save(entry); err != nil {
+			mlog.Warn("Failed to record the previous name of a renamed "+kind+".", mlog.String("target_id", targetId), mlog.String("name", oldName), mlog.Err(err))
+		}
+	}
+
+	a.releaseSlug(kind, scopeId, newName)
+}
+
+// releaseSlug forgets the previous owner of a name that was taken again, so that old links stop
+// resolving to it.
+func (a *App) releaseSlug(kind, scopeId, name string) {
+	if err := a.Srv().Store.SlugHistory().Delete(kind, scopeId, name); err != nil {
+		mlog.Warn("Failed to release the previous owner of a "+kind+" name.", mlog.String("name", name), mlog.Err(err))
+	}
+}
+
+func (a *App) getSlugTarget(kind, scopeId, name string) (string, *model.AppError) {
+	entry, err := a.Srv().Store.SlugHistory().Get(kind, scopeId, name)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return "", model.NewAppError("getSlugTarget", "app.slug_history.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return "", model.NewAppError("getSlugTarget", "app.slug_history.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return entry.TargetId, nil
+}
+
+// ResolveTeamByName returns the team currently named name or, failing that, the team that was named
+// name before being renamed.
+func (a *App) ResolveTeamByName(name string) (*model.Team, *model.AppError) {
+	team, err := a.GetTeamByName(name)
+	if err == nil || err.StatusCode != http.StatusNotFound {
+		return team, err
+	}
+
+	targetId, slugErr := a.getSlugTarget(model.SLUG_HISTORY_KIND_TEAM, "", name)
+	if slugErr != nil {
+		if slugErr.StatusCode == http.StatusNotFound {
+			return nil, err
+		}
+		return nil, slugErr
+	}
+
+	return a.GetTeam(targetId)
+}
+
+// ResolveChannelByName returns the channel of the team currently named channelName or, failing that,
+// the channel of the team that was named channelName before being renamed.
+func (a *App) ResolveChannelByName(channelName, teamId string, includeDeleted bool) (*model.Channel, *model.AppError) {
+	channel, err := a.GetChannelByName(channelName, teamId, includeDeleted)
+	if err == nil || err.StatusCode != http.StatusNotFound {
+		return channel, err
+	}
+
+	targetId, slugErr := a.getSlugTarget(model.SLUG_HISTORY_KIND_CHANNEL, teamId, channelName)
+	if slugErr != nil {
+		if slugErr.StatusCode == http.StatusNotFound {
+			return nil, err
+		}
+		return nil, slugErr
+	}
+
+	renamed, getErr := a.GetChannel(targetId)
+	if getErr != nil {
+		if getErr.StatusCode == http.StatusNotFound {
+			return nil, err
+		}
+		return nil, getErr
+	}
+
+	// The channel may have been moved to another team or archived since it was renamed.
+	if renamed.TeamId != teamId || (renamed.DeleteAt != 0 && !includeDeleted) {
+		return nil, err
+	}
+
+	return renamed, nil
+}
+
+// ResolveChannelByNameForTeamName is ResolveChannelByName for a team given by its current or previous
+// name.
+func (a *App) ResolveChannelByNameForTeamName(channelName, teamName string, includeDeleted bool) (*model.Channel, *model.AppError) {
+	team, err := a.ResolveTeamByName(teamName)
+	if err != nil {
+		err.StatusCode = http.StatusNotFound
+		return nil, err
+	}
+
+	return a.ResolveChannelByName(channelName, team.Id, includeDeleted)
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestResolveChannelByName(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	channel := th.CreateChannel(th.BasicTeam)
+	oldName := channel.Name
+
+	_, err := th.App.RenameChannel(channel, "renamed-"+model.NewId(), "")
+	require.Nil(t, err)
+
+	t.Run("should redirect the old name", func(t *testing.T) {
+		resolved, err := th.App.ResolveChannelByName(oldName, th.BasicTeam.Id, false)
+		require.Nil(t, err)
+		assert.Equal(t, channel.Id, resolved.Id)
+
+		resolved, err = th.App.ResolveChannelByNameForTeamName(oldName, th.BasicTeam.Name, false)
+		require.Nil(t, err)
+		assert.Equal(t, channel.Id, resolved.Id)
+	})
+
+	t.Run("should not redirect in another team", func(t *testing.T) {
+		_, err := th.App.ResolveChannelByName(oldName, model.NewId(), false)
+		require.NotNil(t, err)
+		assert.Equal(t, http.StatusNotFound, err.StatusCode)
+	})
+
+	t.Run("should prefer a channel reusing the old name", func(t *testing.T) {
+		reused, err := th.App.CreateChannel(&model.Channel{
+			TeamId:      th.BasicTeam.Id,
+			Name:        oldName,
+			DisplayName: "Reused",
+			Type:        model.CHANNEL_OPEN,
+			CreatorId:   th.BasicUser.Id,
+		}, false)
+		require.Nil(t, err)
+
+		resolved, err := th.App.ResolveChannelByName(oldName, th.BasicTeam.Id, false)
+		require.Nil(t, err)
+		assert.Equal(t, reused.Id, resolved.Id)
+
+		// Archiving the channel reusing the name must not revive the redirect to the renamed one.
+		err = th.App.DeleteChannel(reused, th.BasicUser.Id)
+		require.Nil(t, err)
+
+		_, err = th.App.ResolveChannelByName(oldName, th.BasicTeam.Id, false)
+		require.NotNil(t, err)
+		assert.Equal(t, http.StatusNotFound, err.StatusCode)
+	})
+}
+
+func TestResolveTeamByName(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	team := th.CreateTeam()
+	oldName := team.Name
+
+	_, err := th.App.RenameTeam(team, "renamed"+model.NewId(), "")
+	require.Nil(t, err)
+
+	resolved, err := th.App.ResolveTeamByName(oldName)
+	require.Nil(t, err)
+	assert.Equal(t, team.Id, resolved.Id)
+
+	_, err = th.App.ResolveTeamByName("unknown" + model.NewId())
+	require.NotNil(t, err)
+	assert.Equal(t, http.StatusNotFound, err.StatusCode)
+
+	err = th.App.PermanentDeleteTeam(resolved)
+	require.Nil(t, err)
+
+	_, err = th.App.ResolveTeamByName(oldName)
+	require.NotNil(t, err)
+	assert.Equal(t, http.StatusNotFound, err.StatusCode)
+}
@@ -67,7 +67,7 @@ func (a *App) GetStatusesByIds(userIds []string) (map[string]interface{}, *model
 	}
 
 	if len(missingUserIds) > 0 {
-		statuses, err := a.Srv().Store.Status().GetByIds(missingUserIds)
+		statuses, err := a.getStatusesByIdsCoalesced(missingUserIds)
 		if err != nil {
 			return nil, err
 		}
@@ -115,7 +115,7 @@ func (a *App) GetUserStatusesByIds(userIds []string) ([]*model.Status, *model.Ap
 	}
 
 	if len(missingUserIds) > 0 {
-		statuses, err := a.Srv().Store.Status().GetByIds(missingUserIds)
+		statuses, err := a.getStatusesByIdsCoalesced(missingUserIds)
 		if err != nil {
 			return nil, err
 		}
@@ -185,6 +185,10 @@ func (a *App) SetStatusOnline(userId string, manual bool) {
 			return // manually set status always overrides non-manual one
 		}
 
+		if manual {
+			a.dropStatusAutomation(userId)
+		}
+
 		if status.Status != model.STATUS_ONLINE {
 			broadcast = true
 		}
@@ -208,9 +212,7 @@ func (a *App) SetStatusOnline(userId string, manual bool) {
 				mlog.Error("Failed to save status", mlog.String("user_id", userId), mlog.Err(err), mlog.String("user_id", userId))
 			}
 		} else {
-			if err := a.Srv().Store.Status().UpdateLastActivityAt(status.UserId, status.LastActivityAt); err != nil {
-				mlog.Error("Failed to save status", mlog.String("user_id", userId), mlog.Err(err), mlog.String("user_id", userId))
-			}
+			a.Srv().updateLastActivityAt(status.UserId, status.LastActivityAt)
 		}
 	}
 
@@ -220,6 +222,8 @@ func (a *App) SetStatusOnline(userId string, manual bool) {
 }
 
 func (a *App) BroadcastStatus(status *model.Status) {
+	a.triggerPresenceWebhooks(status)
+
 	if a.Srv().Busy.IsBusy() {
 		// this is considered a non-critical service and will be disabled when server busy.
 		return
@@ -240,6 +244,10 @@ func (a *App) SetStatusOffline(userId string, manual bool) {
 		return // manually set status always overrides non-manual one
 	}
 
+	if manual {
+		a.dropStatusAutomation(userId)
+	}
+
 	status = &model.Status{UserId: userId, Status: model.STATUS_OFFLINE, Manual: manual, LastActivityAt: model.GetMillis(), ActiveChannel: ""}
 
 	a.SaveAndBroadcastStatus(status)
@@ -268,6 +276,8 @@ func (a *App) SetStatusAwayIfNeeded(userId string, manual bool) {
 		if !a.IsUserAway(status.LastActivityAt) {
 			return
 		}
+	} else {
+		a.dropStatusAutomation(userId)
 	}
 
 	status.Status = model.STATUS_AWAY
@@ -288,6 +298,8 @@ func (a *App) SetStatusDoNotDisturb(userId string) {
 		status = &model.Status{UserId: userId, Status: model.STATUS_OFFLINE, Manual: false, LastActivityAt: 0, ActiveChannel: ""}
 	}
 
+	a.dropStatusAutomation(userId)
+
 	status.Status = model.STATUS_DND
 	status.Manual = true
 
@@ -315,6 +327,8 @@ func (a *App) SetStatusOutOfOffice(userId string) {
 		status = &model.Status{UserId: userId, Status: model.STATUS_OUT_OF_OFFICE, Manual: false, LastActivityAt: 0, ActiveChannel: ""}
 	}
 
+	a.dropStatusAutomation(userId)
+
 	status.Status = model.STATUS_OUT_OF_OFFICE
 	status.Manual = true
 
@@ -0,0 +1,169 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+const (
+	STATUS_AUTOMATION_END_BATCH_SIZE = 100
+)
+
+// StartStatusAutomation sets the status of the user to do not disturb until endAt on behalf of
+// source, typically a calendar connector reporting a meeting. The status of the user before the
+// automation is restored once it ends, unless the user changed their status manually meanwhile.
+// Starting an automation for a user whose status is already automated extends it.
+func (a *App) StartStatusAutomation(userId, source string, endAt int64) (*model.StatusAutomation, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableUserStatuses {
+		return nil, model.NewAppError("StartStatusAutomation", "app.status_automation.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	if endAt <= model.GetMillis() {
+		return nil, model.NewAppError("StartStatusAutomation", "app.status_automation.end_at.app_error", nil, "user_id="+userId, http.StatusBadRequest)
+	}
+
+	automation, err := a.Srv().Store.StatusAutomation().Get(userId)
+	if err == nil {
+		automation.Source = source
+		automation.EndAt = endAt
+		if _, err = a.Srv().Store.StatusAutomation().Update(automation); err == nil {
+			return automation, nil
+		}
+	}
+
+	var nfErr *store.ErrNotFound
+	if err != nil && !errors.As(err, &nfErr) {
+		return nil, statusAutomationAppError("StartStatusAutomation", err)
+	}
+
+	status, appErr := a.GetStatus(userId)
+	if appErr != nil {
+		status = &model.Status{UserId: userId, Status: model.STATUS_OFFLINE, Manual: false, LastActivityAt: 0, ActiveChannel: ""}
+	}
+
+	if status.Status == model.STATUS_OUT_OF_OFFICE {
+		return nil, model.NewAppError("StartStatusAutomation", "app.status_automation.out_of_office.app_error", nil, "user_id="+userId, http.StatusBadRequest)
+	}
+
+	automation, err = a.Srv().Store.StatusAutomation().Save(&model.StatusAutomation{
+		UserId:         userId,
+		Source:         source,
+		PreviousStatus: status.Status,
+		PreviousManual: status.Manual,
+		EndAt:          endAt,
+	})
+	if err != nil {
+		return nil, statusAutomationAppError("StartStatusAutomation", err)
+	}
+
+	// Flagging the status as manual keeps it from being overridden by the activity of the user.
+	status.Status = model.STATUS_DND
+	status.Manual = true
+
+	a.SaveAndBroadcastStatus(status)
+
+	return automation, nil
+}
+
+// EndStatusAutomation ends the automation of the status of the user, restoring the status they
+// had before it started.
+func (a *App) EndStatusAutomation(userId string) *model.AppError {
+	automation, err := a.Srv().Store.StatusAutomation().Get(userId)
+	if err != nil {
+		return statusAutomationAppError("EndStatusAutomation", err)
+	}
+
+	return a.endStatusAutomation(automation)
+}
+
+func (a *App) GetStatusAutomation(userId string) (*model.StatusAutomation, *model.AppError) {
+	automation, err := a.Srv().Store.StatusAutomation().Get(userId)
+	if err != nil {
+		return nil, statusAutomationAppError("GetStatusAutomation", err)
+	}
+
+	return automation, nil
+}
+
+func (a *App) endStatusAutomation(automation *model.StatusAutomation) *model.AppError {
+	// Deleting the automation first makes sure a concurrent manual change of the status, which
+	// deletes it too, is not reverted.
+	if err := a.Srv().Store.StatusAutomation().Delete(automation.UserId); err != nil {
+		return statusAutomationAppError("EndStatusAutomation", err)
+	}
+
+	status, appErr := a.GetStatus(automation.UserId)
+	if appErr != nil || status.Status != model.STATUS_DND {
+		return nil
+	}
+
+	status.Status = automation.PreviousStatus
+	status.Manual = automation.PreviousManual
+
+	a.SaveAndBroadcastStatus(status)
+
+	return nil
+}
+
+// dropStatusAutomation forgets about the automation of the status of the user without restoring
+// their previous status, since they just set it manually.
+func (a *App) dropStatusAutomation(userId string) {
+	if err := a.Srv().Store.StatusAutomation().Delete(userId); err != nil {
+		var nfErr *store.ErrNotFound
+		if !errors.As(err, &nfErr) {
+			mlog.Error("Failed to delete status automation", mlog.String("user_id", userId), mlog.Err(err))
+		}
+	}
+}
+
+func (a *App) doStatusAutomationEnd() {
+	for {
+		automations, err := a.Srv().Store.StatusAutomation().GetEndedBefore(model.GetMillis(), STATUS_AUTOMATION_END_BATCH_SIZE)
+		if err != nil {
+			mlog.Error("Unable to get the ended status automations.", mlog.Err(err))
+			return
+		}
+
+		for _, automation := range automations {
+			if appErr := a.endStatusAutomation(automation); appErr != nil && appErr.StatusCode != http.StatusNotFound {
+				mlog.Error("Unable to end the status automation.", mlog.String("user_id", automation.UserId), mlog.Err(appErr))
+				return
+			}
+		}
+
+		if len(automations) < STATUS_AUTOMATION_END_BATCH_SIZE {
+			return
+		}
+	}
+}
+
+func runStatusAutomationEndJob(a *App) {
+	a.doStatusAutomationEnd()
+	model.CreateRecurringTask("Status Automation End", func() {
+		a.doStatusAutomationEnd()
+	}, time.Minute)
+}
+
+func statusAutomationAppError(where string, err error) *model.AppError {
+	var appErr *model.AppError
+	var cErr *store.ErrConflict
+	var nfErr *store.ErrNotFound
+	switch {
+	case errors.As(err, &appErr):
+		return appErr
+	case errors.As(err, &cErr):
+		return model.NewAppError(where, "app.status_automation.save.exists.app_error", nil, cErr.Error(), http.StatusBadRequest)
+	case errors.As(err, &nfErr):
+		return model.NewAppError(where, "app.status_automation.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+	default:
+		return model.NewAppError(where, "app.status_automation.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/utils"
+)
+
+func TestStatusAutomation(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	userId := th.BasicUser.Id
+
+	t.Run("activity does not override automation", func(t *testing.T) {
+		th.App.SetStatusOnline(userId, false)
+
+		_, err := th.App.StartStatusAutomation(userId, "calendar", model.GetMillis()+60*60*1000)
+		require.Nil(t, err)
+		defer th.App.EndStatusAutomation(userId)
+
+		th.App.SetStatusOnline(userId, false)
+		th.App.SetStatusAwayIfNeeded(userId, false)
+
+		status, err := th.App.GetStatus(userId)
+		require.Nil(t, err)
+		assert.Equal(t, model.STATUS_DND, status.Status)
+	})
+
+	t.Run("ended automations are reverted", func(t *testing.T) {
+		th.App.SetStatusAwayIfNeeded(userId, true)
+
+		automation, err := th.App.StartStatusAutomation(userId, "calendar", model.GetMillis()+50)
+		require.Nil(t, err)
+
+		time.Sleep(time.Until(utils.TimeFromMillis(automation.EndAt + 1)))
+		th.App.doStatusAutomationEnd()
+
+		status, err := th.App.GetStatus(userId)
+		require.Nil(t, err)
+		assert.Equal(t, model.STATUS_AWAY, status.Status)
+		assert.True(t, status.Manual)
+
+		_, err = th.App.GetStatusAutomation(userId)
+		require.NotNil(t, err)
+		assert.Equal(t, http.StatusNotFound, err.StatusCode)
+	})
+
+	t.Run("manual changes are kept when the automation ends", func(t *testing.T) {
+		th.App.SetStatusOnline(userId, true)
+
+		automation, err := th.App.StartStatusAutomation(userId, "calendar", model.GetMillis()+50)
+		require.Nil(t, err)
+
+		th.App.SetStatusOffline(userId, true)
+
+		time.Sleep(time.Until(utils.TimeFromMillis(automation.EndAt + 1)))
+		th.App.doStatusAutomationEnd()
+
+		status, err := th.App.GetStatus(userId)
+		require.Nil(t, err)
+		assert.Equal(t, model.STATUS_OFFLINE, status.Status)
+	})
+
+	t.Run("out of office users are left alone", func(t *testing.T) {
+		th.App.SetStatusOutOfOffice(userId)
+		defer th.App.SetStatusOnline(userId, true)
+
+		_, err := th.App.StartStatusAutomation(userId, "calendar", model.GetMillis()+60*60*1000)
+		require.NotNil(t, err)
+		assert.Equal(t, http.StatusBadRequest, err.StatusCode)
+
+		status, err := th.App.GetStatus(userId)
+		require.Nil(t, err)
+		assert.Equal(t, model.STATUS_OUT_OF_OFFICE, status.Status)
+	})
+}
@@ -0,0 +1,255 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+const (
+	SUPPORT_PACKET_LOG_LINES = 10000
+	SUPPORT_PACKET_MAX_JOBS  = 1000
+	// SUPPORT_PACKET_MIN_SECRET_LENGTH leaves out of the redaction the secrets so short that
+	// replacing them everywhere would garble the packet.
+	SUPPORT_PACKET_MIN_SECRET_LENGTH = 4
+	SUPPORT_PACKET_REDACTED          = "********"
+)
+
+// configSecrets returns the values of the settings removed by model.Config.Sanitize, along with
+// the passwords embedded in the data sources.
+func configSecrets(cfg *model.Config) []string {
+	sanitized := cfg.Clone()
+	sanitized.Sanitize()
+
+	var secrets []string
+	collectSanitizedValues(reflect.ValueOf(cfg).Elem(), reflect.ValueOf(sanitized).Elem(), &secrets)
+
+	dataSources := append([]string{*cfg.SqlSettings.DataSource}, cfg.SqlSettings.DataSourceReplicas...)
+	dataSources = append(dataSources, cfg.SqlSettings.DataSourceSearchReplicas...)
+	for _, dataSource := range dataSources {
+		if password := dataSourcePassword(*cfg.SqlSettings.DriverName, dataSource); password != "" {
+			secrets = append(secrets, password)
+		}
+	}
+
+	return secrets
+}
+
+func collectSanitizedValues(original, sanitized reflect.Value, secrets *[]string) {
+	switch original.Kind() {
+	case reflect.Ptr:
+		if !original.IsNil() && !sanitized.IsNil() {
+			collectSanitizedValues(original.Elem(), sanitized.Elem(), secrets)
+		}
+	case reflect.Struct:
+		for i := 0; i < original.NumField(); i++ {
+			collectSanitizedValues(original.Field(i), sanitized.Field(i), secrets)
+		}
+	case reflect.Slice:
+		if original.Len() == sanitized.Len() {
+			for i := 0; i < original.Len(); i++ {
+				collectSanitizedValues(original.Index(i), sanitized.Index(i), secrets)
+			}
+		}
+	case reflect.String:
+		if original.String() != sanitized.String() && original.String() != "" {
+			*secrets = append(*secrets, original.String())
+		}
+	}
+}
+
+func dataSourcePassword(driverName, dataSource string) string {
+	if driverName == model.DATABASE_DRIVER_MYSQL {
+		if cfg, err := mysql.ParseDSN(dataSource); err == nil {
+			return cfg.Passwd
+		}
+		return ""
+	}
+
+	if u, err := url.Parse(dataSource); err == nil && u.User != nil {
+		password, _ := u.User.Password()
+		return password
+	}
+	return ""
+}
+
+// newSupportPacketRedactor returns the replacer redacting the secrets from every file of the
+// support packet, so that a secret is caught wherever it leaked, logs included.
+func newSupportPacketRedactor(cfg *model.Config) *strings.Replacer {
+	secrets := configSecrets(cfg)
+
+	// The longest secrets are replaced first, so that a data source is redacted as a whole rather
+	// than only its password.
+	sort.Slice(secrets, func(i, j int) bool {
+		return len(secrets[i]) > len(secrets[j])
+	})
+
+	var oldnew []string
+	for _, secret := range secrets {
+		if len(secret) >= SUPPORT_PACKET_MIN_SECRET_LENGTH && secret != model.FAKE_SETTING {
+			oldnew = append(oldnew, secret, SUPPORT_PACKET_REDACTED)
+		}
+	}
+
+	return strings.NewReplacer(oldnew...)
+}
+
+// supportPacketWriter adds the redacted files to the support packet archive, keeping the first
+// write error so that the files can be added without checking each of them.
+type supportPacketWriter struct {
+	zip      *zip.Writer
+	redactor *strings.Replacer
+	warnings []string
+	err      error
+}
+
+func (p *supportPacketWriter) addFile(name string, data string) {
+	if p.err != nil {
+		return
+	}
+
+	var f io.Writer
+	if f, p.err = p.zip.Create(name); p.err != nil {
+		return
+	}
+	_, p.err = io.WriteString(f, p.redactor.Replace(data))
+}
+
+func (p *supportPacketWriter) addJson(name string, v interface{}) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		p.warn(name, err)
+		return
+	}
+	p.addFile(name, string(data))
+}
+
+// warn records that a part of the support packet couldn't be collected, the rest of the packet
+// still being generated.
+func (p *supportPacketWriter) warn(name string, err error) {
+	p.warnings = append(p.warnings, fmt.Sprintf("%s: %s", name, err.Error()))
+}
+
+// GenerateSupportPacket writes to w a zip archive describing the server to help diagnose it: the
+// sanitized configuration, the recent logs, the jobs, the database, the plugins and the metrics.
+// The secrets of the configuration are redacted from every file of the archive.
+func (a *App) GenerateSupportPacket(w io.Writer) *model.AppError {
+	cfg := a.Config()
+
+	packet := &supportPacketWriter{
+		zip:      zip.NewWriter(w),
+		redactor: newSupportPacketRedactor(cfg),
+	}
+
+	packet.addJson("metadata.json", a.supportPacketMetadata())
+	packet.addJson("config.json", a.GetSanitizedConfig())
+
+	if lines, err := a.GetLogs(0, SUPPORT_PACKET_LOG_LINES); err != nil {
+		packet.warn("mattermost.log", err)
+	} else {
+		packet.addFile("mattermost.log", strings.Join(lines, "\n"))
+	}
+
+	if jobs, err := a.Srv().Store.Job().GetAllPage(0, SUPPORT_PACKET_MAX_JOBS); err != nil {
+		packet.warn("jobs.json", err)
+	} else {
+		packet.addJson("jobs.json", jobs)
+	}
+
+	storeDiagnostics, err := a.supportPacketStoreDiagnostics()
+	if err != nil {
+		packet.warn("store.json", err)
+	}
+	packet.addJson("store.json", storeDiagnostics)
+
+	if plugins, appErr := a.GetPlugins(); appErr != nil {
+		packet.warn("plugins.json", appErr)
+	} else {
+		packet.addJson("plugins.json", plugins)
+	}
+
+	if metrics, err := prometheus.DefaultGatherer.Gather(); err != nil {
+		packet.warn("metrics.json", err)
+	} else {
+		packet.addJson("metrics.json", metrics)
+	}
+
+	if len(packet.warnings) > 0 {
+		packet.addFile("warnings.txt", strings.Join(packet.warnings, "\n"))
+	}
+
+	if packet.err == nil {
+		packet.err = packet.zip.Close()
+	}
+	if packet.err != nil {
+		return model.NewAppError("GenerateSupportPacket", "app.support_packet.write.app_error", nil, packet.err.Error(), http.StatusInternalServerError)
+	}
+
+	return nil
+}
+
+func (a *App) supportPacketMetadata() *model.SupportPacketMetadata {
+	metadata := &model.SupportPacketMetadata{
+		ServerVersion:        model.CurrentVersion,
+		BuildNumber:          model.BuildNumber,
+		BuildHash:            model.BuildHash,
+		BuildEnterpriseReady: model.BuildEnterpriseReady,
+		ClusterId:            a.GetClusterId(),
+		OperatingSystem:      runtime.GOOS,
+		Architecture:         runtime.GOARCH,
+		GoVersion:            runtime.Version(),
+		GeneratedAt:          model.GetMillis(),
+	}
+
+	if hostname, err := os.Hostname(); err == nil {
+		metadata.Hostname = hostname
+	}
+
+	if license := a.Srv().License(); license != nil {
+		metadata.LicenseId = license.Id
+		metadata.LicenseSkuShortName = license.SkuShortName
+		if license.Features != nil && license.Features.Users != nil {
+			metadata.LicensedUsers = *license.Features.Users
+		}
+	}
+
+	return metadata
+}
+
+func (a *App) supportPacketStoreDiagnostics() (*model.SupportPacketStoreDiagnostics, error) {
+	cfg := a.Config()
+
+	diagnostics := &model.SupportPacketStoreDiagnostics{
+		DriverName:               *cfg.SqlSettings.DriverName,
+		SchemaVersion:            a.Srv().Store.GetCurrentSchemaVersion(),
+		ReplicaCount:             len(cfg.SqlSettings.DataSourceReplicas),
+		SearchReplicaCount:       len(cfg.SqlSettings.DataSourceSearchReplicas),
+		MasterConnections:        a.Srv().Store.TotalMasterDbConnections(),
+		ReplicaConnections:       a.Srv().Store.TotalReadDbConnections(),
+		SearchReplicaConnections: a.Srv().Store.TotalSearchDbConnections(),
+	}
+
+	databaseVersion, err := a.Srv().Store.GetDbVersion()
+	if err != nil {
+		return diagnostics, err
+	}
+	diagnostics.DatabaseVersion = databaseVersion
+
+	return diagnostics, nil
+}
@@ -28,7 +28,7 @@ func (a *App) createDefaultChannelMemberships(since int64, channelID *string) er
 		}
 
 		tmem, err := a.GetTeamMember(channel.TeamId, userChannel.UserID)
-		if err != nil && err.Id != "store.sql_team.get_member.missing.app_error" {
+		if err != nil && err.Id != "app.team.get_member.missing.app_error" {
 			return err
 		}
 
@@ -204,22 +204,31 @@ func (a *App) SyncSyncableRoles(syncableID string, syncableType model.GroupSynca
 		mlog.Any("permitted_admins", permittedAdmins),
 	)
 
-	var updateFunc func(string, []string) *model.AppError
-
 	switch syncableType {
 	case model.GroupSyncableTypeTeam:
-		updateFunc = a.Srv().Store.Team().UpdateMembersRole
+		members, err := a.Srv().Store.Team().UpdateMembersRoleAndGetUpdated(a.Context(), syncableID, permittedAdmins)
+		if err != nil {
+			return err
+		}
+
+		for _, member := range members {
+			a.sendUpdatedMemberRoleEvent(member.UserId, member)
+		}
 	case model.GroupSyncableTypeChannel:
-		updateFunc = a.Srv().Store.Channel().UpdateMembersRole
+		members, err := a.Srv().Store.Channel().UpdateMembersRoleAndGetUpdated(syncableID, permittedAdmins)
+		if err != nil {
+			return err
+		}
+
+		for _, member := range members {
+			message := model.NewWebSocketEvent(model.WEBSOCKET_EVENT_CHANNEL_MEMBER_UPDATED, "", "", member.UserId, nil)
+			message.Add("channelMember", member.ToJson())
+			a.Publish(message)
+		}
 	default:
 		return model.NewAppError("App.SyncSyncableRoles", "groups.unsupported_syncable_type", map[string]interface{}{"Value": syncableType}, "", http.StatusInternalServerError)
 	}
 
-	err = updateFunc(syncableID, permittedAdmins)
-	if err != nil {
-		return err
-	}
-
 	return nil
 }
 
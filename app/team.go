@@ -20,16 +20,29 @@ import (
 	"github.com/mattermost/mattermost-server/v5/model"
 	"github.com/mattermost/mattermost-server/v5/plugin"
 	"github.com/mattermost/mattermost-server/v5/store"
-	"github.com/mattermost/mattermost-server/v5/utils"
 )
 
 func (a *App) CreateTeam(team *model.Team) (*model.Team, *model.AppError) {
 	team.InviteId = ""
-	rteam, err := a.Srv().Store.Team().Save(team)
+	rteam, err := a.Srv().Store.Team().Save(a.Context(), team)
 	if err != nil {
-		return nil, err
+		var invErr *store.ErrInvalidInput
+		var cErr *store.ErrConflict
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &invErr):
+			return nil, model.NewAppError("CreateTeam", "app.team.save.existing.app_error", nil, invErr.Error(), http.StatusBadRequest)
+		case errors.As(err, &cErr):
+			return nil, model.NewAppError("CreateTeam", "app.team.save.domain_exists.app_error", nil, cErr.Error(), http.StatusBadRequest)
+		case errors.As(err, &appErr): // in case we haven't converted to plain error.
+			return nil, appErr
+		default: // last fallback in case it doesn't map to an existing app error.
+			return nil, model.NewAppError("CreateTeam", "app.team.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
 	}
 
+	a.releaseSlug(model.SLUG_HISTORY_KIND_TEAM, "", rteam.Name)
+
 	if _, err := a.CreateDefaultChannels(rteam.Id); err != nil {
 		return nil, err
 	}
@@ -154,7 +167,20 @@ func (a *App) UpdateTeam(team *model.Team) (*model.Team, *model.AppError) {
 }
 
 func (a *App) updateTeamUnsanitized(team *model.Team) (*model.Team, *model.AppError) {
-	return a.Srv().Store.Team().Update(team)
+	oldTeam, err := a.GetTeam(team.Id)
+	if err != nil {
+		return nil, err
+	}
+	oldName := oldTeam.Name
+
+	updatedTeam, err := a.saveTeamUpdate(team)
+	if err != nil {
+		return nil, err
+	}
+
+	a.recordSlugChange(model.SLUG_HISTORY_KIND_TEAM, "", updatedTeam.Id, oldName, updatedTeam.Name)
+
+	return updatedTeam, nil
 }
 
 // RenameTeam is used to rename the team Name and the DisplayName fields
@@ -193,7 +219,7 @@ func (a *App) UpdateTeamScheme(team *model.Team) (*model.Team, *model.AppError)
 
 	oldTeam.SchemeId = team.SchemeId
 
-	if oldTeam, err = a.Srv().Store.Team().Update(oldTeam); err != nil {
+	if oldTeam, err = a.saveTeamUpdate(oldTeam); err != nil {
 		return nil, err
 	}
 
@@ -216,7 +242,7 @@ func (a *App) UpdateTeamPrivacy(teamId string, teamType string, allowOpenInvite
 	oldTeam.Type = teamType
 	oldTeam.AllowOpenInvite = allowOpenInvite
 
-	if oldTeam, err = a.Srv().Store.Team().Update(oldTeam); err != nil {
+	if oldTeam, err = a.saveTeamUpdate(oldTeam); err != nil {
 		return err
 	}
 
@@ -250,21 +276,45 @@ func (a *App) PatchTeam(teamId string, patch *model.TeamPatch) (*model.Team, *mo
 	return team, nil
 }
 
-func (a *App) RegenerateTeamInviteId(teamId string) (*model.Team, *model.AppError) {
-	team, err := a.GetTeam(teamId)
+// RegenerateTeamInviteId invalidates the invite links of the team by replacing its InviteId with a
+// new one, which expires at inviteExpiresAt unless 0.
+func (a *App) RegenerateTeamInviteId(teamId string, inviteExpiresAt int64) (*model.Team, *model.AppError) {
+	updatedTeam, err := a.Srv().Store.Team().RegenerateInviteId(a.Context(), teamId, inviteExpiresAt)
 	if err != nil {
-		return nil, err
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("RegenerateTeamInviteId", "app.team.get.find.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("RegenerateTeamInviteId", "app.team.update.updating.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
 	}
 
-	team.InviteId = model.NewId()
+	a.sendTeamEvent(updatedTeam, model.WEBSOCKET_EVENT_UPDATE_TEAM)
+
+	return updatedTeam, nil
+}
 
-	updatedTeam, err := a.Srv().Store.Team().Update(team)
+// saveTeamUpdate stores the updated team, mapping the store errors to the app errors returned to
+// clients.
+func (a *App) saveTeamUpdate(team *model.Team) (*model.Team, *model.AppError) {
+	updatedTeam, err := a.Srv().Store.Team().Update(a.Context(), team)
 	if err != nil {
-		return nil, err
+		var nfErr *store.ErrNotFound
+		var cErr *store.ErrConflict
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("UpdateTeam", "app.team.update.find.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		case errors.As(err, &cErr):
+			return nil, model.NewAppError("UpdateTeam", "app.team.save.domain_exists.app_error", nil, cErr.Error(), http.StatusBadRequest)
+		case errors.As(err, &appErr): // in case we haven't converted to plain error.
+			return nil, appErr
+		default: // last fallback in case it doesn't map to an existing app error.
+			return nil, model.NewAppError("UpdateTeam", "app.team.update.updating.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
 	}
 
-	a.sendTeamEvent(updatedTeam, model.WEBSOCKET_EVENT_UPDATE_TEAM)
-
 	return updatedTeam, nil
 }
 
@@ -301,7 +351,17 @@ func (a *App) GetSchemeRolesForTeam(teamId string) (string, string, string, *mod
 }
 
 func (a *App) UpdateTeamMemberRoles(teamId string, userId string, newRoles string) (*model.TeamMember, *model.AppError) {
-	member, err := a.Srv().Store.Team().GetMember(teamId, userId)
+	return a.UpdateTeamMemberRolesWithExpiry(teamId, userId, newRoles, 0)
+}
+
+// UpdateTeamMemberRolesWithExpiry updates the roles of the team member, granting the explicit roles
+// until expiresAt, or permanently when expiresAt is 0.
+func (a *App) UpdateTeamMemberRolesWithExpiry(teamId string, userId string, newRoles string, expiresAt int64) (*model.TeamMember, *model.AppError) {
+	if err := checkRoleGrantExpiry("UpdateTeamMemberRoles", expiresAt); err != nil {
+		return nil, err
+	}
+
+	member, err := a.GetTeamMember(teamId, userId)
 	if err != nil {
 		return nil, err
 	}
@@ -358,8 +418,12 @@ func (a *App) UpdateTeamMemberRoles(teamId string, userId string, newRoles strin
 	}
 
 	member.ExplicitRoles = strings.Join(newExplicitRoles, " ")
+	member.ExplicitRolesExpiresAt = 0
+	if len(newExplicitRoles) > 0 {
+		member.ExplicitRolesExpiresAt = expiresAt
+	}
 
-	member, err = a.Srv().Store.Team().UpdateMember(member)
+	member, err = a.Srv().Store.Team().UpdateMember(a.Context(), member)
 	if err != nil {
 		return nil, err
 	}
@@ -390,7 +454,7 @@ func (a *App) UpdateTeamMemberSchemeRoles(teamId string, userId string, isScheme
 		member.ExplicitRoles = RemoveRoles([]string{model.TEAM_GUEST_ROLE_ID, model.TEAM_USER_ROLE_ID, model.TEAM_ADMIN_ROLE_ID}, member.ExplicitRoles)
 	}
 
-	member, err = a.Srv().Store.Team().UpdateMember(member)
+	member, err = a.Srv().Store.Team().UpdateMember(a.Context(), member)
 	if err != nil {
 		return nil, err
 	}
@@ -411,7 +475,7 @@ func (a *App) sendUpdatedMemberRoleEvent(userId string, member *model.TeamMember
 func (a *App) AddUserToTeam(teamId string, userId string, userRequestorId string) (*model.Team, *model.AppError) {
 	tchan := make(chan store.StoreResult, 1)
 	go func() {
-		team, err := a.Srv().Store.Team().Get(teamId)
+		team, err := a.GetTeam(teamId)
 		tchan <- store.StoreResult{Data: team, Err: err}
 		close(tchan)
 	}()
@@ -443,7 +507,7 @@ func (a *App) AddUserToTeam(teamId string, userId string, userRequestorId string
 }
 
 func (a *App) AddUserToTeamByTeamId(teamId string, user *model.User) *model.AppError {
-	team, err := a.Srv().Store.Team().Get(teamId)
+	team, err := a.GetTeam(teamId)
 	if err != nil {
 		return err
 	}
@@ -470,7 +534,7 @@ func (a *App) AddUserToTeamByToken(userId string, tokenId string) (*model.Team,
 
 	tchan := make(chan store.StoreResult, 1)
 	go func() {
-		team, err := a.Srv().Store.Team().Get(tokenData["teamId"])
+		team, err := a.GetTeam(tokenData["teamId"])
 		tchan <- store.StoreResult{Data: team, Err: err}
 		close(tchan)
 	}()
@@ -533,7 +597,7 @@ func (a *App) AddUserToTeamByToken(userId string, tokenId string) (*model.Team,
 func (a *App) AddUserToTeamByInviteId(inviteId string, userId string) (*model.Team, *model.AppError) {
 	tchan := make(chan store.StoreResult, 1)
 	go func() {
-		team, err := a.Srv().Store.Team().GetByInviteId(inviteId)
+		team, err := a.GetTeamByInviteId(inviteId)
 		tchan <- store.StoreResult{Data: team, Err: err}
 		close(tchan)
 	}()
@@ -588,13 +652,24 @@ func (a *App) joinUserToTeam(team *model.Team, user *model.User) (*model.TeamMem
 		tm.SchemeAdmin = true
 	}
 
-	rtm, err := a.Srv().Store.Team().GetMember(team.Id, user.Id)
+	rtm, err := a.GetTeamMember(team.Id, user.Id)
 	if err != nil {
 		// Membership appears to be missing. Lets try to add.
-		var tmr *model.TeamMember
-		tmr, err = a.Srv().Store.Team().SaveMember(tm, *a.Config().TeamSettings.MaxUsersPerTeam)
-		if err != nil {
-			return nil, false, err
+		tmr, nErr := a.Srv().Store.Team().SaveMember(a.Context(), tm, *a.Config().TeamSettings.MaxUsersPerTeam)
+		if nErr != nil {
+			var appErr *model.AppError
+			var conflictErr *store.ErrConflict
+			var limitExceededErr *store.ErrLimitExceeded
+			switch {
+			case errors.As(nErr, &appErr): // in case we haven't converted to plain error.
+				return nil, false, appErr
+			case errors.As(nErr, &conflictErr):
+				return nil, false, model.NewAppError("joinUserToTeam", "app.team.join_user_to_team.save_member.conflict.app_error", nil, nErr.Error(), http.StatusBadRequest)
+			case errors.As(nErr, &limitExceededErr):
+				return nil, false, model.NewAppError("joinUserToTeam", "app.team.join_user_to_team.max_accounts.app_error", nil, nErr.Error(), http.StatusBadRequest)
+			default: // last fallback in case it doesn't map to an existing app error.
+				return nil, false, model.NewAppError("joinUserToTeam", "app.team.join_user_to_team.save_member.app_error", nil, nErr.Error(), http.StatusInternalServerError)
+			}
 		}
 		return tmr, false, nil
 	}
@@ -605,7 +680,7 @@ func (a *App) joinUserToTeam(team *model.Team, user *model.User) (*model.TeamMem
 		return rtm, true, nil
 	}
 
-	membersCount, err := a.Srv().Store.Team().GetActiveMemberCount(tm.TeamId, nil)
+	membersCount, err := a.Srv().Store.Team().GetActiveMemberCount(a.Context(), tm.TeamId, nil)
 	if err != nil {
 		return nil, false, err
 	}
@@ -614,7 +689,7 @@ func (a *App) joinUserToTeam(team *model.Team, user *model.User) (*model.TeamMem
 		return nil, false, model.NewAppError("joinUserToTeam", "app.team.join_user_to_team.max_accounts.app_error", nil, "teamId="+tm.TeamId, http.StatusBadRequest)
 	}
 
-	member, err := a.Srv().Store.Team().UpdateMember(tm)
+	member, err := a.Srv().Store.Team().UpdateMember(a.Context(), tm)
 	if err != nil {
 		return nil, false, err
 	}
@@ -649,6 +724,8 @@ func (a *App) JoinUserToTeam(team *model.Team, user *model.User, userRequestorId
 		})
 	}
 
+	a.publishTeamMemberEventStreamEvent(model.EVENT_STREAM_TEAM_MEMBER_ADDED, tm)
+
 	if _, err := a.Srv().Store.User().UpdateUpdateAt(user.Id); err != nil {
 		return err
 	}
@@ -689,31 +766,68 @@ func (a *App) JoinUserToTeam(team *model.Team, user *model.User, userRequestorId
 }
 
 func (a *App) GetTeam(teamId string) (*model.Team, *model.AppError) {
-	return a.Srv().Store.Team().Get(teamId)
+	team, err := a.Srv().Store.Team().Get(a.Context(), teamId)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetTeam", "app.team.get.find.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetTeam", "app.team.get.finding.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return team, nil
 }
 
 func (a *App) GetTeamByName(name string) (*model.Team, *model.AppError) {
-	return a.Srv().Store.Team().GetByName(name)
+	team, err := a.Srv().Store.Team().GetByName(a.Context(), name)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetTeamByName", "app.team.get_by_name.missing.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetTeamByName", "app.team.get_by_name.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return team, nil
 }
 
 func (a *App) GetTeamByInviteId(inviteId string) (*model.Team, *model.AppError) {
-	return a.Srv().Store.Team().GetByInviteId(inviteId)
+	team, err := a.Srv().Store.Team().GetByInviteId(a.Context(), inviteId)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetTeamByInviteId", "app.team.get_by_invite_id.finding.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetTeamByInviteId", "app.team.get_by_invite_id.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return team, nil
 }
 
 func (a *App) GetAllTeams() ([]*model.Team, *model.AppError) {
-	return a.Srv().Store.Team().GetAll()
+	return a.Srv().Store.Team().GetAll(a.Context())
 }
 
 func (a *App) GetAllTeamsPage(offset int, limit int) ([]*model.Team, *model.AppError) {
-	return a.Srv().Store.Team().GetAllPage(offset, limit)
+	return a.Srv().Store.Team().GetAllPage(a.Context(), offset, limit)
+}
+
+func (a *App) GetAllDeletedTeamsPage(offset int, limit int) ([]*model.Team, *model.AppError) {
+	return a.Srv().Store.Team().GetAllDeletedPage(a.Context(), offset, limit)
 }
 
 func (a *App) GetAllTeamsPageWithCount(offset int, limit int) (*model.TeamsWithCount, *model.AppError) {
-	totalCount, err := a.Srv().Store.Team().AnalyticsTeamCount(true)
+	totalCount, err := a.Srv().Store.Team().AnalyticsTeamCount(a.Context(), true)
 	if err != nil {
 		return nil, err
 	}
-	teams, err := a.Srv().Store.Team().GetAllPage(offset, limit)
+	teams, err := a.Srv().Store.Team().GetAllPage(a.Context(), offset, limit)
 	if err != nil {
 		return nil, err
 	}
@@ -721,19 +835,19 @@ func (a *App) GetAllTeamsPageWithCount(offset int, limit int) (*model.TeamsWithC
 }
 
 func (a *App) GetAllPrivateTeams() ([]*model.Team, *model.AppError) {
-	return a.Srv().Store.Team().GetAllPrivateTeamListing()
+	return a.Srv().Store.Team().GetAllPrivateTeamListing(a.Context())
 }
 
 func (a *App) GetAllPrivateTeamsPage(offset int, limit int) ([]*model.Team, *model.AppError) {
-	return a.Srv().Store.Team().GetAllPrivateTeamPageListing(offset, limit)
+	return a.Srv().Store.Team().GetAllPrivateTeamPageListing(a.Context(), offset, limit)
 }
 
 func (a *App) GetAllPrivateTeamsPageWithCount(offset int, limit int) (*model.TeamsWithCount, *model.AppError) {
-	totalCount, err := a.Srv().Store.Team().AnalyticsPrivateTeamCount()
+	totalCount, err := a.Srv().Store.Team().AnalyticsPrivateTeamCount(a.Context())
 	if err != nil {
 		return nil, err
 	}
-	teams, err := a.Srv().Store.Team().GetAllPrivateTeamPageListing(offset, limit)
+	teams, err := a.Srv().Store.Team().GetAllPrivateTeamPageListing(a.Context(), offset, limit)
 	if err != nil {
 		return nil, err
 	}
@@ -741,19 +855,19 @@ func (a *App) GetAllPrivateTeamsPageWithCount(offset int, limit int) (*model.Tea
 }
 
 func (a *App) GetAllPublicTeams() ([]*model.Team, *model.AppError) {
-	return a.Srv().Store.Team().GetAllTeamListing()
+	return a.Srv().Store.Team().GetAllTeamListing(a.Context())
 }
 
 func (a *App) GetAllPublicTeamsPage(offset int, limit int) ([]*model.Team, *model.AppError) {
-	return a.Srv().Store.Team().GetAllTeamPageListing(offset, limit)
+	return a.Srv().Store.Team().GetAllTeamPageListing(a.Context(), offset, limit)
 }
 
 func (a *App) GetAllPublicTeamsPageWithCount(offset int, limit int) (*model.TeamsWithCount, *model.AppError) {
-	totalCount, err := a.Srv().Store.Team().AnalyticsPublicTeamCount()
+	totalCount, err := a.Srv().Store.Team().AnalyticsPublicTeamCount(a.Context())
 	if err != nil {
 		return nil, err
 	}
-	teams, err := a.Srv().Store.Team().GetAllPublicTeamPageListing(offset, limit)
+	teams, err := a.Srv().Store.Team().GetAllPublicTeamPageListing(a.Context(), offset, limit)
 	if err != nil {
 		return nil, err
 	}
@@ -763,42 +877,72 @@ func (a *App) GetAllPublicTeamsPageWithCount(offset int, limit int) (*model.Team
 // SearchAllTeams returns a team list and the total count of the results
 func (a *App) SearchAllTeams(searchOpts *model.TeamSearch) ([]*model.Team, int64, *model.AppError) {
 	if searchOpts.IsPaginated() {
-		return a.Srv().Store.Team().SearchAllPaged(searchOpts.Term, *searchOpts.Page, *searchOpts.PerPage)
+		return a.Srv().Store.Team().SearchAllPaged(a.Context(), searchOpts.Term, &searchOpts.TeamSearchOpts, *searchOpts.Page, *searchOpts.PerPage)
 	}
-	results, err := a.Srv().Store.Team().SearchAll(searchOpts.Term)
+	results, err := a.Srv().Store.Team().SearchAll(a.Context(), searchOpts.Term, &searchOpts.TeamSearchOpts)
 	return results, int64(len(results)), err
 }
 
-func (a *App) SearchPublicTeams(term string) ([]*model.Team, *model.AppError) {
-	return a.Srv().Store.Team().SearchOpen(term)
+func (a *App) SearchPublicTeams(searchOpts *model.TeamSearch) ([]*model.Team, *model.AppError) {
+	return a.Srv().Store.Team().SearchOpen(a.Context(), searchOpts.Term, &searchOpts.TeamSearchOpts)
 }
 
-func (a *App) SearchPrivateTeams(term string) ([]*model.Team, *model.AppError) {
-	return a.Srv().Store.Team().SearchPrivate(term)
+func (a *App) SearchPrivateTeams(searchOpts *model.TeamSearch) ([]*model.Team, *model.AppError) {
+	return a.Srv().Store.Team().SearchPrivate(a.Context(), searchOpts.Term, &searchOpts.TeamSearchOpts)
+}
+
+// SearchPrivateTeamsForUser searches the private teams the user is a member of.
+func (a *App) SearchPrivateTeamsForUser(userId string, searchOpts *model.TeamSearch) ([]*model.Team, *model.AppError) {
+	return a.Srv().Store.Team().SearchPrivateForUser(a.Context(), userId, searchOpts.Term, &searchOpts.TeamSearchOpts)
 }
 
 func (a *App) GetTeamsForUser(userId string) ([]*model.Team, *model.AppError) {
-	return a.Srv().Store.Team().GetTeamsByUserId(userId)
+	return a.GetTeamsForUserWithOptions(userId, nil)
+}
+
+func (a *App) GetTeamsForUserWithOptions(userId string, opts *model.TeamsForUserGetOptions) ([]*model.Team, *model.AppError) {
+	return a.Srv().Store.Team().GetTeamsByUserId(a.Context(), userId, opts)
+}
+
+// UserBelongsToTeam returns whether the user is a member of the team, without loading the member.
+func (a *App) UserBelongsToTeam(userId, teamId string) (bool, *model.AppError) {
+	return a.Srv().Store.Team().UserBelongsToTeam(a.Context(), userId, teamId, true)
 }
 
 func (a *App) GetTeamMember(teamId, userId string) (*model.TeamMember, *model.AppError) {
-	return a.Srv().Store.Team().GetMember(teamId, userId)
+	member, err := a.Srv().Store.Team().GetMember(a.Context(), teamId, userId, true)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetTeamMember", "app.team.get_member.missing.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetTeamMember", "app.team.get_member.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return member, nil
 }
 
 func (a *App) GetTeamMembersForUser(userId string) ([]*model.TeamMember, *model.AppError) {
-	return a.Srv().Store.Team().GetTeamsForUser(userId)
+	return a.Srv().Store.Team().GetTeamsForUser(a.Context(), userId)
 }
 
-func (a *App) GetTeamMembersForUserWithPagination(userId string, page, perPage int) ([]*model.TeamMember, *model.AppError) {
-	return a.Srv().Store.Team().GetTeamsForUserWithPagination(userId, page, perPage)
+func (a *App) GetTeamMembersForUserWithPagination(userId string, page, perPage int, opts *model.TeamMembersForUserGetOptions) ([]*model.TeamMember, *model.AppError) {
+	members, err := a.Srv().Store.Team().GetTeamsForUserWithPagination(a.Context(), userId, page, perPage, opts)
+	if err != nil {
+		return nil, model.NewAppError("GetTeamMembersForUserWithPagination", "app.team.get_members.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return members, nil
 }
 
 func (a *App) GetTeamMembers(teamId string, offset int, limit int, teamMembersGetOptions *model.TeamMembersGetOptions) ([]*model.TeamMember, *model.AppError) {
-	return a.Srv().Store.Team().GetMembers(teamId, offset, limit, teamMembersGetOptions)
+	return a.Srv().Store.Team().GetMembers(a.Context(), teamId, offset, limit, teamMembersGetOptions)
 }
 
 func (a *App) GetTeamMembersByIds(teamId string, userIds []string, restrictions *model.ViewUsersRestrictions) ([]*model.TeamMember, *model.AppError) {
-	return a.Srv().Store.Team().GetMembersByIds(teamId, userIds, restrictions)
+	return a.Srv().Store.Team().GetMembersByIds(a.Context(), teamId, userIds, restrictions)
 }
 
 func (a *App) AddTeamMember(teamId, userId string) (*model.TeamMember, *model.AppError) {
@@ -884,7 +1028,7 @@ func (a *App) AddTeamMemberByInviteId(inviteId, userId string) (*model.TeamMembe
 }
 
 func (a *App) GetTeamUnread(teamId, userId string) (*model.TeamUnread, *model.AppError) {
-	channelUnreads, err := a.Srv().Store.Team().GetChannelUnreadsForTeam(teamId, userId)
+	channelUnreads, err := a.Srv().Store.Team().GetChannelUnreadsForTeam(a.Context(), teamId, userId)
 	if err != nil {
 		return nil, err
 	}
@@ -909,7 +1053,7 @@ func (a *App) GetTeamUnread(teamId, userId string) (*model.TeamUnread, *model.Ap
 func (a *App) RemoveUserFromTeam(teamId string, userId string, requestorId string) *model.AppError {
 	tchan := make(chan store.StoreResult, 1)
 	go func() {
-		team, err := a.Srv().Store.Team().Get(teamId)
+		team, err := a.GetTeam(teamId)
 		tchan <- store.StoreResult{Data: team, Err: err}
 		close(tchan)
 	}()
@@ -955,7 +1099,7 @@ func (a *App) RemoveTeamMemberFromTeam(teamMember *model.TeamMember, requestorId
 	teamMember.Roles = ""
 	teamMember.DeleteAt = model.GetMillis()
 
-	if _, err := a.Srv().Store.Team().UpdateMember(teamMember); err != nil {
+	if _, err := a.Srv().Store.Team().UpdateMember(a.Context(), teamMember); err != nil {
 		return err
 	}
 
@@ -974,6 +1118,8 @@ func (a *App) RemoveTeamMemberFromTeam(teamMember *model.TeamMember, requestorId
 		})
 	}
 
+	a.publishTeamMemberEventStreamEvent(model.EVENT_STREAM_TEAM_MEMBER_REMOVED, teamMember)
+
 	if _, err := a.Srv().Store.User().UpdateUpdateAt(user.Id); err != nil {
 		return err
 	}
@@ -1054,13 +1200,13 @@ func (a *App) LeaveTeam(team *model.Team, user *model.User, requestorId string)
 func (a *App) postLeaveTeamMessage(user *model.User, channel *model.Channel) *model.AppError {
 	post := &model.Post{
 		ChannelId: channel.Id,
-		Message:   fmt.Sprintf(utils.T("api.team.leave.left"), user.Username),
 		Type:      model.POST_LEAVE_TEAM,
 		UserId:    user.Id,
 		Props: model.StringInterface{
 			"username": user.Username,
 		},
 	}
+	setSystemMessage(post, "api.team.leave.left", user.Username)
 
 	if _, err := a.CreatePost(post, channel, false, true); err != nil {
 		return model.NewAppError("postRemoveFromChannelMessage", "api.channel.post_user_add_remove_message_and_forget.error", nil, err.Error(), http.StatusInternalServerError)
@@ -1072,13 +1218,13 @@ func (a *App) postLeaveTeamMessage(user *model.User, channel *model.Channel) *mo
 func (a *App) postRemoveFromTeamMessage(user *model.User, channel *model.Channel) *model.AppError {
 	post := &model.Post{
 		ChannelId: channel.Id,
-		Message:   fmt.Sprintf(utils.T("api.team.remove_user_from_team.removed"), user.Username),
 		Type:      model.POST_REMOVE_FROM_TEAM,
 		UserId:    user.Id,
 		Props: model.StringInterface{
 			"username": user.Username,
 		},
 	}
+	setSystemMessage(post, "api.team.remove_user_from_team.removed", user.Username)
 
 	if _, err := a.CreatePost(post, channel, false, true); err != nil {
 		return model.NewAppError("postRemoveFromTeamMessage", "api.channel.post_user_add_remove_message_and_forget.error", nil, err.Error(), http.StatusInternalServerError)
@@ -1090,7 +1236,7 @@ func (a *App) postRemoveFromTeamMessage(user *model.User, channel *model.Channel
 func (a *App) prepareInviteNewUsersToTeam(teamId, senderId string) (*model.User, *model.Team, *model.AppError) {
 	tchan := make(chan store.StoreResult, 1)
 	go func() {
-		team, err := a.Srv().Store.Team().Get(teamId)
+		team, err := a.GetTeam(teamId)
 		tchan <- store.StoreResult{Data: team, Err: err}
 		close(tchan)
 	}()
@@ -1161,7 +1307,7 @@ func (a *App) prepareInviteGuestsToChannels(teamId string, guestsInvite *model.G
 
 	tchan := make(chan store.StoreResult, 1)
 	go func() {
-		team, err := a.Srv().Store.Team().Get(teamId)
+		team, err := a.GetTeam(teamId)
 		tchan <- store.StoreResult{Data: team, Err: err}
 		close(tchan)
 	}()
@@ -1310,48 +1456,16 @@ func (a *App) InviteGuestsToChannels(teamId string, guestsInvite *model.GuestsIn
 }
 
 func (a *App) FindTeamByName(name string) bool {
-	if _, err := a.Srv().Store.Team().GetByName(name); err != nil {
+	if _, err := a.GetTeamByName(name); err != nil {
 		return false
 	}
 	return true
 }
 
+// GetTeamsUnreadForUser returns the unread totals of the user for each of their teams, along with
+// the totals of their sidebar categories on each team.
 func (a *App) GetTeamsUnreadForUser(excludeTeamId string, userId string) ([]*model.TeamUnread, *model.AppError) {
-	data, err := a.Srv().Store.Team().GetChannelUnreadsForAllTeams(excludeTeamId, userId)
-	if err != nil {
-		return nil, err
-	}
-	members := []*model.TeamUnread{}
-	membersMap := make(map[string]*model.TeamUnread)
-
-	unreads := func(cu *model.ChannelUnread, tu *model.TeamUnread) *model.TeamUnread {
-		tu.MentionCount += cu.MentionCount
-
-		if cu.NotifyProps[model.MARK_UNREAD_NOTIFY_PROP] != model.CHANNEL_MARK_UNREAD_MENTION {
-			tu.MsgCount += cu.MsgCount
-		}
-
-		return tu
-	}
-
-	for i := range data {
-		id := data[i].TeamId
-		if mu, ok := membersMap[id]; ok {
-			membersMap[id] = unreads(data[i], mu)
-		} else {
-			membersMap[id] = unreads(data[i], &model.TeamUnread{
-				MsgCount:     0,
-				MentionCount: 0,
-				TeamId:       id,
-			})
-		}
-	}
-
-	for _, val := range membersMap {
-		members = append(members, val)
-	}
-
-	return members, nil
+	return a.Srv().Store.Team().GetUnreadsForAllTeams(a.Context(), excludeTeamId, userId)
 }
 
 func (a *App) PermanentDeleteTeamId(teamId string) *model.AppError {
@@ -1365,7 +1479,7 @@ func (a *App) PermanentDeleteTeamId(teamId string) *model.AppError {
 
 func (a *App) PermanentDeleteTeam(team *model.Team) *model.AppError {
 	team.DeleteAt = model.GetMillis()
-	if _, err := a.Srv().Store.Team().Update(team); err != nil {
+	if _, err := a.saveTeamUpdate(team); err != nil {
 		return err
 	}
 
@@ -1379,16 +1493,20 @@ func (a *App) PermanentDeleteTeam(team *model.Team) *model.AppError {
 		}
 	}
 
-	if err := a.Srv().Store.Team().RemoveAllMembersByTeam(team.Id); err != nil {
+	if err := a.Srv().Store.Command().PermanentDeleteByTeam(team.Id); err != nil {
+		return model.NewAppError("PermanentDeleteTeam", "app.team.permanentdeleteteam.internal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	if err := a.Srv().Store.Team().PermanentDelete(a.Context(), team.Id); err != nil {
 		return err
 	}
 
-	if err := a.Srv().Store.Command().PermanentDeleteByTeam(team.Id); err != nil {
+	if err := a.Srv().Store.SlugHistory().PermanentDeleteByTarget(team.Id); err != nil {
 		return model.NewAppError("PermanentDeleteTeam", "app.team.permanentdeleteteam.internal_error", nil, err.Error(), http.StatusInternalServerError)
 	}
 
-	if err := a.Srv().Store.Team().PermanentDelete(team.Id); err != nil {
-		return err
+	if err := a.Srv().Store.TeamInviteLink().PermanentDeleteByTeam(team.Id); err != nil {
+		return model.NewAppError("PermanentDeleteTeam", "app.team.permanentdeleteteam.internal_error", nil, err.Error(), http.StatusInternalServerError)
 	}
 
 	a.sendTeamEvent(team, model.WEBSOCKET_EVENT_DELETE_TEAM)
@@ -1403,40 +1521,69 @@ func (a *App) SoftDeleteTeam(teamId string) *model.AppError {
 	}
 
 	team.DeleteAt = model.GetMillis()
-	if team, err = a.Srv().Store.Team().Update(team); err != nil {
+	team.SetPurgeAt(a.teamPurgeAt(team.DeleteAt))
+	if team, err = a.saveTeamUpdate(team); err != nil {
 		return err
 	}
 
 	a.sendTeamEvent(team, model.WEBSOCKET_EVENT_DELETE_TEAM)
 
+	if team.GetPurgeAt() > 0 {
+		a.Srv().Go(func() {
+			a.notifyTeamPurgeScheduled(team)
+		})
+	}
+
 	return nil
 }
 
 func (a *App) RestoreTeam(teamId string) *model.AppError {
+	if err := a.Srv().Store.Team().Restore(a.Context(), teamId); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("RestoreTeam", "app.team.get.find.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return model.NewAppError("RestoreTeam", "app.team.restore.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
 	team, err := a.GetTeam(teamId)
 	if err != nil {
 		return err
 	}
 
-	team.DeleteAt = 0
-	if team, err = a.Srv().Store.Team().Update(team); err != nil {
-		return err
+	if team.GetPurgeAt() > 0 {
+		team.SetPurgeAt(0)
+		if team, err = a.saveTeamUpdate(team); err != nil {
+			return err
+		}
 	}
 
 	a.sendTeamEvent(team, model.WEBSOCKET_EVENT_RESTORE_TEAM)
 	return nil
 }
 
+// GetTeamsActiveMemberCounts returns the number of active members of each of the given teams.
+func (a *App) GetTeamsActiveMemberCounts(teamIds []string) (map[string]int64, *model.AppError) {
+	counts, err := a.Srv().Store.Team().GetActiveMemberCounts(a.Context(), teamIds)
+	if err != nil {
+		return nil, model.NewAppError("GetTeamsActiveMemberCounts", "app.team.get_active_member_counts.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return counts, nil
+}
+
 func (a *App) GetTeamStats(teamId string, restrictions *model.ViewUsersRestrictions) (*model.TeamStats, *model.AppError) {
 	tchan := make(chan store.StoreResult, 1)
 	go func() {
-		totalMemberCount, err := a.Srv().Store.Team().GetTotalMemberCount(teamId, restrictions)
+		totalMemberCount, err := a.Srv().Store.Team().GetTotalMemberCount(a.Context(), teamId, restrictions)
 		tchan <- store.StoreResult{Data: totalMemberCount, Err: err}
 		close(tchan)
 	}()
 	achan := make(chan store.StoreResult, 1)
 	go func() {
-		memberCount, err := a.Srv().Store.Team().GetActiveMemberCount(teamId, restrictions)
+		memberCount, err := a.Srv().Store.Team().GetActiveMemberCount(a.Context(), teamId, restrictions)
 		achan <- store.StoreResult{Data: memberCount, Err: err}
 		close(achan)
 	}()
@@ -1483,7 +1630,7 @@ func (a *App) GetTeamIdFromQuery(query url.Values) (string, *model.AppError) {
 		return tokenData["teamId"], nil
 	}
 	if len(inviteId) > 0 {
-		team, err := a.Srv().Store.Team().GetByInviteId(inviteId)
+		team, err := a.GetTeamByInviteId(inviteId)
 		if err == nil {
 			return team.Id, nil
 		}
@@ -1599,7 +1746,7 @@ func (a *App) SetTeamIconFromFile(team *model.Team, file io.Reader) *model.AppEr
 
 	curTime := model.GetMillis()
 
-	if err := a.Srv().Store.Team().UpdateLastTeamIconUpdate(team.Id, curTime); err != nil {
+	if err := a.Srv().Store.Team().UpdateLastTeamIconUpdate(a.Context(), team.Id, curTime); err != nil {
 		return model.NewAppError("SetTeamIcon", "api.team.team_icon.update.app_error", nil, err.Error(), http.StatusBadRequest)
 	}
 
@@ -1617,7 +1764,7 @@ func (a *App) RemoveTeamIcon(teamId string) *model.AppError {
 		return model.NewAppError("RemoveTeamIcon", "api.team.remove_team_icon.get_team.app_error", nil, err.Error(), http.StatusBadRequest)
 	}
 
-	if err := a.Srv().Store.Team().UpdateLastTeamIconUpdate(teamId, 0); err != nil {
+	if err := a.Srv().Store.Team().UpdateLastTeamIconUpdate(a.Context(), teamId, 0); err != nil {
 		return model.NewAppError("RemoveTeamIcon", "api.team.team_icon.update.app_error", nil, err.Error(), http.StatusBadRequest)
 	}
 
@@ -1643,7 +1790,7 @@ func (a *App) ClearTeamMembersCache(teamID string) {
 	page := 0
 
 	for {
-		teamMembers, err := a.Srv().Store.Team().GetMembers(teamID, page, perPage, nil)
+		teamMembers, err := a.Srv().Store.Team().GetMembers(a.Context(), teamID, page, perPage, nil)
 		if err != nil {
 			a.Log().Warn("error clearing cache for team members", mlog.String("team_id", teamID), mlog.String("err", err.Error()))
 			break
@@ -0,0 +1,115 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+const (
+	TEAM_DIRECTORY_CACHE_SIZE = 1
+	TEAM_DIRECTORY_CACHE_KEY  = "entries"
+
+	// TEAM_DIRECTORY_CACHE_EXPIRY bounds how stale the ranking of the team directory can get. The
+	// aggregates behind it are too expensive to compute on every request of a large install.
+	TEAM_DIRECTORY_CACHE_EXPIRY = 5 * time.Minute
+)
+
+// getTeamDirectoryEntries returns every open team along with its directory stats, ranked from the
+// most to the least relevant.
+func (a *App) getTeamDirectoryEntries() ([]*model.TeamDirectoryEntry, *model.AppError) {
+	var entries []*model.TeamDirectoryEntry
+	if err := a.Srv().teamDirectoryCache.Get(TEAM_DIRECTORY_CACHE_KEY, &entries); err == nil {
+		return entries, nil
+	}
+
+	now := model.GetMillis()
+	stats, appErr := a.Srv().Store.Team().GetDirectoryStats(a.Context(), now-model.TEAM_DIRECTORY_RECENT_JOINS_PERIOD)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	teams, appErr := a.Srv().Store.Team().GetAllTeamListing(a.Context())
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	teamsById := make(map[string]*model.Team, len(teams))
+	for _, team := range teams {
+		team.Sanitize()
+		teamsById[team.Id] = team
+	}
+
+	entries = make([]*model.TeamDirectoryEntry, 0, len(stats))
+	for _, teamStats := range stats {
+		team, ok := teamsById[teamStats.TeamId]
+		if !ok {
+			continue
+		}
+		entries = append(entries, &model.TeamDirectoryEntry{Team: team, TeamDirectoryStats: *teamStats})
+	}
+
+	model.SortTeamDirectoryEntries(entries, now)
+	a.Srv().teamDirectoryCache.SetWithDefaultExpiry(TEAM_DIRECTORY_CACHE_KEY, entries)
+
+	return entries, nil
+}
+
+// GetTeamDirectory returns a page of the open teams matching the term and category, ranked by
+// activity and size.
+func (a *App) GetTeamDirectory(term, category string, page, perPage int) (*model.TeamDirectory, *model.AppError) {
+	entries, appErr := a.getTeamDirectoryEntries()
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	matches := []*model.TeamDirectoryEntry{}
+	for _, entry := range entries {
+		if entry.Matches(term, category) {
+			matches = append(matches, entry)
+		}
+	}
+
+	directory := &model.TeamDirectory{
+		Entries:    []*model.TeamDirectoryEntry{},
+		TotalCount: int64(len(matches)),
+	}
+
+	start := page * perPage
+	if start < len(matches) {
+		end := start + perPage
+		if end > len(matches) {
+			end = len(matches)
+		}
+		directory.Entries = matches[start:end]
+	}
+
+	return directory, nil
+}
+
+// SetTeamDirectoryCategories replaces the categories the team is listed under in the team directory.
+func (a *App) SetTeamDirectoryCategories(teamId string, categories []string) (*model.Team, *model.AppError) {
+	categories, appErr := model.NormalizeTeamDirectoryCategories(categories)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	team, appErr := a.GetTeam(teamId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	team.AddProp(model.TEAM_PROP_DIRECTORY_CATEGORIES, categories)
+	team, appErr = a.updateTeamUnsanitized(team)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	a.Srv().teamDirectoryCache.Purge()
+	a.sendTeamEvent(team, model.WEBSOCKET_EVENT_UPDATE_TEAM)
+
+	return team, nil
+}
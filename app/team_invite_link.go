@@ -0,0 +1,192 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+func (a *App) CreateTeamInviteLink(link *model.TeamInviteLink, creatorId string) (*model.TeamInviteLink, *model.AppError) {
+	link.Id = ""
+	link.CreatorId = creatorId
+	link.DeleteAt = 0
+	link.ChannelIds = model.RemoveDuplicateStrings(link.ChannelIds)
+
+	count, err := a.Srv().Store.TeamInviteLink().CountForTeam(link.TeamId)
+	if err != nil {
+		return nil, model.NewAppError("CreateTeamInviteLink", "app.team_invite_link.get_for_team.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	if count >= model.TEAM_INVITE_LINKS_PER_TEAM_MAX {
+		return nil, model.NewAppError("CreateTeamInviteLink", "app.team_invite_link.create.limit.app_error", map[string]interface{}{"Max": model.TEAM_INVITE_LINKS_PER_TEAM_MAX}, "", http.StatusBadRequest)
+	}
+
+	if len(link.ChannelIds) > 0 {
+		channels, appErr := a.Srv().Store.Channel().GetChannelsByIds(link.ChannelIds, false)
+		if appErr != nil {
+			return nil, appErr
+		}
+		if len(channels) != len(link.ChannelIds) {
+			return nil, model.NewAppError("CreateTeamInviteLink", "app.team_invite_link.invalid_channels.app_error", nil, "", http.StatusBadRequest)
+		}
+		for _, channel := range channels {
+			if channel.TeamId != link.TeamId {
+				return nil, model.NewAppError("CreateTeamInviteLink", "app.team_invite_link.invalid_channels.app_error", nil, "channel_id="+channel.Id, http.StatusBadRequest)
+			}
+		}
+	}
+
+	savedLink, err := a.Srv().Store.TeamInviteLink().Save(link)
+	if err != nil {
+		var appErr *model.AppError
+		var cErr *store.ErrConflict
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &cErr):
+			return nil, model.NewAppError("CreateTeamInviteLink", "app.team_invite_link.save.name_exists.app_error", nil, cErr.Error(), http.StatusBadRequest)
+		default:
+			return nil, model.NewAppError("CreateTeamInviteLink", "app.team_invite_link.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return savedLink, nil
+}
+
+func (a *App) GetTeamInviteLink(linkId string) (*model.TeamInviteLink, *model.AppError) {
+	link, err := a.Srv().Store.TeamInviteLink().Get(linkId)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetTeamInviteLink", "app.team_invite_link.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetTeamInviteLink", "app.team_invite_link.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return link, nil
+}
+
+func (a *App) GetTeamInviteLinks(teamId string) ([]*model.TeamInviteLink, *model.AppError) {
+	links, err := a.Srv().Store.TeamInviteLink().GetForTeam(teamId)
+	if err != nil {
+		return nil, model.NewAppError("GetTeamInviteLinks", "app.team_invite_link.get_for_team.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return links, nil
+}
+
+func (a *App) RevokeTeamInviteLink(linkId string) *model.AppError {
+	if err := a.Srv().Store.TeamInviteLink().Revoke(linkId, model.GetMillis()); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("RevokeTeamInviteLink", "app.team_invite_link.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return model.NewAppError("RevokeTeamInviteLink", "app.team_invite_link.revoke.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return nil
+}
+
+// AddTeamMemberByInviteLink joins the user to the team of the invite link with the given name,
+// giving them the role of the link and adding them to its channels. Users already in the team don't
+// use up the link.
+func (a *App) AddTeamMemberByInviteLink(name, userId string) (*model.TeamMember, *model.AppError) {
+	link, err := a.Srv().Store.TeamInviteLink().GetByName(name)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("AddTeamMemberByInviteLink", "app.team_invite_link.invalid.app_error", nil, nfErr.Error(), http.StatusBadRequest)
+		default:
+			return nil, model.NewAppError("AddTeamMemberByInviteLink", "app.team_invite_link.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	if link.IsExpired(model.GetMillis()) {
+		return nil, model.NewAppError("AddTeamMemberByInviteLink", "app.team_invite_link.expired.app_error", nil, "id="+link.Id, http.StatusBadRequest)
+	}
+
+	if link.IsExhausted() {
+		return nil, model.NewAppError("AddTeamMemberByInviteLink", "app.team_invite_link.exhausted.app_error", nil, "id="+link.Id, http.StatusBadRequest)
+	}
+
+	team, appErr := a.GetTeam(link.TeamId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if team.DeleteAt != 0 {
+		return nil, model.NewAppError("AddTeamMemberByInviteLink", "app.team_invite_link.invalid.app_error", nil, "team_id="+team.Id, http.StatusBadRequest)
+	}
+
+	if team.IsGroupConstrained() {
+		return nil, model.NewAppError("AddTeamMemberByInviteLink", "app.team.invite_id.group_constrained.error", nil, "", http.StatusForbidden)
+	}
+
+	user, appErr := a.GetUser(userId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if user.IsGuest() {
+		return nil, model.NewAppError("AddTeamMemberByInviteLink", "api.team.add_user_to_team_from_invite.guest.app_error", nil, "", http.StatusForbidden)
+	}
+
+	// Membership cache invalidations reach the other cluster nodes asynchronously, so the cache of this
+	// node may still hold the membership of a user who just left the team on another node.
+	belongs, appErr := a.Srv().Store.Team().UserBelongsToTeam(a.Context(), user.Id, team.Id, false)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if belongs {
+		return a.GetTeamMember(team.Id, user.Id)
+	}
+
+	if err := a.Srv().Store.TeamInviteLink().IncrementUseCount(link.Id, model.GetMillis()); err != nil {
+		var ltErr *store.ErrLimitExceeded
+		switch {
+		case errors.As(err, &ltErr):
+			return nil, model.NewAppError("AddTeamMemberByInviteLink", "app.team_invite_link.exhausted.app_error", nil, ltErr.Error(), http.StatusBadRequest)
+		default:
+			return nil, model.NewAppError("AddTeamMemberByInviteLink", "app.team_invite_link.increment_use_count.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	if appErr := a.JoinUserToTeam(team, user, ""); appErr != nil {
+		return nil, appErr
+	}
+
+	if link.Role == model.TEAM_ADMIN_ROLE_ID {
+		if _, appErr := a.UpdateTeamMemberSchemeRoles(team.Id, user.Id, false, true, true); appErr != nil {
+			return nil, appErr
+		}
+	}
+
+	if len(link.ChannelIds) > 0 {
+		channels, appErr := a.Srv().Store.Channel().GetChannelsByIds(link.ChannelIds, false)
+		if appErr != nil {
+			mlog.Error("Failed to get the channels of a team invite link.", mlog.String("invite_link_id", link.Id), mlog.Err(appErr))
+		}
+
+		for _, channel := range channels {
+			// The channels may have been moved out of the team since the link was created.
+			if channel.TeamId != team.Id {
+				continue
+			}
+			if _, appErr := a.AddUserToChannel(user, channel); appErr != nil {
+				mlog.Error("Failed to add the user joining through a team invite link to a channel.", mlog.String("channel_id", channel.Id), mlog.Err(appErr))
+			}
+		}
+	}
+
+	return a.GetTeamMember(team.Id, user.Id)
+}
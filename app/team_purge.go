@@ -0,0 +1,146 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+const (
+	TEAM_PURGE_BATCH_SIZE = 100
+)
+
+// teamPurgeAt returns the time at which a team deleted at deleteAt is permanently deleted, or 0 if
+// no grace period is configured.
+func (a *App) teamPurgeAt(deleteAt int64) int64 {
+	gracePeriod := *a.Config().ServiceSettings.TeamDeletionGracePeriodInDays
+	if gracePeriod <= 0 {
+		return 0
+	}
+	return deleteAt + int64(gracePeriod)*24*60*60*1000
+}
+
+// notifyTeamPurgeScheduled lets the members of the team know that it will be permanently deleted
+// at the end of its grace period, and emails the team admins and system admins about it.
+func (a *App) notifyTeamPurgeScheduled(team *model.Team) {
+	message := model.NewWebSocketEvent(model.WEBSOCKET_EVENT_TEAM_PURGE_SCHEDULED, team.Id, "", "", nil)
+	message.Add("team_id", team.Id)
+	message.Add("purge_at", team.GetPurgeAt())
+	a.Publish(message)
+
+	recipients := map[string]*model.User{}
+
+	for page := 0; ; page++ {
+		teamAdmins, err := a.GetUsersInTeam(&model.UserGetOptions{
+			InTeamId:  team.Id,
+			TeamRoles: []string{model.TEAM_ADMIN_ROLE_ID},
+			Active:    true,
+			Page:      page,
+			PerPage:   TEAM_PURGE_BATCH_SIZE,
+		})
+		if err != nil {
+			mlog.Error("Unable to get the team admins to notify of the team deletion", mlog.String("team_id", team.Id), mlog.Err(err))
+			break
+		}
+		for _, user := range teamAdmins {
+			recipients[user.Id] = user
+		}
+		if len(teamAdmins) < TEAM_PURGE_BATCH_SIZE {
+			break
+		}
+	}
+
+	systemAdmins, err := a.Srv().Store.User().GetSystemAdminProfiles()
+	if err != nil {
+		mlog.Error("Unable to get the system admins to notify of the team deletion", mlog.String("team_id", team.Id), mlog.Err(err))
+	}
+	for id, user := range systemAdmins {
+		recipients[id] = user
+	}
+
+	for _, user := range recipients {
+		if user.Email == "" || user.DeleteAt != 0 {
+			continue
+		}
+
+		if err := a.Srv().EmailService.sendTeamPurgeScheduledEmail(user.Email, team.DisplayName, team.GetPurgeAt(), user.Locale, a.GetSiteURL()); err != nil {
+			mlog.Error("Unable to send the team deletion scheduled email", mlog.String("team_id", team.Id), mlog.String("user_id", user.Id), mlog.Err(err))
+		}
+	}
+}
+
+// CancelTeamPurge keeps a soft deleted team from being permanently deleted at the end of its grace
+// period. The team stays archived until it's restored.
+func (a *App) CancelTeamPurge(teamId string) (*model.Team, *model.AppError) {
+	team, err := a.GetTeam(teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	if team.DeleteAt == 0 || team.GetPurgeAt() == 0 {
+		return nil, model.NewAppError("CancelTeamPurge", "app.team.cancel_purge.not_scheduled.app_error", nil, "team_id="+teamId, http.StatusBadRequest)
+	}
+
+	team.SetPurgeAt(0)
+	if team, err = a.saveTeamUpdate(team); err != nil {
+		return nil, err
+	}
+
+	a.sendTeamEvent(team, model.WEBSOCKET_EVENT_UPDATE_TEAM)
+
+	return team, nil
+}
+
+// PurgeScheduledTeams permanently deletes the soft deleted teams whose grace period has ended. It
+// does nothing while the grace period is disabled.
+func (a *App) PurgeScheduledTeams() *model.AppError {
+	if *a.Config().ServiceSettings.TeamDeletionGracePeriodInDays <= 0 {
+		return nil
+	}
+
+	now := model.GetMillis()
+
+	// The teams are collected before any is deleted so that the deletions don't shift the pages.
+	var dueTeams []*model.Team
+	for offset := 0; ; offset += TEAM_PURGE_BATCH_SIZE {
+		teams, err := a.Srv().Store.Team().GetAllDeletedPage(a.Context(), offset, TEAM_PURGE_BATCH_SIZE)
+		if err != nil {
+			return err
+		}
+		for _, team := range teams {
+			if team.IsPurgeDue(now) {
+				dueTeams = append(dueTeams, team)
+			}
+		}
+		if len(teams) < TEAM_PURGE_BATCH_SIZE {
+			break
+		}
+	}
+
+	var lastErr *model.AppError
+	for _, dueTeam := range dueTeams {
+		// The team may have been restored, or its purge canceled, since it was listed.
+		team, err := a.GetTeam(dueTeam.Id)
+		if err != nil {
+			mlog.Error("Failed to get a team scheduled for deletion", mlog.String("team_id", dueTeam.Id), mlog.Err(err))
+			lastErr = err
+			continue
+		}
+		if !team.IsPurgeDue(model.GetMillis()) {
+			continue
+		}
+
+		if err := a.PermanentDeleteTeam(team); err != nil {
+			mlog.Error("Failed to permanently delete a team scheduled for deletion", mlog.String("team_id", team.Id), mlog.Err(err))
+			lastErr = err
+			continue
+		}
+		mlog.Info("Permanently deleted a team at the end of its grace period", mlog.String("team_id", team.Id))
+	}
+
+	return lastErr
+}
@@ -4,6 +4,7 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"sort"
@@ -325,7 +326,7 @@ func TestAddUserToTeamByToken(t *testing.T) {
 
 	t.Run("add a guest user even though there are team and system domain restrictions", func(t *testing.T) {
 		th.BasicTeam.AllowedDomains = "restricted-team.com"
-		_, err := th.Server.Store.Team().Update(th.BasicTeam)
+		_, err := th.Server.Store.Team().Update(context.Background(), th.BasicTeam)
 		require.Nil(t, err)
 		restrictedDomain := *th.App.Config().TeamSettings.RestrictCreationToDomains
 		defer func() {
@@ -342,7 +343,7 @@ func TestAddUserToTeamByToken(t *testing.T) {
 		_, err = th.App.AddUserToTeamByToken(rguest.Id, token.Token)
 		require.Nil(t, err)
 		th.BasicTeam.AllowedDomains = ""
-		_, err = th.Server.Store.Team().Update(th.BasicTeam)
+		_, err = th.Server.Store.Team().Update(context.Background(), th.BasicTeam)
 		require.Nil(t, err)
 	})
 
@@ -510,6 +511,50 @@ func TestPermanentDeleteTeam(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestPurgeScheduledTeams(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.TeamDeletionGracePeriodInDays = 30 })
+
+	dueTeam := th.CreateTeam()
+	require.Nil(t, th.App.SoftDeleteTeam(dueTeam.Id))
+	dueTeam, err := th.App.GetTeam(dueTeam.Id)
+	require.Nil(t, err)
+	require.NotZero(t, dueTeam.GetPurgeAt())
+	dueTeam.SetPurgeAt(model.GetMillis() - 1000)
+	_, err = th.App.saveTeamUpdate(dueTeam)
+	require.Nil(t, err)
+
+	pendingTeam := th.CreateTeam()
+	require.Nil(t, th.App.SoftDeleteTeam(pendingTeam.Id))
+
+	canceledTeam := th.CreateTeam()
+	require.Nil(t, th.App.SoftDeleteTeam(canceledTeam.Id))
+	_, err = th.App.CancelTeamPurge(canceledTeam.Id)
+	require.Nil(t, err)
+
+	t.Run("disabled grace period", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.TeamDeletionGracePeriodInDays = 0 })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.TeamDeletionGracePeriodInDays = 30 })
+
+		require.Nil(t, th.App.PurgeScheduledTeams())
+		_, err := th.App.GetTeam(dueTeam.Id)
+		require.Nil(t, err)
+	})
+
+	require.Nil(t, th.App.PurgeScheduledTeams())
+
+	_, err = th.App.GetTeam(dueTeam.Id)
+	require.NotNil(t, err, "the team past its grace period should be purged")
+
+	_, err = th.App.GetTeam(pendingTeam.Id)
+	require.Nil(t, err, "the team within its grace period should be kept")
+
+	_, err = th.App.GetTeam(canceledTeam.Id)
+	require.Nil(t, err, "the team with a canceled purge should be kept")
+}
+
 func TestSanitizeTeam(t *testing.T) {
 	th := Setup(t)
 	defer th.TearDown()
@@ -0,0 +1,289 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+const (
+	TERMS_OF_SERVICE_POLICY_CACHE_SIZE = model.SESSION_CACHE_SIZE
+
+	// TERMS_OF_SERVICE_POLICY_CACHE_EXPIRY bounds how long a user who joins a targeted team or group,
+	// or a version published on another cluster node, can go unnoticed by the enforcement.
+	TERMS_OF_SERVICE_POLICY_CACHE_EXPIRY = 5 * time.Minute
+)
+
+func (a *App) checkTermsOfServicePolicyLicense(where string) *model.AppError {
+	license := a.Srv().License()
+	if license == nil || !*license.Features.CustomTermsOfService {
+		return model.NewAppError(where, "api.create_terms_of_service.custom_terms_of_service_disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	return nil
+}
+
+func (a *App) GetTermsOfServicePolicies(page, perPage int) ([]*model.TermsOfServicePolicy, *model.AppError) {
+	if appErr := a.checkTermsOfServicePolicyLicense("GetTermsOfServicePolicies"); appErr != nil {
+		return nil, appErr
+	}
+
+	policies, err := a.Srv().Store.TermsOfServicePolicy().GetAll(page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetTermsOfServicePolicies", "app.terms_of_service_policy.get_all.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return policies, nil
+}
+
+func (a *App) GetTermsOfServicePolicy(policyId string) (*model.TermsOfServicePolicy, *model.AppError) {
+	if appErr := a.checkTermsOfServicePolicyLicense("GetTermsOfServicePolicy"); appErr != nil {
+		return nil, appErr
+	}
+
+	policy, err := a.Srv().Store.TermsOfServicePolicy().Get(policyId)
+	if err != nil {
+		return nil, termsOfServicePolicyStoreError("GetTermsOfServicePolicy", err)
+	}
+
+	return policy, nil
+}
+
+func (a *App) CreateTermsOfServicePolicy(policy *model.TermsOfServicePolicy) (*model.TermsOfServicePolicy, *model.AppError) {
+	if appErr := a.checkTermsOfServicePolicyLicense("CreateTermsOfServicePolicy"); appErr != nil {
+		return nil, appErr
+	}
+
+	if appErr := a.validateTermsOfServicePolicyTargets(policy); appErr != nil {
+		return nil, appErr
+	}
+
+	policy.Id = ""
+	policy.DeleteAt = 0
+	savedPolicy, err := a.Srv().Store.TermsOfServicePolicy().Save(policy)
+	if err != nil {
+		return nil, termsOfServicePolicyStoreError("CreateTermsOfServicePolicy", err)
+	}
+
+	return savedPolicy, nil
+}
+
+// UpdateTermsOfServicePolicy changes the name and targets of a policy. Users who become targeted
+// have to accept the latest version of the policy, if it has one.
+func (a *App) UpdateTermsOfServicePolicy(policy *model.TermsOfServicePolicy) (*model.TermsOfServicePolicy, *model.AppError) {
+	oldPolicy, appErr := a.GetTermsOfServicePolicy(policy.Id)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if appErr = a.validateTermsOfServicePolicyTargets(policy); appErr != nil {
+		return nil, appErr
+	}
+
+	policy.CreateAt = oldPolicy.CreateAt
+	policy.DeleteAt = 0
+	updatedPolicy, err := a.Srv().Store.TermsOfServicePolicy().Update(policy)
+	if err != nil {
+		return nil, termsOfServicePolicyStoreError("UpdateTermsOfServicePolicy", err)
+	}
+
+	a.Srv().termsOfServiceCache.Purge()
+
+	return updatedPolicy, nil
+}
+
+// DeleteTermsOfServicePolicy stops enforcing a policy. Its versions and their acceptances are kept.
+func (a *App) DeleteTermsOfServicePolicy(policyId string) *model.AppError {
+	if appErr := a.checkTermsOfServicePolicyLicense("DeleteTermsOfServicePolicy"); appErr != nil {
+		return appErr
+	}
+
+	if err := a.Srv().Store.TermsOfServicePolicy().Delete(policyId, model.GetMillis()); err != nil {
+		return termsOfServicePolicyStoreError("DeleteTermsOfServicePolicy", err)
+	}
+
+	return nil
+}
+
+// PublishTermsOfServicePolicyVersion publishes a new version of a policy, which every targeted user
+// then has to accept before they can keep using the API.
+func (a *App) PublishTermsOfServicePolicyVersion(policyId, text, userId string) (*model.TermsOfServicePolicyVersion, *model.AppError) {
+	if appErr := a.checkTermsOfServicePolicyLicense("PublishTermsOfServicePolicyVersion"); appErr != nil {
+		return nil, appErr
+	}
+
+	version, err := a.Srv().Store.TermsOfServicePolicy().SaveVersion(&model.TermsOfServicePolicyVersion{
+		PolicyId: policyId,
+		UserId:   userId,
+		Text:     text,
+	})
+	if err != nil {
+		return nil, termsOfServicePolicyStoreError("PublishTermsOfServicePolicyVersion", err)
+	}
+
+	a.Srv().termsOfServiceCache.Purge()
+
+	mlog.Info("Published a terms of service policy version.", mlog.String("policy_id", policyId), mlog.Int("version", version.Version), mlog.String("user_id", userId))
+
+	return version, nil
+}
+
+func (a *App) GetLatestTermsOfServicePolicyVersion(policyId string) (*model.TermsOfServicePolicyVersion, *model.AppError) {
+	if _, appErr := a.GetTermsOfServicePolicy(policyId); appErr != nil {
+		return nil, appErr
+	}
+
+	version, err := a.Srv().Store.TermsOfServicePolicy().GetLatestVersion(policyId)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetLatestTermsOfServicePolicyVersion", "app.terms_of_service_policy.no_version.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetLatestTermsOfServicePolicyVersion", "app.terms_of_service_policy.get_version.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return version, nil
+}
+
+// GetTermsOfServicePolicyReport summarizes how many of the users targeted by a policy have accepted
+// its latest version.
+func (a *App) GetTermsOfServicePolicyReport(policyId string) (*model.TermsOfServicePolicyReport, *model.AppError) {
+	version, appErr := a.GetLatestTermsOfServicePolicyVersion(policyId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	targeted, accepted, err := a.Srv().Store.TermsOfServicePolicy().GetAcceptanceCounts(version)
+	if err != nil {
+		return nil, model.NewAppError("GetTermsOfServicePolicyReport", "app.terms_of_service_policy.report.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return &model.TermsOfServicePolicyReport{
+		PolicyId:         policyId,
+		VersionId:        version.Id,
+		Version:          version.Version,
+		TargetedUsers:    targeted,
+		AcceptedUsers:    accepted,
+		OutstandingUsers: targeted - accepted,
+	}, nil
+}
+
+// GetTermsOfServicePolicyOutstandingUsers returns the targeted users who haven't accepted the latest
+// version of a policy yet.
+func (a *App) GetTermsOfServicePolicyOutstandingUsers(policyId string, page, perPage int) ([]*model.User, *model.AppError) {
+	version, appErr := a.GetLatestTermsOfServicePolicyVersion(policyId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	users, err := a.Srv().Store.TermsOfServicePolicy().GetOutstandingUsers(version, page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetTermsOfServicePolicyOutstandingUsers", "app.terms_of_service_policy.report.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return users, nil
+}
+
+// GetPendingTermsOfServicePolicyVersions returns the policy versions the user still has to accept.
+func (a *App) GetPendingTermsOfServicePolicyVersions(userId string) ([]*model.TermsOfServicePolicyVersion, *model.AppError) {
+	versions, err := a.Srv().Store.TermsOfServicePolicy().GetPendingForUser(userId)
+	if err != nil {
+		return nil, model.NewAppError("GetPendingTermsOfServicePolicyVersions", "app.terms_of_service_policy.get_pending.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return versions, nil
+}
+
+// HasPendingTermsOfServicePolicies reports whether the user has to accept a policy version before
+// using the API. Users without pending versions are cached, so that enforcement doesn't query the
+// database on every request.
+func (a *App) HasPendingTermsOfServicePolicies(userId string) (bool, *model.AppError) {
+	var cleared bool
+	if err := a.Srv().termsOfServiceCache.Get(userId, &cleared); err == nil && cleared {
+		return false, nil
+	}
+
+	versions, appErr := a.GetPendingTermsOfServicePolicyVersions(userId)
+	if appErr != nil {
+		return false, appErr
+	}
+
+	if len(versions) > 0 {
+		return true, nil
+	}
+
+	a.Srv().termsOfServiceCache.SetWithDefaultExpiry(userId, true)
+	return false, nil
+}
+
+// AcceptTermsOfServicePolicyVersion records that the user accepted the given version of a policy. Only
+// the latest version can be accepted, so that a client can't skip a version published in the meantime.
+func (a *App) AcceptTermsOfServicePolicyVersion(userId, policyId, versionId string) *model.AppError {
+	version, appErr := a.GetLatestTermsOfServicePolicyVersion(policyId)
+	if appErr != nil {
+		return appErr
+	}
+
+	if version.Id != versionId {
+		return model.NewAppError("AcceptTermsOfServicePolicyVersion", "app.terms_of_service_policy.accept.outdated.app_error", nil, "version_id="+versionId, http.StatusBadRequest)
+	}
+
+	err := a.Srv().Store.TermsOfServicePolicy().SaveAcceptance(&model.TermsOfServiceAcceptance{
+		UserId:    userId,
+		VersionId: versionId,
+	})
+	if err != nil {
+		var conflictErr *store.ErrConflict
+		switch {
+		case errors.As(err, &conflictErr):
+			// Accepting the same version twice is harmless.
+			return nil
+		default:
+			return model.NewAppError("AcceptTermsOfServicePolicyVersion", "app.terms_of_service_policy.accept.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return nil
+}
+
+func termsOfServicePolicyStoreError(where string, err error) *model.AppError {
+	var appErr *model.AppError
+	var nfErr *store.ErrNotFound
+	var conflictErr *store.ErrConflict
+	switch {
+	case errors.As(err, &appErr):
+		return appErr
+	case errors.As(err, &nfErr):
+		return model.NewAppError(where, "app.terms_of_service_policy.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+	case errors.As(err, &conflictErr):
+		return model.NewAppError(where, "app.terms_of_service_policy.save.conflict.app_error", nil, conflictErr.Error(), http.StatusConflict)
+	default:
+		return model.NewAppError(where, "app.terms_of_service_policy.store.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// validateTermsOfServicePolicyTargets makes sure every team and group listed in the policy exists.
+func (a *App) validateTermsOfServicePolicyTargets(policy *model.TermsOfServicePolicy) *model.AppError {
+	for _, teamId := range model.RemoveDuplicateStrings(policy.TeamIds) {
+		if _, appErr := a.GetTeam(teamId); appErr != nil {
+			return model.NewAppError("validateTermsOfServicePolicyTargets", "app.terms_of_service_policy.invalid_team.app_error", nil, "team_id="+teamId, http.StatusBadRequest)
+		}
+	}
+
+	for _, groupId := range model.RemoveDuplicateStrings(policy.GroupIds) {
+		if _, appErr := a.GetGroup(groupId); appErr != nil {
+			return model.NewAppError("validateTermsOfServicePolicyTargets", "app.terms_of_service_policy.invalid_group.app_error", nil, "group_id="+groupId, http.StatusBadRequest)
+		}
+	}
+
+	return nil
+}
@@ -63,7 +63,7 @@ func (a *App) CreateUserWithToken(user *model.User, token *model.Token) (*model.
 
 	tokenData := model.MapFromJson(strings.NewReader(token.Extra))
 
-	team, err := a.Srv().Store.Team().Get(tokenData["teamId"])
+	team, err := a.GetTeam(tokenData["teamId"])
 	if err != nil {
 		return nil, err
 	}
@@ -113,7 +113,7 @@ func (a *App) CreateUserWithInviteId(user *model.User, inviteId string) (*model.
 		return nil, err
 	}
 
-	team, err := a.Srv().Store.Team().GetByInviteId(inviteId)
+	team, err := a.GetTeamByInviteId(inviteId)
 	if err != nil {
 		return nil, err
 	}
@@ -586,7 +586,13 @@ func (a *App) GetChannelGroupUsers(channelID string) ([]*model.User, *model.AppE
 func (a *App) GetUsersByIds(userIds []string, options *store.UserGetByIdsOpts) ([]*model.User, *model.AppError) {
 	allowFromCache := options.ViewRestrictions == nil
 
-	users, err := a.Srv().Store.User().GetProfileByIds(userIds, options, allowFromCache)
+	var users []*model.User
+	var err *model.AppError
+	if allowFromCache && options.Since == 0 {
+		users, err = a.getProfilesByIdsCoalesced(userIds)
+	} else {
+		users, err = a.Srv().Store.User().GetProfileByIds(userIds, options, allowFromCache)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -1034,6 +1040,7 @@ func (a *App) GetSanitizeOptions(asAdmin bool) map[string]bool {
 		options["email"] = true
 		options["fullname"] = true
 		options["authservice"] = true
+		options["lastlogin"] = true
 	}
 	return options
 }
@@ -1118,6 +1125,9 @@ func (a *App) UpdateUser(user *model.User, sendNotifications bool) (*model.User,
 		return nil, err
 	}
 
+	// The notification preferences left unchanged keep following the defaults of the teams.
+	user.NotifyProps = model.UpdatedNotifyProps(prev.NotifyProps, user.NotifyProps)
+
 	if !CheckUserDomain(user, *a.Config().TeamSettings.RestrictCreationToDomains) {
 		if !prev.IsGuest() && !prev.IsLDAPUser() && !prev.IsSAMLUser() && user.Email != prev.Email {
 			return nil, model.NewAppError("UpdateUser", "api.user.update_user.accepted_domain.app_error", nil, "", http.StatusBadRequest)
@@ -1182,6 +1192,7 @@ func (a *App) UpdateUser(user *model.User, sendNotifications bool) (*model.User,
 	}
 
 	a.InvalidateCacheForUser(user.Id)
+	a.publishUserEventStreamEvent(model.EVENT_STREAM_USER_UPDATED, userUpdate.New)
 
 	return userUpdate.New, nil
 }
@@ -1226,6 +1237,8 @@ func (a *App) UpdateMfa(activate bool, userId, token string) *model.AppError {
 		}
 	}
 
+	a.RotateSessionsCSRF(userId)
+
 	a.Srv().Go(func() {
 		user, err := a.GetUser(userId)
 		if err != nil {
@@ -1262,6 +1275,7 @@ func (a *App) UpdatePassword(user *model.User, newPassword string) *model.AppErr
 	}
 
 	a.InvalidateCacheForUser(user.Id)
+	a.RotateSessionsCSRF(user.Id)
 
 	return nil
 }
@@ -1393,6 +1407,16 @@ func (a *App) DeleteToken(token *model.Token) *model.AppError {
 }
 
 func (a *App) UpdateUserRoles(userId string, newRoles string, sendWebSocketEvent bool) (*model.User, *model.AppError) {
+	return a.UpdateUserRolesWithExpiry(userId, newRoles, 0, sendWebSocketEvent)
+}
+
+// UpdateUserRolesWithExpiry updates the system roles of the user. The roles other than system_user
+// and system_guest are granted until expiresAt, or permanently when expiresAt is 0.
+func (a *App) UpdateUserRolesWithExpiry(userId string, newRoles string, expiresAt int64, sendWebSocketEvent bool) (*model.User, *model.AppError) {
+	if err := checkRoleGrantExpiry("UpdateUserRoles", expiresAt); err != nil {
+		return nil, err
+	}
+
 	user, err := a.GetUser(userId)
 	if err != nil {
 		err.StatusCode = http.StatusBadRequest
@@ -1404,6 +1428,10 @@ func (a *App) UpdateUserRoles(userId string, newRoles string, sendWebSocketEvent
 	}
 
 	user.Roles = newRoles
+	user.RolesExpiresAt = 0
+	if len(model.RemoveRoles(strings.Fields(newRoles), []string{model.SYSTEM_USER_ROLE_ID, model.SYSTEM_GUEST_ROLE_ID})) > 0 {
+		user.RolesExpiresAt = expiresAt
+	}
 	uchan := make(chan store.StoreResult, 1)
 	go func() {
 		userUpdate, err := a.Srv().Store.User().Update(user, true)
@@ -1429,8 +1457,9 @@ func (a *App) UpdateUserRoles(userId string, newRoles string, sendWebSocketEvent
 		mlog.Error("Failed during updating user roles", mlog.Err(result.NErr))
 	}
 
+	a.updateSessionsRolesExpiresAt(user.Id, ruser.RolesExpiresAt)
 	a.InvalidateCacheForUser(userId)
-	a.ClearSessionCacheForUser(user.Id)
+	a.RotateSessionsCSRF(user.Id)
 
 	if sendWebSocketEvent {
 		message := model.NewWebSocketEvent(model.WEBSOCKET_EVENT_USER_ROLE_UPDATED, "", "", user.Id, nil)
@@ -1480,6 +1509,28 @@ func (a *App) PermanentDeleteUser(user *model.User) *model.AppError {
 		return err
 	}
 
+	if err := a.Srv().Store.UserProperty().PermanentDeleteByUser(user.Id); err != nil {
+		return model.NewAppError("PermanentDeleteUser", "app.user.permanentdeleteuser.internal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	if err := a.Srv().Store.LoginHistory().PermanentDeleteByUser(user.Id); err != nil {
+		return model.NewAppError("PermanentDeleteUser", "app.user.permanentdeleteuser.internal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	if err := a.Srv().Store.UserRelationship().PermanentDeleteByUser(user.Id); err != nil {
+		return model.NewAppError("PermanentDeleteUser", "app.user.permanentdeleteuser.internal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	if err := a.Srv().Store.PostAcknowledgement().PermanentDeleteByUser(user.Id); err != nil {
+		return model.NewAppError("PermanentDeleteUser", "app.user.permanentdeleteuser.internal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	a.dropStatusAutomation(user.Id)
+
+	if err := a.Srv().Store.EmailVerification().Delete(user.Id); err != nil {
+		return model.NewAppError("PermanentDeleteUser", "app.user.permanentdeleteuser.internal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
 	if err := a.Srv().Store.Channel().PermanentDeleteMembersByUser(user.Id); err != nil {
 		return err
 	}
@@ -1546,10 +1597,6 @@ func (a *App) PermanentDeleteUser(user *model.User) *model.AppError {
 		return model.NewAppError("PermanentDeleteUser", "app.audit.permanent_delete_by_user.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
 
-	if err := a.Srv().Store.Team().RemoveAllMembersByUser(user.Id); err != nil {
-		return err
-	}
-
 	mlog.Warn("Permanently deleted account", mlog.String("user_email", user.Email), mlog.String("user_id", user.Id))
 
 	return nil
@@ -1667,6 +1714,7 @@ func (a *App) VerifyUserEmail(userId, email string) *model.AppError {
 	}
 
 	a.InvalidateCacheForUser(userId)
+	a.clearEmailVerificationDeadline(userId)
 
 	user, err := a.GetUser(userId)
 
@@ -1950,7 +1998,7 @@ func (a *App) UserCanSeeOtherUser(userId string, otherUserId string) (bool, *mod
 	}
 
 	if len(restrictions.Teams) > 0 {
-		result, err := a.Srv().Store.Team().UserBelongsToTeams(otherUserId, restrictions.Teams)
+		result, err := a.Srv().Store.Team().UserBelongsToTeams(a.Context(), otherUserId, restrictions.Teams)
 		if err != nil {
 			return false, err
 		}
@@ -1981,15 +2029,22 @@ func (a *App) GetViewUsersRestrictions(userId string) (*model.ViewUsersRestricti
 		return nil, nil
 	}
 
-	teamIds, getTeamErr := a.Srv().Store.Team().GetUserTeamIds(userId, true)
+	teamIds, getTeamErr := a.Srv().Store.Team().GetUserTeamIds(a.Context(), userId, true)
 	if getTeamErr != nil {
 		return nil, getTeamErr
 	}
 
+	// The user doesn't have the permission system wide, so it can only be granted by the roles of
+	// their team members, fetched all at once rather than team by team.
+	teamMembers, getTeamMembersErr := a.Srv().Store.Team().GetMembersByTeamIds(a.Context(), teamIds, userId)
+	if getTeamMembersErr != nil {
+		return nil, getTeamMembersErr
+	}
+
 	teamIdsWithPermission := []string{}
-	for _, teamId := range teamIds {
-		if a.HasPermissionToTeam(userId, teamId, model.PERMISSION_VIEW_MEMBERS) {
-			teamIdsWithPermission = append(teamIdsWithPermission, teamId)
+	for _, teamMember := range teamMembers {
+		if teamMember.DeleteAt == 0 && a.RolesGrantPermission(teamMember.GetRoles(), model.PERMISSION_VIEW_MEMBERS.Id) {
+			teamIdsWithPermission = append(teamIdsWithPermission, teamMember.TeamId)
 		}
 	}
 
@@ -2014,7 +2069,7 @@ func (a *App) PromoteGuestToUser(user *model.User, requestorId string) *model.Ap
 	if err != nil {
 		return err
 	}
-	userTeams, err := a.Srv().Store.Team().GetTeamsByUserId(user.Id)
+	userTeams, err := a.Srv().Store.Team().GetTeamsByUserId(a.Context(), user.Id, nil)
 	if err != nil {
 		return err
 	}
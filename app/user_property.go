@@ -0,0 +1,194 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+func (a *App) GetUserPropertyFields() ([]*model.UserPropertyField, *model.AppError) {
+	fields, err := a.Srv().Store.UserProperty().GetFields()
+	if err != nil {
+		return nil, model.NewAppError("GetUserPropertyFields", "app.user_property.get_fields.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return fields, nil
+}
+
+func (a *App) GetUserPropertyField(fieldId string) (*model.UserPropertyField, *model.AppError) {
+	field, err := a.Srv().Store.UserProperty().GetField(fieldId)
+	if err != nil {
+		return nil, userPropertyStoreError("GetUserPropertyField", err)
+	}
+
+	return field, nil
+}
+
+func (a *App) CreateUserPropertyField(field *model.UserPropertyField) (*model.UserPropertyField, *model.AppError) {
+	fields, appErr := a.GetUserPropertyFields()
+	if appErr != nil {
+		return nil, appErr
+	}
+	if len(fields) >= model.USER_PROPERTY_FIELDS_MAX {
+		return nil, model.NewAppError("CreateUserPropertyField", "app.user_property.create_field.limit.app_error", map[string]interface{}{"Max": model.USER_PROPERTY_FIELDS_MAX}, "", http.StatusBadRequest)
+	}
+
+	field.Id = ""
+	field.DeleteAt = 0
+	savedField, err := a.Srv().Store.UserProperty().SaveField(field)
+	if err != nil {
+		return nil, userPropertyStoreError("CreateUserPropertyField", err)
+	}
+
+	return savedField, nil
+}
+
+// UpdateUserPropertyField changes the definition of a field. The type of a field can't change since
+// the values users already have wouldn't match it anymore.
+func (a *App) UpdateUserPropertyField(field *model.UserPropertyField) (*model.UserPropertyField, *model.AppError) {
+	oldField, appErr := a.GetUserPropertyField(field.Id)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if field.Type != oldField.Type {
+		return nil, model.NewAppError("UpdateUserPropertyField", "app.user_property.update_field.type.app_error", nil, "field_id="+field.Id, http.StatusBadRequest)
+	}
+
+	field.CreateAt = oldField.CreateAt
+	field.DeleteAt = oldField.DeleteAt
+	updatedField, err := a.Srv().Store.UserProperty().UpdateField(field)
+	if err != nil {
+		return nil, userPropertyStoreError("UpdateUserPropertyField", err)
+	}
+
+	return updatedField, nil
+}
+
+func (a *App) DeleteUserPropertyField(fieldId string) *model.AppError {
+	if err := a.Srv().Store.UserProperty().DeleteField(fieldId, model.GetMillis()); err != nil {
+		return userPropertyStoreError("DeleteUserPropertyField", err)
+	}
+
+	return nil
+}
+
+// GetUserPropertyValues returns the values of the custom profile fields of the user, keyed by
+// field id.
+func (a *App) GetUserPropertyValues(userId string) (map[string]string, *model.AppError) {
+	values, err := a.Srv().Store.UserProperty().GetValuesForUser(userId)
+	if err != nil {
+		return nil, model.NewAppError("GetUserPropertyValues", "app.user_property.get_values.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	valuesByFieldId := make(map[string]string, len(values))
+	for _, value := range values {
+		valuesByFieldId[value.FieldId] = value.Value
+	}
+
+	return valuesByFieldId, nil
+}
+
+// PatchUserPropertyValues sets the given values, keyed by field id, for the user. Empty values
+// clear the field. Fields synced from the directory can only be set by the sync itself.
+func (a *App) PatchUserPropertyValues(userId string, patch map[string]string, fromSync bool) (map[string]string, *model.AppError) {
+	fields, appErr := a.GetUserPropertyFields()
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	fieldsById := make(map[string]*model.UserPropertyField, len(fields))
+	for _, field := range fields {
+		fieldsById[field.Id] = field
+	}
+
+	values := make([]*model.UserPropertyValue, 0, len(patch))
+	for fieldId, value := range patch {
+		field, ok := fieldsById[fieldId]
+		if !ok {
+			return nil, model.NewAppError("PatchUserPropertyValues", "app.user_property.patch_values.invalid_field.app_error", nil, "field_id="+fieldId, http.StatusBadRequest)
+		}
+
+		if field.IsSynced() && !fromSync {
+			return nil, model.NewAppError("PatchUserPropertyValues", "app.user_property.patch_values.synced_field.app_error", map[string]interface{}{"Name": field.Name}, "field_id="+fieldId, http.StatusBadRequest)
+		}
+
+		if appErr := field.ValidateValue(value); appErr != nil {
+			return nil, appErr
+		}
+
+		values = append(values, &model.UserPropertyValue{FieldId: fieldId, Value: value})
+	}
+
+	if err := a.Srv().Store.UserProperty().SaveValuesForUser(userId, values); err != nil {
+		return nil, model.NewAppError("PatchUserPropertyValues", "app.user_property.save_values.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return a.GetUserPropertyValues(userId)
+}
+
+// SyncUserPropertiesFromLdap copies the directory attributes mapped to custom profile fields into
+// the profile of the user. Values that don't fit their field are left out.
+func (a *App) SyncUserPropertiesFromLdap(user *model.User) *model.AppError {
+	if a.Ldap() == nil || user.AuthData == nil {
+		return nil
+	}
+
+	fields, appErr := a.GetUserPropertyFields()
+	if appErr != nil {
+		return appErr
+	}
+
+	var syncedFields []*model.UserPropertyField
+	var attributes []string
+	for _, field := range fields {
+		if field.IsSynced() {
+			syncedFields = append(syncedFields, field)
+			attributes = append(attributes, field.LdapAttribute)
+		}
+	}
+
+	if len(syncedFields) == 0 {
+		return nil
+	}
+
+	attributeValues, appErr := a.Ldap().GetUserAttributes(*user.AuthData, attributes)
+	if appErr != nil {
+		return appErr
+	}
+
+	patch := make(map[string]string, len(syncedFields))
+	for _, field := range syncedFields {
+		value := attributeValues[field.LdapAttribute]
+		if appErr := field.ValidateValue(value); appErr != nil {
+			mlog.Warn("Skipping invalid value of a synced profile field", mlog.String("user_id", user.Id), mlog.String("field_id", field.Id), mlog.Err(appErr))
+			continue
+		}
+		patch[field.Id] = value
+	}
+
+	_, appErr = a.PatchUserPropertyValues(user.Id, patch, true)
+	return appErr
+}
+
+func userPropertyStoreError(where string, err error) *model.AppError {
+	var appErr *model.AppError
+	var nfErr *store.ErrNotFound
+	var conflictErr *store.ErrConflict
+	switch {
+	case errors.As(err, &appErr):
+		return appErr
+	case errors.As(err, &nfErr):
+		return model.NewAppError(where, "app.user_property.get_field.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+	case errors.As(err, &conflictErr):
+		return model.NewAppError(where, "app.user_property.save_field.conflict.app_error", nil, conflictErr.Error(), http.StatusConflict)
+	default:
+		return model.NewAppError(where, "app.user_property.store.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+// AddUserRelationship mutes or blocks the other user on behalf of the user.
+func (a *App) AddUserRelationship(relationship *model.UserRelationship) (*model.UserRelationship, *model.AppError) {
+	if _, err := a.GetUser(relationship.OtherUserId); err != nil {
+		return nil, err
+	}
+
+	savedRelationship, err := a.Srv().Store.UserRelationship().Save(relationship)
+	if err != nil {
+		var appErr *model.AppError
+		var cErr *store.ErrConflict
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &cErr):
+			return nil, model.NewAppError("AddUserRelationship", "app.user_relationship.add.exists.app_error", nil, cErr.Error(), http.StatusBadRequest)
+		default:
+			return nil, model.NewAppError("AddUserRelationship", "app.user_relationship.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return savedRelationship, nil
+}
+
+// GetUserRelationships returns the users the user muted or blocked, as the given relationship type
+// asks, or both when it is empty.
+func (a *App) GetUserRelationships(userId string, relationshipType string) ([]*model.UserRelationship, *model.AppError) {
+	relationships, err := a.Srv().Store.UserRelationship().GetForUser(userId)
+	if err != nil {
+		return nil, model.NewAppError("GetUserRelationships", "app.user_relationship.get_for_user.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	if relationshipType == "" {
+		return relationships, nil
+	}
+
+	filtered := []*model.UserRelationship{}
+	for _, relationship := range relationships {
+		if relationship.Type == relationshipType {
+			filtered = append(filtered, relationship)
+		}
+	}
+
+	return filtered, nil
+}
+
+func (a *App) RemoveUserRelationship(userId string, otherUserId string, relationshipType string) *model.AppError {
+	if err := a.Srv().Store.UserRelationship().Delete(userId, otherUserId, relationshipType); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("RemoveUserRelationship", "app.user_relationship.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return model.NewAppError("RemoveUserRelationship", "app.user_relationship.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return nil
+}
+
+// checkPostAllowedByBlocks returns an error if the post is a direct message to a user who blocked
+// its author.
+func (a *App) checkPostAllowedByBlocks(post *model.Post, channel *model.Channel) *model.AppError {
+	if channel.Type != model.CHANNEL_DIRECT || post.IsSystemMessage() {
+		return nil
+	}
+
+	otherUserId := channel.GetOtherUserIdForDM(post.UserId)
+	if otherUserId == "" || otherUserId == post.UserId {
+		return nil
+	}
+
+	if _, err := a.Srv().Store.UserRelationship().Get(otherUserId, post.UserId, model.USER_RELATIONSHIP_BLOCK); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil
+		default:
+			return model.NewAppError("checkPostAllowedByBlocks", "app.user_relationship.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return model.NewAppError("checkPostAllowedByBlocks", "app.user_relationship.blocked.app_error", nil, "channel_id="+channel.Id, http.StatusForbidden)
+}
+
+// removeRelatedRecipients keeps the users who muted or blocked the author of a post from being
+// mentioned or notified by it, returning the users left to receive a push notification for all
+// activity.
+func removeRelatedRecipients(relatedUserIds []string, mentions *ExplicitMentions, allActivityPushUserIds []string) []string {
+	if len(relatedUserIds) == 0 {
+		return allActivityPushUserIds
+	}
+
+	related := make(map[string]bool, len(relatedUserIds))
+	for _, userId := range relatedUserIds {
+		related[userId] = true
+		mentions.removeMention(userId)
+	}
+
+	remaining := make([]string, 0, len(allActivityPushUserIds))
+	for _, userId := range allActivityPushUserIds {
+		if !related[userId] {
+			remaining = append(remaining, userId)
+		}
+	}
+
+	return remaining
+}
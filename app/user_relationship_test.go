@@ -0,0 +1,73 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestRemoveRelatedRecipients(t *testing.T) {
+	mutingUserId := model.NewId()
+	blockingUserId := model.NewId()
+	otherUserId := model.NewId()
+
+	mentions := &ExplicitMentions{}
+	mentions.addMention(mutingUserId, KeywordMention)
+	mentions.addMention(blockingUserId, DMMention)
+	mentions.addMention(otherUserId, KeywordMention)
+
+	pushUserIds := removeRelatedRecipients([]string{mutingUserId, blockingUserId}, mentions, []string{mutingUserId, otherUserId})
+
+	assert.Equal(t, map[string]MentionType{otherUserId: KeywordMention}, mentions.Mentions)
+	assert.Equal(t, []string{otherUserId}, pushUserIds)
+
+	pushUserIds = removeRelatedRecipients(nil, mentions, []string{otherUserId})
+	assert.Equal(t, []string{otherUserId}, pushUserIds)
+}
+
+func TestUserRelationshipsBlockDirectMessages(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	dm, err := th.App.GetOrCreateDirectChannel(th.BasicUser.Id, th.BasicUser2.Id)
+	require.Nil(t, err)
+
+	_, err = th.App.AddUserRelationship(&model.UserRelationship{
+		UserId:      th.BasicUser2.Id,
+		OtherUserId: th.BasicUser.Id,
+		Type:        model.USER_RELATIONSHIP_BLOCK,
+	})
+	require.Nil(t, err)
+
+	_, err = th.App.CreatePost(&model.Post{UserId: th.BasicUser.Id, ChannelId: dm.Id, Message: "hello"}, dm, false, true)
+	require.NotNil(t, err)
+	assert.Equal(t, "app.user_relationship.blocked.app_error", err.Id)
+
+	_, err = th.App.CreatePost(&model.Post{UserId: th.BasicUser2.Id, ChannelId: dm.Id, Message: "hello"}, dm, false, true)
+	require.Nil(t, err)
+
+	relationships, err := th.App.GetUserRelationships(th.BasicUser2.Id, model.USER_RELATIONSHIP_BLOCK)
+	require.Nil(t, err)
+	require.Len(t, relationships, 1)
+
+	mutedUser := th.CreateUser()
+	_, err = th.App.AddUserRelationship(&model.UserRelationship{
+		UserId:      th.BasicUser2.Id,
+		OtherUserId: mutedUser.Id,
+		Type:        model.USER_RELATIONSHIP_MUTE,
+	})
+	require.Nil(t, err)
+
+	require.Nil(t, th.App.PermanentDeleteUser(mutedUser))
+
+	relationships, err = th.App.GetUserRelationships(th.BasicUser2.Id, "")
+	require.Nil(t, err)
+	require.Len(t, relationships, 1)
+	assert.Equal(t, th.BasicUser.Id, relationships[0].OtherUserId)
+}
@@ -5,6 +5,7 @@ package app
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"image"
 	"image/color"
@@ -608,14 +609,14 @@ func TestCreateUserWithInviteId(t *testing.T) {
 	t.Run("invalid invite id", func(t *testing.T) {
 		_, err := th.App.CreateUserWithInviteId(&user, "")
 		require.NotNil(t, err)
-		require.Contains(t, err.Id, "store.sql_team.get_by_invite_id")
+		require.Contains(t, err.Id, "app.team.get_by_invite_id")
 	})
 
 	t.Run("invalid domain", func(t *testing.T) {
 		th.BasicTeam.AllowedDomains = "mattermost.com"
-		_, err := th.App.Srv().Store.Team().Update(th.BasicTeam)
-		require.Nil(t, err)
-		_, err = th.App.CreateUserWithInviteId(&user, th.BasicTeam.InviteId)
+		_, nErr := th.App.Srv().Store.Team().Update(context.Background(), th.BasicTeam)
+		require.Nil(t, nErr)
+		_, err := th.App.CreateUserWithInviteId(&user, th.BasicTeam.InviteId)
 		require.NotNil(t, err)
 		require.Equal(t, "api.team.invite_members.invalid_email.app_error", err.Id)
 	})
@@ -827,9 +828,22 @@ func TestPermanentDeleteUser(t *testing.T) {
 	assert.Nil(t, err1)
 	assert.Equal(t, 0, len(bots2))
 
+	// Load the memberships of the user into the caches before the deletion.
+	_, err = th.App.GetTeamMember(th.BasicTeam.Id, th.BasicUser.Id)
+	require.Nil(t, err)
+	belongs, err := th.App.Srv().Store.Team().UserBelongsToTeam(context.Background(), th.BasicUser.Id, th.BasicTeam.Id, true)
+	require.Nil(t, err)
+	require.True(t, belongs)
+
 	err = th.App.PermanentDeleteUser(th.BasicUser)
 	require.Nil(t, err, "Unable to delete user. err=%v", err)
 
+	_, err = th.App.GetTeamMember(th.BasicTeam.Id, th.BasicUser.Id)
+	require.NotNil(t, err, "the team membership should have been removed")
+	belongs, err = th.App.Srv().Store.Team().UserBelongsToTeam(context.Background(), th.BasicUser.Id, th.BasicTeam.Id, true)
+	require.Nil(t, err)
+	require.False(t, belongs, "the membership cache should have been invalidated")
+
 	res, err := th.App.FileExists(finfo.Path)
 
 	require.Nil(t, err, "Unable to check whether file exists. err=%v", err)
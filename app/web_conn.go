@@ -39,6 +39,8 @@ type WebConn struct {
 	Sequence         int64
 	UserId           string
 
+	connectionId              string
+	connectedAt               int64
 	allChannelMembers         map[string]string
 	lastAllChannelMembersTime int64
 	lastUserActivityAt        int64
@@ -64,6 +66,8 @@ func (a *App) NewWebConn(ws *websocket.Conn, session model.Session, t goi18n.Tra
 		WebSocket:          ws,
 		lastUserActivityAt: model.GetMillis(),
 		UserId:             session.UserId,
+		connectionId:       model.NewId(),
+		connectedAt:        model.GetMillis(),
 		T:                  t,
 		Locale:             locale,
 		endWritePump:       make(chan struct{}),
@@ -77,6 +81,39 @@ func (a *App) NewWebConn(ws *websocket.Conn, session model.Session, t goi18n.Tra
 	return wc
 }
 
+// GetConnectionId returns the id identifying the connection across the cluster.
+func (wc *WebConn) GetConnectionId() string {
+	return wc.connectionId
+}
+
+// info describes the connection. It must be called from the hub of the connection, which owns
+// its channel members and activity.
+func (wc *WebConn) info(clusterId string) *model.WebConnInfo {
+	info := &model.WebConnInfo{
+		ConnectionId:       wc.connectionId,
+		UserId:             wc.UserId,
+		ClusterId:          clusterId,
+		ConnectedAt:        wc.connectedAt,
+		LastActivityAt:     wc.lastUserActivityAt,
+		SubscribedChannels: -1,
+	}
+
+	if session := wc.GetSession(); session != nil {
+		info.SessionId = session.Id
+		info.ClientType = model.GetWebConnClientType(session)
+	}
+
+	if wc.WebSocket != nil {
+		info.RemoteAddress = wc.WebSocket.RemoteAddr().String()
+	}
+
+	if wc.allChannelMembers != nil {
+		info.SubscribedChannels = len(wc.allChannelMembers)
+	}
+
+	return info
+}
+
 // Close closes the WebConn.
 func (wc *WebConn) Close() {
 	wc.WebSocket.Close()
@@ -0,0 +1,77 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+// GetWebConnections describes the websocket connections open on this node, or only those of the
+// given user.
+func (s *Server) GetWebConnections(userId string) []*model.WebConnInfo {
+	if userId != "" {
+		hub := s.GetHubForUserId(userId)
+		if hub == nil {
+			return []*model.WebConnInfo{}
+		}
+		return hub.ListConnections(userId)
+	}
+
+	infos := []*model.WebConnInfo{}
+	for _, hub := range s.hubs {
+		infos = append(infos, hub.ListConnections("")...)
+	}
+	return infos
+}
+
+// DisconnectWebConnection closes the websocket connection with the given id if it is open on this
+// node, and reports whether it was.
+func (s *Server) DisconnectWebConnection(connectionId string) bool {
+	for _, hub := range s.hubs {
+		if hub.Disconnect(connectionId) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetClusterWebConnections describes the websocket connections open anywhere in the cluster, or only
+// those of the given user.
+func (a *App) GetClusterWebConnections(userId string) ([]*model.WebConnInfo, *model.AppError) {
+	infos := a.Srv().GetWebConnections(userId)
+
+	if a.Cluster() != nil && *a.Config().ClusterSettings.Enable {
+		clusterInfos, err := a.Cluster().GetWebConnections(userId)
+		if err != nil {
+			return nil, model.NewAppError("GetClusterWebConnections", "app.web_conn.get_cluster_web_conns.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+
+		infos = append(infos, clusterInfos...)
+	}
+
+	return infos, nil
+}
+
+// DisconnectWebConnection closes the websocket connection with the given id. The other nodes of the
+// cluster are asked to close it when it isn't open on this one. Clients usually reconnect right away,
+// under a new connection id.
+func (a *App) DisconnectWebConnection(connectionId string) *model.AppError {
+	if a.Srv().DisconnectWebConnection(connectionId) {
+		return nil
+	}
+
+	if a.Cluster() == nil || !*a.Config().ClusterSettings.Enable {
+		return model.NewAppError("DisconnectWebConnection", "app.web_conn.disconnect.not_found.app_error", nil, "connection_id="+connectionId, http.StatusNotFound)
+	}
+
+	a.Cluster().SendClusterMessage(&model.ClusterMessage{
+		Event:    model.CLUSTER_EVENT_DISCONNECT_WEB_CONN,
+		SendType: model.CLUSTER_SEND_RELIABLE,
+		Data:     connectionId,
+	})
+
+	return nil
+}
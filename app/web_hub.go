@@ -35,6 +35,16 @@ type webConnSessionMessage struct {
 	isRegistered chan bool
 }
 
+type webConnListMessage struct {
+	userId string
+	infos  chan []*model.WebConnInfo
+}
+
+type webConnDisconnectMessage struct {
+	connectionId string
+	found        chan bool
+}
+
 // Hub is the central place to manage all websocket connections in the server.
 // It handles different websocket events and sending messages to individual
 // user connections.
@@ -54,6 +64,8 @@ type Hub struct {
 	directMsg       chan *webConnDirectMessage
 	explicitStop    bool
 	checkRegistered chan *webConnSessionMessage
+	listConns       chan *webConnListMessage
+	disconnect      chan *webConnDisconnectMessage
 }
 
 // NewWebHub creates a new Hub.
@@ -69,6 +81,8 @@ func (a *App) NewWebHub() *Hub {
 		activity:        make(chan *webConnActivityMessage),
 		directMsg:       make(chan *webConnDirectMessage),
 		checkRegistered: make(chan *webConnSessionMessage),
+		listConns:       make(chan *webConnListMessage),
+		disconnect:      make(chan *webConnDisconnectMessage),
 	}
 }
 
@@ -97,6 +111,7 @@ func (a *App) HubStart() {
 func (a *App) invalidateCacheForUserSkipClusterSend(userId string) {
 	a.Srv().Store.Channel().InvalidateAllChannelMembersForUser(userId)
 	a.InvalidateWebConnSessionCacheForUser(userId)
+	a.Srv().invalidateProfileCacheForUser(userId)
 }
 
 func (a *App) invalidateCacheForWebhook(webhookId string) {
@@ -345,6 +360,34 @@ func (h *Hub) IsRegistered(userId, sessionToken string) bool {
 	return false
 }
 
+// ListConnections describes the connections of the hub, or only those of the given user if any.
+func (h *Hub) ListConnections(userId string) []*model.WebConnInfo {
+	msg := &webConnListMessage{
+		userId: userId,
+		infos:  make(chan []*model.WebConnInfo),
+	}
+	select {
+	case h.listConns <- msg:
+		return <-msg.infos
+	case <-h.stop:
+	}
+	return nil
+}
+
+// Disconnect closes the connection with the given id, and reports whether the hub held it.
+func (h *Hub) Disconnect(connectionId string) bool {
+	msg := &webConnDisconnectMessage{
+		connectionId: connectionId,
+		found:        make(chan bool),
+	}
+	select {
+	case h.disconnect <- msg:
+		return <-msg.found
+	case <-h.stop:
+	}
+	return false
+}
+
 // Broadcast broadcasts the message to all connections in the hub.
 func (h *Hub) Broadcast(message *model.WebSocketEvent) {
 	// XXX: The hub nil check is because of the way we setup our tests. We call
@@ -425,6 +468,30 @@ func (h *Hub) Start() {
 					}
 				}
 				webSessionMessage.isRegistered <- isRegistered
+			case listMessage := <-h.listConns:
+				clusterId := h.app.GetClusterId()
+				infos := []*model.WebConnInfo{}
+				if listMessage.userId != "" {
+					for _, conn := range connIndex.ForUser(listMessage.userId) {
+						infos = append(infos, conn.info(clusterId))
+					}
+				} else {
+					for conn := range connIndex.All() {
+						infos = append(infos, conn.info(clusterId))
+					}
+				}
+				listMessage.infos <- infos
+			case disconnectMessage := <-h.disconnect:
+				found := false
+				for conn := range connIndex.All() {
+					if conn.GetConnectionId() == disconnectMessage.connectionId {
+						// Closing the socket ends the pumps of the connection, which then unregisters itself.
+						conn.WebSocket.Close()
+						found = true
+						break
+					}
+				}
+				disconnectMessage.found <- found
 			case webConn := <-h.register:
 				connIndex.Add(webConn)
 				atomic.StoreInt64(&h.connectionCount, int64(len(connIndex.All())))
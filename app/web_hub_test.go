@@ -211,6 +211,34 @@ func TestHubIsRegistered(t *testing.T) {
 	assert.False(t, th.App.SessionIsRegistered(*session4))
 }
 
+func TestHubWebConnections(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	s := httptest.NewServer(dummyWebsocketHandler(t))
+	defer s.Close()
+
+	th.App.HubStart()
+	wc1 := registerDummyWebConn(t, th.App, s.Listener.Addr(), th.BasicUser.Id)
+	wc2 := registerDummyWebConn(t, th.App, s.Listener.Addr(), th.BasicUser.Id)
+	wc3 := registerDummyWebConn(t, th.App, s.Listener.Addr(), th.BasicUser2.Id)
+	defer wc1.Close()
+	defer wc2.Close()
+	defer wc3.Close()
+
+	infos := th.App.Srv().GetWebConnections(th.BasicUser.Id)
+	require.Len(t, infos, 2)
+	for _, info := range infos {
+		assert.Equal(t, th.BasicUser.Id, info.UserId)
+		assert.Contains(t, []string{wc1.GetConnectionId(), wc2.GetConnectionId()}, info.ConnectionId)
+	}
+
+	assert.Len(t, th.App.Srv().GetWebConnections(""), 3)
+
+	assert.False(t, th.App.Srv().DisconnectWebConnection(model.NewId()))
+	assert.True(t, th.App.Srv().DisconnectWebConnection(wc1.GetConnectionId()))
+}
+
 // Always run this with -benchtime=0.1s
 // See: https://github.com/golang/go/issues/27217.
 func BenchmarkHubConnIndex(b *testing.B) {
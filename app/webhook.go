@@ -107,6 +107,7 @@ func (a *App) TriggerWebhook(payload *model.OutgoingWebhookPayload, hook *model.
 
 		a.Srv().Go(func() {
 			webhookResp, err := a.doOutgoingWebhookRequest(url, body, contentType)
+			a.Srv().recordIntegrationUsage(hook.Id, model.INTEGRATION_USAGE_TYPE_OUTGOING_WEBHOOK, err == nil)
 			if err != nil {
 				mlog.Error("Event POST failed.", mlog.Err(err))
 				return
@@ -368,6 +369,10 @@ func (a *App) DeleteIncomingWebhook(hookId string) *model.AppError {
 		return err
 	}
 
+	if err := a.Srv().Store.ChannelIntegration().PermanentDeleteByIntegration(hookId); err != nil {
+		return model.NewAppError("DeleteIncomingWebhook", "app.channel_integration.permanent_delete_by_integration.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
 	a.invalidateCacheForWebhook(hookId)
 
 	return nil
@@ -672,6 +677,10 @@ func (a *App) HandleIncomingWebhook(hookId string, req *model.IncomingWebhookReq
 		return model.NewAppError("HandleIncomingWebhook", "web.incoming_webhook.channel_locked.app_error", nil, "", http.StatusForbidden)
 	}
 
+	if err := a.checkIntegrationAllowedInChannel(channel, hook.Id); err != nil {
+		return err
+	}
+
 	var user *model.User
 	if result := <-uchan; result.Err != nil {
 		return model.NewAppError("HandleIncomingWebhook", "web.incoming_webhook.user.app_error", nil, "err="+result.Err.Message, http.StatusForbidden)
@@ -699,6 +708,7 @@ func (a *App) HandleIncomingWebhook(hookId string, req *model.IncomingWebhookReq
 	}
 
 	_, err := a.CreateWebhookPost(hook.UserId, channel, text, overrideUsername, overrideIconUrl, req.IconEmoji, req.Props, webhookType, "")
+	a.Srv().recordIntegrationUsage(hook.Id, model.INTEGRATION_USAGE_TYPE_INCOMING_WEBHOOK, err == nil)
 	return err
 }
 
@@ -0,0 +1,180 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+)
+
+const (
+	WEBHOOK_DELIVERY_QUEUE_SIZE    = 1000
+	WEBHOOK_DELIVERY_CONCURRENCY   = 8
+	WEBHOOK_DELIVERY_MAX_ATTEMPTS  = 5
+	WEBHOOK_DELIVERY_RETRY_BACKOFF = 2 * time.Second
+)
+
+type webhookDelivery struct {
+	url         string
+	contentType string
+	body        []byte
+	attempts    int
+	// onDone, if set, is told whether the body was delivered once the queue is done with it.
+	onDone func(delivered bool)
+}
+
+func (d *webhookDelivery) done(delivered bool) {
+	if d.onDone != nil {
+		d.onDone(delivered)
+	}
+}
+
+// WebhookDeliveryQueue posts webhook payloads in the background, retrying with an exponential
+// backoff the deliveries that fail because of a network error or a server error of the receiver.
+type WebhookDeliveryQueue struct {
+	deliveries   chan *webhookDelivery
+	client       func() *http.Client
+	retryBackoff time.Duration
+	sema         chan struct{}
+	wg           *sync.WaitGroup
+
+	mutex   sync.RWMutex
+	stopped bool
+}
+
+func newWebhookDeliveryQueue(client func() *http.Client) *WebhookDeliveryQueue {
+	return &WebhookDeliveryQueue{
+		deliveries:   make(chan *webhookDelivery, WEBHOOK_DELIVERY_QUEUE_SIZE),
+		client:       client,
+		retryBackoff: WEBHOOK_DELIVERY_RETRY_BACKOFF,
+		sema:         make(chan struct{}, WEBHOOK_DELIVERY_CONCURRENCY),
+		wg:           new(sync.WaitGroup),
+	}
+}
+
+func (s *Server) createWebhookDeliveryQueue() {
+	s.webhookDeliveryQueue = newWebhookDeliveryQueue(func() *http.Client {
+		return s.HTTPService.MakeClient(false)
+	})
+	go s.webhookDeliveryQueue.start()
+}
+
+func (s *Server) stopWebhookDeliveryQueue() {
+	s.webhookDeliveryQueue.stop()
+}
+
+// Enqueue schedules the delivery of the body to the given url. The delivery is dropped when the
+// queue is full or stopped.
+func (q *WebhookDeliveryQueue) Enqueue(url, contentType string, body []byte) bool {
+	return q.EnqueueWithCallback(url, contentType, body, nil)
+}
+
+// EnqueueWithCallback schedules the delivery like Enqueue, then calls onDone once the body was
+// delivered, or once the delivery was given up or dropped.
+func (q *WebhookDeliveryQueue) EnqueueWithCallback(url, contentType string, body []byte, onDone func(delivered bool)) bool {
+	delivery := &webhookDelivery{
+		url:         url,
+		contentType: contentType,
+		body:        body,
+		onDone:      onDone,
+	}
+
+	if !q.enqueue(delivery) {
+		delivery.done(false)
+		return false
+	}
+	return true
+}
+
+func (q *WebhookDeliveryQueue) enqueue(delivery *webhookDelivery) bool {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	if q.stopped {
+		return false
+	}
+
+	select {
+	case q.deliveries <- delivery:
+		return true
+	default:
+		mlog.Warn("Webhook delivery queue is full, dropping delivery.", mlog.String("url", delivery.url))
+		return false
+	}
+}
+
+func (q *WebhookDeliveryQueue) start() {
+	for delivery := range q.deliveries {
+		q.wg.Add(1)
+		q.sema <- struct{}{}
+		go func(delivery *webhookDelivery) {
+			defer func() {
+				<-q.sema
+				q.wg.Done()
+			}()
+
+			delivery.attempts++
+			err := q.deliver(delivery)
+			if err == nil {
+				delivery.done(true)
+				return
+			}
+
+			if delivery.attempts >= WEBHOOK_DELIVERY_MAX_ATTEMPTS {
+				mlog.Error("Webhook delivery failed, giving up.", mlog.String("url", delivery.url), mlog.Int("attempts", delivery.attempts), mlog.Err(err))
+				delivery.done(false)
+				return
+			}
+
+			backoff := q.retryBackoff * time.Duration(1<<uint(delivery.attempts-1))
+			mlog.Warn("Webhook delivery failed, retrying.", mlog.String("url", delivery.url), mlog.Int("attempts", delivery.attempts), mlog.Duration("backoff", backoff), mlog.Err(err))
+			time.AfterFunc(backoff, func() {
+				if !q.enqueue(delivery) {
+					delivery.done(false)
+				}
+			})
+		}(delivery)
+	}
+}
+
+func (q *WebhookDeliveryQueue) deliver(delivery *webhookDelivery) error {
+	req, err := http.NewRequest("POST", delivery.url, bytes.NewReader(delivery.body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", delivery.contentType)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := q.client().Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, MaxIntegrationResponseSize))
+
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// stop drops the deliveries waiting for a retry, and waits for the ones in flight to complete.
+func (q *WebhookDeliveryQueue) stop() {
+	q.mutex.Lock()
+	q.stopped = true
+	close(q.deliveries)
+	q.mutex.Unlock()
+
+	q.wg.Wait()
+}
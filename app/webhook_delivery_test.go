@@ -0,0 +1,148 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestWebhookDeliveryQueue() *WebhookDeliveryQueue {
+	q := newWebhookDeliveryQueue(func() *http.Client { return http.DefaultClient })
+	q.retryBackoff = time.Millisecond
+	go q.start()
+	return q
+}
+
+func TestWebhookDeliveryQueue(t *testing.T) {
+	t.Run("delivers the body", func(t *testing.T) {
+		bodies := make(chan string, 1)
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			body, _ := ioutil.ReadAll(r.Body)
+			bodies <- string(body)
+		}))
+		defer ts.Close()
+
+		q := newTestWebhookDeliveryQueue()
+		defer q.stop()
+
+		require.True(t, q.Enqueue(ts.URL, "application/json", []byte(`{"status":"online"}`)))
+
+		select {
+		case body := <-bodies:
+			assert.Equal(t, `{"status":"online"}`, body)
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "webhook not delivered")
+		}
+	})
+
+	t.Run("retries server errors", func(t *testing.T) {
+		var calls int32
+		delivered := make(chan struct{})
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			close(delivered)
+		}))
+		defer ts.Close()
+
+		q := newTestWebhookDeliveryQueue()
+		defer q.stop()
+
+		require.True(t, q.Enqueue(ts.URL, "application/json", []byte("{}")))
+
+		select {
+		case <-delivered:
+			assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "webhook not delivered")
+		}
+	})
+
+	t.Run("gives up after the maximum number of attempts", func(t *testing.T) {
+		var calls int32
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer ts.Close()
+
+		q := newTestWebhookDeliveryQueue()
+		defer q.stop()
+
+		require.True(t, q.Enqueue(ts.URL, "application/json", []byte("{}")))
+
+		assert.Eventually(t, func() bool {
+			return atomic.LoadInt32(&calls) == WEBHOOK_DELIVERY_MAX_ATTEMPTS
+		}, 5*time.Second, 10*time.Millisecond)
+		time.Sleep(50 * time.Millisecond)
+		assert.Equal(t, int32(WEBHOOK_DELIVERY_MAX_ATTEMPTS), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("does not retry client errors", func(t *testing.T) {
+		var calls int32
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusBadRequest)
+		}))
+		defer ts.Close()
+
+		q := newTestWebhookDeliveryQueue()
+
+		require.True(t, q.Enqueue(ts.URL, "application/json", []byte("{}")))
+		assert.Eventually(t, func() bool {
+			return atomic.LoadInt32(&calls) == 1
+		}, 5*time.Second, 10*time.Millisecond)
+
+		q.stop()
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+		assert.False(t, q.Enqueue(ts.URL, "application/json", []byte("{}")), "stopped queue should drop deliveries")
+	})
+
+	t.Run("reports the outcome of the delivery", func(t *testing.T) {
+		var calls int32
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) == 1 {
+				return
+			}
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer ts.Close()
+
+		q := newTestWebhookDeliveryQueue()
+
+		results := make(chan bool, 3)
+		onDone := func(delivered bool) { results <- delivered }
+
+		require.True(t, q.EnqueueWithCallback(ts.URL, "application/json", []byte("{}"), onDone))
+		select {
+		case delivered := <-results:
+			assert.True(t, delivered)
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "delivery outcome not reported")
+		}
+
+		require.True(t, q.EnqueueWithCallback(ts.URL, "application/json", []byte("{}"), onDone))
+		select {
+		case delivered := <-results:
+			assert.False(t, delivered)
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "delivery outcome not reported")
+		}
+
+		q.stop()
+		assert.False(t, q.EnqueueWithCallback(ts.URL, "application/json", []byte("{}"), onDone))
+		assert.False(t, <-results, "dropped delivery should be reported as failed")
+	})
+}
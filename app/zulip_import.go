@@ -0,0 +1,396 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+// The files of a Zulip export, as written by its export management command. The messages are split
+// across numbered files, and the uploaded files are read from the uploads directory by their path.
+const (
+	zulipRealmFile        = "realm.json"
+	zulipMessagesPattern  = "messages-*.json"
+	zulipUploadsDir       = "uploads"
+	zulipRecipientUser    = 1
+	zulipRecipientStream  = 2
+	zulipRecipientHuddle  = 3
+	zulipRoleRealmAdmin   = 200
+	zulipTopicPlaceholder = "(no topic)"
+)
+
+var (
+	zulipUserMentionRegexp    = regexp.MustCompile(`@_?\*\*([^*|]+)(?:\|(\d+))?\*\*`)
+	zulipStreamMentionRegexp  = regexp.MustCompile(`#\*\*([^*>]+)(?:>[^*]*)?\*\*`)
+	zulipMessageFileSeparator = regexp.MustCompile(`-0*(\d+)\.json$`)
+)
+
+type zulipUser struct {
+	Id            int64  `json:"id"`
+	Email         string `json:"email"`
+	DeliveryEmail string `json:"delivery_email"`
+	FullName      string `json:"full_name"`
+	IsActive      bool   `json:"is_active"`
+	IsBot         bool   `json:"is_bot"`
+	Role          int    `json:"role"`
+	IsRealmAdmin  bool   `json:"is_realm_admin"`
+}
+
+type zulipStream struct {
+	Id          int64  `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	InviteOnly  bool   `json:"invite_only"`
+	Deactivated bool   `json:"deactivated"`
+}
+
+type zulipRecipient struct {
+	Id     int64 `json:"id"`
+	Type   int   `json:"type"`
+	TypeId int64 `json:"type_id"`
+}
+
+type zulipSubscription struct {
+	UserId      int64 `json:"user_profile"`
+	RecipientId int64 `json:"recipient"`
+	Active      *bool `json:"active"`
+}
+
+type zulipReaction struct {
+	MessageId int64  `json:"message"`
+	UserId    int64  `json:"user_profile"`
+	EmojiName string `json:"emoji_name"`
+}
+
+type zulipAttachment struct {
+	Id         int64   `json:"id"`
+	FileName   string  `json:"file_name"`
+	PathId     string  `json:"path_id"`
+	MessageIds []int64 `json:"messages"`
+}
+
+type zulipRealm struct {
+	Users         []zulipUser         `json:"zerver_userprofile"`
+	Streams       []zulipStream       `json:"zerver_stream"`
+	Recipients    []zulipRecipient    `json:"zerver_recipient"`
+	Subscriptions []zulipSubscription `json:"zerver_subscription"`
+	Reactions     []zulipReaction     `json:"zerver_reaction"`
+	Attachments   []zulipAttachment   `json:"zerver_attachment"`
+}
+
+type zulipMessage struct {
+	Id          int64    `json:"id"`
+	SenderId    int64    `json:"sender"`
+	RecipientId int64    `json:"recipient"`
+	Subject     string   `json:"subject"`
+	Content     string   `json:"content"`
+	DateSent    *float64 `json:"date_sent"`
+	PubDate     *float64 `json:"pub_date"`
+}
+
+func (m *zulipMessage) createAt() int64 {
+	if m.DateSent != nil {
+		return int64(*m.DateSent * 1000)
+	}
+	if m.PubDate != nil {
+		return int64(*m.PubDate * 1000)
+	}
+	return 0
+}
+
+// ConvertZulipExport converts the Zulip export in exportDir into a bulk import file for the given
+// team, written to outputDir along with the attached files and the mapping report. Streams become
+// channels, private and group messages direct channels, and each topic a thread whose first message
+// starts with the name of the topic.
+func ConvertZulipExport(exportDir, team, outputDir string) (*ChatImportReport, error) {
+	c, err := newChatImportConverter("zulip", team, outputDir)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(exportDir, zulipRealmFile))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read the realm")
+	}
+
+	var realm zulipRealm
+	if err := json.Unmarshal(data, &realm); err != nil {
+		return nil, errors.Wrap(err, "failed to decode the realm")
+	}
+
+	usernamesByFullName := map[string]string{}
+	for _, user := range realm.Users {
+		sourceId := strconv.FormatInt(user.Id, 10)
+
+		email := user.DeliveryEmail
+		if email == "" {
+			email = user.Email
+		}
+
+		username := email
+		if i := strings.Index(email, "@"); i != -1 {
+			username = email[:i]
+		}
+
+		firstName, lastName := user.FullName, ""
+		if i := strings.Index(user.FullName, " "); i != -1 {
+			firstName, lastName = user.FullName[:i], user.FullName[i+1:]
+		}
+
+		if user.IsBot {
+			c.note("user", sourceId, "bot imported as a user")
+		}
+
+		teamAdmin := user.IsRealmAdmin || (user.Role > 0 && user.Role <= zulipRoleRealmAdmin)
+		usernamesByFullName[user.FullName] = c.addUser(sourceId, username, email, firstName, lastName, teamAdmin, !user.IsActive)
+	}
+
+	channelNamesByStream := map[string]string{}
+	streamsById := map[int64]zulipStream{}
+	for _, stream := range realm.Streams {
+		sourceId := strconv.FormatInt(stream.Id, 10)
+		streamsById[stream.Id] = stream
+
+		if stream.Deactivated {
+			c.note("stream", sourceId, "deactivated stream, skipped")
+			continue
+		}
+
+		channelType := model.CHANNEL_OPEN
+		if stream.InviteOnly {
+			channelType = model.CHANNEL_PRIVATE
+		}
+		channelNamesByStream[stream.Name] = c.addChannel(sourceId, stream.Name, stream.Name, channelType, "", stream.Description)
+	}
+
+	// Messages are sent to recipients: a stream, a user, or a group of users, called a huddle.
+	recipients := map[int64]zulipRecipient{}
+	for _, recipient := range realm.Recipients {
+		recipients[recipient.Id] = recipient
+	}
+
+	huddleMembers := map[int64][]string{}
+	for _, subscription := range realm.Subscriptions {
+		recipient, ok := recipients[subscription.RecipientId]
+		if !ok {
+			continue
+		}
+
+		userSourceId := strconv.FormatInt(subscription.UserId, 10)
+		switch recipient.Type {
+		case zulipRecipientStream:
+			if subscription.Active != nil && !*subscription.Active {
+				continue
+			}
+			if channelName, ok := channelNamesByStream[streamsById[recipient.TypeId].Name]; ok {
+				c.joinChannel(userSourceId, channelName)
+			}
+		case zulipRecipientHuddle:
+			huddleMembers[recipient.Id] = append(huddleMembers[recipient.Id], userSourceId)
+		}
+	}
+
+	reactions := map[int64][]ReactionImportData{}
+	for _, reaction := range realm.Reactions {
+		if username := c.username(strconv.FormatInt(reaction.UserId, 10)); username != "" {
+			reactions[reaction.MessageId] = append(reactions[reaction.MessageId], ReactionImportData{
+				User:      model.NewString(username),
+				EmojiName: model.NewString(reaction.EmojiName),
+			})
+		}
+	}
+
+	attachments := map[int64][]zulipAttachment{}
+	for _, attachment := range realm.Attachments {
+		for _, messageId := range attachment.MessageIds {
+			attachments[messageId] = append(attachments[messageId], attachment)
+		}
+	}
+
+	messageFiles, err := filepath.Glob(filepath.Join(exportDir, zulipMessagesPattern))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list the messages")
+	}
+	sort.Slice(messageFiles, func(i, j int) bool {
+		return zulipMessageFileNumber(messageFiles[i]) < zulipMessageFileNumber(messageFiles[j])
+	})
+
+	type conversation struct {
+		channelName string
+		members     []string
+		threads     []*chatImportMessage
+		topics      map[string]*chatImportMessage
+	}
+	conversations := map[string]*conversation{}
+	var conversationKeys []string
+
+	getConversation := func(key, channelName string, members []string) *conversation {
+		conv, ok := conversations[key]
+		if !ok {
+			conv = &conversation{channelName: channelName, members: members, topics: map[string]*chatImportMessage{}}
+			conversations[key] = conv
+			conversationKeys = append(conversationKeys, key)
+		}
+		return conv
+	}
+
+	directChannels := map[string][]string{}
+
+	for _, messageFile := range messageFiles {
+		data, err := ioutil.ReadFile(messageFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read the messages")
+		}
+
+		var messages struct {
+			Messages []zulipMessage `json:"zerver_message"`
+		}
+		if err := json.Unmarshal(data, &messages); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode %s", filepath.Base(messageFile))
+		}
+
+		for _, message := range messages.Messages {
+			sourceId := strconv.FormatInt(message.Id, 10)
+			senderSourceId := strconv.FormatInt(message.SenderId, 10)
+
+			user := c.username(senderSourceId)
+			if user == "" {
+				c.note("message", sourceId, "author not imported, skipped")
+				continue
+			}
+
+			createAt := message.createAt()
+			if createAt <= 0 {
+				c.note("message", sourceId, "no time, skipped")
+				continue
+			}
+
+			recipient, ok := recipients[message.RecipientId]
+			if !ok {
+				c.note("message", sourceId, "recipient not found, skipped")
+				continue
+			}
+
+			converted := &chatImportMessage{
+				sourceId:  sourceId,
+				user:      user,
+				message:   convertZulipMentions(message.Content, usernamesByFullName, c.report.Users, channelNamesByStream),
+				createAt:  createAt,
+				reactions: reactions[message.Id],
+			}
+
+			for _, attachment := range attachments[message.Id] {
+				if a := c.addAttachment(strconv.FormatInt(attachment.Id, 10), filepath.Join(exportDir, zulipUploadsDir, filepath.FromSlash(attachment.PathId)), attachment.FileName); a != nil {
+					converted.attachments = append(converted.attachments, *a)
+				}
+			}
+
+			switch recipient.Type {
+			case zulipRecipientStream:
+				channelName, ok := channelNamesByStream[streamsById[recipient.TypeId].Name]
+				if !ok {
+					c.note("message", sourceId, "stream not imported, skipped")
+					continue
+				}
+				c.joinChannel(senderSourceId, channelName)
+
+				conv := getConversation("stream:"+channelName, channelName, nil)
+				topic := message.Subject
+				if topic == "" {
+					topic = zulipTopicPlaceholder
+				}
+
+				if root, ok := conv.topics[topic]; ok {
+					root.replies = append(root.replies, converted)
+					continue
+				}
+				converted.message = "**" + topic + "**\n" + converted.message
+				conv.topics[topic] = converted
+				conv.threads = append(conv.threads, converted)
+
+			case zulipRecipientUser, zulipRecipientHuddle:
+				var memberSourceIds []string
+				if recipient.Type == zulipRecipientUser {
+					memberSourceIds = []string{senderSourceId, strconv.FormatInt(recipient.TypeId, 10)}
+				} else {
+					memberSourceIds = huddleMembers[recipient.Id]
+				}
+				sort.Strings(memberSourceIds)
+				key := "direct:" + strings.Join(model.RemoveDuplicateStrings(memberSourceIds), ",")
+
+				members, ok := directChannels[key]
+				if !ok {
+					members = c.addDirectChannel(sourceId, memberSourceIds)
+					directChannels[key] = members
+				}
+				if members == nil {
+					continue
+				}
+
+				conv := getConversation(key, "", members)
+				conv.threads = append(conv.threads, converted)
+
+			default:
+				c.note("message", sourceId, fmt.Sprintf("recipients of type %d are not supported, skipped", recipient.Type))
+			}
+		}
+	}
+
+	for _, key := range conversationKeys {
+		conv := conversations[key]
+		c.addThreads(conv.channelName, conv.members, conv.threads)
+	}
+
+	if err := c.write(); err != nil {
+		return nil, err
+	}
+
+	return c.report, nil
+}
+
+func zulipMessageFileNumber(path string) int {
+	match := zulipMessageFileSeparator.FindStringSubmatch(path)
+	if match == nil {
+		return 0
+	}
+	number, _ := strconv.Atoi(match[1])
+	return number
+}
+
+// convertZulipMentions rewrites the user and stream mentions of a message into the mentions of the
+// users and channels they are imported as.
+func convertZulipMentions(message string, usernamesByFullName, usernamesById, channelNamesByStream map[string]string) string {
+	message = zulipUserMentionRegexp.ReplaceAllStringFunc(message, func(mention string) string {
+		match := zulipUserMentionRegexp.FindStringSubmatch(mention)
+		if username, ok := usernamesById[match[2]]; ok && match[2] != "" {
+			return "@" + username
+		}
+		if username, ok := usernamesByFullName[match[1]]; ok {
+			return "@" + username
+		}
+		if match[1] == "all" || match[1] == "everyone" || match[1] == "stream" {
+			return "@channel"
+		}
+		return mention
+	})
+
+	return zulipStreamMentionRegexp.ReplaceAllStringFunc(message, func(mention string) string {
+		match := zulipStreamMentionRegexp.FindStringSubmatch(mention)
+		if channelName, ok := channelNamesByStream[match[1]]; ok {
+			return "~" + channelName
+		}
+		return mention
+	})
+}
@@ -15,6 +15,8 @@ const (
 	KeyIPAddress = "ip_address"
 	KeyClusterID = "cluster_id"
 
+	KeyImpersonatorID = "impersonator_id"
+
 	Success = "success"
 	Attempt = "attempt"
 	Fail    = "fail"
@@ -0,0 +1,240 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mattermost/mattermost-server/v5/app"
+	"github.com/mattermost/mattermost-server/v5/audit"
+	"github.com/mattermost/mattermost-server/v5/services/backup"
+)
+
+const BACKUP_PASSPHRASE_ENV = "MM_BACKUP_PASSPHRASE"
+
+var BackupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Back up and restore the server.",
+}
+
+var BackupCreateCmd = &cobra.Command{
+	Use:   "create [file]",
+	Short: "Create a backup.",
+	Long: `Back up the database and the files it references into a single archive.
+The backup is encrypted when a passphrase is given with --passphrase-file or the ` + BACKUP_PASSPHRASE_ENV + ` environment variable.`,
+	Example: `  backup create mattermost.mmbackup
+  backup create --incremental-from mattermost.mmbackup mattermost-incremental.mmbackup`,
+	RunE: backupCreateCmdF,
+}
+
+var BackupRestoreCmd = &cobra.Command{
+	Use:   "restore [file]",
+	Short: "Restore a backup.",
+	Long: `Verify a backup and restore it into the database and file store.
+Incremental backups must be restored in order, after the full backup they are based on.`,
+	Example: "  backup restore --apply mattermost.mmbackup",
+	RunE:    backupRestoreCmdF,
+}
+
+func init() {
+	BackupCreateCmd.Flags().String("incremental-from", "", "Only back up what changed since the given backup was taken.")
+	BackupCreateCmd.Flags().Int64("since", 0, "Only back up what changed since the given timestamp, in milliseconds.")
+	BackupCreateCmd.Flags().Bool("no-files", false, "Only list the referenced files in the backup instead of copying them.")
+	BackupCreateCmd.Flags().String("passphrase-file", "", "File holding the passphrase to encrypt the backup with.")
+
+	BackupRestoreCmd.Flags().Bool("apply", false, "Save the backup data to the database. Use with caution - this cannot be reverted.")
+	BackupRestoreCmd.Flags().Bool("verify-only", false, "Only check the integrity of the backup archive.")
+	BackupRestoreCmd.Flags().Int("workers", 2, "How many workers to run whilst restoring the backup.")
+	BackupRestoreCmd.Flags().String("passphrase-file", "", "File holding the passphrase the backup was encrypted with.")
+
+	BackupCmd.AddCommand(
+		BackupCreateCmd,
+		BackupRestoreCmd,
+	)
+	RootCmd.AddCommand(BackupCmd)
+}
+
+// getBackupPassphrase reads the passphrase from the --passphrase-file flag, falling back to the
+// environment so it never has to appear on the command line.
+func getBackupPassphrase(command *cobra.Command) (string, error) {
+	passphraseFile, err := command.Flags().GetString("passphrase-file")
+	if err != nil {
+		return "", errors.New("Passphrase file flag error")
+	}
+
+	if passphraseFile == "" {
+		return os.Getenv(BACKUP_PASSPHRASE_ENV), nil
+	}
+
+	passphrase, err := ioutil.ReadFile(passphraseFile)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(passphrase)), nil
+}
+
+func backupCreateCmdF(command *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return errors.New("Incorrect number of arguments.")
+	}
+
+	incrementalFrom, err := command.Flags().GetString("incremental-from")
+	if err != nil {
+		return errors.New("Incremental from flag error")
+	}
+
+	since, err := command.Flags().GetInt64("since")
+	if err != nil || since < 0 {
+		return errors.New("Since flag error")
+	}
+
+	if incrementalFrom != "" && since != 0 {
+		return errors.New("Use only one of --incremental-from or --since.")
+	}
+
+	noFiles, err := command.Flags().GetBool("no-files")
+	if err != nil {
+		return errors.New("No files flag error")
+	}
+
+	passphrase, err := getBackupPassphrase(command)
+	if err != nil {
+		return err
+	}
+
+	if incrementalFrom != "" {
+		previous, err := backup.Open(incrementalFrom, passphrase)
+		if err != nil {
+			return err
+		}
+		since = previous.Manifest.Until
+		previous.Close()
+	}
+
+	a, err := InitDBCommandContextCobra(command)
+	if err != nil {
+		return err
+	}
+	defer a.Shutdown()
+
+	file, err := os.OpenFile(args[0], os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	CommandPrettyPrintln("Running Backup. This may take a long time.")
+
+	manifest, appErr := a.CreateBackup(file, app.BackupOptions{
+		Since:        since,
+		IncludeFiles: !noFiles,
+		Passphrase:   passphrase,
+	})
+	if appErr != nil {
+		CommandPrintErrorln(appErr.Error())
+		return appErr
+	}
+
+	CommandPrettyPrintln(fmt.Sprintf("Finished Backup of %d files. Changes up to %d are included.", len(manifest.Files), manifest.Until))
+
+	auditRec := a.MakeAuditRecord("backupCreate", audit.Success)
+	auditRec.AddMeta("file", args[0])
+	auditRec.AddMeta("since", since)
+	auditRec.AddMeta("encrypted", passphrase != "")
+	a.LogAuditRec(auditRec, nil)
+
+	return nil
+}
+
+func backupRestoreCmdF(command *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return errors.New("Incorrect number of arguments.")
+	}
+
+	apply, err := command.Flags().GetBool("apply")
+	if err != nil {
+		return errors.New("Apply flag error")
+	}
+
+	verifyOnly, err := command.Flags().GetBool("verify-only")
+	if err != nil {
+		return errors.New("Verify only flag error")
+	}
+
+	workers, err := command.Flags().GetInt("workers")
+	if err != nil || workers < 1 {
+		return errors.New("Workers flag error")
+	}
+
+	if apply && verifyOnly {
+		return errors.New("Use only one of --apply or --verify-only.")
+	}
+
+	passphrase, err := getBackupPassphrase(command)
+	if err != nil {
+		return err
+	}
+
+	if verifyOnly {
+		archive, err := backup.Open(args[0], passphrase)
+		if err != nil {
+			return err
+		}
+		defer archive.Close()
+
+		if err := archive.Verify(); err != nil {
+			return err
+		}
+
+		CommandPrettyPrintln("The backup is intact.")
+		return nil
+	}
+
+	a, err := InitDBCommandContextCobra(command)
+	if err != nil {
+		return err
+	}
+	defer a.Shutdown()
+
+	if apply {
+		CommandPrettyPrintln("Running Restore. This may take a long time.")
+	} else {
+		CommandPrettyPrintln("Running Restore Validation.")
+		CommandPrettyPrintln("** This checks the backup and the validity of its data, but does not persist any changes **")
+		CommandPrettyPrintln("Use the --apply flag to perform the actual restore.")
+	}
+
+	CommandPrettyPrintln("")
+
+	manifest, appErr, lineNumber := a.RestoreBackup(args[0], passphrase, apply, workers)
+	if appErr != nil {
+		CommandPrintErrorln(appErr.Error())
+		if lineNumber != 0 {
+			CommandPrintErrorln(fmt.Sprintf("Error occurred on backup data line %v", lineNumber))
+		}
+		return appErr
+	}
+
+	if manifest.Incremental() {
+		CommandPrettyPrintln(fmt.Sprintf("The backup is incremental from %d.", manifest.Since))
+	}
+
+	if apply {
+		CommandPrettyPrintln("Finished Restore.")
+		auditRec := a.MakeAuditRecord("backupRestore", audit.Success)
+		auditRec.AddMeta("file", args[0])
+		a.LogAuditRec(auditRec, nil)
+	} else {
+		CommandPrettyPrintln("Validation complete. You can now perform the restore by rerunning this command with the --apply flag.")
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package commands
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupCreateBadParameters(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "mattermost.mmbackup")
+
+	require.Error(t, th.RunCommand(t, "backup", "create"))
+	require.Error(t, th.RunCommand(t, "backup", "create", "--since", "-1", file))
+	require.Error(t, th.RunCommand(t, "backup", "create", "--since", "1", "--incremental-from", file, file))
+	require.Error(t, th.RunCommand(t, "backup", "create", "--incremental-from", filepath.Join(dir, "missing.mmbackup"), file))
+	require.Error(t, th.RunCommand(t, "backup", "create", "--passphrase-file", filepath.Join(dir, "missing"), file))
+}
+
+func TestBackupRestoreBadParameters(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	notABackup := filepath.Join(dir, "mattermost.mmbackup")
+	require.NoError(t, ioutil.WriteFile(notABackup, []byte("not a backup"), 0600))
+
+	require.Error(t, th.RunCommand(t, "backup", "restore"))
+	require.Error(t, th.RunCommand(t, "backup", "restore", "--apply", "--verify-only", notABackup))
+	require.Error(t, th.RunCommand(t, "backup", "restore", "--workers", "0", notABackup))
+	require.Error(t, th.RunCommand(t, "backup", "restore", "--verify-only", notABackup))
+	require.Error(t, th.RunCommand(t, "backup", "restore", "--verify-only", filepath.Join(dir, "missing.mmbackup")))
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mattermost/mattermost-server/v5/app"
+	"github.com/mattermost/mattermost-server/v5/audit"
+)
+
+var ChannelSnapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Snapshot and restore a channel.",
+}
+
+var ChannelSnapshotCreateCmd = &cobra.Command{
+	Use:   "create [channel] [file]",
+	Short: "Snapshot a channel",
+	Long: `Save the members, posts and files of a channel into a snapshot file.
+Channel can be specified by [team]:[channel]. ie. myteam:mychannel or by channel ID.
+The snapshot is encrypted when a passphrase is given with --passphrase-file or the ` + BACKUP_PASSPHRASE_ENV + ` environment variable.`,
+	Example: "  channel snapshot create myteam:mychannel mychannel.mmbackup",
+	Args:    cobra.ExactArgs(2),
+	RunE:    createChannelSnapshotCmdF,
+}
+
+var ChannelSnapshotRestoreCmd = &cobra.Command{
+	Use:   "restore [team] [file] --name [name]",
+	Short: "Restore a channel snapshot",
+	Long: `Restore a channel snapshot into a new channel of the given team.
+Members that no longer belong to the team are skipped.`,
+	Example: "  channel snapshot restore myteam mychannel.mmbackup --name mychannel-restored",
+	Args:    cobra.ExactArgs(2),
+	RunE:    restoreChannelSnapshotCmdF,
+}
+
+func init() {
+	ChannelSnapshotCreateCmd.Flags().Bool("no-files", false, "Leave the files attached to the posts out of the snapshot.")
+	ChannelSnapshotCreateCmd.Flags().String("passphrase-file", "", "File holding the passphrase to encrypt the snapshot with.")
+
+	ChannelSnapshotRestoreCmd.Flags().String("name", "", "Required. Name of the channel to restore the snapshot into.")
+	ChannelSnapshotRestoreCmd.Flags().String("passphrase-file", "", "File holding the passphrase the snapshot was encrypted with.")
+
+	ChannelSnapshotCmd.AddCommand(
+		ChannelSnapshotCreateCmd,
+		ChannelSnapshotRestoreCmd,
+	)
+	ChannelCmd.AddCommand(ChannelSnapshotCmd)
+}
+
+func createChannelSnapshotCmdF(command *cobra.Command, args []string) error {
+	noFiles, err := command.Flags().GetBool("no-files")
+	if err != nil {
+		return errors.New("No files flag error")
+	}
+
+	passphrase, err := getBackupPassphrase(command)
+	if err != nil {
+		return err
+	}
+
+	a, err := InitDBCommandContextCobra(command)
+	if err != nil {
+		return err
+	}
+	defer a.Shutdown()
+
+	channel := getChannelFromChannelArg(a, args[0])
+	if channel == nil {
+		return errors.New("Unable to find channel '" + args[0] + "'")
+	}
+
+	file, err := os.OpenFile(args[1], os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	manifest, appErr := a.SnapshotChannel(channel.Id, file, app.ChannelSnapshotOptions{
+		IncludeFiles: !noFiles,
+		Passphrase:   passphrase,
+	})
+	if appErr != nil {
+		return appErr
+	}
+
+	CommandPrettyPrintln(fmt.Sprintf("Saved a snapshot of the channel with %d files.", len(manifest.Files)))
+
+	auditRec := a.MakeAuditRecord("createChannelSnapshot", audit.Success)
+	auditRec.AddMeta("channel", channel)
+	auditRec.AddMeta("file", args[1])
+	a.LogAuditRec(auditRec, nil)
+
+	return nil
+}
+
+func restoreChannelSnapshotCmdF(command *cobra.Command, args []string) error {
+	name, err := command.Flags().GetString("name")
+	if err != nil || name == "" {
+		return errors.New("Name is required")
+	}
+
+	passphrase, err := getBackupPassphrase(command)
+	if err != nil {
+		return err
+	}
+
+	a, err := InitDBCommandContextCobra(command)
+	if err != nil {
+		return err
+	}
+	defer a.Shutdown()
+
+	team := getTeamFromTeamArg(a, args[0])
+	if team == nil {
+		return errors.New("Unable to find team '" + args[0] + "'")
+	}
+
+	channel, appErr := a.RestoreChannelSnapshot(args[1], passphrase, team.Id, name)
+	if appErr != nil {
+		if channel != nil {
+			CommandPrintErrorln("The snapshot was partially restored into channel " + channel.Name + ".")
+		}
+		return appErr
+	}
+
+	CommandPrettyPrintln("Restored the snapshot into channel " + channel.Name + ".")
+
+	auditRec := a.MakeAuditRecord("restoreChannelSnapshot", audit.Success)
+	auditRec.AddMeta("channel", channel)
+	auditRec.AddMeta("file", args[1])
+	a.LogAuditRec(auditRec, nil)
+
+	return nil
+}
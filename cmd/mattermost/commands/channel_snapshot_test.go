@@ -0,0 +1,35 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package commands
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelSnapshotBadParameters(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	dir, err := ioutil.TempDir("", "snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "channel.mmbackup")
+	channelArg := th.BasicTeam.Name + ":" + th.BasicChannel.Name
+
+	require.Error(t, th.RunCommand(t, "channel", "snapshot", "create", channelArg))
+	require.Error(t, th.RunCommand(t, "channel", "snapshot", "create", th.BasicTeam.Name+":missing", file))
+
+	require.NoError(t, th.RunCommand(t, "channel", "snapshot", "create", channelArg, file))
+
+	require.Error(t, th.RunCommand(t, "channel", "snapshot", "restore", th.BasicTeam.Name, file))
+	require.Error(t, th.RunCommand(t, "channel", "snapshot", "restore", "missing", file, "--name", "restored"))
+	require.Error(t, th.RunCommand(t, "channel", "snapshot", "restore", th.BasicTeam.Name, file, "--name", th.BasicChannel.Name))
+	require.NoError(t, th.RunCommand(t, "channel", "snapshot", "restore", th.BasicTeam.Name, file, "--name", "restored"))
+}
@@ -4,6 +4,7 @@
 package commands
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
@@ -149,7 +150,7 @@ func TestCreateChannel(t *testing.T) {
 
 	id := model.NewId()
 	commonName := "name" + id
-	team, _ := th.App.Srv().Store.Team().GetByName(th.BasicTeam.Name)
+	team, _ := th.App.Srv().Store.Team().GetByName(context.Background(), th.BasicTeam.Name)
 
 	t.Run("should create public channel", func(t *testing.T) {
 		th.CheckCommand(t, "channel", "create", "--display_name", commonName, "--team", th.BasicTeam.Name, "--name", commonName)
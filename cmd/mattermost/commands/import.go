@@ -6,9 +6,11 @@ package commands
 import (
 	"errors"
 	"os"
+	"path/filepath"
 
 	"fmt"
 
+	"github.com/mattermost/mattermost-server/v5/app"
 	"github.com/mattermost/mattermost-server/v5/audit"
 	"github.com/spf13/cobra"
 )
@@ -34,14 +36,38 @@ var BulkImportCmd = &cobra.Command{
 	RunE:    bulkImportCmdF,
 }
 
+var RocketChatImportCmd = &cobra.Command{
+	Use:     "rocketchat [team] [export_dir]",
+	Short:   "Import a team from Rocket.Chat.",
+	Long:    "Import a team from a Rocket.Chat export, a directory holding the users, rocketchat_room, rocketchat_subscription and rocketchat_message collections exported by mongoexport and an uploads directory with the uploaded files named after their ids.",
+	Example: "  import rocketchat myteam rocketchat_export --output rocketchat_import --apply",
+	RunE:    rocketChatImportCmdF,
+}
+
+var ZulipImportCmd = &cobra.Command{
+	Use:     "zulip [team] [export_dir]",
+	Short:   "Import a team from Zulip.",
+	Long:    "Import a team from an unpacked Zulip realm export.",
+	Example: "  import zulip myteam zulip_export --output zulip_import --apply",
+	RunE:    zulipImportCmdF,
+}
+
 func init() {
 	BulkImportCmd.Flags().Bool("apply", false, "Save the import data to the database. Use with caution - this cannot be reverted.")
 	BulkImportCmd.Flags().Bool("validate", false, "Validate the import data without making any changes to the system.")
 	BulkImportCmd.Flags().Int("workers", 2, "How many workers to run whilst doing the import.")
 
+	for _, command := range []*cobra.Command{RocketChatImportCmd, ZulipImportCmd} {
+		command.Flags().String("output", "", "Directory the converted data, the attached files and the mapping report are written to. Defaults to a directory named after the export.")
+		command.Flags().Bool("apply", false, "Save the import data to the database. Use with caution - this cannot be reverted.")
+		command.Flags().Int("workers", 2, "How many workers to run whilst doing the import.")
+	}
+
 	ImportCmd.AddCommand(
 		BulkImportCmd,
 		SlackImportCmd,
+		RocketChatImportCmd,
+		ZulipImportCmd,
 	)
 	RootCmd.AddCommand(ImportCmd)
 }
@@ -162,3 +188,101 @@ func bulkImportCmdF(command *cobra.Command, args []string) error {
 
 	return nil
 }
+
+func rocketChatImportCmdF(command *cobra.Command, args []string) error {
+	return chatImportCmdF(command, args, "Rocket.Chat", "rocketChatImport", app.ConvertRocketChatExport)
+}
+
+func zulipImportCmdF(command *cobra.Command, args []string) error {
+	return chatImportCmdF(command, args, "Zulip", "zulipImport", app.ConvertZulipExport)
+}
+
+// chatImportCmdF converts the export of another chat platform into bulk import data and imports it.
+// When the import of previously converted data failed, the data is not converted again and the
+// import resumes from where it failed.
+func chatImportCmdF(command *cobra.Command, args []string, platform, auditName string, convert func(exportDir, team, outputDir string) (*app.ChatImportReport, error)) error {
+	a, err := InitDBCommandContextCobra(command)
+	if err != nil {
+		return err
+	}
+	defer a.Shutdown()
+
+	apply, err := command.Flags().GetBool("apply")
+	if err != nil {
+		return errors.New("Apply flag error")
+	}
+
+	workers, err := command.Flags().GetInt("workers")
+	if err != nil {
+		return errors.New("Workers flag error")
+	}
+
+	output, err := command.Flags().GetString("output")
+	if err != nil {
+		return errors.New("Output flag error")
+	}
+
+	if len(args) != 2 {
+		return errors.New("Incorrect number of arguments.")
+	}
+
+	team := getTeamFromTeamArg(a, args[0])
+	if team == nil {
+		return errors.New("Unable to find team '" + args[0] + "'")
+	}
+
+	if output == "" {
+		output = filepath.Clean(args[1]) + "_import"
+	}
+
+	if app.HasChatImportProgress(output) {
+		CommandPrettyPrintln(fmt.Sprintf("Resuming the import of the data converted in %s.", output))
+	} else {
+		CommandPrettyPrintln(fmt.Sprintf("Converting the %s export. This may take a long time for large teams or teams with many messages.", platform))
+
+		report, err := convert(args[1], team.Name, output)
+		if err != nil {
+			return err
+		}
+
+		CommandPrettyPrintln(fmt.Sprintf("Converted %d users, %d channels, %d direct channels, %d posts, %d replies, %d direct posts and %d attachments.",
+			len(report.Users), len(report.Channels), report.DirectChannels, report.Posts, report.Replies, report.DirectPosts, report.Attachments))
+		if len(report.Notes) > 0 {
+			CommandPrettyPrintln(fmt.Sprintf("%d entities were skipped or altered.", len(report.Notes)))
+		}
+		CommandPrettyPrintln(fmt.Sprintf("The mapping report was written to %s.", filepath.Join(output, app.ChatImportReportFile)))
+	}
+
+	CommandPrettyPrintln("")
+
+	if apply {
+		CommandPrettyPrintln(fmt.Sprintf("Running %s Import. This may take a long time.", platform))
+	} else {
+		CommandPrettyPrintln("Running Bulk Import Data Validation.")
+		CommandPrettyPrintln("** This checks the validity of the entities in the data file, but does not persist any changes **")
+		CommandPrettyPrintln("Use the --apply flag to perform the actual data import.")
+	}
+
+	if err, lineNumber := a.ImportConvertedChatExport(output, !apply, workers); err != nil {
+		CommandPrintErrorln(err.Error())
+		if lineNumber != 0 {
+			CommandPrintErrorln(fmt.Sprintf("Error occurred on data file line %v", lineNumber))
+		}
+		if apply {
+			CommandPrintErrorln("Rerun this command to resume the import from that line.")
+		}
+		return err
+	}
+
+	if apply {
+		CommandPrettyPrintln(fmt.Sprintf("Finished %s Import.", platform))
+		auditRec := a.MakeAuditRecord(auditName, audit.Success)
+		auditRec.AddMeta("team", team)
+		auditRec.AddMeta("export", args[1])
+		a.LogAuditRec(auditRec, nil)
+	} else {
+		CommandPrettyPrintln("Validation complete. You can now perform the import by rerunning this command with the --apply flag.")
+	}
+
+	return nil
+}
@@ -51,6 +51,9 @@ func init() {
 	SampleDataCmd.Flags().IntP("workers", "w", 2, "How many workers to run during the import.")
 	SampleDataCmd.Flags().String("profile-images", "", "Optional. Path to folder with images to randomly pick as user profile image.")
 	SampleDataCmd.Flags().StringP("bulk", "b", "", "Optional. Path to write a JSONL bulk file instead of loading into the database.")
+	SampleDataCmd.Flags().Bool("fast", false, "Optional. Insert the data directly through the store batch inserts instead of the bulk import, to generate large volumes.")
+	SampleDataCmd.Flags().Int("batch-size", 1000, "The number of rows inserted per batch with --fast.")
+	SampleDataCmd.Flags().Int("days", 365, "The number of past days the posts are spread over with --fast.")
 	RootCmd.AddCommand(SampleDataCmd)
 }
 
@@ -240,6 +243,58 @@ func sampleDataCmdF(command *cobra.Command, args []string) error {
 		return errors.New("You can't have more channel memberships than channels per team.")
 	}
 
+	fast, err := command.Flags().GetBool("fast")
+	if err != nil {
+		return errors.New("Invalid fast parameter")
+	}
+	batchSize, err := command.Flags().GetInt("batch-size")
+	if err != nil || batchSize < 1 {
+		return errors.New("Invalid batch-size parameter")
+	}
+	days, err := command.Flags().GetInt("days")
+	if err != nil || days < 1 {
+		return errors.New("Invalid days parameter")
+	}
+
+	if fast {
+		if bulk != "" {
+			return errors.New("You can't write a bulk file with --fast.")
+		}
+		if profileImagesPath != "" {
+			return errors.New("You can't set profile images with --fast.")
+		}
+
+		fake.Seed(seed)
+		rand.Seed(seed)
+
+		generator := newFastSampleData(a, sampleDataOptions{
+			Seed:                  seed,
+			Teams:                 teams,
+			ChannelsPerTeam:       channelsPerTeam,
+			Users:                 users,
+			Guests:                guests,
+			DeactivatedUsers:      deactivatedUsers,
+			TeamMemberships:       teamMemberships,
+			ChannelMemberships:    channelMemberships,
+			PostsPerChannel:       postsPerChannel,
+			DirectChannels:        directChannels,
+			PostsPerDirectChannel: postsPerDirectChannel,
+			GroupChannels:         groupChannels,
+			PostsPerGroupChannel:  postsPerGroupChannel,
+			BatchSize:             batchSize,
+			Days:                  days,
+		})
+		if err = generator.Generate(); err != nil {
+			return err
+		}
+
+		auditRec := a.MakeAuditRecord("sampleData", audit.Success)
+		auditRec.AddMeta("fast", true)
+		auditRec.AddMeta("seed", seed)
+		a.LogAuditRec(auditRec, nil)
+		return nil
+	}
+
 	var bulkFile *os.File
 	switch bulk {
 	case "":
@@ -0,0 +1,514 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/icrowley/fake"
+	"github.com/mattermost/mattermost-server/v5/app"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+const (
+	SAMPLE_DATA_FAST_PASSWORD       = "SampleUs@r-1"
+	SAMPLE_DATA_FAST_ADMIN_PASSWORD = "Sys@dmin-sample1"
+
+	// The skew of the popularity of teams, channels and users: the lower ranks get most of the
+	// memberships and most of the posts, as they do on real servers.
+	sampleDataZipfS = 1.1
+	// One root post out of sampleDataThreadRatio gets replies.
+	sampleDataThreadRatio = 10
+)
+
+// sampleDataOptions are the volumes requested to the sampledata command.
+type sampleDataOptions struct {
+	Seed                  int64
+	Teams                 int
+	ChannelsPerTeam       int
+	Users                 int
+	Guests                int
+	DeactivatedUsers      int
+	TeamMemberships       int
+	ChannelMemberships    int
+	PostsPerChannel       int
+	DirectChannels        int
+	PostsPerDirectChannel int
+	GroupChannels         int
+	PostsPerGroupChannel  int
+	BatchSize             int
+	Days                  int
+}
+
+// fastSampleData inserts the sample data straight through the batch insert paths of the store,
+// skipping the bulk import file and its per line validations, to generate large volumes quickly.
+// Every random choice is drawn from sources seeded by Seed, so the same options generate the same
+// data, ids aside.
+type fastSampleData struct {
+	a       *app.App
+	options sampleDataOptions
+	rand    *rand.Rand
+	start   int64
+	end     int64
+
+	teams          []*model.Team
+	teamChannels   map[string][]*model.Channel
+	users          []*model.User
+	usernames      []string
+	channelMembers map[string][]*model.User
+
+	pendingRoots   []*model.Post
+	pendingReplies []*model.Post
+	savedPosts     int
+}
+
+func newFastSampleData(a *app.App, options sampleDataOptions) *fastSampleData {
+	end := model.GetMillis()
+	return &fastSampleData{
+		a:              a,
+		options:        options,
+		rand:           rand.New(rand.NewSource(options.Seed)),
+		start:          end - int64(options.Days)*24*60*60*1000,
+		end:            end,
+		teamChannels:   make(map[string][]*model.Channel),
+		channelMembers: make(map[string][]*model.User),
+	}
+}
+
+func (g *fastSampleData) Generate() error {
+	if err := g.createTeamsAndChannels(); err != nil {
+		return err
+	}
+	CommandPrettyPrintln(fmt.Sprintf("Created %d teams and %d channels.", len(g.teams), len(g.teams)*g.options.ChannelsPerTeam))
+
+	if err := g.createUsers(); err != nil {
+		return err
+	}
+	CommandPrettyPrintln(fmt.Sprintf("Created %d users.", len(g.users)))
+
+	if err := g.createMemberships(); err != nil {
+		return err
+	}
+	CommandPrettyPrintln("Created the team and channel memberships.")
+
+	if err := g.createChannelPosts(); err != nil {
+		return err
+	}
+	CommandPrettyPrintln(fmt.Sprintf("Created %d posts in the team channels.", g.savedPosts))
+
+	if err := g.createDirectAndGroupChannels(); err != nil {
+		return err
+	}
+	CommandPrettyPrintln(fmt.Sprintf("Created %d posts in total.", g.savedPosts))
+
+	return nil
+}
+
+// zipfIndex picks an index in [0, n), the lower indexes being the most likely.
+func (g *fastSampleData) zipfIndex(n int) int {
+	if n <= 1 {
+		return 0
+	}
+	return int(rand.NewZipf(g.rand, sampleDataZipfS, 1, uint64(n-1)).Uint64())
+}
+
+// zipfIndexes picks k distinct indexes in [0, n) with the same skew as zipfIndex.
+func (g *fastSampleData) zipfIndexes(n, k int) []int {
+	picked := make(map[int]bool, k)
+	indexes := make([]int, 0, k)
+	for attempts := 0; len(indexes) < k && attempts < 4*k; attempts++ {
+		if idx := g.zipfIndex(n); !picked[idx] {
+			picked[idx] = true
+			indexes = append(indexes, idx)
+		}
+	}
+
+	// The tail is rarely drawn, so fill what is left uniformly.
+	for _, idx := range g.rand.Perm(n) {
+		if len(indexes) == k {
+			break
+		}
+		if !picked[idx] {
+			picked[idx] = true
+			indexes = append(indexes, idx)
+		}
+	}
+	return indexes
+}
+
+// randomPostTime returns a time within the generated period, most of the activity happening
+// during the working hours of weekdays.
+func (g *fastSampleData) randomPostTime() int64 {
+	for {
+		createAt := g.start + g.rand.Int63n(g.end-g.start)
+		t := time.Unix(0, createAt*int64(time.Millisecond)).UTC()
+		if (t.Weekday() == time.Saturday || t.Weekday() == time.Sunday) && g.rand.Intn(5) != 0 {
+			continue
+		}
+		if (t.Hour() < 8 || t.Hour() >= 18) && g.rand.Intn(4) != 0 {
+			continue
+		}
+		return createAt
+	}
+}
+
+func (g *fastSampleData) sortedPostTimes(size int) []int64 {
+	times := make([]int64, size)
+	for i := range times {
+		times[i] = g.randomPostTime()
+	}
+	sort.Slice(times, func(a, b int) bool { return times[a] < times[b] })
+	return times
+}
+
+func truncateSampleText(text string, max int) string {
+	if len(text) > max {
+		return text[0:max]
+	}
+	return text
+}
+
+func (g *fastSampleData) createTeamsAndChannels() error {
+	for i := 0; i < g.options.Teams; i++ {
+		team := &model.Team{
+			Name:            getSampleTeamName(i),
+			DisplayName:     fake.Word(),
+			Description:     truncateSampleText(fake.Paragraph(), model.TEAM_DESCRIPTION_MAX_LENGTH),
+			Type:            model.TEAM_OPEN,
+			AllowOpenInvite: g.rand.Intn(2) == 0,
+		}
+		if g.rand.Intn(2) == 0 {
+			team.Type = model.TEAM_INVITE
+		}
+
+		savedTeam, err := g.a.Srv().Store.Team().Save(context.Background(), team)
+		if err != nil {
+			return fmt.Errorf("unable to save the team %s: %s", team.Name, err.Error())
+		}
+		g.teams = append(g.teams, savedTeam)
+
+		for j := 0; j < g.options.ChannelsPerTeam; j++ {
+			channel := &model.Channel{
+				TeamId:      savedTeam.Id,
+				Name:        fmt.Sprintf("%s-%d", fake.Word(), j),
+				DisplayName: fake.Word(),
+				Header:      truncateSampleText(fake.Paragraph(), model.CHANNEL_HEADER_MAX_RUNES),
+				Purpose:     truncateSampleText(fake.Paragraph(), model.CHANNEL_PURPOSE_MAX_RUNES),
+				Type:        model.CHANNEL_PRIVATE,
+			}
+			if g.rand.Intn(2) == 0 {
+				channel.Type = model.CHANNEL_OPEN
+			}
+
+			savedChannel, err := g.a.Srv().Store.Channel().Save(channel, -1)
+			if err != nil {
+				return fmt.Errorf("unable to save the channel %s: %s", channel.Name, err.Error())
+			}
+			g.teamChannels[savedTeam.Id] = append(g.teamChannels[savedTeam.Id], savedChannel)
+		}
+	}
+	return nil
+}
+
+// createUsers saves the users with a single password hash, as hashing one password per user
+// dominates the time spent creating them otherwise.
+func (g *fastSampleData) createUsers() error {
+	hash := model.HashPassword(SAMPLE_DATA_FAST_PASSWORD)
+	adminHash := model.HashPassword(SAMPLE_DATA_FAST_ADMIN_PASSWORD)
+
+	create := func(idx int, userType string) error {
+		user := &model.User{
+			FirstName:     fake.FirstName(),
+			LastName:      fake.LastName(),
+			Position:      truncateSampleText(fake.JobTitle(), model.USER_POSITION_MAX_RUNES),
+			Roles:         model.SYSTEM_USER_ROLE_ID,
+			EmailVerified: true,
+		}
+		user.Nickname = user.FirstName
+
+		switch userType {
+		case GUEST_USER:
+			user.Username = fmt.Sprintf("guest-%d", idx)
+			user.Roles = model.SYSTEM_GUEST_ROLE_ID
+		case DEACTIVATED_USER:
+			user.Username = fmt.Sprintf("deactivated-%d", idx)
+			user.DeleteAt = g.randomPostTime()
+		default:
+			user.Username = fmt.Sprintf("user-%d", idx)
+			if idx == 0 {
+				user.Username = "sysadmin"
+				user.Roles = "system_user system_admin"
+			} else if idx%5 == 0 {
+				user.Roles = "system_user system_admin"
+			}
+		}
+		user.Email = user.Username + "@sample.mattermost.com"
+
+		savedUser, err := g.a.Srv().Store.User().Save(user)
+		if err != nil {
+			return fmt.Errorf("unable to save the user %s: %s", user.Username, err.Error())
+		}
+
+		password := hash
+		if idx == 0 && userType == "" {
+			password = adminHash
+		}
+		if err := g.a.Srv().Store.User().UpdatePassword(savedUser.Id, password); err != nil {
+			return fmt.Errorf("unable to set the password of the user %s: %s", user.Username, err.Error())
+		}
+
+		g.users = append(g.users, savedUser)
+		g.usernames = append(g.usernames, savedUser.Username)
+		return nil
+	}
+
+	for i := 0; i < g.options.Users; i++ {
+		if err := create(i, ""); err != nil {
+			return err
+		}
+	}
+	for i := 0; i < g.options.Guests; i++ {
+		if err := create(i, GUEST_USER); err != nil {
+			return err
+		}
+	}
+	for i := 0; i < g.options.DeactivatedUsers; i++ {
+		if err := create(i, DEACTIVATED_USER); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *fastSampleData) createMemberships() error {
+	var teamMembers []*model.TeamMember
+	var channelMembers []*model.ChannelMember
+
+	// The team members are always saved before the channel members, as those must belong to the
+	// team of the channel.
+	flush := func(force bool) error {
+		if !force && len(teamMembers) < g.options.BatchSize && len(channelMembers) < g.options.BatchSize {
+			return nil
+		}
+		if len(teamMembers) > 0 {
+			if _, err := g.a.Srv().Store.Team().SaveMultipleMembers(context.Background(), teamMembers, -1); err != nil {
+				return fmt.Errorf("unable to save the team members: %s", err.Error())
+			}
+			teamMembers = nil
+		}
+		if len(channelMembers) > 0 {
+			if _, err := g.a.Srv().Store.Channel().SaveMultipleMembers(channelMembers); err != nil {
+				return fmt.Errorf("unable to save the channel members: %s", err.Error())
+			}
+			channelMembers = nil
+		}
+		return nil
+	}
+
+	for _, user := range g.users {
+		guest := user.IsGuest()
+		for _, teamIdx := range g.zipfIndexes(len(g.teams), g.options.TeamMemberships) {
+			team := g.teams[teamIdx]
+			teamMembers = append(teamMembers, &model.TeamMember{
+				TeamId:      team.Id,
+				UserId:      user.Id,
+				SchemeGuest: guest,
+				SchemeUser:  !guest,
+				SchemeAdmin: !guest && g.rand.Intn(5) == 0,
+			})
+
+			channels := g.teamChannels[team.Id]
+			for _, channelIdx := range g.zipfIndexes(len(channels), g.options.ChannelMemberships) {
+				channel := channels[channelIdx]
+				channelMembers = append(channelMembers, &model.ChannelMember{
+					ChannelId:   channel.Id,
+					UserId:      user.Id,
+					NotifyProps: model.GetDefaultChannelNotifyProps(),
+					SchemeGuest: guest,
+					SchemeUser:  !guest,
+					SchemeAdmin: !guest && g.rand.Intn(5) == 0,
+				})
+				g.channelMembers[channel.Id] = append(g.channelMembers[channel.Id], user)
+			}
+
+			if err := flush(false); err != nil {
+				return err
+			}
+		}
+	}
+	return flush(true)
+}
+
+// createChannelPosts spreads PostsPerChannel posts on average over the team channels, the channels
+// with the most members getting the most posts.
+func (g *fastSampleData) createChannelPosts() error {
+	weights := make([]float64, g.options.ChannelsPerTeam)
+	var total float64
+	for rank := range weights {
+		weights[rank] = 1 / math.Pow(float64(rank+1), sampleDataZipfS)
+		total += weights[rank]
+	}
+
+	for _, team := range g.teams {
+		for rank, channel := range g.teamChannels[team.Id] {
+			count := int(math.Round(float64(g.options.PostsPerChannel*g.options.ChannelsPerTeam) * weights[rank] / total))
+			if err := g.createPosts(channel.Id, count); err != nil {
+				return err
+			}
+		}
+	}
+	return g.flushPosts(true)
+}
+
+func (g *fastSampleData) createDirectAndGroupChannels() error {
+	activeUsers := []*model.User{}
+	for _, user := range g.users {
+		if user.DeleteAt == 0 {
+			activeUsers = append(activeUsers, user)
+		}
+	}
+
+	if len(activeUsers) >= 2 {
+		seen := make(map[string]bool)
+		for i := 0; i < g.options.DirectChannels; i++ {
+			user := activeUsers[g.zipfIndex(len(activeUsers))]
+			otherUser := activeUsers[g.rand.Intn(len(activeUsers))]
+			name := model.GetDMNameFromIds(user.Id, otherUser.Id)
+			if user.Id == otherUser.Id || seen[name] {
+				continue
+			}
+			seen[name] = true
+
+			channel, err := g.a.Srv().Store.Channel().CreateDirectChannel(user, otherUser)
+			if err != nil {
+				return fmt.Errorf("unable to save the direct channel %s: %s", name, err.Error())
+			}
+			g.channelMembers[channel.Id] = []*model.User{user, otherUser}
+
+			if err := g.createPosts(channel.Id, g.options.PostsPerDirectChannel); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(activeUsers) >= model.CHANNEL_GROUP_MIN_USERS {
+		for i := 0; i < g.options.GroupChannels; i++ {
+			size := model.CHANNEL_GROUP_MIN_USERS + g.rand.Intn(3)
+			if size > len(activeUsers) {
+				size = len(activeUsers)
+			}
+
+			members := []*model.User{}
+			userIds := []string{}
+			for _, idx := range g.zipfIndexes(len(activeUsers), size) {
+				members = append(members, activeUsers[idx])
+				userIds = append(userIds, activeUsers[idx].Id)
+			}
+
+			channel, err := g.a.CreateGroupChannel(userIds, userIds[0])
+			if err != nil {
+				return fmt.Errorf("unable to save the group channel: %s", err.Error())
+			}
+			if _, ok := g.channelMembers[channel.Id]; ok {
+				continue
+			}
+			g.channelMembers[channel.Id] = members
+
+			if err := g.createPosts(channel.Id, g.options.PostsPerGroupChannel); err != nil {
+				return err
+			}
+		}
+	}
+
+	return g.flushPosts(true)
+}
+
+// createPosts queues count root posts in the channel, written by its members. Channels without
+// members are left empty.
+func (g *fastSampleData) createPosts(channelId string, count int) error {
+	members := g.channelMembers[channelId]
+	if len(members) == 0 || count == 0 {
+		return nil
+	}
+
+	for _, createAt := range g.sortedPostTimes(count) {
+		g.pendingRoots = append(g.pendingRoots, &model.Post{
+			ChannelId: channelId,
+			UserId:    members[g.zipfIndex(len(members))].Id,
+			Message:   randomMessage(g.usernames),
+			CreateAt:  createAt,
+		})
+		if err := g.flushPosts(false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// flushPosts saves the queued posts once a batch is full, or right away when forced. Replies can
+// only be queued once their root post is saved and has an id.
+func (g *fastSampleData) flushPosts(force bool) error {
+	if len(g.pendingRoots) > 0 && (force || len(g.pendingRoots) >= g.options.BatchSize) {
+		roots := g.pendingRoots
+		g.pendingRoots = nil
+		if err := g.savePosts(roots); err != nil {
+			return err
+		}
+
+		for _, root := range roots {
+			if g.rand.Intn(sampleDataThreadRatio) != 0 {
+				continue
+			}
+			members := g.channelMembers[root.ChannelId]
+			createAt := root.CreateAt
+			for {
+				createAt += g.rand.Int63n(60 * 60 * 1000)
+				g.pendingReplies = append(g.pendingReplies, &model.Post{
+					ChannelId: root.ChannelId,
+					RootId:    root.Id,
+					ParentId:  root.Id,
+					UserId:    members[g.rand.Intn(len(members))].Id,
+					Message:   randomMessage(g.usernames),
+					CreateAt:  createAt,
+				})
+				if g.rand.Intn(3) == 0 {
+					break
+				}
+			}
+		}
+	}
+
+	if len(g.pendingReplies) > 0 && (force || len(g.pendingReplies) >= g.options.BatchSize) {
+		replies := g.pendingReplies
+		g.pendingReplies = nil
+		if err := g.savePosts(replies); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *fastSampleData) savePosts(posts []*model.Post) error {
+	for start := 0; start < len(posts); start += g.options.BatchSize {
+		end := start + g.options.BatchSize
+		if end > len(posts) {
+			end = len(posts)
+		}
+
+		if _, idx, err := g.a.Srv().Store.Post().SaveMultiple(posts[start:end]); err != nil {
+			return fmt.Errorf("unable to save the post %d of the batch: %s", start+idx, err.Error())
+		}
+
+		g.savedPosts += end - start
+		if g.savedPosts/100000 != (g.savedPosts-(end-start))/100000 {
+			CommandPrintln(fmt.Sprintf("%d posts created...", g.savedPosts))
+		}
+	}
+	return nil
+}
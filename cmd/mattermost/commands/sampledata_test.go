@@ -21,4 +21,13 @@ func TestSampledataBadParameters(t *testing.T) {
 
 	// should fail because you have more channel memberships than channels per team
 	require.Error(t, th.RunCommand(t, "sampledata", "--channels-per-team", "10", "--channel-memberships", "11"))
+
+	// should fail because the fast mode doesn't write bulk files
+	require.Error(t, th.RunCommand(t, "sampledata", "--fast", "--bulk", "-"))
+
+	// should fail because you need at least 1 row per batch
+	require.Error(t, th.RunCommand(t, "sampledata", "--fast", "--batch-size", "0"))
+
+	// should fail because the posts need at least 1 day to be spread over
+	require.Error(t, th.RunCommand(t, "sampledata", "--fast", "--days", "0"))
 }
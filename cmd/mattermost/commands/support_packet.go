@@ -0,0 +1,65 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package commands
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mattermost/mattermost-server/v5/audit"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+var SupportPacketCmd = &cobra.Command{
+	Use:   "support-packet [file]",
+	Short: "Generate a support packet.",
+	Long: `Bundle the sanitized configuration, the recent logs, the jobs, the database diagnostics, the plugins and the metrics of the server into a zip archive.
+The secrets of the configuration are redacted from every file of the archive.`,
+	Example: `  support-packet
+  support-packet packet.zip`,
+	RunE: supportPacketCmdF,
+}
+
+func init() {
+	RootCmd.AddCommand(SupportPacketCmd)
+}
+
+func supportPacketCmdF(command *cobra.Command, args []string) error {
+	if len(args) > 1 {
+		return errors.New("Incorrect number of arguments.")
+	}
+
+	fileName := model.SupportPacketFileName(time.Now())
+	if len(args) == 1 {
+		fileName = args[0]
+	}
+
+	a, err := InitDBCommandContextCobra(command)
+	if err != nil {
+		return err
+	}
+	defer a.Shutdown()
+
+	file, err := os.OpenFile(fileName, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if appErr := a.GenerateSupportPacket(file); appErr != nil {
+		CommandPrintErrorln(appErr.Error())
+		return appErr
+	}
+
+	CommandPrettyPrintln("Support packet written to " + fileName)
+
+	auditRec := a.MakeAuditRecord("supportPacket", audit.Success)
+	auditRec.AddMeta("file", fileName)
+	a.LogAuditRec(auditRec, nil)
+
+	return nil
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package commands
+
+import (
+	"archive/zip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSupportPacket(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	dir, err := ioutil.TempDir("", "support-packet")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "packet.zip")
+
+	require.Error(t, th.RunCommand(t, "support-packet", file, file))
+	require.NoError(t, th.RunCommand(t, "support-packet", file))
+
+	archive, err := zip.OpenReader(file)
+	require.NoError(t, err)
+	defer archive.Close()
+
+	var names []string
+	for _, f := range archive.File {
+		names = append(names, f.Name)
+	}
+	assert.Contains(t, names, "metadata.json")
+	assert.Contains(t, names, "config.json")
+	assert.Contains(t, names, "store.json")
+}
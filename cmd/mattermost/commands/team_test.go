@@ -4,6 +4,7 @@
 package commands
 
 import (
+	"context"
 	"testing"
 
 	"github.com/mattermost/mattermost-server/v5/model"
@@ -65,7 +66,7 @@ func TestLeaveTeam(t *testing.T) {
 
 	require.False(t, found, "profile should not be on team")
 
-	teams, err := th.App.Srv().Store.Team().GetTeamsByUserId(th.BasicUser.Id)
+	teams, err := th.App.Srv().Store.Team().GetTeamsByUserId(context.Background(), th.BasicUser.Id, nil)
 	require.Nil(t, err)
 	require.Equal(t, 0, len(teams), "Shouldn't be in team")
 }
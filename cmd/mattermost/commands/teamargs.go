@@ -4,6 +4,8 @@
 package commands
 
 import (
+	"context"
+
 	"github.com/mattermost/mattermost-server/v5/app"
 	"github.com/mattermost/mattermost-server/v5/model"
 )
@@ -19,11 +21,11 @@ func getTeamsFromTeamArgs(a *app.App, teamArgs []string) []*model.Team {
 
 func getTeamFromTeamArg(a *app.App, teamArg string) *model.Team {
 	var team *model.Team
-	team, err := a.Srv().Store.Team().GetByName(teamArg)
+	team, err := a.Srv().Store.Team().GetByName(context.Background(), teamArg)
 
 	if err != nil {
 		var t *model.Team
-		if t, err = a.Srv().Store.Team().Get(teamArg); err == nil {
+		if t, err = a.Srv().Store.Team().Get(context.Background(), teamArg); err == nil {
 			team = t
 		}
 	}
@@ -44,6 +44,14 @@ func desanitize(actual, target *model.Config) {
 		*target.ElasticsearchSettings.Password = *actual.ElasticsearchSettings.Password
 	}
 
+	if *target.BackupSettings.EncryptionPassphrase == model.FAKE_SETTING {
+		*target.BackupSettings.EncryptionPassphrase = *actual.BackupSettings.EncryptionPassphrase
+	}
+
+	if *target.EventStreamSettings.WebhookSecret == model.FAKE_SETTING {
+		*target.EventStreamSettings.WebhookSecret = *actual.EventStreamSettings.WebhookSecret
+	}
+
 	target.SqlSettings.DataSourceReplicas = make([]string, len(actual.SqlSettings.DataSourceReplicas))
 	for i := range target.SqlSettings.DataSourceReplicas {
 		target.SqlSettings.DataSourceReplicas[i] = actual.SqlSettings.DataSourceReplicas[i]
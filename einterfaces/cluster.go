@@ -26,5 +26,7 @@ type ClusterInterface interface {
 	GetClusterStats() ([]*model.ClusterStats, *model.AppError)
 	GetLogs(page, perPage int) ([]string, *model.AppError)
 	GetPluginStatuses() (model.PluginStatuses, *model.AppError)
+	// GetWebConnections returns the websocket connections open on the other nodes, or only those of the given user.
+	GetWebConnections(userId string) ([]*model.WebConnInfo, *model.AppError)
 	ConfigChanged(previousConfig *model.Config, newConfig *model.Config, sendToOtherServer bool) *model.AppError
 }
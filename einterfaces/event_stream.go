@@ -0,0 +1,16 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package einterfaces
+
+import (
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+// EventStreamInterface publishes the events of the event stream to the Kafka topic of the
+// EventStreamSettings.
+type EventStreamInterface interface {
+	// Publish returns once all the events were acknowledged by the brokers, or fails if any of them
+	// wasn't.
+	Publish(events []*model.EventStreamEvent) error
+}
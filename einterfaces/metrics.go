@@ -52,6 +52,9 @@ type MetricsInterface interface {
 	IncrementUserIndexCounter()
 	IncrementChannelIndexCounter()
 
+	AddDataRetentionDeletedCounter(entity string, amount float64)
+	ObserveDataRetentionBatchDuration(entity string, elapsed float64)
+
 	ObservePluginHookDuration(pluginID, hookName string, success bool, elapsed float64)
 	ObservePluginMultiHookIterationDuration(pluginID string, elapsed float64)
 	ObservePluginMultiHookDuration(elapsed float64)
@@ -153,6 +153,31 @@ func (_m *ClusterInterface) GetPluginStatuses() (model.PluginStatuses, *model.Ap
 	return r0, r1
 }
 
+// GetWebConnections provides a mock function with given fields: userId
+func (_m *ClusterInterface) GetWebConnections(userId string) ([]*model.WebConnInfo, *model.AppError) {
+	ret := _m.Called(userId)
+
+	var r0 []*model.WebConnInfo
+	if rf, ok := ret.Get(0).(func(string) []*model.WebConnInfo); ok {
+		r0 = rf(userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.WebConnInfo)
+		}
+	}
+
+	var r1 *model.AppError
+	if rf, ok := ret.Get(1).(func(string) *model.AppError); ok {
+		r1 = rf(userId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // HealthScore provides a mock function with given fields:
 func (_m *ClusterInterface) HealthScore() int {
 	ret := _m.Called()
@@ -11,6 +11,11 @@ type MetricsInterface struct {
 	mock.Mock
 }
 
+// AddDataRetentionDeletedCounter provides a mock function with given fields: entity, amount
+func (_m *MetricsInterface) AddDataRetentionDeletedCounter(entity string, amount float64) {
+	_m.Called(entity, amount)
+}
+
 // AddMemCacheHitCounter provides a mock function with given fields: cacheName, amount
 func (_m *MetricsInterface) AddMemCacheHitCounter(cacheName string, amount float64) {
 	_m.Called(cacheName, amount)
@@ -181,6 +186,11 @@ func (_m *MetricsInterface) ObserveClusterRequestDuration(elapsed float64) {
 	_m.Called(elapsed)
 }
 
+// ObserveDataRetentionBatchDuration provides a mock function with given fields: entity, elapsed
+func (_m *MetricsInterface) ObserveDataRetentionBatchDuration(entity string, elapsed float64) {
+	_m.Called(entity, elapsed)
+}
+
 // ObservePluginApiDuration provides a mock function with given fields: pluginID, apiName, success, elapsed
 func (_m *MetricsInterface) ObservePluginApiDuration(pluginID string, apiName string, success bool, elapsed float64) {
 	_m.Called(pluginID, apiName, success, elapsed)
@@ -137,3 +137,6 @@ require (
 	honnef.co/go/tools v0.0.1-2020.1.3 // indirect
 	willnorris.com/go/imageproxy v0.10.0
 )
+
+// Carries the WithContext support of upstream gorp until it is released in the fork.
+replace github.com/mattermost/gorp => ./third_party/gorp
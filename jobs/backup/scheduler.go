@@ -0,0 +1,54 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package backup
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/app"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+type Scheduler struct {
+	App *app.App
+}
+
+func (m *BackupJobInterfaceImpl) MakeScheduler() model.Scheduler {
+	return &Scheduler{m.App}
+}
+
+func (scheduler *Scheduler) Name() string {
+	return JobName + "Scheduler"
+}
+
+func (scheduler *Scheduler) JobType() string {
+	return model.JOB_TYPE_BACKUP
+}
+
+func (scheduler *Scheduler) Enabled(cfg *model.Config) bool {
+	return *cfg.BackupSettings.EnableScheduledBackups
+}
+
+func (scheduler *Scheduler) NextScheduleTime(cfg *model.Config, now time.Time, pendingJobs bool, lastSuccessfulJob *model.Job) *time.Time {
+	// Run right away the first time, then every IntervalHours after the last successful backup.
+	if lastSuccessfulJob == nil {
+		return &now
+	}
+
+	nextTime := time.Unix(0, lastSuccessfulJob.LastActivityAt*int64(time.Millisecond)).Add(time.Duration(*cfg.BackupSettings.IntervalHours) * time.Hour)
+	return &nextTime
+}
+
+func (scheduler *Scheduler) ScheduleJob(cfg *model.Config, pendingJobs bool, lastSuccessfulJob *model.Job) (*model.Job, *model.AppError) {
+	// Backups are incremental from the last one, so never queue a second one behind it.
+	if pendingJobs {
+		return nil, nil
+	}
+
+	job, err := scheduler.App.Srv().Jobs.CreateJob(model.JOB_TYPE_BACKUP, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
@@ -0,0 +1,19 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package dataretention
+
+import (
+	"github.com/mattermost/mattermost-server/v5/app"
+	ejobs "github.com/mattermost/mattermost-server/v5/einterfaces/jobs"
+)
+
+type DataRetentionJobInterfaceImpl struct {
+	Server *app.Server
+}
+
+func init() {
+	app.RegisterJobsDataRetentionJobInterface(func(s *app.Server) ejobs.DataRetentionJobInterface {
+		return &DataRetentionJobInterfaceImpl{s}
+	})
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package dataretention
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/app"
+	"github.com/mattermost/mattermost-server/v5/jobs"
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+type Scheduler struct {
+	server *app.Server
+}
+
+func (m *DataRetentionJobInterfaceImpl) MakeScheduler() model.Scheduler {
+	return &Scheduler{m.Server}
+}
+
+func (scheduler *Scheduler) Name() string {
+	return JobName + "Scheduler"
+}
+
+func (scheduler *Scheduler) JobType() string {
+	return model.JOB_TYPE_DATA_RETENTION
+}
+
+func (scheduler *Scheduler) Enabled(cfg *model.Config) bool {
+	license := scheduler.server.License()
+	if license == nil || !*license.Features.DataRetention {
+		return false
+	}
+
+	return *cfg.DataRetentionSettings.EnableMessageDeletion || *cfg.DataRetentionSettings.EnableFileDeletion
+}
+
+func (scheduler *Scheduler) NextScheduleTime(cfg *model.Config, now time.Time, pendingJobs bool, lastSuccessfulJob *model.Job) *time.Time {
+	parsedTime, err := time.Parse("15:04", *cfg.DataRetentionSettings.DeletionJobStartTime)
+	if err != nil {
+		mlog.Error("Cannot determine next schedule time for data retention. DeletionJobStartTime config value is invalid.", mlog.Err(err))
+		return nil
+	}
+
+	return jobs.GenerateNextStartDateTime(now, parsedTime)
+}
+
+func (scheduler *Scheduler) ScheduleJob(cfg *model.Config, pendingJobs bool, lastSuccessfulJob *model.Job) (*model.Job, *model.AppError) {
+	data := map[string]string{}
+
+	job, err := scheduler.server.Jobs.CreateJob(model.JOB_TYPE_DATA_RETENTION, data)
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
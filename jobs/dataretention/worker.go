@@ -0,0 +1,402 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package dataretention
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/einterfaces"
+	"github.com/mattermost/mattermost-server/v5/jobs"
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+const (
+	JobName = "DataRetention"
+
+	StagePartitions = "partitions"
+	StageFiles      = "files"
+	StagePosts      = "posts"
+)
+
+type Worker struct {
+	name      string
+	stop      chan bool
+	stopped   chan bool
+	jobs      chan model.Job
+	jobServer *jobs.JobServer
+	metrics   einterfaces.MetricsInterface
+}
+
+// retentionTarget is a set of channels sharing the same retention cutoffs. The empty policy id stands
+// for the channels governed by the global DataRetentionSettings. A zero cutoff keeps everything.
+type retentionTarget struct {
+	PolicyId   string
+	PostCutoff int64
+	FileCutoff int64
+}
+
+// Progress tracks a data retention run. Each target goes through the files stage first, as files are
+// matched through their post, and then through the posts stage. When the Posts table is partitioned,
+// the run starts with the partitions stage, which drops the partitions ending at or before
+// PartitionsEndTime once the files created before then are deleted.
+type Progress struct {
+	PartitionsEndTime int64
+	Targets           []retentionTarget
+	TargetIndex       int
+	Stage             string
+	Cursor            model.RetentionPolicyCursor
+	PostsDeleted      int64
+	FilesDeleted      int64
+}
+
+func (m *DataRetentionJobInterfaceImpl) MakeWorker() model.Worker {
+	worker := Worker{
+		name:      JobName,
+		stop:      make(chan bool, 1),
+		stopped:   make(chan bool, 1),
+		jobs:      make(chan model.Job),
+		jobServer: m.Server.Jobs,
+		metrics:   m.Server.Metrics,
+	}
+	return &worker
+}
+
+func (worker *Worker) Run() {
+	mlog.Debug("Worker started", mlog.String("worker", worker.name))
+
+	defer func() {
+		mlog.Debug("Worker finished", mlog.String("worker", worker.name))
+		worker.stopped <- true
+	}()
+
+	for {
+		select {
+		case <-worker.stop:
+			mlog.Debug("Worker received stop signal", mlog.String("worker", worker.name))
+			return
+		case job := <-worker.jobs:
+			mlog.Debug("Worker received a new candidate job.", mlog.String("worker", worker.name))
+			worker.DoJob(&job)
+		}
+	}
+}
+
+func (worker *Worker) Stop() {
+	mlog.Debug("Worker stopping", mlog.String("worker", worker.name))
+	worker.stop <- true
+	<-worker.stopped
+}
+
+func (worker *Worker) JobChannel() chan<- model.Job {
+	return worker.jobs
+}
+
+func (worker *Worker) DoJob(job *model.Job) {
+	if claimed, err := worker.jobServer.ClaimJob(job); err != nil {
+		mlog.Warn("Worker experienced an error while trying to claim job",
+			mlog.String("worker", worker.name),
+			mlog.String("job_id", job.Id),
+			mlog.String("error", err.Error()))
+		return
+	} else if !claimed {
+		return
+	}
+
+	targets, appErr := worker.getTargets(model.GetMillis())
+	if appErr != nil {
+		mlog.Error("Worker: Failed to get data retention policies", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", appErr.Error()))
+		worker.setJobError(job, appErr)
+		return
+	}
+
+	partitionsEndTime, appErr := worker.getPartitionsEndTime(targets)
+	if appErr != nil {
+		mlog.Error("Worker: Failed to get posts partitions", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", appErr.Error()))
+		worker.setJobError(job, appErr)
+		return
+	}
+
+	progress := &Progress{
+		PartitionsEndTime: partitionsEndTime,
+		Targets:           targets,
+		Stage:             StageFiles,
+	}
+	if partitionsEndTime > 0 {
+		progress.Stage = StagePartitions
+	}
+
+	cancelCtx, cancelCancelWatcher := context.WithCancel(context.Background())
+	cancelWatcherChan := make(chan interface{}, 1)
+	go worker.jobServer.CancellationWatcher(cancelCtx, job.Id, cancelWatcherChan)
+
+	defer cancelCancelWatcher()
+
+	pause := time.Duration(*worker.jobServer.Config().DataRetentionSettings.TimeBetweenBatchesMilliseconds) * time.Millisecond
+
+	for !progress.IsDone() {
+		select {
+		case <-cancelWatcherChan:
+			mlog.Info("Worker: Data retention job has been canceled via CancellationWatcher", mlog.String("worker", worker.name), mlog.String("job_id", job.Id))
+			worker.setJobCanceled(job)
+			return
+
+		case <-worker.stop:
+			mlog.Info("Worker: Data retention job has been canceled via Worker Stop", mlog.String("worker", worker.name), mlog.String("job_id", job.Id))
+			worker.setJobCanceled(job)
+			// Let Run notice the stop signal as well.
+			worker.stop <- true
+			return
+
+		case <-time.After(pause):
+			if appErr := worker.DeleteBatch(progress); appErr != nil {
+				mlog.Error("Worker: Failed to delete data retention batch", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", appErr.Error()))
+				worker.setJobError(job, appErr)
+				return
+			}
+
+			progress.SetJobData(job)
+			if appErr := worker.jobServer.SetJobProgress(job, progress.CurrentProgress()); appErr != nil {
+				mlog.Error("Worker: Failed to set progress for job", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", appErr.Error()))
+				worker.setJobError(job, appErr)
+				return
+			}
+		}
+	}
+
+	mlog.Info("Worker: Job is complete", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.Int64("posts_deleted", progress.PostsDeleted), mlog.Int64("files_deleted", progress.FilesDeleted))
+	worker.setJobSuccess(job)
+}
+
+// getTargets lists the custom retention policies followed by the global one.
+func (worker *Worker) getTargets(now int64) ([]retentionTarget, *model.AppError) {
+	var targets []retentionTarget
+
+	for page := 0; ; page++ {
+		policies, err := worker.jobServer.Store.RetentionPolicy().GetAll(page*model.RETENTION_POLICIES_PER_PAGE_DEFAULT, model.RETENTION_POLICIES_PER_PAGE_DEFAULT)
+		if err != nil {
+			return nil, model.NewAppError("DataRetentionWorker", "jobs.data_retention.get_policies.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+
+		for _, policy := range policies {
+			targets = append(targets, retentionTarget{
+				PolicyId:   policy.Id,
+				PostCutoff: policy.PostCutoff(now),
+				FileCutoff: policy.FileCutoff(now),
+			})
+		}
+
+		if len(policies) < model.RETENTION_POLICIES_PER_PAGE_DEFAULT {
+			break
+		}
+	}
+
+	settings := worker.jobServer.Config().DataRetentionSettings
+	global := retentionTarget{}
+	if *settings.EnableMessageDeletion {
+		global.PostCutoff = now - int64(*settings.MessageRetentionDays)*24*60*60*1000
+	}
+	if *settings.EnableFileDeletion {
+		global.FileCutoff = now - int64(*settings.FileRetentionDays)*24*60*60*1000
+	}
+
+	return append(targets, global), nil
+}
+
+// getPartitionsEndTime returns the end of the latest posts partition that every target allows to
+// drop as a whole, or 0 if there is none. Partitions can only be dropped when all the channels have
+// both their posts and files past retention, since a partition holds the posts of every channel.
+func (worker *Worker) getPartitionsEndTime(targets []retentionTarget) (int64, *model.AppError) {
+	if !*worker.jobServer.Config().SqlSettings.EnablePostsPartitioning {
+		return 0, nil
+	}
+
+	minCutoff := int64(-1)
+	for _, target := range targets {
+		if target.PostCutoff <= 0 || target.FileCutoff <= 0 {
+			return 0, nil
+		}
+		if minCutoff < 0 || target.PostCutoff < minCutoff {
+			minCutoff = target.PostCutoff
+		}
+		if target.FileCutoff < minCutoff {
+			minCutoff = target.FileCutoff
+		}
+	}
+
+	partitioned, err := worker.jobServer.Store.PostsPartition().IsPartitioned()
+	if err != nil {
+		return 0, model.NewAppError("DataRetentionWorker", "jobs.data_retention.get_partitions.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	if !partitioned {
+		return 0, nil
+	}
+
+	partitions, err := worker.jobServer.Store.PostsPartition().GetPartitions()
+	if err != nil {
+		return 0, model.NewAppError("DataRetentionWorker", "jobs.data_retention.get_partitions.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	var endTime int64
+	for _, partition := range partitions {
+		if partition.EndAt <= minCutoff && partition.EndAt > endTime {
+			endTime = partition.EndAt
+		}
+	}
+
+	return endTime, nil
+}
+
+// deletePartitionsBatch deletes the next batch of files created before the partitions end time, and
+// drops the partitions once they are all gone.
+func (worker *Worker) deletePartitionsBatch(progress *Progress) *model.AppError {
+	batchSize := int64(*worker.jobServer.Config().DataRetentionSettings.BatchSize)
+
+	start := time.Now()
+	deleted, cursor, err := worker.jobServer.Store.RetentionPolicy().PermanentDeleteAllFilesBatch(progress.PartitionsEndTime, progress.Cursor, batchSize)
+	if err != nil {
+		return model.NewAppError("DataRetentionWorker", "jobs.data_retention.delete_batch.app_error", map[string]interface{}{"Stage": progress.Stage}, err.Error(), http.StatusInternalServerError)
+	}
+
+	if worker.metrics != nil {
+		worker.metrics.ObserveDataRetentionBatchDuration(progress.Stage, time.Since(start).Seconds())
+		worker.metrics.AddDataRetentionDeletedCounter(progress.Stage, float64(deleted))
+	}
+	progress.FilesDeleted += deleted
+
+	if cursor != progress.Cursor {
+		progress.Cursor = cursor
+		return nil
+	}
+
+	names, err := worker.jobServer.Store.PostsPartition().DropPartitionsBefore(progress.PartitionsEndTime)
+	if err != nil {
+		return model.NewAppError("DataRetentionWorker", "jobs.data_retention.drop_partitions.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	mlog.Info("Worker: Dropped posts partitions", mlog.String("worker", worker.name), mlog.Any("partitions", names))
+
+	progress.NextStage()
+	return nil
+}
+
+// DeleteBatch deletes the next batch of the current stage and advances the progress.
+func (worker *Worker) DeleteBatch(progress *Progress) *model.AppError {
+	if progress.Stage == StagePartitions {
+		return worker.deletePartitionsBatch(progress)
+	}
+
+	target := progress.Targets[progress.TargetIndex]
+	batchSize := int64(*worker.jobServer.Config().DataRetentionSettings.BatchSize)
+
+	cutoff := target.PostCutoff
+	deleteBatch := worker.jobServer.Store.RetentionPolicy().PermanentDeletePostsBatch
+	if progress.Stage == StageFiles {
+		cutoff = target.FileCutoff
+		deleteBatch = worker.jobServer.Store.RetentionPolicy().PermanentDeleteFilesBatch
+	}
+
+	if cutoff <= 0 {
+		progress.NextStage()
+		return nil
+	}
+
+	start := time.Now()
+	deleted, cursor, err := deleteBatch(target.PolicyId, cutoff, progress.Cursor, batchSize)
+	if err != nil {
+		return model.NewAppError("DataRetentionWorker", "jobs.data_retention.delete_batch.app_error", map[string]interface{}{"Stage": progress.Stage}, err.Error(), http.StatusInternalServerError)
+	}
+
+	if worker.metrics != nil {
+		worker.metrics.ObserveDataRetentionBatchDuration(progress.Stage, time.Since(start).Seconds())
+		worker.metrics.AddDataRetentionDeletedCounter(progress.Stage, float64(deleted))
+	}
+
+	if progress.Stage == StageFiles {
+		progress.FilesDeleted += deleted
+	} else {
+		progress.PostsDeleted += deleted
+	}
+
+	if cursor == progress.Cursor {
+		// Nothing left to select past the cursor.
+		progress.NextStage()
+	} else {
+		progress.Cursor = cursor
+	}
+
+	return nil
+}
+
+// NextStage moves on to the posts of the current target, or to the files of the next one.
+func (progress *Progress) NextStage() {
+	progress.Cursor = model.RetentionPolicyCursor{}
+	if progress.Stage == StagePartitions {
+		progress.Stage = StageFiles
+		return
+	}
+	if progress.Stage == StageFiles {
+		progress.Stage = StagePosts
+		return
+	}
+
+	progress.Stage = StageFiles
+	progress.TargetIndex++
+}
+
+func (progress *Progress) IsDone() bool {
+	return progress.TargetIndex >= len(progress.Targets)
+}
+
+func (progress *Progress) CurrentProgress() int64 {
+	if len(progress.Targets) == 0 {
+		return 100
+	}
+
+	stagesDone := progress.TargetIndex * 2
+	if progress.Stage == StagePosts {
+		stagesDone++
+	}
+
+	return int64(stagesDone * 100 / (len(progress.Targets) * 2))
+}
+
+// SetJobData records the progress in the job data, so that it can be followed from the System Console.
+func (progress *Progress) SetJobData(job *model.Job) {
+	if job.Data == nil {
+		job.Data = make(map[string]string)
+	}
+
+	if !progress.IsDone() {
+		job.Data["policy_id"] = progress.Targets[progress.TargetIndex].PolicyId
+	} else {
+		delete(job.Data, "policy_id")
+	}
+	job.Data["stage"] = progress.Stage
+	job.Data["cursor_create_at"] = strconv.FormatInt(progress.Cursor.CreateAt, 10)
+	job.Data["cursor_id"] = progress.Cursor.Id
+	job.Data["posts_deleted"] = strconv.FormatInt(progress.PostsDeleted, 10)
+	job.Data["files_deleted"] = strconv.FormatInt(progress.FilesDeleted, 10)
+}
+
+func (worker *Worker) setJobSuccess(job *model.Job) {
+	if err := worker.jobServer.SetJobSuccess(job); err != nil {
+		mlog.Error("Worker: Failed to set success for job", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", err.Error()))
+		worker.setJobError(job, err)
+	}
+}
+
+func (worker *Worker) setJobError(job *model.Job, appError *model.AppError) {
+	if err := worker.jobServer.SetJobError(job, appError); err != nil {
+		mlog.Error("Worker: Failed to set job error", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", err.Error()))
+	}
+}
+
+func (worker *Worker) setJobCanceled(job *model.Job) {
+	if err := worker.jobServer.SetJobCanceled(job); err != nil {
+		mlog.Error("Worker: Failed to mark job as canceled", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", err.Error()))
+	}
+}
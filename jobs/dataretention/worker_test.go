@@ -0,0 +1,144 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package dataretention
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/einterfaces/mocks"
+	"github.com/mattermost/mattermost-server/v5/jobs"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest/mock"
+	"github.com/mattermost/mattermost-server/v5/store/storetest"
+	"github.com/mattermost/mattermost-server/v5/utils/testutils"
+)
+
+func newTestWorker(mockStore *storetest.Store, metrics *mocks.MetricsInterface) *Worker {
+	cfg := &model.Config{}
+	cfg.SetDefaults()
+	*cfg.DataRetentionSettings.EnableMessageDeletion = true
+	*cfg.DataRetentionSettings.BatchSize = 2
+
+	return &Worker{
+		name:      JobName,
+		jobServer: jobs.NewJobServer(&testutils.StaticConfigService{Cfg: cfg}, mockStore),
+		metrics:   metrics,
+	}
+}
+
+func TestGetTargets(t *testing.T) {
+	mockStore := &storetest.Store{}
+	defer mockStore.AssertExpectations(t)
+
+	policy := &model.RetentionPolicy{Id: model.NewId(), PostDuration: 1, FileDuration: model.RETENTION_POLICY_KEEP_FOREVER}
+	mockStore.RetentionPolicyStore.On("GetAll", 0, model.RETENTION_POLICIES_PER_PAGE_DEFAULT).Return([]*model.RetentionPolicy{policy}, nil)
+
+	worker := newTestWorker(mockStore, nil)
+	now := model.GetMillis()
+
+	targets, appErr := worker.getTargets(now)
+	require.Nil(t, appErr)
+	require.Len(t, targets, 2)
+	assert.Equal(t, retentionTarget{PolicyId: policy.Id, PostCutoff: policy.PostCutoff(now)}, targets[0])
+	assert.Equal(t, "", targets[1].PolicyId)
+	assert.Equal(t, now-365*24*60*60*1000, targets[1].PostCutoff)
+	assert.Zero(t, targets[1].FileCutoff, "file deletion is disabled globally")
+}
+
+func TestDeleteBatch(t *testing.T) {
+	mockStore := &storetest.Store{}
+	defer mockStore.AssertExpectations(t)
+	metrics := &mocks.MetricsInterface{}
+	defer metrics.AssertExpectations(t)
+
+	worker := newTestWorker(mockStore, metrics)
+
+	policyId := model.NewId()
+	progress := &Progress{
+		Targets: []retentionTarget{{PolicyId: policyId, PostCutoff: 2000}},
+		Stage:   StageFiles,
+	}
+
+	first := model.RetentionPolicyCursor{CreateAt: 1000, Id: model.NewId()}
+	mockStore.RetentionPolicyStore.On("PermanentDeletePostsBatch", policyId, int64(2000), model.RetentionPolicyCursor{}, int64(2)).Return(int64(2), first, nil)
+	mockStore.RetentionPolicyStore.On("PermanentDeletePostsBatch", policyId, int64(2000), first, int64(2)).Return(int64(0), first, nil)
+	metrics.On("ObserveDataRetentionBatchDuration", StagePosts, mock.AnythingOfType("float64")).Return()
+	metrics.On("AddDataRetentionDeletedCounter", StagePosts, mock.AnythingOfType("float64")).Return()
+
+	// Files are kept forever, so the files stage is skipped without touching the store.
+	require.Nil(t, worker.DeleteBatch(progress))
+	assert.Equal(t, StagePosts, progress.Stage)
+	assert.Equal(t, int64(50), progress.CurrentProgress())
+
+	require.Nil(t, worker.DeleteBatch(progress))
+	assert.Equal(t, first, progress.Cursor)
+	assert.Equal(t, int64(2), progress.PostsDeleted)
+	assert.False(t, progress.IsDone())
+
+	job := &model.Job{}
+	progress.SetJobData(job)
+	assert.Equal(t, policyId, job.Data["policy_id"])
+	assert.Equal(t, StagePosts, job.Data["stage"])
+	assert.Equal(t, first.Id, job.Data["cursor_id"])
+	assert.Equal(t, "2", job.Data["posts_deleted"])
+
+	require.Nil(t, worker.DeleteBatch(progress))
+	assert.True(t, progress.IsDone())
+	assert.Equal(t, int64(100), progress.CurrentProgress())
+}
+
+func TestDropPartitions(t *testing.T) {
+	mockStore := &storetest.Store{}
+	defer mockStore.AssertExpectations(t)
+	metrics := &mocks.MetricsInterface{}
+	defer metrics.AssertExpectations(t)
+
+	worker := newTestWorker(mockStore, metrics)
+	worker.jobServer.Config().SqlSettings.EnablePostsPartitioning = model.NewBool(true)
+
+	partitions := []*model.PostsPartition{
+		{Name: "posts_y1970m01", StartAt: 0, EndAt: 1000},
+		{Name: "posts_y1970m02", StartAt: 1000, EndAt: 2000},
+		{Name: "posts_y1970m03", StartAt: 2000, EndAt: 3000},
+	}
+
+	t.Run("partitions are kept while a target keeps something forever", func(t *testing.T) {
+		endTime, appErr := worker.getPartitionsEndTime([]retentionTarget{{PostCutoff: 2500, FileCutoff: 2500}, {PostCutoff: 2500}})
+		require.Nil(t, appErr)
+		assert.Zero(t, endTime)
+	})
+
+	mockStore.PostsPartitionStore.On("IsPartitioned").Return(true, nil)
+	mockStore.PostsPartitionStore.On("GetPartitions").Return(partitions, nil)
+
+	endTime, appErr := worker.getPartitionsEndTime([]retentionTarget{{PostCutoff: 2500, FileCutoff: 2999}, {PostCutoff: 2800, FileCutoff: 2100}})
+	require.Nil(t, appErr)
+	assert.Equal(t, int64(2000), endTime)
+
+	progress := &Progress{
+		PartitionsEndTime: endTime,
+		Targets:           []retentionTarget{{PostCutoff: 2500, FileCutoff: 2100}},
+		Stage:             StagePartitions,
+	}
+
+	cursor := model.RetentionPolicyCursor{CreateAt: 1500, Id: model.NewId()}
+	mockStore.RetentionPolicyStore.On("PermanentDeleteAllFilesBatch", int64(2000), model.RetentionPolicyCursor{}, int64(2)).Return(int64(1), cursor, nil)
+	mockStore.RetentionPolicyStore.On("PermanentDeleteAllFilesBatch", int64(2000), cursor, int64(2)).Return(int64(0), cursor, nil)
+	mockStore.PostsPartitionStore.On("DropPartitionsBefore", int64(2000)).Return([]string{"posts_y1970m01", "posts_y1970m02"}, nil).Once()
+	metrics.On("ObserveDataRetentionBatchDuration", StagePartitions, mock.AnythingOfType("float64")).Return()
+	metrics.On("AddDataRetentionDeletedCounter", StagePartitions, mock.AnythingOfType("float64")).Return()
+
+	require.Nil(t, worker.DeleteBatch(progress))
+	assert.Equal(t, StagePartitions, progress.Stage)
+	assert.Equal(t, cursor, progress.Cursor)
+
+	require.Nil(t, worker.DeleteBatch(progress))
+	assert.Equal(t, StageFiles, progress.Stage)
+	assert.Equal(t, model.RetentionPolicyCursor{}, progress.Cursor)
+	assert.Equal(t, int64(1), progress.FilesDeleted)
+	assert.Zero(t, progress.TargetIndex)
+}
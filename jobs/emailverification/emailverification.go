@@ -0,0 +1,19 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package emailverification
+
+import (
+	"github.com/mattermost/mattermost-server/v5/app"
+	tjobs "github.com/mattermost/mattermost-server/v5/jobs/interfaces"
+)
+
+type EmailVerificationJobInterfaceImpl struct {
+	App *app.App
+}
+
+func init() {
+	app.RegisterJobsEmailVerificationJobInterface(func(a *app.App) tjobs.EmailVerificationJobInterface {
+		return &EmailVerificationJobInterfaceImpl{a}
+	})
+}
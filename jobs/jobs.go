@@ -19,7 +19,7 @@ const (
 
 func (srv *JobServer) CreateJob(jobType string, jobData map[string]string) (*model.Job, *model.AppError) {
 	job := model.Job{
-		Id:       model.NewId(),
+		Id:       model.NewEntityId(),
 		Type:     jobType,
 		CreateAt: model.GetMillis(),
 		Status:   model.JOB_STATUS_PENDING,
@@ -135,6 +135,76 @@ func (watcher *Watcher) PollAndNotify() {
 				default:
 				}
 			}
+		} else if job.Type == model.JOB_TYPE_POST_ARCHIVE {
+			if watcher.workers.PostArchive != nil {
+				select {
+				case watcher.workers.PostArchive.JobChannel() <- *job:
+				default:
+				}
+			}
+		} else if job.Type == model.JOB_TYPE_POSTS_PARTITIONING {
+			if watcher.workers.PostsPartitioning != nil {
+				select {
+				case watcher.workers.PostsPartitioning.JobChannel() <- *job:
+				default:
+				}
+			}
+		} else if job.Type == model.JOB_TYPE_SEAT_USAGE_NOTIFY {
+			if watcher.workers.SeatUsageNotify != nil {
+				select {
+				case watcher.workers.SeatUsageNotify.JobChannel() <- *job:
+				default:
+				}
+			}
+		} else if job.Type == model.JOB_TYPE_EMAIL_VERIFICATION_ENFORCEMENT {
+			if watcher.workers.EmailVerification != nil {
+				select {
+				case watcher.workers.EmailVerification.JobChannel() <- *job:
+				default:
+				}
+			}
+		} else if job.Type == model.JOB_TYPE_BACKUP {
+			if watcher.workers.Backup != nil {
+				select {
+				case watcher.workers.Backup.JobChannel() <- *job:
+				default:
+				}
+			}
+		} else if job.Type == model.JOB_TYPE_ROLE_EXPIRY {
+			if watcher.workers.RoleExpiry != nil {
+				select {
+				case watcher.workers.RoleExpiry.JobChannel() <- *job:
+				default:
+				}
+			}
+		} else if job.Type == model.JOB_TYPE_FILE_ENRICHMENT {
+			if watcher.workers.FileEnrichment != nil {
+				select {
+				case watcher.workers.FileEnrichment.JobChannel() <- *job:
+				default:
+				}
+			}
+		} else if job.Type == model.JOB_TYPE_PREFERENCES_MIGRATION {
+			if watcher.workers.PreferencesMigration != nil {
+				select {
+				case watcher.workers.PreferencesMigration.JobChannel() <- *job:
+				default:
+				}
+			}
+		} else if job.Type == model.JOB_TYPE_POST_INTEGRITY_VERIFICATION {
+			if watcher.workers.PostIntegrity != nil {
+				select {
+				case watcher.workers.PostIntegrity.JobChannel() <- *job:
+				default:
+				}
+			}
+		} else if job.Type == model.JOB_TYPE_TEAM_PURGE {
+			if watcher.workers.TeamPurge != nil {
+				select {
+				case watcher.workers.TeamPurge.JobChannel() <- *job:
+				default:
+				}
+			}
 		}
 	}
 }
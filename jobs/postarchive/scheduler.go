@@ -0,0 +1,53 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package postarchive
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/app"
+	"github.com/mattermost/mattermost-server/v5/jobs"
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+type Scheduler struct {
+	server *app.Server
+}
+
+func (m *PostArchiveJobInterfaceImpl) MakeScheduler() model.Scheduler {
+	return &Scheduler{m.Server}
+}
+
+func (scheduler *Scheduler) Name() string {
+	return JobName + "Scheduler"
+}
+
+func (scheduler *Scheduler) JobType() string {
+	return model.JOB_TYPE_POST_ARCHIVE
+}
+
+func (scheduler *Scheduler) Enabled(cfg *model.Config) bool {
+	return *cfg.ArchiveSettings.EnablePostArchiving
+}
+
+func (scheduler *Scheduler) NextScheduleTime(cfg *model.Config, now time.Time, pendingJobs bool, lastSuccessfulJob *model.Job) *time.Time {
+	parsedTime, err := time.Parse("15:04", *cfg.ArchiveSettings.ArchiveJobStartTime)
+	if err != nil {
+		mlog.Error("Cannot determine next schedule time for post archiving. ArchiveJobStartTime config value is invalid.", mlog.Err(err))
+		return nil
+	}
+
+	return jobs.GenerateNextStartDateTime(now, parsedTime)
+}
+
+func (scheduler *Scheduler) ScheduleJob(cfg *model.Config, pendingJobs bool, lastSuccessfulJob *model.Job) (*model.Job, *model.AppError) {
+	data := map[string]string{}
+
+	job, err := scheduler.server.Jobs.CreateJob(model.JOB_TYPE_POST_ARCHIVE, data)
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package postarchive
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/jobs"
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+const (
+	JobName = "PostArchive"
+
+	TimeBetweenBatches = 100 * time.Millisecond
+)
+
+type Worker struct {
+	name      string
+	stop      chan bool
+	stopped   chan bool
+	jobs      chan model.Job
+	jobServer *jobs.JobServer
+}
+
+// Progress tracks a post archiving run. Posts created before EndTime are moved to the archive until
+// a batch comes back empty.
+type Progress struct {
+	EndTime       int64
+	PostsArchived int64
+	Done          bool
+}
+
+func (m *PostArchiveJobInterfaceImpl) MakeWorker() model.Worker {
+	worker := Worker{
+		name:      JobName,
+		stop:      make(chan bool, 1),
+		stopped:   make(chan bool, 1),
+		jobs:      make(chan model.Job),
+		jobServer: m.Server.Jobs,
+	}
+	return &worker
+}
+
+func (worker *Worker) Run() {
+	mlog.Debug("Worker started", mlog.String("worker", worker.name))
+
+	defer func() {
+		mlog.Debug("Worker finished", mlog.String("worker", worker.name))
+		worker.stopped <- true
+	}()
+
+	for {
+		select {
+		case <-worker.stop:
+			mlog.Debug("Worker received stop signal", mlog.String("worker", worker.name))
+			return
+		case job := <-worker.jobs:
+			mlog.Debug("Worker received a new candidate job.", mlog.String("worker", worker.name))
+			worker.DoJob(&job)
+		}
+	}
+}
+
+func (worker *Worker) Stop() {
+	mlog.Debug("Worker stopping", mlog.String("worker", worker.name))
+	worker.stop <- true
+	<-worker.stopped
+}
+
+func (worker *Worker) JobChannel() chan<- model.Job {
+	return worker.jobs
+}
+
+func (worker *Worker) DoJob(job *model.Job) {
+	if claimed, err := worker.jobServer.ClaimJob(job); err != nil {
+		mlog.Warn("Worker experienced an error while trying to claim job",
+			mlog.String("worker", worker.name),
+			mlog.String("job_id", job.Id),
+			mlog.String("error", err.Error()))
+		return
+	} else if !claimed {
+		return
+	}
+
+	months := *worker.jobServer.Config().ArchiveSettings.ArchiveAfterMonths
+	progress := &Progress{
+		EndTime: model.GetMillisForTime(time.Now().AddDate(0, -months, 0)),
+	}
+
+	cancelCtx, cancelCancelWatcher := context.WithCancel(context.Background())
+	cancelWatcherChan := make(chan interface{}, 1)
+	go worker.jobServer.CancellationWatcher(cancelCtx, job.Id, cancelWatcherChan)
+
+	defer cancelCancelWatcher()
+
+	for !progress.Done {
+		select {
+		case <-cancelWatcherChan:
+			mlog.Info("Worker: Post archive job has been canceled via CancellationWatcher", mlog.String("worker", worker.name), mlog.String("job_id", job.Id))
+			worker.setJobCanceled(job)
+			return
+
+		case <-worker.stop:
+			mlog.Info("Worker: Post archive job has been canceled via Worker Stop", mlog.String("worker", worker.name), mlog.String("job_id", job.Id))
+			worker.setJobCanceled(job)
+			// Let Run notice the stop signal as well.
+			worker.stop <- true
+			return
+
+		case <-time.After(TimeBetweenBatches):
+			if appErr := worker.ArchiveBatch(progress); appErr != nil {
+				mlog.Error("Worker: Failed to archive posts", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", appErr.Error()))
+				worker.setJobError(job, appErr)
+				return
+			}
+
+			progress.SetJobData(job)
+			if appErr := worker.jobServer.UpdateInProgressJobData(job); appErr != nil {
+				mlog.Error("Worker: Failed to update job data", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", appErr.Error()))
+				worker.setJobError(job, appErr)
+				return
+			}
+		}
+	}
+
+	mlog.Info("Worker: Job is complete", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.Int64("posts_archived", progress.PostsArchived))
+	worker.setJobSuccess(job)
+}
+
+// ArchiveBatch moves the next batch of posts to the archive and records whether anything is left.
+func (worker *Worker) ArchiveBatch(progress *Progress) *model.AppError {
+	batchSize := int64(*worker.jobServer.Config().ArchiveSettings.BatchSize)
+
+	archived, err := worker.jobServer.Store.PostArchive().ArchiveBatch(progress.EndTime, batchSize)
+	if err != nil {
+		return model.NewAppError("PostArchiveWorker", "jobs.post_archive.archive_batch.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	progress.PostsArchived += archived
+	progress.Done = archived == 0
+
+	return nil
+}
+
+// SetJobData records the progress in the job data, so that it can be followed from the System Console.
+func (progress *Progress) SetJobData(job *model.Job) {
+	if job.Data == nil {
+		job.Data = make(map[string]string)
+	}
+
+	job.Data["end_time"] = strconv.FormatInt(progress.EndTime, 10)
+	job.Data["posts_archived"] = strconv.FormatInt(progress.PostsArchived, 10)
+}
+
+func (worker *Worker) setJobSuccess(job *model.Job) {
+	if err := worker.jobServer.SetJobSuccess(job); err != nil {
+		mlog.Error("Worker: Failed to set success for job", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", err.Error()))
+		worker.setJobError(job, err)
+	}
+}
+
+func (worker *Worker) setJobError(job *model.Job, appError *model.AppError) {
+	if err := worker.jobServer.SetJobError(job, appError); err != nil {
+		mlog.Error("Worker: Failed to set job error", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", err.Error()))
+	}
+}
+
+func (worker *Worker) setJobCanceled(job *model.Job) {
+	if err := worker.jobServer.SetJobCanceled(job); err != nil {
+		mlog.Error("Worker: Failed to mark job as canceled", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package postarchive
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/jobs"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store/storetest"
+	"github.com/mattermost/mattermost-server/v5/utils/testutils"
+)
+
+func TestArchiveBatch(t *testing.T) {
+	mockStore := &storetest.Store{}
+	defer mockStore.AssertExpectations(t)
+
+	cfg := &model.Config{}
+	cfg.SetDefaults()
+	*cfg.ArchiveSettings.BatchSize = 2
+
+	worker := &Worker{
+		name:      JobName,
+		jobServer: jobs.NewJobServer(&testutils.StaticConfigService{Cfg: cfg}, mockStore),
+	}
+
+	progress := &Progress{EndTime: 1000}
+	mockStore.PostArchiveStore.On("ArchiveBatch", int64(1000), int64(2)).Return(int64(2), nil).Once()
+	mockStore.PostArchiveStore.On("ArchiveBatch", int64(1000), int64(2)).Return(int64(0), nil).Once()
+
+	require.Nil(t, worker.ArchiveBatch(progress))
+	assert.False(t, progress.Done)
+	assert.Equal(t, int64(2), progress.PostsArchived)
+
+	job := &model.Job{}
+	progress.SetJobData(job)
+	assert.Equal(t, "1000", job.Data["end_time"])
+	assert.Equal(t, "2", job.Data["posts_archived"])
+
+	require.Nil(t, worker.ArchiveBatch(progress))
+	assert.True(t, progress.Done)
+	assert.Equal(t, int64(2), progress.PostsArchived)
+
+	t.Run("store error", func(t *testing.T) {
+		mockStore.PostArchiveStore.On("ArchiveBatch", int64(2000), int64(2)).Return(int64(0), errors.New("failure")).Once()
+
+		appErr := worker.ArchiveBatch(&Progress{EndTime: 2000})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "jobs.post_archive.archive_batch.app_error", appErr.Id)
+	})
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package postintegrity
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/app"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+const (
+	SchedFreqHours = 24
+)
+
+type Scheduler struct {
+	App *app.App
+}
+
+func (m *PostIntegrityJobInterfaceImpl) MakeScheduler() model.Scheduler {
+	return &Scheduler{m.App}
+}
+
+func (scheduler *Scheduler) Name() string {
+	return JobName + "Scheduler"
+}
+
+func (scheduler *Scheduler) JobType() string {
+	return model.JOB_TYPE_POST_INTEGRITY_VERIFICATION
+}
+
+func (scheduler *Scheduler) Enabled(cfg *model.Config) bool {
+	return *cfg.ComplianceSettings.EnablePostIntegrity
+}
+
+func (scheduler *Scheduler) NextScheduleTime(cfg *model.Config, now time.Time, pendingJobs bool, lastSuccessfulJob *model.Job) *time.Time {
+	// A verification which found tampering finishes with a warning, which still counts as a run
+	// so that the channels aren't verified again every minute.
+	lastJob := lastSuccessfulJob
+	if warningJob, err := scheduler.App.Srv().Store.Job().GetNewestJobByStatusAndType(model.JOB_STATUS_WARNING, model.JOB_TYPE_POST_INTEGRITY_VERIFICATION); err == nil && warningJob != nil {
+		if lastJob == nil || warningJob.LastActivityAt > lastJob.LastActivityAt {
+			lastJob = warningJob
+		}
+	}
+
+	nextTime := time.Now().Add(time.Minute)
+	if lastJob != nil {
+		if next := time.Unix(0, lastJob.LastActivityAt*int64(time.Millisecond)).Add(SchedFreqHours * time.Hour); next.After(nextTime) {
+			nextTime = next
+		}
+	}
+	return &nextTime
+}
+
+func (scheduler *Scheduler) ScheduleJob(cfg *model.Config, pendingJobs bool, lastSuccessfulJob *model.Job) (*model.Job, *model.AppError) {
+	if pendingJobs {
+		return nil, nil
+	}
+
+	data := map[string]string{}
+
+	job, err := scheduler.App.Srv().Jobs.CreateJob(model.JOB_TYPE_POST_INTEGRITY_VERIFICATION, data)
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
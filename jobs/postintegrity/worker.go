@@ -0,0 +1,230 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package postintegrity
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/app"
+	"github.com/mattermost/mattermost-server/v5/jobs"
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+const (
+	JobName = "PostIntegrity"
+
+	BatchSize          = 100
+	TimeBetweenBatches = 100 * time.Millisecond
+)
+
+type Worker struct {
+	name      string
+	stop      chan bool
+	stopped   chan bool
+	jobs      chan model.Job
+	jobServer *jobs.JobServer
+	app       *app.App
+}
+
+// Progress tracks the verification of the hash chain of every channel. Channels are verified in
+// batches, in the order of their ids, until a batch comes back empty.
+type Progress struct {
+	LastChannelId      string
+	ChannelsChecked    int64
+	EntriesChecked     int64
+	TamperedChannelIds []string
+	Done               bool
+}
+
+func (m *PostIntegrityJobInterfaceImpl) MakeWorker() model.Worker {
+	worker := Worker{
+		name:      JobName,
+		stop:      make(chan bool, 1),
+		stopped:   make(chan bool, 1),
+		jobs:      make(chan model.Job),
+		jobServer: m.App.Srv().Jobs,
+		app:       m.App,
+	}
+	return &worker
+}
+
+func (worker *Worker) Run() {
+	mlog.Debug("Worker started", mlog.String("worker", worker.name))
+
+	defer func() {
+		mlog.Debug("Worker finished", mlog.String("worker", worker.name))
+		worker.stopped <- true
+	}()
+
+	for {
+		select {
+		case <-worker.stop:
+			mlog.Debug("Worker received stop signal", mlog.String("worker", worker.name))
+			return
+		case job := <-worker.jobs:
+			mlog.Debug("Worker received a new candidate job.", mlog.String("worker", worker.name))
+			worker.DoJob(&job)
+		}
+	}
+}
+
+func (worker *Worker) Stop() {
+	mlog.Debug("Worker stopping", mlog.String("worker", worker.name))
+	worker.stop <- true
+	<-worker.stopped
+}
+
+func (worker *Worker) JobChannel() chan<- model.Job {
+	return worker.jobs
+}
+
+func (worker *Worker) DoJob(job *model.Job) {
+	if claimed, err := worker.jobServer.ClaimJob(job); err != nil {
+		mlog.Warn("Worker experienced an error while trying to claim job",
+			mlog.String("worker", worker.name),
+			mlog.String("job_id", job.Id),
+			mlog.String("error", err.Error()))
+		return
+	} else if !claimed {
+		return
+	}
+
+	progress := ProgressFromJobData(job)
+
+	cancelCtx, cancelCancelWatcher := context.WithCancel(context.Background())
+	cancelWatcherChan := make(chan interface{}, 1)
+	go worker.jobServer.CancellationWatcher(cancelCtx, job.Id, cancelWatcherChan)
+
+	defer cancelCancelWatcher()
+
+	for !progress.Done {
+		select {
+		case <-cancelWatcherChan:
+			mlog.Info("Worker: Post integrity job has been canceled via CancellationWatcher", mlog.String("worker", worker.name), mlog.String("job_id", job.Id))
+			worker.setJobCanceled(job)
+			return
+
+		case <-worker.stop:
+			mlog.Info("Worker: Post integrity job has been canceled via Worker Stop", mlog.String("worker", worker.name), mlog.String("job_id", job.Id))
+			worker.setJobCanceled(job)
+			// Let Run notice the stop signal as well.
+			worker.stop <- true
+			return
+
+		case <-time.After(TimeBetweenBatches):
+			if appErr := worker.VerifyBatch(progress); appErr != nil {
+				mlog.Error("Worker: Failed to verify post integrity", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", appErr.Error()))
+				worker.setJobError(job, appErr)
+				return
+			}
+
+			progress.SetJobData(job)
+			if appErr := worker.jobServer.UpdateInProgressJobData(job); appErr != nil {
+				mlog.Error("Worker: Failed to update job data", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", appErr.Error()))
+				worker.setJobError(job, appErr)
+				return
+			}
+		}
+	}
+
+	if len(progress.TamperedChannelIds) > 0 {
+		mlog.Error("Worker: Post integrity verification found modified posts", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.Any("channel_ids", progress.TamperedChannelIds))
+		worker.setJobWarning(job)
+		return
+	}
+
+	mlog.Info("Worker: Job is complete", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.Int64("channels_checked", progress.ChannelsChecked))
+	worker.setJobSuccess(job)
+}
+
+// ProgressFromJobData reads the progress of a job that was interrupted from the job data.
+func ProgressFromJobData(job *model.Job) *Progress {
+	progress := &Progress{
+		LastChannelId:      job.Data["last_channel_id"],
+		TamperedChannelIds: []string{},
+	}
+
+	if checked, ok := job.Data["channels_checked"]; ok {
+		progress.ChannelsChecked, _ = strconv.ParseInt(checked, 10, 64)
+	}
+	if checked, ok := job.Data["entries_checked"]; ok {
+		progress.EntriesChecked, _ = strconv.ParseInt(checked, 10, 64)
+	}
+	if tampered := job.Data["tampered_channel_ids"]; tampered != "" {
+		progress.TamperedChannelIds = strings.Split(tampered, ",")
+	}
+
+	return progress
+}
+
+// VerifyBatch verifies the hash chains of the next batch of channels and records whether any
+// channel is left.
+func (worker *Worker) VerifyBatch(progress *Progress) *model.AppError {
+	channelIds, err := worker.jobServer.Store.PostIntegrity().GetChannelIds(progress.LastChannelId, BatchSize)
+	if err != nil {
+		return model.NewAppError("PostIntegrityWorker", "app.post_integrity.get_channel_ids.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	for _, channelId := range channelIds {
+		report, appErr := worker.app.VerifyChannelIntegrity(channelId)
+		if appErr != nil {
+			return appErr
+		}
+
+		if !report.Valid {
+			progress.TamperedChannelIds = append(progress.TamperedChannelIds, channelId)
+		}
+		progress.ChannelsChecked++
+		progress.EntriesChecked += report.EntriesChecked
+		progress.LastChannelId = channelId
+	}
+
+	progress.Done = len(channelIds) < BatchSize
+
+	return nil
+}
+
+// SetJobData records the progress in the job data, so that it can be followed from the System
+// Console and the tampered channels verified individually.
+func (progress *Progress) SetJobData(job *model.Job) {
+	if job.Data == nil {
+		job.Data = make(map[string]string)
+	}
+
+	job.Data["last_channel_id"] = progress.LastChannelId
+	job.Data["channels_checked"] = strconv.FormatInt(progress.ChannelsChecked, 10)
+	job.Data["entries_checked"] = strconv.FormatInt(progress.EntriesChecked, 10)
+	job.Data["tampered_channel_ids"] = strings.Join(progress.TamperedChannelIds, ",")
+}
+
+func (worker *Worker) setJobSuccess(job *model.Job) {
+	if err := worker.jobServer.SetJobSuccess(job); err != nil {
+		mlog.Error("Worker: Failed to set success for job", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", err.Error()))
+		worker.setJobError(job, err)
+	}
+}
+
+func (worker *Worker) setJobWarning(job *model.Job) {
+	if err := worker.jobServer.SetJobWarning(job); err != nil {
+		mlog.Error("Worker: Failed to set warning for job", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", err.Error()))
+		worker.setJobError(job, err)
+	}
+}
+
+func (worker *Worker) setJobError(job *model.Job, appError *model.AppError) {
+	if err := worker.jobServer.SetJobError(job, appError); err != nil {
+		mlog.Error("Worker: Failed to set job error", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", err.Error()))
+	}
+}
+
+func (worker *Worker) setJobCanceled(job *model.Job) {
+	if err := worker.jobServer.SetJobCanceled(job); err != nil {
+		mlog.Error("Worker: Failed to mark job as canceled", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package postintegrity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestProgressFromJobData(t *testing.T) {
+	t.Run("new job", func(t *testing.T) {
+		progress := ProgressFromJobData(&model.Job{})
+		assert.Equal(t, "", progress.LastChannelId)
+		assert.Zero(t, progress.ChannelsChecked)
+		assert.Empty(t, progress.TamperedChannelIds)
+		assert.False(t, progress.Done)
+	})
+
+	t.Run("interrupted job", func(t *testing.T) {
+		progress := &Progress{
+			LastChannelId:      model.NewId(),
+			ChannelsChecked:    12,
+			EntriesChecked:     345,
+			TamperedChannelIds: []string{model.NewId(), model.NewId()},
+		}
+
+		job := &model.Job{}
+		progress.SetJobData(job)
+
+		assert.Equal(t, progress, ProgressFromJobData(job))
+	})
+}
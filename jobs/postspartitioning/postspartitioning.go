@@ -0,0 +1,19 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package postspartitioning
+
+import (
+	"github.com/mattermost/mattermost-server/v5/app"
+	tjobs "github.com/mattermost/mattermost-server/v5/jobs/interfaces"
+)
+
+type PostsPartitioningJobInterfaceImpl struct {
+	Server *app.Server
+}
+
+func init() {
+	app.RegisterJobsPostsPartitioningJobInterface(func(s *app.Server) tjobs.PostsPartitioningJobInterface {
+		return &PostsPartitioningJobInterfaceImpl{s}
+	})
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package postspartitioning
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/app"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+const (
+	SchedFreqHours = 24
+)
+
+type Scheduler struct {
+	server *app.Server
+}
+
+func (m *PostsPartitioningJobInterfaceImpl) MakeScheduler() model.Scheduler {
+	return &Scheduler{m.Server}
+}
+
+func (scheduler *Scheduler) Name() string {
+	return JobName + "Scheduler"
+}
+
+func (scheduler *Scheduler) JobType() string {
+	return model.JOB_TYPE_POSTS_PARTITIONING
+}
+
+func (scheduler *Scheduler) Enabled(cfg *model.Config) bool {
+	return *cfg.SqlSettings.EnablePostsPartitioning && *cfg.SqlSettings.DriverName == model.DATABASE_DRIVER_POSTGRES
+}
+
+func (scheduler *Scheduler) NextScheduleTime(cfg *model.Config, now time.Time, pendingJobs bool, lastSuccessfulJob *model.Job) *time.Time {
+	// Convert the table as soon as partitioning is enabled, and then keep the partitions of the next months
+	// around once a day.
+	nextTime := time.Now().Add(time.Minute)
+	if lastSuccessfulJob != nil {
+		if next := time.Unix(0, lastSuccessfulJob.LastActivityAt*int64(time.Millisecond)).Add(SchedFreqHours * time.Hour); next.After(nextTime) {
+			nextTime = next
+		}
+	}
+	return &nextTime
+}
+
+func (scheduler *Scheduler) ScheduleJob(cfg *model.Config, pendingJobs bool, lastSuccessfulJob *model.Job) (*model.Job, *model.AppError) {
+	if pendingJobs {
+		return nil, nil
+	}
+
+	data := map[string]string{}
+
+	job, err := scheduler.server.Jobs.CreateJob(model.JOB_TYPE_POSTS_PARTITIONING, data)
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
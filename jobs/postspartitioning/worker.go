@@ -0,0 +1,214 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package postspartitioning
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/jobs"
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+const (
+	JobName = "PostsPartitioning"
+
+	BatchSize          = 1000
+	TimeBetweenBatches = 100 * time.Millisecond
+)
+
+type Worker struct {
+	name      string
+	stop      chan bool
+	stopped   chan bool
+	jobs      chan model.Job
+	jobServer *jobs.JobServer
+}
+
+// Progress tracks the online conversion of the Posts table. Posts are copied in CreateAt, Id order
+// from the cursor until a batch comes back empty.
+type Progress struct {
+	Cursor      model.PostsPartitionCursor
+	PostsCopied int64
+	Done        bool
+}
+
+func (m *PostsPartitioningJobInterfaceImpl) MakeWorker() model.Worker {
+	worker := Worker{
+		name:      JobName,
+		stop:      make(chan bool, 1),
+		stopped:   make(chan bool, 1),
+		jobs:      make(chan model.Job),
+		jobServer: m.Server.Jobs,
+	}
+	return &worker
+}
+
+func (worker *Worker) Run() {
+	mlog.Debug("Worker started", mlog.String("worker", worker.name))
+
+	defer func() {
+		mlog.Debug("Worker finished", mlog.String("worker", worker.name))
+		worker.stopped <- true
+	}()
+
+	for {
+		select {
+		case <-worker.stop:
+			mlog.Debug("Worker received stop signal", mlog.String("worker", worker.name))
+			return
+		case job := <-worker.jobs:
+			mlog.Debug("Worker received a new candidate job.", mlog.String("worker", worker.name))
+			worker.DoJob(&job)
+		}
+	}
+}
+
+func (worker *Worker) Stop() {
+	mlog.Debug("Worker stopping", mlog.String("worker", worker.name))
+	worker.stop <- true
+	<-worker.stopped
+}
+
+func (worker *Worker) JobChannel() chan<- model.Job {
+	return worker.jobs
+}
+
+func (worker *Worker) DoJob(job *model.Job) {
+	if claimed, err := worker.jobServer.ClaimJob(job); err != nil {
+		mlog.Warn("Worker experienced an error while trying to claim job",
+			mlog.String("worker", worker.name),
+			mlog.String("job_id", job.Id),
+			mlog.String("error", err.Error()))
+		return
+	} else if !claimed {
+		return
+	}
+
+	partitioned, err := worker.jobServer.Store.PostsPartition().IsPartitioned()
+	if err != nil {
+		worker.setJobError(job, model.NewAppError("PostsPartitioningWorker", "jobs.posts_partitioning.is_partitioned.app_error", nil, err.Error(), http.StatusInternalServerError))
+		return
+	}
+
+	if !partitioned {
+		if !worker.convert(job) {
+			return
+		}
+	}
+
+	until := model.GetMillisForTime(time.Now().AddDate(0, model.POSTS_PARTITIONS_AHEAD, 0))
+	if err := worker.jobServer.Store.PostsPartition().EnsurePartitions(until); err != nil {
+		mlog.Error("Worker: Failed to create partitions", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", err.Error()))
+		worker.setJobError(job, model.NewAppError("PostsPartitioningWorker", "jobs.posts_partitioning.ensure_partitions.app_error", nil, err.Error(), http.StatusInternalServerError))
+		return
+	}
+
+	mlog.Info("Worker: Job is complete", mlog.String("worker", worker.name), mlog.String("job_id", job.Id))
+	worker.setJobSuccess(job)
+}
+
+// convert copies the Posts table into its partitioned replacement and swaps the two. It returns false
+// if the job was stopped before the conversion finished, in which case the job status is already set.
+func (worker *Worker) convert(job *model.Job) bool {
+	if err := worker.jobServer.Store.PostsPartition().StartConversion(); err != nil {
+		mlog.Error("Worker: Failed to start the conversion", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", err.Error()))
+		worker.setJobError(job, model.NewAppError("PostsPartitioningWorker", "jobs.posts_partitioning.start_conversion.app_error", nil, err.Error(), http.StatusInternalServerError))
+		return false
+	}
+
+	progress := &Progress{}
+
+	cancelCtx, cancelCancelWatcher := context.WithCancel(context.Background())
+	cancelWatcherChan := make(chan interface{}, 1)
+	go worker.jobServer.CancellationWatcher(cancelCtx, job.Id, cancelWatcherChan)
+
+	defer cancelCancelWatcher()
+
+	for !progress.Done {
+		select {
+		case <-cancelWatcherChan:
+			mlog.Info("Worker: Posts partitioning job has been canceled via CancellationWatcher", mlog.String("worker", worker.name), mlog.String("job_id", job.Id))
+			worker.setJobCanceled(job)
+			return false
+
+		case <-worker.stop:
+			mlog.Info("Worker: Posts partitioning job has been canceled via Worker Stop", mlog.String("worker", worker.name), mlog.String("job_id", job.Id))
+			worker.setJobCanceled(job)
+			// Let Run notice the stop signal as well.
+			worker.stop <- true
+			return false
+
+		case <-time.After(TimeBetweenBatches):
+			if appErr := worker.CopyBatch(progress); appErr != nil {
+				mlog.Error("Worker: Failed to copy posts", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", appErr.Error()))
+				worker.setJobError(job, appErr)
+				return false
+			}
+
+			progress.SetJobData(job)
+			if appErr := worker.jobServer.UpdateInProgressJobData(job); appErr != nil {
+				mlog.Error("Worker: Failed to update job data", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", appErr.Error()))
+				worker.setJobError(job, appErr)
+				return false
+			}
+		}
+	}
+
+	if err := worker.jobServer.Store.PostsPartition().FinishConversion(); err != nil {
+		mlog.Error("Worker: Failed to finish the conversion", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", err.Error()))
+		worker.setJobError(job, model.NewAppError("PostsPartitioningWorker", "jobs.posts_partitioning.finish_conversion.app_error", nil, err.Error(), http.StatusInternalServerError))
+		return false
+	}
+
+	mlog.Info("Worker: Posts table converted to a partitioned table", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.Int64("posts_copied", progress.PostsCopied))
+	return true
+}
+
+// CopyBatch copies the next batch of posts into the partitioned table and records whether anything is left.
+func (worker *Worker) CopyBatch(progress *Progress) *model.AppError {
+	copied, cursor, err := worker.jobServer.Store.PostsPartition().CopyBatch(progress.Cursor, BatchSize)
+	if err != nil {
+		return model.NewAppError("PostsPartitioningWorker", "jobs.posts_partitioning.copy_batch.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	progress.Cursor = cursor
+	progress.PostsCopied += copied
+	progress.Done = copied == 0
+
+	return nil
+}
+
+// SetJobData records the progress in the job data, so that it can be followed from the System Console.
+func (progress *Progress) SetJobData(job *model.Job) {
+	if job.Data == nil {
+		job.Data = make(map[string]string)
+	}
+
+	job.Data["posts_copied"] = strconv.FormatInt(progress.PostsCopied, 10)
+	job.Data["cursor_create_at"] = strconv.FormatInt(progress.Cursor.CreateAt, 10)
+	job.Data["cursor_id"] = progress.Cursor.Id
+}
+
+func (worker *Worker) setJobSuccess(job *model.Job) {
+	if err := worker.jobServer.SetJobSuccess(job); err != nil {
+		mlog.Error("Worker: Failed to set success for job", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", err.Error()))
+		worker.setJobError(job, err)
+	}
+}
+
+func (worker *Worker) setJobError(job *model.Job, appError *model.AppError) {
+	if err := worker.jobServer.SetJobError(job, appError); err != nil {
+		mlog.Error("Worker: Failed to set job error", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", err.Error()))
+	}
+}
+
+func (worker *Worker) setJobCanceled(job *model.Job) {
+	if err := worker.jobServer.SetJobCanceled(job); err != nil {
+		mlog.Error("Worker: Failed to mark job as canceled", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package postspartitioning
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/jobs"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store/storetest"
+	"github.com/mattermost/mattermost-server/v5/utils/testutils"
+)
+
+func TestCopyBatch(t *testing.T) {
+	mockStore := &storetest.Store{}
+	defer mockStore.AssertExpectations(t)
+
+	cfg := &model.Config{}
+	cfg.SetDefaults()
+
+	worker := &Worker{
+		name:      JobName,
+		jobServer: jobs.NewJobServer(&testutils.StaticConfigService{Cfg: cfg}, mockStore),
+	}
+
+	cursor := model.PostsPartitionCursor{CreateAt: 1000, Id: "postid"}
+	progress := &Progress{}
+	mockStore.PostsPartitionStore.On("CopyBatch", model.PostsPartitionCursor{}, int64(BatchSize)).Return(int64(2), cursor, nil).Once()
+	mockStore.PostsPartitionStore.On("CopyBatch", cursor, int64(BatchSize)).Return(int64(0), cursor, nil).Once()
+
+	require.Nil(t, worker.CopyBatch(progress))
+	assert.False(t, progress.Done)
+	assert.Equal(t, int64(2), progress.PostsCopied)
+	assert.Equal(t, cursor, progress.Cursor)
+
+	job := &model.Job{}
+	progress.SetJobData(job)
+	assert.Equal(t, "2", job.Data["posts_copied"])
+	assert.Equal(t, "1000", job.Data["cursor_create_at"])
+	assert.Equal(t, "postid", job.Data["cursor_id"])
+
+	require.Nil(t, worker.CopyBatch(progress))
+	assert.True(t, progress.Done)
+	assert.Equal(t, int64(2), progress.PostsCopied)
+
+	t.Run("store error", func(t *testing.T) {
+		mockStore.PostsPartitionStore.On("CopyBatch", model.PostsPartitionCursor{}, int64(BatchSize)).Return(int64(0), model.PostsPartitionCursor{}, errors.New("failure")).Once()
+
+		appErr := worker.CopyBatch(&Progress{})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "jobs.posts_partitioning.copy_batch.app_error", appErr.Id)
+	})
+}
@@ -0,0 +1,197 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package preferencesmigration
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/jobs"
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+const (
+	JobName = "PreferencesMigration"
+
+	BatchSize          = 1000
+	TimeBetweenBatches = 100 * time.Millisecond
+)
+
+type Worker struct {
+	name      string
+	stop      chan bool
+	stopped   chan bool
+	jobs      chan model.Job
+	jobServer *jobs.JobServer
+}
+
+// Progress tracks the move of the preferences of every user from one category to another. Preferences
+// are moved in batches until a batch comes back empty.
+type Progress struct {
+	OldCategory     string
+	NewCategory     string
+	PreferencesDone int64
+	Done            bool
+}
+
+func (m *PreferencesMigrationJobInterfaceImpl) MakeWorker() model.Worker {
+	worker := Worker{
+		name:      JobName,
+		stop:      make(chan bool, 1),
+		stopped:   make(chan bool, 1),
+		jobs:      make(chan model.Job),
+		jobServer: m.Server.Jobs,
+	}
+	return &worker
+}
+
+func (worker *Worker) Run() {
+	mlog.Debug("Worker started", mlog.String("worker", worker.name))
+
+	defer func() {
+		mlog.Debug("Worker finished", mlog.String("worker", worker.name))
+		worker.stopped <- true
+	}()
+
+	for {
+		select {
+		case <-worker.stop:
+			mlog.Debug("Worker received stop signal", mlog.String("worker", worker.name))
+			return
+		case job := <-worker.jobs:
+			mlog.Debug("Worker received a new candidate job.", mlog.String("worker", worker.name))
+			worker.DoJob(&job)
+		}
+	}
+}
+
+func (worker *Worker) Stop() {
+	mlog.Debug("Worker stopping", mlog.String("worker", worker.name))
+	worker.stop <- true
+	<-worker.stopped
+}
+
+func (worker *Worker) JobChannel() chan<- model.Job {
+	return worker.jobs
+}
+
+func (worker *Worker) DoJob(job *model.Job) {
+	if claimed, err := worker.jobServer.ClaimJob(job); err != nil {
+		mlog.Warn("Worker experienced an error while trying to claim job",
+			mlog.String("worker", worker.name),
+			mlog.String("job_id", job.Id),
+			mlog.String("error", err.Error()))
+		return
+	} else if !claimed {
+		return
+	}
+
+	progress, appErr := ProgressFromJobData(job)
+	if appErr != nil {
+		worker.setJobError(job, appErr)
+		return
+	}
+
+	cancelCtx, cancelCancelWatcher := context.WithCancel(context.Background())
+	cancelWatcherChan := make(chan interface{}, 1)
+	go worker.jobServer.CancellationWatcher(cancelCtx, job.Id, cancelWatcherChan)
+
+	defer cancelCancelWatcher()
+
+	for !progress.Done {
+		select {
+		case <-cancelWatcherChan:
+			mlog.Info("Worker: Preferences migration job has been canceled via CancellationWatcher", mlog.String("worker", worker.name), mlog.String("job_id", job.Id))
+			worker.setJobCanceled(job)
+			return
+
+		case <-worker.stop:
+			mlog.Info("Worker: Preferences migration job has been canceled via Worker Stop", mlog.String("worker", worker.name), mlog.String("job_id", job.Id))
+			worker.setJobCanceled(job)
+			// Let Run notice the stop signal as well.
+			worker.stop <- true
+			return
+
+		case <-time.After(TimeBetweenBatches):
+			if appErr := worker.MoveBatch(progress); appErr != nil {
+				mlog.Error("Worker: Failed to move preferences", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", appErr.Error()))
+				worker.setJobError(job, appErr)
+				return
+			}
+
+			progress.SetJobData(job)
+			if appErr := worker.jobServer.UpdateInProgressJobData(job); appErr != nil {
+				mlog.Error("Worker: Failed to update job data", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", appErr.Error()))
+				worker.setJobError(job, appErr)
+				return
+			}
+		}
+	}
+
+	mlog.Info("Worker: Job is complete", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.Int64("preferences_done", progress.PreferencesDone))
+	worker.setJobSuccess(job)
+}
+
+// ProgressFromJobData reads the categories to migrate between from the job data, along with the progress of a
+// job that was interrupted.
+func ProgressFromJobData(job *model.Job) (*Progress, *model.AppError) {
+	progress := &Progress{
+		OldCategory: job.Data[model.JOB_DATA_OLD_CATEGORY],
+		NewCategory: job.Data[model.JOB_DATA_NEW_CATEGORY],
+	}
+
+	if progress.OldCategory == "" || progress.NewCategory == "" || progress.OldCategory == progress.NewCategory {
+		return nil, model.NewAppError("PreferencesMigrationWorker", "jobs.preferences_migration.categories.app_error", nil, "old_category="+progress.OldCategory+", new_category="+progress.NewCategory, http.StatusBadRequest)
+	}
+
+	if done, ok := job.Data["preferences_done"]; ok {
+		progress.PreferencesDone, _ = strconv.ParseInt(done, 10, 64)
+	}
+
+	return progress, nil
+}
+
+// MoveBatch moves the next batch of preferences to the new category and records whether anything is left.
+func (worker *Worker) MoveBatch(progress *Progress) *model.AppError {
+	moved, err := worker.jobServer.Store.Preference().RenameCategory(progress.OldCategory, progress.NewCategory, nil, BatchSize)
+	if err != nil {
+		return err
+	}
+
+	progress.PreferencesDone += moved
+	progress.Done = moved == 0
+
+	return nil
+}
+
+// SetJobData records the progress in the job data, so that it can be followed from the System Console.
+func (progress *Progress) SetJobData(job *model.Job) {
+	if job.Data == nil {
+		job.Data = make(map[string]string)
+	}
+
+	job.Data["preferences_done"] = strconv.FormatInt(progress.PreferencesDone, 10)
+}
+
+func (worker *Worker) setJobSuccess(job *model.Job) {
+	if err := worker.jobServer.SetJobSuccess(job); err != nil {
+		mlog.Error("Worker: Failed to set success for job", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", err.Error()))
+		worker.setJobError(job, err)
+	}
+}
+
+func (worker *Worker) setJobError(job *model.Job, appError *model.AppError) {
+	if err := worker.jobServer.SetJobError(job, appError); err != nil {
+		mlog.Error("Worker: Failed to set job error", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", err.Error()))
+	}
+}
+
+func (worker *Worker) setJobCanceled(job *model.Job) {
+	if err := worker.jobServer.SetJobCanceled(job); err != nil {
+		mlog.Error("Worker: Failed to mark job as canceled", mlog.String("worker", worker.name), mlog.String("job_id", job.Id), mlog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package preferencesmigration
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/jobs"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store/storetest"
+	"github.com/mattermost/mattermost-server/v5/utils/testutils"
+)
+
+func TestProgressFromJobData(t *testing.T) {
+	job := &model.Job{Data: map[string]string{
+		model.JOB_DATA_OLD_CATEGORY: "old_category",
+		model.JOB_DATA_NEW_CATEGORY: "new_category",
+		"preferences_done":          "42",
+	}}
+
+	progress, appErr := ProgressFromJobData(job)
+	require.Nil(t, appErr)
+	assert.Equal(t, "old_category", progress.OldCategory)
+	assert.Equal(t, "new_category", progress.NewCategory)
+	assert.Equal(t, int64(42), progress.PreferencesDone)
+	assert.False(t, progress.Done)
+
+	for name, data := range map[string]map[string]string{
+		"no categories":     {},
+		"no new category":   {model.JOB_DATA_OLD_CATEGORY: "old_category"},
+		"the same category": {model.JOB_DATA_OLD_CATEGORY: "category", model.JOB_DATA_NEW_CATEGORY: "category"},
+		"no old category":   {model.JOB_DATA_NEW_CATEGORY: "new_category"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, appErr := ProgressFromJobData(&model.Job{Data: data})
+			require.NotNil(t, appErr)
+			assert.Equal(t, "jobs.preferences_migration.categories.app_error", appErr.Id)
+		})
+	}
+}
+
+func TestMoveBatch(t *testing.T) {
+	mockStore := &storetest.Store{}
+	defer mockStore.AssertExpectations(t)
+
+	cfg := &model.Config{}
+	cfg.SetDefaults()
+
+	worker := &Worker{
+		name:      JobName,
+		jobServer: jobs.NewJobServer(&testutils.StaticConfigService{Cfg: cfg}, mockStore),
+	}
+
+	progress := &Progress{OldCategory: "old_category", NewCategory: "new_category"}
+	mockStore.PreferenceStore.On("RenameCategory", "old_category", "new_category", mock.Anything, int64(BatchSize)).Return(int64(3), nil).Once()
+	mockStore.PreferenceStore.On("RenameCategory", "old_category", "new_category", mock.Anything, int64(BatchSize)).Return(int64(0), nil).Once()
+
+	require.Nil(t, worker.MoveBatch(progress))
+	assert.False(t, progress.Done)
+	assert.Equal(t, int64(3), progress.PreferencesDone)
+
+	job := &model.Job{}
+	progress.SetJobData(job)
+	assert.Equal(t, "3", job.Data["preferences_done"])
+
+	require.Nil(t, worker.MoveBatch(progress))
+	assert.True(t, progress.Done)
+	assert.Equal(t, int64(3), progress.PreferencesDone)
+
+	t.Run("store error", func(t *testing.T) {
+		storeErr := model.NewAppError("SqlPreferenceStore.RenameCategory", "store.sql_preference.rename_category.app_error", nil, "", http.StatusInternalServerError)
+		mockStore.PreferenceStore.On("RenameCategory", "old_category", "new_category", mock.Anything, int64(BatchSize)).Return(int64(0), storeErr).Once()
+
+		appErr := worker.MoveBatch(&Progress{OldCategory: "old_category", NewCategory: "new_category"})
+		require.NotNil(t, appErr)
+		assert.Equal(t, storeErr.Id, appErr.Id)
+	})
+}
@@ -66,6 +66,38 @@ func (srv *JobServer) InitSchedulers() *Schedulers {
 		schedulers.schedulers = append(schedulers.schedulers, expiryNotifyInterface.MakeScheduler())
 	}
 
+	if postArchiveInterface := srv.PostArchive; postArchiveInterface != nil {
+		schedulers.schedulers = append(schedulers.schedulers, postArchiveInterface.MakeScheduler())
+	}
+
+	if postsPartitioningInterface := srv.PostsPartitioning; postsPartitioningInterface != nil {
+		schedulers.schedulers = append(schedulers.schedulers, postsPartitioningInterface.MakeScheduler())
+	}
+
+	if seatUsageNotifyInterface := srv.SeatUsageNotify; seatUsageNotifyInterface != nil {
+		schedulers.schedulers = append(schedulers.schedulers, seatUsageNotifyInterface.MakeScheduler())
+	}
+
+	if emailVerificationInterface := srv.EmailVerification; emailVerificationInterface != nil {
+		schedulers.schedulers = append(schedulers.schedulers, emailVerificationInterface.MakeScheduler())
+	}
+
+	if backupInterface := srv.Backup; backupInterface != nil {
+		schedulers.schedulers = append(schedulers.schedulers, backupInterface.MakeScheduler())
+	}
+
+	if roleExpiryInterface := srv.RoleExpiry; roleExpiryInterface != nil {
+		schedulers.schedulers = append(schedulers.schedulers, roleExpiryInterface.MakeScheduler())
+	}
+
+	if postIntegrityInterface := srv.PostIntegrity; postIntegrityInterface != nil {
+		schedulers.schedulers = append(schedulers.schedulers, postIntegrityInterface.MakeScheduler())
+	}
+
+	if teamPurgeInterface := srv.TeamPurge; teamPurgeInterface != nil {
+		schedulers.schedulers = append(schedulers.schedulers, teamPurgeInterface.MakeScheduler())
+	}
+
 	schedulers.nextRunTimes = make([]*time.Time, len(schedulers.schedulers))
 	return schedulers
 }
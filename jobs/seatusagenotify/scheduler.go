@@ -0,0 +1,55 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package seatusagenotify
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/app"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+const (
+	SchedFreqHours = 24
+)
+
+type Scheduler struct {
+	App *app.App
+}
+
+func (m *SeatUsageNotifyJobInterfaceImpl) MakeScheduler() model.Scheduler {
+	return &Scheduler{m.App}
+}
+
+func (scheduler *Scheduler) Name() string {
+	return JobName + "Scheduler"
+}
+
+func (scheduler *Scheduler) JobType() string {
+	return model.JOB_TYPE_SEAT_USAGE_NOTIFY
+}
+
+func (scheduler *Scheduler) Enabled(cfg *model.Config) bool {
+	return *cfg.ServiceSettings.EnableSeatUsageNotifications
+}
+
+func (scheduler *Scheduler) NextScheduleTime(cfg *model.Config, now time.Time, pendingJobs bool, lastSuccessfulJob *model.Job) *time.Time {
+	// Run right away the first time, then once a day after the last successful run.
+	if lastSuccessfulJob == nil {
+		return &now
+	}
+
+	nextTime := time.Unix(0, lastSuccessfulJob.LastActivityAt*int64(time.Millisecond)).Add(SchedFreqHours * time.Hour)
+	return &nextTime
+}
+
+func (scheduler *Scheduler) ScheduleJob(cfg *model.Config, pendingJobs bool, lastSuccessfulJob *model.Job) (*model.Job, *model.AppError) {
+	data := map[string]string{}
+
+	job, err := scheduler.App.Srv().Jobs.CreateJob(model.JOB_TYPE_SEAT_USAGE_NOTIFY, data)
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
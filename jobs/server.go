@@ -26,6 +26,16 @@ type JobServer struct {
 	Plugins                 tjobs.PluginsJobInterface
 	BleveIndexer            tjobs.IndexerJobInterface
 	ExpiryNotify            tjobs.ExpiryNotifyJobInterface
+	PostArchive             tjobs.PostArchiveJobInterface
+	PostsPartitioning       tjobs.PostsPartitioningJobInterface
+	SeatUsageNotify         tjobs.SeatUsageNotifyJobInterface
+	EmailVerification       tjobs.EmailVerificationJobInterface
+	Backup                  tjobs.BackupJobInterface
+	RoleExpiry              tjobs.RoleExpiryJobInterface
+	FileEnrichment          tjobs.FileEnrichmentJobInterface
+	PreferencesMigration    tjobs.PreferencesMigrationJobInterface
+	PostIntegrity           tjobs.PostIntegrityJobInterface
+	TeamPurge               tjobs.TeamPurgeJobInterface
 }
 
 func NewJobServer(configService configservice.ConfigService, store store.Store) *JobServer {
@@ -25,6 +25,16 @@ type Workers struct {
 	Plugins                  model.Worker
 	BleveIndexing            model.Worker
 	ExpiryNotify             model.Worker
+	PostArchive              model.Worker
+	PostsPartitioning        model.Worker
+	SeatUsageNotify          model.Worker
+	EmailVerification        model.Worker
+	Backup                   model.Worker
+	RoleExpiry               model.Worker
+	FileEnrichment           model.Worker
+	PreferencesMigration     model.Worker
+	PostIntegrity            model.Worker
+	TeamPurge                model.Worker
 
 	listenerId string
 }
@@ -70,6 +80,46 @@ func (srv *JobServer) InitWorkers() *Workers {
 	if expiryNotifyInterface := srv.ExpiryNotify; expiryNotifyInterface != nil {
 		workers.ExpiryNotify = expiryNotifyInterface.MakeWorker()
 	}
+
+	if postArchiveInterface := srv.PostArchive; postArchiveInterface != nil {
+		workers.PostArchive = postArchiveInterface.MakeWorker()
+	}
+
+	if postsPartitioningInterface := srv.PostsPartitioning; postsPartitioningInterface != nil {
+		workers.PostsPartitioning = postsPartitioningInterface.MakeWorker()
+	}
+
+	if seatUsageNotifyInterface := srv.SeatUsageNotify; seatUsageNotifyInterface != nil {
+		workers.SeatUsageNotify = seatUsageNotifyInterface.MakeWorker()
+	}
+
+	if emailVerificationInterface := srv.EmailVerification; emailVerificationInterface != nil {
+		workers.EmailVerification = emailVerificationInterface.MakeWorker()
+	}
+
+	if backupInterface := srv.Backup; backupInterface != nil {
+		workers.Backup = backupInterface.MakeWorker()
+	}
+
+	if roleExpiryInterface := srv.RoleExpiry; roleExpiryInterface != nil {
+		workers.RoleExpiry = roleExpiryInterface.MakeWorker()
+	}
+
+	if fileEnrichmentInterface := srv.FileEnrichment; fileEnrichmentInterface != nil {
+		workers.FileEnrichment = fileEnrichmentInterface.MakeWorker()
+	}
+
+	if preferencesMigrationInterface := srv.PreferencesMigration; preferencesMigrationInterface != nil {
+		workers.PreferencesMigration = preferencesMigrationInterface.MakeWorker()
+	}
+
+	if postIntegrityInterface := srv.PostIntegrity; postIntegrityInterface != nil {
+		workers.PostIntegrity = postIntegrityInterface.MakeWorker()
+	}
+
+	if teamPurgeInterface := srv.TeamPurge; teamPurgeInterface != nil {
+		workers.TeamPurge = teamPurgeInterface.MakeWorker()
+	}
 	return workers
 }
 
@@ -113,6 +163,46 @@ func (workers *Workers) Start() *Workers {
 			go workers.ExpiryNotify.Run()
 		}
 
+		if workers.PostArchive != nil && *workers.ConfigService.Config().ArchiveSettings.EnablePostArchiving {
+			go workers.PostArchive.Run()
+		}
+
+		if workers.PostsPartitioning != nil && *workers.ConfigService.Config().SqlSettings.EnablePostsPartitioning {
+			go workers.PostsPartitioning.Run()
+		}
+
+		if workers.SeatUsageNotify != nil && *workers.ConfigService.Config().ServiceSettings.EnableSeatUsageNotifications {
+			go workers.SeatUsageNotify.Run()
+		}
+
+		if workers.EmailVerification != nil && workers.ConfigService.Config().EmailSettings.IsEmailVerificationGracePeriodEnabled() {
+			go workers.EmailVerification.Run()
+		}
+
+		if workers.Backup != nil && *workers.ConfigService.Config().BackupSettings.EnableScheduledBackups {
+			go workers.Backup.Run()
+		}
+
+		if workers.RoleExpiry != nil {
+			go workers.RoleExpiry.Run()
+		}
+
+		if workers.FileEnrichment != nil && *workers.ConfigService.Config().FileSettings.EnableFileEnrichment {
+			go workers.FileEnrichment.Run()
+		}
+
+		if workers.PreferencesMigration != nil {
+			go workers.PreferencesMigration.Run()
+		}
+
+		if workers.PostIntegrity != nil && *workers.ConfigService.Config().ComplianceSettings.EnablePostIntegrity {
+			go workers.PostIntegrity.Run()
+		}
+
+		if workers.TeamPurge != nil && *workers.ConfigService.Config().ServiceSettings.TeamDeletionGracePeriodInDays > 0 {
+			go workers.TeamPurge.Run()
+		}
+
 		go workers.Watcher.Start()
 	})
 
@@ -171,6 +261,70 @@ func (workers *Workers) handleConfigChange(oldConfig *model.Config, newConfig *m
 			workers.BleveIndexing.Stop()
 		}
 	}
+
+	if workers.PostArchive != nil {
+		if !*oldConfig.ArchiveSettings.EnablePostArchiving && *newConfig.ArchiveSettings.EnablePostArchiving {
+			go workers.PostArchive.Run()
+		} else if *oldConfig.ArchiveSettings.EnablePostArchiving && !*newConfig.ArchiveSettings.EnablePostArchiving {
+			workers.PostArchive.Stop()
+		}
+	}
+
+	if workers.PostsPartitioning != nil {
+		if !*oldConfig.SqlSettings.EnablePostsPartitioning && *newConfig.SqlSettings.EnablePostsPartitioning {
+			go workers.PostsPartitioning.Run()
+		} else if *oldConfig.SqlSettings.EnablePostsPartitioning && !*newConfig.SqlSettings.EnablePostsPartitioning {
+			workers.PostsPartitioning.Stop()
+		}
+	}
+
+	if workers.SeatUsageNotify != nil {
+		if !*oldConfig.ServiceSettings.EnableSeatUsageNotifications && *newConfig.ServiceSettings.EnableSeatUsageNotifications {
+			go workers.SeatUsageNotify.Run()
+		} else if *oldConfig.ServiceSettings.EnableSeatUsageNotifications && !*newConfig.ServiceSettings.EnableSeatUsageNotifications {
+			workers.SeatUsageNotify.Stop()
+		}
+	}
+
+	if workers.EmailVerification != nil {
+		if !oldConfig.EmailSettings.IsEmailVerificationGracePeriodEnabled() && newConfig.EmailSettings.IsEmailVerificationGracePeriodEnabled() {
+			go workers.EmailVerification.Run()
+		} else if oldConfig.EmailSettings.IsEmailVerificationGracePeriodEnabled() && !newConfig.EmailSettings.IsEmailVerificationGracePeriodEnabled() {
+			workers.EmailVerification.Stop()
+		}
+	}
+
+	if workers.Backup != nil {
+		if !*oldConfig.BackupSettings.EnableScheduledBackups && *newConfig.BackupSettings.EnableScheduledBackups {
+			go workers.Backup.Run()
+		} else if *oldConfig.BackupSettings.EnableScheduledBackups && !*newConfig.BackupSettings.EnableScheduledBackups {
+			workers.Backup.Stop()
+		}
+	}
+
+	if workers.FileEnrichment != nil {
+		if !*oldConfig.FileSettings.EnableFileEnrichment && *newConfig.FileSettings.EnableFileEnrichment {
+			go workers.FileEnrichment.Run()
+		} else if *oldConfig.FileSettings.EnableFileEnrichment && !*newConfig.FileSettings.EnableFileEnrichment {
+			workers.FileEnrichment.Stop()
+		}
+	}
+
+	if workers.PostIntegrity != nil {
+		if !*oldConfig.ComplianceSettings.EnablePostIntegrity && *newConfig.ComplianceSettings.EnablePostIntegrity {
+			go workers.PostIntegrity.Run()
+		} else if *oldConfig.ComplianceSettings.EnablePostIntegrity && !*newConfig.ComplianceSettings.EnablePostIntegrity {
+			workers.PostIntegrity.Stop()
+		}
+	}
+
+	if workers.TeamPurge != nil {
+		if *oldConfig.ServiceSettings.TeamDeletionGracePeriodInDays <= 0 && *newConfig.ServiceSettings.TeamDeletionGracePeriodInDays > 0 {
+			go workers.TeamPurge.Run()
+		} else if *oldConfig.ServiceSettings.TeamDeletionGracePeriodInDays > 0 && *newConfig.ServiceSettings.TeamDeletionGracePeriodInDays <= 0 {
+			workers.TeamPurge.Stop()
+		}
+	}
 }
 
 func (workers *Workers) Stop() *Workers {
@@ -214,6 +368,46 @@ func (workers *Workers) Stop() *Workers {
 		workers.ExpiryNotify.Stop()
 	}
 
+	if workers.PostArchive != nil && *workers.ConfigService.Config().ArchiveSettings.EnablePostArchiving {
+		workers.PostArchive.Stop()
+	}
+
+	if workers.PostsPartitioning != nil && *workers.ConfigService.Config().SqlSettings.EnablePostsPartitioning {
+		workers.PostsPartitioning.Stop()
+	}
+
+	if workers.SeatUsageNotify != nil && *workers.ConfigService.Config().ServiceSettings.EnableSeatUsageNotifications {
+		workers.SeatUsageNotify.Stop()
+	}
+
+	if workers.EmailVerification != nil && workers.ConfigService.Config().EmailSettings.IsEmailVerificationGracePeriodEnabled() {
+		workers.EmailVerification.Stop()
+	}
+
+	if workers.Backup != nil && *workers.ConfigService.Config().BackupSettings.EnableScheduledBackups {
+		workers.Backup.Stop()
+	}
+
+	if workers.RoleExpiry != nil {
+		workers.RoleExpiry.Stop()
+	}
+
+	if workers.FileEnrichment != nil && *workers.ConfigService.Config().FileSettings.EnableFileEnrichment {
+		workers.FileEnrichment.Stop()
+	}
+
+	if workers.PreferencesMigration != nil {
+		workers.PreferencesMigration.Stop()
+	}
+
+	if workers.PostIntegrity != nil && *workers.ConfigService.Config().ComplianceSettings.EnablePostIntegrity {
+		workers.PostIntegrity.Stop()
+	}
+
+	if workers.TeamPurge != nil && *workers.ConfigService.Config().ServiceSettings.TeamDeletionGracePeriodInDays > 0 {
+		workers.TeamPurge.Stop()
+	}
+
 	mlog.Info("Stopped workers")
 
 	return workers
@@ -4,6 +4,7 @@
 package manualtesting
 
 import (
+	"context"
 	"hash/fnv"
 	"math/rand"
 	"net/http"
@@ -75,9 +76,9 @@ func manualTest(c *web.Context, w http.ResponseWriter, r *http.Request) {
 			Type:        model.TEAM_OPEN,
 		}
 
-		createdTeam, err := c.App.Srv().Store.Team().Save(team)
+		createdTeam, err := c.App.Srv().Store.Team().Save(context.Background(), team)
 		if err != nil {
-			c.Err = err
+			c.Err = model.NewAppError("manualTest", "app.team.save.app_error", nil, err.Error(), http.StatusInternalServerError)
 			return
 		}
 
@@ -102,7 +103,7 @@ func manualTest(c *web.Context, w http.ResponseWriter, r *http.Request) {
 		}
 
 		c.App.Srv().Store.User().VerifyEmail(user.Id, user.Email)
-		c.App.Srv().Store.Team().SaveMember(&model.TeamMember{TeamId: teamID, UserId: user.Id}, *c.App.Config().TeamSettings.MaxUsersPerTeam)
+		c.App.Srv().Store.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamID, UserId: user.Id}, *c.App.Config().TeamSettings.MaxUsersPerTeam)
 
 		userID = user.Id
 
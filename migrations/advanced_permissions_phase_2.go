@@ -4,19 +4,28 @@
 package migrations
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
 	"strings"
 
+	"github.com/mattermost/mattermost-server/v5/mlog"
 	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
 )
 
+// TeamMembersMigrationBatchSize is the number of team members migrated by each batch of the
+// advanced permissions phase 2 migration.
+const TeamMembersMigrationBatchSize = 100
+
 type AdvancedPermissionsPhase2Progress struct {
 	CurrentTable  string `json:"current_table"`
 	LastTeamId    string `json:"last_team_id"`
 	LastChannelId string `json:"last_channel_id"`
 	LastUserId    string `json:"last_user"`
+
+	ProcessedTeamMembers int64 `json:"processed_team_members"`
 }
 
 func (p *AdvancedPermissionsPhase2Progress) ToJson() string {
@@ -71,18 +80,29 @@ func (worker *Worker) runAdvancedPermissionsPhase2Migration(lastDone string) (bo
 
 	if progress.CurrentTable == "TeamMembers" {
 		// Run a TeamMembers migration batch.
-		if result, err := worker.srv.Store.Team().MigrateTeamMembers(progress.LastTeamId, progress.LastUserId); err != nil {
+		migration := &TeamMembersMigration{
+			Store:      worker.srv.Store,
+			BatchSize:  TeamMembersMigrationBatchSize,
+			LastTeamId: progress.LastTeamId,
+			LastUserId: progress.LastUserId,
+			Processed:  progress.ProcessedTeamMembers,
+		}
+
+		done, err := migration.RunBatch(context.Background())
+		if err != nil {
 			return false, progress.ToJson(), err
-		} else {
-			if result == nil {
-				// We haven't progressed. That means that we've reached the end of this stage of the migration, and should now advance to the next stage.
-				progress.LastUserId = strings.Repeat("0", 26)
-				progress.CurrentTable = "ChannelMembers"
-				return false, progress.ToJson(), nil
-			}
+		}
 
-			progress.LastTeamId = result["TeamId"]
-			progress.LastUserId = result["UserId"]
+		progress.LastTeamId = migration.LastTeamId
+		progress.LastUserId = migration.LastUserId
+		progress.ProcessedTeamMembers = migration.Processed
+
+		if done {
+			// We haven't progressed. That means that we've reached the end of this stage of the migration, and should now advance to the next stage.
+			mlog.Info("Migrated the roles of the team members.", mlog.Int64("processed", progress.ProcessedTeamMembers))
+			progress.LastUserId = strings.Repeat("0", 26)
+			progress.CurrentTable = "ChannelMembers"
+			return false, progress.ToJson(), nil
 		}
 	} else if progress.CurrentTable == "ChannelMembers" {
 		// Run a ChannelMembers migration batch.
@@ -102,3 +122,31 @@ func (worker *Worker) runAdvancedPermissionsPhase2Migration(lastDone string) (bo
 
 	return false, progress.ToJson(), nil
 }
+
+// TeamMembersMigration migrates the roles of the team members to the scheme roles in batches. It
+// keeps the key of the last migrated team member, so that it can be resumed from a saved progress.
+type TeamMembersMigration struct {
+	Store      store.Store
+	BatchSize  int
+	LastTeamId string
+	LastUserId string
+	Processed  int64
+}
+
+// RunBatch migrates the next batch of team members, returning whether there were none left.
+func (m *TeamMembersMigration) RunBatch(ctx context.Context) (bool, *model.AppError) {
+	result, processed, err := m.Store.Team().MigrateTeamMembers(ctx, m.LastTeamId, m.LastUserId, m.BatchSize)
+	if err != nil {
+		return false, err
+	}
+
+	if result == nil {
+		return true, nil
+	}
+
+	m.LastTeamId = result["TeamId"]
+	m.LastUserId = result["UserId"]
+	m.Processed += processed
+
+	return false, nil
+}
@@ -33,6 +33,7 @@ func MakeMigrationsList() []string {
 	return []string{
 		model.MIGRATION_KEY_ADVANCED_PERMISSIONS_PHASE_2,
 		model.MIGRATION_KEY_SIDEBAR_CATEGORIES_PHASE_2,
+		model.MIGRATION_KEY_ROOT_MESSAGE_COUNTS,
 	}
 }
 
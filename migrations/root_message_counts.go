@@ -0,0 +1,95 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package migrations
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+// RootMessageCountsProgress is the progress of the root message counts migration, which counts the
+// root messages of the channels, then those read by the channel members, in batches.
+type RootMessageCountsProgress struct {
+	CurrentTable  string `json:"current_table"`
+	LastChannelId string `json:"last_channel_id"`
+	LastUserId    string `json:"last_user"`
+}
+
+func (p *RootMessageCountsProgress) ToJson() string {
+	b, _ := json.Marshal(p)
+	return string(b)
+}
+
+func RootMessageCountsProgressFromJson(data io.Reader) *RootMessageCountsProgress {
+	var o *RootMessageCountsProgress
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
+
+func (p *RootMessageCountsProgress) IsValid() bool {
+	if len(p.LastChannelId) != 26 {
+		return false
+	}
+
+	if len(p.LastUserId) != 26 {
+		return false
+	}
+
+	switch p.CurrentTable {
+	case "Channels", "ChannelMembers":
+		return true
+	default:
+		return false
+	}
+}
+
+func newRootMessageCountsProgress(table string) *RootMessageCountsProgress {
+	return &RootMessageCountsProgress{
+		CurrentTable:  table,
+		LastChannelId: strings.Repeat("0", 26),
+		LastUserId:    strings.Repeat("0", 26),
+	}
+}
+
+func (worker *Worker) runRootMessageCountsMigration(lastDone string) (bool, string, *model.AppError) {
+	var progress *RootMessageCountsProgress
+	if len(lastDone) == 0 {
+		progress = newRootMessageCountsProgress("Channels")
+	} else {
+		progress = RootMessageCountsProgressFromJson(strings.NewReader(lastDone))
+		if progress == nil || !progress.IsValid() {
+			return false, "", model.NewAppError("MigrationsWorker.runRootMessageCountsMigration", "migrations.worker.run_root_message_counts_migration.invalid_progress", map[string]interface{}{"progress": lastDone}, "", http.StatusInternalServerError)
+		}
+	}
+
+	var data map[string]string
+	var err error
+	if progress.CurrentTable == "Channels" {
+		data, err = worker.srv.Store.Channel().MigrateTotalMsgCountRoot(progress.LastChannelId)
+	} else {
+		data, err = worker.srv.Store.Channel().MigrateMsgCountRoot(progress.LastChannelId, progress.LastUserId)
+	}
+	if err != nil {
+		return false, progress.ToJson(), model.NewAppError("MigrationsWorker.runRootMessageCountsMigration", "migrations.worker.run_root_message_counts_migration.internal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	if data == nil {
+		// We haven't progressed. That means that we've reached the end of this stage of the migration, and should now advance to the next stage or stop
+		if progress.CurrentTable == "Channels" {
+			return false, newRootMessageCountsProgress("ChannelMembers").ToJson(), nil
+		}
+		return true, progress.ToJson(), nil
+	}
+
+	progress.LastChannelId = data["ChannelId"]
+	if userId, ok := data["UserId"]; ok {
+		progress.LastUserId = userId
+	}
+
+	return false, progress.ToJson(), nil
+}
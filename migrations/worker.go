@@ -154,6 +154,8 @@ func (worker *Worker) runMigration(key string, lastDone string) (bool, string, *
 		done, progress, err = worker.runSidebarCategoriesPhase2Migration(lastDone)
 	case model.MIGRATION_KEY_ADVANCED_PERMISSIONS_PHASE_2:
 		done, progress, err = worker.runAdvancedPermissionsPhase2Migration(lastDone)
+	case model.MIGRATION_KEY_ROOT_MESSAGE_COUNTS:
+		done, progress, err = worker.runRootMessageCountsMigration(lastDone)
 	default:
 		return false, "", model.NewAppError("MigrationsWorker.runMigration", "migrations.worker.run_migration.unknown_key", map[string]interface{}{"key": key}, "", http.StatusInternalServerError)
 	}
@@ -55,6 +55,20 @@ type Logger struct {
 	consoleLevel zap.AtomicLevel
 	fileLevel    zap.AtomicLevel
 	logrLogger   *logr.Logger
+
+	// newCore builds the console and file cores of the logger with the given levels, so that
+	// WithMinLevel can rebuild them with lower ones.
+	newCore func(consoleLevel, fileLevel zapcore.LevelEnabler) zapcore.Core
+}
+
+// minLevelEnabler enables the levels enabled by level as well as min and above.
+type minLevelEnabler struct {
+	level zapcore.LevelEnabler
+	min   zapcore.Level
+}
+
+func (e minLevelEnabler) Enabled(level zapcore.Level) bool {
+	return level >= e.min || e.level.Enabled(level)
 }
 
 func getZapLevel(level string) zapcore.Level {
@@ -83,31 +97,36 @@ func makeEncoder(json bool) zapcore.Encoder {
 }
 
 func NewLogger(config *LoggerConfiguration) *Logger {
-	cores := []zapcore.Core{}
 	logger := &Logger{
 		consoleLevel: zap.NewAtomicLevelAt(getZapLevel(config.ConsoleLevel)),
 		fileLevel:    zap.NewAtomicLevelAt(getZapLevel(config.FileLevel)),
 	}
 
+	var consoleWriter, fileWriter zapcore.WriteSyncer
 	if config.EnableConsole {
-		writer := zapcore.Lock(os.Stderr)
-		core := zapcore.NewCore(makeEncoder(config.ConsoleJson), writer, logger.consoleLevel)
-		cores = append(cores, core)
+		consoleWriter = zapcore.Lock(os.Stderr)
 	}
 
 	if config.EnableFile {
-		writer := zapcore.AddSync(&lumberjack.Logger{
+		fileWriter = zapcore.AddSync(&lumberjack.Logger{
 			Filename: config.FileLocation,
 			MaxSize:  100,
 			Compress: true,
 		})
-		core := zapcore.NewCore(makeEncoder(config.FileJson), writer, logger.fileLevel)
-		cores = append(cores, core)
 	}
 
-	combinedCore := zapcore.NewTee(cores...)
+	logger.newCore = func(consoleLevel, fileLevel zapcore.LevelEnabler) zapcore.Core {
+		cores := []zapcore.Core{}
+		if consoleWriter != nil {
+			cores = append(cores, zapcore.NewCore(makeEncoder(config.ConsoleJson), consoleWriter, consoleLevel))
+		}
+		if fileWriter != nil {
+			cores = append(cores, zapcore.NewCore(makeEncoder(config.FileJson), fileWriter, fileLevel))
+		}
+		return zapcore.NewTee(cores...)
+	}
 
-	logger.zap = zap.New(combinedCore,
+	logger.zap = zap.New(logger.newCore(logger.consoleLevel, logger.fileLevel),
 		zap.AddCaller(),
 	)
 	return logger
@@ -150,6 +169,23 @@ func (l *Logger) StdLogWriter() io.Writer {
 	return &loggerWriter{f}
 }
 
+// WithMinLevel returns a logger also writing the messages of the given level and above to the
+// console and the file, whatever their levels, such as to debug the requests to a single endpoint.
+// The fields added by With are dropped, so it is to be called first. The advanced logging targets
+// keep their own levels.
+func (l *Logger) WithMinLevel(level string) *Logger {
+	if l.newCore == nil {
+		return l
+	}
+
+	minLevel := getZapLevel(level)
+	newlogger := *l
+	newlogger.zap = newlogger.zap.WithOptions(zap.WrapCore(func(zapcore.Core) zapcore.Core {
+		return l.newCore(minLevelEnabler{l.consoleLevel, minLevel}, minLevelEnabler{l.fileLevel, minLevel})
+	}))
+	return &newlogger
+}
+
 func (l *Logger) WithCallerSkip(skip int) *Logger {
 	newlogger := *l
 	newlogger.zap = newlogger.zap.WithOptions(zap.AddCallerSkip(skip))
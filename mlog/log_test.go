@@ -0,0 +1,33 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package mlog
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoggerWithMinLevel(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	logger := NewTestingLogger(t, buffer)
+	logger.SetConsoleLevel(LevelError)
+
+	debugLogger := logger.WithMinLevel(LevelDebug).With(String("request_id", "id"))
+
+	logger.Debug("filtered out")
+	debugLogger.Debug("lowered level")
+	assert.NotContains(t, buffer.String(), "filtered out")
+	assert.Contains(t, buffer.String(), "lowered level")
+	assert.Contains(t, buffer.String(), `"request_id":"id"`)
+
+	buffer.Reset()
+	logger.WithMinLevel(LevelWarn).Info("below the minimum level")
+	assert.Empty(t, buffer.String())
+
+	logger.SetConsoleLevel(LevelDebug)
+	logger.WithMinLevel(LevelError).Debug("console level still applies")
+	assert.Contains(t, buffer.String(), "console level still applies")
+}
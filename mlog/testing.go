@@ -34,9 +34,11 @@ func NewTestingLogger(tb testing.TB, writer io.Writer) *Logger {
 		fileLevel:    zap.NewAtomicLevelAt(getZapLevel("info")),
 	}
 
-	logWriterCore := zapcore.NewCore(makeEncoder(true), logWriterSync, testingLogger.consoleLevel)
+	testingLogger.newCore = func(consoleLevel, fileLevel zapcore.LevelEnabler) zapcore.Core {
+		return zapcore.NewCore(makeEncoder(true), logWriterSync, consoleLevel)
+	}
 
-	testingLogger.zap = zap.New(logWriterCore,
+	testingLogger.zap = zap.New(testingLogger.newCore(testingLogger.consoleLevel, testingLogger.fileLevel),
 		zap.AddCaller(),
 	)
 	return testingLogger
@@ -27,30 +27,36 @@ const (
 	CHANNEL_NAME_MAX_LENGTH        = 64
 	CHANNEL_HEADER_MAX_RUNES       = 1024
 	CHANNEL_PURPOSE_MAX_RUNES      = 250
+	CHANNEL_PROPS_MAX_LENGTH       = 4000
 	CHANNEL_CACHE_SIZE             = 25000
 
+	CHANNEL_PROPS_CHANNEL_MENTIONS        = "channel_mentions"
+	CHANNEL_PROPS_PIN_APPROVAL_REQUIRED   = "pin_approval_required"
+	CHANNEL_PROPS_INTEGRATIONS_RESTRICTED = "integrations_restricted"
+
 	CHANNEL_SORT_BY_USERNAME = "username"
 	CHANNEL_SORT_BY_STATUS   = "status"
 )
 
 type Channel struct {
-	Id               string                 `json:"id"`
-	CreateAt         int64                  `json:"create_at"`
-	UpdateAt         int64                  `json:"update_at"`
-	DeleteAt         int64                  `json:"delete_at"`
-	TeamId           string                 `json:"team_id"`
-	Type             string                 `json:"type"`
-	DisplayName      string                 `json:"display_name"`
-	Name             string                 `json:"name"`
-	Header           string                 `json:"header"`
-	Purpose          string                 `json:"purpose"`
-	LastPostAt       int64                  `json:"last_post_at"`
-	TotalMsgCount    int64                  `json:"total_msg_count"`
-	ExtraUpdateAt    int64                  `json:"extra_update_at"`
-	CreatorId        string                 `json:"creator_id"`
-	SchemeId         *string                `json:"scheme_id"`
-	Props            map[string]interface{} `json:"props" db:"-"`
-	GroupConstrained *bool                  `json:"group_constrained"`
+	Id                string                 `json:"id"`
+	CreateAt          int64                  `json:"create_at"`
+	UpdateAt          int64                  `json:"update_at"`
+	DeleteAt          int64                  `json:"delete_at"`
+	TeamId            string                 `json:"team_id"`
+	Type              string                 `json:"type"`
+	DisplayName       string                 `json:"display_name"`
+	Name              string                 `json:"name"`
+	Header            string                 `json:"header"`
+	Purpose           string                 `json:"purpose"`
+	LastPostAt        int64                  `json:"last_post_at"`
+	TotalMsgCount     int64                  `json:"total_msg_count"`
+	TotalMsgCountRoot int64                  `json:"total_msg_count_root"`
+	ExtraUpdateAt     int64                  `json:"extra_update_at"`
+	CreatorId         string                 `json:"creator_id"`
+	SchemeId          *string                `json:"scheme_id"`
+	Props             map[string]interface{} `json:"props"`
+	GroupConstrained  *bool                  `json:"group_constrained"`
 }
 
 type ChannelWithTeamData struct {
@@ -66,11 +72,13 @@ type ChannelsWithCount struct {
 }
 
 type ChannelPatch struct {
-	DisplayName      *string `json:"display_name"`
-	Name             *string `json:"name"`
-	Header           *string `json:"header"`
-	Purpose          *string `json:"purpose"`
-	GroupConstrained *bool   `json:"group_constrained"`
+	DisplayName            *string `json:"display_name"`
+	Name                   *string `json:"name"`
+	Header                 *string `json:"header"`
+	Purpose                *string `json:"purpose"`
+	GroupConstrained       *bool   `json:"group_constrained"`
+	PinApprovalRequired    *bool   `json:"pin_approval_required"`
+	IntegrationsRestricted *bool   `json:"integrations_restricted"`
 }
 
 type ChannelForExport struct {
@@ -118,7 +126,6 @@ type ChannelModeratedRolesPatch struct {
 // Paginate whether to paginate the results.
 // Page page requested, if results are paginated.
 // PerPage number of results per page, if paginated.
-//
 type ChannelSearchOpts struct {
 	NotAssociatedToGroup   string
 	ExcludeDefaultChannels bool
@@ -139,6 +146,12 @@ func (o *Channel) DeepCopy() *Channel {
 	if copy.SchemeId != nil {
 		copy.SchemeId = NewString(*o.SchemeId)
 	}
+	if o.Props != nil {
+		copy.Props = make(map[string]interface{}, len(o.Props))
+		for key, value := range o.Props {
+			copy.Props[key] = value
+		}
+	}
 	return &copy
 }
 
@@ -234,6 +247,10 @@ func (o *Channel) IsValid() *AppError {
 		return NewAppError("Channel.IsValid", "model.channel.is_valid.creator_id.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if len(StringInterfaceToJson(o.Props)) > CHANNEL_PROPS_MAX_LENGTH {
+		return NewAppError("Channel.IsValid", "model.channel.is_valid.props.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
 	userIds := strings.Split(o.Name, "__")
 	if o.Type != CHANNEL_DIRECT && len(userIds) == 2 && IsValidId(userIds[0]) && IsValidId(userIds[1]) {
 		return NewAppError("Channel.IsValid", "model.channel.is_valid.name.app_error", nil, "", http.StatusBadRequest)
@@ -259,6 +276,9 @@ func (o *Channel) PreUpdate() {
 	o.UpdateAt = GetMillis()
 	o.Name = SanitizeUnicode(o.Name)
 	o.DisplayName = SanitizeUnicode(o.DisplayName)
+
+	// Channel mentions are computed whenever the channel is read, so they must not be persisted.
+	delete(o.Props, CHANNEL_PROPS_CHANNEL_MENTIONS)
 }
 
 func (o *Channel) IsGroupOrDirect() bool {
@@ -289,6 +309,22 @@ func (o *Channel) Patch(patch *ChannelPatch) {
 	if patch.GroupConstrained != nil {
 		o.GroupConstrained = patch.GroupConstrained
 	}
+
+	if patch.PinApprovalRequired != nil {
+		if *patch.PinApprovalRequired {
+			o.AddProp(CHANNEL_PROPS_PIN_APPROVAL_REQUIRED, true)
+		} else {
+			delete(o.Props, CHANNEL_PROPS_PIN_APPROVAL_REQUIRED)
+		}
+	}
+
+	if patch.IntegrationsRestricted != nil {
+		if *patch.IntegrationsRestricted {
+			o.AddProp(CHANNEL_PROPS_INTEGRATIONS_RESTRICTED, true)
+		} else {
+			delete(o.Props, CHANNEL_PROPS_INTEGRATIONS_RESTRICTED)
+		}
+	}
 }
 
 func (o *Channel) MakeNonNil() {
@@ -307,6 +343,20 @@ func (o *Channel) IsGroupConstrained() bool {
 	return o.GroupConstrained != nil && *o.GroupConstrained
 }
 
+// IsPinApprovalRequired returns whether pinning posts in the channel has to be approved by a
+// channel admin.
+func (o *Channel) IsPinApprovalRequired() bool {
+	required, _ := o.Props[CHANNEL_PROPS_PIN_APPROVAL_REQUIRED].(bool)
+	return required
+}
+
+// IsIntegrationsRestricted returns whether only the bots, incoming webhooks and slash commands
+// allowed in the channel may post or be executed there.
+func (o *Channel) IsIntegrationsRestricted() bool {
+	restricted, _ := o.Props[CHANNEL_PROPS_INTEGRATIONS_RESTRICTED].(bool)
+	return restricted
+}
+
 func (o *Channel) GetOtherUserIdForDM(userId string) string {
 	if o.Type != CHANNEL_DIRECT {
 		return ""
@@ -0,0 +1,190 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"unicode/utf8"
+)
+
+type ChannelBookmarkType string
+
+const (
+	CHANNEL_BOOKMARK_LINK ChannelBookmarkType = "link"
+	CHANNEL_BOOKMARK_FILE ChannelBookmarkType = "file"
+
+	CHANNEL_BOOKMARK_DISPLAY_NAME_MAX_RUNES = 64
+	CHANNEL_BOOKMARK_URL_MAX_LENGTH         = 1024
+	CHANNEL_BOOKMARK_EMOJI_MAX_LENGTH       = 64
+	CHANNEL_BOOKMARKS_PER_CHANNEL_MAX       = 50
+)
+
+// ChannelBookmark is a link or file pinned to the top of a channel.
+type ChannelBookmark struct {
+	Id          string              `json:"id"`
+	CreateAt    int64               `json:"create_at"`
+	UpdateAt    int64               `json:"update_at"`
+	DeleteAt    int64               `json:"delete_at"`
+	ChannelId   string              `json:"channel_id"`
+	OwnerId     string              `json:"owner_id"`
+	FileId      string              `json:"file_id"`
+	DisplayName string              `json:"display_name"`
+	SortOrder   int64               `json:"sort_order"`
+	LinkUrl     string              `json:"link_url,omitempty"`
+	ImageUrl    string              `json:"image_url,omitempty"`
+	Emoji       string              `json:"emoji,omitempty"`
+	Type        ChannelBookmarkType `json:"type"`
+}
+
+// ChannelBookmarkPatch is a description of what fields to update on an existing bookmark.
+type ChannelBookmarkPatch struct {
+	FileId      *string `json:"file_id"`
+	DisplayName *string `json:"display_name"`
+	SortOrder   *int64  `json:"sort_order"`
+	LinkUrl     *string `json:"link_url"`
+	ImageUrl    *string `json:"image_url"`
+	Emoji       *string `json:"emoji"`
+}
+
+// Clone returns a shallow copy of the bookmark.
+func (o *ChannelBookmark) Clone() *ChannelBookmark {
+	bCopy := *o
+	return &bCopy
+}
+
+// IsValid validates the bookmark and returns an error if it isn't configured correctly.
+func (o *ChannelBookmark) IsValid() *AppError {
+	if !IsValidId(o.Id) {
+		return NewAppError("ChannelBookmark.IsValid", "model.channel_bookmark.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("ChannelBookmark.IsValid", "model.channel_bookmark.is_valid.create_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.UpdateAt == 0 {
+		return NewAppError("ChannelBookmark.IsValid", "model.channel_bookmark.is_valid.update_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.ChannelId) {
+		return NewAppError("ChannelBookmark.IsValid", "model.channel_bookmark.is_valid.channel_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.OwnerId) {
+		return NewAppError("ChannelBookmark.IsValid", "model.channel_bookmark.is_valid.owner_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.DisplayName == "" || utf8.RuneCountInString(o.DisplayName) > CHANNEL_BOOKMARK_DISPLAY_NAME_MAX_RUNES {
+		return NewAppError("ChannelBookmark.IsValid", "model.channel_bookmark.is_valid.display_name.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.ImageUrl != "" && (len(o.ImageUrl) > CHANNEL_BOOKMARK_URL_MAX_LENGTH || !IsValidHttpUrl(o.ImageUrl)) {
+		return NewAppError("ChannelBookmark.IsValid", "model.channel_bookmark.is_valid.image_url.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if len(o.Emoji) > CHANNEL_BOOKMARK_EMOJI_MAX_LENGTH {
+		return NewAppError("ChannelBookmark.IsValid", "model.channel_bookmark.is_valid.emoji.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	switch o.Type {
+	case CHANNEL_BOOKMARK_LINK:
+		if o.LinkUrl == "" || len(o.LinkUrl) > CHANNEL_BOOKMARK_URL_MAX_LENGTH || !IsValidHttpUrl(o.LinkUrl) {
+			return NewAppError("ChannelBookmark.IsValid", "model.channel_bookmark.is_valid.link_url.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+		}
+
+		if o.FileId != "" {
+			return NewAppError("ChannelBookmark.IsValid", "model.channel_bookmark.is_valid.file_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+		}
+	case CHANNEL_BOOKMARK_FILE:
+		if !IsValidId(o.FileId) {
+			return NewAppError("ChannelBookmark.IsValid", "model.channel_bookmark.is_valid.file_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+		}
+
+		if o.LinkUrl != "" {
+			return NewAppError("ChannelBookmark.IsValid", "model.channel_bookmark.is_valid.link_url.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+		}
+	default:
+		return NewAppError("ChannelBookmark.IsValid", "model.channel_bookmark.is_valid.type.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// PreSave should be run before saving a new bookmark to the database.
+func (o *ChannelBookmark) PreSave() {
+	if o.Id == "" {
+		o.Id = NewId()
+	}
+
+	o.CreateAt = GetMillis()
+	o.UpdateAt = o.CreateAt
+	o.DeleteAt = 0
+}
+
+// PreUpdate should be run before saving an updated bookmark to the database.
+func (o *ChannelBookmark) PreUpdate() {
+	o.UpdateAt = GetMillis()
+}
+
+// Patch modifies an existing bookmark with optional fields from the given patch.
+func (o *ChannelBookmark) Patch(patch *ChannelBookmarkPatch) {
+	if patch.FileId != nil {
+		o.FileId = *patch.FileId
+	}
+
+	if patch.DisplayName != nil {
+		o.DisplayName = *patch.DisplayName
+	}
+
+	if patch.SortOrder != nil {
+		o.SortOrder = *patch.SortOrder
+	}
+
+	if patch.LinkUrl != nil {
+		o.LinkUrl = *patch.LinkUrl
+	}
+
+	if patch.ImageUrl != nil {
+		o.ImageUrl = *patch.ImageUrl
+	}
+
+	if patch.Emoji != nil {
+		o.Emoji = *patch.Emoji
+	}
+}
+
+func (o *ChannelBookmark) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func ChannelBookmarkFromJson(data io.Reader) *ChannelBookmark {
+	var o *ChannelBookmark
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
+
+func (o *ChannelBookmarkPatch) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func ChannelBookmarkPatchFromJson(data io.Reader) *ChannelBookmarkPatch {
+	var o *ChannelBookmarkPatch
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
+
+func ChannelBookmarkListToJson(l []*ChannelBookmark) string {
+	b, _ := json.Marshal(l)
+	return string(b)
+}
+
+func ChannelBookmarkListFromJson(data io.Reader) []*ChannelBookmark {
+	var o []*ChannelBookmark
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
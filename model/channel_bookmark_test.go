@@ -0,0 +1,104 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelBookmarkJson(t *testing.T) {
+	o := &ChannelBookmark{
+		Id:          NewId(),
+		ChannelId:   NewId(),
+		OwnerId:     NewId(),
+		DisplayName: "docs",
+		LinkUrl:     "https://mattermost.com",
+		Type:        CHANNEL_BOOKMARK_LINK,
+	}
+
+	ro := ChannelBookmarkFromJson(strings.NewReader(o.ToJson()))
+	require.Equal(t, o, ro)
+
+	l := ChannelBookmarkListFromJson(strings.NewReader(ChannelBookmarkListToJson([]*ChannelBookmark{o})))
+	require.Len(t, l, 1)
+	require.Equal(t, o, l[0])
+}
+
+func TestChannelBookmarkIsValid(t *testing.T) {
+	validLink := func() *ChannelBookmark {
+		o := &ChannelBookmark{
+			ChannelId:   NewId(),
+			OwnerId:     NewId(),
+			DisplayName: "docs",
+			LinkUrl:     "https://mattermost.com",
+			Type:        CHANNEL_BOOKMARK_LINK,
+		}
+		o.PreSave()
+		return o
+	}
+
+	testCases := []struct {
+		Description string
+		Modify      func(o *ChannelBookmark)
+		Valid       bool
+	}{
+		{"valid link", func(o *ChannelBookmark) {}, true},
+		{"valid file", func(o *ChannelBookmark) {
+			o.Type = CHANNEL_BOOKMARK_FILE
+			o.LinkUrl = ""
+			o.FileId = NewId()
+		}, true},
+		{"invalid id", func(o *ChannelBookmark) { o.Id = "junk" }, false},
+		{"missing create at", func(o *ChannelBookmark) { o.CreateAt = 0 }, false},
+		{"missing update at", func(o *ChannelBookmark) { o.UpdateAt = 0 }, false},
+		{"invalid channel id", func(o *ChannelBookmark) { o.ChannelId = "junk" }, false},
+		{"invalid owner id", func(o *ChannelBookmark) { o.OwnerId = "" }, false},
+		{"empty display name", func(o *ChannelBookmark) { o.DisplayName = "" }, false},
+		{"long display name", func(o *ChannelBookmark) {
+			o.DisplayName = strings.Repeat("a", CHANNEL_BOOKMARK_DISPLAY_NAME_MAX_RUNES+1)
+		}, false},
+		{"invalid link url", func(o *ChannelBookmark) { o.LinkUrl = "not a url" }, false},
+		{"link with file id", func(o *ChannelBookmark) { o.FileId = NewId() }, false},
+		{"file without file id", func(o *ChannelBookmark) {
+			o.Type = CHANNEL_BOOKMARK_FILE
+			o.LinkUrl = ""
+		}, false},
+		{"invalid image url", func(o *ChannelBookmark) { o.ImageUrl = "junk" }, false},
+		{"long emoji", func(o *ChannelBookmark) { o.Emoji = strings.Repeat("a", CHANNEL_BOOKMARK_EMOJI_MAX_LENGTH+1) }, false},
+		{"unknown type", func(o *ChannelBookmark) { o.Type = "other" }, false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Description, func(t *testing.T) {
+			o := validLink()
+			testCase.Modify(o)
+			if testCase.Valid {
+				assert.Nil(t, o.IsValid())
+			} else {
+				assert.NotNil(t, o.IsValid())
+			}
+		})
+	}
+}
+
+func TestChannelBookmarkPatch(t *testing.T) {
+	o := &ChannelBookmark{
+		DisplayName: "docs",
+		SortOrder:   1,
+		Emoji:       "smile",
+	}
+
+	o.Patch(&ChannelBookmarkPatch{
+		DisplayName: NewString("handbook"),
+		SortOrder:   NewInt64(3),
+	})
+
+	assert.Equal(t, "handbook", o.DisplayName)
+	assert.Equal(t, int64(3), o.SortOrder)
+	assert.Equal(t, "smile", o.Emoji)
+}
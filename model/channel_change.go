@@ -0,0 +1,97 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+)
+
+const (
+	CHANNEL_CHANGE_POST_CREATED = "post_created"
+	CHANNEL_CHANGE_POST_EDITED  = "post_edited"
+	CHANNEL_CHANGE_POST_UPDATED = "post_updated"
+	CHANNEL_CHANGE_POST_DELETED = "post_deleted"
+
+	CHANNEL_CHANGES_MAX = 1000
+)
+
+// ChannelChange is an entry of the change log of a channel. Every post mutation is given the next
+// sequence number of its channel, so that clients sync by sequence rather than by comparing
+// timestamps that are subject to clock skew.
+type ChannelChange struct {
+	ChannelId string `json:"channel_id"`
+	Seq       int64  `json:"seq"`
+	PostId    string `json:"post_id"`
+	Type      string `json:"type"`
+	CreateAt  int64  `json:"create_at"`
+}
+
+// ChannelChanges lists the changes to a channel past a sequence number, keeping only the latest
+// change of each post. Posts holds the current state of the posts that were not deleted.
+type ChannelChanges struct {
+	Changes []*ChannelChange `json:"changes"`
+	Posts   map[string]*Post `json:"posts"`
+	LastSeq int64            `json:"last_seq"`
+	HasMore bool             `json:"has_more"`
+}
+
+func NewChannelChanges(sinceSeq int64) *ChannelChanges {
+	return &ChannelChanges{
+		Changes: []*ChannelChange{},
+		Posts:   map[string]*Post{},
+		LastSeq: sinceSeq,
+	}
+}
+
+// AddChanges appends the changes, ordered by sequence, replacing any earlier change of the same
+// post and advancing LastSeq.
+func (o *ChannelChanges) AddChanges(changes []*ChannelChange) {
+	latest := make(map[string]int, len(o.Changes))
+	for i, change := range o.Changes {
+		latest[change.PostId] = i
+	}
+
+	for _, change := range changes {
+		if i, ok := latest[change.PostId]; ok {
+			o.Changes[i] = nil
+		}
+		latest[change.PostId] = len(o.Changes)
+		o.Changes = append(o.Changes, change)
+
+		if change.Seq > o.LastSeq {
+			o.LastSeq = change.Seq
+		}
+	}
+
+	compacted := o.Changes[:0]
+	for _, change := range o.Changes {
+		if change != nil {
+			compacted = append(compacted, change)
+		}
+	}
+	o.Changes = compacted
+}
+
+// PostIds returns the ids of the posts whose current state is to be sent along with the changes.
+func (o *ChannelChanges) PostIds() []string {
+	ids := []string{}
+	for _, change := range o.Changes {
+		if change.Type != CHANNEL_CHANGE_POST_DELETED {
+			ids = append(ids, change.PostId)
+		}
+	}
+	return ids
+}
+
+func (o *ChannelChanges) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func ChannelChangesFromJson(data io.Reader) *ChannelChanges {
+	var o *ChannelChanges
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
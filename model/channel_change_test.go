@@ -0,0 +1,58 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelChangesAddChanges(t *testing.T) {
+	postId1 := NewId()
+	postId2 := NewId()
+	postId3 := NewId()
+
+	changes := NewChannelChanges(4)
+	assert.Equal(t, int64(4), changes.LastSeq)
+
+	changes.AddChanges([]*ChannelChange{
+		{Seq: 5, PostId: postId1, Type: CHANNEL_CHANGE_POST_CREATED},
+		{Seq: 6, PostId: postId2, Type: CHANNEL_CHANGE_POST_CREATED},
+		{Seq: 7, PostId: postId1, Type: CHANNEL_CHANGE_POST_EDITED},
+	})
+	changes.AddChanges([]*ChannelChange{
+		{Seq: 8, PostId: postId3, Type: CHANNEL_CHANGE_POST_CREATED},
+		{Seq: 9, PostId: postId2, Type: CHANNEL_CHANGE_POST_DELETED},
+	})
+
+	require.Len(t, changes.Changes, 3)
+	assert.Equal(t, int64(7), changes.Changes[0].Seq)
+	assert.Equal(t, postId1, changes.Changes[0].PostId)
+	assert.Equal(t, int64(8), changes.Changes[1].Seq)
+	assert.Equal(t, postId3, changes.Changes[1].PostId)
+	assert.Equal(t, int64(9), changes.Changes[2].Seq)
+	assert.Equal(t, postId2, changes.Changes[2].PostId)
+	assert.Equal(t, int64(9), changes.LastSeq)
+
+	assert.Equal(t, []string{postId1, postId3}, changes.PostIds())
+}
+
+func TestChannelChangesJson(t *testing.T) {
+	post := &Post{Id: NewId(), Message: "message", ChangeSeq: 3}
+	changes := NewChannelChanges(2)
+	changes.AddChanges([]*ChannelChange{{ChannelId: NewId(), Seq: 3, PostId: post.Id, Type: CHANNEL_CHANGE_POST_CREATED}})
+	changes.Posts[post.Id] = post
+	changes.HasMore = true
+
+	result := ChannelChangesFromJson(strings.NewReader(changes.ToJson()))
+	require.NotNil(t, result)
+	assert.Equal(t, changes.Changes, result.Changes)
+	assert.Equal(t, int64(3), result.LastSeq)
+	assert.True(t, result.HasMore)
+	require.Contains(t, result.Posts, post.Id)
+	assert.Equal(t, int64(3), result.Posts[post.Id].ChangeSeq)
+}
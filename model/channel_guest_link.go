@@ -0,0 +1,128 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+const (
+	CHANNEL_GUEST_LINK_TOKEN_SIZE                 = 48
+	CHANNEL_GUEST_LINK_ALLOWED_DOMAINS_MAX_LENGTH = 500
+	CHANNEL_GUEST_LINKS_PER_CHANNEL_MAX           = 50
+)
+
+// ChannelGuestLink is a link creating guest accounts limited to a single channel, optionally limited
+// in time, in number of uses and to email addresses of some domains.
+type ChannelGuestLink struct {
+	Id        string `json:"id"`
+	ChannelId string `json:"channel_id"`
+	TeamId    string `json:"team_id"`
+	CreatorId string `json:"creator_id"`
+	CreateAt  int64  `json:"create_at"`
+	UpdateAt  int64  `json:"update_at"`
+	DeleteAt  int64  `json:"delete_at"`
+	// Token is the secret part of the link, given when signing up through it.
+	Token string `json:"token"`
+	// ExpiresAt is the time past which the link can't be used, or 0 if it never expires.
+	ExpiresAt int64 `json:"expires_at"`
+	// MaxUses is the number of guests that can sign up through the link, or 0 if unlimited.
+	MaxUses  int64 `json:"max_uses"`
+	UseCount int64 `json:"use_count"`
+	// AllowedDomains is a space or comma separated list of the email domains guests signing up
+	// through the link must have, or empty to allow any domain.
+	AllowedDomains string `json:"allowed_domains"`
+}
+
+func (o *ChannelGuestLink) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func ChannelGuestLinkFromJson(data io.Reader) *ChannelGuestLink {
+	var o *ChannelGuestLink
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
+
+func ChannelGuestLinkListToJson(l []*ChannelGuestLink) string {
+	b, _ := json.Marshal(l)
+	return string(b)
+}
+
+func ChannelGuestLinkListFromJson(data io.Reader) []*ChannelGuestLink {
+	var o []*ChannelGuestLink
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
+
+func (o *ChannelGuestLink) IsValid() *AppError {
+	if !IsValidId(o.Id) {
+		return NewAppError("ChannelGuestLink.IsValid", "model.channel_guest_link.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.ChannelId) {
+		return NewAppError("ChannelGuestLink.IsValid", "model.channel_guest_link.is_valid.channel_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.TeamId) {
+		return NewAppError("ChannelGuestLink.IsValid", "model.channel_guest_link.is_valid.team_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.CreatorId) {
+		return NewAppError("ChannelGuestLink.IsValid", "model.channel_guest_link.is_valid.creator_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("ChannelGuestLink.IsValid", "model.channel_guest_link.is_valid.create_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.UpdateAt == 0 {
+		return NewAppError("ChannelGuestLink.IsValid", "model.channel_guest_link.is_valid.update_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if len(o.Token) != CHANNEL_GUEST_LINK_TOKEN_SIZE || !IsValidAlphaNum(o.Token) {
+		return NewAppError("ChannelGuestLink.IsValid", "model.channel_guest_link.is_valid.token.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.ExpiresAt < 0 {
+		return NewAppError("ChannelGuestLink.IsValid", "model.channel_guest_link.is_valid.expires_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.MaxUses < 0 {
+		return NewAppError("ChannelGuestLink.IsValid", "model.channel_guest_link.is_valid.max_uses.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if len(o.AllowedDomains) > CHANNEL_GUEST_LINK_ALLOWED_DOMAINS_MAX_LENGTH {
+		return NewAppError("ChannelGuestLink.IsValid", "model.channel_guest_link.is_valid.allowed_domains.app_error", map[string]interface{}{"Max": CHANNEL_GUEST_LINK_ALLOWED_DOMAINS_MAX_LENGTH}, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (o *ChannelGuestLink) PreSave() {
+	if o.Id == "" {
+		o.Id = NewId()
+	}
+
+	if o.Token == "" {
+		o.Token = NewRandomString(CHANNEL_GUEST_LINK_TOKEN_SIZE)
+	}
+
+	o.CreateAt = GetMillis()
+	o.UpdateAt = o.CreateAt
+	o.UseCount = 0
+}
+
+// IsExpired reports whether the link can no longer be used at the given time.
+func (o *ChannelGuestLink) IsExpired(now int64) bool {
+	return o.ExpiresAt != 0 && now >= o.ExpiresAt
+}
+
+// IsExhausted reports whether as many guests as allowed have signed up through the link.
+func (o *ChannelGuestLink) IsExhausted() bool {
+	return o.MaxUses != 0 && o.UseCount >= o.MaxUses
+}
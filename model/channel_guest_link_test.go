@@ -0,0 +1,66 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelGuestLinkJson(t *testing.T) {
+	o := ChannelGuestLink{Id: NewId(), ChannelId: NewId(), Token: NewRandomString(CHANNEL_GUEST_LINK_TOKEN_SIZE), AllowedDomains: "example.com"}
+	ro := ChannelGuestLinkFromJson(strings.NewReader(o.ToJson()))
+
+	require.NotNil(t, ro)
+	assert.Equal(t, o, *ro)
+}
+
+func TestChannelGuestLinkIsValid(t *testing.T) {
+	o := ChannelGuestLink{ChannelId: NewId(), TeamId: NewId(), CreatorId: NewId()}
+	assert.NotNil(t, o.IsValid(), "empty declaration should be invalid")
+
+	o.PreSave()
+	assert.Len(t, o.Token, CHANNEL_GUEST_LINK_TOKEN_SIZE)
+	assert.Nil(t, o.IsValid())
+
+	o.Token = "short"
+	assert.NotNil(t, o.IsValid())
+
+	o.Token = strings.Repeat("-", CHANNEL_GUEST_LINK_TOKEN_SIZE)
+	assert.NotNil(t, o.IsValid())
+
+	o.Token = NewRandomString(CHANNEL_GUEST_LINK_TOKEN_SIZE)
+	o.ExpiresAt = -1
+	assert.NotNil(t, o.IsValid())
+
+	o.ExpiresAt = GetMillis()
+	o.MaxUses = -1
+	assert.NotNil(t, o.IsValid())
+
+	o.MaxUses = 10
+	o.AllowedDomains = strings.Repeat("a", CHANNEL_GUEST_LINK_ALLOWED_DOMAINS_MAX_LENGTH+1)
+	assert.NotNil(t, o.IsValid())
+
+	o.AllowedDomains = "example.com, example.org"
+	assert.Nil(t, o.IsValid())
+}
+
+func TestChannelGuestLinkUsability(t *testing.T) {
+	o := ChannelGuestLink{}
+	assert.False(t, o.IsExpired(GetMillis()))
+	assert.False(t, o.IsExhausted())
+
+	o.ExpiresAt = 1000
+	assert.False(t, o.IsExpired(999))
+	assert.True(t, o.IsExpired(1000))
+
+	o.MaxUses = 1
+	assert.False(t, o.IsExhausted())
+
+	o.UseCount = 1
+	assert.True(t, o.IsExhausted())
+}
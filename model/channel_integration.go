@@ -0,0 +1,80 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+const (
+	CHANNEL_INTEGRATION_TYPE_BOT              = "bot"
+	CHANNEL_INTEGRATION_TYPE_INCOMING_WEBHOOK = "incoming_webhook"
+	CHANNEL_INTEGRATION_TYPE_COMMAND          = "command"
+)
+
+// ChannelIntegration allows a bot, an incoming webhook or a slash command to post or to be executed
+// in a channel restricting its integrations.
+type ChannelIntegration struct {
+	ChannelId     string `json:"channel_id"`
+	IntegrationId string `json:"integration_id"`
+	Type          string `json:"type"`
+	CreatorId     string `json:"creator_id"`
+	CreateAt      int64  `json:"create_at"`
+}
+
+func (o *ChannelIntegration) IsValid() *AppError {
+	if !IsValidId(o.ChannelId) {
+		return NewAppError("ChannelIntegration.IsValid", "model.channel_integration.is_valid.channel_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.IntegrationId) {
+		return NewAppError("ChannelIntegration.IsValid", "model.channel_integration.is_valid.integration_id.app_error", nil, "channel_id="+o.ChannelId, http.StatusBadRequest)
+	}
+
+	switch o.Type {
+	case CHANNEL_INTEGRATION_TYPE_BOT, CHANNEL_INTEGRATION_TYPE_INCOMING_WEBHOOK, CHANNEL_INTEGRATION_TYPE_COMMAND:
+	default:
+		return NewAppError("ChannelIntegration.IsValid", "model.channel_integration.is_valid.type.app_error", nil, "channel_id="+o.ChannelId, http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.CreatorId) {
+		return NewAppError("ChannelIntegration.IsValid", "model.channel_integration.is_valid.creator_id.app_error", nil, "channel_id="+o.ChannelId, http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("ChannelIntegration.IsValid", "model.channel_integration.is_valid.create_at.app_error", nil, "channel_id="+o.ChannelId, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (o *ChannelIntegration) PreSave() {
+	if o.CreateAt == 0 {
+		o.CreateAt = GetMillis()
+	}
+}
+
+func (o *ChannelIntegration) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func ChannelIntegrationFromJson(data io.Reader) *ChannelIntegration {
+	var o *ChannelIntegration
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
+
+func ChannelIntegrationListToJson(l []*ChannelIntegration) string {
+	b, _ := json.Marshal(l)
+	return string(b)
+}
+
+func ChannelIntegrationListFromJson(data io.Reader) []*ChannelIntegration {
+	var o []*ChannelIntegration
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
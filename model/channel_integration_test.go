@@ -0,0 +1,70 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelIntegrationJson(t *testing.T) {
+	o := &ChannelIntegration{
+		ChannelId:     NewId(),
+		IntegrationId: NewId(),
+		Type:          CHANNEL_INTEGRATION_TYPE_INCOMING_WEBHOOK,
+		CreatorId:     NewId(),
+		CreateAt:      GetMillis(),
+	}
+
+	ro := ChannelIntegrationFromJson(strings.NewReader(o.ToJson()))
+	require.Equal(t, o, ro)
+
+	l := ChannelIntegrationListFromJson(strings.NewReader(ChannelIntegrationListToJson([]*ChannelIntegration{o})))
+	require.Len(t, l, 1)
+	require.Equal(t, o, l[0])
+}
+
+func TestChannelIntegrationIsValid(t *testing.T) {
+	valid := func() *ChannelIntegration {
+		o := &ChannelIntegration{
+			ChannelId:     NewId(),
+			IntegrationId: NewId(),
+			Type:          CHANNEL_INTEGRATION_TYPE_BOT,
+			CreatorId:     NewId(),
+		}
+		o.PreSave()
+		return o
+	}
+
+	testCases := []struct {
+		Description string
+		Modify      func(o *ChannelIntegration)
+		Valid       bool
+	}{
+		{"valid bot", func(o *ChannelIntegration) {}, true},
+		{"valid incoming webhook", func(o *ChannelIntegration) { o.Type = CHANNEL_INTEGRATION_TYPE_INCOMING_WEBHOOK }, true},
+		{"valid command", func(o *ChannelIntegration) { o.Type = CHANNEL_INTEGRATION_TYPE_COMMAND }, true},
+		{"invalid channel id", func(o *ChannelIntegration) { o.ChannelId = "invalid" }, false},
+		{"invalid integration id", func(o *ChannelIntegration) { o.IntegrationId = "" }, false},
+		{"invalid type", func(o *ChannelIntegration) { o.Type = "outgoing_webhook" }, false},
+		{"invalid creator id", func(o *ChannelIntegration) { o.CreatorId = "invalid" }, false},
+		{"missing create at", func(o *ChannelIntegration) { o.CreateAt = 0 }, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			o := valid()
+			tc.Modify(o)
+
+			if tc.Valid {
+				assert.Nil(t, o.IsValid())
+			} else {
+				assert.NotNil(t, o.IsValid())
+			}
+		})
+	}
+}
@@ -24,11 +24,13 @@ const (
 )
 
 type ChannelUnread struct {
-	TeamId       string    `json:"team_id"`
-	ChannelId    string    `json:"channel_id"`
-	MsgCount     int64     `json:"msg_count"`
-	MentionCount int64     `json:"mention_count"`
-	NotifyProps  StringMap `json:"-"`
+	TeamId             string    `json:"team_id"`
+	ChannelId          string    `json:"channel_id"`
+	MsgCount           int64     `json:"msg_count"`
+	MentionCount       int64     `json:"mention_count"`
+	MsgCountRoot       int64     `json:"msg_count_root"`
+	UrgentMentionCount int64     `json:"urgent_mention_count"`
+	NotifyProps        StringMap `json:"-"`
 }
 
 type ChannelUnreadAt struct {
@@ -42,18 +44,21 @@ type ChannelUnreadAt struct {
 }
 
 type ChannelMember struct {
-	ChannelId     string    `json:"channel_id"`
-	UserId        string    `json:"user_id"`
-	Roles         string    `json:"roles"`
-	LastViewedAt  int64     `json:"last_viewed_at"`
-	MsgCount      int64     `json:"msg_count"`
-	MentionCount  int64     `json:"mention_count"`
-	NotifyProps   StringMap `json:"notify_props"`
-	LastUpdateAt  int64     `json:"last_update_at"`
-	SchemeGuest   bool      `json:"scheme_guest"`
-	SchemeUser    bool      `json:"scheme_user"`
-	SchemeAdmin   bool      `json:"scheme_admin"`
-	ExplicitRoles string    `json:"explicit_roles"`
+	ChannelId              string    `json:"channel_id"`
+	UserId                 string    `json:"user_id"`
+	Roles                  string    `json:"roles"`
+	LastViewedAt           int64     `json:"last_viewed_at"`
+	MsgCount               int64     `json:"msg_count"`
+	MentionCount           int64     `json:"mention_count"`
+	MsgCountRoot           int64     `json:"msg_count_root"`
+	UrgentMentionCount     int64     `json:"urgent_mention_count"`
+	NotifyProps            StringMap `json:"notify_props"`
+	LastUpdateAt           int64     `json:"last_update_at"`
+	SchemeGuest            bool      `json:"scheme_guest"`
+	SchemeUser             bool      `json:"scheme_user"`
+	SchemeAdmin            bool      `json:"scheme_admin"`
+	ExplicitRoles          string    `json:"explicit_roles"`
+	ExplicitRolesExpiresAt int64     `json:"explicit_roles_expires_at,omitempty"`
 }
 
 type ChannelMembers []ChannelMember
@@ -160,7 +165,11 @@ func (o *ChannelMember) PreUpdate() {
 	o.LastUpdateAt = GetMillis()
 }
 
+// GetRoles returns the roles of the member, leaving out the explicit roles once they have expired.
 func (o *ChannelMember) GetRoles() []string {
+	if IsRoleGrantExpired(o.ExplicitRolesExpiresAt, GetMillis()) {
+		return RemoveRoles(strings.Fields(o.Roles), strings.Fields(o.ExplicitRoles))
+	}
 	return strings.Fields(o.Roles)
 }
 
@@ -0,0 +1,79 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+)
+
+const (
+	CHANNEL_MEMBERS_SORT_LAST_VIEWED_AT = "last_viewed_at"
+	CHANNEL_MEMBERS_SORT_UNREAD         = "unread"
+)
+
+// ChannelMembersCursor is the position reached while paging through the channel memberships of a
+// user. Memberships are returned most recently viewed first, preceded by the unread ones when
+// sorting by unread state, with ChannelId breaking ties.
+type ChannelMembersCursor struct {
+	SortBy       string `json:"sort_by"`
+	Unread       bool   `json:"unread"`
+	LastViewedAt int64  `json:"last_viewed_at"`
+	ChannelId    string `json:"channel_id"`
+}
+
+// ChannelMembersPage is a page of the channel memberships of a user, holding the token of the next
+// page if there is one.
+type ChannelMembersPage struct {
+	Members   ChannelMembers `json:"members"`
+	HasMore   bool           `json:"has_more"`
+	NextToken string         `json:"next_token"`
+}
+
+// IsValidChannelMembersSort reports whether sortBy is one of the orders memberships can be paged in.
+func IsValidChannelMembersSort(sortBy string) bool {
+	return sortBy == CHANNEL_MEMBERS_SORT_LAST_VIEWED_AT || sortBy == CHANNEL_MEMBERS_SORT_UNREAD
+}
+
+// IsStart reports whether the cursor points before the first membership.
+func (c ChannelMembersCursor) IsStart() bool {
+	return c.ChannelId == ""
+}
+
+// ToToken encodes the cursor as the opaque token handed out to clients.
+func (c ChannelMembersCursor) ToToken() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// ChannelMembersCursorFromToken decodes a token built by ToToken, returning nil if it is malformed.
+func ChannelMembersCursorFromToken(token string) *ChannelMembersCursor {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil
+	}
+
+	var cursor ChannelMembersCursor
+	if err := json.Unmarshal(b, &cursor); err != nil {
+		return nil
+	}
+
+	if !IsValidChannelMembersSort(cursor.SortBy) || cursor.LastViewedAt < 0 || !IsValidId(cursor.ChannelId) {
+		return nil
+	}
+
+	return &cursor
+}
+
+func (o *ChannelMembersPage) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func ChannelMembersPageFromJson(data io.Reader) *ChannelMembersPage {
+	var o *ChannelMembersPage
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelMembersCursorToken(t *testing.T) {
+	cursor := ChannelMembersCursor{SortBy: CHANNEL_MEMBERS_SORT_UNREAD, Unread: true, LastViewedAt: 1000, ChannelId: NewId()}
+
+	decoded := ChannelMembersCursorFromToken(cursor.ToToken())
+	require.NotNil(t, decoded)
+	assert.Equal(t, cursor, *decoded)
+	assert.False(t, decoded.IsStart())
+
+	for _, invalid := range []ChannelMembersCursor{
+		{SortBy: "junk", LastViewedAt: 1000, ChannelId: cursor.ChannelId},
+		{SortBy: CHANNEL_MEMBERS_SORT_LAST_VIEWED_AT, LastViewedAt: -1, ChannelId: cursor.ChannelId},
+		{SortBy: CHANNEL_MEMBERS_SORT_LAST_VIEWED_AT, LastViewedAt: 1000},
+		{SortBy: CHANNEL_MEMBERS_SORT_LAST_VIEWED_AT, LastViewedAt: 1000, ChannelId: "invalid"},
+	} {
+		assert.Nil(t, ChannelMembersCursorFromToken(invalid.ToToken()))
+	}
+
+	assert.Nil(t, ChannelMembersCursorFromToken("!"))
+	assert.Nil(t, ChannelMembersCursorFromToken(""))
+}
+
+func TestChannelMembersPageJson(t *testing.T) {
+	page := &ChannelMembersPage{
+		Members:   ChannelMembers{{ChannelId: NewId(), UserId: NewId(), LastViewedAt: 1000}},
+		HasMore:   true,
+		NextToken: "token",
+	}
+
+	result := ChannelMembersPageFromJson(strings.NewReader(page.ToJson()))
+	require.NotNil(t, result)
+	assert.Equal(t, page, result)
+}
@@ -48,6 +48,17 @@ func TestChannelMemberIsValid(t *testing.T) {
 	require.Error(t, o.IsValid(), "should be invalid")
 }
 
+func TestChannelMemberGetRoles(t *testing.T) {
+	o := ChannelMember{Roles: "channel_user channel_admin custom_role", ExplicitRoles: "custom_role"}
+	require.Equal(t, []string{"channel_user", "channel_admin", "custom_role"}, o.GetRoles())
+
+	o.ExplicitRolesExpiresAt = GetMillis() + 60000
+	require.Equal(t, []string{"channel_user", "channel_admin", "custom_role"}, o.GetRoles())
+
+	o.ExplicitRolesExpiresAt = GetMillis() - 1
+	require.Equal(t, []string{"channel_user", "channel_admin"}, o.GetRoles())
+}
+
 func TestChannelUnreadJson(t *testing.T) {
 	o := ChannelUnread{ChannelId: NewId(), TeamId: NewId(), MsgCount: 5, MentionCount: 3}
 	json := o.ToJson()
@@ -0,0 +1,53 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+)
+
+const (
+	CHANNEL_SNAPSHOT_LINE_TYPE_CHANNEL = "channel"
+	CHANNEL_SNAPSHOT_LINE_TYPE_MEMBER  = "member"
+	CHANNEL_SNAPSHOT_LINE_TYPE_POST    = "post"
+	CHANNEL_SNAPSHOT_LINE_TYPE_FILE    = "file"
+)
+
+// ChannelSnapshotCursor is the position reached while walking the posts of a channel, ordered by
+// CreateAt and then Id. The zero value starts from the oldest post.
+type ChannelSnapshotCursor struct {
+	CreateAt int64
+	Id       string
+}
+
+// ChannelSnapshotLine is one line of the data of a channel snapshot. The channel comes first,
+// followed by its members and then its posts in creation order, each post followed by its files.
+type ChannelSnapshotLine struct {
+	Type    string         `json:"type"`
+	Channel *Channel       `json:"channel,omitempty"`
+	Member  *ChannelMember `json:"member,omitempty"`
+	Post    *Post          `json:"post,omitempty"`
+	File    *FileInfo      `json:"file,omitempty"`
+}
+
+func (o *ChannelSnapshotLine) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+// IsValid checks that the line holds exactly the record its type announces.
+func (o *ChannelSnapshotLine) IsValid() bool {
+	switch o.Type {
+	case CHANNEL_SNAPSHOT_LINE_TYPE_CHANNEL:
+		return o.Channel != nil && o.Member == nil && o.Post == nil && o.File == nil
+	case CHANNEL_SNAPSHOT_LINE_TYPE_MEMBER:
+		return o.Channel == nil && o.Member != nil && o.Post == nil && o.File == nil
+	case CHANNEL_SNAPSHOT_LINE_TYPE_POST:
+		return o.Channel == nil && o.Member == nil && o.Post != nil && o.File == nil
+	case CHANNEL_SNAPSHOT_LINE_TYPE_FILE:
+		return o.Channel == nil && o.Member == nil && o.Post == nil && o.File != nil
+	}
+
+	return false
+}
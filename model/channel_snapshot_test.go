@@ -0,0 +1,42 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelSnapshotLineIsValid(t *testing.T) {
+	for name, tc := range map[string]struct {
+		Line  ChannelSnapshotLine
+		Valid bool
+	}{
+		"channel":        {ChannelSnapshotLine{Type: CHANNEL_SNAPSHOT_LINE_TYPE_CHANNEL, Channel: &Channel{}}, true},
+		"member":         {ChannelSnapshotLine{Type: CHANNEL_SNAPSHOT_LINE_TYPE_MEMBER, Member: &ChannelMember{}}, true},
+		"post":           {ChannelSnapshotLine{Type: CHANNEL_SNAPSHOT_LINE_TYPE_POST, Post: &Post{}}, true},
+		"file":           {ChannelSnapshotLine{Type: CHANNEL_SNAPSHOT_LINE_TYPE_FILE, File: &FileInfo{}}, true},
+		"unknown type":   {ChannelSnapshotLine{Type: "team", Channel: &Channel{}}, false},
+		"missing record": {ChannelSnapshotLine{Type: CHANNEL_SNAPSHOT_LINE_TYPE_POST}, false},
+		"wrong record":   {ChannelSnapshotLine{Type: CHANNEL_SNAPSHOT_LINE_TYPE_POST, File: &FileInfo{}}, false},
+		"two records":    {ChannelSnapshotLine{Type: CHANNEL_SNAPSHOT_LINE_TYPE_POST, Post: &Post{}, File: &FileInfo{}}, false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.Valid, tc.Line.IsValid())
+		})
+	}
+}
+
+func TestChannelSnapshotLineJson(t *testing.T) {
+	line := ChannelSnapshotLine{Type: CHANNEL_SNAPSHOT_LINE_TYPE_POST, Post: &Post{Id: NewId(), Message: "message"}}
+
+	var decoded ChannelSnapshotLine
+	require.NoError(t, json.Unmarshal([]byte(line.ToJson()), &decoded))
+	assert.True(t, decoded.IsValid())
+	assert.Equal(t, line.Post.Id, decoded.Post.Id)
+	assert.Equal(t, line.Post.Message, decoded.Post.Message)
+}
@@ -48,6 +48,23 @@ func TestChannelPatch(t *testing.T) {
 	require.Equal(t, *p.Header, o.Header)
 	require.Equal(t, *p.Purpose, o.Purpose)
 	require.Equal(t, *p.GroupConstrained, *o.GroupConstrained)
+	require.False(t, o.IsPinApprovalRequired())
+
+	o.Patch(&ChannelPatch{PinApprovalRequired: NewBool(true)})
+	require.True(t, o.IsPinApprovalRequired())
+
+	o.Patch(&ChannelPatch{PinApprovalRequired: NewBool(false)})
+	require.False(t, o.IsPinApprovalRequired())
+	require.NotContains(t, o.Props, CHANNEL_PROPS_PIN_APPROVAL_REQUIRED)
+
+	require.False(t, o.IsIntegrationsRestricted())
+
+	o.Patch(&ChannelPatch{IntegrationsRestricted: NewBool(true)})
+	require.True(t, o.IsIntegrationsRestricted())
+
+	o.Patch(&ChannelPatch{IntegrationsRestricted: NewBool(false)})
+	require.False(t, o.IsIntegrationsRestricted())
+	require.NotContains(t, o.Props, CHANNEL_PROPS_INTEGRATIONS_RESTRICTED)
 }
 
 func TestChannelIsValid(t *testing.T) {
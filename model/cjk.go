@@ -0,0 +1,108 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	CJK_NGRAM_MAX_RUNES = 2
+)
+
+// IsCJKRune returns whether the rune belongs to one of the Chinese, Japanese or Korean scripts,
+// which are written without spaces between words.
+func IsCJKRune(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// ContainsCJK returns whether the text contains any CJK rune.
+func ContainsCJK(text string) bool {
+	return strings.IndexFunc(text, IsCJKRune) != -1
+}
+
+// SpaceCJKBoundaries inserts a space wherever a CJK rune is next to a letter, digit or @mention
+// character of another script, so that words written in other scripts, such as @mentions, can be
+// told apart from the CJK text surrounding them.
+func SpaceCJKBoundaries(text string) string {
+	if !ContainsCJK(text) {
+		return text
+	}
+
+	var b strings.Builder
+	b.Grow(len(text))
+
+	var prev rune
+	for i, r := range text {
+		if i > 0 && (IsCJKRune(prev) && isNonCJKWordRune(r) || isNonCJKWordRune(prev) && IsCJKRune(r)) {
+			b.WriteRune(' ')
+		}
+		b.WriteRune(r)
+		prev = r
+	}
+
+	return b.String()
+}
+
+func isNonCJKWordRune(r rune) bool {
+	if IsCJKRune(r) {
+		return false
+	}
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("@_-.", r)
+}
+
+// GetCJKNgrams returns the distinct unigrams and bigrams of the runs of CJK runes in the text, in
+// the order they first appear. Since CJK text has no spaces between words, these are what the
+// text is indexed by for searching.
+func GetCJKNgrams(text string) []string {
+	ngrams := []string{}
+	seen := map[string]bool{}
+
+	add := func(ngram string) {
+		if !seen[ngram] {
+			seen[ngram] = true
+			ngrams = append(ngrams, ngram)
+		}
+	}
+
+	var run []rune
+	flush := func() {
+		for i := range run {
+			add(string(run[i]))
+			if i+CJK_NGRAM_MAX_RUNES <= len(run) {
+				add(string(run[i : i+CJK_NGRAM_MAX_RUNES]))
+			}
+		}
+		run = run[:0]
+	}
+
+	for _, r := range text {
+		if IsCJKRune(r) {
+			run = append(run, r)
+		} else {
+			flush()
+		}
+	}
+	flush()
+
+	return ngrams
+}
+
+// GetCJKSearchNgrams returns the ngrams a post must be indexed by to match the CJK search term.
+// Terms of a single rune are matched by their unigram, longer ones by all of their bigrams.
+func GetCJKSearchNgrams(term string) []string {
+	ngrams := []string{}
+	for _, ngram := range GetCJKNgrams(term) {
+		if len([]rune(ngram)) == CJK_NGRAM_MAX_RUNES {
+			ngrams = append(ngrams, ngram)
+		}
+	}
+
+	if len(ngrams) == 0 {
+		return GetCJKNgrams(term)
+	}
+
+	return ngrams
+}
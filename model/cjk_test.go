@@ -0,0 +1,48 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainsCJK(t *testing.T) {
+	assert.False(t, ContainsCJK(""))
+	assert.False(t, ContainsCJK("hello world"))
+	assert.True(t, ContainsCJK("こんにちは"))
+	assert.True(t, ContainsCJK("hello 世界"))
+	assert.True(t, ContainsCJK("안녕하세요"))
+	assert.True(t, ContainsCJK("カタカナ"))
+}
+
+func TestSpaceCJKBoundaries(t *testing.T) {
+	for _, tc := range []struct {
+		Input    string
+		Expected string
+	}{
+		{"", ""},
+		{"hello world", "hello world"},
+		{"@userさん、こんにちは", "@user さん、こんにちは"},
+		{"こんにちは@user", "こんにちは @user"},
+		{"こんにちは @user さん", "こんにちは @user さん"},
+		{"東京とOsakaと京都", "東京と Osaka と京都"},
+	} {
+		assert.Equal(t, tc.Expected, SpaceCJKBoundaries(tc.Input), tc.Input)
+	}
+}
+
+func TestGetCJKNgrams(t *testing.T) {
+	assert.Equal(t, []string{}, GetCJKNgrams("hello world"))
+	assert.Equal(t, []string{"東", "東京", "京"}, GetCJKNgrams("東京"))
+	assert.Equal(t, []string{"東", "東京", "京", "大", "大阪", "阪"}, GetCJKNgrams("東京 and 大阪"))
+	assert.Equal(t, []string{"日", "日本", "本", "本日"}, GetCJKNgrams("日本日本"))
+}
+
+func TestGetCJKSearchNgrams(t *testing.T) {
+	assert.Equal(t, []string{}, GetCJKSearchNgrams("hello"))
+	assert.Equal(t, []string{"東"}, GetCJKSearchNgrams("東"))
+	assert.Equal(t, []string{"日本", "本語"}, GetCJKSearchNgrams("日本語"))
+}
@@ -246,6 +246,46 @@ func (c *Client4) GetChannelRoute(channelId string) string {
 	return fmt.Sprintf(c.GetChannelsRoute()+"/%v", channelId)
 }
 
+func (c *Client4) GetChannelBookmarksRoute(channelId string) string {
+	return c.GetChannelRoute(channelId) + "/bookmarks"
+}
+
+func (c *Client4) GetChannelBookmarkRoute(channelId, bookmarkId string) string {
+	return fmt.Sprintf(c.GetChannelBookmarksRoute(channelId)+"/%v", bookmarkId)
+}
+
+func (c *Client4) GetChannelGuestLinksRoute(channelId string) string {
+	return c.GetChannelRoute(channelId) + "/guest_links"
+}
+
+func (c *Client4) GetChannelGuestLinkRoute(channelId, linkId string) string {
+	return fmt.Sprintf(c.GetChannelGuestLinksRoute(channelId)+"/%v", linkId)
+}
+
+func (c *Client4) GetChannelIntegrationsRoute(channelId string) string {
+	return c.GetChannelRoute(channelId) + "/integrations"
+}
+
+func (c *Client4) GetChannelIntegrationRoute(channelId, integrationId string) string {
+	return fmt.Sprintf(c.GetChannelIntegrationsRoute(channelId)+"/%v", integrationId)
+}
+
+func (c *Client4) GetUserRelationshipsRoute(userId string) string {
+	return c.GetUserRoute(userId) + "/relationships"
+}
+
+func (c *Client4) GetUserRelationshipRoute(userId, otherUserId string) string {
+	return fmt.Sprintf(c.GetUserRelationshipsRoute(userId)+"/%v", otherUserId)
+}
+
+func (c *Client4) GetTeamInviteLinksRoute(teamId string) string {
+	return c.GetTeamRoute(teamId) + "/invite_links"
+}
+
+func (c *Client4) GetTeamInviteLinkRoute(teamId, linkId string) string {
+	return fmt.Sprintf(c.GetTeamInviteLinksRoute(teamId)+"/%v", linkId)
+}
+
 func (c *Client4) GetChannelByNameRoute(channelName, teamId string) string {
 	return fmt.Sprintf(c.GetTeamRoute(teamId)+"/channels/name/%v", channelName)
 }
@@ -351,6 +391,10 @@ func (c *Client4) GetComplianceReportRoute(reportId string) string {
 	return fmt.Sprintf("/compliance/reports/%v", reportId)
 }
 
+func (c *Client4) GetChannelIntegrityRoute(channelId string) string {
+	return fmt.Sprintf("/compliance/channels/%v/integrity", channelId)
+}
+
 func (c *Client4) GetOutgoingWebhooksRoute() string {
 	return "/hooks/outgoing"
 }
@@ -359,6 +403,14 @@ func (c *Client4) GetOutgoingWebhookRoute(hookID string) string {
 	return fmt.Sprintf(c.GetOutgoingWebhooksRoute()+"/%v", hookID)
 }
 
+func (c *Client4) GetPresenceWebhooksRoute() string {
+	return "/hooks/presence"
+}
+
+func (c *Client4) GetPresenceWebhookRoute(hookID string) string {
+	return fmt.Sprintf(c.GetPresenceWebhooksRoute()+"/%v", hookID)
+}
+
 func (c *Client4) GetPreferencesRoute(userId string) string {
 	return fmt.Sprintf(c.GetUserRoute(userId) + "/preferences")
 }
@@ -387,6 +439,14 @@ func (c *Client4) GetDataRetentionRoute() string {
 	return "/data_retention"
 }
 
+func (c *Client4) GetRetentionPoliciesRoute() string {
+	return c.GetDataRetentionRoute() + "/policies"
+}
+
+func (c *Client4) GetRetentionPolicyRoute(policyId string) string {
+	return fmt.Sprintf(c.GetRetentionPoliciesRoute()+"/%v", policyId)
+}
+
 func (c *Client4) GetElasticsearchRoute() string {
 	return "/elasticsearch"
 }
@@ -431,6 +491,10 @@ func (c *Client4) GetOAuthAppRoute(appId string) string {
 	return fmt.Sprintf("/oauth/apps/%v", appId)
 }
 
+func (c *Client4) GetEmbedRoute() string {
+	return "/embed"
+}
+
 func (c *Client4) GetOpenGraphRoute() string {
 	return "/opengraph"
 }
@@ -479,6 +543,14 @@ func (c *Client4) GetServerBusyRoute() string {
 	return "/server_busy"
 }
 
+func (c *Client4) GetDebugCaptureRoute() string {
+	return c.GetSystemRoute() + "/debug_capture"
+}
+
+func (c *Client4) GetProfilesRoute() string {
+	return c.GetSystemRoute() + "/profiles"
+}
+
 func (c *Client4) GetUserTermsOfServiceRoute(userId string) string {
 	return c.GetUserRoute(userId) + "/terms_of_service"
 }
@@ -487,6 +559,26 @@ func (c *Client4) GetTermsOfServiceRoute() string {
 	return "/terms_of_service"
 }
 
+func (c *Client4) GetTermsOfServicePoliciesRoute() string {
+	return c.GetTermsOfServiceRoute() + "/policies"
+}
+
+func (c *Client4) GetTermsOfServicePolicyRoute(policyId string) string {
+	return fmt.Sprintf(c.GetTermsOfServicePoliciesRoute()+"/%v", policyId)
+}
+
+func (c *Client4) GetUserPropertyFieldsRoute() string {
+	return c.GetUsersRoute() + "/properties/fields"
+}
+
+func (c *Client4) GetUserPropertyFieldRoute(fieldId string) string {
+	return fmt.Sprintf(c.GetUserPropertyFieldsRoute()+"/%v", fieldId)
+}
+
+func (c *Client4) GetUserPropertiesRoute(userId string) string {
+	return c.GetUserRoute(userId) + "/properties"
+}
+
 func (c *Client4) GetGroupsRoute() string {
 	return "/groups"
 }
@@ -795,6 +887,19 @@ func (c *Client4) CreateUserWithInviteId(user *User, inviteId string) (*User, *R
 	return UserFromJson(r.Body), BuildResponse(r)
 }
 
+// CreateGuestWithChannelGuestLink creates a guest in the system through the channel guest link with
+// the provided token.
+func (c *Client4) CreateGuestWithChannelGuestLink(user *User, token string) (*User, *Response) {
+	query := fmt.Sprintf("?guest_link=%v", url.QueryEscape(token))
+	r, err := c.DoApiPost(c.GetUsersRoute()+query, user.ToJson())
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	return UserFromJson(r.Body), BuildResponse(r)
+}
+
 // GetMe returns the logged in user.
 func (c *Client4) GetMe(etag string) (*User, *Response) {
 	r, err := c.DoApiGet(c.GetUserRoute(ME), etag)
@@ -910,6 +1015,36 @@ func (c *Client4) GetUsers(page int, perPage int, etag string) ([]*User, *Respon
 	return UserListFromJson(r.Body), BuildResponse(r)
 }
 
+// GetUsersWithAccountFilters returns a page of the users on the system matching the account
+// filters. Page counting starts at 0.
+func (c *Client4) GetUsersWithAccountFilters(filters *UserAccountFilters, page int, perPage int) ([]*User, *Response) {
+	v := url.Values{}
+	v.Set("page", strconv.Itoa(page))
+	v.Set("per_page", strconv.Itoa(perPage))
+	if filters.NeverLoggedIn {
+		v.Set("never_logged_in", "true")
+	}
+	if filters.LastLoginBefore != 0 {
+		v.Set("last_login_before", strconv.FormatInt(filters.LastLoginBefore, 10))
+	}
+	if filters.AuthService != "" {
+		v.Set("auth_service", filters.AuthService)
+	}
+	if filters.MfaActive {
+		v.Set("mfa_active", "true")
+	}
+	if filters.MfaInactive {
+		v.Set("mfa_inactive", "true")
+	}
+
+	r, err := c.DoApiGet(c.GetUsersRoute()+"?"+v.Encode(), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return UserListFromJson(r.Body), BuildResponse(r)
+}
+
 // GetUsersInTeam returns a page of users on a team. Page counting starts at 0.
 func (c *Client4) GetUsersInTeam(teamId string, page int, perPage int, etag string) ([]*User, *Response) {
 	query := fmt.Sprintf("?in_team=%v&page=%v&per_page=%v", teamId, page, perPage)
@@ -1203,6 +1338,18 @@ func (c *Client4) UpdateUserRoles(userId, roles string) (bool, *Response) {
 	return CheckStatusOK(r), BuildResponse(r)
 }
 
+// UpdateUserRolesWithExpiry updates a user's roles in the system, granting the roles other than
+// system_user and system_guest until expiresAt.
+func (c *Client4) UpdateUserRolesWithExpiry(userId, roles string, expiresAt int64) (bool, *Response) {
+	requestBody := map[string]string{"roles": roles, "expires_at": strconv.FormatInt(expiresAt, 10)}
+	r, err := c.DoApiPut(c.GetUserRoute(userId)+"/roles", MapToJson(requestBody))
+	if err != nil {
+		return false, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return CheckStatusOK(r), BuildResponse(r)
+}
+
 // UpdateUserActive updates status of a user whether active or not.
 func (c *Client4) UpdateUserActive(userId string, active bool) (bool, *Response) {
 	requestBody := make(map[string]interface{})
@@ -1461,6 +1608,19 @@ func (c *Client4) CreateUserAccessToken(userId, description string) (*UserAccess
 	return UserAccessTokenFromJson(r.Body), BuildResponse(r)
 }
 
+// ImpersonateUser creates a time-boxed session acting as the user, read-only unless writeAccess is
+// set. The returned session holds the token to authenticate as the user with. Must have the
+// 'impersonate_user' permission.
+func (c *Client4) ImpersonateUser(userId string, writeAccess bool) (*Session, *Response) {
+	requestBody := map[string]interface{}{"write_access": writeAccess}
+	r, err := c.DoApiPost(c.GetUserRoute(userId)+"/impersonate", StringInterfaceToJson(requestBody))
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return SessionFromJson(r.Body), BuildResponse(r)
+}
+
 // GetUserAccessTokens will get a page of access tokens' id, description, is_active
 // and the user_id in the system. The actual token will not be returned. Must have
 // the 'manage_system' permission.
@@ -1754,6 +1914,17 @@ func (c *Client4) GetAllTeams(etag string, page int, perPage int) ([]*Team, *Res
 	return TeamListFromJson(r.Body), BuildResponse(r)
 }
 
+// GetDeletedTeams returns a page of the archived teams. Must be authenticated as a system admin.
+func (c *Client4) GetDeletedTeams(page int, perPage int) ([]*Team, *Response) {
+	query := fmt.Sprintf("?page=%v&per_page=%v&only_deleted="+c.boolString(true), page, perPage)
+	r, err := c.DoApiGet(c.GetTeamsRoute()+query, "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return TeamListFromJson(r.Body), BuildResponse(r)
+}
+
 // GetAllTeamsWithTotalCount returns all teams based on permissions.
 func (c *Client4) GetAllTeamsWithTotalCount(etag string, page int, perPage int) ([]*Team, int64, *Response) {
 	query := fmt.Sprintf("?page=%v&per_page=%v&include_total_count="+c.boolString(true), page, perPage)
@@ -1824,6 +1995,22 @@ func (c *Client4) GetTeamsForUser(userId, etag string) ([]*Team, *Response) {
 	return TeamListFromJson(r.Body), BuildResponse(r)
 }
 
+// GetTeamsForUserWithOptions returns a page of the teams a user is a member of, optionally including
+// the deleted ones and sorted by one of the TEAMS_FOR_USER_SORT_* orders.
+func (c *Client4) GetTeamsForUserWithOptions(userId string, opts *TeamsForUserGetOptions, etag string) ([]*Team, *Response) {
+	query := fmt.Sprintf("?page=%v&per_page=%v&include_deleted=%v", opts.Page, opts.PerPage, opts.IncludeDeleted)
+	if opts.Sort != "" {
+		query += "&sort=" + url.QueryEscape(opts.Sort)
+	}
+
+	r, err := c.DoApiGet(c.GetUserRoute(userId)+"/teams"+query, etag)
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return TeamListFromJson(r.Body), BuildResponse(r)
+}
+
 // GetTeamMember returns a team member based on the provided team and user id strings.
 func (c *Client4) GetTeamMember(teamId, userId, etag string) (*TeamMember, *Response) {
 	r, err := c.DoApiGet(c.GetTeamMemberRoute(teamId, userId), etag)
@@ -1845,6 +2032,18 @@ func (c *Client4) UpdateTeamMemberRoles(teamId, userId, newRoles string) (bool,
 	return CheckStatusOK(r), BuildResponse(r)
 }
 
+// UpdateTeamMemberRolesWithExpiry updates the roles of a team member, granting the explicit roles
+// until expiresAt.
+func (c *Client4) UpdateTeamMemberRolesWithExpiry(teamId, userId, newRoles string, expiresAt int64) (bool, *Response) {
+	requestBody := map[string]string{"roles": newRoles, "expires_at": strconv.FormatInt(expiresAt, 10)}
+	r, err := c.DoApiPut(c.GetTeamMemberRoute(teamId, userId)+"/roles", MapToJson(requestBody))
+	if err != nil {
+		return false, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return CheckStatusOK(r), BuildResponse(r)
+}
+
 // UpdateTeamMemberSchemeRoles will update the scheme-derived roles on a team for a user.
 func (c *Client4) UpdateTeamMemberSchemeRoles(teamId string, userId string, schemeRoles *SchemeRoles) (bool, *Response) {
 	r, err := c.DoApiPut(c.GetTeamMemberRoute(teamId, userId)+"/schemeRoles", schemeRoles.ToJson())
@@ -1875,6 +2074,38 @@ func (c *Client4) PatchTeam(teamId string, patch *TeamPatch) (*Team, *Response)
 	return TeamFromJson(r.Body), BuildResponse(r)
 }
 
+// UpdateTeamPostingRestrictions restricts file uploads and custom emoji usage in a team.
+func (c *Client4) UpdateTeamPostingRestrictions(teamId string, restrictions *PostingRestrictions) (*Team, *Response) {
+	r, err := c.DoApiPut(c.GetTeamRoute(teamId)+"/posting_restrictions", restrictions.ToJson())
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return TeamFromJson(r.Body), BuildResponse(r)
+}
+
+// GetTeamNotifyDefaults returns the notification preferences given to the members of a team who
+// kept them to the default.
+func (c *Client4) GetTeamNotifyDefaults(teamId string) (map[string]string, *Response) {
+	r, err := c.DoApiGet(c.GetTeamRoute(teamId)+"/notify_defaults", "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return MapFromJson(r.Body), BuildResponse(r)
+}
+
+// UpdateTeamNotifyDefaults replaces the notification preferences given to the members of a team who
+// kept them to the default.
+func (c *Client4) UpdateTeamNotifyDefaults(teamId string, defaults map[string]string) (map[string]string, *Response) {
+	r, err := c.DoApiPut(c.GetTeamRoute(teamId)+"/notify_defaults", MapToJson(defaults))
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return MapFromJson(r.Body), BuildResponse(r)
+}
+
 // RestoreTeam restores a previously deleted team.
 func (c *Client4) RestoreTeam(teamId string) (*Team, *Response) {
 	r, err := c.DoApiPost(c.GetTeamRoute(teamId)+"/restore", "")
@@ -1885,6 +2116,17 @@ func (c *Client4) RestoreTeam(teamId string) (*Team, *Response) {
 	return TeamFromJson(r.Body), BuildResponse(r)
 }
 
+// CancelTeamPurge keeps an archived team from being permanently deleted at the end of its grace
+// period.
+func (c *Client4) CancelTeamPurge(teamId string) (*Team, *Response) {
+	r, err := c.DoApiPost(c.GetTeamRoute(teamId)+"/purge/cancel", "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return TeamFromJson(r.Body), BuildResponse(r)
+}
+
 // RegenerateTeamInviteId requests a new invite ID to be generated.
 func (c *Client4) RegenerateTeamInviteId(teamId string) (*Team, *Response) {
 	r, err := c.DoApiPost(c.GetTeamRoute(teamId)+"/regenerate_invite_id", "")
@@ -1895,6 +2137,17 @@ func (c *Client4) RegenerateTeamInviteId(teamId string) (*Team, *Response) {
 	return TeamFromJson(r.Body), BuildResponse(r)
 }
 
+// RegenerateTeamInviteIdWithExpiry requests a new invite ID to be generated, which stops being
+// accepted at expiresAt.
+func (c *Client4) RegenerateTeamInviteIdWithExpiry(teamId string, expiresAt int64) (*Team, *Response) {
+	r, err := c.DoApiPost(c.GetTeamRoute(teamId)+"/regenerate_invite_id?expires_at="+strconv.FormatInt(expiresAt, 10), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return TeamFromJson(r.Body), BuildResponse(r)
+}
+
 // SoftDeleteTeam deletes the team softly (archive only, not permanent delete).
 func (c *Client4) SoftDeleteTeam(teamId string) (bool, *Response) {
 	r, err := c.DoApiDelete(c.GetTeamRoute(teamId))
@@ -1951,6 +2204,18 @@ func (c *Client4) GetTeamMembersSortAndWithoutDeletedUsers(teamId string, page i
 	return TeamMembersFromJson(r.Body), BuildResponse(r)
 }
 
+// GetTeamMembersWithRoles returns the team members holding one of the given team roles, for example
+// model.TEAM_ADMIN_ROLE_ID to list the team admins.
+func (c *Client4) GetTeamMembersWithRoles(teamId string, page int, perPage int, roles []string, etag string) ([]*TeamMember, *Response) {
+	query := fmt.Sprintf("?page=%v&per_page=%v&roles=%v", page, perPage, url.QueryEscape(strings.Join(roles, ",")))
+	r, err := c.DoApiGet(c.GetTeamMembersRoute(teamId)+query, etag)
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return TeamMembersFromJson(r.Body), BuildResponse(r)
+}
+
 // GetTeamMembersForUser returns the team members for a user.
 func (c *Client4) GetTeamMembersForUser(userId string, etag string) ([]*TeamMember, *Response) {
 	r, err := c.DoApiGet(c.GetUserRoute(userId)+"/teams/members", etag)
@@ -1961,6 +2226,23 @@ func (c *Client4) GetTeamMembersForUser(userId string, etag string) ([]*TeamMemb
 	return TeamMembersFromJson(r.Body), BuildResponse(r)
 }
 
+// GetTeamMembersForUserWithOptions returns a page of the team members of a user, optionally
+// excluding the memberships the user left and those of deleted teams, and sorted by the display
+// name of the teams with TEAMS_FOR_USER_SORT_DISPLAY_NAME.
+func (c *Client4) GetTeamMembersForUserWithOptions(userId string, page, perPage int, opts *TeamMembersForUserGetOptions, etag string) ([]*TeamMember, *Response) {
+	query := fmt.Sprintf("?page=%v&per_page=%v&exclude_deleted=%v", page, perPage, opts.ExcludeDeleted)
+	if opts.Sort != "" {
+		query += "&sort=" + url.QueryEscape(opts.Sort)
+	}
+
+	r, err := c.DoApiGet(c.GetUserRoute(userId)+"/teams/members"+query, etag)
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return TeamMembersFromJson(r.Body), BuildResponse(r)
+}
+
 // GetTeamMembersByIds will return an array of team members based on the
 // team id and a list of user ids provided. Must be authenticated.
 func (c *Client4) GetTeamMembersByIds(teamId string, userIds []string) ([]*TeamMember, *Response) {
@@ -2004,41 +2286,50 @@ func (c *Client4) AddTeamMemberFromInvite(token, inviteId string) (*TeamMember,
 	return TeamMemberFromJson(r.Body), BuildResponse(r)
 }
 
-// AddTeamMembers adds a number of users to a team and returns the team members.
-func (c *Client4) AddTeamMembers(teamId string, userIds []string) ([]*TeamMember, *Response) {
-	var members []*TeamMember
-	for _, userId := range userIds {
-		member := &TeamMember{TeamId: teamId, UserId: userId}
-		members = append(members, member)
+// AddTeamMemberFromInviteLink adds the current user to the team of the invite link with the given
+// name and returns the team member.
+func (c *Client4) AddTeamMemberFromInviteLink(name string) (*TeamMember, *Response) {
+	r, err := c.DoApiPost(c.GetTeamsRoute()+"/members/invite?invite_link="+url.QueryEscape(name), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
 	}
+	defer closeBody(r)
+	return TeamMemberFromJson(r.Body), BuildResponse(r)
+}
 
-	r, err := c.DoApiPost(c.GetTeamMembersRoute(teamId)+"/batch", TeamMembersToJson(members))
+// CreateTeamInviteLink creates a named invite link for a team.
+func (c *Client4) CreateTeamInviteLink(link *TeamInviteLink) (*TeamInviteLink, *Response) {
+	r, err := c.DoApiPost(c.GetTeamInviteLinksRoute(link.TeamId), link.ToJson())
 	if err != nil {
 		return nil, BuildErrorResponse(r, err)
 	}
 	defer closeBody(r)
-	return TeamMembersFromJson(r.Body), BuildResponse(r)
+	return TeamInviteLinkFromJson(r.Body), BuildResponse(r)
 }
 
-// AddTeamMembers adds a number of users to a team and returns the team members.
-func (c *Client4) AddTeamMembersGracefully(teamId string, userIds []string) ([]*TeamMemberWithError, *Response) {
-	var members []*TeamMember
-	for _, userId := range userIds {
-		member := &TeamMember{TeamId: teamId, UserId: userId}
-		members = append(members, member)
+// GetTeamInviteLinks returns the invite links of a team that were not revoked.
+func (c *Client4) GetTeamInviteLinks(teamId string) ([]*TeamInviteLink, *Response) {
+	r, err := c.DoApiGet(c.GetTeamInviteLinksRoute(teamId), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
 	}
+	defer closeBody(r)
+	return TeamInviteLinkListFromJson(r.Body), BuildResponse(r)
+}
 
-	r, err := c.DoApiPost(c.GetTeamMembersRoute(teamId)+"/batch?graceful="+c.boolString(true), TeamMembersToJson(members))
+// GetTeamInviteLink returns a single invite link of a team.
+func (c *Client4) GetTeamInviteLink(teamId, linkId string) (*TeamInviteLink, *Response) {
+	r, err := c.DoApiGet(c.GetTeamInviteLinkRoute(teamId, linkId), "")
 	if err != nil {
 		return nil, BuildErrorResponse(r, err)
 	}
 	defer closeBody(r)
-	return TeamMembersWithErrorFromJson(r.Body), BuildResponse(r)
+	return TeamInviteLinkFromJson(r.Body), BuildResponse(r)
 }
 
-// RemoveTeamMember will remove a user from a team.
-func (c *Client4) RemoveTeamMember(teamId, userId string) (bool, *Response) {
-	r, err := c.DoApiDelete(c.GetTeamMemberRoute(teamId, userId))
+// RevokeTeamInviteLink revokes an invite link of a team.
+func (c *Client4) RevokeTeamInviteLink(teamId, linkId string) (bool, *Response) {
+	r, err := c.DoApiDelete(c.GetTeamInviteLinkRoute(teamId, linkId))
 	if err != nil {
 		return false, BuildErrorResponse(r, err)
 	}
@@ -2046,82 +2337,69 @@ func (c *Client4) RemoveTeamMember(teamId, userId string) (bool, *Response) {
 	return CheckStatusOK(r), BuildResponse(r)
 }
 
-// GetTeamStats returns a team stats based on the team id string.
-// Must be authenticated.
-func (c *Client4) GetTeamStats(teamId, etag string) (*TeamStats, *Response) {
-	r, err := c.DoApiGet(c.GetTeamStatsRoute(teamId), etag)
+// CreateChannelGuestLink creates a guest link for a channel.
+func (c *Client4) CreateChannelGuestLink(link *ChannelGuestLink) (*ChannelGuestLink, *Response) {
+	r, err := c.DoApiPost(c.GetChannelGuestLinksRoute(link.ChannelId), link.ToJson())
 	if err != nil {
 		return nil, BuildErrorResponse(r, err)
 	}
 	defer closeBody(r)
-	return TeamStatsFromJson(r.Body), BuildResponse(r)
+	return ChannelGuestLinkFromJson(r.Body), BuildResponse(r)
 }
 
-// GetTotalUsersStats returns a total system user stats.
-// Must be authenticated.
-func (c *Client4) GetTotalUsersStats(etag string) (*UsersStats, *Response) {
-	r, err := c.DoApiGet(c.GetTotalUsersStatsRoute(), etag)
+// GetChannelGuestLinks returns the guest links of a channel that were not revoked.
+func (c *Client4) GetChannelGuestLinks(channelId string) ([]*ChannelGuestLink, *Response) {
+	r, err := c.DoApiGet(c.GetChannelGuestLinksRoute(channelId), "")
 	if err != nil {
 		return nil, BuildErrorResponse(r, err)
 	}
 	defer closeBody(r)
-	return UsersStatsFromJson(r.Body), BuildResponse(r)
+	return ChannelGuestLinkListFromJson(r.Body), BuildResponse(r)
 }
 
-// GetTeamUnread will return a TeamUnread object that contains the amount of
-// unread messages and mentions the user has for the specified team.
-// Must be authenticated.
-func (c *Client4) GetTeamUnread(teamId, userId string) (*TeamUnread, *Response) {
-	r, err := c.DoApiGet(c.GetUserRoute(userId)+c.GetTeamRoute(teamId)+"/unread", "")
+// GetChannelGuestLink returns a single guest link of a channel.
+func (c *Client4) GetChannelGuestLink(channelId, linkId string) (*ChannelGuestLink, *Response) {
+	r, err := c.DoApiGet(c.GetChannelGuestLinkRoute(channelId, linkId), "")
 	if err != nil {
 		return nil, BuildErrorResponse(r, err)
 	}
 	defer closeBody(r)
-	return TeamUnreadFromJson(r.Body), BuildResponse(r)
+	return ChannelGuestLinkFromJson(r.Body), BuildResponse(r)
 }
 
-// ImportTeam will import an exported team from other app into a existing team.
-func (c *Client4) ImportTeam(data []byte, filesize int, importFrom, filename, teamId string) (map[string]string, *Response) {
-	body := &bytes.Buffer{}
-	writer := multipart.NewWriter(body)
-
-	part, err := writer.CreateFormFile("file", filename)
+// RevokeChannelGuestLink revokes a guest link of a channel.
+func (c *Client4) RevokeChannelGuestLink(channelId, linkId string) (bool, *Response) {
+	r, err := c.DoApiDelete(c.GetChannelGuestLinkRoute(channelId, linkId))
 	if err != nil {
-		return nil, &Response{Error: NewAppError("UploadImportTeam", "model.client.upload_post_attachment.file.app_error", nil, err.Error(), http.StatusBadRequest)}
-	}
-
-	if _, err = io.Copy(part, bytes.NewBuffer(data)); err != nil {
-		return nil, &Response{Error: NewAppError("UploadImportTeam", "model.client.upload_post_attachment.file.app_error", nil, err.Error(), http.StatusBadRequest)}
+		return false, BuildErrorResponse(r, err)
 	}
+	defer closeBody(r)
+	return CheckStatusOK(r), BuildResponse(r)
+}
 
-	part, err = writer.CreateFormField("filesize")
+// GetChannelIntegrations returns the integrations allowed in a channel.
+func (c *Client4) GetChannelIntegrations(channelId string) ([]*ChannelIntegration, *Response) {
+	r, err := c.DoApiGet(c.GetChannelIntegrationsRoute(channelId), "")
 	if err != nil {
-		return nil, &Response{Error: NewAppError("UploadImportTeam", "model.client.upload_post_attachment.file_size.app_error", nil, err.Error(), http.StatusBadRequest)}
-	}
-
-	if _, err = io.Copy(part, strings.NewReader(strconv.Itoa(filesize))); err != nil {
-		return nil, &Response{Error: NewAppError("UploadImportTeam", "model.client.upload_post_attachment.file_size.app_error", nil, err.Error(), http.StatusBadRequest)}
+		return nil, BuildErrorResponse(r, err)
 	}
+	defer closeBody(r)
+	return ChannelIntegrationListFromJson(r.Body), BuildResponse(r)
+}
 
-	part, err = writer.CreateFormField("importFrom")
+// AddChannelIntegration allows a bot, an incoming webhook or a slash command in a channel.
+func (c *Client4) AddChannelIntegration(integration *ChannelIntegration) (*ChannelIntegration, *Response) {
+	r, err := c.DoApiPost(c.GetChannelIntegrationsRoute(integration.ChannelId), integration.ToJson())
 	if err != nil {
-		return nil, &Response{Error: NewAppError("UploadImportTeam", "model.client.upload_post_attachment.import_from.app_error", nil, err.Error(), http.StatusBadRequest)}
-	}
-
-	if _, err := io.Copy(part, strings.NewReader(importFrom)); err != nil {
-		return nil, &Response{Error: NewAppError("UploadImportTeam", "model.client.upload_post_attachment.import_from.app_error", nil, err.Error(), http.StatusBadRequest)}
-	}
-
-	if err := writer.Close(); err != nil {
-		return nil, &Response{Error: NewAppError("UploadImportTeam", "model.client.upload_post_attachment.writer.app_error", nil, err.Error(), http.StatusBadRequest)}
+		return nil, BuildErrorResponse(r, err)
 	}
-
-	return c.DoUploadImportTeam(c.GetTeamImportRoute(teamId), body.Bytes(), writer.FormDataContentType())
+	defer closeBody(r)
+	return ChannelIntegrationFromJson(r.Body), BuildResponse(r)
 }
 
-// InviteUsersToTeam invite users by email to the team.
-func (c *Client4) InviteUsersToTeam(teamId string, userEmails []string) (bool, *Response) {
-	r, err := c.DoApiPost(c.GetTeamRoute(teamId)+"/invite/email", ArrayToJson(userEmails))
+// RemoveChannelIntegration disallows an integration in a channel.
+func (c *Client4) RemoveChannelIntegration(channelId, integrationId string) (bool, *Response) {
+	r, err := c.DoApiDelete(c.GetChannelIntegrationRoute(channelId, integrationId))
 	if err != nil {
 		return false, BuildErrorResponse(r, err)
 	}
@@ -2129,23 +2407,197 @@ func (c *Client4) InviteUsersToTeam(teamId string, userEmails []string) (bool, *
 	return CheckStatusOK(r), BuildResponse(r)
 }
 
-// InviteGuestsToTeam invite guest by email to some channels in a team.
-func (c *Client4) InviteGuestsToTeam(teamId string, userEmails []string, channels []string, message string) (bool, *Response) {
-	guestsInvite := GuestsInvite{
-		Emails:   userEmails,
-		Channels: channels,
-		Message:  message,
+// GetUserRelationships returns the users a user muted or blocked, as the relationship type asks,
+// or both when it is empty.
+func (c *Client4) GetUserRelationships(userId, relationshipType string) ([]*UserRelationship, *Response) {
+	query := ""
+	if relationshipType != "" {
+		query = "?type=" + url.QueryEscape(relationshipType)
 	}
-	r, err := c.DoApiPost(c.GetTeamRoute(teamId)+"/invite-guests/email", guestsInvite.ToJson())
+	r, err := c.DoApiGet(c.GetUserRelationshipsRoute(userId)+query, "")
 	if err != nil {
-		return false, BuildErrorResponse(r, err)
+		return nil, BuildErrorResponse(r, err)
 	}
 	defer closeBody(r)
-	return CheckStatusOK(r), BuildResponse(r)
+	return UserRelationshipListFromJson(r.Body), BuildResponse(r)
 }
 
-// InviteUsersToTeam invite users by email to the team.
-func (c *Client4) InviteUsersToTeamGracefully(teamId string, userEmails []string) ([]*EmailInviteWithError, *Response) {
+// AddUserRelationship mutes or blocks a user on behalf of another.
+func (c *Client4) AddUserRelationship(relationship *UserRelationship) (*UserRelationship, *Response) {
+	r, err := c.DoApiPost(c.GetUserRelationshipsRoute(relationship.UserId), relationship.ToJson())
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return UserRelationshipFromJson(r.Body), BuildResponse(r)
+}
+
+// RemoveUserRelationship unmutes or unblocks a user on behalf of another.
+func (c *Client4) RemoveUserRelationship(userId, otherUserId, relationshipType string) (bool, *Response) {
+	r, err := c.DoApiDelete(c.GetUserRelationshipRoute(userId, otherUserId) + "?type=" + url.QueryEscape(relationshipType))
+	if err != nil {
+		return false, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return CheckStatusOK(r), BuildResponse(r)
+}
+
+// AddTeamMembers adds a number of users to a team and returns the team members.
+func (c *Client4) AddTeamMembers(teamId string, userIds []string) ([]*TeamMember, *Response) {
+	var members []*TeamMember
+	for _, userId := range userIds {
+		member := &TeamMember{TeamId: teamId, UserId: userId}
+		members = append(members, member)
+	}
+
+	r, err := c.DoApiPost(c.GetTeamMembersRoute(teamId)+"/batch", TeamMembersToJson(members))
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return TeamMembersFromJson(r.Body), BuildResponse(r)
+}
+
+// AddTeamMembers adds a number of users to a team and returns the team members.
+func (c *Client4) AddTeamMembersGracefully(teamId string, userIds []string) ([]*TeamMemberWithError, *Response) {
+	var members []*TeamMember
+	for _, userId := range userIds {
+		member := &TeamMember{TeamId: teamId, UserId: userId}
+		members = append(members, member)
+	}
+
+	r, err := c.DoApiPost(c.GetTeamMembersRoute(teamId)+"/batch?graceful="+c.boolString(true), TeamMembersToJson(members))
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return TeamMembersWithErrorFromJson(r.Body), BuildResponse(r)
+}
+
+// RemoveTeamMember will remove a user from a team.
+func (c *Client4) RemoveTeamMember(teamId, userId string) (bool, *Response) {
+	r, err := c.DoApiDelete(c.GetTeamMemberRoute(teamId, userId))
+	if err != nil {
+		return false, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return CheckStatusOK(r), BuildResponse(r)
+}
+
+// GetTeamStats returns a team stats based on the team id string.
+// Must be authenticated.
+func (c *Client4) GetTeamStats(teamId, etag string) (*TeamStats, *Response) {
+	r, err := c.DoApiGet(c.GetTeamStatsRoute(teamId), etag)
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return TeamStatsFromJson(r.Body), BuildResponse(r)
+}
+
+// GetTeamsActiveMemberCounts returns the number of active members of each of the given teams.
+// Must have manage_system permission.
+func (c *Client4) GetTeamsActiveMemberCounts(teamIds []string) (map[string]int64, *Response) {
+	r, err := c.DoApiPost(c.GetTeamsRoute()+"/active_member_counts", ArrayToJson(teamIds))
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	var counts map[string]int64
+	json.NewDecoder(r.Body).Decode(&counts)
+	return counts, BuildResponse(r)
+}
+
+// GetTotalUsersStats returns a total system user stats.
+// Must be authenticated.
+func (c *Client4) GetTotalUsersStats(etag string) (*UsersStats, *Response) {
+	r, err := c.DoApiGet(c.GetTotalUsersStatsRoute(), etag)
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return UsersStatsFromJson(r.Body), BuildResponse(r)
+}
+
+// GetTeamUnread will return a TeamUnread object that contains the amount of
+// unread messages and mentions the user has for the specified team.
+// Must be authenticated.
+func (c *Client4) GetTeamUnread(teamId, userId string) (*TeamUnread, *Response) {
+	r, err := c.DoApiGet(c.GetUserRoute(userId)+c.GetTeamRoute(teamId)+"/unread", "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return TeamUnreadFromJson(r.Body), BuildResponse(r)
+}
+
+// ImportTeam will import an exported team from other app into a existing team.
+func (c *Client4) ImportTeam(data []byte, filesize int, importFrom, filename, teamId string) (map[string]string, *Response) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	part, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		return nil, &Response{Error: NewAppError("UploadImportTeam", "model.client.upload_post_attachment.file.app_error", nil, err.Error(), http.StatusBadRequest)}
+	}
+
+	if _, err = io.Copy(part, bytes.NewBuffer(data)); err != nil {
+		return nil, &Response{Error: NewAppError("UploadImportTeam", "model.client.upload_post_attachment.file.app_error", nil, err.Error(), http.StatusBadRequest)}
+	}
+
+	part, err = writer.CreateFormField("filesize")
+	if err != nil {
+		return nil, &Response{Error: NewAppError("UploadImportTeam", "model.client.upload_post_attachment.file_size.app_error", nil, err.Error(), http.StatusBadRequest)}
+	}
+
+	if _, err = io.Copy(part, strings.NewReader(strconv.Itoa(filesize))); err != nil {
+		return nil, &Response{Error: NewAppError("UploadImportTeam", "model.client.upload_post_attachment.file_size.app_error", nil, err.Error(), http.StatusBadRequest)}
+	}
+
+	part, err = writer.CreateFormField("importFrom")
+	if err != nil {
+		return nil, &Response{Error: NewAppError("UploadImportTeam", "model.client.upload_post_attachment.import_from.app_error", nil, err.Error(), http.StatusBadRequest)}
+	}
+
+	if _, err := io.Copy(part, strings.NewReader(importFrom)); err != nil {
+		return nil, &Response{Error: NewAppError("UploadImportTeam", "model.client.upload_post_attachment.import_from.app_error", nil, err.Error(), http.StatusBadRequest)}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, &Response{Error: NewAppError("UploadImportTeam", "model.client.upload_post_attachment.writer.app_error", nil, err.Error(), http.StatusBadRequest)}
+	}
+
+	return c.DoUploadImportTeam(c.GetTeamImportRoute(teamId), body.Bytes(), writer.FormDataContentType())
+}
+
+// InviteUsersToTeam invite users by email to the team.
+func (c *Client4) InviteUsersToTeam(teamId string, userEmails []string) (bool, *Response) {
+	r, err := c.DoApiPost(c.GetTeamRoute(teamId)+"/invite/email", ArrayToJson(userEmails))
+	if err != nil {
+		return false, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return CheckStatusOK(r), BuildResponse(r)
+}
+
+// InviteGuestsToTeam invite guest by email to some channels in a team.
+func (c *Client4) InviteGuestsToTeam(teamId string, userEmails []string, channels []string, message string) (bool, *Response) {
+	guestsInvite := GuestsInvite{
+		Emails:   userEmails,
+		Channels: channels,
+		Message:  message,
+	}
+	r, err := c.DoApiPost(c.GetTeamRoute(teamId)+"/invite-guests/email", guestsInvite.ToJson())
+	if err != nil {
+		return false, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return CheckStatusOK(r), BuildResponse(r)
+}
+
+// InviteUsersToTeam invite users by email to the team.
+func (c *Client4) InviteUsersToTeamGracefully(teamId string, userEmails []string) ([]*EmailInviteWithError, *Response) {
 	r, err := c.DoApiPost(c.GetTeamRoute(teamId)+"/invite/email?graceful="+c.boolString(true), ArrayToJson(userEmails))
 	if err != nil {
 		return nil, BuildErrorResponse(r, err)
@@ -2320,6 +2772,16 @@ func (c *Client4) PatchChannel(channelId string, patch *ChannelPatch) (*Channel,
 	return ChannelFromJson(r.Body), BuildResponse(r)
 }
 
+// UpdateChannelPostingRestrictions restricts file uploads and custom emoji usage in a channel.
+func (c *Client4) UpdateChannelPostingRestrictions(channelId string, restrictions *PostingRestrictions) (*Channel, *Response) {
+	r, err := c.DoApiPut(c.GetChannelRoute(channelId)+"/posting_restrictions", restrictions.ToJson())
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return ChannelFromJson(r.Body), BuildResponse(r)
+}
+
 // ConvertChannelToPrivate converts public to private channel.
 func (c *Client4) ConvertChannelToPrivate(channelId string) (*Channel, *Response) {
 	r, err := c.DoApiPost(c.GetChannelRoute(channelId)+"/convert", "")
@@ -2621,6 +3083,19 @@ func (c *Client4) GetChannelMembersForUser(userId, teamId, etag string) (*Channe
 	return ChannelMembersFromJson(r.Body), BuildResponse(r)
 }
 
+// GetChannelMembersForUserPage gets a page of the channel memberships of a user in a team, sorted by
+// model.CHANNEL_MEMBERS_SORT_LAST_VIEWED_AT or model.CHANNEL_MEMBERS_SORT_UNREAD. The cursor is the
+// NextToken of the previous page, or empty for the first page.
+func (c *Client4) GetChannelMembersForUserPage(userId, teamId, sortBy, cursor string, perPage int) (*ChannelMembersPage, *Response) {
+	query := fmt.Sprintf("?sort=%v&per_page=%v&cursor=%v", url.QueryEscape(sortBy), perPage, url.QueryEscape(cursor))
+	r, err := c.DoApiGet(fmt.Sprintf(c.GetUserRoute(userId)+"/teams/%v/channels/members", teamId)+query, "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return ChannelMembersPageFromJson(r.Body), BuildResponse(r)
+}
+
 // ViewChannel performs a view action for a user. Synonymous with switching channels or marking channels as read by a user.
 func (c *Client4) ViewChannel(userId string, view *ChannelView) (*ChannelViewResponse, *Response) {
 	url := fmt.Sprintf(c.GetChannelsRoute()+"/members/%v/view", userId)
@@ -2654,6 +3129,18 @@ func (c *Client4) UpdateChannelRoles(channelId, userId, roles string) (bool, *Re
 	return CheckStatusOK(r), BuildResponse(r)
 }
 
+// UpdateChannelRolesWithExpiry updates the roles of a channel member, granting the explicit roles
+// until expiresAt.
+func (c *Client4) UpdateChannelRolesWithExpiry(channelId, userId, roles string, expiresAt int64) (bool, *Response) {
+	requestBody := map[string]string{"roles": roles, "expires_at": strconv.FormatInt(expiresAt, 10)}
+	r, err := c.DoApiPut(c.GetChannelMemberRoute(channelId, userId)+"/roles", MapToJson(requestBody))
+	if err != nil {
+		return false, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return CheckStatusOK(r), BuildResponse(r)
+}
+
 // UpdateChannelMemberSchemeRoles will update the scheme-derived roles on a channel for a user.
 func (c *Client4) UpdateChannelMemberSchemeRoles(channelId string, userId string, schemeRoles *SchemeRoles) (bool, *Response) {
 	r, err := c.DoApiPut(c.GetChannelMemberRoute(channelId, userId)+"/schemeRoles", schemeRoles.ToJson())
@@ -2728,6 +3215,56 @@ func (c *Client4) AutocompleteChannelsForTeamForSearch(teamId, name string) (*Ch
 	return ChannelListFromJson(r.Body), BuildResponse(r)
 }
 
+// GetChannelBookmarks returns the bookmarks of a channel, sorted by their sort order.
+func (c *Client4) GetChannelBookmarks(channelId string) ([]*ChannelBookmark, *Response) {
+	r, err := c.DoApiGet(c.GetChannelBookmarksRoute(channelId), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return ChannelBookmarkListFromJson(r.Body), BuildResponse(r)
+}
+
+// GetChannelBookmark returns a single bookmark of a channel.
+func (c *Client4) GetChannelBookmark(channelId, bookmarkId string) (*ChannelBookmark, *Response) {
+	r, err := c.DoApiGet(c.GetChannelBookmarkRoute(channelId, bookmarkId), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return ChannelBookmarkFromJson(r.Body), BuildResponse(r)
+}
+
+// CreateChannelBookmark adds a link or file bookmark to a channel.
+func (c *Client4) CreateChannelBookmark(bookmark *ChannelBookmark) (*ChannelBookmark, *Response) {
+	r, err := c.DoApiPost(c.GetChannelBookmarksRoute(bookmark.ChannelId), bookmark.ToJson())
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return ChannelBookmarkFromJson(r.Body), BuildResponse(r)
+}
+
+// PatchChannelBookmark partially updates a bookmark of a channel. Any missing fields are not updated.
+func (c *Client4) PatchChannelBookmark(channelId, bookmarkId string, patch *ChannelBookmarkPatch) (*ChannelBookmark, *Response) {
+	r, err := c.DoApiPut(c.GetChannelBookmarkRoute(channelId, bookmarkId), patch.ToJson())
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return ChannelBookmarkFromJson(r.Body), BuildResponse(r)
+}
+
+// DeleteChannelBookmark removes a bookmark from a channel and returns the deleted bookmark.
+func (c *Client4) DeleteChannelBookmark(channelId, bookmarkId string) (*ChannelBookmark, *Response) {
+	r, err := c.DoApiDelete(c.GetChannelBookmarkRoute(channelId, bookmarkId))
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return ChannelBookmarkFromJson(r.Body), BuildResponse(r)
+}
+
 // Post Section
 
 // CreatePost creates a post based on the provided post struct.
@@ -2800,6 +3337,66 @@ func (c *Client4) UnpinPost(postId string) (bool, *Response) {
 	return CheckStatusOK(r), BuildResponse(r)
 }
 
+// GetPendingPinsForChannel returns the requests to pin posts of a channel requiring pins to be approved.
+func (c *Client4) GetPendingPinsForChannel(channelId string) ([]*PendingPin, *Response) {
+	r, err := c.DoApiGet(c.GetChannelRoute(channelId)+"/pending_pins", "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return PendingPinListFromJson(r.Body), BuildResponse(r)
+}
+
+// ApprovePendingPin pins a post whose pinning was requested, returning the pinned post.
+func (c *Client4) ApprovePendingPin(postId string) (*Post, *Response) {
+	r, err := c.DoApiPost(c.GetPostRoute(postId)+"/pin/approve", "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return PostFromJson(r.Body), BuildResponse(r)
+}
+
+// RejectPendingPin rejects the request to pin a post.
+func (c *Client4) RejectPendingPin(postId string) (bool, *Response) {
+	r, err := c.DoApiPost(c.GetPostRoute(postId)+"/pin/reject", "")
+	if err != nil {
+		return false, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return CheckStatusOK(r), BuildResponse(r)
+}
+
+// GetPostAcknowledgements returns the acknowledgements of a post requesting them.
+func (c *Client4) GetPostAcknowledgements(postId string) ([]*PostAcknowledgement, *Response) {
+	r, err := c.DoApiGet(c.GetPostRoute(postId)+"/acks", "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return PostAcknowledgementListFromJson(r.Body), BuildResponse(r)
+}
+
+// AcknowledgePost acknowledges a post requesting it on behalf of the current user.
+func (c *Client4) AcknowledgePost(postId string) (*PostAcknowledgement, *Response) {
+	r, err := c.DoApiPost(c.GetPostRoute(postId)+"/ack", "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return PostAcknowledgementFromJson(r.Body), BuildResponse(r)
+}
+
+// UnacknowledgePost removes the acknowledgement of a post by the current user.
+func (c *Client4) UnacknowledgePost(postId string) (bool, *Response) {
+	r, err := c.DoApiDelete(c.GetPostRoute(postId) + "/ack")
+	if err != nil {
+		return false, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return CheckStatusOK(r), BuildResponse(r)
+}
+
 // GetPost gets a single post.
 func (c *Client4) GetPost(postId string, etag string) (*Post, *Response) {
 	r, err := c.DoApiGet(c.GetPostRoute(postId), etag)
@@ -2893,10 +3490,36 @@ func (c *Client4) GetPostsSince(channelId string, time int64) (*PostList, *Respo
 	return PostListFromJson(r.Body), BuildResponse(r)
 }
 
-// GetPostsAfter gets a page of posts that were posted after the post provided.
-func (c *Client4) GetPostsAfter(channelId, postId string, page, perPage int, etag string) (*PostList, *Response) {
-	query := fmt.Sprintf("?page=%v&per_page=%v&after=%v", page, perPage, postId)
-	r, err := c.DoApiGet(c.GetChannelRoute(channelId)+"/posts"+query, etag)
+// GetPostsDelta gets the changes to the posts of a channel since a specified time as Unix time in
+// milliseconds, or past the token returned by a previous call when one is given.
+func (c *Client4) GetPostsDelta(channelId string, since int64, token string) (*PostsDelta, *Response) {
+	query := fmt.Sprintf("?since=%v", since)
+	if token != "" {
+		query = "?token=" + url.QueryEscape(token)
+	}
+	r, err := c.DoApiGet(c.GetChannelRoute(channelId)+"/posts/delta"+query, "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return PostsDeltaFromJson(r.Body), BuildResponse(r)
+}
+
+// GetChannelChanges gets the changes to the posts of a channel past the given change sequence
+// number, along with the current state of the changed posts.
+func (c *Client4) GetChannelChanges(channelId string, sinceSeq int64) (*ChannelChanges, *Response) {
+	r, err := c.DoApiGet(c.GetChannelRoute(channelId)+fmt.Sprintf("/changes?since_seq=%v", sinceSeq), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return ChannelChangesFromJson(r.Body), BuildResponse(r)
+}
+
+// GetPostsAfter gets a page of posts that were posted after the post provided.
+func (c *Client4) GetPostsAfter(channelId, postId string, page, perPage int, etag string) (*PostList, *Response) {
+	query := fmt.Sprintf("?page=%v&per_page=%v&after=%v", page, perPage, postId)
+	r, err := c.DoApiGet(c.GetChannelRoute(channelId)+"/posts"+query, etag)
 	if err != nil {
 		return nil, BuildErrorResponse(r, err)
 	}
@@ -3161,6 +3784,17 @@ func (c *Client4) GetFileInfo(fileId string) (*FileInfo, *Response) {
 	return FileInfoFromJson(r.Body), BuildResponse(r)
 }
 
+// SetFileSensitive marks or unmarks a file as sensitive, causing every download of it to be audited.
+func (c *Client4) SetFileSensitive(fileId string, sensitive bool) (*FileInfo, *Response) {
+	requestBody := map[string]interface{}{"sensitive": sensitive}
+	r, err := c.DoApiPut(c.GetFileRoute(fileId)+"/sensitive", StringInterfaceToJson(requestBody))
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return FileInfoFromJson(r.Body), BuildResponse(r)
+}
+
 // GetFileInfosForPost gets all the file info objects attached to a post.
 func (c *Client4) GetFileInfosForPost(postId string, etag string) ([]*FileInfo, *Response) {
 	r, err := c.DoApiGet(c.GetPostRoute(postId)+"/files/info", etag)
@@ -3298,6 +3932,17 @@ func (c *Client4) DatabaseRecycle() (bool, *Response) {
 	return CheckStatusOK(r), BuildResponse(r)
 }
 
+// GetSchemaMigrationStatus returns the progress of the database schema migration running in the
+// cluster, or the outcome of the last one.
+func (c *Client4) GetSchemaMigrationStatus() (*SchemaMigrationStatus, *Response) {
+	r, err := c.DoApiGet(c.GetDatabaseRoute()+"/migration", "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return SchemaMigrationStatusFromJson(r.Body), BuildResponse(r)
+}
+
 // InvalidateCaches will purge the cache and can affect the performance while is cleaning.
 func (c *Client4) InvalidateCaches() (bool, *Response) {
 	r, err := c.DoApiPost(c.GetCacheRoute()+"/invalidate", "")
@@ -3384,6 +4029,29 @@ func (c *Client4) GetAnalyticsOld(name, teamId string) (AnalyticsRows, *Response
 	return AnalyticsRowsFromJson(r.Body), BuildResponse(r)
 }
 
+// GetSeatUsageForecast returns the licensed seats used over the given number of days and the day
+// the seat count of the license is projected to be exceeded. Must have manage_system permission.
+func (c *Client4) GetSeatUsageForecast(days int) (*SeatUsageForecast, *Response) {
+	r, err := c.DoApiGet(c.GetAnalyticsRoute()+fmt.Sprintf("/seat_usage?days=%v", days), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return SeatUsageForecastFromJson(r.Body), BuildResponse(r)
+}
+
+// GetIntegrationsUsage returns the daily activity of the bots, webhooks and slash commands over the
+// given number of days, along with the integrations left unused over that period. Must have
+// manage_system permission.
+func (c *Client4) GetIntegrationsUsage(days int) (*IntegrationsUsageReport, *Response) {
+	r, err := c.DoApiGet(c.GetAnalyticsRoute()+fmt.Sprintf("/integrations?days=%v", days), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return IntegrationsUsageReportFromJson(r.Body), BuildResponse(r)
+}
+
 // Webhooks Section
 
 // CreateIncomingWebhook creates an incoming webhook for a channel.
@@ -3531,6 +4199,57 @@ func (c *Client4) DeleteOutgoingWebhook(hookId string) (bool, *Response) {
 	return CheckStatusOK(r), BuildResponse(r)
 }
 
+// CreatePresenceWebhook creates a webhook triggered by status transitions of the given users.
+func (c *Client4) CreatePresenceWebhook(hook *PresenceWebhook) (*PresenceWebhook, *Response) {
+	r, err := c.DoApiPost(c.GetPresenceWebhooksRoute(), hook.ToJson())
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return PresenceWebhookFromJson(r.Body), BuildResponse(r)
+}
+
+// UpdatePresenceWebhook updates a presence webhook.
+func (c *Client4) UpdatePresenceWebhook(hook *PresenceWebhook) (*PresenceWebhook, *Response) {
+	r, err := c.DoApiPut(c.GetPresenceWebhookRoute(hook.Id), hook.ToJson())
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return PresenceWebhookFromJson(r.Body), BuildResponse(r)
+}
+
+// GetPresenceWebhooks returns a page of presence webhooks on the system. Page counting starts at 0.
+func (c *Client4) GetPresenceWebhooks(page int, perPage int, etag string) ([]*PresenceWebhook, *Response) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoApiGet(c.GetPresenceWebhooksRoute()+query, etag)
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return PresenceWebhookListFromJson(r.Body), BuildResponse(r)
+}
+
+// GetPresenceWebhook returns the presence webhook requested by Hook Id.
+func (c *Client4) GetPresenceWebhook(hookId string) (*PresenceWebhook, *Response) {
+	r, err := c.DoApiGet(c.GetPresenceWebhookRoute(hookId), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return PresenceWebhookFromJson(r.Body), BuildResponse(r)
+}
+
+// DeletePresenceWebhook deletes the presence webhook requested by Hook Id.
+func (c *Client4) DeletePresenceWebhook(hookId string) (bool, *Response) {
+	r, err := c.DoApiDelete(c.GetPresenceWebhookRoute(hookId))
+	if err != nil {
+		return false, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return CheckStatusOK(r), BuildResponse(r)
+}
+
 // Preferences Section
 
 // GetPreferences returns the user's preferences.
@@ -3771,6 +4490,16 @@ func (c *Client4) DownloadComplianceReport(reportId string) ([]byte, *Response)
 	return data, BuildResponse(rp)
 }
 
+// VerifyChannelIntegrity verifies the hash chain of the posts of a channel and returns the report.
+func (c *Client4) VerifyChannelIntegrity(channelId string) (*PostIntegrityReport, *Response) {
+	r, err := c.DoApiGet(c.GetChannelIntegrityRoute(channelId), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return PostIntegrityReportFromJson(r.Body), BuildResponse(r)
+}
+
 // Cluster Section
 
 // GetClusterStatus returns the status of all the configured cluster nodes.
@@ -3783,6 +4512,31 @@ func (c *Client4) GetClusterStatus() ([]*ClusterInfo, *Response) {
 	return ClusterInfosFromJson(r.Body), BuildResponse(r)
 }
 
+// GetWebSocketConnections returns the websocket connections open anywhere in the cluster, or only
+// those of the given user if userId isn't empty.
+func (c *Client4) GetWebSocketConnections(userId string) ([]*WebConnInfo, *Response) {
+	query := ""
+	if userId != "" {
+		query = "?user_id=" + userId
+	}
+	r, err := c.DoApiGet(c.GetClusterRoute()+"/websocket_connections"+query, "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return WebConnInfosFromJson(r.Body), BuildResponse(r)
+}
+
+// DisconnectWebSocketConnection closes the websocket connection with the given id.
+func (c *Client4) DisconnectWebSocketConnection(connectionId string) (bool, *Response) {
+	r, err := c.DoApiDelete(c.GetClusterRoute() + "/websocket_connections/" + connectionId)
+	if err != nil {
+		return false, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return CheckStatusOK(r), BuildResponse(r)
+}
+
 // LDAP Section
 
 // SyncLdap will force a sync with the configured LDAP server.
@@ -4069,6 +4823,28 @@ func (c *Client4) GetLogs(page, perPage int) ([]string, *Response) {
 	return ArrayFromJson(r.Body), BuildResponse(r)
 }
 
+// ExportDiagnostics returns the diagnostics the server would send, as they would be sent. Must
+// have manage_system permission.
+func (c *Client4) ExportDiagnostics() (*DiagnosticsExport, *Response) {
+	r, err := c.DoApiGet(c.GetSystemRoute()+"/diagnostics/export", "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return DiagnosticsExportFromJson(r.Body), BuildResponse(r)
+}
+
+// GetErrorCatalog returns the errors the server may return, along with their HTTP status codes
+// and their messages in the locale of the user.
+func (c *Client4) GetErrorCatalog() ([]*ErrorCatalogEntry, *Response) {
+	r, err := c.DoApiGet(c.GetSystemRoute()+"/errors", "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return ErrorCatalogEntryListFromJson(r.Body), BuildResponse(r)
+}
+
 // PostLog is a convenience Web Service call so clients can log messages into
 // the server-side logs. For example we typically log javascript error messages
 // into the server-side. It returns the log message if the logging was successful.
@@ -4211,6 +4987,56 @@ func (c *Client4) GetOAuthAccessToken(data url.Values) (*AccessResponse, *Respon
 	return AccessResponseFromJson(rp.Body), BuildResponse(rp)
 }
 
+// Embed Section
+
+// GetEmbedConfig returns the origins allowed to embed channel views on behalf of an OAuth app,
+// which an embedded frame may accept an embed token from.
+func (c *Client4) GetEmbedConfig(appId string) (*EmbedConfig, *Response) {
+	r, err := c.DoApiGet(c.GetEmbedRoute()+"/apps/"+appId+"/config", "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return EmbedConfigFromJson(r.Body), BuildResponse(r)
+}
+
+// CreateEmbedToken mints a short-lived token to embed the view of a channel. Must be called with
+// the OAuth access token of the embedding app.
+func (c *Client4) CreateEmbedToken(channelId string) (*EmbedToken, *Response) {
+	requestBody := map[string]string{"channel_id": channelId}
+	r, err := c.DoApiPost(c.GetEmbedRoute()+"/tokens", MapToJson(requestBody))
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return EmbedTokenFromJson(r.Body), BuildResponse(r)
+}
+
+// ExchangeEmbedToken exchanges an embed token for a session restricted to the embedded channel,
+// on behalf of the parent application at the given origin. The origin is sent as the Origin
+// header, which browsers set themselves.
+func (c *Client4) ExchangeEmbedToken(token, origin string) (*Session, *Response) {
+	url := c.GetEmbedRoute() + "/tokens/exchange"
+	requestBody := map[string]string{"token": token}
+	rq, err := http.NewRequest(http.MethodPost, c.ApiUrl+url, strings.NewReader(MapToJson(requestBody)))
+	if err != nil {
+		return nil, &Response{Error: NewAppError(url, "model.client.connecting.app_error", nil, err.Error(), http.StatusBadRequest)}
+	}
+	rq.Header.Set("Origin", origin)
+
+	rp, err := c.HttpClient.Do(rq)
+	if err != nil || rp == nil {
+		return nil, BuildErrorResponse(rp, NewAppError(url, "model.client.connecting.app_error", nil, err.Error(), 0))
+	}
+	defer closeBody(rp)
+
+	if rp.StatusCode >= 300 {
+		return nil, BuildErrorResponse(rp, AppErrorFromJson(rp.Body))
+	}
+
+	return SessionFromJson(rp.Body), BuildResponse(rp)
+}
+
 // Elasticsearch Section
 
 // TestElasticsearch will attempt to connect to the configured Elasticsearch server and return OK if configured.
@@ -4258,6 +5084,89 @@ func (c *Client4) GetDataRetentionPolicy() (*DataRetentionPolicy, *Response) {
 	return DataRetentionPolicyFromJson(r.Body), BuildResponse(r)
 }
 
+// GetRetentionPolicies returns a page of the granular data retention policies.
+func (c *Client4) GetRetentionPolicies(page, perPage int) ([]*RetentionPolicy, *Response) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoApiGet(c.GetRetentionPoliciesRoute()+query, "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return RetentionPolicyListFromJson(r.Body), BuildResponse(r)
+}
+
+// GetRetentionPolicy returns a granular data retention policy.
+func (c *Client4) GetRetentionPolicy(policyId string) (*RetentionPolicy, *Response) {
+	r, err := c.DoApiGet(c.GetRetentionPolicyRoute(policyId), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return RetentionPolicyFromJson(r.Body), BuildResponse(r)
+}
+
+// CreateRetentionPolicy creates a data retention policy for a set of teams and channels.
+func (c *Client4) CreateRetentionPolicy(policy *RetentionPolicy) (*RetentionPolicy, *Response) {
+	r, err := c.DoApiPost(c.GetRetentionPoliciesRoute(), policy.ToJson())
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return RetentionPolicyFromJson(r.Body), BuildResponse(r)
+}
+
+// UpdateRetentionPolicy replaces a data retention policy, including its teams and channels.
+func (c *Client4) UpdateRetentionPolicy(policy *RetentionPolicy) (*RetentionPolicy, *Response) {
+	r, err := c.DoApiPut(c.GetRetentionPolicyRoute(policy.Id), policy.ToJson())
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return RetentionPolicyFromJson(r.Body), BuildResponse(r)
+}
+
+// DeleteRetentionPolicy deletes a data retention policy. Its teams and channels go back to the global policy.
+func (c *Client4) DeleteRetentionPolicy(policyId string) (bool, *Response) {
+	r, err := c.DoApiDelete(c.GetRetentionPolicyRoute(policyId))
+	if err != nil {
+		return false, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return CheckStatusOK(r), BuildResponse(r)
+}
+
+// GetTeamsForRetentionPolicy returns a page of the teams governed by a granular data retention policy.
+func (c *Client4) GetTeamsForRetentionPolicy(policyId string, page, perPage int) ([]*Team, *Response) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoApiGet(c.GetRetentionPolicyRoute(policyId)+"/teams"+query, "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return TeamListFromJson(r.Body), BuildResponse(r)
+}
+
+// GetRetentionPolicyForTeam returns the granular data retention policy governing a team.
+func (c *Client4) GetRetentionPolicyForTeam(teamId string) (*RetentionPolicy, *Response) {
+	r, err := c.DoApiGet(c.GetTeamRoute(teamId)+"/data_retention_policy", "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return RetentionPolicyFromJson(r.Body), BuildResponse(r)
+}
+
+// SetRetentionPolicyForTeam makes a granular data retention policy govern a team. An empty policyId
+// leaves the team to the global data retention settings.
+func (c *Client4) SetRetentionPolicyForTeam(teamId, policyId string) (bool, *Response) {
+	r, err := c.DoApiPut(c.GetTeamRoute(teamId)+"/data_retention_policy", MapToJson(map[string]string{"policy_id": policyId}))
+	if err != nil {
+		return false, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return CheckStatusOK(r), BuildResponse(r)
+}
+
 // Commands Section
 
 // CreateCommand will create a new command if the user have the right permissions.
@@ -4420,6 +5329,38 @@ func (c *Client4) UpdateUserStatus(userId string, userStatus *Status) (*Status,
 	return StatusFromJson(r.Body), BuildResponse(r)
 }
 
+// GetUserStatusAutomation returns the automation of the status of a user, if any.
+func (c *Client4) GetUserStatusAutomation(userId string) (*StatusAutomation, *Response) {
+	r, err := c.DoApiGet(c.GetUserStatusRoute(userId)+"/automation", "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return StatusAutomationFromJson(r.Body), BuildResponse(r)
+}
+
+// StartUserStatusAutomation sets the status of a user to do not disturb until the given time,
+// after which the previous status of the user is restored.
+func (c *Client4) StartUserStatusAutomation(userId string, request *StatusAutomationRequest) (*StatusAutomation, *Response) {
+	r, err := c.DoApiPut(c.GetUserStatusRoute(userId)+"/automation", request.ToJson())
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return StatusAutomationFromJson(r.Body), BuildResponse(r)
+}
+
+// EndUserStatusAutomation ends the automation of the status of a user right away, restoring
+// their previous status.
+func (c *Client4) EndUserStatusAutomation(userId string) (bool, *Response) {
+	r, err := c.DoApiDelete(c.GetUserStatusRoute(userId) + "/automation")
+	if err != nil {
+		return false, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return CheckStatusOK(r), BuildResponse(r)
+}
+
 // Emoji Section
 
 // CreateEmoji will save an emoji to the server if the current user has permission
@@ -5037,6 +5978,103 @@ func (c *Client4) GetServerBusyExpires() (*time.Time, *Response) {
 	return &expires, BuildResponse(r)
 }
 
+// CreateDebugCaptureRule starts capturing the requests matching the rule, on the node serving the
+// request, until the rule expires.
+func (c *Client4) CreateDebugCaptureRule(rule *DebugCaptureRule) (*DebugCaptureRule, *Response) {
+	r, err := c.DoApiPost(c.GetDebugCaptureRoute()+"/rules", rule.ToJson())
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return DebugCaptureRuleFromJson(r.Body), BuildResponse(r)
+}
+
+// GetDebugCaptureRules returns the active debug capture rules of the node serving the request.
+func (c *Client4) GetDebugCaptureRules() ([]*DebugCaptureRule, *Response) {
+	r, err := c.DoApiGet(c.GetDebugCaptureRoute()+"/rules", "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return DebugCaptureRuleListFromJson(r.Body), BuildResponse(r)
+}
+
+// DeleteDebugCaptureRule stops capturing the requests matching the rule.
+func (c *Client4) DeleteDebugCaptureRule(ruleId string) (bool, *Response) {
+	r, err := c.DoApiDelete(c.GetDebugCaptureRoute() + "/rules/" + ruleId)
+	if err != nil {
+		return false, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return CheckStatusOK(r), BuildResponse(r)
+}
+
+// GetDebugCaptures returns the requests captured by the rule, or by any rule if ruleId is empty,
+// on the node serving the request.
+func (c *Client4) GetDebugCaptures(ruleId string) ([]*DebugCapture, *Response) {
+	query := ""
+	if ruleId != "" {
+		query = "?rule_id=" + url.QueryEscape(ruleId)
+	}
+	r, err := c.DoApiGet(c.GetDebugCaptureRoute()+query, "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return DebugCaptureListFromJson(r.Body), BuildResponse(r)
+}
+
+// GenerateSupportPacket returns the zip archive of the support packet of the server.
+func (c *Client4) GenerateSupportPacket() ([]byte, *Response) {
+	r, appErr := c.DoApiGet(c.GetSystemRoute()+"/support_packet", "")
+	if appErr != nil {
+		return nil, BuildErrorResponse(r, appErr)
+	}
+	defer closeBody(r)
+
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, BuildErrorResponse(r, NewAppError("GenerateSupportPacket", "model.client.read_file.app_error", nil, err.Error(), r.StatusCode))
+	}
+	return data, BuildResponse(r)
+}
+
+// CaptureProfile starts capturing a profile of the node serving the request, returning the job
+// recording it.
+func (c *Client4) CaptureProfile(request *ProfileCaptureRequest) (*Job, *Response) {
+	r, err := c.DoApiPost(c.GetProfilesRoute(), request.ToJson())
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return JobFromJson(r.Body), BuildResponse(r)
+}
+
+// GetProfiles returns a page of the profile capture jobs.
+func (c *Client4) GetProfiles(page int, perPage int) ([]*Job, *Response) {
+	r, err := c.DoApiGet(c.GetProfilesRoute()+fmt.Sprintf("?page=%v&per_page=%v", page, perPage), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return JobsFromJson(r.Body), BuildResponse(r)
+}
+
+// DownloadProfile returns the profile captured by the job.
+func (c *Client4) DownloadProfile(jobId string) ([]byte, *Response) {
+	r, appErr := c.DoApiGet(c.GetProfilesRoute()+"/"+jobId+"/download", "")
+	if appErr != nil {
+		return nil, BuildErrorResponse(r, appErr)
+	}
+	defer closeBody(r)
+
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, BuildErrorResponse(r, NewAppError("DownloadProfile", "model.client.read_file.app_error", nil, err.Error(), r.StatusCode))
+	}
+	return data, BuildResponse(r)
+}
+
 // RegisterTermsOfServiceAction saves action performed by a user against a specific terms of service.
 func (c *Client4) RegisterTermsOfServiceAction(userId, termsOfServiceId string, accepted bool) (*bool, *Response) {
 	url := c.GetUserTermsOfServiceRoute(userId)
@@ -5083,6 +6121,125 @@ func (c *Client4) CreateTermsOfService(text, userId string) (*TermsOfService, *R
 	return TermsOfServiceFromJson(r.Body), BuildResponse(r)
 }
 
+// GetPendingTermsOfServicePolicyVersions returns the terms of service policy versions the current
+// user has yet to accept.
+func (c *Client4) GetPendingTermsOfServicePolicyVersions() ([]*TermsOfServicePolicyVersion, *Response) {
+	r, err := c.DoApiGet(c.GetTermsOfServiceRoute()+"/pending", "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return TermsOfServicePolicyVersionListFromJson(r.Body), BuildResponse(r)
+}
+
+// GetTermsOfServicePolicies returns a page of the terms of service policies.
+func (c *Client4) GetTermsOfServicePolicies(page, perPage int) ([]*TermsOfServicePolicy, *Response) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoApiGet(c.GetTermsOfServicePoliciesRoute()+query, "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return TermsOfServicePolicyListFromJson(r.Body), BuildResponse(r)
+}
+
+// GetTermsOfServicePolicy returns a terms of service policy.
+func (c *Client4) GetTermsOfServicePolicy(policyId string) (*TermsOfServicePolicy, *Response) {
+	r, err := c.DoApiGet(c.GetTermsOfServicePolicyRoute(policyId), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return TermsOfServicePolicyFromJson(r.Body), BuildResponse(r)
+}
+
+// CreateTermsOfServicePolicy creates a terms of service policy for the members of a set of teams and groups.
+func (c *Client4) CreateTermsOfServicePolicy(policy *TermsOfServicePolicy) (*TermsOfServicePolicy, *Response) {
+	r, err := c.DoApiPost(c.GetTermsOfServicePoliciesRoute(), policy.ToJson())
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return TermsOfServicePolicyFromJson(r.Body), BuildResponse(r)
+}
+
+// UpdateTermsOfServicePolicy replaces a terms of service policy, including its teams and groups.
+func (c *Client4) UpdateTermsOfServicePolicy(policy *TermsOfServicePolicy) (*TermsOfServicePolicy, *Response) {
+	r, err := c.DoApiPut(c.GetTermsOfServicePolicyRoute(policy.Id), policy.ToJson())
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return TermsOfServicePolicyFromJson(r.Body), BuildResponse(r)
+}
+
+// DeleteTermsOfServicePolicy stops enforcing a terms of service policy.
+func (c *Client4) DeleteTermsOfServicePolicy(policyId string) (bool, *Response) {
+	r, err := c.DoApiDelete(c.GetTermsOfServicePolicyRoute(policyId))
+	if err != nil {
+		return false, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return CheckStatusOK(r), BuildResponse(r)
+}
+
+// PublishTermsOfServicePolicyVersion publishes a new version of a terms of service policy, which the
+// targeted users then have to accept.
+func (c *Client4) PublishTermsOfServicePolicyVersion(policyId, text string) (*TermsOfServicePolicyVersion, *Response) {
+	data := map[string]string{"text": text}
+	r, err := c.DoApiPost(c.GetTermsOfServicePolicyRoute(policyId)+"/versions", MapToJson(data))
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return TermsOfServicePolicyVersionFromJson(r.Body), BuildResponse(r)
+}
+
+// GetLatestTermsOfServicePolicyVersion returns the latest version of a terms of service policy.
+func (c *Client4) GetLatestTermsOfServicePolicyVersion(policyId string) (*TermsOfServicePolicyVersion, *Response) {
+	r, err := c.DoApiGet(c.GetTermsOfServicePolicyRoute(policyId)+"/versions/latest", "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return TermsOfServicePolicyVersionFromJson(r.Body), BuildResponse(r)
+}
+
+// AcceptTermsOfServicePolicyVersion accepts the latest version of a terms of service policy on behalf
+// of the current user.
+func (c *Client4) AcceptTermsOfServicePolicyVersion(policyId, versionId string) (bool, *Response) {
+	data := map[string]string{"version_id": versionId}
+	r, err := c.DoApiPost(c.GetTermsOfServicePolicyRoute(policyId)+"/accept", MapToJson(data))
+	if err != nil {
+		return false, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return CheckStatusOK(r), BuildResponse(r)
+}
+
+// GetTermsOfServicePolicyReport returns how many of the targeted users accepted the latest version
+// of a terms of service policy.
+func (c *Client4) GetTermsOfServicePolicyReport(policyId string) (*TermsOfServicePolicyReport, *Response) {
+	r, err := c.DoApiGet(c.GetTermsOfServicePolicyRoute(policyId)+"/report", "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return TermsOfServicePolicyReportFromJson(r.Body), BuildResponse(r)
+}
+
+// GetTermsOfServicePolicyOutstandingUsers returns a page of the targeted users who haven't accepted
+// the latest version of a terms of service policy.
+func (c *Client4) GetTermsOfServicePolicyOutstandingUsers(policyId string, page, perPage int) ([]*User, *Response) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoApiGet(c.GetTermsOfServicePolicyRoute(policyId)+"/report/outstanding"+query, "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return UserListFromJson(r.Body), BuildResponse(r)
+}
+
 func (c *Client4) GetGroup(groupID, etag string) (*Group, *Response) {
 	r, appErr := c.DoApiGet(c.GetGroupRoute(groupID), etag)
 	if appErr != nil {
@@ -5333,3 +6490,220 @@ func (c *Client4) UpdateSidebarCategoryForTeamForUser(userID, teamID, categoryID
 
 	return cat, BuildResponse(r)
 }
+
+// User Properties Section
+
+// GetUserPropertyFields returns the custom profile fields defined by the admins.
+func (c *Client4) GetUserPropertyFields() ([]*UserPropertyField, *Response) {
+	r, err := c.DoApiGet(c.GetUserPropertyFieldsRoute(), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return UserPropertyFieldListFromJson(r.Body), BuildResponse(r)
+}
+
+// CreateUserPropertyField defines a new custom profile field.
+func (c *Client4) CreateUserPropertyField(field *UserPropertyField) (*UserPropertyField, *Response) {
+	r, err := c.DoApiPost(c.GetUserPropertyFieldsRoute(), field.ToJson())
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return UserPropertyFieldFromJson(r.Body), BuildResponse(r)
+}
+
+// UpdateUserPropertyField replaces the definition of a custom profile field.
+func (c *Client4) UpdateUserPropertyField(field *UserPropertyField) (*UserPropertyField, *Response) {
+	r, err := c.DoApiPut(c.GetUserPropertyFieldRoute(field.Id), field.ToJson())
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return UserPropertyFieldFromJson(r.Body), BuildResponse(r)
+}
+
+// DeleteUserPropertyField removes a custom profile field along with the values users had for it.
+func (c *Client4) DeleteUserPropertyField(fieldId string) (bool, *Response) {
+	r, err := c.DoApiDelete(c.GetUserPropertyFieldRoute(fieldId))
+	if err != nil {
+		return false, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return CheckStatusOK(r), BuildResponse(r)
+}
+
+// GetUserPropertyValues returns the values of the custom profile fields of a user, keyed by field id.
+func (c *Client4) GetUserPropertyValues(userId string) (map[string]string, *Response) {
+	r, err := c.DoApiGet(c.GetUserPropertiesRoute(userId), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return MapFromJson(r.Body), BuildResponse(r)
+}
+
+// PatchUserPropertyValues sets the values, keyed by field id, of custom profile fields of a user.
+// Empty values clear the fields.
+func (c *Client4) PatchUserPropertyValues(userId string, patch map[string]string) (map[string]string, *Response) {
+	r, err := c.DoApiPut(c.GetUserPropertiesRoute(userId)+"/patch", MapToJson(patch))
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return MapFromJson(r.Body), BuildResponse(r)
+}
+
+// Org Chart Section
+
+// SetUserManager changes the manager of a user. An empty managerId removes the manager.
+func (c *Client4) SetUserManager(userId, managerId string) (*User, *Response) {
+	r, err := c.DoApiPut(c.GetUserRoute(userId)+"/manager", MapToJson(map[string]string{"manager_id": managerId}))
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return UserFromJson(r.Body), BuildResponse(r)
+}
+
+// GetDirectReports returns a page of the active users that report to a user.
+func (c *Client4) GetDirectReports(userId string, page, perPage int) ([]*User, *Response) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoApiGet(c.GetUserRoute(userId)+"/direct_reports"+query, "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return UserListFromJson(r.Body), BuildResponse(r)
+}
+
+// GetOrgChart returns the reporting chain and the direct reports of a user.
+func (c *Client4) GetOrgChart(userId string) (*OrgChart, *Response) {
+	r, err := c.DoApiGet(c.GetUserRoute(userId)+"/org_chart", "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return OrgChartFromJson(r.Body), BuildResponse(r)
+}
+
+// Team Directory Section
+
+// SearchTeamDirectory returns a page of the open teams matching the term and category, ranked by
+// activity and size. Empty terms and categories match every team.
+func (c *Client4) SearchTeamDirectory(term, category string, page, perPage int) (*TeamDirectory, *Response) {
+	query := fmt.Sprintf("?term=%v&category=%v&page=%v&per_page=%v", url.QueryEscape(term), url.QueryEscape(category), page, perPage)
+	r, err := c.DoApiGet(c.GetTeamsRoute()+"/directory/search"+query, "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return TeamDirectoryFromJson(r.Body), BuildResponse(r)
+}
+
+// SetTeamDirectoryCategories replaces the categories a team is listed under in the team directory.
+func (c *Client4) SetTeamDirectoryCategories(teamId string, categories []string) (*Team, *Response) {
+	r, err := c.DoApiPut(c.GetTeamRoute(teamId)+"/directory_categories", ArrayToJson(categories))
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return TeamFromJson(r.Body), BuildResponse(r)
+}
+
+// Login History Section
+
+// GetLoginHistory returns a page of the login attempts of all users, from the most recent to the
+// oldest. The attempts can be narrowed down with the options.
+func (c *Client4) GetLoginHistory(options *LoginHistoryGetOptions) ([]*LoginHistory, *Response) {
+	v := loginHistoryQueryValues(options)
+	if options.UserId != "" {
+		v.Set("user_id", options.UserId)
+	}
+
+	r, err := c.DoApiGet(c.GetUsersRoute()+"/login_history?"+v.Encode(), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return LoginHistoryListFromJson(r.Body), BuildResponse(r)
+}
+
+// GetUserLoginHistory returns a page of the login attempts of the user, from the most recent to
+// the oldest.
+func (c *Client4) GetUserLoginHistory(userId string, options *LoginHistoryGetOptions) ([]*LoginHistory, *Response) {
+	v := loginHistoryQueryValues(options)
+
+	r, err := c.DoApiGet(c.GetUserRoute(userId)+"/login_history?"+v.Encode(), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return LoginHistoryListFromJson(r.Body), BuildResponse(r)
+}
+
+func loginHistoryQueryValues(options *LoginHistoryGetOptions) url.Values {
+	v := url.Values{}
+	v.Set("page", strconv.Itoa(options.Page))
+	v.Set("per_page", strconv.Itoa(options.PerPage))
+	if options.Since != 0 {
+		v.Set("since", strconv.FormatInt(options.Since, 10))
+	}
+	if options.Until != 0 {
+		v.Set("until", strconv.FormatInt(options.Until, 10))
+	}
+	if options.OnlyFailures {
+		v.Set("only_failures", "true")
+	}
+	return v
+}
+
+// Email Verification Section
+
+// GetUnverifiedUsers returns a page of the users signed up by email who haven't verified their
+// email address, along with their deadline to do so.
+func (c *Client4) GetUnverifiedUsers(page, perPage int) ([]*UnverifiedUser, *Response) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoApiGet(c.GetUsersRoute()+"/unverified_emails"+query, "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return UnverifiedUserListFromJson(r.Body), BuildResponse(r)
+}
+
+// Service Accounts Section
+
+// CreateServiceAccount creates a service account and returns the user backing it.
+func (c *Client4) CreateServiceAccount(account *ServiceAccount) (*User, *Response) {
+	r, err := c.DoApiPost(c.GetUsersRoute()+"/service_accounts", account.ToJson())
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return UserFromJson(r.Body), BuildResponse(r)
+}
+
+// GetServiceAccounts returns a page of the service accounts ordered by username.
+func (c *Client4) GetServiceAccounts(page, perPage int, includeDeleted bool) ([]*User, *Response) {
+	query := fmt.Sprintf("?page=%v&per_page=%v&include_deleted=%v", page, perPage, includeDeleted)
+	r, err := c.DoApiGet(c.GetUsersRoute()+"/service_accounts"+query, "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return UserListFromJson(r.Body), BuildResponse(r)
+}
+
+// Permission Checks Section
+
+// CheckPermissions asks the server whether the current session has each of the given permissions,
+// returning the results in the same order.
+func (c *Client4) CheckPermissions(checks []*PermissionCheck) ([]*PermissionCheckResult, *Response) {
+	r, err := c.DoApiPost(c.GetUserRoute(ME)+"/permissions/batch", PermissionChecksToJson(checks))
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+	return PermissionCheckResultsFromJson(r.Body), BuildResponse(r)
+}
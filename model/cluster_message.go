@@ -37,11 +37,16 @@ const (
 	CLUSTER_EVENT_INVALIDATE_CACHE_FOR_LAST_POSTS                   = "inv_last_posts"
 	CLUSTER_EVENT_INVALIDATE_CACHE_FOR_LAST_POST_TIME               = "inv_last_post_time"
 	CLUSTER_EVENT_INVALIDATE_CACHE_FOR_TEAMS                        = "inv_teams"
+	CLUSTER_EVENT_INVALIDATE_CACHE_FOR_TEAM_MEMBERS                 = "inv_team_members"
+	CLUSTER_EVENT_INVALIDATE_CACHE_FOR_TEAM_MEMBERSHIPS             = "inv_team_memberships"
 	CLUSTER_EVENT_CLEAR_SESSION_CACHE_FOR_ALL_USERS                 = "inv_all_user_sessions"
 	CLUSTER_EVENT_INSTALL_PLUGIN                                    = "install_plugin"
 	CLUSTER_EVENT_REMOVE_PLUGIN                                     = "remove_plugin"
 	CLUSTER_EVENT_INVALIDATE_CACHE_FOR_TERMS_OF_SERVICE             = "inv_terms_of_service"
 	CLUSTER_EVENT_BUSY_STATE_CHANGED                                = "busy_state_change"
+	CLUSTER_EVENT_INVALIDATE_CACHE_FOR_PERMALINK_PREVIEW            = "inv_permalink_preview"
+	CLUSTER_EVENT_DISCONNECT_WEB_CONN                               = "disconnect_web_conn"
+	CLUSTER_EVENT_INVALIDATE_CACHE_FOR_PRESENCE_WEBHOOKS            = "inv_presence_webhooks"
 
 	// Gossip communication
 	CLUSTER_GOSSIP_EVENT_REQUEST_GET_LOGS             = "gossip_request_get_logs"
@@ -52,6 +57,8 @@ const (
 	CLUSTER_GOSSIP_EVENT_RESPONSE_GET_PLUGIN_STATUSES = "gossip_response_plugin_statuses"
 	CLUSTER_GOSSIP_EVENT_REQUEST_SAVE_CONFIG          = "gossip_request_save_config"
 	CLUSTER_GOSSIP_EVENT_RESPONSE_SAVE_CONFIG         = "gossip_response_save_config"
+	CLUSTER_GOSSIP_EVENT_REQUEST_GET_WEB_CONNS        = "gossip_request_web_conns"
+	CLUSTER_GOSSIP_EVENT_RESPONSE_GET_WEB_CONNS       = "gossip_response_web_conns"
 
 	// SendTypes for ClusterMessage.
 	CLUSTER_SEND_BEST_EFFORT = "best_effort"
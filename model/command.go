@@ -5,6 +5,7 @@ package model
 
 import (
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"strings"
@@ -15,6 +16,11 @@ const (
 	COMMAND_METHOD_GET  = "G"
 	MIN_TRIGGER_LENGTH  = 1
 	MAX_TRIGGER_LENGTH  = 128
+
+	COMMAND_AUTOCOMPLETE_DATA_VERSION_MAX_LENGTH = 64
+	// COMMAND_AUTOCOMPLETE_VERSION_HEADER is the header through which a slash command integration
+	// announces the version of its current autocomplete data when responding to the server.
+	COMMAND_AUTOCOMPLETE_VERSION_HEADER = "X-Mattermost-Autocomplete-Version"
 )
 
 type Command struct {
@@ -35,7 +41,12 @@ type Command struct {
 	DisplayName      string            `json:"display_name"`
 	Description      string            `json:"description"`
 	URL              string            `json:"url"`
-	AutocompleteData *AutocompleteData `db:"-" json:"autocomplete_data,omitempty"`
+	AutocompleteData *AutocompleteData `json:"autocomplete_data,omitempty"`
+	// AutocompleteDataVersion identifies the revision of AutocompleteData, as given by the integration.
+	AutocompleteDataVersion string `json:"autocomplete_data_version"`
+	// AutocompleteDataURL, if set, is where the server fetches the autocomplete data from, whenever
+	// the integration announces a version other than AutocompleteDataVersion.
+	AutocompleteDataURL string `json:"autocomplete_data_url"`
 	// AutocompleteIconData is a base64 encoded svg
 	AutocompleteIconData string `db:"-" json:"autocomplete_icon_data,omitempty"`
 }
@@ -116,6 +127,17 @@ func (o *Command) IsValid() *AppError {
 		if err := o.AutocompleteData.IsValid(); err != nil {
 			return NewAppError("Command.IsValid", "model.command.is_valid.autocomplete_data.app_error", nil, err.Error(), http.StatusBadRequest)
 		}
+		if o.AutocompleteData.Trigger != o.Trigger {
+			return NewAppError("Command.IsValid", "model.command.is_valid.autocomplete_data.app_error", nil, "the trigger of the autocomplete data doesn't match the command", http.StatusBadRequest)
+		}
+	}
+
+	if len(o.AutocompleteDataVersion) > COMMAND_AUTOCOMPLETE_DATA_VERSION_MAX_LENGTH {
+		return NewAppError("Command.IsValid", "model.command.is_valid.autocomplete_data_version.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if o.AutocompleteDataURL != "" && (len(o.AutocompleteDataURL) > 1024 || !IsValidHttpUrl(o.AutocompleteDataURL)) {
+		return NewAppError("Command.IsValid", "model.command.is_valid.autocomplete_data_url.app_error", nil, "", http.StatusBadRequest)
 	}
 
 	return nil
@@ -145,4 +167,23 @@ func (o *Command) Sanitize() {
 	o.URL = ""
 	o.Username = ""
 	o.IconURL = ""
+	o.AutocompleteDataURL = ""
+}
+
+// CommandAutocompleteDefinition is the autocomplete data of a slash command, as served by the
+// integration at the AutocompleteDataURL of the command.
+type CommandAutocompleteDefinition struct {
+	Version          string            `json:"version"`
+	AutocompleteData *AutocompleteData `json:"autocomplete_data"`
+}
+
+func CommandAutocompleteDefinitionFromJson(data io.Reader) (*CommandAutocompleteDefinition, error) {
+	var o *CommandAutocompleteDefinition
+	if err := json.NewDecoder(data).Decode(&o); err != nil {
+		return nil, err
+	}
+	if o == nil || o.AutocompleteData == nil {
+		return nil, errors.New("missing autocomplete data")
+	}
+	return o, nil
 }
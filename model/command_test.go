@@ -4,9 +4,12 @@
 package model
 
 import (
+	"bytes"
+	"encoding/json"
 	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -109,6 +112,42 @@ func TestCommandIsValid(t *testing.T) {
 
 	o.Description = strings.Repeat("1", 128)
 	require.Nil(t, o.IsValid())
+
+	o.AutocompleteData = NewAutocompleteData("other", "", "")
+	require.NotNil(t, o.IsValid(), "should be invalid")
+
+	o.AutocompleteData = NewAutocompleteData(o.Trigger, "", "")
+	require.Nil(t, o.IsValid())
+
+	o.AutocompleteDataVersion = strings.Repeat("1", COMMAND_AUTOCOMPLETE_DATA_VERSION_MAX_LENGTH+1)
+	require.NotNil(t, o.IsValid(), "should be invalid")
+
+	o.AutocompleteDataVersion = "v2"
+	require.Nil(t, o.IsValid())
+
+	o.AutocompleteDataURL = "1234"
+	require.NotNil(t, o.IsValid(), "should be invalid")
+
+	o.AutocompleteDataURL = "https://example.com/autocomplete"
+	require.Nil(t, o.IsValid())
+}
+
+func TestCommandAutocompleteDefinitionFromJson(t *testing.T) {
+	data := NewAutocompleteData("trigger", "[action]", "Run an action")
+	data.AddDynamicListArgument("The action", "https://example.com/actions", true)
+	b, err := json.Marshal(&CommandAutocompleteDefinition{Version: "v1", AutocompleteData: data})
+	require.Nil(t, err)
+
+	definition, err := CommandAutocompleteDefinitionFromJson(bytes.NewReader(b))
+	require.Nil(t, err)
+	assert.Equal(t, "v1", definition.Version)
+	assert.True(t, data.Equals(definition.AutocompleteData))
+
+	_, err = CommandAutocompleteDefinitionFromJson(strings.NewReader(`{"version": "v1"}`))
+	require.NotNil(t, err)
+
+	_, err = CommandAutocompleteDefinitionFromJson(strings.NewReader("junk"))
+	require.NotNil(t, err)
 }
 
 func TestCommandPreSave(t *testing.T) {
@@ -100,6 +100,28 @@ const (
 	SERVICE_SETTINGS_DEFAULT_GFYCAT_API_KEY     = "2_KtH_W5"
 	SERVICE_SETTINGS_DEFAULT_GFYCAT_API_SECRET  = "3wLVZPiswc3DnaiaFoLkDvB4X0IV6CpMkj4tf2inJRsBY6-FnkT08zGmppWFgeof"
 
+	SERVICE_SETTINGS_DEFAULT_SEAT_USAGE_NOTIFICATION_DAYS = 30
+	SERVICE_SETTINGS_DEFAULT_IMPERSONATION_SESSION_LENGTH = 30
+	SERVICE_SETTINGS_MAX_IMPERSONATION_SESSION_LENGTH     = 24 * 60
+	SERVICE_SETTINGS_DEFAULT_EMBED_TOKEN_EXPIRY           = 60
+	SERVICE_SETTINGS_MAX_EMBED_TOKEN_EXPIRY               = 10 * 60
+	SERVICE_SETTINGS_DEFAULT_EMBED_SESSION_LENGTH         = 60
+	SERVICE_SETTINGS_MAX_EMBED_SESSION_LENGTH             = 24 * 60
+	SERVICE_SETTINGS_DEFAULT_LOGIN_HISTORY_RETENTION_DAYS = 90
+
+	// The SameSite attribute set on the session cookies. The default leaves the attribute out, letting
+	// the browser decide, while none allows embedding Mattermost in other origins and requires
+	// secure cookies.
+	SESSION_COOKIE_SAME_SITE_DEFAULT = "default"
+	SESSION_COOKIE_SAME_SITE_LAX     = "lax"
+	SESSION_COOKIE_SAME_SITE_STRICT  = "strict"
+	SESSION_COOKIE_SAME_SITE_NONE    = "none"
+
+	// Requests failing the CSRF check are rejected when enforcing, and only logged in report mode so
+	// that the failures can be reviewed before enforcing.
+	CSRF_ENFORCEMENT_MODE_ENFORCE = "enforce"
+	CSRF_ENFORCEMENT_MODE_REPORT  = "report"
+
 	TEAM_SETTINGS_DEFAULT_SITE_NAME                = "Mattermost"
 	TEAM_SETTINGS_DEFAULT_MAX_USERS_PER_TEAM       = 50
 	TEAM_SETTINGS_DEFAULT_CUSTOM_BRAND_TEXT        = ""
@@ -110,7 +132,9 @@ const (
 
 	FILE_SETTINGS_DEFAULT_DIRECTORY = "./data/"
 
-	EMAIL_SETTINGS_DEFAULT_FEEDBACK_ORGANIZATION = ""
+	EMAIL_SETTINGS_DEFAULT_FEEDBACK_ORGANIZATION                = ""
+	EMAIL_SETTINGS_DEFAULT_EMAIL_VERIFICATION_GRACE_PERIOD_DAYS = 0
+	EMAIL_SETTINGS_DEFAULT_EMAIL_VERIFICATION_REMINDER_DAYS     = 2
 
 	SUPPORT_SETTINGS_DEFAULT_TERMS_OF_SERVICE_LINK = "https://about.mattermost.com/default-terms/"
 	SUPPORT_SETTINGS_DEFAULT_PRIVACY_POLICY_LINK   = "https://about.mattermost.com/default-privacy-policy/"
@@ -127,6 +151,7 @@ const (
 	LDAP_SETTINGS_DEFAULT_NICKNAME_ATTRIBUTE           = ""
 	LDAP_SETTINGS_DEFAULT_ID_ATTRIBUTE                 = ""
 	LDAP_SETTINGS_DEFAULT_POSITION_ATTRIBUTE           = ""
+	LDAP_SETTINGS_DEFAULT_MANAGER_ATTRIBUTE            = ""
 	LDAP_SETTINGS_DEFAULT_LOGIN_FIELD_NAME             = ""
 	LDAP_SETTINGS_DEFAULT_GROUP_DISPLAY_NAME_ATTRIBUTE = ""
 	LDAP_SETTINGS_DEFAULT_GROUP_ID_ATTRIBUTE           = ""
@@ -187,6 +212,27 @@ const (
 	DATA_RETENTION_SETTINGS_DEFAULT_MESSAGE_RETENTION_DAYS  = 365
 	DATA_RETENTION_SETTINGS_DEFAULT_FILE_RETENTION_DAYS     = 365
 	DATA_RETENTION_SETTINGS_DEFAULT_DELETION_JOB_START_TIME = "02:00"
+	DATA_RETENTION_SETTINGS_DEFAULT_BATCH_SIZE              = 3000
+	DATA_RETENTION_SETTINGS_DEFAULT_TIME_BETWEEN_BATCHES    = 100
+
+	ARCHIVE_SETTINGS_DEFAULT_ARCHIVE_AFTER_MONTHS = 24
+	ARCHIVE_SETTINGS_DEFAULT_JOB_START_TIME       = "03:00"
+	ARCHIVE_SETTINGS_DEFAULT_BATCH_SIZE           = 1000
+
+	BACKUP_SETTINGS_DEFAULT_DIRECTORY               = "./backups/"
+	BACKUP_SETTINGS_DEFAULT_INTERVAL_HOURS          = 24
+	BACKUP_SETTINGS_DEFAULT_MAX_INCREMENTAL_BACKUPS = 6
+
+	PROFILING_SETTINGS_DEFAULT_LATENCY_THRESHOLD_MILLISECONDS = 2000
+	PROFILING_SETTINGS_DEFAULT_MEMORY_THRESHOLD_MB            = 4096
+	PROFILING_SETTINGS_DEFAULT_CPU_PROFILE_SECONDS            = 30
+	PROFILING_SETTINGS_DEFAULT_MINIMUM_CAPTURE_INTERVAL_MINS  = 60
+
+	EVENT_STREAM_SETTINGS_DEFAULT_BATCH_SIZE     = 100
+	EVENT_STREAM_SETTINGS_DEFAULT_KAFKA_TOPIC    = "mattermost-events"
+	EVENT_STREAM_SETTINGS_MAX_BATCH_SIZE         = 1000
+	EVENT_STREAM_SETTINGS_WEBHOOK_URL_MAX_LEN    = 1024
+	EVENT_STREAM_SETTINGS_WEBHOOK_SECRET_MIN_LEN = 16
 
 	PLUGIN_SETTINGS_DEFAULT_DIRECTORY          = "./plugins"
 	PLUGIN_SETTINGS_DEFAULT_CLIENT_DIRECTORY   = "./client/plugins"
@@ -270,20 +316,32 @@ type ServiceSettings struct {
 	EnableOAuthServiceProvider                        *bool
 	EnableIncomingWebhooks                            *bool
 	EnableOutgoingWebhooks                            *bool
+	EnablePresenceWebhooks                            *bool
 	EnableCommands                                    *bool
 	DEPRECATED_DO_NOT_USE_EnableOnlyAdminIntegrations *bool `json:"EnableOnlyAdminIntegrations" mapstructure:"EnableOnlyAdminIntegrations"` // This field is deprecated and must not be used.
 	EnablePostUsernameOverride                        *bool
 	EnablePostIconOverride                            *bool
 	EnableLinkPreviews                                *bool
+	EnablePermalinkPreviews                           *bool
 	EnableTesting                                     *bool   `restricted:"true"`
 	EnableDeveloper                                   *bool   `restricted:"true"`
 	EnableOpenTracing                                 *bool   `restricted:"true"`
 	EnableSecurityFixAlert                            *bool   `restricted:"true"`
+	EnableSeatUsageNotifications                      *bool   `restricted:"true"`
+	SeatUsageNotificationDays                         *int    `restricted:"true"`
+	ExcludeServiceAccountsFromSeatCount               *bool   `restricted:"true"`
 	EnableInsecureOutgoingConnections                 *bool   `restricted:"true"`
 	AllowedUntrustedInternalConnections               *string `restricted:"true"`
 	EnableMultifactorAuthentication                   *bool
 	EnforceMultifactorAuthentication                  *bool
 	EnableUserAccessTokens                            *bool
+	EnableImpersonation                               *bool   `restricted:"true"`
+	EnableImpersonationWriteAccess                    *bool   `restricted:"true"`
+	ImpersonationSessionLengthInMinutes               *int    `restricted:"true"`
+	EnableEmbedding                                   *bool   `restricted:"true"`
+	EmbedTokenExpiryInSeconds                         *int    `restricted:"true"`
+	EmbedSessionLengthInMinutes                       *int    `restricted:"true"`
+	LoginHistoryRetentionDays                         *int    `restricted:"true"`
 	AllowCorsFrom                                     *string `restricted:"true"`
 	CorsExposedHeaders                                *string `restricted:"true"`
 	CorsAllowCredentials                              *bool   `restricted:"true"`
@@ -327,9 +385,13 @@ type ServiceSettings struct {
 	DEPRECATED_DO_NOT_USE_ImageProxyURL               *string `json:"ImageProxyURL" mapstructure:"ImageProxyURL"`         // This field is deprecated and must not be used.
 	DEPRECATED_DO_NOT_USE_ImageProxyOptions           *string `json:"ImageProxyOptions" mapstructure:"ImageProxyOptions"` // This field is deprecated and must not be used.
 	EnableAPITeamDeletion                             *bool
+	TeamDeletionGracePeriodInDays                     *int
 	ExperimentalEnableHardenedMode                    *bool
-	DisableLegacyMFA                                  *bool `restricted:"true"`
-	ExperimentalStrictCSRFEnforcement                 *bool `restricted:"true"`
+	DisableLegacyMFA                                  *bool   `restricted:"true"`
+	ExperimentalStrictCSRFEnforcement                 *bool   `restricted:"true"`
+	CSRFEnforcementMode                               *string `restricted:"true"`
+	SessionCookieSameSite                             *string `restricted:"true"`
+	SessionCookieSecure                               *bool   `restricted:"true"`
 	EnableEmailInvitations                            *bool
 	DisableBotsWhenOwnerIsDeactivated                 *bool `restricted:"true"`
 	EnableBotAccountCreation                          *bool
@@ -337,6 +399,8 @@ type ServiceSettings struct {
 	EnableLatex                                       *bool
 	EnableLocalMode                                   *bool
 	LocalModeSocketLocation                           *string
+	ExperimentalSortableIds                           *bool `restricted:"true"`
+	UrgentPostsBypassDoNotDisturb                     *bool
 }
 
 func (s *ServiceSettings) SetDefaults(isUpdate bool) {
@@ -373,6 +437,10 @@ func (s *ServiceSettings) SetDefaults(isUpdate bool) {
 		s.EnableLinkPreviews = NewBool(true)
 	}
 
+	if s.EnablePermalinkPreviews == nil {
+		s.EnablePermalinkPreviews = NewBool(true)
+	}
+
 	if s.EnableTesting == nil {
 		s.EnableTesting = NewBool(false)
 	}
@@ -389,6 +457,18 @@ func (s *ServiceSettings) SetDefaults(isUpdate bool) {
 		s.EnableSecurityFixAlert = NewBool(true)
 	}
 
+	if s.EnableSeatUsageNotifications == nil {
+		s.EnableSeatUsageNotifications = NewBool(true)
+	}
+
+	if s.SeatUsageNotificationDays == nil {
+		s.SeatUsageNotificationDays = NewInt(SERVICE_SETTINGS_DEFAULT_SEAT_USAGE_NOTIFICATION_DAYS)
+	}
+
+	if s.ExcludeServiceAccountsFromSeatCount == nil {
+		s.ExcludeServiceAccountsFromSeatCount = NewBool(false)
+	}
+
 	if s.EnableInsecureOutgoingConnections == nil {
 		s.EnableInsecureOutgoingConnections = NewBool(false)
 	}
@@ -409,6 +489,34 @@ func (s *ServiceSettings) SetDefaults(isUpdate bool) {
 		s.EnableUserAccessTokens = NewBool(false)
 	}
 
+	if s.EnableImpersonation == nil {
+		s.EnableImpersonation = NewBool(false)
+	}
+
+	if s.EnableImpersonationWriteAccess == nil {
+		s.EnableImpersonationWriteAccess = NewBool(false)
+	}
+
+	if s.ImpersonationSessionLengthInMinutes == nil {
+		s.ImpersonationSessionLengthInMinutes = NewInt(SERVICE_SETTINGS_DEFAULT_IMPERSONATION_SESSION_LENGTH)
+	}
+
+	if s.EnableEmbedding == nil {
+		s.EnableEmbedding = NewBool(false)
+	}
+
+	if s.EmbedTokenExpiryInSeconds == nil {
+		s.EmbedTokenExpiryInSeconds = NewInt(SERVICE_SETTINGS_DEFAULT_EMBED_TOKEN_EXPIRY)
+	}
+
+	if s.EmbedSessionLengthInMinutes == nil {
+		s.EmbedSessionLengthInMinutes = NewInt(SERVICE_SETTINGS_DEFAULT_EMBED_SESSION_LENGTH)
+	}
+
+	if s.LoginHistoryRetentionDays == nil {
+		s.LoginHistoryRetentionDays = NewInt(SERVICE_SETTINGS_DEFAULT_LOGIN_HISTORY_RETENTION_DAYS)
+	}
+
 	if s.GoroutineHealthThreshold == nil {
 		s.GoroutineHealthThreshold = NewInt(-1)
 	}
@@ -429,6 +537,10 @@ func (s *ServiceSettings) SetDefaults(isUpdate bool) {
 		s.EnableOutgoingWebhooks = NewBool(true)
 	}
 
+	if s.EnablePresenceWebhooks == nil {
+		s.EnablePresenceWebhooks = NewBool(false)
+	}
+
 	if s.ConnectionSecurity == nil {
 		s.ConnectionSecurity = NewString("")
 	}
@@ -699,6 +811,10 @@ func (s *ServiceSettings) SetDefaults(isUpdate bool) {
 		s.EnableAPITeamDeletion = NewBool(false)
 	}
 
+	if s.TeamDeletionGracePeriodInDays == nil {
+		s.TeamDeletionGracePeriodInDays = NewInt(0)
+	}
+
 	if s.ExperimentalEnableHardenedMode == nil {
 		s.ExperimentalEnableHardenedMode = NewBool(false)
 	}
@@ -711,6 +827,18 @@ func (s *ServiceSettings) SetDefaults(isUpdate bool) {
 		s.ExperimentalStrictCSRFEnforcement = NewBool(false)
 	}
 
+	if s.CSRFEnforcementMode == nil {
+		s.CSRFEnforcementMode = NewString(CSRF_ENFORCEMENT_MODE_ENFORCE)
+	}
+
+	if s.SessionCookieSameSite == nil {
+		s.SessionCookieSameSite = NewString(SESSION_COOKIE_SAME_SITE_DEFAULT)
+	}
+
+	if s.SessionCookieSecure == nil {
+		s.SessionCookieSecure = NewBool(false)
+	}
+
 	if s.DisableBotsWhenOwnerIsDeactivated == nil {
 		s.DisableBotsWhenOwnerIsDeactivated = NewBool(true)
 	}
@@ -742,6 +870,14 @@ func (s *ServiceSettings) SetDefaults(isUpdate bool) {
 	if s.LocalModeSocketLocation == nil {
 		s.LocalModeSocketLocation = NewString(LOCAL_MODE_SOCKET_PATH)
 	}
+
+	if s.ExperimentalSortableIds == nil {
+		s.ExperimentalSortableIds = NewBool(false)
+	}
+
+	if s.UrgentPostsBypassDoNotDisturb == nil {
+		s.UrgentPostsBypassDoNotDisturb = NewBool(false)
+	}
 }
 
 type ClusterSettings struct {
@@ -845,12 +981,17 @@ func (s *MetricsSettings) SetDefaults() {
 }
 
 type ExperimentalSettings struct {
-	ClientSideCertEnable            *bool
-	ClientSideCertCheck             *string
-	EnableClickToReply              *bool  `restricted:"true"`
-	LinkMetadataTimeoutMilliseconds *int64 `restricted:"true"`
-	RestrictSystemAdmin             *bool  `restricted:"true"`
-	UseNewSAMLLibrary               *bool
+	ClientSideCertEnable                      *bool
+	ClientSideCertCheck                       *string
+	EnableClickToReply                        *bool  `restricted:"true"`
+	LinkMetadataTimeoutMilliseconds           *int64 `restricted:"true"`
+	RestrictSystemAdmin                       *bool  `restricted:"true"`
+	UseNewSAMLLibrary                         *bool
+	EnableStoreFaultInjection                 *bool    `restricted:"true"`
+	StoreFaultInjectionLatencyMilliseconds    *int64   `restricted:"true"`
+	StoreFaultInjectionErrorPercent           *int     `restricted:"true"`
+	StoreFaultInjectionReplicaLagMilliseconds *int64   `restricted:"true"`
+	StoreFaultInjectionMethods                []string `restricted:"true"`
 }
 
 func (s *ExperimentalSettings) SetDefaults() {
@@ -876,6 +1017,38 @@ func (s *ExperimentalSettings) SetDefaults() {
 	if s.UseNewSAMLLibrary == nil {
 		s.UseNewSAMLLibrary = NewBool(false)
 	}
+
+	if s.EnableStoreFaultInjection == nil {
+		s.EnableStoreFaultInjection = NewBool(false)
+	}
+
+	if s.StoreFaultInjectionLatencyMilliseconds == nil {
+		s.StoreFaultInjectionLatencyMilliseconds = NewInt64(0)
+	}
+
+	if s.StoreFaultInjectionErrorPercent == nil {
+		s.StoreFaultInjectionErrorPercent = NewInt(0)
+	}
+
+	if s.StoreFaultInjectionReplicaLagMilliseconds == nil {
+		s.StoreFaultInjectionReplicaLagMilliseconds = NewInt64(0)
+	}
+
+	if s.StoreFaultInjectionMethods == nil {
+		s.StoreFaultInjectionMethods = []string{}
+	}
+}
+
+func (s *ExperimentalSettings) isValid() *AppError {
+	if *s.StoreFaultInjectionLatencyMilliseconds < 0 || *s.StoreFaultInjectionReplicaLagMilliseconds < 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.store_fault_injection_delay.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.StoreFaultInjectionErrorPercent < 0 || *s.StoreFaultInjectionErrorPercent > 100 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.store_fault_injection_error_percent.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
 }
 
 type AnalyticsSettings struct {
@@ -986,17 +1159,25 @@ func (s *Office365Settings) SSOSettings() *SSOSettings {
 }
 
 type SqlSettings struct {
-	DriverName                  *string  `restricted:"true"`
-	DataSource                  *string  `restricted:"true"`
-	DataSourceReplicas          []string `restricted:"true"`
-	DataSourceSearchReplicas    []string `restricted:"true"`
-	MaxIdleConns                *int     `restricted:"true"`
-	ConnMaxLifetimeMilliseconds *int     `restricted:"true"`
-	MaxOpenConns                *int     `restricted:"true"`
-	Trace                       *bool    `restricted:"true"`
-	AtRestEncryptKey            *string  `restricted:"true"`
-	QueryTimeout                *int     `restricted:"true"`
-	DisableDatabaseSearch       *bool    `restricted:"true"`
+	DriverName                          *string  `restricted:"true"`
+	DataSource                          *string  `restricted:"true"`
+	DataSourceReplicas                  []string `restricted:"true"`
+	DataSourceSearchReplicas            []string `restricted:"true"`
+	MaxIdleConns                        *int     `restricted:"true"`
+	ConnMaxLifetimeMilliseconds         *int     `restricted:"true"`
+	MaxOpenConns                        *int     `restricted:"true"`
+	Trace                               *bool    `restricted:"true"`
+	AtRestEncryptKey                    *string  `restricted:"true"`
+	QueryTimeout                        *int     `restricted:"true"`
+	DisableDatabaseSearch               *bool    `restricted:"true"`
+	EnablePostsPartitioning             *bool    `restricted:"true"`
+	EnableNgramSearch                   *bool    `restricted:"true"`
+	EnableReplicaFallback               *bool    `restricted:"true"`
+	ReplicaHealthCheckIntervalSeconds   *int     `restricted:"true"`
+	ReplicaFailureThreshold             *int     `restricted:"true"`
+	ReplicaRetryIntervalSeconds         *int     `restricted:"true"`
+	ReplicaFallbackMaxMasterReadPercent *int     `restricted:"true"`
+	MigrationLockTimeoutSeconds         *int     `restricted:"true"`
 }
 
 func (s *SqlSettings) SetDefaults(isUpdate bool) {
@@ -1049,20 +1230,54 @@ func (s *SqlSettings) SetDefaults(isUpdate bool) {
 	if s.DisableDatabaseSearch == nil {
 		s.DisableDatabaseSearch = NewBool(false)
 	}
+
+	if s.EnablePostsPartitioning == nil {
+		s.EnablePostsPartitioning = NewBool(false)
+	}
+
+	if s.EnableNgramSearch == nil {
+		s.EnableNgramSearch = NewBool(false)
+	}
+
+	if s.EnableReplicaFallback == nil {
+		s.EnableReplicaFallback = NewBool(true)
+	}
+
+	if s.ReplicaHealthCheckIntervalSeconds == nil {
+		s.ReplicaHealthCheckIntervalSeconds = NewInt(5)
+	}
+
+	if s.ReplicaFailureThreshold == nil {
+		s.ReplicaFailureThreshold = NewInt(3)
+	}
+
+	if s.ReplicaRetryIntervalSeconds == nil {
+		s.ReplicaRetryIntervalSeconds = NewInt(30)
+	}
+
+	if s.ReplicaFallbackMaxMasterReadPercent == nil {
+		s.ReplicaFallbackMaxMasterReadPercent = NewInt(100)
+	}
+
+	if s.MigrationLockTimeoutSeconds == nil {
+		s.MigrationLockTimeoutSeconds = NewInt(60)
+	}
 }
 
 type LogSettings struct {
-	EnableConsole          *bool   `restricted:"true"`
-	ConsoleLevel           *string `restricted:"true"`
-	ConsoleJson            *bool   `restricted:"true"`
-	EnableFile             *bool   `restricted:"true"`
-	FileLevel              *string `restricted:"true"`
-	FileJson               *bool   `restricted:"true"`
-	FileLocation           *string `restricted:"true"`
-	EnableWebhookDebugging *bool   `restricted:"true"`
-	EnableDiagnostics      *bool   `restricted:"true"`
-	EnableSentry           *bool   `restricted:"true"`
-	AdvancedLoggingConfig  *string `restricted:"true"`
+	EnableConsole          *bool    `restricted:"true"`
+	ConsoleLevel           *string  `restricted:"true"`
+	ConsoleJson            *bool    `restricted:"true"`
+	EnableFile             *bool    `restricted:"true"`
+	FileLevel              *string  `restricted:"true"`
+	FileJson               *bool    `restricted:"true"`
+	FileLocation           *string  `restricted:"true"`
+	EnableWebhookDebugging *bool    `restricted:"true"`
+	EnableDiagnostics      *bool    `restricted:"true"`
+	DiagnosticsCategories  []string `restricted:"true"`
+	DiagnosticsSinkURL     *string  `restricted:"true"`
+	EnableSentry           *bool    `restricted:"true"`
+	AdvancedLoggingConfig  *string  `restricted:"true"`
 }
 
 func (s *LogSettings) SetDefaults() {
@@ -1094,6 +1309,14 @@ func (s *LogSettings) SetDefaults() {
 		s.EnableDiagnostics = NewBool(true)
 	}
 
+	if s.DiagnosticsCategories == nil {
+		s.DiagnosticsCategories = AllDiagnosticsCategories()
+	}
+
+	if s.DiagnosticsSinkURL == nil {
+		s.DiagnosticsSinkURL = NewString("")
+	}
+
 	if s.EnableSentry == nil {
 		s.EnableSentry = NewBool(*s.EnableDiagnostics)
 	}
@@ -1187,6 +1410,20 @@ func (s *ExperimentalAuditSettings) SetDefaults() {
 	}
 }
 
+func (s *LogSettings) isValid() *AppError {
+	for _, category := range s.DiagnosticsCategories {
+		if !IsValidDiagnosticsCategory(category) {
+			return NewAppError("Config.IsValid", "model.config.is_valid.diagnostics_categories.app_error", map[string]interface{}{"Category": category}, "", http.StatusBadRequest)
+		}
+	}
+
+	if *s.DiagnosticsSinkURL != "" && !IsValidHttpUrl(*s.DiagnosticsSinkURL) {
+		return NewAppError("Config.IsValid", "model.config.is_valid.diagnostics_sink_url.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
 type NotificationLogSettings struct {
 	EnableConsole *bool   `restricted:"true"`
 	ConsoleLevel  *string `restricted:"true"`
@@ -1262,6 +1499,7 @@ type FileSettings struct {
 	EnableMobileUpload      *bool
 	EnableMobileDownload    *bool
 	MaxFileSize             *int64
+	EnableFileEnrichment    *bool
 	DriverName              *string `restricted:"true"`
 	Directory               *string `restricted:"true"`
 	EnablePublicLink        *bool
@@ -1296,6 +1534,10 @@ func (s *FileSettings) SetDefaults(isUpdate bool) {
 		s.MaxFileSize = NewInt64(52428800) // 50 MB
 	}
 
+	if s.EnableFileEnrichment == nil {
+		s.EnableFileEnrichment = NewBool(true)
+	}
+
 	if s.DriverName == nil {
 		s.DriverName = NewString(IMAGE_DRIVER_LOCAL)
 	}
@@ -1373,6 +1615,8 @@ type EmailSettings struct {
 	SendEmailNotifications            *bool
 	UseChannelInEmailNotifications    *bool
 	RequireEmailVerification          *bool
+	EmailVerificationGracePeriodDays  *int
+	EmailVerificationReminderDays     *int
 	FeedbackName                      *string
 	FeedbackEmail                     *string
 	ReplyToAddress                    *string
@@ -1424,6 +1668,14 @@ func (s *EmailSettings) SetDefaults(isUpdate bool) {
 		s.RequireEmailVerification = NewBool(false)
 	}
 
+	if s.EmailVerificationGracePeriodDays == nil {
+		s.EmailVerificationGracePeriodDays = NewInt(EMAIL_SETTINGS_DEFAULT_EMAIL_VERIFICATION_GRACE_PERIOD_DAYS)
+	}
+
+	if s.EmailVerificationReminderDays == nil {
+		s.EmailVerificationReminderDays = NewInt(EMAIL_SETTINGS_DEFAULT_EMAIL_VERIFICATION_REMINDER_DAYS)
+	}
+
 	if s.FeedbackName == nil {
 		s.FeedbackName = NewString("")
 	}
@@ -1601,6 +1853,7 @@ type SupportSettings struct {
 	SupportEmail                           *string
 	CustomTermsOfServiceEnabled            *bool
 	CustomTermsOfServiceReAcceptancePeriod *int
+	EnforceTermsOfServicePolicies          *bool
 	EnableAskCommunityLink                 *bool
 }
 
@@ -1657,6 +1910,10 @@ func (s *SupportSettings) SetDefaults() {
 		s.CustomTermsOfServiceReAcceptancePeriod = NewInt(SUPPORT_SETTINGS_DEFAULT_RE_ACCEPTANCE_PERIOD)
 	}
 
+	if s.EnforceTermsOfServicePolicies == nil {
+		s.EnforceTermsOfServicePolicies = NewBool(false)
+	}
+
 	if s.EnableAskCommunityLink == nil {
 		s.EnableAskCommunityLink = NewBool(true)
 	}
@@ -1728,6 +1985,8 @@ type TeamSettings struct {
 	EnableCustomBrand                                         *bool
 	CustomBrandText                                           *string
 	CustomDescriptionText                                     *string
+	EnableCustomErrorPages                                    *bool
+	CustomErrorPageTemplate                                   *string `restricted:"true"`
 	RestrictDirectMessage                                     *string
 	DEPRECATED_DO_NOT_USE_RestrictTeamInvite                  *string `json:"RestrictTeamInvite" mapstructure:"RestrictTeamInvite"`                                   // This field is deprecated and must not be used.
 	DEPRECATED_DO_NOT_USE_RestrictPublicChannelManagement     *string `json:"RestrictPublicChannelManagement" mapstructure:"RestrictPublicChannelManagement"`         // This field is deprecated and must not be used.
@@ -1790,6 +2049,14 @@ func (s *TeamSettings) SetDefaults() {
 		s.CustomBrandText = NewString(TEAM_SETTINGS_DEFAULT_CUSTOM_BRAND_TEXT)
 	}
 
+	if s.EnableCustomErrorPages == nil {
+		s.EnableCustomErrorPages = NewBool(false)
+	}
+
+	if s.CustomErrorPageTemplate == nil {
+		s.CustomErrorPageTemplate = NewString("")
+	}
+
 	if s.CustomDescriptionText == nil {
 		s.CustomDescriptionText = NewString(TEAM_SETTINGS_DEFAULT_CUSTOM_DESCRIPTION_TEXT)
 	}
@@ -1941,6 +2208,8 @@ type LdapSettings struct {
 	PositionAttribute  *string
 	LoginIdAttribute   *string
 	PictureAttribute   *string
+	// ManagerAttribute holds the value of the IdAttribute of the manager of the user.
+	ManagerAttribute *string
 
 	// Synchronization
 	SyncIntervalMinutes *int
@@ -2054,6 +2323,10 @@ func (s *LdapSettings) SetDefaults() {
 		s.PictureAttribute = NewString(LDAP_SETTINGS_DEFAULT_PICTURE_ATTRIBUTE)
 	}
 
+	if s.ManagerAttribute == nil {
+		s.ManagerAttribute = NewString(LDAP_SETTINGS_DEFAULT_MANAGER_ATTRIBUTE)
+	}
+
 	// For those upgrading to the version when LoginIdAttribute was added
 	// they need IdAttribute == LoginIdAttribute not to break
 	if s.LoginIdAttribute == nil {
@@ -2098,9 +2371,10 @@ func (s *LdapSettings) SetDefaults() {
 }
 
 type ComplianceSettings struct {
-	Enable      *bool
-	Directory   *string
-	EnableDaily *bool
+	Enable              *bool
+	Directory           *string
+	EnableDaily         *bool
+	EnablePostIntegrity *bool
 }
 
 func (s *ComplianceSettings) SetDefaults() {
@@ -2115,12 +2389,17 @@ func (s *ComplianceSettings) SetDefaults() {
 	if s.EnableDaily == nil {
 		s.EnableDaily = NewBool(false)
 	}
+
+	if s.EnablePostIntegrity == nil {
+		s.EnablePostIntegrity = NewBool(false)
+	}
 }
 
 type LocalizationSettings struct {
-	DefaultServerLocale *string
-	DefaultClientLocale *string
-	AvailableLocales    *string
+	DefaultServerLocale   *string
+	DefaultClientLocale   *string
+	AvailableLocales      *string
+	EnableCJKTokenization *bool
 }
 
 func (s *LocalizationSettings) SetDefaults() {
@@ -2135,6 +2414,10 @@ func (s *LocalizationSettings) SetDefaults() {
 	if s.AvailableLocales == nil {
 		s.AvailableLocales = NewString("")
 	}
+
+	if s.EnableCJKTokenization == nil {
+		s.EnableCJKTokenization = NewBool(false)
+	}
 }
 
 type SamlSettings struct {
@@ -2480,11 +2763,13 @@ func (bs *BleveSettings) SetDefaults() {
 }
 
 type DataRetentionSettings struct {
-	EnableMessageDeletion *bool
-	EnableFileDeletion    *bool
-	MessageRetentionDays  *int
-	FileRetentionDays     *int
-	DeletionJobStartTime  *string
+	EnableMessageDeletion          *bool
+	EnableFileDeletion             *bool
+	MessageRetentionDays           *int
+	FileRetentionDays              *int
+	DeletionJobStartTime           *string
+	BatchSize                      *int
+	TimeBetweenBatchesMilliseconds *int
 }
 
 func (s *DataRetentionSettings) SetDefaults() {
@@ -2507,6 +2792,182 @@ func (s *DataRetentionSettings) SetDefaults() {
 	if s.DeletionJobStartTime == nil {
 		s.DeletionJobStartTime = NewString(DATA_RETENTION_SETTINGS_DEFAULT_DELETION_JOB_START_TIME)
 	}
+
+	if s.BatchSize == nil {
+		s.BatchSize = NewInt(DATA_RETENTION_SETTINGS_DEFAULT_BATCH_SIZE)
+	}
+
+	if s.TimeBetweenBatchesMilliseconds == nil {
+		s.TimeBetweenBatchesMilliseconds = NewInt(DATA_RETENTION_SETTINGS_DEFAULT_TIME_BETWEEN_BATCHES)
+	}
+}
+
+type ArchiveSettings struct {
+	EnablePostArchiving *bool
+	ArchiveAfterMonths  *int
+	ArchiveJobStartTime *string
+	BatchSize           *int
+}
+
+func (s *ArchiveSettings) SetDefaults() {
+	if s.EnablePostArchiving == nil {
+		s.EnablePostArchiving = NewBool(false)
+	}
+
+	if s.ArchiveAfterMonths == nil {
+		s.ArchiveAfterMonths = NewInt(ARCHIVE_SETTINGS_DEFAULT_ARCHIVE_AFTER_MONTHS)
+	}
+
+	if s.ArchiveJobStartTime == nil {
+		s.ArchiveJobStartTime = NewString(ARCHIVE_SETTINGS_DEFAULT_JOB_START_TIME)
+	}
+
+	if s.BatchSize == nil {
+		s.BatchSize = NewInt(ARCHIVE_SETTINGS_DEFAULT_BATCH_SIZE)
+	}
+}
+
+type BackupSettings struct {
+	EnableScheduledBackups *bool
+	Directory              *string `restricted:"true"`
+	IntervalHours          *int
+	EnableIncremental      *bool
+	MaxIncrementalBackups  *int
+	IncludeFiles           *bool
+	EncryptionPassphrase   *string `restricted:"true"`
+}
+
+func (s *BackupSettings) SetDefaults() {
+	if s.EnableScheduledBackups == nil {
+		s.EnableScheduledBackups = NewBool(false)
+	}
+
+	if s.Directory == nil {
+		s.Directory = NewString(BACKUP_SETTINGS_DEFAULT_DIRECTORY)
+	}
+
+	if s.IntervalHours == nil {
+		s.IntervalHours = NewInt(BACKUP_SETTINGS_DEFAULT_INTERVAL_HOURS)
+	}
+
+	if s.EnableIncremental == nil {
+		s.EnableIncremental = NewBool(true)
+	}
+
+	if s.MaxIncrementalBackups == nil {
+		s.MaxIncrementalBackups = NewInt(BACKUP_SETTINGS_DEFAULT_MAX_INCREMENTAL_BACKUPS)
+	}
+
+	if s.IncludeFiles == nil {
+		s.IncludeFiles = NewBool(true)
+	}
+
+	if s.EncryptionPassphrase == nil {
+		s.EncryptionPassphrase = NewString("")
+	}
+}
+
+// EventStreamSettings configures the publication of the changes to posts, memberships and users
+// to an external system, either a Kafka topic or an HTTP firehose.
+type EventStreamSettings struct {
+	Enable *bool
+	// Sink is either EVENT_STREAM_SINK_WEBHOOK or EVENT_STREAM_SINK_KAFKA.
+	Sink          *string
+	WebhookURL    *string
+	WebhookSecret *string
+	// KafkaBrokers is a comma separated list of the host:port addresses of the brokers.
+	KafkaBrokers *string
+	KafkaTopic   *string
+	// EventTypes is a comma separated list of the event types published. All of them are published
+	// when empty.
+	EventTypes *string
+	BatchSize  *int
+}
+
+func (s *EventStreamSettings) SetDefaults() {
+	if s.Enable == nil {
+		s.Enable = NewBool(false)
+	}
+
+	if s.Sink == nil {
+		s.Sink = NewString(EVENT_STREAM_SINK_WEBHOOK)
+	}
+
+	if s.WebhookURL == nil {
+		s.WebhookURL = NewString("")
+	}
+
+	if s.WebhookSecret == nil {
+		s.WebhookSecret = NewString("")
+	}
+
+	if s.KafkaBrokers == nil {
+		s.KafkaBrokers = NewString("")
+	}
+
+	if s.KafkaTopic == nil {
+		s.KafkaTopic = NewString(EVENT_STREAM_SETTINGS_DEFAULT_KAFKA_TOPIC)
+	}
+
+	if s.EventTypes == nil {
+		s.EventTypes = NewString("")
+	}
+
+	if s.BatchSize == nil {
+		s.BatchSize = NewInt(EVENT_STREAM_SETTINGS_DEFAULT_BATCH_SIZE)
+	}
+}
+
+// PublishesEventType reports whether the events of the given type are published.
+func (s *EventStreamSettings) PublishesEventType(eventType string) bool {
+	if *s.EventTypes == "" {
+		return true
+	}
+
+	for _, t := range strings.Split(*s.EventTypes, ",") {
+		if strings.TrimSpace(t) == eventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ProfilingSettings configures the automatic capture of profiles of the server when it becomes
+// slow or uses too much memory. Profiles can also be captured on demand by a system admin.
+type ProfilingSettings struct {
+	EnableAutomaticCapture *bool
+	// LatencyThresholdMilliseconds is compared to the average duration of the API requests since
+	// the last check, a CPU profile being captured when it is exceeded.
+	LatencyThresholdMilliseconds *int
+	// MemoryThresholdMB is compared to the memory allocated by the server, a heap profile being
+	// captured when it is exceeded.
+	MemoryThresholdMB *int
+	CPUProfileSeconds *int
+	// MinimumCaptureIntervalMinutes prevents a lasting slowdown from capturing a profile at every check.
+	MinimumCaptureIntervalMinutes *int
+}
+
+func (s *ProfilingSettings) SetDefaults() {
+	if s.EnableAutomaticCapture == nil {
+		s.EnableAutomaticCapture = NewBool(false)
+	}
+
+	if s.LatencyThresholdMilliseconds == nil {
+		s.LatencyThresholdMilliseconds = NewInt(PROFILING_SETTINGS_DEFAULT_LATENCY_THRESHOLD_MILLISECONDS)
+	}
+
+	if s.MemoryThresholdMB == nil {
+		s.MemoryThresholdMB = NewInt(PROFILING_SETTINGS_DEFAULT_MEMORY_THRESHOLD_MB)
+	}
+
+	if s.CPUProfileSeconds == nil {
+		s.CPUProfileSeconds = NewInt(PROFILING_SETTINGS_DEFAULT_CPU_PROFILE_SECONDS)
+	}
+
+	if s.MinimumCaptureIntervalMinutes == nil {
+		s.MinimumCaptureIntervalMinutes = NewInt(PROFILING_SETTINGS_DEFAULT_MINIMUM_CAPTURE_INTERVAL_MINS)
+	}
 }
 
 type JobSettings struct {
@@ -2787,6 +3248,10 @@ type Config struct {
 	ElasticsearchSettings     ElasticsearchSettings
 	BleveSettings             BleveSettings
 	DataRetentionSettings     DataRetentionSettings
+	ArchiveSettings           ArchiveSettings
+	BackupSettings            BackupSettings
+	EventStreamSettings       EventStreamSettings
+	ProfilingSettings         ProfilingSettings
 	MessageExportSettings     MessageExportSettings
 	JobSettings               JobSettings
 	PluginSettings            PluginSettings
@@ -2870,6 +3335,10 @@ func (o *Config) SetDefaults() {
 	o.BleveSettings.SetDefaults()
 	o.NativeAppSettings.SetDefaults()
 	o.DataRetentionSettings.SetDefaults()
+	o.ArchiveSettings.SetDefaults()
+	o.BackupSettings.SetDefaults()
+	o.EventStreamSettings.SetDefaults()
+	o.ProfilingSettings.SetDefaults()
 	o.RateLimitSettings.SetDefaults()
 	o.LogSettings.SetDefaults()
 	o.ExperimentalAuditSettings.SetDefaults()
@@ -2898,6 +3367,10 @@ func (o *Config) IsValid() *AppError {
 		return err
 	}
 
+	if err := o.LogSettings.isValid(); err != nil {
+		return err
+	}
+
 	if err := o.SqlSettings.isValid(); err != nil {
 		return err
 	}
@@ -2942,6 +3415,22 @@ func (o *Config) IsValid() *AppError {
 		return err
 	}
 
+	if err := o.ArchiveSettings.isValid(); err != nil {
+		return err
+	}
+
+	if err := o.BackupSettings.isValid(); err != nil {
+		return err
+	}
+
+	if err := o.EventStreamSettings.isValid(); err != nil {
+		return err
+	}
+
+	if err := o.ProfilingSettings.isValid(); err != nil {
+		return err
+	}
+
 	if err := o.LocalizationSettings.isValid(); err != nil {
 		return err
 	}
@@ -2957,6 +3446,10 @@ func (o *Config) IsValid() *AppError {
 	if err := o.ImageProxySettings.isValid(); err != nil {
 		return err
 	}
+
+	if err := o.ExperimentalSettings.isValid(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3017,6 +3510,26 @@ func (s *SqlSettings) isValid() *AppError {
 		return NewAppError("Config.IsValid", "model.config.is_valid.sql_max_conn.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if *s.EnablePostsPartitioning && *s.DriverName != DATABASE_DRIVER_POSTGRES {
+		return NewAppError("Config.IsValid", "model.config.is_valid.sql_posts_partitioning.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.ReplicaHealthCheckIntervalSeconds <= 0 || *s.ReplicaRetryIntervalSeconds <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.sql_replica_check_interval.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.ReplicaFailureThreshold <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.sql_replica_failure_threshold.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.ReplicaFallbackMaxMasterReadPercent < 0 || *s.ReplicaFallbackMaxMasterReadPercent > 100 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.sql_replica_master_read_percent.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.MigrationLockTimeoutSeconds <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.sql_migration_lock_timeout.app_error", nil, "", http.StatusBadRequest)
+	}
+
 	return nil
 }
 
@@ -3036,6 +3549,12 @@ func (s *FileSettings) isValid() *AppError {
 	return nil
 }
 
+// IsEmailVerificationGracePeriodEnabled reports whether unverified users can keep logging in until
+// their verification deadline, rather than being refused right away.
+func (s *EmailSettings) IsEmailVerificationGracePeriodEnabled() bool {
+	return *s.RequireEmailVerification && *s.EmailVerificationGracePeriodDays > 0
+}
+
 func (s *EmailSettings) isValid() *AppError {
 	if !(*s.ConnectionSecurity == CONN_SECURITY_NONE || *s.ConnectionSecurity == CONN_SECURITY_TLS || *s.ConnectionSecurity == CONN_SECURITY_STARTTLS || *s.ConnectionSecurity == CONN_SECURITY_PLAIN) {
 		return NewAppError("Config.IsValid", "model.config.is_valid.email_security.app_error", nil, "", http.StatusBadRequest)
@@ -3049,6 +3568,14 @@ func (s *EmailSettings) isValid() *AppError {
 		return NewAppError("Config.IsValid", "model.config.is_valid.email_batching_interval.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if *s.EmailVerificationGracePeriodDays < 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.email_verification_grace_period_days.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.EmailVerificationReminderDays < 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.email_verification_reminder_days.app_error", nil, "", http.StatusBadRequest)
+	}
+
 	if !(*s.EmailNotificationContentsType == EMAIL_NOTIFICATION_CONTENTS_FULL || *s.EmailNotificationContentsType == EMAIL_NOTIFICATION_CONTENTS_GENERIC) {
 		return NewAppError("Config.IsValid", "model.config.is_valid.email_notification_contents_type.app_error", nil, "", http.StatusBadRequest)
 	}
@@ -3208,6 +3735,40 @@ func (s *SamlSettings) isValid() *AppError {
 }
 
 func (s *ServiceSettings) isValid() *AppError {
+	if *s.SeatUsageNotificationDays <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.seat_usage_notification_days.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.ImpersonationSessionLengthInMinutes <= 0 || *s.ImpersonationSessionLengthInMinutes > SERVICE_SETTINGS_MAX_IMPERSONATION_SESSION_LENGTH {
+		return NewAppError("Config.IsValid", "model.config.is_valid.impersonation_session_length.app_error", map[string]interface{}{"MaxLength": SERVICE_SETTINGS_MAX_IMPERSONATION_SESSION_LENGTH}, "", http.StatusBadRequest)
+	}
+
+	if *s.EmbedTokenExpiryInSeconds <= 0 || *s.EmbedTokenExpiryInSeconds > SERVICE_SETTINGS_MAX_EMBED_TOKEN_EXPIRY {
+		return NewAppError("Config.IsValid", "model.config.is_valid.embed_token_expiry.app_error", map[string]interface{}{"MaxExpiry": SERVICE_SETTINGS_MAX_EMBED_TOKEN_EXPIRY}, "", http.StatusBadRequest)
+	}
+
+	if *s.EmbedSessionLengthInMinutes <= 0 || *s.EmbedSessionLengthInMinutes > SERVICE_SETTINGS_MAX_EMBED_SESSION_LENGTH {
+		return NewAppError("Config.IsValid", "model.config.is_valid.embed_session_length.app_error", map[string]interface{}{"MaxLength": SERVICE_SETTINGS_MAX_EMBED_SESSION_LENGTH}, "", http.StatusBadRequest)
+	}
+
+	if *s.TeamDeletionGracePeriodInDays < 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.team_deletion_grace_period.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.LoginHistoryRetentionDays <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.login_history_retention_days.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !(*s.CSRFEnforcementMode == CSRF_ENFORCEMENT_MODE_ENFORCE || *s.CSRFEnforcementMode == CSRF_ENFORCEMENT_MODE_REPORT) {
+		return NewAppError("Config.IsValid", "model.config.is_valid.csrf_enforcement_mode.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	switch *s.SessionCookieSameSite {
+	case SESSION_COOKIE_SAME_SITE_DEFAULT, SESSION_COOKIE_SAME_SITE_LAX, SESSION_COOKIE_SAME_SITE_STRICT, SESSION_COOKIE_SAME_SITE_NONE:
+	default:
+		return NewAppError("Config.IsValid", "model.config.is_valid.session_cookie_same_site.app_error", nil, "", http.StatusBadRequest)
+	}
+
 	if !(*s.ConnectionSecurity == CONN_SECURITY_NONE || *s.ConnectionSecurity == CONN_SECURITY_TLS) {
 		return NewAppError("Config.IsValid", "model.config.is_valid.webserver_security.app_error", nil, "", http.StatusBadRequest)
 	}
@@ -3358,6 +3919,106 @@ func (s *DataRetentionSettings) isValid() *AppError {
 		return NewAppError("Config.IsValid", "model.config.is_valid.data_retention.deletion_job_start_time.app_error", nil, err.Error(), http.StatusBadRequest)
 	}
 
+	if *s.BatchSize <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.data_retention.batch_size.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.TimeBetweenBatchesMilliseconds < 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.data_retention.time_between_batches.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (s *ArchiveSettings) isValid() *AppError {
+	if *s.ArchiveAfterMonths <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.archive.archive_after_months.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if _, err := time.Parse("15:04", *s.ArchiveJobStartTime); err != nil {
+		return NewAppError("Config.IsValid", "model.config.is_valid.archive.job_start_time.app_error", nil, err.Error(), http.StatusBadRequest)
+	}
+
+	if *s.BatchSize <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.archive.batch_size.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (s *BackupSettings) isValid() *AppError {
+	if *s.EnableScheduledBackups && *s.Directory == "" {
+		return NewAppError("Config.IsValid", "model.config.is_valid.backup.directory.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.IntervalHours <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.backup.interval_hours.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.MaxIncrementalBackups < 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.backup.max_incremental_backups.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (s *EventStreamSettings) isValid() *AppError {
+	if *s.Sink != EVENT_STREAM_SINK_WEBHOOK && *s.Sink != EVENT_STREAM_SINK_KAFKA {
+		return NewAppError("Config.IsValid", "model.config.is_valid.event_stream.sink.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.Enable && *s.Sink == EVENT_STREAM_SINK_WEBHOOK {
+		if len(*s.WebhookURL) > EVENT_STREAM_SETTINGS_WEBHOOK_URL_MAX_LEN || !IsValidHttpUrl(*s.WebhookURL) {
+			return NewAppError("Config.IsValid", "model.config.is_valid.event_stream.webhook_url.app_error", nil, "", http.StatusBadRequest)
+		}
+
+		if len(*s.WebhookSecret) < EVENT_STREAM_SETTINGS_WEBHOOK_SECRET_MIN_LEN {
+			return NewAppError("Config.IsValid", "model.config.is_valid.event_stream.webhook_secret.app_error", map[string]interface{}{"MinLength": EVENT_STREAM_SETTINGS_WEBHOOK_SECRET_MIN_LEN}, "", http.StatusBadRequest)
+		}
+	}
+
+	if *s.Enable && *s.Sink == EVENT_STREAM_SINK_KAFKA {
+		if strings.TrimSpace(*s.KafkaBrokers) == "" {
+			return NewAppError("Config.IsValid", "model.config.is_valid.event_stream.kafka_brokers.app_error", nil, "", http.StatusBadRequest)
+		}
+
+		if *s.KafkaTopic == "" {
+			return NewAppError("Config.IsValid", "model.config.is_valid.event_stream.kafka_topic.app_error", nil, "", http.StatusBadRequest)
+		}
+	}
+
+	if *s.EventTypes != "" {
+		for _, eventType := range strings.Split(*s.EventTypes, ",") {
+			if !IsValidEventStreamEventType(strings.TrimSpace(eventType)) {
+				return NewAppError("Config.IsValid", "model.config.is_valid.event_stream.event_types.app_error", map[string]interface{}{"EventType": eventType}, "", http.StatusBadRequest)
+			}
+		}
+	}
+
+	if *s.BatchSize <= 0 || *s.BatchSize > EVENT_STREAM_SETTINGS_MAX_BATCH_SIZE {
+		return NewAppError("Config.IsValid", "model.config.is_valid.event_stream.batch_size.app_error", map[string]interface{}{"Max": EVENT_STREAM_SETTINGS_MAX_BATCH_SIZE}, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (s *ProfilingSettings) isValid() *AppError {
+	if *s.LatencyThresholdMilliseconds <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.profiling.latency_threshold.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.MemoryThresholdMB <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.profiling.memory_threshold.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.CPUProfileSeconds <= 0 || *s.CPUProfileSeconds > PROFILE_CPU_MAX_SECONDS {
+		return NewAppError("Config.IsValid", "model.config.is_valid.profiling.cpu_profile_seconds.app_error", map[string]interface{}{"Max": PROFILE_CPU_MAX_SECONDS}, "", http.StatusBadRequest)
+	}
+
+	if *s.MinimumCaptureIntervalMinutes <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.profiling.minimum_capture_interval.app_error", nil, "", http.StatusBadRequest)
+	}
+
 	return nil
 }
 
@@ -3480,6 +4141,14 @@ func (o *Config) Sanitize() {
 
 	*o.ElasticsearchSettings.Password = FAKE_SETTING
 
+	if len(*o.BackupSettings.EncryptionPassphrase) > 0 {
+		*o.BackupSettings.EncryptionPassphrase = FAKE_SETTING
+	}
+
+	if len(*o.EventStreamSettings.WebhookSecret) > 0 {
+		*o.EventStreamSettings.WebhookSecret = FAKE_SETTING
+	}
+
 	for i := range o.SqlSettings.DataSourceReplicas {
 		o.SqlSettings.DataSourceReplicas[i] = FAKE_SETTING
 	}
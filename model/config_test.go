@@ -767,6 +767,95 @@ func TestListenAddressIsValidated(t *testing.T) {
 
 }
 
+func TestServiceSettingsIsValidCSRFAndCookieModes(t *testing.T) {
+	for mode, expected := range map[string]bool{
+		CSRF_ENFORCEMENT_MODE_ENFORCE: true,
+		CSRF_ENFORCEMENT_MODE_REPORT:  true,
+		"":                            false,
+		"off":                         false,
+	} {
+		ss := &ServiceSettings{CSRFEnforcementMode: NewString(mode)}
+		ss.SetDefaults(true)
+		if expected {
+			require.Nil(t, ss.isValid(), fmt.Sprintf("Got an error from '%v'.", mode))
+		} else {
+			err := ss.isValid()
+			require.NotNil(t, err, fmt.Sprintf("Expected '%v' to throw an error.", mode))
+			require.Equal(t, "model.config.is_valid.csrf_enforcement_mode.app_error", err.Message)
+		}
+	}
+
+	for sameSite, expected := range map[string]bool{
+		SESSION_COOKIE_SAME_SITE_DEFAULT: true,
+		SESSION_COOKIE_SAME_SITE_LAX:     true,
+		SESSION_COOKIE_SAME_SITE_STRICT:  true,
+		SESSION_COOKIE_SAME_SITE_NONE:    true,
+		"":                               false,
+		"Lax":                            false,
+	} {
+		ss := &ServiceSettings{SessionCookieSameSite: NewString(sameSite)}
+		ss.SetDefaults(true)
+		if expected {
+			require.Nil(t, ss.isValid(), fmt.Sprintf("Got an error from '%v'.", sameSite))
+		} else {
+			err := ss.isValid()
+			require.NotNil(t, err, fmt.Sprintf("Expected '%v' to throw an error.", sameSite))
+			require.Equal(t, "model.config.is_valid.session_cookie_same_site.app_error", err.Message)
+		}
+	}
+}
+
+func TestServiceSettingsIsValidEmbedding(t *testing.T) {
+	for expiry, expected := range map[int]bool{
+		1:                                       true,
+		SERVICE_SETTINGS_MAX_EMBED_TOKEN_EXPIRY: true,
+		0:                                       false,
+		SERVICE_SETTINGS_MAX_EMBED_TOKEN_EXPIRY + 1: false,
+	} {
+		ss := &ServiceSettings{EmbedTokenExpiryInSeconds: NewInt(expiry)}
+		ss.SetDefaults(true)
+		if expected {
+			require.Nil(t, ss.isValid(), fmt.Sprintf("Got an error from '%v'.", expiry))
+		} else {
+			err := ss.isValid()
+			require.NotNil(t, err, fmt.Sprintf("Expected '%v' to throw an error.", expiry))
+			require.Equal(t, "model.config.is_valid.embed_token_expiry.app_error", err.Message)
+		}
+	}
+
+	for length, expected := range map[int]bool{
+		1: true,
+		SERVICE_SETTINGS_MAX_EMBED_SESSION_LENGTH: true,
+		-1: false,
+		SERVICE_SETTINGS_MAX_EMBED_SESSION_LENGTH + 1: false,
+	} {
+		ss := &ServiceSettings{EmbedSessionLengthInMinutes: NewInt(length)}
+		ss.SetDefaults(true)
+		if expected {
+			require.Nil(t, ss.isValid(), fmt.Sprintf("Got an error from '%v'.", length))
+		} else {
+			err := ss.isValid()
+			require.NotNil(t, err, fmt.Sprintf("Expected '%v' to throw an error.", length))
+			require.Equal(t, "model.config.is_valid.embed_session_length.app_error", err.Message)
+		}
+	}
+}
+
+func TestServiceSettingsTeamDeletionGracePeriodIsValid(t *testing.T) {
+	ss := &ServiceSettings{}
+	ss.SetDefaults(true)
+	require.Equal(t, 0, *ss.TeamDeletionGracePeriodInDays)
+	require.Nil(t, ss.isValid())
+
+	ss.TeamDeletionGracePeriodInDays = NewInt(30)
+	require.Nil(t, ss.isValid())
+
+	ss.TeamDeletionGracePeriodInDays = NewInt(-1)
+	err := ss.isValid()
+	require.NotNil(t, err)
+	require.Equal(t, "model.config.is_valid.team_deletion_grace_period.app_error", err.Message)
+}
+
 func TestImageProxySettingsSetDefaults(t *testing.T) {
 	ss := ServiceSettings{
 		DEPRECATED_DO_NOT_USE_ImageProxyType:    NewString(IMAGE_PROXY_TYPE_ATMOS_CAMO),
@@ -892,6 +981,136 @@ func TestImageProxySettingsIsValid(t *testing.T) {
 	}
 }
 
+func TestEventStreamSettingsIsValid(t *testing.T) {
+	for _, test := range []struct {
+		Name        string
+		Modify      func(s *EventStreamSettings)
+		ExpectError bool
+	}{
+		{
+			Name:        "defaults",
+			Modify:      func(s *EventStreamSettings) {},
+			ExpectError: false,
+		},
+		{
+			Name: "disabled with missing webhook url",
+			Modify: func(s *EventStreamSettings) {
+				*s.WebhookURL = "garbage"
+			},
+			ExpectError: false,
+		},
+		{
+			Name: "unknown sink",
+			Modify: func(s *EventStreamSettings) {
+				*s.Sink = "garbage"
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "webhook",
+			Modify: func(s *EventStreamSettings) {
+				*s.Enable = true
+				*s.WebhookURL = "https://example.com/events"
+				*s.WebhookSecret = "0123456789abcdef"
+			},
+			ExpectError: false,
+		},
+		{
+			Name: "webhook, invalid url",
+			Modify: func(s *EventStreamSettings) {
+				*s.Enable = true
+				*s.WebhookURL = "example.com/events"
+				*s.WebhookSecret = "0123456789abcdef"
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "webhook, short secret",
+			Modify: func(s *EventStreamSettings) {
+				*s.Enable = true
+				*s.WebhookURL = "https://example.com/events"
+				*s.WebhookSecret = "secret"
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "kafka",
+			Modify: func(s *EventStreamSettings) {
+				*s.Enable = true
+				*s.Sink = EVENT_STREAM_SINK_KAFKA
+				*s.KafkaBrokers = "kafka1:9092,kafka2:9092"
+			},
+			ExpectError: false,
+		},
+		{
+			Name: "kafka, missing brokers",
+			Modify: func(s *EventStreamSettings) {
+				*s.Enable = true
+				*s.Sink = EVENT_STREAM_SINK_KAFKA
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "kafka, missing topic",
+			Modify: func(s *EventStreamSettings) {
+				*s.Enable = true
+				*s.Sink = EVENT_STREAM_SINK_KAFKA
+				*s.KafkaBrokers = "kafka1:9092"
+				*s.KafkaTopic = ""
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "event types",
+			Modify: func(s *EventStreamSettings) {
+				*s.EventTypes = "post_created, user_updated"
+			},
+			ExpectError: false,
+		},
+		{
+			Name: "unknown event type",
+			Modify: func(s *EventStreamSettings) {
+				*s.EventTypes = "post_created,garbage"
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "batch size too large",
+			Modify: func(s *EventStreamSettings) {
+				*s.BatchSize = EVENT_STREAM_SETTINGS_MAX_BATCH_SIZE + 1
+			},
+			ExpectError: true,
+		},
+	} {
+		t.Run(test.Name, func(t *testing.T) {
+			s := &EventStreamSettings{}
+			s.SetDefaults()
+			test.Modify(s)
+
+			err := s.isValid()
+			if test.ExpectError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestEventStreamSettingsPublishesEventType(t *testing.T) {
+	s := &EventStreamSettings{}
+	s.SetDefaults()
+
+	for _, eventType := range EventStreamEventTypes {
+		assert.True(t, s.PublishesEventType(eventType))
+	}
+
+	*s.EventTypes = "post_created, user_updated"
+	assert.True(t, s.PublishesEventType(EVENT_STREAM_POST_CREATED))
+	assert.True(t, s.PublishesEventType(EVENT_STREAM_USER_UPDATED))
+	assert.False(t, s.PublishesEventType(EVENT_STREAM_POST_DELETED))
+}
+
 func TestLdapSettingsIsValid(t *testing.T) {
 	for _, test := range []struct {
 		Name         string
@@ -1251,6 +1470,7 @@ func TestConfigSanitize(t *testing.T) {
 	*c.FileSettings.AmazonS3SecretAccessKey = "bar"
 	*c.EmailSettings.SMTPPassword = "baz"
 	*c.GitLabSettings.Secret = "bingo"
+	*c.EventStreamSettings.WebhookSecret = "qux"
 	c.SqlSettings.DataSourceReplicas = []string{"stuff"}
 	c.SqlSettings.DataSourceSearchReplicas = []string{"stuff"}
 
@@ -1264,6 +1484,7 @@ func TestConfigSanitize(t *testing.T) {
 	assert.Equal(t, FAKE_SETTING, *c.SqlSettings.DataSource)
 	assert.Equal(t, FAKE_SETTING, *c.SqlSettings.AtRestEncryptKey)
 	assert.Equal(t, FAKE_SETTING, *c.ElasticsearchSettings.Password)
+	assert.Equal(t, FAKE_SETTING, *c.EventStreamSettings.WebhookSecret)
 	assert.Equal(t, FAKE_SETTING, c.SqlSettings.DataSourceReplicas[0])
 	assert.Equal(t, FAKE_SETTING, c.SqlSettings.DataSourceSearchReplicas[0])
 }
@@ -1301,3 +1522,91 @@ func TestConfigMarketplaceDefaults(t *testing.T) {
 		require.Equal(t, "https://marketplace.example.com", *c.PluginSettings.MarketplaceUrl)
 	})
 }
+
+func TestExperimentalSettingsIsValidStoreFaultInjection(t *testing.T) {
+	tests := []struct {
+		name         string
+		latency      int64
+		replicaLag   int64
+		errorPercent int
+		valid        bool
+	}{
+		{name: "defaults", valid: true},
+		{name: "all faults", latency: 100, replicaLag: 500, errorPercent: 100, valid: true},
+		{name: "negative latency", latency: -1, valid: false},
+		{name: "negative replica lag", replicaLag: -1, valid: false},
+		{name: "negative error percent", errorPercent: -1, valid: false},
+		{name: "error percent over 100", errorPercent: 101, valid: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			es := &ExperimentalSettings{}
+			es.SetDefaults()
+			es.StoreFaultInjectionLatencyMilliseconds = NewInt64(test.latency)
+			es.StoreFaultInjectionReplicaLagMilliseconds = NewInt64(test.replicaLag)
+			es.StoreFaultInjectionErrorPercent = NewInt(test.errorPercent)
+
+			if test.valid {
+				require.Nil(t, es.isValid())
+			} else {
+				require.NotNil(t, es.isValid())
+			}
+		})
+	}
+}
+
+func TestProfilingSettingsIsValid(t *testing.T) {
+	for _, test := range []struct {
+		Name        string
+		Modify      func(s *ProfilingSettings)
+		ExpectError bool
+	}{
+		{
+			Name:        "defaults",
+			Modify:      func(s *ProfilingSettings) {},
+			ExpectError: false,
+		},
+		{
+			Name: "zero latency threshold",
+			Modify: func(s *ProfilingSettings) {
+				*s.LatencyThresholdMilliseconds = 0
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "zero memory threshold",
+			Modify: func(s *ProfilingSettings) {
+				*s.MemoryThresholdMB = 0
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "cpu profile too long",
+			Modify: func(s *ProfilingSettings) {
+				*s.CPUProfileSeconds = PROFILE_CPU_MAX_SECONDS + 1
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "zero minimum capture interval",
+			Modify: func(s *ProfilingSettings) {
+				*s.MinimumCaptureIntervalMinutes = 0
+			},
+			ExpectError: true,
+		},
+	} {
+		t.Run(test.Name, func(t *testing.T) {
+			s := &ProfilingSettings{}
+			s.SetDefaults()
+			test.Modify(s)
+
+			err := s.isValid()
+			if test.ExpectError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+)
+
+const (
+	// DEBUG_CAPTURE_MAX_DURATION bounds how long a capture rule stays active, so that a forgotten
+	// rule doesn't keep recording requests.
+	DEBUG_CAPTURE_MAX_DURATION = int64(time.Hour / time.Millisecond)
+	// DEBUG_CAPTURE_MAX_BODY_SIZE is the number of bytes of the request and response bodies kept
+	// in a capture.
+	DEBUG_CAPTURE_MAX_BODY_SIZE = 16 * 1024
+)
+
+// DebugCaptureRule enables the capture of the requests to an endpoint, of the requests of a user, or
+// of the requests of a user to an endpoint, until ExpiresAt. If LogLevel is set, the messages the
+// matching requests log at that level or above are also written whatever the level of the logger.
+type DebugCaptureRule struct {
+	Id        string `json:"id"`
+	Endpoint  string `json:"endpoint"`
+	UserId    string `json:"user_id"`
+	LogLevel  string `json:"log_level,omitempty"`
+	CreatorId string `json:"creator_id"`
+	CreateAt  int64  `json:"create_at"`
+	ExpiresAt int64  `json:"expires_at"`
+}
+
+func (r *DebugCaptureRule) ToJson() string {
+	b, _ := json.Marshal(r)
+	return string(b)
+}
+
+func DebugCaptureRuleFromJson(data io.Reader) *DebugCaptureRule {
+	var r *DebugCaptureRule
+	json.NewDecoder(data).Decode(&r)
+	return r
+}
+
+func DebugCaptureRuleListToJson(rules []*DebugCaptureRule) string {
+	b, _ := json.Marshal(rules)
+	return string(b)
+}
+
+func DebugCaptureRuleListFromJson(data io.Reader) []*DebugCaptureRule {
+	var rules []*DebugCaptureRule
+	json.NewDecoder(data).Decode(&rules)
+	return rules
+}
+
+func (r *DebugCaptureRule) IsValid(now int64) *AppError {
+	if !IsValidId(r.Id) {
+		return NewAppError("DebugCaptureRule.IsValid", "model.debug_capture_rule.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if r.Endpoint == "" && r.UserId == "" {
+		return NewAppError("DebugCaptureRule.IsValid", "model.debug_capture_rule.is_valid.target.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if len(r.Endpoint) > 128 {
+		return NewAppError("DebugCaptureRule.IsValid", "model.debug_capture_rule.is_valid.endpoint.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if r.UserId != "" && !IsValidId(r.UserId) {
+		return NewAppError("DebugCaptureRule.IsValid", "model.debug_capture_rule.is_valid.user_id.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	switch r.LogLevel {
+	case "", mlog.LevelDebug, mlog.LevelInfo, mlog.LevelWarn, mlog.LevelError:
+	default:
+		return NewAppError("DebugCaptureRule.IsValid", "model.debug_capture_rule.is_valid.log_level.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if r.ExpiresAt <= now || r.ExpiresAt > now+DEBUG_CAPTURE_MAX_DURATION {
+		return NewAppError("DebugCaptureRule.IsValid", "model.debug_capture_rule.is_valid.expires_at.app_error", map[string]interface{}{"MaxMinutes": DEBUG_CAPTURE_MAX_DURATION / int64(time.Minute/time.Millisecond)}, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// Matches reports whether the request of the user to the endpoint, named after its handler, is to
+// be captured at the given time.
+func (r *DebugCaptureRule) Matches(endpoint, userId string, now int64) bool {
+	if now >= r.ExpiresAt {
+		return false
+	}
+
+	if r.Endpoint != "" && r.Endpoint != endpoint {
+		return false
+	}
+
+	return r.UserId == "" || r.UserId == userId
+}
+
+// DebugCapture is the record of a request captured by a DebugCaptureRule. The credentials found in
+// the request headers are redacted, and the bodies are truncated to DEBUG_CAPTURE_MAX_BODY_SIZE.
+type DebugCapture struct {
+	RuleId         string            `json:"rule_id"`
+	RequestId      string            `json:"request_id"`
+	UserId         string            `json:"user_id"`
+	Endpoint       string            `json:"endpoint"`
+	Method         string            `json:"method"`
+	Path           string            `json:"path"`
+	Query          string            `json:"query,omitempty"`
+	StatusCode     int               `json:"status_code"`
+	CreateAt       int64             `json:"create_at"`
+	Elapsed        float64           `json:"elapsed"`
+	RequestHeaders map[string]string `json:"request_headers"`
+	RequestBody    string            `json:"request_body,omitempty"`
+	ResponseBody   string            `json:"response_body,omitempty"`
+	Error          string            `json:"error,omitempty"`
+}
+
+func DebugCaptureListToJson(captures []*DebugCapture) string {
+	b, _ := json.Marshal(captures)
+	return string(b)
+}
+
+func DebugCaptureListFromJson(data io.Reader) []*DebugCapture {
+	var captures []*DebugCapture
+	json.NewDecoder(data).Decode(&captures)
+	return captures
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDebugCaptureRuleIsValid(t *testing.T) {
+	now := GetMillis()
+	rule := &DebugCaptureRule{Id: NewId(), CreateAt: now, ExpiresAt: now + 60000}
+
+	require.NotNil(t, rule.IsValid(now), "a rule must target an endpoint or a user")
+
+	rule.Endpoint = "getUser"
+	require.Nil(t, rule.IsValid(now))
+
+	rule.UserId = "invalid"
+	require.NotNil(t, rule.IsValid(now))
+
+	rule.UserId = NewId()
+	require.Nil(t, rule.IsValid(now))
+
+	rule.Endpoint = strings.Repeat("a", 129)
+	require.NotNil(t, rule.IsValid(now))
+	rule.Endpoint = ""
+
+	rule.LogLevel = "verbose"
+	require.NotNil(t, rule.IsValid(now))
+
+	rule.LogLevel = "debug"
+	require.Nil(t, rule.IsValid(now))
+
+	rule.ExpiresAt = now
+	require.NotNil(t, rule.IsValid(now))
+
+	rule.ExpiresAt = now + DEBUG_CAPTURE_MAX_DURATION + 1
+	require.NotNil(t, rule.IsValid(now))
+}
+
+func TestDebugCaptureRuleMatches(t *testing.T) {
+	userId := NewId()
+
+	rule := &DebugCaptureRule{Endpoint: "getUser", ExpiresAt: 100}
+	assert.True(t, rule.Matches("getUser", userId, 0))
+	assert.False(t, rule.Matches("getUser", userId, 100))
+	assert.False(t, rule.Matches("getChannel", userId, 0))
+
+	rule = &DebugCaptureRule{UserId: userId, ExpiresAt: 100}
+	assert.True(t, rule.Matches("getUser", userId, 0))
+	assert.False(t, rule.Matches("getUser", NewId(), 0))
+
+	rule = &DebugCaptureRule{Endpoint: "getUser", UserId: userId, ExpiresAt: 100}
+	assert.True(t, rule.Matches("getUser", userId, 0))
+	assert.False(t, rule.Matches("getChannel", userId, 0))
+	assert.False(t, rule.Matches("getUser", NewId(), 0))
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+)
+
+const (
+	DIAGNOSTICS_CATEGORY_ACTIVITY           = "activity"
+	DIAGNOSTICS_CATEGORY_CONFIG             = "config"
+	DIAGNOSTICS_CATEGORY_LICENSE            = "license"
+	DIAGNOSTICS_CATEGORY_PLUGINS            = "plugins"
+	DIAGNOSTICS_CATEGORY_SERVER             = "server"
+	DIAGNOSTICS_CATEGORY_PERMISSIONS        = "permissions"
+	DIAGNOSTICS_CATEGORY_SEARCH             = "search"
+	DIAGNOSTICS_CATEGORY_GROUPS             = "groups"
+	DIAGNOSTICS_CATEGORY_CHANNEL_MODERATION = "channel_moderation"
+)
+
+// AllDiagnosticsCategories returns the categories of diagnostics the server can collect, which are
+// all opted in by default.
+func AllDiagnosticsCategories() []string {
+	return []string{
+		DIAGNOSTICS_CATEGORY_ACTIVITY,
+		DIAGNOSTICS_CATEGORY_CONFIG,
+		DIAGNOSTICS_CATEGORY_LICENSE,
+		DIAGNOSTICS_CATEGORY_PLUGINS,
+		DIAGNOSTICS_CATEGORY_SERVER,
+		DIAGNOSTICS_CATEGORY_PERMISSIONS,
+		DIAGNOSTICS_CATEGORY_SEARCH,
+		DIAGNOSTICS_CATEGORY_GROUPS,
+		DIAGNOSTICS_CATEGORY_CHANNEL_MODERATION,
+	}
+}
+
+func IsValidDiagnosticsCategory(category string) bool {
+	for _, c := range AllDiagnosticsCategories() {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+// DiagnosticsEvent is a single event of the diagnostics collected by the server.
+type DiagnosticsEvent struct {
+	Event      string                 `json:"event"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// DiagnosticsExport holds the diagnostics events of one collection, exactly as they are sent.
+type DiagnosticsExport struct {
+	DiagnosticId string              `json:"diagnostic_id"`
+	CreateAt     int64               `json:"create_at"`
+	Categories   []string            `json:"categories"`
+	Events       []*DiagnosticsEvent `json:"events"`
+}
+
+func (o *DiagnosticsExport) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func DiagnosticsExportFromJson(data io.Reader) *DiagnosticsExport {
+	var o *DiagnosticsExport
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
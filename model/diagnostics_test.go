@@ -0,0 +1,55 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsValidDiagnosticsCategory(t *testing.T) {
+	for _, category := range AllDiagnosticsCategories() {
+		assert.True(t, IsValidDiagnosticsCategory(category), category)
+	}
+	assert.False(t, IsValidDiagnosticsCategory(""))
+	assert.False(t, IsValidDiagnosticsCategory("junk"))
+}
+
+func TestDiagnosticsExportJson(t *testing.T) {
+	export := &DiagnosticsExport{
+		DiagnosticId: NewId(),
+		CreateAt:     GetMillis(),
+		Categories:   []string{DIAGNOSTICS_CATEGORY_SERVER},
+		Events: []*DiagnosticsEvent{
+			{Event: "server", Properties: map[string]interface{}{"version": "5.0.0"}},
+		},
+	}
+
+	result := DiagnosticsExportFromJson(strings.NewReader(export.ToJson()))
+	require.NotNil(t, result)
+	assert.Equal(t, export, result)
+}
+
+func TestLogSettingsIsValid(t *testing.T) {
+	s := LogSettings{}
+	s.SetDefaults()
+	assert.Equal(t, AllDiagnosticsCategories(), s.DiagnosticsCategories)
+	require.Nil(t, s.isValid())
+
+	s.DiagnosticsCategories = []string{}
+	require.Nil(t, s.isValid())
+
+	s.DiagnosticsCategories = []string{DIAGNOSTICS_CATEGORY_SERVER, "junk"}
+	require.NotNil(t, s.isValid())
+
+	s.DiagnosticsCategories = nil
+	s.DiagnosticsSinkURL = NewString("not a url")
+	require.NotNil(t, s.isValid())
+
+	s.DiagnosticsSinkURL = NewString("https://analytics.example.com/mattermost")
+	require.Nil(t, s.isValid())
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+// EmailVerification tracks the deadline an unverified user has to verify their email address
+// before their account gets locked.
+type EmailVerification struct {
+	UserId   string `json:"user_id"`
+	CreateAt int64  `json:"create_at"`
+	Deadline int64  `json:"deadline"`
+	// RemindedAt is the time the user was reminded of the deadline, or zero if they haven't been yet.
+	RemindedAt int64 `json:"reminded_at"`
+	// LockedAt is the time the account got locked for missing the deadline, or zero if it hasn't.
+	LockedAt int64 `json:"locked_at"`
+}
+
+// UnverifiedUser is an entry of the report of the accounts whose email address hasn't been
+// verified. The deadline fields are zero for the users who haven't logged in since the grace
+// period was enabled.
+type UnverifiedUser struct {
+	UserId     string `json:"user_id"`
+	Username   string `json:"username"`
+	Email      string `json:"email"`
+	CreateAt   int64  `json:"create_at"`
+	Deadline   int64  `json:"deadline"`
+	RemindedAt int64  `json:"reminded_at"`
+	LockedAt   int64  `json:"locked_at"`
+}
+
+func (o *EmailVerification) PreSave() {
+	if o.CreateAt == 0 {
+		o.CreateAt = GetMillis()
+	}
+}
+
+func (o *EmailVerification) IsValid() *AppError {
+	if !IsValidId(o.UserId) {
+		return NewAppError("EmailVerification.IsValid", "model.email_verification.is_valid.user_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("EmailVerification.IsValid", "model.email_verification.is_valid.create_at.app_error", nil, "user_id="+o.UserId, http.StatusBadRequest)
+	}
+
+	if o.Deadline < o.CreateAt {
+		return NewAppError("EmailVerification.IsValid", "model.email_verification.is_valid.deadline.app_error", nil, "user_id="+o.UserId, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// IsOverdue reports whether the deadline has passed at the given time.
+func (o *EmailVerification) IsOverdue(now int64) bool {
+	return now >= o.Deadline
+}
+
+func UnverifiedUserListToJson(l []*UnverifiedUser) string {
+	b, _ := json.Marshal(l)
+	return string(b)
+}
+
+func UnverifiedUserListFromJson(data io.Reader) []*UnverifiedUser {
+	var l []*UnverifiedUser
+	json.NewDecoder(data).Decode(&l)
+	return l
+}
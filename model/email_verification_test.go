@@ -0,0 +1,40 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailVerificationIsValid(t *testing.T) {
+	verification := &EmailVerification{UserId: NewId(), Deadline: GetMillis() + 1000}
+	verification.PreSave()
+	require.Nil(t, verification.IsValid())
+
+	verification.UserId = "junk"
+	require.NotNil(t, verification.IsValid())
+
+	verification.UserId = NewId()
+	verification.Deadline = verification.CreateAt - 1
+	require.NotNil(t, verification.IsValid())
+}
+
+func TestEmailVerificationIsOverdue(t *testing.T) {
+	verification := &EmailVerification{Deadline: 1000}
+
+	assert.False(t, verification.IsOverdue(999))
+	assert.True(t, verification.IsOverdue(1000))
+	assert.True(t, verification.IsOverdue(1001))
+}
+
+func TestUnverifiedUserListJson(t *testing.T) {
+	users := []*UnverifiedUser{{UserId: NewId(), Username: "user", Deadline: 1000}}
+
+	rusers := UnverifiedUserListFromJson(strings.NewReader(UnverifiedUserListToJson(users)))
+	assert.Equal(t, users, rusers)
+}
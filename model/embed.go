@@ -0,0 +1,88 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+	"net/url"
+	"strings"
+)
+
+// EmbedToken is a short-lived, single use token minted for an OAuth app to embed the view of a
+// channel on behalf of its user. The embedded frame receives it from the parent application with
+// postMessage and exchanges it for a session restricted to the channel.
+type EmbedToken struct {
+	Token     string `json:"token"`
+	AppId     string `json:"app_id"`
+	ChannelId string `json:"channel_id"`
+	ExpiresAt int64  `json:"expires_at"`
+}
+
+// EmbedTokenExtra is what is stored along with an embed token until it is exchanged.
+type EmbedTokenExtra struct {
+	AppId     string `json:"app_id"`
+	UserId    string `json:"user_id"`
+	ChannelId string `json:"channel_id"`
+}
+
+// EmbedConfig is what an embedded frame needs to know before the handshake with its parent
+// application: the origins it may accept the embed token from.
+type EmbedConfig struct {
+	AppId          string   `json:"app_id"`
+	FrameAncestors []string `json:"frame_ancestors"`
+}
+
+func (o *EmbedToken) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func EmbedTokenFromJson(data io.Reader) *EmbedToken {
+	var o *EmbedToken
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
+
+func (o *EmbedTokenExtra) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func EmbedTokenExtraFromJson(data io.Reader) *EmbedTokenExtra {
+	var o *EmbedTokenExtra
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
+
+func (o *EmbedConfig) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func EmbedConfigFromJson(data io.Reader) *EmbedConfig {
+	var o *EmbedConfig
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
+
+// IsValidEmbedOrigin returns whether the value is an origin usable as a frame ancestor and as
+// the target of postMessage: an http or https scheme and a host, without path, query or fragment.
+func IsValidEmbedOrigin(origin string) bool {
+	// Origins end up in the Content-Security-Policy header, which they must not be able to extend.
+	if strings.ContainsAny(origin, " \t\r\n;,'\"") {
+		return false
+	}
+
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return false
+	}
+
+	return u.Host != "" && u.Scheme+"://"+u.Host == origin
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidEmbedOrigin(t *testing.T) {
+	for origin, valid := range map[string]bool{
+		"https://example.com":                         true,
+		"http://example.com:8080":                     true,
+		"https://example.com/":                        false,
+		"https://example.com/path":                    false,
+		"https://example.com?query":                   false,
+		"https://example.com#fragment":                false,
+		"https://user@example.com":                    false,
+		"ftp://example.com":                           false,
+		"example.com":                                 false,
+		"":                                            false,
+		"https://example.com; script-src *":           false,
+		"https://example.com 'unsafe-inline'":         false,
+		"https://example.com,https://other.com":       false,
+		"javascript://example.com/%0Aalert(document)": false,
+	} {
+		assert.Equal(t, valid, IsValidEmbedOrigin(origin), origin)
+	}
+}
+
+func TestOAuthAppAllowsEmbedOrigin(t *testing.T) {
+	app := &OAuthApp{EmbedFrameAncestors: []string{"https://example.com"}}
+
+	assert.True(t, app.AllowsEmbedOrigin("https://example.com"))
+	assert.False(t, app.AllowsEmbedOrigin("https://other.com"))
+	assert.False(t, app.AllowsEmbedOrigin(""))
+}
@@ -24,6 +24,8 @@ type Emoji struct {
 	DeleteAt  int64  `json:"delete_at"`
 	CreatorId string `json:"creator_id"`
 	Name      string `json:"name"`
+	// TeamId scopes the emoji to the members of a team. The emoji is available to everyone when empty.
+	TeamId string `json:"team_id,omitempty"`
 }
 
 func inSystemEmoji(emojiName string) bool {
@@ -53,6 +55,10 @@ func (emoji *Emoji) IsValid() *AppError {
 		return NewAppError("Emoji.IsValid", "model.emoji.user_id.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if emoji.TeamId != "" && !IsValidId(emoji.TeamId) {
+		return NewAppError("Emoji.IsValid", "model.emoji.team_id.app_error", nil, "id="+emoji.Id, http.StatusBadRequest)
+	}
+
 	return IsValidEmojiName(emoji.Name)
 }
 
@@ -58,4 +58,11 @@ func TestEmojiIsValid(t *testing.T) {
 
 	emoji.Name = "croissant"
 	require.NotNil(t, emoji.IsValid())
+
+	emoji.Name = "name"
+	emoji.TeamId = "team"
+	require.NotNil(t, emoji.IsValid())
+
+	emoji.TeamId = NewId()
+	require.Nil(t, emoji.IsValid())
 }
@@ -0,0 +1,49 @@
+//go:generate go run error_catalog_generator/main.go
+
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+)
+
+// ErrorCatalogEntry describes one of the errors the server may return. The id of an error is also
+// the key of its user-facing message in the translation files.
+type ErrorCatalogEntry struct {
+	Id          string `json:"id"`
+	StatusCodes []int  `json:"status_codes"`
+	Message     string `json:"message,omitempty"`
+}
+
+// GetErrorCatalog returns the errors created by the server, sorted by id, as generated from the
+// sources by "make error-catalog". Status codes only known at runtime are left out.
+func GetErrorCatalog() []*ErrorCatalogEntry {
+	entries := make([]*ErrorCatalogEntry, 0, len(errorCatalog))
+	for id, statusCodes := range errorCatalog {
+		entries = append(entries, &ErrorCatalogEntry{
+			Id:          id,
+			StatusCodes: append([]int{}, statusCodes...),
+		})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Id < entries[j].Id
+	})
+
+	return entries
+}
+
+func ErrorCatalogEntryListToJson(l []*ErrorCatalogEntry) string {
+	b, _ := json.Marshal(l)
+	return string(b)
+}
+
+func ErrorCatalogEntryListFromJson(data io.Reader) []*ErrorCatalogEntry {
+	var o []*ErrorCatalogEntry
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
@@ -0,0 +1,1887 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Code generated by "make error-catalog"
+// DO NOT EDIT
+
+package model
+
+var errorCatalog = map[string][]int{
+	"api.admin.add_certificate.array.app_error":                                            {400},
+	"api.admin.add_certificate.no_file.app_error":                                          {400},
+	"api.admin.add_certificate.open.app_error":                                             {500},
+	"api.admin.add_certificate.saving.app_error":                                           {500},
+	"api.admin.delete_brand_image.storage.not_found":                                       {404},
+	"api.admin.file_read_error":                                                            {500},
+	"api.admin.get_brand_image.storage.app_error":                                          {501},
+	"api.admin.remove_certificate.delete.app_error":                                        {500},
+	"api.admin.saml.failure_get_metadata_from_idp.app_error":                               {400},
+	"api.admin.saml.failure_parse_idp_certificate.app_error":                               {500},
+	"api.admin.saml.failure_save_idp_certificate_file.app_error":                           {500},
+	"api.admin.saml.invalid_xml_missing_idpssodescriptors.app_error":                       {500},
+	"api.admin.saml.invalid_xml_missing_keydescriptor.app_error":                           {500},
+	"api.admin.saml.invalid_xml_missing_ssoservices.app_error":                             {500},
+	"api.admin.saml.metadata.app_error":                                                    {},
+	"api.admin.saml.not_available.app_error":                                               {501},
+	"api.admin.saml.set_certificate_from_metadata.invalid_body.app_error":                  {400},
+	"api.admin.saml.set_certificate_from_metadata.invalid_content_type.app_error":          {400},
+	"api.admin.saml.set_certificate_from_metadata.missing_content_type.app_error":          {400},
+	"api.admin.test_email.missing_server":                                                  {400},
+	"api.admin.test_email.reenter_password":                                                {400},
+	"api.admin.test_s3.missing_s3_bucket":                                                  {400},
+	"api.admin.upload_brand_image.array.app_error":                                         {400},
+	"api.admin.upload_brand_image.no_file.app_error":                                       {400},
+	"api.admin.upload_brand_image.parse.app_error":                                         {400},
+	"api.admin.upload_brand_image.storage.app_error":                                       {501},
+	"api.admin.upload_brand_image.too_large.app_error":                                     {413},
+	"api.bot.create_disabled":                                                              {403},
+	"api.bot.delete_bot_icon_image.app_error":                                              {500},
+	"api.bot.get_bot_icon_image.read.app_error":                                            {404},
+	"api.bot.set_bot_icon_image.app_error":                                                 {500},
+	"api.bot.set_bot_icon_image.array.app_error":                                           {400},
+	"api.bot.set_bot_icon_image.no_file.app_error":                                         {400},
+	"api.bot.set_bot_icon_image.open.app_error":                                            {400},
+	"api.bot.set_bot_icon_image.parse.app_error":                                           {400, 500},
+	"api.bot.set_bot_icon_image.too_large.app_error":                                       {413},
+	"api.channel.add_members.error":                                                        {400},
+	"api.channel.add_members.user_denied":                                                  {400},
+	"api.channel.add_user.to.channel.failed.app_error":                                     {500},
+	"api.channel.add_user.to.channel.failed.deleted.app_error":                             {400},
+	"api.channel.add_user_to_channel.type.app_error":                                       {400, 500},
+	"api.channel.channel_member_counts_by_group.license.error":                             {501},
+	"api.channel.convert_channel_to_private.default_channel_error":                         {400},
+	"api.channel.convert_channel_to_private.private_channel_error":                         {400},
+	"api.channel.create_channel.direct_channel.app_error":                                  {400},
+	"api.channel.create_channel.max_channel_limit.app_error":                               {400},
+	"api.channel.create_direct_channel.invalid_user.app_error":                             {400},
+	"api.channel.create_group.bad_size.app_error":                                          {400},
+	"api.channel.create_group.bad_user.app_error":                                          {400},
+	"api.channel.delete_channel.cannot.app_error":                                          {400},
+	"api.channel.delete_channel.deleted.app_error":                                         {400},
+	"api.channel.delete_channel.type.invalid":                                              {400},
+	"api.channel.get_channel_moderations.license.error":                                    {501},
+	"api.channel.join_channel.permissions.app_error":                                       {400},
+	"api.channel.leave.direct.app_error":                                                   {400},
+	"api.channel.leave.last_member.app_error":                                              {400},
+	"api.channel.move_channel.type.invalid":                                                {403},
+	"api.channel.patch_channel_moderations.license.error":                                  {501},
+	"api.channel.patch_update_channel.forbidden.app_error":                                 {403},
+	"api.channel.post_channel_privacy_message.error":                                       {500},
+	"api.channel.post_update_channel_displayname_message_and_forget.create_post.error":     {500},
+	"api.channel.post_update_channel_displayname_message_and_forget.retrieve_user.error":   {400},
+	"api.channel.post_update_channel_header_message_and_forget.post.error":                 {500},
+	"api.channel.post_update_channel_header_message_and_forget.retrieve_user.error":        {400},
+	"api.channel.post_user_add_remove_message_and_forget.error":                            {500},
+	"api.channel.remove.default.app_error":                                                 {400},
+	"api.channel.remove_channel_member.type.app_error":                                     {400},
+	"api.channel.remove_member.group_constrained.app_error":                                {400},
+	"api.channel.remove_members.denied":                                                    {400},
+	"api.channel.remove_user_from_channel.app_error":                                       {500},
+	"api.channel.rename_channel.cant_rename_direct_messages.app_error":                     {400},
+	"api.channel.rename_channel.cant_rename_group_messages.app_error":                      {400},
+	"api.channel.restore_channel.restored.app_error":                                       {400},
+	"api.channel.update_channel.deleted.app_error":                                         {400},
+	"api.channel.update_channel.tried.app_error":                                           {400},
+	"api.channel.update_channel.typechange.app_error":                                      {400},
+	"api.channel.update_channel_member_roles.changing_guest_role.app_error":                {400},
+	"api.channel.update_channel_member_roles.guest_and_user.app_error":                     {400},
+	"api.channel.update_channel_member_roles.scheme_role.app_error":                        {400},
+	"api.channel.update_channel_privacy.default_channel_error":                             {400},
+	"api.channel.update_channel_scheme.license.error":                                      {501},
+	"api.channel.update_channel_scheme.scheme_scope.error":                                 {400},
+	"api.channel.update_team_member_roles.changing_guest_role.app_error":                   {400},
+	"api.channel.update_team_member_roles.scheme_role.app_error":                           {400},
+	"api.channel_bookmark.channel_archived.app_error":                                      {400},
+	"api.channel_bookmark.forbidden.app_error":                                             {403},
+	"api.command.admin_only.app_error":                                                     {403},
+	"api.command.command_post.forbidden.app_error":                                         {403},
+	"api.command.disabled.app_error":                                                       {501},
+	"api.command.duplicate_trigger.app_error":                                              {400},
+	"api.command.execute_command.create_post_failed.app_error":                             {500},
+	"api.command.execute_command.failed.app_error":                                         {500},
+	"api.command.execute_command.failed_empty.app_error":                                   {500},
+	"api.command.execute_command.failed_resp.app_error":                                    {500},
+	"api.command.execute_command.format.app_error":                                         {400},
+	"api.command.execute_command.not_found.app_error":                                      {404},
+	"api.command.execute_command.start.app_error":                                          {400},
+	"api.command.team_mismatch.app_error":                                                  {400},
+	"api.config.client.old_format.app_error":                                               {501},
+	"api.config.update_config.clear_siteurl.app_error":                                     {400},
+	"api.config.update_config.restricted_merge.app_error":                                  {500},
+	"api.context.404.app_error":                                                            {404},
+	"api.context.impersonation_read_only.app_error":                                        {403},
+	"api.context.invalid_body_param.app_error":                                             {400},
+	"api.context.invalid_param.app_error":                                                  {400},
+	"api.context.invalid_token.error":                                                      {401},
+	"api.context.invalid_url_param.app_error":                                              {400},
+	"api.context.local_origin_required.app_error":                                          {401},
+	"api.context.mfa_required.app_error":                                                   {403},
+	"api.context.permissions.app_error":                                                    {403},
+	"api.context.read_only_mode.app_error":                                                 {503},
+	"api.context.server_busy.app_error":                                                    {503},
+	"api.context.session_expired.app_error":                                                {401},
+	"api.context.terms_of_service_policy_required.app_error":                               {403},
+	"api.context.token_provided.app_error":                                                 {401},
+	"api.create_terms_of_service.custom_terms_of_service_disabled.app_error":               {400, 501},
+	"api.create_terms_of_service.empty_text.app_error":                                     {400},
+	"api.email_batching.add_notification_email_to_batch.channel_full.app_error":            {500},
+	"api.email_batching.add_notification_email_to_batch.disabled.app_error":                {501},
+	"api.emoji.create.duplicate.app_error":                                                 {400},
+	"api.emoji.create.internal_error":                                                      {400},
+	"api.emoji.create.other_user.app_error":                                                {403},
+	"api.emoji.create.parse.app_error":                                                     {400},
+	"api.emoji.create.too_large.app_error":                                                 {413},
+	"api.emoji.disabled.app_error":                                                         {501},
+	"api.emoji.get_image.decode.app_error":                                                 {500},
+	"api.emoji.get_image.read.app_error":                                                   {404},
+	"api.emoji.storage.app_error":                                                          {501},
+	"api.emoji.upload.image.app_error":                                                     {400},
+	"api.emoji.upload.large_image.decode_error":                                            {400},
+	"api.emoji.upload.large_image.encode_error":                                            {400},
+	"api.emoji.upload.large_image.gif_decode_error":                                        {400},
+	"api.emoji.upload.large_image.gif_encode_error":                                        {400},
+	"api.emoji.upload.large_image.too_large.app_error":                                     {400},
+	"api.emoji.upload.open.app_error":                                                      {400},
+	"api.file.attachments.disabled.app_error":                                              {501},
+	"api.file.file_exists.exists_local.app_error":                                          {500},
+	"api.file.file_exists.s3.app_error":                                                    {500},
+	"api.file.get_file.public_invalid.app_error":                                           {400},
+	"api.file.get_file_preview.no_preview.app_error":                                       {400},
+	"api.file.get_file_thumbnail.no_thumbnail.app_error":                                   {400},
+	"api.file.get_public_link.disabled.app_error":                                          {501},
+	"api.file.get_public_link.no_post.app_error":                                           {400},
+	"api.file.move_file.copy_within_s3.app_error":                                          {500},
+	"api.file.move_file.delete_from_s3.app_error":                                          {500},
+	"api.file.move_file.rename.app_error":                                                  {500},
+	"api.file.no_driver.app_error":                                                         {500},
+	"api.file.read_file.reading_local.app_error":                                           {500, 501},
+	"api.file.read_file.s3.app_error":                                                      {500},
+	"api.file.reader.reading_local.app_error":                                              {500},
+	"api.file.reader.s3.app_error":                                                         {500},
+	"api.file.test_connection.local.connection.app_error":                                  {500},
+	"api.file.test_connection.s3.bucked_create.app_error":                                  {500},
+	"api.file.test_connection.s3.bucket_exists.app_error":                                  {500},
+	"api.file.test_connection.s3.connection.app_error":                                     {500},
+	"api.file.upload_file.incorrect_channelId.app_error":                                   {400},
+	"api.file.upload_file.incorrect_number_of_client_ids.app_error":                        {400},
+	"api.file.upload_file.incorrect_number_of_files.app_error":                             {400},
+	"api.file.upload_file.large_image.app_error":                                           {400},
+	"api.file.upload_file.multiple_channel_ids.app_error":                                  {400},
+	"api.file.upload_file.read_form_value.app_error":                                       {400},
+	"api.file.upload_file.read_request.app_error":                                          {400, 500},
+	"api.file.upload_file.storage.app_error":                                               {501},
+	"api.file.write_file.s3.app_error":                                                     {500},
+	"api.file.write_file_locally.create_dir.app_error":                                     {500},
+	"api.file.write_file_locally.writing.app_error":                                        {500},
+	"api.image.get.app_error":                                                              {400},
+	"api.incoming_webhook.disabled.app_error":                                              {501},
+	"api.incoming_webhook.invalid_username.app_error":                                      {400},
+	"api.invalid_channel":                                                                  {400},
+	"api.io_error":                                                                         {400},
+	"api.ldap_group.not_found":                                                             {404},
+	"api.ldap_groups.existing_group_name_error":                                            {501},
+	"api.ldap_groups.existing_reserved_name_error":                                         {501},
+	"api.ldap_groups.existing_user_name_error":                                             {501},
+	"api.ldap_groups.license_error":                                                        {501},
+	"api.license.add_license.array.app_error":                                              {400},
+	"api.license.add_license.invalid_count.app_error":                                      {400},
+	"api.license.add_license.no_file.app_error":                                            {400},
+	"api.license.add_license.open.app_error":                                               {400},
+	"api.license.add_license.save.app_error":                                               {500},
+	"api.license.add_license.save_active.app_error":                                        {500},
+	"api.license.add_license.unique_users.app_error":                                       {400},
+	"api.license.client.old_format.app_error":                                              {501},
+	"api.license.remove_expired_license.failed.error":                                      {500},
+	"api.license.request-trial.bad-request":                                                {400},
+	"api.license.request-trial.bad-request.terms-not-accepted":                             {400},
+	"api.license.request_trial_license.app_error":                                          {400},
+	"api.license.request_trial_license.no-site-url.app_error":                              {400},
+	"api.license.seat_usage_forecast.failed.error":                                         {500},
+	"api.marshal_error":                                                                    {500},
+	"api.oauth.allow_oauth.redirect_callback.app_error":                                    {400},
+	"api.oauth.allow_oauth.turn_off.app_error":                                             {501},
+	"api.oauth.authorize_oauth.disabled.app_error":                                         {501},
+	"api.oauth.get_access_token.bad_client_id.app_error":                                   {400},
+	"api.oauth.get_access_token.bad_client_secret.app_error":                               {400},
+	"api.oauth.get_access_token.bad_grant.app_error":                                       {400},
+	"api.oauth.get_access_token.credentials.app_error":                                     {403, 404},
+	"api.oauth.get_access_token.disabled.app_error":                                        {501},
+	"api.oauth.get_access_token.expired_code.app_error":                                    {400, 403},
+	"api.oauth.get_access_token.internal.app_error":                                        {400},
+	"api.oauth.get_access_token.internal_saving.app_error":                                 {500},
+	"api.oauth.get_access_token.internal_session.app_error":                                {500},
+	"api.oauth.get_access_token.internal_user.app_error":                                   {404},
+	"api.oauth.get_access_token.missing_code.app_error":                                    {400},
+	"api.oauth.get_access_token.missing_refresh_token.app_error":                           {400},
+	"api.oauth.get_access_token.redirect_uri.app_error":                                    {400},
+	"api.oauth.get_access_token.refresh_token.app_error":                                   {404},
+	"api.oauth.invalid_state_token.app_error":                                              {400},
+	"api.oauth.register_oauth_app.turn_off.app_error":                                      {501},
+	"api.oauth.revoke_access_token.del_session.app_error":                                  {500},
+	"api.oauth.revoke_access_token.del_token.app_error":                                    {500},
+	"api.oauth.revoke_access_token.get.app_error":                                          {400},
+	"api.oauth.singup_with_oauth.expired_link.app_error":                                   {400},
+	"api.oauth.singup_with_oauth.invalid_link.app_error":                                   {400},
+	"api.outgoing_webhook.disabled.app_error":                                              {403, 501},
+	"api.plugin.add_public_key.open.app_error":                                             {500},
+	"api.plugin.install.download_failed.app_error":                                         {400},
+	"api.plugin.upload.array.app_error":                                                    {400},
+	"api.plugin.upload.file.app_error":                                                     {400},
+	"api.plugin.upload.no_file.app_error":                                                  {400},
+	"api.plugin.verify_plugin.app_error":                                                   {500},
+	"api.post.create_post.can_not_post_to_deleted.error":                                   {400},
+	"api.post.create_post.channel_root_id.app_error":                                       {500},
+	"api.post.create_post.parent_id.app_error":                                             {500},
+	"api.post.create_post.root_id.app_error":                                               {400},
+	"api.post.create_post.town_square_read_only":                                           {403},
+	"api.post.create_webhook_post.creating.app_error":                                      {500},
+	"api.post.deduplicate_create_post.failed_to_get":                                       {500},
+	"api.post.deduplicate_create_post.pending":                                             {500},
+	"api.post.delete_post.can_not_delete_post_in_deleted.error":                            {400},
+	"api.post.do_action.action_id.app_error":                                               {404},
+	"api.post.do_action.action_integration.app_error":                                      {400},
+	"api.post.error_get_post_id.pending":                                                   {500},
+	"api.post.link_preview_disabled.app_error":                                             {501},
+	"api.post.patch_post.can_not_update_post_in_deleted.error":                             {400},
+	"api.post.save_is_pinned_post.town_square_read_only":                                   {403},
+	"api.post.update_post.can_not_update_post_in_deleted.error":                            {400},
+	"api.post.update_post.find.app_error":                                                  {400},
+	"api.post.update_post.permissions_details.app_error":                                   {400},
+	"api.post.update_post.permissions_time_limit.app_error":                                {400},
+	"api.post.update_post.system_message.app_error":                                        {400},
+	"api.post_get_post_by_id.get.app_error":                                                {404},
+	"api.preference.delete_preferences.delete.app_error":                                   {403},
+	"api.preference.preferences_category.get.app_error":                                    {404},
+	"api.preference.update_preferences.set.app_error":                                      {403},
+	"api.presence_webhook.disabled.app_error":                                              {501},
+	"api.push_notification.disabled.app_error":                                             {501},
+	"api.push_notification.id_loaded.fetch.app_error":                                      {500},
+	"api.push_notifications.message.parse.app_error":                                       {400},
+	"api.push_notifications_ack.forward.app_error":                                         {500},
+	"api.push_notifications_ack.message.parse.app_error":                                   {400},
+	"api.reaction.delete.archived_channel.app_error":                                       {403},
+	"api.reaction.save.archived_channel.app_error":                                         {403},
+	"api.reaction.save_reaction.invalid.app_error":                                         {400},
+	"api.reaction.save_reaction.user_id.app_error":                                         {403},
+	"api.reaction.town_square_read_only":                                                   {403},
+	"api.restricted_system_admin":                                                          {400, 403},
+	"api.roles.patch_roles.license.error":                                                  {501},
+	"api.scheme.create_scheme.license.error":                                               {501},
+	"api.scheme.delete_scheme.license.error":                                               {501},
+	"api.scheme.get_channels_for_scheme.scope.error":                                       {400},
+	"api.scheme.get_teams_for_scheme.scope.error":                                          {400},
+	"api.scheme.patch_scheme.license.error":                                                {501},
+	"api.slackimport.slack_import.open.app_error":                                          {500},
+	"api.slackimport.slack_import.zip.app_error":                                           {400},
+	"api.status.user_not_found.app_error":                                                  {404},
+	"api.system.download_profile.not_found.app_error":                                      {404},
+	"api.system.id_loaded.not_available.app_error":                                         {302},
+	"api.team.add_members.error":                                                           {400},
+	"api.team.add_members.user_denied":                                                     {400},
+	"api.team.add_user_to_team.missing_parameter.app_error":                                {400},
+	"api.team.add_user_to_team_from_invite.guest.app_error":                                {403},
+	"api.team.demote_user_to_guest.disabled.error":                                         {501},
+	"api.team.demote_user_to_guest.license.error":                                          {501},
+	"api.team.get_all_teams.insufficient_permissions":                                      {403},
+	"api.team.get_invite_info.not_open_team":                                               {403},
+	"api.team.get_team_icon.filesettings_no_driver.app_error":                              {501},
+	"api.team.get_team_icon.read_file.app_error":                                           {404},
+	"api.team.import_team.array.app_error":                                                 {400},
+	"api.team.import_team.integer.app_error":                                               {400},
+	"api.team.import_team.no_file.app_error":                                               {400},
+	"api.team.import_team.no_import_from.app_error":                                        {400},
+	"api.team.import_team.open.app_error":                                                  {400},
+	"api.team.import_team.parse.app_error":                                                 {500},
+	"api.team.import_team.unavailable.app_error":                                           {400},
+	"api.team.import_team.unknown_import_from.app_error":                                   {400},
+	"api.team.invalidate_all_email_invites.app_error":                                      {400},
+	"api.team.invate_guests_to_channels.disabled.error":                                    {501},
+	"api.team.invate_guests_to_channels.license.error":                                     {501},
+	"api.team.invite_guests.channel_in_invalid_team.app_error":                             {400},
+	"api.team.invite_members.disabled.app_error":                                           {501},
+	"api.team.invite_members.invalid_email.app_error":                                      {400, 403},
+	"api.team.invite_members.no_one.app_error":                                             {400},
+	"api.team.is_team_creation_allowed.disabled.app_error":                                 {403},
+	"api.team.is_team_creation_allowed.domain.app_error":                                   {400},
+	"api.team.join_user_to_team.allowed_domains.app_error":                                 {400},
+	"api.team.move_channel.post.error":                                                     {500},
+	"api.team.remove_member.group_constrained.app_error":                                   {400},
+	"api.team.remove_team_icon.get_team.app_error":                                         {400},
+	"api.team.remove_user_from_team.missing.app_error":                                     {400},
+	"api.team.search_teams.pagination_not_implemented.private_team_search":                 {501},
+	"api.team.search_teams.pagination_not_implemented.public_team_search":                  {501},
+	"api.team.set_team_icon.array.app_error":                                               {400},
+	"api.team.set_team_icon.decode.app_error":                                              {400},
+	"api.team.set_team_icon.decode_config.app_error":                                       {400},
+	"api.team.set_team_icon.encode.app_error":                                              {500},
+	"api.team.set_team_icon.get_team.app_error":                                            {400},
+	"api.team.set_team_icon.no_file.app_error":                                             {400},
+	"api.team.set_team_icon.open.app_error":                                                {400},
+	"api.team.set_team_icon.parse.app_error":                                               {400},
+	"api.team.set_team_icon.storage.app_error":                                             {501},
+	"api.team.set_team_icon.too_large.app_error":                                           {400},
+	"api.team.set_team_icon.write_file.app_error":                                          {500},
+	"api.team.team_icon.update.app_error":                                                  {400},
+	"api.team.update_member_roles.not_a_member":                                            {400},
+	"api.team.update_restricted_domains.mismatch.app_error":                                {400},
+	"api.team.update_team_member_roles.guest_and_user.app_error":                           {400},
+	"api.team.update_team_scheme.license.error":                                            {501},
+	"api.team.update_team_scheme.scheme_scope.error":                                       {400},
+	"api.templates.role_grant_expired.failed.error":                                        {500},
+	"api.terms_of_service_policy.accept.impersonated.app_error":                            {403},
+	"api.user.activate_mfa.email_and_ldap_only.app_error":                                  {400},
+	"api.user.add_direct_channels_and_forget.failed.error":                                 {500},
+	"api.user.authorize_oauth_user.bad_response.app_error":                                 {500},
+	"api.user.authorize_oauth_user.bad_token.app_error":                                    {500},
+	"api.user.authorize_oauth_user.invalid_state.app_error":                                {302, 400},
+	"api.user.authorize_oauth_user.missing.app_error":                                      {500},
+	"api.user.authorize_oauth_user.response.app_error":                                     {500},
+	"api.user.authorize_oauth_user.service.app_error":                                      {500},
+	"api.user.authorize_oauth_user.token_failed.app_error":                                 {500},
+	"api.user.authorize_oauth_user.unsupported.app_error":                                  {501},
+	"api.user.autocomplete_users.missing_team_id.app_error":                                {500},
+	"api.user.check_user_login_attempts.too_many.app_error":                                {401},
+	"api.user.check_user_mfa.bad_code.app_error":                                           {401},
+	"api.user.check_user_password.invalid.app_error":                                       {401},
+	"api.user.complete_switch_with_oauth.blank_email.app_error":                            {400},
+	"api.user.complete_switch_with_oauth.parse.app_error":                                  {400},
+	"api.user.complete_switch_with_oauth.unavailable.app_error":                            {501},
+	"api.user.create_email_token.error":                                                    {500},
+	"api.user.create_oauth_user.already_attached.app_error":                                {400},
+	"api.user.create_oauth_user.create.app_error":                                          {500},
+	"api.user.create_oauth_user.not_available.app_error":                                   {501},
+	"api.user.create_password_token.error":                                                 {500},
+	"api.user.create_profile_image.default_font.app_error":                                 {500},
+	"api.user.create_profile_image.encode.app_error":                                       {500},
+	"api.user.create_profile_image.initial.app_error":                                      {500},
+	"api.user.create_user.accepted_domain.app_error":                                       {400},
+	"api.user.create_user.disabled.app_error":                                              {501},
+	"api.user.create_user.guest_accounts.disabled.app_error":                               {400},
+	"api.user.create_user.guest_accounts.license.app_error":                                {400},
+	"api.user.create_user.invalid_invitation_type.app_error":                               {400},
+	"api.user.create_user.missing_invite_id.app_error":                                     {400},
+	"api.user.create_user.missing_token.app_error":                                         {400},
+	"api.user.create_user.no_open_server":                                                  {403},
+	"api.user.create_user.signup_email_disabled.app_error":                                 {501},
+	"api.user.create_user.signup_link_expired.app_error":                                   {400},
+	"api.user.create_user.signup_link_invalid.app_error":                                   {400},
+	"api.user.delete_team.not_enabled.app_error":                                           {401},
+	"api.user.demote_user_to_guest.already_guest.app_error":                                {501},
+	"api.user.email_to_ldap.not_available.app_error":                                       {403, 501},
+	"api.user.email_to_oauth.not_available.app_error":                                      {403},
+	"api.user.get_authorization_code.unsupported.app_error":                                {501},
+	"api.user.get_user_by_email.permissions.app_error":                                     {403},
+	"api.user.ldap_to_email.not_available.app_error":                                       {403, 501},
+	"api.user.ldap_to_email.not_ldap_account.app_error":                                    {400},
+	"api.user.login.blank_pwd.app_error":                                                   {400},
+	"api.user.login.bot_login_forbidden.app_error":                                         {401},
+	"api.user.login.client_side_cert.certificate.app_error":                                {400},
+	"api.user.login.client_side_cert.license.app_error":                                    {400},
+	"api.user.login.email_verification_overdue.app_error":                                  {401},
+	"api.user.login.guest_accounts.disabled.error":                                         {401},
+	"api.user.login.guest_accounts.license.error":                                          {401},
+	"api.user.login.inactive.app_error":                                                    {401},
+	"api.user.login.invalid_credentials_email":                                             {401},
+	"api.user.login.invalid_credentials_email_username":                                    {401},
+	"api.user.login.invalid_credentials_sso":                                               {401},
+	"api.user.login.invalid_credentials_username":                                          {401},
+	"api.user.login.not_verified.app_error":                                                {401},
+	"api.user.login.service_account_login_forbidden.app_error":                             {401},
+	"api.user.login.use_auth_service.app_error":                                            {400},
+	"api.user.login_by_oauth.bot_login_forbidden.app_error":                                {403},
+	"api.user.login_by_oauth.not_available.app_error":                                      {501},
+	"api.user.login_by_oauth.parse.app_error":                                              {400},
+	"api.user.login_ldap.not_available.app_error":                                          {501},
+	"api.user.oauth_to_email.context.app_error":                                            {403},
+	"api.user.oauth_to_email.not_available.app_error":                                      {403},
+	"api.user.promote_guest_to_user.no_guest.app_error":                                    {501},
+	"api.user.reset_password.broken_token.app_error":                                       {400},
+	"api.user.reset_password.invalid_link.app_error":                                       {400},
+	"api.user.reset_password.link_expired.app_error":                                       {400},
+	"api.user.reset_password.sso.app_error":                                                {400},
+	"api.user.reset_password.token_parse.error":                                            {500},
+	"api.user.saml.not_available.app_error":                                                {302},
+	"api.user.send_deactivate_email_and_forget.failed.error":                               {500},
+	"api.user.send_email_change_email_and_forget.error":                                    {500},
+	"api.user.send_email_change_username_and_forget.error":                                 {500},
+	"api.user.send_email_change_verify_email_and_forget.error":                             {500},
+	"api.user.send_impersonation_started_email.error":                                      {500},
+	"api.user.send_mfa_change_email.error":                                                 {500},
+	"api.user.send_password_change_email_and_forget.error":                                 {500},
+	"api.user.send_password_reset.send.app_error":                                          {500},
+	"api.user.send_password_reset.sso.app_error":                                           {400},
+	"api.user.send_sign_in_change_email_and_forget.error":                                  {500},
+	"api.user.send_user_access_token.error":                                                {500},
+	"api.user.send_verify_email_and_forget.failed.error":                                   {500},
+	"api.user.send_welcome_email_and_forget.failed.error":                                  {500},
+	"api.user.update_active.cannot_enable_guest_when_guest_feature_is_disabled.app_error":  {401},
+	"api.user.update_active.not_enable.app_error":                                          {401},
+	"api.user.update_active.permissions.app_error":                                         {403},
+	"api.user.update_oauth_user_attrs.get_user.app_error":                                  {400},
+	"api.user.update_password.context.app_error":                                           {403},
+	"api.user.update_password.failed.app_error":                                            {500},
+	"api.user.update_password.incorrect.app_error":                                         {400},
+	"api.user.update_password.oauth.app_error":                                             {400},
+	"api.user.update_password.valid_account.app_error":                                     {400},
+	"api.user.update_user.accepted_domain.app_error":                                       {400},
+	"api.user.update_user.accepted_guest_domain.app_error":                                 {400},
+	"api.user.upload_profile_user.array.app_error":                                         {400},
+	"api.user.upload_profile_user.decode.app_error":                                        {400},
+	"api.user.upload_profile_user.decode_config.app_error":                                 {400},
+	"api.user.upload_profile_user.encode.app_error":                                        {500},
+	"api.user.upload_profile_user.no_file.app_error":                                       {400},
+	"api.user.upload_profile_user.open.app_error":                                          {400},
+	"api.user.upload_profile_user.parse.app_error":                                         {500},
+	"api.user.upload_profile_user.storage.app_error":                                       {501},
+	"api.user.upload_profile_user.too_large.app_error":                                     {400, 413},
+	"api.user.upload_profile_user.upload_profile.app_error":                                {500},
+	"api.user.verify_email.bad_link.app_error":                                             {400},
+	"api.user.verify_email.broken_token.app_error":                                         {400},
+	"api.user.verify_email.link_expired.app_error":                                         {400},
+	"api.user.verify_email.token_parse.error":                                              {500},
+	"api.web_socket.connect.upgrade.app_error":                                             {500},
+	"api.web_socket_router.bad_action.app_error":                                           {500},
+	"api.web_socket_router.bad_seq.app_error":                                              {400},
+	"api.web_socket_router.no_action.app_error":                                            {400},
+	"api.web_socket_router.not_authenticated.app_error":                                    {401},
+	"api.webhook.create_outgoing.intersect.app_error":                                      {500},
+	"api.webhook.create_outgoing.not_open.app_error":                                       {403},
+	"api.webhook.create_outgoing.permissions.app_error":                                    {403},
+	"api.webhook.create_outgoing.triggers.app_error":                                       {400, 500},
+	"api.webhook.incoming.error":                                                           {400},
+	"api.webhook.team_mismatch.app_error":                                                  {400},
+	"api.webhook.update_outgoing.intersect.app_error":                                      {400},
+	"api.websocket_handler.invalid_param.app_error":                                        {400},
+	"api.websocket_handler.server_busy.app_error":                                          {503},
+	"app.admin.saml.failure_decode_metadata_xml_from_idp.app_error":                        {500},
+	"app.admin.saml.failure_read_response_body_from_idp.app_error":                         {500},
+	"app.admin.saml.invalid_response_from_idp.app_error":                                   {400},
+	"app.admin.schema_migration_status.app_error":                                          {500},
+	"app.admin.test_email.failure":                                                         {500},
+	"app.admin.test_site_url.failure":                                                      {400},
+	"app.analytics.getanalytics.internal_error":                                            {500},
+	"app.audit.get.finding.app_error":                                                      {500},
+	"app.audit.get.limit.app_error":                                                        {400},
+	"app.audit.permanent_delete_by_user.app_error":                                         {500},
+	"app.audit.save.saving.app_error":                                                      {500},
+	"app.backup.channel_snapshot.app_error":                                                {400},
+	"app.backup.copy_file.app_error":                                                       {500},
+	"app.backup.create.app_error":                                                          {500},
+	"app.backup.directory.app_error":                                                       {500},
+	"app.backup.extract.app_error":                                                         {500},
+	"app.backup.open.app_error":                                                            {400},
+	"app.backup.verify.app_error":                                                          {400},
+	"app.backup.verify_restore.app_error":                                                  {500},
+	"app.bot.createbot.internal_error":                                                     {500},
+	"app.bot.getbot.internal_error":                                                        {500},
+	"app.bot.getbots.internal_error":                                                       {500},
+	"app.bot.patchbot.internal_error":                                                      {500},
+	"app.bot.permanent_delete.internal_error":                                              {500},
+	"app.bot.permenent_delete.bad_id":                                                      {400},
+	"app.channel.create_channel.internal_error":                                            {500},
+	"app.channel.create_channel.no_team_id.app_error":                                      {400},
+	"app.channel.create_direct_channel.internal_error":                                     {500},
+	"app.channel.create_initial_sidebar_categories.internal_error":                         {500},
+	"app.channel.delete.app_error":                                                         {500},
+	"app.channel.get.existing.app_error":                                                   {404},
+	"app.channel.get.find.app_error":                                                       {500},
+	"app.channel.get_all_channels.app_error":                                               {500},
+	"app.channel.get_all_channels_count.app_error":                                         {500},
+	"app.channel.get_by_name.existing.app_error":                                           {500},
+	"app.channel.get_by_name.missing.app_error":                                            {404},
+	"app.channel.get_channels.get.app_error":                                               {500},
+	"app.channel.get_channels.not_found.app_error":                                         {404},
+	"app.channel.get_deleted.existing.app_error":                                           {500},
+	"app.channel.get_deleted.missing.app_error":                                            {404},
+	"app.channel.get_members_page.invalid_token.app_error":                                 {400},
+	"app.channel.get_more_channels.get.app_error":                                          {500},
+	"app.channel.move_channel.members_do_not_match.error":                                  {500},
+	"app.channel.permanent_delete.app_error":                                               {500},
+	"app.channel.post_update_channel_purpose_message.post.error":                           {500},
+	"app.channel.post_update_channel_purpose_message.retrieve_user.error":                  {400},
+	"app.channel.restore.app_error":                                                        {500},
+	"app.channel.update.bad_id":                                                            {400},
+	"app.channel.update_channel.internal_error":                                            {500},
+	"app.channel_bookmark.count.app_error":                                                 {500},
+	"app.channel_bookmark.create.limit_reached.app_error":                                  {400},
+	"app.channel_bookmark.delete.app_error":                                                {500},
+	"app.channel_bookmark.file.invalid.app_error":                                          {400},
+	"app.channel_bookmark.get.app_error":                                                   {500},
+	"app.channel_bookmark.get.not_found.app_error":                                         {404},
+	"app.channel_bookmark.get_for_channel.app_error":                                       {500},
+	"app.channel_bookmark.permanent_delete_by_channel.app_error":                           {500},
+	"app.channel_bookmark.save.app_error":                                                  {500},
+	"app.channel_bookmark.update.app_error":                                                {500},
+	"app.channel_change.get_since.app_error":                                               {500},
+	"app.channel_change.permanent_delete_by_channel.app_error":                             {500},
+	"app.channel_guest_link.create.limit.app_error":                                        {400},
+	"app.channel_guest_link.exhausted.app_error":                                           {400},
+	"app.channel_guest_link.expired.app_error":                                             {400},
+	"app.channel_guest_link.get.app_error":                                                 {500},
+	"app.channel_guest_link.get.not_found.app_error":                                       {404},
+	"app.channel_guest_link.get_for_channel.app_error":                                     {500},
+	"app.channel_guest_link.group_constrained.app_error":                                   {400},
+	"app.channel_guest_link.increment_use_count.app_error":                                 {500},
+	"app.channel_guest_link.invalid.app_error":                                             {400},
+	"app.channel_guest_link.invalid_channel.app_error":                                     {400},
+	"app.channel_guest_link.invalid_email.app_error":                                       {403},
+	"app.channel_guest_link.permanent_delete_by_channel.app_error":                         {500},
+	"app.channel_guest_link.revoke.app_error":                                              {500},
+	"app.channel_guest_link.save.app_error":                                                {500},
+	"app.channel_integration.add.exists.app_error":                                         {400},
+	"app.channel_integration.add.wrong_team.app_error":                                     {400},
+	"app.channel_integration.delete.app_error":                                             {500},
+	"app.channel_integration.get.app_error":                                                {500},
+	"app.channel_integration.get.not_found.app_error":                                      {404},
+	"app.channel_integration.get_for_channel.app_error":                                    {500},
+	"app.channel_integration.not_allowed.app_error":                                        {403},
+	"app.channel_integration.permanent_delete_by_channel.app_error":                        {500},
+	"app.channel_integration.permanent_delete_by_integration.app_error":                    {500},
+	"app.channel_integration.save.app_error":                                               {500},
+	"app.channel_member_history.log_join_event.internal_error":                             {500},
+	"app.channel_member_history.log_leave_event.internal_error":                            {500},
+	"app.channel_snapshot.channel_type.app_error":                                          {400},
+	"app.channel_snapshot.create.app_error":                                                {500},
+	"app.channel_snapshot.duplicate_channel.app_error":                                     {400},
+	"app.channel_snapshot.invalid_line.app_error":                                          {400},
+	"app.channel_snapshot.not_a_snapshot.app_error":                                        {400},
+	"app.chat_import.open.app_error":                                                       {500},
+	"app.chat_import.progress.app_error":                                                   {500},
+	"app.command.autocomplete_data.fetch.app_error":                                        {400},
+	"app.command.autocomplete_data.no_url.app_error":                                       {400},
+	"app.command.createcommand.internal_error":                                             {500},
+	"app.command.deletecommand.internal_error":                                             {500},
+	"app.command.getcommand.internal_error":                                                {500},
+	"app.command.listallcommands.internal_error":                                           {500},
+	"app.command.listautocompletecommands.internal_error":                                  {500},
+	"app.command.listteamcommands.internal_error":                                          {500},
+	"app.command.movecommand.internal_error":                                               {500},
+	"app.command.regencommandtoken.internal_error":                                         {500},
+	"app.command.tryexecutecustomcommand.internal_error":                                   {500},
+	"app.command.updatecommand.internal_error":                                             {500},
+	"app.debug_capture.create_rule.too_many.app_error":                                     {400},
+	"app.debug_capture.rule_not_found.app_error":                                           {404},
+	"app.email_verification.get.app_error":                                                 {500},
+	"app.email_verification.get_unverified_users.app_error":                                {500},
+	"app.email_verification.save.app_error":                                                {500},
+	"app.email_verification.update.app_error":                                              {500},
+	"app.emoji.create.internal_error":                                                      {500},
+	"app.emoji.delete.app_error":                                                           {500},
+	"app.emoji.delete.no_results":                                                          {404},
+	"app.emoji.get.app_error":                                                              {500},
+	"app.emoji.get.no_result":                                                              {404},
+	"app.emoji.get_by_name.app_error":                                                      {500},
+	"app.emoji.get_by_name.no_result":                                                      {404},
+	"app.emoji.get_list.internal_error":                                                    {500},
+	"app.export.export_custom_emoji.copy_emoji_images.error":                               {400},
+	"app.export.export_write_line.io_writer.error":                                         {400},
+	"app.export.export_write_line.json_marshall.error":                                     {400},
+	"app.file.download.rejected_by_plugin.app_error":                                       {403},
+	"app.file.download.seek.app_error":                                                     {500},
+	"app.impersonation.disabled.app_error":                                                 {501},
+	"app.impersonation.invalid_user.app_error":                                             {400},
+	"app.impersonation.nested.app_error":                                                   {403},
+	"app.impersonation.self.app_error":                                                     {400},
+	"app.impersonation.system_admin.app_error":                                             {403},
+	"app.impersonation.write_access_disabled.app_error":                                    {403},
+	"app.import.attachment.bad_file.error":                                                 {400},
+	"app.import.attachment.file_upload.error":                                              {400},
+	"app.import.bulk_import.file_scan.error":                                               {500},
+	"app.import.bulk_import.json_decode.error":                                             {400},
+	"app.import.bulk_import.unsupported_version.error":                                     {400},
+	"app.import.emoji.bad_file.error":                                                      {400},
+	"app.import.get_teams_by_names.some_teams_not_found.error":                             {400},
+	"app.import.get_users_by_username.some_users_not_found.error":                          {400},
+	"app.import.import_channel.scheme_deleted.error":                                       {400},
+	"app.import.import_channel.scheme_wrong_scope.error":                                   {400},
+	"app.import.import_channel.team_not_found.error":                                       {400},
+	"app.import.import_channel_bookmark.channel_not_found.error":                           {400},
+	"app.import.import_channel_bookmark.owner_not_found.error":                             {400},
+	"app.import.import_channel_bookmark.team_not_found.error":                              {400},
+	"app.import.import_direct_channel.create_direct_channel.error":                         {400},
+	"app.import.import_direct_channel.create_group_channel.error":                          {400},
+	"app.import.import_direct_channel.update_header_failed.error":                          {400},
+	"app.import.import_direct_post.create_direct_channel.error":                            {400},
+	"app.import.import_direct_post.create_group_channel.error":                             {400},
+	"app.import.import_line.null_channel.error":                                            {400},
+	"app.import.import_line.null_channel_bookmark.error":                                   {400},
+	"app.import.import_line.null_direct_channel.error":                                     {400},
+	"app.import.import_line.null_direct_post.error":                                        {400},
+	"app.import.import_line.null_emoji.error":                                              {400},
+	"app.import.import_line.null_post.error":                                               {400},
+	"app.import.import_line.null_scheme.error":                                             {400},
+	"app.import.import_line.null_team.error":                                               {400},
+	"app.import.import_line.null_user.error":                                               {400},
+	"app.import.import_line.null_user_relationship.error":                                  {400},
+	"app.import.import_line.unknown_line_type.error":                                       {400},
+	"app.import.import_post.channel_not_found.error":                                       {400},
+	"app.import.import_post.save_preferences.error":                                        {500},
+	"app.import.import_post.user_not_found.error":                                          {400},
+	"app.import.import_scheme.scope_change.error":                                          {400},
+	"app.import.import_team.scheme_deleted.error":                                          {400},
+	"app.import.import_team.scheme_wrong_scope.error":                                      {400},
+	"app.import.import_user.save_preferences.error":                                        {500},
+	"app.import.import_user_channels.channel_not_found.error":                              {500},
+	"app.import.import_user_channels.save_preferences.error":                               {500},
+	"app.import.import_user_relationship.user_not_found.error":                             {400},
+	"app.import.import_user_teams.save_preferences.error":                                  {500},
+	"app.import.process_import_data_file_version_line.invalid_version.error":               {400},
+	"app.import.validate_channel_bookmark_import_data.channel_missing.error":               {400},
+	"app.import.validate_channel_bookmark_import_data.display_name_length.error":           {400},
+	"app.import.validate_channel_bookmark_import_data.display_name_missing.error":          {400},
+	"app.import.validate_channel_bookmark_import_data.emoji_length.error":                  {400},
+	"app.import.validate_channel_bookmark_import_data.image_url_invalid.error":             {400},
+	"app.import.validate_channel_bookmark_import_data.link_url_invalid.error":              {400},
+	"app.import.validate_channel_bookmark_import_data.link_url_missing.error":              {400},
+	"app.import.validate_channel_bookmark_import_data.owner_missing.error":                 {400},
+	"app.import.validate_channel_bookmark_import_data.team_missing.error":                  {400},
+	"app.import.validate_channel_import_data.display_name_length.error":                    {400},
+	"app.import.validate_channel_import_data.display_name_missing.error":                   {400},
+	"app.import.validate_channel_import_data.header_length.error":                          {400},
+	"app.import.validate_channel_import_data.name_characters.error":                        {400},
+	"app.import.validate_channel_import_data.name_length.error":                            {400},
+	"app.import.validate_channel_import_data.name_missing.error":                           {400},
+	"app.import.validate_channel_import_data.purpose_length.error":                         {400},
+	"app.import.validate_channel_import_data.scheme_invalid.error":                         {400},
+	"app.import.validate_channel_import_data.team_missing.error":                           {400},
+	"app.import.validate_channel_import_data.type_invalid.error":                           {400},
+	"app.import.validate_channel_import_data.type_missing.error":                           {400},
+	"app.import.validate_direct_channel_import_data.header_length.error":                   {400},
+	"app.import.validate_direct_channel_import_data.members_required.error":                {400},
+	"app.import.validate_direct_channel_import_data.members_too_few.error":                 {400},
+	"app.import.validate_direct_channel_import_data.members_too_many.error":                {400},
+	"app.import.validate_direct_channel_import_data.unknown_favoriter.error":               {400},
+	"app.import.validate_direct_post_import_data.channel_members_required.error":           {400},
+	"app.import.validate_direct_post_import_data.channel_members_too_few.error":            {400},
+	"app.import.validate_direct_post_import_data.channel_members_too_many.error":           {400},
+	"app.import.validate_direct_post_import_data.create_at_missing.error":                  {400},
+	"app.import.validate_direct_post_import_data.create_at_zero.error":                     {400},
+	"app.import.validate_direct_post_import_data.message_length.error":                     {400},
+	"app.import.validate_direct_post_import_data.message_missing.error":                    {400},
+	"app.import.validate_direct_post_import_data.unknown_flagger.error":                    {400},
+	"app.import.validate_direct_post_import_data.user_missing.error":                       {400},
+	"app.import.validate_emoji_import_data.empty.error":                                    {400},
+	"app.import.validate_emoji_import_data.image_missing.error":                            {400},
+	"app.import.validate_emoji_import_data.name_missing.error":                             {400},
+	"app.import.validate_post_import_data.channel_missing.error":                           {400},
+	"app.import.validate_post_import_data.create_at_missing.error":                         {400},
+	"app.import.validate_post_import_data.create_at_zero.error":                            {400},
+	"app.import.validate_post_import_data.message_length.error":                            {400},
+	"app.import.validate_post_import_data.message_missing.error":                           {400},
+	"app.import.validate_post_import_data.props_too_large.error":                           {400},
+	"app.import.validate_post_import_data.team_missing.error":                              {400},
+	"app.import.validate_post_import_data.user_missing.error":                              {400},
+	"app.import.validate_reaction_import_data.create_at_before_parent.error":               {400},
+	"app.import.validate_reaction_import_data.create_at_missing.error":                     {400},
+	"app.import.validate_reaction_import_data.create_at_zero.error":                        {400},
+	"app.import.validate_reaction_import_data.emoji_name_length.error":                     {400},
+	"app.import.validate_reaction_import_data.emoji_name_missing.error":                    {400},
+	"app.import.validate_reaction_import_data.user_missing.error":                          {400},
+	"app.import.validate_reply_import_data.create_at_before_parent.error":                  {400},
+	"app.import.validate_reply_import_data.create_at_missing.error":                        {400},
+	"app.import.validate_reply_import_data.create_at_zero.error":                           {400},
+	"app.import.validate_reply_import_data.message_length.error":                           {400},
+	"app.import.validate_reply_import_data.message_missing.error":                          {400},
+	"app.import.validate_reply_import_data.user_missing.error":                             {400},
+	"app.import.validate_role_import_data.description_invalid.error":                       {400},
+	"app.import.validate_role_import_data.display_name_invalid.error":                      {400},
+	"app.import.validate_role_import_data.invalid_permission.error":                        {400},
+	"app.import.validate_role_import_data.name_invalid.error":                              {400},
+	"app.import.validate_scheme_import_data.description_invalid.error":                     {400},
+	"app.import.validate_scheme_import_data.display_name_invalid.error":                    {400},
+	"app.import.validate_scheme_import_data.name_invalid.error":                            {400},
+	"app.import.validate_scheme_import_data.null_scope.error":                              {400},
+	"app.import.validate_scheme_import_data.unknown_scheme.error":                          {400},
+	"app.import.validate_scheme_import_data.wrong_roles_for_scope.error":                   {400},
+	"app.import.validate_team_import_data.description_length.error":                        {400},
+	"app.import.validate_team_import_data.display_name_length.error":                       {400},
+	"app.import.validate_team_import_data.display_name_missing.error":                      {400},
+	"app.import.validate_team_import_data.name_characters.error":                           {400},
+	"app.import.validate_team_import_data.name_length.error":                               {400},
+	"app.import.validate_team_import_data.name_missing.error":                              {400},
+	"app.import.validate_team_import_data.name_reserved.error":                             {400},
+	"app.import.validate_team_import_data.scheme_invalid.error":                            {400},
+	"app.import.validate_team_import_data.type_invalid.error":                              {400},
+	"app.import.validate_team_import_data.type_missing.error":                              {400},
+	"app.import.validate_user_channels_import_data.channel_name_missing.error":             {400},
+	"app.import.validate_user_channels_import_data.invalid_notify_props_desktop.error":     {400},
+	"app.import.validate_user_channels_import_data.invalid_notify_props_mark_unread.error": {400},
+	"app.import.validate_user_channels_import_data.invalid_notify_props_mobile.error":      {400},
+	"app.import.validate_user_channels_import_data.invalid_roles.error":                    {400},
+	"app.import.validate_user_import_data.advanced_props_email_interval.error":             {400},
+	"app.import.validate_user_import_data.advanced_props_feature_markdown_preview.error":   {400},
+	"app.import.validate_user_import_data.advanced_props_formatting.error":                 {400},
+	"app.import.validate_user_import_data.advanced_props_show_unread_section.error":        {400},
+	"app.import.validate_user_import_data.auth_data_and_password.error":                    {400},
+	"app.import.validate_user_import_data.auth_data_and_service_dependency.error":          {400},
+	"app.import.validate_user_import_data.auth_data_length.error":                          {400},
+	"app.import.validate_user_import_data.email_length.error":                              {400},
+	"app.import.validate_user_import_data.email_missing.error":                             {400},
+	"app.import.validate_user_import_data.first_name_length.error":                         {400},
+	"app.import.validate_user_import_data.last_name_length.error":                          {400},
+	"app.import.validate_user_import_data.nickname_length.error":                           {400},
+	"app.import.validate_user_import_data.notify_props_channel_trigger_invalid.error":      {400},
+	"app.import.validate_user_import_data.notify_props_comments_trigger_invalid.error":     {400},
+	"app.import.validate_user_import_data.notify_props_desktop_invalid.error":              {400},
+	"app.import.validate_user_import_data.notify_props_desktop_sound_invalid.error":        {400},
+	"app.import.validate_user_import_data.notify_props_email_invalid.error":                {400},
+	"app.import.validate_user_import_data.notify_props_mobile_invalid.error":               {400},
+	"app.import.validate_user_import_data.notify_props_mobile_push_status_invalid.error":   {400},
+	"app.import.validate_user_import_data.password_length.error":                           {400},
+	"app.import.validate_user_import_data.position_length.error":                           {400},
+	"app.import.validate_user_import_data.profile_image.error":                             {400},
+	"app.import.validate_user_import_data.roles_invalid.error":                             {400},
+	"app.import.validate_user_import_data.username_invalid.error":                          {400},
+	"app.import.validate_user_import_data.username_missing.error":                          {400},
+	"app.import.validate_user_relationship_import_data.other_user_missing.error":           {400},
+	"app.import.validate_user_relationship_import_data.same_user.error":                    {400},
+	"app.import.validate_user_relationship_import_data.type_invalid.error":                 {400},
+	"app.import.validate_user_relationship_import_data.user_missing.error":                 {400},
+	"app.import.validate_user_teams_import_data.invalid_roles.error":                       {400},
+	"app.import.validate_user_teams_import_data.invalid_team_theme.error":                  {400},
+	"app.import.validate_user_teams_import_data.team_name_missing.error":                   {400},
+	"app.integration_usage.get_for_period.app_error":                                       {500},
+	"app.job.get_artifact.not_found.app_error":                                             {404},
+	"app.login_history.get.app_error":                                                      {500},
+	"app.oauth.delete_app.app_error":                                                       {500},
+	"app.oauth.get_access_data_by_user_for_app.app_error":                                  {500},
+	"app.oauth.get_app.find.app_error":                                                     {404},
+	"app.oauth.get_app.finding.app_error":                                                  {500},
+	"app.oauth.get_app_by_user.find.app_error":                                             {500},
+	"app.oauth.get_apps.find.app_error":                                                    {500},
+	"app.oauth.permanent_delete_auth_data_by_user.app_error":                               {500},
+	"app.oauth.remove_access_data.app_error":                                               {500},
+	"app.oauth.save_app.existing.app_error":                                                {400},
+	"app.oauth.save_app.save.app_error":                                                    {500},
+	"app.oauth.update_app.find.app_error":                                                  {400},
+	"app.oauth.update_app.updating.app_error":                                              {500},
+	"app.pending_pin.delete.app_error":                                                     {500},
+	"app.pending_pin.get.app_error":                                                        {500},
+	"app.pending_pin.get.not_found.app_error":                                              {404},
+	"app.pending_pin.get_for_channel.app_error":                                            {500},
+	"app.pending_pin.permanent_delete_by_channel.app_error":                                {500},
+	"app.pending_pin.request.already_pinned.app_error":                                     {400},
+	"app.pending_pin.request.already_requested.app_error":                                  {400},
+	"app.pending_pin.save.app_error":                                                       {500},
+	"app.permission_check.too_many.app_error":                                              {400},
+	"app.permission_check.unknown_permission.app_error":                                    {400},
+	"app.plugin.cluster.save_config.app_error":                                             {500},
+	"app.plugin.config.app_error":                                                          {500},
+	"app.plugin.deactivate.app_error":                                                      {400},
+	"app.plugin.delete_public_key.delete.app_error":                                        {500},
+	"app.plugin.disabled.app_error":                                                        {501},
+	"app.plugin.extract.app_error":                                                         {400},
+	"app.plugin.filesystem.app_error":                                                      {500},
+	"app.plugin.flag_managed.app_error":                                                    {500},
+	"app.plugin.get_cluster_plugin_statuses.app_error":                                     {500},
+	"app.plugin.get_plugins.app_error":                                                     {500},
+	"app.plugin.get_public_key.get_file.app_error":                                         {500},
+	"app.plugin.get_statuses.app_error":                                                    {500},
+	"app.plugin.install.app_error":                                                         {500},
+	"app.plugin.install_id.app_error":                                                      {400},
+	"app.plugin.install_id_failed_remove.app_error":                                        {400},
+	"app.plugin.install_marketplace_plugin.app_error":                                      {500},
+	"app.plugin.invalid_id.app_error":                                                      {400},
+	"app.plugin.invalid_version.app_error":                                                 {400},
+	"app.plugin.manifest.app_error":                                                        {400},
+	"app.plugin.marketplace_client.app_error":                                              {500},
+	"app.plugin.marketplace_client.failed_to_fetch":                                        {500},
+	"app.plugin.marketplace_disabled.app_error":                                            {501},
+	"app.plugin.marketplace_plugin_request.app_error":                                      {501},
+	"app.plugin.marketplace_plugins.not_found.app_error":                                   {500},
+	"app.plugin.marketplace_plugins.signature_not_found.app_error":                         {500},
+	"app.plugin.marshal.app_error":                                                         {500},
+	"app.plugin.modify_saml.app_error":                                                     {500},
+	"app.plugin.mvdir.app_error":                                                           {500},
+	"app.plugin.not_installed.app_error":                                                   {404},
+	"app.plugin.remove.app_error":                                                          {500},
+	"app.plugin.remove_bundle.app_error":                                                   {500},
+	"app.plugin.restart.app_error":                                                         {500},
+	"app.plugin.signature_decode.app_error":                                                {501},
+	"app.plugin.store_bundle.app_error":                                                    {500},
+	"app.plugin.store_signature.app_error":                                                 {500},
+	"app.plugin.sync.list_filestore.app_error":                                             {500},
+	"app.plugin.sync.read_local_folder.app_error":                                          {500},
+	"app.plugin.upload_disabled.app_error":                                                 {501},
+	"app.plugin.webapp_bundle.app_error":                                                   {500},
+	"app.plugin.write_file.read.app_error":                                                 {500},
+	"app.plugin.write_file.saving.app_error":                                               {500},
+	"app.post.get_posts_delta.invalid_token.app_error":                                     {400},
+	"app.post_acknowledgement.delete.app_error":                                            {500},
+	"app.post_acknowledgement.delete.not_found.app_error":                                  {404},
+	"app.post_acknowledgement.get_for_post.app_error":                                      {500},
+	"app.post_acknowledgement.not_requested.app_error":                                     {400},
+	"app.post_acknowledgement.permanent_delete_by_channel.app_error":                       {500},
+	"app.post_acknowledgement.save.app_error":                                              {500},
+	"app.post_acknowledgement.save.exists.app_error":                                       {400},
+	"app.post_archive.get.app_error":                                                       {500},
+	"app.post_archive.search.app_error":                                                    {500},
+	"app.post_priority.urgent.permissions.app_error":                                       {403},
+	"app.posting_restrictions.custom_emoji.channel.app_error":                              {403},
+	"app.posting_restrictions.custom_emoji.team.app_error":                                 {403},
+	"app.posting_restrictions.file_uploads.channel.app_error":                              {403},
+	"app.posting_restrictions.file_uploads.team.app_error":                                 {403},
+	"app.presence_webhook.delete.app_error":                                                {500},
+	"app.presence_webhook.get.app_error":                                                   {500},
+	"app.presence_webhook.get.not_found.app_error":                                         {404},
+	"app.presence_webhook.get_all.app_error":                                               {500},
+	"app.presence_webhook.invalid_users.app_error":                                         {400},
+	"app.presence_webhook.save.app_error":                                                  {500},
+	"app.presence_webhook.update.app_error":                                                {500},
+	"app.profile.capture.app_error":                                                        {500},
+	"app.profile.capture.in_progress.app_error":                                            {409},
+	"app.reaction.bulk_get_for_post_ids.app_error":                                         {500},
+	"app.reaction.delete_all_with_emoji_name.get_reactions.app_error":                      {500},
+	"app.reaction.get_for_post.app_error":                                                  {500},
+	"app.reaction.save.save.app_error":                                                     {500},
+	"app.recover.delete.app_error":                                                         {500},
+	"app.recover.save.app_error":                                                           {500},
+	"app.retention_policy.delete.app_error":                                                {500},
+	"app.retention_policy.get.app_error":                                                   {500},
+	"app.retention_policy.get.not_found.app_error":                                         {404},
+	"app.retention_policy.get_all.app_error":                                               {500},
+	"app.retention_policy.get_teams.app_error":                                             {500},
+	"app.retention_policy.invalid_channel.app_error":                                       {400},
+	"app.retention_policy.invalid_team.app_error":                                          {400},
+	"app.retention_policy.save.app_error":                                                  {500},
+	"app.retention_policy.save.conflict.app_error":                                         {400},
+	"app.retention_policy.set_for_team.app_error":                                          {500},
+	"app.role.check_roles_exist.role_not_found":                                            {400},
+	"app.role.expires_at.app_error":                                                        {400},
+	"app.save_config.app_error":                                                            {500},
+	"app.scheme.delete.app_error":                                                          {500},
+	"app.scheme.get.app_error":                                                             {404, 500},
+	"app.scheme.permanent_delete_all.app_error":                                            {500},
+	"app.scheme.save.app_error":                                                            {500},
+	"app.scheme.save.invalid_scheme.app_error":                                             {400},
+	"app.schemes.is_phase_2_migration_completed.not_completed.app_error":                   {501},
+	"app.session.analytics_session_count.app_error":                                        {500},
+	"app.session.get.app_error":                                                            {400, 500},
+	"app.session.get_sessions.app_error":                                                   {500},
+	"app.session.permanent_delete_sessions_by_user.app_error":                              {500},
+	"app.session.remove.app_error":                                                         {500},
+	"app.session.remove_all_sessions_for_team.app_error":                                   {500},
+	"app.session.save.app_error":                                                           {500},
+	"app.session.save.existing.app_error":                                                  {400},
+	"app.session.update_device_id.app_error":                                               {500},
+	"app.slug_history.get.app_error":                                                       {500},
+	"app.slug_history.get.not_found.app_error":                                             {404},
+	"app.status_automation.app_error":                                                      {500},
+	"app.status_automation.disabled.app_error":                                             {501},
+	"app.status_automation.end_at.app_error":                                               {400},
+	"app.status_automation.get.not_found.app_error":                                        {404},
+	"app.status_automation.out_of_office.app_error":                                        {400},
+	"app.status_automation.save.exists.app_error":                                          {400},
+	"app.submit_interactive_dialog.json_error":                                             {400},
+	"app.support_packet.write.app_error":                                                   {500},
+	"app.system_install_date.parse_int.app_error":                                          {500},
+	"app.team.get.find.app_error":                                                          {404},
+	"app.team.get.finding.app_error":                                                       {500},
+	"app.team.get_active_member_counts.app_error":                                          {500},
+	"app.team.get_by_invite_id.app_error":                                                  {500},
+	"app.team.get_by_invite_id.finding.app_error":                                          {404},
+	"app.team.get_by_name.app_error":                                                       {500},
+	"app.team.get_by_name.missing.app_error":                                               {404},
+	"app.team.get_member.app_error":                                                        {500},
+	"app.team.get_member.missing.app_error":                                                {404},
+	"app.team.get_members.app_error":                                                       {500},
+	"app.team.get_members_with_expired_roles.app_error":                                    {500},
+	"app.team.invite_id.group_constrained.error":                                           {403},
+	"app.team.invite_token.group_constrained.error":                                        {403},
+	"app.team.join_user_to_team.max_accounts.app_error":                                    {400},
+	"app.team.join_user_to_team.save_member.app_error":                                     {500},
+	"app.team.join_user_to_team.save_member.conflict.app_error":                            {400},
+	"app.team.permanentdeleteteam.internal_error":                                          {500},
+	"app.team.rename_team.name_occupied":                                                   {400},
+	"app.team.restore.app_error":                                                           {500},
+	"app.team.save.app_error":                                                              {400, 500},
+	"app.team.save.domain_exists.app_error":                                                {400},
+	"app.team.save.existing.app_error":                                                     {400},
+	"app.team.update.find.app_error":                                                       {404},
+	"app.team.update.updating.app_error":                                                   {500},
+	"app.team_invite_link.create.limit.app_error":                                          {400},
+	"app.team_invite_link.exhausted.app_error":                                             {400},
+	"app.team_invite_link.expired.app_error":                                               {400},
+	"app.team_invite_link.get.app_error":                                                   {500},
+	"app.team_invite_link.get.not_found.app_error":                                         {404},
+	"app.team_invite_link.get_for_team.app_error":                                          {500},
+	"app.team_invite_link.increment_use_count.app_error":                                   {500},
+	"app.team_invite_link.invalid.app_error":                                               {400},
+	"app.team_invite_link.invalid_channels.app_error":                                      {400},
+	"app.team_invite_link.revoke.app_error":                                                {500},
+	"app.team_invite_link.save.app_error":                                                  {500},
+	"app.team_invite_link.save.name_exists.app_error":                                      {400},
+	"app.terms_of_service.create.app_error":                                                {500},
+	"app.terms_of_service.create.existing.app_error":                                       {400},
+	"app.terms_of_service.get.app_error":                                                   {500},
+	"app.terms_of_service.get.no_rows.app_error":                                           {404},
+	"app.terms_of_service_policy.accept.app_error":                                         {500},
+	"app.terms_of_service_policy.accept.outdated.app_error":                                {400},
+	"app.terms_of_service_policy.get.not_found.app_error":                                  {404},
+	"app.terms_of_service_policy.get_all.app_error":                                        {500},
+	"app.terms_of_service_policy.get_pending.app_error":                                    {500},
+	"app.terms_of_service_policy.get_version.app_error":                                    {500},
+	"app.terms_of_service_policy.invalid_group.app_error":                                  {400},
+	"app.terms_of_service_policy.invalid_team.app_error":                                   {400},
+	"app.terms_of_service_policy.no_version.app_error":                                     {404},
+	"app.terms_of_service_policy.report.app_error":                                         {500},
+	"app.terms_of_service_policy.save.conflict.app_error":                                  {409},
+	"app.terms_of_service_policy.store.app_error":                                          {500},
+	"app.user.permanentdeleteuser.internal_error":                                          {500},
+	"app.user.set_manager.cycle.app_error":                                                 {400},
+	"app.user.set_manager.invalid_manager.app_error":                                       {400},
+	"app.user_access_token.disabled":                                                       {501},
+	"app.user_access_token.invalid_or_missing":                                             {401},
+	"app.user_property.create_field.limit.app_error":                                       {400},
+	"app.user_property.get_field.not_found.app_error":                                      {404},
+	"app.user_property.get_fields.app_error":                                               {500},
+	"app.user_property.get_values.app_error":                                               {500},
+	"app.user_property.patch_values.invalid_field.app_error":                               {400},
+	"app.user_property.patch_values.synced_field.app_error":                                {400},
+	"app.user_property.save_field.conflict.app_error":                                      {409},
+	"app.user_property.save_values.app_error":                                              {500},
+	"app.user_property.store.app_error":                                                    {500},
+	"app.user_property.update_field.type.app_error":                                        {400},
+	"app.user_relationship.add.exists.app_error":                                           {400},
+	"app.user_relationship.blocked.app_error":                                              {403},
+	"app.user_relationship.delete.app_error":                                               {500},
+	"app.user_relationship.get.app_error":                                                  {500},
+	"app.user_relationship.get.not_found.app_error":                                        {404},
+	"app.user_relationship.get_for_user.app_error":                                         {500},
+	"app.user_relationship.get_related_to.app_error":                                       {500},
+	"app.user_relationship.save.app_error":                                                 {500},
+	"app.user_terms_of_service.delete.app_error":                                           {500},
+	"app.user_terms_of_service.get_by_user.app_error":                                      {500},
+	"app.user_terms_of_service.get_by_user.no_rows.app_error":                              {404},
+	"app.user_terms_of_service.save.app_error":                                             {500},
+	"app.web_conn.disconnect.not_found.app_error":                                          {404},
+	"app.web_conn.get_cluster_web_conns.app_error":                                         {500},
+	"bleveengine.already_started.error":                                                    {500},
+	"bleveengine.create_channel_index.error":                                               {500},
+	"bleveengine.create_post_index.error":                                                  {500},
+	"bleveengine.create_user_index.error":                                                  {500},
+	"bleveengine.delete_channel.error":                                                     {500},
+	"bleveengine.delete_channel_posts.error":                                               {500},
+	"bleveengine.delete_post.error":                                                        {500},
+	"bleveengine.delete_user.error":                                                        {500},
+	"bleveengine.delete_user_posts.error":                                                  {500},
+	"bleveengine.index_channel.error":                                                      {500},
+	"bleveengine.index_post.error":                                                         {500},
+	"bleveengine.index_user.error":                                                         {500},
+	"bleveengine.indexer.do_job.bulk_index_channels.batch_error":                           {500},
+	"bleveengine.indexer.do_job.bulk_index_posts.batch_error":                              {500},
+	"bleveengine.indexer.do_job.bulk_index_users.batch_error":                              {500},
+	"bleveengine.indexer.do_job.engine_inactive":                                           {500},
+	"bleveengine.indexer.do_job.get_oldest_post.error":                                     {500},
+	"bleveengine.indexer.do_job.parse_end_time.error":                                      {500},
+	"bleveengine.indexer.do_job.parse_start_time.error":                                    {500},
+	"bleveengine.indexer.index_batch.nothing_left_to_index.error":                          {500},
+	"bleveengine.purge_channel_index.error":                                                {500},
+	"bleveengine.purge_post_index.error":                                                   {500},
+	"bleveengine.purge_user_index.error":                                                   {500},
+	"bleveengine.search_channels.error":                                                    {500},
+	"bleveengine.search_posts.error":                                                       {500},
+	"bleveengine.search_users_in_channel.nuchan.error":                                     {500},
+	"bleveengine.search_users_in_channel.uchan.error":                                      {500},
+	"bleveengine.search_users_in_team.error":                                               {500},
+	"bleveengine.stop_channel_index.error":                                                 {500},
+	"bleveengine.stop_post_index.error":                                                    {500},
+	"bleveengine.stop_user_index.error":                                                    {500},
+	"brand.save_brand_image.decode.app_error":                                              {400},
+	"brand.save_brand_image.decode_config.app_error":                                       {400},
+	"brand.save_brand_image.encode.app_error":                                              {500},
+	"brand.save_brand_image.open.app_error":                                                {400},
+	"brand.save_brand_image.save_image.app_error":                                          {500},
+	"brand.save_brand_image.too_large.app_error":                                           {400},
+	"cli.outgoing_webhook.inconsistent_state.app_error":                                    {500},
+	"ent.cluster.save_config.error":                                                        {403},
+	"ent.compliance.licence_disable.app_error":                                             {501},
+	"ent.data_retention.generic.license.error":                                             {501},
+	"ent.elasticsearch.test_config.license.error":                                          {501},
+	"ent.elasticsearch.test_config.reenter_password":                                       {400},
+	"ent.ldap.app_error":                                                                   {501},
+	"ent.ldap.disabled.app_error":                                                          {501},
+	"ent.ldap.validate_admin_filter.app_error":                                             {400},
+	"ent.ldap.validate_filter.app_error":                                                   {400},
+	"ent.ldap.validate_guest_filter.app_error":                                             {400},
+	"ent.ldap_id_migrate.app_error":                                                        {500},
+	"group_not_associated_to_synced_team":                                                  {400},
+	"groups.unsupported_syncable_type":                                                     {500},
+	"interactive_message.decode_trigger_id.base64_decode_failed":                           {400},
+	"interactive_message.decode_trigger_id.base64_decode_failed_signature":                 {400},
+	"interactive_message.decode_trigger_id.expired":                                        {400},
+	"interactive_message.decode_trigger_id.missing_data":                                   {400},
+	"interactive_message.decode_trigger_id.signature_decode_failed":                        {400},
+	"interactive_message.decode_trigger_id.verify_signature_failed":                        {400},
+	"interactive_message.generate_trigger_id.signing_failed":                               {500},
+	"jobs.data_retention.delete_batch.app_error":                                           {500},
+	"jobs.data_retention.drop_partitions.app_error":                                        {500},
+	"jobs.data_retention.get_partitions.app_error":                                         {500},
+	"jobs.data_retention.get_policies.app_error":                                           {500},
+	"jobs.post_archive.archive_batch.app_error":                                            {500},
+	"jobs.posts_partitioning.copy_batch.app_error":                                         {500},
+	"jobs.posts_partitioning.ensure_partitions.app_error":                                  {500},
+	"jobs.posts_partitioning.finish_conversion.app_error":                                  {500},
+	"jobs.posts_partitioning.is_partitioned.app_error":                                     {500},
+	"jobs.posts_partitioning.start_conversion.app_error":                                   {500},
+	"jobs.request_cancellation.status.error":                                               {500},
+	"jobs.set_job_error.update.error":                                                      {500},
+	"manaultesting.manual_test.parse.app_error":                                            {400},
+	"manaultesting.test_autolink.unable.app_error":                                         {500},
+	"mfa.activate.authenticate.app_error":                                                  {500},
+	"mfa.activate.bad_token.app_error":                                                     {401},
+	"mfa.activate.save_active.app_error":                                                   {500},
+	"mfa.deactivate.save_active.app_error":                                                 {500},
+	"mfa.deactivate.save_secret.app_error":                                                 {500},
+	"mfa.generate_qr_code.create_code.app_error":                                           {500},
+	"mfa.generate_qr_code.save_secret.app_error":                                           {500},
+	"mfa.mfa_disabled.app_error":                                                           {501},
+	"mfa.validate_token.authenticate.app_error":                                            {400},
+	"migrations.worker.run_advanced_permissions_phase_2_migration.invalid_progress":        {500},
+	"migrations.worker.run_migration.unknown_key":                                          {500},
+	"migrations.worker.run_sidebar_categories_phase_2_migration.internal_error":            {500},
+	"migrations.worker.run_sidebar_categories_phase_2_migration.invalid_progress":          {500},
+	"model.access.is_valid.access_token.app_error":                                         {400},
+	"model.access.is_valid.client_id.app_error":                                            {400},
+	"model.access.is_valid.redirect_uri.app_error":                                         {400},
+	"model.access.is_valid.refresh_token.app_error":                                        {400},
+	"model.access.is_valid.user_id.app_error":                                              {400},
+	"model.authorize.is_valid.auth_code.app_error":                                         {400},
+	"model.authorize.is_valid.client_id.app_error":                                         {400},
+	"model.authorize.is_valid.create_at.app_error":                                         {400},
+	"model.authorize.is_valid.expires.app_error":                                           {400},
+	"model.authorize.is_valid.redirect_uri.app_error":                                      {400},
+	"model.authorize.is_valid.response_type.app_error":                                     {400},
+	"model.authorize.is_valid.scope.app_error":                                             {400},
+	"model.authorize.is_valid.state.app_error":                                             {400},
+	"model.authorize.is_valid.user_id.app_error":                                           {400},
+	"model.bot.is_valid.create_at.app_error":                                               {400},
+	"model.bot.is_valid.creator_id.app_error":                                              {400},
+	"model.bot.is_valid.description.app_error":                                             {400},
+	"model.bot.is_valid.update_at.app_error":                                               {400},
+	"model.bot.is_valid.user_id.app_error":                                                 {400},
+	"model.bot.is_valid.username.app_error":                                                {400},
+	"model.channel.is_valid.2_or_more.app_error":                                           {400},
+	"model.channel.is_valid.create_at.app_error":                                           {400},
+	"model.channel.is_valid.creator_id.app_error":                                          {400},
+	"model.channel.is_valid.display_name.app_error":                                        {400},
+	"model.channel.is_valid.header.app_error":                                              {400},
+	"model.channel.is_valid.id.app_error":                                                  {400},
+	"model.channel.is_valid.name.app_error":                                                {400},
+	"model.channel.is_valid.props.app_error":                                               {400},
+	"model.channel.is_valid.purpose.app_error":                                             {400},
+	"model.channel.is_valid.type.app_error":                                                {400},
+	"model.channel.is_valid.update_at.app_error":                                           {400},
+	"model.channel_bookmark.is_valid.channel_id.app_error":                                 {400},
+	"model.channel_bookmark.is_valid.create_at.app_error":                                  {400},
+	"model.channel_bookmark.is_valid.display_name.app_error":                               {400},
+	"model.channel_bookmark.is_valid.emoji.app_error":                                      {400},
+	"model.channel_bookmark.is_valid.file_id.app_error":                                    {400},
+	"model.channel_bookmark.is_valid.id.app_error":                                         {400},
+	"model.channel_bookmark.is_valid.image_url.app_error":                                  {400},
+	"model.channel_bookmark.is_valid.link_url.app_error":                                   {400},
+	"model.channel_bookmark.is_valid.owner_id.app_error":                                   {400},
+	"model.channel_bookmark.is_valid.type.app_error":                                       {400},
+	"model.channel_bookmark.is_valid.update_at.app_error":                                  {400},
+	"model.channel_guest_link.is_valid.allowed_domains.app_error":                          {400},
+	"model.channel_guest_link.is_valid.channel_id.app_error":                               {400},
+	"model.channel_guest_link.is_valid.create_at.app_error":                                {400},
+	"model.channel_guest_link.is_valid.creator_id.app_error":                               {400},
+	"model.channel_guest_link.is_valid.expires_at.app_error":                               {400},
+	"model.channel_guest_link.is_valid.id.app_error":                                       {400},
+	"model.channel_guest_link.is_valid.max_uses.app_error":                                 {400},
+	"model.channel_guest_link.is_valid.team_id.app_error":                                  {400},
+	"model.channel_guest_link.is_valid.token.app_error":                                    {400},
+	"model.channel_guest_link.is_valid.update_at.app_error":                                {400},
+	"model.channel_integration.is_valid.channel_id.app_error":                              {400},
+	"model.channel_integration.is_valid.create_at.app_error":                               {400},
+	"model.channel_integration.is_valid.creator_id.app_error":                              {400},
+	"model.channel_integration.is_valid.integration_id.app_error":                          {400},
+	"model.channel_integration.is_valid.type.app_error":                                    {400},
+	"model.channel_member.is_valid.channel_id.app_error":                                   {400},
+	"model.channel_member.is_valid.email_value.app_error":                                  {400},
+	"model.channel_member.is_valid.ignore_channel_mentions_value.app_error":                {400},
+	"model.channel_member.is_valid.notify_level.app_error":                                 {400},
+	"model.channel_member.is_valid.push_level.app_error":                                   {400},
+	"model.channel_member.is_valid.unread_level.app_error":                                 {400},
+	"model.channel_member.is_valid.user_id.app_error":                                      {400},
+	"model.client.connecting.app_error":                                                    {0, 400, 403},
+	"model.client.create_emoji.emoji.app_error":                                            {0},
+	"model.client.create_emoji.image.app_error":                                            {0},
+	"model.client.create_emoji.writer.app_error":                                           {0},
+	"model.client.get_flagged_posts_in_channel.missing_parameter.app_error":                {400},
+	"model.client.get_flagged_posts_in_team.missing_parameter.app_error":                   {400},
+	"model.client.get_team_icon.app_error":                                                 {},
+	"model.client.parse_plugins.app_error":                                                 {400},
+	"model.client.plugin_request_to_json.app_error":                                        {400},
+	"model.client.read_file.app_error":                                                     {},
+	"model.client.set_bot_icon_image.no_file.app_error":                                    {400},
+	"model.client.set_bot_icon_image.writer.app_error":                                     {400},
+	"model.client.set_profile_user.no_file.app_error":                                      {400},
+	"model.client.set_profile_user.writer.app_error":                                       {400},
+	"model.client.set_team_icon.no_file.app_error":                                         {400},
+	"model.client.set_team_icon.writer.app_error":                                          {400},
+	"model.client.upload_post_attachment.channel_id.app_error":                             {400},
+	"model.client.upload_post_attachment.file.app_error":                                   {400},
+	"model.client.upload_post_attachment.file_size.app_error":                              {400},
+	"model.client.upload_post_attachment.import_from.app_error":                            {400},
+	"model.client.upload_post_attachment.writer.app_error":                                 {400},
+	"model.client.upload_saml_cert.app_error":                                              {400},
+	"model.client.writer.app_error":                                                        {0},
+	"model.cluster.is_valid.create_at.app_error":                                           {400},
+	"model.cluster.is_valid.hostname.app_error":                                            {400},
+	"model.cluster.is_valid.id.app_error":                                                  {400},
+	"model.cluster.is_valid.last_ping_at.app_error":                                        {400},
+	"model.cluster.is_valid.name.app_error":                                                {400},
+	"model.cluster.is_valid.type.app_error":                                                {400},
+	"model.command.is_valid.autocomplete_data.app_error":                                   {400},
+	"model.command.is_valid.autocomplete_data_url.app_error":                               {400},
+	"model.command.is_valid.autocomplete_data_version.app_error":                           {400},
+	"model.command.is_valid.create_at.app_error":                                           {400},
+	"model.command.is_valid.description.app_error":                                         {400},
+	"model.command.is_valid.display_name.app_error":                                        {400},
+	"model.command.is_valid.id.app_error":                                                  {400},
+	"model.command.is_valid.method.app_error":                                              {400},
+	"model.command.is_valid.team_id.app_error":                                             {400},
+	"model.command.is_valid.token.app_error":                                               {400},
+	"model.command.is_valid.trigger.app_error":                                             {400},
+	"model.command.is_valid.update_at.app_error":                                           {400},
+	"model.command.is_valid.url.app_error":                                                 {400},
+	"model.command.is_valid.url_http.app_error":                                            {400},
+	"model.command.is_valid.user_id.app_error":                                             {400},
+	"model.command_hook.channel_id.app_error":                                              {400},
+	"model.command_hook.command_id.app_error":                                              {400},
+	"model.command_hook.create_at.app_error":                                               {400},
+	"model.command_hook.id.app_error":                                                      {400},
+	"model.command_hook.parent_id.app_error":                                               {400},
+	"model.command_hook.root_id.app_error":                                                 {400},
+	"model.command_hook.user_id.app_error":                                                 {400},
+	"model.compliance.is_valid.create_at.app_error":                                        {400},
+	"model.compliance.is_valid.desc.app_error":                                             {400},
+	"model.compliance.is_valid.end_at.app_error":                                           {400},
+	"model.compliance.is_valid.id.app_error":                                               {400},
+	"model.compliance.is_valid.start_at.app_error":                                         {400},
+	"model.compliance.is_valid.start_end_at.app_error":                                     {400},
+	"model.config.is_valid.allow_cookies_for_subdomains.app_error":                         {400},
+	"model.config.is_valid.archive.archive_after_months.app_error":                         {400},
+	"model.config.is_valid.archive.batch_size.app_error":                                   {400},
+	"model.config.is_valid.archive.job_start_time.app_error":                               {400},
+	"model.config.is_valid.atmos_camo_image_proxy_options.app_error":                       {400},
+	"model.config.is_valid.atmos_camo_image_proxy_url.app_error":                           {400},
+	"model.config.is_valid.backup.directory.app_error":                                     {400},
+	"model.config.is_valid.backup.interval_hours.app_error":                                {400},
+	"model.config.is_valid.backup.max_incremental_backups.app_error":                       {400},
+	"model.config.is_valid.bleve_search.bulk_indexing_time_window_seconds.app_error":       {400},
+	"model.config.is_valid.bleve_search.enable_autocomplete.app_error":                     {400},
+	"model.config.is_valid.bleve_search.enable_searching.app_error":                        {400},
+	"model.config.is_valid.bleve_search.filename.app_error":                                {400},
+	"model.config.is_valid.cluster_email_batching.app_error":                               {400},
+	"model.config.is_valid.data_retention.batch_size.app_error":                            {400},
+	"model.config.is_valid.data_retention.deletion_job_start_time.app_error":               {400},
+	"model.config.is_valid.data_retention.file_retention_days_too_low.app_error":           {400},
+	"model.config.is_valid.data_retention.message_retention_days_too_low.app_error":        {400},
+	"model.config.is_valid.data_retention.time_between_batches.app_error":                  {400},
+	"model.config.is_valid.diagnostics_categories.app_error":                               {400},
+	"model.config.is_valid.diagnostics_sink_url.app_error":                                 {400},
+	"model.config.is_valid.display.custom_url_schemes.app_error":                           {400},
+	"model.config.is_valid.elastic_search.aggregate_posts_after_days.app_error":            {400},
+	"model.config.is_valid.elastic_search.bulk_indexing_time_window_seconds.app_error":     {400},
+	"model.config.is_valid.elastic_search.connection_url.app_error":                        {400},
+	"model.config.is_valid.elastic_search.enable_autocomplete.app_error":                   {400},
+	"model.config.is_valid.elastic_search.enable_searching.app_error":                      {400},
+	"model.config.is_valid.elastic_search.live_indexing_batch_size.app_error":              {400},
+	"model.config.is_valid.elastic_search.posts_aggregator_job_start_time.app_error":       {400},
+	"model.config.is_valid.elastic_search.request_timeout_seconds.app_error":               {400},
+	"model.config.is_valid.email_batching_buffer_size.app_error":                           {400},
+	"model.config.is_valid.email_batching_interval.app_error":                              {400},
+	"model.config.is_valid.email_notification_contents_type.app_error":                     {400},
+	"model.config.is_valid.email_security.app_error":                                       {400},
+	"model.config.is_valid.email_verification_grace_period_days.app_error":                 {400},
+	"model.config.is_valid.email_verification_reminder_days.app_error":                     {400},
+	"model.config.is_valid.encrypt_sql.app_error":                                          {400},
+	"model.config.is_valid.event_stream.batch_size.app_error":                              {400},
+	"model.config.is_valid.event_stream.event_types.app_error":                             {400},
+	"model.config.is_valid.event_stream.kafka_brokers.app_error":                           {400},
+	"model.config.is_valid.event_stream.kafka_topic.app_error":                             {400},
+	"model.config.is_valid.event_stream.sink.app_error":                                    {400},
+	"model.config.is_valid.event_stream.webhook_secret.app_error":                          {400},
+	"model.config.is_valid.event_stream.webhook_url.app_error":                             {400},
+	"model.config.is_valid.file_driver.app_error":                                          {400},
+	"model.config.is_valid.file_salt.app_error":                                            {400},
+	"model.config.is_valid.group_unread_channels.app_error":                                {400},
+	"model.config.is_valid.image_proxy_type.app_error":                                     {400},
+	"model.config.is_valid.impersonation_session_length.app_error":                         {400},
+	"model.config.is_valid.ldap_basedn":                                                    {400},
+	"model.config.is_valid.ldap_email":                                                     {400},
+	"model.config.is_valid.ldap_id":                                                        {400},
+	"model.config.is_valid.ldap_login_id":                                                  {400},
+	"model.config.is_valid.ldap_max_page_size.app_error":                                   {400},
+	"model.config.is_valid.ldap_security.app_error":                                        {400},
+	"model.config.is_valid.ldap_server":                                                    {400},
+	"model.config.is_valid.ldap_sync_interval.app_error":                                   {400},
+	"model.config.is_valid.ldap_username":                                                  {400},
+	"model.config.is_valid.listen_address.app_error":                                       {400},
+	"model.config.is_valid.localization.available_locales.app_error":                       {400},
+	"model.config.is_valid.login_attempts.app_error":                                       {400},
+	"model.config.is_valid.login_history_retention_days.app_error":                         {400},
+	"model.config.is_valid.max_burst.app_error":                                            {400},
+	"model.config.is_valid.max_channels.app_error":                                         {400},
+	"model.config.is_valid.max_file_size.app_error":                                        {400},
+	"model.config.is_valid.max_notify_per_channel.app_error":                               {400},
+	"model.config.is_valid.max_users.app_error":                                            {400},
+	"model.config.is_valid.message_export.batch_size.app_error":                            {400},
+	"model.config.is_valid.message_export.daily_runtime.app_error":                         {400},
+	"model.config.is_valid.message_export.enable.app_error":                                {400},
+	"model.config.is_valid.message_export.export_from.app_error":                           {400},
+	"model.config.is_valid.message_export.export_type.app_error":                           {400},
+	"model.config.is_valid.message_export.global_relay.config_missing.app_error":           {400},
+	"model.config.is_valid.message_export.global_relay.customer_type.app_error":            {400},
+	"model.config.is_valid.message_export.global_relay.email_address.app_error":            {400},
+	"model.config.is_valid.message_export.global_relay.smtp_password.app_error":            {400},
+	"model.config.is_valid.message_export.global_relay.smtp_username.app_error":            {400},
+	"model.config.is_valid.password_length.app_error":                                      {400},
+	"model.config.is_valid.profiling.cpu_profile_seconds.app_error":                        {400},
+	"model.config.is_valid.profiling.latency_threshold.app_error":                          {400},
+	"model.config.is_valid.profiling.memory_threshold.app_error":                           {400},
+	"model.config.is_valid.profiling.minimum_capture_interval.app_error":                   {400},
+	"model.config.is_valid.rate_mem.app_error":                                             {400},
+	"model.config.is_valid.rate_sec.app_error":                                             {400},
+	"model.config.is_valid.read_timeout.app_error":                                         {400},
+	"model.config.is_valid.restrict_direct_message.app_error":                              {400},
+	"model.config.is_valid.saml_admin_attribute.app_error":                                 {400},
+	"model.config.is_valid.saml_assertion_consumer_service_url.app_error":                  {400},
+	"model.config.is_valid.saml_canonical_algorithm.app_error":                             {400},
+	"model.config.is_valid.saml_email_attribute.app_error":                                 {400},
+	"model.config.is_valid.saml_guest_attribute.app_error":                                 {400},
+	"model.config.is_valid.saml_idp_cert.app_error":                                        {400},
+	"model.config.is_valid.saml_idp_descriptor_url.app_error":                              {400},
+	"model.config.is_valid.saml_idp_url.app_error":                                         {400},
+	"model.config.is_valid.saml_private_key.app_error":                                     {400},
+	"model.config.is_valid.saml_public_cert.app_error":                                     {400},
+	"model.config.is_valid.saml_signature_algorithm.app_error":                             {400},
+	"model.config.is_valid.saml_spidentifier_attribute.app_error":                          {400},
+	"model.config.is_valid.saml_username_attribute.app_error":                              {400},
+	"model.config.is_valid.seat_usage_notification_days.app_error":                         {400},
+	"model.config.is_valid.site_url.app_error":                                             {400},
+	"model.config.is_valid.site_url_email_batching.app_error":                              {400},
+	"model.config.is_valid.sitename_length.app_error":                                      {400},
+	"model.config.is_valid.sql_conn_max_lifetime_milliseconds.app_error":                   {400},
+	"model.config.is_valid.sql_data_src.app_error":                                         {400},
+	"model.config.is_valid.sql_driver.app_error":                                           {400},
+	"model.config.is_valid.sql_idle.app_error":                                             {400},
+	"model.config.is_valid.sql_max_conn.app_error":                                         {400},
+	"model.config.is_valid.sql_migration_lock_timeout.app_error":                           {400},
+	"model.config.is_valid.sql_posts_partitioning.app_error":                               {400},
+	"model.config.is_valid.sql_query_timeout.app_error":                                    {400},
+	"model.config.is_valid.sql_replica_check_interval.app_error":                           {400},
+	"model.config.is_valid.sql_replica_failure_threshold.app_error":                        {400},
+	"model.config.is_valid.sql_replica_master_read_percent.app_error":                      {400},
+	"model.config.is_valid.store_fault_injection_delay.app_error":                          {400},
+	"model.config.is_valid.store_fault_injection_error_percent.app_error":                  {400},
+	"model.config.is_valid.teammate_name_display.app_error":                                {400},
+	"model.config.is_valid.time_between_user_typing.app_error":                             {400},
+	"model.config.is_valid.tls_cert_file.app_error":                                        {400},
+	"model.config.is_valid.tls_key_file.app_error":                                         {400},
+	"model.config.is_valid.tls_overwrite_cipher.app_error":                                 {400},
+	"model.config.is_valid.webserver_security.app_error":                                   {400},
+	"model.config.is_valid.websocket_url.app_error":                                        {400},
+	"model.config.is_valid.write_timeout.app_error":                                        {400},
+	"model.debug_capture_rule.is_valid.endpoint.app_error":                                 {400},
+	"model.debug_capture_rule.is_valid.expires_at.app_error":                               {400},
+	"model.debug_capture_rule.is_valid.id.app_error":                                       {400},
+	"model.debug_capture_rule.is_valid.target.app_error":                                   {400},
+	"model.debug_capture_rule.is_valid.user_id.app_error":                                  {400},
+	"model.email_verification.is_valid.create_at.app_error":                                {400},
+	"model.email_verification.is_valid.deadline.app_error":                                 {400},
+	"model.email_verification.is_valid.user_id.app_error":                                  {400},
+	"model.emoji.create_at.app_error":                                                      {400},
+	"model.emoji.id.app_error":                                                             {400},
+	"model.emoji.name.app_error":                                                           {400},
+	"model.emoji.update_at.app_error":                                                      {400},
+	"model.emoji.user_id.app_error":                                                        {400},
+	"model.event_stream_event.is_valid.create_at.app_error":                                {400},
+	"model.event_stream_event.is_valid.data.app_error":                                     {400},
+	"model.event_stream_event.is_valid.id.app_error":                                       {400},
+	"model.event_stream_event.is_valid.type.app_error":                                     {400},
+	"model.file_info.get.gif.app_error":                                                    {400},
+	"model.file_info.is_valid.create_at.app_error":                                         {400},
+	"model.file_info.is_valid.id.app_error":                                                {400},
+	"model.file_info.is_valid.path.app_error":                                              {400},
+	"model.file_info.is_valid.post_id.app_error":                                           {400},
+	"model.file_info.is_valid.update_at.app_error":                                         {400},
+	"model.file_info.is_valid.user_id.app_error":                                           {400},
+	"model.group.create_at.app_error":                                                      {400},
+	"model.group.delete_at.app_error":                                                      {500},
+	"model.group.description.app_error":                                                    {400},
+	"model.group.display_name.app_error":                                                   {400},
+	"model.group.id.app_error":                                                             {400},
+	"model.group.name.app_error":                                                           {400},
+	"model.group.name.invalid_chars.app_error":                                             {400},
+	"model.group.name.invalid_length.app_error":                                            {400},
+	"model.group.remote_id.app_error":                                                      {400},
+	"model.group.source.app_error":                                                         {400},
+	"model.group.update_at.app_error":                                                      {400},
+	"model.group_member.group_id.app_error":                                                {400},
+	"model.group_member.user_id.app_error":                                                 {400},
+	"model.group_syncable.group_id.app_error":                                              {400},
+	"model.group_syncable.syncable_id.app_error":                                           {400},
+	"model.group_syncable.type.app_error":                                                  {500},
+	"model.guest.is_valid.channel.app_error":                                               {400},
+	"model.guest.is_valid.channels.app_error":                                              {400},
+	"model.guest.is_valid.email.app_error":                                                 {400},
+	"model.guest.is_valid.emails.app_error":                                                {400},
+	"model.incoming_hook.channel_id.app_error":                                             {400},
+	"model.incoming_hook.create_at.app_error":                                              {400},
+	"model.incoming_hook.description.app_error":                                            {400},
+	"model.incoming_hook.display_name.app_error":                                           {400},
+	"model.incoming_hook.icon_url.app_error":                                               {400},
+	"model.incoming_hook.id.app_error":                                                     {400},
+	"model.incoming_hook.parse_data.app_error":                                             {400},
+	"model.incoming_hook.team_id.app_error":                                                {400},
+	"model.incoming_hook.update_at.app_error":                                              {400},
+	"model.incoming_hook.user_id.app_error":                                                {400},
+	"model.incoming_hook.username.app_error":                                               {400},
+	"model.integration_usage.is_valid.counts.app_error":                                    {400},
+	"model.integration_usage.is_valid.day.app_error":                                       {400},
+	"model.integration_usage.is_valid.integration_id.app_error":                            {400},
+	"model.integration_usage.is_valid.type.app_error":                                      {400},
+	"model.job.is_valid.create_at.app_error":                                               {400},
+	"model.job.is_valid.id.app_error":                                                      {400},
+	"model.job.is_valid.status.app_error":                                                  {400},
+	"model.job.is_valid.type.app_error":                                                    {400},
+	"model.license_record.is_valid.create_at.app_error":                                    {400},
+	"model.license_record.is_valid.id.app_error":                                           {400},
+	"model.link_metadata.is_valid.data.app_error":                                          {400},
+	"model.link_metadata.is_valid.data_type.app_error":                                     {400},
+	"model.link_metadata.is_valid.timestamp.app_error":                                     {400},
+	"model.link_metadata.is_valid.type.app_error":                                          {400},
+	"model.link_metadata.is_valid.url.app_error":                                           {400},
+	"model.login_history.is_valid.create_at.app_error":                                     {400},
+	"model.login_history.is_valid.failure_reason.app_error":                                {400},
+	"model.login_history.is_valid.id.app_error":                                            {400},
+	"model.login_history.is_valid.ip_address.app_error":                                    {400},
+	"model.login_history.is_valid.user_agent.app_error":                                    {400},
+	"model.login_history.is_valid.user_id.app_error":                                       {400},
+	"model.oauth.is_valid.app_id.app_error":                                                {400},
+	"model.oauth.is_valid.callback.app_error":                                              {400},
+	"model.oauth.is_valid.client_secret.app_error":                                         {400},
+	"model.oauth.is_valid.create_at.app_error":                                             {400},
+	"model.oauth.is_valid.creator_id.app_error":                                            {400},
+	"model.oauth.is_valid.description.app_error":                                           {400},
+	"model.oauth.is_valid.homepage.app_error":                                              {400},
+	"model.oauth.is_valid.icon_url.app_error":                                              {400},
+	"model.oauth.is_valid.name.app_error":                                                  {400},
+	"model.oauth.is_valid.update_at.app_error":                                             {400},
+	"model.outbox_event.is_valid.create_at.app_error":                                      {400},
+	"model.outbox_event.is_valid.event.app_error":                                          {400},
+	"model.outbox_event.is_valid.id.app_error":                                             {400},
+	"model.outgoing_hook.icon_url.app_error":                                               {400},
+	"model.outgoing_hook.is_valid.callback.app_error":                                      {400},
+	"model.outgoing_hook.is_valid.channel_id.app_error":                                    {400},
+	"model.outgoing_hook.is_valid.content_type.app_error":                                  {400},
+	"model.outgoing_hook.is_valid.create_at.app_error":                                     {400},
+	"model.outgoing_hook.is_valid.description.app_error":                                   {400},
+	"model.outgoing_hook.is_valid.display_name.app_error":                                  {400},
+	"model.outgoing_hook.is_valid.id.app_error":                                            {400},
+	"model.outgoing_hook.is_valid.team_id.app_error":                                       {400},
+	"model.outgoing_hook.is_valid.token.app_error":                                         {400},
+	"model.outgoing_hook.is_valid.trigger_words.app_error":                                 {400},
+	"model.outgoing_hook.is_valid.update_at.app_error":                                     {400},
+	"model.outgoing_hook.is_valid.url.app_error":                                           {400},
+	"model.outgoing_hook.is_valid.user_id.app_error":                                       {400},
+	"model.outgoing_hook.is_valid.words.app_error":                                         {400},
+	"model.outgoing_hook.username.app_error":                                               {400},
+	"model.pending_pin.is_valid.channel_id.app_error":                                      {400},
+	"model.pending_pin.is_valid.create_at.app_error":                                       {400},
+	"model.pending_pin.is_valid.post_id.app_error":                                         {400},
+	"model.pending_pin.is_valid.user_id.app_error":                                         {400},
+	"model.permission_check.is_valid.channel_id.app_error":                                 {400},
+	"model.permission_check.is_valid.permission.app_error":                                 {400},
+	"model.permission_check.is_valid.scope.app_error":                                      {400},
+	"model.permission_check.is_valid.team_id.app_error":                                    {400},
+	"model.plugin_command.error.app_error":                                                 {500},
+	"model.plugin_key_value.is_valid.key.app_error":                                        {400},
+	"model.plugin_key_value.is_valid.plugin_id.app_error":                                  {400},
+	"model.plugin_kvset_options.is_valid.old_value.app_error":                              {400},
+	"model.post.is_valid.channel_id.app_error":                                             {400},
+	"model.post.is_valid.create_at.app_error":                                              {400},
+	"model.post.is_valid.file_ids.app_error":                                               {400},
+	"model.post.is_valid.filenames.app_error":                                              {400},
+	"model.post.is_valid.hashtags.app_error":                                               {400},
+	"model.post.is_valid.id.app_error":                                                     {400},
+	"model.post.is_valid.msg.app_error":                                                    {400},
+	"model.post.is_valid.original_id.app_error":                                            {400},
+	"model.post.is_valid.parent_id.app_error":                                              {400},
+	"model.post.is_valid.priority.app_error":                                               {400},
+	"model.post.is_valid.props.app_error":                                                  {400},
+	"model.post.is_valid.root_id.app_error":                                                {400},
+	"model.post.is_valid.root_parent.app_error":                                            {400},
+	"model.post.is_valid.type.app_error":                                                   {400},
+	"model.post.is_valid.update_at.app_error":                                              {400},
+	"model.post.is_valid.user_id.app_error":                                                {400},
+	"model.post_acknowledgement.is_valid.acknowledged_at.app_error":                        {400},
+	"model.post_acknowledgement.is_valid.channel_id.app_error":                             {400},
+	"model.post_acknowledgement.is_valid.post_id.app_error":                                {400},
+	"model.post_acknowledgement.is_valid.user_id.app_error":                                {400},
+	"model.preference.is_valid.category.app_error":                                         {400},
+	"model.preference.is_valid.id.app_error":                                               {400},
+	"model.preference.is_valid.name.app_error":                                             {400},
+	"model.preference.is_valid.theme.app_error":                                            {400},
+	"model.preference.is_valid.value.app_error":                                            {400},
+	"model.presence_hook.is_valid.callback_url.app_error":                                  {400},
+	"model.presence_hook.is_valid.create_at.app_error":                                     {400},
+	"model.presence_hook.is_valid.creator_id.app_error":                                    {400},
+	"model.presence_hook.is_valid.debounce_seconds.app_error":                              {400},
+	"model.presence_hook.is_valid.description.app_error":                                   {400},
+	"model.presence_hook.is_valid.display_name.app_error":                                  {400},
+	"model.presence_hook.is_valid.id.app_error":                                            {400},
+	"model.presence_hook.is_valid.statuses.app_error":                                      {400},
+	"model.presence_hook.is_valid.update_at.app_error":                                     {400},
+	"model.presence_hook.is_valid.user_ids.app_error":                                      {400},
+	"model.profile_capture.is_valid.seconds.app_error":                                     {400},
+	"model.profile_capture.is_valid.type.app_error":                                        {400},
+	"model.reaction.is_valid.create_at.app_error":                                          {400},
+	"model.reaction.is_valid.emoji_name.app_error":                                         {400},
+	"model.reaction.is_valid.post_id.app_error":                                            {400},
+	"model.reaction.is_valid.user_id.app_error":                                            {400},
+	"model.retention_policy.is_valid.channel_ids.app_error":                                {400},
+	"model.retention_policy.is_valid.create_at.app_error":                                  {400},
+	"model.retention_policy.is_valid.display_name.app_error":                               {400},
+	"model.retention_policy.is_valid.file_duration.app_error":                              {400},
+	"model.retention_policy.is_valid.id.app_error":                                         {400},
+	"model.retention_policy.is_valid.post_duration.app_error":                              {400},
+	"model.retention_policy.is_valid.team_ids.app_error":                                   {400},
+	"model.retention_policy.is_valid.update_at.app_error":                                  {400},
+	"model.service_account.is_valid.display_name.app_error":                                {400},
+	"model.service_account.is_valid.username.app_error":                                    {400},
+	"model.slug_history.is_valid.create_at.app_error":                                      {400},
+	"model.slug_history.is_valid.kind.app_error":                                           {400},
+	"model.slug_history.is_valid.name.app_error":                                           {400},
+	"model.slug_history.is_valid.scope_id.app_error":                                       {400},
+	"model.slug_history.is_valid.target_id.app_error":                                      {400},
+	"model.status_automation.is_valid.end_at.app_error":                                    {400},
+	"model.status_automation.is_valid.previous_status.app_error":                           {400},
+	"model.status_automation.is_valid.source.app_error":                                    {400},
+	"model.status_automation.is_valid.start_at.app_error":                                  {400},
+	"model.status_automation.is_valid.user_id.app_error":                                   {400},
+	"model.team.directory_categories.invalid.app_error":                                    {400},
+	"model.team.directory_categories.too_many.app_error":                                   {400},
+	"model.team.is_valid.characters.app_error":                                             {400},
+	"model.team.is_valid.company.app_error":                                                {400},
+	"model.team.is_valid.create_at.app_error":                                              {400},
+	"model.team.is_valid.description.app_error":                                            {400},
+	"model.team.is_valid.domains.app_error":                                                {400},
+	"model.team.is_valid.email.app_error":                                                  {400},
+	"model.team.is_valid.id.app_error":                                                     {400},
+	"model.team.is_valid.invite_id.app_error":                                              {400},
+	"model.team.is_valid.name.app_error":                                                   {400},
+	"model.team.is_valid.props.app_error":                                                  {400},
+	"model.team.is_valid.reserved.app_error":                                               {400},
+	"model.team.is_valid.type.app_error":                                                   {400},
+	"model.team.is_valid.update_at.app_error":                                              {400},
+	"model.team.is_valid.url.app_error":                                                    {400},
+	"model.team.notify_defaults.key.app_error":                                             {400},
+	"model.team.notify_defaults.value.app_error":                                           {400},
+	"model.team_invite_link.is_valid.channel_ids.app_error":                                {400},
+	"model.team_invite_link.is_valid.create_at.app_error":                                  {400},
+	"model.team_invite_link.is_valid.creator_id.app_error":                                 {400},
+	"model.team_invite_link.is_valid.display_name.app_error":                               {400},
+	"model.team_invite_link.is_valid.expires_at.app_error":                                 {400},
+	"model.team_invite_link.is_valid.id.app_error":                                         {400},
+	"model.team_invite_link.is_valid.max_uses.app_error":                                   {400},
+	"model.team_invite_link.is_valid.name.app_error":                                       {400},
+	"model.team_invite_link.is_valid.role.app_error":                                       {400},
+	"model.team_invite_link.is_valid.team_id.app_error":                                    {400},
+	"model.team_invite_link.is_valid.update_at.app_error":                                  {400},
+	"model.team_member.is_valid.team_id.app_error":                                         {400},
+	"model.team_member.is_valid.user_id.app_error":                                         {400},
+	"model.team_search.is_valid.policy_id.app_error":                                       {400},
+	"model.team_search.is_valid.sort.app_error":                                            {400},
+	"model.team_search.is_valid.team_type.app_error":                                       {400},
+	"model.terms_of_service_policy.is_valid.create_at.app_error":                           {400},
+	"model.terms_of_service_policy.is_valid.display_name.app_error":                        {400},
+	"model.terms_of_service_policy.is_valid.group_ids.app_error":                           {400},
+	"model.terms_of_service_policy.is_valid.id.app_error":                                  {400},
+	"model.terms_of_service_policy.is_valid.team_ids.app_error":                            {400},
+	"model.terms_of_service_policy.is_valid.update_at.app_error":                           {400},
+	"model.terms_of_service_policy_version.is_valid.create_at.app_error":                   {400},
+	"model.terms_of_service_policy_version.is_valid.id.app_error":                          {400},
+	"model.terms_of_service_policy_version.is_valid.policy_id.app_error":                   {400},
+	"model.terms_of_service_policy_version.is_valid.text.app_error":                        {400},
+	"model.terms_of_service_policy_version.is_valid.user_id.app_error":                     {400},
+	"model.token.is_valid.expiry":                                                          {500},
+	"model.token.is_valid.size":                                                            {500},
+	"model.user_access_token.is_valid.description.app_error":                               {400},
+	"model.user_access_token.is_valid.id.app_error":                                        {400},
+	"model.user_access_token.is_valid.token.app_error":                                     {400},
+	"model.user_access_token.is_valid.user_id.app_error":                                   {400},
+	"model.user_account_filters.is_valid.auth_service.app_error":                           {400},
+	"model.user_account_filters.is_valid.last_login_before.app_error":                      {400},
+	"model.user_account_filters.is_valid.mfa.app_error":                                    {400},
+	"model.user_property_field.is_valid.create_at.app_error":                               {400},
+	"model.user_property_field.is_valid.display_name.app_error":                            {400},
+	"model.user_property_field.is_valid.id.app_error":                                      {400},
+	"model.user_property_field.is_valid.ldap_attribute.app_error":                          {400},
+	"model.user_property_field.is_valid.name.app_error":                                    {400},
+	"model.user_property_field.is_valid.options.app_error":                                 {400},
+	"model.user_property_field.is_valid.type.app_error":                                    {400},
+	"model.user_property_field.is_valid.update_at.app_error":                               {400},
+	"model.user_property_value.is_valid.too_long.app_error":                                {400},
+	"model.user_property_value.is_valid.value.app_error":                                   {400},
+	"model.user_relationship.is_valid.create_at.app_error":                                 {400},
+	"model.user_relationship.is_valid.other_user_id.app_error":                             {400},
+	"model.user_relationship.is_valid.type.app_error":                                      {400},
+	"model.user_relationship.is_valid.user_id.app_error":                                   {400},
+	"model.utils.decode_json.app_error":                                                    {500},
+	"model.websocket_client.connect_fail.app_error":                                        {500},
+	"oauth.gitlab.tos.error":                                                               {400},
+	"plugin.api.get_users_in_channel":                                                      {400},
+	"plugin.api.update_user_status.bad_status":                                             {400},
+	"plugin_api.bot_cant_create_bot":                                                       {400},
+	"plugin_api.get_file_link.disabled.app_error":                                          {501},
+	"plugin_api.get_file_link.no_post.app_error":                                           {400},
+	"plugin_api.send_mail.missing_htmlbody":                                                {400},
+	"plugin_api.send_mail.missing_subject":                                                 {400},
+	"plugin_api.send_mail.missing_to":                                                      {400},
+	"searchengine.bleve.disabled.error":                                                    {501},
+	"store.chaos_layer.fault_injected.app_error":                                           {503},
+	"store.insert_error":                                                                   {500},
+	"store.read_only.app_error":                                                            {503},
+	"store.select_error":                                                                   {500},
+	"store.sql.build_query.app_error":                                                      {500},
+	"store.sql_bot.get.missing.app_error":                                                  {404},
+	"store.sql_channel.analytics_deleted_type_count.app_error":                             {500},
+	"store.sql_channel.analytics_type_count.app_error":                                     {500},
+	"store.sql_channel.clear_all_custom_role_assignments.commit_transaction.app_error":     {500},
+	"store.sql_channel.clear_all_custom_role_assignments.open_transaction.app_error":       {500},
+	"store.sql_channel.clear_all_custom_role_assignments.select.app_error":                 {500},
+	"store.sql_channel.clear_all_custom_role_assignments.update.app_error":                 {500},
+	"store.sql_channel.count_posts_since.app_error":                                        {500},
+	"store.sql_channel.get.existing.app_error":                                             {404},
+	"store.sql_channel.get.find.app_error":                                                 {500},
+	"store.sql_channel.get_all.app_error":                                                  {500},
+	"store.sql_channel.get_all_direct.app_error":                                           {500},
+	"store.sql_channel.get_by_scheme.app_error":                                            {500},
+	"store.sql_channel.get_channel_counts.get.app_error":                                   {500},
+	"store.sql_channel.get_channels_batch_for_indexing.get.app_error":                      {500},
+	"store.sql_channel.get_channels_by_ids.app_error":                                      {500},
+	"store.sql_channel.get_channels_by_ids.get.app_error":                                  {500},
+	"store.sql_channel.get_channels_by_ids.not_found.app_error":                            {404},
+	"store.sql_channel.get_for_post.app_error":                                             {500},
+	"store.sql_channel.get_member.app_error":                                               {500},
+	"store.sql_channel.get_member_count.app_error":                                         {500},
+	"store.sql_channel.get_member_for_post.app_error":                                      {500},
+	"store.sql_channel.get_members.app_error":                                              {500},
+	"store.sql_channel.get_members_by_ids.app_error":                                       {500},
+	"store.sql_channel.get_members_with_expired_roles.app_error":                           {500},
+	"store.sql_channel.get_pinnedpost_count.app_error":                                     {500},
+	"store.sql_channel.get_private_channels.get.app_error":                                 {500},
+	"store.sql_channel.get_public_channels.get.app_error":                                  {500},
+	"store.sql_channel.get_unread.app_error":                                               {404, 500},
+	"store.sql_channel.increment_mention_count.app_error":                                  {500},
+	"store.sql_channel.migrate_channel_members.commit_transaction.app_error":               {500},
+	"store.sql_channel.migrate_channel_members.open_transaction.app_error":                 {500},
+	"store.sql_channel.migrate_channel_members.select.app_error":                           {500},
+	"store.sql_channel.migrate_channel_members.update.app_error":                           {500},
+	"store.sql_channel.permanent_delete_members_by_user.app_error":                         {500},
+	"store.sql_channel.pinned_posts.app_error":                                             {500},
+	"store.sql_channel.remove_all_deactivated_members.app_error":                           {500},
+	"store.sql_channel.remove_member.app_error":                                            {500},
+	"store.sql_channel.reset_all_channel_schemes.app_error":                                {500},
+	"store.sql_channel.reset_all_channel_schemes.commit_transaction.app_error":             {500},
+	"store.sql_channel.reset_all_channel_schemes.open_transaction.app_error":               {500},
+	"store.sql_channel.save.archived_channel.app_error":                                    {400},
+	"store.sql_channel.save.direct_channel.app_error":                                      {400},
+	"store.sql_channel.save_channel.existing.app_error":                                    {400},
+	"store.sql_channel.save_channel.limit.app_error":                                       {400},
+	"store.sql_channel.save_direct_channel.not_direct.app_error":                           {400},
+	"store.sql_channel.save_member.commit_transaction.app_error":                           {500},
+	"store.sql_channel.save_member.exists.app_error":                                       {400},
+	"store.sql_channel.save_member.open_transaction.app_error":                             {500},
+	"store.sql_channel.search.app_error":                                                   {500},
+	"store.sql_channel.search_group_channels.app_error":                                    {500},
+	"store.sql_channel.sidebar_categories.app_error":                                       {400, 404, 500},
+	"store.sql_channel.sidebar_categories.commit_transaction.app_error":                    {500},
+	"store.sql_channel.sidebar_categories.delete_invalid.app_error":                        {400},
+	"store.sql_channel.sidebar_categories.open_transaction.app_error":                      {500},
+	"store.sql_channel.update_last_viewed_at.app_error":                                    {500},
+	"store.sql_channel.update_last_viewed_at_post.app_error":                               {500},
+	"store.sql_channel.update_member.app_error":                                            {500},
+	"store.sql_channel.user_belongs_to_channels.app_error":                                 {500},
+	"store.sql_command.get.missing.app_error":                                              {404},
+	"store.sql_command.save.get.app_error":                                                 {404},
+	"store.sql_command.update.missing.app_error":                                           {404},
+	"store.sql_command_webhooks.get.app_error":                                             {500},
+	"store.sql_command_webhooks.save.app_error":                                            {500},
+	"store.sql_command_webhooks.save.existing.app_error":                                   {400},
+	"store.sql_command_webhooks.try_use.app_error":                                         {500},
+	"store.sql_command_webhooks.try_use.invalid.app_error":                                 {400},
+	"store.sql_compliance.get.finding.app_error":                                           {404, 500},
+	"store.sql_compliance.message_export.app_error":                                        {500},
+	"store.sql_compliance.save.saving.app_error":                                           {500},
+	"store.sql_file_info.PermanentDeleteByUser.app_error":                                  {500},
+	"store.sql_file_info.attach_to_post.app_error":                                         {400, 500},
+	"store.sql_file_info.delete_for_post.app_error":                                        {500},
+	"store.sql_file_info.get.app_error":                                                    {404, 500},
+	"store.sql_file_info.get_by_path.app_error":                                            {500},
+	"store.sql_file_info.get_for_post.app_error":                                           {500},
+	"store.sql_file_info.get_for_user_id.app_error":                                        {500},
+	"store.sql_file_info.get_with_options.app_error":                                       {400, 500},
+	"store.sql_file_info.permanent_delete.app_error":                                       {500},
+	"store.sql_file_info.permanent_delete_batch.app_error":                                 {500},
+	"store.sql_file_info.save.app_error":                                                   {500},
+	"store.sql_file_info.set_sensitive.app_error":                                          {500},
+	"store.sql_file_info.update_enrichment.app_error":                                      {500},
+	"store.sql_group.app_error":                                                            {500},
+	"store.sql_group.group_syncable_already_deleted":                                       {400},
+	"store.sql_group.more_than_one_row_changed":                                            {500},
+	"store.sql_group.no_rows":                                                              {404, 500},
+	"store.sql_group.permanent_delete_members_by_user.app_error":                           {500},
+	"store.sql_group.unique_constraint":                                                    {500},
+	"store.sql_group.uniqueness_error":                                                     {400},
+	"store.sql_job.delete.app_error":                                                       {500},
+	"store.sql_job.get.app_error":                                                          {404, 500},
+	"store.sql_job.get_all.app_error":                                                      {500},
+	"store.sql_job.get_count_by_status_and_type.app_error":                                 {500},
+	"store.sql_job.get_newest_job_by_status_and_type.app_error":                            {500},
+	"store.sql_job.save.app_error":                                                         {500},
+	"store.sql_job.update.app_error":                                                       {500},
+	"store.sql_plugin_store.compare_and_set.mysql_select.app_error":                        {500},
+	"store.sql_plugin_store.compare_and_set.too_many_rows.app_error":                       {500},
+	"store.sql_plugin_store.delete.app_error":                                              {500},
+	"store.sql_plugin_store.get.app_error":                                                 {},
+	"store.sql_plugin_store.list.app_error":                                                {500},
+	"store.sql_plugin_store.save.app_error":                                                {400, 500},
+	"store.sql_post.analytics_posts_count.app_error":                                       {500},
+	"store.sql_post.analytics_posts_count_by_day.app_error":                                {500},
+	"store.sql_post.analytics_user_counts_posts_by_day.app_error":                          {500},
+	"store.sql_post.compliance_export.app_error":                                           {500},
+	"store.sql_post.delete.app_error":                                                      {500},
+	"store.sql_post.get.app_error":                                                         {400, 404, 500},
+	"store.sql_post.get_direct_posts.app_error":                                            {500},
+	"store.sql_post.get_flagged_posts.app_error":                                           {500},
+	"store.sql_post.get_oldest_entity_creation_time.app_error":                             {500},
+	"store.sql_post.get_parents_posts.app_error":                                           {500},
+	"store.sql_post.get_post_after_time.app_error":                                         {500},
+	"store.sql_post.get_post_id_around.app_error":                                          {500},
+	"store.sql_post.get_posts.app_error":                                                   {400, 500},
+	"store.sql_post.get_posts_around.get.app_error":                                        {500},
+	"store.sql_post.get_posts_around.get_parent.app_error":                                 {500},
+	"store.sql_post.get_posts_batch_for_indexing.get.app_error":                            {500},
+	"store.sql_post.get_posts_by_ids.app_error":                                            {500},
+	"store.sql_post.get_posts_created_att.app_error":                                       {500},
+	"store.sql_post.get_posts_delta.app_error":                                             {500},
+	"store.sql_post.get_posts_since.app_error":                                             {500},
+	"store.sql_post.get_root_posts.app_error":                                              {500},
+	"store.sql_post.overwrite.app_error":                                                   {500},
+	"store.sql_post.permanent_delete.app_error":                                            {500},
+	"store.sql_post.permanent_delete_all_comments_by_user.app_error":                       {500},
+	"store.sql_post.permanent_delete_batch.app_error":                                      {500},
+	"store.sql_post.permanent_delete_by_channel.app_error":                                 {500},
+	"store.sql_post.permanent_delete_by_user.app_error":                                    {500},
+	"store.sql_post.permanent_delete_by_user.too_many.app_error":                           {500},
+	"store.sql_post.populate_reply_count.app_error":                                        {500},
+	"store.sql_post.save.app_error":                                                        {500},
+	"store.sql_post.save.existing.app_error":                                               {400},
+	"store.sql_post.search.disabled":                                                       {501},
+	"store.sql_post.update.app_error":                                                      {500},
+	"store.sql_preference.cleanup_flags_batch.app_error":                                   {500},
+	"store.sql_preference.delete.app_error":                                                {500},
+	"store.sql_preference.get.app_error":                                                   {500},
+	"store.sql_preference.get_all.app_error":                                               {500},
+	"store.sql_preference.get_category.app_error":                                          {500},
+	"store.sql_preference.insert.exists.app_error":                                         {400},
+	"store.sql_preference.insert.save.app_error":                                           {500},
+	"store.sql_preference.permanent_delete_by_user.app_error":                              {500},
+	"store.sql_preference.save.commit_transaction.app_error":                               {500},
+	"store.sql_preference.save.missing_driver.app_error":                                   {501},
+	"store.sql_preference.save.open_transaction.app_error":                                 {500},
+	"store.sql_preference.save.updating.app_error":                                         {500},
+	"store.sql_preference.update.app_error":                                                {500},
+	"store.sql_role.delete.update.app_error":                                               {500},
+	"store.sql_role.get.app_error":                                                         {404, 500},
+	"store.sql_role.get_all.app_error":                                                     {404, 500},
+	"store.sql_role.get_by_name.app_error":                                                 {404, 500},
+	"store.sql_role.get_by_names.app_error":                                                {500},
+	"store.sql_role.permanent_delete_all.app_error":                                        {500},
+	"store.sql_role.save.insert.app_error":                                                 {500},
+	"store.sql_role.save.invalid_role.app_error":                                           {400},
+	"store.sql_role.save.open_transaction.app_error":                                       {500},
+	"store.sql_role.save.update.app_error":                                                 {500},
+	"store.sql_role.save_role.commit_transaction.app_error":                                {500},
+	"store.sql_status.get.app_error":                                                       {500},
+	"store.sql_status.get_total_active_users_count.app_error":                              {500},
+	"store.sql_status.reset_all.app_error":                                                 {500},
+	"store.sql_status.save.app_error":                                                      {500},
+	"store.sql_status.update.app_error":                                                    {500},
+	"store.sql_status.update_last_activity_at.app_error":                                   {500},
+	"store.sql_system.get.app_error":                                                       {500},
+	"store.sql_system.get_by_name.app_error":                                               {500},
+	"store.sql_system.is_database_read_only.app_error":                                     {500},
+	"store.sql_system.permanent_delete_by_name.app_error":                                  {500},
+	"store.sql_system.save.app_error":                                                      {500},
+	"store.sql_system.save.commit_transaction.app_error":                                   {500},
+	"store.sql_system.update.app_error":                                                    {500},
+	"store.sql_team.analytics_get_team_count_for_scheme.app_error":                         {500},
+	"store.sql_team.analytics_private_team_count.app_error":                                {500},
+	"store.sql_team.analytics_public_team_count.app_error":                                 {500},
+	"store.sql_team.analytics_team_count.app_error":                                        {500},
+	"store.sql_team.clear_all_custom_role_assignments.commit_transaction.app_error":        {500},
+	"store.sql_team.clear_all_custom_role_assignments.open_transaction.app_error":          {500},
+	"store.sql_team.clear_all_custom_role_assignments.select.app_error":                    {500},
+	"store.sql_team.clear_all_custom_role_assignments.update.app_error":                    {500},
+	"store.sql_team.get.find.app_error":                                                    {404},
+	"store.sql_team.get.finding.app_error":                                                 {500},
+	"store.sql_team.get_active_member_count.app_error":                                     {500},
+	"store.sql_team.get_all.app_error":                                                     {500},
+	"store.sql_team.get_all_private_team_listing.app_error":                                {500},
+	"store.sql_team.get_all_team_listing.app_error":                                        {500},
+	"store.sql_team.get_by_scheme.app_error":                                               {500},
+	"store.sql_team.get_directory_stats.app_error":                                         {500},
+	"store.sql_team.get_member_count.app_error":                                            {500},
+	"store.sql_team.get_members.app_error":                                                 {500},
+	"store.sql_team.get_members_by_ids.app_error":                                          {500},
+	"store.sql_team.get_members_by_team_ids.app_error":                                     {500},
+	"store.sql_team.get_unread.app_error":                                                  {500},
+	"store.sql_team.get_user_team_ids.app_error":                                           {500},
+	"store.sql_team.migrate_team_members.commit_transaction.app_error":                     {500},
+	"store.sql_team.migrate_team_members.open_transaction.app_error":                       {500},
+	"store.sql_team.migrate_team_members.select.app_error":                                 {500},
+	"store.sql_team.migrate_team_members.update.app_error":                                 {500},
+	"store.sql_team.permanent_delete.app_error":                                            {500},
+	"store.sql_team.remove_member.app_error":                                               {500},
+	"store.sql_team.reset_all_team_schemes.app_error":                                      {500},
+	"store.sql_team.save_member.save.app_error":                                            {500},
+	"store.sql_team.search_all_team.app_error":                                             {500},
+	"store.sql_team.search_open_team.app_error":                                            {500},
+	"store.sql_team.search_private_team.app_error":                                         {500},
+	"store.sql_team.update_last_team_icon_update.app_error":                                {500},
+	"store.sql_team.user_belongs_to_teams.app_error":                                       {500},
+	"store.sql_user.analytics_daily_active_users.app_error":                                {500},
+	"store.sql_user.analytics_get_inactive_users_count.app_error":                          {500},
+	"store.sql_user.analytics_get_system_admin_count.app_error":                            {500},
+	"store.sql_user.analytics_seat_counts_by_day.app_error":                                {500},
+	"store.sql_user.app_error":                                                             {500},
+	"store.sql_user.clear_all_custom_role_assignments.commit_transaction.app_error":        {500},
+	"store.sql_user.clear_all_custom_role_assignments.open_transaction.app_error":          {500},
+	"store.sql_user.clear_all_custom_role_assignments.select.app_error":                    {500},
+	"store.sql_user.clear_all_custom_role_assignments.update.app_error":                    {500},
+	"store.sql_user.count.app_error":                                                       {500},
+	"store.sql_user.demote_user_to_guest.channel_members_update.app_error":                 {500},
+	"store.sql_user.demote_user_to_guest.commit_transaction.app_error":                     {500},
+	"store.sql_user.demote_user_to_guest.open_transaction.app_error":                       {500},
+	"store.sql_user.demote_user_to_guest.team_members_update.app_error":                    {500},
+	"store.sql_user.demote_user_to_guest.user_update.app_error":                            {500},
+	"store.sql_user.get.app_error":                                                         {500},
+	"store.sql_user.get_by_auth.other.app_error":                                           {500},
+	"store.sql_user.get_by_username.app_error":                                             {500},
+	"store.sql_user.get_direct_reports.app_error":                                          {500},
+	"store.sql_user.get_for_login.app_error":                                               {400, 500},
+	"store.sql_user.get_for_login.multiple_users":                                          {500},
+	"store.sql_user.get_known_users.get_users.app_error":                                   {500},
+	"store.sql_user.get_new_users.app_error":                                               {500},
+	"store.sql_user.get_profile_by_group_channel_ids_for_user.app_error":                   {500},
+	"store.sql_user.get_profiles.app_error":                                                {500},
+	"store.sql_user.get_recently_active_users.app_error":                                   {500},
+	"store.sql_user.get_reporting_chain.app_error":                                         {500},
+	"store.sql_user.get_service_accounts.app_error":                                        {500},
+	"store.sql_user.get_sysadmin_profiles.app_error":                                       {500},
+	"store.sql_user.get_system_install_date.app_error":                                     {500},
+	"store.sql_user.get_total_users_count.app_error":                                       {500},
+	"store.sql_user.get_unread_count.app_error":                                            {500},
+	"store.sql_user.get_unread_count_for_channel.app_error":                                {500},
+	"store.sql_user.get_users_batch_for_indexing.get_channel_members.app_error":            {500},
+	"store.sql_user.get_users_batch_for_indexing.get_team_members.app_error":               {500},
+	"store.sql_user.get_users_batch_for_indexing.get_users.app_error":                      {500},
+	"store.sql_user.get_users_with_expired_roles.app_error":                                {500},
+	"store.sql_user.permanent_delete.app_error":                                            {500},
+	"store.sql_user.promote_guest.channel_members_update.app_error":                        {500},
+	"store.sql_user.promote_guest.commit_transaction.app_error":                            {500},
+	"store.sql_user.promote_guest.open_transaction.app_error":                              {500},
+	"store.sql_user.promote_guest.team_members_update.app_error":                           {500},
+	"store.sql_user.promote_guest.user_update.app_error":                                   {500},
+	"store.sql_user.save.app_error":                                                        {500},
+	"store.sql_user.save.email_exists.app_error":                                           {400},
+	"store.sql_user.save.existing.app_error":                                               {400},
+	"store.sql_user.save.member_count.app_error":                                           {500},
+	"store.sql_user.save.username_exists.app_error":                                        {400},
+	"store.sql_user.search.app_error":                                                      {500},
+	"store.sql_user.update.app_error":                                                      {500},
+	"store.sql_user.update.can_not_change_ldap.app_error":                                  {400},
+	"store.sql_user.update.email_taken.app_error":                                          {400},
+	"store.sql_user.update.find.app_error":                                                 {400},
+	"store.sql_user.update.finding.app_error":                                              {500},
+	"store.sql_user.update.updating.app_error":                                             {500},
+	"store.sql_user.update.username_taken.app_error":                                       {400},
+	"store.sql_user.update_active_for_multiple_users.getting_changed_users.app_error":      {500},
+	"store.sql_user.update_active_for_multiple_users.updating.app_error":                   {500},
+	"store.sql_user.update_auth_data.app_error":                                            {500},
+	"store.sql_user.update_auth_data.email_exists.app_error":                               {400},
+	"store.sql_user.update_failed_pwd_attempts.app_error":                                  {500},
+	"store.sql_user.update_last_login.app_error":                                           {500},
+	"store.sql_user.update_last_picture_update.app_error":                                  {500},
+	"store.sql_user.update_mfa_active.app_error":                                           {500},
+	"store.sql_user.update_mfa_secret.app_error":                                           {500},
+	"store.sql_user.update_password.app_error":                                             {500},
+	"store.sql_user.update_update.app_error":                                               {500},
+	"store.sql_user.verify_email.app_error":                                                {500},
+	"store.sql_user_access_token.delete.app_error":                                         {500},
+	"store.sql_user_access_token.get.app_error":                                            {404, 500},
+	"store.sql_user_access_token.get_all.app_error":                                        {500},
+	"store.sql_user_access_token.get_by_token.app_error":                                   {404, 500},
+	"store.sql_user_access_token.get_by_user.app_error":                                    {500},
+	"store.sql_user_access_token.save.app_error":                                           {500},
+	"store.sql_user_access_token.search.app_error":                                         {500},
+	"store.sql_user_access_token.update_token_disable.app_error":                           {500},
+	"store.sql_user_access_token.update_token_enable.app_error":                            {500},
+	"store.sql_webhooks.analytics_incoming_count.app_error":                                {500},
+	"store.sql_webhooks.analytics_outgoing_count.app_error":                                {500},
+	"store.sql_webhooks.delete_incoming.app_error":                                         {500},
+	"store.sql_webhooks.delete_outgoing.app_error":                                         {500},
+	"store.sql_webhooks.get_incoming.app_error":                                            {404, 500},
+	"store.sql_webhooks.get_incoming_by_channel.app_error":                                 {500},
+	"store.sql_webhooks.get_incoming_by_user.app_error":                                    {500},
+	"store.sql_webhooks.get_outgoing.app_error":                                            {500},
+	"store.sql_webhooks.get_outgoing_by_channel.app_error":                                 {500},
+	"store.sql_webhooks.get_outgoing_by_team.app_error":                                    {500},
+	"store.sql_webhooks.permanent_delete_incoming_by_channel.app_error":                    {500},
+	"store.sql_webhooks.permanent_delete_incoming_by_user.app_error":                       {500},
+	"store.sql_webhooks.permanent_delete_outgoing_by_channel.app_error":                    {500},
+	"store.sql_webhooks.permanent_delete_outgoing_by_user.app_error":                       {500},
+	"store.sql_webhooks.save_incoming.app_error":                                           {500},
+	"store.sql_webhooks.save_incoming.existing.app_error":                                  {400},
+	"store.sql_webhooks.save_outgoing.app_error":                                           {500},
+	"store.sql_webhooks.save_outgoing.override.app_error":                                  {400},
+	"store.sql_webhooks.update_incoming.app_error":                                         {500},
+	"store.sql_webhooks.update_outgoing.app_error":                                         {500},
+	"store.update_error":                                                                   {500},
+	"utils.file.list_directory.local.app_error":                                            {500},
+	"utils.file.list_directory.s3.app_error":                                               {500},
+	"utils.file.remove_directory.local.app_error":                                          {500},
+	"utils.file.remove_directory.s3.app_error":                                             {500},
+	"utils.file.remove_file.local.app_error":                                               {500},
+	"utils.file.remove_file.s3.app_error":                                                  {500},
+	"utils.mail.connect_smtp.helo.app_error":                                               {500},
+	"utils.mail.connect_smtp.open.app_error":                                               {500},
+	"utils.mail.connect_smtp.open_tls.app_error":                                           {500},
+	"utils.mail.new_client.auth.app_error":                                                 {500},
+	"utils.mail.sendMail.attachments.write_error":                                          {500},
+	"utils.mail.send_mail.close.app_error":                                                 {500},
+	"utils.mail.send_mail.from_address.app_error":                                          {500},
+	"utils.mail.send_mail.msg.app_error":                                                   {500},
+	"utils.mail.send_mail.msg_data.app_error":                                              {500},
+	"utils.mail.send_mail.to_address.app_error":                                            {500},
+	"web.command_webhook.command.app_error":                                                {400},
+	"web.command_webhook.invalid.app_error":                                                {},
+	"web.command_webhook.parse.app_error":                                                  {400},
+	"web.get_access_token.internal_saving.app_error":                                       {500},
+	"web.incoming_webhook.channel.app_error":                                               {404, 500},
+	"web.incoming_webhook.channel_locked.app_error":                                        {403},
+	"web.incoming_webhook.disabled.app_error":                                              {501},
+	"web.incoming_webhook.invalid.app_error":                                               {400},
+	"web.incoming_webhook.parse.app_error":                                                 {400},
+	"web.incoming_webhook.permissions.app_error":                                           {403},
+	"web.incoming_webhook.split_props_length.app_error":                                    {400},
+	"web.incoming_webhook.text.app_error":                                                  {400},
+	"web.incoming_webhook.user.app_error":                                                  {400, 403},
+}
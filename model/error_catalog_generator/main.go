@@ -0,0 +1,178 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// statusCodes maps the names of the net/http status constants to their values, since the
+// generator only looks at the syntax of the sources.
+var statusCodes = map[string]int{}
+
+func init() {
+	for code := 100; code < 600; code++ {
+		if text := http.StatusText(code); text != "" {
+			statusCodes["Status"+strings.NewReplacer(" ", "", "-", "", "'", "").Replace(text)] = code
+		}
+	}
+
+	// The names of these constants don't follow their status text.
+	statusCodes["StatusNonAuthoritativeInfo"] = http.StatusNonAuthoritativeInfo
+	statusCodes["StatusTeapot"] = http.StatusTeapot
+}
+
+func main() {
+	root := flag.String("root", "..", "the root directory of the sources to scan")
+	out := flag.String("out", "error_catalog_generated.go", "the file to write the catalog to")
+	flag.Parse()
+
+	catalog, err := scan(*root)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	code, err := generate(catalog)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(*out, code, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// scan collects the ids of the errors created with model.NewAppError in the non test sources
+// under root, along with the HTTP status codes they are created with.
+func scan(root string) (map[string]map[int]bool, error) {
+	catalog := map[string]map[int]bool{}
+
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			name := info.Name()
+			if path != root && (strings.HasPrefix(name, ".") || name == "vendor" || name == "testdata" || name == "node_modules") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+		if err != nil {
+			return err
+		}
+
+		ast.Inspect(file, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok || !isNewAppError(call.Fun) || len(call.Args) != 5 {
+				return true
+			}
+
+			lit, ok := call.Args[1].(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				return true
+			}
+
+			id, err := strconv.Unquote(lit.Value)
+			if err != nil || id == "" {
+				return true
+			}
+
+			if catalog[id] == nil {
+				catalog[id] = map[int]bool{}
+			}
+			if code, ok := statusCode(call.Args[4]); ok {
+				catalog[id][code] = true
+			}
+
+			return true
+		})
+
+		return nil
+	})
+
+	return catalog, err
+}
+
+func isNewAppError(fun ast.Expr) bool {
+	switch f := fun.(type) {
+	case *ast.Ident:
+		return f.Name == "NewAppError"
+	case *ast.SelectorExpr:
+		pkg, ok := f.X.(*ast.Ident)
+		return ok && pkg.Name == "model" && f.Sel.Name == "NewAppError"
+	}
+	return false
+}
+
+// statusCode returns the HTTP status code of the expression when it is a net/http constant or an
+// integer literal, leaving out the ones only known at runtime.
+func statusCode(expr ast.Expr) (int, bool) {
+	switch e := expr.(type) {
+	case *ast.SelectorExpr:
+		pkg, ok := e.X.(*ast.Ident)
+		if !ok || pkg.Name != "http" {
+			return 0, false
+		}
+		code, ok := statusCodes[e.Sel.Name]
+		return code, ok
+	case *ast.BasicLit:
+		if e.Kind != token.INT {
+			return 0, false
+		}
+		code, err := strconv.Atoi(e.Value)
+		return code, err == nil
+	}
+	return 0, false
+}
+
+func generate(catalog map[string]map[int]bool) ([]byte, error) {
+	ids := make([]string, 0, len(catalog))
+	for id := range catalog {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var buf bytes.Buffer
+	buf.WriteString("// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.\n// See LICENSE.txt for license information.\n\n")
+	buf.WriteString("// Code generated by \"make error-catalog\"\n// DO NOT EDIT\n\npackage model\n\n")
+	buf.WriteString("var errorCatalog = map[string][]int{\n")
+	for _, id := range ids {
+		codes := make([]int, 0, len(catalog[id]))
+		for code := range catalog[id] {
+			codes = append(codes, code)
+		}
+		sort.Ints(codes)
+
+		values := make([]string, 0, len(codes))
+		for _, code := range codes {
+			values = append(values, strconv.Itoa(code))
+		}
+		fmt.Fprintf(&buf, "\t%q: {%s},\n", id, strings.Join(values, ", "))
+	}
+	buf.WriteString("}\n")
+
+	return format.Source(buf.Bytes())
+}
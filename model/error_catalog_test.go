@@ -0,0 +1,45 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetErrorCatalog(t *testing.T) {
+	entries := GetErrorCatalog()
+	require.NotEmpty(t, entries)
+
+	assert.True(t, sort.SliceIsSorted(entries, func(i, j int) bool {
+		return entries[i].Id < entries[j].Id
+	}))
+
+	var found *ErrorCatalogEntry
+	for _, entry := range entries {
+		if entry.Id == "model.config.is_valid.max_users.app_error" {
+			found = entry
+		}
+	}
+	require.NotNil(t, found)
+	assert.Equal(t, []int{http.StatusBadRequest}, found.StatusCodes)
+
+	// The entries are copies of the catalog.
+	found.StatusCodes[0] = http.StatusTeapot
+	assert.Equal(t, []int{http.StatusBadRequest}, errorCatalog[found.Id])
+}
+
+func TestErrorCatalogEntryListJson(t *testing.T) {
+	entries := []*ErrorCatalogEntry{
+		{Id: "api.context.404.app_error", StatusCodes: []int{http.StatusNotFound}, Message: "Sorry, we could not find the page."},
+		{Id: "store.sql_post.save.app_error", StatusCodes: []int{http.StatusInternalServerError}},
+	}
+
+	require.Equal(t, entries, ErrorCatalogEntryListFromJson(strings.NewReader(ErrorCatalogEntryListToJson(entries))))
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+const (
+	EVENT_STREAM_SINK_WEBHOOK = "webhook"
+	EVENT_STREAM_SINK_KAFKA   = "kafka"
+
+	EVENT_STREAM_POST_CREATED           = "post_created"
+	EVENT_STREAM_POST_EDITED            = "post_edited"
+	EVENT_STREAM_POST_DELETED           = "post_deleted"
+	EVENT_STREAM_CHANNEL_MEMBER_ADDED   = "channel_member_added"
+	EVENT_STREAM_CHANNEL_MEMBER_REMOVED = "channel_member_removed"
+	EVENT_STREAM_TEAM_MEMBER_ADDED      = "team_member_added"
+	EVENT_STREAM_TEAM_MEMBER_REMOVED    = "team_member_removed"
+	EVENT_STREAM_USER_UPDATED           = "user_updated"
+
+	// EVENT_STREAM_SIGNATURE_HEADER holds the hex encoded HMAC-SHA256 of the body posted to the
+	// firehose, keyed with the webhook secret and prefixed with "sha256=".
+	EVENT_STREAM_SIGNATURE_HEADER = "X-Mattermost-Signature"
+	EVENT_STREAM_SIGNATURE_PREFIX = "sha256="
+)
+
+var EventStreamEventTypes = []string{
+	EVENT_STREAM_POST_CREATED,
+	EVENT_STREAM_POST_EDITED,
+	EVENT_STREAM_POST_DELETED,
+	EVENT_STREAM_CHANNEL_MEMBER_ADDED,
+	EVENT_STREAM_CHANNEL_MEMBER_REMOVED,
+	EVENT_STREAM_TEAM_MEMBER_ADDED,
+	EVENT_STREAM_TEAM_MEMBER_REMOVED,
+	EVENT_STREAM_USER_UPDATED,
+}
+
+// EventStreamEvent is a normalized change of an entity, published to the event stream. Events are
+// kept in an outbox until delivered, so that they are published at least once: consumers should
+// expect duplicates and deduplicate on the id.
+type EventStreamEvent struct {
+	Id        string `json:"id"`
+	Type      string `json:"type"`
+	CreateAt  int64  `json:"create_at"`
+	TeamId    string `json:"team_id,omitempty"`
+	ChannelId string `json:"channel_id,omitempty"`
+	UserId    string `json:"user_id,omitempty"`
+	// Data is the JSON of the changed entity.
+	Data string `json:"-"`
+	// Attempts counts the failed deliveries of the event, and NextAttemptAt is when the next one
+	// may happen.
+	Attempts      int   `json:"-"`
+	NextAttemptAt int64 `json:"-"`
+}
+
+// eventStreamEventFields are the fields of an event without its methods, so that marshalling them
+// doesn't recurse into the methods of the event.
+type eventStreamEventFields EventStreamEvent
+
+// eventStreamEventJson is the JSON of an event, holding its data as a nested document rather than
+// a string.
+type eventStreamEventJson struct {
+	eventStreamEventFields
+	Data json.RawMessage `json:"data"`
+}
+
+func IsValidEventStreamEventType(eventType string) bool {
+	for _, t := range EventStreamEventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func (o *EventStreamEvent) PreSave() {
+	if o.Id == "" {
+		o.Id = NewId()
+	}
+
+	if o.CreateAt == 0 {
+		o.CreateAt = GetMillis()
+	}
+
+	if o.NextAttemptAt == 0 {
+		o.NextAttemptAt = o.CreateAt
+	}
+}
+
+func (o *EventStreamEvent) IsValid() *AppError {
+	if !IsValidId(o.Id) {
+		return NewAppError("EventStreamEvent.IsValid", "model.event_stream_event.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidEventStreamEventType(o.Type) {
+		return NewAppError("EventStreamEvent.IsValid", "model.event_stream_event.is_valid.type.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("EventStreamEvent.IsValid", "model.event_stream_event.is_valid.create_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if !json.Valid([]byte(o.Data)) {
+		return NewAppError("EventStreamEvent.IsValid", "model.event_stream_event.is_valid.data.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (o *EventStreamEvent) MarshalJSON() ([]byte, error) {
+	data := json.RawMessage(o.Data)
+	if o.Data == "" {
+		data = json.RawMessage("null")
+	}
+	return json.Marshal(eventStreamEventJson{eventStreamEventFields: eventStreamEventFields(*o), Data: data})
+}
+
+func (o *EventStreamEvent) UnmarshalJSON(data []byte) error {
+	var decoded eventStreamEventJson
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	*o = EventStreamEvent(decoded.eventStreamEventFields)
+	o.Data = string(decoded.Data)
+	return nil
+}
+
+func (o *EventStreamEvent) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func EventStreamEventListToJson(l []*EventStreamEvent) string {
+	b, _ := json.Marshal(l)
+	return string(b)
+}
+
+func EventStreamEventListFromJson(data io.Reader) []*EventStreamEvent {
+	var o []*EventStreamEvent
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
+
+// SignEventStreamPayload returns the value of the signature header of a body posted to the firehose.
+func SignEventStreamPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return EVENT_STREAM_SIGNATURE_PREFIX + hex.EncodeToString(mac.Sum(nil))
+}
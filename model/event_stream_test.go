@@ -0,0 +1,69 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventStreamEventIsValid(t *testing.T) {
+	event := &EventStreamEvent{
+		Type: EVENT_STREAM_POST_CREATED,
+		Data: `{"id":"post"}`,
+	}
+	event.PreSave()
+	require.Nil(t, event.IsValid())
+	assert.Equal(t, event.CreateAt, event.NextAttemptAt)
+
+	event.Type = "unknown"
+	require.NotNil(t, event.IsValid())
+	event.Type = EVENT_STREAM_USER_UPDATED
+
+	event.Data = `{"id":`
+	require.NotNil(t, event.IsValid())
+	event.Data = `{}`
+
+	event.Id = "garbage"
+	require.NotNil(t, event.IsValid())
+}
+
+func TestEventStreamEventJson(t *testing.T) {
+	event := &EventStreamEvent{
+		Id:            NewId(),
+		Type:          EVENT_STREAM_POST_EDITED,
+		CreateAt:      1234,
+		ChannelId:     NewId(),
+		Data:          `{"message":"edited"}`,
+		Attempts:      3,
+		NextAttemptAt: 5678,
+	}
+
+	json := event.ToJson()
+	assert.Contains(t, json, `"data":{"message":"edited"}`)
+	assert.NotContains(t, json, "team_id")
+	assert.NotContains(t, json, "attempts")
+
+	events := EventStreamEventListFromJson(strings.NewReader(EventStreamEventListToJson([]*EventStreamEvent{event})))
+	require.Len(t, events, 1)
+	assert.Equal(t, event.Id, events[0].Id)
+	assert.Equal(t, event.Type, events[0].Type)
+	assert.Equal(t, event.ChannelId, events[0].ChannelId)
+	assert.Equal(t, event.Data, events[0].Data)
+	assert.Zero(t, events[0].Attempts)
+
+	event.Data = ""
+	assert.Contains(t, event.ToJson(), `"data":null`)
+}
+
+func TestSignEventStreamPayload(t *testing.T) {
+	signature := SignEventStreamPayload("secret", []byte("body"))
+	assert.True(t, strings.HasPrefix(signature, EVENT_STREAM_SIGNATURE_PREFIX))
+	assert.Equal(t, signature, SignEventStreamPayload("secret", []byte("body")))
+	assert.NotEqual(t, signature, SignEventStreamPayload("other", []byte("body")))
+	assert.NotEqual(t, signature, SignEventStreamPayload("secret", []byte("other")))
+}
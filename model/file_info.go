@@ -53,6 +53,13 @@ type FileInfo struct {
 	Width           int    `json:"width,omitempty"`
 	Height          int    `json:"height,omitempty"`
 	HasPreviewImage bool   `json:"has_preview_image,omitempty"`
+	Sensitive       bool   `json:"sensitive,omitempty"`
+
+	// The fields below are filled in asynchronously by the file enrichment job.
+	Hash       string    `json:"hash,omitempty"`
+	PageCount  int       `json:"page_count,omitempty"`
+	Metadata   StringMap `json:"metadata,omitempty"`
+	EnrichedAt int64     `json:"enriched_at,omitempty"`
 }
 
 func (fi *FileInfo) ToJson() string {
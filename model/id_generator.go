@@ -0,0 +1,80 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"encoding/binary"
+	"sync/atomic"
+	"time"
+)
+
+// sortableEncoding is in ascending order, so that the encoded ids sort like the bytes they encode.
+var sortableEncoding = base32.NewEncoding("0123456789abcdefghjkmnpqrstvwxyz").WithPadding(base32.NoPadding)
+
+// IdGenerator generates the ids of new entities.
+type IdGenerator func() string
+
+var entityIdGenerator atomic.Value
+
+// SetEntityIdGenerator sets the generator of the ids of new posts and jobs, the entities created
+// frequently enough for the order of their ids to matter. A nil generator restores NewId.
+func SetEntityIdGenerator(generator IdGenerator) {
+	if generator == nil {
+		generator = NewId
+	}
+	entityIdGenerator.Store(generator)
+}
+
+// NewEntityId generates the id of a new post or job with the generator set by
+// SetEntityIdGenerator, NewId by default.
+func NewEntityId() string {
+	if generator, ok := entityIdGenerator.Load().(IdGenerator); ok {
+		return generator()
+	}
+	return NewId()
+}
+
+// NewSortableId is a globally unique identifier that sorts by creation time, to the millisecond.
+// Like NewId, it is a lowercase [a-z0-9] string 26 characters long, and is accepted by IsValidId.
+// It encodes 48 bits of timestamp in milliseconds followed by 80 random bits, in the manner of a
+// ULID, so that inserting new rows keyed by such ids appends to the primary key index.
+func NewSortableId() string {
+	return newSortableId(GetMillis())
+}
+
+func newSortableId(millis int64) string {
+	var b [16]byte
+
+	var timestamp [8]byte
+	binary.BigEndian.PutUint64(timestamp[:], uint64(millis))
+	copy(b[:6], timestamp[2:])
+
+	if _, err := rand.Read(b[6:]); err != nil {
+		panic(err)
+	}
+
+	return sortableEncoding.EncodeToString(b[:])
+}
+
+// SortableIdTime returns the creation time encoded in an id generated by NewSortableId. The ids
+// generated by NewId have no such time, but can't be told apart reliably: the result is only
+// meaningful for ids known to be sortable.
+func SortableIdTime(id string) (time.Time, bool) {
+	if !IsValidId(id) {
+		return time.Time{}, false
+	}
+
+	b, err := sortableEncoding.DecodeString(id)
+	if err != nil || len(b) != 16 {
+		return time.Time{}, false
+	}
+
+	var timestamp [8]byte
+	copy(timestamp[2:], b[:6])
+	millis := int64(binary.BigEndian.Uint64(timestamp[:]))
+
+	return time.Unix(0, millis*int64(time.Millisecond)), true
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSortableId(t *testing.T) {
+	t.Run("is a valid id", func(t *testing.T) {
+		for i := 0; i < 1000; i++ {
+			id := NewSortableId()
+			require.Len(t, id, 26)
+			require.True(t, IsValidId(id), id)
+		}
+	})
+
+	t.Run("is unique", func(t *testing.T) {
+		ids := make(map[string]bool)
+		for i := 0; i < 1000; i++ {
+			id := NewSortableId()
+			require.False(t, ids[id])
+			ids[id] = true
+		}
+	})
+
+	t.Run("sorts by creation time", func(t *testing.T) {
+		start := GetMillis()
+
+		ids := make([]string, 100)
+		for i := range ids {
+			ids[i] = newSortableId(start + int64(i))
+		}
+
+		sorted := make([]string, len(ids))
+		copy(sorted, ids)
+		sort.Strings(sorted)
+		assert.Equal(t, ids, sorted)
+	})
+
+	t.Run("encodes its creation time", func(t *testing.T) {
+		millis := GetMillis()
+
+		createdAt, ok := SortableIdTime(newSortableId(millis))
+		require.True(t, ok)
+		assert.Equal(t, millis, createdAt.UnixNano()/int64(time.Millisecond))
+
+		_, ok = SortableIdTime("junk")
+		assert.False(t, ok)
+	})
+
+	t.Run("is accepted for posts and jobs", func(t *testing.T) {
+		post := &Post{
+			Id:        NewSortableId(),
+			ChannelId: NewId(),
+			UserId:    NewId(),
+			CreateAt:  GetMillis(),
+			UpdateAt:  GetMillis(),
+		}
+		require.Nil(t, post.IsValid(POST_MESSAGE_MAX_RUNES_V2))
+
+		job := &Job{
+			Id:       NewSortableId(),
+			Type:     JOB_TYPE_DATA_RETENTION,
+			CreateAt: GetMillis(),
+			Status:   JOB_STATUS_PENDING,
+		}
+		require.Nil(t, job.IsValid())
+	})
+}
+
+func TestNewEntityId(t *testing.T) {
+	defer SetEntityIdGenerator(nil)
+
+	SetEntityIdGenerator(func() string { return "generated" })
+	assert.Equal(t, "generated", NewEntityId())
+
+	post := &Post{}
+	post.PreSave()
+	assert.Equal(t, "generated", post.Id)
+
+	SetEntityIdGenerator(nil)
+	assert.True(t, IsValidId(NewEntityId()))
+	assert.NotEqual(t, "generated", NewEntityId())
+}
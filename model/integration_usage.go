@@ -0,0 +1,184 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"sort"
+)
+
+const (
+	INTEGRATION_USAGE_TYPE_BOT              = "bot"
+	INTEGRATION_USAGE_TYPE_INCOMING_WEBHOOK = "incoming_webhook"
+	INTEGRATION_USAGE_TYPE_OUTGOING_WEBHOOK = "outgoing_webhook"
+	INTEGRATION_USAGE_TYPE_PRESENCE_WEBHOOK = "presence_webhook"
+	INTEGRATION_USAGE_TYPE_COMMAND          = "command"
+
+	INTEGRATION_USAGE_DAY_MILLIS   = 24 * 60 * 60 * 1000
+	INTEGRATION_USAGE_DEFAULT_DAYS = 30
+	INTEGRATION_USAGE_MAX_DAYS     = 365
+)
+
+// IntegrationUsage counts the activity of an integration during a day, the day being given as the
+// UTC midnight that starts it.
+type IntegrationUsage struct {
+	IntegrationId string `json:"integration_id"`
+	Type          string `json:"type"`
+	Day           int64  `json:"day"`
+	// Posts counts the posts of bots and incoming webhooks, Executions the executions of slash
+	// commands and Deliveries the payloads delivered by outgoing and presence webhooks.
+	Posts      int64 `json:"posts"`
+	Executions int64 `json:"executions"`
+	Deliveries int64 `json:"deliveries"`
+	// Failures counts the posts, executions and deliveries that failed.
+	Failures int64 `json:"failures"`
+}
+
+// IntegrationUsageDay returns the UTC midnight starting the day of the given time.
+func IntegrationUsageDay(millis int64) int64 {
+	return millis - millis%INTEGRATION_USAGE_DAY_MILLIS
+}
+
+// NewIntegrationUsage returns the usage of the integration for a single post, execution or
+// delivery, depending on its type, at the given time.
+func NewIntegrationUsage(integrationId, integrationType string, succeeded bool, millis int64) *IntegrationUsage {
+	usage := &IntegrationUsage{
+		IntegrationId: integrationId,
+		Type:          integrationType,
+		Day:           IntegrationUsageDay(millis),
+	}
+
+	switch {
+	case !succeeded:
+		usage.Failures = 1
+	case integrationType == INTEGRATION_USAGE_TYPE_COMMAND:
+		usage.Executions = 1
+	case integrationType == INTEGRATION_USAGE_TYPE_OUTGOING_WEBHOOK || integrationType == INTEGRATION_USAGE_TYPE_PRESENCE_WEBHOOK:
+		usage.Deliveries = 1
+	default:
+		usage.Posts = 1
+	}
+
+	return usage
+}
+
+func (o *IntegrationUsage) IsValid() *AppError {
+	if !IsValidId(o.IntegrationId) {
+		return NewAppError("IntegrationUsage.IsValid", "model.integration_usage.is_valid.integration_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	switch o.Type {
+	case INTEGRATION_USAGE_TYPE_BOT, INTEGRATION_USAGE_TYPE_INCOMING_WEBHOOK, INTEGRATION_USAGE_TYPE_OUTGOING_WEBHOOK, INTEGRATION_USAGE_TYPE_PRESENCE_WEBHOOK, INTEGRATION_USAGE_TYPE_COMMAND:
+	default:
+		return NewAppError("IntegrationUsage.IsValid", "model.integration_usage.is_valid.type.app_error", nil, "integration_id="+o.IntegrationId, http.StatusBadRequest)
+	}
+
+	if o.Day <= 0 || o.Day%INTEGRATION_USAGE_DAY_MILLIS != 0 {
+		return NewAppError("IntegrationUsage.IsValid", "model.integration_usage.is_valid.day.app_error", nil, "integration_id="+o.IntegrationId, http.StatusBadRequest)
+	}
+
+	if o.Posts < 0 || o.Executions < 0 || o.Deliveries < 0 || o.Failures < 0 {
+		return NewAppError("IntegrationUsage.IsValid", "model.integration_usage.is_valid.counts.app_error", nil, "integration_id="+o.IntegrationId, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// Add adds the counts of other to the usage.
+func (o *IntegrationUsage) Add(other *IntegrationUsage) {
+	o.Posts += other.Posts
+	o.Executions += other.Executions
+	o.Deliveries += other.Deliveries
+	o.Failures += other.Failures
+}
+
+// Total returns the number of posts, executions and deliveries attempted, failed ones included.
+func (o *IntegrationUsage) Total() int64 {
+	return o.Posts + o.Executions + o.Deliveries + o.Failures
+}
+
+// IntegrationUsageSummary is the activity of an integration over the period of a usage report.
+type IntegrationUsageSummary struct {
+	IntegrationId string  `json:"integration_id"`
+	Type          string  `json:"type"`
+	DisplayName   string  `json:"display_name"`
+	CreatorId     string  `json:"creator_id"`
+	CreateAt      int64   `json:"create_at"`
+	Posts         int64   `json:"posts"`
+	Executions    int64   `json:"executions"`
+	Deliveries    int64   `json:"deliveries"`
+	Failures      int64   `json:"failures"`
+	ErrorRate     float64 `json:"error_rate"`
+	// LastActiveDay is the last day of the period the integration was used, 0 if it wasn't.
+	LastActiveDay int64 `json:"last_active_day"`
+	// Dormant flags the integrations that existed for the whole period without being used, as
+	// candidates for a cleanup.
+	Dormant bool `json:"dormant"`
+}
+
+// IntegrationsUsageReport is the daily activity of the integrations since StartDay, along with
+// the summary of the activity of every existing integration over that period.
+type IntegrationsUsageReport struct {
+	StartDay     int64                      `json:"start_day"`
+	Daily        []*IntegrationUsage        `json:"daily"`
+	Integrations []*IntegrationUsageSummary `json:"integrations"`
+}
+
+// NewIntegrationsUsageReport sums the daily usage into the summaries of the integrations, computes
+// their error rates and flags the dormant ones. The usage of integrations without a summary, such
+// as deleted ones, is only part of the daily activity.
+func NewIntegrationsUsageReport(startDay int64, daily []*IntegrationUsage, integrations []*IntegrationUsageSummary) *IntegrationsUsageReport {
+	summaries := make(map[string]*IntegrationUsageSummary, len(integrations))
+	for _, summary := range integrations {
+		summaries[summary.IntegrationId] = summary
+	}
+
+	for _, usage := range daily {
+		summary, ok := summaries[usage.IntegrationId]
+		if !ok {
+			continue
+		}
+
+		summary.Posts += usage.Posts
+		summary.Executions += usage.Executions
+		summary.Deliveries += usage.Deliveries
+		summary.Failures += usage.Failures
+		if usage.Total() > 0 && usage.Day > summary.LastActiveDay {
+			summary.LastActiveDay = usage.Day
+		}
+	}
+
+	for _, summary := range integrations {
+		if total := summary.Posts + summary.Executions + summary.Deliveries + summary.Failures; total > 0 {
+			summary.ErrorRate = float64(summary.Failures) / float64(total)
+		}
+		summary.Dormant = summary.LastActiveDay == 0 && summary.CreateAt < startDay
+	}
+
+	sort.Slice(integrations, func(i, j int) bool {
+		if integrations[i].Type != integrations[j].Type {
+			return integrations[i].Type < integrations[j].Type
+		}
+		return integrations[i].DisplayName < integrations[j].DisplayName
+	})
+
+	return &IntegrationsUsageReport{
+		StartDay:     startDay,
+		Daily:        daily,
+		Integrations: integrations,
+	}
+}
+
+func (o *IntegrationsUsageReport) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func IntegrationsUsageReportFromJson(data io.Reader) *IntegrationsUsageReport {
+	var o *IntegrationsUsageReport
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIntegrationUsage(t *testing.T) {
+	millis := int64(10*INTEGRATION_USAGE_DAY_MILLIS + 1234)
+
+	usage := NewIntegrationUsage(NewId(), INTEGRATION_USAGE_TYPE_BOT, true, millis)
+	assert.Equal(t, int64(10*INTEGRATION_USAGE_DAY_MILLIS), usage.Day)
+	assert.Equal(t, int64(1), usage.Posts)
+	assert.Nil(t, usage.IsValid())
+
+	usage = NewIntegrationUsage(NewId(), INTEGRATION_USAGE_TYPE_COMMAND, true, millis)
+	assert.Equal(t, int64(1), usage.Executions)
+
+	usage = NewIntegrationUsage(NewId(), INTEGRATION_USAGE_TYPE_PRESENCE_WEBHOOK, true, millis)
+	assert.Equal(t, int64(1), usage.Deliveries)
+
+	usage = NewIntegrationUsage(NewId(), INTEGRATION_USAGE_TYPE_OUTGOING_WEBHOOK, false, millis)
+	assert.Equal(t, int64(0), usage.Deliveries)
+	assert.Equal(t, int64(1), usage.Failures)
+	assert.Equal(t, int64(1), usage.Total())
+}
+
+func TestIntegrationUsageIsValid(t *testing.T) {
+	valid := func() *IntegrationUsage {
+		return NewIntegrationUsage(NewId(), INTEGRATION_USAGE_TYPE_INCOMING_WEBHOOK, true, GetMillis())
+	}
+
+	testCases := []struct {
+		Description string
+		Modify      func(o *IntegrationUsage)
+		Valid       bool
+	}{
+		{"valid", func(o *IntegrationUsage) {}, true},
+		{"invalid integration id", func(o *IntegrationUsage) { o.IntegrationId = "invalid" }, false},
+		{"invalid type", func(o *IntegrationUsage) { o.Type = "invalid" }, false},
+		{"missing day", func(o *IntegrationUsage) { o.Day = 0 }, false},
+		{"day not at midnight", func(o *IntegrationUsage) { o.Day++ }, false},
+		{"negative count", func(o *IntegrationUsage) { o.Failures = -1 }, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			o := valid()
+			tc.Modify(o)
+
+			if tc.Valid {
+				assert.Nil(t, o.IsValid())
+			} else {
+				assert.NotNil(t, o.IsValid())
+			}
+		})
+	}
+}
+
+func TestNewIntegrationsUsageReport(t *testing.T) {
+	startDay := int64(10 * INTEGRATION_USAGE_DAY_MILLIS)
+
+	active := &IntegrationUsageSummary{IntegrationId: NewId(), Type: INTEGRATION_USAGE_TYPE_INCOMING_WEBHOOK, DisplayName: "active", CreateAt: 1}
+	dormant := &IntegrationUsageSummary{IntegrationId: NewId(), Type: INTEGRATION_USAGE_TYPE_COMMAND, DisplayName: "dormant", CreateAt: 1}
+	recent := &IntegrationUsageSummary{IntegrationId: NewId(), Type: INTEGRATION_USAGE_TYPE_BOT, DisplayName: "recent", CreateAt: startDay + 1}
+	deletedId := NewId()
+
+	daily := []*IntegrationUsage{
+		{IntegrationId: active.IntegrationId, Type: active.Type, Day: startDay, Posts: 3, Failures: 1},
+		{IntegrationId: active.IntegrationId, Type: active.Type, Day: startDay + INTEGRATION_USAGE_DAY_MILLIS, Posts: 4},
+		{IntegrationId: deletedId, Type: INTEGRATION_USAGE_TYPE_BOT, Day: startDay, Posts: 10},
+	}
+
+	report := NewIntegrationsUsageReport(startDay, daily, []*IntegrationUsageSummary{active, dormant, recent})
+	assert.Equal(t, startDay, report.StartDay)
+	assert.Len(t, report.Daily, 3)
+	require.Len(t, report.Integrations, 3)
+
+	// sorted by type, then display name
+	assert.Equal(t, []*IntegrationUsageSummary{recent, dormant, active}, report.Integrations)
+
+	assert.Equal(t, int64(7), active.Posts)
+	assert.Equal(t, int64(1), active.Failures)
+	assert.Equal(t, 0.125, active.ErrorRate)
+	assert.Equal(t, startDay+INTEGRATION_USAGE_DAY_MILLIS, active.LastActiveDay)
+	assert.False(t, active.Dormant)
+
+	assert.Zero(t, dormant.ErrorRate)
+	assert.True(t, dormant.Dormant)
+
+	assert.False(t, recent.Dormant, "integrations created during the period should not be dormant")
+
+	rreport := IntegrationsUsageReportFromJson(strings.NewReader(report.ToJson()))
+	assert.Equal(t, report, rreport)
+}
@@ -20,6 +20,17 @@ const (
 	JOB_TYPE_MIGRATIONS                     = "migrations"
 	JOB_TYPE_PLUGINS                        = "plugins"
 	JOB_TYPE_EXPIRY_NOTIFY                  = "expiry_notify"
+	JOB_TYPE_POST_ARCHIVE                   = "post_archive"
+	JOB_TYPE_POSTS_PARTITIONING             = "posts_partitioning"
+	JOB_TYPE_SEAT_USAGE_NOTIFY              = "seat_usage_notify"
+	JOB_TYPE_EMAIL_VERIFICATION_ENFORCEMENT = "email_verification_enforcement"
+	JOB_TYPE_BACKUP                         = "backup"
+	JOB_TYPE_ROLE_EXPIRY                    = "role_expiry"
+	JOB_TYPE_FILE_ENRICHMENT                = "file_enrichment"
+	JOB_TYPE_PROFILE_CAPTURE                = "profile_capture"
+	JOB_TYPE_PREFERENCES_MIGRATION          = "preferences_migration"
+	JOB_TYPE_POST_INTEGRITY_VERIFICATION    = "post_integrity_verification"
+	JOB_TYPE_TEAM_PURGE                     = "team_purge"
 
 	JOB_STATUS_PENDING          = "pending"
 	JOB_STATUS_IN_PROGRESS      = "in_progress"
@@ -28,6 +39,15 @@ const (
 	JOB_STATUS_CANCEL_REQUESTED = "cancel_requested"
 	JOB_STATUS_CANCELED         = "canceled"
 	JOB_STATUS_WARNING          = "warning"
+
+	// The job data keys describing the file a job produced, kept in the job artifact store.
+	JOB_DATA_ARTIFACT_NAME = "artifact_name"
+	JOB_DATA_ARTIFACT_PATH = "artifact_path"
+	JOB_DATA_ARTIFACT_SIZE = "artifact_size"
+
+	// The job data keys of the categories a preferences migration job moves the preferences between.
+	JOB_DATA_OLD_CATEGORY = "old_category"
+	JOB_DATA_NEW_CATEGORY = "new_category"
 )
 
 type Job struct {
@@ -61,6 +81,17 @@ func (j *Job) IsValid() *AppError {
 	case JOB_TYPE_MIGRATIONS:
 	case JOB_TYPE_PLUGINS:
 	case JOB_TYPE_EXPIRY_NOTIFY:
+	case JOB_TYPE_POST_ARCHIVE:
+	case JOB_TYPE_POSTS_PARTITIONING:
+	case JOB_TYPE_SEAT_USAGE_NOTIFY:
+	case JOB_TYPE_EMAIL_VERIFICATION_ENFORCEMENT:
+	case JOB_TYPE_BACKUP:
+	case JOB_TYPE_ROLE_EXPIRY:
+	case JOB_TYPE_FILE_ENRICHMENT:
+	case JOB_TYPE_PROFILE_CAPTURE:
+	case JOB_TYPE_PREFERENCES_MIGRATION:
+	case JOB_TYPE_POST_INTEGRITY_VERIFICATION:
+	case JOB_TYPE_TEAM_PURGE:
 	default:
 		return NewAppError("Job.IsValid", "model.job.is_valid.type.app_error", nil, "id="+j.Id, http.StatusBadRequest)
 	}
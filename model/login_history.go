@@ -0,0 +1,103 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"unicode/utf8"
+)
+
+const (
+	LOGIN_HISTORY_IP_ADDRESS_MAX_LENGTH     = 64
+	LOGIN_HISTORY_USER_AGENT_MAX_RUNES      = 512
+	LOGIN_HISTORY_FAILURE_REASON_MAX_LENGTH = 128
+)
+
+// LoginHistory records an attempt of a user to log in, whether it succeeded or not.
+type LoginHistory struct {
+	Id          string `json:"id"`
+	UserId      string `json:"user_id"`
+	CreateAt    int64  `json:"create_at"`
+	IpAddress   string `json:"ip_address"`
+	UserAgent   string `json:"user_agent"`
+	AuthService string `json:"auth_service"`
+	Success     bool   `json:"success"`
+	// FailureReason is the id of the error the attempt failed with.
+	FailureReason string `json:"failure_reason,omitempty"`
+}
+
+// LoginHistoryGetOptions narrows down the login history returned, from the most recent attempt to
+// the oldest.
+type LoginHistoryGetOptions struct {
+	// UserId filters for the attempts of the given user.
+	UserId string
+	// Since filters for the attempts made at or after the given time.
+	Since int64
+	// Until filters for the attempts made before the given time.
+	Until int64
+	// OnlyFailures filters for the failed attempts.
+	OnlyFailures bool
+	Page         int
+	PerPage      int
+}
+
+func (o *LoginHistory) PreSave() {
+	if o.Id == "" {
+		o.Id = NewId()
+	}
+
+	if o.CreateAt == 0 {
+		o.CreateAt = GetMillis()
+	}
+
+	if o.AuthService == "" {
+		o.AuthService = USER_AUTH_SERVICE_EMAIL
+	}
+
+	// User agents are set by clients, so overly long ones are cut rather than refused.
+	if utf8.RuneCountInString(o.UserAgent) > LOGIN_HISTORY_USER_AGENT_MAX_RUNES {
+		o.UserAgent = string([]rune(o.UserAgent)[:LOGIN_HISTORY_USER_AGENT_MAX_RUNES])
+	}
+}
+
+func (o *LoginHistory) IsValid() *AppError {
+	if !IsValidId(o.Id) {
+		return NewAppError("LoginHistory.IsValid", "model.login_history.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.UserId) {
+		return NewAppError("LoginHistory.IsValid", "model.login_history.is_valid.user_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("LoginHistory.IsValid", "model.login_history.is_valid.create_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if len(o.IpAddress) > LOGIN_HISTORY_IP_ADDRESS_MAX_LENGTH {
+		return NewAppError("LoginHistory.IsValid", "model.login_history.is_valid.ip_address.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(o.UserAgent) > LOGIN_HISTORY_USER_AGENT_MAX_RUNES {
+		return NewAppError("LoginHistory.IsValid", "model.login_history.is_valid.user_agent.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.Success && o.FailureReason != "" || len(o.FailureReason) > LOGIN_HISTORY_FAILURE_REASON_MAX_LENGTH {
+		return NewAppError("LoginHistory.IsValid", "model.login_history.is_valid.failure_reason.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func LoginHistoryListToJson(l []*LoginHistory) string {
+	b, _ := json.Marshal(l)
+	return string(b)
+}
+
+func LoginHistoryListFromJson(data io.Reader) []*LoginHistory {
+	var l []*LoginHistory
+	json.NewDecoder(data).Decode(&l)
+	return l
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginHistoryPreSave(t *testing.T) {
+	entry := &LoginHistory{
+		UserId:    NewId(),
+		UserAgent: strings.Repeat("é", LOGIN_HISTORY_USER_AGENT_MAX_RUNES+10),
+	}
+	entry.PreSave()
+
+	assert.True(t, IsValidId(entry.Id))
+	assert.NotZero(t, entry.CreateAt)
+	assert.Equal(t, USER_AUTH_SERVICE_EMAIL, entry.AuthService)
+	assert.Equal(t, strings.Repeat("é", LOGIN_HISTORY_USER_AGENT_MAX_RUNES), entry.UserAgent)
+	assert.Nil(t, entry.IsValid())
+}
+
+func TestLoginHistoryIsValid(t *testing.T) {
+	newEntry := func() *LoginHistory {
+		entry := &LoginHistory{
+			UserId:    NewId(),
+			IpAddress: "127.0.0.1",
+			Success:   true,
+		}
+		entry.PreSave()
+		return entry
+	}
+
+	require.Nil(t, newEntry().IsValid())
+
+	entry := newEntry()
+	entry.UserId = "junk"
+	require.NotNil(t, entry.IsValid())
+
+	entry = newEntry()
+	entry.IpAddress = strings.Repeat("1", LOGIN_HISTORY_IP_ADDRESS_MAX_LENGTH+1)
+	require.NotNil(t, entry.IsValid())
+
+	entry = newEntry()
+	entry.FailureReason = "api.user.check_user_password.invalid.app_error"
+	require.NotNil(t, entry.IsValid(), "successful attempts can't have a failure reason")
+
+	entry.Success = false
+	require.Nil(t, entry.IsValid())
+
+	entry.FailureReason = strings.Repeat("a", LOGIN_HISTORY_FAILURE_REASON_MAX_LENGTH+1)
+	require.NotNil(t, entry.IsValid())
+}
+
+func TestLoginHistoryListJson(t *testing.T) {
+	entry := &LoginHistory{UserId: NewId(), Success: true}
+	entry.PreSave()
+
+	list := LoginHistoryListFromJson(strings.NewReader(LoginHistoryListToJson([]*LoginHistory{entry})))
+	require.Len(t, list, 1)
+	assert.Equal(t, entry, list[0])
+}
@@ -17,6 +17,11 @@ const (
 	MIGRATION_KEY_ADD_MANAGE_GUESTS_PERMISSIONS               = "add_manage_guests_permissions"
 	MIGRATION_KEY_CHANNEL_MODERATIONS_PERMISSIONS             = "channel_moderations_permissions"
 	MIGRATION_KEY_ADD_USE_GROUP_MENTIONS_PERMISSION           = "add_use_group_mentions_permission"
+	MIGRATION_KEY_ADD_DOWNLOAD_FILE_PERMISSION                = "add_download_file_permission"
+	MIGRATION_KEY_ADD_IMPERSONATE_USER_PERMISSION             = "add_impersonate_user_permission"
+	MIGRATION_KEY_ADD_SYSTEM_CONSOLE_PERMISSIONS              = "add_system_console_permissions"
+	MIGRATION_KEY_ADD_CREATE_URGENT_POST_PERMISSION           = "add_create_urgent_post_permission"
 
 	MIGRATION_KEY_SIDEBAR_CATEGORIES_PHASE_2 = "migration_sidebar_categories_phase_2"
+	MIGRATION_KEY_ROOT_MESSAGE_COUNTS        = "migration_root_message_counts"
 )
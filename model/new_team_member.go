@@ -0,0 +1,39 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// NewTeamMember is a user who recently joined a team, along with the time they joined it.
+type NewTeamMember struct {
+	Id                string `json:"id"`
+	Username          string `json:"username"`
+	FirstName         string `json:"first_name"`
+	LastName          string `json:"last_name"`
+	Nickname          string `json:"nickname"`
+	Position          string `json:"position"`
+	LastPictureUpdate int64  `json:"last_picture_update"`
+	JoinedAt          int64  `json:"joined_at"`
+}
+
+// NewTeamMembersList is a page of the users who recently joined a team, most recent first.
+type NewTeamMembersList struct {
+	HasNext    bool             `json:"has_next"`
+	Items      []*NewTeamMember `json:"items"`
+	TotalCount int64            `json:"total_count"`
+}
+
+func (o *NewTeamMembersList) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func NewTeamMembersListFromJson(data io.Reader) *NewTeamMembersList {
+	var o *NewTeamMembersList
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
@@ -31,6 +31,9 @@ type OAuthApp struct {
 	CallbackUrls StringArray `json:"callback_urls"`
 	Homepage     string      `json:"homepage"`
 	IsTrusted    bool        `json:"is_trusted"`
+	// EmbedFrameAncestors lists the origins allowed to embed channel views on behalf of the
+	// app. Embedding is disabled for the app when empty.
+	EmbedFrameAncestors StringArray `json:"embed_frame_ancestors"`
 }
 
 // IsValid validates the app and returns an error if it isn't configured
@@ -79,6 +82,16 @@ func (a *OAuthApp) IsValid() *AppError {
 		return NewAppError("OAuthApp.IsValid", "model.oauth.is_valid.description.app_error", nil, "app_id="+a.Id, http.StatusBadRequest)
 	}
 
+	if len(fmt.Sprintf("%s", a.EmbedFrameAncestors)) > 1024 {
+		return NewAppError("OAuthApp.IsValid", "model.oauth.is_valid.embed_frame_ancestors.app_error", nil, "app_id="+a.Id, http.StatusBadRequest)
+	}
+
+	for _, origin := range a.EmbedFrameAncestors {
+		if !IsValidEmbedOrigin(origin) {
+			return NewAppError("OAuthApp.IsValid", "model.oauth.is_valid.embed_frame_ancestors.app_error", nil, "app_id="+a.Id, http.StatusBadRequest)
+		}
+	}
+
 	if len(a.IconURL) > 0 {
 		if len(a.IconURL) > 512 || !IsValidHttpUrl(a.IconURL) {
 			return NewAppError("OAuthApp.IsValid", "model.oauth.is_valid.icon_url.app_error", nil, "app_id="+a.Id, http.StatusBadRequest)
@@ -133,6 +146,18 @@ func (a *OAuthApp) IsValidRedirectURL(url string) bool {
 	return false
 }
 
+// AllowsEmbedOrigin returns whether the origin of an embedding frame is one of the frame
+// ancestors of the app.
+func (a *OAuthApp) AllowsEmbedOrigin(origin string) bool {
+	for _, ancestor := range a.EmbedFrameAncestors {
+		if ancestor == origin {
+			return true
+		}
+	}
+
+	return false
+}
+
 func OAuthAppFromJson(data io.Reader) *OAuthApp {
 	var app *OAuthApp
 	json.NewDecoder(data).Decode(&app)
@@ -80,4 +80,10 @@ func TestOAuthAppIsValid(t *testing.T) {
 
 	app.IconURL = "https://nowhere.com/icon_image.png"
 	require.Nil(t, app.IsValid())
+
+	app.EmbedFrameAncestors = []string{"https://nowhere.com/path"}
+	require.NotNil(t, app.IsValid())
+
+	app.EmbedFrameAncestors = []string{"https://nowhere.com"}
+	require.Nil(t, app.IsValid())
 }
@@ -0,0 +1,32 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// USER_REPORTING_CHAIN_MAX_DEPTH bounds how many managers are walked up from a user, which also
+// protects against reporting lines that loop back on themselves.
+const USER_REPORTING_CHAIN_MAX_DEPTH = 20
+
+// OrgChart describes the position of a user in the organization.
+type OrgChart struct {
+	User *User `json:"user"`
+	// Managers is the reporting chain of the user, starting with their direct manager.
+	Managers      []*User `json:"managers"`
+	DirectReports []*User `json:"direct_reports"`
+}
+
+func (o *OrgChart) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func OrgChartFromJson(data io.Reader) *OrgChart {
+	var o *OrgChart
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
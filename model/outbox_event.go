@@ -0,0 +1,56 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"strings"
+)
+
+// OutboxEvent is a websocket event recorded in the same transaction as the change it announces, so
+// that the event is published even when the server stops between committing the change and
+// publishing it. DeliveredAt is set once the event was published.
+type OutboxEvent struct {
+	Id          string `json:"id"`
+	CreateAt    int64  `json:"create_at"`
+	Event       string `json:"event"`
+	DeliveredAt int64  `json:"delivered_at"`
+}
+
+func NewOutboxEvent(message *WebSocketEvent) *OutboxEvent {
+	return &OutboxEvent{
+		Event: message.ToJson(),
+	}
+}
+
+func (o *OutboxEvent) PreSave() {
+	if o.Id == "" {
+		o.Id = NewId()
+	}
+
+	if o.CreateAt == 0 {
+		o.CreateAt = GetMillis()
+	}
+}
+
+func (o *OutboxEvent) IsValid() *AppError {
+	if !IsValidId(o.Id) {
+		return NewAppError("OutboxEvent.IsValid", "model.outbox_event.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("OutboxEvent.IsValid", "model.outbox_event.is_valid.create_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if message := o.WebSocketEvent(); message == nil || !message.IsValid() {
+		return NewAppError("OutboxEvent.IsValid", "model.outbox_event.is_valid.event.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// WebSocketEvent returns the websocket event to publish, or nil if it can't be decoded.
+func (o *OutboxEvent) WebSocketEvent() *WebSocketEvent {
+	return WebSocketEventFromJson(strings.NewReader(o.Event))
+}
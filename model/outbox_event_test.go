@@ -0,0 +1,42 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutboxEventIsValid(t *testing.T) {
+	message := NewWebSocketEvent(WEBSOCKET_EVENT_POST_EDITED, "", NewId(), "", nil)
+	message.Add("post", "{}")
+
+	event := NewOutboxEvent(message)
+	event.PreSave()
+	require.Nil(t, event.IsValid())
+
+	event.Event = "{"
+	require.NotNil(t, event.IsValid())
+
+	event.Event = NewWebSocketEvent("", "", "", "", nil).ToJson()
+	require.NotNil(t, event.IsValid())
+
+	event.Event = message.ToJson()
+	event.CreateAt = 0
+	require.NotNil(t, event.IsValid())
+}
+
+func TestOutboxEventWebSocketEvent(t *testing.T) {
+	channelId := NewId()
+	message := NewWebSocketEvent(WEBSOCKET_EVENT_POSTED, "", channelId, "", nil)
+	message.Add("channel_type", CHANNEL_OPEN)
+
+	decoded := NewOutboxEvent(message).WebSocketEvent()
+	require.NotNil(t, decoded)
+	assert.Equal(t, WEBSOCKET_EVENT_POSTED, decoded.EventType())
+	assert.Equal(t, channelId, decoded.GetBroadcast().ChannelId)
+	assert.Equal(t, CHANNEL_OPEN, decoded.GetData()["channel_type"])
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+// PendingPin is a request to pin a post in a channel that requires the pins to be approved by a
+// channel admin.
+type PendingPin struct {
+	PostId    string `json:"post_id"`
+	ChannelId string `json:"channel_id"`
+	UserId    string `json:"user_id"`
+	CreateAt  int64  `json:"create_at"`
+}
+
+// IsValid validates the pending pin and returns an error if it isn't configured correctly.
+func (o *PendingPin) IsValid() *AppError {
+	if !IsValidId(o.PostId) {
+		return NewAppError("PendingPin.IsValid", "model.pending_pin.is_valid.post_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.ChannelId) {
+		return NewAppError("PendingPin.IsValid", "model.pending_pin.is_valid.channel_id.app_error", nil, "post_id="+o.PostId, http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.UserId) {
+		return NewAppError("PendingPin.IsValid", "model.pending_pin.is_valid.user_id.app_error", nil, "post_id="+o.PostId, http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("PendingPin.IsValid", "model.pending_pin.is_valid.create_at.app_error", nil, "post_id="+o.PostId, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// PreSave should be run before saving a new pending pin to the database.
+func (o *PendingPin) PreSave() {
+	if o.CreateAt == 0 {
+		o.CreateAt = GetMillis()
+	}
+}
+
+func (o *PendingPin) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func PendingPinFromJson(data io.Reader) *PendingPin {
+	var o *PendingPin
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
+
+func PendingPinListToJson(l []*PendingPin) string {
+	b, _ := json.Marshal(l)
+	return string(b)
+}
+
+func PendingPinListFromJson(data io.Reader) []*PendingPin {
+	var o []*PendingPin
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPendingPinJson(t *testing.T) {
+	o := &PendingPin{
+		PostId:    NewId(),
+		ChannelId: NewId(),
+		UserId:    NewId(),
+		CreateAt:  GetMillis(),
+	}
+
+	ro := PendingPinFromJson(strings.NewReader(o.ToJson()))
+	require.Equal(t, o, ro)
+
+	l := PendingPinListFromJson(strings.NewReader(PendingPinListToJson([]*PendingPin{o})))
+	require.Len(t, l, 1)
+	require.Equal(t, o, l[0])
+}
+
+func TestPendingPinIsValid(t *testing.T) {
+	valid := func() *PendingPin {
+		o := &PendingPin{
+			PostId:    NewId(),
+			ChannelId: NewId(),
+			UserId:    NewId(),
+		}
+		o.PreSave()
+		return o
+	}
+
+	testCases := []struct {
+		Description string
+		Modify      func(o *PendingPin)
+		Valid       bool
+	}{
+		{"valid", func(o *PendingPin) {}, true},
+		{"invalid post id", func(o *PendingPin) { o.PostId = "invalid" }, false},
+		{"invalid channel id", func(o *PendingPin) { o.ChannelId = "" }, false},
+		{"invalid user id", func(o *PendingPin) { o.UserId = "invalid" }, false},
+		{"missing create at", func(o *PendingPin) { o.CreateAt = 0 }, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			o := valid()
+			tc.Modify(o)
+
+			if tc.Valid {
+				assert.Nil(t, o.IsValid())
+			} else {
+				assert.NotNil(t, o.IsValid())
+			}
+		})
+	}
+}
@@ -50,6 +50,7 @@ var PERMISSION_REMOVE_OTHERS_REACTIONS *Permission
 var PERMISSION_PERMANENT_DELETE_USER *Permission
 var PERMISSION_UPLOAD_FILE *Permission
 var PERMISSION_GET_PUBLIC_LINK *Permission
+var PERMISSION_DOWNLOAD_FILE *Permission
 var PERMISSION_MANAGE_WEBHOOKS *Permission
 var PERMISSION_MANAGE_OTHERS_WEBHOOKS *Permission
 var PERMISSION_MANAGE_INCOMING_WEBHOOKS *Permission
@@ -90,8 +91,18 @@ var PERMISSION_VIEW_MEMBERS *Permission
 var PERMISSION_INVITE_GUEST *Permission
 var PERMISSION_PROMOTE_GUEST *Permission
 var PERMISSION_DEMOTE_TO_GUEST *Permission
+var PERMISSION_IMPERSONATE_USER *Permission
 var PERMISSION_USE_CHANNEL_MENTIONS *Permission
 var PERMISSION_USE_GROUP_MENTIONS *Permission
+var PERMISSION_CREATE_URGENT_POST *Permission
+
+// System console permissions, each one granting access to a single section of
+// the console so that parts of the administration can be delegated.
+var PERMISSION_SYSCONSOLE_READ_USER_MANAGEMENT *Permission
+var PERMISSION_SYSCONSOLE_WRITE_USER_MANAGEMENT *Permission
+var PERMISSION_SYSCONSOLE_READ_COMPLIANCE *Permission
+var PERMISSION_SYSCONSOLE_READ_INTEGRATIONS *Permission
+var PERMISSION_SYSCONSOLE_WRITE_INTEGRATIONS *Permission
 
 // General permission that encompasses all system admin functions
 // in the future this could be broken up to allow access to some
@@ -99,6 +110,7 @@ var PERMISSION_USE_GROUP_MENTIONS *Permission
 var PERMISSION_MANAGE_SYSTEM *Permission
 
 var ALL_PERMISSIONS []*Permission
+var SYSCONSOLE_PERMISSIONS []*Permission
 
 var CHANNEL_MODERATED_PERMISSIONS []string
 var CHANNEL_MODERATED_PERMISSIONS_MAP map[string]string
@@ -309,6 +321,12 @@ func initializePermissions() {
 		"authentication.permissions.upload_file.description",
 		PERMISSION_SCOPE_CHANNEL,
 	}
+	PERMISSION_DOWNLOAD_FILE = &Permission{
+		"download_file",
+		"authentication.permissions.download_file.name",
+		"authentication.permissions.download_file.description",
+		PERMISSION_SCOPE_CHANNEL,
+	}
 	PERMISSION_GET_PUBLIC_LINK = &Permission{
 		"get_public_link",
 		"authentication.permissions.get_public_link.name",
@@ -561,6 +579,13 @@ func initializePermissions() {
 		PERMISSION_SCOPE_SYSTEM,
 	}
 
+	PERMISSION_IMPERSONATE_USER = &Permission{
+		"impersonate_user",
+		"authentication.permissions.impersonate_user.name",
+		"authentication.permissions.impersonate_user.description",
+		PERMISSION_SCOPE_SYSTEM,
+	}
+
 	PERMISSION_USE_CHANNEL_MENTIONS = &Permission{
 		"use_channel_mentions",
 		"authentication.permissions.use_channel_mentions.name",
@@ -575,6 +600,56 @@ func initializePermissions() {
 		PERMISSION_SCOPE_CHANNEL,
 	}
 
+	PERMISSION_CREATE_URGENT_POST = &Permission{
+		"create_urgent_post",
+		"authentication.permissions.create_urgent_post.name",
+		"authentication.permissions.create_urgent_post.description",
+		PERMISSION_SCOPE_CHANNEL,
+	}
+
+	PERMISSION_SYSCONSOLE_READ_USER_MANAGEMENT = &Permission{
+		"sysconsole_read_user_management",
+		"authentication.permissions.sysconsole_read_user_management.name",
+		"authentication.permissions.sysconsole_read_user_management.description",
+		PERMISSION_SCOPE_SYSTEM,
+	}
+
+	PERMISSION_SYSCONSOLE_WRITE_USER_MANAGEMENT = &Permission{
+		"sysconsole_write_user_management",
+		"authentication.permissions.sysconsole_write_user_management.name",
+		"authentication.permissions.sysconsole_write_user_management.description",
+		PERMISSION_SCOPE_SYSTEM,
+	}
+
+	PERMISSION_SYSCONSOLE_READ_COMPLIANCE = &Permission{
+		"sysconsole_read_compliance",
+		"authentication.permissions.sysconsole_read_compliance.name",
+		"authentication.permissions.sysconsole_read_compliance.description",
+		PERMISSION_SCOPE_SYSTEM,
+	}
+
+	PERMISSION_SYSCONSOLE_READ_INTEGRATIONS = &Permission{
+		"sysconsole_read_integrations",
+		"authentication.permissions.sysconsole_read_integrations.name",
+		"authentication.permissions.sysconsole_read_integrations.description",
+		PERMISSION_SCOPE_SYSTEM,
+	}
+
+	PERMISSION_SYSCONSOLE_WRITE_INTEGRATIONS = &Permission{
+		"sysconsole_write_integrations",
+		"authentication.permissions.sysconsole_write_integrations.name",
+		"authentication.permissions.sysconsole_write_integrations.description",
+		PERMISSION_SCOPE_SYSTEM,
+	}
+
+	SYSCONSOLE_PERMISSIONS = []*Permission{
+		PERMISSION_SYSCONSOLE_READ_USER_MANAGEMENT,
+		PERMISSION_SYSCONSOLE_WRITE_USER_MANAGEMENT,
+		PERMISSION_SYSCONSOLE_READ_COMPLIANCE,
+		PERMISSION_SYSCONSOLE_READ_INTEGRATIONS,
+		PERMISSION_SYSCONSOLE_WRITE_INTEGRATIONS,
+	}
+
 	ALL_PERMISSIONS = []*Permission{
 		PERMISSION_INVITE_USER,
 		PERMISSION_ADD_USER_TO_TEAM,
@@ -609,6 +684,7 @@ func initializePermissions() {
 		PERMISSION_REMOVE_OTHERS_REACTIONS,
 		PERMISSION_PERMANENT_DELETE_USER,
 		PERMISSION_UPLOAD_FILE,
+		PERMISSION_DOWNLOAD_FILE,
 		PERMISSION_GET_PUBLIC_LINK,
 		PERMISSION_MANAGE_WEBHOOKS,
 		PERMISSION_MANAGE_OTHERS_WEBHOOKS,
@@ -650,9 +726,12 @@ func initializePermissions() {
 		PERMISSION_INVITE_GUEST,
 		PERMISSION_PROMOTE_GUEST,
 		PERMISSION_DEMOTE_TO_GUEST,
+		PERMISSION_IMPERSONATE_USER,
 		PERMISSION_USE_CHANNEL_MENTIONS,
 		PERMISSION_USE_GROUP_MENTIONS,
+		PERMISSION_CREATE_URGENT_POST,
 	}
+	ALL_PERMISSIONS = append(ALL_PERMISSIONS, SYSCONSOLE_PERMISSIONS...)
 
 	CHANNEL_MODERATED_PERMISSIONS = []string{
 		PERMISSION_CREATE_POST.Id,
@@ -0,0 +1,69 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+const (
+	PERMISSION_CHECKS_MAX_PER_BATCH = 200
+)
+
+// PermissionCheck asks whether the current session has a permission. The permission is checked
+// against the given channel or team, or system wide when neither is set.
+type PermissionCheck struct {
+	Permission string `json:"permission"`
+	TeamId     string `json:"team_id,omitempty"`
+	ChannelId  string `json:"channel_id,omitempty"`
+}
+
+type PermissionCheckResult struct {
+	PermissionCheck
+	Allowed bool `json:"allowed"`
+}
+
+func (o *PermissionCheck) IsValid() *AppError {
+	if o.Permission == "" {
+		return NewAppError("PermissionCheck.IsValid", "model.permission_check.is_valid.permission.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if o.TeamId != "" && !IsValidId(o.TeamId) {
+		return NewAppError("PermissionCheck.IsValid", "model.permission_check.is_valid.team_id.app_error", nil, "permission="+o.Permission, http.StatusBadRequest)
+	}
+
+	if o.ChannelId != "" && !IsValidId(o.ChannelId) {
+		return NewAppError("PermissionCheck.IsValid", "model.permission_check.is_valid.channel_id.app_error", nil, "permission="+o.Permission, http.StatusBadRequest)
+	}
+
+	if o.TeamId != "" && o.ChannelId != "" {
+		return NewAppError("PermissionCheck.IsValid", "model.permission_check.is_valid.scope.app_error", nil, "permission="+o.Permission, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func PermissionChecksToJson(o []*PermissionCheck) string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func PermissionChecksFromJson(data io.Reader) []*PermissionCheck {
+	var o []*PermissionCheck
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
+
+func PermissionCheckResultsToJson(o []*PermissionCheckResult) string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func PermissionCheckResultsFromJson(data io.Reader) []*PermissionCheckResult {
+	var o []*PermissionCheckResult
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPermissionCheckIsValid(t *testing.T) {
+	require.Nil(t, (&PermissionCheck{Permission: PERMISSION_CREATE_TEAM.Id}).IsValid())
+	require.Nil(t, (&PermissionCheck{Permission: PERMISSION_INVITE_USER.Id, TeamId: NewId()}).IsValid())
+	require.Nil(t, (&PermissionCheck{Permission: PERMISSION_CREATE_POST.Id, ChannelId: NewId()}).IsValid())
+
+	require.NotNil(t, (&PermissionCheck{}).IsValid())
+	require.NotNil(t, (&PermissionCheck{Permission: PERMISSION_INVITE_USER.Id, TeamId: "junk"}).IsValid())
+	require.NotNil(t, (&PermissionCheck{Permission: PERMISSION_CREATE_POST.Id, ChannelId: "junk"}).IsValid())
+	require.NotNil(t, (&PermissionCheck{Permission: PERMISSION_CREATE_POST.Id, TeamId: NewId(), ChannelId: NewId()}).IsValid())
+}
+
+func TestPermissionCheckJson(t *testing.T) {
+	checks := []*PermissionCheck{
+		{Permission: PERMISSION_CREATE_TEAM.Id},
+		{Permission: PERMISSION_CREATE_POST.Id, ChannelId: NewId()},
+	}
+	assert.Equal(t, checks, PermissionChecksFromJson(strings.NewReader(PermissionChecksToJson(checks))))
+
+	results := []*PermissionCheckResult{
+		{PermissionCheck: *checks[0], Allowed: true},
+		{PermissionCheck: *checks[1]},
+	}
+	assert.Equal(t, results, PermissionCheckResultsFromJson(strings.NewReader(PermissionCheckResultsToJson(results))))
+}
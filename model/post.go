@@ -99,6 +99,13 @@ type Post struct {
 	// Transient data populated before sending a post to the client
 	ReplyCount int64         `json:"reply_count" db:"-"`
 	Metadata   *PostMetadata `json:"metadata,omitempty" db:"-"`
+
+	// IsArchived is set when the post was read back from the post archive.
+	IsArchived bool `json:"is_archived,omitempty" db:"-"`
+
+	// ChangeSeq is the sequence number the last mutation of the post was given in the change log
+	// of its channel. It is only populated on the posts returned by mutations.
+	ChangeSeq int64 `json:"change_seq,omitempty" db:"-"`
 }
 
 type PostEphemeral struct {
@@ -194,6 +201,8 @@ func (o *Post) ShallowCopy(dst *Post) error {
 	dst.HasReactions = o.HasReactions
 	dst.ReplyCount = o.ReplyCount
 	dst.Metadata = o.Metadata
+	dst.IsArchived = o.IsArchived
+	dst.ChangeSeq = o.ChangeSeq
 	return nil
 }
 
@@ -331,6 +340,10 @@ func (o *Post) IsValid(maxPostSize int) *AppError {
 		return NewAppError("Post.IsValid", "model.post.is_valid.props.app_error", nil, "id="+o.Id, http.StatusBadRequest)
 	}
 
+	if !o.isPriorityValid() {
+		return NewAppError("Post.IsValid", "model.post.is_valid.priority.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
 	return nil
 }
 
@@ -348,7 +361,7 @@ func (o *Post) SanitizeProps() {
 
 func (o *Post) PreSave() {
 	if o.Id == "" {
-		o.Id = NewId()
+		o.Id = NewEntityId()
 	}
 
 	o.OriginalId = ""
@@ -0,0 +1,76 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io/ioutil"
+)
+
+// ArchivedPost is a post moved out of the Posts table by the archive job. The whole post is kept
+// compressed in Data, while the columns needed to look it up and search it are kept alongside.
+type ArchivedPost struct {
+	Id         string `json:"id"`
+	ChannelId  string `json:"channel_id"`
+	UserId     string `json:"user_id"`
+	RootId     string `json:"root_id"`
+	CreateAt   int64  `json:"create_at"`
+	DeleteAt   int64  `json:"delete_at"`
+	Message    string `json:"message"`
+	ArchivedAt int64  `json:"archived_at"`
+	Data       []byte `json:"-"`
+}
+
+// NewArchivedPost compresses the given post into an archive entry.
+func NewArchivedPost(post *Post) (*ArchivedPost, error) {
+	b, err := json.Marshal(post)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err = zw.Write(b); err != nil {
+		return nil, err
+	}
+	if err = zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return &ArchivedPost{
+		Id:         post.Id,
+		ChannelId:  post.ChannelId,
+		UserId:     post.UserId,
+		RootId:     post.RootId,
+		CreateAt:   post.CreateAt,
+		DeleteAt:   post.DeleteAt,
+		Message:    post.Message,
+		ArchivedAt: GetMillis(),
+		Data:       buf.Bytes(),
+	}, nil
+}
+
+// ToPost decompresses the archived post and marks it as archived.
+func (o *ArchivedPost) ToPost() (*Post, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(o.Data))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	b, err := ioutil.ReadAll(zr)
+	if err != nil {
+		return nil, err
+	}
+
+	var post *Post
+	if err = json.Unmarshal(b, &post); err != nil {
+		return nil, err
+	}
+
+	post.IsArchived = true
+	return post, nil
+}
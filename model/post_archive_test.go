@@ -0,0 +1,44 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchivedPost(t *testing.T) {
+	post := &Post{
+		Id:        NewId(),
+		ChannelId: NewId(),
+		UserId:    NewId(),
+		RootId:    NewId(),
+		CreateAt:  1234,
+		Message:   "an old message",
+		FileIds:   StringArray{NewId()},
+	}
+	post.AddProp("attachments", "value")
+
+	archived, err := NewArchivedPost(post)
+	require.Nil(t, err)
+	assert.Equal(t, post.Id, archived.Id)
+	assert.Equal(t, post.ChannelId, archived.ChannelId)
+	assert.Equal(t, post.RootId, archived.RootId)
+	assert.Equal(t, post.CreateAt, archived.CreateAt)
+	assert.Equal(t, post.Message, archived.Message)
+	assert.NotZero(t, archived.ArchivedAt)
+
+	restored, err := archived.ToPost()
+	require.Nil(t, err)
+	assert.True(t, restored.IsArchived)
+
+	restored.IsArchived = false
+	assert.Equal(t, post.ToJson(), restored.ToJson())
+
+	archived.Data = []byte("garbage")
+	_, err = archived.ToPost()
+	assert.NotNil(t, err)
+}
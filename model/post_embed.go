@@ -8,6 +8,7 @@ const (
 	POST_EMBED_MESSAGE_ATTACHMENT PostEmbedType = "message_attachment"
 	POST_EMBED_OPENGRAPH          PostEmbedType = "opengraph"
 	POST_EMBED_LINK               PostEmbedType = "link"
+	POST_EMBED_PERMALINK          PostEmbedType = "permalink"
 )
 
 type PostEmbedType string
@@ -18,6 +19,6 @@ type PostEmbed struct {
 	// The URL of the embedded content. Used for image and OpenGraph embeds.
 	URL string `json:"url,omitempty"`
 
-	// Any additional data for the embedded content. Only used for OpenGraph embeds.
+	// Any additional data for the embedded content. Only used for OpenGraph and permalink embeds.
 	Data interface{} `json:"data,omitempty"`
 }
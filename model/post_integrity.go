@@ -0,0 +1,92 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"strconv"
+)
+
+// PostIntegrityEntry is a link in the hash chain of a channel. Every time a post of the channel
+// is created or edited while post integrity is enabled, an entry is appended recording the hash
+// of the content of the post, chained to the hash of the previous entry of the channel. Editing
+// a post or an entry directly in the database then breaks the chain or no longer matches the
+// stored post.
+type PostIntegrityEntry struct {
+	ChannelId   string `json:"channel_id"`
+	Seq         int64  `json:"seq"`
+	PostId      string `json:"post_id"`
+	CreateAt    int64  `json:"create_at"`
+	ContentHash string `json:"content_hash"`
+	Hash        string `json:"hash"`
+}
+
+// PostContentHash returns the hex encoded SHA-256 hash of the fields of the post covered by
+// post integrity.
+func PostContentHash(post *Post) string {
+	return hashPostIntegrityFields(post.Id, post.ChannelId, post.UserId, post.RootId, strconv.FormatInt(post.CreateAt, 10), post.Message)
+}
+
+// NewPostIntegrityEntry returns the entry following previous for the given post. previous is
+// nil for the first entry of a channel.
+func NewPostIntegrityEntry(previous *PostIntegrityEntry, post *Post) *PostIntegrityEntry {
+	entry := &PostIntegrityEntry{
+		ChannelId:   post.ChannelId,
+		Seq:         1,
+		PostId:      post.Id,
+		CreateAt:    GetMillis(),
+		ContentHash: PostContentHash(post),
+	}
+
+	previousHash := ""
+	if previous != nil {
+		entry.Seq = previous.Seq + 1
+		previousHash = previous.Hash
+	}
+	entry.Hash = entry.ComputeHash(previousHash)
+
+	return entry
+}
+
+// ComputeHash returns the hash of the entry chained to the hash of the previous entry.
+func (o *PostIntegrityEntry) ComputeHash(previousHash string) string {
+	return hashPostIntegrityFields(previousHash, o.ChannelId, strconv.FormatInt(o.Seq, 10), o.PostId, strconv.FormatInt(o.CreateAt, 10), o.ContentHash)
+}
+
+// hashPostIntegrityFields hashes the fields prefixed by their length, so that moving bytes from
+// one field to the next changes the hash.
+func hashPostIntegrityFields(fields ...string) string {
+	h := sha256.New()
+	for _, field := range fields {
+		io.WriteString(h, strconv.Itoa(len(field)))
+		io.WriteString(h, ":")
+		io.WriteString(h, field)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// PostIntegrityReport is the result of verifying the hash chain of a channel against its posts.
+type PostIntegrityReport struct {
+	ChannelId       string   `json:"channel_id"`
+	EntriesChecked  int64    `json:"entries_checked"`
+	PostsChecked    int64    `json:"posts_checked"`
+	Valid           bool     `json:"valid"`
+	BrokenLinks     []int64  `json:"broken_links"`
+	ModifiedPostIds []string `json:"modified_post_ids"`
+	MissingPostIds  []string `json:"missing_post_ids"`
+}
+
+func (o *PostIntegrityReport) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func PostIntegrityReportFromJson(data io.Reader) *PostIntegrityReport {
+	var o *PostIntegrityReport
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostContentHash(t *testing.T) {
+	post := &Post{Id: NewId(), ChannelId: NewId(), UserId: NewId(), CreateAt: GetMillis(), Message: "message"}
+	hash := PostContentHash(post)
+	assert.Len(t, hash, 64)
+	assert.Equal(t, hash, PostContentHash(post.Clone()))
+
+	edited := post.Clone()
+	edited.Message = "edited"
+	assert.NotEqual(t, hash, PostContentHash(edited))
+
+	// Fields are length prefixed so bytes can't be shifted from one to the next.
+	shifted := post.Clone()
+	shifted.RootId = "m"
+	shifted.Message = "essage"
+	assert.NotEqual(t, hash, PostContentHash(shifted))
+
+	// Fields outside the content, such as the edit time, are not covered.
+	edited = post.Clone()
+	edited.EditAt = GetMillis()
+	assert.Equal(t, hash, PostContentHash(edited))
+}
+
+func TestNewPostIntegrityEntry(t *testing.T) {
+	post := &Post{Id: NewId(), ChannelId: NewId(), UserId: NewId(), CreateAt: GetMillis(), Message: "message"}
+
+	first := NewPostIntegrityEntry(nil, post)
+	assert.Equal(t, post.ChannelId, first.ChannelId)
+	assert.Equal(t, int64(1), first.Seq)
+	assert.Equal(t, post.Id, first.PostId)
+	assert.Equal(t, PostContentHash(post), first.ContentHash)
+	assert.Equal(t, first.ComputeHash(""), first.Hash)
+
+	second := NewPostIntegrityEntry(first, post)
+	assert.Equal(t, int64(2), second.Seq)
+	assert.Equal(t, second.ComputeHash(first.Hash), second.Hash)
+	assert.NotEqual(t, second.ComputeHash(""), second.Hash)
+
+	tampered := *first
+	tampered.ContentHash = PostContentHash(&Post{Id: post.Id, Message: "tampered"})
+	assert.NotEqual(t, first.Hash, tampered.ComputeHash(""))
+}
+
+func TestPostIntegrityReportJson(t *testing.T) {
+	o := &PostIntegrityReport{
+		ChannelId:       NewId(),
+		EntriesChecked:  3,
+		PostsChecked:    2,
+		BrokenLinks:     []int64{2},
+		ModifiedPostIds: []string{NewId()},
+		MissingPostIds:  []string{},
+	}
+
+	ro := PostIntegrityReportFromJson(strings.NewReader(o.ToJson()))
+	require.Equal(t, o, ro)
+}
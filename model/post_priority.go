@@ -0,0 +1,121 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+const (
+	POST_PRIORITY_STANDARD  = ""
+	POST_PRIORITY_IMPORTANT = "important"
+	POST_PRIORITY_URGENT    = "urgent"
+
+	POST_PROPS_PRIORITY      = "priority"
+	POST_PROPS_REQUESTED_ACK = "requested_ack"
+)
+
+func IsValidPostPriority(priority string) bool {
+	switch priority {
+	case POST_PRIORITY_STANDARD, POST_PRIORITY_IMPORTANT, POST_PRIORITY_URGENT:
+		return true
+	}
+	return false
+}
+
+// GetPriority returns the priority of the post, POST_PRIORITY_STANDARD when none was given.
+func (o *Post) GetPriority() string {
+	priority, _ := o.GetProp(POST_PROPS_PRIORITY).(string)
+	return priority
+}
+
+func (o *Post) IsUrgent() bool {
+	return o.GetPriority() == POST_PRIORITY_URGENT
+}
+
+// IsAckRequested returns whether the author of the post asked its recipients to acknowledge it.
+func (o *Post) IsAckRequested() bool {
+	requestedAck, _ := o.GetProp(POST_PROPS_REQUESTED_ACK).(bool)
+	return requestedAck
+}
+
+// isPriorityValid checks the priority props of the post, which must be of the expected types
+// when present.
+func (o *Post) isPriorityValid() bool {
+	props := o.GetProps()
+
+	if priority, ok := props[POST_PROPS_PRIORITY]; ok {
+		if p, isString := priority.(string); !isString || !IsValidPostPriority(p) {
+			return false
+		}
+	}
+
+	if requestedAck, ok := props[POST_PROPS_REQUESTED_ACK]; ok {
+		if _, isBool := requestedAck.(bool); !isBool {
+			return false
+		}
+	}
+
+	return true
+}
+
+// PostAcknowledgement records that a user acknowledged a post whose author requested it.
+type PostAcknowledgement struct {
+	PostId         string `json:"post_id"`
+	UserId         string `json:"user_id"`
+	ChannelId      string `json:"channel_id"`
+	AcknowledgedAt int64  `json:"acknowledged_at"`
+}
+
+// IsValid validates the acknowledgement and returns an error if it isn't configured correctly.
+func (o *PostAcknowledgement) IsValid() *AppError {
+	if !IsValidId(o.PostId) {
+		return NewAppError("PostAcknowledgement.IsValid", "model.post_acknowledgement.is_valid.post_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.UserId) {
+		return NewAppError("PostAcknowledgement.IsValid", "model.post_acknowledgement.is_valid.user_id.app_error", nil, "post_id="+o.PostId, http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.ChannelId) {
+		return NewAppError("PostAcknowledgement.IsValid", "model.post_acknowledgement.is_valid.channel_id.app_error", nil, "post_id="+o.PostId, http.StatusBadRequest)
+	}
+
+	if o.AcknowledgedAt == 0 {
+		return NewAppError("PostAcknowledgement.IsValid", "model.post_acknowledgement.is_valid.acknowledged_at.app_error", nil, "post_id="+o.PostId, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// PreSave should be run before saving a new acknowledgement to the database.
+func (o *PostAcknowledgement) PreSave() {
+	if o.AcknowledgedAt == 0 {
+		o.AcknowledgedAt = GetMillis()
+	}
+}
+
+func (o *PostAcknowledgement) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func PostAcknowledgementFromJson(data io.Reader) *PostAcknowledgement {
+	var o *PostAcknowledgement
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
+
+func PostAcknowledgementListToJson(l []*PostAcknowledgement) string {
+	b, _ := json.Marshal(l)
+	return string(b)
+}
+
+func PostAcknowledgementListFromJson(data io.Reader) []*PostAcknowledgement {
+	var o []*PostAcknowledgement
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
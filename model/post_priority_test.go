@@ -0,0 +1,109 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostPriority(t *testing.T) {
+	post := &Post{}
+	assert.Equal(t, POST_PRIORITY_STANDARD, post.GetPriority())
+	assert.False(t, post.IsUrgent())
+	assert.False(t, post.IsAckRequested())
+
+	post.AddProp(POST_PROPS_PRIORITY, POST_PRIORITY_URGENT)
+	post.AddProp(POST_PROPS_REQUESTED_ACK, true)
+	assert.Equal(t, POST_PRIORITY_URGENT, post.GetPriority())
+	assert.True(t, post.IsUrgent())
+	assert.True(t, post.IsAckRequested())
+
+	post = PostFromJson(strings.NewReader(post.ToJson()))
+	assert.True(t, post.IsUrgent())
+	assert.True(t, post.IsAckRequested())
+}
+
+func TestPostIsValidPriority(t *testing.T) {
+	valid := func() *Post {
+		o := &Post{
+			UserId:    NewId(),
+			ChannelId: NewId(),
+			Message:   "message",
+		}
+		o.PreSave()
+		return o
+	}
+
+	o := valid()
+	require.Nil(t, o.IsValid(POST_MESSAGE_MAX_RUNES_V2))
+
+	for _, priority := range []string{POST_PRIORITY_STANDARD, POST_PRIORITY_IMPORTANT, POST_PRIORITY_URGENT} {
+		o = valid()
+		o.AddProp(POST_PROPS_PRIORITY, priority)
+		o.AddProp(POST_PROPS_REQUESTED_ACK, true)
+		require.Nil(t, o.IsValid(POST_MESSAGE_MAX_RUNES_V2), priority)
+	}
+
+	o = valid()
+	o.AddProp(POST_PROPS_PRIORITY, "critical")
+	require.NotNil(t, o.IsValid(POST_MESSAGE_MAX_RUNES_V2))
+
+	o = valid()
+	o.AddProp(POST_PROPS_PRIORITY, 1)
+	require.NotNil(t, o.IsValid(POST_MESSAGE_MAX_RUNES_V2))
+
+	o = valid()
+	o.AddProp(POST_PROPS_REQUESTED_ACK, "true")
+	require.NotNil(t, o.IsValid(POST_MESSAGE_MAX_RUNES_V2))
+}
+
+func TestPostAcknowledgementJson(t *testing.T) {
+	o := &PostAcknowledgement{
+		PostId:         NewId(),
+		UserId:         NewId(),
+		ChannelId:      NewId(),
+		AcknowledgedAt: GetMillis(),
+	}
+
+	ro := PostAcknowledgementFromJson(strings.NewReader(o.ToJson()))
+	require.Equal(t, o, ro)
+
+	l := PostAcknowledgementListFromJson(strings.NewReader(PostAcknowledgementListToJson([]*PostAcknowledgement{o})))
+	require.Len(t, l, 1)
+	require.Equal(t, o, l[0])
+}
+
+func TestPostAcknowledgementIsValid(t *testing.T) {
+	valid := func() *PostAcknowledgement {
+		o := &PostAcknowledgement{
+			PostId:    NewId(),
+			UserId:    NewId(),
+			ChannelId: NewId(),
+		}
+		o.PreSave()
+		return o
+	}
+
+	require.Nil(t, valid().IsValid())
+
+	o := valid()
+	o.PostId = "invalid"
+	require.NotNil(t, o.IsValid())
+
+	o = valid()
+	o.UserId = ""
+	require.NotNil(t, o.IsValid())
+
+	o = valid()
+	o.ChannelId = ""
+	require.NotNil(t, o.IsValid())
+
+	o = valid()
+	o.AcknowledgedAt = 0
+	require.NotNil(t, o.IsValid())
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+const (
+	POST_PROPS_SYSTEM_MESSAGE_ID     = "system_message_id"
+	POST_PROPS_SYSTEM_MESSAGE_PARAMS = "system_message_params"
+)
+
+// SetSystemMessageTranslation records the translation of the message of a system post along with
+// its parameters, so that the message can be rendered in the locale of whoever reads it.
+func (o *Post) SetSystemMessageTranslation(translationId string, params []string) {
+	o.AddProp(POST_PROPS_SYSTEM_MESSAGE_ID, translationId)
+	o.AddProp(POST_PROPS_SYSTEM_MESSAGE_PARAMS, params)
+}
+
+// GetSystemMessageTranslation returns the translation of the message of a system post and its
+// parameters, or an empty translation id if the post has none.
+func (o *Post) GetSystemMessageTranslation() (string, []interface{}) {
+	if !o.IsSystemMessage() {
+		return "", nil
+	}
+
+	translationId, _ := o.GetProp(POST_PROPS_SYSTEM_MESSAGE_ID).(string)
+	if translationId == "" {
+		return "", nil
+	}
+
+	// The parameters are a []string until the post goes through JSON, and a []interface{} after.
+	switch params := o.GetProp(POST_PROPS_SYSTEM_MESSAGE_PARAMS).(type) {
+	case []string:
+		result := make([]interface{}, len(params))
+		for i, param := range params {
+			result[i] = param
+		}
+		return translationId, result
+	case []interface{}:
+		for _, param := range params {
+			if _, ok := param.(string); !ok {
+				return "", nil
+			}
+		}
+		return translationId, params
+	case nil:
+		return translationId, nil
+	}
+
+	return "", nil
+}
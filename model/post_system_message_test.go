@@ -0,0 +1,37 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostSystemMessageTranslation(t *testing.T) {
+	post := &Post{Type: POST_JOIN_CHANNEL}
+	translationId, params := post.GetSystemMessageTranslation()
+	assert.Empty(t, translationId)
+	assert.Nil(t, params)
+
+	post.SetSystemMessageTranslation("api.channel.join_channel.post_and_forget", []string{"user"})
+	translationId, params = post.GetSystemMessageTranslation()
+	assert.Equal(t, "api.channel.join_channel.post_and_forget", translationId)
+	assert.Equal(t, []interface{}{"user"}, params)
+
+	post = PostFromJson(strings.NewReader(post.ToJson()))
+	translationId, params = post.GetSystemMessageTranslation()
+	assert.Equal(t, "api.channel.join_channel.post_and_forget", translationId)
+	assert.Equal(t, []interface{}{"user"}, params)
+
+	post.AddProp(POST_PROPS_SYSTEM_MESSAGE_PARAMS, []interface{}{1})
+	translationId, _ = post.GetSystemMessageTranslation()
+	assert.Empty(t, translationId)
+
+	post = &Post{}
+	post.SetSystemMessageTranslation("api.channel.join_channel.post_and_forget", []string{"user"})
+	translationId, _ = post.GetSystemMessageTranslation()
+	assert.Empty(t, translationId, "regular posts are never translated")
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+)
+
+const (
+	PROPS_RESTRICT_FILE_UPLOADS = "restrict_file_uploads"
+	PROPS_RESTRICT_CUSTOM_EMOJI = "restrict_custom_emoji"
+)
+
+// PostingRestrictions describes the moderation settings that can be applied to a channel or a team.
+// Fields left nil are not changed when the restrictions are applied.
+type PostingRestrictions struct {
+	RestrictFileUploads *bool `json:"restrict_file_uploads"`
+	RestrictCustomEmoji *bool `json:"restrict_custom_emoji"`
+}
+
+func (r *PostingRestrictions) ToJson() string {
+	b, _ := json.Marshal(r)
+	return string(b)
+}
+
+func PostingRestrictionsFromJson(data io.Reader) *PostingRestrictions {
+	var r *PostingRestrictions
+	json.NewDecoder(data).Decode(&r)
+	return r
+}
+
+func (r *PostingRestrictions) applyTo(props map[string]interface{}) {
+	if r.RestrictFileUploads != nil {
+		setRestrictionProp(props, PROPS_RESTRICT_FILE_UPLOADS, *r.RestrictFileUploads)
+	}
+
+	if r.RestrictCustomEmoji != nil {
+		setRestrictionProp(props, PROPS_RESTRICT_CUSTOM_EMOJI, *r.RestrictCustomEmoji)
+	}
+}
+
+func setRestrictionProp(props map[string]interface{}, key string, restricted bool) {
+	if restricted {
+		props[key] = true
+	} else {
+		delete(props, key)
+	}
+}
+
+func isRestrictedByProps(props map[string]interface{}, key string) bool {
+	restricted, _ := props[key].(bool)
+	return restricted
+}
+
+// SetPostingRestrictions stores the given restrictions in the channel props.
+func (o *Channel) SetPostingRestrictions(restrictions *PostingRestrictions) {
+	o.MakeNonNil()
+	restrictions.applyTo(o.Props)
+}
+
+func (o *Channel) IsFileUploadRestricted() bool {
+	return isRestrictedByProps(o.Props, PROPS_RESTRICT_FILE_UPLOADS)
+}
+
+func (o *Channel) IsCustomEmojiRestricted() bool {
+	return isRestrictedByProps(o.Props, PROPS_RESTRICT_CUSTOM_EMOJI)
+}
+
+// SetPostingRestrictions stores the given restrictions in the team props.
+func (o *Team) SetPostingRestrictions(restrictions *PostingRestrictions) {
+	o.MakeNonNil()
+	restrictions.applyTo(o.Props)
+}
+
+func (o *Team) IsFileUploadRestricted() bool {
+	return isRestrictedByProps(o.Props, PROPS_RESTRICT_FILE_UPLOADS)
+}
+
+func (o *Team) IsCustomEmojiRestricted() bool {
+	return isRestrictedByProps(o.Props, PROPS_RESTRICT_CUSTOM_EMOJI)
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostingRestrictionsJson(t *testing.T) {
+	restrictions := &PostingRestrictions{RestrictFileUploads: NewBool(true)}
+
+	decoded := PostingRestrictionsFromJson(strings.NewReader(restrictions.ToJson()))
+	require.NotNil(t, decoded)
+	require.NotNil(t, decoded.RestrictFileUploads)
+	assert.True(t, *decoded.RestrictFileUploads)
+	assert.Nil(t, decoded.RestrictCustomEmoji)
+}
+
+func TestChannelPostingRestrictions(t *testing.T) {
+	channel := &Channel{}
+	assert.False(t, channel.IsFileUploadRestricted())
+	assert.False(t, channel.IsCustomEmojiRestricted())
+
+	channel.SetPostingRestrictions(&PostingRestrictions{RestrictFileUploads: NewBool(true), RestrictCustomEmoji: NewBool(true)})
+	assert.True(t, channel.IsFileUploadRestricted())
+	assert.True(t, channel.IsCustomEmojiRestricted())
+
+	channel.SetPostingRestrictions(&PostingRestrictions{RestrictCustomEmoji: NewBool(false)})
+	assert.True(t, channel.IsFileUploadRestricted())
+	assert.False(t, channel.IsCustomEmojiRestricted())
+	assert.NotContains(t, channel.Props, PROPS_RESTRICT_CUSTOM_EMOJI)
+}
+
+func TestTeamPostingRestrictions(t *testing.T) {
+	team := &Team{}
+	assert.False(t, team.IsFileUploadRestricted())
+
+	team.SetPostingRestrictions(&PostingRestrictions{RestrictFileUploads: NewBool(true)})
+	assert.True(t, team.IsFileUploadRestricted())
+	assert.False(t, team.IsCustomEmojiRestricted())
+
+	team.SetPostingRestrictions(&PostingRestrictions{RestrictFileUploads: NewBool(false)})
+	assert.False(t, team.IsFileUploadRestricted())
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+)
+
+const (
+	POSTS_DELTA_MAX = 1000
+)
+
+// PostsDeltaCursor is the position reached while syncing the posts of a channel. Posts are returned
+// in UpdateAt, Id order past UpdateAt and Id, and are classified as new or edited relative to Since.
+type PostsDeltaCursor struct {
+	ChannelId string `json:"channel_id"`
+	Since     int64  `json:"since"`
+	UpdateAt  int64  `json:"update_at"`
+	Id        string `json:"id"`
+}
+
+// PostsDeltaUpdate is a post that changed without being edited, such as through its reactions or
+// replies. Clients refetch it only if their copy is older than UpdateAt.
+type PostsDeltaUpdate struct {
+	Id       string `json:"id"`
+	UpdateAt int64  `json:"update_at"`
+}
+
+// PostsDelta lists the changes to the posts of a channel since the time of its cursor.
+type PostsDelta struct {
+	New       []*Post             `json:"new"`
+	Edited    []*Post             `json:"edited"`
+	Updated   []*PostsDeltaUpdate `json:"updated"`
+	Deleted   []string            `json:"deleted"`
+	HasMore   bool                `json:"has_more"`
+	NextToken string              `json:"next_token"`
+}
+
+func NewPostsDelta() *PostsDelta {
+	return &PostsDelta{
+		New:     []*Post{},
+		Edited:  []*Post{},
+		Updated: []*PostsDeltaUpdate{},
+		Deleted: []string{},
+	}
+}
+
+// NewPostsDeltaCursor returns the cursor of the posts of the channel updated after since.
+func NewPostsDeltaCursor(channelId string, since int64) PostsDeltaCursor {
+	return PostsDeltaCursor{
+		ChannelId: channelId,
+		Since:     since,
+		UpdateAt:  since,
+	}
+}
+
+// ToToken encodes the cursor as the opaque token handed out to clients.
+func (c PostsDeltaCursor) ToToken() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// PostsDeltaCursorFromToken decodes a token built by ToToken, returning nil if it is malformed.
+func PostsDeltaCursorFromToken(token string) *PostsDeltaCursor {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil
+	}
+
+	var cursor PostsDeltaCursor
+	if err := json.Unmarshal(b, &cursor); err != nil {
+		return nil
+	}
+
+	if !IsValidId(cursor.ChannelId) || cursor.Since < 0 || cursor.UpdateAt < cursor.Since || (cursor.Id != "" && !IsValidId(cursor.Id)) {
+		return nil
+	}
+
+	return &cursor
+}
+
+func (o *PostsDelta) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func PostsDeltaFromJson(data io.Reader) *PostsDelta {
+	var o *PostsDelta
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostsDeltaCursorToken(t *testing.T) {
+	cursor := PostsDeltaCursor{ChannelId: NewId(), Since: 1000, UpdateAt: 2000, Id: NewId()}
+
+	decoded := PostsDeltaCursorFromToken(cursor.ToToken())
+	require.NotNil(t, decoded)
+	assert.Equal(t, cursor, *decoded)
+
+	initial := NewPostsDeltaCursor(cursor.ChannelId, 1000)
+	decoded = PostsDeltaCursorFromToken(initial.ToToken())
+	require.NotNil(t, decoded)
+	assert.Equal(t, initial, *decoded)
+
+	for _, invalid := range []PostsDeltaCursor{
+		{ChannelId: "invalid", Since: 1000, UpdateAt: 1000},
+		{ChannelId: cursor.ChannelId, Since: 1000, UpdateAt: 999},
+		{ChannelId: cursor.ChannelId, Since: -1, UpdateAt: 0},
+		{ChannelId: cursor.ChannelId, Since: 1000, UpdateAt: 1000, Id: "invalid"},
+	} {
+		assert.Nil(t, PostsDeltaCursorFromToken(invalid.ToToken()))
+	}
+
+	assert.Nil(t, PostsDeltaCursorFromToken("!"))
+	assert.Nil(t, PostsDeltaCursorFromToken(""))
+}
+
+func TestPostsDeltaJson(t *testing.T) {
+	delta := NewPostsDelta()
+	delta.New = append(delta.New, &Post{Id: NewId(), Message: "new"})
+	delta.Updated = append(delta.Updated, &PostsDeltaUpdate{Id: NewId(), UpdateAt: 1000})
+	delta.Deleted = append(delta.Deleted, NewId())
+	delta.NextToken = "token"
+
+	result := PostsDeltaFromJson(strings.NewReader(delta.ToJson()))
+	require.NotNil(t, result)
+	assert.Equal(t, delta, result)
+}
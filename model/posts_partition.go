@@ -0,0 +1,56 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// POSTS_PARTITIONS_AHEAD is the number of months for which partitions are created in advance.
+	POSTS_PARTITIONS_AHEAD = 3
+)
+
+// PostsPartition is a monthly partition of the Posts table, holding the posts with StartAt <= CreateAt < EndAt.
+type PostsPartition struct {
+	Name    string `json:"name"`
+	StartAt int64  `json:"start_at"`
+	EndAt   int64  `json:"end_at"`
+}
+
+// PostsPartitionCursor is the position reached while copying the Posts table into its partitioned
+// replacement, ordered by CreateAt and then Id. The zero value starts from the oldest post.
+type PostsPartitionCursor struct {
+	CreateAt int64
+	Id       string
+}
+
+// NewPostsPartition returns the partition holding the posts created during the UTC month of the given time.
+func NewPostsPartition(millis int64) *PostsPartition {
+	t := time.Unix(0, millis*int64(time.Millisecond)).UTC()
+	start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+
+	return &PostsPartition{
+		Name:    fmt.Sprintf("posts_y%04dm%02d", start.Year(), int(start.Month())),
+		StartAt: GetMillisForTime(start),
+		EndAt:   GetMillisForTime(end),
+	}
+}
+
+// PostsPartitionFromName parses the name of a monthly partition, returning nil if it isn't one.
+func PostsPartitionFromName(name string) *PostsPartition {
+	var year, month int
+	if n, err := fmt.Sscanf(name, "posts_y%04dm%02d", &year, &month); err != nil || n != 2 || month < 1 || month > 12 {
+		return nil
+	}
+
+	partition := NewPostsPartition(GetMillisForTime(time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)))
+	if partition.Name != name {
+		return nil
+	}
+
+	return partition
+}
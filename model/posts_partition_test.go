@@ -0,0 +1,37 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPostsPartition(t *testing.T) {
+	millis := GetMillisForTime(time.Date(2020, time.December, 31, 23, 59, 59, 0, time.UTC))
+
+	partition := NewPostsPartition(millis)
+	assert.Equal(t, "posts_y2020m12", partition.Name)
+	assert.Equal(t, GetMillisForTime(time.Date(2020, time.December, 1, 0, 0, 0, 0, time.UTC)), partition.StartAt)
+	assert.Equal(t, GetMillisForTime(time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)), partition.EndAt)
+
+	next := NewPostsPartition(partition.EndAt)
+	assert.Equal(t, "posts_y2021m01", next.Name)
+	assert.Equal(t, partition.EndAt, next.StartAt)
+
+	assert.Equal(t, "posts_y1970m01", NewPostsPartition(0).Name)
+}
+
+func TestPostsPartitionFromName(t *testing.T) {
+	partition := PostsPartitionFromName("posts_y2020m07")
+	require.NotNil(t, partition)
+	assert.Equal(t, NewPostsPartition(GetMillisForTime(time.Date(2020, time.July, 15, 0, 0, 0, 0, time.UTC))), partition)
+
+	for _, name := range []string{"posts_default", "posts_y2020m13", "posts_y2020m7", "posts", ""} {
+		assert.Nil(t, PostsPartitionFromName(name), name)
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"unicode/utf8"
+)
+
+const (
+	PRESENCE_WEBHOOK_DISPLAY_NAME_MAX_RUNES = 64
+	PRESENCE_WEBHOOK_DESCRIPTION_MAX_RUNES  = 500
+	PRESENCE_WEBHOOK_CALLBACK_URL_MAX_LEN   = 1024
+	PRESENCE_WEBHOOK_USER_IDS_MAX           = 200
+	PRESENCE_WEBHOOK_DEBOUNCE_SECONDS_MAX   = 3600
+)
+
+// PresenceWebhook posts to an external system whenever one of the watched users changes status.
+type PresenceWebhook struct {
+	Id          string      `json:"id"`
+	CreateAt    int64       `json:"create_at"`
+	UpdateAt    int64       `json:"update_at"`
+	DeleteAt    int64       `json:"delete_at"`
+	CreatorId   string      `json:"creator_id"`
+	DisplayName string      `json:"display_name"`
+	Description string      `json:"description"`
+	CallbackURL string      `json:"callback_url"`
+	UserIds     StringArray `json:"user_ids"`
+	// Statuses restricts the transitions delivered to those into one of the listed statuses. All
+	// transitions are delivered when empty.
+	Statuses StringArray `json:"statuses"`
+	// DebounceSeconds coalesces the transitions of a user that happen within this many seconds of
+	// each other, so that only the status they settle on is delivered.
+	DebounceSeconds int `json:"debounce_seconds"`
+}
+
+// PresenceWebhookPayload is the body posted to the callback URL of a presence webhook.
+type PresenceWebhookPayload struct {
+	WebhookId      string `json:"webhook_id"`
+	UserId         string `json:"user_id"`
+	Username       string `json:"username"`
+	Status         string `json:"status"`
+	PreviousStatus string `json:"previous_status"`
+	Manual         bool   `json:"manual"`
+	Timestamp      int64  `json:"timestamp"`
+}
+
+func (o *PresenceWebhook) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func PresenceWebhookFromJson(data io.Reader) *PresenceWebhook {
+	var o *PresenceWebhook
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
+
+func PresenceWebhookListToJson(l []*PresenceWebhook) string {
+	b, _ := json.Marshal(l)
+	return string(b)
+}
+
+func PresenceWebhookListFromJson(data io.Reader) []*PresenceWebhook {
+	var o []*PresenceWebhook
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
+
+func (o *PresenceWebhookPayload) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func PresenceWebhookPayloadFromJson(data io.Reader) *PresenceWebhookPayload {
+	var o *PresenceWebhookPayload
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
+
+func (o *PresenceWebhook) IsValid() *AppError {
+	if !IsValidId(o.Id) {
+		return NewAppError("PresenceWebhook.IsValid", "model.presence_hook.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("PresenceWebhook.IsValid", "model.presence_hook.is_valid.create_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.UpdateAt == 0 {
+		return NewAppError("PresenceWebhook.IsValid", "model.presence_hook.is_valid.update_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.CreatorId) {
+		return NewAppError("PresenceWebhook.IsValid", "model.presence_hook.is_valid.creator_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(o.DisplayName) > PRESENCE_WEBHOOK_DISPLAY_NAME_MAX_RUNES {
+		return NewAppError("PresenceWebhook.IsValid", "model.presence_hook.is_valid.display_name.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(o.Description) > PRESENCE_WEBHOOK_DESCRIPTION_MAX_RUNES {
+		return NewAppError("PresenceWebhook.IsValid", "model.presence_hook.is_valid.description.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if len(o.CallbackURL) > PRESENCE_WEBHOOK_CALLBACK_URL_MAX_LEN || !IsValidHttpUrl(o.CallbackURL) {
+		return NewAppError("PresenceWebhook.IsValid", "model.presence_hook.is_valid.callback_url.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if len(o.UserIds) == 0 || len(o.UserIds) > PRESENCE_WEBHOOK_USER_IDS_MAX {
+		return NewAppError("PresenceWebhook.IsValid", "model.presence_hook.is_valid.user_ids.app_error", map[string]interface{}{"Max": PRESENCE_WEBHOOK_USER_IDS_MAX}, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	for _, userId := range o.UserIds {
+		if !IsValidId(userId) {
+			return NewAppError("PresenceWebhook.IsValid", "model.presence_hook.is_valid.user_ids.app_error", map[string]interface{}{"Max": PRESENCE_WEBHOOK_USER_IDS_MAX}, "id="+o.Id, http.StatusBadRequest)
+		}
+	}
+
+	for _, status := range o.Statuses {
+		switch status {
+		case STATUS_ONLINE, STATUS_AWAY, STATUS_DND, STATUS_OFFLINE, STATUS_OUT_OF_OFFICE:
+		default:
+			return NewAppError("PresenceWebhook.IsValid", "model.presence_hook.is_valid.statuses.app_error", nil, "id="+o.Id+", status="+status, http.StatusBadRequest)
+		}
+	}
+
+	if o.DebounceSeconds < 0 || o.DebounceSeconds > PRESENCE_WEBHOOK_DEBOUNCE_SECONDS_MAX {
+		return NewAppError("PresenceWebhook.IsValid", "model.presence_hook.is_valid.debounce_seconds.app_error", map[string]interface{}{"Max": PRESENCE_WEBHOOK_DEBOUNCE_SECONDS_MAX}, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (o *PresenceWebhook) PreSave() {
+	if o.Id == "" {
+		o.Id = NewId()
+	}
+
+	o.CreateAt = GetMillis()
+	o.UpdateAt = o.CreateAt
+}
+
+func (o *PresenceWebhook) PreUpdate() {
+	o.UpdateAt = GetMillis()
+}
+
+// WatchesUser reports whether transitions of the given user trigger the webhook.
+func (o *PresenceWebhook) WatchesUser(userId string) bool {
+	for _, id := range o.UserIds {
+		if id == userId {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchesStatus reports whether a transition into the given status passes the status filter of the
+// webhook.
+func (o *PresenceWebhook) MatchesStatus(status string) bool {
+	if len(o.Statuses) == 0 {
+		return true
+	}
+	for _, s := range o.Statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPresenceWebhookJson(t *testing.T) {
+	o := PresenceWebhook{Id: NewId(), UserIds: []string{NewId()}, Statuses: []string{STATUS_ONLINE}}
+	json := o.ToJson()
+	ro := PresenceWebhookFromJson(strings.NewReader(json))
+
+	require.NotNil(t, ro)
+	assert.Equal(t, o.Id, ro.Id)
+	assert.Equal(t, o.UserIds, ro.UserIds)
+	assert.Equal(t, o.Statuses, ro.Statuses)
+}
+
+func TestPresenceWebhookIsValid(t *testing.T) {
+	o := PresenceWebhook{}
+	assert.NotNil(t, o.IsValid(), "empty declaration should be invalid")
+
+	o.Id = NewId()
+	assert.NotNil(t, o.IsValid())
+
+	o.CreateAt = GetMillis()
+	assert.NotNil(t, o.IsValid())
+
+	o.UpdateAt = GetMillis()
+	assert.NotNil(t, o.IsValid())
+
+	o.CreatorId = NewId()
+	assert.NotNil(t, o.IsValid())
+
+	o.CallbackURL = "nowhere.com/"
+	assert.NotNil(t, o.IsValid())
+
+	o.CallbackURL = "http://nowhere.com/"
+	assert.NotNil(t, o.IsValid(), "a webhook without users should be invalid")
+
+	o.UserIds = []string{"123"}
+	assert.NotNil(t, o.IsValid())
+
+	o.UserIds = []string{NewId()}
+	assert.Nil(t, o.IsValid())
+
+	o.Statuses = []string{STATUS_ONLINE, "junk"}
+	assert.NotNil(t, o.IsValid())
+
+	o.Statuses = []string{STATUS_ONLINE, STATUS_OFFLINE}
+	assert.Nil(t, o.IsValid())
+
+	o.DebounceSeconds = -1
+	assert.NotNil(t, o.IsValid())
+
+	o.DebounceSeconds = PRESENCE_WEBHOOK_DEBOUNCE_SECONDS_MAX + 1
+	assert.NotNil(t, o.IsValid())
+
+	o.DebounceSeconds = 30
+	assert.Nil(t, o.IsValid())
+
+	o.DisplayName = strings.Repeat("1", PRESENCE_WEBHOOK_DISPLAY_NAME_MAX_RUNES+1)
+	assert.NotNil(t, o.IsValid())
+
+	o.DisplayName = strings.Repeat("1", PRESENCE_WEBHOOK_DISPLAY_NAME_MAX_RUNES)
+	assert.Nil(t, o.IsValid())
+
+	o.Description = strings.Repeat("1", PRESENCE_WEBHOOK_DESCRIPTION_MAX_RUNES+1)
+	assert.NotNil(t, o.IsValid())
+
+	o.Description = strings.Repeat("1", PRESENCE_WEBHOOK_DESCRIPTION_MAX_RUNES)
+	assert.Nil(t, o.IsValid())
+
+	userIds := make([]string, PRESENCE_WEBHOOK_USER_IDS_MAX+1)
+	for i := range userIds {
+		userIds[i] = NewId()
+	}
+	o.UserIds = userIds
+	assert.NotNil(t, o.IsValid())
+}
+
+func TestPresenceWebhookFilters(t *testing.T) {
+	userId := NewId()
+	o := PresenceWebhook{UserIds: []string{userId}}
+
+	assert.True(t, o.WatchesUser(userId))
+	assert.False(t, o.WatchesUser(NewId()))
+
+	assert.True(t, o.MatchesStatus(STATUS_ONLINE))
+	assert.True(t, o.MatchesStatus(STATUS_AWAY))
+
+	o.Statuses = []string{STATUS_OFFLINE}
+	assert.False(t, o.MatchesStatus(STATUS_ONLINE))
+	assert.True(t, o.MatchesStatus(STATUS_OFFLINE))
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"regexp"
+)
+
+// PERMALINK_PATTERN matches the path of a post permalink, e.g. /myteam/pl/<post id>.
+var PERMALINK_PATTERN = regexp.MustCompile(`^/([a-z0-9\-_]+)/pl/([a-z0-9]{26})/?$`)
+
+// PreviewPost holds the data required to render an embedded preview of a post linked to by a permalink.
+type PreviewPost struct {
+	PostID string `json:"post_id"`
+
+	// Post is the referenced post. It is omitted when the requesting user does not have permission to read it.
+	Post *Post `json:"post,omitempty"`
+
+	TeamName           string `json:"team_name"`
+	ChannelId          string `json:"channel_id"`
+	ChannelDisplayName string `json:"channel_display_name"`
+	ChannelType        string `json:"channel_type"`
+}
+
+func NewPreviewPost(post *Post, team *Team, channel *Channel) *PreviewPost {
+	if post == nil {
+		return nil
+	}
+
+	previewPost := &PreviewPost{
+		PostID:    post.Id,
+		Post:      post,
+		ChannelId: post.ChannelId,
+	}
+
+	if team != nil {
+		previewPost.TeamName = team.Name
+	}
+
+	if channel != nil {
+		previewPost.ChannelDisplayName = channel.DisplayName
+		previewPost.ChannelType = channel.Type
+	}
+
+	return previewPost
+}
+
+// ParsePermalinkPostId returns the id of the post referenced by the given permalink path, or an
+// empty string if the path is not a permalink.
+func ParsePermalinkPostId(path string) string {
+	matches := PERMALINK_PATTERN.FindStringSubmatch(path)
+	if matches == nil {
+		return ""
+	}
+
+	return matches[2]
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPreviewPost(t *testing.T) {
+	post := &Post{Id: NewId(), ChannelId: NewId(), Message: "hello"}
+	team := &Team{Name: "myteam"}
+	channel := &Channel{DisplayName: "Town Square", Type: CHANNEL_OPEN}
+
+	t.Run("should return nil without a post", func(t *testing.T) {
+		assert.Nil(t, NewPreviewPost(nil, team, channel))
+	})
+
+	t.Run("should fill in team and channel information", func(t *testing.T) {
+		previewPost := NewPreviewPost(post, team, channel)
+		require.NotNil(t, previewPost)
+		assert.Equal(t, post.Id, previewPost.PostID)
+		assert.Equal(t, post.ChannelId, previewPost.ChannelId)
+		assert.Equal(t, "myteam", previewPost.TeamName)
+		assert.Equal(t, "Town Square", previewPost.ChannelDisplayName)
+		assert.Equal(t, CHANNEL_OPEN, previewPost.ChannelType)
+	})
+
+	t.Run("should allow a missing team for direct channels", func(t *testing.T) {
+		previewPost := NewPreviewPost(post, nil, &Channel{Type: CHANNEL_DIRECT})
+		require.NotNil(t, previewPost)
+		assert.Equal(t, "", previewPost.TeamName)
+		assert.Equal(t, CHANNEL_DIRECT, previewPost.ChannelType)
+	})
+}
+
+func TestParsePermalinkPostId(t *testing.T) {
+	postId := NewId()
+
+	for name, tc := range map[string]struct {
+		Path     string
+		Expected string
+	}{
+		"permalink":                {Path: "/myteam/pl/" + postId, Expected: postId},
+		"permalink with slash":     {Path: "/myteam/pl/" + postId + "/", Expected: postId},
+		"channel link":             {Path: "/myteam/channels/town-square", Expected: ""},
+		"invalid post id":          {Path: "/myteam/pl/abc", Expected: ""},
+		"missing team name":        {Path: "/pl/" + postId, Expected: ""},
+		"permalink with subfolder": {Path: "/sub/myteam/pl/" + postId, Expected: ""},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.Expected, ParsePermalinkPostId(tc.Path))
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+const (
+	PROFILE_TYPE_CPU       = "cpu"
+	PROFILE_TYPE_HEAP      = "heap"
+	PROFILE_TYPE_GOROUTINE = "goroutine"
+
+	PROFILE_CAPTURE_REASON_MANUAL  = "manual"
+	PROFILE_CAPTURE_REASON_LATENCY = "latency"
+	PROFILE_CAPTURE_REASON_MEMORY  = "memory"
+
+	// PROFILE_CPU_MAX_SECONDS bounds how long a CPU profile samples the server.
+	PROFILE_CPU_MAX_SECONDS = 300
+)
+
+// ProfileCaptureRequest asks for a profile of the server. Seconds only applies to CPU profiles,
+// which sample the server for that long; zero uses ProfilingSettings.CPUProfileSeconds.
+type ProfileCaptureRequest struct {
+	Type    string `json:"type"`
+	Seconds int    `json:"seconds"`
+}
+
+func (r *ProfileCaptureRequest) ToJson() string {
+	b, _ := json.Marshal(r)
+	return string(b)
+}
+
+func ProfileCaptureRequestFromJson(data io.Reader) *ProfileCaptureRequest {
+	var r *ProfileCaptureRequest
+	json.NewDecoder(data).Decode(&r)
+	return r
+}
+
+func IsValidProfileType(profileType string) bool {
+	switch profileType {
+	case PROFILE_TYPE_CPU, PROFILE_TYPE_HEAP, PROFILE_TYPE_GOROUTINE:
+		return true
+	}
+	return false
+}
+
+func (r *ProfileCaptureRequest) IsValid() *AppError {
+	if !IsValidProfileType(r.Type) {
+		return NewAppError("ProfileCaptureRequest.IsValid", "model.profile_capture.is_valid.type.app_error", nil, "type="+r.Type, http.StatusBadRequest)
+	}
+
+	if r.Seconds < 0 || r.Seconds > PROFILE_CPU_MAX_SECONDS {
+		return NewAppError("ProfileCaptureRequest.IsValid", "model.profile_capture.is_valid.seconds.app_error", map[string]interface{}{"Max": PROFILE_CPU_MAX_SECONDS}, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileCaptureRequestIsValid(t *testing.T) {
+	request := &ProfileCaptureRequest{Type: "block"}
+	require.NotNil(t, request.IsValid())
+
+	for _, profileType := range []string{PROFILE_TYPE_CPU, PROFILE_TYPE_HEAP, PROFILE_TYPE_GOROUTINE} {
+		request.Type = profileType
+		require.Nil(t, request.IsValid(), profileType)
+	}
+
+	request.Seconds = -1
+	require.NotNil(t, request.IsValid())
+
+	request.Seconds = PROFILE_CPU_MAX_SECONDS + 1
+	require.NotNil(t, request.IsValid())
+
+	request.Seconds = PROFILE_CPU_MAX_SECONDS
+	require.Nil(t, request.IsValid())
+}
+
+func TestProfileCaptureRequestJson(t *testing.T) {
+	request := &ProfileCaptureRequest{Type: PROFILE_TYPE_CPU, Seconds: 10}
+	assert.Equal(t, request, ProfileCaptureRequestFromJson(strings.NewReader(request.ToJson())))
+}
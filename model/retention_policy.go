@@ -0,0 +1,167 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"unicode/utf8"
+)
+
+const (
+	// RETENTION_POLICY_KEEP_FOREVER disables deletion of posts or files for the channels covered by a policy.
+	RETENTION_POLICY_KEEP_FOREVER = -1
+
+	RETENTION_POLICY_DISPLAY_NAME_MAX_RUNES = 64
+	RETENTION_POLICY_MAX_DURATION_DAYS      = 365 * 100
+	RETENTION_POLICIES_PER_PAGE_DEFAULT     = 60
+)
+
+// RetentionPolicy overrides the global data retention settings for a set of teams and channels.
+// When a channel is covered both directly and through its team, the channel policy wins.
+type RetentionPolicy struct {
+	Id           string   `json:"id"`
+	DisplayName  string   `json:"display_name"`
+	PostDuration int64    `json:"post_duration"`
+	FileDuration int64    `json:"file_duration"`
+	CreateAt     int64    `json:"create_at"`
+	UpdateAt     int64    `json:"update_at"`
+	TeamIds      []string `json:"team_ids" db:"-"`
+	ChannelIds   []string `json:"channel_ids" db:"-"`
+}
+
+// RetentionPolicyTeam associates a team with a retention policy.
+type RetentionPolicyTeam struct {
+	PolicyId string
+	TeamId   string
+}
+
+// RetentionPolicyChannel associates a channel with a retention policy.
+type RetentionPolicyChannel struct {
+	PolicyId  string
+	ChannelId string
+}
+
+// RetentionPolicyCursor is the position reached by a keyset-batched retention deletion, ordered by
+// CreateAt and then Id. The zero value starts from the oldest entry.
+type RetentionPolicyCursor struct {
+	CreateAt int64
+	Id       string
+}
+
+// Clone returns a copy of the policy that doesn't share its team and channel lists.
+func (o *RetentionPolicy) Clone() *RetentionPolicy {
+	pCopy := *o
+	pCopy.TeamIds = append([]string(nil), o.TeamIds...)
+	pCopy.ChannelIds = append([]string(nil), o.ChannelIds...)
+	return &pCopy
+}
+
+// IsValid validates the policy and returns an error if it isn't configured correctly.
+func (o *RetentionPolicy) IsValid() *AppError {
+	if !IsValidId(o.Id) {
+		return NewAppError("RetentionPolicy.IsValid", "model.retention_policy.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("RetentionPolicy.IsValid", "model.retention_policy.is_valid.create_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.UpdateAt == 0 {
+		return NewAppError("RetentionPolicy.IsValid", "model.retention_policy.is_valid.update_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.DisplayName == "" || utf8.RuneCountInString(o.DisplayName) > RETENTION_POLICY_DISPLAY_NAME_MAX_RUNES {
+		return NewAppError("RetentionPolicy.IsValid", "model.retention_policy.is_valid.display_name.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if !isValidRetentionDuration(o.PostDuration) {
+		return NewAppError("RetentionPolicy.IsValid", "model.retention_policy.is_valid.post_duration.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if !isValidRetentionDuration(o.FileDuration) {
+		return NewAppError("RetentionPolicy.IsValid", "model.retention_policy.is_valid.file_duration.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	for _, teamId := range o.TeamIds {
+		if !IsValidId(teamId) {
+			return NewAppError("RetentionPolicy.IsValid", "model.retention_policy.is_valid.team_ids.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+		}
+	}
+
+	for _, channelId := range o.ChannelIds {
+		if !IsValidId(channelId) {
+			return NewAppError("RetentionPolicy.IsValid", "model.retention_policy.is_valid.channel_ids.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+		}
+	}
+
+	return nil
+}
+
+func isValidRetentionDuration(days int64) bool {
+	return days == RETENTION_POLICY_KEEP_FOREVER || (days > 0 && days <= RETENTION_POLICY_MAX_DURATION_DAYS)
+}
+
+// PreSave should be run before saving a new policy to the database.
+func (o *RetentionPolicy) PreSave() {
+	if o.Id == "" {
+		o.Id = NewId()
+	}
+
+	o.CreateAt = GetMillis()
+	o.UpdateAt = o.CreateAt
+	o.normalizeIds()
+}
+
+// PreUpdate should be run before saving an updated policy to the database.
+func (o *RetentionPolicy) PreUpdate() {
+	o.UpdateAt = GetMillis()
+	o.normalizeIds()
+}
+
+func (o *RetentionPolicy) normalizeIds() {
+	o.TeamIds = RemoveDuplicateStrings(o.TeamIds)
+	o.ChannelIds = RemoveDuplicateStrings(o.ChannelIds)
+}
+
+// PostCutoff returns the time before which posts covered by the policy are deleted, or 0 if they are kept forever.
+func (o *RetentionPolicy) PostCutoff(now int64) int64 {
+	return retentionCutoff(now, o.PostDuration)
+}
+
+// FileCutoff returns the time before which files covered by the policy are deleted, or 0 if they are kept forever.
+func (o *RetentionPolicy) FileCutoff(now int64) int64 {
+	return retentionCutoff(now, o.FileDuration)
+}
+
+func retentionCutoff(now int64, days int64) int64 {
+	if days <= 0 {
+		return 0
+	}
+
+	return now - days*24*60*60*1000
+}
+
+func (o *RetentionPolicy) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func RetentionPolicyFromJson(data io.Reader) *RetentionPolicy {
+	var o *RetentionPolicy
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
+
+func RetentionPolicyListToJson(l []*RetentionPolicy) string {
+	b, _ := json.Marshal(l)
+	return string(b)
+}
+
+func RetentionPolicyListFromJson(data io.Reader) []*RetentionPolicy {
+	var o []*RetentionPolicy
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetentionPolicyJson(t *testing.T) {
+	o := &RetentionPolicy{
+		Id:           NewId(),
+		DisplayName:  "legal",
+		PostDuration: 30,
+		FileDuration: RETENTION_POLICY_KEEP_FOREVER,
+		TeamIds:      []string{NewId()},
+		ChannelIds:   []string{NewId(), NewId()},
+	}
+
+	ro := RetentionPolicyFromJson(strings.NewReader(o.ToJson()))
+	require.Equal(t, o, ro)
+
+	l := RetentionPolicyListFromJson(strings.NewReader(RetentionPolicyListToJson([]*RetentionPolicy{o})))
+	require.Len(t, l, 1)
+	require.Equal(t, o, l[0])
+}
+
+func TestRetentionPolicyIsValid(t *testing.T) {
+	valid := func() *RetentionPolicy {
+		o := &RetentionPolicy{
+			DisplayName:  "legal",
+			PostDuration: 30,
+			FileDuration: 10,
+			TeamIds:      []string{NewId()},
+			ChannelIds:   []string{NewId()},
+		}
+		o.PreSave()
+		return o
+	}
+
+	testCases := []struct {
+		Description string
+		Modify      func(o *RetentionPolicy)
+		Valid       bool
+	}{
+		{"valid", func(o *RetentionPolicy) {}, true},
+		{"keep forever", func(o *RetentionPolicy) {
+			o.PostDuration = RETENTION_POLICY_KEEP_FOREVER
+			o.FileDuration = RETENTION_POLICY_KEEP_FOREVER
+		}, true},
+		{"invalid id", func(o *RetentionPolicy) { o.Id = "junk" }, false},
+		{"missing create at", func(o *RetentionPolicy) { o.CreateAt = 0 }, false},
+		{"missing update at", func(o *RetentionPolicy) { o.UpdateAt = 0 }, false},
+		{"empty display name", func(o *RetentionPolicy) { o.DisplayName = "" }, false},
+		{"long display name", func(o *RetentionPolicy) {
+			o.DisplayName = strings.Repeat("a", RETENTION_POLICY_DISPLAY_NAME_MAX_RUNES+1)
+		}, false},
+		{"zero post duration", func(o *RetentionPolicy) { o.PostDuration = 0 }, false},
+		{"negative file duration", func(o *RetentionPolicy) { o.FileDuration = -2 }, false},
+		{"too long post duration", func(o *RetentionPolicy) { o.PostDuration = RETENTION_POLICY_MAX_DURATION_DAYS + 1 }, false},
+		{"invalid team id", func(o *RetentionPolicy) { o.TeamIds = []string{"junk"} }, false},
+		{"invalid channel id", func(o *RetentionPolicy) { o.ChannelIds = []string{""} }, false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Description, func(t *testing.T) {
+			o := valid()
+			testCase.Modify(o)
+			if testCase.Valid {
+				assert.Nil(t, o.IsValid())
+			} else {
+				assert.NotNil(t, o.IsValid())
+			}
+		})
+	}
+}
+
+func TestRetentionPolicyCutoffs(t *testing.T) {
+	o := &RetentionPolicy{PostDuration: 2, FileDuration: RETENTION_POLICY_KEEP_FOREVER}
+
+	now := GetMillis()
+	assert.Equal(t, now-2*24*60*60*1000, o.PostCutoff(now))
+	assert.Equal(t, int64(0), o.FileCutoff(now))
+}
+
+func TestRetentionPolicyPreSaveRemovesDuplicates(t *testing.T) {
+	teamId := NewId()
+	o := &RetentionPolicy{TeamIds: []string{teamId, teamId}}
+	o.PreSave()
+
+	assert.Equal(t, []string{teamId}, o.TeamIds)
+	assert.Equal(t, []string{}, o.ChannelIds)
+}
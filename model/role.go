@@ -19,6 +19,9 @@ func init() {
 		SYSTEM_POST_ALL_ROLE_ID,
 		SYSTEM_POST_ALL_PUBLIC_ROLE_ID,
 		SYSTEM_USER_ACCESS_TOKEN_ROLE_ID,
+		SYSTEM_USER_MANAGER_ROLE_ID,
+		SYSTEM_READ_ONLY_ADMIN_ROLE_ID,
+		SYSTEM_MANAGER_ROLE_ID,
 
 		TEAM_GUEST_ROLE_ID,
 		TEAM_USER_ROLE_ID,
@@ -42,6 +45,9 @@ const (
 	SYSTEM_POST_ALL_ROLE_ID          = "system_post_all"
 	SYSTEM_POST_ALL_PUBLIC_ROLE_ID   = "system_post_all_public"
 	SYSTEM_USER_ACCESS_TOKEN_ROLE_ID = "system_user_access_token"
+	SYSTEM_USER_MANAGER_ROLE_ID      = "system_user_manager"
+	SYSTEM_READ_ONLY_ADMIN_ROLE_ID   = "system_read_only_admin"
+	SYSTEM_MANAGER_ROLE_ID           = "system_manager"
 
 	TEAM_GUEST_ROLE_ID           = "team_guest"
 	TEAM_USER_ROLE_ID            = "team_user"
@@ -393,6 +399,42 @@ func IsValidRoleName(roleName string) bool {
 	return true
 }
 
+// IsRoleGrantExpired returns true if a role grant expiring at expiresAt has lapsed by now. Grants
+// without an expiry never lapse.
+func IsRoleGrantExpired(expiresAt int64, now int64) bool {
+	return expiresAt > 0 && expiresAt <= now
+}
+
+// RemoveExpiredSystemRoles returns the system roles left by now of a grant expiring at expiresAt.
+// Once it lapses, only the system_user and system_guest roles are left, since every other system
+// role was granted for a limited time.
+func RemoveExpiredSystemRoles(roles string, expiresAt int64, now int64) string {
+	if !IsRoleGrantExpired(expiresAt, now) {
+		return roles
+	}
+
+	var kept []string
+	for _, role := range strings.Fields(roles) {
+		if role == SYSTEM_USER_ROLE_ID || role == SYSTEM_GUEST_ROLE_ID {
+			kept = append(kept, role)
+		}
+	}
+	return strings.Join(kept, " ")
+}
+
+// RemoveRoles returns the roles that are not in removed, keeping their order.
+func RemoveRoles(roles []string, removed []string) []string {
+	removedSet := AsStringBoolMap(removed)
+
+	var result []string
+	for _, role := range roles {
+		if !removedSet[role] {
+			result = append(result, role)
+		}
+	}
+	return result
+}
+
 func MakeDefaultRoles() map[string]*Role {
 	roles := make(map[string]*Role)
 
@@ -405,6 +447,7 @@ func MakeDefaultRoles() map[string]*Role {
 			PERMISSION_ADD_REACTION.Id,
 			PERMISSION_REMOVE_REACTION.Id,
 			PERMISSION_UPLOAD_FILE.Id,
+			PERMISSION_DOWNLOAD_FILE.Id,
 			PERMISSION_EDIT_POST.Id,
 			PERMISSION_CREATE_POST.Id,
 			PERMISSION_USE_CHANNEL_MENTIONS.Id,
@@ -424,8 +467,10 @@ func MakeDefaultRoles() map[string]*Role {
 			PERMISSION_REMOVE_REACTION.Id,
 			PERMISSION_MANAGE_PUBLIC_CHANNEL_MEMBERS.Id,
 			PERMISSION_UPLOAD_FILE.Id,
+			PERMISSION_DOWNLOAD_FILE.Id,
 			PERMISSION_GET_PUBLIC_LINK.Id,
 			PERMISSION_CREATE_POST.Id,
+			PERMISSION_CREATE_URGENT_POST.Id,
 			PERMISSION_USE_CHANNEL_MENTIONS.Id,
 			PERMISSION_USE_SLASH_COMMANDS.Id,
 		},
@@ -579,6 +624,46 @@ func MakeDefaultRoles() map[string]*Role {
 		BuiltIn:       true,
 	}
 
+	// The following roles give access to parts of the system console without making
+	// the user a system admin.
+	roles[SYSTEM_USER_MANAGER_ROLE_ID] = &Role{
+		Name:        "system_user_manager",
+		DisplayName: "authentication.roles.system_user_manager.name",
+		Description: "authentication.roles.system_user_manager.description",
+		Permissions: []string{
+			PERMISSION_SYSCONSOLE_READ_USER_MANAGEMENT.Id,
+			PERMISSION_SYSCONSOLE_WRITE_USER_MANAGEMENT.Id,
+		},
+		SchemeManaged: false,
+		BuiltIn:       true,
+	}
+
+	roles[SYSTEM_READ_ONLY_ADMIN_ROLE_ID] = &Role{
+		Name:        "system_read_only_admin",
+		DisplayName: "authentication.roles.system_read_only_admin.name",
+		Description: "authentication.roles.system_read_only_admin.description",
+		Permissions: []string{
+			PERMISSION_SYSCONSOLE_READ_USER_MANAGEMENT.Id,
+			PERMISSION_SYSCONSOLE_READ_COMPLIANCE.Id,
+			PERMISSION_SYSCONSOLE_READ_INTEGRATIONS.Id,
+		},
+		SchemeManaged: false,
+		BuiltIn:       true,
+	}
+
+	roles[SYSTEM_MANAGER_ROLE_ID] = &Role{
+		Name:        "system_manager",
+		DisplayName: "authentication.roles.system_manager.name",
+		Description: "authentication.roles.system_manager.description",
+		Permissions: []string{
+			PERMISSION_SYSCONSOLE_READ_USER_MANAGEMENT.Id,
+			PERMISSION_SYSCONSOLE_READ_INTEGRATIONS.Id,
+			PERMISSION_SYSCONSOLE_WRITE_INTEGRATIONS.Id,
+		},
+		SchemeManaged: false,
+		BuiltIn:       true,
+	}
+
 	roles[SYSTEM_ADMIN_ROLE_ID] = &Role{
 		Name:        "system_admin",
 		DisplayName: "authentication.roles.global_admin.name",
@@ -612,6 +697,7 @@ func MakeDefaultRoles() map[string]*Role {
 							PERMISSION_INVITE_GUEST.Id,
 							PERMISSION_PROMOTE_GUEST.Id,
 							PERMISSION_DEMOTE_TO_GUEST.Id,
+							PERMISSION_IMPERSONATE_USER.Id,
 							PERMISSION_DELETE_POST.Id,
 							PERMISSION_DELETE_OTHERS_POSTS.Id,
 							PERMISSION_CREATE_TEAM.Id,
@@ -632,6 +718,11 @@ func MakeDefaultRoles() map[string]*Role {
 							PERMISSION_LIST_PRIVATE_TEAMS.Id,
 							PERMISSION_JOIN_PRIVATE_TEAMS.Id,
 							PERMISSION_VIEW_MEMBERS.Id,
+							PERMISSION_SYSCONSOLE_READ_USER_MANAGEMENT.Id,
+							PERMISSION_SYSCONSOLE_WRITE_USER_MANAGEMENT.Id,
+							PERMISSION_SYSCONSOLE_READ_COMPLIANCE.Id,
+							PERMISSION_SYSCONSOLE_READ_INTEGRATIONS.Id,
+							PERMISSION_SYSCONSOLE_WRITE_INTEGRATIONS.Id,
 						},
 						roles[TEAM_USER_ROLE_ID].Permissions...,
 					),
@@ -0,0 +1,113 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+	"math"
+)
+
+const (
+	SEAT_USAGE_DAY_MILLIS        = 24 * 60 * 60 * 1000
+	SEAT_USAGE_DEFAULT_DAYS      = 30
+	SEAT_USAGE_MAX_DAYS          = 365
+	SEAT_USAGE_MIN_FORECAST_DAYS = 2
+)
+
+// SeatUsagePoint is the number of licensed seats in use at the end of a day, the day being given
+// as the UTC midnight that starts it.
+type SeatUsagePoint struct {
+	Day   int64 `json:"day"`
+	Seats int64 `json:"seats"`
+}
+
+// SeatUsageForecast is the seat consumption history of the server along with the trend fitted to
+// it, and the day the licensed seat count is projected to be exceeded if the trend holds.
+type SeatUsageForecast struct {
+	LicensedSeats int64             `json:"licensed_seats"`
+	CurrentSeats  int64             `json:"current_seats"`
+	DailyGrowth   float64           `json:"daily_growth"`
+	Exceeded      bool              `json:"exceeded"`
+	ProjectedAt   int64             `json:"projected_at"`
+	History       []*SeatUsagePoint `json:"history"`
+}
+
+// NewSeatUsageForecast fits a least squares line to the daily seat counts of history, sorted by
+// day, and projects the first day the seats in use go over licensedSeats. ProjectedAt is left at 0
+// when there is no license limit, when the usage is not growing or when the history is too short
+// to tell.
+func NewSeatUsageForecast(history []*SeatUsagePoint, licensedSeats int64) *SeatUsageForecast {
+	forecast := &SeatUsageForecast{
+		LicensedSeats: licensedSeats,
+		History:       history,
+	}
+
+	if len(history) == 0 {
+		return forecast
+	}
+
+	last := history[len(history)-1]
+	forecast.CurrentSeats = last.Seats
+
+	if len(history) >= SEAT_USAGE_MIN_FORECAST_DAYS {
+		forecast.DailyGrowth = seatUsageSlope(history)
+	}
+
+	if licensedSeats <= 0 {
+		return forecast
+	}
+
+	if last.Seats > licensedSeats {
+		forecast.Exceeded = true
+		forecast.ProjectedAt = last.Day
+		return forecast
+	}
+
+	if forecast.DailyGrowth <= 0 {
+		return forecast
+	}
+
+	days := math.Ceil(float64(licensedSeats+1-last.Seats) / forecast.DailyGrowth)
+	forecast.ProjectedAt = last.Day + int64(days)*SEAT_USAGE_DAY_MILLIS
+
+	return forecast
+}
+
+func seatUsageSlope(history []*SeatUsagePoint) float64 {
+	n := float64(len(history))
+	var sumX, sumY, sumXY, sumXX float64
+	for _, point := range history {
+		x := float64(point.Day-history[0].Day) / SEAT_USAGE_DAY_MILLIS
+		y := float64(point.Seats)
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+
+	denominator := n*sumXX - sumX*sumX
+	if denominator == 0 {
+		return 0
+	}
+
+	return (n*sumXY - sumX*sumY) / denominator
+}
+
+// IsProjectedWithin reports whether the licensed seat count is exceeded or projected to be
+// exceeded before the given time.
+func (f *SeatUsageForecast) IsProjectedWithin(before int64) bool {
+	return f.Exceeded || (f.ProjectedAt > 0 && f.ProjectedAt <= before)
+}
+
+func (f *SeatUsageForecast) ToJson() string {
+	b, _ := json.Marshal(f)
+	return string(b)
+}
+
+func SeatUsageForecastFromJson(data io.Reader) *SeatUsageForecast {
+	var f *SeatUsageForecast
+	json.NewDecoder(data).Decode(&f)
+	return f
+}
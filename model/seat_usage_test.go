@@ -0,0 +1,84 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func seatUsageHistory(start int64, seats ...int64) []*SeatUsagePoint {
+	history := make([]*SeatUsagePoint, len(seats))
+	for i, count := range seats {
+		history[i] = &SeatUsagePoint{Day: start + int64(i)*SEAT_USAGE_DAY_MILLIS, Seats: count}
+	}
+	return history
+}
+
+func TestNewSeatUsageForecast(t *testing.T) {
+	start := int64(1600000000000) / SEAT_USAGE_DAY_MILLIS * SEAT_USAGE_DAY_MILLIS
+
+	t.Run("empty history", func(t *testing.T) {
+		forecast := NewSeatUsageForecast(nil, 100)
+		assert.Equal(t, int64(0), forecast.CurrentSeats)
+		assert.Equal(t, int64(0), forecast.ProjectedAt)
+		assert.False(t, forecast.Exceeded)
+	})
+
+	t.Run("linear growth", func(t *testing.T) {
+		history := seatUsageHistory(start, 10, 12, 14, 16, 18)
+		forecast := NewSeatUsageForecast(history, 25)
+		assert.Equal(t, int64(18), forecast.CurrentSeats)
+		assert.InDelta(t, 2.0, forecast.DailyGrowth, 0.0001)
+		assert.False(t, forecast.Exceeded)
+
+		// 26 seats are reached 4 days after the last point.
+		assert.Equal(t, history[4].Day+4*SEAT_USAGE_DAY_MILLIS, forecast.ProjectedAt)
+		assert.True(t, forecast.IsProjectedWithin(history[4].Day+4*SEAT_USAGE_DAY_MILLIS))
+		assert.False(t, forecast.IsProjectedWithin(history[4].Day+3*SEAT_USAGE_DAY_MILLIS))
+	})
+
+	t.Run("flat or shrinking usage", func(t *testing.T) {
+		forecast := NewSeatUsageForecast(seatUsageHistory(start, 20, 20, 20), 25)
+		assert.Equal(t, 0.0, forecast.DailyGrowth)
+		assert.Equal(t, int64(0), forecast.ProjectedAt)
+
+		forecast = NewSeatUsageForecast(seatUsageHistory(start, 22, 21, 20), 25)
+		assert.True(t, forecast.DailyGrowth < 0)
+		assert.Equal(t, int64(0), forecast.ProjectedAt)
+		assert.False(t, forecast.IsProjectedWithin(start+SEAT_USAGE_MAX_DAYS*SEAT_USAGE_DAY_MILLIS))
+	})
+
+	t.Run("already exceeded", func(t *testing.T) {
+		history := seatUsageHistory(start, 24, 26)
+		forecast := NewSeatUsageForecast(history, 25)
+		assert.True(t, forecast.Exceeded)
+		assert.Equal(t, history[1].Day, forecast.ProjectedAt)
+		assert.True(t, forecast.IsProjectedWithin(0))
+	})
+
+	t.Run("no license limit", func(t *testing.T) {
+		forecast := NewSeatUsageForecast(seatUsageHistory(start, 10, 20), 0)
+		assert.InDelta(t, 10.0, forecast.DailyGrowth, 0.0001)
+		assert.False(t, forecast.Exceeded)
+		assert.Equal(t, int64(0), forecast.ProjectedAt)
+	})
+
+	t.Run("single day", func(t *testing.T) {
+		forecast := NewSeatUsageForecast(seatUsageHistory(start, 10), 25)
+		assert.Equal(t, 0.0, forecast.DailyGrowth)
+		assert.Equal(t, int64(0), forecast.ProjectedAt)
+	})
+}
+
+func TestSeatUsageForecastJson(t *testing.T) {
+	forecast := NewSeatUsageForecast(seatUsageHistory(86400000, 1, 2, 3), 10)
+
+	result := SeatUsageForecastFromJson(strings.NewReader(forecast.ToJson()))
+	require.NotNil(t, result)
+	assert.Equal(t, forecast, result)
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"unicode/utf8"
+)
+
+// ServiceAccount describes a service account to create. Service accounts are users for CI systems
+// and other automation that, unlike bots, have no owner. They can't log in interactively and only
+// authenticate with user access tokens.
+type ServiceAccount struct {
+	Username    string `json:"username"`
+	DisplayName string `json:"display_name"`
+}
+
+func (o *ServiceAccount) IsValid() *AppError {
+	if !IsValidUsername(o.Username) {
+		return NewAppError("ServiceAccount.IsValid", "model.service_account.is_valid.username.app_error", nil, "username="+o.Username, http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(o.DisplayName) > USER_FIRST_NAME_MAX_RUNES {
+		return NewAppError("ServiceAccount.IsValid", "model.service_account.is_valid.display_name.app_error", nil, "username="+o.Username, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// ToUser returns the user backing the service account. The user has no password, and a
+// placeholder email address since nothing is ever sent to it.
+func (o *ServiceAccount) ToUser() *User {
+	return &User{
+		Username:         o.Username,
+		Email:            NormalizeEmail(fmt.Sprintf("%s@localhost", o.Username)),
+		EmailVerified:    true,
+		FirstName:        o.DisplayName,
+		Roles:            SYSTEM_USER_ROLE_ID,
+		IsServiceAccount: true,
+	}
+}
+
+func (o *ServiceAccount) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func ServiceAccountFromJson(data io.Reader) *ServiceAccount {
+	var o *ServiceAccount
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
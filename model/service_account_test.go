@@ -0,0 +1,37 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceAccountIsValid(t *testing.T) {
+	require.Nil(t, (&ServiceAccount{Username: "ci-runner", DisplayName: "CI Runner"}).IsValid())
+	require.NotNil(t, (&ServiceAccount{Username: ""}).IsValid())
+	require.NotNil(t, (&ServiceAccount{Username: "not valid"}).IsValid())
+	require.NotNil(t, (&ServiceAccount{Username: "CI-Runner"}).IsValid())
+	require.NotNil(t, (&ServiceAccount{Username: "ci-runner", DisplayName: strings.Repeat("a", USER_FIRST_NAME_MAX_RUNES+1)}).IsValid())
+}
+
+func TestServiceAccountToUser(t *testing.T) {
+	user := (&ServiceAccount{Username: "ci-runner", DisplayName: "CI Runner"}).ToUser()
+
+	assert.Equal(t, "ci-runner", user.Username)
+	assert.Equal(t, "ci-runner@localhost", user.Email)
+	assert.Equal(t, "CI Runner", user.FirstName)
+	assert.Equal(t, SYSTEM_USER_ROLE_ID, user.Roles)
+	assert.True(t, user.IsServiceAccount)
+	assert.True(t, user.EmailVerified)
+	assert.Empty(t, user.Password)
+}
+
+func TestServiceAccountJson(t *testing.T) {
+	account := &ServiceAccount{Username: "ci-runner", DisplayName: "CI Runner"}
+	assert.Equal(t, account, ServiceAccountFromJson(strings.NewReader(account.ToJson())))
+}
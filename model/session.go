@@ -25,7 +25,17 @@ const (
 	SESSION_PROP_IS_BOT               = "is_bot"
 	SESSION_PROP_IS_BOT_VALUE         = "true"
 	SESSION_TYPE_USER_ACCESS_TOKEN    = "UserAccessToken"
+	SESSION_TYPE_IMPERSONATION        = "Impersonation"
+	SESSION_PROP_IMPERSONATOR_ID      = "impersonator_id"
+	SESSION_PROP_IMPERSONATION_WRITE  = "impersonation_write_access"
 	SESSION_PROP_IS_GUEST             = "is_guest"
+	SESSION_TYPE_EMBED                = "Embed"
+	SESSION_PROP_EMBED_APP_ID         = "embed_app_id"
+	SESSION_PROP_EMBED_CHANNEL_ID     = "embed_channel_id"
+	SESSION_PROP_ROLES_EXPIRES_AT     = "roles_expires_at"
+	SESSION_PROP_CSRF_PREVIOUS        = "csrf_previous"
+	SESSION_PROP_CSRF_ROTATED_AT      = "csrf_rotated_at"
+	SESSION_CSRF_ROTATION_GRACE       = 1000 * 60 * 5 // 5 minutes
 	SESSION_ACTIVITY_TIMEOUT          = 1000 * 60 * 5 // 5 minutes
 	SESSION_USER_ACCESS_TOKEN_EXPIRY  = 100 * 365     // 100 years
 )
@@ -176,8 +186,57 @@ func (me *Session) IsSSOLogin() bool {
 	return me.IsOAuth || me.IsSaml()
 }
 
+// IsImpersonated returns true if the session was created by another user to act as its user.
+func (me *Session) IsImpersonated() bool {
+	return me.Props[SESSION_PROP_TYPE] == SESSION_TYPE_IMPERSONATION && me.Props[SESSION_PROP_IMPERSONATOR_ID] != ""
+}
+
+// ImpersonatorId returns the id of the user acting through an impersonated session, if any.
+func (me *Session) ImpersonatorId() string {
+	if !me.IsImpersonated() {
+		return ""
+	}
+	return me.Props[SESSION_PROP_IMPERSONATOR_ID]
+}
+
+// IsImpersonationReadOnly returns true if the session is impersonated without write access.
+func (me *Session) IsImpersonationReadOnly() bool {
+	return me.IsImpersonated() && me.Props[SESSION_PROP_IMPERSONATION_WRITE] != "true"
+}
+
+// IsEmbedded returns true if the session was exchanged for an embed token, and is restricted to
+// the channel the token was minted for.
+func (me *Session) IsEmbedded() bool {
+	return me.Props[SESSION_PROP_TYPE] == SESSION_TYPE_EMBED && me.Props[SESSION_PROP_EMBED_CHANNEL_ID] != ""
+}
+
+// EmbedChannelId returns the id of the channel an embedded session is restricted to, if any.
+func (me *Session) EmbedChannelId() string {
+	if !me.IsEmbedded() {
+		return ""
+	}
+	return me.Props[SESSION_PROP_EMBED_CHANNEL_ID]
+}
+
+// GetUserRoles returns the system roles of the session, leaving out the ones whose grant expired.
 func (me *Session) GetUserRoles() []string {
-	return strings.Fields(me.Roles)
+	return strings.Fields(RemoveExpiredSystemRoles(me.Roles, me.GetRolesExpiresAt(), GetMillis()))
+}
+
+// SetRolesExpiresAt records when the system roles of the session beyond system_user and
+// system_guest expire, mirroring User.RolesExpiresAt. Zero removes the expiry.
+func (me *Session) SetRolesExpiresAt(expiresAt int64) {
+	if expiresAt <= 0 {
+		delete(me.Props, SESSION_PROP_ROLES_EXPIRES_AT)
+		return
+	}
+	me.AddProp(SESSION_PROP_ROLES_EXPIRES_AT, strconv.FormatInt(expiresAt, 10))
+}
+
+// GetRolesExpiresAt returns when the system roles of the session expire, or 0 if they don't.
+func (me *Session) GetRolesExpiresAt() int64 {
+	expiresAt, _ := strconv.ParseInt(me.Props[SESSION_PROP_ROLES_EXPIRES_AT], 10, 64)
+	return expiresAt
 }
 
 func (me *Session) GenerateCSRF() string {
@@ -194,6 +253,35 @@ func (me *Session) GetCSRF() string {
 	return me.Props["csrf"]
 }
 
+// RotateCSRF replaces the CSRF token of the session. The previous token is still accepted for
+// SESSION_CSRF_ROTATION_GRACE, giving clients time to pick up the new one.
+func (me *Session) RotateCSRF() string {
+	if previous := me.GetCSRF(); previous != "" {
+		me.AddProp(SESSION_PROP_CSRF_PREVIOUS, previous)
+		me.AddProp(SESSION_PROP_CSRF_ROTATED_AT, strconv.FormatInt(GetMillis(), 10))
+	}
+	return me.GenerateCSRF()
+}
+
+// IsValidCSRF reports whether the token is the CSRF token of the session, or its previous token
+// within the grace period following a rotation.
+func (me *Session) IsValidCSRF(token string, now int64) bool {
+	if token == me.GetCSRF() {
+		return true
+	}
+
+	if token == "" || token != me.Props[SESSION_PROP_CSRF_PREVIOUS] {
+		return false
+	}
+
+	rotatedAt, err := strconv.ParseInt(me.Props[SESSION_PROP_CSRF_ROTATED_AT], 10, 64)
+	if err != nil {
+		return false
+	}
+
+	return now-rotatedAt < SESSION_CSRF_ROTATION_GRACE
+}
+
 func SessionsToJson(o []*Session) string {
 	if b, err := json.Marshal(o); err != nil {
 		return "[]"
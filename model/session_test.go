@@ -60,6 +60,22 @@ func TestSessionJson(t *testing.T) {
 	session.SetExpireInDays(10)
 }
 
+func TestSessionRolesExpiry(t *testing.T) {
+	s := Session{Roles: "system_user system_admin"}
+	assert.Equal(t, int64(0), s.GetRolesExpiresAt())
+	assert.Equal(t, []string{"system_user", "system_admin"}, s.GetUserRoles())
+
+	s.SetRolesExpiresAt(GetMillis() + 60000)
+	assert.Equal(t, []string{"system_user", "system_admin"}, s.GetUserRoles())
+
+	s.SetRolesExpiresAt(GetMillis() - 1)
+	assert.Equal(t, []string{"system_user"}, s.GetUserRoles())
+
+	s.SetRolesExpiresAt(0)
+	assert.NotContains(t, s.Props, SESSION_PROP_ROLES_EXPIRES_AT)
+	assert.Equal(t, []string{"system_user", "system_admin"}, s.GetUserRoles())
+}
+
 func TestSessionCSRF(t *testing.T) {
 	s := Session{}
 	token := s.GetCSRF()
@@ -71,4 +87,66 @@ func TestSessionCSRF(t *testing.T) {
 	token2 := s.GetCSRF()
 	assert.NotEmpty(t, token2)
 	assert.Equal(t, token, token2)
+
+	t.Run("rotation", func(t *testing.T) {
+		s := Session{}
+		previous := s.GenerateCSRF()
+		now := GetMillis()
+
+		token := s.RotateCSRF()
+		assert.NotEqual(t, previous, token)
+		assert.Equal(t, token, s.GetCSRF())
+
+		assert.True(t, s.IsValidCSRF(token, now))
+		assert.True(t, s.IsValidCSRF(previous, now), "previous token accepted within the grace period")
+		assert.False(t, s.IsValidCSRF(previous, now+SESSION_CSRF_ROTATION_GRACE+1000), "previous token rejected after the grace period")
+		assert.False(t, s.IsValidCSRF("", now))
+		assert.False(t, s.IsValidCSRF(NewId(), now))
+
+		s.RotateCSRF()
+		assert.False(t, s.IsValidCSRF(previous, now), "only the last previous token is accepted")
+		assert.True(t, s.IsValidCSRF(token, now))
+	})
+}
+
+func TestSessionImpersonation(t *testing.T) {
+	s := Session{}
+	assert.False(t, s.IsImpersonated())
+	assert.False(t, s.IsImpersonationReadOnly())
+	assert.Empty(t, s.ImpersonatorId())
+
+	impersonatorId := NewId()
+	s.AddProp(SESSION_PROP_TYPE, SESSION_TYPE_IMPERSONATION)
+	s.AddProp(SESSION_PROP_IMPERSONATOR_ID, impersonatorId)
+	assert.True(t, s.IsImpersonated())
+	assert.True(t, s.IsImpersonationReadOnly())
+	assert.Equal(t, impersonatorId, s.ImpersonatorId())
+
+	s.AddProp(SESSION_PROP_IMPERSONATION_WRITE, "true")
+	assert.True(t, s.IsImpersonated())
+	assert.False(t, s.IsImpersonationReadOnly())
+
+	// The impersonator prop alone doesn't make a session impersonated.
+	s.AddProp(SESSION_PROP_TYPE, SESSION_TYPE_USER_ACCESS_TOKEN)
+	assert.False(t, s.IsImpersonated())
+	assert.Empty(t, s.ImpersonatorId())
+}
+
+func TestSessionEmbedded(t *testing.T) {
+	s := Session{}
+	assert.False(t, s.IsEmbedded())
+	assert.Empty(t, s.EmbedChannelId())
+
+	channelId := NewId()
+	s.AddProp(SESSION_PROP_TYPE, SESSION_TYPE_EMBED)
+	assert.False(t, s.IsEmbedded())
+
+	s.AddProp(SESSION_PROP_EMBED_CHANNEL_ID, channelId)
+	assert.True(t, s.IsEmbedded())
+	assert.Equal(t, channelId, s.EmbedChannelId())
+
+	// The channel prop alone doesn't make a session embedded.
+	s.AddProp(SESSION_PROP_TYPE, SESSION_TYPE_USER_ACCESS_TOKEN)
+	assert.False(t, s.IsEmbedded())
+	assert.Empty(t, s.EmbedChannelId())
 }
@@ -0,0 +1,55 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+)
+
+const (
+	SLUG_HISTORY_KIND_TEAM    = "team"
+	SLUG_HISTORY_KIND_CHANNEL = "channel"
+)
+
+// SlugHistory records a name given up by a renamed team or channel, so that links using the old name
+// keep resolving to it. Channel names are scoped to the team of the channel, team names are global and
+// have an empty ScopeId.
+type SlugHistory struct {
+	Kind     string `json:"kind"`
+	ScopeId  string `json:"scope_id"`
+	Name     string `json:"name"`
+	TargetId string `json:"target_id"`
+	// CreateAt is the time the name was given up.
+	CreateAt int64 `json:"create_at"`
+}
+
+func (o *SlugHistory) IsValid() *AppError {
+	if o.Kind != SLUG_HISTORY_KIND_TEAM && o.Kind != SLUG_HISTORY_KIND_CHANNEL {
+		return NewAppError("SlugHistory.IsValid", "model.slug_history.is_valid.kind.app_error", nil, "kind="+o.Kind, http.StatusBadRequest)
+	}
+
+	if (o.Kind == SLUG_HISTORY_KIND_TEAM && o.ScopeId != "") || (o.Kind == SLUG_HISTORY_KIND_CHANNEL && !IsValidId(o.ScopeId)) {
+		return NewAppError("SlugHistory.IsValid", "model.slug_history.is_valid.scope_id.app_error", nil, "scope_id="+o.ScopeId, http.StatusBadRequest)
+	}
+
+	if o.Name == "" || len(o.Name) > CHANNEL_NAME_MAX_LENGTH {
+		return NewAppError("SlugHistory.IsValid", "model.slug_history.is_valid.name.app_error", nil, "name="+o.Name, http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.TargetId) {
+		return NewAppError("SlugHistory.IsValid", "model.slug_history.is_valid.target_id.app_error", nil, "target_id="+o.TargetId, http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("SlugHistory.IsValid", "model.slug_history.is_valid.create_at.app_error", nil, "name="+o.Name, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (o *SlugHistory) PreSave() {
+	if o.CreateAt == 0 {
+		o.CreateAt = GetMillis()
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlugHistoryIsValid(t *testing.T) {
+	o := SlugHistory{}
+	assert.NotNil(t, o.IsValid(), "empty declaration should be invalid")
+
+	o.Kind = "junk"
+	assert.NotNil(t, o.IsValid())
+
+	o.Kind = SLUG_HISTORY_KIND_CHANNEL
+	assert.NotNil(t, o.IsValid(), "a channel name should be scoped to a team")
+
+	o.ScopeId = NewId()
+	assert.NotNil(t, o.IsValid())
+
+	o.Name = strings.Repeat("a", CHANNEL_NAME_MAX_LENGTH+1)
+	assert.NotNil(t, o.IsValid())
+
+	o.Name = "old-name"
+	assert.NotNil(t, o.IsValid())
+
+	o.TargetId = NewId()
+	assert.NotNil(t, o.IsValid())
+
+	o.PreSave()
+	assert.Nil(t, o.IsValid())
+
+	o.Kind = SLUG_HISTORY_KIND_TEAM
+	assert.NotNil(t, o.IsValid(), "a team name should not be scoped")
+
+	o.ScopeId = ""
+	assert.Nil(t, o.IsValid())
+}
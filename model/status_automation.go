@@ -0,0 +1,90 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"unicode/utf8"
+)
+
+const (
+	STATUS_AUTOMATION_SOURCE_MAX_RUNES = 64
+)
+
+// StatusAutomation records that an integration, such as a calendar connector, set the status of a
+// user to do not disturb until EndAt, along with the status to restore at that time. The record
+// is dropped as soon as the user changes their status manually, so that the manual change wins.
+type StatusAutomation struct {
+	UserId         string `json:"user_id"`
+	Source         string `json:"source"`
+	PreviousStatus string `json:"previous_status"`
+	PreviousManual bool   `json:"previous_manual"`
+	StartAt        int64  `json:"start_at"`
+	EndAt          int64  `json:"end_at"`
+}
+
+// IsValid validates the automation and returns an error if it isn't configured correctly.
+func (o *StatusAutomation) IsValid() *AppError {
+	if !IsValidId(o.UserId) {
+		return NewAppError("StatusAutomation.IsValid", "model.status_automation.is_valid.user_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if o.Source == "" || utf8.RuneCountInString(o.Source) > STATUS_AUTOMATION_SOURCE_MAX_RUNES {
+		return NewAppError("StatusAutomation.IsValid", "model.status_automation.is_valid.source.app_error", nil, "user_id="+o.UserId, http.StatusBadRequest)
+	}
+
+	switch o.PreviousStatus {
+	case STATUS_ONLINE, STATUS_AWAY, STATUS_OFFLINE, STATUS_DND, STATUS_OUT_OF_OFFICE:
+	default:
+		return NewAppError("StatusAutomation.IsValid", "model.status_automation.is_valid.previous_status.app_error", nil, "user_id="+o.UserId, http.StatusBadRequest)
+	}
+
+	if o.StartAt == 0 {
+		return NewAppError("StatusAutomation.IsValid", "model.status_automation.is_valid.start_at.app_error", nil, "user_id="+o.UserId, http.StatusBadRequest)
+	}
+
+	if o.EndAt <= o.StartAt {
+		return NewAppError("StatusAutomation.IsValid", "model.status_automation.is_valid.end_at.app_error", nil, "user_id="+o.UserId, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// PreSave should be run before saving a new automation to the database.
+func (o *StatusAutomation) PreSave() {
+	if o.StartAt == 0 {
+		o.StartAt = GetMillis()
+	}
+}
+
+func (o *StatusAutomation) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func StatusAutomationFromJson(data io.Reader) *StatusAutomation {
+	var o *StatusAutomation
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
+
+// StatusAutomationRequest is sent by integrations to set the status of a user to do not disturb
+// until EndAt.
+type StatusAutomationRequest struct {
+	Source string `json:"source"`
+	EndAt  int64  `json:"end_at"`
+}
+
+func (o *StatusAutomationRequest) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func StatusAutomationRequestFromJson(data io.Reader) *StatusAutomationRequest {
+	var o *StatusAutomationRequest
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusAutomationJson(t *testing.T) {
+	o := &StatusAutomation{
+		UserId:         NewId(),
+		Source:         "calendar",
+		PreviousStatus: STATUS_AWAY,
+		PreviousManual: true,
+		StartAt:        GetMillis(),
+		EndAt:          GetMillis() + 1000,
+	}
+
+	ro := StatusAutomationFromJson(strings.NewReader(o.ToJson()))
+	require.Equal(t, o, ro)
+
+	request := &StatusAutomationRequest{Source: "calendar", EndAt: GetMillis()}
+	require.Equal(t, request, StatusAutomationRequestFromJson(strings.NewReader(request.ToJson())))
+}
+
+func TestStatusAutomationIsValid(t *testing.T) {
+	valid := func() *StatusAutomation {
+		o := &StatusAutomation{
+			UserId:         NewId(),
+			Source:         "calendar",
+			PreviousStatus: STATUS_ONLINE,
+			EndAt:          GetMillis() + 1000,
+		}
+		o.PreSave()
+		return o
+	}
+
+	require.Nil(t, valid().IsValid())
+
+	o := valid()
+	o.UserId = "invalid"
+	require.NotNil(t, o.IsValid())
+
+	o = valid()
+	o.Source = ""
+	require.NotNil(t, o.IsValid())
+
+	o = valid()
+	o.Source = strings.Repeat("a", STATUS_AUTOMATION_SOURCE_MAX_RUNES+1)
+	require.NotNil(t, o.IsValid())
+
+	o = valid()
+	o.PreviousStatus = "busy"
+	require.NotNil(t, o.IsValid())
+
+	o = valid()
+	o.StartAt = 0
+	require.NotNil(t, o.IsValid())
+
+	o = valid()
+	o.EndAt = o.StartAt
+	require.NotNil(t, o.IsValid())
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// SupportPacketFileName returns the name of the archive of a support packet generated at the given time.
+func SupportPacketFileName(generatedAt time.Time) string {
+	return fmt.Sprintf("mattermost_support_packet_%s.zip", generatedAt.UTC().Format("2006-01-02-15-04"))
+}
+
+// SupportPacketMetadata identifies the server a support packet was generated on.
+type SupportPacketMetadata struct {
+	ServerVersion        string `json:"server_version"`
+	BuildNumber          string `json:"build_number"`
+	BuildHash            string `json:"build_hash"`
+	BuildEnterpriseReady string `json:"build_enterprise_ready"`
+	Hostname             string `json:"hostname"`
+	ClusterId            string `json:"cluster_id,omitempty"`
+	OperatingSystem      string `json:"operating_system"`
+	Architecture         string `json:"architecture"`
+	GoVersion            string `json:"go_version"`
+	LicenseId            string `json:"license_id,omitempty"`
+	LicenseSkuShortName  string `json:"license_sku_short_name,omitempty"`
+	LicensedUsers        int    `json:"licensed_users,omitempty"`
+	GeneratedAt          int64  `json:"generated_at"`
+}
+
+// SupportPacketStoreDiagnostics describes the database of the server a support packet was
+// generated on.
+type SupportPacketStoreDiagnostics struct {
+	DriverName               string `json:"driver_name"`
+	DatabaseVersion          string `json:"database_version"`
+	SchemaVersion            string `json:"schema_version"`
+	ReplicaCount             int    `json:"replica_count"`
+	SearchReplicaCount       int    `json:"search_replica_count"`
+	MasterConnections        int    `json:"master_connections"`
+	ReplicaConnections       int    `json:"replica_connections"`
+	SearchReplicaConnections int    `json:"search_replica_connections"`
+}
@@ -20,6 +20,8 @@ const (
 	SYSTEM_INSTALLATION_DATE_KEY          = "InstallationDate"
 	SYSTEM_FIRST_SERVER_RUN_TIMESTAMP_KEY = "FirstServerRunTimestamp"
 	SYSTEM_CLUSTER_ENCRYPTION_KEY         = "ClusterEncryptionKey"
+	SYSTEM_LAST_SEAT_USAGE_NOTIFICATION   = "LastSeatUsageNotification"
+	SYSTEM_SCHEMA_MIGRATION_STATUS        = "SchemaMigrationStatus"
 )
 
 type System struct {
@@ -70,3 +72,40 @@ func ServerBusyStateFromJson(r io.Reader) *ServerBusyState {
 	json.NewDecoder(r).Decode(&sbs)
 	return sbs
 }
+
+const (
+	SCHEMA_MIGRATION_STATUS_NONE      = "none"
+	SCHEMA_MIGRATION_STATUS_RUNNING   = "running"
+	SCHEMA_MIGRATION_STATUS_COMPLETED = "completed"
+	SCHEMA_MIGRATION_STATUS_FAILED    = "failed"
+)
+
+// SchemaMigrationStatus records which node is migrating the database schema and how far it has
+// got. While Status is running and UpdateAt is recent, other nodes wait instead of migrating.
+type SchemaMigrationStatus struct {
+	NodeId         string `json:"node_id"`
+	Status         string `json:"status"`
+	FromVersion    string `json:"from_version"`
+	CurrentVersion string `json:"current_version"`
+	TargetVersion  string `json:"target_version"`
+	StartAt        int64  `json:"start_at"`
+	UpdateAt       int64  `json:"update_at"`
+	Error          string `json:"error,omitempty"`
+}
+
+func (sms *SchemaMigrationStatus) ToJson() string {
+	b, _ := json.Marshal(sms)
+	return string(b)
+}
+
+func SchemaMigrationStatusFromJson(r io.Reader) *SchemaMigrationStatus {
+	var sms *SchemaMigrationStatus
+	json.NewDecoder(r).Decode(&sms)
+	return sms
+}
+
+// IsStale reports whether a running migration stopped sending heartbeats before timeout, meaning
+// the node that started it most likely died.
+func (sms *SchemaMigrationStatus) IsStale(now int64, timeout int64) bool {
+	return sms.Status == SCHEMA_MIGRATION_STATUS_RUNNING && now-sms.UpdateAt > timeout
+}
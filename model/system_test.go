@@ -28,3 +28,19 @@ func TestServerBusyJson(t *testing.T) {
 	require.Equal(t, sbs.Busy, result.Busy, "busy state does not match")
 	require.Equal(t, sbs.Expires, result.Expires, "expiry does not match")
 }
+
+func TestSchemaMigrationStatusJson(t *testing.T) {
+	sms := SchemaMigrationStatus{NodeId: NewId(), Status: SCHEMA_MIGRATION_STATUS_RUNNING, FromVersion: "5.25.0", TargetVersion: "5.26.0"}
+	result := SchemaMigrationStatusFromJson(strings.NewReader(sms.ToJson()))
+
+	require.Equal(t, sms, *result)
+}
+
+func TestSchemaMigrationStatusIsStale(t *testing.T) {
+	sms := SchemaMigrationStatus{Status: SCHEMA_MIGRATION_STATUS_RUNNING, UpdateAt: 1000}
+	require.False(t, sms.IsStale(1500, 1000))
+	require.True(t, sms.IsStale(2001, 1000))
+
+	sms.Status = SCHEMA_MIGRATION_STATUS_COMPLETED
+	require.False(t, sms.IsStale(2001, 1000), "only running migrations can be stale")
+}
@@ -23,25 +23,33 @@ const (
 	TEAM_EMAIL_MAX_LENGTH           = 128
 	TEAM_NAME_MAX_LENGTH            = 64
 	TEAM_NAME_MIN_LENGTH            = 2
+	TEAM_PROPS_MAX_LENGTH           = 4000
+
+	TEAMS_FOR_USER_SORT_DISPLAY_NAME = "display_name"
+	TEAMS_FOR_USER_SORT_NAME         = "name"
+	TEAMS_FOR_USER_SORT_CREATE_AT    = "create_at"
 )
 
 type Team struct {
-	Id                 string  `json:"id"`
-	CreateAt           int64   `json:"create_at"`
-	UpdateAt           int64   `json:"update_at"`
-	DeleteAt           int64   `json:"delete_at"`
-	DisplayName        string  `json:"display_name"`
-	Name               string  `json:"name"`
-	Description        string  `json:"description"`
-	Email              string  `json:"email"`
-	Type               string  `json:"type"`
-	CompanyName        string  `json:"company_name"`
-	AllowedDomains     string  `json:"allowed_domains"`
-	InviteId           string  `json:"invite_id"`
-	AllowOpenInvite    bool    `json:"allow_open_invite"`
-	LastTeamIconUpdate int64   `json:"last_team_icon_update,omitempty"`
-	SchemeId           *string `json:"scheme_id"`
-	GroupConstrained   *bool   `json:"group_constrained"`
+	Id                 string                 `json:"id"`
+	CreateAt           int64                  `json:"create_at"`
+	UpdateAt           int64                  `json:"update_at"`
+	DeleteAt           int64                  `json:"delete_at"`
+	DisplayName        string                 `json:"display_name"`
+	Name               string                 `json:"name"`
+	Description        string                 `json:"description"`
+	Email              string                 `json:"email"`
+	Type               string                 `json:"type"`
+	CompanyName        string                 `json:"company_name"`
+	AllowedDomains     string                 `json:"allowed_domains"`
+	InviteId           string                 `json:"invite_id"`
+	AllowOpenInvite    bool                   `json:"allow_open_invite"`
+	LastTeamIconUpdate int64                  `json:"last_team_icon_update,omitempty"`
+	SchemeId           *string                `json:"scheme_id"`
+	GroupConstrained   *bool                  `json:"group_constrained"`
+	Props              map[string]interface{} `json:"props"`
+	MemberCount        int64                  `json:"member_count"`
+	InviteExpiresAt    int64                  `json:"invite_expires_at"`
 }
 
 type TeamPatch struct {
@@ -62,6 +70,19 @@ type Invites struct {
 	Invites []map[string]string `json:"invites"`
 }
 
+type TeamsForUserGetOptions struct {
+	// If true, include the deleted teams the user is still a member of.
+	IncludeDeleted bool
+
+	// Page and PerPage page through the teams. A PerPage of 0 returns all of them.
+	Page    int
+	PerPage int
+
+	// Sort the teams. Accepts TEAMS_FOR_USER_SORT_NAME and TEAMS_FOR_USER_SORT_CREATE_AT, but
+	// defaults to TEAMS_FOR_USER_SORT_DISPLAY_NAME.
+	Sort string
+}
+
 type TeamsWithCount struct {
 	Teams      []*Team `json:"teams"`
 	TotalCount int64   `json:"total_count"`
@@ -192,6 +213,10 @@ func (o *Team) IsValid() *AppError {
 		return NewAppError("Team.IsValid", "model.team.is_valid.domains.app_error", nil, "id="+o.Id, http.StatusBadRequest)
 	}
 
+	if len(StringInterfaceToJson(o.Props)) > TEAM_PROPS_MAX_LENGTH {
+		return NewAppError("Team.IsValid", "model.team.is_valid.props.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
 	return nil
 }
 
@@ -211,6 +236,9 @@ func (o *Team) PreSave() {
 	if len(o.InviteId) == 0 {
 		o.InviteId = NewId()
 	}
+
+	// A new team has no members yet, the store keeps the count as members join and leave.
+	o.MemberCount = 0
 }
 
 func (o *Team) PreUpdate() {
@@ -274,6 +302,12 @@ func CleanTeamName(s string) string {
 	return s
 }
 
+// IsInviteExpired returns whether the InviteId of the team stopped being accepted at the given time.
+// An InviteExpiresAt of 0 never expires.
+func (o *Team) IsInviteExpired(millis int64) bool {
+	return o.InviteExpiresAt > 0 && o.InviteExpiresAt <= millis
+}
+
 func (o *Team) Sanitize() {
 	o.Email = ""
 	o.InviteId = ""
@@ -309,6 +343,18 @@ func (o *Team) IsGroupConstrained() bool {
 	return o.GroupConstrained != nil && *o.GroupConstrained
 }
 
+func (o *Team) MakeNonNil() {
+	if o.Props == nil {
+		o.Props = make(map[string]interface{})
+	}
+}
+
+func (o *Team) AddProp(key string, value interface{}) {
+	o.MakeNonNil()
+
+	o.Props[key] = value
+}
+
 func (t *TeamPatch) ToJson() string {
 	b, err := json.Marshal(t)
 	if err != nil {
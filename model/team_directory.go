@@ -0,0 +1,148 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// TEAM_PROP_DIRECTORY_CATEGORIES is the team prop holding the categories the team is listed
+	// under in the team directory.
+	TEAM_PROP_DIRECTORY_CATEGORIES = "directory_categories"
+
+	TEAM_DIRECTORY_MAX_CATEGORIES      = 10
+	TEAM_DIRECTORY_CATEGORY_MAX_RUNES  = 32
+	TEAM_DIRECTORY_RECENT_JOINS_PERIOD = 30 * 24 * 60 * 60 * 1000
+	TEAM_DIRECTORY_ACTIVITY_DECAY      = 7 * 24 * 60 * 60 * 1000
+	TEAM_DIRECTORY_RECENT_JOINS_WEIGHT = 5
+)
+
+// TeamDirectoryStats holds the aggregates used to rank an open team in the team directory.
+type TeamDirectoryStats struct {
+	TeamId string `json:"team_id"`
+	// MemberCount is the number of active users in the team.
+	MemberCount int64 `json:"member_count"`
+	// RecentJoinCount is the number of users who joined the team within the last
+	// TEAM_DIRECTORY_RECENT_JOINS_PERIOD.
+	RecentJoinCount int64 `json:"recent_join_count"`
+	// LastActivityAt is the time of the last post in a public channel of the team.
+	LastActivityAt int64 `json:"last_activity_at"`
+}
+
+type TeamDirectoryEntry struct {
+	Team *Team `json:"team"`
+	TeamDirectoryStats
+}
+
+type TeamDirectory struct {
+	Entries    []*TeamDirectoryEntry `json:"entries"`
+	TotalCount int64                 `json:"total_count"`
+}
+
+// Score ranks the entry in the directory. Bigger and recently joined teams rank higher. The score
+// halves once a team goes TEAM_DIRECTORY_ACTIVITY_DECAY without a post and keeps decreasing after.
+func (o *TeamDirectoryEntry) Score(now int64) float64 {
+	score := float64(o.MemberCount + TEAM_DIRECTORY_RECENT_JOINS_WEIGHT*o.RecentJoinCount)
+
+	idle := now - o.LastActivityAt
+	if idle < 0 {
+		idle = 0
+	}
+
+	return score / (1 + float64(idle)/TEAM_DIRECTORY_ACTIVITY_DECAY)
+}
+
+// Matches reports whether the team is named or described by the term and listed under the
+// category. Empty terms and categories match every team.
+func (o *TeamDirectoryEntry) Matches(term, category string) bool {
+	if category != "" {
+		found := false
+		for _, teamCategory := range o.Team.GetDirectoryCategories() {
+			if teamCategory == category {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	term = strings.ToLower(strings.TrimSpace(term))
+	if term == "" {
+		return true
+	}
+
+	return strings.Contains(strings.ToLower(o.Team.DisplayName), term) ||
+		strings.Contains(strings.ToLower(o.Team.Name), term) ||
+		strings.Contains(strings.ToLower(o.Team.Description), term)
+}
+
+// SortTeamDirectoryEntries orders the entries from the highest score to the lowest, breaking ties
+// by display name.
+func SortTeamDirectoryEntries(entries []*TeamDirectoryEntry, now int64) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		iScore, jScore := entries[i].Score(now), entries[j].Score(now)
+		if iScore != jScore {
+			return iScore > jScore
+		}
+		return strings.ToLower(entries[i].Team.DisplayName) < strings.ToLower(entries[j].Team.DisplayName)
+	})
+}
+
+// GetDirectoryCategories returns the categories the team is listed under in the team directory.
+func (o *Team) GetDirectoryCategories() []string {
+	categories := []string{}
+	switch value := o.Props[TEAM_PROP_DIRECTORY_CATEGORIES].(type) {
+	case []string:
+		categories = append(categories, value...)
+	case []interface{}:
+		for _, category := range value {
+			if s, ok := category.(string); ok {
+				categories = append(categories, s)
+			}
+		}
+	}
+	return categories
+}
+
+// NormalizeTeamDirectoryCategories lowercases, trims and deduplicates the categories, and returns an
+// error if any of them is empty or too long, or if there are too many.
+func NormalizeTeamDirectoryCategories(categories []string) ([]string, *AppError) {
+	normalized := []string{}
+	seen := make(map[string]bool, len(categories))
+	for _, category := range categories {
+		category = strings.ToLower(strings.TrimSpace(category))
+		if category == "" || utf8.RuneCountInString(category) > TEAM_DIRECTORY_CATEGORY_MAX_RUNES {
+			return nil, NewAppError("NormalizeTeamDirectoryCategories", "model.team.directory_categories.invalid.app_error", map[string]interface{}{"MaxLength": TEAM_DIRECTORY_CATEGORY_MAX_RUNES}, "", http.StatusBadRequest)
+		}
+		if !seen[category] {
+			seen[category] = true
+			normalized = append(normalized, category)
+		}
+	}
+
+	if len(normalized) > TEAM_DIRECTORY_MAX_CATEGORIES {
+		return nil, NewAppError("NormalizeTeamDirectoryCategories", "model.team.directory_categories.too_many.app_error", map[string]interface{}{"Max": TEAM_DIRECTORY_MAX_CATEGORIES}, "", http.StatusBadRequest)
+	}
+
+	return normalized, nil
+}
+
+func (o *TeamDirectory) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func TeamDirectoryFromJson(data io.Reader) *TeamDirectory {
+	var o *TeamDirectory
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
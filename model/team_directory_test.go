@@ -0,0 +1,74 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortTeamDirectoryEntries(t *testing.T) {
+	now := GetMillis()
+	newEntry := func(displayName string, memberCount, recentJoinCount, idle int64) *TeamDirectoryEntry {
+		return &TeamDirectoryEntry{
+			Team: &Team{DisplayName: displayName},
+			TeamDirectoryStats: TeamDirectoryStats{
+				MemberCount:     memberCount,
+				RecentJoinCount: recentJoinCount,
+				LastActivityAt:  now - idle,
+			},
+		}
+	}
+
+	big := newEntry("big", 100, 0, 0)
+	idle := newEntry("idle", 100, 0, 4*TEAM_DIRECTORY_ACTIVITY_DECAY)
+	growing := newEntry("growing", 50, 20, 0)
+	small := newEntry("small", 10, 0, 0)
+	tied := newEntry("Also small", 10, 0, 0)
+
+	entries := []*TeamDirectoryEntry{small, idle, big, tied, growing}
+	SortTeamDirectoryEntries(entries, now)
+
+	assert.Equal(t, []*TeamDirectoryEntry{growing, big, idle, tied, small}, entries)
+}
+
+func TestTeamDirectoryEntryMatches(t *testing.T) {
+	entry := &TeamDirectoryEntry{
+		Team: &Team{
+			DisplayName: "Platform",
+			Name:        "platform-eng",
+			Description: "Builds the core services",
+			Props:       map[string]interface{}{TEAM_PROP_DIRECTORY_CATEGORIES: []interface{}{"engineering"}},
+		},
+	}
+
+	assert.True(t, entry.Matches("", ""))
+	assert.True(t, entry.Matches("PLAT", ""))
+	assert.True(t, entry.Matches("eng", "engineering"))
+	assert.True(t, entry.Matches("core", ""))
+	assert.False(t, entry.Matches("sales", ""))
+	assert.False(t, entry.Matches("", "sales"))
+}
+
+func TestNormalizeTeamDirectoryCategories(t *testing.T) {
+	categories, err := NormalizeTeamDirectoryCategories([]string{" Engineering", "engineering", "Design "})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"engineering", "design"}, categories)
+
+	_, err = NormalizeTeamDirectoryCategories([]string{" "})
+	assert.NotNil(t, err)
+
+	_, err = NormalizeTeamDirectoryCategories([]string{strings.Repeat("a", TEAM_DIRECTORY_CATEGORY_MAX_RUNES+1)})
+	assert.NotNil(t, err)
+
+	tooMany := []string{}
+	for i := 0; i <= TEAM_DIRECTORY_MAX_CATEGORIES; i++ {
+		tooMany = append(tooMany, NewId())
+	}
+	_, err = NormalizeTeamDirectoryCategories(tooMany)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"unicode/utf8"
+)
+
+const (
+	TEAM_INVITE_LINK_NAME_MAX_LENGTH        = 64
+	TEAM_INVITE_LINK_DISPLAY_NAME_MAX_RUNES = 64
+	TEAM_INVITE_LINK_CHANNEL_IDS_MAX        = 50
+	TEAM_INVITE_LINKS_PER_TEAM_MAX          = 100
+)
+
+// TeamInviteLink is a named link joining users to a team, optionally limited in time and in number of
+// uses. Users joining through the link are given its role and added to its channels.
+type TeamInviteLink struct {
+	Id          string `json:"id"`
+	TeamId      string `json:"team_id"`
+	CreatorId   string `json:"creator_id"`
+	CreateAt    int64  `json:"create_at"`
+	UpdateAt    int64  `json:"update_at"`
+	DeleteAt    int64  `json:"delete_at"`
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+	// ExpiresAt is the time past which the link can't be used, or 0 if it never expires.
+	ExpiresAt int64 `json:"expires_at"`
+	// MaxUses is the number of users that can join through the link, or 0 if unlimited.
+	MaxUses    int64       `json:"max_uses"`
+	UseCount   int64       `json:"use_count"`
+	ChannelIds StringArray `json:"channel_ids"`
+	// Role is the team role of the users joining through the link, TEAM_USER_ROLE_ID or
+	// TEAM_ADMIN_ROLE_ID.
+	Role string `json:"role"`
+}
+
+func (o *TeamInviteLink) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func TeamInviteLinkFromJson(data io.Reader) *TeamInviteLink {
+	var o *TeamInviteLink
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
+
+func TeamInviteLinkListToJson(l []*TeamInviteLink) string {
+	b, _ := json.Marshal(l)
+	return string(b)
+}
+
+func TeamInviteLinkListFromJson(data io.Reader) []*TeamInviteLink {
+	var o []*TeamInviteLink
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
+
+func (o *TeamInviteLink) IsValid() *AppError {
+	if !IsValidId(o.Id) {
+		return NewAppError("TeamInviteLink.IsValid", "model.team_invite_link.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.TeamId) {
+		return NewAppError("TeamInviteLink.IsValid", "model.team_invite_link.is_valid.team_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.CreatorId) {
+		return NewAppError("TeamInviteLink.IsValid", "model.team_invite_link.is_valid.creator_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("TeamInviteLink.IsValid", "model.team_invite_link.is_valid.create_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.UpdateAt == 0 {
+		return NewAppError("TeamInviteLink.IsValid", "model.team_invite_link.is_valid.update_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if len(o.Name) > TEAM_INVITE_LINK_NAME_MAX_LENGTH || !IsValidChannelIdentifier(o.Name) {
+		return NewAppError("TeamInviteLink.IsValid", "model.team_invite_link.is_valid.name.app_error", map[string]interface{}{"Max": TEAM_INVITE_LINK_NAME_MAX_LENGTH}, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(o.DisplayName) > TEAM_INVITE_LINK_DISPLAY_NAME_MAX_RUNES {
+		return NewAppError("TeamInviteLink.IsValid", "model.team_invite_link.is_valid.display_name.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.ExpiresAt < 0 {
+		return NewAppError("TeamInviteLink.IsValid", "model.team_invite_link.is_valid.expires_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.MaxUses < 0 {
+		return NewAppError("TeamInviteLink.IsValid", "model.team_invite_link.is_valid.max_uses.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if len(o.ChannelIds) > TEAM_INVITE_LINK_CHANNEL_IDS_MAX {
+		return NewAppError("TeamInviteLink.IsValid", "model.team_invite_link.is_valid.channel_ids.app_error", map[string]interface{}{"Max": TEAM_INVITE_LINK_CHANNEL_IDS_MAX}, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	for _, channelId := range o.ChannelIds {
+		if !IsValidId(channelId) {
+			return NewAppError("TeamInviteLink.IsValid", "model.team_invite_link.is_valid.channel_ids.app_error", map[string]interface{}{"Max": TEAM_INVITE_LINK_CHANNEL_IDS_MAX}, "id="+o.Id, http.StatusBadRequest)
+		}
+	}
+
+	if o.Role != TEAM_USER_ROLE_ID && o.Role != TEAM_ADMIN_ROLE_ID {
+		return NewAppError("TeamInviteLink.IsValid", "model.team_invite_link.is_valid.role.app_error", nil, "id="+o.Id+", role="+o.Role, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (o *TeamInviteLink) PreSave() {
+	if o.Id == "" {
+		o.Id = NewId()
+	}
+
+	if o.Role == "" {
+		o.Role = TEAM_USER_ROLE_ID
+	}
+
+	o.CreateAt = GetMillis()
+	o.UpdateAt = o.CreateAt
+	o.UseCount = 0
+}
+
+// IsExpired reports whether the link can no longer be used at the given time.
+func (o *TeamInviteLink) IsExpired(now int64) bool {
+	return o.ExpiresAt != 0 && now >= o.ExpiresAt
+}
+
+// IsExhausted reports whether as many users as allowed have joined through the link.
+func (o *TeamInviteLink) IsExhausted() bool {
+	return o.MaxUses != 0 && o.UseCount >= o.MaxUses
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamInviteLinkJson(t *testing.T) {
+	o := TeamInviteLink{Id: NewId(), Name: "onboarding", ChannelIds: []string{NewId()}, Role: TEAM_USER_ROLE_ID}
+	ro := TeamInviteLinkFromJson(strings.NewReader(o.ToJson()))
+
+	require.NotNil(t, ro)
+	assert.Equal(t, o, *ro)
+}
+
+func TestTeamInviteLinkIsValid(t *testing.T) {
+	o := TeamInviteLink{TeamId: NewId(), CreatorId: NewId()}
+	assert.NotNil(t, o.IsValid(), "empty declaration should be invalid")
+
+	o.PreSave()
+	assert.Equal(t, TEAM_USER_ROLE_ID, o.Role)
+	assert.NotNil(t, o.IsValid(), "a link without a name should be invalid")
+
+	o.Name = "Onboarding"
+	assert.NotNil(t, o.IsValid())
+
+	o.Name = strings.Repeat("a", TEAM_INVITE_LINK_NAME_MAX_LENGTH+1)
+	assert.NotNil(t, o.IsValid())
+
+	o.Name = "onboarding-2020"
+	assert.Nil(t, o.IsValid())
+
+	o.DisplayName = strings.Repeat("a", TEAM_INVITE_LINK_DISPLAY_NAME_MAX_RUNES+1)
+	assert.NotNil(t, o.IsValid())
+
+	o.DisplayName = "Onboarding"
+	assert.Nil(t, o.IsValid())
+
+	o.ExpiresAt = -1
+	assert.NotNil(t, o.IsValid())
+
+	o.ExpiresAt = GetMillis()
+	o.MaxUses = -1
+	assert.NotNil(t, o.IsValid())
+
+	o.MaxUses = 10
+	o.ChannelIds = []string{"junk"}
+	assert.NotNil(t, o.IsValid())
+
+	o.ChannelIds = []string{NewId()}
+	assert.Nil(t, o.IsValid())
+
+	o.Role = SYSTEM_ADMIN_ROLE_ID
+	assert.NotNil(t, o.IsValid())
+
+	o.Role = TEAM_ADMIN_ROLE_ID
+	assert.Nil(t, o.IsValid())
+}
+
+func TestTeamInviteLinkUsability(t *testing.T) {
+	o := TeamInviteLink{}
+	assert.False(t, o.IsExpired(GetMillis()))
+	assert.False(t, o.IsExhausted())
+
+	o.ExpiresAt = 1000
+	assert.False(t, o.IsExpired(999))
+	assert.True(t, o.IsExpired(1000))
+
+	o.MaxUses = 2
+	o.UseCount = 1
+	assert.False(t, o.IsExhausted())
+
+	o.UseCount = 2
+	assert.True(t, o.IsExhausted())
+}
@@ -13,23 +13,37 @@ import (
 
 const (
 	USERNAME = "Username"
+
+	TEAM_MEMBERS_SORT_LAST_ACTIVITY = "last_activity"
 )
 
 type TeamMember struct {
-	TeamId        string `json:"team_id"`
-	UserId        string `json:"user_id"`
-	Roles         string `json:"roles"`
-	DeleteAt      int64  `json:"delete_at"`
-	SchemeGuest   bool   `json:"scheme_guest"`
-	SchemeUser    bool   `json:"scheme_user"`
-	SchemeAdmin   bool   `json:"scheme_admin"`
-	ExplicitRoles string `json:"explicit_roles"`
+	TeamId                 string `json:"team_id"`
+	UserId                 string `json:"user_id"`
+	Roles                  string `json:"roles"`
+	DeleteAt               int64  `json:"delete_at"`
+	SchemeGuest            bool   `json:"scheme_guest"`
+	SchemeUser             bool   `json:"scheme_user"`
+	SchemeAdmin            bool   `json:"scheme_admin"`
+	ExplicitRoles          string `json:"explicit_roles"`
+	ExplicitRolesExpiresAt int64  `json:"explicit_roles_expires_at,omitempty"`
 }
 
 type TeamUnread struct {
 	TeamId       string `json:"team_id"`
 	MsgCount     int64  `json:"msg_count"`
 	MentionCount int64  `json:"mention_count"`
+	// Categories breaks the unreads down by the sidebar categories of the user on the team. Direct
+	// and group messages are counted in the categories holding them, but not in the totals of the
+	// team.
+	Categories []*SidebarCategoryUnread `json:"categories,omitempty"`
+}
+
+// SidebarCategoryUnread holds the unread totals of the channels in a sidebar category.
+type SidebarCategoryUnread struct {
+	CategoryId   string `json:"category_id"`
+	MsgCount     int64  `json:"msg_count"`
+	MentionCount int64  `json:"mention_count"`
 }
 
 type TeamMemberForExport struct {
@@ -48,8 +62,18 @@ type EmailInviteWithError struct {
 	Error *AppError `json:"error"`
 }
 
+type TeamMembersForUserGetOptions struct {
+	// If true, exclude the memberships the user left and those of deleted teams.
+	ExcludeDeleted bool
+
+	// Sort the memberships. Accepts TEAMS_FOR_USER_SORT_DISPLAY_NAME, which sorts them by the
+	// display name of their team, but defaults to the id of the team.
+	Sort string
+}
+
 type TeamMembersGetOptions struct {
-	// Sort the team members. Accepts "Username", but defaults to "Id".
+	// Sort the team members. Accepts "Username" and "last_activity", which puts the most recently
+	// active members first, but defaults to "Id".
 	Sort string
 
 	// If true, exclude team members whose corresponding user is deleted.
@@ -57,6 +81,10 @@ type TeamMembersGetOptions struct {
 
 	// Restrict to search in a list of teams and channels
 	ViewRestrictions *ViewUsersRestrictions
+
+	// If set, only return the team members holding one of these team roles. Accepts
+	// "team_admin", "team_user" and "team_guest", where "team_user" excludes the team admins.
+	Roles []string
 }
 
 func (o *TeamMember) ToJson() string {
@@ -181,6 +209,10 @@ func (o *TeamMember) IsValid() *AppError {
 func (o *TeamMember) PreUpdate() {
 }
 
+// GetRoles returns the roles of the member, leaving out the explicit roles once they have expired.
 func (o *TeamMember) GetRoles() []string {
+	if IsRoleGrantExpired(o.ExplicitRolesExpiresAt, GetMillis()) {
+		return RemoveRoles(strings.Fields(o.Roles), strings.Fields(o.ExplicitRoles))
+	}
 	return strings.Fields(o.Roles)
 }
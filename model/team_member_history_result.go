@@ -0,0 +1,16 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+type TeamMemberHistoryResult struct {
+	TeamId    string
+	UserId    string
+	JoinTime  int64
+	LeaveTime *int64
+
+	// these fields are never set in the database - when we SELECT, we join on Users to get them
+	UserEmail string `db:"Email"`
+	Username  string
+	IsBot     bool
+}
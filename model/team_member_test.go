@@ -28,6 +28,17 @@ func TestTeamMemberIsValid(t *testing.T) {
 	require.Error(t, o.IsValid(), "should be invalid")
 }
 
+func TestTeamMemberGetRoles(t *testing.T) {
+	o := TeamMember{Roles: "team_user team_post_all", ExplicitRoles: "team_post_all"}
+	require.Equal(t, []string{"team_user", "team_post_all"}, o.GetRoles())
+
+	o.ExplicitRolesExpiresAt = GetMillis() + 60000
+	require.Equal(t, []string{"team_user", "team_post_all"}, o.GetRoles())
+
+	o.ExplicitRolesExpiresAt = GetMillis() - 1
+	require.Equal(t, []string{"team_user"}, o.GetRoles())
+}
+
 func TestUnreadMemberJson(t *testing.T) {
 	o := TeamUnread{TeamId: NewId(), MsgCount: 5, MentionCount: 3}
 	json := o.ToJson()
@@ -0,0 +1,143 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"strings"
+)
+
+const (
+	// TEAM_PROP_NOTIFY_DEFAULTS is the team prop holding the notification preferences given to the
+	// members of the team who kept them to the default.
+	TEAM_PROP_NOTIFY_DEFAULTS = "notify_defaults"
+
+	// INHERITED_NOTIFY_PROP is the user notify prop listing, comma separated, the notification
+	// preferences the user never changed. It is maintained by the server: values sent by clients
+	// are ignored.
+	INHERITED_NOTIFY_PROP = "inherited"
+)
+
+// teamNotifyDefaultProps are the notification preferences a team can set a default for.
+var teamNotifyDefaultProps = []string{
+	EMAIL_NOTIFY_PROP,
+	PUSH_NOTIFY_PROP,
+	DESKTOP_NOTIFY_PROP,
+	DESKTOP_SOUND_NOTIFY_PROP,
+	CHANNEL_MENTIONS_NOTIFY_PROP,
+	COMMENTS_NOTIFY_PROP,
+	PUSH_STATUS_NOTIFY_PROP,
+}
+
+// GetNotifyDefaults returns the notification preferences set as defaults for the members of the
+// team, or an empty map if the team has none.
+func (o *Team) GetNotifyDefaults() StringMap {
+	defaults := StringMap{}
+	switch value := o.Props[TEAM_PROP_NOTIFY_DEFAULTS].(type) {
+	case StringMap:
+		for key, setting := range value {
+			defaults[key] = setting
+		}
+	case map[string]interface{}:
+		for key, setting := range value {
+			if s, ok := setting.(string); ok {
+				defaults[key] = s
+			}
+		}
+	}
+	return defaults
+}
+
+// SetNotifyDefaults replaces the notification preferences set as defaults for the members of the
+// team. An empty map removes them.
+func (o *Team) SetNotifyDefaults(defaults StringMap) {
+	o.MakeNonNil()
+
+	if len(defaults) == 0 {
+		delete(o.Props, TEAM_PROP_NOTIFY_DEFAULTS)
+		return
+	}
+
+	value := make(map[string]interface{}, len(defaults))
+	for key, setting := range defaults {
+		value[key] = setting
+	}
+	o.Props[TEAM_PROP_NOTIFY_DEFAULTS] = value
+}
+
+// IsValidTeamNotifyDefaults returns an error if a team sets a default for a notification preference
+// that can't have one, or to an invalid value.
+func IsValidTeamNotifyDefaults(defaults StringMap) *AppError {
+	for key, setting := range defaults {
+		var valid bool
+		switch key {
+		case EMAIL_NOTIFY_PROP, DESKTOP_SOUND_NOTIFY_PROP, CHANNEL_MENTIONS_NOTIFY_PROP:
+			valid = setting == "true" || setting == "false"
+		case PUSH_NOTIFY_PROP, DESKTOP_NOTIFY_PROP:
+			valid = IsValidUserNotifyLevel(setting)
+		case COMMENTS_NOTIFY_PROP:
+			valid = IsValidCommentsNotifyLevel(setting)
+		case PUSH_STATUS_NOTIFY_PROP:
+			valid = IsValidPushStatusNotifyLevel(setting)
+		default:
+			return NewAppError("IsValidTeamNotifyDefaults", "model.team.notify_defaults.key.app_error", map[string]interface{}{"Key": key}, "", http.StatusBadRequest)
+		}
+
+		if !valid {
+			return NewAppError("IsValidTeamNotifyDefaults", "model.team.notify_defaults.value.app_error", map[string]interface{}{"Key": key}, "value="+setting, http.StatusBadRequest)
+		}
+	}
+
+	return nil
+}
+
+// IsInheritedNotifyProp returns whether a notification preference of a user follows the defaults
+// rather than a value the user chose: the user never changed it, set it back to "default" or
+// doesn't have it. An empty value, as for the mention keys, is a value of its own.
+func IsInheritedNotifyProp(props StringMap, key string) bool {
+	value, ok := props[key]
+	if !ok || value == USER_NOTIFY_DEFAULT {
+		return true
+	}
+
+	for _, inherited := range inheritedNotifyProps(props) {
+		if inherited == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// UpdatedNotifyProps returns the notification preferences of a user replacing oldProps with
+// newProps. The preferences the user never changed keep following the defaults as long as their
+// value is left unchanged.
+func UpdatedNotifyProps(oldProps, newProps StringMap) StringMap {
+	updated := CopyStringMap(newProps)
+
+	var inherited []string
+	for _, key := range inheritedNotifyProps(oldProps) {
+		if value, ok := newProps[key]; ok && value == oldProps[key] {
+			inherited = append(inherited, key)
+		}
+	}
+	setInheritedNotifyProps(updated, inherited)
+
+	return updated
+}
+
+func inheritedNotifyProps(props StringMap) []string {
+	if props[INHERITED_NOTIFY_PROP] == "" {
+		return nil
+	}
+	return strings.Split(props[INHERITED_NOTIFY_PROP], ",")
+}
+
+func setInheritedNotifyProps(props StringMap, keys []string) {
+	if len(keys) == 0 {
+		delete(props, INHERITED_NOTIFY_PROP)
+		return
+	}
+	props[INHERITED_NOTIFY_PROP] = strings.Join(keys, ",")
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamNotifyDefaults(t *testing.T) {
+	team := &Team{}
+	assert.Empty(t, team.GetNotifyDefaults())
+
+	team.SetNotifyDefaults(StringMap{EMAIL_NOTIFY_PROP: "false", PUSH_NOTIFY_PROP: USER_NOTIFY_ALL})
+	assert.Equal(t, StringMap{EMAIL_NOTIFY_PROP: "false", PUSH_NOTIFY_PROP: USER_NOTIFY_ALL}, team.GetNotifyDefaults())
+
+	t.Run("read back from json", func(t *testing.T) {
+		decoded := TeamFromJson(strings.NewReader(team.ToJson()))
+		require.NotNil(t, decoded)
+		assert.Equal(t, StringMap{EMAIL_NOTIFY_PROP: "false", PUSH_NOTIFY_PROP: USER_NOTIFY_ALL}, decoded.GetNotifyDefaults())
+	})
+
+	team.SetNotifyDefaults(StringMap{})
+	assert.Empty(t, team.GetNotifyDefaults())
+	assert.NotContains(t, team.Props, TEAM_PROP_NOTIFY_DEFAULTS)
+}
+
+func TestIsValidTeamNotifyDefaults(t *testing.T) {
+	for name, tc := range map[string]struct {
+		Defaults StringMap
+		Valid    bool
+	}{
+		"empty":            {StringMap{}, true},
+		"all valid":        {StringMap{EMAIL_NOTIFY_PROP: "true", PUSH_NOTIFY_PROP: USER_NOTIFY_NONE, DESKTOP_NOTIFY_PROP: USER_NOTIFY_ALL, DESKTOP_SOUND_NOTIFY_PROP: "false", CHANNEL_MENTIONS_NOTIFY_PROP: "false", COMMENTS_NOTIFY_PROP: COMMENTS_NOTIFY_ROOT, PUSH_STATUS_NOTIFY_PROP: STATUS_ONLINE}, true},
+		"unknown key":      {StringMap{"unknown": "true"}, false},
+		"mention keys":     {StringMap{MENTION_KEYS_NOTIFY_PROP: "word"}, false},
+		"invalid bool":     {StringMap{EMAIL_NOTIFY_PROP: "yes"}, false},
+		"invalid level":    {StringMap{PUSH_NOTIFY_PROP: USER_NOTIFY_DEFAULT}, false},
+		"invalid comments": {StringMap{COMMENTS_NOTIFY_PROP: USER_NOTIFY_ALL}, false},
+		"invalid status":   {StringMap{PUSH_STATUS_NOTIFY_PROP: STATUS_DND}, false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := IsValidTeamNotifyDefaults(tc.Defaults)
+			if tc.Valid {
+				assert.Nil(t, err)
+			} else {
+				assert.NotNil(t, err)
+			}
+		})
+	}
+}
+
+func TestInheritedNotifyProps(t *testing.T) {
+	props := DefaultUserNotifyProps()
+	assert.True(t, IsInheritedNotifyProp(props, EMAIL_NOTIFY_PROP))
+	assert.True(t, IsInheritedNotifyProp(props, COMMENTS_NOTIFY_PROP))
+	assert.False(t, IsInheritedNotifyProp(props, MENTION_KEYS_NOTIFY_PROP), "an empty value is a value of its own")
+	assert.True(t, IsInheritedNotifyProp(StringMap{}, MENTION_KEYS_NOTIFY_PROP))
+	assert.True(t, IsInheritedNotifyProp(StringMap{PUSH_NOTIFY_PROP: USER_NOTIFY_DEFAULT}, PUSH_NOTIFY_PROP))
+	assert.False(t, IsInheritedNotifyProp(StringMap{PUSH_NOTIFY_PROP: USER_NOTIFY_ALL}, PUSH_NOTIFY_PROP))
+
+	t.Run("updated", func(t *testing.T) {
+		newProps := CopyStringMap(props)
+		newProps[PUSH_NOTIFY_PROP] = USER_NOTIFY_ALL
+		delete(newProps, INHERITED_NOTIFY_PROP)
+
+		updated := UpdatedNotifyProps(props, newProps)
+		assert.False(t, IsInheritedNotifyProp(updated, PUSH_NOTIFY_PROP))
+		assert.True(t, IsInheritedNotifyProp(updated, EMAIL_NOTIFY_PROP), "the marker should be kept from the old props")
+		assert.NotContains(t, newProps, INHERITED_NOTIFY_PROP, "the new props should not be modified")
+
+		newProps[INHERITED_NOTIFY_PROP] = PUSH_NOTIFY_PROP
+		updated = UpdatedNotifyProps(props, newProps)
+		assert.False(t, IsInheritedNotifyProp(updated, PUSH_NOTIFY_PROP), "the marker sent by clients should be ignored")
+	})
+
+	t.Run("added", func(t *testing.T) {
+		user := &User{NotifyProps: CopyStringMap(props)}
+		user.AddNotifyProp(EMAIL_NOTIFY_PROP, "true")
+		assert.False(t, IsInheritedNotifyProp(user.NotifyProps, EMAIL_NOTIFY_PROP))
+		assert.True(t, IsInheritedNotifyProp(user.NotifyProps, PUSH_NOTIFY_PROP))
+	})
+}
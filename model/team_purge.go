@@ -0,0 +1,40 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+const (
+	// TEAM_PROP_PURGE_AT is the team prop holding the time at which a soft deleted team is
+	// scheduled to be permanently deleted.
+	TEAM_PROP_PURGE_AT = "purge_at"
+)
+
+// GetPurgeAt returns the time at which the team is scheduled to be permanently deleted, or 0 if
+// no purge is scheduled.
+func (o *Team) GetPurgeAt() int64 {
+	switch value := o.Props[TEAM_PROP_PURGE_AT].(type) {
+	case int64:
+		return value
+	case float64:
+		return int64(value)
+	}
+	return 0
+}
+
+// SetPurgeAt schedules the permanent deletion of the team. Zero cancels it.
+func (o *Team) SetPurgeAt(purgeAt int64) {
+	o.MakeNonNil()
+
+	if purgeAt <= 0 {
+		delete(o.Props, TEAM_PROP_PURGE_AT)
+		return
+	}
+
+	o.Props[TEAM_PROP_PURGE_AT] = purgeAt
+}
+
+// IsPurgeDue reports whether the team is soft deleted and its scheduled purge time has passed.
+func (o *Team) IsPurgeDue(now int64) bool {
+	purgeAt := o.GetPurgeAt()
+	return o.DeleteAt != 0 && purgeAt > 0 && purgeAt <= now
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamPurgeAt(t *testing.T) {
+	team := &Team{}
+	assert.Equal(t, int64(0), team.GetPurgeAt())
+
+	team.SetPurgeAt(1000)
+	assert.Equal(t, int64(1000), team.GetPurgeAt())
+
+	t.Run("read back from json", func(t *testing.T) {
+		decoded := TeamFromJson(strings.NewReader(team.ToJson()))
+		require.NotNil(t, decoded)
+		assert.Equal(t, int64(1000), decoded.GetPurgeAt())
+	})
+
+	team.SetPurgeAt(0)
+	assert.Equal(t, int64(0), team.GetPurgeAt())
+	assert.NotContains(t, team.Props, TEAM_PROP_PURGE_AT)
+}
+
+func TestTeamIsPurgeDue(t *testing.T) {
+	team := &Team{}
+	assert.False(t, team.IsPurgeDue(2000), "no purge scheduled")
+
+	team.SetPurgeAt(1000)
+	assert.False(t, team.IsPurgeDue(2000), "team not deleted")
+
+	team.DeleteAt = 500
+	assert.False(t, team.IsPurgeDue(999))
+	assert.True(t, team.IsPurgeDue(1000))
+	assert.True(t, team.IsPurgeDue(2000))
+}
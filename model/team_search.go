@@ -6,18 +6,63 @@ package model
 import (
 	"encoding/json"
 	"io"
+	"net/http"
+)
+
+const (
+	TEAM_SEARCH_SORT_DISPLAY_NAME  = "display_name"
+	TEAM_SEARCH_SORT_CREATE_AT     = "create_at"
+	TEAM_SEARCH_SORT_MEMBER_COUNT  = "member_count"
+	TEAM_SEARCH_SORT_LAST_ACTIVITY = "last_activity"
 )
 
 type TeamSearch struct {
 	Term    string `json:"term"`
 	Page    *int   `json:"page,omitempty"`
 	PerPage *int   `json:"per_page,omitempty"`
+	TeamSearchOpts
+}
+
+// TeamSearchOpts narrows down the teams matched by a search. Nil and empty filters match every team.
+type TeamSearchOpts struct {
+	AllowOpenInvite  *bool `json:"allow_open_invite,omitempty"`
+	GroupConstrained *bool `json:"group_constrained,omitempty"`
+	// ExcludeDeleted leaves out archived teams, which are matched otherwise.
+	ExcludeDeleted bool   `json:"exclude_deleted,omitempty"`
+	TeamType       string `json:"team_type,omitempty"`
+	// PolicyID restricts the search to the teams covered by the retention policy.
+	PolicyID string `json:"policy_id,omitempty"`
+	// ExcludePolicyConstrained leaves out the teams covered by any retention policy.
+	ExcludePolicyConstrained bool `json:"exclude_policy_constrained,omitempty"`
+	// Sort orders the results of paged searches by one of the TEAM_SEARCH_SORT_* options, and defaults
+	// to TEAM_SEARCH_SORT_DISPLAY_NAME. The last activity of a team is the time of its latest post.
+	Sort           string `json:"sort,omitempty"`
+	SortDescending bool   `json:"sort_descending,omitempty"`
 }
 
 func (t *TeamSearch) IsPaginated() bool {
 	return t.Page != nil && t.PerPage != nil
 }
 
+// IsValid returns an error if the team type, the policy id or the sort of the filters are invalid.
+func (o *TeamSearchOpts) IsValid() *AppError {
+	if o.TeamType != "" && o.TeamType != TEAM_OPEN && o.TeamType != TEAM_INVITE {
+		return NewAppError("TeamSearchOpts.IsValid", "model.team_search.is_valid.team_type.app_error", nil, "team_type="+o.TeamType, http.StatusBadRequest)
+	}
+
+	if o.PolicyID != "" && !IsValidId(o.PolicyID) {
+		return NewAppError("TeamSearchOpts.IsValid", "model.team_search.is_valid.policy_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	switch o.Sort {
+	case "", TEAM_SEARCH_SORT_DISPLAY_NAME, TEAM_SEARCH_SORT_CREATE_AT, TEAM_SEARCH_SORT_MEMBER_COUNT, TEAM_SEARCH_SORT_LAST_ACTIVITY:
+	default:
+		return NewAppError("TeamSearchOpts.IsValid", "model.team_search.is_valid.sort.app_error", nil, "sort="+o.Sort, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
 // ToJson convert a TeamSearch to json string
 func (t *TeamSearch) ToJson() string {
 	b, err := json.Marshal(t)
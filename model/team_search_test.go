@@ -17,3 +17,29 @@ func TestTeamSearchJson(t *testing.T) {
 
 	assert.Equal(t, teamSearch.Term, rteamSearch.Term, "Terms do not match")
 }
+
+func TestTeamSearchOptsJson(t *testing.T) {
+	allowOpenInvite := true
+	teamSearch := TeamSearch{
+		Term: NewId(),
+		TeamSearchOpts: TeamSearchOpts{
+			AllowOpenInvite: &allowOpenInvite,
+			ExcludeDeleted:  true,
+			TeamType:        TEAM_INVITE,
+			PolicyID:        NewId(),
+		},
+	}
+
+	rteamSearch := TeamSearchFromJson(strings.NewReader(teamSearch.ToJson()))
+	assert.Equal(t, teamSearch, *rteamSearch)
+	assert.Contains(t, teamSearch.ToJson(), `"allow_open_invite":true`)
+}
+
+func TestTeamSearchOptsIsValid(t *testing.T) {
+	assert.Nil(t, (&TeamSearchOpts{}).IsValid())
+	assert.Nil(t, (&TeamSearchOpts{TeamType: TEAM_OPEN, PolicyID: NewId()}).IsValid())
+	assert.NotNil(t, (&TeamSearchOpts{TeamType: "X"}).IsValid())
+	assert.NotNil(t, (&TeamSearchOpts{PolicyID: "junk"}).IsValid())
+	assert.Nil(t, (&TeamSearchOpts{Sort: TEAM_SEARCH_SORT_MEMBER_COUNT, SortDescending: true}).IsValid())
+	assert.NotNil(t, (&TeamSearchOpts{Sort: "Name"}).IsValid())
+}
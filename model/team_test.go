@@ -147,3 +147,12 @@ func TestTeamPatch(t *testing.T) {
 	require.Equal(t, *p.AllowOpenInvite, o.AllowOpenInvite, "AllowOpenInvite did not update")
 	require.Equal(t, *p.GroupConstrained, *o.GroupConstrained)
 }
+
+func TestTeamIsInviteExpired(t *testing.T) {
+	team := &Team{}
+	assert.False(t, team.IsInviteExpired(GetMillis()))
+
+	team.InviteExpiresAt = 1000
+	assert.False(t, team.IsInviteExpired(999))
+	assert.True(t, team.IsInviteExpired(1000))
+}
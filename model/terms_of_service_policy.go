@@ -0,0 +1,215 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"unicode/utf8"
+)
+
+const (
+	TERMS_OF_SERVICE_POLICY_DISPLAY_NAME_MAX_RUNES = 64
+	TERMS_OF_SERVICE_POLICIES_PER_PAGE_DEFAULT     = 60
+)
+
+// TermsOfServicePolicy is a set of terms that the members of some teams and groups must accept
+// before they can keep using the API. Every published version of the policy has to be accepted
+// again by all of the targeted users.
+type TermsOfServicePolicy struct {
+	Id          string   `json:"id"`
+	DisplayName string   `json:"display_name"`
+	CreateAt    int64    `json:"create_at"`
+	UpdateAt    int64    `json:"update_at"`
+	DeleteAt    int64    `json:"delete_at"`
+	TeamIds     []string `json:"team_ids" db:"-"`
+	GroupIds    []string `json:"group_ids" db:"-"`
+}
+
+// TermsOfServicePolicyTeam targets the members of a team with a terms of service policy.
+type TermsOfServicePolicyTeam struct {
+	PolicyId string
+	TeamId   string
+}
+
+// TermsOfServicePolicyGroup targets the members of a group with a terms of service policy.
+type TermsOfServicePolicyGroup struct {
+	PolicyId string
+	GroupId  string
+}
+
+// TermsOfServicePolicyVersion is the text of a terms of service policy as published at some point.
+// Versions are numbered from 1 and only the latest one of a policy needs to be accepted.
+type TermsOfServicePolicyVersion struct {
+	Id       string `json:"id"`
+	PolicyId string `json:"policy_id"`
+	Version  int    `json:"version"`
+	UserId   string `json:"user_id"`
+	Text     string `json:"text"`
+	CreateAt int64  `json:"create_at"`
+}
+
+// TermsOfServiceAcceptance records that a user accepted a version of a terms of service policy.
+type TermsOfServiceAcceptance struct {
+	UserId    string `json:"user_id"`
+	VersionId string `json:"version_id"`
+	CreateAt  int64  `json:"create_at"`
+}
+
+// TermsOfServicePolicyReport summarizes the acceptance of the latest version of a policy among the
+// active users it targets.
+type TermsOfServicePolicyReport struct {
+	PolicyId         string `json:"policy_id"`
+	VersionId        string `json:"version_id"`
+	Version          int    `json:"version"`
+	TargetedUsers    int64  `json:"targeted_users"`
+	AcceptedUsers    int64  `json:"accepted_users"`
+	OutstandingUsers int64  `json:"outstanding_users"`
+}
+
+// IsValid validates the policy and returns an error if it isn't configured correctly.
+func (o *TermsOfServicePolicy) IsValid() *AppError {
+	if !IsValidId(o.Id) {
+		return NewAppError("TermsOfServicePolicy.IsValid", "model.terms_of_service_policy.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("TermsOfServicePolicy.IsValid", "model.terms_of_service_policy.is_valid.create_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.UpdateAt == 0 {
+		return NewAppError("TermsOfServicePolicy.IsValid", "model.terms_of_service_policy.is_valid.update_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.DisplayName == "" || utf8.RuneCountInString(o.DisplayName) > TERMS_OF_SERVICE_POLICY_DISPLAY_NAME_MAX_RUNES {
+		return NewAppError("TermsOfServicePolicy.IsValid", "model.terms_of_service_policy.is_valid.display_name.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	for _, teamId := range o.TeamIds {
+		if !IsValidId(teamId) {
+			return NewAppError("TermsOfServicePolicy.IsValid", "model.terms_of_service_policy.is_valid.team_ids.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+		}
+	}
+
+	for _, groupId := range o.GroupIds {
+		if !IsValidId(groupId) {
+			return NewAppError("TermsOfServicePolicy.IsValid", "model.terms_of_service_policy.is_valid.group_ids.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+		}
+	}
+
+	return nil
+}
+
+// PreSave should be run before saving a new policy to the database.
+func (o *TermsOfServicePolicy) PreSave() {
+	if o.Id == "" {
+		o.Id = NewId()
+	}
+
+	o.CreateAt = GetMillis()
+	o.UpdateAt = o.CreateAt
+	o.normalizeIds()
+}
+
+// PreUpdate should be run before saving an updated policy to the database.
+func (o *TermsOfServicePolicy) PreUpdate() {
+	o.UpdateAt = GetMillis()
+	o.normalizeIds()
+}
+
+func (o *TermsOfServicePolicy) normalizeIds() {
+	o.TeamIds = RemoveDuplicateStrings(o.TeamIds)
+	o.GroupIds = RemoveDuplicateStrings(o.GroupIds)
+}
+
+// IsValid validates the version and returns an error if it isn't complete.
+func (o *TermsOfServicePolicyVersion) IsValid() *AppError {
+	if !IsValidId(o.Id) {
+		return NewAppError("TermsOfServicePolicyVersion.IsValid", "model.terms_of_service_policy_version.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.PolicyId) {
+		return NewAppError("TermsOfServicePolicyVersion.IsValid", "model.terms_of_service_policy_version.is_valid.policy_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.UserId) {
+		return NewAppError("TermsOfServicePolicyVersion.IsValid", "model.terms_of_service_policy_version.is_valid.user_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("TermsOfServicePolicyVersion.IsValid", "model.terms_of_service_policy_version.is_valid.create_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.Text == "" || utf8.RuneCountInString(o.Text) > POST_MESSAGE_MAX_RUNES_V2 {
+		return NewAppError("TermsOfServicePolicyVersion.IsValid", "model.terms_of_service_policy_version.is_valid.text.app_error", map[string]interface{}{"MaxLength": POST_MESSAGE_MAX_RUNES_V2}, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// PreSave should be run before saving a new version to the database. The version number is
+// assigned by the store.
+func (o *TermsOfServicePolicyVersion) PreSave() {
+	if o.Id == "" {
+		o.Id = NewId()
+	}
+
+	o.CreateAt = GetMillis()
+}
+
+func (o *TermsOfServicePolicy) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func TermsOfServicePolicyFromJson(data io.Reader) *TermsOfServicePolicy {
+	var o *TermsOfServicePolicy
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
+
+func TermsOfServicePolicyListToJson(l []*TermsOfServicePolicy) string {
+	b, _ := json.Marshal(l)
+	return string(b)
+}
+
+func TermsOfServicePolicyListFromJson(data io.Reader) []*TermsOfServicePolicy {
+	var o []*TermsOfServicePolicy
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
+
+func (o *TermsOfServicePolicyVersion) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func TermsOfServicePolicyVersionFromJson(data io.Reader) *TermsOfServicePolicyVersion {
+	var o *TermsOfServicePolicyVersion
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
+
+func TermsOfServicePolicyVersionListToJson(l []*TermsOfServicePolicyVersion) string {
+	b, _ := json.Marshal(l)
+	return string(b)
+}
+
+func TermsOfServicePolicyVersionListFromJson(data io.Reader) []*TermsOfServicePolicyVersion {
+	var o []*TermsOfServicePolicyVersion
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
+
+func (o *TermsOfServicePolicyReport) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func TermsOfServicePolicyReportFromJson(data io.Reader) *TermsOfServicePolicyReport {
+	var o *TermsOfServicePolicyReport
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTermsOfServicePolicyJson(t *testing.T) {
+	o := &TermsOfServicePolicy{
+		Id:          NewId(),
+		DisplayName: "contractors",
+		TeamIds:     []string{NewId()},
+		GroupIds:    []string{NewId(), NewId()},
+	}
+
+	ro := TermsOfServicePolicyFromJson(strings.NewReader(o.ToJson()))
+	require.Equal(t, o, ro)
+
+	l := TermsOfServicePolicyListFromJson(strings.NewReader(TermsOfServicePolicyListToJson([]*TermsOfServicePolicy{o})))
+	require.Len(t, l, 1)
+	require.Equal(t, o, l[0])
+
+	v := &TermsOfServicePolicyVersion{Id: NewId(), PolicyId: o.Id, Version: 2, UserId: NewId(), Text: "terms", CreateAt: 1}
+	vl := TermsOfServicePolicyVersionListFromJson(strings.NewReader(TermsOfServicePolicyVersionListToJson([]*TermsOfServicePolicyVersion{v})))
+	require.Len(t, vl, 1)
+	require.Equal(t, v, vl[0])
+}
+
+func TestTermsOfServicePolicyIsValid(t *testing.T) {
+	valid := func() *TermsOfServicePolicy {
+		o := &TermsOfServicePolicy{
+			DisplayName: "contractors",
+			TeamIds:     []string{NewId()},
+			GroupIds:    []string{NewId()},
+		}
+		o.PreSave()
+		return o
+	}
+
+	testCases := []struct {
+		Description string
+		Modify      func(o *TermsOfServicePolicy)
+		Valid       bool
+	}{
+		{"valid", func(o *TermsOfServicePolicy) {}, true},
+		{"no targets", func(o *TermsOfServicePolicy) {
+			o.TeamIds = nil
+			o.GroupIds = nil
+		}, true},
+		{"invalid id", func(o *TermsOfServicePolicy) { o.Id = "junk" }, false},
+		{"missing create at", func(o *TermsOfServicePolicy) { o.CreateAt = 0 }, false},
+		{"missing update at", func(o *TermsOfServicePolicy) { o.UpdateAt = 0 }, false},
+		{"empty display name", func(o *TermsOfServicePolicy) { o.DisplayName = "" }, false},
+		{"long display name", func(o *TermsOfServicePolicy) {
+			o.DisplayName = strings.Repeat("a", TERMS_OF_SERVICE_POLICY_DISPLAY_NAME_MAX_RUNES+1)
+		}, false},
+		{"invalid team id", func(o *TermsOfServicePolicy) { o.TeamIds = []string{"junk"} }, false},
+		{"invalid group id", func(o *TermsOfServicePolicy) { o.GroupIds = []string{""} }, false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Description, func(t *testing.T) {
+			o := valid()
+			testCase.Modify(o)
+			if testCase.Valid {
+				assert.Nil(t, o.IsValid())
+			} else {
+				assert.NotNil(t, o.IsValid())
+			}
+		})
+	}
+}
+
+func TestTermsOfServicePolicyVersionIsValid(t *testing.T) {
+	valid := func() *TermsOfServicePolicyVersion {
+		o := &TermsOfServicePolicyVersion{
+			PolicyId: NewId(),
+			UserId:   NewId(),
+			Text:     "terms",
+		}
+		o.PreSave()
+		return o
+	}
+
+	testCases := []struct {
+		Description string
+		Modify      func(o *TermsOfServicePolicyVersion)
+		Valid       bool
+	}{
+		{"valid", func(o *TermsOfServicePolicyVersion) {}, true},
+		{"invalid id", func(o *TermsOfServicePolicyVersion) { o.Id = "junk" }, false},
+		{"invalid policy id", func(o *TermsOfServicePolicyVersion) { o.PolicyId = "" }, false},
+		{"invalid user id", func(o *TermsOfServicePolicyVersion) { o.UserId = "junk" }, false},
+		{"missing create at", func(o *TermsOfServicePolicyVersion) { o.CreateAt = 0 }, false},
+		{"empty text", func(o *TermsOfServicePolicyVersion) { o.Text = "" }, false},
+		{"long text", func(o *TermsOfServicePolicyVersion) {
+			o.Text = strings.Repeat("a", POST_MESSAGE_MAX_RUNES_V2+1)
+		}, false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Description, func(t *testing.T) {
+			o := valid()
+			testCase.Modify(o)
+			if testCase.Valid {
+				assert.Nil(t, o.IsValid())
+			} else {
+				assert.NotNil(t, o.IsValid())
+			}
+		})
+	}
+}
+
+func TestTermsOfServicePolicyPreSaveRemovesDuplicates(t *testing.T) {
+	groupId := NewId()
+	o := &TermsOfServicePolicy{GroupIds: []string{groupId, groupId}}
+	o.PreSave()
+
+	assert.Equal(t, []string{groupId}, o.GroupIds)
+	assert.Equal(t, []string{}, o.TeamIds)
+}
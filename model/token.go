@@ -9,6 +9,7 @@ const (
 	TOKEN_SIZE            = 64
 	MAX_TOKEN_EXIPRY_TIME = 1000 * 60 * 60 * 48 // 48 hour
 	TOKEN_TYPE_OAUTH      = "oauth"
+	TOKEN_TYPE_EMBED      = "embed"
 )
 
 type Token struct {
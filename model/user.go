@@ -28,6 +28,7 @@ const (
 	USER_NOTIFY_HERE                   = "here"
 	USER_NOTIFY_MENTION                = "mention"
 	USER_NOTIFY_NONE                   = "none"
+	USER_NOTIFY_DEFAULT                = "default"
 	DESKTOP_NOTIFY_PROP                = "desktop"
 	DESKTOP_SOUND_NOTIFY_PROP          = "desktop_sound"
 	MARK_UNREAD_NOTIFY_PROP            = "mark_unread"
@@ -85,6 +86,10 @@ type User struct {
 	Timezone               StringMap `json:"timezone"`
 	MfaActive              bool      `json:"mfa_active,omitempty"`
 	MfaSecret              string    `json:"mfa_secret,omitempty"`
+	ManagerId              string    `json:"manager_id,omitempty"`
+	LastLogin              int64     `json:"last_login,omitempty"`
+	IsServiceAccount       bool      `json:"is_service_account,omitempty"`
+	RolesExpiresAt         int64     `json:"roles_expires_at,omitempty"`
 	LastActivityAt         int64     `db:"-" json:"last_activity_at,omitempty"`
 	IsBot                  bool      `db:"-" json:"is_bot,omitempty"`
 	BotDescription         string    `db:"-" json:"bot_description,omitempty"`
@@ -267,6 +272,10 @@ func (u *User) IsValid() *AppError {
 		return InvalidUserError("position", u.Id)
 	}
 
+	if u.ManagerId != "" && (!IsValidId(u.ManagerId) || u.ManagerId == u.Id) {
+		return InvalidUserError("manager_id", u.Id)
+	}
+
 	if utf8.RuneCountInString(u.FirstName) > USER_FIRST_NAME_MAX_RUNES {
 		return InvalidUserError("first_name", u.Id)
 	}
@@ -404,16 +413,25 @@ func (u *User) PreUpdate() {
 }
 
 func (u *User) SetDefaultNotifications() {
-	u.NotifyProps = make(map[string]string)
-	u.NotifyProps[EMAIL_NOTIFY_PROP] = "true"
-	u.NotifyProps[PUSH_NOTIFY_PROP] = USER_NOTIFY_MENTION
-	u.NotifyProps[DESKTOP_NOTIFY_PROP] = USER_NOTIFY_MENTION
-	u.NotifyProps[DESKTOP_SOUND_NOTIFY_PROP] = "true"
-	u.NotifyProps[MENTION_KEYS_NOTIFY_PROP] = ""
-	u.NotifyProps[CHANNEL_MENTIONS_NOTIFY_PROP] = "true"
-	u.NotifyProps[PUSH_STATUS_NOTIFY_PROP] = STATUS_AWAY
-	u.NotifyProps[COMMENTS_NOTIFY_PROP] = COMMENTS_NOTIFY_NEVER
-	u.NotifyProps[FIRST_NAME_NOTIFY_PROP] = "false"
+	u.NotifyProps = DefaultUserNotifyProps()
+}
+
+// DefaultUserNotifyProps returns the system default notification preferences, given to new users.
+// The preferences a team can set a default for are marked as inherited, so that new users follow
+// the defaults of their teams until they change them.
+func DefaultUserNotifyProps() StringMap {
+	return StringMap{
+		INHERITED_NOTIFY_PROP:        strings.Join(teamNotifyDefaultProps, ","),
+		EMAIL_NOTIFY_PROP:            "true",
+		PUSH_NOTIFY_PROP:             USER_NOTIFY_MENTION,
+		DESKTOP_NOTIFY_PROP:          USER_NOTIFY_MENTION,
+		DESKTOP_SOUND_NOTIFY_PROP:    "true",
+		MENTION_KEYS_NOTIFY_PROP:     "",
+		CHANNEL_MENTIONS_NOTIFY_PROP: "true",
+		PUSH_STATUS_NOTIFY_PROP:      STATUS_AWAY,
+		COMMENTS_NOTIFY_PROP:         COMMENTS_NOTIFY_NEVER,
+		FIRST_NAME_NOTIFY_PROP:       "false",
+	}
 }
 
 func (u *User) UpdateMentionKeysFromUsername(oldUsername string) {
@@ -528,6 +546,9 @@ func (u *User) Sanitize(options map[string]bool) {
 	if len(options) != 0 && !options["authservice"] {
 		u.AuthService = ""
 	}
+	if len(options) != 0 && !options["lastlogin"] {
+		u.LastLogin = 0
+	}
 }
 
 // Remove any input data from the user object that is not user controlled
@@ -538,6 +559,8 @@ func (u *User) SanitizeInput(isAdmin bool) {
 	}
 	u.LastPasswordUpdate = 0
 	u.LastPictureUpdate = 0
+	u.LastLogin = 0
+	u.IsServiceAccount = false
 	u.FailedAttempts = 0
 	u.EmailVerified = false
 	u.MfaActive = false
@@ -575,6 +598,14 @@ func (u *User) AddNotifyProp(key string, value string) {
 	u.MakeNonNil()
 
 	u.NotifyProps[key] = value
+
+	var inherited []string
+	for _, inheritedKey := range inheritedNotifyProps(u.NotifyProps) {
+		if inheritedKey != key {
+			inherited = append(inherited, inheritedKey)
+		}
+	}
+	setInheritedNotifyProps(u.NotifyProps, inherited)
 }
 
 func (u *User) GetFullName() string {
@@ -620,11 +651,13 @@ func (u *User) GetDisplayNameWithPrefix(nameFormat, prefix string) string {
 }
 
 func (u *User) GetRoles() []string {
-	return strings.Fields(u.Roles)
+	return strings.Fields(u.GetRawRoles())
 }
 
+// GetRawRoles returns the roles of the user. Once RolesExpiresAt has passed, only the system_user
+// and system_guest roles are left, since every other system role was granted for a limited time.
 func (u *User) GetRawRoles() string {
-	return u.Roles
+	return RemoveExpiredSystemRoles(u.Roles, u.RolesExpiresAt, GetMillis())
 }
 
 func IsValidUserRoles(userRoles string) bool {
@@ -652,13 +685,13 @@ func (u *User) IsGuest() bool {
 }
 
 func (u *User) IsSystemAdmin() bool {
-	return IsInRole(u.Roles, SYSTEM_ADMIN_ROLE_ID)
+	return IsInRole(u.GetRawRoles(), SYSTEM_ADMIN_ROLE_ID)
 }
 
 // Make sure you acually want to use this function. In context.go there are functions to check permissions
 // This function should not be used to check permissions.
 func (u *User) IsInRole(inRole string) bool {
-	return IsInRole(u.Roles, inRole)
+	return IsInRole(u.GetRawRoles(), inRole)
 }
 
 // Make sure you acually want to use this function. In context.go there are functions to check permissions
@@ -11,6 +11,8 @@ type UserCountOptions struct {
 	IncludeDeleted bool
 	// Exclude regular users
 	ExcludeRegularUsers bool
+	// Exclude service accounts
+	ExcludeServiceAccounts bool
 	// Only include users on a specific team. "" for any team.
 	TeamId string
 	// Only include users on a specific channel. "" for any channel.
@@ -23,4 +25,7 @@ type UserCountOptions struct {
 	ChannelRoles []string
 	// Only include users matching any of the given team roles, must be used with TeamId.
 	TeamRoles []string
+	// Estimate the count from the table statistics on large tables instead of counting the rows. Only
+	// used when no team, channel or role filter is given, and then includes bots and deleted users.
+	Estimate bool
 }
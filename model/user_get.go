@@ -30,6 +30,8 @@ type UserGetOptions struct {
 	ChannelRoles []string
 	// Filters for users matching any of the given team roles, must be used with InTeamId
 	TeamRoles []string
+	// Filters for users by how and when they log in
+	UserAccountFilters
 	// Sorting option
 	Sort string
 	// Restrict to search in a list of teams and channels
@@ -0,0 +1,194 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	USER_PROPERTY_FIELD_TYPE_TEXT   = "text"
+	USER_PROPERTY_FIELD_TYPE_SELECT = "select"
+	USER_PROPERTY_FIELD_TYPE_DATE   = "date"
+	USER_PROPERTY_FIELD_TYPE_URL    = "url"
+
+	// USER_PROPERTY_DATE_FORMAT is the layout of the values of date fields.
+	USER_PROPERTY_DATE_FORMAT = "2006-01-02"
+
+	USER_PROPERTY_FIELD_NAME_MAX_LENGTH         = 64
+	USER_PROPERTY_FIELD_DISPLAY_NAME_MAX_RUNES  = 64
+	USER_PROPERTY_FIELD_OPTION_MAX_RUNES        = 64
+	USER_PROPERTY_FIELD_MAX_OPTIONS             = 100
+	USER_PROPERTY_FIELD_LDAP_ATTRIBUTE_MAX_SIZE = 128
+	USER_PROPERTY_VALUE_MAX_RUNES               = 512
+
+	// USER_PROPERTY_FIELDS_MAX bounds the number of fields, since every one of them is returned with each profile.
+	USER_PROPERTY_FIELDS_MAX = 50
+)
+
+var userPropertyFieldNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// UserPropertyField is an admin-defined custom attribute of user profiles. Fields mapped to an LDAP
+// attribute are populated from the directory and can't be edited by the users themselves.
+type UserPropertyField struct {
+	Id            string      `json:"id"`
+	Name          string      `json:"name"`
+	DisplayName   string      `json:"display_name"`
+	Type          string      `json:"type"`
+	Options       StringArray `json:"options"`
+	LdapAttribute string      `json:"ldap_attribute"`
+	CreateAt      int64       `json:"create_at"`
+	UpdateAt      int64       `json:"update_at"`
+	DeleteAt      int64       `json:"delete_at"`
+}
+
+// UserPropertyValue is the value of a custom attribute for a user.
+type UserPropertyValue struct {
+	UserId   string `json:"user_id"`
+	FieldId  string `json:"field_id"`
+	Value    string `json:"value"`
+	UpdateAt int64  `json:"update_at"`
+}
+
+// IsValid validates the field and returns an error if it isn't configured correctly.
+func (o *UserPropertyField) IsValid() *AppError {
+	if !IsValidId(o.Id) {
+		return NewAppError("UserPropertyField.IsValid", "model.user_property_field.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if len(o.Name) > USER_PROPERTY_FIELD_NAME_MAX_LENGTH || !userPropertyFieldNameRegex.MatchString(o.Name) {
+		return NewAppError("UserPropertyField.IsValid", "model.user_property_field.is_valid.name.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.DisplayName == "" || utf8.RuneCountInString(o.DisplayName) > USER_PROPERTY_FIELD_DISPLAY_NAME_MAX_RUNES {
+		return NewAppError("UserPropertyField.IsValid", "model.user_property_field.is_valid.display_name.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	switch o.Type {
+	case USER_PROPERTY_FIELD_TYPE_TEXT, USER_PROPERTY_FIELD_TYPE_DATE, USER_PROPERTY_FIELD_TYPE_URL:
+		if len(o.Options) != 0 {
+			return NewAppError("UserPropertyField.IsValid", "model.user_property_field.is_valid.options.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+		}
+	case USER_PROPERTY_FIELD_TYPE_SELECT:
+		if len(o.Options) == 0 || len(o.Options) > USER_PROPERTY_FIELD_MAX_OPTIONS {
+			return NewAppError("UserPropertyField.IsValid", "model.user_property_field.is_valid.options.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+		}
+		seen := make(map[string]bool, len(o.Options))
+		for _, option := range o.Options {
+			if option == "" || utf8.RuneCountInString(option) > USER_PROPERTY_FIELD_OPTION_MAX_RUNES || seen[option] {
+				return NewAppError("UserPropertyField.IsValid", "model.user_property_field.is_valid.options.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+			}
+			seen[option] = true
+		}
+	default:
+		return NewAppError("UserPropertyField.IsValid", "model.user_property_field.is_valid.type.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if len(o.LdapAttribute) > USER_PROPERTY_FIELD_LDAP_ATTRIBUTE_MAX_SIZE {
+		return NewAppError("UserPropertyField.IsValid", "model.user_property_field.is_valid.ldap_attribute.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("UserPropertyField.IsValid", "model.user_property_field.is_valid.create_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.UpdateAt == 0 {
+		return NewAppError("UserPropertyField.IsValid", "model.user_property_field.is_valid.update_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// PreSave should be run before saving a new field to the database.
+func (o *UserPropertyField) PreSave() {
+	if o.Id == "" {
+		o.Id = NewId()
+	}
+
+	o.CreateAt = GetMillis()
+	o.UpdateAt = o.CreateAt
+	o.Name = strings.ToLower(strings.TrimSpace(o.Name))
+
+	if o.Options == nil {
+		o.Options = StringArray{}
+	}
+}
+
+// PreUpdate should be run before saving an updated field to the database.
+func (o *UserPropertyField) PreUpdate() {
+	o.UpdateAt = GetMillis()
+	o.Name = strings.ToLower(strings.TrimSpace(o.Name))
+
+	if o.Options == nil {
+		o.Options = StringArray{}
+	}
+}
+
+// IsSynced reports whether the values of the field come from the directory.
+func (o *UserPropertyField) IsSynced() bool {
+	return o.LdapAttribute != ""
+}
+
+// ValidateValue returns an error if the given value can't be stored in the field. An empty value
+// clears the field.
+func (o *UserPropertyField) ValidateValue(value string) *AppError {
+	if value == "" {
+		return nil
+	}
+
+	if utf8.RuneCountInString(value) > USER_PROPERTY_VALUE_MAX_RUNES {
+		return NewAppError("UserPropertyField.ValidateValue", "model.user_property_value.is_valid.too_long.app_error", map[string]interface{}{"Name": o.Name, "MaxLength": USER_PROPERTY_VALUE_MAX_RUNES}, "field_id="+o.Id, http.StatusBadRequest)
+	}
+
+	valid := true
+	switch o.Type {
+	case USER_PROPERTY_FIELD_TYPE_SELECT:
+		valid = false
+		for _, option := range o.Options {
+			if option == value {
+				valid = true
+				break
+			}
+		}
+	case USER_PROPERTY_FIELD_TYPE_DATE:
+		_, err := time.Parse(USER_PROPERTY_DATE_FORMAT, value)
+		valid = err == nil
+	case USER_PROPERTY_FIELD_TYPE_URL:
+		valid = IsValidHttpUrl(value)
+	}
+
+	if !valid {
+		return NewAppError("UserPropertyField.ValidateValue", "model.user_property_value.is_valid.value.app_error", map[string]interface{}{"Name": o.Name}, "field_id="+o.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (o *UserPropertyField) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func UserPropertyFieldFromJson(data io.Reader) *UserPropertyField {
+	var o *UserPropertyField
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
+
+func UserPropertyFieldListToJson(l []*UserPropertyField) string {
+	b, _ := json.Marshal(l)
+	return string(b)
+}
+
+func UserPropertyFieldListFromJson(data io.Reader) []*UserPropertyField {
+	var o []*UserPropertyField
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserPropertyFieldJson(t *testing.T) {
+	o := &UserPropertyField{
+		Id:          NewId(),
+		Name:        "office",
+		DisplayName: "Office",
+		Type:        USER_PROPERTY_FIELD_TYPE_SELECT,
+		Options:     StringArray{"Berlin", "Toronto"},
+	}
+
+	ro := UserPropertyFieldFromJson(strings.NewReader(o.ToJson()))
+	require.Equal(t, o, ro)
+
+	l := UserPropertyFieldListFromJson(strings.NewReader(UserPropertyFieldListToJson([]*UserPropertyField{o})))
+	require.Len(t, l, 1)
+	require.Equal(t, o, l[0])
+}
+
+func TestUserPropertyFieldIsValid(t *testing.T) {
+	valid := func() *UserPropertyField {
+		o := &UserPropertyField{
+			Name:        " Cost_Center ",
+			DisplayName: "Cost center",
+			Type:        USER_PROPERTY_FIELD_TYPE_TEXT,
+		}
+		o.PreSave()
+		return o
+	}
+
+	testCases := []struct {
+		Description string
+		Modify      func(o *UserPropertyField)
+		Valid       bool
+	}{
+		{"valid", func(o *UserPropertyField) {}, true},
+		{"select", func(o *UserPropertyField) {
+			o.Type = USER_PROPERTY_FIELD_TYPE_SELECT
+			o.Options = StringArray{"a", "b"}
+		}, true},
+		{"synced", func(o *UserPropertyField) { o.LdapAttribute = "departmentNumber" }, true},
+		{"invalid id", func(o *UserPropertyField) { o.Id = "junk" }, false},
+		{"empty name", func(o *UserPropertyField) { o.Name = "" }, false},
+		{"invalid name", func(o *UserPropertyField) { o.Name = "cost-center" }, false},
+		{"long name", func(o *UserPropertyField) { o.Name = strings.Repeat("a", USER_PROPERTY_FIELD_NAME_MAX_LENGTH+1) }, false},
+		{"empty display name", func(o *UserPropertyField) { o.DisplayName = "" }, false},
+		{"unknown type", func(o *UserPropertyField) { o.Type = "number" }, false},
+		{"text with options", func(o *UserPropertyField) { o.Options = StringArray{"a"} }, false},
+		{"select without options", func(o *UserPropertyField) { o.Type = USER_PROPERTY_FIELD_TYPE_SELECT }, false},
+		{"select with duplicate options", func(o *UserPropertyField) {
+			o.Type = USER_PROPERTY_FIELD_TYPE_SELECT
+			o.Options = StringArray{"a", "a"}
+		}, false},
+		{"long ldap attribute", func(o *UserPropertyField) {
+			o.LdapAttribute = strings.Repeat("a", USER_PROPERTY_FIELD_LDAP_ATTRIBUTE_MAX_SIZE+1)
+		}, false},
+		{"missing create at", func(o *UserPropertyField) { o.CreateAt = 0 }, false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Description, func(t *testing.T) {
+			o := valid()
+			testCase.Modify(o)
+			if testCase.Valid {
+				assert.Nil(t, o.IsValid())
+			} else {
+				assert.NotNil(t, o.IsValid())
+			}
+		})
+	}
+
+	assert.Equal(t, "cost_center", valid().Name)
+}
+
+func TestUserPropertyFieldValidateValue(t *testing.T) {
+	testCases := []struct {
+		Description string
+		Field       *UserPropertyField
+		Value       string
+		Valid       bool
+	}{
+		{"empty value", &UserPropertyField{Type: USER_PROPERTY_FIELD_TYPE_DATE}, "", true},
+		{"text", &UserPropertyField{Type: USER_PROPERTY_FIELD_TYPE_TEXT}, "anything", true},
+		{"long text", &UserPropertyField{Type: USER_PROPERTY_FIELD_TYPE_TEXT}, strings.Repeat("a", USER_PROPERTY_VALUE_MAX_RUNES+1), false},
+		{"select option", &UserPropertyField{Type: USER_PROPERTY_FIELD_TYPE_SELECT, Options: StringArray{"a", "b"}}, "b", true},
+		{"select unknown option", &UserPropertyField{Type: USER_PROPERTY_FIELD_TYPE_SELECT, Options: StringArray{"a", "b"}}, "c", false},
+		{"date", &UserPropertyField{Type: USER_PROPERTY_FIELD_TYPE_DATE}, "2020-02-29", true},
+		{"invalid date", &UserPropertyField{Type: USER_PROPERTY_FIELD_TYPE_DATE}, "2021-02-29", false},
+		{"url", &UserPropertyField{Type: USER_PROPERTY_FIELD_TYPE_URL}, "https://example.com/me", true},
+		{"invalid url", &UserPropertyField{Type: USER_PROPERTY_FIELD_TYPE_URL}, "javascript:alert(1)", false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Description, func(t *testing.T) {
+			if testCase.Valid {
+				assert.Nil(t, testCase.Field.ValidateValue(testCase.Value))
+			} else {
+				assert.NotNil(t, testCase.Field.ValidateValue(testCase.Value))
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+const (
+	USER_RELATIONSHIP_MUTE  = "mute"
+	USER_RELATIONSHIP_BLOCK = "block"
+)
+
+// UserRelationship records that a user muted or blocked another user. The posts of a muted user
+// don't notify the user who muted them, and a blocked user can neither send direct messages to nor
+// mention the user who blocked them.
+type UserRelationship struct {
+	UserId      string `json:"user_id"`
+	OtherUserId string `json:"other_user_id"`
+	Type        string `json:"type"`
+	CreateAt    int64  `json:"create_at"`
+}
+
+func IsValidUserRelationshipType(relationshipType string) bool {
+	return relationshipType == USER_RELATIONSHIP_MUTE || relationshipType == USER_RELATIONSHIP_BLOCK
+}
+
+func (o *UserRelationship) IsValid() *AppError {
+	if !IsValidId(o.UserId) {
+		return NewAppError("UserRelationship.IsValid", "model.user_relationship.is_valid.user_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.OtherUserId) || o.OtherUserId == o.UserId {
+		return NewAppError("UserRelationship.IsValid", "model.user_relationship.is_valid.other_user_id.app_error", nil, "user_id="+o.UserId, http.StatusBadRequest)
+	}
+
+	if !IsValidUserRelationshipType(o.Type) {
+		return NewAppError("UserRelationship.IsValid", "model.user_relationship.is_valid.type.app_error", nil, "user_id="+o.UserId, http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("UserRelationship.IsValid", "model.user_relationship.is_valid.create_at.app_error", nil, "user_id="+o.UserId, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (o *UserRelationship) PreSave() {
+	if o.CreateAt == 0 {
+		o.CreateAt = GetMillis()
+	}
+}
+
+func (o *UserRelationship) ToJson() string {
+	b, _ := json.Marshal(o)
+	return string(b)
+}
+
+func UserRelationshipFromJson(data io.Reader) *UserRelationship {
+	var o *UserRelationship
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
+
+func UserRelationshipListToJson(l []*UserRelationship) string {
+	b, _ := json.Marshal(l)
+	return string(b)
+}
+
+func UserRelationshipListFromJson(data io.Reader) []*UserRelationship {
+	var o []*UserRelationship
+	json.NewDecoder(data).Decode(&o)
+	return o
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserRelationshipJson(t *testing.T) {
+	o := &UserRelationship{
+		UserId:      NewId(),
+		OtherUserId: NewId(),
+		Type:        USER_RELATIONSHIP_MUTE,
+		CreateAt:    GetMillis(),
+	}
+
+	ro := UserRelationshipFromJson(strings.NewReader(o.ToJson()))
+	require.Equal(t, o, ro)
+
+	l := UserRelationshipListFromJson(strings.NewReader(UserRelationshipListToJson([]*UserRelationship{o})))
+	require.Len(t, l, 1)
+	require.Equal(t, o, l[0])
+}
+
+func TestUserRelationshipIsValid(t *testing.T) {
+	valid := func() *UserRelationship {
+		o := &UserRelationship{
+			UserId:      NewId(),
+			OtherUserId: NewId(),
+			Type:        USER_RELATIONSHIP_MUTE,
+		}
+		o.PreSave()
+		return o
+	}
+
+	testCases := []struct {
+		Description string
+		Modify      func(o *UserRelationship)
+		Valid       bool
+	}{
+		{"valid mute", func(o *UserRelationship) {}, true},
+		{"valid block", func(o *UserRelationship) { o.Type = USER_RELATIONSHIP_BLOCK }, true},
+		{"invalid user id", func(o *UserRelationship) { o.UserId = "invalid" }, false},
+		{"invalid other user id", func(o *UserRelationship) { o.OtherUserId = "" }, false},
+		{"same user", func(o *UserRelationship) { o.OtherUserId = o.UserId }, false},
+		{"invalid type", func(o *UserRelationship) { o.Type = "follow" }, false},
+		{"missing create at", func(o *UserRelationship) { o.CreateAt = 0 }, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			o := valid()
+			tc.Modify(o)
+
+			if tc.Valid {
+				assert.Nil(t, o.IsValid())
+			} else {
+				assert.NotNil(t, o.IsValid())
+			}
+		})
+	}
+}
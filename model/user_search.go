@@ -6,6 +6,7 @@ package model
 import (
 	"encoding/json"
 	"io"
+	"net/http"
 )
 
 const USER_SEARCH_MAX_LIMIT = 1000
@@ -27,6 +28,9 @@ type UserSearch struct {
 	Roles            []string `json:"roles"`
 	ChannelRoles     []string `json:"channel_roles"`
 	TeamRoles        []string `json:"team_roles"`
+	// PropertyFilters maps the ids of custom profile fields to the value the users must have.
+	PropertyFilters map[string]string `json:"property_filters,omitempty"`
+	UserAccountFilters
 }
 
 // ToJson convert a User to a json string
@@ -74,4 +78,47 @@ type UserSearchOptions struct {
 	ViewRestrictions *ViewUsersRestrictions
 	// List of allowed channels
 	ListOfAllowedChannels []string
+	// Filters for users whose custom profile fields, keyed by field id, have the given values
+	PropertyFilters map[string]string
+	// Filters for users by how and when they log in
+	UserAccountFilters
+}
+
+// UserAccountFilters narrows users down by how they log in and when they last did.
+type UserAccountFilters struct {
+	// NeverLoggedIn filters for the users who have never logged in.
+	NeverLoggedIn bool `json:"never_logged_in,omitempty"`
+	// LastLoginBefore filters for the users who haven't logged in since the given time, including
+	// those who never did.
+	LastLoginBefore int64 `json:"last_login_before,omitempty"`
+	// AuthService filters for the users logging in with the given service. USER_AUTH_SERVICE_EMAIL
+	// stands for the users logging in with an email and a password.
+	AuthService string `json:"auth_service,omitempty"`
+	// MfaActive filters for the users who have enabled multi-factor authentication.
+	MfaActive bool `json:"mfa_active,omitempty"`
+	// MfaInactive filters for the users who haven't enabled multi-factor authentication.
+	MfaInactive bool `json:"mfa_inactive,omitempty"`
+}
+
+// IsEmpty reports whether none of the filters is set.
+func (f *UserAccountFilters) IsEmpty() bool {
+	return *f == UserAccountFilters{}
+}
+
+func (f *UserAccountFilters) IsValid() *AppError {
+	if f.LastLoginBefore < 0 {
+		return NewAppError("UserAccountFilters.IsValid", "model.user_account_filters.is_valid.last_login_before.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if f.MfaActive && f.MfaInactive {
+		return NewAppError("UserAccountFilters.IsValid", "model.user_account_filters.is_valid.mfa.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	switch f.AuthService {
+	case "", USER_AUTH_SERVICE_EMAIL, USER_AUTH_SERVICE_LDAP, USER_AUTH_SERVICE_SAML, USER_AUTH_SERVICE_GITLAB, SERVICE_GOOGLE, SERVICE_OFFICE365:
+	default:
+		return NewAppError("UserAccountFilters.IsValid", "model.user_account_filters.is_valid.auth_service.app_error", nil, "auth_service="+f.AuthService, http.StatusBadRequest)
+	}
+
+	return nil
 }
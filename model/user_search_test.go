@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestUserSearchJson(t *testing.T) {
@@ -17,3 +18,34 @@ func TestUserSearchJson(t *testing.T) {
 
 	assert.Equal(t, userSearch.Term, ruserSearch.Term, "Terms do not match")
 }
+
+func TestUserSearchAccountFiltersJson(t *testing.T) {
+	userSearch := UserSearch{Term: NewId()}
+	userSearch.NeverLoggedIn = true
+	userSearch.AuthService = USER_AUTH_SERVICE_LDAP
+
+	ruserSearch := UserSearchFromJson(bytes.NewReader(userSearch.ToJson()))
+	assert.Equal(t, userSearch.UserAccountFilters, ruserSearch.UserAccountFilters)
+
+	ruserSearch = UserSearchFromJson(bytes.NewReader([]byte(`{"term": "a", "last_login_before": 1000, "mfa_inactive": true}`)))
+	assert.Equal(t, UserAccountFilters{LastLoginBefore: 1000, MfaInactive: true}, ruserSearch.UserAccountFilters)
+}
+
+func TestUserAccountFiltersIsValid(t *testing.T) {
+	filters := UserAccountFilters{}
+	require.True(t, filters.IsEmpty())
+	require.Nil(t, filters.IsValid())
+
+	filters = UserAccountFilters{NeverLoggedIn: true, AuthService: USER_AUTH_SERVICE_EMAIL, MfaActive: true}
+	require.False(t, filters.IsEmpty())
+	require.Nil(t, filters.IsValid())
+
+	filters = UserAccountFilters{LastLoginBefore: -1}
+	require.NotNil(t, filters.IsValid())
+
+	filters = UserAccountFilters{MfaActive: true, MfaInactive: true}
+	require.NotNil(t, filters.IsValid())
+
+	filters = UserAccountFilters{AuthService: "unknown"}
+	require.NotNil(t, filters.IsValid())
+}
@@ -148,6 +148,15 @@ func TestUserIsValid(t *testing.T) {
 	user.Position = strings.Repeat("a", 129)
 	err = user.IsValid()
 	require.True(t, HasExpectedUserIsValidError(err, "position", user.Id), "expected user is valid error: %s", err.Error())
+
+	user.Position = ""
+	user.ManagerId = "junk"
+	err = user.IsValid()
+	require.True(t, HasExpectedUserIsValidError(err, "manager_id", user.Id), "expected user is valid error: %s", err.Error())
+
+	user.ManagerId = user.Id
+	err = user.IsValid()
+	require.True(t, HasExpectedUserIsValidError(err, "manager_id", user.Id), "expected user is valid error: %s", err.Error())
 }
 
 func HasExpectedUserIsValidError(err *AppError, fieldName string, userId string) bool {
@@ -271,6 +280,23 @@ func TestRoles(t *testing.T) {
 	require.False(t, IsInRole("admin", "system_admin"))
 }
 
+func TestUserRolesExpiry(t *testing.T) {
+	user := User{Roles: "system_user system_admin"}
+	require.Equal(t, "system_user system_admin", user.GetRawRoles())
+	require.True(t, user.IsSystemAdmin())
+
+	user.RolesExpiresAt = GetMillis() + 60000
+	require.Equal(t, []string{"system_user", "system_admin"}, user.GetRoles())
+	require.True(t, user.IsSystemAdmin())
+
+	user.RolesExpiresAt = GetMillis() - 1
+	require.Equal(t, "system_user", user.GetRawRoles())
+	require.Equal(t, []string{"system_user"}, user.GetRoles())
+	require.False(t, user.IsSystemAdmin())
+	require.False(t, user.IsInRole(SYSTEM_ADMIN_ROLE_ID))
+	require.True(t, user.IsInRole(SYSTEM_USER_ROLE_ID))
+}
+
 func TestIsValidLocale(t *testing.T) {
 	for _, test := range []struct {
 		Name     string
@@ -0,0 +1,59 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+)
+
+const (
+	WEB_CONN_CLIENT_TYPE_WEB     = "web"
+	WEB_CONN_CLIENT_TYPE_DESKTOP = "desktop"
+	WEB_CONN_CLIENT_TYPE_MOBILE  = "mobile"
+)
+
+// WebConnInfo describes a websocket connection open on one of the nodes of the cluster.
+type WebConnInfo struct {
+	ConnectionId   string `json:"connection_id"`
+	UserId         string `json:"user_id"`
+	SessionId      string `json:"session_id"`
+	ClusterId      string `json:"cluster_id"`
+	ClientType     string `json:"client_type"`
+	RemoteAddress  string `json:"remote_address"`
+	ConnectedAt    int64  `json:"connected_at"`
+	LastActivityAt int64  `json:"last_activity_at"`
+	// SubscribedChannels is the number of channels whose events are sent to the connection, or -1
+	// until they are loaded by the first event the connection may receive.
+	SubscribedChannels int `json:"subscribed_channels"`
+}
+
+// GetWebConnClientType returns the kind of client that opened a connection with the given session.
+func GetWebConnClientType(session *Session) string {
+	if session == nil || session.Id == "" {
+		return ""
+	}
+
+	if session.IsMobileApp() {
+		return WEB_CONN_CLIENT_TYPE_MOBILE
+	}
+
+	if strings.HasPrefix(session.Props[SESSION_PROP_BROWSER], "Desktop App") {
+		return WEB_CONN_CLIENT_TYPE_DESKTOP
+	}
+
+	return WEB_CONN_CLIENT_TYPE_WEB
+}
+
+func WebConnInfosToJson(infos []*WebConnInfo) string {
+	b, _ := json.Marshal(infos)
+	return string(b)
+}
+
+func WebConnInfosFromJson(data io.Reader) []*WebConnInfo {
+	var infos []*WebConnInfo
+	json.NewDecoder(data).Decode(&infos)
+	return infos
+}
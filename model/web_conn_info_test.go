@@ -0,0 +1,30 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetWebConnClientType(t *testing.T) {
+	assert.Equal(t, "", GetWebConnClientType(nil))
+	assert.Equal(t, "", GetWebConnClientType(&Session{}))
+
+	session := &Session{Id: NewId(), Props: StringMap{}}
+	assert.Equal(t, WEB_CONN_CLIENT_TYPE_WEB, GetWebConnClientType(session))
+
+	session.Props[SESSION_PROP_BROWSER] = "Desktop App/4.5.0"
+	assert.Equal(t, WEB_CONN_CLIENT_TYPE_DESKTOP, GetWebConnClientType(session))
+
+	session.DeviceId = NewId()
+	assert.Equal(t, WEB_CONN_CLIENT_TYPE_MOBILE, GetWebConnClientType(session))
+}
+
+func TestWebConnInfosJson(t *testing.T) {
+	infos := []*WebConnInfo{{ConnectionId: NewId(), UserId: NewId(), ClientType: WEB_CONN_CLIENT_TYPE_WEB, SubscribedChannels: -1}}
+	assert.Equal(t, infos, WebConnInfosFromJson(strings.NewReader(WebConnInfosToJson(infos))))
+}
@@ -31,6 +31,7 @@ const (
 	WEBSOCKET_EVENT_UPDATE_TEAM                              = "update_team"
 	WEBSOCKET_EVENT_DELETE_TEAM                              = "delete_team"
 	WEBSOCKET_EVENT_RESTORE_TEAM                             = "restore_team"
+	WEBSOCKET_EVENT_TEAM_PURGE_SCHEDULED                     = "team_purge_scheduled"
 	WEBSOCKET_EVENT_UPDATE_TEAM_SCHEME                       = "update_team_scheme"
 	WEBSOCKET_EVENT_USER_ADDED                               = "user_added"
 	WEBSOCKET_EVENT_USER_UPDATED                             = "user_updated"
@@ -66,6 +67,14 @@ const (
 	WEBSOCKET_EVENT_SIDEBAR_CATEGORY_UPDATED                 = "sidebar_category_updated"
 	WEBSOCKET_EVENT_SIDEBAR_CATEGORY_DELETED                 = "sidebar_category_deleted"
 	WEBSOCKET_EVENT_SIDEBAR_CATEGORY_ORDER_UPDATED           = "sidebar_category_order_updated"
+	WEBSOCKET_EVENT_CHANNEL_BOOKMARK_CREATED                 = "channel_bookmark_created"
+	WEBSOCKET_EVENT_CHANNEL_BOOKMARK_UPDATED                 = "channel_bookmark_updated"
+	WEBSOCKET_EVENT_CHANNEL_BOOKMARK_DELETED                 = "channel_bookmark_deleted"
+	WEBSOCKET_EVENT_PIN_REQUESTED                            = "pin_requested"
+	WEBSOCKET_EVENT_PIN_REQUEST_RESOLVED                     = "pin_request_resolved"
+	WEBSOCKET_EVENT_POST_ACKNOWLEDGEMENT_ADDED               = "post_acknowledgement_added"
+	WEBSOCKET_EVENT_POST_ACKNOWLEDGEMENT_REMOVED             = "post_acknowledgement_removed"
+	WEBSOCKET_EVENT_READ_ONLY_MODE_CHANGED                   = "read_only_mode_changed"
 )
 
 type WebSocketMessage interface {
@@ -222,6 +222,22 @@ type API interface {
 	// Minimum server version: 5.2
 	UpdateUserStatus(userId, status string) (*model.Status, *model.AppError)
 
+	// StartUserStatusAutomation sets a user's status to "dnd" until endAt, in milliseconds, on behalf
+	// of source, such as a calendar connector reporting a meeting. The previous status of the user is
+	// restored when the automation ends, unless the user changed their status manually meanwhile.
+	// Starting an automation for a user whose status is already automated extends it.
+	//
+	// @tag User
+	// Minimum server version: 5.26
+	StartUserStatusAutomation(userId, source string, endAt int64) (*model.StatusAutomation, *model.AppError)
+
+	// EndUserStatusAutomation ends the automation of a user's status right away, restoring the status
+	// the user had before it started.
+	//
+	// @tag User
+	// Minimum server version: 5.26
+	EndUserStatusAutomation(userId string) *model.AppError
+
 	// UpdateUserActive deactivates or reactivates an user.
 	//
 	// @tag User
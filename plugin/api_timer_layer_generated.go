@@ -259,6 +259,20 @@ func (api *apiTimerLayer) UpdateUserStatus(userId, status string) (*model.Status
 	return _returnsA, _returnsB
 }
 
+func (api *apiTimerLayer) StartUserStatusAutomation(userId, source string, endAt int64) (*model.StatusAutomation, *model.AppError) {
+	startTime := timePkg.Now()
+	_returnsA, _returnsB := api.apiImpl.StartUserStatusAutomation(userId, source, endAt)
+	api.recordTime(startTime, "StartUserStatusAutomation", _returnsB == nil)
+	return _returnsA, _returnsB
+}
+
+func (api *apiTimerLayer) EndUserStatusAutomation(userId string) *model.AppError {
+	startTime := timePkg.Now()
+	_returnsA := api.apiImpl.EndUserStatusAutomation(userId)
+	api.recordTime(startTime, "EndUserStatusAutomation", _returnsA == nil)
+	return _returnsA
+}
+
 func (api *apiTimerLayer) UpdateUserActive(userId string, active bool) *model.AppError {
 	startTime := timePkg.Now()
 	_returnsA := api.apiImpl.UpdateUserActive(userId, active)
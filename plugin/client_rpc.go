@@ -528,6 +528,153 @@ func (s *hooksRPCServer) FileWillBeUploaded(args *Z_FileWillBeUploadedArgs, retu
 	return nil
 }
 
+func init() {
+	hookNameToId["FileWillBeDownloaded"] = FileWillBeDownloadedId
+}
+
+type Z_FileWillBeDownloadedArgs struct {
+	A                     *Context
+	B                     *model.FileInfo
+	C                     string
+	DownloadedFileStream  uint32
+	ReplacementFileStream uint32
+}
+
+type Z_FileWillBeDownloadedReturns struct {
+	A string
+}
+
+func (g *hooksRPCClient) FileWillBeDownloaded(c *Context, info *model.FileInfo, userId string, file io.Reader, output io.Writer) string {
+	if !g.implemented[FileWillBeDownloadedId] {
+		return ""
+	}
+
+	downloadedFileStreamId := g.muxBroker.NextId()
+	go func() {
+		downloadedFileConnection, err := g.muxBroker.Accept(downloadedFileStreamId)
+		if err != nil {
+			g.log.Error("Plugin failed to serve download file stream. MuxBroker could not Accept connection", mlog.Err(err))
+			return
+		}
+		defer downloadedFileConnection.Close()
+		serveIOReader(file, downloadedFileConnection)
+	}()
+
+	replacementDone := make(chan bool)
+	replacementFileStreamId := g.muxBroker.NextId()
+	go func() {
+		defer close(replacementDone)
+
+		replacementFileConnection, err := g.muxBroker.Accept(replacementFileStreamId)
+		if err != nil {
+			g.log.Error("Plugin failed to serve replacement file stream. MuxBroker could not Accept connection", mlog.Err(err))
+			return
+		}
+		defer replacementFileConnection.Close()
+		if _, err := io.Copy(output, replacementFileConnection); err != nil {
+			g.log.Error("Error reading replacement file.", mlog.Err(err))
+		}
+	}()
+
+	_args := &Z_FileWillBeDownloadedArgs{c, info, userId, downloadedFileStreamId, replacementFileStreamId}
+	_returns := &Z_FileWillBeDownloadedReturns{}
+	if err := g.client.Call("Plugin.FileWillBeDownloaded", _args, _returns); err != nil {
+		g.log.Error("RPC call FileWillBeDownloaded to plugin failed.", mlog.Err(err))
+	}
+
+	// Ensure the io.Copy from the replacementFileConnection above completes.
+	<-replacementDone
+
+	return _returns.A
+}
+
+func (s *hooksRPCServer) FileWillBeDownloaded(args *Z_FileWillBeDownloadedArgs, returns *Z_FileWillBeDownloadedReturns) error {
+	downloadFileConnection, err := s.muxBroker.Dial(args.DownloadedFileStream)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] Can't connect to remote download file stream, error: %v", err.Error())
+		return err
+	}
+	defer downloadFileConnection.Close()
+	fileReader := connectIOReader(downloadFileConnection)
+	defer fileReader.Close()
+
+	replacementFileConnection, err := s.muxBroker.Dial(args.ReplacementFileStream)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] Can't connect to remote replacement file stream, error: %v", err.Error())
+		return err
+	}
+	defer replacementFileConnection.Close()
+	returnFileWriter := replacementFileConnection
+
+	if hook, ok := s.impl.(interface {
+		FileWillBeDownloaded(c *Context, info *model.FileInfo, userId string, file io.Reader, output io.Writer) string
+	}); ok {
+		returns.A = hook.FileWillBeDownloaded(args.A, args.B, args.C, fileReader, returnFileWriter)
+	} else {
+		return fmt.Errorf("Hook FileWillBeDownloaded called but not implemented.")
+	}
+	return nil
+}
+
+func init() {
+	hookNameToId["FileWillBeEnriched"] = FileWillBeEnrichedId
+}
+
+type Z_FileWillBeEnrichedArgs struct {
+	A                  *Context
+	B                  *model.FileInfo
+	EnrichedFileStream uint32
+}
+
+type Z_FileWillBeEnrichedReturns struct {
+	A map[string]string
+}
+
+func (g *hooksRPCClient) FileWillBeEnriched(c *Context, info *model.FileInfo, file io.Reader) map[string]string {
+	if !g.implemented[FileWillBeEnrichedId] {
+		return nil
+	}
+
+	enrichedFileStreamId := g.muxBroker.NextId()
+	go func() {
+		enrichedFileConnection, err := g.muxBroker.Accept(enrichedFileStreamId)
+		if err != nil {
+			g.log.Error("Plugin failed to serve enriched file stream. MuxBroker could not Accept connection", mlog.Err(err))
+			return
+		}
+		defer enrichedFileConnection.Close()
+		serveIOReader(file, enrichedFileConnection)
+	}()
+
+	_args := &Z_FileWillBeEnrichedArgs{c, info, enrichedFileStreamId}
+	_returns := &Z_FileWillBeEnrichedReturns{}
+	if err := g.client.Call("Plugin.FileWillBeEnriched", _args, _returns); err != nil {
+		g.log.Error("RPC call FileWillBeEnriched to plugin failed.", mlog.Err(err))
+	}
+
+	return _returns.A
+}
+
+func (s *hooksRPCServer) FileWillBeEnriched(args *Z_FileWillBeEnrichedArgs, returns *Z_FileWillBeEnrichedReturns) error {
+	enrichedFileConnection, err := s.muxBroker.Dial(args.EnrichedFileStream)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] Can't connect to remote enriched file stream, error: %v", err.Error())
+		return err
+	}
+	defer enrichedFileConnection.Close()
+	fileReader := connectIOReader(enrichedFileConnection)
+	defer fileReader.Close()
+
+	if hook, ok := s.impl.(interface {
+		FileWillBeEnriched(c *Context, info *model.FileInfo, file io.Reader) map[string]string
+	}); ok {
+		returns.A = hook.FileWillBeEnriched(args.A, args.B, fileReader)
+	} else {
+		return fmt.Errorf("Hook FileWillBeEnriched called but not implemented.")
+	}
+	return nil
+}
+
 // MessageWillBePosted is in this file because of the difficulty of identifying which fields need special behaviour.
 // The special behaviour needed is decoding the returned post into the original one to avoid the unintentional removal
 // of fields by older plugins.
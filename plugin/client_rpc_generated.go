@@ -1380,6 +1380,65 @@ func (s *apiRPCServer) UpdateUserStatus(args *Z_UpdateUserStatusArgs, returns *Z
 	return nil
 }
 
+type Z_StartUserStatusAutomationArgs struct {
+	A string
+	B string
+	C int64
+}
+
+type Z_StartUserStatusAutomationReturns struct {
+	A *model.StatusAutomation
+	B *model.AppError
+}
+
+func (g *apiRPCClient) StartUserStatusAutomation(userId, source string, endAt int64) (*model.StatusAutomation, *model.AppError) {
+	_args := &Z_StartUserStatusAutomationArgs{userId, source, endAt}
+	_returns := &Z_StartUserStatusAutomationReturns{}
+	if err := g.client.Call("Plugin.StartUserStatusAutomation", _args, _returns); err != nil {
+		log.Printf("RPC call to StartUserStatusAutomation API failed: %s", err.Error())
+	}
+	return _returns.A, _returns.B
+}
+
+func (s *apiRPCServer) StartUserStatusAutomation(args *Z_StartUserStatusAutomationArgs, returns *Z_StartUserStatusAutomationReturns) error {
+	if hook, ok := s.impl.(interface {
+		StartUserStatusAutomation(userId, source string, endAt int64) (*model.StatusAutomation, *model.AppError)
+	}); ok {
+		returns.A, returns.B = hook.StartUserStatusAutomation(args.A, args.B, args.C)
+	} else {
+		return encodableError(fmt.Errorf("API StartUserStatusAutomation called but not implemented."))
+	}
+	return nil
+}
+
+type Z_EndUserStatusAutomationArgs struct {
+	A string
+}
+
+type Z_EndUserStatusAutomationReturns struct {
+	A *model.AppError
+}
+
+func (g *apiRPCClient) EndUserStatusAutomation(userId string) *model.AppError {
+	_args := &Z_EndUserStatusAutomationArgs{userId}
+	_returns := &Z_EndUserStatusAutomationReturns{}
+	if err := g.client.Call("Plugin.EndUserStatusAutomation", _args, _returns); err != nil {
+		log.Printf("RPC call to EndUserStatusAutomation API failed: %s", err.Error())
+	}
+	return _returns.A
+}
+
+func (s *apiRPCServer) EndUserStatusAutomation(args *Z_EndUserStatusAutomationArgs, returns *Z_EndUserStatusAutomationReturns) error {
+	if hook, ok := s.impl.(interface {
+		EndUserStatusAutomation(userId string) *model.AppError
+	}); ok {
+		returns.A = hook.EndUserStatusAutomation(args.A)
+	} else {
+		return encodableError(fmt.Errorf("API EndUserStatusAutomation called but not implemented."))
+	}
+	return nil
+}
+
 type Z_UpdateUserActiveArgs struct {
 	A string
 	B bool
@@ -33,6 +33,8 @@ const (
 	UserWillLogInId         = 15
 	UserHasLoggedInId       = 16
 	UserHasBeenCreatedId    = 17
+	FileWillBeDownloadedId  = 18
+	FileWillBeEnrichedId    = 19
 	TotalHooksId            = iota
 )
 
@@ -191,4 +193,24 @@ type Hooks interface {
 	//
 	// Minimum server version: 5.2
 	FileWillBeUploaded(c *Context, info *model.FileInfo, file io.Reader, output io.Writer) (*model.FileInfo, string)
+
+	// FileWillBeDownloaded is invoked when a user downloads the original content of a file, before it is sent.
+	// Read from file to retrieve the stored content of the file.
+	//
+	// To reject the download, return a non-empty string describing why the download was rejected.
+	// To serve different content, for instance a watermarked copy of a document or image, write it to the output
+	// and return an empty string.
+	// To allow the download without modification, do not write to the output and return an empty string.
+	//
+	// Minimum server version: 5.26
+	FileWillBeDownloaded(c *Context, info *model.FileInfo, userId string, file io.Reader, output io.Writer) string
+
+	// FileWillBeEnriched is invoked by the file enrichment job once the built-in enrichers have run over a stored
+	// file, for instance to extract document authors or detected languages. Read from file to retrieve the stored
+	// content of the file.
+	//
+	// Return the entries to add to the metadata of the file, or nil to leave it unchanged.
+	//
+	// Minimum server version: 5.26
+	FileWillBeEnriched(c *Context, info *model.FileInfo, file io.Reader) map[string]string
 }
@@ -150,3 +150,17 @@ func (hooks *hooksTimerLayer) FileWillBeUploaded(c *Context, info *model.FileInf
 	hooks.recordTime(startTime, "FileWillBeUploaded", true)
 	return _returnsA, _returnsB
 }
+
+func (hooks *hooksTimerLayer) FileWillBeDownloaded(c *Context, info *model.FileInfo, userId string, file io.Reader, output io.Writer) string {
+	startTime := timePkg.Now()
+	_returnsA := hooks.hooksImpl.FileWillBeDownloaded(c, info, userId, file, output)
+	hooks.recordTime(startTime, "FileWillBeDownloaded", true)
+	return _returnsA
+}
+
+func (hooks *hooksTimerLayer) FileWillBeEnriched(c *Context, info *model.FileInfo, file io.Reader) map[string]string {
+	startTime := timePkg.Now()
+	_returnsA := hooks.hooksImpl.FileWillBeEnriched(c, info, file)
+	hooks.recordTime(startTime, "FileWillBeEnriched", true)
+	return _returnsA
+}
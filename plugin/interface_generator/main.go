@@ -576,6 +576,8 @@ func removeExcluded(info *PluginInterfaceInfo) *PluginInterfaceInfo {
 	toBeExcluded := func(item string) bool {
 		excluded := []string{
 			"FileWillBeUploaded",
+			"FileWillBeDownloaded",
+			"FileWillBeEnriched",
 			"Implemented",
 			"LoadPluginConfiguration",
 			"InstallPlugin",
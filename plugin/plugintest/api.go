@@ -483,6 +483,22 @@ func (_m *API) EnablePlugin(id string) *model.AppError {
 	return r0
 }
 
+// EndUserStatusAutomation provides a mock function with given fields: userId
+func (_m *API) EndUserStatusAutomation(userId string) *model.AppError {
+	ret := _m.Called(userId)
+
+	var r0 *model.AppError
+	if rf, ok := ret.Get(0).(func(string) *model.AppError); ok {
+		r0 = rf(userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AppError)
+		}
+	}
+
+	return r0
+}
+
 // ExecuteSlashCommand provides a mock function with given fields: commandArgs
 func (_m *API) ExecuteSlashCommand(commandArgs *model.CommandArgs) (*model.CommandResponse, error) {
 	ret := _m.Called(commandArgs)
@@ -2811,6 +2827,31 @@ func (_m *API) SetTeamIcon(teamId string, data []byte) *model.AppError {
 	return r0
 }
 
+// StartUserStatusAutomation provides a mock function with given fields: userId, source, endAt
+func (_m *API) StartUserStatusAutomation(userId string, source string, endAt int64) (*model.StatusAutomation, *model.AppError) {
+	ret := _m.Called(userId, source, endAt)
+
+	var r0 *model.StatusAutomation
+	if rf, ok := ret.Get(0).(func(string, string, int64) *model.StatusAutomation); ok {
+		r0 = rf(userId, source, endAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.StatusAutomation)
+		}
+	}
+
+	var r1 *model.AppError
+	if rf, ok := ret.Get(1).(func(string, string, int64) *model.AppError); ok {
+		r1 = rf(userId, source, endAt)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // UnregisterCommand provides a mock function with given fields: teamId, trigger
 func (_m *API) UnregisterCommand(teamId string, trigger string) error {
 	ret := _m.Called(teamId, trigger)
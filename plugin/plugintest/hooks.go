@@ -50,6 +50,36 @@ func (_m *Hooks) ExecuteCommand(c *plugin.Context, args *model.CommandArgs) (*mo
 	return r0, r1
 }
 
+// FileWillBeDownloaded provides a mock function with given fields: c, info, userId, file, output
+func (_m *Hooks) FileWillBeDownloaded(c *plugin.Context, info *model.FileInfo, userId string, file io.Reader, output io.Writer) string {
+	ret := _m.Called(c, info, userId, file, output)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*plugin.Context, *model.FileInfo, string, io.Reader, io.Writer) string); ok {
+		r0 = rf(c, info, userId, file, output)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// FileWillBeEnriched provides a mock function with given fields: c, info, file
+func (_m *Hooks) FileWillBeEnriched(c *plugin.Context, info *model.FileInfo, file io.Reader) map[string]string {
+	ret := _m.Called(c, info, file)
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func(*plugin.Context, *model.FileInfo, io.Reader) map[string]string); ok {
+		r0 = rf(c, info, file)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	return r0
+}
+
 // FileWillBeUploaded provides a mock function with given fields: c, info, file, output
 func (_m *Hooks) FileWillBeUploaded(c *plugin.Context, info *model.FileInfo, file io.Reader, output io.Writer) (*model.FileInfo, string) {
 	ret := _m.Called(c, info, file, output)
@@ -0,0 +1,355 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Package backup implements the archive format of Mattermost backups: a zip file holding a bulk
+// export of the database, optionally the files it references, and a manifest with the checksum of
+// every entry, optionally encrypted with a passphrase.
+package backup
+
+import (
+	"archive/zip"
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	MANIFEST_VERSION = 1
+
+	MANIFEST_ENTRY = "manifest.json"
+	DATA_ENTRY     = "data.jsonl"
+	FILES_PREFIX   = "files/"
+)
+
+// Entry describes one file of the archive.
+type Entry struct {
+	Name   string `json:"name"`
+	Size   int64  `json:"size"`
+	Sha256 string `json:"sha256"`
+}
+
+// File describes a file of the file store referenced by the backup. Its contents are only part of
+// the archive when the manifest's IncludesFiles is set.
+type File struct {
+	Path string `json:"path"`
+	Size int64  `json:"size"`
+}
+
+// Manifest describes a backup. Since and Until are the UpdateAt watermarks it covers: a full backup
+// has a zero Since, and an incremental backup starts where a previous backup's Until stopped. A
+// snapshot of a single channel has its ChannelId set instead, and its data isn't a bulk export.
+type Manifest struct {
+	Version       int     `json:"version"`
+	ServerVersion string  `json:"server_version"`
+	SchemaVersion string  `json:"schema_version"`
+	CreateAt      int64   `json:"create_at"`
+	Since         int64   `json:"since"`
+	Until         int64   `json:"until"`
+	IncludesFiles bool    `json:"includes_files"`
+	ChannelId     string  `json:"channel_id,omitempty"`
+	Entries       []Entry `json:"entries"`
+	Files         []File  `json:"files"`
+}
+
+func (m *Manifest) Incremental() bool {
+	return m.Since > 0
+}
+
+// Writer writes a backup archive.
+type Writer struct {
+	zw        *zip.Writer
+	encrypter io.WriteCloser
+	entries   []Entry
+	current   *entryWriter
+}
+
+// NewWriter starts a backup archive in w, encrypted when passphrase isn't empty.
+func NewWriter(w io.Writer, passphrase string) (*Writer, error) {
+	bw := &Writer{}
+
+	if passphrase != "" {
+		encrypter, err := NewEncryptWriter(w, passphrase)
+		if err != nil {
+			return nil, err
+		}
+		bw.encrypter = encrypter
+		w = encrypter
+	}
+
+	bw.zw = zip.NewWriter(w)
+	return bw, nil
+}
+
+type entryWriter struct {
+	name string
+	w    io.Writer
+	hash hash.Hash
+	size int64
+}
+
+func (ew *entryWriter) Write(p []byte) (int, error) {
+	n, err := ew.w.Write(p)
+	ew.hash.Write(p[:n])
+	ew.size += int64(n)
+	return n, err
+}
+
+// Create adds an entry to the archive. The returned writer is valid until the next call to Create
+// or Close.
+func (bw *Writer) Create(name string) (io.Writer, error) {
+	bw.finishEntry()
+
+	w, err := bw.zw.Create(name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create %s", name)
+	}
+
+	bw.current = &entryWriter{name: name, w: w, hash: sha256.New()}
+	return bw.current, nil
+}
+
+func (bw *Writer) finishEntry() {
+	if bw.current == nil {
+		return
+	}
+
+	bw.entries = append(bw.entries, Entry{
+		Name:   bw.current.name,
+		Size:   bw.current.size,
+		Sha256: hex.EncodeToString(bw.current.hash.Sum(nil)),
+	})
+	bw.current = nil
+}
+
+// Close writes the manifest, completed with the entries written so far, and finishes the archive.
+func (bw *Writer) Close(manifest *Manifest) error {
+	bw.finishEntry()
+
+	manifest.Version = MANIFEST_VERSION
+	manifest.Entries = bw.entries
+
+	w, err := bw.zw.Create(MANIFEST_ENTRY)
+	if err != nil {
+		return errors.Wrap(err, "failed to create the manifest")
+	}
+
+	if err := json.NewEncoder(w).Encode(manifest); err != nil {
+		return errors.Wrap(err, "failed to write the manifest")
+	}
+
+	if err := bw.zw.Close(); err != nil {
+		return errors.Wrap(err, "failed to finish the archive")
+	}
+
+	if bw.encrypter != nil {
+		return bw.encrypter.Close()
+	}
+
+	return nil
+}
+
+// Archive is a backup opened for verification and restore.
+type Archive struct {
+	Manifest *Manifest
+
+	zr      *zip.ReadCloser
+	tmpFile string
+}
+
+// Open opens the backup at path, decrypting it with passphrase into a temporary file first if it
+// is encrypted.
+func Open(path string, passphrase string) (*Archive, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	archive := &Archive{}
+	zipPath := path
+
+	br := bufio.NewReader(f)
+	if IsEncrypted(br) {
+		if passphrase == "" {
+			return nil, errors.New("the backup is encrypted, a passphrase is required")
+		}
+
+		tmp, err := ioutil.TempFile("", "mattermost-backup")
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create a temporary file")
+		}
+		archive.tmpFile = tmp.Name()
+
+		decrypter, err := NewDecryptReader(br, passphrase)
+		if err == nil {
+			_, err = io.Copy(tmp, decrypter)
+		}
+		tmp.Close()
+		if err != nil {
+			archive.Close()
+			return nil, err
+		}
+
+		zipPath = archive.tmpFile
+	}
+
+	archive.zr, err = zip.OpenReader(zipPath)
+	if err != nil {
+		archive.Close()
+		return nil, errors.Wrap(err, "the backup is not a valid archive")
+	}
+
+	archive.Manifest, err = archive.readManifest()
+	if err != nil {
+		archive.Close()
+		return nil, err
+	}
+
+	return archive, nil
+}
+
+func (a *Archive) find(name string) *zip.File {
+	for _, f := range a.zr.File {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+func (a *Archive) readManifest() (*Manifest, error) {
+	f := a.find(MANIFEST_ENTRY)
+	if f == nil {
+		return nil, errors.New("the backup has no manifest")
+	}
+
+	r, err := f.Open()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open the manifest")
+	}
+	defer r.Close()
+
+	var manifest Manifest
+	if err := json.NewDecoder(r).Decode(&manifest); err != nil {
+		return nil, errors.Wrap(err, "failed to read the manifest")
+	}
+
+	if manifest.Version != MANIFEST_VERSION {
+		return nil, errors.Errorf("unsupported backup version %d", manifest.Version)
+	}
+
+	return &manifest, nil
+}
+
+// OpenEntry opens the entry of the archive with the given name.
+func (a *Archive) OpenEntry(name string) (io.ReadCloser, error) {
+	f := a.find(name)
+	if f == nil {
+		return nil, errors.Errorf("%s is missing from the backup", name)
+	}
+
+	return f.Open()
+}
+
+// Verify checks that every entry listed in the manifest is present with the recorded size and
+// checksum, and that the archive holds nothing else.
+func (a *Archive) Verify() error {
+	listed := map[string]bool{MANIFEST_ENTRY: true}
+
+	for _, entry := range a.Manifest.Entries {
+		listed[entry.Name] = true
+
+		f := a.find(entry.Name)
+		if f == nil {
+			return errors.Errorf("%s is missing from the backup", entry.Name)
+		}
+
+		r, err := f.Open()
+		if err != nil {
+			return errors.Wrapf(err, "failed to open %s", entry.Name)
+		}
+
+		h := sha256.New()
+		size, err := io.Copy(h, r)
+		r.Close()
+		if err != nil {
+			return errors.Wrapf(err, "failed to read %s", entry.Name)
+		}
+
+		if size != entry.Size || hex.EncodeToString(h.Sum(nil)) != entry.Sha256 {
+			return errors.Errorf("%s doesn't match the checksum recorded in the manifest", entry.Name)
+		}
+	}
+
+	for _, f := range a.zr.File {
+		if !listed[f.Name] {
+			return errors.Errorf("%s is not listed in the manifest", f.Name)
+		}
+	}
+
+	return nil
+}
+
+// Extract writes every entry of the archive below dir.
+func (a *Archive) Extract(dir string) error {
+	for _, f := range a.zr.File {
+		target := filepath.Join(dir, filepath.FromSlash(path.Clean("/"+f.Name)))
+		if !strings.HasPrefix(target, filepath.Clean(dir)+string(os.PathSeparator)) {
+			return errors.Errorf("%s points outside of the backup", f.Name)
+		}
+
+		if err := os.MkdirAll(filepath.Dir(target), 0700); err != nil {
+			return err
+		}
+
+		if err := extractFile(f, target); err != nil {
+			return errors.Wrapf(err, "failed to extract %s", f.Name)
+		}
+	}
+
+	return nil
+}
+
+func extractFile(f *zip.File, target string) error {
+	r, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	w, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(w, r); err != nil {
+		w.Close()
+		return err
+	}
+
+	return w.Close()
+}
+
+// Close releases the archive and removes its decrypted copy, if any.
+func (a *Archive) Close() error {
+	var err error
+	if a.zr != nil {
+		err = a.zr.Close()
+	}
+
+	if a.tmpFile != "" {
+		os.Remove(a.tmpFile)
+	}
+
+	return err
+}
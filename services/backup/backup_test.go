@@ -0,0 +1,158 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryption(t *testing.T) {
+	for name, size := range map[string]int{
+		"empty":          0,
+		"small":          100,
+		"exact chunk":    encryptionChunkSize,
+		"several chunks": 3*encryptionChunkSize + 17,
+	} {
+		t.Run(name, func(t *testing.T) {
+			plain := bytes.Repeat([]byte("a"), size)
+
+			var buf bytes.Buffer
+			w, err := NewEncryptWriter(&buf, "secret")
+			require.NoError(t, err)
+			_, err = w.Write(plain)
+			require.NoError(t, err)
+			require.NoError(t, w.Close())
+
+			assert.True(t, IsEncrypted(bufio.NewReader(bytes.NewReader(buf.Bytes()))))
+			assert.False(t, bytes.Contains(buf.Bytes(), []byte("aaaa")))
+
+			r, err := NewDecryptReader(bytes.NewReader(buf.Bytes()), "secret")
+			require.NoError(t, err)
+			decrypted, err := ioutil.ReadAll(r)
+			require.NoError(t, err)
+			assert.Equal(t, plain, decrypted)
+
+			r, err = NewDecryptReader(bytes.NewReader(buf.Bytes()), "wrong")
+			require.NoError(t, err)
+			_, err = ioutil.ReadAll(r)
+			assert.Equal(t, ErrWrongPassphrase, err)
+		})
+	}
+
+	t.Run("truncated", func(t *testing.T) {
+		var buf bytes.Buffer
+		w, err := NewEncryptWriter(&buf, "secret")
+		require.NoError(t, err)
+		_, err = w.Write(bytes.Repeat([]byte("a"), 2*encryptionChunkSize+1))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		// Drop the final chunk: what remains decrypts, but must not pass as a whole backup.
+		finalChunk := 4 + 1 + 16
+		r, err := NewDecryptReader(bytes.NewReader(buf.Bytes()[:buf.Len()-finalChunk]), "secret")
+		require.NoError(t, err)
+		_, err = ioutil.ReadAll(r)
+		assert.Equal(t, ErrTruncated, err)
+	})
+}
+
+func writeArchive(t *testing.T, path, passphrase string) {
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	w, err := NewWriter(f, passphrase)
+	require.NoError(t, err)
+
+	data, err := w.Create(DATA_ENTRY)
+	require.NoError(t, err)
+	data.Write([]byte(`{"type":"version","version":1}` + "\n"))
+
+	file, err := w.Create(FILES_PREFIX + "users/abc/profile.png")
+	require.NoError(t, err)
+	file.Write([]byte("png"))
+
+	require.NoError(t, w.Close(&Manifest{
+		Since:         10,
+		Until:         20,
+		IncludesFiles: true,
+		Files:         []File{{Path: "users/abc/profile.png", Size: 3}},
+	}))
+}
+
+func TestArchive(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	for _, passphrase := range []string{"", "secret"} {
+		path := filepath.Join(dir, "backup"+passphrase+".zip")
+		writeArchive(t, path, passphrase)
+
+		archive, err := Open(path, passphrase)
+		require.NoError(t, err)
+
+		assert.True(t, archive.Manifest.Incremental())
+		assert.Equal(t, int64(20), archive.Manifest.Until)
+		require.Len(t, archive.Manifest.Entries, 2)
+		assert.Equal(t, DATA_ENTRY, archive.Manifest.Entries[0].Name)
+		assert.Equal(t, int64(3), archive.Manifest.Entries[1].Size)
+		require.NoError(t, archive.Verify())
+
+		r, err := archive.OpenEntry(FILES_PREFIX + "users/abc/profile.png")
+		require.NoError(t, err)
+		contents, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		r.Close()
+		assert.Equal(t, "png", string(contents))
+
+		_, err = archive.OpenEntry("missing")
+		assert.Error(t, err)
+
+		extracted := filepath.Join(dir, "extracted"+passphrase)
+		require.NoError(t, archive.Extract(extracted))
+		contents, err = ioutil.ReadFile(filepath.Join(extracted, "files", "users", "abc", "profile.png"))
+		require.NoError(t, err)
+		assert.Equal(t, "png", string(contents))
+
+		require.NoError(t, archive.Close())
+	}
+
+	t.Run("encrypted without a passphrase", func(t *testing.T) {
+		_, err := Open(filepath.Join(dir, "backupsecret.zip"), "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "passphrase")
+	})
+
+	t.Run("tampered entry", func(t *testing.T) {
+		path := filepath.Join(dir, "tampered.zip")
+		writeArchive(t, path, "")
+
+		archive, err := Open(path, "")
+		require.NoError(t, err)
+		defer archive.Close()
+
+		archive.Manifest.Entries[1].Sha256 = strings.Repeat("0", 64)
+		err = archive.Verify()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "checksum")
+	})
+
+	t.Run("not a backup", func(t *testing.T) {
+		path := filepath.Join(dir, "garbage.zip")
+		require.NoError(t, ioutil.WriteFile(path, []byte("garbage"), 0600))
+
+		_, err := Open(path, "")
+		assert.Error(t, err)
+	})
+}
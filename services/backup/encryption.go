@@ -0,0 +1,218 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/argon2"
+)
+
+// Encrypted backups start with a header made of encryptionMagic and a random salt, followed by
+// chunks of at most encryptionChunkSize bytes sealed with AES-256-GCM. Each chunk is prefixed with
+// its sealed length and authenticates whether it is the last one, so a truncated backup is detected.
+const (
+	encryptionMagic     = "MMBACKUP1"
+	encryptionSaltSize  = 16
+	encryptionChunkSize = 64 * 1024
+)
+
+var (
+	ErrWrongPassphrase = errors.New("the backup can't be decrypted with this passphrase or is corrupted")
+	ErrTruncated       = errors.New("the encrypted backup is truncated")
+)
+
+func newGCM(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key := argon2.IDKey([]byte(passphrase), salt, 1, 64*1024, 4, 32)
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// chunkNonce derives a unique nonce for every chunk of a backup from its position.
+func chunkNonce(size int, counter uint64) []byte {
+	nonce := make([]byte, size)
+	binary.BigEndian.PutUint64(nonce[size-8:], counter)
+	return nonce
+}
+
+func chunkAdditionalData(final bool) []byte {
+	if final {
+		return []byte{1}
+	}
+	return []byte{0}
+}
+
+type encryptWriter struct {
+	w       io.Writer
+	gcm     cipher.AEAD
+	buf     []byte
+	counter uint64
+	closed  bool
+}
+
+// NewEncryptWriter returns a writer that encrypts everything written to it into w with a key
+// derived from passphrase. The caller must call Close to flush the final chunk.
+func NewEncryptWriter(w io.Writer, passphrase string) (io.WriteCloser, error) {
+	salt := make([]byte, encryptionSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, errors.Wrap(err, "failed to generate a salt")
+	}
+
+	gcm, err := newGCM(passphrase, salt)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create the cipher")
+	}
+
+	if _, err := w.Write(append([]byte(encryptionMagic), salt...)); err != nil {
+		return nil, err
+	}
+
+	return &encryptWriter{w: w, gcm: gcm, buf: make([]byte, 0, encryptionChunkSize)}, nil
+}
+
+func (ew *encryptWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		// Only seal a full buffer once more data arrives, so that Close always has a final chunk.
+		if len(ew.buf) == encryptionChunkSize {
+			if err := ew.seal(false); err != nil {
+				return written, err
+			}
+		}
+
+		n := copy(ew.buf[len(ew.buf):encryptionChunkSize], p)
+		ew.buf = ew.buf[:len(ew.buf)+n]
+		p = p[n:]
+		written += n
+	}
+
+	return written, nil
+}
+
+func (ew *encryptWriter) seal(final bool) error {
+	sealed := ew.gcm.Seal(nil, chunkNonce(ew.gcm.NonceSize(), ew.counter), ew.buf, chunkAdditionalData(final))
+	ew.counter++
+	ew.buf = ew.buf[:0]
+
+	var length [4]byte
+	binary.BigEndian.PutUint32(length[:], uint32(len(sealed)))
+	if _, err := ew.w.Write(length[:]); err != nil {
+		return err
+	}
+	_, err := ew.w.Write(sealed)
+	return err
+}
+
+func (ew *encryptWriter) Close() error {
+	if ew.closed {
+		return nil
+	}
+	ew.closed = true
+
+	return ew.seal(true)
+}
+
+type decryptReader struct {
+	r       *bufio.Reader
+	gcm     cipher.AEAD
+	buf     []byte
+	counter uint64
+	done    bool
+}
+
+// IsEncrypted reports whether the backup read by r starts with the encryption header. It consumes
+// nothing from r.
+func IsEncrypted(r *bufio.Reader) bool {
+	header, err := r.Peek(len(encryptionMagic))
+	return err == nil && bytes.Equal(header, []byte(encryptionMagic))
+}
+
+// NewDecryptReader returns a reader of the plaintext of an encrypted backup.
+func NewDecryptReader(r io.Reader, passphrase string) (io.Reader, error) {
+	header := make([]byte, len(encryptionMagic)+encryptionSaltSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, errors.Wrap(err, "failed to read the encryption header")
+	}
+
+	if !bytes.Equal(header[:len(encryptionMagic)], []byte(encryptionMagic)) {
+		return nil, errors.New("the backup is not encrypted")
+	}
+
+	gcm, err := newGCM(passphrase, header[len(encryptionMagic):])
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create the cipher")
+	}
+
+	return &decryptReader{r: bufio.NewReader(r), gcm: gcm}, nil
+}
+
+func (dr *decryptReader) Read(p []byte) (int, error) {
+	for len(dr.buf) == 0 {
+		if dr.done {
+			return 0, io.EOF
+		}
+
+		if err := dr.open(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, dr.buf)
+	dr.buf = dr.buf[n:]
+	return n, nil
+}
+
+func (dr *decryptReader) open() error {
+	var length [4]byte
+	if _, err := io.ReadFull(dr.r, length[:]); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return ErrTruncated
+		}
+		return err
+	}
+
+	size := binary.BigEndian.Uint32(length[:])
+	if size > encryptionChunkSize+uint32(dr.gcm.Overhead()) {
+		return ErrWrongPassphrase
+	}
+
+	sealed := make([]byte, size)
+	if _, err := io.ReadFull(dr.r, sealed); err != nil {
+		return ErrTruncated
+	}
+
+	nonce := chunkNonce(dr.gcm.NonceSize(), dr.counter)
+	dr.counter++
+
+	// A chunk is final if it authenticates as such; otherwise it must be followed by another one.
+	plain, err := dr.gcm.Open(nil, nonce, sealed, chunkAdditionalData(true))
+	if err == nil {
+		if _, peekErr := dr.r.Peek(1); peekErr != io.EOF {
+			return errors.New("the encrypted backup has data after its final chunk")
+		}
+		dr.buf = plain
+		dr.done = true
+		return nil
+	}
+
+	plain, err = dr.gcm.Open(nil, nonce, sealed, chunkAdditionalData(false))
+	if err != nil {
+		return ErrWrongPassphrase
+	}
+
+	dr.buf = plain
+	return nil
+}
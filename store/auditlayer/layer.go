@@ -0,0 +1,47 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package auditlayer
+
+import (
+	"github.com/mattermost/mattermost-server/v5/audit"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+// Auditor makes the audit records of the store and logs them through the audit subsystem.
+type Auditor interface {
+	MakeAuditRecord(event string, initialStatus string) *audit.Record
+	LogStoreAuditRec(rec *audit.Record, err error)
+}
+
+// AuditStore writes an audit record for each of the destructive operations of the stores, such as
+// the mass removal of team members, so that they can be traced after the fact. The store doesn't
+// know the session it runs for, so the records identify the server; the API handlers record the
+// user behind the request.
+type AuditStore struct {
+	store.Store
+	auditor Auditor
+	team    *AuditTeamStore
+}
+
+func NewAuditLayer(baseStore store.Store, auditor Auditor) *AuditStore {
+	auditStore := &AuditStore{
+		Store:   baseStore,
+		auditor: auditor,
+	}
+	auditStore.team = &AuditTeamStore{TeamStore: baseStore.Team(), rootStore: auditStore}
+
+	return auditStore
+}
+
+func (s *AuditStore) Team() store.TeamStore {
+	return s.team
+}
+
+// logRecord logs the record of the operation, failed if err isn't nil.
+func (s *AuditStore) logRecord(rec *audit.Record, err error) {
+	if err == nil {
+		rec.Success()
+	}
+	s.auditor.LogStoreAuditRec(rec, err)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package auditlayer
+
+import (
+	"context"
+
+	"github.com/mattermost/mattermost-server/v5/audit"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+type AuditTeamStore struct {
+	store.TeamStore
+	rootStore *AuditStore
+}
+
+// memberCount returns the number of members of the team about to be removed, or -1 if it can't be
+// counted, the operation being recorded anyway.
+func (s AuditTeamStore) memberCount(ctx context.Context, teamId string) int64 {
+	count, err := s.TeamStore.GetTotalMemberCount(ctx, teamId, nil)
+	if err != nil {
+		return -1
+	}
+	return count
+}
+
+func (s AuditTeamStore) RemoveMembers(ctx context.Context, teamId string, userIds []string) *model.AppError {
+	rec := s.rootStore.auditor.MakeAuditRecord("teamStoreRemoveMembers", audit.Fail)
+	rec.AddMeta("team_id", teamId)
+	rec.AddMeta("user_ids", userIds)
+	rec.AddMeta("count", len(userIds))
+
+	err := s.TeamStore.RemoveMembers(ctx, teamId, userIds)
+	s.rootStore.logRecord(rec, appErrorOrNil(err))
+	return err
+}
+
+func (s AuditTeamStore) RemoveAllMembersByTeam(ctx context.Context, teamId string) *model.AppError {
+	rec := s.rootStore.auditor.MakeAuditRecord("teamStoreRemoveAllMembersByTeam", audit.Fail)
+	rec.AddMeta("team_id", teamId)
+	rec.AddMeta("count", s.memberCount(ctx, teamId))
+
+	err := s.TeamStore.RemoveAllMembersByTeam(ctx, teamId)
+	s.rootStore.logRecord(rec, appErrorOrNil(err))
+	return err
+}
+
+func (s AuditTeamStore) PermanentDelete(ctx context.Context, teamId string) *model.AppError {
+	rec := s.rootStore.auditor.MakeAuditRecord("teamStorePermanentDelete", audit.Fail)
+	rec.AddMeta("team_id", teamId)
+	if team, err := s.TeamStore.Get(ctx, teamId); err == nil {
+		rec.AddMeta("team_name", team.Name)
+	}
+	rec.AddMeta("member_count", s.memberCount(ctx, teamId))
+
+	err := s.TeamStore.PermanentDelete(ctx, teamId)
+	s.rootStore.logRecord(rec, appErrorOrNil(err))
+	return err
+}
+
+func (s AuditTeamStore) ResetAllTeamSchemes(ctx context.Context) *model.AppError {
+	rec := s.rootStore.auditor.MakeAuditRecord("teamStoreResetAllTeamSchemes", audit.Fail)
+	if count, err := s.TeamStore.AnalyticsTeamCount(ctx, true); err == nil {
+		rec.AddMeta("team_count", count)
+	}
+
+	err := s.TeamStore.ResetAllTeamSchemes(ctx)
+	s.rootStore.logRecord(rec, appErrorOrNil(err))
+	return err
+}
+
+// appErrorOrNil avoids passing a nil *model.AppError as a non-nil error.
+func appErrorOrNil(err *model.AppError) error {
+	if err == nil {
+		return nil
+	}
+	return err
+}
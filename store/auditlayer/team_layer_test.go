@@ -0,0 +1,117 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package auditlayer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/audit"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store/storetest/mocks"
+)
+
+type testAuditor struct {
+	records []*audit.Record
+}
+
+func (a *testAuditor) MakeAuditRecord(event string, initialStatus string) *audit.Record {
+	return &audit.Record{Event: event, Status: initialStatus}
+}
+
+func (a *testAuditor) LogStoreAuditRec(rec *audit.Record, err error) {
+	if err != nil {
+		rec.Fail()
+	}
+	a.records = append(a.records, rec)
+}
+
+func getMockStore() (*mocks.Store, *mocks.TeamStore) {
+	mockTeamStore := mocks.TeamStore{}
+	mockStore := mocks.Store{}
+	mockStore.On("Team").Return(&mockTeamStore)
+	return &mockStore, &mockTeamStore
+}
+
+func TestAuditTeamStore(t *testing.T) {
+	teamId := model.NewId()
+
+	t.Run("RemoveMembers", func(t *testing.T) {
+		mockStore, mockTeamStore := getMockStore()
+		userIds := []string{model.NewId(), model.NewId()}
+		mockTeamStore.On("RemoveMembers", mock.Anything, teamId, userIds).Return(nil)
+
+		auditor := &testAuditor{}
+		err := NewAuditLayer(mockStore, auditor).Team().RemoveMembers(context.Background(), teamId, userIds)
+		require.Nil(t, err)
+
+		require.Len(t, auditor.records, 1)
+		rec := auditor.records[0]
+		assert.Equal(t, "teamStoreRemoveMembers", rec.Event)
+		assert.Equal(t, audit.Success, rec.Status)
+		assert.Equal(t, teamId, rec.Meta["team_id"])
+		assert.Equal(t, 2, rec.Meta["count"])
+	})
+
+	t.Run("RemoveAllMembersByTeam", func(t *testing.T) {
+		mockStore, mockTeamStore := getMockStore()
+		mockTeamStore.On("GetTotalMemberCount", mock.Anything, teamId, (*model.ViewUsersRestrictions)(nil)).Return(int64(42), nil)
+		mockTeamStore.On("RemoveAllMembersByTeam", mock.Anything, teamId).Return(nil)
+
+		auditor := &testAuditor{}
+		err := NewAuditLayer(mockStore, auditor).Team().RemoveAllMembersByTeam(context.Background(), teamId)
+		require.Nil(t, err)
+
+		require.Len(t, auditor.records, 1)
+		assert.Equal(t, "teamStoreRemoveAllMembersByTeam", auditor.records[0].Event)
+		assert.Equal(t, int64(42), auditor.records[0].Meta["count"])
+	})
+
+	t.Run("PermanentDelete failure", func(t *testing.T) {
+		mockStore, mockTeamStore := getMockStore()
+		mockTeamStore.On("Get", mock.Anything, teamId).Return(&model.Team{Id: teamId, Name: "team-name"}, nil)
+		mockTeamStore.On("GetTotalMemberCount", mock.Anything, teamId, (*model.ViewUsersRestrictions)(nil)).Return(int64(3), nil)
+		mockTeamStore.On("PermanentDelete", mock.Anything, teamId).Return(model.NewAppError("PermanentDelete", "id", nil, "", http.StatusInternalServerError))
+
+		auditor := &testAuditor{}
+		err := NewAuditLayer(mockStore, auditor).Team().PermanentDelete(context.Background(), teamId)
+		require.NotNil(t, err)
+
+		require.Len(t, auditor.records, 1)
+		rec := auditor.records[0]
+		assert.Equal(t, "teamStorePermanentDelete", rec.Event)
+		assert.Equal(t, audit.Fail, rec.Status)
+		assert.Equal(t, "team-name", rec.Meta["team_name"])
+		assert.Equal(t, int64(3), rec.Meta["member_count"])
+	})
+
+	t.Run("ResetAllTeamSchemes", func(t *testing.T) {
+		mockStore, mockTeamStore := getMockStore()
+		mockTeamStore.On("AnalyticsTeamCount", mock.Anything, true).Return(int64(5), nil)
+		mockTeamStore.On("ResetAllTeamSchemes", mock.Anything).Return(nil)
+
+		auditor := &testAuditor{}
+		err := NewAuditLayer(mockStore, auditor).Team().ResetAllTeamSchemes(context.Background())
+		require.Nil(t, err)
+
+		require.Len(t, auditor.records, 1)
+		assert.Equal(t, "teamStoreResetAllTeamSchemes", auditor.records[0].Event)
+		assert.Equal(t, int64(5), auditor.records[0].Meta["team_count"])
+	})
+
+	t.Run("other operations aren't recorded", func(t *testing.T) {
+		mockStore, mockTeamStore := getMockStore()
+		mockTeamStore.On("Get", mock.Anything, teamId).Return(&model.Team{Id: teamId}, nil)
+
+		auditor := &testAuditor{}
+		_, err := NewAuditLayer(mockStore, auditor).Team().Get(context.Background(), teamId)
+		require.NoError(t, err)
+		assert.Empty(t, auditor.records)
+	})
+}
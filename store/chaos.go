@@ -0,0 +1,116 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package store
+
+import (
+	"errors"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrFaultInjected is the error returned by the calls the ChaosLayer makes fail.
+var ErrFaultInjected = errors.New("fault injected by the store chaos layer")
+
+// ChaosSettings are the faults the ChaosLayer injects in the calls to the store.
+type ChaosSettings struct {
+	Enable bool
+	// Latency is added to every call.
+	Latency time.Duration
+	// ErrorPercent is the percentage of the calls returning ErrFaultInjected instead of reaching
+	// the store, for the methods returning an error.
+	ErrorPercent int
+	// ReplicaLag delays the reads of a store issued less than ReplicaLag after a write to it, as if
+	// they waited for a lagging read replica to catch up.
+	ReplicaLag time.Duration
+	// Methods restricts the faults to whole stores ("Post") or single methods ("Post.Get"). Every
+	// method is affected when empty.
+	Methods []string
+}
+
+var chaosReadMethodPrefixes = []string{"Get", "Search", "Count", "Analytics", "Autocomplete", "Is", "Has", "Exists"}
+
+func isChaosReadMethod(method string) bool {
+	for _, prefix := range chaosReadMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+type chaosFaults struct {
+	settings func() ChaosSettings
+
+	mutex      sync.Mutex
+	rand       *rand.Rand
+	lastWrites map[string]time.Time
+}
+
+func newChaosFaults(settings func() ChaosSettings) *chaosFaults {
+	return &chaosFaults{
+		settings:   settings,
+		rand:       rand.New(rand.NewSource(time.Now().UnixNano())),
+		lastWrites: make(map[string]time.Time),
+	}
+}
+
+func (c *chaosFaults) affects(settings ChaosSettings, subStore, method string) bool {
+	if !settings.Enable {
+		return false
+	}
+	if len(settings.Methods) == 0 {
+		return true
+	}
+	for _, name := range settings.Methods {
+		if name == subStore || name == subStore+"."+method {
+			return true
+		}
+	}
+	return false
+}
+
+// delay sleeps for the latency and the replica lag the call is subject to.
+func (c *chaosFaults) delay(subStore, method string) {
+	settings := c.settings()
+	if !c.affects(settings, subStore, method) {
+		return
+	}
+
+	wait := settings.Latency
+	if settings.ReplicaLag > 0 {
+		c.mutex.Lock()
+		now := time.Now()
+		if !isChaosReadMethod(method) {
+			c.lastWrites[subStore] = now
+		} else if lastWrite, ok := c.lastWrites[subStore]; ok && now.Sub(lastWrite) < settings.ReplicaLag {
+			wait += settings.ReplicaLag - now.Sub(lastWrite)
+		}
+		c.mutex.Unlock()
+	}
+
+	if wait > 0 {
+		time.Sleep(wait)
+	}
+}
+
+// inject delays the call, then returns ErrFaultInjected if the call is to fail.
+func (c *chaosFaults) inject(subStore, method string) error {
+	c.delay(subStore, method)
+
+	settings := c.settings()
+	if !c.affects(settings, subStore, method) || settings.ErrorPercent <= 0 {
+		return nil
+	}
+
+	c.mutex.Lock()
+	fail := c.rand.Intn(100) < settings.ErrorPercent
+	c.mutex.Unlock()
+
+	if fail {
+		return ErrFaultInjected
+	}
+	return nil
+}
@@ -0,0 +1,7212 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Code generated by "make store-layers"
+// DO NOT EDIT
+
+package store
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+type ChaosLayer struct {
+	Store
+	faults                    *chaosFaults
+	AuditStore                AuditStore
+	BotStore                  BotStore
+	ChannelStore              ChannelStore
+	ChannelBookmarkStore      ChannelBookmarkStore
+	ChannelChangeStore        ChannelChangeStore
+	ChannelGuestLinkStore     ChannelGuestLinkStore
+	ChannelIntegrationStore   ChannelIntegrationStore
+	ChannelMemberHistoryStore ChannelMemberHistoryStore
+	ClusterDiscoveryStore     ClusterDiscoveryStore
+	CommandStore              CommandStore
+	CommandWebhookStore       CommandWebhookStore
+	ComplianceStore           ComplianceStore
+	EmailVerificationStore    EmailVerificationStore
+	EmojiStore                EmojiStore
+	EventStreamStore          EventStreamStore
+	FileInfoStore             FileInfoStore
+	GroupStore                GroupStore
+	IntegrationUsageStore     IntegrationUsageStore
+	JobStore                  JobStore
+	LicenseStore              LicenseStore
+	LinkMetadataStore         LinkMetadataStore
+	LoginHistoryStore         LoginHistoryStore
+	OAuthStore                OAuthStore
+	OutboxStore               OutboxStore
+	PendingPinStore           PendingPinStore
+	PluginStore               PluginStore
+	PostStore                 PostStore
+	PostAcknowledgementStore  PostAcknowledgementStore
+	PostArchiveStore          PostArchiveStore
+	PostIntegrityStore        PostIntegrityStore
+	PostsPartitionStore       PostsPartitionStore
+	PreferenceStore           PreferenceStore
+	PresenceWebhookStore      PresenceWebhookStore
+	ReactionStore             ReactionStore
+	RetentionPolicyStore      RetentionPolicyStore
+	RoleStore                 RoleStore
+	SchemeStore               SchemeStore
+	SessionStore              SessionStore
+	SlugHistoryStore          SlugHistoryStore
+	StatusStore               StatusStore
+	StatusAutomationStore     StatusAutomationStore
+	SystemStore               SystemStore
+	TeamStore                 TeamStore
+	TeamInviteLinkStore       TeamInviteLinkStore
+	TeamMemberHistoryStore    TeamMemberHistoryStore
+	TermsOfServiceStore       TermsOfServiceStore
+	TermsOfServicePolicyStore TermsOfServicePolicyStore
+	TokenStore                TokenStore
+	UserStore                 UserStore
+	UserAccessTokenStore      UserAccessTokenStore
+	UserPropertyStore         UserPropertyStore
+	UserRelationshipStore     UserRelationshipStore
+	UserTermsOfServiceStore   UserTermsOfServiceStore
+	WebhookStore              WebhookStore
+}
+
+func (s *ChaosLayer) Audit() AuditStore {
+	return s.AuditStore
+}
+
+func (s *ChaosLayer) Bot() BotStore {
+	return s.BotStore
+}
+
+func (s *ChaosLayer) Channel() ChannelStore {
+	return s.ChannelStore
+}
+
+func (s *ChaosLayer) ChannelBookmark() ChannelBookmarkStore {
+	return s.ChannelBookmarkStore
+}
+
+func (s *ChaosLayer) ChannelChange() ChannelChangeStore {
+	return s.ChannelChangeStore
+}
+
+func (s *ChaosLayer) ChannelGuestLink() ChannelGuestLinkStore {
+	return s.ChannelGuestLinkStore
+}
+
+func (s *ChaosLayer) ChannelIntegration() ChannelIntegrationStore {
+	return s.ChannelIntegrationStore
+}
+
+func (s *ChaosLayer) ChannelMemberHistory() ChannelMemberHistoryStore {
+	return s.ChannelMemberHistoryStore
+}
+
+func (s *ChaosLayer) ClusterDiscovery() ClusterDiscoveryStore {
+	return s.ClusterDiscoveryStore
+}
+
+func (s *ChaosLayer) Command() CommandStore {
+	return s.CommandStore
+}
+
+func (s *ChaosLayer) CommandWebhook() CommandWebhookStore {
+	return s.CommandWebhookStore
+}
+
+func (s *ChaosLayer) Compliance() ComplianceStore {
+	return s.ComplianceStore
+}
+
+func (s *ChaosLayer) EmailVerification() EmailVerificationStore {
+	return s.EmailVerificationStore
+}
+
+func (s *ChaosLayer) Emoji() EmojiStore {
+	return s.EmojiStore
+}
+
+func (s *ChaosLayer) EventStream() EventStreamStore {
+	return s.EventStreamStore
+}
+
+func (s *ChaosLayer) FileInfo() FileInfoStore {
+	return s.FileInfoStore
+}
+
+func (s *ChaosLayer) Group() GroupStore {
+	return s.GroupStore
+}
+
+func (s *ChaosLayer) IntegrationUsage() IntegrationUsageStore {
+	return s.IntegrationUsageStore
+}
+
+func (s *ChaosLayer) Job() JobStore {
+	return s.JobStore
+}
+
+func (s *ChaosLayer) License() LicenseStore {
+	return s.LicenseStore
+}
+
+func (s *ChaosLayer) LinkMetadata() LinkMetadataStore {
+	return s.LinkMetadataStore
+}
+
+func (s *ChaosLayer) LoginHistory() LoginHistoryStore {
+	return s.LoginHistoryStore
+}
+
+func (s *ChaosLayer) OAuth() OAuthStore {
+	return s.OAuthStore
+}
+
+func (s *ChaosLayer) Outbox() OutboxStore {
+	return s.OutboxStore
+}
+
+func (s *ChaosLayer) PendingPin() PendingPinStore {
+	return s.PendingPinStore
+}
+
+func (s *ChaosLayer) Plugin() PluginStore {
+	return s.PluginStore
+}
+
+func (s *ChaosLayer) Post() PostStore {
+	return s.PostStore
+}
+
+func (s *ChaosLayer) PostAcknowledgement() PostAcknowledgementStore {
+	return s.PostAcknowledgementStore
+}
+
+func (s *ChaosLayer) PostArchive() PostArchiveStore {
+	return s.PostArchiveStore
+}
+
+func (s *ChaosLayer) PostIntegrity() PostIntegrityStore {
+	return s.PostIntegrityStore
+}
+
+func (s *ChaosLayer) PostsPartition() PostsPartitionStore {
+	return s.PostsPartitionStore
+}
+
+func (s *ChaosLayer) Preference() PreferenceStore {
+	return s.PreferenceStore
+}
+
+func (s *ChaosLayer) PresenceWebhook() PresenceWebhookStore {
+	return s.PresenceWebhookStore
+}
+
+func (s *ChaosLayer) Reaction() ReactionStore {
+	return s.ReactionStore
+}
+
+func (s *ChaosLayer) RetentionPolicy() RetentionPolicyStore {
+	return s.RetentionPolicyStore
+}
+
+func (s *ChaosLayer) Role() RoleStore {
+	return s.RoleStore
+}
+
+func (s *ChaosLayer) Scheme() SchemeStore {
+	return s.SchemeStore
+}
+
+func (s *ChaosLayer) Session() SessionStore {
+	return s.SessionStore
+}
+
+func (s *ChaosLayer) SlugHistory() SlugHistoryStore {
+	return s.SlugHistoryStore
+}
+
+func (s *ChaosLayer) Status() StatusStore {
+	return s.StatusStore
+}
+
+func (s *ChaosLayer) StatusAutomation() StatusAutomationStore {
+	return s.StatusAutomationStore
+}
+
+func (s *ChaosLayer) System() SystemStore {
+	return s.SystemStore
+}
+
+func (s *ChaosLayer) Team() TeamStore {
+	return s.TeamStore
+}
+
+func (s *ChaosLayer) TeamInviteLink() TeamInviteLinkStore {
+	return s.TeamInviteLinkStore
+}
+
+func (s *ChaosLayer) TeamMemberHistory() TeamMemberHistoryStore {
+	return s.TeamMemberHistoryStore
+}
+
+func (s *ChaosLayer) TermsOfService() TermsOfServiceStore {
+	return s.TermsOfServiceStore
+}
+
+func (s *ChaosLayer) TermsOfServicePolicy() TermsOfServicePolicyStore {
+	return s.TermsOfServicePolicyStore
+}
+
+func (s *ChaosLayer) Token() TokenStore {
+	return s.TokenStore
+}
+
+func (s *ChaosLayer) User() UserStore {
+	return s.UserStore
+}
+
+func (s *ChaosLayer) UserAccessToken() UserAccessTokenStore {
+	return s.UserAccessTokenStore
+}
+
+func (s *ChaosLayer) UserProperty() UserPropertyStore {
+	return s.UserPropertyStore
+}
+
+func (s *ChaosLayer) UserRelationship() UserRelationshipStore {
+	return s.UserRelationshipStore
+}
+
+func (s *ChaosLayer) UserTermsOfService() UserTermsOfServiceStore {
+	return s.UserTermsOfServiceStore
+}
+
+func (s *ChaosLayer) Webhook() WebhookStore {
+	return s.WebhookStore
+}
+
+type ChaosLayerAuditStore struct {
+	AuditStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerBotStore struct {
+	BotStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerChannelStore struct {
+	ChannelStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerChannelBookmarkStore struct {
+	ChannelBookmarkStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerChannelChangeStore struct {
+	ChannelChangeStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerChannelGuestLinkStore struct {
+	ChannelGuestLinkStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerChannelIntegrationStore struct {
+	ChannelIntegrationStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerChannelMemberHistoryStore struct {
+	ChannelMemberHistoryStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerClusterDiscoveryStore struct {
+	ClusterDiscoveryStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerCommandStore struct {
+	CommandStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerCommandWebhookStore struct {
+	CommandWebhookStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerComplianceStore struct {
+	ComplianceStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerEmailVerificationStore struct {
+	EmailVerificationStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerEmojiStore struct {
+	EmojiStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerEventStreamStore struct {
+	EventStreamStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerFileInfoStore struct {
+	FileInfoStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerGroupStore struct {
+	GroupStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerIntegrationUsageStore struct {
+	IntegrationUsageStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerJobStore struct {
+	JobStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerLicenseStore struct {
+	LicenseStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerLinkMetadataStore struct {
+	LinkMetadataStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerLoginHistoryStore struct {
+	LoginHistoryStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerOAuthStore struct {
+	OAuthStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerOutboxStore struct {
+	OutboxStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerPendingPinStore struct {
+	PendingPinStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerPluginStore struct {
+	PluginStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerPostStore struct {
+	PostStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerPostAcknowledgementStore struct {
+	PostAcknowledgementStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerPostArchiveStore struct {
+	PostArchiveStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerPostIntegrityStore struct {
+	PostIntegrityStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerPostsPartitionStore struct {
+	PostsPartitionStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerPreferenceStore struct {
+	PreferenceStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerPresenceWebhookStore struct {
+	PresenceWebhookStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerReactionStore struct {
+	ReactionStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerRetentionPolicyStore struct {
+	RetentionPolicyStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerRoleStore struct {
+	RoleStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerSchemeStore struct {
+	SchemeStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerSessionStore struct {
+	SessionStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerSlugHistoryStore struct {
+	SlugHistoryStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerStatusStore struct {
+	StatusStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerStatusAutomationStore struct {
+	StatusAutomationStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerSystemStore struct {
+	SystemStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerTeamStore struct {
+	TeamStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerTeamInviteLinkStore struct {
+	TeamInviteLinkStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerTeamMemberHistoryStore struct {
+	TeamMemberHistoryStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerTermsOfServiceStore struct {
+	TermsOfServiceStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerTermsOfServicePolicyStore struct {
+	TermsOfServicePolicyStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerTokenStore struct {
+	TokenStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerUserStore struct {
+	UserStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerUserAccessTokenStore struct {
+	UserAccessTokenStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerUserPropertyStore struct {
+	UserPropertyStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerUserRelationshipStore struct {
+	UserRelationshipStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerUserTermsOfServiceStore struct {
+	UserTermsOfServiceStore
+	Root *ChaosLayer
+}
+
+type ChaosLayerWebhookStore struct {
+	WebhookStore
+	Root *ChaosLayer
+}
+
+func (s *ChaosLayerAuditStore) Get(user_id string, offset int, limit int) (model.Audits, error) {
+	if err := s.Root.faults.inject("Audit", "Get"); err != nil {
+		var resultVar0 model.Audits
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.AuditStore.Get(user_id, offset, limit)
+}
+
+func (s *ChaosLayerAuditStore) PermanentDeleteByUser(userId string) error {
+	if err := s.Root.faults.inject("Audit", "PermanentDeleteByUser"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.AuditStore.PermanentDeleteByUser(userId)
+}
+
+func (s *ChaosLayerAuditStore) Save(audit *model.Audit) error {
+	if err := s.Root.faults.inject("Audit", "Save"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.AuditStore.Save(audit)
+}
+
+func (s *ChaosLayerBotStore) Get(userId string, includeDeleted bool) (*model.Bot, error) {
+	if err := s.Root.faults.inject("Bot", "Get"); err != nil {
+		var resultVar0 *model.Bot
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.BotStore.Get(userId, includeDeleted)
+}
+
+func (s *ChaosLayerBotStore) GetAll(options *model.BotGetOptions) ([]*model.Bot, error) {
+	if err := s.Root.faults.inject("Bot", "GetAll"); err != nil {
+		var resultVar0 []*model.Bot
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.BotStore.GetAll(options)
+}
+
+func (s *ChaosLayerBotStore) PermanentDelete(userId string) error {
+	if err := s.Root.faults.inject("Bot", "PermanentDelete"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.BotStore.PermanentDelete(userId)
+}
+
+func (s *ChaosLayerBotStore) Save(bot *model.Bot) (*model.Bot, error) {
+	if err := s.Root.faults.inject("Bot", "Save"); err != nil {
+		var resultVar0 *model.Bot
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.BotStore.Save(bot)
+}
+
+func (s *ChaosLayerBotStore) Update(bot *model.Bot) (*model.Bot, error) {
+	if err := s.Root.faults.inject("Bot", "Update"); err != nil {
+		var resultVar0 *model.Bot
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.BotStore.Update(bot)
+}
+
+func (s *ChaosLayerChannelStore) AnalyticsDeletedTypeCount(teamId string, channelType string) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "AnalyticsDeletedTypeCount"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.AnalyticsDeletedTypeCount", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.AnalyticsDeletedTypeCount(teamId, channelType)
+}
+
+func (s *ChaosLayerChannelStore) AnalyticsTypeCount(teamId string, channelType string) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "AnalyticsTypeCount"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.AnalyticsTypeCount", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.AnalyticsTypeCount(teamId, channelType)
+}
+
+func (s *ChaosLayerChannelStore) AutocompleteInTeam(teamId string, term string, includeDeleted bool) (*model.ChannelList, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "AutocompleteInTeam"); err != nil {
+		var resultVar0 *model.ChannelList
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.AutocompleteInTeam", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.AutocompleteInTeam(teamId, term, includeDeleted)
+}
+
+func (s *ChaosLayerChannelStore) AutocompleteInTeamForSearch(teamId string, userId string, term string, includeDeleted bool) (*model.ChannelList, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "AutocompleteInTeamForSearch"); err != nil {
+		var resultVar0 *model.ChannelList
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.AutocompleteInTeamForSearch", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.AutocompleteInTeamForSearch(teamId, userId, term, includeDeleted)
+}
+
+func (s *ChaosLayerChannelStore) ClearAllCustomRoleAssignments() *model.AppError {
+	if err := s.Root.faults.inject("Channel", "ClearAllCustomRoleAssignments"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.ChannelStore.ClearAllCustomRoleAssignments", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.ChannelStore.ClearAllCustomRoleAssignments()
+}
+
+func (s *ChaosLayerChannelStore) ClearCaches() {
+	s.Root.faults.delay("Channel", "ClearCaches")
+	s.ChannelStore.ClearCaches()
+}
+
+func (s *ChaosLayerChannelStore) ClearSidebarOnTeamLeave(userId string, teamId string) *model.AppError {
+	if err := s.Root.faults.inject("Channel", "ClearSidebarOnTeamLeave"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.ChannelStore.ClearSidebarOnTeamLeave", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.ChannelStore.ClearSidebarOnTeamLeave(userId, teamId)
+}
+
+func (s *ChaosLayerChannelStore) CountPostsAfter(channelId string, timestamp int64, userId string) (int, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "CountPostsAfter"); err != nil {
+		var resultVar0 int
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.CountPostsAfter", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.CountPostsAfter(channelId, timestamp, userId)
+}
+
+func (s *ChaosLayerChannelStore) CreateDirectChannel(userId *model.User, otherUserId *model.User) (*model.Channel, error) {
+	if err := s.Root.faults.inject("Channel", "CreateDirectChannel"); err != nil {
+		var resultVar0 *model.Channel
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.CreateDirectChannel(userId, otherUserId)
+}
+
+func (s *ChaosLayerChannelStore) CreateInitialSidebarCategories(userId string, teamId string) error {
+	if err := s.Root.faults.inject("Channel", "CreateInitialSidebarCategories"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.ChannelStore.CreateInitialSidebarCategories(userId, teamId)
+}
+
+func (s *ChaosLayerChannelStore) CreateSidebarCategory(userId string, teamId string, newCategory *model.SidebarCategoryWithChannels) (*model.SidebarCategoryWithChannels, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "CreateSidebarCategory"); err != nil {
+		var resultVar0 *model.SidebarCategoryWithChannels
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.CreateSidebarCategory", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.CreateSidebarCategory(userId, teamId, newCategory)
+}
+
+func (s *ChaosLayerChannelStore) Delete(channelId string, time int64) error {
+	if err := s.Root.faults.inject("Channel", "Delete"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.ChannelStore.Delete(channelId, time)
+}
+
+func (s *ChaosLayerChannelStore) DeleteSidebarCategory(categoryId string) *model.AppError {
+	if err := s.Root.faults.inject("Channel", "DeleteSidebarCategory"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.ChannelStore.DeleteSidebarCategory", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.ChannelStore.DeleteSidebarCategory(categoryId)
+}
+
+func (s *ChaosLayerChannelStore) Get(id string, allowFromCache bool) (*model.Channel, error) {
+	if err := s.Root.faults.inject("Channel", "Get"); err != nil {
+		var resultVar0 *model.Channel
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.Get(id, allowFromCache)
+}
+
+func (s *ChaosLayerChannelStore) GetAll(teamId string) ([]*model.Channel, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetAll"); err != nil {
+		var resultVar0 []*model.Channel
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetAll", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetAll(teamId)
+}
+
+func (s *ChaosLayerChannelStore) GetAllChannelMembersForUser(userId string, allowFromCache bool, includeDeleted bool) (map[string]string, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetAllChannelMembersForUser"); err != nil {
+		var resultVar0 map[string]string
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetAllChannelMembersForUser", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetAllChannelMembersForUser(userId, allowFromCache, includeDeleted)
+}
+
+func (s *ChaosLayerChannelStore) GetAllChannelMembersNotifyPropsForChannel(channelId string, allowFromCache bool) (map[string]model.StringMap, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetAllChannelMembersNotifyPropsForChannel"); err != nil {
+		var resultVar0 map[string]model.StringMap
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetAllChannelMembersNotifyPropsForChannel", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetAllChannelMembersNotifyPropsForChannel(channelId, allowFromCache)
+}
+
+func (s *ChaosLayerChannelStore) GetAllChannels(page int, perPage int, opts ChannelSearchOpts) (*model.ChannelListWithTeamData, error) {
+	if err := s.Root.faults.inject("Channel", "GetAllChannels"); err != nil {
+		var resultVar0 *model.ChannelListWithTeamData
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetAllChannels(page, perPage, opts)
+}
+
+func (s *ChaosLayerChannelStore) GetAllChannelsCount(opts ChannelSearchOpts) (int64, error) {
+	if err := s.Root.faults.inject("Channel", "GetAllChannelsCount"); err != nil {
+		var resultVar0 int64
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetAllChannelsCount(opts)
+}
+
+func (s *ChaosLayerChannelStore) GetAllChannelsForExportAfter(limit int, afterId string) ([]*model.ChannelForExport, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetAllChannelsForExportAfter"); err != nil {
+		var resultVar0 []*model.ChannelForExport
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetAllChannelsForExportAfter", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetAllChannelsForExportAfter(limit, afterId)
+}
+
+func (s *ChaosLayerChannelStore) GetAllDirectChannelsForExportAfter(limit int, afterId string) ([]*model.DirectChannelForExport, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetAllDirectChannelsForExportAfter"); err != nil {
+		var resultVar0 []*model.DirectChannelForExport
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetAllDirectChannelsForExportAfter", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetAllDirectChannelsForExportAfter(limit, afterId)
+}
+
+func (s *ChaosLayerChannelStore) GetByName(team_id string, name string, allowFromCache bool) (*model.Channel, error) {
+	if err := s.Root.faults.inject("Channel", "GetByName"); err != nil {
+		var resultVar0 *model.Channel
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetByName(team_id, name, allowFromCache)
+}
+
+func (s *ChaosLayerChannelStore) GetByNameIncludeDeleted(team_id string, name string, allowFromCache bool) (*model.Channel, error) {
+	if err := s.Root.faults.inject("Channel", "GetByNameIncludeDeleted"); err != nil {
+		var resultVar0 *model.Channel
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetByNameIncludeDeleted(team_id, name, allowFromCache)
+}
+
+func (s *ChaosLayerChannelStore) GetByNames(team_id string, names []string, allowFromCache bool) ([]*model.Channel, error) {
+	if err := s.Root.faults.inject("Channel", "GetByNames"); err != nil {
+		var resultVar0 []*model.Channel
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetByNames(team_id, names, allowFromCache)
+}
+
+func (s *ChaosLayerChannelStore) GetChannelCounts(teamId string, userId string) (*model.ChannelCounts, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetChannelCounts"); err != nil {
+		var resultVar0 *model.ChannelCounts
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetChannelCounts", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetChannelCounts(teamId, userId)
+}
+
+func (s *ChaosLayerChannelStore) GetChannelMembersForExport(userId string, teamId string) ([]*model.ChannelMemberForExport, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetChannelMembersForExport"); err != nil {
+		var resultVar0 []*model.ChannelMemberForExport
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetChannelMembersForExport", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetChannelMembersForExport(userId, teamId)
+}
+
+func (s *ChaosLayerChannelStore) GetChannelMembersTimezones(channelId string) ([]model.StringMap, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetChannelMembersTimezones"); err != nil {
+		var resultVar0 []model.StringMap
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetChannelMembersTimezones", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetChannelMembersTimezones(channelId)
+}
+
+func (s *ChaosLayerChannelStore) GetChannelUnread(channelId string, userId string) (*model.ChannelUnread, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetChannelUnread"); err != nil {
+		var resultVar0 *model.ChannelUnread
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetChannelUnread", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetChannelUnread(channelId, userId)
+}
+
+func (s *ChaosLayerChannelStore) GetChannels(teamId string, userId string, includeDeleted bool) (*model.ChannelList, error) {
+	if err := s.Root.faults.inject("Channel", "GetChannels"); err != nil {
+		var resultVar0 *model.ChannelList
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetChannels(teamId, userId, includeDeleted)
+}
+
+func (s *ChaosLayerChannelStore) GetChannelsBatchForIndexing(startTime int64, endTime int64, limit int) ([]*model.Channel, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetChannelsBatchForIndexing"); err != nil {
+		var resultVar0 []*model.Channel
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetChannelsBatchForIndexing", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetChannelsBatchForIndexing(startTime, endTime, limit)
+}
+
+func (s *ChaosLayerChannelStore) GetChannelsByIds(channelIds []string, includeDeleted bool) ([]*model.Channel, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetChannelsByIds"); err != nil {
+		var resultVar0 []*model.Channel
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetChannelsByIds", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetChannelsByIds(channelIds, includeDeleted)
+}
+
+func (s *ChaosLayerChannelStore) GetChannelsByScheme(schemeId string, offset int, limit int) (model.ChannelList, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetChannelsByScheme"); err != nil {
+		var resultVar0 model.ChannelList
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetChannelsByScheme", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetChannelsByScheme(schemeId, offset, limit)
+}
+
+func (s *ChaosLayerChannelStore) GetDeleted(team_id string, offset int, limit int, userId string) (*model.ChannelList, error) {
+	if err := s.Root.faults.inject("Channel", "GetDeleted"); err != nil {
+		var resultVar0 *model.ChannelList
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetDeleted(team_id, offset, limit, userId)
+}
+
+func (s *ChaosLayerChannelStore) GetDeletedByName(team_id string, name string) (*model.Channel, error) {
+	if err := s.Root.faults.inject("Channel", "GetDeletedByName"); err != nil {
+		var resultVar0 *model.Channel
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetDeletedByName(team_id, name)
+}
+
+func (s *ChaosLayerChannelStore) GetForPost(postId string) (*model.Channel, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetForPost"); err != nil {
+		var resultVar0 *model.Channel
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetForPost", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetForPost(postId)
+}
+
+func (s *ChaosLayerChannelStore) GetFromMaster(id string) (*model.Channel, error) {
+	if err := s.Root.faults.inject("Channel", "GetFromMaster"); err != nil {
+		var resultVar0 *model.Channel
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetFromMaster(id)
+}
+
+func (s *ChaosLayerChannelStore) GetGuestCount(channelId string, allowFromCache bool) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetGuestCount"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetGuestCount", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetGuestCount(channelId, allowFromCache)
+}
+
+func (s *ChaosLayerChannelStore) GetMember(channelId string, userId string) (*model.ChannelMember, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetMember"); err != nil {
+		var resultVar0 *model.ChannelMember
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetMember", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetMember(channelId, userId)
+}
+
+func (s *ChaosLayerChannelStore) GetMemberCount(channelId string, allowFromCache bool) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetMemberCount"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetMemberCount", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetMemberCount(channelId, allowFromCache)
+}
+
+func (s *ChaosLayerChannelStore) GetMemberCountFromCache(channelId string) int64 {
+	s.Root.faults.delay("Channel", "GetMemberCountFromCache")
+	return s.ChannelStore.GetMemberCountFromCache(channelId)
+}
+
+func (s *ChaosLayerChannelStore) GetMemberCountsByGroup(channelID string, includeTimezones bool) ([]*model.ChannelMemberCountByGroup, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetMemberCountsByGroup"); err != nil {
+		var resultVar0 []*model.ChannelMemberCountByGroup
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetMemberCountsByGroup", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetMemberCountsByGroup(channelID, includeTimezones)
+}
+
+func (s *ChaosLayerChannelStore) GetMemberForPost(postId string, userId string) (*model.ChannelMember, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetMemberForPost"); err != nil {
+		var resultVar0 *model.ChannelMember
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetMemberForPost", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetMemberForPost(postId, userId)
+}
+
+func (s *ChaosLayerChannelStore) GetMembers(channelId string, offset int, limit int) (*model.ChannelMembers, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetMembers"); err != nil {
+		var resultVar0 *model.ChannelMembers
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetMembers", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetMembers(channelId, offset, limit)
+}
+
+func (s *ChaosLayerChannelStore) GetMembersByIds(channelId string, userIds []string) (*model.ChannelMembers, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetMembersByIds"); err != nil {
+		var resultVar0 *model.ChannelMembers
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetMembersByIds", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetMembersByIds(channelId, userIds)
+}
+
+func (s *ChaosLayerChannelStore) GetMembersForUser(teamId string, userId string) (*model.ChannelMembers, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetMembersForUser"); err != nil {
+		var resultVar0 *model.ChannelMembers
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetMembersForUser", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetMembersForUser(teamId, userId)
+}
+
+func (s *ChaosLayerChannelStore) GetMembersForUserWithCursor(teamId string, userId string, cursor model.ChannelMembersCursor, limit int) (*model.ChannelMembersPage, model.ChannelMembersCursor, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetMembersForUserWithCursor"); err != nil {
+		var resultVar0 *model.ChannelMembersPage
+		var resultVar1 model.ChannelMembersCursor
+		var resultVar2 *model.AppError
+		resultVar2 = model.NewAppError("ChaosLayer.ChannelStore.GetMembersForUserWithCursor", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1, resultVar2
+	}
+	return s.ChannelStore.GetMembersForUserWithCursor(teamId, userId, cursor, limit)
+}
+
+func (s *ChaosLayerChannelStore) GetMembersForUserWithPagination(teamId string, userId string, page int, perPage int) (*model.ChannelMembers, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetMembersForUserWithPagination"); err != nil {
+		var resultVar0 *model.ChannelMembers
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetMembersForUserWithPagination", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetMembersForUserWithPagination(teamId, userId, page, perPage)
+}
+
+func (s *ChaosLayerChannelStore) GetMembersWithExpiredRoles(expiredBefore int64, limit int) ([]*model.ChannelMember, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetMembersWithExpiredRoles"); err != nil {
+		var resultVar0 []*model.ChannelMember
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetMembersWithExpiredRoles", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetMembersWithExpiredRoles(expiredBefore, limit)
+}
+
+func (s *ChaosLayerChannelStore) GetMoreChannels(teamId string, userId string, offset int, limit int) (*model.ChannelList, error) {
+	if err := s.Root.faults.inject("Channel", "GetMoreChannels"); err != nil {
+		var resultVar0 *model.ChannelList
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetMoreChannels(teamId, userId, offset, limit)
+}
+
+func (s *ChaosLayerChannelStore) GetPinnedPostCount(channelId string, allowFromCache bool) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetPinnedPostCount"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetPinnedPostCount", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetPinnedPostCount(channelId, allowFromCache)
+}
+
+func (s *ChaosLayerChannelStore) GetPinnedPosts(channelId string) (*model.PostList, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetPinnedPosts"); err != nil {
+		var resultVar0 *model.PostList
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetPinnedPosts", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetPinnedPosts(channelId)
+}
+
+func (s *ChaosLayerChannelStore) GetPrivateChannelsForTeam(teamId string, offset int, limit int) (*model.ChannelList, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetPrivateChannelsForTeam"); err != nil {
+		var resultVar0 *model.ChannelList
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetPrivateChannelsForTeam", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetPrivateChannelsForTeam(teamId, offset, limit)
+}
+
+func (s *ChaosLayerChannelStore) GetPublicChannelsByIdsForTeam(teamId string, channelIds []string) (*model.ChannelList, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetPublicChannelsByIdsForTeam"); err != nil {
+		var resultVar0 *model.ChannelList
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetPublicChannelsByIdsForTeam", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetPublicChannelsByIdsForTeam(teamId, channelIds)
+}
+
+func (s *ChaosLayerChannelStore) GetPublicChannelsForTeam(teamId string, offset int, limit int) (*model.ChannelList, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetPublicChannelsForTeam"); err != nil {
+		var resultVar0 *model.ChannelList
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetPublicChannelsForTeam", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetPublicChannelsForTeam(teamId, offset, limit)
+}
+
+func (s *ChaosLayerChannelStore) GetSidebarCategories(userId string, teamId string) (*model.OrderedSidebarCategories, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetSidebarCategories"); err != nil {
+		var resultVar0 *model.OrderedSidebarCategories
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetSidebarCategories", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetSidebarCategories(userId, teamId)
+}
+
+func (s *ChaosLayerChannelStore) GetSidebarCategory(categoryId string) (*model.SidebarCategoryWithChannels, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetSidebarCategory"); err != nil {
+		var resultVar0 *model.SidebarCategoryWithChannels
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetSidebarCategory", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetSidebarCategory(categoryId)
+}
+
+func (s *ChaosLayerChannelStore) GetSidebarCategoryOrder(userId string, teamId string) ([]string, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetSidebarCategoryOrder"); err != nil {
+		var resultVar0 []string
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetSidebarCategoryOrder", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetSidebarCategoryOrder(userId, teamId)
+}
+
+func (s *ChaosLayerChannelStore) GetTeamChannels(teamId string) (*model.ChannelList, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GetTeamChannels"); err != nil {
+		var resultVar0 *model.ChannelList
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GetTeamChannels", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GetTeamChannels(teamId)
+}
+
+func (s *ChaosLayerChannelStore) GroupSyncedChannelCount() (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "GroupSyncedChannelCount"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.GroupSyncedChannelCount", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.GroupSyncedChannelCount()
+}
+
+func (s *ChaosLayerChannelStore) IncrementMentionCount(channelId string, userId string, isUrgent bool) *model.AppError {
+	if err := s.Root.faults.inject("Channel", "IncrementMentionCount"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.ChannelStore.IncrementMentionCount", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.ChannelStore.IncrementMentionCount(channelId, userId, isUrgent)
+}
+
+func (s *ChaosLayerChannelStore) InvalidateAllChannelMembersForUser(userId string) {
+	s.Root.faults.delay("Channel", "InvalidateAllChannelMembersForUser")
+	s.ChannelStore.InvalidateAllChannelMembersForUser(userId)
+}
+
+func (s *ChaosLayerChannelStore) InvalidateCacheForChannelMembersNotifyProps(channelId string) {
+	s.Root.faults.delay("Channel", "InvalidateCacheForChannelMembersNotifyProps")
+	s.ChannelStore.InvalidateCacheForChannelMembersNotifyProps(channelId)
+}
+
+func (s *ChaosLayerChannelStore) InvalidateChannel(id string) {
+	s.Root.faults.delay("Channel", "InvalidateChannel")
+	s.ChannelStore.InvalidateChannel(id)
+}
+
+func (s *ChaosLayerChannelStore) InvalidateChannelByName(teamId string, name string) {
+	s.Root.faults.delay("Channel", "InvalidateChannelByName")
+	s.ChannelStore.InvalidateChannelByName(teamId, name)
+}
+
+func (s *ChaosLayerChannelStore) InvalidateGuestCount(channelId string) {
+	s.Root.faults.delay("Channel", "InvalidateGuestCount")
+	s.ChannelStore.InvalidateGuestCount(channelId)
+}
+
+func (s *ChaosLayerChannelStore) InvalidateMemberCount(channelId string) {
+	s.Root.faults.delay("Channel", "InvalidateMemberCount")
+	s.ChannelStore.InvalidateMemberCount(channelId)
+}
+
+func (s *ChaosLayerChannelStore) InvalidatePinnedPostCount(channelId string) {
+	s.Root.faults.delay("Channel", "InvalidatePinnedPostCount")
+	s.ChannelStore.InvalidatePinnedPostCount(channelId)
+}
+
+func (s *ChaosLayerChannelStore) IsUserInChannelUseCache(userId string, channelId string) bool {
+	s.Root.faults.delay("Channel", "IsUserInChannelUseCache")
+	return s.ChannelStore.IsUserInChannelUseCache(userId, channelId)
+}
+
+func (s *ChaosLayerChannelStore) MigrateChannelMembers(fromChannelId string, fromUserId string) (map[string]string, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "MigrateChannelMembers"); err != nil {
+		var resultVar0 map[string]string
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.MigrateChannelMembers", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.MigrateChannelMembers(fromChannelId, fromUserId)
+}
+
+func (s *ChaosLayerChannelStore) MigrateFavoritesToSidebarChannels(lastUserId string, runningOrder int64) (map[string]interface{}, error) {
+	if err := s.Root.faults.inject("Channel", "MigrateFavoritesToSidebarChannels"); err != nil {
+		var resultVar0 map[string]interface{}
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.MigrateFavoritesToSidebarChannels(lastUserId, runningOrder)
+}
+
+func (s *ChaosLayerChannelStore) MigrateMsgCountRoot(fromChannelId string, fromUserId string) (map[string]string, error) {
+	if err := s.Root.faults.inject("Channel", "MigrateMsgCountRoot"); err != nil {
+		var resultVar0 map[string]string
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.MigrateMsgCountRoot(fromChannelId, fromUserId)
+}
+
+func (s *ChaosLayerChannelStore) MigratePublicChannels() error {
+	if err := s.Root.faults.inject("Channel", "MigratePublicChannels"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.ChannelStore.MigratePublicChannels()
+}
+
+func (s *ChaosLayerChannelStore) MigrateSidebarCategories(fromTeamId string, fromUserId string) (map[string]interface{}, error) {
+	if err := s.Root.faults.inject("Channel", "MigrateSidebarCategories"); err != nil {
+		var resultVar0 map[string]interface{}
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.MigrateSidebarCategories(fromTeamId, fromUserId)
+}
+
+func (s *ChaosLayerChannelStore) MigrateTotalMsgCountRoot(fromChannelId string) (map[string]string, error) {
+	if err := s.Root.faults.inject("Channel", "MigrateTotalMsgCountRoot"); err != nil {
+		var resultVar0 map[string]string
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.MigrateTotalMsgCountRoot(fromChannelId)
+}
+
+func (s *ChaosLayerChannelStore) PermanentDelete(channelId string) error {
+	if err := s.Root.faults.inject("Channel", "PermanentDelete"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.ChannelStore.PermanentDelete(channelId)
+}
+
+func (s *ChaosLayerChannelStore) PermanentDeleteByTeam(teamId string) error {
+	if err := s.Root.faults.inject("Channel", "PermanentDeleteByTeam"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.ChannelStore.PermanentDeleteByTeam(teamId)
+}
+
+func (s *ChaosLayerChannelStore) PermanentDeleteMembersByChannel(channelId string) *model.AppError {
+	if err := s.Root.faults.inject("Channel", "PermanentDeleteMembersByChannel"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.ChannelStore.PermanentDeleteMembersByChannel", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.ChannelStore.PermanentDeleteMembersByChannel(channelId)
+}
+
+func (s *ChaosLayerChannelStore) PermanentDeleteMembersByUser(userId string) *model.AppError {
+	if err := s.Root.faults.inject("Channel", "PermanentDeleteMembersByUser"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.ChannelStore.PermanentDeleteMembersByUser", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.ChannelStore.PermanentDeleteMembersByUser(userId)
+}
+
+func (s *ChaosLayerChannelStore) RemoveAllDeactivatedMembers(channelId string) *model.AppError {
+	if err := s.Root.faults.inject("Channel", "RemoveAllDeactivatedMembers"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.ChannelStore.RemoveAllDeactivatedMembers", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.ChannelStore.RemoveAllDeactivatedMembers(channelId)
+}
+
+func (s *ChaosLayerChannelStore) RemoveMember(channelId string, userId string) *model.AppError {
+	if err := s.Root.faults.inject("Channel", "RemoveMember"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.ChannelStore.RemoveMember", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.ChannelStore.RemoveMember(channelId, userId)
+}
+
+func (s *ChaosLayerChannelStore) RemoveMembers(channelId string, userIds []string) *model.AppError {
+	if err := s.Root.faults.inject("Channel", "RemoveMembers"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.ChannelStore.RemoveMembers", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.ChannelStore.RemoveMembers(channelId, userIds)
+}
+
+func (s *ChaosLayerChannelStore) ResetAllChannelSchemes() *model.AppError {
+	if err := s.Root.faults.inject("Channel", "ResetAllChannelSchemes"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.ChannelStore.ResetAllChannelSchemes", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.ChannelStore.ResetAllChannelSchemes()
+}
+
+func (s *ChaosLayerChannelStore) Restore(channelId string, time int64) error {
+	if err := s.Root.faults.inject("Channel", "Restore"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.ChannelStore.Restore(channelId, time)
+}
+
+func (s *ChaosLayerChannelStore) Save(channel *model.Channel, maxChannelsPerTeam int64) (*model.Channel, error) {
+	if err := s.Root.faults.inject("Channel", "Save"); err != nil {
+		var resultVar0 *model.Channel
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.Save(channel, maxChannelsPerTeam)
+}
+
+func (s *ChaosLayerChannelStore) SaveDirectChannel(channel *model.Channel, member1 *model.ChannelMember, member2 *model.ChannelMember) (*model.Channel, error) {
+	if err := s.Root.faults.inject("Channel", "SaveDirectChannel"); err != nil {
+		var resultVar0 *model.Channel
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.SaveDirectChannel(channel, member1, member2)
+}
+
+func (s *ChaosLayerChannelStore) SaveMember(member *model.ChannelMember) (*model.ChannelMember, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "SaveMember"); err != nil {
+		var resultVar0 *model.ChannelMember
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.SaveMember", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.SaveMember(member)
+}
+
+func (s *ChaosLayerChannelStore) SaveMultipleMembers(members []*model.ChannelMember) ([]*model.ChannelMember, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "SaveMultipleMembers"); err != nil {
+		var resultVar0 []*model.ChannelMember
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.SaveMultipleMembers", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.SaveMultipleMembers(members)
+}
+
+func (s *ChaosLayerChannelStore) SearchAllChannels(term string, opts ChannelSearchOpts) (*model.ChannelListWithTeamData, int64, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "SearchAllChannels"); err != nil {
+		var resultVar0 *model.ChannelListWithTeamData
+		var resultVar1 int64
+		var resultVar2 *model.AppError
+		resultVar2 = model.NewAppError("ChaosLayer.ChannelStore.SearchAllChannels", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1, resultVar2
+	}
+	return s.ChannelStore.SearchAllChannels(term, opts)
+}
+
+func (s *ChaosLayerChannelStore) SearchArchivedInTeam(teamId string, term string, userId string) (*model.ChannelList, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "SearchArchivedInTeam"); err != nil {
+		var resultVar0 *model.ChannelList
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.SearchArchivedInTeam", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.SearchArchivedInTeam(teamId, term, userId)
+}
+
+func (s *ChaosLayerChannelStore) SearchForUserInTeam(userId string, teamId string, term string, includeDeleted bool) (*model.ChannelList, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "SearchForUserInTeam"); err != nil {
+		var resultVar0 *model.ChannelList
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.SearchForUserInTeam", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.SearchForUserInTeam(userId, teamId, term, includeDeleted)
+}
+
+func (s *ChaosLayerChannelStore) SearchGroupChannels(userId string, term string) (*model.ChannelList, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "SearchGroupChannels"); err != nil {
+		var resultVar0 *model.ChannelList
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.SearchGroupChannels", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.SearchGroupChannels(userId, term)
+}
+
+func (s *ChaosLayerChannelStore) SearchInTeam(teamId string, term string, includeDeleted bool) (*model.ChannelList, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "SearchInTeam"); err != nil {
+		var resultVar0 *model.ChannelList
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.SearchInTeam", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.SearchInTeam(teamId, term, includeDeleted)
+}
+
+func (s *ChaosLayerChannelStore) SearchMore(userId string, teamId string, term string) (*model.ChannelList, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "SearchMore"); err != nil {
+		var resultVar0 *model.ChannelList
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.SearchMore", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.SearchMore(userId, teamId, term)
+}
+
+func (s *ChaosLayerChannelStore) SetDeleteAt(channelId string, deleteAt int64, updateAt int64) error {
+	if err := s.Root.faults.inject("Channel", "SetDeleteAt"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.ChannelStore.SetDeleteAt(channelId, deleteAt, updateAt)
+}
+
+func (s *ChaosLayerChannelStore) Update(channel *model.Channel) (*model.Channel, error) {
+	if err := s.Root.faults.inject("Channel", "Update"); err != nil {
+		var resultVar0 *model.Channel
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.Update(channel)
+}
+
+func (s *ChaosLayerChannelStore) UpdateLastViewedAt(channelIds []string, userId string) (map[string]int64, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "UpdateLastViewedAt"); err != nil {
+		var resultVar0 map[string]int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.UpdateLastViewedAt", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.UpdateLastViewedAt(channelIds, userId)
+}
+
+func (s *ChaosLayerChannelStore) UpdateLastViewedAtPost(unreadPost *model.Post, userID string, mentionCount int) (*model.ChannelUnreadAt, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "UpdateLastViewedAtPost"); err != nil {
+		var resultVar0 *model.ChannelUnreadAt
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.UpdateLastViewedAtPost", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.UpdateLastViewedAtPost(unreadPost, userID, mentionCount)
+}
+
+func (s *ChaosLayerChannelStore) UpdateMember(member *model.ChannelMember) (*model.ChannelMember, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "UpdateMember"); err != nil {
+		var resultVar0 *model.ChannelMember
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.UpdateMember", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.UpdateMember(member)
+}
+
+func (s *ChaosLayerChannelStore) UpdateMembersRole(channelID string, userIDs []string) *model.AppError {
+	if err := s.Root.faults.inject("Channel", "UpdateMembersRole"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.ChannelStore.UpdateMembersRole", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.ChannelStore.UpdateMembersRole(channelID, userIDs)
+}
+
+func (s *ChaosLayerChannelStore) UpdateMembersRoleAndGetUpdated(channelID string, userIDs []string) ([]*model.ChannelMember, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "UpdateMembersRoleAndGetUpdated"); err != nil {
+		var resultVar0 []*model.ChannelMember
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.UpdateMembersRoleAndGetUpdated", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.UpdateMembersRoleAndGetUpdated(channelID, userIDs)
+}
+
+func (s *ChaosLayerChannelStore) UpdateMultipleMembers(members []*model.ChannelMember) ([]*model.ChannelMember, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "UpdateMultipleMembers"); err != nil {
+		var resultVar0 []*model.ChannelMember
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.UpdateMultipleMembers", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.UpdateMultipleMembers(members)
+}
+
+func (s *ChaosLayerChannelStore) UpdateSidebarCategories(userId string, teamId string, categories []*model.SidebarCategoryWithChannels) ([]*model.SidebarCategoryWithChannels, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "UpdateSidebarCategories"); err != nil {
+		var resultVar0 []*model.SidebarCategoryWithChannels
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.UpdateSidebarCategories", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.UpdateSidebarCategories(userId, teamId, categories)
+}
+
+func (s *ChaosLayerChannelStore) UpdateSidebarCategoryOrder(userId string, teamId string, categoryOrder []string) *model.AppError {
+	if err := s.Root.faults.inject("Channel", "UpdateSidebarCategoryOrder"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.ChannelStore.UpdateSidebarCategoryOrder", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.ChannelStore.UpdateSidebarCategoryOrder(userId, teamId, categoryOrder)
+}
+
+func (s *ChaosLayerChannelStore) UpdateSidebarChannelCategoryOnMove(channel *model.Channel, newTeamId string) *model.AppError {
+	if err := s.Root.faults.inject("Channel", "UpdateSidebarChannelCategoryOnMove"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.ChannelStore.UpdateSidebarChannelCategoryOnMove", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.ChannelStore.UpdateSidebarChannelCategoryOnMove(channel, newTeamId)
+}
+
+func (s *ChaosLayerChannelStore) UpdateSidebarChannelsByPreferences(preferences *model.Preferences) *model.AppError {
+	if err := s.Root.faults.inject("Channel", "UpdateSidebarChannelsByPreferences"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.ChannelStore.UpdateSidebarChannelsByPreferences", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.ChannelStore.UpdateSidebarChannelsByPreferences(preferences)
+}
+
+func (s *ChaosLayerChannelStore) UserBelongsToChannels(userId string, channelIds []string) (bool, *model.AppError) {
+	if err := s.Root.faults.inject("Channel", "UserBelongsToChannels"); err != nil {
+		var resultVar0 bool
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ChannelStore.UserBelongsToChannels", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ChannelStore.UserBelongsToChannels(userId, channelIds)
+}
+
+func (s *ChaosLayerChannelBookmarkStore) CountForChannel(channelId string) (int64, error) {
+	if err := s.Root.faults.inject("ChannelBookmark", "CountForChannel"); err != nil {
+		var resultVar0 int64
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelBookmarkStore.CountForChannel(channelId)
+}
+
+func (s *ChaosLayerChannelBookmarkStore) Delete(id string, deleteAt int64) error {
+	if err := s.Root.faults.inject("ChannelBookmark", "Delete"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.ChannelBookmarkStore.Delete(id, deleteAt)
+}
+
+func (s *ChaosLayerChannelBookmarkStore) Get(id string, includeDeleted bool) (*model.ChannelBookmark, error) {
+	if err := s.Root.faults.inject("ChannelBookmark", "Get"); err != nil {
+		var resultVar0 *model.ChannelBookmark
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelBookmarkStore.Get(id, includeDeleted)
+}
+
+func (s *ChaosLayerChannelBookmarkStore) GetBookmarksForChannel(channelId string, includeDeleted bool) ([]*model.ChannelBookmark, error) {
+	if err := s.Root.faults.inject("ChannelBookmark", "GetBookmarksForChannel"); err != nil {
+		var resultVar0 []*model.ChannelBookmark
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelBookmarkStore.GetBookmarksForChannel(channelId, includeDeleted)
+}
+
+func (s *ChaosLayerChannelBookmarkStore) PermanentDeleteByChannel(channelId string) error {
+	if err := s.Root.faults.inject("ChannelBookmark", "PermanentDeleteByChannel"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.ChannelBookmarkStore.PermanentDeleteByChannel(channelId)
+}
+
+func (s *ChaosLayerChannelBookmarkStore) Save(bookmark *model.ChannelBookmark) (*model.ChannelBookmark, error) {
+	if err := s.Root.faults.inject("ChannelBookmark", "Save"); err != nil {
+		var resultVar0 *model.ChannelBookmark
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelBookmarkStore.Save(bookmark)
+}
+
+func (s *ChaosLayerChannelBookmarkStore) Update(bookmark *model.ChannelBookmark) (*model.ChannelBookmark, error) {
+	if err := s.Root.faults.inject("ChannelBookmark", "Update"); err != nil {
+		var resultVar0 *model.ChannelBookmark
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelBookmarkStore.Update(bookmark)
+}
+
+func (s *ChaosLayerChannelChangeStore) GetLastSeq(channelId string) (int64, error) {
+	if err := s.Root.faults.inject("ChannelChange", "GetLastSeq"); err != nil {
+		var resultVar0 int64
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelChangeStore.GetLastSeq(channelId)
+}
+
+func (s *ChaosLayerChannelChangeStore) GetSince(channelId string, sinceSeq int64, limit int) ([]*model.ChannelChange, error) {
+	if err := s.Root.faults.inject("ChannelChange", "GetSince"); err != nil {
+		var resultVar0 []*model.ChannelChange
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelChangeStore.GetSince(channelId, sinceSeq, limit)
+}
+
+func (s *ChaosLayerChannelChangeStore) PermanentDeleteByChannel(channelId string) error {
+	if err := s.Root.faults.inject("ChannelChange", "PermanentDeleteByChannel"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.ChannelChangeStore.PermanentDeleteByChannel(channelId)
+}
+
+func (s *ChaosLayerChannelGuestLinkStore) CountForChannel(channelId string) (int64, error) {
+	if err := s.Root.faults.inject("ChannelGuestLink", "CountForChannel"); err != nil {
+		var resultVar0 int64
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelGuestLinkStore.CountForChannel(channelId)
+}
+
+func (s *ChaosLayerChannelGuestLinkStore) Get(id string) (*model.ChannelGuestLink, error) {
+	if err := s.Root.faults.inject("ChannelGuestLink", "Get"); err != nil {
+		var resultVar0 *model.ChannelGuestLink
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelGuestLinkStore.Get(id)
+}
+
+func (s *ChaosLayerChannelGuestLinkStore) GetByToken(token string) (*model.ChannelGuestLink, error) {
+	if err := s.Root.faults.inject("ChannelGuestLink", "GetByToken"); err != nil {
+		var resultVar0 *model.ChannelGuestLink
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelGuestLinkStore.GetByToken(token)
+}
+
+func (s *ChaosLayerChannelGuestLinkStore) GetForChannel(channelId string) ([]*model.ChannelGuestLink, error) {
+	if err := s.Root.faults.inject("ChannelGuestLink", "GetForChannel"); err != nil {
+		var resultVar0 []*model.ChannelGuestLink
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelGuestLinkStore.GetForChannel(channelId)
+}
+
+func (s *ChaosLayerChannelGuestLinkStore) IncrementUseCount(id string, now int64) error {
+	if err := s.Root.faults.inject("ChannelGuestLink", "IncrementUseCount"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.ChannelGuestLinkStore.IncrementUseCount(id, now)
+}
+
+func (s *ChaosLayerChannelGuestLinkStore) PermanentDeleteByChannel(channelId string) error {
+	if err := s.Root.faults.inject("ChannelGuestLink", "PermanentDeleteByChannel"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.ChannelGuestLinkStore.PermanentDeleteByChannel(channelId)
+}
+
+func (s *ChaosLayerChannelGuestLinkStore) Revoke(id string, deleteAt int64) error {
+	if err := s.Root.faults.inject("ChannelGuestLink", "Revoke"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.ChannelGuestLinkStore.Revoke(id, deleteAt)
+}
+
+func (s *ChaosLayerChannelGuestLinkStore) Save(link *model.ChannelGuestLink) (*model.ChannelGuestLink, error) {
+	if err := s.Root.faults.inject("ChannelGuestLink", "Save"); err != nil {
+		var resultVar0 *model.ChannelGuestLink
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelGuestLinkStore.Save(link)
+}
+
+func (s *ChaosLayerChannelIntegrationStore) Delete(channelId string, integrationId string) error {
+	if err := s.Root.faults.inject("ChannelIntegration", "Delete"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.ChannelIntegrationStore.Delete(channelId, integrationId)
+}
+
+func (s *ChaosLayerChannelIntegrationStore) Get(channelId string, integrationId string) (*model.ChannelIntegration, error) {
+	if err := s.Root.faults.inject("ChannelIntegration", "Get"); err != nil {
+		var resultVar0 *model.ChannelIntegration
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelIntegrationStore.Get(channelId, integrationId)
+}
+
+func (s *ChaosLayerChannelIntegrationStore) GetForChannel(channelId string) ([]*model.ChannelIntegration, error) {
+	if err := s.Root.faults.inject("ChannelIntegration", "GetForChannel"); err != nil {
+		var resultVar0 []*model.ChannelIntegration
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelIntegrationStore.GetForChannel(channelId)
+}
+
+func (s *ChaosLayerChannelIntegrationStore) PermanentDeleteByChannel(channelId string) error {
+	if err := s.Root.faults.inject("ChannelIntegration", "PermanentDeleteByChannel"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.ChannelIntegrationStore.PermanentDeleteByChannel(channelId)
+}
+
+func (s *ChaosLayerChannelIntegrationStore) PermanentDeleteByIntegration(integrationId string) error {
+	if err := s.Root.faults.inject("ChannelIntegration", "PermanentDeleteByIntegration"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.ChannelIntegrationStore.PermanentDeleteByIntegration(integrationId)
+}
+
+func (s *ChaosLayerChannelIntegrationStore) Save(integration *model.ChannelIntegration) (*model.ChannelIntegration, error) {
+	if err := s.Root.faults.inject("ChannelIntegration", "Save"); err != nil {
+		var resultVar0 *model.ChannelIntegration
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelIntegrationStore.Save(integration)
+}
+
+func (s *ChaosLayerChannelMemberHistoryStore) GetUsersInChannelDuring(startTime int64, endTime int64, channelId string) ([]*model.ChannelMemberHistoryResult, error) {
+	if err := s.Root.faults.inject("ChannelMemberHistory", "GetUsersInChannelDuring"); err != nil {
+		var resultVar0 []*model.ChannelMemberHistoryResult
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelMemberHistoryStore.GetUsersInChannelDuring(startTime, endTime, channelId)
+}
+
+func (s *ChaosLayerChannelMemberHistoryStore) LogJoinEvent(userId string, channelId string, joinTime int64) error {
+	if err := s.Root.faults.inject("ChannelMemberHistory", "LogJoinEvent"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.ChannelMemberHistoryStore.LogJoinEvent(userId, channelId, joinTime)
+}
+
+func (s *ChaosLayerChannelMemberHistoryStore) LogLeaveEvent(userId string, channelId string, leaveTime int64) error {
+	if err := s.Root.faults.inject("ChannelMemberHistory", "LogLeaveEvent"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.ChannelMemberHistoryStore.LogLeaveEvent(userId, channelId, leaveTime)
+}
+
+func (s *ChaosLayerChannelMemberHistoryStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+	if err := s.Root.faults.inject("ChannelMemberHistory", "PermanentDeleteBatch"); err != nil {
+		var resultVar0 int64
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ChannelMemberHistoryStore.PermanentDeleteBatch(endTime, limit)
+}
+
+func (s *ChaosLayerClusterDiscoveryStore) Cleanup() error {
+	if err := s.Root.faults.inject("ClusterDiscovery", "Cleanup"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.ClusterDiscoveryStore.Cleanup()
+}
+
+func (s *ChaosLayerClusterDiscoveryStore) Delete(discovery *model.ClusterDiscovery) (bool, error) {
+	if err := s.Root.faults.inject("ClusterDiscovery", "Delete"); err != nil {
+		var resultVar0 bool
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ClusterDiscoveryStore.Delete(discovery)
+}
+
+func (s *ChaosLayerClusterDiscoveryStore) Exists(discovery *model.ClusterDiscovery) (bool, error) {
+	if err := s.Root.faults.inject("ClusterDiscovery", "Exists"); err != nil {
+		var resultVar0 bool
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ClusterDiscoveryStore.Exists(discovery)
+}
+
+func (s *ChaosLayerClusterDiscoveryStore) GetAll(discoveryType string, clusterName string) ([]*model.ClusterDiscovery, error) {
+	if err := s.Root.faults.inject("ClusterDiscovery", "GetAll"); err != nil {
+		var resultVar0 []*model.ClusterDiscovery
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ClusterDiscoveryStore.GetAll(discoveryType, clusterName)
+}
+
+func (s *ChaosLayerClusterDiscoveryStore) Save(discovery *model.ClusterDiscovery) error {
+	if err := s.Root.faults.inject("ClusterDiscovery", "Save"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.ClusterDiscoveryStore.Save(discovery)
+}
+
+func (s *ChaosLayerClusterDiscoveryStore) SetLastPingAt(discovery *model.ClusterDiscovery) error {
+	if err := s.Root.faults.inject("ClusterDiscovery", "SetLastPingAt"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.ClusterDiscoveryStore.SetLastPingAt(discovery)
+}
+
+func (s *ChaosLayerCommandStore) AnalyticsCommandCount(teamId string) (int64, error) {
+	if err := s.Root.faults.inject("Command", "AnalyticsCommandCount"); err != nil {
+		var resultVar0 int64
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.CommandStore.AnalyticsCommandCount(teamId)
+}
+
+func (s *ChaosLayerCommandStore) Delete(commandId string, time int64) error {
+	if err := s.Root.faults.inject("Command", "Delete"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.CommandStore.Delete(commandId, time)
+}
+
+func (s *ChaosLayerCommandStore) Get(id string) (*model.Command, error) {
+	if err := s.Root.faults.inject("Command", "Get"); err != nil {
+		var resultVar0 *model.Command
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.CommandStore.Get(id)
+}
+
+func (s *ChaosLayerCommandStore) GetByTeam(teamId string) ([]*model.Command, error) {
+	if err := s.Root.faults.inject("Command", "GetByTeam"); err != nil {
+		var resultVar0 []*model.Command
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.CommandStore.GetByTeam(teamId)
+}
+
+func (s *ChaosLayerCommandStore) GetByTrigger(teamId string, trigger string) (*model.Command, error) {
+	if err := s.Root.faults.inject("Command", "GetByTrigger"); err != nil {
+		var resultVar0 *model.Command
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.CommandStore.GetByTrigger(teamId, trigger)
+}
+
+func (s *ChaosLayerCommandStore) PermanentDeleteByTeam(teamId string) error {
+	if err := s.Root.faults.inject("Command", "PermanentDeleteByTeam"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.CommandStore.PermanentDeleteByTeam(teamId)
+}
+
+func (s *ChaosLayerCommandStore) PermanentDeleteByUser(userId string) error {
+	if err := s.Root.faults.inject("Command", "PermanentDeleteByUser"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.CommandStore.PermanentDeleteByUser(userId)
+}
+
+func (s *ChaosLayerCommandStore) Save(webhook *model.Command) (*model.Command, error) {
+	if err := s.Root.faults.inject("Command", "Save"); err != nil {
+		var resultVar0 *model.Command
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.CommandStore.Save(webhook)
+}
+
+func (s *ChaosLayerCommandStore) Update(hook *model.Command) (*model.Command, error) {
+	if err := s.Root.faults.inject("Command", "Update"); err != nil {
+		var resultVar0 *model.Command
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.CommandStore.Update(hook)
+}
+
+func (s *ChaosLayerCommandWebhookStore) Cleanup() {
+	s.Root.faults.delay("CommandWebhook", "Cleanup")
+	s.CommandWebhookStore.Cleanup()
+}
+
+func (s *ChaosLayerCommandWebhookStore) Get(id string) (*model.CommandWebhook, *model.AppError) {
+	if err := s.Root.faults.inject("CommandWebhook", "Get"); err != nil {
+		var resultVar0 *model.CommandWebhook
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.CommandWebhookStore.Get", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.CommandWebhookStore.Get(id)
+}
+
+func (s *ChaosLayerCommandWebhookStore) Save(webhook *model.CommandWebhook) (*model.CommandWebhook, *model.AppError) {
+	if err := s.Root.faults.inject("CommandWebhook", "Save"); err != nil {
+		var resultVar0 *model.CommandWebhook
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.CommandWebhookStore.Save", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.CommandWebhookStore.Save(webhook)
+}
+
+func (s *ChaosLayerCommandWebhookStore) TryUse(id string, limit int) *model.AppError {
+	if err := s.Root.faults.inject("CommandWebhook", "TryUse"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.CommandWebhookStore.TryUse", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.CommandWebhookStore.TryUse(id, limit)
+}
+
+func (s *ChaosLayerComplianceStore) ComplianceExport(compliance *model.Compliance) ([]*model.CompliancePost, *model.AppError) {
+	if err := s.Root.faults.inject("Compliance", "ComplianceExport"); err != nil {
+		var resultVar0 []*model.CompliancePost
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ComplianceStore.ComplianceExport", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ComplianceStore.ComplianceExport(compliance)
+}
+
+func (s *ChaosLayerComplianceStore) Get(id string) (*model.Compliance, *model.AppError) {
+	if err := s.Root.faults.inject("Compliance", "Get"); err != nil {
+		var resultVar0 *model.Compliance
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ComplianceStore.Get", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ComplianceStore.Get(id)
+}
+
+func (s *ChaosLayerComplianceStore) GetAll(offset int, limit int) (model.Compliances, *model.AppError) {
+	if err := s.Root.faults.inject("Compliance", "GetAll"); err != nil {
+		var resultVar0 model.Compliances
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ComplianceStore.GetAll", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ComplianceStore.GetAll(offset, limit)
+}
+
+func (s *ChaosLayerComplianceStore) MessageExport(after int64, limit int) ([]*model.MessageExport, *model.AppError) {
+	if err := s.Root.faults.inject("Compliance", "MessageExport"); err != nil {
+		var resultVar0 []*model.MessageExport
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ComplianceStore.MessageExport", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ComplianceStore.MessageExport(after, limit)
+}
+
+func (s *ChaosLayerComplianceStore) Save(compliance *model.Compliance) (*model.Compliance, *model.AppError) {
+	if err := s.Root.faults.inject("Compliance", "Save"); err != nil {
+		var resultVar0 *model.Compliance
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ComplianceStore.Save", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ComplianceStore.Save(compliance)
+}
+
+func (s *ChaosLayerComplianceStore) Update(compliance *model.Compliance) (*model.Compliance, *model.AppError) {
+	if err := s.Root.faults.inject("Compliance", "Update"); err != nil {
+		var resultVar0 *model.Compliance
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.ComplianceStore.Update", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.ComplianceStore.Update(compliance)
+}
+
+func (s *ChaosLayerEmailVerificationStore) Delete(userId string) error {
+	if err := s.Root.faults.inject("EmailVerification", "Delete"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.EmailVerificationStore.Delete(userId)
+}
+
+func (s *ChaosLayerEmailVerificationStore) Get(userId string) (*model.EmailVerification, error) {
+	if err := s.Root.faults.inject("EmailVerification", "Get"); err != nil {
+		var resultVar0 *model.EmailVerification
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.EmailVerificationStore.Get(userId)
+}
+
+func (s *ChaosLayerEmailVerificationStore) GetForReminder(remindBefore int64, limit int) ([]*model.EmailVerification, error) {
+	if err := s.Root.faults.inject("EmailVerification", "GetForReminder"); err != nil {
+		var resultVar0 []*model.EmailVerification
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.EmailVerificationStore.GetForReminder(remindBefore, limit)
+}
+
+func (s *ChaosLayerEmailVerificationStore) GetOverdue(now int64, limit int) ([]*model.EmailVerification, error) {
+	if err := s.Root.faults.inject("EmailVerification", "GetOverdue"); err != nil {
+		var resultVar0 []*model.EmailVerification
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.EmailVerificationStore.GetOverdue(now, limit)
+}
+
+func (s *ChaosLayerEmailVerificationStore) GetUnverifiedUsers(offset int, limit int) ([]*model.UnverifiedUser, error) {
+	if err := s.Root.faults.inject("EmailVerification", "GetUnverifiedUsers"); err != nil {
+		var resultVar0 []*model.UnverifiedUser
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.EmailVerificationStore.GetUnverifiedUsers(offset, limit)
+}
+
+func (s *ChaosLayerEmailVerificationStore) Save(verification *model.EmailVerification) (*model.EmailVerification, error) {
+	if err := s.Root.faults.inject("EmailVerification", "Save"); err != nil {
+		var resultVar0 *model.EmailVerification
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.EmailVerificationStore.Save(verification)
+}
+
+func (s *ChaosLayerEmailVerificationStore) Update(verification *model.EmailVerification) (*model.EmailVerification, error) {
+	if err := s.Root.faults.inject("EmailVerification", "Update"); err != nil {
+		var resultVar0 *model.EmailVerification
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.EmailVerificationStore.Update(verification)
+}
+
+func (s *ChaosLayerEmojiStore) Delete(emoji *model.Emoji, time int64) error {
+	if err := s.Root.faults.inject("Emoji", "Delete"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.EmojiStore.Delete(emoji, time)
+}
+
+func (s *ChaosLayerEmojiStore) Get(id string, allowFromCache bool) (*model.Emoji, error) {
+	if err := s.Root.faults.inject("Emoji", "Get"); err != nil {
+		var resultVar0 *model.Emoji
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.EmojiStore.Get(id, allowFromCache)
+}
+
+func (s *ChaosLayerEmojiStore) GetByName(name string, allowFromCache bool) (*model.Emoji, error) {
+	if err := s.Root.faults.inject("Emoji", "GetByName"); err != nil {
+		var resultVar0 *model.Emoji
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.EmojiStore.GetByName(name, allowFromCache)
+}
+
+func (s *ChaosLayerEmojiStore) GetList(offset int, limit int, sort string, teamIds []string) ([]*model.Emoji, error) {
+	if err := s.Root.faults.inject("Emoji", "GetList"); err != nil {
+		var resultVar0 []*model.Emoji
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.EmojiStore.GetList(offset, limit, sort, teamIds)
+}
+
+func (s *ChaosLayerEmojiStore) GetMultipleByName(names []string) ([]*model.Emoji, error) {
+	if err := s.Root.faults.inject("Emoji", "GetMultipleByName"); err != nil {
+		var resultVar0 []*model.Emoji
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.EmojiStore.GetMultipleByName(names)
+}
+
+func (s *ChaosLayerEmojiStore) Save(emoji *model.Emoji) (*model.Emoji, error) {
+	if err := s.Root.faults.inject("Emoji", "Save"); err != nil {
+		var resultVar0 *model.Emoji
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.EmojiStore.Save(emoji)
+}
+
+func (s *ChaosLayerEmojiStore) Search(name string, prefixOnly bool, limit int, teamIds []string) ([]*model.Emoji, error) {
+	if err := s.Root.faults.inject("Emoji", "Search"); err != nil {
+		var resultVar0 []*model.Emoji
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.EmojiStore.Search(name, prefixOnly, limit, teamIds)
+}
+
+func (s *ChaosLayerEventStreamStore) Count() (int64, error) {
+	if err := s.Root.faults.inject("EventStream", "Count"); err != nil {
+		var resultVar0 int64
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.EventStreamStore.Count()
+}
+
+func (s *ChaosLayerEventStreamStore) Delete(ids []string) error {
+	if err := s.Root.faults.inject("EventStream", "Delete"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.EventStreamStore.Delete(ids)
+}
+
+func (s *ChaosLayerEventStreamStore) GetPending(now int64, limit int) ([]*model.EventStreamEvent, error) {
+	if err := s.Root.faults.inject("EventStream", "GetPending"); err != nil {
+		var resultVar0 []*model.EventStreamEvent
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.EventStreamStore.GetPending(now, limit)
+}
+
+func (s *ChaosLayerEventStreamStore) RecordFailedAttempt(ids []string, nextAttemptAt int64) error {
+	if err := s.Root.faults.inject("EventStream", "RecordFailedAttempt"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.EventStreamStore.RecordFailedAttempt(ids, nextAttemptAt)
+}
+
+func (s *ChaosLayerEventStreamStore) Save(event *model.EventStreamEvent) (*model.EventStreamEvent, error) {
+	if err := s.Root.faults.inject("EventStream", "Save"); err != nil {
+		var resultVar0 *model.EventStreamEvent
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.EventStreamStore.Save(event)
+}
+
+func (s *ChaosLayerFileInfoStore) AttachToPost(fileId string, postId string, creatorId string) *model.AppError {
+	if err := s.Root.faults.inject("FileInfo", "AttachToPost"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.FileInfoStore.AttachToPost", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.FileInfoStore.AttachToPost(fileId, postId, creatorId)
+}
+
+func (s *ChaosLayerFileInfoStore) ClearCaches() {
+	s.Root.faults.delay("FileInfo", "ClearCaches")
+	s.FileInfoStore.ClearCaches()
+}
+
+func (s *ChaosLayerFileInfoStore) DeleteForPost(postId string) (string, *model.AppError) {
+	if err := s.Root.faults.inject("FileInfo", "DeleteForPost"); err != nil {
+		var resultVar0 string
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.FileInfoStore.DeleteForPost", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.FileInfoStore.DeleteForPost(postId)
+}
+
+func (s *ChaosLayerFileInfoStore) Get(id string) (*model.FileInfo, *model.AppError) {
+	if err := s.Root.faults.inject("FileInfo", "Get"); err != nil {
+		var resultVar0 *model.FileInfo
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.FileInfoStore.Get", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.FileInfoStore.Get(id)
+}
+
+func (s *ChaosLayerFileInfoStore) GetByPath(path string) (*model.FileInfo, *model.AppError) {
+	if err := s.Root.faults.inject("FileInfo", "GetByPath"); err != nil {
+		var resultVar0 *model.FileInfo
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.FileInfoStore.GetByPath", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.FileInfoStore.GetByPath(path)
+}
+
+func (s *ChaosLayerFileInfoStore) GetForPost(postId string, readFromMaster bool, includeDeleted bool, allowFromCache bool) ([]*model.FileInfo, *model.AppError) {
+	if err := s.Root.faults.inject("FileInfo", "GetForPost"); err != nil {
+		var resultVar0 []*model.FileInfo
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.FileInfoStore.GetForPost", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.FileInfoStore.GetForPost(postId, readFromMaster, includeDeleted, allowFromCache)
+}
+
+func (s *ChaosLayerFileInfoStore) GetForUser(userId string) ([]*model.FileInfo, *model.AppError) {
+	if err := s.Root.faults.inject("FileInfo", "GetForUser"); err != nil {
+		var resultVar0 []*model.FileInfo
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.FileInfoStore.GetForUser", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.FileInfoStore.GetForUser(userId)
+}
+
+func (s *ChaosLayerFileInfoStore) GetWithOptions(page int, perPage int, opt *model.GetFileInfosOptions) ([]*model.FileInfo, *model.AppError) {
+	if err := s.Root.faults.inject("FileInfo", "GetWithOptions"); err != nil {
+		var resultVar0 []*model.FileInfo
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.FileInfoStore.GetWithOptions", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.FileInfoStore.GetWithOptions(page, perPage, opt)
+}
+
+func (s *ChaosLayerFileInfoStore) InvalidateFileInfosForPostCache(postId string, deleted bool) {
+	s.Root.faults.delay("FileInfo", "InvalidateFileInfosForPostCache")
+	s.FileInfoStore.InvalidateFileInfosForPostCache(postId, deleted)
+}
+
+func (s *ChaosLayerFileInfoStore) PermanentDelete(fileId string) *model.AppError {
+	if err := s.Root.faults.inject("FileInfo", "PermanentDelete"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.FileInfoStore.PermanentDelete", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.FileInfoStore.PermanentDelete(fileId)
+}
+
+func (s *ChaosLayerFileInfoStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("FileInfo", "PermanentDeleteBatch"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.FileInfoStore.PermanentDeleteBatch", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.FileInfoStore.PermanentDeleteBatch(endTime, limit)
+}
+
+func (s *ChaosLayerFileInfoStore) PermanentDeleteByUser(userId string) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("FileInfo", "PermanentDeleteByUser"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.FileInfoStore.PermanentDeleteByUser", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.FileInfoStore.PermanentDeleteByUser(userId)
+}
+
+func (s *ChaosLayerFileInfoStore) Save(info *model.FileInfo) (*model.FileInfo, *model.AppError) {
+	if err := s.Root.faults.inject("FileInfo", "Save"); err != nil {
+		var resultVar0 *model.FileInfo
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.FileInfoStore.Save", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.FileInfoStore.Save(info)
+}
+
+func (s *ChaosLayerFileInfoStore) SetSensitive(fileId string, sensitive bool) *model.AppError {
+	if err := s.Root.faults.inject("FileInfo", "SetSensitive"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.FileInfoStore.SetSensitive", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.FileInfoStore.SetSensitive(fileId, sensitive)
+}
+
+func (s *ChaosLayerFileInfoStore) UpdateEnrichment(info *model.FileInfo) *model.AppError {
+	if err := s.Root.faults.inject("FileInfo", "UpdateEnrichment"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.FileInfoStore.UpdateEnrichment", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.FileInfoStore.UpdateEnrichment(info)
+}
+
+func (s *ChaosLayerGroupStore) AdminRoleGroupsForSyncableMember(userID string, syncableID string, syncableType model.GroupSyncableType) ([]string, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "AdminRoleGroupsForSyncableMember"); err != nil {
+		var resultVar0 []string
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.AdminRoleGroupsForSyncableMember", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.AdminRoleGroupsForSyncableMember(userID, syncableID, syncableType)
+}
+
+func (s *ChaosLayerGroupStore) ChannelMembersMinusGroupMembers(channelID string, groupIDs []string, page int, perPage int) ([]*model.UserWithGroups, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "ChannelMembersMinusGroupMembers"); err != nil {
+		var resultVar0 []*model.UserWithGroups
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.ChannelMembersMinusGroupMembers", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.ChannelMembersMinusGroupMembers(channelID, groupIDs, page, perPage)
+}
+
+func (s *ChaosLayerGroupStore) ChannelMembersToAdd(since int64, channelID *string) ([]*model.UserChannelIDPair, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "ChannelMembersToAdd"); err != nil {
+		var resultVar0 []*model.UserChannelIDPair
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.ChannelMembersToAdd", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.ChannelMembersToAdd(since, channelID)
+}
+
+func (s *ChaosLayerGroupStore) ChannelMembersToRemove(channelID *string) ([]*model.ChannelMember, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "ChannelMembersToRemove"); err != nil {
+		var resultVar0 []*model.ChannelMember
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.ChannelMembersToRemove", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.ChannelMembersToRemove(channelID)
+}
+
+func (s *ChaosLayerGroupStore) CountChannelMembersMinusGroupMembers(channelID string, groupIDs []string) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "CountChannelMembersMinusGroupMembers"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.CountChannelMembersMinusGroupMembers", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.CountChannelMembersMinusGroupMembers(channelID, groupIDs)
+}
+
+func (s *ChaosLayerGroupStore) CountGroupsByChannel(channelId string, opts model.GroupSearchOpts) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "CountGroupsByChannel"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.CountGroupsByChannel", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.CountGroupsByChannel(channelId, opts)
+}
+
+func (s *ChaosLayerGroupStore) CountGroupsByTeam(teamId string, opts model.GroupSearchOpts) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "CountGroupsByTeam"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.CountGroupsByTeam", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.CountGroupsByTeam(teamId, opts)
+}
+
+func (s *ChaosLayerGroupStore) CountTeamMembersMinusGroupMembers(teamID string, groupIDs []string) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "CountTeamMembersMinusGroupMembers"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.CountTeamMembersMinusGroupMembers", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.CountTeamMembersMinusGroupMembers(teamID, groupIDs)
+}
+
+func (s *ChaosLayerGroupStore) Create(group *model.Group) (*model.Group, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "Create"); err != nil {
+		var resultVar0 *model.Group
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.Create", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.Create(group)
+}
+
+func (s *ChaosLayerGroupStore) CreateGroupSyncable(groupSyncable *model.GroupSyncable) (*model.GroupSyncable, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "CreateGroupSyncable"); err != nil {
+		var resultVar0 *model.GroupSyncable
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.CreateGroupSyncable", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.CreateGroupSyncable(groupSyncable)
+}
+
+func (s *ChaosLayerGroupStore) Delete(groupID string) (*model.Group, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "Delete"); err != nil {
+		var resultVar0 *model.Group
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.Delete", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.Delete(groupID)
+}
+
+func (s *ChaosLayerGroupStore) DeleteGroupSyncable(groupID string, syncableID string, syncableType model.GroupSyncableType) (*model.GroupSyncable, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "DeleteGroupSyncable"); err != nil {
+		var resultVar0 *model.GroupSyncable
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.DeleteGroupSyncable", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.DeleteGroupSyncable(groupID, syncableID, syncableType)
+}
+
+func (s *ChaosLayerGroupStore) DeleteMember(groupID string, userID string) (*model.GroupMember, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "DeleteMember"); err != nil {
+		var resultVar0 *model.GroupMember
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.DeleteMember", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.DeleteMember(groupID, userID)
+}
+
+func (s *ChaosLayerGroupStore) DistinctGroupMemberCount() (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "DistinctGroupMemberCount"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.DistinctGroupMemberCount", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.DistinctGroupMemberCount()
+}
+
+func (s *ChaosLayerGroupStore) Get(groupID string) (*model.Group, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "Get"); err != nil {
+		var resultVar0 *model.Group
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.Get", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.Get(groupID)
+}
+
+func (s *ChaosLayerGroupStore) GetAllBySource(groupSource model.GroupSource) ([]*model.Group, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "GetAllBySource"); err != nil {
+		var resultVar0 []*model.Group
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.GetAllBySource", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.GetAllBySource(groupSource)
+}
+
+func (s *ChaosLayerGroupStore) GetAllGroupSyncablesByGroupId(groupID string, syncableType model.GroupSyncableType) ([]*model.GroupSyncable, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "GetAllGroupSyncablesByGroupId"); err != nil {
+		var resultVar0 []*model.GroupSyncable
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.GetAllGroupSyncablesByGroupId", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.GetAllGroupSyncablesByGroupId(groupID, syncableType)
+}
+
+func (s *ChaosLayerGroupStore) GetByIDs(groupIDs []string) ([]*model.Group, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "GetByIDs"); err != nil {
+		var resultVar0 []*model.Group
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.GetByIDs", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.GetByIDs(groupIDs)
+}
+
+func (s *ChaosLayerGroupStore) GetByName(name string, opts model.GroupSearchOpts) (*model.Group, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "GetByName"); err != nil {
+		var resultVar0 *model.Group
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.GetByName", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.GetByName(name, opts)
+}
+
+func (s *ChaosLayerGroupStore) GetByRemoteID(remoteID string, groupSource model.GroupSource) (*model.Group, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "GetByRemoteID"); err != nil {
+		var resultVar0 *model.Group
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.GetByRemoteID", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.GetByRemoteID(remoteID, groupSource)
+}
+
+func (s *ChaosLayerGroupStore) GetByUser(userId string) ([]*model.Group, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "GetByUser"); err != nil {
+		var resultVar0 []*model.Group
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.GetByUser", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.GetByUser(userId)
+}
+
+func (s *ChaosLayerGroupStore) GetGroupSyncable(groupID string, syncableID string, syncableType model.GroupSyncableType) (*model.GroupSyncable, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "GetGroupSyncable"); err != nil {
+		var resultVar0 *model.GroupSyncable
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.GetGroupSyncable", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.GetGroupSyncable(groupID, syncableID, syncableType)
+}
+
+func (s *ChaosLayerGroupStore) GetGroups(page int, perPage int, opts model.GroupSearchOpts) ([]*model.Group, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "GetGroups"); err != nil {
+		var resultVar0 []*model.Group
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.GetGroups", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.GetGroups(page, perPage, opts)
+}
+
+func (s *ChaosLayerGroupStore) GetGroupsAssociatedToChannelsByTeam(teamId string, opts model.GroupSearchOpts) (map[string][]*model.GroupWithSchemeAdmin, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "GetGroupsAssociatedToChannelsByTeam"); err != nil {
+		var resultVar0 map[string][]*model.GroupWithSchemeAdmin
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.GetGroupsAssociatedToChannelsByTeam", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.GetGroupsAssociatedToChannelsByTeam(teamId, opts)
+}
+
+func (s *ChaosLayerGroupStore) GetGroupsByChannel(channelId string, opts model.GroupSearchOpts) ([]*model.GroupWithSchemeAdmin, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "GetGroupsByChannel"); err != nil {
+		var resultVar0 []*model.GroupWithSchemeAdmin
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.GetGroupsByChannel", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.GetGroupsByChannel(channelId, opts)
+}
+
+func (s *ChaosLayerGroupStore) GetGroupsByTeam(teamId string, opts model.GroupSearchOpts) ([]*model.GroupWithSchemeAdmin, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "GetGroupsByTeam"); err != nil {
+		var resultVar0 []*model.GroupWithSchemeAdmin
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.GetGroupsByTeam", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.GetGroupsByTeam(teamId, opts)
+}
+
+func (s *ChaosLayerGroupStore) GetMemberCount(groupID string) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "GetMemberCount"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.GetMemberCount", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.GetMemberCount(groupID)
+}
+
+func (s *ChaosLayerGroupStore) GetMemberUsers(groupID string) ([]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "GetMemberUsers"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.GetMemberUsers", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.GetMemberUsers(groupID)
+}
+
+func (s *ChaosLayerGroupStore) GetMemberUsersInTeam(groupID string, teamID string) ([]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "GetMemberUsersInTeam"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.GetMemberUsersInTeam", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.GetMemberUsersInTeam(groupID, teamID)
+}
+
+func (s *ChaosLayerGroupStore) GetMemberUsersNotInChannel(groupID string, channelID string) ([]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "GetMemberUsersNotInChannel"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.GetMemberUsersNotInChannel", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.GetMemberUsersNotInChannel(groupID, channelID)
+}
+
+func (s *ChaosLayerGroupStore) GetMemberUsersPage(groupID string, page int, perPage int) ([]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "GetMemberUsersPage"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.GetMemberUsersPage", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.GetMemberUsersPage(groupID, page, perPage)
+}
+
+func (s *ChaosLayerGroupStore) GroupChannelCount() (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "GroupChannelCount"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.GroupChannelCount", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.GroupChannelCount()
+}
+
+func (s *ChaosLayerGroupStore) GroupCount() (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "GroupCount"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.GroupCount", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.GroupCount()
+}
+
+func (s *ChaosLayerGroupStore) GroupCountWithAllowReference() (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "GroupCountWithAllowReference"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.GroupCountWithAllowReference", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.GroupCountWithAllowReference()
+}
+
+func (s *ChaosLayerGroupStore) GroupMemberCount() (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "GroupMemberCount"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.GroupMemberCount", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.GroupMemberCount()
+}
+
+func (s *ChaosLayerGroupStore) GroupTeamCount() (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "GroupTeamCount"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.GroupTeamCount", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.GroupTeamCount()
+}
+
+func (s *ChaosLayerGroupStore) PermanentDeleteMembersByUser(userId string) *model.AppError {
+	if err := s.Root.faults.inject("Group", "PermanentDeleteMembersByUser"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.GroupStore.PermanentDeleteMembersByUser", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.GroupStore.PermanentDeleteMembersByUser(userId)
+}
+
+func (s *ChaosLayerGroupStore) PermittedSyncableAdmins(syncableID string, syncableType model.GroupSyncableType) ([]string, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "PermittedSyncableAdmins"); err != nil {
+		var resultVar0 []string
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.PermittedSyncableAdmins", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.PermittedSyncableAdmins(syncableID, syncableType)
+}
+
+func (s *ChaosLayerGroupStore) TeamMembersMinusGroupMembers(teamID string, groupIDs []string, page int, perPage int) ([]*model.UserWithGroups, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "TeamMembersMinusGroupMembers"); err != nil {
+		var resultVar0 []*model.UserWithGroups
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.TeamMembersMinusGroupMembers", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.TeamMembersMinusGroupMembers(teamID, groupIDs, page, perPage)
+}
+
+func (s *ChaosLayerGroupStore) TeamMembersToAdd(since int64, teamID *string) ([]*model.UserTeamIDPair, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "TeamMembersToAdd"); err != nil {
+		var resultVar0 []*model.UserTeamIDPair
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.TeamMembersToAdd", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.TeamMembersToAdd(since, teamID)
+}
+
+func (s *ChaosLayerGroupStore) TeamMembersToRemove(teamID *string) ([]*model.TeamMember, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "TeamMembersToRemove"); err != nil {
+		var resultVar0 []*model.TeamMember
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.TeamMembersToRemove", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.TeamMembersToRemove(teamID)
+}
+
+func (s *ChaosLayerGroupStore) Update(group *model.Group) (*model.Group, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "Update"); err != nil {
+		var resultVar0 *model.Group
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.Update", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.Update(group)
+}
+
+func (s *ChaosLayerGroupStore) UpdateGroupSyncable(groupSyncable *model.GroupSyncable) (*model.GroupSyncable, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "UpdateGroupSyncable"); err != nil {
+		var resultVar0 *model.GroupSyncable
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.UpdateGroupSyncable", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.UpdateGroupSyncable(groupSyncable)
+}
+
+func (s *ChaosLayerGroupStore) UpsertMember(groupID string, userID string) (*model.GroupMember, *model.AppError) {
+	if err := s.Root.faults.inject("Group", "UpsertMember"); err != nil {
+		var resultVar0 *model.GroupMember
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.GroupStore.UpsertMember", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.GroupStore.UpsertMember(groupID, userID)
+}
+
+func (s *ChaosLayerIntegrationUsageStore) GetForPeriod(startDay int64, endDay int64) ([]*model.IntegrationUsage, error) {
+	if err := s.Root.faults.inject("IntegrationUsage", "GetForPeriod"); err != nil {
+		var resultVar0 []*model.IntegrationUsage
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.IntegrationUsageStore.GetForPeriod(startDay, endDay)
+}
+
+func (s *ChaosLayerIntegrationUsageStore) Increment(usage *model.IntegrationUsage) error {
+	if err := s.Root.faults.inject("IntegrationUsage", "Increment"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.IntegrationUsageStore.Increment(usage)
+}
+
+func (s *ChaosLayerIntegrationUsageStore) PermanentDeleteBatch(endDay int64, limit int64) (int64, error) {
+	if err := s.Root.faults.inject("IntegrationUsage", "PermanentDeleteBatch"); err != nil {
+		var resultVar0 int64
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.IntegrationUsageStore.PermanentDeleteBatch(endDay, limit)
+}
+
+func (s *ChaosLayerJobStore) Delete(id string) (string, *model.AppError) {
+	if err := s.Root.faults.inject("Job", "Delete"); err != nil {
+		var resultVar0 string
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.JobStore.Delete", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.JobStore.Delete(id)
+}
+
+func (s *ChaosLayerJobStore) Get(id string) (*model.Job, *model.AppError) {
+	if err := s.Root.faults.inject("Job", "Get"); err != nil {
+		var resultVar0 *model.Job
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.JobStore.Get", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.JobStore.Get(id)
+}
+
+func (s *ChaosLayerJobStore) GetAllByStatus(status string) ([]*model.Job, *model.AppError) {
+	if err := s.Root.faults.inject("Job", "GetAllByStatus"); err != nil {
+		var resultVar0 []*model.Job
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.JobStore.GetAllByStatus", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.JobStore.GetAllByStatus(status)
+}
+
+func (s *ChaosLayerJobStore) GetAllByType(jobType string) ([]*model.Job, *model.AppError) {
+	if err := s.Root.faults.inject("Job", "GetAllByType"); err != nil {
+		var resultVar0 []*model.Job
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.JobStore.GetAllByType", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.JobStore.GetAllByType(jobType)
+}
+
+func (s *ChaosLayerJobStore) GetAllByTypePage(jobType string, offset int, limit int) ([]*model.Job, *model.AppError) {
+	if err := s.Root.faults.inject("Job", "GetAllByTypePage"); err != nil {
+		var resultVar0 []*model.Job
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.JobStore.GetAllByTypePage", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.JobStore.GetAllByTypePage(jobType, offset, limit)
+}
+
+func (s *ChaosLayerJobStore) GetAllPage(offset int, limit int) ([]*model.Job, *model.AppError) {
+	if err := s.Root.faults.inject("Job", "GetAllPage"); err != nil {
+		var resultVar0 []*model.Job
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.JobStore.GetAllPage", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.JobStore.GetAllPage(offset, limit)
+}
+
+func (s *ChaosLayerJobStore) GetCountByStatusAndType(status string, jobType string) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Job", "GetCountByStatusAndType"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.JobStore.GetCountByStatusAndType", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.JobStore.GetCountByStatusAndType(status, jobType)
+}
+
+func (s *ChaosLayerJobStore) GetNewestJobByStatusAndType(status string, jobType string) (*model.Job, *model.AppError) {
+	if err := s.Root.faults.inject("Job", "GetNewestJobByStatusAndType"); err != nil {
+		var resultVar0 *model.Job
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.JobStore.GetNewestJobByStatusAndType", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.JobStore.GetNewestJobByStatusAndType(status, jobType)
+}
+
+func (s *ChaosLayerJobStore) Save(job *model.Job) (*model.Job, *model.AppError) {
+	if err := s.Root.faults.inject("Job", "Save"); err != nil {
+		var resultVar0 *model.Job
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.JobStore.Save", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.JobStore.Save(job)
+}
+
+func (s *ChaosLayerJobStore) UpdateOptimistically(job *model.Job, currentStatus string) (bool, *model.AppError) {
+	if err := s.Root.faults.inject("Job", "UpdateOptimistically"); err != nil {
+		var resultVar0 bool
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.JobStore.UpdateOptimistically", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.JobStore.UpdateOptimistically(job, currentStatus)
+}
+
+func (s *ChaosLayerJobStore) UpdateStatus(id string, status string) (*model.Job, *model.AppError) {
+	if err := s.Root.faults.inject("Job", "UpdateStatus"); err != nil {
+		var resultVar0 *model.Job
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.JobStore.UpdateStatus", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.JobStore.UpdateStatus(id, status)
+}
+
+func (s *ChaosLayerJobStore) UpdateStatusOptimistically(id string, currentStatus string, newStatus string) (bool, *model.AppError) {
+	if err := s.Root.faults.inject("Job", "UpdateStatusOptimistically"); err != nil {
+		var resultVar0 bool
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.JobStore.UpdateStatusOptimistically", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.JobStore.UpdateStatusOptimistically(id, currentStatus, newStatus)
+}
+
+func (s *ChaosLayerLicenseStore) Get(id string) (*model.LicenseRecord, error) {
+	if err := s.Root.faults.inject("License", "Get"); err != nil {
+		var resultVar0 *model.LicenseRecord
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.LicenseStore.Get(id)
+}
+
+func (s *ChaosLayerLicenseStore) Save(license *model.LicenseRecord) (*model.LicenseRecord, error) {
+	if err := s.Root.faults.inject("License", "Save"); err != nil {
+		var resultVar0 *model.LicenseRecord
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.LicenseStore.Save(license)
+}
+
+func (s *ChaosLayerLinkMetadataStore) Get(url string, timestamp int64) (*model.LinkMetadata, error) {
+	if err := s.Root.faults.inject("LinkMetadata", "Get"); err != nil {
+		var resultVar0 *model.LinkMetadata
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.LinkMetadataStore.Get(url, timestamp)
+}
+
+func (s *ChaosLayerLinkMetadataStore) Save(linkMetadata *model.LinkMetadata) (*model.LinkMetadata, error) {
+	if err := s.Root.faults.inject("LinkMetadata", "Save"); err != nil {
+		var resultVar0 *model.LinkMetadata
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.LinkMetadataStore.Save(linkMetadata)
+}
+
+func (s *ChaosLayerLoginHistoryStore) Get(options *model.LoginHistoryGetOptions) ([]*model.LoginHistory, error) {
+	if err := s.Root.faults.inject("LoginHistory", "Get"); err != nil {
+		var resultVar0 []*model.LoginHistory
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.LoginHistoryStore.Get(options)
+}
+
+func (s *ChaosLayerLoginHistoryStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+	if err := s.Root.faults.inject("LoginHistory", "PermanentDeleteBatch"); err != nil {
+		var resultVar0 int64
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.LoginHistoryStore.PermanentDeleteBatch(endTime, limit)
+}
+
+func (s *ChaosLayerLoginHistoryStore) PermanentDeleteByUser(userId string) error {
+	if err := s.Root.faults.inject("LoginHistory", "PermanentDeleteByUser"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.LoginHistoryStore.PermanentDeleteByUser(userId)
+}
+
+func (s *ChaosLayerLoginHistoryStore) Save(entry *model.LoginHistory) (*model.LoginHistory, error) {
+	if err := s.Root.faults.inject("LoginHistory", "Save"); err != nil {
+		var resultVar0 *model.LoginHistory
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.LoginHistoryStore.Save(entry)
+}
+
+func (s *ChaosLayerOAuthStore) DeleteApp(id string) error {
+	if err := s.Root.faults.inject("OAuth", "DeleteApp"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.OAuthStore.DeleteApp(id)
+}
+
+func (s *ChaosLayerOAuthStore) GetAccessData(token string) (*model.AccessData, error) {
+	if err := s.Root.faults.inject("OAuth", "GetAccessData"); err != nil {
+		var resultVar0 *model.AccessData
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.OAuthStore.GetAccessData(token)
+}
+
+func (s *ChaosLayerOAuthStore) GetAccessDataByRefreshToken(token string) (*model.AccessData, error) {
+	if err := s.Root.faults.inject("OAuth", "GetAccessDataByRefreshToken"); err != nil {
+		var resultVar0 *model.AccessData
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.OAuthStore.GetAccessDataByRefreshToken(token)
+}
+
+func (s *ChaosLayerOAuthStore) GetAccessDataByUserForApp(userId string, clientId string) ([]*model.AccessData, error) {
+	if err := s.Root.faults.inject("OAuth", "GetAccessDataByUserForApp"); err != nil {
+		var resultVar0 []*model.AccessData
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.OAuthStore.GetAccessDataByUserForApp(userId, clientId)
+}
+
+func (s *ChaosLayerOAuthStore) GetApp(id string) (*model.OAuthApp, error) {
+	if err := s.Root.faults.inject("OAuth", "GetApp"); err != nil {
+		var resultVar0 *model.OAuthApp
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.OAuthStore.GetApp(id)
+}
+
+func (s *ChaosLayerOAuthStore) GetAppByUser(userId string, offset int, limit int) ([]*model.OAuthApp, error) {
+	if err := s.Root.faults.inject("OAuth", "GetAppByUser"); err != nil {
+		var resultVar0 []*model.OAuthApp
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.OAuthStore.GetAppByUser(userId, offset, limit)
+}
+
+func (s *ChaosLayerOAuthStore) GetApps(offset int, limit int) ([]*model.OAuthApp, error) {
+	if err := s.Root.faults.inject("OAuth", "GetApps"); err != nil {
+		var resultVar0 []*model.OAuthApp
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.OAuthStore.GetApps(offset, limit)
+}
+
+func (s *ChaosLayerOAuthStore) GetAuthData(code string) (*model.AuthData, error) {
+	if err := s.Root.faults.inject("OAuth", "GetAuthData"); err != nil {
+		var resultVar0 *model.AuthData
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.OAuthStore.GetAuthData(code)
+}
+
+func (s *ChaosLayerOAuthStore) GetAuthorizedApps(userId string, offset int, limit int) ([]*model.OAuthApp, error) {
+	if err := s.Root.faults.inject("OAuth", "GetAuthorizedApps"); err != nil {
+		var resultVar0 []*model.OAuthApp
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.OAuthStore.GetAuthorizedApps(userId, offset, limit)
+}
+
+func (s *ChaosLayerOAuthStore) GetPreviousAccessData(userId string, clientId string) (*model.AccessData, error) {
+	if err := s.Root.faults.inject("OAuth", "GetPreviousAccessData"); err != nil {
+		var resultVar0 *model.AccessData
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.OAuthStore.GetPreviousAccessData(userId, clientId)
+}
+
+func (s *ChaosLayerOAuthStore) PermanentDeleteAuthDataByUser(userId string) error {
+	if err := s.Root.faults.inject("OAuth", "PermanentDeleteAuthDataByUser"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.OAuthStore.PermanentDeleteAuthDataByUser(userId)
+}
+
+func (s *ChaosLayerOAuthStore) RemoveAccessData(token string) error {
+	if err := s.Root.faults.inject("OAuth", "RemoveAccessData"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.OAuthStore.RemoveAccessData(token)
+}
+
+func (s *ChaosLayerOAuthStore) RemoveAllAccessData() error {
+	if err := s.Root.faults.inject("OAuth", "RemoveAllAccessData"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.OAuthStore.RemoveAllAccessData()
+}
+
+func (s *ChaosLayerOAuthStore) RemoveAuthData(code string) error {
+	if err := s.Root.faults.inject("OAuth", "RemoveAuthData"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.OAuthStore.RemoveAuthData(code)
+}
+
+func (s *ChaosLayerOAuthStore) SaveAccessData(accessData *model.AccessData) (*model.AccessData, error) {
+	if err := s.Root.faults.inject("OAuth", "SaveAccessData"); err != nil {
+		var resultVar0 *model.AccessData
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.OAuthStore.SaveAccessData(accessData)
+}
+
+func (s *ChaosLayerOAuthStore) SaveApp(app *model.OAuthApp) (*model.OAuthApp, error) {
+	if err := s.Root.faults.inject("OAuth", "SaveApp"); err != nil {
+		var resultVar0 *model.OAuthApp
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.OAuthStore.SaveApp(app)
+}
+
+func (s *ChaosLayerOAuthStore) SaveAuthData(authData *model.AuthData) (*model.AuthData, error) {
+	if err := s.Root.faults.inject("OAuth", "SaveAuthData"); err != nil {
+		var resultVar0 *model.AuthData
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.OAuthStore.SaveAuthData(authData)
+}
+
+func (s *ChaosLayerOAuthStore) UpdateAccessData(accessData *model.AccessData) (*model.AccessData, error) {
+	if err := s.Root.faults.inject("OAuth", "UpdateAccessData"); err != nil {
+		var resultVar0 *model.AccessData
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.OAuthStore.UpdateAccessData(accessData)
+}
+
+func (s *ChaosLayerOAuthStore) UpdateApp(app *model.OAuthApp) (*model.OAuthApp, error) {
+	if err := s.Root.faults.inject("OAuth", "UpdateApp"); err != nil {
+		var resultVar0 *model.OAuthApp
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.OAuthStore.UpdateApp(app)
+}
+
+func (s *ChaosLayerOutboxStore) GetUndelivered(createdBefore int64, limit int) ([]*model.OutboxEvent, error) {
+	if err := s.Root.faults.inject("Outbox", "GetUndelivered"); err != nil {
+		var resultVar0 []*model.OutboxEvent
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.OutboxStore.GetUndelivered(createdBefore, limit)
+}
+
+func (s *ChaosLayerOutboxStore) MarkDelivered(ids []string, deliveredAt int64) error {
+	if err := s.Root.faults.inject("Outbox", "MarkDelivered"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.OutboxStore.MarkDelivered(ids, deliveredAt)
+}
+
+func (s *ChaosLayerOutboxStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+	if err := s.Root.faults.inject("Outbox", "PermanentDeleteBatch"); err != nil {
+		var resultVar0 int64
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.OutboxStore.PermanentDeleteBatch(endTime, limit)
+}
+
+func (s *ChaosLayerOutboxStore) Save(event *model.OutboxEvent) (*model.OutboxEvent, error) {
+	if err := s.Root.faults.inject("Outbox", "Save"); err != nil {
+		var resultVar0 *model.OutboxEvent
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.OutboxStore.Save(event)
+}
+
+func (s *ChaosLayerPendingPinStore) Delete(postId string) error {
+	if err := s.Root.faults.inject("PendingPin", "Delete"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.PendingPinStore.Delete(postId)
+}
+
+func (s *ChaosLayerPendingPinStore) Get(postId string) (*model.PendingPin, error) {
+	if err := s.Root.faults.inject("PendingPin", "Get"); err != nil {
+		var resultVar0 *model.PendingPin
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.PendingPinStore.Get(postId)
+}
+
+func (s *ChaosLayerPendingPinStore) GetForChannel(channelId string) ([]*model.PendingPin, error) {
+	if err := s.Root.faults.inject("PendingPin", "GetForChannel"); err != nil {
+		var resultVar0 []*model.PendingPin
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.PendingPinStore.GetForChannel(channelId)
+}
+
+func (s *ChaosLayerPendingPinStore) PermanentDeleteByChannel(channelId string) error {
+	if err := s.Root.faults.inject("PendingPin", "PermanentDeleteByChannel"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.PendingPinStore.PermanentDeleteByChannel(channelId)
+}
+
+func (s *ChaosLayerPendingPinStore) Save(pendingPin *model.PendingPin) (*model.PendingPin, error) {
+	if err := s.Root.faults.inject("PendingPin", "Save"); err != nil {
+		var resultVar0 *model.PendingPin
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.PendingPinStore.Save(pendingPin)
+}
+
+func (s *ChaosLayerPluginStore) CompareAndDelete(keyVal *model.PluginKeyValue, oldValue []byte) (bool, *model.AppError) {
+	if err := s.Root.faults.inject("Plugin", "CompareAndDelete"); err != nil {
+		var resultVar0 bool
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PluginStore.CompareAndDelete", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PluginStore.CompareAndDelete(keyVal, oldValue)
+}
+
+func (s *ChaosLayerPluginStore) CompareAndSet(keyVal *model.PluginKeyValue, oldValue []byte) (bool, *model.AppError) {
+	if err := s.Root.faults.inject("Plugin", "CompareAndSet"); err != nil {
+		var resultVar0 bool
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PluginStore.CompareAndSet", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PluginStore.CompareAndSet(keyVal, oldValue)
+}
+
+func (s *ChaosLayerPluginStore) Delete(pluginId string, key string) *model.AppError {
+	if err := s.Root.faults.inject("Plugin", "Delete"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.PluginStore.Delete", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.PluginStore.Delete(pluginId, key)
+}
+
+func (s *ChaosLayerPluginStore) DeleteAllExpired() *model.AppError {
+	if err := s.Root.faults.inject("Plugin", "DeleteAllExpired"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.PluginStore.DeleteAllExpired", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.PluginStore.DeleteAllExpired()
+}
+
+func (s *ChaosLayerPluginStore) DeleteAllForPlugin(PluginId string) *model.AppError {
+	if err := s.Root.faults.inject("Plugin", "DeleteAllForPlugin"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.PluginStore.DeleteAllForPlugin", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.PluginStore.DeleteAllForPlugin(PluginId)
+}
+
+func (s *ChaosLayerPluginStore) Get(pluginId string, key string) (*model.PluginKeyValue, *model.AppError) {
+	if err := s.Root.faults.inject("Plugin", "Get"); err != nil {
+		var resultVar0 *model.PluginKeyValue
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PluginStore.Get", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PluginStore.Get(pluginId, key)
+}
+
+func (s *ChaosLayerPluginStore) List(pluginId string, page int, perPage int) ([]string, *model.AppError) {
+	if err := s.Root.faults.inject("Plugin", "List"); err != nil {
+		var resultVar0 []string
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PluginStore.List", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PluginStore.List(pluginId, page, perPage)
+}
+
+func (s *ChaosLayerPluginStore) SaveOrUpdate(keyVal *model.PluginKeyValue) (*model.PluginKeyValue, *model.AppError) {
+	if err := s.Root.faults.inject("Plugin", "SaveOrUpdate"); err != nil {
+		var resultVar0 *model.PluginKeyValue
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PluginStore.SaveOrUpdate", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PluginStore.SaveOrUpdate(keyVal)
+}
+
+func (s *ChaosLayerPluginStore) SetWithOptions(pluginId string, key string, value []byte, options model.PluginKVSetOptions) (bool, *model.AppError) {
+	if err := s.Root.faults.inject("Plugin", "SetWithOptions"); err != nil {
+		var resultVar0 bool
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PluginStore.SetWithOptions", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PluginStore.SetWithOptions(pluginId, key, value, options)
+}
+
+func (s *ChaosLayerPostStore) AnalyticsPostCount(teamId string, mustHaveFile bool, mustHaveHashtag bool, estimate bool) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "AnalyticsPostCount"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PostStore.AnalyticsPostCount", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PostStore.AnalyticsPostCount(teamId, mustHaveFile, mustHaveHashtag, estimate)
+}
+
+func (s *ChaosLayerPostStore) AnalyticsPostCountsByDay(options *model.AnalyticsPostCountsOptions) (model.AnalyticsRows, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "AnalyticsPostCountsByDay"); err != nil {
+		var resultVar0 model.AnalyticsRows
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PostStore.AnalyticsPostCountsByDay", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PostStore.AnalyticsPostCountsByDay(options)
+}
+
+func (s *ChaosLayerPostStore) AnalyticsUserCountsWithPostsByDay(teamId string) (model.AnalyticsRows, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "AnalyticsUserCountsWithPostsByDay"); err != nil {
+		var resultVar0 model.AnalyticsRows
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PostStore.AnalyticsUserCountsWithPostsByDay", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PostStore.AnalyticsUserCountsWithPostsByDay(teamId)
+}
+
+func (s *ChaosLayerPostStore) ClearCaches() {
+	s.Root.faults.delay("Post", "ClearCaches")
+	s.PostStore.ClearCaches()
+}
+
+func (s *ChaosLayerPostStore) Delete(postId string, time int64, deleteByID string) *model.AppError {
+	if err := s.Root.faults.inject("Post", "Delete"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.PostStore.Delete", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.PostStore.Delete(postId, time, deleteByID)
+}
+
+func (s *ChaosLayerPostStore) DeleteWithOutboxEvent(postId string, time int64, deleteByID string, message *model.WebSocketEvent) (*model.OutboxEvent, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "DeleteWithOutboxEvent"); err != nil {
+		var resultVar0 *model.OutboxEvent
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PostStore.DeleteWithOutboxEvent", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PostStore.DeleteWithOutboxEvent(postId, time, deleteByID, message)
+}
+
+func (s *ChaosLayerPostStore) Get(id string, skipFetchThreads bool) (*model.PostList, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "Get"); err != nil {
+		var resultVar0 *model.PostList
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PostStore.Get", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PostStore.Get(id, skipFetchThreads)
+}
+
+func (s *ChaosLayerPostStore) GetDirectPostParentsForExportAfter(limit int, afterId string) ([]*model.DirectPostForExport, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "GetDirectPostParentsForExportAfter"); err != nil {
+		var resultVar0 []*model.DirectPostForExport
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PostStore.GetDirectPostParentsForExportAfter", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PostStore.GetDirectPostParentsForExportAfter(limit, afterId)
+}
+
+func (s *ChaosLayerPostStore) GetEtag(channelId string, allowFromCache bool) string {
+	s.Root.faults.delay("Post", "GetEtag")
+	return s.PostStore.GetEtag(channelId, allowFromCache)
+}
+
+func (s *ChaosLayerPostStore) GetFlaggedPosts(userId string, offset int, limit int) (*model.PostList, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "GetFlaggedPosts"); err != nil {
+		var resultVar0 *model.PostList
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PostStore.GetFlaggedPosts", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PostStore.GetFlaggedPosts(userId, offset, limit)
+}
+
+func (s *ChaosLayerPostStore) GetFlaggedPostsForChannel(userId string, channelId string, offset int, limit int) (*model.PostList, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "GetFlaggedPostsForChannel"); err != nil {
+		var resultVar0 *model.PostList
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PostStore.GetFlaggedPostsForChannel", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PostStore.GetFlaggedPostsForChannel(userId, channelId, offset, limit)
+}
+
+func (s *ChaosLayerPostStore) GetFlaggedPostsForTeam(userId string, teamId string, offset int, limit int) (*model.PostList, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "GetFlaggedPostsForTeam"); err != nil {
+		var resultVar0 *model.PostList
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PostStore.GetFlaggedPostsForTeam", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PostStore.GetFlaggedPostsForTeam(userId, teamId, offset, limit)
+}
+
+func (s *ChaosLayerPostStore) GetMaxPostSize() int {
+	s.Root.faults.delay("Post", "GetMaxPostSize")
+	return s.PostStore.GetMaxPostSize()
+}
+
+func (s *ChaosLayerPostStore) GetOldest() (*model.Post, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "GetOldest"); err != nil {
+		var resultVar0 *model.Post
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PostStore.GetOldest", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PostStore.GetOldest()
+}
+
+func (s *ChaosLayerPostStore) GetOldestEntityCreationTime() (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "GetOldestEntityCreationTime"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PostStore.GetOldestEntityCreationTime", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PostStore.GetOldestEntityCreationTime()
+}
+
+func (s *ChaosLayerPostStore) GetParentsForExportAfter(limit int, afterId string) ([]*model.PostForExport, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "GetParentsForExportAfter"); err != nil {
+		var resultVar0 []*model.PostForExport
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PostStore.GetParentsForExportAfter", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PostStore.GetParentsForExportAfter(limit, afterId)
+}
+
+func (s *ChaosLayerPostStore) GetPostAfterTime(channelId string, time int64) (*model.Post, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "GetPostAfterTime"); err != nil {
+		var resultVar0 *model.Post
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PostStore.GetPostAfterTime", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PostStore.GetPostAfterTime(channelId, time)
+}
+
+func (s *ChaosLayerPostStore) GetPostIdAfterTime(channelId string, time int64) (string, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "GetPostIdAfterTime"); err != nil {
+		var resultVar0 string
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PostStore.GetPostIdAfterTime", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PostStore.GetPostIdAfterTime(channelId, time)
+}
+
+func (s *ChaosLayerPostStore) GetPostIdBeforeTime(channelId string, time int64) (string, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "GetPostIdBeforeTime"); err != nil {
+		var resultVar0 string
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PostStore.GetPostIdBeforeTime", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PostStore.GetPostIdBeforeTime(channelId, time)
+}
+
+func (s *ChaosLayerPostStore) GetPosts(options model.GetPostsOptions, allowFromCache bool) (*model.PostList, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "GetPosts"); err != nil {
+		var resultVar0 *model.PostList
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PostStore.GetPosts", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PostStore.GetPosts(options, allowFromCache)
+}
+
+func (s *ChaosLayerPostStore) GetPostsAfter(options model.GetPostsOptions) (*model.PostList, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "GetPostsAfter"); err != nil {
+		var resultVar0 *model.PostList
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PostStore.GetPostsAfter", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PostStore.GetPostsAfter(options)
+}
+
+func (s *ChaosLayerPostStore) GetPostsBatchForIndexing(startTime int64, endTime int64, limit int) ([]*model.PostForIndexing, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "GetPostsBatchForIndexing"); err != nil {
+		var resultVar0 []*model.PostForIndexing
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PostStore.GetPostsBatchForIndexing", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PostStore.GetPostsBatchForIndexing(startTime, endTime, limit)
+}
+
+func (s *ChaosLayerPostStore) GetPostsBefore(options model.GetPostsOptions) (*model.PostList, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "GetPostsBefore"); err != nil {
+		var resultVar0 *model.PostList
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PostStore.GetPostsBefore", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PostStore.GetPostsBefore(options)
+}
+
+func (s *ChaosLayerPostStore) GetPostsByIds(postIds []string) ([]*model.Post, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "GetPostsByIds"); err != nil {
+		var resultVar0 []*model.Post
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PostStore.GetPostsByIds", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PostStore.GetPostsByIds(postIds)
+}
+
+func (s *ChaosLayerPostStore) GetPostsCreatedAt(channelId string, time int64) ([]*model.Post, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "GetPostsCreatedAt"); err != nil {
+		var resultVar0 []*model.Post
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PostStore.GetPostsCreatedAt", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PostStore.GetPostsCreatedAt(channelId, time)
+}
+
+func (s *ChaosLayerPostStore) GetPostsDelta(cursor model.PostsDeltaCursor, limit int) (*model.PostsDelta, model.PostsDeltaCursor, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "GetPostsDelta"); err != nil {
+		var resultVar0 *model.PostsDelta
+		var resultVar1 model.PostsDeltaCursor
+		var resultVar2 *model.AppError
+		resultVar2 = model.NewAppError("ChaosLayer.PostStore.GetPostsDelta", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1, resultVar2
+	}
+	return s.PostStore.GetPostsDelta(cursor, limit)
+}
+
+func (s *ChaosLayerPostStore) GetPostsForChannelSnapshot(channelId string, cursor model.ChannelSnapshotCursor, limit int) ([]*model.Post, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "GetPostsForChannelSnapshot"); err != nil {
+		var resultVar0 []*model.Post
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PostStore.GetPostsForChannelSnapshot", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PostStore.GetPostsForChannelSnapshot(channelId, cursor, limit)
+}
+
+func (s *ChaosLayerPostStore) GetPostsSince(options model.GetPostsSinceOptions, allowFromCache bool) (*model.PostList, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "GetPostsSince"); err != nil {
+		var resultVar0 *model.PostList
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PostStore.GetPostsSince", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PostStore.GetPostsSince(options, allowFromCache)
+}
+
+func (s *ChaosLayerPostStore) GetRepliesForExport(parentId string) ([]*model.ReplyForExport, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "GetRepliesForExport"); err != nil {
+		var resultVar0 []*model.ReplyForExport
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PostStore.GetRepliesForExport", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PostStore.GetRepliesForExport(parentId)
+}
+
+func (s *ChaosLayerPostStore) GetSingle(id string) (*model.Post, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "GetSingle"); err != nil {
+		var resultVar0 *model.Post
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PostStore.GetSingle", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PostStore.GetSingle(id)
+}
+
+func (s *ChaosLayerPostStore) InvalidateLastPostTimeCache(channelId string) {
+	s.Root.faults.delay("Post", "InvalidateLastPostTimeCache")
+	s.PostStore.InvalidateLastPostTimeCache(channelId)
+}
+
+func (s *ChaosLayerPostStore) Overwrite(post *model.Post) (*model.Post, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "Overwrite"); err != nil {
+		var resultVar0 *model.Post
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PostStore.Overwrite", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PostStore.Overwrite(post)
+}
+
+func (s *ChaosLayerPostStore) OverwriteMultiple(posts []*model.Post) ([]*model.Post, int, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "OverwriteMultiple"); err != nil {
+		var resultVar0 []*model.Post
+		var resultVar1 int
+		var resultVar2 *model.AppError
+		resultVar2 = model.NewAppError("ChaosLayer.PostStore.OverwriteMultiple", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1, resultVar2
+	}
+	return s.PostStore.OverwriteMultiple(posts)
+}
+
+func (s *ChaosLayerPostStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "PermanentDeleteBatch"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PostStore.PermanentDeleteBatch", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PostStore.PermanentDeleteBatch(endTime, limit)
+}
+
+func (s *ChaosLayerPostStore) PermanentDeleteByChannel(channelId string) *model.AppError {
+	if err := s.Root.faults.inject("Post", "PermanentDeleteByChannel"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.PostStore.PermanentDeleteByChannel", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.PostStore.PermanentDeleteByChannel(channelId)
+}
+
+func (s *ChaosLayerPostStore) PermanentDeleteByUser(userId string) *model.AppError {
+	if err := s.Root.faults.inject("Post", "PermanentDeleteByUser"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.PostStore.PermanentDeleteByUser", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.PostStore.PermanentDeleteByUser(userId)
+}
+
+func (s *ChaosLayerPostStore) Save(post *model.Post) (*model.Post, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "Save"); err != nil {
+		var resultVar0 *model.Post
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PostStore.Save", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PostStore.Save(post)
+}
+
+func (s *ChaosLayerPostStore) SaveMultiple(posts []*model.Post) ([]*model.Post, int, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "SaveMultiple"); err != nil {
+		var resultVar0 []*model.Post
+		var resultVar1 int
+		var resultVar2 *model.AppError
+		resultVar2 = model.NewAppError("ChaosLayer.PostStore.SaveMultiple", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1, resultVar2
+	}
+	return s.PostStore.SaveMultiple(posts)
+}
+
+func (s *ChaosLayerPostStore) SaveWithOutboxEvent(post *model.Post, message *model.WebSocketEvent) (*model.Post, *model.OutboxEvent, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "SaveWithOutboxEvent"); err != nil {
+		var resultVar0 *model.Post
+		var resultVar1 *model.OutboxEvent
+		var resultVar2 *model.AppError
+		resultVar2 = model.NewAppError("ChaosLayer.PostStore.SaveWithOutboxEvent", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1, resultVar2
+	}
+	return s.PostStore.SaveWithOutboxEvent(post, message)
+}
+
+func (s *ChaosLayerPostStore) Search(teamId string, userId string, params *model.SearchParams) (*model.PostList, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "Search"); err != nil {
+		var resultVar0 *model.PostList
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PostStore.Search", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PostStore.Search(teamId, userId, params)
+}
+
+func (s *ChaosLayerPostStore) SearchPostsInTeamForUser(paramsList []*model.SearchParams, userId string, teamId string, isOrSearch bool, includeDeletedChannels bool, page int, perPage int) (*model.PostSearchResults, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "SearchPostsInTeamForUser"); err != nil {
+		var resultVar0 *model.PostSearchResults
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PostStore.SearchPostsInTeamForUser", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PostStore.SearchPostsInTeamForUser(paramsList, userId, teamId, isOrSearch, includeDeletedChannels, page, perPage)
+}
+
+func (s *ChaosLayerPostStore) Update(newPost *model.Post, oldPost *model.Post) (*model.Post, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "Update"); err != nil {
+		var resultVar0 *model.Post
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PostStore.Update", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PostStore.Update(newPost, oldPost)
+}
+
+func (s *ChaosLayerPostStore) UpdateWithOutboxEvent(newPost *model.Post, oldPost *model.Post, message *model.WebSocketEvent) (*model.Post, *model.OutboxEvent, *model.AppError) {
+	if err := s.Root.faults.inject("Post", "UpdateWithOutboxEvent"); err != nil {
+		var resultVar0 *model.Post
+		var resultVar1 *model.OutboxEvent
+		var resultVar2 *model.AppError
+		resultVar2 = model.NewAppError("ChaosLayer.PostStore.UpdateWithOutboxEvent", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1, resultVar2
+	}
+	return s.PostStore.UpdateWithOutboxEvent(newPost, oldPost, message)
+}
+
+func (s *ChaosLayerPostAcknowledgementStore) Delete(postId string, userId string) error {
+	if err := s.Root.faults.inject("PostAcknowledgement", "Delete"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.PostAcknowledgementStore.Delete(postId, userId)
+}
+
+func (s *ChaosLayerPostAcknowledgementStore) GetForPost(postId string) ([]*model.PostAcknowledgement, error) {
+	if err := s.Root.faults.inject("PostAcknowledgement", "GetForPost"); err != nil {
+		var resultVar0 []*model.PostAcknowledgement
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.PostAcknowledgementStore.GetForPost(postId)
+}
+
+func (s *ChaosLayerPostAcknowledgementStore) PermanentDeleteByChannel(channelId string) error {
+	if err := s.Root.faults.inject("PostAcknowledgement", "PermanentDeleteByChannel"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.PostAcknowledgementStore.PermanentDeleteByChannel(channelId)
+}
+
+func (s *ChaosLayerPostAcknowledgementStore) PermanentDeleteByUser(userId string) error {
+	if err := s.Root.faults.inject("PostAcknowledgement", "PermanentDeleteByUser"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.PostAcknowledgementStore.PermanentDeleteByUser(userId)
+}
+
+func (s *ChaosLayerPostAcknowledgementStore) Save(acknowledgement *model.PostAcknowledgement) (*model.PostAcknowledgement, error) {
+	if err := s.Root.faults.inject("PostAcknowledgement", "Save"); err != nil {
+		var resultVar0 *model.PostAcknowledgement
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.PostAcknowledgementStore.Save(acknowledgement)
+}
+
+func (s *ChaosLayerPostArchiveStore) ArchiveBatch(endTime int64, limit int64) (int64, error) {
+	if err := s.Root.faults.inject("PostArchive", "ArchiveBatch"); err != nil {
+		var resultVar0 int64
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.PostArchiveStore.ArchiveBatch(endTime, limit)
+}
+
+func (s *ChaosLayerPostArchiveStore) Get(id string) (*model.Post, error) {
+	if err := s.Root.faults.inject("PostArchive", "Get"); err != nil {
+		var resultVar0 *model.Post
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.PostArchiveStore.Get(id)
+}
+
+func (s *ChaosLayerPostArchiveStore) GetThread(id string) (*model.PostList, error) {
+	if err := s.Root.faults.inject("PostArchive", "GetThread"); err != nil {
+		var resultVar0 *model.PostList
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.PostArchiveStore.GetThread(id)
+}
+
+func (s *ChaosLayerPostArchiveStore) Search(channelIds []string, terms []string, limit int) ([]*model.Post, error) {
+	if err := s.Root.faults.inject("PostArchive", "Search"); err != nil {
+		var resultVar0 []*model.Post
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.PostArchiveStore.Search(channelIds, terms, limit)
+}
+
+func (s *ChaosLayerPostIntegrityStore) Append(post *model.Post) (*model.PostIntegrityEntry, error) {
+	if err := s.Root.faults.inject("PostIntegrity", "Append"); err != nil {
+		var resultVar0 *model.PostIntegrityEntry
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.PostIntegrityStore.Append(post)
+}
+
+func (s *ChaosLayerPostIntegrityStore) GetChannelIds(afterChannelId string, limit int) ([]string, error) {
+	if err := s.Root.faults.inject("PostIntegrity", "GetChannelIds"); err != nil {
+		var resultVar0 []string
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.PostIntegrityStore.GetChannelIds(afterChannelId, limit)
+}
+
+func (s *ChaosLayerPostIntegrityStore) GetForChannel(channelId string, afterSeq int64, limit int) ([]*model.PostIntegrityEntry, error) {
+	if err := s.Root.faults.inject("PostIntegrity", "GetForChannel"); err != nil {
+		var resultVar0 []*model.PostIntegrityEntry
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.PostIntegrityStore.GetForChannel(channelId, afterSeq, limit)
+}
+
+func (s *ChaosLayerPostsPartitionStore) CopyBatch(cursor model.PostsPartitionCursor, limit int64) (int64, model.PostsPartitionCursor, error) {
+	if err := s.Root.faults.inject("PostsPartition", "CopyBatch"); err != nil {
+		var resultVar0 int64
+		var resultVar1 model.PostsPartitionCursor
+		var resultVar2 error
+		resultVar2 = err
+		return resultVar0, resultVar1, resultVar2
+	}
+	return s.PostsPartitionStore.CopyBatch(cursor, limit)
+}
+
+func (s *ChaosLayerPostsPartitionStore) DropPartitionsBefore(endTime int64) ([]string, error) {
+	if err := s.Root.faults.inject("PostsPartition", "DropPartitionsBefore"); err != nil {
+		var resultVar0 []string
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.PostsPartitionStore.DropPartitionsBefore(endTime)
+}
+
+func (s *ChaosLayerPostsPartitionStore) EnsurePartitions(until int64) error {
+	if err := s.Root.faults.inject("PostsPartition", "EnsurePartitions"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.PostsPartitionStore.EnsurePartitions(until)
+}
+
+func (s *ChaosLayerPostsPartitionStore) FinishConversion() error {
+	if err := s.Root.faults.inject("PostsPartition", "FinishConversion"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.PostsPartitionStore.FinishConversion()
+}
+
+func (s *ChaosLayerPostsPartitionStore) GetPartitions() ([]*model.PostsPartition, error) {
+	if err := s.Root.faults.inject("PostsPartition", "GetPartitions"); err != nil {
+		var resultVar0 []*model.PostsPartition
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.PostsPartitionStore.GetPartitions()
+}
+
+func (s *ChaosLayerPostsPartitionStore) IsPartitioned() (bool, error) {
+	if err := s.Root.faults.inject("PostsPartition", "IsPartitioned"); err != nil {
+		var resultVar0 bool
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.PostsPartitionStore.IsPartitioned()
+}
+
+func (s *ChaosLayerPostsPartitionStore) StartConversion() error {
+	if err := s.Root.faults.inject("PostsPartition", "StartConversion"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.PostsPartitionStore.StartConversion()
+}
+
+func (s *ChaosLayerPreferenceStore) CleanupFlagsBatch(limit int64) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Preference", "CleanupFlagsBatch"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PreferenceStore.CleanupFlagsBatch", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PreferenceStore.CleanupFlagsBatch(limit)
+}
+
+func (s *ChaosLayerPreferenceStore) Delete(userId string, category string, name string) *model.AppError {
+	if err := s.Root.faults.inject("Preference", "Delete"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.PreferenceStore.Delete", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.PreferenceStore.Delete(userId, category, name)
+}
+
+func (s *ChaosLayerPreferenceStore) DeleteCategory(userId string, category string) *model.AppError {
+	if err := s.Root.faults.inject("Preference", "DeleteCategory"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.PreferenceStore.DeleteCategory", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.PreferenceStore.DeleteCategory(userId, category)
+}
+
+func (s *ChaosLayerPreferenceStore) DeleteCategoryAndName(category string, name string) *model.AppError {
+	if err := s.Root.faults.inject("Preference", "DeleteCategoryAndName"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.PreferenceStore.DeleteCategoryAndName", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.PreferenceStore.DeleteCategoryAndName(category, name)
+}
+
+func (s *ChaosLayerPreferenceStore) Get(userId string, category string, name string) (*model.Preference, *model.AppError) {
+	if err := s.Root.faults.inject("Preference", "Get"); err != nil {
+		var resultVar0 *model.Preference
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PreferenceStore.Get", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PreferenceStore.Get(userId, category, name)
+}
+
+func (s *ChaosLayerPreferenceStore) GetAll(userId string) (model.Preferences, *model.AppError) {
+	if err := s.Root.faults.inject("Preference", "GetAll"); err != nil {
+		var resultVar0 model.Preferences
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PreferenceStore.GetAll", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PreferenceStore.GetAll(userId)
+}
+
+func (s *ChaosLayerPreferenceStore) GetCategory(userId string, category string) (model.Preferences, *model.AppError) {
+	if err := s.Root.faults.inject("Preference", "GetCategory"); err != nil {
+		var resultVar0 model.Preferences
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PreferenceStore.GetCategory", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PreferenceStore.GetCategory(userId, category)
+}
+
+func (s *ChaosLayerPreferenceStore) PermanentDeleteByUser(userId string) *model.AppError {
+	if err := s.Root.faults.inject("Preference", "PermanentDeleteByUser"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.PreferenceStore.PermanentDeleteByUser", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.PreferenceStore.PermanentDeleteByUser(userId)
+}
+
+func (s *ChaosLayerPreferenceStore) RenameCategory(oldCategory string, newCategory string, transform PreferenceTransform, limit int64) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Preference", "RenameCategory"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.PreferenceStore.RenameCategory", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.PreferenceStore.RenameCategory(oldCategory, newCategory, transform, limit)
+}
+
+func (s *ChaosLayerPreferenceStore) Save(preferences *model.Preferences) *model.AppError {
+	if err := s.Root.faults.inject("Preference", "Save"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.PreferenceStore.Save", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.PreferenceStore.Save(preferences)
+}
+
+func (s *ChaosLayerPresenceWebhookStore) Delete(id string, deleteAt int64) error {
+	if err := s.Root.faults.inject("PresenceWebhook", "Delete"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.PresenceWebhookStore.Delete(id, deleteAt)
+}
+
+func (s *ChaosLayerPresenceWebhookStore) Get(id string) (*model.PresenceWebhook, error) {
+	if err := s.Root.faults.inject("PresenceWebhook", "Get"); err != nil {
+		var resultVar0 *model.PresenceWebhook
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.PresenceWebhookStore.Get(id)
+}
+
+func (s *ChaosLayerPresenceWebhookStore) GetAll(offset int, limit int) ([]*model.PresenceWebhook, error) {
+	if err := s.Root.faults.inject("PresenceWebhook", "GetAll"); err != nil {
+		var resultVar0 []*model.PresenceWebhook
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.PresenceWebhookStore.GetAll(offset, limit)
+}
+
+func (s *ChaosLayerPresenceWebhookStore) Save(hook *model.PresenceWebhook) (*model.PresenceWebhook, error) {
+	if err := s.Root.faults.inject("PresenceWebhook", "Save"); err != nil {
+		var resultVar0 *model.PresenceWebhook
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.PresenceWebhookStore.Save(hook)
+}
+
+func (s *ChaosLayerPresenceWebhookStore) Update(hook *model.PresenceWebhook) (*model.PresenceWebhook, error) {
+	if err := s.Root.faults.inject("PresenceWebhook", "Update"); err != nil {
+		var resultVar0 *model.PresenceWebhook
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.PresenceWebhookStore.Update(hook)
+}
+
+func (s *ChaosLayerReactionStore) BulkGetForPosts(postIds []string) ([]*model.Reaction, error) {
+	if err := s.Root.faults.inject("Reaction", "BulkGetForPosts"); err != nil {
+		var resultVar0 []*model.Reaction
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ReactionStore.BulkGetForPosts(postIds)
+}
+
+func (s *ChaosLayerReactionStore) Delete(reaction *model.Reaction) (*model.Reaction, error) {
+	if err := s.Root.faults.inject("Reaction", "Delete"); err != nil {
+		var resultVar0 *model.Reaction
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ReactionStore.Delete(reaction)
+}
+
+func (s *ChaosLayerReactionStore) DeleteAllWithEmojiName(emojiName string) error {
+	if err := s.Root.faults.inject("Reaction", "DeleteAllWithEmojiName"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.ReactionStore.DeleteAllWithEmojiName(emojiName)
+}
+
+func (s *ChaosLayerReactionStore) GetForPost(postId string, allowFromCache bool) ([]*model.Reaction, error) {
+	if err := s.Root.faults.inject("Reaction", "GetForPost"); err != nil {
+		var resultVar0 []*model.Reaction
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ReactionStore.GetForPost(postId, allowFromCache)
+}
+
+func (s *ChaosLayerReactionStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+	if err := s.Root.faults.inject("Reaction", "PermanentDeleteBatch"); err != nil {
+		var resultVar0 int64
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ReactionStore.PermanentDeleteBatch(endTime, limit)
+}
+
+func (s *ChaosLayerReactionStore) Save(reaction *model.Reaction) (*model.Reaction, error) {
+	if err := s.Root.faults.inject("Reaction", "Save"); err != nil {
+		var resultVar0 *model.Reaction
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.ReactionStore.Save(reaction)
+}
+
+func (s *ChaosLayerRetentionPolicyStore) Delete(id string) error {
+	if err := s.Root.faults.inject("RetentionPolicy", "Delete"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.RetentionPolicyStore.Delete(id)
+}
+
+func (s *ChaosLayerRetentionPolicyStore) Get(id string) (*model.RetentionPolicy, error) {
+	if err := s.Root.faults.inject("RetentionPolicy", "Get"); err != nil {
+		var resultVar0 *model.RetentionPolicy
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.RetentionPolicyStore.Get(id)
+}
+
+func (s *ChaosLayerRetentionPolicyStore) GetAll(offset int, limit int) ([]*model.RetentionPolicy, error) {
+	if err := s.Root.faults.inject("RetentionPolicy", "GetAll"); err != nil {
+		var resultVar0 []*model.RetentionPolicy
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.RetentionPolicyStore.GetAll(offset, limit)
+}
+
+func (s *ChaosLayerRetentionPolicyStore) GetForChannel(channelId string) (*model.RetentionPolicy, error) {
+	if err := s.Root.faults.inject("RetentionPolicy", "GetForChannel"); err != nil {
+		var resultVar0 *model.RetentionPolicy
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.RetentionPolicyStore.GetForChannel(channelId)
+}
+
+func (s *ChaosLayerRetentionPolicyStore) PermanentDeleteAllFilesBatch(endTime int64, cursor model.RetentionPolicyCursor, limit int64) (int64, model.RetentionPolicyCursor, error) {
+	if err := s.Root.faults.inject("RetentionPolicy", "PermanentDeleteAllFilesBatch"); err != nil {
+		var resultVar0 int64
+		var resultVar1 model.RetentionPolicyCursor
+		var resultVar2 error
+		resultVar2 = err
+		return resultVar0, resultVar1, resultVar2
+	}
+	return s.RetentionPolicyStore.PermanentDeleteAllFilesBatch(endTime, cursor, limit)
+}
+
+func (s *ChaosLayerRetentionPolicyStore) PermanentDeleteFilesBatch(policyId string, endTime int64, cursor model.RetentionPolicyCursor, limit int64) (int64, model.RetentionPolicyCursor, error) {
+	if err := s.Root.faults.inject("RetentionPolicy", "PermanentDeleteFilesBatch"); err != nil {
+		var resultVar0 int64
+		var resultVar1 model.RetentionPolicyCursor
+		var resultVar2 error
+		resultVar2 = err
+		return resultVar0, resultVar1, resultVar2
+	}
+	return s.RetentionPolicyStore.PermanentDeleteFilesBatch(policyId, endTime, cursor, limit)
+}
+
+func (s *ChaosLayerRetentionPolicyStore) PermanentDeletePostsBatch(policyId string, endTime int64, cursor model.RetentionPolicyCursor, limit int64) (int64, model.RetentionPolicyCursor, error) {
+	if err := s.Root.faults.inject("RetentionPolicy", "PermanentDeletePostsBatch"); err != nil {
+		var resultVar0 int64
+		var resultVar1 model.RetentionPolicyCursor
+		var resultVar2 error
+		resultVar2 = err
+		return resultVar0, resultVar1, resultVar2
+	}
+	return s.RetentionPolicyStore.PermanentDeletePostsBatch(policyId, endTime, cursor, limit)
+}
+
+func (s *ChaosLayerRetentionPolicyStore) Save(policy *model.RetentionPolicy) (*model.RetentionPolicy, error) {
+	if err := s.Root.faults.inject("RetentionPolicy", "Save"); err != nil {
+		var resultVar0 *model.RetentionPolicy
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.RetentionPolicyStore.Save(policy)
+}
+
+func (s *ChaosLayerRetentionPolicyStore) Update(policy *model.RetentionPolicy) (*model.RetentionPolicy, error) {
+	if err := s.Root.faults.inject("RetentionPolicy", "Update"); err != nil {
+		var resultVar0 *model.RetentionPolicy
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.RetentionPolicyStore.Update(policy)
+}
+
+func (s *ChaosLayerRoleStore) AllChannelSchemeRoles() ([]*model.Role, *model.AppError) {
+	if err := s.Root.faults.inject("Role", "AllChannelSchemeRoles"); err != nil {
+		var resultVar0 []*model.Role
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.RoleStore.AllChannelSchemeRoles", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.RoleStore.AllChannelSchemeRoles()
+}
+
+func (s *ChaosLayerRoleStore) ChannelHigherScopedPermissions(roleNames []string) (map[string]*model.RolePermissions, *model.AppError) {
+	if err := s.Root.faults.inject("Role", "ChannelHigherScopedPermissions"); err != nil {
+		var resultVar0 map[string]*model.RolePermissions
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.RoleStore.ChannelHigherScopedPermissions", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.RoleStore.ChannelHigherScopedPermissions(roleNames)
+}
+
+func (s *ChaosLayerRoleStore) ChannelRolesUnderTeamRole(roleName string) ([]*model.Role, *model.AppError) {
+	if err := s.Root.faults.inject("Role", "ChannelRolesUnderTeamRole"); err != nil {
+		var resultVar0 []*model.Role
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.RoleStore.ChannelRolesUnderTeamRole", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.RoleStore.ChannelRolesUnderTeamRole(roleName)
+}
+
+func (s *ChaosLayerRoleStore) Delete(roleId string) (*model.Role, *model.AppError) {
+	if err := s.Root.faults.inject("Role", "Delete"); err != nil {
+		var resultVar0 *model.Role
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.RoleStore.Delete", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.RoleStore.Delete(roleId)
+}
+
+func (s *ChaosLayerRoleStore) Get(roleId string) (*model.Role, *model.AppError) {
+	if err := s.Root.faults.inject("Role", "Get"); err != nil {
+		var resultVar0 *model.Role
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.RoleStore.Get", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.RoleStore.Get(roleId)
+}
+
+func (s *ChaosLayerRoleStore) GetAll() ([]*model.Role, *model.AppError) {
+	if err := s.Root.faults.inject("Role", "GetAll"); err != nil {
+		var resultVar0 []*model.Role
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.RoleStore.GetAll", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.RoleStore.GetAll()
+}
+
+func (s *ChaosLayerRoleStore) GetByName(name string) (*model.Role, *model.AppError) {
+	if err := s.Root.faults.inject("Role", "GetByName"); err != nil {
+		var resultVar0 *model.Role
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.RoleStore.GetByName", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.RoleStore.GetByName(name)
+}
+
+func (s *ChaosLayerRoleStore) GetByNames(names []string) ([]*model.Role, *model.AppError) {
+	if err := s.Root.faults.inject("Role", "GetByNames"); err != nil {
+		var resultVar0 []*model.Role
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.RoleStore.GetByNames", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.RoleStore.GetByNames(names)
+}
+
+func (s *ChaosLayerRoleStore) PermanentDeleteAll() *model.AppError {
+	if err := s.Root.faults.inject("Role", "PermanentDeleteAll"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.RoleStore.PermanentDeleteAll", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.RoleStore.PermanentDeleteAll()
+}
+
+func (s *ChaosLayerRoleStore) Save(role *model.Role) (*model.Role, *model.AppError) {
+	if err := s.Root.faults.inject("Role", "Save"); err != nil {
+		var resultVar0 *model.Role
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.RoleStore.Save", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.RoleStore.Save(role)
+}
+
+func (s *ChaosLayerSchemeStore) CountByScope(scope string) (int64, error) {
+	if err := s.Root.faults.inject("Scheme", "CountByScope"); err != nil {
+		var resultVar0 int64
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.SchemeStore.CountByScope(scope)
+}
+
+func (s *ChaosLayerSchemeStore) CountWithoutPermission(scope string, permissionID string, roleScope model.RoleScope, roleType model.RoleType) (int64, error) {
+	if err := s.Root.faults.inject("Scheme", "CountWithoutPermission"); err != nil {
+		var resultVar0 int64
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.SchemeStore.CountWithoutPermission(scope, permissionID, roleScope, roleType)
+}
+
+func (s *ChaosLayerSchemeStore) Delete(schemeId string) (*model.Scheme, error) {
+	if err := s.Root.faults.inject("Scheme", "Delete"); err != nil {
+		var resultVar0 *model.Scheme
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.SchemeStore.Delete(schemeId)
+}
+
+func (s *ChaosLayerSchemeStore) Get(schemeId string) (*model.Scheme, error) {
+	if err := s.Root.faults.inject("Scheme", "Get"); err != nil {
+		var resultVar0 *model.Scheme
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.SchemeStore.Get(schemeId)
+}
+
+func (s *ChaosLayerSchemeStore) GetAllPage(scope string, offset int, limit int) ([]*model.Scheme, error) {
+	if err := s.Root.faults.inject("Scheme", "GetAllPage"); err != nil {
+		var resultVar0 []*model.Scheme
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.SchemeStore.GetAllPage(scope, offset, limit)
+}
+
+func (s *ChaosLayerSchemeStore) GetByName(schemeName string) (*model.Scheme, error) {
+	if err := s.Root.faults.inject("Scheme", "GetByName"); err != nil {
+		var resultVar0 *model.Scheme
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.SchemeStore.GetByName(schemeName)
+}
+
+func (s *ChaosLayerSchemeStore) PermanentDeleteAll() error {
+	if err := s.Root.faults.inject("Scheme", "PermanentDeleteAll"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.SchemeStore.PermanentDeleteAll()
+}
+
+func (s *ChaosLayerSchemeStore) Save(scheme *model.Scheme) (*model.Scheme, error) {
+	if err := s.Root.faults.inject("Scheme", "Save"); err != nil {
+		var resultVar0 *model.Scheme
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.SchemeStore.Save(scheme)
+}
+
+func (s *ChaosLayerSessionStore) AnalyticsSessionCount(estimate bool) (int64, error) {
+	if err := s.Root.faults.inject("Session", "AnalyticsSessionCount"); err != nil {
+		var resultVar0 int64
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.SessionStore.AnalyticsSessionCount(estimate)
+}
+
+func (s *ChaosLayerSessionStore) Cleanup(expiryTime int64, batchSize int64) {
+	s.Root.faults.delay("Session", "Cleanup")
+	s.SessionStore.Cleanup(expiryTime, batchSize)
+}
+
+func (s *ChaosLayerSessionStore) Get(sessionIdOrToken string) (*model.Session, error) {
+	if err := s.Root.faults.inject("Session", "Get"); err != nil {
+		var resultVar0 *model.Session
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.SessionStore.Get(sessionIdOrToken)
+}
+
+func (s *ChaosLayerSessionStore) GetSessions(userId string) ([]*model.Session, error) {
+	if err := s.Root.faults.inject("Session", "GetSessions"); err != nil {
+		var resultVar0 []*model.Session
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.SessionStore.GetSessions(userId)
+}
+
+func (s *ChaosLayerSessionStore) GetSessionsExpired(thresholdMillis int64, mobileOnly bool, unnotifiedOnly bool) ([]*model.Session, error) {
+	if err := s.Root.faults.inject("Session", "GetSessionsExpired"); err != nil {
+		var resultVar0 []*model.Session
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.SessionStore.GetSessionsExpired(thresholdMillis, mobileOnly, unnotifiedOnly)
+}
+
+func (s *ChaosLayerSessionStore) GetSessionsWithActiveDeviceIds(userId string) ([]*model.Session, error) {
+	if err := s.Root.faults.inject("Session", "GetSessionsWithActiveDeviceIds"); err != nil {
+		var resultVar0 []*model.Session
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.SessionStore.GetSessionsWithActiveDeviceIds(userId)
+}
+
+func (s *ChaosLayerSessionStore) PermanentDeleteSessionsByUser(teamId string) error {
+	if err := s.Root.faults.inject("Session", "PermanentDeleteSessionsByUser"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.SessionStore.PermanentDeleteSessionsByUser(teamId)
+}
+
+func (s *ChaosLayerSessionStore) Remove(sessionIdOrToken string) error {
+	if err := s.Root.faults.inject("Session", "Remove"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.SessionStore.Remove(sessionIdOrToken)
+}
+
+func (s *ChaosLayerSessionStore) RemoveAllSessions() error {
+	if err := s.Root.faults.inject("Session", "RemoveAllSessions"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.SessionStore.RemoveAllSessions()
+}
+
+func (s *ChaosLayerSessionStore) Save(session *model.Session) (*model.Session, error) {
+	if err := s.Root.faults.inject("Session", "Save"); err != nil {
+		var resultVar0 *model.Session
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.SessionStore.Save(session)
+}
+
+func (s *ChaosLayerSessionStore) UpdateDeviceId(id string, deviceId string, expiresAt int64) (string, error) {
+	if err := s.Root.faults.inject("Session", "UpdateDeviceId"); err != nil {
+		var resultVar0 string
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.SessionStore.UpdateDeviceId(id, deviceId, expiresAt)
+}
+
+func (s *ChaosLayerSessionStore) UpdateExpiredNotify(sessionid string, notified bool) error {
+	if err := s.Root.faults.inject("Session", "UpdateExpiredNotify"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.SessionStore.UpdateExpiredNotify(sessionid, notified)
+}
+
+func (s *ChaosLayerSessionStore) UpdateExpiresAt(sessionId string, time int64) error {
+	if err := s.Root.faults.inject("Session", "UpdateExpiresAt"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.SessionStore.UpdateExpiresAt(sessionId, time)
+}
+
+func (s *ChaosLayerSessionStore) UpdateLastActivityAt(sessionId string, time int64) error {
+	if err := s.Root.faults.inject("Session", "UpdateLastActivityAt"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.SessionStore.UpdateLastActivityAt(sessionId, time)
+}
+
+func (s *ChaosLayerSessionStore) UpdateProps(session *model.Session) error {
+	if err := s.Root.faults.inject("Session", "UpdateProps"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.SessionStore.UpdateProps(session)
+}
+
+func (s *ChaosLayerSessionStore) UpdateRoles(userId string, roles string) (string, error) {
+	if err := s.Root.faults.inject("Session", "UpdateRoles"); err != nil {
+		var resultVar0 string
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.SessionStore.UpdateRoles(userId, roles)
+}
+
+func (s *ChaosLayerSlugHistoryStore) Delete(kind string, scopeId string, name string) error {
+	if err := s.Root.faults.inject("SlugHistory", "Delete"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.SlugHistoryStore.Delete(kind, scopeId, name)
+}
+
+func (s *ChaosLayerSlugHistoryStore) Get(kind string, scopeId string, name string) (*model.SlugHistory, error) {
+	if err := s.Root.faults.inject("SlugHistory", "Get"); err != nil {
+		var resultVar0 *model.SlugHistory
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.SlugHistoryStore.Get(kind, scopeId, name)
+}
+
+func (s *ChaosLayerSlugHistoryStore) PermanentDeleteByTarget(targetId string) error {
+	if err := s.Root.faults.inject("SlugHistory", "PermanentDeleteByTarget"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.SlugHistoryStore.PermanentDeleteByTarget(targetId)
+}
+
+func (s *ChaosLayerSlugHistoryStore) Save(entry *model.SlugHistory) (*model.SlugHistory, error) {
+	if err := s.Root.faults.inject("SlugHistory", "Save"); err != nil {
+		var resultVar0 *model.SlugHistory
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.SlugHistoryStore.Save(entry)
+}
+
+func (s *ChaosLayerStatusStore) Get(userId string) (*model.Status, *model.AppError) {
+	if err := s.Root.faults.inject("Status", "Get"); err != nil {
+		var resultVar0 *model.Status
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.StatusStore.Get", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.StatusStore.Get(userId)
+}
+
+func (s *ChaosLayerStatusStore) GetByIds(userIds []string) ([]*model.Status, *model.AppError) {
+	if err := s.Root.faults.inject("Status", "GetByIds"); err != nil {
+		var resultVar0 []*model.Status
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.StatusStore.GetByIds", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.StatusStore.GetByIds(userIds)
+}
+
+func (s *ChaosLayerStatusStore) GetTotalActiveUsersCount() (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Status", "GetTotalActiveUsersCount"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.StatusStore.GetTotalActiveUsersCount", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.StatusStore.GetTotalActiveUsersCount()
+}
+
+func (s *ChaosLayerStatusStore) ResetAll() *model.AppError {
+	if err := s.Root.faults.inject("Status", "ResetAll"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.StatusStore.ResetAll", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.StatusStore.ResetAll()
+}
+
+func (s *ChaosLayerStatusStore) SaveOrUpdate(status *model.Status) *model.AppError {
+	if err := s.Root.faults.inject("Status", "SaveOrUpdate"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.StatusStore.SaveOrUpdate", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.StatusStore.SaveOrUpdate(status)
+}
+
+func (s *ChaosLayerStatusStore) UpdateLastActivityAt(userId string, lastActivityAt int64) *model.AppError {
+	if err := s.Root.faults.inject("Status", "UpdateLastActivityAt"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.StatusStore.UpdateLastActivityAt", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.StatusStore.UpdateLastActivityAt(userId, lastActivityAt)
+}
+
+func (s *ChaosLayerStatusStore) UpdateLastActivityAts(lastActivityAts map[string]int64) *model.AppError {
+	if err := s.Root.faults.inject("Status", "UpdateLastActivityAts"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.StatusStore.UpdateLastActivityAts", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.StatusStore.UpdateLastActivityAts(lastActivityAts)
+}
+
+func (s *ChaosLayerStatusAutomationStore) Delete(userId string) error {
+	if err := s.Root.faults.inject("StatusAutomation", "Delete"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.StatusAutomationStore.Delete(userId)
+}
+
+func (s *ChaosLayerStatusAutomationStore) Get(userId string) (*model.StatusAutomation, error) {
+	if err := s.Root.faults.inject("StatusAutomation", "Get"); err != nil {
+		var resultVar0 *model.StatusAutomation
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.StatusAutomationStore.Get(userId)
+}
+
+func (s *ChaosLayerStatusAutomationStore) GetEndedBefore(endTime int64, limit int) ([]*model.StatusAutomation, error) {
+	if err := s.Root.faults.inject("StatusAutomation", "GetEndedBefore"); err != nil {
+		var resultVar0 []*model.StatusAutomation
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.StatusAutomationStore.GetEndedBefore(endTime, limit)
+}
+
+func (s *ChaosLayerStatusAutomationStore) Save(automation *model.StatusAutomation) (*model.StatusAutomation, error) {
+	if err := s.Root.faults.inject("StatusAutomation", "Save"); err != nil {
+		var resultVar0 *model.StatusAutomation
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.StatusAutomationStore.Save(automation)
+}
+
+func (s *ChaosLayerStatusAutomationStore) Update(automation *model.StatusAutomation) (*model.StatusAutomation, error) {
+	if err := s.Root.faults.inject("StatusAutomation", "Update"); err != nil {
+		var resultVar0 *model.StatusAutomation
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.StatusAutomationStore.Update(automation)
+}
+
+func (s *ChaosLayerSystemStore) Get() (model.StringMap, *model.AppError) {
+	if err := s.Root.faults.inject("System", "Get"); err != nil {
+		var resultVar0 model.StringMap
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.SystemStore.Get", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.SystemStore.Get()
+}
+
+func (s *ChaosLayerSystemStore) GetByName(name string) (*model.System, *model.AppError) {
+	if err := s.Root.faults.inject("System", "GetByName"); err != nil {
+		var resultVar0 *model.System
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.SystemStore.GetByName", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.SystemStore.GetByName(name)
+}
+
+func (s *ChaosLayerSystemStore) InsertIfExists(system *model.System) (*model.System, *model.AppError) {
+	if err := s.Root.faults.inject("System", "InsertIfExists"); err != nil {
+		var resultVar0 *model.System
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.SystemStore.InsertIfExists", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.SystemStore.InsertIfExists(system)
+}
+
+func (s *ChaosLayerSystemStore) IsDatabaseReadOnly() (bool, *model.AppError) {
+	if err := s.Root.faults.inject("System", "IsDatabaseReadOnly"); err != nil {
+		var resultVar0 bool
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.SystemStore.IsDatabaseReadOnly", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.SystemStore.IsDatabaseReadOnly()
+}
+
+func (s *ChaosLayerSystemStore) PermanentDeleteByName(name string) (*model.System, *model.AppError) {
+	if err := s.Root.faults.inject("System", "PermanentDeleteByName"); err != nil {
+		var resultVar0 *model.System
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.SystemStore.PermanentDeleteByName", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.SystemStore.PermanentDeleteByName(name)
+}
+
+func (s *ChaosLayerSystemStore) Save(system *model.System) *model.AppError {
+	if err := s.Root.faults.inject("System", "Save"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.SystemStore.Save", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.SystemStore.Save(system)
+}
+
+func (s *ChaosLayerSystemStore) SaveOrUpdate(system *model.System) *model.AppError {
+	if err := s.Root.faults.inject("System", "SaveOrUpdate"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.SystemStore.SaveOrUpdate", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.SystemStore.SaveOrUpdate(system)
+}
+
+func (s *ChaosLayerSystemStore) Update(system *model.System) *model.AppError {
+	if err := s.Root.faults.inject("System", "Update"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.SystemStore.Update", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.SystemStore.Update(system)
+}
+
+func (s *ChaosLayerTeamStore) AnalyticsGetTeamCountForScheme(ctx context.Context, schemeId string) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "AnalyticsGetTeamCountForScheme"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.AnalyticsGetTeamCountForScheme", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.AnalyticsGetTeamCountForScheme(ctx, schemeId)
+}
+
+func (s *ChaosLayerTeamStore) AnalyticsPrivateTeamCount(ctx context.Context) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "AnalyticsPrivateTeamCount"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.AnalyticsPrivateTeamCount", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.AnalyticsPrivateTeamCount(ctx)
+}
+
+func (s *ChaosLayerTeamStore) AnalyticsPublicTeamCount(ctx context.Context) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "AnalyticsPublicTeamCount"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.AnalyticsPublicTeamCount", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.AnalyticsPublicTeamCount(ctx)
+}
+
+func (s *ChaosLayerTeamStore) AnalyticsTeamCount(ctx context.Context, includeDeleted bool) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "AnalyticsTeamCount"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.AnalyticsTeamCount", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.AnalyticsTeamCount(ctx, includeDeleted)
+}
+
+func (s *ChaosLayerTeamStore) ClearAllCustomRoleAssignments(ctx context.Context) *model.AppError {
+	if err := s.Root.faults.inject("Team", "ClearAllCustomRoleAssignments"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.TeamStore.ClearAllCustomRoleAssignments", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.TeamStore.ClearAllCustomRoleAssignments(ctx)
+}
+
+func (s *ChaosLayerTeamStore) ClearCaches() {
+	s.Root.faults.delay("Team", "ClearCaches")
+	s.TeamStore.ClearCaches()
+}
+
+func (s *ChaosLayerTeamStore) Get(ctx context.Context, id string) (*model.Team, error) {
+	if err := s.Root.faults.inject("Team", "Get"); err != nil {
+		var resultVar0 *model.Team
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.Get(ctx, id)
+}
+
+func (s *ChaosLayerTeamStore) GetActiveMemberCount(ctx context.Context, teamId string, restrictions *model.ViewUsersRestrictions) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "GetActiveMemberCount"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.GetActiveMemberCount", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetActiveMemberCount(ctx, teamId, restrictions)
+}
+
+func (s *ChaosLayerTeamStore) GetActiveMemberCounts(ctx context.Context, teamIds []string) (map[string]int64, error) {
+	if err := s.Root.faults.inject("Team", "GetActiveMemberCounts"); err != nil {
+		var resultVar0 map[string]int64
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetActiveMemberCounts(ctx, teamIds)
+}
+
+func (s *ChaosLayerTeamStore) GetAll(ctx context.Context) ([]*model.Team, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "GetAll"); err != nil {
+		var resultVar0 []*model.Team
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.GetAll", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetAll(ctx)
+}
+
+func (s *ChaosLayerTeamStore) GetAllDeletedPage(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "GetAllDeletedPage"); err != nil {
+		var resultVar0 []*model.Team
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.GetAllDeletedPage", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetAllDeletedPage(ctx, offset, limit)
+}
+
+func (s *ChaosLayerTeamStore) GetAllForExportAfter(ctx context.Context, limit int, afterId string) ([]*model.TeamForExport, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "GetAllForExportAfter"); err != nil {
+		var resultVar0 []*model.TeamForExport
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.GetAllForExportAfter", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetAllForExportAfter(ctx, limit, afterId)
+}
+
+func (s *ChaosLayerTeamStore) GetAllPage(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "GetAllPage"); err != nil {
+		var resultVar0 []*model.Team
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.GetAllPage", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetAllPage(ctx, offset, limit)
+}
+
+func (s *ChaosLayerTeamStore) GetAllPrivateTeamListing(ctx context.Context) ([]*model.Team, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "GetAllPrivateTeamListing"); err != nil {
+		var resultVar0 []*model.Team
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.GetAllPrivateTeamListing", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetAllPrivateTeamListing(ctx)
+}
+
+func (s *ChaosLayerTeamStore) GetAllPrivateTeamPageListing(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "GetAllPrivateTeamPageListing"); err != nil {
+		var resultVar0 []*model.Team
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.GetAllPrivateTeamPageListing", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetAllPrivateTeamPageListing(ctx, offset, limit)
+}
+
+func (s *ChaosLayerTeamStore) GetAllPublicTeamPageListing(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "GetAllPublicTeamPageListing"); err != nil {
+		var resultVar0 []*model.Team
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.GetAllPublicTeamPageListing", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetAllPublicTeamPageListing(ctx, offset, limit)
+}
+
+func (s *ChaosLayerTeamStore) GetAllTeamListing(ctx context.Context) ([]*model.Team, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "GetAllTeamListing"); err != nil {
+		var resultVar0 []*model.Team
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.GetAllTeamListing", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetAllTeamListing(ctx)
+}
+
+func (s *ChaosLayerTeamStore) GetAllTeamPageListing(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "GetAllTeamPageListing"); err != nil {
+		var resultVar0 []*model.Team
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.GetAllTeamPageListing", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetAllTeamPageListing(ctx, offset, limit)
+}
+
+func (s *ChaosLayerTeamStore) GetByAllowedDomain(ctx context.Context, domain string) ([]*model.Team, error) {
+	if err := s.Root.faults.inject("Team", "GetByAllowedDomain"); err != nil {
+		var resultVar0 []*model.Team
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetByAllowedDomain(ctx, domain)
+}
+
+func (s *ChaosLayerTeamStore) GetByInviteId(ctx context.Context, inviteId string) (*model.Team, error) {
+	if err := s.Root.faults.inject("Team", "GetByInviteId"); err != nil {
+		var resultVar0 *model.Team
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetByInviteId(ctx, inviteId)
+}
+
+func (s *ChaosLayerTeamStore) GetByName(ctx context.Context, name string) (*model.Team, error) {
+	if err := s.Root.faults.inject("Team", "GetByName"); err != nil {
+		var resultVar0 *model.Team
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetByName(ctx, name)
+}
+
+func (s *ChaosLayerTeamStore) GetByNames(ctx context.Context, name []string) ([]*model.Team, error) {
+	if err := s.Root.faults.inject("Team", "GetByNames"); err != nil {
+		var resultVar0 []*model.Team
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetByNames(ctx, name)
+}
+
+func (s *ChaosLayerTeamStore) GetChannelUnreadsForAllTeams(ctx context.Context, excludeTeamId string, userId string) ([]*model.ChannelUnread, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "GetChannelUnreadsForAllTeams"); err != nil {
+		var resultVar0 []*model.ChannelUnread
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.GetChannelUnreadsForAllTeams", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetChannelUnreadsForAllTeams(ctx, excludeTeamId, userId)
+}
+
+func (s *ChaosLayerTeamStore) GetChannelUnreadsForTeam(ctx context.Context, teamId string, userId string) ([]*model.ChannelUnread, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "GetChannelUnreadsForTeam"); err != nil {
+		var resultVar0 []*model.ChannelUnread
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.GetChannelUnreadsForTeam", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetChannelUnreadsForTeam(ctx, teamId, userId)
+}
+
+func (s *ChaosLayerTeamStore) GetDirectoryStats(ctx context.Context, joinedSince int64) ([]*model.TeamDirectoryStats, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "GetDirectoryStats"); err != nil {
+		var resultVar0 []*model.TeamDirectoryStats
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.GetDirectoryStats", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetDirectoryStats(ctx, joinedSince)
+}
+
+func (s *ChaosLayerTeamStore) GetGroupSyncedTeamsPage(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "GetGroupSyncedTeamsPage"); err != nil {
+		var resultVar0 []*model.Team
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.GetGroupSyncedTeamsPage", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetGroupSyncedTeamsPage(ctx, offset, limit)
+}
+
+func (s *ChaosLayerTeamStore) GetMember(ctx context.Context, teamId string, userId string, allowFromCache bool) (*model.TeamMember, error) {
+	if err := s.Root.faults.inject("Team", "GetMember"); err != nil {
+		var resultVar0 *model.TeamMember
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetMember(ctx, teamId, userId, allowFromCache)
+}
+
+func (s *ChaosLayerTeamStore) GetMembers(ctx context.Context, teamId string, offset int, limit int, teamMembersGetOptions *model.TeamMembersGetOptions) ([]*model.TeamMember, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "GetMembers"); err != nil {
+		var resultVar0 []*model.TeamMember
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.GetMembers", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetMembers(ctx, teamId, offset, limit, teamMembersGetOptions)
+}
+
+func (s *ChaosLayerTeamStore) GetMembersByIds(ctx context.Context, teamId string, userIds []string, restrictions *model.ViewUsersRestrictions) ([]*model.TeamMember, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "GetMembersByIds"); err != nil {
+		var resultVar0 []*model.TeamMember
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.GetMembersByIds", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetMembersByIds(ctx, teamId, userIds, restrictions)
+}
+
+func (s *ChaosLayerTeamStore) GetMembersByTeamIds(ctx context.Context, teamIds []string, userId string) ([]*model.TeamMember, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "GetMembersByTeamIds"); err != nil {
+		var resultVar0 []*model.TeamMember
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.GetMembersByTeamIds", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetMembersByTeamIds(ctx, teamIds, userId)
+}
+
+func (s *ChaosLayerTeamStore) GetMembersWithExpiredRoles(ctx context.Context, expiredBefore int64, limit int) ([]*model.TeamMember, error) {
+	if err := s.Root.faults.inject("Team", "GetMembersWithExpiredRoles"); err != nil {
+		var resultVar0 []*model.TeamMember
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetMembersWithExpiredRoles(ctx, expiredBefore, limit)
+}
+
+func (s *ChaosLayerTeamStore) GetNewTeamMembersSince(ctx context.Context, teamId string, since int64, offset int, limit int) (*model.NewTeamMembersList, error) {
+	if err := s.Root.faults.inject("Team", "GetNewTeamMembersSince"); err != nil {
+		var resultVar0 *model.NewTeamMembersList
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetNewTeamMembersSince(ctx, teamId, since, offset, limit)
+}
+
+func (s *ChaosLayerTeamStore) GetPolicyForTeam(ctx context.Context, teamId string) (*model.RetentionPolicy, error) {
+	if err := s.Root.faults.inject("Team", "GetPolicyForTeam"); err != nil {
+		var resultVar0 *model.RetentionPolicy
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetPolicyForTeam(ctx, teamId)
+}
+
+func (s *ChaosLayerTeamStore) GetTeamMembersForExport(ctx context.Context, userId string) ([]*model.TeamMemberForExport, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "GetTeamMembersForExport"); err != nil {
+		var resultVar0 []*model.TeamMemberForExport
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.GetTeamMembersForExport", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetTeamMembersForExport(ctx, userId)
+}
+
+func (s *ChaosLayerTeamStore) GetTeamsByScheme(ctx context.Context, schemeId string, offset int, limit int) ([]*model.Team, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "GetTeamsByScheme"); err != nil {
+		var resultVar0 []*model.Team
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.GetTeamsByScheme", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetTeamsByScheme(ctx, schemeId, offset, limit)
+}
+
+func (s *ChaosLayerTeamStore) GetTeamsByUserId(ctx context.Context, userId string, opts *model.TeamsForUserGetOptions) ([]*model.Team, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "GetTeamsByUserId"); err != nil {
+		var resultVar0 []*model.Team
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.GetTeamsByUserId", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetTeamsByUserId(ctx, userId, opts)
+}
+
+func (s *ChaosLayerTeamStore) GetTeamsForPolicyPage(ctx context.Context, policyId string, offset int, limit int) ([]*model.Team, error) {
+	if err := s.Root.faults.inject("Team", "GetTeamsForPolicyPage"); err != nil {
+		var resultVar0 []*model.Team
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetTeamsForPolicyPage(ctx, policyId, offset, limit)
+}
+
+func (s *ChaosLayerTeamStore) GetTeamsForUser(ctx context.Context, userId string) ([]*model.TeamMember, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "GetTeamsForUser"); err != nil {
+		var resultVar0 []*model.TeamMember
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.GetTeamsForUser", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetTeamsForUser(ctx, userId)
+}
+
+func (s *ChaosLayerTeamStore) GetTeamsForUserWithPagination(ctx context.Context, userId string, page int, perPage int, opts *model.TeamMembersForUserGetOptions) ([]*model.TeamMember, error) {
+	if err := s.Root.faults.inject("Team", "GetTeamsForUserWithPagination"); err != nil {
+		var resultVar0 []*model.TeamMember
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetTeamsForUserWithPagination(ctx, userId, page, perPage, opts)
+}
+
+func (s *ChaosLayerTeamStore) GetTeamsWithNoActiveMembers(ctx context.Context, offset int, limit int) ([]*model.Team, error) {
+	if err := s.Root.faults.inject("Team", "GetTeamsWithNoActiveMembers"); err != nil {
+		var resultVar0 []*model.Team
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetTeamsWithNoActiveMembers(ctx, offset, limit)
+}
+
+func (s *ChaosLayerTeamStore) GetTotalMemberCount(ctx context.Context, teamId string, restrictions *model.ViewUsersRestrictions) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "GetTotalMemberCount"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.GetTotalMemberCount", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetTotalMemberCount(ctx, teamId, restrictions)
+}
+
+func (s *ChaosLayerTeamStore) GetUnreadsForAllTeams(ctx context.Context, excludeTeamId string, userId string) ([]*model.TeamUnread, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "GetUnreadsForAllTeams"); err != nil {
+		var resultVar0 []*model.TeamUnread
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.GetUnreadsForAllTeams", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetUnreadsForAllTeams(ctx, excludeTeamId, userId)
+}
+
+func (s *ChaosLayerTeamStore) GetUserTeamIds(ctx context.Context, userId string, allowFromCache bool) ([]string, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "GetUserTeamIds"); err != nil {
+		var resultVar0 []string
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.GetUserTeamIds", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GetUserTeamIds(ctx, userId, allowFromCache)
+}
+
+func (s *ChaosLayerTeamStore) GroupSyncedTeamCount(ctx context.Context) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "GroupSyncedTeamCount"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.GroupSyncedTeamCount", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GroupSyncedTeamCount(ctx)
+}
+
+func (s *ChaosLayerTeamStore) GroupSyncedTeamCountByGroup(ctx context.Context, groupId string) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "GroupSyncedTeamCountByGroup"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.GroupSyncedTeamCountByGroup", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.GroupSyncedTeamCountByGroup(ctx, groupId)
+}
+
+func (s *ChaosLayerTeamStore) InvalidateAllTeamIdsForUser(userId string) {
+	s.Root.faults.delay("Team", "InvalidateAllTeamIdsForUser")
+	s.TeamStore.InvalidateAllTeamIdsForUser(userId)
+}
+
+func (s *ChaosLayerTeamStore) MigrateTeamMembers(ctx context.Context, fromTeamId string, fromUserId string, batchSize int) (map[string]string, int64, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "MigrateTeamMembers"); err != nil {
+		var resultVar0 map[string]string
+		var resultVar1 int64
+		var resultVar2 *model.AppError
+		resultVar2 = model.NewAppError("ChaosLayer.TeamStore.MigrateTeamMembers", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1, resultVar2
+	}
+	return s.TeamStore.MigrateTeamMembers(ctx, fromTeamId, fromUserId, batchSize)
+}
+
+func (s *ChaosLayerTeamStore) PermanentDelete(ctx context.Context, teamId string) *model.AppError {
+	if err := s.Root.faults.inject("Team", "PermanentDelete"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.TeamStore.PermanentDelete", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.TeamStore.PermanentDelete(ctx, teamId)
+}
+
+func (s *ChaosLayerTeamStore) RegenerateInviteId(ctx context.Context, teamId string, inviteExpiresAt int64) (*model.Team, error) {
+	if err := s.Root.faults.inject("Team", "RegenerateInviteId"); err != nil {
+		var resultVar0 *model.Team
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.RegenerateInviteId(ctx, teamId, inviteExpiresAt)
+}
+
+func (s *ChaosLayerTeamStore) RemoveAllMembersByTeam(ctx context.Context, teamId string) *model.AppError {
+	if err := s.Root.faults.inject("Team", "RemoveAllMembersByTeam"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.TeamStore.RemoveAllMembersByTeam", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.TeamStore.RemoveAllMembersByTeam(ctx, teamId)
+}
+
+func (s *ChaosLayerTeamStore) RemoveAllMembersByUser(ctx context.Context, userId string) ([]string, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "RemoveAllMembersByUser"); err != nil {
+		var resultVar0 []string
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.RemoveAllMembersByUser", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.RemoveAllMembersByUser(ctx, userId)
+}
+
+func (s *ChaosLayerTeamStore) RemoveMember(ctx context.Context, teamId string, userId string) *model.AppError {
+	if err := s.Root.faults.inject("Team", "RemoveMember"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.TeamStore.RemoveMember", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.TeamStore.RemoveMember(ctx, teamId, userId)
+}
+
+func (s *ChaosLayerTeamStore) RemoveMembers(ctx context.Context, teamId string, userIds []string) *model.AppError {
+	if err := s.Root.faults.inject("Team", "RemoveMembers"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.TeamStore.RemoveMembers", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.TeamStore.RemoveMembers(ctx, teamId, userIds)
+}
+
+func (s *ChaosLayerTeamStore) ResetAllTeamSchemes(ctx context.Context) *model.AppError {
+	if err := s.Root.faults.inject("Team", "ResetAllTeamSchemes"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.TeamStore.ResetAllTeamSchemes", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.TeamStore.ResetAllTeamSchemes(ctx)
+}
+
+func (s *ChaosLayerTeamStore) Restore(ctx context.Context, teamId string) error {
+	if err := s.Root.faults.inject("Team", "Restore"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.TeamStore.Restore(ctx, teamId)
+}
+
+func (s *ChaosLayerTeamStore) Save(ctx context.Context, team *model.Team) (*model.Team, error) {
+	if err := s.Root.faults.inject("Team", "Save"); err != nil {
+		var resultVar0 *model.Team
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.Save(ctx, team)
+}
+
+func (s *ChaosLayerTeamStore) SaveMember(ctx context.Context, member *model.TeamMember, maxUsersPerTeam int) (*model.TeamMember, error) {
+	if err := s.Root.faults.inject("Team", "SaveMember"); err != nil {
+		var resultVar0 *model.TeamMember
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.SaveMember(ctx, member, maxUsersPerTeam)
+}
+
+func (s *ChaosLayerTeamStore) SaveMultiple(ctx context.Context, teams []*model.Team) ([]*model.Team, []error, error) {
+	if err := s.Root.faults.inject("Team", "SaveMultiple"); err != nil {
+		var resultVar0 []*model.Team
+		var resultVar1 []error
+		var resultVar2 error
+		resultVar2 = err
+		return resultVar0, resultVar1, resultVar2
+	}
+	return s.TeamStore.SaveMultiple(ctx, teams)
+}
+
+func (s *ChaosLayerTeamStore) SaveMultipleMembers(ctx context.Context, members []*model.TeamMember, maxUsersPerTeam int) ([]*model.TeamMember, error) {
+	if err := s.Root.faults.inject("Team", "SaveMultipleMembers"); err != nil {
+		var resultVar0 []*model.TeamMember
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.SaveMultipleMembers(ctx, members, maxUsersPerTeam)
+}
+
+func (s *ChaosLayerTeamStore) SearchAll(ctx context.Context, term string, opts *model.TeamSearchOpts) ([]*model.Team, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "SearchAll"); err != nil {
+		var resultVar0 []*model.Team
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.SearchAll", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.SearchAll(ctx, term, opts)
+}
+
+func (s *ChaosLayerTeamStore) SearchAllPaged(ctx context.Context, term string, opts *model.TeamSearchOpts, page int, perPage int) ([]*model.Team, int64, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "SearchAllPaged"); err != nil {
+		var resultVar0 []*model.Team
+		var resultVar1 int64
+		var resultVar2 *model.AppError
+		resultVar2 = model.NewAppError("ChaosLayer.TeamStore.SearchAllPaged", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1, resultVar2
+	}
+	return s.TeamStore.SearchAllPaged(ctx, term, opts, page, perPage)
+}
+
+func (s *ChaosLayerTeamStore) SearchOpen(ctx context.Context, term string, opts *model.TeamSearchOpts) ([]*model.Team, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "SearchOpen"); err != nil {
+		var resultVar0 []*model.Team
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.SearchOpen", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.SearchOpen(ctx, term, opts)
+}
+
+func (s *ChaosLayerTeamStore) SearchPrivate(ctx context.Context, term string, opts *model.TeamSearchOpts) ([]*model.Team, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "SearchPrivate"); err != nil {
+		var resultVar0 []*model.Team
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.SearchPrivate", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.SearchPrivate(ctx, term, opts)
+}
+
+func (s *ChaosLayerTeamStore) SearchPrivateForUser(ctx context.Context, userId string, term string, opts *model.TeamSearchOpts) ([]*model.Team, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "SearchPrivateForUser"); err != nil {
+		var resultVar0 []*model.Team
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.SearchPrivateForUser", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.SearchPrivateForUser(ctx, userId, term, opts)
+}
+
+func (s *ChaosLayerTeamStore) SetPolicy(ctx context.Context, teamId string, policyId string) error {
+	if err := s.Root.faults.inject("Team", "SetPolicy"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.TeamStore.SetPolicy(ctx, teamId, policyId)
+}
+
+func (s *ChaosLayerTeamStore) Update(ctx context.Context, team *model.Team) (*model.Team, error) {
+	if err := s.Root.faults.inject("Team", "Update"); err != nil {
+		var resultVar0 *model.Team
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.Update(ctx, team)
+}
+
+func (s *ChaosLayerTeamStore) UpdateLastTeamIconUpdate(ctx context.Context, teamId string, curTime int64) *model.AppError {
+	if err := s.Root.faults.inject("Team", "UpdateLastTeamIconUpdate"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.TeamStore.UpdateLastTeamIconUpdate", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.TeamStore.UpdateLastTeamIconUpdate(ctx, teamId, curTime)
+}
+
+func (s *ChaosLayerTeamStore) UpdateMember(ctx context.Context, member *model.TeamMember) (*model.TeamMember, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "UpdateMember"); err != nil {
+		var resultVar0 *model.TeamMember
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.UpdateMember", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.UpdateMember(ctx, member)
+}
+
+func (s *ChaosLayerTeamStore) UpdateMembersRole(ctx context.Context, teamID string, userIDs []string) *model.AppError {
+	if err := s.Root.faults.inject("Team", "UpdateMembersRole"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.TeamStore.UpdateMembersRole", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.TeamStore.UpdateMembersRole(ctx, teamID, userIDs)
+}
+
+func (s *ChaosLayerTeamStore) UpdateMembersRoleAndGetUpdated(ctx context.Context, teamID string, userIDs []string) ([]*model.TeamMember, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "UpdateMembersRoleAndGetUpdated"); err != nil {
+		var resultVar0 []*model.TeamMember
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.UpdateMembersRoleAndGetUpdated", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.UpdateMembersRoleAndGetUpdated(ctx, teamID, userIDs)
+}
+
+func (s *ChaosLayerTeamStore) UpdateMultipleMembers(ctx context.Context, members []*model.TeamMember) ([]*model.TeamMember, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "UpdateMultipleMembers"); err != nil {
+		var resultVar0 []*model.TeamMember
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.UpdateMultipleMembers", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.UpdateMultipleMembers(ctx, members)
+}
+
+func (s *ChaosLayerTeamStore) UserBelongsToTeam(ctx context.Context, userId string, teamId string, allowFromCache bool) (bool, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "UserBelongsToTeam"); err != nil {
+		var resultVar0 bool
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.UserBelongsToTeam", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.UserBelongsToTeam(ctx, userId, teamId, allowFromCache)
+}
+
+func (s *ChaosLayerTeamStore) UserBelongsToTeams(ctx context.Context, userId string, teamIds []string) (bool, *model.AppError) {
+	if err := s.Root.faults.inject("Team", "UserBelongsToTeams"); err != nil {
+		var resultVar0 bool
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.TeamStore.UserBelongsToTeams", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.TeamStore.UserBelongsToTeams(ctx, userId, teamIds)
+}
+
+func (s *ChaosLayerTeamInviteLinkStore) CountForTeam(teamId string) (int64, error) {
+	if err := s.Root.faults.inject("TeamInviteLink", "CountForTeam"); err != nil {
+		var resultVar0 int64
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TeamInviteLinkStore.CountForTeam(teamId)
+}
+
+func (s *ChaosLayerTeamInviteLinkStore) Get(id string) (*model.TeamInviteLink, error) {
+	if err := s.Root.faults.inject("TeamInviteLink", "Get"); err != nil {
+		var resultVar0 *model.TeamInviteLink
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TeamInviteLinkStore.Get(id)
+}
+
+func (s *ChaosLayerTeamInviteLinkStore) GetByName(name string) (*model.TeamInviteLink, error) {
+	if err := s.Root.faults.inject("TeamInviteLink", "GetByName"); err != nil {
+		var resultVar0 *model.TeamInviteLink
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TeamInviteLinkStore.GetByName(name)
+}
+
+func (s *ChaosLayerTeamInviteLinkStore) GetForTeam(teamId string) ([]*model.TeamInviteLink, error) {
+	if err := s.Root.faults.inject("TeamInviteLink", "GetForTeam"); err != nil {
+		var resultVar0 []*model.TeamInviteLink
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TeamInviteLinkStore.GetForTeam(teamId)
+}
+
+func (s *ChaosLayerTeamInviteLinkStore) IncrementUseCount(id string, now int64) error {
+	if err := s.Root.faults.inject("TeamInviteLink", "IncrementUseCount"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.TeamInviteLinkStore.IncrementUseCount(id, now)
+}
+
+func (s *ChaosLayerTeamInviteLinkStore) PermanentDeleteByTeam(teamId string) error {
+	if err := s.Root.faults.inject("TeamInviteLink", "PermanentDeleteByTeam"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.TeamInviteLinkStore.PermanentDeleteByTeam(teamId)
+}
+
+func (s *ChaosLayerTeamInviteLinkStore) Revoke(id string, deleteAt int64) error {
+	if err := s.Root.faults.inject("TeamInviteLink", "Revoke"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.TeamInviteLinkStore.Revoke(id, deleteAt)
+}
+
+func (s *ChaosLayerTeamInviteLinkStore) Save(link *model.TeamInviteLink) (*model.TeamInviteLink, error) {
+	if err := s.Root.faults.inject("TeamInviteLink", "Save"); err != nil {
+		var resultVar0 *model.TeamInviteLink
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TeamInviteLinkStore.Save(link)
+}
+
+func (s *ChaosLayerTeamMemberHistoryStore) GetUsersInTeamDuring(startTime int64, endTime int64, teamId string) ([]*model.TeamMemberHistoryResult, error) {
+	if err := s.Root.faults.inject("TeamMemberHistory", "GetUsersInTeamDuring"); err != nil {
+		var resultVar0 []*model.TeamMemberHistoryResult
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TeamMemberHistoryStore.GetUsersInTeamDuring(startTime, endTime, teamId)
+}
+
+func (s *ChaosLayerTeamMemberHistoryStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+	if err := s.Root.faults.inject("TeamMemberHistory", "PermanentDeleteBatch"); err != nil {
+		var resultVar0 int64
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TeamMemberHistoryStore.PermanentDeleteBatch(endTime, limit)
+}
+
+func (s *ChaosLayerTermsOfServiceStore) Get(id string, allowFromCache bool) (*model.TermsOfService, error) {
+	if err := s.Root.faults.inject("TermsOfService", "Get"); err != nil {
+		var resultVar0 *model.TermsOfService
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TermsOfServiceStore.Get(id, allowFromCache)
+}
+
+func (s *ChaosLayerTermsOfServiceStore) GetLatest(allowFromCache bool) (*model.TermsOfService, error) {
+	if err := s.Root.faults.inject("TermsOfService", "GetLatest"); err != nil {
+		var resultVar0 *model.TermsOfService
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TermsOfServiceStore.GetLatest(allowFromCache)
+}
+
+func (s *ChaosLayerTermsOfServiceStore) Save(termsOfService *model.TermsOfService) (*model.TermsOfService, error) {
+	if err := s.Root.faults.inject("TermsOfService", "Save"); err != nil {
+		var resultVar0 *model.TermsOfService
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TermsOfServiceStore.Save(termsOfService)
+}
+
+func (s *ChaosLayerTermsOfServicePolicyStore) Delete(id string, deleteAt int64) error {
+	if err := s.Root.faults.inject("TermsOfServicePolicy", "Delete"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.TermsOfServicePolicyStore.Delete(id, deleteAt)
+}
+
+func (s *ChaosLayerTermsOfServicePolicyStore) Get(id string) (*model.TermsOfServicePolicy, error) {
+	if err := s.Root.faults.inject("TermsOfServicePolicy", "Get"); err != nil {
+		var resultVar0 *model.TermsOfServicePolicy
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TermsOfServicePolicyStore.Get(id)
+}
+
+func (s *ChaosLayerTermsOfServicePolicyStore) GetAcceptanceCounts(version *model.TermsOfServicePolicyVersion) (int64, int64, error) {
+	if err := s.Root.faults.inject("TermsOfServicePolicy", "GetAcceptanceCounts"); err != nil {
+		var resultVar0 int64
+		var resultVar1 int64
+		var resultVar2 error
+		resultVar2 = err
+		return resultVar0, resultVar1, resultVar2
+	}
+	return s.TermsOfServicePolicyStore.GetAcceptanceCounts(version)
+}
+
+func (s *ChaosLayerTermsOfServicePolicyStore) GetAll(offset int, limit int) ([]*model.TermsOfServicePolicy, error) {
+	if err := s.Root.faults.inject("TermsOfServicePolicy", "GetAll"); err != nil {
+		var resultVar0 []*model.TermsOfServicePolicy
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TermsOfServicePolicyStore.GetAll(offset, limit)
+}
+
+func (s *ChaosLayerTermsOfServicePolicyStore) GetLatestVersion(policyId string) (*model.TermsOfServicePolicyVersion, error) {
+	if err := s.Root.faults.inject("TermsOfServicePolicy", "GetLatestVersion"); err != nil {
+		var resultVar0 *model.TermsOfServicePolicyVersion
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TermsOfServicePolicyStore.GetLatestVersion(policyId)
+}
+
+func (s *ChaosLayerTermsOfServicePolicyStore) GetOutstandingUsers(version *model.TermsOfServicePolicyVersion, offset int, limit int) ([]*model.User, error) {
+	if err := s.Root.faults.inject("TermsOfServicePolicy", "GetOutstandingUsers"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TermsOfServicePolicyStore.GetOutstandingUsers(version, offset, limit)
+}
+
+func (s *ChaosLayerTermsOfServicePolicyStore) GetPendingForUser(userId string) ([]*model.TermsOfServicePolicyVersion, error) {
+	if err := s.Root.faults.inject("TermsOfServicePolicy", "GetPendingForUser"); err != nil {
+		var resultVar0 []*model.TermsOfServicePolicyVersion
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TermsOfServicePolicyStore.GetPendingForUser(userId)
+}
+
+func (s *ChaosLayerTermsOfServicePolicyStore) Save(policy *model.TermsOfServicePolicy) (*model.TermsOfServicePolicy, error) {
+	if err := s.Root.faults.inject("TermsOfServicePolicy", "Save"); err != nil {
+		var resultVar0 *model.TermsOfServicePolicy
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TermsOfServicePolicyStore.Save(policy)
+}
+
+func (s *ChaosLayerTermsOfServicePolicyStore) SaveAcceptance(acceptance *model.TermsOfServiceAcceptance) error {
+	if err := s.Root.faults.inject("TermsOfServicePolicy", "SaveAcceptance"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.TermsOfServicePolicyStore.SaveAcceptance(acceptance)
+}
+
+func (s *ChaosLayerTermsOfServicePolicyStore) SaveVersion(version *model.TermsOfServicePolicyVersion) (*model.TermsOfServicePolicyVersion, error) {
+	if err := s.Root.faults.inject("TermsOfServicePolicy", "SaveVersion"); err != nil {
+		var resultVar0 *model.TermsOfServicePolicyVersion
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TermsOfServicePolicyStore.SaveVersion(version)
+}
+
+func (s *ChaosLayerTermsOfServicePolicyStore) Update(policy *model.TermsOfServicePolicy) (*model.TermsOfServicePolicy, error) {
+	if err := s.Root.faults.inject("TermsOfServicePolicy", "Update"); err != nil {
+		var resultVar0 *model.TermsOfServicePolicy
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TermsOfServicePolicyStore.Update(policy)
+}
+
+func (s *ChaosLayerTokenStore) Cleanup() {
+	s.Root.faults.delay("Token", "Cleanup")
+	s.TokenStore.Cleanup()
+}
+
+func (s *ChaosLayerTokenStore) Delete(token string) error {
+	if err := s.Root.faults.inject("Token", "Delete"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.TokenStore.Delete(token)
+}
+
+func (s *ChaosLayerTokenStore) GetByToken(token string) (*model.Token, error) {
+	if err := s.Root.faults.inject("Token", "GetByToken"); err != nil {
+		var resultVar0 *model.Token
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.TokenStore.GetByToken(token)
+}
+
+func (s *ChaosLayerTokenStore) RemoveAllTokensByType(tokenType string) error {
+	if err := s.Root.faults.inject("Token", "RemoveAllTokensByType"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.TokenStore.RemoveAllTokensByType(tokenType)
+}
+
+func (s *ChaosLayerTokenStore) Save(recovery *model.Token) error {
+	if err := s.Root.faults.inject("Token", "Save"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.TokenStore.Save(recovery)
+}
+
+func (s *ChaosLayerUserStore) AnalyticsActiveCount(time int64, options model.UserCountOptions) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("User", "AnalyticsActiveCount"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.AnalyticsActiveCount", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.AnalyticsActiveCount(time, options)
+}
+
+func (s *ChaosLayerUserStore) AnalyticsGetGuestCount() (int64, *model.AppError) {
+	if err := s.Root.faults.inject("User", "AnalyticsGetGuestCount"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.AnalyticsGetGuestCount", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.AnalyticsGetGuestCount()
+}
+
+func (s *ChaosLayerUserStore) AnalyticsGetInactiveUsersCount() (int64, *model.AppError) {
+	if err := s.Root.faults.inject("User", "AnalyticsGetInactiveUsersCount"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.AnalyticsGetInactiveUsersCount", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.AnalyticsGetInactiveUsersCount()
+}
+
+func (s *ChaosLayerUserStore) AnalyticsGetSystemAdminCount() (int64, *model.AppError) {
+	if err := s.Root.faults.inject("User", "AnalyticsGetSystemAdminCount"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.AnalyticsGetSystemAdminCount", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.AnalyticsGetSystemAdminCount()
+}
+
+func (s *ChaosLayerUserStore) AnalyticsSeatCountsByDay(startTime int64, endTime int64, excludeServiceAccounts bool) ([]*model.SeatUsagePoint, *model.AppError) {
+	if err := s.Root.faults.inject("User", "AnalyticsSeatCountsByDay"); err != nil {
+		var resultVar0 []*model.SeatUsagePoint
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.AnalyticsSeatCountsByDay", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.AnalyticsSeatCountsByDay(startTime, endTime, excludeServiceAccounts)
+}
+
+func (s *ChaosLayerUserStore) AutocompleteUsersInChannel(teamId string, channelId string, term string, options *model.UserSearchOptions) (*model.UserAutocompleteInChannel, *model.AppError) {
+	if err := s.Root.faults.inject("User", "AutocompleteUsersInChannel"); err != nil {
+		var resultVar0 *model.UserAutocompleteInChannel
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.AutocompleteUsersInChannel", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.AutocompleteUsersInChannel(teamId, channelId, term, options)
+}
+
+func (s *ChaosLayerUserStore) ClearAllCustomRoleAssignments() *model.AppError {
+	if err := s.Root.faults.inject("User", "ClearAllCustomRoleAssignments"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.UserStore.ClearAllCustomRoleAssignments", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.UserStore.ClearAllCustomRoleAssignments()
+}
+
+func (s *ChaosLayerUserStore) ClearCaches() {
+	s.Root.faults.delay("User", "ClearCaches")
+	s.UserStore.ClearCaches()
+}
+
+func (s *ChaosLayerUserStore) Count(options model.UserCountOptions) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("User", "Count"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.Count", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.Count(options)
+}
+
+func (s *ChaosLayerUserStore) DeactivateGuests() ([]string, *model.AppError) {
+	if err := s.Root.faults.inject("User", "DeactivateGuests"); err != nil {
+		var resultVar0 []string
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.DeactivateGuests", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.DeactivateGuests()
+}
+
+func (s *ChaosLayerUserStore) DemoteUserToGuest(userID string) *model.AppError {
+	if err := s.Root.faults.inject("User", "DemoteUserToGuest"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.UserStore.DemoteUserToGuest", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.UserStore.DemoteUserToGuest(userID)
+}
+
+func (s *ChaosLayerUserStore) Get(id string) (*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "Get"); err != nil {
+		var resultVar0 *model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.Get", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.Get(id)
+}
+
+func (s *ChaosLayerUserStore) GetAll() ([]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetAll"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetAll", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetAll()
+}
+
+func (s *ChaosLayerUserStore) GetAllAfter(limit int, afterId string) ([]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetAllAfter"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetAllAfter", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetAllAfter(limit, afterId)
+}
+
+func (s *ChaosLayerUserStore) GetAllNotInAuthService(authServices []string) ([]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetAllNotInAuthService"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetAllNotInAuthService", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetAllNotInAuthService(authServices)
+}
+
+func (s *ChaosLayerUserStore) GetAllProfiles(options *model.UserGetOptions) ([]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetAllProfiles"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetAllProfiles", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetAllProfiles(options)
+}
+
+func (s *ChaosLayerUserStore) GetAllProfilesInChannel(channelId string, allowFromCache bool) (map[string]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetAllProfilesInChannel"); err != nil {
+		var resultVar0 map[string]*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetAllProfilesInChannel", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetAllProfilesInChannel(channelId, allowFromCache)
+}
+
+func (s *ChaosLayerUserStore) GetAllUsingAuthService(authService string) ([]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetAllUsingAuthService"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetAllUsingAuthService", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetAllUsingAuthService(authService)
+}
+
+func (s *ChaosLayerUserStore) GetAnyUnreadPostCountForChannel(userId string, channelId string) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetAnyUnreadPostCountForChannel"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetAnyUnreadPostCountForChannel", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetAnyUnreadPostCountForChannel(userId, channelId)
+}
+
+func (s *ChaosLayerUserStore) GetByAuth(authData *string, authService string) (*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetByAuth"); err != nil {
+		var resultVar0 *model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetByAuth", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetByAuth(authData, authService)
+}
+
+func (s *ChaosLayerUserStore) GetByEmail(email string) (*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetByEmail"); err != nil {
+		var resultVar0 *model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetByEmail", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetByEmail(email)
+}
+
+func (s *ChaosLayerUserStore) GetByUsername(username string) (*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetByUsername"); err != nil {
+		var resultVar0 *model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetByUsername", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetByUsername(username)
+}
+
+func (s *ChaosLayerUserStore) GetChannelGroupUsers(channelID string) ([]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetChannelGroupUsers"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetChannelGroupUsers", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetChannelGroupUsers(channelID)
+}
+
+func (s *ChaosLayerUserStore) GetDirectReports(managerId string, offset int, limit int) ([]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetDirectReports"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetDirectReports", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetDirectReports(managerId, offset, limit)
+}
+
+func (s *ChaosLayerUserStore) GetEtagForAllProfiles() string {
+	s.Root.faults.delay("User", "GetEtagForAllProfiles")
+	return s.UserStore.GetEtagForAllProfiles()
+}
+
+func (s *ChaosLayerUserStore) GetEtagForProfiles(teamId string) string {
+	s.Root.faults.delay("User", "GetEtagForProfiles")
+	return s.UserStore.GetEtagForProfiles(teamId)
+}
+
+func (s *ChaosLayerUserStore) GetEtagForProfilesNotInTeam(teamId string) string {
+	s.Root.faults.delay("User", "GetEtagForProfilesNotInTeam")
+	return s.UserStore.GetEtagForProfilesNotInTeam(teamId)
+}
+
+func (s *ChaosLayerUserStore) GetForLogin(loginId string, allowSignInWithUsername bool, allowSignInWithEmail bool) (*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetForLogin"); err != nil {
+		var resultVar0 *model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetForLogin", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetForLogin(loginId, allowSignInWithUsername, allowSignInWithEmail)
+}
+
+func (s *ChaosLayerUserStore) GetKnownUsers(userID string) ([]string, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetKnownUsers"); err != nil {
+		var resultVar0 []string
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetKnownUsers", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetKnownUsers(userID)
+}
+
+func (s *ChaosLayerUserStore) GetNewUsersForTeam(teamId string, offset int, limit int, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetNewUsersForTeam"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetNewUsersForTeam", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetNewUsersForTeam(teamId, offset, limit, viewRestrictions)
+}
+
+func (s *ChaosLayerUserStore) GetProfileByGroupChannelIdsForUser(userId string, channelIds []string) (map[string][]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetProfileByGroupChannelIdsForUser"); err != nil {
+		var resultVar0 map[string][]*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetProfileByGroupChannelIdsForUser", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetProfileByGroupChannelIdsForUser(userId, channelIds)
+}
+
+func (s *ChaosLayerUserStore) GetProfileByIds(userIds []string, options *UserGetByIdsOpts, allowFromCache bool) ([]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetProfileByIds"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetProfileByIds", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetProfileByIds(userIds, options, allowFromCache)
+}
+
+func (s *ChaosLayerUserStore) GetProfiles(options *model.UserGetOptions) ([]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetProfiles"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetProfiles", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetProfiles(options)
+}
+
+func (s *ChaosLayerUserStore) GetProfilesByUsernames(usernames []string, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetProfilesByUsernames"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetProfilesByUsernames", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetProfilesByUsernames(usernames, viewRestrictions)
+}
+
+func (s *ChaosLayerUserStore) GetProfilesInChannel(channelId string, offset int, limit int) ([]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetProfilesInChannel"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetProfilesInChannel", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetProfilesInChannel(channelId, offset, limit)
+}
+
+func (s *ChaosLayerUserStore) GetProfilesInChannelByStatus(channelId string, offset int, limit int) ([]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetProfilesInChannelByStatus"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetProfilesInChannelByStatus", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetProfilesInChannelByStatus(channelId, offset, limit)
+}
+
+func (s *ChaosLayerUserStore) GetProfilesNotInChannel(teamId string, channelId string, groupConstrained bool, offset int, limit int, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetProfilesNotInChannel"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetProfilesNotInChannel", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetProfilesNotInChannel(teamId, channelId, groupConstrained, offset, limit, viewRestrictions)
+}
+
+func (s *ChaosLayerUserStore) GetProfilesNotInTeam(teamId string, groupConstrained bool, offset int, limit int, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetProfilesNotInTeam"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetProfilesNotInTeam", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetProfilesNotInTeam(teamId, groupConstrained, offset, limit, viewRestrictions)
+}
+
+func (s *ChaosLayerUserStore) GetProfilesWithoutTeam(options *model.UserGetOptions) ([]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetProfilesWithoutTeam"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetProfilesWithoutTeam", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetProfilesWithoutTeam(options)
+}
+
+func (s *ChaosLayerUserStore) GetRecentlyActiveUsersForTeam(teamId string, offset int, limit int, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetRecentlyActiveUsersForTeam"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetRecentlyActiveUsersForTeam", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetRecentlyActiveUsersForTeam(teamId, offset, limit, viewRestrictions)
+}
+
+func (s *ChaosLayerUserStore) GetReportingChain(userId string) ([]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetReportingChain"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetReportingChain", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetReportingChain(userId)
+}
+
+func (s *ChaosLayerUserStore) GetServiceAccounts(offset int, limit int, includeDeleted bool) ([]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetServiceAccounts"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetServiceAccounts", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetServiceAccounts(offset, limit, includeDeleted)
+}
+
+func (s *ChaosLayerUserStore) GetSystemAdminProfiles() (map[string]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetSystemAdminProfiles"); err != nil {
+		var resultVar0 map[string]*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetSystemAdminProfiles", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetSystemAdminProfiles()
+}
+
+func (s *ChaosLayerUserStore) GetTeamGroupUsers(teamID string) ([]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetTeamGroupUsers"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetTeamGroupUsers", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetTeamGroupUsers(teamID)
+}
+
+func (s *ChaosLayerUserStore) GetUnreadCount(userId string) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetUnreadCount"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetUnreadCount", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetUnreadCount(userId)
+}
+
+func (s *ChaosLayerUserStore) GetUnreadCountForChannel(userId string, channelId string) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetUnreadCountForChannel"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetUnreadCountForChannel", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetUnreadCountForChannel(userId, channelId)
+}
+
+func (s *ChaosLayerUserStore) GetUsersBatchForIndexing(startTime int64, endTime int64, limit int) ([]*model.UserForIndexing, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetUsersBatchForIndexing"); err != nil {
+		var resultVar0 []*model.UserForIndexing
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetUsersBatchForIndexing", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetUsersBatchForIndexing(startTime, endTime, limit)
+}
+
+func (s *ChaosLayerUserStore) GetUsersWithExpiredRoles(expiredBefore int64, limit int) ([]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "GetUsersWithExpiredRoles"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.GetUsersWithExpiredRoles", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.GetUsersWithExpiredRoles(expiredBefore, limit)
+}
+
+func (s *ChaosLayerUserStore) InferSystemInstallDate() (int64, *model.AppError) {
+	if err := s.Root.faults.inject("User", "InferSystemInstallDate"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.InferSystemInstallDate", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.InferSystemInstallDate()
+}
+
+func (s *ChaosLayerUserStore) InvalidateProfileCacheForUser(userId string) {
+	s.Root.faults.delay("User", "InvalidateProfileCacheForUser")
+	s.UserStore.InvalidateProfileCacheForUser(userId)
+}
+
+func (s *ChaosLayerUserStore) InvalidateProfilesInChannelCache(channelId string) {
+	s.Root.faults.delay("User", "InvalidateProfilesInChannelCache")
+	s.UserStore.InvalidateProfilesInChannelCache(channelId)
+}
+
+func (s *ChaosLayerUserStore) InvalidateProfilesInChannelCacheByUser(userId string) {
+	s.Root.faults.delay("User", "InvalidateProfilesInChannelCacheByUser")
+	s.UserStore.InvalidateProfilesInChannelCacheByUser(userId)
+}
+
+func (s *ChaosLayerUserStore) PermanentDelete(userId string) *model.AppError {
+	if err := s.Root.faults.inject("User", "PermanentDelete"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.UserStore.PermanentDelete", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.UserStore.PermanentDelete(userId)
+}
+
+func (s *ChaosLayerUserStore) PromoteGuestToUser(userID string) *model.AppError {
+	if err := s.Root.faults.inject("User", "PromoteGuestToUser"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.UserStore.PromoteGuestToUser", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.UserStore.PromoteGuestToUser(userID)
+}
+
+func (s *ChaosLayerUserStore) ResetLastPictureUpdate(userId string) *model.AppError {
+	if err := s.Root.faults.inject("User", "ResetLastPictureUpdate"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.UserStore.ResetLastPictureUpdate", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.UserStore.ResetLastPictureUpdate(userId)
+}
+
+func (s *ChaosLayerUserStore) Save(user *model.User) (*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "Save"); err != nil {
+		var resultVar0 *model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.Save", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.Save(user)
+}
+
+func (s *ChaosLayerUserStore) Search(teamId string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "Search"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.Search", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.Search(teamId, term, options)
+}
+
+func (s *ChaosLayerUserStore) SearchInChannel(channelId string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "SearchInChannel"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.SearchInChannel", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.SearchInChannel(channelId, term, options)
+}
+
+func (s *ChaosLayerUserStore) SearchInGroup(groupID string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "SearchInGroup"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.SearchInGroup", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.SearchInGroup(groupID, term, options)
+}
+
+func (s *ChaosLayerUserStore) SearchNotInChannel(teamId string, channelId string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "SearchNotInChannel"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.SearchNotInChannel", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.SearchNotInChannel(teamId, channelId, term, options)
+}
+
+func (s *ChaosLayerUserStore) SearchNotInTeam(notInTeamId string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "SearchNotInTeam"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.SearchNotInTeam", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.SearchNotInTeam(notInTeamId, term, options)
+}
+
+func (s *ChaosLayerUserStore) SearchWithoutTeam(term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError) {
+	if err := s.Root.faults.inject("User", "SearchWithoutTeam"); err != nil {
+		var resultVar0 []*model.User
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.SearchWithoutTeam", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.SearchWithoutTeam(term, options)
+}
+
+func (s *ChaosLayerUserStore) Update(user *model.User, allowRoleUpdate bool) (*model.UserUpdate, *model.AppError) {
+	if err := s.Root.faults.inject("User", "Update"); err != nil {
+		var resultVar0 *model.UserUpdate
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.Update", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.Update(user, allowRoleUpdate)
+}
+
+func (s *ChaosLayerUserStore) UpdateAuthData(userId string, service string, authData *string, email string, resetMfa bool) (string, *model.AppError) {
+	if err := s.Root.faults.inject("User", "UpdateAuthData"); err != nil {
+		var resultVar0 string
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.UpdateAuthData", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.UpdateAuthData(userId, service, authData, email, resetMfa)
+}
+
+func (s *ChaosLayerUserStore) UpdateFailedPasswordAttempts(userId string, attempts int) *model.AppError {
+	if err := s.Root.faults.inject("User", "UpdateFailedPasswordAttempts"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.UserStore.UpdateFailedPasswordAttempts", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.UserStore.UpdateFailedPasswordAttempts(userId, attempts)
+}
+
+func (s *ChaosLayerUserStore) UpdateLastLogin(userId string, lastLogin int64) *model.AppError {
+	if err := s.Root.faults.inject("User", "UpdateLastLogin"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.UserStore.UpdateLastLogin", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.UserStore.UpdateLastLogin(userId, lastLogin)
+}
+
+func (s *ChaosLayerUserStore) UpdateLastPictureUpdate(userId string) *model.AppError {
+	if err := s.Root.faults.inject("User", "UpdateLastPictureUpdate"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.UserStore.UpdateLastPictureUpdate", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.UserStore.UpdateLastPictureUpdate(userId)
+}
+
+func (s *ChaosLayerUserStore) UpdateMfaActive(userId string, active bool) *model.AppError {
+	if err := s.Root.faults.inject("User", "UpdateMfaActive"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.UserStore.UpdateMfaActive", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.UserStore.UpdateMfaActive(userId, active)
+}
+
+func (s *ChaosLayerUserStore) UpdateMfaSecret(userId string, secret string) *model.AppError {
+	if err := s.Root.faults.inject("User", "UpdateMfaSecret"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.UserStore.UpdateMfaSecret", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.UserStore.UpdateMfaSecret(userId, secret)
+}
+
+func (s *ChaosLayerUserStore) UpdatePassword(userId string, newPassword string) *model.AppError {
+	if err := s.Root.faults.inject("User", "UpdatePassword"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.UserStore.UpdatePassword", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.UserStore.UpdatePassword(userId, newPassword)
+}
+
+func (s *ChaosLayerUserStore) UpdateUpdateAt(userId string) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("User", "UpdateUpdateAt"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.UpdateUpdateAt", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.UpdateUpdateAt(userId)
+}
+
+func (s *ChaosLayerUserStore) VerifyEmail(userId string, email string) (string, *model.AppError) {
+	if err := s.Root.faults.inject("User", "VerifyEmail"); err != nil {
+		var resultVar0 string
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserStore.VerifyEmail", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserStore.VerifyEmail(userId, email)
+}
+
+func (s *ChaosLayerUserAccessTokenStore) Delete(tokenId string) *model.AppError {
+	if err := s.Root.faults.inject("UserAccessToken", "Delete"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.UserAccessTokenStore.Delete", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.UserAccessTokenStore.Delete(tokenId)
+}
+
+func (s *ChaosLayerUserAccessTokenStore) DeleteAllForUser(userId string) *model.AppError {
+	if err := s.Root.faults.inject("UserAccessToken", "DeleteAllForUser"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.UserAccessTokenStore.DeleteAllForUser", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.UserAccessTokenStore.DeleteAllForUser(userId)
+}
+
+func (s *ChaosLayerUserAccessTokenStore) Get(tokenId string) (*model.UserAccessToken, *model.AppError) {
+	if err := s.Root.faults.inject("UserAccessToken", "Get"); err != nil {
+		var resultVar0 *model.UserAccessToken
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserAccessTokenStore.Get", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserAccessTokenStore.Get(tokenId)
+}
+
+func (s *ChaosLayerUserAccessTokenStore) GetAll(offset int, limit int) ([]*model.UserAccessToken, *model.AppError) {
+	if err := s.Root.faults.inject("UserAccessToken", "GetAll"); err != nil {
+		var resultVar0 []*model.UserAccessToken
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserAccessTokenStore.GetAll", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserAccessTokenStore.GetAll(offset, limit)
+}
+
+func (s *ChaosLayerUserAccessTokenStore) GetByToken(tokenString string) (*model.UserAccessToken, *model.AppError) {
+	if err := s.Root.faults.inject("UserAccessToken", "GetByToken"); err != nil {
+		var resultVar0 *model.UserAccessToken
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserAccessTokenStore.GetByToken", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserAccessTokenStore.GetByToken(tokenString)
+}
+
+func (s *ChaosLayerUserAccessTokenStore) GetByUser(userId string, page int, perPage int) ([]*model.UserAccessToken, *model.AppError) {
+	if err := s.Root.faults.inject("UserAccessToken", "GetByUser"); err != nil {
+		var resultVar0 []*model.UserAccessToken
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserAccessTokenStore.GetByUser", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserAccessTokenStore.GetByUser(userId, page, perPage)
+}
+
+func (s *ChaosLayerUserAccessTokenStore) Save(token *model.UserAccessToken) (*model.UserAccessToken, *model.AppError) {
+	if err := s.Root.faults.inject("UserAccessToken", "Save"); err != nil {
+		var resultVar0 *model.UserAccessToken
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserAccessTokenStore.Save", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserAccessTokenStore.Save(token)
+}
+
+func (s *ChaosLayerUserAccessTokenStore) Search(term string) ([]*model.UserAccessToken, *model.AppError) {
+	if err := s.Root.faults.inject("UserAccessToken", "Search"); err != nil {
+		var resultVar0 []*model.UserAccessToken
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.UserAccessTokenStore.Search", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.UserAccessTokenStore.Search(term)
+}
+
+func (s *ChaosLayerUserAccessTokenStore) UpdateTokenDisable(tokenId string) *model.AppError {
+	if err := s.Root.faults.inject("UserAccessToken", "UpdateTokenDisable"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.UserAccessTokenStore.UpdateTokenDisable", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.UserAccessTokenStore.UpdateTokenDisable(tokenId)
+}
+
+func (s *ChaosLayerUserAccessTokenStore) UpdateTokenEnable(tokenId string) *model.AppError {
+	if err := s.Root.faults.inject("UserAccessToken", "UpdateTokenEnable"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.UserAccessTokenStore.UpdateTokenEnable", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.UserAccessTokenStore.UpdateTokenEnable(tokenId)
+}
+
+func (s *ChaosLayerUserPropertyStore) DeleteField(id string, deleteAt int64) error {
+	if err := s.Root.faults.inject("UserProperty", "DeleteField"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.UserPropertyStore.DeleteField(id, deleteAt)
+}
+
+func (s *ChaosLayerUserPropertyStore) GetField(id string) (*model.UserPropertyField, error) {
+	if err := s.Root.faults.inject("UserProperty", "GetField"); err != nil {
+		var resultVar0 *model.UserPropertyField
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.UserPropertyStore.GetField(id)
+}
+
+func (s *ChaosLayerUserPropertyStore) GetFields() ([]*model.UserPropertyField, error) {
+	if err := s.Root.faults.inject("UserProperty", "GetFields"); err != nil {
+		var resultVar0 []*model.UserPropertyField
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.UserPropertyStore.GetFields()
+}
+
+func (s *ChaosLayerUserPropertyStore) GetValuesForUser(userId string) ([]*model.UserPropertyValue, error) {
+	if err := s.Root.faults.inject("UserProperty", "GetValuesForUser"); err != nil {
+		var resultVar0 []*model.UserPropertyValue
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.UserPropertyStore.GetValuesForUser(userId)
+}
+
+func (s *ChaosLayerUserPropertyStore) PermanentDeleteByUser(userId string) error {
+	if err := s.Root.faults.inject("UserProperty", "PermanentDeleteByUser"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.UserPropertyStore.PermanentDeleteByUser(userId)
+}
+
+func (s *ChaosLayerUserPropertyStore) SaveField(field *model.UserPropertyField) (*model.UserPropertyField, error) {
+	if err := s.Root.faults.inject("UserProperty", "SaveField"); err != nil {
+		var resultVar0 *model.UserPropertyField
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.UserPropertyStore.SaveField(field)
+}
+
+func (s *ChaosLayerUserPropertyStore) SaveValuesForUser(userId string, values []*model.UserPropertyValue) error {
+	if err := s.Root.faults.inject("UserProperty", "SaveValuesForUser"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.UserPropertyStore.SaveValuesForUser(userId, values)
+}
+
+func (s *ChaosLayerUserPropertyStore) UpdateField(field *model.UserPropertyField) (*model.UserPropertyField, error) {
+	if err := s.Root.faults.inject("UserProperty", "UpdateField"); err != nil {
+		var resultVar0 *model.UserPropertyField
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.UserPropertyStore.UpdateField(field)
+}
+
+func (s *ChaosLayerUserRelationshipStore) Delete(userId string, otherUserId string, relationshipType string) error {
+	if err := s.Root.faults.inject("UserRelationship", "Delete"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.UserRelationshipStore.Delete(userId, otherUserId, relationshipType)
+}
+
+func (s *ChaosLayerUserRelationshipStore) Get(userId string, otherUserId string, relationshipType string) (*model.UserRelationship, error) {
+	if err := s.Root.faults.inject("UserRelationship", "Get"); err != nil {
+		var resultVar0 *model.UserRelationship
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.UserRelationshipStore.Get(userId, otherUserId, relationshipType)
+}
+
+func (s *ChaosLayerUserRelationshipStore) GetForUser(userId string) ([]*model.UserRelationship, error) {
+	if err := s.Root.faults.inject("UserRelationship", "GetForUser"); err != nil {
+		var resultVar0 []*model.UserRelationship
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.UserRelationshipStore.GetForUser(userId)
+}
+
+func (s *ChaosLayerUserRelationshipStore) GetUserIdsRelatedTo(otherUserId string) ([]string, error) {
+	if err := s.Root.faults.inject("UserRelationship", "GetUserIdsRelatedTo"); err != nil {
+		var resultVar0 []string
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.UserRelationshipStore.GetUserIdsRelatedTo(otherUserId)
+}
+
+func (s *ChaosLayerUserRelationshipStore) PermanentDeleteByUser(userId string) error {
+	if err := s.Root.faults.inject("UserRelationship", "PermanentDeleteByUser"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.UserRelationshipStore.PermanentDeleteByUser(userId)
+}
+
+func (s *ChaosLayerUserRelationshipStore) Save(relationship *model.UserRelationship) (*model.UserRelationship, error) {
+	if err := s.Root.faults.inject("UserRelationship", "Save"); err != nil {
+		var resultVar0 *model.UserRelationship
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.UserRelationshipStore.Save(relationship)
+}
+
+func (s *ChaosLayerUserTermsOfServiceStore) Delete(userId string, termsOfServiceId string) error {
+	if err := s.Root.faults.inject("UserTermsOfService", "Delete"); err != nil {
+		var resultVar0 error
+		resultVar0 = err
+		return resultVar0
+	}
+	return s.UserTermsOfServiceStore.Delete(userId, termsOfServiceId)
+}
+
+func (s *ChaosLayerUserTermsOfServiceStore) GetByUser(userId string) (*model.UserTermsOfService, error) {
+	if err := s.Root.faults.inject("UserTermsOfService", "GetByUser"); err != nil {
+		var resultVar0 *model.UserTermsOfService
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.UserTermsOfServiceStore.GetByUser(userId)
+}
+
+func (s *ChaosLayerUserTermsOfServiceStore) Save(userTermsOfService *model.UserTermsOfService) (*model.UserTermsOfService, error) {
+	if err := s.Root.faults.inject("UserTermsOfService", "Save"); err != nil {
+		var resultVar0 *model.UserTermsOfService
+		var resultVar1 error
+		resultVar1 = err
+		return resultVar0, resultVar1
+	}
+	return s.UserTermsOfServiceStore.Save(userTermsOfService)
+}
+
+func (s *ChaosLayerWebhookStore) AnalyticsIncomingCount(teamId string) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Webhook", "AnalyticsIncomingCount"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.WebhookStore.AnalyticsIncomingCount", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.WebhookStore.AnalyticsIncomingCount(teamId)
+}
+
+func (s *ChaosLayerWebhookStore) AnalyticsOutgoingCount(teamId string) (int64, *model.AppError) {
+	if err := s.Root.faults.inject("Webhook", "AnalyticsOutgoingCount"); err != nil {
+		var resultVar0 int64
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.WebhookStore.AnalyticsOutgoingCount", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.WebhookStore.AnalyticsOutgoingCount(teamId)
+}
+
+func (s *ChaosLayerWebhookStore) ClearCaches() {
+	s.Root.faults.delay("Webhook", "ClearCaches")
+	s.WebhookStore.ClearCaches()
+}
+
+func (s *ChaosLayerWebhookStore) DeleteIncoming(webhookId string, time int64) *model.AppError {
+	if err := s.Root.faults.inject("Webhook", "DeleteIncoming"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.WebhookStore.DeleteIncoming", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.WebhookStore.DeleteIncoming(webhookId, time)
+}
+
+func (s *ChaosLayerWebhookStore) DeleteOutgoing(webhookId string, time int64) *model.AppError {
+	if err := s.Root.faults.inject("Webhook", "DeleteOutgoing"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.WebhookStore.DeleteOutgoing", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.WebhookStore.DeleteOutgoing(webhookId, time)
+}
+
+func (s *ChaosLayerWebhookStore) GetIncoming(id string, allowFromCache bool) (*model.IncomingWebhook, *model.AppError) {
+	if err := s.Root.faults.inject("Webhook", "GetIncoming"); err != nil {
+		var resultVar0 *model.IncomingWebhook
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.WebhookStore.GetIncoming", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.WebhookStore.GetIncoming(id, allowFromCache)
+}
+
+func (s *ChaosLayerWebhookStore) GetIncomingByChannel(channelId string) ([]*model.IncomingWebhook, *model.AppError) {
+	if err := s.Root.faults.inject("Webhook", "GetIncomingByChannel"); err != nil {
+		var resultVar0 []*model.IncomingWebhook
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.WebhookStore.GetIncomingByChannel", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.WebhookStore.GetIncomingByChannel(channelId)
+}
+
+func (s *ChaosLayerWebhookStore) GetIncomingByTeam(teamId string, offset int, limit int) ([]*model.IncomingWebhook, *model.AppError) {
+	if err := s.Root.faults.inject("Webhook", "GetIncomingByTeam"); err != nil {
+		var resultVar0 []*model.IncomingWebhook
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.WebhookStore.GetIncomingByTeam", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.WebhookStore.GetIncomingByTeam(teamId, offset, limit)
+}
+
+func (s *ChaosLayerWebhookStore) GetIncomingByTeamByUser(teamId string, userId string, offset int, limit int) ([]*model.IncomingWebhook, *model.AppError) {
+	if err := s.Root.faults.inject("Webhook", "GetIncomingByTeamByUser"); err != nil {
+		var resultVar0 []*model.IncomingWebhook
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.WebhookStore.GetIncomingByTeamByUser", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.WebhookStore.GetIncomingByTeamByUser(teamId, userId, offset, limit)
+}
+
+func (s *ChaosLayerWebhookStore) GetIncomingList(offset int, limit int) ([]*model.IncomingWebhook, *model.AppError) {
+	if err := s.Root.faults.inject("Webhook", "GetIncomingList"); err != nil {
+		var resultVar0 []*model.IncomingWebhook
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.WebhookStore.GetIncomingList", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.WebhookStore.GetIncomingList(offset, limit)
+}
+
+func (s *ChaosLayerWebhookStore) GetIncomingListByUser(userId string, offset int, limit int) ([]*model.IncomingWebhook, *model.AppError) {
+	if err := s.Root.faults.inject("Webhook", "GetIncomingListByUser"); err != nil {
+		var resultVar0 []*model.IncomingWebhook
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.WebhookStore.GetIncomingListByUser", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.WebhookStore.GetIncomingListByUser(userId, offset, limit)
+}
+
+func (s *ChaosLayerWebhookStore) GetOutgoing(id string) (*model.OutgoingWebhook, *model.AppError) {
+	if err := s.Root.faults.inject("Webhook", "GetOutgoing"); err != nil {
+		var resultVar0 *model.OutgoingWebhook
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.WebhookStore.GetOutgoing", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.WebhookStore.GetOutgoing(id)
+}
+
+func (s *ChaosLayerWebhookStore) GetOutgoingByChannel(channelId string, offset int, limit int) ([]*model.OutgoingWebhook, *model.AppError) {
+	if err := s.Root.faults.inject("Webhook", "GetOutgoingByChannel"); err != nil {
+		var resultVar0 []*model.OutgoingWebhook
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.WebhookStore.GetOutgoingByChannel", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.WebhookStore.GetOutgoingByChannel(channelId, offset, limit)
+}
+
+func (s *ChaosLayerWebhookStore) GetOutgoingByChannelByUser(channelId string, userId string, offset int, limit int) ([]*model.OutgoingWebhook, *model.AppError) {
+	if err := s.Root.faults.inject("Webhook", "GetOutgoingByChannelByUser"); err != nil {
+		var resultVar0 []*model.OutgoingWebhook
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.WebhookStore.GetOutgoingByChannelByUser", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.WebhookStore.GetOutgoingByChannelByUser(channelId, userId, offset, limit)
+}
+
+func (s *ChaosLayerWebhookStore) GetOutgoingByTeam(teamId string, offset int, limit int) ([]*model.OutgoingWebhook, *model.AppError) {
+	if err := s.Root.faults.inject("Webhook", "GetOutgoingByTeam"); err != nil {
+		var resultVar0 []*model.OutgoingWebhook
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.WebhookStore.GetOutgoingByTeam", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.WebhookStore.GetOutgoingByTeam(teamId, offset, limit)
+}
+
+func (s *ChaosLayerWebhookStore) GetOutgoingByTeamByUser(teamId string, userId string, offset int, limit int) ([]*model.OutgoingWebhook, *model.AppError) {
+	if err := s.Root.faults.inject("Webhook", "GetOutgoingByTeamByUser"); err != nil {
+		var resultVar0 []*model.OutgoingWebhook
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.WebhookStore.GetOutgoingByTeamByUser", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.WebhookStore.GetOutgoingByTeamByUser(teamId, userId, offset, limit)
+}
+
+func (s *ChaosLayerWebhookStore) GetOutgoingList(offset int, limit int) ([]*model.OutgoingWebhook, *model.AppError) {
+	if err := s.Root.faults.inject("Webhook", "GetOutgoingList"); err != nil {
+		var resultVar0 []*model.OutgoingWebhook
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.WebhookStore.GetOutgoingList", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.WebhookStore.GetOutgoingList(offset, limit)
+}
+
+func (s *ChaosLayerWebhookStore) GetOutgoingListByUser(userId string, offset int, limit int) ([]*model.OutgoingWebhook, *model.AppError) {
+	if err := s.Root.faults.inject("Webhook", "GetOutgoingListByUser"); err != nil {
+		var resultVar0 []*model.OutgoingWebhook
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.WebhookStore.GetOutgoingListByUser", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.WebhookStore.GetOutgoingListByUser(userId, offset, limit)
+}
+
+func (s *ChaosLayerWebhookStore) InvalidateWebhookCache(webhook string) {
+	s.Root.faults.delay("Webhook", "InvalidateWebhookCache")
+	s.WebhookStore.InvalidateWebhookCache(webhook)
+}
+
+func (s *ChaosLayerWebhookStore) PermanentDeleteIncomingByChannel(channelId string) *model.AppError {
+	if err := s.Root.faults.inject("Webhook", "PermanentDeleteIncomingByChannel"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.WebhookStore.PermanentDeleteIncomingByChannel", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.WebhookStore.PermanentDeleteIncomingByChannel(channelId)
+}
+
+func (s *ChaosLayerWebhookStore) PermanentDeleteIncomingByUser(userId string) *model.AppError {
+	if err := s.Root.faults.inject("Webhook", "PermanentDeleteIncomingByUser"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.WebhookStore.PermanentDeleteIncomingByUser", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.WebhookStore.PermanentDeleteIncomingByUser(userId)
+}
+
+func (s *ChaosLayerWebhookStore) PermanentDeleteOutgoingByChannel(channelId string) *model.AppError {
+	if err := s.Root.faults.inject("Webhook", "PermanentDeleteOutgoingByChannel"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.WebhookStore.PermanentDeleteOutgoingByChannel", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.WebhookStore.PermanentDeleteOutgoingByChannel(channelId)
+}
+
+func (s *ChaosLayerWebhookStore) PermanentDeleteOutgoingByUser(userId string) *model.AppError {
+	if err := s.Root.faults.inject("Webhook", "PermanentDeleteOutgoingByUser"); err != nil {
+		var resultVar0 *model.AppError
+		resultVar0 = model.NewAppError("ChaosLayer.WebhookStore.PermanentDeleteOutgoingByUser", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0
+	}
+	return s.WebhookStore.PermanentDeleteOutgoingByUser(userId)
+}
+
+func (s *ChaosLayerWebhookStore) SaveIncoming(webhook *model.IncomingWebhook) (*model.IncomingWebhook, *model.AppError) {
+	if err := s.Root.faults.inject("Webhook", "SaveIncoming"); err != nil {
+		var resultVar0 *model.IncomingWebhook
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.WebhookStore.SaveIncoming", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.WebhookStore.SaveIncoming(webhook)
+}
+
+func (s *ChaosLayerWebhookStore) SaveOutgoing(webhook *model.OutgoingWebhook) (*model.OutgoingWebhook, *model.AppError) {
+	if err := s.Root.faults.inject("Webhook", "SaveOutgoing"); err != nil {
+		var resultVar0 *model.OutgoingWebhook
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.WebhookStore.SaveOutgoing", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.WebhookStore.SaveOutgoing(webhook)
+}
+
+func (s *ChaosLayerWebhookStore) UpdateIncoming(webhook *model.IncomingWebhook) (*model.IncomingWebhook, *model.AppError) {
+	if err := s.Root.faults.inject("Webhook", "UpdateIncoming"); err != nil {
+		var resultVar0 *model.IncomingWebhook
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.WebhookStore.UpdateIncoming", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.WebhookStore.UpdateIncoming(webhook)
+}
+
+func (s *ChaosLayerWebhookStore) UpdateOutgoing(hook *model.OutgoingWebhook) (*model.OutgoingWebhook, *model.AppError) {
+	if err := s.Root.faults.inject("Webhook", "UpdateOutgoing"); err != nil {
+		var resultVar0 *model.OutgoingWebhook
+		var resultVar1 *model.AppError
+		resultVar1 = model.NewAppError("ChaosLayer.WebhookStore.UpdateOutgoing", "store.chaos_layer.fault_injected.app_error", nil, err.Error(), http.StatusServiceUnavailable)
+		return resultVar0, resultVar1
+	}
+	return s.WebhookStore.UpdateOutgoing(hook)
+}
+
+func (s *ChaosLayer) Close() {
+	s.Store.Close()
+}
+
+func (s *ChaosLayer) DropAllTables() {
+	s.Store.DropAllTables()
+}
+
+func (s *ChaosLayer) GetCurrentSchemaVersion() string {
+	return s.Store.GetCurrentSchemaVersion()
+}
+
+func (s *ChaosLayer) LockToMaster() {
+	s.Store.LockToMaster()
+}
+
+func (s *ChaosLayer) MarkSystemRanUnitTests() {
+	s.Store.MarkSystemRanUnitTests()
+}
+
+func (s *ChaosLayer) SetContext(context context.Context) {
+	s.Store.SetContext(context)
+}
+
+func (s *ChaosLayer) TotalMasterDbConnections() int {
+	return s.Store.TotalMasterDbConnections()
+}
+
+func (s *ChaosLayer) TotalReadDbConnections() int {
+	return s.Store.TotalReadDbConnections()
+}
+
+func (s *ChaosLayer) TotalSearchDbConnections() int {
+	return s.Store.TotalSearchDbConnections()
+}
+
+func (s *ChaosLayer) UnlockFromMaster() {
+	s.Store.UnlockFromMaster()
+}
+
+func NewChaosLayer(childStore Store, settings func() ChaosSettings) *ChaosLayer {
+	newStore := ChaosLayer{
+		Store:  childStore,
+		faults: newChaosFaults(settings),
+	}
+
+	newStore.AuditStore = &ChaosLayerAuditStore{AuditStore: childStore.Audit(), Root: &newStore}
+	newStore.BotStore = &ChaosLayerBotStore{BotStore: childStore.Bot(), Root: &newStore}
+	newStore.ChannelStore = &ChaosLayerChannelStore{ChannelStore: childStore.Channel(), Root: &newStore}
+	newStore.ChannelBookmarkStore = &ChaosLayerChannelBookmarkStore{ChannelBookmarkStore: childStore.ChannelBookmark(), Root: &newStore}
+	newStore.ChannelChangeStore = &ChaosLayerChannelChangeStore{ChannelChangeStore: childStore.ChannelChange(), Root: &newStore}
+	newStore.ChannelGuestLinkStore = &ChaosLayerChannelGuestLinkStore{ChannelGuestLinkStore: childStore.ChannelGuestLink(), Root: &newStore}
+	newStore.ChannelIntegrationStore = &ChaosLayerChannelIntegrationStore{ChannelIntegrationStore: childStore.ChannelIntegration(), Root: &newStore}
+	newStore.ChannelMemberHistoryStore = &ChaosLayerChannelMemberHistoryStore{ChannelMemberHistoryStore: childStore.ChannelMemberHistory(), Root: &newStore}
+	newStore.ClusterDiscoveryStore = &ChaosLayerClusterDiscoveryStore{ClusterDiscoveryStore: childStore.ClusterDiscovery(), Root: &newStore}
+	newStore.CommandStore = &ChaosLayerCommandStore{CommandStore: childStore.Command(), Root: &newStore}
+	newStore.CommandWebhookStore = &ChaosLayerCommandWebhookStore{CommandWebhookStore: childStore.CommandWebhook(), Root: &newStore}
+	newStore.ComplianceStore = &ChaosLayerComplianceStore{ComplianceStore: childStore.Compliance(), Root: &newStore}
+	newStore.EmailVerificationStore = &ChaosLayerEmailVerificationStore{EmailVerificationStore: childStore.EmailVerification(), Root: &newStore}
+	newStore.EmojiStore = &ChaosLayerEmojiStore{EmojiStore: childStore.Emoji(), Root: &newStore}
+	newStore.EventStreamStore = &ChaosLayerEventStreamStore{EventStreamStore: childStore.EventStream(), Root: &newStore}
+	newStore.FileInfoStore = &ChaosLayerFileInfoStore{FileInfoStore: childStore.FileInfo(), Root: &newStore}
+	newStore.GroupStore = &ChaosLayerGroupStore{GroupStore: childStore.Group(), Root: &newStore}
+	newStore.IntegrationUsageStore = &ChaosLayerIntegrationUsageStore{IntegrationUsageStore: childStore.IntegrationUsage(), Root: &newStore}
+	newStore.JobStore = &ChaosLayerJobStore{JobStore: childStore.Job(), Root: &newStore}
+	newStore.LicenseStore = &ChaosLayerLicenseStore{LicenseStore: childStore.License(), Root: &newStore}
+	newStore.LinkMetadataStore = &ChaosLayerLinkMetadataStore{LinkMetadataStore: childStore.LinkMetadata(), Root: &newStore}
+	newStore.LoginHistoryStore = &ChaosLayerLoginHistoryStore{LoginHistoryStore: childStore.LoginHistory(), Root: &newStore}
+	newStore.OAuthStore = &ChaosLayerOAuthStore{OAuthStore: childStore.OAuth(), Root: &newStore}
+	newStore.OutboxStore = &ChaosLayerOutboxStore{OutboxStore: childStore.Outbox(), Root: &newStore}
+	newStore.PendingPinStore = &ChaosLayerPendingPinStore{PendingPinStore: childStore.PendingPin(), Root: &newStore}
+	newStore.PluginStore = &ChaosLayerPluginStore{PluginStore: childStore.Plugin(), Root: &newStore}
+	newStore.PostStore = &ChaosLayerPostStore{PostStore: childStore.Post(), Root: &newStore}
+	newStore.PostAcknowledgementStore = &ChaosLayerPostAcknowledgementStore{PostAcknowledgementStore: childStore.PostAcknowledgement(), Root: &newStore}
+	newStore.PostArchiveStore = &ChaosLayerPostArchiveStore{PostArchiveStore: childStore.PostArchive(), Root: &newStore}
+	newStore.PostIntegrityStore = &ChaosLayerPostIntegrityStore{PostIntegrityStore: childStore.PostIntegrity(), Root: &newStore}
+	newStore.PostsPartitionStore = &ChaosLayerPostsPartitionStore{PostsPartitionStore: childStore.PostsPartition(), Root: &newStore}
+	newStore.PreferenceStore = &ChaosLayerPreferenceStore{PreferenceStore: childStore.Preference(), Root: &newStore}
+	newStore.PresenceWebhookStore = &ChaosLayerPresenceWebhookStore{PresenceWebhookStore: childStore.PresenceWebhook(), Root: &newStore}
+	newStore.ReactionStore = &ChaosLayerReactionStore{ReactionStore: childStore.Reaction(), Root: &newStore}
+	newStore.RetentionPolicyStore = &ChaosLayerRetentionPolicyStore{RetentionPolicyStore: childStore.RetentionPolicy(), Root: &newStore}
+	newStore.RoleStore = &ChaosLayerRoleStore{RoleStore: childStore.Role(), Root: &newStore}
+	newStore.SchemeStore = &ChaosLayerSchemeStore{SchemeStore: childStore.Scheme(), Root: &newStore}
+	newStore.SessionStore = &ChaosLayerSessionStore{SessionStore: childStore.Session(), Root: &newStore}
+	newStore.SlugHistoryStore = &ChaosLayerSlugHistoryStore{SlugHistoryStore: childStore.SlugHistory(), Root: &newStore}
+	newStore.StatusStore = &ChaosLayerStatusStore{StatusStore: childStore.Status(), Root: &newStore}
+	newStore.StatusAutomationStore = &ChaosLayerStatusAutomationStore{StatusAutomationStore: childStore.StatusAutomation(), Root: &newStore}
+	newStore.SystemStore = &ChaosLayerSystemStore{SystemStore: childStore.System(), Root: &newStore}
+	newStore.TeamStore = &ChaosLayerTeamStore{TeamStore: childStore.Team(), Root: &newStore}
+	newStore.TeamInviteLinkStore = &ChaosLayerTeamInviteLinkStore{TeamInviteLinkStore: childStore.TeamInviteLink(), Root: &newStore}
+	newStore.TeamMemberHistoryStore = &ChaosLayerTeamMemberHistoryStore{TeamMemberHistoryStore: childStore.TeamMemberHistory(), Root: &newStore}
+	newStore.TermsOfServiceStore = &ChaosLayerTermsOfServiceStore{TermsOfServiceStore: childStore.TermsOfService(), Root: &newStore}
+	newStore.TermsOfServicePolicyStore = &ChaosLayerTermsOfServicePolicyStore{TermsOfServicePolicyStore: childStore.TermsOfServicePolicy(), Root: &newStore}
+	newStore.TokenStore = &ChaosLayerTokenStore{TokenStore: childStore.Token(), Root: &newStore}
+	newStore.UserStore = &ChaosLayerUserStore{UserStore: childStore.User(), Root: &newStore}
+	newStore.UserAccessTokenStore = &ChaosLayerUserAccessTokenStore{UserAccessTokenStore: childStore.UserAccessToken(), Root: &newStore}
+	newStore.UserPropertyStore = &ChaosLayerUserPropertyStore{UserPropertyStore: childStore.UserProperty(), Root: &newStore}
+	newStore.UserRelationshipStore = &ChaosLayerUserRelationshipStore{UserRelationshipStore: childStore.UserRelationship(), Root: &newStore}
+	newStore.UserTermsOfServiceStore = &ChaosLayerUserTermsOfServiceStore{UserTermsOfServiceStore: childStore.UserTermsOfService(), Root: &newStore}
+	newStore.WebhookStore = &ChaosLayerWebhookStore{WebhookStore: childStore.Webhook(), Root: &newStore}
+	return &newStore
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package store
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+type chaosTestPostStore struct {
+	PostStore
+	calls int
+}
+
+func (s *chaosTestPostStore) Get(id string, skipFetchThreads bool) (*model.PostList, *model.AppError) {
+	s.calls++
+	return model.NewPostList(), nil
+}
+
+func (s *chaosTestPostStore) Save(post *model.Post) (*model.Post, *model.AppError) {
+	s.calls++
+	return post, nil
+}
+
+type chaosTestChannelStore struct {
+	ChannelStore
+	calls int
+}
+
+func (s *chaosTestChannelStore) Save(channel *model.Channel, maxChannelsPerTeam int64) (*model.Channel, error) {
+	s.calls++
+	return channel, nil
+}
+
+func newChaosTestLayer(settings *ChaosSettings) (*ChaosLayer, *chaosTestPostStore, *chaosTestChannelStore) {
+	postStore := &chaosTestPostStore{}
+	channelStore := &chaosTestChannelStore{}
+
+	layer := &ChaosLayer{faults: newChaosFaults(func() ChaosSettings { return *settings })}
+	layer.PostStore = &ChaosLayerPostStore{PostStore: postStore, Root: layer}
+	layer.ChannelStore = &ChaosLayerChannelStore{ChannelStore: channelStore, Root: layer}
+	return layer, postStore, channelStore
+}
+
+func TestChaosLayerErrors(t *testing.T) {
+	settings := &ChaosSettings{Enable: true, ErrorPercent: 100}
+	layer, postStore, channelStore := newChaosTestLayer(settings)
+
+	t.Run("app errors", func(t *testing.T) {
+		list, err := layer.Post().Get("postid", false)
+		require.NotNil(t, err)
+		assert.Nil(t, list)
+		assert.Equal(t, "store.chaos_layer.fault_injected.app_error", err.Id)
+		assert.Equal(t, http.StatusServiceUnavailable, err.StatusCode)
+		assert.Equal(t, 0, postStore.calls)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		channel, err := layer.Channel().Save(&model.Channel{}, -1)
+		assert.Equal(t, ErrFaultInjected, err)
+		assert.Nil(t, channel)
+		assert.Equal(t, 0, channelStore.calls)
+	})
+
+	t.Run("restricted to some methods", func(t *testing.T) {
+		settings.Methods = []string{"Post.Save"}
+		defer func() { settings.Methods = nil }()
+
+		_, err := layer.Post().Get("postid", false)
+		require.Nil(t, err)
+
+		_, err = layer.Post().Save(&model.Post{})
+		require.NotNil(t, err)
+
+		_, nErr := layer.Channel().Save(&model.Channel{}, -1)
+		require.NoError(t, nErr)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		settings.Enable = false
+		defer func() { settings.Enable = true }()
+
+		_, err := layer.Post().Get("postid", false)
+		require.Nil(t, err)
+	})
+
+	t.Run("no errors", func(t *testing.T) {
+		settings.ErrorPercent = 0
+		defer func() { settings.ErrorPercent = 100 }()
+
+		_, err := layer.Post().Get("postid", false)
+		require.Nil(t, err)
+	})
+}
+
+func TestChaosLayerDelays(t *testing.T) {
+	settings := &ChaosSettings{Enable: true}
+	layer, _, _ := newChaosTestLayer(settings)
+
+	t.Run("latency", func(t *testing.T) {
+		settings.Latency = 20 * time.Millisecond
+		defer func() { settings.Latency = 0 }()
+
+		start := time.Now()
+		_, err := layer.Post().Get("postid", false)
+		require.Nil(t, err)
+		assert.True(t, time.Since(start) >= 20*time.Millisecond)
+	})
+
+	t.Run("replica lag", func(t *testing.T) {
+		settings.ReplicaLag = 50 * time.Millisecond
+		defer func() { settings.ReplicaLag = 0 }()
+
+		_, err := layer.Post().Save(&model.Post{})
+		require.Nil(t, err)
+
+		start := time.Now()
+		_, err = layer.Post().Get("postid", false)
+		require.Nil(t, err)
+		assert.True(t, time.Since(start) >= 40*time.Millisecond)
+
+		// Once the replica caught up, the reads are not delayed anymore.
+		start = time.Now()
+		_, err = layer.Post().Get("postid", false)
+		require.Nil(t, err)
+		assert.True(t, time.Since(start) < 40*time.Millisecond)
+	})
+}
@@ -102,3 +102,20 @@ func (e *ErrOutOfBounds) Error() string {
 func NewErrOutOfBounds(value int) *ErrOutOfBounds {
 	return &ErrOutOfBounds{value: value}
 }
+
+// ErrReadOnly indicates that a write was refused because the database is read-only.
+type ErrReadOnly struct {
+	err error
+}
+
+func NewErrReadOnly(err error) *ErrReadOnly {
+	return &ErrReadOnly{err: err}
+}
+
+func (e *ErrReadOnly) Error() string {
+	return "database is read-only: " + e.err.Error()
+}
+
+func (e *ErrReadOnly) Unwrap() error {
+	return e.err
+}
@@ -25,7 +25,7 @@ const (
 )
 
 func isError(typeName string) bool {
-	return strings.Contains(typeName, APP_ERROR_TYPE) || strings.Contains(typeName, ERROR_TYPE)
+	return typeName == APP_ERROR_TYPE || typeName == ERROR_TYPE
 }
 
 func main() {
@@ -35,6 +35,12 @@ func main() {
 	if err := buildOpenTracingLayer(); err != nil {
 		log.Fatal(err)
 	}
+	if err := buildChaosLayer(); err != nil {
+		log.Fatal(err)
+	}
+	if err := buildReadOnlyLayer(); err != nil {
+		log.Fatal(err)
+	}
 }
 
 func buildTimerLayer() error {
@@ -63,6 +69,32 @@ func buildOpenTracingLayer() error {
 	return ioutil.WriteFile(path.Join("opentracing_layer.go"), formatedCode, 0644)
 }
 
+func buildChaosLayer() error {
+	code, err := generateLayer("ChaosLayer", "chaos_layer.go.tmpl")
+	if err != nil {
+		return err
+	}
+	formatedCode, err := format.Source(code)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path.Join("chaos_layer.go"), formatedCode, 0644)
+}
+
+func buildReadOnlyLayer() error {
+	code, err := generateLayer("ReadOnlyLayer", "read_only_layer.go.tmpl")
+	if err != nil {
+		return err
+	}
+	formatedCode, err := format.Source(code)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path.Join("read_only_layer.go"), formatedCode, 0644)
+}
+
 type methodParam struct {
 	Name string
 	Type string
@@ -234,6 +266,14 @@ func generateLayer(name, templateFile string) ([]byte, error) {
 			}
 			return false
 		},
+		"errorType": func(results []string) string {
+			for _, typeName := range results {
+				if isError(typeName) {
+					return typeName
+				}
+			}
+			return ""
+		},
 		"errorVar": func(results []string) string {
 			for i, typeName := range results {
 				if isError(typeName) {
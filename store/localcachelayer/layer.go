@@ -57,6 +57,12 @@ const (
 	TEAM_CACHE_SIZE = 20000
 	TEAM_CACHE_SEC  = 30 * 60
 
+	TEAM_MEMBER_CACHE_SIZE = 50000
+	TEAM_MEMBER_CACHE_SEC  = 30 * 60
+
+	TEAM_MEMBERSHIP_CACHE_SIZE = 50000
+	TEAM_MEMBERSHIP_CACHE_SEC  = 30 * 60
+
 	CLEAR_CACHE_MESSAGE_DATA = ""
 
 	CHANNEL_CACHE_SEC = 15 * 60 // 15 mins
@@ -103,6 +109,8 @@ type LocalCacheStore struct {
 
 	team                       LocalCacheTeamStore
 	teamAllTeamIdsForUserCache cache.Cache
+	teamMemberCache            cache.Cache
+	teamMembershipCache        cache.Cache
 
 	termsOfService      LocalCacheTermsOfServiceStore
 	termsOfServiceCache cache.Cache
@@ -254,6 +262,18 @@ func NewLocalCacheLayer(baseStore store.Store, metrics einterfaces.MetricsInterf
 		DefaultExpiry:          TEAM_CACHE_SEC * time.Second,
 		InvalidateClusterEvent: model.CLUSTER_EVENT_INVALIDATE_CACHE_FOR_TEAMS,
 	})
+	localCacheStore.teamMemberCache = cacheProvider.NewCache(&cache.CacheOptions{
+		Size:                   TEAM_MEMBER_CACHE_SIZE,
+		Name:                   "TeamMember",
+		DefaultExpiry:          TEAM_MEMBER_CACHE_SEC * time.Second,
+		InvalidateClusterEvent: model.CLUSTER_EVENT_INVALIDATE_CACHE_FOR_TEAM_MEMBERS,
+	})
+	localCacheStore.teamMembershipCache = cacheProvider.NewCache(&cache.CacheOptions{
+		Size:                   TEAM_MEMBERSHIP_CACHE_SIZE,
+		Name:                   "TeamMembership",
+		DefaultExpiry:          TEAM_MEMBERSHIP_CACHE_SEC * time.Second,
+		InvalidateClusterEvent: model.CLUSTER_EVENT_INVALIDATE_CACHE_FOR_TEAM_MEMBERSHIPS,
+	})
 	localCacheStore.team = LocalCacheTeamStore{TeamStore: baseStore.Team(), rootStore: &localCacheStore}
 
 	if cluster != nil {
@@ -275,6 +295,8 @@ func NewLocalCacheLayer(baseStore store.Store, metrics einterfaces.MetricsInterf
 		cluster.RegisterClusterMessageHandler(model.CLUSTER_EVENT_INVALIDATE_CACHE_FOR_PROFILE_BY_IDS, localCacheStore.user.handleClusterInvalidateScheme)
 		cluster.RegisterClusterMessageHandler(model.CLUSTER_EVENT_INVALIDATE_CACHE_FOR_PROFILE_IN_CHANNEL, localCacheStore.user.handleClusterInvalidateProfilesInChannel)
 		cluster.RegisterClusterMessageHandler(model.CLUSTER_EVENT_INVALIDATE_CACHE_FOR_TEAMS, localCacheStore.team.handleClusterInvalidateTeam)
+		cluster.RegisterClusterMessageHandler(model.CLUSTER_EVENT_INVALIDATE_CACHE_FOR_TEAM_MEMBERS, localCacheStore.team.handleClusterInvalidateTeamMember)
+		cluster.RegisterClusterMessageHandler(model.CLUSTER_EVENT_INVALIDATE_CACHE_FOR_TEAM_MEMBERSHIPS, localCacheStore.team.handleClusterInvalidateTeamMembership)
 	}
 	return localCacheStore
 }
@@ -388,5 +410,7 @@ func (s *LocalCacheStore) Invalidate() {
 	s.doClearCacheCluster(s.userProfileByIdsCache)
 	s.doClearCacheCluster(s.profilesInChannelCache)
 	s.doClearCacheCluster(s.teamAllTeamIdsForUserCache)
+	s.doClearCacheCluster(s.teamMemberCache)
+	s.doClearCacheCluster(s.teamMembershipCache)
 	s.doClearCacheCluster(s.rolePermissionsCache)
 }
@@ -4,6 +4,7 @@
 package localcachelayer
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -145,8 +146,20 @@ func getMockStore() *mocks.Store {
 
 	fakeUserTeamIds := []string{"1", "2", "3"}
 	mockTeamStore := mocks.TeamStore{}
-	mockTeamStore.On("GetUserTeamIds", "123", true).Return(fakeUserTeamIds, nil)
-	mockTeamStore.On("GetUserTeamIds", "123", false).Return(fakeUserTeamIds, nil)
+	mockTeamStore.On("GetUserTeamIds", mock.Anything, "123", true).Return(fakeUserTeamIds, nil)
+	mockTeamStore.On("GetUserTeamIds", mock.Anything, "123", false).Return(fakeUserTeamIds, nil)
+
+	fakeTeamMember := model.TeamMember{TeamId: "team1", UserId: "123", Roles: "team_user"}
+	mockTeamStore.On("GetMember", mock.Anything, "team1", "123", true).Return(&fakeTeamMember, nil)
+	mockTeamStore.On("GetMember", mock.Anything, "team1", "123", false).Return(&fakeTeamMember, nil)
+	mockTeamStore.On("UpdateMember", mock.Anything, &fakeTeamMember).Return(&fakeTeamMember, nil)
+	mockTeamStore.On("RemoveMember", mock.Anything, "team1", "123").Return(nil)
+	mockTeamStore.On("UserBelongsToTeam", mock.Anything, "123", "team1", true).Return(true, nil)
+	mockTeamStore.On("UserBelongsToTeam", mock.Anything, "123", "team1", false).Return(true, nil)
+	mockTeamStore.On("RemoveAllMembersByUser", mock.Anything, "123").Return([]string{"team1"}, nil)
+	fakeTeam := model.Team{Id: "team1", SchemeId: model.NewString("scheme1")}
+	mockTeamStore.On("Get", mock.Anything, "team1").Return(&fakeTeam, nil)
+	mockTeamStore.On("Update", mock.Anything, mock.AnythingOfType("*model.Team")).Return(func(_ context.Context, team *model.Team) *model.Team { return team }, nil)
 	mockStore.On("Team").Return(&mockTeamStore)
 
 	return &mockStore
@@ -48,6 +48,7 @@ func (s LocalCacheSchemeStore) Delete(schemeId string) (*model.Scheme, error) {
 	defer s.rootStore.doInvalidateCacheCluster(s.rootStore.schemeCache, schemeId)
 	defer s.rootStore.doClearCacheCluster(s.rootStore.roleCache)
 	defer s.rootStore.doClearCacheCluster(s.rootStore.rolePermissionsCache)
+	defer s.rootStore.doClearCacheCluster(s.rootStore.teamMemberCache)
 	return s.SchemeStore.Delete(schemeId)
 }
 
@@ -55,5 +56,6 @@ func (s LocalCacheSchemeStore) PermanentDeleteAll() error {
 	defer s.rootStore.doClearCacheCluster(s.rootStore.schemeCache)
 	defer s.rootStore.doClearCacheCluster(s.rootStore.roleCache)
 	defer s.rootStore.doClearCacheCluster(s.rootStore.rolePermissionsCache)
+	defer s.rootStore.doClearCacheCluster(s.rootStore.teamMemberCache)
 	return s.SchemeStore.PermanentDeleteAll()
 }
@@ -4,6 +4,8 @@
 package localcachelayer
 
 import (
+	"context"
+
 	"github.com/mattermost/mattermost-server/v5/model"
 	"github.com/mattermost/mattermost-server/v5/store"
 )
@@ -21,8 +23,76 @@ func (s *LocalCacheTeamStore) handleClusterInvalidateTeam(msg *model.ClusterMess
 	}
 }
 
+func (s *LocalCacheTeamStore) handleClusterInvalidateTeamMember(msg *model.ClusterMessage) {
+	if msg.Data == CLEAR_CACHE_MESSAGE_DATA {
+		s.rootStore.teamMemberCache.Purge()
+	} else {
+		s.rootStore.teamMemberCache.Remove(msg.Data)
+	}
+}
+
+func (s *LocalCacheTeamStore) handleClusterInvalidateTeamMembership(msg *model.ClusterMessage) {
+	if msg.Data == CLEAR_CACHE_MESSAGE_DATA {
+		s.rootStore.teamMembershipCache.Purge()
+	} else {
+		s.rootStore.teamMembershipCache.Remove(msg.Data)
+	}
+}
+
 func (s LocalCacheTeamStore) ClearCaches() {
 	s.rootStore.teamAllTeamIdsForUserCache.Purge()
+	s.rootStore.teamMemberCache.Purge()
+	s.rootStore.teamMembershipCache.Purge()
+	if s.rootStore.metrics != nil {
+		s.rootStore.metrics.IncrementMemCacheInvalidationCounter("All Team Ids for User - Purge")
+		s.rootStore.metrics.IncrementMemCacheInvalidationCounter("Team Member - Purge")
+		s.rootStore.metrics.IncrementMemCacheInvalidationCounter("Team Membership - Purge")
+	}
+}
+
+func teamMemberCacheKey(teamId, userId string) string {
+	return teamId + userId
+}
+
+// invalidateMember drops the cached member along with its cached membership and the cached team
+// ids of its user, since saving, updating or removing a member can change the teams the user
+// belongs to.
+func (s LocalCacheTeamStore) invalidateMember(teamId, userId string) {
+	s.rootStore.doInvalidateCacheCluster(s.rootStore.teamMemberCache, teamMemberCacheKey(teamId, userId))
+	s.rootStore.doInvalidateCacheCluster(s.rootStore.teamMembershipCache, teamMemberCacheKey(teamId, userId))
+	if s.rootStore.metrics != nil {
+		s.rootStore.metrics.IncrementMemCacheInvalidationCounter("Team Member - Remove by TeamId and UserId")
+		s.rootStore.metrics.IncrementMemCacheInvalidationCounter("Team Membership - Remove by TeamId and UserId")
+	}
+	s.InvalidateAllTeamIdsForUser(userId)
+}
+
+func (s LocalCacheTeamStore) invalidateMembers(members []*model.TeamMember) {
+	for _, member := range members {
+		s.invalidateMember(member.TeamId, member.UserId)
+	}
+}
+
+// clearMembers drops every cached team member, for the changes touching the members of a whole
+// team, of a user in every team, or the roles given by the schemes of the teams.
+func (s LocalCacheTeamStore) clearMembers() {
+	s.rootStore.doClearCacheCluster(s.rootStore.teamMemberCache)
+	if s.rootStore.metrics != nil {
+		s.rootStore.metrics.IncrementMemCacheInvalidationCounter("Team Member - Purge")
+	}
+}
+
+// clearMemberships drops every cached membership, for the changes removing the members of a whole
+// team.
+func (s LocalCacheTeamStore) clearMemberships() {
+	s.rootStore.doClearCacheCluster(s.rootStore.teamMembershipCache)
+	if s.rootStore.metrics != nil {
+		s.rootStore.metrics.IncrementMemCacheInvalidationCounter("Team Membership - Purge")
+	}
+}
+
+func (s LocalCacheTeamStore) clearAllTeamIdsForUsers() {
+	s.rootStore.doClearCacheCluster(s.rootStore.teamAllTeamIdsForUserCache)
 	if s.rootStore.metrics != nil {
 		s.rootStore.metrics.IncrementMemCacheInvalidationCounter("All Team Ids for User - Purge")
 	}
@@ -35,9 +105,9 @@ func (s LocalCacheTeamStore) InvalidateAllTeamIdsForUser(userId string) {
 	}
 }
 
-func (s LocalCacheTeamStore) GetUserTeamIds(userID string, allowFromCache bool) ([]string, *model.AppError) {
+func (s LocalCacheTeamStore) GetUserTeamIds(ctx context.Context, userID string, allowFromCache bool) ([]string, *model.AppError) {
 	if !allowFromCache {
-		return s.TeamStore.GetUserTeamIds(userID, allowFromCache)
+		return s.TeamStore.GetUserTeamIds(ctx, userID, allowFromCache)
 	}
 
 	var userTeamIds []string
@@ -45,7 +115,7 @@ func (s LocalCacheTeamStore) GetUserTeamIds(userID string, allowFromCache bool)
 		return userTeamIds, nil
 	}
 
-	userTeamIds, err := s.TeamStore.GetUserTeamIds(userID, allowFromCache)
+	userTeamIds, err := s.TeamStore.GetUserTeamIds(ctx, userID, allowFromCache)
 	if err != nil {
 		return nil, err
 	}
@@ -57,25 +127,171 @@ func (s LocalCacheTeamStore) GetUserTeamIds(userID string, allowFromCache bool)
 	return userTeamIds, nil
 }
 
-func (s LocalCacheTeamStore) Update(team *model.Team) (*model.Team, *model.AppError) {
-	var oldTeam *model.Team
-	var err *model.AppError
-	if team.DeleteAt != 0 {
-		oldTeam, err = s.TeamStore.Get(team.Id)
-		if err != nil {
-			return nil, err
+func (s LocalCacheTeamStore) UserBelongsToTeam(ctx context.Context, userId string, teamId string, allowFromCache bool) (bool, *model.AppError) {
+	if !allowFromCache {
+		return s.TeamStore.UserBelongsToTeam(ctx, userId, teamId, allowFromCache)
+	}
+
+	key := teamMemberCacheKey(teamId, userId)
+
+	var belongs bool
+	if err := s.rootStore.doStandardReadCache(s.rootStore.teamMembershipCache, key, &belongs); err == nil {
+		return belongs, nil
+	}
+
+	belongs, err := s.TeamStore.UserBelongsToTeam(ctx, userId, teamId, allowFromCache)
+	if err != nil {
+		return false, err
+	}
+
+	s.rootStore.doStandardAddToCache(s.rootStore.teamMembershipCache, key, belongs)
+
+	return belongs, nil
+}
+
+func (s LocalCacheTeamStore) GetMember(ctx context.Context, teamId string, userId string, allowFromCache bool) (*model.TeamMember, error) {
+	if !allowFromCache {
+		return s.TeamStore.GetMember(ctx, teamId, userId, allowFromCache)
+	}
+
+	key := teamMemberCacheKey(teamId, userId)
+
+	var member *model.TeamMember
+	if err := s.rootStore.doStandardReadCache(s.rootStore.teamMemberCache, key, &member); err == nil {
+		return member, nil
+	}
+
+	member, err := s.TeamStore.GetMember(ctx, teamId, userId, allowFromCache)
+	if err != nil {
+		return nil, err
+	}
+
+	s.rootStore.doStandardAddToCache(s.rootStore.teamMemberCache, key, member)
+
+	return member, nil
+}
+
+func (s LocalCacheTeamStore) SaveMember(ctx context.Context, member *model.TeamMember, maxUsersPerTeam int) (*model.TeamMember, error) {
+	defer s.invalidateMember(member.TeamId, member.UserId)
+	return s.TeamStore.SaveMember(ctx, member, maxUsersPerTeam)
+}
+
+func (s LocalCacheTeamStore) SaveMultipleMembers(ctx context.Context, members []*model.TeamMember, maxUsersPerTeam int) ([]*model.TeamMember, error) {
+	defer s.invalidateMembers(members)
+	return s.TeamStore.SaveMultipleMembers(ctx, members, maxUsersPerTeam)
+}
+
+func (s LocalCacheTeamStore) UpdateMember(ctx context.Context, member *model.TeamMember) (*model.TeamMember, *model.AppError) {
+	defer s.invalidateMember(member.TeamId, member.UserId)
+	return s.TeamStore.UpdateMember(ctx, member)
+}
+
+func (s LocalCacheTeamStore) UpdateMultipleMembers(ctx context.Context, members []*model.TeamMember) ([]*model.TeamMember, *model.AppError) {
+	defer s.invalidateMembers(members)
+	return s.TeamStore.UpdateMultipleMembers(ctx, members)
+}
+
+func (s LocalCacheTeamStore) RemoveMember(ctx context.Context, teamId string, userId string) *model.AppError {
+	defer s.invalidateMember(teamId, userId)
+	return s.TeamStore.RemoveMember(ctx, teamId, userId)
+}
+
+func (s LocalCacheTeamStore) RemoveMembers(ctx context.Context, teamId string, userIds []string) *model.AppError {
+	defer func() {
+		for _, userId := range userIds {
+			s.invalidateMember(teamId, userId)
 		}
+	}()
+	return s.TeamStore.RemoveMembers(ctx, teamId, userIds)
+}
+
+func (s LocalCacheTeamStore) RemoveAllMembersByTeam(ctx context.Context, teamId string) *model.AppError {
+	defer s.clearAllTeamIdsForUsers()
+	defer s.clearMemberships()
+	defer s.clearMembers()
+	return s.TeamStore.RemoveAllMembersByTeam(ctx, teamId)
+}
+
+func (s LocalCacheTeamStore) RemoveAllMembersByUser(ctx context.Context, userId string) ([]string, *model.AppError) {
+	teamIds, err := s.TeamStore.RemoveAllMembersByUser(ctx, userId)
+	for _, teamId := range teamIds {
+		s.invalidateMember(teamId, userId)
 	}
+	s.InvalidateAllTeamIdsForUser(userId)
+	return teamIds, err
+}
+
+func (s LocalCacheTeamStore) PermanentDelete(ctx context.Context, teamId string) *model.AppError {
+	defer s.clearAllTeamIdsForUsers()
+	defer s.clearMemberships()
+	defer s.clearMembers()
+	return s.TeamStore.PermanentDelete(ctx, teamId)
+}
 
-	tm, err := s.TeamStore.Update(team)
+func (s LocalCacheTeamStore) MigrateTeamMembers(ctx context.Context, fromTeamId string, fromUserId string, batchSize int) (map[string]string, int64, *model.AppError) {
+	defer s.clearAllTeamIdsForUsers()
+	defer s.clearMembers()
+	return s.TeamStore.MigrateTeamMembers(ctx, fromTeamId, fromUserId, batchSize)
+}
+
+func (s LocalCacheTeamStore) ResetAllTeamSchemes(ctx context.Context) *model.AppError {
+	defer s.clearMembers()
+	return s.TeamStore.ResetAllTeamSchemes(ctx)
+}
+
+func (s LocalCacheTeamStore) ClearAllCustomRoleAssignments(ctx context.Context) *model.AppError {
+	defer s.clearMembers()
+	return s.TeamStore.ClearAllCustomRoleAssignments(ctx)
+}
+
+func (s LocalCacheTeamStore) UpdateMembersRole(ctx context.Context, teamID string, userIDs []string) *model.AppError {
+	defer s.clearMembers()
+	return s.TeamStore.UpdateMembersRole(ctx, teamID, userIDs)
+}
+
+func (s LocalCacheTeamStore) UpdateMembersRoleAndGetUpdated(ctx context.Context, teamID string, userIDs []string) ([]*model.TeamMember, *model.AppError) {
+	defer s.clearMembers()
+	return s.TeamStore.UpdateMembersRoleAndGetUpdated(ctx, teamID, userIDs)
+}
+
+func (s LocalCacheTeamStore) Update(ctx context.Context, team *model.Team) (*model.Team, error) {
+	oldTeam, err := s.TeamStore.Get(ctx, team.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	tm, err := s.TeamStore.Update(ctx, team)
 	if err != nil {
 		return nil, err
 	}
 	defer s.rootStore.doClearCacheCluster(s.rootStore.rolePermissionsCache)
 
-	if oldTeam != nil && oldTeam.DeleteAt == 0 {
-		s.rootStore.doClearCacheCluster(s.rootStore.teamAllTeamIdsForUserCache)
+	// The roles of the cached members depend on the scheme of the team.
+	if teamSchemeId(oldTeam) != teamSchemeId(tm) {
+		defer s.clearMembers()
+	}
+
+	if tm.DeleteAt != 0 && oldTeam.DeleteAt == 0 {
+		s.clearAllTeamIdsForUsers()
 	}
 
 	return tm, err
 }
+
+func teamSchemeId(team *model.Team) string {
+	if team.SchemeId == nil {
+		return ""
+	}
+	return *team.SchemeId
+}
+
+func (s LocalCacheTeamStore) Restore(ctx context.Context, teamId string) error {
+	if err := s.TeamStore.Restore(ctx, teamId); err != nil {
+		return err
+	}
+
+	s.rootStore.doClearCacheCluster(s.rootStore.rolePermissionsCache)
+	s.clearAllTeamIdsForUsers()
+
+	return nil
+}
@@ -4,8 +4,10 @@
 package localcachelayer
 
 import (
+	"context"
 	"testing"
 
+	"github.com/mattermost/mattermost-server/v5/model"
 	"github.com/mattermost/mattermost-server/v5/store/storetest"
 	"github.com/mattermost/mattermost-server/v5/store/storetest/mocks"
 	"github.com/stretchr/testify/assert"
@@ -25,12 +27,12 @@ func TestTeamStoreCache(t *testing.T) {
 		mockCacheProvider := getMockCacheProvider()
 		cachedStore := NewLocalCacheLayer(mockStore, nil, nil, mockCacheProvider)
 
-		gotUserTeamIds, err := cachedStore.Team().GetUserTeamIds(fakeUserId, true)
+		gotUserTeamIds, err := cachedStore.Team().GetUserTeamIds(context.Background(), fakeUserId, true)
 		require.Nil(t, err)
 		assert.Equal(t, fakeUserTeamIds, gotUserTeamIds)
 		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "GetUserTeamIds", 1)
 
-		gotUserTeamIds, err = cachedStore.Team().GetUserTeamIds(fakeUserId, true)
+		gotUserTeamIds, err = cachedStore.Team().GetUserTeamIds(context.Background(), fakeUserId, true)
 		require.Nil(t, err)
 		assert.Equal(t, fakeUserTeamIds, gotUserTeamIds)
 		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "GetUserTeamIds", 1)
@@ -41,12 +43,12 @@ func TestTeamStoreCache(t *testing.T) {
 		mockCacheProvider := getMockCacheProvider()
 		cachedStore := NewLocalCacheLayer(mockStore, nil, nil, mockCacheProvider)
 
-		gotUserTeamIds, err := cachedStore.Team().GetUserTeamIds(fakeUserId, true)
+		gotUserTeamIds, err := cachedStore.Team().GetUserTeamIds(context.Background(), fakeUserId, true)
 		require.Nil(t, err)
 		assert.Equal(t, fakeUserTeamIds, gotUserTeamIds)
 		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "GetUserTeamIds", 1)
 
-		gotUserTeamIds, err = cachedStore.Team().GetUserTeamIds(fakeUserId, false)
+		gotUserTeamIds, err = cachedStore.Team().GetUserTeamIds(context.Background(), fakeUserId, false)
 		require.Nil(t, err)
 		assert.Equal(t, fakeUserTeamIds, gotUserTeamIds)
 		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "GetUserTeamIds", 2)
@@ -57,17 +59,233 @@ func TestTeamStoreCache(t *testing.T) {
 		mockCacheProvider := getMockCacheProvider()
 		cachedStore := NewLocalCacheLayer(mockStore, nil, nil, mockCacheProvider)
 
-		gotUserTeamIds, err := cachedStore.Team().GetUserTeamIds(fakeUserId, true)
+		gotUserTeamIds, err := cachedStore.Team().GetUserTeamIds(context.Background(), fakeUserId, true)
 		require.Nil(t, err)
 		assert.Equal(t, fakeUserTeamIds, gotUserTeamIds)
 		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "GetUserTeamIds", 1)
 
 		cachedStore.Team().InvalidateAllTeamIdsForUser(fakeUserId)
 
-		gotUserTeamIds, err = cachedStore.Team().GetUserTeamIds(fakeUserId, true)
+		gotUserTeamIds, err = cachedStore.Team().GetUserTeamIds(context.Background(), fakeUserId, true)
 		require.Nil(t, err)
 		assert.Equal(t, fakeUserTeamIds, gotUserTeamIds)
 		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "GetUserTeamIds", 2)
 	})
 
+	t.Run("first call not cached, clear caches, and then not cached again", func(t *testing.T) {
+		mockStore := getMockStore()
+		mockCacheProvider := getMockCacheProvider()
+		cachedStore := NewLocalCacheLayer(mockStore, nil, nil, mockCacheProvider)
+
+		_, err := cachedStore.Team().GetUserTeamIds(context.Background(), fakeUserId, true)
+		require.Nil(t, err)
+		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "GetUserTeamIds", 1)
+
+		cachedStore.Team().ClearCaches()
+
+		_, err = cachedStore.Team().GetUserTeamIds(context.Background(), fakeUserId, true)
+		require.Nil(t, err)
+		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "GetUserTeamIds", 2)
+	})
+
+	t.Run("first call not cached, remove member, and then not cached again", func(t *testing.T) {
+		mockStore := getMockStore()
+		mockCacheProvider := getMockCacheProvider()
+		cachedStore := NewLocalCacheLayer(mockStore, nil, nil, mockCacheProvider)
+
+		_, err := cachedStore.Team().GetUserTeamIds(context.Background(), fakeUserId, true)
+		require.Nil(t, err)
+		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "GetUserTeamIds", 1)
+
+		appErr := cachedStore.Team().RemoveMember(context.Background(), "team1", fakeUserId)
+		require.Nil(t, appErr)
+
+		_, err = cachedStore.Team().GetUserTeamIds(context.Background(), fakeUserId, true)
+		require.Nil(t, err)
+		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "GetUserTeamIds", 2)
+	})
+}
+
+func TestTeamStoreMemberCache(t *testing.T) {
+	fakeTeamMember := model.TeamMember{TeamId: "team1", UserId: "123", Roles: "team_user"}
+
+	t.Run("first call not cached, second cached and returning same data", func(t *testing.T) {
+		mockStore := getMockStore()
+		mockCacheProvider := getMockCacheProvider()
+		cachedStore := NewLocalCacheLayer(mockStore, nil, nil, mockCacheProvider)
+
+		member, err := cachedStore.Team().GetMember(context.Background(), "team1", "123", true)
+		require.Nil(t, err)
+		assert.Equal(t, &fakeTeamMember, member)
+		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "GetMember", 1)
+
+		member, err = cachedStore.Team().GetMember(context.Background(), "team1", "123", true)
+		require.Nil(t, err)
+		assert.Equal(t, &fakeTeamMember, member)
+		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "GetMember", 1)
+	})
+
+	t.Run("first call not cached, second force not cached", func(t *testing.T) {
+		mockStore := getMockStore()
+		mockCacheProvider := getMockCacheProvider()
+		cachedStore := NewLocalCacheLayer(mockStore, nil, nil, mockCacheProvider)
+
+		_, err := cachedStore.Team().GetMember(context.Background(), "team1", "123", true)
+		require.Nil(t, err)
+		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "GetMember", 1)
+
+		_, err = cachedStore.Team().GetMember(context.Background(), "team1", "123", false)
+		require.Nil(t, err)
+		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "GetMember", 2)
+	})
+
+	t.Run("first call not cached, update member, and then not cached again", func(t *testing.T) {
+		mockStore := getMockStore()
+		mockCacheProvider := getMockCacheProvider()
+		cachedStore := NewLocalCacheLayer(mockStore, nil, nil, mockCacheProvider)
+
+		_, err := cachedStore.Team().GetMember(context.Background(), "team1", "123", true)
+		require.Nil(t, err)
+		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "GetMember", 1)
+
+		_, appErr := cachedStore.Team().UpdateMember(context.Background(), &fakeTeamMember)
+		require.Nil(t, appErr)
+
+		_, err = cachedStore.Team().GetMember(context.Background(), "team1", "123", true)
+		require.Nil(t, err)
+		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "GetMember", 2)
+	})
+
+	t.Run("first call not cached, update team without changing its scheme, and then cached", func(t *testing.T) {
+		mockStore := getMockStore()
+		mockCacheProvider := getMockCacheProvider()
+		cachedStore := NewLocalCacheLayer(mockStore, nil, nil, mockCacheProvider)
+
+		_, err := cachedStore.Team().GetMember(context.Background(), "team1", "123", true)
+		require.Nil(t, err)
+		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "GetMember", 1)
+
+		_, err = cachedStore.Team().Update(context.Background(), &model.Team{Id: "team1", SchemeId: model.NewString("scheme1")})
+		require.Nil(t, err)
+
+		_, err = cachedStore.Team().GetMember(context.Background(), "team1", "123", true)
+		require.Nil(t, err)
+		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "GetMember", 1)
+	})
+
+	t.Run("first call not cached, change the scheme of the team, and then not cached again", func(t *testing.T) {
+		mockStore := getMockStore()
+		mockCacheProvider := getMockCacheProvider()
+		cachedStore := NewLocalCacheLayer(mockStore, nil, nil, mockCacheProvider)
+
+		_, err := cachedStore.Team().GetMember(context.Background(), "team1", "123", true)
+		require.Nil(t, err)
+		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "GetMember", 1)
+
+		_, err = cachedStore.Team().Update(context.Background(), &model.Team{Id: "team1", SchemeId: model.NewString("scheme2")})
+		require.Nil(t, err)
+
+		_, err = cachedStore.Team().GetMember(context.Background(), "team1", "123", true)
+		require.Nil(t, err)
+		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "GetMember", 2)
+	})
+
+	t.Run("first call not cached, clear caches, and then not cached again", func(t *testing.T) {
+		mockStore := getMockStore()
+		mockCacheProvider := getMockCacheProvider()
+		cachedStore := NewLocalCacheLayer(mockStore, nil, nil, mockCacheProvider)
+
+		_, err := cachedStore.Team().GetMember(context.Background(), "team1", "123", true)
+		require.Nil(t, err)
+		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "GetMember", 1)
+
+		cachedStore.Team().ClearCaches()
+
+		_, err = cachedStore.Team().GetMember(context.Background(), "team1", "123", true)
+		require.Nil(t, err)
+		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "GetMember", 2)
+	})
+}
+
+func TestTeamStoreMembershipCache(t *testing.T) {
+	t.Run("first call not cached, second cached and returning same data", func(t *testing.T) {
+		mockStore := getMockStore()
+		mockCacheProvider := getMockCacheProvider()
+		cachedStore := NewLocalCacheLayer(mockStore, nil, nil, mockCacheProvider)
+
+		belongs, err := cachedStore.Team().UserBelongsToTeam(context.Background(), "123", "team1", true)
+		require.Nil(t, err)
+		assert.True(t, belongs)
+		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "UserBelongsToTeam", 1)
+
+		belongs, err = cachedStore.Team().UserBelongsToTeam(context.Background(), "123", "team1", true)
+		require.Nil(t, err)
+		assert.True(t, belongs)
+		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "UserBelongsToTeam", 1)
+	})
+
+	t.Run("first call not cached, second force not cached", func(t *testing.T) {
+		mockStore := getMockStore()
+		mockCacheProvider := getMockCacheProvider()
+		cachedStore := NewLocalCacheLayer(mockStore, nil, nil, mockCacheProvider)
+
+		_, err := cachedStore.Team().UserBelongsToTeam(context.Background(), "123", "team1", true)
+		require.Nil(t, err)
+		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "UserBelongsToTeam", 1)
+
+		_, err = cachedStore.Team().UserBelongsToTeam(context.Background(), "123", "team1", false)
+		require.Nil(t, err)
+		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "UserBelongsToTeam", 2)
+	})
+
+	t.Run("first call not cached, remove member, and then not cached again", func(t *testing.T) {
+		mockStore := getMockStore()
+		mockCacheProvider := getMockCacheProvider()
+		cachedStore := NewLocalCacheLayer(mockStore, nil, nil, mockCacheProvider)
+
+		_, err := cachedStore.Team().UserBelongsToTeam(context.Background(), "123", "team1", true)
+		require.Nil(t, err)
+		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "UserBelongsToTeam", 1)
+
+		appErr := cachedStore.Team().RemoveMember(context.Background(), "team1", "123")
+		require.Nil(t, appErr)
+
+		_, err = cachedStore.Team().UserBelongsToTeam(context.Background(), "123", "team1", true)
+		require.Nil(t, err)
+		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "UserBelongsToTeam", 2)
+	})
+
+	t.Run("first call not cached, remove user from all teams, and then not cached again", func(t *testing.T) {
+		mockStore := getMockStore()
+		mockCacheProvider := getMockCacheProvider()
+		cachedStore := NewLocalCacheLayer(mockStore, nil, nil, mockCacheProvider)
+
+		_, err := cachedStore.Team().UserBelongsToTeam(context.Background(), "123", "team1", true)
+		require.Nil(t, err)
+		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "UserBelongsToTeam", 1)
+
+		teamIds, err := cachedStore.Team().RemoveAllMembersByUser(context.Background(), "123")
+		require.Nil(t, err)
+		assert.Equal(t, []string{"team1"}, teamIds)
+
+		_, err = cachedStore.Team().UserBelongsToTeam(context.Background(), "123", "team1", true)
+		require.Nil(t, err)
+		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "UserBelongsToTeam", 2)
+	})
+
+	t.Run("first call not cached, clear caches, and then not cached again", func(t *testing.T) {
+		mockStore := getMockStore()
+		mockCacheProvider := getMockCacheProvider()
+		cachedStore := NewLocalCacheLayer(mockStore, nil, nil, mockCacheProvider)
+
+		_, err := cachedStore.Team().UserBelongsToTeam(context.Background(), "123", "team1", true)
+		require.Nil(t, err)
+		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "UserBelongsToTeam", 1)
+
+		cachedStore.Team().ClearCaches()
+
+		_, err = cachedStore.Team().UserBelongsToTeam(context.Background(), "123", "team1", true)
+		require.Nil(t, err)
+		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "UserBelongsToTeam", 2)
+	})
 }
@@ -166,3 +166,22 @@ func deepCopyUserMap(users map[string]*model.User) map[string]*model.User {
 
 	return copyOfUsers
 }
+
+// PromoteGuestToUser and DemoteUserToGuest change the team members of the user along with the user.
+func (s LocalCacheUserStore) PromoteGuestToUser(userId string) *model.AppError {
+	defer s.rootStore.doClearCacheCluster(s.rootStore.teamMemberCache)
+	return s.UserStore.PromoteGuestToUser(userId)
+}
+
+func (s LocalCacheUserStore) DemoteUserToGuest(userId string) *model.AppError {
+	defer s.rootStore.doClearCacheCluster(s.rootStore.teamMemberCache)
+	return s.UserStore.DemoteUserToGuest(userId)
+}
+
+// PermanentDelete deletes the team members of the user along with the user.
+func (s LocalCacheUserStore) PermanentDelete(userId string) *model.AppError {
+	defer s.rootStore.team.InvalidateAllTeamIdsForUser(userId)
+	defer s.rootStore.team.clearMemberships()
+	defer s.rootStore.team.clearMembers()
+	return s.UserStore.PermanentDelete(userId)
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package store
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+)
+
+const (
+	mySQLOptionPreventsStatementCode = uint16(1290)
+	mySQLReadOnlyTransactionCode     = uint16(1792)
+	mySQLReadOnlyModeCode            = uint16(1836)
+	postgresReadOnlyTransactionCode  = "25006"
+)
+
+// readOnlyErrorMessages are matched against the errors the driver errors were flattened into, as
+// most of the store methods only keep the message of the error they got.
+var readOnlyErrorMessages = []string{
+	"--read-only option",
+	"--super-read-only option",
+	"read-only transaction",
+	"read only transaction",
+	"while in read-only mode",
+}
+
+// IsReadOnlyError reports whether err was caused by a write the database refused because it's
+// read-only, as it briefly is during a failover.
+func IsReadOnlyError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		switch mysqlErr.Number {
+		case mySQLReadOnlyTransactionCode, mySQLReadOnlyModeCode:
+			return true
+		case mySQLOptionPreventsStatementCode:
+			return strings.Contains(mysqlErr.Message, "read-only")
+		}
+		return false
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return pqErr.Code == postgresReadOnlyTransactionCode
+	}
+
+	var readOnlyErr *ErrReadOnly
+	if errors.As(err, &readOnlyErr) {
+		return true
+	}
+
+	message := err.Error()
+	for _, readOnlyMessage := range readOnlyErrorMessages {
+		if strings.Contains(message, readOnlyMessage) {
+			return true
+		}
+	}
+	return false
+}
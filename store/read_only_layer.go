@@ -0,0 +1,6661 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Code generated by "make store-layers"
+// DO NOT EDIT
+
+package store
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+type ReadOnlyLayer struct {
+	Store
+	OnReadOnly                func(err error)
+	AuditStore                AuditStore
+	BotStore                  BotStore
+	ChannelStore              ChannelStore
+	ChannelBookmarkStore      ChannelBookmarkStore
+	ChannelChangeStore        ChannelChangeStore
+	ChannelGuestLinkStore     ChannelGuestLinkStore
+	ChannelIntegrationStore   ChannelIntegrationStore
+	ChannelMemberHistoryStore ChannelMemberHistoryStore
+	ClusterDiscoveryStore     ClusterDiscoveryStore
+	CommandStore              CommandStore
+	CommandWebhookStore       CommandWebhookStore
+	ComplianceStore           ComplianceStore
+	EmailVerificationStore    EmailVerificationStore
+	EmojiStore                EmojiStore
+	EventStreamStore          EventStreamStore
+	FileInfoStore             FileInfoStore
+	GroupStore                GroupStore
+	IntegrationUsageStore     IntegrationUsageStore
+	JobStore                  JobStore
+	LicenseStore              LicenseStore
+	LinkMetadataStore         LinkMetadataStore
+	LoginHistoryStore         LoginHistoryStore
+	OAuthStore                OAuthStore
+	OutboxStore               OutboxStore
+	PendingPinStore           PendingPinStore
+	PluginStore               PluginStore
+	PostStore                 PostStore
+	PostAcknowledgementStore  PostAcknowledgementStore
+	PostArchiveStore          PostArchiveStore
+	PostIntegrityStore        PostIntegrityStore
+	PostsPartitionStore       PostsPartitionStore
+	PreferenceStore           PreferenceStore
+	PresenceWebhookStore      PresenceWebhookStore
+	ReactionStore             ReactionStore
+	RetentionPolicyStore      RetentionPolicyStore
+	RoleStore                 RoleStore
+	SchemeStore               SchemeStore
+	SessionStore              SessionStore
+	SlugHistoryStore          SlugHistoryStore
+	StatusStore               StatusStore
+	StatusAutomationStore     StatusAutomationStore
+	SystemStore               SystemStore
+	TeamStore                 TeamStore
+	TeamInviteLinkStore       TeamInviteLinkStore
+	TeamMemberHistoryStore    TeamMemberHistoryStore
+	TermsOfServiceStore       TermsOfServiceStore
+	TermsOfServicePolicyStore TermsOfServicePolicyStore
+	TokenStore                TokenStore
+	UserStore                 UserStore
+	UserAccessTokenStore      UserAccessTokenStore
+	UserPropertyStore         UserPropertyStore
+	UserRelationshipStore     UserRelationshipStore
+	UserTermsOfServiceStore   UserTermsOfServiceStore
+	WebhookStore              WebhookStore
+}
+
+func (s *ReadOnlyLayer) Audit() AuditStore {
+	return s.AuditStore
+}
+
+func (s *ReadOnlyLayer) Bot() BotStore {
+	return s.BotStore
+}
+
+func (s *ReadOnlyLayer) Channel() ChannelStore {
+	return s.ChannelStore
+}
+
+func (s *ReadOnlyLayer) ChannelBookmark() ChannelBookmarkStore {
+	return s.ChannelBookmarkStore
+}
+
+func (s *ReadOnlyLayer) ChannelChange() ChannelChangeStore {
+	return s.ChannelChangeStore
+}
+
+func (s *ReadOnlyLayer) ChannelGuestLink() ChannelGuestLinkStore {
+	return s.ChannelGuestLinkStore
+}
+
+func (s *ReadOnlyLayer) ChannelIntegration() ChannelIntegrationStore {
+	return s.ChannelIntegrationStore
+}
+
+func (s *ReadOnlyLayer) ChannelMemberHistory() ChannelMemberHistoryStore {
+	return s.ChannelMemberHistoryStore
+}
+
+func (s *ReadOnlyLayer) ClusterDiscovery() ClusterDiscoveryStore {
+	return s.ClusterDiscoveryStore
+}
+
+func (s *ReadOnlyLayer) Command() CommandStore {
+	return s.CommandStore
+}
+
+func (s *ReadOnlyLayer) CommandWebhook() CommandWebhookStore {
+	return s.CommandWebhookStore
+}
+
+func (s *ReadOnlyLayer) Compliance() ComplianceStore {
+	return s.ComplianceStore
+}
+
+func (s *ReadOnlyLayer) EmailVerification() EmailVerificationStore {
+	return s.EmailVerificationStore
+}
+
+func (s *ReadOnlyLayer) Emoji() EmojiStore {
+	return s.EmojiStore
+}
+
+func (s *ReadOnlyLayer) EventStream() EventStreamStore {
+	return s.EventStreamStore
+}
+
+func (s *ReadOnlyLayer) FileInfo() FileInfoStore {
+	return s.FileInfoStore
+}
+
+func (s *ReadOnlyLayer) Group() GroupStore {
+	return s.GroupStore
+}
+
+func (s *ReadOnlyLayer) IntegrationUsage() IntegrationUsageStore {
+	return s.IntegrationUsageStore
+}
+
+func (s *ReadOnlyLayer) Job() JobStore {
+	return s.JobStore
+}
+
+func (s *ReadOnlyLayer) License() LicenseStore {
+	return s.LicenseStore
+}
+
+func (s *ReadOnlyLayer) LinkMetadata() LinkMetadataStore {
+	return s.LinkMetadataStore
+}
+
+func (s *ReadOnlyLayer) LoginHistory() LoginHistoryStore {
+	return s.LoginHistoryStore
+}
+
+func (s *ReadOnlyLayer) OAuth() OAuthStore {
+	return s.OAuthStore
+}
+
+func (s *ReadOnlyLayer) Outbox() OutboxStore {
+	return s.OutboxStore
+}
+
+func (s *ReadOnlyLayer) PendingPin() PendingPinStore {
+	return s.PendingPinStore
+}
+
+func (s *ReadOnlyLayer) Plugin() PluginStore {
+	return s.PluginStore
+}
+
+func (s *ReadOnlyLayer) Post() PostStore {
+	return s.PostStore
+}
+
+func (s *ReadOnlyLayer) PostAcknowledgement() PostAcknowledgementStore {
+	return s.PostAcknowledgementStore
+}
+
+func (s *ReadOnlyLayer) PostArchive() PostArchiveStore {
+	return s.PostArchiveStore
+}
+
+func (s *ReadOnlyLayer) PostIntegrity() PostIntegrityStore {
+	return s.PostIntegrityStore
+}
+
+func (s *ReadOnlyLayer) PostsPartition() PostsPartitionStore {
+	return s.PostsPartitionStore
+}
+
+func (s *ReadOnlyLayer) Preference() PreferenceStore {
+	return s.PreferenceStore
+}
+
+func (s *ReadOnlyLayer) PresenceWebhook() PresenceWebhookStore {
+	return s.PresenceWebhookStore
+}
+
+func (s *ReadOnlyLayer) Reaction() ReactionStore {
+	return s.ReactionStore
+}
+
+func (s *ReadOnlyLayer) RetentionPolicy() RetentionPolicyStore {
+	return s.RetentionPolicyStore
+}
+
+func (s *ReadOnlyLayer) Role() RoleStore {
+	return s.RoleStore
+}
+
+func (s *ReadOnlyLayer) Scheme() SchemeStore {
+	return s.SchemeStore
+}
+
+func (s *ReadOnlyLayer) Session() SessionStore {
+	return s.SessionStore
+}
+
+func (s *ReadOnlyLayer) SlugHistory() SlugHistoryStore {
+	return s.SlugHistoryStore
+}
+
+func (s *ReadOnlyLayer) Status() StatusStore {
+	return s.StatusStore
+}
+
+func (s *ReadOnlyLayer) StatusAutomation() StatusAutomationStore {
+	return s.StatusAutomationStore
+}
+
+func (s *ReadOnlyLayer) System() SystemStore {
+	return s.SystemStore
+}
+
+func (s *ReadOnlyLayer) Team() TeamStore {
+	return s.TeamStore
+}
+
+func (s *ReadOnlyLayer) TeamInviteLink() TeamInviteLinkStore {
+	return s.TeamInviteLinkStore
+}
+
+func (s *ReadOnlyLayer) TeamMemberHistory() TeamMemberHistoryStore {
+	return s.TeamMemberHistoryStore
+}
+
+func (s *ReadOnlyLayer) TermsOfService() TermsOfServiceStore {
+	return s.TermsOfServiceStore
+}
+
+func (s *ReadOnlyLayer) TermsOfServicePolicy() TermsOfServicePolicyStore {
+	return s.TermsOfServicePolicyStore
+}
+
+func (s *ReadOnlyLayer) Token() TokenStore {
+	return s.TokenStore
+}
+
+func (s *ReadOnlyLayer) User() UserStore {
+	return s.UserStore
+}
+
+func (s *ReadOnlyLayer) UserAccessToken() UserAccessTokenStore {
+	return s.UserAccessTokenStore
+}
+
+func (s *ReadOnlyLayer) UserProperty() UserPropertyStore {
+	return s.UserPropertyStore
+}
+
+func (s *ReadOnlyLayer) UserRelationship() UserRelationshipStore {
+	return s.UserRelationshipStore
+}
+
+func (s *ReadOnlyLayer) UserTermsOfService() UserTermsOfServiceStore {
+	return s.UserTermsOfServiceStore
+}
+
+func (s *ReadOnlyLayer) Webhook() WebhookStore {
+	return s.WebhookStore
+}
+
+type ReadOnlyLayerAuditStore struct {
+	AuditStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerBotStore struct {
+	BotStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerChannelStore struct {
+	ChannelStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerChannelBookmarkStore struct {
+	ChannelBookmarkStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerChannelChangeStore struct {
+	ChannelChangeStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerChannelGuestLinkStore struct {
+	ChannelGuestLinkStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerChannelIntegrationStore struct {
+	ChannelIntegrationStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerChannelMemberHistoryStore struct {
+	ChannelMemberHistoryStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerClusterDiscoveryStore struct {
+	ClusterDiscoveryStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerCommandStore struct {
+	CommandStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerCommandWebhookStore struct {
+	CommandWebhookStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerComplianceStore struct {
+	ComplianceStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerEmailVerificationStore struct {
+	EmailVerificationStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerEmojiStore struct {
+	EmojiStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerEventStreamStore struct {
+	EventStreamStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerFileInfoStore struct {
+	FileInfoStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerGroupStore struct {
+	GroupStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerIntegrationUsageStore struct {
+	IntegrationUsageStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerJobStore struct {
+	JobStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerLicenseStore struct {
+	LicenseStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerLinkMetadataStore struct {
+	LinkMetadataStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerLoginHistoryStore struct {
+	LoginHistoryStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerOAuthStore struct {
+	OAuthStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerOutboxStore struct {
+	OutboxStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerPendingPinStore struct {
+	PendingPinStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerPluginStore struct {
+	PluginStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerPostStore struct {
+	PostStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerPostAcknowledgementStore struct {
+	PostAcknowledgementStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerPostArchiveStore struct {
+	PostArchiveStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerPostIntegrityStore struct {
+	PostIntegrityStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerPostsPartitionStore struct {
+	PostsPartitionStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerPreferenceStore struct {
+	PreferenceStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerPresenceWebhookStore struct {
+	PresenceWebhookStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerReactionStore struct {
+	ReactionStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerRetentionPolicyStore struct {
+	RetentionPolicyStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerRoleStore struct {
+	RoleStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerSchemeStore struct {
+	SchemeStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerSessionStore struct {
+	SessionStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerSlugHistoryStore struct {
+	SlugHistoryStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerStatusStore struct {
+	StatusStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerStatusAutomationStore struct {
+	StatusAutomationStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerSystemStore struct {
+	SystemStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerTeamStore struct {
+	TeamStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerTeamInviteLinkStore struct {
+	TeamInviteLinkStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerTeamMemberHistoryStore struct {
+	TeamMemberHistoryStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerTermsOfServiceStore struct {
+	TermsOfServiceStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerTermsOfServicePolicyStore struct {
+	TermsOfServicePolicyStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerTokenStore struct {
+	TokenStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerUserStore struct {
+	UserStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerUserAccessTokenStore struct {
+	UserAccessTokenStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerUserPropertyStore struct {
+	UserPropertyStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerUserRelationshipStore struct {
+	UserRelationshipStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerUserTermsOfServiceStore struct {
+	UserTermsOfServiceStore
+	Root *ReadOnlyLayer
+}
+
+type ReadOnlyLayerWebhookStore struct {
+	WebhookStore
+	Root *ReadOnlyLayer
+}
+
+func (s *ReadOnlyLayerAuditStore) Get(user_id string, offset int, limit int) (model.Audits, error) {
+	resultVar0, resultVar1 := s.AuditStore.Get(user_id, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerAuditStore) PermanentDeleteByUser(userId string) error {
+	resultVar0 := s.AuditStore.PermanentDeleteByUser(userId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerAuditStore) Save(audit *model.Audit) error {
+	resultVar0 := s.AuditStore.Save(audit)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerBotStore) Get(userId string, includeDeleted bool) (*model.Bot, error) {
+	resultVar0, resultVar1 := s.BotStore.Get(userId, includeDeleted)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerBotStore) GetAll(options *model.BotGetOptions) ([]*model.Bot, error) {
+	resultVar0, resultVar1 := s.BotStore.GetAll(options)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerBotStore) PermanentDelete(userId string) error {
+	resultVar0 := s.BotStore.PermanentDelete(userId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerBotStore) Save(bot *model.Bot) (*model.Bot, error) {
+	resultVar0, resultVar1 := s.BotStore.Save(bot)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerBotStore) Update(bot *model.Bot) (*model.Bot, error) {
+	resultVar0, resultVar1 := s.BotStore.Update(bot)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) AnalyticsDeletedTypeCount(teamId string, channelType string) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.AnalyticsDeletedTypeCount(teamId, channelType)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.AnalyticsDeletedTypeCount", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) AnalyticsTypeCount(teamId string, channelType string) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.AnalyticsTypeCount(teamId, channelType)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.AnalyticsTypeCount", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) AutocompleteInTeam(teamId string, term string, includeDeleted bool) (*model.ChannelList, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.AutocompleteInTeam(teamId, term, includeDeleted)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.AutocompleteInTeam", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) AutocompleteInTeamForSearch(teamId string, userId string, term string, includeDeleted bool) (*model.ChannelList, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.AutocompleteInTeamForSearch(teamId, userId, term, includeDeleted)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.AutocompleteInTeamForSearch", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) ClearAllCustomRoleAssignments() *model.AppError {
+	resultVar0 := s.ChannelStore.ClearAllCustomRoleAssignments()
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.ChannelStore.ClearAllCustomRoleAssignments", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelStore) ClearCaches() {
+	s.ChannelStore.ClearCaches()
+}
+
+func (s *ReadOnlyLayerChannelStore) ClearSidebarOnTeamLeave(userId string, teamId string) *model.AppError {
+	resultVar0 := s.ChannelStore.ClearSidebarOnTeamLeave(userId, teamId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.ChannelStore.ClearSidebarOnTeamLeave", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelStore) CountPostsAfter(channelId string, timestamp int64, userId string) (int, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.CountPostsAfter(channelId, timestamp, userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.CountPostsAfter", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) CreateDirectChannel(userId *model.User, otherUserId *model.User) (*model.Channel, error) {
+	resultVar0, resultVar1 := s.ChannelStore.CreateDirectChannel(userId, otherUserId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) CreateInitialSidebarCategories(userId string, teamId string) error {
+	resultVar0 := s.ChannelStore.CreateInitialSidebarCategories(userId, teamId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelStore) CreateSidebarCategory(userId string, teamId string, newCategory *model.SidebarCategoryWithChannels) (*model.SidebarCategoryWithChannels, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.CreateSidebarCategory(userId, teamId, newCategory)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.CreateSidebarCategory", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) Delete(channelId string, time int64) error {
+	resultVar0 := s.ChannelStore.Delete(channelId, time)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelStore) DeleteSidebarCategory(categoryId string) *model.AppError {
+	resultVar0 := s.ChannelStore.DeleteSidebarCategory(categoryId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.ChannelStore.DeleteSidebarCategory", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelStore) Get(id string, allowFromCache bool) (*model.Channel, error) {
+	resultVar0, resultVar1 := s.ChannelStore.Get(id, allowFromCache)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetAll(teamId string) ([]*model.Channel, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetAll(teamId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetAll", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetAllChannelMembersForUser(userId string, allowFromCache bool, includeDeleted bool) (map[string]string, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetAllChannelMembersForUser(userId, allowFromCache, includeDeleted)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetAllChannelMembersForUser", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetAllChannelMembersNotifyPropsForChannel(channelId string, allowFromCache bool) (map[string]model.StringMap, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetAllChannelMembersNotifyPropsForChannel(channelId, allowFromCache)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetAllChannelMembersNotifyPropsForChannel", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetAllChannels(page int, perPage int, opts ChannelSearchOpts) (*model.ChannelListWithTeamData, error) {
+	resultVar0, resultVar1 := s.ChannelStore.GetAllChannels(page, perPage, opts)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetAllChannelsCount(opts ChannelSearchOpts) (int64, error) {
+	resultVar0, resultVar1 := s.ChannelStore.GetAllChannelsCount(opts)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetAllChannelsForExportAfter(limit int, afterId string) ([]*model.ChannelForExport, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetAllChannelsForExportAfter(limit, afterId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetAllChannelsForExportAfter", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetAllDirectChannelsForExportAfter(limit int, afterId string) ([]*model.DirectChannelForExport, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetAllDirectChannelsForExportAfter(limit, afterId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetAllDirectChannelsForExportAfter", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetByName(team_id string, name string, allowFromCache bool) (*model.Channel, error) {
+	resultVar0, resultVar1 := s.ChannelStore.GetByName(team_id, name, allowFromCache)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetByNameIncludeDeleted(team_id string, name string, allowFromCache bool) (*model.Channel, error) {
+	resultVar0, resultVar1 := s.ChannelStore.GetByNameIncludeDeleted(team_id, name, allowFromCache)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetByNames(team_id string, names []string, allowFromCache bool) ([]*model.Channel, error) {
+	resultVar0, resultVar1 := s.ChannelStore.GetByNames(team_id, names, allowFromCache)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetChannelCounts(teamId string, userId string) (*model.ChannelCounts, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetChannelCounts(teamId, userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetChannelCounts", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetChannelMembersForExport(userId string, teamId string) ([]*model.ChannelMemberForExport, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetChannelMembersForExport(userId, teamId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetChannelMembersForExport", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetChannelMembersTimezones(channelId string) ([]model.StringMap, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetChannelMembersTimezones(channelId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetChannelMembersTimezones", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetChannelUnread(channelId string, userId string) (*model.ChannelUnread, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetChannelUnread(channelId, userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetChannelUnread", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetChannels(teamId string, userId string, includeDeleted bool) (*model.ChannelList, error) {
+	resultVar0, resultVar1 := s.ChannelStore.GetChannels(teamId, userId, includeDeleted)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetChannelsBatchForIndexing(startTime int64, endTime int64, limit int) ([]*model.Channel, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetChannelsBatchForIndexing(startTime, endTime, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetChannelsBatchForIndexing", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetChannelsByIds(channelIds []string, includeDeleted bool) ([]*model.Channel, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetChannelsByIds(channelIds, includeDeleted)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetChannelsByIds", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetChannelsByScheme(schemeId string, offset int, limit int) (model.ChannelList, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetChannelsByScheme(schemeId, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetChannelsByScheme", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetDeleted(team_id string, offset int, limit int, userId string) (*model.ChannelList, error) {
+	resultVar0, resultVar1 := s.ChannelStore.GetDeleted(team_id, offset, limit, userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetDeletedByName(team_id string, name string) (*model.Channel, error) {
+	resultVar0, resultVar1 := s.ChannelStore.GetDeletedByName(team_id, name)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetForPost(postId string) (*model.Channel, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetForPost(postId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetForPost", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetFromMaster(id string) (*model.Channel, error) {
+	resultVar0, resultVar1 := s.ChannelStore.GetFromMaster(id)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetGuestCount(channelId string, allowFromCache bool) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetGuestCount(channelId, allowFromCache)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetGuestCount", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetMember(channelId string, userId string) (*model.ChannelMember, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetMember(channelId, userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetMember", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetMemberCount(channelId string, allowFromCache bool) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetMemberCount(channelId, allowFromCache)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetMemberCount", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetMemberCountFromCache(channelId string) int64 {
+	return s.ChannelStore.GetMemberCountFromCache(channelId)
+}
+
+func (s *ReadOnlyLayerChannelStore) GetMemberCountsByGroup(channelID string, includeTimezones bool) ([]*model.ChannelMemberCountByGroup, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetMemberCountsByGroup(channelID, includeTimezones)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetMemberCountsByGroup", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetMemberForPost(postId string, userId string) (*model.ChannelMember, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetMemberForPost(postId, userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetMemberForPost", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetMembers(channelId string, offset int, limit int) (*model.ChannelMembers, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetMembers(channelId, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetMembers", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetMembersByIds(channelId string, userIds []string) (*model.ChannelMembers, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetMembersByIds(channelId, userIds)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetMembersByIds", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetMembersForUser(teamId string, userId string) (*model.ChannelMembers, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetMembersForUser(teamId, userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetMembersForUser", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetMembersForUserWithCursor(teamId string, userId string, cursor model.ChannelMembersCursor, limit int) (*model.ChannelMembersPage, model.ChannelMembersCursor, *model.AppError) {
+	resultVar0, resultVar1, resultVar2 := s.ChannelStore.GetMembersForUserWithCursor(teamId, userId, cursor, limit)
+	if resultVar2 != nil && IsReadOnlyError(resultVar2) {
+		s.Root.OnReadOnly(resultVar2)
+		resultVar2 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetMembersForUserWithCursor", "store.read_only.app_error", nil, resultVar2.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1, resultVar2
+}
+
+func (s *ReadOnlyLayerChannelStore) GetMembersForUserWithPagination(teamId string, userId string, page int, perPage int) (*model.ChannelMembers, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetMembersForUserWithPagination(teamId, userId, page, perPage)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetMembersForUserWithPagination", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetMembersWithExpiredRoles(expiredBefore int64, limit int) ([]*model.ChannelMember, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetMembersWithExpiredRoles(expiredBefore, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetMembersWithExpiredRoles", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetMoreChannels(teamId string, userId string, offset int, limit int) (*model.ChannelList, error) {
+	resultVar0, resultVar1 := s.ChannelStore.GetMoreChannels(teamId, userId, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetPinnedPostCount(channelId string, allowFromCache bool) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetPinnedPostCount(channelId, allowFromCache)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetPinnedPostCount", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetPinnedPosts(channelId string) (*model.PostList, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetPinnedPosts(channelId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetPinnedPosts", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetPrivateChannelsForTeam(teamId string, offset int, limit int) (*model.ChannelList, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetPrivateChannelsForTeam(teamId, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetPrivateChannelsForTeam", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetPublicChannelsByIdsForTeam(teamId string, channelIds []string) (*model.ChannelList, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetPublicChannelsByIdsForTeam(teamId, channelIds)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetPublicChannelsByIdsForTeam", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetPublicChannelsForTeam(teamId string, offset int, limit int) (*model.ChannelList, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetPublicChannelsForTeam(teamId, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetPublicChannelsForTeam", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetSidebarCategories(userId string, teamId string) (*model.OrderedSidebarCategories, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetSidebarCategories(userId, teamId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetSidebarCategories", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetSidebarCategory(categoryId string) (*model.SidebarCategoryWithChannels, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetSidebarCategory(categoryId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetSidebarCategory", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetSidebarCategoryOrder(userId string, teamId string) ([]string, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetSidebarCategoryOrder(userId, teamId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetSidebarCategoryOrder", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GetTeamChannels(teamId string) (*model.ChannelList, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GetTeamChannels(teamId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GetTeamChannels", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) GroupSyncedChannelCount() (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.GroupSyncedChannelCount()
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.GroupSyncedChannelCount", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) IncrementMentionCount(channelId string, userId string, isUrgent bool) *model.AppError {
+	resultVar0 := s.ChannelStore.IncrementMentionCount(channelId, userId, isUrgent)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.ChannelStore.IncrementMentionCount", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelStore) InvalidateAllChannelMembersForUser(userId string) {
+	s.ChannelStore.InvalidateAllChannelMembersForUser(userId)
+}
+
+func (s *ReadOnlyLayerChannelStore) InvalidateCacheForChannelMembersNotifyProps(channelId string) {
+	s.ChannelStore.InvalidateCacheForChannelMembersNotifyProps(channelId)
+}
+
+func (s *ReadOnlyLayerChannelStore) InvalidateChannel(id string) {
+	s.ChannelStore.InvalidateChannel(id)
+}
+
+func (s *ReadOnlyLayerChannelStore) InvalidateChannelByName(teamId string, name string) {
+	s.ChannelStore.InvalidateChannelByName(teamId, name)
+}
+
+func (s *ReadOnlyLayerChannelStore) InvalidateGuestCount(channelId string) {
+	s.ChannelStore.InvalidateGuestCount(channelId)
+}
+
+func (s *ReadOnlyLayerChannelStore) InvalidateMemberCount(channelId string) {
+	s.ChannelStore.InvalidateMemberCount(channelId)
+}
+
+func (s *ReadOnlyLayerChannelStore) InvalidatePinnedPostCount(channelId string) {
+	s.ChannelStore.InvalidatePinnedPostCount(channelId)
+}
+
+func (s *ReadOnlyLayerChannelStore) IsUserInChannelUseCache(userId string, channelId string) bool {
+	return s.ChannelStore.IsUserInChannelUseCache(userId, channelId)
+}
+
+func (s *ReadOnlyLayerChannelStore) MigrateChannelMembers(fromChannelId string, fromUserId string) (map[string]string, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.MigrateChannelMembers(fromChannelId, fromUserId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.MigrateChannelMembers", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) MigrateFavoritesToSidebarChannels(lastUserId string, runningOrder int64) (map[string]interface{}, error) {
+	resultVar0, resultVar1 := s.ChannelStore.MigrateFavoritesToSidebarChannels(lastUserId, runningOrder)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) MigrateMsgCountRoot(fromChannelId string, fromUserId string) (map[string]string, error) {
+	resultVar0, resultVar1 := s.ChannelStore.MigrateMsgCountRoot(fromChannelId, fromUserId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) MigratePublicChannels() error {
+	resultVar0 := s.ChannelStore.MigratePublicChannels()
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelStore) MigrateSidebarCategories(fromTeamId string, fromUserId string) (map[string]interface{}, error) {
+	resultVar0, resultVar1 := s.ChannelStore.MigrateSidebarCategories(fromTeamId, fromUserId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) MigrateTotalMsgCountRoot(fromChannelId string) (map[string]string, error) {
+	resultVar0, resultVar1 := s.ChannelStore.MigrateTotalMsgCountRoot(fromChannelId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) PermanentDelete(channelId string) error {
+	resultVar0 := s.ChannelStore.PermanentDelete(channelId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelStore) PermanentDeleteByTeam(teamId string) error {
+	resultVar0 := s.ChannelStore.PermanentDeleteByTeam(teamId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelStore) PermanentDeleteMembersByChannel(channelId string) *model.AppError {
+	resultVar0 := s.ChannelStore.PermanentDeleteMembersByChannel(channelId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.ChannelStore.PermanentDeleteMembersByChannel", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelStore) PermanentDeleteMembersByUser(userId string) *model.AppError {
+	resultVar0 := s.ChannelStore.PermanentDeleteMembersByUser(userId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.ChannelStore.PermanentDeleteMembersByUser", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelStore) RemoveAllDeactivatedMembers(channelId string) *model.AppError {
+	resultVar0 := s.ChannelStore.RemoveAllDeactivatedMembers(channelId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.ChannelStore.RemoveAllDeactivatedMembers", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelStore) RemoveMember(channelId string, userId string) *model.AppError {
+	resultVar0 := s.ChannelStore.RemoveMember(channelId, userId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.ChannelStore.RemoveMember", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelStore) RemoveMembers(channelId string, userIds []string) *model.AppError {
+	resultVar0 := s.ChannelStore.RemoveMembers(channelId, userIds)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.ChannelStore.RemoveMembers", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelStore) ResetAllChannelSchemes() *model.AppError {
+	resultVar0 := s.ChannelStore.ResetAllChannelSchemes()
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.ChannelStore.ResetAllChannelSchemes", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelStore) Restore(channelId string, time int64) error {
+	resultVar0 := s.ChannelStore.Restore(channelId, time)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelStore) Save(channel *model.Channel, maxChannelsPerTeam int64) (*model.Channel, error) {
+	resultVar0, resultVar1 := s.ChannelStore.Save(channel, maxChannelsPerTeam)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) SaveDirectChannel(channel *model.Channel, member1 *model.ChannelMember, member2 *model.ChannelMember) (*model.Channel, error) {
+	resultVar0, resultVar1 := s.ChannelStore.SaveDirectChannel(channel, member1, member2)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) SaveMember(member *model.ChannelMember) (*model.ChannelMember, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.SaveMember(member)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.SaveMember", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) SaveMultipleMembers(members []*model.ChannelMember) ([]*model.ChannelMember, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.SaveMultipleMembers(members)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.SaveMultipleMembers", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) SearchAllChannels(term string, opts ChannelSearchOpts) (*model.ChannelListWithTeamData, int64, *model.AppError) {
+	resultVar0, resultVar1, resultVar2 := s.ChannelStore.SearchAllChannels(term, opts)
+	if resultVar2 != nil && IsReadOnlyError(resultVar2) {
+		s.Root.OnReadOnly(resultVar2)
+		resultVar2 = model.NewAppError("ReadOnlyLayer.ChannelStore.SearchAllChannels", "store.read_only.app_error", nil, resultVar2.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1, resultVar2
+}
+
+func (s *ReadOnlyLayerChannelStore) SearchArchivedInTeam(teamId string, term string, userId string) (*model.ChannelList, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.SearchArchivedInTeam(teamId, term, userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.SearchArchivedInTeam", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) SearchForUserInTeam(userId string, teamId string, term string, includeDeleted bool) (*model.ChannelList, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.SearchForUserInTeam(userId, teamId, term, includeDeleted)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.SearchForUserInTeam", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) SearchGroupChannels(userId string, term string) (*model.ChannelList, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.SearchGroupChannels(userId, term)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.SearchGroupChannels", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) SearchInTeam(teamId string, term string, includeDeleted bool) (*model.ChannelList, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.SearchInTeam(teamId, term, includeDeleted)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.SearchInTeam", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) SearchMore(userId string, teamId string, term string) (*model.ChannelList, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.SearchMore(userId, teamId, term)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.SearchMore", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) SetDeleteAt(channelId string, deleteAt int64, updateAt int64) error {
+	resultVar0 := s.ChannelStore.SetDeleteAt(channelId, deleteAt, updateAt)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelStore) Update(channel *model.Channel) (*model.Channel, error) {
+	resultVar0, resultVar1 := s.ChannelStore.Update(channel)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) UpdateLastViewedAt(channelIds []string, userId string) (map[string]int64, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.UpdateLastViewedAt(channelIds, userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.UpdateLastViewedAt", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) UpdateLastViewedAtPost(unreadPost *model.Post, userID string, mentionCount int) (*model.ChannelUnreadAt, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.UpdateLastViewedAtPost(unreadPost, userID, mentionCount)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.UpdateLastViewedAtPost", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) UpdateMember(member *model.ChannelMember) (*model.ChannelMember, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.UpdateMember(member)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.UpdateMember", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) UpdateMembersRole(channelID string, userIDs []string) *model.AppError {
+	resultVar0 := s.ChannelStore.UpdateMembersRole(channelID, userIDs)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.ChannelStore.UpdateMembersRole", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelStore) UpdateMembersRoleAndGetUpdated(channelID string, userIDs []string) ([]*model.ChannelMember, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.UpdateMembersRoleAndGetUpdated(channelID, userIDs)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.UpdateMembersRoleAndGetUpdated", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) UpdateMultipleMembers(members []*model.ChannelMember) ([]*model.ChannelMember, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.UpdateMultipleMembers(members)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.UpdateMultipleMembers", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) UpdateSidebarCategories(userId string, teamId string, categories []*model.SidebarCategoryWithChannels) ([]*model.SidebarCategoryWithChannels, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.UpdateSidebarCategories(userId, teamId, categories)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.UpdateSidebarCategories", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelStore) UpdateSidebarCategoryOrder(userId string, teamId string, categoryOrder []string) *model.AppError {
+	resultVar0 := s.ChannelStore.UpdateSidebarCategoryOrder(userId, teamId, categoryOrder)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.ChannelStore.UpdateSidebarCategoryOrder", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelStore) UpdateSidebarChannelCategoryOnMove(channel *model.Channel, newTeamId string) *model.AppError {
+	resultVar0 := s.ChannelStore.UpdateSidebarChannelCategoryOnMove(channel, newTeamId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.ChannelStore.UpdateSidebarChannelCategoryOnMove", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelStore) UpdateSidebarChannelsByPreferences(preferences *model.Preferences) *model.AppError {
+	resultVar0 := s.ChannelStore.UpdateSidebarChannelsByPreferences(preferences)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.ChannelStore.UpdateSidebarChannelsByPreferences", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelStore) UserBelongsToChannels(userId string, channelIds []string) (bool, *model.AppError) {
+	resultVar0, resultVar1 := s.ChannelStore.UserBelongsToChannels(userId, channelIds)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ChannelStore.UserBelongsToChannels", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelBookmarkStore) CountForChannel(channelId string) (int64, error) {
+	resultVar0, resultVar1 := s.ChannelBookmarkStore.CountForChannel(channelId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelBookmarkStore) Delete(id string, deleteAt int64) error {
+	resultVar0 := s.ChannelBookmarkStore.Delete(id, deleteAt)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelBookmarkStore) Get(id string, includeDeleted bool) (*model.ChannelBookmark, error) {
+	resultVar0, resultVar1 := s.ChannelBookmarkStore.Get(id, includeDeleted)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelBookmarkStore) GetBookmarksForChannel(channelId string, includeDeleted bool) ([]*model.ChannelBookmark, error) {
+	resultVar0, resultVar1 := s.ChannelBookmarkStore.GetBookmarksForChannel(channelId, includeDeleted)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelBookmarkStore) PermanentDeleteByChannel(channelId string) error {
+	resultVar0 := s.ChannelBookmarkStore.PermanentDeleteByChannel(channelId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelBookmarkStore) Save(bookmark *model.ChannelBookmark) (*model.ChannelBookmark, error) {
+	resultVar0, resultVar1 := s.ChannelBookmarkStore.Save(bookmark)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelBookmarkStore) Update(bookmark *model.ChannelBookmark) (*model.ChannelBookmark, error) {
+	resultVar0, resultVar1 := s.ChannelBookmarkStore.Update(bookmark)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelChangeStore) GetLastSeq(channelId string) (int64, error) {
+	resultVar0, resultVar1 := s.ChannelChangeStore.GetLastSeq(channelId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelChangeStore) GetSince(channelId string, sinceSeq int64, limit int) ([]*model.ChannelChange, error) {
+	resultVar0, resultVar1 := s.ChannelChangeStore.GetSince(channelId, sinceSeq, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelChangeStore) PermanentDeleteByChannel(channelId string) error {
+	resultVar0 := s.ChannelChangeStore.PermanentDeleteByChannel(channelId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelGuestLinkStore) CountForChannel(channelId string) (int64, error) {
+	resultVar0, resultVar1 := s.ChannelGuestLinkStore.CountForChannel(channelId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelGuestLinkStore) Get(id string) (*model.ChannelGuestLink, error) {
+	resultVar0, resultVar1 := s.ChannelGuestLinkStore.Get(id)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelGuestLinkStore) GetByToken(token string) (*model.ChannelGuestLink, error) {
+	resultVar0, resultVar1 := s.ChannelGuestLinkStore.GetByToken(token)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelGuestLinkStore) GetForChannel(channelId string) ([]*model.ChannelGuestLink, error) {
+	resultVar0, resultVar1 := s.ChannelGuestLinkStore.GetForChannel(channelId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelGuestLinkStore) IncrementUseCount(id string, now int64) error {
+	resultVar0 := s.ChannelGuestLinkStore.IncrementUseCount(id, now)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelGuestLinkStore) PermanentDeleteByChannel(channelId string) error {
+	resultVar0 := s.ChannelGuestLinkStore.PermanentDeleteByChannel(channelId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelGuestLinkStore) Revoke(id string, deleteAt int64) error {
+	resultVar0 := s.ChannelGuestLinkStore.Revoke(id, deleteAt)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelGuestLinkStore) Save(link *model.ChannelGuestLink) (*model.ChannelGuestLink, error) {
+	resultVar0, resultVar1 := s.ChannelGuestLinkStore.Save(link)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelIntegrationStore) Delete(channelId string, integrationId string) error {
+	resultVar0 := s.ChannelIntegrationStore.Delete(channelId, integrationId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelIntegrationStore) Get(channelId string, integrationId string) (*model.ChannelIntegration, error) {
+	resultVar0, resultVar1 := s.ChannelIntegrationStore.Get(channelId, integrationId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelIntegrationStore) GetForChannel(channelId string) ([]*model.ChannelIntegration, error) {
+	resultVar0, resultVar1 := s.ChannelIntegrationStore.GetForChannel(channelId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelIntegrationStore) PermanentDeleteByChannel(channelId string) error {
+	resultVar0 := s.ChannelIntegrationStore.PermanentDeleteByChannel(channelId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelIntegrationStore) PermanentDeleteByIntegration(integrationId string) error {
+	resultVar0 := s.ChannelIntegrationStore.PermanentDeleteByIntegration(integrationId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelIntegrationStore) Save(integration *model.ChannelIntegration) (*model.ChannelIntegration, error) {
+	resultVar0, resultVar1 := s.ChannelIntegrationStore.Save(integration)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelMemberHistoryStore) GetUsersInChannelDuring(startTime int64, endTime int64, channelId string) ([]*model.ChannelMemberHistoryResult, error) {
+	resultVar0, resultVar1 := s.ChannelMemberHistoryStore.GetUsersInChannelDuring(startTime, endTime, channelId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerChannelMemberHistoryStore) LogJoinEvent(userId string, channelId string, joinTime int64) error {
+	resultVar0 := s.ChannelMemberHistoryStore.LogJoinEvent(userId, channelId, joinTime)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelMemberHistoryStore) LogLeaveEvent(userId string, channelId string, leaveTime int64) error {
+	resultVar0 := s.ChannelMemberHistoryStore.LogLeaveEvent(userId, channelId, leaveTime)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerChannelMemberHistoryStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+	resultVar0, resultVar1 := s.ChannelMemberHistoryStore.PermanentDeleteBatch(endTime, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerClusterDiscoveryStore) Cleanup() error {
+	resultVar0 := s.ClusterDiscoveryStore.Cleanup()
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerClusterDiscoveryStore) Delete(discovery *model.ClusterDiscovery) (bool, error) {
+	resultVar0, resultVar1 := s.ClusterDiscoveryStore.Delete(discovery)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerClusterDiscoveryStore) Exists(discovery *model.ClusterDiscovery) (bool, error) {
+	resultVar0, resultVar1 := s.ClusterDiscoveryStore.Exists(discovery)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerClusterDiscoveryStore) GetAll(discoveryType string, clusterName string) ([]*model.ClusterDiscovery, error) {
+	resultVar0, resultVar1 := s.ClusterDiscoveryStore.GetAll(discoveryType, clusterName)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerClusterDiscoveryStore) Save(discovery *model.ClusterDiscovery) error {
+	resultVar0 := s.ClusterDiscoveryStore.Save(discovery)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerClusterDiscoveryStore) SetLastPingAt(discovery *model.ClusterDiscovery) error {
+	resultVar0 := s.ClusterDiscoveryStore.SetLastPingAt(discovery)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerCommandStore) AnalyticsCommandCount(teamId string) (int64, error) {
+	resultVar0, resultVar1 := s.CommandStore.AnalyticsCommandCount(teamId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerCommandStore) Delete(commandId string, time int64) error {
+	resultVar0 := s.CommandStore.Delete(commandId, time)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerCommandStore) Get(id string) (*model.Command, error) {
+	resultVar0, resultVar1 := s.CommandStore.Get(id)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerCommandStore) GetByTeam(teamId string) ([]*model.Command, error) {
+	resultVar0, resultVar1 := s.CommandStore.GetByTeam(teamId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerCommandStore) GetByTrigger(teamId string, trigger string) (*model.Command, error) {
+	resultVar0, resultVar1 := s.CommandStore.GetByTrigger(teamId, trigger)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerCommandStore) PermanentDeleteByTeam(teamId string) error {
+	resultVar0 := s.CommandStore.PermanentDeleteByTeam(teamId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerCommandStore) PermanentDeleteByUser(userId string) error {
+	resultVar0 := s.CommandStore.PermanentDeleteByUser(userId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerCommandStore) Save(webhook *model.Command) (*model.Command, error) {
+	resultVar0, resultVar1 := s.CommandStore.Save(webhook)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerCommandStore) Update(hook *model.Command) (*model.Command, error) {
+	resultVar0, resultVar1 := s.CommandStore.Update(hook)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerCommandWebhookStore) Cleanup() {
+	s.CommandWebhookStore.Cleanup()
+}
+
+func (s *ReadOnlyLayerCommandWebhookStore) Get(id string) (*model.CommandWebhook, *model.AppError) {
+	resultVar0, resultVar1 := s.CommandWebhookStore.Get(id)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.CommandWebhookStore.Get", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerCommandWebhookStore) Save(webhook *model.CommandWebhook) (*model.CommandWebhook, *model.AppError) {
+	resultVar0, resultVar1 := s.CommandWebhookStore.Save(webhook)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.CommandWebhookStore.Save", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerCommandWebhookStore) TryUse(id string, limit int) *model.AppError {
+	resultVar0 := s.CommandWebhookStore.TryUse(id, limit)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.CommandWebhookStore.TryUse", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerComplianceStore) ComplianceExport(compliance *model.Compliance) ([]*model.CompliancePost, *model.AppError) {
+	resultVar0, resultVar1 := s.ComplianceStore.ComplianceExport(compliance)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ComplianceStore.ComplianceExport", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerComplianceStore) Get(id string) (*model.Compliance, *model.AppError) {
+	resultVar0, resultVar1 := s.ComplianceStore.Get(id)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ComplianceStore.Get", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerComplianceStore) GetAll(offset int, limit int) (model.Compliances, *model.AppError) {
+	resultVar0, resultVar1 := s.ComplianceStore.GetAll(offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ComplianceStore.GetAll", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerComplianceStore) MessageExport(after int64, limit int) ([]*model.MessageExport, *model.AppError) {
+	resultVar0, resultVar1 := s.ComplianceStore.MessageExport(after, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ComplianceStore.MessageExport", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerComplianceStore) Save(compliance *model.Compliance) (*model.Compliance, *model.AppError) {
+	resultVar0, resultVar1 := s.ComplianceStore.Save(compliance)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ComplianceStore.Save", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerComplianceStore) Update(compliance *model.Compliance) (*model.Compliance, *model.AppError) {
+	resultVar0, resultVar1 := s.ComplianceStore.Update(compliance)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.ComplianceStore.Update", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerEmailVerificationStore) Delete(userId string) error {
+	resultVar0 := s.EmailVerificationStore.Delete(userId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerEmailVerificationStore) Get(userId string) (*model.EmailVerification, error) {
+	resultVar0, resultVar1 := s.EmailVerificationStore.Get(userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerEmailVerificationStore) GetForReminder(remindBefore int64, limit int) ([]*model.EmailVerification, error) {
+	resultVar0, resultVar1 := s.EmailVerificationStore.GetForReminder(remindBefore, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerEmailVerificationStore) GetOverdue(now int64, limit int) ([]*model.EmailVerification, error) {
+	resultVar0, resultVar1 := s.EmailVerificationStore.GetOverdue(now, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerEmailVerificationStore) GetUnverifiedUsers(offset int, limit int) ([]*model.UnverifiedUser, error) {
+	resultVar0, resultVar1 := s.EmailVerificationStore.GetUnverifiedUsers(offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerEmailVerificationStore) Save(verification *model.EmailVerification) (*model.EmailVerification, error) {
+	resultVar0, resultVar1 := s.EmailVerificationStore.Save(verification)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerEmailVerificationStore) Update(verification *model.EmailVerification) (*model.EmailVerification, error) {
+	resultVar0, resultVar1 := s.EmailVerificationStore.Update(verification)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerEmojiStore) Delete(emoji *model.Emoji, time int64) error {
+	resultVar0 := s.EmojiStore.Delete(emoji, time)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerEmojiStore) Get(id string, allowFromCache bool) (*model.Emoji, error) {
+	resultVar0, resultVar1 := s.EmojiStore.Get(id, allowFromCache)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerEmojiStore) GetByName(name string, allowFromCache bool) (*model.Emoji, error) {
+	resultVar0, resultVar1 := s.EmojiStore.GetByName(name, allowFromCache)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerEmojiStore) GetList(offset int, limit int, sort string, teamIds []string) ([]*model.Emoji, error) {
+	resultVar0, resultVar1 := s.EmojiStore.GetList(offset, limit, sort, teamIds)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerEmojiStore) GetMultipleByName(names []string) ([]*model.Emoji, error) {
+	resultVar0, resultVar1 := s.EmojiStore.GetMultipleByName(names)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerEmojiStore) Save(emoji *model.Emoji) (*model.Emoji, error) {
+	resultVar0, resultVar1 := s.EmojiStore.Save(emoji)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerEmojiStore) Search(name string, prefixOnly bool, limit int, teamIds []string) ([]*model.Emoji, error) {
+	resultVar0, resultVar1 := s.EmojiStore.Search(name, prefixOnly, limit, teamIds)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerEventStreamStore) Count() (int64, error) {
+	resultVar0, resultVar1 := s.EventStreamStore.Count()
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerEventStreamStore) Delete(ids []string) error {
+	resultVar0 := s.EventStreamStore.Delete(ids)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerEventStreamStore) GetPending(now int64, limit int) ([]*model.EventStreamEvent, error) {
+	resultVar0, resultVar1 := s.EventStreamStore.GetPending(now, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerEventStreamStore) RecordFailedAttempt(ids []string, nextAttemptAt int64) error {
+	resultVar0 := s.EventStreamStore.RecordFailedAttempt(ids, nextAttemptAt)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerEventStreamStore) Save(event *model.EventStreamEvent) (*model.EventStreamEvent, error) {
+	resultVar0, resultVar1 := s.EventStreamStore.Save(event)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerFileInfoStore) AttachToPost(fileId string, postId string, creatorId string) *model.AppError {
+	resultVar0 := s.FileInfoStore.AttachToPost(fileId, postId, creatorId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.FileInfoStore.AttachToPost", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerFileInfoStore) ClearCaches() {
+	s.FileInfoStore.ClearCaches()
+}
+
+func (s *ReadOnlyLayerFileInfoStore) DeleteForPost(postId string) (string, *model.AppError) {
+	resultVar0, resultVar1 := s.FileInfoStore.DeleteForPost(postId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.FileInfoStore.DeleteForPost", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerFileInfoStore) Get(id string) (*model.FileInfo, *model.AppError) {
+	resultVar0, resultVar1 := s.FileInfoStore.Get(id)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.FileInfoStore.Get", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerFileInfoStore) GetByPath(path string) (*model.FileInfo, *model.AppError) {
+	resultVar0, resultVar1 := s.FileInfoStore.GetByPath(path)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.FileInfoStore.GetByPath", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerFileInfoStore) GetForPost(postId string, readFromMaster bool, includeDeleted bool, allowFromCache bool) ([]*model.FileInfo, *model.AppError) {
+	resultVar0, resultVar1 := s.FileInfoStore.GetForPost(postId, readFromMaster, includeDeleted, allowFromCache)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.FileInfoStore.GetForPost", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerFileInfoStore) GetForUser(userId string) ([]*model.FileInfo, *model.AppError) {
+	resultVar0, resultVar1 := s.FileInfoStore.GetForUser(userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.FileInfoStore.GetForUser", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerFileInfoStore) GetWithOptions(page int, perPage int, opt *model.GetFileInfosOptions) ([]*model.FileInfo, *model.AppError) {
+	resultVar0, resultVar1 := s.FileInfoStore.GetWithOptions(page, perPage, opt)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.FileInfoStore.GetWithOptions", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerFileInfoStore) InvalidateFileInfosForPostCache(postId string, deleted bool) {
+	s.FileInfoStore.InvalidateFileInfosForPostCache(postId, deleted)
+}
+
+func (s *ReadOnlyLayerFileInfoStore) PermanentDelete(fileId string) *model.AppError {
+	resultVar0 := s.FileInfoStore.PermanentDelete(fileId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.FileInfoStore.PermanentDelete", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerFileInfoStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.FileInfoStore.PermanentDeleteBatch(endTime, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.FileInfoStore.PermanentDeleteBatch", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerFileInfoStore) PermanentDeleteByUser(userId string) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.FileInfoStore.PermanentDeleteByUser(userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.FileInfoStore.PermanentDeleteByUser", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerFileInfoStore) Save(info *model.FileInfo) (*model.FileInfo, *model.AppError) {
+	resultVar0, resultVar1 := s.FileInfoStore.Save(info)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.FileInfoStore.Save", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerFileInfoStore) SetSensitive(fileId string, sensitive bool) *model.AppError {
+	resultVar0 := s.FileInfoStore.SetSensitive(fileId, sensitive)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.FileInfoStore.SetSensitive", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerFileInfoStore) UpdateEnrichment(info *model.FileInfo) *model.AppError {
+	resultVar0 := s.FileInfoStore.UpdateEnrichment(info)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.FileInfoStore.UpdateEnrichment", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerGroupStore) AdminRoleGroupsForSyncableMember(userID string, syncableID string, syncableType model.GroupSyncableType) ([]string, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.AdminRoleGroupsForSyncableMember(userID, syncableID, syncableType)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.AdminRoleGroupsForSyncableMember", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) ChannelMembersMinusGroupMembers(channelID string, groupIDs []string, page int, perPage int) ([]*model.UserWithGroups, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.ChannelMembersMinusGroupMembers(channelID, groupIDs, page, perPage)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.ChannelMembersMinusGroupMembers", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) ChannelMembersToAdd(since int64, channelID *string) ([]*model.UserChannelIDPair, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.ChannelMembersToAdd(since, channelID)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.ChannelMembersToAdd", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) ChannelMembersToRemove(channelID *string) ([]*model.ChannelMember, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.ChannelMembersToRemove(channelID)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.ChannelMembersToRemove", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) CountChannelMembersMinusGroupMembers(channelID string, groupIDs []string) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.CountChannelMembersMinusGroupMembers(channelID, groupIDs)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.CountChannelMembersMinusGroupMembers", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) CountGroupsByChannel(channelId string, opts model.GroupSearchOpts) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.CountGroupsByChannel(channelId, opts)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.CountGroupsByChannel", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) CountGroupsByTeam(teamId string, opts model.GroupSearchOpts) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.CountGroupsByTeam(teamId, opts)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.CountGroupsByTeam", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) CountTeamMembersMinusGroupMembers(teamID string, groupIDs []string) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.CountTeamMembersMinusGroupMembers(teamID, groupIDs)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.CountTeamMembersMinusGroupMembers", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) Create(group *model.Group) (*model.Group, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.Create(group)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.Create", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) CreateGroupSyncable(groupSyncable *model.GroupSyncable) (*model.GroupSyncable, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.CreateGroupSyncable(groupSyncable)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.CreateGroupSyncable", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) Delete(groupID string) (*model.Group, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.Delete(groupID)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.Delete", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) DeleteGroupSyncable(groupID string, syncableID string, syncableType model.GroupSyncableType) (*model.GroupSyncable, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.DeleteGroupSyncable(groupID, syncableID, syncableType)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.DeleteGroupSyncable", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) DeleteMember(groupID string, userID string) (*model.GroupMember, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.DeleteMember(groupID, userID)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.DeleteMember", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) DistinctGroupMemberCount() (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.DistinctGroupMemberCount()
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.DistinctGroupMemberCount", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) Get(groupID string) (*model.Group, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.Get(groupID)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.Get", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) GetAllBySource(groupSource model.GroupSource) ([]*model.Group, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.GetAllBySource(groupSource)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.GetAllBySource", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) GetAllGroupSyncablesByGroupId(groupID string, syncableType model.GroupSyncableType) ([]*model.GroupSyncable, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.GetAllGroupSyncablesByGroupId(groupID, syncableType)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.GetAllGroupSyncablesByGroupId", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) GetByIDs(groupIDs []string) ([]*model.Group, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.GetByIDs(groupIDs)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.GetByIDs", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) GetByName(name string, opts model.GroupSearchOpts) (*model.Group, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.GetByName(name, opts)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.GetByName", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) GetByRemoteID(remoteID string, groupSource model.GroupSource) (*model.Group, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.GetByRemoteID(remoteID, groupSource)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.GetByRemoteID", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) GetByUser(userId string) ([]*model.Group, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.GetByUser(userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.GetByUser", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) GetGroupSyncable(groupID string, syncableID string, syncableType model.GroupSyncableType) (*model.GroupSyncable, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.GetGroupSyncable(groupID, syncableID, syncableType)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.GetGroupSyncable", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) GetGroups(page int, perPage int, opts model.GroupSearchOpts) ([]*model.Group, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.GetGroups(page, perPage, opts)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.GetGroups", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) GetGroupsAssociatedToChannelsByTeam(teamId string, opts model.GroupSearchOpts) (map[string][]*model.GroupWithSchemeAdmin, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.GetGroupsAssociatedToChannelsByTeam(teamId, opts)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.GetGroupsAssociatedToChannelsByTeam", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) GetGroupsByChannel(channelId string, opts model.GroupSearchOpts) ([]*model.GroupWithSchemeAdmin, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.GetGroupsByChannel(channelId, opts)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.GetGroupsByChannel", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) GetGroupsByTeam(teamId string, opts model.GroupSearchOpts) ([]*model.GroupWithSchemeAdmin, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.GetGroupsByTeam(teamId, opts)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.GetGroupsByTeam", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) GetMemberCount(groupID string) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.GetMemberCount(groupID)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.GetMemberCount", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) GetMemberUsers(groupID string) ([]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.GetMemberUsers(groupID)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.GetMemberUsers", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) GetMemberUsersInTeam(groupID string, teamID string) ([]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.GetMemberUsersInTeam(groupID, teamID)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.GetMemberUsersInTeam", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) GetMemberUsersNotInChannel(groupID string, channelID string) ([]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.GetMemberUsersNotInChannel(groupID, channelID)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.GetMemberUsersNotInChannel", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) GetMemberUsersPage(groupID string, page int, perPage int) ([]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.GetMemberUsersPage(groupID, page, perPage)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.GetMemberUsersPage", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) GroupChannelCount() (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.GroupChannelCount()
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.GroupChannelCount", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) GroupCount() (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.GroupCount()
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.GroupCount", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) GroupCountWithAllowReference() (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.GroupCountWithAllowReference()
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.GroupCountWithAllowReference", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) GroupMemberCount() (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.GroupMemberCount()
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.GroupMemberCount", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) GroupTeamCount() (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.GroupTeamCount()
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.GroupTeamCount", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) PermanentDeleteMembersByUser(userId string) *model.AppError {
+	resultVar0 := s.GroupStore.PermanentDeleteMembersByUser(userId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.GroupStore.PermanentDeleteMembersByUser", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerGroupStore) PermittedSyncableAdmins(syncableID string, syncableType model.GroupSyncableType) ([]string, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.PermittedSyncableAdmins(syncableID, syncableType)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.PermittedSyncableAdmins", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) TeamMembersMinusGroupMembers(teamID string, groupIDs []string, page int, perPage int) ([]*model.UserWithGroups, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.TeamMembersMinusGroupMembers(teamID, groupIDs, page, perPage)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.TeamMembersMinusGroupMembers", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) TeamMembersToAdd(since int64, teamID *string) ([]*model.UserTeamIDPair, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.TeamMembersToAdd(since, teamID)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.TeamMembersToAdd", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) TeamMembersToRemove(teamID *string) ([]*model.TeamMember, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.TeamMembersToRemove(teamID)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.TeamMembersToRemove", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) Update(group *model.Group) (*model.Group, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.Update(group)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.Update", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) UpdateGroupSyncable(groupSyncable *model.GroupSyncable) (*model.GroupSyncable, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.UpdateGroupSyncable(groupSyncable)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.UpdateGroupSyncable", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerGroupStore) UpsertMember(groupID string, userID string) (*model.GroupMember, *model.AppError) {
+	resultVar0, resultVar1 := s.GroupStore.UpsertMember(groupID, userID)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.GroupStore.UpsertMember", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerIntegrationUsageStore) GetForPeriod(startDay int64, endDay int64) ([]*model.IntegrationUsage, error) {
+	resultVar0, resultVar1 := s.IntegrationUsageStore.GetForPeriod(startDay, endDay)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerIntegrationUsageStore) Increment(usage *model.IntegrationUsage) error {
+	resultVar0 := s.IntegrationUsageStore.Increment(usage)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerIntegrationUsageStore) PermanentDeleteBatch(endDay int64, limit int64) (int64, error) {
+	resultVar0, resultVar1 := s.IntegrationUsageStore.PermanentDeleteBatch(endDay, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerJobStore) Delete(id string) (string, *model.AppError) {
+	resultVar0, resultVar1 := s.JobStore.Delete(id)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.JobStore.Delete", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerJobStore) Get(id string) (*model.Job, *model.AppError) {
+	resultVar0, resultVar1 := s.JobStore.Get(id)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.JobStore.Get", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerJobStore) GetAllByStatus(status string) ([]*model.Job, *model.AppError) {
+	resultVar0, resultVar1 := s.JobStore.GetAllByStatus(status)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.JobStore.GetAllByStatus", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerJobStore) GetAllByType(jobType string) ([]*model.Job, *model.AppError) {
+	resultVar0, resultVar1 := s.JobStore.GetAllByType(jobType)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.JobStore.GetAllByType", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerJobStore) GetAllByTypePage(jobType string, offset int, limit int) ([]*model.Job, *model.AppError) {
+	resultVar0, resultVar1 := s.JobStore.GetAllByTypePage(jobType, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.JobStore.GetAllByTypePage", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerJobStore) GetAllPage(offset int, limit int) ([]*model.Job, *model.AppError) {
+	resultVar0, resultVar1 := s.JobStore.GetAllPage(offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.JobStore.GetAllPage", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerJobStore) GetCountByStatusAndType(status string, jobType string) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.JobStore.GetCountByStatusAndType(status, jobType)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.JobStore.GetCountByStatusAndType", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerJobStore) GetNewestJobByStatusAndType(status string, jobType string) (*model.Job, *model.AppError) {
+	resultVar0, resultVar1 := s.JobStore.GetNewestJobByStatusAndType(status, jobType)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.JobStore.GetNewestJobByStatusAndType", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerJobStore) Save(job *model.Job) (*model.Job, *model.AppError) {
+	resultVar0, resultVar1 := s.JobStore.Save(job)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.JobStore.Save", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerJobStore) UpdateOptimistically(job *model.Job, currentStatus string) (bool, *model.AppError) {
+	resultVar0, resultVar1 := s.JobStore.UpdateOptimistically(job, currentStatus)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.JobStore.UpdateOptimistically", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerJobStore) UpdateStatus(id string, status string) (*model.Job, *model.AppError) {
+	resultVar0, resultVar1 := s.JobStore.UpdateStatus(id, status)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.JobStore.UpdateStatus", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerJobStore) UpdateStatusOptimistically(id string, currentStatus string, newStatus string) (bool, *model.AppError) {
+	resultVar0, resultVar1 := s.JobStore.UpdateStatusOptimistically(id, currentStatus, newStatus)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.JobStore.UpdateStatusOptimistically", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerLicenseStore) Get(id string) (*model.LicenseRecord, error) {
+	resultVar0, resultVar1 := s.LicenseStore.Get(id)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerLicenseStore) Save(license *model.LicenseRecord) (*model.LicenseRecord, error) {
+	resultVar0, resultVar1 := s.LicenseStore.Save(license)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerLinkMetadataStore) Get(url string, timestamp int64) (*model.LinkMetadata, error) {
+	resultVar0, resultVar1 := s.LinkMetadataStore.Get(url, timestamp)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerLinkMetadataStore) Save(linkMetadata *model.LinkMetadata) (*model.LinkMetadata, error) {
+	resultVar0, resultVar1 := s.LinkMetadataStore.Save(linkMetadata)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerLoginHistoryStore) Get(options *model.LoginHistoryGetOptions) ([]*model.LoginHistory, error) {
+	resultVar0, resultVar1 := s.LoginHistoryStore.Get(options)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerLoginHistoryStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+	resultVar0, resultVar1 := s.LoginHistoryStore.PermanentDeleteBatch(endTime, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerLoginHistoryStore) PermanentDeleteByUser(userId string) error {
+	resultVar0 := s.LoginHistoryStore.PermanentDeleteByUser(userId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerLoginHistoryStore) Save(entry *model.LoginHistory) (*model.LoginHistory, error) {
+	resultVar0, resultVar1 := s.LoginHistoryStore.Save(entry)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerOAuthStore) DeleteApp(id string) error {
+	resultVar0 := s.OAuthStore.DeleteApp(id)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerOAuthStore) GetAccessData(token string) (*model.AccessData, error) {
+	resultVar0, resultVar1 := s.OAuthStore.GetAccessData(token)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerOAuthStore) GetAccessDataByRefreshToken(token string) (*model.AccessData, error) {
+	resultVar0, resultVar1 := s.OAuthStore.GetAccessDataByRefreshToken(token)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerOAuthStore) GetAccessDataByUserForApp(userId string, clientId string) ([]*model.AccessData, error) {
+	resultVar0, resultVar1 := s.OAuthStore.GetAccessDataByUserForApp(userId, clientId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerOAuthStore) GetApp(id string) (*model.OAuthApp, error) {
+	resultVar0, resultVar1 := s.OAuthStore.GetApp(id)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerOAuthStore) GetAppByUser(userId string, offset int, limit int) ([]*model.OAuthApp, error) {
+	resultVar0, resultVar1 := s.OAuthStore.GetAppByUser(userId, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerOAuthStore) GetApps(offset int, limit int) ([]*model.OAuthApp, error) {
+	resultVar0, resultVar1 := s.OAuthStore.GetApps(offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerOAuthStore) GetAuthData(code string) (*model.AuthData, error) {
+	resultVar0, resultVar1 := s.OAuthStore.GetAuthData(code)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerOAuthStore) GetAuthorizedApps(userId string, offset int, limit int) ([]*model.OAuthApp, error) {
+	resultVar0, resultVar1 := s.OAuthStore.GetAuthorizedApps(userId, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerOAuthStore) GetPreviousAccessData(userId string, clientId string) (*model.AccessData, error) {
+	resultVar0, resultVar1 := s.OAuthStore.GetPreviousAccessData(userId, clientId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerOAuthStore) PermanentDeleteAuthDataByUser(userId string) error {
+	resultVar0 := s.OAuthStore.PermanentDeleteAuthDataByUser(userId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerOAuthStore) RemoveAccessData(token string) error {
+	resultVar0 := s.OAuthStore.RemoveAccessData(token)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerOAuthStore) RemoveAllAccessData() error {
+	resultVar0 := s.OAuthStore.RemoveAllAccessData()
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerOAuthStore) RemoveAuthData(code string) error {
+	resultVar0 := s.OAuthStore.RemoveAuthData(code)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerOAuthStore) SaveAccessData(accessData *model.AccessData) (*model.AccessData, error) {
+	resultVar0, resultVar1 := s.OAuthStore.SaveAccessData(accessData)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerOAuthStore) SaveApp(app *model.OAuthApp) (*model.OAuthApp, error) {
+	resultVar0, resultVar1 := s.OAuthStore.SaveApp(app)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerOAuthStore) SaveAuthData(authData *model.AuthData) (*model.AuthData, error) {
+	resultVar0, resultVar1 := s.OAuthStore.SaveAuthData(authData)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerOAuthStore) UpdateAccessData(accessData *model.AccessData) (*model.AccessData, error) {
+	resultVar0, resultVar1 := s.OAuthStore.UpdateAccessData(accessData)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerOAuthStore) UpdateApp(app *model.OAuthApp) (*model.OAuthApp, error) {
+	resultVar0, resultVar1 := s.OAuthStore.UpdateApp(app)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerOutboxStore) GetUndelivered(createdBefore int64, limit int) ([]*model.OutboxEvent, error) {
+	resultVar0, resultVar1 := s.OutboxStore.GetUndelivered(createdBefore, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerOutboxStore) MarkDelivered(ids []string, deliveredAt int64) error {
+	resultVar0 := s.OutboxStore.MarkDelivered(ids, deliveredAt)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerOutboxStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+	resultVar0, resultVar1 := s.OutboxStore.PermanentDeleteBatch(endTime, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerOutboxStore) Save(event *model.OutboxEvent) (*model.OutboxEvent, error) {
+	resultVar0, resultVar1 := s.OutboxStore.Save(event)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPendingPinStore) Delete(postId string) error {
+	resultVar0 := s.PendingPinStore.Delete(postId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerPendingPinStore) Get(postId string) (*model.PendingPin, error) {
+	resultVar0, resultVar1 := s.PendingPinStore.Get(postId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPendingPinStore) GetForChannel(channelId string) ([]*model.PendingPin, error) {
+	resultVar0, resultVar1 := s.PendingPinStore.GetForChannel(channelId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPendingPinStore) PermanentDeleteByChannel(channelId string) error {
+	resultVar0 := s.PendingPinStore.PermanentDeleteByChannel(channelId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerPendingPinStore) Save(pendingPin *model.PendingPin) (*model.PendingPin, error) {
+	resultVar0, resultVar1 := s.PendingPinStore.Save(pendingPin)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPluginStore) CompareAndDelete(keyVal *model.PluginKeyValue, oldValue []byte) (bool, *model.AppError) {
+	resultVar0, resultVar1 := s.PluginStore.CompareAndDelete(keyVal, oldValue)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PluginStore.CompareAndDelete", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPluginStore) CompareAndSet(keyVal *model.PluginKeyValue, oldValue []byte) (bool, *model.AppError) {
+	resultVar0, resultVar1 := s.PluginStore.CompareAndSet(keyVal, oldValue)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PluginStore.CompareAndSet", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPluginStore) Delete(pluginId string, key string) *model.AppError {
+	resultVar0 := s.PluginStore.Delete(pluginId, key)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.PluginStore.Delete", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerPluginStore) DeleteAllExpired() *model.AppError {
+	resultVar0 := s.PluginStore.DeleteAllExpired()
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.PluginStore.DeleteAllExpired", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerPluginStore) DeleteAllForPlugin(PluginId string) *model.AppError {
+	resultVar0 := s.PluginStore.DeleteAllForPlugin(PluginId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.PluginStore.DeleteAllForPlugin", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerPluginStore) Get(pluginId string, key string) (*model.PluginKeyValue, *model.AppError) {
+	resultVar0, resultVar1 := s.PluginStore.Get(pluginId, key)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PluginStore.Get", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPluginStore) List(pluginId string, page int, perPage int) ([]string, *model.AppError) {
+	resultVar0, resultVar1 := s.PluginStore.List(pluginId, page, perPage)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PluginStore.List", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPluginStore) SaveOrUpdate(keyVal *model.PluginKeyValue) (*model.PluginKeyValue, *model.AppError) {
+	resultVar0, resultVar1 := s.PluginStore.SaveOrUpdate(keyVal)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PluginStore.SaveOrUpdate", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPluginStore) SetWithOptions(pluginId string, key string, value []byte, options model.PluginKVSetOptions) (bool, *model.AppError) {
+	resultVar0, resultVar1 := s.PluginStore.SetWithOptions(pluginId, key, value, options)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PluginStore.SetWithOptions", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) AnalyticsPostCount(teamId string, mustHaveFile bool, mustHaveHashtag bool, estimate bool) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.PostStore.AnalyticsPostCount(teamId, mustHaveFile, mustHaveHashtag, estimate)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PostStore.AnalyticsPostCount", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) AnalyticsPostCountsByDay(options *model.AnalyticsPostCountsOptions) (model.AnalyticsRows, *model.AppError) {
+	resultVar0, resultVar1 := s.PostStore.AnalyticsPostCountsByDay(options)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PostStore.AnalyticsPostCountsByDay", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) AnalyticsUserCountsWithPostsByDay(teamId string) (model.AnalyticsRows, *model.AppError) {
+	resultVar0, resultVar1 := s.PostStore.AnalyticsUserCountsWithPostsByDay(teamId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PostStore.AnalyticsUserCountsWithPostsByDay", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) ClearCaches() {
+	s.PostStore.ClearCaches()
+}
+
+func (s *ReadOnlyLayerPostStore) Delete(postId string, time int64, deleteByID string) *model.AppError {
+	resultVar0 := s.PostStore.Delete(postId, time, deleteByID)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.PostStore.Delete", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerPostStore) DeleteWithOutboxEvent(postId string, time int64, deleteByID string, message *model.WebSocketEvent) (*model.OutboxEvent, *model.AppError) {
+	resultVar0, resultVar1 := s.PostStore.DeleteWithOutboxEvent(postId, time, deleteByID, message)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PostStore.DeleteWithOutboxEvent", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) Get(id string, skipFetchThreads bool) (*model.PostList, *model.AppError) {
+	resultVar0, resultVar1 := s.PostStore.Get(id, skipFetchThreads)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PostStore.Get", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) GetDirectPostParentsForExportAfter(limit int, afterId string) ([]*model.DirectPostForExport, *model.AppError) {
+	resultVar0, resultVar1 := s.PostStore.GetDirectPostParentsForExportAfter(limit, afterId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PostStore.GetDirectPostParentsForExportAfter", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) GetEtag(channelId string, allowFromCache bool) string {
+	return s.PostStore.GetEtag(channelId, allowFromCache)
+}
+
+func (s *ReadOnlyLayerPostStore) GetFlaggedPosts(userId string, offset int, limit int) (*model.PostList, *model.AppError) {
+	resultVar0, resultVar1 := s.PostStore.GetFlaggedPosts(userId, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PostStore.GetFlaggedPosts", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) GetFlaggedPostsForChannel(userId string, channelId string, offset int, limit int) (*model.PostList, *model.AppError) {
+	resultVar0, resultVar1 := s.PostStore.GetFlaggedPostsForChannel(userId, channelId, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PostStore.GetFlaggedPostsForChannel", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) GetFlaggedPostsForTeam(userId string, teamId string, offset int, limit int) (*model.PostList, *model.AppError) {
+	resultVar0, resultVar1 := s.PostStore.GetFlaggedPostsForTeam(userId, teamId, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PostStore.GetFlaggedPostsForTeam", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) GetMaxPostSize() int {
+	return s.PostStore.GetMaxPostSize()
+}
+
+func (s *ReadOnlyLayerPostStore) GetOldest() (*model.Post, *model.AppError) {
+	resultVar0, resultVar1 := s.PostStore.GetOldest()
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PostStore.GetOldest", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) GetOldestEntityCreationTime() (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.PostStore.GetOldestEntityCreationTime()
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PostStore.GetOldestEntityCreationTime", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) GetParentsForExportAfter(limit int, afterId string) ([]*model.PostForExport, *model.AppError) {
+	resultVar0, resultVar1 := s.PostStore.GetParentsForExportAfter(limit, afterId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PostStore.GetParentsForExportAfter", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) GetPostAfterTime(channelId string, time int64) (*model.Post, *model.AppError) {
+	resultVar0, resultVar1 := s.PostStore.GetPostAfterTime(channelId, time)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PostStore.GetPostAfterTime", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) GetPostIdAfterTime(channelId string, time int64) (string, *model.AppError) {
+	resultVar0, resultVar1 := s.PostStore.GetPostIdAfterTime(channelId, time)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PostStore.GetPostIdAfterTime", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) GetPostIdBeforeTime(channelId string, time int64) (string, *model.AppError) {
+	resultVar0, resultVar1 := s.PostStore.GetPostIdBeforeTime(channelId, time)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PostStore.GetPostIdBeforeTime", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) GetPosts(options model.GetPostsOptions, allowFromCache bool) (*model.PostList, *model.AppError) {
+	resultVar0, resultVar1 := s.PostStore.GetPosts(options, allowFromCache)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PostStore.GetPosts", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) GetPostsAfter(options model.GetPostsOptions) (*model.PostList, *model.AppError) {
+	resultVar0, resultVar1 := s.PostStore.GetPostsAfter(options)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PostStore.GetPostsAfter", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) GetPostsBatchForIndexing(startTime int64, endTime int64, limit int) ([]*model.PostForIndexing, *model.AppError) {
+	resultVar0, resultVar1 := s.PostStore.GetPostsBatchForIndexing(startTime, endTime, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PostStore.GetPostsBatchForIndexing", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) GetPostsBefore(options model.GetPostsOptions) (*model.PostList, *model.AppError) {
+	resultVar0, resultVar1 := s.PostStore.GetPostsBefore(options)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PostStore.GetPostsBefore", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) GetPostsByIds(postIds []string) ([]*model.Post, *model.AppError) {
+	resultVar0, resultVar1 := s.PostStore.GetPostsByIds(postIds)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PostStore.GetPostsByIds", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) GetPostsCreatedAt(channelId string, time int64) ([]*model.Post, *model.AppError) {
+	resultVar0, resultVar1 := s.PostStore.GetPostsCreatedAt(channelId, time)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PostStore.GetPostsCreatedAt", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) GetPostsDelta(cursor model.PostsDeltaCursor, limit int) (*model.PostsDelta, model.PostsDeltaCursor, *model.AppError) {
+	resultVar0, resultVar1, resultVar2 := s.PostStore.GetPostsDelta(cursor, limit)
+	if resultVar2 != nil && IsReadOnlyError(resultVar2) {
+		s.Root.OnReadOnly(resultVar2)
+		resultVar2 = model.NewAppError("ReadOnlyLayer.PostStore.GetPostsDelta", "store.read_only.app_error", nil, resultVar2.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1, resultVar2
+}
+
+func (s *ReadOnlyLayerPostStore) GetPostsForChannelSnapshot(channelId string, cursor model.ChannelSnapshotCursor, limit int) ([]*model.Post, *model.AppError) {
+	resultVar0, resultVar1 := s.PostStore.GetPostsForChannelSnapshot(channelId, cursor, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PostStore.GetPostsForChannelSnapshot", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) GetPostsSince(options model.GetPostsSinceOptions, allowFromCache bool) (*model.PostList, *model.AppError) {
+	resultVar0, resultVar1 := s.PostStore.GetPostsSince(options, allowFromCache)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PostStore.GetPostsSince", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) GetRepliesForExport(parentId string) ([]*model.ReplyForExport, *model.AppError) {
+	resultVar0, resultVar1 := s.PostStore.GetRepliesForExport(parentId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PostStore.GetRepliesForExport", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) GetSingle(id string) (*model.Post, *model.AppError) {
+	resultVar0, resultVar1 := s.PostStore.GetSingle(id)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PostStore.GetSingle", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) InvalidateLastPostTimeCache(channelId string) {
+	s.PostStore.InvalidateLastPostTimeCache(channelId)
+}
+
+func (s *ReadOnlyLayerPostStore) Overwrite(post *model.Post) (*model.Post, *model.AppError) {
+	resultVar0, resultVar1 := s.PostStore.Overwrite(post)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PostStore.Overwrite", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) OverwriteMultiple(posts []*model.Post) ([]*model.Post, int, *model.AppError) {
+	resultVar0, resultVar1, resultVar2 := s.PostStore.OverwriteMultiple(posts)
+	if resultVar2 != nil && IsReadOnlyError(resultVar2) {
+		s.Root.OnReadOnly(resultVar2)
+		resultVar2 = model.NewAppError("ReadOnlyLayer.PostStore.OverwriteMultiple", "store.read_only.app_error", nil, resultVar2.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1, resultVar2
+}
+
+func (s *ReadOnlyLayerPostStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.PostStore.PermanentDeleteBatch(endTime, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PostStore.PermanentDeleteBatch", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) PermanentDeleteByChannel(channelId string) *model.AppError {
+	resultVar0 := s.PostStore.PermanentDeleteByChannel(channelId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.PostStore.PermanentDeleteByChannel", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerPostStore) PermanentDeleteByUser(userId string) *model.AppError {
+	resultVar0 := s.PostStore.PermanentDeleteByUser(userId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.PostStore.PermanentDeleteByUser", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerPostStore) Save(post *model.Post) (*model.Post, *model.AppError) {
+	resultVar0, resultVar1 := s.PostStore.Save(post)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PostStore.Save", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) SaveMultiple(posts []*model.Post) ([]*model.Post, int, *model.AppError) {
+	resultVar0, resultVar1, resultVar2 := s.PostStore.SaveMultiple(posts)
+	if resultVar2 != nil && IsReadOnlyError(resultVar2) {
+		s.Root.OnReadOnly(resultVar2)
+		resultVar2 = model.NewAppError("ReadOnlyLayer.PostStore.SaveMultiple", "store.read_only.app_error", nil, resultVar2.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1, resultVar2
+}
+
+func (s *ReadOnlyLayerPostStore) SaveWithOutboxEvent(post *model.Post, message *model.WebSocketEvent) (*model.Post, *model.OutboxEvent, *model.AppError) {
+	resultVar0, resultVar1, resultVar2 := s.PostStore.SaveWithOutboxEvent(post, message)
+	if resultVar2 != nil && IsReadOnlyError(resultVar2) {
+		s.Root.OnReadOnly(resultVar2)
+		resultVar2 = model.NewAppError("ReadOnlyLayer.PostStore.SaveWithOutboxEvent", "store.read_only.app_error", nil, resultVar2.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1, resultVar2
+}
+
+func (s *ReadOnlyLayerPostStore) Search(teamId string, userId string, params *model.SearchParams) (*model.PostList, *model.AppError) {
+	resultVar0, resultVar1 := s.PostStore.Search(teamId, userId, params)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PostStore.Search", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) SearchPostsInTeamForUser(paramsList []*model.SearchParams, userId string, teamId string, isOrSearch bool, includeDeletedChannels bool, page int, perPage int) (*model.PostSearchResults, *model.AppError) {
+	resultVar0, resultVar1 := s.PostStore.SearchPostsInTeamForUser(paramsList, userId, teamId, isOrSearch, includeDeletedChannels, page, perPage)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PostStore.SearchPostsInTeamForUser", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) Update(newPost *model.Post, oldPost *model.Post) (*model.Post, *model.AppError) {
+	resultVar0, resultVar1 := s.PostStore.Update(newPost, oldPost)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PostStore.Update", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostStore) UpdateWithOutboxEvent(newPost *model.Post, oldPost *model.Post, message *model.WebSocketEvent) (*model.Post, *model.OutboxEvent, *model.AppError) {
+	resultVar0, resultVar1, resultVar2 := s.PostStore.UpdateWithOutboxEvent(newPost, oldPost, message)
+	if resultVar2 != nil && IsReadOnlyError(resultVar2) {
+		s.Root.OnReadOnly(resultVar2)
+		resultVar2 = model.NewAppError("ReadOnlyLayer.PostStore.UpdateWithOutboxEvent", "store.read_only.app_error", nil, resultVar2.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1, resultVar2
+}
+
+func (s *ReadOnlyLayerPostAcknowledgementStore) Delete(postId string, userId string) error {
+	resultVar0 := s.PostAcknowledgementStore.Delete(postId, userId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerPostAcknowledgementStore) GetForPost(postId string) ([]*model.PostAcknowledgement, error) {
+	resultVar0, resultVar1 := s.PostAcknowledgementStore.GetForPost(postId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostAcknowledgementStore) PermanentDeleteByChannel(channelId string) error {
+	resultVar0 := s.PostAcknowledgementStore.PermanentDeleteByChannel(channelId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerPostAcknowledgementStore) PermanentDeleteByUser(userId string) error {
+	resultVar0 := s.PostAcknowledgementStore.PermanentDeleteByUser(userId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerPostAcknowledgementStore) Save(acknowledgement *model.PostAcknowledgement) (*model.PostAcknowledgement, error) {
+	resultVar0, resultVar1 := s.PostAcknowledgementStore.Save(acknowledgement)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostArchiveStore) ArchiveBatch(endTime int64, limit int64) (int64, error) {
+	resultVar0, resultVar1 := s.PostArchiveStore.ArchiveBatch(endTime, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostArchiveStore) Get(id string) (*model.Post, error) {
+	resultVar0, resultVar1 := s.PostArchiveStore.Get(id)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostArchiveStore) GetThread(id string) (*model.PostList, error) {
+	resultVar0, resultVar1 := s.PostArchiveStore.GetThread(id)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostArchiveStore) Search(channelIds []string, terms []string, limit int) ([]*model.Post, error) {
+	resultVar0, resultVar1 := s.PostArchiveStore.Search(channelIds, terms, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostIntegrityStore) Append(post *model.Post) (*model.PostIntegrityEntry, error) {
+	resultVar0, resultVar1 := s.PostIntegrityStore.Append(post)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostIntegrityStore) GetChannelIds(afterChannelId string, limit int) ([]string, error) {
+	resultVar0, resultVar1 := s.PostIntegrityStore.GetChannelIds(afterChannelId, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostIntegrityStore) GetForChannel(channelId string, afterSeq int64, limit int) ([]*model.PostIntegrityEntry, error) {
+	resultVar0, resultVar1 := s.PostIntegrityStore.GetForChannel(channelId, afterSeq, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostsPartitionStore) CopyBatch(cursor model.PostsPartitionCursor, limit int64) (int64, model.PostsPartitionCursor, error) {
+	resultVar0, resultVar1, resultVar2 := s.PostsPartitionStore.CopyBatch(cursor, limit)
+	if resultVar2 != nil && IsReadOnlyError(resultVar2) {
+		s.Root.OnReadOnly(resultVar2)
+		resultVar2 = NewErrReadOnly(resultVar2)
+	}
+	return resultVar0, resultVar1, resultVar2
+}
+
+func (s *ReadOnlyLayerPostsPartitionStore) DropPartitionsBefore(endTime int64) ([]string, error) {
+	resultVar0, resultVar1 := s.PostsPartitionStore.DropPartitionsBefore(endTime)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostsPartitionStore) EnsurePartitions(until int64) error {
+	resultVar0 := s.PostsPartitionStore.EnsurePartitions(until)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerPostsPartitionStore) FinishConversion() error {
+	resultVar0 := s.PostsPartitionStore.FinishConversion()
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerPostsPartitionStore) GetPartitions() ([]*model.PostsPartition, error) {
+	resultVar0, resultVar1 := s.PostsPartitionStore.GetPartitions()
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostsPartitionStore) IsPartitioned() (bool, error) {
+	resultVar0, resultVar1 := s.PostsPartitionStore.IsPartitioned()
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPostsPartitionStore) StartConversion() error {
+	resultVar0 := s.PostsPartitionStore.StartConversion()
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerPreferenceStore) CleanupFlagsBatch(limit int64) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.PreferenceStore.CleanupFlagsBatch(limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PreferenceStore.CleanupFlagsBatch", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPreferenceStore) Delete(userId string, category string, name string) *model.AppError {
+	resultVar0 := s.PreferenceStore.Delete(userId, category, name)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.PreferenceStore.Delete", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerPreferenceStore) DeleteCategory(userId string, category string) *model.AppError {
+	resultVar0 := s.PreferenceStore.DeleteCategory(userId, category)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.PreferenceStore.DeleteCategory", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerPreferenceStore) DeleteCategoryAndName(category string, name string) *model.AppError {
+	resultVar0 := s.PreferenceStore.DeleteCategoryAndName(category, name)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.PreferenceStore.DeleteCategoryAndName", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerPreferenceStore) Get(userId string, category string, name string) (*model.Preference, *model.AppError) {
+	resultVar0, resultVar1 := s.PreferenceStore.Get(userId, category, name)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PreferenceStore.Get", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPreferenceStore) GetAll(userId string) (model.Preferences, *model.AppError) {
+	resultVar0, resultVar1 := s.PreferenceStore.GetAll(userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PreferenceStore.GetAll", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPreferenceStore) GetCategory(userId string, category string) (model.Preferences, *model.AppError) {
+	resultVar0, resultVar1 := s.PreferenceStore.GetCategory(userId, category)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PreferenceStore.GetCategory", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPreferenceStore) PermanentDeleteByUser(userId string) *model.AppError {
+	resultVar0 := s.PreferenceStore.PermanentDeleteByUser(userId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.PreferenceStore.PermanentDeleteByUser", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerPreferenceStore) RenameCategory(oldCategory string, newCategory string, transform PreferenceTransform, limit int64) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.PreferenceStore.RenameCategory(oldCategory, newCategory, transform, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.PreferenceStore.RenameCategory", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPreferenceStore) Save(preferences *model.Preferences) *model.AppError {
+	resultVar0 := s.PreferenceStore.Save(preferences)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.PreferenceStore.Save", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerPresenceWebhookStore) Delete(id string, deleteAt int64) error {
+	resultVar0 := s.PresenceWebhookStore.Delete(id, deleteAt)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerPresenceWebhookStore) Get(id string) (*model.PresenceWebhook, error) {
+	resultVar0, resultVar1 := s.PresenceWebhookStore.Get(id)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPresenceWebhookStore) GetAll(offset int, limit int) ([]*model.PresenceWebhook, error) {
+	resultVar0, resultVar1 := s.PresenceWebhookStore.GetAll(offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPresenceWebhookStore) Save(hook *model.PresenceWebhook) (*model.PresenceWebhook, error) {
+	resultVar0, resultVar1 := s.PresenceWebhookStore.Save(hook)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerPresenceWebhookStore) Update(hook *model.PresenceWebhook) (*model.PresenceWebhook, error) {
+	resultVar0, resultVar1 := s.PresenceWebhookStore.Update(hook)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerReactionStore) BulkGetForPosts(postIds []string) ([]*model.Reaction, error) {
+	resultVar0, resultVar1 := s.ReactionStore.BulkGetForPosts(postIds)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerReactionStore) Delete(reaction *model.Reaction) (*model.Reaction, error) {
+	resultVar0, resultVar1 := s.ReactionStore.Delete(reaction)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerReactionStore) DeleteAllWithEmojiName(emojiName string) error {
+	resultVar0 := s.ReactionStore.DeleteAllWithEmojiName(emojiName)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerReactionStore) GetForPost(postId string, allowFromCache bool) ([]*model.Reaction, error) {
+	resultVar0, resultVar1 := s.ReactionStore.GetForPost(postId, allowFromCache)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerReactionStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+	resultVar0, resultVar1 := s.ReactionStore.PermanentDeleteBatch(endTime, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerReactionStore) Save(reaction *model.Reaction) (*model.Reaction, error) {
+	resultVar0, resultVar1 := s.ReactionStore.Save(reaction)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerRetentionPolicyStore) Delete(id string) error {
+	resultVar0 := s.RetentionPolicyStore.Delete(id)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerRetentionPolicyStore) Get(id string) (*model.RetentionPolicy, error) {
+	resultVar0, resultVar1 := s.RetentionPolicyStore.Get(id)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerRetentionPolicyStore) GetAll(offset int, limit int) ([]*model.RetentionPolicy, error) {
+	resultVar0, resultVar1 := s.RetentionPolicyStore.GetAll(offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerRetentionPolicyStore) GetForChannel(channelId string) (*model.RetentionPolicy, error) {
+	resultVar0, resultVar1 := s.RetentionPolicyStore.GetForChannel(channelId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerRetentionPolicyStore) PermanentDeleteAllFilesBatch(endTime int64, cursor model.RetentionPolicyCursor, limit int64) (int64, model.RetentionPolicyCursor, error) {
+	resultVar0, resultVar1, resultVar2 := s.RetentionPolicyStore.PermanentDeleteAllFilesBatch(endTime, cursor, limit)
+	if resultVar2 != nil && IsReadOnlyError(resultVar2) {
+		s.Root.OnReadOnly(resultVar2)
+		resultVar2 = NewErrReadOnly(resultVar2)
+	}
+	return resultVar0, resultVar1, resultVar2
+}
+
+func (s *ReadOnlyLayerRetentionPolicyStore) PermanentDeleteFilesBatch(policyId string, endTime int64, cursor model.RetentionPolicyCursor, limit int64) (int64, model.RetentionPolicyCursor, error) {
+	resultVar0, resultVar1, resultVar2 := s.RetentionPolicyStore.PermanentDeleteFilesBatch(policyId, endTime, cursor, limit)
+	if resultVar2 != nil && IsReadOnlyError(resultVar2) {
+		s.Root.OnReadOnly(resultVar2)
+		resultVar2 = NewErrReadOnly(resultVar2)
+	}
+	return resultVar0, resultVar1, resultVar2
+}
+
+func (s *ReadOnlyLayerRetentionPolicyStore) PermanentDeletePostsBatch(policyId string, endTime int64, cursor model.RetentionPolicyCursor, limit int64) (int64, model.RetentionPolicyCursor, error) {
+	resultVar0, resultVar1, resultVar2 := s.RetentionPolicyStore.PermanentDeletePostsBatch(policyId, endTime, cursor, limit)
+	if resultVar2 != nil && IsReadOnlyError(resultVar2) {
+		s.Root.OnReadOnly(resultVar2)
+		resultVar2 = NewErrReadOnly(resultVar2)
+	}
+	return resultVar0, resultVar1, resultVar2
+}
+
+func (s *ReadOnlyLayerRetentionPolicyStore) Save(policy *model.RetentionPolicy) (*model.RetentionPolicy, error) {
+	resultVar0, resultVar1 := s.RetentionPolicyStore.Save(policy)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerRetentionPolicyStore) Update(policy *model.RetentionPolicy) (*model.RetentionPolicy, error) {
+	resultVar0, resultVar1 := s.RetentionPolicyStore.Update(policy)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerRoleStore) AllChannelSchemeRoles() ([]*model.Role, *model.AppError) {
+	resultVar0, resultVar1 := s.RoleStore.AllChannelSchemeRoles()
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.RoleStore.AllChannelSchemeRoles", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerRoleStore) ChannelHigherScopedPermissions(roleNames []string) (map[string]*model.RolePermissions, *model.AppError) {
+	resultVar0, resultVar1 := s.RoleStore.ChannelHigherScopedPermissions(roleNames)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.RoleStore.ChannelHigherScopedPermissions", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerRoleStore) ChannelRolesUnderTeamRole(roleName string) ([]*model.Role, *model.AppError) {
+	resultVar0, resultVar1 := s.RoleStore.ChannelRolesUnderTeamRole(roleName)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.RoleStore.ChannelRolesUnderTeamRole", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerRoleStore) Delete(roleId string) (*model.Role, *model.AppError) {
+	resultVar0, resultVar1 := s.RoleStore.Delete(roleId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.RoleStore.Delete", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerRoleStore) Get(roleId string) (*model.Role, *model.AppError) {
+	resultVar0, resultVar1 := s.RoleStore.Get(roleId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.RoleStore.Get", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerRoleStore) GetAll() ([]*model.Role, *model.AppError) {
+	resultVar0, resultVar1 := s.RoleStore.GetAll()
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.RoleStore.GetAll", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerRoleStore) GetByName(name string) (*model.Role, *model.AppError) {
+	resultVar0, resultVar1 := s.RoleStore.GetByName(name)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.RoleStore.GetByName", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerRoleStore) GetByNames(names []string) ([]*model.Role, *model.AppError) {
+	resultVar0, resultVar1 := s.RoleStore.GetByNames(names)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.RoleStore.GetByNames", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerRoleStore) PermanentDeleteAll() *model.AppError {
+	resultVar0 := s.RoleStore.PermanentDeleteAll()
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.RoleStore.PermanentDeleteAll", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerRoleStore) Save(role *model.Role) (*model.Role, *model.AppError) {
+	resultVar0, resultVar1 := s.RoleStore.Save(role)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.RoleStore.Save", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerSchemeStore) CountByScope(scope string) (int64, error) {
+	resultVar0, resultVar1 := s.SchemeStore.CountByScope(scope)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerSchemeStore) CountWithoutPermission(scope string, permissionID string, roleScope model.RoleScope, roleType model.RoleType) (int64, error) {
+	resultVar0, resultVar1 := s.SchemeStore.CountWithoutPermission(scope, permissionID, roleScope, roleType)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerSchemeStore) Delete(schemeId string) (*model.Scheme, error) {
+	resultVar0, resultVar1 := s.SchemeStore.Delete(schemeId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerSchemeStore) Get(schemeId string) (*model.Scheme, error) {
+	resultVar0, resultVar1 := s.SchemeStore.Get(schemeId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerSchemeStore) GetAllPage(scope string, offset int, limit int) ([]*model.Scheme, error) {
+	resultVar0, resultVar1 := s.SchemeStore.GetAllPage(scope, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerSchemeStore) GetByName(schemeName string) (*model.Scheme, error) {
+	resultVar0, resultVar1 := s.SchemeStore.GetByName(schemeName)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerSchemeStore) PermanentDeleteAll() error {
+	resultVar0 := s.SchemeStore.PermanentDeleteAll()
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerSchemeStore) Save(scheme *model.Scheme) (*model.Scheme, error) {
+	resultVar0, resultVar1 := s.SchemeStore.Save(scheme)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerSessionStore) AnalyticsSessionCount(estimate bool) (int64, error) {
+	resultVar0, resultVar1 := s.SessionStore.AnalyticsSessionCount(estimate)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerSessionStore) Cleanup(expiryTime int64, batchSize int64) {
+	s.SessionStore.Cleanup(expiryTime, batchSize)
+}
+
+func (s *ReadOnlyLayerSessionStore) Get(sessionIdOrToken string) (*model.Session, error) {
+	resultVar0, resultVar1 := s.SessionStore.Get(sessionIdOrToken)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerSessionStore) GetSessions(userId string) ([]*model.Session, error) {
+	resultVar0, resultVar1 := s.SessionStore.GetSessions(userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerSessionStore) GetSessionsExpired(thresholdMillis int64, mobileOnly bool, unnotifiedOnly bool) ([]*model.Session, error) {
+	resultVar0, resultVar1 := s.SessionStore.GetSessionsExpired(thresholdMillis, mobileOnly, unnotifiedOnly)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerSessionStore) GetSessionsWithActiveDeviceIds(userId string) ([]*model.Session, error) {
+	resultVar0, resultVar1 := s.SessionStore.GetSessionsWithActiveDeviceIds(userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerSessionStore) PermanentDeleteSessionsByUser(teamId string) error {
+	resultVar0 := s.SessionStore.PermanentDeleteSessionsByUser(teamId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerSessionStore) Remove(sessionIdOrToken string) error {
+	resultVar0 := s.SessionStore.Remove(sessionIdOrToken)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerSessionStore) RemoveAllSessions() error {
+	resultVar0 := s.SessionStore.RemoveAllSessions()
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerSessionStore) Save(session *model.Session) (*model.Session, error) {
+	resultVar0, resultVar1 := s.SessionStore.Save(session)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerSessionStore) UpdateDeviceId(id string, deviceId string, expiresAt int64) (string, error) {
+	resultVar0, resultVar1 := s.SessionStore.UpdateDeviceId(id, deviceId, expiresAt)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerSessionStore) UpdateExpiredNotify(sessionid string, notified bool) error {
+	resultVar0 := s.SessionStore.UpdateExpiredNotify(sessionid, notified)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerSessionStore) UpdateExpiresAt(sessionId string, time int64) error {
+	resultVar0 := s.SessionStore.UpdateExpiresAt(sessionId, time)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerSessionStore) UpdateLastActivityAt(sessionId string, time int64) error {
+	resultVar0 := s.SessionStore.UpdateLastActivityAt(sessionId, time)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerSessionStore) UpdateProps(session *model.Session) error {
+	resultVar0 := s.SessionStore.UpdateProps(session)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerSessionStore) UpdateRoles(userId string, roles string) (string, error) {
+	resultVar0, resultVar1 := s.SessionStore.UpdateRoles(userId, roles)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerSlugHistoryStore) Delete(kind string, scopeId string, name string) error {
+	resultVar0 := s.SlugHistoryStore.Delete(kind, scopeId, name)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerSlugHistoryStore) Get(kind string, scopeId string, name string) (*model.SlugHistory, error) {
+	resultVar0, resultVar1 := s.SlugHistoryStore.Get(kind, scopeId, name)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerSlugHistoryStore) PermanentDeleteByTarget(targetId string) error {
+	resultVar0 := s.SlugHistoryStore.PermanentDeleteByTarget(targetId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerSlugHistoryStore) Save(entry *model.SlugHistory) (*model.SlugHistory, error) {
+	resultVar0, resultVar1 := s.SlugHistoryStore.Save(entry)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerStatusStore) Get(userId string) (*model.Status, *model.AppError) {
+	resultVar0, resultVar1 := s.StatusStore.Get(userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.StatusStore.Get", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerStatusStore) GetByIds(userIds []string) ([]*model.Status, *model.AppError) {
+	resultVar0, resultVar1 := s.StatusStore.GetByIds(userIds)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.StatusStore.GetByIds", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerStatusStore) GetTotalActiveUsersCount() (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.StatusStore.GetTotalActiveUsersCount()
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.StatusStore.GetTotalActiveUsersCount", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerStatusStore) ResetAll() *model.AppError {
+	resultVar0 := s.StatusStore.ResetAll()
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.StatusStore.ResetAll", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerStatusStore) SaveOrUpdate(status *model.Status) *model.AppError {
+	resultVar0 := s.StatusStore.SaveOrUpdate(status)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.StatusStore.SaveOrUpdate", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerStatusStore) UpdateLastActivityAt(userId string, lastActivityAt int64) *model.AppError {
+	resultVar0 := s.StatusStore.UpdateLastActivityAt(userId, lastActivityAt)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.StatusStore.UpdateLastActivityAt", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerStatusStore) UpdateLastActivityAts(lastActivityAts map[string]int64) *model.AppError {
+	resultVar0 := s.StatusStore.UpdateLastActivityAts(lastActivityAts)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.StatusStore.UpdateLastActivityAts", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerStatusAutomationStore) Delete(userId string) error {
+	resultVar0 := s.StatusAutomationStore.Delete(userId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerStatusAutomationStore) Get(userId string) (*model.StatusAutomation, error) {
+	resultVar0, resultVar1 := s.StatusAutomationStore.Get(userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerStatusAutomationStore) GetEndedBefore(endTime int64, limit int) ([]*model.StatusAutomation, error) {
+	resultVar0, resultVar1 := s.StatusAutomationStore.GetEndedBefore(endTime, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerStatusAutomationStore) Save(automation *model.StatusAutomation) (*model.StatusAutomation, error) {
+	resultVar0, resultVar1 := s.StatusAutomationStore.Save(automation)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerStatusAutomationStore) Update(automation *model.StatusAutomation) (*model.StatusAutomation, error) {
+	resultVar0, resultVar1 := s.StatusAutomationStore.Update(automation)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerSystemStore) Get() (model.StringMap, *model.AppError) {
+	resultVar0, resultVar1 := s.SystemStore.Get()
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.SystemStore.Get", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerSystemStore) GetByName(name string) (*model.System, *model.AppError) {
+	resultVar0, resultVar1 := s.SystemStore.GetByName(name)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.SystemStore.GetByName", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerSystemStore) InsertIfExists(system *model.System) (*model.System, *model.AppError) {
+	resultVar0, resultVar1 := s.SystemStore.InsertIfExists(system)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.SystemStore.InsertIfExists", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerSystemStore) IsDatabaseReadOnly() (bool, *model.AppError) {
+	resultVar0, resultVar1 := s.SystemStore.IsDatabaseReadOnly()
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.SystemStore.IsDatabaseReadOnly", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerSystemStore) PermanentDeleteByName(name string) (*model.System, *model.AppError) {
+	resultVar0, resultVar1 := s.SystemStore.PermanentDeleteByName(name)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.SystemStore.PermanentDeleteByName", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerSystemStore) Save(system *model.System) *model.AppError {
+	resultVar0 := s.SystemStore.Save(system)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.SystemStore.Save", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerSystemStore) SaveOrUpdate(system *model.System) *model.AppError {
+	resultVar0 := s.SystemStore.SaveOrUpdate(system)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.SystemStore.SaveOrUpdate", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerSystemStore) Update(system *model.System) *model.AppError {
+	resultVar0 := s.SystemStore.Update(system)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.SystemStore.Update", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerTeamStore) AnalyticsGetTeamCountForScheme(ctx context.Context, schemeId string) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.AnalyticsGetTeamCountForScheme(ctx, schemeId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.AnalyticsGetTeamCountForScheme", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) AnalyticsPrivateTeamCount(ctx context.Context) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.AnalyticsPrivateTeamCount(ctx)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.AnalyticsPrivateTeamCount", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) AnalyticsPublicTeamCount(ctx context.Context) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.AnalyticsPublicTeamCount(ctx)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.AnalyticsPublicTeamCount", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) AnalyticsTeamCount(ctx context.Context, includeDeleted bool) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.AnalyticsTeamCount(ctx, includeDeleted)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.AnalyticsTeamCount", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) ClearAllCustomRoleAssignments(ctx context.Context) *model.AppError {
+	resultVar0 := s.TeamStore.ClearAllCustomRoleAssignments(ctx)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.TeamStore.ClearAllCustomRoleAssignments", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerTeamStore) ClearCaches() {
+	s.TeamStore.ClearCaches()
+}
+
+func (s *ReadOnlyLayerTeamStore) Get(ctx context.Context, id string) (*model.Team, error) {
+	resultVar0, resultVar1 := s.TeamStore.Get(ctx, id)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetActiveMemberCount(ctx context.Context, teamId string, restrictions *model.ViewUsersRestrictions) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.GetActiveMemberCount(ctx, teamId, restrictions)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.GetActiveMemberCount", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetActiveMemberCounts(ctx context.Context, teamIds []string) (map[string]int64, error) {
+	resultVar0, resultVar1 := s.TeamStore.GetActiveMemberCounts(ctx, teamIds)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetAll(ctx context.Context) ([]*model.Team, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.GetAll(ctx)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.GetAll", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetAllDeletedPage(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.GetAllDeletedPage(ctx, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.GetAllDeletedPage", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetAllForExportAfter(ctx context.Context, limit int, afterId string) ([]*model.TeamForExport, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.GetAllForExportAfter(ctx, limit, afterId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.GetAllForExportAfter", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetAllPage(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.GetAllPage(ctx, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.GetAllPage", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetAllPrivateTeamListing(ctx context.Context) ([]*model.Team, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.GetAllPrivateTeamListing(ctx)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.GetAllPrivateTeamListing", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetAllPrivateTeamPageListing(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.GetAllPrivateTeamPageListing(ctx, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.GetAllPrivateTeamPageListing", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetAllPublicTeamPageListing(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.GetAllPublicTeamPageListing(ctx, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.GetAllPublicTeamPageListing", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetAllTeamListing(ctx context.Context) ([]*model.Team, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.GetAllTeamListing(ctx)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.GetAllTeamListing", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetAllTeamPageListing(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.GetAllTeamPageListing(ctx, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.GetAllTeamPageListing", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetByAllowedDomain(ctx context.Context, domain string) ([]*model.Team, error) {
+	resultVar0, resultVar1 := s.TeamStore.GetByAllowedDomain(ctx, domain)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetByInviteId(ctx context.Context, inviteId string) (*model.Team, error) {
+	resultVar0, resultVar1 := s.TeamStore.GetByInviteId(ctx, inviteId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetByName(ctx context.Context, name string) (*model.Team, error) {
+	resultVar0, resultVar1 := s.TeamStore.GetByName(ctx, name)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetByNames(ctx context.Context, name []string) ([]*model.Team, error) {
+	resultVar0, resultVar1 := s.TeamStore.GetByNames(ctx, name)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetChannelUnreadsForAllTeams(ctx context.Context, excludeTeamId string, userId string) ([]*model.ChannelUnread, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.GetChannelUnreadsForAllTeams(ctx, excludeTeamId, userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.GetChannelUnreadsForAllTeams", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetChannelUnreadsForTeam(ctx context.Context, teamId string, userId string) ([]*model.ChannelUnread, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.GetChannelUnreadsForTeam(ctx, teamId, userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.GetChannelUnreadsForTeam", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetDirectoryStats(ctx context.Context, joinedSince int64) ([]*model.TeamDirectoryStats, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.GetDirectoryStats(ctx, joinedSince)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.GetDirectoryStats", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetGroupSyncedTeamsPage(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.GetGroupSyncedTeamsPage(ctx, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.GetGroupSyncedTeamsPage", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetMember(ctx context.Context, teamId string, userId string, allowFromCache bool) (*model.TeamMember, error) {
+	resultVar0, resultVar1 := s.TeamStore.GetMember(ctx, teamId, userId, allowFromCache)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetMembers(ctx context.Context, teamId string, offset int, limit int, teamMembersGetOptions *model.TeamMembersGetOptions) ([]*model.TeamMember, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.GetMembers(ctx, teamId, offset, limit, teamMembersGetOptions)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.GetMembers", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetMembersByIds(ctx context.Context, teamId string, userIds []string, restrictions *model.ViewUsersRestrictions) ([]*model.TeamMember, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.GetMembersByIds(ctx, teamId, userIds, restrictions)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.GetMembersByIds", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetMembersByTeamIds(ctx context.Context, teamIds []string, userId string) ([]*model.TeamMember, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.GetMembersByTeamIds(ctx, teamIds, userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.GetMembersByTeamIds", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetMembersWithExpiredRoles(ctx context.Context, expiredBefore int64, limit int) ([]*model.TeamMember, error) {
+	resultVar0, resultVar1 := s.TeamStore.GetMembersWithExpiredRoles(ctx, expiredBefore, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetNewTeamMembersSince(ctx context.Context, teamId string, since int64, offset int, limit int) (*model.NewTeamMembersList, error) {
+	resultVar0, resultVar1 := s.TeamStore.GetNewTeamMembersSince(ctx, teamId, since, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetPolicyForTeam(ctx context.Context, teamId string) (*model.RetentionPolicy, error) {
+	resultVar0, resultVar1 := s.TeamStore.GetPolicyForTeam(ctx, teamId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetTeamMembersForExport(ctx context.Context, userId string) ([]*model.TeamMemberForExport, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.GetTeamMembersForExport(ctx, userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.GetTeamMembersForExport", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetTeamsByScheme(ctx context.Context, schemeId string, offset int, limit int) ([]*model.Team, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.GetTeamsByScheme(ctx, schemeId, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.GetTeamsByScheme", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetTeamsByUserId(ctx context.Context, userId string, opts *model.TeamsForUserGetOptions) ([]*model.Team, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.GetTeamsByUserId(ctx, userId, opts)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.GetTeamsByUserId", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetTeamsForPolicyPage(ctx context.Context, policyId string, offset int, limit int) ([]*model.Team, error) {
+	resultVar0, resultVar1 := s.TeamStore.GetTeamsForPolicyPage(ctx, policyId, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetTeamsForUser(ctx context.Context, userId string) ([]*model.TeamMember, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.GetTeamsForUser(ctx, userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.GetTeamsForUser", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetTeamsForUserWithPagination(ctx context.Context, userId string, page int, perPage int, opts *model.TeamMembersForUserGetOptions) ([]*model.TeamMember, error) {
+	resultVar0, resultVar1 := s.TeamStore.GetTeamsForUserWithPagination(ctx, userId, page, perPage, opts)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetTeamsWithNoActiveMembers(ctx context.Context, offset int, limit int) ([]*model.Team, error) {
+	resultVar0, resultVar1 := s.TeamStore.GetTeamsWithNoActiveMembers(ctx, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetTotalMemberCount(ctx context.Context, teamId string, restrictions *model.ViewUsersRestrictions) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.GetTotalMemberCount(ctx, teamId, restrictions)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.GetTotalMemberCount", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetUnreadsForAllTeams(ctx context.Context, excludeTeamId string, userId string) ([]*model.TeamUnread, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.GetUnreadsForAllTeams(ctx, excludeTeamId, userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.GetUnreadsForAllTeams", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GetUserTeamIds(ctx context.Context, userId string, allowFromCache bool) ([]string, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.GetUserTeamIds(ctx, userId, allowFromCache)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.GetUserTeamIds", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GroupSyncedTeamCount(ctx context.Context) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.GroupSyncedTeamCount(ctx)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.GroupSyncedTeamCount", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) GroupSyncedTeamCountByGroup(ctx context.Context, groupId string) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.GroupSyncedTeamCountByGroup(ctx, groupId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.GroupSyncedTeamCountByGroup", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) InvalidateAllTeamIdsForUser(userId string) {
+	s.TeamStore.InvalidateAllTeamIdsForUser(userId)
+}
+
+func (s *ReadOnlyLayerTeamStore) MigrateTeamMembers(ctx context.Context, fromTeamId string, fromUserId string, batchSize int) (map[string]string, int64, *model.AppError) {
+	resultVar0, resultVar1, resultVar2 := s.TeamStore.MigrateTeamMembers(ctx, fromTeamId, fromUserId, batchSize)
+	if resultVar2 != nil && IsReadOnlyError(resultVar2) {
+		s.Root.OnReadOnly(resultVar2)
+		resultVar2 = model.NewAppError("ReadOnlyLayer.TeamStore.MigrateTeamMembers", "store.read_only.app_error", nil, resultVar2.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1, resultVar2
+}
+
+func (s *ReadOnlyLayerTeamStore) PermanentDelete(ctx context.Context, teamId string) *model.AppError {
+	resultVar0 := s.TeamStore.PermanentDelete(ctx, teamId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.TeamStore.PermanentDelete", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerTeamStore) RegenerateInviteId(ctx context.Context, teamId string, inviteExpiresAt int64) (*model.Team, error) {
+	resultVar0, resultVar1 := s.TeamStore.RegenerateInviteId(ctx, teamId, inviteExpiresAt)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) RemoveAllMembersByTeam(ctx context.Context, teamId string) *model.AppError {
+	resultVar0 := s.TeamStore.RemoveAllMembersByTeam(ctx, teamId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.TeamStore.RemoveAllMembersByTeam", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerTeamStore) RemoveAllMembersByUser(ctx context.Context, userId string) ([]string, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.RemoveAllMembersByUser(ctx, userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.RemoveAllMembersByUser", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) RemoveMember(ctx context.Context, teamId string, userId string) *model.AppError {
+	resultVar0 := s.TeamStore.RemoveMember(ctx, teamId, userId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.TeamStore.RemoveMember", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerTeamStore) RemoveMembers(ctx context.Context, teamId string, userIds []string) *model.AppError {
+	resultVar0 := s.TeamStore.RemoveMembers(ctx, teamId, userIds)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.TeamStore.RemoveMembers", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerTeamStore) ResetAllTeamSchemes(ctx context.Context) *model.AppError {
+	resultVar0 := s.TeamStore.ResetAllTeamSchemes(ctx)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.TeamStore.ResetAllTeamSchemes", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerTeamStore) Restore(ctx context.Context, teamId string) error {
+	resultVar0 := s.TeamStore.Restore(ctx, teamId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerTeamStore) Save(ctx context.Context, team *model.Team) (*model.Team, error) {
+	resultVar0, resultVar1 := s.TeamStore.Save(ctx, team)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) SaveMember(ctx context.Context, member *model.TeamMember, maxUsersPerTeam int) (*model.TeamMember, error) {
+	resultVar0, resultVar1 := s.TeamStore.SaveMember(ctx, member, maxUsersPerTeam)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) SaveMultiple(ctx context.Context, teams []*model.Team) ([]*model.Team, []error, error) {
+	resultVar0, resultVar1, resultVar2 := s.TeamStore.SaveMultiple(ctx, teams)
+	if resultVar2 != nil && IsReadOnlyError(resultVar2) {
+		s.Root.OnReadOnly(resultVar2)
+		resultVar2 = NewErrReadOnly(resultVar2)
+	}
+	return resultVar0, resultVar1, resultVar2
+}
+
+func (s *ReadOnlyLayerTeamStore) SaveMultipleMembers(ctx context.Context, members []*model.TeamMember, maxUsersPerTeam int) ([]*model.TeamMember, error) {
+	resultVar0, resultVar1 := s.TeamStore.SaveMultipleMembers(ctx, members, maxUsersPerTeam)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) SearchAll(ctx context.Context, term string, opts *model.TeamSearchOpts) ([]*model.Team, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.SearchAll(ctx, term, opts)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.SearchAll", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) SearchAllPaged(ctx context.Context, term string, opts *model.TeamSearchOpts, page int, perPage int) ([]*model.Team, int64, *model.AppError) {
+	resultVar0, resultVar1, resultVar2 := s.TeamStore.SearchAllPaged(ctx, term, opts, page, perPage)
+	if resultVar2 != nil && IsReadOnlyError(resultVar2) {
+		s.Root.OnReadOnly(resultVar2)
+		resultVar2 = model.NewAppError("ReadOnlyLayer.TeamStore.SearchAllPaged", "store.read_only.app_error", nil, resultVar2.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1, resultVar2
+}
+
+func (s *ReadOnlyLayerTeamStore) SearchOpen(ctx context.Context, term string, opts *model.TeamSearchOpts) ([]*model.Team, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.SearchOpen(ctx, term, opts)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.SearchOpen", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) SearchPrivate(ctx context.Context, term string, opts *model.TeamSearchOpts) ([]*model.Team, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.SearchPrivate(ctx, term, opts)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.SearchPrivate", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) SearchPrivateForUser(ctx context.Context, userId string, term string, opts *model.TeamSearchOpts) ([]*model.Team, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.SearchPrivateForUser(ctx, userId, term, opts)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.SearchPrivateForUser", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) SetPolicy(ctx context.Context, teamId string, policyId string) error {
+	resultVar0 := s.TeamStore.SetPolicy(ctx, teamId, policyId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerTeamStore) Update(ctx context.Context, team *model.Team) (*model.Team, error) {
+	resultVar0, resultVar1 := s.TeamStore.Update(ctx, team)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) UpdateLastTeamIconUpdate(ctx context.Context, teamId string, curTime int64) *model.AppError {
+	resultVar0 := s.TeamStore.UpdateLastTeamIconUpdate(ctx, teamId, curTime)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.TeamStore.UpdateLastTeamIconUpdate", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerTeamStore) UpdateMember(ctx context.Context, member *model.TeamMember) (*model.TeamMember, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.UpdateMember(ctx, member)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.UpdateMember", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) UpdateMembersRole(ctx context.Context, teamID string, userIDs []string) *model.AppError {
+	resultVar0 := s.TeamStore.UpdateMembersRole(ctx, teamID, userIDs)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.TeamStore.UpdateMembersRole", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerTeamStore) UpdateMembersRoleAndGetUpdated(ctx context.Context, teamID string, userIDs []string) ([]*model.TeamMember, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.UpdateMembersRoleAndGetUpdated(ctx, teamID, userIDs)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.UpdateMembersRoleAndGetUpdated", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) UpdateMultipleMembers(ctx context.Context, members []*model.TeamMember) ([]*model.TeamMember, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.UpdateMultipleMembers(ctx, members)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.UpdateMultipleMembers", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) UserBelongsToTeam(ctx context.Context, userId string, teamId string, allowFromCache bool) (bool, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.UserBelongsToTeam(ctx, userId, teamId, allowFromCache)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.UserBelongsToTeam", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamStore) UserBelongsToTeams(ctx context.Context, userId string, teamIds []string) (bool, *model.AppError) {
+	resultVar0, resultVar1 := s.TeamStore.UserBelongsToTeams(ctx, userId, teamIds)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.TeamStore.UserBelongsToTeams", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamInviteLinkStore) CountForTeam(teamId string) (int64, error) {
+	resultVar0, resultVar1 := s.TeamInviteLinkStore.CountForTeam(teamId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamInviteLinkStore) Get(id string) (*model.TeamInviteLink, error) {
+	resultVar0, resultVar1 := s.TeamInviteLinkStore.Get(id)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamInviteLinkStore) GetByName(name string) (*model.TeamInviteLink, error) {
+	resultVar0, resultVar1 := s.TeamInviteLinkStore.GetByName(name)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamInviteLinkStore) GetForTeam(teamId string) ([]*model.TeamInviteLink, error) {
+	resultVar0, resultVar1 := s.TeamInviteLinkStore.GetForTeam(teamId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamInviteLinkStore) IncrementUseCount(id string, now int64) error {
+	resultVar0 := s.TeamInviteLinkStore.IncrementUseCount(id, now)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerTeamInviteLinkStore) PermanentDeleteByTeam(teamId string) error {
+	resultVar0 := s.TeamInviteLinkStore.PermanentDeleteByTeam(teamId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerTeamInviteLinkStore) Revoke(id string, deleteAt int64) error {
+	resultVar0 := s.TeamInviteLinkStore.Revoke(id, deleteAt)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerTeamInviteLinkStore) Save(link *model.TeamInviteLink) (*model.TeamInviteLink, error) {
+	resultVar0, resultVar1 := s.TeamInviteLinkStore.Save(link)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamMemberHistoryStore) GetUsersInTeamDuring(startTime int64, endTime int64, teamId string) ([]*model.TeamMemberHistoryResult, error) {
+	resultVar0, resultVar1 := s.TeamMemberHistoryStore.GetUsersInTeamDuring(startTime, endTime, teamId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTeamMemberHistoryStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+	resultVar0, resultVar1 := s.TeamMemberHistoryStore.PermanentDeleteBatch(endTime, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTermsOfServiceStore) Get(id string, allowFromCache bool) (*model.TermsOfService, error) {
+	resultVar0, resultVar1 := s.TermsOfServiceStore.Get(id, allowFromCache)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTermsOfServiceStore) GetLatest(allowFromCache bool) (*model.TermsOfService, error) {
+	resultVar0, resultVar1 := s.TermsOfServiceStore.GetLatest(allowFromCache)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTermsOfServiceStore) Save(termsOfService *model.TermsOfService) (*model.TermsOfService, error) {
+	resultVar0, resultVar1 := s.TermsOfServiceStore.Save(termsOfService)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTermsOfServicePolicyStore) Delete(id string, deleteAt int64) error {
+	resultVar0 := s.TermsOfServicePolicyStore.Delete(id, deleteAt)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerTermsOfServicePolicyStore) Get(id string) (*model.TermsOfServicePolicy, error) {
+	resultVar0, resultVar1 := s.TermsOfServicePolicyStore.Get(id)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTermsOfServicePolicyStore) GetAcceptanceCounts(version *model.TermsOfServicePolicyVersion) (int64, int64, error) {
+	resultVar0, resultVar1, resultVar2 := s.TermsOfServicePolicyStore.GetAcceptanceCounts(version)
+	if resultVar2 != nil && IsReadOnlyError(resultVar2) {
+		s.Root.OnReadOnly(resultVar2)
+		resultVar2 = NewErrReadOnly(resultVar2)
+	}
+	return resultVar0, resultVar1, resultVar2
+}
+
+func (s *ReadOnlyLayerTermsOfServicePolicyStore) GetAll(offset int, limit int) ([]*model.TermsOfServicePolicy, error) {
+	resultVar0, resultVar1 := s.TermsOfServicePolicyStore.GetAll(offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTermsOfServicePolicyStore) GetLatestVersion(policyId string) (*model.TermsOfServicePolicyVersion, error) {
+	resultVar0, resultVar1 := s.TermsOfServicePolicyStore.GetLatestVersion(policyId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTermsOfServicePolicyStore) GetOutstandingUsers(version *model.TermsOfServicePolicyVersion, offset int, limit int) ([]*model.User, error) {
+	resultVar0, resultVar1 := s.TermsOfServicePolicyStore.GetOutstandingUsers(version, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTermsOfServicePolicyStore) GetPendingForUser(userId string) ([]*model.TermsOfServicePolicyVersion, error) {
+	resultVar0, resultVar1 := s.TermsOfServicePolicyStore.GetPendingForUser(userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTermsOfServicePolicyStore) Save(policy *model.TermsOfServicePolicy) (*model.TermsOfServicePolicy, error) {
+	resultVar0, resultVar1 := s.TermsOfServicePolicyStore.Save(policy)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTermsOfServicePolicyStore) SaveAcceptance(acceptance *model.TermsOfServiceAcceptance) error {
+	resultVar0 := s.TermsOfServicePolicyStore.SaveAcceptance(acceptance)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerTermsOfServicePolicyStore) SaveVersion(version *model.TermsOfServicePolicyVersion) (*model.TermsOfServicePolicyVersion, error) {
+	resultVar0, resultVar1 := s.TermsOfServicePolicyStore.SaveVersion(version)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTermsOfServicePolicyStore) Update(policy *model.TermsOfServicePolicy) (*model.TermsOfServicePolicy, error) {
+	resultVar0, resultVar1 := s.TermsOfServicePolicyStore.Update(policy)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTokenStore) Cleanup() {
+	s.TokenStore.Cleanup()
+}
+
+func (s *ReadOnlyLayerTokenStore) Delete(token string) error {
+	resultVar0 := s.TokenStore.Delete(token)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerTokenStore) GetByToken(token string) (*model.Token, error) {
+	resultVar0, resultVar1 := s.TokenStore.GetByToken(token)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerTokenStore) RemoveAllTokensByType(tokenType string) error {
+	resultVar0 := s.TokenStore.RemoveAllTokensByType(tokenType)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerTokenStore) Save(recovery *model.Token) error {
+	resultVar0 := s.TokenStore.Save(recovery)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerUserStore) AnalyticsActiveCount(time int64, options model.UserCountOptions) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.AnalyticsActiveCount(time, options)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.AnalyticsActiveCount", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) AnalyticsGetGuestCount() (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.AnalyticsGetGuestCount()
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.AnalyticsGetGuestCount", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) AnalyticsGetInactiveUsersCount() (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.AnalyticsGetInactiveUsersCount()
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.AnalyticsGetInactiveUsersCount", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) AnalyticsGetSystemAdminCount() (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.AnalyticsGetSystemAdminCount()
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.AnalyticsGetSystemAdminCount", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) AnalyticsSeatCountsByDay(startTime int64, endTime int64, excludeServiceAccounts bool) ([]*model.SeatUsagePoint, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.AnalyticsSeatCountsByDay(startTime, endTime, excludeServiceAccounts)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.AnalyticsSeatCountsByDay", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) AutocompleteUsersInChannel(teamId string, channelId string, term string, options *model.UserSearchOptions) (*model.UserAutocompleteInChannel, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.AutocompleteUsersInChannel(teamId, channelId, term, options)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.AutocompleteUsersInChannel", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) ClearAllCustomRoleAssignments() *model.AppError {
+	resultVar0 := s.UserStore.ClearAllCustomRoleAssignments()
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.UserStore.ClearAllCustomRoleAssignments", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerUserStore) ClearCaches() {
+	s.UserStore.ClearCaches()
+}
+
+func (s *ReadOnlyLayerUserStore) Count(options model.UserCountOptions) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.Count(options)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.Count", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) DeactivateGuests() ([]string, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.DeactivateGuests()
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.DeactivateGuests", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) DemoteUserToGuest(userID string) *model.AppError {
+	resultVar0 := s.UserStore.DemoteUserToGuest(userID)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.UserStore.DemoteUserToGuest", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerUserStore) Get(id string) (*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.Get(id)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.Get", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetAll() ([]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetAll()
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetAll", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetAllAfter(limit int, afterId string) ([]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetAllAfter(limit, afterId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetAllAfter", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetAllNotInAuthService(authServices []string) ([]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetAllNotInAuthService(authServices)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetAllNotInAuthService", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetAllProfiles(options *model.UserGetOptions) ([]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetAllProfiles(options)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetAllProfiles", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetAllProfilesInChannel(channelId string, allowFromCache bool) (map[string]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetAllProfilesInChannel(channelId, allowFromCache)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetAllProfilesInChannel", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetAllUsingAuthService(authService string) ([]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetAllUsingAuthService(authService)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetAllUsingAuthService", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetAnyUnreadPostCountForChannel(userId string, channelId string) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetAnyUnreadPostCountForChannel(userId, channelId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetAnyUnreadPostCountForChannel", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetByAuth(authData *string, authService string) (*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetByAuth(authData, authService)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetByAuth", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetByEmail(email string) (*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetByEmail(email)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetByEmail", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetByUsername(username string) (*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetByUsername(username)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetByUsername", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetChannelGroupUsers(channelID string) ([]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetChannelGroupUsers(channelID)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetChannelGroupUsers", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetDirectReports(managerId string, offset int, limit int) ([]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetDirectReports(managerId, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetDirectReports", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetEtagForAllProfiles() string {
+	return s.UserStore.GetEtagForAllProfiles()
+}
+
+func (s *ReadOnlyLayerUserStore) GetEtagForProfiles(teamId string) string {
+	return s.UserStore.GetEtagForProfiles(teamId)
+}
+
+func (s *ReadOnlyLayerUserStore) GetEtagForProfilesNotInTeam(teamId string) string {
+	return s.UserStore.GetEtagForProfilesNotInTeam(teamId)
+}
+
+func (s *ReadOnlyLayerUserStore) GetForLogin(loginId string, allowSignInWithUsername bool, allowSignInWithEmail bool) (*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetForLogin(loginId, allowSignInWithUsername, allowSignInWithEmail)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetForLogin", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetKnownUsers(userID string) ([]string, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetKnownUsers(userID)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetKnownUsers", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetNewUsersForTeam(teamId string, offset int, limit int, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetNewUsersForTeam(teamId, offset, limit, viewRestrictions)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetNewUsersForTeam", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetProfileByGroupChannelIdsForUser(userId string, channelIds []string) (map[string][]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetProfileByGroupChannelIdsForUser(userId, channelIds)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetProfileByGroupChannelIdsForUser", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetProfileByIds(userIds []string, options *UserGetByIdsOpts, allowFromCache bool) ([]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetProfileByIds(userIds, options, allowFromCache)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetProfileByIds", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetProfiles(options *model.UserGetOptions) ([]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetProfiles(options)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetProfiles", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetProfilesByUsernames(usernames []string, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetProfilesByUsernames(usernames, viewRestrictions)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetProfilesByUsernames", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetProfilesInChannel(channelId string, offset int, limit int) ([]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetProfilesInChannel(channelId, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetProfilesInChannel", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetProfilesInChannelByStatus(channelId string, offset int, limit int) ([]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetProfilesInChannelByStatus(channelId, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetProfilesInChannelByStatus", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetProfilesNotInChannel(teamId string, channelId string, groupConstrained bool, offset int, limit int, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetProfilesNotInChannel(teamId, channelId, groupConstrained, offset, limit, viewRestrictions)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetProfilesNotInChannel", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetProfilesNotInTeam(teamId string, groupConstrained bool, offset int, limit int, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetProfilesNotInTeam(teamId, groupConstrained, offset, limit, viewRestrictions)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetProfilesNotInTeam", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetProfilesWithoutTeam(options *model.UserGetOptions) ([]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetProfilesWithoutTeam(options)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetProfilesWithoutTeam", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetRecentlyActiveUsersForTeam(teamId string, offset int, limit int, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetRecentlyActiveUsersForTeam(teamId, offset, limit, viewRestrictions)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetRecentlyActiveUsersForTeam", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetReportingChain(userId string) ([]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetReportingChain(userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetReportingChain", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetServiceAccounts(offset int, limit int, includeDeleted bool) ([]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetServiceAccounts(offset, limit, includeDeleted)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetServiceAccounts", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetSystemAdminProfiles() (map[string]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetSystemAdminProfiles()
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetSystemAdminProfiles", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetTeamGroupUsers(teamID string) ([]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetTeamGroupUsers(teamID)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetTeamGroupUsers", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetUnreadCount(userId string) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetUnreadCount(userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetUnreadCount", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetUnreadCountForChannel(userId string, channelId string) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetUnreadCountForChannel(userId, channelId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetUnreadCountForChannel", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetUsersBatchForIndexing(startTime int64, endTime int64, limit int) ([]*model.UserForIndexing, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetUsersBatchForIndexing(startTime, endTime, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetUsersBatchForIndexing", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) GetUsersWithExpiredRoles(expiredBefore int64, limit int) ([]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.GetUsersWithExpiredRoles(expiredBefore, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.GetUsersWithExpiredRoles", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) InferSystemInstallDate() (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.InferSystemInstallDate()
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.InferSystemInstallDate", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) InvalidateProfileCacheForUser(userId string) {
+	s.UserStore.InvalidateProfileCacheForUser(userId)
+}
+
+func (s *ReadOnlyLayerUserStore) InvalidateProfilesInChannelCache(channelId string) {
+	s.UserStore.InvalidateProfilesInChannelCache(channelId)
+}
+
+func (s *ReadOnlyLayerUserStore) InvalidateProfilesInChannelCacheByUser(userId string) {
+	s.UserStore.InvalidateProfilesInChannelCacheByUser(userId)
+}
+
+func (s *ReadOnlyLayerUserStore) PermanentDelete(userId string) *model.AppError {
+	resultVar0 := s.UserStore.PermanentDelete(userId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.UserStore.PermanentDelete", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerUserStore) PromoteGuestToUser(userID string) *model.AppError {
+	resultVar0 := s.UserStore.PromoteGuestToUser(userID)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.UserStore.PromoteGuestToUser", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerUserStore) ResetLastPictureUpdate(userId string) *model.AppError {
+	resultVar0 := s.UserStore.ResetLastPictureUpdate(userId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.UserStore.ResetLastPictureUpdate", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerUserStore) Save(user *model.User) (*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.Save(user)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.Save", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) Search(teamId string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.Search(teamId, term, options)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.Search", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) SearchInChannel(channelId string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.SearchInChannel(channelId, term, options)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.SearchInChannel", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) SearchInGroup(groupID string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.SearchInGroup(groupID, term, options)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.SearchInGroup", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) SearchNotInChannel(teamId string, channelId string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.SearchNotInChannel(teamId, channelId, term, options)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.SearchNotInChannel", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) SearchNotInTeam(notInTeamId string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.SearchNotInTeam(notInTeamId, term, options)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.SearchNotInTeam", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) SearchWithoutTeam(term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.SearchWithoutTeam(term, options)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.SearchWithoutTeam", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) Update(user *model.User, allowRoleUpdate bool) (*model.UserUpdate, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.Update(user, allowRoleUpdate)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.Update", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) UpdateAuthData(userId string, service string, authData *string, email string, resetMfa bool) (string, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.UpdateAuthData(userId, service, authData, email, resetMfa)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.UpdateAuthData", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) UpdateFailedPasswordAttempts(userId string, attempts int) *model.AppError {
+	resultVar0 := s.UserStore.UpdateFailedPasswordAttempts(userId, attempts)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.UserStore.UpdateFailedPasswordAttempts", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerUserStore) UpdateLastLogin(userId string, lastLogin int64) *model.AppError {
+	resultVar0 := s.UserStore.UpdateLastLogin(userId, lastLogin)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.UserStore.UpdateLastLogin", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerUserStore) UpdateLastPictureUpdate(userId string) *model.AppError {
+	resultVar0 := s.UserStore.UpdateLastPictureUpdate(userId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.UserStore.UpdateLastPictureUpdate", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerUserStore) UpdateMfaActive(userId string, active bool) *model.AppError {
+	resultVar0 := s.UserStore.UpdateMfaActive(userId, active)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.UserStore.UpdateMfaActive", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerUserStore) UpdateMfaSecret(userId string, secret string) *model.AppError {
+	resultVar0 := s.UserStore.UpdateMfaSecret(userId, secret)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.UserStore.UpdateMfaSecret", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerUserStore) UpdatePassword(userId string, newPassword string) *model.AppError {
+	resultVar0 := s.UserStore.UpdatePassword(userId, newPassword)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.UserStore.UpdatePassword", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerUserStore) UpdateUpdateAt(userId string) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.UpdateUpdateAt(userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.UpdateUpdateAt", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserStore) VerifyEmail(userId string, email string) (string, *model.AppError) {
+	resultVar0, resultVar1 := s.UserStore.VerifyEmail(userId, email)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserStore.VerifyEmail", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserAccessTokenStore) Delete(tokenId string) *model.AppError {
+	resultVar0 := s.UserAccessTokenStore.Delete(tokenId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.UserAccessTokenStore.Delete", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerUserAccessTokenStore) DeleteAllForUser(userId string) *model.AppError {
+	resultVar0 := s.UserAccessTokenStore.DeleteAllForUser(userId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.UserAccessTokenStore.DeleteAllForUser", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerUserAccessTokenStore) Get(tokenId string) (*model.UserAccessToken, *model.AppError) {
+	resultVar0, resultVar1 := s.UserAccessTokenStore.Get(tokenId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserAccessTokenStore.Get", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserAccessTokenStore) GetAll(offset int, limit int) ([]*model.UserAccessToken, *model.AppError) {
+	resultVar0, resultVar1 := s.UserAccessTokenStore.GetAll(offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserAccessTokenStore.GetAll", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserAccessTokenStore) GetByToken(tokenString string) (*model.UserAccessToken, *model.AppError) {
+	resultVar0, resultVar1 := s.UserAccessTokenStore.GetByToken(tokenString)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserAccessTokenStore.GetByToken", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserAccessTokenStore) GetByUser(userId string, page int, perPage int) ([]*model.UserAccessToken, *model.AppError) {
+	resultVar0, resultVar1 := s.UserAccessTokenStore.GetByUser(userId, page, perPage)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserAccessTokenStore.GetByUser", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserAccessTokenStore) Save(token *model.UserAccessToken) (*model.UserAccessToken, *model.AppError) {
+	resultVar0, resultVar1 := s.UserAccessTokenStore.Save(token)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserAccessTokenStore.Save", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserAccessTokenStore) Search(term string) ([]*model.UserAccessToken, *model.AppError) {
+	resultVar0, resultVar1 := s.UserAccessTokenStore.Search(term)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.UserAccessTokenStore.Search", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserAccessTokenStore) UpdateTokenDisable(tokenId string) *model.AppError {
+	resultVar0 := s.UserAccessTokenStore.UpdateTokenDisable(tokenId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.UserAccessTokenStore.UpdateTokenDisable", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerUserAccessTokenStore) UpdateTokenEnable(tokenId string) *model.AppError {
+	resultVar0 := s.UserAccessTokenStore.UpdateTokenEnable(tokenId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.UserAccessTokenStore.UpdateTokenEnable", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerUserPropertyStore) DeleteField(id string, deleteAt int64) error {
+	resultVar0 := s.UserPropertyStore.DeleteField(id, deleteAt)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerUserPropertyStore) GetField(id string) (*model.UserPropertyField, error) {
+	resultVar0, resultVar1 := s.UserPropertyStore.GetField(id)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserPropertyStore) GetFields() ([]*model.UserPropertyField, error) {
+	resultVar0, resultVar1 := s.UserPropertyStore.GetFields()
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserPropertyStore) GetValuesForUser(userId string) ([]*model.UserPropertyValue, error) {
+	resultVar0, resultVar1 := s.UserPropertyStore.GetValuesForUser(userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserPropertyStore) PermanentDeleteByUser(userId string) error {
+	resultVar0 := s.UserPropertyStore.PermanentDeleteByUser(userId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerUserPropertyStore) SaveField(field *model.UserPropertyField) (*model.UserPropertyField, error) {
+	resultVar0, resultVar1 := s.UserPropertyStore.SaveField(field)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserPropertyStore) SaveValuesForUser(userId string, values []*model.UserPropertyValue) error {
+	resultVar0 := s.UserPropertyStore.SaveValuesForUser(userId, values)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerUserPropertyStore) UpdateField(field *model.UserPropertyField) (*model.UserPropertyField, error) {
+	resultVar0, resultVar1 := s.UserPropertyStore.UpdateField(field)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserRelationshipStore) Delete(userId string, otherUserId string, relationshipType string) error {
+	resultVar0 := s.UserRelationshipStore.Delete(userId, otherUserId, relationshipType)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerUserRelationshipStore) Get(userId string, otherUserId string, relationshipType string) (*model.UserRelationship, error) {
+	resultVar0, resultVar1 := s.UserRelationshipStore.Get(userId, otherUserId, relationshipType)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserRelationshipStore) GetForUser(userId string) ([]*model.UserRelationship, error) {
+	resultVar0, resultVar1 := s.UserRelationshipStore.GetForUser(userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserRelationshipStore) GetUserIdsRelatedTo(otherUserId string) ([]string, error) {
+	resultVar0, resultVar1 := s.UserRelationshipStore.GetUserIdsRelatedTo(otherUserId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserRelationshipStore) PermanentDeleteByUser(userId string) error {
+	resultVar0 := s.UserRelationshipStore.PermanentDeleteByUser(userId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerUserRelationshipStore) Save(relationship *model.UserRelationship) (*model.UserRelationship, error) {
+	resultVar0, resultVar1 := s.UserRelationshipStore.Save(relationship)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserTermsOfServiceStore) Delete(userId string, termsOfServiceId string) error {
+	resultVar0 := s.UserTermsOfServiceStore.Delete(userId, termsOfServiceId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = NewErrReadOnly(resultVar0)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerUserTermsOfServiceStore) GetByUser(userId string) (*model.UserTermsOfService, error) {
+	resultVar0, resultVar1 := s.UserTermsOfServiceStore.GetByUser(userId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerUserTermsOfServiceStore) Save(userTermsOfService *model.UserTermsOfService) (*model.UserTermsOfService, error) {
+	resultVar0, resultVar1 := s.UserTermsOfServiceStore.Save(userTermsOfService)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = NewErrReadOnly(resultVar1)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerWebhookStore) AnalyticsIncomingCount(teamId string) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.WebhookStore.AnalyticsIncomingCount(teamId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.WebhookStore.AnalyticsIncomingCount", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerWebhookStore) AnalyticsOutgoingCount(teamId string) (int64, *model.AppError) {
+	resultVar0, resultVar1 := s.WebhookStore.AnalyticsOutgoingCount(teamId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.WebhookStore.AnalyticsOutgoingCount", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerWebhookStore) ClearCaches() {
+	s.WebhookStore.ClearCaches()
+}
+
+func (s *ReadOnlyLayerWebhookStore) DeleteIncoming(webhookId string, time int64) *model.AppError {
+	resultVar0 := s.WebhookStore.DeleteIncoming(webhookId, time)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.WebhookStore.DeleteIncoming", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerWebhookStore) DeleteOutgoing(webhookId string, time int64) *model.AppError {
+	resultVar0 := s.WebhookStore.DeleteOutgoing(webhookId, time)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.WebhookStore.DeleteOutgoing", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerWebhookStore) GetIncoming(id string, allowFromCache bool) (*model.IncomingWebhook, *model.AppError) {
+	resultVar0, resultVar1 := s.WebhookStore.GetIncoming(id, allowFromCache)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.WebhookStore.GetIncoming", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerWebhookStore) GetIncomingByChannel(channelId string) ([]*model.IncomingWebhook, *model.AppError) {
+	resultVar0, resultVar1 := s.WebhookStore.GetIncomingByChannel(channelId)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.WebhookStore.GetIncomingByChannel", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerWebhookStore) GetIncomingByTeam(teamId string, offset int, limit int) ([]*model.IncomingWebhook, *model.AppError) {
+	resultVar0, resultVar1 := s.WebhookStore.GetIncomingByTeam(teamId, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.WebhookStore.GetIncomingByTeam", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerWebhookStore) GetIncomingByTeamByUser(teamId string, userId string, offset int, limit int) ([]*model.IncomingWebhook, *model.AppError) {
+	resultVar0, resultVar1 := s.WebhookStore.GetIncomingByTeamByUser(teamId, userId, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.WebhookStore.GetIncomingByTeamByUser", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerWebhookStore) GetIncomingList(offset int, limit int) ([]*model.IncomingWebhook, *model.AppError) {
+	resultVar0, resultVar1 := s.WebhookStore.GetIncomingList(offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.WebhookStore.GetIncomingList", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerWebhookStore) GetIncomingListByUser(userId string, offset int, limit int) ([]*model.IncomingWebhook, *model.AppError) {
+	resultVar0, resultVar1 := s.WebhookStore.GetIncomingListByUser(userId, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.WebhookStore.GetIncomingListByUser", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerWebhookStore) GetOutgoing(id string) (*model.OutgoingWebhook, *model.AppError) {
+	resultVar0, resultVar1 := s.WebhookStore.GetOutgoing(id)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.WebhookStore.GetOutgoing", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerWebhookStore) GetOutgoingByChannel(channelId string, offset int, limit int) ([]*model.OutgoingWebhook, *model.AppError) {
+	resultVar0, resultVar1 := s.WebhookStore.GetOutgoingByChannel(channelId, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.WebhookStore.GetOutgoingByChannel", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerWebhookStore) GetOutgoingByChannelByUser(channelId string, userId string, offset int, limit int) ([]*model.OutgoingWebhook, *model.AppError) {
+	resultVar0, resultVar1 := s.WebhookStore.GetOutgoingByChannelByUser(channelId, userId, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.WebhookStore.GetOutgoingByChannelByUser", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerWebhookStore) GetOutgoingByTeam(teamId string, offset int, limit int) ([]*model.OutgoingWebhook, *model.AppError) {
+	resultVar0, resultVar1 := s.WebhookStore.GetOutgoingByTeam(teamId, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.WebhookStore.GetOutgoingByTeam", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerWebhookStore) GetOutgoingByTeamByUser(teamId string, userId string, offset int, limit int) ([]*model.OutgoingWebhook, *model.AppError) {
+	resultVar0, resultVar1 := s.WebhookStore.GetOutgoingByTeamByUser(teamId, userId, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.WebhookStore.GetOutgoingByTeamByUser", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerWebhookStore) GetOutgoingList(offset int, limit int) ([]*model.OutgoingWebhook, *model.AppError) {
+	resultVar0, resultVar1 := s.WebhookStore.GetOutgoingList(offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.WebhookStore.GetOutgoingList", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerWebhookStore) GetOutgoingListByUser(userId string, offset int, limit int) ([]*model.OutgoingWebhook, *model.AppError) {
+	resultVar0, resultVar1 := s.WebhookStore.GetOutgoingListByUser(userId, offset, limit)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.WebhookStore.GetOutgoingListByUser", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerWebhookStore) InvalidateWebhookCache(webhook string) {
+	s.WebhookStore.InvalidateWebhookCache(webhook)
+}
+
+func (s *ReadOnlyLayerWebhookStore) PermanentDeleteIncomingByChannel(channelId string) *model.AppError {
+	resultVar0 := s.WebhookStore.PermanentDeleteIncomingByChannel(channelId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.WebhookStore.PermanentDeleteIncomingByChannel", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerWebhookStore) PermanentDeleteIncomingByUser(userId string) *model.AppError {
+	resultVar0 := s.WebhookStore.PermanentDeleteIncomingByUser(userId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.WebhookStore.PermanentDeleteIncomingByUser", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerWebhookStore) PermanentDeleteOutgoingByChannel(channelId string) *model.AppError {
+	resultVar0 := s.WebhookStore.PermanentDeleteOutgoingByChannel(channelId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.WebhookStore.PermanentDeleteOutgoingByChannel", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerWebhookStore) PermanentDeleteOutgoingByUser(userId string) *model.AppError {
+	resultVar0 := s.WebhookStore.PermanentDeleteOutgoingByUser(userId)
+	if resultVar0 != nil && IsReadOnlyError(resultVar0) {
+		s.Root.OnReadOnly(resultVar0)
+		resultVar0 = model.NewAppError("ReadOnlyLayer.WebhookStore.PermanentDeleteOutgoingByUser", "store.read_only.app_error", nil, resultVar0.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0
+}
+
+func (s *ReadOnlyLayerWebhookStore) SaveIncoming(webhook *model.IncomingWebhook) (*model.IncomingWebhook, *model.AppError) {
+	resultVar0, resultVar1 := s.WebhookStore.SaveIncoming(webhook)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.WebhookStore.SaveIncoming", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerWebhookStore) SaveOutgoing(webhook *model.OutgoingWebhook) (*model.OutgoingWebhook, *model.AppError) {
+	resultVar0, resultVar1 := s.WebhookStore.SaveOutgoing(webhook)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.WebhookStore.SaveOutgoing", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerWebhookStore) UpdateIncoming(webhook *model.IncomingWebhook) (*model.IncomingWebhook, *model.AppError) {
+	resultVar0, resultVar1 := s.WebhookStore.UpdateIncoming(webhook)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.WebhookStore.UpdateIncoming", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayerWebhookStore) UpdateOutgoing(hook *model.OutgoingWebhook) (*model.OutgoingWebhook, *model.AppError) {
+	resultVar0, resultVar1 := s.WebhookStore.UpdateOutgoing(hook)
+	if resultVar1 != nil && IsReadOnlyError(resultVar1) {
+		s.Root.OnReadOnly(resultVar1)
+		resultVar1 = model.NewAppError("ReadOnlyLayer.WebhookStore.UpdateOutgoing", "store.read_only.app_error", nil, resultVar1.Error(), http.StatusServiceUnavailable)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *ReadOnlyLayer) Close() {
+	s.Store.Close()
+}
+
+func (s *ReadOnlyLayer) DropAllTables() {
+	s.Store.DropAllTables()
+}
+
+func (s *ReadOnlyLayer) GetCurrentSchemaVersion() string {
+	return s.Store.GetCurrentSchemaVersion()
+}
+
+func (s *ReadOnlyLayer) LockToMaster() {
+	s.Store.LockToMaster()
+}
+
+func (s *ReadOnlyLayer) MarkSystemRanUnitTests() {
+	s.Store.MarkSystemRanUnitTests()
+}
+
+func (s *ReadOnlyLayer) SetContext(context context.Context) {
+	s.Store.SetContext(context)
+}
+
+func (s *ReadOnlyLayer) TotalMasterDbConnections() int {
+	return s.Store.TotalMasterDbConnections()
+}
+
+func (s *ReadOnlyLayer) TotalReadDbConnections() int {
+	return s.Store.TotalReadDbConnections()
+}
+
+func (s *ReadOnlyLayer) TotalSearchDbConnections() int {
+	return s.Store.TotalSearchDbConnections()
+}
+
+func (s *ReadOnlyLayer) UnlockFromMaster() {
+	s.Store.UnlockFromMaster()
+}
+
+// NewReadOnlyLayer wraps childStore so that the writes refused because the database is read-only
+// return a read-only error, onReadOnly being called with the original error.
+func NewReadOnlyLayer(childStore Store, onReadOnly func(err error)) *ReadOnlyLayer {
+	newStore := ReadOnlyLayer{
+		Store:      childStore,
+		OnReadOnly: onReadOnly,
+	}
+
+	newStore.AuditStore = &ReadOnlyLayerAuditStore{AuditStore: childStore.Audit(), Root: &newStore}
+	newStore.BotStore = &ReadOnlyLayerBotStore{BotStore: childStore.Bot(), Root: &newStore}
+	newStore.ChannelStore = &ReadOnlyLayerChannelStore{ChannelStore: childStore.Channel(), Root: &newStore}
+	newStore.ChannelBookmarkStore = &ReadOnlyLayerChannelBookmarkStore{ChannelBookmarkStore: childStore.ChannelBookmark(), Root: &newStore}
+	newStore.ChannelChangeStore = &ReadOnlyLayerChannelChangeStore{ChannelChangeStore: childStore.ChannelChange(), Root: &newStore}
+	newStore.ChannelGuestLinkStore = &ReadOnlyLayerChannelGuestLinkStore{ChannelGuestLinkStore: childStore.ChannelGuestLink(), Root: &newStore}
+	newStore.ChannelIntegrationStore = &ReadOnlyLayerChannelIntegrationStore{ChannelIntegrationStore: childStore.ChannelIntegration(), Root: &newStore}
+	newStore.ChannelMemberHistoryStore = &ReadOnlyLayerChannelMemberHistoryStore{ChannelMemberHistoryStore: childStore.ChannelMemberHistory(), Root: &newStore}
+	newStore.ClusterDiscoveryStore = &ReadOnlyLayerClusterDiscoveryStore{ClusterDiscoveryStore: childStore.ClusterDiscovery(), Root: &newStore}
+	newStore.CommandStore = &ReadOnlyLayerCommandStore{CommandStore: childStore.Command(), Root: &newStore}
+	newStore.CommandWebhookStore = &ReadOnlyLayerCommandWebhookStore{CommandWebhookStore: childStore.CommandWebhook(), Root: &newStore}
+	newStore.ComplianceStore = &ReadOnlyLayerComplianceStore{ComplianceStore: childStore.Compliance(), Root: &newStore}
+	newStore.EmailVerificationStore = &ReadOnlyLayerEmailVerificationStore{EmailVerificationStore: childStore.EmailVerification(), Root: &newStore}
+	newStore.EmojiStore = &ReadOnlyLayerEmojiStore{EmojiStore: childStore.Emoji(), Root: &newStore}
+	newStore.EventStreamStore = &ReadOnlyLayerEventStreamStore{EventStreamStore: childStore.EventStream(), Root: &newStore}
+	newStore.FileInfoStore = &ReadOnlyLayerFileInfoStore{FileInfoStore: childStore.FileInfo(), Root: &newStore}
+	newStore.GroupStore = &ReadOnlyLayerGroupStore{GroupStore: childStore.Group(), Root: &newStore}
+	newStore.IntegrationUsageStore = &ReadOnlyLayerIntegrationUsageStore{IntegrationUsageStore: childStore.IntegrationUsage(), Root: &newStore}
+	newStore.JobStore = &ReadOnlyLayerJobStore{JobStore: childStore.Job(), Root: &newStore}
+	newStore.LicenseStore = &ReadOnlyLayerLicenseStore{LicenseStore: childStore.License(), Root: &newStore}
+	newStore.LinkMetadataStore = &ReadOnlyLayerLinkMetadataStore{LinkMetadataStore: childStore.LinkMetadata(), Root: &newStore}
+	newStore.LoginHistoryStore = &ReadOnlyLayerLoginHistoryStore{LoginHistoryStore: childStore.LoginHistory(), Root: &newStore}
+	newStore.OAuthStore = &ReadOnlyLayerOAuthStore{OAuthStore: childStore.OAuth(), Root: &newStore}
+	newStore.OutboxStore = &ReadOnlyLayerOutboxStore{OutboxStore: childStore.Outbox(), Root: &newStore}
+	newStore.PendingPinStore = &ReadOnlyLayerPendingPinStore{PendingPinStore: childStore.PendingPin(), Root: &newStore}
+	newStore.PluginStore = &ReadOnlyLayerPluginStore{PluginStore: childStore.Plugin(), Root: &newStore}
+	newStore.PostStore = &ReadOnlyLayerPostStore{PostStore: childStore.Post(), Root: &newStore}
+	newStore.PostAcknowledgementStore = &ReadOnlyLayerPostAcknowledgementStore{PostAcknowledgementStore: childStore.PostAcknowledgement(), Root: &newStore}
+	newStore.PostArchiveStore = &ReadOnlyLayerPostArchiveStore{PostArchiveStore: childStore.PostArchive(), Root: &newStore}
+	newStore.PostIntegrityStore = &ReadOnlyLayerPostIntegrityStore{PostIntegrityStore: childStore.PostIntegrity(), Root: &newStore}
+	newStore.PostsPartitionStore = &ReadOnlyLayerPostsPartitionStore{PostsPartitionStore: childStore.PostsPartition(), Root: &newStore}
+	newStore.PreferenceStore = &ReadOnlyLayerPreferenceStore{PreferenceStore: childStore.Preference(), Root: &newStore}
+	newStore.PresenceWebhookStore = &ReadOnlyLayerPresenceWebhookStore{PresenceWebhookStore: childStore.PresenceWebhook(), Root: &newStore}
+	newStore.ReactionStore = &ReadOnlyLayerReactionStore{ReactionStore: childStore.Reaction(), Root: &newStore}
+	newStore.RetentionPolicyStore = &ReadOnlyLayerRetentionPolicyStore{RetentionPolicyStore: childStore.RetentionPolicy(), Root: &newStore}
+	newStore.RoleStore = &ReadOnlyLayerRoleStore{RoleStore: childStore.Role(), Root: &newStore}
+	newStore.SchemeStore = &ReadOnlyLayerSchemeStore{SchemeStore: childStore.Scheme(), Root: &newStore}
+	newStore.SessionStore = &ReadOnlyLayerSessionStore{SessionStore: childStore.Session(), Root: &newStore}
+	newStore.SlugHistoryStore = &ReadOnlyLayerSlugHistoryStore{SlugHistoryStore: childStore.SlugHistory(), Root: &newStore}
+	newStore.StatusStore = &ReadOnlyLayerStatusStore{StatusStore: childStore.Status(), Root: &newStore}
+	newStore.StatusAutomationStore = &ReadOnlyLayerStatusAutomationStore{StatusAutomationStore: childStore.StatusAutomation(), Root: &newStore}
+	newStore.SystemStore = &ReadOnlyLayerSystemStore{SystemStore: childStore.System(), Root: &newStore}
+	newStore.TeamStore = &ReadOnlyLayerTeamStore{TeamStore: childStore.Team(), Root: &newStore}
+	newStore.TeamInviteLinkStore = &ReadOnlyLayerTeamInviteLinkStore{TeamInviteLinkStore: childStore.TeamInviteLink(), Root: &newStore}
+	newStore.TeamMemberHistoryStore = &ReadOnlyLayerTeamMemberHistoryStore{TeamMemberHistoryStore: childStore.TeamMemberHistory(), Root: &newStore}
+	newStore.TermsOfServiceStore = &ReadOnlyLayerTermsOfServiceStore{TermsOfServiceStore: childStore.TermsOfService(), Root: &newStore}
+	newStore.TermsOfServicePolicyStore = &ReadOnlyLayerTermsOfServicePolicyStore{TermsOfServicePolicyStore: childStore.TermsOfServicePolicy(), Root: &newStore}
+	newStore.TokenStore = &ReadOnlyLayerTokenStore{TokenStore: childStore.Token(), Root: &newStore}
+	newStore.UserStore = &ReadOnlyLayerUserStore{UserStore: childStore.User(), Root: &newStore}
+	newStore.UserAccessTokenStore = &ReadOnlyLayerUserAccessTokenStore{UserAccessTokenStore: childStore.UserAccessToken(), Root: &newStore}
+	newStore.UserPropertyStore = &ReadOnlyLayerUserPropertyStore{UserPropertyStore: childStore.UserProperty(), Root: &newStore}
+	newStore.UserRelationshipStore = &ReadOnlyLayerUserRelationshipStore{UserRelationshipStore: childStore.UserRelationship(), Root: &newStore}
+	newStore.UserTermsOfServiceStore = &ReadOnlyLayerUserTermsOfServiceStore{UserTermsOfServiceStore: childStore.UserTermsOfService(), Root: &newStore}
+	newStore.WebhookStore = &ReadOnlyLayerWebhookStore{WebhookStore: childStore.Webhook(), Root: &newStore}
+	return &newStore
+}
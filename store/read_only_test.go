@@ -0,0 +1,70 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package store
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestIsReadOnlyError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		readOnly bool
+	}{
+		{name: "nil", err: nil, readOnly: false},
+		{name: "unrelated", err: errors.New("connection refused"), readOnly: false},
+		{name: "mysql read-only option", err: &mysql.MySQLError{Number: 1290, Message: "The MySQL server is running with the --read-only option so it cannot execute this statement"}, readOnly: true},
+		{name: "mysql other option", err: &mysql.MySQLError{Number: 1290, Message: "The MySQL server is running with the --secure-file-priv option so it cannot execute this statement"}, readOnly: false},
+		{name: "mysql read-only transaction", err: &mysql.MySQLError{Number: 1792, Message: "Cannot execute statement in a READ ONLY transaction."}, readOnly: true},
+		{name: "mysql duplicate entry", err: &mysql.MySQLError{Number: 1062, Message: "Duplicate entry"}, readOnly: false},
+		{name: "postgres read-only transaction", err: &pq.Error{Code: "25006", Message: "cannot execute INSERT in a read-only transaction"}, readOnly: true},
+		{name: "postgres unique violation", err: &pq.Error{Code: "23505"}, readOnly: false},
+		{name: "wrapped", err: pkgerrors.Wrap(&pq.Error{Code: "25006"}, "failed to save"), readOnly: true},
+		{name: "read-only error", err: NewErrReadOnly(errors.New("refused")), readOnly: true},
+		{name: "flattened into an app error", err: model.NewAppError("SqlPostStore.Save", "store.sql_post.save.app_error", nil, "pq: cannot execute INSERT in a read-only transaction", http.StatusInternalServerError), readOnly: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.readOnly, IsReadOnlyError(test.err))
+		})
+	}
+}
+
+type readOnlyTestPostStore struct {
+	PostStore
+	err *model.AppError
+}
+
+func (s *readOnlyTestPostStore) Save(post *model.Post) (*model.Post, *model.AppError) {
+	return nil, s.err
+}
+
+func TestReadOnlyLayer(t *testing.T) {
+	var reported []error
+	layer := &ReadOnlyLayer{OnReadOnly: func(err error) { reported = append(reported, err) }}
+	postStore := &readOnlyTestPostStore{}
+	layer.PostStore = &ReadOnlyLayerPostStore{PostStore: postStore, Root: layer}
+
+	postStore.err = model.NewAppError("SqlPostStore.Save", "store.sql_post.save.app_error", nil, "Error 1290: The MySQL server is running with the --read-only option so it cannot execute this statement", http.StatusInternalServerError)
+	_, err := layer.Post().Save(&model.Post{})
+	assert.Equal(t, "store.read_only.app_error", err.Id)
+	assert.Equal(t, http.StatusServiceUnavailable, err.StatusCode)
+	assert.Len(t, reported, 1)
+
+	postStore.err = model.NewAppError("SqlPostStore.Save", "store.sql_post.save.app_error", nil, "", http.StatusBadRequest)
+	_, err = layer.Post().Save(&model.Post{})
+	assert.Equal(t, "store.sql_post.save.app_error", err.Id)
+	assert.Len(t, reported, 1)
+}
@@ -84,6 +84,15 @@ func (s SearchPostStore) Update(newPost, oldPost *model.Post) (*model.Post, *mod
 	return post, err
 }
 
+func (s SearchPostStore) UpdateWithOutboxEvent(newPost, oldPost *model.Post, message *model.WebSocketEvent) (*model.Post, *model.OutboxEvent, *model.AppError) {
+	post, outboxEvent, err := s.PostStore.UpdateWithOutboxEvent(newPost, oldPost, message)
+
+	if err == nil {
+		s.indexPost(post)
+	}
+	return post, outboxEvent, err
+}
+
 func (s *SearchPostStore) Overwrite(post *model.Post) (*model.Post, *model.AppError) {
 	post, err := s.PostStore.Overwrite(post)
 	if err == nil {
@@ -101,6 +110,15 @@ func (s SearchPostStore) Save(post *model.Post) (*model.Post, *model.AppError) {
 	return npost, err
 }
 
+func (s SearchPostStore) SaveWithOutboxEvent(post *model.Post, message *model.WebSocketEvent) (*model.Post, *model.OutboxEvent, *model.AppError) {
+	npost, outboxEvent, err := s.PostStore.SaveWithOutboxEvent(post, message)
+
+	if err == nil {
+		s.indexPost(npost)
+	}
+	return npost, outboxEvent, err
+}
+
 func (s SearchPostStore) Delete(postId string, date int64, deletedByID string) *model.AppError {
 	err := s.PostStore.Delete(postId, date, deletedByID)
 
@@ -115,6 +133,20 @@ func (s SearchPostStore) Delete(postId string, date int64, deletedByID string) *
 	return err
 }
 
+func (s SearchPostStore) DeleteWithOutboxEvent(postId string, date int64, deletedByID string, message *model.WebSocketEvent) (*model.OutboxEvent, *model.AppError) {
+	outboxEvent, err := s.PostStore.DeleteWithOutboxEvent(postId, date, deletedByID, message)
+
+	if err == nil {
+		postList, err2 := s.PostStore.Get(postId, true)
+		if postList != nil && len(postList.Order) > 0 {
+			if err2 != nil {
+				s.deletePostIndex(postList.Posts[postList.Order[0]])
+			}
+		}
+	}
+	return outboxEvent, err
+}
+
 func (s SearchPostStore) PermanentDeleteByUser(userID string) *model.AppError {
 	err := s.PostStore.PermanentDeleteByUser(userID)
 	if err == nil {
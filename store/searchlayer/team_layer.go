@@ -4,6 +4,8 @@
 package searchlayer
 
 import (
+	"context"
+
 	model "github.com/mattermost/mattermost-server/v5/model"
 	store "github.com/mattermost/mattermost-server/v5/store"
 )
@@ -13,34 +15,34 @@ type SearchTeamStore struct {
 	rootStore *SearchStore
 }
 
-func (s SearchTeamStore) SaveMember(teamMember *model.TeamMember, maxUsersPerTeam int) (*model.TeamMember, *model.AppError) {
-	member, err := s.TeamStore.SaveMember(teamMember, maxUsersPerTeam)
+func (s SearchTeamStore) SaveMember(ctx context.Context, teamMember *model.TeamMember, maxUsersPerTeam int) (*model.TeamMember, error) {
+	member, err := s.TeamStore.SaveMember(ctx, teamMember, maxUsersPerTeam)
 	if err == nil {
 		s.rootStore.indexUserFromID(member.UserId)
 	}
 	return member, err
 }
 
-func (s SearchTeamStore) UpdateMember(teamMember *model.TeamMember) (*model.TeamMember, *model.AppError) {
-	member, err := s.TeamStore.UpdateMember(teamMember)
+func (s SearchTeamStore) UpdateMember(ctx context.Context, teamMember *model.TeamMember) (*model.TeamMember, *model.AppError) {
+	member, err := s.TeamStore.UpdateMember(ctx, teamMember)
 	if err == nil {
 		s.rootStore.indexUserFromID(member.UserId)
 	}
 	return member, err
 }
 
-func (s SearchTeamStore) RemoveMember(teamId string, userId string) *model.AppError {
-	err := s.TeamStore.RemoveMember(teamId, userId)
+func (s SearchTeamStore) RemoveMember(ctx context.Context, teamId string, userId string) *model.AppError {
+	err := s.TeamStore.RemoveMember(ctx, teamId, userId)
 	if err == nil {
 		s.rootStore.indexUserFromID(userId)
 	}
 	return err
 }
 
-func (s SearchTeamStore) RemoveAllMembersByUser(userId string) *model.AppError {
-	err := s.TeamStore.RemoveAllMembersByUser(userId)
+func (s SearchTeamStore) RemoveAllMembersByUser(ctx context.Context, userId string) ([]string, *model.AppError) {
+	teamIds, err := s.TeamStore.RemoveAllMembersByUser(ctx, userId)
 	if err == nil {
 		s.rootStore.indexUserFromID(userId)
 	}
-	return err
+	return teamIds, err
 }
@@ -33,7 +33,9 @@ func (s *SearchUserStore) deleteUserIndex(user *model.User) {
 
 func (s *SearchUserStore) Search(teamId, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError) {
 	for _, engine := range s.rootStore.searchEngine.GetActiveEngines() {
-		if engine.IsSearchEnabled() {
+		// Custom profile fields and account details aren't indexed, so filtering on them is left to
+		// the database.
+		if engine.IsSearchEnabled() && len(options.PropertyFilters) == 0 && options.UserAccountFilters.IsEmpty() {
 			listOfAllowedChannels, err := s.getListOfAllowedChannelsForTeam(teamId, options.ViewRestrictions)
 			if err != nil {
 				mlog.Error("Encountered error on Search.", mlog.String("search_engine", engine.GetName()), mlog.Err(err))
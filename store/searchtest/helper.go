@@ -4,6 +4,7 @@
 package searchtest
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -147,7 +148,7 @@ func (th *SearchTestHelper) CleanFixtures() error {
 }
 
 func (th *SearchTestHelper) createTeam(name, displayName, teamType string) (*model.Team, error) {
-	team, appError := th.Store.Team().Save(&model.Team{
+	team, appError := th.Store.Team().Save(context.Background(), &model.Team{
 		Name:        name,
 		DisplayName: displayName,
 		Type:        teamType,
@@ -160,11 +161,11 @@ func (th *SearchTestHelper) createTeam(name, displayName, teamType string) (*mod
 }
 
 func (th *SearchTestHelper) deleteTeam(team *model.Team) error {
-	appError := th.Store.Team().RemoveAllMembersByTeam(team.Id)
+	appError := th.Store.Team().RemoveAllMembersByTeam(context.Background(), team.Id)
 	if appError != nil {
 		return errors.New(appError.Error())
 	}
-	appError = th.Store.Team().PermanentDelete(team.Id)
+	appError = th.Store.Team().PermanentDelete(context.Background(), team.Id)
 	if appError != nil {
 		return errors.New(appError.Error())
 	}
@@ -413,7 +414,7 @@ func (th *SearchTestHelper) deleteUserPosts(userID string) error {
 
 func (th *SearchTestHelper) addUserToTeams(user *model.User, teamIDS []string) error {
 	for _, teamID := range teamIDS {
-		_, err := th.Store.Team().SaveMember(&model.TeamMember{TeamId: teamID, UserId: user.Id}, -1)
+		_, err := th.Store.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamID, UserId: user.Id}, -1)
 		if err != nil {
 			return errors.New(err.Error())
 		}
@@ -263,6 +263,11 @@ var searchPostStoreTests = []searchTest{
 		Fn:   testShouldNotReturnLinksEmbeddedInMarkdown,
 		Tags: []string{ENGINE_POSTGRES, ENGINE_ELASTICSEARCH},
 	},
+	{
+		Name: "Should be able to search CJK words using ngrams",
+		Fn:   testSearchCJKWordsUsingNgrams,
+		Tags: []string{ENGINE_MYSQL, ENGINE_POSTGRES},
+	},
 }
 
 func TestSearchPostStore(t *testing.T, s store.Store, testEngine *SearchTestEngine) {
@@ -1772,3 +1777,64 @@ func testShouldNotReturnLinksEmbeddedInMarkdown(t *testing.T, th *SearchTestHelp
 
 	require.Len(t, results.Posts, 0)
 }
+
+func testSearchCJKWordsUsingNgrams(t *testing.T, th *SearchTestHelper) {
+	p1, err := th.createPost(th.User.Id, th.ChannelBasic.Id, "東京で会議があります", "", model.POST_DEFAULT, 0, false)
+	require.Nil(t, err)
+	p2, err := th.createPost(th.User.Id, th.ChannelBasic.Id, "大阪の会議 meeting", "", model.POST_DEFAULT, 0, false)
+	require.Nil(t, err)
+	_, err = th.createPost(th.User.Id, th.ChannelBasic.Id, "東の京都", "", model.POST_DEFAULT, 0, false)
+	require.Nil(t, err)
+	defer th.deleteUserPosts(th.User.Id)
+
+	t.Run("Should search words within sentences", func(t *testing.T) {
+		params := &model.SearchParams{Terms: "会議"}
+		results, apperr := th.Store.Post().SearchPostsInTeamForUser([]*model.SearchParams{params}, th.User.Id, th.Team.Id, false, false, 0, 20)
+		require.Nil(t, apperr)
+
+		require.Len(t, results.Posts, 2)
+		th.checkPostInSearchResults(t, p1.Id, results.Posts)
+		th.checkPostInSearchResults(t, p2.Id, results.Posts)
+	})
+
+	t.Run("Should match the runes in order", func(t *testing.T) {
+		params := &model.SearchParams{Terms: "東京"}
+		results, apperr := th.Store.Post().SearchPostsInTeamForUser([]*model.SearchParams{params}, th.User.Id, th.Team.Id, false, false, 0, 20)
+		require.Nil(t, apperr)
+
+		require.Len(t, results.Posts, 1)
+		th.checkPostInSearchResults(t, p1.Id, results.Posts)
+	})
+
+	t.Run("Should exclude words", func(t *testing.T) {
+		params := &model.SearchParams{Terms: "会議", ExcludedTerms: "東京"}
+		results, apperr := th.Store.Post().SearchPostsInTeamForUser([]*model.SearchParams{params}, th.User.Id, th.Team.Id, false, false, 0, 20)
+		require.Nil(t, apperr)
+
+		require.Len(t, results.Posts, 1)
+		th.checkPostInSearchResults(t, p2.Id, results.Posts)
+	})
+
+	t.Run("Should combine with other words", func(t *testing.T) {
+		params := &model.SearchParams{Terms: "会議 meeting"}
+		results, apperr := th.Store.Post().SearchPostsInTeamForUser([]*model.SearchParams{params}, th.User.Id, th.Team.Id, false, false, 0, 20)
+		require.Nil(t, apperr)
+
+		require.Len(t, results.Posts, 1)
+		th.checkPostInSearchResults(t, p2.Id, results.Posts)
+	})
+
+	t.Run("Should find updated posts", func(t *testing.T) {
+		updated := p2.Clone()
+		updated.Message = "名古屋の会議"
+		_, apperr := th.Store.Post().Update(updated, p2)
+		require.Nil(t, apperr)
+
+		params := &model.SearchParams{Terms: "名古屋"}
+		results, apperr := th.Store.Post().SearchPostsInTeamForUser([]*model.SearchParams{params}, th.User.Id, th.Team.Id, false, false, 0, 20)
+		require.Nil(t, apperr)
+
+		require.Len(t, results.Posts, 1)
+		th.checkPostInSearchResults(t, p2.Id, results.Posts)
+	})
+}
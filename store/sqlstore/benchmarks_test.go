@@ -0,0 +1,61 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"io/ioutil"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store/storetest/benchmarks"
+)
+
+const defaultStoreBenchmarksThreshold = 0.2
+
+// TestStoreBenchmarks runs the store benchmarks against every database and writes their report to
+// MM_STORE_BENCHMARKS_OUTPUT. When MM_STORE_BENCHMARKS_BASELINE points to the report of a previous
+// run, the benchmarks slower than that run by more than MM_STORE_BENCHMARKS_THRESHOLD fail the test.
+func TestStoreBenchmarks(t *testing.T) {
+	output := os.Getenv("MM_STORE_BENCHMARKS_OUTPUT")
+	if output == "" {
+		t.Skip("MM_STORE_BENCHMARKS_OUTPUT is not set")
+	}
+
+	threshold := defaultStoreBenchmarksThreshold
+	if value := os.Getenv("MM_STORE_BENCHMARKS_THRESHOLD"); value != "" {
+		var err error
+		threshold, err = strconv.ParseFloat(value, 64)
+		require.NoError(t, err)
+	}
+
+	report := &benchmarks.Report{CreateAt: model.GetMillis()}
+	for _, st := range storeTypes {
+		st := st
+		t.Run(st.Name, func(t *testing.T) {
+			report.Results = append(report.Results, benchmarks.Run(t, *st.SqlSettings.DriverName, st.Store)...)
+		})
+	}
+
+	require.NoError(t, ioutil.WriteFile(output, []byte(report.ToJson()), 0644))
+
+	baselineFile := os.Getenv("MM_STORE_BENCHMARKS_BASELINE")
+	if baselineFile == "" {
+		return
+	}
+
+	file, err := os.Open(baselineFile)
+	require.NoError(t, err)
+	defer file.Close()
+
+	baseline, err := benchmarks.ReportFromJson(file)
+	require.NoError(t, err)
+
+	for _, regression := range report.Compare(baseline, threshold) {
+		t.Errorf("%s on %s regressed by %.0f%%: %d ns/op, was %d ns/op", regression.Benchmark, regression.Driver, regression.Change*100, regression.NsPerOp, regression.BaselineNsPerOp)
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+type SqlChannelBookmarkStore struct {
+	SqlStore
+}
+
+func newSqlChannelBookmarkStore(sqlStore SqlStore) store.ChannelBookmarkStore {
+	s := &SqlChannelBookmarkStore{sqlStore}
+
+	for _, db := range sqlStore.GetAllConns() {
+		table := db.AddTableWithName(model.ChannelBookmark{}, "ChannelBookmarks").SetKeys(false, "Id")
+		table.ColMap("Id").SetMaxSize(26)
+		table.ColMap("ChannelId").SetMaxSize(26)
+		table.ColMap("OwnerId").SetMaxSize(26)
+		table.ColMap("FileId").SetMaxSize(26)
+		table.ColMap("DisplayName").SetMaxSize(model.CHANNEL_BOOKMARK_DISPLAY_NAME_MAX_RUNES * 4)
+		table.ColMap("LinkUrl").SetMaxSize(model.CHANNEL_BOOKMARK_URL_MAX_LENGTH)
+		table.ColMap("ImageUrl").SetMaxSize(model.CHANNEL_BOOKMARK_URL_MAX_LENGTH)
+		table.ColMap("Emoji").SetMaxSize(model.CHANNEL_BOOKMARK_EMOJI_MAX_LENGTH)
+		table.ColMap("Type").SetMaxSize(26)
+	}
+
+	return s
+}
+
+func (s SqlChannelBookmarkStore) createIndexesIfNotExists() {
+	s.CreateIndexIfNotExists("idx_channelbookmarks_channel_id", "ChannelBookmarks", "ChannelId")
+	s.CreateIndexIfNotExists("idx_channelbookmarks_update_at", "ChannelBookmarks", "UpdateAt")
+	s.CreateIndexIfNotExists("idx_channelbookmarks_delete_at", "ChannelBookmarks", "DeleteAt")
+}
+
+func (s SqlChannelBookmarkStore) Save(bookmark *model.ChannelBookmark) (*model.ChannelBookmark, error) {
+	if bookmark.Id != "" {
+		return nil, store.NewErrInvalidInput("ChannelBookmark", "id", bookmark.Id)
+	}
+
+	bookmark.PreSave()
+	if err := bookmark.IsValid(); err != nil {
+		return nil, err
+	}
+
+	if err := s.GetMaster().Insert(bookmark); err != nil {
+		return nil, errors.Wrapf(err, "failed to save ChannelBookmark with id=%s", bookmark.Id)
+	}
+
+	return bookmark, nil
+}
+
+func (s SqlChannelBookmarkStore) Update(bookmark *model.ChannelBookmark) (*model.ChannelBookmark, error) {
+	bookmark.PreUpdate()
+	if err := bookmark.IsValid(); err != nil {
+		return nil, err
+	}
+
+	count, err := s.GetMaster().Update(bookmark)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update ChannelBookmark with id=%s", bookmark.Id)
+	}
+	if count != 1 {
+		return nil, store.NewErrNotFound("ChannelBookmark", bookmark.Id)
+	}
+
+	return bookmark, nil
+}
+
+func (s SqlChannelBookmarkStore) Get(id string, includeDeleted bool) (*model.ChannelBookmark, error) {
+	query := s.getQueryBuilder().
+		Select("*").
+		From("ChannelBookmarks").
+		Where(sq.Eq{"Id": id})
+
+	if !includeDeleted {
+		query = query.Where(sq.Eq{"DeleteAt": 0})
+	}
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_bookmark_tosql")
+	}
+
+	var bookmark *model.ChannelBookmark
+	if err := s.GetReplica().SelectOne(&bookmark, queryString, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("ChannelBookmark", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get ChannelBookmark with id=%s", id)
+	}
+
+	return bookmark, nil
+}
+
+func (s SqlChannelBookmarkStore) GetBookmarksForChannel(channelId string, includeDeleted bool) ([]*model.ChannelBookmark, error) {
+	query := s.getQueryBuilder().
+		Select("*").
+		From("ChannelBookmarks").
+		Where(sq.Eq{"ChannelId": channelId}).
+		OrderBy("SortOrder ASC", "CreateAt ASC")
+
+	if !includeDeleted {
+		query = query.Where(sq.Eq{"DeleteAt": 0})
+	}
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_bookmarks_tosql")
+	}
+
+	bookmarks := []*model.ChannelBookmark{}
+	if _, err := s.GetReplica().Select(&bookmarks, queryString, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to find ChannelBookmarks with channelId=%s", channelId)
+	}
+
+	return bookmarks, nil
+}
+
+func (s SqlChannelBookmarkStore) CountForChannel(channelId string) (int64, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("COUNT(*)").
+		From("ChannelBookmarks").
+		Where(sq.Eq{"ChannelId": channelId, "DeleteAt": 0}).
+		ToSql()
+	if err != nil {
+		return 0, errors.Wrap(err, "channel_bookmarks_count_tosql")
+	}
+
+	count, err := s.GetReplica().SelectInt(queryString, args...)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to count ChannelBookmarks with channelId=%s", channelId)
+	}
+
+	return count, nil
+}
+
+func (s SqlChannelBookmarkStore) Delete(id string, deleteAt int64) error {
+	queryString, args, err := s.getQueryBuilder().
+		Update("ChannelBookmarks").
+		Set("DeleteAt", deleteAt).
+		Set("UpdateAt", deleteAt).
+		Where(sq.Eq{"Id": id, "DeleteAt": 0}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "channel_bookmark_delete_tosql")
+	}
+
+	result, err := s.GetMaster().Exec(queryString, args...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete ChannelBookmark with id=%s", id)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrapf(err, "failed to get rows affected for ChannelBookmark with id=%s", id)
+	}
+	if rowsAffected == 0 {
+		return store.NewErrNotFound("ChannelBookmark", id)
+	}
+	if rowsAffected != 1 {
+		return fmt.Errorf("unexpected count while deleting ChannelBookmark: count=%d, id=%s", rowsAffected, id)
+	}
+
+	return nil
+}
+
+func (s SqlChannelBookmarkStore) PermanentDeleteByChannel(channelId string) error {
+	queryString, args, err := s.getQueryBuilder().
+		Delete("ChannelBookmarks").
+		Where(sq.Eq{"ChannelId": channelId}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "channel_bookmarks_permanent_delete_tosql")
+	}
+
+	if _, err := s.GetMaster().Exec(queryString, args...); err != nil {
+		return errors.Wrapf(err, "failed to delete ChannelBookmarks with channelId=%s", channelId)
+	}
+
+	return nil
+}
@@ -0,0 +1,193 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"sort"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/mattermost/gorp"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+// channelChangeSequence holds the sequence number of the latest change of a channel.
+type channelChangeSequence struct {
+	ChannelId string
+	Seq       int64
+}
+
+type SqlChannelChangeStore struct {
+	SqlStore
+}
+
+func newSqlChannelChangeStore(sqlStore SqlStore) store.ChannelChangeStore {
+	s := &SqlChannelChangeStore{sqlStore}
+
+	for _, db := range sqlStore.GetAllConns() {
+		table := db.AddTableWithName(model.ChannelChange{}, "ChannelChanges").SetKeys(false, "ChannelId", "Seq")
+		table.ColMap("ChannelId").SetMaxSize(26)
+		table.ColMap("PostId").SetMaxSize(26)
+		table.ColMap("Type").SetMaxSize(32)
+
+		tableSequences := db.AddTableWithName(channelChangeSequence{}, "ChannelChangeSequences").SetKeys(false, "ChannelId")
+		tableSequences.ColMap("ChannelId").SetMaxSize(26)
+	}
+
+	return s
+}
+
+// nextChannelChangeSeqT reserves count sequence numbers of the channel as part of the given
+// transaction and returns the last of them. The sequence row stays locked until the transaction
+// ends, so concurrent changes of the channel are numbered in commit order.
+func nextChannelChangeSeqT(s SqlStore, transaction *gorp.Transaction, channelId string, count int64) (int64, error) {
+	params := map[string]interface{}{"ChannelId": channelId, "Count": count}
+
+	if s.DriverName() == model.DATABASE_DRIVER_POSTGRES {
+		seq, err := transaction.SelectInt(`
+			INSERT INTO ChannelChangeSequences (ChannelId, Seq)
+			VALUES (:ChannelId, :Count)
+			ON CONFLICT (ChannelId) DO UPDATE SET Seq = ChannelChangeSequences.Seq + :Count
+			RETURNING Seq`, params)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to increment the change sequence of channelId=%s", channelId)
+		}
+		return seq, nil
+	}
+
+	if _, err := transaction.Exec(`
+		INSERT INTO ChannelChangeSequences (ChannelId, Seq)
+		VALUES (:ChannelId, :Count)
+		ON DUPLICATE KEY UPDATE Seq = Seq + :Count`, params); err != nil {
+		return 0, errors.Wrapf(err, "failed to increment the change sequence of channelId=%s", channelId)
+	}
+
+	seq, err := transaction.SelectInt("SELECT Seq FROM ChannelChangeSequences WHERE ChannelId = :ChannelId", params)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get the change sequence of channelId=%s", channelId)
+	}
+	return seq, nil
+}
+
+// saveChannelChangesT numbers the changes within their channels, in the given order, and records
+// them as part of the given transaction.
+func saveChannelChangesT(s SqlStore, transaction *gorp.Transaction, changes []*model.ChannelChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	byChannel := make(map[string][]*model.ChannelChange)
+	for _, change := range changes {
+		byChannel[change.ChannelId] = append(byChannel[change.ChannelId], change)
+	}
+
+	// Reserve the sequences in a fixed channel order to avoid deadlocking with concurrent transactions.
+	channelIds := make([]string, 0, len(byChannel))
+	for channelId := range byChannel {
+		channelIds = append(channelIds, channelId)
+	}
+	sort.Strings(channelIds)
+
+	createAt := model.GetMillis()
+	for _, channelId := range channelIds {
+		channelChanges := byChannel[channelId]
+		lastSeq, err := nextChannelChangeSeqT(s, transaction, channelId, int64(len(channelChanges)))
+		if err != nil {
+			return err
+		}
+
+		firstSeq := lastSeq - int64(len(channelChanges)) + 1
+		for i, change := range channelChanges {
+			change.Seq = firstSeq + int64(i)
+			change.CreateAt = createAt
+		}
+	}
+
+	query := s.getQueryBuilder().
+		Insert("ChannelChanges").
+		Columns("ChannelId", "Seq", "PostId", "Type", "CreateAt")
+	for _, change := range changes {
+		query = query.Values(change.ChannelId, change.Seq, change.PostId, change.Type, change.CreateAt)
+	}
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "channel_changes_save_tosql")
+	}
+
+	if _, err := transaction.Exec(queryString, args...); err != nil {
+		return errors.Wrap(err, "failed to save ChannelChanges")
+	}
+
+	return nil
+}
+
+// savePostChangesT records a change of the given type for each of the posts as part of the given
+// transaction, and sets the sequence numbers they were given on the posts.
+func savePostChangesT(s SqlStore, transaction *gorp.Transaction, posts []*model.Post, changeType string) error {
+	changes := make([]*model.ChannelChange, 0, len(posts))
+	for _, post := range posts {
+		changes = append(changes, &model.ChannelChange{
+			ChannelId: post.ChannelId,
+			PostId:    post.Id,
+			Type:      changeType,
+		})
+	}
+
+	if err := saveChannelChangesT(s, transaction, changes); err != nil {
+		return err
+	}
+
+	for i, post := range posts {
+		post.ChangeSeq = changes[i].Seq
+	}
+
+	return nil
+}
+
+func (s SqlChannelChangeStore) GetSince(channelId string, sinceSeq int64, limit int) ([]*model.ChannelChange, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("*").
+		From("ChannelChanges").
+		Where(sq.Eq{"ChannelId": channelId}).
+		Where(sq.Gt{"Seq": sinceSeq}).
+		OrderBy("Seq ASC").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_changes_get_since_tosql")
+	}
+
+	changes := []*model.ChannelChange{}
+	if _, err := s.GetReplica().Select(&changes, queryString, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to find ChannelChanges with channelId=%s", channelId)
+	}
+
+	return changes, nil
+}
+
+func (s SqlChannelChangeStore) GetLastSeq(channelId string) (int64, error) {
+	seq, err := s.GetReplica().SelectInt("SELECT COALESCE(MAX(Seq), 0) FROM ChannelChangeSequences WHERE ChannelId = :ChannelId", map[string]interface{}{"ChannelId": channelId})
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get the change sequence of channelId=%s", channelId)
+	}
+
+	return seq, nil
+}
+
+func (s SqlChannelChangeStore) PermanentDeleteByChannel(channelId string) error {
+	params := map[string]interface{}{"ChannelId": channelId}
+
+	if _, err := s.GetMaster().Exec("DELETE FROM ChannelChanges WHERE ChannelId = :ChannelId", params); err != nil {
+		return errors.Wrapf(err, "failed to delete ChannelChanges with channelId=%s", channelId)
+	}
+
+	if _, err := s.GetMaster().Exec("DELETE FROM ChannelChangeSequences WHERE ChannelId = :ChannelId", params); err != nil {
+		return errors.Wrapf(err, "failed to delete ChannelChangeSequences with channelId=%s", channelId)
+	}
+
+	return nil
+}
@@ -0,0 +1,200 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+type SqlChannelGuestLinkStore struct {
+	SqlStore
+}
+
+func newSqlChannelGuestLinkStore(sqlStore SqlStore) store.ChannelGuestLinkStore {
+	s := &SqlChannelGuestLinkStore{sqlStore}
+
+	for _, db := range sqlStore.GetAllConns() {
+		table := db.AddTableWithName(model.ChannelGuestLink{}, "ChannelGuestLinks").SetKeys(false, "Id")
+		table.ColMap("Id").SetMaxSize(26)
+		table.ColMap("ChannelId").SetMaxSize(26)
+		table.ColMap("TeamId").SetMaxSize(26)
+		table.ColMap("CreatorId").SetMaxSize(26)
+		table.ColMap("Token").SetMaxSize(model.CHANNEL_GUEST_LINK_TOKEN_SIZE).SetUnique(true)
+		table.ColMap("AllowedDomains").SetMaxSize(model.CHANNEL_GUEST_LINK_ALLOWED_DOMAINS_MAX_LENGTH)
+	}
+
+	return s
+}
+
+func (s SqlChannelGuestLinkStore) createIndexesIfNotExists() {
+	s.CreateIndexIfNotExists("idx_channelguestlinks_channel_id", "ChannelGuestLinks", "ChannelId")
+}
+
+func (s SqlChannelGuestLinkStore) Save(link *model.ChannelGuestLink) (*model.ChannelGuestLink, error) {
+	if link.Id != "" {
+		return nil, store.NewErrInvalidInput("ChannelGuestLink", "id", link.Id)
+	}
+
+	link.PreSave()
+	if err := link.IsValid(); err != nil {
+		return nil, err
+	}
+
+	if err := s.GetMaster().Insert(link); err != nil {
+		return nil, errors.Wrapf(err, "failed to save ChannelGuestLink with id=%s", link.Id)
+	}
+
+	return link, nil
+}
+
+func (s SqlChannelGuestLinkStore) getBy(where sq.Eq, key string) (*model.ChannelGuestLink, error) {
+	where["DeleteAt"] = 0
+	queryString, args, err := s.getQueryBuilder().
+		Select("*").
+		From("ChannelGuestLinks").
+		Where(where).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_guest_link_tosql")
+	}
+
+	var link *model.ChannelGuestLink
+	if err := s.GetReplica().SelectOne(&link, queryString, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("ChannelGuestLink", key)
+		}
+		return nil, errors.Wrapf(err, "failed to get ChannelGuestLink with %s", key)
+	}
+
+	return link, nil
+}
+
+func (s SqlChannelGuestLinkStore) Get(id string) (*model.ChannelGuestLink, error) {
+	return s.getBy(sq.Eq{"Id": id}, "id="+id)
+}
+
+func (s SqlChannelGuestLinkStore) GetByToken(token string) (*model.ChannelGuestLink, error) {
+	return s.getBy(sq.Eq{"Token": token}, "token")
+}
+
+func (s SqlChannelGuestLinkStore) GetForChannel(channelId string) ([]*model.ChannelGuestLink, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("*").
+		From("ChannelGuestLinks").
+		Where(sq.Eq{"ChannelId": channelId, "DeleteAt": 0}).
+		OrderBy("CreateAt ASC", "Id ASC").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_guest_links_tosql")
+	}
+
+	links := []*model.ChannelGuestLink{}
+	if _, err := s.GetReplica().Select(&links, queryString, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to find ChannelGuestLinks with channelId=%s", channelId)
+	}
+
+	return links, nil
+}
+
+func (s SqlChannelGuestLinkStore) CountForChannel(channelId string) (int64, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("COUNT(*)").
+		From("ChannelGuestLinks").
+		Where(sq.Eq{"ChannelId": channelId, "DeleteAt": 0}).
+		ToSql()
+	if err != nil {
+		return 0, errors.Wrap(err, "channel_guest_links_count_tosql")
+	}
+
+	count, err := s.GetReplica().SelectInt(queryString, args...)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to count ChannelGuestLinks with channelId=%s", channelId)
+	}
+
+	return count, nil
+}
+
+// IncrementUseCount records a use of the link, failing with ErrLimitExceeded if the link was revoked,
+// expired or used up in the meantime.
+func (s SqlChannelGuestLinkStore) IncrementUseCount(id string, now int64) error {
+	queryString, args, err := s.getQueryBuilder().
+		Update("ChannelGuestLinks").
+		Set("UseCount", sq.Expr("UseCount + 1")).
+		Set("UpdateAt", now).
+		Where(sq.Eq{"Id": id, "DeleteAt": 0}).
+		Where(sq.Or{sq.Eq{"MaxUses": 0}, sq.Expr("UseCount < MaxUses")}).
+		Where(sq.Or{sq.Eq{"ExpiresAt": 0}, sq.Gt{"ExpiresAt": now}}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "channel_guest_link_increment_tosql")
+	}
+
+	result, err := s.GetMaster().Exec(queryString, args...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to increment the use count of ChannelGuestLink with id=%s", id)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrapf(err, "failed to get rows affected for ChannelGuestLink with id=%s", id)
+	}
+	if rowsAffected == 0 {
+		return store.NewErrLimitExceeded("ChannelGuestLink", 0, "id="+id)
+	}
+
+	return nil
+}
+
+func (s SqlChannelGuestLinkStore) Revoke(id string, deleteAt int64) error {
+	queryString, args, err := s.getQueryBuilder().
+		Update("ChannelGuestLinks").
+		Set("DeleteAt", deleteAt).
+		Set("UpdateAt", deleteAt).
+		Where(sq.Eq{"Id": id, "DeleteAt": 0}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "channel_guest_link_revoke_tosql")
+	}
+
+	result, err := s.GetMaster().Exec(queryString, args...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to revoke ChannelGuestLink with id=%s", id)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrapf(err, "failed to get rows affected for ChannelGuestLink with id=%s", id)
+	}
+	if rowsAffected == 0 {
+		return store.NewErrNotFound("ChannelGuestLink", id)
+	}
+	if rowsAffected != 1 {
+		return fmt.Errorf("unexpected count while revoking ChannelGuestLink: count=%d, id=%s", rowsAffected, id)
+	}
+
+	return nil
+}
+
+func (s SqlChannelGuestLinkStore) PermanentDeleteByChannel(channelId string) error {
+	queryString, args, err := s.getQueryBuilder().
+		Delete("ChannelGuestLinks").
+		Where(sq.Eq{"ChannelId": channelId}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "channel_guest_links_delete_tosql")
+	}
+
+	if _, err := s.GetMaster().Exec(queryString, args...); err != nil {
+		return errors.Wrapf(err, "failed to delete ChannelGuestLinks with channelId=%s", channelId)
+	}
+
+	return nil
+}
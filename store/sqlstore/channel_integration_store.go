@@ -0,0 +1,120 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+type SqlChannelIntegrationStore struct {
+	SqlStore
+}
+
+func newSqlChannelIntegrationStore(sqlStore SqlStore) store.ChannelIntegrationStore {
+	s := &SqlChannelIntegrationStore{sqlStore}
+
+	for _, db := range sqlStore.GetAllConns() {
+		table := db.AddTableWithName(model.ChannelIntegration{}, "ChannelIntegrations").SetKeys(false, "ChannelId", "IntegrationId")
+		table.ColMap("ChannelId").SetMaxSize(26)
+		table.ColMap("IntegrationId").SetMaxSize(26)
+		table.ColMap("Type").SetMaxSize(32)
+		table.ColMap("CreatorId").SetMaxSize(26)
+	}
+
+	return s
+}
+
+func (s SqlChannelIntegrationStore) createIndexesIfNotExists() {
+	s.CreateIndexIfNotExists("idx_channelintegrations_integration_id", "ChannelIntegrations", "IntegrationId")
+}
+
+// Save allows the integration in the channel. It returns a store.ErrConflict if the integration is
+// already allowed there.
+func (s SqlChannelIntegrationStore) Save(integration *model.ChannelIntegration) (*model.ChannelIntegration, error) {
+	integration.PreSave()
+	if err := integration.IsValid(); err != nil {
+		return nil, err
+	}
+
+	if err := s.GetMaster().Insert(integration); err != nil {
+		if IsUniqueConstraintError(err, []string{"PRIMARY", "channelintegrations_pkey"}) {
+			return nil, store.NewErrConflict("ChannelIntegration", err, "channel_id="+integration.ChannelId+", integration_id="+integration.IntegrationId)
+		}
+		return nil, errors.Wrapf(err, "failed to save ChannelIntegration with channel_id=%s and integration_id=%s", integration.ChannelId, integration.IntegrationId)
+	}
+
+	return integration, nil
+}
+
+func (s SqlChannelIntegrationStore) Get(channelId string, integrationId string) (*model.ChannelIntegration, error) {
+	var integration *model.ChannelIntegration
+	if err := s.GetReplica().SelectOne(&integration, "SELECT * FROM ChannelIntegrations WHERE ChannelId = :ChannelId AND IntegrationId = :IntegrationId", map[string]interface{}{"ChannelId": channelId, "IntegrationId": integrationId}); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("ChannelIntegration", integrationId)
+		}
+		return nil, errors.Wrapf(err, "failed to get ChannelIntegration with channel_id=%s and integration_id=%s", channelId, integrationId)
+	}
+
+	return integration, nil
+}
+
+// GetForChannel returns the integrations allowed in the channel, oldest first.
+func (s SqlChannelIntegrationStore) GetForChannel(channelId string) ([]*model.ChannelIntegration, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("*").
+		From("ChannelIntegrations").
+		Where(sq.Eq{"ChannelId": channelId}).
+		OrderBy("CreateAt ASC", "IntegrationId ASC").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_integrations_tosql")
+	}
+
+	integrations := []*model.ChannelIntegration{}
+	if _, err := s.GetReplica().Select(&integrations, queryString, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to find ChannelIntegrations with channel_id=%s", channelId)
+	}
+
+	return integrations, nil
+}
+
+// Delete disallows the integration in the channel, returning a store.ErrNotFound if it wasn't allowed.
+func (s SqlChannelIntegrationStore) Delete(channelId string, integrationId string) error {
+	result, err := s.GetMaster().Exec("DELETE FROM ChannelIntegrations WHERE ChannelId = :ChannelId AND IntegrationId = :IntegrationId", map[string]interface{}{"ChannelId": channelId, "IntegrationId": integrationId})
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete ChannelIntegration with channel_id=%s and integration_id=%s", channelId, integrationId)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrapf(err, "failed to get rows affected for ChannelIntegration with channel_id=%s and integration_id=%s", channelId, integrationId)
+	}
+	if rowsAffected == 0 {
+		return store.NewErrNotFound("ChannelIntegration", integrationId)
+	}
+
+	return nil
+}
+
+func (s SqlChannelIntegrationStore) PermanentDeleteByChannel(channelId string) error {
+	if _, err := s.GetMaster().Exec("DELETE FROM ChannelIntegrations WHERE ChannelId = :ChannelId", map[string]interface{}{"ChannelId": channelId}); err != nil {
+		return errors.Wrapf(err, "failed to delete ChannelIntegrations with channel_id=%s", channelId)
+	}
+
+	return nil
+}
+
+func (s SqlChannelIntegrationStore) PermanentDeleteByIntegration(integrationId string) error {
+	if _, err := s.GetMaster().Exec("DELETE FROM ChannelIntegrations WHERE IntegrationId = :IntegrationId", map[string]interface{}{"IntegrationId": integrationId}); err != nil {
+		return errors.Wrapf(err, "failed to delete ChannelIntegrations with integration_id=%s", integrationId)
+	}
+
+	return nil
+}
@@ -39,32 +39,38 @@ type SqlChannelStore struct {
 }
 
 type channelMember struct {
-	ChannelId    string
-	UserId       string
-	Roles        string
-	LastViewedAt int64
-	MsgCount     int64
-	MentionCount int64
-	NotifyProps  model.StringMap
-	LastUpdateAt int64
-	SchemeUser   sql.NullBool
-	SchemeAdmin  sql.NullBool
-	SchemeGuest  sql.NullBool
+	ChannelId              string
+	UserId                 string
+	Roles                  string
+	LastViewedAt           int64
+	MsgCount               int64
+	MentionCount           int64
+	MsgCountRoot           int64
+	UrgentMentionCount     int64
+	NotifyProps            model.StringMap
+	LastUpdateAt           int64
+	SchemeUser             sql.NullBool
+	SchemeAdmin            sql.NullBool
+	SchemeGuest            sql.NullBool
+	ExplicitRolesExpiresAt int64
 }
 
 func NewChannelMemberFromModel(cm *model.ChannelMember) *channelMember {
 	return &channelMember{
-		ChannelId:    cm.ChannelId,
-		UserId:       cm.UserId,
-		Roles:        cm.ExplicitRoles,
-		LastViewedAt: cm.LastViewedAt,
-		MsgCount:     cm.MsgCount,
-		MentionCount: cm.MentionCount,
-		NotifyProps:  cm.NotifyProps,
-		LastUpdateAt: cm.LastUpdateAt,
-		SchemeGuest:  sql.NullBool{Valid: true, Bool: cm.SchemeGuest},
-		SchemeUser:   sql.NullBool{Valid: true, Bool: cm.SchemeUser},
-		SchemeAdmin:  sql.NullBool{Valid: true, Bool: cm.SchemeAdmin},
+		ChannelId:              cm.ChannelId,
+		UserId:                 cm.UserId,
+		Roles:                  cm.ExplicitRoles,
+		LastViewedAt:           cm.LastViewedAt,
+		MsgCount:               cm.MsgCount,
+		MentionCount:           cm.MentionCount,
+		MsgCountRoot:           cm.MsgCountRoot,
+		UrgentMentionCount:     cm.UrgentMentionCount,
+		NotifyProps:            cm.NotifyProps,
+		LastUpdateAt:           cm.LastUpdateAt,
+		SchemeGuest:            sql.NullBool{Valid: true, Bool: cm.SchemeGuest},
+		SchemeUser:             sql.NullBool{Valid: true, Bool: cm.SchemeUser},
+		SchemeAdmin:            sql.NullBool{Valid: true, Bool: cm.SchemeAdmin},
+		ExplicitRolesExpiresAt: cm.ExplicitRolesExpiresAt,
 	}
 }
 
@@ -75,11 +81,14 @@ type channelMemberWithSchemeRoles struct {
 	LastViewedAt                  int64
 	MsgCount                      int64
 	MentionCount                  int64
+	MsgCountRoot                  int64
+	UrgentMentionCount            int64
 	NotifyProps                   model.StringMap
 	LastUpdateAt                  int64
 	SchemeGuest                   sql.NullBool
 	SchemeUser                    sql.NullBool
 	SchemeAdmin                   sql.NullBool
+	ExplicitRolesExpiresAt        int64
 	TeamSchemeDefaultGuestRole    sql.NullString
 	TeamSchemeDefaultUserRole     sql.NullString
 	TeamSchemeDefaultAdminRole    sql.NullString
@@ -89,7 +98,7 @@ type channelMemberWithSchemeRoles struct {
 }
 
 func channelMemberSliceColumns() []string {
-	return []string{"ChannelId", "UserId", "Roles", "LastViewedAt", "MsgCount", "MentionCount", "NotifyProps", "LastUpdateAt", "SchemeUser", "SchemeAdmin", "SchemeGuest"}
+	return []string{"ChannelId", "UserId", "Roles", "LastViewedAt", "MsgCount", "MentionCount", "MsgCountRoot", "UrgentMentionCount", "NotifyProps", "LastUpdateAt", "SchemeUser", "SchemeAdmin", "SchemeGuest", "ExplicitRolesExpiresAt"}
 }
 
 func channelMemberToSlice(member *model.ChannelMember) []interface{} {
@@ -100,11 +109,14 @@ func channelMemberToSlice(member *model.ChannelMember) []interface{} {
 	resultSlice = append(resultSlice, member.LastViewedAt)
 	resultSlice = append(resultSlice, member.MsgCount)
 	resultSlice = append(resultSlice, member.MentionCount)
+	resultSlice = append(resultSlice, member.MsgCountRoot)
+	resultSlice = append(resultSlice, member.UrgentMentionCount)
 	resultSlice = append(resultSlice, model.MapToJson(member.NotifyProps))
 	resultSlice = append(resultSlice, member.LastUpdateAt)
 	resultSlice = append(resultSlice, member.SchemeUser)
 	resultSlice = append(resultSlice, member.SchemeAdmin)
 	resultSlice = append(resultSlice, member.SchemeGuest)
+	resultSlice = append(resultSlice, member.ExplicitRolesExpiresAt)
 	return resultSlice
 }
 
@@ -224,18 +236,21 @@ func (db channelMemberWithSchemeRoles) ToModel() *model.ChannelMember {
 		strings.Fields(db.Roles),
 	)
 	return &model.ChannelMember{
-		ChannelId:     db.ChannelId,
-		UserId:        db.UserId,
-		Roles:         strings.Join(rolesResult.roles, " "),
-		LastViewedAt:  db.LastViewedAt,
-		MsgCount:      db.MsgCount,
-		MentionCount:  db.MentionCount,
-		NotifyProps:   db.NotifyProps,
-		LastUpdateAt:  db.LastUpdateAt,
-		SchemeAdmin:   rolesResult.schemeAdmin,
-		SchemeUser:    rolesResult.schemeUser,
-		SchemeGuest:   rolesResult.schemeGuest,
-		ExplicitRoles: strings.Join(rolesResult.explicitRoles, " "),
+		ChannelId:              db.ChannelId,
+		UserId:                 db.UserId,
+		Roles:                  strings.Join(rolesResult.roles, " "),
+		LastViewedAt:           db.LastViewedAt,
+		MsgCount:               db.MsgCount,
+		MentionCount:           db.MentionCount,
+		MsgCountRoot:           db.MsgCountRoot,
+		UrgentMentionCount:     db.UrgentMentionCount,
+		NotifyProps:            db.NotifyProps,
+		LastUpdateAt:           db.LastUpdateAt,
+		SchemeAdmin:            rolesResult.schemeAdmin,
+		SchemeUser:             rolesResult.schemeUser,
+		SchemeGuest:            rolesResult.schemeGuest,
+		ExplicitRoles:          strings.Join(rolesResult.explicitRoles, " "),
+		ExplicitRolesExpiresAt: db.ExplicitRolesExpiresAt,
 	}
 }
 
@@ -255,6 +270,7 @@ type allChannelMember struct {
 	SchemeGuest                   sql.NullBool
 	SchemeUser                    sql.NullBool
 	SchemeAdmin                   sql.NullBool
+	ExplicitRolesExpiresAt        int64
 	TeamSchemeDefaultGuestRole    sql.NullString
 	TeamSchemeDefaultUserRole     sql.NullString
 	TeamSchemeDefaultAdminRole    sql.NullString
@@ -268,6 +284,18 @@ type allChannelMembers []allChannelMember
 func (db allChannelMember) Process() (string, string) {
 	roles := strings.Fields(db.Roles)
 
+	// Once the explicit roles have expired, only the scheme roles not yet migrated out of the Roles field
+	// are left.
+	if model.IsRoleGrantExpired(db.ExplicitRolesExpiresAt, model.GetMillis()) {
+		var schemeRoles []string
+		for _, role := range roles {
+			if role == model.CHANNEL_GUEST_ROLE_ID || role == model.CHANNEL_USER_ROLE_ID || role == model.CHANNEL_ADMIN_ROLE_ID {
+				schemeRoles = append(schemeRoles, role)
+			}
+		}
+		roles = schemeRoles
+	}
+
 	// Add any scheme derived roles that are not in the Roles field due to being Implicit from the Scheme, and add
 	// them to the Roles field for backwards compatibility reasons.
 	var schemeImpliedRoles []string
@@ -375,6 +403,7 @@ func newSqlChannelStore(sqlStore SqlStore, metrics einterfaces.MetricsInterface)
 		table.ColMap("Purpose").SetMaxSize(250)
 		table.ColMap("CreatorId").SetMaxSize(26)
 		table.ColMap("SchemeId").SetMaxSize(26)
+		table.ColMap("Props").SetMaxSize(model.CHANNEL_PROPS_MAX_LENGTH)
 
 		tablem := db.AddTableWithName(channelMember{}, "ChannelMembers").SetKeys(false, "ChannelId", "UserId")
 		tablem.ColMap("ChannelId").SetMaxSize(26)
@@ -422,6 +451,8 @@ func (s SqlChannelStore) createIndexesIfNotExists() {
 
 	s.CreateIndexIfNotExists("idx_channelmembers_channel_id", "ChannelMembers", "ChannelId")
 	s.CreateIndexIfNotExists("idx_channelmembers_user_id", "ChannelMembers", "UserId")
+	s.CreateCompositeIndexIfNotExists("idx_channelmembers_user_id_last_viewed_at", "ChannelMembers", []string{"UserId", "LastViewedAt", "ChannelId"})
+	s.CreateIndexIfNotExists("idx_channelmembers_explicit_roles_expires_at", "ChannelMembers", "ExplicitRolesExpiresAt")
 
 	s.CreateFullTextIndexIfNotExists("idx_channel_search_txt", "Channels", "Name, DisplayName, Purpose")
 
@@ -1561,6 +1592,24 @@ var CHANNEL_MEMBERS_WITH_SCHEME_SELECT_QUERY = `
 		Schemes TeamScheme ON Teams.SchemeId = TeamScheme.Id
 `
 
+func (s SqlChannelStore) getChannelMembersWithSchemeSelectQuery() sq.SelectBuilder {
+	return s.getQueryBuilder().
+		Select(
+			"ChannelMembers.*",
+			"TeamScheme.DefaultChannelGuestRole TeamSchemeDefaultGuestRole",
+			"TeamScheme.DefaultChannelUserRole TeamSchemeDefaultUserRole",
+			"TeamScheme.DefaultChannelAdminRole TeamSchemeDefaultAdminRole",
+			"ChannelScheme.DefaultChannelGuestRole ChannelSchemeDefaultGuestRole",
+			"ChannelScheme.DefaultChannelUserRole ChannelSchemeDefaultUserRole",
+			"ChannelScheme.DefaultChannelAdminRole ChannelSchemeDefaultAdminRole",
+		).
+		From("ChannelMembers").
+		Join("Channels ON ChannelMembers.ChannelId = Channels.Id").
+		LeftJoin("Schemes ChannelScheme ON Channels.SchemeId = ChannelScheme.Id").
+		LeftJoin("Teams ON Channels.TeamId = Teams.Id").
+		LeftJoin("Schemes TeamScheme ON Teams.SchemeId = TeamScheme.Id")
+}
+
 func (s SqlChannelStore) SaveMultipleMembers(members []*model.ChannelMember) ([]*model.ChannelMember, *model.AppError) {
 	for _, member := range members {
 		defer s.InvalidateAllChannelMembersForUser(member.UserId)
@@ -1881,6 +1930,29 @@ func (s SqlChannelStore) IsUserInChannelUseCache(userId string, channelId string
 	return false
 }
 
+// GetMembersWithExpiredRoles returns the channel members whose explicit roles expired at or before
+// expiredBefore, oldest expiry first.
+func (s SqlChannelStore) GetMembersWithExpiredRoles(expiredBefore int64, limit int) ([]*model.ChannelMember, *model.AppError) {
+	var dbMembers channelMemberWithSchemeRolesList
+	query := CHANNEL_MEMBERS_WITH_SCHEME_SELECT_QUERY + `
+		WHERE
+			ChannelMembers.ExplicitRolesExpiresAt > 0
+		AND
+			ChannelMembers.ExplicitRolesExpiresAt <= :ExpiredBefore
+		ORDER BY
+			ChannelMembers.ExplicitRolesExpiresAt ASC, ChannelMembers.ChannelId ASC, ChannelMembers.UserId ASC
+		LIMIT :Limit`
+	if _, err := s.GetReplica().Select(&dbMembers, query, map[string]interface{}{"ExpiredBefore": expiredBefore, "Limit": limit}); err != nil {
+		return nil, model.NewAppError("SqlChannelStore.GetMembersWithExpiredRoles", "store.sql_channel.get_members_with_expired_roles.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	members := make([]*model.ChannelMember, 0, len(dbMembers))
+	for _, dbMember := range dbMembers {
+		members = append(members, dbMember.ToModel())
+	}
+	return members, nil
+}
+
 func (s SqlChannelStore) GetMemberForPost(postId string, userId string) (*model.ChannelMember, *model.AppError) {
 	var dbMember channelMemberWithSchemeRoles
 	query := `
@@ -1947,7 +2019,7 @@ func (s SqlChannelStore) GetAllChannelMembersForUser(userId string, allowFromCac
 	query := s.getQueryBuilder().
 		Select(`
 				ChannelMembers.ChannelId, ChannelMembers.Roles, ChannelMembers.SchemeGuest,
-				ChannelMembers.SchemeUser, ChannelMembers.SchemeAdmin,
+				ChannelMembers.SchemeUser, ChannelMembers.SchemeAdmin, ChannelMembers.ExplicitRolesExpiresAt,
 				TeamScheme.DefaultChannelGuestRole TeamSchemeDefaultGuestRole,
 				TeamScheme.DefaultChannelUserRole TeamSchemeDefaultUserRole,
 				TeamScheme.DefaultChannelAdminRole TeamSchemeDefaultAdminRole,
@@ -1980,7 +2052,7 @@ func (s SqlChannelStore) GetAllChannelMembersForUser(userId string, allowFromCac
 		var cm allChannelMember
 		err = rows.Scan(
 			&cm.ChannelId, &cm.Roles, &cm.SchemeGuest, &cm.SchemeUser,
-			&cm.SchemeAdmin, &cm.TeamSchemeDefaultGuestRole, &cm.TeamSchemeDefaultUserRole,
+			&cm.SchemeAdmin, &cm.ExplicitRolesExpiresAt, &cm.TeamSchemeDefaultGuestRole, &cm.TeamSchemeDefaultUserRole,
 			&cm.TeamSchemeDefaultAdminRole, &cm.ChannelSchemeDefaultGuestRole,
 			&cm.ChannelSchemeDefaultUserRole, &cm.ChannelSchemeDefaultAdminRole,
 		)
@@ -2255,12 +2327,13 @@ func (s SqlChannelStore) UpdateLastViewedAt(channelIds []string, userId string)
 	props["UserId"] = userId
 
 	var lastPostAtTimes []struct {
-		Id            string
-		LastPostAt    int64
-		TotalMsgCount int64
+		Id                string
+		LastPostAt        int64
+		TotalMsgCount     int64
+		TotalMsgCountRoot int64
 	}
 
-	query := `SELECT Id, LastPostAt, TotalMsgCount FROM Channels WHERE Id IN ` + keys
+	query := `SELECT Id, LastPostAt, TotalMsgCount, TotalMsgCountRoot FROM Channels WHERE Id IN ` + keys
 	// TODO: use a CTE for mysql too when version 8 becomes the minimum supported version.
 	if s.DriverName() == model.DATABASE_DRIVER_POSTGRES {
 		query = `WITH c AS ( ` + query + `),
@@ -2269,7 +2342,9 @@ func (s SqlChannelStore) UpdateLastViewedAt(channelIds []string, userId string)
 		ChannelMembers cm
 	SET
 		MentionCount = 0,
+		UrgentMentionCount = 0,
 		MsgCount = greatest(cm.MsgCount, c.TotalMsgCount),
+		MsgCountRoot = greatest(cm.MsgCountRoot, c.TotalMsgCountRoot),
 		LastViewedAt = greatest(cm.LastViewedAt, c.LastPostAt),
 		LastUpdateAt = greatest(cm.LastViewedAt, c.LastPostAt)
 	FROM c
@@ -2306,6 +2381,7 @@ func (s SqlChannelStore) UpdateLastViewedAt(channelIds []string, userId string)
 	}
 
 	msgCountQuery := ""
+	msgCountRootQuery := ""
 	lastViewedQuery := ""
 	for index, t := range lastPostAtTimes {
 		times[t.Id] = t.LastPostAt
@@ -2313,6 +2389,9 @@ func (s SqlChannelStore) UpdateLastViewedAt(channelIds []string, userId string)
 		props["msgCount"+strconv.Itoa(index)] = t.TotalMsgCount
 		msgCountQuery += fmt.Sprintf("WHEN :channelId%d THEN GREATEST(MsgCount, :msgCount%d) ", index, index)
 
+		props["msgCountRoot"+strconv.Itoa(index)] = t.TotalMsgCountRoot
+		msgCountRootQuery += fmt.Sprintf("WHEN :channelId%d THEN GREATEST(MsgCountRoot, :msgCountRoot%d) ", index, index)
+
 		props["lastViewed"+strconv.Itoa(index)] = t.LastPostAt
 		lastViewedQuery += fmt.Sprintf("WHEN :channelId%d THEN GREATEST(LastViewedAt, :lastViewed%d) ", index, index)
 
@@ -2323,7 +2402,9 @@ func (s SqlChannelStore) UpdateLastViewedAt(channelIds []string, userId string)
 			ChannelMembers
 		SET
 			MentionCount = 0,
+			UrgentMentionCount = 0,
 			MsgCount = CASE ChannelId ` + msgCountQuery + ` END,
+			MsgCountRoot = CASE ChannelId ` + msgCountRootQuery + ` END,
 			LastViewedAt = CASE ChannelId ` + lastViewedQuery + ` END,
 			LastUpdateAt = LastViewedAt
 		WHERE
@@ -2339,6 +2420,11 @@ func (s SqlChannelStore) UpdateLastViewedAt(channelIds []string, userId string)
 
 // CountPostsAfter returns the number of posts in the given channel created after but not including the given timestamp. If given a non-empty user ID, only counts posts made by that user.
 func (s SqlChannelStore) CountPostsAfter(channelId string, timestamp int64, userId string) (int, *model.AppError) {
+	return s.countPostsAfter(channelId, timestamp, userId, false)
+}
+
+// countPostsAfter is CountPostsAfter, only counting the root posts when rootOnly is set.
+func (s SqlChannelStore) countPostsAfter(channelId string, timestamp int64, userId string, rootOnly bool) (int, *model.AppError) {
 	joinLeavePostTypes, params := MapStringsToQueryParams([]string{
 		// These types correspond to the ones checked by Post.IsJoinLeaveMessage
 		model.POST_JOIN_LEAVE,
@@ -2371,6 +2457,10 @@ func (s SqlChannelStore) CountPostsAfter(channelId string, timestamp int64, user
 		params["UserId"] = userId
 	}
 
+	if rootOnly {
+		query += " AND RootId = ''"
+	}
+
 	unread, err := s.GetReplica().SelectInt(query, params)
 	if err != nil {
 		return 0, model.NewAppError("SqlChannelStore.CountPostsAfter", "store.sql_channel.count_posts_since.app_error", nil, fmt.Sprintf("channel_id=%s, timestamp=%d, err=%s", channelId, timestamp, err), http.StatusInternalServerError)
@@ -2389,13 +2479,19 @@ func (s SqlChannelStore) UpdateLastViewedAtPost(unreadPost *model.Post, userID s
 		return nil, appErr
 	}
 
+	unreadRoot, appErr := s.countPostsAfter(unreadPost.ChannelId, unreadDate, "", true)
+	if appErr != nil {
+		return nil, appErr
+	}
+
 	params := map[string]interface{}{
-		"mentions":     mentionCount,
-		"unreadCount":  unread,
-		"lastViewedAt": unreadDate,
-		"userId":       userID,
-		"channelId":    unreadPost.ChannelId,
-		"updatedAt":    model.GetMillis(),
+		"mentions":        mentionCount,
+		"unreadCount":     unread,
+		"unreadCountRoot": unreadRoot,
+		"lastViewedAt":    unreadDate,
+		"userId":          userID,
+		"channelId":       unreadPost.ChannelId,
+		"updatedAt":       model.GetMillis(),
 	}
 
 	// msg count uses the value from channels to prevent counting on older channels where no. of messages can be high.
@@ -2406,6 +2502,7 @@ func (s SqlChannelStore) UpdateLastViewedAtPost(unreadPost *model.Post, userID s
 	SET
 		MentionCount = :mentions,
 		MsgCount = (SELECT TotalMsgCount FROM Channels WHERE ID = :channelId) - :unreadCount,
+		MsgCountRoot = (SELECT TotalMsgCountRoot FROM Channels WHERE ID = :channelId) - :unreadCountRoot,
 		LastViewedAt = :lastViewedAt,
 		LastUpdateAt = :updatedAt
 	WHERE
@@ -2441,17 +2538,25 @@ func (s SqlChannelStore) UpdateLastViewedAtPost(unreadPost *model.Post, userID s
 	return result, nil
 }
 
-func (s SqlChannelStore) IncrementMentionCount(channelId string, userId string) *model.AppError {
+// IncrementMentionCount counts a new mention of the user in the channel, which is also counted as an
+// urgent mention when isUrgent is set.
+func (s SqlChannelStore) IncrementMentionCount(channelId string, userId string, isUrgent bool) *model.AppError {
+	urgentMentionIncrement := 0
+	if isUrgent {
+		urgentMentionIncrement = 1
+	}
+
 	_, err := s.GetMaster().Exec(
 		`UPDATE
 			ChannelMembers
 		SET
 			MentionCount = MentionCount + 1,
+			UrgentMentionCount = UrgentMentionCount + :UrgentMentionIncrement,
 			LastUpdateAt = :LastUpdateAt
 		WHERE
 			UserId = :UserId
 				AND ChannelId = :ChannelId`,
-		map[string]interface{}{"ChannelId": channelId, "UserId": userId, "LastUpdateAt": model.GetMillis()})
+		map[string]interface{}{"ChannelId": channelId, "UserId": userId, "UrgentMentionIncrement": urgentMentionIncrement, "LastUpdateAt": model.GetMillis()})
 	if err != nil {
 		return model.NewAppError("SqlChannelStore.IncrementMentionCount", "store.sql_channel.increment_mention_count.app_error", nil, "channel_id="+channelId+", user_id="+userId+", "+err.Error(), http.StatusInternalServerError)
 	}
@@ -2556,6 +2661,90 @@ func (s SqlChannelStore) GetMembersForUserWithPagination(teamId, userId string,
 	return dbMembers.ToModel(), nil
 }
 
+type channelMemberWithUnread struct {
+	channelMemberWithSchemeRoles
+	Unread bool
+}
+
+// channelMemberUnreadExpr flags the memberships with unread mentions, or with unread messages unless
+// the channel is muted down to mentions.
+const channelMemberUnreadExpr = `(CASE WHEN ChannelMembers.MentionCount > 0 OR (Channels.TotalMsgCount > ChannelMembers.MsgCount AND ChannelMembers.NotifyProps NOT LIKE '%"mark_unread":"mention"%') THEN 1 ELSE 0 END)`
+
+func (s SqlChannelStore) GetMembersForUserWithCursor(teamId, userId string, cursor model.ChannelMembersCursor, limit int) (*model.ChannelMembersPage, model.ChannelMembersCursor, *model.AppError) {
+	query := s.getQueryBuilder().
+		Select(
+			"ChannelMembers.*",
+			"TeamScheme.DefaultChannelGuestRole TeamSchemeDefaultGuestRole",
+			"TeamScheme.DefaultChannelUserRole TeamSchemeDefaultUserRole",
+			"TeamScheme.DefaultChannelAdminRole TeamSchemeDefaultAdminRole",
+			"ChannelScheme.DefaultChannelGuestRole ChannelSchemeDefaultGuestRole",
+			"ChannelScheme.DefaultChannelUserRole ChannelSchemeDefaultUserRole",
+			"ChannelScheme.DefaultChannelAdminRole ChannelSchemeDefaultAdminRole",
+			channelMemberUnreadExpr+" Unread",
+		).
+		From("ChannelMembers").
+		Join("Channels ON ChannelMembers.ChannelId = Channels.Id").
+		LeftJoin("Schemes ChannelScheme ON Channels.SchemeId = ChannelScheme.Id").
+		LeftJoin("Teams ON Channels.TeamId = Teams.Id").
+		LeftJoin("Schemes TeamScheme ON Teams.SchemeId = TeamScheme.Id").
+		Where(sq.Eq{"ChannelMembers.UserId": userId}).
+		Where(sq.Or{sq.Eq{"Channels.TeamId": teamId}, sq.Eq{"Channels.TeamId": ""}}).
+		Limit(uint64(limit + 1))
+
+	// Past the cursor means viewed less recently, or viewed at the same time with a greater ChannelId.
+	after := sq.Or{
+		sq.Lt{"ChannelMembers.LastViewedAt": cursor.LastViewedAt},
+		sq.And{sq.Eq{"ChannelMembers.LastViewedAt": cursor.LastViewedAt}, sq.Gt{"ChannelMembers.ChannelId": cursor.ChannelId}},
+	}
+
+	unread := 0
+	if cursor.Unread {
+		unread = 1
+	}
+
+	switch cursor.SortBy {
+	case model.CHANNEL_MEMBERS_SORT_UNREAD:
+		query = query.OrderBy(channelMemberUnreadExpr+" DESC", "ChannelMembers.LastViewedAt DESC", "ChannelMembers.ChannelId ASC")
+		if !cursor.IsStart() {
+			query = query.Where(sq.Or{
+				sq.Expr(channelMemberUnreadExpr+" < ?", unread),
+				sq.And{sq.Expr(channelMemberUnreadExpr+" = ?", unread), after},
+			})
+		}
+	default:
+		query = query.OrderBy("ChannelMembers.LastViewedAt DESC", "ChannelMembers.ChannelId ASC")
+		if !cursor.IsStart() {
+			query = query.Where(after)
+		}
+	}
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, cursor, model.NewAppError("SqlChannelStore.GetMembersForUserWithCursor", "store.sql_channel.get_members.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	var dbMembers []*channelMemberWithUnread
+	if _, err = s.GetReplica().Select(&dbMembers, queryString, args...); err != nil {
+		return nil, cursor, model.NewAppError("SqlChannelStore.GetMembersForUserWithCursor", "store.sql_channel.get_members.app_error", nil, "teamId="+teamId+", userId="+userId+", err="+err.Error(), http.StatusInternalServerError)
+	}
+
+	page := &model.ChannelMembersPage{Members: model.ChannelMembers{}}
+	if len(dbMembers) > limit {
+		dbMembers = dbMembers[:limit]
+		page.HasMore = true
+	}
+
+	next := cursor
+	for _, dbMember := range dbMembers {
+		page.Members = append(page.Members, *dbMember.ToModel())
+		next.Unread = dbMember.Unread
+		next.LastViewedAt = dbMember.LastViewedAt
+		next.ChannelId = dbMember.ChannelId
+	}
+
+	return page, next, nil
+}
+
 func (s SqlChannelStore) AutocompleteInTeam(teamId string, term string, includeDeleted bool) (*model.ChannelList, *model.AppError) {
 	deleteFilter := "AND c.DeleteAt = 0"
 	if includeDeleted {
@@ -3195,6 +3384,83 @@ func (s SqlChannelStore) MigrateChannelMembers(fromChannelId string, fromUserId
 	return data, nil
 }
 
+// rootMessageCountBatchSize is the number of channels or channel members whose root message counts
+// are computed by each batch of MigrateTotalMsgCountRoot and MigrateMsgCountRoot.
+const rootMessageCountBatchSize = 100
+
+// joinLeavePostTypes are the types of the posts that aren't counted as root messages, as checked by
+// model.Post.IsJoinLeaveMessage when saving them.
+var joinLeavePostTypes = []string{
+	model.POST_JOIN_LEAVE,
+	model.POST_ADD_REMOVE,
+	model.POST_JOIN_CHANNEL,
+	model.POST_LEAVE_CHANNEL,
+	model.POST_JOIN_TEAM,
+	model.POST_LEAVE_TEAM,
+	model.POST_ADD_TO_CHANNEL,
+	model.POST_REMOVE_FROM_CHANNEL,
+	model.POST_ADD_TO_TEAM,
+	model.POST_REMOVE_FROM_TEAM,
+}
+
+// MigrateTotalMsgCountRoot counts the root messages of a batch of channels after the given channel,
+// returning the key of the last channel counted, or nil once there are none left.
+func (s SqlChannelStore) MigrateTotalMsgCountRoot(fromChannelId string) (map[string]string, error) {
+	var channelIds []string
+	if _, err := s.GetMaster().Select(&channelIds, "SELECT Id FROM Channels WHERE Id > :FromChannelId ORDER BY Id LIMIT :Limit", map[string]interface{}{"FromChannelId": fromChannelId, "Limit": rootMessageCountBatchSize}); err != nil {
+		return nil, errors.Wrapf(err, "failed to find Channels after channelId=%s", fromChannelId)
+	}
+
+	if len(channelIds) == 0 {
+		return nil, nil
+	}
+
+	postTypes, params := MapStringsToQueryParams(joinLeavePostTypes, "PostType")
+	params["FromChannelId"] = fromChannelId
+	params["ToChannelId"] = channelIds[len(channelIds)-1]
+	query := `UPDATE Channels SET TotalMsgCountRoot = (
+			SELECT COUNT(*) FROM Posts WHERE Posts.ChannelId = Channels.Id AND Posts.RootId = '' AND Posts.Type NOT IN ` + postTypes + `
+		)
+		WHERE Id > :FromChannelId AND Id <= :ToChannelId`
+	if _, err := s.GetMaster().Exec(query, params); err != nil {
+		return nil, errors.Wrapf(err, "failed to count the root messages of Channels after channelId=%s", fromChannelId)
+	}
+
+	return map[string]string{"ChannelId": channelIds[len(channelIds)-1]}, nil
+}
+
+// MigrateMsgCountRoot counts the root messages read by a batch of channel members after the given
+// key, returning the key of the last channel member counted, or nil once there are none left.
+func (s SqlChannelStore) MigrateMsgCountRoot(fromChannelId string, fromUserId string) (map[string]string, error) {
+	var keys []struct {
+		ChannelId string
+		UserId    string
+	}
+	if _, err := s.GetMaster().Select(&keys, "SELECT ChannelId, UserId FROM ChannelMembers WHERE (ChannelId, UserId) > (:FromChannelId, :FromUserId) ORDER BY ChannelId, UserId LIMIT :Limit", map[string]interface{}{"FromChannelId": fromChannelId, "FromUserId": fromUserId, "Limit": rootMessageCountBatchSize}); err != nil {
+		return nil, errors.Wrapf(err, "failed to find ChannelMembers after channelId=%s userId=%s", fromChannelId, fromUserId)
+	}
+
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	last := keys[len(keys)-1]
+	postTypes, params := MapStringsToQueryParams(joinLeavePostTypes, "PostType")
+	params["FromChannelId"] = fromChannelId
+	params["FromUserId"] = fromUserId
+	params["ToChannelId"] = last.ChannelId
+	params["ToUserId"] = last.UserId
+	query := `UPDATE ChannelMembers SET MsgCountRoot = (
+			SELECT COUNT(*) FROM Posts WHERE Posts.ChannelId = ChannelMembers.ChannelId AND Posts.RootId = '' AND Posts.Type NOT IN ` + postTypes + ` AND Posts.CreateAt <= ChannelMembers.LastViewedAt
+		)
+		WHERE (ChannelId, UserId) > (:FromChannelId, :FromUserId) AND (ChannelId, UserId) <= (:ToChannelId, :ToUserId)`
+	if _, err := s.GetMaster().Exec(query, params); err != nil {
+		return nil, errors.Wrapf(err, "failed to count the root messages of ChannelMembers after channelId=%s userId=%s", fromChannelId, fromUserId)
+	}
+
+	return map[string]string{"ChannelId": last.ChannelId, "UserId": last.UserId}, nil
+}
+
 func (s SqlChannelStore) ResetAllChannelSchemes() *model.AppError {
 	transaction, err := s.GetMaster().Begin()
 	if err != nil {
@@ -3442,28 +3708,75 @@ func (s SqlChannelStore) UserBelongsToChannels(userId string, channelIds []strin
 	return c > 0, nil
 }
 
+// updateMembersRoleQuery builds the update making the given members of the channel admins and the
+// other members of the channel, guests excepted, non-admin members.
+func (s SqlChannelStore) updateMembersRoleQuery(channelID string, userIDs []string) sq.UpdateBuilder {
+	return s.getQueryBuilder().
+		Update("ChannelMembers").
+		Set("SchemeAdmin", sq.Case().When(sq.Eq{"UserId": userIDs}, "TRUE").Else("FALSE")).
+		Where(sq.Eq{"ChannelId": channelID}).
+		Where(sq.Or{sq.Eq{"SchemeGuest": false}, sq.Eq{"SchemeGuest": nil}})
+}
+
 func (s SqlChannelStore) UpdateMembersRole(channelID string, userIDs []string) *model.AppError {
-	sql := fmt.Sprintf(`
-		UPDATE
-			ChannelMembers
-		SET
-			SchemeAdmin = CASE WHEN UserId IN ('%s') THEN
-				TRUE
-			ELSE
-				FALSE
-			END
-		WHERE
-			ChannelId = :ChannelId
-			AND (SchemeGuest = false OR SchemeGuest IS NULL)
-			`, strings.Join(userIDs, "', '"))
+	query, args, err := s.updateMembersRoleQuery(channelID, userIDs).ToSql()
+	if err != nil {
+		return model.NewAppError("SqlChannelStore.UpdateMembersRole", "store.sql.build_query.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
 
-	if _, err := s.GetMaster().Exec(sql, map[string]interface{}{"ChannelId": channelID}); err != nil {
+	if _, err := s.GetMaster().Exec(query, args...); err != nil {
 		return model.NewAppError("SqlChannelStore.UpdateMembersRole", "store.update_error", nil, err.Error(), http.StatusInternalServerError)
 	}
 
 	return nil
 }
 
+func (s SqlChannelStore) UpdateMembersRoleAndGetUpdated(channelID string, userIDs []string) ([]*model.ChannelMember, *model.AppError) {
+	selectQuery, selectArgs, err := s.getChannelMembersWithSchemeSelectQuery().
+		Where(sq.Eq{"ChannelMembers.ChannelId": channelID}).
+		Where(sq.Or{sq.Eq{"ChannelMembers.SchemeGuest": false}, sq.Eq{"ChannelMembers.SchemeGuest": nil}}).
+		Where(sq.Or{
+			sq.And{sq.Eq{"ChannelMembers.UserId": userIDs}, sq.Or{sq.Eq{"ChannelMembers.SchemeAdmin": false}, sq.Eq{"ChannelMembers.SchemeAdmin": nil}}},
+			sq.And{sq.NotEq{"ChannelMembers.UserId": userIDs}, sq.Eq{"ChannelMembers.SchemeAdmin": true}},
+		}).
+		ToSql()
+	if err != nil {
+		return nil, model.NewAppError("SqlChannelStore.UpdateMembersRoleAndGetUpdated", "store.sql.build_query.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	updateQuery, updateArgs, err := s.updateMembersRoleQuery(channelID, userIDs).ToSql()
+	if err != nil {
+		return nil, model.NewAppError("SqlChannelStore.UpdateMembersRoleAndGetUpdated", "store.sql.build_query.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	transaction, err := s.GetMaster().Begin()
+	if err != nil {
+		return nil, model.NewAppError("SqlChannelStore.UpdateMembersRoleAndGetUpdated", "store.update_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	defer finalizeTransaction(transaction)
+
+	var dbMembers channelMemberWithSchemeRolesList
+	if _, err := transaction.Select(&dbMembers, selectQuery, selectArgs...); err != nil {
+		return nil, model.NewAppError("SqlChannelStore.UpdateMembersRoleAndGetUpdated", "store.sql_channel.get_members.app_error", nil, "channel_id="+channelID+", "+err.Error(), http.StatusInternalServerError)
+	}
+
+	if _, err := transaction.Exec(updateQuery, updateArgs...); err != nil {
+		return nil, model.NewAppError("SqlChannelStore.UpdateMembersRoleAndGetUpdated", "store.update_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return nil, model.NewAppError("SqlChannelStore.UpdateMembersRoleAndGetUpdated", "store.update_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	members := make([]*model.ChannelMember, 0, len(dbMembers))
+	for _, dbMember := range dbMembers {
+		dbMember.SchemeAdmin = sql.NullBool{Bool: !dbMember.SchemeAdmin.Bool, Valid: true}
+		members = append(members, dbMember.ToModel())
+	}
+
+	return members, nil
+}
+
 func (s SqlChannelStore) GroupSyncedChannelCount() (int64, *model.AppError) {
 	query := s.getQueryBuilder().Select("COUNT(*)").From("Channels").Where(sq.Eq{"GroupConstrained": true, "DeleteAt": 0})
 
@@ -40,6 +40,8 @@ func newSqlCommandStore(sqlStore SqlStore) store.CommandStore {
 		tableo.ColMap("AutoCompleteHint").SetMaxSize(1024)
 		tableo.ColMap("DisplayName").SetMaxSize(64)
 		tableo.ColMap("Description").SetMaxSize(128)
+		tableo.ColMap("AutocompleteDataVersion").SetMaxSize(model.COMMAND_AUTOCOMPLETE_DATA_VERSION_MAX_LENGTH)
+		tableo.ColMap("AutocompleteDataURL").SetMaxSize(1024)
 	}
 
 	return s
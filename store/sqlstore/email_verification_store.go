@@ -0,0 +1,147 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+type SqlEmailVerificationStore struct {
+	SqlStore
+}
+
+func newSqlEmailVerificationStore(sqlStore SqlStore) store.EmailVerificationStore {
+	s := &SqlEmailVerificationStore{sqlStore}
+
+	for _, db := range sqlStore.GetAllConns() {
+		table := db.AddTableWithName(model.EmailVerification{}, "EmailVerifications").SetKeys(false, "UserId")
+		table.ColMap("UserId").SetMaxSize(26)
+	}
+
+	return s
+}
+
+func (s SqlEmailVerificationStore) createIndexesIfNotExists() {
+	s.CreateIndexIfNotExists("idx_emailverifications_deadline", "EmailVerifications", "Deadline")
+}
+
+func (s SqlEmailVerificationStore) Save(verification *model.EmailVerification) (*model.EmailVerification, error) {
+	verification.PreSave()
+	if err := verification.IsValid(); err != nil {
+		return nil, err
+	}
+
+	if err := s.GetMaster().Insert(verification); err != nil {
+		if IsUniqueConstraintError(err, []string{"PRIMARY", "emailverifications_pkey"}) {
+			return nil, store.NewErrConflict("EmailVerification", err, "user_id="+verification.UserId)
+		}
+		return nil, errors.Wrapf(err, "failed to save EmailVerification with userId=%s", verification.UserId)
+	}
+
+	return verification, nil
+}
+
+func (s SqlEmailVerificationStore) Get(userId string) (*model.EmailVerification, error) {
+	var verification model.EmailVerification
+	if err := s.GetReplica().SelectOne(&verification, "SELECT * FROM EmailVerifications WHERE UserId = :UserId", map[string]interface{}{"UserId": userId}); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("EmailVerification", userId)
+		}
+		return nil, errors.Wrapf(err, "failed to get EmailVerification with userId=%s", userId)
+	}
+
+	return &verification, nil
+}
+
+func (s SqlEmailVerificationStore) Update(verification *model.EmailVerification) (*model.EmailVerification, error) {
+	if err := verification.IsValid(); err != nil {
+		return nil, err
+	}
+
+	count, err := s.GetMaster().Update(verification)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update EmailVerification with userId=%s", verification.UserId)
+	}
+	if count == 0 {
+		return nil, store.NewErrNotFound("EmailVerification", verification.UserId)
+	}
+
+	return verification, nil
+}
+
+func (s SqlEmailVerificationStore) Delete(userId string) error {
+	queryString, args, err := s.getQueryBuilder().
+		Delete("EmailVerifications").
+		Where(sq.Eq{"UserId": userId}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "email_verification_delete_tosql")
+	}
+
+	if _, err := s.GetMaster().Exec(queryString, args...); err != nil {
+		return errors.Wrapf(err, "failed to delete EmailVerification with userId=%s", userId)
+	}
+
+	return nil
+}
+
+func (s SqlEmailVerificationStore) GetForReminder(remindBefore int64, limit int) ([]*model.EmailVerification, error) {
+	return s.getPending(sq.And{
+		sq.Eq{"RemindedAt": 0},
+		sq.LtOrEq{"Deadline": remindBefore},
+	}, limit)
+}
+
+func (s SqlEmailVerificationStore) GetOverdue(now int64, limit int) ([]*model.EmailVerification, error) {
+	return s.getPending(sq.LtOrEq{"Deadline": now}, limit)
+}
+
+func (s SqlEmailVerificationStore) getPending(where sq.Sqlizer, limit int) ([]*model.EmailVerification, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("*").
+		From("EmailVerifications").
+		Where(sq.Eq{"LockedAt": 0}).
+		Where(where).
+		OrderBy("Deadline ASC", "UserId ASC").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "email_verification_tosql")
+	}
+
+	verifications := []*model.EmailVerification{}
+	if _, err := s.GetReplica().Select(&verifications, queryString, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to find EmailVerifications")
+	}
+
+	return verifications, nil
+}
+
+func (s SqlEmailVerificationStore) GetUnverifiedUsers(offset, limit int) ([]*model.UnverifiedUser, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("u.Id AS UserId, u.Username, u.Email, u.CreateAt, COALESCE(ev.Deadline, 0) AS Deadline, COALESCE(ev.RemindedAt, 0) AS RemindedAt, COALESCE(ev.LockedAt, 0) AS LockedAt").
+		From("Users u").
+		LeftJoin("EmailVerifications ev ON ev.UserId = u.Id").
+		Where(sq.Eq{"u.EmailVerified": false, "u.DeleteAt": 0, "u.AuthService": ""}).
+		OrderBy("u.CreateAt ASC", "u.Id ASC").
+		Offset(uint64(offset)).
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "unverified_users_tosql")
+	}
+
+	users := []*model.UnverifiedUser{}
+	if _, err := s.GetReplica().Select(&users, queryString, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to find unverified Users")
+	}
+
+	return users, nil
+}
@@ -30,6 +30,7 @@ func newSqlEmojiStore(sqlStore SqlStore, metrics einterfaces.MetricsInterface) s
 		table.ColMap("Id").SetMaxSize(26)
 		table.ColMap("CreatorId").SetMaxSize(26)
 		table.ColMap("Name").SetMaxSize(64)
+		table.ColMap("TeamId").SetMaxSize(26)
 
 		table.SetUniqueTogether("Name", "DeleteAt")
 	}
@@ -42,6 +43,7 @@ func (es SqlEmojiStore) createIndexesIfNotExists() {
 	es.CreateIndexIfNotExists("idx_emoji_create_at", "Emoji", "CreateAt")
 	es.CreateIndexIfNotExists("idx_emoji_delete_at", "Emoji", "DeleteAt")
 	es.CreateIndexIfNotExists("idx_emoji_name", "Emoji", "Name")
+	es.CreateIndexIfNotExists("idx_emoji_team_id", "Emoji", "TeamId")
 }
 
 func (es SqlEmojiStore) Save(emoji *model.Emoji) (*model.Emoji, error) {
@@ -83,10 +85,11 @@ func (es SqlEmojiStore) GetMultipleByName(names []string) ([]*model.Emoji, error
 	return emojis, nil
 }
 
-func (es SqlEmojiStore) GetList(offset, limit int, sort string) ([]*model.Emoji, error) {
+func (es SqlEmojiStore) GetList(offset, limit int, sort string, teamIds []string) ([]*model.Emoji, error) {
 	var emoji []*model.Emoji
 
-	query := "SELECT * FROM Emoji WHERE DeleteAt = 0"
+	params := map[string]interface{}{"Offset": offset, "Limit": limit}
+	query := "SELECT * FROM Emoji WHERE DeleteAt = 0" + teamEmojiFilter(teamIds, params)
 
 	if sort == model.EMOJI_SORT_BY_NAME {
 		query += " ORDER BY Name"
@@ -94,7 +97,7 @@ func (es SqlEmojiStore) GetList(offset, limit int, sort string) ([]*model.Emoji,
 
 	query += " LIMIT :Limit OFFSET :Offset"
 
-	if _, err := es.GetReplica().Select(&emoji, query, map[string]interface{}{"Offset": offset, "Limit": limit}); err != nil {
+	if _, err := es.GetReplica().Select(&emoji, query, params); err != nil {
 		return nil, errors.Wrap(err, "could not get list of emojis")
 	}
 	return emoji, nil
@@ -118,7 +121,7 @@ func (es SqlEmojiStore) Delete(emoji *model.Emoji, time int64) error {
 	return nil
 }
 
-func (es SqlEmojiStore) Search(name string, prefixOnly bool, limit int) ([]*model.Emoji, error) {
+func (es SqlEmojiStore) Search(name string, prefixOnly bool, limit int, teamIds []string) ([]*model.Emoji, error) {
 	var emojis []*model.Emoji
 
 	name = sanitizeSearchTerm(name, "\\")
@@ -130,6 +133,7 @@ func (es SqlEmojiStore) Search(name string, prefixOnly bool, limit int) ([]*mode
 
 	term += name + "%"
 
+	params := map[string]interface{}{"Name": term, "Limit": limit}
 	if _, err := es.GetReplica().Select(&emojis,
 		`SELECT
 			*
@@ -137,14 +141,33 @@ func (es SqlEmojiStore) Search(name string, prefixOnly bool, limit int) ([]*mode
 			Emoji
 		WHERE
 			Name LIKE :Name
-			AND DeleteAt = 0
+			AND DeleteAt = 0`+teamEmojiFilter(teamIds, params)+`
 			ORDER BY Name
-			LIMIT :Limit`, map[string]interface{}{"Name": term, "Limit": limit}); err != nil {
+			LIMIT :Limit`, params); err != nil {
 		return nil, errors.Wrapf(err, "could not search emojis by name %s", name)
 	}
 	return emojis, nil
 }
 
+// teamEmojiFilter returns the condition restricting the emoji to the ones available to everyone and those of the
+// given teams, adding its parameters to params. There is no restriction when teamIds is nil.
+func teamEmojiFilter(teamIds []string, params map[string]interface{}) string {
+	if teamIds == nil {
+		return ""
+	}
+
+	if len(teamIds) == 0 {
+		return " AND TeamId = ''"
+	}
+
+	keys, teamParams := MapStringsToQueryParams(teamIds, "TeamId")
+	for key, value := range teamParams {
+		params[key] = value
+	}
+
+	return " AND (TeamId = '' OR TeamId IN " + keys + ")"
+}
+
 // getBy returns one active (not deleted) emoji, found by any one column (what/key).
 func (es SqlEmojiStore) getBy(what, key string, addToCache bool) (*model.Emoji, error) {
 	var emoji *model.Emoji
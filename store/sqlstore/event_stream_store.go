@@ -0,0 +1,124 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+type SqlEventStreamStore struct {
+	SqlStore
+}
+
+func newSqlEventStreamStore(sqlStore SqlStore) store.EventStreamStore {
+	s := &SqlEventStreamStore{sqlStore}
+
+	for _, db := range sqlStore.GetAllConns() {
+		table := db.AddTableWithName(model.EventStreamEvent{}, "EventStreamOutbox").SetKeys(false, "Id")
+		table.ColMap("Id").SetMaxSize(26)
+		table.ColMap("Type").SetMaxSize(64)
+		table.ColMap("TeamId").SetMaxSize(26)
+		table.ColMap("ChannelId").SetMaxSize(26)
+		table.ColMap("UserId").SetMaxSize(26)
+		table.ColMap("Data").SetMaxSize(model.POST_MESSAGE_MAX_BYTES_V2)
+	}
+
+	return s
+}
+
+func (s SqlEventStreamStore) createIndexesIfNotExists() {
+	s.CreateIndexIfNotExists("idx_eventstreamoutbox_next_attempt_at", "EventStreamOutbox", "NextAttemptAt")
+}
+
+func (s SqlEventStreamStore) Save(event *model.EventStreamEvent) (*model.EventStreamEvent, error) {
+	if event.Id != "" {
+		return nil, store.NewErrInvalidInput("EventStreamEvent", "id", event.Id)
+	}
+
+	event.PreSave()
+	if err := event.IsValid(); err != nil {
+		return nil, err
+	}
+
+	if err := s.GetMaster().Insert(event); err != nil {
+		return nil, errors.Wrapf(err, "failed to save EventStreamEvent with id=%s", event.Id)
+	}
+
+	return event, nil
+}
+
+func (s SqlEventStreamStore) GetPending(now int64, limit int) ([]*model.EventStreamEvent, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("*").
+		From("EventStreamOutbox").
+		Where(sq.LtOrEq{"NextAttemptAt": now}).
+		OrderBy("CreateAt ASC", "Id ASC").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "event_stream_get_pending_tosql")
+	}
+
+	events := []*model.EventStreamEvent{}
+	if _, err := s.GetMaster().Select(&events, queryString, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to find pending EventStreamEvents")
+	}
+
+	return events, nil
+}
+
+func (s SqlEventStreamStore) RecordFailedAttempt(ids []string, nextAttemptAt int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	queryString, args, err := s.getQueryBuilder().
+		Update("EventStreamOutbox").
+		Set("Attempts", sq.Expr("Attempts + 1")).
+		Set("NextAttemptAt", nextAttemptAt).
+		Where(sq.Eq{"Id": ids}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "event_stream_record_failed_attempt_tosql")
+	}
+
+	if _, err := s.GetMaster().Exec(queryString, args...); err != nil {
+		return errors.Wrap(err, "failed to record a failed attempt of EventStreamEvents")
+	}
+
+	return nil
+}
+
+func (s SqlEventStreamStore) Delete(ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	queryString, args, err := s.getQueryBuilder().
+		Delete("EventStreamOutbox").
+		Where(sq.Eq{"Id": ids}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "event_stream_delete_tosql")
+	}
+
+	if _, err := s.GetMaster().Exec(queryString, args...); err != nil {
+		return errors.Wrap(err, "failed to delete EventStreamEvents")
+	}
+
+	return nil
+}
+
+func (s SqlEventStreamStore) Count() (int64, error) {
+	count, err := s.GetReplica().SelectInt("SELECT COUNT(*) FROM EventStreamOutbox")
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to count EventStreamEvents")
+	}
+
+	return count, nil
+}
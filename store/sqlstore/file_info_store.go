@@ -40,6 +40,8 @@ func newSqlFileInfoStore(sqlStore SqlStore, metrics einterfaces.MetricsInterface
 		table.ColMap("Name").SetMaxSize(256)
 		table.ColMap("Extension").SetMaxSize(64)
 		table.ColMap("MimeType").SetMaxSize(256)
+		table.ColMap("Hash").SetMaxSize(64)
+		table.ColMap("Metadata").SetMaxSize(4000)
 	}
 
 	return s
@@ -257,6 +259,60 @@ func (fs SqlFileInfoStore) AttachToPost(fileId, postId, creatorId string) *model
 	return nil
 }
 
+func (fs SqlFileInfoStore) SetSensitive(fileId string, sensitive bool) *model.AppError {
+	if _, err := fs.GetMaster().Exec(`
+		UPDATE
+			FileInfo
+		SET
+			Sensitive = :Sensitive,
+			UpdateAt = :UpdateAt
+		WHERE
+			Id = :Id
+	`, map[string]interface{}{
+		"Sensitive": sensitive,
+		"UpdateAt":  model.GetMillis(),
+		"Id":        fileId,
+	}); err != nil {
+		return model.NewAppError("SqlFileInfoStore.SetSensitive",
+			"store.sql_file_info.set_sensitive.app_error", nil, "file_id="+fileId+", err="+err.Error(), http.StatusInternalServerError)
+	}
+	return nil
+}
+
+// UpdateEnrichment saves the metadata extracted by the file enrichment job onto the FileInfo. The size is
+// updated as well, since enrichment may rewrite the stored content of the file.
+func (fs SqlFileInfoStore) UpdateEnrichment(info *model.FileInfo) *model.AppError {
+	if _, err := fs.GetMaster().Exec(`
+		UPDATE
+			FileInfo
+		SET
+			Size = :Size,
+			Width = :Width,
+			Height = :Height,
+			Hash = :Hash,
+			PageCount = :PageCount,
+			Metadata = :Metadata,
+			EnrichedAt = :EnrichedAt,
+			UpdateAt = :UpdateAt
+		WHERE
+			Id = :Id
+	`, map[string]interface{}{
+		"Size":       info.Size,
+		"Width":      info.Width,
+		"Height":     info.Height,
+		"Hash":       info.Hash,
+		"PageCount":  info.PageCount,
+		"Metadata":   model.MapToJson(info.Metadata),
+		"EnrichedAt": info.EnrichedAt,
+		"UpdateAt":   model.GetMillis(),
+		"Id":         info.Id,
+	}); err != nil {
+		return model.NewAppError("SqlFileInfoStore.UpdateEnrichment",
+			"store.sql_file_info.update_enrichment.app_error", nil, "file_id="+info.Id+", err="+err.Error(), http.StatusInternalServerError)
+	}
+	return nil
+}
+
 func (fs SqlFileInfoStore) DeleteForPost(postId string) (string, *model.AppError) {
 	if _, err := fs.GetMaster().Exec(
 		`UPDATE
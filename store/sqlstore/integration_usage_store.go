@@ -0,0 +1,125 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+type SqlIntegrationUsageStore struct {
+	SqlStore
+}
+
+func newSqlIntegrationUsageStore(sqlStore SqlStore) store.IntegrationUsageStore {
+	s := &SqlIntegrationUsageStore{sqlStore}
+
+	for _, db := range sqlStore.GetAllConns() {
+		table := db.AddTableWithName(model.IntegrationUsage{}, "IntegrationUsage").SetKeys(false, "IntegrationId", "Day")
+		table.ColMap("IntegrationId").SetMaxSize(26)
+		table.ColMap("Type").SetMaxSize(32)
+	}
+
+	return s
+}
+
+func (s SqlIntegrationUsageStore) createIndexesIfNotExists() {
+	s.CreateIndexIfNotExists("idx_integrationusage_day", "IntegrationUsage", "Day")
+}
+
+// Increment adds the counts of the usage to those recorded for the integration on that day.
+func (s SqlIntegrationUsageStore) Increment(usage *model.IntegrationUsage) error {
+	if err := usage.IsValid(); err != nil {
+		return err
+	}
+
+	params := map[string]interface{}{
+		"IntegrationId": usage.IntegrationId,
+		"Type":          usage.Type,
+		"Day":           usage.Day,
+		"Posts":         usage.Posts,
+		"Executions":    usage.Executions,
+		"Deliveries":    usage.Deliveries,
+		"Failures":      usage.Failures,
+	}
+
+	var query string
+	if s.DriverName() == model.DATABASE_DRIVER_POSTGRES {
+		query = `
+			INSERT INTO IntegrationUsage (IntegrationId, Type, Day, Posts, Executions, Deliveries, Failures)
+			VALUES (:IntegrationId, :Type, :Day, :Posts, :Executions, :Deliveries, :Failures)
+			ON CONFLICT (IntegrationId, Day) DO UPDATE SET
+				Posts = IntegrationUsage.Posts + :Posts,
+				Executions = IntegrationUsage.Executions + :Executions,
+				Deliveries = IntegrationUsage.Deliveries + :Deliveries,
+				Failures = IntegrationUsage.Failures + :Failures`
+	} else {
+		query = `
+			INSERT INTO IntegrationUsage (IntegrationId, Type, Day, Posts, Executions, Deliveries, Failures)
+			VALUES (:IntegrationId, :Type, :Day, :Posts, :Executions, :Deliveries, :Failures)
+			ON DUPLICATE KEY UPDATE
+				Posts = Posts + :Posts,
+				Executions = Executions + :Executions,
+				Deliveries = Deliveries + :Deliveries,
+				Failures = Failures + :Failures`
+	}
+
+	if _, err := s.GetMaster().Exec(query, params); err != nil {
+		return errors.Wrapf(err, "failed to increment the usage of integrationId=%s", usage.IntegrationId)
+	}
+	return nil
+}
+
+// GetForPeriod returns the usage recorded on the days between startDay and endDay, both included,
+// ordered by day.
+func (s SqlIntegrationUsageStore) GetForPeriod(startDay, endDay int64) ([]*model.IntegrationUsage, error) {
+	query, args, err := s.getQueryBuilder().
+		Select("IntegrationId", "Type", "Day", "Posts", "Executions", "Deliveries", "Failures").
+		From("IntegrationUsage").
+		Where("Day >= ? AND Day <= ?", startDay, endDay).
+		OrderBy("Day", "IntegrationId").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "integration_usage_tosql")
+	}
+
+	var usages []*model.IntegrationUsage
+	if _, err := s.GetReplica().Select(&usages, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get the integration usage between startDay=%d and endDay=%d", startDay, endDay)
+	}
+	return usages, nil
+}
+
+// PermanentDeleteBatch deletes up to limit rows of usage recorded on days before endDay.
+func (s SqlIntegrationUsageStore) PermanentDeleteBatch(endDay int64, limit int64) (int64, error) {
+	var query string
+	if s.DriverName() == model.DATABASE_DRIVER_POSTGRES {
+		query =
+			`DELETE FROM IntegrationUsage
+				 WHERE ctid IN (
+					SELECT ctid FROM IntegrationUsage
+					WHERE Day < :EndDay
+					LIMIT :Limit
+				);`
+	} else {
+		query =
+			`DELETE FROM IntegrationUsage
+				 WHERE Day < :EndDay
+				 LIMIT :Limit`
+	}
+
+	params := map[string]interface{}{"EndDay": endDay, "Limit": limit}
+	sqlResult, err := s.GetMaster().Exec(query, params)
+	if err != nil {
+		return 0, errors.Wrapf(err, "PermanentDeleteBatch endDay=%d limit=%d", endDay, limit)
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrapf(err, "PermanentDeleteBatch endDay=%d limit=%d", endDay, limit)
+	}
+	return rowsAffected, nil
+}
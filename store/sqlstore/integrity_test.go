@@ -4,6 +4,7 @@
 package sqlstore
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -303,7 +304,7 @@ func createTeam(ss store.Store, userId string) *model.Team {
 	m.Type = model.TEAM_OPEN
 	m.Email = "test@example.com"
 	m.Name = "z-z-z" + model.NewRandomTeamName() + "b"
-	t, _ := ss.Team().Save(&m)
+	t, _ := ss.Team().Save(context.Background(), &m)
 	return t
 }
 
@@ -311,7 +312,7 @@ func createTeamMember(ss store.Store, teamId, userId string) *model.TeamMember {
 	m := model.TeamMember{}
 	m.TeamId = teamId
 	m.UserId = userId
-	tm, _ := ss.Team().SaveMember(&m, -1)
+	tm, _ := ss.Team().SaveMember(context.Background(), &m, -1)
 	return tm
 }
 
@@ -322,7 +323,7 @@ func createTeamWithSchemeId(ss store.Store, schemeId *string) *model.Team {
 	m.Type = model.TEAM_OPEN
 	m.Email = "test@example.com"
 	m.Name = "z-z-z" + model.NewId() + "b"
-	t, _ := ss.Team().Save(&m)
+	t, _ := ss.Team().Save(context.Background(), &m)
 	return t
 }
 
@@ -933,7 +934,7 @@ func TestCheckTeamsTeamMembersIntegrity(t *testing.T) {
 			require.Equal(t, store.OrphanedRecord{
 				ParentId: &team.Id,
 			}, data.Records[0])
-			ss.Team().RemoveAllMembersByTeam(member.TeamId)
+			ss.Team().RemoveAllMembersByTeam(context.Background(), member.TeamId)
 		})
 	})
 }
@@ -1530,7 +1531,7 @@ func TestCheckUsersTeamMembersIntegrity(t *testing.T) {
 			require.Equal(t, store.OrphanedRecord{
 				ParentId: &member.UserId,
 			}, data.Records[0])
-			ss.Team().RemoveAllMembersByTeam(member.TeamId)
+			ss.Team().RemoveAllMembersByTeam(context.Background(), member.TeamId)
 			dbmap.Delete(team)
 		})
 	})
@@ -0,0 +1,124 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+type SqlLoginHistoryStore struct {
+	SqlStore
+}
+
+func newSqlLoginHistoryStore(sqlStore SqlStore) store.LoginHistoryStore {
+	s := &SqlLoginHistoryStore{sqlStore}
+
+	for _, db := range sqlStore.GetAllConns() {
+		table := db.AddTableWithName(model.LoginHistory{}, "LoginHistory").SetKeys(false, "Id")
+		table.ColMap("Id").SetMaxSize(26)
+		table.ColMap("UserId").SetMaxSize(26)
+		table.ColMap("IpAddress").SetMaxSize(model.LOGIN_HISTORY_IP_ADDRESS_MAX_LENGTH)
+		table.ColMap("UserAgent").SetMaxSize(model.LOGIN_HISTORY_USER_AGENT_MAX_RUNES * 4)
+		table.ColMap("AuthService").SetMaxSize(32)
+		table.ColMap("FailureReason").SetMaxSize(model.LOGIN_HISTORY_FAILURE_REASON_MAX_LENGTH)
+	}
+
+	return s
+}
+
+func (s SqlLoginHistoryStore) createIndexesIfNotExists() {
+	s.CreateCompositeIndexIfNotExists("idx_loginhistory_user_id_create_at", "LoginHistory", []string{"UserId", "CreateAt"})
+	s.CreateIndexIfNotExists("idx_loginhistory_create_at", "LoginHistory", "CreateAt")
+}
+
+func (s SqlLoginHistoryStore) Save(entry *model.LoginHistory) (*model.LoginHistory, error) {
+	if entry.Id != "" {
+		return nil, store.NewErrInvalidInput("LoginHistory", "id", entry.Id)
+	}
+
+	entry.PreSave()
+	if err := entry.IsValid(); err != nil {
+		return nil, err
+	}
+
+	if err := s.GetMaster().Insert(entry); err != nil {
+		return nil, errors.Wrapf(err, "failed to save LoginHistory with id=%s", entry.Id)
+	}
+
+	return entry, nil
+}
+
+func (s SqlLoginHistoryStore) Get(options *model.LoginHistoryGetOptions) ([]*model.LoginHistory, error) {
+	query := s.getQueryBuilder().
+		Select("*").
+		From("LoginHistory").
+		OrderBy("CreateAt DESC", "Id DESC").
+		Offset(uint64(options.Page * options.PerPage)).
+		Limit(uint64(options.PerPage))
+
+	if options.UserId != "" {
+		query = query.Where(sq.Eq{"UserId": options.UserId})
+	}
+	if options.Since > 0 {
+		query = query.Where(sq.GtOrEq{"CreateAt": options.Since})
+	}
+	if options.Until > 0 {
+		query = query.Where(sq.Lt{"CreateAt": options.Until})
+	}
+	if options.OnlyFailures {
+		query = query.Where(sq.Eq{"Success": false})
+	}
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "login_history_tosql")
+	}
+
+	entries := []*model.LoginHistory{}
+	if _, err := s.GetReplica().Select(&entries, queryString, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to find LoginHistory")
+	}
+
+	return entries, nil
+}
+
+func (s SqlLoginHistoryStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+	var query string
+	if s.DriverName() == model.DATABASE_DRIVER_POSTGRES {
+		query = "DELETE FROM LoginHistory WHERE Id = any (array (SELECT Id FROM LoginHistory WHERE CreateAt < :EndTime LIMIT :Limit))"
+	} else {
+		query = "DELETE FROM LoginHistory WHERE CreateAt < :EndTime LIMIT :Limit"
+	}
+
+	sqlResult, err := s.GetMaster().Exec(query, map[string]interface{}{"EndTime": endTime, "Limit": limit})
+	if err != nil {
+		return 0, errors.Wrapf(err, "PermanentDeleteBatch endTime=%d limit=%d", endTime, limit)
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrapf(err, "PermanentDeleteBatch endTime=%d limit=%d", endTime, limit)
+	}
+	return rowsAffected, nil
+}
+
+func (s SqlLoginHistoryStore) PermanentDeleteByUser(userId string) error {
+	queryString, args, err := s.getQueryBuilder().
+		Delete("LoginHistory").
+		Where(sq.Eq{"UserId": userId}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "login_history_delete_tosql")
+	}
+
+	if _, err := s.GetMaster().Exec(queryString, args...); err != nil {
+		return errors.Wrapf(err, "failed to delete LoginHistory with userId=%s", userId)
+	}
+
+	return nil
+}
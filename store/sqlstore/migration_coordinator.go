@@ -0,0 +1,225 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/blang/semver"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+const (
+	MIGRATION_POLL_INTERVAL = 2 * time.Second
+
+	// Leave room for the rest of the status in the 1024 characters of Systems.Value.
+	MIGRATION_STATUS_MAX_ERROR_LENGTH = 512
+)
+
+// migrationCoordinator makes sure only one node of a cluster migrates the database schema. The
+// lock is the SchemaMigrationStatus row of the Systems table: a node owns it while the row says the
+// migration is running and its heartbeat is fresh. Nodes that lose the race wait for the schema to
+// reach their version instead of running the same migrations concurrently.
+type migrationCoordinator struct {
+	sqlStore      SqlStore
+	nodeId        string
+	lockTimeout   time.Duration
+	pollInterval  time.Duration
+	targetVersion string
+}
+
+func newMigrationCoordinator(sqlStore SqlStore, lockTimeout time.Duration, targetVersion string) *migrationCoordinator {
+	hostname, _ := os.Hostname()
+
+	return &migrationCoordinator{
+		sqlStore:      sqlStore,
+		nodeId:        fmt.Sprintf("%s-%s", hostname, model.NewId()[:8]),
+		lockTimeout:   lockTimeout,
+		pollInterval:  MIGRATION_POLL_INTERVAL,
+		targetVersion: targetVersion,
+	}
+}
+
+// run migrates the schema with migrate if this node wins the migration lock, and otherwise blocks
+// until the node holding the lock has finished. Once the schema is up to date migrate runs without
+// the lock, since it then only validates the schema version.
+func (c *migrationCoordinator) run(migrate func() error) error {
+	for {
+		if c.isSchemaUpToDate() {
+			return migrate()
+		}
+
+		status, acquired, err := c.tryAcquire()
+		if err != nil {
+			return err
+		}
+
+		if acquired {
+			return c.migrate(status, migrate)
+		}
+
+		mlog.Info("Waiting for another node to migrate the database schema.",
+			mlog.String("node_id", status.NodeId),
+			mlog.String("current_version", status.CurrentVersion),
+			mlog.String("target_version", status.TargetVersion),
+		)
+		time.Sleep(c.pollInterval)
+	}
+}
+
+func (c *migrationCoordinator) isSchemaUpToDate() bool {
+	current := c.sqlStore.GetCurrentSchemaVersion()
+	if current == "" {
+		return false
+	}
+
+	currentVersion, err := semver.Parse(current)
+	if err != nil {
+		return false
+	}
+
+	targetVersion, err := semver.Parse(c.targetVersion)
+	if err != nil {
+		return false
+	}
+
+	return currentVersion.GTE(targetVersion)
+}
+
+// tryAcquire claims the migration lock, returning the status it wrote or, when another node
+// holds the lock, the status that node last reported.
+func (c *migrationCoordinator) tryAcquire() (*model.SchemaMigrationStatus, bool, error) {
+	now := model.GetMillis()
+	status := &model.SchemaMigrationStatus{
+		NodeId:         c.nodeId,
+		Status:         model.SCHEMA_MIGRATION_STATUS_RUNNING,
+		FromVersion:    c.sqlStore.GetCurrentSchemaVersion(),
+		CurrentVersion: c.sqlStore.GetCurrentSchemaVersion(),
+		TargetVersion:  c.targetVersion,
+		StartAt:        now,
+		UpdateAt:       now,
+	}
+
+	var oldValue string
+	err := c.sqlStore.GetMaster().SelectOne(&oldValue, "SELECT Value FROM Systems WHERE Name = :Name", map[string]interface{}{"Name": model.SYSTEM_SCHEMA_MIGRATION_STATUS})
+	if err == sql.ErrNoRows {
+		if err := c.sqlStore.GetMaster().Insert(&model.System{Name: model.SYSTEM_SCHEMA_MIGRATION_STATUS, Value: status.ToJson()}); err != nil {
+			if IsUniqueConstraintError(err, []string{"PRIMARY", "systems_pkey"}) {
+				// Another node inserted the row first.
+				return &model.SchemaMigrationStatus{}, false, nil
+			}
+			return nil, false, errors.Wrap(err, "failed to create the schema migration lock")
+		}
+		return status, true, nil
+	} else if err != nil {
+		return nil, false, errors.Wrap(err, "failed to read the schema migration lock")
+	}
+
+	held := model.SchemaMigrationStatusFromJson(strings.NewReader(oldValue))
+	if held == nil {
+		held = &model.SchemaMigrationStatus{}
+	}
+
+	if held.Status == model.SCHEMA_MIGRATION_STATUS_RUNNING && !held.IsStale(now, int64(c.lockTimeout/time.Millisecond)) {
+		return held, false, nil
+	}
+
+	if held.Status == model.SCHEMA_MIGRATION_STATUS_RUNNING {
+		mlog.Warn("Taking over a schema migration that stopped sending heartbeats.", mlog.String("node_id", held.NodeId), mlog.String("current_version", held.CurrentVersion))
+	}
+
+	swapped, err := c.compareAndSwap(oldValue, status)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return status, swapped, nil
+}
+
+// migrate runs migrate while holding the lock, refreshing the heartbeat and recorded progress until
+// it returns.
+func (c *migrationCoordinator) migrate(status *model.SchemaMigrationStatus, migrate func() error) error {
+	mlog.Info("Acquired the schema migration lock.", mlog.String("node_id", c.nodeId), mlog.String("from_version", status.FromVersion), mlog.String("target_version", status.TargetVersion))
+
+	stop := make(chan struct{})
+	done := make(chan *model.SchemaMigrationStatus)
+	go c.heartbeat(status, stop, done)
+
+	migrateErr := migrate()
+
+	close(stop)
+	status = <-done
+
+	final := *status
+	final.CurrentVersion = c.sqlStore.GetCurrentSchemaVersion()
+	final.UpdateAt = model.GetMillis()
+	final.Status = model.SCHEMA_MIGRATION_STATUS_COMPLETED
+	if migrateErr != nil {
+		final.Status = model.SCHEMA_MIGRATION_STATUS_FAILED
+		final.Error = migrateErr.Error()
+		if len(final.Error) > MIGRATION_STATUS_MAX_ERROR_LENGTH {
+			final.Error = final.Error[:MIGRATION_STATUS_MAX_ERROR_LENGTH]
+		}
+	}
+
+	if _, err := c.compareAndSwap(status.ToJson(), &final); err != nil {
+		mlog.Warn("Failed to release the schema migration lock.", mlog.Err(err))
+	}
+
+	return migrateErr
+}
+
+func (c *migrationCoordinator) heartbeat(status *model.SchemaMigrationStatus, stop <-chan struct{}, done chan<- *model.SchemaMigrationStatus) {
+	ticker := time.NewTicker(c.lockTimeout / 4)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			done <- status
+			return
+		case <-ticker.C:
+			next := *status
+			next.CurrentVersion = c.sqlStore.GetCurrentSchemaVersion()
+			next.UpdateAt = model.GetMillis()
+
+			swapped, err := c.compareAndSwap(status.ToJson(), &next)
+			if err != nil {
+				mlog.Warn("Failed to refresh the schema migration lock.", mlog.Err(err))
+				continue
+			}
+			if !swapped {
+				mlog.Warn("The schema migration lock was taken over by another node.", mlog.String("node_id", c.nodeId))
+				continue
+			}
+
+			status = &next
+		}
+	}
+}
+
+func (c *migrationCoordinator) compareAndSwap(oldValue string, status *model.SchemaMigrationStatus) (bool, error) {
+	result, err := c.sqlStore.GetMaster().Exec("UPDATE Systems SET Value = :NewValue WHERE Name = :Name AND Value = :OldValue", map[string]interface{}{
+		"Name":     model.SYSTEM_SCHEMA_MIGRATION_STATUS,
+		"NewValue": status.ToJson(),
+		"OldValue": oldValue,
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to update the schema migration lock")
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to update the schema migration lock")
+	}
+
+	return rows == 1, nil
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+func getSchemaMigrationStatus(t *testing.T, ss store.Store) *model.SchemaMigrationStatus {
+	system, err := ss.System().GetByName(model.SYSTEM_SCHEMA_MIGRATION_STATUS)
+	require.Nil(t, err)
+	return model.SchemaMigrationStatusFromJson(strings.NewReader(system.Value))
+}
+
+func TestMigrationCoordinator(t *testing.T) {
+	StoreTest(t, func(t *testing.T, ss store.Store) {
+		supplier := ss.(*SqlSupplier)
+
+		// A version the test schema can never reach, so the coordinator always takes the lock.
+		newCoordinator := func() *migrationCoordinator {
+			c := newMigrationCoordinator(supplier, time.Minute, "99.0.0")
+			c.pollInterval = 10 * time.Millisecond
+			return c
+		}
+
+		t.Run("records a completed migration", func(t *testing.T) {
+			defer ss.System().PermanentDeleteByName(model.SYSTEM_SCHEMA_MIGRATION_STATUS)
+
+			c := newCoordinator()
+			ran := false
+			require.NoError(t, c.run(func() error {
+				ran = true
+				status := getSchemaMigrationStatus(t, ss)
+				assert.Equal(t, model.SCHEMA_MIGRATION_STATUS_RUNNING, status.Status)
+				assert.Equal(t, c.nodeId, status.NodeId)
+				return nil
+			}))
+			assert.True(t, ran)
+
+			status := getSchemaMigrationStatus(t, ss)
+			assert.Equal(t, model.SCHEMA_MIGRATION_STATUS_COMPLETED, status.Status)
+			assert.Equal(t, "99.0.0", status.TargetVersion)
+
+			// A completed migration doesn't stop the next node from taking the lock.
+			_, acquired, err := newCoordinator().tryAcquire()
+			require.NoError(t, err)
+			assert.True(t, acquired)
+		})
+
+		t.Run("records a failed migration", func(t *testing.T) {
+			defer ss.System().PermanentDeleteByName(model.SYSTEM_SCHEMA_MIGRATION_STATUS)
+
+			err := newCoordinator().run(func() error {
+				return errors.New("column already exists")
+			})
+			require.Error(t, err)
+
+			status := getSchemaMigrationStatus(t, ss)
+			assert.Equal(t, model.SCHEMA_MIGRATION_STATUS_FAILED, status.Status)
+			assert.Equal(t, "column already exists", status.Error)
+		})
+
+		t.Run("waits while another node holds the lock", func(t *testing.T) {
+			defer ss.System().PermanentDeleteByName(model.SYSTEM_SCHEMA_MIGRATION_STATUS)
+
+			holder := newCoordinator()
+			held, acquired, err := holder.tryAcquire()
+			require.NoError(t, err)
+			require.True(t, acquired)
+
+			waiter := newCoordinator()
+			reported, acquired, err := waiter.tryAcquire()
+			require.NoError(t, err)
+			assert.False(t, acquired)
+			assert.Equal(t, holder.nodeId, reported.NodeId)
+
+			done := make(chan error)
+			go func() {
+				done <- waiter.run(func() error { return nil })
+			}()
+
+			select {
+			case <-done:
+				require.Fail(t, "the waiting node should not migrate while the lock is held")
+			case <-time.After(100 * time.Millisecond):
+			}
+
+			released := *held
+			released.Status = model.SCHEMA_MIGRATION_STATUS_COMPLETED
+			swapped, err := holder.compareAndSwap(held.ToJson(), &released)
+			require.NoError(t, err)
+			require.True(t, swapped)
+
+			select {
+			case err := <-done:
+				require.NoError(t, err)
+			case <-time.After(5 * time.Second):
+				require.Fail(t, "the waiting node should take the lock once it is released")
+			}
+		})
+
+		t.Run("takes over a stale lock", func(t *testing.T) {
+			defer ss.System().PermanentDeleteByName(model.SYSTEM_SCHEMA_MIGRATION_STATUS)
+
+			stale := &model.SchemaMigrationStatus{
+				NodeId:   "dead-node",
+				Status:   model.SCHEMA_MIGRATION_STATUS_RUNNING,
+				UpdateAt: model.GetMillis() - 2*time.Minute.Milliseconds(),
+			}
+			require.Nil(t, ss.System().Save(&model.System{Name: model.SYSTEM_SCHEMA_MIGRATION_STATUS, Value: stale.ToJson()}))
+
+			c := newCoordinator()
+			_, acquired, err := c.tryAcquire()
+			require.NoError(t, err)
+			assert.True(t, acquired)
+			assert.Equal(t, c.nodeId, getSchemaMigrationStatus(t, ss).NodeId)
+		})
+	})
+}
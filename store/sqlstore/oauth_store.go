@@ -31,6 +31,7 @@ func newSqlOAuthStore(sqlStore SqlStore) store.OAuthStore {
 		table.ColMap("CallbackUrls").SetMaxSize(1024)
 		table.ColMap("Homepage").SetMaxSize(256)
 		table.ColMap("IconURL").SetMaxSize(512)
+		table.ColMap("EmbedFrameAncestors").SetMaxSize(1024)
 
 		tableAuth := db.AddTableWithName(model.AuthData{}, "OAuthAuthData").SetKeys(false, "Code")
 		tableAuth.ColMap("UserId").SetMaxSize(26)
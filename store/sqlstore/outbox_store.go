@@ -0,0 +1,129 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/mattermost/gorp"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+type SqlOutboxStore struct {
+	SqlStore
+}
+
+func newSqlOutboxStore(sqlStore SqlStore) store.OutboxStore {
+	s := &SqlOutboxStore{sqlStore}
+
+	for _, db := range sqlStore.GetAllConns() {
+		table := db.AddTableWithName(model.OutboxEvent{}, "OutboxEvents").SetKeys(false, "Id")
+		table.ColMap("Id").SetMaxSize(26)
+		table.ColMap("Event").SetMaxSize(model.POST_MESSAGE_MAX_BYTES_V2)
+	}
+
+	return s
+}
+
+func (s SqlOutboxStore) createIndexesIfNotExists() {
+	s.CreateCompositeIndexIfNotExists("idx_outboxevents_delivered_at_create_at", "OutboxEvents", []string{"DeliveredAt", "CreateAt"})
+}
+
+// saveOutboxEventT records the websocket event in the outbox as part of the given transaction.
+func saveOutboxEventT(transaction *gorp.Transaction, message *model.WebSocketEvent) (*model.OutboxEvent, error) {
+	event := model.NewOutboxEvent(message)
+	event.PreSave()
+	if err := event.IsValid(); err != nil {
+		return nil, err
+	}
+
+	if err := transaction.Insert(event); err != nil {
+		return nil, errors.Wrapf(err, "failed to save OutboxEvent with id=%s", event.Id)
+	}
+
+	return event, nil
+}
+
+func (s SqlOutboxStore) Save(event *model.OutboxEvent) (*model.OutboxEvent, error) {
+	if event.Id != "" {
+		return nil, store.NewErrInvalidInput("OutboxEvent", "id", event.Id)
+	}
+
+	event.PreSave()
+	if err := event.IsValid(); err != nil {
+		return nil, err
+	}
+
+	if err := s.GetMaster().Insert(event); err != nil {
+		return nil, errors.Wrapf(err, "failed to save OutboxEvent with id=%s", event.Id)
+	}
+
+	return event, nil
+}
+
+func (s SqlOutboxStore) GetUndelivered(createdBefore int64, limit int) ([]*model.OutboxEvent, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("*").
+		From("OutboxEvents").
+		Where(sq.Eq{"DeliveredAt": 0}).
+		Where(sq.Lt{"CreateAt": createdBefore}).
+		OrderBy("CreateAt ASC", "Id ASC").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "outbox_get_undelivered_tosql")
+	}
+
+	events := []*model.OutboxEvent{}
+	if _, err := s.GetMaster().Select(&events, queryString, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to find undelivered OutboxEvents")
+	}
+
+	return events, nil
+}
+
+func (s SqlOutboxStore) MarkDelivered(ids []string, deliveredAt int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	queryString, args, err := s.getQueryBuilder().
+		Update("OutboxEvents").
+		Set("DeliveredAt", deliveredAt).
+		Where(sq.Eq{"Id": ids}).
+		Where(sq.Eq{"DeliveredAt": 0}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "outbox_mark_delivered_tosql")
+	}
+
+	if _, err := s.GetMaster().Exec(queryString, args...); err != nil {
+		return errors.Wrap(err, "failed to mark OutboxEvents as delivered")
+	}
+
+	return nil
+}
+
+func (s SqlOutboxStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+	var query string
+	if s.DriverName() == model.DATABASE_DRIVER_POSTGRES {
+		query = "DELETE FROM OutboxEvents WHERE Id = any (array (SELECT Id FROM OutboxEvents WHERE DeliveredAt > 0 AND DeliveredAt < :EndTime LIMIT :Limit))"
+	} else {
+		query = "DELETE FROM OutboxEvents WHERE DeliveredAt > 0 AND DeliveredAt < :EndTime LIMIT :Limit"
+	}
+
+	sqlResult, err := s.GetMaster().Exec(query, map[string]interface{}{"EndTime": endTime, "Limit": limit})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to delete delivered OutboxEvents")
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to get rows affected")
+	}
+
+	return rowsAffected, nil
+}
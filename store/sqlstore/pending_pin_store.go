@@ -0,0 +1,111 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+type SqlPendingPinStore struct {
+	SqlStore
+}
+
+func newSqlPendingPinStore(sqlStore SqlStore) store.PendingPinStore {
+	s := &SqlPendingPinStore{sqlStore}
+
+	for _, db := range sqlStore.GetAllConns() {
+		table := db.AddTableWithName(model.PendingPin{}, "PendingPins").SetKeys(false, "PostId")
+		table.ColMap("PostId").SetMaxSize(26)
+		table.ColMap("ChannelId").SetMaxSize(26)
+		table.ColMap("UserId").SetMaxSize(26)
+	}
+
+	return s
+}
+
+func (s SqlPendingPinStore) createIndexesIfNotExists() {
+	s.CreateIndexIfNotExists("idx_pendingpins_channel_id", "PendingPins", "ChannelId")
+}
+
+// Save saves the request to pin a post. It returns a store.ErrConflict if the pinning of the post
+// was already requested.
+func (s SqlPendingPinStore) Save(pendingPin *model.PendingPin) (*model.PendingPin, error) {
+	pendingPin.PreSave()
+	if err := pendingPin.IsValid(); err != nil {
+		return nil, err
+	}
+
+	if err := s.GetMaster().Insert(pendingPin); err != nil {
+		if IsUniqueConstraintError(err, []string{"PRIMARY", "pendingpins_pkey"}) {
+			return nil, store.NewErrConflict("PendingPin", err, "post_id="+pendingPin.PostId)
+		}
+		return nil, errors.Wrapf(err, "failed to save PendingPin with post_id=%s", pendingPin.PostId)
+	}
+
+	return pendingPin, nil
+}
+
+func (s SqlPendingPinStore) Get(postId string) (*model.PendingPin, error) {
+	var pendingPin *model.PendingPin
+	if err := s.GetReplica().SelectOne(&pendingPin, "SELECT * FROM PendingPins WHERE PostId = :PostId", map[string]interface{}{"PostId": postId}); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("PendingPin", postId)
+		}
+		return nil, errors.Wrapf(err, "failed to get PendingPin with post_id=%s", postId)
+	}
+
+	return pendingPin, nil
+}
+
+// GetForChannel returns the pending pins of the channel, oldest first.
+func (s SqlPendingPinStore) GetForChannel(channelId string) ([]*model.PendingPin, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("*").
+		From("PendingPins").
+		Where(sq.Eq{"ChannelId": channelId}).
+		OrderBy("CreateAt ASC", "PostId ASC").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "pending_pins_tosql")
+	}
+
+	pendingPins := []*model.PendingPin{}
+	if _, err := s.GetReplica().Select(&pendingPins, queryString, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to find PendingPins with channel_id=%s", channelId)
+	}
+
+	return pendingPins, nil
+}
+
+// Delete removes the request to pin the post, returning a store.ErrNotFound if there is none.
+func (s SqlPendingPinStore) Delete(postId string) error {
+	result, err := s.GetMaster().Exec("DELETE FROM PendingPins WHERE PostId = :PostId", map[string]interface{}{"PostId": postId})
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete PendingPin with post_id=%s", postId)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrapf(err, "failed to get rows affected for PendingPin with post_id=%s", postId)
+	}
+	if rowsAffected == 0 {
+		return store.NewErrNotFound("PendingPin", postId)
+	}
+
+	return nil
+}
+
+func (s SqlPendingPinStore) PermanentDeleteByChannel(channelId string) error {
+	if _, err := s.GetMaster().Exec("DELETE FROM PendingPins WHERE ChannelId = :ChannelId", map[string]interface{}{"ChannelId": channelId}); err != nil {
+		return errors.Wrapf(err, "failed to delete PendingPins with channel_id=%s", channelId)
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+type SqlPostAcknowledgementStore struct {
+	SqlStore
+}
+
+func newSqlPostAcknowledgementStore(sqlStore SqlStore) store.PostAcknowledgementStore {
+	s := &SqlPostAcknowledgementStore{sqlStore}
+
+	for _, db := range sqlStore.GetAllConns() {
+		table := db.AddTableWithName(model.PostAcknowledgement{}, "PostAcknowledgements").SetKeys(false, "PostId", "UserId")
+		table.ColMap("PostId").SetMaxSize(26)
+		table.ColMap("UserId").SetMaxSize(26)
+		table.ColMap("ChannelId").SetMaxSize(26)
+	}
+
+	return s
+}
+
+func (s SqlPostAcknowledgementStore) createIndexesIfNotExists() {
+	s.CreateIndexIfNotExists("idx_postacknowledgements_user_id", "PostAcknowledgements", "UserId")
+	s.CreateIndexIfNotExists("idx_postacknowledgements_channel_id", "PostAcknowledgements", "ChannelId")
+}
+
+// Save records that the user acknowledged the post. It returns a store.ErrConflict if the user
+// already did.
+func (s SqlPostAcknowledgementStore) Save(acknowledgement *model.PostAcknowledgement) (*model.PostAcknowledgement, error) {
+	acknowledgement.PreSave()
+	if err := acknowledgement.IsValid(); err != nil {
+		return nil, err
+	}
+
+	if err := s.GetMaster().Insert(acknowledgement); err != nil {
+		if IsUniqueConstraintError(err, []string{"PRIMARY", "postacknowledgements_pkey"}) {
+			return nil, store.NewErrConflict("PostAcknowledgement", err, "post_id="+acknowledgement.PostId+", user_id="+acknowledgement.UserId)
+		}
+		return nil, errors.Wrapf(err, "failed to save PostAcknowledgement with post_id=%s and user_id=%s", acknowledgement.PostId, acknowledgement.UserId)
+	}
+
+	return acknowledgement, nil
+}
+
+// GetForPost returns the acknowledgements of the post, oldest first.
+func (s SqlPostAcknowledgementStore) GetForPost(postId string) ([]*model.PostAcknowledgement, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("*").
+		From("PostAcknowledgements").
+		Where(sq.Eq{"PostId": postId}).
+		OrderBy("AcknowledgedAt ASC", "UserId ASC").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "post_acknowledgements_tosql")
+	}
+
+	acknowledgements := []*model.PostAcknowledgement{}
+	if _, err := s.GetReplica().Select(&acknowledgements, queryString, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to find PostAcknowledgements with post_id=%s", postId)
+	}
+
+	return acknowledgements, nil
+}
+
+// Delete removes the acknowledgement of the post by the user, returning a store.ErrNotFound if
+// there is none.
+func (s SqlPostAcknowledgementStore) Delete(postId string, userId string) error {
+	result, err := s.GetMaster().Exec("DELETE FROM PostAcknowledgements WHERE PostId = :PostId AND UserId = :UserId", map[string]interface{}{"PostId": postId, "UserId": userId})
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete PostAcknowledgement with post_id=%s and user_id=%s", postId, userId)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrapf(err, "failed to get rows affected for PostAcknowledgement with post_id=%s and user_id=%s", postId, userId)
+	}
+	if rowsAffected == 0 {
+		return store.NewErrNotFound("PostAcknowledgement", postId)
+	}
+
+	return nil
+}
+
+func (s SqlPostAcknowledgementStore) PermanentDeleteByChannel(channelId string) error {
+	if _, err := s.GetMaster().Exec("DELETE FROM PostAcknowledgements WHERE ChannelId = :ChannelId", map[string]interface{}{"ChannelId": channelId}); err != nil {
+		return errors.Wrapf(err, "failed to delete PostAcknowledgements with channel_id=%s", channelId)
+	}
+
+	return nil
+}
+
+func (s SqlPostAcknowledgementStore) PermanentDeleteByUser(userId string) error {
+	if _, err := s.GetMaster().Exec("DELETE FROM PostAcknowledgements WHERE UserId = :UserId", map[string]interface{}{"UserId": userId}); err != nil {
+		return errors.Wrapf(err, "failed to delete PostAcknowledgements with user_id=%s", userId)
+	}
+
+	return nil
+}
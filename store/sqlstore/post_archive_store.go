@@ -0,0 +1,215 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+type SqlPostArchiveStore struct {
+	SqlStore
+}
+
+func newSqlPostArchiveStore(sqlStore SqlStore) store.PostArchiveStore {
+	s := &SqlPostArchiveStore{sqlStore}
+
+	for _, db := range sqlStore.GetAllConns() {
+		table := db.AddTableWithName(model.ArchivedPost{}, "PostsArchive").SetKeys(false, "Id")
+		table.ColMap("Id").SetMaxSize(26)
+		table.ColMap("ChannelId").SetMaxSize(26)
+		table.ColMap("UserId").SetMaxSize(26)
+		table.ColMap("RootId").SetMaxSize(26)
+		table.ColMap("Message").SetMaxSize(model.POST_MESSAGE_MAX_BYTES_V2)
+	}
+
+	return s
+}
+
+func (s SqlPostArchiveStore) createIndexesIfNotExists() {
+	s.CreateIndexIfNotExists("idx_postsarchive_channel_id_create_at", "PostsArchive", "ChannelId, CreateAt")
+	s.CreateIndexIfNotExists("idx_postsarchive_root_id", "PostsArchive", "RootId")
+}
+
+func (s SqlPostArchiveStore) ArchiveBatch(endTime int64, limit int64) (int64, error) {
+	transaction, err := s.GetMaster().Begin()
+	if err != nil {
+		return 0, errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransaction(transaction)
+
+	var posts []*model.Post
+	if _, err := transaction.Select(&posts, `
+		SELECT * FROM Posts p
+		WHERE p.CreateAt < :EndTime
+		AND NOT EXISTS (
+			SELECT 1 FROM Posts Replies
+			WHERE Replies.RootId = (CASE WHEN p.RootId = '' THEN p.Id ELSE p.RootId END)
+			AND Replies.CreateAt >= :EndTime)
+		ORDER BY p.CreateAt, p.Id
+		LIMIT :Limit`, map[string]interface{}{"EndTime": endTime, "Limit": limit}); err != nil {
+		return 0, errors.Wrap(err, "failed to find Posts to archive")
+	}
+
+	if len(posts) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]string, 0, len(posts))
+	for _, post := range posts {
+		archived, err := model.NewArchivedPost(post)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to compress Post with id=%s", post.Id)
+		}
+
+		if err := transaction.Insert(archived); err != nil {
+			return 0, errors.Wrapf(err, "failed to archive Post with id=%s", post.Id)
+		}
+
+		ids = append(ids, post.Id)
+	}
+
+	queryString, args, err := s.getQueryBuilder().
+		Delete("Posts").
+		Where(sq.Eq{"Id": ids}).
+		ToSql()
+	if err != nil {
+		return 0, errors.Wrap(err, "post_archive_delete_tosql")
+	}
+
+	if _, err := transaction.Exec(queryString, args...); err != nil {
+		return 0, errors.Wrap(err, "failed to delete archived Posts")
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return 0, errors.Wrap(err, "commit_transaction")
+	}
+
+	return int64(len(ids)), nil
+}
+
+func (s SqlPostArchiveStore) Get(id string) (*model.Post, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("*").
+		From("PostsArchive").
+		Where(sq.Eq{"Id": id, "DeleteAt": 0}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "post_archive_tosql")
+	}
+
+	var archived model.ArchivedPost
+	if err := s.GetReplica().SelectOne(&archived, queryString, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("ArchivedPost", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get ArchivedPost with id=%s", id)
+	}
+
+	post, err := archived.ToPost()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decompress ArchivedPost with id=%s", id)
+	}
+
+	return post, nil
+}
+
+func (s SqlPostArchiveStore) GetThread(id string) (*model.PostList, error) {
+	post, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	rootId := post.RootId
+	if rootId == "" {
+		rootId = post.Id
+	}
+
+	queryString, args, err := s.getQueryBuilder().
+		Select("*").
+		From("PostsArchive").
+		Where(sq.Or{sq.Eq{"Id": rootId}, sq.Eq{"RootId": rootId}}).
+		Where(sq.Eq{"DeleteAt": 0}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "post_archive_thread_tosql")
+	}
+
+	var archivedPosts []*model.ArchivedPost
+	if _, err := s.GetReplica().Select(&archivedPosts, queryString, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to find ArchivedPosts with rootId=%s", rootId)
+	}
+
+	posts, err := archivedPostsToPosts(archivedPosts)
+	if err != nil {
+		return nil, err
+	}
+
+	var replyCount int64
+	for _, p := range posts {
+		if p.RootId != "" {
+			replyCount++
+		}
+	}
+
+	pl := model.NewPostList()
+	post.ReplyCount = replyCount
+	pl.AddPost(post)
+	pl.AddOrder(post.Id)
+	for _, p := range posts {
+		p.ReplyCount = replyCount
+		pl.AddPost(p)
+		pl.AddOrder(p.Id)
+	}
+
+	return pl, nil
+}
+
+func (s SqlPostArchiveStore) Search(channelIds []string, terms []string, limit int) ([]*model.Post, error) {
+	if len(channelIds) == 0 || len(terms) == 0 {
+		return []*model.Post{}, nil
+	}
+
+	query := s.getQueryBuilder().
+		Select("*").
+		From("PostsArchive").
+		Where(sq.Eq{"ChannelId": channelIds, "DeleteAt": 0}).
+		OrderBy("CreateAt DESC").
+		Limit(uint64(limit))
+
+	for _, term := range terms {
+		query = query.Where("LOWER(Message) LIKE ?", "%"+sanitizeSearchTerm(strings.ToLower(term), "\\")+"%")
+	}
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "post_archive_search_tosql")
+	}
+
+	var archivedPosts []*model.ArchivedPost
+	if _, err := s.GetReplica().Select(&archivedPosts, queryString, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to search ArchivedPosts")
+	}
+
+	return archivedPostsToPosts(archivedPosts)
+}
+
+func archivedPostsToPosts(archivedPosts []*model.ArchivedPost) ([]*model.Post, error) {
+	posts := make([]*model.Post, 0, len(archivedPosts))
+	for _, archived := range archivedPosts {
+		post, err := archived.ToPost()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decompress ArchivedPost with id=%s", archived.Id)
+		}
+		posts = append(posts, post)
+	}
+
+	return posts, nil
+}
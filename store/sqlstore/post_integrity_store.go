@@ -0,0 +1,121 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+// postIntegrityAppendAttempts is the number of times appending an entry is attempted when
+// concurrent posts to the same channel race for the next sequence number.
+const postIntegrityAppendAttempts = 5
+
+type SqlPostIntegrityStore struct {
+	SqlStore
+}
+
+func newSqlPostIntegrityStore(sqlStore SqlStore) store.PostIntegrityStore {
+	s := &SqlPostIntegrityStore{sqlStore}
+
+	for _, db := range sqlStore.GetAllConns() {
+		table := db.AddTableWithName(model.PostIntegrityEntry{}, "PostIntegrity").SetKeys(false, "ChannelId", "Seq")
+		table.ColMap("ChannelId").SetMaxSize(26)
+		table.ColMap("PostId").SetMaxSize(26)
+		table.ColMap("ContentHash").SetMaxSize(64)
+		table.ColMap("Hash").SetMaxSize(64)
+	}
+
+	return s
+}
+
+func (s SqlPostIntegrityStore) createIndexesIfNotExists() {
+	s.CreateIndexIfNotExists("idx_postintegrity_post_id", "PostIntegrity", "PostId")
+}
+
+// Append chains a new entry for the current content of the post to the last entry of its
+// channel.
+func (s SqlPostIntegrityStore) Append(post *model.Post) (*model.PostIntegrityEntry, error) {
+	var err error
+	for i := 0; i < postIntegrityAppendAttempts; i++ {
+		var previous *model.PostIntegrityEntry
+		previous, err = s.getLast(post.ChannelId)
+		if err != nil {
+			return nil, err
+		}
+
+		entry := model.NewPostIntegrityEntry(previous, post)
+		if err = s.GetMaster().Insert(entry); err == nil {
+			return entry, nil
+		}
+
+		// Another entry took the sequence number in the meantime, so chain to it instead.
+		if !IsUniqueConstraintError(err, []string{"PRIMARY", "postintegrity_pkey"}) {
+			return nil, errors.Wrapf(err, "failed to save PostIntegrityEntry with channel_id=%s", post.ChannelId)
+		}
+	}
+
+	return nil, errors.Wrapf(err, "giving up saving PostIntegrityEntry with channel_id=%s after %d attempts", post.ChannelId, postIntegrityAppendAttempts)
+}
+
+func (s SqlPostIntegrityStore) getLast(channelId string) (*model.PostIntegrityEntry, error) {
+	var entry model.PostIntegrityEntry
+	if err := s.GetMaster().SelectOne(&entry, "SELECT * FROM PostIntegrity WHERE ChannelId = :ChannelId ORDER BY Seq DESC LIMIT 1", map[string]interface{}{"ChannelId": channelId}); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get last PostIntegrityEntry with channel_id=%s", channelId)
+	}
+
+	return &entry, nil
+}
+
+// GetForChannel returns up to limit entries of the channel following afterSeq, in chain order.
+func (s SqlPostIntegrityStore) GetForChannel(channelId string, afterSeq int64, limit int) ([]*model.PostIntegrityEntry, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("*").
+		From("PostIntegrity").
+		Where(sq.Eq{"ChannelId": channelId}).
+		Where(sq.Gt{"Seq": afterSeq}).
+		OrderBy("Seq ASC").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "post_integrity_tosql")
+	}
+
+	entries := []*model.PostIntegrityEntry{}
+	if _, err := s.GetReplica().Select(&entries, queryString, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to find PostIntegrityEntries with channel_id=%s", channelId)
+	}
+
+	return entries, nil
+}
+
+// GetChannelIds returns up to limit ids, in order, of the channels with a hash chain following
+// afterChannelId.
+func (s SqlPostIntegrityStore) GetChannelIds(afterChannelId string, limit int) ([]string, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("DISTINCT ChannelId").
+		From("PostIntegrity").
+		Where(sq.Gt{"ChannelId": afterChannelId}).
+		OrderBy("ChannelId ASC").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "post_integrity_tosql")
+	}
+
+	channelIds := []string{}
+	if _, err := s.GetReplica().Select(&channelIds, queryString, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to find channel ids of PostIntegrityEntries")
+	}
+
+	return channelIds, nil
+}
@@ -13,6 +13,9 @@ import (
 	"sync"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/mattermost/gorp"
+	"github.com/pkg/errors"
+
 	"github.com/mattermost/mattermost-server/v5/einterfaces"
 	"github.com/mattermost/mattermost-server/v5/mlog"
 	"github.com/mattermost/mattermost-server/v5/model"
@@ -25,6 +28,13 @@ type SqlPostStore struct {
 	metrics           einterfaces.MetricsInterface
 	maxPostSizeOnce   sync.Once
 	maxPostSizeCached int
+	ngramSearch       bool
+}
+
+// postNgram indexes a post by one of the ngrams of the CJK text of its message.
+type postNgram struct {
+	PostId string
+	Ngram  string
 }
 
 func (s *SqlPostStore) ClearCaches() {
@@ -57,15 +67,21 @@ func postToSlice(post *model.Post) []interface{} {
 	}
 }
 
-func newSqlPostStore(sqlStore SqlStore, metrics einterfaces.MetricsInterface) store.PostStore {
+func newSqlPostStore(sqlStore SqlStore, metrics einterfaces.MetricsInterface, ngramSearch bool) store.PostStore {
 	s := &SqlPostStore{
 		SqlStore:          sqlStore,
 		metrics:           metrics,
 		maxPostSizeCached: model.POST_MESSAGE_MAX_RUNES_V1,
+		ngramSearch:       ngramSearch,
+	}
+
+	keys := []string{"Id"}
+	if isPostsTablePartitioned(sqlStore) {
+		keys = append(keys, "CreateAt")
 	}
 
 	for _, db := range sqlStore.GetAllConns() {
-		table := db.AddTableWithName(model.Post{}, "Posts").SetKeys(false, "Id")
+		table := db.AddTableWithName(model.Post{}, "Posts").SetKeys(false, keys...)
 		table.ColMap("Id").SetMaxSize(26)
 		table.ColMap("UserId").SetMaxSize(26)
 		table.ColMap("ChannelId").SetMaxSize(26)
@@ -78,6 +94,10 @@ func newSqlPostStore(sqlStore SqlStore, metrics einterfaces.MetricsInterface) st
 		table.ColMap("Props").SetMaxSize(8000)
 		table.ColMap("Filenames").SetMaxSize(model.POST_FILENAMES_MAX_RUNES)
 		table.ColMap("FileIds").SetMaxSize(150)
+
+		tableNgrams := db.AddTableWithName(postNgram{}, "PostNgrams").SetKeys(false, "PostId", "Ngram")
+		tableNgrams.ColMap("PostId").SetMaxSize(26)
+		tableNgrams.ColMap("Ngram").SetMaxSize(8)
 	}
 
 	return s
@@ -94,24 +114,73 @@ func (s *SqlPostStore) createIndexesIfNotExists() {
 
 	s.CreateCompositeIndexIfNotExists("idx_posts_channel_id_update_at", "Posts", []string{"ChannelId", "UpdateAt"})
 	s.CreateCompositeIndexIfNotExists("idx_posts_channel_id_delete_at_create_at", "Posts", []string{"ChannelId", "DeleteAt", "CreateAt"})
+	// Covers the columns read by GetPostsDelta, so that the changes of a channel are listed from the index alone.
+	s.CreateCompositeIndexIfNotExists("idx_posts_channel_id_update_at_delta", "Posts", []string{"ChannelId", "UpdateAt", "Id", "CreateAt", "EditAt", "DeleteAt"})
 
 	s.CreateFullTextIndexIfNotExists("idx_posts_message_txt", "Posts", "Message")
 	s.CreateFullTextIndexIfNotExists("idx_posts_hashtags_txt", "Posts", "Hashtags")
+
+	s.CreateIndexIfNotExists("idx_postngrams_ngram", "PostNgrams", "Ngram")
+}
+
+// savePostNgramsT indexes the posts by the ngrams of the CJK text of their messages as part of the
+// given transaction, replacing the ngrams they were indexed by before.
+func (s *SqlPostStore) savePostNgramsT(transaction *gorp.Transaction, posts []*model.Post) error {
+	ids := make([]string, 0, len(posts))
+	query := s.getQueryBuilder().Insert("PostNgrams").Columns("PostId", "Ngram")
+	count := 0
+	for _, post := range posts {
+		ids = append(ids, post.Id)
+		for _, ngram := range model.GetCJKNgrams(post.Message) {
+			query = query.Values(post.Id, ngram)
+			count++
+		}
+	}
+
+	queryString, args, err := s.getQueryBuilder().Delete("PostNgrams").Where(sq.Eq{"PostId": ids}).ToSql()
+	if err != nil {
+		return errors.Wrap(err, "post_ngrams_delete_tosql")
+	}
+	if _, err = transaction.Exec(queryString, args...); err != nil {
+		return errors.Wrap(err, "failed to delete PostNgrams")
+	}
+
+	if count == 0 {
+		return nil
+	}
+
+	queryString, args, err = query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "post_ngrams_insert_tosql")
+	}
+	if _, err = transaction.Exec(queryString, args...); err != nil {
+		return errors.Wrap(err, "failed to save PostNgrams")
+	}
+
+	return nil
 }
 
 func (s *SqlPostStore) SaveMultiple(posts []*model.Post) ([]*model.Post, int, *model.AppError) {
+	posts, _, idx, err := s.saveMultiple(posts, nil)
+	return posts, idx, err
+}
+
+// saveMultiple saves the posts along with their changes in the change log of their channels and,
+// when given, records the websocket event in the outbox within the same transaction.
+func (s *SqlPostStore) saveMultiple(posts []*model.Post, message *model.WebSocketEvent) ([]*model.Post, *model.OutboxEvent, int, *model.AppError) {
 	channelNewPosts := make(map[string]int)
+	channelNewRootPosts := make(map[string]int)
 	maxDateNewPosts := make(map[string]int64)
 	rootIds := make(map[string]int)
 	maxDateRootIds := make(map[string]int64)
 	for idx, post := range posts {
 		if len(post.Id) > 0 {
-			return nil, idx, model.NewAppError("SqlPostStore.Save", "store.sql_post.save.existing.app_error", nil, "id="+post.Id, http.StatusBadRequest)
+			return nil, nil, idx, model.NewAppError("SqlPostStore.Save", "store.sql_post.save.existing.app_error", nil, "id="+post.Id, http.StatusBadRequest)
 		}
 		post.PreSave()
 		maxPostSize := s.GetMaxPostSize()
 		if err := post.IsValid(maxPostSize); err != nil {
-			return nil, idx, err
+			return nil, nil, idx, err
 		}
 
 		currentChannelCount, ok := channelNewPosts[post.ChannelId]
@@ -131,6 +200,10 @@ func (s *SqlPostStore) SaveMultiple(posts []*model.Post) ([]*model.Post, int, *m
 			}
 		}
 
+		if len(post.RootId) == 0 && !post.IsJoinLeaveMessage() {
+			channelNewRootPosts[post.ChannelId]++
+		}
+
 		if len(post.RootId) == 0 {
 			continue
 		}
@@ -153,15 +226,43 @@ func (s *SqlPostStore) SaveMultiple(posts []*model.Post) ([]*model.Post, int, *m
 	}
 	sql, args, err := query.ToSql()
 	if err != nil {
-		return nil, -1, model.NewAppError("SqlPostStore.Save", "store.sql_post.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		return nil, nil, -1, model.NewAppError("SqlPostStore.Save", "store.sql_post.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	transaction, err := s.GetMaster().Begin()
+	if err != nil {
+		return nil, nil, -1, model.NewAppError("SqlPostStore.Save", "store.sql_post.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	defer finalizeTransaction(transaction)
+
+	if _, err := transaction.Exec(sql, args...); err != nil {
+		return nil, nil, -1, model.NewAppError("SqlPostStore.Save", "store.sql_post.save.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
 
-	if _, err := s.GetMaster().Exec(sql, args...); err != nil {
-		return nil, -1, model.NewAppError("SqlPostStore.Save", "store.sql_post.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+	if err := savePostChangesT(s.SqlStore, transaction, posts, model.CHANNEL_CHANGE_POST_CREATED); err != nil {
+		return nil, nil, -1, model.NewAppError("SqlPostStore.Save", "store.sql_post.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	if s.ngramSearch {
+		if err := s.savePostNgramsT(transaction, posts); err != nil {
+			return nil, nil, -1, model.NewAppError("SqlPostStore.Save", "store.sql_post.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	var outboxEvent *model.OutboxEvent
+	if message != nil {
+		message.Add("post", posts[0].ToJson())
+		if outboxEvent, err = saveOutboxEventT(transaction, message); err != nil {
+			return nil, nil, -1, model.NewAppError("SqlPostStore.Save", "store.sql_post.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return nil, nil, -1, model.NewAppError("SqlPostStore.Save", "store.sql_post.save.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
 
 	for channelId, count := range channelNewPosts {
-		if _, err := s.GetMaster().Exec("UPDATE Channels SET LastPostAt = GREATEST(:LastPostAt, LastPostAt), TotalMsgCount = TotalMsgCount + :Count WHERE Id = :ChannelId", map[string]interface{}{"LastPostAt": maxDateNewPosts[channelId], "ChannelId": channelId, "Count": count}); err != nil {
+		if _, err := s.GetMaster().Exec("UPDATE Channels SET LastPostAt = GREATEST(:LastPostAt, LastPostAt), TotalMsgCount = TotalMsgCount + :Count, TotalMsgCountRoot = TotalMsgCountRoot + :CountRoot WHERE Id = :ChannelId", map[string]interface{}{"LastPostAt": maxDateNewPosts[channelId], "ChannelId": channelId, "Count": count, "CountRoot": channelNewRootPosts[channelId]}); err != nil {
 			mlog.Error("Error updating Channel LastPostAt.", mlog.Err(err))
 		}
 	}
@@ -190,7 +291,7 @@ func (s *SqlPostStore) SaveMultiple(posts []*model.Post) ([]*model.Post, int, *m
 		}
 	}
 
-	return posts, -1, nil
+	return posts, outboxEvent, -1, nil
 }
 
 func (s *SqlPostStore) Save(post *model.Post) (*model.Post, *model.AppError) {
@@ -201,6 +302,14 @@ func (s *SqlPostStore) Save(post *model.Post) (*model.Post, *model.AppError) {
 	return posts[0], nil
 }
 
+func (s *SqlPostStore) SaveWithOutboxEvent(post *model.Post, message *model.WebSocketEvent) (*model.Post, *model.OutboxEvent, *model.AppError) {
+	posts, outboxEvent, _, err := s.saveMultiple([]*model.Post{post}, message)
+	if err != nil {
+		return nil, nil, err
+	}
+	return posts[0], outboxEvent, nil
+}
+
 func (s *SqlPostStore) populateReplyCount(posts []*model.Post) *model.AppError {
 	rootIds := []string{}
 	for _, post := range posts {
@@ -238,6 +347,22 @@ func (s *SqlPostStore) populateReplyCount(posts []*model.Post) *model.AppError {
 }
 
 func (s *SqlPostStore) Update(newPost *model.Post, oldPost *model.Post) (*model.Post, *model.AppError) {
+	post, _, err := s.update(newPost, oldPost, nil)
+	return post, err
+}
+
+func (s *SqlPostStore) UpdateWithOutboxEvent(newPost *model.Post, oldPost *model.Post, message *model.WebSocketEvent) (*model.Post, *model.OutboxEvent, *model.AppError) {
+	return s.update(newPost, oldPost, message)
+}
+
+// update updates the post along with its change in the change log of its channel and, when given,
+// records the websocket event in the outbox within the same transaction.
+func (s *SqlPostStore) update(newPost *model.Post, oldPost *model.Post, message *model.WebSocketEvent) (*model.Post, *model.OutboxEvent, *model.AppError) {
+	changeType := model.CHANNEL_CHANGE_POST_UPDATED
+	if newPost.EditAt != oldPost.EditAt {
+		changeType = model.CHANNEL_CHANGE_POST_EDITED
+	}
+
 	newPost.UpdateAt = model.GetMillis()
 	newPost.PreCommit()
 
@@ -250,11 +375,43 @@ func (s *SqlPostStore) Update(newPost *model.Post, oldPost *model.Post) (*model.
 	maxPostSize := s.GetMaxPostSize()
 
 	if err := newPost.IsValid(maxPostSize); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	if _, err := s.GetMaster().Update(newPost); err != nil {
-		return nil, model.NewAppError("SqlPostStore.Update", "store.sql_post.update.app_error", nil, "id="+newPost.Id+", "+err.Error(), http.StatusInternalServerError)
+	appErr := func(errMsg string) *model.AppError {
+		return model.NewAppError("SqlPostStore.Update", "store.sql_post.update.app_error", nil, "id="+newPost.Id+", "+errMsg, http.StatusInternalServerError)
+	}
+
+	transaction, err := s.GetMaster().Begin()
+	if err != nil {
+		return nil, nil, appErr(err.Error())
+	}
+	defer finalizeTransaction(transaction)
+
+	if _, err = transaction.Update(newPost); err != nil {
+		return nil, nil, appErr(err.Error())
+	}
+
+	if err = savePostChangesT(s.SqlStore, transaction, []*model.Post{newPost}, changeType); err != nil {
+		return nil, nil, appErr(err.Error())
+	}
+
+	if s.ngramSearch {
+		if err = s.savePostNgramsT(transaction, []*model.Post{newPost}); err != nil {
+			return nil, nil, appErr(err.Error())
+		}
+	}
+
+	var outboxEvent *model.OutboxEvent
+	if message != nil {
+		message.Add("post", newPost.ToJson())
+		if outboxEvent, err = saveOutboxEventT(transaction, message); err != nil {
+			return nil, nil, appErr(err.Error())
+		}
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return nil, nil, appErr(err.Error())
 	}
 
 	time := model.GetMillis()
@@ -267,7 +424,7 @@ func (s *SqlPostStore) Update(newPost *model.Post, oldPost *model.Post) (*model.
 	// mark the old post as deleted
 	s.GetMaster().Insert(oldPost)
 
-	return newPost, nil
+	return newPost, outboxEvent, nil
 }
 
 func (s *SqlPostStore) OverwriteMultiple(posts []*model.Post) ([]*model.Post, int, *model.AppError) {
@@ -294,6 +451,22 @@ func (s *SqlPostStore) OverwriteMultiple(posts []*model.Post) ([]*model.Post, in
 			return nil, idx, model.NewAppError("SqlPostStore.Overwrite", "store.sql_post.overwrite.app_error", nil, "id="+post.Id+", "+err.Error(), http.StatusInternalServerError)
 		}
 	}
+	if err = savePostChangesT(s.SqlStore, tx, posts, model.CHANNEL_CHANGE_POST_UPDATED); err != nil {
+		if txErr := tx.Rollback(); txErr != nil {
+			return nil, -1, model.NewAppError("SqlPostStore.Overwrite", "store.sql_post.overwrite.app_error", nil, txErr.Error(), http.StatusInternalServerError)
+		}
+
+		return nil, -1, model.NewAppError("SqlPostStore.Overwrite", "store.sql_post.overwrite.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	if s.ngramSearch {
+		if err = s.savePostNgramsT(tx, posts); err != nil {
+			if txErr := tx.Rollback(); txErr != nil {
+				return nil, -1, model.NewAppError("SqlPostStore.Overwrite", "store.sql_post.overwrite.app_error", nil, txErr.Error(), http.StatusInternalServerError)
+			}
+
+			return nil, -1, model.NewAppError("SqlPostStore.Overwrite", "store.sql_post.overwrite.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
 	err = tx.Commit()
 	if err != nil {
 		return nil, -1, model.NewAppError("SqlPostStore.Overwrite", "store.sql_post.overwrite.app_error", nil, err.Error(), http.StatusInternalServerError)
@@ -467,7 +640,17 @@ func (s *SqlPostStore) GetEtag(channelId string, allowFromCache bool) string {
 }
 
 func (s *SqlPostStore) Delete(postId string, time int64, deleteByID string) *model.AppError {
+	_, err := s.delete(postId, time, deleteByID, nil)
+	return err
+}
 
+func (s *SqlPostStore) DeleteWithOutboxEvent(postId string, time int64, deleteByID string, message *model.WebSocketEvent) (*model.OutboxEvent, *model.AppError) {
+	return s.delete(postId, time, deleteByID, message)
+}
+
+// delete deletes the post and its replies along with their changes in the change log of the channel
+// and, when given, records the websocket event in the outbox within the same transaction.
+func (s *SqlPostStore) delete(postId string, time int64, deleteByID string, message *model.WebSocketEvent) (*model.OutboxEvent, *model.AppError) {
 	appErr := func(errMsg string) *model.AppError {
 		return model.NewAppError("SqlPostStore.Delete", "store.sql_post.delete.app_error", nil, "id="+postId+", err="+errMsg, http.StatusInternalServerError)
 	}
@@ -475,21 +658,63 @@ func (s *SqlPostStore) Delete(postId string, time int64, deleteByID string) *mod
 	var post model.Post
 	err := s.GetReplica().SelectOne(&post, "SELECT * FROM Posts WHERE Id = :Id AND DeleteAt = 0", map[string]interface{}{"Id": postId})
 	if err != nil {
-		return appErr(err.Error())
+		return nil, appErr(err.Error())
 	}
 
 	post.AddProp(model.POST_PROPS_DELETE_BY, deleteByID)
 
-	_, err = s.GetMaster().Exec("UPDATE Posts SET DeleteAt = :DeleteAt, UpdateAt = :UpdateAt, Props = :Props WHERE Id = :Id OR RootId = :RootId", map[string]interface{}{"DeleteAt": time, "UpdateAt": time, "Id": postId, "RootId": postId, "Props": model.StringInterfaceToJson(post.GetProps())})
+	query := "UPDATE Posts SET DeleteAt = :DeleteAt, UpdateAt = :UpdateAt, Props = :Props WHERE Id = :Id OR RootId = :RootId"
+	params := map[string]interface{}{"DeleteAt": time, "UpdateAt": time, "Id": postId, "RootId": postId, "Props": model.StringInterfaceToJson(post.GetProps())}
+
+	transaction, err := s.GetMaster().Begin()
 	if err != nil {
-		return appErr(err.Error())
+		return nil, appErr(err.Error())
 	}
+	defer finalizeTransaction(transaction)
 
-	return nil
+	var replyIds []string
+	if _, err = transaction.Select(&replyIds, "SELECT Id FROM Posts WHERE RootId = :RootId AND DeleteAt = 0", map[string]interface{}{"RootId": postId}); err != nil {
+		return nil, appErr(err.Error())
+	}
+
+	if _, err = transaction.Exec(query, params); err != nil {
+		return nil, appErr(err.Error())
+	}
+
+	changes := []*model.ChannelChange{{ChannelId: post.ChannelId, PostId: post.Id, Type: model.CHANNEL_CHANGE_POST_DELETED}}
+	for _, replyId := range replyIds {
+		changes = append(changes, &model.ChannelChange{ChannelId: post.ChannelId, PostId: replyId, Type: model.CHANNEL_CHANGE_POST_DELETED})
+	}
+	if err = saveChannelChangesT(s.SqlStore, transaction, changes); err != nil {
+		return nil, appErr(err.Error())
+	}
+
+	var outboxEvent *model.OutboxEvent
+	if message != nil {
+		post.DeleteAt = time
+		post.UpdateAt = time
+		post.ChangeSeq = changes[0].Seq
+		message.Add("post", post.ToJson())
+
+		if outboxEvent, err = saveOutboxEventT(transaction, message); err != nil {
+			return nil, appErr(err.Error())
+		}
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return nil, appErr(err.Error())
+	}
+
+	return outboxEvent, nil
 }
 
 func (s *SqlPostStore) permanentDelete(postId string) *model.AppError {
-	_, err := s.GetMaster().Exec("DELETE FROM Posts WHERE Id = :Id OR RootId = :RootId", map[string]interface{}{"Id": postId, "RootId": postId})
+	_, err := s.GetMaster().Exec("DELETE FROM PostNgrams WHERE PostId IN (SELECT Id FROM Posts WHERE Id = :Id OR RootId = :RootId)", map[string]interface{}{"Id": postId, "RootId": postId})
+	if err != nil {
+		return model.NewAppError("SqlPostStore.Delete", "store.sql_post.permanent_delete.app_error", nil, "id="+postId+", err="+err.Error(), http.StatusInternalServerError)
+	}
+
+	_, err = s.GetMaster().Exec("DELETE FROM Posts WHERE Id = :Id OR RootId = :RootId", map[string]interface{}{"Id": postId, "RootId": postId})
 	if err != nil {
 		return model.NewAppError("SqlPostStore.Delete", "store.sql_post.permanent_delete.app_error", nil, "id="+postId+", err="+err.Error(), http.StatusInternalServerError)
 	}
@@ -497,7 +722,12 @@ func (s *SqlPostStore) permanentDelete(postId string) *model.AppError {
 }
 
 func (s *SqlPostStore) permanentDeleteAllCommentByUser(userId string) *model.AppError {
-	_, err := s.GetMaster().Exec("DELETE FROM Posts WHERE UserId = :UserId AND RootId != ''", map[string]interface{}{"UserId": userId})
+	_, err := s.GetMaster().Exec("DELETE FROM PostNgrams WHERE PostId IN (SELECT Id FROM Posts WHERE UserId = :UserId AND RootId != '')", map[string]interface{}{"UserId": userId})
+	if err != nil {
+		return model.NewAppError("SqlPostStore.permanentDeleteAllCommentByUser", "store.sql_post.permanent_delete_all_comments_by_user.app_error", nil, "userId="+userId+", err="+err.Error(), http.StatusInternalServerError)
+	}
+
+	_, err = s.GetMaster().Exec("DELETE FROM Posts WHERE UserId = :UserId AND RootId != ''", map[string]interface{}{"UserId": userId})
 	if err != nil {
 		return model.NewAppError("SqlPostStore.permanentDeleteAllCommentByUser", "store.sql_post.permanent_delete_all_comments_by_user.app_error", nil, "userId="+userId+", err="+err.Error(), http.StatusInternalServerError)
 	}
@@ -541,6 +771,10 @@ func (s *SqlPostStore) PermanentDeleteByUser(userId string) *model.AppError {
 }
 
 func (s *SqlPostStore) PermanentDeleteByChannel(channelId string) *model.AppError {
+	if _, err := s.GetMaster().Exec("DELETE FROM PostNgrams WHERE PostId IN (SELECT Id FROM Posts WHERE ChannelId = :ChannelId)", map[string]interface{}{"ChannelId": channelId}); err != nil {
+		return model.NewAppError("SqlPostStore.PermanentDeleteByChannel", "store.sql_post.permanent_delete_by_channel.app_error", nil, "channel_id="+channelId+", "+err.Error(), http.StatusInternalServerError)
+	}
+
 	if _, err := s.GetMaster().Exec("DELETE FROM Posts WHERE ChannelId = :ChannelId", map[string]interface{}{"ChannelId": channelId}); err != nil {
 		return model.NewAppError("SqlPostStore.PermanentDeleteByChannel", "store.sql_post.permanent_delete_by_channel.app_error", nil, "channel_id="+channelId+", "+err.Error(), http.StatusInternalServerError)
 	}
@@ -682,6 +916,107 @@ func (s *SqlPostStore) GetPostsSince(options model.GetPostsSinceOptions, allowFr
 	return list, nil
 }
 
+type postDeltaRow struct {
+	Id       string
+	CreateAt int64
+	UpdateAt int64
+	EditAt   int64
+	DeleteAt int64
+}
+
+func (s *SqlPostStore) GetPostsDelta(cursor model.PostsDeltaCursor, limit int) (*model.PostsDelta, model.PostsDeltaCursor, *model.AppError) {
+	// The changes are listed from the covering index first, so that only new and edited posts are read
+	// from the table itself.
+	query, args, err := s.getQueryBuilder().
+		Select("Id, CreateAt, UpdateAt, EditAt, DeleteAt").
+		From("Posts").
+		Where(sq.Eq{"ChannelId": cursor.ChannelId}).
+		Where(sq.Or{
+			sq.Gt{"UpdateAt": cursor.UpdateAt},
+			sq.And{sq.Eq{"UpdateAt": cursor.UpdateAt}, sq.Gt{"Id": cursor.Id}},
+		}).
+		OrderBy("UpdateAt", "Id").
+		Limit(uint64(limit + 1)).
+		ToSql()
+	if err != nil {
+		return nil, cursor, model.NewAppError("SqlPostStore.GetPostsDelta", "store.sql_post.get_posts_delta.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	var rows []*postDeltaRow
+	if _, err = s.GetReplica().Select(&rows, query, args...); err != nil {
+		return nil, cursor, model.NewAppError("SqlPostStore.GetPostsDelta", "store.sql_post.get_posts_delta.app_error", nil, "channelId="+cursor.ChannelId+", "+err.Error(), http.StatusInternalServerError)
+	}
+
+	delta := model.NewPostsDelta()
+	if len(rows) > limit {
+		rows = rows[:limit]
+		delta.HasMore = true
+	}
+
+	var fetchIds []string
+	var minCreateAt, maxCreateAt int64
+	for _, row := range rows {
+		switch {
+		case row.DeleteAt > 0:
+			delta.Deleted = append(delta.Deleted, row.Id)
+			continue
+		case row.CreateAt > cursor.Since || row.EditAt > cursor.Since:
+			fetchIds = append(fetchIds, row.Id)
+		default:
+			delta.Updated = append(delta.Updated, &model.PostsDeltaUpdate{Id: row.Id, UpdateAt: row.UpdateAt})
+			continue
+		}
+
+		if minCreateAt == 0 || row.CreateAt < minCreateAt {
+			minCreateAt = row.CreateAt
+		}
+		if row.CreateAt > maxCreateAt {
+			maxCreateAt = row.CreateAt
+		}
+	}
+
+	if len(fetchIds) > 0 {
+		// The CreateAt bounds let a partitioned Posts table skip the partitions that can't hold these posts.
+		query, args, err = s.getQueryBuilder().
+			Select("*").
+			From("Posts").
+			Where(sq.Eq{"Id": fetchIds}).
+			Where(sq.GtOrEq{"CreateAt": minCreateAt}).
+			Where(sq.LtOrEq{"CreateAt": maxCreateAt}).
+			OrderBy("CreateAt", "Id").
+			ToSql()
+		if err != nil {
+			return nil, cursor, model.NewAppError("SqlPostStore.GetPostsDelta", "store.sql_post.get_posts_delta.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+
+		var posts []*model.Post
+		if _, err = s.GetReplica().Select(&posts, query, args...); err != nil {
+			return nil, cursor, model.NewAppError("SqlPostStore.GetPostsDelta", "store.sql_post.get_posts_delta.app_error", nil, "channelId="+cursor.ChannelId+", "+err.Error(), http.StatusInternalServerError)
+		}
+
+		for _, post := range posts {
+			if post.CreateAt > cursor.Since {
+				delta.New = append(delta.New, post)
+			} else {
+				delta.Edited = append(delta.Edited, post)
+			}
+		}
+	}
+
+	next := cursor
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		next.UpdateAt = last.UpdateAt
+		next.Id = last.Id
+	}
+	if !delta.HasMore {
+		// The next sync classifies posts relative to the end of this one.
+		next.Since = next.UpdateAt
+	}
+
+	return delta, next, nil
+}
+
 func (s *SqlPostStore) GetPostsBefore(options model.GetPostsOptions) (*model.PostList, *model.AppError) {
 	return s.getPostsAround(true, options)
 }
@@ -1211,7 +1546,19 @@ func (s *SqlPostStore) search(teamId string, userId string, params *model.Search
 		excludedTerms = strings.Replace(excludedTerms, c, " ", -1)
 	}
 
-	if terms == "" && excludedTerms == "" {
+	// CJK text has no spaces between words and so isn't handled by the full text indexes, the
+	// terms written in it are matched against the ngrams the posts were indexed by instead.
+	var cjkTerms, cjkExcludedTerms []string
+	if s.ngramSearch && !params.IsHashtag {
+		terms, cjkTerms = splitCJKSearchTerms(terms)
+		excludedTerms, cjkExcludedTerms = splitCJKSearchTerms(excludedTerms)
+	}
+
+	if len(cjkTerms) > 0 || len(cjkExcludedTerms) > 0 {
+		searchClause, searchParams := s.buildSearchNgramClause(terms, excludedTerms, cjkTerms, cjkExcludedTerms, params.OrTerms, queryParams)
+		queryParams = searchParams
+		searchQuery = strings.Replace(searchQuery, "SEARCH_CLAUSE", searchClause, 1)
+	} else if terms == "" && excludedTerms == "" {
 		// we've already confirmed that we have a channel or user to search for
 		searchQuery = strings.Replace(searchQuery, "SEARCH_CLAUSE", "", 1)
 	} else if s.DriverName() == model.DATABASE_DRIVER_POSTGRES {
@@ -1280,6 +1627,98 @@ func (s *SqlPostStore) search(teamId string, userId string, params *model.Search
 	return list, nil
 }
 
+// splitCJKSearchTerms splits the terms containing CJK text from the others.
+func splitCJKSearchTerms(terms string) (string, []string) {
+	otherTerms := []string{}
+	cjkTerms := []string{}
+	for _, term := range strings.Fields(terms) {
+		if model.ContainsCJK(term) {
+			cjkTerms = append(cjkTerms, term)
+		} else {
+			otherTerms = append(otherTerms, term)
+		}
+	}
+
+	return strings.Join(otherTerms, " "), cjkTerms
+}
+
+// buildSearchNgramClause builds the search clause for terms including CJK ones, which are matched
+// against the PostNgrams table while the other terms are matched against the full text index.
+func (s *SqlPostStore) buildSearchNgramClause(terms string, excludedTerms string, cjkTerms []string, cjkExcludedTerms []string, orTerms bool, queryParams map[string]interface{}) (string, map[string]interface{}) {
+	ngramIdx := 0
+	ngramCondition := func(term string) string {
+		conditions := []string{}
+		for _, ngram := range model.GetCJKSearchNgrams(term) {
+			paramName := "Ngram" + strconv.Itoa(ngramIdx)
+			queryParams[paramName] = ngram
+			conditions = append(conditions, "Id IN (SELECT PostId FROM PostNgrams WHERE Ngram = :"+paramName+")")
+			ngramIdx++
+		}
+		return "(" + strings.Join(conditions, " AND ") + ")"
+	}
+
+	searchType := "Message"
+	fullTextCondition := func(paramName string) string {
+		if s.DriverName() == model.DATABASE_DRIVER_POSTGRES {
+			return fmt.Sprintf("to_tsvector('english', %s) @@  to_tsquery('english', :%s)", searchType, paramName)
+		}
+		return fmt.Sprintf("MATCH (%s) AGAINST (:%s IN BOOLEAN MODE)", searchType, paramName)
+	}
+
+	if s.DriverName() == model.DATABASE_DRIVER_POSTGRES {
+		if wildcard, err := regexp.Compile(`\*($| )`); err == nil {
+			terms = wildcard.ReplaceAllLiteralString(terms, ":* ")
+			excludedTerms = wildcard.ReplaceAllLiteralString(excludedTerms, ":* ")
+		}
+	}
+
+	includeConditions := []string{}
+	if terms != "" {
+		if s.DriverName() == model.DATABASE_DRIVER_POSTGRES {
+			if orTerms {
+				queryParams["Terms"] = strings.Join(strings.Fields(terms), " | ")
+			} else {
+				queryParams["Terms"] = strings.Join(strings.Fields(terms), " & ")
+			}
+		} else if orTerms {
+			queryParams["Terms"] = terms
+		} else {
+			splitTerms := []string{}
+			for _, t := range strings.Fields(terms) {
+				splitTerms = append(splitTerms, "+"+t)
+			}
+			queryParams["Terms"] = strings.Join(splitTerms, " ")
+		}
+		includeConditions = append(includeConditions, fullTextCondition("Terms"))
+	}
+	for _, term := range cjkTerms {
+		includeConditions = append(includeConditions, ngramCondition(term))
+	}
+
+	searchClause := ""
+	if len(includeConditions) > 0 {
+		if orTerms {
+			searchClause = "AND (" + strings.Join(includeConditions, " OR ") + ")"
+		} else {
+			searchClause = "AND " + strings.Join(includeConditions, " AND ")
+		}
+	}
+
+	if excludedTerms != "" {
+		if s.DriverName() == model.DATABASE_DRIVER_POSTGRES {
+			queryParams["ExcludedTerms"] = strings.Join(strings.Fields(excludedTerms), " | ")
+		} else {
+			queryParams["ExcludedTerms"] = excludedTerms
+		}
+		searchClause += " AND NOT " + fullTextCondition("ExcludedTerms")
+	}
+	for _, term := range cjkExcludedTerms {
+		searchClause += " AND NOT " + ngramCondition(term)
+	}
+
+	return searchClause, queryParams
+}
+
 func (s *SqlPostStore) AnalyticsUserCountsWithPostsByDay(teamId string) (model.AnalyticsRows, *model.AppError) {
 	query :=
 		`SELECT DISTINCT
@@ -1394,7 +1833,26 @@ func (s *SqlPostStore) AnalyticsPostCountsByDay(options *model.AnalyticsPostCoun
 	return rows, nil
 }
 
-func (s *SqlPostStore) AnalyticsPostCount(teamId string, mustHaveFile bool, mustHaveHashtag bool) (int64, *model.AppError) {
+func (s *SqlPostStore) AnalyticsPostCount(teamId string, mustHaveFile bool, mustHaveHashtag bool, estimate bool) (int64, *model.AppError) {
+	if estimate && teamId == "" && !mustHaveFile && !mustHaveHashtag {
+		v, err := estimateRowCountOrExact(s, "Posts", func() (int64, error) {
+			return s.analyticsPostCount(teamId, mustHaveFile, mustHaveHashtag)
+		})
+		if err != nil {
+			return 0, model.NewAppError("SqlPostStore.AnalyticsPostCount", "store.sql_post.analytics_posts_count.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		return v, nil
+	}
+
+	v, err := s.analyticsPostCount(teamId, mustHaveFile, mustHaveHashtag)
+	if err != nil {
+		return 0, model.NewAppError("SqlPostStore.AnalyticsPostCount", "store.sql_post.analytics_posts_count.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return v, nil
+}
+
+func (s *SqlPostStore) analyticsPostCount(teamId string, mustHaveFile bool, mustHaveHashtag bool) (int64, error) {
 	query :=
 		`SELECT
 			COUNT(Posts.Id) AS Value
@@ -1416,12 +1874,7 @@ func (s *SqlPostStore) AnalyticsPostCount(teamId string, mustHaveFile bool, must
 		query += " AND Posts.Hashtags != ''"
 	}
 
-	v, err := s.GetReplica().SelectInt(query, map[string]interface{}{"TeamId": teamId})
-	if err != nil {
-		return 0, model.NewAppError("SqlPostStore.AnalyticsPostCount", "store.sql_post.analytics_posts_count.app_error", nil, err.Error(), http.StatusInternalServerError)
-	}
-
-	return v, nil
+	return s.GetReplica().SelectInt(query, map[string]interface{}{"TeamId": teamId})
 }
 
 func (s *SqlPostStore) GetPostsCreatedAt(channelId string, time int64) ([]*model.Post, *model.AppError) {
@@ -1734,6 +2187,33 @@ func (s *SqlPostStore) GetDirectPostParentsForExportAfter(limit int, afterId str
 	return posts, nil
 }
 
+func (s *SqlPostStore) GetPostsForChannelSnapshot(channelId string, cursor model.ChannelSnapshotCursor, limit int) ([]*model.Post, *model.AppError) {
+	var posts []*model.Post
+	_, err := s.GetReplica().Select(&posts, `
+		SELECT
+			*
+		FROM
+			Posts
+		WHERE
+			ChannelId = :ChannelId
+			AND DeleteAt = 0
+			AND (CreateAt > :CursorCreateAt OR (CreateAt = :CursorCreateAt AND Id > :CursorId))
+		ORDER BY
+			CreateAt, Id
+		LIMIT :Limit`,
+		map[string]interface{}{
+			"ChannelId":      channelId,
+			"CursorCreateAt": cursor.CreateAt,
+			"CursorId":       cursor.Id,
+			"Limit":          limit,
+		})
+	if err != nil {
+		return nil, model.NewAppError("SqlPostStore.GetPostsForChannelSnapshot", "store.sql_post.get_posts.app_error", nil, "channelId="+channelId+", "+err.Error(), http.StatusInternalServerError)
+	}
+
+	return posts, nil
+}
+
 func (s *SqlPostStore) SearchPostsInTeamForUser(paramsList []*model.SearchParams, userId, teamId string, isOrSearch, includeDeletedChannels bool, page, perPage int) (*model.PostSearchResults, *model.AppError) {
 	// Since we don't support paging for DB search, we just return nothing for later pages
 	if page > 0 {
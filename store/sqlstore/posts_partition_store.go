@@ -0,0 +1,375 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/mattermost/gorp"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+const (
+	postsPartitionedTable     = "postspartitioned"
+	postsPartitionDefault     = "posts_default"
+	postsPartitioningDeletes  = "postspartitioningdeletes"
+	postsPartitioningTrigger  = "posts_partitioning_sync"
+	postsPartitionIndexSuffix = "_partitioned"
+)
+
+var postsIndexDefinitionRegexp = regexp.MustCompile(`^CREATE INDEX (\S+) ON (\S+\.)?posts `)
+
+type postsIndex struct {
+	IndexName string
+	IndexDef  string
+}
+
+type SqlPostsPartitionStore struct {
+	SqlStore
+}
+
+func newSqlPostsPartitionStore(sqlStore SqlStore) store.PostsPartitionStore {
+	return &SqlPostsPartitionStore{sqlStore}
+}
+
+// isPostsTablePartitioned reports whether the Posts table is natively partitioned. Lookups are then
+// keyed by both Id and CreateAt, so that they only touch a single partition.
+func isPostsTablePartitioned(sqlStore SqlStore) bool {
+	partitioned, err := SqlPostsPartitionStore{sqlStore}.IsPartitioned()
+	return err == nil && partitioned
+}
+
+func (s SqlPostsPartitionStore) checkDriver() error {
+	if s.DriverName() != model.DATABASE_DRIVER_POSTGRES {
+		return store.NewErrInvalidInput("PostsPartition", "DriverName", s.DriverName())
+	}
+
+	return nil
+}
+
+func (s SqlPostsPartitionStore) IsPartitioned() (bool, error) {
+	if s.DriverName() != model.DATABASE_DRIVER_POSTGRES {
+		return false, nil
+	}
+
+	count, err := s.GetMaster().SelectInt("SELECT COUNT(*) FROM pg_class WHERE oid = to_regclass('posts') AND relkind = 'p'")
+	if err != nil {
+		return false, errors.Wrap(err, "failed to check whether Posts is partitioned")
+	}
+
+	return count > 0, nil
+}
+
+func (s SqlPostsPartitionStore) getPartitions(table string) ([]*model.PostsPartition, error) {
+	var names []string
+	if _, err := s.GetMaster().Select(&names, `
+		SELECT pg_class.relname FROM pg_inherits
+		INNER JOIN pg_class ON pg_class.oid = pg_inherits.inhrelid
+		WHERE pg_inherits.inhparent = to_regclass(:Table)
+		ORDER BY pg_class.relname`, map[string]interface{}{"Table": table}); err != nil {
+		return nil, errors.Wrapf(err, "failed to find partitions of %s", table)
+	}
+
+	partitions := []*model.PostsPartition{}
+	for _, name := range names {
+		if partition := model.PostsPartitionFromName(name); partition != nil {
+			partitions = append(partitions, partition)
+		}
+	}
+
+	return partitions, nil
+}
+
+func (s SqlPostsPartitionStore) GetPartitions() ([]*model.PostsPartition, error) {
+	if err := s.checkDriver(); err != nil {
+		return nil, err
+	}
+
+	return s.getPartitions("posts")
+}
+
+func (s SqlPostsPartitionStore) createPartition(table string, partition *model.PostsPartition) error {
+	query := fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s PARTITION OF %s FOR VALUES FROM (%d) TO (%d)", partition.Name, table, partition.StartAt, partition.EndAt)
+	if _, err := s.GetMaster().ExecNoTimeout(query); err != nil {
+		return errors.Wrapf(err, "failed to create partition %s of %s", partition.Name, table)
+	}
+
+	return nil
+}
+
+// ensurePartitions creates the monthly partitions of the given table from the month of from up to the month of until.
+func (s SqlPostsPartitionStore) ensurePartitions(table string, from, until int64) error {
+	for partition := model.NewPostsPartition(from); partition.StartAt <= until; partition = model.NewPostsPartition(partition.EndAt) {
+		if err := s.createPartition(table, partition); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s SqlPostsPartitionStore) EnsurePartitions(until int64) error {
+	if err := s.checkDriver(); err != nil {
+		return err
+	}
+
+	return s.ensurePartitions("posts", model.GetMillis(), until)
+}
+
+func (s SqlPostsPartitionStore) DropPartitionsBefore(endTime int64) ([]string, error) {
+	partitions, err := s.GetPartitions()
+	if err != nil {
+		return nil, err
+	}
+
+	dropped := []string{}
+	for _, partition := range partitions {
+		if partition.EndAt > endTime {
+			continue
+		}
+
+		if _, err := s.GetMaster().ExecNoTimeout("DROP TABLE IF EXISTS " + partition.Name); err != nil {
+			return dropped, errors.Wrapf(err, "failed to drop partition %s", partition.Name)
+		}
+		dropped = append(dropped, partition.Name)
+	}
+
+	return dropped, nil
+}
+
+func (s SqlPostsPartitionStore) getPostsColumns() ([]string, error) {
+	var columns []string
+	if _, err := s.GetMaster().Select(&columns, `
+		SELECT column_name FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = 'posts'
+		ORDER BY ordinal_position`); err != nil {
+		return nil, errors.Wrap(err, "failed to find the columns of Posts")
+	}
+
+	return columns, nil
+}
+
+func (s SqlPostsPartitionStore) StartConversion() error {
+	if err := s.checkDriver(); err != nil {
+		return err
+	}
+
+	if partitioned, err := s.IsPartitioned(); err != nil {
+		return err
+	} else if partitioned {
+		return store.NewErrInvalidInput("PostsPartition", "Posts", "partitioned")
+	}
+
+	if _, err := s.GetMaster().ExecNoTimeout(`
+		CREATE TABLE IF NOT EXISTS ` + postsPartitionedTable + ` (
+			LIKE posts INCLUDING DEFAULTS,
+			PRIMARY KEY (Id, CreateAt)
+		) PARTITION BY RANGE (CreateAt)`); err != nil {
+		return errors.Wrap(err, "failed to create the partitioned Posts table")
+	}
+
+	if _, err := s.GetMaster().ExecNoTimeout("CREATE TABLE IF NOT EXISTS " + postsPartitionDefault + " PARTITION OF " + postsPartitionedTable + " DEFAULT"); err != nil {
+		return errors.Wrap(err, "failed to create the default Posts partition")
+	}
+
+	// Only create partitions for the months that hold posts, walking them through the CreateAt index.
+	month, err := s.GetMaster().SelectNullInt("SELECT MIN(CreateAt) FROM Posts")
+	if err != nil {
+		return errors.Wrap(err, "failed to find the oldest post")
+	}
+	for month.Valid {
+		partition := model.NewPostsPartition(month.Int64)
+		if err := s.createPartition(postsPartitionedTable, partition); err != nil {
+			return err
+		}
+
+		if month, err = s.GetMaster().SelectNullInt("SELECT MIN(CreateAt) FROM Posts WHERE CreateAt >= :EndAt", map[string]interface{}{"EndAt": partition.EndAt}); err != nil {
+			return errors.Wrap(err, "failed to find the next month holding posts")
+		}
+	}
+
+	now := time.Now()
+	if err := s.ensurePartitions(postsPartitionedTable, model.GetMillisForTime(now), model.GetMillisForTime(now.AddDate(0, model.POSTS_PARTITIONS_AHEAD, 0))); err != nil {
+		return err
+	}
+
+	if err := s.copyIndexes(); err != nil {
+		return err
+	}
+
+	return s.createSyncTrigger()
+}
+
+// copyIndexes recreates the non unique indexes of Posts on the partitioned table, under a suffixed name
+// until the tables are swapped.
+func (s SqlPostsPartitionStore) copyIndexes() error {
+	var indexes []*postsIndex
+	if _, err := s.GetMaster().Select(&indexes, `
+		SELECT indexname AS IndexName, indexdef AS IndexDef FROM pg_indexes
+		WHERE schemaname = current_schema() AND tablename = 'posts'`); err != nil {
+		return errors.Wrap(err, "failed to find the indexes of Posts")
+	}
+
+	for _, index := range indexes {
+		if !postsIndexDefinitionRegexp.MatchString(index.IndexDef) {
+			// Unique indexes, such as the primary key, must include the partition key and aren't copied.
+			continue
+		}
+
+		query := postsIndexDefinitionRegexp.ReplaceAllString(index.IndexDef, "CREATE INDEX IF NOT EXISTS ${1}"+postsPartitionIndexSuffix+" ON "+postsPartitionedTable+" ")
+		if _, err := s.GetMaster().ExecNoTimeout(query); err != nil {
+			return errors.Wrapf(err, "failed to copy index %s", index.IndexName)
+		}
+	}
+
+	return nil
+}
+
+// createSyncTrigger mirrors the writes made to Posts into the partitioned table while it is being filled.
+// Deleted ids are also recorded, so that posts copied right before their deletion can be removed when
+// the tables are swapped.
+func (s SqlPostsPartitionStore) createSyncTrigger() error {
+	columns, err := s.getPostsColumns()
+	if err != nil {
+		return err
+	}
+
+	updates := make([]string, 0, len(columns))
+	for _, column := range columns {
+		updates = append(updates, fmt.Sprintf("%s = EXCLUDED.%s", column, column))
+	}
+
+	queries := []string{
+		"CREATE TABLE IF NOT EXISTS " + postsPartitioningDeletes + " (Id varchar(26) PRIMARY KEY)",
+		`CREATE OR REPLACE FUNCTION ` + postsPartitioningTrigger + `() RETURNS trigger AS $$
+		BEGIN
+			IF TG_OP = 'DELETE' THEN
+				DELETE FROM ` + postsPartitionedTable + ` WHERE Id = OLD.Id AND CreateAt = OLD.CreateAt;
+				INSERT INTO ` + postsPartitioningDeletes + ` VALUES (OLD.Id) ON CONFLICT DO NOTHING;
+				RETURN OLD;
+			END IF;
+			INSERT INTO ` + postsPartitionedTable + ` VALUES (NEW.*)
+				ON CONFLICT (Id, CreateAt) DO UPDATE SET ` + strings.Join(updates, ", ") + `;
+			RETURN NEW;
+		END;
+		$$ LANGUAGE plpgsql`,
+		"DROP TRIGGER IF EXISTS " + postsPartitioningTrigger + " ON posts",
+		"CREATE TRIGGER " + postsPartitioningTrigger + " AFTER INSERT OR UPDATE OR DELETE ON posts FOR EACH ROW EXECUTE PROCEDURE " + postsPartitioningTrigger + "()",
+	}
+
+	for _, query := range queries {
+		if _, err := s.GetMaster().ExecNoTimeout(query); err != nil {
+			return errors.Wrap(err, "failed to set up the Posts partitioning trigger")
+		}
+	}
+
+	return nil
+}
+
+func (s SqlPostsPartitionStore) CopyBatch(cursor model.PostsPartitionCursor, limit int64) (int64, model.PostsPartitionCursor, error) {
+	if err := s.checkDriver(); err != nil {
+		return 0, cursor, err
+	}
+
+	var entries []*retentionBatchEntry
+	if _, err := s.GetMaster().Select(&entries, `
+		SELECT Id, CreateAt FROM Posts
+		WHERE CreateAt > :CursorCreateAt OR (CreateAt = :CursorCreateAt AND Id > :CursorId)
+		ORDER BY CreateAt, Id
+		LIMIT :Limit`, map[string]interface{}{
+		"CursorCreateAt": cursor.CreateAt,
+		"CursorId":       cursor.Id,
+		"Limit":          limit,
+	}); err != nil {
+		return 0, cursor, errors.Wrap(err, "failed to find Posts to copy")
+	}
+
+	if len(entries) == 0 {
+		return 0, cursor, nil
+	}
+
+	ids := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		ids = append(ids, entry.Id)
+	}
+
+	queryString, args, err := s.getQueryBuilder().
+		Select("*").
+		From("Posts").
+		Where(sq.Eq{"Id": ids}).
+		ToSql()
+	if err != nil {
+		return 0, cursor, errors.Wrap(err, "posts_partition_copy_tosql")
+	}
+
+	if _, err := s.GetMaster().Exec("INSERT INTO "+postsPartitionedTable+" "+queryString+" ON CONFLICT (Id, CreateAt) DO NOTHING", args...); err != nil {
+		return 0, cursor, errors.Wrap(err, "failed to copy Posts into the partitioned table")
+	}
+
+	last := entries[len(entries)-1]
+	return int64(len(entries)), model.PostsPartitionCursor{CreateAt: last.CreateAt, Id: last.Id}, nil
+}
+
+func (s SqlPostsPartitionStore) FinishConversion() error {
+	if err := s.checkDriver(); err != nil {
+		return err
+	}
+
+	var indexNames []string
+	if _, err := s.GetMaster().Select(&indexNames, `
+		SELECT indexname FROM pg_indexes
+		WHERE schemaname = current_schema() AND tablename = :Table AND indexname LIKE :Suffix`,
+		map[string]interface{}{"Table": postsPartitionedTable, "Suffix": "%" + postsPartitionIndexSuffix}); err != nil {
+		return errors.Wrap(err, "failed to find the indexes of the partitioned Posts table")
+	}
+
+	transaction, err := s.GetMaster().Begin()
+	if err != nil {
+		return errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransaction(transaction)
+
+	queries := []string{
+		"LOCK TABLE posts IN ACCESS EXCLUSIVE MODE",
+		"DELETE FROM " + postsPartitionedTable + " WHERE Id IN (SELECT Id FROM " + postsPartitioningDeletes + ")",
+		"DROP TRIGGER " + postsPartitioningTrigger + " ON posts",
+		"DROP TABLE posts",
+		"ALTER TABLE " + postsPartitionedTable + " RENAME TO posts",
+		"ALTER TABLE posts RENAME CONSTRAINT " + postsPartitionedTable + "_pkey TO posts_pkey",
+	}
+	for _, indexName := range indexNames {
+		queries = append(queries, "ALTER INDEX "+indexName+" RENAME TO "+strings.TrimSuffix(indexName, postsPartitionIndexSuffix))
+	}
+	queries = append(queries,
+		"DROP TABLE "+postsPartitioningDeletes,
+		"DROP FUNCTION "+postsPartitioningTrigger+"()",
+	)
+
+	if err := execAllT(transaction, queries); err != nil {
+		return err
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return errors.Wrap(err, "commit_transaction")
+	}
+
+	return nil
+}
+
+func execAllT(transaction *gorp.Transaction, queries []string) error {
+	for _, query := range queries {
+		if _, err := transaction.Exec(query); err != nil {
+			return errors.Wrapf(err, "failed to execute %q", query)
+		}
+	}
+
+	return nil
+}
@@ -297,3 +297,82 @@ func (s SqlPreferenceStore) CleanupFlagsBatch(limit int64) (int64, *model.AppErr
 
 	return rowsAffected, nil
 }
+
+func (s SqlPreferenceStore) RenameCategory(oldCategory string, newCategory string, transform store.PreferenceTransform, limit int64) (int64, *model.AppError) {
+	if oldCategory == newCategory {
+		return 0, model.NewAppError("SqlPreferenceStore.RenameCategory", "store.sql_preference.rename_category.same_category.app_error", nil, "category="+oldCategory, http.StatusBadRequest)
+	}
+
+	transaction, err := s.GetMaster().Begin()
+	if err != nil {
+		return 0, model.NewAppError("SqlPreferenceStore.RenameCategory", "store.sql_preference.rename_category.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	defer finalizeTransaction(transaction)
+
+	var preferences model.Preferences
+	if _, err := transaction.Select(&preferences,
+		`SELECT
+			*
+		FROM
+			Preferences
+		WHERE
+			Category = :Category
+		ORDER BY
+			UserId, Name
+		LIMIT
+			:Limit`, map[string]interface{}{"Category": oldCategory, "Limit": limit}); err != nil {
+		return 0, model.NewAppError("SqlPreferenceStore.RenameCategory", "store.sql_preference.rename_category.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	for _, preference := range preferences {
+		preference := preference
+
+		if _, err := transaction.Exec(
+			`DELETE FROM
+				Preferences
+			WHERE
+				UserId = :UserId
+				AND Category = :Category
+				AND Name = :Name`, map[string]interface{}{"UserId": preference.UserId, "Category": oldCategory, "Name": preference.Name}); err != nil {
+			return 0, model.NewAppError("SqlPreferenceStore.RenameCategory", "store.sql_preference.rename_category.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+
+		preference.Category = newCategory
+		if transform != nil && !transform(&preference) {
+			continue
+		}
+		preference.Category = newCategory
+
+		// The preferences users already set in the new category take precedence over the moved ones.
+		count, err := transaction.SelectInt(
+			`SELECT
+				count(0)
+			FROM
+				Preferences
+			WHERE
+				UserId = :UserId
+				AND Category = :Category
+				AND Name = :Name`, map[string]interface{}{"UserId": preference.UserId, "Category": newCategory, "Name": preference.Name})
+		if err != nil {
+			return 0, model.NewAppError("SqlPreferenceStore.RenameCategory", "store.sql_preference.rename_category.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		if count > 0 {
+			continue
+		}
+
+		preference.PreUpdate()
+		if appErr := preference.IsValid(); appErr != nil {
+			return 0, appErr
+		}
+
+		if appErr := s.insert(transaction, &preference); appErr != nil {
+			return 0, appErr
+		}
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return 0, model.NewAppError("SqlPreferenceStore.RenameCategory", "store.sql_preference.rename_category.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return int64(len(preferences)), nil
+}
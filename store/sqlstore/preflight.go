@@ -0,0 +1,240 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+const PREFLIGHT_TABLE_NAME = "PreflightCheck"
+
+// PreflightError describes a database pre-flight check that failed, along with what an administrator
+// should do about it.
+type PreflightError struct {
+	Check   string
+	Problem string
+	Fix     string
+}
+
+func (e *PreflightError) Error() string {
+	return fmt.Sprintf("%s: %s. %s", e.Check, e.Problem, e.Fix)
+}
+
+// preflightCheck is run against the master database before any table is created or migrated.
+// A fatal check stops the server from starting, the others only log a warning.
+type preflightCheck struct {
+	name  string
+	fatal bool
+	run   func(ss *SqlSupplier) *PreflightError
+}
+
+var preflightChecks = []preflightCheck{
+	{name: "permissions", fatal: true, run: checkPreflightPermissions},
+	{name: "encoding", fatal: true, run: checkPreflightEncoding},
+	{name: "collation", fatal: false, run: checkPreflightCollation},
+	{name: "max_connections", fatal: false, run: checkPreflightMaxConnections},
+}
+
+// runPreflightChecks verifies that the database can host Mattermost, logging an actionable message
+// for every check that fails. It returns an error if any fatal check failed.
+func (ss *SqlSupplier) runPreflightChecks() error {
+	if ss.DriverName() == model.DATABASE_DRIVER_SQLITE {
+		return nil
+	}
+
+	failed := []string{}
+	for _, check := range preflightChecks {
+		perr := check.run(ss)
+		if perr == nil {
+			continue
+		}
+
+		fields := []mlog.Field{mlog.String("check", perr.Check), mlog.String("problem", perr.Problem), mlog.String("fix", perr.Fix)}
+		if !check.fatal {
+			mlog.Warn("Database pre-flight check failed.", fields...)
+			continue
+		}
+
+		mlog.Critical("Database pre-flight check failed.", fields...)
+		failed = append(failed, check.name)
+	}
+
+	if len(failed) > 0 {
+		return errors.Errorf("database pre-flight checks failed: %s", strings.Join(failed, ", "))
+	}
+
+	return nil
+}
+
+// checkPreflightPermissions creates and drops a scratch table to make sure the database user is
+// allowed to manage the schema.
+func checkPreflightPermissions(ss *SqlSupplier) *PreflightError {
+	query := "CREATE TABLE IF NOT EXISTS " + PREFLIGHT_TABLE_NAME + " (Id VARCHAR(26) NOT NULL, PRIMARY KEY (Id))"
+	if _, err := ss.GetMaster().Exec(query); err != nil {
+		return permissionsPreflightError("CREATE TABLE", err)
+	}
+
+	if _, err := ss.GetMaster().Exec("DROP TABLE " + PREFLIGHT_TABLE_NAME); err != nil {
+		return permissionsPreflightError("DROP TABLE", err)
+	}
+
+	return nil
+}
+
+func permissionsPreflightError(statement string, err error) *PreflightError {
+	if store.IsReadOnlyError(err) {
+		return &PreflightError{
+			Check:   "permissions",
+			Problem: "the database is read-only",
+			Fix:     "Point SqlSettings.DataSource at the writable primary database, not a replica or a standby",
+		}
+	}
+
+	return &PreflightError{
+		Check:   "permissions",
+		Problem: fmt.Sprintf("the database user can't run %s: %s", statement, err.Error()),
+		Fix:     "Grant the database user ALL PRIVILEGES on the Mattermost database so tables can be created and migrated",
+	}
+}
+
+func checkPreflightEncoding(ss *SqlSupplier) *PreflightError {
+	switch ss.DriverName() {
+	case model.DATABASE_DRIVER_MYSQL:
+		charset, err := ss.GetMaster().SelectStr("SELECT @@character_set_database")
+		if err != nil {
+			return queryPreflightError("encoding", err)
+		}
+		return checkMySQLCharset(charset)
+	case model.DATABASE_DRIVER_POSTGRES:
+		encoding, err := ss.GetMaster().SelectStr("SHOW server_encoding")
+		if err != nil {
+			return queryPreflightError("encoding", err)
+		}
+		return checkPostgresEncoding(encoding)
+	}
+
+	return nil
+}
+
+// checkMySQLCharset accepts utf8mb4 and, for existing installations, the legacy three byte utf8
+// character set. Anything else corrupts non-ASCII text.
+func checkMySQLCharset(charset string) *PreflightError {
+	switch strings.ToLower(charset) {
+	case "utf8mb4", "utf8", "utf8mb3":
+		return nil
+	}
+
+	return &PreflightError{
+		Check:   "encoding",
+		Problem: fmt.Sprintf("the database character set is %s", charset),
+		Fix:     "Run ALTER DATABASE <name> CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci before starting Mattermost",
+	}
+}
+
+func checkPostgresEncoding(encoding string) *PreflightError {
+	if strings.EqualFold(encoding, "UTF8") {
+		return nil
+	}
+
+	return &PreflightError{
+		Check:   "encoding",
+		Problem: fmt.Sprintf("the database server encoding is %s", encoding),
+		Fix:     "Recreate the database with CREATE DATABASE <name> ENCODING 'UTF8' and restore your data into it",
+	}
+}
+
+func checkPreflightCollation(ss *SqlSupplier) *PreflightError {
+	if ss.DriverName() != model.DATABASE_DRIVER_MYSQL {
+		return nil
+	}
+
+	charset, err := ss.GetMaster().SelectStr("SELECT @@character_set_database")
+	if err != nil {
+		return queryPreflightError("collation", err)
+	}
+
+	collation, err := ss.GetMaster().SelectStr("SELECT @@collation_database")
+	if err != nil {
+		return queryPreflightError("collation", err)
+	}
+
+	return checkMySQLCollation(charset, collation)
+}
+
+// checkMySQLCollation warns about collations that make lookups case-sensitive or can't store
+// characters outside the basic multilingual plane, such as most emoji.
+func checkMySQLCollation(charset, collation string) *PreflightError {
+	collation = strings.ToLower(collation)
+	if strings.HasSuffix(collation, "_bin") || strings.HasSuffix(collation, "_cs") {
+		return &PreflightError{
+			Check:   "collation",
+			Problem: fmt.Sprintf("the database collation %s is case-sensitive, so usernames and emails that differ only in case are treated as different", collation),
+			Fix:     "Run ALTER DATABASE <name> COLLATE utf8mb4_general_ci",
+		}
+	}
+
+	if !strings.EqualFold(charset, "utf8mb4") {
+		return &PreflightError{
+			Check:   "collation",
+			Problem: fmt.Sprintf("the database character set %s can't store emoji and other four byte characters", charset),
+			Fix:     "Run ALTER DATABASE <name> CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci",
+		}
+	}
+
+	return nil
+}
+
+func checkPreflightMaxConnections(ss *SqlSupplier) *PreflightError {
+	var query string
+	switch ss.DriverName() {
+	case model.DATABASE_DRIVER_MYSQL:
+		query = "SELECT @@max_connections"
+	case model.DATABASE_DRIVER_POSTGRES:
+		query = "SHOW max_connections"
+	default:
+		return nil
+	}
+
+	value, err := ss.GetMaster().SelectStr(query)
+	if err != nil {
+		return queryPreflightError("max_connections", err)
+	}
+
+	maxConnections, err := strconv.Atoi(value)
+	if err != nil {
+		return queryPreflightError("max_connections", err)
+	}
+
+	return checkMaxConnections(maxConnections, *ss.settings.MaxOpenConns)
+}
+
+// checkMaxConnections compares the database's connection limit with the number of connections a
+// single app server may open to the master.
+func checkMaxConnections(maxConnections, maxOpenConns int) *PreflightError {
+	if maxOpenConns <= maxConnections {
+		return nil
+	}
+
+	return &PreflightError{
+		Check:   "max_connections",
+		Problem: fmt.Sprintf("SqlSettings.MaxOpenConns is %d but the database only accepts %d connections", maxOpenConns, maxConnections),
+		Fix:     "Lower SqlSettings.MaxOpenConns or raise max_connections on the database server, leaving room for every app server in the cluster",
+	}
+}
+
+func queryPreflightError(check string, err error) *PreflightError {
+	return &PreflightError{
+		Check:   check,
+		Problem: fmt.Sprintf("the check could not be run: %s", err.Error()),
+		Fix:     "Make sure the database user can read server variables",
+	}
+}
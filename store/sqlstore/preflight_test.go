@@ -0,0 +1,73 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+func TestCheckMySQLCharset(t *testing.T) {
+	assert.Nil(t, checkMySQLCharset("utf8mb4"))
+	assert.Nil(t, checkMySQLCharset("UTF8"))
+	assert.Nil(t, checkMySQLCharset("utf8mb3"))
+
+	perr := checkMySQLCharset("latin1")
+	require.NotNil(t, perr)
+	assert.Equal(t, "encoding", perr.Check)
+	assert.Contains(t, perr.Error(), "latin1")
+}
+
+func TestCheckPostgresEncoding(t *testing.T) {
+	assert.Nil(t, checkPostgresEncoding("UTF8"))
+
+	perr := checkPostgresEncoding("SQL_ASCII")
+	require.NotNil(t, perr)
+	assert.Contains(t, perr.Problem, "SQL_ASCII")
+}
+
+func TestCheckMySQLCollation(t *testing.T) {
+	assert.Nil(t, checkMySQLCollation("utf8mb4", "utf8mb4_general_ci"))
+	assert.Nil(t, checkMySQLCollation("utf8mb4", "utf8mb4_unicode_ci"))
+
+	perr := checkMySQLCollation("utf8mb4", "utf8mb4_bin")
+	require.NotNil(t, perr)
+	assert.Contains(t, perr.Problem, "case-sensitive")
+
+	perr = checkMySQLCollation("utf8", "utf8_general_ci")
+	require.NotNil(t, perr)
+	assert.Contains(t, perr.Problem, "emoji")
+}
+
+func TestCheckMaxConnections(t *testing.T) {
+	assert.Nil(t, checkMaxConnections(300, 300))
+	assert.Nil(t, checkMaxConnections(500, 100))
+
+	perr := checkMaxConnections(151, 300)
+	require.NotNil(t, perr)
+	assert.Contains(t, perr.Problem, "151")
+}
+
+func TestPermissionsPreflightError(t *testing.T) {
+	perr := permissionsPreflightError("CREATE TABLE", errors.New("CREATE command denied to user"))
+	assert.Contains(t, perr.Problem, "CREATE command denied")
+	assert.Contains(t, perr.Fix, "ALL PRIVILEGES")
+
+	perr = permissionsPreflightError("CREATE TABLE", &mysql.MySQLError{Number: 1290, Message: "The MySQL server is running with the --read-only option"})
+	assert.Equal(t, "the database is read-only", perr.Problem)
+}
+
+func TestRunPreflightChecks(t *testing.T) {
+	StoreTest(t, func(t *testing.T, ss store.Store) {
+		supplier := ss.(*SqlSupplier)
+		assert.NoError(t, supplier.runPreflightChecks())
+		assert.False(t, supplier.DoesTableExist(PREFLIGHT_TABLE_NAME))
+	})
+}
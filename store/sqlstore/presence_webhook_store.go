@@ -0,0 +1,146 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+type SqlPresenceWebhookStore struct {
+	SqlStore
+}
+
+func newSqlPresenceWebhookStore(sqlStore SqlStore) store.PresenceWebhookStore {
+	s := &SqlPresenceWebhookStore{sqlStore}
+
+	for _, db := range sqlStore.GetAllConns() {
+		table := db.AddTableWithName(model.PresenceWebhook{}, "PresenceWebhooks").SetKeys(false, "Id")
+		table.ColMap("Id").SetMaxSize(26)
+		table.ColMap("CreatorId").SetMaxSize(26)
+		table.ColMap("DisplayName").SetMaxSize(model.PRESENCE_WEBHOOK_DISPLAY_NAME_MAX_RUNES * 4)
+		table.ColMap("Description").SetMaxSize(model.PRESENCE_WEBHOOK_DESCRIPTION_MAX_RUNES * 4)
+		table.ColMap("CallbackURL").SetMaxSize(model.PRESENCE_WEBHOOK_CALLBACK_URL_MAX_LEN)
+		table.ColMap("UserIds").SetMaxSize(model.PRESENCE_WEBHOOK_USER_IDS_MAX * 30)
+		table.ColMap("Statuses").SetMaxSize(128)
+	}
+
+	return s
+}
+
+func (s SqlPresenceWebhookStore) createIndexesIfNotExists() {
+	s.CreateIndexIfNotExists("idx_presencewebhooks_delete_at", "PresenceWebhooks", "DeleteAt")
+}
+
+func (s SqlPresenceWebhookStore) Save(hook *model.PresenceWebhook) (*model.PresenceWebhook, error) {
+	if hook.Id != "" {
+		return nil, store.NewErrInvalidInput("PresenceWebhook", "id", hook.Id)
+	}
+
+	hook.PreSave()
+	if err := hook.IsValid(); err != nil {
+		return nil, err
+	}
+
+	if err := s.GetMaster().Insert(hook); err != nil {
+		return nil, errors.Wrapf(err, "failed to save PresenceWebhook with id=%s", hook.Id)
+	}
+
+	return hook, nil
+}
+
+func (s SqlPresenceWebhookStore) Update(hook *model.PresenceWebhook) (*model.PresenceWebhook, error) {
+	hook.PreUpdate()
+	if err := hook.IsValid(); err != nil {
+		return nil, err
+	}
+
+	count, err := s.GetMaster().Update(hook)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update PresenceWebhook with id=%s", hook.Id)
+	}
+	if count != 1 {
+		return nil, store.NewErrNotFound("PresenceWebhook", hook.Id)
+	}
+
+	return hook, nil
+}
+
+func (s SqlPresenceWebhookStore) Get(id string) (*model.PresenceWebhook, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("*").
+		From("PresenceWebhooks").
+		Where(sq.Eq{"Id": id, "DeleteAt": 0}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "presence_webhook_tosql")
+	}
+
+	var hook *model.PresenceWebhook
+	if err := s.GetReplica().SelectOne(&hook, queryString, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("PresenceWebhook", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get PresenceWebhook with id=%s", id)
+	}
+
+	return hook, nil
+}
+
+func (s SqlPresenceWebhookStore) GetAll(offset, limit int) ([]*model.PresenceWebhook, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("*").
+		From("PresenceWebhooks").
+		Where(sq.Eq{"DeleteAt": 0}).
+		OrderBy("CreateAt ASC", "Id ASC").
+		Limit(uint64(limit)).
+		Offset(uint64(offset)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "presence_webhooks_tosql")
+	}
+
+	hooks := []*model.PresenceWebhook{}
+	if _, err := s.GetReplica().Select(&hooks, queryString, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to find PresenceWebhooks")
+	}
+
+	return hooks, nil
+}
+
+func (s SqlPresenceWebhookStore) Delete(id string, deleteAt int64) error {
+	queryString, args, err := s.getQueryBuilder().
+		Update("PresenceWebhooks").
+		Set("DeleteAt", deleteAt).
+		Set("UpdateAt", deleteAt).
+		Where(sq.Eq{"Id": id, "DeleteAt": 0}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "presence_webhook_delete_tosql")
+	}
+
+	result, err := s.GetMaster().Exec(queryString, args...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete PresenceWebhook with id=%s", id)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrapf(err, "failed to get rows affected for PresenceWebhook with id=%s", id)
+	}
+	if rowsAffected == 0 {
+		return store.NewErrNotFound("PresenceWebhook", id)
+	}
+	if rowsAffected != 1 {
+		return fmt.Errorf("unexpected count while deleting PresenceWebhook: count=%d, id=%s", rowsAffected, id)
+	}
+
+	return nil
+}
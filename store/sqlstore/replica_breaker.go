@@ -0,0 +1,176 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/mattermost/gorp"
+
+	"github.com/mattermost/mattermost-server/v5/mlog"
+)
+
+// replicaBreaker is the circuit breaker of a read replica. It opens after FailureThreshold
+// consecutive failed health checks, taking the replica out of the rotation, and lets a single
+// health check through every RetryInterval to close it again once the replica is back.
+type replicaBreaker struct {
+	name string
+	db   *gorp.DbMap
+
+	mutex    sync.RWMutex
+	open     bool
+	failures int
+	openedAt time.Time
+}
+
+func newReplicaBreaker(name string, db *gorp.DbMap) *replicaBreaker {
+	return &replicaBreaker{name: name, db: db}
+}
+
+func (b *replicaBreaker) available() bool {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	return !b.open
+}
+
+// shouldCheck reports whether the replica is due a health check: always while it's in the
+// rotation, and once per retryInterval while it's out of it.
+func (b *replicaBreaker) shouldCheck(now time.Time, retryInterval time.Duration) bool {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	return !b.open || now.Sub(b.openedAt) >= retryInterval
+}
+
+// recordCheck updates the breaker with the result of a health check, and reports whether the
+// breaker opened or closed because of it.
+func (b *replicaBreaker) recordCheck(err error, threshold int, now time.Time) (opened bool, closed bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if err == nil {
+		closed = b.open
+		b.open = false
+		b.failures = 0
+		return false, closed
+	}
+
+	b.failures++
+	if b.open {
+		// The probe of an open breaker failed, wait for another retry interval.
+		b.openedAt = now
+		return false, false
+	}
+
+	if b.failures >= threshold {
+		b.open = true
+		b.openedAt = now
+		return true, false
+	}
+	return false, false
+}
+
+// pickReplica returns the next replica in the rotation that is available. When none is, reads fall
+// back to master for up to ReplicaFallbackMaxMasterReadPercent of them, the others still going to
+// the replicas so that master isn't overloaded in their place.
+func (ss *SqlSupplier) pickReplica(breakers []*replicaBreaker, counter *int64) *gorp.DbMap {
+	var rrNum int64
+	for i := 0; i < len(breakers); i++ {
+		rrNum = atomic.AddInt64(counter, 1) % int64(len(breakers))
+		if breakers[rrNum].available() {
+			return breakers[rrNum].db
+		}
+	}
+
+	if atomic.AddInt64(&ss.fallbackCounter, 1)%100 < int64(*ss.settings.ReplicaFallbackMaxMasterReadPercent) {
+		return ss.GetMaster()
+	}
+	return breakers[rrNum].db
+}
+
+func (ss *SqlSupplier) allReplicaBreakers() []*replicaBreaker {
+	all := make([]*replicaBreaker, 0, len(ss.replicaBreakers)+len(ss.searchReplicaBreakers))
+	all = append(all, ss.replicaBreakers...)
+	return append(all, ss.searchReplicaBreakers...)
+}
+
+func (ss *SqlSupplier) startReplicaHealthChecks() {
+	if len(ss.allReplicaBreakers()) == 0 {
+		return
+	}
+
+	ss.replicaChecksStop = make(chan struct{})
+	ss.replicaChecksDone = make(chan struct{})
+
+	go func() {
+		defer close(ss.replicaChecksDone)
+
+		ticker := time.NewTicker(time.Duration(*ss.settings.ReplicaHealthCheckIntervalSeconds) * time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ss.replicaChecksStop:
+				return
+			case <-ticker.C:
+				ss.checkReplicas(time.Now())
+			}
+		}
+	}()
+}
+
+func (ss *SqlSupplier) stopReplicaHealthChecks() {
+	if ss.replicaChecksStop == nil {
+		return
+	}
+	close(ss.replicaChecksStop)
+	<-ss.replicaChecksDone
+	ss.replicaChecksStop = nil
+}
+
+func (ss *SqlSupplier) checkReplicas(now time.Time) {
+	threshold := *ss.settings.ReplicaFailureThreshold
+	retryInterval := time.Duration(*ss.settings.ReplicaRetryIntervalSeconds) * time.Second
+
+	for _, breaker := range ss.allReplicaBreakers() {
+		if !breaker.shouldCheck(now, retryInterval) {
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), DB_PING_TIMEOUT_SECS*time.Second)
+		err := breaker.db.Db.PingContext(ctx)
+		cancel()
+
+		opened, closed := breaker.recordCheck(err, threshold, now)
+		if opened {
+			mlog.Warn("Read replica is unreachable, taking it out of the rotation.",
+				mlog.String("replica", breaker.name),
+				mlog.Int("consecutive_failures", threshold),
+				mlog.Int("retry_interval_seconds", *ss.settings.ReplicaRetryIntervalSeconds),
+				mlog.Err(err),
+			)
+			if !anyReplicaAvailable(ss.replicaBreakers) || !anyReplicaAvailable(ss.searchReplicaBreakers) {
+				mlog.Warn("No read replica is reachable, reads are falling back to master.",
+					mlog.Int("max_master_read_percent", *ss.settings.ReplicaFallbackMaxMasterReadPercent),
+				)
+			}
+		} else if closed {
+			mlog.Info("Read replica is reachable again, putting it back in the rotation.", mlog.String("replica", breaker.name))
+		}
+	}
+}
+
+func anyReplicaAvailable(breakers []*replicaBreaker) bool {
+	if len(breakers) == 0 {
+		return true
+	}
+	for _, breaker := range breakers {
+		if breaker.available() {
+			return true
+		}
+	}
+	return false
+}
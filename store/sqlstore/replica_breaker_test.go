@@ -0,0 +1,103 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mattermost/gorp"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+func TestReplicaBreaker(t *testing.T) {
+	now := time.Now()
+	retryInterval := 30 * time.Second
+	pingErr := errors.New("connection refused")
+
+	breaker := newReplicaBreaker("replica-0", &gorp.DbMap{})
+	assert.True(t, breaker.available())
+	assert.True(t, breaker.shouldCheck(now, retryInterval))
+
+	opened, closed := breaker.recordCheck(pingErr, 3, now)
+	assert.False(t, opened)
+	assert.False(t, closed)
+	breaker.recordCheck(pingErr, 3, now)
+	assert.True(t, breaker.available(), "the breaker should stay closed below the threshold")
+
+	opened, _ = breaker.recordCheck(pingErr, 3, now)
+	assert.True(t, opened)
+	assert.False(t, breaker.available())
+	assert.False(t, breaker.shouldCheck(now.Add(retryInterval/2), retryInterval))
+	assert.True(t, breaker.shouldCheck(now.Add(retryInterval), retryInterval))
+
+	// A failed probe waits for another retry interval.
+	opened, _ = breaker.recordCheck(pingErr, 3, now.Add(retryInterval))
+	assert.False(t, opened)
+	assert.False(t, breaker.shouldCheck(now.Add(retryInterval+retryInterval/2), retryInterval))
+
+	_, closed = breaker.recordCheck(nil, 3, now.Add(2*retryInterval))
+	assert.True(t, closed)
+	assert.True(t, breaker.available())
+
+	// Failures start counting from zero again once the replica is back.
+	opened, _ = breaker.recordCheck(pingErr, 3, now)
+	assert.False(t, opened)
+	assert.True(t, breaker.available())
+}
+
+func TestPickReplica(t *testing.T) {
+	master := &gorp.DbMap{}
+	replica0 := newReplicaBreaker("replica-0", &gorp.DbMap{})
+	replica1 := newReplicaBreaker("replica-1", &gorp.DbMap{})
+
+	settings := &model.SqlSettings{}
+	settings.SetDefaults(false)
+	ss := &SqlSupplier{
+		master:          master,
+		settings:        settings,
+		replicaBreakers: []*replicaBreaker{replica0, replica1},
+	}
+
+	t.Run("round robin over the available replicas", func(t *testing.T) {
+		picked := map[*gorp.DbMap]int{}
+		for i := 0; i < 10; i++ {
+			picked[ss.pickReplica(ss.replicaBreakers, &ss.rrCounter)]++
+		}
+		assert.Equal(t, 5, picked[replica0.db])
+		assert.Equal(t, 5, picked[replica1.db])
+	})
+
+	t.Run("skip the unreachable replicas", func(t *testing.T) {
+		replica0.recordCheck(errors.New("connection refused"), 1, time.Now())
+		defer replica0.recordCheck(nil, 1, time.Now())
+
+		for i := 0; i < 10; i++ {
+			assert.Same(t, replica1.db, ss.pickReplica(ss.replicaBreakers, &ss.rrCounter))
+		}
+	})
+
+	t.Run("fall back to master", func(t *testing.T) {
+		replica0.recordCheck(errors.New("connection refused"), 1, time.Now())
+		replica1.recordCheck(errors.New("connection refused"), 1, time.Now())
+		defer replica0.recordCheck(nil, 1, time.Now())
+		defer replica1.recordCheck(nil, 1, time.Now())
+
+		for i := 0; i < 10; i++ {
+			assert.Same(t, master, ss.pickReplica(ss.replicaBreakers, &ss.rrCounter))
+		}
+
+		*settings.ReplicaFallbackMaxMasterReadPercent = 25
+		masterReads := 0
+		for i := 0; i < 100; i++ {
+			if ss.pickReplica(ss.replicaBreakers, &ss.rrCounter) == master {
+				masterReads++
+			}
+		}
+		assert.Equal(t, 25, masterReads)
+	})
+}
@@ -0,0 +1,430 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/mattermost/gorp"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+const (
+	// retentionPolicyChannelsQuery selects the channels governed by the policy :PolicyId. Channels listed
+	// directly in a policy take precedence over the policy of their team.
+	retentionPolicyChannelsQuery = `
+		SELECT ChannelId FROM RetentionPoliciesChannels WHERE PolicyId = :PolicyId
+		UNION
+		SELECT Channels.Id FROM Channels
+			INNER JOIN RetentionPoliciesTeams ON Channels.TeamId = RetentionPoliciesTeams.TeamId
+			WHERE RetentionPoliciesTeams.PolicyId = :PolicyId
+			AND Channels.Id NOT IN (SELECT ChannelId FROM RetentionPoliciesChannels)`
+
+	// retentionPolicyAllChannelsQuery selects the channels governed by any policy.
+	retentionPolicyAllChannelsQuery = `
+		SELECT ChannelId FROM RetentionPoliciesChannels
+		UNION
+		SELECT Channels.Id FROM Channels
+			INNER JOIN RetentionPoliciesTeams ON Channels.TeamId = RetentionPoliciesTeams.TeamId`
+)
+
+type SqlRetentionPolicyStore struct {
+	SqlStore
+}
+
+func newSqlRetentionPolicyStore(sqlStore SqlStore) store.RetentionPolicyStore {
+	s := &SqlRetentionPolicyStore{sqlStore}
+
+	for _, db := range sqlStore.GetAllConns() {
+		table := db.AddTableWithName(model.RetentionPolicy{}, "RetentionPolicies").SetKeys(false, "Id")
+		table.ColMap("Id").SetMaxSize(26)
+		table.ColMap("DisplayName").SetMaxSize(model.RETENTION_POLICY_DISPLAY_NAME_MAX_RUNES * 4)
+
+		teamsTable := db.AddTableWithName(model.RetentionPolicyTeam{}, "RetentionPoliciesTeams").SetKeys(false, "TeamId")
+		teamsTable.ColMap("PolicyId").SetMaxSize(26)
+		teamsTable.ColMap("TeamId").SetMaxSize(26)
+
+		channelsTable := db.AddTableWithName(model.RetentionPolicyChannel{}, "RetentionPoliciesChannels").SetKeys(false, "ChannelId")
+		channelsTable.ColMap("PolicyId").SetMaxSize(26)
+		channelsTable.ColMap("ChannelId").SetMaxSize(26)
+	}
+
+	return s
+}
+
+func (s SqlRetentionPolicyStore) createIndexesIfNotExists() {
+	s.CreateIndexIfNotExists("idx_retentionpoliciesteams_policy_id", "RetentionPoliciesTeams", "PolicyId")
+	s.CreateIndexIfNotExists("idx_retentionpolicieschannels_policy_id", "RetentionPoliciesChannels", "PolicyId")
+}
+
+func (s SqlRetentionPolicyStore) Save(policy *model.RetentionPolicy) (*model.RetentionPolicy, error) {
+	if policy.Id != "" {
+		return nil, store.NewErrInvalidInput("RetentionPolicy", "id", policy.Id)
+	}
+
+	policy.PreSave()
+	if err := policy.IsValid(); err != nil {
+		return nil, err
+	}
+
+	transaction, err := s.GetMaster().Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransaction(transaction)
+
+	if err := transaction.Insert(policy); err != nil {
+		return nil, errors.Wrapf(err, "failed to save RetentionPolicy with id=%s", policy.Id)
+	}
+
+	if err := s.saveAssociationsT(transaction, policy); err != nil {
+		return nil, err
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit_transaction")
+	}
+
+	return policy, nil
+}
+
+func (s SqlRetentionPolicyStore) Update(policy *model.RetentionPolicy) (*model.RetentionPolicy, error) {
+	policy.PreUpdate()
+	if err := policy.IsValid(); err != nil {
+		return nil, err
+	}
+
+	transaction, err := s.GetMaster().Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransaction(transaction)
+
+	count, err := transaction.Update(policy)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update RetentionPolicy with id=%s", policy.Id)
+	}
+	if count != 1 {
+		return nil, store.NewErrNotFound("RetentionPolicy", policy.Id)
+	}
+
+	if err := s.deleteAssociationsT(transaction, policy.Id); err != nil {
+		return nil, err
+	}
+
+	if err := s.saveAssociationsT(transaction, policy); err != nil {
+		return nil, err
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit_transaction")
+	}
+
+	return policy, nil
+}
+
+func (s SqlRetentionPolicyStore) saveAssociationsT(transaction *gorp.Transaction, policy *model.RetentionPolicy) error {
+	for _, teamId := range policy.TeamIds {
+		if err := transaction.Insert(&model.RetentionPolicyTeam{PolicyId: policy.Id, TeamId: teamId}); err != nil {
+			if IsUniqueConstraintError(err, []string{"TeamId", "PRIMARY", "retentionpoliciesteams_pkey"}) {
+				return store.NewErrConflict("RetentionPolicyTeam", err, "team_id="+teamId)
+			}
+			return errors.Wrapf(err, "failed to save RetentionPolicyTeam with policyId=%s and teamId=%s", policy.Id, teamId)
+		}
+	}
+
+	for _, channelId := range policy.ChannelIds {
+		if err := transaction.Insert(&model.RetentionPolicyChannel{PolicyId: policy.Id, ChannelId: channelId}); err != nil {
+			if IsUniqueConstraintError(err, []string{"ChannelId", "PRIMARY", "retentionpolicieschannels_pkey"}) {
+				return store.NewErrConflict("RetentionPolicyChannel", err, "channel_id="+channelId)
+			}
+			return errors.Wrapf(err, "failed to save RetentionPolicyChannel with policyId=%s and channelId=%s", policy.Id, channelId)
+		}
+	}
+
+	return nil
+}
+
+func (s SqlRetentionPolicyStore) deleteAssociationsT(transaction *gorp.Transaction, policyId string) error {
+	for _, table := range []string{"RetentionPoliciesTeams", "RetentionPoliciesChannels"} {
+		queryString, args, err := s.getQueryBuilder().
+			Delete(table).
+			Where(sq.Eq{"PolicyId": policyId}).
+			ToSql()
+		if err != nil {
+			return errors.Wrap(err, "retention_policy_associations_delete_tosql")
+		}
+
+		if _, err := transaction.Exec(queryString, args...); err != nil {
+			return errors.Wrapf(err, "failed to delete %s with policyId=%s", table, policyId)
+		}
+	}
+
+	return nil
+}
+
+func (s SqlRetentionPolicyStore) Get(id string) (*model.RetentionPolicy, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("*").
+		From("RetentionPolicies").
+		Where(sq.Eq{"Id": id}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "retention_policy_tosql")
+	}
+
+	var policy *model.RetentionPolicy
+	if err := s.GetReplica().SelectOne(&policy, queryString, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("RetentionPolicy", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get RetentionPolicy with id=%s", id)
+	}
+
+	if err := s.populateAssociations([]*model.RetentionPolicy{policy}); err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func (s SqlRetentionPolicyStore) GetAll(offset, limit int) ([]*model.RetentionPolicy, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("*").
+		From("RetentionPolicies").
+		OrderBy("DisplayName ASC", "Id ASC").
+		Limit(uint64(limit)).
+		Offset(uint64(offset)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "retention_policies_tosql")
+	}
+
+	policies := []*model.RetentionPolicy{}
+	if _, err := s.GetReplica().Select(&policies, queryString, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to find RetentionPolicies")
+	}
+
+	if err := s.populateAssociations(policies); err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+func (s SqlRetentionPolicyStore) GetForChannel(channelId string) (*model.RetentionPolicy, error) {
+	var policyId string
+	err := s.GetReplica().SelectOne(&policyId, `
+		SELECT COALESCE(
+			(SELECT PolicyId FROM RetentionPoliciesChannels WHERE ChannelId = :ChannelId),
+			(SELECT RetentionPoliciesTeams.PolicyId FROM RetentionPoliciesTeams
+				INNER JOIN Channels ON Channels.TeamId = RetentionPoliciesTeams.TeamId
+				WHERE Channels.Id = :ChannelId),
+			'')`, map[string]interface{}{"ChannelId": channelId})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find RetentionPolicy for channelId=%s", channelId)
+	}
+	if policyId == "" {
+		return nil, store.NewErrNotFound("RetentionPolicy", "channel_id="+channelId)
+	}
+
+	return s.Get(policyId)
+}
+
+func (s SqlRetentionPolicyStore) populateAssociations(policies []*model.RetentionPolicy) error {
+	if len(policies) == 0 {
+		return nil
+	}
+
+	policiesById := make(map[string]*model.RetentionPolicy, len(policies))
+	policyIds := make([]string, 0, len(policies))
+	for _, policy := range policies {
+		policy.TeamIds = []string{}
+		policy.ChannelIds = []string{}
+		policiesById[policy.Id] = policy
+		policyIds = append(policyIds, policy.Id)
+	}
+
+	queryString, args, err := s.getQueryBuilder().
+		Select("PolicyId", "TeamId").
+		From("RetentionPoliciesTeams").
+		Where(sq.Eq{"PolicyId": policyIds}).
+		OrderBy("TeamId").
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "retention_policy_teams_tosql")
+	}
+
+	var teams []*model.RetentionPolicyTeam
+	if _, err := s.GetReplica().Select(&teams, queryString, args...); err != nil {
+		return errors.Wrap(err, "failed to find RetentionPoliciesTeams")
+	}
+	for _, team := range teams {
+		policiesById[team.PolicyId].TeamIds = append(policiesById[team.PolicyId].TeamIds, team.TeamId)
+	}
+
+	queryString, args, err = s.getQueryBuilder().
+		Select("PolicyId", "ChannelId").
+		From("RetentionPoliciesChannels").
+		Where(sq.Eq{"PolicyId": policyIds}).
+		OrderBy("ChannelId").
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "retention_policy_channels_tosql")
+	}
+
+	var channels []*model.RetentionPolicyChannel
+	if _, err := s.GetReplica().Select(&channels, queryString, args...); err != nil {
+		return errors.Wrap(err, "failed to find RetentionPoliciesChannels")
+	}
+	for _, channel := range channels {
+		policiesById[channel.PolicyId].ChannelIds = append(policiesById[channel.PolicyId].ChannelIds, channel.ChannelId)
+	}
+
+	return nil
+}
+
+func (s SqlRetentionPolicyStore) Delete(id string) error {
+	transaction, err := s.GetMaster().Begin()
+	if err != nil {
+		return errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransaction(transaction)
+
+	queryString, args, err := s.getQueryBuilder().
+		Delete("RetentionPolicies").
+		Where(sq.Eq{"Id": id}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "retention_policy_delete_tosql")
+	}
+
+	result, err := transaction.Exec(queryString, args...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete RetentionPolicy with id=%s", id)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrapf(err, "failed to get rows affected for RetentionPolicy with id=%s", id)
+	}
+	if rowsAffected == 0 {
+		return store.NewErrNotFound("RetentionPolicy", id)
+	}
+
+	if err := s.deleteAssociationsT(transaction, id); err != nil {
+		return err
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return errors.Wrap(err, "commit_transaction")
+	}
+
+	return nil
+}
+
+// retentionPolicyScope returns the condition matching the channels governed by the given policy,
+// or the channels not governed by any policy when policyId is empty.
+func retentionPolicyScope(column string, policyId string) string {
+	if policyId == "" {
+		return column + " NOT IN (" + retentionPolicyAllChannelsQuery + ")"
+	}
+
+	return column + " IN (" + retentionPolicyChannelsQuery + ")"
+}
+
+type retentionBatchEntry struct {
+	Id       string
+	CreateAt int64
+}
+
+// permanentDeleteBatch selects the next batch of entries with the given keyset query and deletes them
+// by primary key, so that each statement only touches a bounded number of rows.
+func (s SqlRetentionPolicyStore) permanentDeleteBatch(table, selectQuery, policyId string, endTime int64, cursor model.RetentionPolicyCursor, limit int64) (int64, model.RetentionPolicyCursor, error) {
+	var entries []*retentionBatchEntry
+	if _, err := s.GetReplica().Select(&entries, selectQuery, map[string]interface{}{
+		"PolicyId":       policyId,
+		"EndTime":        endTime,
+		"CursorCreateAt": cursor.CreateAt,
+		"CursorId":       cursor.Id,
+		"Limit":          limit,
+	}); err != nil {
+		return 0, cursor, errors.Wrapf(err, "failed to find %s to delete for RetentionPolicy with id=%s", table, policyId)
+	}
+
+	if len(entries) == 0 {
+		return 0, cursor, nil
+	}
+
+	ids := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		ids = append(ids, entry.Id)
+	}
+
+	queryString, args, err := s.getQueryBuilder().
+		Delete(table).
+		Where(sq.Eq{"Id": ids}).
+		ToSql()
+	if err != nil {
+		return 0, cursor, errors.Wrap(err, "retention_policy_delete_batch_tosql")
+	}
+
+	sqlResult, err := s.GetMaster().Exec(queryString, args...)
+	if err != nil {
+		return 0, cursor, errors.Wrapf(err, "failed to delete %s for RetentionPolicy with id=%s", table, policyId)
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err != nil {
+		return 0, cursor, errors.Wrapf(err, "failed to get rows affected for RetentionPolicy with id=%s", policyId)
+	}
+
+	last := entries[len(entries)-1]
+	return rowsAffected, model.RetentionPolicyCursor{CreateAt: last.CreateAt, Id: last.Id}, nil
+}
+
+func (s SqlRetentionPolicyStore) PermanentDeletePostsBatch(policyId string, endTime int64, cursor model.RetentionPolicyCursor, limit int64) (int64, model.RetentionPolicyCursor, error) {
+	query := `
+		SELECT Id, CreateAt FROM Posts
+		WHERE CreateAt < :EndTime
+		AND (CreateAt > :CursorCreateAt OR (CreateAt = :CursorCreateAt AND Id > :CursorId))
+		AND ` + retentionPolicyScope("ChannelId", policyId) + `
+		ORDER BY CreateAt, Id
+		LIMIT :Limit`
+
+	return s.permanentDeleteBatch("Posts", query, policyId, endTime, cursor, limit)
+}
+
+func (s SqlRetentionPolicyStore) PermanentDeleteFilesBatch(policyId string, endTime int64, cursor model.RetentionPolicyCursor, limit int64) (int64, model.RetentionPolicyCursor, error) {
+	scope := retentionPolicyScope("Posts.ChannelId", policyId)
+	if policyId == "" {
+		scope = "(FileInfo.PostId = '' OR " + scope + ")"
+	}
+
+	query := `
+		SELECT FileInfo.Id, FileInfo.CreateAt FROM FileInfo
+		LEFT JOIN Posts ON FileInfo.PostId = Posts.Id
+		WHERE FileInfo.CreateAt < :EndTime
+		AND (FileInfo.CreateAt > :CursorCreateAt OR (FileInfo.CreateAt = :CursorCreateAt AND FileInfo.Id > :CursorId))
+		AND ` + scope + `
+		ORDER BY FileInfo.CreateAt, FileInfo.Id
+		LIMIT :Limit`
+
+	return s.permanentDeleteBatch("FileInfo", query, policyId, endTime, cursor, limit)
+}
+
+func (s SqlRetentionPolicyStore) PermanentDeleteAllFilesBatch(endTime int64, cursor model.RetentionPolicyCursor, limit int64) (int64, model.RetentionPolicyCursor, error) {
+	query := `
+		SELECT Id, CreateAt FROM FileInfo
+		WHERE CreateAt < :EndTime
+		AND (CreateAt > :CursorCreateAt OR (CreateAt = :CursorCreateAt AND Id > :CursorId))
+		ORDER BY CreateAt, Id
+		LIMIT :Limit`
+
+	return s.permanentDeleteBatch("FileInfo", query, "", endTime, cursor, limit)
+}
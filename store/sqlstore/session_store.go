@@ -4,6 +4,7 @@
 package sqlstore
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -57,7 +58,7 @@ func (me SqlSessionStore) Save(session *model.Session) (*model.Session, error) {
 		return nil, errors.Wrapf(err, "failed to save Session with id=%s", session.Id)
 	}
 
-	teamMembers, err := me.Team().GetTeamsForUser(session.UserId)
+	teamMembers, err := me.Team().GetTeamsForUser(context.Background(), session.UserId)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to find TeamMembers for Session with userId=%s", session.UserId)
 	}
@@ -82,7 +83,7 @@ func (me SqlSessionStore) Get(sessionIdOrToken string) (*model.Session, error) {
 	}
 	session := sessions[0]
 
-	tempMembers, err := me.Team().GetTeamsForUser(session.UserId)
+	tempMembers, err := me.Team().GetTeamsForUser(context.Background(), session.UserId)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to find TeamMembers for Session with userId=%s", session.UserId)
 	}
@@ -102,7 +103,7 @@ func (me SqlSessionStore) GetSessions(userId string) ([]*model.Session, error) {
 		return nil, errors.Wrapf(err, "failed to find Sessions with userId=%s", userId)
 	}
 
-	teamMembers, err := me.Team().GetTeamsForUser(userId)
+	teamMembers, err := me.Team().GetTeamsForUser(context.Background(), userId)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to find TeamMembers for Session with userId=%s", userId)
 	}
@@ -262,14 +263,25 @@ func (me SqlSessionStore) UpdateProps(session *model.Session) error {
 	return nil
 }
 
-func (me SqlSessionStore) AnalyticsSessionCount() (int64, error) {
-	query :=
-		`SELECT
-			COUNT(*)
-		FROM
-			Sessions
-		WHERE ExpiresAt > :Time`
-	count, err := me.GetReplica().SelectInt(query, map[string]interface{}{"Time": model.GetMillis()})
+func (me SqlSessionStore) AnalyticsSessionCount(estimate bool) (int64, error) {
+	exactCount := func() (int64, error) {
+		query :=
+			`SELECT
+				COUNT(*)
+			FROM
+				Sessions
+			WHERE ExpiresAt > :Time`
+		return me.GetReplica().SelectInt(query, map[string]interface{}{"Time": model.GetMillis()})
+	}
+
+	var count int64
+	var err error
+	if estimate {
+		// Expired sessions are only removed by the periodic cleanup, so they are part of the estimate.
+		count, err = estimateRowCountOrExact(me, "Sessions", exactCount)
+	} else {
+		count, err = exactCount()
+	}
 	if err != nil {
 		return int64(0), errors.Wrap(err, "failed to count Sessions")
 	}
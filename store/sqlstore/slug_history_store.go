@@ -0,0 +1,133 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+type SqlSlugHistoryStore struct {
+	SqlStore
+}
+
+func newSqlSlugHistoryStore(sqlStore SqlStore) store.SlugHistoryStore {
+	s := &SqlSlugHistoryStore{sqlStore}
+
+	for _, db := range sqlStore.GetAllConns() {
+		table := db.AddTableWithName(model.SlugHistory{}, "SlugHistory").SetKeys(false, "Kind", "ScopeId", "Name")
+		table.ColMap("Kind").SetMaxSize(16)
+		table.ColMap("ScopeId").SetMaxSize(26)
+		table.ColMap("Name").SetMaxSize(model.CHANNEL_NAME_MAX_LENGTH)
+		table.ColMap("TargetId").SetMaxSize(26)
+	}
+
+	return s
+}
+
+func (s SqlSlugHistoryStore) createIndexesIfNotExists() {
+	s.CreateIndexIfNotExists("idx_slughistory_target_id", "SlugHistory", "TargetId")
+}
+
+// Save records the name as given up by the target, replacing any previous record of the same name.
+func (s SqlSlugHistoryStore) Save(entry *model.SlugHistory) (*model.SlugHistory, error) {
+	entry.PreSave()
+	if err := entry.IsValid(); err != nil {
+		return nil, err
+	}
+
+	transaction, err := s.GetMaster().Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransaction(transaction)
+
+	queryString, args, err := s.deleteQuery(entry.Kind, entry.ScopeId, entry.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := transaction.Exec(queryString, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to replace SlugHistory with name=%s", entry.Name)
+	}
+
+	if err := transaction.Insert(entry); err != nil {
+		return nil, errors.Wrapf(err, "failed to save SlugHistory with name=%s", entry.Name)
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit_transaction")
+	}
+
+	return entry, nil
+}
+
+func (s SqlSlugHistoryStore) Get(kind, scopeId, name string) (*model.SlugHistory, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("*").
+		From("SlugHistory").
+		Where(sq.Eq{"Kind": kind, "ScopeId": scopeId, "Name": name}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "slug_history_tosql")
+	}
+
+	var entry *model.SlugHistory
+	if err := s.GetReplica().SelectOne(&entry, queryString, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("SlugHistory", name)
+		}
+		return nil, errors.Wrapf(err, "failed to get SlugHistory with name=%s", name)
+	}
+
+	return entry, nil
+}
+
+// Delete forgets the name, doing nothing if it was never given up.
+func (s SqlSlugHistoryStore) Delete(kind, scopeId, name string) error {
+	queryString, args, err := s.deleteQuery(kind, scopeId, name)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.GetMaster().Exec(queryString, args...); err != nil {
+		return errors.Wrapf(err, "failed to delete SlugHistory with name=%s", name)
+	}
+
+	return nil
+}
+
+func (s SqlSlugHistoryStore) deleteQuery(kind, scopeId, name string) (string, []interface{}, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Delete("SlugHistory").
+		Where(sq.Eq{"Kind": kind, "ScopeId": scopeId, "Name": name}).
+		ToSql()
+	if err != nil {
+		return "", nil, errors.Wrap(err, "slug_history_delete_tosql")
+	}
+
+	return queryString, args, nil
+}
+
+// PermanentDeleteByTarget forgets all the names given up by the target.
+func (s SqlSlugHistoryStore) PermanentDeleteByTarget(targetId string) error {
+	queryString, args, err := s.getQueryBuilder().
+		Delete("SlugHistory").
+		Where(sq.Eq{"TargetId": targetId}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "slug_history_delete_tosql")
+	}
+
+	if _, err := s.GetMaster().Exec(queryString, args...); err != nil {
+		return errors.Wrapf(err, "failed to delete SlugHistory with targetId=%s", targetId)
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+type SqlStatusAutomationStore struct {
+	SqlStore
+}
+
+func newSqlStatusAutomationStore(sqlStore SqlStore) store.StatusAutomationStore {
+	s := &SqlStatusAutomationStore{sqlStore}
+
+	for _, db := range sqlStore.GetAllConns() {
+		table := db.AddTableWithName(model.StatusAutomation{}, "StatusAutomations").SetKeys(false, "UserId")
+		table.ColMap("UserId").SetMaxSize(26)
+		table.ColMap("Source").SetMaxSize(model.STATUS_AUTOMATION_SOURCE_MAX_RUNES)
+		table.ColMap("PreviousStatus").SetMaxSize(32)
+	}
+
+	return s
+}
+
+func (s SqlStatusAutomationStore) createIndexesIfNotExists() {
+	s.CreateIndexIfNotExists("idx_statusautomations_end_at", "StatusAutomations", "EndAt")
+}
+
+// Save records the automation of the status of a user. It returns a store.ErrConflict if the
+// status of the user is already automated.
+func (s SqlStatusAutomationStore) Save(automation *model.StatusAutomation) (*model.StatusAutomation, error) {
+	automation.PreSave()
+	if err := automation.IsValid(); err != nil {
+		return nil, err
+	}
+
+	if err := s.GetMaster().Insert(automation); err != nil {
+		if IsUniqueConstraintError(err, []string{"PRIMARY", "statusautomations_pkey"}) {
+			return nil, store.NewErrConflict("StatusAutomation", err, "user_id="+automation.UserId)
+		}
+		return nil, errors.Wrapf(err, "failed to save StatusAutomation with user_id=%s", automation.UserId)
+	}
+
+	return automation, nil
+}
+
+func (s SqlStatusAutomationStore) Update(automation *model.StatusAutomation) (*model.StatusAutomation, error) {
+	if err := automation.IsValid(); err != nil {
+		return nil, err
+	}
+
+	count, err := s.GetMaster().Update(automation)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update StatusAutomation with user_id=%s", automation.UserId)
+	}
+	if count == 0 {
+		return nil, store.NewErrNotFound("StatusAutomation", automation.UserId)
+	}
+
+	return automation, nil
+}
+
+func (s SqlStatusAutomationStore) Get(userId string) (*model.StatusAutomation, error) {
+	var automation model.StatusAutomation
+	if err := s.GetMaster().SelectOne(&automation, "SELECT * FROM StatusAutomations WHERE UserId = :UserId", map[string]interface{}{"UserId": userId}); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("StatusAutomation", userId)
+		}
+		return nil, errors.Wrapf(err, "failed to get StatusAutomation with user_id=%s", userId)
+	}
+
+	return &automation, nil
+}
+
+// GetEndedBefore returns up to limit automations which ended before endTime, oldest first.
+func (s SqlStatusAutomationStore) GetEndedBefore(endTime int64, limit int) ([]*model.StatusAutomation, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("*").
+		From("StatusAutomations").
+		Where(sq.Lt{"EndAt": endTime}).
+		OrderBy("EndAt ASC", "UserId ASC").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "status_automations_tosql")
+	}
+
+	automations := []*model.StatusAutomation{}
+	if _, err := s.GetMaster().Select(&automations, queryString, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to find StatusAutomations ended before %d", endTime)
+	}
+
+	return automations, nil
+}
+
+// Delete removes the automation of the status of the user, returning a store.ErrNotFound if
+// there is none.
+func (s SqlStatusAutomationStore) Delete(userId string) error {
+	result, err := s.GetMaster().Exec("DELETE FROM StatusAutomations WHERE UserId = :UserId", map[string]interface{}{"UserId": userId})
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete StatusAutomation with user_id=%s", userId)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrapf(err, "failed to get rows affected for StatusAutomation with user_id=%s", userId)
+	}
+	if rowsAffected == 0 {
+		return store.NewErrNotFound("StatusAutomation", userId)
+	}
+
+	return nil
+}
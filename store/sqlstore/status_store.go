@@ -6,6 +6,7 @@ package sqlstore
 import (
 	"database/sql"
 	"net/http"
+	"sort"
 	"strings"
 
 	sq "github.com/Masterminds/squirrel"
@@ -136,3 +137,38 @@ func (s SqlStatusStore) UpdateLastActivityAt(userId string, lastActivityAt int64
 
 	return nil
 }
+
+// UpdateLastActivityAts sets the LastActivityAt of the users in a single statement. A LastActivityAt
+// is never moved back, so that delayed updates don't undo more recent ones.
+func (s SqlStatusStore) UpdateLastActivityAts(lastActivityAts map[string]int64) *model.AppError {
+	if len(lastActivityAts) == 0 {
+		return nil
+	}
+
+	// Update the rows in a consistent order to avoid deadlocks between concurrent updates.
+	userIds := make([]string, 0, len(lastActivityAts))
+	for userId := range lastActivityAts {
+		userIds = append(userIds, userId)
+	}
+	sort.Strings(userIds)
+
+	lastActivityAt := sq.Case("UserId")
+	for _, userId := range userIds {
+		lastActivityAt = lastActivityAt.When(sq.Expr("?", userId), sq.Expr("?", lastActivityAts[userId]))
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Update("Status").
+		Set("LastActivityAt", sq.Expr("GREATEST(LastActivityAt, ?)", lastActivityAt)).
+		Where(sq.Eq{"UserId": userIds}).
+		ToSql()
+	if err != nil {
+		return model.NewAppError("SqlStatusStore.UpdateLastActivityAts", "store.sql.build_query.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	if _, err := s.GetMaster().Exec(query, args...); err != nil {
+		return model.NewAppError("SqlStatusStore.UpdateLastActivityAts", "store.sql_status.update_last_activity_at.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return nil
+}
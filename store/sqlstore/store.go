@@ -53,6 +53,7 @@ type SqlStore interface {
 	DoesTableExist(tablename string) bool
 	DoesColumnExist(tableName string, columName string) bool
 	DoesTriggerExist(triggerName string) bool
+	DoesForeignKeyExist(tableName string, constraintName string) bool
 	CreateColumnIfNotExists(tableName string, columnName string, mySqlColType string, postgresColType string, defaultValue string) bool
 	CreateColumnIfNotExistsNoDefault(tableName string, columnName string, mySqlColType string, postgresColType string) bool
 	RemoveColumnIfExists(tableName string, columnName string) bool
@@ -68,6 +69,7 @@ type SqlStore interface {
 	CreateUniqueCompositeIndexIfNotExists(indexName string, tableName string, columnNames []string) bool
 	CreateFullTextIndexIfNotExists(indexName string, tableName string, columnName string) bool
 	RemoveIndexIfExists(indexName string, tableName string) bool
+	CreateForeignKeyIfNotExists(constraintName string, tableName string, columnName string, refTableName string, refColumnName string, onDelete string) bool
 	GetAllConns() []*gorp.DbMap
 	Close()
 	LockToMaster()
@@ -101,5 +103,27 @@ type SqlStore interface {
 	TermsOfService() store.TermsOfServiceStore
 	UserTermsOfService() store.UserTermsOfServiceStore
 	LinkMetadata() store.LinkMetadataStore
+	ChannelBookmark() store.ChannelBookmarkStore
+	RetentionPolicy() store.RetentionPolicyStore
+	PostArchive() store.PostArchiveStore
+	PostsPartition() store.PostsPartitionStore
+	PresenceWebhook() store.PresenceWebhookStore
+	SlugHistory() store.SlugHistoryStore
+	TeamInviteLink() store.TeamInviteLinkStore
+	ChannelGuestLink() store.ChannelGuestLinkStore
+	TermsOfServicePolicy() store.TermsOfServicePolicyStore
+	UserProperty() store.UserPropertyStore
+	LoginHistory() store.LoginHistoryStore
+	EmailVerification() store.EmailVerificationStore
+	EventStream() store.EventStreamStore
+	Outbox() store.OutboxStore
+	ChannelChange() store.ChannelChangeStore
+	PendingPin() store.PendingPinStore
+	ChannelIntegration() store.ChannelIntegrationStore
+	IntegrationUsage() store.IntegrationUsageStore
+	UserRelationship() store.UserRelationshipStore
+	PostAcknowledgement() store.PostAcknowledgementStore
+	StatusAutomation() store.StatusAutomationStore
+	PostIntegrity() store.PostIntegrityStore
 	getQueryBuilder() sq.StatementBuilderType
 }
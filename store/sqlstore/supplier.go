@@ -66,6 +66,8 @@ const (
 	EXIT_TABLE_EXISTS_SQLITE         = 137
 	EXIT_DOES_COLUMN_EXISTS_SQLITE   = 138
 	EXIT_ALTER_PRIMARY_KEY           = 139
+	EXIT_PREFLIGHT                   = 140
+	EXIT_CREATE_FOREIGN_KEY          = 141
 )
 
 type SqlSupplierStores struct {
@@ -94,28 +96,56 @@ type SqlSupplierStores struct {
 	userAccessToken      store.UserAccessTokenStore
 	plugin               store.PluginStore
 	channelMemberHistory store.ChannelMemberHistoryStore
+	teamMemberHistory    store.TeamMemberHistoryStore
 	role                 store.RoleStore
 	scheme               store.SchemeStore
 	TermsOfService       store.TermsOfServiceStore
 	group                store.GroupStore
 	UserTermsOfService   store.UserTermsOfServiceStore
 	linkMetadata         store.LinkMetadataStore
+	channelBookmark      store.ChannelBookmarkStore
+	retentionPolicy      store.RetentionPolicyStore
+	postArchive          store.PostArchiveStore
+	postsPartition       store.PostsPartitionStore
+	presenceWebhook      store.PresenceWebhookStore
+	slugHistory          store.SlugHistoryStore
+	teamInviteLink       store.TeamInviteLinkStore
+	channelGuestLink     store.ChannelGuestLinkStore
+	termsOfServicePolicy store.TermsOfServicePolicyStore
+	userProperty         store.UserPropertyStore
+	loginHistory         store.LoginHistoryStore
+	emailVerification    store.EmailVerificationStore
+	eventStream          store.EventStreamStore
+	outbox               store.OutboxStore
+	channelChange        store.ChannelChangeStore
+	pendingPin           store.PendingPinStore
+	channelIntegration   store.ChannelIntegrationStore
+	integrationUsage     store.IntegrationUsageStore
+	userRelationship     store.UserRelationshipStore
+	postAcknowledgement  store.PostAcknowledgementStore
+	statusAutomation     store.StatusAutomationStore
+	postIntegrity        store.PostIntegrityStore
 }
 
 type SqlSupplier struct {
 	// rrCounter and srCounter should be kept first.
 	// See https://github.com/mattermost/mattermost-server/v5/pull/7281
-	rrCounter      int64
-	srCounter      int64
-	master         *gorp.DbMap
-	replicas       []*gorp.DbMap
-	searchReplicas []*gorp.DbMap
-	stores         SqlSupplierStores
-	settings       *model.SqlSettings
-	lockedToMaster bool
-	context        context.Context
-	license        *model.License
-	licenseMutex   sync.Mutex
+	rrCounter             int64
+	srCounter             int64
+	fallbackCounter       int64
+	master                *gorp.DbMap
+	replicas              []*gorp.DbMap
+	searchReplicas        []*gorp.DbMap
+	replicaBreakers       []*replicaBreaker
+	searchReplicaBreakers []*replicaBreaker
+	replicaChecksStop     chan struct{}
+	replicaChecksDone     chan struct{}
+	stores                SqlSupplierStores
+	settings              *model.SqlSettings
+	lockedToMaster        bool
+	context               context.Context
+	license               *model.License
+	licenseMutex          sync.Mutex
 }
 
 type TraceOnAdapter struct{}
@@ -137,9 +167,15 @@ func NewSqlSupplier(settings model.SqlSettings, metrics einterfaces.MetricsInter
 
 	supplier.initConnection()
 
+	if err := supplier.runPreflightChecks(); err != nil {
+		mlog.Critical("Database pre-flight checks failed, server will exit.", mlog.Err(err))
+		time.Sleep(time.Second)
+		os.Exit(EXIT_PREFLIGHT)
+	}
+
 	supplier.stores.team = newSqlTeamStore(supplier)
 	supplier.stores.channel = newSqlChannelStore(supplier, metrics)
-	supplier.stores.post = newSqlPostStore(supplier, metrics)
+	supplier.stores.post = newSqlPostStore(supplier, metrics, *supplier.settings.EnableNgramSearch)
 	supplier.stores.user = newSqlUserStore(supplier, metrics)
 	supplier.stores.bot = newSqlBotStore(supplier, metrics)
 	supplier.stores.audit = newSqlAuditStore(supplier)
@@ -160,10 +196,33 @@ func NewSqlSupplier(settings model.SqlSettings, metrics einterfaces.MetricsInter
 	supplier.stores.job = newSqlJobStore(supplier)
 	supplier.stores.userAccessToken = newSqlUserAccessTokenStore(supplier)
 	supplier.stores.channelMemberHistory = newSqlChannelMemberHistoryStore(supplier)
+	supplier.stores.teamMemberHistory = newSqlTeamMemberHistoryStore(supplier)
 	supplier.stores.plugin = newSqlPluginStore(supplier)
 	supplier.stores.TermsOfService = newSqlTermsOfServiceStore(supplier, metrics)
 	supplier.stores.UserTermsOfService = newSqlUserTermsOfServiceStore(supplier)
 	supplier.stores.linkMetadata = newSqlLinkMetadataStore(supplier)
+	supplier.stores.channelBookmark = newSqlChannelBookmarkStore(supplier)
+	supplier.stores.retentionPolicy = newSqlRetentionPolicyStore(supplier)
+	supplier.stores.postArchive = newSqlPostArchiveStore(supplier)
+	supplier.stores.postsPartition = newSqlPostsPartitionStore(supplier)
+	supplier.stores.presenceWebhook = newSqlPresenceWebhookStore(supplier)
+	supplier.stores.slugHistory = newSqlSlugHistoryStore(supplier)
+	supplier.stores.teamInviteLink = newSqlTeamInviteLinkStore(supplier)
+	supplier.stores.channelGuestLink = newSqlChannelGuestLinkStore(supplier)
+	supplier.stores.termsOfServicePolicy = newSqlTermsOfServicePolicyStore(supplier)
+	supplier.stores.userProperty = newSqlUserPropertyStore(supplier)
+	supplier.stores.loginHistory = newSqlLoginHistoryStore(supplier)
+	supplier.stores.emailVerification = newSqlEmailVerificationStore(supplier)
+	supplier.stores.eventStream = newSqlEventStreamStore(supplier)
+	supplier.stores.outbox = newSqlOutboxStore(supplier)
+	supplier.stores.channelChange = newSqlChannelChangeStore(supplier)
+	supplier.stores.pendingPin = newSqlPendingPinStore(supplier)
+	supplier.stores.channelIntegration = newSqlChannelIntegrationStore(supplier)
+	supplier.stores.integrationUsage = newSqlIntegrationUsageStore(supplier)
+	supplier.stores.userRelationship = newSqlUserRelationshipStore(supplier)
+	supplier.stores.postAcknowledgement = newSqlPostAcknowledgementStore(supplier)
+	supplier.stores.statusAutomation = newSqlStatusAutomationStore(supplier)
+	supplier.stores.postIntegrity = newSqlPostIntegrityStore(supplier)
 	supplier.stores.reaction = newSqlReactionStore(supplier)
 	supplier.stores.role = newSqlRoleStore(supplier)
 	supplier.stores.scheme = newSqlSchemeStore(supplier)
@@ -176,7 +235,10 @@ func NewSqlSupplier(settings model.SqlSettings, metrics einterfaces.MetricsInter
 		os.Exit(EXIT_CREATE_TABLE)
 	}
 
-	err = upgradeDatabase(supplier, model.CurrentVersion)
+	coordinator := newMigrationCoordinator(supplier, time.Duration(*settings.MigrationLockTimeoutSeconds)*time.Second, model.CurrentVersion)
+	err = coordinator.run(func() error {
+		return upgradeDatabase(supplier, model.CurrentVersion)
+	})
 	if err != nil {
 		mlog.Critical("Failed to upgrade database.", mlog.Err(err))
 		time.Sleep(time.Second)
@@ -208,6 +270,26 @@ func NewSqlSupplier(settings model.SqlSettings, metrics einterfaces.MetricsInter
 	supplier.stores.TermsOfService.(SqlTermsOfServiceStore).createIndexesIfNotExists()
 	supplier.stores.UserTermsOfService.(SqlUserTermsOfServiceStore).createIndexesIfNotExists()
 	supplier.stores.linkMetadata.(*SqlLinkMetadataStore).createIndexesIfNotExists()
+	supplier.stores.channelBookmark.(*SqlChannelBookmarkStore).createIndexesIfNotExists()
+	supplier.stores.retentionPolicy.(*SqlRetentionPolicyStore).createIndexesIfNotExists()
+	supplier.stores.postArchive.(*SqlPostArchiveStore).createIndexesIfNotExists()
+	supplier.stores.presenceWebhook.(*SqlPresenceWebhookStore).createIndexesIfNotExists()
+	supplier.stores.slugHistory.(*SqlSlugHistoryStore).createIndexesIfNotExists()
+	supplier.stores.teamInviteLink.(*SqlTeamInviteLinkStore).createIndexesIfNotExists()
+	supplier.stores.channelGuestLink.(*SqlChannelGuestLinkStore).createIndexesIfNotExists()
+	supplier.stores.termsOfServicePolicy.(*SqlTermsOfServicePolicyStore).createIndexesIfNotExists()
+	supplier.stores.userProperty.(*SqlUserPropertyStore).createIndexesIfNotExists()
+	supplier.stores.loginHistory.(*SqlLoginHistoryStore).createIndexesIfNotExists()
+	supplier.stores.emailVerification.(*SqlEmailVerificationStore).createIndexesIfNotExists()
+	supplier.stores.eventStream.(*SqlEventStreamStore).createIndexesIfNotExists()
+	supplier.stores.outbox.(*SqlOutboxStore).createIndexesIfNotExists()
+	supplier.stores.pendingPin.(*SqlPendingPinStore).createIndexesIfNotExists()
+	supplier.stores.channelIntegration.(*SqlChannelIntegrationStore).createIndexesIfNotExists()
+	supplier.stores.integrationUsage.(*SqlIntegrationUsageStore).createIndexesIfNotExists()
+	supplier.stores.userRelationship.(*SqlUserRelationshipStore).createIndexesIfNotExists()
+	supplier.stores.postAcknowledgement.(*SqlPostAcknowledgementStore).createIndexesIfNotExists()
+	supplier.stores.statusAutomation.(*SqlStatusAutomationStore).createIndexesIfNotExists()
+	supplier.stores.postIntegrity.(*SqlPostIntegrityStore).createIndexesIfNotExists()
 	supplier.stores.group.(*SqlGroupStore).createIndexesIfNotExists()
 	supplier.stores.scheme.(*SqlSchemeStore).createIndexesIfNotExists()
 	supplier.stores.preference.(*SqlPreferenceStore).deleteUnusedFeatures()
@@ -242,6 +324,33 @@ func setupConnection(con_type string, dataSource string, settings *model.SqlSett
 		}
 	}
 
+	return newDbMap(db, settings)
+}
+
+// setupReplicaConnection connects to a read replica without waiting for it to be reachable, its
+// breaker starting open when it isn't so that reads go elsewhere until the health checks close it.
+func setupReplicaConnection(con_type string, dataSource string, settings *model.SqlSettings) *replicaBreaker {
+	db, err := dbsql.Open(*settings.DriverName, dataSource)
+	if err != nil {
+		mlog.Critical("Failed to open SQL connection to err.", mlog.Err(err))
+		time.Sleep(time.Second)
+		os.Exit(EXIT_DB_OPEN)
+	}
+
+	breaker := newReplicaBreaker(con_type, newDbMap(db, settings))
+
+	mlog.Info("Pinging SQL", mlog.String("database", con_type))
+	ctx, cancel := context.WithTimeout(context.Background(), DB_PING_TIMEOUT_SECS*time.Second)
+	defer cancel()
+	if err := db.PingContext(ctx); err != nil {
+		mlog.Warn("Read replica is unreachable, starting without it.", mlog.String("replica", con_type), mlog.Err(err))
+		breaker.recordCheck(err, 1, time.Now())
+	}
+
+	return breaker
+}
+
+func newDbMap(db *dbsql.DB, settings *model.SqlSettings) *gorp.DbMap {
 	db.SetMaxIdleConns(*settings.MaxIdleConns)
 	db.SetMaxOpenConns(*settings.MaxOpenConns)
 	db.SetConnMaxLifetime(time.Duration(*settings.ConnMaxLifetimeMilliseconds) * time.Millisecond)
@@ -280,6 +389,11 @@ func (ss *SqlSupplier) Context() context.Context {
 func (ss *SqlSupplier) initConnection() {
 	ss.master = setupConnection("master", *ss.settings.DataSource, ss.settings)
 
+	if *ss.settings.EnableReplicaFallback {
+		ss.initReplicaBreakers()
+		return
+	}
+
 	if len(ss.settings.DataSourceReplicas) > 0 {
 		ss.replicas = make([]*gorp.DbMap, len(ss.settings.DataSourceReplicas))
 		for i, replica := range ss.settings.DataSourceReplicas {
@@ -295,6 +409,22 @@ func (ss *SqlSupplier) initConnection() {
 	}
 }
 
+func (ss *SqlSupplier) initReplicaBreakers() {
+	for i, replica := range ss.settings.DataSourceReplicas {
+		breaker := setupReplicaConnection(fmt.Sprintf("replica-%v", i), replica, ss.settings)
+		ss.replicaBreakers = append(ss.replicaBreakers, breaker)
+		ss.replicas = append(ss.replicas, breaker.db)
+	}
+
+	for i, replica := range ss.settings.DataSourceSearchReplicas {
+		breaker := setupReplicaConnection(fmt.Sprintf("search-replica-%v", i), replica, ss.settings)
+		ss.searchReplicaBreakers = append(ss.searchReplicaBreakers, breaker)
+		ss.searchReplicas = append(ss.searchReplicas, breaker.db)
+	}
+
+	ss.startReplicaHealthChecks()
+}
+
 func (ss *SqlSupplier) DriverName() string {
 	return *ss.settings.DriverName
 }
@@ -338,6 +468,13 @@ func (ss *SqlSupplier) GetSearchReplica() *gorp.DbMap {
 		return ss.GetReplica()
 	}
 
+	if len(ss.searchReplicaBreakers) > 0 {
+		if !anyReplicaAvailable(ss.searchReplicaBreakers) {
+			return ss.GetReplica()
+		}
+		return ss.pickReplica(ss.searchReplicaBreakers, &ss.srCounter)
+	}
+
 	rrNum := atomic.AddInt64(&ss.srCounter, 1) % int64(len(ss.searchReplicas))
 	return ss.searchReplicas[rrNum]
 }
@@ -347,6 +484,10 @@ func (ss *SqlSupplier) GetReplica() *gorp.DbMap {
 		return ss.GetMaster()
 	}
 
+	if len(ss.replicaBreakers) > 0 {
+		return ss.pickReplica(ss.replicaBreakers, &ss.rrCounter)
+	}
+
 	rrNum := atomic.AddInt64(&ss.rrCounter, 1) % int64(len(ss.replicas))
 	return ss.replicas[rrNum]
 }
@@ -936,6 +1077,69 @@ func (ss *SqlSupplier) createIndexIfNotExists(indexName string, tableName string
 	return true
 }
 
+// DoesForeignKeyExist reports whether tableName has the foreign key constraint. SQLite can't add
+// constraints to existing tables, so none are reported there.
+func (ss *SqlSupplier) DoesForeignKeyExist(tableName string, constraintName string) bool {
+	var count int64
+	var err error
+
+	switch ss.DriverName() {
+	case model.DATABASE_DRIVER_POSTGRES:
+		count, err = ss.GetMaster().SelectInt(
+			`SELECT COUNT(0)
+			FROM   information_schema.table_constraints
+			WHERE  constraint_type = 'FOREIGN KEY'
+			AND    table_name = $1
+			AND    constraint_name = $2`,
+			strings.ToLower(tableName),
+			strings.ToLower(constraintName),
+		)
+	case model.DATABASE_DRIVER_MYSQL:
+		count, err = ss.GetMaster().SelectInt(
+			`SELECT COUNT(0)
+			FROM   information_schema.TABLE_CONSTRAINTS
+			WHERE  CONSTRAINT_SCHEMA = DATABASE()
+			AND    CONSTRAINT_TYPE = 'FOREIGN KEY'
+			AND    TABLE_NAME = ?
+			AND    CONSTRAINT_NAME = ?`,
+			tableName,
+			constraintName,
+		)
+	case model.DATABASE_DRIVER_SQLITE:
+		return false
+	default:
+		mlog.Critical("Failed to check foreign key because of missing driver")
+		time.Sleep(time.Second)
+		os.Exit(EXIT_CREATE_FOREIGN_KEY)
+	}
+
+	if err != nil {
+		mlog.Critical("Failed to check foreign key", mlog.Err(err))
+		time.Sleep(time.Second)
+		os.Exit(EXIT_CREATE_FOREIGN_KEY)
+	}
+
+	return count > 0
+}
+
+// CreateForeignKeyIfNotExists makes columnName of tableName reference refColumnName of refTableName,
+// applying onDelete, such as CASCADE, when the referenced row is deleted. SQLite is left alone.
+func (ss *SqlSupplier) CreateForeignKeyIfNotExists(constraintName string, tableName string, columnName string, refTableName string, refColumnName string, onDelete string) bool {
+	if ss.DriverName() == model.DATABASE_DRIVER_SQLITE || ss.DoesForeignKeyExist(tableName, constraintName) {
+		return false
+	}
+
+	_, err := ss.GetMaster().ExecNoTimeout("ALTER TABLE " + tableName + " ADD CONSTRAINT " + constraintName +
+		" FOREIGN KEY (" + columnName + ") REFERENCES " + refTableName + " (" + refColumnName + ") ON DELETE " + onDelete)
+	if err != nil {
+		mlog.Critical("Failed to create foreign key", mlog.String("constraint", constraintName), mlog.Err(err))
+		time.Sleep(time.Second)
+		os.Exit(EXIT_CREATE_FOREIGN_KEY)
+	}
+
+	return true
+}
+
 func (ss *SqlSupplier) RemoveIndexIfExists(indexName string, tableName string) bool {
 
 	if ss.DriverName() == model.DATABASE_DRIVER_POSTGRES {
@@ -1034,6 +1238,7 @@ func (ss *SqlSupplier) RecycleDBConnections(d time.Duration) {
 }
 
 func (ss *SqlSupplier) Close() {
+	ss.stopReplicaHealthChecks()
 	ss.master.Db.Close()
 	for _, replica := range ss.replicas {
 		replica.Db.Close()
@@ -1144,6 +1349,10 @@ func (ss *SqlSupplier) ChannelMemberHistory() store.ChannelMemberHistoryStore {
 	return ss.stores.channelMemberHistory
 }
 
+func (ss *SqlSupplier) TeamMemberHistory() store.TeamMemberHistoryStore {
+	return ss.stores.teamMemberHistory
+}
+
 func (ss *SqlSupplier) Plugin() store.PluginStore {
 	return ss.stores.plugin
 }
@@ -1172,6 +1381,94 @@ func (ss *SqlSupplier) LinkMetadata() store.LinkMetadataStore {
 	return ss.stores.linkMetadata
 }
 
+func (ss *SqlSupplier) ChannelBookmark() store.ChannelBookmarkStore {
+	return ss.stores.channelBookmark
+}
+
+func (ss *SqlSupplier) RetentionPolicy() store.RetentionPolicyStore {
+	return ss.stores.retentionPolicy
+}
+
+func (ss *SqlSupplier) PostArchive() store.PostArchiveStore {
+	return ss.stores.postArchive
+}
+
+func (ss *SqlSupplier) PostsPartition() store.PostsPartitionStore {
+	return ss.stores.postsPartition
+}
+
+func (ss *SqlSupplier) EventStream() store.EventStreamStore {
+	return ss.stores.eventStream
+}
+
+func (ss *SqlSupplier) Outbox() store.OutboxStore {
+	return ss.stores.outbox
+}
+
+func (ss *SqlSupplier) ChannelChange() store.ChannelChangeStore {
+	return ss.stores.channelChange
+}
+
+func (ss *SqlSupplier) PendingPin() store.PendingPinStore {
+	return ss.stores.pendingPin
+}
+
+func (ss *SqlSupplier) ChannelIntegration() store.ChannelIntegrationStore {
+	return ss.stores.channelIntegration
+}
+
+func (ss *SqlSupplier) IntegrationUsage() store.IntegrationUsageStore {
+	return ss.stores.integrationUsage
+}
+
+func (ss *SqlSupplier) UserRelationship() store.UserRelationshipStore {
+	return ss.stores.userRelationship
+}
+
+func (ss *SqlSupplier) PostAcknowledgement() store.PostAcknowledgementStore {
+	return ss.stores.postAcknowledgement
+}
+
+func (ss *SqlSupplier) StatusAutomation() store.StatusAutomationStore {
+	return ss.stores.statusAutomation
+}
+
+func (ss *SqlSupplier) PostIntegrity() store.PostIntegrityStore {
+	return ss.stores.postIntegrity
+}
+
+func (ss *SqlSupplier) PresenceWebhook() store.PresenceWebhookStore {
+	return ss.stores.presenceWebhook
+}
+
+func (ss *SqlSupplier) SlugHistory() store.SlugHistoryStore {
+	return ss.stores.slugHistory
+}
+
+func (ss *SqlSupplier) TeamInviteLink() store.TeamInviteLinkStore {
+	return ss.stores.teamInviteLink
+}
+
+func (ss *SqlSupplier) ChannelGuestLink() store.ChannelGuestLinkStore {
+	return ss.stores.channelGuestLink
+}
+
+func (ss *SqlSupplier) TermsOfServicePolicy() store.TermsOfServicePolicyStore {
+	return ss.stores.termsOfServicePolicy
+}
+
+func (ss *SqlSupplier) UserProperty() store.UserPropertyStore {
+	return ss.stores.userProperty
+}
+
+func (ss *SqlSupplier) LoginHistory() store.LoginHistoryStore {
+	return ss.stores.loginHistory
+}
+
+func (ss *SqlSupplier) EmailVerification() store.EmailVerificationStore {
+	return ss.stores.emailVerification
+}
+
 func (ss *SqlSupplier) DropAllTables() {
 	ss.master.TruncateTables()
 }
@@ -1211,6 +1508,12 @@ func (me mattermConverter) ToDb(val interface{}) (interface{}, error) {
 		return model.StringInterfaceToJson(t), nil
 	case map[string]interface{}:
 		return model.StringInterfaceToJson(model.StringInterface(t)), nil
+	case *model.AutocompleteData:
+		if t == nil {
+			return "", nil
+		}
+		b, err := t.ToJSON()
+		return string(b), err
 	case JSONSerializable:
 		return t.ToJson(), nil
 	case *opengraph.OpenGraph:
@@ -1272,6 +1575,23 @@ func (me mattermConverter) FromDb(target interface{}) (gorp.CustomScanner, bool)
 			return json.Unmarshal(b, target)
 		}
 		return gorp.CustomScanner{Holder: new(string), Target: target, Binder: binder}, true
+	case **model.AutocompleteData:
+		binder := func(holder, target interface{}) error {
+			s, ok := holder.(*dbsql.NullString)
+			if !ok {
+				return errors.New(utils.T("store.sql.convert_autocomplete_data"))
+			}
+			if !s.Valid || s.String == "" {
+				return nil
+			}
+			data, err := model.AutocompleteDataFromJSON([]byte(s.String))
+			if err != nil {
+				return err
+			}
+			*(target.(**model.AutocompleteData)) = data
+			return nil
+		}
+		return gorp.CustomScanner{Holder: new(dbsql.NullString), Target: target, Binder: binder}, true
 	}
 
 	return gorp.CustomScanner{}, false
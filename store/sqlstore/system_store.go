@@ -80,6 +80,22 @@ func (s SqlSystemStore) GetByName(name string) (*model.System, *model.AppError)
 	return &system, nil
 }
 
+// IsDatabaseReadOnly reports whether the master refuses writes, as it does while a failover is
+// in progress.
+func (s SqlSystemStore) IsDatabaseReadOnly() (bool, *model.AppError) {
+	query := "SELECT @@global.read_only"
+	if s.DriverName() == model.DATABASE_DRIVER_POSTGRES {
+		query = "SHOW transaction_read_only"
+	}
+
+	value, err := s.GetMaster().SelectStr(query)
+	if err != nil {
+		return false, model.NewAppError("SqlSystemStore.IsDatabaseReadOnly", "store.sql_system.is_database_read_only.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return value == "1" || value == "on", nil
+}
+
 func (s SqlSystemStore) PermanentDeleteByName(name string) (*model.System, *model.AppError) {
 	var system model.System
 	if _, err := s.GetMaster().Exec("DELETE FROM Systems WHERE Name = :Name", map[string]interface{}{"Name": name}); err != nil {
@@ -0,0 +1,206 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+type SqlTeamInviteLinkStore struct {
+	SqlStore
+}
+
+func newSqlTeamInviteLinkStore(sqlStore SqlStore) store.TeamInviteLinkStore {
+	s := &SqlTeamInviteLinkStore{sqlStore}
+
+	for _, db := range sqlStore.GetAllConns() {
+		table := db.AddTableWithName(model.TeamInviteLink{}, "TeamInviteLinks").SetKeys(false, "Id")
+		table.ColMap("Id").SetMaxSize(26)
+		table.ColMap("TeamId").SetMaxSize(26)
+		table.ColMap("CreatorId").SetMaxSize(26)
+		table.ColMap("Name").SetMaxSize(model.TEAM_INVITE_LINK_NAME_MAX_LENGTH)
+		table.ColMap("DisplayName").SetMaxSize(model.TEAM_INVITE_LINK_DISPLAY_NAME_MAX_RUNES * 4)
+		table.ColMap("ChannelIds").SetMaxSize(model.TEAM_INVITE_LINK_CHANNEL_IDS_MAX * 30)
+		table.ColMap("Role").SetMaxSize(64)
+		// Revoked links keep their name, without preventing a new link from taking it.
+		table.SetUniqueTogether("Name", "DeleteAt")
+	}
+
+	return s
+}
+
+func (s SqlTeamInviteLinkStore) createIndexesIfNotExists() {
+	s.CreateIndexIfNotExists("idx_teaminvitelinks_team_id", "TeamInviteLinks", "TeamId")
+}
+
+func (s SqlTeamInviteLinkStore) Save(link *model.TeamInviteLink) (*model.TeamInviteLink, error) {
+	if link.Id != "" {
+		return nil, store.NewErrInvalidInput("TeamInviteLink", "id", link.Id)
+	}
+
+	link.PreSave()
+	if err := link.IsValid(); err != nil {
+		return nil, err
+	}
+
+	if err := s.GetMaster().Insert(link); err != nil {
+		if IsUniqueConstraintError(err, []string{"Name", "teaminvitelinks_name_deleteat_key"}) {
+			return nil, store.NewErrConflict("TeamInviteLink", err, "name="+link.Name)
+		}
+		return nil, errors.Wrapf(err, "failed to save TeamInviteLink with id=%s", link.Id)
+	}
+
+	return link, nil
+}
+
+func (s SqlTeamInviteLinkStore) getBy(where sq.Eq, key string) (*model.TeamInviteLink, error) {
+	where["DeleteAt"] = 0
+	queryString, args, err := s.getQueryBuilder().
+		Select("*").
+		From("TeamInviteLinks").
+		Where(where).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_invite_link_tosql")
+	}
+
+	var link *model.TeamInviteLink
+	if err := s.GetReplica().SelectOne(&link, queryString, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("TeamInviteLink", key)
+		}
+		return nil, errors.Wrapf(err, "failed to get TeamInviteLink with %s", key)
+	}
+
+	return link, nil
+}
+
+func (s SqlTeamInviteLinkStore) Get(id string) (*model.TeamInviteLink, error) {
+	return s.getBy(sq.Eq{"Id": id}, "id="+id)
+}
+
+func (s SqlTeamInviteLinkStore) GetByName(name string) (*model.TeamInviteLink, error) {
+	return s.getBy(sq.Eq{"Name": name}, "name="+name)
+}
+
+func (s SqlTeamInviteLinkStore) GetForTeam(teamId string) ([]*model.TeamInviteLink, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("*").
+		From("TeamInviteLinks").
+		Where(sq.Eq{"TeamId": teamId, "DeleteAt": 0}).
+		OrderBy("CreateAt ASC", "Id ASC").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_invite_links_tosql")
+	}
+
+	links := []*model.TeamInviteLink{}
+	if _, err := s.GetReplica().Select(&links, queryString, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to find TeamInviteLinks with teamId=%s", teamId)
+	}
+
+	return links, nil
+}
+
+func (s SqlTeamInviteLinkStore) CountForTeam(teamId string) (int64, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("COUNT(*)").
+		From("TeamInviteLinks").
+		Where(sq.Eq{"TeamId": teamId, "DeleteAt": 0}).
+		ToSql()
+	if err != nil {
+		return 0, errors.Wrap(err, "team_invite_links_count_tosql")
+	}
+
+	count, err := s.GetReplica().SelectInt(queryString, args...)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to count TeamInviteLinks with teamId=%s", teamId)
+	}
+
+	return count, nil
+}
+
+// IncrementUseCount records a use of the link, failing with ErrLimitExceeded if the link was revoked,
+// expired or used up in the meantime.
+func (s SqlTeamInviteLinkStore) IncrementUseCount(id string, now int64) error {
+	queryString, args, err := s.getQueryBuilder().
+		Update("TeamInviteLinks").
+		Set("UseCount", sq.Expr("UseCount + 1")).
+		Set("UpdateAt", now).
+		Where(sq.Eq{"Id": id, "DeleteAt": 0}).
+		Where(sq.Or{sq.Eq{"MaxUses": 0}, sq.Expr("UseCount < MaxUses")}).
+		Where(sq.Or{sq.Eq{"ExpiresAt": 0}, sq.Gt{"ExpiresAt": now}}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "team_invite_link_increment_tosql")
+	}
+
+	result, err := s.GetMaster().Exec(queryString, args...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to increment the use count of TeamInviteLink with id=%s", id)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrapf(err, "failed to get rows affected for TeamInviteLink with id=%s", id)
+	}
+	if rowsAffected == 0 {
+		return store.NewErrLimitExceeded("TeamInviteLink", 0, "id="+id)
+	}
+
+	return nil
+}
+
+func (s SqlTeamInviteLinkStore) Revoke(id string, deleteAt int64) error {
+	queryString, args, err := s.getQueryBuilder().
+		Update("TeamInviteLinks").
+		Set("DeleteAt", deleteAt).
+		Set("UpdateAt", deleteAt).
+		Where(sq.Eq{"Id": id, "DeleteAt": 0}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "team_invite_link_revoke_tosql")
+	}
+
+	result, err := s.GetMaster().Exec(queryString, args...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to revoke TeamInviteLink with id=%s", id)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrapf(err, "failed to get rows affected for TeamInviteLink with id=%s", id)
+	}
+	if rowsAffected == 0 {
+		return store.NewErrNotFound("TeamInviteLink", id)
+	}
+	if rowsAffected != 1 {
+		return fmt.Errorf("unexpected count while revoking TeamInviteLink: count=%d, id=%s", rowsAffected, id)
+	}
+
+	return nil
+}
+
+func (s SqlTeamInviteLinkStore) PermanentDeleteByTeam(teamId string) error {
+	queryString, args, err := s.getQueryBuilder().
+		Delete("TeamInviteLinks").
+		Where(sq.Eq{"TeamId": teamId}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "team_invite_links_delete_tosql")
+	}
+
+	if _, err := s.GetMaster().Exec(queryString, args...); err != nil {
+		return errors.Wrapf(err, "failed to delete TeamInviteLinks with teamId=%s", teamId)
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+type SqlTeamMemberHistoryStore struct {
+	SqlStore
+}
+
+func newSqlTeamMemberHistoryStore(sqlStore SqlStore) store.TeamMemberHistoryStore {
+	s := &SqlTeamMemberHistoryStore{
+		SqlStore: sqlStore,
+	}
+
+	for _, db := range sqlStore.GetAllConns() {
+		table := db.AddTableWithName(model.TeamMemberHistory{}, "TeamMemberHistory").SetKeys(false, "TeamId", "UserId", "JoinTime")
+		table.ColMap("TeamId").SetMaxSize(26)
+		table.ColMap("UserId").SetMaxSize(26)
+		table.ColMap("JoinTime").SetNotNull(true)
+	}
+
+	return s
+}
+
+// GetUsersInTeamDuring returns the users that were members of the team at some point between the
+// given times. Passing the same start and end time returns the members of the team at that time.
+func (s SqlTeamMemberHistoryStore) GetUsersInTeamDuring(startTime int64, endTime int64, teamId string) ([]*model.TeamMemberHistoryResult, error) {
+	useTeamMemberHistory, err := s.hasDataAtOrBefore(startTime)
+	if err != nil {
+		return nil, errors.Wrapf(err, "hasDataAtOrBefore startTime=%d endTime=%d teamId=%s", startTime, endTime, teamId)
+	}
+
+	if useTeamMemberHistory {
+		teamMemberHistories, err2 := s.getFromTeamMemberHistoryTable(startTime, endTime, teamId)
+		if err2 != nil {
+			return nil, errors.Wrapf(err2, "getFromTeamMemberHistoryTable startTime=%d endTime=%d teamId=%s", startTime, endTime, teamId)
+		}
+		return teamMemberHistories, nil
+	}
+
+	// the period starts before the TeamMemberHistory table was introduced, so, as for the channels, we
+	// assume that anybody who is or was a member of the team was present during the whole period
+	teamMemberHistories, err := s.getFromTeamMembersTable(startTime, endTime, teamId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getFromTeamMembersTable startTime=%d endTime=%d teamId=%s", startTime, endTime, teamId)
+	}
+	return teamMemberHistories, nil
+}
+
+func (s SqlTeamMemberHistoryStore) hasDataAtOrBefore(time int64) (bool, error) {
+	type NullableCountResult struct {
+		Min sql.NullInt64
+	}
+	var result NullableCountResult
+	query := "SELECT MIN(JoinTime) AS Min FROM TeamMemberHistory"
+	if err := s.GetReplica().SelectOne(&result, query); err != nil {
+		return false, err
+	} else if result.Min.Valid {
+		return result.Min.Int64 <= time, nil
+	}
+	// if the result was null, there are no rows in the table, so there is no data from before
+	return false, nil
+}
+
+func (s SqlTeamMemberHistoryStore) getFromTeamMemberHistoryTable(startTime int64, endTime int64, teamId string) ([]*model.TeamMemberHistoryResult, error) {
+	query := `
+		SELECT
+			tmh.*,
+			u.Email,
+			u.Username,
+			Bots.UserId IS NOT NULL AS IsBot
+		FROM TeamMemberHistory tmh
+		INNER JOIN Users u ON tmh.UserId = u.Id
+		LEFT JOIN Bots ON Bots.UserId = u.Id
+		WHERE tmh.TeamId = :TeamId
+		AND tmh.JoinTime <= :EndTime
+		AND (tmh.LeaveTime IS NULL OR tmh.LeaveTime >= :StartTime)
+		ORDER BY tmh.JoinTime ASC`
+
+	params := map[string]interface{}{"TeamId": teamId, "StartTime": startTime, "EndTime": endTime}
+	var histories []*model.TeamMemberHistoryResult
+	if _, err := s.GetReplica().Select(&histories, query, params); err != nil {
+		return nil, err
+	}
+
+	return histories, nil
+}
+
+func (s SqlTeamMemberHistoryStore) getFromTeamMembersTable(startTime int64, endTime int64, teamId string) ([]*model.TeamMemberHistoryResult, error) {
+	query := `
+		SELECT DISTINCT
+			tm.TeamId,
+			tm.UserId,
+			u.Email,
+			u.Username,
+			Bots.UserId IS NOT NULL AS IsBot
+		FROM TeamMembers AS tm
+		INNER JOIN Users AS u ON tm.UserId = u.Id
+		LEFT JOIN Bots ON Bots.UserId = u.Id
+		WHERE tm.TeamId = :TeamId`
+
+	params := map[string]interface{}{"TeamId": teamId}
+	var histories []*model.TeamMemberHistoryResult
+	if _, err := s.GetReplica().Select(&histories, query, params); err != nil {
+		return nil, err
+	}
+	// we have to fill in the join/leave times, because that data doesn't exist in the team members table
+	for _, teamMemberHistory := range histories {
+		teamMemberHistory.JoinTime = startTime
+		teamMemberHistory.LeaveTime = model.NewInt64(endTime)
+	}
+	return histories, nil
+}
+
+func (s SqlTeamMemberHistoryStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+	var query string
+	if s.DriverName() == model.DATABASE_DRIVER_POSTGRES {
+		query =
+			`DELETE FROM TeamMemberHistory
+				 WHERE ctid IN (
+					SELECT ctid FROM TeamMemberHistory
+					WHERE LeaveTime IS NOT NULL
+					AND LeaveTime <= :EndTime
+					LIMIT :Limit
+				);`
+	} else {
+		query =
+			`DELETE FROM TeamMemberHistory
+				 WHERE LeaveTime IS NOT NULL
+				 AND LeaveTime <= :EndTime
+				 LIMIT :Limit`
+	}
+
+	params := map[string]interface{}{"EndTime": endTime, "Limit": limit}
+	sqlResult, err := s.GetMaster().Exec(query, params)
+	if err != nil {
+		return 0, errors.Wrapf(err, "PermanentDeleteBatch endTime=%d limit=%d", endTime, limit)
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrapf(err, "PermanentDeleteBatch endTime=%d limit=%d", endTime, limit)
+	}
+	return rowsAffected, nil
+}
@@ -4,45 +4,47 @@
 package sqlstore
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"net/http"
+	"sort"
 	"strings"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/mattermost/gorp"
+	"github.com/pkg/errors"
+
 	"github.com/mattermost/mattermost-server/v5/model"
 	"github.com/mattermost/mattermost-server/v5/store"
 	"github.com/mattermost/mattermost-server/v5/utils"
 )
 
-const (
-	TEAM_MEMBER_EXISTS_ERROR = "store.sql_team.save_member.exists.app_error"
-)
-
 type SqlTeamStore struct {
 	SqlStore
 }
 
 type teamMember struct {
-	TeamId      string
-	UserId      string
-	Roles       string
-	DeleteAt    int64
-	SchemeUser  sql.NullBool
-	SchemeAdmin sql.NullBool
-	SchemeGuest sql.NullBool
+	TeamId                 string
+	UserId                 string
+	Roles                  string
+	DeleteAt               int64
+	SchemeUser             sql.NullBool
+	SchemeAdmin            sql.NullBool
+	SchemeGuest            sql.NullBool
+	ExplicitRolesExpiresAt int64
 }
 
 func NewTeamMemberFromModel(tm *model.TeamMember) *teamMember {
 	return &teamMember{
-		TeamId:      tm.TeamId,
-		UserId:      tm.UserId,
-		Roles:       tm.ExplicitRoles,
-		DeleteAt:    tm.DeleteAt,
-		SchemeGuest: sql.NullBool{Valid: true, Bool: tm.SchemeGuest},
-		SchemeUser:  sql.NullBool{Valid: true, Bool: tm.SchemeUser},
-		SchemeAdmin: sql.NullBool{Valid: true, Bool: tm.SchemeAdmin},
+		TeamId:                 tm.TeamId,
+		UserId:                 tm.UserId,
+		Roles:                  tm.ExplicitRoles,
+		DeleteAt:               tm.DeleteAt,
+		SchemeGuest:            sql.NullBool{Valid: true, Bool: tm.SchemeGuest},
+		SchemeUser:             sql.NullBool{Valid: true, Bool: tm.SchemeUser},
+		SchemeAdmin:            sql.NullBool{Valid: true, Bool: tm.SchemeAdmin},
+		ExplicitRolesExpiresAt: tm.ExplicitRolesExpiresAt,
 	}
 }
 
@@ -54,6 +56,7 @@ type teamMemberWithSchemeRoles struct {
 	SchemeGuest                sql.NullBool
 	SchemeUser                 sql.NullBool
 	SchemeAdmin                sql.NullBool
+	ExplicitRolesExpiresAt     int64
 	TeamSchemeDefaultGuestRole sql.NullString
 	TeamSchemeDefaultUserRole  sql.NullString
 	TeamSchemeDefaultAdminRole sql.NullString
@@ -62,7 +65,7 @@ type teamMemberWithSchemeRoles struct {
 type teamMemberWithSchemeRolesList []teamMemberWithSchemeRoles
 
 func teamMemberSliceColumns() []string {
-	return []string{"TeamId", "UserId", "Roles", "DeleteAt", "SchemeUser", "SchemeAdmin", "SchemeGuest"}
+	return []string{"TeamId", "UserId", "Roles", "DeleteAt", "SchemeUser", "SchemeAdmin", "SchemeGuest", "ExplicitRolesExpiresAt"}
 }
 
 func teamMemberToSlice(member *model.TeamMember) []interface{} {
@@ -74,6 +77,7 @@ func teamMemberToSlice(member *model.TeamMember) []interface{} {
 	resultSlice = append(resultSlice, member.SchemeUser)
 	resultSlice = append(resultSlice, member.SchemeAdmin)
 	resultSlice = append(resultSlice, member.SchemeGuest)
+	resultSlice = append(resultSlice, member.ExplicitRolesExpiresAt)
 	return resultSlice
 }
 
@@ -176,14 +180,15 @@ func (db teamMemberWithSchemeRoles) ToModel() *model.TeamMember {
 	rolesResult := getTeamRoles(schemeGuest, schemeUser, schemeAdmin, defaultTeamGuestRole, defaultTeamUserRole, defaultTeamAdminRole, strings.Fields(db.Roles))
 
 	tm := &model.TeamMember{
-		TeamId:        db.TeamId,
-		UserId:        db.UserId,
-		Roles:         strings.Join(rolesResult.roles, " "),
-		DeleteAt:      db.DeleteAt,
-		SchemeGuest:   rolesResult.schemeGuest,
-		SchemeUser:    rolesResult.schemeUser,
-		SchemeAdmin:   rolesResult.schemeAdmin,
-		ExplicitRoles: strings.Join(rolesResult.explicitRoles, " "),
+		TeamId:                 db.TeamId,
+		UserId:                 db.UserId,
+		Roles:                  strings.Join(rolesResult.roles, " "),
+		DeleteAt:               db.DeleteAt,
+		SchemeGuest:            rolesResult.schemeGuest,
+		SchemeUser:             rolesResult.schemeUser,
+		SchemeAdmin:            rolesResult.schemeAdmin,
+		ExplicitRoles:          strings.Join(rolesResult.explicitRoles, " "),
+		ExplicitRolesExpiresAt: db.ExplicitRolesExpiresAt,
 	}
 	return tm
 }
@@ -213,6 +218,7 @@ func newSqlTeamStore(sqlStore SqlStore) store.TeamStore {
 		table.ColMap("CompanyName").SetMaxSize(64)
 		table.ColMap("AllowedDomains").SetMaxSize(1000)
 		table.ColMap("InviteId").SetMaxSize(32)
+		table.ColMap("Props").SetMaxSize(model.TEAM_PROPS_MAX_LENGTH)
 
 		tablem := db.AddTableWithName(teamMember{}, "TeamMembers").SetKeys(false, "TeamId", "UserId")
 		tablem.ColMap("TeamId").SetMaxSize(26)
@@ -235,14 +241,20 @@ func (s SqlTeamStore) createIndexesIfNotExists() {
 	s.CreateIndexIfNotExists("idx_teammembers_team_id", "TeamMembers", "TeamId")
 	s.CreateIndexIfNotExists("idx_teammembers_user_id", "TeamMembers", "UserId")
 	s.CreateIndexIfNotExists("idx_teammembers_delete_at", "TeamMembers", "DeleteAt")
+	s.CreateIndexIfNotExists("idx_teammembers_explicit_roles_expires_at", "TeamMembers", "ExplicitRolesExpiresAt")
+
+	// The store deletes the memberships of the teams and users it deletes on every driver. The
+	// constraints only keep other deletions from leaving memberships behind, where supported.
+	s.CreateForeignKeyIfNotExists("fk_teammembers_teams", "TeamMembers", "TeamId", "Teams", "Id", "CASCADE")
+	s.CreateForeignKeyIfNotExists("fk_teammembers_users", "TeamMembers", "UserId", "Users", "Id", "CASCADE")
 }
 
 // Save adds the team to the database if a team with the same name does not already
-// exist in the database. It returns the team added if the operation is successful.
-func (s SqlTeamStore) Save(team *model.Team) (*model.Team, *model.AppError) {
+// exist in the database. It returns the team added if the operation is successful, or a
+// store.ErrConflict if the name is already taken.
+func (s SqlTeamStore) Save(ctx context.Context, team *model.Team) (*model.Team, error) {
 	if len(team.Id) > 0 {
-		return nil, model.NewAppError("SqlTeamStore.Save",
-			"store.sql_team.save.existing.app_error", nil, "id="+team.Id, http.StatusBadRequest)
+		return nil, store.NewErrInvalidInput("Team", "id", team.Id)
 	}
 
 	team.PreSave()
@@ -251,19 +263,209 @@ func (s SqlTeamStore) Save(team *model.Team) (*model.Team, *model.AppError) {
 		return nil, err
 	}
 
-	if err := s.GetMaster().Insert(team); err != nil {
+	if err := s.GetMaster().WithContext(ctx).Insert(team); err != nil {
 		if IsUniqueConstraintError(err, []string{"Name", "teams_name_key"}) {
-			return nil, model.NewAppError("SqlTeamStore.Save", "store.sql_team.save.domain_exists.app_error", nil, "id="+team.Id+", "+err.Error(), http.StatusBadRequest)
+			return nil, store.NewErrConflict("Team", err, "name="+team.Name)
 		}
-		return nil, model.NewAppError("SqlTeamStore.Save", "store.sql_team.save.app_error", nil, "id="+team.Id+", "+err.Error(), http.StatusInternalServerError)
+		return nil, errors.Wrapf(err, "failed to save Team with id=%s", team.Id)
 	}
 	return team, nil
 }
 
+func teamSliceColumns() []string {
+	return []string{"Id", "CreateAt", "UpdateAt", "DeleteAt", "DisplayName", "Name", "Description", "Email", "Type", "CompanyName", "AllowedDomains", "InviteId", "AllowOpenInvite", "LastTeamIconUpdate", "SchemeId", "GroupConstrained", "Props", "MemberCount", "InviteExpiresAt"}
+}
+
+func teamToSlice(team *model.Team) []interface{} {
+	return []interface{}{
+		team.Id,
+		team.CreateAt,
+		team.UpdateAt,
+		team.DeleteAt,
+		team.DisplayName,
+		team.Name,
+		team.Description,
+		team.Email,
+		team.Type,
+		team.CompanyName,
+		team.AllowedDomains,
+		team.InviteId,
+		team.AllowOpenInvite,
+		team.LastTeamIconUpdate,
+		team.SchemeId,
+		team.GroupConstrained,
+		model.StringInterfaceToJson(team.Props),
+		team.MemberCount,
+		team.InviteExpiresAt,
+	}
+}
+
+// updateMemberCounts recounts the active members of the teams into their MemberCount as part of
+// the transaction changing their members, so that the count can't drift from the TeamMembers.
+func (s SqlTeamStore) updateMemberCounts(transaction *gorp.Transaction, teamIds []string) error {
+	if len(teamIds) == 0 {
+		return nil
+	}
+
+	query := s.getQueryBuilder().
+		Update("Teams").
+		Set("MemberCount", sq.Expr("(SELECT COUNT(*) FROM TeamMembers INNER JOIN Users ON Users.Id = TeamMembers.UserId WHERE TeamMembers.TeamId = Teams.Id AND TeamMembers.DeleteAt = 0)")).
+		Where(sq.Eq{"Id": teamIds})
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "member_count_tosql")
+	}
+
+	if _, err := transaction.Exec(queryString, args...); err != nil {
+		return errors.Wrap(err, "failed to update the Teams member count")
+	}
+
+	return nil
+}
+
+// logTeamMemberJoinEvents records the members joining their teams into the TeamMemberHistory as
+// part of the transaction saving them. Members saved as already deleted are skipped.
+func (s SqlTeamStore) logTeamMemberJoinEvents(transaction *gorp.Transaction, members []*model.TeamMember, joinTime int64) error {
+	for _, member := range members {
+		if member.DeleteAt != 0 {
+			continue
+		}
+
+		teamMemberHistory := &model.TeamMemberHistory{
+			TeamId:   member.TeamId,
+			UserId:   member.UserId,
+			JoinTime: joinTime,
+		}
+
+		if err := transaction.Insert(teamMemberHistory); err != nil {
+			return errors.Wrapf(err, "logTeamMemberJoinEvents teamId=%s userId=%s joinTime=%d", member.TeamId, member.UserId, joinTime)
+		}
+	}
+
+	return nil
+}
+
+// logTeamMemberLeaveEvents records the members matching the condition leaving their teams into the
+// TeamMemberHistory as part of the transaction deleting them.
+func (s SqlTeamStore) logTeamMemberLeaveEvents(transaction *gorp.Transaction, members sq.Eq, leaveTime int64) error {
+	query, args, err := s.getQueryBuilder().
+		Update("TeamMemberHistory").
+		Set("LeaveTime", leaveTime).
+		Where(members).
+		Where(sq.Eq{"LeaveTime": nil}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "team_member_history_tosql")
+	}
+
+	if _, err := transaction.Exec(query, args...); err != nil {
+		return errors.Wrapf(err, "logTeamMemberLeaveEvents leaveTime=%d", leaveTime)
+	}
+
+	return nil
+}
+
+// SaveMultiple inserts the teams with a single multi-row INSERT inside a transaction. It returns
+// the saved team or the error of each of the given teams at the same index: teams that are invalid
+// or whose name is already taken, with a store.ErrConflict, aren't inserted, while the others are.
+// The last error is set when none of the teams could be inserted.
+func (s SqlTeamStore) SaveMultiple(ctx context.Context, teams []*model.Team) ([]*model.Team, []error, error) {
+	saved := make([]*model.Team, len(teams))
+	rowErrs := make([]error, len(teams))
+
+	names := []string{}
+	namesInBatch := map[string]bool{}
+	for i, team := range teams {
+		if len(team.Id) > 0 {
+			rowErrs[i] = store.NewErrInvalidInput("Team", "id", team.Id)
+			continue
+		}
+
+		team.PreSave()
+
+		if err := team.IsValid(); err != nil {
+			rowErrs[i] = err
+			continue
+		}
+
+		if namesInBatch[team.Name] {
+			rowErrs[i] = store.NewErrConflict("Team", nil, "name="+team.Name)
+			continue
+		}
+		namesInBatch[team.Name] = true
+		names = append(names, team.Name)
+		saved[i] = team
+	}
+
+	if len(names) == 0 {
+		return saved, rowErrs, nil
+	}
+
+	transaction, err := s.GetMaster().BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransaction(transaction)
+
+	selectQuery, args, err := s.getQueryBuilder().Select("Name").From("Teams").Where(sq.Eq{"Name": names}).ToSql()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "team_tosql")
+	}
+
+	var existingNames []string
+	if _, err = transaction.Select(&existingNames, selectQuery, args...); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to find existing Team names")
+	}
+
+	nameTaken := map[string]bool{}
+	for _, name := range existingNames {
+		nameTaken[name] = true
+	}
+
+	query := s.getQueryBuilder().Insert("Teams").Columns(teamSliceColumns()...)
+	toInsert := 0
+	for i, team := range saved {
+		if team == nil {
+			continue
+		}
+		if nameTaken[team.Name] {
+			saved[i] = nil
+			rowErrs[i] = store.NewErrConflict("Team", nil, "name="+team.Name)
+			continue
+		}
+		query = query.Values(teamToSlice(team)...)
+		toInsert++
+	}
+
+	if toInsert == 0 {
+		return saved, rowErrs, nil
+	}
+
+	insertQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "team_tosql")
+	}
+
+	if _, err = transaction.Exec(insertQuery, args...); err != nil {
+		if IsUniqueConstraintError(err, []string{"Name", "teams_name_key"}) {
+			return nil, nil, store.NewErrConflict("Team", err, "")
+		}
+		return nil, nil, errors.Wrap(err, "failed to save Teams")
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return nil, nil, errors.Wrap(err, "commit_transaction")
+	}
+
+	return saved, rowErrs, nil
+}
+
 // Update updates the details of the team passed as the parameter using the team Id
 // if the team exists in the database.
-// It returns the updated team if the operation is successful.
-func (s SqlTeamStore) Update(team *model.Team) (*model.Team, *model.AppError) {
+// It returns the updated team if the operation is successful, a store.ErrNotFound if the team
+// doesn't exist, or a store.ErrConflict if the new name is already taken.
+func (s SqlTeamStore) Update(ctx context.Context, team *model.Team) (*model.Team, error) {
 
 	team.PreUpdate()
 
@@ -271,81 +473,148 @@ func (s SqlTeamStore) Update(team *model.Team) (*model.Team, *model.AppError) {
 		return nil, err
 	}
 
-	oldResult, err := s.GetMaster().Get(model.Team{}, team.Id)
+	transaction, err := s.GetMaster().BeginTx(ctx, nil)
 	if err != nil {
-		return nil, model.NewAppError("SqlTeamStore.Update", "store.sql_team.update.finding.app_error", nil, "id="+team.Id+", "+err.Error(), http.StatusInternalServerError)
+		return nil, errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransaction(transaction)
+
+	oldResult, err := transaction.Get(model.Team{}, team.Id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get Team with id=%s", team.Id)
 
 	}
 
 	if oldResult == nil {
-		return nil, model.NewAppError("SqlTeamStore.Update", "store.sql_team.update.find.app_error", nil, "id="+team.Id, http.StatusBadRequest)
+		return nil, store.NewErrNotFound("Team", team.Id)
 	}
 
 	oldTeam := oldResult.(*model.Team)
 	team.CreateAt = oldTeam.CreateAt
 	team.UpdateAt = model.GetMillis()
 
-	count, err := s.GetMaster().Update(team)
+	count, err := transaction.Update(team)
 	if err != nil {
-		return nil, model.NewAppError("SqlTeamStore.Update", "store.sql_team.update.updating.app_error", nil, "id="+team.Id+", "+err.Error(), http.StatusInternalServerError)
+		if IsUniqueConstraintError(err, []string{"Name", "teams_name_key"}) {
+			return nil, store.NewErrConflict("Team", err, "name="+team.Name)
+		}
+		return nil, errors.Wrapf(err, "failed to update Team with id=%s", team.Id)
 	}
 	if count != 1 {
-		return nil, model.NewAppError("SqlTeamStore.Update", "store.sql_team.update.app_error", nil, "id="+team.Id, http.StatusInternalServerError)
+		return nil, fmt.Errorf("the expected number of teams to be updated is 1 but was %d", count)
+	}
+
+	// The whole row is written back, so the member count is recounted rather than trusting the one
+	// of the team, which members may have joined or left since it was read.
+	if err = s.updateMemberCounts(transaction, []string{team.Id}); err != nil {
+		return nil, err
+	}
+
+	team.MemberCount, err = transaction.SelectInt("SELECT MemberCount FROM Teams WHERE Id = :Id", map[string]interface{}{"Id": team.Id})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the member count of Team with id=%s", team.Id)
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit_transaction")
 	}
 
 	return team, nil
 }
 
 // Get returns from the database the team that matches the id provided as parameter.
-// If the team doesn't exist it returns a model.AppError with a
-// http.StatusNotFound in the StatusCode field.
-func (s SqlTeamStore) Get(id string) (*model.Team, *model.AppError) {
-	obj, err := s.GetReplica().Get(model.Team{}, id)
+// If the team doesn't exist it returns a store.ErrNotFound.
+func (s SqlTeamStore) Get(ctx context.Context, id string) (*model.Team, error) {
+	obj, err := s.GetReplica().WithContext(ctx).Get(model.Team{}, id)
 	if err != nil {
-		return nil, model.NewAppError("SqlTeamStore.Get", "store.sql_team.get.finding.app_error", nil, "id="+id+", "+err.Error(), http.StatusInternalServerError)
+		return nil, errors.Wrapf(err, "failed to get Team with id=%s", id)
 	}
 	if obj == nil {
-		return nil, model.NewAppError("SqlTeamStore.Get", "store.sql_team.get.find.app_error", nil, "id="+id, http.StatusNotFound)
+		return nil, store.NewErrNotFound("Team", id)
 	}
 
 	return obj.(*model.Team), nil
 }
 
 // GetByInviteId returns from the database the team that matches the inviteId provided as parameter.
-// If the parameter provided is empty or if there is no match in the database, it returns a model.AppError
-// with a http.StatusNotFound in the StatusCode field.
-func (s SqlTeamStore) GetByInviteId(inviteId string) (*model.Team, *model.AppError) {
+// If the parameter provided is empty or if there is no match in the database, it returns a
+// store.ErrNotFound.
+func (s SqlTeamStore) GetByInviteId(ctx context.Context, inviteId string) (*model.Team, error) {
 	team := model.Team{}
 
-	err := s.GetReplica().SelectOne(&team, "SELECT * FROM Teams WHERE InviteId = :InviteId", map[string]interface{}{"InviteId": inviteId})
+	err := s.GetReplica().WithContext(ctx).SelectOne(&team, "SELECT * FROM Teams WHERE InviteId = :InviteId", map[string]interface{}{"InviteId": inviteId})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("Team", fmt.Sprintf("inviteId=%s", inviteId))
+		}
+		return nil, errors.Wrapf(err, "failed to find Team with inviteId=%s", inviteId)
+	}
+
+	if len(inviteId) == 0 || team.InviteId != inviteId || team.IsInviteExpired(model.GetMillis()) {
+		return nil, store.NewErrNotFound("Team", fmt.Sprintf("inviteId=%s", inviteId))
+	}
+	return &team, nil
+}
+
+// RegenerateInviteId replaces the InviteId of the team with a new one, expiring at inviteExpiresAt
+// unless 0, so that the previous invite links stop working. It returns the updated team.
+func (s SqlTeamStore) RegenerateInviteId(ctx context.Context, teamId string, inviteExpiresAt int64) (*model.Team, error) {
+	transaction, err := s.GetMaster().BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransaction(transaction)
+
+	query, args, err := s.getQueryBuilder().
+		Update("Teams").
+		Set("InviteId", model.NewId()).
+		Set("InviteExpiresAt", inviteExpiresAt).
+		Set("UpdateAt", model.GetMillis()).
+		Where(sq.Eq{"Id": teamId, "DeleteAt": 0}).
+		ToSql()
 	if err != nil {
-		return nil, model.NewAppError("SqlTeamStore.GetByInviteId", "store.sql_team.get_by_invite_id.finding.app_error", nil, "inviteId="+inviteId+", "+err.Error(), http.StatusNotFound)
+		return nil, errors.Wrap(err, "team_tosql")
+	}
+
+	result, err := transaction.Exec(query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update Team with id=%s", teamId)
+	}
+	if count, _ := result.RowsAffected(); count == 0 {
+		return nil, store.NewErrNotFound("Team", teamId)
 	}
 
-	if len(inviteId) == 0 || team.InviteId != inviteId {
-		return nil, model.NewAppError("SqlTeamStore.GetByInviteId", "store.sql_team.get_by_invite_id.find.app_error", nil, "inviteId="+inviteId, http.StatusNotFound)
+	var team model.Team
+	if err := transaction.SelectOne(&team, "SELECT * FROM Teams WHERE Id = :Id", map[string]interface{}{"Id": teamId}); err != nil {
+		return nil, errors.Wrapf(err, "failed to get Team with id=%s", teamId)
 	}
+
+	if err := transaction.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit_transaction")
+	}
+
 	return &team, nil
 }
 
 // GetByName returns from the database the team that matches the name provided as parameter.
-// If there is no match in the database, it returns a model.AppError with a
-// http.StatusNotFound in the StatusCode field.
-func (s SqlTeamStore) GetByName(name string) (*model.Team, *model.AppError) {
+// If there is no match in the database, it returns a store.ErrNotFound.
+func (s SqlTeamStore) GetByName(ctx context.Context, name string) (*model.Team, error) {
 
 	team := model.Team{}
 
-	err := s.GetReplica().SelectOne(&team, "SELECT * FROM Teams WHERE Name = :Name", map[string]interface{}{"Name": name})
+	err := s.GetReplica().WithContext(ctx).SelectOne(&team, "SELECT * FROM Teams WHERE Name = :Name", map[string]interface{}{"Name": name})
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, model.NewAppError("SqlTeamStore.GetByName", "store.sql_team.get_by_name.missing.app_error", nil, "name="+name+","+err.Error(), http.StatusNotFound)
+			return nil, store.NewErrNotFound("Team", fmt.Sprintf("name=%s", name))
 		}
-		return nil, model.NewAppError("SqlTeamStore.GetByName", "store.sql_team.get_by_name.app_error", nil, "name="+name+", "+err.Error(), http.StatusInternalServerError)
+		return nil, errors.Wrapf(err, "failed to find Team with name=%s", name)
 	}
 	return &team, nil
 }
 
-func (s SqlTeamStore) GetByNames(names []string) ([]*model.Team, *model.AppError) {
+// GetByNames returns the teams with the given names, or a store.ErrNotFound if any of them
+// doesn't exist.
+func (s SqlTeamStore) GetByNames(ctx context.Context, names []string) ([]*model.Team, error) {
 	uniqueNames := utils.RemoveDuplicatesFromStringArray(names)
 
 	query := s.getQueryBuilder().
@@ -355,62 +624,166 @@ func (s SqlTeamStore) GetByNames(names []string) ([]*model.Team, *model.AppError
 
 	queryString, args, err := query.ToSql()
 	if err != nil {
-		return nil, model.NewAppError("SqlTeamStore.GetByNames", "store.sql_team.get_by_names.app_error", nil, err.Error(), http.StatusInternalServerError)
+		return nil, errors.Wrap(err, "team_tosql")
 	}
 
 	teams := []*model.Team{}
-	_, err = s.GetReplica().Select(&teams, queryString, args...)
+	_, err = s.GetReplica().WithContext(ctx).Select(&teams, queryString, args...)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, model.NewAppError("SqlTeamStore.GetByNames", "store.sql_team.get_by_names.missing.app_error", nil, err.Error(), http.StatusNotFound)
-		}
-		return nil, model.NewAppError("SqlTeamStore.GetByNames", "store.sql_team.get_by_names.app_error", nil, err.Error(), http.StatusInternalServerError)
+		return nil, errors.Wrap(err, "failed to find Teams")
 	}
 	if len(teams) != len(uniqueNames) {
-		return nil, model.NewAppError("SqlTeamStore.GetByNames", "store.sql_team.get_by_names.missing.app_error", nil, "", http.StatusNotFound)
+		return nil, store.NewErrNotFound("Team", fmt.Sprintf("names=%v", uniqueNames))
 	}
 	return teams, nil
 }
 
-// SearchAll returns from the database a list of teams that match the Name or DisplayName
-// passed as the term search parameter.
-func (s SqlTeamStore) SearchAll(term string) ([]*model.Team, *model.AppError) {
-	var teams []*model.Team
+// GetByAllowedDomain returns the teams, not deleted, whose AllowedDomains list the given email domain.
+// The list may separate the domains with commas or spaces and prefix them with @, so the domains are
+// matched whole after normalizing the separators rather than as substrings of the column.
+func (s SqlTeamStore) GetByAllowedDomain(ctx context.Context, domain string) ([]*model.Team, error) {
+	domain = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(domain)), "@")
+	if domain == "" || strings.ContainsAny(domain, ", @") {
+		return nil, store.NewErrInvalidInput("Team", "AllowedDomains", domain)
+	}
 
+	query := s.getQueryBuilder().
+		Select("*").
+		From("Teams").
+		Where(sq.Eq{"DeleteAt": 0}).
+		Where(sq.Expr("CONCAT(' ', REPLACE(REPLACE(LOWER(AllowedDomains), ',', ' '), '@', ' '), ' ') LIKE ?", "% "+sanitizeSearchTerm(domain, "\\")+" %")).
+		OrderBy("DisplayName")
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_tosql")
+	}
+
+	teams := []*model.Team{}
+	if _, err = s.GetReplica().WithContext(ctx).Select(&teams, queryString, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to find Teams with allowed domain=%s", domain)
+	}
+	return teams, nil
+}
+
+// teamSearchQuery builds the query for the teams whose Name or DisplayName match the term and
+// that pass the filters of the options.
+func (s SqlTeamStore) teamSearchQuery(term string, opts *model.TeamSearchOpts, selectStr string) sq.SelectBuilder {
 	term = sanitizeSearchTerm(term, "\\")
 	term = wildcardSearchTerm(term)
-
 	operatorKeyword := "ILIKE"
 	if s.DriverName() == model.DATABASE_DRIVER_MYSQL {
 		operatorKeyword = "LIKE"
 	}
-	queryString := fmt.Sprintf("SELECT * FROM Teams WHERE Name %[1]s :Term OR DisplayName %[1]s :Term", operatorKeyword)
-	if _, err := s.GetReplica().Select(&teams, queryString, map[string]interface{}{"Term": term}); err != nil {
+
+	query := s.getQueryBuilder().
+		Select(selectStr).
+		From("Teams").
+		Where(sq.Or{
+			sq.Expr("Name "+operatorKeyword+" ?", term),
+			sq.Expr("DisplayName "+operatorKeyword+" ?", term),
+		})
+
+	if opts.AllowOpenInvite != nil {
+		query = query.Where(sq.Eq{"AllowOpenInvite": *opts.AllowOpenInvite})
+	}
+
+	if opts.GroupConstrained != nil {
+		if *opts.GroupConstrained {
+			query = query.Where(sq.Eq{"GroupConstrained": true})
+		} else {
+			query = query.Where(sq.Or{sq.Eq{"GroupConstrained": nil}, sq.Eq{"GroupConstrained": false}})
+		}
+	}
+
+	if opts.ExcludeDeleted {
+		query = query.Where(sq.Eq{"DeleteAt": 0})
+	}
+
+	if opts.TeamType != "" {
+		query = query.Where(sq.Eq{"Type": opts.TeamType})
+	}
+
+	if opts.PolicyID != "" {
+		query = query.Where(sq.Expr("Id IN (SELECT TeamId FROM RetentionPoliciesTeams WHERE PolicyId = ?)", opts.PolicyID))
+	}
+
+	if opts.ExcludePolicyConstrained {
+		query = query.Where(sq.Expr("Id NOT IN (SELECT TeamId FROM RetentionPoliciesTeams)"))
+	}
+
+	return query
+}
+
+func (s SqlTeamStore) searchTeams(ctx context.Context, query sq.SelectBuilder) ([]*model.Team, error) {
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var teams []*model.Team
+	if _, err = s.GetReplica().WithContext(ctx).Select(&teams, queryString, args...); err != nil {
+		return nil, err
+	}
+
+	return teams, nil
+}
+
+// SearchAll returns from the database a list of teams that match the Name or DisplayName
+// passed as the term search parameter and the filters of the options.
+func (s SqlTeamStore) SearchAll(ctx context.Context, term string, opts *model.TeamSearchOpts) ([]*model.Team, *model.AppError) {
+	teams, err := s.searchTeams(ctx, s.teamSearchQuery(term, opts, "*"))
+	if err != nil {
 		return nil, model.NewAppError("SqlTeamStore.SearchAll", "store.sql_team.search_all_team.app_error", nil, "term="+term+", "+err.Error(), http.StatusInternalServerError)
 	}
 
 	return teams, nil
 }
 
-// SearchAllPaged returns a teams list and the total count of teams that matched the search.
-func (s SqlTeamStore) SearchAllPaged(term string, page int, perPage int) ([]*model.Team, int64, *model.AppError) {
-	var teams []*model.Team
-	var totalCount int64
-	offset := page * perPage
+// orderTeamSearch sorts the teams of a search following the sort option, joining the member count or
+// the last activity of the teams when they are needed. Ties are broken by display name and name.
+func (s SqlTeamStore) orderTeamSearch(query sq.SelectBuilder, opts *model.TeamSearchOpts) sq.SelectBuilder {
+	direction := " ASC"
+	if opts.SortDescending {
+		direction = " DESC"
+	}
 
-	term = sanitizeSearchTerm(term, "\\")
-	term = wildcardSearchTerm(term)
-	operatorKeyword := "ILIKE"
-	if s.DriverName() == model.DATABASE_DRIVER_MYSQL {
-		operatorKeyword = "LIKE"
+	switch opts.Sort {
+	case model.TEAM_SEARCH_SORT_CREATE_AT:
+		query = query.OrderBy("Teams.CreateAt" + direction)
+	case model.TEAM_SEARCH_SORT_MEMBER_COUNT:
+		query = query.
+			LeftJoin("(SELECT TeamId, COUNT(*) AS MemberCount FROM TeamMembers WHERE DeleteAt = 0 GROUP BY TeamId) AS TeamMemberCounts ON TeamMemberCounts.TeamId = Teams.Id").
+			OrderBy("COALESCE(TeamMemberCounts.MemberCount, 0)" + direction)
+	case model.TEAM_SEARCH_SORT_LAST_ACTIVITY:
+		query = query.
+			LeftJoin("(SELECT TeamId, MAX(LastPostAt) AS LastActivityAt FROM Channels GROUP BY TeamId) AS TeamActivity ON TeamActivity.TeamId = Teams.Id").
+			OrderBy("COALESCE(TeamActivity.LastActivityAt, 0)" + direction)
+	default:
+		return query.OrderBy("Teams.DisplayName"+direction, "Teams.Name"+direction)
+	}
+
+	return query.OrderBy("Teams.DisplayName", "Teams.Name")
+}
+
+// SearchAllPaged returns a teams list, sorted following the options, and the total count of teams
+// that matched the search.
+func (s SqlTeamStore) SearchAllPaged(ctx context.Context, term string, opts *model.TeamSearchOpts, page int, perPage int) ([]*model.Team, int64, *model.AppError) {
+	query := s.orderTeamSearch(s.teamSearchQuery(term, opts, "Teams.*"), opts).
+		Limit(uint64(perPage)).
+		Offset(uint64(page * perPage))
+
+	teams, err := s.searchTeams(ctx, query)
+	if err != nil {
+		return nil, 0, model.NewAppError("SqlTeamStore.SearchAllPage", "store.sql_team.search_all_team.app_error", nil, "term="+term+", "+err.Error(), http.StatusInternalServerError)
 	}
-	queryString := fmt.Sprintf("SELECT * FROM Teams WHERE Name %[1]s :Term OR DisplayName %[1]s :Term ORDER BY DisplayName, Name LIMIT :Limit  OFFSET :Offset", operatorKeyword)
-	if _, err := s.GetReplica().Select(&teams, queryString, map[string]interface{}{"Term": term, "Limit": perPage, "Offset": offset}); err != nil {
+
+	queryString, args, err := s.teamSearchQuery(term, opts, "COUNT(*)").ToSql()
+	if err != nil {
 		return nil, 0, model.NewAppError("SqlTeamStore.SearchAllPage", "store.sql_team.search_all_team.app_error", nil, "term="+term+", "+err.Error(), http.StatusInternalServerError)
 	}
 
-	queryString = fmt.Sprintf("SELECT COUNT(*) FROM Teams WHERE Name %[1]s :Term OR DisplayName %[1]s :Term", operatorKeyword)
-	totalCount, err := s.GetReplica().SelectInt(queryString, map[string]interface{}{"Term": term})
+	totalCount, err := s.GetReplica().WithContext(ctx).SelectInt(queryString, args...)
 	if err != nil {
 		return nil, 0, model.NewAppError("SqlTeamStore.SearchAllPage", "store.sql_team.search_all_team.app_error", nil, "term="+term+", "+err.Error(), http.StatusInternalServerError)
 	}
@@ -419,18 +792,13 @@ func (s SqlTeamStore) SearchAllPaged(term string, page int, perPage int) ([]*mod
 }
 
 // SearchOpen returns from the database a list of public teams that match the Name or DisplayName
-// passed as the term search parameter.
-func (s SqlTeamStore) SearchOpen(term string) ([]*model.Team, *model.AppError) {
-	var teams []*model.Team
+// passed as the term search parameter and the filters of the options.
+func (s SqlTeamStore) SearchOpen(ctx context.Context, term string, opts *model.TeamSearchOpts) ([]*model.Team, *model.AppError) {
+	query := s.teamSearchQuery(term, opts, "*").
+		Where(sq.Eq{"Type": model.TEAM_OPEN, "AllowOpenInvite": true})
 
-	term = sanitizeSearchTerm(term, "\\")
-	term = wildcardSearchTerm(term)
-	operatorKeyword := "ILIKE"
-	if s.DriverName() == model.DATABASE_DRIVER_MYSQL {
-		operatorKeyword = "LIKE"
-	}
-	queryString := fmt.Sprintf("SELECT * FROM Teams WHERE Type = 'O' AND AllowOpenInvite = true AND (Name %[1]s :Term OR DisplayName %[1]s :Term)", operatorKeyword)
-	if _, err := s.GetReplica().Select(&teams, queryString, map[string]interface{}{"Term": term}); err != nil {
+	teams, err := s.searchTeams(ctx, query)
+	if err != nil {
 		return nil, model.NewAppError("SqlTeamStore.SearchOpen", "store.sql_team.search_open_team.app_error", nil, "term="+term+", "+err.Error(), http.StatusInternalServerError)
 	}
 
@@ -438,34 +806,38 @@ func (s SqlTeamStore) SearchOpen(term string) ([]*model.Team, *model.AppError) {
 }
 
 // SearchPrivate returns from the database a list of private teams that match the Name or DisplayName
-// passed as the term search parameter.
-func (s SqlTeamStore) SearchPrivate(term string) ([]*model.Team, *model.AppError) {
-	var teams []*model.Team
+// passed as the term search parameter and the filters of the options.
+func (s SqlTeamStore) SearchPrivate(ctx context.Context, term string, opts *model.TeamSearchOpts) ([]*model.Team, *model.AppError) {
+	query := s.teamSearchQuery(term, opts, "*").
+		Where(sq.Or{sq.NotEq{"Type": model.TEAM_OPEN}, sq.Eq{"AllowOpenInvite": false}})
 
-	term = sanitizeSearchTerm(term, "\\")
-	term = wildcardSearchTerm(term)
-	operatorKeyword := "ILIKE"
-	if s.DriverName() == model.DATABASE_DRIVER_MYSQL {
-		operatorKeyword = "LIKE"
-	}
-	query := fmt.Sprintf(`
-	SELECT *
-		FROM
-			Teams
-		WHERE
-			(Type != 'O' OR AllowOpenInvite = false) AND
-			(Name %[1]s :Term OR DisplayName %[1]s :Term)`, operatorKeyword)
-	if _, err := s.GetReplica().Select(&teams, query, map[string]interface{}{"Term": term}); err != nil {
+	teams, err := s.searchTeams(ctx, query)
+	if err != nil {
 		return nil, model.NewAppError("SqlTeamStore.SearchPrivate", "store.sql_team.search_private_team.app_error", nil, "term="+term+", "+err.Error(), http.StatusInternalServerError)
 	}
+
+	return teams, nil
+}
+
+// SearchPrivateForUser searches the private teams the user is an active member of.
+func (s SqlTeamStore) SearchPrivateForUser(ctx context.Context, userId string, term string, opts *model.TeamSearchOpts) ([]*model.Team, *model.AppError) {
+	query := s.teamSearchQuery(term, opts, "*").
+		Where(sq.Or{sq.NotEq{"Type": model.TEAM_OPEN}, sq.Eq{"AllowOpenInvite": false}}).
+		Where(sq.Expr("Id IN (SELECT TeamId FROM TeamMembers WHERE UserId = ? AND DeleteAt = 0)", userId))
+
+	teams, err := s.searchTeams(ctx, query)
+	if err != nil {
+		return nil, model.NewAppError("SqlTeamStore.SearchPrivateForUser", "store.sql_team.search_private_team.app_error", nil, "userId="+userId+", term="+term+", "+err.Error(), http.StatusInternalServerError)
+	}
+
 	return teams, nil
 }
 
 // GetAll returns all teams
-func (s SqlTeamStore) GetAll() ([]*model.Team, *model.AppError) {
+func (s SqlTeamStore) GetAll(ctx context.Context) ([]*model.Team, *model.AppError) {
 	var teams []*model.Team
 
-	_, err := s.GetReplica().Select(&teams, "SELECT * FROM Teams ORDER BY DisplayName")
+	_, err := s.GetReplica().WithContext(ctx).Select(&teams, "SELECT * FROM Teams ORDER BY DisplayName")
 	if err != nil {
 		return nil, model.NewAppError("SqlTeamStore.GetAllTeams", "store.sql_team.get_all.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
@@ -473,10 +845,10 @@ func (s SqlTeamStore) GetAll() ([]*model.Team, *model.AppError) {
 }
 
 // GetAllPage returns teams, up to a total limit passed as parameter and paginated by offset number passed as parameter.
-func (s SqlTeamStore) GetAllPage(offset int, limit int) ([]*model.Team, *model.AppError) {
+func (s SqlTeamStore) GetAllPage(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError) {
 	var teams []*model.Team
 
-	if _, err := s.GetReplica().Select(&teams,
+	if _, err := s.GetReplica().WithContext(ctx).Select(&teams,
 		`SELECT
 			*
 		FROM
@@ -494,10 +866,123 @@ func (s SqlTeamStore) GetAllPage(offset int, limit int) ([]*model.Team, *model.A
 	return teams, nil
 }
 
-// GetTeamsByUserId returns from the database all teams that userId belongs to.
-func (s SqlTeamStore) GetTeamsByUserId(userId string) ([]*model.Team, *model.AppError) {
+// GetAllDeletedPage returns the archived teams, ordered by display name.
+func (s SqlTeamStore) GetAllDeletedPage(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError) {
+	var teams []*model.Team
+
+	if _, err := s.GetReplica().WithContext(ctx).Select(&teams,
+		`SELECT
+			*
+		FROM
+			Teams
+		WHERE
+			DeleteAt != 0
+		ORDER BY
+			DisplayName
+		LIMIT
+			:Limit
+		OFFSET
+			:Offset`, map[string]interface{}{"Offset": offset, "Limit": limit}); err != nil {
+		return nil, model.NewAppError("SqlTeamStore.GetAllDeletedPage",
+			"store.sql_team.get_all.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return teams, nil
+}
+
+// GetTeamsWithNoActiveMembers returns the teams that aren't archived and have no active members left,
+// either because all of their members are deleted users or because they have no members at all.
+// The teams are ordered by creation time, so that they can be paged through by cleanup jobs.
+func (s SqlTeamStore) GetTeamsWithNoActiveMembers(ctx context.Context, offset int, limit int) ([]*model.Team, error) {
+	var teams []*model.Team
+
+	if _, err := s.GetReplica().WithContext(ctx).Select(&teams,
+		`SELECT
+			*
+		FROM
+			Teams
+		WHERE
+			DeleteAt = 0
+			AND NOT EXISTS (
+				SELECT
+					1
+				FROM
+					TeamMembers
+				INNER JOIN
+					Users ON Users.Id = TeamMembers.UserId
+				WHERE
+					TeamMembers.TeamId = Teams.Id
+					AND TeamMembers.DeleteAt = 0
+					AND Users.DeleteAt = 0
+			)
+		ORDER BY
+			CreateAt, Id
+		LIMIT
+			:Limit
+		OFFSET
+			:Offset`, map[string]interface{}{"Offset": offset, "Limit": limit}); err != nil {
+		return nil, errors.Wrap(err, "failed to find Teams with no active members")
+	}
+
+	return teams, nil
+}
+
+// Restore un-archives the team by clearing its DeleteAt. If the team doesn't exist it returns a
+// store.ErrNotFound.
+func (s SqlTeamStore) Restore(ctx context.Context, teamId string) error {
+	result, err := s.GetMaster().WithContext(ctx).Exec("UPDATE Teams SET DeleteAt = 0, UpdateAt = :UpdateAt WHERE Id = :TeamId", map[string]interface{}{"UpdateAt": model.GetMillis(), "TeamId": teamId})
+	if err != nil {
+		return errors.Wrapf(err, "failed to restore Team with id=%s", teamId)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "unable to get rows affected")
+	}
+	if count == 0 {
+		return store.NewErrNotFound("Team", teamId)
+	}
+
+	return nil
+}
+
+// GetTeamsByUserId returns from the database the teams that userId belongs to, following the given
+// options. Nil options return all the teams that aren't deleted.
+func (s SqlTeamStore) GetTeamsByUserId(ctx context.Context, userId string, opts *model.TeamsForUserGetOptions) ([]*model.Team, *model.AppError) {
+	if opts == nil {
+		opts = &model.TeamsForUserGetOptions{}
+	}
+
+	query := s.getQueryBuilder().
+		Select("Teams.*").
+		From("Teams").
+		Join("TeamMembers ON TeamMembers.TeamId = Teams.Id").
+		Where(sq.Eq{"TeamMembers.UserId": userId, "TeamMembers.DeleteAt": 0})
+
+	if !opts.IncludeDeleted {
+		query = query.Where(sq.Eq{"Teams.DeleteAt": 0})
+	}
+
+	switch opts.Sort {
+	case model.TEAMS_FOR_USER_SORT_NAME:
+		query = query.OrderBy("Teams.Name ASC")
+	case model.TEAMS_FOR_USER_SORT_CREATE_AT:
+		query = query.OrderBy("Teams.CreateAt ASC", "Teams.Id ASC")
+	default:
+		query = query.OrderBy("Teams.DisplayName ASC", "Teams.Id ASC")
+	}
+
+	if opts.PerPage > 0 {
+		query = query.Limit(uint64(opts.PerPage)).Offset(uint64(opts.Page * opts.PerPage))
+	}
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, model.NewAppError("SqlTeamStore.GetTeamsByUserId", "store.sql_team.get_all.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
 	var teams []*model.Team
-	if _, err := s.GetReplica().Select(&teams, "SELECT Teams.* FROM Teams, TeamMembers WHERE TeamMembers.TeamId = Teams.Id AND TeamMembers.UserId = :UserId AND TeamMembers.DeleteAt = 0 AND Teams.DeleteAt = 0", map[string]interface{}{"UserId": userId}); err != nil {
+	if _, err := s.GetReplica().WithContext(ctx).Select(&teams, queryString, args...); err != nil {
 		return nil, model.NewAppError("SqlTeamStore.GetTeamsByUserId", "store.sql_team.get_all.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
 
@@ -505,7 +990,7 @@ func (s SqlTeamStore) GetTeamsByUserId(userId string) ([]*model.Team, *model.App
 }
 
 // GetAllPrivateTeamListing returns all private teams.
-func (s SqlTeamStore) GetAllPrivateTeamListing() ([]*model.Team, *model.AppError) {
+func (s SqlTeamStore) GetAllPrivateTeamListing(ctx context.Context) ([]*model.Team, *model.AppError) {
 	query := "SELECT * FROM Teams WHERE AllowOpenInvite = 0 ORDER BY DisplayName"
 
 	if s.DriverName() == model.DATABASE_DRIVER_POSTGRES {
@@ -513,7 +998,7 @@ func (s SqlTeamStore) GetAllPrivateTeamListing() ([]*model.Team, *model.AppError
 	}
 
 	var data []*model.Team
-	if _, err := s.GetReplica().Select(&data, query); err != nil {
+	if _, err := s.GetReplica().WithContext(ctx).Select(&data, query); err != nil {
 		return nil, model.NewAppError("SqlTeamStore.GetAllPrivateTeamListing", "store.sql_team.get_all_private_team_listing.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
 
@@ -521,7 +1006,7 @@ func (s SqlTeamStore) GetAllPrivateTeamListing() ([]*model.Team, *model.AppError
 }
 
 // GetAllPublicTeamPageListing returns public teams, up to a total limit passed as parameter and paginated by offset number passed as parameter.
-func (s SqlTeamStore) GetAllPublicTeamPageListing(offset int, limit int) ([]*model.Team, *model.AppError) {
+func (s SqlTeamStore) GetAllPublicTeamPageListing(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError) {
 	query := "SELECT * FROM Teams WHERE AllowOpenInvite = 1 ORDER BY DisplayName LIMIT :Limit OFFSET :Offset"
 
 	if s.DriverName() == model.DATABASE_DRIVER_POSTGRES {
@@ -529,7 +1014,7 @@ func (s SqlTeamStore) GetAllPublicTeamPageListing(offset int, limit int) ([]*mod
 	}
 
 	var data []*model.Team
-	if _, err := s.GetReplica().Select(&data, query, map[string]interface{}{"Offset": offset, "Limit": limit}); err != nil {
+	if _, err := s.GetReplica().WithContext(ctx).Select(&data, query, map[string]interface{}{"Offset": offset, "Limit": limit}); err != nil {
 		return nil, model.NewAppError("SqlTeamStore.GetAllPrivateTeamListing", "store.sql_team.get_all_private_team_listing.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
 
@@ -537,7 +1022,7 @@ func (s SqlTeamStore) GetAllPublicTeamPageListing(offset int, limit int) ([]*mod
 }
 
 // GetAllPrivateTeamPageListing returns private teams, up to a total limit passed as paramater and paginated by offset number passed as parameter.
-func (s SqlTeamStore) GetAllPrivateTeamPageListing(offset int, limit int) ([]*model.Team, *model.AppError) {
+func (s SqlTeamStore) GetAllPrivateTeamPageListing(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError) {
 	query := "SELECT * FROM Teams WHERE AllowOpenInvite = 0 ORDER BY DisplayName LIMIT :Limit OFFSET :Offset"
 
 	if s.DriverName() == model.DATABASE_DRIVER_POSTGRES {
@@ -545,7 +1030,7 @@ func (s SqlTeamStore) GetAllPrivateTeamPageListing(offset int, limit int) ([]*mo
 	}
 
 	var data []*model.Team
-	if _, err := s.GetReplica().Select(&data, query, map[string]interface{}{"Offset": offset, "Limit": limit}); err != nil {
+	if _, err := s.GetReplica().WithContext(ctx).Select(&data, query, map[string]interface{}{"Offset": offset, "Limit": limit}); err != nil {
 		return nil, model.NewAppError("SqlTeamStore.GetAllPrivateTeamListing", "store.sql_team.get_all_private_team_listing.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
 
@@ -553,7 +1038,7 @@ func (s SqlTeamStore) GetAllPrivateTeamPageListing(offset int, limit int) ([]*mo
 }
 
 // GetAllTeamListing returns all public teams.
-func (s SqlTeamStore) GetAllTeamListing() ([]*model.Team, *model.AppError) {
+func (s SqlTeamStore) GetAllTeamListing(ctx context.Context) ([]*model.Team, *model.AppError) {
 	query := "SELECT * FROM Teams WHERE AllowOpenInvite = 1 ORDER BY DisplayName"
 
 	if s.DriverName() == model.DATABASE_DRIVER_POSTGRES {
@@ -561,7 +1046,7 @@ func (s SqlTeamStore) GetAllTeamListing() ([]*model.Team, *model.AppError) {
 	}
 
 	var data []*model.Team
-	if _, err := s.GetReplica().Select(&data, query); err != nil {
+	if _, err := s.GetReplica().WithContext(ctx).Select(&data, query); err != nil {
 		return nil, model.NewAppError("SqlTeamStore.GetAllTeamListing", "store.sql_team.get_all_team_listing.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
 
@@ -569,7 +1054,7 @@ func (s SqlTeamStore) GetAllTeamListing() ([]*model.Team, *model.AppError) {
 }
 
 // GetAllTeamPageListing returns public teams, up to a total limit passed as parameter and paginated by offset number passed as parameter.
-func (s SqlTeamStore) GetAllTeamPageListing(offset int, limit int) ([]*model.Team, *model.AppError) {
+func (s SqlTeamStore) GetAllTeamPageListing(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError) {
 	query := "SELECT * FROM Teams WHERE AllowOpenInvite = 1 ORDER BY DisplayName LIMIT :Limit OFFSET :Offset"
 
 	if s.DriverName() == model.DATABASE_DRIVER_POSTGRES {
@@ -577,7 +1062,7 @@ func (s SqlTeamStore) GetAllTeamPageListing(offset int, limit int) ([]*model.Tea
 	}
 
 	var teams []*model.Team
-	if _, err := s.GetReplica().Select(&teams, query, map[string]interface{}{"Offset": offset, "Limit": limit}); err != nil {
+	if _, err := s.GetReplica().WithContext(ctx).Select(&teams, query, map[string]interface{}{"Offset": offset, "Limit": limit}); err != nil {
 		return nil, model.NewAppError("SqlTeamStore.GetAllTeamListing", "store.sql_team.get_all_team_listing.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
 
@@ -586,20 +1071,36 @@ func (s SqlTeamStore) GetAllTeamPageListing(offset int, limit int) ([]*model.Tea
 
 // PermanentDelete permanently deletes from the database the team entry that matches the teamId passed as parameter.
 // To soft-delete the team you can Update it with the DeleteAt field set to the current millisecond using model.GetMillis()
-func (s SqlTeamStore) PermanentDelete(teamId string) *model.AppError {
-	if _, err := s.GetMaster().Exec("DELETE FROM Teams WHERE Id = :TeamId", map[string]interface{}{"TeamId": teamId}); err != nil {
+// The team members are deleted along with it, after recording that they left the team.
+func (s SqlTeamStore) PermanentDelete(ctx context.Context, teamId string) *model.AppError {
+	transaction, err := s.GetMaster().BeginTx(ctx, nil)
+	if err != nil {
+		return model.NewAppError("SqlTeamStore.Delete", "store.sql_team.permanent_delete.app_error", nil, "teamId="+teamId+", "+err.Error(), http.StatusInternalServerError)
+	}
+	defer finalizeTransaction(transaction)
+
+	if err = s.logTeamMemberLeaveEvents(transaction, sq.Eq{"TeamId": teamId}, model.GetMillis()); err != nil {
+		return model.NewAppError("SqlTeamStore.Delete", "store.sql_team.permanent_delete.app_error", nil, "teamId="+teamId+", "+err.Error(), http.StatusInternalServerError)
+	}
+	if _, err = transaction.Exec("DELETE FROM TeamMembers WHERE TeamId = :TeamId", map[string]interface{}{"TeamId": teamId}); err != nil {
+		return model.NewAppError("SqlTeamStore.Delete", "store.sql_team.permanent_delete.app_error", nil, "teamId="+teamId+", "+err.Error(), http.StatusInternalServerError)
+	}
+	if _, err = transaction.Exec("DELETE FROM Teams WHERE Id = :TeamId", map[string]interface{}{"TeamId": teamId}); err != nil {
+		return model.NewAppError("SqlTeamStore.Delete", "store.sql_team.permanent_delete.app_error", nil, "teamId="+teamId+", "+err.Error(), http.StatusInternalServerError)
+	}
+	if err = transaction.Commit(); err != nil {
 		return model.NewAppError("SqlTeamStore.Delete", "store.sql_team.permanent_delete.app_error", nil, "teamId="+teamId+", "+err.Error(), http.StatusInternalServerError)
 	}
 	return nil
 }
 
 // AnalyticsPublicTeamCount returns the number of active public teams.
-func (s SqlTeamStore) AnalyticsPublicTeamCount() (int64, *model.AppError) {
+func (s SqlTeamStore) AnalyticsPublicTeamCount(ctx context.Context) (int64, *model.AppError) {
 
-	c, err := s.GetReplica().SelectInt("SELECT COUNT(*) FROM Teams WHERE DeleteAt = 0 AND AllowOpenInvite = 1", map[string]interface{}{})
+	c, err := s.GetReplica().WithContext(ctx).SelectInt("SELECT COUNT(*) FROM Teams WHERE DeleteAt = 0 AND AllowOpenInvite = 1", map[string]interface{}{})
 
 	if s.DriverName() == model.DATABASE_DRIVER_POSTGRES {
-		c, err = s.GetReplica().SelectInt("SELECT COUNT(*) FROM Teams WHERE DeleteAt = 0 AND AllowOpenInvite = true", map[string]interface{}{})
+		c, err = s.GetReplica().WithContext(ctx).SelectInt("SELECT COUNT(*) FROM Teams WHERE DeleteAt = 0 AND AllowOpenInvite = true", map[string]interface{}{})
 	}
 
 	if err != nil {
@@ -610,11 +1111,11 @@ func (s SqlTeamStore) AnalyticsPublicTeamCount() (int64, *model.AppError) {
 }
 
 // AnalyticsPrivateTeamCount returns the number of active private teams.
-func (s SqlTeamStore) AnalyticsPrivateTeamCount() (int64, *model.AppError) {
-	c, err := s.GetReplica().SelectInt("SELECT COUNT(*) FROM Teams WHERE DeleteAt = 0 AND AllowOpenInvite = 0", map[string]interface{}{})
+func (s SqlTeamStore) AnalyticsPrivateTeamCount(ctx context.Context) (int64, *model.AppError) {
+	c, err := s.GetReplica().WithContext(ctx).SelectInt("SELECT COUNT(*) FROM Teams WHERE DeleteAt = 0 AND AllowOpenInvite = 0", map[string]interface{}{})
 
 	if s.DriverName() == model.DATABASE_DRIVER_POSTGRES {
-		c, err = s.GetReplica().SelectInt("SELECT COUNT(*) FROM Teams WHERE DeleteAt = 0 AND AllowOpenInvite = false", map[string]interface{}{})
+		c, err = s.GetReplica().WithContext(ctx).SelectInt("SELECT COUNT(*) FROM Teams WHERE DeleteAt = 0 AND AllowOpenInvite = false", map[string]interface{}{})
 	}
 
 	if err != nil {
@@ -624,8 +1125,88 @@ func (s SqlTeamStore) AnalyticsPrivateTeamCount() (int64, *model.AppError) {
 	return c, nil
 }
 
+// GetDirectoryStats returns the member count, recent joins and last activity of every open team.
+// Joins are read from the history of the default channel, which every member of a team joins.
+func (s SqlTeamStore) GetDirectoryStats(ctx context.Context, joinedSince int64) ([]*model.TeamDirectoryStats, *model.AppError) {
+	query := `
+		SELECT
+			t.Id AS TeamId,
+			(SELECT COUNT(*) FROM TeamMembers tm
+				INNER JOIN Users u ON u.Id = tm.UserId
+				WHERE tm.TeamId = t.Id AND tm.DeleteAt = 0 AND u.DeleteAt = 0) AS MemberCount,
+			(SELECT COUNT(DISTINCT cmh.UserId) FROM ChannelMemberHistory cmh
+				INNER JOIN Channels c ON c.Id = cmh.ChannelId
+				WHERE c.TeamId = t.Id AND c.Name = :DefaultChannel AND cmh.JoinTime >= :JoinedSince) AS RecentJoinCount,
+			(SELECT COALESCE(MAX(c.LastPostAt), 0) FROM Channels c
+				WHERE c.TeamId = t.Id AND c.Type = :ChannelType AND c.DeleteAt = 0) AS LastActivityAt
+		FROM Teams t
+		WHERE t.DeleteAt = 0 AND t.Type = :TeamType AND t.AllowOpenInvite = :AllowOpenInvite`
+
+	params := map[string]interface{}{
+		"DefaultChannel":  model.DEFAULT_CHANNEL,
+		"JoinedSince":     joinedSince,
+		"ChannelType":     model.CHANNEL_OPEN,
+		"TeamType":        model.TEAM_OPEN,
+		"AllowOpenInvite": true,
+	}
+
+	var stats []*model.TeamDirectoryStats
+	if _, err := s.GetReplica().WithContext(ctx).Select(&stats, query, params); err != nil {
+		return nil, model.NewAppError("SqlTeamStore.GetDirectoryStats", "store.sql_team.get_directory_stats.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return stats, nil
+}
+
+// GetNewTeamMembersSince returns a page of the active users who joined the team since the given
+// time, most recent first. Users are considered to have joined the team when they last joined it,
+// as recorded by the TeamMemberHistory, so that members who left and came back count as new. Bots
+// are left out.
+func (s SqlTeamStore) GetNewTeamMembersSince(ctx context.Context, teamId string, since int64, offset int, limit int) (*model.NewTeamMembersList, error) {
+	joinersQuery := `
+		SELECT
+			u.Id, u.Username, u.FirstName, u.LastName, u.Nickname, u.Position, u.LastPictureUpdate,
+			MAX(tmh.JoinTime) AS JoinedAt
+		FROM TeamMembers tm
+			INNER JOIN Users u ON u.Id = tm.UserId
+			INNER JOIN TeamMemberHistory tmh ON tmh.TeamId = tm.TeamId AND tmh.UserId = tm.UserId AND tmh.LeaveTime IS NULL
+			LEFT JOIN Bots b ON b.UserId = u.Id
+		WHERE tm.TeamId = :TeamId AND tm.DeleteAt = 0 AND u.DeleteAt = 0 AND b.UserId IS NULL
+		GROUP BY u.Id, u.Username, u.FirstName, u.LastName, u.Nickname, u.Position, u.LastPictureUpdate
+		HAVING MAX(tmh.JoinTime) >= :Since`
+
+	params := map[string]interface{}{
+		"TeamId": teamId,
+		"Since":  since,
+		"Offset": offset,
+		// One more member than requested tells whether there is a next page.
+		"Limit": limit + 1,
+	}
+
+	count, err := s.GetReplica().WithContext(ctx).SelectInt("SELECT COUNT(*) FROM ("+joinersQuery+") AS Joiners", params)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to count new TeamMembers with teamId=%s", teamId)
+	}
+
+	members := []*model.NewTeamMember{}
+	if _, err := s.GetReplica().WithContext(ctx).Select(&members, joinersQuery+" ORDER BY JoinedAt DESC, u.Id ASC LIMIT :Limit OFFSET :Offset", params); err != nil {
+		return nil, errors.Wrapf(err, "failed to find new TeamMembers with teamId=%s", teamId)
+	}
+
+	list := &model.NewTeamMembersList{
+		Items:      members,
+		TotalCount: count,
+	}
+	if len(members) > limit {
+		list.HasNext = true
+		list.Items = members[:limit]
+	}
+
+	return list, nil
+}
+
 // AnalyticsTeamCount returns the total number of teams including deleted teams if parameter passed is set to 'true'.
-func (s SqlTeamStore) AnalyticsTeamCount(includeDeleted bool) (int64, *model.AppError) {
+func (s SqlTeamStore) AnalyticsTeamCount(ctx context.Context, includeDeleted bool) (int64, *model.AppError) {
 	query := s.getQueryBuilder().Select("COUNT(*) FROM Teams")
 	if !includeDeleted {
 		query = query.Where(sq.Eq{"DeleteAt": 0})
@@ -636,7 +1217,7 @@ func (s SqlTeamStore) AnalyticsTeamCount(includeDeleted bool) (int64, *model.App
 		return 0, model.NewAppError("SqlTeamStore.AnalyticsTeamCount", "store.sql_team.analytics_team_count.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
 
-	c, err := s.GetReplica().SelectInt(queryString, args...)
+	c, err := s.GetReplica().WithContext(ctx).SelectInt(queryString, args...)
 
 	if err != nil {
 		return int64(0), model.NewAppError("SqlTeamStore.AnalyticsTeamCount", "store.sql_team.analytics_team_count.app_error", nil, err.Error(), http.StatusInternalServerError)
@@ -658,7 +1239,10 @@ func (s SqlTeamStore) getTeamMembersWithSchemeSelectQuery() sq.SelectBuilder {
 		LeftJoin("Schemes TeamScheme ON Teams.SchemeId = TeamScheme.Id")
 }
 
-func (s SqlTeamStore) SaveMultipleMembers(members []*model.TeamMember, maxUsersPerTeam int) ([]*model.TeamMember, *model.AppError) {
+// SaveMultipleMembers saves the team members, failing with ErrLimitExceeded if a team would exceed
+// maxUsersPerTeam active members. The teams are locked while counting their members, so that
+// concurrent saves of members of a team can't exceed the limit together.
+func (s SqlTeamStore) SaveMultipleMembers(ctx context.Context, members []*model.TeamMember, maxUsersPerTeam int) ([]*model.TeamMember, error) {
 	newTeamMembers := map[string]int{}
 	users := map[string]bool{}
 	for _, member := range members {
@@ -678,6 +1262,14 @@ func (s SqlTeamStore) SaveMultipleMembers(members []*model.TeamMember, maxUsersP
 	for team := range newTeamMembers {
 		teams = append(teams, team)
 	}
+	// Locks the teams in the same order, so that concurrent saves don't deadlock.
+	sort.Strings(teams)
+
+	transaction, err := s.GetMaster().BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransaction(transaction)
 
 	defaultTeamRolesByTeam := map[string]struct {
 		Id    string
@@ -699,7 +1291,7 @@ func (s SqlTeamStore) SaveMultipleMembers(members []*model.TeamMember, maxUsersP
 
 	sqlRolesQuery, argsRoles, err := queryRoles.ToSql()
 	if err != nil {
-		return nil, model.NewAppError("SqlUserStore.Save", "store.sql_user.save.member_count.app_error", nil, err.Error(), http.StatusInternalServerError)
+		return nil, errors.Wrap(err, "team_roles_tosql")
 	}
 	var defaultTeamsRoles []struct {
 		Id    string
@@ -707,9 +1299,9 @@ func (s SqlTeamStore) SaveMultipleMembers(members []*model.TeamMember, maxUsersP
 		User  sql.NullString
 		Admin sql.NullString
 	}
-	_, err = s.GetMaster().Select(&defaultTeamsRoles, sqlRolesQuery, argsRoles...)
+	_, err = transaction.Select(&defaultTeamsRoles, sqlRolesQuery, argsRoles...)
 	if err != nil {
-		return nil, model.NewAppError("SqlUserStore.Save", "store.sql_user.save.member_count.app_error", nil, err.Error(), http.StatusInternalServerError)
+		return nil, errors.Wrap(err, "failed to find default team roles")
 	}
 
 	for _, defaultRoles := range defaultTeamsRoles {
@@ -717,6 +1309,25 @@ func (s SqlTeamStore) SaveMultipleMembers(members []*model.TeamMember, maxUsersP
 	}
 
 	if maxUsersPerTeam >= 0 {
+		// SELECT ... FOR UPDATE takes the row locks on both Postgres and MySQL, held until the
+		// transaction ends.
+		queryLock := s.getQueryBuilder().
+			Select("Id").
+			From("Teams").
+			Where(sq.Eq{"Id": teams}).
+			OrderBy("Id").
+			Suffix("FOR UPDATE")
+
+		sqlLockQuery, argsLock, errLock := queryLock.ToSql()
+		if errLock != nil {
+			return nil, errors.Wrap(errLock, "lock_teams_tosql")
+		}
+
+		var lockedTeams []string
+		if _, err = transaction.Select(&lockedTeams, sqlLockQuery, argsLock...); err != nil {
+			return nil, errors.Wrap(err, "failed to lock the teams")
+		}
+
 		queryCount := s.getQueryBuilder().
 			Select(
 				"COUNT(0) as Count, TeamMembers.TeamId as TeamId",
@@ -730,7 +1341,7 @@ func (s SqlTeamStore) SaveMultipleMembers(members []*model.TeamMember, maxUsersP
 
 		sqlCountQuery, argsCount, errCount := queryCount.ToSql()
 		if errCount != nil {
-			return nil, model.NewAppError("SqlUserStore.Save", "store.sql_user.save.member_count.app_error", nil, errCount.Error(), http.StatusInternalServerError)
+			return nil, errors.Wrap(errCount, "member_count_tosql")
 		}
 
 		var counters []struct {
@@ -738,9 +1349,9 @@ func (s SqlTeamStore) SaveMultipleMembers(members []*model.TeamMember, maxUsersP
 			TeamId string `db:"TeamId"`
 		}
 
-		_, err = s.GetMaster().Select(&counters, sqlCountQuery, argsCount...)
+		_, err = transaction.Select(&counters, sqlCountQuery, argsCount...)
 		if err != nil {
-			return nil, model.NewAppError("SqlUserStore.Save", "store.sql_user.save.member_count.app_error", nil, err.Error(), http.StatusInternalServerError)
+			return nil, errors.Wrap(err, "failed to count the team members")
 		}
 
 		for teamId, newMembers := range newTeamMembers {
@@ -751,7 +1362,7 @@ func (s SqlTeamStore) SaveMultipleMembers(members []*model.TeamMember, maxUsersP
 				}
 			}
 			if existingMembers+newMembers > maxUsersPerTeam {
-				return nil, model.NewAppError("SqlUserStore.Save", "store.sql_user.save.max_accounts.app_error", nil, "", http.StatusBadRequest)
+				return nil, store.NewErrLimitExceeded("TeamMember", existingMembers+newMembers, "teamId="+teamId)
 			}
 		}
 	}
@@ -763,19 +1374,30 @@ func (s SqlTeamStore) SaveMultipleMembers(members []*model.TeamMember, maxUsersP
 
 	sql, args, err := query.ToSql()
 	if err != nil {
-		return nil, model.NewAppError("SqlTeamStore.SaveMember", "store.sql_team.save_member.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		return nil, errors.Wrap(err, "team_members_tosql")
+	}
+
+	if _, err := transaction.Exec(sql, args...); err != nil {
+		if IsUniqueConstraintError(err, []string{"TeamId", "teammembers_pkey", "PRIMARY"}) {
+			return nil, store.NewErrConflict("TeamMember", err, "")
+		}
+		return nil, errors.Wrap(err, "failed to save TeamMembers")
+	}
+
+	if err := s.logTeamMemberJoinEvents(transaction, members, model.GetMillis()); err != nil {
+		return nil, err
+	}
+
+	if err := s.updateMemberCounts(transaction, teams); err != nil {
+		return nil, err
 	}
 
-	if _, err := s.GetMaster().Exec(sql, args...); err != nil {
-		if IsUniqueConstraintError(err, []string{"TeamId", "teammembers_pkey", "PRIMARY"}) {
-			return nil, model.NewAppError("SqlTeamStore.SaveMember", TEAM_MEMBER_EXISTS_ERROR, nil, err.Error(), http.StatusBadRequest)
-		}
-		return nil, model.NewAppError("SqlTeamStore.SaveMember", "store.sql_team.save_member.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+	if err := transaction.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit_transaction")
 	}
 
 	newMembers := []*model.TeamMember{}
 	for _, member := range members {
-		s.InvalidateAllTeamIdsForUser(member.UserId)
 		defaultTeamGuestRole := defaultTeamRolesByTeam[member.TeamId].Guest.String
 		defaultTeamUserRole := defaultTeamRolesByTeam[member.TeamId].User.String
 		defaultTeamAdminRole := defaultTeamRolesByTeam[member.TeamId].Admin.String
@@ -792,15 +1414,21 @@ func (s SqlTeamStore) SaveMultipleMembers(members []*model.TeamMember, maxUsersP
 	return newMembers, nil
 }
 
-func (s SqlTeamStore) SaveMember(member *model.TeamMember, maxUsersPerTeam int) (*model.TeamMember, *model.AppError) {
-	members, err := s.SaveMultipleMembers([]*model.TeamMember{member}, maxUsersPerTeam)
+func (s SqlTeamStore) SaveMember(ctx context.Context, member *model.TeamMember, maxUsersPerTeam int) (*model.TeamMember, error) {
+	members, err := s.SaveMultipleMembers(ctx, []*model.TeamMember{member}, maxUsersPerTeam)
 	if err != nil {
 		return nil, err
 	}
 	return members[0], nil
 }
 
-func (s SqlTeamStore) UpdateMultipleMembers(members []*model.TeamMember) ([]*model.TeamMember, *model.AppError) {
+func (s SqlTeamStore) UpdateMultipleMembers(ctx context.Context, members []*model.TeamMember) ([]*model.TeamMember, *model.AppError) {
+	transaction, err := s.GetMaster().BeginTx(ctx, nil)
+	if err != nil {
+		return nil, model.NewAppError("SqlTeamStore.UpdateMember", "store.sql_team.save_member.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	defer finalizeTransaction(transaction)
+
 	teams := []string{}
 	for _, member := range members {
 		member.PreUpdate()
@@ -809,10 +1437,34 @@ func (s SqlTeamStore) UpdateMultipleMembers(members []*model.TeamMember) ([]*mod
 			return nil, err
 		}
 
-		if _, err := s.GetMaster().Update(NewTeamMemberFromModel(member)); err != nil {
+		oldDeleteAt, err := transaction.SelectInt("SELECT DeleteAt FROM TeamMembers WHERE TeamId = :TeamId AND UserId = :UserId", map[string]interface{}{"TeamId": member.TeamId, "UserId": member.UserId})
+		if err != nil {
+			return nil, model.NewAppError("SqlTeamStore.UpdateMember", "store.sql_team.save_member.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+
+		if _, err := transaction.Update(NewTeamMemberFromModel(member)); err != nil {
 			return nil, model.NewAppError("SqlTeamStore.UpdateMember", "store.sql_team.save_member.save.app_error", nil, err.Error(), http.StatusInternalServerError)
 		}
 		teams = append(teams, member.TeamId)
+
+		// Leaving and rejoining a team only toggle the DeleteAt of the member.
+		if oldDeleteAt == 0 && member.DeleteAt != 0 {
+			err = s.logTeamMemberLeaveEvents(transaction, sq.Eq{"TeamId": member.TeamId, "UserId": member.UserId}, member.DeleteAt)
+		} else if oldDeleteAt != 0 && member.DeleteAt == 0 {
+			err = s.logTeamMemberJoinEvents(transaction, []*model.TeamMember{member}, model.GetMillis())
+		}
+		if err != nil {
+			return nil, model.NewAppError("SqlTeamStore.UpdateMember", "store.sql_team.save_member.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	// Leaving a team only sets the DeleteAt of the member, so the counts have to follow updates too.
+	if err := s.updateMemberCounts(transaction, teams); err != nil {
+		return nil, model.NewAppError("SqlTeamStore.UpdateMember", "store.sql_team.save_member.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return nil, model.NewAppError("SqlTeamStore.UpdateMember", "store.sql_team.save_member.save.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
 
 	query := s.getQueryBuilder().
@@ -836,7 +1488,7 @@ func (s SqlTeamStore) UpdateMultipleMembers(members []*model.TeamMember) ([]*mod
 		User  sql.NullString
 		Admin sql.NullString
 	}
-	_, err = s.GetMaster().Select(&defaultTeamsRoles, sqlQuery, args...)
+	_, err = s.GetMaster().WithContext(ctx).Select(&defaultTeamsRoles, sqlQuery, args...)
 	if err != nil {
 		return nil, model.NewAppError("SqlUserStore.Save", "store.sql_user.save.member_count.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
@@ -853,7 +1505,6 @@ func (s SqlTeamStore) UpdateMultipleMembers(members []*model.TeamMember) ([]*mod
 
 	updatedMembers := []*model.TeamMember{}
 	for _, member := range members {
-		s.InvalidateAllTeamIdsForUser(member.UserId)
 		defaultTeamGuestRole := defaultTeamRolesByTeam[member.TeamId].Guest.String
 		defaultTeamUserRole := defaultTeamRolesByTeam[member.TeamId].User.String
 		defaultTeamAdminRole := defaultTeamRolesByTeam[member.TeamId].Admin.String
@@ -870,37 +1521,56 @@ func (s SqlTeamStore) UpdateMultipleMembers(members []*model.TeamMember) ([]*mod
 	return updatedMembers, nil
 }
 
-func (s SqlTeamStore) UpdateMember(member *model.TeamMember) (*model.TeamMember, *model.AppError) {
-	members, err := s.UpdateMultipleMembers([]*model.TeamMember{member})
+func (s SqlTeamStore) UpdateMember(ctx context.Context, member *model.TeamMember) (*model.TeamMember, *model.AppError) {
+	members, err := s.UpdateMultipleMembers(ctx, []*model.TeamMember{member})
 	if err != nil {
 		return nil, err
 	}
 	return members[0], nil
 }
 
-func (s SqlTeamStore) GetMember(teamId string, userId string) (*model.TeamMember, *model.AppError) {
+// GetMember returns the member of the team, or a store.ErrNotFound if the user doesn't belong to
+// the team.
+func (s SqlTeamStore) GetMember(ctx context.Context, teamId string, userId string, allowFromCache bool) (*model.TeamMember, error) {
 	query := s.getTeamMembersWithSchemeSelectQuery().
 		Where(sq.Eq{"TeamMembers.TeamId": teamId}).
 		Where(sq.Eq{"TeamMembers.UserId": userId})
 
 	queryString, args, err := query.ToSql()
 	if err != nil {
-		return nil, model.NewAppError("SqlTeamStore.GetMember", "store.sql_team.get_member.app_error", nil, err.Error(), http.StatusInternalServerError)
+		return nil, errors.Wrap(err, "team_tosql")
 	}
 
 	var dbMember teamMemberWithSchemeRoles
-	err = s.GetReplica().SelectOne(&dbMember, queryString, args...)
+	err = s.GetReplica().WithContext(ctx).SelectOne(&dbMember, queryString, args...)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, model.NewAppError("SqlTeamStore.GetMember", "store.sql_team.get_member.missing.app_error", nil, "teamId="+teamId+" userId="+userId+" "+err.Error(), http.StatusNotFound)
+			return nil, store.NewErrNotFound("TeamMember", fmt.Sprintf("teamId=%s, userId=%s", teamId, userId))
 		}
-		return nil, model.NewAppError("SqlTeamStore.GetMember", "store.sql_team.get_member.app_error", nil, "teamId="+teamId+" userId="+userId+" "+err.Error(), http.StatusInternalServerError)
+		return nil, errors.Wrapf(err, "failed to find TeamMember with teamId=%s and userId=%s", teamId, userId)
 	}
 
 	return dbMember.ToModel(), nil
 }
 
-func (s SqlTeamStore) GetMembers(teamId string, offset int, limit int, teamMembersGetOptions *model.TeamMembersGetOptions) ([]*model.TeamMember, *model.AppError) {
+// teamMemberRolesFilter matches the team members holding any of the given team scheme roles. Roles
+// other than the team admin, user and guest roles match nothing.
+func teamMemberRolesFilter(roles []string) sq.Or {
+	filter := sq.Or{}
+	for _, role := range roles {
+		switch role {
+		case model.TEAM_ADMIN_ROLE_ID:
+			filter = append(filter, sq.Eq{"TeamMembers.SchemeAdmin": true})
+		case model.TEAM_USER_ROLE_ID:
+			filter = append(filter, sq.And{sq.Eq{"TeamMembers.SchemeUser": true}, sq.Eq{"TeamMembers.SchemeAdmin": false}})
+		case model.TEAM_GUEST_ROLE_ID:
+			filter = append(filter, sq.Eq{"TeamMembers.SchemeGuest": true})
+		}
+	}
+	return filter
+}
+
+func (s SqlTeamStore) GetMembers(ctx context.Context, teamId string, offset int, limit int, teamMembersGetOptions *model.TeamMembersGetOptions) ([]*model.TeamMember, *model.AppError) {
 	query := s.getTeamMembersWithSchemeSelectQuery().
 		Where(sq.Eq{"TeamMembers.TeamId": teamId}).
 		Where(sq.Eq{"TeamMembers.DeleteAt": 0}).
@@ -924,6 +1594,17 @@ func (s SqlTeamStore) GetMembers(teamId string, offset int, limit int, teamMembe
 			query = query.OrderBy(model.USERNAME)
 		}
 
+		if teamMembersGetOptions.Sort == model.TEAM_MEMBERS_SORT_LAST_ACTIVITY {
+			// Members without a status have never been active, so they come last.
+			query = query.
+				LeftJoin("Status ON TeamMembers.UserId = Status.UserId").
+				OrderBy("COALESCE(Status.LastActivityAt, 0) DESC", "TeamMembers.UserId")
+		}
+
+		if len(teamMembersGetOptions.Roles) > 0 {
+			query = query.Where(teamMemberRolesFilter(teamMembersGetOptions.Roles))
+		}
+
 		query = applyTeamMemberViewRestrictionsFilter(query, teamId, teamMembersGetOptions.ViewRestrictions)
 	}
 
@@ -933,7 +1614,7 @@ func (s SqlTeamStore) GetMembers(teamId string, offset int, limit int, teamMembe
 	}
 
 	var dbMembers teamMemberWithSchemeRolesList
-	_, err = s.GetReplica().Select(&dbMembers, queryString, args...)
+	_, err = s.GetReplica().WithContext(ctx).Select(&dbMembers, queryString, args...)
 	if err != nil {
 		return nil, model.NewAppError("SqlTeamStore.GetMembers", "store.sql_team.get_members.app_error", nil, "teamId="+teamId+" "+err.Error(), http.StatusInternalServerError)
 	}
@@ -941,7 +1622,17 @@ func (s SqlTeamStore) GetMembers(teamId string, offset int, limit int, teamMembe
 	return dbMembers.ToModel(), nil
 }
 
-func (s SqlTeamStore) GetTotalMemberCount(teamId string, restrictions *model.ViewUsersRestrictions) (int64, *model.AppError) {
+// GetTotalMemberCount returns the number of members of the team. Without view restrictions it
+// reads the count kept in Teams.MemberCount instead of counting the TeamMembers.
+func (s SqlTeamStore) GetTotalMemberCount(ctx context.Context, teamId string, restrictions *model.ViewUsersRestrictions) (int64, *model.AppError) {
+	if restrictions == nil {
+		count, err := s.GetReplica().WithContext(ctx).SelectInt("SELECT MemberCount FROM Teams WHERE Id = :TeamId", map[string]interface{}{"TeamId": teamId})
+		if err != nil {
+			return int64(0), model.NewAppError("SqlTeamStore.GetTotalMemberCount", "store.sql_team.get_member_count.app_error", nil, "teamId="+teamId+" "+err.Error(), http.StatusInternalServerError)
+		}
+		return count, nil
+	}
+
 	query := s.getQueryBuilder().
 		Select("count(DISTINCT TeamMembers.UserId)").
 		From("TeamMembers, Users").
@@ -955,14 +1646,14 @@ func (s SqlTeamStore) GetTotalMemberCount(teamId string, restrictions *model.Vie
 		return int64(0), model.NewAppError("SqlTeamStore.GetTotalMemberCount", "store.sql_team.get_member_count.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
 
-	count, err := s.GetReplica().SelectInt(queryString, args...)
+	count, err := s.GetReplica().WithContext(ctx).SelectInt(queryString, args...)
 	if err != nil {
 		return int64(0), model.NewAppError("SqlTeamStore.GetTotalMemberCount", "store.sql_team.get_member_count.app_error", nil, "teamId="+teamId+" "+err.Error(), http.StatusInternalServerError)
 	}
 	return count, nil
 }
 
-func (s SqlTeamStore) GetActiveMemberCount(teamId string, restrictions *model.ViewUsersRestrictions) (int64, *model.AppError) {
+func (s SqlTeamStore) GetActiveMemberCount(ctx context.Context, teamId string, restrictions *model.ViewUsersRestrictions) (int64, *model.AppError) {
 	query := s.getQueryBuilder().
 		Select("count(DISTINCT TeamMembers.UserId)").
 		From("TeamMembers, Users").
@@ -977,7 +1668,7 @@ func (s SqlTeamStore) GetActiveMemberCount(teamId string, restrictions *model.Vi
 		return 0, model.NewAppError("SqlTeamStore.GetActiveMemberCount", "store.sql_team.get_active_member_count.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
 
-	count, err := s.GetReplica().SelectInt(queryString, args...)
+	count, err := s.GetReplica().WithContext(ctx).SelectInt(queryString, args...)
 	if err != nil {
 		return 0, model.NewAppError("SqlTeamStore.GetActiveMemberCount", "store.sql_team.get_active_member_count.app_error", nil, "teamId="+teamId+" "+err.Error(), http.StatusInternalServerError)
 	}
@@ -985,7 +1676,46 @@ func (s SqlTeamStore) GetActiveMemberCount(teamId string, restrictions *model.Vi
 	return count, nil
 }
 
-func (s SqlTeamStore) GetMembersByIds(teamId string, userIds []string, restrictions *model.ViewUsersRestrictions) ([]*model.TeamMember, *model.AppError) {
+// GetActiveMemberCounts returns the number of active members of each of the teams, counted in a
+// single query. The teams without any active member are mapped to 0.
+func (s SqlTeamStore) GetActiveMemberCounts(ctx context.Context, teamIds []string) (map[string]int64, error) {
+	counts := make(map[string]int64, len(teamIds))
+	if len(teamIds) == 0 {
+		return counts, nil
+	}
+
+	query := s.getQueryBuilder().
+		Select("TeamMembers.TeamId", "COUNT(DISTINCT TeamMembers.UserId) AS Count").
+		From("TeamMembers").
+		Join("Users ON Users.Id = TeamMembers.UserId").
+		Where(sq.Eq{"TeamMembers.TeamId": teamIds}).
+		Where(sq.Eq{"TeamMembers.DeleteAt": 0, "Users.DeleteAt": 0}).
+		GroupBy("TeamMembers.TeamId")
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "active_member_counts_tosql")
+	}
+
+	var rows []struct {
+		TeamId string
+		Count  int64
+	}
+	if _, err := s.GetReplica().WithContext(ctx).Select(&rows, queryString, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to count the active TeamMembers")
+	}
+
+	for _, teamId := range teamIds {
+		counts[teamId] = 0
+	}
+	for _, row := range rows {
+		counts[row.TeamId] = row.Count
+	}
+
+	return counts, nil
+}
+
+func (s SqlTeamStore) GetMembersByIds(ctx context.Context, teamId string, userIds []string, restrictions *model.ViewUsersRestrictions) ([]*model.TeamMember, *model.AppError) {
 	if len(userIds) == 0 {
 		return nil, model.NewAppError("SqlTeamStore.GetMembersByIds", "store.sql_team.get_members_by_ids.app_error", nil, "Invalid list of user ids", http.StatusInternalServerError)
 	}
@@ -1003,13 +1733,58 @@ func (s SqlTeamStore) GetMembersByIds(teamId string, userIds []string, restricti
 	}
 
 	var dbMembers teamMemberWithSchemeRolesList
-	if _, err := s.GetReplica().Select(&dbMembers, queryString, args...); err != nil {
+	if _, err := s.GetReplica().WithContext(ctx).Select(&dbMembers, queryString, args...); err != nil {
 		return nil, model.NewAppError("SqlTeamStore.GetMembersByIds", "store.sql_team.get_members_by_ids.app_error", nil, "teamId="+teamId+" "+err.Error(), http.StatusInternalServerError)
 	}
 	return dbMembers.ToModel(), nil
 }
 
-func (s SqlTeamStore) GetTeamsForUser(userId string) ([]*model.TeamMember, *model.AppError) {
+// GetMembersByTeamIds returns the members of the given user in any of the given teams, including the
+// ones who left, in a single query.
+func (s SqlTeamStore) GetMembersByTeamIds(ctx context.Context, teamIds []string, userId string) ([]*model.TeamMember, *model.AppError) {
+	if len(teamIds) == 0 {
+		return []*model.TeamMember{}, nil
+	}
+
+	query := s.getTeamMembersWithSchemeSelectQuery().
+		Where(sq.Eq{"TeamMembers.TeamId": teamIds}).
+		Where(sq.Eq{"TeamMembers.UserId": userId})
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, model.NewAppError("SqlTeamStore.GetMembersByTeamIds", "store.sql_team.get_members_by_team_ids.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	var dbMembers teamMemberWithSchemeRolesList
+	if _, err := s.GetReplica().WithContext(ctx).Select(&dbMembers, queryString, args...); err != nil {
+		return nil, model.NewAppError("SqlTeamStore.GetMembersByTeamIds", "store.sql_team.get_members_by_team_ids.app_error", nil, "userId="+userId+" "+err.Error(), http.StatusInternalServerError)
+	}
+	return dbMembers.ToModel(), nil
+}
+
+// GetMembersWithExpiredRoles returns the active team members whose explicit roles expired at or
+// before expiredBefore, oldest expiry first.
+func (s SqlTeamStore) GetMembersWithExpiredRoles(ctx context.Context, expiredBefore int64, limit int) ([]*model.TeamMember, error) {
+	query := s.getTeamMembersWithSchemeSelectQuery().
+		Where(sq.Gt{"TeamMembers.ExplicitRolesExpiresAt": 0}).
+		Where(sq.LtOrEq{"TeamMembers.ExplicitRolesExpiresAt": expiredBefore}).
+		Where(sq.Eq{"TeamMembers.DeleteAt": 0}).
+		OrderBy("TeamMembers.ExplicitRolesExpiresAt ASC", "TeamMembers.TeamId ASC", "TeamMembers.UserId ASC").
+		Limit(uint64(limit))
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_members_tosql")
+	}
+
+	var dbMembers teamMemberWithSchemeRolesList
+	if _, err := s.GetReplica().WithContext(ctx).Select(&dbMembers, queryString, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to find TeamMembers with expired roles")
+	}
+	return dbMembers.ToModel(), nil
+}
+
+func (s SqlTeamStore) GetTeamsForUser(ctx context.Context, userId string) ([]*model.TeamMember, *model.AppError) {
 	query := s.getTeamMembersWithSchemeSelectQuery().
 		Where(sq.Eq{"TeamMembers.UserId": userId})
 
@@ -1019,7 +1794,7 @@ func (s SqlTeamStore) GetTeamsForUser(userId string) ([]*model.TeamMember, *mode
 	}
 
 	var dbMembers teamMemberWithSchemeRolesList
-	_, err = s.GetReplica().Select(&dbMembers, queryString, args...)
+	_, err = s.GetReplica().WithContext(ctx).Select(&dbMembers, queryString, args...)
 	if err != nil {
 		return nil, model.NewAppError("SqlTeamStore.GetMembers", "store.sql_team.get_members.app_error", nil, "userId="+userId+" "+err.Error(), http.StatusInternalServerError)
 	}
@@ -1027,31 +1802,48 @@ func (s SqlTeamStore) GetTeamsForUser(userId string) ([]*model.TeamMember, *mode
 	return dbMembers.ToModel(), nil
 }
 
-func (s SqlTeamStore) GetTeamsForUserWithPagination(userId string, page, perPage int) ([]*model.TeamMember, *model.AppError) {
+// GetTeamsForUserWithPagination returns from the database a page of the team members of userId,
+// following the given options. Nil options return all the memberships, sorted by team id.
+func (s SqlTeamStore) GetTeamsForUserWithPagination(ctx context.Context, userId string, page, perPage int, opts *model.TeamMembersForUserGetOptions) ([]*model.TeamMember, error) {
+	if opts == nil {
+		opts = &model.TeamMembersForUserGetOptions{}
+	}
+
 	query := s.getTeamMembersWithSchemeSelectQuery().
 		Where(sq.Eq{"TeamMembers.UserId": userId}).
 		Limit(uint64(perPage)).
 		Offset(uint64(page * perPage))
 
+	if opts.ExcludeDeleted {
+		query = query.Where(sq.Eq{"TeamMembers.DeleteAt": 0, "Teams.DeleteAt": 0})
+	}
+
+	switch opts.Sort {
+	case model.TEAMS_FOR_USER_SORT_DISPLAY_NAME:
+		query = query.OrderBy("Teams.DisplayName ASC", "TeamMembers.TeamId ASC")
+	default:
+		query = query.OrderBy("TeamMembers.TeamId ASC")
+	}
+
 	queryString, args, err := query.ToSql()
 	if err != nil {
-		return nil, model.NewAppError("SqlTeamStore.GetTeamsForUserWithPagination", "store.sql_team.get_members.app_error", nil, err.Error(), http.StatusInternalServerError)
+		return nil, errors.Wrap(err, "team_members_tosql")
 	}
 
 	var dbMembers teamMemberWithSchemeRolesList
-	_, err = s.GetReplica().Select(&dbMembers, queryString, args...)
+	_, err = s.GetReplica().WithContext(ctx).Select(&dbMembers, queryString, args...)
 	if err != nil {
-		return nil, model.NewAppError("SqlTeamStore.GetTeamsForUserWithPagination", "store.sql_team.get_members.app_error", nil, "userId="+userId+" "+err.Error(), http.StatusInternalServerError)
+		return nil, errors.Wrapf(err, "failed to find TeamMembers with userId=%s", userId)
 	}
 
 	return dbMembers.ToModel(), nil
 }
 
-func (s SqlTeamStore) GetChannelUnreadsForAllTeams(excludeTeamId, userId string) ([]*model.ChannelUnread, *model.AppError) {
+func (s SqlTeamStore) GetChannelUnreadsForAllTeams(ctx context.Context, excludeTeamId, userId string) ([]*model.ChannelUnread, *model.AppError) {
 	var data []*model.ChannelUnread
-	_, err := s.GetReplica().Select(&data,
+	_, err := s.GetReplica().WithContext(ctx).Select(&data,
 		`SELECT
-			Channels.TeamId TeamId, Channels.Id ChannelId, (Channels.TotalMsgCount - ChannelMembers.MsgCount) MsgCount, ChannelMembers.MentionCount MentionCount, ChannelMembers.NotifyProps NotifyProps
+			Channels.TeamId TeamId, Channels.Id ChannelId, (Channels.TotalMsgCount - ChannelMembers.MsgCount) MsgCount, ChannelMembers.MentionCount MentionCount, ChannelMembers.NotifyProps NotifyProps, (Channels.TotalMsgCountRoot - ChannelMembers.MsgCountRoot) MsgCountRoot, ChannelMembers.UrgentMentionCount UrgentMentionCount
 		FROM
 			Channels, ChannelMembers
 		WHERE
@@ -1068,10 +1860,112 @@ func (s SqlTeamStore) GetChannelUnreadsForAllTeams(excludeTeamId, userId string)
 	return data, nil
 }
 
-func (s SqlTeamStore) GetChannelUnreadsForTeam(teamId, userId string) ([]*model.ChannelUnread, *model.AppError) {
+// GetUnreadsForAllTeams totals the unreads of the user for each team, and for each of their sidebar
+// categories on the team, in a single query. Channels muted down to mentions don't count towards
+// the message totals, and channels that aren't explicitly assigned to a category are counted in the
+// default Channels or Direct Messages category of the team, as they appear in the sidebar.
+func (s SqlTeamStore) GetUnreadsForAllTeams(ctx context.Context, excludeTeamId, userId string) ([]*model.TeamUnread, *model.AppError) {
+	var rows []struct {
+		TeamId       string
+		CategoryId   string
+		MsgCount     int64
+		MentionCount int64
+	}
+	_, err := s.GetReplica().WithContext(ctx).Select(&rows,
+		`SELECT
+			Channels.TeamId AS TeamId,
+			'' AS CategoryId,
+			SUM(CASE WHEN ChannelMembers.NotifyProps LIKE :MarkUnreadMention THEN 0 ELSE Channels.TotalMsgCount - ChannelMembers.MsgCount END) AS MsgCount,
+			SUM(ChannelMembers.MentionCount) AS MentionCount
+		FROM
+			Channels
+			INNER JOIN ChannelMembers ON ChannelMembers.ChannelId = Channels.Id
+		WHERE
+			ChannelMembers.UserId = :UserId
+			AND Channels.DeleteAt = 0
+			AND Channels.TeamId != ''
+			AND Channels.TeamId != :ExcludeTeamId
+		GROUP BY
+			Channels.TeamId
+		UNION ALL
+		SELECT
+			SidebarCategories.TeamId AS TeamId,
+			SidebarCategories.Id AS CategoryId,
+			SUM(CASE WHEN ChannelMembers.NotifyProps LIKE :MarkUnreadMention THEN 0 ELSE Channels.TotalMsgCount - ChannelMembers.MsgCount END) AS MsgCount,
+			SUM(ChannelMembers.MentionCount) AS MentionCount
+		FROM
+			SidebarCategories
+			INNER JOIN ChannelMembers ON ChannelMembers.UserId = SidebarCategories.UserId
+			INNER JOIN Channels ON Channels.Id = ChannelMembers.ChannelId
+		WHERE
+			SidebarCategories.UserId = :UserId
+			AND SidebarCategories.TeamId != :ExcludeTeamId
+			AND Channels.DeleteAt = 0
+			AND (
+				EXISTS (
+					SELECT 1 FROM SidebarChannels
+					WHERE SidebarChannels.CategoryId = SidebarCategories.Id AND SidebarChannels.ChannelId = Channels.Id
+				)
+				OR (
+					NOT EXISTS (
+						SELECT 1 FROM SidebarChannels
+						INNER JOIN SidebarCategories AssignedCategories ON AssignedCategories.Id = SidebarChannels.CategoryId
+						WHERE SidebarChannels.ChannelId = Channels.Id
+							AND AssignedCategories.UserId = :UserId
+							AND AssignedCategories.TeamId = SidebarCategories.TeamId
+					)
+					AND (
+						(SidebarCategories.Type = :ChannelsCategory AND Channels.Type IN ('O', 'P') AND Channels.TeamId = SidebarCategories.TeamId)
+						OR (SidebarCategories.Type = :DirectMessagesCategory AND Channels.Type IN ('D', 'G'))
+					)
+				)
+			)
+		GROUP BY
+			SidebarCategories.TeamId, SidebarCategories.Id`,
+		map[string]interface{}{
+			"UserId":                 userId,
+			"ExcludeTeamId":          excludeTeamId,
+			"MarkUnreadMention":      "%\"" + model.MARK_UNREAD_NOTIFY_PROP + "\":\"" + model.CHANNEL_MARK_UNREAD_MENTION + "\"%",
+			"ChannelsCategory":       model.SidebarCategoryChannels,
+			"DirectMessagesCategory": model.SidebarCategoryDirectMessages,
+		})
+	if err != nil {
+		return nil, model.NewAppError("SqlTeamStore.GetUnreadsForAllTeams", "store.sql_team.get_unread.app_error", nil, "userId="+userId+" "+err.Error(), http.StatusInternalServerError)
+	}
+
+	unreads := []*model.TeamUnread{}
+	unreadsByTeam := make(map[string]*model.TeamUnread)
+	for _, row := range rows {
+		unread, ok := unreadsByTeam[row.TeamId]
+		if !ok {
+			unread = &model.TeamUnread{
+				TeamId:     row.TeamId,
+				Categories: []*model.SidebarCategoryUnread{},
+			}
+			unreadsByTeam[row.TeamId] = unread
+			unreads = append(unreads, unread)
+		}
+
+		if row.CategoryId == "" {
+			unread.MsgCount = row.MsgCount
+			unread.MentionCount = row.MentionCount
+			continue
+		}
+
+		unread.Categories = append(unread.Categories, &model.SidebarCategoryUnread{
+			CategoryId:   row.CategoryId,
+			MsgCount:     row.MsgCount,
+			MentionCount: row.MentionCount,
+		})
+	}
+
+	return unreads, nil
+}
+
+func (s SqlTeamStore) GetChannelUnreadsForTeam(ctx context.Context, teamId, userId string) ([]*model.ChannelUnread, *model.AppError) {
 	query := `
 		SELECT
-			Channels.TeamId TeamId, Channels.Id ChannelId, (Channels.TotalMsgCount - ChannelMembers.MsgCount) MsgCount, ChannelMembers.MentionCount MentionCount, ChannelMembers.NotifyProps NotifyProps
+			Channels.TeamId TeamId, Channels.Id ChannelId, (Channels.TotalMsgCount - ChannelMembers.MsgCount) MsgCount, ChannelMembers.MentionCount MentionCount, ChannelMembers.NotifyProps NotifyProps, (Channels.TotalMsgCountRoot - ChannelMembers.MsgCountRoot) MsgCountRoot, ChannelMembers.UrgentMentionCount UrgentMentionCount
 		FROM
 			Channels, ChannelMembers
 		WHERE
@@ -1081,7 +1975,7 @@ func (s SqlTeamStore) GetChannelUnreadsForTeam(teamId, userId string) ([]*model.
 			AND DeleteAt = 0`
 
 	var channels []*model.ChannelUnread
-	_, err := s.GetReplica().Select(&channels, query, map[string]interface{}{"TeamId": teamId, "UserId": userId})
+	_, err := s.GetReplica().WithContext(ctx).Select(&channels, query, map[string]interface{}{"TeamId": teamId, "UserId": userId})
 
 	if err != nil {
 		return nil, model.NewAppError("SqlTeamStore.GetChannelUnreadsForTeam", "store.sql_team.get_unread.app_error", nil, "teamId="+teamId+" "+err.Error(), http.StatusInternalServerError)
@@ -1089,7 +1983,7 @@ func (s SqlTeamStore) GetChannelUnreadsForTeam(teamId, userId string) ([]*model.
 	return channels, nil
 }
 
-func (s SqlTeamStore) RemoveMembers(teamId string, userIds []string) *model.AppError {
+func (s SqlTeamStore) RemoveMembers(ctx context.Context, teamId string, userIds []string) *model.AppError {
 	query := s.getQueryBuilder().
 		Delete("TeamMembers").
 		Where(sq.Eq{"TeamId": teamId}).
@@ -1099,38 +1993,95 @@ func (s SqlTeamStore) RemoveMembers(teamId string, userIds []string) *model.AppE
 	if err != nil {
 		return model.NewAppError("SqlTeamStore.RemoveMembers", "store.sql_team.remove_member.app_error", nil, "team_id="+teamId+", "+err.Error(), http.StatusInternalServerError)
 	}
-	_, err = s.GetMaster().Exec(sql, args...)
+
+	transaction, err := s.GetMaster().BeginTx(ctx, nil)
 	if err != nil {
 		return model.NewAppError("SqlTeamStore.RemoveMembers", "store.sql_team.remove_member.app_error", nil, "team_id="+teamId+", "+err.Error(), http.StatusInternalServerError)
 	}
+	defer finalizeTransaction(transaction)
+
+	if _, err = transaction.Exec(sql, args...); err != nil {
+		return model.NewAppError("SqlTeamStore.RemoveMembers", "store.sql_team.remove_member.app_error", nil, "team_id="+teamId+", "+err.Error(), http.StatusInternalServerError)
+	}
+	if err = s.logTeamMemberLeaveEvents(transaction, sq.Eq{"TeamId": teamId, "UserId": userIds}, model.GetMillis()); err != nil {
+		return model.NewAppError("SqlTeamStore.RemoveMembers", "store.sql_team.remove_member.app_error", nil, "team_id="+teamId+", "+err.Error(), http.StatusInternalServerError)
+	}
+	if err = s.updateMemberCounts(transaction, []string{teamId}); err != nil {
+		return model.NewAppError("SqlTeamStore.RemoveMembers", "store.sql_team.remove_member.app_error", nil, "team_id="+teamId+", "+err.Error(), http.StatusInternalServerError)
+	}
+	if err = transaction.Commit(); err != nil {
+		return model.NewAppError("SqlTeamStore.RemoveMembers", "store.sql_team.remove_member.app_error", nil, "team_id="+teamId+", "+err.Error(), http.StatusInternalServerError)
+	}
 	return nil
 }
 
 // RemoveMember remove from the database the team members that match the userId and teamId passed as parameter.
-func (s SqlTeamStore) RemoveMember(teamId string, userId string) *model.AppError {
-	return s.RemoveMembers(teamId, []string{userId})
+func (s SqlTeamStore) RemoveMember(ctx context.Context, teamId string, userId string) *model.AppError {
+	return s.RemoveMembers(ctx, teamId, []string{userId})
 }
 
 // RemoveAllMembersByTeam removes from the database the team members that belong to the teamId passed as parameter.
-func (s SqlTeamStore) RemoveAllMembersByTeam(teamId string) *model.AppError {
-	_, err := s.GetMaster().Exec("DELETE FROM TeamMembers WHERE TeamId = :TeamId", map[string]interface{}{"TeamId": teamId})
+func (s SqlTeamStore) RemoveAllMembersByTeam(ctx context.Context, teamId string) *model.AppError {
+	transaction, err := s.GetMaster().BeginTx(ctx, nil)
 	if err != nil {
 		return model.NewAppError("SqlTeamStore.RemoveMember", "store.sql_team.remove_member.app_error", nil, "team_id="+teamId+", "+err.Error(), http.StatusInternalServerError)
 	}
+	defer finalizeTransaction(transaction)
+
+	if _, err = transaction.Exec("DELETE FROM TeamMembers WHERE TeamId = :TeamId", map[string]interface{}{"TeamId": teamId}); err != nil {
+		return model.NewAppError("SqlTeamStore.RemoveMember", "store.sql_team.remove_member.app_error", nil, "team_id="+teamId+", "+err.Error(), http.StatusInternalServerError)
+	}
+	if err = s.logTeamMemberLeaveEvents(transaction, sq.Eq{"TeamId": teamId}, model.GetMillis()); err != nil {
+		return model.NewAppError("SqlTeamStore.RemoveMember", "store.sql_team.remove_member.app_error", nil, "team_id="+teamId+", "+err.Error(), http.StatusInternalServerError)
+	}
+	if _, err = transaction.Exec("UPDATE Teams SET MemberCount = 0 WHERE Id = :TeamId", map[string]interface{}{"TeamId": teamId}); err != nil {
+		return model.NewAppError("SqlTeamStore.RemoveMember", "store.sql_team.remove_member.app_error", nil, "team_id="+teamId+", "+err.Error(), http.StatusInternalServerError)
+	}
+	if err = transaction.Commit(); err != nil {
+		return model.NewAppError("SqlTeamStore.RemoveMember", "store.sql_team.remove_member.app_error", nil, "team_id="+teamId+", "+err.Error(), http.StatusInternalServerError)
+	}
 	return nil
 }
 
-// RemoveAllMembersByUser removes from the database the team members that match the userId passed as parameter.
-func (s SqlTeamStore) RemoveAllMembersByUser(userId string) *model.AppError {
-	_, err := s.GetMaster().Exec("DELETE FROM TeamMembers WHERE UserId = :UserId", map[string]interface{}{"UserId": userId})
+// RemoveAllMembersByUser removes from the database the team members that match the userId passed as parameter,
+// and returns the ids of the teams the user was removed from.
+func (s SqlTeamStore) RemoveAllMembersByUser(ctx context.Context, userId string) ([]string, *model.AppError) {
+	transaction, err := s.GetMaster().BeginTx(ctx, nil)
 	if err != nil {
-		return model.NewAppError("SqlTeamStore.RemoveMember", "store.sql_team.remove_member.app_error", nil, "user_id="+userId+", "+err.Error(), http.StatusInternalServerError)
+		return nil, model.NewAppError("SqlTeamStore.RemoveMember", "store.sql_team.remove_member.app_error", nil, "user_id="+userId+", "+err.Error(), http.StatusInternalServerError)
 	}
-	return nil
+	defer finalizeTransaction(transaction)
+
+	teamIds := []string{}
+	params := map[string]interface{}{"UserId": userId}
+	if s.DriverName() == model.DATABASE_DRIVER_POSTGRES {
+		if _, err = transaction.Select(&teamIds, "DELETE FROM TeamMembers WHERE UserId = :UserId RETURNING TeamId", params); err != nil {
+			return nil, model.NewAppError("SqlTeamStore.RemoveMember", "store.sql_team.remove_member.app_error", nil, "user_id="+userId+", "+err.Error(), http.StatusInternalServerError)
+		}
+	} else {
+		// MySQL has no DELETE ... RETURNING, so the rows are locked while selecting them to
+		// return exactly the teams the user is removed from.
+		if _, err = transaction.Select(&teamIds, "SELECT TeamId FROM TeamMembers WHERE UserId = :UserId FOR UPDATE", params); err != nil {
+			return nil, model.NewAppError("SqlTeamStore.RemoveMember", "store.sql_team.remove_member.app_error", nil, "user_id="+userId+", "+err.Error(), http.StatusInternalServerError)
+		}
+		if _, err = transaction.Exec("DELETE FROM TeamMembers WHERE UserId = :UserId", params); err != nil {
+			return nil, model.NewAppError("SqlTeamStore.RemoveMember", "store.sql_team.remove_member.app_error", nil, "user_id="+userId+", "+err.Error(), http.StatusInternalServerError)
+		}
+	}
+	if err = s.logTeamMemberLeaveEvents(transaction, sq.Eq{"UserId": userId}, model.GetMillis()); err != nil {
+		return nil, model.NewAppError("SqlTeamStore.RemoveMember", "store.sql_team.remove_member.app_error", nil, "user_id="+userId+", "+err.Error(), http.StatusInternalServerError)
+	}
+	if err = s.updateMemberCounts(transaction, teamIds); err != nil {
+		return nil, model.NewAppError("SqlTeamStore.RemoveMember", "store.sql_team.remove_member.app_error", nil, "user_id="+userId+", "+err.Error(), http.StatusInternalServerError)
+	}
+	if err = transaction.Commit(); err != nil {
+		return nil, model.NewAppError("SqlTeamStore.RemoveMember", "store.sql_team.remove_member.app_error", nil, "user_id="+userId+", "+err.Error(), http.StatusInternalServerError)
+	}
+	return teamIds, nil
 }
 
-func (s SqlTeamStore) UpdateLastTeamIconUpdate(teamId string, curTime int64) *model.AppError {
-	if _, err := s.GetMaster().Exec("UPDATE Teams SET LastTeamIconUpdate = :Time, UpdateAt = :Time WHERE Id = :teamId", map[string]interface{}{"Time": curTime, "teamId": teamId}); err != nil {
+func (s SqlTeamStore) UpdateLastTeamIconUpdate(ctx context.Context, teamId string, curTime int64) *model.AppError {
+	if _, err := s.GetMaster().WithContext(ctx).Exec("UPDATE Teams SET LastTeamIconUpdate = :Time, UpdateAt = :Time WHERE Id = :teamId", map[string]interface{}{"Time": curTime, "teamId": teamId}); err != nil {
 		return model.NewAppError("SqlTeamStore.UpdateLastTeamIconUpdate", "store.sql_team.update_last_team_icon_update.app_error", nil, "team_id="+teamId, http.StatusInternalServerError)
 	}
 	return nil
@@ -1138,9 +2089,9 @@ func (s SqlTeamStore) UpdateLastTeamIconUpdate(teamId string, curTime int64) *mo
 
 // GetTeamsByScheme returns from the database all teams that match the schemeId provided as parameter, up to
 // a total limit passed as paramater and paginated by offset number passed as parameter.
-func (s SqlTeamStore) GetTeamsByScheme(schemeId string, offset int, limit int) ([]*model.Team, *model.AppError) {
+func (s SqlTeamStore) GetTeamsByScheme(ctx context.Context, schemeId string, offset int, limit int) ([]*model.Team, *model.AppError) {
 	var teams []*model.Team
-	_, err := s.GetReplica().Select(&teams, "SELECT * FROM Teams WHERE SchemeId = :SchemeId ORDER BY DisplayName LIMIT :Limit OFFSET :Offset", map[string]interface{}{"SchemeId": schemeId, "Offset": offset, "Limit": limit})
+	_, err := s.GetReplica().WithContext(ctx).Select(&teams, "SELECT * FROM Teams WHERE SchemeId = :SchemeId ORDER BY DisplayName LIMIT :Limit OFFSET :Offset", map[string]interface{}{"SchemeId": schemeId, "Offset": offset, "Limit": limit})
 	if err != nil {
 		return nil, model.NewAppError("SqlTeamStore.GetTeamsByScheme", "store.sql_team.get_by_scheme.app_error", nil, "schemeId="+schemeId+" "+err.Error(), http.StatusInternalServerError)
 	}
@@ -1151,23 +2102,23 @@ func (s SqlTeamStore) GetTeamsByScheme(schemeId string, offset int, limit int) (
 // in batches as a single transaction per batch to ensure consistency but to also minimise execution time to avoid
 // causing unnecessary table locks. **THIS FUNCTION SHOULD NOT BE USED FOR ANY OTHER PURPOSE.** Executing this function
 // *after* the new Schemes functionality has been used on an installation will have unintended consequences.
-func (s SqlTeamStore) MigrateTeamMembers(fromTeamId string, fromUserId string) (map[string]string, *model.AppError) {
+func (s SqlTeamStore) MigrateTeamMembers(ctx context.Context, fromTeamId string, fromUserId string, batchSize int) (map[string]string, int64, *model.AppError) {
 	var transaction *gorp.Transaction
 	var err error
 
-	if transaction, err = s.GetMaster().Begin(); err != nil {
-		return nil, model.NewAppError("SqlTeamStore.MigrateTeamMembers", "store.sql_team.migrate_team_members.open_transaction.app_error", nil, err.Error(), http.StatusInternalServerError)
+	if transaction, err = s.GetMaster().BeginTx(ctx, nil); err != nil {
+		return nil, 0, model.NewAppError("SqlTeamStore.MigrateTeamMembers", "store.sql_team.migrate_team_members.open_transaction.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
 	defer finalizeTransaction(transaction)
 
 	var teamMembers []teamMember
-	if _, err := transaction.Select(&teamMembers, "SELECT * from TeamMembers WHERE (TeamId, UserId) > (:FromTeamId, :FromUserId) ORDER BY TeamId, UserId LIMIT 100", map[string]interface{}{"FromTeamId": fromTeamId, "FromUserId": fromUserId}); err != nil {
-		return nil, model.NewAppError("SqlTeamStore.MigrateTeamMembers", "store.sql_team.migrate_team_members.select.app_error", nil, err.Error(), http.StatusInternalServerError)
+	if _, err := transaction.Select(&teamMembers, "SELECT * from TeamMembers WHERE (TeamId, UserId) > (:FromTeamId, :FromUserId) ORDER BY TeamId, UserId LIMIT :Limit", map[string]interface{}{"FromTeamId": fromTeamId, "FromUserId": fromUserId, "Limit": batchSize}); err != nil {
+		return nil, 0, model.NewAppError("SqlTeamStore.MigrateTeamMembers", "store.sql_team.migrate_team_members.select.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
 
 	if len(teamMembers) == 0 {
 		// No more team members in query result means that the migration has finished.
-		return nil, nil
+		return nil, 0, nil
 	}
 
 	for i := range teamMembers {
@@ -1197,34 +2148,36 @@ func (s SqlTeamStore) MigrateTeamMembers(fromTeamId string, fromUserId string) (
 		member.Roles = strings.Join(newRoles, " ")
 
 		if _, err := transaction.Update(&member); err != nil {
-			return nil, model.NewAppError("SqlTeamStore.MigrateTeamMembers", "store.sql_team.migrate_team_members.update.app_error", nil, err.Error(), http.StatusInternalServerError)
+			return nil, 0, model.NewAppError("SqlTeamStore.MigrateTeamMembers", "store.sql_team.migrate_team_members.update.app_error", nil, err.Error(), http.StatusInternalServerError)
 		}
 
 	}
 
 	if err := transaction.Commit(); err != nil {
-		return nil, model.NewAppError("SqlTeamStore.MigrateTeamMembers", "store.sql_team.migrate_team_members.commit_transaction.app_error", nil, err.Error(), http.StatusInternalServerError)
+		return nil, 0, model.NewAppError("SqlTeamStore.MigrateTeamMembers", "store.sql_team.migrate_team_members.commit_transaction.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
 
 	data := make(map[string]string)
 	data["TeamId"] = teamMembers[len(teamMembers)-1].TeamId
 	data["UserId"] = teamMembers[len(teamMembers)-1].UserId
 
-	return data, nil
+	return data, int64(len(teamMembers)), nil
 }
 
-func (s SqlTeamStore) ResetAllTeamSchemes() *model.AppError {
-	if _, err := s.GetMaster().Exec("UPDATE Teams SET SchemeId=''"); err != nil {
+func (s SqlTeamStore) ResetAllTeamSchemes(ctx context.Context) *model.AppError {
+	if _, err := s.GetMaster().WithContext(ctx).Exec("UPDATE Teams SET SchemeId=''"); err != nil {
 		return model.NewAppError("SqlTeamStore.ResetAllTeamSchemes", "store.sql_team.reset_all_team_schemes.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
 	return nil
 }
 
+// ClearCaches is a no-op, the teams of the users are cached by the local cache layer.
 func (s SqlTeamStore) ClearCaches() {}
 
+// InvalidateAllTeamIdsForUser is a no-op, the teams of the users are cached by the local cache layer.
 func (s SqlTeamStore) InvalidateAllTeamIdsForUser(userId string) {}
 
-func (s SqlTeamStore) ClearAllCustomRoleAssignments() *model.AppError {
+func (s SqlTeamStore) ClearAllCustomRoleAssignments(ctx context.Context) *model.AppError {
 
 	builtInRoles := model.MakeDefaultRoles()
 	lastUserId := strings.Repeat("0", 26)
@@ -1234,7 +2187,7 @@ func (s SqlTeamStore) ClearAllCustomRoleAssignments() *model.AppError {
 		var transaction *gorp.Transaction
 		var err error
 
-		if transaction, err = s.GetMaster().Begin(); err != nil {
+		if transaction, err = s.GetMaster().BeginTx(ctx, nil); err != nil {
 			return model.NewAppError("SqlTeamStore.ClearAllCustomRoleAssignments", "store.sql_team.clear_all_custom_role_assignments.open_transaction.app_error", nil, err.Error(), http.StatusInternalServerError)
 		}
 		defer finalizeTransaction(transaction)
@@ -1279,8 +2232,8 @@ func (s SqlTeamStore) ClearAllCustomRoleAssignments() *model.AppError {
 }
 
 // AnalyticsGetTeamCountForScheme returns the number of active teams that match the schemeId passed as parameter.
-func (s SqlTeamStore) AnalyticsGetTeamCountForScheme(schemeId string) (int64, *model.AppError) {
-	count, err := s.GetReplica().SelectInt("SELECT count(*) FROM Teams WHERE SchemeId = :SchemeId AND DeleteAt = 0", map[string]interface{}{"SchemeId": schemeId})
+func (s SqlTeamStore) AnalyticsGetTeamCountForScheme(ctx context.Context, schemeId string) (int64, *model.AppError) {
+	count, err := s.GetReplica().WithContext(ctx).SelectInt("SELECT count(*) FROM Teams WHERE SchemeId = :SchemeId AND DeleteAt = 0", map[string]interface{}{"SchemeId": schemeId})
 	if err != nil {
 		return 0, model.NewAppError("SqlTeamStore.AnalyticsGetTeamCountForScheme", "store.sql_team.analytics_get_team_count_for_scheme.app_error", nil, "schemeId="+schemeId+" "+err.Error(), http.StatusInternalServerError)
 	}
@@ -1289,9 +2242,9 @@ func (s SqlTeamStore) AnalyticsGetTeamCountForScheme(schemeId string) (int64, *m
 }
 
 // GetAllForExportAfter returns teams for export, up to a total limit passed as paramater where Teams.Id is greater than the afterId passed as parameter.
-func (s SqlTeamStore) GetAllForExportAfter(limit int, afterId string) ([]*model.TeamForExport, *model.AppError) {
+func (s SqlTeamStore) GetAllForExportAfter(ctx context.Context, limit int, afterId string) ([]*model.TeamForExport, *model.AppError) {
 	var data []*model.TeamForExport
-	if _, err := s.GetReplica().Select(&data, `
+	if _, err := s.GetReplica().WithContext(ctx).Select(&data, `
 		SELECT
 			Teams.*,
 			Schemes.Name as SchemeName
@@ -1313,9 +2266,9 @@ func (s SqlTeamStore) GetAllForExportAfter(limit int, afterId string) ([]*model.
 }
 
 // GetUserTeamIds get the team ids to which the user belongs to. allowFromCache parameter does not have any effect in this Store
-func (s SqlTeamStore) GetUserTeamIds(userID string, allowFromCache bool) ([]string, *model.AppError) {
+func (s SqlTeamStore) GetUserTeamIds(ctx context.Context, userID string, allowFromCache bool) ([]string, *model.AppError) {
 	var teamIds []string
-	_, err := s.GetReplica().Select(&teamIds,
+	_, err := s.GetReplica().WithContext(ctx).Select(&teamIds,
 		`SELECT
 			TeamId
 		FROM
@@ -1334,9 +2287,9 @@ func (s SqlTeamStore) GetUserTeamIds(userID string, allowFromCache bool) ([]stri
 	return teamIds, nil
 }
 
-func (s SqlTeamStore) GetTeamMembersForExport(userId string) ([]*model.TeamMemberForExport, *model.AppError) {
+func (s SqlTeamStore) GetTeamMembersForExport(ctx context.Context, userId string) ([]*model.TeamMemberForExport, *model.AppError) {
 	var members []*model.TeamMemberForExport
-	_, err := s.GetReplica().Select(&members, `
+	_, err := s.GetReplica().WithContext(ctx).Select(&members, `
 		SELECT
 			TeamMembers.TeamId,
 			TeamMembers.UserId,
@@ -1360,48 +2313,115 @@ func (s SqlTeamStore) GetTeamMembersForExport(userId string) ([]*model.TeamMembe
 	return members, nil
 }
 
-func (s SqlTeamStore) UserBelongsToTeams(userId string, teamIds []string) (bool, *model.AppError) {
-	idQuery := sq.Eq{
+func (s SqlTeamStore) UserBelongsToTeams(ctx context.Context, userId string, teamIds []string) (bool, *model.AppError) {
+	belongs, err := s.teamMemberExists(ctx, sq.Eq{
 		"UserId":   userId,
 		"TeamId":   teamIds,
 		"DeleteAt": 0,
+	})
+	if err != nil {
+		return false, model.NewAppError("SqlTeamStore.UserBelongsToTeams", "store.sql_team.user_belongs_to_teams.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return belongs, nil
+}
+
+func (s SqlTeamStore) UserBelongsToTeam(ctx context.Context, userId string, teamId string, allowFromCache bool) (bool, *model.AppError) {
+	belongs, err := s.teamMemberExists(ctx, sq.Eq{
+		"UserId":   userId,
+		"TeamId":   teamId,
+		"DeleteAt": 0,
+	})
+	if err != nil {
+		return false, model.NewAppError("SqlTeamStore.UserBelongsToTeam", "store.sql_team.user_belongs_to_team.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
 
-	query, params, err := s.getQueryBuilder().Select("Count(*)").From("TeamMembers").Where(idQuery).ToSql()
+	return belongs, nil
+}
+
+// teamMemberExists returns whether any team member matches the condition, stopping at the first
+// one found rather than counting all of them.
+func (s SqlTeamStore) teamMemberExists(ctx context.Context, where sq.Eq) (bool, error) {
+	subQuery, params, err := s.getQueryBuilder().Select("1").From("TeamMembers").Where(where).ToSql()
 	if err != nil {
-		return false, model.NewAppError("SqlTeamStore.UserBelongsToTeams", "store.sql_team.user_belongs_to_teams.app_error", nil, err.Error(), http.StatusInternalServerError)
+		return false, errors.Wrap(err, "team_members_tosql")
 	}
 
-	c, err := s.GetReplica().SelectInt(query, params...)
+	exists, err := s.GetReplica().WithContext(ctx).SelectInt("SELECT CASE WHEN EXISTS ("+subQuery+") THEN 1 ELSE 0 END", params...)
 	if err != nil {
-		return false, model.NewAppError("SqlTeamStore.UserBelongsToTeams", "store.sql_team.user_belongs_to_teams.app_error", nil, err.Error(), http.StatusInternalServerError)
+		return false, errors.Wrap(err, "failed to check for TeamMembers")
 	}
 
-	return c > 0, nil
+	return exists == 1, nil
 }
 
-func (s SqlTeamStore) UpdateMembersRole(teamID string, userIDs []string) *model.AppError {
-	sql := fmt.Sprintf(`
-		UPDATE
-			TeamMembers
-		SET
-			SchemeAdmin = CASE WHEN UserId IN ('%s') THEN
-				TRUE
-			ELSE
-				FALSE
-			END
-		WHERE
-			TeamId = :TeamId
-			AND (SchemeGuest = false OR SchemeGuest IS NULL)
-			AND DeleteAt = 0`, strings.Join(userIDs, "', '"))
+// updateMembersRoleQuery builds the update making the given members of the team admins and the
+// other members of the team, guests excepted, non-admin members.
+func (s SqlTeamStore) updateMembersRoleQuery(teamID string, userIDs []string) sq.UpdateBuilder {
+	return s.getQueryBuilder().
+		Update("TeamMembers").
+		Set("SchemeAdmin", sq.Case().When(sq.Eq{"UserId": userIDs}, "TRUE").Else("FALSE")).
+		Where(sq.Eq{"TeamId": teamID, "DeleteAt": 0}).
+		Where(sq.Or{sq.Eq{"SchemeGuest": false}, sq.Eq{"SchemeGuest": nil}})
+}
 
-	if _, err := s.GetMaster().Exec(sql, map[string]interface{}{"TeamId": teamID}); err != nil {
+func (s SqlTeamStore) UpdateMembersRole(ctx context.Context, teamID string, userIDs []string) *model.AppError {
+	query, args, err := s.updateMembersRoleQuery(teamID, userIDs).ToSql()
+	if err != nil {
+		return model.NewAppError("SqlTeamStore.UpdateMembersRole", "store.sql.build_query.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	if _, err := s.GetMaster().WithContext(ctx).Exec(query, args...); err != nil {
 		return model.NewAppError("SqlTeamStore.UpdateMembersRole", "store.update_error", nil, err.Error(), http.StatusInternalServerError)
 	}
 
 	return nil
 }
 
+func (s SqlTeamStore) UpdateMembersRoleAndGetUpdated(ctx context.Context, teamID string, userIDs []string) ([]*model.TeamMember, *model.AppError) {
+	selectQuery, selectArgs, err := s.getTeamMembersWithSchemeSelectQuery().
+		Where(sq.Eq{"TeamMembers.TeamId": teamID, "TeamMembers.DeleteAt": 0}).
+		Where(sq.Or{sq.Eq{"TeamMembers.SchemeGuest": false}, sq.Eq{"TeamMembers.SchemeGuest": nil}}).
+		Where(sq.Or{
+			sq.And{sq.Eq{"TeamMembers.UserId": userIDs}, sq.Or{sq.Eq{"TeamMembers.SchemeAdmin": false}, sq.Eq{"TeamMembers.SchemeAdmin": nil}}},
+			sq.And{sq.NotEq{"TeamMembers.UserId": userIDs}, sq.Eq{"TeamMembers.SchemeAdmin": true}},
+		}).
+		ToSql()
+	if err != nil {
+		return nil, model.NewAppError("SqlTeamStore.UpdateMembersRoleAndGetUpdated", "store.sql.build_query.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	updateQuery, updateArgs, err := s.updateMembersRoleQuery(teamID, userIDs).ToSql()
+	if err != nil {
+		return nil, model.NewAppError("SqlTeamStore.UpdateMembersRoleAndGetUpdated", "store.sql.build_query.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	transaction, err := s.GetMaster().BeginTx(ctx, nil)
+	if err != nil {
+		return nil, model.NewAppError("SqlTeamStore.UpdateMembersRoleAndGetUpdated", "store.update_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	defer finalizeTransaction(transaction)
+
+	var dbMembers teamMemberWithSchemeRolesList
+	if _, err := transaction.Select(&dbMembers, selectQuery, selectArgs...); err != nil {
+		return nil, model.NewAppError("SqlTeamStore.UpdateMembersRoleAndGetUpdated", "store.sql_team.get_members.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	if _, err := transaction.Exec(updateQuery, updateArgs...); err != nil {
+		return nil, model.NewAppError("SqlTeamStore.UpdateMembersRoleAndGetUpdated", "store.update_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return nil, model.NewAppError("SqlTeamStore.UpdateMembersRoleAndGetUpdated", "store.update_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	for i := range dbMembers {
+		dbMembers[i].SchemeAdmin = sql.NullBool{Bool: !dbMembers[i].SchemeAdmin.Bool, Valid: true}
+	}
+
+	return dbMembers.ToModel(), nil
+}
+
 func applyTeamMemberViewRestrictionsFilter(query sq.SelectBuilder, teamId string, restrictions *model.ViewUsersRestrictions) sq.SelectBuilder {
 	if restrictions == nil {
 		return query
@@ -1462,7 +2482,7 @@ func applyTeamMemberViewRestrictionsFilterForStats(query sq.SelectBuilder, teamI
 	return resultQuery
 }
 
-func (s SqlTeamStore) GroupSyncedTeamCount() (int64, *model.AppError) {
+func (s SqlTeamStore) GroupSyncedTeamCount(ctx context.Context) (int64, *model.AppError) {
 	query := s.getQueryBuilder().Select("COUNT(*)").From("Teams").Where(sq.Eq{"GroupConstrained": true, "DeleteAt": 0})
 
 	sql, args, err := query.ToSql()
@@ -1470,10 +2490,140 @@ func (s SqlTeamStore) GroupSyncedTeamCount() (int64, *model.AppError) {
 		return 0, model.NewAppError("SqlTeamStore.GroupSyncedTeamCount", "store.sql_group.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
 
-	count, err := s.GetReplica().SelectInt(sql, args...)
+	count, err := s.GetReplica().WithContext(ctx).SelectInt(sql, args...)
 	if err != nil {
 		return 0, model.NewAppError("SqlTeamStore.GroupSyncedTeamCount", "store.select_error", nil, err.Error(), http.StatusInternalServerError)
 	}
 
 	return count, nil
 }
+
+func (s SqlTeamStore) GetGroupSyncedTeamsPage(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError) {
+	query := s.getQueryBuilder().
+		Select("*").
+		From("Teams").
+		Where(sq.Eq{"GroupConstrained": true, "DeleteAt": 0}).
+		OrderBy("Id").
+		Limit(uint64(limit)).
+		Offset(uint64(offset))
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, model.NewAppError("SqlTeamStore.GetGroupSyncedTeamsPage", "store.sql_group.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	var teams []*model.Team
+	if _, err := s.GetReplica().WithContext(ctx).Select(&teams, sql, args...); err != nil {
+		return nil, model.NewAppError("SqlTeamStore.GetGroupSyncedTeamsPage", "store.select_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return teams, nil
+}
+
+func (s SqlTeamStore) GroupSyncedTeamCountByGroup(ctx context.Context, groupId string) (int64, *model.AppError) {
+	query := s.getQueryBuilder().
+		Select("COUNT(*)").
+		From("Teams").
+		Join("GroupTeams ON GroupTeams.TeamId = Teams.Id").
+		Where(sq.Eq{
+			"GroupTeams.GroupId":     groupId,
+			"GroupTeams.DeleteAt":    0,
+			"Teams.GroupConstrained": true,
+			"Teams.DeleteAt":         0,
+		})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return 0, model.NewAppError("SqlTeamStore.GroupSyncedTeamCountByGroup", "store.sql_group.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	count, err := s.GetReplica().WithContext(ctx).SelectInt(sql, args...)
+	if err != nil {
+		return 0, model.NewAppError("SqlTeamStore.GroupSyncedTeamCountByGroup", "store.select_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return count, nil
+}
+
+// SetPolicy makes the retention policy govern the team, replacing its previous policy. An empty
+// policyId leaves the team governed by the global data retention settings. It returns a
+// store.ErrNotFound if the team or the policy doesn't exist.
+func (s SqlTeamStore) SetPolicy(ctx context.Context, teamId string, policyId string) error {
+	transaction, err := s.GetMaster().BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransaction(transaction)
+
+	count, err := transaction.SelectInt("SELECT COUNT(*) FROM Teams WHERE Id = :TeamId", map[string]interface{}{"TeamId": teamId})
+	if err != nil {
+		return errors.Wrapf(err, "failed to count Teams with id=%s", teamId)
+	}
+	if count == 0 {
+		return store.NewErrNotFound("Team", teamId)
+	}
+
+	if policyId != "" {
+		count, err = transaction.SelectInt("SELECT COUNT(*) FROM RetentionPolicies WHERE Id = :PolicyId", map[string]interface{}{"PolicyId": policyId})
+		if err != nil {
+			return errors.Wrapf(err, "failed to count RetentionPolicies with id=%s", policyId)
+		}
+		if count == 0 {
+			return store.NewErrNotFound("RetentionPolicy", policyId)
+		}
+	}
+
+	if _, err := transaction.Exec("DELETE FROM RetentionPoliciesTeams WHERE TeamId = :TeamId", map[string]interface{}{"TeamId": teamId}); err != nil {
+		return errors.Wrapf(err, "failed to delete RetentionPolicyTeam with teamId=%s", teamId)
+	}
+
+	if policyId != "" {
+		if err := transaction.Insert(&model.RetentionPolicyTeam{PolicyId: policyId, TeamId: teamId}); err != nil {
+			return errors.Wrapf(err, "failed to save RetentionPolicyTeam with policyId=%s and teamId=%s", policyId, teamId)
+		}
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return errors.Wrap(err, "commit_transaction")
+	}
+
+	return nil
+}
+
+// GetPolicyForTeam returns the retention policy governing the team, or a store.ErrNotFound if the
+// team is governed by the global data retention settings.
+func (s SqlTeamStore) GetPolicyForTeam(ctx context.Context, teamId string) (*model.RetentionPolicy, error) {
+	var policyTeam model.RetentionPolicyTeam
+	if err := s.GetReplica().WithContext(ctx).SelectOne(&policyTeam, "SELECT * FROM RetentionPoliciesTeams WHERE TeamId = :TeamId", map[string]interface{}{"TeamId": teamId}); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("RetentionPolicy", "team_id="+teamId)
+		}
+		return nil, errors.Wrapf(err, "failed to get RetentionPolicyTeam with teamId=%s", teamId)
+	}
+
+	return s.RetentionPolicy().Get(policyTeam.PolicyId)
+}
+
+// GetTeamsForPolicyPage returns a page of the teams governed by the retention policy, archived
+// teams included, ordered by display name.
+func (s SqlTeamStore) GetTeamsForPolicyPage(ctx context.Context, policyId string, offset int, limit int) ([]*model.Team, error) {
+	query, args, err := s.getQueryBuilder().
+		Select("Teams.*").
+		From("Teams").
+		Join("RetentionPoliciesTeams ON RetentionPoliciesTeams.TeamId = Teams.Id").
+		Where(sq.Eq{"RetentionPoliciesTeams.PolicyId": policyId}).
+		OrderBy("Teams.DisplayName", "Teams.Id").
+		Limit(uint64(limit)).
+		Offset(uint64(offset)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_tosql")
+	}
+
+	teams := []*model.Team{}
+	if _, err := s.GetReplica().WithContext(ctx).Select(&teams, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to find Teams with policyId=%s", policyId)
+	}
+
+	return teams, nil
+}
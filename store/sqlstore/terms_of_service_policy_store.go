@@ -0,0 +1,463 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/mattermost/gorp"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+const (
+	// termsOfServicePolicyUsersQuery selects the users targeted by the policy :PolicyId through the
+	// teams and groups they are a member of.
+	termsOfServicePolicyUsersQuery = `
+		SELECT TeamMembers.UserId FROM TeamMembers
+			INNER JOIN TermsOfServicePoliciesTeams ON TermsOfServicePoliciesTeams.TeamId = TeamMembers.TeamId
+			WHERE TermsOfServicePoliciesTeams.PolicyId = :PolicyId
+			AND TeamMembers.DeleteAt = 0
+		UNION
+		SELECT GroupMembers.UserId FROM GroupMembers
+			INNER JOIN TermsOfServicePoliciesGroups ON TermsOfServicePoliciesGroups.GroupId = GroupMembers.GroupId
+			WHERE TermsOfServicePoliciesGroups.PolicyId = :PolicyId
+			AND GroupMembers.DeleteAt = 0`
+
+	// termsOfServicePolicyActiveUsersCondition restricts a query on Users to the active human users
+	// targeted by the policy :PolicyId.
+	termsOfServicePolicyActiveUsersCondition = `
+		Users.Id IN (` + termsOfServicePolicyUsersQuery + `)
+		AND Users.DeleteAt = 0
+		AND Users.Id NOT IN (SELECT UserId FROM Bots)`
+)
+
+type SqlTermsOfServicePolicyStore struct {
+	SqlStore
+}
+
+func newSqlTermsOfServicePolicyStore(sqlStore SqlStore) store.TermsOfServicePolicyStore {
+	s := &SqlTermsOfServicePolicyStore{sqlStore}
+
+	for _, db := range sqlStore.GetAllConns() {
+		table := db.AddTableWithName(model.TermsOfServicePolicy{}, "TermsOfServicePolicies").SetKeys(false, "Id")
+		table.ColMap("Id").SetMaxSize(26)
+		table.ColMap("DisplayName").SetMaxSize(model.TERMS_OF_SERVICE_POLICY_DISPLAY_NAME_MAX_RUNES * 4)
+
+		teamsTable := db.AddTableWithName(model.TermsOfServicePolicyTeam{}, "TermsOfServicePoliciesTeams").SetKeys(false, "PolicyId", "TeamId")
+		teamsTable.ColMap("PolicyId").SetMaxSize(26)
+		teamsTable.ColMap("TeamId").SetMaxSize(26)
+
+		groupsTable := db.AddTableWithName(model.TermsOfServicePolicyGroup{}, "TermsOfServicePoliciesGroups").SetKeys(false, "PolicyId", "GroupId")
+		groupsTable.ColMap("PolicyId").SetMaxSize(26)
+		groupsTable.ColMap("GroupId").SetMaxSize(26)
+
+		versionsTable := db.AddTableWithName(model.TermsOfServicePolicyVersion{}, "TermsOfServicePolicyVersions").SetKeys(false, "Id")
+		versionsTable.ColMap("Id").SetMaxSize(26)
+		versionsTable.ColMap("PolicyId").SetMaxSize(26)
+		versionsTable.ColMap("UserId").SetMaxSize(26)
+		versionsTable.ColMap("Text").SetMaxSize(model.POST_MESSAGE_MAX_BYTES_V2)
+		versionsTable.SetUniqueTogether("PolicyId", "Version")
+
+		acceptancesTable := db.AddTableWithName(model.TermsOfServiceAcceptance{}, "TermsOfServiceAcceptances").SetKeys(false, "UserId", "VersionId")
+		acceptancesTable.ColMap("UserId").SetMaxSize(26)
+		acceptancesTable.ColMap("VersionId").SetMaxSize(26)
+	}
+
+	return s
+}
+
+func (s SqlTermsOfServicePolicyStore) createIndexesIfNotExists() {
+	s.CreateIndexIfNotExists("idx_termsofservicepoliciesteams_team_id", "TermsOfServicePoliciesTeams", "TeamId")
+	s.CreateIndexIfNotExists("idx_termsofservicepoliciesgroups_group_id", "TermsOfServicePoliciesGroups", "GroupId")
+	s.CreateIndexIfNotExists("idx_termsofserviceacceptances_version_id", "TermsOfServiceAcceptances", "VersionId")
+}
+
+func (s SqlTermsOfServicePolicyStore) Save(policy *model.TermsOfServicePolicy) (*model.TermsOfServicePolicy, error) {
+	if policy.Id != "" {
+		return nil, store.NewErrInvalidInput("TermsOfServicePolicy", "id", policy.Id)
+	}
+
+	policy.PreSave()
+	if err := policy.IsValid(); err != nil {
+		return nil, err
+	}
+
+	transaction, err := s.GetMaster().Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransaction(transaction)
+
+	if err := transaction.Insert(policy); err != nil {
+		return nil, errors.Wrapf(err, "failed to save TermsOfServicePolicy with id=%s", policy.Id)
+	}
+
+	if err := s.saveAssociationsT(transaction, policy); err != nil {
+		return nil, err
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit_transaction")
+	}
+
+	return policy, nil
+}
+
+func (s SqlTermsOfServicePolicyStore) Update(policy *model.TermsOfServicePolicy) (*model.TermsOfServicePolicy, error) {
+	policy.PreUpdate()
+	if err := policy.IsValid(); err != nil {
+		return nil, err
+	}
+
+	transaction, err := s.GetMaster().Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransaction(transaction)
+
+	count, err := transaction.Update(policy)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update TermsOfServicePolicy with id=%s", policy.Id)
+	}
+	if count != 1 {
+		return nil, store.NewErrNotFound("TermsOfServicePolicy", policy.Id)
+	}
+
+	if err := s.deleteAssociationsT(transaction, policy.Id); err != nil {
+		return nil, err
+	}
+
+	if err := s.saveAssociationsT(transaction, policy); err != nil {
+		return nil, err
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit_transaction")
+	}
+
+	return policy, nil
+}
+
+func (s SqlTermsOfServicePolicyStore) saveAssociationsT(transaction *gorp.Transaction, policy *model.TermsOfServicePolicy) error {
+	for _, teamId := range policy.TeamIds {
+		if err := transaction.Insert(&model.TermsOfServicePolicyTeam{PolicyId: policy.Id, TeamId: teamId}); err != nil {
+			return errors.Wrapf(err, "failed to save TermsOfServicePolicyTeam with policyId=%s and teamId=%s", policy.Id, teamId)
+		}
+	}
+
+	for _, groupId := range policy.GroupIds {
+		if err := transaction.Insert(&model.TermsOfServicePolicyGroup{PolicyId: policy.Id, GroupId: groupId}); err != nil {
+			return errors.Wrapf(err, "failed to save TermsOfServicePolicyGroup with policyId=%s and groupId=%s", policy.Id, groupId)
+		}
+	}
+
+	return nil
+}
+
+func (s SqlTermsOfServicePolicyStore) deleteAssociationsT(transaction *gorp.Transaction, policyId string) error {
+	for _, table := range []string{"TermsOfServicePoliciesTeams", "TermsOfServicePoliciesGroups"} {
+		queryString, args, err := s.getQueryBuilder().
+			Delete(table).
+			Where(sq.Eq{"PolicyId": policyId}).
+			ToSql()
+		if err != nil {
+			return errors.Wrap(err, "terms_of_service_policy_associations_delete_tosql")
+		}
+
+		if _, err := transaction.Exec(queryString, args...); err != nil {
+			return errors.Wrapf(err, "failed to delete %s with policyId=%s", table, policyId)
+		}
+	}
+
+	return nil
+}
+
+func (s SqlTermsOfServicePolicyStore) Get(id string) (*model.TermsOfServicePolicy, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("*").
+		From("TermsOfServicePolicies").
+		Where(sq.Eq{"Id": id, "DeleteAt": 0}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "terms_of_service_policy_tosql")
+	}
+
+	var policy *model.TermsOfServicePolicy
+	if err := s.GetReplica().SelectOne(&policy, queryString, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("TermsOfServicePolicy", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get TermsOfServicePolicy with id=%s", id)
+	}
+
+	if err := s.populateAssociations([]*model.TermsOfServicePolicy{policy}); err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func (s SqlTermsOfServicePolicyStore) GetAll(offset, limit int) ([]*model.TermsOfServicePolicy, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("*").
+		From("TermsOfServicePolicies").
+		Where(sq.Eq{"DeleteAt": 0}).
+		OrderBy("DisplayName ASC", "Id ASC").
+		Limit(uint64(limit)).
+		Offset(uint64(offset)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "terms_of_service_policies_tosql")
+	}
+
+	policies := []*model.TermsOfServicePolicy{}
+	if _, err := s.GetReplica().Select(&policies, queryString, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to find TermsOfServicePolicies")
+	}
+
+	if err := s.populateAssociations(policies); err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+func (s SqlTermsOfServicePolicyStore) populateAssociations(policies []*model.TermsOfServicePolicy) error {
+	if len(policies) == 0 {
+		return nil
+	}
+
+	policiesById := make(map[string]*model.TermsOfServicePolicy, len(policies))
+	policyIds := make([]string, 0, len(policies))
+	for _, policy := range policies {
+		policy.TeamIds = []string{}
+		policy.GroupIds = []string{}
+		policiesById[policy.Id] = policy
+		policyIds = append(policyIds, policy.Id)
+	}
+
+	queryString, args, err := s.getQueryBuilder().
+		Select("PolicyId", "TeamId").
+		From("TermsOfServicePoliciesTeams").
+		Where(sq.Eq{"PolicyId": policyIds}).
+		OrderBy("TeamId").
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "terms_of_service_policy_teams_tosql")
+	}
+
+	var teams []*model.TermsOfServicePolicyTeam
+	if _, err := s.GetReplica().Select(&teams, queryString, args...); err != nil {
+		return errors.Wrap(err, "failed to find TermsOfServicePoliciesTeams")
+	}
+	for _, team := range teams {
+		policiesById[team.PolicyId].TeamIds = append(policiesById[team.PolicyId].TeamIds, team.TeamId)
+	}
+
+	queryString, args, err = s.getQueryBuilder().
+		Select("PolicyId", "GroupId").
+		From("TermsOfServicePoliciesGroups").
+		Where(sq.Eq{"PolicyId": policyIds}).
+		OrderBy("GroupId").
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "terms_of_service_policy_groups_tosql")
+	}
+
+	var groups []*model.TermsOfServicePolicyGroup
+	if _, err := s.GetReplica().Select(&groups, queryString, args...); err != nil {
+		return errors.Wrap(err, "failed to find TermsOfServicePoliciesGroups")
+	}
+	for _, group := range groups {
+		policiesById[group.PolicyId].GroupIds = append(policiesById[group.PolicyId].GroupIds, group.GroupId)
+	}
+
+	return nil
+}
+
+// Delete only marks the policy as deleted so that its versions and their acceptances remain on record.
+func (s SqlTermsOfServicePolicyStore) Delete(id string, deleteAt int64) error {
+	queryString, args, err := s.getQueryBuilder().
+		Update("TermsOfServicePolicies").
+		Set("DeleteAt", deleteAt).
+		Set("UpdateAt", deleteAt).
+		Where(sq.Eq{"Id": id, "DeleteAt": 0}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "terms_of_service_policy_delete_tosql")
+	}
+
+	result, err := s.GetMaster().Exec(queryString, args...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete TermsOfServicePolicy with id=%s", id)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrapf(err, "failed to get rows affected for TermsOfServicePolicy with id=%s", id)
+	}
+	if rowsAffected == 0 {
+		return store.NewErrNotFound("TermsOfServicePolicy", id)
+	}
+
+	return nil
+}
+
+func (s SqlTermsOfServicePolicyStore) SaveVersion(version *model.TermsOfServicePolicyVersion) (*model.TermsOfServicePolicyVersion, error) {
+	if version.Id != "" {
+		return nil, store.NewErrInvalidInput("TermsOfServicePolicyVersion", "id", version.Id)
+	}
+
+	version.PreSave()
+	if err := version.IsValid(); err != nil {
+		return nil, err
+	}
+
+	transaction, err := s.GetMaster().Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransaction(transaction)
+
+	count, err := transaction.SelectInt("SELECT COUNT(*) FROM TermsOfServicePolicies WHERE Id = :PolicyId AND DeleteAt = 0", map[string]interface{}{"PolicyId": version.PolicyId})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get TermsOfServicePolicy with id=%s", version.PolicyId)
+	}
+	if count == 0 {
+		return nil, store.NewErrNotFound("TermsOfServicePolicy", version.PolicyId)
+	}
+
+	latest, err := transaction.SelectInt("SELECT COALESCE(MAX(Version), 0) FROM TermsOfServicePolicyVersions WHERE PolicyId = :PolicyId", map[string]interface{}{"PolicyId": version.PolicyId})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the latest TermsOfServicePolicyVersion for policyId=%s", version.PolicyId)
+	}
+	version.Version = int(latest) + 1
+
+	if err := transaction.Insert(version); err != nil {
+		// Another version of the policy was published concurrently.
+		if IsUniqueConstraintError(err, []string{"PolicyId", "policyid_version"}) {
+			return nil, store.NewErrConflict("TermsOfServicePolicyVersion", err, "policy_id="+version.PolicyId)
+		}
+		return nil, errors.Wrapf(err, "failed to save TermsOfServicePolicyVersion with id=%s", version.Id)
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit_transaction")
+	}
+
+	return version, nil
+}
+
+func (s SqlTermsOfServicePolicyStore) GetLatestVersion(policyId string) (*model.TermsOfServicePolicyVersion, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("*").
+		From("TermsOfServicePolicyVersions").
+		Where(sq.Eq{"PolicyId": policyId}).
+		OrderBy("Version DESC").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "terms_of_service_policy_version_tosql")
+	}
+
+	var version *model.TermsOfServicePolicyVersion
+	if err := s.GetReplica().SelectOne(&version, queryString, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("TermsOfServicePolicyVersion", "policy_id="+policyId)
+		}
+		return nil, errors.Wrapf(err, "failed to get the latest TermsOfServicePolicyVersion for policyId=%s", policyId)
+	}
+
+	return version, nil
+}
+
+func (s SqlTermsOfServicePolicyStore) SaveAcceptance(acceptance *model.TermsOfServiceAcceptance) error {
+	if acceptance.CreateAt == 0 {
+		acceptance.CreateAt = model.GetMillis()
+	}
+
+	if err := s.GetMaster().Insert(acceptance); err != nil {
+		if IsUniqueConstraintError(err, []string{"PRIMARY", "termsofserviceacceptances_pkey"}) {
+			return store.NewErrConflict("TermsOfServiceAcceptance", err, "user_id="+acceptance.UserId+", version_id="+acceptance.VersionId)
+		}
+		return errors.Wrapf(err, "failed to save TermsOfServiceAcceptance with userId=%s and versionId=%s", acceptance.UserId, acceptance.VersionId)
+	}
+
+	return nil
+}
+
+func (s SqlTermsOfServicePolicyStore) GetPendingForUser(userId string) ([]*model.TermsOfServicePolicyVersion, error) {
+	query := `
+		SELECT TermsOfServicePolicyVersions.* FROM TermsOfServicePolicyVersions
+			INNER JOIN TermsOfServicePolicies ON TermsOfServicePolicies.Id = TermsOfServicePolicyVersions.PolicyId
+		WHERE TermsOfServicePolicies.DeleteAt = 0
+		AND TermsOfServicePolicyVersions.Version = (
+			SELECT MAX(Latest.Version) FROM TermsOfServicePolicyVersions Latest
+			WHERE Latest.PolicyId = TermsOfServicePolicyVersions.PolicyId)
+		AND (
+			TermsOfServicePolicies.Id IN (
+				SELECT TermsOfServicePoliciesTeams.PolicyId FROM TermsOfServicePoliciesTeams
+					INNER JOIN TeamMembers ON TeamMembers.TeamId = TermsOfServicePoliciesTeams.TeamId
+					WHERE TeamMembers.UserId = :UserId AND TeamMembers.DeleteAt = 0)
+			OR TermsOfServicePolicies.Id IN (
+				SELECT TermsOfServicePoliciesGroups.PolicyId FROM TermsOfServicePoliciesGroups
+					INNER JOIN GroupMembers ON GroupMembers.GroupId = TermsOfServicePoliciesGroups.GroupId
+					WHERE GroupMembers.UserId = :UserId AND GroupMembers.DeleteAt = 0))
+		AND TermsOfServicePolicyVersions.Id NOT IN (
+			SELECT VersionId FROM TermsOfServiceAcceptances WHERE UserId = :UserId)
+		ORDER BY TermsOfServicePolicyVersions.CreateAt, TermsOfServicePolicyVersions.Id`
+
+	versions := []*model.TermsOfServicePolicyVersion{}
+	if _, err := s.GetReplica().Select(&versions, query, map[string]interface{}{"UserId": userId}); err != nil {
+		return nil, errors.Wrapf(err, "failed to find pending TermsOfServicePolicyVersions for userId=%s", userId)
+	}
+
+	return versions, nil
+}
+
+func (s SqlTermsOfServicePolicyStore) GetOutstandingUsers(version *model.TermsOfServicePolicyVersion, offset, limit int) ([]*model.User, error) {
+	query := `
+		SELECT Users.* FROM Users
+		WHERE ` + termsOfServicePolicyActiveUsersCondition + `
+		AND Users.Id NOT IN (SELECT UserId FROM TermsOfServiceAcceptances WHERE VersionId = :VersionId)
+		ORDER BY Users.Username
+		LIMIT :Limit OFFSET :Offset`
+
+	users := []*model.User{}
+	if _, err := s.GetReplica().Select(&users, query, map[string]interface{}{
+		"PolicyId":  version.PolicyId,
+		"VersionId": version.Id,
+		"Limit":     limit,
+		"Offset":    offset,
+	}); err != nil {
+		return nil, errors.Wrapf(err, "failed to find Users with outstanding TermsOfServicePolicyVersion id=%s", version.Id)
+	}
+
+	return users, nil
+}
+
+func (s SqlTermsOfServicePolicyStore) GetAcceptanceCounts(version *model.TermsOfServicePolicyVersion) (int64, int64, error) {
+	params := map[string]interface{}{"PolicyId": version.PolicyId, "VersionId": version.Id}
+
+	targeted, err := s.GetReplica().SelectInt(`
+		SELECT COUNT(*) FROM Users
+		WHERE `+termsOfServicePolicyActiveUsersCondition, params)
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "failed to count Users targeted by TermsOfServicePolicy id=%s", version.PolicyId)
+	}
+
+	accepted, err := s.GetReplica().SelectInt(`
+		SELECT COUNT(*) FROM Users
+		WHERE `+termsOfServicePolicyActiveUsersCondition+`
+		AND Users.Id IN (SELECT UserId FROM TermsOfServiceAcceptances WHERE VersionId = :VersionId)`, params)
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "failed to count Users who accepted TermsOfServicePolicyVersion id=%s", version.Id)
+	}
+
+	return targeted, accepted, nil
+}
@@ -4,6 +4,7 @@
 package sqlstore
 
 import (
+	"context"
 	"encoding/json"
 	"os"
 	"strings"
@@ -18,7 +19,7 @@ import (
 )
 
 const (
-	CURRENT_SCHEMA_VERSION   = VERSION_5_25_0
+	CURRENT_SCHEMA_VERSION   = VERSION_5_26_0
 	VERSION_5_26_0           = "5.26.0"
 	VERSION_5_25_0           = "5.25.0"
 	VERSION_5_24_0           = "5.24.0"
@@ -667,7 +668,7 @@ func upgradeDatabaseToVersion511(sqlStore SqlStore) {
 		} else {
 			for _, team := range teams {
 				team.InviteId = model.NewId()
-				if _, err := sqlStore.Team().Update(team); err != nil {
+				if _, err := sqlStore.Team().Update(context.Background(), team); err != nil {
 					mlog.Error("Error updating Team InviteIDs", mlog.String("team_id", team.Id), mlog.Err(err))
 				}
 			}
@@ -815,10 +816,57 @@ func upgradeDatabaseToVersion525(sqlStore SqlStore) {
 }
 
 func upgradeDatabaseToVersion526(sqlStore SqlStore) {
-	// TODO: uncomment when the time arrive to upgrade the DB for 5.26
-	//if shouldPerformUpgrade(sqlStore, VERSION_5_25_0, VERSION_5_26_0) {
-	sqlStore.CreateColumnIfNotExists("Sessions", "ExpiredNotify", "boolean", "boolean", "0")
+	if shouldPerformUpgrade(sqlStore, VERSION_5_25_0, VERSION_5_26_0) {
+		sqlStore.CreateColumnIfNotExists("Sessions", "ExpiredNotify", "boolean", "boolean", "0")
 
-	//saveSchemaVersion(sqlStore, VERSION_5_26_0)
-	//}
+		sqlStore.CreateColumnIfNotExists("FileInfo", "Sensitive", "boolean", "boolean", "0")
+		sqlStore.CreateColumnIfNotExists("FileInfo", "Hash", "varchar(64)", "varchar(64)", "")
+		sqlStore.CreateColumnIfNotExists("FileInfo", "PageCount", "int(11)", "integer", "0")
+		sqlStore.CreateColumnIfNotExists("FileInfo", "EnrichedAt", "bigint", "bigint", "0")
+		sqlStore.CreateColumnIfNotExistsNoDefault("FileInfo", "Metadata", "text", "varchar(4000)")
+
+		sqlStore.CreateColumnIfNotExists("Users", "ManagerId", "varchar(26)", "varchar(26)", "")
+
+		if sqlStore.CreateColumnIfNotExists("Users", "LastLogin", "bigint", "bigint", "0") {
+			// Sessions are the only record of past logins, so the most recent one is the best guess.
+			sqlStore.GetMaster().Exec("UPDATE Users SET LastLogin = COALESCE((SELECT MAX(Sessions.CreateAt) FROM Sessions WHERE Sessions.UserId = Users.Id), 0)")
+		}
+
+		sqlStore.CreateColumnIfNotExists("Users", "IsServiceAccount", "boolean", "boolean", "0")
+
+		sqlStore.CreateColumnIfNotExists("Users", "RolesExpiresAt", "bigint", "bigint", "0")
+		sqlStore.CreateColumnIfNotExists("TeamMembers", "ExplicitRolesExpiresAt", "bigint", "bigint", "0")
+		sqlStore.CreateColumnIfNotExists("ChannelMembers", "ExplicitRolesExpiresAt", "bigint", "bigint", "0")
+
+		if sqlStore.CreateColumnIfNotExistsNoDefault("Channels", "Props", "text", "varchar(4000)") {
+			sqlStore.GetMaster().Exec("UPDATE Channels SET Props = '{}' WHERE Props IS NULL")
+		}
+		if sqlStore.CreateColumnIfNotExistsNoDefault("Teams", "Props", "text", "varchar(4000)") {
+			sqlStore.GetMaster().Exec("UPDATE Teams SET Props = '{}' WHERE Props IS NULL")
+		}
+
+		if sqlStore.CreateColumnIfNotExists("Teams", "MemberCount", "bigint", "bigint", "0") {
+			sqlStore.GetMaster().Exec("UPDATE Teams SET MemberCount = (SELECT COUNT(*) FROM TeamMembers INNER JOIN Users ON Users.Id = TeamMembers.UserId WHERE TeamMembers.TeamId = Teams.Id AND TeamMembers.DeleteAt = 0)")
+		}
+
+		sqlStore.CreateColumnIfNotExistsNoDefault("Commands", "AutocompleteData", "text", "text")
+		sqlStore.CreateColumnIfNotExists("Commands", "AutocompleteDataVersion", "varchar(64)", "varchar(64)", "")
+		sqlStore.CreateColumnIfNotExists("Commands", "AutocompleteDataURL", "varchar(1024)", "varchar(1024)", "")
+		sqlStore.CreateColumnIfNotExists("Teams", "InviteExpiresAt", "bigint", "bigint", "0")
+		sqlStore.CreateColumnIfNotExists("Emoji", "TeamId", "varchar(26)", "varchar(26)", "")
+		sqlStore.CreateColumnIfNotExists("OAuthApps", "EmbedFrameAncestors", "varchar(1024)", "varchar(1024)", "[]")
+
+		// The memberships of deleted teams and users are deleted along with them from now on. Those
+		// orphaned before have to go before the TeamMembers foreign keys are created.
+		sqlStore.GetMaster().ExecNoTimeout("DELETE FROM TeamMembers WHERE TeamId NOT IN (SELECT Id FROM Teams)")
+		sqlStore.GetMaster().ExecNoTimeout("DELETE FROM TeamMembers WHERE UserId NOT IN (SELECT Id FROM Users)")
+
+		// The root messages are counted by the root message counts migration job, as counting them here
+		// would hold up the start of the servers with many posts.
+		sqlStore.CreateColumnIfNotExists("Channels", "TotalMsgCountRoot", "bigint", "bigint", "0")
+		sqlStore.CreateColumnIfNotExists("ChannelMembers", "MsgCountRoot", "bigint", "bigint", "0")
+		sqlStore.CreateColumnIfNotExists("ChannelMembers", "UrgentMentionCount", "bigint", "bigint", "0")
+
+		saveSchemaVersion(sqlStore, VERSION_5_26_0)
+	}
 }
@@ -0,0 +1,258 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+type SqlUserPropertyStore struct {
+	SqlStore
+}
+
+func newSqlUserPropertyStore(sqlStore SqlStore) store.UserPropertyStore {
+	s := &SqlUserPropertyStore{sqlStore}
+
+	for _, db := range sqlStore.GetAllConns() {
+		fieldsTable := db.AddTableWithName(model.UserPropertyField{}, "UserPropertyFields").SetKeys(false, "Id")
+		fieldsTable.ColMap("Id").SetMaxSize(26)
+		fieldsTable.ColMap("Name").SetMaxSize(model.USER_PROPERTY_FIELD_NAME_MAX_LENGTH)
+		fieldsTable.ColMap("DisplayName").SetMaxSize(model.USER_PROPERTY_FIELD_DISPLAY_NAME_MAX_RUNES * 4)
+		fieldsTable.ColMap("Type").SetMaxSize(32)
+		fieldsTable.ColMap("Options").SetMaxSize(model.USER_PROPERTY_FIELD_MAX_OPTIONS * (model.USER_PROPERTY_FIELD_OPTION_MAX_RUNES*4 + 3))
+		fieldsTable.ColMap("LdapAttribute").SetMaxSize(model.USER_PROPERTY_FIELD_LDAP_ATTRIBUTE_MAX_SIZE)
+		fieldsTable.SetUniqueTogether("Name", "DeleteAt")
+
+		valuesTable := db.AddTableWithName(model.UserPropertyValue{}, "UserPropertyValues").SetKeys(false, "UserId", "FieldId")
+		valuesTable.ColMap("UserId").SetMaxSize(26)
+		valuesTable.ColMap("FieldId").SetMaxSize(26)
+		valuesTable.ColMap("Value").SetMaxSize(model.USER_PROPERTY_VALUE_MAX_RUNES * 4)
+	}
+
+	return s
+}
+
+func (s SqlUserPropertyStore) createIndexesIfNotExists() {
+	s.CreateIndexIfNotExists("idx_userpropertyvalues_field_id", "UserPropertyValues", "FieldId")
+}
+
+func (s SqlUserPropertyStore) SaveField(field *model.UserPropertyField) (*model.UserPropertyField, error) {
+	if field.Id != "" {
+		return nil, store.NewErrInvalidInput("UserPropertyField", "id", field.Id)
+	}
+
+	field.PreSave()
+	if err := field.IsValid(); err != nil {
+		return nil, err
+	}
+
+	if err := s.GetMaster().Insert(field); err != nil {
+		if IsUniqueConstraintError(err, []string{"Name", "userpropertyfields_name_deleteat_key"}) {
+			return nil, store.NewErrConflict("UserPropertyField", err, "name="+field.Name)
+		}
+		return nil, errors.Wrapf(err, "failed to save UserPropertyField with id=%s", field.Id)
+	}
+
+	return field, nil
+}
+
+func (s SqlUserPropertyStore) UpdateField(field *model.UserPropertyField) (*model.UserPropertyField, error) {
+	field.PreUpdate()
+	if err := field.IsValid(); err != nil {
+		return nil, err
+	}
+
+	count, err := s.GetMaster().Update(field)
+	if err != nil {
+		if IsUniqueConstraintError(err, []string{"Name", "userpropertyfields_name_deleteat_key"}) {
+			return nil, store.NewErrConflict("UserPropertyField", err, "name="+field.Name)
+		}
+		return nil, errors.Wrapf(err, "failed to update UserPropertyField with id=%s", field.Id)
+	}
+	if count != 1 {
+		return nil, store.NewErrNotFound("UserPropertyField", field.Id)
+	}
+
+	return field, nil
+}
+
+func (s SqlUserPropertyStore) GetField(id string) (*model.UserPropertyField, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("*").
+		From("UserPropertyFields").
+		Where(sq.Eq{"Id": id, "DeleteAt": 0}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "user_property_field_tosql")
+	}
+
+	var field *model.UserPropertyField
+	if err := s.GetReplica().SelectOne(&field, queryString, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("UserPropertyField", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get UserPropertyField with id=%s", id)
+	}
+
+	return field, nil
+}
+
+func (s SqlUserPropertyStore) GetFields() ([]*model.UserPropertyField, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("*").
+		From("UserPropertyFields").
+		Where(sq.Eq{"DeleteAt": 0}).
+		OrderBy("CreateAt ASC", "Id ASC").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "user_property_fields_tosql")
+	}
+
+	fields := []*model.UserPropertyField{}
+	if _, err := s.GetReplica().Select(&fields, queryString, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to find UserPropertyFields")
+	}
+
+	return fields, nil
+}
+
+// DeleteField marks the field as deleted and removes the values that users had for it.
+func (s SqlUserPropertyStore) DeleteField(id string, deleteAt int64) error {
+	transaction, err := s.GetMaster().Begin()
+	if err != nil {
+		return errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransaction(transaction)
+
+	queryString, args, err := s.getQueryBuilder().
+		Update("UserPropertyFields").
+		Set("DeleteAt", deleteAt).
+		Set("UpdateAt", deleteAt).
+		Where(sq.Eq{"Id": id, "DeleteAt": 0}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "user_property_field_delete_tosql")
+	}
+
+	result, err := transaction.Exec(queryString, args...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete UserPropertyField with id=%s", id)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrapf(err, "failed to get rows affected for UserPropertyField with id=%s", id)
+	}
+	if rowsAffected == 0 {
+		return store.NewErrNotFound("UserPropertyField", id)
+	}
+
+	queryString, args, err = s.getQueryBuilder().
+		Delete("UserPropertyValues").
+		Where(sq.Eq{"FieldId": id}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "user_property_values_delete_tosql")
+	}
+
+	if _, err := transaction.Exec(queryString, args...); err != nil {
+		return errors.Wrapf(err, "failed to delete UserPropertyValues with fieldId=%s", id)
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return errors.Wrap(err, "commit_transaction")
+	}
+
+	return nil
+}
+
+func (s SqlUserPropertyStore) GetValuesForUser(userId string) ([]*model.UserPropertyValue, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("UserPropertyValues.*").
+		From("UserPropertyValues").
+		Join("UserPropertyFields ON UserPropertyFields.Id = UserPropertyValues.FieldId").
+		Where(sq.Eq{"UserPropertyValues.UserId": userId, "UserPropertyFields.DeleteAt": 0}).
+		OrderBy("UserPropertyValues.FieldId").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "user_property_values_tosql")
+	}
+
+	values := []*model.UserPropertyValue{}
+	if _, err := s.GetReplica().Select(&values, queryString, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to find UserPropertyValues with userId=%s", userId)
+	}
+
+	return values, nil
+}
+
+func (s SqlUserPropertyStore) SaveValuesForUser(userId string, values []*model.UserPropertyValue) error {
+	if len(values) == 0 {
+		return nil
+	}
+
+	transaction, err := s.GetMaster().Begin()
+	if err != nil {
+		return errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransaction(transaction)
+
+	fieldIds := make([]string, 0, len(values))
+	for _, value := range values {
+		fieldIds = append(fieldIds, value.FieldId)
+	}
+
+	queryString, args, err := s.getQueryBuilder().
+		Delete("UserPropertyValues").
+		Where(sq.Eq{"UserId": userId, "FieldId": fieldIds}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "user_property_values_delete_tosql")
+	}
+
+	if _, err := transaction.Exec(queryString, args...); err != nil {
+		return errors.Wrapf(err, "failed to delete UserPropertyValues with userId=%s", userId)
+	}
+
+	updateAt := model.GetMillis()
+	for _, value := range values {
+		if value.Value == "" {
+			continue
+		}
+
+		value.UserId = userId
+		value.UpdateAt = updateAt
+		if err := transaction.Insert(value); err != nil {
+			return errors.Wrapf(err, "failed to save UserPropertyValue with userId=%s and fieldId=%s", userId, value.FieldId)
+		}
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return errors.Wrap(err, "commit_transaction")
+	}
+
+	return nil
+}
+
+func (s SqlUserPropertyStore) PermanentDeleteByUser(userId string) error {
+	queryString, args, err := s.getQueryBuilder().
+		Delete("UserPropertyValues").
+		Where(sq.Eq{"UserId": userId}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "user_property_values_delete_tosql")
+	}
+
+	if _, err := s.GetMaster().Exec(queryString, args...); err != nil {
+		return errors.Wrapf(err, "failed to delete UserPropertyValues with userId=%s", userId)
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+type SqlUserRelationshipStore struct {
+	SqlStore
+}
+
+func newSqlUserRelationshipStore(sqlStore SqlStore) store.UserRelationshipStore {
+	s := &SqlUserRelationshipStore{sqlStore}
+
+	for _, db := range sqlStore.GetAllConns() {
+		table := db.AddTableWithName(model.UserRelationship{}, "UserRelationships").SetKeys(false, "UserId", "OtherUserId", "Type")
+		table.ColMap("UserId").SetMaxSize(26)
+		table.ColMap("OtherUserId").SetMaxSize(26)
+		table.ColMap("Type").SetMaxSize(32)
+	}
+
+	return s
+}
+
+func (s SqlUserRelationshipStore) createIndexesIfNotExists() {
+	s.CreateIndexIfNotExists("idx_userrelationships_other_user_id", "UserRelationships", "OtherUserId")
+}
+
+// Save records that the user muted or blocked the other user. It returns a store.ErrConflict if
+// the user already did.
+func (s SqlUserRelationshipStore) Save(relationship *model.UserRelationship) (*model.UserRelationship, error) {
+	relationship.PreSave()
+	if err := relationship.IsValid(); err != nil {
+		return nil, err
+	}
+
+	if err := s.GetMaster().Insert(relationship); err != nil {
+		if IsUniqueConstraintError(err, []string{"PRIMARY", "userrelationships_pkey"}) {
+			return nil, store.NewErrConflict("UserRelationship", err, "user_id="+relationship.UserId+", other_user_id="+relationship.OtherUserId+", type="+relationship.Type)
+		}
+		return nil, errors.Wrapf(err, "failed to save UserRelationship with user_id=%s, other_user_id=%s and type=%s", relationship.UserId, relationship.OtherUserId, relationship.Type)
+	}
+
+	return relationship, nil
+}
+
+func (s SqlUserRelationshipStore) Get(userId string, otherUserId string, relationshipType string) (*model.UserRelationship, error) {
+	var relationship *model.UserRelationship
+	if err := s.GetReplica().SelectOne(&relationship, "SELECT * FROM UserRelationships WHERE UserId = :UserId AND OtherUserId = :OtherUserId AND Type = :Type", map[string]interface{}{"UserId": userId, "OtherUserId": otherUserId, "Type": relationshipType}); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("UserRelationship", otherUserId)
+		}
+		return nil, errors.Wrapf(err, "failed to get UserRelationship with user_id=%s, other_user_id=%s and type=%s", userId, otherUserId, relationshipType)
+	}
+
+	return relationship, nil
+}
+
+// GetForUser returns the users the user muted or blocked, oldest first.
+func (s SqlUserRelationshipStore) GetForUser(userId string) ([]*model.UserRelationship, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("*").
+		From("UserRelationships").
+		Where(sq.Eq{"UserId": userId}).
+		OrderBy("CreateAt ASC", "OtherUserId ASC", "Type ASC").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "user_relationships_tosql")
+	}
+
+	relationships := []*model.UserRelationship{}
+	if _, err := s.GetReplica().Select(&relationships, queryString, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to find UserRelationships with user_id=%s", userId)
+	}
+
+	return relationships, nil
+}
+
+// GetUserIdsRelatedTo returns the ids of the users who muted or blocked the other user.
+func (s SqlUserRelationshipStore) GetUserIdsRelatedTo(otherUserId string) ([]string, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("DISTINCT UserId").
+		From("UserRelationships").
+		Where(sq.Eq{"OtherUserId": otherUserId}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "user_relationships_related_to_tosql")
+	}
+
+	userIds := []string{}
+	if _, err := s.GetReplica().Select(&userIds, queryString, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to find UserRelationships with other_user_id=%s", otherUserId)
+	}
+
+	return userIds, nil
+}
+
+// Delete removes the mute or the block of the other user, returning a store.ErrNotFound if the
+// user hadn't muted or blocked them.
+func (s SqlUserRelationshipStore) Delete(userId string, otherUserId string, relationshipType string) error {
+	result, err := s.GetMaster().Exec("DELETE FROM UserRelationships WHERE UserId = :UserId AND OtherUserId = :OtherUserId AND Type = :Type", map[string]interface{}{"UserId": userId, "OtherUserId": otherUserId, "Type": relationshipType})
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete UserRelationship with user_id=%s, other_user_id=%s and type=%s", userId, otherUserId, relationshipType)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrapf(err, "failed to get rows affected for UserRelationship with user_id=%s, other_user_id=%s and type=%s", userId, otherUserId, relationshipType)
+	}
+	if rowsAffected == 0 {
+		return store.NewErrNotFound("UserRelationship", otherUserId)
+	}
+
+	return nil
+}
+
+// PermanentDeleteByUser deletes the relationships of the user with others in both directions.
+func (s SqlUserRelationshipStore) PermanentDeleteByUser(userId string) error {
+	if _, err := s.GetMaster().Exec("DELETE FROM UserRelationships WHERE UserId = :UserId OR OtherUserId = :UserId", map[string]interface{}{"UserId": userId}); err != nil {
+		return errors.Wrapf(err, "failed to delete UserRelationships with user_id=%s", userId)
+	}
+
+	return nil
+}
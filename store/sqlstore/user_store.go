@@ -50,7 +50,7 @@ func newSqlUserStore(sqlStore SqlStore, metrics einterfaces.MetricsInterface) st
 
 	// note: we are providing field names explicitly here to maintain order of columns (needed when using raw queries)
 	us.usersQuery = us.getQueryBuilder().
-		Select("u.Id", "u.CreateAt", "u.UpdateAt", "u.DeleteAt", "u.Username", "u.Password", "u.AuthData", "u.AuthService", "u.Email", "u.EmailVerified", "u.Nickname", "u.FirstName", "u.LastName", "u.Position", "u.Roles", "u.AllowMarketing", "u.Props", "u.NotifyProps", "u.LastPasswordUpdate", "u.LastPictureUpdate", "u.FailedAttempts", "u.Locale", "u.Timezone", "u.MfaActive", "u.MfaSecret",
+		Select("u.Id", "u.CreateAt", "u.UpdateAt", "u.DeleteAt", "u.Username", "u.Password", "u.AuthData", "u.AuthService", "u.Email", "u.EmailVerified", "u.Nickname", "u.FirstName", "u.LastName", "u.Position", "u.Roles", "u.AllowMarketing", "u.Props", "u.NotifyProps", "u.LastPasswordUpdate", "u.LastPictureUpdate", "u.FailedAttempts", "u.Locale", "u.Timezone", "u.MfaActive", "u.MfaSecret", "u.ManagerId", "u.LastLogin", "u.IsServiceAccount", "u.RolesExpiresAt",
 			"b.UserId IS NOT NULL AS IsBot", "COALESCE(b.Description, '') AS BotDescription", "COALESCE(b.LastIconUpdate, 0) AS BotLastIconUpdate").
 		From("Users u").
 		LeftJoin("Bots b ON ( b.UserId = u.Id )")
@@ -73,6 +73,7 @@ func newSqlUserStore(sqlStore SqlStore, metrics einterfaces.MetricsInterface) st
 		table.ColMap("MfaSecret").SetMaxSize(128)
 		table.ColMap("Position").SetMaxSize(128)
 		table.ColMap("Timezone").SetMaxSize(256)
+		table.ColMap("ManagerId").SetMaxSize(26)
 	}
 
 	return us
@@ -83,6 +84,11 @@ func (us SqlUserStore) createIndexesIfNotExists() {
 	us.CreateIndexIfNotExists("idx_users_update_at", "Users", "UpdateAt")
 	us.CreateIndexIfNotExists("idx_users_create_at", "Users", "CreateAt")
 	us.CreateIndexIfNotExists("idx_users_delete_at", "Users", "DeleteAt")
+	us.CreateIndexIfNotExists("idx_users_manager_id", "Users", "ManagerId")
+	us.CreateIndexIfNotExists("idx_users_roles_expires_at", "Users", "RolesExpiresAt")
+	us.CreateCompositeIndexIfNotExists("idx_users_delete_at_last_login", "Users", []string{"DeleteAt", "LastLogin"})
+	us.CreateCompositeIndexIfNotExists("idx_users_delete_at_auth_service", "Users", []string{"DeleteAt", "AuthService"})
+	us.CreateCompositeIndexIfNotExists("idx_users_delete_at_mfa_active", "Users", []string{"DeleteAt", "MfaActive"})
 
 	if us.DriverName() == model.DATABASE_DRIVER_POSTGRES {
 		us.CreateIndexIfNotExists("idx_users_email_lower_textpattern", "Users", "lower(Email) text_pattern_ops")
@@ -182,10 +188,14 @@ func (us SqlUserStore) Update(user *model.User, trustedUpdateData bool) (*model.
 	user.FailedAttempts = oldUser.FailedAttempts
 	user.MfaSecret = oldUser.MfaSecret
 	user.MfaActive = oldUser.MfaActive
+	user.LastLogin = oldUser.LastLogin
+	user.IsServiceAccount = oldUser.IsServiceAccount
 
 	if !trustedUpdateData {
 		user.Roles = oldUser.Roles
+		user.RolesExpiresAt = oldUser.RolesExpiresAt
 		user.DeleteAt = oldUser.DeleteAt
+		user.ManagerId = oldUser.ManagerId
 	}
 
 	if user.IsOAuthUser() {
@@ -327,6 +337,16 @@ func (us SqlUserStore) UpdateMfaActive(userId string, active bool) *model.AppErr
 	return nil
 }
 
+// UpdateLastLogin records when the user last logged in. It leaves UpdateAt alone since logging in
+// doesn't change anything clients care about.
+func (us SqlUserStore) UpdateLastLogin(userId string, lastLogin int64) *model.AppError {
+	if _, err := us.GetMaster().Exec("UPDATE Users SET LastLogin = :LastLogin WHERE Id = :UserId", map[string]interface{}{"LastLogin": lastLogin, "UserId": userId}); err != nil {
+		return model.NewAppError("SqlUserStore.UpdateLastLogin", "store.sql_user.update_last_login.app_error", nil, "user_id="+userId+", "+err.Error(), http.StatusInternalServerError)
+	}
+
+	return nil
+}
+
 func (us SqlUserStore) Get(id string) (*model.User, *model.AppError) {
 	failure := func(err error, id string, statusCode int) *model.AppError {
 		details := "user_id=" + id + ", " + err.Error()
@@ -419,6 +439,8 @@ func (us SqlUserStore) GetAllProfiles(options *model.UserGetOptions) ([]*model.U
 	query = applyViewRestrictionsFilter(query, options.ViewRestrictions, true)
 
 	query = applyRoleFilter(query, options.Role, isPostgreSQL)
+	query = applyMultiRoleFilters(query, options.Roles, []string{}, []string{})
+	query = applyAccountFilters(query, options.UserAccountFilters)
 
 	if options.Inactive {
 		query = query.Where("u.DeleteAt != 0")
@@ -458,6 +480,45 @@ func applyRoleFilter(query sq.SelectBuilder, role string, isPostgreSQL bool) sq.
 	return query.Where("u.Roles LIKE ? ESCAPE '*'", roleParam)
 }
 
+func applyPropertyFilters(query sq.SelectBuilder, propertyFilters map[string]string) sq.SelectBuilder {
+	fieldIds := make([]string, 0, len(propertyFilters))
+	for fieldId := range propertyFilters {
+		fieldIds = append(fieldIds, fieldId)
+	}
+	// Sorting keeps the generated query stable for the same filters.
+	sort.Strings(fieldIds)
+
+	for _, fieldId := range fieldIds {
+		query = query.Where("u.Id IN (SELECT UserId FROM UserPropertyValues WHERE FieldId = ? AND Value = ?)", fieldId, propertyFilters[fieldId])
+	}
+
+	return query
+}
+
+func applyAccountFilters(query sq.SelectBuilder, filters model.UserAccountFilters) sq.SelectBuilder {
+	if filters.NeverLoggedIn {
+		query = query.Where("u.LastLogin = 0")
+	}
+
+	if filters.LastLoginBefore > 0 {
+		query = query.Where("u.LastLogin < ?", filters.LastLoginBefore)
+	}
+
+	if filters.AuthService == model.USER_AUTH_SERVICE_EMAIL {
+		query = query.Where("u.AuthService = ''")
+	} else if filters.AuthService != "" {
+		query = query.Where("u.AuthService = ?", filters.AuthService)
+	}
+
+	if filters.MfaActive {
+		query = query.Where("u.MfaActive = ?", true)
+	} else if filters.MfaInactive {
+		query = query.Where("u.MfaActive = ?", false)
+	}
+
+	return query
+}
+
 func applyMultiRoleFilters(query sq.SelectBuilder, roles []string, teamRoles []string, channelRoles []string) sq.SelectBuilder {
 	queryString := ""
 	if len(roles) > 0 && roles[0] != "" {
@@ -641,6 +702,7 @@ func (us SqlUserStore) GetProfiles(options *model.UserGetOptions) ([]*model.User
 
 	query = applyRoleFilter(query, options.Role, isPostgreSQL)
 	query = applyMultiRoleFilters(query, options.Roles, options.TeamRoles, options.ChannelRoles)
+	query = applyAccountFilters(query, options.UserAccountFilters)
 
 	if options.Inactive {
 		query = query.Where("u.DeleteAt != 0")
@@ -750,7 +812,7 @@ func (us SqlUserStore) GetAllProfilesInChannel(channelId string, allowFromCache
 	for rows.Next() {
 		var user model.User
 		var props, notifyProps, timezone []byte
-		if err = rows.Scan(&user.Id, &user.CreateAt, &user.UpdateAt, &user.DeleteAt, &user.Username, &user.Password, &user.AuthData, &user.AuthService, &user.Email, &user.EmailVerified, &user.Nickname, &user.FirstName, &user.LastName, &user.Position, &user.Roles, &user.AllowMarketing, &props, &notifyProps, &user.LastPasswordUpdate, &user.LastPictureUpdate, &user.FailedAttempts, &user.Locale, &timezone, &user.MfaActive, &user.MfaSecret, &user.IsBot, &user.BotDescription, &user.BotLastIconUpdate); err != nil {
+		if err = rows.Scan(&user.Id, &user.CreateAt, &user.UpdateAt, &user.DeleteAt, &user.Username, &user.Password, &user.AuthData, &user.AuthService, &user.Email, &user.EmailVerified, &user.Nickname, &user.FirstName, &user.LastName, &user.Position, &user.Roles, &user.AllowMarketing, &props, &notifyProps, &user.LastPasswordUpdate, &user.LastPictureUpdate, &user.FailedAttempts, &user.Locale, &timezone, &user.MfaActive, &user.MfaSecret, &user.ManagerId, &user.LastLogin, &user.IsServiceAccount, &user.RolesExpiresAt, &user.IsBot, &user.BotDescription, &user.BotLastIconUpdate); err != nil {
 			return failure(err)
 		}
 		if err = json.Unmarshal(props, &user.Props); err != nil {
@@ -828,6 +890,7 @@ func (us SqlUserStore) GetProfilesWithoutTeam(options *model.UserGetOptions) ([]
 	query = applyViewRestrictionsFilter(query, options.ViewRestrictions, true)
 
 	query = applyRoleFilter(query, options.Role, isPostgreSQL)
+	query = applyAccountFilters(query, options.UserAccountFilters)
 
 	if options.Inactive {
 		query = query.Where("u.DeleteAt != 0")
@@ -1187,14 +1250,58 @@ func (us SqlUserStore) VerifyEmail(userId, email string) (string, *model.AppErro
 	return userId, nil
 }
 
+// PermanentDelete deletes the user along with their team memberships, recording that they left the
+// teams and recounting their members.
 func (us SqlUserStore) PermanentDelete(userId string) *model.AppError {
-	if _, err := us.GetMaster().Exec("DELETE FROM Users WHERE Id = :UserId", map[string]interface{}{"UserId": userId}); err != nil {
+	transaction, err := us.GetMaster().Begin()
+	if err != nil {
+		return model.NewAppError("SqlUserStore.PermanentDelete", "store.sql_user.permanent_delete.app_error", nil, "userId="+userId+", "+err.Error(), http.StatusInternalServerError)
+	}
+	defer finalizeTransaction(transaction)
+
+	teamStore := SqlTeamStore{SqlStore: us.SqlStore}
+
+	var teamIds []string
+	if _, err = transaction.Select(&teamIds, "SELECT TeamId FROM TeamMembers WHERE UserId = :UserId", map[string]interface{}{"UserId": userId}); err != nil {
+		return model.NewAppError("SqlUserStore.PermanentDelete", "store.sql_user.permanent_delete.app_error", nil, "userId="+userId+", "+err.Error(), http.StatusInternalServerError)
+	}
+	if err = teamStore.logTeamMemberLeaveEvents(transaction, sq.Eq{"UserId": userId}, model.GetMillis()); err != nil {
+		return model.NewAppError("SqlUserStore.PermanentDelete", "store.sql_user.permanent_delete.app_error", nil, "userId="+userId+", "+err.Error(), http.StatusInternalServerError)
+	}
+	if _, err = transaction.Exec("DELETE FROM TeamMembers WHERE UserId = :UserId", map[string]interface{}{"UserId": userId}); err != nil {
+		return model.NewAppError("SqlUserStore.PermanentDelete", "store.sql_user.permanent_delete.app_error", nil, "userId="+userId+", "+err.Error(), http.StatusInternalServerError)
+	}
+	if _, err = transaction.Exec("DELETE FROM Users WHERE Id = :UserId", map[string]interface{}{"UserId": userId}); err != nil {
+		return model.NewAppError("SqlUserStore.PermanentDelete", "store.sql_user.permanent_delete.app_error", nil, "userId="+userId+", "+err.Error(), http.StatusInternalServerError)
+	}
+	if err = teamStore.updateMemberCounts(transaction, teamIds); err != nil {
+		return model.NewAppError("SqlUserStore.PermanentDelete", "store.sql_user.permanent_delete.app_error", nil, "userId="+userId+", "+err.Error(), http.StatusInternalServerError)
+	}
+	if err = transaction.Commit(); err != nil {
 		return model.NewAppError("SqlUserStore.PermanentDelete", "store.sql_user.permanent_delete.app_error", nil, "userId="+userId+", "+err.Error(), http.StatusInternalServerError)
 	}
 	return nil
 }
 
 func (us SqlUserStore) Count(options model.UserCountOptions) (int64, *model.AppError) {
+	if options.Estimate && options.TeamId == "" && options.ChannelId == "" && options.ViewRestrictions == nil && !options.ExcludeServiceAccounts &&
+		len(options.Roles) == 0 && len(options.TeamRoles) == 0 && len(options.ChannelRoles) == 0 {
+		exactOptions := options
+		exactOptions.Estimate = false
+
+		count, err := estimateRowCountOrExact(us, "Users", func() (int64, error) {
+			count, appErr := us.Count(exactOptions)
+			if appErr != nil {
+				return 0, appErr
+			}
+			return count, nil
+		})
+		if err != nil {
+			return int64(0), model.NewAppError("SqlUserStore.Count", "store.sql_user.get_total_users_count.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		return count, nil
+	}
+
 	isPostgreSQL := us.DriverName() == model.DATABASE_DRIVER_POSTGRES
 	query := us.getQueryBuilder().Select("COUNT(DISTINCT u.Id)").From("Users AS u")
 
@@ -1214,6 +1321,10 @@ func (us SqlUserStore) Count(options model.UserCountOptions) (int64, *model.AppE
 		}
 	}
 
+	if options.ExcludeServiceAccounts {
+		query = query.Where(sq.Eq{"u.IsServiceAccount": false})
+	}
+
 	if options.TeamId != "" {
 		query = query.LeftJoin("TeamMembers AS tm ON u.Id = tm.UserId").Where("tm.TeamId = ? AND tm.DeleteAt = 0", options.TeamId)
 	} else if options.ChannelId != "" {
@@ -1430,6 +1541,8 @@ func (us SqlUserStore) performSearch(query sq.SelectBuilder, term string, option
 
 	query = applyRoleFilter(query, options.Role, isPostgreSQL)
 	query = applyMultiRoleFilters(query, options.Roles, options.TeamRoles, options.ChannelRoles)
+	query = applyPropertyFilters(query, options.PropertyFilters)
+	query = applyAccountFilters(query, options.UserAccountFilters)
 
 	if !options.AllowInactive {
 		query = query.Where("u.DeleteAt = 0")
@@ -1466,6 +1579,100 @@ func (us SqlUserStore) AnalyticsGetInactiveUsersCount() (int64, *model.AppError)
 	return count, nil
 }
 
+type seatCountByDay struct {
+	Day   int64
+	Count int64
+}
+
+// AnalyticsSeatCountsByDay returns, for each day between startTime and endTime, the number of users
+// holding a licensed seat at the end of the day, that is the users that are neither bots nor
+// deactivated, nor service accounts when excludeServiceAccounts is set. The counts are rebuilt from
+// the creation and deactivation times of the users.
+func (us SqlUserStore) AnalyticsSeatCountsByDay(startTime, endTime int64, excludeServiceAccounts bool) ([]*model.SeatUsagePoint, *model.AppError) {
+	startDay := startTime / model.SEAT_USAGE_DAY_MILLIS
+	endDay := endTime / model.SEAT_USAGE_DAY_MILLIS
+	if endDay < startDay {
+		return []*model.SeatUsagePoint{}, nil
+	}
+	from := startDay * model.SEAT_USAGE_DAY_MILLIS
+	to := (endDay + 1) * model.SEAT_USAGE_DAY_MILLIS
+
+	queryString, args, err := us.seatsQuery("COUNT(*)", excludeServiceAccounts).
+		Where(sq.Lt{"u.CreateAt": from}).
+		Where(sq.Or{sq.Eq{"u.DeleteAt": 0}, sq.GtOrEq{"u.DeleteAt": from}}).
+		ToSql()
+	if err != nil {
+		return nil, model.NewAppError("SqlUserStore.AnalyticsSeatCountsByDay", "store.sql_user.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	seats, err := us.GetReplica().SelectInt(queryString, args...)
+	if err != nil {
+		return nil, model.NewAppError("SqlUserStore.AnalyticsSeatCountsByDay", "store.sql_user.analytics_seat_counts_by_day.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	created, err := us.seatChangesByDay("CreateAt", from, to, excludeServiceAccounts)
+	if err != nil {
+		return nil, model.NewAppError("SqlUserStore.AnalyticsSeatCountsByDay", "store.sql_user.analytics_seat_counts_by_day.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	deleted, err := us.seatChangesByDay("DeleteAt", from, to, excludeServiceAccounts)
+	if err != nil {
+		return nil, model.NewAppError("SqlUserStore.AnalyticsSeatCountsByDay", "store.sql_user.analytics_seat_counts_by_day.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	points := make([]*model.SeatUsagePoint, 0, endDay-startDay+1)
+	for day := startDay; day <= endDay; day++ {
+		seats += created[day] - deleted[day]
+		points = append(points, &model.SeatUsagePoint{Day: day * model.SEAT_USAGE_DAY_MILLIS, Seats: seats})
+	}
+
+	return points, nil
+}
+
+// seatChangesByDay counts the non bot users whose column falls in [from, to), by day since the epoch.
+// seatsQuery selects the users that can hold a licensed seat.
+func (us SqlUserStore) seatsQuery(selectStr string, excludeServiceAccounts bool) sq.SelectBuilder {
+	query := us.getQueryBuilder().
+		Select(selectStr).
+		From("Users AS u").
+		LeftJoin("Bots ON u.Id = Bots.UserId").
+		Where("Bots.UserId IS NULL")
+
+	if excludeServiceAccounts {
+		query = query.Where(sq.Eq{"u.IsServiceAccount": false})
+	}
+
+	return query
+}
+
+func (us SqlUserStore) seatChangesByDay(column string, from, to int64, excludeServiceAccounts bool) (map[int64]int64, error) {
+	dayExpr := fmt.Sprintf("u.%s / %d", column, model.SEAT_USAGE_DAY_MILLIS)
+	if us.DriverName() == model.DATABASE_DRIVER_MYSQL {
+		dayExpr = fmt.Sprintf("u.%s DIV %d", column, model.SEAT_USAGE_DAY_MILLIS)
+	}
+
+	queryString, args, err := us.seatsQuery(dayExpr+" AS Day, COUNT(*) AS Count", excludeServiceAccounts).
+		Where(sq.GtOrEq{"u." + column: from}).
+		Where(sq.Lt{"u." + column: to}).
+		GroupBy(dayExpr).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []seatCountByDay
+	if _, err := us.GetReplica().Select(&rows, queryString, args...); err != nil {
+		return nil, err
+	}
+
+	changes := make(map[int64]int64, len(rows))
+	for _, row := range rows {
+		changes[row.Day] = row.Count
+	}
+
+	return changes, nil
+}
+
 func (us SqlUserStore) AnalyticsGetGuestCount() (int64, *model.AppError) {
 	count, err := us.GetReplica().SelectInt("SELECT count(*) FROM Users WHERE Roles LIKE :Roles and DeleteAt = 0", map[string]interface{}{"Roles": "%system_guest%"})
 	if err != nil {
@@ -1949,3 +2156,121 @@ func (us SqlUserStore) GetKnownUsers(userId string) ([]string, *model.AppError)
 
 	return userIds, nil
 }
+
+// GetDirectReports returns the active users whose manager is the given user.
+func (us SqlUserStore) GetDirectReports(managerId string, offset, limit int) ([]*model.User, *model.AppError) {
+	query := us.usersQuery.
+		Where(sq.Eq{"u.ManagerId": managerId, "u.DeleteAt": 0}).
+		OrderBy("u.Username ASC").
+		Offset(uint64(offset)).
+		Limit(uint64(limit))
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, model.NewAppError("SqlUserStore.GetDirectReports", "store.sql_user.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	var users []*model.User
+	if _, err := us.GetReplica().Select(&users, queryString, args...); err != nil {
+		return nil, model.NewAppError("SqlUserStore.GetDirectReports", "store.sql_user.get_direct_reports.app_error", nil, "manager_id="+managerId+", "+err.Error(), http.StatusInternalServerError)
+	}
+
+	for _, u := range users {
+		u.Sanitize(map[string]bool{})
+	}
+
+	return users, nil
+}
+
+// GetServiceAccounts returns a page of the service accounts ordered by username.
+func (us SqlUserStore) GetServiceAccounts(offset, limit int, includeDeleted bool) ([]*model.User, *model.AppError) {
+	query := us.usersQuery.
+		Where(sq.Eq{"u.IsServiceAccount": true}).
+		OrderBy("u.Username ASC").
+		Offset(uint64(offset)).
+		Limit(uint64(limit))
+
+	if !includeDeleted {
+		query = query.Where(sq.Eq{"u.DeleteAt": 0})
+	}
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, model.NewAppError("SqlUserStore.GetServiceAccounts", "store.sql_user.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	var users []*model.User
+	if _, err := us.GetReplica().Select(&users, queryString, args...); err != nil {
+		return nil, model.NewAppError("SqlUserStore.GetServiceAccounts", "store.sql_user.get_service_accounts.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	for _, u := range users {
+		u.Sanitize(map[string]bool{})
+	}
+
+	return users, nil
+}
+
+// GetUsersWithExpiredRoles returns the active users whose system roles expired at or before
+// expiredBefore, oldest expiry first.
+func (us SqlUserStore) GetUsersWithExpiredRoles(expiredBefore int64, limit int) ([]*model.User, *model.AppError) {
+	query := us.usersQuery.
+		Where(sq.Gt{"u.RolesExpiresAt": 0}).
+		Where(sq.LtOrEq{"u.RolesExpiresAt": expiredBefore}).
+		Where(sq.Eq{"u.DeleteAt": 0}).
+		OrderBy("u.RolesExpiresAt ASC", "u.Id ASC").
+		Limit(uint64(limit))
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, model.NewAppError("SqlUserStore.GetUsersWithExpiredRoles", "store.sql_user.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	var users []*model.User
+	if _, err := us.GetReplica().Select(&users, queryString, args...); err != nil {
+		return nil, model.NewAppError("SqlUserStore.GetUsersWithExpiredRoles", "store.sql_user.get_users_with_expired_roles.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	for _, u := range users {
+		u.Sanitize(map[string]bool{})
+	}
+
+	return users, nil
+}
+
+// GetReportingChain returns the managers of the user, starting with their direct manager. The chain
+// stops at model.USER_REPORTING_CHAIN_MAX_DEPTH managers or when it loops back on itself.
+func (us SqlUserStore) GetReportingChain(userId string) ([]*model.User, *model.AppError) {
+	users := []*model.User{}
+	seen := map[string]bool{userId: true}
+
+	for len(users) < model.USER_REPORTING_CHAIN_MAX_DEPTH {
+		query := us.usersQuery.
+			Join("Users r ON r.ManagerId = u.Id").
+			Where(sq.Eq{"r.Id": userId})
+
+		queryString, args, err := query.ToSql()
+		if err != nil {
+			return nil, model.NewAppError("SqlUserStore.GetReportingChain", "store.sql_user.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+
+		var manager *model.User
+		if err := us.GetReplica().SelectOne(&manager, queryString, args...); err != nil {
+			if err == sql.ErrNoRows {
+				break
+			}
+			return nil, model.NewAppError("SqlUserStore.GetReportingChain", "store.sql_user.get_reporting_chain.app_error", nil, "user_id="+userId+", "+err.Error(), http.StatusInternalServerError)
+		}
+
+		if seen[manager.Id] {
+			break
+		}
+		seen[manager.Id] = true
+
+		manager.Sanitize(map[string]bool{})
+		users = append(users, manager)
+		userId = manager.Id
+	}
+
+	return users, nil
+}
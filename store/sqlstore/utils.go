@@ -10,8 +10,13 @@ import (
 
 	"github.com/mattermost/gorp"
 	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
 )
 
+// estimatedCountThreshold is the estimated number of rows under which a table is counted exactly
+// anyway, as the statistics of small or freshly created tables are often missing or stale.
+const estimatedCountThreshold = 100000
+
 var escapeLikeSearchChar = []string{
 	"%",
 	"_",
@@ -52,3 +57,48 @@ func finalizeTransaction(transaction *gorp.Transaction) {
 		mlog.Error("Failed to rollback transaction", mlog.Err(err))
 	}
 }
+
+// estimateRowCount returns the number of rows of the table according to the database statistics,
+// without scanning it. The rows of the partitions of a partitioned Postgres table are included.
+// The estimate is only refreshed by ANALYZE or the autovacuum, so it can be off by a few percent.
+func estimateRowCount(sqlStore SqlStore, table string) (int64, error) {
+	switch sqlStore.DriverName() {
+	case model.DATABASE_DRIVER_POSTGRES:
+		return sqlStore.GetReplica().SelectInt(`
+			SELECT
+				COALESCE(SUM(GREATEST(c.reltuples, 0)), 0)::bigint
+			FROM
+				pg_class c
+			WHERE
+				c.oid = to_regclass(:Table)
+				OR c.oid IN (SELECT inhrelid FROM pg_inherits WHERE inhparent = to_regclass(:Table))`,
+			map[string]interface{}{"Table": strings.ToLower(table)})
+	case model.DATABASE_DRIVER_MYSQL:
+		return sqlStore.GetReplica().SelectInt(`
+			SELECT
+				COALESCE(SUM(TABLE_ROWS), 0)
+			FROM
+				information_schema.TABLES
+			WHERE
+				TABLE_SCHEMA = DATABASE()
+				AND TABLE_NAME = :Table`,
+			map[string]interface{}{"Table": table})
+	}
+
+	return 0, nil
+}
+
+// estimateRowCountOrExact returns the estimated number of rows of the table, falling back to the
+// exact count when the table is small enough for the estimate not to save anything.
+func estimateRowCountOrExact(sqlStore SqlStore, table string, exactCount func() (int64, error)) (int64, error) {
+	estimate, err := estimateRowCount(sqlStore, table)
+	if err != nil {
+		return 0, err
+	}
+
+	if estimate < estimatedCountThreshold {
+		return exactCount()
+	}
+
+	return estimate, nil
+}
@@ -51,11 +51,34 @@ type Store interface {
 	Job() JobStore
 	UserAccessToken() UserAccessTokenStore
 	ChannelMemberHistory() ChannelMemberHistoryStore
+	TeamMemberHistory() TeamMemberHistoryStore
 	Plugin() PluginStore
 	TermsOfService() TermsOfServiceStore
 	Group() GroupStore
 	UserTermsOfService() UserTermsOfServiceStore
 	LinkMetadata() LinkMetadataStore
+	ChannelBookmark() ChannelBookmarkStore
+	RetentionPolicy() RetentionPolicyStore
+	PostArchive() PostArchiveStore
+	PostsPartition() PostsPartitionStore
+	PresenceWebhook() PresenceWebhookStore
+	SlugHistory() SlugHistoryStore
+	TeamInviteLink() TeamInviteLinkStore
+	ChannelGuestLink() ChannelGuestLinkStore
+	TermsOfServicePolicy() TermsOfServicePolicyStore
+	UserProperty() UserPropertyStore
+	LoginHistory() LoginHistoryStore
+	EmailVerification() EmailVerificationStore
+	EventStream() EventStreamStore
+	Outbox() OutboxStore
+	ChannelChange() ChannelChangeStore
+	PendingPin() PendingPinStore
+	ChannelIntegration() ChannelIntegrationStore
+	IntegrationUsage() IntegrationUsageStore
+	UserRelationship() UserRelationshipStore
+	PostAcknowledgement() PostAcknowledgementStore
+	StatusAutomation() StatusAutomationStore
+	PostIntegrity() PostIntegrityStore
 	MarkSystemRanUnitTests()
 	Close()
 	LockToMaster()
@@ -73,64 +96,107 @@ type Store interface {
 }
 
 type TeamStore interface {
-	Save(team *model.Team) (*model.Team, *model.AppError)
-	Update(team *model.Team) (*model.Team, *model.AppError)
-	Get(id string) (*model.Team, *model.AppError)
-	GetByName(name string) (*model.Team, *model.AppError)
-	GetByNames(name []string) ([]*model.Team, *model.AppError)
-	SearchAll(term string) ([]*model.Team, *model.AppError)
-	SearchAllPaged(term string, page int, perPage int) ([]*model.Team, int64, *model.AppError)
-	SearchOpen(term string) ([]*model.Team, *model.AppError)
-	SearchPrivate(term string) ([]*model.Team, *model.AppError)
-	GetAll() ([]*model.Team, *model.AppError)
-	GetAllPage(offset int, limit int) ([]*model.Team, *model.AppError)
-	GetAllPrivateTeamListing() ([]*model.Team, *model.AppError)
-	GetAllPrivateTeamPageListing(offset int, limit int) ([]*model.Team, *model.AppError)
-	GetAllPublicTeamPageListing(offset int, limit int) ([]*model.Team, *model.AppError)
-	GetAllTeamListing() ([]*model.Team, *model.AppError)
-	GetAllTeamPageListing(offset int, limit int) ([]*model.Team, *model.AppError)
-	GetTeamsByUserId(userId string) ([]*model.Team, *model.AppError)
-	GetByInviteId(inviteId string) (*model.Team, *model.AppError)
-	PermanentDelete(teamId string) *model.AppError
-	AnalyticsTeamCount(includeDeleted bool) (int64, *model.AppError)
-	AnalyticsPublicTeamCount() (int64, *model.AppError)
-	AnalyticsPrivateTeamCount() (int64, *model.AppError)
-	SaveMultipleMembers(members []*model.TeamMember, maxUsersPerTeam int) ([]*model.TeamMember, *model.AppError)
-	SaveMember(member *model.TeamMember, maxUsersPerTeam int) (*model.TeamMember, *model.AppError)
-	UpdateMember(member *model.TeamMember) (*model.TeamMember, *model.AppError)
-	UpdateMultipleMembers(members []*model.TeamMember) ([]*model.TeamMember, *model.AppError)
-	GetMember(teamId string, userId string) (*model.TeamMember, *model.AppError)
-	GetMembers(teamId string, offset int, limit int, teamMembersGetOptions *model.TeamMembersGetOptions) ([]*model.TeamMember, *model.AppError)
-	GetMembersByIds(teamId string, userIds []string, restrictions *model.ViewUsersRestrictions) ([]*model.TeamMember, *model.AppError)
-	GetTotalMemberCount(teamId string, restrictions *model.ViewUsersRestrictions) (int64, *model.AppError)
-	GetActiveMemberCount(teamId string, restrictions *model.ViewUsersRestrictions) (int64, *model.AppError)
-	GetTeamsForUser(userId string) ([]*model.TeamMember, *model.AppError)
-	GetTeamsForUserWithPagination(userId string, page, perPage int) ([]*model.TeamMember, *model.AppError)
-	GetChannelUnreadsForAllTeams(excludeTeamId, userId string) ([]*model.ChannelUnread, *model.AppError)
-	GetChannelUnreadsForTeam(teamId, userId string) ([]*model.ChannelUnread, *model.AppError)
-	RemoveMember(teamId string, userId string) *model.AppError
-	RemoveMembers(teamId string, userIds []string) *model.AppError
-	RemoveAllMembersByTeam(teamId string) *model.AppError
-	RemoveAllMembersByUser(userId string) *model.AppError
-	UpdateLastTeamIconUpdate(teamId string, curTime int64) *model.AppError
-	GetTeamsByScheme(schemeId string, offset int, limit int) ([]*model.Team, *model.AppError)
-	MigrateTeamMembers(fromTeamId string, fromUserId string) (map[string]string, *model.AppError)
-	ResetAllTeamSchemes() *model.AppError
-	ClearAllCustomRoleAssignments() *model.AppError
-	AnalyticsGetTeamCountForScheme(schemeId string) (int64, *model.AppError)
-	GetAllForExportAfter(limit int, afterId string) ([]*model.TeamForExport, *model.AppError)
-	GetTeamMembersForExport(userId string) ([]*model.TeamMemberForExport, *model.AppError)
-	UserBelongsToTeams(userId string, teamIds []string) (bool, *model.AppError)
-	GetUserTeamIds(userId string, allowFromCache bool) ([]string, *model.AppError)
+	Save(ctx context.Context, team *model.Team) (*model.Team, error)
+	SaveMultiple(ctx context.Context, teams []*model.Team) ([]*model.Team, []error, error)
+	Update(ctx context.Context, team *model.Team) (*model.Team, error)
+	Get(ctx context.Context, id string) (*model.Team, error)
+	GetByName(ctx context.Context, name string) (*model.Team, error)
+	GetByNames(ctx context.Context, name []string) ([]*model.Team, error)
+	GetByAllowedDomain(ctx context.Context, domain string) ([]*model.Team, error)
+	SearchAll(ctx context.Context, term string, opts *model.TeamSearchOpts) ([]*model.Team, *model.AppError)
+	SearchAllPaged(ctx context.Context, term string, opts *model.TeamSearchOpts, page int, perPage int) ([]*model.Team, int64, *model.AppError)
+	SearchOpen(ctx context.Context, term string, opts *model.TeamSearchOpts) ([]*model.Team, *model.AppError)
+	SearchPrivate(ctx context.Context, term string, opts *model.TeamSearchOpts) ([]*model.Team, *model.AppError)
+	// SearchPrivateForUser searches the private teams the user is an active member of.
+	SearchPrivateForUser(ctx context.Context, userId string, term string, opts *model.TeamSearchOpts) ([]*model.Team, *model.AppError)
+	GetAll(ctx context.Context) ([]*model.Team, *model.AppError)
+	GetAllPage(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError)
+	GetAllDeletedPage(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError)
+	GetTeamsWithNoActiveMembers(ctx context.Context, offset int, limit int) ([]*model.Team, error)
+	Restore(ctx context.Context, teamId string) error
+	GetAllPrivateTeamListing(ctx context.Context) ([]*model.Team, *model.AppError)
+	GetAllPrivateTeamPageListing(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError)
+	GetAllPublicTeamPageListing(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError)
+	GetAllTeamListing(ctx context.Context) ([]*model.Team, *model.AppError)
+	GetAllTeamPageListing(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError)
+	GetTeamsByUserId(ctx context.Context, userId string, opts *model.TeamsForUserGetOptions) ([]*model.Team, *model.AppError)
+	GetByInviteId(ctx context.Context, inviteId string) (*model.Team, error)
+	// RegenerateInviteId replaces the InviteId of the team, expiring at inviteExpiresAt unless 0.
+	RegenerateInviteId(ctx context.Context, teamId string, inviteExpiresAt int64) (*model.Team, error)
+	PermanentDelete(ctx context.Context, teamId string) *model.AppError
+	AnalyticsTeamCount(ctx context.Context, includeDeleted bool) (int64, *model.AppError)
+	AnalyticsPublicTeamCount(ctx context.Context) (int64, *model.AppError)
+	AnalyticsPrivateTeamCount(ctx context.Context) (int64, *model.AppError)
+	GetDirectoryStats(ctx context.Context, joinedSince int64) ([]*model.TeamDirectoryStats, *model.AppError)
+	SaveMultipleMembers(ctx context.Context, members []*model.TeamMember, maxUsersPerTeam int) ([]*model.TeamMember, error)
+	SaveMember(ctx context.Context, member *model.TeamMember, maxUsersPerTeam int) (*model.TeamMember, error)
+	UpdateMember(ctx context.Context, member *model.TeamMember) (*model.TeamMember, *model.AppError)
+	UpdateMultipleMembers(ctx context.Context, members []*model.TeamMember) ([]*model.TeamMember, *model.AppError)
+	GetMember(ctx context.Context, teamId string, userId string, allowFromCache bool) (*model.TeamMember, error)
+	GetMembers(ctx context.Context, teamId string, offset int, limit int, teamMembersGetOptions *model.TeamMembersGetOptions) ([]*model.TeamMember, *model.AppError)
+	GetMembersByIds(ctx context.Context, teamId string, userIds []string, restrictions *model.ViewUsersRestrictions) ([]*model.TeamMember, *model.AppError)
+	GetMembersByTeamIds(ctx context.Context, teamIds []string, userId string) ([]*model.TeamMember, *model.AppError)
+	GetMembersWithExpiredRoles(ctx context.Context, expiredBefore int64, limit int) ([]*model.TeamMember, error)
+	GetTotalMemberCount(ctx context.Context, teamId string, restrictions *model.ViewUsersRestrictions) (int64, *model.AppError)
+	GetActiveMemberCount(ctx context.Context, teamId string, restrictions *model.ViewUsersRestrictions) (int64, *model.AppError)
+	// GetActiveMemberCounts returns the number of active members of each of the given teams.
+	GetActiveMemberCounts(ctx context.Context, teamIds []string) (map[string]int64, error)
+	GetTeamsForUser(ctx context.Context, userId string) ([]*model.TeamMember, *model.AppError)
+	GetTeamsForUserWithPagination(ctx context.Context, userId string, page, perPage int, opts *model.TeamMembersForUserGetOptions) ([]*model.TeamMember, error)
+	GetChannelUnreadsForAllTeams(ctx context.Context, excludeTeamId, userId string) ([]*model.ChannelUnread, *model.AppError)
+	GetUnreadsForAllTeams(ctx context.Context, excludeTeamId, userId string) ([]*model.TeamUnread, *model.AppError)
+	GetChannelUnreadsForTeam(ctx context.Context, teamId, userId string) ([]*model.ChannelUnread, *model.AppError)
+	RemoveMember(ctx context.Context, teamId string, userId string) *model.AppError
+	RemoveMembers(ctx context.Context, teamId string, userIds []string) *model.AppError
+	RemoveAllMembersByTeam(ctx context.Context, teamId string) *model.AppError
+	// RemoveAllMembersByUser removes the user from every team and returns the ids of the teams
+	// the user was removed from.
+	RemoveAllMembersByUser(ctx context.Context, userId string) ([]string, *model.AppError)
+	UpdateLastTeamIconUpdate(ctx context.Context, teamId string, curTime int64) *model.AppError
+	GetTeamsByScheme(ctx context.Context, schemeId string, offset int, limit int) ([]*model.Team, *model.AppError)
+	// MigrateTeamMembers migrates a batch of up to batchSize team members after the given key, returning the key of
+	// the last one along with the number of team members processed. No key is returned once the migration is done.
+	MigrateTeamMembers(ctx context.Context, fromTeamId string, fromUserId string, batchSize int) (map[string]string, int64, *model.AppError)
+	ResetAllTeamSchemes(ctx context.Context) *model.AppError
+	ClearAllCustomRoleAssignments(ctx context.Context) *model.AppError
+	AnalyticsGetTeamCountForScheme(ctx context.Context, schemeId string) (int64, *model.AppError)
+	GetAllForExportAfter(ctx context.Context, limit int, afterId string) ([]*model.TeamForExport, *model.AppError)
+	GetTeamMembersForExport(ctx context.Context, userId string) ([]*model.TeamMemberForExport, *model.AppError)
+	UserBelongsToTeams(ctx context.Context, userId string, teamIds []string) (bool, *model.AppError)
+	// UserBelongsToTeam returns whether the user is a member of the team, and is cached since it
+	// is checked on most permission evaluations.
+	UserBelongsToTeam(ctx context.Context, userId string, teamId string, allowFromCache bool) (bool, *model.AppError)
+	GetUserTeamIds(ctx context.Context, userId string, allowFromCache bool) ([]string, *model.AppError)
 	InvalidateAllTeamIdsForUser(userId string)
 	ClearCaches()
 
 	// UpdateMembersRole sets all of the given team members to admins and all of the other members of the team to
 	// non-admin members.
-	UpdateMembersRole(teamID string, userIDs []string) *model.AppError
+	UpdateMembersRole(ctx context.Context, teamID string, userIDs []string) *model.AppError
+
+	// UpdateMembersRoleAndGetUpdated is UpdateMembersRole, also returning the team members whose SchemeAdmin
+	// field value changed.
+	UpdateMembersRoleAndGetUpdated(ctx context.Context, teamID string, userIDs []string) ([]*model.TeamMember, *model.AppError)
 
 	// GroupSyncedTeamCount returns the count of non-deleted group-constrained teams.
-	GroupSyncedTeamCount() (int64, *model.AppError)
+	GroupSyncedTeamCount(ctx context.Context) (int64, *model.AppError)
+
+	// GetGroupSyncedTeamsPage returns a page of non-deleted group-constrained teams, ordered by id.
+	GetGroupSyncedTeamsPage(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError)
+
+	// GroupSyncedTeamCountByGroup returns the count of non-deleted group-constrained teams synced with the
+	// group.
+	GroupSyncedTeamCountByGroup(ctx context.Context, groupId string) (int64, *model.AppError)
+
+	// GetNewTeamMembersSince returns a page of the active users who joined the team since the given
+	// time, most recent first, along with their total count.
+	GetNewTeamMembersSince(ctx context.Context, teamId string, since int64, offset int, limit int) (*model.NewTeamMembersList, error)
+
+	// SetPolicy makes the retention policy govern the team, replacing its previous policy. An empty
+	// policyId leaves the team governed by the global data retention settings.
+	SetPolicy(ctx context.Context, teamId string, policyId string) error
+	GetPolicyForTeam(ctx context.Context, teamId string) (*model.RetentionPolicy, error)
+	GetTeamsForPolicyPage(ctx context.Context, policyId string, offset int, limit int) ([]*model.Team, error)
 }
 
 type ChannelStore interface {
@@ -179,6 +245,7 @@ type ChannelStore interface {
 	GetAllChannelMembersNotifyPropsForChannel(channelId string, allowFromCache bool) (map[string]model.StringMap, *model.AppError)
 	InvalidateCacheForChannelMembersNotifyProps(channelId string)
 	GetMemberForPost(postId string, userId string) (*model.ChannelMember, *model.AppError)
+	GetMembersWithExpiredRoles(expiredBefore int64, limit int) ([]*model.ChannelMember, *model.AppError)
 	InvalidateMemberCount(channelId string)
 	GetMemberCountFromCache(channelId string) int64
 	GetMemberCount(channelId string, allowFromCache bool) (int64, *model.AppError)
@@ -195,10 +262,13 @@ type ChannelStore interface {
 	UpdateLastViewedAt(channelIds []string, userId string) (map[string]int64, *model.AppError)
 	UpdateLastViewedAtPost(unreadPost *model.Post, userID string, mentionCount int) (*model.ChannelUnreadAt, *model.AppError)
 	CountPostsAfter(channelId string, timestamp int64, userId string) (int, *model.AppError)
-	IncrementMentionCount(channelId string, userId string) *model.AppError
+	IncrementMentionCount(channelId string, userId string, isUrgent bool) *model.AppError
 	AnalyticsTypeCount(teamId string, channelType string) (int64, *model.AppError)
 	GetMembersForUser(teamId string, userId string) (*model.ChannelMembers, *model.AppError)
 	GetMembersForUserWithPagination(teamId, userId string, page, perPage int) (*model.ChannelMembers, *model.AppError)
+	// GetMembersForUserWithCursor returns the memberships of the user in the team past the cursor, in
+	// the order of the cursor, along with the cursor of the next page.
+	GetMembersForUserWithCursor(teamId, userId string, cursor model.ChannelMembersCursor, limit int) (*model.ChannelMembersPage, model.ChannelMembersCursor, *model.AppError)
 	AutocompleteInTeam(teamId string, term string, includeDeleted bool) (*model.ChannelList, *model.AppError)
 	AutocompleteInTeamForSearch(teamId string, userId string, term string, includeDeleted bool) (*model.ChannelList, *model.AppError)
 	SearchAllChannels(term string, opts ChannelSearchOpts) (*model.ChannelListWithTeamData, int64, *model.AppError)
@@ -213,6 +283,13 @@ type ChannelStore interface {
 	ClearCaches()
 	GetChannelsByScheme(schemeId string, offset int, limit int) (model.ChannelList, *model.AppError)
 	MigrateChannelMembers(fromChannelId string, fromUserId string) (map[string]string, *model.AppError)
+	// MigrateTotalMsgCountRoot counts the root messages of a batch of channels after the given
+	// channel, returning the key of the last channel counted, or nil once there are none left.
+	MigrateTotalMsgCountRoot(fromChannelId string) (map[string]string, error)
+	// MigrateMsgCountRoot counts the root messages read by a batch of channel members after the
+	// given key, returning the key of the last channel member counted, or nil once there are none
+	// left.
+	MigrateMsgCountRoot(fromChannelId string, fromUserId string) (map[string]string, error)
 	ResetAllChannelSchemes() *model.AppError
 	ClearAllCustomRoleAssignments() *model.AppError
 	MigratePublicChannels() error
@@ -238,6 +315,10 @@ type ChannelStore interface {
 	// non-admin members.
 	UpdateMembersRole(channelID string, userIDs []string) *model.AppError
 
+	// UpdateMembersRoleAndGetUpdated is UpdateMembersRole, also returning the channel members whose SchemeAdmin
+	// field value changed.
+	UpdateMembersRoleAndGetUpdated(channelID string, userIDs []string) ([]*model.ChannelMember, *model.AppError)
+
 	// GroupSyncedChannelCount returns the count of non-deleted group-constrained channels.
 	GroupSyncedChannelCount() (int64, *model.AppError)
 }
@@ -249,13 +330,27 @@ type ChannelMemberHistoryStore interface {
 	PermanentDeleteBatch(endTime int64, limit int64) (int64, error)
 }
 
+type TeamMemberHistoryStore interface {
+	GetUsersInTeamDuring(startTime int64, endTime int64, teamId string) ([]*model.TeamMemberHistoryResult, error)
+	PermanentDeleteBatch(endTime int64, limit int64) (int64, error)
+}
+
 type PostStore interface {
 	SaveMultiple(posts []*model.Post) ([]*model.Post, int, *model.AppError)
 	Save(post *model.Post) (*model.Post, *model.AppError)
+	// SaveWithOutboxEvent saves the post and records the given websocket event in the outbox within
+	// the same transaction, with the saved post added to the event data as "post".
+	SaveWithOutboxEvent(post *model.Post, message *model.WebSocketEvent) (*model.Post, *model.OutboxEvent, *model.AppError)
 	Update(newPost *model.Post, oldPost *model.Post) (*model.Post, *model.AppError)
+	// UpdateWithOutboxEvent is Update recording the given websocket event in the outbox, as
+	// SaveWithOutboxEvent does.
+	UpdateWithOutboxEvent(newPost *model.Post, oldPost *model.Post, message *model.WebSocketEvent) (*model.Post, *model.OutboxEvent, *model.AppError)
 	Get(id string, skipFetchThreads bool) (*model.PostList, *model.AppError)
 	GetSingle(id string) (*model.Post, *model.AppError)
 	Delete(postId string, time int64, deleteByID string) *model.AppError
+	// DeleteWithOutboxEvent is Delete recording the given websocket event in the outbox, as
+	// SaveWithOutboxEvent does.
+	DeleteWithOutboxEvent(postId string, time int64, deleteByID string, message *model.WebSocketEvent) (*model.OutboxEvent, *model.AppError)
 	PermanentDeleteByUser(userId string) *model.AppError
 	PermanentDeleteByChannel(channelId string) *model.AppError
 	GetPosts(options model.GetPostsOptions, allowFromCache bool) (*model.PostList, *model.AppError)
@@ -266,6 +361,9 @@ type PostStore interface {
 	GetPostsBefore(options model.GetPostsOptions) (*model.PostList, *model.AppError)
 	GetPostsAfter(options model.GetPostsOptions) (*model.PostList, *model.AppError)
 	GetPostsSince(options model.GetPostsSinceOptions, allowFromCache bool) (*model.PostList, *model.AppError)
+	// GetPostsDelta splits up to limit posts of the channel updated past the cursor into new, edited, deleted
+	// and otherwise updated posts, and returns the cursor to continue from.
+	GetPostsDelta(cursor model.PostsDeltaCursor, limit int) (*model.PostsDelta, model.PostsDeltaCursor, *model.AppError)
 	GetPostAfterTime(channelId string, time int64) (*model.Post, *model.AppError)
 	GetPostIdAfterTime(channelId string, time int64) (string, *model.AppError)
 	GetPostIdBeforeTime(channelId string, time int64) (string, *model.AppError)
@@ -273,7 +371,8 @@ type PostStore interface {
 	Search(teamId string, userId string, params *model.SearchParams) (*model.PostList, *model.AppError)
 	AnalyticsUserCountsWithPostsByDay(teamId string) (model.AnalyticsRows, *model.AppError)
 	AnalyticsPostCountsByDay(options *model.AnalyticsPostCountsOptions) (model.AnalyticsRows, *model.AppError)
-	AnalyticsPostCount(teamId string, mustHaveFile bool, mustHaveHashtag bool) (int64, *model.AppError)
+	// AnalyticsPostCount counts the posts, from the table statistics when estimate is set and no filter is given.
+	AnalyticsPostCount(teamId string, mustHaveFile bool, mustHaveHashtag bool, estimate bool) (int64, *model.AppError)
 	ClearCaches()
 	InvalidateLastPostTimeCache(channelId string)
 	GetPostsCreatedAt(channelId string, time int64) ([]*model.Post, *model.AppError)
@@ -287,6 +386,9 @@ type PostStore interface {
 	GetParentsForExportAfter(limit int, afterId string) ([]*model.PostForExport, *model.AppError)
 	GetRepliesForExport(parentId string) ([]*model.ReplyForExport, *model.AppError)
 	GetDirectPostParentsForExportAfter(limit int, afterId string) ([]*model.DirectPostForExport, *model.AppError)
+	// GetPostsForChannelSnapshot returns up to limit undeleted posts of the channel past the cursor, in
+	// (CreateAt, Id) order, so that threads are walked root first.
+	GetPostsForChannelSnapshot(channelId string, cursor model.ChannelSnapshotCursor, limit int) ([]*model.Post, *model.AppError)
 	SearchPostsInTeamForUser(paramsList []*model.SearchParams, userId, teamId string, isOrSearch, includeDeletedChannels bool, page, perPage int) (*model.PostSearchResults, *model.AppError)
 	GetOldestEntityCreationTime() (int64, *model.AppError)
 }
@@ -301,6 +403,7 @@ type UserStore interface {
 	UpdateAuthData(userId string, service string, authData *string, email string, resetMfa bool) (string, *model.AppError)
 	UpdateMfaSecret(userId, secret string) *model.AppError
 	UpdateMfaActive(userId string, active bool) *model.AppError
+	UpdateLastLogin(userId string, lastLogin int64) *model.AppError
 	Get(id string) (*model.User, *model.AppError)
 	GetAll() ([]*model.User, *model.AppError)
 	ClearCaches()
@@ -342,6 +445,7 @@ type UserStore interface {
 	SearchWithoutTeam(term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError)
 	SearchInGroup(groupID string, term string, options *model.UserSearchOptions) ([]*model.User, *model.AppError)
 	AnalyticsGetInactiveUsersCount() (int64, *model.AppError)
+	AnalyticsSeatCountsByDay(startTime, endTime int64, excludeServiceAccounts bool) ([]*model.SeatUsagePoint, *model.AppError)
 	AnalyticsGetSystemAdminCount() (int64, *model.AppError)
 	AnalyticsGetGuestCount() (int64, *model.AppError)
 	GetProfilesNotInTeam(teamId string, groupConstrained bool, offset int, limit int, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, *model.AppError)
@@ -358,6 +462,10 @@ type UserStore interface {
 	DeactivateGuests() ([]string, *model.AppError)
 	AutocompleteUsersInChannel(teamId, channelId, term string, options *model.UserSearchOptions) (*model.UserAutocompleteInChannel, *model.AppError)
 	GetKnownUsers(userID string) ([]string, *model.AppError)
+	GetDirectReports(managerId string, offset, limit int) ([]*model.User, *model.AppError)
+	GetReportingChain(userId string) ([]*model.User, *model.AppError)
+	GetServiceAccounts(offset, limit int, includeDeleted bool) ([]*model.User, *model.AppError)
+	GetUsersWithExpiredRoles(expiredBefore int64, limit int) ([]*model.User, *model.AppError)
 }
 
 type BotStore interface {
@@ -383,7 +491,8 @@ type SessionStore interface {
 	UpdateRoles(userId string, roles string) (string, error)
 	UpdateDeviceId(id string, deviceId string, expiresAt int64) (string, error)
 	UpdateProps(session *model.Session) error
-	AnalyticsSessionCount() (int64, error)
+	// AnalyticsSessionCount counts the active sessions, or estimates the number of sessions from the table statistics.
+	AnalyticsSessionCount(estimate bool) (int64, error)
 	Cleanup(expiryTime int64, batchSize int64)
 }
 
@@ -441,6 +550,7 @@ type SystemStore interface {
 	GetByName(name string) (*model.System, *model.AppError)
 	PermanentDeleteByName(name string) (*model.System, *model.AppError)
 	InsertIfExists(system *model.System) (*model.System, *model.AppError)
+	IsDatabaseReadOnly() (bool, *model.AppError)
 }
 
 type WebhookStore interface {
@@ -504,6 +614,10 @@ type PreferenceStore interface {
 	DeleteCategoryAndName(category string, name string) *model.AppError
 	PermanentDeleteByUser(userId string) *model.AppError
 	CleanupFlagsBatch(limit int64) (int64, *model.AppError)
+	// RenameCategory moves up to limit preferences from oldCategory to newCategory, passing each of them through
+	// transform when one is given. It returns the number of preferences taken out of oldCategory, which is zero
+	// once none are left.
+	RenameCategory(oldCategory string, newCategory string, transform PreferenceTransform, limit int64) (int64, *model.AppError)
 }
 
 type LicenseStore interface {
@@ -524,9 +638,11 @@ type EmojiStore interface {
 	Get(id string, allowFromCache bool) (*model.Emoji, error)
 	GetByName(name string, allowFromCache bool) (*model.Emoji, error)
 	GetMultipleByName(names []string) ([]*model.Emoji, error)
-	GetList(offset, limit int, sort string) ([]*model.Emoji, error)
+	// GetList and Search only return the emoji available to everyone and those of the given teams, unless teamIds
+	// is nil.
+	GetList(offset, limit int, sort string, teamIds []string) ([]*model.Emoji, error)
 	Delete(emoji *model.Emoji, time int64) error
-	Search(name string, prefixOnly bool, limit int) ([]*model.Emoji, error)
+	Search(name string, prefixOnly bool, limit int, teamIds []string) ([]*model.Emoji, error)
 }
 
 type StatusStore interface {
@@ -536,6 +652,7 @@ type StatusStore interface {
 	ResetAll() *model.AppError
 	GetTotalActiveUsersCount() (int64, *model.AppError)
 	UpdateLastActivityAt(userId string, lastActivityAt int64) *model.AppError
+	UpdateLastActivityAts(lastActivityAts map[string]int64) *model.AppError
 }
 
 type FileInfoStore interface {
@@ -547,6 +664,8 @@ type FileInfoStore interface {
 	GetWithOptions(page, perPage int, opt *model.GetFileInfosOptions) ([]*model.FileInfo, *model.AppError)
 	InvalidateFileInfosForPostCache(postId string, deleted bool)
 	AttachToPost(fileId string, postId string, creatorId string) *model.AppError
+	SetSensitive(fileId string, sensitive bool) *model.AppError
+	UpdateEnrichment(info *model.FileInfo) *model.AppError
 	DeleteForPost(postId string) (string, *model.AppError)
 	PermanentDelete(fileId string) *model.AppError
 	PermanentDeleteBatch(endTime int64, limit int64) (int64, *model.AppError)
@@ -647,6 +766,71 @@ type UserTermsOfServiceStore interface {
 	Delete(userId, termsOfServiceId string) error
 }
 
+type TermsOfServicePolicyStore interface {
+	Save(policy *model.TermsOfServicePolicy) (*model.TermsOfServicePolicy, error)
+	Update(policy *model.TermsOfServicePolicy) (*model.TermsOfServicePolicy, error)
+	Get(id string) (*model.TermsOfServicePolicy, error)
+	GetAll(offset, limit int) ([]*model.TermsOfServicePolicy, error)
+	Delete(id string, deleteAt int64) error
+
+	// SaveVersion publishes a new version of a policy, numbered one past its latest version.
+	SaveVersion(version *model.TermsOfServicePolicyVersion) (*model.TermsOfServicePolicyVersion, error)
+	GetLatestVersion(policyId string) (*model.TermsOfServicePolicyVersion, error)
+	SaveAcceptance(acceptance *model.TermsOfServiceAcceptance) error
+
+	// GetPendingForUser returns the latest versions of the policies targeting the user, through one of
+	// their teams or groups, that the user hasn't accepted yet.
+	GetPendingForUser(userId string) ([]*model.TermsOfServicePolicyVersion, error)
+
+	// GetOutstandingUsers returns the active users targeted by the policy of the given version that
+	// haven't accepted it, ordered by username.
+	GetOutstandingUsers(version *model.TermsOfServicePolicyVersion, offset, limit int) ([]*model.User, error)
+
+	// GetAcceptanceCounts returns how many active users are targeted by the policy of the given version
+	// and how many of them have accepted it.
+	GetAcceptanceCounts(version *model.TermsOfServicePolicyVersion) (int64, int64, error)
+}
+
+type UserPropertyStore interface {
+	SaveField(field *model.UserPropertyField) (*model.UserPropertyField, error)
+	UpdateField(field *model.UserPropertyField) (*model.UserPropertyField, error)
+	GetField(id string) (*model.UserPropertyField, error)
+	GetFields() ([]*model.UserPropertyField, error)
+	DeleteField(id string, deleteAt int64) error
+
+	// GetValuesForUser returns the values of the user for the fields that haven't been deleted.
+	GetValuesForUser(userId string) ([]*model.UserPropertyValue, error)
+
+	// SaveValuesForUser stores the given values for the user, removing the ones that are empty.
+	SaveValuesForUser(userId string, values []*model.UserPropertyValue) error
+	PermanentDeleteByUser(userId string) error
+}
+
+type LoginHistoryStore interface {
+	Save(entry *model.LoginHistory) (*model.LoginHistory, error)
+	Get(options *model.LoginHistoryGetOptions) ([]*model.LoginHistory, error)
+
+	// PermanentDeleteBatch deletes up to limit entries recorded before endTime.
+	PermanentDeleteBatch(endTime int64, limit int64) (int64, error)
+	PermanentDeleteByUser(userId string) error
+}
+
+type EmailVerificationStore interface {
+	Save(verification *model.EmailVerification) (*model.EmailVerification, error)
+	Get(userId string) (*model.EmailVerification, error)
+	Update(verification *model.EmailVerification) (*model.EmailVerification, error)
+	Delete(userId string) error
+
+	// GetForReminder returns up to limit unlocked entries with a deadline at or before
+	// remindBefore whose users haven't been reminded yet.
+	GetForReminder(remindBefore int64, limit int) ([]*model.EmailVerification, error)
+	// GetOverdue returns up to limit unlocked entries with a deadline at or before now.
+	GetOverdue(now int64, limit int) ([]*model.EmailVerification, error)
+	// GetUnverifiedUsers returns the active users signed up by email who haven't verified their
+	// email address, along with their deadline if they have one.
+	GetUnverifiedUsers(offset, limit int) ([]*model.UnverifiedUser, error)
+}
+
 type GroupStore interface {
 	Create(group *model.Group) (*model.Group, *model.AppError)
 	Get(groupID string) (*model.Group, *model.AppError)
@@ -739,6 +923,202 @@ type LinkMetadataStore interface {
 	Get(url string, timestamp int64) (*model.LinkMetadata, error)
 }
 
+type ChannelBookmarkStore interface {
+	Save(bookmark *model.ChannelBookmark) (*model.ChannelBookmark, error)
+	Update(bookmark *model.ChannelBookmark) (*model.ChannelBookmark, error)
+	Get(id string, includeDeleted bool) (*model.ChannelBookmark, error)
+	GetBookmarksForChannel(channelId string, includeDeleted bool) ([]*model.ChannelBookmark, error)
+	CountForChannel(channelId string) (int64, error)
+	Delete(id string, deleteAt int64) error
+	PermanentDeleteByChannel(channelId string) error
+}
+
+// EventStreamStore is the outbox of the events to publish to the event stream, from which they are
+// removed once delivered.
+type EventStreamStore interface {
+	Save(event *model.EventStreamEvent) (*model.EventStreamEvent, error)
+	// GetPending returns up to limit events whose next delivery attempt is due at now, oldest first.
+	GetPending(now int64, limit int) ([]*model.EventStreamEvent, error)
+	// RecordFailedAttempt counts a failed delivery of the given events, and defers their next one
+	// until nextAttemptAt.
+	RecordFailedAttempt(ids []string, nextAttemptAt int64) error
+	Delete(ids []string) error
+	Count() (int64, error)
+}
+
+// OutboxStore holds the websocket events recorded along with the changes they announce, until they
+// are published.
+type OutboxStore interface {
+	Save(event *model.OutboxEvent) (*model.OutboxEvent, error)
+	// GetUndelivered returns up to limit events created before createdBefore and not delivered yet,
+	// oldest first.
+	GetUndelivered(createdBefore int64, limit int) ([]*model.OutboxEvent, error)
+	MarkDelivered(ids []string, deliveredAt int64) error
+	// PermanentDeleteBatch removes up to limit events delivered before endTime.
+	PermanentDeleteBatch(endTime int64, limit int64) (int64, error)
+}
+
+// ChannelChangeStore reads the change log recorded by the post mutations of the PostStore, which
+// gives every mutation the next sequence number of the channel of the post.
+type ChannelChangeStore interface {
+	// GetSince returns up to limit changes of the channel with a sequence number greater than
+	// sinceSeq, in sequence order.
+	GetSince(channelId string, sinceSeq int64, limit int) ([]*model.ChannelChange, error)
+	// GetLastSeq returns the sequence number of the latest change of the channel, or 0 if it has none.
+	GetLastSeq(channelId string) (int64, error)
+	PermanentDeleteByChannel(channelId string) error
+}
+
+type PendingPinStore interface {
+	Save(pendingPin *model.PendingPin) (*model.PendingPin, error)
+	Get(postId string) (*model.PendingPin, error)
+	GetForChannel(channelId string) ([]*model.PendingPin, error)
+	Delete(postId string) error
+	PermanentDeleteByChannel(channelId string) error
+}
+
+type ChannelIntegrationStore interface {
+	Save(integration *model.ChannelIntegration) (*model.ChannelIntegration, error)
+	Get(channelId string, integrationId string) (*model.ChannelIntegration, error)
+	GetForChannel(channelId string) ([]*model.ChannelIntegration, error)
+	Delete(channelId string, integrationId string) error
+	PermanentDeleteByChannel(channelId string) error
+	PermanentDeleteByIntegration(integrationId string) error
+}
+
+// UserRelationshipStore keeps the users each user muted or blocked.
+type UserRelationshipStore interface {
+	Save(relationship *model.UserRelationship) (*model.UserRelationship, error)
+	Get(userId string, otherUserId string, relationshipType string) (*model.UserRelationship, error)
+	GetForUser(userId string) ([]*model.UserRelationship, error)
+	GetUserIdsRelatedTo(otherUserId string) ([]string, error)
+	Delete(userId string, otherUserId string, relationshipType string) error
+	PermanentDeleteByUser(userId string) error
+}
+
+type PostAcknowledgementStore interface {
+	Save(acknowledgement *model.PostAcknowledgement) (*model.PostAcknowledgement, error)
+	GetForPost(postId string) ([]*model.PostAcknowledgement, error)
+	Delete(postId string, userId string) error
+	PermanentDeleteByChannel(channelId string) error
+	PermanentDeleteByUser(userId string) error
+}
+
+type StatusAutomationStore interface {
+	Save(automation *model.StatusAutomation) (*model.StatusAutomation, error)
+	Update(automation *model.StatusAutomation) (*model.StatusAutomation, error)
+	Get(userId string) (*model.StatusAutomation, error)
+	GetEndedBefore(endTime int64, limit int) ([]*model.StatusAutomation, error)
+	Delete(userId string) error
+}
+
+type PostIntegrityStore interface {
+	Append(post *model.Post) (*model.PostIntegrityEntry, error)
+	GetForChannel(channelId string, afterSeq int64, limit int) ([]*model.PostIntegrityEntry, error)
+	GetChannelIds(afterChannelId string, limit int) ([]string, error)
+}
+
+// IntegrationUsageStore keeps daily counters of the activity of the integrations.
+type IntegrationUsageStore interface {
+	Increment(usage *model.IntegrationUsage) error
+	GetForPeriod(startDay, endDay int64) ([]*model.IntegrationUsage, error)
+	PermanentDeleteBatch(endDay int64, limit int64) (int64, error)
+}
+
+type PresenceWebhookStore interface {
+	Save(hook *model.PresenceWebhook) (*model.PresenceWebhook, error)
+	Update(hook *model.PresenceWebhook) (*model.PresenceWebhook, error)
+	Get(id string) (*model.PresenceWebhook, error)
+	GetAll(offset, limit int) ([]*model.PresenceWebhook, error)
+	Delete(id string, deleteAt int64) error
+}
+
+type SlugHistoryStore interface {
+	Save(entry *model.SlugHistory) (*model.SlugHistory, error)
+	Get(kind, scopeId, name string) (*model.SlugHistory, error)
+	Delete(kind, scopeId, name string) error
+	PermanentDeleteByTarget(targetId string) error
+}
+
+type TeamInviteLinkStore interface {
+	Save(link *model.TeamInviteLink) (*model.TeamInviteLink, error)
+	Get(id string) (*model.TeamInviteLink, error)
+	GetByName(name string) (*model.TeamInviteLink, error)
+	GetForTeam(teamId string) ([]*model.TeamInviteLink, error)
+	CountForTeam(teamId string) (int64, error)
+	IncrementUseCount(id string, now int64) error
+	Revoke(id string, deleteAt int64) error
+	PermanentDeleteByTeam(teamId string) error
+}
+
+type ChannelGuestLinkStore interface {
+	Save(link *model.ChannelGuestLink) (*model.ChannelGuestLink, error)
+	Get(id string) (*model.ChannelGuestLink, error)
+	GetByToken(token string) (*model.ChannelGuestLink, error)
+	GetForChannel(channelId string) ([]*model.ChannelGuestLink, error)
+	CountForChannel(channelId string) (int64, error)
+	IncrementUseCount(id string, now int64) error
+	Revoke(id string, deleteAt int64) error
+	PermanentDeleteByChannel(channelId string) error
+}
+
+type RetentionPolicyStore interface {
+	Save(policy *model.RetentionPolicy) (*model.RetentionPolicy, error)
+	Update(policy *model.RetentionPolicy) (*model.RetentionPolicy, error)
+	Get(id string) (*model.RetentionPolicy, error)
+	GetAll(offset, limit int) ([]*model.RetentionPolicy, error)
+	GetForChannel(channelId string) (*model.RetentionPolicy, error)
+	Delete(id string) error
+
+	// PermanentDeletePostsBatch deletes up to limit posts created before endTime in the channels governed
+	// by the given policy, walking them in (CreateAt, Id) order from the cursor. An empty policyId targets
+	// the channels that aren't covered by any policy. It returns the number of deleted posts and the cursor
+	// to continue from.
+	PermanentDeletePostsBatch(policyId string, endTime int64, cursor model.RetentionPolicyCursor, limit int64) (int64, model.RetentionPolicyCursor, error)
+
+	// PermanentDeleteFilesBatch deletes up to limit file infos created before endTime that belong to posts
+	// in the channels governed by the given policy, walking them in (CreateAt, Id) order from the cursor.
+	// An empty policyId targets the channels that aren't covered by any policy, as well as files that were
+	// never attached to a post. It returns the number of deleted files and the cursor to continue from.
+	PermanentDeleteFilesBatch(policyId string, endTime int64, cursor model.RetentionPolicyCursor, limit int64) (int64, model.RetentionPolicyCursor, error)
+
+	// PermanentDeleteAllFilesBatch deletes up to limit files created before endTime regardless of the policy
+	// governing them, walking them in (CreateAt, Id) order from the cursor.
+	PermanentDeleteAllFilesBatch(endTime int64, cursor model.RetentionPolicyCursor, limit int64) (int64, model.RetentionPolicyCursor, error)
+}
+
+type PostArchiveStore interface {
+	// ArchiveBatch moves up to limit posts created before endTime from the Posts table into the archive,
+	// oldest first. Threads are only archived once none of their posts are newer than endTime, so that a
+	// thread is never split between both tables. It returns the number of archived posts.
+	ArchiveBatch(endTime int64, limit int64) (int64, error)
+	Get(id string) (*model.Post, error)
+	// GetThread returns the archived post along with the rest of its thread.
+	GetThread(id string) (*model.PostList, error)
+	// Search returns up to limit archived posts from the given channels whose message contains every term.
+	Search(channelIds []string, terms []string, limit int) ([]*model.Post, error)
+}
+
+// PostsPartitionStore manages the native partitioning of the Posts table by CreateAt month. It is only
+// supported by Postgres; the other methods return an ErrInvalidInput on any other database.
+type PostsPartitionStore interface {
+	IsPartitioned() (bool, error)
+	GetPartitions() ([]*model.PostsPartition, error)
+	// EnsurePartitions creates the missing monthly partitions up to and including the month of until.
+	EnsurePartitions(until int64) error
+	// DropPartitionsBefore drops the partitions ending at or before endTime, and returns their names.
+	DropPartitionsBefore(endTime int64) ([]string, error)
+
+	// StartConversion creates the partitioned copy of the Posts table and starts mirroring writes into it.
+	// It is safe to call again to resume an interrupted conversion.
+	StartConversion() error
+	// CopyBatch copies up to limit posts past the cursor into the partitioned copy. It returns the number
+	// of copied posts and the cursor to continue from.
+	CopyBatch(cursor model.PostsPartitionCursor, limit int64) (int64, model.PostsPartitionCursor, error)
+	// FinishConversion replaces the Posts table with its partitioned copy.
+	FinishConversion() error
+}
+
 // ChannelSearchOpts contains options for searching channels.
 //
 // NotAssociatedToGroup will exclude channels that have associated, active GroupChannels records.
@@ -748,6 +1128,10 @@ type LinkMetadataStore interface {
 // Page page requested, if results are paginated.
 // PerPage number of results per page, if paginated.
 //
+// PreferenceTransform updates a preference being moved to another category, for instance to rename it or to
+// convert its value. The preference is dropped instead of being moved when it returns false.
+type PreferenceTransform func(preference *model.Preference) bool
+
 type ChannelSearchOpts struct {
 	NotAssociatedToGroup string
 	IncludeDeleted       bool
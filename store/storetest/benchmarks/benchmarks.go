@@ -0,0 +1,44 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Package benchmarks measures the hot methods of the store against a real database, so that
+// performance regressions in store rewrites show up in the reports of two runs.
+package benchmarks
+
+import (
+	"testing"
+
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+// Benchmark is a single store benchmark. Setup saves the data the benchmark runs against, once,
+// and returns the timed body.
+type Benchmark struct {
+	Name  string
+	Setup func(tb testing.TB, ss store.Store) func(b *testing.B)
+}
+
+// All returns the store benchmarks, in the order they run.
+func All() []Benchmark {
+	return []Benchmark{
+		{Name: "ChannelStore.GetMember", Setup: setupChannelGetMember},
+		{Name: "StatusStore.GetByIds", Setup: setupStatusGetByIds},
+		{Name: "PreferenceStore.GetAll", Setup: setupPreferenceGetAll},
+		{Name: "JobStore.Claim", Setup: setupJobClaim},
+	}
+}
+
+// Run runs every benchmark against the given store, and returns their results for the driver.
+func Run(t *testing.T, driver string, ss store.Store) []*Result {
+	var results []*Result
+	for _, benchmark := range All() {
+		benchmark := benchmark
+		t.Run(benchmark.Name, func(t *testing.T) {
+			body := benchmark.Setup(t, ss)
+			result := NewResult(benchmark.Name, driver, testing.Benchmark(body))
+			t.Logf("%s\t%d\t%d ns/op\t%d B/op\t%d allocs/op", driver, result.Iterations, result.NsPerOp, result.BytesPerOp, result.AllocsPerOp)
+			results = append(results, result)
+		})
+	}
+	return results
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package benchmarks
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+	"testing"
+)
+
+// Result is the measure of a benchmark against one database driver.
+type Result struct {
+	Benchmark   string `json:"benchmark"`
+	Driver      string `json:"driver"`
+	Iterations  int    `json:"iterations"`
+	NsPerOp     int64  `json:"ns_per_op"`
+	BytesPerOp  int64  `json:"bytes_per_op"`
+	AllocsPerOp int64  `json:"allocs_per_op"`
+}
+
+func NewResult(benchmark, driver string, result testing.BenchmarkResult) *Result {
+	return &Result{
+		Benchmark:   benchmark,
+		Driver:      driver,
+		Iterations:  result.N,
+		NsPerOp:     result.NsPerOp(),
+		BytesPerOp:  result.AllocedBytesPerOp(),
+		AllocsPerOp: result.AllocsPerOp(),
+	}
+}
+
+func (r *Result) key() string {
+	return r.Driver + "/" + r.Benchmark
+}
+
+// Report holds the results of a run of the store benchmarks.
+type Report struct {
+	CreateAt int64     `json:"create_at"`
+	Results  []*Result `json:"results"`
+}
+
+func (r *Report) ToJson() string {
+	b, _ := json.MarshalIndent(r, "", "  ")
+	return string(b)
+}
+
+func ReportFromJson(data io.Reader) (*Report, error) {
+	var r *Report
+	if err := json.NewDecoder(data).Decode(&r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Regression is a benchmark that got slower than its baseline by more than the allowed threshold.
+type Regression struct {
+	Benchmark       string  `json:"benchmark"`
+	Driver          string  `json:"driver"`
+	BaselineNsPerOp int64   `json:"baseline_ns_per_op"`
+	NsPerOp         int64   `json:"ns_per_op"`
+	Change          float64 `json:"change"`
+}
+
+// Compare returns the results of the report slower than those of the baseline by more than
+// threshold, as a ratio: 0.2 allows benchmarks to get up to 20% slower. Benchmarks missing from
+// either report are ignored.
+func (r *Report) Compare(baseline *Report, threshold float64) []*Regression {
+	baselineResults := make(map[string]*Result, len(baseline.Results))
+	for _, result := range baseline.Results {
+		baselineResults[result.key()] = result
+	}
+
+	regressions := []*Regression{}
+	for _, result := range r.Results {
+		previous, ok := baselineResults[result.key()]
+		if !ok || previous.NsPerOp <= 0 {
+			continue
+		}
+
+		change := float64(result.NsPerOp-previous.NsPerOp) / float64(previous.NsPerOp)
+		if change > threshold {
+			regressions = append(regressions, &Regression{
+				Benchmark:       result.Benchmark,
+				Driver:          result.Driver,
+				BaselineNsPerOp: previous.NsPerOp,
+				NsPerOp:         result.NsPerOp,
+				Change:          change,
+			})
+		}
+	}
+
+	sort.Slice(regressions, func(i, j int) bool { return regressions[i].Change > regressions[j].Change })
+	return regressions
+}
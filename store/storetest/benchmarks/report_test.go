@@ -0,0 +1,62 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package benchmarks
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportJson(t *testing.T) {
+	report := &Report{
+		CreateAt: 1600000000000,
+		Results: []*Result{
+			{Benchmark: "StatusStore.GetByIds", Driver: "postgres", Iterations: 1000, NsPerOp: 250000, BytesPerOp: 4096, AllocsPerOp: 80},
+		},
+	}
+
+	result, err := ReportFromJson(strings.NewReader(report.ToJson()))
+	require.NoError(t, err)
+	assert.Equal(t, report, result)
+
+	_, err = ReportFromJson(strings.NewReader("{"))
+	require.Error(t, err)
+}
+
+func TestReportCompare(t *testing.T) {
+	baseline := &Report{
+		Results: []*Result{
+			{Benchmark: "ChannelStore.GetMember", Driver: "mysql", NsPerOp: 1000},
+			{Benchmark: "ChannelStore.GetMember", Driver: "postgres", NsPerOp: 1000},
+			{Benchmark: "JobStore.Claim", Driver: "postgres", NsPerOp: 1000},
+			{Benchmark: "PreferenceStore.GetAll", Driver: "postgres", NsPerOp: 1000},
+		},
+	}
+
+	report := &Report{
+		Results: []*Result{
+			{Benchmark: "ChannelStore.GetMember", Driver: "mysql", NsPerOp: 1150},
+			{Benchmark: "ChannelStore.GetMember", Driver: "postgres", NsPerOp: 1300},
+			{Benchmark: "JobStore.Claim", Driver: "postgres", NsPerOp: 2000},
+			{Benchmark: "PreferenceStore.GetAll", Driver: "postgres", NsPerOp: 500},
+			{Benchmark: "StatusStore.GetByIds", Driver: "postgres", NsPerOp: 9000},
+		},
+	}
+
+	regressions := report.Compare(baseline, 0.2)
+	require.Len(t, regressions, 2)
+
+	assert.Equal(t, "JobStore.Claim", regressions[0].Benchmark)
+	assert.InDelta(t, 1.0, regressions[0].Change, 0.0001)
+
+	assert.Equal(t, "ChannelStore.GetMember", regressions[1].Benchmark)
+	assert.Equal(t, "postgres", regressions[1].Driver)
+	assert.Equal(t, int64(1000), regressions[1].BaselineNsPerOp)
+	assert.Equal(t, int64(1300), regressions[1].NsPerOp)
+
+	assert.Empty(t, report.Compare(baseline, 1.5))
+}
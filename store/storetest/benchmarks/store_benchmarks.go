@@ -0,0 +1,139 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package benchmarks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+const (
+	benchmarkChannelMembers = 500
+	benchmarkStatuses       = 1000
+	benchmarkStatusesPerGet = 100
+	benchmarkUsers          = 50
+	benchmarkPreferences    = 100
+)
+
+func setupChannelGetMember(tb testing.TB, ss store.Store) func(b *testing.B) {
+	channel, err := ss.Channel().Save(&model.Channel{
+		TeamId:      model.NewId(),
+		DisplayName: "Benchmark",
+		Name:        "benchmark-" + model.NewId(),
+		Type:        model.CHANNEL_OPEN,
+	}, -1)
+	require.Nil(tb, err)
+
+	userIds := make([]string, benchmarkChannelMembers)
+	members := make([]*model.ChannelMember, benchmarkChannelMembers)
+	for i := range members {
+		userIds[i] = model.NewId()
+		members[i] = &model.ChannelMember{
+			ChannelId:   channel.Id,
+			UserId:      userIds[i],
+			NotifyProps: model.GetDefaultChannelNotifyProps(),
+			SchemeUser:  true,
+		}
+	}
+	_, appErr := ss.Channel().SaveMultipleMembers(members)
+	require.Nil(tb, appErr)
+
+	return func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := ss.Channel().GetMember(channel.Id, userIds[i%len(userIds)]); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func setupStatusGetByIds(tb testing.TB, ss store.Store) func(b *testing.B) {
+	userIds := make([]string, benchmarkStatuses)
+	for i := range userIds {
+		userIds[i] = model.NewId()
+		require.Nil(tb, ss.Status().SaveOrUpdate(&model.Status{
+			UserId:         userIds[i],
+			Status:         model.STATUS_ONLINE,
+			LastActivityAt: model.GetMillis(),
+		}))
+	}
+
+	return func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			start := (i * benchmarkStatusesPerGet) % (len(userIds) - benchmarkStatusesPerGet)
+			statuses, err := ss.Status().GetByIds(userIds[start : start+benchmarkStatusesPerGet])
+			if err != nil {
+				b.Fatal(err)
+			}
+			if len(statuses) != benchmarkStatusesPerGet {
+				b.Fatalf("expected %d statuses, got %d", benchmarkStatusesPerGet, len(statuses))
+			}
+		}
+	}
+}
+
+func setupPreferenceGetAll(tb testing.TB, ss store.Store) func(b *testing.B) {
+	userIds := make([]string, benchmarkUsers)
+	for i := range userIds {
+		userIds[i] = model.NewId()
+		preferences := make(model.Preferences, benchmarkPreferences)
+		for j := range preferences {
+			preferences[j] = model.Preference{
+				UserId:   userIds[i],
+				Category: model.PREFERENCE_CATEGORY_DIRECT_CHANNEL_SHOW,
+				Name:     model.NewId(),
+				Value:    "true",
+			}
+		}
+		require.Nil(tb, ss.Preference().Save(&preferences))
+	}
+
+	return func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := ss.Preference().GetAll(userIds[i%len(userIds)]); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+// setupJobClaim measures the optimistic status update the job workers claim their jobs with, the
+// job going back and forth between pending and in progress.
+func setupJobClaim(tb testing.TB, ss store.Store) func(b *testing.B) {
+	job, err := ss.Job().Save(&model.Job{
+		Id:     model.NewId(),
+		Type:   model.JOB_TYPE_DATA_RETENTION,
+		Status: model.JOB_STATUS_PENDING,
+	})
+	require.Nil(tb, err)
+
+	return func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			from, to := model.JOB_STATUS_PENDING, model.JOB_STATUS_IN_PROGRESS
+			if i%2 == 1 {
+				from, to = to, from
+			}
+			claimed, err := ss.Job().UpdateStatusOptimistically(job.Id, from, to)
+			if err != nil {
+				b.Fatal(err)
+			}
+			if !claimed {
+				b.Fatalf("unable to move the job from %s to %s", from, to)
+			}
+		}
+
+		// Leave the job pending for the next run of the body.
+		if b.N%2 == 1 {
+			ss.Job().UpdateStatusOptimistically(job.Id, model.JOB_STATUS_IN_PROGRESS, model.JOB_STATUS_PENDING)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+func TestChannelBookmarkStore(t *testing.T, ss store.Store) {
+	t.Run("Save", func(t *testing.T) { testChannelBookmarkStoreSave(t, ss) })
+	t.Run("Update", func(t *testing.T) { testChannelBookmarkStoreUpdate(t, ss) })
+	t.Run("GetBookmarksForChannel", func(t *testing.T) { testChannelBookmarkStoreGetBookmarksForChannel(t, ss) })
+	t.Run("Delete", func(t *testing.T) { testChannelBookmarkStoreDelete(t, ss) })
+	t.Run("PermanentDeleteByChannel", func(t *testing.T) { testChannelBookmarkStorePermanentDeleteByChannel(t, ss) })
+}
+
+func newTestChannelBookmark(channelId string, sortOrder int64) *model.ChannelBookmark {
+	return &model.ChannelBookmark{
+		ChannelId:   channelId,
+		OwnerId:     model.NewId(),
+		DisplayName: "bookmark",
+		SortOrder:   sortOrder,
+		LinkUrl:     "https://mattermost.com",
+		Type:        model.CHANNEL_BOOKMARK_LINK,
+	}
+}
+
+func testChannelBookmarkStoreSave(t *testing.T, ss store.Store) {
+	t.Run("should save bookmark", func(t *testing.T) {
+		bookmark := newTestChannelBookmark(model.NewId(), 0)
+
+		saved, err := ss.ChannelBookmark().Save(bookmark)
+		require.Nil(t, err)
+		assert.NotEmpty(t, saved.Id)
+		assert.NotZero(t, saved.CreateAt)
+
+		fetched, err := ss.ChannelBookmark().Get(saved.Id, false)
+		require.Nil(t, err)
+		assert.Equal(t, saved, fetched)
+	})
+
+	t.Run("should fail to save existing bookmark", func(t *testing.T) {
+		bookmark := newTestChannelBookmark(model.NewId(), 0)
+		bookmark.Id = model.NewId()
+
+		_, err := ss.ChannelBookmark().Save(bookmark)
+		var invErr *store.ErrInvalidInput
+		assert.True(t, errors.As(err, &invErr))
+	})
+
+	t.Run("should fail to save invalid bookmark", func(t *testing.T) {
+		bookmark := newTestChannelBookmark(model.NewId(), 0)
+		bookmark.LinkUrl = ""
+
+		_, err := ss.ChannelBookmark().Save(bookmark)
+		assert.NotNil(t, err)
+	})
+}
+
+func testChannelBookmarkStoreUpdate(t *testing.T, ss store.Store) {
+	bookmark, err := ss.ChannelBookmark().Save(newTestChannelBookmark(model.NewId(), 0))
+	require.Nil(t, err)
+
+	t.Run("should update bookmark", func(t *testing.T) {
+		bookmark.DisplayName = "updated"
+		bookmark.Emoji = "smile"
+
+		_, err := ss.ChannelBookmark().Update(bookmark)
+		require.Nil(t, err)
+
+		fetched, err := ss.ChannelBookmark().Get(bookmark.Id, false)
+		require.Nil(t, err)
+		assert.Equal(t, "updated", fetched.DisplayName)
+		assert.Equal(t, "smile", fetched.Emoji)
+	})
+
+	t.Run("should fail to update missing bookmark", func(t *testing.T) {
+		missing := newTestChannelBookmark(model.NewId(), 0)
+		missing.PreSave()
+
+		_, err := ss.ChannelBookmark().Update(missing)
+		var nfErr *store.ErrNotFound
+		assert.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testChannelBookmarkStoreGetBookmarksForChannel(t *testing.T, ss store.Store) {
+	channelId := model.NewId()
+
+	second, err := ss.ChannelBookmark().Save(newTestChannelBookmark(channelId, 2))
+	require.Nil(t, err)
+	first, err := ss.ChannelBookmark().Save(newTestChannelBookmark(channelId, 1))
+	require.Nil(t, err)
+	deleted, err := ss.ChannelBookmark().Save(newTestChannelBookmark(channelId, 3))
+	require.Nil(t, err)
+	_, err = ss.ChannelBookmark().Save(newTestChannelBookmark(model.NewId(), 0))
+	require.Nil(t, err)
+
+	require.Nil(t, ss.ChannelBookmark().Delete(deleted.Id, model.GetMillis()))
+
+	bookmarks, err := ss.ChannelBookmark().GetBookmarksForChannel(channelId, false)
+	require.Nil(t, err)
+	require.Len(t, bookmarks, 2)
+	assert.Equal(t, first.Id, bookmarks[0].Id)
+	assert.Equal(t, second.Id, bookmarks[1].Id)
+
+	bookmarks, err = ss.ChannelBookmark().GetBookmarksForChannel(channelId, true)
+	require.Nil(t, err)
+	require.Len(t, bookmarks, 3)
+	assert.Equal(t, deleted.Id, bookmarks[2].Id)
+
+	count, err := ss.ChannelBookmark().CountForChannel(channelId)
+	require.Nil(t, err)
+	assert.Equal(t, int64(2), count)
+}
+
+func testChannelBookmarkStoreDelete(t *testing.T, ss store.Store) {
+	bookmark, err := ss.ChannelBookmark().Save(newTestChannelBookmark(model.NewId(), 0))
+	require.Nil(t, err)
+
+	err = ss.ChannelBookmark().Delete(bookmark.Id, model.GetMillis())
+	require.Nil(t, err)
+
+	_, err = ss.ChannelBookmark().Get(bookmark.Id, false)
+	var nfErr *store.ErrNotFound
+	assert.True(t, errors.As(err, &nfErr))
+
+	fetched, err := ss.ChannelBookmark().Get(bookmark.Id, true)
+	require.Nil(t, err)
+	assert.NotZero(t, fetched.DeleteAt)
+
+	err = ss.ChannelBookmark().Delete(bookmark.Id, model.GetMillis())
+	assert.True(t, errors.As(err, &nfErr))
+}
+
+func testChannelBookmarkStorePermanentDeleteByChannel(t *testing.T, ss store.Store) {
+	channelId := model.NewId()
+
+	bookmark, err := ss.ChannelBookmark().Save(newTestChannelBookmark(channelId, 0))
+	require.Nil(t, err)
+	other, err := ss.ChannelBookmark().Save(newTestChannelBookmark(model.NewId(), 0))
+	require.Nil(t, err)
+
+	require.Nil(t, ss.ChannelBookmark().PermanentDeleteByChannel(channelId))
+
+	_, err = ss.ChannelBookmark().Get(bookmark.Id, true)
+	var nfErr *store.ErrNotFound
+	assert.True(t, errors.As(err, &nfErr))
+
+	_, err = ss.ChannelBookmark().Get(other.Id, false)
+	assert.Nil(t, err)
+}
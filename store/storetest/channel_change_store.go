@@ -0,0 +1,170 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+func TestChannelChangeStore(t *testing.T, ss store.Store) {
+	t.Run("PostMutations", func(t *testing.T) { testChannelChangeStorePostMutations(t, ss) })
+	t.Run("GetSince", func(t *testing.T) { testChannelChangeStoreGetSince(t, ss) })
+	t.Run("PermanentDeleteByChannel", func(t *testing.T) { testChannelChangeStorePermanentDeleteByChannel(t, ss) })
+}
+
+func newTestChannelChangePost(channelId string) *model.Post {
+	return &model.Post{
+		ChannelId: channelId,
+		UserId:    model.NewId(),
+		Message:   "zz" + model.NewId() + "b",
+	}
+}
+
+func testChannelChangeStorePostMutations(t *testing.T, ss store.Store) {
+	channelId := model.NewId()
+
+	root, err := ss.Post().Save(newTestChannelChangePost(channelId))
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), root.ChangeSeq)
+
+	reply := newTestChannelChangePost(channelId)
+	reply.RootId = root.Id
+	reply.ParentId = root.Id
+	reply, err = ss.Post().Save(reply)
+	require.Nil(t, err)
+	assert.Equal(t, int64(2), reply.ChangeSeq)
+
+	edited := root.Clone()
+	edited.Message = "edited"
+	edited.EditAt = model.GetMillis()
+	edited, err = ss.Post().Update(edited, root.Clone())
+	require.Nil(t, err)
+	assert.Equal(t, int64(3), edited.ChangeSeq)
+
+	pinned := edited.Clone()
+	pinned.IsPinned = true
+	pinned, err = ss.Post().Update(pinned, edited.Clone())
+	require.Nil(t, err)
+	assert.Equal(t, int64(4), pinned.ChangeSeq)
+
+	overwritten := pinned.Clone()
+	overwritten.AddProp("key", "value")
+	overwritten, err = ss.Post().Overwrite(overwritten)
+	require.Nil(t, err)
+	assert.Equal(t, int64(5), overwritten.ChangeSeq)
+
+	err = ss.Post().Delete(root.Id, model.GetMillis(), "")
+	require.Nil(t, err)
+
+	changes, nErr := ss.ChannelChange().GetSince(channelId, 0, 100)
+	require.Nil(t, nErr)
+
+	type change struct {
+		Seq    int64
+		PostId string
+		Type   string
+	}
+	actual := []change{}
+	for _, c := range changes {
+		assert.Equal(t, channelId, c.ChannelId)
+		assert.NotZero(t, c.CreateAt)
+		actual = append(actual, change{c.Seq, c.PostId, c.Type})
+	}
+	assert.Equal(t, []change{
+		{1, root.Id, model.CHANNEL_CHANGE_POST_CREATED},
+		{2, reply.Id, model.CHANNEL_CHANGE_POST_CREATED},
+		{3, root.Id, model.CHANNEL_CHANGE_POST_EDITED},
+		{4, root.Id, model.CHANNEL_CHANGE_POST_UPDATED},
+		{5, root.Id, model.CHANNEL_CHANGE_POST_UPDATED},
+		{6, root.Id, model.CHANNEL_CHANGE_POST_DELETED},
+		{7, reply.Id, model.CHANNEL_CHANGE_POST_DELETED},
+	}, actual)
+
+	lastSeq, nErr := ss.ChannelChange().GetLastSeq(channelId)
+	require.Nil(t, nErr)
+	assert.Equal(t, int64(7), lastSeq)
+
+	t.Run("should number the changes of each channel separately", func(t *testing.T) {
+		otherChannelId := model.NewId()
+
+		posts, _, err := ss.Post().SaveMultiple([]*model.Post{
+			newTestChannelChangePost(otherChannelId),
+			newTestChannelChangePost(channelId),
+			newTestChannelChangePost(otherChannelId),
+		})
+		require.Nil(t, err)
+		assert.Equal(t, int64(1), posts[0].ChangeSeq)
+		assert.Equal(t, int64(8), posts[1].ChangeSeq)
+		assert.Equal(t, int64(2), posts[2].ChangeSeq)
+	})
+}
+
+func testChannelChangeStoreGetSince(t *testing.T, ss store.Store) {
+	channelId := model.NewId()
+
+	var posts []*model.Post
+	for i := 0; i < 5; i++ {
+		post, err := ss.Post().Save(newTestChannelChangePost(channelId))
+		require.Nil(t, err)
+		posts = append(posts, post)
+	}
+
+	t.Run("should return the changes past the sequence number", func(t *testing.T) {
+		changes, err := ss.ChannelChange().GetSince(channelId, 3, 100)
+		require.Nil(t, err)
+		require.Len(t, changes, 2)
+		assert.Equal(t, posts[3].Id, changes[0].PostId)
+		assert.Equal(t, posts[4].Id, changes[1].PostId)
+	})
+
+	t.Run("should limit the changes", func(t *testing.T) {
+		changes, err := ss.ChannelChange().GetSince(channelId, 1, 2)
+		require.Nil(t, err)
+		require.Len(t, changes, 2)
+		assert.Equal(t, int64(2), changes[0].Seq)
+		assert.Equal(t, int64(3), changes[1].Seq)
+	})
+
+	t.Run("should return no changes for an unknown channel", func(t *testing.T) {
+		changes, err := ss.ChannelChange().GetSince(model.NewId(), 0, 100)
+		require.Nil(t, err)
+		assert.Empty(t, changes)
+
+		lastSeq, err := ss.ChannelChange().GetLastSeq(model.NewId())
+		require.Nil(t, err)
+		assert.Zero(t, lastSeq)
+	})
+}
+
+func testChannelChangeStorePermanentDeleteByChannel(t *testing.T, ss store.Store) {
+	channelId := model.NewId()
+	otherChannelId := model.NewId()
+
+	_, _, err := ss.Post().SaveMultiple([]*model.Post{
+		newTestChannelChangePost(channelId),
+		newTestChannelChangePost(otherChannelId),
+	})
+	require.Nil(t, err)
+
+	nErr := ss.ChannelChange().PermanentDeleteByChannel(channelId)
+	require.Nil(t, nErr)
+
+	changes, nErr := ss.ChannelChange().GetSince(channelId, 0, 100)
+	require.Nil(t, nErr)
+	assert.Empty(t, changes)
+
+	lastSeq, nErr := ss.ChannelChange().GetLastSeq(channelId)
+	require.Nil(t, nErr)
+	assert.Zero(t, lastSeq)
+
+	changes, nErr = ss.ChannelChange().GetSince(otherChannelId, 0, 100)
+	require.Nil(t, nErr)
+	assert.Len(t, changes, 1)
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+func TestChannelGuestLinkStore(t *testing.T, ss store.Store) {
+	t.Run("Save", func(t *testing.T) { testChannelGuestLinkStoreSave(t, ss) })
+	t.Run("GetForChannel", func(t *testing.T) { testChannelGuestLinkStoreGetForChannel(t, ss) })
+	t.Run("IncrementUseCount", func(t *testing.T) { testChannelGuestLinkStoreIncrementUseCount(t, ss) })
+	t.Run("Revoke", func(t *testing.T) { testChannelGuestLinkStoreRevoke(t, ss) })
+	t.Run("PermanentDeleteByChannel", func(t *testing.T) { testChannelGuestLinkStorePermanentDeleteByChannel(t, ss) })
+}
+
+func newTestChannelGuestLink(channelId string) *model.ChannelGuestLink {
+	return &model.ChannelGuestLink{
+		ChannelId: channelId,
+		TeamId:    model.NewId(),
+		CreatorId: model.NewId(),
+	}
+}
+
+func testChannelGuestLinkStoreSave(t *testing.T, ss store.Store) {
+	t.Run("should save link", func(t *testing.T) {
+		saved, err := ss.ChannelGuestLink().Save(newTestChannelGuestLink(model.NewId()))
+		require.Nil(t, err)
+		assert.NotEmpty(t, saved.Id)
+		assert.Len(t, saved.Token, model.CHANNEL_GUEST_LINK_TOKEN_SIZE)
+
+		fetched, err := ss.ChannelGuestLink().Get(saved.Id)
+		require.Nil(t, err)
+		assert.Equal(t, saved, fetched)
+
+		fetched, err = ss.ChannelGuestLink().GetByToken(saved.Token)
+		require.Nil(t, err)
+		assert.Equal(t, saved, fetched)
+	})
+
+	t.Run("should fail to save an existing link", func(t *testing.T) {
+		saved, err := ss.ChannelGuestLink().Save(newTestChannelGuestLink(model.NewId()))
+		require.Nil(t, err)
+
+		_, err = ss.ChannelGuestLink().Save(saved)
+		var iiErr *store.ErrInvalidInput
+		assert.True(t, errors.As(err, &iiErr))
+	})
+
+	t.Run("should fail to save invalid link", func(t *testing.T) {
+		link := newTestChannelGuestLink(model.NewId())
+		link.MaxUses = -1
+
+		_, err := ss.ChannelGuestLink().Save(link)
+		var appErr *model.AppError
+		assert.True(t, errors.As(err, &appErr))
+	})
+}
+
+func testChannelGuestLinkStoreGetForChannel(t *testing.T, ss store.Store) {
+	channelId := model.NewId()
+	link1, err := ss.ChannelGuestLink().Save(newTestChannelGuestLink(channelId))
+	require.Nil(t, err)
+	link2, err := ss.ChannelGuestLink().Save(newTestChannelGuestLink(channelId))
+	require.Nil(t, err)
+	_, err = ss.ChannelGuestLink().Save(newTestChannelGuestLink(model.NewId()))
+	require.Nil(t, err)
+
+	links, err := ss.ChannelGuestLink().GetForChannel(channelId)
+	require.Nil(t, err)
+	require.Len(t, links, 2)
+	assert.ElementsMatch(t, []string{link1.Id, link2.Id}, []string{links[0].Id, links[1].Id})
+
+	count, err := ss.ChannelGuestLink().CountForChannel(channelId)
+	require.Nil(t, err)
+	assert.Equal(t, int64(2), count)
+}
+
+func testChannelGuestLinkStoreIncrementUseCount(t *testing.T, ss store.Store) {
+	t.Run("should stop at max uses", func(t *testing.T) {
+		link := newTestChannelGuestLink(model.NewId())
+		link.MaxUses = 1
+		saved, err := ss.ChannelGuestLink().Save(link)
+		require.Nil(t, err)
+
+		require.Nil(t, ss.ChannelGuestLink().IncrementUseCount(saved.Id, model.GetMillis()))
+
+		err = ss.ChannelGuestLink().IncrementUseCount(saved.Id, model.GetMillis())
+		var ltErr *store.ErrLimitExceeded
+		assert.True(t, errors.As(err, &ltErr))
+
+		fetched, err := ss.ChannelGuestLink().Get(saved.Id)
+		require.Nil(t, err)
+		assert.Equal(t, int64(1), fetched.UseCount)
+	})
+
+	t.Run("should stop at expiry", func(t *testing.T) {
+		link := newTestChannelGuestLink(model.NewId())
+		link.ExpiresAt = model.GetMillis() + 1000
+		saved, err := ss.ChannelGuestLink().Save(link)
+		require.Nil(t, err)
+
+		require.Nil(t, ss.ChannelGuestLink().IncrementUseCount(saved.Id, link.ExpiresAt-1))
+
+		err = ss.ChannelGuestLink().IncrementUseCount(saved.Id, link.ExpiresAt)
+		var ltErr *store.ErrLimitExceeded
+		assert.True(t, errors.As(err, &ltErr))
+	})
+}
+
+func testChannelGuestLinkStoreRevoke(t *testing.T, ss store.Store) {
+	saved, err := ss.ChannelGuestLink().Save(newTestChannelGuestLink(model.NewId()))
+	require.Nil(t, err)
+
+	err = ss.ChannelGuestLink().Revoke(saved.Id, model.GetMillis())
+	require.Nil(t, err)
+
+	var nfErr *store.ErrNotFound
+	_, err = ss.ChannelGuestLink().Get(saved.Id)
+	assert.True(t, errors.As(err, &nfErr))
+
+	_, err = ss.ChannelGuestLink().GetByToken(saved.Token)
+	assert.True(t, errors.As(err, &nfErr))
+
+	err = ss.ChannelGuestLink().Revoke(saved.Id, model.GetMillis())
+	assert.True(t, errors.As(err, &nfErr))
+
+	err = ss.ChannelGuestLink().IncrementUseCount(saved.Id, model.GetMillis())
+	var ltErr *store.ErrLimitExceeded
+	assert.True(t, errors.As(err, &ltErr))
+}
+
+func testChannelGuestLinkStorePermanentDeleteByChannel(t *testing.T, ss store.Store) {
+	channelId := model.NewId()
+	_, err := ss.ChannelGuestLink().Save(newTestChannelGuestLink(channelId))
+	require.Nil(t, err)
+	other, err := ss.ChannelGuestLink().Save(newTestChannelGuestLink(model.NewId()))
+	require.Nil(t, err)
+
+	require.Nil(t, ss.ChannelGuestLink().PermanentDeleteByChannel(channelId))
+
+	links, err := ss.ChannelGuestLink().GetForChannel(channelId)
+	require.Nil(t, err)
+	assert.Empty(t, links)
+
+	_, err = ss.ChannelGuestLink().Get(other.Id)
+	require.Nil(t, err)
+}
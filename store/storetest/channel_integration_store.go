@@ -0,0 +1,164 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+func TestChannelIntegrationStore(t *testing.T, ss store.Store) {
+	t.Run("Save", func(t *testing.T) { testChannelIntegrationStoreSave(t, ss) })
+	t.Run("GetForChannel", func(t *testing.T) { testChannelIntegrationStoreGetForChannel(t, ss) })
+	t.Run("Delete", func(t *testing.T) { testChannelIntegrationStoreDelete(t, ss) })
+	t.Run("PermanentDeleteByChannel", func(t *testing.T) { testChannelIntegrationStorePermanentDeleteByChannel(t, ss) })
+	t.Run("PermanentDeleteByIntegration", func(t *testing.T) { testChannelIntegrationStorePermanentDeleteByIntegration(t, ss) })
+}
+
+func newTestChannelIntegration(channelId, integrationId string) *model.ChannelIntegration {
+	return &model.ChannelIntegration{
+		ChannelId:     channelId,
+		IntegrationId: integrationId,
+		Type:          model.CHANNEL_INTEGRATION_TYPE_INCOMING_WEBHOOK,
+		CreatorId:     model.NewId(),
+	}
+}
+
+func testChannelIntegrationStoreSave(t *testing.T, ss store.Store) {
+	t.Run("should save channel integration", func(t *testing.T) {
+		saved, err := ss.ChannelIntegration().Save(newTestChannelIntegration(model.NewId(), model.NewId()))
+		require.Nil(t, err)
+		assert.NotZero(t, saved.CreateAt)
+
+		fetched, err := ss.ChannelIntegration().Get(saved.ChannelId, saved.IntegrationId)
+		require.Nil(t, err)
+		assert.Equal(t, saved, fetched)
+	})
+
+	t.Run("should allow the same integration in several channels", func(t *testing.T) {
+		integrationId := model.NewId()
+
+		_, err := ss.ChannelIntegration().Save(newTestChannelIntegration(model.NewId(), integrationId))
+		require.Nil(t, err)
+
+		_, err = ss.ChannelIntegration().Save(newTestChannelIntegration(model.NewId(), integrationId))
+		require.Nil(t, err)
+	})
+
+	t.Run("should fail to allow the same integration twice", func(t *testing.T) {
+		integration, err := ss.ChannelIntegration().Save(newTestChannelIntegration(model.NewId(), model.NewId()))
+		require.Nil(t, err)
+
+		_, err = ss.ChannelIntegration().Save(newTestChannelIntegration(integration.ChannelId, integration.IntegrationId))
+		var cErr *store.ErrConflict
+		assert.True(t, errors.As(err, &cErr))
+	})
+
+	t.Run("should fail to save invalid channel integration", func(t *testing.T) {
+		integration := newTestChannelIntegration(model.NewId(), model.NewId())
+		integration.Type = "invalid"
+
+		_, err := ss.ChannelIntegration().Save(integration)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("should fail to get missing channel integration", func(t *testing.T) {
+		_, err := ss.ChannelIntegration().Get(model.NewId(), model.NewId())
+		var nfErr *store.ErrNotFound
+		assert.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testChannelIntegrationStoreGetForChannel(t *testing.T, ss store.Store) {
+	channelId := model.NewId()
+
+	first := newTestChannelIntegration(channelId, model.NewId())
+	first.CreateAt = 1000
+	_, err := ss.ChannelIntegration().Save(first)
+	require.Nil(t, err)
+
+	second := newTestChannelIntegration(channelId, model.NewId())
+	second.Type = model.CHANNEL_INTEGRATION_TYPE_BOT
+	second.CreateAt = 2000
+	_, err = ss.ChannelIntegration().Save(second)
+	require.Nil(t, err)
+
+	_, err = ss.ChannelIntegration().Save(newTestChannelIntegration(model.NewId(), model.NewId()))
+	require.Nil(t, err)
+
+	integrations, err := ss.ChannelIntegration().GetForChannel(channelId)
+	require.Nil(t, err)
+	require.Len(t, integrations, 2)
+	assert.Equal(t, first, integrations[0])
+	assert.Equal(t, second, integrations[1])
+
+	integrations, err = ss.ChannelIntegration().GetForChannel(model.NewId())
+	require.Nil(t, err)
+	assert.Empty(t, integrations)
+}
+
+func testChannelIntegrationStoreDelete(t *testing.T, ss store.Store) {
+	integration, err := ss.ChannelIntegration().Save(newTestChannelIntegration(model.NewId(), model.NewId()))
+	require.Nil(t, err)
+
+	err = ss.ChannelIntegration().Delete(integration.ChannelId, integration.IntegrationId)
+	require.Nil(t, err)
+
+	_, err = ss.ChannelIntegration().Get(integration.ChannelId, integration.IntegrationId)
+	var nfErr *store.ErrNotFound
+	assert.True(t, errors.As(err, &nfErr))
+
+	err = ss.ChannelIntegration().Delete(integration.ChannelId, integration.IntegrationId)
+	assert.True(t, errors.As(err, &nfErr))
+}
+
+func testChannelIntegrationStorePermanentDeleteByChannel(t *testing.T, ss store.Store) {
+	channelId := model.NewId()
+	_, err := ss.ChannelIntegration().Save(newTestChannelIntegration(channelId, model.NewId()))
+	require.Nil(t, err)
+	_, err = ss.ChannelIntegration().Save(newTestChannelIntegration(channelId, model.NewId()))
+	require.Nil(t, err)
+
+	other, err := ss.ChannelIntegration().Save(newTestChannelIntegration(model.NewId(), model.NewId()))
+	require.Nil(t, err)
+
+	err = ss.ChannelIntegration().PermanentDeleteByChannel(channelId)
+	require.Nil(t, err)
+
+	integrations, err := ss.ChannelIntegration().GetForChannel(channelId)
+	require.Nil(t, err)
+	assert.Empty(t, integrations)
+
+	_, err = ss.ChannelIntegration().Get(other.ChannelId, other.IntegrationId)
+	assert.Nil(t, err)
+}
+
+func testChannelIntegrationStorePermanentDeleteByIntegration(t *testing.T, ss store.Store) {
+	integrationId := model.NewId()
+	first, err := ss.ChannelIntegration().Save(newTestChannelIntegration(model.NewId(), integrationId))
+	require.Nil(t, err)
+	second, err := ss.ChannelIntegration().Save(newTestChannelIntegration(model.NewId(), integrationId))
+	require.Nil(t, err)
+
+	other, err := ss.ChannelIntegration().Save(newTestChannelIntegration(first.ChannelId, model.NewId()))
+	require.Nil(t, err)
+
+	err = ss.ChannelIntegration().PermanentDeleteByIntegration(integrationId)
+	require.Nil(t, err)
+
+	var nfErr *store.ErrNotFound
+	_, err = ss.ChannelIntegration().Get(first.ChannelId, integrationId)
+	assert.True(t, errors.As(err, &nfErr))
+	_, err = ss.ChannelIntegration().Get(second.ChannelId, integrationId)
+	assert.True(t, errors.As(err, &nfErr))
+
+	_, err = ss.ChannelIntegration().Get(other.ChannelId, other.IntegrationId)
+	assert.Nil(t, err)
+}
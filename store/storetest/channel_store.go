@@ -4,6 +4,7 @@
 package storetest
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"sort"
@@ -69,12 +70,14 @@ func TestChannelStore(t *testing.T, ss store.Store, s SqlSupplier) {
 	t.Run("GetChannelCounts", func(t *testing.T) { testChannelStoreGetChannelCounts(t, ss) })
 	t.Run("GetMembersForUser", func(t *testing.T) { testChannelStoreGetMembersForUser(t, ss) })
 	t.Run("GetMembersForUserWithPagination", func(t *testing.T) { testChannelStoreGetMembersForUserWithPagination(t, ss) })
+	t.Run("GetMembersForUserWithCursor", func(t *testing.T) { testChannelStoreGetMembersForUserWithCursor(t, ss) })
 	t.Run("CountPostsAfter", func(t *testing.T) { testCountPostsAfter(t, ss) })
 	t.Run("UpdateLastViewedAt", func(t *testing.T) { testChannelStoreUpdateLastViewedAt(t, ss) })
 	t.Run("IncrementMentionCount", func(t *testing.T) { testChannelStoreIncrementMentionCount(t, ss) })
 	t.Run("UpdateChannelMember", func(t *testing.T) { testUpdateChannelMember(t, ss) })
 	t.Run("GetMember", func(t *testing.T) { testGetMember(t, ss) })
 	t.Run("GetMemberForPost", func(t *testing.T) { testChannelStoreGetMemberForPost(t, ss) })
+	t.Run("GetMembersWithExpiredRoles", func(t *testing.T) { testChannelStoreGetMembersWithExpiredRoles(t, ss) })
 	t.Run("GetMemberCount", func(t *testing.T) { testGetMemberCount(t, ss) })
 	t.Run("GetMemberCountsByGroup", func(t *testing.T) { testGetMemberCountsByGroup(t, ss) })
 	t.Run("GetGuestCount", func(t *testing.T) { testGetGuestCount(t, ss) })
@@ -90,6 +93,7 @@ func TestChannelStore(t *testing.T, ss store.Store, s SqlSupplier) {
 	t.Run("MaxChannelsPerTeam", func(t *testing.T) { testChannelStoreMaxChannelsPerTeam(t, ss) })
 	t.Run("GetChannelsByScheme", func(t *testing.T) { testChannelStoreGetChannelsByScheme(t, ss) })
 	t.Run("MigrateChannelMembers", func(t *testing.T) { testChannelStoreMigrateChannelMembers(t, ss) })
+	t.Run("MigrateRootMessageCounts", func(t *testing.T) { testChannelStoreMigrateRootMessageCounts(t, ss) })
 	t.Run("ResetAllChannelSchemes", func(t *testing.T) { testResetAllChannelSchemes(t, ss) })
 	t.Run("ClearAllCustomRoleAssignments", func(t *testing.T) { testChannelStoreClearAllCustomRoleAssignments(t, ss) })
 	t.Run("MaterializedPublicChannels", func(t *testing.T) { testMaterializedPublicChannels(t, ss, s) })
@@ -161,7 +165,7 @@ func testChannelStoreSave(t *testing.T, ss store.Store) {
 }
 
 func testChannelStoreSaveDirectChannel(t *testing.T, ss store.Store, s SqlSupplier) {
-	teamId := model.NewId()
+	teamId := makeTeam(t, ss).Id
 
 	o1 := model.Channel{}
 	o1.TeamId = teamId
@@ -174,16 +178,16 @@ func testChannelStoreSaveDirectChannel(t *testing.T, ss store.Store, s SqlSuppli
 	u1.Nickname = model.NewId()
 	_, err := ss.User().Save(u1)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: makeTeam(t, ss).Id, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	u2 := &model.User{}
 	u2.Email = MakeEmail()
 	u2.Nickname = model.NewId()
 	_, err = ss.User().Save(u2)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: makeTeam(t, ss).Id, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	m1 := model.ChannelMember{}
 	m1.ChannelId = o1.Id
@@ -195,7 +199,7 @@ func testChannelStoreSaveDirectChannel(t *testing.T, ss store.Store, s SqlSuppli
 	m2.UserId = u2.Id
 	m2.NotifyProps = model.GetDefaultChannelNotifyProps()
 
-	_, nErr := ss.Channel().SaveDirectChannel(&o1, &m1, &m2)
+	_, nErr = ss.Channel().SaveDirectChannel(&o1, &m1, &m2)
 	require.Nil(t, nErr, "couldn't save direct channel", nErr)
 
 	members, err := ss.Channel().GetMembers(o1.Id, 0, 100)
@@ -248,16 +252,16 @@ func testChannelStoreCreateDirectChannel(t *testing.T, ss store.Store) {
 	u1.Nickname = model.NewId()
 	_, err := ss.User().Save(u1)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: makeTeam(t, ss).Id, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	u2 := &model.User{}
 	u2.Email = MakeEmail()
 	u2.Nickname = model.NewId()
 	_, err = ss.User().Save(u2)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: makeTeam(t, ss).Id, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	c1, nErr := ss.Channel().CreateDirectChannel(u1, u2)
 	require.Nil(t, nErr, "couldn't create direct channel", nErr)
@@ -314,15 +318,15 @@ func testChannelStoreUpdate(t *testing.T, ss store.Store) {
 }
 
 func testGetChannelUnread(t *testing.T, ss store.Store) {
-	teamId1 := model.NewId()
-	teamId2 := model.NewId()
+	teamId1 := makeTeam(t, ss).Id
+	teamId2 := makeTeam(t, ss).Id
 
-	uid := model.NewId()
+	uid := makeUser(t, ss).Id
 	m1 := &model.TeamMember{TeamId: teamId1, UserId: uid}
 	m2 := &model.TeamMember{TeamId: teamId2, UserId: uid}
-	_, err := ss.Team().SaveMember(m1, -1)
+	_, err := ss.Team().SaveMember(context.Background(), m1, -1)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(m2, -1)
+	_, err = ss.Team().SaveMember(context.Background(), m2, -1)
 	require.Nil(t, err)
 	notifyPropsModel := model.GetDefaultChannelNotifyProps()
 
@@ -386,7 +390,7 @@ func testChannelStoreGet(t *testing.T, ss store.Store, s SqlSupplier) {
 	u1.Nickname = model.NewId()
 	_, err = ss.User().Save(u1)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u1.Id}, -1)
+	_, err = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: makeTeam(t, ss).Id, UserId: u1.Id}, -1)
 	require.Nil(t, err)
 
 	u2 := model.User{}
@@ -394,7 +398,7 @@ func testChannelStoreGet(t *testing.T, ss store.Store, s SqlSupplier) {
 	u2.Nickname = model.NewId()
 	_, err = ss.User().Save(&u2)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u2.Id}, -1)
+	_, err = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: makeTeam(t, ss).Id, UserId: u2.Id}, -1)
 	require.Nil(t, err)
 
 	o2 := model.Channel{}
@@ -450,16 +454,16 @@ func testChannelStoreGetChannelsByIds(t *testing.T, ss store.Store) {
 	u1.Nickname = model.NewId()
 	_, err := ss.User().Save(u1)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: makeTeam(t, ss).Id, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	u2 := model.User{}
 	u2.Email = MakeEmail()
 	u2.Nickname = model.NewId()
 	_, err = ss.User().Save(&u2)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: makeTeam(t, ss).Id, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	o2 := model.Channel{}
 	o2.TeamId = model.NewId()
@@ -827,16 +831,16 @@ func testChannelMemberStore(t *testing.T, ss store.Store) {
 	u1.Nickname = model.NewId()
 	_, err := ss.User().Save(&u1)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: makeTeam(t, ss).Id, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	u2 := model.User{}
 	u2.Email = MakeEmail()
 	u2.Nickname = model.NewId()
 	_, err = ss.User().Save(&u2)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: makeTeam(t, ss).Id, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	o1 := model.ChannelMember{}
 	o1.ChannelId = c1.Id
@@ -929,8 +933,8 @@ func testChannelSaveMember(t *testing.T, ss store.Store) {
 			Type:        model.TEAM_OPEN,
 		}
 
-		team, err = ss.Team().Save(team)
-		require.Nil(t, err)
+		team, nErr := ss.Team().Save(context.Background(), team)
+		require.Nil(t, nErr)
 
 		channel := &model.Channel{
 			DisplayName: "DisplayName",
@@ -938,7 +942,7 @@ func testChannelSaveMember(t *testing.T, ss store.Store) {
 			Type:        model.CHANNEL_OPEN,
 			TeamId:      team.Id,
 		}
-		channel, nErr := ss.Channel().Save(channel, -1)
+		channel, nErr = ss.Channel().Save(channel, -1)
 		require.Nil(t, nErr)
 		defer func() { ss.Channel().PermanentDelete(channel.Id) }()
 
@@ -1090,8 +1094,8 @@ func testChannelSaveMember(t *testing.T, ss store.Store) {
 			SchemeId:    &ts.Id,
 		}
 
-		team, err = ss.Team().Save(team)
-		require.Nil(t, err)
+		team, nErr = ss.Team().Save(context.Background(), team)
+		require.Nil(t, nErr)
 
 		channel := &model.Channel{
 			DisplayName: "DisplayName",
@@ -1250,8 +1254,8 @@ func testChannelSaveMember(t *testing.T, ss store.Store) {
 			Type:        model.TEAM_OPEN,
 		}
 
-		team, err = ss.Team().Save(team)
-		require.Nil(t, err)
+		team, nErr = ss.Team().Save(context.Background(), team)
+		require.Nil(t, nErr)
 
 		channel, nErr := ss.Channel().Save(&model.Channel{
 			DisplayName: "DisplayName",
@@ -1426,8 +1430,8 @@ func testChannelSaveMultipleMembers(t *testing.T, ss store.Store) {
 			Type:        model.TEAM_OPEN,
 		}
 
-		team, err = ss.Team().Save(team)
-		require.Nil(t, err)
+		team, nErr := ss.Team().Save(context.Background(), team)
+		require.Nil(t, nErr)
 
 		channel := &model.Channel{
 			DisplayName: "DisplayName",
@@ -1435,7 +1439,7 @@ func testChannelSaveMultipleMembers(t *testing.T, ss store.Store) {
 			Type:        model.CHANNEL_OPEN,
 			TeamId:      team.Id,
 		}
-		channel, nErr := ss.Channel().Save(channel, -1)
+		channel, nErr = ss.Channel().Save(channel, -1)
 		require.Nil(t, nErr)
 		defer func() { ss.Channel().PermanentDelete(channel.Id) }()
 
@@ -1601,8 +1605,8 @@ func testChannelSaveMultipleMembers(t *testing.T, ss store.Store) {
 			SchemeId:    &ts.Id,
 		}
 
-		team, err = ss.Team().Save(team)
-		require.Nil(t, err)
+		team, nErr = ss.Team().Save(context.Background(), team)
+		require.Nil(t, nErr)
 
 		channel := &model.Channel{
 			DisplayName: "DisplayName",
@@ -1775,8 +1779,8 @@ func testChannelSaveMultipleMembers(t *testing.T, ss store.Store) {
 			Type:        model.TEAM_OPEN,
 		}
 
-		team, err = ss.Team().Save(team)
-		require.Nil(t, err)
+		team, nErr = ss.Team().Save(context.Background(), team)
+		require.Nil(t, nErr)
 
 		channel, nErr := ss.Channel().Save(&model.Channel{
 			DisplayName: "DisplayName",
@@ -1952,8 +1956,8 @@ func testChannelUpdateMember(t *testing.T, ss store.Store) {
 			Type:        model.TEAM_OPEN,
 		}
 
-		team, err = ss.Team().Save(team)
-		require.Nil(t, err)
+		team, nErr := ss.Team().Save(context.Background(), team)
+		require.Nil(t, nErr)
 
 		channel := &model.Channel{
 			DisplayName: "DisplayName",
@@ -1961,7 +1965,7 @@ func testChannelUpdateMember(t *testing.T, ss store.Store) {
 			Type:        model.CHANNEL_OPEN,
 			TeamId:      team.Id,
 		}
-		channel, nErr := ss.Channel().Save(channel, -1)
+		channel, nErr = ss.Channel().Save(channel, -1)
 		require.Nil(t, nErr)
 		defer func() { ss.Channel().PermanentDelete(channel.Id) }()
 
@@ -2115,8 +2119,8 @@ func testChannelUpdateMember(t *testing.T, ss store.Store) {
 			SchemeId:    &ts.Id,
 		}
 
-		team, err = ss.Team().Save(team)
-		require.Nil(t, err)
+		team, nErr = ss.Team().Save(context.Background(), team)
+		require.Nil(t, nErr)
 
 		channel := &model.Channel{
 			DisplayName: "DisplayName",
@@ -2277,8 +2281,8 @@ func testChannelUpdateMember(t *testing.T, ss store.Store) {
 			Type:        model.TEAM_OPEN,
 		}
 
-		team, err = ss.Team().Save(team)
-		require.Nil(t, err)
+		team, nErr = ss.Team().Save(context.Background(), team)
+		require.Nil(t, nErr)
 
 		channel, nErr := ss.Channel().Save(&model.Channel{
 			DisplayName: "DisplayName",
@@ -2455,8 +2459,8 @@ func testChannelUpdateMultipleMembers(t *testing.T, ss store.Store) {
 			Type:        model.TEAM_OPEN,
 		}
 
-		team, err = ss.Team().Save(team)
-		require.Nil(t, err)
+		team, nErr := ss.Team().Save(context.Background(), team)
+		require.Nil(t, nErr)
 
 		channel := &model.Channel{
 			DisplayName: "DisplayName",
@@ -2464,7 +2468,7 @@ func testChannelUpdateMultipleMembers(t *testing.T, ss store.Store) {
 			Type:        model.CHANNEL_OPEN,
 			TeamId:      team.Id,
 		}
-		channel, nErr := ss.Channel().Save(channel, -1)
+		channel, nErr = ss.Channel().Save(channel, -1)
 		require.Nil(t, nErr)
 		defer func() { ss.Channel().PermanentDelete(channel.Id) }()
 
@@ -2625,8 +2629,8 @@ func testChannelUpdateMultipleMembers(t *testing.T, ss store.Store) {
 			SchemeId:    &ts.Id,
 		}
 
-		team, err = ss.Team().Save(team)
-		require.Nil(t, err)
+		team, nErr = ss.Team().Save(context.Background(), team)
+		require.Nil(t, nErr)
 
 		channel := &model.Channel{
 			DisplayName: "DisplayName",
@@ -2794,8 +2798,8 @@ func testChannelUpdateMultipleMembers(t *testing.T, ss store.Store) {
 			Type:        model.TEAM_OPEN,
 		}
 
-		team, err = ss.Team().Save(team)
-		require.Nil(t, err)
+		team, nErr = ss.Team().Save(context.Background(), team)
+		require.Nil(t, nErr)
 
 		channel, nErr := ss.Channel().Save(&model.Channel{
 			DisplayName: "DisplayName",
@@ -3064,16 +3068,16 @@ func testChannelDeleteMemberStore(t *testing.T, ss store.Store) {
 	u1.Nickname = model.NewId()
 	_, err := ss.User().Save(&u1)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: makeTeam(t, ss).Id, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	u2 := model.User{}
 	u2.Email = MakeEmail()
 	u2.Nickname = model.NewId()
 	_, err = ss.User().Save(&u2)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: makeTeam(t, ss).Id, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	o1 := model.ChannelMember{}
 	o1.ChannelId = c1.Id
@@ -3203,7 +3207,7 @@ func testChannelStoreGetAllChannels(t *testing.T, ss store.Store, s SqlSupplier)
 	t1.Name = "zz" + model.NewId()
 	t1.Email = MakeEmail()
 	t1.Type = model.TEAM_OPEN
-	_, err := ss.Team().Save(&t1)
+	_, err := ss.Team().Save(context.Background(), &t1)
 	require.Nil(t, err)
 
 	t2 := model.Team{}
@@ -3211,7 +3215,7 @@ func testChannelStoreGetAllChannels(t *testing.T, ss store.Store, s SqlSupplier)
 	t2.Name = "zz" + model.NewId()
 	t2.Email = MakeEmail()
 	t2.Type = model.TEAM_OPEN
-	_, err = ss.Team().Save(&t2)
+	_, err = ss.Team().Save(context.Background(), &t2)
 	require.Nil(t, err)
 
 	c1 := model.Channel{}
@@ -3777,7 +3781,7 @@ func testChannelStoreGetMembersForUser(t *testing.T, ss store.Store) {
 	t1.Name = "zz" + model.NewId()
 	t1.Email = MakeEmail()
 	t1.Type = model.TEAM_OPEN
-	_, err := ss.Team().Save(&t1)
+	_, err := ss.Team().Save(context.Background(), &t1)
 	require.Nil(t, err)
 
 	o1 := model.Channel{}
@@ -3874,7 +3878,7 @@ func testChannelStoreGetMembersForUserWithPagination(t *testing.T, ss store.Stor
 	t1.Name = "zz" + model.NewId()
 	t1.Email = MakeEmail()
 	t1.Type = model.TEAM_OPEN
-	_, err := ss.Team().Save(&t1)
+	_, err := ss.Team().Save(context.Background(), &t1)
 	require.Nil(t, err)
 
 	o1 := model.Channel{}
@@ -3916,6 +3920,73 @@ func testChannelStoreGetMembersForUserWithPagination(t *testing.T, ss store.Stor
 	assert.Len(t, *members, 1)
 }
 
+func testChannelStoreGetMembersForUserWithCursor(t *testing.T, ss store.Store) {
+	teamId := model.NewId()
+	userId := model.NewId()
+
+	makeMember := func(teamId string, lastViewedAt int64) *model.Channel {
+		channel := &model.Channel{
+			TeamId:      teamId,
+			DisplayName: "Channel",
+			Name:        "zz" + model.NewId() + "b",
+			Type:        model.CHANNEL_OPEN,
+		}
+		channel, nErr := ss.Channel().Save(channel, -1)
+		require.Nil(t, nErr)
+
+		_, err := ss.Channel().SaveMember(&model.ChannelMember{
+			ChannelId:    channel.Id,
+			UserId:       userId,
+			LastViewedAt: lastViewedAt,
+			NotifyProps:  model.GetDefaultChannelNotifyProps(),
+		})
+		require.Nil(t, err)
+		return channel
+	}
+
+	c1 := makeMember(teamId, 3000)
+	c2 := makeMember(teamId, 2000)
+	c3 := makeMember(teamId, 1000)
+	makeMember(model.NewId(), 4000)
+
+	err := ss.Channel().IncrementMentionCount(c2.Id, userId, false)
+	require.Nil(t, err)
+
+	pageThrough := func(sortBy string) []string {
+		var channelIds []string
+		cursor := model.ChannelMembersCursor{SortBy: sortBy}
+		for i := 0; i < 5; i++ {
+			page, next, err := ss.Channel().GetMembersForUserWithCursor(teamId, userId, cursor, 2)
+			require.Nil(t, err)
+			for _, member := range page.Members {
+				channelIds = append(channelIds, member.ChannelId)
+			}
+			if !page.HasMore {
+				return channelIds
+			}
+			cursor = next
+		}
+		require.Fail(t, "too many pages")
+		return nil
+	}
+
+	t.Run("by last viewed at", func(t *testing.T) {
+		assert.Equal(t, []string{c1.Id, c2.Id, c3.Id}, pageThrough(model.CHANNEL_MEMBERS_SORT_LAST_VIEWED_AT))
+	})
+
+	t.Run("unread first", func(t *testing.T) {
+		assert.Equal(t, []string{c2.Id, c1.Id, c3.Id}, pageThrough(model.CHANNEL_MEMBERS_SORT_UNREAD))
+	})
+
+	t.Run("next cursor", func(t *testing.T) {
+		page, next, err := ss.Channel().GetMembersForUserWithCursor(teamId, userId, model.ChannelMembersCursor{SortBy: model.CHANNEL_MEMBERS_SORT_UNREAD}, 1)
+		require.Nil(t, err)
+		require.Len(t, page.Members, 1)
+		assert.True(t, page.HasMore)
+		assert.Equal(t, model.ChannelMembersCursor{SortBy: model.CHANNEL_MEMBERS_SORT_UNREAD, Unread: true, LastViewedAt: 2000, ChannelId: c2.Id}, next)
+	})
+}
+
 func testCountPostsAfter(t *testing.T, ss store.Store) {
 	t.Run("should count all posts with or without the given user ID", func(t *testing.T) {
 		userId1 := model.NewId()
@@ -4137,16 +4208,24 @@ func testChannelStoreIncrementMentionCount(t *testing.T, ss store.Store) {
 	_, err := ss.Channel().SaveMember(&m1)
 	require.Nil(t, err)
 
-	err = ss.Channel().IncrementMentionCount(m1.ChannelId, m1.UserId)
+	err = ss.Channel().IncrementMentionCount(m1.ChannelId, m1.UserId, false)
 	require.Nil(t, err, "failed to update")
 
-	err = ss.Channel().IncrementMentionCount(m1.ChannelId, "missing id")
+	err = ss.Channel().IncrementMentionCount(m1.ChannelId, m1.UserId, true)
 	require.Nil(t, err, "failed to update")
 
-	err = ss.Channel().IncrementMentionCount("missing id", m1.UserId)
+	member, err := ss.Channel().GetMember(m1.ChannelId, m1.UserId)
+	require.Nil(t, err)
+	require.Equal(t, int64(2), member.MentionCount)
+	require.Equal(t, int64(1), member.UrgentMentionCount)
+
+	err = ss.Channel().IncrementMentionCount(m1.ChannelId, "missing id", false)
 	require.Nil(t, err, "failed to update")
 
-	err = ss.Channel().IncrementMentionCount("missing id", "missing id")
+	err = ss.Channel().IncrementMentionCount("missing id", m1.UserId, false)
+	require.Nil(t, err, "failed to update")
+
+	err = ss.Channel().IncrementMentionCount("missing id", "missing id", false)
 	require.Nil(t, err, "failed to update")
 }
 
@@ -4276,8 +4355,60 @@ func testChannelStoreGetMemberForPost(t *testing.T, ss store.Store) {
 	require.NotNil(t, err, "shouldn't have returned a member")
 }
 
+func testChannelStoreGetMembersWithExpiredRoles(t *testing.T, ss store.Store) {
+	channel, nErr := ss.Channel().Save(&model.Channel{
+		TeamId:      model.NewId(),
+		DisplayName: "Name",
+		Name:        "zz" + model.NewId() + "b",
+		Type:        model.CHANNEL_OPEN,
+	}, -1)
+	require.Nil(t, nErr)
+
+	now := model.GetMillis()
+	expired := &model.ChannelMember{ChannelId: channel.Id, UserId: model.NewId(), NotifyProps: model.GetDefaultChannelNotifyProps(), SchemeUser: true, ExplicitRoles: "test", ExplicitRolesExpiresAt: now - 1000}
+	expiring := &model.ChannelMember{ChannelId: channel.Id, UserId: model.NewId(), NotifyProps: model.GetDefaultChannelNotifyProps(), SchemeUser: true, ExplicitRoles: "test", ExplicitRolesExpiresAt: now + 60*60*1000}
+	permanent := &model.ChannelMember{ChannelId: channel.Id, UserId: model.NewId(), NotifyProps: model.GetDefaultChannelNotifyProps(), SchemeUser: true, ExplicitRoles: "test"}
+	_, err := ss.Channel().SaveMultipleMembers([]*model.ChannelMember{expired, expiring, permanent})
+	require.Nil(t, err)
+
+	memberUserIds := func(members []*model.ChannelMember) []string {
+		var userIds []string
+		for _, member := range members {
+			if member.ChannelId == channel.Id {
+				userIds = append(userIds, member.UserId)
+			}
+		}
+		return userIds
+	}
+
+	members, err := ss.Channel().GetMembersWithExpiredRoles(now, 100)
+	require.Nil(t, err)
+	require.Equal(t, []string{expired.UserId}, memberUserIds(members))
+	for _, member := range members {
+		if member.UserId == expired.UserId {
+			assert.Equal(t, "test", member.ExplicitRoles)
+			assert.Equal(t, expired.ExplicitRolesExpiresAt, member.ExplicitRolesExpiresAt)
+			assert.Equal(t, []string{model.CHANNEL_USER_ROLE_ID}, member.GetRoles())
+		}
+	}
+
+	members, err = ss.Channel().GetMembersWithExpiredRoles(now+2*60*60*1000, 100)
+	require.Nil(t, err)
+	require.Equal(t, []string{expired.UserId, expiring.UserId}, memberUserIds(members))
+
+	t.Run("expired roles are left out of the roles for the user", func(t *testing.T) {
+		roles, err := ss.Channel().GetAllChannelMembersForUser(expired.UserId, false, false)
+		require.Nil(t, err)
+		assert.Equal(t, model.CHANNEL_USER_ROLE_ID, roles[channel.Id])
+
+		roles, err = ss.Channel().GetAllChannelMembersForUser(expiring.UserId, false, false)
+		require.Nil(t, err)
+		assert.Equal(t, "test "+model.CHANNEL_USER_ROLE_ID, roles[channel.Id])
+	})
+}
+
 func testGetMemberCount(t *testing.T, ss store.Store) {
-	teamId := model.NewId()
+	teamId := makeTeam(t, ss).Id
 
 	c1 := model.Channel{
 		TeamId:      teamId,
@@ -4303,8 +4434,8 @@ func testGetMemberCount(t *testing.T, ss store.Store) {
 	}
 	_, err := ss.User().Save(u1)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	m1 := model.ChannelMember{
 		ChannelId:   c1.Id,
@@ -4324,8 +4455,8 @@ func testGetMemberCount(t *testing.T, ss store.Store) {
 	}
 	_, err = ss.User().Save(&u2)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	m2 := model.ChannelMember{
 		ChannelId:   c1.Id,
@@ -4346,8 +4477,8 @@ func testGetMemberCount(t *testing.T, ss store.Store) {
 	}
 	_, err = ss.User().Save(&u3)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
+	require.Nil(t, nErr)
 
 	m3 := model.ChannelMember{
 		ChannelId:   c2.Id,
@@ -4368,8 +4499,8 @@ func testGetMemberCount(t *testing.T, ss store.Store) {
 	}
 	_, err = ss.User().Save(u4)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u4.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u4.Id}, -1)
+	require.Nil(t, nErr)
 
 	m4 := model.ChannelMember{
 		ChannelId:   c1.Id,
@@ -4386,7 +4517,7 @@ func testGetMemberCount(t *testing.T, ss store.Store) {
 
 func testGetMemberCountsByGroup(t *testing.T, ss store.Store) {
 	var memberCounts []*model.ChannelMemberCountByGroup
-	teamId := model.NewId()
+	teamId := makeTeam(t, ss).Id
 	g1 := &model.Group{
 		Name:        model.NewString(model.NewId()),
 		DisplayName: model.NewId(),
@@ -4412,8 +4543,8 @@ func testGetMemberCountsByGroup(t *testing.T, ss store.Store) {
 	}
 	_, err = ss.User().Save(u1)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	m1 := model.ChannelMember{
 		ChannelId:   c1.Id,
@@ -4483,8 +4614,8 @@ func testGetMemberCountsByGroup(t *testing.T, ss store.Store) {
 		}
 		_, err = ss.User().Save(u)
 		require.Nil(t, err)
-		_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u.Id}, -1)
-		require.Nil(t, err)
+		_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u.Id}, -1)
+		require.Nil(t, nErr)
 
 		m := model.ChannelMember{
 			ChannelId:   c1.Id,
@@ -4534,8 +4665,8 @@ func testGetMemberCountsByGroup(t *testing.T, ss store.Store) {
 		}
 		_, err = ss.User().Save(u)
 		require.Nil(t, err)
-		_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u.Id}, -1)
-		require.Nil(t, err)
+		_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u.Id}, -1)
+		require.Nil(t, nErr)
 
 		m := model.ChannelMember{
 			ChannelId:   c1.Id,
@@ -4597,7 +4728,7 @@ func testGetMemberCountsByGroup(t *testing.T, ss store.Store) {
 }
 
 func testGetGuestCount(t *testing.T, ss store.Store) {
-	teamId := model.NewId()
+	teamId := makeTeam(t, ss).Id
 
 	c1 := model.Channel{
 		TeamId:      teamId,
@@ -4625,8 +4756,8 @@ func testGetGuestCount(t *testing.T, ss store.Store) {
 		}
 		_, err := ss.User().Save(u1)
 		require.Nil(t, err)
-		_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
-		require.Nil(t, err)
+		_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
+		require.Nil(t, nErr)
 
 		m1 := model.ChannelMember{
 			ChannelId:   c1.Id,
@@ -4650,8 +4781,8 @@ func testGetGuestCount(t *testing.T, ss store.Store) {
 		}
 		_, err := ss.User().Save(&u2)
 		require.Nil(t, err)
-		_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
-		require.Nil(t, err)
+		_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
+		require.Nil(t, nErr)
 
 		m2 := model.ChannelMember{
 			ChannelId:   c1.Id,
@@ -4675,8 +4806,8 @@ func testGetGuestCount(t *testing.T, ss store.Store) {
 		}
 		_, err := ss.User().Save(&u3)
 		require.Nil(t, err)
-		_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
-		require.Nil(t, err)
+		_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
+		require.Nil(t, nErr)
 
 		m3 := model.ChannelMember{
 			ChannelId:   c2.Id,
@@ -4700,8 +4831,8 @@ func testGetGuestCount(t *testing.T, ss store.Store) {
 		}
 		_, err := ss.User().Save(u4)
 		require.Nil(t, err)
-		_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u4.Id}, -1)
-		require.Nil(t, err)
+		_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u4.Id}, -1)
+		require.Nil(t, nErr)
 
 		m4 := model.ChannelMember{
 			ChannelId:   c1.Id,
@@ -5195,7 +5326,7 @@ func testChannelStoreSearchAllChannels(t *testing.T, ss store.Store) {
 	t1.Name = "zz" + model.NewId()
 	t1.Email = MakeEmail()
 	t1.Type = model.TEAM_OPEN
-	_, err := ss.Team().Save(&t1)
+	_, err := ss.Team().Save(context.Background(), &t1)
 	require.Nil(t, err)
 
 	t2 := model.Team{}
@@ -5203,7 +5334,7 @@ func testChannelStoreSearchAllChannels(t *testing.T, ss store.Store) {
 	t2.Name = "zz" + model.NewId()
 	t2.Email = MakeEmail()
 	t2.Type = model.TEAM_OPEN
-	_, err = ss.Team().Save(&t2)
+	_, err = ss.Team().Save(context.Background(), &t2)
 	require.Nil(t, err)
 
 	o1 := model.Channel{
@@ -5985,6 +6116,71 @@ func testChannelStoreMigrateChannelMembers(t *testing.T, ss store.Store) {
 	assert.False(t, cm3b.SchemeAdmin)
 }
 
+func testChannelStoreMigrateRootMessageCounts(t *testing.T, ss store.Store) {
+	channel, nErr := ss.Channel().Save(&model.Channel{
+		TeamId:      model.NewId(),
+		DisplayName: "Name",
+		Name:        model.NewId(),
+		Type:        model.CHANNEL_OPEN,
+	}, -1)
+	require.Nil(t, nErr)
+
+	savePost := func(post *model.Post) *model.Post {
+		post.ChannelId = channel.Id
+		post.UserId = model.NewId()
+		post.Message = "message"
+		post, err := ss.Post().Save(post)
+		require.Nil(t, err)
+		return post
+	}
+
+	root := savePost(&model.Post{CreateAt: 1000})
+	savePost(&model.Post{CreateAt: 2000, RootId: root.Id, ParentId: root.Id})
+	savePost(&model.Post{CreateAt: 3000, Type: model.POST_JOIN_CHANNEL})
+	savePost(&model.Post{CreateAt: 4000})
+
+	member, err := ss.Channel().SaveMember(&model.ChannelMember{
+		ChannelId:    channel.Id,
+		UserId:       model.NewId(),
+		LastViewedAt: 3500,
+		NotifyProps:  model.GetDefaultChannelNotifyProps(),
+	})
+	require.Nil(t, err)
+	require.Zero(t, member.MsgCountRoot)
+
+	lastChannelId := strings.Repeat("0", 26)
+	for {
+		data, err := ss.Channel().MigrateTotalMsgCountRoot(lastChannelId)
+		require.Nil(t, err)
+		if data == nil {
+			break
+		}
+		lastChannelId = data["ChannelId"]
+	}
+
+	lastChannelId = strings.Repeat("0", 26)
+	lastUserId := strings.Repeat("0", 26)
+	for {
+		data, err := ss.Channel().MigrateMsgCountRoot(lastChannelId, lastUserId)
+		require.Nil(t, err)
+		if data == nil {
+			break
+		}
+		lastChannelId = data["ChannelId"]
+		lastUserId = data["UserId"]
+	}
+
+	ss.Channel().ClearCaches()
+
+	channel, nErr = ss.Channel().Get(channel.Id, false)
+	require.Nil(t, nErr)
+	assert.Equal(t, int64(2), channel.TotalMsgCountRoot, "replies and join messages should not be counted")
+
+	member, err = ss.Channel().GetMember(member.ChannelId, member.UserId)
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), member.MsgCountRoot, "only the root messages viewed should be counted")
+}
+
 func testResetAllChannelSchemes(t *testing.T, ss store.Store) {
 	s1 := &model.Scheme{
 		Name:        model.NewId(),
@@ -6255,7 +6451,7 @@ func testChannelStoreGetAllChannelsForExportAfter(t *testing.T, ss store.Store)
 	t1.Name = "zz" + model.NewId()
 	t1.Email = MakeEmail()
 	t1.Type = model.TEAM_OPEN
-	_, err := ss.Team().Save(&t1)
+	_, err := ss.Team().Save(context.Background(), &t1)
 	require.Nil(t, err)
 
 	c1 := model.Channel{}
@@ -6287,7 +6483,7 @@ func testChannelStoreGetChannelMembersForExport(t *testing.T, ss store.Store) {
 	t1.Name = "zz" + model.NewId()
 	t1.Email = MakeEmail()
 	t1.Type = model.TEAM_OPEN
-	_, err := ss.Team().Save(&t1)
+	_, err := ss.Team().Save(context.Background(), &t1)
 	require.Nil(t, err)
 
 	c1 := model.Channel{}
@@ -6344,7 +6540,7 @@ func testChannelStoreRemoveAllDeactivatedMembers(t *testing.T, ss store.Store, s
 	t1.Name = "zz" + model.NewId()
 	t1.Email = MakeEmail()
 	t1.Type = model.TEAM_OPEN
-	_, err := ss.Team().Save(&t1)
+	_, err := ss.Team().Save(context.Background(), &t1)
 	require.Nil(t, err)
 
 	c1 := model.Channel{}
@@ -6421,7 +6617,7 @@ func testChannelStoreRemoveAllDeactivatedMembers(t *testing.T, ss store.Store, s
 }
 
 func testChannelStoreExportAllDirectChannels(t *testing.T, ss store.Store, s SqlSupplier) {
-	teamId := model.NewId()
+	teamId := makeTeam(t, ss).Id
 
 	o1 := model.Channel{}
 	o1.TeamId = teamId
@@ -6444,16 +6640,16 @@ func testChannelStoreExportAllDirectChannels(t *testing.T, ss store.Store, s Sql
 	u1.Nickname = model.NewId()
 	_, err := ss.User().Save(u1)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: makeTeam(t, ss).Id, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	u2 := &model.User{}
 	u2.Email = MakeEmail()
 	u2.Nickname = model.NewId()
 	_, err = ss.User().Save(u2)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: makeTeam(t, ss).Id, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	m1 := model.ChannelMember{}
 	m1.ChannelId = o1.Id
@@ -6478,7 +6674,7 @@ func testChannelStoreExportAllDirectChannels(t *testing.T, ss store.Store, s Sql
 }
 
 func testChannelStoreExportAllDirectChannelsExcludePrivateAndPublic(t *testing.T, ss store.Store, s SqlSupplier) {
-	teamId := model.NewId()
+	teamId := makeTeam(t, ss).Id
 
 	o1 := model.Channel{}
 	o1.TeamId = teamId
@@ -6507,16 +6703,16 @@ func testChannelStoreExportAllDirectChannelsExcludePrivateAndPublic(t *testing.T
 	u1.Nickname = model.NewId()
 	_, err := ss.User().Save(u1)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: makeTeam(t, ss).Id, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	u2 := &model.User{}
 	u2.Email = MakeEmail()
 	u2.Nickname = model.NewId()
 	_, err = ss.User().Save(u2)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: makeTeam(t, ss).Id, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	m1 := model.ChannelMember{}
 	m1.ChannelId = o1.Id
@@ -6540,7 +6736,7 @@ func testChannelStoreExportAllDirectChannelsExcludePrivateAndPublic(t *testing.T
 }
 
 func testChannelStoreExportAllDirectChannelsDeletedChannel(t *testing.T, ss store.Store, s SqlSupplier) {
-	teamId := model.NewId()
+	teamId := makeTeam(t, ss).Id
 
 	o1 := model.Channel{}
 	o1.TeamId = teamId
@@ -6553,16 +6749,16 @@ func testChannelStoreExportAllDirectChannelsDeletedChannel(t *testing.T, ss stor
 	u1.Nickname = model.NewId()
 	_, err := ss.User().Save(u1)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: makeTeam(t, ss).Id, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	u2 := &model.User{}
 	u2.Email = MakeEmail()
 	u2.Nickname = model.NewId()
 	_, err = ss.User().Save(u2)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: makeTeam(t, ss).Id, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	m1 := model.ChannelMember{}
 	m1.ChannelId = o1.Id
@@ -6577,7 +6773,7 @@ func testChannelStoreExportAllDirectChannelsDeletedChannel(t *testing.T, ss stor
 	ss.Channel().SaveDirectChannel(&o1, &m1, &m2)
 
 	o1.DeleteAt = 1
-	nErr := ss.Channel().SetDeleteAt(o1.Id, 1, 1)
+	nErr = ss.Channel().SetDeleteAt(o1.Id, 1, 1)
 	require.Nil(t, nErr, "channel should have been deleted")
 
 	d1, err := ss.Channel().GetAllDirectChannelsForExportAfter(10000, strings.Repeat("0", 26))
@@ -6694,7 +6890,7 @@ func testGroupSyncedChannelCount(t *testing.T, ss store.Store) {
 }
 
 func testSidebarChannelsMigration(t *testing.T, ss store.Store) {
-	teamId := model.NewId()
+	teamId := makeTeam(t, ss).Id
 
 	channel1, err := ss.Channel().Save(&model.Channel{
 		DisplayName:      model.NewId(),
@@ -6729,7 +6925,7 @@ func testSidebarChannelsMigration(t *testing.T, ss store.Store) {
 		u := &model.User{Email: MakeEmail(), Nickname: model.NewId()}
 		_, err = ss.User().Save(u)
 		require.Nil(t, err)
-		_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u.Id}, -1)
+		_, err = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u.Id}, -1)
 		require.Nil(t, err)
 		users = append(users, u)
 	}
@@ -22,6 +22,7 @@ func TestCommandStore(t *testing.T, ss store.Store) {
 	t.Run("DeleteByTeam", func(t *testing.T) { testCommandStoreDeleteByTeam(t, ss) })
 	t.Run("DeleteByUser", func(t *testing.T) { testCommandStoreDeleteByUser(t, ss) })
 	t.Run("Update", func(t *testing.T) { testCommandStoreUpdate(t, ss) })
+	t.Run("AutocompleteData", func(t *testing.T) { testCommandStoreAutocompleteData(t, ss) })
 	t.Run("CommandCount", func(t *testing.T) { testCommandCount(t, ss) })
 }
 
@@ -61,6 +62,44 @@ func testCommandStoreGet(t *testing.T, ss store.Store) {
 	require.True(t, errors.As(err, &nfErr))
 }
 
+func testCommandStoreAutocompleteData(t *testing.T, ss store.Store) {
+	o1 := &model.Command{}
+	o1.CreatorId = model.NewId()
+	o1.Method = model.COMMAND_METHOD_POST
+	o1.TeamId = model.NewId()
+	o1.URL = "http://nowhere.com/"
+	o1.Trigger = "trigger"
+
+	o1, nErr := ss.Command().Save(o1)
+	require.Nil(t, nErr)
+
+	r1, nErr := ss.Command().Get(o1.Id)
+	require.Nil(t, nErr)
+	require.Nil(t, r1.AutocompleteData, "commands without autocomplete data should read back without any")
+
+	data := model.NewAutocompleteData("trigger", "[action]", "Run an action")
+	data.AddStaticListArgument("The action", true, []model.AutocompleteListItem{{Item: "start"}, {Item: "stop"}})
+	data.AddDynamicListArgument("The target", "http://nowhere.com/targets", true)
+	o1.AutocompleteData = data
+	o1.AutocompleteDataVersion = "v1"
+	o1.AutocompleteDataURL = "http://nowhere.com/autocomplete"
+
+	_, nErr = ss.Command().Update(o1)
+	require.Nil(t, nErr)
+
+	r1, nErr = ss.Command().Get(o1.Id)
+	require.Nil(t, nErr)
+	require.NotNil(t, r1.AutocompleteData)
+	require.True(t, data.Equals(r1.AutocompleteData))
+	require.Equal(t, "v1", r1.AutocompleteDataVersion)
+	require.Equal(t, "http://nowhere.com/autocomplete", r1.AutocompleteDataURL)
+
+	commands, nErr := ss.Command().GetByTeam(o1.TeamId)
+	require.Nil(t, nErr)
+	require.Len(t, commands, 1)
+	require.True(t, data.Equals(commands[0].AutocompleteData))
+}
+
 func testCommandStoreGetByTeam(t *testing.T, ss store.Store) {
 	o1 := &model.Command{}
 	o1.CreatorId = model.NewId()
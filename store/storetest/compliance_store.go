@@ -4,6 +4,7 @@
 package storetest
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 	"time"
@@ -36,10 +37,10 @@ func cleanupStoreState(t *testing.T, ss store.Store) {
 	}
 
 	//remove existing teams
-	allTeams, err := ss.Team().GetAll()
+	allTeams, err := ss.Team().GetAll(context.Background())
 	require.Nilf(t, err, "error cleaning all test teams: %v", err)
 	for _, team := range allTeams {
-		err := ss.Team().PermanentDelete(team.Id)
+		err := ss.Team().PermanentDelete(context.Background(), team.Id)
 		require.Nil(t, err, "failed cleaning up test team %s", team.Id)
 	}
 }
@@ -103,7 +104,7 @@ func testComplianceExport(t *testing.T, ss store.Store) {
 	t1.Name = "zz" + model.NewId() + "b"
 	t1.Email = MakeEmail()
 	t1.Type = model.TEAM_OPEN
-	t1, err := ss.Team().Save(t1)
+	t1, err := ss.Team().Save(context.Background(), t1)
 	require.Nil(t, err)
 
 	u1 := &model.User{}
@@ -111,23 +112,23 @@ func testComplianceExport(t *testing.T, ss store.Store) {
 	u1.Username = model.NewId()
 	u1, err = ss.User().Save(u1)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: t1.Id, UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: t1.Id, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	u2 := &model.User{}
 	u2.Email = MakeEmail()
 	u2.Username = model.NewId()
 	u2, err = ss.User().Save(u2)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: t1.Id, UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: t1.Id, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	c1 := &model.Channel{}
 	c1.TeamId = t1.Id
 	c1.DisplayName = "Channel2"
 	c1.Name = "zz" + model.NewId() + "b"
 	c1.Type = model.CHANNEL_OPEN
-	c1, nErr := ss.Channel().Save(c1, -1)
+	c1, nErr = ss.Channel().Save(c1, -1)
 	require.Nil(t, nErr)
 
 	o1 := &model.Post{}
@@ -212,7 +213,7 @@ func testComplianceExportDirectMessages(t *testing.T, ss store.Store) {
 	t1.Name = "zz" + model.NewId() + "b"
 	t1.Email = MakeEmail()
 	t1.Type = model.TEAM_OPEN
-	t1, err := ss.Team().Save(t1)
+	t1, err := ss.Team().Save(context.Background(), t1)
 	require.Nil(t, err)
 
 	u1 := &model.User{}
@@ -220,23 +221,23 @@ func testComplianceExportDirectMessages(t *testing.T, ss store.Store) {
 	u1.Username = model.NewId()
 	u1, err = ss.User().Save(u1)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: t1.Id, UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: t1.Id, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	u2 := &model.User{}
 	u2.Email = MakeEmail()
 	u2.Username = model.NewId()
 	u2, err = ss.User().Save(u2)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: t1.Id, UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: t1.Id, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	c1 := &model.Channel{}
 	c1.TeamId = t1.Id
 	c1.DisplayName = "Channel2"
 	c1.Name = "zz" + model.NewId() + "b"
 	c1.Type = model.CHANNEL_OPEN
-	c1, nErr := ss.Channel().Save(c1, -1)
+	c1, nErr = ss.Channel().Save(c1, -1)
 	require.Nil(t, nErr)
 
 	cDM, nErr := ss.Channel().CreateDirectChannel(u1, u2)
@@ -307,8 +308,8 @@ func testMessageExportPublicChannel(t *testing.T, ss store.Store) {
 		Email:       MakeEmail(),
 		Type:        model.TEAM_OPEN,
 	}
-	team, err = ss.Team().Save(team)
-	require.Nil(t, err)
+	team, nErr := ss.Team().Save(context.Background(), team)
+	require.Nil(t, nErr)
 
 	// and two users that are a part of that team
 	user1 := &model.User{
@@ -317,11 +318,11 @@ func testMessageExportPublicChannel(t *testing.T, ss store.Store) {
 	}
 	user1, err = ss.User().Save(user1)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{
 		TeamId: team.Id,
 		UserId: user1.Id,
 	}, -1)
-	require.Nil(t, err)
+	require.Nil(t, nErr)
 
 	user2 := &model.User{
 		Email:    MakeEmail(),
@@ -329,11 +330,11 @@ func testMessageExportPublicChannel(t *testing.T, ss store.Store) {
 	}
 	user2, err = ss.User().Save(user2)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{
 		TeamId: team.Id,
 		UserId: user2.Id,
 	}, -1)
-	require.Nil(t, err)
+	require.Nil(t, nErr)
 
 	// need a public channel
 	channel := &model.Channel{
@@ -342,7 +343,7 @@ func testMessageExportPublicChannel(t *testing.T, ss store.Store) {
 		DisplayName: "Public Channel",
 		Type:        model.CHANNEL_OPEN,
 	}
-	channel, nErr := ss.Channel().Save(channel, -1)
+	channel, nErr = ss.Channel().Save(channel, -1)
 	require.Nil(t, nErr)
 
 	// user1 posts twice in the public channel
@@ -411,8 +412,8 @@ func testMessageExportPrivateChannel(t *testing.T, ss store.Store) {
 		Email:       MakeEmail(),
 		Type:        model.TEAM_OPEN,
 	}
-	team, err = ss.Team().Save(team)
-	require.Nil(t, err)
+	team, nErr := ss.Team().Save(context.Background(), team)
+	require.Nil(t, nErr)
 
 	// and two users that are a part of that team
 	user1 := &model.User{
@@ -421,11 +422,11 @@ func testMessageExportPrivateChannel(t *testing.T, ss store.Store) {
 	}
 	user1, err = ss.User().Save(user1)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{
 		TeamId: team.Id,
 		UserId: user1.Id,
 	}, -1)
-	require.Nil(t, err)
+	require.Nil(t, nErr)
 
 	user2 := &model.User{
 		Email:    MakeEmail(),
@@ -433,11 +434,11 @@ func testMessageExportPrivateChannel(t *testing.T, ss store.Store) {
 	}
 	user2, err = ss.User().Save(user2)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{
 		TeamId: team.Id,
 		UserId: user2.Id,
 	}, -1)
-	require.Nil(t, err)
+	require.Nil(t, nErr)
 
 	// need a private channel
 	channel := &model.Channel{
@@ -446,7 +447,7 @@ func testMessageExportPrivateChannel(t *testing.T, ss store.Store) {
 		DisplayName: "Private Channel",
 		Type:        model.CHANNEL_PRIVATE,
 	}
-	channel, nErr := ss.Channel().Save(channel, -1)
+	channel, nErr = ss.Channel().Save(channel, -1)
 	require.Nil(t, nErr)
 
 	// user1 posts twice in the private channel
@@ -517,8 +518,8 @@ func testMessageExportDirectMessageChannel(t *testing.T, ss store.Store) {
 		Email:       MakeEmail(),
 		Type:        model.TEAM_OPEN,
 	}
-	team, err = ss.Team().Save(team)
-	require.Nil(t, err)
+	team, nErr := ss.Team().Save(context.Background(), team)
+	require.Nil(t, nErr)
 
 	// and two users that are a part of that team
 	user1 := &model.User{
@@ -527,11 +528,11 @@ func testMessageExportDirectMessageChannel(t *testing.T, ss store.Store) {
 	}
 	user1, err = ss.User().Save(user1)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{
 		TeamId: team.Id,
 		UserId: user1.Id,
 	}, -1)
-	require.Nil(t, err)
+	require.Nil(t, nErr)
 
 	user2 := &model.User{
 		Email:    MakeEmail(),
@@ -539,11 +540,11 @@ func testMessageExportDirectMessageChannel(t *testing.T, ss store.Store) {
 	}
 	user2, err = ss.User().Save(user2)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{
 		TeamId: team.Id,
 		UserId: user2.Id,
 	}, -1)
-	require.Nil(t, err)
+	require.Nil(t, nErr)
 
 	// as well as a DM channel between those users
 	directMessageChannel, nErr := ss.Channel().CreateDirectChannel(user1, user2)
@@ -598,8 +599,8 @@ func testMessageExportGroupMessageChannel(t *testing.T, ss store.Store) {
 		Email:       MakeEmail(),
 		Type:        model.TEAM_OPEN,
 	}
-	team, err = ss.Team().Save(team)
-	require.Nil(t, err)
+	team, nErr := ss.Team().Save(context.Background(), team)
+	require.Nil(t, nErr)
 
 	// and three users that are a part of that team
 	user1 := &model.User{
@@ -608,11 +609,11 @@ func testMessageExportGroupMessageChannel(t *testing.T, ss store.Store) {
 	}
 	user1, err = ss.User().Save(user1)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{
 		TeamId: team.Id,
 		UserId: user1.Id,
 	}, -1)
-	require.Nil(t, err)
+	require.Nil(t, nErr)
 
 	user2 := &model.User{
 		Email:    MakeEmail(),
@@ -620,11 +621,11 @@ func testMessageExportGroupMessageChannel(t *testing.T, ss store.Store) {
 	}
 	user2, err = ss.User().Save(user2)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{
 		TeamId: team.Id,
 		UserId: user2.Id,
 	}, -1)
-	require.Nil(t, err)
+	require.Nil(t, nErr)
 
 	user3 := &model.User{
 		Email:    MakeEmail(),
@@ -632,11 +633,11 @@ func testMessageExportGroupMessageChannel(t *testing.T, ss store.Store) {
 	}
 	user3, err = ss.User().Save(user3)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{
 		TeamId: team.Id,
 		UserId: user3.Id,
 	}, -1)
-	require.Nil(t, err)
+	require.Nil(t, nErr)
 
 	// can't create a group channel directly, because importing app creates an import cycle, so we have to fake it
 	groupMessageChannel := &model.Channel{
@@ -644,7 +645,7 @@ func testMessageExportGroupMessageChannel(t *testing.T, ss store.Store) {
 		Name:   model.NewId(),
 		Type:   model.CHANNEL_GROUP,
 	}
-	groupMessageChannel, nErr := ss.Channel().Save(groupMessageChannel, -1)
+	groupMessageChannel, nErr = ss.Channel().Save(groupMessageChannel, -1)
 	require.Nil(t, nErr)
 
 	// user1 posts in the GM
@@ -695,8 +696,8 @@ func testEditExportMessage(t *testing.T, ss store.Store) {
 		Email:       MakeEmail(),
 		Type:        model.TEAM_OPEN,
 	}
-	team, err = ss.Team().Save(team)
-	require.Nil(t, err)
+	team, nErr := ss.Team().Save(context.Background(), team)
+	require.Nil(t, nErr)
 
 	// need a user part of that team
 	user1 := &model.User{
@@ -705,11 +706,11 @@ func testEditExportMessage(t *testing.T, ss store.Store) {
 	}
 	user1, err = ss.User().Save(user1)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{
 		TeamId: team.Id,
 		UserId: user1.Id,
 	}, -1)
-	require.Nil(t, err)
+	require.Nil(t, nErr)
 
 	// need a public channel
 	channel := &model.Channel{
@@ -718,7 +719,7 @@ func testEditExportMessage(t *testing.T, ss store.Store) {
 		DisplayName: "Public Channel",
 		Type:        model.CHANNEL_OPEN,
 	}
-	channel, nErr := ss.Channel().Save(channel, -1)
+	channel, nErr = ss.Channel().Save(channel, -1)
 	require.Nil(t, nErr)
 
 	// user1 posts in the public channel
@@ -788,8 +789,8 @@ func testEditAfterExportMessage(t *testing.T, ss store.Store) {
 		Email:       MakeEmail(),
 		Type:        model.TEAM_OPEN,
 	}
-	team, err = ss.Team().Save(team)
-	require.Nil(t, err)
+	team, nErr := ss.Team().Save(context.Background(), team)
+	require.Nil(t, nErr)
 
 	// need a user part of that team
 	user1 := &model.User{
@@ -798,11 +799,11 @@ func testEditAfterExportMessage(t *testing.T, ss store.Store) {
 	}
 	user1, err = ss.User().Save(user1)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{
 		TeamId: team.Id,
 		UserId: user1.Id,
 	}, -1)
-	require.Nil(t, err)
+	require.Nil(t, nErr)
 
 	// need a public channel
 	channel := &model.Channel{
@@ -811,7 +812,7 @@ func testEditAfterExportMessage(t *testing.T, ss store.Store) {
 		DisplayName: "Public Channel",
 		Type:        model.CHANNEL_OPEN,
 	}
-	channel, nErr := ss.Channel().Save(channel, -1)
+	channel, nErr = ss.Channel().Save(channel, -1)
 	require.Nil(t, nErr)
 
 	// user1 posts in the public channel
@@ -900,8 +901,8 @@ func testDeleteExportMessage(t *testing.T, ss store.Store) {
 		Email:       MakeEmail(),
 		Type:        model.TEAM_OPEN,
 	}
-	team, err = ss.Team().Save(team)
-	require.Nil(t, err)
+	team, nErr := ss.Team().Save(context.Background(), team)
+	require.Nil(t, nErr)
 
 	// need a user part of that team
 	user1 := &model.User{
@@ -910,11 +911,11 @@ func testDeleteExportMessage(t *testing.T, ss store.Store) {
 	}
 	user1, err = ss.User().Save(user1)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{
 		TeamId: team.Id,
 		UserId: user1.Id,
 	}, -1)
-	require.Nil(t, err)
+	require.Nil(t, nErr)
 
 	// need a public channel
 	channel := &model.Channel{
@@ -923,7 +924,7 @@ func testDeleteExportMessage(t *testing.T, ss store.Store) {
 		DisplayName: "Public Channel",
 		Type:        model.CHANNEL_OPEN,
 	}
-	channel, nErr := ss.Channel().Save(channel, -1)
+	channel, nErr = ss.Channel().Save(channel, -1)
 	require.Nil(t, nErr)
 
 	// user1 posts in the public channel
@@ -985,8 +986,8 @@ func testDeleteAfterExportMessage(t *testing.T, ss store.Store) {
 		Email:       MakeEmail(),
 		Type:        model.TEAM_OPEN,
 	}
-	team, err = ss.Team().Save(team)
-	require.Nil(t, err)
+	team, nErr := ss.Team().Save(context.Background(), team)
+	require.Nil(t, nErr)
 
 	// need a user part of that team
 	user1 := &model.User{
@@ -995,11 +996,11 @@ func testDeleteAfterExportMessage(t *testing.T, ss store.Store) {
 	}
 	user1, err = ss.User().Save(user1)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{
 		TeamId: team.Id,
 		UserId: user1.Id,
 	}, -1)
-	require.Nil(t, err)
+	require.Nil(t, nErr)
 
 	// need a public channel
 	channel := &model.Channel{
@@ -1008,7 +1009,7 @@ func testDeleteAfterExportMessage(t *testing.T, ss store.Store) {
 		DisplayName: "Public Channel",
 		Type:        model.CHANNEL_OPEN,
 	}
-	channel, nErr := ss.Channel().Save(channel, -1)
+	channel, nErr = ss.Channel().Save(channel, -1)
 	require.Nil(t, nErr)
 
 	// user1 posts in the public channel
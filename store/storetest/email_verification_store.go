@@ -0,0 +1,144 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+func TestEmailVerificationStore(t *testing.T, ss store.Store) {
+	t.Run("SaveGetUpdateDelete", func(t *testing.T) { testEmailVerificationStoreSaveGetUpdateDelete(t, ss) })
+	t.Run("GetForReminderAndOverdue", func(t *testing.T) { testEmailVerificationStoreGetForReminderAndOverdue(t, ss) })
+	t.Run("GetUnverifiedUsers", func(t *testing.T) { testEmailVerificationStoreGetUnverifiedUsers(t, ss) })
+}
+
+func testEmailVerificationStoreSaveGetUpdateDelete(t *testing.T, ss store.Store) {
+	userId := model.NewId()
+
+	_, err := ss.EmailVerification().Get(userId)
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr))
+
+	verification, err := ss.EmailVerification().Save(&model.EmailVerification{UserId: userId, Deadline: model.GetMillis() + 1000})
+	require.Nil(t, err)
+	assert.NotZero(t, verification.CreateAt)
+
+	_, err = ss.EmailVerification().Save(&model.EmailVerification{UserId: userId, Deadline: model.GetMillis() + 1000})
+	var cErr *store.ErrConflict
+	require.True(t, errors.As(err, &cErr))
+
+	verification.RemindedAt = model.GetMillis()
+	_, err = ss.EmailVerification().Update(verification)
+	require.Nil(t, err)
+
+	rverification, err := ss.EmailVerification().Get(userId)
+	require.Nil(t, err)
+	assert.Equal(t, verification, rverification)
+
+	require.Nil(t, ss.EmailVerification().Delete(userId))
+	_, err = ss.EmailVerification().Get(userId)
+	require.True(t, errors.As(err, &nfErr))
+
+	_, err = ss.EmailVerification().Update(verification)
+	require.True(t, errors.As(err, &nfErr))
+}
+
+func testEmailVerificationStoreGetForReminderAndOverdue(t *testing.T, ss store.Store) {
+	userIds := []string{}
+	defer func() {
+		for _, userId := range userIds {
+			ss.EmailVerification().Delete(userId)
+		}
+	}()
+
+	save := func(deadline, remindedAt, lockedAt int64) string {
+		verification, err := ss.EmailVerification().Save(&model.EmailVerification{
+			UserId:     model.NewId(),
+			CreateAt:   1,
+			Deadline:   deadline,
+			RemindedAt: remindedAt,
+			LockedAt:   lockedAt,
+		})
+		require.Nil(t, err)
+		userIds = append(userIds, verification.UserId)
+		return verification.UserId
+	}
+
+	overdue := save(1000, 0, 0)
+	overdueReminded := save(1500, 500, 0)
+	save(1200, 0, 1100)
+	upcoming := save(2000, 0, 0)
+	save(5000, 0, 0)
+
+	getIds := func(verifications []*model.EmailVerification, err error) []string {
+		require.Nil(t, err)
+		ids := []string{}
+		for _, verification := range verifications {
+			ids = append(ids, verification.UserId)
+		}
+		return ids
+	}
+
+	assert.Equal(t, []string{overdue, upcoming}, getIds(ss.EmailVerification().GetForReminder(3000, 100)))
+	assert.Equal(t, []string{overdue}, getIds(ss.EmailVerification().GetForReminder(3000, 1)))
+	assert.Equal(t, []string{overdue, overdueReminded}, getIds(ss.EmailVerification().GetOverdue(1500, 100)))
+}
+
+func testEmailVerificationStoreGetUnverifiedUsers(t *testing.T, ss store.Store) {
+	userIds := []string{}
+	defer func() {
+		for _, userId := range userIds {
+			ss.User().PermanentDelete(userId)
+		}
+	}()
+
+	newUser := func(emailVerified bool, authService string) *model.User {
+		user := &model.User{
+			Email:         MakeEmail(),
+			Username:      model.NewId(),
+			EmailVerified: emailVerified,
+			AuthService:   authService,
+		}
+		if authService != "" {
+			authData := model.NewId()
+			user.AuthData = &authData
+		}
+		user, err := ss.User().Save(user)
+		require.Nil(t, err)
+		userIds = append(userIds, user.Id)
+		return user
+	}
+
+	unverified := newUser(false, "")
+	withDeadline := newUser(false, "")
+	newUser(true, "")
+	newUser(false, model.USER_AUTH_SERVICE_GITLAB)
+
+	_, err := ss.EmailVerification().Save(&model.EmailVerification{UserId: withDeadline.Id, Deadline: model.GetMillis() + 1000})
+	require.Nil(t, err)
+	defer ss.EmailVerification().Delete(withDeadline.Id)
+
+	users, err := ss.EmailVerification().GetUnverifiedUsers(0, 10000)
+	require.Nil(t, err)
+
+	found := map[string]*model.UnverifiedUser{}
+	for _, user := range users {
+		found[user.UserId] = user
+	}
+
+	require.Len(t, found, len(users))
+	require.Contains(t, found, unverified.Id)
+	require.Contains(t, found, withDeadline.Id)
+	assert.Zero(t, found[unverified.Id].Deadline)
+	assert.NotZero(t, found[withDeadline.Id].Deadline)
+	assert.Equal(t, withDeadline.Username, found[withDeadline.Id].Username)
+	assert.Equal(t, withDeadline.Email, found[withDeadline.Id].Email)
+}
@@ -4,6 +4,7 @@
 package storetest
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -21,6 +22,7 @@ func TestEmojiStore(t *testing.T, ss store.Store) {
 	t.Run("EmojiGetMultipleByName", func(t *testing.T) { testEmojiGetMultipleByName(t, ss) })
 	t.Run("EmojiGetList", func(t *testing.T) { testEmojiGetList(t, ss) })
 	t.Run("EmojiSearch", func(t *testing.T) { testEmojiSearch(t, ss) })
+	t.Run("EmojiTeamFilter", func(t *testing.T) { testEmojiTeamFilter(t, ss) })
 }
 
 func testEmojiSaveDelete(t *testing.T, ss store.Store) {
@@ -206,7 +208,7 @@ func testEmojiGetList(t *testing.T, ss store.Store) {
 		}
 	}()
 
-	result, err := ss.Emoji().GetList(0, 100, "")
+	result, err := ss.Emoji().GetList(0, 100, "", nil)
 	require.Nil(t, err)
 
 	for _, emoji := range emojis {
@@ -222,14 +224,14 @@ func testEmojiGetList(t *testing.T, ss store.Store) {
 		require.Truef(t, found, "failed to get emoji with id %v", emoji.Id)
 	}
 
-	remojis, err := ss.Emoji().GetList(0, 3, model.EMOJI_SORT_BY_NAME)
+	remojis, err := ss.Emoji().GetList(0, 3, model.EMOJI_SORT_BY_NAME, nil)
 	assert.Nil(t, err)
 	assert.Equal(t, 3, len(remojis))
 	assert.Equal(t, emojis[0].Name, remojis[0].Name)
 	assert.Equal(t, emojis[1].Name, remojis[1].Name)
 	assert.Equal(t, emojis[2].Name, remojis[2].Name)
 
-	remojis, err = ss.Emoji().GetList(1, 2, model.EMOJI_SORT_BY_NAME)
+	remojis, err = ss.Emoji().GetList(1, 2, model.EMOJI_SORT_BY_NAME, nil)
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(remojis))
 	assert.Equal(t, emojis[1].Name, remojis[0].Name)
@@ -271,7 +273,7 @@ func testEmojiSearch(t *testing.T, ss store.Store) {
 
 	shouldFind := []bool{true, false, false, false}
 
-	result, err := ss.Emoji().Search("blargh", true, 100)
+	result, err := ss.Emoji().Search("blargh", true, 100, nil)
 	require.Nil(t, err)
 	for i, emoji := range emojis {
 		found := false
@@ -287,7 +289,7 @@ func testEmojiSearch(t *testing.T, ss store.Store) {
 	}
 
 	shouldFind = []bool{true, true, true, false}
-	result, err = ss.Emoji().Search("blargh", false, 100)
+	result, err = ss.Emoji().Search("blargh", false, 100, nil)
 	require.Nil(t, err)
 	for i, emoji := range emojis {
 		found := false
@@ -302,3 +304,60 @@ func testEmojiSearch(t *testing.T, ss store.Store) {
 		assert.Equal(t, shouldFind[i], found, emoji.Name)
 	}
 }
+
+func testEmojiTeamFilter(t *testing.T, ss store.Store) {
+	teamId1 := model.NewId()
+	teamId2 := model.NewId()
+	prefix := "teamfilter" + model.NewId()[:10]
+
+	emojis := []model.Emoji{
+		{CreatorId: model.NewId(), Name: prefix + "_global"},
+		{CreatorId: model.NewId(), Name: prefix + "_team1", TeamId: teamId1},
+		{CreatorId: model.NewId(), Name: prefix + "_team2", TeamId: teamId2},
+	}
+
+	for i, emoji := range emojis {
+		data, err := ss.Emoji().Save(&emoji)
+		require.Nil(t, err)
+		emojis[i] = *data
+	}
+	defer func() {
+		for _, emoji := range emojis {
+			err := ss.Emoji().Delete(&emoji, time.Now().Unix())
+			require.Nil(t, err)
+		}
+	}()
+
+	getNames := func(result []*model.Emoji, err error) []string {
+		require.Nil(t, err)
+		names := []string{}
+		for _, emoji := range result {
+			if strings.HasPrefix(emoji.Name, prefix) {
+				names = append(names, emoji.Name)
+			}
+		}
+		return names
+	}
+
+	testCases := []struct {
+		Name          string
+		TeamIds       []string
+		ExpectedNames []string
+	}{
+		{"no filter", nil, []string{prefix + "_global", prefix + "_team1", prefix + "_team2"}},
+		{"no teams", []string{}, []string{prefix + "_global"}},
+		{"one team", []string{teamId1}, []string{prefix + "_global", prefix + "_team1"}},
+		{"both teams", []string{teamId1, teamId2}, []string{prefix + "_global", prefix + "_team1", prefix + "_team2"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			assert.ElementsMatch(t, tc.ExpectedNames, getNames(ss.Emoji().Search(prefix, true, 100, tc.TeamIds)))
+			assert.ElementsMatch(t, tc.ExpectedNames, getNames(ss.Emoji().GetList(0, 10000, model.EMOJI_SORT_BY_NAME, tc.TeamIds)))
+		})
+	}
+
+	emoji, err := ss.Emoji().GetByName(prefix+"_team1", false)
+	require.Nil(t, err)
+	assert.Equal(t, teamId1, emoji.TeamId)
+}
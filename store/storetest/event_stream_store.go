@@ -0,0 +1,155 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+func TestEventStreamStore(t *testing.T, ss store.Store) {
+	t.Run("Save", func(t *testing.T) { testEventStreamStoreSave(t, ss) })
+	t.Run("GetPending", func(t *testing.T) { testEventStreamStoreGetPending(t, ss) })
+	t.Run("RecordFailedAttempt", func(t *testing.T) { testEventStreamStoreRecordFailedAttempt(t, ss) })
+	t.Run("Delete", func(t *testing.T) { testEventStreamStoreDelete(t, ss) })
+}
+
+func newTestEventStreamEvent(createAt int64) *model.EventStreamEvent {
+	return &model.EventStreamEvent{
+		Type:      model.EVENT_STREAM_POST_CREATED,
+		CreateAt:  createAt,
+		TeamId:    model.NewId(),
+		ChannelId: model.NewId(),
+		UserId:    model.NewId(),
+		Data:      `{"message":"hello"}`,
+	}
+}
+
+// pendingEventStreamEventIds returns the ids of the pending events among the given ones, in the
+// order they are returned, ignoring the events saved by other tests.
+func pendingEventStreamEventIds(t *testing.T, ss store.Store, now int64, events ...*model.EventStreamEvent) []string {
+	pending, err := ss.EventStream().GetPending(now, 1000)
+	require.Nil(t, err)
+
+	wanted := map[string]bool{}
+	for _, event := range events {
+		wanted[event.Id] = true
+	}
+
+	ids := []string{}
+	for _, event := range pending {
+		if wanted[event.Id] {
+			ids = append(ids, event.Id)
+		}
+	}
+	return ids
+}
+
+func testEventStreamStoreSave(t *testing.T, ss store.Store) {
+	t.Run("should save event", func(t *testing.T) {
+		saved, err := ss.EventStream().Save(newTestEventStreamEvent(0))
+		require.Nil(t, err)
+		defer ss.EventStream().Delete([]string{saved.Id})
+
+		assert.NotEmpty(t, saved.Id)
+		assert.NotZero(t, saved.CreateAt)
+		assert.Equal(t, saved.CreateAt, saved.NextAttemptAt)
+		assert.Zero(t, saved.Attempts)
+	})
+
+	t.Run("should fail on existing id", func(t *testing.T) {
+		event := newTestEventStreamEvent(0)
+		event.Id = model.NewId()
+
+		_, err := ss.EventStream().Save(event)
+		require.NotNil(t, err)
+	})
+
+	t.Run("should fail on invalid type", func(t *testing.T) {
+		event := newTestEventStreamEvent(0)
+		event.Type = "unknown"
+
+		_, err := ss.EventStream().Save(event)
+		require.NotNil(t, err)
+	})
+}
+
+func testEventStreamStoreGetPending(t *testing.T, ss store.Store) {
+	e1, err := ss.EventStream().Save(newTestEventStreamEvent(3000))
+	require.Nil(t, err)
+	e2, err := ss.EventStream().Save(newTestEventStreamEvent(1000))
+	require.Nil(t, err)
+	e3, err := ss.EventStream().Save(newTestEventStreamEvent(2000))
+	require.Nil(t, err)
+	defer ss.EventStream().Delete([]string{e1.Id, e2.Id, e3.Id})
+
+	t.Run("should return the due events oldest first", func(t *testing.T) {
+		assert.Equal(t, []string{e2.Id, e3.Id, e1.Id}, pendingEventStreamEventIds(t, ss, 3000, e1, e2, e3))
+		assert.Equal(t, []string{e2.Id, e3.Id}, pendingEventStreamEventIds(t, ss, 2999, e1, e2, e3))
+	})
+
+	t.Run("should return the data of the events", func(t *testing.T) {
+		pending, err := ss.EventStream().GetPending(1000, 1000)
+		require.Nil(t, err)
+
+		for _, event := range pending {
+			if event.Id == e2.Id {
+				assert.Equal(t, e2.Data, event.Data)
+				assert.Equal(t, e2.Type, event.Type)
+				assert.Equal(t, e2.ChannelId, event.ChannelId)
+				return
+			}
+		}
+		require.Fail(t, "event not found")
+	})
+}
+
+func testEventStreamStoreRecordFailedAttempt(t *testing.T, ss store.Store) {
+	e1, err := ss.EventStream().Save(newTestEventStreamEvent(1000))
+	require.Nil(t, err)
+	e2, err := ss.EventStream().Save(newTestEventStreamEvent(1000))
+	require.Nil(t, err)
+	defer ss.EventStream().Delete([]string{e1.Id, e2.Id})
+
+	require.Nil(t, ss.EventStream().RecordFailedAttempt([]string{e1.Id}, 5000))
+	require.Nil(t, ss.EventStream().RecordFailedAttempt([]string{e1.Id}, 6000))
+
+	assert.Equal(t, []string{e2.Id}, pendingEventStreamEventIds(t, ss, 5999, e1, e2))
+
+	pending, err := ss.EventStream().GetPending(6000, 1000)
+	require.Nil(t, err)
+	for _, event := range pending {
+		if event.Id == e1.Id {
+			assert.Equal(t, 2, event.Attempts)
+			assert.Equal(t, int64(6000), event.NextAttemptAt)
+		}
+	}
+
+	require.Nil(t, ss.EventStream().RecordFailedAttempt([]string{}, 6000))
+}
+
+func testEventStreamStoreDelete(t *testing.T, ss store.Store) {
+	e1, err := ss.EventStream().Save(newTestEventStreamEvent(1000))
+	require.Nil(t, err)
+	e2, err := ss.EventStream().Save(newTestEventStreamEvent(1000))
+	require.Nil(t, err)
+	defer ss.EventStream().Delete([]string{e2.Id})
+
+	count, err := ss.EventStream().Count()
+	require.Nil(t, err)
+
+	require.Nil(t, ss.EventStream().Delete([]string{e1.Id, model.NewId()}))
+	assert.Equal(t, []string{e2.Id}, pendingEventStreamEventIds(t, ss, 1000, e1, e2))
+
+	newCount, err := ss.EventStream().Count()
+	require.Nil(t, err)
+	assert.Equal(t, count-1, newCount)
+
+	require.Nil(t, ss.EventStream().Delete([]string{}))
+}
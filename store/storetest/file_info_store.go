@@ -23,6 +23,8 @@ func TestFileInfoStore(t *testing.T, ss store.Store) {
 	t.Run("FileInfoGetForUser", func(t *testing.T) { testFileInfoGetForUser(t, ss) })
 	t.Run("FileInfoGetWithOptions", func(t *testing.T) { testFileInfoGetWithOptions(t, ss) })
 	t.Run("FileInfoAttachToPost", func(t *testing.T) { testFileInfoAttachToPost(t, ss) })
+	t.Run("FileInfoSetSensitive", func(t *testing.T) { testFileInfoSetSensitive(t, ss) })
+	t.Run("FileInfoUpdateEnrichment", func(t *testing.T) { testFileInfoUpdateEnrichment(t, ss) })
 	t.Run("FileInfoDeleteForPost", func(t *testing.T) { testFileInfoDeleteForPost(t, ss) })
 	t.Run("FileInfoPermanentDelete", func(t *testing.T) { testFileInfoPermanentDelete(t, ss) })
 	t.Run("FileInfoPermanentDeleteBatch", func(t *testing.T) { testFileInfoPermanentDeleteBatch(t, ss) })
@@ -492,6 +494,62 @@ func testFileInfoAttachToPost(t *testing.T, ss store.Store) {
 	})
 }
 
+func testFileInfoSetSensitive(t *testing.T, ss store.Store) {
+	info, err := ss.FileInfo().Save(&model.FileInfo{
+		CreatorId: model.NewId(),
+		Path:      "file.txt",
+	})
+	require.Nil(t, err)
+	defer func() {
+		ss.FileInfo().PermanentDelete(info.Id)
+	}()
+	require.False(t, info.Sensitive)
+
+	err = ss.FileInfo().SetSensitive(info.Id, true)
+	require.Nil(t, err)
+
+	fetched, err := ss.FileInfo().Get(info.Id)
+	require.Nil(t, err)
+	assert.True(t, fetched.Sensitive)
+
+	err = ss.FileInfo().SetSensitive(info.Id, false)
+	require.Nil(t, err)
+
+	fetched, err = ss.FileInfo().Get(info.Id)
+	require.Nil(t, err)
+	assert.False(t, fetched.Sensitive)
+}
+
+func testFileInfoUpdateEnrichment(t *testing.T, ss store.Store) {
+	info, err := ss.FileInfo().Save(&model.FileInfo{
+		CreatorId: model.NewId(),
+		Path:      "file.pdf",
+		Size:      100,
+	})
+	require.Nil(t, err)
+	defer func() {
+		ss.FileInfo().PermanentDelete(info.Id)
+	}()
+	require.Zero(t, info.EnrichedAt)
+
+	info.Size = 90
+	info.Hash = "d2a84f4b8b650937ec8f73cd8be2c74add5a911ba64df27458ed8229da804a26"
+	info.PageCount = 3
+	info.Metadata = model.StringMap{"author": "someone"}
+	info.EnrichedAt = model.GetMillis()
+	err = ss.FileInfo().UpdateEnrichment(info)
+	require.Nil(t, err)
+
+	fetched, err := ss.FileInfo().Get(info.Id)
+	require.Nil(t, err)
+	assert.Equal(t, int64(90), fetched.Size)
+	assert.Equal(t, info.Hash, fetched.Hash)
+	assert.Equal(t, 3, fetched.PageCount)
+	assert.Equal(t, info.Metadata, fetched.Metadata)
+	assert.Equal(t, info.EnrichedAt, fetched.EnrichedAt)
+	assert.GreaterOrEqual(t, fetched.UpdateAt, info.UpdateAt)
+}
+
 func testFileInfoDeleteForPost(t *testing.T, ss store.Store) {
 	userId := model.NewId()
 	postId := model.NewId()
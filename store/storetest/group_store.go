@@ -4,6 +4,7 @@
 package storetest
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"sort"
@@ -75,6 +76,8 @@ func TestGroupStore(t *testing.T, ss store.Store) {
 	t.Run("PermittedSyncableAdmins_Channel", func(t *testing.T) { groupTestPermittedSyncableAdminsChannel(t, ss) })
 	t.Run("UpdateMembersRole_Team", func(t *testing.T) { groupTestpUpdateMembersRoleTeam(t, ss) })
 	t.Run("UpdateMembersRole_Channel", func(t *testing.T) { groupTestpUpdateMembersRoleChannel(t, ss) })
+	t.Run("UpdateMembersRoleAndGetUpdated_Team", func(t *testing.T) { groupTestpUpdateMembersRoleAndGetUpdatedTeam(t, ss) })
+	t.Run("UpdateMembersRoleAndGetUpdated_Channel", func(t *testing.T) { groupTestpUpdateMembersRoleAndGetUpdatedChannel(t, ss) })
 
 	t.Run("GroupCount", func(t *testing.T) { groupTestGroupCount(t, ss) })
 	t.Run("GroupTeamCount", func(t *testing.T) { groupTestGroupTeamCount(t, ss) })
@@ -692,7 +695,7 @@ func testGroupGetMemberUsersInTeam(t *testing.T, ss store.Store) {
 		Email:       "success+" + model.NewId() + "@simulator.amazonses.com",
 		Type:        model.TEAM_OPEN,
 	}
-	team, err := ss.Team().Save(team)
+	team, err := ss.Team().Save(context.Background(), team)
 	require.Nil(t, err)
 
 	// Save a group
@@ -747,8 +750,8 @@ func testGroupGetMemberUsersInTeam(t *testing.T, ss store.Store) {
 	require.Equal(t, 0, len(groupMembers))
 
 	m1 := &model.TeamMember{TeamId: team.Id, UserId: user1.Id}
-	_, err = ss.Team().SaveMember(m1, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), m1, -1)
+	require.Nil(t, nErr)
 
 	// returns single member in team
 	groupMembers, err = ss.Group().GetMemberUsersInTeam(group.Id, team.Id)
@@ -757,10 +760,10 @@ func testGroupGetMemberUsersInTeam(t *testing.T, ss store.Store) {
 
 	m2 := &model.TeamMember{TeamId: team.Id, UserId: user2.Id}
 	m3 := &model.TeamMember{TeamId: team.Id, UserId: user3.Id}
-	_, err = ss.Team().SaveMember(m2, -1)
-	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(m3, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), m2, -1)
+	require.Nil(t, nErr)
+	_, nErr = ss.Team().SaveMember(context.Background(), m3, -1)
+	require.Nil(t, nErr)
 
 	// returns all members when all members are in team
 	groupMembers, err = ss.Group().GetMemberUsersInTeam(group.Id, team.Id)
@@ -778,7 +781,7 @@ func testGroupGetMemberUsersNotInChannel(t *testing.T, ss store.Store) {
 		Email:       "success+" + model.NewId() + "@simulator.amazonses.com",
 		Type:        model.TEAM_OPEN,
 	}
-	team, err := ss.Team().Save(team)
+	team, err := ss.Team().Save(context.Background(), team)
 	require.Nil(t, err)
 
 	// Save a group
@@ -843,8 +846,8 @@ func testGroupGetMemberUsersNotInChannel(t *testing.T, ss store.Store) {
 	require.Equal(t, 0, len(groupMembers))
 
 	m1 := &model.TeamMember{TeamId: team.Id, UserId: user1.Id}
-	_, err = ss.Team().SaveMember(m1, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), m1, -1)
+	require.Nil(t, nErr)
 
 	// returns single member in team and not in channel
 	groupMembers, err = ss.Group().GetMemberUsersNotInChannel(group.Id, channel.Id)
@@ -853,10 +856,10 @@ func testGroupGetMemberUsersNotInChannel(t *testing.T, ss store.Store) {
 
 	m2 := &model.TeamMember{TeamId: team.Id, UserId: user2.Id}
 	m3 := &model.TeamMember{TeamId: team.Id, UserId: user3.Id}
-	_, err = ss.Team().SaveMember(m2, -1)
-	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(m3, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), m2, -1)
+	require.Nil(t, nErr)
+	_, nErr = ss.Team().SaveMember(context.Background(), m3, -1)
+	require.Nil(t, nErr)
 
 	// returns all members when all members are in team and not in channel
 	groupMembers, err = ss.Group().GetMemberUsersNotInChannel(group.Id, channel.Id)
@@ -1071,8 +1074,8 @@ func testCreateGroupSyncable(t *testing.T, ss store.Store) {
 		Email:           "success+" + model.NewId() + "@simulator.amazonses.com",
 		Type:            model.TEAM_OPEN,
 	}
-	team, err := ss.Team().Save(t1)
-	require.Nil(t, err)
+	team, nErr := ss.Team().Save(context.Background(), t1)
+	require.Nil(t, nErr)
 
 	// New GroupSyncable, happy path
 	gt1 := model.NewGroupTeam(group.Id, team.Id, false)
@@ -1108,8 +1111,8 @@ func testGetGroupSyncable(t *testing.T, ss store.Store) {
 		Email:           "success+" + model.NewId() + "@simulator.amazonses.com",
 		Type:            model.TEAM_OPEN,
 	}
-	team, err := ss.Team().Save(t1)
-	require.Nil(t, err)
+	team, nErr := ss.Team().Save(context.Background(), t1)
+	require.Nil(t, nErr)
 
 	// Create GroupSyncable
 	gt1 := model.NewGroupTeam(group.Id, team.Id, false)
@@ -1157,8 +1160,8 @@ func testGetAllGroupSyncablesByGroup(t *testing.T, ss store.Store) {
 			Type:            model.TEAM_OPEN,
 		}
 		var team *model.Team
-		team, err = ss.Team().Save(t1)
-		require.Nil(t, err)
+		team, nErr := ss.Team().Save(context.Background(), t1)
+		require.Nil(t, nErr)
 
 		// create groupteam
 		var groupTeam *model.GroupSyncable
@@ -1208,8 +1211,8 @@ func testUpdateGroupSyncable(t *testing.T, ss store.Store) {
 		Email:           "success+" + model.NewId() + "@simulator.amazonses.com",
 		Type:            model.TEAM_OPEN,
 	}
-	team, err := ss.Team().Save(t1)
-	require.Nil(t, err)
+	team, nErr := ss.Team().Save(context.Background(), t1)
+	require.Nil(t, nErr)
 
 	// New GroupSyncable, happy path
 	gt1 := model.NewGroupTeam(group.Id, team.Id, false)
@@ -1274,8 +1277,8 @@ func testDeleteGroupSyncable(t *testing.T, ss store.Store) {
 		Email:           "success+" + model.NewId() + "@simulator.amazonses.com",
 		Type:            model.TEAM_OPEN,
 	}
-	team, err := ss.Team().Save(t1)
-	require.Nil(t, err)
+	team, nErr := ss.Team().Save(context.Background(), t1)
+	require.Nil(t, nErr)
 
 	// Create GroupSyncable
 	gt1 := model.NewGroupTeam(group.Id, team.Id, false)
@@ -1339,8 +1342,8 @@ func testTeamMembersToAdd(t *testing.T, ss store.Store) {
 		Email:           "success+" + model.NewId() + "@simulator.amazonses.com",
 		Type:            model.TEAM_OPEN,
 	}
-	team, err = ss.Team().Save(team)
-	require.Nil(t, err)
+	team, nErr := ss.Team().Save(context.Background(), team)
+	require.Nil(t, nErr)
 
 	// Create GroupTeam
 	syncable, err := ss.Group().CreateGroupSyncable(model.NewGroupTeam(group.Id, team.Id, true))
@@ -1416,16 +1419,16 @@ func testTeamMembersToAdd(t *testing.T, ss store.Store) {
 
 	// No result if Team deleted
 	team.DeleteAt = model.GetMillis()
-	team, err = ss.Team().Update(team)
-	require.Nil(t, err)
+	team, nErr = ss.Team().Update(context.Background(), team)
+	require.Nil(t, nErr)
 	teamMembers, err = ss.Group().TeamMembersToAdd(0, nil)
 	require.Nil(t, err)
 	require.Empty(t, teamMembers)
 
 	// reset state of team and verify
 	team.DeleteAt = 0
-	team, err = ss.Team().Update(team)
-	require.Nil(t, err)
+	team, nErr = ss.Team().Update(context.Background(), team)
+	require.Nil(t, nErr)
 	teamMembers, err = ss.Group().TeamMembersToAdd(0, nil)
 	require.Nil(t, err)
 	require.Len(t, teamMembers, 1)
@@ -1459,11 +1462,11 @@ func testTeamMembersToAdd(t *testing.T, ss store.Store) {
 	require.Len(t, teamMembers, 1)
 
 	// adding team membership stops returning result
-	_, err = ss.Team().SaveMember(&model.TeamMember{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{
 		TeamId: team.Id,
 		UserId: user.Id,
 	}, 999)
-	require.Nil(t, err)
+	require.Nil(t, nErr)
 	teamMembers, err = ss.Group().TeamMembersToAdd(0, nil)
 	require.Nil(t, err)
 	require.Empty(t, teamMembers)
@@ -1524,8 +1527,8 @@ func testTeamMembersToAddSingleTeam(t *testing.T, ss store.Store) {
 		Email:           "success+" + model.NewId() + "@simulator.amazonses.com",
 		Type:            model.TEAM_OPEN,
 	}
-	team1, err = ss.Team().Save(team1)
-	require.Nil(t, err)
+	team1, nErr := ss.Team().Save(context.Background(), team1)
+	require.Nil(t, nErr)
 
 	team2 := &model.Team{
 		DisplayName:     "Name",
@@ -1537,8 +1540,8 @@ func testTeamMembersToAddSingleTeam(t *testing.T, ss store.Store) {
 		Email:           "success+" + model.NewId() + "@simulator.amazonses.com",
 		Type:            model.TEAM_OPEN,
 	}
-	team2, err = ss.Team().Save(team2)
-	require.Nil(t, err)
+	team2, nErr = ss.Team().Save(context.Background(), team2)
+	require.Nil(t, nErr)
 
 	_, err = ss.Group().CreateGroupSyncable(model.NewGroupTeam(group1.Id, team1.Id, true))
 	require.Nil(t, err)
@@ -1869,11 +1872,11 @@ func testTeamMembersToRemove(t *testing.T, ss store.Store) {
 	require.Len(t, teamMembers, 3)
 
 	// add users back to groups
-	res := ss.Team().RemoveMember(data.ConstrainedTeam.Id, data.UserA.Id)
+	res := ss.Team().RemoveMember(context.Background(), data.ConstrainedTeam.Id, data.UserA.Id)
 	require.Nil(t, res)
-	res = ss.Team().RemoveMember(data.ConstrainedTeam.Id, data.UserB.Id)
+	res = ss.Team().RemoveMember(context.Background(), data.ConstrainedTeam.Id, data.UserB.Id)
 	require.Nil(t, res)
-	res = ss.Team().RemoveMember(data.ConstrainedTeam.Id, data.UserC.Id)
+	res = ss.Team().RemoveMember(context.Background(), data.ConstrainedTeam.Id, data.UserC.Id)
 	require.Nil(t, res)
 	err = ss.Channel().RemoveMember(data.ConstrainedChannel.Id, data.UserA.Id)
 	require.Nil(t, err)
@@ -1916,8 +1919,8 @@ func testTeamMembersToRemoveSingleTeam(t *testing.T, ss store.Store) {
 		Type:             model.TEAM_OPEN,
 		GroupConstrained: model.NewBool(true),
 	}
-	team1, err = ss.Team().Save(team1)
-	require.Nil(t, err)
+	team1, nErr := ss.Team().Save(context.Background(), team1)
+	require.Nil(t, nErr)
 
 	team2 := &model.Team{
 		DisplayName:      "Name",
@@ -1930,22 +1933,22 @@ func testTeamMembersToRemoveSingleTeam(t *testing.T, ss store.Store) {
 		Type:             model.TEAM_OPEN,
 		GroupConstrained: model.NewBool(true),
 	}
-	team2, err = ss.Team().Save(team2)
-	require.Nil(t, err)
+	team2, nErr = ss.Team().Save(context.Background(), team2)
+	require.Nil(t, nErr)
 
 	for _, user := range []*model.User{user1, user2} {
-		_, err = ss.Team().SaveMember(&model.TeamMember{
+		_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{
 			TeamId: team1.Id,
 			UserId: user.Id,
 		}, 999)
-		require.Nil(t, err)
+		require.Nil(t, nErr)
 	}
 
-	_, err = ss.Team().SaveMember(&model.TeamMember{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{
 		TeamId: team2.Id,
 		UserId: user3.Id,
 	}, 999)
-	require.Nil(t, err)
+	require.Nil(t, nErr)
 
 	teamMembers, err := ss.Group().TeamMembersToRemove(nil)
 	require.Nil(t, err)
@@ -2021,11 +2024,11 @@ func testChannelMembersToRemove(t *testing.T, ss store.Store) {
 	require.Len(t, channelMembers, 3)
 
 	// add users back to groups
-	res := ss.Team().RemoveMember(data.ConstrainedTeam.Id, data.UserA.Id)
+	res := ss.Team().RemoveMember(context.Background(), data.ConstrainedTeam.Id, data.UserA.Id)
 	require.Nil(t, res)
-	res = ss.Team().RemoveMember(data.ConstrainedTeam.Id, data.UserB.Id)
+	res = ss.Team().RemoveMember(context.Background(), data.ConstrainedTeam.Id, data.UserB.Id)
 	require.Nil(t, res)
-	res = ss.Team().RemoveMember(data.ConstrainedTeam.Id, data.UserC.Id)
+	res = ss.Team().RemoveMember(context.Background(), data.ConstrainedTeam.Id, data.UserC.Id)
 	require.Nil(t, res)
 	err = ss.Channel().RemoveMember(data.ConstrainedChannel.Id, data.UserA.Id)
 	require.Nil(t, err)
@@ -2189,8 +2192,8 @@ func pendingMemberRemovalsDataSetup(t *testing.T, ss store.Store) *removalsData
 		Type:             model.TEAM_INVITE,
 		GroupConstrained: model.NewBool(true),
 	}
-	teamConstrained, err = ss.Team().Save(teamConstrained)
-	require.Nil(t, err)
+	teamConstrained, nErr = ss.Team().Save(context.Background(), teamConstrained)
+	require.Nil(t, nErr)
 
 	teamUnconstrained := &model.Team{
 		DisplayName:     "Name",
@@ -2202,8 +2205,8 @@ func pendingMemberRemovalsDataSetup(t *testing.T, ss store.Store) *removalsData
 		Email:           "success+" + model.NewId() + "@simulator.amazonses.com",
 		Type:            model.TEAM_INVITE,
 	}
-	teamUnconstrained, err = ss.Team().Save(teamUnconstrained)
-	require.Nil(t, err)
+	teamUnconstrained, nErr = ss.Team().Save(context.Background(), teamUnconstrained)
+	require.Nil(t, nErr)
 
 	// create groupteams
 	_, err = ss.Group().CreateGroupSyncable(model.NewGroupTeam(group.Id, teamConstrained.Id, true))
@@ -2230,11 +2233,11 @@ func pendingMemberRemovalsDataSetup(t *testing.T, ss store.Store) *removalsData
 	}
 
 	for _, item := range userIDTeamIDs {
-		_, err = ss.Team().SaveMember(&model.TeamMember{
+		_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{
 			UserId: item[0],
 			TeamId: item[1],
 		}, 99)
-		require.Nil(t, err)
+		require.Nil(t, nErr)
 	}
 
 	// add users to channels
@@ -2514,7 +2517,7 @@ func testGetGroupsAssociatedToChannelsByTeam(t *testing.T, ss store.Store) {
 		Email:           "success+" + model.NewId() + "@simulator.amazonses.com",
 		Type:            model.TEAM_OPEN,
 	}
-	team1, errt := ss.Team().Save(team1)
+	team1, errt := ss.Team().Save(context.Background(), team1)
 	require.Nil(t, errt)
 
 	// Create Channel1
@@ -2757,7 +2760,7 @@ func testGetGroupsByTeam(t *testing.T, ss store.Store) {
 		Email:           "success+" + model.NewId() + "@simulator.amazonses.com",
 		Type:            model.TEAM_OPEN,
 	}
-	team1, err := ss.Team().Save(team1)
+	team1, err := ss.Team().Save(context.Background(), team1)
 	require.Nil(t, err)
 
 	// Create Groups 1, 2 and a deleted group
@@ -2811,7 +2814,7 @@ func testGetGroupsByTeam(t *testing.T, ss store.Store) {
 		Email:           "success+" + model.NewId() + "@simulator.amazonses.com",
 		Type:            model.TEAM_INVITE,
 	}
-	team2, err = ss.Team().Save(team2)
+	team2, err = ss.Team().Save(context.Background(), team2)
 	require.Nil(t, err)
 
 	// Create Group3
@@ -3004,7 +3007,7 @@ func testGetGroups(t *testing.T, ss store.Store) {
 		Type:             model.TEAM_OPEN,
 		GroupConstrained: model.NewBool(true),
 	}
-	team1, err := ss.Team().Save(team1)
+	team1, err := ss.Team().Save(context.Background(), team1)
 	require.Nil(t, err)
 
 	startCreateTime := team1.UpdateAt - 1
@@ -3070,7 +3073,7 @@ func testGetGroups(t *testing.T, ss store.Store) {
 		Email:           "success+" + model.NewId() + "@simulator.amazonses.com",
 		Type:            model.TEAM_INVITE,
 	}
-	team2, err = ss.Team().Save(team2)
+	team2, err = ss.Team().Save(context.Background(), team2)
 	require.Nil(t, err)
 
 	// Create Channel2
@@ -3174,7 +3177,7 @@ func testGetGroups(t *testing.T, ss store.Store) {
 		Email:           "success+" + model.NewId() + "@simulator.amazonses.com",
 		Type:            model.TEAM_INVITE,
 	}
-	team3, err = ss.Team().Save(team3)
+	team3, err = ss.Team().Save(context.Background(), team3)
 	require.Nil(t, err)
 
 	channel4 := &model.Channel{
@@ -3434,7 +3437,7 @@ func testTeamMembersMinusGroupMembers(t *testing.T, ss store.Store) {
 		Type:             model.TEAM_OPEN,
 		GroupConstrained: model.NewBool(true),
 	}
-	team, err := ss.Team().Save(team)
+	team, err := ss.Team().Save(context.Background(), team)
 	require.Nil(t, err)
 
 	for i := 0; i < numberOfUsers; i++ {
@@ -3447,8 +3450,8 @@ func testTeamMembersMinusGroupMembers(t *testing.T, ss store.Store) {
 		users = append(users, user)
 
 		trueOrFalse := int(math.Mod(float64(i), 2)) == 0
-		_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: team.Id, UserId: user.Id, SchemeUser: trueOrFalse, SchemeAdmin: !trueOrFalse}, 999)
-		require.Nil(t, err)
+		_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: team.Id, UserId: user.Id, SchemeUser: trueOrFalse, SchemeAdmin: !trueOrFalse}, 999)
+		require.Nil(t, nErr)
 	}
 
 	// Extra user outside of the group member users.
@@ -3459,8 +3462,8 @@ func testTeamMembersMinusGroupMembers(t *testing.T, ss store.Store) {
 	user, err = ss.User().Save(user)
 	require.Nil(t, err)
 	users = append(users, user)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: team.Id, UserId: user.Id, SchemeUser: true, SchemeAdmin: false}, 999)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: team.Id, UserId: user.Id, SchemeUser: true, SchemeAdmin: false}, 999)
+	require.Nil(t, nErr)
 
 	for i := 0; i < numberOfGroups; i++ {
 		group := &model.Group{
@@ -3900,8 +3903,8 @@ func groupTestAdminRoleGroupsForSyncableMemberTeam(t *testing.T, ss store.Store)
 		Name:        "zz" + model.NewId(),
 		Type:        model.CHANNEL_OPEN,
 	}
-	team, err = ss.Team().Save(team)
-	require.Nil(t, err)
+	team, nErr := ss.Team().Save(context.Background(), team)
+	require.Nil(t, nErr)
 
 	_, err = ss.Group().CreateGroupSyncable(&model.GroupSyncable{
 		AutoAdd:     true,
@@ -4003,8 +4006,8 @@ func groupTestPermittedSyncableAdminsTeam(t *testing.T, ss store.Store) {
 		Name:        "zz" + model.NewId(),
 		Type:        model.CHANNEL_OPEN,
 	}
-	team, err = ss.Team().Save(team)
-	require.Nil(t, err)
+	team, nErr := ss.Team().Save(context.Background(), team)
+	require.Nil(t, nErr)
 
 	_, err = ss.Group().CreateGroupSyncable(&model.GroupSyncable{
 		AutoAdd:     true,
@@ -4172,7 +4175,7 @@ func groupTestpUpdateMembersRoleTeam(t *testing.T, ss store.Store) {
 		Email:           "success+" + model.NewId() + "@simulator.amazonses.com",
 		Type:            model.TEAM_OPEN,
 	}
-	team, err := ss.Team().Save(team)
+	team, err := ss.Team().Save(context.Background(), team)
 	require.Nil(t, err)
 
 	user1 := &model.User{
@@ -4204,12 +4207,12 @@ func groupTestpUpdateMembersRoleTeam(t *testing.T, ss store.Store) {
 	require.Nil(t, err)
 
 	for _, user := range []*model.User{user1, user2, user3} {
-		_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: team.Id, UserId: user.Id}, 9999)
-		require.Nil(t, err)
+		_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: team.Id, UserId: user.Id}, 9999)
+		require.Nil(t, nErr)
 	}
 
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: team.Id, UserId: user4.Id, SchemeGuest: true}, 9999)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: team.Id, UserId: user4.Id, SchemeGuest: true}, 9999)
+	require.Nil(t, nErr)
 
 	tests := []struct {
 		testName               string
@@ -4240,10 +4243,10 @@ func groupTestpUpdateMembersRoleTeam(t *testing.T, ss store.Store) {
 
 	for _, tt := range tests {
 		t.Run(tt.testName, func(t *testing.T) {
-			err = ss.Team().UpdateMembersRole(team.Id, tt.inUserIDs)
+			err = ss.Team().UpdateMembersRole(context.Background(), team.Id, tt.inUserIDs)
 			require.Nil(t, err)
 
-			members, err := ss.Team().GetMembers(team.Id, 0, 100, nil)
+			members, err := ss.Team().GetMembers(context.Background(), team.Id, 0, 100, nil)
 			require.Nil(t, err)
 			require.GreaterOrEqual(t, len(members), 4) // sanity check for team membership
 
@@ -4404,7 +4407,7 @@ func groupTestGroupCount(t *testing.T, ss store.Store) {
 }
 
 func groupTestGroupTeamCount(t *testing.T, ss store.Store) {
-	team, err := ss.Team().Save(&model.Team{
+	team, err := ss.Team().Save(context.Background(), &model.Team{
 		DisplayName:     model.NewId(),
 		Description:     model.NewId(),
 		AllowOpenInvite: false,
@@ -4414,7 +4417,7 @@ func groupTestGroupTeamCount(t *testing.T, ss store.Store) {
 		Type:            model.TEAM_OPEN,
 	})
 	require.Nil(t, err)
-	defer ss.Team().PermanentDelete(team.Id)
+	defer ss.Team().PermanentDelete(context.Background(), team.Id)
 
 	group1, err := ss.Group().Create(&model.Group{
 		Name:        model.NewString(model.NewId()),
@@ -4598,3 +4601,147 @@ func groupTestGroupCountWithAllowReference(t *testing.T, ss store.Store) {
 	require.Nil(t, err)
 	require.Greater(t, countAfter, count)
 }
+
+func groupTestpUpdateMembersRoleAndGetUpdatedTeam(t *testing.T, ss store.Store) {
+	team := &model.Team{
+		DisplayName: "Name",
+		Name:        "z-z-" + model.NewId() + "a",
+		Email:       "success+" + model.NewId() + "@simulator.amazonses.com",
+		Type:        model.TEAM_OPEN,
+	}
+	team, err := ss.Team().Save(context.Background(), team)
+	require.Nil(t, err)
+
+	var userIDs []string
+	for i := 0; i < 3; i++ {
+		user, err := ss.User().Save(&model.User{Email: MakeEmail(), Username: model.NewId()})
+		require.Nil(t, err)
+		userIDs = append(userIDs, user.Id)
+
+		_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: team.Id, UserId: user.Id}, 9999)
+		require.Nil(t, nErr)
+	}
+
+	guest, err := ss.User().Save(&model.User{Email: MakeEmail(), Username: model.NewId()})
+	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: team.Id, UserId: guest.Id, SchemeGuest: true}, 9999)
+	require.Nil(t, nErr)
+
+	t.Run("returns the members promoted to admins", func(t *testing.T) {
+		members, err := ss.Team().UpdateMembersRoleAndGetUpdated(context.Background(), team.Id, []string{userIDs[0], userIDs[1], guest.Id})
+		require.Nil(t, err)
+		require.Len(t, members, 2)
+		for _, member := range members {
+			require.Contains(t, []string{userIDs[0], userIDs[1]}, member.UserId)
+			require.True(t, member.SchemeAdmin)
+			require.Contains(t, member.Roles, model.TEAM_ADMIN_ROLE_ID)
+		}
+	})
+
+	t.Run("returns only the members whose role changed", func(t *testing.T) {
+		members, err := ss.Team().UpdateMembersRoleAndGetUpdated(context.Background(), team.Id, []string{userIDs[1], userIDs[2]})
+		require.Nil(t, err)
+		require.Len(t, members, 2)
+		for _, member := range members {
+			switch member.UserId {
+			case userIDs[0]:
+				require.False(t, member.SchemeAdmin)
+			case userIDs[2]:
+				require.True(t, member.SchemeAdmin)
+			default:
+				require.Fail(t, "unexpected member", member.UserId)
+			}
+		}
+
+		member, nErr := ss.Team().GetMember(context.Background(), team.Id, userIDs[0], false)
+		require.Nil(t, nErr)
+		require.False(t, member.SchemeAdmin)
+	})
+
+	t.Run("returns nothing when no role changed", func(t *testing.T) {
+		members, err := ss.Team().UpdateMembersRoleAndGetUpdated(context.Background(), team.Id, []string{userIDs[1], userIDs[2]})
+		require.Nil(t, err)
+		require.Empty(t, members)
+	})
+
+	t.Run("demotes every member when no user is given", func(t *testing.T) {
+		members, err := ss.Team().UpdateMembersRoleAndGetUpdated(context.Background(), team.Id, []string{})
+		require.Nil(t, err)
+		require.Len(t, members, 2)
+		for _, member := range members {
+			require.False(t, member.SchemeAdmin)
+		}
+	})
+}
+
+func groupTestpUpdateMembersRoleAndGetUpdatedChannel(t *testing.T, ss store.Store) {
+	channel := &model.Channel{
+		TeamId:      model.NewId(),
+		DisplayName: "A Name",
+		Name:        model.NewId(),
+		Type:        model.CHANNEL_OPEN,
+	}
+	channel, err := ss.Channel().Save(channel, 9999)
+	require.Nil(t, err)
+
+	var userIDs []string
+	for i := 0; i < 3; i++ {
+		user, err := ss.User().Save(&model.User{Email: MakeEmail(), Username: model.NewId()})
+		require.Nil(t, err)
+		userIDs = append(userIDs, user.Id)
+
+		_, err = ss.Channel().SaveMember(&model.ChannelMember{
+			ChannelId:   channel.Id,
+			UserId:      user.Id,
+			NotifyProps: model.GetDefaultChannelNotifyProps(),
+		})
+		require.Nil(t, err)
+	}
+
+	guest, err := ss.User().Save(&model.User{Email: MakeEmail(), Username: model.NewId()})
+	require.Nil(t, err)
+	_, err = ss.Channel().SaveMember(&model.ChannelMember{
+		ChannelId:   channel.Id,
+		UserId:      guest.Id,
+		NotifyProps: model.GetDefaultChannelNotifyProps(),
+		SchemeGuest: true,
+	})
+	require.Nil(t, err)
+
+	t.Run("returns the members promoted to admins", func(t *testing.T) {
+		members, err := ss.Channel().UpdateMembersRoleAndGetUpdated(channel.Id, []string{userIDs[0], userIDs[1], guest.Id})
+		require.Nil(t, err)
+		require.Len(t, members, 2)
+		for _, member := range members {
+			require.Contains(t, []string{userIDs[0], userIDs[1]}, member.UserId)
+			require.True(t, member.SchemeAdmin)
+			require.Contains(t, member.Roles, model.CHANNEL_ADMIN_ROLE_ID)
+		}
+	})
+
+	t.Run("returns only the members whose role changed", func(t *testing.T) {
+		members, err := ss.Channel().UpdateMembersRoleAndGetUpdated(channel.Id, []string{userIDs[1], userIDs[2]})
+		require.Nil(t, err)
+		require.Len(t, members, 2)
+		for _, member := range members {
+			switch member.UserId {
+			case userIDs[0]:
+				require.False(t, member.SchemeAdmin)
+			case userIDs[2]:
+				require.True(t, member.SchemeAdmin)
+			default:
+				require.Fail(t, "unexpected member", member.UserId)
+			}
+		}
+
+		member, err := ss.Channel().GetMember(channel.Id, userIDs[0])
+		require.Nil(t, err)
+		require.False(t, member.SchemeAdmin)
+	})
+
+	t.Run("returns nothing when no role changed", func(t *testing.T) {
+		members, err := ss.Channel().UpdateMembersRoleAndGetUpdated(channel.Id, []string{userIDs[1], userIDs[2]})
+		require.Nil(t, err)
+		require.Empty(t, members)
+	})
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+func TestIntegrationUsageStore(t *testing.T, ss store.Store) {
+	t.Run("Increment", func(t *testing.T) { testIntegrationUsageStoreIncrement(t, ss) })
+	t.Run("GetForPeriod", func(t *testing.T) { testIntegrationUsageStoreGetForPeriod(t, ss) })
+	t.Run("PermanentDeleteBatch", func(t *testing.T) { testIntegrationUsageStorePermanentDeleteBatch(t, ss) })
+}
+
+func filterIntegrationUsage(usages []*model.IntegrationUsage, integrationId string) []*model.IntegrationUsage {
+	var filtered []*model.IntegrationUsage
+	for _, usage := range usages {
+		if usage.IntegrationId == integrationId {
+			filtered = append(filtered, usage)
+		}
+	}
+	return filtered
+}
+
+func testIntegrationUsageStoreIncrement(t *testing.T, ss store.Store) {
+	day := model.IntegrationUsageDay(model.GetMillis())
+
+	t.Run("should add to the counters of the day", func(t *testing.T) {
+		integrationId := model.NewId()
+
+		require.Nil(t, ss.IntegrationUsage().Increment(&model.IntegrationUsage{IntegrationId: integrationId, Type: model.INTEGRATION_USAGE_TYPE_OUTGOING_WEBHOOK, Day: day, Deliveries: 2}))
+		require.Nil(t, ss.IntegrationUsage().Increment(&model.IntegrationUsage{IntegrationId: integrationId, Type: model.INTEGRATION_USAGE_TYPE_OUTGOING_WEBHOOK, Day: day, Deliveries: 3, Failures: 1}))
+
+		usages, err := ss.IntegrationUsage().GetForPeriod(day, day)
+		require.Nil(t, err)
+		usages = filterIntegrationUsage(usages, integrationId)
+		require.Len(t, usages, 1)
+		assert.Equal(t, model.INTEGRATION_USAGE_TYPE_OUTGOING_WEBHOOK, usages[0].Type)
+		assert.Equal(t, int64(5), usages[0].Deliveries)
+		assert.Equal(t, int64(1), usages[0].Failures)
+		assert.Zero(t, usages[0].Posts)
+	})
+
+	t.Run("should fail on invalid usage", func(t *testing.T) {
+		err := ss.IntegrationUsage().Increment(&model.IntegrationUsage{IntegrationId: model.NewId(), Type: "invalid", Day: day})
+		require.NotNil(t, err)
+	})
+}
+
+func testIntegrationUsageStoreGetForPeriod(t *testing.T, ss store.Store) {
+	integrationId := model.NewId()
+	day := model.IntegrationUsageDay(model.GetMillis())
+
+	for i := int64(0); i < 3; i++ {
+		require.Nil(t, ss.IntegrationUsage().Increment(&model.IntegrationUsage{
+			IntegrationId: integrationId,
+			Type:          model.INTEGRATION_USAGE_TYPE_BOT,
+			Day:           day - i*model.INTEGRATION_USAGE_DAY_MILLIS,
+			Posts:         i + 1,
+		}))
+	}
+
+	usages, err := ss.IntegrationUsage().GetForPeriod(day-model.INTEGRATION_USAGE_DAY_MILLIS, day)
+	require.Nil(t, err)
+	usages = filterIntegrationUsage(usages, integrationId)
+	require.Len(t, usages, 2)
+	assert.Equal(t, day-model.INTEGRATION_USAGE_DAY_MILLIS, usages[0].Day)
+	assert.Equal(t, int64(2), usages[0].Posts)
+	assert.Equal(t, day, usages[1].Day)
+	assert.Equal(t, int64(1), usages[1].Posts)
+}
+
+func testIntegrationUsageStorePermanentDeleteBatch(t *testing.T, ss store.Store) {
+	integrationId := model.NewId()
+	oldDay := int64(model.INTEGRATION_USAGE_DAY_MILLIS)
+	day := model.IntegrationUsageDay(model.GetMillis())
+
+	require.Nil(t, ss.IntegrationUsage().Increment(&model.IntegrationUsage{IntegrationId: integrationId, Type: model.INTEGRATION_USAGE_TYPE_COMMAND, Day: oldDay, Executions: 1}))
+	require.Nil(t, ss.IntegrationUsage().Increment(&model.IntegrationUsage{IntegrationId: integrationId, Type: model.INTEGRATION_USAGE_TYPE_COMMAND, Day: day, Executions: 1}))
+
+	deleted, err := ss.IntegrationUsage().PermanentDeleteBatch(oldDay+model.INTEGRATION_USAGE_DAY_MILLIS, 1000)
+	require.Nil(t, err)
+	assert.GreaterOrEqual(t, deleted, int64(1))
+
+	usages, err := ss.IntegrationUsage().GetForPeriod(oldDay, day)
+	require.Nil(t, err)
+	usages = filterIntegrationUsage(usages, integrationId)
+	require.Len(t, usages, 1)
+	assert.Equal(t, day, usages[0].Day)
+}
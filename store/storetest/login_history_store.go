@@ -0,0 +1,133 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+func TestLoginHistoryStore(t *testing.T, ss store.Store) {
+	t.Run("Save", func(t *testing.T) { testLoginHistoryStoreSave(t, ss) })
+	t.Run("Get", func(t *testing.T) { testLoginHistoryStoreGet(t, ss) })
+	t.Run("PermanentDeleteBatch", func(t *testing.T) { testLoginHistoryStorePermanentDeleteBatch(t, ss) })
+	t.Run("PermanentDeleteByUser", func(t *testing.T) { testLoginHistoryStorePermanentDeleteByUser(t, ss) })
+}
+
+func testLoginHistoryStoreSave(t *testing.T, ss store.Store) {
+	entry, err := ss.LoginHistory().Save(&model.LoginHistory{
+		UserId:    model.NewId(),
+		IpAddress: "127.0.0.1",
+		UserAgent: "Mozilla/5.0",
+		Success:   true,
+	})
+	require.Nil(t, err)
+	assert.NotEmpty(t, entry.Id)
+	assert.Equal(t, model.USER_AUTH_SERVICE_EMAIL, entry.AuthService)
+
+	_, err = ss.LoginHistory().Save(entry)
+	require.NotNil(t, err, "should not save an entry twice")
+
+	_, err = ss.LoginHistory().Save(&model.LoginHistory{UserId: "junk"})
+	require.NotNil(t, err)
+}
+
+func testLoginHistoryStoreGet(t *testing.T, ss store.Store) {
+	userId := model.NewId()
+	save := func(createAt int64, success bool) *model.LoginHistory {
+		entry := &model.LoginHistory{UserId: userId, CreateAt: createAt, Success: success}
+		if !success {
+			entry.FailureReason = "api.user.check_user_password.invalid.app_error"
+		}
+		entry, err := ss.LoginHistory().Save(entry)
+		require.Nil(t, err)
+		return entry
+	}
+
+	first := save(1000, true)
+	second := save(2000, false)
+	third := save(3000, true)
+	_, err := ss.LoginHistory().Save(&model.LoginHistory{UserId: model.NewId(), CreateAt: 2500, Success: true})
+	require.Nil(t, err)
+
+	getIds := func(options *model.LoginHistoryGetOptions) []string {
+		entries, err := ss.LoginHistory().Get(options)
+		require.Nil(t, err)
+
+		ids := []string{}
+		for _, entry := range entries {
+			ids = append(ids, entry.Id)
+		}
+		return ids
+	}
+
+	t.Run("newest first", func(t *testing.T) {
+		ids := getIds(&model.LoginHistoryGetOptions{UserId: userId, PerPage: 10})
+		assert.Equal(t, []string{third.Id, second.Id, first.Id}, ids)
+	})
+
+	t.Run("paging", func(t *testing.T) {
+		ids := getIds(&model.LoginHistoryGetOptions{UserId: userId, Page: 1, PerPage: 2})
+		assert.Equal(t, []string{first.Id}, ids)
+	})
+
+	t.Run("time range", func(t *testing.T) {
+		ids := getIds(&model.LoginHistoryGetOptions{UserId: userId, Since: 2000, Until: 3000, PerPage: 10})
+		assert.Equal(t, []string{second.Id}, ids)
+	})
+
+	t.Run("only failures", func(t *testing.T) {
+		ids := getIds(&model.LoginHistoryGetOptions{UserId: userId, OnlyFailures: true, PerPage: 10})
+		assert.Equal(t, []string{second.Id}, ids)
+	})
+}
+
+func testLoginHistoryStorePermanentDeleteBatch(t *testing.T, ss store.Store) {
+	// Clear the old entries left by other tests.
+	_, err := ss.LoginHistory().PermanentDeleteBatch(2500, 1000)
+	require.Nil(t, err)
+
+	userId := model.NewId()
+	for _, createAt := range []int64{1000, 2000, 3000} {
+		_, err := ss.LoginHistory().Save(&model.LoginHistory{UserId: userId, CreateAt: createAt, Success: true})
+		require.Nil(t, err)
+	}
+
+	deleted, err := ss.LoginHistory().PermanentDeleteBatch(2500, 1)
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	deleted, err = ss.LoginHistory().PermanentDeleteBatch(2500, 10)
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	entries, err := ss.LoginHistory().Get(&model.LoginHistoryGetOptions{UserId: userId, PerPage: 10})
+	require.Nil(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, int64(3000), entries[0].CreateAt)
+}
+
+func testLoginHistoryStorePermanentDeleteByUser(t *testing.T, ss store.Store) {
+	userId := model.NewId()
+	otherUserId := model.NewId()
+	for _, id := range []string{userId, otherUserId} {
+		_, err := ss.LoginHistory().Save(&model.LoginHistory{UserId: id, Success: true})
+		require.Nil(t, err)
+	}
+
+	require.Nil(t, ss.LoginHistory().PermanentDeleteByUser(userId))
+
+	entries, err := ss.LoginHistory().Get(&model.LoginHistoryGetOptions{UserId: userId, PerPage: 10})
+	require.Nil(t, err)
+	assert.Empty(t, entries)
+
+	entries, err = ss.LoginHistory().Get(&model.LoginHistoryGetOptions{UserId: otherUserId, PerPage: 10})
+	require.Nil(t, err)
+	assert.Len(t, entries, 1)
+}
@@ -1026,6 +1026,38 @@ func (_m *ChannelStore) GetMembersForUser(teamId string, userId string) (*model.
 	return r0, r1
 }
 
+// GetMembersForUserWithCursor provides a mock function with given fields: teamId, userId, cursor, limit
+func (_m *ChannelStore) GetMembersForUserWithCursor(teamId string, userId string, cursor model.ChannelMembersCursor, limit int) (*model.ChannelMembersPage, model.ChannelMembersCursor, *model.AppError) {
+	ret := _m.Called(teamId, userId, cursor, limit)
+
+	var r0 *model.ChannelMembersPage
+	if rf, ok := ret.Get(0).(func(string, string, model.ChannelMembersCursor, int) *model.ChannelMembersPage); ok {
+		r0 = rf(teamId, userId, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ChannelMembersPage)
+		}
+	}
+
+	var r1 model.ChannelMembersCursor
+	if rf, ok := ret.Get(1).(func(string, string, model.ChannelMembersCursor, int) model.ChannelMembersCursor); ok {
+		r1 = rf(teamId, userId, cursor, limit)
+	} else {
+		r1 = ret.Get(1).(model.ChannelMembersCursor)
+	}
+
+	var r2 *model.AppError
+	if rf, ok := ret.Get(2).(func(string, string, model.ChannelMembersCursor, int) *model.AppError); ok {
+		r2 = rf(teamId, userId, cursor, limit)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(*model.AppError)
+		}
+	}
+
+	return r0, r1, r2
+}
+
 // GetMembersForUserWithPagination provides a mock function with given fields: teamId, userId, page, perPage
 func (_m *ChannelStore) GetMembersForUserWithPagination(teamId string, userId string, page int, perPage int) (*model.ChannelMembers, *model.AppError) {
 	ret := _m.Called(teamId, userId, page, perPage)
@@ -1051,6 +1083,31 @@ func (_m *ChannelStore) GetMembersForUserWithPagination(teamId string, userId st
 	return r0, r1
 }
 
+// GetMembersWithExpiredRoles provides a mock function with given fields: expiredBefore, limit
+func (_m *ChannelStore) GetMembersWithExpiredRoles(expiredBefore int64, limit int) ([]*model.ChannelMember, *model.AppError) {
+	ret := _m.Called(expiredBefore, limit)
+
+	var r0 []*model.ChannelMember
+	if rf, ok := ret.Get(0).(func(int64, int) []*model.ChannelMember); ok {
+		r0 = rf(expiredBefore, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ChannelMember)
+		}
+	}
+
+	var r1 *model.AppError
+	if rf, ok := ret.Get(1).(func(int64, int) *model.AppError); ok {
+		r1 = rf(expiredBefore, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // GetMoreChannels provides a mock function with given fields: teamId, userId, offset, limit
 func (_m *ChannelStore) GetMoreChannels(teamId string, userId string, offset int, limit int) (*model.ChannelList, error) {
 	ret := _m.Called(teamId, userId, offset, limit)
@@ -1320,13 +1377,13 @@ func (_m *ChannelStore) GroupSyncedChannelCount() (int64, *model.AppError) {
 	return r0, r1
 }
 
-// IncrementMentionCount provides a mock function with given fields: channelId, userId
-func (_m *ChannelStore) IncrementMentionCount(channelId string, userId string) *model.AppError {
-	ret := _m.Called(channelId, userId)
+// IncrementMentionCount provides a mock function with given fields: channelId, userId, isUrgent
+func (_m *ChannelStore) IncrementMentionCount(channelId string, userId string, isUrgent bool) *model.AppError {
+	ret := _m.Called(channelId, userId, isUrgent)
 
 	var r0 *model.AppError
-	if rf, ok := ret.Get(0).(func(string, string) *model.AppError); ok {
-		r0 = rf(channelId, userId)
+	if rf, ok := ret.Get(0).(func(string, string, bool) *model.AppError); ok {
+		r0 = rf(channelId, userId, isUrgent)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.AppError)
@@ -1433,6 +1490,29 @@ func (_m *ChannelStore) MigrateFavoritesToSidebarChannels(lastUserId string, run
 	return r0, r1
 }
 
+// MigrateMsgCountRoot provides a mock function with given fields: fromChannelId, fromUserId
+func (_m *ChannelStore) MigrateMsgCountRoot(fromChannelId string, fromUserId string) (map[string]string, error) {
+	ret := _m.Called(fromChannelId, fromUserId)
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func(string, string) map[string]string); ok {
+		r0 = rf(fromChannelId, fromUserId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(fromChannelId, fromUserId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // MigratePublicChannels provides a mock function with given fields:
 func (_m *ChannelStore) MigratePublicChannels() error {
 	ret := _m.Called()
@@ -1470,6 +1550,29 @@ func (_m *ChannelStore) MigrateSidebarCategories(fromTeamId string, fromUserId s
 	return r0, r1
 }
 
+// MigrateTotalMsgCountRoot provides a mock function with given fields: fromChannelId
+func (_m *ChannelStore) MigrateTotalMsgCountRoot(fromChannelId string) (map[string]string, error) {
+	ret := _m.Called(fromChannelId)
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func(string) map[string]string); ok {
+		r0 = rf(fromChannelId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(fromChannelId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PermanentDelete provides a mock function with given fields: channelId
 func (_m *ChannelStore) PermanentDelete(channelId string) error {
 	ret := _m.Called(channelId)
@@ -1989,6 +2092,31 @@ func (_m *ChannelStore) UpdateMembersRole(channelID string, userIDs []string) *m
 	return r0
 }
 
+// UpdateMembersRoleAndGetUpdated provides a mock function with given fields: channelID, userIDs
+func (_m *ChannelStore) UpdateMembersRoleAndGetUpdated(channelID string, userIDs []string) ([]*model.ChannelMember, *model.AppError) {
+	ret := _m.Called(channelID, userIDs)
+
+	var r0 []*model.ChannelMember
+	if rf, ok := ret.Get(0).(func(string, []string) []*model.ChannelMember); ok {
+		r0 = rf(channelID, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ChannelMember)
+		}
+	}
+
+	var r1 *model.AppError
+	if rf, ok := ret.Get(1).(func(string, []string) *model.AppError); ok {
+		r1 = rf(channelID, userIDs)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // UpdateMultipleMembers provides a mock function with given fields: members
 func (_m *ChannelStore) UpdateMultipleMembers(members []*model.ChannelMember) ([]*model.ChannelMember, *model.AppError) {
 	ret := _m.Called(members)
@@ -74,13 +74,13 @@ func (_m *EmojiStore) GetByName(name string, allowFromCache bool) (*model.Emoji,
 	return r0, r1
 }
 
-// GetList provides a mock function with given fields: offset, limit, sort
-func (_m *EmojiStore) GetList(offset int, limit int, sort string) ([]*model.Emoji, error) {
-	ret := _m.Called(offset, limit, sort)
+// GetList provides a mock function with given fields: offset, limit, sort, teamIds
+func (_m *EmojiStore) GetList(offset int, limit int, sort string, teamIds []string) ([]*model.Emoji, error) {
+	ret := _m.Called(offset, limit, sort, teamIds)
 
 	var r0 []*model.Emoji
-	if rf, ok := ret.Get(0).(func(int, int, string) []*model.Emoji); ok {
-		r0 = rf(offset, limit, sort)
+	if rf, ok := ret.Get(0).(func(int, int, string, []string) []*model.Emoji); ok {
+		r0 = rf(offset, limit, sort, teamIds)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.Emoji)
@@ -88,8 +88,8 @@ func (_m *EmojiStore) GetList(offset int, limit int, sort string) ([]*model.Emoj
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int, int, string) error); ok {
-		r1 = rf(offset, limit, sort)
+	if rf, ok := ret.Get(1).(func(int, int, string, []string) error); ok {
+		r1 = rf(offset, limit, sort, teamIds)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -143,13 +143,13 @@ func (_m *EmojiStore) Save(emoji *model.Emoji) (*model.Emoji, error) {
 	return r0, r1
 }
 
-// Search provides a mock function with given fields: name, prefixOnly, limit
-func (_m *EmojiStore) Search(name string, prefixOnly bool, limit int) ([]*model.Emoji, error) {
-	ret := _m.Called(name, prefixOnly, limit)
+// Search provides a mock function with given fields: name, prefixOnly, limit, teamIds
+func (_m *EmojiStore) Search(name string, prefixOnly bool, limit int, teamIds []string) ([]*model.Emoji, error) {
+	ret := _m.Called(name, prefixOnly, limit, teamIds)
 
 	var r0 []*model.Emoji
-	if rf, ok := ret.Get(0).(func(string, bool, int) []*model.Emoji); ok {
-		r0 = rf(name, prefixOnly, limit)
+	if rf, ok := ret.Get(0).(func(string, bool, int, []string) []*model.Emoji); ok {
+		r0 = rf(name, prefixOnly, limit, teamIds)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.Emoji)
@@ -157,8 +157,8 @@ func (_m *EmojiStore) Search(name string, prefixOnly bool, limit int) ([]*model.
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, bool, int) error); ok {
-		r1 = rf(name, prefixOnly, limit)
+	if rf, ok := ret.Get(1).(func(string, bool, int, []string) error); ok {
+		r1 = rf(name, prefixOnly, limit, teamIds)
 	} else {
 		r1 = ret.Error(1)
 	}
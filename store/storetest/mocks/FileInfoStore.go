@@ -274,3 +274,35 @@ func (_m *FileInfoStore) Save(info *model.FileInfo) (*model.FileInfo, *model.App
 
 	return r0, r1
 }
+
+// SetSensitive provides a mock function with given fields: fileId, sensitive
+func (_m *FileInfoStore) SetSensitive(fileId string, sensitive bool) *model.AppError {
+	ret := _m.Called(fileId, sensitive)
+
+	var r0 *model.AppError
+	if rf, ok := ret.Get(0).(func(string, bool) *model.AppError); ok {
+		r0 = rf(fileId, sensitive)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AppError)
+		}
+	}
+
+	return r0
+}
+
+// UpdateEnrichment provides a mock function with given fields: info
+func (_m *FileInfoStore) UpdateEnrichment(info *model.FileInfo) *model.AppError {
+	ret := _m.Called(info)
+
+	var r0 *model.AppError
+	if rf, ok := ret.Get(0).(func(*model.FileInfo) *model.AppError); ok {
+		r0 = rf(info)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AppError)
+		}
+	}
+
+	return r0
+}
@@ -14,20 +14,20 @@ type PostStore struct {
 	mock.Mock
 }
 
-// AnalyticsPostCount provides a mock function with given fields: teamId, mustHaveFile, mustHaveHashtag
-func (_m *PostStore) AnalyticsPostCount(teamId string, mustHaveFile bool, mustHaveHashtag bool) (int64, *model.AppError) {
-	ret := _m.Called(teamId, mustHaveFile, mustHaveHashtag)
+// AnalyticsPostCount provides a mock function with given fields: teamId, mustHaveFile, mustHaveHashtag, estimate
+func (_m *PostStore) AnalyticsPostCount(teamId string, mustHaveFile bool, mustHaveHashtag bool, estimate bool) (int64, *model.AppError) {
+	ret := _m.Called(teamId, mustHaveFile, mustHaveHashtag, estimate)
 
 	var r0 int64
-	if rf, ok := ret.Get(0).(func(string, bool, bool) int64); ok {
-		r0 = rf(teamId, mustHaveFile, mustHaveHashtag)
+	if rf, ok := ret.Get(0).(func(string, bool, bool, bool) int64); ok {
+		r0 = rf(teamId, mustHaveFile, mustHaveHashtag, estimate)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(string, bool, bool) *model.AppError); ok {
-		r1 = rf(teamId, mustHaveFile, mustHaveHashtag)
+	if rf, ok := ret.Get(1).(func(string, bool, bool, bool) *model.AppError); ok {
+		r1 = rf(teamId, mustHaveFile, mustHaveHashtag, estimate)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -108,6 +108,31 @@ func (_m *PostStore) Delete(postId string, time int64, deleteByID string) *model
 	return r0
 }
 
+// DeleteWithOutboxEvent provides a mock function with given fields: postId, time, deleteByID, message
+func (_m *PostStore) DeleteWithOutboxEvent(postId string, time int64, deleteByID string, message *model.WebSocketEvent) (*model.OutboxEvent, *model.AppError) {
+	ret := _m.Called(postId, time, deleteByID, message)
+
+	var r0 *model.OutboxEvent
+	if rf, ok := ret.Get(0).(func(string, int64, string, *model.WebSocketEvent) *model.OutboxEvent); ok {
+		r0 = rf(postId, time, deleteByID, message)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OutboxEvent)
+		}
+	}
+
+	var r1 *model.AppError
+	if rf, ok := ret.Get(1).(func(string, int64, string, *model.WebSocketEvent) *model.AppError); ok {
+		r1 = rf(postId, time, deleteByID, message)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // Get provides a mock function with given fields: id, skipFetchThreads
 func (_m *PostStore) Get(id string, skipFetchThreads bool) (*model.PostList, *model.AppError) {
 	ret := _m.Called(id, skipFetchThreads)
@@ -555,6 +580,63 @@ func (_m *PostStore) GetPostsCreatedAt(channelId string, time int64) ([]*model.P
 	return r0, r1
 }
 
+// GetPostsDelta provides a mock function with given fields: cursor, limit
+func (_m *PostStore) GetPostsDelta(cursor model.PostsDeltaCursor, limit int) (*model.PostsDelta, model.PostsDeltaCursor, *model.AppError) {
+	ret := _m.Called(cursor, limit)
+
+	var r0 *model.PostsDelta
+	if rf, ok := ret.Get(0).(func(model.PostsDeltaCursor, int) *model.PostsDelta); ok {
+		r0 = rf(cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PostsDelta)
+		}
+	}
+
+	var r1 model.PostsDeltaCursor
+	if rf, ok := ret.Get(1).(func(model.PostsDeltaCursor, int) model.PostsDeltaCursor); ok {
+		r1 = rf(cursor, limit)
+	} else {
+		r1 = ret.Get(1).(model.PostsDeltaCursor)
+	}
+
+	var r2 *model.AppError
+	if rf, ok := ret.Get(2).(func(model.PostsDeltaCursor, int) *model.AppError); ok {
+		r2 = rf(cursor, limit)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(*model.AppError)
+		}
+	}
+
+	return r0, r1, r2
+}
+
+// GetPostsForChannelSnapshot provides a mock function with given fields: channelId, cursor, limit
+func (_m *PostStore) GetPostsForChannelSnapshot(channelId string, cursor model.ChannelSnapshotCursor, limit int) ([]*model.Post, *model.AppError) {
+	ret := _m.Called(channelId, cursor, limit)
+
+	var r0 []*model.Post
+	if rf, ok := ret.Get(0).(func(string, model.ChannelSnapshotCursor, int) []*model.Post); ok {
+		r0 = rf(channelId, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Post)
+		}
+	}
+
+	var r1 *model.AppError
+	if rf, ok := ret.Get(1).(func(string, model.ChannelSnapshotCursor, int) *model.AppError); ok {
+		r1 = rf(channelId, cursor, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // GetPostsSince provides a mock function with given fields: options, allowFromCache
 func (_m *PostStore) GetPostsSince(options model.GetPostsSinceOptions, allowFromCache bool) (*model.PostList, *model.AppError) {
 	ret := _m.Called(options, allowFromCache)
@@ -804,6 +886,40 @@ func (_m *PostStore) SaveMultiple(posts []*model.Post) ([]*model.Post, int, *mod
 	return r0, r1, r2
 }
 
+// SaveWithOutboxEvent provides a mock function with given fields: post, message
+func (_m *PostStore) SaveWithOutboxEvent(post *model.Post, message *model.WebSocketEvent) (*model.Post, *model.OutboxEvent, *model.AppError) {
+	ret := _m.Called(post, message)
+
+	var r0 *model.Post
+	if rf, ok := ret.Get(0).(func(*model.Post, *model.WebSocketEvent) *model.Post); ok {
+		r0 = rf(post, message)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Post)
+		}
+	}
+
+	var r1 *model.OutboxEvent
+	if rf, ok := ret.Get(1).(func(*model.Post, *model.WebSocketEvent) *model.OutboxEvent); ok {
+		r1 = rf(post, message)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.OutboxEvent)
+		}
+	}
+
+	var r2 *model.AppError
+	if rf, ok := ret.Get(2).(func(*model.Post, *model.WebSocketEvent) *model.AppError); ok {
+		r2 = rf(post, message)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(*model.AppError)
+		}
+	}
+
+	return r0, r1, r2
+}
+
 // Search provides a mock function with given fields: teamId, userId, params
 func (_m *PostStore) Search(teamId string, userId string, params *model.SearchParams) (*model.PostList, *model.AppError) {
 	ret := _m.Called(teamId, userId, params)
@@ -878,3 +994,37 @@ func (_m *PostStore) Update(newPost *model.Post, oldPost *model.Post) (*model.Po
 
 	return r0, r1
 }
+
+// UpdateWithOutboxEvent provides a mock function with given fields: newPost, oldPost, message
+func (_m *PostStore) UpdateWithOutboxEvent(newPost *model.Post, oldPost *model.Post, message *model.WebSocketEvent) (*model.Post, *model.OutboxEvent, *model.AppError) {
+	ret := _m.Called(newPost, oldPost, message)
+
+	var r0 *model.Post
+	if rf, ok := ret.Get(0).(func(*model.Post, *model.Post, *model.WebSocketEvent) *model.Post); ok {
+		r0 = rf(newPost, oldPost, message)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Post)
+		}
+	}
+
+	var r1 *model.OutboxEvent
+	if rf, ok := ret.Get(1).(func(*model.Post, *model.Post, *model.WebSocketEvent) *model.OutboxEvent); ok {
+		r1 = rf(newPost, oldPost, message)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.OutboxEvent)
+		}
+	}
+
+	var r2 *model.AppError
+	if rf, ok := ret.Get(2).(func(*model.Post, *model.Post, *model.WebSocketEvent) *model.AppError); ok {
+		r2 = rf(newPost, oldPost, message)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(*model.AppError)
+		}
+	}
+
+	return r0, r1, r2
+}
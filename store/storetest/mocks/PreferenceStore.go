@@ -6,6 +6,7 @@ package mocks
 
 import (
 	model "github.com/mattermost/mattermost-server/v5/model"
+	store "github.com/mattermost/mattermost-server/v5/store"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -176,6 +177,29 @@ func (_m *PreferenceStore) PermanentDeleteByUser(userId string) *model.AppError
 	return r0
 }
 
+// RenameCategory provides a mock function with given fields: oldCategory, newCategory, transform, limit
+func (_m *PreferenceStore) RenameCategory(oldCategory string, newCategory string, transform store.PreferenceTransform, limit int64) (int64, *model.AppError) {
+	ret := _m.Called(oldCategory, newCategory, transform, limit)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string, string, store.PreferenceTransform, int64) int64); ok {
+		r0 = rf(oldCategory, newCategory, transform, limit)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 *model.AppError
+	if rf, ok := ret.Get(1).(func(string, string, store.PreferenceTransform, int64) *model.AppError); ok {
+		r1 = rf(oldCategory, newCategory, transform, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // Save provides a mock function with given fields: preferences
 func (_m *PreferenceStore) Save(preferences *model.Preferences) *model.AppError {
 	ret := _m.Called(preferences)
@@ -14,20 +14,20 @@ type SessionStore struct {
 	mock.Mock
 }
 
-// AnalyticsSessionCount provides a mock function with given fields:
-func (_m *SessionStore) AnalyticsSessionCount() (int64, error) {
-	ret := _m.Called()
+// AnalyticsSessionCount provides a mock function with given fields: estimate
+func (_m *SessionStore) AnalyticsSessionCount(estimate bool) (int64, error) {
+	ret := _m.Called(estimate)
 
 	var r0 int64
-	if rf, ok := ret.Get(0).(func() int64); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(bool) int64); ok {
+		r0 = rf(estimate)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(bool) error); ok {
+		r1 = rf(estimate)
 	} else {
 		r1 = ret.Error(1)
 	}
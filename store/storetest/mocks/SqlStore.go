@@ -219,6 +219,20 @@ func (_m *SqlStore) CreateCompositeIndexIfNotExists(indexName string, tableName
 	return r0
 }
 
+// CreateForeignKeyIfNotExists provides a mock function with given fields: constraintName, tableName, columnName, refTableName, refColumnName, onDelete
+func (_m *SqlStore) CreateForeignKeyIfNotExists(constraintName string, tableName string, columnName string, refTableName string, refColumnName string, onDelete string) bool {
+	ret := _m.Called(constraintName, tableName, columnName, refTableName, refColumnName, onDelete)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, string, string, string, string, string) bool); ok {
+		r0 = rf(constraintName, tableName, columnName, refTableName, refColumnName, onDelete)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // CreateFullTextIndexIfNotExists provides a mock function with given fields: indexName, tableName, columnName
 func (_m *SqlStore) CreateFullTextIndexIfNotExists(indexName string, tableName string, columnName string) bool {
 	ret := _m.Called(indexName, tableName, columnName)
@@ -303,6 +317,20 @@ func (_m *SqlStore) DoesTableExist(tablename string) bool {
 	return r0
 }
 
+// DoesForeignKeyExist provides a mock function with given fields: tableName, constraintName
+func (_m *SqlStore) DoesForeignKeyExist(tableName string, constraintName string) bool {
+	ret := _m.Called(tableName, constraintName)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(tableName, constraintName)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // DoesTriggerExist provides a mock function with given fields: triggerName
 func (_m *SqlStore) DoesTriggerExist(triggerName string) bool {
 	ret := _m.Called(triggerName)
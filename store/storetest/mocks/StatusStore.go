@@ -134,3 +134,19 @@ func (_m *StatusStore) UpdateLastActivityAt(userId string, lastActivityAt int64)
 
 	return r0
 }
+
+// UpdateLastActivityAts provides a mock function with given fields: lastActivityAts
+func (_m *StatusStore) UpdateLastActivityAts(lastActivityAts map[string]int64) *model.AppError {
+	ret := _m.Called(lastActivityAts)
+
+	var r0 *model.AppError
+	if rf, ok := ret.Get(0).(func(map[string]int64) *model.AppError); ok {
+		r0 = rf(lastActivityAts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AppError)
+		}
+	}
+
+	return r0
+}
@@ -66,6 +66,70 @@ func (_m *Store) Channel() store.ChannelStore {
 	return r0
 }
 
+// ChannelBookmark provides a mock function with given fields:
+func (_m *Store) ChannelBookmark() store.ChannelBookmarkStore {
+	ret := _m.Called()
+
+	var r0 store.ChannelBookmarkStore
+	if rf, ok := ret.Get(0).(func() store.ChannelBookmarkStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.ChannelBookmarkStore)
+		}
+	}
+
+	return r0
+}
+
+// ChannelChange provides a mock function with given fields:
+func (_m *Store) ChannelChange() store.ChannelChangeStore {
+	ret := _m.Called()
+
+	var r0 store.ChannelChangeStore
+	if rf, ok := ret.Get(0).(func() store.ChannelChangeStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.ChannelChangeStore)
+		}
+	}
+
+	return r0
+}
+
+// ChannelGuestLink provides a mock function with given fields:
+func (_m *Store) ChannelGuestLink() store.ChannelGuestLinkStore {
+	ret := _m.Called()
+
+	var r0 store.ChannelGuestLinkStore
+	if rf, ok := ret.Get(0).(func() store.ChannelGuestLinkStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.ChannelGuestLinkStore)
+		}
+	}
+
+	return r0
+}
+
+// ChannelIntegration provides a mock function with given fields:
+func (_m *Store) ChannelIntegration() store.ChannelIntegrationStore {
+	ret := _m.Called()
+
+	var r0 store.ChannelIntegrationStore
+	if rf, ok := ret.Get(0).(func() store.ChannelIntegrationStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.ChannelIntegrationStore)
+		}
+	}
+
+	return r0
+}
+
 // ChannelMemberHistory provides a mock function with given fields:
 func (_m *Store) ChannelMemberHistory() store.ChannelMemberHistoryStore {
 	ret := _m.Called()
@@ -188,6 +252,22 @@ func (_m *Store) DropAllTables() {
 	_m.Called()
 }
 
+// EmailVerification provides a mock function with given fields:
+func (_m *Store) EmailVerification() store.EmailVerificationStore {
+	ret := _m.Called()
+
+	var r0 store.EmailVerificationStore
+	if rf, ok := ret.Get(0).(func() store.EmailVerificationStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.EmailVerificationStore)
+		}
+	}
+
+	return r0
+}
+
 // Emoji provides a mock function with given fields:
 func (_m *Store) Emoji() store.EmojiStore {
 	ret := _m.Called()
@@ -204,6 +284,22 @@ func (_m *Store) Emoji() store.EmojiStore {
 	return r0
 }
 
+// EventStream provides a mock function with given fields:
+func (_m *Store) EventStream() store.EventStreamStore {
+	ret := _m.Called()
+
+	var r0 store.EventStreamStore
+	if rf, ok := ret.Get(0).(func() store.EventStreamStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.EventStreamStore)
+		}
+	}
+
+	return r0
+}
+
 // FileInfo provides a mock function with given fields:
 func (_m *Store) FileInfo() store.FileInfoStore {
 	ret := _m.Called()
@@ -271,6 +367,22 @@ func (_m *Store) Group() store.GroupStore {
 	return r0
 }
 
+// IntegrationUsage provides a mock function with given fields:
+func (_m *Store) IntegrationUsage() store.IntegrationUsageStore {
+	ret := _m.Called()
+
+	var r0 store.IntegrationUsageStore
+	if rf, ok := ret.Get(0).(func() store.IntegrationUsageStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.IntegrationUsageStore)
+		}
+	}
+
+	return r0
+}
+
 // Job provides a mock function with given fields:
 func (_m *Store) Job() store.JobStore {
 	ret := _m.Called()
@@ -324,6 +436,22 @@ func (_m *Store) LockToMaster() {
 	_m.Called()
 }
 
+// LoginHistory provides a mock function with given fields:
+func (_m *Store) LoginHistory() store.LoginHistoryStore {
+	ret := _m.Called()
+
+	var r0 store.LoginHistoryStore
+	if rf, ok := ret.Get(0).(func() store.LoginHistoryStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.LoginHistoryStore)
+		}
+	}
+
+	return r0
+}
+
 // MarkSystemRanUnitTests provides a mock function with given fields:
 func (_m *Store) MarkSystemRanUnitTests() {
 	_m.Called()
@@ -345,6 +473,38 @@ func (_m *Store) OAuth() store.OAuthStore {
 	return r0
 }
 
+// Outbox provides a mock function with given fields:
+func (_m *Store) Outbox() store.OutboxStore {
+	ret := _m.Called()
+
+	var r0 store.OutboxStore
+	if rf, ok := ret.Get(0).(func() store.OutboxStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.OutboxStore)
+		}
+	}
+
+	return r0
+}
+
+// PendingPin provides a mock function with given fields:
+func (_m *Store) PendingPin() store.PendingPinStore {
+	ret := _m.Called()
+
+	var r0 store.PendingPinStore
+	if rf, ok := ret.Get(0).(func() store.PendingPinStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.PendingPinStore)
+		}
+	}
+
+	return r0
+}
+
 // Plugin provides a mock function with given fields:
 func (_m *Store) Plugin() store.PluginStore {
 	ret := _m.Called()
@@ -377,6 +537,70 @@ func (_m *Store) Post() store.PostStore {
 	return r0
 }
 
+// PostAcknowledgement provides a mock function with given fields:
+func (_m *Store) PostAcknowledgement() store.PostAcknowledgementStore {
+	ret := _m.Called()
+
+	var r0 store.PostAcknowledgementStore
+	if rf, ok := ret.Get(0).(func() store.PostAcknowledgementStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.PostAcknowledgementStore)
+		}
+	}
+
+	return r0
+}
+
+// PostArchive provides a mock function with given fields:
+func (_m *Store) PostArchive() store.PostArchiveStore {
+	ret := _m.Called()
+
+	var r0 store.PostArchiveStore
+	if rf, ok := ret.Get(0).(func() store.PostArchiveStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.PostArchiveStore)
+		}
+	}
+
+	return r0
+}
+
+// PostIntegrity provides a mock function with given fields:
+func (_m *Store) PostIntegrity() store.PostIntegrityStore {
+	ret := _m.Called()
+
+	var r0 store.PostIntegrityStore
+	if rf, ok := ret.Get(0).(func() store.PostIntegrityStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.PostIntegrityStore)
+		}
+	}
+
+	return r0
+}
+
+// PostsPartition provides a mock function with given fields:
+func (_m *Store) PostsPartition() store.PostsPartitionStore {
+	ret := _m.Called()
+
+	var r0 store.PostsPartitionStore
+	if rf, ok := ret.Get(0).(func() store.PostsPartitionStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.PostsPartitionStore)
+		}
+	}
+
+	return r0
+}
+
 // Preference provides a mock function with given fields:
 func (_m *Store) Preference() store.PreferenceStore {
 	ret := _m.Called()
@@ -393,6 +617,22 @@ func (_m *Store) Preference() store.PreferenceStore {
 	return r0
 }
 
+// PresenceWebhook provides a mock function with given fields:
+func (_m *Store) PresenceWebhook() store.PresenceWebhookStore {
+	ret := _m.Called()
+
+	var r0 store.PresenceWebhookStore
+	if rf, ok := ret.Get(0).(func() store.PresenceWebhookStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.PresenceWebhookStore)
+		}
+	}
+
+	return r0
+}
+
 // Reaction provides a mock function with given fields:
 func (_m *Store) Reaction() store.ReactionStore {
 	ret := _m.Called()
@@ -414,6 +654,22 @@ func (_m *Store) RecycleDBConnections(d time.Duration) {
 	_m.Called(d)
 }
 
+// RetentionPolicy provides a mock function with given fields:
+func (_m *Store) RetentionPolicy() store.RetentionPolicyStore {
+	ret := _m.Called()
+
+	var r0 store.RetentionPolicyStore
+	if rf, ok := ret.Get(0).(func() store.RetentionPolicyStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.RetentionPolicyStore)
+		}
+	}
+
+	return r0
+}
+
 // Role provides a mock function with given fields:
 func (_m *Store) Role() store.RoleStore {
 	ret := _m.Called()
@@ -467,6 +723,22 @@ func (_m *Store) SetContext(_a0 context.Context) {
 	_m.Called(_a0)
 }
 
+// SlugHistory provides a mock function with given fields:
+func (_m *Store) SlugHistory() store.SlugHistoryStore {
+	ret := _m.Called()
+
+	var r0 store.SlugHistoryStore
+	if rf, ok := ret.Get(0).(func() store.SlugHistoryStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.SlugHistoryStore)
+		}
+	}
+
+	return r0
+}
+
 // Status provides a mock function with given fields:
 func (_m *Store) Status() store.StatusStore {
 	ret := _m.Called()
@@ -483,6 +755,22 @@ func (_m *Store) Status() store.StatusStore {
 	return r0
 }
 
+// StatusAutomation provides a mock function with given fields:
+func (_m *Store) StatusAutomation() store.StatusAutomationStore {
+	ret := _m.Called()
+
+	var r0 store.StatusAutomationStore
+	if rf, ok := ret.Get(0).(func() store.StatusAutomationStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.StatusAutomationStore)
+		}
+	}
+
+	return r0
+}
+
 // System provides a mock function with given fields:
 func (_m *Store) System() store.SystemStore {
 	ret := _m.Called()
@@ -515,6 +803,38 @@ func (_m *Store) Team() store.TeamStore {
 	return r0
 }
 
+// TeamInviteLink provides a mock function with given fields:
+func (_m *Store) TeamInviteLink() store.TeamInviteLinkStore {
+	ret := _m.Called()
+
+	var r0 store.TeamInviteLinkStore
+	if rf, ok := ret.Get(0).(func() store.TeamInviteLinkStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.TeamInviteLinkStore)
+		}
+	}
+
+	return r0
+}
+
+// TeamMemberHistory provides a mock function with given fields:
+func (_m *Store) TeamMemberHistory() store.TeamMemberHistoryStore {
+	ret := _m.Called()
+
+	var r0 store.TeamMemberHistoryStore
+	if rf, ok := ret.Get(0).(func() store.TeamMemberHistoryStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.TeamMemberHistoryStore)
+		}
+	}
+
+	return r0
+}
+
 // TermsOfService provides a mock function with given fields:
 func (_m *Store) TermsOfService() store.TermsOfServiceStore {
 	ret := _m.Called()
@@ -531,6 +851,22 @@ func (_m *Store) TermsOfService() store.TermsOfServiceStore {
 	return r0
 }
 
+// TermsOfServicePolicy provides a mock function with given fields:
+func (_m *Store) TermsOfServicePolicy() store.TermsOfServicePolicyStore {
+	ret := _m.Called()
+
+	var r0 store.TermsOfServicePolicyStore
+	if rf, ok := ret.Get(0).(func() store.TermsOfServicePolicyStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.TermsOfServicePolicyStore)
+		}
+	}
+
+	return r0
+}
+
 // Token provides a mock function with given fields:
 func (_m *Store) Token() store.TokenStore {
 	ret := _m.Called()
@@ -626,6 +962,38 @@ func (_m *Store) UserAccessToken() store.UserAccessTokenStore {
 	return r0
 }
 
+// UserProperty provides a mock function with given fields:
+func (_m *Store) UserProperty() store.UserPropertyStore {
+	ret := _m.Called()
+
+	var r0 store.UserPropertyStore
+	if rf, ok := ret.Get(0).(func() store.UserPropertyStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.UserPropertyStore)
+		}
+	}
+
+	return r0
+}
+
+// UserRelationship provides a mock function with given fields:
+func (_m *Store) UserRelationship() store.UserRelationshipStore {
+	ret := _m.Called()
+
+	var r0 store.UserRelationshipStore
+	if rf, ok := ret.Get(0).(func() store.UserRelationshipStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.UserRelationshipStore)
+		}
+	}
+
+	return r0
+}
+
 // UserTermsOfService provides a mock function with given fields:
 func (_m *Store) UserTermsOfService() store.UserTermsOfServiceStore {
 	ret := _m.Called()
@@ -89,6 +89,29 @@ func (_m *SystemStore) InsertIfExists(system *model.System) (*model.System, *mod
 	return r0, r1
 }
 
+// IsDatabaseReadOnly provides a mock function with given fields:
+func (_m *SystemStore) IsDatabaseReadOnly() (bool, *model.AppError) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 *model.AppError
+	if rf, ok := ret.Get(1).(func() *model.AppError); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // PermanentDeleteByName provides a mock function with given fields: name
 func (_m *SystemStore) PermanentDeleteByName(name string) (*model.System, *model.AppError) {
 	ret := _m.Called(name)
@@ -5,6 +5,8 @@
 package mocks
 
 import (
+	context "context"
+
 	model "github.com/mattermost/mattermost-server/v5/model"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -14,20 +16,20 @@ type TeamStore struct {
 	mock.Mock
 }
 
-// AnalyticsGetTeamCountForScheme provides a mock function with given fields: schemeId
-func (_m *TeamStore) AnalyticsGetTeamCountForScheme(schemeId string) (int64, *model.AppError) {
-	ret := _m.Called(schemeId)
+// AnalyticsGetTeamCountForScheme provides a mock function with given fields: ctx, schemeId
+func (_m *TeamStore) AnalyticsGetTeamCountForScheme(ctx context.Context, schemeId string) (int64, *model.AppError) {
+	ret := _m.Called(ctx, schemeId)
 
 	var r0 int64
-	if rf, ok := ret.Get(0).(func(string) int64); ok {
-		r0 = rf(schemeId)
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, schemeId)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(string) *model.AppError); ok {
-		r1 = rf(schemeId)
+	if rf, ok := ret.Get(1).(func(context.Context, string) *model.AppError); ok {
+		r1 = rf(ctx, schemeId)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -37,20 +39,20 @@ func (_m *TeamStore) AnalyticsGetTeamCountForScheme(schemeId string) (int64, *mo
 	return r0, r1
 }
 
-// AnalyticsPrivateTeamCount provides a mock function with given fields:
-func (_m *TeamStore) AnalyticsPrivateTeamCount() (int64, *model.AppError) {
-	ret := _m.Called()
+// AnalyticsPrivateTeamCount provides a mock function with given fields: ctx
+func (_m *TeamStore) AnalyticsPrivateTeamCount(ctx context.Context) (int64, *model.AppError) {
+	ret := _m.Called(ctx)
 
 	var r0 int64
-	if rf, ok := ret.Get(0).(func() int64); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func() *model.AppError); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) *model.AppError); ok {
+		r1 = rf(ctx)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -60,20 +62,20 @@ func (_m *TeamStore) AnalyticsPrivateTeamCount() (int64, *model.AppError) {
 	return r0, r1
 }
 
-// AnalyticsPublicTeamCount provides a mock function with given fields:
-func (_m *TeamStore) AnalyticsPublicTeamCount() (int64, *model.AppError) {
-	ret := _m.Called()
+// AnalyticsPublicTeamCount provides a mock function with given fields: ctx
+func (_m *TeamStore) AnalyticsPublicTeamCount(ctx context.Context) (int64, *model.AppError) {
+	ret := _m.Called(ctx)
 
 	var r0 int64
-	if rf, ok := ret.Get(0).(func() int64); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func() *model.AppError); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) *model.AppError); ok {
+		r1 = rf(ctx)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -83,20 +85,20 @@ func (_m *TeamStore) AnalyticsPublicTeamCount() (int64, *model.AppError) {
 	return r0, r1
 }
 
-// AnalyticsTeamCount provides a mock function with given fields: includeDeleted
-func (_m *TeamStore) AnalyticsTeamCount(includeDeleted bool) (int64, *model.AppError) {
-	ret := _m.Called(includeDeleted)
+// AnalyticsTeamCount provides a mock function with given fields: ctx, includeDeleted
+func (_m *TeamStore) AnalyticsTeamCount(ctx context.Context, includeDeleted bool) (int64, *model.AppError) {
+	ret := _m.Called(ctx, includeDeleted)
 
 	var r0 int64
-	if rf, ok := ret.Get(0).(func(bool) int64); ok {
-		r0 = rf(includeDeleted)
+	if rf, ok := ret.Get(0).(func(context.Context, bool) int64); ok {
+		r0 = rf(ctx, includeDeleted)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(bool) *model.AppError); ok {
-		r1 = rf(includeDeleted)
+	if rf, ok := ret.Get(1).(func(context.Context, bool) *model.AppError); ok {
+		r1 = rf(ctx, includeDeleted)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -106,13 +108,13 @@ func (_m *TeamStore) AnalyticsTeamCount(includeDeleted bool) (int64, *model.AppE
 	return r0, r1
 }
 
-// ClearAllCustomRoleAssignments provides a mock function with given fields:
-func (_m *TeamStore) ClearAllCustomRoleAssignments() *model.AppError {
-	ret := _m.Called()
+// ClearAllCustomRoleAssignments provides a mock function with given fields: ctx
+func (_m *TeamStore) ClearAllCustomRoleAssignments(ctx context.Context) *model.AppError {
+	ret := _m.Called(ctx)
 
 	var r0 *model.AppError
-	if rf, ok := ret.Get(0).(func() *model.AppError); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) *model.AppError); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.AppError)
@@ -127,22 +129,43 @@ func (_m *TeamStore) ClearCaches() {
 	_m.Called()
 }
 
-// Get provides a mock function with given fields: id
-func (_m *TeamStore) Get(id string) (*model.Team, *model.AppError) {
-	ret := _m.Called(id)
+// Get provides a mock function with given fields: ctx, id
+func (_m *TeamStore) Get(ctx context.Context, id string) (*model.Team, error) {
+	ret := _m.Called(ctx, id)
 
 	var r0 *model.Team
-	if rf, ok := ret.Get(0).(func(string) *model.Team); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Team); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Team)
 		}
 	}
 
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetActiveMemberCount provides a mock function with given fields: ctx, teamId, restrictions
+func (_m *TeamStore) GetActiveMemberCount(ctx context.Context, teamId string, restrictions *model.ViewUsersRestrictions) (int64, *model.AppError) {
+	ret := _m.Called(ctx, teamId, restrictions)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.ViewUsersRestrictions) int64); ok {
+		r0 = rf(ctx, teamId, restrictions)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(string) *model.AppError); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, string, *model.ViewUsersRestrictions) *model.AppError); ok {
+		r1 = rf(ctx, teamId, restrictions)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -152,20 +175,45 @@ func (_m *TeamStore) Get(id string) (*model.Team, *model.AppError) {
 	return r0, r1
 }
 
-// GetActiveMemberCount provides a mock function with given fields: teamId, restrictions
-func (_m *TeamStore) GetActiveMemberCount(teamId string, restrictions *model.ViewUsersRestrictions) (int64, *model.AppError) {
-	ret := _m.Called(teamId, restrictions)
+// GetActiveMemberCounts provides a mock function with given fields: ctx, teamIds
+func (_m *TeamStore) GetActiveMemberCounts(ctx context.Context, teamIds []string) (map[string]int64, error) {
+	ret := _m.Called(ctx, teamIds)
 
-	var r0 int64
-	if rf, ok := ret.Get(0).(func(string, *model.ViewUsersRestrictions) int64); ok {
-		r0 = rf(teamId, restrictions)
+	var r0 map[string]int64
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string]int64); ok {
+		r0 = rf(ctx, teamIds)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, teamIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *TeamStore) GetAll(ctx context.Context) ([]*model.Team, *model.AppError) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.Team
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.Team); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Team)
+		}
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(string, *model.ViewUsersRestrictions) *model.AppError); ok {
-		r1 = rf(teamId, restrictions)
+	if rf, ok := ret.Get(1).(func(context.Context) *model.AppError); ok {
+		r1 = rf(ctx)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -175,13 +223,13 @@ func (_m *TeamStore) GetActiveMemberCount(teamId string, restrictions *model.Vie
 	return r0, r1
 }
 
-// GetAll provides a mock function with given fields:
-func (_m *TeamStore) GetAll() ([]*model.Team, *model.AppError) {
-	ret := _m.Called()
+// GetAllDeletedPage provides a mock function with given fields: ctx, offset, limit
+func (_m *TeamStore) GetAllDeletedPage(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError) {
+	ret := _m.Called(ctx, offset, limit)
 
 	var r0 []*model.Team
-	if rf, ok := ret.Get(0).(func() []*model.Team); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []*model.Team); ok {
+		r0 = rf(ctx, offset, limit)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.Team)
@@ -189,8 +237,8 @@ func (_m *TeamStore) GetAll() ([]*model.Team, *model.AppError) {
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func() *model.AppError); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) *model.AppError); ok {
+		r1 = rf(ctx, offset, limit)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -200,13 +248,13 @@ func (_m *TeamStore) GetAll() ([]*model.Team, *model.AppError) {
 	return r0, r1
 }
 
-// GetAllForExportAfter provides a mock function with given fields: limit, afterId
-func (_m *TeamStore) GetAllForExportAfter(limit int, afterId string) ([]*model.TeamForExport, *model.AppError) {
-	ret := _m.Called(limit, afterId)
+// GetAllForExportAfter provides a mock function with given fields: ctx, limit, afterId
+func (_m *TeamStore) GetAllForExportAfter(ctx context.Context, limit int, afterId string) ([]*model.TeamForExport, *model.AppError) {
+	ret := _m.Called(ctx, limit, afterId)
 
 	var r0 []*model.TeamForExport
-	if rf, ok := ret.Get(0).(func(int, string) []*model.TeamForExport); ok {
-		r0 = rf(limit, afterId)
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) []*model.TeamForExport); ok {
+		r0 = rf(ctx, limit, afterId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.TeamForExport)
@@ -214,8 +262,8 @@ func (_m *TeamStore) GetAllForExportAfter(limit int, afterId string) ([]*model.T
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(int, string) *model.AppError); ok {
-		r1 = rf(limit, afterId)
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) *model.AppError); ok {
+		r1 = rf(ctx, limit, afterId)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -225,13 +273,13 @@ func (_m *TeamStore) GetAllForExportAfter(limit int, afterId string) ([]*model.T
 	return r0, r1
 }
 
-// GetAllPage provides a mock function with given fields: offset, limit
-func (_m *TeamStore) GetAllPage(offset int, limit int) ([]*model.Team, *model.AppError) {
-	ret := _m.Called(offset, limit)
+// GetAllPage provides a mock function with given fields: ctx, offset, limit
+func (_m *TeamStore) GetAllPage(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError) {
+	ret := _m.Called(ctx, offset, limit)
 
 	var r0 []*model.Team
-	if rf, ok := ret.Get(0).(func(int, int) []*model.Team); ok {
-		r0 = rf(offset, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []*model.Team); ok {
+		r0 = rf(ctx, offset, limit)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.Team)
@@ -239,8 +287,8 @@ func (_m *TeamStore) GetAllPage(offset int, limit int) ([]*model.Team, *model.Ap
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(int, int) *model.AppError); ok {
-		r1 = rf(offset, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) *model.AppError); ok {
+		r1 = rf(ctx, offset, limit)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -250,13 +298,13 @@ func (_m *TeamStore) GetAllPage(offset int, limit int) ([]*model.Team, *model.Ap
 	return r0, r1
 }
 
-// GetAllPrivateTeamListing provides a mock function with given fields:
-func (_m *TeamStore) GetAllPrivateTeamListing() ([]*model.Team, *model.AppError) {
-	ret := _m.Called()
+// GetAllPrivateTeamListing provides a mock function with given fields: ctx
+func (_m *TeamStore) GetAllPrivateTeamListing(ctx context.Context) ([]*model.Team, *model.AppError) {
+	ret := _m.Called(ctx)
 
 	var r0 []*model.Team
-	if rf, ok := ret.Get(0).(func() []*model.Team); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.Team); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.Team)
@@ -264,8 +312,8 @@ func (_m *TeamStore) GetAllPrivateTeamListing() ([]*model.Team, *model.AppError)
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func() *model.AppError); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) *model.AppError); ok {
+		r1 = rf(ctx)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -275,13 +323,13 @@ func (_m *TeamStore) GetAllPrivateTeamListing() ([]*model.Team, *model.AppError)
 	return r0, r1
 }
 
-// GetAllPrivateTeamPageListing provides a mock function with given fields: offset, limit
-func (_m *TeamStore) GetAllPrivateTeamPageListing(offset int, limit int) ([]*model.Team, *model.AppError) {
-	ret := _m.Called(offset, limit)
+// GetAllPrivateTeamPageListing provides a mock function with given fields: ctx, offset, limit
+func (_m *TeamStore) GetAllPrivateTeamPageListing(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError) {
+	ret := _m.Called(ctx, offset, limit)
 
 	var r0 []*model.Team
-	if rf, ok := ret.Get(0).(func(int, int) []*model.Team); ok {
-		r0 = rf(offset, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []*model.Team); ok {
+		r0 = rf(ctx, offset, limit)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.Team)
@@ -289,8 +337,8 @@ func (_m *TeamStore) GetAllPrivateTeamPageListing(offset int, limit int) ([]*mod
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(int, int) *model.AppError); ok {
-		r1 = rf(offset, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) *model.AppError); ok {
+		r1 = rf(ctx, offset, limit)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -300,13 +348,13 @@ func (_m *TeamStore) GetAllPrivateTeamPageListing(offset int, limit int) ([]*mod
 	return r0, r1
 }
 
-// GetAllPublicTeamPageListing provides a mock function with given fields: offset, limit
-func (_m *TeamStore) GetAllPublicTeamPageListing(offset int, limit int) ([]*model.Team, *model.AppError) {
-	ret := _m.Called(offset, limit)
+// GetAllPublicTeamPageListing provides a mock function with given fields: ctx, offset, limit
+func (_m *TeamStore) GetAllPublicTeamPageListing(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError) {
+	ret := _m.Called(ctx, offset, limit)
 
 	var r0 []*model.Team
-	if rf, ok := ret.Get(0).(func(int, int) []*model.Team); ok {
-		r0 = rf(offset, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []*model.Team); ok {
+		r0 = rf(ctx, offset, limit)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.Team)
@@ -314,8 +362,8 @@ func (_m *TeamStore) GetAllPublicTeamPageListing(offset int, limit int) ([]*mode
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(int, int) *model.AppError); ok {
-		r1 = rf(offset, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) *model.AppError); ok {
+		r1 = rf(ctx, offset, limit)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -325,13 +373,13 @@ func (_m *TeamStore) GetAllPublicTeamPageListing(offset int, limit int) ([]*mode
 	return r0, r1
 }
 
-// GetAllTeamListing provides a mock function with given fields:
-func (_m *TeamStore) GetAllTeamListing() ([]*model.Team, *model.AppError) {
-	ret := _m.Called()
+// GetAllTeamListing provides a mock function with given fields: ctx
+func (_m *TeamStore) GetAllTeamListing(ctx context.Context) ([]*model.Team, *model.AppError) {
+	ret := _m.Called(ctx)
 
 	var r0 []*model.Team
-	if rf, ok := ret.Get(0).(func() []*model.Team); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.Team); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.Team)
@@ -339,8 +387,8 @@ func (_m *TeamStore) GetAllTeamListing() ([]*model.Team, *model.AppError) {
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func() *model.AppError); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) *model.AppError); ok {
+		r1 = rf(ctx)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -350,13 +398,13 @@ func (_m *TeamStore) GetAllTeamListing() ([]*model.Team, *model.AppError) {
 	return r0, r1
 }
 
-// GetAllTeamPageListing provides a mock function with given fields: offset, limit
-func (_m *TeamStore) GetAllTeamPageListing(offset int, limit int) ([]*model.Team, *model.AppError) {
-	ret := _m.Called(offset, limit)
+// GetAllTeamPageListing provides a mock function with given fields: ctx, offset, limit
+func (_m *TeamStore) GetAllTeamPageListing(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError) {
+	ret := _m.Called(ctx, offset, limit)
 
 	var r0 []*model.Team
-	if rf, ok := ret.Get(0).(func(int, int) []*model.Team); ok {
-		r0 = rf(offset, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []*model.Team); ok {
+		r0 = rf(ctx, offset, limit)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.Team)
@@ -364,8 +412,8 @@ func (_m *TeamStore) GetAllTeamPageListing(offset int, limit int) ([]*model.Team
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(int, int) *model.AppError); ok {
-		r1 = rf(offset, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) *model.AppError); ok {
+		r1 = rf(ctx, offset, limit)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -375,72 +423,114 @@ func (_m *TeamStore) GetAllTeamPageListing(offset int, limit int) ([]*model.Team
 	return r0, r1
 }
 
-// GetByInviteId provides a mock function with given fields: inviteId
-func (_m *TeamStore) GetByInviteId(inviteId string) (*model.Team, *model.AppError) {
-	ret := _m.Called(inviteId)
+// GetByAllowedDomain provides a mock function with given fields: ctx, domain
+func (_m *TeamStore) GetByAllowedDomain(ctx context.Context, domain string) ([]*model.Team, error) {
+	ret := _m.Called(ctx, domain)
+
+	var r0 []*model.Team
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.Team); ok {
+		r0 = rf(ctx, domain)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Team)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, domain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByInviteId provides a mock function with given fields: ctx, inviteId
+func (_m *TeamStore) GetByInviteId(ctx context.Context, inviteId string) (*model.Team, error) {
+	ret := _m.Called(ctx, inviteId)
 
 	var r0 *model.Team
-	if rf, ok := ret.Get(0).(func(string) *model.Team); ok {
-		r0 = rf(inviteId)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Team); ok {
+		r0 = rf(ctx, inviteId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Team)
 		}
 	}
 
-	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(string) *model.AppError); ok {
-		r1 = rf(inviteId)
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, inviteId)
 	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*model.AppError)
-		}
+		r1 = ret.Error(1)
 	}
 
 	return r0, r1
 }
 
-// GetByName provides a mock function with given fields: name
-func (_m *TeamStore) GetByName(name string) (*model.Team, *model.AppError) {
-	ret := _m.Called(name)
+// GetByName provides a mock function with given fields: ctx, name
+func (_m *TeamStore) GetByName(ctx context.Context, name string) (*model.Team, error) {
+	ret := _m.Called(ctx, name)
 
 	var r0 *model.Team
-	if rf, ok := ret.Get(0).(func(string) *model.Team); ok {
-		r0 = rf(name)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Team); ok {
+		r0 = rf(ctx, name)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Team)
 		}
 	}
 
-	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(string) *model.AppError); ok {
-		r1 = rf(name)
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
 	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*model.AppError)
-		}
+		r1 = ret.Error(1)
 	}
 
 	return r0, r1
 }
 
-// GetByNames provides a mock function with given fields: name
-func (_m *TeamStore) GetByNames(name []string) ([]*model.Team, *model.AppError) {
-	ret := _m.Called(name)
+// GetByNames provides a mock function with given fields: ctx, name
+func (_m *TeamStore) GetByNames(ctx context.Context, name []string) ([]*model.Team, error) {
+	ret := _m.Called(ctx, name)
 
 	var r0 []*model.Team
-	if rf, ok := ret.Get(0).(func([]string) []*model.Team); ok {
-		r0 = rf(name)
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*model.Team); ok {
+		r0 = rf(ctx, name)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.Team)
 		}
 	}
 
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetChannelUnreadsForAllTeams provides a mock function with given fields: ctx, excludeTeamId, userId
+func (_m *TeamStore) GetChannelUnreadsForAllTeams(ctx context.Context, excludeTeamId string, userId string) ([]*model.ChannelUnread, *model.AppError) {
+	ret := _m.Called(ctx, excludeTeamId, userId)
+
+	var r0 []*model.ChannelUnread
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*model.ChannelUnread); ok {
+		r0 = rf(ctx, excludeTeamId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ChannelUnread)
+		}
+	}
+
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func([]string) *model.AppError); ok {
-		r1 = rf(name)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) *model.AppError); ok {
+		r1 = rf(ctx, excludeTeamId, userId)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -450,13 +540,13 @@ func (_m *TeamStore) GetByNames(name []string) ([]*model.Team, *model.AppError)
 	return r0, r1
 }
 
-// GetChannelUnreadsForAllTeams provides a mock function with given fields: excludeTeamId, userId
-func (_m *TeamStore) GetChannelUnreadsForAllTeams(excludeTeamId string, userId string) ([]*model.ChannelUnread, *model.AppError) {
-	ret := _m.Called(excludeTeamId, userId)
+// GetChannelUnreadsForTeam provides a mock function with given fields: ctx, teamId, userId
+func (_m *TeamStore) GetChannelUnreadsForTeam(ctx context.Context, teamId string, userId string) ([]*model.ChannelUnread, *model.AppError) {
+	ret := _m.Called(ctx, teamId, userId)
 
 	var r0 []*model.ChannelUnread
-	if rf, ok := ret.Get(0).(func(string, string) []*model.ChannelUnread); ok {
-		r0 = rf(excludeTeamId, userId)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*model.ChannelUnread); ok {
+		r0 = rf(ctx, teamId, userId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.ChannelUnread)
@@ -464,8 +554,8 @@ func (_m *TeamStore) GetChannelUnreadsForAllTeams(excludeTeamId string, userId s
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(string, string) *model.AppError); ok {
-		r1 = rf(excludeTeamId, userId)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) *model.AppError); ok {
+		r1 = rf(ctx, teamId, userId)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -475,22 +565,22 @@ func (_m *TeamStore) GetChannelUnreadsForAllTeams(excludeTeamId string, userId s
 	return r0, r1
 }
 
-// GetChannelUnreadsForTeam provides a mock function with given fields: teamId, userId
-func (_m *TeamStore) GetChannelUnreadsForTeam(teamId string, userId string) ([]*model.ChannelUnread, *model.AppError) {
-	ret := _m.Called(teamId, userId)
+// GetDirectoryStats provides a mock function with given fields: ctx, joinedSince
+func (_m *TeamStore) GetDirectoryStats(ctx context.Context, joinedSince int64) ([]*model.TeamDirectoryStats, *model.AppError) {
+	ret := _m.Called(ctx, joinedSince)
 
-	var r0 []*model.ChannelUnread
-	if rf, ok := ret.Get(0).(func(string, string) []*model.ChannelUnread); ok {
-		r0 = rf(teamId, userId)
+	var r0 []*model.TeamDirectoryStats
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*model.TeamDirectoryStats); ok {
+		r0 = rf(ctx, joinedSince)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.ChannelUnread)
+			r0 = ret.Get(0).([]*model.TeamDirectoryStats)
 		}
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(string, string) *model.AppError); ok {
-		r1 = rf(teamId, userId)
+	if rf, ok := ret.Get(1).(func(context.Context, int64) *model.AppError); ok {
+		r1 = rf(ctx, joinedSince)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -500,22 +590,70 @@ func (_m *TeamStore) GetChannelUnreadsForTeam(teamId string, userId string) ([]*
 	return r0, r1
 }
 
-// GetMember provides a mock function with given fields: teamId, userId
-func (_m *TeamStore) GetMember(teamId string, userId string) (*model.TeamMember, *model.AppError) {
-	ret := _m.Called(teamId, userId)
+// GetGroupSyncedTeamsPage provides a mock function with given fields: ctx, offset, limit
+func (_m *TeamStore) GetGroupSyncedTeamsPage(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError) {
+	ret := _m.Called(ctx, offset, limit)
+
+	var r0 []*model.Team
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []*model.Team); ok {
+		r0 = rf(ctx, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Team)
+		}
+	}
+
+	var r1 *model.AppError
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) *model.AppError); ok {
+		r1 = rf(ctx, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetMember provides a mock function with given fields: ctx, teamId, userId, allowFromCache
+func (_m *TeamStore) GetMember(ctx context.Context, teamId string, userId string, allowFromCache bool) (*model.TeamMember, error) {
+	ret := _m.Called(ctx, teamId, userId, allowFromCache)
 
 	var r0 *model.TeamMember
-	if rf, ok := ret.Get(0).(func(string, string) *model.TeamMember); ok {
-		r0 = rf(teamId, userId)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool) *model.TeamMember); ok {
+		r0 = rf(ctx, teamId, userId, allowFromCache)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.TeamMember)
 		}
 	}
 
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, bool) error); ok {
+		r1 = rf(ctx, teamId, userId, allowFromCache)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMembers provides a mock function with given fields: ctx, teamId, offset, limit, teamMembersGetOptions
+func (_m *TeamStore) GetMembers(ctx context.Context, teamId string, offset int, limit int, teamMembersGetOptions *model.TeamMembersGetOptions) ([]*model.TeamMember, *model.AppError) {
+	ret := _m.Called(ctx, teamId, offset, limit, teamMembersGetOptions)
+
+	var r0 []*model.TeamMember
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int, *model.TeamMembersGetOptions) []*model.TeamMember); ok {
+		r0 = rf(ctx, teamId, offset, limit, teamMembersGetOptions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TeamMember)
+		}
+	}
+
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(string, string) *model.AppError); ok {
-		r1 = rf(teamId, userId)
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int, *model.TeamMembersGetOptions) *model.AppError); ok {
+		r1 = rf(ctx, teamId, offset, limit, teamMembersGetOptions)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -525,13 +663,13 @@ func (_m *TeamStore) GetMember(teamId string, userId string) (*model.TeamMember,
 	return r0, r1
 }
 
-// GetMembers provides a mock function with given fields: teamId, offset, limit, teamMembersGetOptions
-func (_m *TeamStore) GetMembers(teamId string, offset int, limit int, teamMembersGetOptions *model.TeamMembersGetOptions) ([]*model.TeamMember, *model.AppError) {
-	ret := _m.Called(teamId, offset, limit, teamMembersGetOptions)
+// GetMembersByIds provides a mock function with given fields: ctx, teamId, userIds, restrictions
+func (_m *TeamStore) GetMembersByIds(ctx context.Context, teamId string, userIds []string, restrictions *model.ViewUsersRestrictions) ([]*model.TeamMember, *model.AppError) {
+	ret := _m.Called(ctx, teamId, userIds, restrictions)
 
 	var r0 []*model.TeamMember
-	if rf, ok := ret.Get(0).(func(string, int, int, *model.TeamMembersGetOptions) []*model.TeamMember); ok {
-		r0 = rf(teamId, offset, limit, teamMembersGetOptions)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, *model.ViewUsersRestrictions) []*model.TeamMember); ok {
+		r0 = rf(ctx, teamId, userIds, restrictions)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.TeamMember)
@@ -539,8 +677,8 @@ func (_m *TeamStore) GetMembers(teamId string, offset int, limit int, teamMember
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(string, int, int, *model.TeamMembersGetOptions) *model.AppError); ok {
-		r1 = rf(teamId, offset, limit, teamMembersGetOptions)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, *model.ViewUsersRestrictions) *model.AppError); ok {
+		r1 = rf(ctx, teamId, userIds, restrictions)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -550,13 +688,13 @@ func (_m *TeamStore) GetMembers(teamId string, offset int, limit int, teamMember
 	return r0, r1
 }
 
-// GetMembersByIds provides a mock function with given fields: teamId, userIds, restrictions
-func (_m *TeamStore) GetMembersByIds(teamId string, userIds []string, restrictions *model.ViewUsersRestrictions) ([]*model.TeamMember, *model.AppError) {
-	ret := _m.Called(teamId, userIds, restrictions)
+// GetMembersByTeamIds provides a mock function with given fields: ctx, teamIds, userId
+func (_m *TeamStore) GetMembersByTeamIds(ctx context.Context, teamIds []string, userId string) ([]*model.TeamMember, *model.AppError) {
+	ret := _m.Called(ctx, teamIds, userId)
 
 	var r0 []*model.TeamMember
-	if rf, ok := ret.Get(0).(func(string, []string, *model.ViewUsersRestrictions) []*model.TeamMember); ok {
-		r0 = rf(teamId, userIds, restrictions)
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string) []*model.TeamMember); ok {
+		r0 = rf(ctx, teamIds, userId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.TeamMember)
@@ -564,8 +702,8 @@ func (_m *TeamStore) GetMembersByIds(teamId string, userIds []string, restrictio
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(string, []string, *model.ViewUsersRestrictions) *model.AppError); ok {
-		r1 = rf(teamId, userIds, restrictions)
+	if rf, ok := ret.Get(1).(func(context.Context, []string, string) *model.AppError); ok {
+		r1 = rf(ctx, teamIds, userId)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -575,13 +713,82 @@ func (_m *TeamStore) GetMembersByIds(teamId string, userIds []string, restrictio
 	return r0, r1
 }
 
-// GetTeamMembersForExport provides a mock function with given fields: userId
-func (_m *TeamStore) GetTeamMembersForExport(userId string) ([]*model.TeamMemberForExport, *model.AppError) {
-	ret := _m.Called(userId)
+// GetMembersWithExpiredRoles provides a mock function with given fields: ctx, expiredBefore, limit
+func (_m *TeamStore) GetMembersWithExpiredRoles(ctx context.Context, expiredBefore int64, limit int) ([]*model.TeamMember, error) {
+	ret := _m.Called(ctx, expiredBefore, limit)
+
+	var r0 []*model.TeamMember
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) []*model.TeamMember); ok {
+		r0 = rf(ctx, expiredBefore, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TeamMember)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int) error); ok {
+		r1 = rf(ctx, expiredBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNewTeamMembersSince provides a mock function with given fields: ctx, teamId, since, offset, limit
+func (_m *TeamStore) GetNewTeamMembersSince(ctx context.Context, teamId string, since int64, offset int, limit int) (*model.NewTeamMembersList, error) {
+	ret := _m.Called(ctx, teamId, since, offset, limit)
+
+	var r0 *model.NewTeamMembersList
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int, int) *model.NewTeamMembersList); ok {
+		r0 = rf(ctx, teamId, since, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.NewTeamMembersList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, int, int) error); ok {
+		r1 = rf(ctx, teamId, since, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPolicyForTeam provides a mock function with given fields: ctx, teamId
+func (_m *TeamStore) GetPolicyForTeam(ctx context.Context, teamId string) (*model.RetentionPolicy, error) {
+	ret := _m.Called(ctx, teamId)
+
+	var r0 *model.RetentionPolicy
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.RetentionPolicy); ok {
+		r0 = rf(ctx, teamId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RetentionPolicy)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, teamId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTeamMembersForExport provides a mock function with given fields: ctx, userId
+func (_m *TeamStore) GetTeamMembersForExport(ctx context.Context, userId string) ([]*model.TeamMemberForExport, *model.AppError) {
+	ret := _m.Called(ctx, userId)
 
 	var r0 []*model.TeamMemberForExport
-	if rf, ok := ret.Get(0).(func(string) []*model.TeamMemberForExport); ok {
-		r0 = rf(userId)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.TeamMemberForExport); ok {
+		r0 = rf(ctx, userId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.TeamMemberForExport)
@@ -589,8 +796,8 @@ func (_m *TeamStore) GetTeamMembersForExport(userId string) ([]*model.TeamMember
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(string) *model.AppError); ok {
-		r1 = rf(userId)
+	if rf, ok := ret.Get(1).(func(context.Context, string) *model.AppError); ok {
+		r1 = rf(ctx, userId)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -600,13 +807,13 @@ func (_m *TeamStore) GetTeamMembersForExport(userId string) ([]*model.TeamMember
 	return r0, r1
 }
 
-// GetTeamsByScheme provides a mock function with given fields: schemeId, offset, limit
-func (_m *TeamStore) GetTeamsByScheme(schemeId string, offset int, limit int) ([]*model.Team, *model.AppError) {
-	ret := _m.Called(schemeId, offset, limit)
+// GetTeamsByScheme provides a mock function with given fields: ctx, schemeId, offset, limit
+func (_m *TeamStore) GetTeamsByScheme(ctx context.Context, schemeId string, offset int, limit int) ([]*model.Team, *model.AppError) {
+	ret := _m.Called(ctx, schemeId, offset, limit)
 
 	var r0 []*model.Team
-	if rf, ok := ret.Get(0).(func(string, int, int) []*model.Team); ok {
-		r0 = rf(schemeId, offset, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) []*model.Team); ok {
+		r0 = rf(ctx, schemeId, offset, limit)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.Team)
@@ -614,8 +821,8 @@ func (_m *TeamStore) GetTeamsByScheme(schemeId string, offset int, limit int) ([
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(string, int, int) *model.AppError); ok {
-		r1 = rf(schemeId, offset, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) *model.AppError); ok {
+		r1 = rf(ctx, schemeId, offset, limit)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -625,13 +832,13 @@ func (_m *TeamStore) GetTeamsByScheme(schemeId string, offset int, limit int) ([
 	return r0, r1
 }
 
-// GetTeamsByUserId provides a mock function with given fields: userId
-func (_m *TeamStore) GetTeamsByUserId(userId string) ([]*model.Team, *model.AppError) {
-	ret := _m.Called(userId)
+// GetTeamsByUserId provides a mock function with given fields: ctx, userId, opts
+func (_m *TeamStore) GetTeamsByUserId(ctx context.Context, userId string, opts *model.TeamsForUserGetOptions) ([]*model.Team, *model.AppError) {
+	ret := _m.Called(ctx, userId, opts)
 
 	var r0 []*model.Team
-	if rf, ok := ret.Get(0).(func(string) []*model.Team); ok {
-		r0 = rf(userId)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.TeamsForUserGetOptions) []*model.Team); ok {
+		r0 = rf(ctx, userId, opts)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.Team)
@@ -639,8 +846,8 @@ func (_m *TeamStore) GetTeamsByUserId(userId string) ([]*model.Team, *model.AppE
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(string) *model.AppError); ok {
-		r1 = rf(userId)
+	if rf, ok := ret.Get(1).(func(context.Context, string, *model.TeamsForUserGetOptions) *model.AppError); ok {
+		r1 = rf(ctx, userId, opts)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -650,13 +857,36 @@ func (_m *TeamStore) GetTeamsByUserId(userId string) ([]*model.Team, *model.AppE
 	return r0, r1
 }
 
-// GetTeamsForUser provides a mock function with given fields: userId
-func (_m *TeamStore) GetTeamsForUser(userId string) ([]*model.TeamMember, *model.AppError) {
-	ret := _m.Called(userId)
+// GetTeamsForPolicyPage provides a mock function with given fields: ctx, policyId, offset, limit
+func (_m *TeamStore) GetTeamsForPolicyPage(ctx context.Context, policyId string, offset int, limit int) ([]*model.Team, error) {
+	ret := _m.Called(ctx, policyId, offset, limit)
+
+	var r0 []*model.Team
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) []*model.Team); ok {
+		r0 = rf(ctx, policyId, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Team)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
+		r1 = rf(ctx, policyId, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTeamsForUser provides a mock function with given fields: ctx, userId
+func (_m *TeamStore) GetTeamsForUser(ctx context.Context, userId string) ([]*model.TeamMember, *model.AppError) {
+	ret := _m.Called(ctx, userId)
 
 	var r0 []*model.TeamMember
-	if rf, ok := ret.Get(0).(func(string) []*model.TeamMember); ok {
-		r0 = rf(userId)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.TeamMember); ok {
+		r0 = rf(ctx, userId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.TeamMember)
@@ -664,8 +894,8 @@ func (_m *TeamStore) GetTeamsForUser(userId string) ([]*model.TeamMember, *model
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(string) *model.AppError); ok {
-		r1 = rf(userId)
+	if rf, ok := ret.Get(1).(func(context.Context, string) *model.AppError); ok {
+		r1 = rf(ctx, userId)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -675,22 +905,66 @@ func (_m *TeamStore) GetTeamsForUser(userId string) ([]*model.TeamMember, *model
 	return r0, r1
 }
 
-// GetTeamsForUserWithPagination provides a mock function with given fields: userId, page, perPage
-func (_m *TeamStore) GetTeamsForUserWithPagination(userId string, page int, perPage int) ([]*model.TeamMember, *model.AppError) {
-	ret := _m.Called(userId, page, perPage)
+// GetTeamsForUserWithPagination provides a mock function with given fields: ctx, userId, page, perPage, opts
+func (_m *TeamStore) GetTeamsForUserWithPagination(ctx context.Context, userId string, page int, perPage int, opts *model.TeamMembersForUserGetOptions) ([]*model.TeamMember, error) {
+	ret := _m.Called(ctx, userId, page, perPage, opts)
 
 	var r0 []*model.TeamMember
-	if rf, ok := ret.Get(0).(func(string, int, int) []*model.TeamMember); ok {
-		r0 = rf(userId, page, perPage)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int, *model.TeamMembersForUserGetOptions) []*model.TeamMember); ok {
+		r0 = rf(ctx, userId, page, perPage, opts)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.TeamMember)
 		}
 	}
 
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int, *model.TeamMembersForUserGetOptions) error); ok {
+		r1 = rf(ctx, userId, page, perPage, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTeamsWithNoActiveMembers provides a mock function with given fields: ctx, offset, limit
+func (_m *TeamStore) GetTeamsWithNoActiveMembers(ctx context.Context, offset int, limit int) ([]*model.Team, error) {
+	ret := _m.Called(ctx, offset, limit)
+
+	var r0 []*model.Team
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []*model.Team); ok {
+		r0 = rf(ctx, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Team)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTotalMemberCount provides a mock function with given fields: ctx, teamId, restrictions
+func (_m *TeamStore) GetTotalMemberCount(ctx context.Context, teamId string, restrictions *model.ViewUsersRestrictions) (int64, *model.AppError) {
+	ret := _m.Called(ctx, teamId, restrictions)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.ViewUsersRestrictions) int64); ok {
+		r0 = rf(ctx, teamId, restrictions)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(string, int, int) *model.AppError); ok {
-		r1 = rf(userId, page, perPage)
+	if rf, ok := ret.Get(1).(func(context.Context, string, *model.ViewUsersRestrictions) *model.AppError); ok {
+		r1 = rf(ctx, teamId, restrictions)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -700,20 +974,22 @@ func (_m *TeamStore) GetTeamsForUserWithPagination(userId string, page int, perP
 	return r0, r1
 }
 
-// GetTotalMemberCount provides a mock function with given fields: teamId, restrictions
-func (_m *TeamStore) GetTotalMemberCount(teamId string, restrictions *model.ViewUsersRestrictions) (int64, *model.AppError) {
-	ret := _m.Called(teamId, restrictions)
+// GetUnreadsForAllTeams provides a mock function with given fields: ctx, excludeTeamId, userId
+func (_m *TeamStore) GetUnreadsForAllTeams(ctx context.Context, excludeTeamId string, userId string) ([]*model.TeamUnread, *model.AppError) {
+	ret := _m.Called(ctx, excludeTeamId, userId)
 
-	var r0 int64
-	if rf, ok := ret.Get(0).(func(string, *model.ViewUsersRestrictions) int64); ok {
-		r0 = rf(teamId, restrictions)
+	var r0 []*model.TeamUnread
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*model.TeamUnread); ok {
+		r0 = rf(ctx, excludeTeamId, userId)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TeamUnread)
+		}
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(string, *model.ViewUsersRestrictions) *model.AppError); ok {
-		r1 = rf(teamId, restrictions)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) *model.AppError); ok {
+		r1 = rf(ctx, excludeTeamId, userId)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -723,13 +999,13 @@ func (_m *TeamStore) GetTotalMemberCount(teamId string, restrictions *model.View
 	return r0, r1
 }
 
-// GetUserTeamIds provides a mock function with given fields: userId, allowFromCache
-func (_m *TeamStore) GetUserTeamIds(userId string, allowFromCache bool) ([]string, *model.AppError) {
-	ret := _m.Called(userId, allowFromCache)
+// GetUserTeamIds provides a mock function with given fields: ctx, userId, allowFromCache
+func (_m *TeamStore) GetUserTeamIds(ctx context.Context, userId string, allowFromCache bool) ([]string, *model.AppError) {
+	ret := _m.Called(ctx, userId, allowFromCache)
 
 	var r0 []string
-	if rf, ok := ret.Get(0).(func(string, bool) []string); ok {
-		r0 = rf(userId, allowFromCache)
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) []string); ok {
+		r0 = rf(ctx, userId, allowFromCache)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
@@ -737,8 +1013,31 @@ func (_m *TeamStore) GetUserTeamIds(userId string, allowFromCache bool) ([]strin
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(string, bool) *model.AppError); ok {
-		r1 = rf(userId, allowFromCache)
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool) *model.AppError); ok {
+		r1 = rf(ctx, userId, allowFromCache)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// GroupSyncedTeamCount provides a mock function with given fields: ctx
+func (_m *TeamStore) GroupSyncedTeamCount(ctx context.Context) (int64, *model.AppError) {
+	ret := _m.Called(ctx)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 *model.AppError
+	if rf, ok := ret.Get(1).(func(context.Context) *model.AppError); ok {
+		r1 = rf(ctx)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -748,20 +1047,20 @@ func (_m *TeamStore) GetUserTeamIds(userId string, allowFromCache bool) ([]strin
 	return r0, r1
 }
 
-// GroupSyncedTeamCount provides a mock function with given fields:
-func (_m *TeamStore) GroupSyncedTeamCount() (int64, *model.AppError) {
-	ret := _m.Called()
+// GroupSyncedTeamCountByGroup provides a mock function with given fields: ctx, groupId
+func (_m *TeamStore) GroupSyncedTeamCountByGroup(ctx context.Context, groupId string) (int64, *model.AppError) {
+	ret := _m.Called(ctx, groupId)
 
 	var r0 int64
-	if rf, ok := ret.Get(0).(func() int64); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, groupId)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func() *model.AppError); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context, string) *model.AppError); ok {
+		r1 = rf(ctx, groupId)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -776,38 +1075,45 @@ func (_m *TeamStore) InvalidateAllTeamIdsForUser(userId string) {
 	_m.Called(userId)
 }
 
-// MigrateTeamMembers provides a mock function with given fields: fromTeamId, fromUserId
-func (_m *TeamStore) MigrateTeamMembers(fromTeamId string, fromUserId string) (map[string]string, *model.AppError) {
-	ret := _m.Called(fromTeamId, fromUserId)
+// MigrateTeamMembers provides a mock function with given fields: ctx, fromTeamId, fromUserId, batchSize
+func (_m *TeamStore) MigrateTeamMembers(ctx context.Context, fromTeamId string, fromUserId string, batchSize int) (map[string]string, int64, *model.AppError) {
+	ret := _m.Called(ctx, fromTeamId, fromUserId, batchSize)
 
 	var r0 map[string]string
-	if rf, ok := ret.Get(0).(func(string, string) map[string]string); ok {
-		r0 = rf(fromTeamId, fromUserId)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) map[string]string); ok {
+		r0 = rf(ctx, fromTeamId, fromUserId, batchSize)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(map[string]string)
 		}
 	}
 
-	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(string, string) *model.AppError); ok {
-		r1 = rf(fromTeamId, fromUserId)
+	var r1 int64
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int) int64); ok {
+		r1 = rf(ctx, fromTeamId, fromUserId, batchSize)
 	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*model.AppError)
+		r1 = ret.Get(1).(int64)
+	}
+
+	var r2 *model.AppError
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, int) *model.AppError); ok {
+		r2 = rf(ctx, fromTeamId, fromUserId, batchSize)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(*model.AppError)
 		}
 	}
 
-	return r0, r1
+	return r0, r1, r2
 }
 
-// PermanentDelete provides a mock function with given fields: teamId
-func (_m *TeamStore) PermanentDelete(teamId string) *model.AppError {
-	ret := _m.Called(teamId)
+// PermanentDelete provides a mock function with given fields: ctx, teamId
+func (_m *TeamStore) PermanentDelete(ctx context.Context, teamId string) *model.AppError {
+	ret := _m.Called(ctx, teamId)
 
 	var r0 *model.AppError
-	if rf, ok := ret.Get(0).(func(string) *model.AppError); ok {
-		r0 = rf(teamId)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.AppError); ok {
+		r0 = rf(ctx, teamId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.AppError)
@@ -817,29 +1123,36 @@ func (_m *TeamStore) PermanentDelete(teamId string) *model.AppError {
 	return r0
 }
 
-// RemoveAllMembersByTeam provides a mock function with given fields: teamId
-func (_m *TeamStore) RemoveAllMembersByTeam(teamId string) *model.AppError {
-	ret := _m.Called(teamId)
+// RegenerateInviteId provides a mock function with given fields: ctx, teamId, inviteExpiresAt
+func (_m *TeamStore) RegenerateInviteId(ctx context.Context, teamId string, inviteExpiresAt int64) (*model.Team, error) {
+	ret := _m.Called(ctx, teamId, inviteExpiresAt)
 
-	var r0 *model.AppError
-	if rf, ok := ret.Get(0).(func(string) *model.AppError); ok {
-		r0 = rf(teamId)
+	var r0 *model.Team
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) *model.Team); ok {
+		r0 = rf(ctx, teamId, inviteExpiresAt)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.AppError)
+			r0 = ret.Get(0).(*model.Team)
 		}
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, teamId, inviteExpiresAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// RemoveAllMembersByUser provides a mock function with given fields: userId
-func (_m *TeamStore) RemoveAllMembersByUser(userId string) *model.AppError {
-	ret := _m.Called(userId)
+// RemoveAllMembersByTeam provides a mock function with given fields: ctx, teamId
+func (_m *TeamStore) RemoveAllMembersByTeam(ctx context.Context, teamId string) *model.AppError {
+	ret := _m.Called(ctx, teamId)
 
 	var r0 *model.AppError
-	if rf, ok := ret.Get(0).(func(string) *model.AppError); ok {
-		r0 = rf(userId)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.AppError); ok {
+		r0 = rf(ctx, teamId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.AppError)
@@ -849,13 +1162,38 @@ func (_m *TeamStore) RemoveAllMembersByUser(userId string) *model.AppError {
 	return r0
 }
 
-// RemoveMember provides a mock function with given fields: teamId, userId
-func (_m *TeamStore) RemoveMember(teamId string, userId string) *model.AppError {
-	ret := _m.Called(teamId, userId)
+// RemoveAllMembersByUser provides a mock function with given fields: ctx, userId
+func (_m *TeamStore) RemoveAllMembersByUser(ctx context.Context, userId string) ([]string, *model.AppError) {
+	ret := _m.Called(ctx, userId)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 *model.AppError
+	if rf, ok := ret.Get(1).(func(context.Context, string) *model.AppError); ok {
+		r1 = rf(ctx, userId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// RemoveMember provides a mock function with given fields: ctx, teamId, userId
+func (_m *TeamStore) RemoveMember(ctx context.Context, teamId string, userId string) *model.AppError {
+	ret := _m.Called(ctx, teamId, userId)
 
 	var r0 *model.AppError
-	if rf, ok := ret.Get(0).(func(string, string) *model.AppError); ok {
-		r0 = rf(teamId, userId)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.AppError); ok {
+		r0 = rf(ctx, teamId, userId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.AppError)
@@ -865,13 +1203,13 @@ func (_m *TeamStore) RemoveMember(teamId string, userId string) *model.AppError
 	return r0
 }
 
-// RemoveMembers provides a mock function with given fields: teamId, userIds
-func (_m *TeamStore) RemoveMembers(teamId string, userIds []string) *model.AppError {
-	ret := _m.Called(teamId, userIds)
+// RemoveMembers provides a mock function with given fields: ctx, teamId, userIds
+func (_m *TeamStore) RemoveMembers(ctx context.Context, teamId string, userIds []string) *model.AppError {
+	ret := _m.Called(ctx, teamId, userIds)
 
 	var r0 *model.AppError
-	if rf, ok := ret.Get(0).(func(string, []string) *model.AppError); ok {
-		r0 = rf(teamId, userIds)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) *model.AppError); ok {
+		r0 = rf(ctx, teamId, userIds)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.AppError)
@@ -881,13 +1219,13 @@ func (_m *TeamStore) RemoveMembers(teamId string, userIds []string) *model.AppEr
 	return r0
 }
 
-// ResetAllTeamSchemes provides a mock function with given fields:
-func (_m *TeamStore) ResetAllTeamSchemes() *model.AppError {
-	ret := _m.Called()
+// ResetAllTeamSchemes provides a mock function with given fields: ctx
+func (_m *TeamStore) ResetAllTeamSchemes(ctx context.Context) *model.AppError {
+	ret := _m.Called(ctx)
 
 	var r0 *model.AppError
-	if rf, ok := ret.Get(0).(func() *model.AppError); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) *model.AppError); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.AppError)
@@ -897,88 +1235,128 @@ func (_m *TeamStore) ResetAllTeamSchemes() *model.AppError {
 	return r0
 }
 
-// Save provides a mock function with given fields: team
-func (_m *TeamStore) Save(team *model.Team) (*model.Team, *model.AppError) {
-	ret := _m.Called(team)
+// Restore provides a mock function with given fields: ctx, teamId
+func (_m *TeamStore) Restore(ctx context.Context, teamId string) error {
+	ret := _m.Called(ctx, teamId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, teamId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Save provides a mock function with given fields: ctx, team
+func (_m *TeamStore) Save(ctx context.Context, team *model.Team) (*model.Team, error) {
+	ret := _m.Called(ctx, team)
 
 	var r0 *model.Team
-	if rf, ok := ret.Get(0).(func(*model.Team) *model.Team); ok {
-		r0 = rf(team)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Team) *model.Team); ok {
+		r0 = rf(ctx, team)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Team)
 		}
 	}
 
-	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(*model.Team) *model.AppError); ok {
-		r1 = rf(team)
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Team) error); ok {
+		r1 = rf(ctx, team)
 	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*model.AppError)
-		}
+		r1 = ret.Error(1)
 	}
 
 	return r0, r1
 }
 
-// SaveMember provides a mock function with given fields: member, maxUsersPerTeam
-func (_m *TeamStore) SaveMember(member *model.TeamMember, maxUsersPerTeam int) (*model.TeamMember, *model.AppError) {
-	ret := _m.Called(member, maxUsersPerTeam)
+// SaveMember provides a mock function with given fields: ctx, member, maxUsersPerTeam
+func (_m *TeamStore) SaveMember(ctx context.Context, member *model.TeamMember, maxUsersPerTeam int) (*model.TeamMember, error) {
+	ret := _m.Called(ctx, member, maxUsersPerTeam)
 
 	var r0 *model.TeamMember
-	if rf, ok := ret.Get(0).(func(*model.TeamMember, int) *model.TeamMember); ok {
-		r0 = rf(member, maxUsersPerTeam)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.TeamMember, int) *model.TeamMember); ok {
+		r0 = rf(ctx, member, maxUsersPerTeam)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.TeamMember)
 		}
 	}
 
-	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(*model.TeamMember, int) *model.AppError); ok {
-		r1 = rf(member, maxUsersPerTeam)
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *model.TeamMember, int) error); ok {
+		r1 = rf(ctx, member, maxUsersPerTeam)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveMultiple provides a mock function with given fields: ctx, teams
+func (_m *TeamStore) SaveMultiple(ctx context.Context, teams []*model.Team) ([]*model.Team, []error, error) {
+	ret := _m.Called(ctx, teams)
+
+	var r0 []*model.Team
+	if rf, ok := ret.Get(0).(func(context.Context, []*model.Team) []*model.Team); ok {
+		r0 = rf(ctx, teams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Team)
+		}
+	}
+
+	var r1 []error
+	if rf, ok := ret.Get(1).(func(context.Context, []*model.Team) []error); ok {
+		r1 = rf(ctx, teams)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*model.AppError)
+			r1 = ret.Get(1).([]error)
 		}
 	}
 
-	return r0, r1
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, []*model.Team) error); ok {
+		r2 = rf(ctx, teams)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
-// SaveMultipleMembers provides a mock function with given fields: members, maxUsersPerTeam
-func (_m *TeamStore) SaveMultipleMembers(members []*model.TeamMember, maxUsersPerTeam int) ([]*model.TeamMember, *model.AppError) {
-	ret := _m.Called(members, maxUsersPerTeam)
+// SaveMultipleMembers provides a mock function with given fields: ctx, members, maxUsersPerTeam
+func (_m *TeamStore) SaveMultipleMembers(ctx context.Context, members []*model.TeamMember, maxUsersPerTeam int) ([]*model.TeamMember, error) {
+	ret := _m.Called(ctx, members, maxUsersPerTeam)
 
 	var r0 []*model.TeamMember
-	if rf, ok := ret.Get(0).(func([]*model.TeamMember, int) []*model.TeamMember); ok {
-		r0 = rf(members, maxUsersPerTeam)
+	if rf, ok := ret.Get(0).(func(context.Context, []*model.TeamMember, int) []*model.TeamMember); ok {
+		r0 = rf(ctx, members, maxUsersPerTeam)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.TeamMember)
 		}
 	}
 
-	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func([]*model.TeamMember, int) *model.AppError); ok {
-		r1 = rf(members, maxUsersPerTeam)
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []*model.TeamMember, int) error); ok {
+		r1 = rf(ctx, members, maxUsersPerTeam)
 	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*model.AppError)
-		}
+		r1 = ret.Error(1)
 	}
 
 	return r0, r1
 }
 
-// SearchAll provides a mock function with given fields: term
-func (_m *TeamStore) SearchAll(term string) ([]*model.Team, *model.AppError) {
-	ret := _m.Called(term)
+// SearchAll provides a mock function with given fields: ctx, term, opts
+func (_m *TeamStore) SearchAll(ctx context.Context, term string, opts *model.TeamSearchOpts) ([]*model.Team, *model.AppError) {
+	ret := _m.Called(ctx, term, opts)
 
 	var r0 []*model.Team
-	if rf, ok := ret.Get(0).(func(string) []*model.Team); ok {
-		r0 = rf(term)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.TeamSearchOpts) []*model.Team); ok {
+		r0 = rf(ctx, term, opts)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.Team)
@@ -986,8 +1364,8 @@ func (_m *TeamStore) SearchAll(term string) ([]*model.Team, *model.AppError) {
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(string) *model.AppError); ok {
-		r1 = rf(term)
+	if rf, ok := ret.Get(1).(func(context.Context, string, *model.TeamSearchOpts) *model.AppError); ok {
+		r1 = rf(ctx, term, opts)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -997,13 +1375,13 @@ func (_m *TeamStore) SearchAll(term string) ([]*model.Team, *model.AppError) {
 	return r0, r1
 }
 
-// SearchAllPaged provides a mock function with given fields: term, page, perPage
-func (_m *TeamStore) SearchAllPaged(term string, page int, perPage int) ([]*model.Team, int64, *model.AppError) {
-	ret := _m.Called(term, page, perPage)
+// SearchAllPaged provides a mock function with given fields: ctx, term, opts, page, perPage
+func (_m *TeamStore) SearchAllPaged(ctx context.Context, term string, opts *model.TeamSearchOpts, page int, perPage int) ([]*model.Team, int64, *model.AppError) {
+	ret := _m.Called(ctx, term, opts, page, perPage)
 
 	var r0 []*model.Team
-	if rf, ok := ret.Get(0).(func(string, int, int) []*model.Team); ok {
-		r0 = rf(term, page, perPage)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.TeamSearchOpts, int, int) []*model.Team); ok {
+		r0 = rf(ctx, term, opts, page, perPage)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.Team)
@@ -1011,15 +1389,15 @@ func (_m *TeamStore) SearchAllPaged(term string, page int, perPage int) ([]*mode
 	}
 
 	var r1 int64
-	if rf, ok := ret.Get(1).(func(string, int, int) int64); ok {
-		r1 = rf(term, page, perPage)
+	if rf, ok := ret.Get(1).(func(context.Context, string, *model.TeamSearchOpts, int, int) int64); ok {
+		r1 = rf(ctx, term, opts, page, perPage)
 	} else {
 		r1 = ret.Get(1).(int64)
 	}
 
 	var r2 *model.AppError
-	if rf, ok := ret.Get(2).(func(string, int, int) *model.AppError); ok {
-		r2 = rf(term, page, perPage)
+	if rf, ok := ret.Get(2).(func(context.Context, string, *model.TeamSearchOpts, int, int) *model.AppError); ok {
+		r2 = rf(ctx, term, opts, page, perPage)
 	} else {
 		if ret.Get(2) != nil {
 			r2 = ret.Get(2).(*model.AppError)
@@ -1029,13 +1407,13 @@ func (_m *TeamStore) SearchAllPaged(term string, page int, perPage int) ([]*mode
 	return r0, r1, r2
 }
 
-// SearchOpen provides a mock function with given fields: term
-func (_m *TeamStore) SearchOpen(term string) ([]*model.Team, *model.AppError) {
-	ret := _m.Called(term)
+// SearchOpen provides a mock function with given fields: ctx, term, opts
+func (_m *TeamStore) SearchOpen(ctx context.Context, term string, opts *model.TeamSearchOpts) ([]*model.Team, *model.AppError) {
+	ret := _m.Called(ctx, term, opts)
 
 	var r0 []*model.Team
-	if rf, ok := ret.Get(0).(func(string) []*model.Team); ok {
-		r0 = rf(term)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.TeamSearchOpts) []*model.Team); ok {
+		r0 = rf(ctx, term, opts)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.Team)
@@ -1043,8 +1421,8 @@ func (_m *TeamStore) SearchOpen(term string) ([]*model.Team, *model.AppError) {
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(string) *model.AppError); ok {
-		r1 = rf(term)
+	if rf, ok := ret.Get(1).(func(context.Context, string, *model.TeamSearchOpts) *model.AppError); ok {
+		r1 = rf(ctx, term, opts)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -1054,13 +1432,13 @@ func (_m *TeamStore) SearchOpen(term string) ([]*model.Team, *model.AppError) {
 	return r0, r1
 }
 
-// SearchPrivate provides a mock function with given fields: term
-func (_m *TeamStore) SearchPrivate(term string) ([]*model.Team, *model.AppError) {
-	ret := _m.Called(term)
+// SearchPrivate provides a mock function with given fields: ctx, term, opts
+func (_m *TeamStore) SearchPrivate(ctx context.Context, term string, opts *model.TeamSearchOpts) ([]*model.Team, *model.AppError) {
+	ret := _m.Called(ctx, term, opts)
 
 	var r0 []*model.Team
-	if rf, ok := ret.Get(0).(func(string) []*model.Team); ok {
-		r0 = rf(term)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.TeamSearchOpts) []*model.Team); ok {
+		r0 = rf(ctx, term, opts)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.Team)
@@ -1068,8 +1446,8 @@ func (_m *TeamStore) SearchPrivate(term string) ([]*model.Team, *model.AppError)
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(string) *model.AppError); ok {
-		r1 = rf(term)
+	if rf, ok := ret.Get(1).(func(context.Context, string, *model.TeamSearchOpts) *model.AppError); ok {
+		r1 = rf(ctx, term, opts)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -1079,22 +1457,22 @@ func (_m *TeamStore) SearchPrivate(term string) ([]*model.Team, *model.AppError)
 	return r0, r1
 }
 
-// Update provides a mock function with given fields: team
-func (_m *TeamStore) Update(team *model.Team) (*model.Team, *model.AppError) {
-	ret := _m.Called(team)
+// SearchPrivateForUser provides a mock function with given fields: ctx, userId, term, opts
+func (_m *TeamStore) SearchPrivateForUser(ctx context.Context, userId string, term string, opts *model.TeamSearchOpts) ([]*model.Team, *model.AppError) {
+	ret := _m.Called(ctx, userId, term, opts)
 
-	var r0 *model.Team
-	if rf, ok := ret.Get(0).(func(*model.Team) *model.Team); ok {
-		r0 = rf(team)
+	var r0 []*model.Team
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *model.TeamSearchOpts) []*model.Team); ok {
+		r0 = rf(ctx, userId, term, opts)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Team)
+			r0 = ret.Get(0).([]*model.Team)
 		}
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(*model.Team) *model.AppError); ok {
-		r1 = rf(team)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *model.TeamSearchOpts) *model.AppError); ok {
+		r1 = rf(ctx, userId, term, opts)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -1104,13 +1482,50 @@ func (_m *TeamStore) Update(team *model.Team) (*model.Team, *model.AppError) {
 	return r0, r1
 }
 
-// UpdateLastTeamIconUpdate provides a mock function with given fields: teamId, curTime
-func (_m *TeamStore) UpdateLastTeamIconUpdate(teamId string, curTime int64) *model.AppError {
-	ret := _m.Called(teamId, curTime)
+// SetPolicy provides a mock function with given fields: ctx, teamId, policyId
+func (_m *TeamStore) SetPolicy(ctx context.Context, teamId string, policyId string) error {
+	ret := _m.Called(ctx, teamId, policyId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, teamId, policyId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, team
+func (_m *TeamStore) Update(ctx context.Context, team *model.Team) (*model.Team, error) {
+	ret := _m.Called(ctx, team)
+
+	var r0 *model.Team
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Team) *model.Team); ok {
+		r0 = rf(ctx, team)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Team)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Team) error); ok {
+		r1 = rf(ctx, team)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateLastTeamIconUpdate provides a mock function with given fields: ctx, teamId, curTime
+func (_m *TeamStore) UpdateLastTeamIconUpdate(ctx context.Context, teamId string, curTime int64) *model.AppError {
+	ret := _m.Called(ctx, teamId, curTime)
 
 	var r0 *model.AppError
-	if rf, ok := ret.Get(0).(func(string, int64) *model.AppError); ok {
-		r0 = rf(teamId, curTime)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) *model.AppError); ok {
+		r0 = rf(ctx, teamId, curTime)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.AppError)
@@ -1120,13 +1535,13 @@ func (_m *TeamStore) UpdateLastTeamIconUpdate(teamId string, curTime int64) *mod
 	return r0
 }
 
-// UpdateMember provides a mock function with given fields: member
-func (_m *TeamStore) UpdateMember(member *model.TeamMember) (*model.TeamMember, *model.AppError) {
-	ret := _m.Called(member)
+// UpdateMember provides a mock function with given fields: ctx, member
+func (_m *TeamStore) UpdateMember(ctx context.Context, member *model.TeamMember) (*model.TeamMember, *model.AppError) {
+	ret := _m.Called(ctx, member)
 
 	var r0 *model.TeamMember
-	if rf, ok := ret.Get(0).(func(*model.TeamMember) *model.TeamMember); ok {
-		r0 = rf(member)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.TeamMember) *model.TeamMember); ok {
+		r0 = rf(ctx, member)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.TeamMember)
@@ -1134,8 +1549,8 @@ func (_m *TeamStore) UpdateMember(member *model.TeamMember) (*model.TeamMember,
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(*model.TeamMember) *model.AppError); ok {
-		r1 = rf(member)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.TeamMember) *model.AppError); ok {
+		r1 = rf(ctx, member)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -1145,13 +1560,13 @@ func (_m *TeamStore) UpdateMember(member *model.TeamMember) (*model.TeamMember,
 	return r0, r1
 }
 
-// UpdateMembersRole provides a mock function with given fields: teamID, userIDs
-func (_m *TeamStore) UpdateMembersRole(teamID string, userIDs []string) *model.AppError {
-	ret := _m.Called(teamID, userIDs)
+// UpdateMembersRole provides a mock function with given fields: ctx, teamID, userIDs
+func (_m *TeamStore) UpdateMembersRole(ctx context.Context, teamID string, userIDs []string) *model.AppError {
+	ret := _m.Called(ctx, teamID, userIDs)
 
 	var r0 *model.AppError
-	if rf, ok := ret.Get(0).(func(string, []string) *model.AppError); ok {
-		r0 = rf(teamID, userIDs)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) *model.AppError); ok {
+		r0 = rf(ctx, teamID, userIDs)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.AppError)
@@ -1161,13 +1576,13 @@ func (_m *TeamStore) UpdateMembersRole(teamID string, userIDs []string) *model.A
 	return r0
 }
 
-// UpdateMultipleMembers provides a mock function with given fields: members
-func (_m *TeamStore) UpdateMultipleMembers(members []*model.TeamMember) ([]*model.TeamMember, *model.AppError) {
-	ret := _m.Called(members)
+// UpdateMembersRoleAndGetUpdated provides a mock function with given fields: ctx, teamID, userIDs
+func (_m *TeamStore) UpdateMembersRoleAndGetUpdated(ctx context.Context, teamID string, userIDs []string) ([]*model.TeamMember, *model.AppError) {
+	ret := _m.Called(ctx, teamID, userIDs)
 
 	var r0 []*model.TeamMember
-	if rf, ok := ret.Get(0).(func([]*model.TeamMember) []*model.TeamMember); ok {
-		r0 = rf(members)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []*model.TeamMember); ok {
+		r0 = rf(ctx, teamID, userIDs)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.TeamMember)
@@ -1175,8 +1590,56 @@ func (_m *TeamStore) UpdateMultipleMembers(members []*model.TeamMember) ([]*mode
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func([]*model.TeamMember) *model.AppError); ok {
-		r1 = rf(members)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) *model.AppError); ok {
+		r1 = rf(ctx, teamID, userIDs)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpdateMultipleMembers provides a mock function with given fields: ctx, members
+func (_m *TeamStore) UpdateMultipleMembers(ctx context.Context, members []*model.TeamMember) ([]*model.TeamMember, *model.AppError) {
+	ret := _m.Called(ctx, members)
+
+	var r0 []*model.TeamMember
+	if rf, ok := ret.Get(0).(func(context.Context, []*model.TeamMember) []*model.TeamMember); ok {
+		r0 = rf(ctx, members)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TeamMember)
+		}
+	}
+
+	var r1 *model.AppError
+	if rf, ok := ret.Get(1).(func(context.Context, []*model.TeamMember) *model.AppError); ok {
+		r1 = rf(ctx, members)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// UserBelongsToTeam provides a mock function with given fields: ctx, userId, teamId, allowFromCache
+func (_m *TeamStore) UserBelongsToTeam(ctx context.Context, userId string, teamId string, allowFromCache bool) (bool, *model.AppError) {
+	ret := _m.Called(ctx, userId, teamId, allowFromCache)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool) bool); ok {
+		r0 = rf(ctx, userId, teamId, allowFromCache)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 *model.AppError
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, bool) *model.AppError); ok {
+		r1 = rf(ctx, userId, teamId, allowFromCache)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -1186,20 +1649,20 @@ func (_m *TeamStore) UpdateMultipleMembers(members []*model.TeamMember) ([]*mode
 	return r0, r1
 }
 
-// UserBelongsToTeams provides a mock function with given fields: userId, teamIds
-func (_m *TeamStore) UserBelongsToTeams(userId string, teamIds []string) (bool, *model.AppError) {
-	ret := _m.Called(userId, teamIds)
+// UserBelongsToTeams provides a mock function with given fields: ctx, userId, teamIds
+func (_m *TeamStore) UserBelongsToTeams(ctx context.Context, userId string, teamIds []string) (bool, *model.AppError) {
+	ret := _m.Called(ctx, userId, teamIds)
 
 	var r0 bool
-	if rf, ok := ret.Get(0).(func(string, []string) bool); ok {
-		r0 = rf(userId, teamIds)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) bool); ok {
+		r0 = rf(ctx, userId, teamIds)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 *model.AppError
-	if rf, ok := ret.Get(1).(func(string, []string) *model.AppError); ok {
-		r1 = rf(userId, teamIds)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) *model.AppError); ok {
+		r1 = rf(ctx, userId, teamIds)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*model.AppError)
@@ -107,6 +107,31 @@ func (_m *UserStore) AnalyticsGetSystemAdminCount() (int64, *model.AppError) {
 	return r0, r1
 }
 
+// AnalyticsSeatCountsByDay provides a mock function with given fields: startTime, endTime, excludeServiceAccounts
+func (_m *UserStore) AnalyticsSeatCountsByDay(startTime int64, endTime int64, excludeServiceAccounts bool) ([]*model.SeatUsagePoint, *model.AppError) {
+	ret := _m.Called(startTime, endTime, excludeServiceAccounts)
+
+	var r0 []*model.SeatUsagePoint
+	if rf, ok := ret.Get(0).(func(int64, int64, bool) []*model.SeatUsagePoint); ok {
+		r0 = rf(startTime, endTime, excludeServiceAccounts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.SeatUsagePoint)
+		}
+	}
+
+	var r1 *model.AppError
+	if rf, ok := ret.Get(1).(func(int64, int64, bool) *model.AppError); ok {
+		r1 = rf(startTime, endTime, excludeServiceAccounts)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // AutocompleteUsersInChannel provides a mock function with given fields: teamId, channelId, term, options
 func (_m *UserStore) AutocompleteUsersInChannel(teamId string, channelId string, term string, options *model.UserSearchOptions) (*model.UserAutocompleteInChannel, *model.AppError) {
 	ret := _m.Called(teamId, channelId, term, options)
@@ -515,6 +540,31 @@ func (_m *UserStore) GetChannelGroupUsers(channelID string) ([]*model.User, *mod
 	return r0, r1
 }
 
+// GetDirectReports provides a mock function with given fields: managerId, offset, limit
+func (_m *UserStore) GetDirectReports(managerId string, offset int, limit int) ([]*model.User, *model.AppError) {
+	ret := _m.Called(managerId, offset, limit)
+
+	var r0 []*model.User
+	if rf, ok := ret.Get(0).(func(string, int, int) []*model.User); ok {
+		r0 = rf(managerId, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.User)
+		}
+	}
+
+	var r1 *model.AppError
+	if rf, ok := ret.Get(1).(func(string, int, int) *model.AppError); ok {
+		r1 = rf(managerId, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // GetEtagForAllProfiles provides a mock function with given fields:
 func (_m *UserStore) GetEtagForAllProfiles() string {
 	ret := _m.Called()
@@ -882,6 +932,56 @@ func (_m *UserStore) GetRecentlyActiveUsersForTeam(teamId string, offset int, li
 	return r0, r1
 }
 
+// GetReportingChain provides a mock function with given fields: userId
+func (_m *UserStore) GetReportingChain(userId string) ([]*model.User, *model.AppError) {
+	ret := _m.Called(userId)
+
+	var r0 []*model.User
+	if rf, ok := ret.Get(0).(func(string) []*model.User); ok {
+		r0 = rf(userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.User)
+		}
+	}
+
+	var r1 *model.AppError
+	if rf, ok := ret.Get(1).(func(string) *model.AppError); ok {
+		r1 = rf(userId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetServiceAccounts provides a mock function with given fields: offset, limit, includeDeleted
+func (_m *UserStore) GetServiceAccounts(offset int, limit int, includeDeleted bool) ([]*model.User, *model.AppError) {
+	ret := _m.Called(offset, limit, includeDeleted)
+
+	var r0 []*model.User
+	if rf, ok := ret.Get(0).(func(int, int, bool) []*model.User); ok {
+		r0 = rf(offset, limit, includeDeleted)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.User)
+		}
+	}
+
+	var r1 *model.AppError
+	if rf, ok := ret.Get(1).(func(int, int, bool) *model.AppError); ok {
+		r1 = rf(offset, limit, includeDeleted)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // GetSystemAdminProfiles provides a mock function with given fields:
 func (_m *UserStore) GetSystemAdminProfiles() (map[string]*model.User, *model.AppError) {
 	ret := _m.Called()
@@ -1003,6 +1103,31 @@ func (_m *UserStore) GetUsersBatchForIndexing(startTime int64, endTime int64, li
 	return r0, r1
 }
 
+// GetUsersWithExpiredRoles provides a mock function with given fields: expiredBefore, limit
+func (_m *UserStore) GetUsersWithExpiredRoles(expiredBefore int64, limit int) ([]*model.User, *model.AppError) {
+	ret := _m.Called(expiredBefore, limit)
+
+	var r0 []*model.User
+	if rf, ok := ret.Get(0).(func(int64, int) []*model.User); ok {
+		r0 = rf(expiredBefore, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.User)
+		}
+	}
+
+	var r1 *model.AppError
+	if rf, ok := ret.Get(1).(func(int64, int) *model.AppError); ok {
+		r1 = rf(expiredBefore, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // InferSystemInstallDate provides a mock function with given fields:
 func (_m *UserStore) InferSystemInstallDate() (int64, *model.AppError) {
 	ret := _m.Called()
@@ -1328,6 +1453,22 @@ func (_m *UserStore) UpdateFailedPasswordAttempts(userId string, attempts int) *
 	return r0
 }
 
+// UpdateLastLogin provides a mock function with given fields: userId, lastLogin
+func (_m *UserStore) UpdateLastLogin(userId string, lastLogin int64) *model.AppError {
+	ret := _m.Called(userId, lastLogin)
+
+	var r0 *model.AppError
+	if rf, ok := ret.Get(0).(func(string, int64) *model.AppError); ok {
+		r0 = rf(userId, lastLogin)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AppError)
+		}
+	}
+
+	return r0
+}
+
 // UpdateLastPictureUpdate provides a mock function with given fields: userId
 func (_m *UserStore) UpdateLastPictureUpdate(userId string) *model.AppError {
 	ret := _m.Called(userId)
@@ -0,0 +1,147 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+func TestOutboxStore(t *testing.T, ss store.Store) {
+	t.Run("Save", func(t *testing.T) { testOutboxStoreSave(t, ss) })
+	t.Run("GetUndelivered", func(t *testing.T) { testOutboxStoreGetUndelivered(t, ss) })
+	t.Run("MarkDelivered", func(t *testing.T) { testOutboxStoreMarkDelivered(t, ss) })
+	t.Run("PermanentDeleteBatch", func(t *testing.T) { testOutboxStorePermanentDeleteBatch(t, ss) })
+}
+
+func newTestOutboxEvent(createAt int64) *model.OutboxEvent {
+	message := model.NewWebSocketEvent(model.WEBSOCKET_EVENT_POST_EDITED, "", model.NewId(), "", nil)
+	message.Add("post", "{}")
+
+	event := model.NewOutboxEvent(message)
+	event.CreateAt = createAt
+	return event
+}
+
+// undeliveredOutboxEventIds returns the ids of the undelivered events among the given ones, in the
+// order they are returned, ignoring the events saved by other tests.
+func undeliveredOutboxEventIds(t *testing.T, ss store.Store, createdBefore int64, events ...*model.OutboxEvent) []string {
+	undelivered, err := ss.Outbox().GetUndelivered(createdBefore, 1000)
+	require.Nil(t, err)
+
+	wanted := map[string]bool{}
+	for _, event := range events {
+		wanted[event.Id] = true
+	}
+
+	ids := []string{}
+	for _, event := range undelivered {
+		if wanted[event.Id] {
+			ids = append(ids, event.Id)
+		}
+	}
+	return ids
+}
+
+func testOutboxStoreSave(t *testing.T, ss store.Store) {
+	t.Run("should save event", func(t *testing.T) {
+		saved, err := ss.Outbox().Save(newTestOutboxEvent(0))
+		require.Nil(t, err)
+		defer ss.Outbox().MarkDelivered([]string{saved.Id}, 1)
+
+		assert.NotEmpty(t, saved.Id)
+		assert.NotZero(t, saved.CreateAt)
+		assert.Zero(t, saved.DeliveredAt)
+	})
+
+	t.Run("should fail to save an existing event", func(t *testing.T) {
+		event := newTestOutboxEvent(0)
+		event.Id = model.NewId()
+
+		_, err := ss.Outbox().Save(event)
+		require.NotNil(t, err)
+	})
+
+	t.Run("should fail to save an invalid event", func(t *testing.T) {
+		event := newTestOutboxEvent(0)
+		event.Event = "{"
+
+		_, err := ss.Outbox().Save(event)
+		require.NotNil(t, err)
+	})
+}
+
+func testOutboxStoreGetUndelivered(t *testing.T, ss store.Store) {
+	e1, err := ss.Outbox().Save(newTestOutboxEvent(1000))
+	require.Nil(t, err)
+	e2, err := ss.Outbox().Save(newTestOutboxEvent(2000))
+	require.Nil(t, err)
+	e3, err := ss.Outbox().Save(newTestOutboxEvent(3000))
+	require.Nil(t, err)
+	defer ss.Outbox().MarkDelivered([]string{e1.Id, e2.Id, e3.Id}, 1)
+
+	t.Run("should return the events created before the given time, oldest first", func(t *testing.T) {
+		assert.Equal(t, []string{e1.Id, e2.Id}, undeliveredOutboxEventIds(t, ss, 3000, e1, e2, e3))
+		assert.Equal(t, []string{e1.Id, e2.Id, e3.Id}, undeliveredOutboxEventIds(t, ss, 3001, e1, e2, e3))
+	})
+
+	t.Run("should skip the delivered events", func(t *testing.T) {
+		require.Nil(t, ss.Outbox().MarkDelivered([]string{e2.Id}, model.GetMillis()))
+
+		assert.Equal(t, []string{e1.Id, e3.Id}, undeliveredOutboxEventIds(t, ss, 3001, e1, e2, e3))
+	})
+
+	t.Run("should limit the events", func(t *testing.T) {
+		events, err := ss.Outbox().GetUndelivered(3001, 1)
+		require.Nil(t, err)
+		assert.Len(t, events, 1)
+	})
+}
+
+func testOutboxStoreMarkDelivered(t *testing.T, ss store.Store) {
+	e1, err := ss.Outbox().Save(newTestOutboxEvent(1000))
+	require.Nil(t, err)
+	e2, err := ss.Outbox().Save(newTestOutboxEvent(1000))
+	require.Nil(t, err)
+	defer ss.Outbox().MarkDelivered([]string{e1.Id, e2.Id}, 1)
+
+	require.Nil(t, ss.Outbox().MarkDelivered([]string{}, 1))
+	require.Nil(t, ss.Outbox().MarkDelivered([]string{e1.Id}, 1234))
+	assert.Equal(t, []string{e2.Id}, undeliveredOutboxEventIds(t, ss, 1001, e1, e2))
+
+	// Marking an event delivered again keeps the time of its first delivery.
+	require.Nil(t, ss.Outbox().MarkDelivered([]string{e1.Id}, 5678))
+	deleted, err := ss.Outbox().PermanentDeleteBatch(1235, 1000)
+	require.Nil(t, err)
+	assert.GreaterOrEqual(t, deleted, int64(1))
+}
+
+func testOutboxStorePermanentDeleteBatch(t *testing.T, ss store.Store) {
+	delivered, err := ss.Outbox().Save(newTestOutboxEvent(1000))
+	require.Nil(t, err)
+	require.Nil(t, ss.Outbox().MarkDelivered([]string{delivered.Id}, 2000))
+
+	undelivered, err := ss.Outbox().Save(newTestOutboxEvent(1000))
+	require.Nil(t, err)
+	defer ss.Outbox().MarkDelivered([]string{undelivered.Id}, 1)
+
+	// Removes the events delivered by the other tests first.
+	_, err = ss.Outbox().PermanentDeleteBatch(2000, 1000)
+	require.Nil(t, err)
+
+	deleted, err := ss.Outbox().PermanentDeleteBatch(2000, 1000)
+	require.Nil(t, err)
+	assert.Zero(t, deleted)
+
+	deleted, err = ss.Outbox().PermanentDeleteBatch(2001, 1000)
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	assert.Equal(t, []string{undelivered.Id}, undeliveredOutboxEventIds(t, ss, 1001, delivered, undelivered))
+}
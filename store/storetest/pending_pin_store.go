@@ -0,0 +1,128 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+func TestPendingPinStore(t *testing.T, ss store.Store) {
+	t.Run("Save", func(t *testing.T) { testPendingPinStoreSave(t, ss) })
+	t.Run("GetForChannel", func(t *testing.T) { testPendingPinStoreGetForChannel(t, ss) })
+	t.Run("Delete", func(t *testing.T) { testPendingPinStoreDelete(t, ss) })
+	t.Run("PermanentDeleteByChannel", func(t *testing.T) { testPendingPinStorePermanentDeleteByChannel(t, ss) })
+}
+
+func newTestPendingPin(channelId string) *model.PendingPin {
+	return &model.PendingPin{
+		PostId:    model.NewId(),
+		ChannelId: channelId,
+		UserId:    model.NewId(),
+	}
+}
+
+func testPendingPinStoreSave(t *testing.T, ss store.Store) {
+	t.Run("should save pending pin", func(t *testing.T) {
+		saved, err := ss.PendingPin().Save(newTestPendingPin(model.NewId()))
+		require.Nil(t, err)
+		assert.NotZero(t, saved.CreateAt)
+
+		fetched, err := ss.PendingPin().Get(saved.PostId)
+		require.Nil(t, err)
+		assert.Equal(t, saved, fetched)
+	})
+
+	t.Run("should fail to request pinning the same post twice", func(t *testing.T) {
+		pendingPin, err := ss.PendingPin().Save(newTestPendingPin(model.NewId()))
+		require.Nil(t, err)
+
+		_, err = ss.PendingPin().Save(&model.PendingPin{PostId: pendingPin.PostId, ChannelId: pendingPin.ChannelId, UserId: model.NewId()})
+		var cErr *store.ErrConflict
+		assert.True(t, errors.As(err, &cErr))
+	})
+
+	t.Run("should fail to save invalid pending pin", func(t *testing.T) {
+		pendingPin := newTestPendingPin(model.NewId())
+		pendingPin.UserId = ""
+
+		_, err := ss.PendingPin().Save(pendingPin)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("should fail to get missing pending pin", func(t *testing.T) {
+		_, err := ss.PendingPin().Get(model.NewId())
+		var nfErr *store.ErrNotFound
+		assert.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testPendingPinStoreGetForChannel(t *testing.T, ss store.Store) {
+	channelId := model.NewId()
+
+	first := newTestPendingPin(channelId)
+	first.CreateAt = 1000
+	_, err := ss.PendingPin().Save(first)
+	require.Nil(t, err)
+
+	second := newTestPendingPin(channelId)
+	second.CreateAt = 2000
+	_, err = ss.PendingPin().Save(second)
+	require.Nil(t, err)
+
+	_, err = ss.PendingPin().Save(newTestPendingPin(model.NewId()))
+	require.Nil(t, err)
+
+	pendingPins, err := ss.PendingPin().GetForChannel(channelId)
+	require.Nil(t, err)
+	require.Len(t, pendingPins, 2)
+	assert.Equal(t, first.PostId, pendingPins[0].PostId)
+	assert.Equal(t, second.PostId, pendingPins[1].PostId)
+
+	pendingPins, err = ss.PendingPin().GetForChannel(model.NewId())
+	require.Nil(t, err)
+	assert.Empty(t, pendingPins)
+}
+
+func testPendingPinStoreDelete(t *testing.T, ss store.Store) {
+	pendingPin, err := ss.PendingPin().Save(newTestPendingPin(model.NewId()))
+	require.Nil(t, err)
+
+	err = ss.PendingPin().Delete(pendingPin.PostId)
+	require.Nil(t, err)
+
+	_, err = ss.PendingPin().Get(pendingPin.PostId)
+	var nfErr *store.ErrNotFound
+	assert.True(t, errors.As(err, &nfErr))
+
+	err = ss.PendingPin().Delete(pendingPin.PostId)
+	assert.True(t, errors.As(err, &nfErr))
+}
+
+func testPendingPinStorePermanentDeleteByChannel(t *testing.T, ss store.Store) {
+	channelId := model.NewId()
+	_, err := ss.PendingPin().Save(newTestPendingPin(channelId))
+	require.Nil(t, err)
+	_, err = ss.PendingPin().Save(newTestPendingPin(channelId))
+	require.Nil(t, err)
+
+	other, err := ss.PendingPin().Save(newTestPendingPin(model.NewId()))
+	require.Nil(t, err)
+
+	err = ss.PendingPin().PermanentDeleteByChannel(channelId)
+	require.Nil(t, err)
+
+	pendingPins, err := ss.PendingPin().GetForChannel(channelId)
+	require.Nil(t, err)
+	assert.Empty(t, pendingPins)
+
+	_, err = ss.PendingPin().Get(other.PostId)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+func TestPostAcknowledgementStore(t *testing.T, ss store.Store) {
+	t.Run("Save", func(t *testing.T) { testPostAcknowledgementStoreSave(t, ss) })
+	t.Run("GetForPost", func(t *testing.T) { testPostAcknowledgementStoreGetForPost(t, ss) })
+	t.Run("Delete", func(t *testing.T) { testPostAcknowledgementStoreDelete(t, ss) })
+	t.Run("PermanentDeleteByChannel", func(t *testing.T) { testPostAcknowledgementStorePermanentDeleteByChannel(t, ss) })
+	t.Run("PermanentDeleteByUser", func(t *testing.T) { testPostAcknowledgementStorePermanentDeleteByUser(t, ss) })
+}
+
+func newTestPostAcknowledgement(postId, userId string) *model.PostAcknowledgement {
+	return &model.PostAcknowledgement{
+		PostId:    postId,
+		UserId:    userId,
+		ChannelId: model.NewId(),
+	}
+}
+
+func newTestPostAcknowledgementInChannel(postId, userId, channelId string) *model.PostAcknowledgement {
+	acknowledgement := newTestPostAcknowledgement(postId, userId)
+	acknowledgement.ChannelId = channelId
+	return acknowledgement
+}
+
+func testPostAcknowledgementStoreSave(t *testing.T, ss store.Store) {
+	t.Run("should save acknowledgement", func(t *testing.T) {
+		saved, err := ss.PostAcknowledgement().Save(newTestPostAcknowledgement(model.NewId(), model.NewId()))
+		require.Nil(t, err)
+		assert.NotZero(t, saved.AcknowledgedAt)
+
+		acknowledgements, err := ss.PostAcknowledgement().GetForPost(saved.PostId)
+		require.Nil(t, err)
+		require.Len(t, acknowledgements, 1)
+		assert.Equal(t, saved, acknowledgements[0])
+	})
+
+	t.Run("should fail to acknowledge the same post twice", func(t *testing.T) {
+		acknowledgement, err := ss.PostAcknowledgement().Save(newTestPostAcknowledgement(model.NewId(), model.NewId()))
+		require.Nil(t, err)
+
+		_, err = ss.PostAcknowledgement().Save(newTestPostAcknowledgement(acknowledgement.PostId, acknowledgement.UserId))
+		var cErr *store.ErrConflict
+		assert.True(t, errors.As(err, &cErr))
+	})
+
+	t.Run("should fail to save invalid acknowledgement", func(t *testing.T) {
+		_, err := ss.PostAcknowledgement().Save(newTestPostAcknowledgement(model.NewId(), ""))
+		assert.NotNil(t, err)
+	})
+}
+
+func testPostAcknowledgementStoreGetForPost(t *testing.T, ss store.Store) {
+	postId := model.NewId()
+
+	first := newTestPostAcknowledgement(postId, model.NewId())
+	first.AcknowledgedAt = 1000
+	_, err := ss.PostAcknowledgement().Save(first)
+	require.Nil(t, err)
+
+	second := newTestPostAcknowledgement(postId, model.NewId())
+	second.AcknowledgedAt = 2000
+	_, err = ss.PostAcknowledgement().Save(second)
+	require.Nil(t, err)
+
+	_, err = ss.PostAcknowledgement().Save(newTestPostAcknowledgement(model.NewId(), first.UserId))
+	require.Nil(t, err)
+
+	acknowledgements, err := ss.PostAcknowledgement().GetForPost(postId)
+	require.Nil(t, err)
+	assert.Equal(t, []*model.PostAcknowledgement{first, second}, acknowledgements)
+
+	acknowledgements, err = ss.PostAcknowledgement().GetForPost(model.NewId())
+	require.Nil(t, err)
+	assert.Empty(t, acknowledgements)
+}
+
+func testPostAcknowledgementStoreDelete(t *testing.T, ss store.Store) {
+	acknowledgement, err := ss.PostAcknowledgement().Save(newTestPostAcknowledgement(model.NewId(), model.NewId()))
+	require.Nil(t, err)
+
+	err = ss.PostAcknowledgement().Delete(acknowledgement.PostId, acknowledgement.UserId)
+	require.Nil(t, err)
+
+	acknowledgements, err := ss.PostAcknowledgement().GetForPost(acknowledgement.PostId)
+	require.Nil(t, err)
+	assert.Empty(t, acknowledgements)
+
+	err = ss.PostAcknowledgement().Delete(acknowledgement.PostId, acknowledgement.UserId)
+	var nfErr *store.ErrNotFound
+	assert.True(t, errors.As(err, &nfErr))
+}
+
+func testPostAcknowledgementStorePermanentDeleteByChannel(t *testing.T, ss store.Store) {
+	channelId := model.NewId()
+	postId := model.NewId()
+	otherPostId := model.NewId()
+	userId := model.NewId()
+
+	_, err := ss.PostAcknowledgement().Save(newTestPostAcknowledgementInChannel(postId, userId, channelId))
+	require.Nil(t, err)
+	_, err = ss.PostAcknowledgement().Save(newTestPostAcknowledgementInChannel(postId, model.NewId(), channelId))
+	require.Nil(t, err)
+	_, err = ss.PostAcknowledgement().Save(newTestPostAcknowledgement(otherPostId, userId))
+	require.Nil(t, err)
+
+	err = ss.PostAcknowledgement().PermanentDeleteByChannel(channelId)
+	require.Nil(t, err)
+
+	acknowledgements, err := ss.PostAcknowledgement().GetForPost(postId)
+	require.Nil(t, err)
+	assert.Empty(t, acknowledgements)
+
+	acknowledgements, err = ss.PostAcknowledgement().GetForPost(otherPostId)
+	require.Nil(t, err)
+	assert.Len(t, acknowledgements, 1)
+}
+
+func testPostAcknowledgementStorePermanentDeleteByUser(t *testing.T, ss store.Store) {
+	postId := model.NewId()
+	userId := model.NewId()
+	otherUserId := model.NewId()
+
+	_, err := ss.PostAcknowledgement().Save(newTestPostAcknowledgement(postId, userId))
+	require.Nil(t, err)
+	_, err = ss.PostAcknowledgement().Save(newTestPostAcknowledgement(postId, otherUserId))
+	require.Nil(t, err)
+
+	err = ss.PostAcknowledgement().PermanentDeleteByUser(userId)
+	require.Nil(t, err)
+
+	acknowledgements, err := ss.PostAcknowledgement().GetForPost(postId)
+	require.Nil(t, err)
+	require.Len(t, acknowledgements, 1)
+	assert.Equal(t, otherUserId, acknowledgements[0].UserId)
+}
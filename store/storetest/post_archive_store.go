@@ -0,0 +1,124 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+func TestPostArchiveStore(t *testing.T, ss store.Store) {
+	t.Run("ArchiveBatch", func(t *testing.T) { testPostArchiveStoreArchiveBatch(t, ss) })
+	t.Run("Search", func(t *testing.T) { testPostArchiveStoreSearch(t, ss) })
+}
+
+func saveTestArchivePost(t *testing.T, ss store.Store, channelId, rootId, message string, createAt int64) *model.Post {
+	post, err := ss.Post().Save(&model.Post{
+		ChannelId: channelId,
+		UserId:    model.NewId(),
+		RootId:    rootId,
+		ParentId:  rootId,
+		Message:   message,
+		CreateAt:  createAt,
+	})
+	require.Nil(t, err)
+	return post
+}
+
+func archiveAllPosts(t *testing.T, ss store.Store, endTime int64) {
+	for {
+		archived, err := ss.PostArchive().ArchiveBatch(endTime, 100)
+		require.Nil(t, err)
+		if archived == 0 {
+			return
+		}
+	}
+}
+
+func testPostArchiveStoreArchiveBatch(t *testing.T, ss store.Store) {
+	channelId := model.NewId()
+	endTime := int64(5000)
+
+	oldRoot := saveTestArchivePost(t, ss, channelId, "", "old root", 1000)
+	oldReply := saveTestArchivePost(t, ss, channelId, oldRoot.Id, "old reply", 1001)
+	activeRoot := saveTestArchivePost(t, ss, channelId, "", "active root", 1002)
+	activeReply := saveTestArchivePost(t, ss, channelId, activeRoot.Id, "active reply", endTime+1)
+	recent := saveTestArchivePost(t, ss, channelId, "", "recent", endTime+2)
+
+	archiveAllPosts(t, ss, endTime)
+
+	t.Run("old threads are moved out of the posts table", func(t *testing.T) {
+		for _, id := range []string{oldRoot.Id, oldReply.Id} {
+			_, appErr := ss.Post().GetSingle(id)
+			require.NotNil(t, appErr)
+
+			post, err := ss.PostArchive().Get(id)
+			require.Nil(t, err)
+			assert.True(t, post.IsArchived)
+			assert.Equal(t, channelId, post.ChannelId)
+		}
+	})
+
+	t.Run("threads with recent replies and recent posts are kept", func(t *testing.T) {
+		for _, id := range []string{activeRoot.Id, activeReply.Id, recent.Id} {
+			_, appErr := ss.Post().GetSingle(id)
+			require.Nil(t, appErr)
+
+			_, err := ss.PostArchive().Get(id)
+			var nfErr *store.ErrNotFound
+			assert.True(t, errors.As(err, &nfErr))
+		}
+	})
+
+	t.Run("archived thread is returned whole", func(t *testing.T) {
+		list, err := ss.PostArchive().GetThread(oldReply.Id)
+		require.Nil(t, err)
+		assert.Equal(t, oldReply.Id, list.Order[0])
+		require.Len(t, list.Posts, 2)
+		assert.Equal(t, "old root", list.Posts[oldRoot.Id].Message)
+		assert.Equal(t, int64(1), list.Posts[oldRoot.Id].ReplyCount)
+	})
+
+	t.Run("missing post", func(t *testing.T) {
+		_, err := ss.PostArchive().GetThread(model.NewId())
+		var nfErr *store.ErrNotFound
+		assert.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testPostArchiveStoreSearch(t *testing.T, ss store.Store) {
+	channelId := model.NewId()
+	otherChannelId := model.NewId()
+
+	first := saveTestArchivePost(t, ss, channelId, "", "The quarterly Report 100%", 1000)
+	second := saveTestArchivePost(t, ss, channelId, "", "another quarterly report", 1001)
+	saveTestArchivePost(t, ss, otherChannelId, "", "quarterly report elsewhere", 1002)
+
+	archiveAllPosts(t, ss, 5000)
+
+	posts, err := ss.PostArchive().Search([]string{channelId}, []string{"quarterly", "REPORT"}, 10)
+	require.Nil(t, err)
+	require.Len(t, posts, 2)
+	assert.Equal(t, second.Id, posts[0].Id)
+	assert.Equal(t, first.Id, posts[1].Id)
+
+	posts, err = ss.PostArchive().Search([]string{channelId}, []string{"100%"}, 10)
+	require.Nil(t, err)
+	require.Len(t, posts, 1)
+	assert.Equal(t, first.Id, posts[0].Id)
+
+	posts, err = ss.PostArchive().Search([]string{channelId}, []string{"quarterly"}, 1)
+	require.Nil(t, err)
+	assert.Len(t, posts, 1)
+
+	posts, err = ss.PostArchive().Search(nil, []string{"quarterly"}, 10)
+	require.Nil(t, err)
+	assert.Empty(t, posts)
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+func TestPostIntegrityStore(t *testing.T, ss store.Store) {
+	t.Run("Append", func(t *testing.T) { testPostIntegrityStoreAppend(t, ss) })
+	t.Run("GetForChannel", func(t *testing.T) { testPostIntegrityStoreGetForChannel(t, ss) })
+	t.Run("GetChannelIds", func(t *testing.T) { testPostIntegrityStoreGetChannelIds(t, ss) })
+}
+
+func newTestIntegrityPost(channelId string) *model.Post {
+	return &model.Post{
+		Id:        model.NewId(),
+		ChannelId: channelId,
+		UserId:    model.NewId(),
+		CreateAt:  model.GetMillis(),
+		Message:   "message " + model.NewId(),
+	}
+}
+
+func testPostIntegrityStoreAppend(t *testing.T, ss store.Store) {
+	channelId := model.NewId()
+	post := newTestIntegrityPost(channelId)
+
+	first, err := ss.PostIntegrity().Append(post)
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), first.Seq)
+	assert.Equal(t, model.PostContentHash(post), first.ContentHash)
+	assert.Equal(t, first.ComputeHash(""), first.Hash)
+
+	post.Message = "edited"
+	second, err := ss.PostIntegrity().Append(post)
+	require.Nil(t, err)
+	assert.Equal(t, int64(2), second.Seq)
+	assert.Equal(t, model.PostContentHash(post), second.ContentHash)
+	assert.Equal(t, second.ComputeHash(first.Hash), second.Hash)
+
+	other, err := ss.PostIntegrity().Append(newTestIntegrityPost(model.NewId()))
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), other.Seq, "chains are kept per channel")
+}
+
+func testPostIntegrityStoreGetForChannel(t *testing.T, ss store.Store) {
+	channelId := model.NewId()
+	var entries []*model.PostIntegrityEntry
+	for i := 0; i < 3; i++ {
+		entry, err := ss.PostIntegrity().Append(newTestIntegrityPost(channelId))
+		require.Nil(t, err)
+		entries = append(entries, entry)
+	}
+	_, err := ss.PostIntegrity().Append(newTestIntegrityPost(model.NewId()))
+	require.Nil(t, err)
+
+	result, err := ss.PostIntegrity().GetForChannel(channelId, 0, 2)
+	require.Nil(t, err)
+	assert.Equal(t, entries[:2], result)
+
+	result, err = ss.PostIntegrity().GetForChannel(channelId, 2, 2)
+	require.Nil(t, err)
+	assert.Equal(t, entries[2:], result)
+
+	result, err = ss.PostIntegrity().GetForChannel(channelId, 3, 2)
+	require.Nil(t, err)
+	assert.Empty(t, result)
+}
+
+func testPostIntegrityStoreGetChannelIds(t *testing.T, ss store.Store) {
+	channelId1 := model.NewId()
+	channelId2 := model.NewId()
+	for _, channelId := range []string{channelId1, channelId1, channelId2} {
+		_, err := ss.PostIntegrity().Append(newTestIntegrityPost(channelId))
+		require.Nil(t, err)
+	}
+
+	var channelIds []string
+	afterChannelId := ""
+	for {
+		result, err := ss.PostIntegrity().GetChannelIds(afterChannelId, 1)
+		require.Nil(t, err)
+		if len(result) == 0 {
+			break
+		}
+		require.Len(t, result, 1)
+		channelIds = append(channelIds, result[0])
+		afterChannelId = result[0]
+	}
+
+	assert.Contains(t, channelIds, channelId1)
+	assert.Contains(t, channelIds, channelId2)
+	seen := map[string]bool{}
+	for _, channelId := range channelIds {
+		assert.False(t, seen[channelId], "channel ids are distinct")
+		seen[channelId] = true
+	}
+}
@@ -4,6 +4,7 @@
 package storetest
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
@@ -26,6 +27,9 @@ func TestPostStore(t *testing.T, ss store.Store, s SqlSupplier) {
 	t.Run("Update", func(t *testing.T) { testPostStoreUpdate(t, ss) })
 	t.Run("Delete", func(t *testing.T) { testPostStoreDelete(t, ss) })
 	t.Run("Delete1Level", func(t *testing.T) { testPostStoreDelete1Level(t, ss) })
+	t.Run("SaveWithOutboxEvent", func(t *testing.T) { testPostStoreSaveWithOutboxEvent(t, ss) })
+	t.Run("UpdateWithOutboxEvent", func(t *testing.T) { testPostStoreUpdateWithOutboxEvent(t, ss) })
+	t.Run("DeleteWithOutboxEvent", func(t *testing.T) { testPostStoreDeleteWithOutboxEvent(t, ss) })
 	t.Run("Delete2Level", func(t *testing.T) { testPostStoreDelete2Level(t, ss) })
 	t.Run("PermDelete1Level", func(t *testing.T) { testPostStorePermDelete1Level(t, ss) })
 	t.Run("PermDelete1Level2", func(t *testing.T) { testPostStorePermDelete1Level2(t, ss) })
@@ -33,6 +37,7 @@ func TestPostStore(t *testing.T, ss store.Store, s SqlSupplier) {
 	t.Run("GetPostsWithDetails", func(t *testing.T) { testPostStoreGetPostsWithDetails(t, ss) })
 	t.Run("GetPostsBeforeAfter", func(t *testing.T) { testPostStoreGetPostsBeforeAfter(t, ss) })
 	t.Run("GetPostsSince", func(t *testing.T) { testPostStoreGetPostsSince(t, ss) })
+	t.Run("GetPostsDelta", func(t *testing.T) { testPostStoreGetPostsDelta(t, ss) })
 	t.Run("GetPosts", func(t *testing.T) { testPostStoreGetPosts(t, ss) })
 	t.Run("GetPostBeforeAfter", func(t *testing.T) { testPostStoreGetPostBeforeAfter(t, ss) })
 	t.Run("UserCountsWithPostsByDay", func(t *testing.T) { testUserCountsWithPostsByDay(t, ss) })
@@ -53,6 +58,7 @@ func TestPostStore(t *testing.T, ss store.Store, s SqlSupplier) {
 	t.Run("GetDirectPostParentsForExportAfter", func(t *testing.T) { testPostStoreGetDirectPostParentsForExportAfter(t, ss, s) })
 	t.Run("GetDirectPostParentsForExportAfterDeleted", func(t *testing.T) { testPostStoreGetDirectPostParentsForExportAfterDeleted(t, ss, s) })
 	t.Run("GetDirectPostParentsForExportAfterBatched", func(t *testing.T) { testPostStoreGetDirectPostParentsForExportAfterBatched(t, ss, s) })
+	t.Run("GetPostsForChannelSnapshot", func(t *testing.T) { testPostStoreGetPostsForChannelSnapshot(t, ss) })
 }
 
 func testPostStoreSave(t *testing.T, ss store.Store) {
@@ -575,6 +581,106 @@ func testPostStoreDelete(t *testing.T, ss store.Store) {
 	require.Equal(t, 0, strings.Index(etag2, model.CurrentVersion+"."), "Invalid Etag")
 }
 
+// outboxEventPost returns the post held by the websocket event of the outbox event.
+func outboxEventPost(t *testing.T, event *model.OutboxEvent) *model.Post {
+	message := event.WebSocketEvent()
+	require.NotNil(t, message)
+
+	postJson, ok := message.GetData()["post"].(string)
+	require.True(t, ok)
+	return model.PostFromJson(strings.NewReader(postJson))
+}
+
+func testPostStoreSaveWithOutboxEvent(t *testing.T, ss store.Store) {
+	o1 := &model.Post{}
+	o1.ChannelId = model.NewId()
+	o1.UserId = model.NewId()
+	o1.Message = "zz" + model.NewId() + "b"
+
+	message := model.NewWebSocketEvent(model.WEBSOCKET_EVENT_POSTED, "", o1.ChannelId, "", nil)
+	message.Add("channel_type", model.CHANNEL_OPEN)
+
+	p1, event, err := ss.Post().SaveWithOutboxEvent(o1, message)
+	require.Nil(t, err)
+	require.NotNil(t, event)
+	defer ss.Outbox().MarkDelivered([]string{event.Id}, 1)
+
+	_, err = ss.Post().GetSingle(p1.Id)
+	require.Nil(t, err)
+
+	assert.Equal(t, []string{event.Id}, undeliveredOutboxEventIds(t, ss, event.CreateAt+1, event))
+	assert.Equal(t, p1.Id, outboxEventPost(t, event).Id)
+	assert.Equal(t, model.CHANNEL_OPEN, event.WebSocketEvent().GetData()["channel_type"])
+
+	t.Run("should save neither the post nor the event when the post is invalid", func(t *testing.T) {
+		o2 := &model.Post{}
+		o2.ChannelId = "invalid"
+		o2.UserId = model.NewId()
+
+		_, event, err := ss.Post().SaveWithOutboxEvent(o2, model.NewWebSocketEvent(model.WEBSOCKET_EVENT_POSTED, "", o2.ChannelId, "", nil))
+		require.NotNil(t, err)
+		require.Nil(t, event)
+	})
+}
+
+func testPostStoreUpdateWithOutboxEvent(t *testing.T, ss store.Store) {
+	o1 := &model.Post{}
+	o1.ChannelId = model.NewId()
+	o1.UserId = model.NewId()
+	o1.Message = "zz" + model.NewId() + "b"
+	o1, err := ss.Post().Save(o1)
+	require.Nil(t, err)
+
+	newPost := o1.Clone()
+	newPost.Message = "edited " + o1.Message
+
+	message := model.NewWebSocketEvent(model.WEBSOCKET_EVENT_POST_EDITED, "", o1.ChannelId, "", nil)
+	updated, event, err := ss.Post().UpdateWithOutboxEvent(newPost, o1.Clone(), message)
+	require.Nil(t, err)
+	require.NotNil(t, event)
+	defer ss.Outbox().MarkDelivered([]string{event.Id}, 1)
+
+	stored, err := ss.Post().GetSingle(o1.Id)
+	require.Nil(t, err)
+	assert.Equal(t, newPost.Message, stored.Message)
+
+	eventPost := outboxEventPost(t, event)
+	assert.Equal(t, updated.Message, eventPost.Message)
+	assert.Equal(t, updated.UpdateAt, eventPost.UpdateAt)
+}
+
+func testPostStoreDeleteWithOutboxEvent(t *testing.T, ss store.Store) {
+	o1 := &model.Post{}
+	o1.ChannelId = model.NewId()
+	o1.UserId = model.NewId()
+	o1.Message = "zz" + model.NewId() + "b"
+	o1, err := ss.Post().Save(o1)
+	require.Nil(t, err)
+
+	deleteByID := model.NewId()
+	deleteAt := model.GetMillis()
+
+	message := model.NewWebSocketEvent(model.WEBSOCKET_EVENT_POST_DELETED, "", o1.ChannelId, "", nil)
+	event, err := ss.Post().DeleteWithOutboxEvent(o1.Id, deleteAt, deleteByID, message)
+	require.Nil(t, err)
+	require.NotNil(t, event)
+	defer ss.Outbox().MarkDelivered([]string{event.Id}, 1)
+
+	_, err = ss.Post().GetSingle(o1.Id)
+	require.NotNil(t, err)
+
+	eventPost := outboxEventPost(t, event)
+	assert.Equal(t, o1.Id, eventPost.Id)
+	assert.Equal(t, deleteAt, eventPost.DeleteAt)
+	assert.Equal(t, deleteByID, eventPost.GetProp(model.POST_PROPS_DELETE_BY))
+
+	t.Run("should not record an event when the post doesn't exist", func(t *testing.T) {
+		event, err := ss.Post().DeleteWithOutboxEvent(model.NewId(), deleteAt, deleteByID, model.NewWebSocketEvent(model.WEBSOCKET_EVENT_POST_DELETED, "", "", "", nil))
+		require.NotNil(t, err)
+		require.Nil(t, event)
+	})
+}
+
 func testPostStoreDelete1Level(t *testing.T, ss store.Store) {
 	o1 := &model.Post{}
 	o1.ChannelId = model.NewId()
@@ -888,6 +994,8 @@ func testPostStoreGetPostsBeforeAfter(t *testing.T, ss store.Store) {
 			})
 			require.Nil(t, err)
 
+			// The change sequence is only populated on the posts returned by mutations.
+			post.ChangeSeq = 0
 			posts = append(posts, post)
 
 			time.Sleep(time.Millisecond)
@@ -1032,6 +1140,11 @@ func testPostStoreGetPostsBeforeAfter(t *testing.T, ss store.Store) {
 		post1.UpdateAt = post3.UpdateAt
 		post2.UpdateAt = post6.UpdateAt
 
+		// The change sequence is only populated on the posts returned by mutations.
+		for _, post := range []*model.Post{post1, post2, post3, post4, post5, post6} {
+			post.ChangeSeq = 0
+		}
+
 		t.Run("should return each post and thread before a post", func(t *testing.T) {
 			postList, err := ss.Post().GetPostsBefore(model.GetPostsOptions{ChannelId: channelId, PostId: post4.Id, PerPage: 2})
 			assert.Nil(t, err)
@@ -1133,6 +1246,11 @@ func testPostStoreGetPostsBeforeAfter(t *testing.T, ss store.Store) {
 		post1.UpdateAt = post3.UpdateAt
 		post2.UpdateAt = post6.UpdateAt
 
+		// The change sequence is only populated on the posts returned by mutations.
+		for _, post := range []*model.Post{post1, post2, post3, post4, post5, post6} {
+			post.ChangeSeq = 0
+		}
+
 		t.Run("should return each post and thread before a post", func(t *testing.T) {
 			postList, err := ss.Post().GetPostsBefore(model.GetPostsOptions{ChannelId: channelId, PostId: post4.Id, PerPage: 2, SkipFetchThreads: true})
 			assert.Nil(t, err)
@@ -1170,6 +1288,88 @@ func testPostStoreGetPostsBeforeAfter(t *testing.T, ss store.Store) {
 	})
 }
 
+func testPostStoreGetPostsDelta(t *testing.T, ss store.Store) {
+	channelId := model.NewId()
+	userId := model.NewId()
+
+	savePost := func(createAt int64) *model.Post {
+		post, err := ss.Post().Save(&model.Post{
+			ChannelId: channelId,
+			UserId:    userId,
+			Message:   "message",
+			CreateAt:  createAt,
+		})
+		require.Nil(t, err)
+		return post
+	}
+
+	savePost(1000)
+	editedPost := savePost(1100)
+	updatedPost := savePost(1200)
+	deletedPost := savePost(1300)
+	newPost := savePost(2000)
+
+	editedPost.EditAt = 2000
+	editedPost.Message = "edited"
+	_, err := ss.Post().Overwrite(editedPost)
+	require.Nil(t, err)
+
+	updatedPost.HasReactions = true
+	_, err = ss.Post().Overwrite(updatedPost)
+	require.Nil(t, err)
+
+	require.Nil(t, ss.Post().Delete(deletedPost.Id, 2000, userId))
+
+	t.Run("should split the changes since the given time", func(t *testing.T) {
+		delta, next, err := ss.Post().GetPostsDelta(model.NewPostsDeltaCursor(channelId, 1500), 100)
+		require.Nil(t, err)
+		assert.False(t, delta.HasMore)
+
+		require.Len(t, delta.New, 1)
+		assert.Equal(t, newPost.Id, delta.New[0].Id)
+		require.Len(t, delta.Edited, 1)
+		assert.Equal(t, editedPost.Id, delta.Edited[0].Id)
+		assert.Equal(t, "edited", delta.Edited[0].Message)
+		require.Len(t, delta.Updated, 1)
+		assert.Equal(t, updatedPost.Id, delta.Updated[0].Id)
+		assert.Equal(t, []string{deletedPost.Id}, delta.Deleted)
+
+		assert.Equal(t, next.UpdateAt, next.Since)
+
+		delta, _, err = ss.Post().GetPostsDelta(next, 100)
+		require.Nil(t, err)
+		assert.Empty(t, delta.New)
+		assert.Empty(t, delta.Edited)
+		assert.Empty(t, delta.Updated)
+		assert.Empty(t, delta.Deleted)
+	})
+
+	t.Run("should page through the changes", func(t *testing.T) {
+		cursor := model.NewPostsDeltaCursor(channelId, 1500)
+		var changed []string
+		for {
+			delta, next, err := ss.Post().GetPostsDelta(cursor, 1)
+			require.Nil(t, err)
+			assert.Equal(t, int64(1500), cursor.Since, "posts are classified relative to the start of the sync")
+
+			for _, post := range append(delta.New, delta.Edited...) {
+				changed = append(changed, post.Id)
+			}
+			for _, update := range delta.Updated {
+				changed = append(changed, update.Id)
+			}
+			changed = append(changed, delta.Deleted...)
+
+			cursor = next
+			if !delta.HasMore {
+				break
+			}
+		}
+
+		assert.ElementsMatch(t, []string{newPost.Id, editedPost.Id, updatedPost.Id, deletedPost.Id}, changed)
+	})
+}
+
 func testPostStoreGetPostsSince(t *testing.T, ss store.Store) {
 	t.Run("should return posts created after the given time", func(t *testing.T) {
 		channelId := model.NewId()
@@ -1514,7 +1714,7 @@ func testUserCountsWithPostsByDay(t *testing.T, ss store.Store) {
 	t1.Name = "zz" + model.NewId() + "b"
 	t1.Email = MakeEmail()
 	t1.Type = model.TEAM_OPEN
-	t1, err := ss.Team().Save(t1)
+	t1, err := ss.Team().Save(context.Background(), t1)
 	require.Nil(t, err)
 
 	c1 := &model.Channel{}
@@ -1573,7 +1773,7 @@ func testPostCountsByDay(t *testing.T, ss store.Store) {
 	t1.Name = "zz" + model.NewId() + "b"
 	t1.Email = MakeEmail()
 	t1.Type = model.TEAM_OPEN
-	t1, err := ss.Team().Save(t1)
+	t1, err := ss.Team().Save(context.Background(), t1)
 	require.Nil(t, err)
 
 	c1 := &model.Channel{}
@@ -1674,9 +1874,20 @@ func testPostCountsByDay(t *testing.T, ss store.Store) {
 	assert.Equal(t, float64(1), r1[0].Value)
 
 	// total
-	r2, err := ss.Post().AnalyticsPostCount(t1.Id, false, false)
+	r2, err := ss.Post().AnalyticsPostCount(t1.Id, false, false, false)
 	require.Nil(t, err)
 	assert.Equal(t, int64(6), r2)
+
+	// the estimate is only used for the whole table, and is exact on small tables
+	r2, err = ss.Post().AnalyticsPostCount(t1.Id, false, false, true)
+	require.Nil(t, err)
+	assert.Equal(t, int64(6), r2)
+
+	exact, err := ss.Post().AnalyticsPostCount("", false, false, false)
+	require.Nil(t, err)
+	estimated, err := ss.Post().AnalyticsPostCount("", false, false, true)
+	require.Nil(t, err)
+	assert.Equal(t, exact, estimated)
 }
 
 func testPostStoreGetFlaggedPostsForTeam(t *testing.T, ss store.Store, s SqlSupplier) {
@@ -2486,7 +2697,7 @@ func testPostStoreGetParentsForExportAfter(t *testing.T, ss store.Store) {
 	t1.Name = "zz" + model.NewId()
 	t1.Email = MakeEmail()
 	t1.Type = model.TEAM_OPEN
-	_, err := ss.Team().Save(&t1)
+	_, err := ss.Team().Save(context.Background(), &t1)
 	require.Nil(t, err)
 
 	c1 := model.Channel{}
@@ -2535,7 +2746,7 @@ func testPostStoreGetRepliesForExport(t *testing.T, ss store.Store) {
 	t1.Name = "zz" + model.NewId()
 	t1.Email = MakeEmail()
 	t1.Type = model.TEAM_OPEN
-	_, err := ss.Team().Save(&t1)
+	_, err := ss.Team().Save(context.Background(), &t1)
 	require.Nil(t, err)
 
 	c1 := model.Channel{}
@@ -2598,7 +2809,7 @@ func testPostStoreGetRepliesForExport(t *testing.T, ss store.Store) {
 }
 
 func testPostStoreGetDirectPostParentsForExportAfter(t *testing.T, ss store.Store, s SqlSupplier) {
-	teamId := model.NewId()
+	teamId := makeTeam(t, ss).Id
 
 	o1 := model.Channel{}
 	o1.TeamId = teamId
@@ -2611,16 +2822,16 @@ func testPostStoreGetDirectPostParentsForExportAfter(t *testing.T, ss store.Stor
 	u1.Nickname = model.NewId()
 	_, err := ss.User().Save(u1)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: makeTeam(t, ss).Id, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	u2 := &model.User{}
 	u2.Email = MakeEmail()
 	u2.Nickname = model.NewId()
 	_, err = ss.User().Save(u2)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: makeTeam(t, ss).Id, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	m1 := model.ChannelMember{}
 	m1.ChannelId = o1.Id
@@ -2652,7 +2863,7 @@ func testPostStoreGetDirectPostParentsForExportAfter(t *testing.T, ss store.Stor
 }
 
 func testPostStoreGetDirectPostParentsForExportAfterDeleted(t *testing.T, ss store.Store, s SqlSupplier) {
-	teamId := model.NewId()
+	teamId := makeTeam(t, ss).Id
 
 	o1 := model.Channel{}
 	o1.TeamId = teamId
@@ -2666,8 +2877,8 @@ func testPostStoreGetDirectPostParentsForExportAfterDeleted(t *testing.T, ss sto
 	u1.Nickname = model.NewId()
 	_, err := ss.User().Save(u1)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: makeTeam(t, ss).Id, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	u2 := &model.User{}
 	u2.DeleteAt = 1
@@ -2675,8 +2886,8 @@ func testPostStoreGetDirectPostParentsForExportAfterDeleted(t *testing.T, ss sto
 	u2.Nickname = model.NewId()
 	_, err = ss.User().Save(u2)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: makeTeam(t, ss).Id, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	m1 := model.ChannelMember{}
 	m1.ChannelId = o1.Id
@@ -2691,7 +2902,7 @@ func testPostStoreGetDirectPostParentsForExportAfterDeleted(t *testing.T, ss sto
 	ss.Channel().SaveDirectChannel(&o1, &m1, &m2)
 
 	o1.DeleteAt = 1
-	nErr := ss.Channel().SetDeleteAt(o1.Id, 1, 1)
+	nErr = ss.Channel().SetDeleteAt(o1.Id, 1, 1)
 	assert.Nil(t, nErr)
 
 	p1 := &model.Post{}
@@ -2718,7 +2929,7 @@ func testPostStoreGetDirectPostParentsForExportAfterDeleted(t *testing.T, ss sto
 }
 
 func testPostStoreGetDirectPostParentsForExportAfterBatched(t *testing.T, ss store.Store, s SqlSupplier) {
-	teamId := model.NewId()
+	teamId := makeTeam(t, ss).Id
 
 	o1 := model.Channel{}
 	o1.TeamId = teamId
@@ -2733,16 +2944,16 @@ func testPostStoreGetDirectPostParentsForExportAfterBatched(t *testing.T, ss sto
 		u1.Nickname = model.NewId()
 		_, err := ss.User().Save(u1)
 		require.Nil(t, err)
-		_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u1.Id}, -1)
-		require.Nil(t, err)
+		_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: makeTeam(t, ss).Id, UserId: u1.Id}, -1)
+		require.Nil(t, nErr)
 
 		u2 := &model.User{}
 		u2.Email = MakeEmail()
 		u2.Nickname = model.NewId()
 		_, err = ss.User().Save(u2)
 		require.Nil(t, err)
-		_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u2.Id}, -1)
-		require.Nil(t, err)
+		_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: makeTeam(t, ss).Id, UserId: u2.Id}, -1)
+		require.Nil(t, nErr)
 
 		m1 := model.ChannelMember{}
 		m1.ChannelId = o1.Id
@@ -2792,3 +3003,49 @@ func testPostStoreGetDirectPostParentsForExportAfterBatched(t *testing.T, ss sto
 	// Manually truncate Channels table until testlib can handle cleanups
 	s.GetMaster().Exec("TRUNCATE Channels")
 }
+
+func testPostStoreGetPostsForChannelSnapshot(t *testing.T, ss store.Store) {
+	channelId := model.NewId()
+	userId := model.NewId()
+
+	root, err := ss.Post().Save(&model.Post{ChannelId: channelId, UserId: userId, Message: "root", CreateAt: 1000})
+	require.Nil(t, err)
+
+	// Posts created at the same time are ordered by id.
+	sameTime1, err := ss.Post().Save(&model.Post{ChannelId: channelId, UserId: userId, Message: "same time", CreateAt: 2000})
+	require.Nil(t, err)
+	sameTime2, err := ss.Post().Save(&model.Post{ChannelId: channelId, UserId: userId, Message: "same time", CreateAt: 2000})
+	require.Nil(t, err)
+	if sameTime2.Id < sameTime1.Id {
+		sameTime1, sameTime2 = sameTime2, sameTime1
+	}
+
+	reply, err := ss.Post().Save(&model.Post{ChannelId: channelId, UserId: userId, RootId: root.Id, ParentId: root.Id, Message: "reply", CreateAt: 3000})
+	require.Nil(t, err)
+
+	deleted, err := ss.Post().Save(&model.Post{ChannelId: channelId, UserId: userId, Message: "deleted", CreateAt: 4000})
+	require.Nil(t, err)
+	err = ss.Post().Delete(deleted.Id, model.GetMillis(), userId)
+	require.Nil(t, err)
+
+	_, err = ss.Post().Save(&model.Post{ChannelId: model.NewId(), UserId: userId, Message: "other channel", CreateAt: 1500})
+	require.Nil(t, err)
+
+	postIds := []string{}
+	cursor := model.ChannelSnapshotCursor{}
+	for {
+		posts, err := ss.Post().GetPostsForChannelSnapshot(channelId, cursor, 2)
+		require.Nil(t, err)
+		if len(posts) == 0 {
+			break
+		}
+
+		for _, post := range posts {
+			postIds = append(postIds, post.Id)
+		}
+		last := posts[len(posts)-1]
+		cursor = model.ChannelSnapshotCursor{CreateAt: last.CreateAt, Id: last.Id}
+	}
+
+	assert.Equal(t, []string{root.Id, sameTime1.Id, sameTime2.Id, reply.Id}, postIds)
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+func TestPostsPartitionStore(t *testing.T, ss store.Store, s SqlSupplier) {
+	t.Run("IsPartitioned", func(t *testing.T) { testPostsPartitionStoreIsPartitioned(t, ss, s) })
+	t.Run("UnsupportedDriver", func(t *testing.T) { testPostsPartitionStoreUnsupportedDriver(t, ss, s) })
+}
+
+func testPostsPartitionStoreIsPartitioned(t *testing.T, ss store.Store, s SqlSupplier) {
+	// The test database is never converted, as the other tests share its Posts table.
+	partitioned, err := ss.PostsPartition().IsPartitioned()
+	require.Nil(t, err)
+	assert.False(t, partitioned)
+
+	if s.DriverName() != model.DATABASE_DRIVER_POSTGRES {
+		return
+	}
+
+	partitions, err := ss.PostsPartition().GetPartitions()
+	require.Nil(t, err)
+	assert.Empty(t, partitions)
+
+	dropped, err := ss.PostsPartition().DropPartitionsBefore(model.GetMillis())
+	require.Nil(t, err)
+	assert.Empty(t, dropped)
+}
+
+func testPostsPartitionStoreUnsupportedDriver(t *testing.T, ss store.Store, s SqlSupplier) {
+	if s.DriverName() == model.DATABASE_DRIVER_POSTGRES {
+		t.Skip("partitioning is supported by Postgres")
+	}
+
+	var invErr *store.ErrInvalidInput
+
+	_, err := ss.PostsPartition().GetPartitions()
+	assert.True(t, errors.As(err, &invErr))
+
+	err = ss.PostsPartition().EnsurePartitions(model.GetMillis())
+	assert.True(t, errors.As(err, &invErr))
+
+	err = ss.PostsPartition().StartConversion()
+	assert.True(t, errors.As(err, &invErr))
+
+	_, _, err = ss.PostsPartition().CopyBatch(model.PostsPartitionCursor{}, 100)
+	assert.True(t, errors.As(err, &invErr))
+
+	err = ss.PostsPartition().FinishConversion()
+	assert.True(t, errors.As(err, &invErr))
+}
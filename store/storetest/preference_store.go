@@ -23,6 +23,7 @@ func TestPreferenceStore(t *testing.T, ss store.Store) {
 	t.Run("PreferenceDeleteCategory", func(t *testing.T) { testPreferenceDeleteCategory(t, ss) })
 	t.Run("PreferenceDeleteCategoryAndName", func(t *testing.T) { testPreferenceDeleteCategoryAndName(t, ss) })
 	t.Run("PreferenceCleanupFlagsBatch", func(t *testing.T) { testPreferenceCleanupFlagsBatch(t, ss) })
+	t.Run("PreferenceRenameCategory", func(t *testing.T) { testPreferenceRenameCategory(t, ss) })
 }
 
 func testPreferenceSave(t *testing.T, ss store.Store) {
@@ -368,3 +369,59 @@ func testPreferenceCleanupFlagsBatch(t *testing.T, ss store.Store) {
 	_, err = ss.Preference().Get(userId, category, preference2.Name)
 	assert.NotNil(t, err)
 }
+
+func testPreferenceRenameCategory(t *testing.T, ss store.Store) {
+	oldCategory := model.NewId()[:20]
+	newCategory := model.NewId()[:20]
+	userId := model.NewId()
+
+	preferences := model.Preferences{
+		{UserId: userId, Category: oldCategory, Name: "kept", Value: "value1"},
+		{UserId: userId, Category: oldCategory, Name: "renamed", Value: "value2"},
+		{UserId: userId, Category: oldCategory, Name: "dropped", Value: "value3"},
+		{UserId: userId, Category: oldCategory, Name: "existing", Value: "value4"},
+		{UserId: userId, Category: newCategory, Name: "existing", Value: "value5"},
+	}
+	require.Nil(t, ss.Preference().Save(&preferences))
+
+	transform := func(preference *model.Preference) bool {
+		switch preference.Name {
+		case "renamed":
+			preference.Name = "new_name"
+		case "dropped":
+			return false
+		}
+		return true
+	}
+
+	t.Run("same category", func(t *testing.T) {
+		_, err := ss.Preference().RenameCategory(oldCategory, oldCategory, transform, 10)
+		require.NotNil(t, err)
+	})
+
+	var moved int64
+	for {
+		count, err := ss.Preference().RenameCategory(oldCategory, newCategory, transform, 3)
+		require.Nil(t, err)
+		if count == 0 {
+			break
+		}
+		assert.LessOrEqual(t, count, int64(3))
+		moved += count
+	}
+	assert.Equal(t, int64(4), moved)
+
+	remaining, err := ss.Preference().GetCategory(userId, oldCategory)
+	require.Nil(t, err)
+	assert.Empty(t, remaining)
+
+	migrated, err := ss.Preference().GetCategory(userId, newCategory)
+	require.Nil(t, err)
+	require.Len(t, migrated, 3)
+
+	values := map[string]string{}
+	for _, preference := range migrated {
+		values[preference.Name] = preference.Value
+	}
+	assert.Equal(t, map[string]string{"kept": "value1", "new_name": "value2", "existing": "value5"}, values)
+}
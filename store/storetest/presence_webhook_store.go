@@ -0,0 +1,123 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+func TestPresenceWebhookStore(t *testing.T, ss store.Store) {
+	t.Run("Save", func(t *testing.T) { testPresenceWebhookStoreSave(t, ss) })
+	t.Run("Update", func(t *testing.T) { testPresenceWebhookStoreUpdate(t, ss) })
+	t.Run("GetAll", func(t *testing.T) { testPresenceWebhookStoreGetAll(t, ss) })
+	t.Run("Delete", func(t *testing.T) { testPresenceWebhookStoreDelete(t, ss) })
+}
+
+func newTestPresenceWebhook() *model.PresenceWebhook {
+	return &model.PresenceWebhook{
+		CreatorId:       model.NewId(),
+		DisplayName:     "on-call",
+		CallbackURL:     "https://example.com/presence",
+		UserIds:         []string{model.NewId(), model.NewId()},
+		Statuses:        []string{model.STATUS_ONLINE, model.STATUS_OFFLINE},
+		DebounceSeconds: 30,
+	}
+}
+
+func testPresenceWebhookStoreSave(t *testing.T, ss store.Store) {
+	t.Run("should save webhook", func(t *testing.T) {
+		saved, err := ss.PresenceWebhook().Save(newTestPresenceWebhook())
+		require.Nil(t, err)
+		assert.NotEmpty(t, saved.Id)
+		assert.NotZero(t, saved.CreateAt)
+
+		fetched, err := ss.PresenceWebhook().Get(saved.Id)
+		require.Nil(t, err)
+		assert.Equal(t, saved, fetched)
+	})
+
+	t.Run("should fail to save existing webhook", func(t *testing.T) {
+		hook := newTestPresenceWebhook()
+		hook.Id = model.NewId()
+
+		_, err := ss.PresenceWebhook().Save(hook)
+		var invErr *store.ErrInvalidInput
+		assert.True(t, errors.As(err, &invErr))
+	})
+
+	t.Run("should fail to save invalid webhook", func(t *testing.T) {
+		hook := newTestPresenceWebhook()
+		hook.UserIds = nil
+
+		_, err := ss.PresenceWebhook().Save(hook)
+		var appErr *model.AppError
+		assert.True(t, errors.As(err, &appErr))
+	})
+}
+
+func testPresenceWebhookStoreUpdate(t *testing.T, ss store.Store) {
+	saved, err := ss.PresenceWebhook().Save(newTestPresenceWebhook())
+	require.Nil(t, err)
+
+	saved.Statuses = nil
+	saved.DebounceSeconds = 0
+	updated, err := ss.PresenceWebhook().Update(saved)
+	require.Nil(t, err)
+
+	fetched, err := ss.PresenceWebhook().Get(saved.Id)
+	require.Nil(t, err)
+	assert.Equal(t, updated, fetched)
+
+	unknown := newTestPresenceWebhook()
+	unknown.PreSave()
+	_, err = ss.PresenceWebhook().Update(unknown)
+	var nfErr *store.ErrNotFound
+	assert.True(t, errors.As(err, &nfErr))
+}
+
+func testPresenceWebhookStoreGetAll(t *testing.T, ss store.Store) {
+	existing, err := ss.PresenceWebhook().GetAll(0, 1000)
+	require.Nil(t, err)
+
+	hook1, err := ss.PresenceWebhook().Save(newTestPresenceWebhook())
+	require.Nil(t, err)
+	hook2, err := ss.PresenceWebhook().Save(newTestPresenceWebhook())
+	require.Nil(t, err)
+
+	hooks, err := ss.PresenceWebhook().GetAll(0, 1000)
+	require.Nil(t, err)
+	require.Len(t, hooks, len(existing)+2)
+
+	hooks, err = ss.PresenceWebhook().GetAll(len(existing), 1)
+	require.Nil(t, err)
+	require.Len(t, hooks, 1)
+	assert.Equal(t, hook1.Id, hooks[0].Id)
+
+	require.Nil(t, ss.PresenceWebhook().Delete(hook2.Id, model.GetMillis()))
+
+	hooks, err = ss.PresenceWebhook().GetAll(0, 1000)
+	require.Nil(t, err)
+	assert.Len(t, hooks, len(existing)+1)
+}
+
+func testPresenceWebhookStoreDelete(t *testing.T, ss store.Store) {
+	saved, err := ss.PresenceWebhook().Save(newTestPresenceWebhook())
+	require.Nil(t, err)
+
+	require.Nil(t, ss.PresenceWebhook().Delete(saved.Id, model.GetMillis()))
+
+	_, err = ss.PresenceWebhook().Get(saved.Id)
+	var nfErr *store.ErrNotFound
+	assert.True(t, errors.As(err, &nfErr))
+
+	err = ss.PresenceWebhook().Delete(saved.Id, model.GetMillis())
+	assert.True(t, errors.As(err, &nfErr))
+}
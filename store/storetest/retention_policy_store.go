@@ -0,0 +1,421 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+func TestRetentionPolicyStore(t *testing.T, ss store.Store) {
+	t.Run("Save", func(t *testing.T) { testRetentionPolicyStoreSave(t, ss) })
+	t.Run("Update", func(t *testing.T) { testRetentionPolicyStoreUpdate(t, ss) })
+	t.Run("GetAll", func(t *testing.T) { testRetentionPolicyStoreGetAll(t, ss) })
+	t.Run("GetForChannel", func(t *testing.T) { testRetentionPolicyStoreGetForChannel(t, ss) })
+	t.Run("Delete", func(t *testing.T) { testRetentionPolicyStoreDelete(t, ss) })
+	t.Run("PermanentDeletePostsBatch", func(t *testing.T) { testRetentionPolicyStorePermanentDeletePostsBatch(t, ss) })
+	t.Run("PermanentDeleteFilesBatch", func(t *testing.T) { testRetentionPolicyStorePermanentDeleteFilesBatch(t, ss) })
+	t.Run("PermanentDeleteAllFilesBatch", func(t *testing.T) { testRetentionPolicyStorePermanentDeleteAllFilesBatch(t, ss) })
+	t.Run("PermanentDeleteBatchCursor", func(t *testing.T) { testRetentionPolicyStorePermanentDeleteBatchCursor(t, ss) })
+}
+
+func newTestRetentionPolicy(teamIds, channelIds []string) *model.RetentionPolicy {
+	return &model.RetentionPolicy{
+		DisplayName:  "policy " + model.NewId(),
+		PostDuration: 30,
+		FileDuration: model.RETENTION_POLICY_KEEP_FOREVER,
+		TeamIds:      teamIds,
+		ChannelIds:   channelIds,
+	}
+}
+
+func saveTestRetentionPolicyChannel(t *testing.T, ss store.Store, teamId string) *model.Channel {
+	channel, err := ss.Channel().Save(&model.Channel{
+		TeamId:      teamId,
+		DisplayName: "Retention",
+		Name:        "z-z-" + model.NewId() + "a",
+		Type:        model.CHANNEL_OPEN,
+	}, -1)
+	require.Nil(t, err)
+	return channel
+}
+
+func testRetentionPolicyStoreSave(t *testing.T, ss store.Store) {
+	t.Run("should save policy with its teams and channels", func(t *testing.T) {
+		teamId := model.NewId()
+		channelId := model.NewId()
+
+		saved, err := ss.RetentionPolicy().Save(newTestRetentionPolicy([]string{teamId}, []string{channelId}))
+		require.Nil(t, err)
+		assert.NotEmpty(t, saved.Id)
+		assert.NotZero(t, saved.CreateAt)
+
+		fetched, err := ss.RetentionPolicy().Get(saved.Id)
+		require.Nil(t, err)
+		assert.Equal(t, saved, fetched)
+	})
+
+	t.Run("should fail to save existing policy", func(t *testing.T) {
+		policy := newTestRetentionPolicy(nil, nil)
+		policy.Id = model.NewId()
+
+		_, err := ss.RetentionPolicy().Save(policy)
+		var invErr *store.ErrInvalidInput
+		assert.True(t, errors.As(err, &invErr))
+	})
+
+	t.Run("should fail to save invalid policy", func(t *testing.T) {
+		policy := newTestRetentionPolicy(nil, nil)
+		policy.PostDuration = 0
+
+		_, err := ss.RetentionPolicy().Save(policy)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("should fail when a channel already belongs to another policy", func(t *testing.T) {
+		channelId := model.NewId()
+
+		_, err := ss.RetentionPolicy().Save(newTestRetentionPolicy(nil, []string{channelId}))
+		require.Nil(t, err)
+
+		policy := newTestRetentionPolicy(nil, []string{channelId})
+		_, err = ss.RetentionPolicy().Save(policy)
+		var conflictErr *store.ErrConflict
+		assert.True(t, errors.As(err, &conflictErr))
+
+		_, err = ss.RetentionPolicy().Get(policy.Id)
+		var nfErr *store.ErrNotFound
+		assert.True(t, errors.As(err, &nfErr))
+	})
+
+	t.Run("should fail when a team already belongs to another policy", func(t *testing.T) {
+		teamId := model.NewId()
+
+		_, err := ss.RetentionPolicy().Save(newTestRetentionPolicy([]string{teamId}, nil))
+		require.Nil(t, err)
+
+		_, err = ss.RetentionPolicy().Save(newTestRetentionPolicy([]string{teamId}, nil))
+		var conflictErr *store.ErrConflict
+		assert.True(t, errors.As(err, &conflictErr))
+	})
+}
+
+func testRetentionPolicyStoreUpdate(t *testing.T, ss store.Store) {
+	policy, err := ss.RetentionPolicy().Save(newTestRetentionPolicy([]string{model.NewId()}, []string{model.NewId()}))
+	require.Nil(t, err)
+
+	t.Run("should update policy and replace its teams and channels", func(t *testing.T) {
+		channelId := model.NewId()
+		policy.DisplayName = "updated"
+		policy.FileDuration = 10
+		policy.TeamIds = []string{}
+		policy.ChannelIds = []string{channelId}
+
+		_, err := ss.RetentionPolicy().Update(policy)
+		require.Nil(t, err)
+
+		fetched, err := ss.RetentionPolicy().Get(policy.Id)
+		require.Nil(t, err)
+		assert.Equal(t, "updated", fetched.DisplayName)
+		assert.Equal(t, int64(10), fetched.FileDuration)
+		assert.Empty(t, fetched.TeamIds)
+		assert.Equal(t, []string{channelId}, fetched.ChannelIds)
+	})
+
+	t.Run("should fail to update missing policy", func(t *testing.T) {
+		missing := newTestRetentionPolicy(nil, nil)
+		missing.PreSave()
+
+		_, err := ss.RetentionPolicy().Update(missing)
+		var nfErr *store.ErrNotFound
+		assert.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testRetentionPolicyStoreGetAll(t *testing.T, ss store.Store) {
+	first := newTestRetentionPolicy(nil, []string{model.NewId()})
+	first.DisplayName = "0000 first " + model.NewId()
+	first, err := ss.RetentionPolicy().Save(first)
+	require.Nil(t, err)
+
+	second := newTestRetentionPolicy([]string{model.NewId()}, nil)
+	second.DisplayName = "0000 second " + model.NewId()
+	second, err = ss.RetentionPolicy().Save(second)
+	require.Nil(t, err)
+
+	policies, err := ss.RetentionPolicy().GetAll(0, 2)
+	require.Nil(t, err)
+	require.Len(t, policies, 2)
+	assert.Equal(t, first, policies[0])
+	assert.Equal(t, second, policies[1])
+
+	policies, err = ss.RetentionPolicy().GetAll(1, 1)
+	require.Nil(t, err)
+	require.Len(t, policies, 1)
+	assert.Equal(t, second.Id, policies[0].Id)
+}
+
+func testRetentionPolicyStoreGetForChannel(t *testing.T, ss store.Store) {
+	teamId := model.NewId()
+	teamChannel := saveTestRetentionPolicyChannel(t, ss, teamId)
+	overriddenChannel := saveTestRetentionPolicyChannel(t, ss, teamId)
+	otherChannel := saveTestRetentionPolicyChannel(t, ss, model.NewId())
+
+	teamPolicy, err := ss.RetentionPolicy().Save(newTestRetentionPolicy([]string{teamId}, nil))
+	require.Nil(t, err)
+	channelPolicy, err := ss.RetentionPolicy().Save(newTestRetentionPolicy(nil, []string{overriddenChannel.Id}))
+	require.Nil(t, err)
+
+	policy, err := ss.RetentionPolicy().GetForChannel(teamChannel.Id)
+	require.Nil(t, err)
+	assert.Equal(t, teamPolicy.Id, policy.Id)
+
+	policy, err = ss.RetentionPolicy().GetForChannel(overriddenChannel.Id)
+	require.Nil(t, err)
+	assert.Equal(t, channelPolicy.Id, policy.Id)
+
+	_, err = ss.RetentionPolicy().GetForChannel(otherChannel.Id)
+	var nfErr *store.ErrNotFound
+	assert.True(t, errors.As(err, &nfErr))
+}
+
+func testRetentionPolicyStoreDelete(t *testing.T, ss store.Store) {
+	channelId := model.NewId()
+	policy, err := ss.RetentionPolicy().Save(newTestRetentionPolicy([]string{model.NewId()}, []string{channelId}))
+	require.Nil(t, err)
+
+	err = ss.RetentionPolicy().Delete(policy.Id)
+	require.Nil(t, err)
+
+	_, err = ss.RetentionPolicy().Get(policy.Id)
+	var nfErr *store.ErrNotFound
+	assert.True(t, errors.As(err, &nfErr))
+
+	err = ss.RetentionPolicy().Delete(policy.Id)
+	assert.True(t, errors.As(err, &nfErr))
+
+	// The channel is free to join another policy.
+	_, err = ss.RetentionPolicy().Save(newTestRetentionPolicy(nil, []string{channelId}))
+	assert.Nil(t, err)
+}
+
+func testRetentionPolicyStorePermanentDeletePostsBatch(t *testing.T, ss store.Store) {
+	teamId := model.NewId()
+	teamChannel := saveTestRetentionPolicyChannel(t, ss, teamId)
+	overriddenChannel := saveTestRetentionPolicyChannel(t, ss, teamId)
+	globalChannel := saveTestRetentionPolicyChannel(t, ss, model.NewId())
+
+	teamPolicy, err := ss.RetentionPolicy().Save(newTestRetentionPolicy([]string{teamId}, nil))
+	require.Nil(t, err)
+	channelPolicy, err := ss.RetentionPolicy().Save(newTestRetentionPolicy(nil, []string{overriddenChannel.Id}))
+	require.Nil(t, err)
+
+	savePost := func(channelId string, createAt int64) *model.Post {
+		post, appErr := ss.Post().Save(&model.Post{
+			ChannelId: channelId,
+			UserId:    model.NewId(),
+			Message:   "message",
+			CreateAt:  createAt,
+		})
+		require.Nil(t, appErr)
+		return post
+	}
+
+	oldTeamPost := savePost(teamChannel.Id, 1000)
+	newTeamPost := savePost(teamChannel.Id, 3000)
+	oldOverriddenPost := savePost(overriddenChannel.Id, 1000)
+	oldGlobalPost := savePost(globalChannel.Id, 1000)
+
+	exists := func(post *model.Post) bool {
+		_, appErr := ss.Post().GetSingle(post.Id)
+		return appErr == nil
+	}
+
+	start := model.RetentionPolicyCursor{}
+
+	deleted, _, err := ss.RetentionPolicy().PermanentDeletePostsBatch(teamPolicy.Id, 2000, start, 1000)
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), deleted)
+	assert.False(t, exists(oldTeamPost))
+	assert.True(t, exists(newTeamPost))
+	assert.True(t, exists(oldOverriddenPost), "channel policy should take precedence over the team policy")
+	assert.True(t, exists(oldGlobalPost))
+
+	deleted, _, err = ss.RetentionPolicy().PermanentDeletePostsBatch(channelPolicy.Id, 2000, start, 1000)
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), deleted)
+	assert.False(t, exists(oldOverriddenPost))
+	assert.True(t, exists(oldGlobalPost))
+
+	for cursor := start; ; {
+		var next model.RetentionPolicyCursor
+		_, next, err = ss.RetentionPolicy().PermanentDeletePostsBatch("", 2000, cursor, 1000)
+		require.Nil(t, err)
+		if next == cursor {
+			break
+		}
+		cursor = next
+	}
+	assert.False(t, exists(oldGlobalPost))
+	assert.True(t, exists(newTeamPost))
+}
+
+func testRetentionPolicyStorePermanentDeleteFilesBatch(t *testing.T, ss store.Store) {
+	teamId := model.NewId()
+	teamChannel := saveTestRetentionPolicyChannel(t, ss, teamId)
+	globalChannel := saveTestRetentionPolicyChannel(t, ss, model.NewId())
+
+	teamPolicy, err := ss.RetentionPolicy().Save(newTestRetentionPolicy([]string{teamId}, nil))
+	require.Nil(t, err)
+
+	saveFile := func(channelId string, createAt int64) *model.FileInfo {
+		postId := ""
+		if channelId != "" {
+			post, appErr := ss.Post().Save(&model.Post{
+				ChannelId: channelId,
+				UserId:    model.NewId(),
+				Message:   "message",
+			})
+			require.Nil(t, appErr)
+			postId = post.Id
+		}
+
+		info, appErr := ss.FileInfo().Save(&model.FileInfo{
+			PostId:    postId,
+			CreatorId: model.NewId(),
+			Path:      "file.txt",
+			CreateAt:  createAt,
+		})
+		require.Nil(t, appErr)
+		return info
+	}
+
+	oldTeamFile := saveFile(teamChannel.Id, 1000)
+	newTeamFile := saveFile(teamChannel.Id, 3000)
+	oldGlobalFile := saveFile(globalChannel.Id, 1000)
+	oldUnattachedFile := saveFile("", 1000)
+
+	exists := func(info *model.FileInfo) bool {
+		_, appErr := ss.FileInfo().Get(info.Id)
+		return appErr == nil
+	}
+
+	deleted, _, err := ss.RetentionPolicy().PermanentDeleteFilesBatch(teamPolicy.Id, 2000, model.RetentionPolicyCursor{}, 1000)
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), deleted)
+	assert.False(t, exists(oldTeamFile))
+	assert.True(t, exists(newTeamFile))
+	assert.True(t, exists(oldGlobalFile))
+	assert.True(t, exists(oldUnattachedFile))
+
+	for cursor := (model.RetentionPolicyCursor{}); ; {
+		var next model.RetentionPolicyCursor
+		_, next, err = ss.RetentionPolicy().PermanentDeleteFilesBatch("", 2000, cursor, 1000)
+		require.Nil(t, err)
+		if next == cursor {
+			break
+		}
+		cursor = next
+	}
+	assert.False(t, exists(oldGlobalFile))
+	assert.False(t, exists(oldUnattachedFile))
+	assert.True(t, exists(newTeamFile))
+}
+
+func testRetentionPolicyStorePermanentDeleteAllFilesBatch(t *testing.T, ss store.Store) {
+	teamId := model.NewId()
+	teamChannel := saveTestRetentionPolicyChannel(t, ss, teamId)
+
+	_, err := ss.RetentionPolicy().Save(newTestRetentionPolicy([]string{teamId}, nil))
+	require.Nil(t, err)
+
+	post, appErr := ss.Post().Save(&model.Post{
+		ChannelId: teamChannel.Id,
+		UserId:    model.NewId(),
+		Message:   "message",
+	})
+	require.Nil(t, appErr)
+
+	saveFile := func(postId string, createAt int64) *model.FileInfo {
+		info, appErr := ss.FileInfo().Save(&model.FileInfo{
+			PostId:    postId,
+			CreatorId: model.NewId(),
+			Path:      "file.txt",
+			CreateAt:  createAt,
+		})
+		require.Nil(t, appErr)
+		return info
+	}
+
+	oldPolicyFile := saveFile(post.Id, 1000)
+	oldUnattachedFile := saveFile("", 1500)
+	newFile := saveFile("", 3000)
+
+	exists := func(info *model.FileInfo) bool {
+		_, appErr := ss.FileInfo().Get(info.Id)
+		return appErr == nil
+	}
+
+	deleted, cursor, err := ss.RetentionPolicy().PermanentDeleteAllFilesBatch(2000, model.RetentionPolicyCursor{}, 1)
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	for {
+		var next model.RetentionPolicyCursor
+		_, next, err = ss.RetentionPolicy().PermanentDeleteAllFilesBatch(2000, cursor, 1)
+		require.Nil(t, err)
+		if next == cursor {
+			break
+		}
+		cursor = next
+	}
+	assert.False(t, exists(oldPolicyFile))
+	assert.False(t, exists(oldUnattachedFile))
+	assert.True(t, exists(newFile))
+}
+
+func testRetentionPolicyStorePermanentDeleteBatchCursor(t *testing.T, ss store.Store) {
+	channel := saveTestRetentionPolicyChannel(t, ss, model.NewId())
+	policy, err := ss.RetentionPolicy().Save(newTestRetentionPolicy(nil, []string{channel.Id}))
+	require.Nil(t, err)
+
+	var posts []*model.Post
+	for _, createAt := range []int64{1000, 1000, 1500} {
+		post, appErr := ss.Post().Save(&model.Post{
+			ChannelId: channel.Id,
+			UserId:    model.NewId(),
+			Message:   "message",
+			CreateAt:  createAt,
+		})
+		require.Nil(t, appErr)
+		posts = append(posts, post)
+	}
+
+	cursor := model.RetentionPolicyCursor{}
+	deleted, cursor, err := ss.RetentionPolicy().PermanentDeletePostsBatch(policy.Id, 2000, cursor, 2)
+	require.Nil(t, err)
+	assert.Equal(t, int64(2), deleted)
+	assert.Equal(t, int64(1000), cursor.CreateAt)
+
+	deleted, cursor, err = ss.RetentionPolicy().PermanentDeletePostsBatch(policy.Id, 2000, cursor, 2)
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), deleted)
+	assert.Equal(t, model.RetentionPolicyCursor{CreateAt: 1500, Id: posts[2].Id}, cursor)
+
+	deleted, next, err := ss.RetentionPolicy().PermanentDeletePostsBatch(policy.Id, 2000, cursor, 2)
+	require.Nil(t, err)
+	assert.Equal(t, int64(0), deleted)
+	assert.Equal(t, cursor, next)
+
+	for _, post := range posts {
+		_, appErr := ss.Post().GetSingle(post.Id)
+		assert.NotNil(t, appErr)
+	}
+}
@@ -4,6 +4,7 @@
 package storetest
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -410,9 +411,9 @@ func testRoleStoreLowerScopedChannelSchemeRoles(t *testing.T, ss store.Store) {
 		Type:        model.TEAM_OPEN,
 		SchemeId:    &teamScheme1.Id,
 	}
-	team1, err = ss.Team().Save(team1)
+	team1, err = ss.Team().Save(context.Background(), team1)
 	require.Nil(t, err)
-	defer ss.Team().PermanentDelete(team1.Id)
+	defer ss.Team().PermanentDelete(context.Background(), team1.Id)
 
 	team2 := &model.Team{
 		DisplayName: "Name",
@@ -421,9 +422,9 @@ func testRoleStoreLowerScopedChannelSchemeRoles(t *testing.T, ss store.Store) {
 		Type:        model.TEAM_OPEN,
 		SchemeId:    &teamScheme2.Id,
 	}
-	team2, err = ss.Team().Save(team2)
+	team2, err = ss.Team().Save(context.Background(), team2)
 	require.Nil(t, err)
-	defer ss.Team().PermanentDelete(team2.Id)
+	defer ss.Team().PermanentDelete(context.Background(), team2.Id)
 
 	channel1 := &model.Channel{
 		TeamId:      team1.Id,
@@ -544,9 +545,9 @@ func testRoleStoreChannelHigherScopedPermissionsBlankTeamSchemeChannelGuest(t *t
 		Type:        model.TEAM_OPEN,
 		SchemeId:    &teamScheme.Id,
 	}
-	team, err = ss.Team().Save(team)
+	team, err = ss.Team().Save(context.Background(), team)
 	require.Nil(t, err)
-	defer ss.Team().PermanentDelete(team.Id)
+	defer ss.Team().PermanentDelete(context.Background(), team.Id)
 
 	channel := &model.Channel{
 		TeamId:      team.Id,
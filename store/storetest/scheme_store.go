@@ -4,6 +4,7 @@
 package storetest
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -417,13 +418,13 @@ func testSchemeStoreDelete(t *testing.T, ss store.Store) {
 		Type:        model.TEAM_OPEN,
 		SchemeId:    &d4.Id,
 	}
-	t4, err = ss.Team().Save(t4)
+	t4, err = ss.Team().Save(context.Background(), t4)
 	require.Nil(t, err)
 
 	_, err = ss.Scheme().Delete(d4.Id)
 	assert.Nil(t, err)
 
-	t5, err := ss.Team().Get(t4.Id)
+	t5, err := ss.Team().Get(context.Background(), t4.Id)
 	require.Nil(t, err)
 	assert.Equal(t, "", *t5.SchemeId)
 
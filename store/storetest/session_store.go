@@ -257,9 +257,13 @@ func testSessionCount(t *testing.T, ss store.Store) {
 	s1, err := ss.Session().Save(s1)
 	require.Nil(t, err)
 
-	count, err := ss.Session().AnalyticsSessionCount()
+	count, err := ss.Session().AnalyticsSessionCount(false)
 	require.Nil(t, err)
 	require.NotZero(t, count, "should have at least 1 session")
+
+	estimated, err := ss.Session().AnalyticsSessionCount(true)
+	require.Nil(t, err)
+	require.Equal(t, count, estimated, "should count small tables exactly")
 }
 
 func testSessionCleanup(t *testing.T, ss store.Store) {
@@ -144,6 +144,13 @@ func databaseSettings(driver, dataSource string) *model.SqlSettings {
 	*settings.ConnMaxLifetimeMilliseconds = 3600000
 	*settings.MaxOpenConns = 100
 	*settings.QueryTimeout = 60
+	settings.EnableReplicaFallback = model.NewBool(true)
+	settings.EnableNgramSearch = model.NewBool(true)
+	settings.ReplicaHealthCheckIntervalSeconds = model.NewInt(5)
+	settings.ReplicaFailureThreshold = model.NewInt(3)
+	settings.ReplicaRetryIntervalSeconds = model.NewInt(30)
+	settings.ReplicaFallbackMaxMasterReadPercent = model.NewInt(100)
+	settings.MigrationLockTimeoutSeconds = model.NewInt(60)
 
 	return settings
 }
@@ -0,0 +1,107 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+func TestSlugHistoryStore(t *testing.T, ss store.Store) {
+	t.Run("SaveAndGet", func(t *testing.T) { testSlugHistoryStoreSaveAndGet(t, ss) })
+	t.Run("Delete", func(t *testing.T) { testSlugHistoryStoreDelete(t, ss) })
+	t.Run("PermanentDeleteByTarget", func(t *testing.T) { testSlugHistoryStorePermanentDeleteByTarget(t, ss) })
+}
+
+func testSlugHistoryStoreSaveAndGet(t *testing.T, ss store.Store) {
+	teamId := model.NewId()
+	name := "zz" + model.NewId()
+
+	t.Run("should save entry", func(t *testing.T) {
+		entry := &model.SlugHistory{Kind: model.SLUG_HISTORY_KIND_CHANNEL, ScopeId: teamId, Name: name, TargetId: model.NewId()}
+		saved, err := ss.SlugHistory().Save(entry)
+		require.Nil(t, err)
+		assert.NotZero(t, saved.CreateAt)
+
+		fetched, err := ss.SlugHistory().Get(model.SLUG_HISTORY_KIND_CHANNEL, teamId, name)
+		require.Nil(t, err)
+		assert.Equal(t, saved, fetched)
+	})
+
+	t.Run("should replace entry of the same name", func(t *testing.T) {
+		entry := &model.SlugHistory{Kind: model.SLUG_HISTORY_KIND_CHANNEL, ScopeId: teamId, Name: name, TargetId: model.NewId()}
+		_, err := ss.SlugHistory().Save(entry)
+		require.Nil(t, err)
+
+		fetched, err := ss.SlugHistory().Get(model.SLUG_HISTORY_KIND_CHANNEL, teamId, name)
+		require.Nil(t, err)
+		assert.Equal(t, entry.TargetId, fetched.TargetId)
+	})
+
+	t.Run("should scope names", func(t *testing.T) {
+		_, err := ss.SlugHistory().Get(model.SLUG_HISTORY_KIND_CHANNEL, model.NewId(), name)
+		var nfErr *store.ErrNotFound
+		assert.True(t, errors.As(err, &nfErr))
+
+		_, err = ss.SlugHistory().Get(model.SLUG_HISTORY_KIND_TEAM, "", name)
+		assert.True(t, errors.As(err, &nfErr))
+	})
+
+	t.Run("should fail to save invalid entry", func(t *testing.T) {
+		entry := &model.SlugHistory{Kind: model.SLUG_HISTORY_KIND_TEAM, ScopeId: teamId, Name: name, TargetId: model.NewId()}
+		_, err := ss.SlugHistory().Save(entry)
+		var appErr *model.AppError
+		assert.True(t, errors.As(err, &appErr))
+	})
+}
+
+func testSlugHistoryStoreDelete(t *testing.T, ss store.Store) {
+	name := "zz" + model.NewId()
+	_, err := ss.SlugHistory().Save(&model.SlugHistory{Kind: model.SLUG_HISTORY_KIND_TEAM, Name: name, TargetId: model.NewId()})
+	require.Nil(t, err)
+
+	err = ss.SlugHistory().Delete(model.SLUG_HISTORY_KIND_TEAM, "", name)
+	require.Nil(t, err)
+
+	_, err = ss.SlugHistory().Get(model.SLUG_HISTORY_KIND_TEAM, "", name)
+	var nfErr *store.ErrNotFound
+	assert.True(t, errors.As(err, &nfErr))
+
+	err = ss.SlugHistory().Delete(model.SLUG_HISTORY_KIND_TEAM, "", name)
+	assert.Nil(t, err, "deleting an unknown name should do nothing")
+}
+
+func testSlugHistoryStorePermanentDeleteByTarget(t *testing.T, ss store.Store) {
+	targetId := model.NewId()
+	name1 := "zz" + model.NewId()
+	name2 := "zz" + model.NewId()
+	other := "zz" + model.NewId()
+
+	for _, entry := range []*model.SlugHistory{
+		{Kind: model.SLUG_HISTORY_KIND_TEAM, Name: name1, TargetId: targetId},
+		{Kind: model.SLUG_HISTORY_KIND_TEAM, Name: name2, TargetId: targetId},
+		{Kind: model.SLUG_HISTORY_KIND_TEAM, Name: other, TargetId: model.NewId()},
+	} {
+		_, err := ss.SlugHistory().Save(entry)
+		require.Nil(t, err)
+	}
+
+	err := ss.SlugHistory().PermanentDeleteByTarget(targetId)
+	require.Nil(t, err)
+
+	var nfErr *store.ErrNotFound
+	_, err = ss.SlugHistory().Get(model.SLUG_HISTORY_KIND_TEAM, "", name1)
+	assert.True(t, errors.As(err, &nfErr))
+	_, err = ss.SlugHistory().Get(model.SLUG_HISTORY_KIND_TEAM, "", name2)
+	assert.True(t, errors.As(err, &nfErr))
+
+	_, err = ss.SlugHistory().Get(model.SLUG_HISTORY_KIND_TEAM, "", other)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+func TestStatusAutomationStore(t *testing.T, ss store.Store) {
+	t.Run("Save", func(t *testing.T) { testStatusAutomationStoreSave(t, ss) })
+	t.Run("Update", func(t *testing.T) { testStatusAutomationStoreUpdate(t, ss) })
+	t.Run("Get", func(t *testing.T) { testStatusAutomationStoreGet(t, ss) })
+	t.Run("GetEndedBefore", func(t *testing.T) { testStatusAutomationStoreGetEndedBefore(t, ss) })
+	t.Run("Delete", func(t *testing.T) { testStatusAutomationStoreDelete(t, ss) })
+}
+
+func newTestStatusAutomation(userId string) *model.StatusAutomation {
+	return &model.StatusAutomation{
+		UserId:         userId,
+		Source:         "calendar",
+		PreviousStatus: model.STATUS_ONLINE,
+		EndAt:          model.GetMillis() + 60*60*1000,
+	}
+}
+
+func testStatusAutomationStoreSave(t *testing.T, ss store.Store) {
+	t.Run("should save automation", func(t *testing.T) {
+		saved, err := ss.StatusAutomation().Save(newTestStatusAutomation(model.NewId()))
+		require.Nil(t, err)
+		assert.NotZero(t, saved.StartAt)
+
+		automation, err := ss.StatusAutomation().Get(saved.UserId)
+		require.Nil(t, err)
+		assert.Equal(t, saved, automation)
+	})
+
+	t.Run("should fail to automate the status of a user twice", func(t *testing.T) {
+		automation, err := ss.StatusAutomation().Save(newTestStatusAutomation(model.NewId()))
+		require.Nil(t, err)
+
+		_, err = ss.StatusAutomation().Save(newTestStatusAutomation(automation.UserId))
+		var cErr *store.ErrConflict
+		assert.True(t, errors.As(err, &cErr))
+	})
+
+	t.Run("should fail to save invalid automation", func(t *testing.T) {
+		automation := newTestStatusAutomation(model.NewId())
+		automation.Source = ""
+
+		_, err := ss.StatusAutomation().Save(automation)
+		var appErr *model.AppError
+		assert.True(t, errors.As(err, &appErr))
+	})
+}
+
+func testStatusAutomationStoreUpdate(t *testing.T, ss store.Store) {
+	t.Run("should update automation", func(t *testing.T) {
+		automation, err := ss.StatusAutomation().Save(newTestStatusAutomation(model.NewId()))
+		require.Nil(t, err)
+
+		automation.EndAt += 1000
+		automation.Source = "caldav"
+		_, err = ss.StatusAutomation().Update(automation)
+		require.Nil(t, err)
+
+		updated, err := ss.StatusAutomation().Get(automation.UserId)
+		require.Nil(t, err)
+		assert.Equal(t, automation, updated)
+	})
+
+	t.Run("should fail to update missing automation", func(t *testing.T) {
+		automation := newTestStatusAutomation(model.NewId())
+		automation.PreSave()
+
+		_, err := ss.StatusAutomation().Update(automation)
+		var nfErr *store.ErrNotFound
+		assert.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testStatusAutomationStoreGet(t *testing.T, ss store.Store) {
+	_, err := ss.StatusAutomation().Get(model.NewId())
+	var nfErr *store.ErrNotFound
+	assert.True(t, errors.As(err, &nfErr))
+}
+
+func testStatusAutomationStoreGetEndedBefore(t *testing.T, ss store.Store) {
+	saveEndingAt := func(endAt int64) *model.StatusAutomation {
+		automation := newTestStatusAutomation(model.NewId())
+		automation.StartAt = 1
+		automation.EndAt = endAt
+
+		saved, err := ss.StatusAutomation().Save(automation)
+		require.Nil(t, err)
+		return saved
+	}
+
+	a1 := saveEndingAt(100)
+	a2 := saveEndingAt(200)
+	a3 := saveEndingAt(300)
+	defer func() {
+		for _, automation := range []*model.StatusAutomation{a1, a2, a3} {
+			ss.StatusAutomation().Delete(automation.UserId)
+		}
+	}()
+
+	automations, err := ss.StatusAutomation().GetEndedBefore(300, 10)
+	require.Nil(t, err)
+	assert.Equal(t, []*model.StatusAutomation{a1, a2}, automations)
+
+	automations, err = ss.StatusAutomation().GetEndedBefore(300, 1)
+	require.Nil(t, err)
+	assert.Equal(t, []*model.StatusAutomation{a1}, automations)
+}
+
+func testStatusAutomationStoreDelete(t *testing.T, ss store.Store) {
+	automation, err := ss.StatusAutomation().Save(newTestStatusAutomation(model.NewId()))
+	require.Nil(t, err)
+
+	err = ss.StatusAutomation().Delete(automation.UserId)
+	require.Nil(t, err)
+
+	_, err = ss.StatusAutomation().Get(automation.UserId)
+	var nfErr *store.ErrNotFound
+	assert.True(t, errors.As(err, &nfErr))
+
+	err = ss.StatusAutomation().Delete(automation.UserId)
+	assert.True(t, errors.As(err, &nfErr))
+}
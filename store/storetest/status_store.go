@@ -15,6 +15,7 @@ import (
 func TestStatusStore(t *testing.T, ss store.Store) {
 	t.Run("", func(t *testing.T) { testStatusStore(t, ss) })
 	t.Run("ActiveUserCount", func(t *testing.T) { testActiveUserCount(t, ss) })
+	t.Run("UpdateLastActivityAts", func(t *testing.T) { testUpdateLastActivityAts(t, ss) })
 }
 
 func testStatusStore(t *testing.T, ss store.Store) {
@@ -56,6 +57,36 @@ func testActiveUserCount(t *testing.T, ss store.Store) {
 	require.True(t, count > 0, "expected count > 0, got %d", count)
 }
 
+func testUpdateLastActivityAts(t *testing.T, ss store.Store) {
+	status1 := &model.Status{UserId: model.NewId(), Status: model.STATUS_ONLINE, LastActivityAt: 100}
+	require.Nil(t, ss.Status().SaveOrUpdate(status1))
+	status2 := &model.Status{UserId: model.NewId(), Status: model.STATUS_ONLINE, LastActivityAt: 100}
+	require.Nil(t, ss.Status().SaveOrUpdate(status2))
+	status3 := &model.Status{UserId: model.NewId(), Status: model.STATUS_ONLINE, LastActivityAt: 100}
+	require.Nil(t, ss.Status().SaveOrUpdate(status3))
+
+	err := ss.Status().UpdateLastActivityAts(map[string]int64{
+		status1.UserId: 200,
+		status2.UserId: 50,
+		model.NewId():  300,
+	})
+	require.Nil(t, err)
+
+	status, err := ss.Status().Get(status1.UserId)
+	require.Nil(t, err)
+	require.Equal(t, int64(200), status.LastActivityAt)
+
+	status, err = ss.Status().Get(status2.UserId)
+	require.Nil(t, err)
+	require.Equal(t, int64(100), status.LastActivityAt, "should not move LastActivityAt back")
+
+	status, err = ss.Status().Get(status3.UserId)
+	require.Nil(t, err)
+	require.Equal(t, int64(100), status.LastActivityAt, "should not update the other users")
+
+	require.Nil(t, ss.Status().UpdateLastActivityAts(map[string]int64{}))
+}
+
 type ByUserId []*model.Status
 
 func (s ByUserId) Len() int           { return len(s) }
@@ -39,12 +39,35 @@ type Store struct {
 	UserAccessTokenStore      mocks.UserAccessTokenStore
 	PluginStore               mocks.PluginStore
 	ChannelMemberHistoryStore mocks.ChannelMemberHistoryStore
+	TeamMemberHistoryStore    mocks.TeamMemberHistoryStore
 	RoleStore                 mocks.RoleStore
 	SchemeStore               mocks.SchemeStore
 	TermsOfServiceStore       mocks.TermsOfServiceStore
 	GroupStore                mocks.GroupStore
 	UserTermsOfServiceStore   mocks.UserTermsOfServiceStore
 	LinkMetadataStore         mocks.LinkMetadataStore
+	ChannelBookmarkStore      mocks.ChannelBookmarkStore
+	RetentionPolicyStore      mocks.RetentionPolicyStore
+	PostArchiveStore          mocks.PostArchiveStore
+	PostsPartitionStore       mocks.PostsPartitionStore
+	PresenceWebhookStore      mocks.PresenceWebhookStore
+	SlugHistoryStore          mocks.SlugHistoryStore
+	TeamInviteLinkStore       mocks.TeamInviteLinkStore
+	ChannelGuestLinkStore     mocks.ChannelGuestLinkStore
+	TermsOfServicePolicyStore mocks.TermsOfServicePolicyStore
+	UserPropertyStore         mocks.UserPropertyStore
+	LoginHistoryStore         mocks.LoginHistoryStore
+	EmailVerificationStore    mocks.EmailVerificationStore
+	EventStreamStore          mocks.EventStreamStore
+	OutboxStore               mocks.OutboxStore
+	ChannelChangeStore        mocks.ChannelChangeStore
+	PendingPinStore           mocks.PendingPinStore
+	ChannelIntegrationStore   mocks.ChannelIntegrationStore
+	IntegrationUsageStore     mocks.IntegrationUsageStore
+	UserRelationshipStore     mocks.UserRelationshipStore
+	PostAcknowledgementStore  mocks.PostAcknowledgementStore
+	StatusAutomationStore     mocks.StatusAutomationStore
+	PostIntegrityStore        mocks.PostIntegrityStore
 	context                   context.Context
 }
 
@@ -81,19 +104,62 @@ func (s *Store) UserTermsOfService() store.UserTermsOfServiceStore { return &s.U
 func (s *Store) ChannelMemberHistory() store.ChannelMemberHistoryStore {
 	return &s.ChannelMemberHistoryStore
 }
-func (s *Store) Group() store.GroupStore               { return &s.GroupStore }
-func (s *Store) LinkMetadata() store.LinkMetadataStore { return &s.LinkMetadataStore }
-func (s *Store) MarkSystemRanUnitTests()               { /* do nothing */ }
-func (s *Store) Close()                                { /* do nothing */ }
-func (s *Store) LockToMaster()                         { /* do nothing */ }
-func (s *Store) UnlockFromMaster()                     { /* do nothing */ }
-func (s *Store) DropAllTables()                        { /* do nothing */ }
-func (s *Store) GetDbVersion() (string, error)         { return "", nil }
-func (s *Store) RecycleDBConnections(time.Duration)    {}
-func (s *Store) TotalMasterDbConnections() int         { return 1 }
-func (s *Store) TotalReadDbConnections() int           { return 1 }
-func (s *Store) TotalSearchDbConnections() int         { return 1 }
-func (s *Store) GetCurrentSchemaVersion() string       { return "" }
+func (s *Store) TeamMemberHistory() store.TeamMemberHistoryStore {
+	return &s.TeamMemberHistoryStore
+}
+func (s *Store) Group() store.GroupStore                       { return &s.GroupStore }
+func (s *Store) LinkMetadata() store.LinkMetadataStore         { return &s.LinkMetadataStore }
+func (s *Store) ChannelBookmark() store.ChannelBookmarkStore   { return &s.ChannelBookmarkStore }
+func (s *Store) RetentionPolicy() store.RetentionPolicyStore   { return &s.RetentionPolicyStore }
+func (s *Store) PostArchive() store.PostArchiveStore           { return &s.PostArchiveStore }
+func (s *Store) PostsPartition() store.PostsPartitionStore     { return &s.PostsPartitionStore }
+func (s *Store) PresenceWebhook() store.PresenceWebhookStore   { return &s.PresenceWebhookStore }
+func (s *Store) SlugHistory() store.SlugHistoryStore           { return &s.SlugHistoryStore }
+func (s *Store) TeamInviteLink() store.TeamInviteLinkStore     { return &s.TeamInviteLinkStore }
+func (s *Store) ChannelGuestLink() store.ChannelGuestLinkStore { return &s.ChannelGuestLinkStore }
+func (s *Store) MarkSystemRanUnitTests()                       { /* do nothing */ }
+func (s *Store) Close()                                        { /* do nothing */ }
+func (s *Store) LockToMaster()                                 { /* do nothing */ }
+func (s *Store) UnlockFromMaster()                             { /* do nothing */ }
+func (s *Store) DropAllTables()                                { /* do nothing */ }
+func (s *Store) GetDbVersion() (string, error)                 { return "", nil }
+func (s *Store) RecycleDBConnections(time.Duration)            {}
+func (s *Store) TotalMasterDbConnections() int                 { return 1 }
+func (s *Store) TotalReadDbConnections() int                   { return 1 }
+func (s *Store) TotalSearchDbConnections() int                 { return 1 }
+func (s *Store) GetCurrentSchemaVersion() string               { return "" }
+func (s *Store) TermsOfServicePolicy() store.TermsOfServicePolicyStore {
+	return &s.TermsOfServicePolicyStore
+}
+func (s *Store) UserProperty() store.UserPropertyStore { return &s.UserPropertyStore }
+func (s *Store) LoginHistory() store.LoginHistoryStore { return &s.LoginHistoryStore }
+func (s *Store) EmailVerification() store.EmailVerificationStore {
+	return &s.EmailVerificationStore
+}
+func (s *Store) EventStream() store.EventStreamStore { return &s.EventStreamStore }
+func (s *Store) Outbox() store.OutboxStore           { return &s.OutboxStore }
+func (s *Store) ChannelChange() store.ChannelChangeStore {
+	return &s.ChannelChangeStore
+}
+func (s *Store) PendingPin() store.PendingPinStore { return &s.PendingPinStore }
+func (s *Store) ChannelIntegration() store.ChannelIntegrationStore {
+	return &s.ChannelIntegrationStore
+}
+func (s *Store) IntegrationUsage() store.IntegrationUsageStore {
+	return &s.IntegrationUsageStore
+}
+func (s *Store) UserRelationship() store.UserRelationshipStore {
+	return &s.UserRelationshipStore
+}
+func (s *Store) PostAcknowledgement() store.PostAcknowledgementStore {
+	return &s.PostAcknowledgementStore
+}
+func (s *Store) StatusAutomation() store.StatusAutomationStore {
+	return &s.StatusAutomationStore
+}
+func (s *Store) PostIntegrity() store.PostIntegrityStore {
+	return &s.PostIntegrityStore
+}
 func (s *Store) CheckIntegrity() <-chan store.IntegrityCheckResult {
 	return make(chan store.IntegrityCheckResult)
 }
@@ -124,6 +190,7 @@ func (s *Store) AssertExpectations(t mock.TestingT) bool {
 		&s.JobStore,
 		&s.UserAccessTokenStore,
 		&s.ChannelMemberHistoryStore,
+		&s.TeamMemberHistoryStore,
 		&s.PluginStore,
 		&s.RoleStore,
 		&s.SchemeStore,
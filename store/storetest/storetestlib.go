@@ -4,9 +4,39 @@
 package storetest
 
 import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
 	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
 )
 
 func MakeEmail() string {
 	return "success_" + model.NewId() + "@simulator.amazonses.com"
 }
+
+// makeTeam saves a new open team. The team members reference existing teams, so the tests adding
+// members to a team have to save it first.
+func makeTeam(t *testing.T, ss store.Store) *model.Team {
+	team, err := ss.Team().Save(context.Background(), &model.Team{
+		DisplayName: "DisplayName",
+		Name:        "z-z-z" + model.NewId() + "b",
+		Email:       MakeEmail(),
+		Type:        model.TEAM_OPEN,
+	})
+	require.Nil(t, err)
+	return team
+}
+
+// makeUser saves a new user. The team members reference existing users, so the tests adding users
+// to a team have to save them first.
+func makeUser(t *testing.T, ss store.Store) *model.User {
+	user, err := ss.User().Save(&model.User{
+		Email:    MakeEmail(),
+		Username: "u" + model.NewId(),
+	})
+	require.Nil(t, err)
+	return user
+}
@@ -21,6 +21,7 @@ func TestSystemStore(t *testing.T, ss store.Store) {
 	t.Run("InsertIfExists", func(t *testing.T) {
 		testInsertIfExists(t, ss)
 	})
+	t.Run("IsDatabaseReadOnly", func(t *testing.T) { testSystemStoreIsDatabaseReadOnly(t, ss) })
 }
 
 func testSystemStore(t *testing.T, ss store.Store) {
@@ -127,3 +128,9 @@ func testInsertIfExists(t *testing.T, ss store.Store) {
 		assert.Equal(t, s2.Value, s3.Value)
 	})
 }
+
+func testSystemStoreIsDatabaseReadOnly(t *testing.T, ss store.Store) {
+	readOnly, err := ss.System().IsDatabaseReadOnly()
+	require.Nil(t, err)
+	assert.False(t, readOnly)
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+func TestTeamInviteLinkStore(t *testing.T, ss store.Store) {
+	t.Run("Save", func(t *testing.T) { testTeamInviteLinkStoreSave(t, ss) })
+	t.Run("GetForTeam", func(t *testing.T) { testTeamInviteLinkStoreGetForTeam(t, ss) })
+	t.Run("IncrementUseCount", func(t *testing.T) { testTeamInviteLinkStoreIncrementUseCount(t, ss) })
+	t.Run("Revoke", func(t *testing.T) { testTeamInviteLinkStoreRevoke(t, ss) })
+}
+
+func newTestTeamInviteLink(teamId string) *model.TeamInviteLink {
+	return &model.TeamInviteLink{
+		TeamId:     teamId,
+		CreatorId:  model.NewId(),
+		Name:       "link-" + model.NewId(),
+		ChannelIds: []string{model.NewId()},
+	}
+}
+
+func testTeamInviteLinkStoreSave(t *testing.T, ss store.Store) {
+	t.Run("should save link", func(t *testing.T) {
+		saved, err := ss.TeamInviteLink().Save(newTestTeamInviteLink(model.NewId()))
+		require.Nil(t, err)
+		assert.NotEmpty(t, saved.Id)
+		assert.Equal(t, model.TEAM_USER_ROLE_ID, saved.Role)
+
+		fetched, err := ss.TeamInviteLink().Get(saved.Id)
+		require.Nil(t, err)
+		assert.Equal(t, saved, fetched)
+
+		fetched, err = ss.TeamInviteLink().GetByName(saved.Name)
+		require.Nil(t, err)
+		assert.Equal(t, saved, fetched)
+	})
+
+	t.Run("should fail to save a taken name", func(t *testing.T) {
+		saved, err := ss.TeamInviteLink().Save(newTestTeamInviteLink(model.NewId()))
+		require.Nil(t, err)
+
+		link := newTestTeamInviteLink(model.NewId())
+		link.Name = saved.Name
+		_, err = ss.TeamInviteLink().Save(link)
+		var cErr *store.ErrConflict
+		assert.True(t, errors.As(err, &cErr))
+	})
+
+	t.Run("should fail to save invalid link", func(t *testing.T) {
+		link := newTestTeamInviteLink(model.NewId())
+		link.Name = "Invalid Name"
+
+		_, err := ss.TeamInviteLink().Save(link)
+		var appErr *model.AppError
+		assert.True(t, errors.As(err, &appErr))
+	})
+}
+
+func testTeamInviteLinkStoreGetForTeam(t *testing.T, ss store.Store) {
+	teamId := model.NewId()
+	link1, err := ss.TeamInviteLink().Save(newTestTeamInviteLink(teamId))
+	require.Nil(t, err)
+	link2, err := ss.TeamInviteLink().Save(newTestTeamInviteLink(teamId))
+	require.Nil(t, err)
+	_, err = ss.TeamInviteLink().Save(newTestTeamInviteLink(model.NewId()))
+	require.Nil(t, err)
+
+	links, err := ss.TeamInviteLink().GetForTeam(teamId)
+	require.Nil(t, err)
+	require.Len(t, links, 2)
+	assert.ElementsMatch(t, []string{link1.Id, link2.Id}, []string{links[0].Id, links[1].Id})
+
+	count, err := ss.TeamInviteLink().CountForTeam(teamId)
+	require.Nil(t, err)
+	assert.Equal(t, int64(2), count)
+}
+
+func testTeamInviteLinkStoreIncrementUseCount(t *testing.T, ss store.Store) {
+	t.Run("should stop at max uses", func(t *testing.T) {
+		link := newTestTeamInviteLink(model.NewId())
+		link.MaxUses = 2
+		saved, err := ss.TeamInviteLink().Save(link)
+		require.Nil(t, err)
+
+		require.Nil(t, ss.TeamInviteLink().IncrementUseCount(saved.Id, model.GetMillis()))
+		require.Nil(t, ss.TeamInviteLink().IncrementUseCount(saved.Id, model.GetMillis()))
+
+		err = ss.TeamInviteLink().IncrementUseCount(saved.Id, model.GetMillis())
+		var ltErr *store.ErrLimitExceeded
+		assert.True(t, errors.As(err, &ltErr))
+
+		fetched, err := ss.TeamInviteLink().Get(saved.Id)
+		require.Nil(t, err)
+		assert.Equal(t, int64(2), fetched.UseCount)
+	})
+
+	t.Run("should stop at expiry", func(t *testing.T) {
+		link := newTestTeamInviteLink(model.NewId())
+		link.ExpiresAt = model.GetMillis() + 1000
+		saved, err := ss.TeamInviteLink().Save(link)
+		require.Nil(t, err)
+
+		require.Nil(t, ss.TeamInviteLink().IncrementUseCount(saved.Id, link.ExpiresAt-1))
+
+		err = ss.TeamInviteLink().IncrementUseCount(saved.Id, link.ExpiresAt)
+		var ltErr *store.ErrLimitExceeded
+		assert.True(t, errors.As(err, &ltErr))
+	})
+}
+
+func testTeamInviteLinkStoreRevoke(t *testing.T, ss store.Store) {
+	saved, err := ss.TeamInviteLink().Save(newTestTeamInviteLink(model.NewId()))
+	require.Nil(t, err)
+
+	err = ss.TeamInviteLink().Revoke(saved.Id, model.GetMillis())
+	require.Nil(t, err)
+
+	var nfErr *store.ErrNotFound
+	_, err = ss.TeamInviteLink().Get(saved.Id)
+	assert.True(t, errors.As(err, &nfErr))
+
+	err = ss.TeamInviteLink().Revoke(saved.Id, model.GetMillis())
+	assert.True(t, errors.As(err, &nfErr))
+
+	err = ss.TeamInviteLink().IncrementUseCount(saved.Id, model.GetMillis())
+	var ltErr *store.ErrLimitExceeded
+	assert.True(t, errors.As(err, &ltErr))
+
+	t.Run("should allow reusing the name of a revoked link", func(t *testing.T) {
+		link := newTestTeamInviteLink(model.NewId())
+		link.Name = saved.Name
+		_, err := ss.TeamInviteLink().Save(link)
+		require.Nil(t, err)
+	})
+}
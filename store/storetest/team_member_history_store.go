@@ -0,0 +1,159 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+func TestTeamMemberHistoryStore(t *testing.T, ss store.Store) {
+	t.Run("LogJoinAndLeaveEvents", func(t *testing.T) { testTeamMemberHistoryLogJoinAndLeaveEvents(t, ss) })
+	t.Run("LogRemoveEvents", func(t *testing.T) { testTeamMemberHistoryLogRemoveEvents(t, ss) })
+	t.Run("PermanentDeleteBatch", func(t *testing.T) { testTeamMemberHistoryPermanentDeleteBatch(t, ss) })
+}
+
+func createTeamMemberHistoryTeamAndUser(t *testing.T, ss store.Store) (*model.Team, *model.User) {
+	team, err := ss.Team().Save(context.Background(), &model.Team{
+		DisplayName: "DisplayName",
+		Name:        "zz" + model.NewId(),
+		Email:       MakeEmail(),
+		Type:        model.TEAM_OPEN,
+	})
+	require.Nil(t, err)
+
+	user, err := ss.User().Save(&model.User{
+		Email:    MakeEmail(),
+		Username: model.NewId(),
+	})
+	require.Nil(t, err)
+
+	return team, user
+}
+
+func testTeamMemberHistoryLogJoinAndLeaveEvents(t *testing.T, ss store.Store) {
+	team, user := createTeamMemberHistoryTeamAndUser(t, ss)
+
+	beforeJoin := model.GetMillis() - 1
+	member, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: team.Id, UserId: user.Id}, -1)
+	require.Nil(t, nErr)
+	time.Sleep(2 * time.Millisecond)
+
+	now := model.GetMillis()
+	histories, nErr := ss.TeamMemberHistory().GetUsersInTeamDuring(now, now, team.Id)
+	require.Nil(t, nErr)
+	require.Len(t, histories, 1)
+	assert.Equal(t, user.Id, histories[0].UserId)
+	assert.Equal(t, user.Email, histories[0].UserEmail)
+	assert.Equal(t, user.Username, histories[0].Username)
+	assert.False(t, histories[0].IsBot)
+	assert.Nil(t, histories[0].LeaveTime)
+
+	// leaving the team only sets the DeleteAt of the member
+	member.DeleteAt = model.GetMillis()
+	_, err := ss.Team().UpdateMember(context.Background(), member)
+	require.Nil(t, err)
+	time.Sleep(2 * time.Millisecond)
+
+	now = model.GetMillis()
+	histories, nErr = ss.TeamMemberHistory().GetUsersInTeamDuring(now, now, team.Id)
+	require.Nil(t, nErr)
+	assert.Empty(t, histories)
+
+	histories, nErr = ss.TeamMemberHistory().GetUsersInTeamDuring(beforeJoin, now, team.Id)
+	require.Nil(t, nErr)
+	require.Len(t, histories, 1)
+	require.NotNil(t, histories[0].LeaveTime)
+	assert.Equal(t, member.DeleteAt, *histories[0].LeaveTime)
+
+	// rejoining the team records a new join event
+	member.DeleteAt = 0
+	_, err = ss.Team().UpdateMember(context.Background(), member)
+	require.Nil(t, err)
+	time.Sleep(2 * time.Millisecond)
+
+	now = model.GetMillis()
+	histories, nErr = ss.TeamMemberHistory().GetUsersInTeamDuring(now, now, team.Id)
+	require.Nil(t, nErr)
+	require.Len(t, histories, 1)
+	assert.Nil(t, histories[0].LeaveTime)
+
+	histories, nErr = ss.TeamMemberHistory().GetUsersInTeamDuring(beforeJoin, now, team.Id)
+	require.Nil(t, nErr)
+	assert.Len(t, histories, 2)
+
+	// updating the roles of the member doesn't record anything
+	member.SchemeAdmin = true
+	_, err = ss.Team().UpdateMember(context.Background(), member)
+	require.Nil(t, err)
+
+	histories, nErr = ss.TeamMemberHistory().GetUsersInTeamDuring(beforeJoin, model.GetMillis(), team.Id)
+	require.Nil(t, nErr)
+	assert.Len(t, histories, 2)
+}
+
+func testTeamMemberHistoryLogRemoveEvents(t *testing.T, ss store.Store) {
+	team, user := createTeamMemberHistoryTeamAndUser(t, ss)
+	_, otherUser := createTeamMemberHistoryTeamAndUser(t, ss)
+
+	_, nErr := ss.Team().SaveMultipleMembers(context.Background(), []*model.TeamMember{
+		{TeamId: team.Id, UserId: user.Id},
+		{TeamId: team.Id, UserId: otherUser.Id},
+	}, -1)
+	require.Nil(t, nErr)
+	time.Sleep(2 * time.Millisecond)
+
+	err := ss.Team().RemoveMember(context.Background(), team.Id, user.Id)
+	require.Nil(t, err)
+	time.Sleep(2 * time.Millisecond)
+
+	now := model.GetMillis()
+	histories, nErr := ss.TeamMemberHistory().GetUsersInTeamDuring(now, now, team.Id)
+	require.Nil(t, nErr)
+	require.Len(t, histories, 1)
+	assert.Equal(t, otherUser.Id, histories[0].UserId)
+
+	err = ss.Team().RemoveAllMembersByTeam(context.Background(), team.Id)
+	require.Nil(t, err)
+	time.Sleep(2 * time.Millisecond)
+
+	now = model.GetMillis()
+	histories, nErr = ss.TeamMemberHistory().GetUsersInTeamDuring(now, now, team.Id)
+	require.Nil(t, nErr)
+	assert.Empty(t, histories)
+}
+
+func testTeamMemberHistoryPermanentDeleteBatch(t *testing.T, ss store.Store) {
+	team, user := createTeamMemberHistoryTeamAndUser(t, ss)
+
+	beforeJoin := model.GetMillis() - 1
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: team.Id, UserId: user.Id}, -1)
+	require.Nil(t, nErr)
+	time.Sleep(2 * time.Millisecond)
+
+	_, err := ss.Team().RemoveAllMembersByUser(context.Background(), user.Id)
+	require.Nil(t, err)
+	leaveTime := model.GetMillis()
+
+	histories, nErr := ss.TeamMemberHistory().GetUsersInTeamDuring(beforeJoin, leaveTime, team.Id)
+	require.Nil(t, nErr)
+	require.Len(t, histories, 1)
+	require.NotNil(t, histories[0].LeaveTime)
+
+	rowsDeleted, nErr := ss.TeamMemberHistory().PermanentDeleteBatch(leaveTime, math.MaxInt64)
+	require.Nil(t, nErr)
+	assert.GreaterOrEqual(t, rowsDeleted, int64(1))
+
+	histories, nErr = ss.TeamMemberHistory().GetUsersInTeamDuring(beforeJoin, leaveTime, team.Id)
+	require.Nil(t, nErr)
+	assert.Empty(t, histories)
+}
@@ -4,7 +4,12 @@
 package storetest
 
 import (
+	"context"
+	"errors"
+	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -16,9 +21,9 @@ import (
 )
 
 func cleanupTeamStore(t *testing.T, ss store.Store) {
-	allTeams, err := ss.Team().GetAll()
+	allTeams, err := ss.Team().GetAll(context.Background())
 	for _, team := range allTeams {
-		ss.Team().PermanentDelete(team.Id)
+		ss.Team().PermanentDelete(context.Background(), team.Id)
 	}
 	assert.Nil(t, err)
 }
@@ -27,14 +32,20 @@ func TestTeamStore(t *testing.T, ss store.Store) {
 	createDefaultRoles(t, ss)
 
 	t.Run("Save", func(t *testing.T) { testTeamStoreSave(t, ss) })
+	t.Run("SaveMultiple", func(t *testing.T) { testTeamStoreSaveMultiple(t, ss) })
 	t.Run("Update", func(t *testing.T) { testTeamStoreUpdate(t, ss) })
 	t.Run("Get", func(t *testing.T) { testTeamStoreGet(t, ss) })
 	t.Run("GetByName", func(t *testing.T) { testTeamStoreGetByName(t, ss) })
 	t.Run("GetByNames", func(t *testing.T) { testTeamStoreGetByNames(t, ss) })
+	t.Run("GetByAllowedDomain", func(t *testing.T) { testTeamStoreGetByAllowedDomain(t, ss) })
 	t.Run("SearchAll", func(t *testing.T) { testTeamStoreSearchAll(t, ss) })
 	t.Run("SearchOpen", func(t *testing.T) { testTeamStoreSearchOpen(t, ss) })
 	t.Run("SearchPrivate", func(t *testing.T) { testTeamStoreSearchPrivate(t, ss) })
+	t.Run("SearchPrivateForUser", func(t *testing.T) { testTeamStoreSearchPrivateForUser(t, ss) })
+	t.Run("SearchWithOpts", func(t *testing.T) { testTeamStoreSearchWithOpts(t, ss) })
+	t.Run("SearchAllPagedSort", func(t *testing.T) { testTeamStoreSearchAllPagedSort(t, ss) })
 	t.Run("GetByInviteId", func(t *testing.T) { testTeamStoreGetByInviteId(t, ss) })
+	t.Run("RegenerateInviteId", func(t *testing.T) { testTeamStoreRegenerateInviteId(t, ss) })
 	t.Run("ByUserId", func(t *testing.T) { testTeamStoreByUserId(t, ss) })
 	t.Run("GetAllTeamListing", func(t *testing.T) { testGetAllTeamListing(t, ss) })
 	t.Run("GetAllTeamPageListing", func(t *testing.T) { testGetAllTeamPageListing(t, ss) })
@@ -42,6 +53,9 @@ func TestTeamStore(t *testing.T, ss store.Store) {
 	t.Run("GetAllPrivateTeamPageListing", func(t *testing.T) { testGetAllPrivateTeamPageListing(t, ss) })
 	t.Run("GetAllPublicTeamPageListing", func(t *testing.T) { testGetAllPublicTeamPageListing(t, ss) })
 	t.Run("Delete", func(t *testing.T) { testDelete(t, ss) })
+	t.Run("GetAllDeletedPage", func(t *testing.T) { testGetAllDeletedPage(t, ss) })
+	t.Run("GetTeamsWithNoActiveMembers", func(t *testing.T) { testGetTeamsWithNoActiveMembers(t, ss) })
+	t.Run("Restore", func(t *testing.T) { testTeamStoreRestore(t, ss) })
 	t.Run("TeamCount", func(t *testing.T) { testTeamCount(t, ss) })
 	t.Run("TeamPublicCount", func(t *testing.T) { testPublicTeamCount(t, ss) })
 	t.Run("TeamPrivateCount", func(t *testing.T) { testPrivateTeamCount(t, ss) })
@@ -55,9 +69,14 @@ func TestTeamStore(t *testing.T, ss store.Store) {
 	t.Run("RemoveMembers", func(t *testing.T) { testTeamRemoveMembers(t, ss) })
 	t.Run("SaveTeamMemberMaxMembers", func(t *testing.T) { testSaveTeamMemberMaxMembers(t, ss) })
 	t.Run("GetTeamMember", func(t *testing.T) { testGetTeamMember(t, ss) })
+	t.Run("UserBelongsToTeams", func(t *testing.T) { testTeamStoreUserBelongsToTeams(t, ss) })
+	t.Run("GetMembersByTeamIds", func(t *testing.T) { testGetTeamMembersByTeamIds(t, ss) })
 	t.Run("GetTeamMembersByIds", func(t *testing.T) { testGetTeamMembersByIds(t, ss) })
+	t.Run("GetMembersWithExpiredRoles", func(t *testing.T) { testTeamStoreGetMembersWithExpiredRoles(t, ss) })
 	t.Run("MemberCount", func(t *testing.T) { testTeamStoreMemberCount(t, ss) })
+	t.Run("GetActiveMemberCounts", func(t *testing.T) { testTeamStoreGetActiveMemberCounts(t, ss) })
 	t.Run("GetChannelUnreadsForAllTeams", func(t *testing.T) { testGetChannelUnreadsForAllTeams(t, ss) })
+	t.Run("GetUnreadsForAllTeams", func(t *testing.T) { testGetUnreadsForAllTeams(t, ss) })
 	t.Run("GetChannelUnreadsForTeam", func(t *testing.T) { testGetChannelUnreadsForTeam(t, ss) })
 	t.Run("UpdateLastTeamIconUpdate", func(t *testing.T) { testUpdateLastTeamIconUpdate(t, ss) })
 	t.Run("GetTeamsByScheme", func(t *testing.T) { testGetTeamsByScheme(t, ss) })
@@ -68,7 +87,14 @@ func TestTeamStore(t *testing.T, ss store.Store) {
 	t.Run("GetAllForExportAfter", func(t *testing.T) { testTeamStoreGetAllForExportAfter(t, ss) })
 	t.Run("GetTeamMembersForExport", func(t *testing.T) { testTeamStoreGetTeamMembersForExport(t, ss) })
 	t.Run("GetTeamsForUserWithPagination", func(t *testing.T) { testTeamMembersWithPagination(t, ss) })
+	t.Run("GetTeamsForUserWithPaginationOptions", func(t *testing.T) { testTeamMembersWithPaginationOptions(t, ss) })
 	t.Run("GroupSyncedTeamCount", func(t *testing.T) { testGroupSyncedTeamCount(t, ss) })
+	t.Run("GetGroupSyncedTeamsPage", func(t *testing.T) { testGetGroupSyncedTeamsPage(t, ss) })
+	t.Run("GroupSyncedTeamCountByGroup", func(t *testing.T) { testGroupSyncedTeamCountByGroup(t, ss) })
+	t.Run("GetDirectoryStats", func(t *testing.T) { testTeamStoreGetDirectoryStats(t, ss) })
+	t.Run("GetNewTeamMembersSince", func(t *testing.T) { testTeamStoreGetNewTeamMembersSince(t, ss) })
+	t.Run("RetentionPolicy", func(t *testing.T) { testTeamStoreRetentionPolicy(t, ss) })
+	t.Run("CancelledContext", func(t *testing.T) { testTeamStoreCancelledContext(t, ss) })
 }
 
 func testTeamStoreSave(t *testing.T, ss store.Store) {
@@ -78,15 +104,66 @@ func testTeamStoreSave(t *testing.T, ss store.Store) {
 	o1.Email = MakeEmail()
 	o1.Type = model.TEAM_OPEN
 
-	_, err := ss.Team().Save(&o1)
+	_, err := ss.Team().Save(context.Background(), &o1)
 	require.Nil(t, err, "couldn't save item")
 
-	_, err = ss.Team().Save(&o1)
+	_, err = ss.Team().Save(context.Background(), &o1)
 	require.NotNil(t, err, "shouldn't be able to update from save")
+	var invErr *store.ErrInvalidInput
+	assert.True(t, errors.As(err, &invErr))
 
 	o1.Id = ""
-	_, err = ss.Team().Save(&o1)
+	_, err = ss.Team().Save(context.Background(), &o1)
 	require.NotNil(t, err, "should be unique domain")
+	var cErr *store.ErrConflict
+	assert.True(t, errors.As(err, &cErr))
+}
+
+func testTeamStoreSaveMultiple(t *testing.T, ss store.Store) {
+	existing := &model.Team{DisplayName: "DisplayName", Name: "z-z-z" + model.NewId() + "b", Email: MakeEmail(), Type: model.TEAM_OPEN}
+	_, err := ss.Team().Save(context.Background(), existing)
+	require.Nil(t, err)
+
+	name := "z-z-z" + model.NewId() + "b"
+	teams := []*model.Team{
+		{DisplayName: "DisplayName", Name: name, Email: MakeEmail(), Type: model.TEAM_OPEN, Props: map[string]interface{}{"key": "value"}},
+		{DisplayName: "DisplayName", Name: "z-z-z" + model.NewId() + "b", Email: MakeEmail(), Type: model.TEAM_INVITE},
+		{DisplayName: "DisplayName", Name: existing.Name, Email: MakeEmail(), Type: model.TEAM_OPEN},
+		{DisplayName: "DisplayName", Name: name, Email: MakeEmail(), Type: model.TEAM_OPEN},
+		{DisplayName: "DisplayName", Name: "z-z-z" + model.NewId() + "b", Email: MakeEmail(), Type: "XYZ"},
+	}
+
+	saved, rowErrs, err := ss.Team().SaveMultiple(context.Background(), teams)
+	require.Nil(t, err)
+	require.Len(t, saved, len(teams))
+	require.Len(t, rowErrs, len(teams))
+
+	for i := 0; i < 2; i++ {
+		require.Nil(t, rowErrs[i])
+		require.NotNil(t, saved[i])
+
+		team, err := ss.Team().Get(context.Background(), saved[i].Id)
+		require.Nil(t, err)
+		assert.Equal(t, teams[i].Name, team.Name)
+		assert.Equal(t, teams[i].Type, team.Type)
+	}
+
+	team, err := ss.Team().Get(context.Background(), saved[0].Id)
+	require.Nil(t, err)
+	assert.Equal(t, "value", team.Props["key"])
+
+	for i := 2; i < len(teams); i++ {
+		assert.Nil(t, saved[i])
+		assert.NotNil(t, rowErrs[i])
+	}
+	var cErr *store.ErrConflict
+	assert.True(t, errors.As(rowErrs[2], &cErr))
+	assert.True(t, errors.As(rowErrs[3], &cErr))
+
+	saved, rowErrs, err = ss.Team().SaveMultiple(context.Background(), []*model.Team{})
+	require.Nil(t, err)
+	assert.Empty(t, saved)
+	assert.Empty(t, rowErrs)
 }
 
 func testTeamStoreUpdate(t *testing.T, ss store.Store) {
@@ -95,21 +172,37 @@ func testTeamStoreUpdate(t *testing.T, ss store.Store) {
 	o1.Name = "z-z-z" + model.NewId() + "b"
 	o1.Email = MakeEmail()
 	o1.Type = model.TEAM_OPEN
-	_, err := ss.Team().Save(&o1)
+	_, err := ss.Team().Save(context.Background(), &o1)
 	require.Nil(t, err)
 
 	time.Sleep(100 * time.Millisecond)
 
-	_, err = ss.Team().Update(&o1)
+	_, err = ss.Team().Update(context.Background(), &o1)
 	require.Nil(t, err)
 
 	o1.Id = "missing"
-	_, err = ss.Team().Update(&o1)
+	_, err = ss.Team().Update(context.Background(), &o1)
 	require.NotNil(t, err, "Update should have failed because of missing key")
 
 	o1.Id = model.NewId()
-	_, err = ss.Team().Update(&o1)
+	_, err = ss.Team().Update(context.Background(), &o1)
 	require.NotNil(t, err, "Update should have faile because id change")
+	var nfErr *store.ErrNotFound
+	assert.True(t, errors.As(err, &nfErr))
+
+	o2 := model.Team{}
+	o2.DisplayName = "DisplayName"
+	o2.Name = "z-z-z" + model.NewId() + "b"
+	o2.Email = MakeEmail()
+	o2.Type = model.TEAM_OPEN
+	_, err = ss.Team().Save(context.Background(), &o2)
+	require.Nil(t, err)
+
+	o2.Name = o1.Name
+	_, err = ss.Team().Update(context.Background(), &o2)
+	require.NotNil(t, err, "Update should have failed because of the duplicate name")
+	var cErr *store.ErrConflict
+	assert.True(t, errors.As(err, &cErr))
 }
 
 func testTeamStoreGet(t *testing.T, ss store.Store) {
@@ -118,15 +211,78 @@ func testTeamStoreGet(t *testing.T, ss store.Store) {
 	o1.Name = "z-z-z" + model.NewId() + "b"
 	o1.Email = MakeEmail()
 	o1.Type = model.TEAM_OPEN
-	_, err := ss.Team().Save(&o1)
+	_, err := ss.Team().Save(context.Background(), &o1)
 	require.Nil(t, err)
 
-	r1, err := ss.Team().Get(o1.Id)
+	r1, err := ss.Team().Get(context.Background(), o1.Id)
 	require.Nil(t, err)
 	require.Equal(t, r1.ToJson(), o1.ToJson())
 
-	_, err = ss.Team().Get("")
+	_, err = ss.Team().Get(context.Background(), "")
 	require.NotNil(t, err, "Missing id should have failed")
+	var nfErr *store.ErrNotFound
+	assert.True(t, errors.As(err, &nfErr))
+}
+
+func testTeamStoreGetByAllowedDomain(t *testing.T, ss store.Store) {
+	domain := "z" + model.NewId() + ".com"
+
+	newTeam := func(allowedDomains string) *model.Team {
+		team, err := ss.Team().Save(context.Background(), &model.Team{
+			DisplayName:    "DisplayName",
+			Name:           "z-z-z" + model.NewId() + "b",
+			Email:          MakeEmail(),
+			Type:           model.TEAM_OPEN,
+			AllowedDomains: allowedDomains,
+		})
+		require.Nil(t, err)
+		return team
+	}
+
+	only := newTeam(domain)
+	commaSeparated := newTeam("other.com," + domain)
+	spaceSeparated := newTeam("@other.com @" + strings.ToUpper(domain) + " other.org")
+	subdomain := newTeam("corp." + domain)
+	superstring := newTeam(domain + ".au, other" + domain)
+	wildcard := newTeam(strings.Replace(domain, ".", "_", 1))
+
+	deleted := newTeam(domain)
+	deleted.DeleteAt = model.GetMillis()
+	_, err := ss.Team().Update(context.Background(), deleted)
+	require.Nil(t, err)
+
+	teams, err := ss.Team().GetByAllowedDomain(context.Background(), domain)
+	require.Nil(t, err)
+
+	teamIds := []string{}
+	for _, team := range teams {
+		teamIds = append(teamIds, team.Id)
+	}
+	assert.ElementsMatch(t, []string{only.Id, commaSeparated.Id, spaceSeparated.Id}, teamIds)
+	assert.NotContains(t, teamIds, subdomain.Id)
+	assert.NotContains(t, teamIds, superstring.Id)
+	assert.NotContains(t, teamIds, wildcard.Id)
+
+	t.Run("accepts the domain prefixed with @", func(t *testing.T) {
+		teams, err := ss.Team().GetByAllowedDomain(context.Background(), "@"+domain)
+		require.Nil(t, err)
+		assert.Len(t, teams, 3)
+	})
+
+	t.Run("no team allows the domain", func(t *testing.T) {
+		teams, err := ss.Team().GetByAllowedDomain(context.Background(), "z"+model.NewId()+".com")
+		require.Nil(t, err)
+		assert.Empty(t, teams)
+	})
+
+	t.Run("invalid domain", func(t *testing.T) {
+		_, err := ss.Team().GetByAllowedDomain(context.Background(), "")
+		var invErr *store.ErrInvalidInput
+		require.True(t, errors.As(err, &invErr))
+
+		_, err = ss.Team().GetByAllowedDomain(context.Background(), domain+", other.com")
+		require.True(t, errors.As(err, &invErr))
+	})
 }
 
 func testTeamStoreGetByNames(t *testing.T, ss store.Store) {
@@ -136,7 +292,7 @@ func testTeamStoreGetByNames(t *testing.T, ss store.Store) {
 	o1.Email = MakeEmail()
 	o1.Type = model.TEAM_OPEN
 
-	_, err := ss.Team().Save(&o1)
+	_, err := ss.Team().Save(context.Background(), &o1)
 	require.Nil(t, err)
 
 	o2 := model.Team{}
@@ -145,19 +301,19 @@ func testTeamStoreGetByNames(t *testing.T, ss store.Store) {
 	o2.Email = MakeEmail()
 	o2.Type = model.TEAM_OPEN
 
-	_, err = ss.Team().Save(&o2)
+	_, err = ss.Team().Save(context.Background(), &o2)
 	require.Nil(t, err)
 
 	t.Run("Get empty list", func(t *testing.T) {
 		var teams []*model.Team
-		teams, err = ss.Team().GetByNames([]string{})
+		teams, err = ss.Team().GetByNames(context.Background(), []string{})
 		require.Nil(t, err)
 		require.Empty(t, teams)
 	})
 
 	t.Run("Get existing teams", func(t *testing.T) {
 		var teams []*model.Team
-		teams, err = ss.Team().GetByNames([]string{o1.Name, o2.Name})
+		teams, err = ss.Team().GetByNames(context.Background(), []string{o1.Name, o2.Name})
 		require.Nil(t, err)
 		teamsIds := []string{}
 		for _, team := range teams {
@@ -168,16 +324,16 @@ func testTeamStoreGetByNames(t *testing.T, ss store.Store) {
 	})
 
 	t.Run("Get existing team and one invalid team name", func(t *testing.T) {
-		_, err = ss.Team().GetByNames([]string{o1.Name, ""})
+		_, err = ss.Team().GetByNames(context.Background(), []string{o1.Name, ""})
 		require.NotNil(t, err)
 	})
 
 	t.Run("Get existing team and not existing team", func(t *testing.T) {
-		_, err = ss.Team().GetByNames([]string{o1.Name, "not-existing-team-name"})
+		_, err = ss.Team().GetByNames(context.Background(), []string{o1.Name, "not-existing-team-name"})
 		require.NotNil(t, err)
 	})
 	t.Run("Get not existing teams", func(t *testing.T) {
-		_, err = ss.Team().GetByNames([]string{"not-existing-team-name", "not-existing-team-name-2"})
+		_, err = ss.Team().GetByNames(context.Background(), []string{"not-existing-team-name", "not-existing-team-name-2"})
 		require.NotNil(t, err)
 	})
 }
@@ -189,27 +345,80 @@ func testTeamStoreGetByName(t *testing.T, ss store.Store) {
 	o1.Email = MakeEmail()
 	o1.Type = model.TEAM_OPEN
 
-	_, err := ss.Team().Save(&o1)
+	_, err := ss.Team().Save(context.Background(), &o1)
 	require.Nil(t, err)
 
 	t.Run("Get existing team", func(t *testing.T) {
 		var team *model.Team
-		team, err = ss.Team().GetByName(o1.Name)
+		team, err = ss.Team().GetByName(context.Background(), o1.Name)
 		require.Nil(t, err)
 		require.Equal(t, *team, o1, "invalid returned team")
 	})
 
 	t.Run("Get invalid team name", func(t *testing.T) {
-		_, err = ss.Team().GetByName("")
+		_, err = ss.Team().GetByName(context.Background(), "")
 		require.NotNil(t, err, "Missing id should have failed")
 	})
 
 	t.Run("Get not existing team", func(t *testing.T) {
-		_, err = ss.Team().GetByName("not-existing-team-name")
+		_, err = ss.Team().GetByName(context.Background(), "not-existing-team-name")
 		require.NotNil(t, err, "Missing id should have failed")
 	})
 }
 
+func testTeamStoreCancelledContext(t *testing.T, ss store.Store) {
+	team, err := ss.Team().Save(context.Background(), &model.Team{
+		DisplayName: "DisplayName",
+		Name:        "z-z-z" + model.NewId() + "b",
+		Email:       MakeEmail(),
+		Type:        model.TEAM_OPEN,
+		Props:       map[string]interface{}{"key": "value"},
+	})
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, appErr := ss.Team().SearchAll(ctx, team.Name, &model.TeamSearchOpts{})
+	require.NotNil(t, appErr)
+
+	_, _, appErr = ss.Team().SearchAllPaged(ctx, team.Name, &model.TeamSearchOpts{}, 0, 10)
+	require.NotNil(t, appErr)
+
+	_, err = ss.Team().Get(ctx, team.Id)
+	require.Error(t, err)
+
+	_, err = ss.Team().Update(ctx, team)
+	require.Error(t, err)
+
+	_, err = ss.Team().GetByName(ctx, team.Name)
+	require.Error(t, err)
+
+	_, appErr = ss.Team().GetAll(ctx)
+	require.NotNil(t, appErr)
+
+	_, appErr = ss.Team().AnalyticsTeamCount(ctx, false)
+	require.NotNil(t, appErr)
+
+	_, err = ss.Team().SaveMember(ctx, &model.TeamMember{TeamId: team.Id, UserId: model.NewId()}, -1)
+	require.Error(t, err)
+
+	teams, appErr := ss.Team().SearchAll(context.Background(), team.Name, &model.TeamSearchOpts{})
+	require.Nil(t, appErr)
+	require.Len(t, teams, 1)
+	assert.Equal(t, team.Id, teams[0].Id)
+	assert.Equal(t, "value", teams[0].Props["key"])
+
+	byName, err := ss.Team().GetByName(context.Background(), team.Name)
+	require.NoError(t, err)
+	assert.Equal(t, team.Id, byName.Id)
+	assert.Equal(t, "value", byName.Props["key"])
+
+	count, appErr := ss.Team().AnalyticsTeamCount(context.Background(), false)
+	require.Nil(t, appErr)
+	assert.NotZero(t, count)
+}
+
 func testTeamStoreSearchAll(t *testing.T, ss store.Store) {
 	o := model.Team{}
 	o.DisplayName = "ADisplayName" + model.NewId()
@@ -218,7 +427,7 @@ func testTeamStoreSearchAll(t *testing.T, ss store.Store) {
 	o.Type = model.TEAM_OPEN
 	o.AllowOpenInvite = true
 
-	_, err := ss.Team().Save(&o)
+	_, err := ss.Team().Save(context.Background(), &o)
 	require.Nil(t, err)
 
 	p := model.Team{}
@@ -228,7 +437,7 @@ func testTeamStoreSearchAll(t *testing.T, ss store.Store) {
 	p.Type = model.TEAM_OPEN
 	p.AllowOpenInvite = false
 
-	_, err = ss.Team().Save(&p)
+	_, err = ss.Team().Save(context.Background(), &p)
 	require.Nil(t, err)
 
 	q := model.Team{}
@@ -238,7 +447,7 @@ func testTeamStoreSearchAll(t *testing.T, ss store.Store) {
 	q.Type = model.TEAM_OPEN
 	q.AllowOpenInvite = false
 
-	_, err = ss.Team().Save(&q)
+	_, err = ss.Team().Save(context.Background(), &q)
 	require.Nil(t, err)
 
 	testCases := []struct {
@@ -311,7 +520,7 @@ func testTeamStoreSearchAll(t *testing.T, ss store.Store) {
 
 	for _, tc := range testCases {
 		t.Run(tc.Name, func(t *testing.T) {
-			r1, err := ss.Team().SearchAll(tc.Term)
+			r1, err := ss.Team().SearchAll(context.Background(), tc.Term, &model.TeamSearchOpts{})
 			require.Nil(t, err)
 			require.Equal(t, tc.ExpectedLenth, len(r1))
 			if tc.ExpectedFirstId != "" {
@@ -329,7 +538,7 @@ func testTeamStoreSearchOpen(t *testing.T, ss store.Store) {
 	o.Type = model.TEAM_OPEN
 	o.AllowOpenInvite = true
 
-	_, err := ss.Team().Save(&o)
+	_, err := ss.Team().Save(context.Background(), &o)
 	require.Nil(t, err)
 
 	p := model.Team{}
@@ -339,7 +548,7 @@ func testTeamStoreSearchOpen(t *testing.T, ss store.Store) {
 	p.Type = model.TEAM_OPEN
 	p.AllowOpenInvite = false
 
-	_, err = ss.Team().Save(&p)
+	_, err = ss.Team().Save(context.Background(), &p)
 	require.Nil(t, err)
 
 	q := model.Team{}
@@ -349,7 +558,7 @@ func testTeamStoreSearchOpen(t *testing.T, ss store.Store) {
 	q.Type = model.TEAM_OPEN
 	q.AllowOpenInvite = true
 
-	_, err = ss.Team().Save(&q)
+	_, err = ss.Team().Save(context.Background(), &q)
 	require.Nil(t, err)
 
 	testCases := []struct {
@@ -416,7 +625,7 @@ func testTeamStoreSearchOpen(t *testing.T, ss store.Store) {
 
 	for _, tc := range testCases {
 		t.Run(tc.Name, func(t *testing.T) {
-			r1, err := ss.Team().SearchOpen(tc.Term)
+			r1, err := ss.Team().SearchOpen(context.Background(), tc.Term, &model.TeamSearchOpts{})
 			require.Nil(t, err)
 			results := r1
 			require.Equal(t, tc.ExpectedLength, len(results))
@@ -435,7 +644,7 @@ func testTeamStoreSearchPrivate(t *testing.T, ss store.Store) {
 	o.Type = model.TEAM_OPEN
 	o.AllowOpenInvite = true
 
-	_, err := ss.Team().Save(&o)
+	_, err := ss.Team().Save(context.Background(), &o)
 	require.Nil(t, err)
 
 	p := model.Team{}
@@ -445,7 +654,7 @@ func testTeamStoreSearchPrivate(t *testing.T, ss store.Store) {
 	p.Type = model.TEAM_OPEN
 	p.AllowOpenInvite = false
 
-	_, err = ss.Team().Save(&p)
+	_, err = ss.Team().Save(context.Background(), &p)
 	require.Nil(t, err)
 
 	q := model.Team{}
@@ -455,7 +664,7 @@ func testTeamStoreSearchPrivate(t *testing.T, ss store.Store) {
 	q.Type = model.TEAM_OPEN
 	q.AllowOpenInvite = false
 
-	_, err = ss.Team().Save(&q)
+	_, err = ss.Team().Save(context.Background(), &q)
 	require.Nil(t, err)
 
 	testCases := []struct {
@@ -522,7 +731,7 @@ func testTeamStoreSearchPrivate(t *testing.T, ss store.Store) {
 
 	for _, tc := range testCases {
 		t.Run(tc.Name, func(t *testing.T) {
-			r1, err := ss.Team().SearchPrivate(tc.Term)
+			r1, err := ss.Team().SearchPrivate(context.Background(), tc.Term, &model.TeamSearchOpts{})
 			require.Nil(t, err)
 			results := r1
 			require.Equal(t, tc.ExpectedLength, len(results))
@@ -533,6 +742,190 @@ func testTeamStoreSearchPrivate(t *testing.T, ss store.Store) {
 	}
 }
 
+func testTeamStoreSearchPrivateForUser(t *testing.T, ss store.Store) {
+	term := "searchprivateforuser" + model.NewId()
+	saveTeam := func(teamType string, allowOpenInvite bool) *model.Team {
+		team, err := ss.Team().Save(context.Background(), &model.Team{
+			DisplayName:     term + model.NewId(),
+			Name:            "zz" + model.NewId() + "a",
+			Email:           MakeEmail(),
+			Type:            teamType,
+			AllowOpenInvite: allowOpenInvite,
+		})
+		require.Nil(t, err)
+		return team
+	}
+
+	member := saveTeam(model.TEAM_INVITE, false)
+	closedMember := saveTeam(model.TEAM_OPEN, false)
+	notMember := saveTeam(model.TEAM_INVITE, false)
+	left := saveTeam(model.TEAM_INVITE, false)
+	open := saveTeam(model.TEAM_OPEN, true)
+
+	userId := makeUser(t, ss).Id
+	otherUserId := makeUser(t, ss).Id
+
+	_, err := ss.Team().SaveMultipleMembers(context.Background(), []*model.TeamMember{
+		{TeamId: member.Id, UserId: userId},
+		{TeamId: closedMember.Id, UserId: userId},
+		{TeamId: notMember.Id, UserId: otherUserId},
+		{TeamId: left.Id, UserId: userId, DeleteAt: model.GetMillis()},
+		{TeamId: open.Id, UserId: userId},
+	}, -1)
+	require.Nil(t, err)
+
+	getIds := func(teams []*model.Team, err *model.AppError) []string {
+		require.Nil(t, err)
+		ids := []string{}
+		for _, team := range teams {
+			ids = append(ids, team.Id)
+		}
+		return ids
+	}
+
+	assert.ElementsMatch(t, []string{member.Id, closedMember.Id}, getIds(ss.Team().SearchPrivateForUser(context.Background(), userId, term, &model.TeamSearchOpts{})))
+	assert.ElementsMatch(t, []string{member.Id}, getIds(ss.Team().SearchPrivateForUser(context.Background(), userId, member.Name, &model.TeamSearchOpts{})))
+	assert.ElementsMatch(t, []string{member.Id}, getIds(ss.Team().SearchPrivateForUser(context.Background(), userId, term, &model.TeamSearchOpts{TeamType: model.TEAM_INVITE})))
+	assert.ElementsMatch(t, []string{notMember.Id}, getIds(ss.Team().SearchPrivateForUser(context.Background(), otherUserId, term, &model.TeamSearchOpts{})))
+	assert.Empty(t, getIds(ss.Team().SearchPrivateForUser(context.Background(), model.NewId(), term, &model.TeamSearchOpts{})))
+}
+
+func testTeamStoreSearchWithOpts(t *testing.T, ss store.Store) {
+	term := "searchopts" + model.NewId()
+	saveTeam := func(teamType string, allowOpenInvite, groupConstrained bool) *model.Team {
+		team, err := ss.Team().Save(context.Background(), &model.Team{
+			DisplayName:      term + model.NewId(),
+			Name:             "zz" + model.NewId() + "a",
+			Email:            MakeEmail(),
+			Type:             teamType,
+			AllowOpenInvite:  allowOpenInvite,
+			GroupConstrained: model.NewBool(groupConstrained),
+		})
+		require.Nil(t, err)
+		return team
+	}
+
+	open := saveTeam(model.TEAM_OPEN, true, false)
+	invite := saveTeam(model.TEAM_INVITE, false, true)
+	closed := saveTeam(model.TEAM_OPEN, false, false)
+	deleted := saveTeam(model.TEAM_OPEN, true, false)
+	deleted.DeleteAt = model.GetMillis()
+	_, err := ss.Team().Update(context.Background(), deleted)
+	require.Nil(t, err)
+
+	policy, policyErr := ss.RetentionPolicy().Save(newTestRetentionPolicy([]string{invite.Id, closed.Id}, []string{}))
+	require.Nil(t, policyErr)
+	defer ss.RetentionPolicy().Delete(policy.Id)
+
+	getIds := func(teams []*model.Team, err *model.AppError) []string {
+		require.Nil(t, err)
+		ids := []string{}
+		for _, team := range teams {
+			ids = append(ids, team.Id)
+		}
+		return ids
+	}
+
+	testCases := []struct {
+		Name        string
+		Opts        *model.TeamSearchOpts
+		ExpectedIds []string
+	}{
+		{"no filters", &model.TeamSearchOpts{}, []string{open.Id, invite.Id, closed.Id, deleted.Id}},
+		{"exclude deleted", &model.TeamSearchOpts{ExcludeDeleted: true}, []string{open.Id, invite.Id, closed.Id}},
+		{"allow open invite", &model.TeamSearchOpts{AllowOpenInvite: model.NewBool(true)}, []string{open.Id, deleted.Id}},
+		{"disallow open invite", &model.TeamSearchOpts{AllowOpenInvite: model.NewBool(false)}, []string{invite.Id, closed.Id}},
+		{"group constrained", &model.TeamSearchOpts{GroupConstrained: model.NewBool(true)}, []string{invite.Id}},
+		{"not group constrained", &model.TeamSearchOpts{GroupConstrained: model.NewBool(false)}, []string{open.Id, closed.Id, deleted.Id}},
+		{"team type", &model.TeamSearchOpts{TeamType: model.TEAM_OPEN}, []string{open.Id, closed.Id, deleted.Id}},
+		{"team type, exclude deleted", &model.TeamSearchOpts{TeamType: model.TEAM_OPEN, ExcludeDeleted: true}, []string{open.Id, closed.Id}},
+		{"policy", &model.TeamSearchOpts{PolicyID: policy.Id}, []string{invite.Id, closed.Id}},
+		{"combined", &model.TeamSearchOpts{PolicyID: policy.Id, TeamType: model.TEAM_INVITE}, []string{invite.Id}},
+		{"exclude policy constrained", &model.TeamSearchOpts{ExcludePolicyConstrained: true}, []string{open.Id, deleted.Id}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			assert.ElementsMatch(t, tc.ExpectedIds, getIds(ss.Team().SearchAll(context.Background(), term, tc.Opts)))
+
+			teams, totalCount, err := ss.Team().SearchAllPaged(context.Background(), term, tc.Opts, 0, 1)
+			require.Nil(t, err)
+			assert.Len(t, teams, 1)
+			assert.Equal(t, int64(len(tc.ExpectedIds)), totalCount)
+		})
+	}
+
+	t.Run("open and private searches", func(t *testing.T) {
+		assert.ElementsMatch(t, []string{open.Id, deleted.Id}, getIds(ss.Team().SearchOpen(context.Background(), term, &model.TeamSearchOpts{})))
+		assert.ElementsMatch(t, []string{open.Id}, getIds(ss.Team().SearchOpen(context.Background(), term, &model.TeamSearchOpts{ExcludeDeleted: true})))
+		assert.ElementsMatch(t, []string{invite.Id, closed.Id}, getIds(ss.Team().SearchPrivate(context.Background(), term, &model.TeamSearchOpts{})))
+		assert.ElementsMatch(t, []string{invite.Id}, getIds(ss.Team().SearchPrivate(context.Background(), term, &model.TeamSearchOpts{GroupConstrained: model.NewBool(true)})))
+	})
+}
+
+func testTeamStoreSearchAllPagedSort(t *testing.T, ss store.Store) {
+	term := "searchsort" + model.NewId()
+	saveTeam := func(displayName string, members int, lastPostAt int64) *model.Team {
+		team, err := ss.Team().Save(context.Background(), &model.Team{
+			DisplayName: term + displayName,
+			Name:        "zz" + model.NewId() + "a",
+			Email:       MakeEmail(),
+			Type:        model.TEAM_OPEN,
+		})
+		require.Nil(t, err)
+
+		for i := 0; i < members; i++ {
+			_, err = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: team.Id, UserId: makeUser(t, ss).Id}, -1)
+			require.Nil(t, err)
+		}
+
+		_, err = ss.Channel().Save(&model.Channel{
+			TeamId:      team.Id,
+			DisplayName: "Town Square",
+			Name:        "town-square",
+			Type:        model.CHANNEL_OPEN,
+			LastPostAt:  lastPostAt,
+		}, -1)
+		require.Nil(t, err)
+
+		return team
+	}
+
+	// Teams are created in order, so that their creation times increase.
+	b := saveTeam("b", 3, 100)
+	time.Sleep(2 * time.Millisecond)
+	c := saveTeam("c", 1, 300)
+	time.Sleep(2 * time.Millisecond)
+	a := saveTeam("a", 2, 200)
+
+	testCases := []struct {
+		Name        string
+		Opts        *model.TeamSearchOpts
+		ExpectedIds []string
+	}{
+		{"default", &model.TeamSearchOpts{}, []string{a.Id, b.Id, c.Id}},
+		{"display name descending", &model.TeamSearchOpts{Sort: model.TEAM_SEARCH_SORT_DISPLAY_NAME, SortDescending: true}, []string{c.Id, b.Id, a.Id}},
+		{"create at", &model.TeamSearchOpts{Sort: model.TEAM_SEARCH_SORT_CREATE_AT}, []string{b.Id, c.Id, a.Id}},
+		{"member count", &model.TeamSearchOpts{Sort: model.TEAM_SEARCH_SORT_MEMBER_COUNT}, []string{c.Id, a.Id, b.Id}},
+		{"member count descending", &model.TeamSearchOpts{Sort: model.TEAM_SEARCH_SORT_MEMBER_COUNT, SortDescending: true}, []string{b.Id, a.Id, c.Id}},
+		{"last activity descending", &model.TeamSearchOpts{Sort: model.TEAM_SEARCH_SORT_LAST_ACTIVITY, SortDescending: true}, []string{c.Id, a.Id, b.Id}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			teams, totalCount, err := ss.Team().SearchAllPaged(context.Background(), term, tc.Opts, 0, 10)
+			require.Nil(t, err)
+			assert.Equal(t, int64(3), totalCount)
+
+			ids := []string{}
+			for _, team := range teams {
+				ids = append(ids, team.Id)
+			}
+			assert.Equal(t, tc.ExpectedIds, ids)
+		})
+	}
+}
+
 func testTeamStoreGetByInviteId(t *testing.T, ss store.Store) {
 	o1 := model.Team{}
 	o1.DisplayName = "DisplayName"
@@ -541,7 +934,7 @@ func testTeamStoreGetByInviteId(t *testing.T, ss store.Store) {
 	o1.Type = model.TEAM_OPEN
 	o1.InviteId = model.NewId()
 
-	save1, err := ss.Team().Save(&o1)
+	save1, err := ss.Team().Save(context.Background(), &o1)
 	require.Nil(t, err)
 
 	o2 := model.Team{}
@@ -550,12 +943,61 @@ func testTeamStoreGetByInviteId(t *testing.T, ss store.Store) {
 	o2.Email = MakeEmail()
 	o2.Type = model.TEAM_OPEN
 
-	r1, err := ss.Team().GetByInviteId(save1.InviteId)
+	r1, err := ss.Team().GetByInviteId(context.Background(), save1.InviteId)
 	require.Nil(t, err)
 	require.Equal(t, *r1, o1, "invalid returned team")
 
-	_, err = ss.Team().GetByInviteId("")
+	_, err = ss.Team().GetByInviteId(context.Background(), "")
 	require.NotNil(t, err, "Missing id should have failed")
+	var nfErr *store.ErrNotFound
+	assert.True(t, errors.As(err, &nfErr))
+}
+
+func testTeamStoreRegenerateInviteId(t *testing.T, ss store.Store) {
+	team, err := ss.Team().Save(context.Background(), &model.Team{
+		DisplayName: "DisplayName",
+		Name:        "z-z-z" + model.NewId() + "b",
+		Email:       MakeEmail(),
+		Type:        model.TEAM_OPEN,
+	})
+	require.Nil(t, err)
+
+	t.Run("replaces the invite id", func(t *testing.T) {
+		updated, err := ss.Team().RegenerateInviteId(context.Background(), team.Id, 0)
+		require.Nil(t, err)
+		assert.NotEqual(t, team.InviteId, updated.InviteId)
+		assert.Greater(t, updated.UpdateAt, team.UpdateAt)
+
+		_, err = ss.Team().GetByInviteId(context.Background(), team.InviteId)
+		var nfErr *store.ErrNotFound
+		assert.True(t, errors.As(err, &nfErr))
+
+		found, err := ss.Team().GetByInviteId(context.Background(), updated.InviteId)
+		require.Nil(t, err)
+		assert.Equal(t, team.Id, found.Id)
+		team = updated
+	})
+
+	t.Run("expires the invite id", func(t *testing.T) {
+		updated, err := ss.Team().RegenerateInviteId(context.Background(), team.Id, model.GetMillis()+60*1000)
+		require.Nil(t, err)
+
+		_, err = ss.Team().GetByInviteId(context.Background(), updated.InviteId)
+		require.Nil(t, err)
+
+		updated, err = ss.Team().RegenerateInviteId(context.Background(), team.Id, model.GetMillis()-1)
+		require.Nil(t, err)
+
+		_, err = ss.Team().GetByInviteId(context.Background(), updated.InviteId)
+		var nfErr *store.ErrNotFound
+		assert.True(t, errors.As(err, &nfErr))
+	})
+
+	t.Run("fails for a missing team", func(t *testing.T) {
+		_, err := ss.Team().RegenerateInviteId(context.Background(), model.NewId(), 0)
+		var nfErr *store.ErrNotFound
+		assert.True(t, errors.As(err, &nfErr))
+	})
 }
 
 func testTeamStoreByUserId(t *testing.T, ss store.Store) {
@@ -565,17 +1007,71 @@ func testTeamStoreByUserId(t *testing.T, ss store.Store) {
 	o1.Email = MakeEmail()
 	o1.Type = model.TEAM_OPEN
 	o1.InviteId = model.NewId()
-	o1, err := ss.Team().Save(o1)
+	o1, err := ss.Team().Save(context.Background(), o1)
 	require.Nil(t, err)
 
-	m1 := &model.TeamMember{TeamId: o1.Id, UserId: model.NewId()}
-	_, err = ss.Team().SaveMember(m1, -1)
-	require.Nil(t, err)
+	m1 := &model.TeamMember{TeamId: o1.Id, UserId: makeUser(t, ss).Id}
+	_, nErr := ss.Team().SaveMember(context.Background(), m1, -1)
+	require.Nil(t, nErr)
 
-	teams, err := ss.Team().GetTeamsByUserId(m1.UserId)
+	teams, err := ss.Team().GetTeamsByUserId(context.Background(), m1.UserId, nil)
 	require.Nil(t, err)
 	require.Len(t, teams, 1, "Should return a team")
 	require.Equal(t, teams[0].Id, o1.Id, "should be a member")
+
+	o2 := &model.Team{
+		DisplayName: "A DisplayName",
+		Name:        "a" + model.NewId(),
+		Email:       MakeEmail(),
+		Type:        model.TEAM_OPEN,
+	}
+	o2, err = ss.Team().Save(context.Background(), o2)
+	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: o2.Id, UserId: m1.UserId}, -1)
+	require.Nil(t, nErr)
+
+	o3 := &model.Team{
+		DisplayName: "B DisplayName",
+		Name:        "b" + model.NewId(),
+		Email:       MakeEmail(),
+		Type:        model.TEAM_OPEN,
+		DeleteAt:    model.GetMillis(),
+	}
+	o3, err = ss.Team().Save(context.Background(), o3)
+	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: o3.Id, UserId: m1.UserId}, -1)
+	require.Nil(t, nErr)
+
+	t.Run("should sort by display name by default", func(t *testing.T) {
+		teams, err := ss.Team().GetTeamsByUserId(context.Background(), m1.UserId, &model.TeamsForUserGetOptions{})
+		require.Nil(t, err)
+		require.Len(t, teams, 2)
+		assert.Equal(t, o2.Id, teams[0].Id)
+		assert.Equal(t, o1.Id, teams[1].Id)
+	})
+
+	t.Run("should sort by name", func(t *testing.T) {
+		teams, err := ss.Team().GetTeamsByUserId(context.Background(), m1.UserId, &model.TeamsForUserGetOptions{Sort: model.TEAMS_FOR_USER_SORT_NAME, IncludeDeleted: true})
+		require.Nil(t, err)
+		require.Len(t, teams, 3)
+		assert.Equal(t, o2.Id, teams[0].Id)
+		assert.Equal(t, o3.Id, teams[1].Id)
+		assert.Equal(t, o1.Id, teams[2].Id)
+	})
+
+	t.Run("should include deleted teams", func(t *testing.T) {
+		teams, err := ss.Team().GetTeamsByUserId(context.Background(), m1.UserId, &model.TeamsForUserGetOptions{IncludeDeleted: true})
+		require.Nil(t, err)
+		require.Len(t, teams, 3)
+		assert.Equal(t, o3.Id, teams[1].Id)
+	})
+
+	t.Run("should page through teams", func(t *testing.T) {
+		teams, err := ss.Team().GetTeamsByUserId(context.Background(), m1.UserId, &model.TeamsForUserGetOptions{IncludeDeleted: true, Page: 1, PerPage: 2})
+		require.Nil(t, err)
+		require.Len(t, teams, 1)
+		assert.Equal(t, o1.Id, teams[0].Id)
+	})
 }
 
 func testGetAllTeamListing(t *testing.T, ss store.Store) {
@@ -585,7 +1081,7 @@ func testGetAllTeamListing(t *testing.T, ss store.Store) {
 	o1.Email = MakeEmail()
 	o1.Type = model.TEAM_OPEN
 	o1.AllowOpenInvite = true
-	_, err := ss.Team().Save(&o1)
+	_, err := ss.Team().Save(context.Background(), &o1)
 	require.Nil(t, err)
 
 	o2 := model.Team{}
@@ -593,7 +1089,7 @@ func testGetAllTeamListing(t *testing.T, ss store.Store) {
 	o2.Name = "zz" + model.NewId() + "b"
 	o2.Email = MakeEmail()
 	o2.Type = model.TEAM_OPEN
-	_, err = ss.Team().Save(&o2)
+	_, err = ss.Team().Save(context.Background(), &o2)
 	require.Nil(t, err)
 
 	o3 := model.Team{}
@@ -602,7 +1098,7 @@ func testGetAllTeamListing(t *testing.T, ss store.Store) {
 	o3.Email = MakeEmail()
 	o3.Type = model.TEAM_INVITE
 	o3.AllowOpenInvite = true
-	_, err = ss.Team().Save(&o3)
+	_, err = ss.Team().Save(context.Background(), &o3)
 	require.Nil(t, err)
 
 	o4 := model.Team{}
@@ -610,10 +1106,10 @@ func testGetAllTeamListing(t *testing.T, ss store.Store) {
 	o4.Name = "zz" + model.NewId() + "b"
 	o4.Email = MakeEmail()
 	o4.Type = model.TEAM_INVITE
-	_, err = ss.Team().Save(&o4)
+	_, err = ss.Team().Save(context.Background(), &o4)
 	require.Nil(t, err)
 
-	teams, err := ss.Team().GetAllTeamListing()
+	teams, err := ss.Team().GetAllTeamListing(context.Background())
 	require.Nil(t, err)
 	for _, team := range teams {
 		require.True(t, team.AllowOpenInvite, "should have returned team with AllowOpenInvite as true")
@@ -629,7 +1125,7 @@ func testGetAllTeamPageListing(t *testing.T, ss store.Store) {
 	o1.Email = MakeEmail()
 	o1.Type = model.TEAM_OPEN
 	o1.AllowOpenInvite = true
-	_, err := ss.Team().Save(&o1)
+	_, err := ss.Team().Save(context.Background(), &o1)
 	require.Nil(t, err)
 
 	o2 := model.Team{}
@@ -638,7 +1134,7 @@ func testGetAllTeamPageListing(t *testing.T, ss store.Store) {
 	o2.Email = MakeEmail()
 	o2.Type = model.TEAM_OPEN
 	o2.AllowOpenInvite = false
-	_, err = ss.Team().Save(&o2)
+	_, err = ss.Team().Save(context.Background(), &o2)
 	require.Nil(t, err)
 
 	o3 := model.Team{}
@@ -647,7 +1143,7 @@ func testGetAllTeamPageListing(t *testing.T, ss store.Store) {
 	o3.Email = MakeEmail()
 	o3.Type = model.TEAM_INVITE
 	o3.AllowOpenInvite = true
-	_, err = ss.Team().Save(&o3)
+	_, err = ss.Team().Save(context.Background(), &o3)
 	require.Nil(t, err)
 
 	o4 := model.Team{}
@@ -656,10 +1152,10 @@ func testGetAllTeamPageListing(t *testing.T, ss store.Store) {
 	o4.Email = MakeEmail()
 	o4.Type = model.TEAM_INVITE
 	o4.AllowOpenInvite = false
-	_, err = ss.Team().Save(&o4)
+	_, err = ss.Team().Save(context.Background(), &o4)
 	require.Nil(t, err)
 
-	teams, err := ss.Team().GetAllTeamPageListing(0, 10)
+	teams, err := ss.Team().GetAllTeamPageListing(context.Background(), 0, 10)
 	require.Nil(t, err)
 
 	for _, team := range teams {
@@ -674,10 +1170,10 @@ func testGetAllTeamPageListing(t *testing.T, ss store.Store) {
 	o5.Email = MakeEmail()
 	o5.Type = model.TEAM_OPEN
 	o5.AllowOpenInvite = true
-	_, err = ss.Team().Save(&o5)
+	_, err = ss.Team().Save(context.Background(), &o5)
 	require.Nil(t, err)
 
-	teams, err = ss.Team().GetAllTeamPageListing(0, 4)
+	teams, err = ss.Team().GetAllTeamPageListing(context.Background(), 0, 4)
 	require.Nil(t, err)
 
 	for _, team := range teams {
@@ -686,7 +1182,7 @@ func testGetAllTeamPageListing(t *testing.T, ss store.Store) {
 
 	require.LessOrEqual(t, len(teams), 4, "should have returned max of 4 teams")
 
-	teams, err = ss.Team().GetAllTeamPageListing(1, 1)
+	teams, err = ss.Team().GetAllTeamPageListing(context.Background(), 1, 1)
 	require.Nil(t, err)
 
 	for _, team := range teams {
@@ -703,7 +1199,7 @@ func testGetAllPrivateTeamListing(t *testing.T, ss store.Store) {
 	o1.Email = MakeEmail()
 	o1.Type = model.TEAM_OPEN
 	o1.AllowOpenInvite = true
-	_, err := ss.Team().Save(&o1)
+	_, err := ss.Team().Save(context.Background(), &o1)
 	require.Nil(t, err)
 
 	o2 := model.Team{}
@@ -711,7 +1207,7 @@ func testGetAllPrivateTeamListing(t *testing.T, ss store.Store) {
 	o2.Name = "zz" + model.NewId() + "b"
 	o2.Email = MakeEmail()
 	o2.Type = model.TEAM_OPEN
-	_, err = ss.Team().Save(&o2)
+	_, err = ss.Team().Save(context.Background(), &o2)
 	require.Nil(t, err)
 
 	o3 := model.Team{}
@@ -720,7 +1216,7 @@ func testGetAllPrivateTeamListing(t *testing.T, ss store.Store) {
 	o3.Email = MakeEmail()
 	o3.Type = model.TEAM_INVITE
 	o3.AllowOpenInvite = true
-	_, err = ss.Team().Save(&o3)
+	_, err = ss.Team().Save(context.Background(), &o3)
 	require.Nil(t, err)
 
 	o4 := model.Team{}
@@ -728,10 +1224,10 @@ func testGetAllPrivateTeamListing(t *testing.T, ss store.Store) {
 	o4.Name = "zz" + model.NewId() + "b"
 	o4.Email = MakeEmail()
 	o4.Type = model.TEAM_INVITE
-	_, err = ss.Team().Save(&o4)
+	_, err = ss.Team().Save(context.Background(), &o4)
 	require.Nil(t, err)
 
-	teams, err := ss.Team().GetAllPrivateTeamListing()
+	teams, err := ss.Team().GetAllPrivateTeamListing(context.Background())
 	require.Nil(t, err)
 	require.NotEmpty(t, teams, "failed team listing")
 
@@ -747,7 +1243,7 @@ func testGetAllPrivateTeamPageListing(t *testing.T, ss store.Store) {
 	o1.Email = MakeEmail()
 	o1.Type = model.TEAM_OPEN
 	o1.AllowOpenInvite = true
-	_, err := ss.Team().Save(&o1)
+	_, err := ss.Team().Save(context.Background(), &o1)
 	require.Nil(t, err)
 
 	o2 := model.Team{}
@@ -756,7 +1252,7 @@ func testGetAllPrivateTeamPageListing(t *testing.T, ss store.Store) {
 	o2.Email = MakeEmail()
 	o2.Type = model.TEAM_OPEN
 	o2.AllowOpenInvite = false
-	_, err = ss.Team().Save(&o2)
+	_, err = ss.Team().Save(context.Background(), &o2)
 	require.Nil(t, err)
 
 	o3 := model.Team{}
@@ -765,7 +1261,7 @@ func testGetAllPrivateTeamPageListing(t *testing.T, ss store.Store) {
 	o3.Email = MakeEmail()
 	o3.Type = model.TEAM_INVITE
 	o3.AllowOpenInvite = true
-	_, err = ss.Team().Save(&o3)
+	_, err = ss.Team().Save(context.Background(), &o3)
 	require.Nil(t, err)
 
 	o4 := model.Team{}
@@ -774,10 +1270,10 @@ func testGetAllPrivateTeamPageListing(t *testing.T, ss store.Store) {
 	o4.Email = MakeEmail()
 	o4.Type = model.TEAM_INVITE
 	o4.AllowOpenInvite = false
-	_, err = ss.Team().Save(&o4)
+	_, err = ss.Team().Save(context.Background(), &o4)
 	require.Nil(t, err)
 
-	teams, listErr := ss.Team().GetAllPrivateTeamPageListing(0, 10)
+	teams, listErr := ss.Team().GetAllPrivateTeamPageListing(context.Background(), 0, 10)
 	require.Nil(t, listErr)
 	for _, team := range teams {
 		require.False(t, team.AllowOpenInvite, "should have returned team with AllowOpenInvite as false")
@@ -791,10 +1287,10 @@ func testGetAllPrivateTeamPageListing(t *testing.T, ss store.Store) {
 	o5.Email = MakeEmail()
 	o5.Type = model.TEAM_OPEN
 	o5.AllowOpenInvite = true
-	_, err = ss.Team().Save(&o5)
+	_, err = ss.Team().Save(context.Background(), &o5)
 	require.Nil(t, err)
 
-	teams, listErr = ss.Team().GetAllPrivateTeamPageListing(0, 4)
+	teams, listErr = ss.Team().GetAllPrivateTeamPageListing(context.Background(), 0, 4)
 	require.Nil(t, listErr)
 	for _, team := range teams {
 		require.False(t, team.AllowOpenInvite, "should have returned team with AllowOpenInvite as false")
@@ -802,7 +1298,7 @@ func testGetAllPrivateTeamPageListing(t *testing.T, ss store.Store) {
 
 	require.LessOrEqual(t, len(teams), 4, "should have returned max of 4 teams")
 
-	teams, listErr = ss.Team().GetAllPrivateTeamPageListing(1, 1)
+	teams, listErr = ss.Team().GetAllPrivateTeamPageListing(context.Background(), 1, 1)
 	require.Nil(t, listErr)
 	for _, team := range teams {
 		require.False(t, team.AllowOpenInvite, "should have returned team with AllowOpenInvite as false")
@@ -820,7 +1316,7 @@ func testGetAllPublicTeamPageListing(t *testing.T, ss store.Store) {
 	o1.Email = MakeEmail()
 	o1.Type = model.TEAM_OPEN
 	o1.AllowOpenInvite = true
-	t1, err := ss.Team().Save(&o1)
+	t1, err := ss.Team().Save(context.Background(), &o1)
 	require.Nil(t, err)
 
 	o2 := model.Team{}
@@ -829,7 +1325,7 @@ func testGetAllPublicTeamPageListing(t *testing.T, ss store.Store) {
 	o2.Email = MakeEmail()
 	o2.Type = model.TEAM_OPEN
 	o2.AllowOpenInvite = false
-	_, err = ss.Team().Save(&o2)
+	_, err = ss.Team().Save(context.Background(), &o2)
 	require.Nil(t, err)
 
 	o3 := model.Team{}
@@ -838,7 +1334,7 @@ func testGetAllPublicTeamPageListing(t *testing.T, ss store.Store) {
 	o3.Email = MakeEmail()
 	o3.Type = model.TEAM_INVITE
 	o3.AllowOpenInvite = true
-	t3, err := ss.Team().Save(&o3)
+	t3, err := ss.Team().Save(context.Background(), &o3)
 	require.Nil(t, err)
 
 	o4 := model.Team{}
@@ -847,10 +1343,10 @@ func testGetAllPublicTeamPageListing(t *testing.T, ss store.Store) {
 	o4.Email = MakeEmail()
 	o4.Type = model.TEAM_INVITE
 	o4.AllowOpenInvite = false
-	_, err = ss.Team().Save(&o4)
+	_, err = ss.Team().Save(context.Background(), &o4)
 	require.Nil(t, err)
 
-	teams, err := ss.Team().GetAllPublicTeamPageListing(0, 10)
+	teams, err := ss.Team().GetAllPublicTeamPageListing(context.Background(), 0, 10)
 	assert.Nil(t, err)
 	assert.Equal(t, []*model.Team{t1, t3}, teams)
 
@@ -860,14 +1356,14 @@ func testGetAllPublicTeamPageListing(t *testing.T, ss store.Store) {
 	o5.Email = MakeEmail()
 	o5.Type = model.TEAM_OPEN
 	o5.AllowOpenInvite = true
-	t5, err := ss.Team().Save(&o5)
+	t5, err := ss.Team().Save(context.Background(), &o5)
 	require.Nil(t, err)
 
-	teams, err = ss.Team().GetAllPublicTeamPageListing(0, 4)
+	teams, err = ss.Team().GetAllPublicTeamPageListing(context.Background(), 0, 4)
 	assert.Nil(t, err)
 	assert.Equal(t, []*model.Team{t1, t3, t5}, teams)
 
-	_, err = ss.Team().GetAllPublicTeamPageListing(1, 1)
+	_, err = ss.Team().GetAllPublicTeamPageListing(context.Background(), 1, 1)
 	assert.Nil(t, err)
 }
 
@@ -878,7 +1374,7 @@ func testDelete(t *testing.T, ss store.Store) {
 	o1.Email = MakeEmail()
 	o1.Type = model.TEAM_OPEN
 	o1.AllowOpenInvite = true
-	_, err := ss.Team().Save(&o1)
+	_, err := ss.Team().Save(context.Background(), &o1)
 	require.Nil(t, err)
 
 	o2 := model.Team{}
@@ -886,14 +1382,29 @@ func testDelete(t *testing.T, ss store.Store) {
 	o2.Name = "zz" + model.NewId() + "b"
 	o2.Email = MakeEmail()
 	o2.Type = model.TEAM_OPEN
-	_, err = ss.Team().Save(&o2)
+	_, err = ss.Team().Save(context.Background(), &o2)
 	require.Nil(t, err)
 
-	r1 := ss.Team().PermanentDelete(o1.Id)
+	user := makeUser(t, ss)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: o1.Id, UserId: user.Id}, -1)
+	require.Nil(t, nErr)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: o2.Id, UserId: user.Id}, -1)
+	require.Nil(t, nErr)
+
+	r1 := ss.Team().PermanentDelete(context.Background(), o1.Id)
 	require.Nil(t, r1)
+
+	t.Run("the members of the team are deleted with it", func(t *testing.T) {
+		_, nErr := ss.Team().GetMember(context.Background(), o1.Id, user.Id, false)
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(nErr, &nfErr))
+
+		_, nErr = ss.Team().GetMember(context.Background(), o2.Id, user.Id, false)
+		require.Nil(t, nErr)
+	})
 }
 
-func testPublicTeamCount(t *testing.T, ss store.Store) {
+func testGetAllDeletedPage(t *testing.T, ss store.Store) {
 	cleanupTeamStore(t, ss)
 
 	o1 := model.Team{}
@@ -901,95 +1412,213 @@ func testPublicTeamCount(t *testing.T, ss store.Store) {
 	o1.Name = "z-z-z" + model.NewId() + "b"
 	o1.Email = MakeEmail()
 	o1.Type = model.TEAM_OPEN
-	o1.AllowOpenInvite = true
-	_, err := ss.Team().Save(&o1)
+	_, err := ss.Team().Save(context.Background(), &o1)
 	require.Nil(t, err)
 
 	o2 := model.Team{}
 	o2.DisplayName = "DisplayName"
 	o2.Name = "z-z-z" + model.NewId() + "b"
 	o2.Email = MakeEmail()
-	o2.Type = model.TEAM_OPEN
-	o2.AllowOpenInvite = false
-	_, err = ss.Team().Save(&o2)
+	o2.Type = model.TEAM_INVITE
+	o2.DeleteAt = model.GetMillis()
+	_, err = ss.Team().Save(context.Background(), &o2)
 	require.Nil(t, err)
 
-	o3 := model.Team{}
-	o3.DisplayName = "DisplayName"
-	o3.Name = "z-z-z" + model.NewId() + "b"
-	o3.Email = MakeEmail()
-	o3.Type = model.TEAM_OPEN
-	o3.AllowOpenInvite = true
-	_, err = ss.Team().Save(&o3)
+	teams, err := ss.Team().GetAllDeletedPage(context.Background(), 0, 10)
 	require.Nil(t, err)
+	require.Len(t, teams, 1)
+	assert.Equal(t, o2.Id, teams[0].Id)
 
-	teamCount, err := ss.Team().AnalyticsPublicTeamCount()
+	teams, err = ss.Team().GetAllDeletedPage(context.Background(), 1, 10)
 	require.Nil(t, err)
-	require.Equal(t, int64(2), teamCount, "should only be 1 team")
+	assert.Empty(t, teams)
 }
 
-func testPrivateTeamCount(t *testing.T, ss store.Store) {
+func testGetTeamsWithNoActiveMembers(t *testing.T, ss store.Store) {
 	cleanupTeamStore(t, ss)
 
-	o1 := model.Team{}
-	o1.DisplayName = "DisplayName"
-	o1.Name = "z-z-z" + model.NewId() + "b"
-	o1.Email = MakeEmail()
-	o1.Type = model.TEAM_OPEN
-	o1.AllowOpenInvite = false
-	_, err := ss.Team().Save(&o1)
+	saveTeam := func(deleteAt int64) *model.Team {
+		team, err := ss.Team().Save(context.Background(), &model.Team{
+			DisplayName: "DisplayName",
+			Name:        "z-z-z" + model.NewId() + "b",
+			Email:       MakeEmail(),
+			Type:        model.TEAM_OPEN,
+			DeleteAt:    deleteAt,
+		})
+		require.Nil(t, err)
+		return team
+	}
+	saveUser := func(deleteAt int64) *model.User {
+		user, err := ss.User().Save(&model.User{
+			Email:    MakeEmail(),
+			Username: "u" + model.NewId(),
+			DeleteAt: deleteAt,
+		})
+		require.Nil(t, err)
+		return user
+	}
+
+	activeUser := saveUser(0)
+	defer ss.User().PermanentDelete(activeUser.Id)
+	deletedUser := saveUser(model.GetMillis())
+	defer ss.User().PermanentDelete(deletedUser.Id)
+
+	active := saveTeam(0)
+	_, err := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: active.Id, UserId: activeUser.Id}, -1)
+	require.Nil(t, err)
+	_, err = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: active.Id, UserId: deletedUser.Id}, -1)
 	require.Nil(t, err)
 
-	o2 := model.Team{}
-	o2.DisplayName = "DisplayName"
-	o2.Name = "z-z-z" + model.NewId() + "b"
-	o2.Email = MakeEmail()
-	o2.Type = model.TEAM_OPEN
-	o2.AllowOpenInvite = true
-	_, err = ss.Team().Save(&o2)
+	empty := saveTeam(0)
+
+	onlyDeletedUsers := saveTeam(0)
+	_, err = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: onlyDeletedUsers.Id, UserId: deletedUser.Id}, -1)
 	require.Nil(t, err)
 
-	o3 := model.Team{}
-	o3.DisplayName = "DisplayName"
-	o3.Name = "z-z-z" + model.NewId() + "b"
-	o3.Email = MakeEmail()
-	o3.Type = model.TEAM_OPEN
-	o3.AllowOpenInvite = false
-	_, err = ss.Team().Save(&o3)
+	onlyFormerMembers := saveTeam(0)
+	_, err = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: onlyFormerMembers.Id, UserId: activeUser.Id, DeleteAt: model.GetMillis()}, -1)
 	require.Nil(t, err)
 
-	teamCount, err := ss.Team().AnalyticsPrivateTeamCount()
+	saveTeam(model.GetMillis())
+
+	teams, err := ss.Team().GetTeamsWithNoActiveMembers(context.Background(), 0, 10)
 	require.Nil(t, err)
-	require.Equal(t, int64(2), teamCount, "should only be 1 team")
+	ids := []string{}
+	for _, team := range teams {
+		ids = append(ids, team.Id)
+	}
+	assert.ElementsMatch(t, []string{empty.Id, onlyDeletedUsers.Id, onlyFormerMembers.Id}, ids)
+
+	teams, err = ss.Team().GetTeamsWithNoActiveMembers(context.Background(), 0, 2)
+	require.Nil(t, err)
+	assert.Len(t, teams, 2)
+
+	teams, err = ss.Team().GetTeamsWithNoActiveMembers(context.Background(), 2, 2)
+	require.Nil(t, err)
+	assert.Len(t, teams, 1)
 }
 
-func testTeamCount(t *testing.T, ss store.Store) {
+func testTeamStoreRestore(t *testing.T, ss store.Store) {
 	o1 := model.Team{}
 	o1.DisplayName = "DisplayName"
 	o1.Name = "z-z-z" + model.NewId() + "b"
 	o1.Email = MakeEmail()
 	o1.Type = model.TEAM_OPEN
-	o1.AllowOpenInvite = true
-	team, err := ss.Team().Save(&o1)
+	o1.DeleteAt = model.GetMillis()
+	_, err := ss.Team().Save(context.Background(), &o1)
 	require.Nil(t, err)
 
-	// not including deleted teams
-	teamCount, err := ss.Team().AnalyticsTeamCount(false)
+	err = ss.Team().Restore(context.Background(), o1.Id)
 	require.Nil(t, err)
-	require.NotEqual(t, 0, int(teamCount), "should be at least 1 team")
 
-	// delete the team for the next check
-	team.DeleteAt = model.GetMillis()
-	_, err = ss.Team().Update(team)
+	team, err := ss.Team().Get(context.Background(), o1.Id)
 	require.Nil(t, err)
+	assert.Zero(t, team.DeleteAt)
+	assert.GreaterOrEqual(t, team.UpdateAt, o1.UpdateAt)
 
-	// get the count of teams not including deleted
-	countNotIncludingDeleted, err := ss.Team().AnalyticsTeamCount(false)
-	require.Nil(t, err)
+	err = ss.Team().Restore(context.Background(), model.NewId())
+	require.NotNil(t, err)
+	var nfErr *store.ErrNotFound
+	assert.True(t, errors.As(err, &nfErr))
+}
 
-	// get the count of teams including deleted
-	countIncludingDeleted, err := ss.Team().AnalyticsTeamCount(true)
-	require.Nil(t, err)
+func testPublicTeamCount(t *testing.T, ss store.Store) {
+	cleanupTeamStore(t, ss)
+
+	o1 := model.Team{}
+	o1.DisplayName = "DisplayName"
+	o1.Name = "z-z-z" + model.NewId() + "b"
+	o1.Email = MakeEmail()
+	o1.Type = model.TEAM_OPEN
+	o1.AllowOpenInvite = true
+	_, err := ss.Team().Save(context.Background(), &o1)
+	require.Nil(t, err)
+
+	o2 := model.Team{}
+	o2.DisplayName = "DisplayName"
+	o2.Name = "z-z-z" + model.NewId() + "b"
+	o2.Email = MakeEmail()
+	o2.Type = model.TEAM_OPEN
+	o2.AllowOpenInvite = false
+	_, err = ss.Team().Save(context.Background(), &o2)
+	require.Nil(t, err)
+
+	o3 := model.Team{}
+	o3.DisplayName = "DisplayName"
+	o3.Name = "z-z-z" + model.NewId() + "b"
+	o3.Email = MakeEmail()
+	o3.Type = model.TEAM_OPEN
+	o3.AllowOpenInvite = true
+	_, err = ss.Team().Save(context.Background(), &o3)
+	require.Nil(t, err)
+
+	teamCount, err := ss.Team().AnalyticsPublicTeamCount(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, int64(2), teamCount, "should only be 1 team")
+}
+
+func testPrivateTeamCount(t *testing.T, ss store.Store) {
+	cleanupTeamStore(t, ss)
+
+	o1 := model.Team{}
+	o1.DisplayName = "DisplayName"
+	o1.Name = "z-z-z" + model.NewId() + "b"
+	o1.Email = MakeEmail()
+	o1.Type = model.TEAM_OPEN
+	o1.AllowOpenInvite = false
+	_, err := ss.Team().Save(context.Background(), &o1)
+	require.Nil(t, err)
+
+	o2 := model.Team{}
+	o2.DisplayName = "DisplayName"
+	o2.Name = "z-z-z" + model.NewId() + "b"
+	o2.Email = MakeEmail()
+	o2.Type = model.TEAM_OPEN
+	o2.AllowOpenInvite = true
+	_, err = ss.Team().Save(context.Background(), &o2)
+	require.Nil(t, err)
+
+	o3 := model.Team{}
+	o3.DisplayName = "DisplayName"
+	o3.Name = "z-z-z" + model.NewId() + "b"
+	o3.Email = MakeEmail()
+	o3.Type = model.TEAM_OPEN
+	o3.AllowOpenInvite = false
+	_, err = ss.Team().Save(context.Background(), &o3)
+	require.Nil(t, err)
+
+	teamCount, err := ss.Team().AnalyticsPrivateTeamCount(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, int64(2), teamCount, "should only be 1 team")
+}
+
+func testTeamCount(t *testing.T, ss store.Store) {
+	o1 := model.Team{}
+	o1.DisplayName = "DisplayName"
+	o1.Name = "z-z-z" + model.NewId() + "b"
+	o1.Email = MakeEmail()
+	o1.Type = model.TEAM_OPEN
+	o1.AllowOpenInvite = true
+	team, err := ss.Team().Save(context.Background(), &o1)
+	require.Nil(t, err)
+
+	// not including deleted teams
+	teamCount, err := ss.Team().AnalyticsTeamCount(context.Background(), false)
+	require.Nil(t, err)
+	require.NotEqual(t, 0, int(teamCount), "should be at least 1 team")
+
+	// delete the team for the next check
+	team.DeleteAt = model.GetMillis()
+	_, err = ss.Team().Update(context.Background(), team)
+	require.Nil(t, err)
+
+	// get the count of teams not including deleted
+	countNotIncludingDeleted, err := ss.Team().AnalyticsTeamCount(context.Background(), false)
+	require.Nil(t, err)
+
+	// get the count of teams including deleted
+	countIncludingDeleted, err := ss.Team().AnalyticsTeamCount(context.Background(), true)
+	require.Nil(t, err)
 
 	// count including deleted should be one greater than not including deleted
 	require.Equal(t, countNotIncludingDeleted+1, countIncludingDeleted)
@@ -998,33 +1627,34 @@ func testTeamCount(t *testing.T, ss store.Store) {
 func testGetMembers(t *testing.T, ss store.Store) {
 	// Each user should have a mention count of exactly 1 in the DB at this point.
 	t.Run("Test GetMembers Order By UserID", func(t *testing.T) {
-		teamId1 := model.NewId()
-		teamId2 := model.NewId()
-
-		m1 := &model.TeamMember{TeamId: teamId1, UserId: "55555555555555555555555555"}
-		m2 := &model.TeamMember{TeamId: teamId1, UserId: "11111111111111111111111111"}
-		m3 := &model.TeamMember{TeamId: teamId1, UserId: "33333333333333333333333333"}
-		m4 := &model.TeamMember{TeamId: teamId1, UserId: "22222222222222222222222222"}
-		m5 := &model.TeamMember{TeamId: teamId1, UserId: "44444444444444444444444444"}
-		m6 := &model.TeamMember{TeamId: teamId2, UserId: "00000000000000000000000000"}
+		teamId1 := makeTeam(t, ss).Id
+		teamId2 := makeTeam(t, ss).Id
+
+		members := []*model.TeamMember{}
+		userIds := []string{}
+		for i := 0; i < 5; i++ {
+			userId := makeUser(t, ss).Id
+			members = append(members, &model.TeamMember{TeamId: teamId1, UserId: userId})
+			userIds = append(userIds, userId)
+		}
+		members = append(members, &model.TeamMember{TeamId: teamId2, UserId: makeUser(t, ss).Id})
 
-		_, err := ss.Team().SaveMultipleMembers([]*model.TeamMember{m1, m2, m3, m4, m5, m6}, -1)
+		_, err := ss.Team().SaveMultipleMembers(context.Background(), members, -1)
 		require.Nil(t, err)
 
 		// Gets users ordered by UserId
-		ms, err := ss.Team().GetMembers(teamId1, 0, 100, nil)
+		ms, err := ss.Team().GetMembers(context.Background(), teamId1, 0, 100, nil)
 		require.Nil(t, err)
-		assert.Len(t, ms, 5)
-		assert.Equal(t, "11111111111111111111111111", ms[0].UserId)
-		assert.Equal(t, "22222222222222222222222222", ms[1].UserId)
-		assert.Equal(t, "33333333333333333333333333", ms[2].UserId)
-		assert.Equal(t, "44444444444444444444444444", ms[3].UserId)
-		assert.Equal(t, "55555555555555555555555555", ms[4].UserId)
+		require.Len(t, ms, 5)
+		sort.Strings(userIds)
+		for i, userId := range userIds {
+			assert.Equal(t, userId, ms[i].UserId)
+		}
 	})
 
 	t.Run("Test GetMembers Order By Username And Exclude Deleted Members", func(t *testing.T) {
-		teamId1 := model.NewId()
-		teamId2 := model.NewId()
+		teamId1 := makeTeam(t, ss).Id
+		teamId2 := makeTeam(t, ss).Id
 
 		u1 := &model.User{Username: "a", Email: MakeEmail(), DeleteAt: int64(1)}
 		u2 := &model.User{Username: "c", Email: MakeEmail()}
@@ -1053,11 +1683,11 @@ func testGetMembers(t *testing.T, ss store.Store) {
 		m5 := &model.TeamMember{TeamId: teamId1, UserId: u5.Id}
 		m6 := &model.TeamMember{TeamId: teamId2, UserId: u6.Id}
 
-		_, err = ss.Team().SaveMultipleMembers([]*model.TeamMember{m1, m2, m3, m4, m5, m6}, -1)
-		require.Nil(t, err)
+		_, nErr := ss.Team().SaveMultipleMembers(context.Background(), []*model.TeamMember{m1, m2, m3, m4, m5, m6}, -1)
+		require.Nil(t, nErr)
 
 		// Gets users ordered by UserName
-		ms, err := ss.Team().GetMembers(teamId1, 0, 100, &model.TeamMembersGetOptions{Sort: model.USERNAME})
+		ms, err := ss.Team().GetMembers(context.Background(), teamId1, 0, 100, &model.TeamMembersGetOptions{Sort: model.USERNAME})
 		require.Nil(t, err)
 		assert.Len(t, ms, 5)
 		assert.Equal(t, u1.Id, ms[0].UserId)
@@ -1067,16 +1697,46 @@ func testGetMembers(t *testing.T, ss store.Store) {
 		assert.Equal(t, u4.Id, ms[4].UserId)
 
 		// Gets users ordered by UserName and excludes deleted members
-		ms, err = ss.Team().GetMembers(teamId1, 0, 100, &model.TeamMembersGetOptions{Sort: model.USERNAME, ExcludeDeletedUsers: true})
+		ms, err = ss.Team().GetMembers(context.Background(), teamId1, 0, 100, &model.TeamMembersGetOptions{Sort: model.USERNAME, ExcludeDeletedUsers: true})
 		require.Nil(t, err)
 		assert.Len(t, ms, 2)
 		assert.Equal(t, u2.Id, ms[0].UserId)
 		assert.Equal(t, u4.Id, ms[1].UserId)
 	})
 
+	t.Run("Test GetMembers Order By Last Activity", func(t *testing.T) {
+		teamId := makeTeam(t, ss).Id
+
+		u1 := makeUser(t, ss)
+		u2 := makeUser(t, ss)
+		u3 := makeUser(t, ss)
+
+		_, nErr := ss.Team().SaveMultipleMembers(context.Background(), []*model.TeamMember{
+			{TeamId: teamId, UserId: u1.Id},
+			{TeamId: teamId, UserId: u2.Id},
+			{TeamId: teamId, UserId: u3.Id},
+		}, -1)
+		require.Nil(t, nErr)
+
+		require.Nil(t, ss.Status().SaveOrUpdate(&model.Status{UserId: u1.Id, Status: model.STATUS_OFFLINE, LastActivityAt: 1000}))
+		require.Nil(t, ss.Status().SaveOrUpdate(&model.Status{UserId: u3.Id, Status: model.STATUS_ONLINE, LastActivityAt: 2000}))
+
+		ms, err := ss.Team().GetMembers(context.Background(), teamId, 0, 100, &model.TeamMembersGetOptions{Sort: model.TEAM_MEMBERS_SORT_LAST_ACTIVITY})
+		require.Nil(t, err)
+		require.Len(t, ms, 3)
+		assert.Equal(t, u3.Id, ms[0].UserId)
+		assert.Equal(t, u1.Id, ms[1].UserId)
+		assert.Equal(t, u2.Id, ms[2].UserId)
+
+		ms, err = ss.Team().GetMembers(context.Background(), teamId, 1, 1, &model.TeamMembersGetOptions{Sort: model.TEAM_MEMBERS_SORT_LAST_ACTIVITY})
+		require.Nil(t, err)
+		require.Len(t, ms, 1)
+		assert.Equal(t, u1.Id, ms[0].UserId)
+	})
+
 	t.Run("Test GetMembers Excluded Deleted Users", func(t *testing.T) {
-		teamId1 := model.NewId()
-		teamId2 := model.NewId()
+		teamId1 := makeTeam(t, ss).Id
+		teamId2 := makeTeam(t, ss).Id
 
 		u1 := &model.User{Email: MakeEmail()}
 		u2 := &model.User{Email: MakeEmail(), DeleteAt: int64(1)}
@@ -1105,84 +1765,164 @@ func testGetMembers(t *testing.T, ss store.Store) {
 		m5 := &model.TeamMember{TeamId: teamId1, UserId: u5.Id}
 		m6 := &model.TeamMember{TeamId: teamId2, UserId: u6.Id}
 
-		t1, err := ss.Team().SaveMember(m1, -1)
+		t1, nErr := ss.Team().SaveMember(context.Background(), m1, -1)
+		require.Nil(t, nErr)
+		_, nErr = ss.Team().SaveMember(context.Background(), m2, -1)
+		require.Nil(t, nErr)
+		t3, nErr := ss.Team().SaveMember(context.Background(), m3, -1)
+		require.Nil(t, nErr)
+		_, nErr = ss.Team().SaveMember(context.Background(), m4, -1)
+		require.Nil(t, nErr)
+		t5, nErr := ss.Team().SaveMember(context.Background(), m5, -1)
+		require.Nil(t, nErr)
+		_, nErr = ss.Team().SaveMember(context.Background(), m6, -1)
+		require.Nil(t, nErr)
+
+		// Gets users ordered by UserName
+		ms, err := ss.Team().GetMembers(context.Background(), teamId1, 0, 100, &model.TeamMembersGetOptions{ExcludeDeletedUsers: true})
+		require.Nil(t, err)
+		assert.Len(t, ms, 3)
+		require.ElementsMatch(t, ms, [3]*model.TeamMember{t1, t3, t5})
+	})
+
+	t.Run("Filter by roles", func(t *testing.T) {
+		teamId := makeTeam(t, ss).Id
+
+		admin, err := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: makeUser(t, ss).Id, SchemeUser: true, SchemeAdmin: true}, -1)
 		require.Nil(t, err)
-		_, err = ss.Team().SaveMember(m2, -1)
+		user, err := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: makeUser(t, ss).Id, SchemeUser: true}, -1)
 		require.Nil(t, err)
-		t3, err := ss.Team().SaveMember(m3, -1)
+		guest, err := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: makeUser(t, ss).Id, SchemeGuest: true}, -1)
 		require.Nil(t, err)
-		_, err = ss.Team().SaveMember(m4, -1)
+
+		ms, err := ss.Team().GetMembers(context.Background(), teamId, 0, 100, &model.TeamMembersGetOptions{Roles: []string{model.TEAM_ADMIN_ROLE_ID}})
 		require.Nil(t, err)
-		t5, err := ss.Team().SaveMember(m5, -1)
+		require.Len(t, ms, 1)
+		assert.Equal(t, admin.UserId, ms[0].UserId)
+
+		ms, err = ss.Team().GetMembers(context.Background(), teamId, 0, 100, &model.TeamMembersGetOptions{Roles: []string{model.TEAM_USER_ROLE_ID}})
 		require.Nil(t, err)
-		_, err = ss.Team().SaveMember(m6, -1)
+		require.Len(t, ms, 1)
+		assert.Equal(t, user.UserId, ms[0].UserId)
+
+		ms, err = ss.Team().GetMembers(context.Background(), teamId, 0, 100, &model.TeamMembersGetOptions{Roles: []string{model.TEAM_ADMIN_ROLE_ID, model.TEAM_GUEST_ROLE_ID}})
 		require.Nil(t, err)
+		require.Len(t, ms, 2)
+		assert.ElementsMatch(t, []string{admin.UserId, guest.UserId}, []string{ms[0].UserId, ms[1].UserId})
 
-		// Gets users ordered by UserName
-		ms, err := ss.Team().GetMembers(teamId1, 0, 100, &model.TeamMembersGetOptions{ExcludeDeletedUsers: true})
+		ms, err = ss.Team().GetMembers(context.Background(), teamId, 0, 100, &model.TeamMembersGetOptions{Roles: []string{"custom_role"}})
 		require.Nil(t, err)
-		assert.Len(t, ms, 3)
-		require.ElementsMatch(t, ms, [3]*model.TeamMember{t1, t3, t5})
+		assert.Empty(t, ms)
 	})
 }
 
+func testTeamStoreGetMembersWithExpiredRoles(t *testing.T, ss store.Store) {
+	teamId := makeTeam(t, ss).Id
+	now := model.GetMillis()
+
+	expired := &model.TeamMember{TeamId: teamId, UserId: makeUser(t, ss).Id, SchemeUser: true, ExplicitRoles: "test", ExplicitRolesExpiresAt: now - 1000}
+	expiring := &model.TeamMember{TeamId: teamId, UserId: makeUser(t, ss).Id, SchemeUser: true, ExplicitRoles: "test", ExplicitRolesExpiresAt: now + 60*60*1000}
+	permanent := &model.TeamMember{TeamId: teamId, UserId: makeUser(t, ss).Id, SchemeUser: true, ExplicitRoles: "test"}
+	_, err := ss.Team().SaveMultipleMembers(context.Background(), []*model.TeamMember{expired, expiring, permanent}, -1)
+	require.Nil(t, err)
+
+	memberUserIds := func(members []*model.TeamMember) []string {
+		var userIds []string
+		for _, member := range members {
+			if member.TeamId == teamId {
+				userIds = append(userIds, member.UserId)
+			}
+		}
+		return userIds
+	}
+
+	members, err := ss.Team().GetMembersWithExpiredRoles(context.Background(), now, 100)
+	require.NoError(t, err)
+	require.Equal(t, []string{expired.UserId}, memberUserIds(members))
+	for _, member := range members {
+		if member.UserId == expired.UserId {
+			assert.Equal(t, "test", member.ExplicitRoles)
+			assert.Equal(t, expired.ExplicitRolesExpiresAt, member.ExplicitRolesExpiresAt)
+			assert.Equal(t, []string{model.TEAM_USER_ROLE_ID}, member.GetRoles())
+		}
+	}
+
+	members, err = ss.Team().GetMembersWithExpiredRoles(context.Background(), now+2*60*60*1000, 100)
+	require.NoError(t, err)
+	require.Equal(t, []string{expired.UserId, expiring.UserId}, memberUserIds(members))
+
+	expired.ExplicitRoles = ""
+	expired.ExplicitRolesExpiresAt = 0
+	_, err = ss.Team().UpdateMember(context.Background(), expired)
+	require.Nil(t, err)
+
+	members, err = ss.Team().GetMembersWithExpiredRoles(context.Background(), now, 100)
+	require.NoError(t, err)
+	require.Empty(t, memberUserIds(members))
+}
+
 func testTeamMembers(t *testing.T, ss store.Store) {
-	teamId1 := model.NewId()
-	teamId2 := model.NewId()
+	teamId1 := makeTeam(t, ss).Id
+	teamId2 := makeTeam(t, ss).Id
 
-	m1 := &model.TeamMember{TeamId: teamId1, UserId: model.NewId()}
-	m2 := &model.TeamMember{TeamId: teamId1, UserId: model.NewId()}
-	m3 := &model.TeamMember{TeamId: teamId2, UserId: model.NewId()}
+	m1 := &model.TeamMember{TeamId: teamId1, UserId: makeUser(t, ss).Id}
+	m2 := &model.TeamMember{TeamId: teamId1, UserId: makeUser(t, ss).Id}
+	m3 := &model.TeamMember{TeamId: teamId2, UserId: makeUser(t, ss).Id}
 
-	_, err := ss.Team().SaveMultipleMembers([]*model.TeamMember{m1, m2, m3}, -1)
+	_, err := ss.Team().SaveMultipleMembers(context.Background(), []*model.TeamMember{m1, m2, m3}, -1)
 	require.Nil(t, err)
 
-	ms, err := ss.Team().GetMembers(teamId1, 0, 100, nil)
+	ms, err := ss.Team().GetMembers(context.Background(), teamId1, 0, 100, nil)
 	require.Nil(t, err)
 	assert.Len(t, ms, 2)
 
-	ms, err = ss.Team().GetMembers(teamId2, 0, 100, nil)
+	ms, err = ss.Team().GetMembers(context.Background(), teamId2, 0, 100, nil)
 	require.Nil(t, err)
 	require.Len(t, ms, 1)
 	require.Equal(t, m3.UserId, ms[0].UserId)
 
-	ms, err = ss.Team().GetTeamsForUser(m1.UserId)
+	ms, err = ss.Team().GetTeamsForUser(context.Background(), m1.UserId)
 	require.Nil(t, err)
 	require.Len(t, ms, 1)
 	require.Equal(t, m1.TeamId, ms[0].TeamId)
 
-	err = ss.Team().RemoveMember(teamId1, m1.UserId)
+	err = ss.Team().RemoveMember(context.Background(), teamId1, m1.UserId)
 	require.Nil(t, err)
 
-	ms, err = ss.Team().GetMembers(teamId1, 0, 100, nil)
+	ms, err = ss.Team().GetMembers(context.Background(), teamId1, 0, 100, nil)
 	require.Nil(t, err)
 	require.Len(t, ms, 1)
 	require.Equal(t, m2.UserId, ms[0].UserId)
 
-	_, err = ss.Team().SaveMember(m1, -1)
+	_, err = ss.Team().SaveMember(context.Background(), m1, -1)
 	require.Nil(t, err)
 
-	err = ss.Team().RemoveAllMembersByTeam(teamId1)
+	err = ss.Team().RemoveAllMembersByTeam(context.Background(), teamId1)
 	require.Nil(t, err)
 
-	ms, err = ss.Team().GetMembers(teamId1, 0, 100, nil)
+	ms, err = ss.Team().GetMembers(context.Background(), teamId1, 0, 100, nil)
 	require.Nil(t, err)
 	require.Empty(t, ms)
 
-	uid := model.NewId()
+	uid := makeUser(t, ss).Id
 	m4 := &model.TeamMember{TeamId: teamId1, UserId: uid}
 	m5 := &model.TeamMember{TeamId: teamId2, UserId: uid}
-	_, err = ss.Team().SaveMultipleMembers([]*model.TeamMember{m4, m5}, -1)
+	_, err = ss.Team().SaveMultipleMembers(context.Background(), []*model.TeamMember{m4, m5}, -1)
 	require.Nil(t, err)
 
-	ms, err = ss.Team().GetTeamsForUser(uid)
+	ms, err = ss.Team().GetTeamsForUser(context.Background(), uid)
 	require.Nil(t, err)
 	require.Len(t, ms, 2)
 
-	err = ss.Team().RemoveAllMembersByUser(uid)
+	teamIds, err := ss.Team().RemoveAllMembersByUser(context.Background(), uid)
+	require.Nil(t, err)
+	assert.ElementsMatch(t, []string{teamId1, teamId2}, teamIds)
+
+	teamIds, err = ss.Team().RemoveAllMembersByUser(context.Background(), uid)
 	require.Nil(t, err)
+	assert.Empty(t, teamIds)
 
-	ms, err = ss.Team().GetTeamsForUser(m1.UserId)
+	ms, err = ss.Team().GetTeamsForUser(context.Background(), m1.UserId)
 	require.Nil(t, err)
 	require.Empty(t, ms)
 }
@@ -1195,38 +1935,76 @@ func testTeamSaveMember(t *testing.T, ss store.Store) {
 
 	t.Run("not valid team member", func(t *testing.T) {
 		member := &model.TeamMember{TeamId: "wrong", UserId: u1.Id}
-		_, err = ss.Team().SaveMember(member, -1)
-		require.NotNil(t, err)
-		require.Equal(t, "model.team_member.is_valid.team_id.app_error", err.Id)
+		_, nErr := ss.Team().SaveMember(context.Background(), member, -1)
+		require.NotNil(t, nErr)
+		var appErr *model.AppError
+		require.True(t, errors.As(nErr, &appErr))
+		require.Equal(t, "model.team_member.is_valid.team_id.app_error", appErr.Id)
 	})
 
 	t.Run("too many members", func(t *testing.T) {
 		member := &model.TeamMember{TeamId: model.NewId(), UserId: u1.Id}
-		_, err = ss.Team().SaveMember(member, 0)
-		require.NotNil(t, err)
-		require.Equal(t, "store.sql_user.save.max_accounts.app_error", err.Id)
+		_, nErr := ss.Team().SaveMember(context.Background(), member, 0)
+		require.NotNil(t, nErr)
+		var limitErr *store.ErrLimitExceeded
+		require.True(t, errors.As(nErr, &limitErr))
 	})
 
 	t.Run("too many members because previous existing members", func(t *testing.T) {
-		teamID := model.NewId()
+		teamID := makeTeam(t, ss).Id
 
 		m1 := &model.TeamMember{TeamId: teamID, UserId: u1.Id}
-		_, err = ss.Team().SaveMember(m1, 1)
+		_, nErr := ss.Team().SaveMember(context.Background(), m1, 1)
+		require.Nil(t, nErr)
 		m2 := &model.TeamMember{TeamId: teamID, UserId: u2.Id}
-		_, err = ss.Team().SaveMember(m2, 1)
-		require.NotNil(t, err)
-		require.Equal(t, "store.sql_user.save.max_accounts.app_error", err.Id)
+		_, nErr = ss.Team().SaveMember(context.Background(), m2, 1)
+		require.NotNil(t, nErr)
+		var limitErr *store.ErrLimitExceeded
+		require.True(t, errors.As(nErr, &limitErr))
+	})
+
+	t.Run("concurrent members shouldn't exceed the limit", func(t *testing.T) {
+		team, err := ss.Team().Save(context.Background(), &model.Team{
+			DisplayName: "Name",
+			Name:        "zz" + model.NewId(),
+			Email:       MakeEmail(),
+			Type:        model.TEAM_OPEN,
+		})
+		require.Nil(t, err)
+
+		const maxUsersPerTeam = 3
+		var wg sync.WaitGroup
+		var saved int32
+		for i := 0; i < 10; i++ {
+			user, err := ss.User().Save(&model.User{Username: model.NewId(), Email: MakeEmail()})
+			require.Nil(t, err)
+
+			wg.Add(1)
+			go func(userId string) {
+				defer wg.Done()
+				if _, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: team.Id, UserId: userId}, maxUsersPerTeam); nErr == nil {
+					atomic.AddInt32(&saved, 1)
+				}
+			}(user.Id)
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(maxUsersPerTeam), saved)
+		count, err := ss.Team().GetActiveMemberCount(context.Background(), team.Id, nil)
+		require.Nil(t, err)
+		assert.Equal(t, int64(maxUsersPerTeam), count)
 	})
 
 	t.Run("duplicated entries should fail", func(t *testing.T) {
-		teamID1 := model.NewId()
+		teamID1 := makeTeam(t, ss).Id
 		m1 := &model.TeamMember{TeamId: teamID1, UserId: u1.Id}
-		_, err = ss.Team().SaveMember(m1, -1)
-		require.Nil(t, err)
+		_, nErr := ss.Team().SaveMember(context.Background(), m1, -1)
+		require.Nil(t, nErr)
 		m2 := &model.TeamMember{TeamId: teamID1, UserId: u1.Id}
-		_, err = ss.Team().SaveMember(m2, -1)
-		require.NotNil(t, err)
-		require.Equal(t, "store.sql_team.save_member.exists.app_error", err.Id)
+		_, nErr = ss.Team().SaveMember(context.Background(), m2, -1)
+		require.NotNil(t, nErr)
+		var conflictErr *store.ErrConflict
+		require.True(t, errors.As(nErr, &conflictErr))
 	})
 
 	t.Run("insert member correctly (in team without scheme)", func(t *testing.T) {
@@ -1237,8 +2015,8 @@ func testTeamSaveMember(t *testing.T, ss store.Store) {
 			Type:        model.TEAM_OPEN,
 		}
 
-		team, err = ss.Team().Save(team)
-		require.Nil(t, err)
+		team, nErr := ss.Team().Save(context.Background(), team)
+		require.Nil(t, nErr)
 
 		testCases := []struct {
 			Name                  string
@@ -1357,9 +2135,9 @@ func testTeamSaveMember(t *testing.T, ss store.Store) {
 					SchemeAdmin:   tc.SchemeAdmin,
 					ExplicitRoles: tc.ExplicitRoles,
 				}
-				member, err = ss.Team().SaveMember(member, -1)
-				require.Nil(t, err)
-				defer ss.Team().RemoveMember(team.Id, u1.Id)
+				member, nErr := ss.Team().SaveMember(context.Background(), member, -1)
+				require.Nil(t, nErr)
+				defer ss.Team().RemoveMember(context.Background(), team.Id, u1.Id)
 
 				assert.Equal(t, tc.ExpectedRoles, member.Roles)
 				assert.Equal(t, tc.ExpectedExplicitRoles, member.ExplicitRoles)
@@ -1388,8 +2166,8 @@ func testTeamSaveMember(t *testing.T, ss store.Store) {
 			SchemeId:    &ts.Id,
 		}
 
-		team, err = ss.Team().Save(team)
-		require.Nil(t, err)
+		team, nErr = ss.Team().Save(context.Background(), team)
+		require.Nil(t, nErr)
 
 		testCases := []struct {
 			Name                  string
@@ -1508,9 +2286,9 @@ func testTeamSaveMember(t *testing.T, ss store.Store) {
 					SchemeAdmin:   tc.SchemeAdmin,
 					ExplicitRoles: tc.ExplicitRoles,
 				}
-				member, err := ss.Team().SaveMember(member, -1)
+				member, err := ss.Team().SaveMember(context.Background(), member, -1)
 				require.Nil(t, err)
-				defer ss.Team().RemoveMember(team.Id, u1.Id)
+				defer ss.Team().RemoveMember(context.Background(), team.Id, u1.Id)
 
 				assert.Equal(t, tc.ExpectedRoles, member.Roles)
 				assert.Equal(t, tc.ExpectedExplicitRoles, member.ExplicitRoles)
@@ -1535,54 +2313,60 @@ func testTeamSaveMultipleMembers(t *testing.T, ss store.Store) {
 	t.Run("any not valid team member", func(t *testing.T) {
 		m1 := &model.TeamMember{TeamId: "wrong", UserId: u1.Id}
 		m2 := &model.TeamMember{TeamId: model.NewId(), UserId: u2.Id}
-		_, err = ss.Team().SaveMultipleMembers([]*model.TeamMember{m1, m2}, -1)
-		require.NotNil(t, err)
-		require.Equal(t, "model.team_member.is_valid.team_id.app_error", err.Id)
+		_, nErr := ss.Team().SaveMultipleMembers(context.Background(), []*model.TeamMember{m1, m2}, -1)
+		require.NotNil(t, nErr)
+		var appErr *model.AppError
+		require.True(t, errors.As(nErr, &appErr))
+		require.Equal(t, "model.team_member.is_valid.team_id.app_error", appErr.Id)
 	})
 
 	t.Run("too many members in one team", func(t *testing.T) {
-		teamID := model.NewId()
+		teamID := makeTeam(t, ss).Id
 		m1 := &model.TeamMember{TeamId: teamID, UserId: u1.Id}
 		m2 := &model.TeamMember{TeamId: teamID, UserId: u2.Id}
-		_, err = ss.Team().SaveMultipleMembers([]*model.TeamMember{m1, m2}, 0)
-		require.NotNil(t, err)
-		require.Equal(t, "store.sql_user.save.max_accounts.app_error", err.Id)
+		_, nErr := ss.Team().SaveMultipleMembers(context.Background(), []*model.TeamMember{m1, m2}, 0)
+		require.NotNil(t, nErr)
+		var limitErr *store.ErrLimitExceeded
+		require.True(t, errors.As(nErr, &limitErr))
 	})
 
 	t.Run("too many members in one team because previous existing members", func(t *testing.T) {
-		teamID := model.NewId()
+		teamID := makeTeam(t, ss).Id
 		m1 := &model.TeamMember{TeamId: teamID, UserId: u1.Id}
 		m2 := &model.TeamMember{TeamId: teamID, UserId: u2.Id}
 		m3 := &model.TeamMember{TeamId: teamID, UserId: u3.Id}
 		m4 := &model.TeamMember{TeamId: teamID, UserId: u4.Id}
-		_, err = ss.Team().SaveMultipleMembers([]*model.TeamMember{m1, m2}, 3)
-		require.Nil(t, err)
+		_, nErr := ss.Team().SaveMultipleMembers(context.Background(), []*model.TeamMember{m1, m2}, 3)
+		require.Nil(t, nErr)
 
-		_, err = ss.Team().SaveMultipleMembers([]*model.TeamMember{m3, m4}, 3)
-		require.NotNil(t, err)
-		require.Equal(t, "store.sql_user.save.max_accounts.app_error", err.Id)
+		_, nErr = ss.Team().SaveMultipleMembers(context.Background(), []*model.TeamMember{m3, m4}, 3)
+		require.NotNil(t, nErr)
+		var limitErr *store.ErrLimitExceeded
+		require.True(t, errors.As(nErr, &limitErr))
 	})
 
 	t.Run("too many members, but in different teams", func(t *testing.T) {
-		teamID1 := model.NewId()
-		teamID2 := model.NewId()
+		teamID1 := makeTeam(t, ss).Id
+		teamID2 := makeTeam(t, ss).Id
 		m1 := &model.TeamMember{TeamId: teamID1, UserId: u1.Id}
 		m2 := &model.TeamMember{TeamId: teamID1, UserId: u2.Id}
 		m3 := &model.TeamMember{TeamId: teamID1, UserId: u3.Id}
 		m4 := &model.TeamMember{TeamId: teamID2, UserId: u1.Id}
 		m5 := &model.TeamMember{TeamId: teamID2, UserId: u2.Id}
-		_, err = ss.Team().SaveMultipleMembers([]*model.TeamMember{m1, m2, m3, m4, m5}, 2)
-		require.NotNil(t, err)
-		require.Equal(t, "store.sql_user.save.max_accounts.app_error", err.Id)
+		_, nErr := ss.Team().SaveMultipleMembers(context.Background(), []*model.TeamMember{m1, m2, m3, m4, m5}, 2)
+		require.NotNil(t, nErr)
+		var limitErr *store.ErrLimitExceeded
+		require.True(t, errors.As(nErr, &limitErr))
 	})
 
 	t.Run("duplicated entries should fail", func(t *testing.T) {
-		teamID1 := model.NewId()
+		teamID1 := makeTeam(t, ss).Id
 		m1 := &model.TeamMember{TeamId: teamID1, UserId: u1.Id}
 		m2 := &model.TeamMember{TeamId: teamID1, UserId: u1.Id}
-		_, err = ss.Team().SaveMultipleMembers([]*model.TeamMember{m1, m2}, 10)
-		require.NotNil(t, err)
-		require.Equal(t, "store.sql_team.save_member.exists.app_error", err.Id)
+		_, nErr := ss.Team().SaveMultipleMembers(context.Background(), []*model.TeamMember{m1, m2}, 10)
+		require.NotNil(t, nErr)
+		var conflictErr *store.ErrConflict
+		require.True(t, errors.As(nErr, &conflictErr))
 	})
 
 	t.Run("insert members correctly (in team without scheme)", func(t *testing.T) {
@@ -1593,8 +2377,8 @@ func testTeamSaveMultipleMembers(t *testing.T, ss store.Store) {
 			Type:        model.TEAM_OPEN,
 		}
 
-		team, err = ss.Team().Save(team)
-		require.Nil(t, err)
+		team, nErr := ss.Team().Save(context.Background(), team)
+		require.Nil(t, nErr)
 
 		testCases := []struct {
 			Name                  string
@@ -1722,12 +2506,12 @@ func testTeamSaveMultipleMembers(t *testing.T, ss store.Store) {
 					ExplicitRoles: tc.ExplicitRoles,
 				}
 				var members []*model.TeamMember
-				members, err = ss.Team().SaveMultipleMembers([]*model.TeamMember{member, otherMember}, -1)
-				require.Nil(t, err)
+				members, nErr := ss.Team().SaveMultipleMembers(context.Background(), []*model.TeamMember{member, otherMember}, -1)
+				require.Nil(t, nErr)
 				require.Len(t, members, 2)
 				member = members[0]
-				defer ss.Team().RemoveMember(team.Id, u1.Id)
-				defer ss.Team().RemoveMember(team.Id, u2.Id)
+				defer ss.Team().RemoveMember(context.Background(), team.Id, u1.Id)
+				defer ss.Team().RemoveMember(context.Background(), team.Id, u2.Id)
 
 				assert.Equal(t, tc.ExpectedRoles, member.Roles)
 				assert.Equal(t, tc.ExpectedExplicitRoles, member.ExplicitRoles)
@@ -1756,8 +2540,8 @@ func testTeamSaveMultipleMembers(t *testing.T, ss store.Store) {
 			SchemeId:    &ts.Id,
 		}
 
-		team, err = ss.Team().Save(team)
-		require.Nil(t, err)
+		team, nErr = ss.Team().Save(context.Background(), team)
+		require.Nil(t, nErr)
 
 		testCases := []struct {
 			Name                  string
@@ -1884,12 +2668,12 @@ func testTeamSaveMultipleMembers(t *testing.T, ss store.Store) {
 					SchemeAdmin:   tc.SchemeAdmin,
 					ExplicitRoles: tc.ExplicitRoles,
 				}
-				members, err := ss.Team().SaveMultipleMembers([]*model.TeamMember{member, otherMember}, -1)
+				members, err := ss.Team().SaveMultipleMembers(context.Background(), []*model.TeamMember{member, otherMember}, -1)
 				require.Nil(t, err)
 				require.Len(t, members, 2)
 				member = members[0]
-				defer ss.Team().RemoveMember(team.Id, u1.Id)
-				defer ss.Team().RemoveMember(team.Id, u2.Id)
+				defer ss.Team().RemoveMember(context.Background(), team.Id, u1.Id)
+				defer ss.Team().RemoveMember(context.Background(), team.Id, u2.Id)
 
 				assert.Equal(t, tc.ExpectedRoles, member.Roles)
 				assert.Equal(t, tc.ExpectedExplicitRoles, member.ExplicitRoles)
@@ -1907,7 +2691,7 @@ func testTeamUpdateMember(t *testing.T, ss store.Store) {
 
 	t.Run("not valid team member", func(t *testing.T) {
 		member := &model.TeamMember{TeamId: "wrong", UserId: u1.Id}
-		_, err = ss.Team().UpdateMember(member)
+		_, err = ss.Team().UpdateMember(context.Background(), member)
 		require.NotNil(t, err)
 		require.Equal(t, "model.team_member.is_valid.team_id.app_error", err.Id)
 	})
@@ -1920,12 +2704,12 @@ func testTeamUpdateMember(t *testing.T, ss store.Store) {
 			Type:        model.TEAM_OPEN,
 		}
 
-		team, err = ss.Team().Save(team)
-		require.Nil(t, err)
+		team, nErr := ss.Team().Save(context.Background(), team)
+		require.Nil(t, nErr)
 
 		member := &model.TeamMember{TeamId: team.Id, UserId: u1.Id}
-		member, err = ss.Team().SaveMember(member, -1)
-		require.Nil(t, err)
+		member, nErr = ss.Team().SaveMember(context.Background(), member, -1)
+		require.Nil(t, nErr)
 
 		testCases := []struct {
 			Name                  string
@@ -2041,7 +2825,7 @@ func testTeamUpdateMember(t *testing.T, ss store.Store) {
 				member.SchemeAdmin = tc.SchemeAdmin
 				member.ExplicitRoles = tc.ExplicitRoles
 
-				member, err = ss.Team().UpdateMember(member)
+				member, err = ss.Team().UpdateMember(context.Background(), member)
 				require.Nil(t, err)
 
 				assert.Equal(t, tc.ExpectedRoles, member.Roles)
@@ -2071,11 +2855,11 @@ func testTeamUpdateMember(t *testing.T, ss store.Store) {
 			SchemeId:    &ts.Id,
 		}
 
-		team, err = ss.Team().Save(team)
-		require.Nil(t, err)
+		team, nErr = ss.Team().Save(context.Background(), team)
+		require.Nil(t, nErr)
 
 		member := &model.TeamMember{TeamId: team.Id, UserId: u1.Id}
-		member, err := ss.Team().SaveMember(member, -1)
+		member, err := ss.Team().SaveMember(context.Background(), member, -1)
 		require.Nil(t, err)
 
 		testCases := []struct {
@@ -2192,7 +2976,7 @@ func testTeamUpdateMember(t *testing.T, ss store.Store) {
 				member.SchemeAdmin = tc.SchemeAdmin
 				member.ExplicitRoles = tc.ExplicitRoles
 
-				member, err = ss.Team().UpdateMember(member)
+				member, err = ss.Team().UpdateMember(context.Background(), member)
 				require.Nil(t, err)
 
 				assert.Equal(t, tc.ExpectedRoles, member.Roles)
@@ -2214,7 +2998,7 @@ func testTeamUpdateMultipleMembers(t *testing.T, ss store.Store) {
 	t.Run("any not valid team member", func(t *testing.T) {
 		m1 := &model.TeamMember{TeamId: "wrong", UserId: u1.Id}
 		m2 := &model.TeamMember{TeamId: model.NewId(), UserId: u2.Id}
-		_, err = ss.Team().UpdateMultipleMembers([]*model.TeamMember{m1, m2})
+		_, err = ss.Team().UpdateMultipleMembers(context.Background(), []*model.TeamMember{m1, m2})
 		require.NotNil(t, err)
 		require.Equal(t, "model.team_member.is_valid.team_id.app_error", err.Id)
 	})
@@ -2227,14 +3011,14 @@ func testTeamUpdateMultipleMembers(t *testing.T, ss store.Store) {
 			Type:        model.TEAM_OPEN,
 		}
 
-		team, err = ss.Team().Save(team)
-		require.Nil(t, err)
+		team, nErr := ss.Team().Save(context.Background(), team)
+		require.Nil(t, nErr)
 
 		member := &model.TeamMember{TeamId: team.Id, UserId: u1.Id}
 		otherMember := &model.TeamMember{TeamId: team.Id, UserId: u2.Id}
 		var members []*model.TeamMember
-		members, err = ss.Team().SaveMultipleMembers([]*model.TeamMember{member, otherMember}, -1)
-		require.Nil(t, err)
+		members, nErr = ss.Team().SaveMultipleMembers(context.Background(), []*model.TeamMember{member, otherMember}, -1)
+		require.Nil(t, nErr)
 		require.Len(t, members, 2)
 		member = members[0]
 		otherMember = members[1]
@@ -2354,7 +3138,7 @@ func testTeamUpdateMultipleMembers(t *testing.T, ss store.Store) {
 				member.ExplicitRoles = tc.ExplicitRoles
 
 				var members []*model.TeamMember
-				members, err = ss.Team().UpdateMultipleMembers([]*model.TeamMember{member, otherMember})
+				members, err = ss.Team().UpdateMultipleMembers(context.Background(), []*model.TeamMember{member, otherMember})
 				require.Nil(t, err)
 				require.Len(t, members, 2)
 				member = members[0]
@@ -2386,12 +3170,12 @@ func testTeamUpdateMultipleMembers(t *testing.T, ss store.Store) {
 			SchemeId:    &ts.Id,
 		}
 
-		team, nErr = ss.Team().Save(team)
+		team, nErr = ss.Team().Save(context.Background(), team)
 		require.Nil(t, nErr)
 
 		member := &model.TeamMember{TeamId: team.Id, UserId: u1.Id}
 		otherMember := &model.TeamMember{TeamId: team.Id, UserId: u2.Id}
-		members, err := ss.Team().SaveMultipleMembers([]*model.TeamMember{member, otherMember}, -1)
+		members, err := ss.Team().SaveMultipleMembers(context.Background(), []*model.TeamMember{member, otherMember}, -1)
 		require.Nil(t, err)
 		require.Len(t, members, 2)
 		member = members[0]
@@ -2511,7 +3295,7 @@ func testTeamUpdateMultipleMembers(t *testing.T, ss store.Store) {
 				member.SchemeAdmin = tc.SchemeAdmin
 				member.ExplicitRoles = tc.ExplicitRoles
 
-				members, err := ss.Team().UpdateMultipleMembers([]*model.TeamMember{member, otherMember})
+				members, err := ss.Team().UpdateMultipleMembers(context.Background(), []*model.TeamMember{member, otherMember})
 				require.Nil(t, err)
 				require.Len(t, members, 2)
 				member = members[0]
@@ -2535,43 +3319,96 @@ func testTeamRemoveMember(t *testing.T, ss store.Store) {
 	require.Nil(t, err)
 	u4, err := ss.User().Save(&model.User{Username: model.NewId(), Email: MakeEmail()})
 	require.Nil(t, err)
-	teamID := model.NewId()
+	teamID := makeTeam(t, ss).Id
 	m1 := &model.TeamMember{TeamId: teamID, UserId: u1.Id}
 	m2 := &model.TeamMember{TeamId: teamID, UserId: u2.Id}
 	m3 := &model.TeamMember{TeamId: teamID, UserId: u3.Id}
 	m4 := &model.TeamMember{TeamId: teamID, UserId: u4.Id}
-	_, err = ss.Team().SaveMultipleMembers([]*model.TeamMember{m1, m2, m3, m4}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMultipleMembers(context.Background(), []*model.TeamMember{m1, m2, m3, m4}, -1)
+	require.Nil(t, nErr)
 
 	t.Run("remove member from not existing team", func(t *testing.T) {
-		err = ss.Team().RemoveMember("not-existing-team", u1.Id)
+		err = ss.Team().RemoveMember(context.Background(), "not-existing-team", u1.Id)
 		require.Nil(t, err)
 		var membersOtherTeam []*model.TeamMember
-		membersOtherTeam, err = ss.Team().GetMembers(teamID, 0, 100, nil)
+		membersOtherTeam, err = ss.Team().GetMembers(context.Background(), teamID, 0, 100, nil)
 		require.Nil(t, err)
 		require.Len(t, membersOtherTeam, 4)
 	})
 
 	t.Run("remove not existing member from an existing team", func(t *testing.T) {
-		err = ss.Team().RemoveMember(teamID, model.NewId())
+		err = ss.Team().RemoveMember(context.Background(), teamID, model.NewId())
 		require.Nil(t, err)
 		var membersOtherTeam []*model.TeamMember
-		membersOtherTeam, err = ss.Team().GetMembers(teamID, 0, 100, nil)
+		membersOtherTeam, err = ss.Team().GetMembers(context.Background(), teamID, 0, 100, nil)
 		require.Nil(t, err)
 		require.Len(t, membersOtherTeam, 4)
 	})
 
 	t.Run("remove existing member from an existing team", func(t *testing.T) {
-		err = ss.Team().RemoveMember(teamID, u1.Id)
+		err = ss.Team().RemoveMember(context.Background(), teamID, u1.Id)
 		require.Nil(t, err)
-		defer ss.Team().SaveMember(m1, -1)
+		defer ss.Team().SaveMember(context.Background(), m1, -1)
 		var membersOtherTeam []*model.TeamMember
-		membersOtherTeam, err = ss.Team().GetMembers(teamID, 0, 100, nil)
+		membersOtherTeam, err = ss.Team().GetMembers(context.Background(), teamID, 0, 100, nil)
 		require.Nil(t, err)
 		require.Len(t, membersOtherTeam, 3)
 	})
 }
 
+func testTeamStoreUserBelongsToTeams(t *testing.T, ss store.Store) {
+	u1, err := ss.User().Save(&model.User{Username: model.NewId(), Email: MakeEmail()})
+	require.Nil(t, err)
+	u2, err := ss.User().Save(&model.User{Username: model.NewId(), Email: MakeEmail()})
+	require.Nil(t, err)
+	team1 := makeTeam(t, ss)
+	team2 := makeTeam(t, ss)
+
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: team1.Id, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
+	m2, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: team2.Id, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
+	m2.DeleteAt = model.GetMillis()
+	_, nErr = ss.Team().UpdateMember(context.Background(), m2)
+	require.Nil(t, nErr)
+
+	t.Run("member of one of the teams", func(t *testing.T) {
+		belongs, appErr := ss.Team().UserBelongsToTeams(context.Background(), u1.Id, []string{team2.Id, team1.Id})
+		require.Nil(t, appErr)
+		assert.True(t, belongs)
+
+		belongs, appErr = ss.Team().UserBelongsToTeam(context.Background(), u1.Id, team1.Id, false)
+		require.Nil(t, appErr)
+		assert.True(t, belongs)
+	})
+
+	t.Run("not a member", func(t *testing.T) {
+		belongs, appErr := ss.Team().UserBelongsToTeams(context.Background(), u1.Id, []string{team2.Id})
+		require.Nil(t, appErr)
+		assert.False(t, belongs)
+
+		belongs, appErr = ss.Team().UserBelongsToTeam(context.Background(), u1.Id, team2.Id, false)
+		require.Nil(t, appErr)
+		assert.False(t, belongs)
+	})
+
+	t.Run("removed member", func(t *testing.T) {
+		belongs, appErr := ss.Team().UserBelongsToTeams(context.Background(), u2.Id, []string{team2.Id})
+		require.Nil(t, appErr)
+		assert.False(t, belongs)
+
+		belongs, appErr = ss.Team().UserBelongsToTeam(context.Background(), u2.Id, team2.Id, false)
+		require.Nil(t, appErr)
+		assert.False(t, belongs)
+	})
+
+	t.Run("no teams", func(t *testing.T) {
+		belongs, appErr := ss.Team().UserBelongsToTeams(context.Background(), u1.Id, []string{})
+		require.Nil(t, appErr)
+		assert.False(t, belongs)
+	})
+}
+
 func testTeamRemoveMembers(t *testing.T, ss store.Store) {
 	u1, err := ss.User().Save(&model.User{Username: model.NewId(), Email: MakeEmail()})
 	require.Nil(t, err)
@@ -2581,113 +3418,194 @@ func testTeamRemoveMembers(t *testing.T, ss store.Store) {
 	require.Nil(t, err)
 	u4, err := ss.User().Save(&model.User{Username: model.NewId(), Email: MakeEmail()})
 	require.Nil(t, err)
-	teamID := model.NewId()
+	teamID := makeTeam(t, ss).Id
 	m1 := &model.TeamMember{TeamId: teamID, UserId: u1.Id}
 	m2 := &model.TeamMember{TeamId: teamID, UserId: u2.Id}
 	m3 := &model.TeamMember{TeamId: teamID, UserId: u3.Id}
 	m4 := &model.TeamMember{TeamId: teamID, UserId: u4.Id}
-	_, err = ss.Team().SaveMultipleMembers([]*model.TeamMember{m1, m2, m3, m4}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMultipleMembers(context.Background(), []*model.TeamMember{m1, m2, m3, m4}, -1)
+	require.Nil(t, nErr)
 
 	t.Run("remove members from not existing team", func(t *testing.T) {
-		err = ss.Team().RemoveMembers("not-existing-team", []string{u1.Id, u2.Id, u3.Id, u4.Id})
+		err = ss.Team().RemoveMembers(context.Background(), "not-existing-team", []string{u1.Id, u2.Id, u3.Id, u4.Id})
 		require.Nil(t, err)
 		var membersOtherTeam []*model.TeamMember
-		membersOtherTeam, err = ss.Team().GetMembers(teamID, 0, 100, nil)
+		membersOtherTeam, err = ss.Team().GetMembers(context.Background(), teamID, 0, 100, nil)
 		require.Nil(t, err)
 		require.Len(t, membersOtherTeam, 4)
 	})
 
 	t.Run("remove not existing members from an existing team", func(t *testing.T) {
-		err = ss.Team().RemoveMembers(teamID, []string{model.NewId(), model.NewId()})
+		err = ss.Team().RemoveMembers(context.Background(), teamID, []string{model.NewId(), model.NewId()})
 		require.Nil(t, err)
 		var membersOtherTeam []*model.TeamMember
-		membersOtherTeam, err = ss.Team().GetMembers(teamID, 0, 100, nil)
+		membersOtherTeam, err = ss.Team().GetMembers(context.Background(), teamID, 0, 100, nil)
 		require.Nil(t, err)
 		require.Len(t, membersOtherTeam, 4)
 	})
 
 	t.Run("remove not existing and not existing members from an existing team", func(t *testing.T) {
-		err = ss.Team().RemoveMembers(teamID, []string{u1.Id, u2.Id, model.NewId(), model.NewId()})
+		err = ss.Team().RemoveMembers(context.Background(), teamID, []string{u1.Id, u2.Id, model.NewId(), model.NewId()})
 		require.Nil(t, err)
-		defer ss.Team().SaveMultipleMembers([]*model.TeamMember{m1, m2}, -1)
+		defer ss.Team().SaveMultipleMembers(context.Background(), []*model.TeamMember{m1, m2}, -1)
 		var membersOtherTeam []*model.TeamMember
-		membersOtherTeam, err = ss.Team().GetMembers(teamID, 0, 100, nil)
+		membersOtherTeam, err = ss.Team().GetMembers(context.Background(), teamID, 0, 100, nil)
 		require.Nil(t, err)
 		require.Len(t, membersOtherTeam, 2)
 	})
 	t.Run("remove existing members from an existing team", func(t *testing.T) {
-		err = ss.Team().RemoveMembers(teamID, []string{u1.Id, u2.Id, u3.Id})
+		err = ss.Team().RemoveMembers(context.Background(), teamID, []string{u1.Id, u2.Id, u3.Id})
 		require.Nil(t, err)
-		defer ss.Team().SaveMultipleMembers([]*model.TeamMember{m1, m2, m3}, -1)
+		defer ss.Team().SaveMultipleMembers(context.Background(), []*model.TeamMember{m1, m2, m3}, -1)
 		var membersOtherTeam []*model.TeamMember
-		membersOtherTeam, err = ss.Team().GetMembers(teamID, 0, 100, nil)
+		membersOtherTeam, err = ss.Team().GetMembers(context.Background(), teamID, 0, 100, nil)
 		require.Nil(t, err)
 		require.Len(t, membersOtherTeam, 1)
 	})
 }
 
 func testTeamMembersWithPagination(t *testing.T, ss store.Store) {
-	teamId1 := model.NewId()
-	teamId2 := model.NewId()
+	teamId1 := makeTeam(t, ss).Id
+	teamId2 := makeTeam(t, ss).Id
 
-	m1 := &model.TeamMember{TeamId: teamId1, UserId: model.NewId()}
-	m2 := &model.TeamMember{TeamId: teamId1, UserId: model.NewId()}
-	m3 := &model.TeamMember{TeamId: teamId2, UserId: model.NewId()}
+	m1 := &model.TeamMember{TeamId: teamId1, UserId: makeUser(t, ss).Id}
+	m2 := &model.TeamMember{TeamId: teamId1, UserId: makeUser(t, ss).Id}
+	m3 := &model.TeamMember{TeamId: teamId2, UserId: makeUser(t, ss).Id}
 
-	_, err := ss.Team().SaveMultipleMembers([]*model.TeamMember{m1, m2, m3}, -1)
+	_, err := ss.Team().SaveMultipleMembers(context.Background(), []*model.TeamMember{m1, m2, m3}, -1)
 	require.Nil(t, err)
 
-	ms, errTeam := ss.Team().GetTeamsForUserWithPagination(m1.UserId, 0, 1)
-	require.Nil(t, errTeam)
+	ms, errTeam := ss.Team().GetTeamsForUserWithPagination(context.Background(), m1.UserId, 0, 1, nil)
+	require.NoError(t, errTeam)
 
 	require.Len(t, ms, 1)
 	require.Equal(t, m1.TeamId, ms[0].TeamId)
 
-	e := ss.Team().RemoveMember(teamId1, m1.UserId)
+	e := ss.Team().RemoveMember(context.Background(), teamId1, m1.UserId)
 	require.Nil(t, e)
 
-	ms, err = ss.Team().GetMembers(teamId1, 0, 100, nil)
+	ms, err = ss.Team().GetMembers(context.Background(), teamId1, 0, 100, nil)
 	require.Nil(t, err)
 
 	require.Len(t, ms, 1)
 	require.Equal(t, m2.UserId, ms[0].UserId)
 
-	_, err = ss.Team().SaveMember(m1, -1)
+	_, err = ss.Team().SaveMember(context.Background(), m1, -1)
 	require.Nil(t, err)
 
-	err = ss.Team().RemoveAllMembersByTeam(teamId1)
+	err = ss.Team().RemoveAllMembersByTeam(context.Background(), teamId1)
 	require.Nil(t, err)
 
-	uid := model.NewId()
+	uid := makeUser(t, ss).Id
 	m4 := &model.TeamMember{TeamId: teamId1, UserId: uid}
 	m5 := &model.TeamMember{TeamId: teamId2, UserId: uid}
-	_, err = ss.Team().SaveMultipleMembers([]*model.TeamMember{m4, m5}, -1)
+	_, err = ss.Team().SaveMultipleMembers(context.Background(), []*model.TeamMember{m4, m5}, -1)
 	require.Nil(t, err)
 
-	result, err := ss.Team().GetTeamsForUserWithPagination(uid, 0, 1)
-	require.Nil(t, err)
+	result, err := ss.Team().GetTeamsForUserWithPagination(context.Background(), uid, 0, 1, nil)
+	require.NoError(t, err)
 	require.Len(t, result, 1)
 
-	err = ss.Team().RemoveAllMembersByUser(uid)
+	_, err = ss.Team().RemoveAllMembersByUser(context.Background(), uid)
 	require.Nil(t, err)
 
-	result, err = ss.Team().GetTeamsForUserWithPagination(uid, 1, 1)
-	require.Nil(t, err)
+	result, err = ss.Team().GetTeamsForUserWithPagination(context.Background(), uid, 1, 1, nil)
+	require.NoError(t, err)
 	require.Empty(t, result)
 }
 
+func testTeamMembersWithPaginationOptions(t *testing.T, ss store.Store) {
+	newTeam := func(displayName string) *model.Team {
+		team, err := ss.Team().Save(context.Background(), &model.Team{
+			DisplayName: displayName,
+			Name:        "z-z-z" + model.NewId() + "b",
+			Type:        model.TEAM_OPEN,
+		})
+		require.Nil(t, err)
+		return team
+	}
+
+	teamB := newTeam("B")
+	teamA := newTeam("A")
+	deletedTeam := newTeam("C")
+	leftTeam := newTeam("D")
+
+	uid := makeUser(t, ss).Id
+	_, nErr := ss.Team().SaveMultipleMembers(context.Background(), []*model.TeamMember{
+		{TeamId: teamB.Id, UserId: uid},
+		{TeamId: teamA.Id, UserId: uid},
+		{TeamId: deletedTeam.Id, UserId: uid},
+		{TeamId: leftTeam.Id, UserId: uid},
+	}, -1)
+	require.Nil(t, nErr)
+
+	deletedTeam.DeleteAt = model.GetMillis()
+	_, err := ss.Team().Update(context.Background(), deletedTeam)
+	require.Nil(t, err)
+
+	leftMember, nErr := ss.Team().GetMember(context.Background(), leftTeam.Id, uid, false)
+	require.Nil(t, nErr)
+	leftMember.DeleteAt = model.GetMillis()
+	_, nErr = ss.Team().UpdateMember(context.Background(), leftMember)
+	require.Nil(t, nErr)
+
+	teamIds := func(members []*model.TeamMember) []string {
+		ids := []string{}
+		for _, member := range members {
+			ids = append(ids, member.TeamId)
+		}
+		return ids
+	}
+
+	t.Run("sorted by team id by default", func(t *testing.T) {
+		expected := []string{teamA.Id, teamB.Id, deletedTeam.Id, leftTeam.Id}
+		sort.Strings(expected)
+
+		members, err := ss.Team().GetTeamsForUserWithPagination(context.Background(), uid, 0, 10, nil)
+		require.NoError(t, err)
+		assert.Equal(t, expected, teamIds(members))
+
+		members, err = ss.Team().GetTeamsForUserWithPagination(context.Background(), uid, 1, 2, &model.TeamMembersForUserGetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, expected[2:], teamIds(members))
+	})
+
+	t.Run("sorted by display name", func(t *testing.T) {
+		opts := &model.TeamMembersForUserGetOptions{Sort: model.TEAMS_FOR_USER_SORT_DISPLAY_NAME}
+
+		members, err := ss.Team().GetTeamsForUserWithPagination(context.Background(), uid, 0, 10, opts)
+		require.NoError(t, err)
+		assert.Equal(t, []string{teamA.Id, teamB.Id, deletedTeam.Id, leftTeam.Id}, teamIds(members))
+
+		members, err = ss.Team().GetTeamsForUserWithPagination(context.Background(), uid, 1, 1, opts)
+		require.NoError(t, err)
+		assert.Equal(t, []string{teamB.Id}, teamIds(members))
+	})
+
+	t.Run("excluding deleted", func(t *testing.T) {
+		opts := &model.TeamMembersForUserGetOptions{ExcludeDeleted: true, Sort: model.TEAMS_FOR_USER_SORT_DISPLAY_NAME}
+
+		members, err := ss.Team().GetTeamsForUserWithPagination(context.Background(), uid, 0, 10, opts)
+		require.NoError(t, err)
+		assert.Equal(t, []string{teamA.Id, teamB.Id}, teamIds(members))
+
+		members, err = ss.Team().GetTeamsForUserWithPagination(context.Background(), uid, 1, 1, opts)
+		require.NoError(t, err)
+		assert.Equal(t, []string{teamB.Id}, teamIds(members))
+	})
+}
+
 func testSaveTeamMemberMaxMembers(t *testing.T, ss store.Store) {
 	maxUsersPerTeam := 5
 
-	team, errSave := ss.Team().Save(&model.Team{
+	team, errSave := ss.Team().Save(context.Background(), &model.Team{
 		DisplayName: "DisplayName",
 		Name:        "z-z-z" + model.NewId() + "b",
 		Type:        model.TEAM_OPEN,
 	})
 	require.Nil(t, errSave)
 	defer func() {
-		ss.Team().PermanentDelete(team.Id)
+		ss.Team().PermanentDelete(context.Background(), team.Id)
 	}()
 
 	userIds := make([]string, maxUsersPerTeam)
@@ -2704,18 +3622,18 @@ func testSaveTeamMemberMaxMembers(t *testing.T, ss store.Store) {
 			ss.User().PermanentDelete(userId)
 		}(userIds[i])
 
-		_, err = ss.Team().SaveMember(&model.TeamMember{
+		_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{
 			TeamId: team.Id,
 			UserId: userIds[i],
 		}, maxUsersPerTeam)
-		require.Nil(t, err)
+		require.Nil(t, nErr)
 
 		defer func(userId string) {
-			ss.Team().RemoveMember(team.Id, userId)
+			ss.Team().RemoveMember(context.Background(), team.Id, userId)
 		}(userIds[i])
 	}
 
-	totalMemberCount, err := ss.Team().GetTotalMemberCount(team.Id, nil)
+	totalMemberCount, err := ss.Team().GetTotalMemberCount(context.Background(), team.Id, nil)
 	require.Nil(t, err)
 	require.Equal(t, int(totalMemberCount), maxUsersPerTeam, "should start with 5 team members, had %v instead", totalMemberCount)
 
@@ -2729,34 +3647,34 @@ func testSaveTeamMemberMaxMembers(t *testing.T, ss store.Store) {
 		ss.User().PermanentDelete(newUserId)
 	}()
 
-	_, err = ss.Team().SaveMember(&model.TeamMember{
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{
 		TeamId: team.Id,
 		UserId: newUserId,
 	}, maxUsersPerTeam)
-	require.NotNil(t, err, "shouldn't be able to save member when at maximum members per team")
+	require.NotNil(t, nErr, "shouldn't be able to save member when at maximum members per team")
 
-	totalMemberCount, teamErr := ss.Team().GetTotalMemberCount(team.Id, nil)
+	totalMemberCount, teamErr := ss.Team().GetTotalMemberCount(context.Background(), team.Id, nil)
 	require.Nil(t, teamErr)
 	require.Equal(t, maxUsersPerTeam, int(totalMemberCount), "should still have 5 team members, had %v instead", totalMemberCount)
 
 	// Leaving the team from the UI sets DeleteAt instead of using TeamStore.RemoveMember
-	_, teamErr = ss.Team().UpdateMember(&model.TeamMember{
+	_, teamErr = ss.Team().UpdateMember(context.Background(), &model.TeamMember{
 		TeamId:   team.Id,
 		UserId:   userIds[0],
 		DeleteAt: 1234,
 	})
 	require.Nil(t, teamErr)
 
-	totalMemberCount, teamErr = ss.Team().GetTotalMemberCount(team.Id, nil)
+	totalMemberCount, teamErr = ss.Team().GetTotalMemberCount(context.Background(), team.Id, nil)
 	require.Nil(t, teamErr)
 	require.Equal(t, maxUsersPerTeam-1, int(totalMemberCount), "should now only have 4 team members, had %v instead", totalMemberCount)
 
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: team.Id, UserId: newUserId}, maxUsersPerTeam)
-	require.Nil(t, err, "should've been able to save new member after deleting one")
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: team.Id, UserId: newUserId}, maxUsersPerTeam)
+	require.Nil(t, nErr, "should've been able to save new member after deleting one")
 
-	defer ss.Team().RemoveMember(team.Id, newUserId)
+	defer ss.Team().RemoveMember(context.Background(), team.Id, newUserId)
 
-	totalMemberCount, teamErr = ss.Team().GetTotalMemberCount(team.Id, nil)
+	totalMemberCount, teamErr = ss.Team().GetTotalMemberCount(context.Background(), team.Id, nil)
 	require.Nil(t, teamErr)
 	require.Equal(t, maxUsersPerTeam, int(totalMemberCount), "should have 5 team members again, had %v instead", totalMemberCount)
 
@@ -2773,31 +3691,33 @@ func testSaveTeamMemberMaxMembers(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	newUserId2 := user.Id
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: team.Id, UserId: newUserId2}, maxUsersPerTeam)
-	require.Nil(t, err, "should've been able to save new member after deleting one")
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: team.Id, UserId: newUserId2}, maxUsersPerTeam)
+	require.Nil(t, nErr, "should've been able to save new member after deleting one")
 
-	defer ss.Team().RemoveMember(team.Id, newUserId2)
+	defer ss.Team().RemoveMember(context.Background(), team.Id, newUserId2)
 }
 
 func testGetTeamMember(t *testing.T, ss store.Store) {
-	teamId1 := model.NewId()
+	teamId1 := makeTeam(t, ss).Id
 
-	m1 := &model.TeamMember{TeamId: teamId1, UserId: model.NewId()}
-	_, err := ss.Team().SaveMember(m1, -1)
+	m1 := &model.TeamMember{TeamId: teamId1, UserId: makeUser(t, ss).Id}
+	_, err := ss.Team().SaveMember(context.Background(), m1, -1)
 	require.Nil(t, err)
 
 	var rm1 *model.TeamMember
-	rm1, err = ss.Team().GetMember(m1.TeamId, m1.UserId)
+	rm1, err = ss.Team().GetMember(context.Background(), m1.TeamId, m1.UserId, false)
 	require.Nil(t, err)
 
 	require.Equal(t, rm1.TeamId, m1.TeamId, "bad team id")
 
 	require.Equal(t, rm1.UserId, m1.UserId, "bad user id")
 
-	_, err = ss.Team().GetMember(m1.TeamId, "")
+	_, err = ss.Team().GetMember(context.Background(), m1.TeamId, "", false)
 	require.NotNil(t, err, "empty user id - should have failed")
+	var nfErr *store.ErrNotFound
+	assert.True(t, errors.As(err, &nfErr))
 
-	_, err = ss.Team().GetMember("", m1.UserId)
+	_, err = ss.Team().GetMember(context.Background(), "", m1.UserId, false)
 	require.NotNil(t, err, "empty team id - should have failed")
 
 	// Test with a custom team scheme.
@@ -2811,7 +3731,7 @@ func testGetTeamMember(t *testing.T, ss store.Store) {
 	require.Nil(t, nErr)
 	t.Log(s2)
 
-	t2, err := ss.Team().Save(&model.Team{
+	t2, err := ss.Team().Save(context.Background(), &model.Team{
 		DisplayName: "DisplayName",
 		Name:        "z-z-z" + model.NewId() + "b",
 		Type:        model.TEAM_OPEN,
@@ -2820,129 +3740,363 @@ func testGetTeamMember(t *testing.T, ss store.Store) {
 	require.Nil(t, err)
 
 	defer func() {
-		ss.Team().PermanentDelete(t2.Id)
+		ss.Team().PermanentDelete(context.Background(), t2.Id)
 	}()
 
-	m2 := &model.TeamMember{TeamId: t2.Id, UserId: model.NewId(), SchemeUser: true}
-	_, err = ss.Team().SaveMember(m2, -1)
+	m2 := &model.TeamMember{TeamId: t2.Id, UserId: makeUser(t, ss).Id, SchemeUser: true}
+	_, err = ss.Team().SaveMember(context.Background(), m2, -1)
 	require.Nil(t, err)
 
-	m3, err := ss.Team().GetMember(m2.TeamId, m2.UserId)
+	m3, err := ss.Team().GetMember(context.Background(), m2.TeamId, m2.UserId, false)
 	require.Nil(t, err)
 	t.Log(m3)
 
 	assert.Equal(t, s2.DefaultTeamUserRole, m3.Roles)
 
-	m4 := &model.TeamMember{TeamId: t2.Id, UserId: model.NewId(), SchemeGuest: true}
-	_, err = ss.Team().SaveMember(m4, -1)
+	m4 := &model.TeamMember{TeamId: t2.Id, UserId: makeUser(t, ss).Id, SchemeGuest: true}
+	_, err = ss.Team().SaveMember(context.Background(), m4, -1)
 	require.Nil(t, err)
 
-	m5, err := ss.Team().GetMember(m4.TeamId, m4.UserId)
+	m5, err := ss.Team().GetMember(context.Background(), m4.TeamId, m4.UserId, false)
 	require.Nil(t, err)
 
 	assert.Equal(t, s2.DefaultTeamGuestRole, m5.Roles)
 }
 
 func testGetTeamMembersByIds(t *testing.T, ss store.Store) {
-	teamId1 := model.NewId()
+	teamId1 := makeTeam(t, ss).Id
 
-	m1 := &model.TeamMember{TeamId: teamId1, UserId: model.NewId()}
-	_, err := ss.Team().SaveMember(m1, -1)
+	m1 := &model.TeamMember{TeamId: teamId1, UserId: makeUser(t, ss).Id}
+	_, err := ss.Team().SaveMember(context.Background(), m1, -1)
 	require.Nil(t, err)
 
 	var r []*model.TeamMember
-	r, err = ss.Team().GetMembersByIds(m1.TeamId, []string{m1.UserId}, nil)
+	r, err = ss.Team().GetMembersByIds(context.Background(), m1.TeamId, []string{m1.UserId}, nil)
 	require.Nil(t, err)
 	rm1 := r[0]
 
 	require.Equal(t, rm1.TeamId, m1.TeamId, "bad team id")
 	require.Equal(t, rm1.UserId, m1.UserId, "bad user id")
 
-	m2 := &model.TeamMember{TeamId: teamId1, UserId: model.NewId()}
-	_, err = ss.Team().SaveMember(m2, -1)
+	m2 := &model.TeamMember{TeamId: teamId1, UserId: makeUser(t, ss).Id}
+	_, err = ss.Team().SaveMember(context.Background(), m2, -1)
 	require.Nil(t, err)
 
-	rm, err := ss.Team().GetMembersByIds(m1.TeamId, []string{m1.UserId, m2.UserId, model.NewId()}, nil)
+	rm, err := ss.Team().GetMembersByIds(context.Background(), m1.TeamId, []string{m1.UserId, m2.UserId, model.NewId()}, nil)
 	require.Nil(t, err)
 
 	require.Len(t, rm, 2, "return wrong number of results")
 
-	_, err = ss.Team().GetMembersByIds(m1.TeamId, []string{}, nil)
+	_, err = ss.Team().GetMembersByIds(context.Background(), m1.TeamId, []string{}, nil)
 	require.NotNil(t, err, "empty user ids - should have failed")
 }
 
-func testTeamStoreMemberCount(t *testing.T, ss store.Store) {
-	u1 := &model.User{}
-	u1.Email = MakeEmail()
-	_, err := ss.User().Save(u1)
-	require.Nil(t, err)
-
-	u2 := &model.User{}
-	u2.Email = MakeEmail()
-	u2.DeleteAt = 1
+func testGetTeamMembersByTeamIds(t *testing.T, ss store.Store) {
+	s1 := &model.Scheme{
+		Name:        model.NewId(),
+		DisplayName: model.NewId(),
+		Description: model.NewId(),
+		Scope:       model.SCHEME_SCOPE_TEAM,
+	}
+	s1, nErr := ss.Scheme().Save(s1)
+	require.Nil(t, nErr)
+
+	t1, err := ss.Team().Save(context.Background(), &model.Team{
+		DisplayName: "DisplayName",
+		Name:        "z-z-z" + model.NewId() + "b",
+		Type:        model.TEAM_OPEN,
+		SchemeId:    &s1.Id,
+	})
+	require.Nil(t, err)
+	defer ss.Team().PermanentDelete(context.Background(), t1.Id)
+
+	userId := makeUser(t, ss).Id
+	teamId2 := makeTeam(t, ss).Id
+	teamId3 := makeTeam(t, ss).Id
+
+	m1 := &model.TeamMember{TeamId: t1.Id, UserId: userId, SchemeUser: true, SchemeAdmin: true}
+	_, nErr = ss.Team().SaveMember(context.Background(), m1, -1)
+	require.Nil(t, nErr)
+
+	m2 := &model.TeamMember{TeamId: teamId2, UserId: userId, SchemeUser: true, DeleteAt: model.GetMillis()}
+	_, nErr = ss.Team().SaveMember(context.Background(), m2, -1)
+	require.Nil(t, nErr)
+
+	// Neither the members of other users nor of other teams are returned.
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: t1.Id, UserId: makeUser(t, ss).Id}, -1)
+	require.Nil(t, nErr)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId3, UserId: userId}, -1)
+	require.Nil(t, nErr)
+
+	members, err := ss.Team().GetMembersByTeamIds(context.Background(), []string{t1.Id, teamId2, model.NewId()}, userId)
+	require.Nil(t, err)
+	require.Len(t, members, 2)
+
+	membersByTeam := map[string]*model.TeamMember{}
+	for _, member := range members {
+		assert.Equal(t, userId, member.UserId)
+		membersByTeam[member.TeamId] = member
+	}
+
+	require.Contains(t, membersByTeam, t1.Id)
+	assert.Equal(t, s1.DefaultTeamUserRole+" "+s1.DefaultTeamAdminRole, membersByTeam[t1.Id].Roles)
+	require.Contains(t, membersByTeam, teamId2)
+	assert.NotZero(t, membersByTeam[teamId2].DeleteAt)
+
+	members, err = ss.Team().GetMembersByTeamIds(context.Background(), []string{}, userId)
+	require.Nil(t, err)
+	assert.Empty(t, members)
+}
+
+func testTeamStoreGetActiveMemberCounts(t *testing.T, ss store.Store) {
+	active, err := ss.User().Save(&model.User{Email: MakeEmail(), Username: model.NewId()})
+	require.Nil(t, err)
+	deactivated, err := ss.User().Save(&model.User{Email: MakeEmail(), Username: model.NewId(), DeleteAt: 1})
+	require.Nil(t, err)
+
+	teamId1 := makeTeam(t, ss).Id
+	teamId2 := makeTeam(t, ss).Id
+	teamId3 := makeTeam(t, ss).Id
+
+	for _, member := range []*model.TeamMember{
+		{TeamId: teamId1, UserId: active.Id},
+		{TeamId: teamId1, UserId: deactivated.Id},
+		{TeamId: teamId2, UserId: active.Id, DeleteAt: model.GetMillis()},
+		{TeamId: teamId2, UserId: deactivated.Id},
+	} {
+		_, nErr := ss.Team().SaveMember(context.Background(), member, -1)
+		require.Nil(t, nErr)
+	}
+
+	counts, nErr := ss.Team().GetActiveMemberCounts(context.Background(), []string{teamId1, teamId2, teamId3})
+	require.NoError(t, nErr)
+	assert.Equal(t, map[string]int64{teamId1: 1, teamId2: 0, teamId3: 0}, counts)
+
+	counts, nErr = ss.Team().GetActiveMemberCounts(context.Background(), []string{})
+	require.NoError(t, nErr)
+	assert.Empty(t, counts)
+}
+
+func testTeamStoreMemberCount(t *testing.T, ss store.Store) {
+	u1 := &model.User{}
+	u1.Email = MakeEmail()
+	_, err := ss.User().Save(u1)
+	require.Nil(t, err)
+
+	u2 := &model.User{}
+	u2.Email = MakeEmail()
+	u2.DeleteAt = 1
 	_, err = ss.User().Save(u2)
 	require.Nil(t, err)
 
-	teamId1 := model.NewId()
+	team, nErr := ss.Team().Save(context.Background(), &model.Team{
+		DisplayName: "DisplayName",
+		Name:        "z-z-z" + model.NewId() + "b",
+		Email:       MakeEmail(),
+		Type:        model.TEAM_OPEN,
+	})
+	require.Nil(t, nErr)
+	teamId1 := team.Id
+
 	m1 := &model.TeamMember{TeamId: teamId1, UserId: u1.Id}
-	_, err = ss.Team().SaveMember(m1, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), m1, -1)
+	require.Nil(t, nErr)
 
 	m2 := &model.TeamMember{TeamId: teamId1, UserId: u2.Id}
-	_, err = ss.Team().SaveMember(m2, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), m2, -1)
+	require.Nil(t, nErr)
 
 	var totalMemberCount int64
-	totalMemberCount, err = ss.Team().GetTotalMemberCount(teamId1, nil)
+	totalMemberCount, err = ss.Team().GetTotalMemberCount(context.Background(), teamId1, nil)
 	require.Nil(t, err)
 	require.Equal(t, int(totalMemberCount), 2, "wrong count")
 
 	var result int64
-	result, err = ss.Team().GetActiveMemberCount(teamId1, nil)
+	result, err = ss.Team().GetActiveMemberCount(context.Background(), teamId1, nil)
 	require.Nil(t, err)
 	require.Equal(t, 1, int(result), "wrong count")
 
-	m3 := &model.TeamMember{TeamId: teamId1, UserId: model.NewId()}
-	_, err = ss.Team().SaveMember(m3, -1)
+	m3 := &model.TeamMember{TeamId: teamId1, UserId: makeUser(t, ss).Id}
+	_, nErr = ss.Team().SaveMember(context.Background(), m3, -1)
+	require.Nil(t, nErr)
+
+	totalMemberCount, err = ss.Team().GetTotalMemberCount(context.Background(), teamId1, nil)
+	require.Nil(t, err)
+	require.Equal(t, 3, int(totalMemberCount), "wrong count")
+
+	result, err = ss.Team().GetActiveMemberCount(context.Background(), teamId1, nil)
+	require.Nil(t, err)
+	require.Equal(t, 2, int(result), "wrong count")
+
+	team, nErr = ss.Team().Get(context.Background(), teamId1)
+	require.Nil(t, nErr)
+	require.Equal(t, int64(3), team.MemberCount, "the team should keep its member count")
+
+	// Leaving the team sets DeleteAt
+	m1.DeleteAt = model.GetMillis()
+	_, err = ss.Team().UpdateMember(context.Background(), m1)
 	require.Nil(t, err)
 
-	totalMemberCount, err = ss.Team().GetTotalMemberCount(teamId1, nil)
+	totalMemberCount, err = ss.Team().GetTotalMemberCount(context.Background(), teamId1, nil)
 	require.Nil(t, err)
 	require.Equal(t, 2, int(totalMemberCount), "wrong count")
 
-	result, err = ss.Team().GetActiveMemberCount(teamId1, nil)
+	// Updating the team keeps the count
+	team.MemberCount = 10
+	_, nErr = ss.Team().Update(context.Background(), team)
+	require.Nil(t, nErr)
+
+	// Updating a team read before a member joined counts the new member
+	team, nErr = ss.Team().Get(context.Background(), teamId1)
+	require.Nil(t, nErr)
+	require.Equal(t, int64(2), team.MemberCount)
+
+	m4 := &model.TeamMember{TeamId: teamId1, UserId: makeUser(t, ss).Id}
+	_, nErr = ss.Team().SaveMember(context.Background(), m4, -1)
+	require.Nil(t, nErr)
+
+	team.DisplayName = "NewDisplayName"
+	team, nErr = ss.Team().Update(context.Background(), team)
+	require.Nil(t, nErr)
+	assert.Equal(t, int64(3), team.MemberCount)
+
+	team, nErr = ss.Team().Get(context.Background(), teamId1)
+	require.Nil(t, nErr)
+	assert.Equal(t, int64(3), team.MemberCount)
+
+	err = ss.Team().RemoveMember(context.Background(), teamId1, m4.UserId)
+	require.Nil(t, err)
+
+	totalMemberCount, err = ss.Team().GetTotalMemberCount(context.Background(), teamId1, nil)
+	require.Nil(t, err)
+	require.Equal(t, 2, int(totalMemberCount), "wrong count")
+
+	err = ss.Team().RemoveMember(context.Background(), teamId1, u2.Id)
+	require.Nil(t, err)
+
+	totalMemberCount, err = ss.Team().GetTotalMemberCount(context.Background(), teamId1, nil)
+	require.Nil(t, err)
+	require.Equal(t, 1, int(totalMemberCount), "wrong count")
+}
+
+func testGetUnreadsForAllTeams(t *testing.T, ss store.Store) {
+	teamId1 := makeTeam(t, ss).Id
+	teamId2 := makeTeam(t, ss).Id
+
+	uid := makeUser(t, ss).Id
+	otherUid := makeUser(t, ss).Id
+	for _, teamId := range []string{teamId1, teamId2} {
+		_, err := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: uid}, -1)
+		require.Nil(t, err)
+		require.Nil(t, ss.Channel().CreateInitialSidebarCategories(uid, teamId))
+	}
+
+	saveChannel := func(teamId, channelType string, totalMsgCount, msgCount, mentionCount int64, notifyProps model.StringMap) *model.Channel {
+		channel, nErr := ss.Channel().Save(&model.Channel{TeamId: teamId, Name: model.NewId(), DisplayName: "Channel", Type: channelType, TotalMsgCount: totalMsgCount}, -1)
+		require.Nil(t, nErr)
+		_, err := ss.Channel().SaveMember(&model.ChannelMember{ChannelId: channel.Id, UserId: uid, NotifyProps: notifyProps, MsgCount: msgCount, MentionCount: mentionCount})
+		require.Nil(t, err)
+		return channel
+	}
+
+	mentionsOnly := model.GetDefaultChannelNotifyProps()
+	mentionsOnly[model.MARK_UNREAD_NOTIFY_PROP] = model.CHANNEL_MARK_UNREAD_MENTION
+
+	saveChannel(teamId1, model.CHANNEL_OPEN, 100, 90, 2, model.GetDefaultChannelNotifyProps())
+	saveChannel(teamId1, model.CHANNEL_PRIVATE, 50, 40, 1, mentionsOnly)
+	customChannel := saveChannel(teamId1, model.CHANNEL_OPEN, 20, 15, 0, model.GetDefaultChannelNotifyProps())
+	saveChannel(teamId2, model.CHANNEL_OPEN, 10, 5, 0, model.GetDefaultChannelNotifyProps())
+
+	dm := &model.Channel{Name: model.GetDMNameFromIds(uid, otherUid), DisplayName: "DM", Type: model.CHANNEL_DIRECT, TotalMsgCount: 8}
+	_, nErr := ss.Channel().SaveDirectChannel(dm,
+		&model.ChannelMember{UserId: uid, NotifyProps: model.GetDefaultChannelNotifyProps(), MsgCount: 3, MentionCount: 3},
+		&model.ChannelMember{UserId: otherUid, NotifyProps: model.GetDefaultChannelNotifyProps()},
+	)
+	require.Nil(t, nErr)
+
+	customCategory, err := ss.Channel().CreateSidebarCategory(uid, teamId1, &model.SidebarCategoryWithChannels{
+		SidebarCategory: model.SidebarCategory{DisplayName: "custom"},
+		Channels:        []string{customChannel.Id},
+	})
+	require.Nil(t, err)
+
+	categoryIdsByType := func(teamId string) map[model.SidebarCategoryType]string {
+		categories, appErr := ss.Channel().GetSidebarCategories(uid, teamId)
+		require.Nil(t, appErr)
+		ids := make(map[model.SidebarCategoryType]string)
+		for _, category := range categories.Categories {
+			ids[category.Type] = category.Id
+		}
+		return ids
+	}
+	team1Categories := categoryIdsByType(teamId1)
+	team2Categories := categoryIdsByType(teamId2)
+
+	unreads, err := ss.Team().GetUnreadsForAllTeams(context.Background(), "", uid)
+	require.Nil(t, err)
+	require.Len(t, unreads, 2)
+
+	unreadsByTeam := make(map[string]*model.TeamUnread)
+	for _, unread := range unreads {
+		unreadsByTeam[unread.TeamId] = unread
+	}
+
+	team1 := unreadsByTeam[teamId1]
+	require.NotNil(t, team1)
+	assert.Equal(t, int64(15), team1.MsgCount, "muted channels shouldn't count towards messages")
+	assert.Equal(t, int64(3), team1.MentionCount, "direct messages shouldn't count towards the team")
+	assert.ElementsMatch(t, []*model.SidebarCategoryUnread{
+		{CategoryId: team1Categories[model.SidebarCategoryChannels], MsgCount: 10, MentionCount: 3},
+		{CategoryId: customCategory.Id, MsgCount: 5, MentionCount: 0},
+		{CategoryId: team1Categories[model.SidebarCategoryDirectMessages], MsgCount: 5, MentionCount: 3},
+	}, team1.Categories)
+
+	team2 := unreadsByTeam[teamId2]
+	require.NotNil(t, team2)
+	assert.Equal(t, int64(5), team2.MsgCount)
+	assert.Equal(t, int64(0), team2.MentionCount)
+	assert.ElementsMatch(t, []*model.SidebarCategoryUnread{
+		{CategoryId: team2Categories[model.SidebarCategoryChannels], MsgCount: 5, MentionCount: 0},
+		{CategoryId: team2Categories[model.SidebarCategoryDirectMessages], MsgCount: 5, MentionCount: 3},
+	}, team2.Categories)
+
+	unreads, err = ss.Team().GetUnreadsForAllTeams(context.Background(), teamId1, uid)
+	require.Nil(t, err)
+	require.Len(t, unreads, 1)
+	assert.Equal(t, teamId2, unreads[0].TeamId)
+
+	_, err = ss.Team().RemoveAllMembersByUser(context.Background(), uid)
 	require.Nil(t, err)
-	require.Equal(t, 1, int(result), "wrong count")
 }
 
 func testGetChannelUnreadsForAllTeams(t *testing.T, ss store.Store) {
-	teamId1 := model.NewId()
-	teamId2 := model.NewId()
+	teamId1 := makeTeam(t, ss).Id
+	teamId2 := makeTeam(t, ss).Id
 
-	uid := model.NewId()
+	uid := makeUser(t, ss).Id
 	m1 := &model.TeamMember{TeamId: teamId1, UserId: uid}
 	m2 := &model.TeamMember{TeamId: teamId2, UserId: uid}
-	_, err := ss.Team().SaveMember(m1, -1)
+	_, err := ss.Team().SaveMember(context.Background(), m1, -1)
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(m2, -1)
+	_, err = ss.Team().SaveMember(context.Background(), m2, -1)
 	require.Nil(t, err)
 
-	c1 := &model.Channel{TeamId: m1.TeamId, Name: model.NewId(), DisplayName: "Town Square", Type: model.CHANNEL_OPEN, TotalMsgCount: 100}
+	c1 := &model.Channel{TeamId: m1.TeamId, Name: model.NewId(), DisplayName: "Town Square", Type: model.CHANNEL_OPEN, TotalMsgCount: 100, TotalMsgCountRoot: 40}
 	_, nErr := ss.Channel().Save(c1, -1)
 	require.Nil(t, nErr)
 
-	c2 := &model.Channel{TeamId: m2.TeamId, Name: model.NewId(), DisplayName: "Town Square", Type: model.CHANNEL_OPEN, TotalMsgCount: 100}
+	c2 := &model.Channel{TeamId: m2.TeamId, Name: model.NewId(), DisplayName: "Town Square", Type: model.CHANNEL_OPEN, TotalMsgCount: 100, TotalMsgCountRoot: 40}
 	_, nErr = ss.Channel().Save(c2, -1)
 	require.Nil(t, nErr)
 
-	cm1 := &model.ChannelMember{ChannelId: c1.Id, UserId: m1.UserId, NotifyProps: model.GetDefaultChannelNotifyProps(), MsgCount: 90}
+	cm1 := &model.ChannelMember{ChannelId: c1.Id, UserId: m1.UserId, NotifyProps: model.GetDefaultChannelNotifyProps(), MsgCount: 90, MsgCountRoot: 35}
 	_, err = ss.Channel().SaveMember(cm1)
 	require.Nil(t, err)
-	cm2 := &model.ChannelMember{ChannelId: c2.Id, UserId: m2.UserId, NotifyProps: model.GetDefaultChannelNotifyProps(), MsgCount: 90}
+	cm2 := &model.ChannelMember{ChannelId: c2.Id, UserId: m2.UserId, NotifyProps: model.GetDefaultChannelNotifyProps(), MsgCount: 90, MsgCountRoot: 38}
 	_, err = ss.Channel().SaveMember(cm2)
 	require.Nil(t, err)
 
-	ms1, err := ss.Team().GetChannelUnreadsForAllTeams("", uid)
+	ms1, err := ss.Team().GetChannelUnreadsForAllTeams(context.Background(), "", uid)
 	require.Nil(t, err)
 	membersMap := make(map[string]bool)
 	for i := range ms1 {
@@ -2955,7 +4109,7 @@ func testGetChannelUnreadsForAllTeams(t *testing.T, ss store.Store) {
 
 	require.Equal(t, 10, int(ms1[0].MsgCount), "subtraction failed")
 
-	ms2, err := ss.Team().GetChannelUnreadsForAllTeams(teamId1, uid)
+	ms2, err := ss.Team().GetChannelUnreadsForAllTeams(context.Background(), teamId1, uid)
 	require.Nil(t, err)
 	membersMap = make(map[string]bool)
 	for i := range ms2 {
@@ -2968,17 +4122,60 @@ func testGetChannelUnreadsForAllTeams(t *testing.T, ss store.Store) {
 	require.Len(t, membersMap, 1, "Should be the unreads for just one team")
 
 	require.Equal(t, 10, int(ms2[0].MsgCount), "subtraction failed")
+	require.Equal(t, c2.Id, ms2[0].ChannelId)
+	require.Equal(t, int64(2), ms2[0].MsgCountRoot)
+
+	t.Run("counts the unread root posts and urgent mentions", func(t *testing.T) {
+		root, nErr := ss.Post().Save(&model.Post{ChannelId: c1.Id, UserId: model.NewId(), Message: "root"})
+		require.Nil(t, nErr)
+		_, nErr = ss.Post().Save(&model.Post{ChannelId: c1.Id, UserId: model.NewId(), Message: "reply", RootId: root.Id, ParentId: root.Id})
+		require.Nil(t, nErr)
+
+		err = ss.Channel().IncrementMentionCount(c1.Id, uid, true)
+		require.Nil(t, err)
+		err = ss.Channel().IncrementMentionCount(c1.Id, uid, false)
+		require.Nil(t, err)
 
-	err = ss.Team().RemoveAllMembersByUser(uid)
+		ms, err := ss.Team().GetChannelUnreadsForAllTeams(context.Background(), "", uid)
+		require.Nil(t, err)
+		require.Len(t, ms, 2)
+		for _, unread := range ms {
+			switch unread.ChannelId {
+			case c1.Id:
+				require.Equal(t, int64(12), unread.MsgCount)
+				require.Equal(t, int64(6), unread.MsgCountRoot)
+				require.Equal(t, int64(2), unread.MentionCount)
+				require.Equal(t, int64(1), unread.UrgentMentionCount)
+			case c2.Id:
+				require.Equal(t, int64(10), unread.MsgCount)
+				require.Equal(t, int64(2), unread.MsgCountRoot)
+				require.Equal(t, int64(0), unread.MentionCount)
+				require.Equal(t, int64(0), unread.UrgentMentionCount)
+			default:
+				require.Fail(t, "unexpected channel", unread.ChannelId)
+			}
+		}
+
+		_, err = ss.Channel().UpdateLastViewedAt([]string{c1.Id}, uid)
+		require.Nil(t, err)
+
+		ms, err = ss.Team().GetChannelUnreadsForAllTeams(context.Background(), teamId2, uid)
+		require.Nil(t, err)
+		require.Len(t, ms, 1)
+		require.Equal(t, int64(0), ms[0].MsgCountRoot)
+		require.Equal(t, int64(0), ms[0].UrgentMentionCount)
+	})
+
+	_, err = ss.Team().RemoveAllMembersByUser(context.Background(), uid)
 	require.Nil(t, err)
 }
 
 func testGetChannelUnreadsForTeam(t *testing.T, ss store.Store) {
-	teamId1 := model.NewId()
+	teamId1 := makeTeam(t, ss).Id
 
-	uid := model.NewId()
+	uid := makeUser(t, ss).Id
 	m1 := &model.TeamMember{TeamId: teamId1, UserId: uid}
-	_, err := ss.Team().SaveMember(m1, -1)
+	_, err := ss.Team().SaveMember(context.Background(), m1, -1)
 	require.Nil(t, err)
 
 	c1 := &model.Channel{TeamId: m1.TeamId, Name: model.NewId(), DisplayName: "Town Square", Type: model.CHANNEL_OPEN, TotalMsgCount: 100}
@@ -2996,11 +4193,35 @@ func testGetChannelUnreadsForTeam(t *testing.T, ss store.Store) {
 	_, err = ss.Channel().SaveMember(cm2)
 	require.Nil(t, err)
 
-	ms, err := ss.Team().GetChannelUnreadsForTeam(m1.TeamId, m1.UserId)
+	ms, err := ss.Team().GetChannelUnreadsForTeam(context.Background(), m1.TeamId, m1.UserId)
 	require.Nil(t, err)
 	require.Len(t, ms, 2, "wrong length")
 
 	require.Equal(t, 10, int(ms[0].MsgCount), "subtraction failed")
+
+	t.Run("counts the unread root posts and urgent mentions", func(t *testing.T) {
+		root, nErr := ss.Post().Save(&model.Post{ChannelId: c1.Id, UserId: model.NewId(), Message: "root"})
+		require.Nil(t, nErr)
+		_, nErr = ss.Post().Save(&model.Post{ChannelId: c1.Id, UserId: model.NewId(), Message: "reply", RootId: root.Id, ParentId: root.Id})
+		require.Nil(t, nErr)
+		_, nErr = ss.Post().Save(&model.Post{ChannelId: c1.Id, UserId: model.NewId(), Message: "another root"})
+		require.Nil(t, nErr)
+
+		err = ss.Channel().IncrementMentionCount(c1.Id, m1.UserId, true)
+		require.Nil(t, err)
+
+		ms, err := ss.Team().GetChannelUnreadsForTeam(context.Background(), m1.TeamId, m1.UserId)
+		require.Nil(t, err)
+		for _, unread := range ms {
+			if unread.ChannelId == c1.Id {
+				require.Equal(t, int64(2), unread.MsgCountRoot)
+				require.Equal(t, int64(1), unread.UrgentMentionCount)
+			} else {
+				require.Equal(t, int64(0), unread.MsgCountRoot)
+				require.Equal(t, int64(0), unread.UrgentMentionCount)
+			}
+		}
+	})
 }
 
 func testUpdateLastTeamIconUpdate(t *testing.T, ss store.Store) {
@@ -3014,15 +4235,15 @@ func testUpdateLastTeamIconUpdate(t *testing.T, ss store.Store) {
 	o1.Email = MakeEmail()
 	o1.Type = model.TEAM_OPEN
 	o1.LastTeamIconUpdate = lastTeamIconUpdateInitial
-	o1, err := ss.Team().Save(o1)
+	o1, err := ss.Team().Save(context.Background(), o1)
 	require.Nil(t, err)
 
 	curTime := model.GetMillis()
 
-	err = ss.Team().UpdateLastTeamIconUpdate(o1.Id, curTime)
+	err = ss.Team().UpdateLastTeamIconUpdate(context.Background(), o1.Id, curTime)
 	require.Nil(t, err)
 
-	ro1, err := ss.Team().Get(o1.Id)
+	ro1, err := ss.Team().Get(context.Background(), o1.Id)
 	require.Nil(t, err)
 
 	require.Greater(t, ro1.LastTeamIconUpdate, lastTeamIconUpdateInitial, "LastTeamIconUpdate not updated")
@@ -3073,27 +4294,27 @@ func testGetTeamsByScheme(t *testing.T, ss store.Store) {
 		Type:        model.TEAM_OPEN,
 	}
 
-	_, err = ss.Team().Save(t1)
+	_, err = ss.Team().Save(context.Background(), t1)
 	require.Nil(t, err)
 
-	_, err = ss.Team().Save(t2)
+	_, err = ss.Team().Save(context.Background(), t2)
 	require.Nil(t, err)
 
-	_, err = ss.Team().Save(t3)
+	_, err = ss.Team().Save(context.Background(), t3)
 	require.Nil(t, err)
 
 	// Get the teams by a valid Scheme ID.
-	d, err := ss.Team().GetTeamsByScheme(s1.Id, 0, 100)
+	d, err := ss.Team().GetTeamsByScheme(context.Background(), s1.Id, 0, 100)
 	assert.Nil(t, err)
 	assert.Len(t, d, 2)
 
 	// Get the teams by a valid Scheme ID where there aren't any matching Teams.
-	d, err = ss.Team().GetTeamsByScheme(s2.Id, 0, 100)
+	d, err = ss.Team().GetTeamsByScheme(context.Background(), s2.Id, 0, 100)
 	assert.Nil(t, err)
 	assert.Empty(t, d)
 
 	// Get the teams by an invalid Scheme ID.
-	d, err = ss.Team().GetTeamsByScheme(model.NewId(), 0, 100)
+	d, err = ss.Team().GetTeamsByScheme(context.Background(), model.NewId(), 0, 100)
 	assert.Nil(t, err)
 	assert.Empty(t, d)
 }
@@ -3108,27 +4329,27 @@ func testTeamStoreMigrateTeamMembers(t *testing.T, ss store.Store) {
 		InviteId:    model.NewId(),
 		SchemeId:    &s1,
 	}
-	t1, err := ss.Team().Save(t1)
+	t1, err := ss.Team().Save(context.Background(), t1)
 	require.Nil(t, err)
 
 	tm1 := &model.TeamMember{
 		TeamId:        t1.Id,
-		UserId:        model.NewId(),
+		UserId:        makeUser(t, ss).Id,
 		ExplicitRoles: "team_admin team_user",
 	}
 	tm2 := &model.TeamMember{
 		TeamId:        t1.Id,
-		UserId:        model.NewId(),
+		UserId:        makeUser(t, ss).Id,
 		ExplicitRoles: "team_user",
 	}
 	tm3 := &model.TeamMember{
 		TeamId:        t1.Id,
-		UserId:        model.NewId(),
+		UserId:        makeUser(t, ss).Id,
 		ExplicitRoles: "something_else",
 	}
 
-	memberships, err := ss.Team().SaveMultipleMembers([]*model.TeamMember{tm1, tm2, tm3}, -1)
-	require.Nil(t, err)
+	memberships, nErr := ss.Team().SaveMultipleMembers(context.Background(), []*model.TeamMember{tm1, tm2, tm3}, -1)
+	require.Nil(t, nErr)
 	require.Len(t, memberships, 3)
 	tm1 = memberships[0]
 	tm2 = memberships[1]
@@ -3137,30 +4358,34 @@ func testTeamStoreMigrateTeamMembers(t *testing.T, ss store.Store) {
 	lastDoneTeamId := strings.Repeat("0", 26)
 	lastDoneUserId := strings.Repeat("0", 26)
 
+	var processed int64
 	for {
-		res, e := ss.Team().MigrateTeamMembers(lastDoneTeamId, lastDoneUserId)
-		if assert.Nil(t, e) {
-			if res == nil {
-				break
-			}
-			lastDoneTeamId = res["TeamId"]
-			lastDoneUserId = res["UserId"]
+		res, count, e := ss.Team().MigrateTeamMembers(context.Background(), lastDoneTeamId, lastDoneUserId, 2)
+		require.Nil(t, e)
+		if res == nil {
+			assert.Zero(t, count)
+			break
 		}
+		assert.True(t, count > 0 && count <= 2, "batches should hold between 1 and 2 team members, got %d", count)
+		processed += count
+		lastDoneTeamId = res["TeamId"]
+		lastDoneUserId = res["UserId"]
 	}
+	assert.GreaterOrEqual(t, processed, int64(3))
 
-	tm1b, err := ss.Team().GetMember(tm1.TeamId, tm1.UserId)
+	tm1b, err := ss.Team().GetMember(context.Background(), tm1.TeamId, tm1.UserId, false)
 	assert.Nil(t, err)
 	assert.Equal(t, "", tm1b.ExplicitRoles)
 	assert.True(t, tm1b.SchemeUser)
 	assert.True(t, tm1b.SchemeAdmin)
 
-	tm2b, err := ss.Team().GetMember(tm2.TeamId, tm2.UserId)
+	tm2b, err := ss.Team().GetMember(context.Background(), tm2.TeamId, tm2.UserId, false)
 	assert.Nil(t, err)
 	assert.Equal(t, "", tm2b.ExplicitRoles)
 	assert.True(t, tm2b.SchemeUser)
 	assert.False(t, tm2b.SchemeAdmin)
 
-	tm3b, err := ss.Team().GetMember(tm3.TeamId, tm3.UserId)
+	tm3b, err := ss.Team().GetMember(context.Background(), tm3.TeamId, tm3.UserId, false)
 	assert.Nil(t, err)
 	assert.Equal(t, "something_else", tm3b.ExplicitRoles)
 	assert.False(t, tm3b.SchemeUser)
@@ -3193,21 +4418,21 @@ func testResetAllTeamSchemes(t *testing.T, ss store.Store) {
 		SchemeId:    &s1.Id,
 	}
 
-	t1, err = ss.Team().Save(t1)
+	t1, err = ss.Team().Save(context.Background(), t1)
 	require.Nil(t, err)
-	t2, err = ss.Team().Save(t2)
+	t2, err = ss.Team().Save(context.Background(), t2)
 	require.Nil(t, err)
 
 	assert.Equal(t, s1.Id, *t1.SchemeId)
 	assert.Equal(t, s1.Id, *t2.SchemeId)
 
-	res := ss.Team().ResetAllTeamSchemes()
+	res := ss.Team().ResetAllTeamSchemes(context.Background())
 	assert.Nil(t, res)
 
-	t1, err = ss.Team().Get(t1.Id)
+	t1, err = ss.Team().Get(context.Background(), t1.Id)
 	require.Nil(t, err)
 
-	t2, err = ss.Team().Get(t2.Id)
+	t2, err = ss.Team().Get(context.Background(), t2.Id)
 	require.Nil(t, err)
 
 	assert.Equal(t, "", *t1.SchemeId)
@@ -3216,44 +4441,44 @@ func testResetAllTeamSchemes(t *testing.T, ss store.Store) {
 
 func testTeamStoreClearAllCustomRoleAssignments(t *testing.T, ss store.Store) {
 	m1 := &model.TeamMember{
-		TeamId:        model.NewId(),
-		UserId:        model.NewId(),
+		TeamId:        makeTeam(t, ss).Id,
+		UserId:        makeUser(t, ss).Id,
 		ExplicitRoles: "team_post_all_public team_user team_admin",
 	}
 	m2 := &model.TeamMember{
-		TeamId:        model.NewId(),
-		UserId:        model.NewId(),
+		TeamId:        makeTeam(t, ss).Id,
+		UserId:        makeUser(t, ss).Id,
 		ExplicitRoles: "team_user custom_role team_admin another_custom_role",
 	}
 	m3 := &model.TeamMember{
-		TeamId:        model.NewId(),
-		UserId:        model.NewId(),
+		TeamId:        makeTeam(t, ss).Id,
+		UserId:        makeUser(t, ss).Id,
 		ExplicitRoles: "team_user",
 	}
 	m4 := &model.TeamMember{
-		TeamId:        model.NewId(),
-		UserId:        model.NewId(),
+		TeamId:        makeTeam(t, ss).Id,
+		UserId:        makeUser(t, ss).Id,
 		ExplicitRoles: "custom_only",
 	}
 
-	_, err := ss.Team().SaveMultipleMembers([]*model.TeamMember{m1, m2, m3, m4}, -1)
+	_, err := ss.Team().SaveMultipleMembers(context.Background(), []*model.TeamMember{m1, m2, m3, m4}, -1)
 	require.Nil(t, err)
 
-	require.Nil(t, (ss.Team().ClearAllCustomRoleAssignments()))
+	require.Nil(t, (ss.Team().ClearAllCustomRoleAssignments(context.Background())))
 
-	r1, err := ss.Team().GetMember(m1.TeamId, m1.UserId)
+	r1, err := ss.Team().GetMember(context.Background(), m1.TeamId, m1.UserId, false)
 	require.Nil(t, err)
 	assert.Equal(t, m1.ExplicitRoles, r1.Roles)
 
-	r2, err := ss.Team().GetMember(m2.TeamId, m2.UserId)
+	r2, err := ss.Team().GetMember(context.Background(), m2.TeamId, m2.UserId, false)
 	require.Nil(t, err)
 	assert.Equal(t, "team_user team_admin", r2.Roles)
 
-	r3, err := ss.Team().GetMember(m3.TeamId, m3.UserId)
+	r3, err := ss.Team().GetMember(context.Background(), m3.TeamId, m3.UserId, false)
 	require.Nil(t, err)
 	assert.Equal(t, m3.ExplicitRoles, r3.Roles)
 
-	r4, err := ss.Team().GetMember(m4.TeamId, m4.UserId)
+	r4, err := ss.Team().GetMember(context.Background(), m4.TeamId, m4.UserId, false)
 	require.Nil(t, err)
 	assert.Equal(t, "", r4.Roles)
 }
@@ -3268,7 +4493,7 @@ func testTeamStoreAnalyticsGetTeamCountForScheme(t *testing.T, ss store.Store) {
 	s1, err := ss.Scheme().Save(s1)
 	require.Nil(t, err)
 
-	count1, err := ss.Team().AnalyticsGetTeamCountForScheme(s1.Id)
+	count1, err := ss.Team().AnalyticsGetTeamCountForScheme(context.Background(), s1.Id)
 	assert.Nil(t, err)
 	assert.Equal(t, int64(0), count1)
 
@@ -3279,10 +4504,10 @@ func testTeamStoreAnalyticsGetTeamCountForScheme(t *testing.T, ss store.Store) {
 		Type:        model.TEAM_OPEN,
 		SchemeId:    &s1.Id,
 	}
-	_, err = ss.Team().Save(t1)
+	_, err = ss.Team().Save(context.Background(), t1)
 	require.Nil(t, err)
 
-	count2, err := ss.Team().AnalyticsGetTeamCountForScheme(s1.Id)
+	count2, err := ss.Team().AnalyticsGetTeamCountForScheme(context.Background(), s1.Id)
 	assert.Nil(t, err)
 	assert.Equal(t, int64(1), count2)
 
@@ -3293,10 +4518,10 @@ func testTeamStoreAnalyticsGetTeamCountForScheme(t *testing.T, ss store.Store) {
 		Type:        model.TEAM_OPEN,
 		SchemeId:    &s1.Id,
 	}
-	_, err = ss.Team().Save(t2)
+	_, err = ss.Team().Save(context.Background(), t2)
 	require.Nil(t, err)
 
-	count3, err := ss.Team().AnalyticsGetTeamCountForScheme(s1.Id)
+	count3, err := ss.Team().AnalyticsGetTeamCountForScheme(context.Background(), s1.Id)
 	assert.Nil(t, err)
 	assert.Equal(t, int64(2), count3)
 
@@ -3306,10 +4531,10 @@ func testTeamStoreAnalyticsGetTeamCountForScheme(t *testing.T, ss store.Store) {
 		Email:       MakeEmail(),
 		Type:        model.TEAM_OPEN,
 	}
-	_, err = ss.Team().Save(t3)
+	_, err = ss.Team().Save(context.Background(), t3)
 	require.Nil(t, err)
 
-	count4, err := ss.Team().AnalyticsGetTeamCountForScheme(s1.Id)
+	count4, err := ss.Team().AnalyticsGetTeamCountForScheme(context.Background(), s1.Id)
 	assert.Nil(t, err)
 	assert.Equal(t, int64(2), count4)
 
@@ -3321,10 +4546,10 @@ func testTeamStoreAnalyticsGetTeamCountForScheme(t *testing.T, ss store.Store) {
 		SchemeId:    &s1.Id,
 		DeleteAt:    model.GetMillis(),
 	}
-	_, err = ss.Team().Save(t4)
+	_, err = ss.Team().Save(context.Background(), t4)
 	require.Nil(t, err)
 
-	count5, err := ss.Team().AnalyticsGetTeamCountForScheme(s1.Id)
+	count5, err := ss.Team().AnalyticsGetTeamCountForScheme(context.Background(), s1.Id)
 	assert.Nil(t, err)
 	assert.Equal(t, int64(2), count5)
 }
@@ -3335,10 +4560,10 @@ func testTeamStoreGetAllForExportAfter(t *testing.T, ss store.Store) {
 	t1.Name = "zz" + model.NewId()
 	t1.Email = MakeEmail()
 	t1.Type = model.TEAM_OPEN
-	_, err := ss.Team().Save(&t1)
+	_, err := ss.Team().Save(context.Background(), &t1)
 	require.Nil(t, err)
 
-	d1, err := ss.Team().GetAllForExportAfter(10000, strings.Repeat("0", 26))
+	d1, err := ss.Team().GetAllForExportAfter(context.Background(), 10000, strings.Repeat("0", 26))
 	assert.Nil(t, err)
 
 	found := false
@@ -3359,7 +4584,7 @@ func testTeamStoreGetTeamMembersForExport(t *testing.T, ss store.Store) {
 	t1.Name = "zz" + model.NewId()
 	t1.Email = MakeEmail()
 	t1.Type = model.TEAM_OPEN
-	_, err := ss.Team().Save(&t1)
+	_, err := ss.Team().Save(context.Background(), &t1)
 	require.Nil(t, err)
 
 	u1 := model.User{}
@@ -3376,10 +4601,10 @@ func testTeamStoreGetTeamMembersForExport(t *testing.T, ss store.Store) {
 
 	m1 := &model.TeamMember{TeamId: t1.Id, UserId: u1.Id}
 	m2 := &model.TeamMember{TeamId: t1.Id, UserId: u2.Id}
-	_, err = ss.Team().SaveMultipleMembers([]*model.TeamMember{m1, m2}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMultipleMembers(context.Background(), []*model.TeamMember{m1, m2}, -1)
+	require.Nil(t, nErr)
 
-	d1, err := ss.Team().GetTeamMembersForExport(u1.Id)
+	d1, err := ss.Team().GetTeamMembersForExport(context.Background(), u1.Id)
 	assert.Nil(t, err)
 
 	assert.Len(t, d1, 1)
@@ -3391,7 +4616,7 @@ func testTeamStoreGetTeamMembersForExport(t *testing.T, ss store.Store) {
 }
 
 func testGroupSyncedTeamCount(t *testing.T, ss store.Store) {
-	team1, err := ss.Team().Save(&model.Team{
+	team1, err := ss.Team().Save(context.Background(), &model.Team{
 		DisplayName:      model.NewId(),
 		Name:             "zz" + model.NewId(),
 		Email:            MakeEmail(),
@@ -3400,9 +4625,9 @@ func testGroupSyncedTeamCount(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	require.True(t, team1.IsGroupConstrained())
-	defer ss.Team().PermanentDelete(team1.Id)
+	defer ss.Team().PermanentDelete(context.Background(), team1.Id)
 
-	team2, err := ss.Team().Save(&model.Team{
+	team2, err := ss.Team().Save(context.Background(), &model.Team{
 		DisplayName: model.NewId(),
 		Name:        "zz" + model.NewId(),
 		Email:       MakeEmail(),
@@ -3410,18 +4635,336 @@ func testGroupSyncedTeamCount(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	require.False(t, team2.IsGroupConstrained())
-	defer ss.Team().PermanentDelete(team2.Id)
+	defer ss.Team().PermanentDelete(context.Background(), team2.Id)
 
-	count, err := ss.Team().GroupSyncedTeamCount()
+	count, err := ss.Team().GroupSyncedTeamCount(context.Background())
 	require.Nil(t, err)
 	require.GreaterOrEqual(t, count, int64(1))
 
 	team2.GroupConstrained = model.NewBool(true)
-	team2, err = ss.Team().Update(team2)
+	team2, err = ss.Team().Update(context.Background(), team2)
 	require.Nil(t, err)
 	require.True(t, team2.IsGroupConstrained())
 
-	countAfter, err := ss.Team().GroupSyncedTeamCount()
+	countAfter, err := ss.Team().GroupSyncedTeamCount(context.Background())
 	require.Nil(t, err)
 	require.GreaterOrEqual(t, countAfter, count+1)
 }
+
+func saveTestGroupSyncedTeam(t *testing.T, ss store.Store, groupConstrained bool) *model.Team {
+	team, err := ss.Team().Save(context.Background(), &model.Team{
+		DisplayName:      model.NewId(),
+		Name:             "zz" + model.NewId(),
+		Email:            MakeEmail(),
+		Type:             model.TEAM_INVITE,
+		GroupConstrained: model.NewBool(groupConstrained),
+	})
+	require.Nil(t, err)
+	return team
+}
+
+func testGetGroupSyncedTeamsPage(t *testing.T, ss store.Store) {
+	team1 := saveTestGroupSyncedTeam(t, ss, true)
+	defer ss.Team().PermanentDelete(context.Background(), team1.Id)
+	team2 := saveTestGroupSyncedTeam(t, ss, true)
+	defer ss.Team().PermanentDelete(context.Background(), team2.Id)
+	team3 := saveTestGroupSyncedTeam(t, ss, false)
+	defer ss.Team().PermanentDelete(context.Background(), team3.Id)
+	team4 := saveTestGroupSyncedTeam(t, ss, true)
+	defer ss.Team().PermanentDelete(context.Background(), team4.Id)
+	team4.DeleteAt = model.GetMillis()
+	_, err := ss.Team().Update(context.Background(), team4)
+	require.Nil(t, err)
+
+	count, err := ss.Team().GroupSyncedTeamCount(context.Background())
+	require.Nil(t, err)
+
+	seen := map[string]bool{}
+	lastId := ""
+	for offset := 0; ; offset += 2 {
+		teams, err := ss.Team().GetGroupSyncedTeamsPage(context.Background(), offset, 2)
+		require.Nil(t, err)
+		require.LessOrEqual(t, len(teams), 2)
+
+		for _, team := range teams {
+			require.True(t, team.IsGroupConstrained())
+			require.Zero(t, team.DeleteAt)
+			require.Greater(t, team.Id, lastId)
+			lastId = team.Id
+			seen[team.Id] = true
+		}
+
+		if len(teams) < 2 {
+			break
+		}
+	}
+
+	assert.Len(t, seen, int(count))
+	assert.True(t, seen[team1.Id])
+	assert.True(t, seen[team2.Id])
+	assert.False(t, seen[team3.Id])
+	assert.False(t, seen[team4.Id])
+}
+
+func testGroupSyncedTeamCountByGroup(t *testing.T, ss store.Store) {
+	group, err := ss.Group().Create(&model.Group{
+		Name:        model.NewString(model.NewId()),
+		DisplayName: model.NewId(),
+		Source:      model.GroupSourceLdap,
+		RemoteId:    model.NewId(),
+	})
+	require.Nil(t, err)
+	defer ss.Group().Delete(group.Id)
+
+	count, err := ss.Team().GroupSyncedTeamCountByGroup(context.Background(), group.Id)
+	require.Nil(t, err)
+	require.Zero(t, count)
+
+	team1 := saveTestGroupSyncedTeam(t, ss, true)
+	defer ss.Team().PermanentDelete(context.Background(), team1.Id)
+	team2 := saveTestGroupSyncedTeam(t, ss, true)
+	defer ss.Team().PermanentDelete(context.Background(), team2.Id)
+	team3 := saveTestGroupSyncedTeam(t, ss, false)
+	defer ss.Team().PermanentDelete(context.Background(), team3.Id)
+	otherTeam := saveTestGroupSyncedTeam(t, ss, true)
+	defer ss.Team().PermanentDelete(context.Background(), otherTeam.Id)
+
+	for _, team := range []*model.Team{team1, team2, team3} {
+		_, err = ss.Group().CreateGroupSyncable(model.NewGroupTeam(group.Id, team.Id, false))
+		require.Nil(t, err)
+	}
+
+	count, err = ss.Team().GroupSyncedTeamCountByGroup(context.Background(), group.Id)
+	require.Nil(t, err)
+	require.Equal(t, int64(2), count)
+
+	_, err = ss.Group().DeleteGroupSyncable(group.Id, team2.Id, model.GroupSyncableTypeTeam)
+	require.Nil(t, err)
+
+	count, err = ss.Team().GroupSyncedTeamCountByGroup(context.Background(), group.Id)
+	require.Nil(t, err)
+	require.Equal(t, int64(1), count)
+}
+
+func testTeamStoreGetNewTeamMembersSince(t *testing.T, ss store.Store) {
+	team, err := ss.Team().Save(context.Background(), &model.Team{
+		DisplayName: "DisplayName",
+		Name:        "zz" + model.NewId(),
+		Email:       MakeEmail(),
+		Type:        model.TEAM_OPEN,
+	})
+	require.Nil(t, err)
+
+	join := func(user *model.User) *model.TeamMember {
+		member, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: team.Id, UserId: user.Id}, -1)
+		require.Nil(t, nErr)
+		time.Sleep(2 * time.Millisecond)
+		return member
+	}
+
+	saveUser := func() *model.User {
+		user, err := ss.User().Save(&model.User{Email: MakeEmail(), Username: "u" + model.NewId()})
+		require.Nil(t, err)
+		return user
+	}
+
+	oldMember := saveUser()
+	join(oldMember)
+
+	rejoinedMember := saveUser()
+	member := join(rejoinedMember)
+	member.DeleteAt = model.GetMillis()
+	_, err = ss.Team().UpdateMember(context.Background(), member)
+	require.Nil(t, err)
+	time.Sleep(2 * time.Millisecond)
+
+	since := model.GetMillis()
+
+	newMember1 := saveUser()
+	join(newMember1)
+
+	newMember2 := saveUser()
+	join(newMember2)
+
+	// rejoining the team after the given time makes the member new again
+	member.DeleteAt = 0
+	_, err = ss.Team().UpdateMember(context.Background(), member)
+	require.Nil(t, err)
+	time.Sleep(2 * time.Millisecond)
+
+	leftMember := saveUser()
+	join(leftMember)
+	require.Nil(t, ss.Team().RemoveMember(context.Background(), team.Id, leftMember.Id))
+
+	_, botUser := makeBotWithUser(t, ss, &model.Bot{Username: "bot" + model.NewId(), OwnerId: model.NewId()})
+	join(botUser)
+
+	t.Run("all new members", func(t *testing.T) {
+		list, err := ss.Team().GetNewTeamMembersSince(context.Background(), team.Id, since, 0, 10)
+		require.Nil(t, err)
+		assert.Equal(t, int64(3), list.TotalCount)
+		assert.False(t, list.HasNext)
+		require.Len(t, list.Items, 3)
+		assert.Equal(t, rejoinedMember.Id, list.Items[0].Id)
+		assert.Equal(t, rejoinedMember.Username, list.Items[0].Username)
+		assert.Equal(t, newMember2.Id, list.Items[1].Id)
+		assert.Equal(t, newMember1.Id, list.Items[2].Id)
+		assert.GreaterOrEqual(t, list.Items[2].JoinedAt, since)
+		assert.Greater(t, list.Items[0].JoinedAt, list.Items[1].JoinedAt)
+	})
+
+	t.Run("paged", func(t *testing.T) {
+		list, err := ss.Team().GetNewTeamMembersSince(context.Background(), team.Id, since, 0, 2)
+		require.Nil(t, err)
+		assert.Equal(t, int64(3), list.TotalCount)
+		assert.True(t, list.HasNext)
+		require.Len(t, list.Items, 2)
+		assert.Equal(t, rejoinedMember.Id, list.Items[0].Id)
+		assert.Equal(t, newMember2.Id, list.Items[1].Id)
+
+		list, err = ss.Team().GetNewTeamMembersSince(context.Background(), team.Id, since, 2, 2)
+		require.Nil(t, err)
+		assert.False(t, list.HasNext)
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, newMember1.Id, list.Items[0].Id)
+	})
+
+	t.Run("no new members", func(t *testing.T) {
+		list, err := ss.Team().GetNewTeamMembersSince(context.Background(), team.Id, model.GetMillis()+1000, 0, 10)
+		require.Nil(t, err)
+		assert.Zero(t, list.TotalCount)
+		assert.Empty(t, list.Items)
+	})
+}
+
+func testTeamStoreGetDirectoryStats(t *testing.T, ss store.Store) {
+	saveTeam := func(allowOpenInvite bool) *model.Team {
+		team, err := ss.Team().Save(context.Background(), &model.Team{
+			DisplayName:     "DisplayName",
+			Name:            "zz" + model.NewId(),
+			Email:           MakeEmail(),
+			Type:            model.TEAM_OPEN,
+			AllowOpenInvite: allowOpenInvite,
+		})
+		require.Nil(t, err)
+		return team
+	}
+
+	openTeam := saveTeam(true)
+	inviteOnlyTeam := saveTeam(false)
+
+	townSquare, nErr := ss.Channel().Save(&model.Channel{
+		TeamId:      openTeam.Id,
+		DisplayName: "Town Square",
+		Name:        model.DEFAULT_CHANNEL,
+		Type:        model.CHANNEL_OPEN,
+	}, -1)
+	require.Nil(t, nErr)
+
+	now := model.GetMillis()
+	for i, joinTime := range []int64{now - 2*model.TEAM_DIRECTORY_RECENT_JOINS_PERIOD, now} {
+		user, err := ss.User().Save(&model.User{Email: MakeEmail(), Username: "u" + model.NewId()})
+		require.Nil(t, err)
+		_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: openTeam.Id, UserId: user.Id}, -1)
+		require.Nil(t, nErr)
+		require.Nil(t, ss.ChannelMemberHistory().LogJoinEvent(user.Id, townSquare.Id, joinTime), "join %d", i)
+	}
+
+	post, err := ss.Post().Save(&model.Post{ChannelId: townSquare.Id, UserId: model.NewId(), Message: "message", CreateAt: now})
+	require.Nil(t, err)
+
+	stats, err := ss.Team().GetDirectoryStats(context.Background(), now-model.TEAM_DIRECTORY_RECENT_JOINS_PERIOD)
+	require.Nil(t, err)
+
+	statsByTeamId := make(map[string]*model.TeamDirectoryStats, len(stats))
+	for _, teamStats := range stats {
+		statsByTeamId[teamStats.TeamId] = teamStats
+	}
+
+	require.Contains(t, statsByTeamId, openTeam.Id)
+	assert.Equal(t, int64(2), statsByTeamId[openTeam.Id].MemberCount)
+	assert.Equal(t, int64(1), statsByTeamId[openTeam.Id].RecentJoinCount)
+	assert.Equal(t, post.CreateAt, statsByTeamId[openTeam.Id].LastActivityAt)
+
+	assert.NotContains(t, statsByTeamId, inviteOnlyTeam.Id)
+}
+
+func testTeamStoreRetentionPolicy(t *testing.T, ss store.Store) {
+	saveTeam := func(displayName string) *model.Team {
+		team, err := ss.Team().Save(context.Background(), &model.Team{
+			DisplayName: displayName,
+			Name:        "zz" + model.NewId(),
+			Email:       MakeEmail(),
+			Type:        model.TEAM_OPEN,
+		})
+		require.Nil(t, err)
+		return team
+	}
+	team1 := saveTeam("b team")
+	team2 := saveTeam("a team")
+
+	policy, err := ss.RetentionPolicy().Save(newTestRetentionPolicy([]string{}, []string{}))
+	require.Nil(t, err)
+	defer ss.RetentionPolicy().Delete(policy.Id)
+
+	otherPolicy, err := ss.RetentionPolicy().Save(newTestRetentionPolicy([]string{}, []string{}))
+	require.Nil(t, err)
+	defer ss.RetentionPolicy().Delete(otherPolicy.Id)
+
+	t.Run("should not find a policy for a team without one", func(t *testing.T) {
+		_, err := ss.Team().GetPolicyForTeam(context.Background(), team1.Id)
+		var nfErr *store.ErrNotFound
+		assert.True(t, errors.As(err, &nfErr))
+	})
+
+	t.Run("should set the policy of the teams", func(t *testing.T) {
+		require.Nil(t, ss.Team().SetPolicy(context.Background(), team1.Id, policy.Id))
+		require.Nil(t, ss.Team().SetPolicy(context.Background(), team2.Id, policy.Id))
+
+		teamPolicy, err := ss.Team().GetPolicyForTeam(context.Background(), team1.Id)
+		require.Nil(t, err)
+		assert.Equal(t, policy.Id, teamPolicy.Id)
+		assert.ElementsMatch(t, []string{team1.Id, team2.Id}, teamPolicy.TeamIds)
+
+		teams, err := ss.Team().GetTeamsForPolicyPage(context.Background(), policy.Id, 0, 10)
+		require.Nil(t, err)
+		require.Len(t, teams, 2)
+		assert.Equal(t, team2.Id, teams[0].Id)
+		assert.Equal(t, team1.Id, teams[1].Id)
+
+		teams, err = ss.Team().GetTeamsForPolicyPage(context.Background(), policy.Id, 1, 10)
+		require.Nil(t, err)
+		require.Len(t, teams, 1)
+		assert.Equal(t, team1.Id, teams[0].Id)
+	})
+
+	t.Run("should replace the policy of a team", func(t *testing.T) {
+		require.Nil(t, ss.Team().SetPolicy(context.Background(), team1.Id, otherPolicy.Id))
+
+		teamPolicy, err := ss.Team().GetPolicyForTeam(context.Background(), team1.Id)
+		require.Nil(t, err)
+		assert.Equal(t, otherPolicy.Id, teamPolicy.Id)
+
+		teams, err := ss.Team().GetTeamsForPolicyPage(context.Background(), policy.Id, 0, 10)
+		require.Nil(t, err)
+		require.Len(t, teams, 1)
+		assert.Equal(t, team2.Id, teams[0].Id)
+	})
+
+	t.Run("should remove the policy of a team", func(t *testing.T) {
+		require.Nil(t, ss.Team().SetPolicy(context.Background(), team1.Id, ""))
+
+		_, err := ss.Team().GetPolicyForTeam(context.Background(), team1.Id)
+		var nfErr *store.ErrNotFound
+		assert.True(t, errors.As(err, &nfErr))
+	})
+
+	t.Run("should fail for a missing team or policy", func(t *testing.T) {
+		var nfErr *store.ErrNotFound
+		err := ss.Team().SetPolicy(context.Background(), model.NewId(), policy.Id)
+		assert.True(t, errors.As(err, &nfErr))
+
+		err = ss.Team().SetPolicy(context.Background(), team1.Id, model.NewId())
+		assert.True(t, errors.As(err, &nfErr))
+	})
+}
@@ -0,0 +1,262 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+func TestTermsOfServicePolicyStore(t *testing.T, ss store.Store) {
+	t.Run("Save", func(t *testing.T) { testTermsOfServicePolicyStoreSave(t, ss) })
+	t.Run("Update", func(t *testing.T) { testTermsOfServicePolicyStoreUpdate(t, ss) })
+	t.Run("Delete", func(t *testing.T) { testTermsOfServicePolicyStoreDelete(t, ss) })
+	t.Run("SaveVersion", func(t *testing.T) { testTermsOfServicePolicyStoreSaveVersion(t, ss) })
+	t.Run("GetPendingForUser", func(t *testing.T) { testTermsOfServicePolicyStoreGetPendingForUser(t, ss) })
+	t.Run("Outstanding", func(t *testing.T) { testTermsOfServicePolicyStoreOutstanding(t, ss) })
+}
+
+func newTestTermsOfServicePolicy(teamIds, groupIds []string) *model.TermsOfServicePolicy {
+	return &model.TermsOfServicePolicy{
+		DisplayName: "policy " + model.NewId(),
+		TeamIds:     teamIds,
+		GroupIds:    groupIds,
+	}
+}
+
+func saveTestTermsOfServicePolicyVersion(t *testing.T, ss store.Store, policyId string) *model.TermsOfServicePolicyVersion {
+	version, err := ss.TermsOfServicePolicy().SaveVersion(&model.TermsOfServicePolicyVersion{
+		PolicyId: policyId,
+		UserId:   model.NewId(),
+		Text:     "terms " + model.NewId(),
+	})
+	require.Nil(t, err)
+	return version
+}
+
+func saveTestTermsOfServicePolicyUser(t *testing.T, ss store.Store, username string) *model.User {
+	user, err := ss.User().Save(&model.User{
+		Email:    MakeEmail(),
+		Username: username + model.NewId(),
+	})
+	require.Nil(t, err)
+	return user
+}
+
+func testTermsOfServicePolicyStoreSave(t *testing.T, ss store.Store) {
+	t.Run("should save policy with its teams and groups", func(t *testing.T) {
+		saved, err := ss.TermsOfServicePolicy().Save(newTestTermsOfServicePolicy([]string{model.NewId()}, []string{model.NewId()}))
+		require.Nil(t, err)
+		assert.NotEmpty(t, saved.Id)
+		assert.NotZero(t, saved.CreateAt)
+
+		fetched, err := ss.TermsOfServicePolicy().Get(saved.Id)
+		require.Nil(t, err)
+		assert.Equal(t, saved, fetched)
+	})
+
+	t.Run("should fail to save existing policy", func(t *testing.T) {
+		policy := newTestTermsOfServicePolicy(nil, nil)
+		policy.Id = model.NewId()
+
+		_, err := ss.TermsOfServicePolicy().Save(policy)
+		var invErr *store.ErrInvalidInput
+		assert.True(t, errors.As(err, &invErr))
+	})
+
+	t.Run("should fail to save invalid policy", func(t *testing.T) {
+		policy := newTestTermsOfServicePolicy(nil, nil)
+		policy.DisplayName = ""
+
+		_, err := ss.TermsOfServicePolicy().Save(policy)
+		assert.NotNil(t, err)
+	})
+}
+
+func testTermsOfServicePolicyStoreUpdate(t *testing.T, ss store.Store) {
+	t.Run("should replace the teams and groups of the policy", func(t *testing.T) {
+		saved, err := ss.TermsOfServicePolicy().Save(newTestTermsOfServicePolicy([]string{model.NewId()}, []string{model.NewId()}))
+		require.Nil(t, err)
+
+		teamId := model.NewId()
+		saved.TeamIds = []string{teamId}
+		saved.GroupIds = nil
+		_, err = ss.TermsOfServicePolicy().Update(saved)
+		require.Nil(t, err)
+
+		fetched, err := ss.TermsOfServicePolicy().Get(saved.Id)
+		require.Nil(t, err)
+		assert.Equal(t, []string{teamId}, fetched.TeamIds)
+		assert.Empty(t, fetched.GroupIds)
+	})
+
+	t.Run("should fail to update missing policy", func(t *testing.T) {
+		policy := newTestTermsOfServicePolicy(nil, nil)
+		policy.PreSave()
+
+		_, err := ss.TermsOfServicePolicy().Update(policy)
+		var nfErr *store.ErrNotFound
+		assert.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testTermsOfServicePolicyStoreDelete(t *testing.T, ss store.Store) {
+	saved, err := ss.TermsOfServicePolicy().Save(newTestTermsOfServicePolicy(nil, nil))
+	require.Nil(t, err)
+
+	require.Nil(t, ss.TermsOfServicePolicy().Delete(saved.Id, model.GetMillis()))
+
+	_, err = ss.TermsOfServicePolicy().Get(saved.Id)
+	var nfErr *store.ErrNotFound
+	assert.True(t, errors.As(err, &nfErr))
+
+	policies, err := ss.TermsOfServicePolicy().GetAll(0, 1000)
+	require.Nil(t, err)
+	for _, policy := range policies {
+		assert.NotEqual(t, saved.Id, policy.Id)
+	}
+
+	err = ss.TermsOfServicePolicy().Delete(saved.Id, model.GetMillis())
+	assert.True(t, errors.As(err, &nfErr))
+
+	_, err = ss.TermsOfServicePolicy().SaveVersion(&model.TermsOfServicePolicyVersion{PolicyId: saved.Id, UserId: model.NewId(), Text: "terms"})
+	assert.True(t, errors.As(err, &nfErr))
+}
+
+func testTermsOfServicePolicyStoreSaveVersion(t *testing.T, ss store.Store) {
+	saved, err := ss.TermsOfServicePolicy().Save(newTestTermsOfServicePolicy(nil, nil))
+	require.Nil(t, err)
+
+	_, err = ss.TermsOfServicePolicy().GetLatestVersion(saved.Id)
+	var nfErr *store.ErrNotFound
+	assert.True(t, errors.As(err, &nfErr))
+
+	first := saveTestTermsOfServicePolicyVersion(t, ss, saved.Id)
+	assert.Equal(t, 1, first.Version)
+
+	second := saveTestTermsOfServicePolicyVersion(t, ss, saved.Id)
+	assert.Equal(t, 2, second.Version)
+
+	latest, err := ss.TermsOfServicePolicy().GetLatestVersion(saved.Id)
+	require.Nil(t, err)
+	assert.Equal(t, second, latest)
+}
+
+func testTermsOfServicePolicyStoreGetPendingForUser(t *testing.T, ss store.Store) {
+	teamId := makeTeam(t, ss).Id
+	group, appErr := ss.Group().Create(&model.Group{
+		Name:        model.NewString(model.NewId()),
+		DisplayName: model.NewId(),
+		Source:      model.GroupSourceLdap,
+		RemoteId:    model.NewId(),
+	})
+	require.Nil(t, appErr)
+
+	teamUser := saveTestTermsOfServicePolicyUser(t, ss, "team")
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: teamUser.Id}, -1)
+	require.Nil(t, nErr)
+
+	groupUser := saveTestTermsOfServicePolicyUser(t, ss, "group")
+	_, appErr = ss.Group().UpsertMember(group.Id, groupUser.Id)
+	require.Nil(t, appErr)
+
+	otherUser := saveTestTermsOfServicePolicyUser(t, ss, "other")
+
+	teamPolicy, err := ss.TermsOfServicePolicy().Save(newTestTermsOfServicePolicy([]string{teamId}, nil))
+	require.Nil(t, err)
+	groupPolicy, err := ss.TermsOfServicePolicy().Save(newTestTermsOfServicePolicy(nil, []string{group.Id}))
+	require.Nil(t, err)
+
+	t.Run("policies without versions aren't pending", func(t *testing.T) {
+		pending, err := ss.TermsOfServicePolicy().GetPendingForUser(teamUser.Id)
+		require.Nil(t, err)
+		assert.Empty(t, pending)
+	})
+
+	saveTestTermsOfServicePolicyVersion(t, ss, teamPolicy.Id)
+	teamVersion := saveTestTermsOfServicePolicyVersion(t, ss, teamPolicy.Id)
+	groupVersion := saveTestTermsOfServicePolicyVersion(t, ss, groupPolicy.Id)
+
+	t.Run("only the latest version of the targeting policies is pending", func(t *testing.T) {
+		pending, err := ss.TermsOfServicePolicy().GetPendingForUser(teamUser.Id)
+		require.Nil(t, err)
+		require.Len(t, pending, 1)
+		assert.Equal(t, teamVersion.Id, pending[0].Id)
+
+		pending, err = ss.TermsOfServicePolicy().GetPendingForUser(groupUser.Id)
+		require.Nil(t, err)
+		require.Len(t, pending, 1)
+		assert.Equal(t, groupVersion.Id, pending[0].Id)
+
+		pending, err = ss.TermsOfServicePolicy().GetPendingForUser(otherUser.Id)
+		require.Nil(t, err)
+		assert.Empty(t, pending)
+	})
+
+	t.Run("accepted versions aren't pending", func(t *testing.T) {
+		require.Nil(t, ss.TermsOfServicePolicy().SaveAcceptance(&model.TermsOfServiceAcceptance{UserId: teamUser.Id, VersionId: teamVersion.Id}))
+
+		err := ss.TermsOfServicePolicy().SaveAcceptance(&model.TermsOfServiceAcceptance{UserId: teamUser.Id, VersionId: teamVersion.Id})
+		var conflictErr *store.ErrConflict
+		assert.True(t, errors.As(err, &conflictErr))
+
+		pending, err := ss.TermsOfServicePolicy().GetPendingForUser(teamUser.Id)
+		require.Nil(t, err)
+		assert.Empty(t, pending)
+
+		newVersion := saveTestTermsOfServicePolicyVersion(t, ss, teamPolicy.Id)
+		pending, err = ss.TermsOfServicePolicy().GetPendingForUser(teamUser.Id)
+		require.Nil(t, err)
+		require.Len(t, pending, 1)
+		assert.Equal(t, newVersion.Id, pending[0].Id)
+	})
+
+	t.Run("deleted policies aren't pending", func(t *testing.T) {
+		require.Nil(t, ss.TermsOfServicePolicy().Delete(groupPolicy.Id, model.GetMillis()))
+
+		pending, err := ss.TermsOfServicePolicy().GetPendingForUser(groupUser.Id)
+		require.Nil(t, err)
+		assert.Empty(t, pending)
+	})
+}
+
+func testTermsOfServicePolicyStoreOutstanding(t *testing.T, ss store.Store) {
+	teamId := makeTeam(t, ss).Id
+
+	accepted := saveTestTermsOfServicePolicyUser(t, ss, "a")
+	outstanding := saveTestTermsOfServicePolicyUser(t, ss, "b")
+	deactivated := saveTestTermsOfServicePolicyUser(t, ss, "c")
+	deactivated.DeleteAt = model.GetMillis()
+	_, appErr := ss.User().Update(deactivated, true)
+	require.Nil(t, appErr)
+	_, bot := makeBotWithUser(t, ss, &model.Bot{Username: "bot" + model.NewId(), OwnerId: model.NewId()})
+
+	for _, userId := range []string{accepted.Id, outstanding.Id, deactivated.Id, bot.Id} {
+		_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: userId}, -1)
+		require.Nil(t, nErr)
+	}
+
+	policy, err := ss.TermsOfServicePolicy().Save(newTestTermsOfServicePolicy([]string{teamId}, nil))
+	require.Nil(t, err)
+	version := saveTestTermsOfServicePolicyVersion(t, ss, policy.Id)
+
+	require.Nil(t, ss.TermsOfServicePolicy().SaveAcceptance(&model.TermsOfServiceAcceptance{UserId: accepted.Id, VersionId: version.Id}))
+
+	users, err := ss.TermsOfServicePolicy().GetOutstandingUsers(version, 0, 100)
+	require.Nil(t, err)
+	require.Len(t, users, 1)
+	assert.Equal(t, outstanding.Id, users[0].Id)
+
+	targeted, acceptedCount, err := ss.TermsOfServicePolicy().GetAcceptanceCounts(version)
+	require.Nil(t, err)
+	assert.Equal(t, int64(2), targeted)
+	assert.Equal(t, int64(1), acceptedCount)
+}
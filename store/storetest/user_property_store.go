@@ -0,0 +1,151 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+func TestUserPropertyStore(t *testing.T, ss store.Store) {
+	t.Run("SaveField", func(t *testing.T) { testUserPropertyStoreSaveField(t, ss) })
+	t.Run("UpdateField", func(t *testing.T) { testUserPropertyStoreUpdateField(t, ss) })
+	t.Run("DeleteField", func(t *testing.T) { testUserPropertyStoreDeleteField(t, ss) })
+	t.Run("SaveValuesForUser", func(t *testing.T) { testUserPropertyStoreSaveValuesForUser(t, ss) })
+}
+
+func newTestUserPropertyField() *model.UserPropertyField {
+	return &model.UserPropertyField{
+		Name:        "field_" + model.NewId(),
+		DisplayName: "Field",
+		Type:        model.USER_PROPERTY_FIELD_TYPE_TEXT,
+	}
+}
+
+func testUserPropertyStoreSaveField(t *testing.T, ss store.Store) {
+	t.Run("should save field", func(t *testing.T) {
+		saved, err := ss.UserProperty().SaveField(newTestUserPropertyField())
+		require.Nil(t, err)
+		assert.NotEmpty(t, saved.Id)
+
+		fetched, err := ss.UserProperty().GetField(saved.Id)
+		require.Nil(t, err)
+		assert.Equal(t, saved, fetched)
+
+		fields, err := ss.UserProperty().GetFields()
+		require.Nil(t, err)
+		assert.Contains(t, fields, saved)
+	})
+
+	t.Run("should fail to save existing field", func(t *testing.T) {
+		field := newTestUserPropertyField()
+		field.Id = model.NewId()
+
+		_, err := ss.UserProperty().SaveField(field)
+		var invErr *store.ErrInvalidInput
+		assert.True(t, errors.As(err, &invErr))
+	})
+
+	t.Run("should fail to save field with a taken name", func(t *testing.T) {
+		saved, err := ss.UserProperty().SaveField(newTestUserPropertyField())
+		require.Nil(t, err)
+
+		field := newTestUserPropertyField()
+		field.Name = saved.Name
+		_, err = ss.UserProperty().SaveField(field)
+		var conflictErr *store.ErrConflict
+		assert.True(t, errors.As(err, &conflictErr))
+	})
+}
+
+func testUserPropertyStoreUpdateField(t *testing.T, ss store.Store) {
+	t.Run("should update field", func(t *testing.T) {
+		saved, err := ss.UserProperty().SaveField(newTestUserPropertyField())
+		require.Nil(t, err)
+
+		saved.DisplayName = "Updated"
+		_, err = ss.UserProperty().UpdateField(saved)
+		require.Nil(t, err)
+
+		fetched, err := ss.UserProperty().GetField(saved.Id)
+		require.Nil(t, err)
+		assert.Equal(t, "Updated", fetched.DisplayName)
+	})
+
+	t.Run("should fail to update missing field", func(t *testing.T) {
+		field := newTestUserPropertyField()
+		field.PreSave()
+
+		_, err := ss.UserProperty().UpdateField(field)
+		var nfErr *store.ErrNotFound
+		assert.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testUserPropertyStoreDeleteField(t *testing.T, ss store.Store) {
+	userId := model.NewId()
+	saved, err := ss.UserProperty().SaveField(newTestUserPropertyField())
+	require.Nil(t, err)
+	require.Nil(t, ss.UserProperty().SaveValuesForUser(userId, []*model.UserPropertyValue{{FieldId: saved.Id, Value: "value"}}))
+
+	require.Nil(t, ss.UserProperty().DeleteField(saved.Id, model.GetMillis()))
+
+	_, err = ss.UserProperty().GetField(saved.Id)
+	var nfErr *store.ErrNotFound
+	assert.True(t, errors.As(err, &nfErr))
+
+	values, err := ss.UserProperty().GetValuesForUser(userId)
+	require.Nil(t, err)
+	assert.Empty(t, values)
+
+	err = ss.UserProperty().DeleteField(saved.Id, model.GetMillis())
+	assert.True(t, errors.As(err, &nfErr))
+
+	t.Run("name of a deleted field can be reused", func(t *testing.T) {
+		field := newTestUserPropertyField()
+		field.Name = saved.Name
+		_, err := ss.UserProperty().SaveField(field)
+		assert.Nil(t, err)
+	})
+}
+
+func testUserPropertyStoreSaveValuesForUser(t *testing.T, ss store.Store) {
+	userId := model.NewId()
+	first, err := ss.UserProperty().SaveField(newTestUserPropertyField())
+	require.Nil(t, err)
+	second, err := ss.UserProperty().SaveField(newTestUserPropertyField())
+	require.Nil(t, err)
+
+	require.Nil(t, ss.UserProperty().SaveValuesForUser(userId, []*model.UserPropertyValue{
+		{FieldId: first.Id, Value: "a"},
+		{FieldId: second.Id, Value: "b"},
+	}))
+
+	t.Run("should replace and clear values", func(t *testing.T) {
+		require.Nil(t, ss.UserProperty().SaveValuesForUser(userId, []*model.UserPropertyValue{
+			{FieldId: first.Id, Value: "c"},
+			{FieldId: second.Id, Value: ""},
+		}))
+
+		values, err := ss.UserProperty().GetValuesForUser(userId)
+		require.Nil(t, err)
+		require.Len(t, values, 1)
+		assert.Equal(t, first.Id, values[0].FieldId)
+		assert.Equal(t, "c", values[0].Value)
+	})
+
+	t.Run("should permanently delete the values of the user", func(t *testing.T) {
+		require.Nil(t, ss.UserProperty().PermanentDeleteByUser(userId))
+
+		values, err := ss.UserProperty().GetValuesForUser(userId)
+		require.Nil(t, err)
+		assert.Empty(t, values)
+	})
+}
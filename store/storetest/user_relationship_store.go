@@ -0,0 +1,167 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/store"
+)
+
+func TestUserRelationshipStore(t *testing.T, ss store.Store) {
+	t.Run("Save", func(t *testing.T) { testUserRelationshipStoreSave(t, ss) })
+	t.Run("GetForUser", func(t *testing.T) { testUserRelationshipStoreGetForUser(t, ss) })
+	t.Run("GetUserIdsRelatedTo", func(t *testing.T) { testUserRelationshipStoreGetUserIdsRelatedTo(t, ss) })
+	t.Run("Delete", func(t *testing.T) { testUserRelationshipStoreDelete(t, ss) })
+	t.Run("PermanentDeleteByUser", func(t *testing.T) { testUserRelationshipStorePermanentDeleteByUser(t, ss) })
+}
+
+func newTestUserRelationship(userId, otherUserId, relationshipType string) *model.UserRelationship {
+	return &model.UserRelationship{
+		UserId:      userId,
+		OtherUserId: otherUserId,
+		Type:        relationshipType,
+	}
+}
+
+func testUserRelationshipStoreSave(t *testing.T, ss store.Store) {
+	t.Run("should save user relationship", func(t *testing.T) {
+		saved, err := ss.UserRelationship().Save(newTestUserRelationship(model.NewId(), model.NewId(), model.USER_RELATIONSHIP_MUTE))
+		require.Nil(t, err)
+		assert.NotZero(t, saved.CreateAt)
+
+		fetched, err := ss.UserRelationship().Get(saved.UserId, saved.OtherUserId, model.USER_RELATIONSHIP_MUTE)
+		require.Nil(t, err)
+		assert.Equal(t, saved, fetched)
+	})
+
+	t.Run("should allow to both mute and block the same user", func(t *testing.T) {
+		userId := model.NewId()
+		otherUserId := model.NewId()
+
+		_, err := ss.UserRelationship().Save(newTestUserRelationship(userId, otherUserId, model.USER_RELATIONSHIP_MUTE))
+		require.Nil(t, err)
+
+		_, err = ss.UserRelationship().Save(newTestUserRelationship(userId, otherUserId, model.USER_RELATIONSHIP_BLOCK))
+		require.Nil(t, err)
+	})
+
+	t.Run("should fail to save the same relationship twice", func(t *testing.T) {
+		relationship, err := ss.UserRelationship().Save(newTestUserRelationship(model.NewId(), model.NewId(), model.USER_RELATIONSHIP_BLOCK))
+		require.Nil(t, err)
+
+		_, err = ss.UserRelationship().Save(newTestUserRelationship(relationship.UserId, relationship.OtherUserId, model.USER_RELATIONSHIP_BLOCK))
+		var cErr *store.ErrConflict
+		assert.True(t, errors.As(err, &cErr))
+	})
+
+	t.Run("should fail to save invalid user relationship", func(t *testing.T) {
+		_, err := ss.UserRelationship().Save(newTestUserRelationship(model.NewId(), model.NewId(), "invalid"))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("should fail to get missing user relationship", func(t *testing.T) {
+		_, err := ss.UserRelationship().Get(model.NewId(), model.NewId(), model.USER_RELATIONSHIP_MUTE)
+		var nfErr *store.ErrNotFound
+		assert.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testUserRelationshipStoreGetForUser(t *testing.T, ss store.Store) {
+	userId := model.NewId()
+
+	first := newTestUserRelationship(userId, model.NewId(), model.USER_RELATIONSHIP_MUTE)
+	first.CreateAt = 1000
+	_, err := ss.UserRelationship().Save(first)
+	require.Nil(t, err)
+
+	second := newTestUserRelationship(userId, model.NewId(), model.USER_RELATIONSHIP_BLOCK)
+	second.CreateAt = 2000
+	_, err = ss.UserRelationship().Save(second)
+	require.Nil(t, err)
+
+	_, err = ss.UserRelationship().Save(newTestUserRelationship(first.OtherUserId, userId, model.USER_RELATIONSHIP_MUTE))
+	require.Nil(t, err)
+
+	relationships, err := ss.UserRelationship().GetForUser(userId)
+	require.Nil(t, err)
+	require.Len(t, relationships, 2)
+	assert.Equal(t, first, relationships[0])
+	assert.Equal(t, second, relationships[1])
+
+	relationships, err = ss.UserRelationship().GetForUser(model.NewId())
+	require.Nil(t, err)
+	assert.Empty(t, relationships)
+}
+
+func testUserRelationshipStoreGetUserIdsRelatedTo(t *testing.T, ss store.Store) {
+	otherUserId := model.NewId()
+	mutingUserId := model.NewId()
+	blockingUserId := model.NewId()
+
+	_, err := ss.UserRelationship().Save(newTestUserRelationship(mutingUserId, otherUserId, model.USER_RELATIONSHIP_MUTE))
+	require.Nil(t, err)
+	_, err = ss.UserRelationship().Save(newTestUserRelationship(blockingUserId, otherUserId, model.USER_RELATIONSHIP_MUTE))
+	require.Nil(t, err)
+	_, err = ss.UserRelationship().Save(newTestUserRelationship(blockingUserId, otherUserId, model.USER_RELATIONSHIP_BLOCK))
+	require.Nil(t, err)
+	_, err = ss.UserRelationship().Save(newTestUserRelationship(otherUserId, model.NewId(), model.USER_RELATIONSHIP_BLOCK))
+	require.Nil(t, err)
+
+	userIds, err := ss.UserRelationship().GetUserIdsRelatedTo(otherUserId)
+	require.Nil(t, err)
+	assert.ElementsMatch(t, []string{mutingUserId, blockingUserId}, userIds)
+
+	userIds, err = ss.UserRelationship().GetUserIdsRelatedTo(model.NewId())
+	require.Nil(t, err)
+	assert.Empty(t, userIds)
+}
+
+func testUserRelationshipStoreDelete(t *testing.T, ss store.Store) {
+	relationship, err := ss.UserRelationship().Save(newTestUserRelationship(model.NewId(), model.NewId(), model.USER_RELATIONSHIP_MUTE))
+	require.Nil(t, err)
+	blocked, err := ss.UserRelationship().Save(newTestUserRelationship(relationship.UserId, relationship.OtherUserId, model.USER_RELATIONSHIP_BLOCK))
+	require.Nil(t, err)
+
+	err = ss.UserRelationship().Delete(relationship.UserId, relationship.OtherUserId, model.USER_RELATIONSHIP_MUTE)
+	require.Nil(t, err)
+
+	_, err = ss.UserRelationship().Get(relationship.UserId, relationship.OtherUserId, model.USER_RELATIONSHIP_MUTE)
+	var nfErr *store.ErrNotFound
+	assert.True(t, errors.As(err, &nfErr))
+
+	_, err = ss.UserRelationship().Get(blocked.UserId, blocked.OtherUserId, model.USER_RELATIONSHIP_BLOCK)
+	assert.Nil(t, err)
+
+	err = ss.UserRelationship().Delete(relationship.UserId, relationship.OtherUserId, model.USER_RELATIONSHIP_MUTE)
+	assert.True(t, errors.As(err, &nfErr))
+}
+
+func testUserRelationshipStorePermanentDeleteByUser(t *testing.T, ss store.Store) {
+	userId := model.NewId()
+	muted, err := ss.UserRelationship().Save(newTestUserRelationship(userId, model.NewId(), model.USER_RELATIONSHIP_MUTE))
+	require.Nil(t, err)
+	blockedBy, err := ss.UserRelationship().Save(newTestUserRelationship(model.NewId(), userId, model.USER_RELATIONSHIP_BLOCK))
+	require.Nil(t, err)
+
+	other, err := ss.UserRelationship().Save(newTestUserRelationship(muted.OtherUserId, blockedBy.UserId, model.USER_RELATIONSHIP_MUTE))
+	require.Nil(t, err)
+
+	err = ss.UserRelationship().PermanentDeleteByUser(userId)
+	require.Nil(t, err)
+
+	var nfErr *store.ErrNotFound
+	_, err = ss.UserRelationship().Get(muted.UserId, muted.OtherUserId, muted.Type)
+	assert.True(t, errors.As(err, &nfErr))
+	_, err = ss.UserRelationship().Get(blockedBy.UserId, blockedBy.OtherUserId, blockedBy.Type)
+	assert.True(t, errors.As(err, &nfErr))
+
+	_, err = ss.UserRelationship().Get(other.UserId, other.OtherUserId, other.Type)
+	assert.Nil(t, err)
+}
@@ -4,6 +4,8 @@
 package storetest
 
 import (
+	"context"
+	"errors"
 	"strings"
 	"testing"
 	"time"
@@ -37,6 +39,7 @@ func TestUserStore(t *testing.T, ss store.Store, s SqlSupplier) {
 	t.Run("Count", func(t *testing.T) { testCount(t, ss) })
 	t.Run("AnalyticsActiveCount", func(t *testing.T) { testUserStoreAnalyticsActiveCount(t, ss, s) })
 	t.Run("AnalyticsGetInactiveUsersCount", func(t *testing.T) { testUserStoreAnalyticsGetInactiveUsersCount(t, ss) })
+	t.Run("AnalyticsSeatCountsByDay", func(t *testing.T) { testUserStoreAnalyticsSeatCountsByDay(t, ss) })
 	t.Run("AnalyticsGetSystemAdminCount", func(t *testing.T) { testUserStoreAnalyticsGetSystemAdminCount(t, ss) })
 	t.Run("AnalyticsGetGuestCount", func(t *testing.T) { testUserStoreAnalyticsGetGuestCount(t, ss) })
 	t.Run("Save", func(t *testing.T) { testUserStoreSave(t, ss) })
@@ -85,10 +88,14 @@ func TestUserStore(t *testing.T, ss store.Store, s SqlSupplier) {
 	t.Run("DeactivateGuests", func(t *testing.T) { testDeactivateGuests(t, ss) })
 	t.Run("ResetLastPictureUpdate", func(t *testing.T) { testUserStoreResetLastPictureUpdate(t, ss) })
 	t.Run("GetKnownUsers", func(t *testing.T) { testGetKnownUsers(t, ss) })
+	t.Run("ReportingLines", func(t *testing.T) { testUserStoreReportingLines(t, ss) })
+	t.Run("AccountFilters", func(t *testing.T) { testUserStoreAccountFilters(t, ss) })
+	t.Run("ServiceAccounts", func(t *testing.T) { testUserStoreServiceAccounts(t, ss) })
+	t.Run("GetUsersWithExpiredRoles", func(t *testing.T) { testUserStoreGetUsersWithExpiredRoles(t, ss) })
 }
 
 func testUserStoreSave(t *testing.T, ss store.Store) {
-	teamId := model.NewId()
+	teamId := makeTeam(t, ss).Id
 	maxUsersPerTeam := 50
 
 	u1 := model.User{
@@ -101,8 +108,8 @@ func testUserStoreSave(t *testing.T, ss store.Store) {
 
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u1.Id)) }()
 
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u1.Id}, maxUsersPerTeam)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u1.Id}, maxUsersPerTeam)
+	require.Nil(t, nErr)
 
 	_, err = ss.User().Save(&u1)
 	require.NotNil(t, err, "shouldn't be able to update user from save")
@@ -133,8 +140,8 @@ func testUserStoreSave(t *testing.T, ss store.Store) {
 
 		defer func() { require.Nil(t, ss.User().PermanentDelete(u.Id)) }()
 
-		_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u.Id}, maxUsersPerTeam)
-		require.Nil(t, err)
+		_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u.Id}, maxUsersPerTeam)
+		require.Nil(t, nErr)
 	}
 
 	u2.Id = ""
@@ -145,8 +152,8 @@ func testUserStoreSave(t *testing.T, ss store.Store) {
 
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u2.Id)) }()
 
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u1.Id}, maxUsersPerTeam)
-	require.NotNil(t, err, "should be the limit")
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u1.Id}, maxUsersPerTeam)
+	require.NotNil(t, nErr, "should be the limit")
 }
 
 func testUserStoreUpdate(t *testing.T, ss store.Store) {
@@ -156,8 +163,8 @@ func testUserStoreUpdate(t *testing.T, ss store.Store) {
 	_, err := ss.User().Save(u1)
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u1.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: makeTeam(t, ss).Id, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	u2 := &model.User{
 		Email:       MakeEmail(),
@@ -166,8 +173,8 @@ func testUserStoreUpdate(t *testing.T, ss store.Store) {
 	_, err = ss.User().Save(u2)
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u2.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: makeTeam(t, ss).Id, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	_, err = ss.User().Update(u1, false)
 	require.Nil(t, err)
@@ -194,8 +201,8 @@ func testUserStoreUpdate(t *testing.T, ss store.Store) {
 	_, err = ss.User().Save(u3)
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u3.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u3.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: makeTeam(t, ss).Id, UserId: u3.Id}, -1)
+	require.Nil(t, nErr)
 
 	u3.Email = MakeEmail()
 	userUpdate, err := ss.User().Update(u3, false)
@@ -217,8 +224,8 @@ func testUserStoreUpdateUpdateAt(t *testing.T, ss store.Store) {
 	_, err := ss.User().Save(u1)
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u1.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: makeTeam(t, ss).Id, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	_, err = ss.User().UpdateUpdateAt(u1.Id)
 	require.Nil(t, err)
@@ -234,8 +241,8 @@ func testUserStoreUpdateFailedPasswordAttempts(t *testing.T, ss store.Store) {
 	_, err := ss.User().Save(u1)
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u1.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: makeTeam(t, ss).Id, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	err = ss.User().UpdateFailedPasswordAttempts(u1.Id, 3)
 	require.Nil(t, err)
@@ -269,8 +276,8 @@ func testUserStoreGet(t *testing.T, ss store.Store) {
 	defer func() { require.Nil(t, ss.Bot().PermanentDelete(u2.Id)) }()
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u2.Id)) }()
 
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: makeTeam(t, ss).Id, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	t.Run("fetch empty id", func(t *testing.T) {
 		_, err := ss.User().Get("")
@@ -294,7 +301,7 @@ func testUserStoreGet(t *testing.T, ss store.Store) {
 }
 
 func testGetAllUsingAuthService(t *testing.T, ss store.Store) {
-	teamId := model.NewId()
+	teamId := makeTeam(t, ss).Id
 
 	u1, err := ss.User().Save(&model.User{
 		Email:       MakeEmail(),
@@ -303,8 +310,8 @@ func testGetAllUsingAuthService(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u1.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	u2, err := ss.User().Save(&model.User{
 		Email:       MakeEmail(),
@@ -313,8 +320,8 @@ func testGetAllUsingAuthService(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u2.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	u3, err := ss.User().Save(&model.User{
 		Email:       MakeEmail(),
@@ -323,9 +330,9 @@ func testGetAllUsingAuthService(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u3.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
-	require.Nil(t, err)
-	_, nErr := ss.Bot().Save(&model.Bot{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
+	require.Nil(t, nErr)
+	_, nErr = ss.Bot().Save(&model.Bot{
 		UserId:   u3.Id,
 		Username: u3.Username,
 		OwnerId:  u1.Id,
@@ -550,7 +557,7 @@ func testUserStoreGetAllProfiles(t *testing.T, ss store.Store) {
 }
 
 func testUserStoreGetProfiles(t *testing.T, ss store.Store) {
-	teamId := model.NewId()
+	teamId := makeTeam(t, ss).Id
 
 	u1, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -558,8 +565,8 @@ func testUserStoreGetProfiles(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u1.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	u2, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -567,15 +574,15 @@ func testUserStoreGetProfiles(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u2.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	u3, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
 		Username: "u3" + model.NewId(),
 	})
 	require.Nil(t, err)
-	_, nErr := ss.Bot().Save(&model.Bot{
+	_, nErr = ss.Bot().Save(&model.Bot{
 		UserId:   u3.Id,
 		Username: u3.Username,
 		OwnerId:  u1.Id,
@@ -584,8 +591,8 @@ func testUserStoreGetProfiles(t *testing.T, ss store.Store) {
 	u3.IsBot = true
 	defer func() { require.Nil(t, ss.Bot().PermanentDelete(u3.Id)) }()
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u3.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
+	require.Nil(t, nErr)
 
 	u4, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -594,8 +601,8 @@ func testUserStoreGetProfiles(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u4.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u4.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u4.Id}, -1)
+	require.Nil(t, nErr)
 
 	u5, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -604,8 +611,8 @@ func testUserStoreGetProfiles(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u5.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u5.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u5.Id}, -1)
+	require.Nil(t, nErr)
 
 	t.Run("get page 0, perPage 100", func(t *testing.T) {
 		actual, err := ss.User().GetProfiles(&model.UserGetOptions{
@@ -654,8 +661,8 @@ func testUserStoreGetProfiles(t *testing.T, ss store.Store) {
 		_, err := ss.User().Save(uNew)
 		require.Nil(t, err)
 		defer func() { require.Nil(t, ss.User().PermanentDelete(uNew.Id)) }()
-		_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: uNew.Id}, -1)
-		require.Nil(t, err)
+		_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: uNew.Id}, -1)
+		require.Nil(t, nErr)
 
 		updatedEtag := ss.User().GetEtagForProfiles(teamId)
 		require.NotEqual(t, etag, updatedEtag)
@@ -719,7 +726,7 @@ func testUserStoreGetProfiles(t *testing.T, ss store.Store) {
 }
 
 func testUserStoreGetProfilesInChannel(t *testing.T, ss store.Store) {
-	teamId := model.NewId()
+	teamId := makeTeam(t, ss).Id
 
 	u1, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -727,8 +734,8 @@ func testUserStoreGetProfilesInChannel(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u1.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	u2, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -736,8 +743,8 @@ func testUserStoreGetProfilesInChannel(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u2.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	u3, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -745,9 +752,9 @@ func testUserStoreGetProfilesInChannel(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u3.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
-	require.Nil(t, err)
-	_, nErr := ss.Bot().Save(&model.Bot{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
+	require.Nil(t, nErr)
+	_, nErr = ss.Bot().Save(&model.Bot{
 		UserId:   u3.Id,
 		Username: u3.Username,
 		OwnerId:  u1.Id,
@@ -824,7 +831,7 @@ func testUserStoreGetProfilesInChannelByStatus(t *testing.T, ss store.Store, s S
 
 	cleanupStatusStore(t, s)
 
-	teamId := model.NewId()
+	teamId := makeTeam(t, ss).Id
 
 	u1, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -832,8 +839,8 @@ func testUserStoreGetProfilesInChannelByStatus(t *testing.T, ss store.Store, s S
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u1.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	u2, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -841,8 +848,8 @@ func testUserStoreGetProfilesInChannelByStatus(t *testing.T, ss store.Store, s S
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u2.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	u3, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -850,9 +857,9 @@ func testUserStoreGetProfilesInChannelByStatus(t *testing.T, ss store.Store, s S
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u3.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
-	require.Nil(t, err)
-	_, nErr := ss.Bot().Save(&model.Bot{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
+	require.Nil(t, nErr)
+	_, nErr = ss.Bot().Save(&model.Bot{
 		UserId:   u3.Id,
 		Username: u3.Username,
 		OwnerId:  u1.Id,
@@ -933,7 +940,7 @@ func testUserStoreGetProfilesInChannelByStatus(t *testing.T, ss store.Store, s S
 }
 
 func testUserStoreGetProfilesWithoutTeam(t *testing.T, ss store.Store) {
-	teamId := model.NewId()
+	teamId := makeTeam(t, ss).Id
 
 	u1, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -941,8 +948,8 @@ func testUserStoreGetProfilesWithoutTeam(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u1.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	u2, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -959,7 +966,7 @@ func testUserStoreGetProfilesWithoutTeam(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u3.Id)) }()
-	_, nErr := ss.Bot().Save(&model.Bot{
+	_, nErr = ss.Bot().Save(&model.Bot{
 		UserId:   u3.Id,
 		Username: u3.Username,
 		OwnerId:  u1.Id,
@@ -1000,7 +1007,7 @@ func testUserStoreGetProfilesWithoutTeam(t *testing.T, ss store.Store) {
 }
 
 func testUserStoreGetAllProfilesInChannel(t *testing.T, ss store.Store) {
-	teamId := model.NewId()
+	teamId := makeTeam(t, ss).Id
 
 	u1, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -1008,8 +1015,8 @@ func testUserStoreGetAllProfilesInChannel(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u1.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	u2, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -1017,8 +1024,8 @@ func testUserStoreGetAllProfilesInChannel(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u2.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	u3, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -1026,9 +1033,9 @@ func testUserStoreGetAllProfilesInChannel(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u3.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
-	require.Nil(t, err)
-	_, nErr := ss.Bot().Save(&model.Bot{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
+	require.Nil(t, nErr)
+	_, nErr = ss.Bot().Save(&model.Bot{
 		UserId:   u3.Id,
 		Username: u3.Username,
 		OwnerId:  u1.Id,
@@ -1128,7 +1135,7 @@ func testUserStoreGetAllProfilesInChannel(t *testing.T, ss store.Store) {
 }
 
 func testUserStoreGetProfilesNotInChannel(t *testing.T, ss store.Store) {
-	teamId := model.NewId()
+	teamId := makeTeam(t, ss).Id
 
 	u1, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -1136,8 +1143,8 @@ func testUserStoreGetProfilesNotInChannel(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u1.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	u2, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -1145,8 +1152,8 @@ func testUserStoreGetProfilesNotInChannel(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u2.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	u3, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -1154,9 +1161,9 @@ func testUserStoreGetProfilesNotInChannel(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u3.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
-	require.Nil(t, err)
-	_, nErr := ss.Bot().Save(&model.Bot{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
+	require.Nil(t, nErr)
+	_, nErr = ss.Bot().Save(&model.Bot{
 		UserId:   u3.Id,
 		Username: u3.Username,
 		OwnerId:  u1.Id,
@@ -1291,7 +1298,7 @@ func testUserStoreGetProfilesNotInChannel(t *testing.T, ss store.Store) {
 }
 
 func testUserStoreGetProfilesByIds(t *testing.T, ss store.Store) {
-	teamId := model.NewId()
+	teamId := makeTeam(t, ss).Id
 
 	u1, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -1299,8 +1306,8 @@ func testUserStoreGetProfilesByIds(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u1.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	u2, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -1308,8 +1315,8 @@ func testUserStoreGetProfilesByIds(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u2.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	u3, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -1317,9 +1324,9 @@ func testUserStoreGetProfilesByIds(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u3.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
-	require.Nil(t, err)
-	_, nErr := ss.Bot().Save(&model.Bot{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
+	require.Nil(t, nErr)
+	_, nErr = ss.Bot().Save(&model.Bot{
 		UserId:   u3.Id,
 		Username: u3.Username,
 		OwnerId:  u1.Id,
@@ -1499,8 +1506,8 @@ func testUserStoreGetProfileByGroupChannelIdsForUser(t *testing.T, ss store.Stor
 }
 
 func testUserStoreGetProfilesByUsernames(t *testing.T, ss store.Store) {
-	teamId := model.NewId()
-	team2Id := model.NewId()
+	teamId := makeTeam(t, ss).Id
+	team2Id := makeTeam(t, ss).Id
 
 	u1, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -1508,8 +1515,8 @@ func testUserStoreGetProfilesByUsernames(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u1.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	u2, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -1517,8 +1524,8 @@ func testUserStoreGetProfilesByUsernames(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u2.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	u3, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -1526,9 +1533,9 @@ func testUserStoreGetProfilesByUsernames(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u3.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: team2Id, UserId: u3.Id}, -1)
-	require.Nil(t, err)
-	_, nErr := ss.Bot().Save(&model.Bot{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: team2Id, UserId: u3.Id}, -1)
+	require.Nil(t, nErr)
+	_, nErr = ss.Bot().Save(&model.Bot{
 		UserId:   u3.Id,
 		Username: u3.Username,
 		OwnerId:  u1.Id,
@@ -1569,7 +1576,7 @@ func testUserStoreGetProfilesByUsernames(t *testing.T, ss store.Store) {
 }
 
 func testUserStoreGetSystemAdminProfiles(t *testing.T, ss store.Store) {
-	teamId := model.NewId()
+	teamId := makeTeam(t, ss).Id
 
 	u1, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -1578,8 +1585,8 @@ func testUserStoreGetSystemAdminProfiles(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u1.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	u2, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -1587,8 +1594,8 @@ func testUserStoreGetSystemAdminProfiles(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u2.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	u3, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -1597,9 +1604,9 @@ func testUserStoreGetSystemAdminProfiles(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u3.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
-	require.Nil(t, err)
-	_, nErr := ss.Bot().Save(&model.Bot{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
+	require.Nil(t, nErr)
+	_, nErr = ss.Bot().Save(&model.Bot{
 		UserId:   u3.Id,
 		Username: u3.Username,
 		OwnerId:  u1.Id,
@@ -1619,7 +1626,7 @@ func testUserStoreGetSystemAdminProfiles(t *testing.T, ss store.Store) {
 }
 
 func testUserStoreGetByEmail(t *testing.T, ss store.Store) {
-	teamId := model.NewId()
+	teamId := makeTeam(t, ss).Id
 
 	u1, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -1627,8 +1634,8 @@ func testUserStoreGetByEmail(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u1.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	u2, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -1636,8 +1643,8 @@ func testUserStoreGetByEmail(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u2.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	u3, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -1645,9 +1652,9 @@ func testUserStoreGetByEmail(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u3.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
-	require.Nil(t, err)
-	_, nErr := ss.Bot().Save(&model.Bot{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
+	require.Nil(t, nErr)
+	_, nErr = ss.Bot().Save(&model.Bot{
 		UserId:   u3.Id,
 		Username: u3.Username,
 		OwnerId:  u1.Id,
@@ -1688,7 +1695,7 @@ func testUserStoreGetByEmail(t *testing.T, ss store.Store) {
 }
 
 func testUserStoreGetByAuthData(t *testing.T, ss store.Store) {
-	teamId := model.NewId()
+	teamId := makeTeam(t, ss).Id
 	auth1 := model.NewId()
 	auth3 := model.NewId()
 
@@ -1700,8 +1707,8 @@ func testUserStoreGetByAuthData(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u1.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	u2, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -1709,8 +1716,8 @@ func testUserStoreGetByAuthData(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u2.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	u3, err := ss.User().Save(&model.User{
 		Email:       MakeEmail(),
@@ -1720,9 +1727,9 @@ func testUserStoreGetByAuthData(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u3.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
-	require.Nil(t, err)
-	_, nErr := ss.Bot().Save(&model.Bot{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
+	require.Nil(t, nErr)
+	_, nErr = ss.Bot().Save(&model.Bot{
 		UserId:   u3.Id,
 		Username: u3.Username,
 		OwnerId:  u1.Id,
@@ -1765,7 +1772,7 @@ func testUserStoreGetByAuthData(t *testing.T, ss store.Store) {
 }
 
 func testUserStoreGetByUsername(t *testing.T, ss store.Store) {
-	teamId := model.NewId()
+	teamId := makeTeam(t, ss).Id
 
 	u1, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -1773,8 +1780,8 @@ func testUserStoreGetByUsername(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u1.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	u2, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -1782,8 +1789,8 @@ func testUserStoreGetByUsername(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u2.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	u3, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -1791,9 +1798,9 @@ func testUserStoreGetByUsername(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u3.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
-	require.Nil(t, err)
-	_, nErr := ss.Bot().Save(&model.Bot{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
+	require.Nil(t, nErr)
+	_, nErr = ss.Bot().Save(&model.Bot{
 		UserId:   u3.Id,
 		Username: u3.Username,
 		OwnerId:  u1.Id,
@@ -1834,7 +1841,7 @@ func testUserStoreGetByUsername(t *testing.T, ss store.Store) {
 }
 
 func testUserStoreGetForLogin(t *testing.T, ss store.Store) {
-	teamId := model.NewId()
+	teamId := makeTeam(t, ss).Id
 	auth := model.NewId()
 	auth2 := model.NewId()
 	auth3 := model.NewId()
@@ -1847,8 +1854,8 @@ func testUserStoreGetForLogin(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u1.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	u2, err := ss.User().Save(&model.User{
 		Email:       MakeEmail(),
@@ -1858,8 +1865,8 @@ func testUserStoreGetForLogin(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u2.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	u3, err := ss.User().Save(&model.User{
 		Email:       MakeEmail(),
@@ -1869,9 +1876,9 @@ func testUserStoreGetForLogin(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u3.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
-	require.Nil(t, err)
-	_, nErr := ss.Bot().Save(&model.Bot{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
+	require.Nil(t, nErr)
+	_, nErr = ss.Bot().Save(&model.Bot{
 		UserId:   u3.Id,
 		Username: u3.Username,
 		OwnerId:  u1.Id,
@@ -1924,15 +1931,15 @@ func testUserStoreGetForLogin(t *testing.T, ss store.Store) {
 }
 
 func testUserStoreUpdatePassword(t *testing.T, ss store.Store) {
-	teamId := model.NewId()
+	teamId := makeTeam(t, ss).Id
 
 	u1 := &model.User{}
 	u1.Email = MakeEmail()
 	_, err := ss.User().Save(u1)
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u1.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	hashedPassword := model.HashPassword("newpwd")
 
@@ -1950,23 +1957,38 @@ func testUserStoreDelete(t *testing.T, ss store.Store) {
 	_, err := ss.User().Save(u1)
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u1.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	u2 := makeUser(t, ss)
+
+	teamId := makeTeam(t, ss).Id
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	err = ss.User().PermanentDelete(u1.Id)
 	require.Nil(t, err)
+
+	t.Run("the team members of the user are deleted with them", func(t *testing.T) {
+		_, nErr := ss.Team().GetMember(context.Background(), teamId, u1.Id, false)
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(nErr, &nfErr))
+
+		count, err := ss.Team().GetTotalMemberCount(context.Background(), teamId, nil)
+		require.Nil(t, err)
+		assert.Equal(t, int64(1), count)
+	})
 }
 
 func testUserStoreUpdateAuthData(t *testing.T, ss store.Store) {
-	teamId := model.NewId()
+	teamId := makeTeam(t, ss).Id
 
 	u1 := &model.User{}
 	u1.Email = MakeEmail()
 	_, err := ss.User().Save(u1)
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u1.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	service := "someservice"
 	authData := model.NewId()
@@ -1982,7 +2004,7 @@ func testUserStoreUpdateAuthData(t *testing.T, ss store.Store) {
 }
 
 func testUserUnreadCount(t *testing.T, ss store.Store) {
-	teamId := model.NewId()
+	teamId := makeTeam(t, ss).Id
 
 	c1 := model.Channel{}
 	c1.TeamId = teamId
@@ -2002,8 +2024,8 @@ func testUserUnreadCount(t *testing.T, ss store.Store) {
 	_, err := ss.User().Save(u1)
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u1.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	u2 := &model.User{}
 	u2.Email = MakeEmail()
@@ -2011,10 +2033,10 @@ func testUserUnreadCount(t *testing.T, ss store.Store) {
 	_, err = ss.User().Save(u2)
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u2.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
-	_, nErr := ss.Channel().Save(&c1, -1)
+	_, nErr = ss.Channel().Save(&c1, -1)
 	require.Nil(t, nErr, "couldn't save item")
 
 	m1 := model.ChannelMember{}
@@ -2044,7 +2066,7 @@ func testUserUnreadCount(t *testing.T, ss store.Store) {
 	// Post one message with mention to open channel
 	_, err = ss.Post().Save(&p1)
 	require.Nil(t, err)
-	err = ss.Channel().IncrementMentionCount(c1.Id, u2.Id)
+	err = ss.Channel().IncrementMentionCount(c1.Id, u2.Id, false)
 	require.Nil(t, err)
 
 	// Post 2 messages without mention to direct channel
@@ -2055,7 +2077,7 @@ func testUserUnreadCount(t *testing.T, ss store.Store) {
 
 	_, err = ss.Post().Save(&p2)
 	require.Nil(t, err)
-	err = ss.Channel().IncrementMentionCount(c2.Id, u2.Id)
+	err = ss.Channel().IncrementMentionCount(c2.Id, u2.Id, false)
 	require.Nil(t, err)
 
 	p3 := model.Post{}
@@ -2065,7 +2087,7 @@ func testUserUnreadCount(t *testing.T, ss store.Store) {
 	_, err = ss.Post().Save(&p3)
 	require.Nil(t, err)
 
-	err = ss.Channel().IncrementMentionCount(c2.Id, u2.Id)
+	err = ss.Channel().IncrementMentionCount(c2.Id, u2.Id, false)
 	require.Nil(t, err)
 
 	badge, unreadCountErr := ss.User().GetUnreadCount(u2.Id)
@@ -2120,7 +2142,7 @@ func testUserStoreGetRecentlyActiveUsersForTeam(t *testing.T, ss store.Store, s
 
 	cleanupStatusStore(t, s)
 
-	teamId := model.NewId()
+	teamId := makeTeam(t, ss).Id
 
 	u1, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -2128,8 +2150,8 @@ func testUserStoreGetRecentlyActiveUsersForTeam(t *testing.T, ss store.Store, s
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u1.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	u2, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -2137,8 +2159,8 @@ func testUserStoreGetRecentlyActiveUsersForTeam(t *testing.T, ss store.Store, s
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u2.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	u3, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -2146,9 +2168,9 @@ func testUserStoreGetRecentlyActiveUsersForTeam(t *testing.T, ss store.Store, s
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u3.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
-	require.Nil(t, err)
-	_, nErr := ss.Bot().Save(&model.Bot{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
+	require.Nil(t, nErr)
+	_, nErr = ss.Bot().Save(&model.Bot{
 		UserId:   u3.Id,
 		Username: u3.Username,
 		OwnerId:  u1.Id,
@@ -2194,8 +2216,8 @@ func testUserStoreGetRecentlyActiveUsersForTeam(t *testing.T, ss store.Store, s
 }
 
 func testUserStoreGetNewUsersForTeam(t *testing.T, ss store.Store) {
-	teamId := model.NewId()
-	teamId2 := model.NewId()
+	teamId := makeTeam(t, ss).Id
+	teamId2 := makeTeam(t, ss).Id
 
 	u1, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -2203,8 +2225,8 @@ func testUserStoreGetNewUsersForTeam(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u1.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	u2, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -2212,8 +2234,8 @@ func testUserStoreGetNewUsersForTeam(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u2.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	u3, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -2221,9 +2243,9 @@ func testUserStoreGetNewUsersForTeam(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u3.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
-	require.Nil(t, err)
-	_, nErr := ss.Bot().Save(&model.Bot{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
+	require.Nil(t, nErr)
+	_, nErr = ss.Bot().Save(&model.Bot{
 		UserId:   u3.Id,
 		Username: u3.Username,
 		OwnerId:  u1.Id,
@@ -2238,8 +2260,8 @@ func testUserStoreGetNewUsersForTeam(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u4.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId2, UserId: u4.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId2, UserId: u4.Id}, -1)
+	require.Nil(t, nErr)
 
 	t.Run("get team 1, offset 0, limit 100", func(t *testing.T) {
 		result, err := ss.User().GetNewUsersForTeam(teamId, 0, 100, nil)
@@ -2330,13 +2352,13 @@ func testUserStoreSearch(t *testing.T, ss store.Store) {
 	u2.AuthData = nilAuthData
 	u3.AuthData = nilAuthData
 
-	t1id := model.NewId()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: t1id, UserId: u1.Id, SchemeAdmin: true, SchemeUser: true}, -1)
-	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: t1id, UserId: u2.Id, SchemeAdmin: true, SchemeUser: true}, -1)
-	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: t1id, UserId: u3.Id, SchemeAdmin: false, SchemeUser: false, SchemeGuest: true}, -1)
-	require.Nil(t, err)
+	t1id := makeTeam(t, ss).Id
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: t1id, UserId: u1.Id, SchemeAdmin: true, SchemeUser: true}, -1)
+	require.Nil(t, nErr)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: t1id, UserId: u2.Id, SchemeAdmin: true, SchemeUser: true}, -1)
+	require.Nil(t, nErr)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: t1id, UserId: u3.Id, SchemeAdmin: false, SchemeUser: false, SchemeGuest: true}, -1)
+	require.Nil(t, nErr)
 
 	testCases := []struct {
 		Description string
@@ -2476,13 +2498,13 @@ func testUserStoreSearchNotInChannel(t *testing.T, ss store.Store) {
 	u3.IsBot = true
 	defer func() { require.Nil(t, ss.Bot().PermanentDelete(u3.Id)) }()
 
-	tid := model.NewId()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: tid, UserId: u1.Id}, -1)
-	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: tid, UserId: u2.Id}, -1)
-	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: tid, UserId: u3.Id}, -1)
-	require.Nil(t, err)
+	tid := makeTeam(t, ss).Id
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: tid, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: tid, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: tid, UserId: u3.Id}, -1)
+	require.Nil(t, nErr)
 
 	// The users returned from the database will have AuthData as an empty string.
 	nilAuthData := new(string)
@@ -2705,13 +2727,13 @@ func testUserStoreSearchInChannel(t *testing.T, ss store.Store) {
 	u3.IsBot = true
 	defer func() { require.Nil(t, ss.Bot().PermanentDelete(u3.Id)) }()
 
-	tid := model.NewId()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: tid, UserId: u1.Id}, -1)
-	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: tid, UserId: u2.Id}, -1)
-	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: tid, UserId: u3.Id}, -1)
-	require.Nil(t, err)
+	tid := makeTeam(t, ss).Id
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: tid, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: tid, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: tid, UserId: u3.Id}, -1)
+	require.Nil(t, nErr)
 
 	// The users returned from the database will have AuthData as an empty string.
 	nilAuthData := new(string)
@@ -2967,22 +2989,22 @@ func testUserStoreSearchNotInTeam(t *testing.T, ss store.Store) {
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u6.Id)) }()
 
-	teamId1 := model.NewId()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId1, UserId: u1.Id}, -1)
-	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId1, UserId: u2.Id}, -1)
-	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId1, UserId: u3.Id}, -1)
-	require.Nil(t, err)
+	teamId1 := makeTeam(t, ss).Id
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId1, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId1, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId1, UserId: u3.Id}, -1)
+	require.Nil(t, nErr)
 	// u4 is not in team 1
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId1, UserId: u5.Id}, -1)
-	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId1, UserId: u6.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId1, UserId: u5.Id}, -1)
+	require.Nil(t, nErr)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId1, UserId: u6.Id}, -1)
+	require.Nil(t, nErr)
 
-	teamId2 := model.NewId()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId2, UserId: u4.Id}, -1)
-	require.Nil(t, err)
+	teamId2 := makeTeam(t, ss).Id
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId2, UserId: u4.Id}, -1)
+	require.Nil(t, nErr)
 
 	// The users returned from the database will have AuthData as an empty string.
 	nilAuthData := new(string)
@@ -3128,9 +3150,9 @@ func testUserStoreSearchWithoutTeam(t *testing.T, ss store.Store) {
 	u3.IsBot = true
 	defer func() { require.Nil(t, ss.Bot().PermanentDelete(u3.Id)) }()
 
-	tid := model.NewId()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: tid, UserId: u3.Id}, -1)
-	require.Nil(t, err)
+	tid := makeTeam(t, ss).Id
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: tid, UserId: u3.Id}, -1)
+	require.Nil(t, nErr)
 
 	// The users returned from the database will have AuthData as an empty string.
 	nilAuthData := new(string)
@@ -3338,7 +3360,7 @@ func testUserStoreSearchInGroup(t *testing.T, ss store.Store) {
 
 func testCount(t *testing.T, ss store.Store) {
 	// Regular
-	teamId := model.NewId()
+	teamId := makeTeam(t, ss).Id
 	channelId := model.NewId()
 	regularUser := &model.User{}
 	regularUser.Email = MakeEmail()
@@ -3346,8 +3368,8 @@ func testCount(t *testing.T, ss store.Store) {
 	_, err := ss.User().Save(regularUser)
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(regularUser.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: regularUser.Id, SchemeAdmin: false, SchemeUser: true}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: regularUser.Id, SchemeAdmin: false, SchemeUser: true}, -1)
+	require.Nil(t, nErr)
 	_, err = ss.Channel().SaveMember(&model.ChannelMember{UserId: regularUser.Id, ChannelId: channelId, SchemeAdmin: false, SchemeUser: true, NotifyProps: model.GetDefaultChannelNotifyProps()})
 	require.Nil(t, err)
 
@@ -3357,8 +3379,8 @@ func testCount(t *testing.T, ss store.Store) {
 	_, err = ss.User().Save(guestUser)
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(guestUser.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: guestUser.Id, SchemeAdmin: false, SchemeUser: false, SchemeGuest: true}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: guestUser.Id, SchemeAdmin: false, SchemeUser: false, SchemeGuest: true}, -1)
+	require.Nil(t, nErr)
 	_, err = ss.Channel().SaveMember(&model.ChannelMember{UserId: guestUser.Id, ChannelId: channelId, SchemeAdmin: false, SchemeUser: false, SchemeGuest: true, NotifyProps: model.GetDefaultChannelNotifyProps()})
 	require.Nil(t, err)
 
@@ -3368,8 +3390,8 @@ func testCount(t *testing.T, ss store.Store) {
 	_, err = ss.User().Save(teamAdmin)
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(teamAdmin.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: teamAdmin.Id, SchemeAdmin: true, SchemeUser: true}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: teamAdmin.Id, SchemeAdmin: true, SchemeUser: true}, -1)
+	require.Nil(t, nErr)
 	_, err = ss.Channel().SaveMember(&model.ChannelMember{UserId: teamAdmin.Id, ChannelId: channelId, SchemeAdmin: true, SchemeUser: true, NotifyProps: model.GetDefaultChannelNotifyProps()})
 	require.Nil(t, err)
 
@@ -3379,8 +3401,8 @@ func testCount(t *testing.T, ss store.Store) {
 	_, err = ss.User().Save(sysAdmin)
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(sysAdmin.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: sysAdmin.Id, SchemeAdmin: false, SchemeUser: true}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: sysAdmin.Id, SchemeAdmin: false, SchemeUser: true}, -1)
+	require.Nil(t, nErr)
 	_, err = ss.Channel().SaveMember(&model.ChannelMember{UserId: sysAdmin.Id, ChannelId: channelId, SchemeAdmin: true, SchemeUser: true, NotifyProps: model.GetDefaultChannelNotifyProps()})
 	require.Nil(t, err)
 
@@ -3398,7 +3420,7 @@ func testCount(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(botUser.Id)) }()
-	_, nErr := ss.Bot().Save(&model.Bot{
+	_, nErr = ss.Bot().Save(&model.Bot{
 		UserId:   botUser.Id,
 		Username: botUser.Username,
 		OwnerId:  regularUser.Id,
@@ -3612,6 +3634,13 @@ func testCount(t *testing.T, ss store.Store) {
 			},
 			2,
 		},
+		{
+			"Estimate falls back to the exact count on small tables",
+			model.UserCountOptions{
+				Estimate: true,
+			},
+			4,
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.Description, func(t *testing.T) {
@@ -3730,6 +3759,162 @@ func testUserStoreAnalyticsGetInactiveUsersCount(t *testing.T, ss store.Store) {
 	require.Equal(t, count, newCount-1, "Expected 1 more inactive users but found otherwise.")
 }
 
+func testUserStoreAnalyticsSeatCountsByDay(t *testing.T, ss store.Store) {
+	endTime := model.GetMillis()
+	startTime := endTime - 2*model.SEAT_USAGE_DAY_MILLIS
+
+	before, err := ss.User().AnalyticsSeatCountsByDay(startTime, endTime, false)
+	require.Nil(t, err)
+	require.Len(t, before, 3)
+	beforeExcluding, err := ss.User().AnalyticsSeatCountsByDay(startTime, endTime, true)
+	require.Nil(t, err)
+	assert.Equal(t, startTime/model.SEAT_USAGE_DAY_MILLIS*model.SEAT_USAGE_DAY_MILLIS, before[0].Day)
+	assert.Equal(t, before[0].Day+2*model.SEAT_USAGE_DAY_MILLIS, before[2].Day)
+
+	u1, err := ss.User().Save(&model.User{Email: MakeEmail(), Username: model.NewId()})
+	require.Nil(t, err)
+	defer func() { require.Nil(t, ss.User().PermanentDelete(u1.Id)) }()
+
+	u2, err := ss.User().Save(&model.User{Email: MakeEmail(), Username: model.NewId(), DeleteAt: model.GetMillis()})
+	require.Nil(t, err)
+	defer func() { require.Nil(t, ss.User().PermanentDelete(u2.Id)) }()
+
+	u3, err := ss.User().Save(&model.User{Email: MakeEmail(), Username: model.NewId()})
+	require.Nil(t, err)
+	defer func() { require.Nil(t, ss.User().PermanentDelete(u3.Id)) }()
+	_, nErr := ss.Bot().Save(&model.Bot{UserId: u3.Id, Username: u3.Username, OwnerId: u1.Id})
+	require.Nil(t, nErr)
+	defer func() { require.Nil(t, ss.Bot().PermanentDelete(u3.Id)) }()
+
+	u4, err := ss.User().Save(&model.User{Email: MakeEmail(), Username: model.NewId(), IsServiceAccount: true})
+	require.Nil(t, err)
+	defer func() { require.Nil(t, ss.User().PermanentDelete(u4.Id)) }()
+
+	after, err := ss.User().AnalyticsSeatCountsByDay(startTime, endTime, false)
+	require.Nil(t, err)
+	require.Len(t, after, 3)
+	assert.Equal(t, before[0].Seats, after[0].Seats)
+	assert.Equal(t, before[1].Seats, after[1].Seats)
+	assert.Equal(t, before[2].Seats+2, after[2].Seats, "only the active non bot users should take a seat")
+
+	afterExcluding, err := ss.User().AnalyticsSeatCountsByDay(startTime, endTime, true)
+	require.Nil(t, err)
+	assert.Equal(t, beforeExcluding[2].Seats+1, afterExcluding[2].Seats, "service accounts should not take a seat when excluded")
+
+	empty, err := ss.User().AnalyticsSeatCountsByDay(endTime, startTime, false)
+	require.Nil(t, err)
+	assert.Empty(t, empty)
+}
+
+func testUserStoreGetUsersWithExpiredRoles(t *testing.T, ss store.Store) {
+	now := model.GetMillis()
+
+	expired, err := ss.User().Save(&model.User{Email: MakeEmail(), Username: model.NewId(), Roles: "system_user system_admin", RolesExpiresAt: now - 1000})
+	require.Nil(t, err)
+	defer func() { require.Nil(t, ss.User().PermanentDelete(expired.Id)) }()
+
+	expiring, err := ss.User().Save(&model.User{Email: MakeEmail(), Username: model.NewId(), Roles: "system_user system_admin", RolesExpiresAt: now + 60*60*1000})
+	require.Nil(t, err)
+	defer func() { require.Nil(t, ss.User().PermanentDelete(expiring.Id)) }()
+
+	permanent, err := ss.User().Save(&model.User{Email: MakeEmail(), Username: model.NewId(), Roles: "system_user system_admin"})
+	require.Nil(t, err)
+	defer func() { require.Nil(t, ss.User().PermanentDelete(permanent.Id)) }()
+
+	testUserIds := map[string]bool{expired.Id: true, expiring.Id: true, permanent.Id: true}
+	userIds := func(users []*model.User) []string {
+		var ids []string
+		for _, user := range users {
+			if testUserIds[user.Id] {
+				ids = append(ids, user.Id)
+			}
+		}
+		return ids
+	}
+
+	users, err := ss.User().GetUsersWithExpiredRoles(now, 100)
+	require.Nil(t, err)
+	require.Equal(t, []string{expired.Id}, userIds(users))
+	for _, user := range users {
+		if user.Id == expired.Id {
+			assert.Equal(t, "system_user system_admin", user.Roles)
+			assert.Equal(t, "system_user", user.GetRawRoles())
+		}
+	}
+
+	users, err = ss.User().GetUsersWithExpiredRoles(now+2*60*60*1000, 100)
+	require.Nil(t, err)
+	require.Equal(t, []string{expired.Id, expiring.Id}, userIds(users))
+
+	t.Run("untrusted updates keep the expiry", func(t *testing.T) {
+		user := expiring.DeepCopy()
+		user.RolesExpiresAt = 0
+		_, err := ss.User().Update(user, false)
+		require.Nil(t, err)
+
+		updated, err := ss.User().Get(expiring.Id)
+		require.Nil(t, err)
+		assert.Equal(t, expiring.RolesExpiresAt, updated.RolesExpiresAt)
+	})
+}
+
+func testUserStoreServiceAccounts(t *testing.T, ss store.Store) {
+	countBefore, err := ss.User().Count(model.UserCountOptions{ExcludeServiceAccounts: true})
+	require.Nil(t, err)
+
+	prefix := "zz" + model.NewId()[:8]
+	account, err := ss.User().Save(&model.User{Email: MakeEmail(), Username: prefix + "a", IsServiceAccount: true})
+	require.Nil(t, err)
+	defer func() { require.Nil(t, ss.User().PermanentDelete(account.Id)) }()
+
+	deleted, err := ss.User().Save(&model.User{Email: MakeEmail(), Username: prefix + "b", IsServiceAccount: true, DeleteAt: model.GetMillis()})
+	require.Nil(t, err)
+	defer func() { require.Nil(t, ss.User().PermanentDelete(deleted.Id)) }()
+
+	regular, err := ss.User().Save(&model.User{Email: MakeEmail(), Username: prefix + "c"})
+	require.Nil(t, err)
+	defer func() { require.Nil(t, ss.User().PermanentDelete(regular.Id)) }()
+
+	t.Run("count", func(t *testing.T) {
+		count, err := ss.User().Count(model.UserCountOptions{ExcludeServiceAccounts: true})
+		require.Nil(t, err)
+		assert.Equal(t, countBefore+1, count)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		users, err := ss.User().GetServiceAccounts(0, 1000, false)
+		require.Nil(t, err)
+		containsUser := func(users []*model.User, userId string) bool {
+			for _, user := range users {
+				if user.Id == userId {
+					return true
+				}
+			}
+			return false
+		}
+		assert.True(t, containsUser(users, account.Id))
+		assert.False(t, containsUser(users, deleted.Id))
+		assert.False(t, containsUser(users, regular.Id))
+		for _, user := range users {
+			assert.True(t, user.IsServiceAccount)
+		}
+
+		users, err = ss.User().GetServiceAccounts(0, 1000, true)
+		require.Nil(t, err)
+		assert.True(t, containsUser(users, deleted.Id))
+	})
+
+	t.Run("update keeps the account type", func(t *testing.T) {
+		account.IsServiceAccount = false
+		_, err := ss.User().Update(account, true)
+		require.Nil(t, err)
+
+		ruser, err := ss.User().Get(account.Id)
+		require.Nil(t, err)
+		assert.True(t, ruser.IsServiceAccount)
+	})
+}
+
 func testUserStoreAnalyticsGetSystemAdminCount(t *testing.T, ss store.Store) {
 	countBefore, err := ss.User().AnalyticsGetSystemAdminCount()
 	require.Nil(t, err)
@@ -3795,7 +3980,7 @@ func testUserStoreAnalyticsGetGuestCount(t *testing.T, ss store.Store) {
 }
 
 func testUserStoreGetProfilesNotInTeam(t *testing.T, ss store.Store) {
-	team, err := ss.Team().Save(&model.Team{
+	team, err := ss.Team().Save(context.Background(), &model.Team{
 		DisplayName: "Team",
 		Name:        "zz" + model.NewId(),
 		Type:        model.TEAM_OPEN,
@@ -3803,7 +3988,7 @@ func testUserStoreGetProfilesNotInTeam(t *testing.T, ss store.Store) {
 	require.Nil(t, err)
 
 	teamId := team.Id
-	teamId2 := model.NewId()
+	teamId2 := makeTeam(t, ss).Id
 
 	u1, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -3811,8 +3996,8 @@ func testUserStoreGetProfilesNotInTeam(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u1.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	// Ensure update at timestamp changes
 	time.Sleep(time.Millisecond)
@@ -3823,8 +4008,8 @@ func testUserStoreGetProfilesNotInTeam(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u2.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId2, UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId2, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	// Ensure update at timestamp changes
 	time.Sleep(time.Millisecond)
@@ -3835,7 +4020,7 @@ func testUserStoreGetProfilesNotInTeam(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u3.Id)) }()
-	_, nErr := ss.Bot().Save(&model.Bot{
+	_, nErr = ss.Bot().Save(&model.Bot{
 		UserId:   u3.Id,
 		Username: u3.Username,
 		OwnerId:  u1.Id,
@@ -3880,8 +4065,8 @@ func testUserStoreGetProfilesNotInTeam(t *testing.T, ss store.Store) {
 	time.Sleep(time.Millisecond)
 
 	// Add u2 to team 1
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 	u2.UpdateAt, err = ss.User().UpdateUpdateAt(u2.Id)
 	require.Nil(t, err)
 
@@ -3901,9 +4086,9 @@ func testUserStoreGetProfilesNotInTeam(t *testing.T, ss store.Store) {
 	// Ensure update at timestamp changes
 	time.Sleep(time.Millisecond)
 
-	e := ss.Team().RemoveMember(teamId, u1.Id)
+	e := ss.Team().RemoveMember(context.Background(), teamId, u1.Id)
 	require.Nil(t, e)
-	e = ss.Team().RemoveMember(teamId, u2.Id)
+	e = ss.Team().RemoveMember(context.Background(), teamId, u2.Id)
 	require.Nil(t, e)
 
 	u1.UpdateAt, err = ss.User().UpdateUpdateAt(u1.Id)
@@ -3936,8 +4121,8 @@ func testUserStoreGetProfilesNotInTeam(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u4.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u4.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u4.Id}, -1)
+	require.Nil(t, nErr)
 
 	t.Run("etag for profiles not in team 1 after addition to team", func(t *testing.T) {
 		etag4 := ss.User().GetEtagForProfilesNotInTeam(teamId)
@@ -3945,8 +4130,8 @@ func testUserStoreGetProfilesNotInTeam(t *testing.T, ss store.Store) {
 	})
 
 	// Add u3 to team 2
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId2, UserId: u3.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId2, UserId: u3.Id}, -1)
+	require.Nil(t, nErr)
 	u3.UpdateAt, err = ss.User().UpdateUpdateAt(u3.Id)
 	require.Nil(t, err)
 
@@ -4107,7 +4292,7 @@ func testUserStoreGetAllAfter(t *testing.T, ss store.Store) {
 
 func testUserStoreGetUsersBatchForIndexing(t *testing.T, ss store.Store) {
 	// Set up all the objects needed
-	t1, err := ss.Team().Save(&model.Team{
+	t1, err := ss.Team().Save(context.Background(), &model.Team{
 		DisplayName: "Team1",
 		Name:        "zz" + model.NewId(),
 		Type:        model.TEAM_OPEN,
@@ -4151,11 +4336,11 @@ func testUserStoreGetUsersBatchForIndexing(t *testing.T, ss store.Store) {
 		CreateAt: model.GetMillis(),
 	})
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{
 		UserId: u2.Id,
 		TeamId: t1.Id,
 	}, 100)
-	require.Nil(t, err)
+	require.Nil(t, nErr)
 	_, err = ss.Channel().SaveMember(&model.ChannelMember{
 		UserId:      u2.Id,
 		ChannelId:   cPub1.Id,
@@ -4178,12 +4363,12 @@ func testUserStoreGetUsersBatchForIndexing(t *testing.T, ss store.Store) {
 		CreateAt: model.GetMillis(),
 	})
 	require.Nil(t, err)
-	_, err = ss.Team().SaveMember(&model.TeamMember{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{
 		UserId:   u3.Id,
 		TeamId:   t1.Id,
 		DeleteAt: model.GetMillis(),
 	}, 100)
-	require.Nil(t, err)
+	require.Nil(t, nErr)
 	_, err = ss.Channel().SaveMember(&model.ChannelMember{
 		UserId:      u3.Id,
 		ChannelId:   cPub2.Id,
@@ -4243,7 +4428,7 @@ func testUserStoreGetUsersBatchForIndexing(t *testing.T, ss store.Store) {
 func testUserStoreGetTeamGroupUsers(t *testing.T, ss store.Store) {
 	// create team
 	id := model.NewId()
-	team, err := ss.Team().Save(&model.Team{
+	team, err := ss.Team().Save(context.Background(), &model.Team{
 		DisplayName: "dn_" + id,
 		Name:        "n-" + id,
 		Email:       id + "@test.com",
@@ -4272,11 +4457,11 @@ func testUserStoreGetTeamGroupUsers(t *testing.T, ss store.Store) {
 	userGroupA, userGroupB, userNoGroup := testUsers[0], testUsers[1], testUsers[2]
 
 	// add non-group-member to the team (to prove that the query isn't just returning all members)
-	_, err = ss.Team().SaveMember(&model.TeamMember{
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{
 		TeamId: team.Id,
 		UserId: userNoGroup.Id,
 	}, 999)
-	require.Nil(t, err)
+	require.Nil(t, nErr)
 
 	// create groups
 	var testGroups []*model.Group
@@ -4325,7 +4510,7 @@ func testUserStoreGetTeamGroupUsers(t *testing.T, ss store.Store) {
 
 	// update team to be group-constrained
 	team.GroupConstrained = model.NewBool(true)
-	team, err = ss.Team().Update(team)
+	team, err = ss.Team().Update(context.Background(), team)
 	require.Nil(t, err)
 
 	// still returns user (being group-constrained has no effect)
@@ -4344,17 +4529,17 @@ func testUserStoreGetTeamGroupUsers(t *testing.T, ss store.Store) {
 	requireNUsers(2)
 
 	// add team membership of allowed user
-	_, err = ss.Team().SaveMember(&model.TeamMember{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{
 		TeamId: team.Id,
 		UserId: userGroupA.Id,
 	}, 999)
-	require.Nil(t, err)
+	require.Nil(t, nErr)
 
 	// ensure allowed member still returned by query
 	requireNUsers(2)
 
 	// delete team membership of allowed user
-	err = ss.Team().RemoveMember(team.Id, userGroupA.Id)
+	err = ss.Team().RemoveMember(context.Background(), team.Id, userGroupA.Id)
 	require.Nil(t, err)
 
 	// ensure removed allowed member still returned by query
@@ -4498,9 +4683,9 @@ func testUserStorePromoteGuestToUser(t *testing.T, ss store.Store) {
 		require.Nil(t, err)
 		defer func() { require.Nil(t, ss.User().PermanentDelete(user.Id)) }()
 
-		teamId := model.NewId()
-		_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: user.Id, SchemeGuest: true, SchemeUser: false}, 999)
-		require.Nil(t, err)
+		teamId := makeTeam(t, ss).Id
+		_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: user.Id, SchemeGuest: true, SchemeUser: false}, 999)
+		require.Nil(t, nErr)
 
 		channel, nErr := ss.Channel().Save(&model.Channel{
 			TeamId:      teamId,
@@ -4519,8 +4704,8 @@ func testUserStorePromoteGuestToUser(t *testing.T, ss store.Store) {
 		require.Equal(t, "system_user", updatedUser.Roles)
 		require.True(t, user.UpdateAt < updatedUser.UpdateAt)
 
-		updatedTeamMember, err := ss.Team().GetMember(teamId, user.Id)
-		require.Nil(t, err)
+		updatedTeamMember, nErr := ss.Team().GetMember(context.Background(), teamId, user.Id, false)
+		require.Nil(t, nErr)
 		require.False(t, updatedTeamMember.SchemeGuest)
 		require.True(t, updatedTeamMember.SchemeUser)
 
@@ -4544,9 +4729,9 @@ func testUserStorePromoteGuestToUser(t *testing.T, ss store.Store) {
 		require.Nil(t, err)
 		defer func() { require.Nil(t, ss.User().PermanentDelete(user.Id)) }()
 
-		teamId := model.NewId()
-		_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: user.Id, SchemeGuest: true, SchemeUser: false}, 999)
-		require.Nil(t, err)
+		teamId := makeTeam(t, ss).Id
+		_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: user.Id, SchemeGuest: true, SchemeUser: false}, 999)
+		require.Nil(t, nErr)
 
 		channel, nErr := ss.Channel().Save(&model.Channel{
 			TeamId:      teamId,
@@ -4564,8 +4749,8 @@ func testUserStorePromoteGuestToUser(t *testing.T, ss store.Store) {
 		require.Nil(t, err)
 		require.Equal(t, "system_user system_admin", updatedUser.Roles)
 
-		updatedTeamMember, err := ss.Team().GetMember(teamId, user.Id)
-		require.Nil(t, err)
+		updatedTeamMember, nErr := ss.Team().GetMember(context.Background(), teamId, user.Id, false)
+		require.Nil(t, nErr)
 		require.False(t, updatedTeamMember.SchemeGuest)
 		require.True(t, updatedTeamMember.SchemeUser)
 
@@ -4610,9 +4795,9 @@ func testUserStorePromoteGuestToUser(t *testing.T, ss store.Store) {
 		require.Nil(t, err)
 		defer func() { require.Nil(t, ss.User().PermanentDelete(user.Id)) }()
 
-		teamId := model.NewId()
-		_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: user.Id, SchemeGuest: true, SchemeUser: false}, 999)
-		require.Nil(t, err)
+		teamId := makeTeam(t, ss).Id
+		_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: user.Id, SchemeGuest: true, SchemeUser: false}, 999)
+		require.Nil(t, nErr)
 
 		err = ss.User().PromoteGuestToUser(user.Id)
 		require.Nil(t, err)
@@ -4620,8 +4805,8 @@ func testUserStorePromoteGuestToUser(t *testing.T, ss store.Store) {
 		require.Nil(t, err)
 		require.Equal(t, "system_user", updatedUser.Roles)
 
-		updatedTeamMember, err := ss.Team().GetMember(teamId, user.Id)
-		require.Nil(t, err)
+		updatedTeamMember, nErr := ss.Team().GetMember(context.Background(), teamId, user.Id, false)
+		require.Nil(t, nErr)
 		require.False(t, updatedTeamMember.SchemeGuest)
 		require.True(t, updatedTeamMember.SchemeUser)
 	})
@@ -4640,9 +4825,9 @@ func testUserStorePromoteGuestToUser(t *testing.T, ss store.Store) {
 		require.Nil(t, err)
 		defer func() { require.Nil(t, ss.User().PermanentDelete(user.Id)) }()
 
-		teamId := model.NewId()
-		_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: user.Id, SchemeGuest: true, SchemeUser: false}, 999)
-		require.Nil(t, err)
+		teamId := makeTeam(t, ss).Id
+		_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: user.Id, SchemeGuest: true, SchemeUser: false}, 999)
+		require.Nil(t, nErr)
 
 		channel, nErr := ss.Channel().Save(&model.Channel{
 			TeamId:      teamId,
@@ -4660,8 +4845,8 @@ func testUserStorePromoteGuestToUser(t *testing.T, ss store.Store) {
 		require.Nil(t, err)
 		require.Equal(t, "system_user", updatedUser.Roles)
 
-		updatedTeamMember, err := ss.Team().GetMember(teamId, user.Id)
-		require.Nil(t, err)
+		updatedTeamMember, nErr := ss.Team().GetMember(context.Background(), teamId, user.Id, false)
+		require.Nil(t, nErr)
 		require.False(t, updatedTeamMember.SchemeGuest)
 		require.True(t, updatedTeamMember.SchemeUser)
 
@@ -4685,9 +4870,9 @@ func testUserStorePromoteGuestToUser(t *testing.T, ss store.Store) {
 		require.Nil(t, err)
 		defer func() { require.Nil(t, ss.User().PermanentDelete(user.Id)) }()
 
-		teamId := model.NewId()
-		_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: user.Id, SchemeGuest: true, SchemeUser: false}, 999)
-		require.Nil(t, err)
+		teamId := makeTeam(t, ss).Id
+		_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: user.Id, SchemeGuest: true, SchemeUser: false}, 999)
+		require.Nil(t, nErr)
 
 		channel, nErr := ss.Channel().Save(&model.Channel{
 			TeamId:      teamId,
@@ -4705,8 +4890,8 @@ func testUserStorePromoteGuestToUser(t *testing.T, ss store.Store) {
 		require.Nil(t, err)
 		require.Equal(t, "system_user custom_role", updatedUser.Roles)
 
-		updatedTeamMember, err := ss.Team().GetMember(teamId, user.Id)
-		require.Nil(t, err)
+		updatedTeamMember, nErr := ss.Team().GetMember(context.Background(), teamId, user.Id, false)
+		require.Nil(t, nErr)
 		require.False(t, updatedTeamMember.SchemeGuest)
 		require.True(t, updatedTeamMember.SchemeUser)
 
@@ -4730,9 +4915,9 @@ func testUserStorePromoteGuestToUser(t *testing.T, ss store.Store) {
 		require.Nil(t, err)
 		defer func() { require.Nil(t, ss.User().PermanentDelete(user1.Id)) }()
 
-		teamId1 := model.NewId()
-		_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId1, UserId: user1.Id, SchemeGuest: true, SchemeUser: false}, 999)
-		require.Nil(t, err)
+		teamId1 := makeTeam(t, ss).Id
+		_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId1, UserId: user1.Id, SchemeGuest: true, SchemeUser: false}, 999)
+		require.Nil(t, nErr)
 
 		channel, nErr := ss.Channel().Save(&model.Channel{
 			TeamId:      teamId1,
@@ -4758,9 +4943,9 @@ func testUserStorePromoteGuestToUser(t *testing.T, ss store.Store) {
 		require.Nil(t, err)
 		defer func() { require.Nil(t, ss.User().PermanentDelete(user2.Id)) }()
 
-		teamId2 := model.NewId()
-		_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId2, UserId: user2.Id, SchemeGuest: true, SchemeUser: false}, 999)
-		require.Nil(t, err)
+		teamId2 := makeTeam(t, ss).Id
+		_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId2, UserId: user2.Id, SchemeGuest: true, SchemeUser: false}, 999)
+		require.Nil(t, nErr)
 
 		_, err = ss.Channel().SaveMember(&model.ChannelMember{ChannelId: channel.Id, UserId: user2.Id, SchemeGuest: true, SchemeUser: false, NotifyProps: model.GetDefaultChannelNotifyProps()})
 		require.Nil(t, err)
@@ -4771,8 +4956,8 @@ func testUserStorePromoteGuestToUser(t *testing.T, ss store.Store) {
 		require.Nil(t, err)
 		require.Equal(t, "system_user", updatedUser.Roles)
 
-		updatedTeamMember, err := ss.Team().GetMember(teamId1, user1.Id)
-		require.Nil(t, err)
+		updatedTeamMember, nErr := ss.Team().GetMember(context.Background(), teamId1, user1.Id, false)
+		require.Nil(t, nErr)
 		require.False(t, updatedTeamMember.SchemeGuest)
 		require.True(t, updatedTeamMember.SchemeUser)
 
@@ -4785,8 +4970,8 @@ func testUserStorePromoteGuestToUser(t *testing.T, ss store.Store) {
 		require.Nil(t, err)
 		require.Equal(t, "system_guest", notUpdatedUser.Roles)
 
-		notUpdatedTeamMember, err := ss.Team().GetMember(teamId2, user2.Id)
-		require.Nil(t, err)
+		notUpdatedTeamMember, nErr := ss.Team().GetMember(context.Background(), teamId2, user2.Id, false)
+		require.Nil(t, nErr)
 		require.True(t, notUpdatedTeamMember.SchemeGuest)
 		require.False(t, notUpdatedTeamMember.SchemeUser)
 
@@ -4813,9 +4998,9 @@ func testUserStoreDemoteUserToGuest(t *testing.T, ss store.Store) {
 		require.Nil(t, err)
 		defer func() { require.Nil(t, ss.User().PermanentDelete(user.Id)) }()
 
-		teamId := model.NewId()
-		_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: user.Id, SchemeGuest: false, SchemeUser: true}, 999)
-		require.Nil(t, err)
+		teamId := makeTeam(t, ss).Id
+		_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: user.Id, SchemeGuest: false, SchemeUser: true}, 999)
+		require.Nil(t, nErr)
 
 		channel, nErr := ss.Channel().Save(&model.Channel{
 			TeamId:      teamId,
@@ -4834,8 +5019,8 @@ func testUserStoreDemoteUserToGuest(t *testing.T, ss store.Store) {
 		require.Equal(t, "system_guest", updatedUser.Roles)
 		require.True(t, user.UpdateAt < updatedUser.UpdateAt)
 
-		updatedTeamMember, err := ss.Team().GetMember(teamId, user.Id)
-		require.Nil(t, err)
+		updatedTeamMember, nErr := ss.Team().GetMember(context.Background(), teamId, user.Id, false)
+		require.Nil(t, nErr)
 		require.True(t, updatedTeamMember.SchemeGuest)
 		require.False(t, updatedTeamMember.SchemeUser)
 
@@ -4859,9 +5044,9 @@ func testUserStoreDemoteUserToGuest(t *testing.T, ss store.Store) {
 		require.Nil(t, err)
 		defer func() { require.Nil(t, ss.User().PermanentDelete(user.Id)) }()
 
-		teamId := model.NewId()
-		_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: user.Id, SchemeGuest: true, SchemeUser: false}, 999)
-		require.Nil(t, err)
+		teamId := makeTeam(t, ss).Id
+		_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: user.Id, SchemeGuest: true, SchemeUser: false}, 999)
+		require.Nil(t, nErr)
 
 		channel, nErr := ss.Channel().Save(&model.Channel{
 			TeamId:      teamId,
@@ -4879,8 +5064,8 @@ func testUserStoreDemoteUserToGuest(t *testing.T, ss store.Store) {
 		require.Nil(t, err)
 		require.Equal(t, "system_guest", updatedUser.Roles)
 
-		updatedTeamMember, err := ss.Team().GetMember(teamId, user.Id)
-		require.Nil(t, err)
+		updatedTeamMember, nErr := ss.Team().GetMember(context.Background(), teamId, user.Id, false)
+		require.Nil(t, nErr)
 		require.True(t, updatedTeamMember.SchemeGuest)
 		require.False(t, updatedTeamMember.SchemeUser)
 
@@ -4925,9 +5110,9 @@ func testUserStoreDemoteUserToGuest(t *testing.T, ss store.Store) {
 		require.Nil(t, err)
 		defer func() { require.Nil(t, ss.User().PermanentDelete(user.Id)) }()
 
-		teamId := model.NewId()
-		_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: user.Id, SchemeGuest: false, SchemeUser: true}, 999)
-		require.Nil(t, err)
+		teamId := makeTeam(t, ss).Id
+		_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: user.Id, SchemeGuest: false, SchemeUser: true}, 999)
+		require.Nil(t, nErr)
 
 		err = ss.User().DemoteUserToGuest(user.Id)
 		require.Nil(t, err)
@@ -4935,8 +5120,8 @@ func testUserStoreDemoteUserToGuest(t *testing.T, ss store.Store) {
 		require.Nil(t, err)
 		require.Equal(t, "system_guest", updatedUser.Roles)
 
-		updatedTeamMember, err := ss.Team().GetMember(teamId, user.Id)
-		require.Nil(t, err)
+		updatedTeamMember, nErr := ss.Team().GetMember(context.Background(), teamId, user.Id, false)
+		require.Nil(t, nErr)
 		require.True(t, updatedTeamMember.SchemeGuest)
 		require.False(t, updatedTeamMember.SchemeUser)
 	})
@@ -4955,9 +5140,9 @@ func testUserStoreDemoteUserToGuest(t *testing.T, ss store.Store) {
 		require.Nil(t, err)
 		defer func() { require.Nil(t, ss.User().PermanentDelete(user.Id)) }()
 
-		teamId := model.NewId()
-		_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: user.Id, SchemeGuest: false, SchemeUser: true}, 999)
-		require.Nil(t, err)
+		teamId := makeTeam(t, ss).Id
+		_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: user.Id, SchemeGuest: false, SchemeUser: true}, 999)
+		require.Nil(t, nErr)
 
 		channel, nErr := ss.Channel().Save(&model.Channel{
 			TeamId:      teamId,
@@ -4975,8 +5160,8 @@ func testUserStoreDemoteUserToGuest(t *testing.T, ss store.Store) {
 		require.Nil(t, err)
 		require.Equal(t, "system_guest", updatedUser.Roles)
 
-		updatedTeamMember, err := ss.Team().GetMember(teamId, user.Id)
-		require.Nil(t, err)
+		updatedTeamMember, nErr := ss.Team().GetMember(context.Background(), teamId, user.Id, false)
+		require.Nil(t, nErr)
 		require.True(t, updatedTeamMember.SchemeGuest)
 		require.False(t, updatedTeamMember.SchemeUser)
 
@@ -5000,9 +5185,9 @@ func testUserStoreDemoteUserToGuest(t *testing.T, ss store.Store) {
 		require.Nil(t, err)
 		defer func() { require.Nil(t, ss.User().PermanentDelete(user.Id)) }()
 
-		teamId := model.NewId()
-		_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: user.Id, SchemeGuest: false, SchemeUser: true}, 999)
-		require.Nil(t, err)
+		teamId := makeTeam(t, ss).Id
+		_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: user.Id, SchemeGuest: false, SchemeUser: true}, 999)
+		require.Nil(t, nErr)
 
 		channel, nErr := ss.Channel().Save(&model.Channel{
 			TeamId:      teamId,
@@ -5020,8 +5205,8 @@ func testUserStoreDemoteUserToGuest(t *testing.T, ss store.Store) {
 		require.Nil(t, err)
 		require.Equal(t, "system_guest custom_role", updatedUser.Roles)
 
-		updatedTeamMember, err := ss.Team().GetMember(teamId, user.Id)
-		require.Nil(t, err)
+		updatedTeamMember, nErr := ss.Team().GetMember(context.Background(), teamId, user.Id, false)
+		require.Nil(t, nErr)
 		require.True(t, updatedTeamMember.SchemeGuest)
 		require.False(t, updatedTeamMember.SchemeUser)
 
@@ -5045,9 +5230,9 @@ func testUserStoreDemoteUserToGuest(t *testing.T, ss store.Store) {
 		require.Nil(t, err)
 		defer func() { require.Nil(t, ss.User().PermanentDelete(user1.Id)) }()
 
-		teamId1 := model.NewId()
-		_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId1, UserId: user1.Id, SchemeGuest: false, SchemeUser: true}, 999)
-		require.Nil(t, err)
+		teamId1 := makeTeam(t, ss).Id
+		_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId1, UserId: user1.Id, SchemeGuest: false, SchemeUser: true}, 999)
+		require.Nil(t, nErr)
 
 		channel, nErr := ss.Channel().Save(&model.Channel{
 			TeamId:      teamId1,
@@ -5073,9 +5258,9 @@ func testUserStoreDemoteUserToGuest(t *testing.T, ss store.Store) {
 		require.Nil(t, err)
 		defer func() { require.Nil(t, ss.User().PermanentDelete(user2.Id)) }()
 
-		teamId2 := model.NewId()
-		_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId2, UserId: user2.Id, SchemeGuest: false, SchemeUser: true}, 999)
-		require.Nil(t, err)
+		teamId2 := makeTeam(t, ss).Id
+		_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId2, UserId: user2.Id, SchemeGuest: false, SchemeUser: true}, 999)
+		require.Nil(t, nErr)
 
 		_, err = ss.Channel().SaveMember(&model.ChannelMember{ChannelId: channel.Id, UserId: user2.Id, SchemeGuest: false, SchemeUser: true, NotifyProps: model.GetDefaultChannelNotifyProps()})
 		require.Nil(t, err)
@@ -5086,8 +5271,8 @@ func testUserStoreDemoteUserToGuest(t *testing.T, ss store.Store) {
 		require.Nil(t, err)
 		require.Equal(t, "system_guest", updatedUser.Roles)
 
-		updatedTeamMember, err := ss.Team().GetMember(teamId1, user1.Id)
-		require.Nil(t, err)
+		updatedTeamMember, nErr := ss.Team().GetMember(context.Background(), teamId1, user1.Id, false)
+		require.Nil(t, nErr)
 		require.True(t, updatedTeamMember.SchemeGuest)
 		require.False(t, updatedTeamMember.SchemeUser)
 
@@ -5100,8 +5285,8 @@ func testUserStoreDemoteUserToGuest(t *testing.T, ss store.Store) {
 		require.Nil(t, err)
 		require.Equal(t, "system_user", notUpdatedUser.Roles)
 
-		notUpdatedTeamMember, err := ss.Team().GetMember(teamId2, user2.Id)
-		require.Nil(t, err)
+		notUpdatedTeamMember, nErr := ss.Team().GetMember(context.Background(), teamId2, user2.Id, false)
+		require.Nil(t, nErr)
 		require.False(t, notUpdatedTeamMember.SchemeGuest)
 		require.True(t, notUpdatedTeamMember.SchemeUser)
 
@@ -5196,8 +5381,8 @@ func testUserStoreResetLastPictureUpdate(t *testing.T, ss store.Store) {
 	_, err := ss.User().Save(u1)
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u1.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: model.NewId(), UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: makeTeam(t, ss).Id, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	err = ss.User().UpdateLastPictureUpdate(u1.Id)
 	require.Nil(t, err)
@@ -5224,7 +5409,7 @@ func testUserStoreResetLastPictureUpdate(t *testing.T, ss store.Store) {
 }
 
 func testGetKnownUsers(t *testing.T, ss store.Store) {
-	teamId := model.NewId()
+	teamId := makeTeam(t, ss).Id
 
 	u1, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -5232,8 +5417,8 @@ func testGetKnownUsers(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u1.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
-	require.Nil(t, err)
+	_, nErr := ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u1.Id}, -1)
+	require.Nil(t, nErr)
 
 	u2, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -5241,8 +5426,8 @@ func testGetKnownUsers(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u2.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u2.Id}, -1)
+	require.Nil(t, nErr)
 
 	u3, err := ss.User().Save(&model.User{
 		Email:    MakeEmail(),
@@ -5250,9 +5435,9 @@ func testGetKnownUsers(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u3.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
-	require.Nil(t, err)
-	_, nErr := ss.Bot().Save(&model.Bot{
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u3.Id}, -1)
+	require.Nil(t, nErr)
+	_, nErr = ss.Bot().Save(&model.Bot{
 		UserId:   u3.Id,
 		Username: u3.Username,
 		OwnerId:  u1.Id,
@@ -5268,8 +5453,8 @@ func testGetKnownUsers(t *testing.T, ss store.Store) {
 	})
 	require.Nil(t, err)
 	defer func() { require.Nil(t, ss.User().PermanentDelete(u4.Id)) }()
-	_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: teamId, UserId: u4.Id}, -1)
-	require.Nil(t, err)
+	_, nErr = ss.Team().SaveMember(context.Background(), &model.TeamMember{TeamId: teamId, UserId: u4.Id}, -1)
+	require.Nil(t, nErr)
 
 	ch1 := &model.Channel{
 		TeamId:      teamId,
@@ -5353,3 +5538,165 @@ func testGetKnownUsers(t *testing.T, ss store.Store) {
 		assert.ElementsMatch(t, userIds, []string{u2.Id, u3.Id})
 	})
 }
+
+func testUserStoreReportingLines(t *testing.T, ss store.Store) {
+	newUser := func(managerId string) *model.User {
+		user, err := ss.User().Save(&model.User{
+			Email:     MakeEmail(),
+			Username:  "u" + model.NewId(),
+			ManagerId: managerId,
+		})
+		require.Nil(t, err)
+		return user
+	}
+
+	ceo := newUser("")
+	vp := newUser(ceo.Id)
+	engineer := newUser(vp.Id)
+	designer := newUser(vp.Id)
+	deactivated := newUser(vp.Id)
+	deactivated.DeleteAt = model.GetMillis()
+	_, err := ss.User().Update(deactivated, true)
+	require.Nil(t, err)
+
+	t.Run("direct reports", func(t *testing.T) {
+		reports, err := ss.User().GetDirectReports(vp.Id, 0, 100)
+		require.Nil(t, err)
+		require.Len(t, reports, 2)
+		assert.ElementsMatch(t, []string{engineer.Id, designer.Id}, []string{reports[0].Id, reports[1].Id})
+
+		reports, err = ss.User().GetDirectReports(engineer.Id, 0, 100)
+		require.Nil(t, err)
+		assert.Empty(t, reports)
+	})
+
+	t.Run("reporting chain", func(t *testing.T) {
+		chain, err := ss.User().GetReportingChain(engineer.Id)
+		require.Nil(t, err)
+		require.Len(t, chain, 2)
+		assert.Equal(t, vp.Id, chain[0].Id)
+		assert.Equal(t, ceo.Id, chain[1].Id)
+
+		chain, err = ss.User().GetReportingChain(ceo.Id)
+		require.Nil(t, err)
+		assert.Empty(t, chain)
+	})
+
+	t.Run("manager is only changed by trusted updates", func(t *testing.T) {
+		engineer.ManagerId = ceo.Id
+		_, err := ss.User().Update(engineer, false)
+		require.Nil(t, err)
+
+		user, err := ss.User().Get(engineer.Id)
+		require.Nil(t, err)
+		assert.Equal(t, vp.Id, user.ManagerId)
+
+		_, err = ss.User().Update(engineer, true)
+		require.Nil(t, err)
+
+		user, err = ss.User().Get(engineer.Id)
+		require.Nil(t, err)
+		assert.Equal(t, ceo.Id, user.ManagerId)
+	})
+
+	t.Run("reporting chain stops at loops", func(t *testing.T) {
+		ceo.ManagerId = designer.Id
+		_, err := ss.User().Update(ceo, true)
+		require.Nil(t, err)
+
+		chain, err := ss.User().GetReportingChain(designer.Id)
+		require.Nil(t, err)
+		require.Len(t, chain, 2)
+		assert.Equal(t, vp.Id, chain[0].Id)
+		assert.Equal(t, ceo.Id, chain[1].Id)
+	})
+}
+
+func testUserStoreAccountFilters(t *testing.T, ss store.Store) {
+	neverLoggedIn, err := ss.User().Save(&model.User{
+		Email:    MakeEmail(),
+		Username: "u" + model.NewId(),
+	})
+	require.Nil(t, err)
+	defer func() { require.Nil(t, ss.User().PermanentDelete(neverLoggedIn.Id)) }()
+
+	dormant, err := ss.User().Save(&model.User{
+		Email:       MakeEmail(),
+		Username:    "u" + model.NewId(),
+		AuthService: model.USER_AUTH_SERVICE_LDAP,
+		AuthData:    model.NewString(model.NewId()),
+	})
+	require.Nil(t, err)
+	defer func() { require.Nil(t, ss.User().PermanentDelete(dormant.Id)) }()
+	require.Nil(t, ss.User().UpdateLastLogin(dormant.Id, 1000))
+	require.Nil(t, ss.User().UpdateMfaActive(dormant.Id, true))
+
+	active, err := ss.User().Save(&model.User{
+		Email:    MakeEmail(),
+		Username: "u" + model.NewId(),
+	})
+	require.Nil(t, err)
+	defer func() { require.Nil(t, ss.User().PermanentDelete(active.Id)) }()
+	require.Nil(t, ss.User().UpdateLastLogin(active.Id, model.GetMillis()))
+
+	testIds := map[string]bool{neverLoggedIn.Id: true, dormant.Id: true, active.Id: true}
+	getIds := func(filters model.UserAccountFilters) []string {
+		users, err := ss.User().GetAllProfiles(&model.UserGetOptions{
+			UserAccountFilters: filters,
+			Page:               0,
+			PerPage:            1000,
+		})
+		require.Nil(t, err)
+
+		ids := []string{}
+		for _, user := range users {
+			if testIds[user.Id] {
+				ids = append(ids, user.Id)
+			}
+		}
+		return ids
+	}
+
+	t.Run("last login is kept on update", func(t *testing.T) {
+		_, err := ss.User().Update(dormant, true)
+		require.Nil(t, err)
+
+		user, err := ss.User().Get(dormant.Id)
+		require.Nil(t, err)
+		assert.Equal(t, int64(1000), user.LastLogin)
+	})
+
+	t.Run("never logged in", func(t *testing.T) {
+		assert.ElementsMatch(t, []string{neverLoggedIn.Id}, getIds(model.UserAccountFilters{NeverLoggedIn: true}))
+	})
+
+	t.Run("last login before", func(t *testing.T) {
+		assert.ElementsMatch(t, []string{neverLoggedIn.Id, dormant.Id}, getIds(model.UserAccountFilters{LastLoginBefore: 2000}))
+	})
+
+	t.Run("auth service", func(t *testing.T) {
+		assert.ElementsMatch(t, []string{dormant.Id}, getIds(model.UserAccountFilters{AuthService: model.USER_AUTH_SERVICE_LDAP}))
+		assert.ElementsMatch(t, []string{neverLoggedIn.Id, active.Id}, getIds(model.UserAccountFilters{AuthService: model.USER_AUTH_SERVICE_EMAIL}))
+	})
+
+	t.Run("mfa", func(t *testing.T) {
+		assert.ElementsMatch(t, []string{dormant.Id}, getIds(model.UserAccountFilters{MfaActive: true}))
+		assert.ElementsMatch(t, []string{neverLoggedIn.Id, active.Id}, getIds(model.UserAccountFilters{MfaInactive: true}))
+	})
+
+	t.Run("search", func(t *testing.T) {
+		options := &model.UserSearchOptions{
+			AllowFullNames:     true,
+			Limit:              model.USER_SEARCH_MAX_LIMIT,
+			UserAccountFilters: model.UserAccountFilters{NeverLoggedIn: true},
+		}
+		users, err := ss.User().SearchWithoutTeam(neverLoggedIn.Username, options)
+		require.Nil(t, err)
+		require.Len(t, users, 1)
+		assert.Equal(t, neverLoggedIn.Id, users[0].Id)
+
+		users, err = ss.User().SearchWithoutTeam(active.Username, options)
+		require.Nil(t, err)
+		assert.Empty(t, users)
+	})
+}
@@ -20,32 +20,55 @@ type TimerLayer struct {
 	AuditStore                AuditStore
 	BotStore                  BotStore
 	ChannelStore              ChannelStore
+	ChannelBookmarkStore      ChannelBookmarkStore
+	ChannelChangeStore        ChannelChangeStore
+	ChannelGuestLinkStore     ChannelGuestLinkStore
+	ChannelIntegrationStore   ChannelIntegrationStore
 	ChannelMemberHistoryStore ChannelMemberHistoryStore
 	ClusterDiscoveryStore     ClusterDiscoveryStore
 	CommandStore              CommandStore
 	CommandWebhookStore       CommandWebhookStore
 	ComplianceStore           ComplianceStore
+	EmailVerificationStore    EmailVerificationStore
 	EmojiStore                EmojiStore
+	EventStreamStore          EventStreamStore
 	FileInfoStore             FileInfoStore
 	GroupStore                GroupStore
+	IntegrationUsageStore     IntegrationUsageStore
 	JobStore                  JobStore
 	LicenseStore              LicenseStore
 	LinkMetadataStore         LinkMetadataStore
+	LoginHistoryStore         LoginHistoryStore
 	OAuthStore                OAuthStore
+	OutboxStore               OutboxStore
+	PendingPinStore           PendingPinStore
 	PluginStore               PluginStore
 	PostStore                 PostStore
+	PostAcknowledgementStore  PostAcknowledgementStore
+	PostArchiveStore          PostArchiveStore
+	PostIntegrityStore        PostIntegrityStore
+	PostsPartitionStore       PostsPartitionStore
 	PreferenceStore           PreferenceStore
+	PresenceWebhookStore      PresenceWebhookStore
 	ReactionStore             ReactionStore
+	RetentionPolicyStore      RetentionPolicyStore
 	RoleStore                 RoleStore
 	SchemeStore               SchemeStore
 	SessionStore              SessionStore
+	SlugHistoryStore          SlugHistoryStore
 	StatusStore               StatusStore
+	StatusAutomationStore     StatusAutomationStore
 	SystemStore               SystemStore
 	TeamStore                 TeamStore
+	TeamInviteLinkStore       TeamInviteLinkStore
+	TeamMemberHistoryStore    TeamMemberHistoryStore
 	TermsOfServiceStore       TermsOfServiceStore
+	TermsOfServicePolicyStore TermsOfServicePolicyStore
 	TokenStore                TokenStore
 	UserStore                 UserStore
 	UserAccessTokenStore      UserAccessTokenStore
+	UserPropertyStore         UserPropertyStore
+	UserRelationshipStore     UserRelationshipStore
 	UserTermsOfServiceStore   UserTermsOfServiceStore
 	WebhookStore              WebhookStore
 }
@@ -62,6 +85,22 @@ func (s *TimerLayer) Channel() ChannelStore {
 	return s.ChannelStore
 }
 
+func (s *TimerLayer) ChannelBookmark() ChannelBookmarkStore {
+	return s.ChannelBookmarkStore
+}
+
+func (s *TimerLayer) ChannelChange() ChannelChangeStore {
+	return s.ChannelChangeStore
+}
+
+func (s *TimerLayer) ChannelGuestLink() ChannelGuestLinkStore {
+	return s.ChannelGuestLinkStore
+}
+
+func (s *TimerLayer) ChannelIntegration() ChannelIntegrationStore {
+	return s.ChannelIntegrationStore
+}
+
 func (s *TimerLayer) ChannelMemberHistory() ChannelMemberHistoryStore {
 	return s.ChannelMemberHistoryStore
 }
@@ -82,10 +121,18 @@ func (s *TimerLayer) Compliance() ComplianceStore {
 	return s.ComplianceStore
 }
 
+func (s *TimerLayer) EmailVerification() EmailVerificationStore {
+	return s.EmailVerificationStore
+}
+
 func (s *TimerLayer) Emoji() EmojiStore {
 	return s.EmojiStore
 }
 
+func (s *TimerLayer) EventStream() EventStreamStore {
+	return s.EventStreamStore
+}
+
 func (s *TimerLayer) FileInfo() FileInfoStore {
 	return s.FileInfoStore
 }
@@ -94,6 +141,10 @@ func (s *TimerLayer) Group() GroupStore {
 	return s.GroupStore
 }
 
+func (s *TimerLayer) IntegrationUsage() IntegrationUsageStore {
+	return s.IntegrationUsageStore
+}
+
 func (s *TimerLayer) Job() JobStore {
 	return s.JobStore
 }
@@ -106,10 +157,22 @@ func (s *TimerLayer) LinkMetadata() LinkMetadataStore {
 	return s.LinkMetadataStore
 }
 
+func (s *TimerLayer) LoginHistory() LoginHistoryStore {
+	return s.LoginHistoryStore
+}
+
 func (s *TimerLayer) OAuth() OAuthStore {
 	return s.OAuthStore
 }
 
+func (s *TimerLayer) Outbox() OutboxStore {
+	return s.OutboxStore
+}
+
+func (s *TimerLayer) PendingPin() PendingPinStore {
+	return s.PendingPinStore
+}
+
 func (s *TimerLayer) Plugin() PluginStore {
 	return s.PluginStore
 }
@@ -118,14 +181,38 @@ func (s *TimerLayer) Post() PostStore {
 	return s.PostStore
 }
 
+func (s *TimerLayer) PostAcknowledgement() PostAcknowledgementStore {
+	return s.PostAcknowledgementStore
+}
+
+func (s *TimerLayer) PostArchive() PostArchiveStore {
+	return s.PostArchiveStore
+}
+
+func (s *TimerLayer) PostIntegrity() PostIntegrityStore {
+	return s.PostIntegrityStore
+}
+
+func (s *TimerLayer) PostsPartition() PostsPartitionStore {
+	return s.PostsPartitionStore
+}
+
 func (s *TimerLayer) Preference() PreferenceStore {
 	return s.PreferenceStore
 }
 
+func (s *TimerLayer) PresenceWebhook() PresenceWebhookStore {
+	return s.PresenceWebhookStore
+}
+
 func (s *TimerLayer) Reaction() ReactionStore {
 	return s.ReactionStore
 }
 
+func (s *TimerLayer) RetentionPolicy() RetentionPolicyStore {
+	return s.RetentionPolicyStore
+}
+
 func (s *TimerLayer) Role() RoleStore {
 	return s.RoleStore
 }
@@ -138,10 +225,18 @@ func (s *TimerLayer) Session() SessionStore {
 	return s.SessionStore
 }
 
+func (s *TimerLayer) SlugHistory() SlugHistoryStore {
+	return s.SlugHistoryStore
+}
+
 func (s *TimerLayer) Status() StatusStore {
 	return s.StatusStore
 }
 
+func (s *TimerLayer) StatusAutomation() StatusAutomationStore {
+	return s.StatusAutomationStore
+}
+
 func (s *TimerLayer) System() SystemStore {
 	return s.SystemStore
 }
@@ -150,10 +245,22 @@ func (s *TimerLayer) Team() TeamStore {
 	return s.TeamStore
 }
 
+func (s *TimerLayer) TeamInviteLink() TeamInviteLinkStore {
+	return s.TeamInviteLinkStore
+}
+
+func (s *TimerLayer) TeamMemberHistory() TeamMemberHistoryStore {
+	return s.TeamMemberHistoryStore
+}
+
 func (s *TimerLayer) TermsOfService() TermsOfServiceStore {
 	return s.TermsOfServiceStore
 }
 
+func (s *TimerLayer) TermsOfServicePolicy() TermsOfServicePolicyStore {
+	return s.TermsOfServicePolicyStore
+}
+
 func (s *TimerLayer) Token() TokenStore {
 	return s.TokenStore
 }
@@ -166,6 +273,14 @@ func (s *TimerLayer) UserAccessToken() UserAccessTokenStore {
 	return s.UserAccessTokenStore
 }
 
+func (s *TimerLayer) UserProperty() UserPropertyStore {
+	return s.UserPropertyStore
+}
+
+func (s *TimerLayer) UserRelationship() UserRelationshipStore {
+	return s.UserRelationshipStore
+}
+
 func (s *TimerLayer) UserTermsOfService() UserTermsOfServiceStore {
 	return s.UserTermsOfServiceStore
 }
@@ -189,6 +304,26 @@ type TimerLayerChannelStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerChannelBookmarkStore struct {
+	ChannelBookmarkStore
+	Root *TimerLayer
+}
+
+type TimerLayerChannelChangeStore struct {
+	ChannelChangeStore
+	Root *TimerLayer
+}
+
+type TimerLayerChannelGuestLinkStore struct {
+	ChannelGuestLinkStore
+	Root *TimerLayer
+}
+
+type TimerLayerChannelIntegrationStore struct {
+	ChannelIntegrationStore
+	Root *TimerLayer
+}
+
 type TimerLayerChannelMemberHistoryStore struct {
 	ChannelMemberHistoryStore
 	Root *TimerLayer
@@ -214,11 +349,21 @@ type TimerLayerComplianceStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerEmailVerificationStore struct {
+	EmailVerificationStore
+	Root *TimerLayer
+}
+
 type TimerLayerEmojiStore struct {
 	EmojiStore
 	Root *TimerLayer
 }
 
+type TimerLayerEventStreamStore struct {
+	EventStreamStore
+	Root *TimerLayer
+}
+
 type TimerLayerFileInfoStore struct {
 	FileInfoStore
 	Root *TimerLayer
@@ -229,6 +374,11 @@ type TimerLayerGroupStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerIntegrationUsageStore struct {
+	IntegrationUsageStore
+	Root *TimerLayer
+}
+
 type TimerLayerJobStore struct {
 	JobStore
 	Root *TimerLayer
@@ -244,11 +394,26 @@ type TimerLayerLinkMetadataStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerLoginHistoryStore struct {
+	LoginHistoryStore
+	Root *TimerLayer
+}
+
 type TimerLayerOAuthStore struct {
 	OAuthStore
 	Root *TimerLayer
 }
 
+type TimerLayerOutboxStore struct {
+	OutboxStore
+	Root *TimerLayer
+}
+
+type TimerLayerPendingPinStore struct {
+	PendingPinStore
+	Root *TimerLayer
+}
+
 type TimerLayerPluginStore struct {
 	PluginStore
 	Root *TimerLayer
@@ -259,16 +424,46 @@ type TimerLayerPostStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerPostAcknowledgementStore struct {
+	PostAcknowledgementStore
+	Root *TimerLayer
+}
+
+type TimerLayerPostArchiveStore struct {
+	PostArchiveStore
+	Root *TimerLayer
+}
+
+type TimerLayerPostIntegrityStore struct {
+	PostIntegrityStore
+	Root *TimerLayer
+}
+
+type TimerLayerPostsPartitionStore struct {
+	PostsPartitionStore
+	Root *TimerLayer
+}
+
 type TimerLayerPreferenceStore struct {
 	PreferenceStore
 	Root *TimerLayer
 }
 
+type TimerLayerPresenceWebhookStore struct {
+	PresenceWebhookStore
+	Root *TimerLayer
+}
+
 type TimerLayerReactionStore struct {
 	ReactionStore
 	Root *TimerLayer
 }
 
+type TimerLayerRetentionPolicyStore struct {
+	RetentionPolicyStore
+	Root *TimerLayer
+}
+
 type TimerLayerRoleStore struct {
 	RoleStore
 	Root *TimerLayer
@@ -284,11 +479,21 @@ type TimerLayerSessionStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerSlugHistoryStore struct {
+	SlugHistoryStore
+	Root *TimerLayer
+}
+
 type TimerLayerStatusStore struct {
 	StatusStore
 	Root *TimerLayer
 }
 
+type TimerLayerStatusAutomationStore struct {
+	StatusAutomationStore
+	Root *TimerLayer
+}
+
 type TimerLayerSystemStore struct {
 	SystemStore
 	Root *TimerLayer
@@ -299,11 +504,26 @@ type TimerLayerTeamStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerTeamInviteLinkStore struct {
+	TeamInviteLinkStore
+	Root *TimerLayer
+}
+
+type TimerLayerTeamMemberHistoryStore struct {
+	TeamMemberHistoryStore
+	Root *TimerLayer
+}
+
 type TimerLayerTermsOfServiceStore struct {
 	TermsOfServiceStore
 	Root *TimerLayer
 }
 
+type TimerLayerTermsOfServicePolicyStore struct {
+	TermsOfServicePolicyStore
+	Root *TimerLayer
+}
+
 type TimerLayerTokenStore struct {
 	TokenStore
 	Root *TimerLayer
@@ -319,6 +539,16 @@ type TimerLayerUserAccessTokenStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerUserPropertyStore struct {
+	UserPropertyStore
+	Root *TimerLayer
+}
+
+type TimerLayerUserRelationshipStore struct {
+	UserRelationshipStore
+	Root *TimerLayer
+}
+
 type TimerLayerUserTermsOfServiceStore struct {
 	UserTermsOfServiceStore
 	Root *TimerLayer
@@ -1176,6 +1406,22 @@ func (s *TimerLayerChannelStore) GetMembersForUser(teamId string, userId string)
 	return resultVar0, resultVar1
 }
 
+func (s *TimerLayerChannelStore) GetMembersForUserWithCursor(teamId string, userId string, cursor model.ChannelMembersCursor, limit int) (*model.ChannelMembersPage, model.ChannelMembersCursor, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1, resultVar2 := s.ChannelStore.GetMembersForUserWithCursor(teamId, userId, cursor, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar2 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetMembersForUserWithCursor", success, elapsed)
+	}
+	return resultVar0, resultVar1, resultVar2
+}
+
 func (s *TimerLayerChannelStore) GetMembersForUserWithPagination(teamId string, userId string, page int, perPage int) (*model.ChannelMembers, *model.AppError) {
 	start := timemodule.Now()
 
@@ -1192,6 +1438,22 @@ func (s *TimerLayerChannelStore) GetMembersForUserWithPagination(teamId string,
 	return resultVar0, resultVar1
 }
 
+func (s *TimerLayerChannelStore) GetMembersWithExpiredRoles(expiredBefore int64, limit int) ([]*model.ChannelMember, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.ChannelStore.GetMembersWithExpiredRoles(expiredBefore, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetMembersWithExpiredRoles", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
 func (s *TimerLayerChannelStore) GetMoreChannels(teamId string, userId string, offset int, limit int) (*model.ChannelList, error) {
 	start := timemodule.Now()
 
@@ -1368,10 +1630,10 @@ func (s *TimerLayerChannelStore) GroupSyncedChannelCount() (int64, *model.AppErr
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerChannelStore) IncrementMentionCount(channelId string, userId string) *model.AppError {
+func (s *TimerLayerChannelStore) IncrementMentionCount(channelId string, userId string, isUrgent bool) *model.AppError {
 	start := timemodule.Now()
 
-	resultVar0 := s.ChannelStore.IncrementMentionCount(channelId, userId)
+	resultVar0 := s.ChannelStore.IncrementMentionCount(channelId, userId, isUrgent)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1537,6 +1799,22 @@ func (s *TimerLayerChannelStore) MigrateFavoritesToSidebarChannels(lastUserId st
 	return resultVar0, resultVar1
 }
 
+func (s *TimerLayerChannelStore) MigrateMsgCountRoot(fromChannelId string, fromUserId string) (map[string]string, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.ChannelStore.MigrateMsgCountRoot(fromChannelId, fromUserId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.MigrateMsgCountRoot", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
 func (s *TimerLayerChannelStore) MigratePublicChannels() error {
 	start := timemodule.Now()
 
@@ -1569,6 +1847,22 @@ func (s *TimerLayerChannelStore) MigrateSidebarCategories(fromTeamId string, fro
 	return resultVar0, resultVar1
 }
 
+func (s *TimerLayerChannelStore) MigrateTotalMsgCountRoot(fromChannelId string) (map[string]string, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.ChannelStore.MigrateTotalMsgCountRoot(fromChannelId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.MigrateTotalMsgCountRoot", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
 func (s *TimerLayerChannelStore) PermanentDelete(channelId string) error {
 	start := timemodule.Now()
 
@@ -1969,6 +2263,22 @@ func (s *TimerLayerChannelStore) UpdateMembersRole(channelID string, userIDs []s
 	return resultVar0
 }
 
+func (s *TimerLayerChannelStore) UpdateMembersRoleAndGetUpdated(channelID string, userIDs []string) ([]*model.ChannelMember, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.ChannelStore.UpdateMembersRoleAndGetUpdated(channelID, userIDs)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.UpdateMembersRoleAndGetUpdated", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
 func (s *TimerLayerChannelStore) UpdateMultipleMembers(members []*model.ChannelMember) ([]*model.ChannelMember, *model.AppError) {
 	start := timemodule.Now()
 
@@ -2065,10 +2375,10 @@ func (s *TimerLayerChannelStore) UserBelongsToChannels(userId string, channelIds
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerChannelMemberHistoryStore) GetUsersInChannelDuring(startTime int64, endTime int64, channelId string) ([]*model.ChannelMemberHistoryResult, error) {
+func (s *TimerLayerChannelBookmarkStore) CountForChannel(channelId string) (int64, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.ChannelMemberHistoryStore.GetUsersInChannelDuring(startTime, endTime, channelId)
+	resultVar0, resultVar1 := s.ChannelBookmarkStore.CountForChannel(channelId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2076,15 +2386,15 @@ func (s *TimerLayerChannelMemberHistoryStore) GetUsersInChannelDuring(startTime
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.GetUsersInChannelDuring", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelBookmarkStore.CountForChannel", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerChannelMemberHistoryStore) LogJoinEvent(userId string, channelId string, joinTime int64) error {
+func (s *TimerLayerChannelBookmarkStore) Delete(id string, deleteAt int64) error {
 	start := timemodule.Now()
 
-	resultVar0 := s.ChannelMemberHistoryStore.LogJoinEvent(userId, channelId, joinTime)
+	resultVar0 := s.ChannelBookmarkStore.Delete(id, deleteAt)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2092,31 +2402,31 @@ func (s *TimerLayerChannelMemberHistoryStore) LogJoinEvent(userId string, channe
 		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.LogJoinEvent", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelBookmarkStore.Delete", success, elapsed)
 	}
 	return resultVar0
 }
 
-func (s *TimerLayerChannelMemberHistoryStore) LogLeaveEvent(userId string, channelId string, leaveTime int64) error {
+func (s *TimerLayerChannelBookmarkStore) Get(id string, includeDeleted bool) (*model.ChannelBookmark, error) {
 	start := timemodule.Now()
 
-	resultVar0 := s.ChannelMemberHistoryStore.LogLeaveEvent(userId, channelId, leaveTime)
+	resultVar0, resultVar1 := s.ChannelBookmarkStore.Get(id, includeDeleted)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.LogLeaveEvent", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelBookmarkStore.Get", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerChannelMemberHistoryStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+func (s *TimerLayerChannelBookmarkStore) GetBookmarksForChannel(channelId string, includeDeleted bool) ([]*model.ChannelBookmark, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.ChannelMemberHistoryStore.PermanentDeleteBatch(endTime, limit)
+	resultVar0, resultVar1 := s.ChannelBookmarkStore.GetBookmarksForChannel(channelId, includeDeleted)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2124,15 +2434,15 @@ func (s *TimerLayerChannelMemberHistoryStore) PermanentDeleteBatch(endTime int64
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.PermanentDeleteBatch", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelBookmarkStore.GetBookmarksForChannel", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerClusterDiscoveryStore) Cleanup() error {
+func (s *TimerLayerChannelBookmarkStore) PermanentDeleteByChannel(channelId string) error {
 	start := timemodule.Now()
 
-	resultVar0 := s.ClusterDiscoveryStore.Cleanup()
+	resultVar0 := s.ChannelBookmarkStore.PermanentDeleteByChannel(channelId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2140,15 +2450,15 @@ func (s *TimerLayerClusterDiscoveryStore) Cleanup() error {
 		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.Cleanup", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelBookmarkStore.PermanentDeleteByChannel", success, elapsed)
 	}
 	return resultVar0
 }
 
-func (s *TimerLayerClusterDiscoveryStore) Delete(discovery *model.ClusterDiscovery) (bool, error) {
+func (s *TimerLayerChannelBookmarkStore) Save(bookmark *model.ChannelBookmark) (*model.ChannelBookmark, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.ClusterDiscoveryStore.Delete(discovery)
+	resultVar0, resultVar1 := s.ChannelBookmarkStore.Save(bookmark)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2156,15 +2466,15 @@ func (s *TimerLayerClusterDiscoveryStore) Delete(discovery *model.ClusterDiscove
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelBookmarkStore.Save", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerClusterDiscoveryStore) Exists(discovery *model.ClusterDiscovery) (bool, error) {
+func (s *TimerLayerChannelBookmarkStore) Update(bookmark *model.ChannelBookmark) (*model.ChannelBookmark, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.ClusterDiscoveryStore.Exists(discovery)
+	resultVar0, resultVar1 := s.ChannelBookmarkStore.Update(bookmark)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2172,15 +2482,15 @@ func (s *TimerLayerClusterDiscoveryStore) Exists(discovery *model.ClusterDiscove
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.Exists", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelBookmarkStore.Update", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerClusterDiscoveryStore) GetAll(discoveryType string, clusterName string) ([]*model.ClusterDiscovery, error) {
+func (s *TimerLayerChannelChangeStore) GetLastSeq(channelId string) (int64, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.ClusterDiscoveryStore.GetAll(discoveryType, clusterName)
+	resultVar0, resultVar1 := s.ChannelChangeStore.GetLastSeq(channelId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2188,31 +2498,31 @@ func (s *TimerLayerClusterDiscoveryStore) GetAll(discoveryType string, clusterNa
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.GetAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelChangeStore.GetLastSeq", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerClusterDiscoveryStore) Save(discovery *model.ClusterDiscovery) error {
+func (s *TimerLayerChannelChangeStore) GetSince(channelId string, sinceSeq int64, limit int) ([]*model.ChannelChange, error) {
 	start := timemodule.Now()
 
-	resultVar0 := s.ClusterDiscoveryStore.Save(discovery)
+	resultVar0, resultVar1 := s.ChannelChangeStore.GetSince(channelId, sinceSeq, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelChangeStore.GetSince", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerClusterDiscoveryStore) SetLastPingAt(discovery *model.ClusterDiscovery) error {
+func (s *TimerLayerChannelChangeStore) PermanentDeleteByChannel(channelId string) error {
 	start := timemodule.Now()
 
-	resultVar0 := s.ClusterDiscoveryStore.SetLastPingAt(discovery)
+	resultVar0 := s.ChannelChangeStore.PermanentDeleteByChannel(channelId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2220,15 +2530,15 @@ func (s *TimerLayerClusterDiscoveryStore) SetLastPingAt(discovery *model.Cluster
 		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.SetLastPingAt", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelChangeStore.PermanentDeleteByChannel", success, elapsed)
 	}
 	return resultVar0
 }
 
-func (s *TimerLayerCommandStore) AnalyticsCommandCount(teamId string) (int64, error) {
+func (s *TimerLayerChannelGuestLinkStore) CountForChannel(channelId string) (int64, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.CommandStore.AnalyticsCommandCount(teamId)
+	resultVar0, resultVar1 := s.ChannelGuestLinkStore.CountForChannel(channelId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2236,31 +2546,31 @@ func (s *TimerLayerCommandStore) AnalyticsCommandCount(teamId string) (int64, er
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.AnalyticsCommandCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelGuestLinkStore.CountForChannel", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerCommandStore) Delete(commandId string, time int64) error {
+func (s *TimerLayerChannelGuestLinkStore) Get(id string) (*model.ChannelGuestLink, error) {
 	start := timemodule.Now()
 
-	resultVar0 := s.CommandStore.Delete(commandId, time)
+	resultVar0, resultVar1 := s.ChannelGuestLinkStore.Get(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelGuestLinkStore.Get", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerCommandStore) Get(id string) (*model.Command, error) {
+func (s *TimerLayerChannelGuestLinkStore) GetByToken(token string) (*model.ChannelGuestLink, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.CommandStore.Get(id)
+	resultVar0, resultVar1 := s.ChannelGuestLinkStore.GetByToken(token)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2268,15 +2578,15 @@ func (s *TimerLayerCommandStore) Get(id string) (*model.Command, error) {
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelGuestLinkStore.GetByToken", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerCommandStore) GetByTeam(teamId string) ([]*model.Command, error) {
+func (s *TimerLayerChannelGuestLinkStore) GetForChannel(channelId string) ([]*model.ChannelGuestLink, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.CommandStore.GetByTeam(teamId)
+	resultVar0, resultVar1 := s.ChannelGuestLinkStore.GetForChannel(channelId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2284,31 +2594,31 @@ func (s *TimerLayerCommandStore) GetByTeam(teamId string) ([]*model.Command, err
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.GetByTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelGuestLinkStore.GetForChannel", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerCommandStore) GetByTrigger(teamId string, trigger string) (*model.Command, error) {
+func (s *TimerLayerChannelGuestLinkStore) IncrementUseCount(id string, now int64) error {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.CommandStore.GetByTrigger(teamId, trigger)
+	resultVar0 := s.ChannelGuestLinkStore.IncrementUseCount(id, now)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.GetByTrigger", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelGuestLinkStore.IncrementUseCount", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerCommandStore) PermanentDeleteByTeam(teamId string) error {
+func (s *TimerLayerChannelGuestLinkStore) PermanentDeleteByChannel(channelId string) error {
 	start := timemodule.Now()
 
-	resultVar0 := s.CommandStore.PermanentDeleteByTeam(teamId)
+	resultVar0 := s.ChannelGuestLinkStore.PermanentDeleteByChannel(channelId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2316,15 +2626,15 @@ func (s *TimerLayerCommandStore) PermanentDeleteByTeam(teamId string) error {
 		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.PermanentDeleteByTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelGuestLinkStore.PermanentDeleteByChannel", success, elapsed)
 	}
 	return resultVar0
 }
 
-func (s *TimerLayerCommandStore) PermanentDeleteByUser(userId string) error {
+func (s *TimerLayerChannelGuestLinkStore) Revoke(id string, deleteAt int64) error {
 	start := timemodule.Now()
 
-	resultVar0 := s.CommandStore.PermanentDeleteByUser(userId)
+	resultVar0 := s.ChannelGuestLinkStore.Revoke(id, deleteAt)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2332,15 +2642,15 @@ func (s *TimerLayerCommandStore) PermanentDeleteByUser(userId string) error {
 		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.PermanentDeleteByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelGuestLinkStore.Revoke", success, elapsed)
 	}
 	return resultVar0
 }
 
-func (s *TimerLayerCommandStore) Save(webhook *model.Command) (*model.Command, error) {
+func (s *TimerLayerChannelGuestLinkStore) Save(link *model.ChannelGuestLink) (*model.ChannelGuestLink, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.CommandStore.Save(webhook)
+	resultVar0, resultVar1 := s.ChannelGuestLinkStore.Save(link)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2348,46 +2658,31 @@ func (s *TimerLayerCommandStore) Save(webhook *model.Command) (*model.Command, e
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelGuestLinkStore.Save", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerCommandStore) Update(hook *model.Command) (*model.Command, error) {
+func (s *TimerLayerChannelIntegrationStore) Delete(channelId string, integrationId string) error {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.CommandStore.Update(hook)
+	resultVar0 := s.ChannelIntegrationStore.Delete(channelId, integrationId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.Update", success, elapsed)
-	}
-	return resultVar0, resultVar1
-}
-
-func (s *TimerLayerCommandWebhookStore) Cleanup() {
-	start := timemodule.Now()
-
-	s.CommandWebhookStore.Cleanup()
-
-	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
-	if s.Root.Metrics != nil {
-		success := "false"
-		if true {
-			success = "true"
-		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandWebhookStore.Cleanup", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelIntegrationStore.Delete", success, elapsed)
 	}
+	return resultVar0
 }
 
-func (s *TimerLayerCommandWebhookStore) Get(id string) (*model.CommandWebhook, *model.AppError) {
+func (s *TimerLayerChannelIntegrationStore) Get(channelId string, integrationId string) (*model.ChannelIntegration, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.CommandWebhookStore.Get(id)
+	resultVar0, resultVar1 := s.ChannelIntegrationStore.Get(channelId, integrationId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2395,15 +2690,15 @@ func (s *TimerLayerCommandWebhookStore) Get(id string) (*model.CommandWebhook, *
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandWebhookStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelIntegrationStore.Get", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerCommandWebhookStore) Save(webhook *model.CommandWebhook) (*model.CommandWebhook, *model.AppError) {
+func (s *TimerLayerChannelIntegrationStore) GetForChannel(channelId string) ([]*model.ChannelIntegration, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.CommandWebhookStore.Save(webhook)
+	resultVar0, resultVar1 := s.ChannelIntegrationStore.GetForChannel(channelId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2411,15 +2706,15 @@ func (s *TimerLayerCommandWebhookStore) Save(webhook *model.CommandWebhook) (*mo
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandWebhookStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelIntegrationStore.GetForChannel", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerCommandWebhookStore) TryUse(id string, limit int) *model.AppError {
+func (s *TimerLayerChannelIntegrationStore) PermanentDeleteByChannel(channelId string) error {
 	start := timemodule.Now()
 
-	resultVar0 := s.CommandWebhookStore.TryUse(id, limit)
+	resultVar0 := s.ChannelIntegrationStore.PermanentDeleteByChannel(channelId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2427,31 +2722,31 @@ func (s *TimerLayerCommandWebhookStore) TryUse(id string, limit int) *model.AppE
 		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandWebhookStore.TryUse", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelIntegrationStore.PermanentDeleteByChannel", success, elapsed)
 	}
 	return resultVar0
 }
 
-func (s *TimerLayerComplianceStore) ComplianceExport(compliance *model.Compliance) ([]*model.CompliancePost, *model.AppError) {
+func (s *TimerLayerChannelIntegrationStore) PermanentDeleteByIntegration(integrationId string) error {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.ComplianceStore.ComplianceExport(compliance)
+	resultVar0 := s.ChannelIntegrationStore.PermanentDeleteByIntegration(integrationId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.ComplianceExport", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelIntegrationStore.PermanentDeleteByIntegration", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerComplianceStore) Get(id string) (*model.Compliance, *model.AppError) {
+func (s *TimerLayerChannelIntegrationStore) Save(integration *model.ChannelIntegration) (*model.ChannelIntegration, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.ComplianceStore.Get(id)
+	resultVar0, resultVar1 := s.ChannelIntegrationStore.Save(integration)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2459,15 +2754,15 @@ func (s *TimerLayerComplianceStore) Get(id string) (*model.Compliance, *model.Ap
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelIntegrationStore.Save", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerComplianceStore) GetAll(offset int, limit int) (model.Compliances, *model.AppError) {
+func (s *TimerLayerChannelMemberHistoryStore) GetUsersInChannelDuring(startTime int64, endTime int64, channelId string) ([]*model.ChannelMemberHistoryResult, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.ComplianceStore.GetAll(offset, limit)
+	resultVar0, resultVar1 := s.ChannelMemberHistoryStore.GetUsersInChannelDuring(startTime, endTime, channelId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2475,47 +2770,47 @@ func (s *TimerLayerComplianceStore) GetAll(offset int, limit int) (model.Complia
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.GetAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.GetUsersInChannelDuring", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerComplianceStore) MessageExport(after int64, limit int) ([]*model.MessageExport, *model.AppError) {
+func (s *TimerLayerChannelMemberHistoryStore) LogJoinEvent(userId string, channelId string, joinTime int64) error {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.ComplianceStore.MessageExport(after, limit)
+	resultVar0 := s.ChannelMemberHistoryStore.LogJoinEvent(userId, channelId, joinTime)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.MessageExport", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.LogJoinEvent", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerComplianceStore) Save(compliance *model.Compliance) (*model.Compliance, *model.AppError) {
+func (s *TimerLayerChannelMemberHistoryStore) LogLeaveEvent(userId string, channelId string, leaveTime int64) error {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.ComplianceStore.Save(compliance)
+	resultVar0 := s.ChannelMemberHistoryStore.LogLeaveEvent(userId, channelId, leaveTime)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.LogLeaveEvent", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerComplianceStore) Update(compliance *model.Compliance) (*model.Compliance, *model.AppError) {
+func (s *TimerLayerChannelMemberHistoryStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.ComplianceStore.Update(compliance)
+	resultVar0, resultVar1 := s.ChannelMemberHistoryStore.PermanentDeleteBatch(endTime, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2523,15 +2818,15 @@ func (s *TimerLayerComplianceStore) Update(compliance *model.Compliance) (*model
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.Update", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.PermanentDeleteBatch", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerEmojiStore) Delete(emoji *model.Emoji, time int64) error {
+func (s *TimerLayerClusterDiscoveryStore) Cleanup() error {
 	start := timemodule.Now()
 
-	resultVar0 := s.EmojiStore.Delete(emoji, time)
+	resultVar0 := s.ClusterDiscoveryStore.Cleanup()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2539,15 +2834,15 @@ func (s *TimerLayerEmojiStore) Delete(emoji *model.Emoji, time int64) error {
 		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.Cleanup", success, elapsed)
 	}
 	return resultVar0
 }
 
-func (s *TimerLayerEmojiStore) Get(id string, allowFromCache bool) (*model.Emoji, error) {
+func (s *TimerLayerClusterDiscoveryStore) Delete(discovery *model.ClusterDiscovery) (bool, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.EmojiStore.Get(id, allowFromCache)
+	resultVar0, resultVar1 := s.ClusterDiscoveryStore.Delete(discovery)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2555,15 +2850,15 @@ func (s *TimerLayerEmojiStore) Get(id string, allowFromCache bool) (*model.Emoji
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.Delete", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerEmojiStore) GetByName(name string, allowFromCache bool) (*model.Emoji, error) {
+func (s *TimerLayerClusterDiscoveryStore) Exists(discovery *model.ClusterDiscovery) (bool, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.EmojiStore.GetByName(name, allowFromCache)
+	resultVar0, resultVar1 := s.ClusterDiscoveryStore.Exists(discovery)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2571,15 +2866,15 @@ func (s *TimerLayerEmojiStore) GetByName(name string, allowFromCache bool) (*mod
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.GetByName", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.Exists", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerEmojiStore) GetList(offset int, limit int, sort string) ([]*model.Emoji, error) {
+func (s *TimerLayerClusterDiscoveryStore) GetAll(discoveryType string, clusterName string) ([]*model.ClusterDiscovery, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.EmojiStore.GetList(offset, limit, sort)
+	resultVar0, resultVar1 := s.ClusterDiscoveryStore.GetAll(discoveryType, clusterName)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2587,47 +2882,47 @@ func (s *TimerLayerEmojiStore) GetList(offset int, limit int, sort string) ([]*m
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.GetList", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.GetAll", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerEmojiStore) GetMultipleByName(names []string) ([]*model.Emoji, error) {
+func (s *TimerLayerClusterDiscoveryStore) Save(discovery *model.ClusterDiscovery) error {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.EmojiStore.GetMultipleByName(names)
+	resultVar0 := s.ClusterDiscoveryStore.Save(discovery)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.GetMultipleByName", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.Save", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerEmojiStore) Save(emoji *model.Emoji) (*model.Emoji, error) {
+func (s *TimerLayerClusterDiscoveryStore) SetLastPingAt(discovery *model.ClusterDiscovery) error {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.EmojiStore.Save(emoji)
+	resultVar0 := s.ClusterDiscoveryStore.SetLastPingAt(discovery)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.SetLastPingAt", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerEmojiStore) Search(name string, prefixOnly bool, limit int) ([]*model.Emoji, error) {
+func (s *TimerLayerCommandStore) AnalyticsCommandCount(teamId string) (int64, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.EmojiStore.Search(name, prefixOnly, limit)
+	resultVar0, resultVar1 := s.CommandStore.AnalyticsCommandCount(teamId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2635,15 +2930,15 @@ func (s *TimerLayerEmojiStore) Search(name string, prefixOnly bool, limit int) (
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.Search", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.AnalyticsCommandCount", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerFileInfoStore) AttachToPost(fileId string, postId string, creatorId string) *model.AppError {
+func (s *TimerLayerCommandStore) Delete(commandId string, time int64) error {
 	start := timemodule.Now()
 
-	resultVar0 := s.FileInfoStore.AttachToPost(fileId, postId, creatorId)
+	resultVar0 := s.CommandStore.Delete(commandId, time)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2651,30 +2946,31 @@ func (s *TimerLayerFileInfoStore) AttachToPost(fileId string, postId string, cre
 		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.AttachToPost", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.Delete", success, elapsed)
 	}
 	return resultVar0
 }
 
-func (s *TimerLayerFileInfoStore) ClearCaches() {
+func (s *TimerLayerCommandStore) Get(id string) (*model.Command, error) {
 	start := timemodule.Now()
 
-	s.FileInfoStore.ClearCaches()
+	resultVar0, resultVar1 := s.CommandStore.Get(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.ClearCaches", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.Get", success, elapsed)
 	}
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerFileInfoStore) DeleteForPost(postId string) (string, *model.AppError) {
+func (s *TimerLayerCommandStore) GetByTeam(teamId string) ([]*model.Command, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.FileInfoStore.DeleteForPost(postId)
+	resultVar0, resultVar1 := s.CommandStore.GetByTeam(teamId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2682,15 +2978,15 @@ func (s *TimerLayerFileInfoStore) DeleteForPost(postId string) (string, *model.A
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.DeleteForPost", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.GetByTeam", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerFileInfoStore) Get(id string) (*model.FileInfo, *model.AppError) {
+func (s *TimerLayerCommandStore) GetByTrigger(teamId string, trigger string) (*model.Command, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.FileInfoStore.Get(id)
+	resultVar0, resultVar1 := s.CommandStore.GetByTrigger(teamId, trigger)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2698,47 +2994,47 @@ func (s *TimerLayerFileInfoStore) Get(id string) (*model.FileInfo, *model.AppErr
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.GetByTrigger", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerFileInfoStore) GetByPath(path string) (*model.FileInfo, *model.AppError) {
+func (s *TimerLayerCommandStore) PermanentDeleteByTeam(teamId string) error {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.FileInfoStore.GetByPath(path)
+	resultVar0 := s.CommandStore.PermanentDeleteByTeam(teamId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetByPath", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.PermanentDeleteByTeam", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerFileInfoStore) GetForPost(postId string, readFromMaster bool, includeDeleted bool, allowFromCache bool) ([]*model.FileInfo, *model.AppError) {
+func (s *TimerLayerCommandStore) PermanentDeleteByUser(userId string) error {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.FileInfoStore.GetForPost(postId, readFromMaster, includeDeleted, allowFromCache)
+	resultVar0 := s.CommandStore.PermanentDeleteByUser(userId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetForPost", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.PermanentDeleteByUser", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerFileInfoStore) GetForUser(userId string) ([]*model.FileInfo, *model.AppError) {
+func (s *TimerLayerCommandStore) Save(webhook *model.Command) (*model.Command, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.FileInfoStore.GetForUser(userId)
+	resultVar0, resultVar1 := s.CommandStore.Save(webhook)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2746,15 +3042,15 @@ func (s *TimerLayerFileInfoStore) GetForUser(userId string) ([]*model.FileInfo,
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetForUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.Save", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerFileInfoStore) GetWithOptions(page int, perPage int, opt *model.GetFileInfosOptions) ([]*model.FileInfo, *model.AppError) {
+func (s *TimerLayerCommandStore) Update(hook *model.Command) (*model.Command, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.FileInfoStore.GetWithOptions(page, perPage, opt)
+	resultVar0, resultVar1 := s.CommandStore.Update(hook)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2762,15 +3058,15 @@ func (s *TimerLayerFileInfoStore) GetWithOptions(page int, perPage int, opt *mod
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetWithOptions", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.Update", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerFileInfoStore) InvalidateFileInfosForPostCache(postId string, deleted bool) {
+func (s *TimerLayerCommandWebhookStore) Cleanup() {
 	start := timemodule.Now()
 
-	s.FileInfoStore.InvalidateFileInfosForPostCache(postId, deleted)
+	s.CommandWebhookStore.Cleanup()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2778,30 +3074,30 @@ func (s *TimerLayerFileInfoStore) InvalidateFileInfosForPostCache(postId string,
 		if true {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.InvalidateFileInfosForPostCache", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandWebhookStore.Cleanup", success, elapsed)
 	}
 }
 
-func (s *TimerLayerFileInfoStore) PermanentDelete(fileId string) *model.AppError {
+func (s *TimerLayerCommandWebhookStore) Get(id string) (*model.CommandWebhook, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0 := s.FileInfoStore.PermanentDelete(fileId)
+	resultVar0, resultVar1 := s.CommandWebhookStore.Get(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.PermanentDelete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandWebhookStore.Get", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerFileInfoStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, *model.AppError) {
+func (s *TimerLayerCommandWebhookStore) Save(webhook *model.CommandWebhook) (*model.CommandWebhook, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.FileInfoStore.PermanentDeleteBatch(endTime, limit)
+	resultVar0, resultVar1 := s.CommandWebhookStore.Save(webhook)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2809,31 +3105,31 @@ func (s *TimerLayerFileInfoStore) PermanentDeleteBatch(endTime int64, limit int6
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.PermanentDeleteBatch", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandWebhookStore.Save", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerFileInfoStore) PermanentDeleteByUser(userId string) (int64, *model.AppError) {
+func (s *TimerLayerCommandWebhookStore) TryUse(id string, limit int) *model.AppError {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.FileInfoStore.PermanentDeleteByUser(userId)
+	resultVar0 := s.CommandWebhookStore.TryUse(id, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.PermanentDeleteByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandWebhookStore.TryUse", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerFileInfoStore) Save(info *model.FileInfo) (*model.FileInfo, *model.AppError) {
+func (s *TimerLayerComplianceStore) ComplianceExport(compliance *model.Compliance) ([]*model.CompliancePost, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.FileInfoStore.Save(info)
+	resultVar0, resultVar1 := s.ComplianceStore.ComplianceExport(compliance)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2841,15 +3137,15 @@ func (s *TimerLayerFileInfoStore) Save(info *model.FileInfo) (*model.FileInfo, *
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.ComplianceExport", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) AdminRoleGroupsForSyncableMember(userID string, syncableID string, syncableType model.GroupSyncableType) ([]string, *model.AppError) {
+func (s *TimerLayerComplianceStore) Get(id string) (*model.Compliance, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.AdminRoleGroupsForSyncableMember(userID, syncableID, syncableType)
+	resultVar0, resultVar1 := s.ComplianceStore.Get(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2857,15 +3153,15 @@ func (s *TimerLayerGroupStore) AdminRoleGroupsForSyncableMember(userID string, s
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.AdminRoleGroupsForSyncableMember", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.Get", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) ChannelMembersMinusGroupMembers(channelID string, groupIDs []string, page int, perPage int) ([]*model.UserWithGroups, *model.AppError) {
+func (s *TimerLayerComplianceStore) GetAll(offset int, limit int) (model.Compliances, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.ChannelMembersMinusGroupMembers(channelID, groupIDs, page, perPage)
+	resultVar0, resultVar1 := s.ComplianceStore.GetAll(offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2873,15 +3169,15 @@ func (s *TimerLayerGroupStore) ChannelMembersMinusGroupMembers(channelID string,
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.ChannelMembersMinusGroupMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.GetAll", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) ChannelMembersToAdd(since int64, channelID *string) ([]*model.UserChannelIDPair, *model.AppError) {
+func (s *TimerLayerComplianceStore) MessageExport(after int64, limit int) ([]*model.MessageExport, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.ChannelMembersToAdd(since, channelID)
+	resultVar0, resultVar1 := s.ComplianceStore.MessageExport(after, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2889,15 +3185,15 @@ func (s *TimerLayerGroupStore) ChannelMembersToAdd(since int64, channelID *strin
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.ChannelMembersToAdd", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.MessageExport", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) ChannelMembersToRemove(channelID *string) ([]*model.ChannelMember, *model.AppError) {
+func (s *TimerLayerComplianceStore) Save(compliance *model.Compliance) (*model.Compliance, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.ChannelMembersToRemove(channelID)
+	resultVar0, resultVar1 := s.ComplianceStore.Save(compliance)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2905,15 +3201,15 @@ func (s *TimerLayerGroupStore) ChannelMembersToRemove(channelID *string) ([]*mod
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.ChannelMembersToRemove", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.Save", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) CountChannelMembersMinusGroupMembers(channelID string, groupIDs []string) (int64, *model.AppError) {
+func (s *TimerLayerComplianceStore) Update(compliance *model.Compliance) (*model.Compliance, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.CountChannelMembersMinusGroupMembers(channelID, groupIDs)
+	resultVar0, resultVar1 := s.ComplianceStore.Update(compliance)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2921,31 +3217,31 @@ func (s *TimerLayerGroupStore) CountChannelMembersMinusGroupMembers(channelID st
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CountChannelMembersMinusGroupMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.Update", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) CountGroupsByChannel(channelId string, opts model.GroupSearchOpts) (int64, *model.AppError) {
+func (s *TimerLayerEmailVerificationStore) Delete(userId string) error {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.CountGroupsByChannel(channelId, opts)
+	resultVar0 := s.EmailVerificationStore.Delete(userId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CountGroupsByChannel", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("EmailVerificationStore.Delete", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerGroupStore) CountGroupsByTeam(teamId string, opts model.GroupSearchOpts) (int64, *model.AppError) {
+func (s *TimerLayerEmailVerificationStore) Get(userId string) (*model.EmailVerification, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.CountGroupsByTeam(teamId, opts)
+	resultVar0, resultVar1 := s.EmailVerificationStore.Get(userId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2953,15 +3249,15 @@ func (s *TimerLayerGroupStore) CountGroupsByTeam(teamId string, opts model.Group
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CountGroupsByTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("EmailVerificationStore.Get", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) CountTeamMembersMinusGroupMembers(teamID string, groupIDs []string) (int64, *model.AppError) {
+func (s *TimerLayerEmailVerificationStore) GetForReminder(remindBefore int64, limit int) ([]*model.EmailVerification, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.CountTeamMembersMinusGroupMembers(teamID, groupIDs)
+	resultVar0, resultVar1 := s.EmailVerificationStore.GetForReminder(remindBefore, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2969,15 +3265,15 @@ func (s *TimerLayerGroupStore) CountTeamMembersMinusGroupMembers(teamID string,
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CountTeamMembersMinusGroupMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("EmailVerificationStore.GetForReminder", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) Create(group *model.Group) (*model.Group, *model.AppError) {
+func (s *TimerLayerEmailVerificationStore) GetOverdue(now int64, limit int) ([]*model.EmailVerification, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.Create(group)
+	resultVar0, resultVar1 := s.EmailVerificationStore.GetOverdue(now, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2985,15 +3281,15 @@ func (s *TimerLayerGroupStore) Create(group *model.Group) (*model.Group, *model.
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.Create", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("EmailVerificationStore.GetOverdue", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) CreateGroupSyncable(groupSyncable *model.GroupSyncable) (*model.GroupSyncable, *model.AppError) {
+func (s *TimerLayerEmailVerificationStore) GetUnverifiedUsers(offset int, limit int) ([]*model.UnverifiedUser, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.CreateGroupSyncable(groupSyncable)
+	resultVar0, resultVar1 := s.EmailVerificationStore.GetUnverifiedUsers(offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3001,15 +3297,15 @@ func (s *TimerLayerGroupStore) CreateGroupSyncable(groupSyncable *model.GroupSyn
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CreateGroupSyncable", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("EmailVerificationStore.GetUnverifiedUsers", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) Delete(groupID string) (*model.Group, *model.AppError) {
+func (s *TimerLayerEmailVerificationStore) Save(verification *model.EmailVerification) (*model.EmailVerification, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.Delete(groupID)
+	resultVar0, resultVar1 := s.EmailVerificationStore.Save(verification)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3017,15 +3313,15 @@ func (s *TimerLayerGroupStore) Delete(groupID string) (*model.Group, *model.AppE
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("EmailVerificationStore.Save", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) DeleteGroupSyncable(groupID string, syncableID string, syncableType model.GroupSyncableType) (*model.GroupSyncable, *model.AppError) {
+func (s *TimerLayerEmailVerificationStore) Update(verification *model.EmailVerification) (*model.EmailVerification, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.DeleteGroupSyncable(groupID, syncableID, syncableType)
+	resultVar0, resultVar1 := s.EmailVerificationStore.Update(verification)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3033,31 +3329,31 @@ func (s *TimerLayerGroupStore) DeleteGroupSyncable(groupID string, syncableID st
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.DeleteGroupSyncable", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("EmailVerificationStore.Update", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) DeleteMember(groupID string, userID string) (*model.GroupMember, *model.AppError) {
+func (s *TimerLayerEmojiStore) Delete(emoji *model.Emoji, time int64) error {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.DeleteMember(groupID, userID)
+	resultVar0 := s.EmojiStore.Delete(emoji, time)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.DeleteMember", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.Delete", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerGroupStore) DistinctGroupMemberCount() (int64, *model.AppError) {
+func (s *TimerLayerEmojiStore) Get(id string, allowFromCache bool) (*model.Emoji, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.DistinctGroupMemberCount()
+	resultVar0, resultVar1 := s.EmojiStore.Get(id, allowFromCache)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3065,15 +3361,15 @@ func (s *TimerLayerGroupStore) DistinctGroupMemberCount() (int64, *model.AppErro
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.DistinctGroupMemberCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.Get", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) Get(groupID string) (*model.Group, *model.AppError) {
+func (s *TimerLayerEmojiStore) GetByName(name string, allowFromCache bool) (*model.Emoji, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.Get(groupID)
+	resultVar0, resultVar1 := s.EmojiStore.GetByName(name, allowFromCache)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3081,15 +3377,15 @@ func (s *TimerLayerGroupStore) Get(groupID string) (*model.Group, *model.AppErro
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.GetByName", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) GetAllBySource(groupSource model.GroupSource) ([]*model.Group, *model.AppError) {
+func (s *TimerLayerEmojiStore) GetList(offset int, limit int, sort string, teamIds []string) ([]*model.Emoji, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.GetAllBySource(groupSource)
+	resultVar0, resultVar1 := s.EmojiStore.GetList(offset, limit, sort, teamIds)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3097,15 +3393,15 @@ func (s *TimerLayerGroupStore) GetAllBySource(groupSource model.GroupSource) ([]
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetAllBySource", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.GetList", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) GetAllGroupSyncablesByGroupId(groupID string, syncableType model.GroupSyncableType) ([]*model.GroupSyncable, *model.AppError) {
+func (s *TimerLayerEmojiStore) GetMultipleByName(names []string) ([]*model.Emoji, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.GetAllGroupSyncablesByGroupId(groupID, syncableType)
+	resultVar0, resultVar1 := s.EmojiStore.GetMultipleByName(names)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3113,15 +3409,15 @@ func (s *TimerLayerGroupStore) GetAllGroupSyncablesByGroupId(groupID string, syn
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetAllGroupSyncablesByGroupId", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.GetMultipleByName", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) GetByIDs(groupIDs []string) ([]*model.Group, *model.AppError) {
+func (s *TimerLayerEmojiStore) Save(emoji *model.Emoji) (*model.Emoji, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.GetByIDs(groupIDs)
+	resultVar0, resultVar1 := s.EmojiStore.Save(emoji)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3129,15 +3425,15 @@ func (s *TimerLayerGroupStore) GetByIDs(groupIDs []string) ([]*model.Group, *mod
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetByIDs", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.Save", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) GetByName(name string, opts model.GroupSearchOpts) (*model.Group, *model.AppError) {
+func (s *TimerLayerEmojiStore) Search(name string, prefixOnly bool, limit int, teamIds []string) ([]*model.Emoji, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.GetByName(name, opts)
+	resultVar0, resultVar1 := s.EmojiStore.Search(name, prefixOnly, limit, teamIds)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3145,15 +3441,15 @@ func (s *TimerLayerGroupStore) GetByName(name string, opts model.GroupSearchOpts
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetByName", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.Search", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) GetByRemoteID(remoteID string, groupSource model.GroupSource) (*model.Group, *model.AppError) {
+func (s *TimerLayerEventStreamStore) Count() (int64, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.GetByRemoteID(remoteID, groupSource)
+	resultVar0, resultVar1 := s.EventStreamStore.Count()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3161,31 +3457,31 @@ func (s *TimerLayerGroupStore) GetByRemoteID(remoteID string, groupSource model.
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetByRemoteID", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("EventStreamStore.Count", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) GetByUser(userId string) ([]*model.Group, *model.AppError) {
+func (s *TimerLayerEventStreamStore) Delete(ids []string) error {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.GetByUser(userId)
+	resultVar0 := s.EventStreamStore.Delete(ids)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("EventStreamStore.Delete", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerGroupStore) GetGroupSyncable(groupID string, syncableID string, syncableType model.GroupSyncableType) (*model.GroupSyncable, *model.AppError) {
+func (s *TimerLayerEventStreamStore) GetPending(now int64, limit int) ([]*model.EventStreamEvent, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.GetGroupSyncable(groupID, syncableID, syncableType)
+	resultVar0, resultVar1 := s.EventStreamStore.GetPending(now, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3193,31 +3489,31 @@ func (s *TimerLayerGroupStore) GetGroupSyncable(groupID string, syncableID strin
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetGroupSyncable", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("EventStreamStore.GetPending", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) GetGroups(page int, perPage int, opts model.GroupSearchOpts) ([]*model.Group, *model.AppError) {
+func (s *TimerLayerEventStreamStore) RecordFailedAttempt(ids []string, nextAttemptAt int64) error {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.GetGroups(page, perPage, opts)
+	resultVar0 := s.EventStreamStore.RecordFailedAttempt(ids, nextAttemptAt)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetGroups", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("EventStreamStore.RecordFailedAttempt", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerGroupStore) GetGroupsAssociatedToChannelsByTeam(teamId string, opts model.GroupSearchOpts) (map[string][]*model.GroupWithSchemeAdmin, *model.AppError) {
+func (s *TimerLayerEventStreamStore) Save(event *model.EventStreamEvent) (*model.EventStreamEvent, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.GetGroupsAssociatedToChannelsByTeam(teamId, opts)
+	resultVar0, resultVar1 := s.EventStreamStore.Save(event)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3225,47 +3521,46 @@ func (s *TimerLayerGroupStore) GetGroupsAssociatedToChannelsByTeam(teamId string
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetGroupsAssociatedToChannelsByTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("EventStreamStore.Save", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) GetGroupsByChannel(channelId string, opts model.GroupSearchOpts) ([]*model.GroupWithSchemeAdmin, *model.AppError) {
+func (s *TimerLayerFileInfoStore) AttachToPost(fileId string, postId string, creatorId string) *model.AppError {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.GetGroupsByChannel(channelId, opts)
+	resultVar0 := s.FileInfoStore.AttachToPost(fileId, postId, creatorId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetGroupsByChannel", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.AttachToPost", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerGroupStore) GetGroupsByTeam(teamId string, opts model.GroupSearchOpts) ([]*model.GroupWithSchemeAdmin, *model.AppError) {
+func (s *TimerLayerFileInfoStore) ClearCaches() {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.GetGroupsByTeam(teamId, opts)
+	s.FileInfoStore.ClearCaches()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if true {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetGroupsByTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.ClearCaches", success, elapsed)
 	}
-	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) GetMemberCount(groupID string) (int64, *model.AppError) {
+func (s *TimerLayerFileInfoStore) DeleteForPost(postId string) (string, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.GetMemberCount(groupID)
+	resultVar0, resultVar1 := s.FileInfoStore.DeleteForPost(postId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3273,15 +3568,15 @@ func (s *TimerLayerGroupStore) GetMemberCount(groupID string) (int64, *model.App
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.DeleteForPost", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) GetMemberUsers(groupID string) ([]*model.User, *model.AppError) {
+func (s *TimerLayerFileInfoStore) Get(id string) (*model.FileInfo, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.GetMemberUsers(groupID)
+	resultVar0, resultVar1 := s.FileInfoStore.Get(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3289,15 +3584,15 @@ func (s *TimerLayerGroupStore) GetMemberUsers(groupID string) ([]*model.User, *m
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberUsers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.Get", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) GetMemberUsersInTeam(groupID string, teamID string) ([]*model.User, *model.AppError) {
+func (s *TimerLayerFileInfoStore) GetByPath(path string) (*model.FileInfo, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.GetMemberUsersInTeam(groupID, teamID)
+	resultVar0, resultVar1 := s.FileInfoStore.GetByPath(path)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3305,15 +3600,15 @@ func (s *TimerLayerGroupStore) GetMemberUsersInTeam(groupID string, teamID strin
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberUsersInTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetByPath", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) GetMemberUsersNotInChannel(groupID string, channelID string) ([]*model.User, *model.AppError) {
+func (s *TimerLayerFileInfoStore) GetForPost(postId string, readFromMaster bool, includeDeleted bool, allowFromCache bool) ([]*model.FileInfo, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.GetMemberUsersNotInChannel(groupID, channelID)
+	resultVar0, resultVar1 := s.FileInfoStore.GetForPost(postId, readFromMaster, includeDeleted, allowFromCache)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3321,15 +3616,15 @@ func (s *TimerLayerGroupStore) GetMemberUsersNotInChannel(groupID string, channe
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberUsersNotInChannel", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetForPost", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) GetMemberUsersPage(groupID string, page int, perPage int) ([]*model.User, *model.AppError) {
+func (s *TimerLayerFileInfoStore) GetForUser(userId string) ([]*model.FileInfo, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.GetMemberUsersPage(groupID, page, perPage)
+	resultVar0, resultVar1 := s.FileInfoStore.GetForUser(userId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3337,15 +3632,15 @@ func (s *TimerLayerGroupStore) GetMemberUsersPage(groupID string, page int, perP
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberUsersPage", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetForUser", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) GroupChannelCount() (int64, *model.AppError) {
+func (s *TimerLayerFileInfoStore) GetWithOptions(page int, perPage int, opt *model.GetFileInfosOptions) ([]*model.FileInfo, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.GroupChannelCount()
+	resultVar0, resultVar1 := s.FileInfoStore.GetWithOptions(page, perPage, opt)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3353,47 +3648,46 @@ func (s *TimerLayerGroupStore) GroupChannelCount() (int64, *model.AppError) {
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupChannelCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetWithOptions", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) GroupCount() (int64, *model.AppError) {
+func (s *TimerLayerFileInfoStore) InvalidateFileInfosForPostCache(postId string, deleted bool) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.GroupCount()
+	s.FileInfoStore.InvalidateFileInfosForPostCache(postId, deleted)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if true {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.InvalidateFileInfosForPostCache", success, elapsed)
 	}
-	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) GroupCountWithAllowReference() (int64, *model.AppError) {
+func (s *TimerLayerFileInfoStore) PermanentDelete(fileId string) *model.AppError {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.GroupCountWithAllowReference()
+	resultVar0 := s.FileInfoStore.PermanentDelete(fileId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupCountWithAllowReference", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.PermanentDelete", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerGroupStore) GroupMemberCount() (int64, *model.AppError) {
+func (s *TimerLayerFileInfoStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.GroupMemberCount()
+	resultVar0, resultVar1 := s.FileInfoStore.PermanentDeleteBatch(endTime, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3401,15 +3695,15 @@ func (s *TimerLayerGroupStore) GroupMemberCount() (int64, *model.AppError) {
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupMemberCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.PermanentDeleteBatch", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) GroupTeamCount() (int64, *model.AppError) {
+func (s *TimerLayerFileInfoStore) PermanentDeleteByUser(userId string) (int64, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.GroupTeamCount()
+	resultVar0, resultVar1 := s.FileInfoStore.PermanentDeleteByUser(userId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3417,63 +3711,63 @@ func (s *TimerLayerGroupStore) GroupTeamCount() (int64, *model.AppError) {
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupTeamCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.PermanentDeleteByUser", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) PermanentDeleteMembersByUser(userId string) *model.AppError {
+func (s *TimerLayerFileInfoStore) Save(info *model.FileInfo) (*model.FileInfo, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0 := s.GroupStore.PermanentDeleteMembersByUser(userId)
+	resultVar0, resultVar1 := s.FileInfoStore.Save(info)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.PermanentDeleteMembersByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.Save", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) PermittedSyncableAdmins(syncableID string, syncableType model.GroupSyncableType) ([]string, *model.AppError) {
+func (s *TimerLayerFileInfoStore) SetSensitive(fileId string, sensitive bool) *model.AppError {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.PermittedSyncableAdmins(syncableID, syncableType)
+	resultVar0 := s.FileInfoStore.SetSensitive(fileId, sensitive)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.PermittedSyncableAdmins", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.SetSensitive", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerGroupStore) TeamMembersMinusGroupMembers(teamID string, groupIDs []string, page int, perPage int) ([]*model.UserWithGroups, *model.AppError) {
+func (s *TimerLayerFileInfoStore) UpdateEnrichment(info *model.FileInfo) *model.AppError {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.TeamMembersMinusGroupMembers(teamID, groupIDs, page, perPage)
+	resultVar0 := s.FileInfoStore.UpdateEnrichment(info)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.TeamMembersMinusGroupMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.UpdateEnrichment", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerGroupStore) TeamMembersToAdd(since int64, teamID *string) ([]*model.UserTeamIDPair, *model.AppError) {
+func (s *TimerLayerGroupStore) AdminRoleGroupsForSyncableMember(userID string, syncableID string, syncableType model.GroupSyncableType) ([]string, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.TeamMembersToAdd(since, teamID)
+	resultVar0, resultVar1 := s.GroupStore.AdminRoleGroupsForSyncableMember(userID, syncableID, syncableType)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3481,15 +3775,15 @@ func (s *TimerLayerGroupStore) TeamMembersToAdd(since int64, teamID *string) ([]
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.TeamMembersToAdd", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.AdminRoleGroupsForSyncableMember", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) TeamMembersToRemove(teamID *string) ([]*model.TeamMember, *model.AppError) {
+func (s *TimerLayerGroupStore) ChannelMembersMinusGroupMembers(channelID string, groupIDs []string, page int, perPage int) ([]*model.UserWithGroups, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.TeamMembersToRemove(teamID)
+	resultVar0, resultVar1 := s.GroupStore.ChannelMembersMinusGroupMembers(channelID, groupIDs, page, perPage)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3497,15 +3791,15 @@ func (s *TimerLayerGroupStore) TeamMembersToRemove(teamID *string) ([]*model.Tea
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.TeamMembersToRemove", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.ChannelMembersMinusGroupMembers", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) Update(group *model.Group) (*model.Group, *model.AppError) {
+func (s *TimerLayerGroupStore) ChannelMembersToAdd(since int64, channelID *string) ([]*model.UserChannelIDPair, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.Update(group)
+	resultVar0, resultVar1 := s.GroupStore.ChannelMembersToAdd(since, channelID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3513,15 +3807,15 @@ func (s *TimerLayerGroupStore) Update(group *model.Group) (*model.Group, *model.
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.Update", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.ChannelMembersToAdd", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) UpdateGroupSyncable(groupSyncable *model.GroupSyncable) (*model.GroupSyncable, *model.AppError) {
+func (s *TimerLayerGroupStore) ChannelMembersToRemove(channelID *string) ([]*model.ChannelMember, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.UpdateGroupSyncable(groupSyncable)
+	resultVar0, resultVar1 := s.GroupStore.ChannelMembersToRemove(channelID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3529,15 +3823,15 @@ func (s *TimerLayerGroupStore) UpdateGroupSyncable(groupSyncable *model.GroupSyn
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.UpdateGroupSyncable", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.ChannelMembersToRemove", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerGroupStore) UpsertMember(groupID string, userID string) (*model.GroupMember, *model.AppError) {
+func (s *TimerLayerGroupStore) CountChannelMembersMinusGroupMembers(channelID string, groupIDs []string) (int64, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.GroupStore.UpsertMember(groupID, userID)
+	resultVar0, resultVar1 := s.GroupStore.CountChannelMembersMinusGroupMembers(channelID, groupIDs)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3545,15 +3839,15 @@ func (s *TimerLayerGroupStore) UpsertMember(groupID string, userID string) (*mod
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.UpsertMember", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CountChannelMembersMinusGroupMembers", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerJobStore) Delete(id string) (string, *model.AppError) {
+func (s *TimerLayerGroupStore) CountGroupsByChannel(channelId string, opts model.GroupSearchOpts) (int64, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.JobStore.Delete(id)
+	resultVar0, resultVar1 := s.GroupStore.CountGroupsByChannel(channelId, opts)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3561,15 +3855,15 @@ func (s *TimerLayerJobStore) Delete(id string) (string, *model.AppError) {
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CountGroupsByChannel", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerJobStore) Get(id string) (*model.Job, *model.AppError) {
+func (s *TimerLayerGroupStore) CountGroupsByTeam(teamId string, opts model.GroupSearchOpts) (int64, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.JobStore.Get(id)
+	resultVar0, resultVar1 := s.GroupStore.CountGroupsByTeam(teamId, opts)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3577,15 +3871,15 @@ func (s *TimerLayerJobStore) Get(id string) (*model.Job, *model.AppError) {
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CountGroupsByTeam", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerJobStore) GetAllByStatus(status string) ([]*model.Job, *model.AppError) {
+func (s *TimerLayerGroupStore) CountTeamMembersMinusGroupMembers(teamID string, groupIDs []string) (int64, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.JobStore.GetAllByStatus(status)
+	resultVar0, resultVar1 := s.GroupStore.CountTeamMembersMinusGroupMembers(teamID, groupIDs)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3593,15 +3887,15 @@ func (s *TimerLayerJobStore) GetAllByStatus(status string) ([]*model.Job, *model
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllByStatus", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CountTeamMembersMinusGroupMembers", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerJobStore) GetAllByType(jobType string) ([]*model.Job, *model.AppError) {
+func (s *TimerLayerGroupStore) Create(group *model.Group) (*model.Group, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.JobStore.GetAllByType(jobType)
+	resultVar0, resultVar1 := s.GroupStore.Create(group)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3609,15 +3903,15 @@ func (s *TimerLayerJobStore) GetAllByType(jobType string) ([]*model.Job, *model.
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllByType", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.Create", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerJobStore) GetAllByTypePage(jobType string, offset int, limit int) ([]*model.Job, *model.AppError) {
+func (s *TimerLayerGroupStore) CreateGroupSyncable(groupSyncable *model.GroupSyncable) (*model.GroupSyncable, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.JobStore.GetAllByTypePage(jobType, offset, limit)
+	resultVar0, resultVar1 := s.GroupStore.CreateGroupSyncable(groupSyncable)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3625,15 +3919,15 @@ func (s *TimerLayerJobStore) GetAllByTypePage(jobType string, offset int, limit
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllByTypePage", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CreateGroupSyncable", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerJobStore) GetAllPage(offset int, limit int) ([]*model.Job, *model.AppError) {
+func (s *TimerLayerGroupStore) Delete(groupID string) (*model.Group, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.JobStore.GetAllPage(offset, limit)
+	resultVar0, resultVar1 := s.GroupStore.Delete(groupID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3641,15 +3935,15 @@ func (s *TimerLayerJobStore) GetAllPage(offset int, limit int) ([]*model.Job, *m
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllPage", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.Delete", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerJobStore) GetCountByStatusAndType(status string, jobType string) (int64, *model.AppError) {
+func (s *TimerLayerGroupStore) DeleteGroupSyncable(groupID string, syncableID string, syncableType model.GroupSyncableType) (*model.GroupSyncable, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.JobStore.GetCountByStatusAndType(status, jobType)
+	resultVar0, resultVar1 := s.GroupStore.DeleteGroupSyncable(groupID, syncableID, syncableType)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3657,15 +3951,15 @@ func (s *TimerLayerJobStore) GetCountByStatusAndType(status string, jobType stri
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetCountByStatusAndType", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.DeleteGroupSyncable", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerJobStore) GetNewestJobByStatusAndType(status string, jobType string) (*model.Job, *model.AppError) {
+func (s *TimerLayerGroupStore) DeleteMember(groupID string, userID string) (*model.GroupMember, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.JobStore.GetNewestJobByStatusAndType(status, jobType)
+	resultVar0, resultVar1 := s.GroupStore.DeleteMember(groupID, userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3673,15 +3967,15 @@ func (s *TimerLayerJobStore) GetNewestJobByStatusAndType(status string, jobType
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetNewestJobByStatusAndType", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.DeleteMember", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerJobStore) Save(job *model.Job) (*model.Job, *model.AppError) {
+func (s *TimerLayerGroupStore) DistinctGroupMemberCount() (int64, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.JobStore.Save(job)
+	resultVar0, resultVar1 := s.GroupStore.DistinctGroupMemberCount()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3689,15 +3983,15 @@ func (s *TimerLayerJobStore) Save(job *model.Job) (*model.Job, *model.AppError)
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.DistinctGroupMemberCount", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerJobStore) UpdateOptimistically(job *model.Job, currentStatus string) (bool, *model.AppError) {
+func (s *TimerLayerGroupStore) Get(groupID string) (*model.Group, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.JobStore.UpdateOptimistically(job, currentStatus)
+	resultVar0, resultVar1 := s.GroupStore.Get(groupID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3705,15 +3999,15 @@ func (s *TimerLayerJobStore) UpdateOptimistically(job *model.Job, currentStatus
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.UpdateOptimistically", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.Get", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerJobStore) UpdateStatus(id string, status string) (*model.Job, *model.AppError) {
+func (s *TimerLayerGroupStore) GetAllBySource(groupSource model.GroupSource) ([]*model.Group, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.JobStore.UpdateStatus(id, status)
+	resultVar0, resultVar1 := s.GroupStore.GetAllBySource(groupSource)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3721,15 +4015,15 @@ func (s *TimerLayerJobStore) UpdateStatus(id string, status string) (*model.Job,
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.UpdateStatus", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetAllBySource", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerJobStore) UpdateStatusOptimistically(id string, currentStatus string, newStatus string) (bool, *model.AppError) {
+func (s *TimerLayerGroupStore) GetAllGroupSyncablesByGroupId(groupID string, syncableType model.GroupSyncableType) ([]*model.GroupSyncable, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.JobStore.UpdateStatusOptimistically(id, currentStatus, newStatus)
+	resultVar0, resultVar1 := s.GroupStore.GetAllGroupSyncablesByGroupId(groupID, syncableType)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3737,15 +4031,15 @@ func (s *TimerLayerJobStore) UpdateStatusOptimistically(id string, currentStatus
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.UpdateStatusOptimistically", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetAllGroupSyncablesByGroupId", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerLicenseStore) Get(id string) (*model.LicenseRecord, error) {
+func (s *TimerLayerGroupStore) GetByIDs(groupIDs []string) ([]*model.Group, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.LicenseStore.Get(id)
+	resultVar0, resultVar1 := s.GroupStore.GetByIDs(groupIDs)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3753,15 +4047,15 @@ func (s *TimerLayerLicenseStore) Get(id string) (*model.LicenseRecord, error) {
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("LicenseStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetByIDs", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerLicenseStore) Save(license *model.LicenseRecord) (*model.LicenseRecord, error) {
+func (s *TimerLayerGroupStore) GetByName(name string, opts model.GroupSearchOpts) (*model.Group, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.LicenseStore.Save(license)
+	resultVar0, resultVar1 := s.GroupStore.GetByName(name, opts)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3769,15 +4063,15 @@ func (s *TimerLayerLicenseStore) Save(license *model.LicenseRecord) (*model.Lice
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("LicenseStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetByName", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerLinkMetadataStore) Get(url string, timestamp int64) (*model.LinkMetadata, error) {
+func (s *TimerLayerGroupStore) GetByRemoteID(remoteID string, groupSource model.GroupSource) (*model.Group, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.LinkMetadataStore.Get(url, timestamp)
+	resultVar0, resultVar1 := s.GroupStore.GetByRemoteID(remoteID, groupSource)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3785,15 +4079,15 @@ func (s *TimerLayerLinkMetadataStore) Get(url string, timestamp int64) (*model.L
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("LinkMetadataStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetByRemoteID", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerLinkMetadataStore) Save(linkMetadata *model.LinkMetadata) (*model.LinkMetadata, error) {
+func (s *TimerLayerGroupStore) GetByUser(userId string) ([]*model.Group, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.LinkMetadataStore.Save(linkMetadata)
+	resultVar0, resultVar1 := s.GroupStore.GetByUser(userId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3801,31 +4095,31 @@ func (s *TimerLayerLinkMetadataStore) Save(linkMetadata *model.LinkMetadata) (*m
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("LinkMetadataStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetByUser", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerOAuthStore) DeleteApp(id string) error {
+func (s *TimerLayerGroupStore) GetGroupSyncable(groupID string, syncableID string, syncableType model.GroupSyncableType) (*model.GroupSyncable, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0 := s.OAuthStore.DeleteApp(id)
+	resultVar0, resultVar1 := s.GroupStore.GetGroupSyncable(groupID, syncableID, syncableType)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.DeleteApp", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetGroupSyncable", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerOAuthStore) GetAccessData(token string) (*model.AccessData, error) {
+func (s *TimerLayerGroupStore) GetGroups(page int, perPage int, opts model.GroupSearchOpts) ([]*model.Group, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.OAuthStore.GetAccessData(token)
+	resultVar0, resultVar1 := s.GroupStore.GetGroups(page, perPage, opts)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3833,15 +4127,15 @@ func (s *TimerLayerOAuthStore) GetAccessData(token string) (*model.AccessData, e
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAccessData", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetGroups", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerOAuthStore) GetAccessDataByRefreshToken(token string) (*model.AccessData, error) {
+func (s *TimerLayerGroupStore) GetGroupsAssociatedToChannelsByTeam(teamId string, opts model.GroupSearchOpts) (map[string][]*model.GroupWithSchemeAdmin, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.OAuthStore.GetAccessDataByRefreshToken(token)
+	resultVar0, resultVar1 := s.GroupStore.GetGroupsAssociatedToChannelsByTeam(teamId, opts)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3849,15 +4143,15 @@ func (s *TimerLayerOAuthStore) GetAccessDataByRefreshToken(token string) (*model
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAccessDataByRefreshToken", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetGroupsAssociatedToChannelsByTeam", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerOAuthStore) GetAccessDataByUserForApp(userId string, clientId string) ([]*model.AccessData, error) {
+func (s *TimerLayerGroupStore) GetGroupsByChannel(channelId string, opts model.GroupSearchOpts) ([]*model.GroupWithSchemeAdmin, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.OAuthStore.GetAccessDataByUserForApp(userId, clientId)
+	resultVar0, resultVar1 := s.GroupStore.GetGroupsByChannel(channelId, opts)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3865,15 +4159,15 @@ func (s *TimerLayerOAuthStore) GetAccessDataByUserForApp(userId string, clientId
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAccessDataByUserForApp", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetGroupsByChannel", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerOAuthStore) GetApp(id string) (*model.OAuthApp, error) {
+func (s *TimerLayerGroupStore) GetGroupsByTeam(teamId string, opts model.GroupSearchOpts) ([]*model.GroupWithSchemeAdmin, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.OAuthStore.GetApp(id)
+	resultVar0, resultVar1 := s.GroupStore.GetGroupsByTeam(teamId, opts)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3881,15 +4175,15 @@ func (s *TimerLayerOAuthStore) GetApp(id string) (*model.OAuthApp, error) {
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetApp", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetGroupsByTeam", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerOAuthStore) GetAppByUser(userId string, offset int, limit int) ([]*model.OAuthApp, error) {
+func (s *TimerLayerGroupStore) GetMemberCount(groupID string) (int64, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.OAuthStore.GetAppByUser(userId, offset, limit)
+	resultVar0, resultVar1 := s.GroupStore.GetMemberCount(groupID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3897,15 +4191,15 @@ func (s *TimerLayerOAuthStore) GetAppByUser(userId string, offset int, limit int
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAppByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberCount", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerOAuthStore) GetApps(offset int, limit int) ([]*model.OAuthApp, error) {
+func (s *TimerLayerGroupStore) GetMemberUsers(groupID string) ([]*model.User, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.OAuthStore.GetApps(offset, limit)
+	resultVar0, resultVar1 := s.GroupStore.GetMemberUsers(groupID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3913,15 +4207,15 @@ func (s *TimerLayerOAuthStore) GetApps(offset int, limit int) ([]*model.OAuthApp
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetApps", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberUsers", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerOAuthStore) GetAuthData(code string) (*model.AuthData, error) {
+func (s *TimerLayerGroupStore) GetMemberUsersInTeam(groupID string, teamID string) ([]*model.User, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.OAuthStore.GetAuthData(code)
+	resultVar0, resultVar1 := s.GroupStore.GetMemberUsersInTeam(groupID, teamID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3929,15 +4223,15 @@ func (s *TimerLayerOAuthStore) GetAuthData(code string) (*model.AuthData, error)
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAuthData", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberUsersInTeam", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerOAuthStore) GetAuthorizedApps(userId string, offset int, limit int) ([]*model.OAuthApp, error) {
+func (s *TimerLayerGroupStore) GetMemberUsersNotInChannel(groupID string, channelID string) ([]*model.User, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.OAuthStore.GetAuthorizedApps(userId, offset, limit)
+	resultVar0, resultVar1 := s.GroupStore.GetMemberUsersNotInChannel(groupID, channelID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3945,15 +4239,15 @@ func (s *TimerLayerOAuthStore) GetAuthorizedApps(userId string, offset int, limi
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAuthorizedApps", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberUsersNotInChannel", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerOAuthStore) GetPreviousAccessData(userId string, clientId string) (*model.AccessData, error) {
+func (s *TimerLayerGroupStore) GetMemberUsersPage(groupID string, page int, perPage int) ([]*model.User, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.OAuthStore.GetPreviousAccessData(userId, clientId)
+	resultVar0, resultVar1 := s.GroupStore.GetMemberUsersPage(groupID, page, perPage)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3961,79 +4255,79 @@ func (s *TimerLayerOAuthStore) GetPreviousAccessData(userId string, clientId str
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetPreviousAccessData", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberUsersPage", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerOAuthStore) PermanentDeleteAuthDataByUser(userId string) error {
+func (s *TimerLayerGroupStore) GroupChannelCount() (int64, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0 := s.OAuthStore.PermanentDeleteAuthDataByUser(userId)
+	resultVar0, resultVar1 := s.GroupStore.GroupChannelCount()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.PermanentDeleteAuthDataByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupChannelCount", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerOAuthStore) RemoveAccessData(token string) error {
+func (s *TimerLayerGroupStore) GroupCount() (int64, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0 := s.OAuthStore.RemoveAccessData(token)
+	resultVar0, resultVar1 := s.GroupStore.GroupCount()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.RemoveAccessData", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupCount", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerOAuthStore) RemoveAllAccessData() error {
+func (s *TimerLayerGroupStore) GroupCountWithAllowReference() (int64, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0 := s.OAuthStore.RemoveAllAccessData()
+	resultVar0, resultVar1 := s.GroupStore.GroupCountWithAllowReference()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.RemoveAllAccessData", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupCountWithAllowReference", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerOAuthStore) RemoveAuthData(code string) error {
+func (s *TimerLayerGroupStore) GroupMemberCount() (int64, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0 := s.OAuthStore.RemoveAuthData(code)
+	resultVar0, resultVar1 := s.GroupStore.GroupMemberCount()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.RemoveAuthData", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupMemberCount", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerOAuthStore) SaveAccessData(accessData *model.AccessData) (*model.AccessData, error) {
+func (s *TimerLayerGroupStore) GroupTeamCount() (int64, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.OAuthStore.SaveAccessData(accessData)
+	resultVar0, resultVar1 := s.GroupStore.GroupTeamCount()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4041,31 +4335,31 @@ func (s *TimerLayerOAuthStore) SaveAccessData(accessData *model.AccessData) (*mo
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.SaveAccessData", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupTeamCount", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerOAuthStore) SaveApp(app *model.OAuthApp) (*model.OAuthApp, error) {
+func (s *TimerLayerGroupStore) PermanentDeleteMembersByUser(userId string) *model.AppError {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.OAuthStore.SaveApp(app)
+	resultVar0 := s.GroupStore.PermanentDeleteMembersByUser(userId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.SaveApp", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.PermanentDeleteMembersByUser", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerOAuthStore) SaveAuthData(authData *model.AuthData) (*model.AuthData, error) {
+func (s *TimerLayerGroupStore) PermittedSyncableAdmins(syncableID string, syncableType model.GroupSyncableType) ([]string, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.OAuthStore.SaveAuthData(authData)
+	resultVar0, resultVar1 := s.GroupStore.PermittedSyncableAdmins(syncableID, syncableType)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4073,15 +4367,15 @@ func (s *TimerLayerOAuthStore) SaveAuthData(authData *model.AuthData) (*model.Au
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.SaveAuthData", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.PermittedSyncableAdmins", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerOAuthStore) UpdateAccessData(accessData *model.AccessData) (*model.AccessData, error) {
+func (s *TimerLayerGroupStore) TeamMembersMinusGroupMembers(teamID string, groupIDs []string, page int, perPage int) ([]*model.UserWithGroups, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.OAuthStore.UpdateAccessData(accessData)
+	resultVar0, resultVar1 := s.GroupStore.TeamMembersMinusGroupMembers(teamID, groupIDs, page, perPage)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4089,15 +4383,15 @@ func (s *TimerLayerOAuthStore) UpdateAccessData(accessData *model.AccessData) (*
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.UpdateAccessData", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.TeamMembersMinusGroupMembers", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerOAuthStore) UpdateApp(app *model.OAuthApp) (*model.OAuthApp, error) {
+func (s *TimerLayerGroupStore) TeamMembersToAdd(since int64, teamID *string) ([]*model.UserTeamIDPair, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.OAuthStore.UpdateApp(app)
+	resultVar0, resultVar1 := s.GroupStore.TeamMembersToAdd(since, teamID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4105,15 +4399,15 @@ func (s *TimerLayerOAuthStore) UpdateApp(app *model.OAuthApp) (*model.OAuthApp,
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.UpdateApp", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.TeamMembersToAdd", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPluginStore) CompareAndDelete(keyVal *model.PluginKeyValue, oldValue []byte) (bool, *model.AppError) {
+func (s *TimerLayerGroupStore) TeamMembersToRemove(teamID *string) ([]*model.TeamMember, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PluginStore.CompareAndDelete(keyVal, oldValue)
+	resultVar0, resultVar1 := s.GroupStore.TeamMembersToRemove(teamID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4121,15 +4415,15 @@ func (s *TimerLayerPluginStore) CompareAndDelete(keyVal *model.PluginKeyValue, o
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.CompareAndDelete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.TeamMembersToRemove", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPluginStore) CompareAndSet(keyVal *model.PluginKeyValue, oldValue []byte) (bool, *model.AppError) {
+func (s *TimerLayerGroupStore) Update(group *model.Group) (*model.Group, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PluginStore.CompareAndSet(keyVal, oldValue)
+	resultVar0, resultVar1 := s.GroupStore.Update(group)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4137,79 +4431,79 @@ func (s *TimerLayerPluginStore) CompareAndSet(keyVal *model.PluginKeyValue, oldV
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.CompareAndSet", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.Update", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPluginStore) Delete(pluginId string, key string) *model.AppError {
+func (s *TimerLayerGroupStore) UpdateGroupSyncable(groupSyncable *model.GroupSyncable) (*model.GroupSyncable, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0 := s.PluginStore.Delete(pluginId, key)
+	resultVar0, resultVar1 := s.GroupStore.UpdateGroupSyncable(groupSyncable)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.UpdateGroupSyncable", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPluginStore) DeleteAllExpired() *model.AppError {
+func (s *TimerLayerGroupStore) UpsertMember(groupID string, userID string) (*model.GroupMember, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0 := s.PluginStore.DeleteAllExpired()
+	resultVar0, resultVar1 := s.GroupStore.UpsertMember(groupID, userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.DeleteAllExpired", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.UpsertMember", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPluginStore) DeleteAllForPlugin(PluginId string) *model.AppError {
+func (s *TimerLayerIntegrationUsageStore) GetForPeriod(startDay int64, endDay int64) ([]*model.IntegrationUsage, error) {
 	start := timemodule.Now()
 
-	resultVar0 := s.PluginStore.DeleteAllForPlugin(PluginId)
+	resultVar0, resultVar1 := s.IntegrationUsageStore.GetForPeriod(startDay, endDay)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.DeleteAllForPlugin", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("IntegrationUsageStore.GetForPeriod", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPluginStore) Get(pluginId string, key string) (*model.PluginKeyValue, *model.AppError) {
+func (s *TimerLayerIntegrationUsageStore) Increment(usage *model.IntegrationUsage) error {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PluginStore.Get(pluginId, key)
+	resultVar0 := s.IntegrationUsageStore.Increment(usage)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("IntegrationUsageStore.Increment", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerPluginStore) List(pluginId string, page int, perPage int) ([]string, *model.AppError) {
+func (s *TimerLayerIntegrationUsageStore) PermanentDeleteBatch(endDay int64, limit int64) (int64, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PluginStore.List(pluginId, page, perPage)
+	resultVar0, resultVar1 := s.IntegrationUsageStore.PermanentDeleteBatch(endDay, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4217,15 +4511,15 @@ func (s *TimerLayerPluginStore) List(pluginId string, page int, perPage int) ([]
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.List", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("IntegrationUsageStore.PermanentDeleteBatch", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPluginStore) SaveOrUpdate(keyVal *model.PluginKeyValue) (*model.PluginKeyValue, *model.AppError) {
+func (s *TimerLayerJobStore) Delete(id string) (string, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PluginStore.SaveOrUpdate(keyVal)
+	resultVar0, resultVar1 := s.JobStore.Delete(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4233,15 +4527,15 @@ func (s *TimerLayerPluginStore) SaveOrUpdate(keyVal *model.PluginKeyValue) (*mod
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.SaveOrUpdate", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.Delete", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPluginStore) SetWithOptions(pluginId string, key string, value []byte, options model.PluginKVSetOptions) (bool, *model.AppError) {
+func (s *TimerLayerJobStore) Get(id string) (*model.Job, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PluginStore.SetWithOptions(pluginId, key, value, options)
+	resultVar0, resultVar1 := s.JobStore.Get(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4249,15 +4543,15 @@ func (s *TimerLayerPluginStore) SetWithOptions(pluginId string, key string, valu
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.SetWithOptions", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.Get", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) AnalyticsPostCount(teamId string, mustHaveFile bool, mustHaveHashtag bool) (int64, *model.AppError) {
+func (s *TimerLayerJobStore) GetAllByStatus(status string) ([]*model.Job, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PostStore.AnalyticsPostCount(teamId, mustHaveFile, mustHaveHashtag)
+	resultVar0, resultVar1 := s.JobStore.GetAllByStatus(status)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4265,15 +4559,15 @@ func (s *TimerLayerPostStore) AnalyticsPostCount(teamId string, mustHaveFile boo
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.AnalyticsPostCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllByStatus", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) AnalyticsPostCountsByDay(options *model.AnalyticsPostCountsOptions) (model.AnalyticsRows, *model.AppError) {
+func (s *TimerLayerJobStore) GetAllByType(jobType string) ([]*model.Job, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PostStore.AnalyticsPostCountsByDay(options)
+	resultVar0, resultVar1 := s.JobStore.GetAllByType(jobType)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4281,15 +4575,15 @@ func (s *TimerLayerPostStore) AnalyticsPostCountsByDay(options *model.AnalyticsP
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.AnalyticsPostCountsByDay", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllByType", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) AnalyticsUserCountsWithPostsByDay(teamId string) (model.AnalyticsRows, *model.AppError) {
+func (s *TimerLayerJobStore) GetAllByTypePage(jobType string, offset int, limit int) ([]*model.Job, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PostStore.AnalyticsUserCountsWithPostsByDay(teamId)
+	resultVar0, resultVar1 := s.JobStore.GetAllByTypePage(jobType, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4297,46 +4591,47 @@ func (s *TimerLayerPostStore) AnalyticsUserCountsWithPostsByDay(teamId string) (
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.AnalyticsUserCountsWithPostsByDay", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllByTypePage", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) ClearCaches() {
+func (s *TimerLayerJobStore) GetAllPage(offset int, limit int) ([]*model.Job, *model.AppError) {
 	start := timemodule.Now()
 
-	s.PostStore.ClearCaches()
+	resultVar0, resultVar1 := s.JobStore.GetAllPage(offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.ClearCaches", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllPage", success, elapsed)
 	}
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) Delete(postId string, time int64, deleteByID string) *model.AppError {
+func (s *TimerLayerJobStore) GetCountByStatusAndType(status string, jobType string) (int64, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0 := s.PostStore.Delete(postId, time, deleteByID)
+	resultVar0, resultVar1 := s.JobStore.GetCountByStatusAndType(status, jobType)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetCountByStatusAndType", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) Get(id string, skipFetchThreads bool) (*model.PostList, *model.AppError) {
+func (s *TimerLayerJobStore) GetNewestJobByStatusAndType(status string, jobType string) (*model.Job, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PostStore.Get(id, skipFetchThreads)
+	resultVar0, resultVar1 := s.JobStore.GetNewestJobByStatusAndType(status, jobType)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4344,15 +4639,15 @@ func (s *TimerLayerPostStore) Get(id string, skipFetchThreads bool) (*model.Post
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetNewestJobByStatusAndType", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) GetDirectPostParentsForExportAfter(limit int, afterId string) ([]*model.DirectPostForExport, *model.AppError) {
+func (s *TimerLayerJobStore) Save(job *model.Job) (*model.Job, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PostStore.GetDirectPostParentsForExportAfter(limit, afterId)
+	resultVar0, resultVar1 := s.JobStore.Save(job)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4360,31 +4655,31 @@ func (s *TimerLayerPostStore) GetDirectPostParentsForExportAfter(limit int, afte
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetDirectPostParentsForExportAfter", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.Save", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) GetEtag(channelId string, allowFromCache bool) string {
+func (s *TimerLayerJobStore) UpdateOptimistically(job *model.Job, currentStatus string) (bool, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0 := s.PostStore.GetEtag(channelId, allowFromCache)
+	resultVar0, resultVar1 := s.JobStore.UpdateOptimistically(job, currentStatus)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetEtag", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.UpdateOptimistically", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) GetFlaggedPosts(userId string, offset int, limit int) (*model.PostList, *model.AppError) {
+func (s *TimerLayerJobStore) UpdateStatus(id string, status string) (*model.Job, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PostStore.GetFlaggedPosts(userId, offset, limit)
+	resultVar0, resultVar1 := s.JobStore.UpdateStatus(id, status)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4392,15 +4687,15 @@ func (s *TimerLayerPostStore) GetFlaggedPosts(userId string, offset int, limit i
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetFlaggedPosts", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.UpdateStatus", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) GetFlaggedPostsForChannel(userId string, channelId string, offset int, limit int) (*model.PostList, *model.AppError) {
+func (s *TimerLayerJobStore) UpdateStatusOptimistically(id string, currentStatus string, newStatus string) (bool, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PostStore.GetFlaggedPostsForChannel(userId, channelId, offset, limit)
+	resultVar0, resultVar1 := s.JobStore.UpdateStatusOptimistically(id, currentStatus, newStatus)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4408,15 +4703,15 @@ func (s *TimerLayerPostStore) GetFlaggedPostsForChannel(userId string, channelId
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetFlaggedPostsForChannel", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.UpdateStatusOptimistically", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) GetFlaggedPostsForTeam(userId string, teamId string, offset int, limit int) (*model.PostList, *model.AppError) {
+func (s *TimerLayerLicenseStore) Get(id string) (*model.LicenseRecord, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PostStore.GetFlaggedPostsForTeam(userId, teamId, offset, limit)
+	resultVar0, resultVar1 := s.LicenseStore.Get(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4424,31 +4719,31 @@ func (s *TimerLayerPostStore) GetFlaggedPostsForTeam(userId string, teamId strin
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetFlaggedPostsForTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("LicenseStore.Get", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) GetMaxPostSize() int {
+func (s *TimerLayerLicenseStore) Save(license *model.LicenseRecord) (*model.LicenseRecord, error) {
 	start := timemodule.Now()
 
-	resultVar0 := s.PostStore.GetMaxPostSize()
+	resultVar0, resultVar1 := s.LicenseStore.Save(license)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetMaxPostSize", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("LicenseStore.Save", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) GetOldest() (*model.Post, *model.AppError) {
+func (s *TimerLayerLinkMetadataStore) Get(url string, timestamp int64) (*model.LinkMetadata, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PostStore.GetOldest()
+	resultVar0, resultVar1 := s.LinkMetadataStore.Get(url, timestamp)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4456,15 +4751,15 @@ func (s *TimerLayerPostStore) GetOldest() (*model.Post, *model.AppError) {
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetOldest", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("LinkMetadataStore.Get", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) GetOldestEntityCreationTime() (int64, *model.AppError) {
+func (s *TimerLayerLinkMetadataStore) Save(linkMetadata *model.LinkMetadata) (*model.LinkMetadata, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PostStore.GetOldestEntityCreationTime()
+	resultVar0, resultVar1 := s.LinkMetadataStore.Save(linkMetadata)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4472,15 +4767,15 @@ func (s *TimerLayerPostStore) GetOldestEntityCreationTime() (int64, *model.AppEr
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetOldestEntityCreationTime", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("LinkMetadataStore.Save", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) GetParentsForExportAfter(limit int, afterId string) ([]*model.PostForExport, *model.AppError) {
+func (s *TimerLayerLoginHistoryStore) Get(options *model.LoginHistoryGetOptions) ([]*model.LoginHistory, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PostStore.GetParentsForExportAfter(limit, afterId)
+	resultVar0, resultVar1 := s.LoginHistoryStore.Get(options)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4488,15 +4783,15 @@ func (s *TimerLayerPostStore) GetParentsForExportAfter(limit int, afterId string
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetParentsForExportAfter", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("LoginHistoryStore.Get", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) GetPostAfterTime(channelId string, time int64) (*model.Post, *model.AppError) {
+func (s *TimerLayerLoginHistoryStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PostStore.GetPostAfterTime(channelId, time)
+	resultVar0, resultVar1 := s.LoginHistoryStore.PermanentDeleteBatch(endTime, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4504,31 +4799,31 @@ func (s *TimerLayerPostStore) GetPostAfterTime(channelId string, time int64) (*m
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostAfterTime", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("LoginHistoryStore.PermanentDeleteBatch", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) GetPostIdAfterTime(channelId string, time int64) (string, *model.AppError) {
+func (s *TimerLayerLoginHistoryStore) PermanentDeleteByUser(userId string) error {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PostStore.GetPostIdAfterTime(channelId, time)
+	resultVar0 := s.LoginHistoryStore.PermanentDeleteByUser(userId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostIdAfterTime", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("LoginHistoryStore.PermanentDeleteByUser", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerPostStore) GetPostIdBeforeTime(channelId string, time int64) (string, *model.AppError) {
+func (s *TimerLayerLoginHistoryStore) Save(entry *model.LoginHistory) (*model.LoginHistory, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PostStore.GetPostIdBeforeTime(channelId, time)
+	resultVar0, resultVar1 := s.LoginHistoryStore.Save(entry)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4536,31 +4831,31 @@ func (s *TimerLayerPostStore) GetPostIdBeforeTime(channelId string, time int64)
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostIdBeforeTime", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("LoginHistoryStore.Save", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) GetPosts(options model.GetPostsOptions, allowFromCache bool) (*model.PostList, *model.AppError) {
+func (s *TimerLayerOAuthStore) DeleteApp(id string) error {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PostStore.GetPosts(options, allowFromCache)
+	resultVar0 := s.OAuthStore.DeleteApp(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPosts", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.DeleteApp", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerPostStore) GetPostsAfter(options model.GetPostsOptions) (*model.PostList, *model.AppError) {
+func (s *TimerLayerOAuthStore) GetAccessData(token string) (*model.AccessData, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PostStore.GetPostsAfter(options)
+	resultVar0, resultVar1 := s.OAuthStore.GetAccessData(token)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4568,15 +4863,15 @@ func (s *TimerLayerPostStore) GetPostsAfter(options model.GetPostsOptions) (*mod
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsAfter", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAccessData", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) GetPostsBatchForIndexing(startTime int64, endTime int64, limit int) ([]*model.PostForIndexing, *model.AppError) {
+func (s *TimerLayerOAuthStore) GetAccessDataByRefreshToken(token string) (*model.AccessData, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PostStore.GetPostsBatchForIndexing(startTime, endTime, limit)
+	resultVar0, resultVar1 := s.OAuthStore.GetAccessDataByRefreshToken(token)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4584,15 +4879,15 @@ func (s *TimerLayerPostStore) GetPostsBatchForIndexing(startTime int64, endTime
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsBatchForIndexing", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAccessDataByRefreshToken", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) GetPostsBefore(options model.GetPostsOptions) (*model.PostList, *model.AppError) {
+func (s *TimerLayerOAuthStore) GetAccessDataByUserForApp(userId string, clientId string) ([]*model.AccessData, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PostStore.GetPostsBefore(options)
+	resultVar0, resultVar1 := s.OAuthStore.GetAccessDataByUserForApp(userId, clientId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4600,15 +4895,15 @@ func (s *TimerLayerPostStore) GetPostsBefore(options model.GetPostsOptions) (*mo
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsBefore", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAccessDataByUserForApp", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) GetPostsByIds(postIds []string) ([]*model.Post, *model.AppError) {
+func (s *TimerLayerOAuthStore) GetApp(id string) (*model.OAuthApp, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PostStore.GetPostsByIds(postIds)
+	resultVar0, resultVar1 := s.OAuthStore.GetApp(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4616,15 +4911,15 @@ func (s *TimerLayerPostStore) GetPostsByIds(postIds []string) ([]*model.Post, *m
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsByIds", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetApp", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) GetPostsCreatedAt(channelId string, time int64) ([]*model.Post, *model.AppError) {
+func (s *TimerLayerOAuthStore) GetAppByUser(userId string, offset int, limit int) ([]*model.OAuthApp, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PostStore.GetPostsCreatedAt(channelId, time)
+	resultVar0, resultVar1 := s.OAuthStore.GetAppByUser(userId, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4632,15 +4927,15 @@ func (s *TimerLayerPostStore) GetPostsCreatedAt(channelId string, time int64) ([
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsCreatedAt", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAppByUser", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) GetPostsSince(options model.GetPostsSinceOptions, allowFromCache bool) (*model.PostList, *model.AppError) {
+func (s *TimerLayerOAuthStore) GetApps(offset int, limit int) ([]*model.OAuthApp, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PostStore.GetPostsSince(options, allowFromCache)
+	resultVar0, resultVar1 := s.OAuthStore.GetApps(offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4648,15 +4943,15 @@ func (s *TimerLayerPostStore) GetPostsSince(options model.GetPostsSinceOptions,
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsSince", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetApps", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) GetRepliesForExport(parentId string) ([]*model.ReplyForExport, *model.AppError) {
+func (s *TimerLayerOAuthStore) GetAuthData(code string) (*model.AuthData, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PostStore.GetRepliesForExport(parentId)
+	resultVar0, resultVar1 := s.OAuthStore.GetAuthData(code)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4664,15 +4959,15 @@ func (s *TimerLayerPostStore) GetRepliesForExport(parentId string) ([]*model.Rep
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetRepliesForExport", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAuthData", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) GetSingle(id string) (*model.Post, *model.AppError) {
+func (s *TimerLayerOAuthStore) GetAuthorizedApps(userId string, offset int, limit int) ([]*model.OAuthApp, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PostStore.GetSingle(id)
+	resultVar0, resultVar1 := s.OAuthStore.GetAuthorizedApps(userId, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4680,78 +4975,79 @@ func (s *TimerLayerPostStore) GetSingle(id string) (*model.Post, *model.AppError
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetSingle", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAuthorizedApps", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) InvalidateLastPostTimeCache(channelId string) {
+func (s *TimerLayerOAuthStore) GetPreviousAccessData(userId string, clientId string) (*model.AccessData, error) {
 	start := timemodule.Now()
 
-	s.PostStore.InvalidateLastPostTimeCache(channelId)
+	resultVar0, resultVar1 := s.OAuthStore.GetPreviousAccessData(userId, clientId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.InvalidateLastPostTimeCache", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetPreviousAccessData", success, elapsed)
 	}
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) Overwrite(post *model.Post) (*model.Post, *model.AppError) {
+func (s *TimerLayerOAuthStore) PermanentDeleteAuthDataByUser(userId string) error {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PostStore.Overwrite(post)
+	resultVar0 := s.OAuthStore.PermanentDeleteAuthDataByUser(userId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Overwrite", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.PermanentDeleteAuthDataByUser", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerPostStore) OverwriteMultiple(posts []*model.Post) ([]*model.Post, int, *model.AppError) {
+func (s *TimerLayerOAuthStore) RemoveAccessData(token string) error {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1, resultVar2 := s.PostStore.OverwriteMultiple(posts)
+	resultVar0 := s.OAuthStore.RemoveAccessData(token)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar2 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.OverwriteMultiple", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.RemoveAccessData", success, elapsed)
 	}
-	return resultVar0, resultVar1, resultVar2
+	return resultVar0
 }
 
-func (s *TimerLayerPostStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, *model.AppError) {
+func (s *TimerLayerOAuthStore) RemoveAllAccessData() error {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PostStore.PermanentDeleteBatch(endTime, limit)
+	resultVar0 := s.OAuthStore.RemoveAllAccessData()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.PermanentDeleteBatch", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.RemoveAllAccessData", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerPostStore) PermanentDeleteByChannel(channelId string) *model.AppError {
+func (s *TimerLayerOAuthStore) RemoveAuthData(code string) error {
 	start := timemodule.Now()
 
-	resultVar0 := s.PostStore.PermanentDeleteByChannel(channelId)
+	resultVar0 := s.OAuthStore.RemoveAuthData(code)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4759,31 +5055,31 @@ func (s *TimerLayerPostStore) PermanentDeleteByChannel(channelId string) *model.
 		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.PermanentDeleteByChannel", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.RemoveAuthData", success, elapsed)
 	}
 	return resultVar0
 }
 
-func (s *TimerLayerPostStore) PermanentDeleteByUser(userId string) *model.AppError {
+func (s *TimerLayerOAuthStore) SaveAccessData(accessData *model.AccessData) (*model.AccessData, error) {
 	start := timemodule.Now()
 
-	resultVar0 := s.PostStore.PermanentDeleteByUser(userId)
+	resultVar0, resultVar1 := s.OAuthStore.SaveAccessData(accessData)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.PermanentDeleteByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.SaveAccessData", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) Save(post *model.Post) (*model.Post, *model.AppError) {
+func (s *TimerLayerOAuthStore) SaveApp(app *model.OAuthApp) (*model.OAuthApp, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PostStore.Save(post)
+	resultVar0, resultVar1 := s.OAuthStore.SaveApp(app)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4791,31 +5087,31 @@ func (s *TimerLayerPostStore) Save(post *model.Post) (*model.Post, *model.AppErr
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.SaveApp", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) SaveMultiple(posts []*model.Post) ([]*model.Post, int, *model.AppError) {
+func (s *TimerLayerOAuthStore) SaveAuthData(authData *model.AuthData) (*model.AuthData, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1, resultVar2 := s.PostStore.SaveMultiple(posts)
+	resultVar0, resultVar1 := s.OAuthStore.SaveAuthData(authData)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar2 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.SaveMultiple", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.SaveAuthData", success, elapsed)
 	}
-	return resultVar0, resultVar1, resultVar2
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) Search(teamId string, userId string, params *model.SearchParams) (*model.PostList, *model.AppError) {
+func (s *TimerLayerOAuthStore) UpdateAccessData(accessData *model.AccessData) (*model.AccessData, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PostStore.Search(teamId, userId, params)
+	resultVar0, resultVar1 := s.OAuthStore.UpdateAccessData(accessData)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4823,15 +5119,15 @@ func (s *TimerLayerPostStore) Search(teamId string, userId string, params *model
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Search", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.UpdateAccessData", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) SearchPostsInTeamForUser(paramsList []*model.SearchParams, userId string, teamId string, isOrSearch bool, includeDeletedChannels bool, page int, perPage int) (*model.PostSearchResults, *model.AppError) {
+func (s *TimerLayerOAuthStore) UpdateApp(app *model.OAuthApp) (*model.OAuthApp, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PostStore.SearchPostsInTeamForUser(paramsList, userId, teamId, isOrSearch, includeDeletedChannels, page, perPage)
+	resultVar0, resultVar1 := s.OAuthStore.UpdateApp(app)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4839,15 +5135,15 @@ func (s *TimerLayerPostStore) SearchPostsInTeamForUser(paramsList []*model.Searc
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.SearchPostsInTeamForUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.UpdateApp", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPostStore) Update(newPost *model.Post, oldPost *model.Post) (*model.Post, *model.AppError) {
+func (s *TimerLayerOutboxStore) GetUndelivered(createdBefore int64, limit int) ([]*model.OutboxEvent, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PostStore.Update(newPost, oldPost)
+	resultVar0, resultVar1 := s.OutboxStore.GetUndelivered(createdBefore, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4855,15 +5151,2124 @@ func (s *TimerLayerPostStore) Update(newPost *model.Post, oldPost *model.Post) (
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Update", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OutboxStore.GetUndelivered", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPreferenceStore) CleanupFlagsBatch(limit int64) (int64, *model.AppError) {
+func (s *TimerLayerOutboxStore) MarkDelivered(ids []string, deliveredAt int64) error {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PreferenceStore.CleanupFlagsBatch(limit)
+	resultVar0 := s.OutboxStore.MarkDelivered(ids, deliveredAt)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("OutboxStore.MarkDelivered", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerOutboxStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.OutboxStore.PermanentDeleteBatch(endTime, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("OutboxStore.PermanentDeleteBatch", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerOutboxStore) Save(event *model.OutboxEvent) (*model.OutboxEvent, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.OutboxStore.Save(event)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("OutboxStore.Save", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPendingPinStore) Delete(postId string) error {
+	start := timemodule.Now()
+
+	resultVar0 := s.PendingPinStore.Delete(postId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PendingPinStore.Delete", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerPendingPinStore) Get(postId string) (*model.PendingPin, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PendingPinStore.Get(postId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PendingPinStore.Get", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPendingPinStore) GetForChannel(channelId string) ([]*model.PendingPin, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PendingPinStore.GetForChannel(channelId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PendingPinStore.GetForChannel", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPendingPinStore) PermanentDeleteByChannel(channelId string) error {
+	start := timemodule.Now()
+
+	resultVar0 := s.PendingPinStore.PermanentDeleteByChannel(channelId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PendingPinStore.PermanentDeleteByChannel", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerPendingPinStore) Save(pendingPin *model.PendingPin) (*model.PendingPin, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PendingPinStore.Save(pendingPin)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PendingPinStore.Save", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPluginStore) CompareAndDelete(keyVal *model.PluginKeyValue, oldValue []byte) (bool, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PluginStore.CompareAndDelete(keyVal, oldValue)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.CompareAndDelete", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPluginStore) CompareAndSet(keyVal *model.PluginKeyValue, oldValue []byte) (bool, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PluginStore.CompareAndSet(keyVal, oldValue)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.CompareAndSet", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPluginStore) Delete(pluginId string, key string) *model.AppError {
+	start := timemodule.Now()
+
+	resultVar0 := s.PluginStore.Delete(pluginId, key)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.Delete", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerPluginStore) DeleteAllExpired() *model.AppError {
+	start := timemodule.Now()
+
+	resultVar0 := s.PluginStore.DeleteAllExpired()
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.DeleteAllExpired", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerPluginStore) DeleteAllForPlugin(PluginId string) *model.AppError {
+	start := timemodule.Now()
+
+	resultVar0 := s.PluginStore.DeleteAllForPlugin(PluginId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.DeleteAllForPlugin", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerPluginStore) Get(pluginId string, key string) (*model.PluginKeyValue, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PluginStore.Get(pluginId, key)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.Get", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPluginStore) List(pluginId string, page int, perPage int) ([]string, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PluginStore.List(pluginId, page, perPage)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.List", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPluginStore) SaveOrUpdate(keyVal *model.PluginKeyValue) (*model.PluginKeyValue, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PluginStore.SaveOrUpdate(keyVal)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.SaveOrUpdate", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPluginStore) SetWithOptions(pluginId string, key string, value []byte, options model.PluginKVSetOptions) (bool, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PluginStore.SetWithOptions(pluginId, key, value, options)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.SetWithOptions", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) AnalyticsPostCount(teamId string, mustHaveFile bool, mustHaveHashtag bool, estimate bool) (int64, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostStore.AnalyticsPostCount(teamId, mustHaveFile, mustHaveHashtag, estimate)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.AnalyticsPostCount", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) AnalyticsPostCountsByDay(options *model.AnalyticsPostCountsOptions) (model.AnalyticsRows, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostStore.AnalyticsPostCountsByDay(options)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.AnalyticsPostCountsByDay", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) AnalyticsUserCountsWithPostsByDay(teamId string) (model.AnalyticsRows, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostStore.AnalyticsUserCountsWithPostsByDay(teamId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.AnalyticsUserCountsWithPostsByDay", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) ClearCaches() {
+	start := timemodule.Now()
+
+	s.PostStore.ClearCaches()
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if true {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.ClearCaches", success, elapsed)
+	}
+}
+
+func (s *TimerLayerPostStore) Delete(postId string, time int64, deleteByID string) *model.AppError {
+	start := timemodule.Now()
+
+	resultVar0 := s.PostStore.Delete(postId, time, deleteByID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Delete", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerPostStore) DeleteWithOutboxEvent(postId string, time int64, deleteByID string, message *model.WebSocketEvent) (*model.OutboxEvent, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostStore.DeleteWithOutboxEvent(postId, time, deleteByID, message)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.DeleteWithOutboxEvent", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) Get(id string, skipFetchThreads bool) (*model.PostList, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostStore.Get(id, skipFetchThreads)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Get", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) GetDirectPostParentsForExportAfter(limit int, afterId string) ([]*model.DirectPostForExport, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostStore.GetDirectPostParentsForExportAfter(limit, afterId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetDirectPostParentsForExportAfter", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) GetEtag(channelId string, allowFromCache bool) string {
+	start := timemodule.Now()
+
+	resultVar0 := s.PostStore.GetEtag(channelId, allowFromCache)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if true {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetEtag", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerPostStore) GetFlaggedPosts(userId string, offset int, limit int) (*model.PostList, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostStore.GetFlaggedPosts(userId, offset, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetFlaggedPosts", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) GetFlaggedPostsForChannel(userId string, channelId string, offset int, limit int) (*model.PostList, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostStore.GetFlaggedPostsForChannel(userId, channelId, offset, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetFlaggedPostsForChannel", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) GetFlaggedPostsForTeam(userId string, teamId string, offset int, limit int) (*model.PostList, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostStore.GetFlaggedPostsForTeam(userId, teamId, offset, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetFlaggedPostsForTeam", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) GetMaxPostSize() int {
+	start := timemodule.Now()
+
+	resultVar0 := s.PostStore.GetMaxPostSize()
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if true {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetMaxPostSize", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerPostStore) GetOldest() (*model.Post, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostStore.GetOldest()
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetOldest", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) GetOldestEntityCreationTime() (int64, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostStore.GetOldestEntityCreationTime()
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetOldestEntityCreationTime", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) GetParentsForExportAfter(limit int, afterId string) ([]*model.PostForExport, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostStore.GetParentsForExportAfter(limit, afterId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetParentsForExportAfter", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) GetPostAfterTime(channelId string, time int64) (*model.Post, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostStore.GetPostAfterTime(channelId, time)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostAfterTime", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) GetPostIdAfterTime(channelId string, time int64) (string, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostStore.GetPostIdAfterTime(channelId, time)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostIdAfterTime", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) GetPostIdBeforeTime(channelId string, time int64) (string, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostStore.GetPostIdBeforeTime(channelId, time)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostIdBeforeTime", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) GetPosts(options model.GetPostsOptions, allowFromCache bool) (*model.PostList, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostStore.GetPosts(options, allowFromCache)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPosts", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) GetPostsAfter(options model.GetPostsOptions) (*model.PostList, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostStore.GetPostsAfter(options)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsAfter", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) GetPostsBatchForIndexing(startTime int64, endTime int64, limit int) ([]*model.PostForIndexing, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostStore.GetPostsBatchForIndexing(startTime, endTime, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsBatchForIndexing", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) GetPostsBefore(options model.GetPostsOptions) (*model.PostList, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostStore.GetPostsBefore(options)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsBefore", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) GetPostsByIds(postIds []string) ([]*model.Post, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostStore.GetPostsByIds(postIds)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsByIds", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) GetPostsCreatedAt(channelId string, time int64) ([]*model.Post, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostStore.GetPostsCreatedAt(channelId, time)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsCreatedAt", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) GetPostsDelta(cursor model.PostsDeltaCursor, limit int) (*model.PostsDelta, model.PostsDeltaCursor, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1, resultVar2 := s.PostStore.GetPostsDelta(cursor, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar2 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsDelta", success, elapsed)
+	}
+	return resultVar0, resultVar1, resultVar2
+}
+
+func (s *TimerLayerPostStore) GetPostsForChannelSnapshot(channelId string, cursor model.ChannelSnapshotCursor, limit int) ([]*model.Post, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostStore.GetPostsForChannelSnapshot(channelId, cursor, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsForChannelSnapshot", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) GetPostsSince(options model.GetPostsSinceOptions, allowFromCache bool) (*model.PostList, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostStore.GetPostsSince(options, allowFromCache)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsSince", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) GetRepliesForExport(parentId string) ([]*model.ReplyForExport, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostStore.GetRepliesForExport(parentId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetRepliesForExport", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) GetSingle(id string) (*model.Post, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostStore.GetSingle(id)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetSingle", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) InvalidateLastPostTimeCache(channelId string) {
+	start := timemodule.Now()
+
+	s.PostStore.InvalidateLastPostTimeCache(channelId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if true {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.InvalidateLastPostTimeCache", success, elapsed)
+	}
+}
+
+func (s *TimerLayerPostStore) Overwrite(post *model.Post) (*model.Post, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostStore.Overwrite(post)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Overwrite", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) OverwriteMultiple(posts []*model.Post) ([]*model.Post, int, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1, resultVar2 := s.PostStore.OverwriteMultiple(posts)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar2 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.OverwriteMultiple", success, elapsed)
+	}
+	return resultVar0, resultVar1, resultVar2
+}
+
+func (s *TimerLayerPostStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostStore.PermanentDeleteBatch(endTime, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.PermanentDeleteBatch", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) PermanentDeleteByChannel(channelId string) *model.AppError {
+	start := timemodule.Now()
+
+	resultVar0 := s.PostStore.PermanentDeleteByChannel(channelId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.PermanentDeleteByChannel", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerPostStore) PermanentDeleteByUser(userId string) *model.AppError {
+	start := timemodule.Now()
+
+	resultVar0 := s.PostStore.PermanentDeleteByUser(userId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.PermanentDeleteByUser", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerPostStore) Save(post *model.Post) (*model.Post, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostStore.Save(post)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Save", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) SaveMultiple(posts []*model.Post) ([]*model.Post, int, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1, resultVar2 := s.PostStore.SaveMultiple(posts)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar2 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.SaveMultiple", success, elapsed)
+	}
+	return resultVar0, resultVar1, resultVar2
+}
+
+func (s *TimerLayerPostStore) SaveWithOutboxEvent(post *model.Post, message *model.WebSocketEvent) (*model.Post, *model.OutboxEvent, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1, resultVar2 := s.PostStore.SaveWithOutboxEvent(post, message)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar2 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.SaveWithOutboxEvent", success, elapsed)
+	}
+	return resultVar0, resultVar1, resultVar2
+}
+
+func (s *TimerLayerPostStore) Search(teamId string, userId string, params *model.SearchParams) (*model.PostList, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostStore.Search(teamId, userId, params)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Search", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) SearchPostsInTeamForUser(paramsList []*model.SearchParams, userId string, teamId string, isOrSearch bool, includeDeletedChannels bool, page int, perPage int) (*model.PostSearchResults, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostStore.SearchPostsInTeamForUser(paramsList, userId, teamId, isOrSearch, includeDeletedChannels, page, perPage)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.SearchPostsInTeamForUser", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) Update(newPost *model.Post, oldPost *model.Post) (*model.Post, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostStore.Update(newPost, oldPost)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Update", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostStore) UpdateWithOutboxEvent(newPost *model.Post, oldPost *model.Post, message *model.WebSocketEvent) (*model.Post, *model.OutboxEvent, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1, resultVar2 := s.PostStore.UpdateWithOutboxEvent(newPost, oldPost, message)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar2 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.UpdateWithOutboxEvent", success, elapsed)
+	}
+	return resultVar0, resultVar1, resultVar2
+}
+
+func (s *TimerLayerPostAcknowledgementStore) Delete(postId string, userId string) error {
+	start := timemodule.Now()
+
+	resultVar0 := s.PostAcknowledgementStore.Delete(postId, userId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostAcknowledgementStore.Delete", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerPostAcknowledgementStore) GetForPost(postId string) ([]*model.PostAcknowledgement, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostAcknowledgementStore.GetForPost(postId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostAcknowledgementStore.GetForPost", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostAcknowledgementStore) PermanentDeleteByChannel(channelId string) error {
+	start := timemodule.Now()
+
+	resultVar0 := s.PostAcknowledgementStore.PermanentDeleteByChannel(channelId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostAcknowledgementStore.PermanentDeleteByChannel", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerPostAcknowledgementStore) PermanentDeleteByUser(userId string) error {
+	start := timemodule.Now()
+
+	resultVar0 := s.PostAcknowledgementStore.PermanentDeleteByUser(userId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostAcknowledgementStore.PermanentDeleteByUser", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerPostAcknowledgementStore) Save(acknowledgement *model.PostAcknowledgement) (*model.PostAcknowledgement, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostAcknowledgementStore.Save(acknowledgement)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostAcknowledgementStore.Save", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostArchiveStore) ArchiveBatch(endTime int64, limit int64) (int64, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostArchiveStore.ArchiveBatch(endTime, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostArchiveStore.ArchiveBatch", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostArchiveStore) Get(id string) (*model.Post, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostArchiveStore.Get(id)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostArchiveStore.Get", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostArchiveStore) GetThread(id string) (*model.PostList, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostArchiveStore.GetThread(id)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostArchiveStore.GetThread", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostArchiveStore) Search(channelIds []string, terms []string, limit int) ([]*model.Post, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostArchiveStore.Search(channelIds, terms, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostArchiveStore.Search", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostIntegrityStore) Append(post *model.Post) (*model.PostIntegrityEntry, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostIntegrityStore.Append(post)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostIntegrityStore.Append", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostIntegrityStore) GetChannelIds(afterChannelId string, limit int) ([]string, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostIntegrityStore.GetChannelIds(afterChannelId, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostIntegrityStore.GetChannelIds", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostIntegrityStore) GetForChannel(channelId string, afterSeq int64, limit int) ([]*model.PostIntegrityEntry, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostIntegrityStore.GetForChannel(channelId, afterSeq, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostIntegrityStore.GetForChannel", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostsPartitionStore) CopyBatch(cursor model.PostsPartitionCursor, limit int64) (int64, model.PostsPartitionCursor, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1, resultVar2 := s.PostsPartitionStore.CopyBatch(cursor, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar2 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostsPartitionStore.CopyBatch", success, elapsed)
+	}
+	return resultVar0, resultVar1, resultVar2
+}
+
+func (s *TimerLayerPostsPartitionStore) DropPartitionsBefore(endTime int64) ([]string, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostsPartitionStore.DropPartitionsBefore(endTime)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostsPartitionStore.DropPartitionsBefore", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostsPartitionStore) EnsurePartitions(until int64) error {
+	start := timemodule.Now()
+
+	resultVar0 := s.PostsPartitionStore.EnsurePartitions(until)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostsPartitionStore.EnsurePartitions", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerPostsPartitionStore) FinishConversion() error {
+	start := timemodule.Now()
+
+	resultVar0 := s.PostsPartitionStore.FinishConversion()
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostsPartitionStore.FinishConversion", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerPostsPartitionStore) GetPartitions() ([]*model.PostsPartition, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostsPartitionStore.GetPartitions()
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostsPartitionStore.GetPartitions", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostsPartitionStore) IsPartitioned() (bool, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PostsPartitionStore.IsPartitioned()
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostsPartitionStore.IsPartitioned", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPostsPartitionStore) StartConversion() error {
+	start := timemodule.Now()
+
+	resultVar0 := s.PostsPartitionStore.StartConversion()
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostsPartitionStore.StartConversion", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerPreferenceStore) CleanupFlagsBatch(limit int64) (int64, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PreferenceStore.CleanupFlagsBatch(limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.CleanupFlagsBatch", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPreferenceStore) Delete(userId string, category string, name string) *model.AppError {
+	start := timemodule.Now()
+
+	resultVar0 := s.PreferenceStore.Delete(userId, category, name)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.Delete", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerPreferenceStore) DeleteCategory(userId string, category string) *model.AppError {
+	start := timemodule.Now()
+
+	resultVar0 := s.PreferenceStore.DeleteCategory(userId, category)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.DeleteCategory", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerPreferenceStore) DeleteCategoryAndName(category string, name string) *model.AppError {
+	start := timemodule.Now()
+
+	resultVar0 := s.PreferenceStore.DeleteCategoryAndName(category, name)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.DeleteCategoryAndName", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerPreferenceStore) Get(userId string, category string, name string) (*model.Preference, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PreferenceStore.Get(userId, category, name)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.Get", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPreferenceStore) GetAll(userId string) (model.Preferences, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PreferenceStore.GetAll(userId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.GetAll", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPreferenceStore) GetCategory(userId string, category string) (model.Preferences, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PreferenceStore.GetCategory(userId, category)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.GetCategory", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPreferenceStore) PermanentDeleteByUser(userId string) *model.AppError {
+	start := timemodule.Now()
+
+	resultVar0 := s.PreferenceStore.PermanentDeleteByUser(userId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.PermanentDeleteByUser", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerPreferenceStore) RenameCategory(oldCategory string, newCategory string, transform PreferenceTransform, limit int64) (int64, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PreferenceStore.RenameCategory(oldCategory, newCategory, transform, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.RenameCategory", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPreferenceStore) Save(preferences *model.Preferences) *model.AppError {
+	start := timemodule.Now()
+
+	resultVar0 := s.PreferenceStore.Save(preferences)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.Save", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerPresenceWebhookStore) Delete(id string, deleteAt int64) error {
+	start := timemodule.Now()
+
+	resultVar0 := s.PresenceWebhookStore.Delete(id, deleteAt)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PresenceWebhookStore.Delete", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerPresenceWebhookStore) Get(id string) (*model.PresenceWebhook, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PresenceWebhookStore.Get(id)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PresenceWebhookStore.Get", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPresenceWebhookStore) GetAll(offset int, limit int) ([]*model.PresenceWebhook, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PresenceWebhookStore.GetAll(offset, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PresenceWebhookStore.GetAll", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPresenceWebhookStore) Save(hook *model.PresenceWebhook) (*model.PresenceWebhook, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PresenceWebhookStore.Save(hook)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PresenceWebhookStore.Save", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerPresenceWebhookStore) Update(hook *model.PresenceWebhook) (*model.PresenceWebhook, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.PresenceWebhookStore.Update(hook)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PresenceWebhookStore.Update", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerReactionStore) BulkGetForPosts(postIds []string) ([]*model.Reaction, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.ReactionStore.BulkGetForPosts(postIds)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.BulkGetForPosts", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerReactionStore) Delete(reaction *model.Reaction) (*model.Reaction, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.ReactionStore.Delete(reaction)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.Delete", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerReactionStore) DeleteAllWithEmojiName(emojiName string) error {
+	start := timemodule.Now()
+
+	resultVar0 := s.ReactionStore.DeleteAllWithEmojiName(emojiName)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.DeleteAllWithEmojiName", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerReactionStore) GetForPost(postId string, allowFromCache bool) ([]*model.Reaction, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.ReactionStore.GetForPost(postId, allowFromCache)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.GetForPost", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerReactionStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.ReactionStore.PermanentDeleteBatch(endTime, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.PermanentDeleteBatch", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerReactionStore) Save(reaction *model.Reaction) (*model.Reaction, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.ReactionStore.Save(reaction)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.Save", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerRetentionPolicyStore) Delete(id string) error {
+	start := timemodule.Now()
+
+	resultVar0 := s.RetentionPolicyStore.Delete(id)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.Delete", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerRetentionPolicyStore) Get(id string) (*model.RetentionPolicy, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.RetentionPolicyStore.Get(id)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.Get", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerRetentionPolicyStore) GetAll(offset int, limit int) ([]*model.RetentionPolicy, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.RetentionPolicyStore.GetAll(offset, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.GetAll", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerRetentionPolicyStore) GetForChannel(channelId string) (*model.RetentionPolicy, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.RetentionPolicyStore.GetForChannel(channelId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.GetForChannel", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerRetentionPolicyStore) PermanentDeleteAllFilesBatch(endTime int64, cursor model.RetentionPolicyCursor, limit int64) (int64, model.RetentionPolicyCursor, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1, resultVar2 := s.RetentionPolicyStore.PermanentDeleteAllFilesBatch(endTime, cursor, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar2 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.PermanentDeleteAllFilesBatch", success, elapsed)
+	}
+	return resultVar0, resultVar1, resultVar2
+}
+
+func (s *TimerLayerRetentionPolicyStore) PermanentDeleteFilesBatch(policyId string, endTime int64, cursor model.RetentionPolicyCursor, limit int64) (int64, model.RetentionPolicyCursor, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1, resultVar2 := s.RetentionPolicyStore.PermanentDeleteFilesBatch(policyId, endTime, cursor, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar2 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.PermanentDeleteFilesBatch", success, elapsed)
+	}
+	return resultVar0, resultVar1, resultVar2
+}
+
+func (s *TimerLayerRetentionPolicyStore) PermanentDeletePostsBatch(policyId string, endTime int64, cursor model.RetentionPolicyCursor, limit int64) (int64, model.RetentionPolicyCursor, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1, resultVar2 := s.RetentionPolicyStore.PermanentDeletePostsBatch(policyId, endTime, cursor, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar2 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.PermanentDeletePostsBatch", success, elapsed)
+	}
+	return resultVar0, resultVar1, resultVar2
+}
+
+func (s *TimerLayerRetentionPolicyStore) Save(policy *model.RetentionPolicy) (*model.RetentionPolicy, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.RetentionPolicyStore.Save(policy)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.Save", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerRetentionPolicyStore) Update(policy *model.RetentionPolicy) (*model.RetentionPolicy, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.RetentionPolicyStore.Update(policy)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.Update", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerRoleStore) AllChannelSchemeRoles() ([]*model.Role, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.RoleStore.AllChannelSchemeRoles()
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.AllChannelSchemeRoles", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerRoleStore) ChannelHigherScopedPermissions(roleNames []string) (map[string]*model.RolePermissions, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.RoleStore.ChannelHigherScopedPermissions(roleNames)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.ChannelHigherScopedPermissions", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerRoleStore) ChannelRolesUnderTeamRole(roleName string) ([]*model.Role, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.RoleStore.ChannelRolesUnderTeamRole(roleName)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.ChannelRolesUnderTeamRole", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerRoleStore) Delete(roleId string) (*model.Role, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.RoleStore.Delete(roleId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.Delete", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerRoleStore) Get(roleId string) (*model.Role, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.RoleStore.Get(roleId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.Get", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerRoleStore) GetAll() ([]*model.Role, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.RoleStore.GetAll()
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.GetAll", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerRoleStore) GetByName(name string) (*model.Role, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.RoleStore.GetByName(name)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.GetByName", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerRoleStore) GetByNames(names []string) ([]*model.Role, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.RoleStore.GetByNames(names)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.GetByNames", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerRoleStore) PermanentDeleteAll() *model.AppError {
+	start := timemodule.Now()
+
+	resultVar0 := s.RoleStore.PermanentDeleteAll()
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.PermanentDeleteAll", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerRoleStore) Save(role *model.Role) (*model.Role, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.RoleStore.Save(role)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.Save", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerSchemeStore) CountByScope(scope string) (int64, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.SchemeStore.CountByScope(scope)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.CountByScope", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerSchemeStore) CountWithoutPermission(scope string, permissionID string, roleScope model.RoleScope, roleType model.RoleType) (int64, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.SchemeStore.CountWithoutPermission(scope, permissionID, roleScope, roleType)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.CountWithoutPermission", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerSchemeStore) Delete(schemeId string) (*model.Scheme, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.SchemeStore.Delete(schemeId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.Delete", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerSchemeStore) Get(schemeId string) (*model.Scheme, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.SchemeStore.Get(schemeId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.Get", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerSchemeStore) GetAllPage(scope string, offset int, limit int) ([]*model.Scheme, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.SchemeStore.GetAllPage(scope, offset, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.GetAllPage", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerSchemeStore) GetByName(schemeName string) (*model.Scheme, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.SchemeStore.GetByName(schemeName)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.GetByName", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerSchemeStore) PermanentDeleteAll() error {
+	start := timemodule.Now()
+
+	resultVar0 := s.SchemeStore.PermanentDeleteAll()
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.PermanentDeleteAll", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerSchemeStore) Save(scheme *model.Scheme) (*model.Scheme, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.SchemeStore.Save(scheme)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.Save", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerSessionStore) AnalyticsSessionCount(estimate bool) (int64, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.SessionStore.AnalyticsSessionCount(estimate)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.AnalyticsSessionCount", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerSessionStore) Cleanup(expiryTime int64, batchSize int64) {
+	start := timemodule.Now()
+
+	s.SessionStore.Cleanup(expiryTime, batchSize)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if true {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.Cleanup", success, elapsed)
+	}
+}
+
+func (s *TimerLayerSessionStore) Get(sessionIdOrToken string) (*model.Session, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.SessionStore.Get(sessionIdOrToken)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.Get", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerSessionStore) GetSessions(userId string) ([]*model.Session, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.SessionStore.GetSessions(userId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.GetSessions", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerSessionStore) GetSessionsExpired(thresholdMillis int64, mobileOnly bool, unnotifiedOnly bool) ([]*model.Session, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.SessionStore.GetSessionsExpired(thresholdMillis, mobileOnly, unnotifiedOnly)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.GetSessionsExpired", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerSessionStore) GetSessionsWithActiveDeviceIds(userId string) ([]*model.Session, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.SessionStore.GetSessionsWithActiveDeviceIds(userId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4871,15 +7276,15 @@ func (s *TimerLayerPreferenceStore) CleanupFlagsBatch(limit int64) (int64, *mode
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.CleanupFlagsBatch", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.GetSessionsWithActiveDeviceIds", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPreferenceStore) Delete(userId string, category string, name string) *model.AppError {
+func (s *TimerLayerSessionStore) PermanentDeleteSessionsByUser(teamId string) error {
 	start := timemodule.Now()
 
-	resultVar0 := s.PreferenceStore.Delete(userId, category, name)
+	resultVar0 := s.SessionStore.PermanentDeleteSessionsByUser(teamId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4887,15 +7292,15 @@ func (s *TimerLayerPreferenceStore) Delete(userId string, category string, name
 		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.PermanentDeleteSessionsByUser", success, elapsed)
 	}
 	return resultVar0
 }
 
-func (s *TimerLayerPreferenceStore) DeleteCategory(userId string, category string) *model.AppError {
+func (s *TimerLayerSessionStore) Remove(sessionIdOrToken string) error {
 	start := timemodule.Now()
 
-	resultVar0 := s.PreferenceStore.DeleteCategory(userId, category)
+	resultVar0 := s.SessionStore.Remove(sessionIdOrToken)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4903,15 +7308,15 @@ func (s *TimerLayerPreferenceStore) DeleteCategory(userId string, category strin
 		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.DeleteCategory", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.Remove", success, elapsed)
 	}
 	return resultVar0
 }
 
-func (s *TimerLayerPreferenceStore) DeleteCategoryAndName(category string, name string) *model.AppError {
+func (s *TimerLayerSessionStore) RemoveAllSessions() error {
 	start := timemodule.Now()
 
-	resultVar0 := s.PreferenceStore.DeleteCategoryAndName(category, name)
+	resultVar0 := s.SessionStore.RemoveAllSessions()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4919,15 +7324,15 @@ func (s *TimerLayerPreferenceStore) DeleteCategoryAndName(category string, name
 		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.DeleteCategoryAndName", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.RemoveAllSessions", success, elapsed)
 	}
 	return resultVar0
 }
 
-func (s *TimerLayerPreferenceStore) Get(userId string, category string, name string) (*model.Preference, *model.AppError) {
+func (s *TimerLayerSessionStore) Save(session *model.Session) (*model.Session, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PreferenceStore.Get(userId, category, name)
+	resultVar0, resultVar1 := s.SessionStore.Save(session)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4935,15 +7340,15 @@ func (s *TimerLayerPreferenceStore) Get(userId string, category string, name str
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.Save", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPreferenceStore) GetAll(userId string) (model.Preferences, *model.AppError) {
+func (s *TimerLayerSessionStore) UpdateDeviceId(id string, deviceId string, expiresAt int64) (string, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PreferenceStore.GetAll(userId)
+	resultVar0, resultVar1 := s.SessionStore.UpdateDeviceId(id, deviceId, expiresAt)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4951,15 +7356,191 @@ func (s *TimerLayerPreferenceStore) GetAll(userId string) (model.Preferences, *m
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.GetAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.UpdateDeviceId", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerPreferenceStore) GetCategory(userId string, category string) (model.Preferences, *model.AppError) {
+func (s *TimerLayerSessionStore) UpdateExpiredNotify(sessionid string, notified bool) error {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.PreferenceStore.GetCategory(userId, category)
+	resultVar0 := s.SessionStore.UpdateExpiredNotify(sessionid, notified)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.UpdateExpiredNotify", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerSessionStore) UpdateExpiresAt(sessionId string, time int64) error {
+	start := timemodule.Now()
+
+	resultVar0 := s.SessionStore.UpdateExpiresAt(sessionId, time)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.UpdateExpiresAt", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerSessionStore) UpdateLastActivityAt(sessionId string, time int64) error {
+	start := timemodule.Now()
+
+	resultVar0 := s.SessionStore.UpdateLastActivityAt(sessionId, time)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.UpdateLastActivityAt", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerSessionStore) UpdateProps(session *model.Session) error {
+	start := timemodule.Now()
+
+	resultVar0 := s.SessionStore.UpdateProps(session)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.UpdateProps", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerSessionStore) UpdateRoles(userId string, roles string) (string, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.SessionStore.UpdateRoles(userId, roles)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.UpdateRoles", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerSlugHistoryStore) Delete(kind string, scopeId string, name string) error {
+	start := timemodule.Now()
+
+	resultVar0 := s.SlugHistoryStore.Delete(kind, scopeId, name)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SlugHistoryStore.Delete", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerSlugHistoryStore) Get(kind string, scopeId string, name string) (*model.SlugHistory, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.SlugHistoryStore.Get(kind, scopeId, name)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SlugHistoryStore.Get", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerSlugHistoryStore) PermanentDeleteByTarget(targetId string) error {
+	start := timemodule.Now()
+
+	resultVar0 := s.SlugHistoryStore.PermanentDeleteByTarget(targetId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SlugHistoryStore.PermanentDeleteByTarget", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerSlugHistoryStore) Save(entry *model.SlugHistory) (*model.SlugHistory, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.SlugHistoryStore.Save(entry)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SlugHistoryStore.Save", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerStatusStore) Get(userId string) (*model.Status, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.StatusStore.Get(userId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("StatusStore.Get", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerStatusStore) GetByIds(userIds []string) ([]*model.Status, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.StatusStore.GetByIds(userIds)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("StatusStore.GetByIds", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerStatusStore) GetTotalActiveUsersCount() (int64, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.StatusStore.GetTotalActiveUsersCount()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4967,15 +7548,63 @@ func (s *TimerLayerPreferenceStore) GetCategory(userId string, category string)
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.GetCategory", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("StatusStore.GetTotalActiveUsersCount", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerStatusStore) ResetAll() *model.AppError {
+	start := timemodule.Now()
+
+	resultVar0 := s.StatusStore.ResetAll()
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("StatusStore.ResetAll", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerStatusStore) SaveOrUpdate(status *model.Status) *model.AppError {
+	start := timemodule.Now()
+
+	resultVar0 := s.StatusStore.SaveOrUpdate(status)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("StatusStore.SaveOrUpdate", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerStatusStore) UpdateLastActivityAt(userId string, lastActivityAt int64) *model.AppError {
+	start := timemodule.Now()
+
+	resultVar0 := s.StatusStore.UpdateLastActivityAt(userId, lastActivityAt)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("StatusStore.UpdateLastActivityAt", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerPreferenceStore) PermanentDeleteByUser(userId string) *model.AppError {
+func (s *TimerLayerStatusStore) UpdateLastActivityAts(lastActivityAts map[string]int64) *model.AppError {
 	start := timemodule.Now()
 
-	resultVar0 := s.PreferenceStore.PermanentDeleteByUser(userId)
+	resultVar0 := s.StatusStore.UpdateLastActivityAts(lastActivityAts)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4983,15 +7612,15 @@ func (s *TimerLayerPreferenceStore) PermanentDeleteByUser(userId string) *model.
 		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.PermanentDeleteByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("StatusStore.UpdateLastActivityAts", success, elapsed)
 	}
 	return resultVar0
 }
 
-func (s *TimerLayerPreferenceStore) Save(preferences *model.Preferences) *model.AppError {
+func (s *TimerLayerStatusAutomationStore) Delete(userId string) error {
 	start := timemodule.Now()
 
-	resultVar0 := s.PreferenceStore.Save(preferences)
+	resultVar0 := s.StatusAutomationStore.Delete(userId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4999,15 +7628,15 @@ func (s *TimerLayerPreferenceStore) Save(preferences *model.Preferences) *model.
 		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("StatusAutomationStore.Delete", success, elapsed)
 	}
 	return resultVar0
 }
 
-func (s *TimerLayerReactionStore) BulkGetForPosts(postIds []string) ([]*model.Reaction, error) {
+func (s *TimerLayerStatusAutomationStore) Get(userId string) (*model.StatusAutomation, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.ReactionStore.BulkGetForPosts(postIds)
+	resultVar0, resultVar1 := s.StatusAutomationStore.Get(userId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5015,15 +7644,15 @@ func (s *TimerLayerReactionStore) BulkGetForPosts(postIds []string) ([]*model.Re
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.BulkGetForPosts", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("StatusAutomationStore.Get", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerReactionStore) Delete(reaction *model.Reaction) (*model.Reaction, error) {
+func (s *TimerLayerStatusAutomationStore) GetEndedBefore(endTime int64, limit int) ([]*model.StatusAutomation, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.ReactionStore.Delete(reaction)
+	resultVar0, resultVar1 := s.StatusAutomationStore.GetEndedBefore(endTime, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5031,31 +7660,31 @@ func (s *TimerLayerReactionStore) Delete(reaction *model.Reaction) (*model.React
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("StatusAutomationStore.GetEndedBefore", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerReactionStore) DeleteAllWithEmojiName(emojiName string) error {
+func (s *TimerLayerStatusAutomationStore) Save(automation *model.StatusAutomation) (*model.StatusAutomation, error) {
 	start := timemodule.Now()
 
-	resultVar0 := s.ReactionStore.DeleteAllWithEmojiName(emojiName)
+	resultVar0, resultVar1 := s.StatusAutomationStore.Save(automation)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.DeleteAllWithEmojiName", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("StatusAutomationStore.Save", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerReactionStore) GetForPost(postId string, allowFromCache bool) ([]*model.Reaction, error) {
+func (s *TimerLayerStatusAutomationStore) Update(automation *model.StatusAutomation) (*model.StatusAutomation, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.ReactionStore.GetForPost(postId, allowFromCache)
+	resultVar0, resultVar1 := s.StatusAutomationStore.Update(automation)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5063,15 +7692,15 @@ func (s *TimerLayerReactionStore) GetForPost(postId string, allowFromCache bool)
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.GetForPost", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("StatusAutomationStore.Update", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerReactionStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+func (s *TimerLayerSystemStore) Get() (model.StringMap, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.ReactionStore.PermanentDeleteBatch(endTime, limit)
+	resultVar0, resultVar1 := s.SystemStore.Get()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5079,15 +7708,15 @@ func (s *TimerLayerReactionStore) PermanentDeleteBatch(endTime int64, limit int6
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.PermanentDeleteBatch", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SystemStore.Get", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerReactionStore) Save(reaction *model.Reaction) (*model.Reaction, error) {
+func (s *TimerLayerSystemStore) GetByName(name string) (*model.System, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.ReactionStore.Save(reaction)
+	resultVar0, resultVar1 := s.SystemStore.GetByName(name)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5095,15 +7724,15 @@ func (s *TimerLayerReactionStore) Save(reaction *model.Reaction) (*model.Reactio
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SystemStore.GetByName", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerRoleStore) AllChannelSchemeRoles() ([]*model.Role, *model.AppError) {
+func (s *TimerLayerSystemStore) InsertIfExists(system *model.System) (*model.System, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.RoleStore.AllChannelSchemeRoles()
+	resultVar0, resultVar1 := s.SystemStore.InsertIfExists(system)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5111,15 +7740,15 @@ func (s *TimerLayerRoleStore) AllChannelSchemeRoles() ([]*model.Role, *model.App
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.AllChannelSchemeRoles", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SystemStore.InsertIfExists", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerRoleStore) ChannelHigherScopedPermissions(roleNames []string) (map[string]*model.RolePermissions, *model.AppError) {
+func (s *TimerLayerSystemStore) IsDatabaseReadOnly() (bool, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.RoleStore.ChannelHigherScopedPermissions(roleNames)
+	resultVar0, resultVar1 := s.SystemStore.IsDatabaseReadOnly()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5127,15 +7756,15 @@ func (s *TimerLayerRoleStore) ChannelHigherScopedPermissions(roleNames []string)
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.ChannelHigherScopedPermissions", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SystemStore.IsDatabaseReadOnly", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerRoleStore) ChannelRolesUnderTeamRole(roleName string) ([]*model.Role, *model.AppError) {
+func (s *TimerLayerSystemStore) PermanentDeleteByName(name string) (*model.System, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.RoleStore.ChannelRolesUnderTeamRole(roleName)
+	resultVar0, resultVar1 := s.SystemStore.PermanentDeleteByName(name)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5143,63 +7772,63 @@ func (s *TimerLayerRoleStore) ChannelRolesUnderTeamRole(roleName string) ([]*mod
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.ChannelRolesUnderTeamRole", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SystemStore.PermanentDeleteByName", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerRoleStore) Delete(roleId string) (*model.Role, *model.AppError) {
+func (s *TimerLayerSystemStore) Save(system *model.System) *model.AppError {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.RoleStore.Delete(roleId)
+	resultVar0 := s.SystemStore.Save(system)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SystemStore.Save", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerRoleStore) Get(roleId string) (*model.Role, *model.AppError) {
+func (s *TimerLayerSystemStore) SaveOrUpdate(system *model.System) *model.AppError {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.RoleStore.Get(roleId)
+	resultVar0 := s.SystemStore.SaveOrUpdate(system)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SystemStore.SaveOrUpdate", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerRoleStore) GetAll() ([]*model.Role, *model.AppError) {
+func (s *TimerLayerSystemStore) Update(system *model.System) *model.AppError {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.RoleStore.GetAll()
+	resultVar0 := s.SystemStore.Update(system)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.GetAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SystemStore.Update", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerRoleStore) GetByName(name string) (*model.Role, *model.AppError) {
+func (s *TimerLayerTeamStore) AnalyticsGetTeamCountForScheme(ctx context.Context, schemeId string) (int64, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.RoleStore.GetByName(name)
+	resultVar0, resultVar1 := s.TeamStore.AnalyticsGetTeamCountForScheme(ctx, schemeId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5207,15 +7836,15 @@ func (s *TimerLayerRoleStore) GetByName(name string) (*model.Role, *model.AppErr
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.GetByName", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.AnalyticsGetTeamCountForScheme", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerRoleStore) GetByNames(names []string) ([]*model.Role, *model.AppError) {
+func (s *TimerLayerTeamStore) AnalyticsPrivateTeamCount(ctx context.Context) (int64, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.RoleStore.GetByNames(names)
+	resultVar0, resultVar1 := s.TeamStore.AnalyticsPrivateTeamCount(ctx)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5223,31 +7852,31 @@ func (s *TimerLayerRoleStore) GetByNames(names []string) ([]*model.Role, *model.
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.GetByNames", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.AnalyticsPrivateTeamCount", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerRoleStore) PermanentDeleteAll() *model.AppError {
+func (s *TimerLayerTeamStore) AnalyticsPublicTeamCount(ctx context.Context) (int64, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0 := s.RoleStore.PermanentDeleteAll()
+	resultVar0, resultVar1 := s.TeamStore.AnalyticsPublicTeamCount(ctx)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.PermanentDeleteAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.AnalyticsPublicTeamCount", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerRoleStore) Save(role *model.Role) (*model.Role, *model.AppError) {
+func (s *TimerLayerTeamStore) AnalyticsTeamCount(ctx context.Context, includeDeleted bool) (int64, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.RoleStore.Save(role)
+	resultVar0, resultVar1 := s.TeamStore.AnalyticsTeamCount(ctx, includeDeleted)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5255,47 +7884,46 @@ func (s *TimerLayerRoleStore) Save(role *model.Role) (*model.Role, *model.AppErr
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.AnalyticsTeamCount", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerSchemeStore) CountByScope(scope string) (int64, error) {
+func (s *TimerLayerTeamStore) ClearAllCustomRoleAssignments(ctx context.Context) *model.AppError {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.SchemeStore.CountByScope(scope)
+	resultVar0 := s.TeamStore.ClearAllCustomRoleAssignments(ctx)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.CountByScope", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.ClearAllCustomRoleAssignments", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerSchemeStore) CountWithoutPermission(scope string, permissionID string, roleScope model.RoleScope, roleType model.RoleType) (int64, error) {
+func (s *TimerLayerTeamStore) ClearCaches() {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.SchemeStore.CountWithoutPermission(scope, permissionID, roleScope, roleType)
+	s.TeamStore.ClearCaches()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if true {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.CountWithoutPermission", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.ClearCaches", success, elapsed)
 	}
-	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerSchemeStore) Delete(schemeId string) (*model.Scheme, error) {
+func (s *TimerLayerTeamStore) Get(ctx context.Context, id string) (*model.Team, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.SchemeStore.Delete(schemeId)
+	resultVar0, resultVar1 := s.TeamStore.Get(ctx, id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5303,15 +7931,15 @@ func (s *TimerLayerSchemeStore) Delete(schemeId string) (*model.Scheme, error) {
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.Get", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerSchemeStore) Get(schemeId string) (*model.Scheme, error) {
+func (s *TimerLayerTeamStore) GetActiveMemberCount(ctx context.Context, teamId string, restrictions *model.ViewUsersRestrictions) (int64, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.SchemeStore.Get(schemeId)
+	resultVar0, resultVar1 := s.TeamStore.GetActiveMemberCount(ctx, teamId, restrictions)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5319,15 +7947,15 @@ func (s *TimerLayerSchemeStore) Get(schemeId string) (*model.Scheme, error) {
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetActiveMemberCount", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerSchemeStore) GetAllPage(scope string, offset int, limit int) ([]*model.Scheme, error) {
+func (s *TimerLayerTeamStore) GetActiveMemberCounts(ctx context.Context, teamIds []string) (map[string]int64, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.SchemeStore.GetAllPage(scope, offset, limit)
+	resultVar0, resultVar1 := s.TeamStore.GetActiveMemberCounts(ctx, teamIds)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5335,15 +7963,15 @@ func (s *TimerLayerSchemeStore) GetAllPage(scope string, offset int, limit int)
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.GetAllPage", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetActiveMemberCounts", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerSchemeStore) GetByName(schemeName string) (*model.Scheme, error) {
+func (s *TimerLayerTeamStore) GetAll(ctx context.Context) ([]*model.Team, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.SchemeStore.GetByName(schemeName)
+	resultVar0, resultVar1 := s.TeamStore.GetAll(ctx)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5351,31 +7979,31 @@ func (s *TimerLayerSchemeStore) GetByName(schemeName string) (*model.Scheme, err
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.GetByName", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetAll", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerSchemeStore) PermanentDeleteAll() error {
+func (s *TimerLayerTeamStore) GetAllDeletedPage(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0 := s.SchemeStore.PermanentDeleteAll()
+	resultVar0, resultVar1 := s.TeamStore.GetAllDeletedPage(ctx, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.PermanentDeleteAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetAllDeletedPage", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerSchemeStore) Save(scheme *model.Scheme) (*model.Scheme, error) {
+func (s *TimerLayerTeamStore) GetAllForExportAfter(ctx context.Context, limit int, afterId string) ([]*model.TeamForExport, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.SchemeStore.Save(scheme)
+	resultVar0, resultVar1 := s.TeamStore.GetAllForExportAfter(ctx, limit, afterId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5383,15 +8011,15 @@ func (s *TimerLayerSchemeStore) Save(scheme *model.Scheme) (*model.Scheme, error
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetAllForExportAfter", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerSessionStore) AnalyticsSessionCount() (int64, error) {
+func (s *TimerLayerTeamStore) GetAllPage(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.SessionStore.AnalyticsSessionCount()
+	resultVar0, resultVar1 := s.TeamStore.GetAllPage(ctx, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5399,30 +8027,31 @@ func (s *TimerLayerSessionStore) AnalyticsSessionCount() (int64, error) {
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.AnalyticsSessionCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetAllPage", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerSessionStore) Cleanup(expiryTime int64, batchSize int64) {
+func (s *TimerLayerTeamStore) GetAllPrivateTeamListing(ctx context.Context) ([]*model.Team, *model.AppError) {
 	start := timemodule.Now()
 
-	s.SessionStore.Cleanup(expiryTime, batchSize)
+	resultVar0, resultVar1 := s.TeamStore.GetAllPrivateTeamListing(ctx)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.Cleanup", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetAllPrivateTeamListing", success, elapsed)
 	}
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerSessionStore) Get(sessionIdOrToken string) (*model.Session, error) {
+func (s *TimerLayerTeamStore) GetAllPrivateTeamPageListing(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.SessionStore.Get(sessionIdOrToken)
+	resultVar0, resultVar1 := s.TeamStore.GetAllPrivateTeamPageListing(ctx, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5430,15 +8059,15 @@ func (s *TimerLayerSessionStore) Get(sessionIdOrToken string) (*model.Session, e
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetAllPrivateTeamPageListing", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerSessionStore) GetSessions(userId string) ([]*model.Session, error) {
+func (s *TimerLayerTeamStore) GetAllPublicTeamPageListing(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.SessionStore.GetSessions(userId)
+	resultVar0, resultVar1 := s.TeamStore.GetAllPublicTeamPageListing(ctx, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5446,15 +8075,15 @@ func (s *TimerLayerSessionStore) GetSessions(userId string) ([]*model.Session, e
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.GetSessions", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetAllPublicTeamPageListing", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerSessionStore) GetSessionsExpired(thresholdMillis int64, mobileOnly bool, unnotifiedOnly bool) ([]*model.Session, error) {
+func (s *TimerLayerTeamStore) GetAllTeamListing(ctx context.Context) ([]*model.Team, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.SessionStore.GetSessionsExpired(thresholdMillis, mobileOnly, unnotifiedOnly)
+	resultVar0, resultVar1 := s.TeamStore.GetAllTeamListing(ctx)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5462,15 +8091,15 @@ func (s *TimerLayerSessionStore) GetSessionsExpired(thresholdMillis int64, mobil
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.GetSessionsExpired", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetAllTeamListing", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerSessionStore) GetSessionsWithActiveDeviceIds(userId string) ([]*model.Session, error) {
+func (s *TimerLayerTeamStore) GetAllTeamPageListing(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.SessionStore.GetSessionsWithActiveDeviceIds(userId)
+	resultVar0, resultVar1 := s.TeamStore.GetAllTeamPageListing(ctx, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5478,63 +8107,63 @@ func (s *TimerLayerSessionStore) GetSessionsWithActiveDeviceIds(userId string) (
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.GetSessionsWithActiveDeviceIds", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetAllTeamPageListing", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerSessionStore) PermanentDeleteSessionsByUser(teamId string) error {
+func (s *TimerLayerTeamStore) GetByAllowedDomain(ctx context.Context, domain string) ([]*model.Team, error) {
 	start := timemodule.Now()
 
-	resultVar0 := s.SessionStore.PermanentDeleteSessionsByUser(teamId)
+	resultVar0, resultVar1 := s.TeamStore.GetByAllowedDomain(ctx, domain)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.PermanentDeleteSessionsByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetByAllowedDomain", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerSessionStore) Remove(sessionIdOrToken string) error {
+func (s *TimerLayerTeamStore) GetByInviteId(ctx context.Context, inviteId string) (*model.Team, error) {
 	start := timemodule.Now()
 
-	resultVar0 := s.SessionStore.Remove(sessionIdOrToken)
+	resultVar0, resultVar1 := s.TeamStore.GetByInviteId(ctx, inviteId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.Remove", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetByInviteId", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerSessionStore) RemoveAllSessions() error {
+func (s *TimerLayerTeamStore) GetByName(ctx context.Context, name string) (*model.Team, error) {
 	start := timemodule.Now()
 
-	resultVar0 := s.SessionStore.RemoveAllSessions()
+	resultVar0, resultVar1 := s.TeamStore.GetByName(ctx, name)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.RemoveAllSessions", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetByName", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerSessionStore) Save(session *model.Session) (*model.Session, error) {
+func (s *TimerLayerTeamStore) GetByNames(ctx context.Context, name []string) ([]*model.Team, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.SessionStore.Save(session)
+	resultVar0, resultVar1 := s.TeamStore.GetByNames(ctx, name)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5542,15 +8171,15 @@ func (s *TimerLayerSessionStore) Save(session *model.Session) (*model.Session, e
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetByNames", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerSessionStore) UpdateDeviceId(id string, deviceId string, expiresAt int64) (string, error) {
+func (s *TimerLayerTeamStore) GetChannelUnreadsForAllTeams(ctx context.Context, excludeTeamId string, userId string) ([]*model.ChannelUnread, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.SessionStore.UpdateDeviceId(id, deviceId, expiresAt)
+	resultVar0, resultVar1 := s.TeamStore.GetChannelUnreadsForAllTeams(ctx, excludeTeamId, userId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5558,79 +8187,79 @@ func (s *TimerLayerSessionStore) UpdateDeviceId(id string, deviceId string, expi
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.UpdateDeviceId", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetChannelUnreadsForAllTeams", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerSessionStore) UpdateExpiredNotify(sessionid string, notified bool) error {
+func (s *TimerLayerTeamStore) GetChannelUnreadsForTeam(ctx context.Context, teamId string, userId string) ([]*model.ChannelUnread, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0 := s.SessionStore.UpdateExpiredNotify(sessionid, notified)
+	resultVar0, resultVar1 := s.TeamStore.GetChannelUnreadsForTeam(ctx, teamId, userId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.UpdateExpiredNotify", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetChannelUnreadsForTeam", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerSessionStore) UpdateExpiresAt(sessionId string, time int64) error {
+func (s *TimerLayerTeamStore) GetDirectoryStats(ctx context.Context, joinedSince int64) ([]*model.TeamDirectoryStats, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0 := s.SessionStore.UpdateExpiresAt(sessionId, time)
+	resultVar0, resultVar1 := s.TeamStore.GetDirectoryStats(ctx, joinedSince)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.UpdateExpiresAt", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetDirectoryStats", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerSessionStore) UpdateLastActivityAt(sessionId string, time int64) error {
+func (s *TimerLayerTeamStore) GetGroupSyncedTeamsPage(ctx context.Context, offset int, limit int) ([]*model.Team, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0 := s.SessionStore.UpdateLastActivityAt(sessionId, time)
+	resultVar0, resultVar1 := s.TeamStore.GetGroupSyncedTeamsPage(ctx, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.UpdateLastActivityAt", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetGroupSyncedTeamsPage", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerSessionStore) UpdateProps(session *model.Session) error {
+func (s *TimerLayerTeamStore) GetMember(ctx context.Context, teamId string, userId string, allowFromCache bool) (*model.TeamMember, error) {
 	start := timemodule.Now()
 
-	resultVar0 := s.SessionStore.UpdateProps(session)
+	resultVar0, resultVar1 := s.TeamStore.GetMember(ctx, teamId, userId, allowFromCache)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.UpdateProps", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetMember", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerSessionStore) UpdateRoles(userId string, roles string) (string, error) {
+func (s *TimerLayerTeamStore) GetMembers(ctx context.Context, teamId string, offset int, limit int, teamMembersGetOptions *model.TeamMembersGetOptions) ([]*model.TeamMember, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.SessionStore.UpdateRoles(userId, roles)
+	resultVar0, resultVar1 := s.TeamStore.GetMembers(ctx, teamId, offset, limit, teamMembersGetOptions)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5638,15 +8267,15 @@ func (s *TimerLayerSessionStore) UpdateRoles(userId string, roles string) (strin
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.UpdateRoles", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetMembers", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerStatusStore) Get(userId string) (*model.Status, *model.AppError) {
+func (s *TimerLayerTeamStore) GetMembersByIds(ctx context.Context, teamId string, userIds []string, restrictions *model.ViewUsersRestrictions) ([]*model.TeamMember, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.StatusStore.Get(userId)
+	resultVar0, resultVar1 := s.TeamStore.GetMembersByIds(ctx, teamId, userIds, restrictions)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5654,15 +8283,15 @@ func (s *TimerLayerStatusStore) Get(userId string) (*model.Status, *model.AppErr
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("StatusStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetMembersByIds", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerStatusStore) GetByIds(userIds []string) ([]*model.Status, *model.AppError) {
+func (s *TimerLayerTeamStore) GetMembersByTeamIds(ctx context.Context, teamIds []string, userId string) ([]*model.TeamMember, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.StatusStore.GetByIds(userIds)
+	resultVar0, resultVar1 := s.TeamStore.GetMembersByTeamIds(ctx, teamIds, userId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5670,15 +8299,15 @@ func (s *TimerLayerStatusStore) GetByIds(userIds []string) ([]*model.Status, *mo
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("StatusStore.GetByIds", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetMembersByTeamIds", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerStatusStore) GetTotalActiveUsersCount() (int64, *model.AppError) {
+func (s *TimerLayerTeamStore) GetMembersWithExpiredRoles(ctx context.Context, expiredBefore int64, limit int) ([]*model.TeamMember, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.StatusStore.GetTotalActiveUsersCount()
+	resultVar0, resultVar1 := s.TeamStore.GetMembersWithExpiredRoles(ctx, expiredBefore, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5686,63 +8315,63 @@ func (s *TimerLayerStatusStore) GetTotalActiveUsersCount() (int64, *model.AppErr
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("StatusStore.GetTotalActiveUsersCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetMembersWithExpiredRoles", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerStatusStore) ResetAll() *model.AppError {
+func (s *TimerLayerTeamStore) GetNewTeamMembersSince(ctx context.Context, teamId string, since int64, offset int, limit int) (*model.NewTeamMembersList, error) {
 	start := timemodule.Now()
 
-	resultVar0 := s.StatusStore.ResetAll()
+	resultVar0, resultVar1 := s.TeamStore.GetNewTeamMembersSince(ctx, teamId, since, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("StatusStore.ResetAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetNewTeamMembersSince", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerStatusStore) SaveOrUpdate(status *model.Status) *model.AppError {
+func (s *TimerLayerTeamStore) GetPolicyForTeam(ctx context.Context, teamId string) (*model.RetentionPolicy, error) {
 	start := timemodule.Now()
 
-	resultVar0 := s.StatusStore.SaveOrUpdate(status)
+	resultVar0, resultVar1 := s.TeamStore.GetPolicyForTeam(ctx, teamId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("StatusStore.SaveOrUpdate", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetPolicyForTeam", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerStatusStore) UpdateLastActivityAt(userId string, lastActivityAt int64) *model.AppError {
+func (s *TimerLayerTeamStore) GetTeamMembersForExport(ctx context.Context, userId string) ([]*model.TeamMemberForExport, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0 := s.StatusStore.UpdateLastActivityAt(userId, lastActivityAt)
+	resultVar0, resultVar1 := s.TeamStore.GetTeamMembersForExport(ctx, userId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("StatusStore.UpdateLastActivityAt", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetTeamMembersForExport", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerSystemStore) Get() (model.StringMap, *model.AppError) {
+func (s *TimerLayerTeamStore) GetTeamsByScheme(ctx context.Context, schemeId string, offset int, limit int) ([]*model.Team, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.SystemStore.Get()
+	resultVar0, resultVar1 := s.TeamStore.GetTeamsByScheme(ctx, schemeId, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5750,15 +8379,15 @@ func (s *TimerLayerSystemStore) Get() (model.StringMap, *model.AppError) {
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SystemStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetTeamsByScheme", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerSystemStore) GetByName(name string) (*model.System, *model.AppError) {
+func (s *TimerLayerTeamStore) GetTeamsByUserId(ctx context.Context, userId string, opts *model.TeamsForUserGetOptions) ([]*model.Team, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.SystemStore.GetByName(name)
+	resultVar0, resultVar1 := s.TeamStore.GetTeamsByUserId(ctx, userId, opts)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5766,15 +8395,15 @@ func (s *TimerLayerSystemStore) GetByName(name string) (*model.System, *model.Ap
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SystemStore.GetByName", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetTeamsByUserId", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerSystemStore) InsertIfExists(system *model.System) (*model.System, *model.AppError) {
+func (s *TimerLayerTeamStore) GetTeamsForPolicyPage(ctx context.Context, policyId string, offset int, limit int) ([]*model.Team, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.SystemStore.InsertIfExists(system)
+	resultVar0, resultVar1 := s.TeamStore.GetTeamsForPolicyPage(ctx, policyId, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5782,15 +8411,15 @@ func (s *TimerLayerSystemStore) InsertIfExists(system *model.System) (*model.Sys
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SystemStore.InsertIfExists", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetTeamsForPolicyPage", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerSystemStore) PermanentDeleteByName(name string) (*model.System, *model.AppError) {
+func (s *TimerLayerTeamStore) GetTeamsForUser(ctx context.Context, userId string) ([]*model.TeamMember, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.SystemStore.PermanentDeleteByName(name)
+	resultVar0, resultVar1 := s.TeamStore.GetTeamsForUser(ctx, userId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5798,63 +8427,63 @@ func (s *TimerLayerSystemStore) PermanentDeleteByName(name string) (*model.Syste
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SystemStore.PermanentDeleteByName", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetTeamsForUser", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerSystemStore) Save(system *model.System) *model.AppError {
+func (s *TimerLayerTeamStore) GetTeamsForUserWithPagination(ctx context.Context, userId string, page int, perPage int, opts *model.TeamMembersForUserGetOptions) ([]*model.TeamMember, error) {
 	start := timemodule.Now()
 
-	resultVar0 := s.SystemStore.Save(system)
+	resultVar0, resultVar1 := s.TeamStore.GetTeamsForUserWithPagination(ctx, userId, page, perPage, opts)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SystemStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetTeamsForUserWithPagination", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerSystemStore) SaveOrUpdate(system *model.System) *model.AppError {
+func (s *TimerLayerTeamStore) GetTeamsWithNoActiveMembers(ctx context.Context, offset int, limit int) ([]*model.Team, error) {
 	start := timemodule.Now()
 
-	resultVar0 := s.SystemStore.SaveOrUpdate(system)
+	resultVar0, resultVar1 := s.TeamStore.GetTeamsWithNoActiveMembers(ctx, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SystemStore.SaveOrUpdate", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetTeamsWithNoActiveMembers", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerSystemStore) Update(system *model.System) *model.AppError {
+func (s *TimerLayerTeamStore) GetTotalMemberCount(ctx context.Context, teamId string, restrictions *model.ViewUsersRestrictions) (int64, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0 := s.SystemStore.Update(system)
+	resultVar0, resultVar1 := s.TeamStore.GetTotalMemberCount(ctx, teamId, restrictions)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SystemStore.Update", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetTotalMemberCount", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) AnalyticsGetTeamCountForScheme(schemeId string) (int64, *model.AppError) {
+func (s *TimerLayerTeamStore) GetUnreadsForAllTeams(ctx context.Context, excludeTeamId string, userId string) ([]*model.TeamUnread, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.AnalyticsGetTeamCountForScheme(schemeId)
+	resultVar0, resultVar1 := s.TeamStore.GetUnreadsForAllTeams(ctx, excludeTeamId, userId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5862,15 +8491,15 @@ func (s *TimerLayerTeamStore) AnalyticsGetTeamCountForScheme(schemeId string) (i
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.AnalyticsGetTeamCountForScheme", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetUnreadsForAllTeams", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) AnalyticsPrivateTeamCount() (int64, *model.AppError) {
+func (s *TimerLayerTeamStore) GetUserTeamIds(ctx context.Context, userId string, allowFromCache bool) ([]string, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.AnalyticsPrivateTeamCount()
+	resultVar0, resultVar1 := s.TeamStore.GetUserTeamIds(ctx, userId, allowFromCache)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5878,15 +8507,15 @@ func (s *TimerLayerTeamStore) AnalyticsPrivateTeamCount() (int64, *model.AppErro
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.AnalyticsPrivateTeamCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetUserTeamIds", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) AnalyticsPublicTeamCount() (int64, *model.AppError) {
+func (s *TimerLayerTeamStore) GroupSyncedTeamCount(ctx context.Context) (int64, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.AnalyticsPublicTeamCount()
+	resultVar0, resultVar1 := s.TeamStore.GroupSyncedTeamCount(ctx)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5894,15 +8523,15 @@ func (s *TimerLayerTeamStore) AnalyticsPublicTeamCount() (int64, *model.AppError
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.AnalyticsPublicTeamCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GroupSyncedTeamCount", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) AnalyticsTeamCount(includeDeleted bool) (int64, *model.AppError) {
+func (s *TimerLayerTeamStore) GroupSyncedTeamCountByGroup(ctx context.Context, groupId string) (int64, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.AnalyticsTeamCount(includeDeleted)
+	resultVar0, resultVar1 := s.TeamStore.GroupSyncedTeamCountByGroup(ctx, groupId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5910,62 +8539,62 @@ func (s *TimerLayerTeamStore) AnalyticsTeamCount(includeDeleted bool) (int64, *m
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.AnalyticsTeamCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GroupSyncedTeamCountByGroup", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) ClearAllCustomRoleAssignments() *model.AppError {
+func (s *TimerLayerTeamStore) InvalidateAllTeamIdsForUser(userId string) {
 	start := timemodule.Now()
 
-	resultVar0 := s.TeamStore.ClearAllCustomRoleAssignments()
+	s.TeamStore.InvalidateAllTeamIdsForUser(userId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if true {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.ClearAllCustomRoleAssignments", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.InvalidateAllTeamIdsForUser", success, elapsed)
 	}
-	return resultVar0
 }
 
-func (s *TimerLayerTeamStore) ClearCaches() {
+func (s *TimerLayerTeamStore) MigrateTeamMembers(ctx context.Context, fromTeamId string, fromUserId string, batchSize int) (map[string]string, int64, *model.AppError) {
 	start := timemodule.Now()
 
-	s.TeamStore.ClearCaches()
+	resultVar0, resultVar1, resultVar2 := s.TeamStore.MigrateTeamMembers(ctx, fromTeamId, fromUserId, batchSize)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if resultVar2 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.ClearCaches", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.MigrateTeamMembers", success, elapsed)
 	}
+	return resultVar0, resultVar1, resultVar2
 }
 
-func (s *TimerLayerTeamStore) Get(id string) (*model.Team, *model.AppError) {
+func (s *TimerLayerTeamStore) PermanentDelete(ctx context.Context, teamId string) *model.AppError {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.Get(id)
+	resultVar0 := s.TeamStore.PermanentDelete(ctx, teamId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.PermanentDelete", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerTeamStore) GetActiveMemberCount(teamId string, restrictions *model.ViewUsersRestrictions) (int64, *model.AppError) {
+func (s *TimerLayerTeamStore) RegenerateInviteId(ctx context.Context, teamId string, inviteExpiresAt int64) (*model.Team, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.GetActiveMemberCount(teamId, restrictions)
+	resultVar0, resultVar1 := s.TeamStore.RegenerateInviteId(ctx, teamId, inviteExpiresAt)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5973,31 +8602,31 @@ func (s *TimerLayerTeamStore) GetActiveMemberCount(teamId string, restrictions *
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetActiveMemberCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.RegenerateInviteId", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) GetAll() ([]*model.Team, *model.AppError) {
+func (s *TimerLayerTeamStore) RemoveAllMembersByTeam(ctx context.Context, teamId string) *model.AppError {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.GetAll()
+	resultVar0 := s.TeamStore.RemoveAllMembersByTeam(ctx, teamId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.RemoveAllMembersByTeam", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerTeamStore) GetAllForExportAfter(limit int, afterId string) ([]*model.TeamForExport, *model.AppError) {
+func (s *TimerLayerTeamStore) RemoveAllMembersByUser(ctx context.Context, userId string) ([]string, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.GetAllForExportAfter(limit, afterId)
+	resultVar0, resultVar1 := s.TeamStore.RemoveAllMembersByUser(ctx, userId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6005,79 +8634,79 @@ func (s *TimerLayerTeamStore) GetAllForExportAfter(limit int, afterId string) ([
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetAllForExportAfter", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.RemoveAllMembersByUser", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) GetAllPage(offset int, limit int) ([]*model.Team, *model.AppError) {
+func (s *TimerLayerTeamStore) RemoveMember(ctx context.Context, teamId string, userId string) *model.AppError {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.GetAllPage(offset, limit)
+	resultVar0 := s.TeamStore.RemoveMember(ctx, teamId, userId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetAllPage", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.RemoveMember", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerTeamStore) GetAllPrivateTeamListing() ([]*model.Team, *model.AppError) {
+func (s *TimerLayerTeamStore) RemoveMembers(ctx context.Context, teamId string, userIds []string) *model.AppError {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.GetAllPrivateTeamListing()
+	resultVar0 := s.TeamStore.RemoveMembers(ctx, teamId, userIds)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetAllPrivateTeamListing", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.RemoveMembers", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerTeamStore) GetAllPrivateTeamPageListing(offset int, limit int) ([]*model.Team, *model.AppError) {
+func (s *TimerLayerTeamStore) ResetAllTeamSchemes(ctx context.Context) *model.AppError {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.GetAllPrivateTeamPageListing(offset, limit)
+	resultVar0 := s.TeamStore.ResetAllTeamSchemes(ctx)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetAllPrivateTeamPageListing", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.ResetAllTeamSchemes", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerTeamStore) GetAllPublicTeamPageListing(offset int, limit int) ([]*model.Team, *model.AppError) {
+func (s *TimerLayerTeamStore) Restore(ctx context.Context, teamId string) error {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.GetAllPublicTeamPageListing(offset, limit)
+	resultVar0 := s.TeamStore.Restore(ctx, teamId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetAllPublicTeamPageListing", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.Restore", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerTeamStore) GetAllTeamListing() ([]*model.Team, *model.AppError) {
+func (s *TimerLayerTeamStore) Save(ctx context.Context, team *model.Team) (*model.Team, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.GetAllTeamListing()
+	resultVar0, resultVar1 := s.TeamStore.Save(ctx, team)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6085,15 +8714,15 @@ func (s *TimerLayerTeamStore) GetAllTeamListing() ([]*model.Team, *model.AppErro
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetAllTeamListing", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.Save", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) GetAllTeamPageListing(offset int, limit int) ([]*model.Team, *model.AppError) {
+func (s *TimerLayerTeamStore) SaveMember(ctx context.Context, member *model.TeamMember, maxUsersPerTeam int) (*model.TeamMember, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.GetAllTeamPageListing(offset, limit)
+	resultVar0, resultVar1 := s.TeamStore.SaveMember(ctx, member, maxUsersPerTeam)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6101,31 +8730,31 @@ func (s *TimerLayerTeamStore) GetAllTeamPageListing(offset int, limit int) ([]*m
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetAllTeamPageListing", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SaveMember", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) GetByInviteId(inviteId string) (*model.Team, *model.AppError) {
+func (s *TimerLayerTeamStore) SaveMultiple(ctx context.Context, teams []*model.Team) ([]*model.Team, []error, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.GetByInviteId(inviteId)
+	resultVar0, resultVar1, resultVar2 := s.TeamStore.SaveMultiple(ctx, teams)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar2 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetByInviteId", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SaveMultiple", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0, resultVar1, resultVar2
 }
 
-func (s *TimerLayerTeamStore) GetByName(name string) (*model.Team, *model.AppError) {
+func (s *TimerLayerTeamStore) SaveMultipleMembers(ctx context.Context, members []*model.TeamMember, maxUsersPerTeam int) ([]*model.TeamMember, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.GetByName(name)
+	resultVar0, resultVar1 := s.TeamStore.SaveMultipleMembers(ctx, members, maxUsersPerTeam)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6133,15 +8762,15 @@ func (s *TimerLayerTeamStore) GetByName(name string) (*model.Team, *model.AppErr
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetByName", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SaveMultipleMembers", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) GetByNames(name []string) ([]*model.Team, *model.AppError) {
+func (s *TimerLayerTeamStore) SearchAll(ctx context.Context, term string, opts *model.TeamSearchOpts) ([]*model.Team, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.GetByNames(name)
+	resultVar0, resultVar1 := s.TeamStore.SearchAll(ctx, term, opts)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6149,31 +8778,31 @@ func (s *TimerLayerTeamStore) GetByNames(name []string) ([]*model.Team, *model.A
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetByNames", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SearchAll", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) GetChannelUnreadsForAllTeams(excludeTeamId string, userId string) ([]*model.ChannelUnread, *model.AppError) {
+func (s *TimerLayerTeamStore) SearchAllPaged(ctx context.Context, term string, opts *model.TeamSearchOpts, page int, perPage int) ([]*model.Team, int64, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.GetChannelUnreadsForAllTeams(excludeTeamId, userId)
+	resultVar0, resultVar1, resultVar2 := s.TeamStore.SearchAllPaged(ctx, term, opts, page, perPage)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar2 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetChannelUnreadsForAllTeams", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SearchAllPaged", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0, resultVar1, resultVar2
 }
 
-func (s *TimerLayerTeamStore) GetChannelUnreadsForTeam(teamId string, userId string) ([]*model.ChannelUnread, *model.AppError) {
+func (s *TimerLayerTeamStore) SearchOpen(ctx context.Context, term string, opts *model.TeamSearchOpts) ([]*model.Team, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.GetChannelUnreadsForTeam(teamId, userId)
+	resultVar0, resultVar1 := s.TeamStore.SearchOpen(ctx, term, opts)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6181,15 +8810,15 @@ func (s *TimerLayerTeamStore) GetChannelUnreadsForTeam(teamId string, userId str
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetChannelUnreadsForTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SearchOpen", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) GetMember(teamId string, userId string) (*model.TeamMember, *model.AppError) {
+func (s *TimerLayerTeamStore) SearchPrivate(ctx context.Context, term string, opts *model.TeamSearchOpts) ([]*model.Team, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.GetMember(teamId, userId)
+	resultVar0, resultVar1 := s.TeamStore.SearchPrivate(ctx, term, opts)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6197,15 +8826,15 @@ func (s *TimerLayerTeamStore) GetMember(teamId string, userId string) (*model.Te
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetMember", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SearchPrivate", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) GetMembers(teamId string, offset int, limit int, teamMembersGetOptions *model.TeamMembersGetOptions) ([]*model.TeamMember, *model.AppError) {
+func (s *TimerLayerTeamStore) SearchPrivateForUser(ctx context.Context, userId string, term string, opts *model.TeamSearchOpts) ([]*model.Team, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.GetMembers(teamId, offset, limit, teamMembersGetOptions)
+	resultVar0, resultVar1 := s.TeamStore.SearchPrivateForUser(ctx, userId, term, opts)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6213,31 +8842,31 @@ func (s *TimerLayerTeamStore) GetMembers(teamId string, offset int, limit int, t
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SearchPrivateForUser", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) GetMembersByIds(teamId string, userIds []string, restrictions *model.ViewUsersRestrictions) ([]*model.TeamMember, *model.AppError) {
+func (s *TimerLayerTeamStore) SetPolicy(ctx context.Context, teamId string, policyId string) error {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.GetMembersByIds(teamId, userIds, restrictions)
+	resultVar0 := s.TeamStore.SetPolicy(ctx, teamId, policyId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetMembersByIds", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SetPolicy", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerTeamStore) GetTeamMembersForExport(userId string) ([]*model.TeamMemberForExport, *model.AppError) {
+func (s *TimerLayerTeamStore) Update(ctx context.Context, team *model.Team) (*model.Team, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.GetTeamMembersForExport(userId)
+	resultVar0, resultVar1 := s.TeamStore.Update(ctx, team)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6245,31 +8874,31 @@ func (s *TimerLayerTeamStore) GetTeamMembersForExport(userId string) ([]*model.T
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetTeamMembersForExport", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.Update", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) GetTeamsByScheme(schemeId string, offset int, limit int) ([]*model.Team, *model.AppError) {
+func (s *TimerLayerTeamStore) UpdateLastTeamIconUpdate(ctx context.Context, teamId string, curTime int64) *model.AppError {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.GetTeamsByScheme(schemeId, offset, limit)
+	resultVar0 := s.TeamStore.UpdateLastTeamIconUpdate(ctx, teamId, curTime)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetTeamsByScheme", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UpdateLastTeamIconUpdate", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerTeamStore) GetTeamsByUserId(userId string) ([]*model.Team, *model.AppError) {
+func (s *TimerLayerTeamStore) UpdateMember(ctx context.Context, member *model.TeamMember) (*model.TeamMember, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.GetTeamsByUserId(userId)
+	resultVar0, resultVar1 := s.TeamStore.UpdateMember(ctx, member)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6277,31 +8906,31 @@ func (s *TimerLayerTeamStore) GetTeamsByUserId(userId string) ([]*model.Team, *m
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetTeamsByUserId", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UpdateMember", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) GetTeamsForUser(userId string) ([]*model.TeamMember, *model.AppError) {
+func (s *TimerLayerTeamStore) UpdateMembersRole(ctx context.Context, teamID string, userIDs []string) *model.AppError {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.GetTeamsForUser(userId)
+	resultVar0 := s.TeamStore.UpdateMembersRole(ctx, teamID, userIDs)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetTeamsForUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UpdateMembersRole", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerTeamStore) GetTeamsForUserWithPagination(userId string, page int, perPage int) ([]*model.TeamMember, *model.AppError) {
+func (s *TimerLayerTeamStore) UpdateMembersRoleAndGetUpdated(ctx context.Context, teamID string, userIDs []string) ([]*model.TeamMember, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.GetTeamsForUserWithPagination(userId, page, perPage)
+	resultVar0, resultVar1 := s.TeamStore.UpdateMembersRoleAndGetUpdated(ctx, teamID, userIDs)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6309,15 +8938,15 @@ func (s *TimerLayerTeamStore) GetTeamsForUserWithPagination(userId string, page
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetTeamsForUserWithPagination", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UpdateMembersRoleAndGetUpdated", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) GetTotalMemberCount(teamId string, restrictions *model.ViewUsersRestrictions) (int64, *model.AppError) {
+func (s *TimerLayerTeamStore) UpdateMultipleMembers(ctx context.Context, members []*model.TeamMember) ([]*model.TeamMember, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.GetTotalMemberCount(teamId, restrictions)
+	resultVar0, resultVar1 := s.TeamStore.UpdateMultipleMembers(ctx, members)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6325,15 +8954,15 @@ func (s *TimerLayerTeamStore) GetTotalMemberCount(teamId string, restrictions *m
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetTotalMemberCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UpdateMultipleMembers", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) GetUserTeamIds(userId string, allowFromCache bool) ([]string, *model.AppError) {
+func (s *TimerLayerTeamStore) UserBelongsToTeam(ctx context.Context, userId string, teamId string, allowFromCache bool) (bool, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.GetUserTeamIds(userId, allowFromCache)
+	resultVar0, resultVar1 := s.TeamStore.UserBelongsToTeam(ctx, userId, teamId, allowFromCache)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6341,15 +8970,15 @@ func (s *TimerLayerTeamStore) GetUserTeamIds(userId string, allowFromCache bool)
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetUserTeamIds", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UserBelongsToTeam", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) GroupSyncedTeamCount() (int64, *model.AppError) {
+func (s *TimerLayerTeamStore) UserBelongsToTeams(ctx context.Context, userId string, teamIds []string) (bool, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.GroupSyncedTeamCount()
+	resultVar0, resultVar1 := s.TeamStore.UserBelongsToTeams(ctx, userId, teamIds)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6357,30 +8986,31 @@ func (s *TimerLayerTeamStore) GroupSyncedTeamCount() (int64, *model.AppError) {
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GroupSyncedTeamCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UserBelongsToTeams", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) InvalidateAllTeamIdsForUser(userId string) {
+func (s *TimerLayerTeamInviteLinkStore) CountForTeam(teamId string) (int64, error) {
 	start := timemodule.Now()
 
-	s.TeamStore.InvalidateAllTeamIdsForUser(userId)
+	resultVar0, resultVar1 := s.TeamInviteLinkStore.CountForTeam(teamId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.InvalidateAllTeamIdsForUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamInviteLinkStore.CountForTeam", success, elapsed)
 	}
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) MigrateTeamMembers(fromTeamId string, fromUserId string) (map[string]string, *model.AppError) {
+func (s *TimerLayerTeamInviteLinkStore) Get(id string) (*model.TeamInviteLink, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.MigrateTeamMembers(fromTeamId, fromUserId)
+	resultVar0, resultVar1 := s.TeamInviteLinkStore.Get(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6388,47 +9018,47 @@ func (s *TimerLayerTeamStore) MigrateTeamMembers(fromTeamId string, fromUserId s
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.MigrateTeamMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamInviteLinkStore.Get", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) PermanentDelete(teamId string) *model.AppError {
+func (s *TimerLayerTeamInviteLinkStore) GetByName(name string) (*model.TeamInviteLink, error) {
 	start := timemodule.Now()
 
-	resultVar0 := s.TeamStore.PermanentDelete(teamId)
+	resultVar0, resultVar1 := s.TeamInviteLinkStore.GetByName(name)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.PermanentDelete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamInviteLinkStore.GetByName", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) RemoveAllMembersByTeam(teamId string) *model.AppError {
+func (s *TimerLayerTeamInviteLinkStore) GetForTeam(teamId string) ([]*model.TeamInviteLink, error) {
 	start := timemodule.Now()
 
-	resultVar0 := s.TeamStore.RemoveAllMembersByTeam(teamId)
+	resultVar0, resultVar1 := s.TeamInviteLinkStore.GetForTeam(teamId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.RemoveAllMembersByTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamInviteLinkStore.GetForTeam", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) RemoveAllMembersByUser(userId string) *model.AppError {
+func (s *TimerLayerTeamInviteLinkStore) IncrementUseCount(id string, now int64) error {
 	start := timemodule.Now()
 
-	resultVar0 := s.TeamStore.RemoveAllMembersByUser(userId)
+	resultVar0 := s.TeamInviteLinkStore.IncrementUseCount(id, now)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6436,15 +9066,15 @@ func (s *TimerLayerTeamStore) RemoveAllMembersByUser(userId string) *model.AppEr
 		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.RemoveAllMembersByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamInviteLinkStore.IncrementUseCount", success, elapsed)
 	}
 	return resultVar0
 }
 
-func (s *TimerLayerTeamStore) RemoveMember(teamId string, userId string) *model.AppError {
+func (s *TimerLayerTeamInviteLinkStore) PermanentDeleteByTeam(teamId string) error {
 	start := timemodule.Now()
 
-	resultVar0 := s.TeamStore.RemoveMember(teamId, userId)
+	resultVar0 := s.TeamInviteLinkStore.PermanentDeleteByTeam(teamId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6452,15 +9082,15 @@ func (s *TimerLayerTeamStore) RemoveMember(teamId string, userId string) *model.
 		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.RemoveMember", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamInviteLinkStore.PermanentDeleteByTeam", success, elapsed)
 	}
 	return resultVar0
 }
 
-func (s *TimerLayerTeamStore) RemoveMembers(teamId string, userIds []string) *model.AppError {
+func (s *TimerLayerTeamInviteLinkStore) Revoke(id string, deleteAt int64) error {
 	start := timemodule.Now()
 
-	resultVar0 := s.TeamStore.RemoveMembers(teamId, userIds)
+	resultVar0 := s.TeamInviteLinkStore.Revoke(id, deleteAt)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6468,31 +9098,31 @@ func (s *TimerLayerTeamStore) RemoveMembers(teamId string, userIds []string) *mo
 		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.RemoveMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamInviteLinkStore.Revoke", success, elapsed)
 	}
 	return resultVar0
 }
 
-func (s *TimerLayerTeamStore) ResetAllTeamSchemes() *model.AppError {
+func (s *TimerLayerTeamInviteLinkStore) Save(link *model.TeamInviteLink) (*model.TeamInviteLink, error) {
 	start := timemodule.Now()
 
-	resultVar0 := s.TeamStore.ResetAllTeamSchemes()
+	resultVar0, resultVar1 := s.TeamInviteLinkStore.Save(link)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.ResetAllTeamSchemes", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamInviteLinkStore.Save", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) Save(team *model.Team) (*model.Team, *model.AppError) {
+func (s *TimerLayerTeamMemberHistoryStore) GetUsersInTeamDuring(startTime int64, endTime int64, teamId string) ([]*model.TeamMemberHistoryResult, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.Save(team)
+	resultVar0, resultVar1 := s.TeamMemberHistoryStore.GetUsersInTeamDuring(startTime, endTime, teamId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6500,15 +9130,15 @@ func (s *TimerLayerTeamStore) Save(team *model.Team) (*model.Team, *model.AppErr
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamMemberHistoryStore.GetUsersInTeamDuring", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) SaveMember(member *model.TeamMember, maxUsersPerTeam int) (*model.TeamMember, *model.AppError) {
+func (s *TimerLayerTeamMemberHistoryStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.SaveMember(member, maxUsersPerTeam)
+	resultVar0, resultVar1 := s.TeamMemberHistoryStore.PermanentDeleteBatch(endTime, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6516,15 +9146,15 @@ func (s *TimerLayerTeamStore) SaveMember(member *model.TeamMember, maxUsersPerTe
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SaveMember", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamMemberHistoryStore.PermanentDeleteBatch", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) SaveMultipleMembers(members []*model.TeamMember, maxUsersPerTeam int) ([]*model.TeamMember, *model.AppError) {
+func (s *TimerLayerTermsOfServiceStore) Get(id string, allowFromCache bool) (*model.TermsOfService, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.SaveMultipleMembers(members, maxUsersPerTeam)
+	resultVar0, resultVar1 := s.TermsOfServiceStore.Get(id, allowFromCache)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6532,15 +9162,15 @@ func (s *TimerLayerTeamStore) SaveMultipleMembers(members []*model.TeamMember, m
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SaveMultipleMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TermsOfServiceStore.Get", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) SearchAll(term string) ([]*model.Team, *model.AppError) {
+func (s *TimerLayerTermsOfServiceStore) GetLatest(allowFromCache bool) (*model.TermsOfService, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.SearchAll(term)
+	resultVar0, resultVar1 := s.TermsOfServiceStore.GetLatest(allowFromCache)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6548,47 +9178,47 @@ func (s *TimerLayerTeamStore) SearchAll(term string) ([]*model.Team, *model.AppE
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SearchAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TermsOfServiceStore.GetLatest", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) SearchAllPaged(term string, page int, perPage int) ([]*model.Team, int64, *model.AppError) {
+func (s *TimerLayerTermsOfServiceStore) Save(termsOfService *model.TermsOfService) (*model.TermsOfService, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1, resultVar2 := s.TeamStore.SearchAllPaged(term, page, perPage)
+	resultVar0, resultVar1 := s.TermsOfServiceStore.Save(termsOfService)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar2 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SearchAllPaged", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TermsOfServiceStore.Save", success, elapsed)
 	}
-	return resultVar0, resultVar1, resultVar2
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) SearchOpen(term string) ([]*model.Team, *model.AppError) {
+func (s *TimerLayerTermsOfServicePolicyStore) Delete(id string, deleteAt int64) error {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.SearchOpen(term)
+	resultVar0 := s.TermsOfServicePolicyStore.Delete(id, deleteAt)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SearchOpen", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TermsOfServicePolicyStore.Delete", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerTeamStore) SearchPrivate(term string) ([]*model.Team, *model.AppError) {
+func (s *TimerLayerTermsOfServicePolicyStore) Get(id string) (*model.TermsOfServicePolicy, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.SearchPrivate(term)
+	resultVar0, resultVar1 := s.TermsOfServicePolicyStore.Get(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6596,47 +9226,47 @@ func (s *TimerLayerTeamStore) SearchPrivate(term string) ([]*model.Team, *model.
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SearchPrivate", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TermsOfServicePolicyStore.Get", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) Update(team *model.Team) (*model.Team, *model.AppError) {
+func (s *TimerLayerTermsOfServicePolicyStore) GetAcceptanceCounts(version *model.TermsOfServicePolicyVersion) (int64, int64, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.Update(team)
+	resultVar0, resultVar1, resultVar2 := s.TermsOfServicePolicyStore.GetAcceptanceCounts(version)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar2 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.Update", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TermsOfServicePolicyStore.GetAcceptanceCounts", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0, resultVar1, resultVar2
 }
 
-func (s *TimerLayerTeamStore) UpdateLastTeamIconUpdate(teamId string, curTime int64) *model.AppError {
+func (s *TimerLayerTermsOfServicePolicyStore) GetAll(offset int, limit int) ([]*model.TermsOfServicePolicy, error) {
 	start := timemodule.Now()
 
-	resultVar0 := s.TeamStore.UpdateLastTeamIconUpdate(teamId, curTime)
+	resultVar0, resultVar1 := s.TermsOfServicePolicyStore.GetAll(offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UpdateLastTeamIconUpdate", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TermsOfServicePolicyStore.GetAll", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) UpdateMember(member *model.TeamMember) (*model.TeamMember, *model.AppError) {
+func (s *TimerLayerTermsOfServicePolicyStore) GetLatestVersion(policyId string) (*model.TermsOfServicePolicyVersion, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.UpdateMember(member)
+	resultVar0, resultVar1 := s.TermsOfServicePolicyStore.GetLatestVersion(policyId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6644,31 +9274,31 @@ func (s *TimerLayerTeamStore) UpdateMember(member *model.TeamMember) (*model.Tea
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UpdateMember", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TermsOfServicePolicyStore.GetLatestVersion", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) UpdateMembersRole(teamID string, userIDs []string) *model.AppError {
+func (s *TimerLayerTermsOfServicePolicyStore) GetOutstandingUsers(version *model.TermsOfServicePolicyVersion, offset int, limit int) ([]*model.User, error) {
 	start := timemodule.Now()
 
-	resultVar0 := s.TeamStore.UpdateMembersRole(teamID, userIDs)
+	resultVar0, resultVar1 := s.TermsOfServicePolicyStore.GetOutstandingUsers(version, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar0 == nil {
+		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UpdateMembersRole", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TermsOfServicePolicyStore.GetOutstandingUsers", success, elapsed)
 	}
-	return resultVar0
+	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) UpdateMultipleMembers(members []*model.TeamMember) ([]*model.TeamMember, *model.AppError) {
+func (s *TimerLayerTermsOfServicePolicyStore) GetPendingForUser(userId string) ([]*model.TermsOfServicePolicyVersion, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.UpdateMultipleMembers(members)
+	resultVar0, resultVar1 := s.TermsOfServicePolicyStore.GetPendingForUser(userId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6676,15 +9306,15 @@ func (s *TimerLayerTeamStore) UpdateMultipleMembers(members []*model.TeamMember)
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UpdateMultipleMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TermsOfServicePolicyStore.GetPendingForUser", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTeamStore) UserBelongsToTeams(userId string, teamIds []string) (bool, *model.AppError) {
+func (s *TimerLayerTermsOfServicePolicyStore) Save(policy *model.TermsOfServicePolicy) (*model.TermsOfServicePolicy, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TeamStore.UserBelongsToTeams(userId, teamIds)
+	resultVar0, resultVar1 := s.TermsOfServicePolicyStore.Save(policy)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6692,31 +9322,31 @@ func (s *TimerLayerTeamStore) UserBelongsToTeams(userId string, teamIds []string
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UserBelongsToTeams", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TermsOfServicePolicyStore.Save", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTermsOfServiceStore) Get(id string, allowFromCache bool) (*model.TermsOfService, error) {
+func (s *TimerLayerTermsOfServicePolicyStore) SaveAcceptance(acceptance *model.TermsOfServiceAcceptance) error {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TermsOfServiceStore.Get(id, allowFromCache)
+	resultVar0 := s.TermsOfServicePolicyStore.SaveAcceptance(acceptance)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if resultVar1 == nil {
+		if resultVar0 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TermsOfServiceStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TermsOfServicePolicyStore.SaveAcceptance", success, elapsed)
 	}
-	return resultVar0, resultVar1
+	return resultVar0
 }
 
-func (s *TimerLayerTermsOfServiceStore) GetLatest(allowFromCache bool) (*model.TermsOfService, error) {
+func (s *TimerLayerTermsOfServicePolicyStore) SaveVersion(version *model.TermsOfServicePolicyVersion) (*model.TermsOfServicePolicyVersion, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TermsOfServiceStore.GetLatest(allowFromCache)
+	resultVar0, resultVar1 := s.TermsOfServicePolicyStore.SaveVersion(version)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6724,15 +9354,15 @@ func (s *TimerLayerTermsOfServiceStore) GetLatest(allowFromCache bool) (*model.T
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TermsOfServiceStore.GetLatest", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TermsOfServicePolicyStore.SaveVersion", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerTermsOfServiceStore) Save(termsOfService *model.TermsOfService) (*model.TermsOfService, error) {
+func (s *TimerLayerTermsOfServicePolicyStore) Update(policy *model.TermsOfServicePolicy) (*model.TermsOfServicePolicy, error) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.TermsOfServiceStore.Save(termsOfService)
+	resultVar0, resultVar1 := s.TermsOfServicePolicyStore.Update(policy)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6740,7 +9370,7 @@ func (s *TimerLayerTermsOfServiceStore) Save(termsOfService *model.TermsOfServic
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TermsOfServiceStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TermsOfServicePolicyStore.Update", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
@@ -6856,10 +9486,26 @@ func (s *TimerLayerUserStore) AnalyticsGetGuestCount() (int64, *model.AppError)
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerUserStore) AnalyticsGetInactiveUsersCount() (int64, *model.AppError) {
+func (s *TimerLayerUserStore) AnalyticsGetInactiveUsersCount() (int64, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.UserStore.AnalyticsGetInactiveUsersCount()
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserStore.AnalyticsGetInactiveUsersCount", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerUserStore) AnalyticsGetSystemAdminCount() (int64, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.UserStore.AnalyticsGetInactiveUsersCount()
+	resultVar0, resultVar1 := s.UserStore.AnalyticsGetSystemAdminCount()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6867,15 +9513,15 @@ func (s *TimerLayerUserStore) AnalyticsGetInactiveUsersCount() (int64, *model.Ap
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("UserStore.AnalyticsGetInactiveUsersCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("UserStore.AnalyticsGetSystemAdminCount", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
 
-func (s *TimerLayerUserStore) AnalyticsGetSystemAdminCount() (int64, *model.AppError) {
+func (s *TimerLayerUserStore) AnalyticsSeatCountsByDay(startTime int64, endTime int64, excludeServiceAccounts bool) ([]*model.SeatUsagePoint, *model.AppError) {
 	start := timemodule.Now()
 
-	resultVar0, resultVar1 := s.UserStore.AnalyticsGetSystemAdminCount()
+	resultVar0, resultVar1 := s.UserStore.AnalyticsSeatCountsByDay(startTime, endTime, excludeServiceAccounts)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6883,7 +9529,7 @@ func (s *TimerLayerUserStore) AnalyticsGetSystemAdminCount() (int64, *model.AppE
 		if resultVar1 == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("UserStore.AnalyticsGetSystemAdminCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("UserStore.AnalyticsSeatCountsByDay", success, elapsed)
 	}
 	return resultVar0, resultVar1
 }
@@ -7175,6 +9821,22 @@ func (s *TimerLayerUserStore) GetChannelGroupUsers(channelID string) ([]*model.U
 	return resultVar0, resultVar1
 }
 
+func (s *TimerLayerUserStore) GetDirectReports(managerId string, offset int, limit int) ([]*model.User, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.UserStore.GetDirectReports(managerId, offset, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserStore.GetDirectReports", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
 func (s *TimerLayerUserStore) GetEtagForAllProfiles() string {
 	start := timemodule.Now()
 
@@ -7431,6 +10093,38 @@ func (s *TimerLayerUserStore) GetRecentlyActiveUsersForTeam(teamId string, offse
 	return resultVar0, resultVar1
 }
 
+func (s *TimerLayerUserStore) GetReportingChain(userId string) ([]*model.User, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.UserStore.GetReportingChain(userId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserStore.GetReportingChain", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerUserStore) GetServiceAccounts(offset int, limit int, includeDeleted bool) ([]*model.User, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.UserStore.GetServiceAccounts(offset, limit, includeDeleted)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserStore.GetServiceAccounts", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
 func (s *TimerLayerUserStore) GetSystemAdminProfiles() (map[string]*model.User, *model.AppError) {
 	start := timemodule.Now()
 
@@ -7511,6 +10205,22 @@ func (s *TimerLayerUserStore) GetUsersBatchForIndexing(startTime int64, endTime
 	return resultVar0, resultVar1
 }
 
+func (s *TimerLayerUserStore) GetUsersWithExpiredRoles(expiredBefore int64, limit int) ([]*model.User, *model.AppError) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.UserStore.GetUsersWithExpiredRoles(expiredBefore, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserStore.GetUsersWithExpiredRoles", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
 func (s *TimerLayerUserStore) InferSystemInstallDate() (int64, *model.AppError) {
 	start := timemodule.Now()
 
@@ -7780,6 +10490,22 @@ func (s *TimerLayerUserStore) UpdateFailedPasswordAttempts(userId string, attemp
 	return resultVar0
 }
 
+func (s *TimerLayerUserStore) UpdateLastLogin(userId string, lastLogin int64) *model.AppError {
+	start := timemodule.Now()
+
+	resultVar0 := s.UserStore.UpdateLastLogin(userId, lastLogin)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserStore.UpdateLastLogin", success, elapsed)
+	}
+	return resultVar0
+}
+
 func (s *TimerLayerUserStore) UpdateLastPictureUpdate(userId string) *model.AppError {
 	start := timemodule.Now()
 
@@ -8036,6 +10762,230 @@ func (s *TimerLayerUserAccessTokenStore) UpdateTokenEnable(tokenId string) *mode
 	return resultVar0
 }
 
+func (s *TimerLayerUserPropertyStore) DeleteField(id string, deleteAt int64) error {
+	start := timemodule.Now()
+
+	resultVar0 := s.UserPropertyStore.DeleteField(id, deleteAt)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserPropertyStore.DeleteField", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerUserPropertyStore) GetField(id string) (*model.UserPropertyField, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.UserPropertyStore.GetField(id)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserPropertyStore.GetField", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerUserPropertyStore) GetFields() ([]*model.UserPropertyField, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.UserPropertyStore.GetFields()
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserPropertyStore.GetFields", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerUserPropertyStore) GetValuesForUser(userId string) ([]*model.UserPropertyValue, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.UserPropertyStore.GetValuesForUser(userId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserPropertyStore.GetValuesForUser", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerUserPropertyStore) PermanentDeleteByUser(userId string) error {
+	start := timemodule.Now()
+
+	resultVar0 := s.UserPropertyStore.PermanentDeleteByUser(userId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserPropertyStore.PermanentDeleteByUser", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerUserPropertyStore) SaveField(field *model.UserPropertyField) (*model.UserPropertyField, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.UserPropertyStore.SaveField(field)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserPropertyStore.SaveField", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerUserPropertyStore) SaveValuesForUser(userId string, values []*model.UserPropertyValue) error {
+	start := timemodule.Now()
+
+	resultVar0 := s.UserPropertyStore.SaveValuesForUser(userId, values)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserPropertyStore.SaveValuesForUser", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerUserPropertyStore) UpdateField(field *model.UserPropertyField) (*model.UserPropertyField, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.UserPropertyStore.UpdateField(field)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserPropertyStore.UpdateField", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerUserRelationshipStore) Delete(userId string, otherUserId string, relationshipType string) error {
+	start := timemodule.Now()
+
+	resultVar0 := s.UserRelationshipStore.Delete(userId, otherUserId, relationshipType)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserRelationshipStore.Delete", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerUserRelationshipStore) Get(userId string, otherUserId string, relationshipType string) (*model.UserRelationship, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.UserRelationshipStore.Get(userId, otherUserId, relationshipType)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserRelationshipStore.Get", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerUserRelationshipStore) GetForUser(userId string) ([]*model.UserRelationship, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.UserRelationshipStore.GetForUser(userId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserRelationshipStore.GetForUser", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerUserRelationshipStore) GetUserIdsRelatedTo(otherUserId string) ([]string, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.UserRelationshipStore.GetUserIdsRelatedTo(otherUserId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserRelationshipStore.GetUserIdsRelatedTo", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
+func (s *TimerLayerUserRelationshipStore) PermanentDeleteByUser(userId string) error {
+	start := timemodule.Now()
+
+	resultVar0 := s.UserRelationshipStore.PermanentDeleteByUser(userId)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar0 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserRelationshipStore.PermanentDeleteByUser", success, elapsed)
+	}
+	return resultVar0
+}
+
+func (s *TimerLayerUserRelationshipStore) Save(relationship *model.UserRelationship) (*model.UserRelationship, error) {
+	start := timemodule.Now()
+
+	resultVar0, resultVar1 := s.UserRelationshipStore.Save(relationship)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if resultVar1 == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserRelationshipStore.Save", success, elapsed)
+	}
+	return resultVar0, resultVar1
+}
+
 func (s *TimerLayerUserTermsOfServiceStore) Delete(userId string, termsOfServiceId string) error {
 	start := timemodule.Now()
 
@@ -8563,32 +11513,55 @@ func NewTimerLayer(childStore Store, metrics einterfaces.MetricsInterface) *Time
 	newStore.AuditStore = &TimerLayerAuditStore{AuditStore: childStore.Audit(), Root: &newStore}
 	newStore.BotStore = &TimerLayerBotStore{BotStore: childStore.Bot(), Root: &newStore}
 	newStore.ChannelStore = &TimerLayerChannelStore{ChannelStore: childStore.Channel(), Root: &newStore}
+	newStore.ChannelBookmarkStore = &TimerLayerChannelBookmarkStore{ChannelBookmarkStore: childStore.ChannelBookmark(), Root: &newStore}
+	newStore.ChannelChangeStore = &TimerLayerChannelChangeStore{ChannelChangeStore: childStore.ChannelChange(), Root: &newStore}
+	newStore.ChannelGuestLinkStore = &TimerLayerChannelGuestLinkStore{ChannelGuestLinkStore: childStore.ChannelGuestLink(), Root: &newStore}
+	newStore.ChannelIntegrationStore = &TimerLayerChannelIntegrationStore{ChannelIntegrationStore: childStore.ChannelIntegration(), Root: &newStore}
 	newStore.ChannelMemberHistoryStore = &TimerLayerChannelMemberHistoryStore{ChannelMemberHistoryStore: childStore.ChannelMemberHistory(), Root: &newStore}
 	newStore.ClusterDiscoveryStore = &TimerLayerClusterDiscoveryStore{ClusterDiscoveryStore: childStore.ClusterDiscovery(), Root: &newStore}
 	newStore.CommandStore = &TimerLayerCommandStore{CommandStore: childStore.Command(), Root: &newStore}
 	newStore.CommandWebhookStore = &TimerLayerCommandWebhookStore{CommandWebhookStore: childStore.CommandWebhook(), Root: &newStore}
 	newStore.ComplianceStore = &TimerLayerComplianceStore{ComplianceStore: childStore.Compliance(), Root: &newStore}
+	newStore.EmailVerificationStore = &TimerLayerEmailVerificationStore{EmailVerificationStore: childStore.EmailVerification(), Root: &newStore}
 	newStore.EmojiStore = &TimerLayerEmojiStore{EmojiStore: childStore.Emoji(), Root: &newStore}
+	newStore.EventStreamStore = &TimerLayerEventStreamStore{EventStreamStore: childStore.EventStream(), Root: &newStore}
 	newStore.FileInfoStore = &TimerLayerFileInfoStore{FileInfoStore: childStore.FileInfo(), Root: &newStore}
 	newStore.GroupStore = &TimerLayerGroupStore{GroupStore: childStore.Group(), Root: &newStore}
+	newStore.IntegrationUsageStore = &TimerLayerIntegrationUsageStore{IntegrationUsageStore: childStore.IntegrationUsage(), Root: &newStore}
 	newStore.JobStore = &TimerLayerJobStore{JobStore: childStore.Job(), Root: &newStore}
 	newStore.LicenseStore = &TimerLayerLicenseStore{LicenseStore: childStore.License(), Root: &newStore}
 	newStore.LinkMetadataStore = &TimerLayerLinkMetadataStore{LinkMetadataStore: childStore.LinkMetadata(), Root: &newStore}
+	newStore.LoginHistoryStore = &TimerLayerLoginHistoryStore{LoginHistoryStore: childStore.LoginHistory(), Root: &newStore}
 	newStore.OAuthStore = &TimerLayerOAuthStore{OAuthStore: childStore.OAuth(), Root: &newStore}
+	newStore.OutboxStore = &TimerLayerOutboxStore{OutboxStore: childStore.Outbox(), Root: &newStore}
+	newStore.PendingPinStore = &TimerLayerPendingPinStore{PendingPinStore: childStore.PendingPin(), Root: &newStore}
 	newStore.PluginStore = &TimerLayerPluginStore{PluginStore: childStore.Plugin(), Root: &newStore}
 	newStore.PostStore = &TimerLayerPostStore{PostStore: childStore.Post(), Root: &newStore}
+	newStore.PostAcknowledgementStore = &TimerLayerPostAcknowledgementStore{PostAcknowledgementStore: childStore.PostAcknowledgement(), Root: &newStore}
+	newStore.PostArchiveStore = &TimerLayerPostArchiveStore{PostArchiveStore: childStore.PostArchive(), Root: &newStore}
+	newStore.PostIntegrityStore = &TimerLayerPostIntegrityStore{PostIntegrityStore: childStore.PostIntegrity(), Root: &newStore}
+	newStore.PostsPartitionStore = &TimerLayerPostsPartitionStore{PostsPartitionStore: childStore.PostsPartition(), Root: &newStore}
 	newStore.PreferenceStore = &TimerLayerPreferenceStore{PreferenceStore: childStore.Preference(), Root: &newStore}
+	newStore.PresenceWebhookStore = &TimerLayerPresenceWebhookStore{PresenceWebhookStore: childStore.PresenceWebhook(), Root: &newStore}
 	newStore.ReactionStore = &TimerLayerReactionStore{ReactionStore: childStore.Reaction(), Root: &newStore}
+	newStore.RetentionPolicyStore = &TimerLayerRetentionPolicyStore{RetentionPolicyStore: childStore.RetentionPolicy(), Root: &newStore}
 	newStore.RoleStore = &TimerLayerRoleStore{RoleStore: childStore.Role(), Root: &newStore}
 	newStore.SchemeStore = &TimerLayerSchemeStore{SchemeStore: childStore.Scheme(), Root: &newStore}
 	newStore.SessionStore = &TimerLayerSessionStore{SessionStore: childStore.Session(), Root: &newStore}
+	newStore.SlugHistoryStore = &TimerLayerSlugHistoryStore{SlugHistoryStore: childStore.SlugHistory(), Root: &newStore}
 	newStore.StatusStore = &TimerLayerStatusStore{StatusStore: childStore.Status(), Root: &newStore}
+	newStore.StatusAutomationStore = &TimerLayerStatusAutomationStore{StatusAutomationStore: childStore.StatusAutomation(), Root: &newStore}
 	newStore.SystemStore = &TimerLayerSystemStore{SystemStore: childStore.System(), Root: &newStore}
 	newStore.TeamStore = &TimerLayerTeamStore{TeamStore: childStore.Team(), Root: &newStore}
+	newStore.TeamInviteLinkStore = &TimerLayerTeamInviteLinkStore{TeamInviteLinkStore: childStore.TeamInviteLink(), Root: &newStore}
+	newStore.TeamMemberHistoryStore = &TimerLayerTeamMemberHistoryStore{TeamMemberHistoryStore: childStore.TeamMemberHistory(), Root: &newStore}
 	newStore.TermsOfServiceStore = &TimerLayerTermsOfServiceStore{TermsOfServiceStore: childStore.TermsOfService(), Root: &newStore}
+	newStore.TermsOfServicePolicyStore = &TimerLayerTermsOfServicePolicyStore{TermsOfServicePolicyStore: childStore.TermsOfServicePolicy(), Root: &newStore}
 	newStore.TokenStore = &TimerLayerTokenStore{TokenStore: childStore.Token(), Root: &newStore}
 	newStore.UserStore = &TimerLayerUserStore{UserStore: childStore.User(), Root: &newStore}
 	newStore.UserAccessTokenStore = &TimerLayerUserAccessTokenStore{UserAccessTokenStore: childStore.UserAccessToken(), Root: &newStore}
+	newStore.UserPropertyStore = &TimerLayerUserPropertyStore{UserPropertyStore: childStore.UserProperty(), Root: &newStore}
+	newStore.UserRelationshipStore = &TimerLayerUserRelationshipStore{UserRelationshipStore: childStore.UserRelationship(), Root: &newStore}
 	newStore.UserTermsOfServiceStore = &TimerLayerUserTermsOfServiceStore{UserTermsOfServiceStore: childStore.UserTermsOfService(), Root: &newStore}
 	newStore.WebhookStore = &TimerLayerWebhookStore{WebhookStore: childStore.Webhook(), Root: &newStore}
 	return &newStore
@@ -63,6 +63,10 @@ func (c *FakeClusterInterface) GetPluginStatuses() (model.PluginStatuses, *model
 	return nil, nil
 }
 
+func (c *FakeClusterInterface) GetWebConnections(userId string) ([]*model.WebConnInfo, *model.AppError) {
+	return nil, nil
+}
+
 func (c *FakeClusterInterface) GetMessages() []*model.ClusterMessage {
 	return c.messages
 }
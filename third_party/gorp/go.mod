@@ -0,0 +1,3 @@
+module github.com/mattermost/gorp
+
+go 1.14
@@ -0,0 +1,28 @@
+// +build !windows
+
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package utils
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFreeDiskSpace(t *testing.T) {
+	dir, err := ioutil.TempDir("", "disk_space")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	free, err := FreeDiskSpace(dir)
+	require.NoError(t, err)
+	assert.NotZero(t, free)
+
+	_, err = FreeDiskSpace(dir + "/missing")
+	assert.Error(t, err)
+}
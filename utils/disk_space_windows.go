@@ -0,0 +1,15 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package utils
+
+import (
+	"errors"
+)
+
+var ErrFreeDiskSpaceUnsupported = errors.New("checking free disk space is not supported on this platform")
+
+// FreeDiskSpace isn't implemented on Windows.
+func FreeDiskSpace(path string) (uint64, error) {
+	return 0, ErrFreeDiskSpaceUnsupported
+}
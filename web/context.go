@@ -57,12 +57,24 @@ func (c *Context) MakeAuditRecord(event string, initialStatus string) *audit.Rec
 		IPAddress: c.App.IpAddress(),
 		Meta:      audit.Meta{audit.KeyClusterID: c.App.GetClusterId()},
 	}
+	if impersonatorId := c.App.Session().ImpersonatorId(); impersonatorId != "" {
+		rec.AddMeta(audit.KeyImpersonatorID, impersonatorId)
+	}
 	rec.AddMetaTypeConverter(model.AuditModelTypeConv)
 
 	return rec
 }
 
+// impersonationAuditInfo tags the extra info of an audit with the impersonator of the session, if any.
+func (c *Context) impersonationAuditInfo(extraInfo string) string {
+	if impersonatorId := c.App.Session().ImpersonatorId(); impersonatorId != "" {
+		return strings.TrimSpace(extraInfo + " impersonator_id=" + impersonatorId)
+	}
+	return extraInfo
+}
+
 func (c *Context) LogAudit(extraInfo string) {
+	extraInfo = c.impersonationAuditInfo(extraInfo)
 	audit := &model.Audit{UserId: c.App.Session().UserId, IpAddress: c.App.IpAddress(), Action: c.App.Path(), ExtraInfo: extraInfo, SessionId: c.App.Session().Id}
 	if err := c.App.Srv().Store.Audit().Save(audit); err != nil {
 		appErr := model.NewAppError("LogAudit", "app.audit.save.saving.app_error", nil, err.Error(), http.StatusInternalServerError)
@@ -75,6 +87,7 @@ func (c *Context) LogAuditWithUserId(userId, extraInfo string) {
 	if len(c.App.Session().UserId) > 0 {
 		extraInfo = strings.TrimSpace(extraInfo + " session_user=" + c.App.Session().UserId)
 	}
+	extraInfo = c.impersonationAuditInfo(extraInfo)
 
 	audit := &model.Audit{UserId: userId, IpAddress: c.App.IpAddress(), Action: c.App.Path(), ExtraInfo: extraInfo, SessionId: c.App.Session().Id}
 	if err := c.App.Srv().Store.Audit().Save(audit); err != nil {
@@ -139,6 +152,16 @@ func (c *Context) SessionRequired() {
 		c.Err = model.NewAppError("", "api.context.session_expired.app_error", nil, "UserRequired", http.StatusUnauthorized)
 		return
 	}
+
+	if !*c.App.Config().ServiceSettings.EnableImpersonation && c.App.Session().IsImpersonated() {
+		c.Err = model.NewAppError("", "api.context.session_expired.app_error", nil, "Impersonation", http.StatusUnauthorized)
+		return
+	}
+
+	if !*c.App.Config().ServiceSettings.EnableEmbedding && c.App.Session().IsEmbedded() {
+		c.Err = model.NewAppError("", "api.context.session_expired.app_error", nil, "Embed", http.StatusUnauthorized)
+		return
+	}
 }
 
 func (c *Context) MfaRequired() {
@@ -184,6 +207,53 @@ func (c *Context) MfaRequired() {
 	}
 }
 
+// TermsOfServicePoliciesRequired blocks the API for users who haven't accepted the latest version of
+// every terms of service policy targeting them, except for the requests needed to review and accept
+// those versions.
+func (c *Context) TermsOfServicePoliciesRequired(r *http.Request) {
+	if license := c.App.Srv().License(); license == nil || !*license.Features.CustomTermsOfService || !*c.App.Config().SupportSettings.EnforceTermsOfServicePolicies {
+		return
+	}
+
+	// OAuth integrations and bots are excepted, and an impersonator must not accept on behalf of the user.
+	session := c.App.Session()
+	if session.IsOAuth || session.IsImpersonated() || session.Props[model.SESSION_PROP_IS_BOT] == model.SESSION_PROP_IS_BOT_VALUE {
+		return
+	}
+
+	subpath, _ := utils.GetSubpathFromConfig(c.App.Config())
+	if isTermsOfServicePolicyExempt(r.Method, strings.TrimPrefix(c.App.Path(), strings.TrimSuffix(subpath, "/"))) {
+		return
+	}
+
+	pending, err := c.App.HasPendingTermsOfServicePolicies(session.UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if pending {
+		c.Err = model.NewAppError("", "api.context.terms_of_service_policy_required.app_error", nil, "TermsOfServicePoliciesRequired", http.StatusForbidden)
+	}
+}
+
+// isTermsOfServicePolicyExempt returns true for the requests a user with pending terms of service
+// policies may make: getting themselves, reviewing and accepting terms of service, and logging out.
+func isTermsOfServicePolicyExempt(method, urlPath string) bool {
+	urlPath = strings.TrimSuffix(urlPath, "/")
+
+	switch {
+	case method == http.MethodGet && urlPath == model.API_URL_SUFFIX+"/users/me":
+		return true
+	case method == http.MethodPost && urlPath == model.API_URL_SUFFIX+"/users/logout":
+		return true
+	case urlPath == model.API_URL_SUFFIX+"/terms_of_service" || strings.HasPrefix(urlPath, model.API_URL_SUFFIX+"/terms_of_service/"):
+		return true
+	}
+
+	return false
+}
+
 // ExtendSessionExpiryIfNeeded will update Session.ExpiresAt based on session lengths in config.
 // Session cookies will be resent to the client with updated max age.
 func (c *Context) ExtendSessionExpiryIfNeeded(w http.ResponseWriter, r *http.Request) {
@@ -218,6 +288,18 @@ func (c *Context) SetServerBusyError() {
 	c.Err = NewServerBusyError()
 }
 
+func (c *Context) SetReadOnlyModeError() {
+	c.Err = NewReadOnlyModeError()
+}
+
+func (c *Context) SetImpersonationReadOnlyError() {
+	c.Err = model.NewAppError("ServeHTTP", "api.context.impersonation_read_only.app_error", nil, "impersonator_id="+c.App.Session().ImpersonatorId(), http.StatusForbidden)
+}
+
+func (c *Context) SetEmbedScopeError() {
+	c.Err = model.NewAppError("ServeHTTP", "api.context.embed_scope.app_error", nil, "channel_id="+c.App.Session().EmbedChannelId(), http.StatusForbidden)
+}
+
 func (c *Context) SetCommandNotFoundError() {
 	c.Err = model.NewAppError("GetCommand", "store.sql_command.save.get.app_error", nil, "", http.StatusNotFound)
 }
@@ -255,6 +337,52 @@ func NewServerBusyError() *model.AppError {
 	return err
 }
 
+func NewReadOnlyModeError() *model.AppError {
+	err := model.NewAppError("Context", "api.context.read_only_mode.app_error", nil, "", http.StatusServiceUnavailable)
+	return err
+}
+
+// isReadOnlySafeMethod reports whether requests of the given method are still served while the
+// server is in read-only mode.
+func isReadOnlySafeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
+// isImpersonationReadOnlySafe returns true for the requests a read-only impersonation session may
+// make: reads, and logging out to end the impersonation.
+func isImpersonationReadOnlySafe(r *http.Request) bool {
+	return isReadOnlySafeMethod(r.Method) || (r.Method == http.MethodPost && strings.TrimSuffix(r.URL.Path, "/") == model.API_URL_SUFFIX+"/users/logout")
+}
+
+// embedUserPathRegexp matches the user endpoints an embedded session may read to render the authors
+// of the posts: the profile, image and status of a user. The endpoints listing the teams and
+// channels of the user aren't matched, since they aren't restricted to the embedded channel.
+var embedUserPathRegexp = regexp.MustCompile(`^` + model.API_URL_SUFFIX + `/users/(me|[a-z0-9]{26})(/image|/image/default|/status)?$`)
+
+// isEmbedSessionRequestAllowed returns true for the requests an embedded session may make: those
+// needed to render and take part in the channel it is restricted to. The permission checks on
+// channels and posts reject the other channels. The websocket isn't allowed, since its events
+// aren't filtered by channel.
+func isEmbedSessionRequestAllowed(r *http.Request, channelId string) bool {
+	urlPath := strings.TrimSuffix(r.URL.Path, "/")
+	channelPath := model.API_URL_SUFFIX + "/channels/" + channelId
+
+	switch {
+	case urlPath == channelPath || strings.HasPrefix(urlPath, channelPath+"/"):
+		return true
+	case urlPath == model.API_URL_SUFFIX+"/posts" || strings.HasPrefix(urlPath, model.API_URL_SUFFIX+"/posts/"):
+		return true
+	case urlPath == model.API_URL_SUFFIX+"/files" || strings.HasPrefix(urlPath, model.API_URL_SUFFIX+"/files/"):
+		return true
+	case r.Method == http.MethodPost && (urlPath == model.API_URL_SUFFIX+"/users/ids" || urlPath == model.API_URL_SUFFIX+"/users/logout"):
+		return true
+	case isReadOnlySafeMethod(r.Method) && embedUserPathRegexp.MatchString(urlPath):
+		return true
+	}
+
+	return false
+}
+
 func (c *Context) SetPermissionError(permission *model.Permission) {
 	c.Err = c.App.MakePermissionError(permission)
 }
@@ -304,6 +432,94 @@ func (c *Context) RequireCategoryId() *Context {
 	return c
 }
 
+func (c *Context) RequireBookmarkId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.BookmarkId) {
+		c.SetInvalidUrlParam("bookmark_id")
+	}
+	return c
+}
+
+func (c *Context) RequireInviteLinkId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.InviteLinkId) {
+		c.SetInvalidUrlParam("invite_link_id")
+	}
+	return c
+}
+
+func (c *Context) RequireGuestLinkId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.GuestLinkId) {
+		c.SetInvalidUrlParam("guest_link_id")
+	}
+	return c
+}
+
+func (c *Context) RequireIntegrationId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.IntegrationId) {
+		c.SetInvalidUrlParam("integration_id")
+	}
+	return c
+}
+
+func (c *Context) RequireOtherUserId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.OtherUserId) {
+		c.SetInvalidUrlParam("other_user_id")
+	}
+	return c
+}
+
+func (c *Context) RequirePolicyId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.PolicyId) {
+		c.SetInvalidUrlParam("policy_id")
+	}
+	return c
+}
+
+func (c *Context) RequireFieldId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.FieldId) {
+		c.SetInvalidUrlParam("field_id")
+	}
+	return c
+}
+
+func (c *Context) RequireConnectionId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.ConnectionId) {
+		c.SetInvalidUrlParam("connection_id")
+	}
+	return c
+}
+
 func (c *Context) RequireInviteId() *Context {
 	if c.Err != nil {
 		return c
@@ -549,6 +765,17 @@ func (c *Context) RequireJobId() *Context {
 	return c
 }
 
+func (c *Context) RequireDebugCaptureRuleId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.DebugCaptureRuleId) {
+		c.SetInvalidUrlParam("rule_id")
+	}
+	return c
+}
+
 func (c *Context) RequireJobType() *Context {
 	if c.Err != nil {
 		return c
@@ -8,6 +8,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v5/model"
 )
 
 func TestRequireHookId(t *testing.T) {
@@ -27,3 +29,86 @@ func TestRequireHookId(t *testing.T) {
 		require.Equal(t, http.StatusBadRequest, c.Err.StatusCode, "Should have set status as 400")
 	})
 }
+
+func TestIsImpersonationReadOnlySafe(t *testing.T) {
+	for _, tc := range []struct {
+		Method string
+		Path   string
+		Safe   bool
+	}{
+		{http.MethodGet, "/api/v4/users/me", true},
+		{http.MethodHead, "/api/v4/users/me", true},
+		{http.MethodPost, "/api/v4/users/logout", true},
+		{http.MethodPost, "/api/v4/users/logout/", true},
+		{http.MethodPost, "/api/v4/posts", false},
+		{http.MethodPut, "/api/v4/users/me/patch", false},
+		{http.MethodDelete, "/api/v4/users/logout", false},
+	} {
+		r, err := http.NewRequest(tc.Method, tc.Path, nil)
+		require.NoError(t, err)
+		require.Equal(t, tc.Safe, isImpersonationReadOnlySafe(r), "%s %s", tc.Method, tc.Path)
+	}
+}
+
+func TestIsEmbedSessionRequestAllowed(t *testing.T) {
+	channelId := model.NewId()
+	userId := model.NewId()
+	teamId := model.NewId()
+
+	for _, tc := range []struct {
+		Method  string
+		Path    string
+		Allowed bool
+	}{
+		{http.MethodGet, "/api/v4/channels/" + channelId, true},
+		{http.MethodGet, "/api/v4/channels/" + channelId + "/posts", true},
+		{http.MethodPost, "/api/v4/channels/" + channelId + "/members", true},
+		{http.MethodGet, "/api/v4/channels/" + model.NewId(), false},
+		{http.MethodGet, "/api/v4/channels/" + channelId + "x", false},
+		{http.MethodPost, "/api/v4/posts", true},
+		{http.MethodPut, "/api/v4/posts/" + model.NewId() + "/patch", true},
+		{http.MethodPost, "/api/v4/files", true},
+		{http.MethodGet, "/api/v4/users/me", true},
+		{http.MethodGet, "/api/v4/users/me/", true},
+		{http.MethodGet, "/api/v4/users/" + userId, true},
+		{http.MethodGet, "/api/v4/users/" + userId + "/image", true},
+		{http.MethodGet, "/api/v4/users/" + userId + "/image/default", true},
+		{http.MethodGet, "/api/v4/users/" + userId + "/status", true},
+		{http.MethodGet, "/api/v4/users/me/teams", false},
+		{http.MethodGet, "/api/v4/users/me/teams/unread", false},
+		{http.MethodGet, "/api/v4/users/" + userId + "/teams/" + teamId + "/channels", false},
+		{http.MethodGet, "/api/v4/users/" + userId + "/teams/" + teamId + "/channels/members", false},
+		{http.MethodGet, "/api/v4/users/" + userId + "/channels", false},
+		{http.MethodGet, "/api/v4/users/me/preferences", false},
+		{http.MethodGet, "/api/v4/users", false},
+		{http.MethodPost, "/api/v4/users/ids", true},
+		{http.MethodPost, "/api/v4/users/logout", true},
+		{http.MethodPut, "/api/v4/users/me/patch", false},
+		{http.MethodGet, "/api/v4/teams/" + model.NewId(), false},
+		{http.MethodPost, "/api/v4/embed/tokens", false},
+		{http.MethodGet, "/api/v4/websocket", false},
+	} {
+		r, err := http.NewRequest(tc.Method, tc.Path, nil)
+		require.NoError(t, err)
+		require.Equal(t, tc.Allowed, isEmbedSessionRequestAllowed(r, channelId), "%s %s", tc.Method, tc.Path)
+	}
+}
+
+func TestIsTermsOfServicePolicyExempt(t *testing.T) {
+	for _, tc := range []struct {
+		Method string
+		Path   string
+		Exempt bool
+	}{
+		{http.MethodGet, "/api/v4/users/me", true},
+		{http.MethodPut, "/api/v4/users/me/patch", false},
+		{http.MethodPost, "/api/v4/users/logout", true},
+		{http.MethodGet, "/api/v4/terms_of_service", true},
+		{http.MethodGet, "/api/v4/terms_of_service/pending", true},
+		{http.MethodPost, "/api/v4/terms_of_service/policies/" + model.NewId() + "/accept", true},
+		{http.MethodGet, "/api/v4/terms_of_service_other", false},
+		{http.MethodGet, "/api/v4/channels", false},
+	} {
+		require.Equal(t, tc.Exempt, isTermsOfServicePolicyExempt(tc.Method, tc.Path), "%s %s", tc.Method, tc.Path)
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+)
+
+const debugCaptureRedacted = "********"
+
+// debugCaptureRedactedHeaders are the request headers carrying credentials.
+var debugCaptureRedactedHeaders = map[string]bool{
+	model.HEADER_AUTH:       true,
+	model.HEADER_CSRF_TOKEN: true,
+	model.HEADER_TOKEN:      true,
+	"Cookie":                true,
+}
+
+type debugCaptureBody struct {
+	io.Reader
+	io.Closer
+}
+
+// captureRequestBody reads the beginning of the request body and puts it back in front of the rest,
+// so that the handler still reads the whole body.
+func captureRequestBody(r *http.Request, limit int) []byte {
+	if r.Body == nil {
+		return nil
+	}
+
+	captured := make([]byte, limit)
+	n, _ := io.ReadFull(r.Body, captured)
+	captured = captured[:n]
+
+	r.Body = &debugCaptureBody{
+		Reader: io.MultiReader(bytes.NewReader(captured), r.Body),
+		Closer: r.Body,
+	}
+
+	return captured
+}
+
+func debugCaptureHeaders(header http.Header) map[string]string {
+	headers := make(map[string]string, len(header))
+	for name, values := range header {
+		if debugCaptureRedactedHeaders[http.CanonicalHeaderKey(name)] {
+			headers[name] = debugCaptureRedacted
+		} else {
+			headers[name] = strings.Join(values, ", ")
+		}
+	}
+	return headers
+}
+
+// debugCaptureSecretFields are the parts of the names of the JSON fields, form fields and query
+// parameters carrying credentials, in lower case, including those of the configuration such as the
+// data sources of the database.
+var debugCaptureSecretFields = []string{"password", "passphrase", "token", "secret", "salt", "datasource", "encryptkey"}
+
+// debugCaptureSecretParams are the names of the form fields and query parameters carrying
+// credentials that debugCaptureSecretFields doesn't match, such as the authorization code exchanged
+// for an OAuth access token.
+var debugCaptureSecretParams = []string{"code"}
+
+// debugCaptureSecretRegexp matches the credential fields of a JSON document along with their string
+// or array value, possibly cut short, to redact the bodies that can't be parsed such as those
+// truncated to DEBUG_CAPTURE_MAX_BODY_SIZE.
+var debugCaptureSecretRegexp = regexp.MustCompile(`(?i)("[^"]*(?:` + strings.Join(debugCaptureSecretFields, "|") + `)[^"]*"\s*:\s*)(?:"(?:[^"\\]|\\.)*"?|\[[^\]]*\]?)`)
+
+// debugCaptureFormSecretRegexp matches the credential fields of a form encoded body along with their
+// value, to redact them while keeping the body as it was sent, even when truncated.
+var debugCaptureFormSecretRegexp = regexp.MustCompile(`(?i)((?:^|&)(?:[^=&]*(?:` + strings.Join(debugCaptureSecretFields, "|") + `)[^=&]*|` + strings.Join(debugCaptureSecretParams, "|") + `)=)[^&]*`)
+
+func isDebugCaptureSecretField(name string) bool {
+	lowerName := strings.ToLower(name)
+	for _, secretField := range debugCaptureSecretFields {
+		if strings.Contains(lowerName, secretField) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactDebugCaptureValue redacts in place the credentials found in the objects nested in a decoded
+// JSON value, at any depth and within arrays, and reports whether it found any.
+func redactDebugCaptureValue(value interface{}) bool {
+	redacted := false
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for name, field := range v {
+			if isDebugCaptureSecretField(name) {
+				v[name] = debugCaptureRedacted
+				redacted = true
+			} else if redactDebugCaptureValue(field) {
+				redacted = true
+			}
+		}
+	case []interface{}:
+		for _, elem := range v {
+			if redactDebugCaptureValue(elem) {
+				redacted = true
+			}
+		}
+	}
+	return redacted
+}
+
+func isDebugCaptureSecretParam(name string) bool {
+	lowerName := strings.ToLower(name)
+	for _, secretParam := range debugCaptureSecretParams {
+		if lowerName == secretParam {
+			return true
+		}
+	}
+	return isDebugCaptureSecretField(name)
+}
+
+// debugCaptureBodyString redacts the credentials found in a body, such as the password sent to
+// login, the settings sent to update the configuration or the client secret sent to get an OAuth
+// access token. The bodies of the content types other than JSON and forms, such as the files
+// uploaded, aren't captured. A body without a content type is handled as JSON.
+func debugCaptureBodyString(body []byte, contentType string) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	switch mediaType := strings.TrimSpace(strings.ToLower(strings.Split(contentType, ";")[0])); {
+	case mediaType == "application/x-www-form-urlencoded":
+		return debugCaptureFormSecretRegexp.ReplaceAllString(string(body), "${1}"+debugCaptureRedacted)
+	case mediaType != "" && mediaType != "application/json" && !strings.HasSuffix(mediaType, "+json"):
+		return ""
+	}
+
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil || decoder.More() {
+		return debugCaptureSecretRegexp.ReplaceAllString(string(body), `${1}"`+debugCaptureRedacted+`"`)
+	}
+
+	if !redactDebugCaptureValue(value) {
+		return string(body)
+	}
+
+	b, _ := json.Marshal(value)
+	return string(b)
+}
+
+// debugCaptureQuery redacts the credentials found in the query of the request, such as an access
+// token or the authorization code given back by an OAuth provider.
+func debugCaptureQuery(r *http.Request) string {
+	query := r.URL.Query()
+	for name := range query {
+		if isDebugCaptureSecretParam(name) {
+			query.Set(name, debugCaptureRedacted)
+		}
+	}
+	return query.Encode()
+}
+
+func (h Handler) recordDebugCapture(c *Context, w *responseWriterWrapper, r *http.Request, ruleId string, requestBody []byte, start time.Time) {
+	capture := &model.DebugCapture{
+		RuleId:         ruleId,
+		RequestId:      c.App.RequestId(),
+		UserId:         c.App.Session().UserId,
+		Endpoint:       h.HandlerName,
+		Method:         r.Method,
+		Path:           r.URL.Path,
+		Query:          debugCaptureQuery(r),
+		StatusCode:     w.StatusCode(),
+		CreateAt:       start.UnixNano() / int64(time.Millisecond),
+		Elapsed:        float64(time.Since(start)) / float64(time.Second),
+		RequestHeaders: debugCaptureHeaders(r.Header),
+		RequestBody:    debugCaptureBodyString(requestBody, r.Header.Get("Content-Type")),
+		ResponseBody:   debugCaptureBodyString(w.CapturedBody(), w.Header().Get("Content-Type")),
+	}
+
+	if c.Err != nil {
+		capture.Error = c.Err.Error()
+	}
+
+	c.App.RecordDebugCapture(capture)
+}
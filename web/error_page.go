@@ -0,0 +1,74 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package web
+
+import (
+	"html/template"
+	"net/http"
+	"path"
+
+	"github.com/mattermost/mattermost-server/v5/app"
+	"github.com/mattermost/mattermost-server/v5/mlog"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/utils"
+)
+
+// renderWebAppError renders the error of a flow the browser was redirected through, such as
+// signing in with OAuth or SAML. When custom error pages are enabled, the branded error page is
+// rendered in place instead of redirecting to the error page of the web app.
+func renderWebAppError(a app.AppIface, w http.ResponseWriter, r *http.Request, err *model.AppError) {
+	if !*a.Config().TeamSettings.EnableCustomErrorPages {
+		utils.RenderWebAppError(a.Config(), w, r, err, a.AsymmetricSigningKey())
+		return
+	}
+
+	renderErrorPage(a, w, err)
+}
+
+// renderErrorPage renders the branded error page, using the template configured by the admin when
+// there is one and the error_page template otherwise.
+func renderErrorPage(a app.AppIface, w http.ResponseWriter, err *model.AppError) {
+	cfg := a.Config()
+	page := utils.NewHTMLTemplate(a.Srv().HTMLTemplates(), "error_page")
+
+	if templatePath := *cfg.TeamSettings.CustomErrorPageTemplate; templatePath != "" {
+		if custom, parseErr := template.ParseFiles(templatePath); parseErr != nil {
+			mlog.Error("Failed to parse the custom error page template, using the default one.", mlog.String("path", templatePath), mlog.Err(parseErr))
+		} else {
+			page = utils.NewHTMLTemplate(custom, custom.Name())
+		}
+	}
+
+	subpath, _ := utils.GetSubpathFromConfig(cfg)
+
+	err.Translate(a.T)
+
+	page.Props["SiteName"] = *cfg.TeamSettings.SiteName
+	if *cfg.TeamSettings.EnableCustomBrand {
+		page.Props["BrandText"] = *cfg.TeamSettings.CustomBrandText
+		if _, appErr := a.GetBrandImage(); appErr == nil {
+			page.Props["BrandImageURL"] = path.Join(subpath, model.API_URL_SUFFIX, "brand", "image")
+		}
+	}
+	page.Props["Title"] = a.T("web.error_page.title")
+	page.Props["Message"] = err.Message
+	page.Props["ErrorIdLabel"] = a.T("web.error_page.error_id")
+	page.Props["ErrorId"] = err.Id
+	page.Props["RequestIdLabel"] = a.T("web.error_page.request_id")
+	page.Props["RequestId"] = err.RequestId
+	page.Props["LinkText"] = a.T("web.error_page.back_to_login")
+	page.Props["LinkURL"] = path.Join(subpath, "login")
+
+	// Errors of redirect flows are given a redirect status code so that they are sent to the error
+	// page of the web app, the branded page is that error page.
+	status := err.StatusCode
+	if status < http.StatusBadRequest {
+		status = http.StatusOK
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	page.RenderToWriter(w)
+}
@@ -5,7 +5,6 @@ package web
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -87,6 +86,7 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	requestID := model.NewId()
 	var statusCode string
+	logRequest := mlog.Debug
 	defer func() {
 		responseLogFields := []mlog.Field{
 			mlog.String("method", r.Method),
@@ -97,7 +97,7 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		if statusCode != "0" {
 			responseLogFields = append(responseLogFields, mlog.String("status_code", statusCode))
 		}
-		mlog.Debug("Received HTTP request", responseLogFields...)
+		logRequest("Received HTTP request", responseLogFields...)
 	}()
 
 	c := &Context{}
@@ -113,11 +113,12 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	c.App.SetUserAgent(r.UserAgent())
 	c.App.SetAcceptLanguage(r.Header.Get("Accept-Language"))
 	c.App.SetPath(r.URL.Path)
+	c.App.SetContext(r.Context())
 	c.Params = ParamsFromRequest(r)
 	c.Log = c.App.Log()
 
 	if *c.App.Config().ServiceSettings.EnableOpenTracing {
-		span, ctx := tracing.StartRootSpanByContext(context.Background(), "web:ServeHTTP")
+		span, ctx := tracing.StartRootSpanByContext(r.Context(), "web:ServeHTTP")
 		carrier := opentracing.HTTPHeadersCarrier(r.Header)
 		_ = opentracing.GlobalTracer().Inject(span.Context(), opentracing.HTTPHeaders, carrier)
 		ext.HTTPMethod.Set(span, r.Method)
@@ -203,6 +204,18 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 
 		h.checkCSRFToken(c, r, token, tokenLocation, session)
+
+		if c.Err == nil && tokenLocation == app.TokenLocationCookie {
+			h.refreshCSRFCookie(c, w, r)
+		}
+	}
+
+	// Capture the request for troubleshooting, and log it at a lower level, if an admin enabled it for
+	// the endpoint or the user.
+	debugCaptureRuleId, debugCaptureLogLevel := c.App.DebugCaptureRuleFor(h.HandlerName, c.App.Session().UserId)
+	if debugCaptureLogLevel != "" {
+		c.App.SetLog(c.App.Log().WithMinLevel(debugCaptureLogLevel))
+		logRequest = c.App.Log().Debug
 	}
 
 	c.Log = c.App.Log().With(
@@ -225,6 +238,22 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		c.SetServerBusyError()
 	}
 
+	if c.Err == nil && c.App.Srv().ReadOnly.IsReadOnly() && !isReadOnlySafeMethod(r.Method) {
+		c.SetReadOnlyModeError()
+	}
+
+	if c.Err == nil && c.App.Session().IsImpersonationReadOnly() && !isImpersonationReadOnlySafe(r) {
+		c.SetImpersonationReadOnlyError()
+	}
+
+	if c.Err == nil && c.App.Session().IsEmbedded() && !isEmbedSessionRequestAllowed(r, c.App.Session().EmbedChannelId()) {
+		c.SetEmbedScopeError()
+	}
+
+	if c.Err == nil && h.RequireSession {
+		c.TermsOfServicePoliciesRequired(r)
+	}
+
 	if c.Err == nil && h.IsLocal {
 		// if the connection is local, RemoteAddr shouldn't have the
 		// shape IP:PORT (it will be "@" in Linux, for example)
@@ -236,6 +265,12 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	var debugCaptureRequestBody []byte
+	if debugCaptureRuleId != "" {
+		debugCaptureRequestBody = captureRequestBody(r, model.DEBUG_CAPTURE_MAX_BODY_SIZE)
+		w.(*responseWriterWrapper).startCapture(model.DEBUG_CAPTURE_MAX_BODY_SIZE)
+	}
+
 	if c.Err == nil {
 		h.HandleFunc(c, w, r)
 	}
@@ -271,6 +306,9 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		if IsApiCall(c.App, r) || IsWebhookCall(c.App, r) || IsOAuthApiCall(c.App, r) || len(r.Header.Get("X-Mobile-App")) > 0 {
 			w.WriteHeader(c.Err.StatusCode)
 			w.Write([]byte(c.Err.ToJson()))
+		} else if c.Err.StatusCode >= 300 && c.Err.StatusCode < 400 {
+			// Errors of redirect flows, such as signing in with SAML, may use the branded error page.
+			renderWebAppError(c.App, w, r, c.Err)
 		} else {
 			utils.RenderWebAppError(c.App.Config(), w, r, c.Err, c.App.AsymmetricSigningKey())
 		}
@@ -280,6 +318,10 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	if debugCaptureRuleId != "" {
+		h.recordDebugCapture(c, w.(*responseWriterWrapper), r, debugCaptureRuleId, debugCaptureRequestBody, now)
+	}
+
 	statusCode = strconv.Itoa(w.(*responseWriterWrapper).StatusCode())
 	if c.App.Metrics() != nil {
 		c.App.Metrics().IncrementHttpRequest()
@@ -289,6 +331,10 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			c.App.Metrics().ObserveApiEndpointDuration(h.HandlerName, r.Method, statusCode, elapsed)
 		}
 	}
+
+	if !h.IsStatic && r.URL.Path != model.API_URL_SUFFIX+"/websocket" {
+		c.App.Srv().RecordRequestDuration(time.Since(now))
+	}
 }
 
 // checkCSRFToken performs a CSRF check on the provided request with the given CSRF token. Returns whether or not
@@ -300,7 +346,7 @@ func (h *Handler) checkCSRFToken(c *Context, r *http.Request, token string, toke
 	if csrfCheckNeeded {
 		csrfHeader := r.Header.Get(model.HEADER_CSRF_TOKEN)
 
-		if csrfHeader == session.GetCSRF() {
+		if session.IsValidCSRF(csrfHeader, model.GetMillis()) {
 			csrfCheckPassed = true
 		} else if r.Header.Get(model.HEADER_REQUESTED_WITH) == model.HEADER_REQUESTED_WITH_XML {
 			// ToDo(DSchalla) 2019/01/04: Remove after deprecation period and only allow CSRF Header (MM-13657)
@@ -330,14 +376,38 @@ func (h *Handler) checkCSRFToken(c *Context, r *http.Request, token string, toke
 		}
 
 		if !csrfCheckPassed {
-			c.App.SetSession(&model.Session{})
-			c.Err = model.NewAppError("ServeHTTP", "api.context.session_expired.app_error", nil, "token="+token+" Appears to be a CSRF attempt", http.StatusUnauthorized)
+			if *c.App.Config().ServiceSettings.CSRFEnforcementMode == model.CSRF_ENFORCEMENT_MODE_REPORT {
+				c.Log.Warn("CSRF check failed for request, allowed in report mode",
+					mlog.String("path", r.URL.Path),
+					mlog.String("ip", r.RemoteAddr),
+					mlog.String("session_id", session.Id),
+					mlog.String("user_id", session.UserId),
+				)
+			} else {
+				c.App.SetSession(&model.Session{})
+				c.Err = model.NewAppError("ServeHTTP", "api.context.session_expired.app_error", nil, "token="+token+" Appears to be a CSRF attempt", http.StatusUnauthorized)
+			}
 		}
 	}
 
 	return csrfCheckNeeded, csrfCheckPassed
 }
 
+// refreshCSRFCookie attaches the CSRF cookie again when it no longer matches the token of the
+// session, such as after the token was rotated following a change to the privileges of the user.
+func (h *Handler) refreshCSRFCookie(c *Context, w http.ResponseWriter, r *http.Request) {
+	expected := c.App.Session().GetCSRF()
+	if expected == "" {
+		return
+	}
+
+	if cookie, err := r.Cookie(model.SESSION_COOKIE_CSRF); err == nil && cookie.Value == expected {
+		return
+	}
+
+	c.App.AttachCSRFCookie(w, r)
+}
+
 // ApiHandler provides a handler for API endpoints which do not require the user to be logged in order for access to be
 // granted.
 func (w *Web) ApiHandler(h func(*Context, http.ResponseWriter, *http.Request)) http.Handler {
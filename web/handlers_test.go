@@ -4,8 +4,12 @@
 package web
 
 import (
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/mattermost/mattermost-server/v5/app"
@@ -57,6 +61,60 @@ func TestHandlerServeHTTPErrors(t *testing.T) {
 	}
 }
 
+func TestHandlerServeHTTPErrorsWithCustomErrorPages(t *testing.T) {
+	th := SetupWithStoreMock(t)
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.TeamSettings.EnableCustomErrorPages = true
+		*cfg.TeamSettings.SiteName = "Acme Chat"
+	})
+
+	web := New(th.Server, th.Server.AppOptions, th.Server.Router)
+	handler := web.NewHandler(handlerForHTTPErrors)
+
+	t.Run("render the branded error page on desktop non-api endpoint", func(t *testing.T) {
+		request := httptest.NewRequest("GET", "/login/sso/saml", nil)
+		response := httptest.NewRecorder()
+		handler.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.Contains(t, response.Header().Get("Content-Type"), "text/html")
+		assert.Contains(t, response.Body.String(), "Acme Chat")
+		assert.Contains(t, response.Body.String(), "api.user.saml.not_available.app_error")
+		assert.NotContains(t, response.Body.String(), "/error?message=")
+	})
+
+	t.Run("render the template configured by the admin", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "error_page")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		templatePath := filepath.Join(dir, "error.html")
+		require.NoError(t, ioutil.WriteFile(templatePath, []byte(`<p>{{.Props.SiteName}}: {{.Props.Message}}</p>`), 0600))
+
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.TeamSettings.CustomErrorPageTemplate = templatePath
+		})
+
+		request := httptest.NewRequest("GET", "/login/sso/saml", nil)
+		response := httptest.NewRecorder()
+		handler.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.True(t, strings.HasPrefix(response.Body.String(), "<p>Acme Chat: "))
+	})
+
+	t.Run("not render the branded error page on api endpoint", func(t *testing.T) {
+		request := httptest.NewRequest("GET", "/api/v4/test", nil)
+		response := httptest.NewRecorder()
+		handler.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusFound, response.Code)
+		assert.NotContains(t, response.Body.String(), "Acme Chat")
+	})
+}
+
 func handlerForHTTPSecureTransport(c *Context, w http.ResponseWriter, r *http.Request) {
 }
 
@@ -656,4 +714,52 @@ func TestCheckCSRFToken(t *testing.T) {
 		assert.True(t, passed)
 		assert.Nil(t, c.Err)
 	})
+
+	t.Run("should allow a POST request with an invalid CSRF token in report mode", func(t *testing.T) {
+		th := SetupWithStoreMock(t)
+		defer th.TearDown()
+
+		mockStore := th.App.Srv().Store.(*mocks.Store)
+		mockUserStore := mocks.UserStore{}
+		mockUserStore.On("Count", mock.Anything).Return(int64(10), nil)
+		mockPostStore := mocks.PostStore{}
+		mockPostStore.On("GetMaxPostSize").Return(65535, nil)
+		mockSystemStore := mocks.SystemStore{}
+		mockSystemStore.On("GetByName", "InstallationDate").Return(&model.System{Name: "InstallationDate", Value: "10"}, nil)
+		mockSystemStore.On("GetByName", "FirstServerRunTimestamp").Return(&model.System{Name: "FirstServerRunTimestamp", Value: "10"}, nil)
+
+		mockStore.On("User").Return(&mockUserStore)
+		mockStore.On("Post").Return(&mockPostStore)
+		mockStore.On("System").Return(&mockSystemStore)
+
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.ServiceSettings.CSRFEnforcementMode = model.CSRF_ENFORCEMENT_MODE_REPORT
+		})
+
+		h := &Handler{
+			RequireSession: true,
+			TrustRequester: false,
+		}
+
+		token := "token"
+		tokenLocation := app.TokenLocationCookie
+
+		c := &Context{
+			App: th.App,
+			Log: th.App.Log(),
+		}
+		r, _ := http.NewRequest(http.MethodPost, "", nil)
+		r.Header.Set(model.HEADER_CSRF_TOKEN, "other_token")
+		session := &model.Session{
+			Props: map[string]string{
+				"csrf": token,
+			},
+		}
+
+		checked, passed := h.checkCSRFToken(c, r, token, tokenLocation, session)
+
+		assert.True(t, checked)
+		assert.False(t, passed)
+		assert.Nil(t, c.Err)
+	})
 }
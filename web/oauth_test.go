@@ -275,6 +275,13 @@ func TestOAuthAccessToken(t *testing.T) {
 	data.Set("code", rurl.Query().Get("code"))
 	data.Set("redirect_uri", oauthApp.CallbackUrls[0])
 
+	captureRule, appErr := th.App.CreateDebugCaptureRule(&model.DebugCaptureRule{
+		Endpoint:  "getAccessToken",
+		ExpiresAt: model.GetMillis() + 60000,
+	})
+	require.Nil(t, appErr)
+	defer th.App.DeleteDebugCaptureRule(captureRule.Id)
+
 	token := ""
 	refreshToken := ""
 	rsp, resp := ApiClient.GetOAuthAccessToken(data)
@@ -284,6 +291,15 @@ func TestOAuthAccessToken(t *testing.T) {
 	token, refreshToken = rsp.AccessToken, rsp.RefreshToken
 	require.Equal(t, rsp.TokenType, model.ACCESS_TOKEN_TYPE, "access token type incorrect")
 
+	captures := th.App.GetDebugCaptures(captureRule.Id)
+	require.Len(t, captures, 1, "the token exchange should have been captured")
+	for _, secret := range []string{oauthApp.ClientSecret, data.Get("code"), token, refreshToken} {
+		assert.NotContains(t, captures[0].RequestBody, secret)
+		assert.NotContains(t, captures[0].ResponseBody, secret)
+	}
+	assert.Contains(t, captures[0].RequestBody, "client_id="+oauthApp.Id)
+	assert.Contains(t, captures[0].RequestBody, "client_secret=********")
+
 	_, err := ApiClient.DoApiGet("/oauth_test", "")
 	require.Nil(t, err)
 
@@ -47,6 +47,7 @@ type Params struct {
 	Service                   string
 	JobId                     string
 	JobType                   string
+	DebugCaptureRuleId        string
 	ActionId                  string
 	RoleId                    string
 	RoleName                  string
@@ -78,6 +79,14 @@ type Params struct {
 	FilterAllowReference      bool
 	FilterParentTeamPermitted bool
 	CategoryId                string
+	BookmarkId                string
+	PolicyId                  string
+	ConnectionId              string
+	InviteLinkId              string
+	GuestLinkId               string
+	IntegrationId             string
+	OtherUserId               string
+	FieldId                   string
 }
 
 func ParamsFromRequest(r *http.Request) *Params {
@@ -98,6 +107,38 @@ func ParamsFromRequest(r *http.Request) *Params {
 		params.CategoryId = val
 	}
 
+	if val, ok := props["bookmark_id"]; ok {
+		params.BookmarkId = val
+	}
+
+	if val, ok := props["policy_id"]; ok {
+		params.PolicyId = val
+	}
+
+	if val, ok := props["field_id"]; ok {
+		params.FieldId = val
+	}
+
+	if val, ok := props["connection_id"]; ok {
+		params.ConnectionId = val
+	}
+
+	if val, ok := props["invite_link_id"]; ok {
+		params.InviteLinkId = val
+	}
+
+	if val, ok := props["guest_link_id"]; ok {
+		params.GuestLinkId = val
+	}
+
+	if val, ok := props["integration_id"]; ok {
+		params.IntegrationId = val
+	}
+
+	if val, ok := props["other_user_id"]; ok {
+		params.OtherUserId = val
+	}
+
 	if val, ok := props["invite_id"]; ok {
 		params.InviteId = val
 	}
@@ -186,6 +227,10 @@ func ParamsFromRequest(r *http.Request) *Params {
 		params.JobType = val
 	}
 
+	if val, ok := props["rule_id"]; ok {
+		params.DebugCaptureRuleId = val
+	}
+
 	if val, ok := props["action_id"]; ok {
 		params.ActionId = val
 	}
@@ -5,6 +5,7 @@ package web
 
 import (
 	"bufio"
+	"bytes"
 	"errors"
 	"net"
 	"net/http"
@@ -16,6 +17,10 @@ type responseWriterWrapper struct {
 	statusCodeWritten bool
 	hijacker          http.Hijacker
 	flusher           http.Flusher
+
+	// captured holds the beginning of the response body once startCapture was called.
+	captured     *bytes.Buffer
+	captureLimit int
 }
 
 func newWrappedWriter(original http.ResponseWriter) *responseWriterWrapper {
@@ -43,9 +48,30 @@ func (rw *responseWriterWrapper) Write(data []byte) (int, error) {
 	if !rw.statusCodeWritten {
 		rw.statusCode = http.StatusOK
 	}
+	if rw.captured != nil && rw.captured.Len() < rw.captureLimit {
+		remaining := rw.captureLimit - rw.captured.Len()
+		if len(data) < remaining {
+			remaining = len(data)
+		}
+		rw.captured.Write(data[:remaining])
+	}
 	return rw.ResponseWriter.Write(data)
 }
 
+// startCapture keeps a copy of up to limit bytes of the response body written from now on.
+func (rw *responseWriterWrapper) startCapture(limit int) {
+	rw.captured = &bytes.Buffer{}
+	rw.captureLimit = limit
+}
+
+// CapturedBody returns the response body captured since startCapture was called.
+func (rw *responseWriterWrapper) CapturedBody() []byte {
+	if rw.captured == nil {
+		return nil
+	}
+	return rw.captured.Bytes()
+}
+
 // Using as embedded makes the ResponseWrite be stored as interface and that way
 // it loses the access to the implementation for Hijack or Flush
 func (rw *responseWriterWrapper) Hijack() (net.Conn, *bufio.ReadWriter, error) {
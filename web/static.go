@@ -41,6 +41,7 @@ func (w *Web) InitStatic() {
 		w.MainRouter.PathPrefix("/static/").Handler(staticHandler)
 		w.MainRouter.Handle("/robots.txt", http.HandlerFunc(robotsHandler))
 		w.MainRouter.Handle("/unsupported_browser.js", http.HandlerFunc(unsupportedBrowserScriptHandler))
+		w.MainRouter.Handle("/embed/{app_id:[A-Za-z0-9]+}/channels/{channel_id:[A-Za-z0-9]+}", w.NewStaticHandler(embedRoot)).Methods("GET")
 		w.MainRouter.Handle("/{anything:.*}", w.NewStaticHandler(root)).Methods("GET")
 
 		// When a subpath is defined, it's necessary to handle redirects without a
@@ -71,6 +72,49 @@ func root(c *Context, w http.ResponseWriter, r *http.Request) {
 	http.ServeFile(w, r, filepath.Join(staticDir, "root.html"))
 }
 
+// embedRoot serves the webapp for a channel view embedded by an OAuth app, letting the frame
+// ancestors of the app embed it instead of the same origin only.
+func embedRoot(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireAppId().RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	embedConfig, err := c.App.GetEmbedConfig(c.Params.AppId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	// X-Frame-Options can't list origins, and is superseded by frame-ancestors anyway.
+	w.Header().Del("X-Frame-Options")
+	setFrameAncestors(w.Header(), embedConfig.FrameAncestors)
+
+	root(c, w, r)
+}
+
+// setFrameAncestors sets the frame-ancestors directive of the Content-Security-Policy header to the
+// same origin and the given ones, keeping the other directives of the policy.
+func setFrameAncestors(header http.Header, ancestors []string) {
+	sources := []string{"'self'"}
+	for _, ancestor := range ancestors {
+		if model.IsValidEmbedOrigin(ancestor) {
+			sources = append(sources, ancestor)
+		}
+	}
+
+	directives := []string{"frame-ancestors " + strings.Join(sources, " ")}
+	for _, directive := range strings.Split(header.Get("Content-Security-Policy"), ";") {
+		directive = strings.TrimSpace(directive)
+		if directive == "" || strings.HasPrefix(strings.ToLower(directive), "frame-ancestors") {
+			continue
+		}
+		directives = append(directives, directive)
+	}
+
+	header.Set("Content-Security-Policy", strings.Join(directives, "; "))
+}
+
 func staticFilesHandler(handler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		//wrap our ResponseWriter with our no-cache 404-handler
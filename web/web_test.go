@@ -10,6 +10,7 @@ import (
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -322,6 +323,55 @@ func TestPublicFilesRequest(t *testing.T) {
 	assert.Equal(t, 301, res.Code)
 }
 
+func TestEmbedRootFrameAncestors(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableOAuthServiceProvider = true
+		*cfg.ServiceSettings.EnableEmbedding = true
+	})
+
+	oauthApp, appErr := th.App.CreateOAuthApp(&model.OAuthApp{
+		CreatorId:           th.SystemAdminUser.Id,
+		Name:                "embedding app",
+		Homepage:            "https://nowhere.com",
+		CallbackUrls:        []string{"https://nowhere.com"},
+		EmbedFrameAncestors: []string{"https://parent.example.com"},
+	})
+	require.Nil(t, appErr)
+
+	req, _ := http.NewRequest("GET", "/embed/"+oauthApp.Id+"/channels/"+th.BasicChannel.Id, nil)
+	res := httptest.NewRecorder()
+	th.Web.MainRouter.ServeHTTP(res, req)
+	assert.Empty(t, res.Header().Get("X-Frame-Options"))
+	csp := res.Header().Get("Content-Security-Policy")
+	assert.True(t, strings.HasPrefix(csp, "frame-ancestors 'self' https://parent.example.com; script-src 'self'"), csp)
+
+	t.Run("embedding disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableEmbedding = false })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableEmbedding = true })
+
+		req, _ := http.NewRequest("GET", "/embed/"+oauthApp.Id+"/channels/"+th.BasicChannel.Id, nil)
+		res := httptest.NewRecorder()
+		th.Web.MainRouter.ServeHTTP(res, req)
+		assert.Equal(t, "SAMEORIGIN", res.Header().Get("X-Frame-Options"))
+		assert.True(t, strings.HasPrefix(res.Header().Get("Content-Security-Policy"), "frame-ancestors 'self';"))
+	})
+}
+
+func TestSetFrameAncestors(t *testing.T) {
+	header := http.Header{}
+	header.Set("Content-Security-Policy", "frame-ancestors 'self'; script-src 'self' cdn.rudderlabs.com")
+
+	setFrameAncestors(header, []string{"https://parent.example.com", "https://evil.example.com; script-src *"})
+	assert.Equal(t, "frame-ancestors 'self' https://parent.example.com; script-src 'self' cdn.rudderlabs.com", header.Get("Content-Security-Policy"))
+
+	header = http.Header{}
+	setFrameAncestors(header, []string{"https://parent.example.com"})
+	assert.Equal(t, "frame-ancestors 'self' https://parent.example.com", header.Get("Content-Security-Policy"))
+}
+
 /* Test disabled for now so we don't requrie the client to build. Maybe re-enable after client gets moved out.
 func TestStatic(t *testing.T) {
 	Setup()